@@ -33,11 +33,32 @@ type Account struct {
 	Kind     AccountKind
 	Admin    bool
 	Metadata map[string]any
+	Locale   opt.Optional[string]
+	Timezone opt.Optional[string]
+	Privacy  Privacy
+
+	// Owner is the member responsible for a bot account, bots whose owner has
+	// since been removed are managed by administrators.
+	Owner opt.Optional[AccountID]
+
+	// Verification is the badge staff have given the account, if any.
+	Verification opt.Optional[Verification]
 
 	DeletedAt opt.Optional[time.Time]
 	IndexedAt opt.Optional[time.Time]
 }
 
+// Privacy holds the account's choices about what is recorded about them.
+type Privacy struct {
+	// ReadingHistory records which threads and pages the member has read and
+	// how far they got, so they can continue where they left off.
+	ReadingHistory bool
+
+	// HiddenActivity lists the kinds of activity left off the member's public
+	// profile timeline, the member can still see everything on their own.
+	HiddenActivity []ActivityKind
+}
+
 type AccountWithEdges struct {
 	Account
 	Roles          held.Roles
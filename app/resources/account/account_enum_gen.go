@@ -62,6 +62,128 @@ func NewAccountKind(__iNpUt__ string) (AccountKind, error) {
 	}
 }
 
+type ActivityKind struct {
+	v activityKindEnum
+}
+
+var (
+	ActivityKindThreadCreated       = ActivityKind{activityKindThreadCreated}
+	ActivityKindReplyCreated        = ActivityKind{activityKindReplyCreated}
+	ActivityKindNodeUpdated         = ActivityKind{activityKindNodeUpdated}
+	ActivityKindCollectionPublished = ActivityKind{activityKindCollectionPublished}
+)
+
+func (r ActivityKind) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r ActivityKind) String() string {
+	return string(r.v)
+}
+func (r ActivityKind) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *ActivityKind) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewActivityKind(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r ActivityKind) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *ActivityKind) Scan(__iNpUt__ any) error {
+	s, err := NewActivityKind(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewActivityKind(__iNpUt__ string) (ActivityKind, error) {
+	switch __iNpUt__ {
+	case string(activityKindThreadCreated):
+		return ActivityKindThreadCreated, nil
+	case string(activityKindReplyCreated):
+		return ActivityKindReplyCreated, nil
+	case string(activityKindNodeUpdated):
+		return ActivityKindNodeUpdated, nil
+	case string(activityKindCollectionPublished):
+		return ActivityKindCollectionPublished, nil
+	default:
+		return ActivityKind{}, fmt.Errorf("invalid value for type 'ActivityKind': '%s'", __iNpUt__)
+	}
+}
+
+type Verification struct {
+	v verificationEnum
+}
+
+var (
+	VerificationStaff        = Verification{verificationStaff}
+	VerificationVerified     = Verification{verificationVerified}
+	VerificationBot          = Verification{verificationBot}
+	VerificationOrganization = Verification{verificationOrganization}
+)
+
+func (r Verification) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r Verification) String() string {
+	return string(r.v)
+}
+func (r Verification) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *Verification) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewVerification(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r Verification) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *Verification) Scan(__iNpUt__ any) error {
+	s, err := NewVerification(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewVerification(__iNpUt__ string) (Verification, error) {
+	switch __iNpUt__ {
+	case string(verificationStaff):
+		return VerificationStaff, nil
+	case string(verificationVerified):
+		return VerificationVerified, nil
+	case string(verificationBot):
+		return VerificationBot, nil
+	case string(verificationOrganization):
+		return VerificationOrganization, nil
+	default:
+		return Verification{}, fmt.Errorf("invalid value for type 'Verification': '%s'", __iNpUt__)
+	}
+}
+
 type VerifiedStatus struct {
 	v verifiedStatusEnum
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/schema"
 )
 
@@ -63,6 +64,18 @@ func WithInvitedBy(id xid.ID) Option {
 	}
 }
 
+func WithKind(kind account.AccountKind) Option {
+	return func(a *ent.AccountMutation) {
+		a.SetKind(ent_account.Kind(kind.String()))
+	}
+}
+
+func WithOwner(id account.AccountID) Option {
+	return func(a *ent.AccountMutation) {
+		a.SetOwnerID(xid.ID(id))
+	}
+}
+
 func SetHandle(handle string) Mutation {
 	return func(u *ent.AccountUpdateOne) {
 		u.SetHandle(handle)
@@ -110,6 +123,48 @@ func SetMetadata(m map[string]any) Mutation {
 	}
 }
 
+func SetLocale(locale opt.Optional[string]) Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		if v, ok := locale.Get(); ok {
+			u.SetLocale(v)
+		} else {
+			u.ClearLocale()
+		}
+	}
+}
+
+func SetTimezone(timezone opt.Optional[string]) Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		if v, ok := timezone.Get(); ok {
+			u.SetTimezone(v)
+		} else {
+			u.ClearTimezone()
+		}
+	}
+}
+
+func SetReadingHistory(enabled bool) Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		u.SetReadingHistoryEnabled(enabled)
+	}
+}
+
+func SetHiddenActivity(kinds []account.ActivityKind) Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		u.SetHiddenActivity(dt.Map(kinds, func(k account.ActivityKind) string { return k.String() }))
+	}
+}
+
+func SetVerification(v opt.Optional[account.Verification]) Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		if b, ok := v.Get(); ok {
+			u.SetVerification(b.String())
+		} else {
+			u.ClearVerification()
+		}
+	}
+}
+
 func SetDeleted(t opt.Optional[time.Time]) Mutation {
 	return func(u *ent.AccountUpdateOne) {
 		if v, ok := t.Get(); ok {
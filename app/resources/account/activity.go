@@ -0,0 +1,28 @@
+package account
+
+//go:generate go run github.com/Southclaws/enumerator
+
+// activityKindEnum is the kind of thing a member has done, as shown on their
+// profile's activity timeline.
+type activityKindEnum string
+
+const (
+	activityKindThreadCreated       activityKindEnum = "thread_created"
+	activityKindReplyCreated        activityKindEnum = "reply_created"
+	activityKindNodeUpdated         activityKindEnum = "node_updated"
+	activityKindCollectionPublished activityKindEnum = "collection_published"
+)
+
+// mapActivityKinds skips any stored kinds which are no longer recognised so an
+// old preference never prevents the account from loading.
+func mapActivityKinds(in []string) []ActivityKind {
+	out := make([]ActivityKind, 0, len(in))
+	for _, s := range in {
+		k, err := NewActivityKind(s)
+		if err != nil {
+			continue
+		}
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,97 @@
+// Package application describes the applications new members submit when
+// registration requires approval from the instance's staff.
+package application
+
+import (
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/schema"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type statusEnum string
+
+const (
+	statusPending  statusEnum = "pending"
+	statusApproved statusEnum = "approved"
+	statusRejected statusEnum = "rejected"
+)
+
+type ApplicationID xid.ID
+
+func (i ApplicationID) String() string { return xid.ID(i).String() }
+
+type Application struct {
+	ID          ApplicationID
+	CreatedAt   time.Time
+	Account     account.Account
+	Status      Status
+	Answers     []Answer
+	SubmittedAt opt.Optional[time.Time]
+	ReviewedAt  opt.Optional[time.Time]
+	Reason      opt.Optional[string]
+}
+
+// Held reports whether the account is still waiting on, or was refused, the
+// instance's approval and so can't yet take part.
+func (a *Application) Held() bool {
+	return a.Status != StatusApproved
+}
+
+type Answer struct {
+	QuestionID string
+	Answer     string
+}
+
+type Question struct {
+	ID       string
+	Prompt   string
+	Required bool
+}
+
+// Settings control whether new members must be approved before they can take
+// part and the questions they are asked when applying.
+type Settings struct {
+	// Required places every new account, apart from the first, in a pending
+	// state until a member of staff approves it.
+	Required bool
+
+	Questions []Question
+}
+
+func Map(in *ent.AccountApplication) (*Application, error) {
+	accEdge, err := in.Edges.AccountOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := account.MapRef(accEdge)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := NewStatus(in.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Application{
+		ID:        ApplicationID(in.ID),
+		CreatedAt: in.CreatedAt,
+		Account:   *acc,
+		Status:    status,
+		Answers: dt.Map(in.Answers, func(a schema.ApplicationAnswer) Answer {
+			return Answer{QuestionID: a.QuestionID, Answer: a.Answer}
+		}),
+		SubmittedAt: opt.NewPtr(in.SubmittedAt),
+		ReviewedAt:  opt.NewPtr(in.ReviewedAt),
+		Reason:      opt.NewPtr(in.Reason),
+	}, nil
+}
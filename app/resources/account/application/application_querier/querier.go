@@ -0,0 +1,106 @@
+package application_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/application"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_application "github.com/Southclaws/storyden/internal/ent/accountapplication"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) Get(ctx context.Context, id application.ApplicationID) (*application.Application, error) {
+	r, err := q.db.AccountApplication.Query().
+		Where(ent_application.ID(xid.ID(id))).
+		WithAccount().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a, err := application.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return a, nil
+}
+
+func (q *Querier) GetByAccount(ctx context.Context, accountID account.AccountID) (*application.Application, error) {
+	r, err := q.db.AccountApplication.Query().
+		Where(ent_application.AccountID(xid.ID(accountID))).
+		WithAccount().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a, err := application.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return a, nil
+}
+
+// IsHeld reports whether the account has an application which hasn't been
+// approved. Accounts which never applied are not held.
+func (q *Querier) IsHeld(ctx context.Context, accountID account.AccountID) (bool, error) {
+	held, err := q.db.AccountApplication.Query().
+		Where(
+			ent_application.AccountID(xid.ID(accountID)),
+			ent_application.StatusNEQ(application.StatusApproved.String()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return held, nil
+}
+
+// List returns applications oldest first so the queue is reviewed in the
+// order members applied. Applications still awaiting answers are excluded.
+func (q *Querier) List(ctx context.Context, status opt.Optional[application.Status]) ([]*application.Application, error) {
+	query := q.db.AccountApplication.Query().
+		Where(ent_application.SubmittedAtNotNil()).
+		WithAccount().
+		Order(ent.Asc(ent_application.FieldSubmittedAt))
+
+	if s, ok := status.Get(); ok {
+		query.Where(ent_application.Status(s.String()))
+	}
+
+	r, err := query.All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	apps, err := dt.MapErr(r, application.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return apps, nil
+}
@@ -0,0 +1,91 @@
+package application_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/application"
+	"github.com/Southclaws/storyden/app/resources/account/application/application_querier"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_application "github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/schema"
+)
+
+type Writer struct {
+	db      *ent.Client
+	querier *application_querier.Querier
+}
+
+func New(db *ent.Client, querier *application_querier.Querier) *Writer {
+	return &Writer{db: db, querier: querier}
+}
+
+// Create opens a pending application for the account. Accounts only ever have
+// one application so creating another is a conflict.
+func (w *Writer) Create(ctx context.Context, accountID account.AccountID) (*application.Application, error) {
+	r, err := w.db.AccountApplication.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetStatus(application.StatusPending.String()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, application.ApplicationID(r.ID))
+}
+
+// Submit records the applicant's answers, replacing any given previously.
+func (w *Writer) Submit(ctx context.Context, id application.ApplicationID, answers []application.Answer) (*application.Application, error) {
+	err := w.db.AccountApplication.UpdateOneID(xid.ID(id)).
+		SetAnswers(dt.Map(answers, func(a application.Answer) schema.ApplicationAnswer {
+			return schema.ApplicationAnswer{QuestionID: a.QuestionID, Answer: a.Answer}
+		})).
+		SetSubmittedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, id)
+}
+
+// Review sets the outcome of every listed application which is still pending,
+// applications which were already reviewed are left as they are. The number
+// of applications changed is returned.
+func (w *Writer) Review(
+	ctx context.Context,
+	ids []application.ApplicationID,
+	status application.Status,
+	reviewer opt.Optional[account.AccountID],
+	reason opt.Optional[string],
+) (int, error) {
+	n, err := w.db.AccountApplication.Update().
+		Where(
+			ent_application.IDIn(dt.Map(ids, func(id application.ApplicationID) xid.ID { return xid.ID(id) })...),
+			ent_application.Status(application.StatusPending.String()),
+		).
+		SetStatus(status.String()).
+		SetNillableReviewedByID(opt.Map(reviewer, func(id account.AccountID) xid.ID { return xid.ID(id) }).Ptr()).
+		SetReviewedAt(time.Now()).
+		SetNillableReason(reason.Ptr()).
+		Save(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
@@ -90,6 +90,8 @@ var (
 	ServiceOAuthGitHub   = Service{serviceOAuthGitHub}
 	ServiceOAuthDiscord  = Service{serviceOAuthDiscord}
 	ServiceOAuthKeycloak = Service{serviceOAuthKeycloak}
+	ServiceMatrix        = Service{serviceMatrix}
+	ServiceTelegram      = Service{serviceTelegram}
 )
 
 func (r Service) Format(f fmt.State, verb rune) {
@@ -118,6 +120,10 @@ func (r Service) Format(f fmt.State, verb rune) {
 			fmt.Fprint(f, "Discord")
 		case ServiceOAuthKeycloak:
 			fmt.Fprint(f, "Keycloak")
+		case ServiceMatrix:
+			fmt.Fprint(f, "Matrix")
+		case ServiceTelegram:
+			fmt.Fprint(f, "Telegram")
 		default:
 			fmt.Fprint(f, "")
 		}
@@ -170,6 +176,10 @@ func NewService(__iNpUt__ string) (Service, error) {
 		return ServiceOAuthDiscord, nil
 	case string(serviceOAuthKeycloak):
 		return ServiceOAuthKeycloak, nil
+	case string(serviceMatrix):
+		return ServiceMatrix, nil
+	case string(serviceTelegram):
+		return ServiceTelegram, nil
 	default:
 		return Service{}, fmt.Errorf("invalid value for type 'Service': '%s'", __iNpUt__)
 	}
@@ -12,10 +12,14 @@ const (
 	serviceAccessKey   serviceEnum = "access_key"   // API access key
 
 	// OAuth services
-	serviceOAuthGoogle  serviceEnum = "oauth_google"  // Google
-	serviceOAuthGitHub  serviceEnum = "oauth_github"  // GitHub
-	serviceOAuthDiscord serviceEnum = "oauth_discord" // Discord
+	serviceOAuthGoogle   serviceEnum = "oauth_google"   // Google
+	serviceOAuthGitHub   serviceEnum = "oauth_github"   // GitHub
+	serviceOAuthDiscord  serviceEnum = "oauth_discord"  // Discord
 	serviceOAuthKeycloak serviceEnum = "oauth_keycloak" // Keycloak
+
+	// Chat identities linked by bridges, these cannot be used to sign in
+	serviceMatrix   serviceEnum = "matrix"   // Matrix
+	serviceTelegram serviceEnum = "telegram" // Telegram
 )
 
 type tokenTypeEnum string
@@ -0,0 +1,38 @@
+// Package bot describes bot accounts, which are accounts for automated clients
+// that authenticate only with bot access keys and are managed by a member.
+package bot
+
+import (
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Bot struct {
+	Account account.Account
+	Owner   opt.Optional[account.Account]
+}
+
+func Map(in *ent.Account) (*Bot, error) {
+	acc, err := account.MapRef(in)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := opt.MapErr(opt.NewPtr(in.Edges.Owner), func(o ent.Account) (account.Account, error) {
+		a, err := account.MapRef(&o)
+		if err != nil {
+			return account.Account{}, err
+		}
+		return *a, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bot{
+		Account: *acc,
+		Owner:   owner,
+	}, nil
+}
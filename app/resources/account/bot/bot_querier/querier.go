@@ -0,0 +1,71 @@
+package bot_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/bot"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) GetByHandle(ctx context.Context, handle string) (*bot.Bot, error) {
+	a, err := q.db.Account.Query().
+		Where(
+			ent_account.Handle(handle),
+			ent_account.KindEQ(ent_account.KindBot),
+			ent_account.DeletedAtIsNil(),
+		).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	b, err := bot.Map(a)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+// ListByOwner lists the bots the member is responsible for, oldest first.
+func (q *Querier) ListByOwner(ctx context.Context, ownerID account.AccountID) ([]*bot.Bot, error) {
+	as, err := q.db.Account.Query().
+		Where(
+			ent_account.OwnerID(xid.ID(ownerID)),
+			ent_account.KindEQ(ent_account.KindBot),
+			ent_account.DeletedAtIsNil(),
+		).
+		WithOwner().
+		Order(ent.Asc(ent_account.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	bots, err := dt.MapErr(as, bot.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return bots, nil
+}
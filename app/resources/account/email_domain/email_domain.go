@@ -0,0 +1,88 @@
+package email_domain
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+)
+
+// Rule applies to email addresses at a domain or any of its subdomains.
+type Rule struct {
+	Domain string
+
+	// AutoApprove skips the approval queue once an address is verified.
+	AutoApprove bool
+
+	// Roles are granted to the account once an address is verified.
+	Roles []role.RoleID
+}
+
+// Settings scope an instance to a set of email domains, such as a company or
+// a school, and configure what verifying an address at each domain grants.
+type Settings struct {
+	// Restricted only allows addresses matching a rule to be registered. It
+	// applies to email addresses, so it's best paired with email-only login.
+	Restricted bool
+
+	Rules []Rule
+}
+
+// Match returns the most specific rule for the address, if any.
+func (s Settings) Match(address mail.Address) opt.Optional[Rule] {
+	domain := Domain(address)
+	if domain == "" {
+		return opt.NewEmpty[Rule]()
+	}
+
+	var (
+		best  Rule
+		found bool
+	)
+	for _, r := range s.Rules {
+		d := Normalise(r.Domain)
+		if d == "" {
+			continue
+		}
+		if domain != d && !strings.HasSuffix(domain, "."+d) {
+			continue
+		}
+		if !found || len(d) > len(Normalise(best.Domain)) {
+			best, found = r, true
+		}
+	}
+
+	if !found {
+		return opt.NewEmpty[Rule]()
+	}
+
+	return opt.New(best)
+}
+
+func (s Settings) Allows(address mail.Address) bool {
+	return !s.Restricted || s.Match(address).Ok()
+}
+
+func (s Settings) Domains() []string {
+	domains := make([]string, 0, len(s.Rules))
+	for _, r := range s.Rules {
+		if d := Normalise(r.Domain); d != "" {
+			domains = append(domains, d)
+		}
+	}
+	return domains
+}
+
+func Domain(address mail.Address) string {
+	at := strings.LastIndex(address.Address, "@")
+	if at < 0 {
+		return ""
+	}
+	return Normalise(address.Address[at+1:])
+}
+
+func Normalise(domain string) string {
+	return strings.Trim(strings.ToLower(strings.TrimSpace(domain)), ".@")
+}
@@ -0,0 +1,99 @@
+package feed_token
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
+)
+
+var ErrInvalidToken = fault.New("invalid feed token", ftag.With(ftag.Unauthenticated))
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Get returns the member's feed token, issuing one if they don't have one.
+func (r *Repository) Get(ctx context.Context, accountID account.AccountID) (string, error) {
+	t, err := r.db.FeedToken.Query().
+		Where(feedtoken.AccountID(xid.ID(accountID))).
+		Only(ctx)
+	if err == nil {
+		return t.Token, nil
+	}
+	if !ent.IsNotFound(err) {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	token, err := generate()
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = r.db.FeedToken.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetToken(token).
+		OnConflictColumns(feedtoken.FieldAccountID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	t, err = r.db.FeedToken.Query().
+		Where(feedtoken.AccountID(xid.ID(accountID))).
+		Only(ctx)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return t.Token, nil
+}
+
+// Revoke deletes the member's token, invalidating every feed URL which has
+// been shared. A new token is issued the next time one is requested.
+func (r *Repository) Revoke(ctx context.Context, accountID account.AccountID) error {
+	_, err := r.db.FeedToken.Delete().
+		Where(feedtoken.AccountID(xid.ID(accountID))).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) Lookup(ctx context.Context, token string) (account.AccountID, error) {
+	t, err := r.db.FeedToken.Query().
+		Where(feedtoken.Token(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return account.AccountID{}, fault.Wrap(ErrInvalidToken, fctx.With(ctx))
+		}
+		return account.AccountID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return account.AccountID(t.AccountID), nil
+}
+
+func generate() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
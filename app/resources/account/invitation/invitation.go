@@ -4,9 +4,11 @@ import (
 	"time"
 
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/internal/ent"
-	"github.com/rs/xid"
 )
 
 type Invitation struct {
@@ -16,6 +18,36 @@ type Invitation struct {
 	DeletedAt opt.Optional[time.Time]
 	Message   opt.Optional[string]
 	Creator   account.Account
+	MaxUses   opt.Optional[int]
+	ExpiresAt opt.Optional[time.Time]
+	Role      opt.Optional[role.RoleID]
+	Uses      int
+}
+
+func (i *Invitation) Expired(now time.Time) bool {
+	exp, ok := i.ExpiresAt.Get()
+	return ok && !now.Before(exp)
+}
+
+func (i *Invitation) Exhausted() bool {
+	limit, ok := i.MaxUses.Get()
+	return ok && i.Uses >= limit
+}
+
+// Usable reports whether a new member may still register with the invitation.
+func (i *Invitation) Usable(now time.Time) bool {
+	return !i.DeletedAt.Ok() && !i.Expired(now) && !i.Exhausted()
+}
+
+// Settings control how members may invite others and whether an invitation is
+// required to register at all.
+type Settings struct {
+	// Required makes registration invitation-only, apart from the first account.
+	Required bool
+
+	// Quota is how many usable invitations a member may hold at once, zero
+	// means no limit. Administrators are never limited.
+	Quota int
 }
 
 func Map(in *ent.Invitation) (*Invitation, error) {
@@ -36,5 +68,9 @@ func Map(in *ent.Invitation) (*Invitation, error) {
 		DeletedAt: opt.NewPtr(in.DeletedAt),
 		Message:   opt.NewPtr(in.Message),
 		Creator:   *acc,
+		MaxUses:   opt.NewPtr(in.MaxUses),
+		ExpiresAt: opt.NewPtr(in.ExpiresAt),
+		Role:      opt.Map(opt.NewPtr(in.RoleID), func(id xid.ID) role.RoleID { return role.RoleID(id) }),
+		Uses:      len(in.Edges.Invited),
 	}, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/invitation"
 	"github.com/Southclaws/storyden/internal/ent"
+	account_ent "github.com/Southclaws/storyden/internal/ent/account"
 	invitation_ent "github.com/Southclaws/storyden/internal/ent/invitation"
 )
 
@@ -35,7 +36,8 @@ func (d *Querier) GetByID(ctx context.Context, id xid.ID) (*invitation.Invitatio
 	q := d.db.Invitation.
 		Query().
 		Where(invitation_ent.ID(id)).
-		WithCreator()
+		WithCreator().
+		WithInvited()
 
 	result, err := q.Only(ctx)
 	if err != nil {
@@ -55,7 +57,10 @@ func (d *Querier) GetByID(ctx context.Context, id xid.ID) (*invitation.Invitatio
 }
 
 func (d *Querier) List(ctx context.Context, opts ...Filter) ([]*invitation.Invitation, error) {
-	q := d.db.Invitation.Query()
+	q := d.db.Invitation.Query().
+		WithCreator().
+		WithInvited().
+		Order(ent.Desc(invitation_ent.FieldCreatedAt))
 
 	for _, opt := range opts {
 		opt(q)
@@ -73,3 +78,21 @@ func (d *Querier) List(ctx context.Context, opts ...Filter) ([]*invitation.Invit
 
 	return invs, nil
 }
+
+// ListInvited returns the members who registered using the invitation.
+func (d *Querier) ListInvited(ctx context.Context, id xid.ID) ([]*account.Account, error) {
+	result, err := d.db.Account.Query().
+		Where(account_ent.InvitedByID(id)).
+		Order(ent.Desc(account_ent.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accs, err := dt.MapErr(result, account.MapRef)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return accs, nil
+}
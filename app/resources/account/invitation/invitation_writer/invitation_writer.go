@@ -2,6 +2,7 @@ package invitation_writer
 
 import (
 	"context"
+	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/invitation"
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/internal/ent"
 )
 
@@ -22,12 +24,37 @@ func New(db *ent.Client) *Writer {
 	return &Writer{db: db}
 }
 
-func (d *Writer) Create(ctx context.Context, creator account.AccountID, message opt.Optional[string]) (*invitation.Invitation, error) {
+type Option func(*ent.InvitationMutation)
+
+func WithMaxUses(n int) Option {
+	return func(m *ent.InvitationMutation) {
+		m.SetMaxUses(n)
+	}
+}
+
+func WithExpiry(t time.Time) Option {
+	return func(m *ent.InvitationMutation) {
+		m.SetExpiresAt(t)
+	}
+}
+
+func WithRole(id role.RoleID) Option {
+	return func(m *ent.InvitationMutation) {
+		m.SetRoleID(xid.ID(id))
+	}
+}
+
+func (d *Writer) Create(ctx context.Context, creator account.AccountID, message opt.Optional[string], opts ...Option) (*invitation.Invitation, error) {
 	create := d.db.Invitation.Create()
+	mutation := create.Mutation()
 
 	create.SetCreatorAccountID(xid.ID(creator))
 	create.SetNillableMessage(message.Ptr())
 
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
 	result, err := create.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
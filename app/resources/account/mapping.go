@@ -6,6 +6,7 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account/role/held"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -34,6 +35,14 @@ func MapRef(a *ent.Account) (*Account, error) {
 		Kind:     kind,
 		Admin:    a.Admin, // TODO: should this be derived from roles?
 		Metadata: a.Metadata,
+		Locale:   opt.NewPtr(a.Locale),
+		Timezone: opt.NewPtr(a.Timezone),
+		Privacy: Privacy{
+			ReadingHistory: a.ReadingHistoryEnabled,
+			HiddenActivity: mapActivityKinds(a.HiddenActivity),
+		},
+		Owner:        opt.Map(opt.NewPtr(a.OwnerID), func(id xid.ID) AccountID { return AccountID(id) }),
+		Verification: MapVerification(a.Verification),
 
 		DeletedAt: opt.NewPtr(a.DeletedAt),
 		IndexedAt: opt.NewPtr(a.IndexedAt),
@@ -0,0 +1,112 @@
+package digest
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+)
+
+type Thread struct {
+	ID      xid.ID `db:"id"`
+	Title   string `db:"title"`
+	Slug    string `db:"slug"`
+	Replies int    `db:"replies"`
+}
+
+type Page struct {
+	Name string `db:"name"`
+	Slug string `db:"slug"`
+}
+
+type Querier struct {
+	raw *sqlx.DB
+}
+
+func NewQuerier(raw *sqlx.DB) *Querier {
+	return &Querier{raw: raw}
+}
+
+const topThreadsQuery = `select
+  p.id,
+  coalesce(p.title, '') title,
+  coalesce(p.slug, '') slug,
+  count(r.id) replies
+from
+  posts p
+  inner join category_follows cf on cf.category_id = p.category_id and cf.account_id = $1
+  left join posts r on r.root_post_id = p.id and r.deleted_at is null and r.visibility = 'published' and r.created_at > $2
+where
+  p.root_post_id is null
+  and p.deleted_at is null
+  and p.visibility = 'published'
+  and (p.created_at > $2 or p.last_reply_at > $2)
+group by
+  p.id
+order by
+  replies desc,
+  p.last_reply_at desc
+limit $3
+`
+
+// TopThreads returns the most replied-to threads with activity since the given
+// time in the categories the member follows.
+func (q *Querier) TopThreads(ctx context.Context, accountID account.AccountID, since time.Time, limit int) ([]Thread, error) {
+	var rows []Thread
+	err := q.raw.SelectContext(ctx, &rows, topThreadsQuery, xid.ID(accountID).String(), since, limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rows, nil
+}
+
+const newPagesQuery = `select
+  n.name,
+  n.slug
+from
+  nodes n
+where
+  n.deleted_at is null
+  and n.visibility = 'published'
+  and n.created_at > $1
+order by
+  n.created_at desc
+limit $2
+`
+
+// NewPages returns library pages published since the given time.
+func (q *Querier) NewPages(ctx context.Context, since time.Time, limit int) ([]Page, error) {
+	var rows []Page
+	err := q.raw.SelectContext(ctx, &rows, newPagesQuery, since, limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rows, nil
+}
+
+const unreadQuery = `select
+  count(*)
+from
+  notifications
+where
+  owner_account_id = $1
+  and read = false
+  and deleted_at is null
+`
+
+func (q *Querier) CountUnread(ctx context.Context, accountID account.AccountID) (int, error) {
+	var n int
+	err := q.raw.GetContext(ctx, &n, unreadQuery, xid.ID(accountID).String())
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
@@ -0,0 +1,119 @@
+// Package digest stores members' summary email frequency and queries the
+// content that goes into each summary.
+package digest
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+	entaccount "github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/i18n"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Get(ctx context.Context, accountID account.AccountID) (Frequency, error) {
+	s, err := r.db.DigestSubscription.Query().
+		Where(digestsubscription.AccountID(xid.ID(accountID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return FrequencyNever, nil
+		}
+		return Frequency{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	f, err := NewFrequency(s.Frequency)
+	if err != nil {
+		return FrequencyNever, nil
+	}
+
+	return f, nil
+}
+
+func (r *Repository) Set(ctx context.Context, accountID account.AccountID, f Frequency) error {
+	err := r.db.DigestSubscription.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetFrequency(f.String()).
+		OnConflictColumns(digestsubscription.FieldAccountID).
+		UpdateFrequency().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+type Due struct {
+	AccountID  account.AccountID
+	LastSentAt time.Time
+}
+
+// ListDue returns members on the given frequency who haven't been sent a digest
+// since their most recent slot, which depends on their timezone. Members who
+// haven't chosen a timezone use the given default. A member who has never
+// received one is due straight away and summarised from one interval ago so
+// their first digest isn't their entire history.
+func (r *Repository) ListDue(ctx context.Context, f Frequency, now time.Time, defaultTimezone *time.Location) ([]Due, error) {
+	subs, err := r.db.DigestSubscription.Query().
+		Where(
+			digestsubscription.Frequency(f.String()),
+			digestsubscription.Or(
+				digestsubscription.LastSentAtIsNil(),
+				digestsubscription.LastSentAtLT(now),
+			),
+			digestsubscription.HasAccountWith(entaccount.DeletedAtIsNil()),
+		).
+		WithAccount().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	due := []Due{}
+	for _, s := range subs {
+		if s.LastSentAt == nil {
+			due = append(due, Due{AccountID: account.AccountID(s.AccountID), LastSentAt: now.Add(-f.Interval())})
+			continue
+		}
+
+		loc := defaultTimezone
+		if s.Edges.Account != nil && s.Edges.Account.Timezone != nil {
+			if l, ok := i18n.LoadTimezone(*s.Edges.Account.Timezone); ok {
+				loc = l
+			}
+		}
+
+		if s.LastSentAt.Before(f.Slot(now, loc)) {
+			due = append(due, Due{AccountID: account.AccountID(s.AccountID), LastSentAt: *s.LastSentAt})
+		}
+	}
+
+	return due, nil
+}
+
+func (r *Repository) MarkSent(ctx context.Context, accountID account.AccountID, at time.Time) error {
+	err := r.db.DigestSubscription.Update().
+		Where(digestsubscription.AccountID(xid.ID(accountID))).
+		SetLastSentAt(at).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
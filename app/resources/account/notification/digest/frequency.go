@@ -0,0 +1,49 @@
+package digest
+
+import "time"
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type frequencyEnum string
+
+const (
+	frequencyNever  frequencyEnum = "never"
+	frequencyDaily  frequencyEnum = "daily"
+	frequencyWeekly frequencyEnum = "weekly"
+)
+
+// DeliveryHour is the hour of the day, in each member's own timezone, at which
+// their digest is sent.
+const DeliveryHour = 8
+
+// Interval is the time between two digests.
+func (f Frequency) Interval() time.Duration {
+	switch f {
+	case FrequencyDaily:
+		return 24 * time.Hour
+	case FrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Slot is the most recent time, at or before now, that a digest was due in the
+// given timezone. Daily digests are due every morning at the delivery hour and
+// weekly digests on Monday mornings, so they arrive at the same local time no
+// matter when the job runs or how long the last one took to send.
+func (f Frequency) Slot(now time.Time, loc *time.Location) time.Time {
+	now = now.In(loc)
+
+	slot := time.Date(now.Year(), now.Month(), now.Day(), DeliveryHour, 0, 0, 0, loc)
+	if slot.After(now) {
+		slot = slot.AddDate(0, 0, -1)
+	}
+
+	if f == FrequencyWeekly {
+		back := (int(slot.Weekday()) - int(time.Monday) + 7) % 7
+		slot = slot.AddDate(0, 0, -back)
+	}
+
+	return slot
+}
@@ -0,0 +1,36 @@
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlot(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// Wednesday 14:00 in Tokyo.
+	now := time.Date(2025, 3, 12, 5, 0, 0, 0, time.UTC)
+
+	t.Run("daily", func(t *testing.T) {
+		assert.Equal(t, time.Date(2025, 3, 12, 8, 0, 0, 0, tokyo), FrequencyDaily.Slot(now, tokyo))
+		assert.Equal(t, time.Date(2025, 3, 11, 8, 0, 0, 0, time.UTC), FrequencyDaily.Slot(now, time.UTC))
+	})
+
+	t.Run("weekly", func(t *testing.T) {
+		assert.Equal(t, time.Date(2025, 3, 10, 8, 0, 0, 0, tokyo), FrequencyWeekly.Slot(now, tokyo))
+	})
+
+	t.Run("weekly_on_monday_before_delivery", func(t *testing.T) {
+		monday := time.Date(2025, 3, 10, 7, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC), FrequencyWeekly.Slot(monday, time.UTC))
+	})
+
+	t.Run("at_delivery_hour", func(t *testing.T) {
+		at := time.Date(2025, 3, 12, 8, 0, 0, 0, time.UTC)
+		assert.Equal(t, at, FrequencyDaily.Slot(at, time.UTC))
+	})
+}
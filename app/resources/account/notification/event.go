@@ -16,4 +16,9 @@ const (
 	eventAttendeeRemoved      eventEnum = `attendee_removed`
 	eventReportSubmitted      eventEnum = "report_submitted"
 	eventReportUpdated        eventEnum = "report_updated"
+	eventReportEscalated      eventEnum = "report_escalated"
+	eventFollowedThread       eventEnum = "followed_thread"
+	eventAssetFlagged         eventEnum = "asset_flagged"
+	eventDirectMessage        eventEnum = "direct_message"
+	eventApplicationSubmitted eventEnum = "application_submitted"
 )
@@ -22,6 +22,11 @@ var (
 	EventAttendeeRemoved      = Event{eventAttendeeRemoved}
 	EventReportSubmitted      = Event{eventReportSubmitted}
 	EventReportUpdated        = Event{eventReportUpdated}
+	EventReportEscalated      = Event{eventReportEscalated}
+	EventFollowedThread       = Event{eventFollowedThread}
+	EventAssetFlagged         = Event{eventAssetFlagged}
+	EventDirectMessage        = Event{eventDirectMessage}
+	EventApplicationSubmitted = Event{eventApplicationSubmitted}
 )
 
 func (r Event) Format(f fmt.State, verb rune) {
@@ -81,6 +86,16 @@ func NewEvent(__iNpUt__ string) (Event, error) {
 		return EventReportSubmitted, nil
 	case string(eventReportUpdated):
 		return EventReportUpdated, nil
+	case string(eventReportEscalated):
+		return EventReportEscalated, nil
+	case string(eventFollowedThread):
+		return EventFollowedThread, nil
+	case string(eventAssetFlagged):
+		return EventAssetFlagged, nil
+	case string(eventDirectMessage):
+		return EventDirectMessage, nil
+	case string(eventApplicationSubmitted):
+		return EventApplicationSubmitted, nil
 	default:
 		return Event{}, fmt.Errorf("invalid value for type 'Event': '%s'", __iNpUt__)
 	}
@@ -0,0 +1,142 @@
+// Package notify_pref stores which channels each notification event is
+// delivered through for a member, falling back to defaults for events which
+// the member has never changed.
+package notify_pref
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+)
+
+type Channels struct {
+	InApp    bool
+	Email    bool
+	WebPush  bool
+	Telegram bool
+}
+
+type Preferences map[notification.Event]Channels
+
+// Events is the order in which events are presented to members.
+var Events = []notification.Event{
+	notification.EventThreadReply,
+	notification.EventDirectMessage,
+	notification.EventProfileMention,
+	notification.EventFollowedThread,
+	notification.EventFollow,
+	notification.EventPostLike,
+	notification.EventReportSubmitted,
+	notification.EventReportUpdated,
+	notification.EventReportEscalated,
+	notification.EventAssetFlagged,
+	notification.EventApplicationSubmitted,
+	notification.EventEventHostAdded,
+	notification.EventMemberAttendingEvent,
+	notification.EventMemberDeclinedEvent,
+	notification.EventAttendeeRemoved,
+}
+
+// Direct interactions and moderation outcomes warrant an email by default, the
+// rest stay in-app so a new member's inbox isn't flooded. Only direct
+// interactions are sent to Telegram once it's linked.
+var defaults = Preferences{
+	notification.EventThreadReply:          {InApp: true, WebPush: true, Telegram: true},
+	notification.EventDirectMessage:        {InApp: true, Email: true, WebPush: true, Telegram: true},
+	notification.EventProfileMention:       {InApp: true, Email: true, WebPush: true, Telegram: true},
+	notification.EventFollowedThread:       {InApp: true, WebPush: true},
+	notification.EventReportUpdated:        {InApp: true, Email: true},
+	notification.EventReportEscalated:      {InApp: true, Email: true},
+	notification.EventAssetFlagged:         {InApp: true, Email: true},
+	notification.EventApplicationSubmitted: {InApp: true, Email: true},
+	notification.EventAttendeeRemoved:      {InApp: true, Email: true},
+}
+
+func Default(event notification.Event) Channels {
+	if c, ok := defaults[event]; ok {
+		return c
+	}
+	return Channels{InApp: true}
+}
+
+func Defaults() Preferences {
+	p := make(Preferences, len(Events))
+	for _, e := range Events {
+		p[e] = Default(e)
+	}
+	return p
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Get(ctx context.Context, accountID account.AccountID) (Preferences, error) {
+	rows, err := r.db.NotificationPreference.Query().
+		Where(notificationpreference.AccountID(xid.ID(accountID))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p := Defaults()
+	for _, row := range rows {
+		event, err := notification.NewEvent(row.EventType)
+		if err != nil {
+			continue
+		}
+		p[event] = Channels{InApp: row.InApp, Email: row.Email, WebPush: row.WebPush, Telegram: row.Telegram}
+	}
+
+	return p, nil
+}
+
+// Resolve returns the channels a single event should be delivered through.
+func (r *Repository) Resolve(ctx context.Context, accountID account.AccountID, event notification.Event) (Channels, error) {
+	row, err := r.db.NotificationPreference.Query().
+		Where(
+			notificationpreference.AccountID(xid.ID(accountID)),
+			notificationpreference.EventType(event.String()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return Default(event), nil
+		}
+		return Channels{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Channels{InApp: row.InApp, Email: row.Email, WebPush: row.WebPush, Telegram: row.Telegram}, nil
+}
+
+// Update stores the given events' channels, events not present are unchanged.
+func (r *Repository) Update(ctx context.Context, accountID account.AccountID, p Preferences) (Preferences, error) {
+	for event, c := range p {
+		err := r.db.NotificationPreference.Create().
+			SetAccountID(xid.ID(accountID)).
+			SetEventType(event.String()).
+			SetInApp(c.InApp).
+			SetEmail(c.Email).
+			SetWebPush(c.WebPush).
+			SetTelegram(c.Telegram).
+			OnConflictColumns(notificationpreference.FieldAccountID, notificationpreference.FieldEventType).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return r.Get(ctx, accountID)
+}
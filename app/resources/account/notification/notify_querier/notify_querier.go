@@ -12,11 +12,13 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/notification"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/post_search"
 	"github.com/Southclaws/storyden/internal/ent"
 	entaccount "github.com/Southclaws/storyden/internal/ent/account"
 	entnotification "github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
 )
 
 type Querier struct {
@@ -28,10 +30,27 @@ func New(db *ent.Client, postSearcher post_search.Repository) *Querier {
 	return &Querier{db: db, postSearcher: postSearcher}
 }
 
-func (n *Querier) ListNotifications(ctx context.Context, accountID account.AccountID) (notification.Notifications, error) {
-	r, err := n.db.Notification.Query().
-		Where(entnotification.HasOwnerWith(entaccount.ID(xid.ID(accountID)))).
-		Order(ent.Desc(entnotification.FieldCreatedAt)).
+func (n *Querier) ListNotifications(ctx context.Context, accountID account.AccountID, pp pagination.Parameters) (*pagination.Result[*notification.Notification], error) {
+	q := n.db.Notification.Query().
+		Where(entnotification.HasOwnerWith(entaccount.ID(xid.ID(accountID))))
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if c, ok := pp.Cursor().Get(); ok {
+		t, err := c.Time()
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		q.Where(predicate.Notification(c.Seek(true, entnotification.FieldCreatedAt, t, entnotification.FieldID)))
+	}
+
+	r, err := q.
+		Order(ent.Desc(entnotification.FieldCreatedAt), ent.Desc(entnotification.FieldID)).
+		Limit(pp.Limit()).
+		Offset(pp.Offset()).
 		WithSource().
 		All(ctx)
 	if err != nil {
@@ -43,12 +62,22 @@ func (n *Querier) ListNotifications(ctx context.Context, accountID account.Accou
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	ns, err := n.hydrateRefs(ctx, refs)
+	// The cursor is taken from the refs because hydration drops notifications
+	// about deleted posts and the next page must continue after those too.
+	page := pagination.NewPageResult(pp, total, refs).
+		WithCursor(func(r *notification.NotificationRef) pagination.Cursor {
+			return pagination.NewTimeCursor(r.Time, r.ID)
+		})
+
+	ns, err := n.hydrateRefs(ctx, page.Items)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	return ns, nil
+	result := pagination.ConvertPageResult(page, ns)
+	result.Results = len(ns)
+
+	return &result, nil
 }
 
 func (n *Querier) hydrateRefs(ctx context.Context, refs notification.NotificationRefs) (notification.Notifications, error) {
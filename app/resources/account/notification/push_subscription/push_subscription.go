@@ -0,0 +1,127 @@
+// Package push_subscription stores the Web Push endpoints of members' browsers.
+package push_subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/pushsubscription"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+type Subscription struct {
+	ID        ID
+	AccountID account.AccountID
+	CreatedAt time.Time
+	Endpoint  string
+	P256DH    string
+	Auth      string
+	ExpiresAt opt.Optional[time.Time]
+}
+
+func (s *Subscription) Expired(now time.Time) bool {
+	exp, ok := s.ExpiresAt.Get()
+	return ok && now.After(exp)
+}
+
+func Map(in *ent.PushSubscription) *Subscription {
+	return &Subscription{
+		ID:        ID(in.ID),
+		AccountID: account.AccountID(in.AccountID),
+		CreatedAt: in.CreatedAt,
+		Endpoint:  in.Endpoint,
+		P256DH:    in.P256dh,
+		Auth:      in.Auth,
+		ExpiresAt: opt.NewPtr(in.ExpiresAt),
+	}
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Add stores a subscription, a browser re-subscribing with the same endpoint
+// replaces the previous keys and owner.
+func (r *Repository) Add(ctx context.Context, accountID account.AccountID, endpoint, p256dh, auth string, expiresAt opt.Optional[time.Time]) (*Subscription, error) {
+	id, err := r.db.PushSubscription.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetEndpoint(endpoint).
+		SetP256dh(p256dh).
+		SetAuth(auth).
+		SetNillableExpiresAt(expiresAt.Ptr()).
+		OnConflictColumns(pushsubscription.FieldEndpoint).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := r.db.PushSubscription.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(s), nil
+}
+
+func (r *Repository) List(ctx context.Context, accountID account.AccountID) ([]*Subscription, error) {
+	subs, err := r.db.PushSubscription.Query().
+		Where(pushsubscription.AccountID(xid.ID(accountID))).
+		Order(ent.Asc(pushsubscription.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make([]*Subscription, len(subs))
+	for i, s := range subs {
+		out[i] = Map(s)
+	}
+
+	return out, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, accountID account.AccountID, id ID) error {
+	n, err := r.db.PushSubscription.Delete().
+		Where(
+			pushsubscription.ID(xid.ID(id)),
+			pushsubscription.AccountID(xid.ID(accountID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if n == 0 {
+		return fault.Wrap(fault.New("push subscription not found"), fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return nil
+}
+
+// Prune removes the given subscription, used when a push service reports it
+// has gone or its expiry time has passed.
+func (r *Repository) Prune(ctx context.Context, id ID) error {
+	_, err := r.db.PushSubscription.Delete().
+		Where(pushsubscription.ID(xid.ID(id))).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
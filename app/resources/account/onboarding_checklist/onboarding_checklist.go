@@ -0,0 +1,71 @@
+// Package onboarding_checklist tracks which steps of the new member checklist
+// each account has completed, the checklist itself is configured in settings.
+package onboarding_checklist
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type stepEnum string
+
+const (
+	stepVerifyEmail  stepEnum = "verify_email"
+	stepSetAvatar    stepEnum = "set_avatar"
+	stepFirstPost    stepEnum = "first_post"
+	stepJoinCategory stepEnum = "join_category"
+)
+
+// Settings chooses which steps make up the checklist and in which order. An
+// empty list of steps turns the checklist off.
+type Settings struct {
+	Steps []Step
+}
+
+var DefaultSettings = Settings{
+	Steps: []Step{StepVerifyEmail, StepSetAvatar, StepFirstPost, StepJoinCategory},
+}
+
+type Item struct {
+	Step        Step
+	CompletedAt opt.Optional[time.Time]
+}
+
+// Checklist is an account's progress through the configured steps.
+type Checklist struct {
+	Items []Item
+}
+
+func (c Checklist) Complete() bool {
+	for _, i := range c.Items {
+		if !i.CompletedAt.Ok() {
+			return false
+		}
+	}
+	return true
+}
+
+// Build lays out the configured steps with the account's completion times.
+func Build(s Settings, completed map[Step]time.Time) Checklist {
+	items := make([]Item, 0, len(s.Steps))
+	for _, step := range s.Steps {
+		at, ok := completed[step]
+		items = append(items, Item{Step: step, CompletedAt: opt.NewSafe(at, ok)})
+	}
+	return Checklist{Items: items}
+}
+
+type StepCount struct {
+	Step      Step
+	Completed int
+}
+
+// Funnel shows how far members get through the checklist.
+type Funnel struct {
+	Accounts  int
+	Steps     []StepCount
+	Completed int
+}
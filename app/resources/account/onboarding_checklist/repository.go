@@ -0,0 +1,117 @@
+package onboarding_checklist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_step "github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
+)
+
+type Repository struct {
+	db  *ent.Client
+	raw *sqlx.DB
+}
+
+func New(db *ent.Client, raw *sqlx.DB) *Repository {
+	return &Repository{db: db, raw: raw}
+}
+
+// Complete records the step for the account, reporting false if it had
+// already been completed before.
+func (r *Repository) Complete(ctx context.Context, accountID account.AccountID, step Step) (bool, error) {
+	err := r.db.AccountOnboardingStep.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetStep(step.String()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return true, nil
+}
+
+func (r *Repository) Completed(ctx context.Context, accountID account.AccountID) (map[Step]time.Time, error) {
+	rows, err := r.db.AccountOnboardingStep.Query().
+		Where(ent_step.AccountID(xid.ID(accountID))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make(map[Step]time.Time, len(rows))
+	for _, row := range rows {
+		step, err := NewStep(row.Step)
+		if err != nil {
+			// Steps which no longer exist are ignored.
+			continue
+		}
+		out[step] = row.CreatedAt
+	}
+
+	return out, nil
+}
+
+// Funnel counts the members who have completed each step as well as those
+// who have completed every one of the given steps.
+func (r *Repository) Funnel(ctx context.Context, steps []Step) (*Funnel, error) {
+	accounts, err := r.db.Account.Query().
+		Where(ent_account.DeletedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	counts := make([]StepCount, 0, len(steps))
+	for _, step := range steps {
+		n, err := r.db.AccountOnboardingStep.Query().
+			Where(
+				ent_step.Step(step.String()),
+				ent_step.HasAccountWith(ent_account.DeletedAtIsNil()),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		counts = append(counts, StepCount{Step: step, Completed: n})
+	}
+
+	completed := 0
+	if len(steps) > 0 {
+		// Step names come from the enum so they're safe to inline.
+		names := strings.Join(dt.Map(steps, func(s Step) string { return "'" + s.String() + "'" }), ", ")
+
+		query := fmt.Sprintf(`select count(*) from (
+  select s.account_id
+  from account_onboarding_steps s
+  inner join accounts a on a.id = s.account_id
+  where a.deleted_at is null and s.step in (%s)
+  group by s.account_id
+  having count(*) = %d
+) completed`, names, len(steps))
+
+		if err := r.raw.GetContext(ctx, &completed, query); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return &Funnel{
+		Accounts:  accounts,
+		Steps:     counts,
+		Completed: completed,
+	}, nil
+}
@@ -13,6 +13,7 @@ var (
 	DefaultRoleGuestID  = RoleID(utils.Must(xid.FromString("0000000000000000000g")))
 	DefaultRoleMemberID = RoleID(utils.Must(xid.FromString("000000000000000000m0")))
 	DefaultRoleAdminID  = RoleID(utils.Must(xid.FromString("00000000000000000a00")))
+	DefaultRoleBotID    = RoleID(utils.Must(xid.FromString("0000000000000000b000")))
 )
 
 var DefaultRoleMember = Role{
@@ -51,6 +52,25 @@ var DefaultRoleGuest = Role{
 	SortKey: -2, // Sorts before member role
 }
 
+// DefaultRoleBot is held by every bot account in place of the member role, so
+// what bots may do, and their rate limits, can be set apart from members.
+var DefaultRoleBot = Role{
+	ID:     DefaultRoleBotID,
+	Name:   "Bot",
+	Colour: "blue",
+	Permissions: rbac.NewList(
+		rbac.PermissionCreatePost,
+		rbac.PermissionReadPublishedThreads,
+		rbac.PermissionCreateReaction,
+		rbac.PermissionReadPublishedLibrary,
+		rbac.PermissionListProfiles,
+		rbac.PermissionReadProfile,
+		rbac.PermissionListCollections,
+		rbac.PermissionReadCollection,
+	),
+	SortKey: -1, // Held instead of the member role so sorts in the same place
+}
+
 var DefaultRoleAdmin = Role{
 	ID:          DefaultRoleAdminID,
 	Name:        "Admin",
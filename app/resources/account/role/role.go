@@ -9,6 +9,7 @@ import (
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
 	"github.com/Southclaws/storyden/app/resources/rbac"
 	"github.com/Southclaws/storyden/internal/ent"
 )
@@ -23,6 +24,7 @@ type Role struct {
 	Colour      string
 	Permissions rbac.Permissions
 	SortKey     float64
+	UploadQuota opt.Optional[int64]
 	CreatedAt   time.Time
 }
 
@@ -46,6 +48,30 @@ func (r Roles) Permissions() rbac.Permissions {
 	return rbac.NewList(flat...)
 }
 
+// UploadQuota is the number of bytes of assets a member holding these roles
+// may store. The most generous quota wins and administrators, or members with
+// any role that does not set a quota, are unlimited.
+func (r Roles) UploadQuota() opt.Optional[int64] {
+	if r.Permissions().HasAny(rbac.PermissionAdministrator) {
+		return opt.NewEmpty[int64]()
+	}
+
+	quota := int64(0)
+	for _, role := range r {
+		q, ok := role.UploadQuota.Get()
+		if !ok {
+			return opt.NewEmpty[int64]()
+		}
+		quota = max(quota, q)
+	}
+
+	if len(r) == 0 {
+		return opt.NewEmpty[int64]()
+	}
+
+	return opt.New(quota)
+}
+
 func Map(r *ent.Role) (*Role, error) {
 	perms, err := rbac.NewPermissions(r.Permissions)
 	if err != nil {
@@ -57,6 +83,7 @@ func Map(r *ent.Role) (*Role, error) {
 		Name:        r.Name,
 		Colour:      r.Colour,
 		Permissions: *perms,
+		UploadQuota: opt.NewPtr(r.UploadQuota),
 		CreatedAt:   r.CreatedAt,
 	}, nil
 }
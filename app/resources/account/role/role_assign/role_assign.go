@@ -66,7 +66,7 @@ func (w *Assignment) UpdateRoles(ctx context.Context, accountID account.AccountI
 	mutation := update.Mutation()
 
 	roles = dt.Filter(roles, func(m Mutation) bool {
-		return m.id != role.DefaultRoleMemberID
+		return m.id != role.DefaultRoleMemberID && m.id != role.DefaultRoleBotID
 	})
 
 	adds, removes, admin := split(roles...)
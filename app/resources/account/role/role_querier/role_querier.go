@@ -6,11 +6,13 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/account/role/held"
 	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
 	ent_account_role "github.com/Southclaws/storyden/internal/ent/accountroles"
 	ent_role "github.com/Southclaws/storyden/internal/ent/role"
 )
@@ -26,6 +28,9 @@ func New(db *ent.Client) *Querier {
 func (q *Querier) Get(ctx context.Context, id role.RoleID) (*role.Role, error) {
 	r, err := q.db.Role.Get(ctx, xid.ID(id))
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
@@ -41,6 +46,7 @@ func (q *Querier) List(ctx context.Context) (role.Roles, error) {
 	roles, err := q.db.Role.Query().Where(ent_role.IDNotIn(
 		xid.ID(role.DefaultRoleGuestID),
 		xid.ID(role.DefaultRoleMemberID),
+		xid.ID(role.DefaultRoleBotID),
 	)).All(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -61,7 +67,12 @@ func (q *Querier) List(ctx context.Context) (role.Roles, error) {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	mapped = append(mapped, defaultRole, guestRole)
+	botRole, err := q.GetBotRole(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	mapped = append(mapped, defaultRole, guestRole, botRole)
 
 	sort.Sort(mapped)
 
@@ -76,6 +87,7 @@ func (q *Querier) ListFor(ctx context.Context, account *ent.Account) (held.Roles
 			ent_account_role.HasRoleWith(ent_role.IDNotIn(
 				xid.ID(role.DefaultRoleGuestID),
 				xid.ID(role.DefaultRoleMemberID),
+				xid.ID(role.DefaultRoleBotID),
 			)),
 		).
 		WithRole(func(rq *ent.RoleQuery) {
@@ -92,17 +104,23 @@ func (q *Querier) ListFor(ctx context.Context, account *ent.Account) (held.Roles
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	_, memberRole, err := q.lookupDefaultRoles(ctx)
+	_, memberRole, botRole, err := q.lookupDefaultRoles(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// Bots hold the bot role in place of the member role.
+	storedRole, fallbackRole := memberRole, role.DefaultRoleMember
+	if account.Kind == ent_account.KindBot {
+		storedRole, fallbackRole = botRole, role.DefaultRoleBot
+	}
+
 	var list held.Roles
 
 	// If the default member role has not been modified (aka not added to the DB
 	// with custom permissions) we add the default manually.
-	if memberRole != nil {
-		defaultRole, err := role.Map(memberRole)
+	if storedRole != nil {
+		defaultRole, err := role.Map(storedRole)
 		if err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
 		}
@@ -115,7 +133,7 @@ func (q *Querier) ListFor(ctx context.Context, account *ent.Account) (held.Roles
 		})
 	} else {
 		mapped = append(mapped, &held.Role{
-			Role: role.DefaultRoleMember,
+			Role: fallbackRole,
 		})
 	}
 
@@ -128,7 +146,7 @@ func (q *Querier) ListFor(ctx context.Context, account *ent.Account) (held.Roles
 }
 
 func (q *Querier) GetMemberRole(ctx context.Context) (*role.Role, error) {
-	_, memberRole, err := q.lookupDefaultRoles(ctx)
+	_, memberRole, _, err := q.lookupDefaultRoles(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -141,7 +159,7 @@ func (q *Querier) GetMemberRole(ctx context.Context) (*role.Role, error) {
 }
 
 func (q *Querier) GetGuestRole(ctx context.Context) (*role.Role, error) {
-	guestRole, _, err := q.lookupDefaultRoles(ctx)
+	guestRole, _, _, err := q.lookupDefaultRoles(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -153,25 +171,43 @@ func (q *Querier) GetGuestRole(ctx context.Context) (*role.Role, error) {
 	return role.Map(guestRole)
 }
 
-func (q *Querier) lookupDefaultRoles(ctx context.Context) (*ent.Role, *ent.Role, error) {
+func (q *Querier) GetBotRole(ctx context.Context) (*role.Role, error) {
+	_, _, botRole, err := q.lookupDefaultRoles(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if botRole == nil {
+		return &role.DefaultRoleBot, nil
+	}
+
+	return role.Map(botRole)
+}
+
+func (q *Querier) lookupDefaultRoles(ctx context.Context) (*ent.Role, *ent.Role, *ent.Role, error) {
 	roles, err := q.db.Role.Query().Where(ent_role.IDIn(
 		xid.ID(role.DefaultRoleGuestID),
 		xid.ID(role.DefaultRoleMemberID),
+		xid.ID(role.DefaultRoleBotID),
 	)).All(ctx)
 	if err != nil {
-		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+		return nil, nil, nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
 	var guestRole *ent.Role
 	var memberRole *ent.Role
+	var botRole *ent.Role
 
 	for _, r := range roles {
-		if r.ID == xid.ID(role.DefaultRoleGuestID) {
+		switch r.ID {
+		case xid.ID(role.DefaultRoleGuestID):
 			guestRole = r
-		} else if r.ID == xid.ID(role.DefaultRoleMemberID) {
+		case xid.ID(role.DefaultRoleMemberID):
 			memberRole = r
+		case xid.ID(role.DefaultRoleBotID):
+			botRole = r
 		}
 	}
 
-	return guestRole, memberRole, nil
+	return guestRole, memberRole, botRole, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account/role"
@@ -46,15 +47,34 @@ func WithPermissions(perms rbac.PermissionList) Mutation {
 	}
 }
 
-func (w *Writer) Create(ctx context.Context, name string, colour string, perms rbac.PermissionList) (*role.Role, error) {
+// WithUploadQuota sets the number of bytes members with the role may store,
+// an empty quota removes the limit.
+func WithUploadQuota(quota opt.Optional[int64]) Mutation {
+	return func(m *ent.RoleMutation) {
+		if q, ok := quota.Get(); ok {
+			m.SetUploadQuota(q)
+		} else {
+			m.ClearUploadQuota()
+		}
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, name string, colour string, perms rbac.PermissionList, opts ...Mutation) (*role.Role, error) {
 	ps := dt.Map(perms, func(p rbac.Permission) string { return p.String() })
 
-	r, err := w.db.Role.Create().
-		SetName(name).
-		SetColour(colour).
-		SetPermissions(ps).
-		SetSortKey(0.0).
-		Save(ctx)
+	create := w.db.Role.Create()
+	mutate := create.Mutation()
+
+	mutate.SetName(name)
+	mutate.SetColour(colour)
+	mutate.SetPermissions(ps)
+	mutate.SetSortKey(0.0)
+
+	for _, opt := range opts {
+		opt(mutate)
+	}
+
+	r, err := create.Save(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -69,7 +89,11 @@ func (w *Writer) Create(ctx context.Context, name string, colour string, perms r
 
 func (w *Writer) Update(ctx context.Context, id role.RoleID, opts ...Mutation) (*role.Role, error) {
 	if id == role.DefaultRoleMemberID {
-		return w.updateDefaultRole(ctx, opts...)
+		return w.updateDefaultRole(ctx, role.DefaultRoleMember, opts...)
+	}
+
+	if id == role.DefaultRoleBotID {
+		return w.updateDefaultRole(ctx, role.DefaultRoleBot, opts...)
 	}
 
 	if id == role.DefaultRoleGuestID {
@@ -96,8 +120,8 @@ func (w *Writer) Update(ctx context.Context, id role.RoleID, opts ...Mutation) (
 	return rl, nil
 }
 
-func (w *Writer) updateDefaultRole(ctx context.Context, opts ...Mutation) (*role.Role, error) {
-	rl, found, err := w.lookupRole(ctx, role.DefaultRoleMemberID)
+func (w *Writer) updateDefaultRole(ctx context.Context, def role.Role, opts ...Mutation) (*role.Role, error) {
+	rl, found, err := w.lookupRole(ctx, def.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -106,10 +130,12 @@ func (w *Writer) updateDefaultRole(ctx context.Context, opts ...Mutation) (*role
 		create := w.db.Role.Create()
 		mutate := create.Mutation()
 
-		// The default Member role has a hard-coded ID.
-		mutate.SetID(xid.ID(role.DefaultRoleMemberID))
-		mutate.SetName("Member")
-		mutate.SetSortKey(-1)
+		// The default Member and Bot roles have hard-coded IDs.
+		mutate.SetID(xid.ID(def.ID))
+		mutate.SetName(def.Name)
+		mutate.SetSortKey(def.SortKey)
+		WithColour(def.Colour)(mutate)
+		WithPermissions(def.Permissions.List())(mutate)
 
 		for _, opt := range opts {
 			opt(mutate)
@@ -151,6 +177,8 @@ func (w *Writer) updateGuestRole(ctx context.Context, opts ...Mutation) (*role.R
 		mutate.SetID(xid.ID(role.DefaultRoleGuestID))
 		mutate.SetName("Guest")
 		mutate.SetSortKey(-2)
+		WithColour(role.DefaultRoleGuest.Colour)(mutate)
+		WithPermissions(role.DefaultRoleGuest.Permissions.List())(mutate)
 
 		for _, opt := range opts {
 			opt(mutate)
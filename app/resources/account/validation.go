@@ -9,9 +9,14 @@ import (
 	"github.com/Southclaws/fault/ftag"
 
 	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/internal/i18n"
 )
 
-var errInvalidHandle = fault.New("invalid handle")
+var (
+	errInvalidHandle   = fault.New("invalid handle")
+	errInvalidLocale   = fault.New("invalid locale")
+	errInvalidTimezone = fault.New("invalid timezone")
+)
 
 // ValidateHandle checks if a handle meets the requirements:
 // - Must be a valid slug (lowercase, letters, numbers, hyphens, underscores)
@@ -37,3 +42,34 @@ func ValidateHandle(ctx context.Context, handle string) error {
 
 	return nil
 }
+
+// ValidateLocale checks a locale is a language tag such as "en" or "de-AT" for
+// a supported language, and returns it in its canonical form.
+func ValidateLocale(ctx context.Context, locale string) (string, error) {
+	tag, ok := i18n.Normalise(locale)
+	if !ok {
+		return "", fault.Wrap(
+			errInvalidLocale,
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("unsupported locale", "Language must be one of the supported languages"),
+		)
+	}
+
+	return tag, nil
+}
+
+// ValidateTimezone checks a timezone is an IANA time zone name such as
+// "Europe/Berlin" or "UTC".
+func ValidateTimezone(ctx context.Context, timezone string) (string, error) {
+	if _, ok := i18n.LoadTimezone(timezone); !ok {
+		return "", fault.Wrap(
+			errInvalidTimezone,
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("unknown timezone", "Timezone must be a time zone name such as Europe/Berlin"),
+		)
+	}
+
+	return timezone, nil
+}
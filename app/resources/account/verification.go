@@ -0,0 +1,37 @@
+package account
+
+import "github.com/Southclaws/opt"
+
+//go:generate go run github.com/Southclaws/enumerator
+
+// verificationEnum is a badge shown beside an account's name to confirm who is
+// behind it. It's granted by staff and is unrelated to email verification.
+type verificationEnum string
+
+const (
+	verificationStaff        verificationEnum = "staff"
+	verificationVerified     verificationEnum = "verified"
+	verificationBot          verificationEnum = "bot"
+	verificationOrganization verificationEnum = "organization"
+)
+
+// Requestable reports whether members may ask for the badge themselves, staff
+// and bot badges are only ever given out by administrators.
+func (v Verification) Requestable() bool {
+	return v == VerificationVerified || v == VerificationOrganization
+}
+
+// MapVerification reads a stored badge, anything unrecognised is treated as no
+// badge at all rather than failing to load the account.
+func MapVerification(in *string) opt.Optional[Verification] {
+	if in == nil {
+		return opt.NewEmpty[Verification]()
+	}
+
+	v, err := NewVerification(*in)
+	if err != nil {
+		return opt.NewEmpty[Verification]()
+	}
+
+	return opt.New(v)
+}
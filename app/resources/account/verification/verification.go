@@ -0,0 +1,71 @@
+// Package verification describes the requests members make for a verification
+// badge on their account and the outcome of staff reviewing them.
+package verification
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type statusEnum string
+
+const (
+	statusPending  statusEnum = "pending"
+	statusApproved statusEnum = "approved"
+	statusRejected statusEnum = "rejected"
+)
+
+type RequestID xid.ID
+
+func (i RequestID) String() string { return xid.ID(i).String() }
+
+type Request struct {
+	ID         RequestID
+	CreatedAt  time.Time
+	Account    account.Account
+	Badge      account.Verification
+	Note       opt.Optional[string]
+	Status     Status
+	ReviewedAt opt.Optional[time.Time]
+	Reason     opt.Optional[string]
+}
+
+func Map(in *ent.VerificationRequest) (*Request, error) {
+	accEdge, err := in.Edges.AccountOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := account.MapRef(accEdge)
+	if err != nil {
+		return nil, err
+	}
+
+	badge, err := account.NewVerification(in.Badge)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := NewStatus(in.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Request{
+		ID:         RequestID(in.ID),
+		CreatedAt:  in.CreatedAt,
+		Account:    *acc,
+		Badge:      badge,
+		Note:       opt.NewPtr(in.Note),
+		Status:     status,
+		ReviewedAt: opt.NewPtr(in.ReviewedAt),
+		Reason:     opt.NewPtr(in.Reason),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package verification_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/verification"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_verification "github.com/Southclaws/storyden/internal/ent/verificationrequest"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) Get(ctx context.Context, id verification.RequestID) (*verification.Request, error) {
+	r, err := q.db.VerificationRequest.Query().
+		Where(ent_verification.ID(xid.ID(id))).
+		WithAccount().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	v, err := verification.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return v, nil
+}
+
+// GetLatest returns the account's most recent request, whatever its outcome.
+func (q *Querier) GetLatest(ctx context.Context, accountID account.AccountID) (*verification.Request, error) {
+	r, err := q.db.VerificationRequest.Query().
+		Where(ent_verification.AccountID(xid.ID(accountID))).
+		WithAccount().
+		Order(ent.Desc(ent_verification.FieldCreatedAt), ent.Desc(ent_verification.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	v, err := verification.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return v, nil
+}
+
+// HasPending reports whether the account is already waiting on a review.
+func (q *Querier) HasPending(ctx context.Context, accountID account.AccountID) (bool, error) {
+	exists, err := q.db.VerificationRequest.Query().
+		Where(
+			ent_verification.AccountID(xid.ID(accountID)),
+			ent_verification.Status(verification.StatusPending.String()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return exists, nil
+}
+
+// List returns requests oldest first so the queue is reviewed in the order
+// members asked.
+func (q *Querier) List(ctx context.Context, status opt.Optional[verification.Status]) ([]*verification.Request, error) {
+	query := q.db.VerificationRequest.Query().
+		WithAccount().
+		Order(ent.Asc(ent_verification.FieldCreatedAt), ent.Asc(ent_verification.FieldID))
+
+	if s, ok := status.Get(); ok {
+		query.Where(ent_verification.Status(s.String()))
+	}
+
+	r, err := query.All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	reqs, err := dt.MapErr(r, verification.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return reqs, nil
+}
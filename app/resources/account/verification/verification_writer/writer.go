@@ -0,0 +1,70 @@
+package verification_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/verification"
+	"github.com/Southclaws/storyden/app/resources/account/verification/verification_querier"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_verification "github.com/Southclaws/storyden/internal/ent/verificationrequest"
+)
+
+type Writer struct {
+	db      *ent.Client
+	querier *verification_querier.Querier
+}
+
+func New(db *ent.Client, querier *verification_querier.Querier) *Writer {
+	return &Writer{db: db, querier: querier}
+}
+
+func (w *Writer) Create(ctx context.Context, accountID account.AccountID, badge account.Verification, note opt.Optional[string]) (*verification.Request, error) {
+	r, err := w.db.VerificationRequest.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetBadge(badge.String()).
+		SetNillableNote(note.Ptr()).
+		SetStatus(verification.StatusPending.String()).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, verification.RequestID(r.ID))
+}
+
+// Review sets the outcome of a pending request. Requests which were already
+// reviewed are left as they are and reported as not found.
+func (w *Writer) Review(
+	ctx context.Context,
+	id verification.RequestID,
+	status verification.Status,
+	reviewer account.AccountID,
+	reason opt.Optional[string],
+) (*verification.Request, error) {
+	n, err := w.db.VerificationRequest.Update().
+		Where(
+			ent_verification.ID(xid.ID(id)),
+			ent_verification.Status(verification.StatusPending.String()),
+		).
+		SetStatus(status.String()).
+		SetReviewedByID(xid.ID(reviewer)).
+		SetReviewedAt(time.Now()).
+		SetNillableReason(reason.Ptr()).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if n == 0 {
+		return nil, fault.New("no pending verification request", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return w.querier.Get(ctx, id)
+}
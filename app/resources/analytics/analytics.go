@@ -0,0 +1,115 @@
+// Package analytics describes summaries of community activity which are rolled
+// up periodically from the raw activity, so reports stay cheap to read however
+// large the community grows. Days and weeks are in UTC and weeks start on
+// Monday.
+package analytics
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+// CohortWeeks is how many weeks after signing up each cohort is followed for.
+const CohortWeeks = 12
+
+// StartOfDay is midnight UTC on the day t falls on.
+func StartOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(day)
+}
+
+// StartOfWeek is midnight UTC on the Monday of the week t falls on.
+func StartOfWeek(t time.Time) time.Time {
+	d := StartOfDay(t)
+	offset := (int(d.Weekday()) + 6) % 7
+	return d.AddDate(0, 0, -offset)
+}
+
+// Day summarises a single day. Active accounts are those which made at least
+// one signed in request, weekly and monthly active are counted over the 7 and
+// 30 days up to and including the day.
+type Day struct {
+	Date          time.Time
+	Signups       int
+	DailyActive   int
+	WeeklyActive  int
+	MonthlyActive int
+	Threads       int
+	Replies       int
+	Reactions     int
+	Likes         int
+	UpdatedAt     time.Time
+}
+
+func MapDay(in *ent.AnalyticsDay) *Day {
+	return &Day{
+		Date:          in.Date.UTC(),
+		Signups:       in.Signups,
+		DailyActive:   in.DailyActive,
+		WeeklyActive:  in.WeeklyActive,
+		MonthlyActive: in.MonthlyActive,
+		Threads:       in.Threads,
+		Replies:       in.Replies,
+		Reactions:     in.Reactions,
+		Likes:         in.Likes,
+		UpdatedAt:     in.UpdatedAt,
+	}
+}
+
+// Cohort is how many of the accounts which signed up in the week starting on
+// Cohort were active the given number of weeks later.
+type Cohort struct {
+	Cohort   time.Time
+	Week     int
+	Size     int
+	Retained int
+}
+
+func (c Cohort) Rate() float64 {
+	if c.Size == 0 {
+		return 0
+	}
+	return float64(c.Retained) / float64(c.Size)
+}
+
+func MapCohort(in *ent.AnalyticsCohort) *Cohort {
+	return &Cohort{
+		Cohort:   in.Cohort.UTC(),
+		Week:     in.Week,
+		Size:     in.Size,
+		Retained: in.Retained,
+	}
+}
+
+// Content is the activity on a piece of content, either on one day or summed
+// over several.
+type Content struct {
+	Kind        datagraph.Kind
+	ItemID      xid.ID
+	Views       int
+	Replies     int
+	Reactions   int
+	Likes       int
+	Collections int
+}
+
+// Total is every kind of activity counted equally, content is ranked by it.
+func (c Content) Total() int {
+	return c.Views + c.Replies + c.Reactions + c.Likes + c.Collections
+}
+
+func (c *Content) Add(o Content) {
+	c.Views += o.Views
+	c.Replies += o.Replies
+	c.Reactions += o.Reactions
+	c.Likes += o.Likes
+	c.Collections += o.Collections
+}
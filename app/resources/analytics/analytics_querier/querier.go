@@ -0,0 +1,330 @@
+package analytics_querier
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
+	"github.com/Southclaws/storyden/internal/ent/likepost"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// Days lists the summaries of every day rolled up between from and to.
+func (q *Querier) Days(ctx context.Context, from, to time.Time) ([]*analytics.Day, error) {
+	// Summaries are rolled up periodically so are never up to the second.
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
+	r, err := q.db.AnalyticsDay.Query().
+		Where(
+			analyticsday.DateGTE(analytics.StartOfDay(from)),
+			analyticsday.DateLTE(to),
+		).
+		Order(ent.Asc(analyticsday.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, analytics.MapDay), nil
+}
+
+// LatestDay is the most recent day which has been rolled up, if any.
+func (q *Querier) LatestDay(ctx context.Context) (opt.Optional[time.Time], error) {
+	r, err := q.db.AnalyticsDay.Query().
+		Order(ent.Desc(analyticsday.FieldDate)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return opt.NewEmpty[time.Time](), nil
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.New(r.Date.UTC()), nil
+}
+
+// Cohorts lists the retention of every cohort which signed up since the given
+// time, oldest cohort first.
+func (q *Querier) Cohorts(ctx context.Context, since time.Time) ([]*analytics.Cohort, error) {
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
+	r, err := q.db.AnalyticsCohort.Query().
+		Where(analyticscohort.CohortGTE(analytics.StartOfWeek(since))).
+		Order(
+			ent.Asc(analyticscohort.FieldCohort),
+			ent.Asc(analyticscohort.FieldWeek),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, analytics.MapCohort), nil
+}
+
+type contentGroup struct {
+	ItemKind    string `json:"item_kind"`
+	ItemID      xid.ID `json:"item_id"`
+	Views       int    `json:"views"`
+	Replies     int    `json:"replies"`
+	Reactions   int    `json:"reactions"`
+	Likes       int    `json:"likes"`
+	Collections int    `json:"collections"`
+}
+
+// TopContent sums the activity on each piece of content over the days rolled
+// up between from and to and returns the most active.
+func (q *Querier) TopContent(ctx context.Context, from, to time.Time, limit int) ([]*analytics.Content, error) {
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
+	var groups []contentGroup
+	err := q.db.AnalyticsContent.Query().
+		Where(
+			analyticscontent.DateGTE(analytics.StartOfDay(from)),
+			analyticscontent.DateLTE(to),
+		).
+		GroupBy(analyticscontent.FieldItemKind, analyticscontent.FieldItemID).
+		Aggregate(
+			ent.As(ent.Sum(analyticscontent.FieldViews), "views"),
+			ent.As(ent.Sum(analyticscontent.FieldReplies), "replies"),
+			ent.As(ent.Sum(analyticscontent.FieldReactions), "reactions"),
+			ent.As(ent.Sum(analyticscontent.FieldLikes), "likes"),
+			ent.As(ent.Sum(analyticscontent.FieldCollections), "collections"),
+		).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	content := dt.Reduce(groups, func(acc []*analytics.Content, g contentGroup) []*analytics.Content {
+		kind, err := datagraph.NewKind(g.ItemKind)
+		if err != nil {
+			return acc
+		}
+		return append(acc, &analytics.Content{
+			Kind:        kind,
+			ItemID:      g.ItemID,
+			Views:       g.Views,
+			Replies:     g.Replies,
+			Reactions:   g.Reactions,
+			Likes:       g.Likes,
+			Collections: g.Collections,
+		})
+	}, []*analytics.Content{})
+
+	slices.SortFunc(content, func(a, b *analytics.Content) int {
+		if a.Total() == b.Total() {
+			return b.ItemID.Compare(a.ItemID)
+		}
+		return b.Total() - a.Total()
+	})
+
+	if len(content) > limit {
+		content = content[:limit]
+	}
+
+	return content, nil
+}
+
+// ContentOn lists the activity on each piece of content kept for the day
+// starting at date, most active first.
+func (q *Querier) ContentOn(ctx context.Context, date time.Time) ([]*analytics.Content, error) {
+	r, err := q.db.AnalyticsContent.Query().
+		Where(analyticscontent.Date(analytics.StartOfDay(date))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	content := dt.Reduce(r, func(acc []*analytics.Content, c *ent.AnalyticsContent) []*analytics.Content {
+		kind, err := datagraph.NewKind(c.ItemKind)
+		if err != nil {
+			return acc
+		}
+		return append(acc, &analytics.Content{
+			Kind:        kind,
+			ItemID:      c.ItemID,
+			Views:       c.Views,
+			Replies:     c.Replies,
+			Reactions:   c.Reactions,
+			Likes:       c.Likes,
+			Collections: c.Collections,
+		})
+	}, []*analytics.Content{})
+
+	slices.SortFunc(content, func(a, b *analytics.Content) int {
+		if a.Total() == b.Total() {
+			return b.ItemID.Compare(a.ItemID)
+		}
+		return b.Total() - a.Total()
+	})
+
+	return content, nil
+}
+
+// ExportedThrough is the last day the given sink was sent analytics for, if
+// it has ever been exported to.
+func (q *Querier) ExportedThrough(ctx context.Context, sink string) (opt.Optional[time.Time], error) {
+	r, err := q.db.AnalyticsExport.Query().
+		Where(analyticsexport.Sink(sink)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return opt.NewEmpty[time.Time](), nil
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.New(r.ExportedThrough.UTC()), nil
+}
+
+// Activity counts the activity on the day starting at date from the raw data,
+// for rolling up into a summary.
+func (q *Querier) Activity(ctx context.Context, date time.Time) (*analytics.Day, error) {
+	from := analytics.StartOfDay(date)
+	to := from.AddDate(0, 0, 1)
+
+	d := &analytics.Day{Date: from}
+
+	var err error
+
+	d.Signups, err = q.db.Account.Query().
+		Where(ent_account.CreatedAtGTE(from), ent_account.CreatedAtLT(to)).
+		Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d.DailyActive, err = q.active(ctx, from, to)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d.WeeklyActive, err = q.active(ctx, to.AddDate(0, 0, -7), to)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d.MonthlyActive, err = q.active(ctx, to.AddDate(0, 0, -30), to)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d.Threads, err = q.db.Post.Query().
+		Where(
+			ent_post.RootPostIDIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+			ent_post.DeletedAtIsNil(),
+			ent_post.CreatedAtGTE(from),
+			ent_post.CreatedAtLT(to),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d.Replies, err = q.db.Post.Query().
+		Where(
+			ent_post.RootPostIDNotNil(),
+			ent_post.DeletedAtIsNil(),
+			ent_post.CreatedAtGTE(from),
+			ent_post.CreatedAtLT(to),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d.Reactions, err = q.db.React.Query().
+		Where(react.CreatedAtGTE(from), react.CreatedAtLT(to)).
+		Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d.Likes, err = q.db.LikePost.Query().
+		Where(likepost.CreatedAtGTE(from), likepost.CreatedAtLT(to)).
+		Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d, nil
+}
+
+// Cohort counts how many of the accounts which signed up in the week starting
+// at cohort were active the given number of weeks later.
+func (q *Querier) Cohort(ctx context.Context, cohort time.Time, week int) (*analytics.Cohort, error) {
+	cohort = analytics.StartOfWeek(cohort)
+	signedUp := []predicate.Account{
+		ent_account.CreatedAtGTE(cohort),
+		ent_account.CreatedAtLT(cohort.AddDate(0, 0, 7)),
+	}
+
+	size, err := q.db.Account.Query().Where(signedUp...).Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	from := cohort.AddDate(0, 0, 7*week)
+
+	retained, err := q.active(ctx, from, from.AddDate(0, 0, 7), apiusage.HasAccountWith(signedUp...))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &analytics.Cohort{
+		Cohort:   cohort,
+		Week:     week,
+		Size:     size,
+		Retained: retained,
+	}, nil
+}
+
+// active counts the accounts which made at least one signed in request in the
+// period. API usage is counted for every signed in request, including those
+// from the web interface, so it's the most complete record of activity.
+func (q *Querier) active(ctx context.Context, from, to time.Time, ps ...predicate.APIUsage) (int, error) {
+	n, err := q.db.APIUsage.Query().
+		Where(
+			apiusage.BucketGTE(from),
+			apiusage.BucketLT(to),
+		).
+		Where(ps...).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.Count(sql.Distinct(s.C(apiusage.FieldAccountID)))
+		}).
+		Int(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
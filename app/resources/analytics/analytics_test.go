@@ -0,0 +1,23 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartOfWeek(t *testing.T) {
+	a := assert.New(t)
+
+	monday := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	a.Equal(monday, StartOfWeek(monday))
+	a.Equal(monday, StartOfWeek(monday.Add(13*time.Hour)))
+	a.Equal(monday, StartOfWeek(time.Date(2025, 3, 16, 23, 59, 0, 0, time.UTC)))
+	a.Equal(monday.AddDate(0, 0, 7), StartOfWeek(time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)))
+
+	// Weeks are in UTC whatever the timezone of the time given.
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	a.Equal(monday.AddDate(0, 0, -7), StartOfWeek(time.Date(2025, 3, 10, 0, 30, 0, 0, berlin)))
+}
@@ -0,0 +1,126 @@
+package analytics_writer
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// PutDay stores a day's summary, replacing the one from the last roll up.
+func (w *Writer) PutDay(ctx context.Context, d *analytics.Day) error {
+	err := w.db.AnalyticsDay.Create().
+		SetDate(analytics.StartOfDay(d.Date)).
+		SetSignups(d.Signups).
+		SetDailyActive(d.DailyActive).
+		SetWeeklyActive(d.WeeklyActive).
+		SetMonthlyActive(d.MonthlyActive).
+		SetThreads(d.Threads).
+		SetReplies(d.Replies).
+		SetReactions(d.Reactions).
+		SetLikes(d.Likes).
+		OnConflictColumns(analyticsday.FieldDate).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// PutCohort stores a cohort's retention for one week, replacing the one from
+// the last roll up.
+func (w *Writer) PutCohort(ctx context.Context, c *analytics.Cohort) error {
+	err := w.db.AnalyticsCohort.Create().
+		SetCohort(analytics.StartOfWeek(c.Cohort)).
+		SetWeek(c.Week).
+		SetSize(c.Size).
+		SetRetained(c.Retained).
+		OnConflictColumns(analyticscohort.FieldCohort, analyticscohort.FieldWeek).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// ReplaceContent swaps the content activity stored for a day in a single
+// transaction so readers never observe a partially rolled up day.
+func (w *Writer) ReplaceContent(ctx context.Context, date time.Time, content []*analytics.Content) error {
+	date = analytics.StartOfDay(date)
+
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer tx.Rollback()
+
+	_, err = tx.AnalyticsContent.Delete().
+		Where(analyticscontent.Date(date)).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	creates := dt.Map(content, func(c *analytics.Content) *ent.AnalyticsContentCreate {
+		return tx.AnalyticsContent.Create().
+			SetDate(date).
+			SetItemKind(c.Kind.String()).
+			SetItemID(c.ItemID).
+			SetViews(c.Views).
+			SetReplies(c.Replies).
+			SetReactions(c.Reactions).
+			SetLikes(c.Likes).
+			SetCollections(c.Collections)
+	})
+
+	for chunk := range slices.Chunk(creates, 500) {
+		err = tx.AnalyticsContent.CreateBulk(chunk...).Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// PutExported records the last day the given sink has been sent analytics for.
+func (w *Writer) PutExported(ctx context.Context, sink string, through time.Time) error {
+	err := w.db.AnalyticsExport.Create().
+		SetSink(sink).
+		SetExportedThrough(analytics.StartOfDay(through)).
+		OnConflictColumns(analyticsexport.FieldSink).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
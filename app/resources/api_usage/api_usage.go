@@ -0,0 +1,332 @@
+// Package api_usage stores how many API requests each account and access key
+// makes, and how many of them failed, in hourly buckets.
+package api_usage
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+	entaccount "github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
+	entauthentication "github.com/Southclaws/storyden/internal/ent/authentication"
+)
+
+// Bucket is the period requests are counted over.
+const Bucket = time.Hour
+
+type Counts struct {
+	Requests     int
+	ClientErrors int
+	ServerErrors int
+	RateLimited  int
+}
+
+// Count adds a request which was responded to with the given status code.
+// Rate limited requests aren't client errors so they're counted separately.
+func (c *Counts) Count(status int) {
+	c.Requests++
+
+	switch {
+	case status == http.StatusTooManyRequests:
+		c.RateLimited++
+	case status >= 500:
+		c.ServerErrors++
+	case status >= 400:
+		c.ClientErrors++
+	}
+}
+
+func (c *Counts) Add(o Counts) {
+	c.Requests += o.Requests
+	c.ClientErrors += o.ClientErrors
+	c.ServerErrors += o.ServerErrors
+	c.RateLimited += o.RateLimited
+}
+
+// ErrorRate is the fraction of requests which failed for any reason.
+func (c Counts) ErrorRate() float64 {
+	if c.Requests == 0 {
+		return 0
+	}
+	return float64(c.ClientErrors+c.ServerErrors+c.RateLimited) / float64(c.Requests)
+}
+
+// Subject is who made a request, the access key is empty for requests made
+// with a browser session.
+type Subject struct {
+	AccountID account.AccountID
+	AccessKey opt.Optional[authentication.ID]
+}
+
+type Entry struct {
+	Subject
+	Bucket time.Time
+	Counts Counts
+}
+
+// AccessKey identifies the access key requests were made with.
+type AccessKey struct {
+	ID      authentication.ID
+	Name    opt.Optional[string]
+	Enabled bool
+}
+
+// KeyUsage is the usage of one access key, or of browser sessions when there's
+// no access key.
+type KeyUsage struct {
+	AccessKey opt.Optional[AccessKey]
+	Counts    Counts
+}
+
+type Day struct {
+	Date   time.Time
+	Counts Counts
+}
+
+// AccountUsage is one account's usage since a point in time, in total, for each
+// access key and for each day.
+type AccountUsage struct {
+	Since      time.Time
+	Total      Counts
+	AccessKeys []KeyUsage
+	Days       []Day
+}
+
+// ReportEntry is the usage of one of an account's access keys, or its browser
+// sessions.
+type ReportEntry struct {
+	Account profile.Ref
+	KeyUsage
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Add adds counts to their hourly buckets, creating the bucket if this is the
+// first time the subject has been counted within it.
+func (r *Repository) Add(ctx context.Context, entries []Entry) error {
+	for _, e := range entries {
+		bucket := e.Bucket.Truncate(Bucket)
+
+		q := r.db.APIUsage.Update().
+			Where(
+				apiusage.AccountID(xid.ID(e.AccountID)),
+				apiusage.Bucket(bucket),
+			).
+			AddRequests(e.Counts.Requests).
+			AddClientErrors(e.Counts.ClientErrors).
+			AddServerErrors(e.Counts.ServerErrors).
+			AddRateLimited(e.Counts.RateLimited)
+
+		if id, ok := e.AccessKey.Get(); ok {
+			q.Where(apiusage.AccessKeyID(xid.ID(id)))
+		} else {
+			q.Where(apiusage.AccessKeyIDIsNil())
+		}
+
+		n, err := q.Save(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			continue
+		}
+
+		err = r.db.APIUsage.Create().
+			SetAccountID(xid.ID(e.AccountID)).
+			SetNillableAccessKeyID((*xid.ID)(e.AccessKey.Ptr())).
+			SetBucket(bucket).
+			SetRequests(e.Counts.Requests).
+			SetClientErrors(e.Counts.ClientErrors).
+			SetServerErrors(e.Counts.ServerErrors).
+			SetRateLimited(e.Counts.RateLimited).
+			Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// ForAccount returns an account's usage since the given time, days are in UTC.
+func (r *Repository) ForAccount(ctx context.Context, accountID account.AccountID, since time.Time) (*AccountUsage, error) {
+	rows, err := r.db.APIUsage.Query().
+		Where(
+			apiusage.AccountID(xid.ID(accountID)),
+			apiusage.BucketGTE(since.Truncate(Bucket)),
+		).
+		WithAccessKey().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	usage := &AccountUsage{Since: since}
+	// Browser sessions are grouped under the nil ID.
+	keys := map[xid.ID]*KeyUsage{}
+	days := map[time.Time]*Day{}
+
+	for _, row := range rows {
+		c := counts(row)
+
+		usage.Total.Add(c)
+
+		id := xid.NilID()
+		if row.AccessKeyID != nil {
+			id = *row.AccessKeyID
+		}
+		k, ok := keys[id]
+		if !ok {
+			k = &KeyUsage{AccessKey: accessKey(row.Edges.AccessKey)}
+			keys[id] = k
+		}
+		k.Counts.Add(c)
+
+		date := row.Bucket.UTC().Truncate(24 * time.Hour)
+		d, ok := days[date]
+		if !ok {
+			d = &Day{Date: date}
+			days[date] = d
+		}
+		d.Counts.Add(c)
+	}
+
+	for _, k := range keys {
+		usage.AccessKeys = append(usage.AccessKeys, *k)
+	}
+	slices.SortFunc(usage.AccessKeys, func(a, b KeyUsage) int { return b.Counts.Requests - a.Counts.Requests })
+
+	for _, d := range days {
+		usage.Days = append(usage.Days, *d)
+	}
+	slices.SortFunc(usage.Days, func(a, b Day) int { return a.Date.Compare(b.Date) })
+
+	return usage, nil
+}
+
+type reportGroup struct {
+	AccountID    xid.ID  `json:"account_id"`
+	AccessKeyID  *xid.ID `json:"access_key_id"`
+	Requests     int     `json:"requests"`
+	ClientErrors int     `json:"client_errors"`
+	ServerErrors int     `json:"server_errors"`
+	RateLimited  int     `json:"rate_limited"`
+}
+
+// Report returns the access keys and browser sessions which made the most
+// requests since the given time across every account.
+func (r *Repository) Report(ctx context.Context, since time.Time, limit int) ([]*ReportEntry, error) {
+	var groups []reportGroup
+	err := r.db.APIUsage.Query().
+		Where(apiusage.BucketGTE(since.Truncate(Bucket))).
+		GroupBy(apiusage.FieldAccountID, apiusage.FieldAccessKeyID).
+		Aggregate(
+			ent.As(ent.Sum(apiusage.FieldRequests), "requests"),
+			ent.As(ent.Sum(apiusage.FieldClientErrors), "client_errors"),
+			ent.As(ent.Sum(apiusage.FieldServerErrors), "server_errors"),
+			ent.As(ent.Sum(apiusage.FieldRateLimited), "rate_limited"),
+		).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	slices.SortFunc(groups, func(a, b reportGroup) int { return b.Requests - a.Requests })
+	if len(groups) > limit {
+		groups = groups[:limit]
+	}
+
+	accountIDs := dt.Map(groups, func(g reportGroup) xid.ID { return g.AccountID })
+	keyIDs := dt.Reduce(groups, func(ids []xid.ID, g reportGroup) []xid.ID {
+		if g.AccessKeyID != nil {
+			ids = append(ids, *g.AccessKeyID)
+		}
+		return ids
+	}, []xid.ID{})
+
+	accounts, err := r.db.Account.Query().Where(entaccount.IDIn(accountIDs...)).All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	keys, err := r.db.Authentication.Query().Where(entauthentication.IDIn(keyIDs...)).All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accountsByID := lo.KeyBy(accounts, func(a *ent.Account) xid.ID { return a.ID })
+	keysByID := lo.KeyBy(keys, func(a *ent.Authentication) xid.ID { return a.ID })
+
+	entries := []*ReportEntry{}
+	for _, g := range groups {
+		acc, ok := accountsByID[g.AccountID]
+		if !ok {
+			continue
+		}
+
+		ref, err := profile.MapRef(acc)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		var key opt.Optional[AccessKey]
+		if g.AccessKeyID != nil {
+			key = accessKey(keysByID[*g.AccessKeyID])
+		}
+
+		entries = append(entries, &ReportEntry{
+			Account: *ref,
+			KeyUsage: KeyUsage{
+				AccessKey: key,
+				Counts: Counts{
+					Requests:     g.Requests,
+					ClientErrors: g.ClientErrors,
+					ServerErrors: g.ServerErrors,
+					RateLimited:  g.RateLimited,
+				},
+			},
+		})
+	}
+
+	return entries, nil
+}
+
+func counts(row *ent.APIUsage) Counts {
+	return Counts{
+		Requests:     row.Requests,
+		ClientErrors: row.ClientErrors,
+		ServerErrors: row.ServerErrors,
+		RateLimited:  row.RateLimited,
+	}
+}
+
+func accessKey(a *ent.Authentication) opt.Optional[AccessKey] {
+	if a == nil {
+		return opt.NewEmpty[AccessKey]()
+	}
+
+	return opt.New(AccessKey{
+		ID:      authentication.ID(a.ID),
+		Name:    opt.NewPtr(a.Name),
+		Enabled: !a.Disabled,
+	})
+}
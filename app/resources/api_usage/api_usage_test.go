@@ -0,0 +1,30 @@
+package api_usage
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounts(t *testing.T) {
+	a := assert.New(t)
+
+	c := Counts{}
+	a.Zero(c.ErrorRate())
+
+	c.Count(http.StatusOK)
+	c.Count(http.StatusNoContent)
+	c.Count(http.StatusNotFound)
+	c.Count(http.StatusTooManyRequests)
+	c.Count(http.StatusInternalServerError)
+	c.Count(http.StatusServiceUnavailable)
+	c.Count(http.StatusFound)
+	c.Count(http.StatusBadRequest)
+
+	a.Equal(Counts{Requests: 8, ClientErrors: 2, ServerErrors: 2, RateLimited: 1}, c)
+	a.InDelta(5.0/8.0, c.ErrorRate(), 0.0001)
+
+	c.Add(Counts{Requests: 2, ServerErrors: 1})
+	a.Equal(Counts{Requests: 10, ClientErrors: 2, ServerErrors: 3, RateLimited: 1}, c)
+}
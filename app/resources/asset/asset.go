@@ -21,11 +21,54 @@ type Asset struct {
 	MIME     mime.Type
 	Metadata Metadata
 	Parent   opt.Optional[Asset]
+	OwnerID  xid.ID
+
+	// Processing is only present for assets which are processed after upload.
+	Processing opt.Optional[ProcessingStatus]
+
+	ContentHash opt.Optional[string]
+
+	Private bool
+
+	// Scan is only present for assets which were scanned for malware.
+	Scan          opt.Optional[ScanStatus]
+	ScanSignature opt.Optional[string]
+}
+
+// Path is where the asset's contents are stored. Deduplicated assets share a
+// blob with every other asset of the same content, older assets are stored
+// under their own filename.
+func (a *Asset) Path() string {
+	if a.IsQuarantined() {
+		return BuildQuarantinePath(a.ID)
+	}
+	if h, ok := a.ContentHash.Get(); ok {
+		return BuildBlobPath(h)
+	}
+	return BuildAssetPath(a.Name)
+}
+
+func (a *Asset) IsQuarantined() bool {
+	return a.Scan.OrZero() == ScanStatusQuarantined
 }
 
 func Map(a *ent.Asset) *Asset {
 	parent := opt.NewPtrMap(a.Edges.Parent, func(a ent.Asset) Asset { return *Map(&a) })
 
+	processing := opt.NewEmpty[ProcessingStatus]()
+	if a.ProcessingStatus != nil {
+		if ps, err := NewProcessingStatus(*a.ProcessingStatus); err == nil {
+			processing = opt.New(ps)
+		}
+	}
+
+	scan := opt.NewEmpty[ScanStatus]()
+	if a.ScanStatus != nil {
+		if ss, err := NewScanStatus(*a.ScanStatus); err == nil {
+			scan = opt.New(ss)
+		}
+	}
+
 	return &Asset{
 		ID: AssetID(a.ID),
 		Name: Filename{
@@ -33,10 +76,16 @@ func Map(a *ent.Asset) *Asset {
 			name:  a.Filename,
 			hasID: true,
 		},
-		Size:     a.Size,
-		MIME:     mime.New(a.MimeType),
-		Metadata: a.Metadata,
-		Parent:   parent,
+		Size:          a.Size,
+		MIME:          mime.New(a.MimeType),
+		Metadata:      a.Metadata,
+		Parent:        parent,
+		OwnerID:       a.AccountID,
+		Processing:    processing,
+		ContentHash:   opt.NewPtr(a.ContentHash),
+		Private:       a.Private,
+		Scan:          scan,
+		ScanSignature: opt.NewPtr(a.ScanSignature),
 	}
 }
 
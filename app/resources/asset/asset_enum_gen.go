@@ -125,3 +125,122 @@ func NewFillSource(__iNpUt__ string) (FillSource, error) {
 		return FillSource{}, fmt.Errorf("invalid value for type 'FillSource': '%s'", __iNpUt__)
 	}
 }
+
+type ProcessingStatus struct {
+	v processingStatusEnum
+}
+
+var (
+	ProcessingStatusPending    = ProcessingStatus{processingStatusPending}
+	ProcessingStatusProcessing = ProcessingStatus{processingStatusProcessing}
+	ProcessingStatusReady      = ProcessingStatus{processingStatusReady}
+	ProcessingStatusFailed     = ProcessingStatus{processingStatusFailed}
+)
+
+func (r ProcessingStatus) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r ProcessingStatus) String() string {
+	return string(r.v)
+}
+func (r ProcessingStatus) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *ProcessingStatus) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewProcessingStatus(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r ProcessingStatus) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *ProcessingStatus) Scan(__iNpUt__ any) error {
+	s, err := NewProcessingStatus(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewProcessingStatus(__iNpUt__ string) (ProcessingStatus, error) {
+	switch __iNpUt__ {
+	case string(processingStatusPending):
+		return ProcessingStatusPending, nil
+	case string(processingStatusProcessing):
+		return ProcessingStatusProcessing, nil
+	case string(processingStatusReady):
+		return ProcessingStatusReady, nil
+	case string(processingStatusFailed):
+		return ProcessingStatusFailed, nil
+	default:
+		return ProcessingStatus{}, fmt.Errorf("invalid value for type 'ProcessingStatus': '%s'", __iNpUt__)
+	}
+}
+
+type ScanStatus struct {
+	v scanStatusEnum
+}
+
+var (
+	ScanStatusClean       = ScanStatus{scanStatusClean}
+	ScanStatusFlagged     = ScanStatus{scanStatusFlagged}
+	ScanStatusQuarantined = ScanStatus{scanStatusQuarantined}
+)
+
+func (r ScanStatus) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r ScanStatus) String() string {
+	return string(r.v)
+}
+func (r ScanStatus) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *ScanStatus) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewScanStatus(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r ScanStatus) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *ScanStatus) Scan(__iNpUt__ any) error {
+	s, err := NewScanStatus(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewScanStatus(__iNpUt__ string) (ScanStatus, error) {
+	switch __iNpUt__ {
+	case string(scanStatusClean):
+		return ScanStatusClean, nil
+	case string(scanStatusFlagged):
+		return ScanStatusFlagged, nil
+	case string(scanStatusQuarantined):
+		return ScanStatusQuarantined, nil
+	default:
+		return ScanStatus{}, fmt.Errorf("invalid value for type 'ScanStatus': '%s'", __iNpUt__)
+	}
+}
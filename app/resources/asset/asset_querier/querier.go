@@ -3,13 +3,18 @@ package asset_querier
 import (
 	"context"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
 
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
 	"github.com/Southclaws/storyden/internal/ent"
 	ent_asset "github.com/Southclaws/storyden/internal/ent/asset"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
 )
 
 type Querier struct {
@@ -47,3 +52,62 @@ func (q *Querier) GetByID(ctx context.Context, id asset.AssetID) (*asset.Asset,
 
 	return asset.Map(r), nil
 }
+
+// ListFlagged returns every asset the malware scanner flagged, newest first.
+func (q *Querier) ListFlagged(ctx context.Context) ([]*asset.Asset, error) {
+	rs, err := q.db.Asset.Query().
+		Where(ent_asset.ScanStatusIn(
+			asset.ScanStatusFlagged.String(),
+			asset.ScanStatusQuarantined.String(),
+		)).
+		Order(ent.Desc(ent_asset.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(rs, asset.Map), nil
+}
+
+type Attachments struct {
+	PublishedPosts bool
+	PublishedNodes bool
+}
+
+// Attachments reports whether an asset is used by any published post, in a
+// category the roles can read, or library page, which is what decides who may
+// see a private asset.
+func (q *Querier) Attachments(ctx context.Context, id asset.AssetID, roles role.Roles) (*Attachments, error) {
+	postQuery := q.db.Asset.Query().
+		Where(ent_asset.ID(id)).
+		QueryPosts().
+		Where(
+			ent_post.DeletedAtIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+		)
+	if p, ok := thread_querier.PostInReadableCategory(roles).Get(); ok {
+		postQuery.Where(p)
+	}
+
+	posts, err := postQuery.Exist(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := q.db.Asset.Query().
+		Where(ent_asset.ID(id)).
+		QueryNodes().
+		Where(
+			ent_node.DeletedAtIsNil(),
+			ent_node.VisibilityEQ(ent_node.VisibilityPublished),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Attachments{
+		PublishedPosts: posts,
+		PublishedNodes: nodes,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package asset_usage
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+)
+
+type Usage struct {
+	Bytes  int64 `db:"bytes"`
+	Assets int   `db:"assets"`
+}
+
+type Consumer struct {
+	AccountID account.AccountID
+	Usage
+}
+
+type Querier struct {
+	raw *sqlx.DB
+}
+
+func New(raw *sqlx.DB) *Querier {
+	return &Querier{raw: raw}
+}
+
+const accountQuery = `select
+  coalesce(sum(size), 0) bytes,
+  count(*) assets
+from
+  assets
+where
+  account_id = $1
+`
+
+// ForAccount is the total size of every asset an account has uploaded. Assets
+// which share a stored blob with another upload still count towards each
+// uploader's usage.
+func (q *Querier) ForAccount(ctx context.Context, accountID account.AccountID) (*Usage, error) {
+	var u Usage
+	err := q.raw.GetContext(ctx, &u, accountQuery, xid.ID(accountID).String())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &u, nil
+}
+
+const totalQuery = `select
+  coalesce(sum(size), 0) bytes,
+  count(*) assets
+from
+  assets
+`
+
+// Total is the size of every asset uploaded by every account.
+func (q *Querier) Total(ctx context.Context) (*Usage, error) {
+	var u Usage
+	err := q.raw.GetContext(ctx, &u, totalQuery)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &u, nil
+}
+
+const largestQuery = `select
+  account_id,
+  sum(size) bytes,
+  count(*) assets
+from
+  assets
+group by
+  account_id
+order by
+  bytes desc
+limit $1
+`
+
+type consumerResult struct {
+	AccountID xid.ID `db:"account_id"`
+	Bytes     int64  `db:"bytes"`
+	Assets    int    `db:"assets"`
+}
+
+// Largest lists the accounts using the most storage, largest first.
+func (q *Querier) Largest(ctx context.Context, limit int) ([]*Consumer, error) {
+	var r []consumerResult
+	err := q.raw.SelectContext(ctx, &r, largestQuery, limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, func(c consumerResult) *Consumer {
+		return &Consumer{
+			AccountID: account.AccountID(c.AccountID),
+			Usage: Usage{
+				Bytes:  c.Bytes,
+				Assets: c.Assets,
+			},
+		}
+	}), nil
+}
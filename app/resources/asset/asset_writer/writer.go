@@ -22,20 +22,49 @@ func New(db *ent.Client) *Writer {
 	return &Writer{db}
 }
 
+type Option func(*ent.AssetMutation)
+
+func WithContentHash(v string) Option {
+	return func(m *ent.AssetMutation) {
+		m.SetContentHash(v)
+	}
+}
+
+func WithPrivate() Option {
+	return func(m *ent.AssetMutation) {
+		m.SetPrivate(true)
+	}
+}
+
+func WithScan(status asset.ScanStatus, signature string) Option {
+	return func(m *ent.AssetMutation) {
+		m.SetScanStatus(status.String())
+		if signature != "" {
+			m.SetScanSignature(signature)
+		}
+	}
+}
+
 func (w *Writer) Add(ctx context.Context,
 	accountID xid.ID,
 	filename asset.Filename,
 	size int,
 	mt mime.Type,
+	opts ...Option,
 ) (*asset.Asset, error) {
-	r, err := w.db.Asset.
+	create := w.db.Asset.
 		Create().
 		SetID(filename.GetID()).
 		SetFilename(filename.String()).
 		SetSize(size).
 		SetMimeType(mt.String()).
-		SetAccountID(xid.ID(accountID)).
-		Save(ctx)
+		SetAccountID(xid.ID(accountID))
+
+	for _, fn := range opts {
+		fn(create.Mutation())
+	}
+
+	r, err := create.Save(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
 	}
@@ -49,16 +78,22 @@ func (w *Writer) AddVersion(ctx context.Context,
 	size int,
 	mt mime.Type,
 	parent asset.AssetID,
+	opts ...Option,
 ) (*asset.Asset, error) {
-	r, err := w.db.Asset.
+	create := w.db.Asset.
 		Create().
 		SetID(filename.GetID()).
 		SetParentAssetID(parent).
 		SetFilename(filename.String()).
 		SetSize(size).
 		SetMimeType(mt.String()).
-		SetAccountID(xid.ID(accountID)).
-		Save(ctx)
+		SetAccountID(xid.ID(accountID))
+
+	for _, fn := range opts {
+		fn(create.Mutation())
+	}
+
+	r, err := create.Save(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -71,15 +106,84 @@ func (w *Writer) AddVersion(ctx context.Context,
 	return asset.Map(r), nil
 }
 
-func (w *Writer) Remove(ctx context.Context, accountID xid.ID, id asset.Filename) error {
-	q := w.db.Asset.
-		Delete().Where(
-		ent_asset.Filename(id.String()),
-	)
+func (w *Writer) SetProcessingStatus(ctx context.Context, id asset.AssetID, status asset.ProcessingStatus) error {
+	err := w.db.Asset.UpdateOneID(id).
+		SetProcessingStatus(status.String()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// SetProcessed marks a processed asset as ready and merges in the metadata
+// found while processing it, such as the dimensions of a video.
+func (w *Writer) SetProcessed(ctx context.Context, id asset.AssetID, metadata asset.Metadata) error {
+	a, err := w.db.Asset.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
 
-	if _, err := q.Exec(ctx); err != nil {
-		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	merged := map[string]any{}
+	for k, v := range a.Metadata {
+		merged[k] = v
+	}
+	for k, v := range metadata {
+		merged[k] = v
+	}
+
+	err = w.db.Asset.UpdateOneID(id).
+		SetMetadata(merged).
+		SetProcessingStatus(asset.ProcessingStatusReady.String()).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
 	}
 
 	return nil
 }
+
+// Remove deletes an asset and returns how many assets still share its stored
+// contents. Both happen in one transaction so the count reflects the delete.
+func (w *Writer) Remove(ctx context.Context, accountID xid.ID, id asset.Filename) (int, error) {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	a, err := tx.Asset.Query().Where(ent_asset.Filename(id.String())).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if err := tx.Asset.DeleteOneID(a.ID).Exec(ctx); err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	refs := 0
+	if a.ContentHash != nil {
+		refs, err = tx.Asset.Query().Where(ent_asset.ContentHash(*a.ContentHash)).Count(ctx)
+		if err != nil {
+			return 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return refs, nil
+}
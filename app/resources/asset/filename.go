@@ -12,7 +12,12 @@ import (
 	"github.com/rs/xid"
 )
 
-const AssetsSubdirectory = "assets"
+const (
+	AssetsSubdirectory     = "assets"
+	VariantsSubdirectory   = "asset_variants"
+	BlobsSubdirectory      = "asset_blobs"
+	QuarantineSubdirectory = "asset_quarantine"
+)
 
 var errInvalidFormat = fault.New("invalid format")
 
@@ -79,3 +84,21 @@ func formatFilename(id xid.ID, name string) string {
 func BuildAssetPath(name Filename) string {
 	return path.Join(AssetsSubdirectory, name.String())
 }
+
+// BuildBlobPath is where the contents of every asset with the given content
+// hash are stored.
+func BuildBlobPath(hash string) string {
+	return path.Join(BlobsSubdirectory, hash)
+}
+
+// BuildQuarantinePath is where an asset flagged by the malware scanner is kept
+// for moderators, away from the blobs which are served.
+func BuildQuarantinePath(id AssetID) string {
+	return path.Join(QuarantineSubdirectory, id.String())
+}
+
+// BuildVariantPath is where a file derived from an asset, such as a resized
+// image or transcoded video, is stored.
+func BuildVariantPath(name Filename, variant string) string {
+	return path.Join(VariantsSubdirectory, name.String(), variant)
+}
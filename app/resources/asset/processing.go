@@ -0,0 +1,30 @@
+package asset
+
+type processingStatusEnum string
+
+const (
+	processingStatusPending    processingStatusEnum = "pending"
+	processingStatusProcessing processingStatusEnum = "processing"
+	processingStatusReady      processingStatusEnum = "ready"
+	processingStatusFailed     processingStatusEnum = "failed"
+)
+
+// Names of the files derived from a video once it has been transcoded.
+const (
+	VideoStreamVariant = "stream.mp4"
+	VideoPosterVariant = "poster.jpg"
+)
+
+func (m Metadata) GetDuration() float64 {
+	v, ok := m["duration"]
+	if !ok {
+		return 0.0
+	}
+
+	s, ok := v.(float64)
+	if !ok {
+		return 0.0
+	}
+
+	return s
+}
@@ -0,0 +1,9 @@
+package asset
+
+type scanStatusEnum string
+
+const (
+	scanStatusClean       scanStatusEnum = "clean"
+	scanStatusFlagged     scanStatusEnum = "flagged"
+	scanStatusQuarantined scanStatusEnum = "quarantined"
+)
@@ -0,0 +1,172 @@
+package upload_session
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+)
+
+var (
+	ErrNotFound       = fault.New("upload session not found", ftag.With(ftag.NotFound))
+	ErrOffsetMismatch = fault.New("upload offset does not match the bytes received", ftag.With(ftag.InvalidArgument))
+)
+
+// Expiry is how long a session may go without receiving a part before it is
+// considered abandoned and its parts are removed.
+const Expiry = 24 * time.Hour
+
+const PartsSubdirectory = "asset_uploads"
+
+type SessionID xid.ID
+
+func (i SessionID) String() string { return xid.ID(i).String() }
+
+type Session struct {
+	ID        SessionID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+	AccountID account.AccountID
+	Filename  string
+	Size      int64
+	Received  int64
+	ParentID  opt.Optional[asset.AssetID]
+	Private   bool
+}
+
+func (s *Session) Complete() bool {
+	return s.Received == s.Size
+}
+
+// PartPath is where the part beginning at the given offset is stored. Parts
+// are contiguous so the next one always begins at the end of the previous.
+func (s *Session) PartPath(offset int64) string {
+	return path.Join(PartsSubdirectory, s.ID.String(), fmt.Sprintf("%020d", offset))
+}
+
+func Map(in *ent.UploadSession) *Session {
+	return &Session{
+		ID:        SessionID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		ExpiresAt: in.ExpiresAt,
+		AccountID: account.AccountID(in.AccountID),
+		Filename:  in.Filename,
+		Size:      in.Size,
+		Received:  in.Received,
+		ParentID:  opt.NewPtr(in.ParentAssetID),
+		Private:   in.Private,
+	}
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+type Option func(*ent.UploadSessionMutation)
+
+func WithParent(id asset.AssetID) Option {
+	return func(m *ent.UploadSessionMutation) {
+		m.SetParentAssetID(xid.ID(id))
+	}
+}
+
+func WithPrivate() Option {
+	return func(m *ent.UploadSessionMutation) {
+		m.SetPrivate(true)
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, accountID account.AccountID, filename string, size int64, opts ...Option) (*Session, error) {
+	create := r.db.UploadSession.Create()
+	mutate := create.Mutation()
+
+	mutate.SetAccountID(xid.ID(accountID))
+	mutate.SetFilename(filename)
+	mutate.SetSize(size)
+	mutate.SetExpiresAt(time.Now().Add(Expiry))
+
+	for _, fn := range opts {
+		fn(mutate)
+	}
+
+	s, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(s), nil
+}
+
+func (r *Repository) Get(ctx context.Context, id SessionID) (*Session, error) {
+	s, err := r.db.UploadSession.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(s), nil
+}
+
+// Advance records a part of n bytes written at offset and pushes the expiry
+// back. It fails if another part was recorded at the same offset first.
+func (r *Repository) Advance(ctx context.Context, id SessionID, offset, n int64) (*Session, error) {
+	updated, err := r.db.UploadSession.Update().
+		Where(
+			uploadsession.ID(xid.ID(id)),
+			uploadsession.Received(offset),
+		).
+		SetReceived(offset + n).
+		SetExpiresAt(time.Now().Add(Expiry)).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if updated == 0 {
+		return nil, fault.Wrap(ErrOffsetMismatch, fctx.With(ctx))
+	}
+
+	return r.Get(ctx, id)
+}
+
+func (r *Repository) ListExpired(ctx context.Context, now time.Time) ([]*Session, error) {
+	sessions, err := r.db.UploadSession.Query().
+		Where(uploadsession.ExpiresAtLT(now)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(sessions, Map), nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id SessionID) error {
+	err := r.db.UploadSession.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(ErrNotFound, fctx.With(ctx))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Package automod describes administrator configured rules which are checked
+// against new posts along with the action taken when one of them matches.
+package automod
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type kindEnum string
+
+const (
+	// Matches when the regular expression in Pattern matches the plaintext.
+	kindPattern kindEnum = "pattern"
+	// Matches when any of Words appears in the plaintext as a whole word.
+	kindWords kindEnum = "words"
+	// Matches when the content contains more than Threshold links.
+	kindLinkCount kindEnum = "link_count"
+	// Matches when the author's account is younger than Window.
+	kindAccountAge kindEnum = "account_age"
+	// Matches when the author has made more than Threshold posts in Window.
+	kindVelocity kindEnum = "velocity"
+)
+
+type actionEnum string
+
+const (
+	actionFlag   actionEnum = "flag"   // Published, moderators are notified.
+	actionHide   actionEnum = "hide"   // Only visible to the author and moderators.
+	actionHold   actionEnum = "hold"   // Placed in the review queue.
+	actionReject actionEnum = "reject" // Not created, the author is told why.
+)
+
+// Severity orders actions so the strictest one wins when several rules match.
+func (a Action) Severity() int {
+	switch a {
+	case ActionFlag:
+		return 1
+	case ActionHide:
+		return 2
+	case ActionHold:
+		return 3
+	case ActionReject:
+		return 4
+	default:
+		return 0
+	}
+}
+
+type RuleID xid.ID
+
+func (i RuleID) String() string { return xid.ID(i).String() }
+
+type Rule struct {
+	ID        RuleID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	Kind      Kind
+	Action    Action
+	Pattern   opt.Optional[string]
+	Words     []string
+	Threshold opt.Optional[int]
+	Window    opt.Optional[time.Duration]
+	Reason    opt.Optional[string]
+	Enabled   bool
+}
+
+type Rules []*Rule
+
+func Map(in *ent.AutomodRule) (*Rule, error) {
+	kind, err := NewKind(in.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	action, err := NewAction(in.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	window := opt.Map(opt.NewPtr(in.WindowSeconds), func(s int) time.Duration {
+		return time.Duration(s) * time.Second
+	})
+
+	return &Rule{
+		ID:        RuleID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Name:      in.Name,
+		Kind:      kind,
+		Action:    action,
+		Pattern:   opt.NewPtr(in.Pattern),
+		Words:     in.Words,
+		Threshold: opt.NewPtr(in.Threshold),
+		Window:    window,
+		Reason:    opt.NewPtr(in.Reason),
+		Enabled:   in.Enabled,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package automod_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/automod"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_automod_rule "github.com/Southclaws/storyden/internal/ent/automodrule"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) List(ctx context.Context) (automod.Rules, error) {
+	r, err := q.db.AutomodRule.Query().
+		Order(ent.Asc(ent_automod_rule.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rules, err := dt.MapErr(r, automod.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rules, nil
+}
+
+func (q *Querier) ListEnabled(ctx context.Context) (automod.Rules, error) {
+	r, err := q.db.AutomodRule.Query().
+		Where(ent_automod_rule.Enabled(true)).
+		Order(ent.Asc(ent_automod_rule.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rules, err := dt.MapErr(r, automod.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rules, nil
+}
+
+func (q *Querier) Get(ctx context.Context, id automod.RuleID) (*automod.Rule, error) {
+	r, err := q.db.AutomodRule.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rule, err := automod.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rule, nil
+}
+
+// CountPostsSince counts the threads and replies an account has written since
+// the given time, including ones which have since been deleted.
+func (q *Querier) CountPostsSince(ctx context.Context, accountID account.AccountID, since time.Time) (int, error) {
+	n, err := q.db.Post.Query().
+		Where(
+			ent_post.AccountPosts(xid.ID(accountID)),
+			ent_post.CreatedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
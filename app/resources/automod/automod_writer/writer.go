@@ -0,0 +1,147 @@
+package automod_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/automod"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.AutomodRuleMutation)
+
+func WithName(v string) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		m.SetName(v)
+	}
+}
+
+func WithKind(v automod.Kind) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		m.SetKind(v.String())
+	}
+}
+
+func WithAction(v automod.Action) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		m.SetAction(v.String())
+	}
+}
+
+func WithPattern(v string) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		if v == "" {
+			m.ClearPattern()
+			return
+		}
+		m.SetPattern(v)
+	}
+}
+
+func WithWords(v []string) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		m.SetWords(v)
+	}
+}
+
+func WithThreshold(v int) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		m.SetThreshold(v)
+	}
+}
+
+func WithWindow(v time.Duration) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		m.SetWindowSeconds(int(v.Seconds()))
+	}
+}
+
+func WithReason(v string) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		if v == "" {
+			m.ClearReason()
+			return
+		}
+		m.SetReason(v)
+	}
+}
+
+func WithEnabled(v bool) Option {
+	return func(m *ent.AutomodRuleMutation) {
+		m.SetEnabled(v)
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, name string, kind automod.Kind, action automod.Action, opts ...Option) (*automod.Rule, error) {
+	create := w.db.AutomodRule.Create()
+	mutation := create.Mutation()
+
+	mutation.SetName(name)
+	WithKind(kind)(mutation)
+	WithAction(action)(mutation)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rule, err := automod.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rule, nil
+}
+
+func (w *Writer) Update(ctx context.Context, id automod.RuleID, opts ...Option) (*automod.Rule, error) {
+	update := w.db.AutomodRule.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rule, err := automod.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rule, nil
+}
+
+func (w *Writer) Delete(ctx context.Context, id automod.RuleID) error {
+	err := w.db.AutomodRule.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Package badge describes achievements which are awarded to members, either
+// automatically when their activity meets a badge's rule or manually by staff.
+package badge
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type triggerEnum string
+
+const (
+	triggerThreadPublished  triggerEnum = "thread_published"
+	triggerReplyCreated     triggerEnum = "reply_created"
+	triggerLikeReceived     triggerEnum = "like_received"
+	triggerReactionReceived triggerEnum = "reaction_received"
+	triggerAnswerAccepted   triggerEnum = "answer_accepted"
+	triggerReputation       triggerEnum = "reputation"
+)
+
+type BadgeID xid.ID
+
+func (i BadgeID) String() string { return xid.ID(i).String() }
+
+// Rule describes when a badge is awarded: once the number of occurrences of the
+// trigger for a member reaches the threshold. For the reputation trigger, the
+// threshold is compared against the member's total reputation.
+type Rule struct {
+	Trigger   Trigger
+	Threshold int
+}
+
+func (r Rule) Met(progress int) bool {
+	return progress >= max(1, r.Threshold)
+}
+
+type Badge struct {
+	ID          BadgeID
+	Name        string
+	Description string
+	Icon        opt.Optional[string]
+	Rule        opt.Optional[Rule]
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Badges []*Badge
+
+// Award is a badge held by a member and when they earned it.
+type Award struct {
+	Badge
+	AwardedAt time.Time
+}
+
+type Awards []*Award
+
+// Builtin badges are created when an instance has no badges at all,
+// administrators may edit or remove them like any other badge.
+var Builtin = []Badge{
+	{
+		Name:        "First Post",
+		Description: "Started a first thread.",
+		Rule:        opt.New(Rule{Trigger: TriggerThreadPublished, Threshold: 1}),
+	},
+	{
+		Name:        "Conversationalist",
+		Description: "Replied to 25 threads.",
+		Rule:        opt.New(Rule{Trigger: TriggerReplyCreated, Threshold: 25}),
+	},
+	{
+		Name:        "Well Liked",
+		Description: "Received 50 likes.",
+		Rule:        opt.New(Rule{Trigger: TriggerLikeReceived, Threshold: 50}),
+	},
+	{
+		Name:        "Helpful",
+		Description: "Had a reply accepted as the answer to a thread.",
+		Rule:        opt.New(Rule{Trigger: TriggerAnswerAccepted, Threshold: 1}),
+	},
+	{
+		Name:        "Respected",
+		Description: "Earned 500 reputation.",
+		Rule:        opt.New(Rule{Trigger: TriggerReputation, Threshold: 500}),
+	},
+}
+
+func Map(in *ent.Badge) (*Badge, error) {
+	rule, err := opt.MapErr(opt.NewPtr(in.Trigger), func(t string) (Rule, error) {
+		trigger, err := NewTrigger(t)
+		if err != nil {
+			return Rule{}, err
+		}
+		return Rule{Trigger: trigger, Threshold: in.Threshold}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Badge{
+		ID:          BadgeID(in.ID),
+		Name:        in.Name,
+		Description: in.Description,
+		Icon:        opt.NewPtr(in.Icon),
+		Rule:        rule,
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+	}, nil
+}
+
+func MapAward(in *ent.AccountBadge) (*Award, error) {
+	b, err := in.Edges.BadgeOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	mapped, err := Map(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Award{
+		Badge:     *mapped,
+		AwardedAt: in.CreatedAt,
+	}, nil
+}
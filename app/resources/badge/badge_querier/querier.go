@@ -0,0 +1,133 @@
+package badge_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/badge"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	ent_badge "github.com/Southclaws/storyden/internal/ent/badge"
+)
+
+type Querier struct {
+	db  *ent.Client
+	raw *sqlx.DB
+}
+
+func New(db *ent.Client, raw *sqlx.DB) *Querier {
+	return &Querier{db: db, raw: raw}
+}
+
+func (q *Querier) List(ctx context.Context) (badge.Badges, error) {
+	r, err := q.db.Badge.Query().
+		Order(ent.Asc(ent_badge.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	badges, err := dt.MapErr(r, badge.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return badges, nil
+}
+
+func (q *Querier) Get(ctx context.Context, id badge.BadgeID) (*badge.Badge, error) {
+	r, err := q.db.Badge.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	b, err := badge.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+// ListByTrigger returns the automatically awarded badges for the trigger.
+func (q *Querier) ListByTrigger(ctx context.Context, trigger badge.Trigger) (badge.Badges, error) {
+	r, err := q.db.Badge.Query().
+		Where(ent_badge.Trigger(trigger.String())).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	badges, err := dt.MapErr(r, badge.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return badges, nil
+}
+
+func (q *Querier) ListAwarded(ctx context.Context, accountID account.AccountID) (badge.Awards, error) {
+	r, err := q.db.AccountBadge.Query().
+		Where(accountbadge.AccountID(xid.ID(accountID))).
+		WithBadge().
+		Order(ent.Desc(accountbadge.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	awards, err := dt.MapErr(r, badge.MapAward)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return awards, nil
+}
+
+var progressQueries = map[badge.Trigger]string{
+	badge.TriggerThreadPublished: `select count(*) from posts
+where account_posts = $1 and root_post_id is null and deleted_at is null and visibility = 'published'`,
+
+	badge.TriggerReplyCreated: `select count(*) from posts
+where account_posts = $1 and root_post_id is not null and deleted_at is null and visibility = 'published'`,
+
+	badge.TriggerLikeReceived: `select count(*) from like_posts l
+inner join posts p on l.post_id = p.id
+where p.account_posts = $1 and p.deleted_at is null and l.account_id != p.account_posts`,
+
+	badge.TriggerReactionReceived: `select count(*) from reacts r
+inner join posts p on r.post_id = p.id
+where p.account_posts = $1 and p.deleted_at is null and r.account_id != p.account_posts`,
+
+	badge.TriggerAnswerAccepted: `select count(*) from posts t
+inner join posts r on t.accepted_reply_id = r.id
+where r.account_posts = $1 and t.deleted_at is null and r.deleted_at is null and t.account_posts != r.account_posts`,
+
+	badge.TriggerReputation: `select coalesce(sum(points), 0) from reputation_entries where account_id = $1`,
+}
+
+// Progress returns the account's current count towards badges of a trigger.
+func (q *Querier) Progress(ctx context.Context, accountID account.AccountID, trigger badge.Trigger) (int, error) {
+	query, ok := progressQueries[trigger]
+	if !ok {
+		return 0, fault.Newf("no progress query for trigger: %s", trigger)
+	}
+
+	var n int
+	err := q.raw.GetContext(ctx, &n, query, xid.ID(accountID).String())
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
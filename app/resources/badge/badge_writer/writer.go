@@ -0,0 +1,155 @@
+package badge_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/badge"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.BadgeMutation)
+
+func WithName(v string) Option {
+	return func(m *ent.BadgeMutation) {
+		m.SetName(v)
+	}
+}
+
+func WithDescription(v string) Option {
+	return func(m *ent.BadgeMutation) {
+		m.SetDescription(v)
+	}
+}
+
+func WithIcon(v opt.Optional[string]) Option {
+	return func(m *ent.BadgeMutation) {
+		if icon, ok := v.Get(); ok {
+			m.SetIcon(icon)
+		} else {
+			m.ClearIcon()
+		}
+	}
+}
+
+func WithRule(v opt.Optional[badge.Rule]) Option {
+	return func(m *ent.BadgeMutation) {
+		if rule, ok := v.Get(); ok {
+			m.SetTrigger(rule.Trigger.String())
+			m.SetThreshold(rule.Threshold)
+		} else {
+			m.ClearTrigger()
+			m.SetThreshold(0)
+		}
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, name string, description string, opts ...Option) (*badge.Badge, error) {
+	create := w.db.Badge.Create()
+	mutation := create.Mutation()
+
+	mutation.SetName(name)
+	mutation.SetDescription(description)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return badge.Map(r)
+}
+
+func (w *Writer) Update(ctx context.Context, id badge.BadgeID, opts ...Option) (*badge.Badge, error) {
+	update := w.db.Badge.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return badge.Map(r)
+}
+
+func (w *Writer) Delete(ctx context.Context, id badge.BadgeID) error {
+	err := w.db.Badge.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// EnsureBuiltin creates any of the given badges which do not already exist.
+func (w *Writer) EnsureBuiltin(ctx context.Context, badges []badge.Badge) error {
+	for _, b := range badges {
+		_, err := w.Create(ctx, b.Name, b.Description, WithIcon(b.Icon), WithRule(b.Rule))
+		if err != nil && ftag.Get(err) != ftag.AlreadyExists {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// Award gives the badge to the account, returning false if it was already held.
+func (w *Writer) Award(ctx context.Context, accountID account.AccountID, id badge.BadgeID) (bool, error) {
+	err := w.db.AccountBadge.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetBadgeID(xid.ID(id)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return true, nil
+}
+
+func (w *Writer) Revoke(ctx context.Context, accountID account.AccountID, id badge.BadgeID) error {
+	_, err := w.db.AccountBadge.Delete().
+		Where(
+			accountbadge.AccountID(xid.ID(accountID)),
+			accountbadge.BadgeID(xid.ID(id)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
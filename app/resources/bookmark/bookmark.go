@@ -0,0 +1,51 @@
+// Package bookmark describes a member's private read-later list. Bookmarks may
+// point at any kind of item and are never visible to anyone else.
+package bookmark
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+type Bookmark struct {
+	ID        ID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	AccountID account.AccountID
+	Target    datagraph.Ref
+	Note      opt.Optional[string]
+
+	// Item is the hydrated target, nil if it's no longer available.
+	Item datagraph.Item
+}
+
+type Bookmarks []*Bookmark
+
+func Map(in *ent.Bookmark) (*Bookmark, error) {
+	kind, err := datagraph.NewKind(in.TargetKind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bookmark{
+		ID:        ID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		AccountID: account.AccountID(in.AccountID),
+		Target: datagraph.Ref{
+			ID:   in.TargetID,
+			Kind: kind,
+		},
+		Note: opt.NewPtr(in.Note),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package bookmark_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/bookmark"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_bookmark "github.com/Southclaws/storyden/internal/ent/bookmark"
+)
+
+type Querier struct {
+	db       *ent.Client
+	hydrator *hydrate.Hydrator
+}
+
+func New(db *ent.Client, hydrator *hydrate.Hydrator) *Querier {
+	return &Querier{db: db, hydrator: hydrator}
+}
+
+type Query func(*ent.BookmarkQuery)
+
+func WithKind(kinds ...datagraph.Kind) Query {
+	return func(q *ent.BookmarkQuery) {
+		if len(kinds) == 0 {
+			return
+		}
+		q.Where(ent_bookmark.TargetKindIn(dt.Map(kinds, func(k datagraph.Kind) string { return k.String() })...))
+	}
+}
+
+// List pages through the member's bookmarks, most recently bookmarked first.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID, page pagination.Parameters, opts ...Query) (pagination.Result[*bookmark.Bookmark], error) {
+	query := q.db.Bookmark.Query().
+		Where(ent_bookmark.AccountID(xid.ID(accountID)))
+
+	for _, fn := range opts {
+		fn(query)
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return pagination.Result[*bookmark.Bookmark]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		Order(ent.Desc(ent_bookmark.FieldCreatedAt), ent.Desc(ent_bookmark.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return pagination.Result[*bookmark.Bookmark]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	bookmarks, err := dt.MapErr(result, bookmark.Map)
+	if err != nil {
+		return pagination.Result[*bookmark.Bookmark]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := q.hydrate(ctx, bookmarks); err != nil {
+		return pagination.Result[*bookmark.Bookmark]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pagination.NewPageResult(page, total, bookmarks), nil
+}
+
+func (q *Querier) Get(ctx context.Context, accountID account.AccountID, targetID xid.ID) (*bookmark.Bookmark, error) {
+	r, err := q.db.Bookmark.Query().
+		Where(
+			ent_bookmark.AccountID(xid.ID(accountID)),
+			ent_bookmark.TargetID(targetID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	b, err := bookmark.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := q.hydrate(ctx, bookmark.Bookmarks{b}); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+// hydrate fills in each bookmark's item, items which have since been deleted
+// are left empty so the member can still see and remove the bookmark.
+func (q *Querier) hydrate(ctx context.Context, bookmarks bookmark.Bookmarks) error {
+	refs := dt.Map(bookmarks, func(b *bookmark.Bookmark) *datagraph.Ref { return &b.Target })
+
+	items, err := q.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	byID := lo.KeyBy(items, func(i datagraph.Item) xid.ID { return i.GetID() })
+
+	for _, b := range bookmarks {
+		if item, ok := byID[b.Target.ID]; ok {
+			b.Item = item
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package bookmark_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/bookmark"
+	"github.com/Southclaws/storyden/app/resources/bookmark/bookmark_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_bookmark "github.com/Southclaws/storyden/internal/ent/bookmark"
+)
+
+type Writer struct {
+	db      *ent.Client
+	querier *bookmark_querier.Querier
+}
+
+func New(db *ent.Client, querier *bookmark_querier.Querier) *Writer {
+	return &Writer{db: db, querier: querier}
+}
+
+// Add bookmarks the target for the member. Bookmarking an item again keeps the
+// original bookmark and only replaces its note if one is given.
+func (w *Writer) Add(ctx context.Context, accountID account.AccountID, target datagraph.Ref, note opt.Optional[string]) (*bookmark.Bookmark, error) {
+	create := w.db.Bookmark.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetTargetID(target.ID).
+		SetTargetKind(target.Kind.String()).
+		SetNillableNote(note.Ptr())
+
+	err := create.
+		OnConflictColumns(ent_bookmark.FieldAccountID, ent_bookmark.FieldTargetID).
+		Update(func(u *ent.BookmarkUpsert) {
+			u.UpdateUpdatedAt()
+			if note.Ok() {
+				u.UpdateNote()
+			}
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, accountID, target.ID)
+}
+
+// Remove deletes the member's bookmark of the target, if there is one.
+func (w *Writer) Remove(ctx context.Context, accountID account.AccountID, targetID xid.ID) error {
+	_, err := w.db.Bookmark.Delete().
+		Where(
+			ent_bookmark.AccountID(xid.ID(accountID)),
+			ent_bookmark.TargetID(targetID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package cachecontrol
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// NewETag creates a strong entity tag from the full content of a response.
+func NewETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+}
+
+// NewResourceETag creates a strong entity tag for immutable content identified
+// by the given parts, such as an asset's filename and the variant requested.
+func NewResourceETag(parts ...string) string {
+	return NewETag([]byte(strings.Join(parts, "\x00")))
+}
+
+// ETagMatches compares an If-None-Match header value against an entity tag. As
+// If-None-Match uses the weak comparison, W/ prefixes are ignored on both sides
+// and the header may list multiple tags or be "*" to match any tag.
+func ETagMatches(ifNoneMatch string, etag string) bool {
+	if etag == "" {
+		return false
+	}
+
+	want := strings.TrimPrefix(etag, "W/")
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == want {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,38 @@
+package cachecontrol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETagMatches(t *testing.T) {
+	a := assert.New(t)
+
+	tag := NewETag([]byte("content"))
+
+	a.Equal(tag, NewETag([]byte("content")))
+	a.NotEqual(tag, NewETag([]byte("changed")))
+
+	a.True(ETagMatches(tag, tag))
+	a.True(ETagMatches("W/"+tag, tag))
+	a.True(ETagMatches(`"a", `+tag+`, "b"`, tag))
+	a.True(ETagMatches("*", tag))
+	a.False(ETagMatches(`"a", "b"`, tag))
+	a.False(ETagMatches("", tag))
+	a.False(ETagMatches("*", ""))
+}
+
+func TestNotModifiedPrefersETag(t *testing.T) {
+	a := assert.New(t)
+
+	updated := time.Now().Add(-time.Hour)
+	resource := func() *time.Time { return &updated }
+
+	since := opt.New(time.Now().Truncate(time.Second))
+
+	a.True(NewQuery(opt.NewEmpty[string](), since).NotModified(resource))
+	a.False(NewQuery(opt.New(`"stale"`), since).NotModified(resource), "If-Modified-Since is ignored when If-None-Match is present")
+}
@@ -23,7 +23,13 @@ func NewQuery(ifNoneMatch opt.Optional[string], ifModifiedSince opt.Optional[tim
 // NotModified takes the current updated date of a resource and returns true if
 // the cache control query includes a Is-Modified-Since header and the resource
 // updated date is not after the header value. True means a 304 response header.
+// If-Modified-Since is ignored when the request also has an If-None-Match as
+// the ETag is the more precise of the two and takes precedence (RFC 9110).
 func (q Query) NotModified(fn func() *time.Time) bool {
+	if q.ETag.Ok() {
+		return false
+	}
+
 	if ms, ok := q.ModifiedSince.Get(); ok {
 		resourceUpdated := fn()
 		if resourceUpdated == nil {
@@ -45,3 +51,13 @@ func (q Query) NotModified(fn func() *time.Time) bool {
 
 	return false
 }
+
+// MatchesETag is true if the If-None-Match header matches the given entity tag.
+func (q Query) MatchesETag(etag string) bool {
+	inm, ok := q.ETag.Get()
+	if !ok {
+		return false
+	}
+
+	return ETagMatches(inm, etag)
+}
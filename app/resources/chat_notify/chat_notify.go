@@ -0,0 +1,75 @@
+// Package chat_notify describes the Slack and Discord channels which receive a
+// formatted message when selected activity happens on the instance. Channels
+// are configured by administrators as part of the instance settings.
+package chat_notify
+
+import (
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post/category"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type providerEnum string
+
+const (
+	providerSlack   providerEnum = "slack"
+	providerDiscord providerEnum = "discord"
+)
+
+type triggerEnum string
+
+const (
+	triggerThreadCreated triggerEnum = "thread_created"
+	triggerMemberJoined  triggerEnum = "member_joined"
+	triggerReportFiled   triggerEnum = "report_filed"
+)
+
+type Channel struct {
+	Name       string
+	Provider   Provider
+	WebhookURL string
+	Triggers   []Trigger
+
+	// Categories restricts thread notifications to threads posted in any of
+	// these categories, when empty threads in every category are included.
+	Categories []xid.ID
+}
+
+// Wants reports whether the channel should be notified of the trigger. The
+// category is only considered for thread notifications.
+func (c Channel) Wants(t Trigger, cat opt.Optional[category.CategoryID]) bool {
+	subscribed := false
+	for _, ct := range c.Triggers {
+		if ct == t {
+			subscribed = true
+			break
+		}
+	}
+	if !subscribed {
+		return false
+	}
+
+	if t != TriggerThreadCreated || len(c.Categories) == 0 {
+		return true
+	}
+
+	id, ok := cat.Get()
+	if !ok {
+		return false
+	}
+
+	for _, allowed := range c.Categories {
+		if allowed == xid.ID(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Settings struct {
+	Channels []Channel
+}
@@ -30,7 +30,8 @@ type Collection struct {
 
 type CollectionWithItems struct {
 	Collection
-	Items CollectionItems
+	Sections Sections
+	Items    CollectionItems
 }
 
 func Map(queriedItems []xid.ID) func(c *ent.Collection) (*Collection, error) {
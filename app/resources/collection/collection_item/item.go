@@ -8,6 +8,8 @@ import (
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
+	"github.com/Southclaws/lexorank"
+
 	"github.com/Southclaws/storyden/app/resources/collection"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -90,6 +92,21 @@ func (d *Repository) UpdateItems(ctx context.Context, qk collection.QueryKey, op
 		fn(&options)
 	}
 
+	sortKey, err := d.nextSortKey(ctx, cid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// All changes are applied together so adding many items at once either
+	// adds every item or, if any one of them fails, leaves the collection as is.
+	tx, err := d.db.Tx(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
 	for _, op := range options {
 		var err error
 
@@ -101,24 +118,26 @@ func (d *Repository) UpdateItems(ctx context.Context, qk collection.QueryKey, op
 			)
 
 			if op.remove {
-				_, err = d.db.CollectionPost.Delete().Where(predicate).Exec(ctx)
+				_, err = tx.CollectionPost.Delete().Where(predicate).Exec(ctx)
 			} else {
-				exists, exerr := d.db.CollectionPost.Query().Where(predicate).Exist(ctx)
+				exists, exerr := tx.CollectionPost.Query().Where(predicate).Exist(ctx)
 				if exerr != nil {
 					return nil, exerr
 				}
 
 				if exists {
-					err = d.db.CollectionPost.Update().
+					err = tx.CollectionPost.Update().
 						Where(predicate).
 						SetMembershipType(op.mt.String()).
 						Exec(ctx)
 				} else {
-					err = d.db.CollectionPost.Create().
+					err = tx.CollectionPost.Create().
 						SetCollectionID(cid).
 						SetPostID(op.id).
 						SetMembershipType(op.mt.String()).
+						SetNillableSort(sortKey.Ptr()).
 						Exec(ctx)
+					sortKey = nextKeyBefore(sortKey)
 				}
 			}
 
@@ -129,27 +148,29 @@ func (d *Repository) UpdateItems(ctx context.Context, qk collection.QueryKey, op
 			)
 
 			if op.remove {
-				_, err = d.db.CollectionNode.Delete().Where(
+				_, err = tx.CollectionNode.Delete().Where(
 					collectionnode.CollectionID(cid),
 					collectionnode.NodeID(op.id),
 				).Exec(ctx)
 			} else {
-				exists, exerr := d.db.CollectionNode.Query().Where(predicate).Exist(ctx)
+				exists, exerr := tx.CollectionNode.Query().Where(predicate).Exist(ctx)
 				if exerr != nil {
 					return nil, exerr
 				}
 
 				if exists {
-					err = d.db.CollectionNode.Update().
+					err = tx.CollectionNode.Update().
 						Where(predicate).
 						SetMembershipType(op.mt.String()).
 						Exec(ctx)
 				} else {
-					err = d.db.CollectionNode.Create().
+					err = tx.CollectionNode.Create().
 						SetCollectionID(cid).
 						SetNodeID(op.id).
 						SetMembershipType(op.mt.String()).
+						SetNillableSort(sortKey.Ptr()).
 						Exec(ctx)
+					sortKey = nextKeyBefore(sortKey)
 				}
 
 			}
@@ -159,9 +180,29 @@ func (d *Repository) UpdateItems(ctx context.Context, qk collection.QueryKey, op
 		}
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	return d.querier.Get(ctx, qk)
 }
 
+// nextKeyBefore gives each item added in the same update its own position, in
+// the order they were added, instead of all sharing the same key at the top.
+func nextKeyBefore(k opt.Optional[lexorank.Key]) opt.Optional[lexorank.Key] {
+	key, ok := k.Get()
+	if !ok {
+		return k
+	}
+
+	before, ok := key.Before(1)
+	if !ok {
+		return opt.NewEmpty[lexorank.Key]()
+	}
+
+	return opt.NewPtr(before)
+}
+
 func (d *Repository) ProbeItem(ctx context.Context, qk collection.QueryKey, itemID xid.ID) (*collection.CollectionItemStatus, error) {
 	r, err := d.db.Collection.Query().
 		Where(qk.Predicate()).
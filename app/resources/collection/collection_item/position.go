@@ -0,0 +1,333 @@
+package collection_item
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/lexorank"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/collectionnode"
+	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+)
+
+var (
+	ErrItemNotInCollection    = fault.New("item is not in collection", ftag.With(ftag.NotFound))
+	ErrSectionNotInCollection = fault.New("section is not in collection", ftag.With(ftag.NotFound))
+)
+
+type positionedItem struct {
+	id    xid.ID
+	kind  datagraph.Kind
+	added time.Time
+	sort  opt.Optional[lexorank.Key]
+}
+
+func (p *positionedItem) GetKey() lexorank.Key  { return p.sort.OrZero() }
+func (p *positionedItem) SetKey(k lexorank.Key) { p.sort = opt.New(k) }
+
+// Move repositions a single item relative to another item in the collection by
+// computing a sort key between the target and its neighbour. Only the item that
+// moved is written unless the key space is exhausted or the collection has not
+// been manually ordered before, in which case every item is given a key first.
+func (d *Repository) Move(ctx context.Context, qk collection.QueryKey, itemID xid.ID, pos collection.Position) (*collection.CollectionWithItems, error) {
+	cid, err := d.db.Collection.Query().Where(qk.Predicate()).OnlyID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := d.listPositions(ctx, cid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	target, ok := findItem(items, itemID)
+	if !ok {
+		return nil, fault.Wrap(ErrItemNotInCollection, fctx.With(ctx))
+	}
+
+	key, ok, err := computeItemSortKey(items, itemID, pos)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		if err := d.normalise(ctx, cid, items); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		key, ok, err = computeItemSortKey(items, itemID, pos)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !ok {
+			return nil, fault.New("failed to calculate new item sort key after normalisation", fctx.With(ctx))
+		}
+	}
+
+	if err := d.setSort(ctx, cid, target, *key); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d.querier.Get(ctx, qk)
+}
+
+// SetSection moves an item into the specified section or, if the section is
+// empty, removes the item from whichever section it is currently within.
+func (d *Repository) SetSection(ctx context.Context, qk collection.QueryKey, itemID xid.ID, section opt.Optional[collection.SectionID]) (*collection.CollectionWithItems, error) {
+	cid, err := d.db.Collection.Query().Where(qk.Predicate()).OnlyID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if sid, ok := section.Get(); ok {
+		exists, err := d.db.CollectionSection.Query().
+			Where(
+				collectionsection.ID(xid.ID(sid)),
+				collectionsection.CollectionID(cid),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !exists {
+			return nil, fault.Wrap(ErrSectionNotInCollection, fctx.With(ctx),
+				fmsg.WithDesc("section not found", "The specified section does not belong to this collection."))
+		}
+	}
+
+	items, err := d.listPositions(ctx, cid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	target, ok := findItem(items, itemID)
+	if !ok {
+		return nil, fault.Wrap(ErrItemNotInCollection, fctx.With(ctx))
+	}
+
+	switch target.kind {
+	case datagraph.KindPost:
+		update := d.db.CollectionPost.Update().Where(
+			collectionpost.CollectionID(cid),
+			collectionpost.PostID(target.id),
+		)
+		if sid, ok := section.Get(); ok {
+			update.SetSectionID(xid.ID(sid))
+		} else {
+			update.ClearSectionID()
+		}
+		err = update.Exec(ctx)
+
+	case datagraph.KindNode:
+		update := d.db.CollectionNode.Update().Where(
+			collectionnode.CollectionID(cid),
+			collectionnode.NodeID(target.id),
+		)
+		if sid, ok := section.Get(); ok {
+			update.SetSectionID(xid.ID(sid))
+		} else {
+			update.ClearSectionID()
+		}
+		err = update.Exec(ctx)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d.querier.Get(ctx, qk)
+}
+
+func (d *Repository) listPositions(ctx context.Context, cid xid.ID) ([]*positionedItem, error) {
+	posts, err := d.db.CollectionPost.Query().
+		Where(collectionpost.CollectionID(cid)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := d.db.CollectionNode.Query().
+		Where(collectionnode.CollectionID(cid)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items := append(
+		dt.Map(posts, func(p *ent.CollectionPost) *positionedItem {
+			return &positionedItem{id: p.PostID, kind: datagraph.KindPost, added: p.CreatedAt, sort: opt.NewPtr(p.Sort)}
+		}),
+		dt.Map(nodes, func(n *ent.CollectionNode) *positionedItem {
+			return &positionedItem{id: n.NodeID, kind: datagraph.KindNode, added: n.CreatedAt, sort: opt.NewPtr(n.Sort)}
+		})...,
+	)
+
+	// Must match the ordering of collection.CollectionItems.
+	sort.SliceStable(items, func(i, j int) bool {
+		si, iok := items[i].sort.Get()
+		sj, jok := items[j].sort.Get()
+
+		switch {
+		case iok && jok && si.String() != sj.String():
+			return si.String() < sj.String()
+		case iok != jok:
+			return jok
+		default:
+			return items[i].added.After(items[j].added)
+		}
+	})
+
+	return items, nil
+}
+
+// nextSortKey yields a key which places a newly added item at the top of the
+// collection, but only if the collection has been manually ordered already.
+func (d *Repository) nextSortKey(ctx context.Context, cid xid.ID) (opt.Optional[lexorank.Key], error) {
+	posts, err := d.db.CollectionPost.Query().
+		Select(collectionpost.FieldSort).
+		Where(collectionpost.CollectionID(cid), collectionpost.SortNotNil()).
+		Order(ent.Asc(collectionpost.FieldSort)).
+		Limit(1).
+		All(ctx)
+	if err != nil {
+		return opt.NewEmpty[lexorank.Key](), fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := d.db.CollectionNode.Query().
+		Select(collectionnode.FieldSort).
+		Where(collectionnode.CollectionID(cid), collectionnode.SortNotNil()).
+		Order(ent.Asc(collectionnode.FieldSort)).
+		Limit(1).
+		All(ctx)
+	if err != nil {
+		return opt.NewEmpty[lexorank.Key](), fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var first *lexorank.Key
+	if len(posts) == 1 {
+		first = posts[0].Sort
+	}
+	if len(nodes) == 1 && (first == nil || nodes[0].Sort.String() < first.String()) {
+		first = nodes[0].Sort
+	}
+	if first == nil {
+		return opt.NewEmpty[lexorank.Key](), nil
+	}
+
+	// If there's no room at the top, the item is left unpositioned which still
+	// places it at the top and the next move will normalise the collection.
+	key, ok := first.Before(1)
+	if !ok {
+		return opt.NewEmpty[lexorank.Key](), nil
+	}
+
+	return opt.NewPtr(key), nil
+}
+
+func (d *Repository) normalise(ctx context.Context, cid xid.ID, items []*positionedItem) error {
+	rol := lexorank.ReorderableList(dt.Map(items, func(p *positionedItem) lexorank.Reorderable { return p }))
+
+	rol.Normalise()
+
+	for _, p := range items {
+		if err := d.setSort(ctx, cid, p, p.sort.OrZero()); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (d *Repository) setSort(ctx context.Context, cid xid.ID, p *positionedItem, key lexorank.Key) error {
+	var err error
+
+	switch p.kind {
+	case datagraph.KindPost:
+		err = d.db.CollectionPost.Update().
+			Where(collectionpost.CollectionID(cid), collectionpost.PostID(p.id)).
+			SetSort(key).
+			Exec(ctx)
+
+	case datagraph.KindNode:
+		err = d.db.CollectionNode.Update().
+			Where(collectionnode.CollectionID(cid), collectionnode.NodeID(p.id)).
+			SetSort(key).
+			Exec(ctx)
+	}
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p.sort = opt.New(key)
+
+	return nil
+}
+
+func findItem(items []*positionedItem, id xid.ID) (*positionedItem, bool) {
+	for _, p := range items {
+		if p.id == id {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// computeItemSortKey yields false if any item in the collection has no sort key
+// or if there is no room between the anchor and its neighbour, either of which
+// requires the whole collection to be normalised before trying again.
+func computeItemSortKey(items []*positionedItem, itemID xid.ID, pos collection.Position) (*lexorank.Key, bool, error) {
+	siblings := dt.Filter(items, func(p *positionedItem) bool { return p.id != itemID })
+
+	for _, p := range siblings {
+		if !p.sort.Ok() {
+			return nil, false, nil
+		}
+	}
+
+	var anchorID xid.ID
+	var direction int
+	if v, ok := pos.Before.Get(); ok {
+		anchorID, direction = v, -1
+	} else if v, ok := pos.After.Get(); ok {
+		anchorID, direction = v, 1
+	} else {
+		return nil, false, fault.New("either before or after must be specified", ftag.With(ftag.InvalidArgument))
+	}
+
+	idx := -1
+	for i, p := range siblings {
+		if p.id == anchorID {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil, false, fault.Wrap(ErrItemNotInCollection,
+			fmsg.WithDesc("anchor not found", "The item to position relative to is not in this collection."))
+	}
+
+	anchor := siblings[idx].sort.OrZero()
+	neighbour := idx + direction
+
+	if neighbour < 0 {
+		key, ok := anchor.Before(1)
+		return key, ok, nil
+	}
+	if neighbour >= len(siblings) {
+		key, ok := anchor.After(1)
+		return key, ok, nil
+	}
+
+	key, ok := anchor.Between(siblings[neighbour].sort.OrZero())
+	return key, ok, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
 	ent_node "github.com/Southclaws/storyden/internal/ent/node"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
 )
 
 type Querier struct {
@@ -52,23 +53,29 @@ func WithItemPresenceQuery(id xid.ID) Option {
 
 func WithVisibility(v ...visibility.Visibility) ItemFilter {
 	return func(pq *ent.CollectionPostQuery, nq *ent.CollectionNodeQuery) {
-		pv := dt.Map(v, func(v visibility.Visibility) ent_post.Visibility { return ent_post.Visibility(v.String()) })
-		pq.Where(
-			collectionpost.HasPostWith(
-				ent_post.VisibilityIn(pv...),
-			),
-		)
-
-		nv := dt.Map(v, func(v visibility.Visibility) ent_node.Visibility { return ent_node.Visibility(v.String()) })
-		nq.Where(
-			collectionnode.HasNodeWith(
-				ent_node.VisibilityIn(nv...),
-			),
-		)
+		if pq != nil {
+			pv := dt.Map(v, func(v visibility.Visibility) ent_post.Visibility { return ent_post.Visibility(v.String()) })
+			pq.Where(
+				collectionpost.HasPostWith(
+					ent_post.VisibilityIn(pv...),
+				),
+			)
+		}
+
+		if nq != nil {
+			nv := dt.Map(v, func(v visibility.Visibility) ent_node.Visibility { return ent_node.Visibility(v.String()) })
+			nq.Where(
+				collectionnode.HasNodeWith(
+					ent_node.VisibilityIn(nv...),
+				),
+			)
+		}
 	}
 }
 
 func (d *Querier) List(ctx context.Context, filters ...Option) ([]*collection.Collection, error) {
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
 	var opts listOption
 	for _, fn := range filters {
 		fn(&opts)
@@ -121,6 +128,7 @@ func (d *Querier) Get(ctx context.Context, qk collection.QueryKey, filters ...It
 		Query().
 		Where(qk.Predicate()).
 		WithOwner().
+		WithSections().
 		WithCollectionPosts(func(pq *ent.CollectionPostQuery) {
 			for _, fn := range filters {
 				fn(pq, nil)
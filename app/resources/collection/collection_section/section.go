@@ -0,0 +1,281 @@
+package collection_section
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/lexorank"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+)
+
+var (
+	ErrNotFound       = fault.New("section not found", ftag.With(ftag.NotFound))
+	ErrAnchorNotFound = fault.New("anchor section not found", ftag.With(ftag.NotFound))
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+type Option func(*ent.CollectionSectionMutation)
+
+func WithName(v string) Option {
+	return func(m *ent.CollectionSectionMutation) {
+		m.SetName(v)
+	}
+}
+
+func WithDescription(v string) Option {
+	return func(m *ent.CollectionSectionMutation) {
+		m.SetDescription(v)
+	}
+}
+
+func (r *Repository) List(ctx context.Context, qk collection.QueryKey) (collection.Sections, error) {
+	sections, err := r.db.CollectionSection.Query().
+		Where(collectionsection.HasCollectionWith(qk.Predicate())).
+		Order(ent.Asc(collectionsection.FieldSort)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return collection.MapSections(sections), nil
+}
+
+func (r *Repository) Create(ctx context.Context, qk collection.QueryKey, name string, opts ...Option) (*collection.Section, error) {
+	cid, err := r.db.Collection.Query().Where(qk.Predicate()).OnlyID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sortKey, err := r.getNextSortKey(ctx, cid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	create := r.db.CollectionSection.Create()
+	mutate := create.Mutation()
+
+	mutate.SetCollectionID(cid)
+	mutate.SetName(name)
+	mutate.SetSort(*sortKey)
+
+	for _, fn := range opts {
+		fn(mutate)
+	}
+
+	s, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return collection.MapSection(s), nil
+}
+
+func (r *Repository) Update(ctx context.Context, qk collection.QueryKey, id collection.SectionID, opts ...Option) (*collection.Section, error) {
+	update := r.db.CollectionSection.Update().Where(
+		collectionsection.ID(xid.ID(id)),
+		collectionsection.HasCollectionWith(qk.Predicate()),
+	)
+	mutate := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutate)
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if n == 0 {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	return r.get(ctx, id)
+}
+
+func (r *Repository) Delete(ctx context.Context, qk collection.QueryKey, id collection.SectionID) error {
+	n, err := r.db.CollectionSection.Delete().Where(
+		collectionsection.ID(xid.ID(id)),
+		collectionsection.HasCollectionWith(qk.Predicate()),
+	).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if n == 0 {
+		return fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Move repositions a section relative to one of its siblings, only writing the
+// moved section's sort key unless the key space between the two is exhausted.
+func (r *Repository) Move(ctx context.Context, qk collection.QueryKey, id collection.SectionID, pos collection.Position) (*collection.Section, error) {
+	sections, err := r.List(ctx, qk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, ok := findSection(sections, id); !ok {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	key, ok, err := computeSectionSortKey(sections, id, pos)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		if err := r.normalise(ctx, sections); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		key, ok, err = computeSectionSortKey(sections, id, pos)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !ok {
+			return nil, fault.New("failed to calculate new section sort key after normalisation", fctx.With(ctx))
+		}
+	}
+
+	err = r.db.CollectionSection.UpdateOneID(xid.ID(id)).SetSort(*key).Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r.get(ctx, id)
+}
+
+func (r *Repository) get(ctx context.Context, id collection.SectionID) (*collection.Section, error) {
+	s, err := r.db.CollectionSection.Get(ctx, xid.ID(id))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return collection.MapSection(s), nil
+}
+
+func (r *Repository) getNextSortKey(ctx context.Context, cid xid.ID) (*lexorank.Key, error) {
+	last, err := r.db.CollectionSection.Query().
+		Select(collectionsection.FieldSort).
+		Where(collectionsection.CollectionID(cid)).
+		Order(ent.Desc(collectionsection.FieldSort)).
+		Limit(1).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(last) == 0 {
+		return &lexorank.Middle, nil
+	}
+
+	key, ok := last[0].Sort.After(100)
+	if !ok {
+		sections, err := r.db.CollectionSection.Query().
+			Where(collectionsection.CollectionID(cid)).
+			Order(ent.Asc(collectionsection.FieldSort)).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		mapped := collection.MapSections(sections)
+		if err := r.normalise(ctx, mapped); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		key, ok = mapped[len(mapped)-1].Sort.After(1)
+		if !ok {
+			return nil, fault.Newf("failed to get next section sort key after %s", mapped[len(mapped)-1].Sort)
+		}
+	}
+
+	return key, nil
+}
+
+type reorderableSection struct{ *collection.Section }
+
+func (r reorderableSection) GetKey() lexorank.Key  { return r.Sort }
+func (r reorderableSection) SetKey(k lexorank.Key) { r.Sort = k }
+
+func (r *Repository) normalise(ctx context.Context, sections collection.Sections) error {
+	rol := lexorank.ReorderableList(dt.Map(sections, func(s *collection.Section) lexorank.Reorderable {
+		return reorderableSection{s}
+	}))
+
+	rol.Normalise()
+
+	for _, s := range sections {
+		err := r.db.CollectionSection.UpdateOneID(xid.ID(s.ID)).SetSort(s.Sort).Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func findSection(sections collection.Sections, id collection.SectionID) (*collection.Section, bool) {
+	for _, s := range sections {
+		if s.ID == id {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+func computeSectionSortKey(sections collection.Sections, id collection.SectionID, pos collection.Position) (*lexorank.Key, bool, error) {
+	siblings := dt.Filter(sections, func(s *collection.Section) bool { return s.ID != id })
+
+	var anchorID collection.SectionID
+	var direction int
+	if v, ok := pos.Before.Get(); ok {
+		anchorID, direction = collection.SectionID(v), -1
+	} else if v, ok := pos.After.Get(); ok {
+		anchorID, direction = collection.SectionID(v), 1
+	} else {
+		return nil, false, fault.New("either before or after must be specified", ftag.With(ftag.InvalidArgument))
+	}
+
+	idx := -1
+	for i, s := range siblings {
+		if s.ID == anchorID {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil, false, fault.Wrap(ErrAnchorNotFound,
+			fmsg.WithDesc("anchor not found", "The section to position relative to is not in this collection."))
+	}
+
+	anchor := siblings[idx].Sort
+	neighbour := idx + direction
+
+	if neighbour < 0 {
+		key, ok := anchor.Before(1)
+		return key, ok, nil
+	}
+	if neighbour >= len(siblings) {
+		key, ok := anchor.After(1)
+		return key, ok, nil
+	}
+
+	key, ok := anchor.Between(siblings[neighbour].Sort)
+	return key, ok, nil
+}
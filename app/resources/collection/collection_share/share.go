@@ -0,0 +1,159 @@
+package collection_share
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
+)
+
+var ErrNotFound = fault.New("collection share not found", ftag.With(ftag.NotFound))
+
+const tokenLength = 24
+
+type ShareID xid.ID
+
+func (i ShareID) String() string { return xid.ID(i).String() }
+
+type Share struct {
+	ID           ShareID
+	CreatedAt    time.Time
+	ExpiresAt    opt.Optional[time.Time]
+	CollectionID xid.ID
+	Token        string
+	HasPasscode  bool
+}
+
+// ShareWithHash is only used internally for validating a passcode, the hash is
+// never exposed outside of the service layer.
+type ShareWithHash struct {
+	Share
+	PasscodeHash opt.Optional[string]
+}
+
+func (s *Share) Expired(now time.Time) bool {
+	exp, ok := s.ExpiresAt.Get()
+	return ok && now.After(exp)
+}
+
+func Map(in *ent.CollectionShare) *Share {
+	return &Share{
+		ID:           ShareID(in.ID),
+		CreatedAt:    in.CreatedAt,
+		ExpiresAt:    opt.NewPtr(in.ExpiresAt),
+		CollectionID: in.CollectionID,
+		Token:        in.Token,
+		HasPasscode:  in.PasscodeHash != nil,
+	}
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+type Option func(*ent.CollectionShareMutation)
+
+func WithPasscodeHash(v string) Option {
+	return func(m *ent.CollectionShareMutation) {
+		m.SetPasscodeHash(v)
+	}
+}
+
+func WithExpiry(v time.Time) Option {
+	return func(m *ent.CollectionShareMutation) {
+		m.SetExpiresAt(v)
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, qk collection.QueryKey, opts ...Option) (*Share, error) {
+	cid, err := r.db.Collection.Query().Where(qk.Predicate()).OnlyID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	create := r.db.CollectionShare.Create()
+	mutate := create.Mutation()
+
+	mutate.SetCollectionID(cid)
+	mutate.SetToken(newToken())
+
+	for _, fn := range opts {
+		fn(mutate)
+	}
+
+	s, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(s), nil
+}
+
+func (r *Repository) List(ctx context.Context, qk collection.QueryKey) ([]*Share, error) {
+	shares, err := r.db.CollectionShare.Query().
+		Where(collectionshare.HasCollectionWith(qk.Predicate())).
+		Order(ent.Desc(collectionshare.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(shares, Map), nil
+}
+
+func (r *Repository) GetByToken(ctx context.Context, token string) (*ShareWithHash, error) {
+	s, err := r.db.CollectionShare.Query().
+		Where(collectionshare.Token(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &ShareWithHash{
+		Share:        *Map(s),
+		PasscodeHash: opt.NewPtr(s.PasscodeHash),
+	}, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, qk collection.QueryKey, id ShareID) error {
+	n, err := r.db.CollectionShare.Delete().
+		Where(
+			collectionshare.ID(xid.ID(id)),
+			collectionshare.HasCollectionWith(qk.Predicate()),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if n == 0 {
+		return fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func newToken() string {
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/library"
@@ -21,6 +23,8 @@ type CollectionItem struct {
 	Author         profile.Ref
 	Item           datagraph.Item
 	RelevanceScore opt.Optional[float64]
+	Sort           opt.Optional[lexorank.Key]
+	Section        opt.Optional[SectionID]
 }
 
 type CollectionItemStatus struct {
@@ -30,9 +34,25 @@ type CollectionItemStatus struct {
 
 type CollectionItems []*CollectionItem
 
-func (a CollectionItems) Len() int           { return len(a) }
-func (a CollectionItems) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a CollectionItems) Less(i, j int) bool { return a[i].Added.After(a[j].Added) }
+func (a CollectionItems) Len() int      { return len(a) }
+func (a CollectionItems) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+
+// Less orders items by their manual sort key once they have been positioned,
+// items which have never been positioned are listed first, newest first, which
+// is how collections are ordered before they have been manually arranged.
+func (a CollectionItems) Less(i, j int) bool {
+	si, iok := a[i].Sort.Get()
+	sj, jok := a[j].Sort.Get()
+
+	switch {
+	case iok && jok && si.String() != sj.String():
+		return si.String() < sj.String()
+	case iok != jok:
+		return jok
+	default:
+		return a[i].Added.After(a[j].Added)
+	}
+}
 
 func MapWithItems(c *ent.Collection) (*CollectionWithItems, error) {
 	col, err := Map(nil)(c)
@@ -56,6 +76,7 @@ func MapWithItems(c *ent.Collection) (*CollectionWithItems, error) {
 
 	colWithItems := &CollectionWithItems{
 		Collection: *col,
+		Sections:   MapSections(c.Edges.Sections),
 		Items:      items,
 	}
 
@@ -90,6 +111,8 @@ func MapPost(n *ent.CollectionPost) (*CollectionItem, error) {
 		MembershipType: mt,
 		Author:         *pro,
 		Item:           item,
+		Sort:           opt.NewPtr(n.Sort),
+		Section:        opt.NewPtrMap(n.SectionID, func(id xid.ID) SectionID { return SectionID(id) }),
 	}, nil
 }
 
@@ -121,5 +144,7 @@ func MapNode(n *ent.CollectionNode) (*CollectionItem, error) {
 		MembershipType: mt,
 		Author:         *pro,
 		Item:           item,
+		Sort:           opt.NewPtr(n.Sort),
+		Section:        opt.NewPtrMap(n.SectionID, func(id xid.ID) SectionID { return SectionID(id) }),
 	}, nil
 }
@@ -0,0 +1,13 @@
+package collection
+
+import (
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+)
+
+// Position describes where an item or section should be placed relative to one
+// of its siblings. Only one of Before or After may be specified at once.
+type Position struct {
+	Before opt.Optional[xid.ID]
+	After  opt.Optional[xid.ID]
+}
@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/lexorank"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type SectionID xid.ID
+
+func (i SectionID) String() string { return xid.ID(i).String() }
+
+type Section struct {
+	ID          SectionID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Name        string
+	Description opt.Optional[string]
+	Sort        lexorank.Key
+}
+
+type Sections []*Section
+
+func (a Sections) Len() int           { return len(a) }
+func (a Sections) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a Sections) Less(i, j int) bool { return a[i].Sort.String() < a[j].Sort.String() }
+
+func MapSection(in *ent.CollectionSection) *Section {
+	return &Section{
+		ID:          SectionID(in.ID),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Name:        in.Name,
+		Description: opt.NewPtr(in.Description),
+		Sort:        in.Sort,
+	}
+}
+
+func MapSections(in []*ent.CollectionSection) Sections {
+	sections := Sections(dt.Map(in, MapSection))
+	sort.Sort(sections)
+	return sections
+}
@@ -0,0 +1,94 @@
+// Package content_view stores how many times threads and library pages were
+// viewed, and by how many different people, in hourly and daily buckets. Who
+// viewed them is never stored.
+package content_view
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type periodEnum string
+
+const (
+	periodHour periodEnum = "hour"
+	periodDay  periodEnum = "day"
+)
+
+var Periods = []Period{PeriodHour, PeriodDay}
+
+func (p Period) Duration() time.Duration {
+	switch p {
+	case PeriodHour:
+		return time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// Truncate is the start of the bucket t falls in, days are in UTC.
+func (p Period) Truncate(t time.Time) time.Time {
+	return t.UTC().Truncate(p.Duration())
+}
+
+// Counts are the views within a bucket, or summed over several buckets. Each
+// viewer is counted once per bucket, so unique viewers summed over several
+// days counts someone who came back on another day again.
+type Counts struct {
+	Views         int
+	UniqueViewers int
+}
+
+func (c *Counts) Add(o Counts) {
+	c.Views += o.Views
+	c.UniqueViewers += o.UniqueViewers
+}
+
+type Entry struct {
+	Period Period
+	Bucket time.Time
+	ItemID xid.ID
+	Counts Counts
+}
+
+type Point struct {
+	Bucket time.Time
+	Counts Counts
+}
+
+// ItemStats are the views of a single thread or library page.
+type ItemStats struct {
+	Kind     datagraph.Kind
+	ItemID   xid.ID
+	AuthorID account.AccountID
+	Total    Counts
+	Days     []Point
+	Hours    []Point
+}
+
+type ItemCounts struct {
+	Kind   datagraph.Kind
+	ItemID xid.ID
+	Counts Counts
+}
+
+// ItemViews are the views of a thread or library page within one bucket.
+type ItemViews struct {
+	Kind     datagraph.Kind
+	ItemID   xid.ID
+	AuthorID account.AccountID
+	Counts   Counts
+}
+
+// AuthorStats are the views of everything an account has published.
+type AuthorStats struct {
+	Total Counts
+	Days  []Point
+	Items []ItemCounts
+}
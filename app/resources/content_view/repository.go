@@ -0,0 +1,297 @@
+package content_view
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
+)
+
+var ErrNotFound = fault.New("content not found", ftag.With(ftag.NotFound))
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+type owner struct {
+	kind     datagraph.Kind
+	authorID xid.ID
+}
+
+// owners finds who published each of the given threads and library pages.
+// Content which doesn't exist, isn't published or was deleted is left out so
+// views of it are never stored.
+func (r *Repository) owners(ctx context.Context, ids []xid.ID) (map[xid.ID]owner, error) {
+	out := map[xid.ID]owner{}
+
+	threads, err := r.db.Post.Query().
+		Where(
+			ent_post.IDIn(ids...),
+			ent_post.RootPostIDIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+			ent_post.DeletedAtIsNil(),
+		).
+		Select(ent_post.FieldID, ent_post.FieldAccountPosts).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, t := range threads {
+		out[t.ID] = owner{datagraph.KindThread, t.AccountPosts}
+	}
+
+	nodes, err := r.db.Node.Query().
+		Where(
+			ent_node.IDIn(ids...),
+			ent_node.VisibilityEQ(ent_node.VisibilityPublished),
+			ent_node.DeletedAtIsNil(),
+		).
+		Select(ent_node.FieldID, ent_node.FieldAccountID).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, n := range nodes {
+		out[n.ID] = owner{datagraph.KindNode, n.AccountID}
+	}
+
+	return out, nil
+}
+
+// Add adds view counts to their buckets, creating the bucket if this is the
+// first time the item has been viewed within it.
+func (r *Repository) Add(ctx context.Context, entries []Entry) error {
+	owners, err := r.owners(ctx, dedupe(entries))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, e := range entries {
+		o, ok := owners[e.ItemID]
+		if !ok {
+			continue
+		}
+
+		bucket := e.Period.Truncate(e.Bucket)
+
+		n, err := r.db.ContentView.Update().
+			Where(
+				contentview.ItemID(e.ItemID),
+				contentview.Period(e.Period.String()),
+				contentview.Bucket(bucket),
+			).
+			AddViews(e.Counts.Views).
+			AddUniqueViewers(e.Counts.UniqueViewers).
+			Save(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			continue
+		}
+
+		err = r.db.ContentView.Create().
+			SetPeriod(e.Period.String()).
+			SetBucket(bucket).
+			SetItemKind(o.kind.String()).
+			SetItemID(e.ItemID).
+			SetAuthorID(o.authorID).
+			SetViews(e.Counts.Views).
+			SetUniqueViewers(e.Counts.UniqueViewers).
+			Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// ForItem returns the daily views of a thread or library page since the given
+// time along with its hourly views since hoursSince.
+func (r *Repository) ForItem(ctx context.Context, id xid.ID, since, hoursSince time.Time) (*ItemStats, error) {
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
+	owners, err := r.owners(ctx, []xid.ID{id})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	o, ok := owners[id]
+	if !ok {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	days, err := r.db.ContentView.Query().
+		Where(
+			contentview.ItemID(id),
+			contentview.Period(PeriodDay.String()),
+			contentview.BucketGTE(PeriodDay.Truncate(since)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	hours, err := r.db.ContentView.Query().
+		Where(
+			contentview.ItemID(id),
+			contentview.Period(PeriodHour.String()),
+			contentview.BucketGTE(PeriodHour.Truncate(hoursSince)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	stats := &ItemStats{
+		Kind:     o.kind,
+		ItemID:   id,
+		AuthorID: account.AccountID(o.authorID),
+		Days:     points(days),
+		Hours:    points(hours),
+	}
+	for _, d := range stats.Days {
+		stats.Total.Add(d.Counts)
+	}
+
+	return stats, nil
+}
+
+// ForAuthor returns the daily views of everything an account has published
+// since the given time, along with its most viewed content.
+func (r *Repository) ForAuthor(ctx context.Context, authorID account.AccountID, since time.Time, limit int) (*AuthorStats, error) {
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
+	rows, err := r.db.ContentView.Query().
+		Where(
+			contentview.AuthorID(xid.ID(authorID)),
+			contentview.Period(PeriodDay.String()),
+			contentview.BucketGTE(PeriodDay.Truncate(since)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	stats := &AuthorStats{Days: points(rows)}
+	for _, d := range stats.Days {
+		stats.Total.Add(d.Counts)
+	}
+
+	items := map[xid.ID]*ItemCounts{}
+	for _, row := range rows {
+		i, ok := items[row.ItemID]
+		if !ok {
+			kind, err := datagraph.NewKind(row.ItemKind)
+			if err != nil {
+				continue
+			}
+			i = &ItemCounts{Kind: kind, ItemID: row.ItemID}
+			items[row.ItemID] = i
+		}
+		i.Counts.Add(counts(row))
+	}
+
+	for _, i := range items {
+		stats.Items = append(stats.Items, *i)
+	}
+	slices.SortFunc(stats.Items, func(a, b ItemCounts) int {
+		if a.Counts.Views == b.Counts.Views {
+			return b.ItemID.Compare(a.ItemID)
+		}
+		return b.Counts.Views - a.Counts.Views
+	})
+	if len(stats.Items) > limit {
+		stats.Items = stats.Items[:limit]
+	}
+
+	return stats, nil
+}
+
+// Daily lists the views of every item viewed on the day starting at date.
+func (r *Repository) Daily(ctx context.Context, date time.Time) ([]*ItemViews, error) {
+	rows, err := r.db.ContentView.Query().
+		Where(
+			contentview.Period(PeriodDay.String()),
+			contentview.Bucket(PeriodDay.Truncate(date)),
+		).
+		Order(ent.Desc(contentview.FieldViews), ent.Asc(contentview.FieldItemID)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make([]*ItemViews, 0, len(rows))
+	for _, row := range rows {
+		kind, err := datagraph.NewKind(row.ItemKind)
+		if err != nil {
+			continue
+		}
+		out = append(out, &ItemViews{
+			Kind:     kind,
+			ItemID:   row.ItemID,
+			AuthorID: account.AccountID(row.AuthorID),
+			Counts:   counts(row),
+		})
+	}
+
+	return out, nil
+}
+
+// points sums rows into one point per bucket, oldest first.
+func points(rows []*ent.ContentView) []Point {
+	buckets := map[int64]*Point{}
+	for _, row := range rows {
+		k := row.Bucket.Unix()
+		p, ok := buckets[k]
+		if !ok {
+			p = &Point{Bucket: row.Bucket.UTC()}
+			buckets[k] = p
+		}
+		p.Counts.Add(counts(row))
+	}
+
+	out := make([]Point, 0, len(buckets))
+	for _, p := range buckets {
+		out = append(out, *p)
+	}
+	slices.SortFunc(out, func(a, b Point) int { return a.Bucket.Compare(b.Bucket) })
+
+	return out
+}
+
+func counts(row *ent.ContentView) Counts {
+	return Counts{
+		Views:         row.Views,
+		UniqueViewers: row.UniqueViewers,
+	}
+}
+
+func dedupe(entries []Entry) []xid.ID {
+	seen := map[xid.ID]struct{}{}
+	ids := []xid.ID{}
+	for _, e := range entries {
+		if _, ok := seen[e.ItemID]; !ok {
+			seen[e.ItemID] = struct{}{}
+			ids = append(ids, e.ItemID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,145 @@
+// Package conversation describes private messages between members, kept apart
+// from public threads. A conversation is either between two members or a small
+// group, optionally with a title.
+package conversation
+
+import (
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+// MaxParticipants is the most members a group conversation may have, larger
+// discussions belong in a thread.
+const MaxParticipants = 10
+
+type ConversationID xid.ID
+
+func (i ConversationID) String() string { return xid.ID(i).String() }
+
+type MessageID xid.ID
+
+func (i MessageID) String() string { return xid.ID(i).String() }
+
+type Participant struct {
+	Profile    profile.Ref
+	JoinedAt   time.Time
+	LastReadAt opt.Optional[time.Time]
+	LeftAt     opt.Optional[time.Time]
+}
+
+func (p *Participant) Active() bool { return !p.LeftAt.Ok() }
+
+type Conversation struct {
+	ID            ConversationID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Title         opt.Optional[string]
+	LastMessageAt time.Time
+	Participants  []*Participant
+
+	// Set by the querier for the member viewing the conversation.
+	LastMessage opt.Optional[Message]
+	Unread      int
+}
+
+// Participant returns the member's participation in the conversation, which
+// may have ended if they left.
+func (c *Conversation) Participant(id account.AccountID) (*Participant, bool) {
+	return lo.Find(c.Participants, func(p *Participant) bool {
+		return p.Profile.ID == id
+	})
+}
+
+// IsMember reports whether the account takes part in the conversation and has
+// not left it.
+func (c *Conversation) IsMember(id account.AccountID) bool {
+	p, ok := c.Participant(id)
+	return ok && p.Active()
+}
+
+func (c *Conversation) IsGroup() bool {
+	return len(c.Participants) > 2 || c.Title.Ok()
+}
+
+type Message struct {
+	ID             MessageID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ConversationID ConversationID
+	Author         profile.Ref
+	Content        datagraph.Content
+	Assets         []*asset.Asset
+}
+
+func Map(in *ent.Conversation) (*Conversation, error) {
+	participants, err := dt.MapErr(in.Edges.Participants, MapParticipant)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Conversation{
+		ID:            ConversationID(in.ID),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Title:         opt.NewPtr(in.Title),
+		LastMessageAt: in.LastMessageAt,
+		Participants:  participants,
+	}, nil
+}
+
+func MapParticipant(in *ent.ConversationParticipant) (*Participant, error) {
+	accountEdge, err := in.Edges.AccountOrErr()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	pro, err := profile.MapRef(accountEdge)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Participant{
+		Profile:    *pro,
+		JoinedAt:   in.CreatedAt,
+		LastReadAt: opt.NewPtr(in.LastReadAt),
+		LeftAt:     opt.NewPtr(in.LeftAt),
+	}, nil
+}
+
+func MapMessage(in *ent.DirectMessage) (*Message, error) {
+	authorEdge, err := in.Edges.AuthorOrErr()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	pro, err := profile.MapRef(authorEdge)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	content, err := datagraph.NewRichText(in.Body)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Message{
+		ID:             MessageID(in.ID),
+		CreatedAt:      in.CreatedAt,
+		UpdatedAt:      in.UpdatedAt,
+		ConversationID: ConversationID(in.ConversationID),
+		Author:         *pro,
+		Content:        content,
+		Assets:         dt.Map(in.Edges.Assets, asset.Map),
+	}, nil
+}
@@ -0,0 +1,279 @@
+package conversation_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_asset "github.com/Southclaws/storyden/internal/ent/asset"
+	ent_conversation "github.com/Southclaws/storyden/internal/ent/conversation"
+	ent_participant "github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	ent_message "github.com/Southclaws/storyden/internal/ent/directmessage"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// List returns the conversations the account takes part in, most recently
+// active first, with the latest message and how many are unread.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID, page pagination.Parameters) (*pagination.Result[*conversation.Conversation], error) {
+	query := q.db.Conversation.Query().
+		Where(ent_conversation.HasParticipantsWith(
+			ent_participant.AccountID(xid.ID(accountID)),
+			ent_participant.LeftAtIsNil(),
+		))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := query.
+		WithParticipants(func(pq *ent.ConversationParticipantQuery) {
+			pq.WithAccount()
+		}).
+		Order(ent.Desc(ent_conversation.FieldLastMessageAt)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	conversations, err := dt.MapErr(r, conversation.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, c := range conversations {
+		if err := q.hydrate(ctx, c, accountID); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	result := pagination.NewPageResult(page, total, conversations)
+	return &result, nil
+}
+
+// Get returns a conversation as seen by the given account, whether or not they
+// take part in it, callers must check membership.
+func (q *Querier) Get(ctx context.Context, id conversation.ConversationID, accountID account.AccountID) (*conversation.Conversation, error) {
+	r, err := q.db.Conversation.Query().
+		Where(ent_conversation.ID(xid.ID(id))).
+		WithParticipants(func(pq *ent.ConversationParticipantQuery) {
+			pq.WithAccount()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := conversation.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := q.hydrate(ctx, c, accountID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+// FindDirect returns the untitled conversation between exactly these two
+// members, if neither has left it, so a new message continues it.
+func (q *Querier) FindDirect(ctx context.Context, a, b account.AccountID) (opt.Optional[conversation.ConversationID], error) {
+	r, err := q.db.Conversation.Query().
+		Where(
+			ent_conversation.TitleIsNil(),
+			ent_conversation.HasParticipantsWith(ent_participant.AccountID(xid.ID(a)), ent_participant.LeftAtIsNil()),
+			ent_conversation.HasParticipantsWith(ent_participant.AccountID(xid.ID(b)), ent_participant.LeftAtIsNil()),
+		).
+		WithParticipants().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, c := range r {
+		if len(c.Edges.Participants) == 2 {
+			return opt.New(conversation.ConversationID(c.ID)), nil
+		}
+	}
+
+	return opt.NewEmpty[conversation.ConversationID](), nil
+}
+
+// ListMessages returns the messages in a conversation, newest first.
+func (q *Querier) ListMessages(ctx context.Context, id conversation.ConversationID, page pagination.Parameters) (*pagination.Result[*conversation.Message], error) {
+	query := q.db.DirectMessage.Query().
+		Where(
+			ent_message.ConversationID(xid.ID(id)),
+			ent_message.DeletedAtIsNil(),
+		)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := query.
+		WithAuthor().
+		WithAssets().
+		Order(ent.Desc(ent_message.FieldCreatedAt), ent.Desc(ent_message.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	messages, err := dt.MapErr(r, conversation.MapMessage)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := pagination.NewPageResult(page, total, messages)
+	return &result, nil
+}
+
+func (q *Querier) GetMessage(ctx context.Context, id conversation.MessageID) (*conversation.Message, error) {
+	r, err := q.db.DirectMessage.Query().
+		Where(
+			ent_message.ID(xid.ID(id)),
+			ent_message.DeletedAtIsNil(),
+		).
+		WithAuthor().
+		WithAssets().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m, err := conversation.MapMessage(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m, nil
+}
+
+// UnreadTotal is how many messages the account has not read across all the
+// conversations they take part in.
+func (q *Querier) UnreadTotal(ctx context.Context, accountID account.AccountID) (int, error) {
+	ps, err := q.db.ConversationParticipant.Query().
+		Where(
+			ent_participant.AccountID(xid.ID(accountID)),
+			ent_participant.LeftAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	total := 0
+	for _, p := range ps {
+		n, err := q.unread(ctx, conversation.ConversationID(p.ConversationID), accountID, opt.NewPtr(p.LastReadAt))
+		if err != nil {
+			return 0, fault.Wrap(err, fctx.With(ctx))
+		}
+		total += n
+	}
+
+	return total, nil
+}
+
+// CanSeeAsset reports whether the asset is attached to a message in any
+// conversation the account currently takes part in.
+func (q *Querier) CanSeeAsset(ctx context.Context, accountID account.AccountID, assetID xid.ID) (bool, error) {
+	ok, err := q.db.DirectMessage.Query().
+		Where(
+			ent_message.DeletedAtIsNil(),
+			ent_message.HasAssetsWith(ent_asset.ID(assetID)),
+			ent_message.HasConversationWith(ent_conversation.HasParticipantsWith(
+				ent_participant.AccountID(xid.ID(accountID)),
+				ent_participant.LeftAtIsNil(),
+			)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ok, nil
+}
+
+func (q *Querier) hydrate(ctx context.Context, c *conversation.Conversation, accountID account.AccountID) error {
+	last, err := q.db.DirectMessage.Query().
+		Where(
+			ent_message.ConversationID(xid.ID(c.ID)),
+			ent_message.DeletedAtIsNil(),
+		).
+		WithAuthor().
+		WithAssets().
+		Order(ent.Desc(ent_message.FieldCreatedAt), ent.Desc(ent_message.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if last != nil {
+		m, err := conversation.MapMessage(last)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		c.LastMessage = opt.New(*m)
+	}
+
+	p, ok := c.Participant(accountID)
+	if !ok || !p.Active() {
+		return nil
+	}
+
+	c.Unread, err = q.unread(ctx, c.ID, accountID, p.LastReadAt)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (q *Querier) unread(ctx context.Context, id conversation.ConversationID, accountID account.AccountID, lastRead opt.Optional[time.Time]) (int, error) {
+	query := q.db.DirectMessage.Query().
+		Where(
+			ent_message.ConversationID(xid.ID(id)),
+			ent_message.AccountIDNEQ(xid.ID(accountID)),
+			ent_message.DeletedAtIsNil(),
+		)
+
+	if t, ok := lastRead.Get(); ok {
+		query.Where(ent_message.CreatedAtGT(t))
+	}
+
+	n, err := query.Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
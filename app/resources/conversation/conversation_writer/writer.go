@@ -0,0 +1,148 @@
+package conversation_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_participant "github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// Create starts a conversation between the given members, the first of which
+// is the member starting it and has therefore read everything so far.
+func (w *Writer) Create(ctx context.Context, title opt.Optional[string], participants []account.AccountID) (conversation.ConversationID, error) {
+	now := time.Now()
+
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	c, err := tx.Conversation.Create().
+		SetNillableTitle(title.Ptr()).
+		SetLastMessageAt(now).
+		Save(ctx)
+	if err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	creates := make([]*ent.ConversationParticipantCreate, 0, len(participants))
+	for i, id := range participants {
+		create := tx.ConversationParticipant.Create().
+			SetConversationID(c.ID).
+			SetAccountID(xid.ID(id))
+		if i == 0 {
+			create.SetLastReadAt(now)
+		}
+		creates = append(creates, create)
+	}
+
+	if err := tx.ConversationParticipant.CreateBulk(creates...).Exec(ctx); err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return conversation.ConversationID(c.ID), nil
+}
+
+// AddMessage stores a message and bumps the conversation to the top of its
+// members' lists. The author has read up to and including their own message.
+func (w *Writer) AddMessage(ctx context.Context, id conversation.ConversationID, authorID account.AccountID, content datagraph.Content, assetIDs []xid.ID) (conversation.MessageID, error) {
+	now := time.Now()
+
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return conversation.MessageID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	m, err := tx.DirectMessage.Create().
+		SetConversationID(xid.ID(id)).
+		SetAccountID(xid.ID(authorID)).
+		SetBody(content.HTML()).
+		SetCreatedAt(now).
+		AddAssetIDs(assetIDs...).
+		Save(ctx)
+	if err != nil {
+		return conversation.MessageID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = tx.Conversation.UpdateOneID(xid.ID(id)).
+		SetLastMessageAt(now).
+		Exec(ctx)
+	if err != nil {
+		return conversation.MessageID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = tx.ConversationParticipant.Update().
+		Where(
+			ent_participant.ConversationID(xid.ID(id)),
+			ent_participant.AccountID(xid.ID(authorID)),
+		).
+		SetLastReadAt(now).
+		Exec(ctx)
+	if err != nil {
+		return conversation.MessageID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return conversation.MessageID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return conversation.MessageID(m.ID), nil
+}
+
+func (w *Writer) MarkRead(ctx context.Context, id conversation.ConversationID, accountID account.AccountID, at time.Time) error {
+	err := w.db.ConversationParticipant.Update().
+		Where(
+			ent_participant.ConversationID(xid.ID(id)),
+			ent_participant.AccountID(xid.ID(accountID)),
+		).
+		SetLastReadAt(at).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) Leave(ctx context.Context, id conversation.ConversationID, accountID account.AccountID) error {
+	err := w.db.ConversationParticipant.Update().
+		Where(
+			ent_participant.ConversationID(xid.ID(id)),
+			ent_participant.AccountID(xid.ID(accountID)),
+			ent_participant.LeftAtIsNil(),
+		).
+		SetLeftAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
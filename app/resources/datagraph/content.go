@@ -124,6 +124,33 @@ func (r Content) IsEmpty() bool {
 	return r.html == nil || r.plain == "" || r.short == ""
 }
 
+// ReplaceText rewrites every text node in the document with fn, leaving markup
+// such as links and mentions intact, and returns the result as new content.
+func (r Content) ReplaceText(fn func(string) string) (Content, error) {
+	tree, err := html.Parse(strings.NewReader(r.HTML()))
+	if err != nil {
+		return Content{}, fault.Wrap(err)
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			n.Data = fn(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(tree)
+
+	w := &bytes.Buffer{}
+	if err := html.Render(w, tree); err != nil {
+		return Content{}, fault.Wrap(err)
+	}
+
+	return NewRichTextFromReader(w)
+}
+
 type options struct {
 	baseURL string
 }
@@ -320,9 +347,34 @@ func (c Content) Split() []string {
 		return []string{}
 	}
 
+	// iterate the top level nodes and split any that are "too big"
+	chunks := chunksFromNodes(c.blocks(), roughMaxSentenceSize)
+
+	return chunks
+}
+
+// Paragraphs is the text of each top-most block of content, such as headings
+// and paragraphs, for rendering where HTML isn't available.
+func (c Content) Paragraphs() []string {
+	if c.IsEmpty() {
+		return []string{}
+	}
+
+	ps := []string{}
+	for _, n := range c.blocks() {
+		t := strings.TrimSpace(spaces.ReplaceAllString(textfromnode(&n), " "))
+		if t != "" {
+			ps = append(ps, t)
+		}
+	}
+
+	return ps
+}
+
+func (c Content) blocks() []html.Node {
 	r := []html.Node{}
 
-	// first, walk the tree for the top-most block-content nodes.
+	// walk the tree for the top-most block-content nodes.
 	var walk func(n *html.Node)
 	walk = func(n *html.Node) {
 		if n.Type == html.ElementNode {
@@ -358,10 +410,7 @@ func (c Content) Split() []string {
 	}
 	walk(c.html)
 
-	// now, iterate these top level nodes and split any that are "too big"
-	chunks := chunksFromNodes(r, roughMaxSentenceSize)
-
-	return chunks
+	return r
 }
 
 func chunksFromNodes(ns []html.Node, max int) []string {
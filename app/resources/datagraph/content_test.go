@@ -2,6 +2,7 @@ package datagraph
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/Southclaws/storyden/internal/utils"
@@ -248,3 +249,40 @@ Thank you im advance</body>
 	a.Equal("Hello friends,\n\nI need help. I am currently using Europeam Values Study data set (2017) and i did crosstab for two variables - country code and political party support.\n\nThe problem is that i have been given all the countries and all the political parties", ps[0])
 	a.Equal("I would like to sort varibles in a way that i see only a specific country and the support for the political parties only in that country.\n\nThank you im advance", ps[1])
 }
+
+func TestReplaceText(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	c, err := NewRichText(`<p>hello <a href="https://darn.example">darn</a> world</p>`)
+	r.NoError(err)
+
+	replaced, err := c.ReplaceText(func(s string) string {
+		return strings.ReplaceAll(s, "darn", "****")
+	})
+	r.NoError(err)
+
+	a.Contains(replaced.HTML(), `href="https://darn.example"`)
+	a.Contains(replaced.HTML(), `>****</a>`)
+	a.Contains(replaced.Plaintext(), "hello **** world")
+}
+
+func TestParagraphs(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	c, err := NewRichText(`<h1>heading</h1>
+
+<p>Here's a paragraph with <strong>bold</strong>
+text.</p>
+
+<ul><li>one</li><li>two</li></ul>`)
+	r.NoError(err)
+
+	a.Equal([]string{
+		"heading",
+		"Here's a paragraph with bold text.",
+		"one",
+		"two",
+	}, c.Paragraphs())
+}
@@ -0,0 +1,140 @@
+// Package facet describes the structured filters which may be applied to a
+// search and the facet counts which summarise the full set of matches.
+package facet
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	post_category "github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/tag"
+)
+
+type Filter struct {
+	// Authors is a list of account handles.
+	Authors []string
+	// Tags is a list of tag names, items matching any of them are included.
+	Tags []string
+	// Categories is a list of category slugs, only threads have categories.
+	Categories    []string
+	CreatedAfter  opt.Optional[time.Time]
+	CreatedBefore opt.Optional[time.Time]
+	// Solved only applies to threads, true for threads with an accepted answer.
+	Solved opt.Optional[bool]
+	// ReadableBy hides posts in categories none of the roles can read, it is
+	// set from the session rather than chosen by whoever is searching.
+	ReadableBy opt.Optional[role.Roles]
+}
+
+func (f Filter) IsEmpty() bool {
+	_, restricted := f.Readable()
+	return f.isUnfiltered() && !restricted
+}
+
+func (f Filter) isUnfiltered() bool {
+	return len(f.Authors) == 0 &&
+		len(f.Tags) == 0 &&
+		len(f.Categories) == 0 &&
+		!f.CreatedAfter.Ok() &&
+		!f.CreatedBefore.Ok() &&
+		!f.Solved.Ok()
+}
+
+// Readable returns the roles which posts must be readable by, false when every
+// category is visible because no roles were given or they bypass permissions.
+func (f Filter) Readable() (role.Roles, bool) {
+	roles, ok := f.ReadableBy.Get()
+	if !ok || post_category.BypassesPermissions(roles) {
+		return nil, false
+	}
+
+	return roles, true
+}
+
+// ThreadOnly is true when the filter uses a field that only threads have, so
+// other kinds of content can never match it.
+func (f Filter) ThreadOnly() bool {
+	return len(f.Categories) > 0 || f.Solved.Ok()
+}
+
+func (f Filter) PostPredicates() []predicate.Post {
+	ps := []predicate.Post{}
+
+	if len(f.Authors) > 0 {
+		ps = append(ps, post.HasAuthorWith(account.HandleIn(f.Authors...)))
+	}
+	if len(f.Tags) > 0 {
+		ps = append(ps, post.HasTagsWith(tag.NameIn(f.Tags...)))
+	}
+	if len(f.Categories) > 0 {
+		ps = append(ps, post.RootPostIDIsNil(), post.HasCategoryWith(category.SlugIn(f.Categories...)))
+	}
+	if v, ok := f.CreatedAfter.Get(); ok {
+		ps = append(ps, post.CreatedAtGTE(v))
+	}
+	if v, ok := f.CreatedBefore.Get(); ok {
+		ps = append(ps, post.CreatedAtLT(v))
+	}
+	if v, ok := f.Solved.Get(); ok {
+		ps = append(ps, post.RootPostIDIsNil())
+		if v {
+			ps = append(ps, post.AcceptedReplyIDNotNil())
+		} else {
+			ps = append(ps, post.AcceptedReplyIDIsNil())
+		}
+	}
+	if roles, ok := f.Readable(); ok {
+		if p, ok := thread_querier.PostInReadableCategory(roles).Get(); ok {
+			ps = append(ps, p)
+		}
+	}
+
+	return ps
+}
+
+// NodePredicates returns nil, false when the filter can never match a node.
+func (f Filter) NodePredicates() ([]predicate.Node, bool) {
+	if f.ThreadOnly() {
+		return nil, false
+	}
+
+	ps := []predicate.Node{}
+
+	if len(f.Authors) > 0 {
+		ps = append(ps, node.HasOwnerWith(account.HandleIn(f.Authors...)))
+	}
+	if len(f.Tags) > 0 {
+		ps = append(ps, node.HasTagsWith(tag.NameIn(f.Tags...)))
+	}
+	if v, ok := f.CreatedAfter.Get(); ok {
+		ps = append(ps, node.CreatedAtGTE(v))
+	}
+	if v, ok := f.CreatedBefore.Get(); ok {
+		ps = append(ps, node.CreatedAtLT(v))
+	}
+
+	return ps, true
+}
+
+type Count struct {
+	Value string
+	Label string
+	Count int
+}
+
+type Facets struct {
+	Kinds      []Count
+	Authors    []Count
+	Categories []Count
+	Tags       []Count
+	Solved     int
+	Unsolved   int
+}
@@ -0,0 +1,85 @@
+package facet
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/post"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// FilterRefs narrows a list of references, such as candidates returned from a
+// vector index which has no knowledge of authors or tags, down to those which
+// match the filter. The order of the input list is preserved.
+func (q *Querier) FilterRefs(ctx context.Context, refs []*datagraph.Ref, f Filter) ([]*datagraph.Ref, error) {
+	if f.IsEmpty() || len(refs) == 0 {
+		return refs, nil
+	}
+
+	postIDs := []xid.ID{}
+	nodeIDs := []xid.ID{}
+	for _, r := range refs {
+		switch r.Kind {
+		case datagraph.KindPost, datagraph.KindThread, datagraph.KindReply:
+			postIDs = append(postIDs, r.ID)
+		case datagraph.KindNode:
+			nodeIDs = append(nodeIDs, r.ID)
+		}
+	}
+
+	matched := map[xid.ID]struct{}{}
+
+	if len(postIDs) > 0 {
+		ids, err := q.db.Post.Query().
+			Where(post.IDIn(postIDs...)).
+			Where(f.PostPredicates()...).
+			IDs(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		for _, id := range ids {
+			matched[id] = struct{}{}
+		}
+	}
+
+	if ps, ok := f.NodePredicates(); ok && len(nodeIDs) > 0 {
+		ids, err := q.db.Node.Query().
+			Where(node.IDIn(nodeIDs...)).
+			Where(ps...).
+			IDs(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		for _, id := range ids {
+			matched[id] = struct{}{}
+		}
+	}
+
+	// Profiles have nothing to filter on, so they're only kept when the filter
+	// does nothing more than hide unreadable categories.
+	keepOthers := f.isUnfiltered()
+
+	return dt.Filter(refs, func(r *datagraph.Ref) bool {
+		switch r.Kind {
+		case datagraph.KindPost, datagraph.KindThread, datagraph.KindReply, datagraph.KindNode:
+			return lo.HasKey(matched, r.ID)
+		default:
+			return keepOthers
+		}
+	}), nil
+}
@@ -0,0 +1,346 @@
+// Package fulltext queries the full-text search index maintained by the database
+// layer, Postgres tsvector columns or SQLite FTS5 tables depending on DATABASE_URL.
+package fulltext
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+type Querier struct {
+	raw      *sqlx.DB
+	language string
+	sqlite   bool
+}
+
+func New(cfg config.Config, raw *sqlx.DB) *Querier {
+	sqlite := false
+	switch raw.DriverName() {
+	case "sqlite", "sqlite3", "libsql":
+		sqlite = true
+	}
+
+	return &Querier{raw: raw, language: cfg.SearchLanguage, sqlite: sqlite}
+}
+
+// Each source selects the matching rows of one table as (id, kind, rank) and
+// also the plain text document used for highlighting and the columns used by
+// filters and facets, $1 is the language and $2 is the query.
+const (
+	sourcePosts = `select p.id, case when p.root_post_id is null then 'thread' else 'reply' end as kind, ts_rank_cd(p.search_tsv, q.query) as rank, coalesce(p.title, '') || ' ' || regexp_replace(p.body, '<[^>]*>', ' ', 'g') as document,
+  p.account_posts as author_id, p.category_id, p.created_at, case when p.root_post_id is null then p.accepted_reply_id is not null end as solved
+from posts p, q
+where p.search_tsv @@ q.query and p.deleted_at is null and p.visibility = 'published'`
+
+	sourceNodes = `select n.id, 'node' as kind, ts_rank_cd(n.search_tsv, q.query) as rank, coalesce(n.description, '') || ' ' || regexp_replace(coalesce(n.content, ''), '<[^>]*>', ' ', 'g') as document,
+  n.account_id as author_id, null::text as category_id, n.created_at, null::boolean as solved
+from nodes n, q
+where n.search_tsv @@ q.query and n.deleted_at is null and n.visibility = 'published'`
+
+	sourceProfiles = `select a.id, 'profile' as kind, ts_rank_cd(a.search_tsv, q.query) as rank, a.name || ' ' || coalesce(a.bio, '') as document,
+  null::text as author_id, null::text as category_id, a.created_at, null::boolean as solved
+from accounts a, q
+where a.search_tsv @@ q.query and a.deleted_at is null`
+
+	headlineOptions = `StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2`
+
+	facetLimit = 20
+)
+
+type match struct {
+	ID    string  `db:"id"`
+	Kind  string  `db:"kind"`
+	Rank  float64 `db:"rank"`
+	Total int     `db:"total"`
+}
+
+// params returns the leading arguments for the query, the language and query
+// as $1 and $2 on Postgres and the FTS5 match expression as $1 on SQLite.
+func (q *Querier) params(query string) ([]any, bool) {
+	if q.sqlite {
+		expr, ok := matchExpression(query)
+		return []any{expr}, ok
+	}
+
+	return []any{q.language, query}, true
+}
+
+// matches builds the common table expression "m" for all matching rows with the
+// filter applied, preceded on Postgres by "q" for the parsed query. Arguments
+// are appended to the given list, which must already contain the params.
+func (q *Querier) matches(kinds []datagraph.Kind, f facet.Filter, args *[]any) (string, bool) {
+	sources := sourcesFor(kinds)
+	if q.sqlite {
+		sources = sqliteSourcesFor(kinds)
+	}
+	if len(sources) == 0 {
+		return "", false
+	}
+
+	arg := func(v any) string {
+		*args = append(*args, v)
+		return fmt.Sprintf("$%d", len(*args))
+	}
+
+	anyOf := func(v []string) string {
+		if q.sqlite {
+			return fmt.Sprintf("in (%s)", strings.Join(dt.Map(v, func(s string) string { return arg(s) }), ", "))
+		}
+		return fmt.Sprintf("= any(%s)", arg(v))
+	}
+
+	where := []string{"true"}
+
+	if len(f.Authors) > 0 {
+		where = append(where, fmt.Sprintf(`s.author_id in (select id from accounts where handle %s)`, anyOf(f.Authors)))
+	}
+	if len(f.Tags) > 0 {
+		where = append(where, fmt.Sprintf(`s.id in (
+  select tp.post_id from tag_posts tp join tags t on t.id = tp.tag_id where t.name %s
+  union all
+  select tn.node_id from tag_nodes tn join tags t on t.id = tn.tag_id where t.name %s
+)`, anyOf(f.Tags), anyOf(f.Tags)))
+	}
+	if len(f.Categories) > 0 {
+		where = append(where, fmt.Sprintf(`s.category_id in (select id from categories where slug %s)`, anyOf(f.Categories)))
+	}
+	if v, ok := f.CreatedAfter.Get(); ok {
+		where = append(where, fmt.Sprintf(`s.created_at >= %s`, arg(v)))
+	}
+	if v, ok := f.CreatedBefore.Get(); ok {
+		where = append(where, fmt.Sprintf(`s.created_at < %s`, arg(v)))
+	}
+	if v, ok := f.Solved.Get(); ok {
+		where = append(where, fmt.Sprintf(`s.solved = %s`, arg(v)))
+	}
+	if roles, ok := f.Readable(); ok {
+		// Posts are hidden when their category, or their thread's category for
+		// replies, has permissions and none of them let the roles read it.
+		roleIDs := dt.Map(roles, func(r *role.Role) string { return r.ID.String() })
+		where = append(where, fmt.Sprintf(`s.id not in (
+  select p.id from posts p
+  left join posts r on r.id = p.root_post_id
+  join category_permissions cp on cp.category_id = coalesce(r.category_id, p.category_id)
+  group by p.id
+  having sum(case when cp.can_read and cp.role_id %s then 1 else 0 end) = 0
+)`, anyOf(roleIDs)))
+	}
+
+	m := fmt.Sprintf(`m as (
+  select s.* from (%s) s
+  where %s
+)`, strings.Join(sources, "\nunion all\n"), strings.Join(where, "\n    and "))
+
+	if q.sqlite {
+		return "with " + m, true
+	}
+
+	return "with q as (select websearch_to_tsquery($1::regconfig, $2) as query),\n" + m, true
+}
+
+// Search returns references to the published content matching the query,
+// most relevant first. The query supports web search syntax such as quoted
+// phrases, "or" and negation with a leading "-".
+func (q *Querier) Search(ctx context.Context, query string, p pagination.Parameters, kinds []datagraph.Kind, f facet.Filter) (*pagination.Result[*datagraph.Ref], error) {
+	args, ok := q.params(query)
+	if !ok {
+		result := pagination.NewPageResult(p, 0, []*datagraph.Ref{})
+		return &result, nil
+	}
+
+	cte, ok := q.matches(kinds, f, &args)
+	if !ok {
+		result := pagination.NewPageResult(p, 0, []*datagraph.Ref{})
+		return &result, nil
+	}
+
+	sql := fmt.Sprintf(`%s
+select m.id, m.kind, m.rank, count(*) over () as total
+from m
+order by m.rank desc, m.id
+limit %d offset %d`, cte, p.Limit(), p.Offset())
+
+	rows := []match{}
+	err := q.raw.SelectContext(ctx, &rows, sql, args...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs, err := dt.MapErr(rows, func(m match) (*datagraph.Ref, error) {
+		id, err := xid.FromString(m.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		kind, err := datagraph.NewKind(m.Kind)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datagraph.Ref{ID: id, Kind: kind, Relevance: m.Rank}, nil
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	total := 0
+	if len(rows) > 0 {
+		total = rows[0].Total
+	}
+
+	result := pagination.NewPageResult(p, total, refs)
+
+	return &result, nil
+}
+
+type facetRow struct {
+	Value string `db:"value"`
+	Label string `db:"label"`
+	Count int    `db:"count"`
+}
+
+// Facets counts every match of the query, not just the current page, grouped
+// by each of the filterable dimensions.
+func (q *Querier) Facets(ctx context.Context, query string, kinds []datagraph.Kind, f facet.Filter) (*facet.Facets, error) {
+	args, ok := q.params(query)
+	if !ok {
+		return &facet.Facets{}, nil
+	}
+
+	cte, ok := q.matches(kinds, f, &args)
+	if !ok {
+		return &facet.Facets{}, nil
+	}
+
+	out := &facet.Facets{}
+
+	groups := []struct {
+		dest *[]facet.Count
+		sql  string
+	}{
+		{dest: &out.Kinds, sql: `select m.kind as value, m.kind as label, count(*) as count from m group by m.kind order by count desc`},
+		{dest: &out.Authors, sql: fmt.Sprintf(`select a.handle as value, a.name as label, count(*) as count from m join accounts a on a.id = m.author_id group by a.handle, a.name order by count desc, a.handle limit %d`, facetLimit)},
+		{dest: &out.Categories, sql: fmt.Sprintf(`select c.slug as value, c.name as label, count(*) as count from m join categories c on c.id = m.category_id group by c.slug, c.name order by count desc, c.slug limit %d`, facetLimit)},
+		{dest: &out.Tags, sql: fmt.Sprintf(`select t.name as value, t.name as label, count(*) as count
+from m
+join (
+  select tag_id, post_id as item_id from tag_posts
+  union all
+  select tag_id, node_id as item_id from tag_nodes
+) ti on ti.item_id = m.id
+join tags t on t.id = ti.tag_id
+group by t.name order by count desc, t.name limit %d`, facetLimit)},
+	}
+
+	for _, g := range groups {
+		rows := []facetRow{}
+		if err := q.raw.SelectContext(ctx, &rows, cte+"\n"+g.sql, args...); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		*g.dest = dt.Map(rows, func(r facetRow) facet.Count {
+			return facet.Count{Value: r.Value, Label: r.Label, Count: r.Count}
+		})
+	}
+
+	var solved struct {
+		Solved   int `db:"solved"`
+		Unsolved int `db:"unsolved"`
+	}
+	err := q.raw.GetContext(ctx, &solved, cte+`
+select count(*) filter (where m.solved) as solved, count(*) filter (where not m.solved) as unsolved from m`, args...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out.Solved = solved.Solved
+	out.Unsolved = solved.Unsolved
+
+	return out, nil
+}
+
+type highlight struct {
+	ID        string `db:"id"`
+	Highlight string `db:"highlight"`
+}
+
+// Highlight produces a short excerpt of each of the given items with the terms
+// matching the query wrapped in <mark> tags.
+func (q *Querier) Highlight(ctx context.Context, query string, refs []*datagraph.Ref) (map[xid.ID]string, error) {
+	if len(refs) == 0 {
+		return map[xid.ID]string{}, nil
+	}
+
+	if q.sqlite {
+		return q.highlightSQLite(ctx, query, refs)
+	}
+
+	sources := sourcesFor(lo.Uniq(dt.Map(refs, func(r *datagraph.Ref) datagraph.Kind { return r.Kind })))
+
+	ids := dt.Map(refs, func(r *datagraph.Ref) string { return r.ID.String() })
+
+	sql := fmt.Sprintf(`with q as (select websearch_to_tsquery($1::regconfig, $2) as query)
+select m.id, ts_headline($1::regconfig, m.document, q.query, '%s') as highlight
+from (%s) m, q
+where m.id = any($3)`, headlineOptions, strings.Join(sources, "\nunion all\n"))
+
+	rows := []highlight{}
+	err := q.raw.SelectContext(ctx, &rows, sql, q.language, query, ids)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make(map[xid.ID]string, len(rows))
+	for _, r := range rows {
+		id, err := xid.FromString(r.ID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		out[id] = r.Highlight
+	}
+
+	return out, nil
+}
+
+func sourcesFor(kinds []datagraph.Kind) []string {
+	if len(kinds) == 0 {
+		return []string{sourcePosts, sourceNodes, sourceProfiles}
+	}
+
+	has := func(k datagraph.Kind) bool { return lo.Contains(kinds, k) }
+
+	sources := []string{}
+
+	switch {
+	case has(datagraph.KindPost) || (has(datagraph.KindThread) && has(datagraph.KindReply)):
+		sources = append(sources, sourcePosts)
+	case has(datagraph.KindThread):
+		sources = append(sources, sourcePosts+" and p.root_post_id is null")
+	case has(datagraph.KindReply):
+		sources = append(sources, sourcePosts+" and p.root_post_id is not null")
+	}
+
+	if has(datagraph.KindNode) {
+		sources = append(sources, sourceNodes)
+	}
+
+	if has(datagraph.KindProfile) {
+		sources = append(sources, sourceProfiles)
+	}
+
+	return sources
+}
@@ -0,0 +1,272 @@
+package fulltext
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+// The SQLite sources query the FTS5 tables maintained by the database layer.
+// bm25 is lower for better matches and the weights mirror the Postgres ones,
+// $1 is the FTS5 match expression.
+const (
+	sqliteSourcePosts = `select p.id, case when p.root_post_id is null then 'thread' else 'reply' end as kind, -bm25(posts_fts, 0, 1.0, 0.4) as rank,
+  p.account_posts as author_id, p.category_id, p.created_at, case when p.root_post_id is null then p.accepted_reply_id is not null end as solved
+from posts_fts join posts p on p.id = posts_fts.id
+where posts_fts match $1 and p.deleted_at is null and p.visibility = 'published'`
+
+	sqliteSourceNodes = `select n.id, 'node' as kind, -bm25(nodes_fts, 0, 1.0, 0.4, 0.2) as rank,
+  n.account_id as author_id, null as category_id, n.created_at, null as solved
+from nodes_fts join nodes n on n.id = nodes_fts.id
+where nodes_fts match $1 and n.deleted_at is null and n.visibility = 'published'`
+
+	sqliteSourceProfiles = `select a.id, 'profile' as kind, -bm25(accounts_fts, 0, 1.0, 1.0, 0.4) as rank,
+  null as author_id, null as category_id, a.created_at, null as solved
+from accounts_fts join accounts a on a.id = accounts_fts.id
+where accounts_fts match $1 and a.deleted_at is null`
+
+	// The documents used for highlighting wrap matches in control characters
+	// which are turned into <mark> tags once the HTML has been stripped.
+	sqliteHighlightPosts = `select posts_fts.id, coalesce(highlight(posts_fts, 1, char(2), char(3)), '') || ' ' || highlight(posts_fts, 2, char(2), char(3)) as highlight
+from posts_fts where posts_fts match $1`
+
+	sqliteHighlightNodes = `select nodes_fts.id, coalesce(highlight(nodes_fts, 2, char(2), char(3)), '') || ' ' || highlight(nodes_fts, 3, char(2), char(3)) as highlight
+from nodes_fts where nodes_fts match $1`
+
+	sqliteHighlightProfiles = `select accounts_fts.id, highlight(accounts_fts, 2, char(2), char(3)) || ' ' || highlight(accounts_fts, 3, char(2), char(3)) as highlight
+from accounts_fts where accounts_fts match $1`
+
+	markStart = "\x02"
+	markEnd   = "\x03"
+
+	excerptWords   = 35
+	excerptLeading = 10
+)
+
+// matchExpression translates web search syntax into an FTS5 query so that both
+// databases accept the same input. Every term is quoted which means user input
+// can never be a syntax error, quoted phrases are kept together, "or" between
+// terms is a disjunction and a leading "-" excludes a term. Returns false when
+// nothing in the query can match.
+func matchExpression(query string) (string, bool) {
+	type group struct{ include, exclude []string }
+
+	groups := []group{{}}
+	or := false
+
+	quote := func(s string) string {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+
+	for _, t := range tokenise(query) {
+		if !t.phrase && strings.EqualFold(t.text, "or") {
+			or = true
+			continue
+		}
+
+		if or && len(groups[len(groups)-1].include) > 0 {
+			groups = append(groups, group{})
+		}
+		or = false
+
+		g := &groups[len(groups)-1]
+		if t.negated {
+			g.exclude = append(g.exclude, quote(t.text))
+		} else {
+			g.include = append(g.include, quote(t.text))
+		}
+	}
+
+	clauses := []string{}
+	for _, g := range groups {
+		if len(g.include) == 0 {
+			continue
+		}
+
+		clause := "(" + strings.Join(g.include, " AND ") + ")"
+		for _, e := range g.exclude {
+			clause += " NOT " + e
+		}
+
+		clauses = append(clauses, "("+clause+")")
+	}
+
+	if len(clauses) == 0 {
+		return "", false
+	}
+
+	return strings.Join(clauses, " OR "), true
+}
+
+type token struct {
+	text    string
+	phrase  bool
+	negated bool
+}
+
+func tokenise(query string) []token {
+	tokens := []token{}
+	rs := []rune(query)
+
+	for i := 0; i < len(rs); {
+		if unicode.IsSpace(rs[i]) {
+			i++
+			continue
+		}
+
+		negated := false
+		if rs[i] == '-' && i+1 < len(rs) && !unicode.IsSpace(rs[i+1]) {
+			negated = true
+			i++
+		}
+
+		if rs[i] == '"' {
+			end := i + 1
+			for end < len(rs) && rs[end] != '"' {
+				end++
+			}
+
+			text := strings.TrimSpace(string(rs[i+1 : end]))
+			if text != "" {
+				tokens = append(tokens, token{text: text, phrase: true, negated: negated})
+			}
+
+			i = end + 1
+			continue
+		}
+
+		end := i
+		for end < len(rs) && !unicode.IsSpace(rs[end]) {
+			end++
+		}
+
+		text := strings.TrimFunc(string(rs[i:end]), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		if text != "" {
+			tokens = append(tokens, token{text: text, negated: negated})
+		}
+
+		i = end
+	}
+
+	return tokens
+}
+
+func sqliteSourcesFor(kinds []datagraph.Kind) []string {
+	if len(kinds) == 0 {
+		return []string{sqliteSourcePosts, sqliteSourceNodes, sqliteSourceProfiles}
+	}
+
+	has := func(k datagraph.Kind) bool { return lo.Contains(kinds, k) }
+
+	sources := []string{}
+
+	switch {
+	case has(datagraph.KindPost) || (has(datagraph.KindThread) && has(datagraph.KindReply)):
+		sources = append(sources, sqliteSourcePosts)
+	case has(datagraph.KindThread):
+		sources = append(sources, sqliteSourcePosts+" and p.root_post_id is null")
+	case has(datagraph.KindReply):
+		sources = append(sources, sqliteSourcePosts+" and p.root_post_id is not null")
+	}
+
+	if has(datagraph.KindNode) {
+		sources = append(sources, sqliteSourceNodes)
+	}
+
+	if has(datagraph.KindProfile) {
+		sources = append(sources, sqliteSourceProfiles)
+	}
+
+	return sources
+}
+
+func (q *Querier) highlightSQLite(ctx context.Context, query string, refs []*datagraph.Ref) (map[xid.ID]string, error) {
+	expr, ok := matchExpression(query)
+	if !ok {
+		return map[xid.ID]string{}, nil
+	}
+
+	kinds := lo.Uniq(dt.Map(refs, func(r *datagraph.Ref) datagraph.Kind { return r.Kind }))
+	has := func(k datagraph.Kind) bool { return lo.Contains(kinds, k) }
+
+	sources := []string{}
+	if has(datagraph.KindPost) || has(datagraph.KindThread) || has(datagraph.KindReply) {
+		sources = append(sources, sqliteHighlightPosts)
+	}
+	if has(datagraph.KindNode) {
+		sources = append(sources, sqliteHighlightNodes)
+	}
+	if has(datagraph.KindProfile) {
+		sources = append(sources, sqliteHighlightProfiles)
+	}
+	if len(sources) == 0 {
+		return map[xid.ID]string{}, nil
+	}
+
+	args := []any{expr}
+	placeholders := dt.Map(refs, func(r *datagraph.Ref) string {
+		args = append(args, r.ID.String())
+		return fmt.Sprintf("$%d", len(args))
+	})
+
+	sql := fmt.Sprintf(`select m.id, m.highlight from (%s) m where m.id in (%s)`,
+		strings.Join(sources, "\nunion all\n"), strings.Join(placeholders, ", "))
+
+	rows := []highlight{}
+	err := q.raw.SelectContext(ctx, &rows, sql, args...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make(map[xid.ID]string, len(rows))
+	for _, r := range rows {
+		id, err := xid.FromString(r.ID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		out[id] = excerpt(r.Highlight)
+	}
+
+	return out, nil
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// excerpt strips the HTML from a highlighted document and cuts it down to a
+// window of words around the first match, similar to Postgres' ts_headline.
+func excerpt(doc string) string {
+	words := strings.Fields(htmlTag.ReplaceAllString(doc, " "))
+
+	start := 0
+	for i, w := range words {
+		if strings.Contains(w, markStart) {
+			start = max(0, i-excerptLeading)
+			break
+		}
+	}
+	end := min(len(words), start+excerptWords)
+
+	window := strings.Join(words[start:end], " ")
+
+	// A highlighted phrase may straddle either end of the window.
+	if i := strings.IndexAny(window, markStart+markEnd); i >= 0 && window[i:i+1] == markEnd {
+		window = markStart + window
+	}
+	if strings.Count(window, markStart) > strings.Count(window, markEnd) {
+		window += markEnd
+	}
+
+	return strings.NewReplacer(markStart, "<mark>", markEnd, "</mark>").Replace(window)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/samber/lo"
 
+	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/library/node_querier"
@@ -16,6 +17,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/spanner"
 )
 
@@ -24,6 +26,7 @@ type Hydrator struct {
 	threads     *thread_querier.Querier
 	replies     reply.Repository
 	nodeQuerier *node_querier.Querier
+	profiles    *profile_querier.Querier
 }
 
 func New(
@@ -31,12 +34,14 @@ func New(
 	threads *thread_querier.Querier,
 	replies reply.Repository,
 	nodeQuerier *node_querier.Querier,
+	profiles *profile_querier.Querier,
 ) *Hydrator {
 	return &Hydrator{
 		ins:         ins.Build(),
 		threads:     threads,
 		replies:     replies,
 		nodeQuerier: nodeQuerier,
+		profiles:    profiles,
 	}
 }
 
@@ -104,7 +109,12 @@ func (h *Hydrator) Hydrate(ctx context.Context, refs ...*datagraph.Ref) (datagra
 				// TODO
 
 			case datagraph.KindProfile:
-				// TODO
+				for _, r := range v {
+					i, err := h.profiles.GetByID(ctx, account.AccountID(r.ID))
+					if err == nil {
+						results <- withRelevance{i, r.Relevance}
+					}
+				}
 
 			case datagraph.KindEvent:
 				// TODO
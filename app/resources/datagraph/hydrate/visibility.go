@@ -0,0 +1,23 @@
+package hydrate
+
+import (
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+)
+
+// VisibleTo reports whether a hydrated item may be shown back to the member.
+// Hydration doesn't apply visibility rules so anything that stores references
+// on a member's behalf must check this to avoid revealing others' drafts.
+func VisibleTo(item datagraph.Item, accountID account.AccountID) bool {
+	switch v := item.(type) {
+	case *thread.Thread:
+		return v.Visibility == visibility.VisibilityPublished || v.Author.ID == accountID
+	case *library.Node:
+		return v.Visibility == visibility.VisibilityPublished || v.Owner.ID == accountID
+	}
+
+	return true
+}
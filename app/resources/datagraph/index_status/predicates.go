@@ -0,0 +1,66 @@
+package index_status
+
+import (
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+func postPredicates(kind datagraph.Kind, r Range, after xid.ID) []predicate.Post {
+	ps := []predicate.Post{}
+
+	if kind == datagraph.KindThread {
+		ps = append(ps, ent_post.RootPostIDIsNil())
+	} else {
+		ps = append(ps, ent_post.RootPostIDNotNil())
+	}
+	if !after.IsNil() {
+		ps = append(ps, ent_post.IDGT(after))
+	}
+	if v, ok := r.From.Get(); ok {
+		ps = append(ps, ent_post.UpdatedAtGTE(v))
+	}
+	if v, ok := r.To.Get(); ok {
+		ps = append(ps, ent_post.UpdatedAtLT(v))
+	}
+
+	return ps
+}
+
+func nodePredicates(r Range, after xid.ID) []predicate.Node {
+	ps := []predicate.Node{}
+
+	if !after.IsNil() {
+		ps = append(ps, ent_node.IDGT(after))
+	}
+	if v, ok := r.From.Get(); ok {
+		ps = append(ps, ent_node.UpdatedAtGTE(v))
+	}
+	if v, ok := r.To.Get(); ok {
+		ps = append(ps, ent_node.UpdatedAtLT(v))
+	}
+
+	return ps
+}
+
+// accountPredicates only selects live accounts, there's no way to remove a
+// single profile from the index so deleted accounts are never targeted.
+func accountPredicates(r Range, after xid.ID) []predicate.Account {
+	ps := []predicate.Account{ent_account.DeletedAtIsNil()}
+
+	if !after.IsNil() {
+		ps = append(ps, ent_account.IDGT(after))
+	}
+	if v, ok := r.From.Get(); ok {
+		ps = append(ps, ent_account.UpdatedAtGTE(v))
+	}
+	if v, ok := r.To.Get(); ok {
+		ps = append(ps, ent_account.UpdatedAtLT(v))
+	}
+
+	return ps
+}
@@ -0,0 +1,193 @@
+// Package index_status reports on the indexing bookkeeping of content which
+// is stored in the semantic index, using the indexed_at column of each table.
+package index_status
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+)
+
+// Kinds are the kinds of content which are stored in the semantic index.
+var Kinds = []datagraph.Kind{
+	datagraph.KindThread,
+	datagraph.KindReply,
+	datagraph.KindNode,
+	datagraph.KindProfile,
+}
+
+var ErrUnsupportedKind = fault.New("kind is not indexed", ftag.With(ftag.InvalidArgument))
+
+type Health struct {
+	Kind datagraph.Kind
+	// Published is the number of items which should be present in the index.
+	Published int
+	// Indexed items have been indexed since they were last updated.
+	Indexed int
+	// Stale items have been updated since they were last indexed.
+	Stale int
+	// Missing items have never been indexed.
+	Missing int
+}
+
+// Target is an item to be reindexed, items which are not live (unpublished or
+// deleted) should be removed from the index instead.
+type Target struct {
+	ID   xid.ID
+	Live bool
+}
+
+type Range struct {
+	From opt.Optional[time.Time]
+	To   opt.Optional[time.Time]
+}
+
+type Querier struct {
+	db  *ent.Client
+	raw *sqlx.DB
+}
+
+func New(db *ent.Client, raw *sqlx.DB) *Querier {
+	return &Querier{db: db, raw: raw}
+}
+
+// source is the table and the conditions for items of a kind to be live.
+type source struct {
+	table string
+	scope string
+	live  string
+}
+
+var sources = map[datagraph.Kind]source{
+	datagraph.KindThread:  {table: "posts", scope: "root_post_id is null", live: "visibility = 'published' and deleted_at is null"},
+	datagraph.KindReply:   {table: "posts", scope: "root_post_id is not null", live: "visibility = 'published' and deleted_at is null"},
+	datagraph.KindNode:    {table: "nodes", scope: "true", live: "visibility = 'published' and deleted_at is null"},
+	datagraph.KindProfile: {table: "accounts", scope: "true", live: "deleted_at is null"},
+}
+
+type healthRow struct {
+	Published int `db:"published"`
+	Indexed   int `db:"indexed"`
+	Stale     int `db:"stale"`
+	Missing   int `db:"missing"`
+}
+
+func (q *Querier) Health(ctx context.Context) ([]*Health, error) {
+	out := make([]*Health, 0, len(Kinds))
+
+	for _, k := range Kinds {
+		s := sources[k]
+
+		sql := fmt.Sprintf(`select
+  coalesce(sum(case when %[3]s then 1 else 0 end), 0) as published,
+  coalesce(sum(case when %[3]s and indexed_at is not null and indexed_at >= updated_at then 1 else 0 end), 0) as indexed,
+  coalesce(sum(case when %[3]s and indexed_at is not null and indexed_at < updated_at then 1 else 0 end), 0) as stale,
+  coalesce(sum(case when %[3]s and indexed_at is null then 1 else 0 end), 0) as missing
+from %[1]s
+where %[2]s`, s.table, s.scope, s.live)
+
+		var row healthRow
+		if err := q.raw.GetContext(ctx, &row, sql); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		out = append(out, &Health{
+			Kind:      k,
+			Published: row.Published,
+			Indexed:   row.Indexed,
+			Stale:     row.Stale,
+			Missing:   row.Missing,
+		})
+	}
+
+	return out, nil
+}
+
+// Count returns how many items of a kind were updated within the range.
+func (q *Querier) Count(ctx context.Context, kind datagraph.Kind, r Range) (int, error) {
+	var (
+		n   int
+		err error
+	)
+
+	switch kind {
+	case datagraph.KindThread, datagraph.KindReply:
+		n, err = q.db.Post.Query().Where(postPredicates(kind, r, xid.NilID())...).Count(ctx)
+	case datagraph.KindNode:
+		n, err = q.db.Node.Query().Where(nodePredicates(r, xid.NilID())...).Count(ctx)
+	case datagraph.KindProfile:
+		n, err = q.db.Account.Query().Where(accountPredicates(r, xid.NilID())...).Count(ctx)
+	default:
+		return 0, fault.Wrap(ErrUnsupportedKind, fctx.With(ctx))
+	}
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+// Targets returns a batch of items of a kind updated within the range, in ID
+// order starting after the given cursor. Pass xid.NilID() for the first batch.
+func (q *Querier) Targets(ctx context.Context, kind datagraph.Kind, r Range, after xid.ID, limit int) ([]Target, error) {
+	switch kind {
+	case datagraph.KindThread, datagraph.KindReply:
+		rows, err := q.db.Post.Query().
+			Select(ent_post.FieldID, ent_post.FieldVisibility, ent_post.FieldDeletedAt).
+			Where(postPredicates(kind, r, after)...).
+			Order(ent.Asc(ent_post.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		return dt.Map(rows, func(p *ent.Post) Target {
+			return Target{ID: p.ID, Live: p.Visibility == ent_post.VisibilityPublished && p.DeletedAt == nil}
+		}), nil
+
+	case datagraph.KindNode:
+		rows, err := q.db.Node.Query().
+			Select(ent_node.FieldID, ent_node.FieldVisibility, ent_node.FieldDeletedAt).
+			Where(nodePredicates(r, after)...).
+			Order(ent.Asc(ent_node.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		return dt.Map(rows, func(n *ent.Node) Target {
+			return Target{ID: n.ID, Live: n.Visibility == ent_node.VisibilityPublished && n.DeletedAt == nil}
+		}), nil
+
+	case datagraph.KindProfile:
+		rows, err := q.db.Account.Query().
+			Select(ent_account.FieldID).
+			Where(accountPredicates(r, after)...).
+			Order(ent.Asc(ent_account.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		return dt.Map(rows, func(a *ent.Account) Target {
+			return Target{ID: a.ID, Live: true}
+		}), nil
+
+	default:
+		return nil, fault.Wrap(ErrUnsupportedKind, fctx.With(ctx))
+	}
+}
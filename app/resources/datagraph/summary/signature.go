@@ -0,0 +1,73 @@
+package summary
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+const (
+	signatureSize = 64
+	shingleSize   = 3
+)
+
+// Signature is a MinHash of a piece of text's word shingles. Comparing two
+// signatures estimates how similar the texts are without storing either.
+type Signature []uint64
+
+func NewSignature(text string) Signature {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	sig := make(Signature, signatureSize)
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+
+	if len(words) == 0 {
+		return sig
+	}
+
+	n := min(shingleSize, len(words))
+	for i := 0; i+n <= len(words); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:i+n], " ")))
+		base := h.Sum64()
+
+		for j := range sig {
+			if v := mix(base, uint64(j)); v < sig[j] {
+				sig[j] = v
+			}
+		}
+	}
+
+	return sig
+}
+
+// Similarity estimates the Jaccard similarity of the texts behind two
+// signatures, between 0 and 1.
+func (s Signature) Similarity(other Signature) float64 {
+	if len(s) != len(other) || len(s) == 0 {
+		return 0
+	}
+
+	same := 0
+	for i := range s {
+		if s[i] == other[i] {
+			same++
+		}
+	}
+
+	return float64(same) / float64(len(s))
+}
+
+// mix derives an independent hash per signature slot from a single base hash
+// using the splitmix64 finaliser.
+func mix(h, seed uint64) uint64 {
+	z := h + (seed+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
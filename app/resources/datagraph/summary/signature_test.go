@@ -0,0 +1,26 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignature(t *testing.T) {
+	a := assert.New(t)
+
+	text := strings.Repeat("the quick brown fox jumps over the lazy dog while the cat sleeps. ", 4) +
+		"storyden is a forum and knowledgebase for communities which care about their content."
+
+	a.Equal(1.0, NewSignature(text).Similarity(NewSignature(text)))
+	a.Equal(1.0, NewSignature(text).Similarity(NewSignature(strings.ToUpper(text))), "case and punctuation are ignored")
+
+	edited := text + " a single short sentence appended at the end."
+	a.Greater(NewSignature(text).Similarity(NewSignature(edited)), 0.6)
+
+	unrelated := "an entirely different piece of writing about gardening, soil health and seasonal planting schedules."
+	a.Less(NewSignature(text).Similarity(NewSignature(unrelated)), 0.2)
+
+	a.Zero(NewSignature(text).Similarity(nil))
+}
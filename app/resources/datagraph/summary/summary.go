@@ -0,0 +1,116 @@
+// Package summary stores generated summaries of long content, such as threads
+// with many replies and large library pages.
+package summary
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/contentsummary"
+)
+
+type Summary struct {
+	ItemID    xid.ID
+	ItemKind  datagraph.Kind
+	Text      string
+	Signature Signature
+	UpdatedAt time.Time
+}
+
+func Map(in *ent.ContentSummary) (*Summary, error) {
+	k, err := datagraph.NewKind(in.ItemKind)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Summary{
+		ItemID:    in.ItemID,
+		ItemKind:  k,
+		Text:      in.Summary,
+		Signature: in.Signature,
+		UpdatedAt: in.UpdatedAt,
+	}, nil
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Get(ctx context.Context, id xid.ID) (*Summary, error) {
+	s, err := r.db.ContentSummary.Query().
+		Where(contentsummary.ItemID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(s)
+}
+
+// GetMany returns the summaries which exist for the given items, keyed by item.
+func (r *Repository) GetMany(ctx context.Context, ids ...xid.ID) (map[xid.ID]*Summary, error) {
+	if len(ids) == 0 {
+		return map[xid.ID]*Summary{}, nil
+	}
+
+	rows, err := r.db.ContentSummary.Query().
+		Where(contentsummary.ItemIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	summaries, err := dt.MapErr(rows, Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make(map[xid.ID]*Summary, len(summaries))
+	for _, s := range summaries {
+		out[s.ItemID] = s
+	}
+
+	return out, nil
+}
+
+func (r *Repository) Store(ctx context.Context, kind datagraph.Kind, id xid.ID, text string, sig Signature) error {
+	err := r.db.ContentSummary.Create().
+		SetItemKind(kind.String()).
+		SetItemID(id).
+		SetSummary(text).
+		SetSignature(sig).
+		OnConflictColumns(contentsummary.FieldItemID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id xid.ID) error {
+	_, err := r.db.ContentSummary.Delete().
+		Where(contentsummary.ItemID(id)).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package discord_bridge stores the configuration and message mapping of the
+// two-way bridge between Storyden threads and Discord forum channels.
+package discord_bridge
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
+)
+
+// Forum maps a Storyden category to the Discord forum channel its threads are
+// mirrored to.
+type Forum struct {
+	CategoryID xid.ID
+	ChannelID  string
+}
+
+type Settings struct {
+	Forums []Forum
+}
+
+func (s Settings) ForumFor(cat opt.Optional[category.CategoryID]) (string, bool) {
+	id, ok := cat.Get()
+	if !ok {
+		return "", false
+	}
+
+	for _, f := range s.Forums {
+		if f.CategoryID == xid.ID(id) {
+			return f.ChannelID, true
+		}
+	}
+
+	return "", false
+}
+
+// Link pairs a Storyden post with a Discord message. For thread posts, the
+// message is the forum post's starter message and the channel is the forum
+// post itself, for replies the channel is the forum post they were sent in.
+type Link struct {
+	PostID    post.ID
+	ThreadID  post.ID
+	ChannelID string
+	MessageID string
+}
+
+func mapLink(in *ent.DiscordBridgeLink) *Link {
+	return &Link{
+		PostID:    post.ID(in.PostID),
+		ThreadID:  post.ID(in.ThreadID),
+		ChannelID: in.DiscordChannelID,
+		MessageID: in.DiscordMessageID,
+	}
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(ctx context.Context, l Link) error {
+	err := r.db.DiscordBridgeLink.Create().
+		SetPostID(xid.ID(l.PostID)).
+		SetThreadID(xid.ID(l.ThreadID)).
+		SetDiscordChannelID(l.ChannelID).
+		SetDiscordMessageID(l.MessageID).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByPost(ctx context.Context, id post.ID) (*Link, error) {
+	l, err := r.db.DiscordBridgeLink.Query().
+		Where(discordbridgelink.PostID(xid.ID(id))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapLink(l), nil
+}
+
+func (r *Repository) GetByMessage(ctx context.Context, messageID string) (*Link, error) {
+	l, err := r.db.DiscordBridgeLink.Query().
+		Where(discordbridgelink.DiscordMessageID(messageID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapLink(l), nil
+}
+
+// GetThreadByChannel returns the Storyden thread mirrored to a Discord forum
+// post, identified by the forum post's channel ID.
+func (r *Repository) GetThreadByChannel(ctx context.Context, channelID string) (post.ID, error) {
+	l, err := r.db.DiscordBridgeLink.Query().
+		Where(discordbridgelink.DiscordChannelID(channelID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return post.ID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return post.ID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return post.ID(l.ThreadID), nil
+}
@@ -2,14 +2,21 @@ package event_querier
 
 import (
 	"context"
+	"time"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/event"
 	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/event/participation"
+	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/internal/ent"
+	ent_event "github.com/Southclaws/storyden/internal/ent/event"
+	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
 )
 
 type Querier struct {
@@ -62,11 +69,49 @@ func (q *Querier) Get(ctx context.Context, mark event_ref.QueryKey) (*event.Even
 	return evt, nil
 }
 
-func (q *Querier) List(ctx context.Context) ([]*event_ref.Event, error) {
+type Query func(q *ent.EventQuery)
+
+func HasStatus(status ...visibility.Visibility) Query {
+	ev := dt.Map(status, func(v visibility.Visibility) ent_event.Visibility { return ent_event.Visibility(v.String()) })
+	return func(q *ent.EventQuery) {
+		q.Where(ent_event.VisibilityIn(ev...))
+	}
+}
+
+func HasNotBeenDeleted() Query {
+	return func(q *ent.EventQuery) {
+		q.Where(ent_event.DeletedAtIsNil())
+	}
+}
+
+func HasEndedAfter(t time.Time) Query {
+	return func(q *ent.EventQuery) {
+		q.Where(ent_event.EndTimeGT(t))
+	}
+}
+
+func HasParticipant(id account.AccountID, status ...participation.Status) Query {
+	ss := dt.Map(status, func(s participation.Status) string { return s.String() })
+	return func(q *ent.EventQuery) {
+		q.Where(ent_event.HasParticipantsWith(
+			eventparticipant.AccountID(xid.ID(id)),
+			eventparticipant.StatusIn(ss...),
+		))
+	}
+}
+
+func (q *Querier) List(ctx context.Context, filters ...Query) ([]*event_ref.Event, error) {
 	query := q.db.Event.Query()
 
-	query.WithParticipants()
+	query.WithParticipants(func(epq *ent.EventParticipantQuery) {
+		epq.WithAccount()
+	})
 	query.WithPrimaryImage()
+	query.Order(ent.Asc(ent_event.FieldStartTime))
+
+	for _, fn := range filters {
+		fn(query)
+	}
 
 	r, err := query.All(ctx)
 	if err != nil {
@@ -80,3 +125,35 @@ func (q *Querier) List(ctx context.Context) ([]*event_ref.Event, error) {
 
 	return evts, nil
 }
+
+// ListWithThreads is List but also yields each event's discussion thread, for
+// consumers that need to link out to where the event is discussed.
+func (q *Querier) ListWithThreads(ctx context.Context, filters ...Query) ([]*event.Event, error) {
+	query := q.db.Event.Query()
+
+	query.WithParticipants(func(epq *ent.EventParticipantQuery) {
+		epq.WithAccount()
+	})
+	query.WithPrimaryImage()
+	query.WithThread(func(pq *ent.PostQuery) {
+		pq.WithCategory()
+		pq.WithAuthor()
+	})
+	query.Order(ent.Asc(ent_event.FieldStartTime))
+
+	for _, fn := range filters {
+		fn(query)
+	}
+
+	r, err := query.All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	evts, err := dt.MapErr(r, event.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return evts, nil
+}
@@ -0,0 +1,110 @@
+// Package feature_flag describes flags which gate features that are rolled out
+// gradually to specific accounts, roles or a percentage of members.
+package feature_flag
+
+import (
+	"hash/fnv"
+	"slices"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type FlagID xid.ID
+
+func (i FlagID) String() string { return xid.ID(i).String() }
+
+type Flag struct {
+	ID          FlagID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Key         string
+	Description opt.Optional[string]
+
+	// Enabled is the flag's kill switch, a disabled flag is off for everyone
+	// regardless of who it targets.
+	Enabled bool
+
+	// Percentage of members, from 0 to 100, the flag is rolled out to on top
+	// of the accounts and roles it explicitly targets.
+	Percentage int
+
+	Roles    []role.RoleID
+	Accounts []account.AccountID
+}
+
+type Flags []*Flag
+
+// Evaluate reports whether the flag is on for an account holding the given
+// roles. Guests only see flags which are rolled out to every member.
+func (f *Flag) Evaluate(accountID opt.Optional[account.AccountID], roles []role.RoleID) bool {
+	if !f.Enabled {
+		return false
+	}
+
+	if f.Percentage >= 100 {
+		return true
+	}
+
+	id, ok := accountID.Get()
+	if !ok {
+		return false
+	}
+
+	if slices.Contains(f.Accounts, id) {
+		return true
+	}
+
+	for _, r := range roles {
+		if slices.Contains(f.Roles, r) {
+			return true
+		}
+	}
+
+	return f.Percentage > 0 && Bucket(f.Key, id) < f.Percentage
+}
+
+// Bucket places an account into one of 100 buckets for a flag. The bucket is
+// stable so raising a flag's percentage only ever adds members to it.
+func Bucket(key string, id account.AccountID) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write(xid.ID(id).Bytes())
+	return int(h.Sum32() % 100)
+}
+
+func Map(in *ent.FeatureFlag) (*Flag, error) {
+	roles, err := dt.MapErr(in.Roles, func(s string) (role.RoleID, error) {
+		id, err := xid.FromString(s)
+		return role.RoleID(id), err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := dt.MapErr(in.Accounts, func(s string) (account.AccountID, error) {
+		id, err := xid.FromString(s)
+		return account.AccountID(id), err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Flag{
+		ID:          FlagID(in.ID),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Key:         in.Key,
+		Description: opt.NewPtr(in.Description),
+		Enabled:     in.Enabled,
+		Percentage:  in.Percentage,
+		Roles:       roles,
+		Accounts:    accounts,
+	}, nil
+}
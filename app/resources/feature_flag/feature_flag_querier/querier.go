@@ -0,0 +1,57 @@
+package feature_flag_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/feature_flag"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_feature_flag "github.com/Southclaws/storyden/internal/ent/featureflag"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) List(ctx context.Context) (feature_flag.Flags, error) {
+	r, err := q.db.FeatureFlag.Query().
+		Order(ent.Asc(ent_feature_flag.FieldKey)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	flags, err := dt.MapErr(r, feature_flag.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return flags, nil
+}
+
+func (q *Querier) Get(ctx context.Context, key string) (*feature_flag.Flag, error) {
+	r, err := q.db.FeatureFlag.Query().
+		Where(ent_feature_flag.Key(key)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	flag, err := feature_flag.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return flag, nil
+}
@@ -0,0 +1,75 @@
+package feature_flag
+
+import (
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+)
+
+func TestEvaluate(t *testing.T) {
+	a := assert.New(t)
+
+	member := account.AccountID(xid.New())
+	targeted := account.AccountID(xid.New())
+	beta := role.RoleID(xid.New())
+
+	f := &Flag{
+		Key:      "example",
+		Enabled:  true,
+		Roles:    []role.RoleID{beta},
+		Accounts: []account.AccountID{targeted},
+	}
+
+	a.True(f.Evaluate(opt.New(targeted), nil))
+	a.True(f.Evaluate(opt.New(member), []role.RoleID{beta}))
+	a.False(f.Evaluate(opt.New(member), nil))
+	a.False(f.Evaluate(opt.NewEmpty[account.AccountID](), nil))
+
+	f.Enabled = false
+	a.False(f.Evaluate(opt.New(targeted), nil))
+}
+
+func TestEvaluatePercentage(t *testing.T) {
+	a := assert.New(t)
+
+	members := make([]account.AccountID, 1000)
+	for i := range members {
+		members[i] = account.AccountID(xid.New())
+	}
+
+	count := func(f *Flag) (n int, included map[account.AccountID]bool) {
+		included = map[account.AccountID]bool{}
+		for _, m := range members {
+			if f.Evaluate(opt.New(m), nil) {
+				n++
+				included[m] = true
+			}
+		}
+		return
+	}
+
+	f := &Flag{Key: "rollout", Enabled: true, Percentage: 20}
+	n20, at20 := count(f)
+	a.InDelta(200, n20, 60)
+
+	f.Percentage = 50
+	n50, at50 := count(f)
+	a.InDelta(500, n50, 80)
+
+	for m := range at20 {
+		a.True(at50[m], "members keep the flag as the percentage rises")
+	}
+
+	f.Percentage = 0
+	n0, _ := count(f)
+	a.Zero(n0)
+
+	f.Percentage = 100
+	n100, _ := count(f)
+	a.Equal(len(members), n100)
+}
@@ -0,0 +1,128 @@
+package feature_flag_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/feature_flag"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_feature_flag "github.com/Southclaws/storyden/internal/ent/featureflag"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.FeatureFlagMutation)
+
+func WithDescription(v string) Option {
+	return func(m *ent.FeatureFlagMutation) {
+		if v == "" {
+			m.ClearDescription()
+			return
+		}
+		m.SetDescription(v)
+	}
+}
+
+func WithEnabled(v bool) Option {
+	return func(m *ent.FeatureFlagMutation) {
+		m.SetEnabled(v)
+	}
+}
+
+func WithPercentage(v int) Option {
+	return func(m *ent.FeatureFlagMutation) {
+		m.SetPercentage(v)
+	}
+}
+
+func WithRoles(v []role.RoleID) Option {
+	return func(m *ent.FeatureFlagMutation) {
+		m.SetRoles(dt.Map(v, func(id role.RoleID) string { return id.String() }))
+	}
+}
+
+func WithAccounts(v []account.AccountID) Option {
+	return func(m *ent.FeatureFlagMutation) {
+		m.SetAccounts(dt.Map(v, func(id account.AccountID) string { return id.String() }))
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, key string, opts ...Option) (*feature_flag.Flag, error) {
+	create := w.db.FeatureFlag.Create()
+	mutation := create.Mutation()
+
+	mutation.SetKey(key)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	flag, err := feature_flag.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return flag, nil
+}
+
+func (w *Writer) Update(ctx context.Context, key string, opts ...Option) (*feature_flag.Flag, error) {
+	r, err := w.db.FeatureFlag.Query().Where(ent_feature_flag.Key(key)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	update := w.db.FeatureFlag.UpdateOne(r)
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err = update.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	flag, err := feature_flag.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return flag, nil
+}
+
+func (w *Writer) Delete(ctx context.Context, key string) error {
+	n, err := w.db.FeatureFlag.Delete().Where(ent_feature_flag.Key(key)).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if n == 0 {
+		return fault.New("feature flag not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return nil
+}
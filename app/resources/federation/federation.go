@@ -0,0 +1,49 @@
+// Package federation describes the local ActivityPub actors which remote
+// servers can follow and the records kept about those followers.
+package federation
+
+import (
+	"strings"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+)
+
+// LocalActor identifies an actor on this instance. The instance actor is the
+// literal "instance", members are identified by their account ID.
+type LocalActor string
+
+const InstanceActor LocalActor = "instance"
+
+func AccountActor(id account.AccountID) LocalActor {
+	return LocalActor("account:" + id.String())
+}
+
+func (a LocalActor) AccountID() opt.Optional[account.AccountID] {
+	raw, ok := strings.CutPrefix(string(a), "account:")
+	if !ok {
+		return opt.NewEmpty[account.AccountID]()
+	}
+
+	id, err := xid.FromString(raw)
+	if err != nil {
+		return opt.NewEmpty[account.AccountID]()
+	}
+
+	return opt.New(account.AccountID(id))
+}
+
+type Follower struct {
+	LocalActor  LocalActor
+	ActorURI    string
+	Inbox       string
+	SharedInbox opt.Optional[string]
+}
+
+// DeliveryInbox is the inbox activities for this follower should be sent to,
+// servers which support a shared inbox receive one copy for all followers.
+func (f Follower) DeliveryInbox() string {
+	return f.SharedInbox.Or(f.Inbox)
+}
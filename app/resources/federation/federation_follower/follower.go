@@ -0,0 +1,89 @@
+package federation_follower
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Add(ctx context.Context, f federation.Follower) error {
+	err := r.db.FederationFollower.Create().
+		SetLocalActor(string(f.LocalActor)).
+		SetActorURI(f.ActorURI).
+		SetInbox(f.Inbox).
+		SetNillableSharedInbox(f.SharedInbox.Ptr()).
+		OnConflictColumns(federationfollower.FieldLocalActor, federationfollower.FieldActorURI).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) Remove(ctx context.Context, actor federation.LocalActor, actorURI string) error {
+	_, err := r.db.FederationFollower.Delete().
+		Where(
+			federationfollower.LocalActor(string(actor)),
+			federationfollower.ActorURI(actorURI),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) Count(ctx context.Context, actor federation.LocalActor) (int, error) {
+	n, err := r.db.FederationFollower.Query().
+		Where(federationfollower.LocalActor(string(actor))).
+		Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+// Inboxes returns the distinct inboxes that activities from the actor must be
+// delivered to in order to reach all of its followers.
+func (r *Repository) Inboxes(ctx context.Context, actor federation.LocalActor) ([]string, error) {
+	rows, err := r.db.FederationFollower.Query().
+		Where(federationfollower.LocalActor(string(actor))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	seen := map[string]bool{}
+	inboxes := []string{}
+	for _, row := range rows {
+		inbox := federation.Follower{
+			Inbox:       row.Inbox,
+			SharedInbox: opt.NewPtr(row.SharedInbox),
+		}.DeliveryInbox()
+
+		if !seen[inbox] {
+			seen[inbox] = true
+			inboxes = append(inboxes, inbox)
+		}
+	}
+
+	return inboxes, nil
+}
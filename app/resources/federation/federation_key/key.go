@@ -0,0 +1,90 @@
+package federation_key
+
+import (
+	"context"
+	"crypto/rsa"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/federationkey"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpsig"
+)
+
+type KeyPair struct {
+	Private   *rsa.PrivateKey
+	PublicPEM string
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Get returns the actor's signing key, generating and storing one the first
+// time the actor is used.
+func (r *Repository) Get(ctx context.Context, actor federation.LocalActor) (*KeyPair, error) {
+	k, err := r.db.FederationKey.Query().
+		Where(federationkey.LocalActor(string(actor))).
+		Only(ctx)
+	if err == nil {
+		return mapKeyPair(k)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	key, err := httpsig.GenerateKey()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	private, err := httpsig.EncodePrivateKey(key)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	public, err := httpsig.EncodePublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Two concurrent requests may both generate a key, the first one stored
+	// wins and the other is discarded by re-reading after the insert.
+	err = r.db.FederationKey.Create().
+		SetLocalActor(string(actor)).
+		SetPrivateKey(private).
+		SetPublicKey(public).
+		OnConflictColumns(federationkey.FieldLocalActor).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	k, err = r.db.FederationKey.Query().
+		Where(federationkey.LocalActor(string(actor))).
+		Only(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapKeyPair(k)
+}
+
+func mapKeyPair(in *ent.FederationKey) (*KeyPair, error) {
+	key, err := httpsig.ParsePrivateKey(in.PrivateKey)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &KeyPair{
+		Private:   key,
+		PublicPEM: in.PublicKey,
+	}, nil
+}
@@ -0,0 +1,124 @@
+// Package import_run stores imports of archives from other platforms and the
+// record of what each item in an archive became, which is also what makes an
+// import safe to resume or repeat.
+package import_run
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type statusEnum string
+
+const (
+	statusPending   statusEnum = "pending"
+	statusRunning   statusEnum = "running"
+	statusSucceeded statusEnum = "succeeded"
+	statusFailed    statusEnum = "failed"
+)
+
+type kindEnum string
+
+const (
+	kindMember   kindEnum = "member"
+	kindCategory kindEnum = "category"
+	kindUpload   kindEnum = "upload"
+	kindThread   kindEnum = "thread"
+	kindPost     kindEnum = "post"
+	kindLike     kindEnum = "like"
+)
+
+type recordStatusEnum string
+
+const (
+	recordStatusImported recordStatusEnum = "imported"
+	recordStatusSkipped  recordStatusEnum = "skipped"
+	recordStatusFailed   recordStatusEnum = "failed"
+)
+
+type RunID xid.ID
+
+func (i RunID) String() string { return xid.ID(i).String() }
+
+type Run struct {
+	ID         RunID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Source     string
+	AccountID  account.AccountID
+	AssetID    opt.Optional[asset.AssetID]
+	Status     Status
+	Error      opt.Optional[string]
+	FinishedAt opt.Optional[time.Time]
+}
+
+// KindStats counts the records of one kind of item in each status.
+type KindStats struct {
+	Kind     Kind
+	Imported int
+	Skipped  int
+	Failed   int
+}
+
+type Record struct {
+	ID         xid.ID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	RunID      RunID
+	Kind       Kind
+	ExternalID string
+	InternalID opt.Optional[xid.ID]
+	Status     RecordStatus
+	Message    opt.Optional[string]
+}
+
+func Map(in *ent.ImportRun) (*Run, error) {
+	status, err := NewStatus(in.Status.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Run{
+		ID:         RunID(in.ID),
+		CreatedAt:  in.CreatedAt,
+		UpdatedAt:  in.UpdatedAt,
+		Source:     in.Source,
+		AccountID:  account.AccountID(in.AccountID),
+		AssetID:    opt.NewPtr(in.AssetID),
+		Status:     status,
+		Error:      opt.NewPtr(in.Error),
+		FinishedAt: opt.NewPtr(in.FinishedAt),
+	}, nil
+}
+
+func MapRecord(in *ent.ImportRecord) (*Record, error) {
+	kind, err := NewKind(in.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := NewRecordStatus(in.Status.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Record{
+		ID:         in.ID,
+		CreatedAt:  in.CreatedAt,
+		UpdatedAt:  in.UpdatedAt,
+		RunID:      RunID(in.RunID),
+		Kind:       kind,
+		ExternalID: in.ExternalID,
+		InternalID: opt.NewPtr(in.InternalID),
+		Status:     status,
+		Message:    opt.NewPtr(in.Message),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package import_run
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+)
+
+// Lookup finds the record for an item from a source, regardless of which run
+// created it.
+func (r *Repository) Lookup(ctx context.Context, source string, kind Kind, externalID string) (*Record, bool, error) {
+	rec, err := r.db.ImportRecord.Query().
+		Where(
+			importrecord.Source(source),
+			importrecord.Kind(kind.String()),
+			importrecord.ExternalID(externalID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	mapped, err := MapRecord(rec)
+	if err != nil {
+		return nil, false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapped, true, nil
+}
+
+// Put stores the outcome of importing an item, replacing any earlier outcome
+// such as a failure which has since been retried.
+func (r *Repository) Put(ctx context.Context,
+	runID RunID,
+	source string,
+	kind Kind,
+	externalID string,
+	internalID opt.Optional[xid.ID],
+	status RecordStatus,
+	message opt.Optional[string],
+) error {
+	err := r.db.ImportRecord.Create().
+		SetRunID(xid.ID(runID)).
+		SetSource(source).
+		SetKind(kind.String()).
+		SetExternalID(externalID).
+		SetNillableInternalID(internalID.Ptr()).
+		SetStatus(importrecord.Status(status.String())).
+		SetNillableMessage(message.Ptr()).
+		OnConflictColumns(
+			importrecord.FieldSource,
+			importrecord.FieldKind,
+			importrecord.FieldExternalID,
+		).
+		UpdateRunID().
+		UpdateInternalID().
+		UpdateStatus().
+		UpdateMessage().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+type RecordQuery func(*ent.ImportRecordQuery)
+
+func WithRecordStatus(s RecordStatus) RecordQuery {
+	return func(q *ent.ImportRecordQuery) {
+		q.Where(importrecord.StatusEQ(importrecord.Status(s.String())))
+	}
+}
+
+func WithRecordKind(k Kind) RecordQuery {
+	return func(q *ent.ImportRecordQuery) {
+		q.Where(importrecord.Kind(k.String()))
+	}
+}
+
+func (r *Repository) ListRecords(ctx context.Context, id RunID, page pagination.Parameters, opts ...RecordQuery) (pagination.Result[*Record], error) {
+	query := r.db.ImportRecord.Query().
+		Where(importrecord.RunID(xid.ID(id)))
+
+	for _, fn := range opts {
+		fn(query)
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return pagination.Result[*Record]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		Order(ent.Asc(importrecord.FieldCreatedAt), ent.Asc(importrecord.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return pagination.Result[*Record]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	records, err := dt.MapErr(result, MapRecord)
+	if err != nil {
+		return pagination.Result[*Record]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pagination.NewPageResult(page, total, records), nil
+}
@@ -0,0 +1,218 @@
+package import_run
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(ctx context.Context, source string, accountID account.AccountID, assetID opt.Optional[asset.AssetID]) (*Run, error) {
+	create := r.db.ImportRun.Create().
+		SetSource(source).
+		SetAccountID(xid.ID(accountID)).
+		SetNillableAssetID(assetID.Ptr())
+
+	run, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(run)
+}
+
+func (r *Repository) Get(ctx context.Context, id RunID) (*Run, error) {
+	run, err := r.db.ImportRun.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(run)
+}
+
+func (r *Repository) List(ctx context.Context, page pagination.Parameters) (pagination.Result[*Run], error) {
+	query := r.db.ImportRun.Query()
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return pagination.Result[*Run]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		Order(ent.Desc(importrun.FieldCreatedAt), ent.Desc(importrun.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return pagination.Result[*Run]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	runs, err := dt.MapErr(result, Map)
+	if err != nil {
+		return pagination.Result[*Run]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pagination.NewPageResult(page, total, runs), nil
+}
+
+// Start marks a run as running. Runs which already finished are left alone
+// and false is returned so a stray job doesn't repeat them. Running runs may
+// be started again, as that's what happens when a job is interrupted.
+func (r *Repository) Start(ctx context.Context, id RunID) (bool, error) {
+	n, err := r.db.ImportRun.Update().
+		Where(
+			importrun.ID(xid.ID(id)),
+			importrun.StatusIn(importrun.StatusPending, importrun.StatusRunning),
+		).
+		SetStatus(importrun.StatusRunning).
+		Save(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n > 0, nil
+}
+
+// Pause returns a running run to pending, for when it will be continued by
+// another job.
+func (r *Repository) Pause(ctx context.Context, id RunID) error {
+	err := r.db.ImportRun.UpdateOneID(xid.ID(id)).
+		SetStatus(importrun.StatusPending).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) Succeed(ctx context.Context, id RunID, now time.Time) error {
+	err := r.db.ImportRun.UpdateOneID(xid.ID(id)).
+		SetStatus(importrun.StatusSucceeded).
+		SetFinishedAt(now).
+		ClearError().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) Fail(ctx context.Context, id RunID, now time.Time, reason string) error {
+	err := r.db.ImportRun.UpdateOneID(xid.ID(id)).
+		SetStatus(importrun.StatusFailed).
+		SetFinishedAt(now).
+		SetError(reason).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Resume returns a failed run to pending so it can carry on from where it
+// stopped. Items which were already imported are skipped.
+func (r *Repository) Resume(ctx context.Context, id RunID) (*Run, error) {
+	n, err := r.db.ImportRun.Update().
+		Where(
+			importrun.ID(xid.ID(id)),
+			importrun.StatusEQ(importrun.StatusFailed),
+		).
+		SetStatus(importrun.StatusPending).
+		ClearFinishedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if n == 0 {
+		run, err := r.Get(ctx, id)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return nil, fault.New("import is not failed",
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("not failed", "Only failed imports can be resumed, this one is "+run.Status.String()+"."),
+		)
+	}
+
+	return r.Get(ctx, id)
+}
+
+func (r *Repository) Stats(ctx context.Context, id RunID) ([]*KindStats, error) {
+	var rows []struct {
+		Kind   string `json:"kind"`
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+
+	err := r.db.ImportRecord.Query().
+		Where(importrecord.RunID(xid.ID(id))).
+		GroupBy(importrecord.FieldKind, importrecord.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	byKind := map[string]*KindStats{}
+	stats := []*KindStats{}
+	for _, row := range rows {
+		s, ok := byKind[row.Kind]
+		if !ok {
+			kind, err := NewKind(row.Kind)
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+
+			s = &KindStats{Kind: kind}
+			byKind[row.Kind] = s
+			stats = append(stats, s)
+		}
+
+		switch row.Status {
+		case string(recordStatusImported):
+			s.Imported = row.Count
+		case string(recordStatusSkipped):
+			s.Skipped = row.Count
+		case string(recordStatusFailed):
+			s.Failed = row.Count
+		}
+	}
+
+	slices.SortFunc(stats, func(a, b *KindStats) int {
+		return strings.Compare(a.Kind.String(), b.Kind.String())
+	})
+
+	return stats, nil
+}
@@ -0,0 +1,75 @@
+// Package job persists background work so it survives restarts and failed
+// attempts can be retried before the job is moved aside as dead.
+package job
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type statusEnum string
+
+const (
+	statusPending   statusEnum = "pending"
+	statusRunning   statusEnum = "running"
+	statusSucceeded statusEnum = "succeeded"
+	statusDead      statusEnum = "dead"
+)
+
+type JobID xid.ID
+
+func (i JobID) String() string { return xid.ID(i).String() }
+
+type Job struct {
+	ID          JobID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Kind        string
+	Payload     []byte
+	UniqueKey   opt.Optional[string]
+	Status      Status
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	StartedAt   opt.Optional[time.Time]
+	FinishedAt  opt.Optional[time.Time]
+	LastError   opt.Optional[string]
+}
+
+// KindStats counts the jobs of one kind in each status.
+type KindStats struct {
+	Kind      string
+	Pending   int
+	Running   int
+	Succeeded int
+	Dead      int
+}
+
+func Map(in *ent.Job) (*Job, error) {
+	status, err := NewStatus(in.Status.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		ID:          JobID(in.ID),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Kind:        in.Kind,
+		Payload:     in.Payload,
+		UniqueKey:   opt.NewPtr(in.UniqueKey),
+		Status:      status,
+		Attempts:    in.Attempts,
+		MaxAttempts: in.MaxAttempts,
+		RunAt:       in.RunAt,
+		StartedAt:   opt.NewPtr(in.StartedAt),
+		FinishedAt:  opt.NewPtr(in.FinishedAt),
+		LastError:   opt.NewPtr(in.LastError),
+	}, nil
+}
@@ -0,0 +1,373 @@
+package job
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	entjob "github.com/Southclaws/storyden/internal/ent/job"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// activeUniqueKey is the predicate of the partial unique index on unique_key.
+const activeUniqueKey = "status IN ('pending', 'running')"
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+type Option func(*ent.JobMutation)
+
+func WithUniqueKey(key string) Option {
+	return func(m *ent.JobMutation) {
+		m.SetUniqueKey(key)
+	}
+}
+
+func WithRunAt(t time.Time) Option {
+	return func(m *ent.JobMutation) {
+		m.SetRunAt(t)
+	}
+}
+
+func WithMaxAttempts(n int) Option {
+	return func(m *ent.JobMutation) {
+		m.SetMaxAttempts(n)
+	}
+}
+
+// Enqueue stores a new pending job. When the job has a unique key and another
+// job with that key is still pending or running, that job is returned instead.
+func (r *Repository) Enqueue(ctx context.Context, kind string, payload []byte, maxAttempts int, opts ...Option) (*Job, error) {
+	create := r.db.Job.Create().
+		SetKind(kind).
+		SetPayload(payload).
+		SetMaxAttempts(maxAttempts).
+		SetRunAt(time.Now())
+
+	mutation := create.Mutation()
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	if _, ok := mutation.UniqueKey(); !ok {
+		j, err := create.Save(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return Map(j)
+	}
+
+	// The unique index only covers active jobs, so the conflict target must
+	// repeat its predicate. On conflict the existing job's ID is returned.
+	id, err := create.
+		OnConflict(
+			sql.ConflictColumns(entjob.FieldUniqueKey),
+			sql.ConflictWhere(sql.ExprP(activeUniqueKey)),
+		).
+		Ignore().
+		ID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r.Get(ctx, JobID(id))
+}
+
+// Claim marks up to limit due jobs of the given kinds as running and returns
+// them. Each job is claimed with a conditional update so when several workers
+// poll the same database only one of them will run a given job.
+func (r *Repository) Claim(ctx context.Context, kinds []string, now time.Time, limit int) ([]*Job, error) {
+	if len(kinds) == 0 || limit <= 0 {
+		return nil, nil
+	}
+
+	candidates, err := r.db.Job.Query().
+		Where(
+			entjob.StatusEQ(entjob.StatusPending),
+			entjob.RunAtLTE(now),
+			entjob.KindIn(kinds...),
+		).
+		Order(ent.Asc(entjob.FieldRunAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	claimed := []*Job{}
+	for _, c := range candidates {
+		n, err := r.db.Job.Update().
+			Where(
+				entjob.ID(c.ID),
+				entjob.StatusEQ(entjob.StatusPending),
+			).
+			SetStatus(entjob.StatusRunning).
+			SetStartedAt(now).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if n == 0 {
+			continue
+		}
+
+		j, err := Map(c)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		j.Status = StatusRunning
+		j.StartedAt = opt.New(now)
+		j.Attempts++
+
+		claimed = append(claimed, j)
+	}
+
+	return claimed, nil
+}
+
+func (r *Repository) Succeed(ctx context.Context, id JobID, now time.Time) error {
+	err := r.db.Job.UpdateOneID(xid.ID(id)).
+		SetStatus(entjob.StatusSucceeded).
+		SetFinishedAt(now).
+		ClearLastError().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Fail records a failed attempt. The job is scheduled to run again at retryAt
+// or, when there's no retry, moved to the dead state for an admin to inspect.
+func (r *Repository) Fail(ctx context.Context, id JobID, now time.Time, reason string, retryAt opt.Optional[time.Time]) error {
+	update := r.db.Job.UpdateOneID(xid.ID(id)).
+		SetLastError(reason)
+
+	if at, ok := retryAt.Get(); ok {
+		update.SetStatus(entjob.StatusPending).SetRunAt(at)
+	} else {
+		update.SetStatus(entjob.StatusDead).SetFinishedAt(now)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// ReleaseStale returns jobs which started before the given time and never
+// finished, usually because the process running them stopped, to pending. The
+// interrupted run counts as an attempt so jobs which have none left are dead.
+func (r *Repository) ReleaseStale(ctx context.Context, startedBefore time.Time) (int, error) {
+	const reason = "job was interrupted before it finished"
+
+	now := time.Now()
+	exhausted := predicate.Job(sql.FieldsGTE(entjob.FieldAttempts, entjob.FieldMaxAttempts))
+
+	dead, err := r.db.Job.Update().
+		Where(
+			entjob.StatusEQ(entjob.StatusRunning),
+			entjob.StartedAtLT(startedBefore),
+			exhausted,
+		).
+		SetStatus(entjob.StatusDead).
+		SetFinishedAt(now).
+		SetLastError(reason).
+		Save(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	released, err := r.db.Job.Update().
+		Where(
+			entjob.StatusEQ(entjob.StatusRunning),
+			entjob.StartedAtLT(startedBefore),
+			entjob.Not(exhausted),
+		).
+		SetStatus(entjob.StatusPending).
+		SetRunAt(now).
+		SetLastError(reason).
+		Save(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dead + released, nil
+}
+
+type Query func(*ent.JobQuery)
+
+func WithStatus(s Status) Query {
+	return func(q *ent.JobQuery) {
+		q.Where(entjob.StatusEQ(entjob.Status(s.String())))
+	}
+}
+
+func WithKind(kind string) Query {
+	return func(q *ent.JobQuery) {
+		q.Where(entjob.Kind(kind))
+	}
+}
+
+func (r *Repository) List(ctx context.Context, page pagination.Parameters, opts ...Query) (pagination.Result[*Job], error) {
+	query := r.db.Job.Query()
+
+	for _, fn := range opts {
+		fn(query)
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return pagination.Result[*Job]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		Order(ent.Desc(entjob.FieldUpdatedAt), ent.Desc(entjob.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return pagination.Result[*Job]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	jobs, err := dt.MapErr(result, Map)
+	if err != nil {
+		return pagination.Result[*Job]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pagination.NewPageResult(page, total, jobs), nil
+}
+
+func (r *Repository) Get(ctx context.Context, id JobID) (*Job, error) {
+	j, err := r.db.Job.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(j)
+}
+
+// Retry resets a dead job so it runs again with a fresh set of attempts.
+func (r *Repository) Retry(ctx context.Context, id JobID) (*Job, error) {
+	j, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if j.Status != StatusDead {
+		return nil, fault.New("job is not dead", fctx.With(ctx), ftag.With(ftag.InvalidArgument), fmsg.WithDesc("not dead", "Only jobs which have run out of attempts can be retried."))
+	}
+
+	updated, err := r.db.Job.UpdateOneID(xid.ID(id)).
+		SetStatus(entjob.StatusPending).
+		SetAttempts(0).
+		SetRunAt(time.Now()).
+		ClearStartedAt().
+		ClearFinishedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(updated)
+}
+
+func (r *Repository) Delete(ctx context.Context, id JobID) error {
+	j, err := r.Get(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if j.Status == StatusRunning {
+		return fault.New("job is running", fctx.With(ctx), ftag.With(ftag.InvalidArgument), fmsg.WithDesc("running", "A job can't be deleted while it's running."))
+	}
+
+	if err := r.db.Job.DeleteOneID(xid.ID(id)).Exec(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteSucceeded(ctx context.Context, finishedBefore time.Time) (int, error) {
+	n, err := r.db.Job.Delete().
+		Where(
+			entjob.StatusEQ(entjob.StatusSucceeded),
+			entjob.FinishedAtLT(finishedBefore),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+func (r *Repository) Stats(ctx context.Context) ([]*KindStats, error) {
+	var rows []struct {
+		Kind   string `json:"kind"`
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+
+	err := r.db.Job.Query().
+		GroupBy(entjob.FieldKind, entjob.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	byKind := map[string]*KindStats{}
+	stats := []*KindStats{}
+	for _, row := range rows {
+		s, ok := byKind[row.Kind]
+		if !ok {
+			s = &KindStats{Kind: row.Kind}
+			byKind[row.Kind] = s
+			stats = append(stats, s)
+		}
+
+		switch row.Status {
+		case string(statusPending):
+			s.Pending = row.Count
+		case string(statusRunning):
+			s.Running = row.Count
+		case string(statusSucceeded):
+			s.Succeeded = row.Count
+		case string(statusDead):
+			s.Dead = row.Count
+		}
+	}
+
+	slices.SortFunc(stats, func(a, b *KindStats) int {
+		return strings.Compare(a.Kind, b.Kind)
+	})
+
+	return stats, nil
+}
@@ -55,6 +55,7 @@ type Node struct {
 	SortKey         lexorank.Key
 	RelevanceScore  opt.Optional[float64]
 	Metadata        map[string]any
+	Summary         opt.Optional[string]
 
 	Nodes []*Node
 }
@@ -123,7 +123,7 @@ order by source desc, sort asc
 func (q *Querier) Get(ctx context.Context, qk library.QueryKey, opts ...Option) (*library.Node, error) {
 	query := q.db.Node.Query()
 
-	query.Where(qk.Predicate())
+	query.Where(qk.Predicate(), node.DeletedAtIsNil())
 
 	o := &options{}
 	for _, opt := range opts {
@@ -248,12 +248,12 @@ func (q *Querier) ListChildren(ctx context.Context, qk library.QueryKey, pp pagi
 
 	// We need to resolve the parent ID first, as the child query is too complex
 	// to use the qk predicate on.
-	parentID, err := q.db.Node.Query().Where(qk.Predicate()).OnlyID(ctx)
+	parentID, err := q.db.Node.Query().Where(qk.Predicate(), node.DeletedAtIsNil()).OnlyID(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	query := q.db.Node.Query().Where(node.ParentNodeID(parentID))
+	query := q.db.Node.Query().Where(node.ParentNodeID(parentID), node.DeletedAtIsNil())
 
 	// Load all relevant edges
 	query.
@@ -389,7 +389,7 @@ func (q *Querier) ListChildren(ctx context.Context, qk library.QueryKey, pp pagi
 func (q *Querier) Probe(ctx context.Context, id library.NodeID) (*library.Node, error) {
 	query := q.db.Node.
 		Query().
-		Where(node.ID(xid.ID(id))).
+		Where(node.ID(xid.ID(id)), node.DeletedAtIsNil()).
 		WithOwner()
 
 	col, err := query.Only(ctx)
@@ -417,7 +417,7 @@ func (q *Querier) ProbeMany(ctx context.Context, ids ...library.NodeID) ([]*libr
 
 	query := q.db.Node.
 		Query().
-		Where(node.IDIn(xids...)).
+		Where(node.IDIn(xids...), node.DeletedAtIsNil()).
 		WithOwner()
 
 	nodes, err := query.All(ctx)
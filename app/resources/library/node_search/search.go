@@ -14,6 +14,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/internal/ent"
 	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
 )
 
 type Search interface {
@@ -24,6 +25,7 @@ type query struct {
 	nameContains    string
 	contentContains string
 	visibility      []visibility.Visibility
+	predicates      []predicate.Node
 }
 
 type Option func(*query)
@@ -46,6 +48,12 @@ func WithVisibility(v []visibility.Visibility) Option {
 	}
 }
 
+func WithPredicates(ps ...predicate.Node) Option {
+	return func(q *query) {
+		q.predicates = append(q.predicates, ps...)
+	}
+}
+
 type service struct {
 	db  *ent.Client
 	raw *sqlx.DB
@@ -65,14 +73,16 @@ func (s *service) Search(ctx context.Context, params pagination.Parameters, opts
 		fn(q)
 	}
 
-	predicate := node.And(
+	predicates := append([]predicate.Node{
 		node.Or(
 			node.NameContainsFold(q.nameContains),
 			node.ContentContainsFold(q.contentContains),
 		),
 		node.VisibilityEQ(node.VisibilityPublished),
 		node.DeletedAtIsNil(),
-	)
+	}, q.predicates...)
+
+	predicate := node.And(predicates...)
 
 	total, err := s.db.Node.Query().Where(predicate).Count(ctx)
 	if err != nil {
@@ -36,7 +36,7 @@ func New(db *ent.Client, raw *sqlx.DB) Repository {
 
 func (d *database) Root(ctx context.Context, fs ...Filter) ([]*library.Node, error) {
 	query := d.db.Node.Query().
-		Where(node.ParentNodeIDIsNil()).
+		Where(node.ParentNodeIDIsNil(), node.DeletedAtIsNil()).
 		WithOwner().
 		WithAssets().
 		Order(node.ByParentNodeID(), node.BySort())
@@ -130,7 +130,7 @@ func (d *database) Subtree(ctx context.Context, id opt.Optional[library.NodeID],
 	// NOTE: i fucking hate writing raw sql into source code...
 
 	var rootPredicate string
-	predicates := []string{}
+	predicates := []string{"n.deleted_at is null"}
 	args := []interface{}{}
 	argOffset := 0
 
@@ -162,10 +162,7 @@ func (d *database) Subtree(ctx context.Context, id opt.Optional[library.NodeID],
 		args = append(args, *f.depth)
 	}
 
-	additional := ""
-	if len(predicates) > 0 {
-		additional = "where " + strings.Join(predicates, " AND ")
-	}
+	additional := "where " + strings.Join(predicates, " AND ")
 	q := fmt.Sprintf(ddl, rootPredicate, additional)
 
 	r, err := d.raw.QueryxContext(ctx, q, args...)
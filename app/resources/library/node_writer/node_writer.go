@@ -13,6 +13,8 @@ import (
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
+	"github.com/samber/lo"
+
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -293,22 +295,70 @@ func (w *Writer) Update(ctx context.Context, qk library.QueryKey, opts ...Option
 	return w.querier.Get(ctx, qk)
 }
 
-func (w *Writer) Delete(ctx context.Context, qk library.QueryKey) error {
-	delete := w.db.Node.Delete()
+// AddTags adds the given tags to every node, skipping any which a node already
+// has. Either all nodes are tagged or, if any update fails, none of them are.
+func (w *Writer) AddTags(ctx context.Context, ids []library.NodeID, refs ...tag_ref.ID) error {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	tagIDs := dt.Map(refs, func(i tag_ref.ID) xid.ID { return xid.ID(i) })
+
+	for _, id := range ids {
+		existing, err := tx.Node.Query().
+			Where(node.ID(xid.ID(id))).
+			QueryTags().
+			IDs(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		missing, _ := lo.Difference(tagIDs, existing)
+		if len(missing) == 0 {
+			continue
+		}
+
+		err = tx.Node.UpdateOneID(xid.ID(id)).
+			AddTagIDs(missing...).
+			Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
 
-	delete.Where(qk.Predicate())
+	if err := tx.Commit(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
 
-	_, err := delete.Exec(ctx)
+// Delete moves the node to the trash, where it remains restorable until it's
+// purged. Any children left under the node are moved to the root of the tree.
+func (w *Writer) Delete(ctx context.Context, qk library.QueryKey) error {
+	id, err := w.db.Node.Query().Where(qk.Predicate(), node.DeletedAtIsNil()).OnlyID(ctx)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
-	// NOTE: This should probably be run separately either as a background job
-	// or in parallel. However, running in a goroutine here for some reason does
-	// not delete anything, presumably because SQLite has not committed deletion
-	// performed above to disk and is running in a mode that prevents this. It
-	// may work fine on Postgres or CockroachDB though but for now this is fine.
-	w.CleanupOrphanedSchemas(ctx)
+	err = w.db.Node.Update().
+		Where(node.ParentNodeID(id)).
+		ClearParentNodeID().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = w.db.Node.UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
 
 	return nil
 }
@@ -0,0 +1,175 @@
+// Package link_check stores the results of periodically checking whether the
+// external links shared on the site still resolve.
+package link_check
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/link/link_ref"
+	"github.com/Southclaws/storyden/internal/ent"
+	link_ent "github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
+)
+
+// HistorySize is how many checks are kept for each link, older checks are
+// removed as new ones are recorded.
+const HistorySize = 20
+
+type Check struct {
+	ID         xid.ID
+	StatusCode opt.Optional[int]
+	Error      opt.Optional[string]
+	CheckedAt  time.Time
+}
+
+func mapCheck(in *ent.LinkCheck) *Check {
+	return &Check{
+		ID:         in.ID,
+		StatusCode: opt.NewPtr(in.StatusCode),
+		Error:      opt.NewPtr(in.Error),
+		CheckedAt:  in.CreatedAt,
+	}
+}
+
+// Result is the outcome of a single check, Failed is decided by the checker.
+type Result struct {
+	StatusCode opt.Optional[int]
+	Error      opt.Optional[string]
+	Failed     bool
+}
+
+type BrokenLink struct {
+	Link     *link_ref.LinkRef
+	Failures int
+	History  []*Check
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// ListDue returns links which have never been checked or were last checked
+// before the given time, least recently checked first.
+func (r *Repository) ListDue(ctx context.Context, before time.Time, limit int) ([]*link_ref.LinkRef, error) {
+	links, err := r.db.Link.Query().
+		Where(link_ent.Or(
+			link_ent.CheckedAtIsNil(),
+			link_ent.CheckedAtLT(before),
+		)).
+		Order(link_ent.ByCheckedAt(sql.OrderNullsFirst())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(links, link_ref.Map), nil
+}
+
+// Record stores the result of checking a link. A link is flagged as broken
+// once threshold consecutive checks have failed and the flag is cleared by the
+// next check which succeeds. It reports whether the link is now broken.
+func (r *Repository) Record(ctx context.Context, id link_ref.ID, result Result, at time.Time, threshold int) (bool, error) {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	l, err := tx.Link.Get(ctx, id)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = tx.LinkCheck.Create().
+		SetLinkID(id).
+		SetNillableStatusCode(result.StatusCode.Ptr()).
+		SetNillableError(result.Error.Ptr()).
+		SetCreatedAt(at).
+		Exec(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	update := tx.Link.UpdateOneID(id).SetCheckedAt(at)
+
+	broken := false
+	if result.Failed {
+		failures := l.CheckFailures + 1
+		update.SetCheckFailures(failures)
+
+		if failures >= threshold {
+			broken = true
+			if l.BrokenAt == nil {
+				update.SetBrokenAt(at)
+			}
+		}
+	} else {
+		update.SetCheckFailures(0).ClearBrokenAt()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	expired, err := tx.LinkCheck.Query().
+		Where(linkcheck.LinkID(id)).
+		Order(linkcheck.ByCreatedAt(sql.OrderDesc())).
+		Offset(HistorySize).
+		IDs(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(expired) > 0 {
+		_, err = tx.LinkCheck.Delete().Where(linkcheck.IDIn(expired...)).Exec(ctx)
+		if err != nil {
+			return false, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return broken, nil
+}
+
+// ListBroken returns every link currently flagged as broken, most recently
+// broken first, with its check history.
+func (r *Repository) ListBroken(ctx context.Context) ([]*BrokenLink, error) {
+	links, err := r.db.Link.Query().
+		Where(link_ent.BrokenAtNotNil()).
+		WithFaviconImage().
+		WithPrimaryImage().
+		WithChecks(func(lcq *ent.LinkCheckQuery) {
+			lcq.Order(linkcheck.ByCreatedAt(sql.OrderDesc()))
+		}).
+		Order(link_ent.ByBrokenAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(links, func(in *ent.Link) *BrokenLink {
+		return &BrokenLink{
+			Link:     link_ref.Map(in),
+			Failures: in.CheckFailures,
+			History:  dt.Map(in.Edges.Checks, mapCheck),
+		}
+	}), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
@@ -47,6 +48,7 @@ func WithKeyword(s string) Filter {
 			link_ent.TitleContainsFold(s),
 			link_ent.DescriptionContainsFold(s),
 			link_ent.URLContainsFold(s),
+			link_ent.SnapshotTextContainsFold(s),
 		))
 	}
 }
@@ -57,6 +59,7 @@ func (d *LinkQuerier) Get(ctx context.Context, slug string) (*link.Link, error)
 		WithAssets().
 		WithPrimaryImage().
 		WithFaviconImage().
+		WithSnapshot().
 		WithPosts(func(pq *ent.PostQuery) {
 			pq.WithAuthor()
 			pq.WithCategory()
@@ -93,6 +96,25 @@ func (d *LinkQuerier) GetByID(ctx context.Context, id link.LinkID) (*link_ref.Li
 	return link, nil
 }
 
+// GetByURL returns the link stored for exactly the given URL, unlike WithURL
+// which matches any link containing it.
+func (d *LinkQuerier) GetByURL(ctx context.Context, url string) (*link_ref.LinkRef, error) {
+	r, err := d.db.Link.Query().
+		Where(link_ent.URL(url)).
+		WithFaviconImage().
+		WithPrimaryImage().
+		WithSnapshot().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return link_ref.Map(r), nil
+}
+
 func (d *LinkQuerier) Search(ctx context.Context, page int, size int, filters ...Filter) (*Result, error) {
 	total, err := d.db.Link.Query().Count(ctx)
 	if err != nil {
@@ -102,6 +124,7 @@ func (d *LinkQuerier) Search(ctx context.Context, page int, size int, filters ..
 	query := d.db.Link.Query().
 		WithPrimaryImage().
 		WithFaviconImage().
+		WithSnapshot().
 		Limit(size + 1).
 		Offset(page * size).
 		Order(ent.Desc(link_ent.FieldCreatedAt))
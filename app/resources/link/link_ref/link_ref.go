@@ -24,8 +24,25 @@ type LinkRef struct {
 	Domain       string
 	Title        opt.Optional[string]
 	Description  opt.Optional[string]
+	SiteName     opt.Optional[string]
 	FaviconImage opt.Optional[asset.Asset]
 	PrimaryImage opt.Optional[asset.Asset]
+	Snapshot     opt.Optional[asset.Asset]
+	ArchiveURL   opt.Optional[string]
+	FetchedAt    opt.Optional[time.Time]
+	CheckedAt    opt.Optional[time.Time]
+	BrokenAt     opt.Optional[time.Time]
+}
+
+// IsStale reports whether the link's preview is due to be fetched again. Links
+// which have never been fetched are always stale.
+func (l *LinkRef) IsStale(now time.Time, ttl time.Duration) bool {
+	fetched, ok := l.FetchedAt.Get()
+	if !ok {
+		return true
+	}
+
+	return now.Sub(fetched) > ttl
 }
 
 type LinkRefs []*LinkRef
@@ -57,6 +74,10 @@ func Map(in *ent.Link) *LinkRef {
 		return *asset.Map(&a)
 	})
 
+	snapshot := opt.NewPtrMap(in.Edges.Snapshot, func(a ent.Asset) asset.Asset {
+		return *asset.Map(&a)
+	})
+
 	return &LinkRef{
 		ID:           ID(in.ID),
 		URL:          in.URL,
@@ -64,7 +85,13 @@ func Map(in *ent.Link) *LinkRef {
 		Domain:       in.Domain,
 		Title:        opt.New(in.Title),
 		Description:  opt.New(in.Description),
+		SiteName:     opt.NewPtr(in.SiteName),
 		FaviconImage: favicon,
 		PrimaryImage: primary,
+		Snapshot:     snapshot,
+		ArchiveURL:   opt.NewPtr(in.ArchiveURL),
+		FetchedAt:    opt.NewPtr(in.FetchedAt),
+		CheckedAt:    opt.NewPtr(in.CheckedAt),
+		BrokenAt:     opt.NewPtr(in.BrokenAt),
 	}
 }
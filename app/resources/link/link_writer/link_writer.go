@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
@@ -51,6 +52,31 @@ func WithPrimaryImage(id asset.AssetID) Option {
 	}
 }
 
+func WithSiteName(name string) Option {
+	return func(lm *ent.LinkMutation) {
+		lm.SetSiteName(name)
+	}
+}
+
+func WithFetchedAt(t time.Time) Option {
+	return func(lm *ent.LinkMutation) {
+		lm.SetFetchedAt(t)
+	}
+}
+
+func WithSnapshot(id asset.AssetID, text string) Option {
+	return func(lm *ent.LinkMutation) {
+		lm.SetSnapshotID(id)
+		lm.SetSnapshotText(text)
+	}
+}
+
+func WithArchiveURL(u string) Option {
+	return func(lm *ent.LinkMutation) {
+		lm.SetArchiveURL(u)
+	}
+}
+
 func WithAssets(ids ...asset.AssetID) Option {
 	return func(lm *ent.LinkMutation) {
 		lm.AddAssetIDs(ids...)
@@ -90,6 +116,7 @@ func (d *LinkWriter) Store(ctx context.Context, address, title, description stri
 		Where(link_ent.ID(r.ID)).
 		WithFaviconImage().
 		WithPrimaryImage().
+		WithSnapshot().
 		First(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -98,6 +125,33 @@ func (d *LinkWriter) Store(ctx context.Context, address, title, description stri
 	return link_ref.Map(r), nil
 }
 
+// Update applies options to an existing link without scraping it again.
+func (d *LinkWriter) Update(ctx context.Context, id link_ref.ID, opts ...Option) error {
+	update := d.db.Link.UpdateOneID(xid.ID(id))
+	mutate := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutate)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// MarkFetched records an attempt to fetch a link's metadata without changing
+// what was previously stored, used when a refresh fails.
+func (d *LinkWriter) MarkFetched(ctx context.Context, id link_ref.ID, at time.Time) error {
+	err := d.db.Link.UpdateOneID(xid.ID(id)).SetFetchedAt(at).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
 func getLinkAttrs(u url.URL) (string, string) {
 	host := strings.TrimPrefix(u.Hostname(), "www.")
 
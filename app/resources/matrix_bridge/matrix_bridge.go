@@ -0,0 +1,124 @@
+// Package matrix_bridge stores the configuration and event mapping of the
+// two-way bridge between Storyden threads and Matrix rooms.
+package matrix_bridge
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
+)
+
+// Room maps a Storyden category to the Matrix room its threads are announced
+// in.
+type Room struct {
+	CategoryID xid.ID
+	RoomID     string
+}
+
+type Settings struct {
+	Rooms []Room
+}
+
+func (s Settings) RoomFor(cat opt.Optional[category.CategoryID]) (string, bool) {
+	id, ok := cat.Get()
+	if !ok {
+		return "", false
+	}
+
+	for _, r := range s.Rooms {
+		if r.CategoryID == xid.ID(id) {
+			return r.RoomID, true
+		}
+	}
+
+	return "", false
+}
+
+// HasRoom reports whether the room is mapped to any category.
+func (s Settings) HasRoom(roomID string) bool {
+	for _, r := range s.Rooms {
+		if r.RoomID == roomID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Link pairs a Storyden post with a Matrix event. For thread posts, the event
+// is the announcement the bridge sent to the room and is the root of the
+// Matrix thread that replies are written in.
+type Link struct {
+	PostID   post.ID
+	ThreadID post.ID
+	RoomID   string
+	EventID  string
+}
+
+func mapLink(in *ent.MatrixBridgeLink) *Link {
+	return &Link{
+		PostID:   post.ID(in.PostID),
+		ThreadID: post.ID(in.ThreadID),
+		RoomID:   in.MatrixRoomID,
+		EventID:  in.MatrixEventID,
+	}
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(ctx context.Context, l Link) error {
+	err := r.db.MatrixBridgeLink.Create().
+		SetPostID(xid.ID(l.PostID)).
+		SetThreadID(xid.ID(l.ThreadID)).
+		SetMatrixRoomID(l.RoomID).
+		SetMatrixEventID(l.EventID).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByPost(ctx context.Context, id post.ID) (*Link, error) {
+	l, err := r.db.MatrixBridgeLink.Query().
+		Where(matrixbridgelink.PostID(xid.ID(id))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapLink(l), nil
+}
+
+func (r *Repository) GetByEvent(ctx context.Context, eventID string) (*Link, error) {
+	l, err := r.db.MatrixBridgeLink.Query().
+		Where(matrixbridgelink.MatrixEventID(eventID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapLink(l), nil
+}
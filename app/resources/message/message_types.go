@@ -7,13 +7,19 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/application"
 	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/onboarding_checklist"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/automod"
+	"github.com/Southclaws/storyden/app/resources/conversation"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/event/event_ref"
 	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/policy"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/report"
-	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/app/resources/webhook"
 )
 
 // -
@@ -53,20 +59,44 @@ type EventThreadReplyUpdated struct {
 	ReplyID  post.ID
 }
 
+// EventPostHeldForReview is sent when a thread or reply is held in the post
+// queue instead of being published. For threads, ThreadID and PostID match.
+type EventPostHeldForReview struct {
+	ThreadID post.ID
+	PostID   post.ID
+	AuthorID account.AccountID
+}
+
+type EventThreadAnswerAccepted struct {
+	ThreadID post.ID
+	ReplyID  post.ID
+}
+
+type EventThreadAnswerUnaccepted struct {
+	ThreadID post.ID
+	ReplyID  post.ID
+}
+
 type EventPostLiked struct {
-	PostID post.ID
+	PostID    post.ID
+	AccountID account.AccountID
 }
 
 type EventPostUnliked struct {
-	PostID post.ID
+	PostID    post.ID
+	AccountID account.AccountID
 }
 
 type EventPostReacted struct {
-	PostID post.ID
+	PostID    post.ID
+	ReactID   xid.ID
+	AccountID account.AccountID
 }
 
 type EventPostUnreacted struct {
-	PostID post.ID
+	PostID    post.ID
+	ReactID   xid.ID
+	AccountID account.AccountID
 }
 
 // -
@@ -83,28 +113,17 @@ type EventCategoryDeleted struct {
 	Slug string
 }
 
+type EventCategoryFollowed struct {
+	AccountID account.AccountID
+	Slug      string
+}
+
 type EventMemberMentioned struct {
 	By     account.AccountID
 	Source datagraph.Ref
 	Item   datagraph.Ref
 }
 
-type CommandThreadIndex struct {
-	ID post.ID
-}
-
-type CommandThreadDeindex struct {
-	ID post.ID
-}
-
-type CommandReplyIndex struct {
-	ID post.ID
-}
-
-type CommandReplyDeindex struct {
-	ID post.ID
-}
-
 // -
 // Library node events and commands
 // -
@@ -139,14 +158,6 @@ type EventNodeUnpublished struct {
 	Slug string
 }
 
-type CommandNodeIndex struct {
-	ID library.NodeID
-}
-
-type CommandNodeDeindex struct {
-	ID library.NodeID
-}
-
 // -
 // Account and profile events and commands
 // -
@@ -159,10 +170,40 @@ type EventAccountUpdated struct {
 	ID account.AccountID
 }
 
-type CommandProfileIndex struct {
+type EventAccountEmailVerified struct {
 	ID account.AccountID
 }
 
+type EventAccountAvatarUpdated struct {
+	ID account.AccountID
+}
+
+type EventOnboardingStepCompleted struct {
+	AccountID account.AccountID
+	Step      onboarding_checklist.Step
+}
+
+type EventSettingsUpdated struct{}
+
+type EventFeatureFlagUpdated struct {
+	Key string
+}
+
+type EventPolicyPublished struct {
+	DocumentID policy.DocumentID
+	VersionID  policy.VersionID
+}
+
+type EventAccountApplicationSubmitted struct {
+	ID        application.ApplicationID
+	AccountID account.AccountID
+}
+
+type EventBadgeAwarded struct {
+	AccountID account.AccountID
+	BadgeID   xid.ID
+}
+
 // -
 // Notifications
 // -
@@ -172,15 +213,25 @@ type CommandSendNotification struct {
 	Item     *datagraph.Ref
 	TargetID account.AccountID
 	SourceID opt.Optional[account.AccountID]
+
+	// PostID is a post whose content is included in full when the notification
+	// is emailed, such as the reply a thread reply notification is about.
+	PostID opt.Optional[post.ID]
 }
 
-type CommandSendEmail struct {
-	Message mailer.Message
+type EventNotificationCreated struct {
+	ID       xid.ID
+	Event    notification.Event
+	TargetID account.AccountID
 }
 
 type CommandSendBeacon struct {
 	Item    datagraph.Ref
 	Subject opt.Optional[account.AccountID]
+
+	// Position is an optional client-defined marker of how far into the item
+	// the member has read, such as the last reply on screen.
+	Position string
 }
 
 // -
@@ -201,6 +252,18 @@ type EventReportUpdated struct {
 	Status     report.Status
 }
 
+type EventReportEscalated struct {
+	Target      datagraph.Ref
+	EscalatedBy account.AccountID
+}
+
+type EventAutomodTriggered struct {
+	Target   datagraph.Ref
+	AuthorID account.AccountID
+	Action   automod.Action
+	Rules    []automod.RuleID
+}
+
 // -
 // Scraping commands
 // -
@@ -210,6 +273,44 @@ type CommandScrapeLink struct {
 	Item *datagraph.Ref
 }
 
+type CommandArchiveLink struct {
+	ID  xid.ID
+	URL url.URL
+}
+
+// -
+// Asset events and commands
+// -
+
+type CommandTranscodeVideo struct {
+	ID asset.AssetID
+}
+
+type EventAssetFlagged struct {
+	ID        asset.AssetID
+	OwnerID   account.AccountID
+	Status    asset.ScanStatus
+	Signature string
+}
+
+// -
+// Direct messages
+// -
+
+type EventDirectMessageSent struct {
+	ID             conversation.MessageID
+	ConversationID conversation.ConversationID
+	AuthorID       account.AccountID
+}
+
+// -
+// Generative commands
+// -
+
+type CommandSummarise struct {
+	Item datagraph.Ref
+}
+
 // -
 // Scheduled event events
 // -
@@ -229,3 +330,11 @@ type EventActivityDeleted struct {
 type EventActivityPublished struct {
 	ID event_ref.EventID
 }
+
+// -
+// Webhooks
+// -
+
+type CommandDeliverWebhook struct {
+	ID webhook.DeliveryID
+}
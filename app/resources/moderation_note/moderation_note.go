@@ -0,0 +1,86 @@
+// Package moderation_note describes the entries which make up a member's case
+// file: private notes written by moderators and records of actions taken.
+package moderation_note
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type kindEnum string
+
+const (
+	kindNote          kindEnum = "note"            // Written by a moderator.
+	kindSuspension    kindEnum = "suspension"      // The account was suspended.
+	kindReinstatement kindEnum = "reinstatement"   // The account's suspension was lifted.
+	kindWarning       kindEnum = "warning"         // The member was issued a warning.
+	kindWarningRevoke kindEnum = "warning_revoked" // A warning was revoked.
+)
+
+// Editable reports whether the entry was written by a moderator, records of
+// actions are kept as they are.
+func (k Kind) Editable() bool {
+	return k == KindNote
+}
+
+type NoteID xid.ID
+
+func (i NoteID) String() string { return xid.ID(i).String() }
+
+type Note struct {
+	ID        NoteID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Kind      Kind
+	AccountID account.AccountID
+	Target    datagraph.Ref
+	Author    opt.Optional[account.Account]
+	Content   opt.Optional[string]
+}
+
+type Notes []*Note
+
+func Map(in *ent.ModerationNote) (*Note, error) {
+	kind, err := NewKind(in.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	targetKind, err := datagraph.NewKind(in.TargetKind)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := opt.MapErr(opt.NewPtr(in.Edges.Author), func(a ent.Account) (account.Account, error) {
+		acc, err := account.MapRef(&a)
+		if err != nil {
+			return account.Account{}, err
+		}
+		return *acc, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Note{
+		ID:        NoteID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Kind:      kind,
+		AccountID: account.AccountID(in.AccountID),
+		Target: datagraph.Ref{
+			ID:   in.TargetID,
+			Kind: targetKind,
+		},
+		Author:  author,
+		Content: opt.NewPtr(in.Content),
+	}, nil
+}
@@ -0,0 +1,140 @@
+package moderation_note_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/moderation_note"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_moderation_note "github.com/Southclaws/storyden/internal/ent/moderationnote"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+)
+
+var ErrUnsupportedTarget = fault.New("moderation notes cannot be attached to this kind of resource", ftag.With(ftag.InvalidArgument))
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// ListForAccount returns the member's case file, oldest entry first.
+func (q *Querier) ListForAccount(ctx context.Context, accountID account.AccountID) (moderation_note.Notes, error) {
+	r, err := q.db.ModerationNote.Query().
+		Where(ent_moderation_note.AccountID(xid.ID(accountID))).
+		WithAuthor().
+		Order(ent.Asc(ent_moderation_note.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	notes, err := dt.MapErr(r, moderation_note.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return notes, nil
+}
+
+// ListForTarget returns entries about a single member or piece of content,
+// oldest entry first.
+func (q *Querier) ListForTarget(ctx context.Context, targetID xid.ID) (moderation_note.Notes, error) {
+	r, err := q.db.ModerationNote.Query().
+		Where(ent_moderation_note.TargetID(targetID)).
+		WithAuthor().
+		Order(ent.Asc(ent_moderation_note.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	notes, err := dt.MapErr(r, moderation_note.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return notes, nil
+}
+
+func (q *Querier) Get(ctx context.Context, id moderation_note.NoteID) (*moderation_note.Note, error) {
+	r, err := q.db.ModerationNote.Query().
+		Where(ent_moderation_note.ID(xid.ID(id))).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	note, err := moderation_note.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return note, nil
+}
+
+// Subject finds the member whose case file an entry about the target belongs
+// to, which is the member themselves or the author of the content.
+func (q *Querier) Subject(ctx context.Context, target datagraph.Ref) (account.AccountID, error) {
+	var (
+		id  xid.ID
+		err error
+	)
+
+	switch target.Kind {
+	case datagraph.KindProfile:
+		id, err = q.db.Account.Query().
+			Where(ent_account.ID(target.ID)).
+			OnlyID(ctx)
+
+	case datagraph.KindPost, datagraph.KindThread, datagraph.KindReply:
+		var p *ent.Post
+		p, err = q.db.Post.Query().
+			Where(ent_post.ID(target.ID)).
+			Select(ent_post.FieldAccountPosts).
+			Only(ctx)
+		if err == nil {
+			id = p.AccountPosts
+		}
+
+	case datagraph.KindNode:
+		var n *ent.Node
+		n, err = q.db.Node.Query().
+			Where(ent_node.ID(target.ID)).
+			Select(ent_node.FieldAccountID).
+			Only(ctx)
+		if err == nil {
+			id = n.AccountID
+		}
+
+	default:
+		return account.AccountID{}, fault.Wrap(ErrUnsupportedTarget,
+			fctx.With(ctx),
+			fmsg.WithDesc("unsupported", "Notes can only be attached to members, posts and library pages."),
+		)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return account.AccountID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return account.AccountID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return account.AccountID(id), nil
+}
@@ -0,0 +1,97 @@
+package moderation_note_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/moderation_note"
+	"github.com/Southclaws/storyden/app/resources/moderation_note/moderation_note_querier"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Writer struct {
+	db      *ent.Client
+	querier *moderation_note_querier.Querier
+}
+
+func New(db *ent.Client, querier *moderation_note_querier.Querier) *Writer {
+	return &Writer{db: db, querier: querier}
+}
+
+type Option func(*ent.ModerationNoteMutation)
+
+func WithAuthor(v account.AccountID) Option {
+	return func(m *ent.ModerationNoteMutation) {
+		m.SetAuthorID(xid.ID(v))
+	}
+}
+
+func WithContent(v string) Option {
+	return func(m *ent.ModerationNoteMutation) {
+		m.SetContent(v)
+	}
+}
+
+func (w *Writer) Create(
+	ctx context.Context,
+	accountID account.AccountID,
+	target datagraph.Ref,
+	kind moderation_note.Kind,
+	opts ...Option,
+) (*moderation_note.Note, error) {
+	create := w.db.ModerationNote.Create()
+	mutation := create.Mutation()
+
+	mutation.SetAccountID(xid.ID(accountID))
+	mutation.SetTargetID(target.ID)
+	mutation.SetTargetKind(target.Kind.String())
+	mutation.SetKind(kind.String())
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, moderation_note.NoteID(r.ID))
+}
+
+func (w *Writer) Update(ctx context.Context, id moderation_note.NoteID, opts ...Option) (*moderation_note.Note, error) {
+	update := w.db.ModerationNote.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, id)
+}
+
+func (w *Writer) Delete(ctx context.Context, id moderation_note.NoteID) error {
+	err := w.db.ModerationNote.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
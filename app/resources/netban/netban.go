@@ -0,0 +1,148 @@
+// Package netban describes bans on IP addresses, CIDR ranges and autonomous
+// systems which stop them from registering accounts or writing posts.
+package netban
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type listEnum string
+
+const (
+	listBlock listEnum = "block" // Matching networks are banned.
+	listAllow listEnum = "allow" // Matching networks are exempt from any blocks.
+)
+
+var ErrInvalidTarget = fault.New("invalid network ban target", ftag.With(ftag.InvalidArgument))
+
+// Target is either an address range or an autonomous system number.
+type Target struct {
+	Prefix opt.Optional[netip.Prefix]
+	ASN    opt.Optional[uint32]
+}
+
+// ParseTarget accepts a single IP address, a CIDR range or an AS number, with
+// or without the "AS" prefix.
+func ParseTarget(s string) (Target, error) {
+	s = strings.TrimSpace(s)
+
+	if n, ok := strings.CutPrefix(strings.ToUpper(s), "AS"); ok || isDigits(s) {
+		asn, err := strconv.ParseUint(n, 10, 32)
+		if err != nil {
+			return Target{}, fault.Wrap(ErrInvalidTarget, fmsg.WithDesc("invalid asn", fmt.Sprintf("'%s' is not a valid AS number.", s)))
+		}
+		return Target{ASN: opt.New(uint32(asn))}, nil
+	}
+
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return Target{}, fault.Wrap(ErrInvalidTarget, fmsg.WithDesc("invalid cidr", fmt.Sprintf("'%s' is not a valid CIDR range.", s)))
+		}
+		return Target{Prefix: opt.New(p.Masked())}, nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return Target{}, fault.Wrap(ErrInvalidTarget, fmsg.WithDesc("invalid address", fmt.Sprintf("'%s' is not a valid IP address, CIDR range or AS number.", s)))
+	}
+	addr = addr.Unmap()
+
+	return Target{Prefix: opt.New(netip.PrefixFrom(addr, addr.BitLen()))}, nil
+}
+
+func (t Target) String() string {
+	if asn, ok := t.ASN.Get(); ok {
+		return fmt.Sprintf("AS%d", asn)
+	}
+	return t.Prefix.OrZero().String()
+}
+
+// Matches reports whether the given address, which is announced by the given
+// autonomous systems, falls within this target.
+func (t Target) Matches(addr netip.Addr, asns []uint32) bool {
+	if asn, ok := t.ASN.Get(); ok {
+		for _, a := range asns {
+			if a == asn {
+				return true
+			}
+		}
+		return false
+	}
+
+	p, ok := t.Prefix.Get()
+	return ok && p.Contains(addr.Unmap())
+}
+
+type BanID xid.ID
+
+func (i BanID) String() string { return xid.ID(i).String() }
+
+type Ban struct {
+	ID        BanID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Target    Target
+	List      List
+	Reason    opt.Optional[string]
+	ExpiresAt opt.Optional[time.Time]
+	Hits      int
+	LastHitAt opt.Optional[time.Time]
+}
+
+func (b *Ban) Active(now time.Time) bool {
+	exp, ok := b.ExpiresAt.Get()
+	return !ok || exp.After(now)
+}
+
+type Bans []*Ban
+
+func Map(in *ent.NetworkBan) (*Ban, error) {
+	target, err := ParseTarget(in.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := NewList(in.List)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ban{
+		ID:        BanID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Target:    target,
+		List:      list,
+		Reason:    opt.NewPtr(in.Reason),
+		ExpiresAt: opt.NewPtr(in.ExpiresAt),
+		Hits:      in.Hits,
+		LastHitAt: opt.NewPtr(in.LastHitAt),
+	}, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
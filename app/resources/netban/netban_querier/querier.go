@@ -0,0 +1,77 @@
+package netban_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/netban"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_network_ban "github.com/Southclaws/storyden/internal/ent/networkban"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// List returns every ban, including those which have expired.
+func (q *Querier) List(ctx context.Context) (netban.Bans, error) {
+	r, err := q.db.NetworkBan.Query().
+		Order(ent.Desc(ent_network_ban.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	bans, err := dt.MapErr(r, netban.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return bans, nil
+}
+
+func (q *Querier) ListActive(ctx context.Context) (netban.Bans, error) {
+	r, err := q.db.NetworkBan.Query().
+		Where(ent_network_ban.Or(
+			ent_network_ban.ExpiresAtIsNil(),
+			ent_network_ban.ExpiresAtGT(time.Now()),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	bans, err := dt.MapErr(r, netban.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return bans, nil
+}
+
+func (q *Querier) Get(ctx context.Context, id netban.BanID) (*netban.Ban, error) {
+	r, err := q.db.NetworkBan.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ban, err := netban.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ban, nil
+}
@@ -0,0 +1,152 @@
+package netban_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/netban"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_network_ban "github.com/Southclaws/storyden/internal/ent/networkban"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.NetworkBanMutation)
+
+func WithTarget(v netban.Target) Option {
+	return func(m *ent.NetworkBanMutation) {
+		m.SetTarget(v.String())
+	}
+}
+
+func WithList(v netban.List) Option {
+	return func(m *ent.NetworkBanMutation) {
+		m.SetList(v.String())
+	}
+}
+
+func WithReason(v string) Option {
+	return func(m *ent.NetworkBanMutation) {
+		if v == "" {
+			m.ClearReason()
+			return
+		}
+		m.SetReason(v)
+	}
+}
+
+func WithExpiresAt(v time.Time) Option {
+	return func(m *ent.NetworkBanMutation) {
+		m.SetExpiresAt(v)
+	}
+}
+
+func WithoutExpiry() Option {
+	return func(m *ent.NetworkBanMutation) {
+		m.ClearExpiresAt()
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, target netban.Target, list netban.List, opts ...Option) (*netban.Ban, error) {
+	create := w.db.NetworkBan.Create()
+	mutation := create.Mutation()
+
+	WithTarget(target)(mutation)
+	WithList(list)(mutation)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err,
+				fctx.With(ctx),
+				ftag.With(ftag.AlreadyExists),
+				fmsg.WithDesc("duplicate", "There is already an entry for this network on the "+list.String()+" list."))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ban, err := netban.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ban, nil
+}
+
+func (w *Writer) Update(ctx context.Context, id netban.BanID, opts ...Option) (*netban.Ban, error) {
+	update := w.db.NetworkBan.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ban, err := netban.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ban, nil
+}
+
+// RecordHit counts a request which matched the given bans.
+func (w *Writer) RecordHit(ctx context.Context, ids ...netban.BanID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	xids := make([]xid.ID, len(ids))
+	for i, id := range ids {
+		xids[i] = xid.ID(id)
+	}
+
+	err := w.db.NetworkBan.Update().
+		Where(ent_network_ban.IDIn(xids...)).
+		AddHits(1).
+		SetLastHitAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) Delete(ctx context.Context, id netban.BanID) error {
+	err := w.db.NetworkBan.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package pagination
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+)
+
+// Cursor is an opaque position within a listing made from the sort key and ID
+// of the last item on a page. Unlike a page number, a cursor is not affected by
+// items being inserted or removed before it while a client is paging through.
+type Cursor struct {
+	Key string
+	ID  xid.ID
+}
+
+func NewCursor(key string, id xid.ID) Cursor {
+	return Cursor{Key: key, ID: id}
+}
+
+// NewTimeCursor creates a cursor for listings sorted by a timestamp column.
+func NewTimeCursor(t time.Time, id xid.ID) Cursor {
+	return Cursor{Key: t.Format(time.RFC3339Nano), ID: id}
+}
+
+func ParseCursor(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, invalidCursor(err)
+	}
+
+	var parts [2]string
+	if err := json.Unmarshal(b, &parts); err != nil {
+		return Cursor{}, invalidCursor(err)
+	}
+
+	id, err := xid.FromString(parts[1])
+	if err != nil {
+		return Cursor{}, invalidCursor(err)
+	}
+
+	return Cursor{Key: parts[0], ID: id}, nil
+}
+
+func (c Cursor) String() string {
+	b, _ := json.Marshal([2]string{c.Key, c.ID.String()})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (c Cursor) Time() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return time.Time{}, invalidCursor(err)
+	}
+
+	return t, nil
+}
+
+// Seek returns a selector predicate for the rows which come after the cursor
+// in a listing ordered by keyColumn then idColumn, both in the same direction.
+// The key is passed separately so it can be the column's native type.
+func (c Cursor) Seek(desc bool, keyColumn string, key any, idColumn string) func(*sql.Selector) {
+	after := sql.GT
+	if desc {
+		after = sql.LT
+	}
+
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			after(s.C(keyColumn), key),
+			sql.And(
+				sql.EQ(s.C(keyColumn), key),
+				after(s.C(idColumn), c.ID),
+			),
+		))
+	}
+}
+
+// Compare orders cursors by key then ID, for listings paged in memory.
+func (c Cursor) Compare(other Cursor) int {
+	return cmp.Or(strings.Compare(c.Key, other.Key), c.ID.Compare(other.ID))
+}
+
+// NewSliceResult pages through an already loaded list for listings which can't
+// be paged by the database. Items are sorted by their cursor so that the pages
+// are stable regardless of the order the list was loaded in.
+func NewSliceResult[T any](p Parameters, items []T, key func(T) Cursor) Result[T] {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int { return key(a).Compare(key(b)) })
+
+	start := min(p.Offset(), len(sorted))
+	if c, ok := p.Cursor().Get(); ok {
+		start = sort.Search(len(sorted), func(i int) bool { return key(sorted[i]).Compare(c) > 0 })
+	}
+
+	end := min(start+p.Limit(), len(sorted))
+
+	return NewPageResult(p, len(sorted), sorted[start:end]).WithCursor(key)
+}
+
+func invalidCursor(err error) error {
+	return fault.Wrap(err,
+		ftag.With(ftag.InvalidArgument),
+		fmsg.WithDesc("invalid cursor", "The pagination cursor is malformed, request the first page again."),
+	)
+}
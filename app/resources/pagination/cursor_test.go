@@ -0,0 +1,75 @@
+package pagination
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("round_trip", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		now := time.Now()
+		c := NewTimeCursor(now, xid.New())
+
+		parsed, err := ParseCursor(c.String())
+		r.NoError(err)
+		a.Equal(c, parsed)
+
+		ts, err := parsed.Time()
+		r.NoError(err)
+		a.True(now.Equal(ts))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{"", "not base64!", "bm90IGpzb24", NewCursor("k", xid.ID{}).String()[:8]} {
+			_, err := ParseCursor(s)
+			assert.Equal(t, ftag.InvalidArgument, ftag.Get(err), s)
+		}
+
+		_, err := NewCursor("not a time", xid.New()).Time()
+		assert.Equal(t, ftag.InvalidArgument, ftag.Get(err))
+	})
+}
+
+func TestNewSliceResult(t *testing.T) {
+	a := assert.New(t)
+
+	type item struct {
+		key string
+		id  xid.ID
+	}
+
+	// Shuffled on the way in, the result must be ordered by key regardless.
+	items := lo.Shuffle(lo.Times(25, func(i int) item {
+		return item{key: fmt.Sprintf("%03d", i), id: xid.New()}
+	}))
+	key := func(i item) Cursor { return NewCursor(i.key, i.id) }
+
+	seen := []string{}
+	cursor := opt.NewEmpty[Cursor]()
+	for range 3 {
+		r := NewSliceResult(NewCursorParams(cursor, 10), items, key)
+		for _, i := range r.Items {
+			seen = append(seen, i.key)
+		}
+		cursor = r.NextCursor
+	}
+
+	a.False(cursor.Ok(), "there is no cursor after the last page")
+	a.Equal(lo.Times(25, func(i int) string { return fmt.Sprintf("%03d", i) }), seen)
+
+	r := NewSliceResult(NewPageParams(3, 10), items, key)
+	a.Len(r.Items, 5)
+	a.Equal(3, r.TotalPages)
+	a.False(r.NextPage.Ok())
+}
@@ -13,8 +13,9 @@ type (
 
 // Parameters is to be used in any paginated repository query method for paging.
 type Parameters struct {
-	page Page
-	size Size
+	page   Page
+	size   Size
+	cursor opt.Optional[Cursor]
 }
 
 // Result holds a list of rows from a paginated query result with page metadata.
@@ -24,6 +25,7 @@ type Result[T any] struct {
 	TotalPages  int
 	CurrentPage int
 	NextPage    opt.Optional[int]
+	NextCursor  opt.Optional[Cursor]
 	Items       []T
 }
 
@@ -45,6 +47,19 @@ func NewPageParams(oneIndexedPageNumber uint, pageSize uint) Parameters {
 	}
 }
 
+// NewCursorParams creates pagination parameters which continue from a cursor
+// if one is given. Cursor pages are always the first page relative to the
+// cursor so offsets are never used, the query must seek past the cursor.
+func NewCursorParams(cursor opt.Optional[Cursor], pageSize uint) Parameters {
+	p := NewPageParams(1, pageSize)
+	p.cursor = cursor
+	return p
+}
+
+func (p Parameters) Cursor() opt.Optional[Cursor] {
+	return p.cursor
+}
+
 func (p Parameters) PageOneIndexed() int {
 	return int(p.page)
 }
@@ -112,6 +127,17 @@ func ConvertPageResult[F, T any](f Result[F], t []T) Result[T] {
 		TotalPages:  f.TotalPages,
 		CurrentPage: f.CurrentPage,
 		NextPage:    f.NextPage,
+		NextCursor:  f.NextCursor,
 		Items:       t,
 	}
 }
+
+// WithCursor sets the cursor for the next page from the last item of this page
+// if there is a next page, key must match the sort order used by the query.
+func (r Result[T]) WithCursor(key func(T) Cursor) Result[T] {
+	if r.NextPage.Ok() && len(r.Items) > 0 {
+		r.NextCursor = opt.New(key(r.Items[len(r.Items)-1]))
+	}
+
+	return r
+}
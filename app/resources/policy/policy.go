@@ -0,0 +1,103 @@
+// Package policy describes documents such as the terms of service which are
+// versioned so members' acceptance of each version can be recorded.
+package policy
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type DocumentID xid.ID
+
+func (i DocumentID) String() string { return xid.ID(i).String() }
+
+type VersionID xid.ID
+
+func (i VersionID) String() string { return xid.ID(i).String() }
+
+type Document struct {
+	ID        DocumentID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Slug      string
+	Title     string
+	// Required documents must be accepted before a member may post.
+	Required bool
+	// Current is the latest published version, if any have been published.
+	Current opt.Optional[Version]
+}
+
+type Version struct {
+	ID          VersionID
+	CreatedAt   time.Time
+	Version     int
+	Content     datagraph.Content
+	Summary     opt.Optional[string]
+	PublishedAt opt.Optional[time.Time]
+	Acceptances int
+}
+
+func (v *Version) Published() bool { return v.PublishedAt.Ok() }
+
+type Acceptance struct {
+	Account    account.Account
+	AcceptedAt time.Time
+}
+
+// Status is a member's standing with the current version of a document.
+type Status struct {
+	Document   Document
+	AcceptedAt opt.Optional[time.Time]
+	// Previously is set when the member accepted an earlier version but not
+	// the current one, the member must accept the new version.
+	Previously opt.Optional[int]
+}
+
+func (s Status) Pending() bool {
+	return s.Document.Required && s.Document.Current.Ok() && !s.AcceptedAt.Ok()
+}
+
+func MapDocument(in *ent.PolicyDocument, current opt.Optional[*ent.PolicyVersion]) (*Document, error) {
+	cur, err := opt.MapErr(current, func(v *ent.PolicyVersion) (Version, error) {
+		mapped, err := MapVersion(v)
+		if err != nil {
+			return Version{}, err
+		}
+		return *mapped, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		ID:        DocumentID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Slug:      in.Slug,
+		Title:     in.Title,
+		Required:  in.Required,
+		Current:   cur,
+	}, nil
+}
+
+func MapVersion(in *ent.PolicyVersion) (*Version, error) {
+	content, err := datagraph.NewRichText(in.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Version{
+		ID:          VersionID(in.ID),
+		CreatedAt:   in.CreatedAt,
+		Version:     in.Version,
+		Content:     content,
+		Summary:     opt.NewPtr(in.Summary),
+		PublishedAt: opt.NewPtr(in.PublishedAt),
+	}, nil
+}
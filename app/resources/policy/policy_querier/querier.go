@@ -0,0 +1,195 @@
+package policy_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/policy"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_acceptance "github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	ent_document "github.com/Southclaws/storyden/internal/ent/policydocument"
+	ent_version "github.com/Southclaws/storyden/internal/ent/policyversion"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) List(ctx context.Context) ([]*policy.Document, error) {
+	docs, err := q.db.PolicyDocument.Query().
+		Order(ent.Asc(ent_document.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.MapErr(docs, func(d *ent.PolicyDocument) (*policy.Document, error) {
+		return q.withCurrent(ctx, d)
+	})
+}
+
+func (q *Querier) Get(ctx context.Context, slug string) (*policy.Document, error) {
+	d, err := q.db.PolicyDocument.Query().
+		Where(ent_document.Slug(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return q.withCurrent(ctx, d)
+}
+
+func (q *Querier) withCurrent(ctx context.Context, d *ent.PolicyDocument) (*policy.Document, error) {
+	current, err := q.db.PolicyVersion.Query().
+		Where(
+			ent_version.DocumentID(d.ID),
+			ent_version.PublishedAtNotNil(),
+		).
+		Order(ent.Desc(ent_version.FieldVersion)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	doc, err := policy.MapDocument(d, opt.NewSafe(current, current != nil))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return doc, nil
+}
+
+// ListVersions returns every version of the document, drafts included, with
+// the newest first and the number of members who accepted each.
+func (q *Querier) ListVersions(ctx context.Context, id policy.DocumentID) ([]*policy.Version, error) {
+	versions, err := q.db.PolicyVersion.Query().
+		Where(ent_version.DocumentID(xid.ID(id))).
+		Order(ent.Desc(ent_version.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out, err := dt.MapErr(versions, policy.MapVersion)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, v := range out {
+		n, err := q.db.PolicyAcceptance.Query().
+			Where(ent_acceptance.VersionID(xid.ID(v.ID))).
+			Count(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		v.Acceptances = n
+	}
+
+	return out, nil
+}
+
+// GetVersion returns a version of the document by its ID, ensuring the version
+// belongs to the document.
+func (q *Querier) GetVersion(ctx context.Context, id policy.DocumentID, versionID policy.VersionID) (*policy.Version, error) {
+	v, err := q.db.PolicyVersion.Query().
+		Where(
+			ent_version.ID(xid.ID(versionID)),
+			ent_version.DocumentID(xid.ID(id)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	version, err := policy.MapVersion(v)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return version, nil
+}
+
+// ListAcceptances returns who accepted a version and when, oldest first.
+func (q *Querier) ListAcceptances(ctx context.Context, versionID policy.VersionID) ([]*policy.Acceptance, error) {
+	rows, err := q.db.PolicyAcceptance.Query().
+		Where(ent_acceptance.VersionID(xid.ID(versionID))).
+		WithAccount().
+		Order(ent.Asc(ent_acceptance.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out, err := dt.MapErr(rows, func(r *ent.PolicyAcceptance) (*policy.Acceptance, error) {
+		acc, err := account.MapRef(r.Edges.Account)
+		if err != nil {
+			return nil, err
+		}
+		return &policy.Acceptance{Account: *acc, AcceptedAt: r.CreatedAt}, nil
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return out, nil
+}
+
+// Statuses returns the account's standing with every document which has a
+// published version.
+func (q *Querier) Statuses(ctx context.Context, accountID account.AccountID) ([]*policy.Status, error) {
+	docs, err := q.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	docs = dt.Filter(docs, func(d *policy.Document) bool { return d.Current.Ok() })
+
+	out := make([]*policy.Status, 0, len(docs))
+	for _, d := range docs {
+		current := d.Current.OrZero()
+
+		accepted, err := q.db.PolicyAcceptance.Query().
+			Where(
+				ent_acceptance.AccountID(xid.ID(accountID)),
+				ent_acceptance.HasVersionWith(ent_version.DocumentID(xid.ID(d.ID))),
+			).
+			WithVersion().
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		status := &policy.Status{Document: *d}
+		for _, a := range accepted {
+			if a.VersionID == xid.ID(current.ID) {
+				status.AcceptedAt = opt.New(a.CreatedAt)
+			} else if v := a.Edges.Version; v != nil && v.Version > status.Previously.OrZero() {
+				status.Previously = opt.New(v.Version)
+			}
+		}
+		if status.AcceptedAt.Ok() {
+			status.Previously = opt.NewEmpty[int]()
+		}
+
+		out = append(out, status)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,158 @@
+package policy_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/policy"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_version "github.com/Southclaws/storyden/internal/ent/policyversion"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.PolicyDocumentMutation)
+
+func WithTitle(v string) Option {
+	return func(m *ent.PolicyDocumentMutation) {
+		m.SetTitle(v)
+	}
+}
+
+func WithRequired(v bool) Option {
+	return func(m *ent.PolicyDocumentMutation) {
+		m.SetRequired(v)
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, slug string, title string, opts ...Option) (policy.DocumentID, error) {
+	create := w.db.PolicyDocument.Create()
+	mutation := create.Mutation()
+
+	mutation.SetSlug(slug)
+	mutation.SetTitle(title)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	d, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return policy.DocumentID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return policy.DocumentID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return policy.DocumentID(d.ID), nil
+}
+
+func (w *Writer) Update(ctx context.Context, id policy.DocumentID, opts ...Option) error {
+	update := w.db.PolicyDocument.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) Delete(ctx context.Context, id policy.DocumentID) error {
+	if err := w.db.PolicyDocument.DeleteOneID(xid.ID(id)).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// CreateVersion adds an unpublished version numbered after the document's
+// latest version.
+func (w *Writer) CreateVersion(ctx context.Context, id policy.DocumentID, content datagraph.Content, summary opt.Optional[string]) (*policy.Version, error) {
+	latest, err := w.db.PolicyVersion.Query().
+		Where(ent_version.DocumentID(xid.ID(id))).
+		Order(ent.Desc(ent_version.FieldVersion)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	next := 1
+	if latest != nil {
+		next = latest.Version + 1
+	}
+
+	v, err := w.db.PolicyVersion.Create().
+		SetDocumentID(xid.ID(id)).
+		SetVersion(next).
+		SetContent(content.HTML()).
+		SetNillableSummary(summary.Ptr()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	version, err := policy.MapVersion(v)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return version, nil
+}
+
+// Publish makes the version current, versions may only be published once.
+func (w *Writer) Publish(ctx context.Context, versionID policy.VersionID) error {
+	err := w.db.PolicyVersion.Update().
+		Where(
+			ent_version.ID(xid.ID(versionID)),
+			ent_version.PublishedAtIsNil(),
+		).
+		SetPublishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Accept records the account's acceptance of the version, accepting the same
+// version again keeps the original record.
+func (w *Writer) Accept(ctx context.Context, accountID account.AccountID, versionID policy.VersionID) error {
+	err := w.db.PolicyAcceptance.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetVersionID(xid.ID(versionID)).
+		Exec(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
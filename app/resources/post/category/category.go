@@ -9,6 +9,7 @@ import (
 	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/internal/ent"
 )
 
@@ -38,7 +39,14 @@ type Category struct {
 	Recent      []PostMeta
 	PostCount   int
 	Metadata    map[string]any
-	UpdatedAt   time.Time
+	Permissions Permissions
+	// RequiredTags, when not empty, means threads posted in the category must
+	// have at least one of these tags.
+	RequiredTags tag_ref.Names
+	ArchivedAt   opt.Optional[time.Time]
+	OpensAt      opt.Optional[time.Time]
+	ClosesAt     opt.Optional[time.Time]
+	UpdatedAt    time.Time
 }
 
 func PostMetaFromModel(p *ent.Post) *PostMeta {
@@ -90,6 +98,13 @@ func FromModel(c *ent.Category) *Category {
 		Children:    children,
 		Recent:      recent,
 		Metadata:    c.Metadata,
-		UpdatedAt:   c.UpdatedAt,
+		Permissions: dt.Map(c.Edges.Permissions, mapPermission),
+		RequiredTags: dt.Map(c.Edges.RequiredTags, func(t *ent.Tag) tag_ref.Name {
+			return tag_ref.NewName(t.Name)
+		}),
+		ArchivedAt: opt.NewPtr(c.ArchivedAt),
+		OpensAt:    opt.NewPtr(c.OpensAt),
+		ClosesAt:   opt.NewPtr(c.ClosesAt),
+		UpdatedAt:  c.UpdatedAt,
 	}
 }
@@ -2,6 +2,7 @@ package category
 
 import (
 	"context"
+	"time"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
@@ -82,6 +83,45 @@ func WithCoverImageAssetID(id *xid.ID) Option {
 	}
 }
 
+func WithArchivedAt(t *time.Time) Option {
+	return func(cm *ent.CategoryMutation) {
+		if t == nil {
+			cm.ClearArchivedAt()
+			return
+		}
+		cm.SetArchivedAt(*t)
+	}
+}
+
+func WithOpensAt(t *time.Time) Option {
+	return func(cm *ent.CategoryMutation) {
+		if t == nil {
+			cm.ClearOpensAt()
+			return
+		}
+		cm.SetOpensAt(*t)
+	}
+}
+
+func WithClosesAt(t *time.Time) Option {
+	return func(cm *ent.CategoryMutation) {
+		if t == nil {
+			cm.ClearClosesAt()
+			return
+		}
+		cm.SetClosesAt(*t)
+	}
+}
+
+// WithRequiredTags replaces the set of tags, one of which every thread in the
+// category must have, an empty set removes the requirement.
+func WithRequiredTags(ids ...xid.ID) Option {
+	return func(cm *ent.CategoryMutation) {
+		cm.ClearRequiredTags()
+		cm.AddRequiredTagIDs(ids...)
+	}
+}
+
 func WithParent(id *CategoryID) Option {
 	return func(cm *ent.CategoryMutation) {
 		if id == nil {
@@ -127,7 +167,10 @@ func (d *Repository) CreateCategory(ctx context.Context, name, desc, colour stri
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	c, err := d.db.Category.Get(ctx, id)
+	c, err := d.db.Category.Query().
+		Where(category.ID(id)).
+		WithRequiredTags().
+		Only(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -185,6 +228,8 @@ func (d *Repository) GetCategories(ctx context.Context, admin bool) ([]*Category
 		WithCoverImage(func(aq *ent.AssetQuery) {
 			aq.WithParent()
 		}).
+		WithPermissions().
+		WithRequiredTags().
 		Order(ent.Asc(category.FieldSort)).
 		All(ctx)
 	if err != nil {
@@ -213,10 +258,14 @@ func (d *Repository) Get(ctx context.Context, slug string) (*Category, error) {
 	c, err := d.db.Category.
 		Query().
 		Where(category.SlugEQ(slug)).
-		WithChildren().
+		WithChildren(func(cq *ent.CategoryQuery) {
+			cq.WithPermissions()
+		}).
 		WithCoverImage(func(aq *ent.AssetQuery) {
 			aq.WithParent()
 		}).
+		WithPermissions().
+		WithRequiredTags().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -246,6 +295,41 @@ func (d *Repository) Get(ctx context.Context, slug string) (*Category, error) {
 	return category, nil
 }
 
+// GetByID returns a category without its recent posts or post counts, for
+// checking its permissions and state before writing to it.
+func (d *Repository) GetByID(ctx context.Context, id CategoryID) (*Category, error) {
+	c, err := d.db.Category.Query().
+		Where(category.ID(xid.ID(id))).
+		WithPermissions().
+		WithRequiredTags().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return FromModel(c), nil
+}
+
+// LookupByThread returns the category a thread is in, if it has one.
+func (d *Repository) LookupByThread(ctx context.Context, threadID xid.ID) (*Category, bool, error) {
+	c, err := d.db.Category.Query().
+		Where(category.HasPostsWith(post.ID(threadID))).
+		WithPermissions().
+		WithRequiredTags().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return FromModel(c), true, nil
+}
+
 func (d *Repository) UpdateCategory(ctx context.Context, slug string, opts ...Option) (*Category, error) {
 	cat, err := d.db.Category.Query().Where(category.SlugEQ(slug)).Only(ctx)
 	if err != nil {
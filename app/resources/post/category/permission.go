@@ -0,0 +1,164 @@
+package category
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
+)
+
+// RolePermission is what members holding a role may do within a category.
+type RolePermission struct {
+	RoleID       role.RoleID
+	Read         bool
+	CreateThread bool
+	Reply        bool
+	Attach       bool
+}
+
+// Permissions is a category's permission matrix. A category with no entries is
+// unrestricted and only subject to the global permissions of a member's roles,
+// once any entry exists only the listed roles have access to the category.
+type Permissions []RolePermission
+
+func (p Permissions) Restricted() bool { return len(p) > 0 }
+
+func (p Permissions) CanRead(roles role.Roles) bool {
+	return p.allows(roles, func(rp RolePermission) bool { return rp.Read })
+}
+
+// CanCreateThread, like the other write permissions, also requires read access
+// via the same role, a role that can't see the category can't post in it.
+func (p Permissions) CanCreateThread(roles role.Roles) bool {
+	return p.allows(roles, func(rp RolePermission) bool { return rp.Read && rp.CreateThread })
+}
+
+func (p Permissions) CanReply(roles role.Roles) bool {
+	return p.allows(roles, func(rp RolePermission) bool { return rp.Read && rp.Reply })
+}
+
+func (p Permissions) CanAttach(roles role.Roles) bool {
+	return p.allows(roles, func(rp RolePermission) bool { return rp.Read && rp.Attach })
+}
+
+func (p Permissions) allows(roles role.Roles, fn func(RolePermission) bool) bool {
+	if !p.Restricted() || BypassesPermissions(roles) {
+		return true
+	}
+
+	held := map[role.RoleID]bool{}
+	for _, r := range roles {
+		held[r.ID] = true
+	}
+
+	for _, rp := range p {
+		if held[rp.RoleID] && fn(rp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BypassesPermissions reports whether the roles grant access to every category
+// regardless of its permission matrix.
+func BypassesPermissions(roles role.Roles) bool {
+	return roles.Permissions().HasAny(rbac.PermissionAdministrator, rbac.PermissionManageCategories)
+}
+
+// FilterReadable removes categories, and their children, which the roles can't
+// read from a list of categories.
+func FilterReadable(cats []*Category, roles role.Roles) []*Category {
+	return dt.Reduce(cats, func(acc []*Category, c *Category) []*Category {
+		if !c.Permissions.CanRead(roles) {
+			return acc
+		}
+		c.Children = FilterReadable(c.Children, roles)
+		return append(acc, c)
+	}, []*Category{})
+}
+
+func mapPermission(in *ent.CategoryPermission) RolePermission {
+	return RolePermission{
+		RoleID:       role.RoleID(in.RoleID),
+		Read:         in.CanRead,
+		CreateThread: in.CanCreateThread,
+		Reply:        in.CanReply,
+		Attach:       in.CanAttach,
+	}
+}
+
+func (d *Repository) GetPermissions(ctx context.Context, slug string) (Permissions, error) {
+	c, err := d.db.Category.Query().
+		Where(category.SlugEQ(slug)).
+		WithPermissions().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(c.Edges.Permissions, mapPermission), nil
+}
+
+// SetPermissions replaces the entire permission matrix of a category, an empty
+// matrix removes all restrictions from the category.
+func (d *Repository) SetPermissions(ctx context.Context, slug string, perms Permissions) (Permissions, error) {
+	tx, err := d.db.Tx(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	c, err := tx.Category.Query().Where(category.SlugEQ(slug)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	_, err = tx.CategoryPermission.Delete().
+		Where(categorypermission.CategoryID(c.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	creates := dt.Map(perms, func(rp RolePermission) *ent.CategoryPermissionCreate {
+		return tx.CategoryPermission.Create().
+			SetCategoryID(c.ID).
+			SetRoleID(xid.ID(rp.RoleID)).
+			SetCanRead(rp.Read).
+			SetCanCreateThread(rp.CreateThread).
+			SetCanReply(rp.Reply).
+			SetCanAttach(rp.Attach)
+	})
+
+	rows, err := tx.CategoryPermission.CreateBulk(creates...).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(rows, mapPermission), nil
+}
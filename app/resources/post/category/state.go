@@ -0,0 +1,68 @@
+package category
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type stateEnum string
+
+const (
+	stateOpen      stateEnum = "open"
+	stateArchived  stateEnum = "archived"
+	stateScheduled stateEnum = "scheduled"
+	stateClosed    stateEnum = "closed"
+)
+
+var ErrReadOnly = fault.New("category is read-only", ftag.With(ftag.PermissionDenied))
+
+// State is whether the category can be posted in at the given time. Archived
+// and scheduled or closed categories are still visible but read-only.
+func (c *Category) State(now time.Time) State {
+	if c.ArchivedAt.Ok() {
+		return StateArchived
+	}
+
+	if opens, ok := c.OpensAt.Get(); ok && now.Before(opens) {
+		return StateScheduled
+	}
+
+	if closes, ok := c.ClosesAt.Get(); ok && !now.Before(closes) {
+		return StateClosed
+	}
+
+	return StateOpen
+}
+
+// CheckWritable fails if the category is read-only at the given time, unless
+// the roles may manage categories.
+func (c *Category) CheckWritable(ctx context.Context, now time.Time, roles role.Roles) error {
+	if BypassesPermissions(roles) {
+		return nil
+	}
+
+	switch c.State(now) {
+	case StateArchived:
+		return fault.Wrap(ErrReadOnly, fctx.With(ctx),
+			fmsg.WithDesc("archived", "This category has been archived and is read-only."))
+
+	case StateScheduled:
+		return fault.Wrap(ErrReadOnly, fctx.With(ctx),
+			fmsg.WithDesc("not open", "This category is not open for posting yet."))
+
+	case StateClosed:
+		return fault.Wrap(ErrReadOnly, fctx.With(ctx),
+			fmsg.WithDesc("closed", "This category is closed for posting."))
+	}
+
+	return nil
+}
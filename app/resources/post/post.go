@@ -40,7 +40,10 @@ type Post struct {
 	Reacts      []*reaction.React
 	Assets      []*asset.Asset
 	WebLink     opt.Optional[link_ref.LinkRef]
-	Meta        map[string]any
+	// ContentLinks are the links mentioned in the content, with their preview.
+	ContentLinks link_ref.LinkRefs
+	Meta         map[string]any
+	SpamScore    opt.Optional[float64]
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -109,6 +112,10 @@ func Map(in *ent.Post) (*Post, error) {
 		WebLink: link,
 		Meta:    in.Metadata,
 
+		ContentLinks: link_ref.LinksFromModel(in.Edges.ContentLinks),
+
+		SpamScore: opt.NewPtr(in.SpamScore),
+
 		CreatedAt: in.CreatedAt,
 		UpdatedAt: in.UpdatedAt,
 		DeletedAt: opt.NewPtr(in.DeletedAt),
@@ -0,0 +1,62 @@
+package post_read_state
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post"
+)
+
+type Querier struct {
+	raw *sqlx.DB
+}
+
+func NewQuerier(raw *sqlx.DB) *Querier {
+	return &Querier{raw: raw}
+}
+
+const coVisitedQuery = `select
+  r2.root_post_id id,
+  count(*) visits
+from
+  post_reads r1
+  inner join post_reads r2 on r2.account_id = r1.account_id and r2.root_post_id != r1.root_post_id
+  inner join posts p on p.id = r2.root_post_id and p.visibility = 'published' and p.deleted_at is null
+where
+  r1.root_post_id = $1
+group by
+  r2.root_post_id
+order by
+  visits desc
+limit $2
+`
+
+type CoVisit struct {
+	ID     post.ID
+	Visits int
+}
+
+// CoVisited returns the published threads most often read by the members who
+// also read the given thread, ordered by the number of shared readers.
+func (q *Querier) CoVisited(ctx context.Context, threadID post.ID, limit int) ([]CoVisit, error) {
+	var rows []struct {
+		ID     xid.ID `db:"id"`
+		Visits int    `db:"visits"`
+	}
+
+	err := q.raw.SelectContext(ctx, &rows, coVisitedQuery, xid.ID(threadID).String(), limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make([]CoVisit, len(rows))
+	for i, r := range rows {
+		out[i] = CoVisit{ID: post.ID(r.ID), Visits: r.Visits}
+	}
+
+	return out, nil
+}
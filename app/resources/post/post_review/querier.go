@@ -0,0 +1,115 @@
+// Package post_review provides read access to the threads and replies which are
+// held in the review queue, waiting for a moderator to approve or reject them.
+package post_review
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// List returns held posts, oldest first, so the queue is worked in order.
+func (q *Querier) List(ctx context.Context, page pagination.Parameters) (*pagination.Result[*post.Post], error) {
+	query := q.db.Post.Query().
+		Where(
+			ent_post.DeletedAtIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityReview),
+		)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := query.
+		WithAuthor().
+		WithRoot().
+		WithAssets().
+		Order(ent.Asc(ent_post.FieldCreatedAt)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	posts, err := dt.MapErr(r, post.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := pagination.NewPageResult(page, total, posts)
+
+	return &result, nil
+}
+
+// Get returns a post only if it's currently held for review.
+func (q *Querier) Get(ctx context.Context, id post.ID) (*post.Post, error) {
+	r, err := q.db.Post.Query().
+		Where(
+			ent_post.ID(xid.ID(id)),
+			ent_post.DeletedAtIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityReview),
+		).
+		WithAuthor().
+		WithRoot().
+		WithAssets().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p, err := post.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return p, nil
+}
+
+// CountApproved counts an account's posts which are visible to others. Drafts
+// don't count, nor do posts which are still held or were rejected.
+func (q *Querier) CountApproved(ctx context.Context, accountID account.AccountID) (int, error) {
+	n, err := q.db.Post.Query().
+		Where(
+			ent_post.AccountPosts(xid.ID(accountID)),
+			ent_post.DeletedAtIsNil(),
+			ent_post.Or(
+				ent_post.And(
+					ent_post.RootPostIDIsNil(),
+					ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+				),
+				ent_post.And(
+					ent_post.RootPostIDNotNil(),
+					ent_post.VisibilityNEQ(ent_post.VisibilityReview),
+				),
+			),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
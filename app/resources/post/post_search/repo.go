@@ -72,3 +72,9 @@ func WithAuthorHandle(handle string) Filter {
 		)
 	}
 }
+
+func WithPredicates(ps ...predicate.Post) Filter {
+	return func(pq *ent.PostQuery) {
+		pq.Where(ps...)
+	}
+}
@@ -2,14 +2,18 @@ package post_writer
 
 import (
 	"context"
+	"time"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/internal/ent"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
 )
@@ -41,6 +45,12 @@ func WithContentLinks(ids ...xid.ID) Option {
 	}
 }
 
+func WithVisibility(v visibility.Visibility) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetVisibility(ent_post.Visibility(v.String()))
+	}
+}
+
 func (p *PostWriter) Update(ctx context.Context, id post.ID, opts ...Option) (*post.Post, error) {
 	update := p.db.Post.UpdateOneID(xid.ID(id))
 	mutate := update.Mutation()
@@ -58,6 +68,7 @@ func (p *PostWriter) Update(ctx context.Context, id post.ID, opts ...Option) (*p
 		Query().
 		Where(ent_post.IDEQ(xid.ID(id))).
 		WithAuthor().
+		WithRoot().
 		WithCategory().
 		WithTags().
 		WithAssets().
@@ -71,3 +82,42 @@ func (p *PostWriter) Update(ctx context.Context, id post.ID, opts ...Option) (*p
 
 	return post.Map(r)
 }
+
+// DeleteMany deletes posts of any kind. When a thread is deleted, its replies
+// share the thread's deletion time so they're restored with it.
+func (p *PostWriter) DeleteMany(ctx context.Context, ids ...post.ID) error {
+	tx, err := p.db.Tx(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	now := time.Now()
+	rawids := dt.Map(ids, func(in post.ID) xid.ID { return xid.ID(in) })
+
+	err = tx.Post.
+		Update().
+		Where(ent_post.IDIn(rawids...), ent_post.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to delete posts"))
+	}
+
+	err = tx.Post.
+		Update().
+		Where(ent_post.RootPostIDIn(rawids...), ent_post.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to delete thread replies"))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -102,6 +102,9 @@ func (d *database) Get(ctx context.Context, id post.ID) (*Reply, error) {
 		WithAssets(func(aq *ent.AssetQuery) {
 			aq.Order(asset.ByUpdatedAt(), asset.ByCreatedAt())
 		}).
+		WithContentLinks(func(lq *ent.LinkQuery) {
+			lq.WithFaviconImage().WithPrimaryImage()
+		}).
 		Only(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
@@ -136,6 +139,9 @@ func (d *database) Update(ctx context.Context, id post.ID, opts ...Option) (*Rep
 			pq.WithAuthor()
 		}).
 		WithAssets().
+		WithContentLinks(func(lq *ent.LinkQuery) {
+			lq.WithFaviconImage().WithPrimaryImage()
+		}).
 		Only(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
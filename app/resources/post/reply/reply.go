@@ -10,6 +10,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_item_status"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/link/link_ref"
 	"github.com/Southclaws/storyden/app/resources/post/reaction"
 	"github.com/Southclaws/storyden/app/resources/profile"
 	"github.com/rs/xid"
@@ -103,6 +104,8 @@ func Map(m *ent.Post) (*Reply, error) {
 			Assets:  dt.Map(m.Edges.Assets, asset.Map),
 			Meta:    m.Metadata,
 
+			ContentLinks: link_ref.LinksFromModel(m.Edges.ContentLinks),
+
 			CreatedAt: m.CreatedAt,
 			UpdatedAt: m.UpdatedAt,
 			DeletedAt: opt.NewPtr(m.DeletedAt),
@@ -150,6 +153,8 @@ func Mapper(
 				Assets: dt.Map(m.Edges.Assets, asset.Map),
 				Meta:   m.Metadata,
 
+				ContentLinks: link_ref.LinksFromModel(m.Edges.ContentLinks),
+
 				CreatedAt: m.CreatedAt,
 				UpdatedAt: m.UpdatedAt,
 				DeletedAt: opt.NewPtr(m.DeletedAt),
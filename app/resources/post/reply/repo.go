@@ -9,7 +9,9 @@ import (
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/internal/ent"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
 )
 
 type Option func(*ent.PostMutation)
@@ -48,6 +50,18 @@ func WithReplyTo(v post.ID) Option {
 	}
 }
 
+func WithVisibility(v visibility.Visibility) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetVisibility(ent_post.Visibility(v.String()))
+	}
+}
+
+func WithSpamScore(v float64) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetSpamScore(v)
+	}
+}
+
 func WithMeta(meta map[string]any) Option {
 	return func(m *ent.PostMutation) {
 		m.SetMetadata(meta)
@@ -33,13 +33,17 @@ type Thread struct {
 	Pinned      bool
 	LastReplyAt opt.Optional[time.Time]
 
+	AcceptedReplyID opt.Optional[post.ID]
+
 	ReadStatus  opt.Optional[post.ReadStatus]
 	ReplyStatus post.ReplyStatus
 	Replies     pagination.Result[*reply.Reply]
 	Category    opt.Optional[category.Category]
+	SpaceID     opt.Optional[xid.ID]
 	Visibility  visibility.Visibility
 	Tags        tag_ref.Tags
 	Related     datagraph.ItemList
+	Summary     opt.Optional[string]
 }
 
 func (*Thread) GetResourceName() string { return "thread" }
@@ -82,6 +86,8 @@ func Map(m *ent.Post) (*Thread, error) {
 			WebLink: link,
 			Meta:    m.Metadata,
 
+			ContentLinks: link_ref.LinksFromModel(m.Edges.ContentLinks),
+
 			CreatedAt: m.CreatedAt,
 			UpdatedAt: m.UpdatedAt,
 			DeletedAt: opt.NewPtr(m.DeletedAt),
@@ -93,7 +99,10 @@ func Map(m *ent.Post) (*Thread, error) {
 		Pinned:      m.Pinned,
 		LastReplyAt: opt.New(m.LastReplyAt),
 
+		AcceptedReplyID: acceptedReplyID(m),
+
 		Category:   category,
+		SpaceID:    opt.NewPtr(m.SpaceID),
 		Visibility: visibility.NewVisibilityFromEnt(m.Visibility),
 		Tags:       tags,
 	}, nil
@@ -149,6 +158,8 @@ func Mapper(
 				WebLink:     link,
 				Meta:        m.Metadata,
 
+				ContentLinks: link_ref.LinksFromModel(m.Edges.ContentLinks),
+
 				CreatedAt: m.CreatedAt,
 				UpdatedAt: m.UpdatedAt,
 				DeletedAt: opt.NewPtr(m.DeletedAt),
@@ -161,10 +172,17 @@ func Mapper(
 			// Only populate the last-reply-at if there are replies.
 			LastReplyAt: opt.NewSafe(m.LastReplyAt, rs.Status(m.ID).Count > 0),
 
+			AcceptedReplyID: acceptedReplyID(m),
+
 			ReadStatus:  rr.Status(m.ID),
 			ReplyStatus: rs.Status(m.ID),
 			Category:    category,
+			SpaceID:     opt.NewPtr(m.SpaceID),
 			Visibility:  visibility.NewVisibilityFromEnt(m.Visibility),
 		}, nil
 	}
 }
+
+func acceptedReplyID(m *ent.Post) opt.Optional[post.ID] {
+	return opt.Map(opt.NewPtr(m.AcceptedReplyID), func(id xid.ID) post.ID { return post.ID(id) })
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/storyden/app/resources/cachecontrol"
+	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/ent"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/infrastructure/cache"
@@ -23,23 +24,26 @@ const (
 )
 
 type Cache struct {
-	db    *ent.Client
-	store cache.Store
-	clock func() time.Time
+	db       *ent.Client
+	store    cache.Store
+	listings *cache.Namespace
+	clock    func() time.Time
 }
 
 func New(
 	ctx context.Context,
 	lc fx.Lifecycle,
 
+	cfg config.Config,
 	db *ent.Client,
 	store cache.Store,
 	bus *pubsub.Bus,
 ) *Cache {
 	c := &Cache{
-		db:    db,
-		store: store,
-		clock: time.Now,
+		db:       db,
+		store:    store,
+		listings: cache.NewNamespace(store, "thread:list", cfg.CacheQueryTTL),
+		clock:    time.Now,
 	}
 
 	register := func(hook fx.Hook) { lc.Append(hook) }
@@ -66,6 +70,13 @@ func (c *Cache) Store(ctx context.Context, id xid.ID, ts time.Time) error {
 	return c.storeTimestamp(ctx, id, ts)
 }
 
+// Listings holds guest thread listings, dropped whenever a thread is
+// published, approved, changed or removed, receives a reply or a category,
+// including who can read it, changes.
+func (c *Cache) Listings() *cache.Namespace {
+	return c.listings
+}
+
 func (c *Cache) cacheKey(id xid.ID) string {
 	return cachePrefix + id.String()
 }
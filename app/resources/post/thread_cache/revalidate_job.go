@@ -79,5 +79,55 @@ func (c *Cache) subscribe(ctx context.Context, bus *pubsub.Bus) error {
 		return err
 	}
 
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_updated", func(ctx context.Context, evt *message.EventThreadUpdated) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_unpublished", func(ctx context.Context, evt *message.EventThreadUnpublished) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_deleted", func(ctx context.Context, evt *message.EventThreadDeleted) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_reply_deleted", func(ctx context.Context, evt *message.EventThreadReplyDeleted) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
+	// Listings only hold threads in categories guests can read and show each
+	// thread's category, so any change to a category may change them.
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_category_updated", func(ctx context.Context, evt *message.EventCategoryUpdated) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "thread_cache.listings_category_deleted", func(ctx context.Context, evt *message.EventCategoryDeleted) error {
+		return c.listings.Invalidate(ctx)
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
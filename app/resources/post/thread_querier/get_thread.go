@@ -25,6 +25,7 @@ import (
 	ent_account "github.com/Southclaws/storyden/internal/ent/account"
 	ent_asset "github.com/Southclaws/storyden/internal/ent/asset"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
 	ent_react "github.com/Southclaws/storyden/internal/ent/react"
 	ent_tag "github.com/Southclaws/storyden/internal/ent/tag"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/kv"
@@ -115,14 +116,34 @@ func (d *Querier) Get(ctx context.Context, threadID post.ID, pageParams paginati
 		ctx, span := d.ins.InstrumentNamed(ctx, "thread_replies")
 		defer span.End()
 
-		r, err := d.db.Post.Query().
+		// Replies held by automod are only visible to their author.
+		visible := ent_post.VisibilityNEQ(ent_post.VisibilityReview)
+		if id, ok := accountID.Get(); ok {
+			visible = ent_post.Or(visible, ent_post.AccountPosts(xid.ID(id)))
+		}
+
+		q := d.db.Post.Query().
 			Where(
 				ent_post.DeletedAtIsNil(),
 				ent_post.RootPostID(xid.ID(threadID)),
-			).
+				visible,
+			)
+
+		if c, ok := pageParams.Cursor().Get(); ok {
+			t, err := c.Time()
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			q.Where(predicate.Post(c.Seek(false, ent_post.FieldCreatedAt, t, ent_post.FieldID)))
+		}
+
+		r, err := q.
+			WithContentLinks(func(lq *ent.LinkQuery) {
+				lq.WithFaviconImage().WithPrimaryImage()
+			}).
 			Limit(pageParams.Limit()).
 			Offset(pageParams.Offset()).
-			Order(ent.Asc(ent_post.FieldCreatedAt)).
+			Order(ent.Asc(ent_post.FieldCreatedAt), ent.Asc(ent_post.FieldID)).
 			All(ctx)
 		if err != nil {
 			return fault.Wrap(err, fctx.With(ctx))
@@ -148,6 +169,9 @@ func (d *Querier) Get(ctx context.Context, threadID post.ID, pageParams paginati
 			WithLink(func(lq *ent.LinkQuery) {
 				lq.WithFaviconImage().WithPrimaryImage()
 			}).
+			WithContentLinks(func(lq *ent.LinkQuery) {
+				lq.WithFaviconImage().WithPrimaryImage()
+			}).
 			Only(ctx)
 		if err != nil {
 			if ent.IsNotFound(err) {
@@ -226,7 +250,10 @@ func (d *Querier) Get(ctx context.Context, threadID post.ID, pageParams paginati
 	}
 
 	totalReplies := replyStatsMap[threadResult.ID].Count
-	repliesPage := pagination.NewPageResult(pageParams, totalReplies, replies)
+	repliesPage := pagination.NewPageResult(pageParams, totalReplies, replies).
+		WithCursor(func(r *reply.Reply) pagination.Cursor {
+			return pagination.NewTimeCursor(r.CreatedAt, xid.ID(r.ID))
+		})
 
 	p.Replies = repliesPage
 	p.Tags = tags
@@ -234,3 +261,23 @@ func (d *Querier) Get(ctx context.Context, threadID post.ID, pageParams paginati
 
 	return p, nil
 }
+
+// Matches reports whether the thread satisfies all the given queries, used to
+// check permission filters against a single thread without loading it.
+func (d *Querier) Matches(ctx context.Context, threadID post.ID, opts ...Query) (bool, error) {
+	query := d.db.Post.Query().Where(
+		ent_post.ID(xid.ID(threadID)),
+		ent_post.RootPostIDIsNil(),
+	)
+
+	for _, fn := range opts {
+		fn(query)
+	}
+
+	ok, err := query.Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ok, nil
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_item_status"
+	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/internal/ent"
@@ -22,6 +23,7 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
 )
 
 func (d *Querier) List(
@@ -39,6 +41,8 @@ func (d *Querier) List(
 		size = 100
 	}
 
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
 	query := d.db.Post.Query().Where(ent_post.RootPostIDIsNil())
 
 	for _, fn := range opts {
@@ -58,7 +62,7 @@ func (d *Querier) List(
 			lq.WithFaviconImage().WithPrimaryImage()
 			lq.WithAssets().Order(link.ByCreatedAt(sql.OrderDesc()))
 		}).
-		Order(ent.Desc(ent_post.FieldLastReplyAt))
+		Order(ent.Desc(ent_post.FieldLastReplyAt), ent.Desc(ent_post.FieldID))
 
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -76,6 +80,7 @@ func (d *Querier) List(
 
 	isNextPage := len(result) > size
 	nextPage := opt.NewSafe(page+1, isNextPage)
+	var nextCursor opt.Optional[pagination.Cursor]
 	totalPages := int(math.Ceil(float64(total) / float64(size)))
 
 	if len(result) == 0 {
@@ -85,12 +90,15 @@ func (d *Querier) List(
 			TotalPages:  totalPages,
 			CurrentPage: page,
 			NextPage:    nextPage,
+			NextCursor:  nextCursor,
 			Threads:     []*thread.Thread{},
 		}, nil
 	}
 
 	if isNextPage {
 		result = result[:len(result)-1]
+		last := result[len(result)-1]
+		nextCursor = opt.New(pagination.NewTimeCursor(last.LastReplyAt, last.ID))
 	}
 
 	ids := dt.Map(result, func(p *ent.Post) xid.ID { return p.ID })
@@ -154,6 +162,7 @@ func (d *Querier) List(
 		TotalPages:  totalPages,
 		CurrentPage: page,
 		NextPage:    nextPage,
+		NextCursor:  nextCursor,
 		Threads:     threads,
 	}, nil
 }
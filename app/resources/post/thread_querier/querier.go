@@ -9,13 +9,25 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post/category"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/space"
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/internal/ent"
 	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+	"github.com/Southclaws/storyden/internal/ent/accountfollow"
 	ent_category "github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	ent_space "github.com/Southclaws/storyden/internal/ent/space"
+	ent_member "github.com/Southclaws/storyden/internal/ent/spacemember"
 	ent_tag "github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/spanner"
 )
 
@@ -39,6 +51,7 @@ type Result struct {
 	TotalPages  int
 	CurrentPage int
 	NextPage    opt.Optional[int]
+	NextCursor  opt.Optional[pagination.Cursor]
 	Threads     []*thread.Thread
 }
 
@@ -63,6 +76,19 @@ func HasKeyword(s string) Query {
 	}
 }
 
+// HasCursor continues a listing from the last thread of a previous page. The
+// listing is ordered by last reply time so this is also the cursor's key.
+func HasCursor(c pagination.Cursor) (Query, error) {
+	t, err := c.Time()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(q *ent.PostQuery) {
+		q.Where(predicate.Post(c.Seek(true, ent_post.FieldLastReplyAt, t, ent_post.FieldID)))
+	}, nil
+}
+
 func HasCreatedDateBefore(t time.Time) Query {
 	return func(q *ent.PostQuery) {
 		q.Where(ent_post.CreatedAtLT(t))
@@ -101,6 +127,117 @@ func HasCategories(cf CategoryFilter) Query {
 	}
 }
 
+// IsInFeedOf restricts results to threads relevant to the given account's home
+// feed: threads written by followed accounts, posted in followed categories,
+// tags or joined spaces, or threads the account has replied to. The account's
+// own threads are never included.
+func IsInFeedOf(id account.AccountID) Query {
+	return func(q *ent.PostQuery) {
+		q.Where(
+			ent_post.Or(
+				ent_post.HasAuthorWith(ent_account.HasFollowedByWith(accountfollow.FollowerAccountID(xid.ID(id)))),
+				ent_post.HasCategoryWith(ent_category.HasFollowersWith(categoryfollow.AccountID(xid.ID(id)))),
+				ent_post.HasTagsWith(ent_tag.HasFollowersWith(tagfollow.AccountID(xid.ID(id)))),
+				ent_post.HasSpaceWith(ent_space.HasMembersWith(isActiveMember(id))),
+				ent_post.HasPostsWith(
+					ent_post.AccountPosts(xid.ID(id)),
+					ent_post.DeletedAtIsNil(),
+				),
+			),
+			ent_post.AccountPostsNEQ(xid.ID(id)),
+		)
+	}
+}
+
+// IsNotHiddenFrom excludes threads written by members the account has blocked
+// or muted.
+func IsNotHiddenFrom(id account.AccountID) Query {
+	return func(q *ent.PostQuery) {
+		q.Where(ent_post.Not(ent_post.HasAuthorWith(
+			ent_account.HasBlockedByWith(accountblock.AccountID(xid.ID(id))),
+		)))
+	}
+}
+
+func HasSpace(slug string) Query {
+	return func(q *ent.PostQuery) {
+		q.Where(ent_post.HasSpaceWith(ent_space.Slug(slug)))
+	}
+}
+
+// IsReadableBy excludes threads in spaces that aren't open unless the account
+// is one of the space's members.
+func IsReadableBy(id opt.Optional[account.AccountID]) Query {
+	p := SpaceReadableBy(id)
+	return func(q *ent.PostQuery) {
+		q.Where(p)
+	}
+}
+
+// SpaceReadableBy is the predicate form of IsReadableBy, for use on threads
+// reached through an edge such as the root of a reply.
+func SpaceReadableBy(id opt.Optional[account.AccountID]) predicate.Post {
+	readable := []predicate.Space{ent_space.JoinPolicy(space.PolicyOpen.String())}
+	if accountID, ok := id.Get(); ok {
+		readable = append(readable, ent_space.HasMembersWith(isActiveMember(accountID)))
+	}
+
+	return ent_post.Or(
+		ent_post.SpaceIDIsNil(),
+		ent_post.HasSpaceWith(ent_space.Or(readable...)),
+	)
+}
+
+// IsInReadableCategory excludes threads in categories with a permission matrix
+// that doesn't grant read access to any of the given roles.
+func IsInReadableCategory(roles role.Roles) Query {
+	p, ok := CategoryReadableBy(roles).Get()
+	if !ok {
+		return func(q *ent.PostQuery) {}
+	}
+
+	return func(q *ent.PostQuery) {
+		q.Where(p)
+	}
+}
+
+// CategoryReadableBy is the predicate form of IsInReadableCategory, it's empty
+// when the roles bypass category permissions and no filtering is necessary.
+func CategoryReadableBy(roles role.Roles) opt.Optional[predicate.Post] {
+	if category.BypassesPermissions(roles) {
+		return opt.NewEmpty[predicate.Post]()
+	}
+
+	roleIDs := dt.Map(roles, func(r *role.Role) xid.ID { return xid.ID(r.ID) })
+
+	return opt.New(ent_post.Or(
+		ent_post.CategoryIDIsNil(),
+		ent_post.HasCategoryWith(ent_category.Not(ent_category.HasPermissions())),
+		ent_post.HasCategoryWith(ent_category.HasPermissionsWith(
+			categorypermission.RoleIDIn(roleIDs...),
+			categorypermission.CanRead(true),
+		)),
+	))
+}
+
+// PostInReadableCategory is CategoryReadableBy for both threads and replies,
+// a reply is in the category of the thread it belongs to.
+func PostInReadableCategory(roles role.Roles) opt.Optional[predicate.Post] {
+	return opt.Map(CategoryReadableBy(roles), func(p predicate.Post) predicate.Post {
+		return ent_post.Or(
+			ent_post.And(ent_post.RootPostIDIsNil(), p),
+			ent_post.HasRootWith(p),
+		)
+	})
+}
+
+func isActiveMember(id account.AccountID) predicate.SpaceMember {
+	return ent_member.And(
+		ent_member.AccountID(xid.ID(id)),
+		ent_member.Status(space.StatusActive.String()),
+	)
+}
+
 func HasStatus(status ...visibility.Visibility) Query {
 	pv := dt.Map(status, func(v visibility.Visibility) ent_post.Visibility { return ent_post.Visibility(v.String()) })
 	return func(q *ent.PostQuery) {
@@ -12,6 +12,7 @@ import (
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -66,12 +67,24 @@ func WithCategory(v xid.ID) Option {
 	}
 }
 
+func WithSpace(v xid.ID) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetSpaceID(v)
+	}
+}
+
 func WithVisibility(v visibility.Visibility) Option {
 	return func(pm *ent.PostMutation) {
 		pm.SetVisibility(ent_post.Visibility(v.String()))
 	}
 }
 
+func WithSpamScore(v float64) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetSpamScore(v)
+	}
+}
+
 func WithMeta(meta map[string]any) Option {
 	return func(m *ent.PostMutation) {
 		m.SetMetadata(meta)
@@ -110,6 +123,16 @@ func WithTagsRemove(refs ...tag_ref.ID) Option {
 	}
 }
 
+func WithAcceptedReply(id opt.Optional[post.ID]) Option {
+	return func(c *ent.PostMutation) {
+		if v, ok := id.Get(); ok {
+			c.SetAcceptedReplyID(xid.ID(v))
+		} else {
+			c.ClearAcceptedReplyID()
+		}
+	}
+}
+
 func (d *Writer) Create(
 	ctx context.Context,
 	title string,
@@ -220,9 +243,12 @@ func (d *Writer) Update(ctx context.Context, id post.ID, opts ...Option) (*threa
 }
 
 func (d *Writer) Delete(ctx context.Context, id post.ID) error {
+	// Replies share the thread's deletion time so they're restored with it.
+	now := time.Now()
+
 	err := d.db.Post.
 		UpdateOneID(xid.ID(id)).
-		SetDeletedAt(time.Now()).
+		SetDeletedAt(now).
 		Exec(ctx)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to archive thread root post"))
@@ -230,8 +256,8 @@ func (d *Writer) Delete(ctx context.Context, id post.ID) error {
 
 	err = d.db.Post.
 		Update().
-		Where(ent_post.RootPostID(xid.ID(id))).
-		SetDeletedAt(time.Now()).
+		Where(ent_post.RootPostID(xid.ID(id)), ent_post.DeletedAtIsNil()).
+		SetDeletedAt(now).
 		Exec(ctx)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to archive thread posts"))
@@ -0,0 +1,45 @@
+// Package block describes how members keep others out of their way. Blocking
+// a member stops them messaging or mentioning you and hides their content from
+// your feeds, muting only hides their content.
+package block
+
+import (
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Block struct {
+	ID        xid.ID
+	CreatedAt time.Time
+	Kind      Kind
+	Target    profile.Ref
+}
+
+func Map(in *ent.AccountBlock) (*Block, error) {
+	targetEdge, err := in.Edges.TargetOrErr()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	target, err := profile.MapRef(targetEdge)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	kind, err := NewKind(in.Kind)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Block{
+		ID:        in.ID,
+		CreatedAt: in.CreatedAt,
+		Kind:      kind,
+		Target:    *target,
+	}, nil
+}
@@ -0,0 +1,10 @@
+package block
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type kindEnum string
+
+const (
+	kindBlock kindEnum = "block"
+	kindMute  kindEnum = "mute"
+)
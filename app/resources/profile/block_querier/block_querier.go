@@ -0,0 +1,67 @@
+package block_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile/block"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db}
+}
+
+// List returns the members the account has blocked or muted, most recent first.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID) ([]*block.Block, error) {
+	r, err := q.db.AccountBlock.Query().
+		Where(accountblock.AccountID(xid.ID(accountID))).
+		WithTarget().
+		Order(ent.Desc(accountblock.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	blocks, err := dt.MapErr(r, block.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return blocks, nil
+}
+
+// IsBlocked reports whether either member has blocked the other, mutes are not
+// considered as they don't prevent interaction.
+func (q *Querier) IsBlocked(ctx context.Context, a, b account.AccountID) (bool, error) {
+	ok, err := q.db.AccountBlock.Query().
+		Where(
+			accountblock.Kind(block.KindBlock.String()),
+			accountblock.Or(
+				accountblock.And(
+					accountblock.AccountID(xid.ID(a)),
+					accountblock.TargetAccountID(xid.ID(b)),
+				),
+				accountblock.And(
+					accountblock.AccountID(xid.ID(b)),
+					accountblock.TargetAccountID(xid.ID(a)),
+				),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,52 @@
+package block_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile/block"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db}
+}
+
+// Set blocks or mutes the target, replacing any existing relationship.
+func (w *Writer) Set(ctx context.Context, accountID, targetID account.AccountID, kind block.Kind) error {
+	err := w.db.AccountBlock.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetTargetAccountID(xid.ID(targetID)).
+		SetKind(kind.String()).
+		OnConflictColumns(accountblock.FieldAccountID, accountblock.FieldTargetAccountID).
+		UpdateKind().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) Remove(ctx context.Context, accountID, targetID account.AccountID) error {
+	_, err := w.db.AccountBlock.Delete().
+		Where(
+			accountblock.AccountID(xid.ID(accountID)),
+			accountblock.TargetAccountID(xid.ID(targetID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package follow_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountfollow"
+	ent_category "github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+)
+
+func (q *Querier) GetFollowedCategories(ctx context.Context, id account.AccountID) ([]*category.Category, error) {
+	r, err := q.db.Category.Query().
+		Where(ent_category.HasFollowersWith(categoryfollow.AccountID(xid.ID(id)))).
+		WithCoverImage().
+		Order(ent.Asc(ent_category.FieldSort)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, category.FromModel), nil
+}
+
+type ThreadSubscribers struct {
+	AuthorID    account.AccountID
+	Subscribers []account.AccountID
+}
+
+// GetThreadSubscribers returns every account that follows the author, the
+// category or any of the tags of the given thread, excluding the author.
+func (q *Querier) GetThreadSubscribers(ctx context.Context, id post.ID) (*ThreadSubscribers, error) {
+	p, err := q.db.Post.Query().
+		Where(ent_post.ID(xid.ID(id))).
+		WithTags().
+		Only(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	predicates := []predicate.Account{
+		ent_account.HasFollowingWith(accountfollow.FollowingAccountID(p.AccountPosts)),
+	}
+
+	if !p.CategoryID.IsNil() {
+		predicates = append(predicates, ent_account.HasCategoryFollowsWith(categoryfollow.CategoryID(p.CategoryID)))
+	}
+
+	if len(p.Edges.Tags) > 0 {
+		tagIDs := dt.Map(p.Edges.Tags, func(t *ent.Tag) xid.ID { return t.ID })
+		predicates = append(predicates, ent_account.HasTagFollowsWith(tagfollow.TagIDIn(tagIDs...)))
+	}
+
+	ids, err := q.db.Account.Query().
+		Where(
+			ent_account.Or(predicates...),
+			ent_account.IDNEQ(p.AccountPosts),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &ThreadSubscribers{
+		AuthorID:    account.AccountID(p.AccountPosts),
+		Subscribers: dt.Map(ids, func(i xid.ID) account.AccountID { return account.AccountID(i) }),
+	}, nil
+}
+
+// SubscriptionSet holds the identifiers of everything an account follows, used
+// for cheap membership checks when assembling feeds.
+type SubscriptionSet struct {
+	Accounts   map[xid.ID]struct{}
+	Categories map[xid.ID]struct{}
+	Tags       map[xid.ID]struct{}
+}
+
+func (q *Querier) GetSubscriptionSet(ctx context.Context, id account.AccountID) (*SubscriptionSet, error) {
+	accounts, err := q.db.AccountFollow.Query().
+		Where(accountfollow.FollowerAccountID(xid.ID(id))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	categories, err := q.db.CategoryFollow.Query().
+		Where(categoryfollow.AccountID(xid.ID(id))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tags, err := q.db.TagFollow.Query().
+		Where(tagfollow.AccountID(xid.ID(id))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &SubscriptionSet{
+		Accounts:   toSet(dt.Map(accounts, func(f *ent.AccountFollow) xid.ID { return f.FollowingAccountID })),
+		Categories: toSet(dt.Map(categories, func(f *ent.CategoryFollow) xid.ID { return f.CategoryID })),
+		Tags:       toSet(dt.Map(tags, func(f *ent.TagFollow) xid.ID { return f.TagID })),
+	}, nil
+}
+
+func toSet(ids []xid.ID) map[xid.ID]struct{} {
+	set := make(map[xid.ID]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
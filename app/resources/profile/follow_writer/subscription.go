@@ -0,0 +1,92 @@
+package follow_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+)
+
+func (w *Writer) FollowTag(ctx context.Context, follower account.AccountID, name tag_ref.Name) error {
+	tagID, err := w.db.Tag.Query().Where(tag.Name(name.String())).OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = w.db.TagFollow.Create().
+		SetAccountID(xid.ID(follower)).
+		SetTagID(tagID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) UnfollowTag(ctx context.Context, follower account.AccountID, name tag_ref.Name) error {
+	_, err := w.db.TagFollow.Delete().
+		Where(
+			tagfollow.AccountID(xid.ID(follower)),
+			tagfollow.HasTagWith(tag.Name(name.String())),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) FollowCategory(ctx context.Context, follower account.AccountID, slug string) error {
+	categoryID, err := w.db.Category.Query().Where(category.Slug(slug)).OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = w.db.CategoryFollow.Create().
+		SetAccountID(xid.ID(follower)).
+		SetCategoryID(categoryID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) UnfollowCategory(ctx context.Context, follower account.AccountID, slug string) error {
+	_, err := w.db.CategoryFollow.Delete().
+		Where(
+			categoryfollow.AccountID(xid.ID(follower)),
+			categoryfollow.HasCategoryWith(category.Slug(slug)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -24,8 +24,11 @@ type Ref struct {
 	Handle   string
 	Name     string
 	Bio      datagraph.Content
+	Kind     account.AccountKind
 	Admin    bool
 	Metadata map[string]any
+
+	Verification opt.Optional[account.Verification]
 }
 
 func MapRef(a *ent.Account) (*Ref, error) {
@@ -34,6 +37,11 @@ func MapRef(a *ent.Account) (*Ref, error) {
 		return nil, err
 	}
 
+	kind, err := account.NewAccountKind(string(a.Kind))
+	if err != nil {
+		return nil, err
+	}
+
 	return &Ref{
 		ID:       account.AccountID(a.ID),
 		Created:  a.CreatedAt,
@@ -42,8 +50,11 @@ func MapRef(a *ent.Account) (*Ref, error) {
 		Handle:   a.Handle,
 		Name:     a.Name,
 		Bio:      bio,
+		Kind:     kind,
 		Admin:    a.Admin,
 		Metadata: a.Metadata,
+
+		Verification: account.MapVerification(a.Verification),
 	}, nil
 }
 
@@ -57,6 +68,7 @@ type Public struct {
 	Interests     []*tag_ref.Tag
 	ExternalLinks []account.ExternalLink
 	InvitedBy     opt.Optional[Ref]
+	Owner         opt.Optional[Ref]
 }
 
 func (p *Public) GetID() xid.ID                 { return xid.ID(p.ID) }
@@ -103,6 +115,18 @@ func Map(roles held.Roles) func(a *ent.Account) (*Public, error) {
 			return nil, err
 		}
 
+		owner, err := opt.MapErr(opt.NewPtr(a.Edges.Owner), func(o ent.Account) (Ref, error) {
+			p, err := MapRef(&o)
+			if err != nil {
+				return Ref{}, err
+			}
+
+			return *p, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		links, err := dt.MapErr(a.Links, account.MapExternalLink)
 		if err != nil {
 			return nil, fault.Wrap(err)
@@ -117,6 +141,7 @@ func Map(roles held.Roles) func(a *ent.Account) (*Public, error) {
 			Roles:         roles,
 			Interests:     interests,
 			InvitedBy:     invitedBy,
+			Owner:         owner,
 			ExternalLinks: links,
 		}, nil
 	}
@@ -0,0 +1,38 @@
+// Package profile_activity builds a member's activity timeline from the
+// threads, replies, library pages and collections they've published.
+package profile_activity
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+)
+
+type Activity struct {
+	// ID is the ID of the thread, reply, page or collection the activity is
+	// about, there's no separate record of the activity itself.
+	ID   xid.ID
+	Kind account.ActivityKind
+	Time time.Time
+
+	// Either Item or Collection is set depending on the kind of activity.
+	Item       datagraph.Item
+	Collection *collection.Collection
+}
+
+func (a *Activity) Cursor() pagination.Cursor {
+	return pagination.NewTimeCursor(a.Time, a.ID)
+}
+
+// AllKinds is every kind of activity shown on a timeline.
+var AllKinds = []account.ActivityKind{
+	account.ActivityKindThreadCreated,
+	account.ActivityKindReplyCreated,
+	account.ActivityKindNodeUpdated,
+	account.ActivityKindCollectionPublished,
+}
@@ -0,0 +1,281 @@
+package profile_activity
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_collection "github.com/Southclaws/storyden/internal/ent/collection"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+type Querier struct {
+	db          *ent.Client
+	hydrator    *hydrate.Hydrator
+	collections *collection_querier.Querier
+}
+
+func New(db *ent.Client, hydrator *hydrate.Hydrator, collections *collection_querier.Querier) *Querier {
+	return &Querier{db: db, hydrator: hydrator, collections: collections}
+}
+
+// Viewer is who the timeline is being shown to, threads and replies in spaces
+// or categories they can't read are left out.
+type Viewer struct {
+	AccountID opt.Optional[account.AccountID]
+	Roles     role.Roles
+}
+
+// List pages through the member's published activity of the given kinds, most
+// recent first. Each kind is read separately up to a page's worth past the
+// cursor, then merged, so the timeline is consistent across pages.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID, viewer Viewer, kinds []account.ActivityKind, page pagination.Parameters) (pagination.Result[*Activity], error) {
+	var (
+		total int
+		all   []*Activity
+	)
+
+	for _, kind := range lo.Uniq(kinds) {
+		n, activity, err := q.list(ctx, accountID, viewer, kind, page)
+		if err != nil {
+			return pagination.Result[*Activity]{}, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		total += n
+		all = append(all, activity...)
+	}
+
+	slices.SortFunc(all, func(a, b *Activity) int {
+		if c := b.Time.Compare(a.Time); c != 0 {
+			return c
+		}
+		return b.ID.Compare(a.ID)
+	})
+
+	all = lo.Slice(all, 0, page.Limit())
+
+	if err := q.hydrate(ctx, all); err != nil {
+		return pagination.Result[*Activity]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := pagination.NewPageResult(page, total, all).
+		WithCursor(func(a *Activity) pagination.Cursor { return a.Cursor() })
+
+	// Anything removed between listing and hydrating is dropped after the
+	// cursor is taken so the next page still continues from the right place.
+	result.Items = lo.Filter(result.Items, func(a *Activity, _ int) bool {
+		return a.Item != nil || a.Collection != nil
+	})
+	result.Results = len(result.Items)
+
+	return result, nil
+}
+
+func (q *Querier) list(ctx context.Context, accountID account.AccountID, viewer Viewer, kind account.ActivityKind, page pagination.Parameters) (int, []*Activity, error) {
+	switch kind {
+	case account.ActivityKindThreadCreated:
+		return q.listPosts(ctx, kind, page, q.readable(viewer,
+			ent_post.RootPostIDIsNil(),
+			ent_post.AccountPosts(xid.ID(accountID)),
+		))
+
+	case account.ActivityKindReplyCreated:
+		// Replies aren't published individually, only those held for review
+		// are excluded, as when listing a thread's replies.
+		root := q.readable(viewer)
+		return q.listPosts(ctx, kind, page, []predicate.Post{
+			ent_post.RootPostIDNotNil(),
+			ent_post.AccountPosts(xid.ID(accountID)),
+			ent_post.DeletedAtIsNil(),
+			ent_post.VisibilityNEQ(ent_post.VisibilityReview),
+			ent_post.HasRootWith(root...),
+		})
+
+	case account.ActivityKindNodeUpdated:
+		return q.listNodes(ctx, accountID, page)
+
+	case account.ActivityKindCollectionPublished:
+		return q.listCollections(ctx, accountID, page)
+	}
+
+	return 0, nil, nil
+}
+
+// readable filters to published threads the viewer is allowed to read.
+func (q *Querier) readable(viewer Viewer, ps ...predicate.Post) []predicate.Post {
+	ps = append(ps,
+		ent_post.DeletedAtIsNil(),
+		ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+		thread_querier.SpaceReadableBy(viewer.AccountID),
+	)
+
+	if p, ok := thread_querier.CategoryReadableBy(viewer.Roles).Get(); ok {
+		ps = append(ps, p)
+	}
+
+	return ps
+}
+
+func (q *Querier) listPosts(ctx context.Context, kind account.ActivityKind, page pagination.Parameters, ps []predicate.Post) (int, []*Activity, error) {
+	query := q.db.Post.Query().Where(ps...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return 0, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if c, ok := page.Cursor().Get(); ok {
+		t, err := c.Time()
+		if err != nil {
+			return 0, nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		query.Where(predicate.Post(c.Seek(true, ent_post.FieldCreatedAt, t, ent_post.FieldID)))
+	}
+
+	posts, err := query.
+		Order(ent.Desc(ent_post.FieldCreatedAt), ent.Desc(ent_post.FieldID)).
+		Limit(page.Limit()).
+		All(ctx)
+	if err != nil {
+		return 0, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	activity := make([]*Activity, 0, len(posts))
+	for _, p := range posts {
+		activity = append(activity, &Activity{ID: p.ID, Kind: kind, Time: p.CreatedAt})
+	}
+
+	return total, activity, nil
+}
+
+// listNodes lists library pages by when they were last edited, so a page only
+// appears once on the timeline at its most recent change.
+func (q *Querier) listNodes(ctx context.Context, accountID account.AccountID, page pagination.Parameters) (int, []*Activity, error) {
+	query := q.db.Node.Query().Where(
+		ent_node.AccountID(xid.ID(accountID)),
+		ent_node.DeletedAtIsNil(),
+		ent_node.VisibilityEQ(ent_node.VisibilityPublished),
+	)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return 0, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if c, ok := page.Cursor().Get(); ok {
+		t, err := c.Time()
+		if err != nil {
+			return 0, nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		query.Where(predicate.Node(c.Seek(true, ent_node.FieldUpdatedAt, t, ent_node.FieldID)))
+	}
+
+	nodes, err := query.
+		Order(ent.Desc(ent_node.FieldUpdatedAt), ent.Desc(ent_node.FieldID)).
+		Limit(page.Limit()).
+		All(ctx)
+	if err != nil {
+		return 0, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	activity := make([]*Activity, 0, len(nodes))
+	for _, n := range nodes {
+		activity = append(activity, &Activity{ID: n.ID, Kind: account.ActivityKindNodeUpdated, Time: n.UpdatedAt})
+	}
+
+	return total, activity, nil
+}
+
+// listCollections lists collections by when they were created, collections
+// don't have drafts so they're published to the member's profile immediately.
+func (q *Querier) listCollections(ctx context.Context, accountID account.AccountID, page pagination.Parameters) (int, []*Activity, error) {
+	query := q.db.Collection.Query().Where(
+		ent_collection.HasOwnerWith(ent_account.ID(xid.ID(accountID))),
+	)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return 0, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if c, ok := page.Cursor().Get(); ok {
+		t, err := c.Time()
+		if err != nil {
+			return 0, nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		query.Where(predicate.Collection(c.Seek(true, ent_collection.FieldCreatedAt, t, ent_collection.FieldID)))
+	}
+
+	cols, err := query.
+		Order(ent.Desc(ent_collection.FieldCreatedAt), ent.Desc(ent_collection.FieldID)).
+		Limit(page.Limit()).
+		All(ctx)
+	if err != nil {
+		return 0, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	activity := make([]*Activity, 0, len(cols))
+	for _, c := range cols {
+		activity = append(activity, &Activity{ID: c.ID, Kind: account.ActivityKindCollectionPublished, Time: c.CreatedAt})
+	}
+
+	return total, activity, nil
+}
+
+func (q *Querier) hydrate(ctx context.Context, activity []*Activity) error {
+	refs := []*datagraph.Ref{}
+	for _, a := range activity {
+		switch a.Kind {
+		case account.ActivityKindThreadCreated:
+			refs = append(refs, &datagraph.Ref{ID: a.ID, Kind: datagraph.KindThread})
+		case account.ActivityKindReplyCreated:
+			refs = append(refs, &datagraph.Ref{ID: a.ID, Kind: datagraph.KindReply})
+		case account.ActivityKindNodeUpdated:
+			refs = append(refs, &datagraph.Ref{ID: a.ID, Kind: datagraph.KindNode})
+		case account.ActivityKindCollectionPublished:
+			c, err := q.collections.Probe(ctx, collection.NewID(a.ID))
+			if err != nil {
+				if ent.IsNotFound(err) {
+					continue
+				}
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			a.Collection = c
+		}
+	}
+
+	if len(refs) == 0 {
+		return nil
+	}
+
+	items, err := q.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	byID := lo.KeyBy(items, func(i datagraph.Item) xid.ID { return i.GetID() })
+	for _, a := range activity {
+		if i, ok := byID[a.ID]; ok {
+			a.Item = i
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/resources/cachecontrol"
+	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/cache"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
@@ -19,19 +20,22 @@ const (
 )
 
 type Cache struct {
-	store cache.Store
-	clock func() time.Time
+	store    cache.Store
+	profiles *cache.Namespace
+	clock    func() time.Time
 }
 
 func New(
 	ctx context.Context,
 	lc fx.Lifecycle,
+	cfg config.Config,
 	store cache.Store,
 	bus *pubsub.Bus,
 ) *Cache {
 	c := &Cache{
-		store: store,
-		clock: time.Now,
+		store:    store,
+		profiles: cache.NewNamespace(store, "profile:public", cfg.CacheQueryTTL),
+		clock:    time.Now,
 	}
 
 	register := func(hook fx.Hook) { lc.Append(hook) }
@@ -58,6 +62,12 @@ func (c *Cache) Store(ctx context.Context, id xid.ID, ts time.Time) error {
 	return c.storeTimestamp(ctx, id, ts)
 }
 
+// Profiles holds rendered public profiles keyed by account ID, an entry is
+// dropped when its account is updated.
+func (c *Cache) Profiles() *cache.Namespace {
+	return c.profiles
+}
+
 func (c *Cache) cacheKey(id xid.ID) string {
 	return cachePrefix + id.String()
 }
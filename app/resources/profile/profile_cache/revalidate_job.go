@@ -20,5 +20,11 @@ func (c *Cache) subscribe(ctx context.Context, bus *pubsub.Bus) error {
 		return err
 	}
 
+	if _, err := pubsub.Subscribe(ctx, bus, "profile_cache.drop_updated", func(ctx context.Context, evt *message.EventAccountUpdated) error {
+		return c.profiles.Delete(ctx, evt.ID.String())
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
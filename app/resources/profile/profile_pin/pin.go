@@ -0,0 +1,75 @@
+// Package profile_pin stores the threads and library pages members have pinned
+// to their profiles, in the order they chose.
+package profile_pin
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/schema"
+)
+
+// DefaultLimit is how many items a member may pin when the instance hasn't
+// configured a limit.
+const DefaultLimit = 6
+
+// Limit resolves the configured pin limit, zero disables pinning entirely.
+func Limit(v opt.Optional[int]) int {
+	l := v.Or(DefaultLimit)
+	if l < 0 {
+		return DefaultLimit
+	}
+	return l
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Get(ctx context.Context, accountID account.AccountID) ([]datagraph.Ref, error) {
+	acc, err := r.db.Account.Get(ctx, xid.ID(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	pins, err := dt.MapErr(acc.PinnedItems, func(p schema.PinnedItem) (datagraph.Ref, error) {
+		kind, err := datagraph.NewKind(p.Kind)
+		if err != nil {
+			return datagraph.Ref{}, err
+		}
+		return datagraph.Ref{ID: p.ID, Kind: kind}, nil
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pins, nil
+}
+
+// Set replaces the member's pinned items, an empty list unpins everything.
+func (r *Repository) Set(ctx context.Context, accountID account.AccountID, pins []datagraph.Ref) error {
+	items := dt.Map(pins, func(p datagraph.Ref) schema.PinnedItem {
+		return schema.PinnedItem{ID: p.ID, Kind: p.Kind.String()}
+	})
+
+	err := r.db.Account.UpdateOneID(xid.ID(accountID)).
+		SetPinnedItems(items).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -31,6 +31,7 @@ func (d *Querier) GetByID(ctx context.Context, id account.AccountID) (*profile.P
 		Where(account_ent.ID(xid.ID(id))).
 		WithTags().
 		WithEmails().
+		WithOwner().
 		WithAccountRoles(func(arq *ent.AccountRolesQuery) { arq.WithRole() }).
 		WithInvitedBy(func(iq *ent.InvitationQuery) {
 			iq.WithCreator(func(aq *ent.AccountQuery) {
@@ -74,6 +75,7 @@ func (d *Querier) LookupByHandle(ctx context.Context, handle string) (*profile.P
 		WithInvitedBy(func(iq *ent.InvitationQuery) {
 			iq.WithCreator()
 		}).
+		WithOwner().
 		WithTags()
 
 	result, err := q.Only(ctx)
@@ -9,9 +9,12 @@ import (
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/opt"
 
+	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/profile"
 	"github.com/Southclaws/storyden/internal/ent"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
 )
 
 type Filter func(*ent.AccountQuery)
@@ -22,6 +25,7 @@ type Result struct {
 	TotalPages  int
 	CurrentPage int
 	NextPage    opt.Optional[int]
+	NextCursor  opt.Optional[pagination.Cursor]
 	Profiles    []*profile.Public
 }
 
@@ -50,6 +54,19 @@ func WithNamesLike(q string) Filter {
 	}
 }
 
+// WithCursor continues from the last profile of a previous page, profiles are
+// listed newest first so the cursor's key is the account's creation time.
+func WithCursor(c pagination.Cursor) (Filter, error) {
+	t, err := c.Time()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(pq *ent.AccountQuery) {
+		pq.Where(predicate.Account(c.Seek(true, account.FieldCreatedAt, t, account.FieldID)))
+	}, nil
+}
+
 type database struct {
 	db *ent.Client
 }
@@ -59,6 +76,8 @@ func New(db *ent.Client) Repository {
 }
 
 func (d *database) Search(ctx context.Context, page int, size int, filters ...Filter) (*Result, error) {
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
 	total, err := d.db.Account.Query().Count(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -74,9 +93,9 @@ func (d *database) Search(ctx context.Context, page int, size int, filters ...Fi
 			})
 		}).
 		WithAuthentication().
-		Limit(size + 1).
-		Offset(page * size).
-		Order(ent.Desc(account.FieldCreatedAt))
+		Limit(size+1).
+		Offset(page*size).
+		Order(ent.Desc(account.FieldCreatedAt), ent.Desc(account.FieldID))
 
 	for _, fn := range filters {
 		fn(q)
@@ -87,10 +106,13 @@ func (d *database) Search(ctx context.Context, page int, size int, filters ...Fi
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	nextPage := opt.NewSafe(page+1, len(r) >= size)
+	nextPage := opt.NewSafe(page+1, len(r) > size)
+	var nextCursor opt.Optional[pagination.Cursor]
 
 	if len(r) > size {
 		r = r[:len(r)-1]
+		last := r[len(r)-1]
+		nextCursor = opt.New(pagination.NewTimeCursor(last.CreatedAt, last.ID))
 	}
 
 	// hr, err := d.roleQuerier.ListFor(ctx, result)
@@ -109,6 +131,7 @@ func (d *database) Search(ctx context.Context, page int, size int, filters ...Fi
 		TotalPages:  int(math.Ceil(float64(total) / float64(size))),
 		CurrentPage: page,
 		NextPage:    nextPage,
+		NextCursor:  nextCursor,
 		Profiles:    profiles,
 	}, nil
 }
@@ -0,0 +1,115 @@
+// Package rate_limit describes how many API requests a client may make within
+// the rate limit period. Routes are grouped into classes which each have their
+// own limit, and administrators may override the limits for members with a
+// particular role or for requests made with a particular access key.
+package rate_limit
+
+import (
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type classEnum string
+
+const (
+	classRead   classEnum = "read"
+	classWrite  classEnum = "write"
+	classAuth   classEnum = "auth"
+	classUpload classEnum = "upload"
+)
+
+// Limits is how many requests may be made to each class of route within the
+// period. Unset classes fall back to the next most general limit and a limit of
+// zero removes the limit for that class entirely.
+type Limits struct {
+	Read   opt.Optional[int]
+	Write  opt.Optional[int]
+	Auth   opt.Optional[int]
+	Upload opt.Optional[int]
+}
+
+func (l Limits) Get(c Class) opt.Optional[int] {
+	switch c {
+	case ClassRead:
+		return l.Read
+	case ClassWrite:
+		return l.Write
+	case ClassAuth:
+		return l.Auth
+	case ClassUpload:
+		return l.Upload
+	default:
+		return opt.NewEmpty[int]()
+	}
+}
+
+type RoleLimits struct {
+	RoleID xid.ID
+	Limits Limits
+}
+
+type AccessKeyLimits struct {
+	AccessKeyID xid.ID
+	Limits      Limits
+}
+
+type Settings struct {
+	// Default applies to every client without a more specific override, unset
+	// classes use the limits derived from the environment's configuration.
+	Default Limits
+
+	// Roles override the default for members holding the role. When a member
+	// holds multiple roles with an override, the most generous limit applies.
+	Roles []RoleLimits
+
+	// AccessKeys override all other limits for requests using the access key.
+	AccessKeys []AccessKeyLimits
+}
+
+// Limit resolves the limit for a class of route for a client holding the given
+// roles and optionally using an access key. Zero means the class is unlimited.
+func (s Settings) Limit(c Class, roles []xid.ID, accessKey opt.Optional[xid.ID], fallback int) int {
+	if id, ok := accessKey.Get(); ok {
+		for _, ak := range s.AccessKeys {
+			if ak.AccessKeyID == id {
+				if v, ok := ak.Limits.Get(c).Get(); ok {
+					return max(v, 0)
+				}
+			}
+		}
+	}
+
+	found := false
+	best := 0
+	for _, rl := range s.Roles {
+		for _, id := range roles {
+			if rl.RoleID != id {
+				continue
+			}
+
+			v, ok := rl.Limits.Get(c).Get()
+			if !ok {
+				continue
+			}
+
+			switch {
+			case v <= 0:
+				return 0
+			case !found || v > best:
+				best = v
+			}
+			found = true
+		}
+	}
+	if found {
+		return best
+	}
+
+	if v, ok := s.Default.Get(c).Get(); ok {
+		return max(v, 0)
+	}
+
+	return fallback
+}
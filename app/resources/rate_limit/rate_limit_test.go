@@ -0,0 +1,37 @@
+package rate_limit
+
+import (
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimit(t *testing.T) {
+	a := assert.New(t)
+
+	member, trusted, bot, key := xid.New(), xid.New(), xid.New(), xid.New()
+
+	s := Settings{
+		Default: Limits{Write: opt.New(100)},
+		Roles: []RoleLimits{
+			{RoleID: member, Limits: Limits{Write: opt.New(200)}},
+			{RoleID: trusted, Limits: Limits{Write: opt.New(500)}},
+			{RoleID: bot, Limits: Limits{Write: opt.New(0)}},
+		},
+		AccessKeys: []AccessKeyLimits{
+			{AccessKeyID: key, Limits: Limits{Write: opt.New(10)}},
+		},
+	}
+
+	none := opt.NewEmpty[xid.ID]()
+
+	a.Equal(1000, s.Limit(ClassRead, nil, none, 1000), "unset classes use the fallback")
+	a.Equal(100, s.Limit(ClassWrite, nil, none, 1000))
+	a.Equal(200, s.Limit(ClassWrite, []xid.ID{member}, none, 1000))
+	a.Equal(500, s.Limit(ClassWrite, []xid.ID{member, trusted}, none, 1000), "the most generous role wins")
+	a.Equal(0, s.Limit(ClassWrite, []xid.ID{member, bot}, none, 1000), "zero is unlimited")
+	a.Equal(10, s.Limit(ClassWrite, []xid.ID{trusted}, opt.New(key), 1000), "access keys take precedence")
+	a.Equal(500, s.Limit(ClassWrite, []xid.ID{trusted}, opt.New(xid.New()), 1000))
+}
@@ -0,0 +1,136 @@
+package reading_history
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
+)
+
+type Querier struct {
+	db       *ent.Client
+	hydrator *hydrate.Hydrator
+}
+
+func NewQuerier(db *ent.Client, hydrator *hydrate.Hydrator) *Querier {
+	return &Querier{db: db, hydrator: hydrator}
+}
+
+type Query func(*ent.ReadingHistoryQuery)
+
+func WithKind(kinds ...datagraph.Kind) Query {
+	return func(q *ent.ReadingHistoryQuery) {
+		if len(kinds) == 0 {
+			return
+		}
+		q.Where(readinghistory.ItemKindIn(dt.Map(kinds, func(k datagraph.Kind) string { return k.String() })...))
+	}
+}
+
+// List pages through the member's reading history, most recently read first.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID, page pagination.Parameters, opts ...Query) (pagination.Result[*Entry], error) {
+	query := q.db.ReadingHistory.Query().
+		Where(readinghistory.AccountID(xid.ID(accountID)))
+
+	for _, fn := range opts {
+		fn(query)
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return pagination.Result[*Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		Order(ent.Desc(readinghistory.FieldUpdatedAt), ent.Desc(readinghistory.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return pagination.Result[*Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	entries, err := dt.MapErr(result, Map)
+	if err != nil {
+		return pagination.Result[*Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := q.hydrate(ctx, entries); err != nil {
+		return pagination.Result[*Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pagination.NewPageResult(page, total, entries), nil
+}
+
+func (q *Querier) Get(ctx context.Context, accountID account.AccountID, itemID xid.ID) (*Entry, error) {
+	r, err := q.db.ReadingHistory.Query().
+		Where(
+			readinghistory.AccountID(xid.ID(accountID)),
+			readinghistory.ItemID(itemID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	e, err := Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := q.hydrate(ctx, Entries{e}); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return e, nil
+}
+
+// Enabled reports whether the member currently allows history to be recorded.
+func (q *Querier) Enabled(ctx context.Context, accountID account.AccountID) (bool, error) {
+	acc, err := q.db.Account.Query().
+		Where(ent_account.ID(xid.ID(accountID))).
+		Select(ent_account.FieldReadingHistoryEnabled).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return acc.ReadingHistoryEnabled, nil
+}
+
+func (q *Querier) hydrate(ctx context.Context, entries Entries) error {
+	refs := dt.Map(entries, func(e *Entry) *datagraph.Ref { return &e.Target })
+
+	items, err := q.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	byID := lo.KeyBy(items, func(i datagraph.Item) xid.ID { return i.GetID() })
+
+	for _, e := range entries {
+		if item, ok := byID[e.Target.ID]; ok {
+			e.Item = item
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Package reading_history stores which threads and library pages each member
+// has read and how far they got through them. History is private to the
+// member and is only recorded while they have it enabled.
+package reading_history
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+type Entry struct {
+	ID ID
+
+	// FirstReadAt is when the member first opened the item and LastReadAt is
+	// the most recent time, which the history is ordered by.
+	FirstReadAt time.Time
+	LastReadAt  time.Time
+
+	AccountID account.AccountID
+	Target    datagraph.Ref
+	Position  opt.Optional[string]
+
+	// Item is the hydrated target, nil if it's no longer available.
+	Item datagraph.Item
+}
+
+type Entries []*Entry
+
+func Map(in *ent.ReadingHistory) (*Entry, error) {
+	kind, err := datagraph.NewKind(in.ItemKind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		ID:          ID(in.ID),
+		FirstReadAt: in.CreatedAt,
+		LastReadAt:  in.UpdatedAt,
+		AccountID:   account.AccountID(in.AccountID),
+		Target: datagraph.Ref{
+			ID:   in.ItemID,
+			Kind: kind,
+		},
+		Position: opt.NewPtr(in.Position),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package reading_history
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// Record marks the item as read just now. The position is only replaced when
+// one is given so simply opening an item doesn't lose the member's place.
+func (w *Writer) Record(ctx context.Context, accountID account.AccountID, target datagraph.Ref, position opt.Optional[string]) error {
+	err := w.db.ReadingHistory.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetItemID(target.ID).
+		SetItemKind(target.Kind.String()).
+		SetNillablePosition(position.Ptr()).
+		OnConflictColumns(readinghistory.FieldAccountID, readinghistory.FieldItemID).
+		Update(func(u *ent.ReadingHistoryUpsert) {
+			u.UpdateUpdatedAt()
+			if position.Ok() {
+				u.UpdatePosition()
+			}
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Remove forgets a single item from the member's history, if it's there.
+func (w *Writer) Remove(ctx context.Context, accountID account.AccountID, itemID xid.ID) error {
+	_, err := w.db.ReadingHistory.Delete().
+		Where(
+			readinghistory.AccountID(xid.ID(accountID)),
+			readinghistory.ItemID(itemID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Clear forgets the member's entire reading history.
+func (w *Writer) Clear(ctx context.Context, accountID account.AccountID) error {
+	_, err := w.db.ReadingHistory.Delete().
+		Where(readinghistory.AccountID(xid.ID(accountID))).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
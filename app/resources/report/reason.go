@@ -0,0 +1,12 @@
+package report
+
+type reasonEnum string
+
+const (
+	reasonSpam           reasonEnum = "spam"
+	reasonHarassment     reasonEnum = "harassment"
+	reasonInappropriate  reasonEnum = "inappropriate"
+	reasonMisinformation reasonEnum = "misinformation"
+	reasonOffTopic       reasonEnum = "off_topic"
+	reasonOther          reasonEnum = "other"
+)
@@ -26,6 +26,7 @@ type Report struct {
 	TargetItem     datagraph.Item
 	ReportedBy     account.Account
 	HandledBy      opt.Optional[account.Account]
+	Reason         opt.Optional[Reason]
 	Comment        opt.Optional[string]
 }
 
@@ -44,6 +45,7 @@ type ReportRef struct {
 	TargetRef  datagraph.Ref
 	ReportedBy account.Account
 	HandledBy  opt.Optional[account.Account]
+	Reason     opt.Optional[Reason]
 	Comment    opt.Optional[string]
 }
 
@@ -77,6 +79,11 @@ func Map(r *ent.Report) (*ReportRef, error) {
 		return nil, err
 	}
 
+	reason, err := opt.MapErr(opt.NewPtr(r.Reason), NewReason)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ReportRef{
 		ID:        ID(r.ID),
 		CreatedAt: r.CreatedAt,
@@ -88,6 +95,7 @@ func Map(r *ent.Report) (*ReportRef, error) {
 		Status:     status,
 		ReportedBy: *reportedBy,
 		HandledBy:  handledBy,
+		Reason:     reason,
 		Comment:    opt.NewPtr(r.Comment),
 	}, nil
 }
@@ -7,6 +7,73 @@ import (
 	"fmt"
 )
 
+type Reason struct {
+	v reasonEnum
+}
+
+var (
+	ReasonSpam           = Reason{reasonSpam}
+	ReasonHarassment     = Reason{reasonHarassment}
+	ReasonInappropriate  = Reason{reasonInappropriate}
+	ReasonMisinformation = Reason{reasonMisinformation}
+	ReasonOffTopic       = Reason{reasonOffTopic}
+	ReasonOther          = Reason{reasonOther}
+)
+
+func (r Reason) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r Reason) String() string {
+	return string(r.v)
+}
+func (r Reason) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *Reason) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewReason(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r Reason) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *Reason) Scan(__iNpUt__ any) error {
+	s, err := NewReason(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewReason(__iNpUt__ string) (Reason, error) {
+	switch __iNpUt__ {
+	case string(reasonSpam):
+		return ReasonSpam, nil
+	case string(reasonHarassment):
+		return ReasonHarassment, nil
+	case string(reasonInappropriate):
+		return ReasonInappropriate, nil
+	case string(reasonMisinformation):
+		return ReasonMisinformation, nil
+	case string(reasonOffTopic):
+		return ReasonOffTopic, nil
+	case string(reasonOther):
+		return ReasonOther, nil
+	default:
+		return Reason{}, fmt.Errorf("invalid value for type 'Reason': '%s'", __iNpUt__)
+	}
+}
+
 type Status struct {
 	v statusEnum
 }
@@ -14,7 +81,9 @@ type Status struct {
 var (
 	StatusSubmitted    = Status{statusSubmitted}
 	StatusAcknowledged = Status{statusAcknowledged}
+	StatusEscalated    = Status{statusEscalated}
 	StatusResolved     = Status{statusResolved}
+	StatusDismissed    = Status{statusDismissed}
 )
 
 func (r Status) Format(f fmt.State, verb rune) {
@@ -58,8 +127,12 @@ func NewStatus(__iNpUt__ string) (Status, error) {
 		return StatusSubmitted, nil
 	case string(statusAcknowledged):
 		return StatusAcknowledged, nil
+	case string(statusEscalated):
+		return StatusEscalated, nil
 	case string(statusResolved):
 		return StatusResolved, nil
+	case string(statusDismissed):
+		return StatusDismissed, nil
 	default:
 		return Status{}, fmt.Errorf("invalid value for type 'Status': '%s'", __iNpUt__)
 	}
@@ -194,6 +194,7 @@ func (q *Querier) hydrateRefs(ctx context.Context, refs report.ReportRefs) (repo
 			TargetItem:     item,
 			ReportedBy:     r.ReportedBy,
 			HandledBy:      r.HandledBy,
+			Reason:         r.Reason,
 			Comment:        r.Comment,
 			Status:         r.Status,
 			CreatedAt:      r.CreatedAt,
@@ -230,3 +231,60 @@ func (q *Querier) Get(ctx context.Context, id report.ID) (*report.Report, error)
 
 	return reports[0], nil
 }
+
+func WithIDs(ids ...xid.ID) Query {
+	return func(q *ent.ReportQuery) {
+		q.Where(entreport.IDIn(ids...))
+	}
+}
+
+// ListTargets pages through reports aggregated by their target. Aggregation
+// happens after loading every matching report, which is fine for the queue as
+// it only ever holds what moderators have yet to get to.
+func (q *Querier) ListTargets(
+	ctx context.Context,
+	page pagination.Parameters,
+	opts ...Query,
+) (pagination.Result[*report.Target], error) {
+	reports, err := q.ListAll(ctx, opts...)
+	if err != nil {
+		return pagination.Result[*report.Target]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	targets := report.Aggregate(reports)
+
+	start := min(page.Offset(), len(targets))
+	end := min(start+page.Limit(), len(targets))
+
+	return pagination.NewPageResult(page, len(targets), targets[start:end]), nil
+}
+
+func (q *Querier) ListAll(ctx context.Context, opts ...Query) (report.Reports, error) {
+	query := q.db.Report.Query()
+
+	for _, fn := range opts {
+		fn(query)
+	}
+
+	query.
+		WithReportedBy().
+		WithHandledBy().
+		Order(ent.Asc(entreport.FieldCreatedAt))
+
+	result, err := query.All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs, err := dt.MapErr(result, report.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	reports, err := q.hydrateRefs(ctx, refs)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return reports, nil
+}
@@ -3,8 +3,10 @@ package report_writer
 import (
 	"context"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/report"
 	"github.com/Southclaws/storyden/app/resources/report/report_querier"
 	"github.com/Southclaws/storyden/internal/ent"
+	entreport "github.com/Southclaws/storyden/internal/ent/report"
 )
 
 type Writer struct {
@@ -29,6 +32,7 @@ func (w *Writer) Create(
 	targetID xid.ID,
 	targetKind datagraph.Kind,
 	reportedBy account.AccountID,
+	reason opt.Optional[report.Reason],
 	comment opt.Optional[string],
 ) (*report.Report, error) {
 	create := w.db.Report.Create().
@@ -37,6 +41,7 @@ func (w *Writer) Create(
 		SetReportedByID(xid.ID(reportedBy)).
 		SetStatus(report.StatusSubmitted.String())
 
+	reason.Call(func(value report.Reason) { create.SetReason(value.String()) })
 	comment.Call(func(value string) { create.SetComment(value) })
 
 	r, err := create.Save(ctx)
@@ -65,3 +70,38 @@ func (w *Writer) Update(
 
 	return w.querier.Get(ctx, report.ID(r.ID))
 }
+
+// UpdateTarget moves every report against a target that's currently in one of
+// the given statuses to a new status in one go.
+func (w *Writer) UpdateTarget(
+	ctx context.Context,
+	targetID xid.ID,
+	from []report.Status,
+	status report.Status,
+	handledBy account.AccountID,
+) (report.Reports, error) {
+	ids, err := w.db.Report.Query().
+		Where(
+			entreport.TargetID(targetID),
+			entreport.StatusIn(dt.Map(from, func(s report.Status) string { return s.String() })...),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(ids) == 0 {
+		return nil, fault.New("no open reports for target", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	err = w.db.Report.Update().
+		Where(entreport.IDIn(ids...)).
+		SetStatus(status.String()).
+		SetHandledByID(xid.ID(handledBy)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.ListAll(ctx, report_querier.WithIDs(ids...))
+}
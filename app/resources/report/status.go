@@ -7,5 +7,14 @@ type statusEnum string
 const (
 	statusSubmitted    statusEnum = "submitted"
 	statusAcknowledged statusEnum = "acknowledged"
+	statusEscalated    statusEnum = "escalated"
 	statusResolved     statusEnum = "resolved"
+	statusDismissed    statusEnum = "dismissed"
 )
+
+// OpenStatuses are the statuses of reports still waiting in the queue.
+var OpenStatuses = []Status{StatusSubmitted, StatusAcknowledged, StatusEscalated}
+
+func (s Status) IsOpen() bool {
+	return s == StatusSubmitted || s == StatusAcknowledged || s == StatusEscalated
+}
@@ -0,0 +1,88 @@
+package report
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+// Target aggregates every open report against a single piece of content or
+// member so moderators can act on it once rather than report by report.
+type Target struct {
+	ID            xid.ID
+	Kind          datagraph.Kind
+	Item          datagraph.Item
+	Status        Status
+	Reasons       map[Reason]int
+	Reports       Reports
+	FirstReportAt time.Time
+	LastReportAt  time.Time
+}
+
+func (t *Target) Count() int { return len(t.Reports) }
+
+// Aggregate groups reports by their target. Escalated targets come first, then
+// the most reported, then the most recently reported.
+func Aggregate(reports Reports) []*Target {
+	byID := map[xid.ID]*Target{}
+	targets := []*Target{}
+
+	for _, r := range reports {
+		t, ok := byID[r.TargetItemID]
+		if !ok {
+			t = &Target{
+				ID:            r.TargetItemID,
+				Kind:          r.TargetItemKind,
+				Item:          r.TargetItem,
+				Status:        r.Status,
+				Reasons:       map[Reason]int{},
+				FirstReportAt: r.CreatedAt,
+				LastReportAt:  r.CreatedAt,
+			}
+			byID[r.TargetItemID] = t
+			targets = append(targets, t)
+		}
+
+		t.Reports = append(t.Reports, r)
+
+		if reason, ok := r.Reason.Get(); ok {
+			t.Reasons[reason]++
+		}
+		if r.CreatedAt.Before(t.FirstReportAt) {
+			t.FirstReportAt = r.CreatedAt
+		}
+		if r.CreatedAt.After(t.LastReportAt) {
+			t.LastReportAt = r.CreatedAt
+		}
+		if severity(r.Status) > severity(t.Status) {
+			t.Status = r.Status
+		}
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		a, b := targets[i], targets[j]
+		if ea, eb := a.Status == StatusEscalated, b.Status == StatusEscalated; ea != eb {
+			return ea
+		}
+		if a.Count() != b.Count() {
+			return a.Count() > b.Count()
+		}
+		return a.LastReportAt.After(b.LastReportAt)
+	})
+
+	return targets
+}
+
+func severity(s Status) int {
+	switch s {
+	case StatusEscalated:
+		return 2
+	case StatusAcknowledged:
+		return 1
+	default:
+		return 0
+	}
+}
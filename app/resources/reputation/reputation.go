@@ -0,0 +1,136 @@
+// Package reputation describes the points ledger members accumulate from the
+// community's response to their contributions.
+package reputation
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type reasonEnum string
+
+const (
+	reasonAnswerAccepted   reasonEnum = "answer_accepted"
+	reasonLikeReceived     reasonEnum = "like_received"
+	reasonReactionReceived reasonEnum = "reaction_received"
+	reasonMilestone        reasonEnum = "milestone"
+)
+
+type EntryID xid.ID
+
+func (i EntryID) String() string { return xid.ID(i).String() }
+
+// Entry is a single award (or penalty, if negative) in an account's ledger.
+type Entry struct {
+	ID        EntryID
+	AccountID account.AccountID
+	Reason    Reason
+	Points    int
+	Item      opt.Optional[datagraph.Ref]
+	CreatedAt time.Time
+}
+
+type Ledger []*Entry
+
+// Standing is an account's position on the leaderboard.
+type Standing struct {
+	AccountID account.AccountID
+	Points    int
+}
+
+// Weights controls how many points each kind of contribution is worth.
+type Weights struct {
+	AnswerAccepted   int
+	LikeReceived     int
+	ReactionReceived int
+	Milestone        int
+}
+
+func (w Weights) For(r Reason) int {
+	switch r {
+	case ReasonAnswerAccepted:
+		return w.AnswerAccepted
+	case ReasonLikeReceived:
+		return w.LikeReceived
+	case ReasonReactionReceived:
+		return w.ReactionReceived
+	case ReasonMilestone:
+		return w.Milestone
+	default:
+		return 0
+	}
+}
+
+// Threshold gates a permission behind a minimum amount of reputation, this is
+// applied on top of role permissions so members must hold both.
+type Threshold struct {
+	Permission rbac.Permission
+	Points     int
+}
+
+type Settings struct {
+	Weights    Weights
+	Thresholds []Threshold
+}
+
+func (s Settings) ThresholdFor(p rbac.Permission) (int, bool) {
+	for _, t := range s.Thresholds {
+		if t.Permission == p {
+			return t.Points, true
+		}
+	}
+	return 0, false
+}
+
+var DefaultSettings = Settings{
+	Weights: Weights{
+		AnswerAccepted:   15,
+		LikeReceived:     2,
+		ReactionReceived: 1,
+		Milestone:        5,
+	},
+}
+
+// Milestones are the number of published posts at which a member is awarded
+// milestone points.
+var Milestones = []int{1, 10, 50, 100, 500, 1000}
+
+func Map(in *ent.ReputationEntry) (*Entry, error) {
+	reason, err := NewReason(in.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := opt.MapErr(opt.NewPtr(in.ItemKind), func(k string) (datagraph.Ref, error) {
+		kind, err := datagraph.NewKind(k)
+		if err != nil {
+			return datagraph.Ref{}, err
+		}
+		id := xid.ID{}
+		if in.ItemID != nil {
+			id = *in.ItemID
+		}
+		return datagraph.Ref{ID: id, Kind: kind}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		ID:        EntryID(in.ID),
+		AccountID: account.AccountID(in.AccountID),
+		Reason:    reason,
+		Points:    in.Points,
+		Item:      item,
+		CreatedAt: in.CreatedAt,
+	}, nil
+}
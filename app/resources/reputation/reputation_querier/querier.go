@@ -0,0 +1,152 @@
+package reputation_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/reputation"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
+)
+
+type Querier struct {
+	db  *ent.Client
+	raw *sqlx.DB
+}
+
+func New(db *ent.Client, raw *sqlx.DB) *Querier {
+	return &Querier{db: db, raw: raw}
+}
+
+const totalQuery = `select coalesce(sum(points), 0) from reputation_entries where account_id = $1`
+
+func (q *Querier) Total(ctx context.Context, accountID account.AccountID) (int, error) {
+	var total int
+	err := q.raw.GetContext(ctx, &total, totalQuery, xid.ID(accountID).String())
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return total, nil
+}
+
+func (q *Querier) Ledger(ctx context.Context, accountID account.AccountID, params pagination.Parameters) (*pagination.Result[*reputation.Entry], error) {
+	predicate := reputationentry.AccountID(xid.ID(accountID))
+
+	total, err := q.db.ReputationEntry.Query().Where(predicate).Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := q.db.ReputationEntry.Query().
+		Where(predicate).
+		Order(ent.Desc(reputationentry.FieldCreatedAt)).
+		Limit(params.Limit()).
+		Offset(params.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	entries, err := dt.MapErr(r, reputation.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := pagination.NewPageResult(params, total, entries)
+
+	return &result, nil
+}
+
+const leaderboardQuery = `select
+  account_id,
+  sum(points) points
+from
+  reputation_entries
+where
+  created_at >= $1
+group by
+  account_id
+having
+  sum(points) > 0
+order by
+  points desc
+limit $2
+`
+
+type standingResult struct {
+	AccountID xid.ID `db:"account_id"`
+	Points    int    `db:"points"`
+}
+
+// Leaderboard ranks accounts by reputation earned since the given time, or
+// over all time if no time is specified.
+func (q *Querier) Leaderboard(ctx context.Context, since opt.Optional[time.Time], limit int) ([]*reputation.Standing, error) {
+	var r []standingResult
+	err := q.raw.SelectContext(ctx, &r, leaderboardQuery, since.OrZero(), limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, func(s standingResult) *reputation.Standing {
+		return &reputation.Standing{
+			AccountID: account.AccountID(s.AccountID),
+			Points:    s.Points,
+		}
+	}), nil
+}
+
+func (q *Querier) PostAuthor(ctx context.Context, postID post.ID) (account.AccountID, error) {
+	p, err := q.db.Post.Query().
+		Where(ent_post.ID(xid.ID(postID))).
+		Select(ent_post.FieldAccountPosts).
+		Only(ctx)
+	if err != nil {
+		return account.AccountID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return account.AccountID(p.AccountPosts), nil
+}
+
+// Contributions counts the published threads and replies an account has made,
+// used for determining which content milestones have been reached.
+func (q *Querier) Contributions(ctx context.Context, accountID account.AccountID) (int, error) {
+	n, err := q.db.Post.Query().
+		Where(
+			ent_post.AccountPosts(xid.ID(accountID)),
+			ent_post.DeletedAtIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+// MilestonesAwarded counts how many milestone awards an account already holds.
+func (q *Querier) MilestonesAwarded(ctx context.Context, accountID account.AccountID) (int, error) {
+	n, err := q.db.ReputationEntry.Query().
+		Where(
+			reputationentry.AccountID(xid.ID(accountID)),
+			reputationentry.Reason(reputation.ReasonMilestone.String()),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
@@ -0,0 +1,76 @@
+package reputation_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/reputation"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// Award adds an entry to the account's ledger. The same reason, item and
+// source may only be awarded once so duplicate events are a no-op.
+func (w *Writer) Award(
+	ctx context.Context,
+	accountID account.AccountID,
+	reason reputation.Reason,
+	points int,
+	item opt.Optional[datagraph.Ref],
+	source opt.Optional[xid.ID],
+) error {
+	create := w.db.ReputationEntry.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetReason(reason.String()).
+		SetPoints(points).
+		SetNillableSourceID(source.Ptr())
+
+	if ref, ok := item.Get(); ok {
+		create.SetItemKind(ref.Kind.String()).SetItemID(ref.ID)
+	}
+
+	err := create.Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Revoke removes a previously awarded entry, such as when a like is removed.
+func (w *Writer) Revoke(
+	ctx context.Context,
+	reason reputation.Reason,
+	itemID xid.ID,
+	source xid.ID,
+) error {
+	_, err := w.db.ReputationEntry.Delete().
+		Where(
+			reputationentry.Reason(reason.String()),
+			reputationentry.ItemID(itemID),
+			reputationentry.SourceID(source),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
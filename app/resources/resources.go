@@ -5,28 +5,65 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/application/application_querier"
+	"github.com/Southclaws/storyden/app/resources/account/application/application_writer"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
+	"github.com/Southclaws/storyden/app/resources/account/bot/bot_querier"
 	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/account/feed_token"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_querier"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_writer"
+	"github.com/Southclaws/storyden/app/resources/account/notification/digest"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_pref"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_querier"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_writer"
+	"github.com/Southclaws/storyden/app/resources/account/notification/push_subscription"
+	"github.com/Southclaws/storyden/app/resources/account/onboarding_checklist"
 	"github.com/Southclaws/storyden/app/resources/account/role/role_assign"
 	"github.com/Southclaws/storyden/app/resources/account/role/role_badge"
 	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
 	"github.com/Southclaws/storyden/app/resources/account/role/role_writer"
 	"github.com/Southclaws/storyden/app/resources/account/token"
+	"github.com/Southclaws/storyden/app/resources/account/verification/verification_querier"
+	"github.com/Southclaws/storyden/app/resources/account/verification/verification_writer"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_querier"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_writer"
+	"github.com/Southclaws/storyden/app/resources/api_usage"
 	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_usage"
 	"github.com/Southclaws/storyden/app/resources/asset/asset_writer"
+	"github.com/Southclaws/storyden/app/resources/asset/upload_session"
+	"github.com/Southclaws/storyden/app/resources/automod/automod_querier"
+	"github.com/Southclaws/storyden/app/resources/automod/automod_writer"
+	"github.com/Southclaws/storyden/app/resources/badge/badge_querier"
+	"github.com/Southclaws/storyden/app/resources/badge/badge_writer"
+	"github.com/Southclaws/storyden/app/resources/bookmark/bookmark_querier"
+	"github.com/Southclaws/storyden/app/resources/bookmark/bookmark_writer"
 	collection_items "github.com/Southclaws/storyden/app/resources/collection/collection_item"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_section"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_share"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_writer"
+	"github.com/Southclaws/storyden/app/resources/content_view"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_querier"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
+	"github.com/Southclaws/storyden/app/resources/datagraph/fulltext"
 	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/datagraph/index_status"
+	"github.com/Southclaws/storyden/app/resources/datagraph/summary"
+	"github.com/Southclaws/storyden/app/resources/discord_bridge"
 	"github.com/Southclaws/storyden/app/resources/event/event_querier"
 	"github.com/Southclaws/storyden/app/resources/event/event_writer"
 	"github.com/Southclaws/storyden/app/resources/event/participation/participant_querier"
 	"github.com/Southclaws/storyden/app/resources/event/participation/participant_writer"
+	"github.com/Southclaws/storyden/app/resources/feature_flag/feature_flag_querier"
+	"github.com/Southclaws/storyden/app/resources/feature_flag/feature_flag_writer"
+	"github.com/Southclaws/storyden/app/resources/federation/federation_follower"
+	"github.com/Southclaws/storyden/app/resources/federation/federation_key"
+	"github.com/Southclaws/storyden/app/resources/import_run"
+	"github.com/Southclaws/storyden/app/resources/job"
 	"github.com/Southclaws/storyden/app/resources/library/node_cache"
 	"github.com/Southclaws/storyden/app/resources/library/node_children"
 	"github.com/Southclaws/storyden/app/resources/library/node_properties"
@@ -36,11 +73,20 @@ import (
 	"github.com/Southclaws/storyden/app/resources/library/node_writer"
 	"github.com/Southclaws/storyden/app/resources/like/like_querier"
 	"github.com/Southclaws/storyden/app/resources/like/like_writer"
+	"github.com/Southclaws/storyden/app/resources/link/link_check"
 	"github.com/Southclaws/storyden/app/resources/link/link_querier"
 	"github.com/Southclaws/storyden/app/resources/link/link_writer"
+	"github.com/Southclaws/storyden/app/resources/matrix_bridge"
+	"github.com/Southclaws/storyden/app/resources/moderation_note/moderation_note_querier"
+	"github.com/Southclaws/storyden/app/resources/moderation_note/moderation_note_writer"
+	"github.com/Southclaws/storyden/app/resources/netban/netban_querier"
+	"github.com/Southclaws/storyden/app/resources/netban/netban_writer"
+	"github.com/Southclaws/storyden/app/resources/policy/policy_querier"
+	"github.com/Southclaws/storyden/app/resources/policy/policy_writer"
 	"github.com/Southclaws/storyden/app/resources/post/category"
 	"github.com/Southclaws/storyden/app/resources/post/category_cache"
 	"github.com/Southclaws/storyden/app/resources/post/post_read_state"
+	"github.com/Southclaws/storyden/app/resources/post/post_review"
 	"github.com/Southclaws/storyden/app/resources/post/post_search"
 	"github.com/Southclaws/storyden/app/resources/post/post_writer"
 	"github.com/Southclaws/storyden/app/resources/post/reaction"
@@ -48,17 +94,43 @@ import (
 	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
 	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/block_writer"
 	"github.com/Southclaws/storyden/app/resources/profile/follow_querier"
 	"github.com/Southclaws/storyden/app/resources/profile/follow_writer"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_activity"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_cache"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_pin"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_search"
 	"github.com/Southclaws/storyden/app/resources/question"
+	"github.com/Southclaws/storyden/app/resources/reading_history"
 	"github.com/Southclaws/storyden/app/resources/report/report_querier"
 	"github.com/Southclaws/storyden/app/resources/report/report_writer"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_querier"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_writer"
+	"github.com/Southclaws/storyden/app/resources/retention/retention_repo"
+	"github.com/Southclaws/storyden/app/resources/scheduled_task"
 	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/sitemap"
+	"github.com/Southclaws/storyden/app/resources/space/space_querier"
+	"github.com/Southclaws/storyden/app/resources/space/space_writer"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+	"github.com/Southclaws/storyden/app/resources/telegram_message"
+	"github.com/Southclaws/storyden/app/resources/tenant"
+	"github.com/Southclaws/storyden/app/resources/trash/trash_querier"
+	"github.com/Southclaws/storyden/app/resources/trash/trash_writer"
+	"github.com/Southclaws/storyden/app/resources/trending/trending_querier"
+	"github.com/Southclaws/storyden/app/resources/trending/trending_writer"
+	"github.com/Southclaws/storyden/app/resources/warning/warning_querier"
+	"github.com/Southclaws/storyden/app/resources/warning/warning_writer"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_delivery"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_feed"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_querier"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_writer"
+	"github.com/Southclaws/storyden/app/resources/word_filter/word_filter_querier"
+	"github.com/Southclaws/storyden/app/resources/word_filter/word_filter_writer"
 )
 
 func Build() fx.Option {
@@ -75,28 +147,53 @@ func Build() fx.Option {
 			role_badge.New,
 			invitation_querier.New,
 			invitation_writer.New,
+			application_querier.New,
+			application_writer.New,
+			verification_querier.New,
+			verification_writer.New,
+			bot_querier.New,
+			onboarding_checklist.New,
+			policy_querier.New,
+			policy_writer.New,
 			asset_querier.New,
 			asset_writer.New,
+			upload_session.New,
+			asset_usage.New,
 			authentication.New,
 			category.New,
 			category_cache.New,
+			digest.New,
+			digest.NewQuerier,
+			api_usage.New,
+			job.New,
+			import_run.New,
+			scheduled_task.New,
+			tenant.New,
+			feed_token.New,
+			notify_pref.New,
 			notify_querier.New,
 			notify_writer.New,
+			push_subscription.New,
 			reply.New,
 			tag_querier.New,
 			tag_writer.New,
 			thread_writer.New,
 			thread_querier.New,
 			thread_cache.New,
+			sitemap.New,
 			reaction.New,
 			like_querier.New,
 			like_writer.New,
 			post_search.New,
 			post_writer.New,
+			post_review.New,
 			post_read_state.New,
+			post_read_state.NewQuerier,
 			collection_querier.New,
 			collection_writer.New,
 			collection_items.New,
+			collection_section.New,
+			collection_share.New,
 			node_cache.New,
 			node_querier.New,
 			node_writer.New,
@@ -106,19 +203,69 @@ func Build() fx.Option {
 			node_properties.New,
 			link_querier.New,
 			link_writer.New,
+			link_check.New,
 			profile_search.New,
 			profile_querier.New,
 			profile_cache.New,
+			profile_pin.New,
+			profile_activity.New,
 			follow_writer.New,
 			follow_querier.New,
+			block_writer.New,
+			block_querier.New,
 			event_querier.New,
 			event_writer.New,
 			participant_querier.New,
 			participant_writer.New,
 			hydrate.New,
+			facet.New,
+			fulltext.New,
+			index_status.New,
+			summary.New,
 			question.New,
 			report_querier.New,
 			report_writer.New,
+			trending_querier.New,
+			trending_writer.New,
+			analytics_querier.New,
+			analytics_writer.New,
+			content_view.New,
+			reputation_querier.New,
+			reputation_writer.New,
+			badge_querier.New,
+			badge_writer.New,
+			webhook_querier.New,
+			webhook_writer.New,
+			webhook_delivery.New,
+			webhook_feed.New,
+			automod_querier.New,
+			automod_writer.New,
+			word_filter_querier.New,
+			word_filter_writer.New,
+			feature_flag_querier.New,
+			feature_flag_writer.New,
+			netban_querier.New,
+			netban_writer.New,
+			moderation_note_querier.New,
+			moderation_note_writer.New,
+			bookmark_querier.New,
+			bookmark_writer.New,
+			reading_history.New,
+			reading_history.NewQuerier,
+			warning_querier.New,
+			warning_writer.New,
+			trash_querier.New,
+			trash_writer.New,
+			retention_repo.New,
+			discord_bridge.New,
+			matrix_bridge.New,
+			telegram_message.New,
+			federation_key.New,
+			federation_follower.New,
+			conversation_querier.New,
+			conversation_writer.New,
+			space_querier.New,
+			space_writer.New,
 		),
 		token.Build(),
 	)
@@ -0,0 +1,103 @@
+// Package retention describes the rules which permanently remove old data such
+// as expired sessions once it has been kept for a configured number of days.
+package retention
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type ruleEnum string
+
+const (
+	ruleTrash             ruleEnum = "trash"
+	ruleSessions          ruleEnum = "sessions"
+	ruleUnverifiedEmails  ruleEnum = "unverified_emails"
+	ruleWebhookDeliveries ruleEnum = "webhook_deliveries"
+	ruleAPIUsage          ruleEnum = "api_usage"
+	ruleHourlyViews       ruleEnum = "hourly_views"
+)
+
+// Rules lists every rule in the order they're applied.
+var Rules = []Rule{
+	RuleTrash,
+	RuleSessions,
+	RuleUnverifiedEmails,
+	RuleWebhookDeliveries,
+	RuleAPIUsage,
+	RuleHourlyViews,
+}
+
+// Settings holds how many days data is kept for before it's purged. Unset or
+// zero keeps the data forever. Trash has its own setting, TrashRetentionDays.
+type Settings struct {
+	// SessionDays is how long sessions are kept after they expired or were
+	// revoked, they can't be used to sign in but show up in session history.
+	SessionDays opt.Optional[int]
+
+	// UnverifiedEmailDays is how long email addresses which were added but
+	// never verified are kept. Addresses belonging to a member are only removed
+	// once they have verified another, so nobody loses the address they use to
+	// sign in.
+	UnverifiedEmailDays opt.Optional[int]
+
+	// WebhookDeliveryDays is how long the log of finished webhook deliveries
+	// is kept. Deliveries still being retried are never removed.
+	WebhookDeliveryDays opt.Optional[int]
+
+	// APIUsageDays is how long hourly API usage counts are kept, which limits
+	// how far back usage reports can look.
+	APIUsageDays opt.Optional[int]
+
+	// HourlyViewDays is how long hourly view counts of threads and library
+	// pages are kept. Daily counts are kept forever.
+	HourlyViewDays opt.Optional[int]
+}
+
+// Days is how many days the rule keeps data for, empty when it's kept forever.
+func (s Settings) Days(r Rule) opt.Optional[int] {
+	var d opt.Optional[int]
+	switch r {
+	case RuleSessions:
+		d = s.SessionDays
+	case RuleUnverifiedEmails:
+		d = s.UnverifiedEmailDays
+	case RuleWebhookDeliveries:
+		d = s.WebhookDeliveryDays
+	case RuleAPIUsage:
+		d = s.APIUsageDays
+	case RuleHourlyViews:
+		d = s.HourlyViewDays
+	}
+
+	if v, ok := d.Get(); !ok || v <= 0 {
+		return opt.NewEmpty[int]()
+	}
+
+	return d
+}
+
+// Result is how much data a rule removed, or would remove on a dry run.
+type Result struct {
+	Rule   Rule
+	Days   int
+	Before time.Time
+	Count  int
+}
+
+type Report struct {
+	DryRun  bool
+	Results []Result
+}
+
+// Total is the number of items removed by every rule.
+func (r *Report) Total() int {
+	n := 0
+	for _, res := range r.Results {
+		n += res.Count
+	}
+	return n
+}
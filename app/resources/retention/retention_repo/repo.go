@@ -0,0 +1,118 @@
+package retention_repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/content_view"
+	"github.com/Southclaws/storyden/app/resources/retention"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	ent_email "github.com/Southclaws/storyden/internal/ent/email"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	ent_session "github.com/Southclaws/storyden/internal/ent/session"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
+)
+
+var ErrUnsupportedRule = fault.New("retention rule is not handled by the repository")
+
+// Repository counts and removes the data covered by retention rules other than
+// trash, which is purged through the trash resources.
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Count returns how many items the rule would remove for the given cutoff.
+func (r *Repository) Count(ctx context.Context, rule retention.Rule, before time.Time) (int, error) {
+	var n int
+	var err error
+
+	switch rule {
+	case retention.RuleSessions:
+		n, err = r.db.Session.Query().Where(sessions(before)).Count(ctx)
+	case retention.RuleUnverifiedEmails:
+		n, err = r.db.Email.Query().Where(unverifiedEmails(before)).Count(ctx)
+	case retention.RuleWebhookDeliveries:
+		n, err = r.db.WebhookDelivery.Query().Where(webhookDeliveries(before)).Count(ctx)
+	case retention.RuleAPIUsage:
+		n, err = r.db.APIUsage.Query().Where(apiusage.BucketLT(before)).Count(ctx)
+	case retention.RuleHourlyViews:
+		n, err = r.db.ContentView.Query().Where(hourlyViews(before)).Count(ctx)
+	default:
+		return 0, fault.Wrap(ErrUnsupportedRule, fctx.With(ctx))
+	}
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+// Purge permanently removes the items covered by the rule for the given cutoff
+// and returns how many were removed.
+func (r *Repository) Purge(ctx context.Context, rule retention.Rule, before time.Time) (int, error) {
+	var n int
+	var err error
+
+	switch rule {
+	case retention.RuleSessions:
+		n, err = r.db.Session.Delete().Where(sessions(before)).Exec(ctx)
+	case retention.RuleUnverifiedEmails:
+		n, err = r.db.Email.Delete().Where(unverifiedEmails(before)).Exec(ctx)
+	case retention.RuleWebhookDeliveries:
+		n, err = r.db.WebhookDelivery.Delete().Where(webhookDeliveries(before)).Exec(ctx)
+	case retention.RuleAPIUsage:
+		n, err = r.db.APIUsage.Delete().Where(apiusage.BucketLT(before)).Exec(ctx)
+	case retention.RuleHourlyViews:
+		n, err = r.db.ContentView.Delete().Where(hourlyViews(before)).Exec(ctx)
+	default:
+		return 0, fault.Wrap(ErrUnsupportedRule, fctx.With(ctx))
+	}
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+func sessions(before time.Time) predicate.Session {
+	return ent_session.Or(
+		ent_session.ExpiresAtLT(before),
+		ent_session.RevokedAtLT(before),
+	)
+}
+
+func unverifiedEmails(before time.Time) predicate.Email {
+	return ent_email.And(
+		ent_email.Verified(false),
+		ent_email.CreatedAtLT(before),
+		ent_email.Or(
+			ent_email.AccountIDIsNil(),
+			ent_email.HasAccountWith(ent_account.HasEmailsWith(ent_email.Verified(true))),
+		),
+	)
+}
+
+func webhookDeliveries(before time.Time) predicate.WebhookDelivery {
+	return webhookdelivery.And(
+		webhookdelivery.StatusNEQ(webhook.StatusPending.String()),
+		webhookdelivery.UpdatedAtLT(before),
+	)
+}
+
+func hourlyViews(before time.Time) predicate.ContentView {
+	return contentview.And(
+		contentview.Period(content_view.PeriodHour.String()),
+		contentview.BucketLT(before),
+	)
+}
@@ -0,0 +1,158 @@
+package scheduled_task
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/internal/ent"
+	entscheduledtask "github.com/Southclaws/storyden/internal/ent/scheduledtask"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Ensure creates the row for a task the first time it's registered. Existing
+// rows are left alone so their schedule overrides and run state are kept.
+func (r *Repository) Ensure(ctx context.Context, name string, nextRunAt time.Time) error {
+	err := r.db.ScheduledTask.Create().
+		SetName(name).
+		SetNextRunAt(nextRunAt).
+		OnConflictColumns(entscheduledtask.FieldName).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) List(ctx context.Context) ([]*Task, error) {
+	rows, err := r.db.ScheduledTask.Query().
+		Order(ent.Asc(entscheduledtask.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.MapErr(rows, Map)
+}
+
+func (r *Repository) Get(ctx context.Context, name string) (*Task, error) {
+	row, err := r.db.ScheduledTask.Query().
+		Where(entscheduledtask.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(row)
+}
+
+// Advance moves a due task's next run to the given time. Only one of several
+// replicas racing to advance the same tick succeeds, that replica runs it.
+func (r *Repository) Advance(ctx context.Context, name string, now time.Time, next time.Time) (bool, error) {
+	n, err := r.db.ScheduledTask.Update().
+		Where(
+			entscheduledtask.Name(name),
+			entscheduledtask.Enabled(true),
+			entscheduledtask.NextRunAtLTE(now),
+		).
+		SetNextRunAt(next).
+		Save(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n > 0, nil
+}
+
+type Option func(*ent.ScheduledTaskMutation)
+
+// WithSchedule overrides the task's registered cron expression, an empty
+// value goes back to the registered schedule.
+func WithSchedule(expr opt.Optional[string]) Option {
+	return func(m *ent.ScheduledTaskMutation) {
+		if v, ok := expr.Get(); ok {
+			m.SetSchedule(v)
+		} else {
+			m.ClearSchedule()
+		}
+	}
+}
+
+func WithEnabled(enabled bool) Option {
+	return func(m *ent.ScheduledTaskMutation) {
+		m.SetEnabled(enabled)
+	}
+}
+
+func WithNextRunAt(t time.Time) Option {
+	return func(m *ent.ScheduledTaskMutation) {
+		m.SetNextRunAt(t)
+	}
+}
+
+func (r *Repository) Update(ctx context.Context, name string, opts ...Option) (*Task, error) {
+	update := r.db.ScheduledTask.Update().
+		Where(entscheduledtask.Name(name))
+
+	for _, fn := range opts {
+		fn(update.Mutation())
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if n == 0 {
+		return nil, fault.Wrap(fault.New("scheduled task not found"), fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return r.Get(ctx, name)
+}
+
+func (r *Repository) RecordStart(ctx context.Context, name string, at time.Time) error {
+	err := r.db.ScheduledTask.Update().
+		Where(entscheduledtask.Name(name)).
+		SetLastStartedAt(at).
+		SetLastStatus(entscheduledtask.LastStatusRunning).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) RecordFinish(ctx context.Context, name string, at time.Time, runErr error) error {
+	update := r.db.ScheduledTask.Update().
+		Where(entscheduledtask.Name(name)).
+		SetLastFinishedAt(at)
+
+	if runErr != nil {
+		update.SetLastStatus(entscheduledtask.LastStatusFailed).SetLastError(runErr.Error())
+	} else {
+		update.SetLastStatus(entscheduledtask.LastStatusSucceeded).ClearLastError()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Package scheduled_task stores the run state of recurring tasks, shared by
+// every replica so each scheduled run happens once.
+package scheduled_task
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type statusEnum string
+
+const (
+	statusRunning   statusEnum = "running"
+	statusSucceeded statusEnum = "succeeded"
+	statusFailed    statusEnum = "failed"
+)
+
+type Task struct {
+	Name           string
+	Schedule       opt.Optional[string]
+	Enabled        bool
+	NextRunAt      time.Time
+	LastStartedAt  opt.Optional[time.Time]
+	LastFinishedAt opt.Optional[time.Time]
+	LastStatus     opt.Optional[Status]
+	LastError      opt.Optional[string]
+}
+
+func Map(in *ent.ScheduledTask) (*Task, error) {
+	var status opt.Optional[Status]
+	if in.LastStatus != nil {
+		s, err := NewStatus(in.LastStatus.String())
+		if err != nil {
+			return nil, err
+		}
+		status = opt.New(s)
+	}
+
+	return &Task{
+		Name:           in.Name,
+		Schedule:       opt.NewPtr(in.Schedule),
+		Enabled:        in.Enabled,
+		NextRunAt:      in.NextRunAt,
+		LastStartedAt:  opt.NewPtr(in.LastStartedAt),
+		LastFinishedAt: opt.NewPtr(in.LastFinishedAt),
+		LastStatus:     status,
+		LastError:      opt.NewPtr(in.LastError),
+	}, nil
+}
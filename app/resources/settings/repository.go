@@ -13,7 +13,9 @@ import (
 	"github.com/puzpuzpuz/xsync/v4"
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 	"github.com/Southclaws/storyden/internal/utils/errutil"
 )
 
@@ -26,6 +28,7 @@ const StorydenPrimarySettingsKey = "storyden_system"
 type SettingsRepository struct {
 	logger *slog.Logger
 	db     *ent.Client
+	bus    *pubsub.Bus
 
 	// cached stores the most recent copy of all the settings from the database.
 	// Directly changing settings via external database queries will result in
@@ -37,20 +40,31 @@ type SettingsRepository struct {
 	cacheLastFetch time.Time
 }
 
-func New(ctx context.Context, lc fx.Lifecycle, logger *slog.Logger, db *ent.Client) (*SettingsRepository, error) {
+func New(ctx context.Context, lc fx.Lifecycle, logger *slog.Logger, db *ent.Client, bus *pubsub.Bus) (*SettingsRepository, error) {
 	d := &SettingsRepository{
 		logger:         logger,
 		db:             db,
+		bus:            bus,
 		cachedSettings: xsync.NewMap[string, any](),
 	}
 
-	lc.Append(fx.StartHook(func() error {
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
 		if err := d.initDefaults(ctx); err != nil {
 			return fault.Wrap(err,
 				fctx.With(ctx),
 				fmsg.With("failed to initialise default settings"))
 		}
 
+		// Other instances sharing the database drop their cached copy when the
+		// settings change so every instance reflects updates immediately.
+		_, err := pubsub.Subscribe(hctx, bus, "settings.reload", func(ctx context.Context, evt *message.EventSettingsUpdated) error {
+			d.cachedSettings.Delete(StorydenPrimarySettingsKey)
+			return nil
+		})
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
 		return nil
 	}))
 
@@ -120,6 +134,8 @@ func (d *SettingsRepository) Set(ctx context.Context, s Settings) (*Settings, er
 
 	d.cache(settings)
 
+	d.bus.Publish(ctx, &message.EventSettingsUpdated{})
+
 	return settings, nil
 }
 
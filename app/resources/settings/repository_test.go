@@ -2,7 +2,9 @@ package settings_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/Southclaws/opt"
 	"github.com/stretchr/testify/assert"
@@ -10,7 +12,10 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 	"github.com/Southclaws/storyden/internal/integration"
 )
 
@@ -44,3 +49,33 @@ func TestSettingsRepository(t *testing.T) {
 		}))
 	}))
 }
+
+func TestSettingsRepositoryReload(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, fx.Invoke(func(lc fx.Lifecycle, sr *settings.SettingsRepository, db *ent.Client, bus *pubsub.Bus) {
+		lc.Append(fx.StartHook(func(ctx context.Context) {
+			t.Run("updated_elsewhere", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+
+				current, err := sr.Get(ctx)
+				r.NoError(err)
+
+				// Simulates another instance sharing the database.
+				current.Title = opt.New("Elsewhere")
+				b, err := json.Marshal(current)
+				r.NoError(err)
+				err = db.Setting.UpdateOneID(settings.StorydenPrimarySettingsKey).SetValue(string(b)).Exec(ctx)
+				r.NoError(err)
+
+				bus.Publish(ctx, &message.EventSettingsUpdated{})
+
+				r.Eventually(func() bool {
+					got, err := sr.Get(ctx)
+					return err == nil && got.Title.OrZero() == "Elsewhere"
+				}, 5*time.Second, 50*time.Millisecond)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,24 @@
+package settings
+
+import "github.com/Southclaws/opt"
+
+// Limits override limits which are otherwise set by environment variables.
+// Zero values fall back to the environment's configuration.
+type Limits struct {
+	// MaxRequestSize is the largest request body, in bytes, the API accepts.
+	MaxRequestSize int64
+
+	// SpamThreshold is the spam score at or above which new posts are held in
+	// the review queue.
+	SpamThreshold float64
+}
+
+// Features toggle optional behaviour at runtime. Features which are unset fall
+// back to the environment's configuration.
+type Features struct {
+	// LinkSnapshots stores a copy of the text content of shared links.
+	LinkSnapshots opt.Optional[bool]
+
+	// LinkWaybackArchive submits shared links to the Internet Archive.
+	LinkWaybackArchive opt.Optional[bool]
+}
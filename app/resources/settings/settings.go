@@ -7,8 +7,20 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/opt"
 
+	"github.com/Southclaws/storyden/app/resources/account/application"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/account/email_domain"
+	"github.com/Southclaws/storyden/app/resources/account/invitation"
+	"github.com/Southclaws/storyden/app/resources/account/onboarding_checklist"
+	"github.com/Southclaws/storyden/app/resources/chat_notify"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/discord_bridge"
+	"github.com/Southclaws/storyden/app/resources/matrix_bridge"
+	"github.com/Southclaws/storyden/app/resources/rate_limit"
+	"github.com/Southclaws/storyden/app/resources/reputation"
+	"github.com/Southclaws/storyden/app/resources/retention"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_policy"
+	"github.com/Southclaws/storyden/app/resources/warning"
 	"github.com/Southclaws/storyden/internal/ent"
 )
 
@@ -28,6 +40,14 @@ type Settings struct {
 	// being frontend-specific, it may be used for backend email/SMS templates.
 	AccentColour opt.Optional[string]
 
+	// DefaultLocale is the language emails, notifications and error messages
+	// are written in for members who haven't chosen one. Unset uses English.
+	DefaultLocale opt.Optional[string]
+
+	// DefaultTimezone is the timezone digests are scheduled in and dates are
+	// written in for members who haven't chosen one. Unset uses UTC.
+	DefaultTimezone opt.Optional[string]
+
 	// Public is intended to be used to configure public access to the API. If
 	// set to false any request to the API will require a verified user account.
 	Public opt.Optional[bool]
@@ -36,6 +56,75 @@ type Settings struct {
 	// are exposed to members during the frontend registration and login flows.
 	AuthenticationMode opt.Optional[authentication.Mode]
 
+	// Invitations controls whether registration requires an invitation and how
+	// many invitations each member may have outstanding at once.
+	Invitations opt.Optional[invitation.Settings]
+
+	// Applications controls whether new members need approval from staff and
+	// which questions they're asked when applying.
+	Applications opt.Optional[application.Settings]
+
+	// EmailDomains restricts registration to certain email domains and sets
+	// what verifying an address at each domain grants the member.
+	EmailDomains opt.Optional[email_domain.Settings]
+
+	// OnboardingChecklist lists the steps new members are guided through, such
+	// as verifying their email address and making their first post.
+	OnboardingChecklist opt.Optional[onboarding_checklist.Settings]
+
+	// Reputation controls how many points members are awarded for different
+	// contributions and which permissions are gated behind reputation.
+	Reputation opt.Optional[reputation.Settings]
+
+	// ChatNotifications lists the Slack and Discord channels which are sent a
+	// message when selected activity happens, such as a new thread or member.
+	ChatNotifications opt.Optional[chat_notify.Settings]
+
+	// DiscordBridge maps categories to the Discord forum channels which their
+	// threads are mirrored to when the Discord bridge is enabled.
+	DiscordBridge opt.Optional[discord_bridge.Settings]
+
+	// MatrixBridge maps categories to the Matrix rooms which their threads are
+	// announced in when the Matrix bridge is enabled.
+	MatrixBridge opt.Optional[matrix_bridge.Settings]
+
+	// NewMemberApprovals is how many of a new member's posts are held for a
+	// moderator's approval before they're trusted to publish without review.
+	// Zero or unset disables the approval queue for new members.
+	NewMemberApprovals opt.Optional[int]
+
+	// Warnings controls which permissions are withheld from members once their
+	// active warnings add up to enough points.
+	Warnings opt.Optional[warning.Settings]
+
+	// TagCreation restricts creating new tags to trusted members, others may
+	// only apply tags which already exist.
+	TagCreation opt.Optional[tag_policy.Settings]
+
+	// ProfilePinLimit is how many threads and library pages each member may
+	// pin to their profile. Zero disables pinning, unset uses the default.
+	ProfilePinLimit opt.Optional[int]
+
+	// TrashRetentionDays is how many days deleted threads, replies and library
+	// pages stay restorable before they're purged. Unset uses the default.
+	TrashRetentionDays opt.Optional[int]
+
+	// Retention controls how long old data, such as expired sessions, is kept
+	// before the retention task removes it. Unset rules keep data forever.
+	Retention opt.Optional[retention.Settings]
+
+	// Limits override request and content limits otherwise configured by the
+	// environment, changes apply without restarting the server.
+	Limits opt.Optional[Limits]
+
+	// RateLimits controls how many API requests clients may make, with
+	// overrides for particular roles and access keys.
+	RateLimits opt.Optional[rate_limit.Settings]
+
+	// Features toggle optional subsystems otherwise configured by environment
+	// variables, changes apply without restarting the server.
+	Features opt.Optional[Features]
+
 	// Metadata is an arbitrary object which can be used by frontends/clients to
 	// store vendor-specific configuration to control the client implementation.
 	Metadata opt.Optional[map[string]any]
@@ -0,0 +1,14 @@
+package sitemap
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type sectionEnum string
+
+const (
+	sectionThreads  sectionEnum = "threads"
+	sectionNodes    sectionEnum = "nodes"
+	sectionProfiles sectionEnum = "profiles"
+)
+
+// Sections in the order they're listed in the sitemap index.
+var Sections = []Section{SectionThreads, SectionNodes, SectionProfiles}
@@ -0,0 +1,201 @@
+// Package sitemap lists the content which is public to everyone, the threads,
+// library pages and profiles a search engine may index, in a stable order so it
+// can be split into pages which only change when their own items do.
+package sitemap
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/space"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	ent_space "github.com/Southclaws/storyden/internal/ent/space"
+)
+
+type Entry struct {
+	ID      xid.ID
+	Slug    string
+	Updated time.Time
+}
+
+type Querier struct {
+	db          *ent.Client
+	roleQuerier *role_querier.Querier
+}
+
+func New(db *ent.Client, roleQuerier *role_querier.Querier) *Querier {
+	return &Querier{db: db, roleQuerier: roleQuerier}
+}
+
+// List returns a page of a section's entries, oldest first.
+func (q *Querier) List(ctx context.Context, section Section, offset, limit int) ([]Entry, error) {
+	var (
+		entries []Entry
+		err     error
+	)
+
+	switch section {
+	case SectionThreads:
+		entries, err = q.threads(ctx, offset, limit)
+	case SectionNodes:
+		entries, err = q.nodes(ctx, offset, limit)
+	case SectionProfiles:
+		entries, err = q.profiles(ctx, offset, limit)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return entries, nil
+}
+
+// Lookup finds a listed item by its ID, slug or handle, items which aren't
+// public are not found.
+func (q *Querier) Lookup(ctx context.Context, section Section, key string) (*Entry, bool, error) {
+	id, idErr := xid.FromString(key)
+	isID := idErr == nil
+
+	var (
+		entries []Entry
+		err     error
+	)
+
+	switch {
+	case section == SectionThreads && isID:
+		entries, err = q.threads(ctx, 0, 1, ent_post.ID(id))
+	case section == SectionNodes && isID:
+		entries, err = q.nodes(ctx, 0, 1, ent_node.ID(id))
+	case section == SectionNodes:
+		entries, err = q.nodes(ctx, 0, 1, ent_node.Slug(key))
+	case section == SectionProfiles:
+		entries, err = q.profiles(ctx, 0, 1, ent_account.Handle(key))
+	}
+	if err != nil {
+		return nil, false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(entries) == 0 {
+		return nil, false, nil
+	}
+
+	return &entries[0], true, nil
+}
+
+// Pages returns when each page of a section, of the given size, last changed.
+func (q *Querier) Pages(ctx context.Context, section Section, size int) ([]time.Time, error) {
+	pages := []time.Time{}
+
+	for offset := 0; ; offset += size {
+		entries, err := q.List(ctx, section, offset, size)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if len(entries) == 0 {
+			return pages, nil
+		}
+
+		updated := time.Time{}
+		for _, e := range entries {
+			if e.Updated.After(updated) {
+				updated = e.Updated
+			}
+		}
+		pages = append(pages, updated)
+
+		if len(entries) < size {
+			return pages, nil
+		}
+	}
+}
+
+func (q *Querier) threads(ctx context.Context, offset, limit int, where ...predicate.Post) ([]Entry, error) {
+	// Only threads a guest could read are public, a category restricted to
+	// other roles keeps its threads out of the sitemap and structured data.
+	guest, err := q.roleQuerier.GetGuestRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := thread_querier.CategoryReadableBy(role.Roles{guest}).Get(); ok {
+		where = append(where, p)
+	}
+
+	posts, err := q.db.Post.Query().
+		Where(
+			ent_post.RootPostIDIsNil(),
+			ent_post.VisibilityEQ(ent_post.Visibility(visibility.VisibilityPublished.String())),
+			ent_post.DeletedAtIsNil(),
+			ent_post.Or(
+				ent_post.SpaceIDIsNil(),
+				ent_post.HasSpaceWith(ent_space.JoinPolicy(space.PolicyOpen.String())),
+			),
+		).
+		Where(where...).
+		Select(ent_post.FieldID, ent_post.FieldSlug, ent_post.FieldUpdatedAt, ent_post.FieldLastReplyAt).
+		Order(ent.Asc(ent_post.FieldCreatedAt), ent.Asc(ent_post.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dt.Map(posts, func(p *ent.Post) Entry {
+		updated := p.UpdatedAt
+		if p.LastReplyAt.After(updated) {
+			updated = p.LastReplyAt
+		}
+		return Entry{ID: p.ID, Slug: p.Slug, Updated: updated}
+	}), nil
+}
+
+func (q *Querier) nodes(ctx context.Context, offset, limit int, where ...predicate.Node) ([]Entry, error) {
+	nodes, err := q.db.Node.Query().
+		Where(
+			ent_node.VisibilityEQ(ent_node.Visibility(visibility.VisibilityPublished.String())),
+			ent_node.DeletedAtIsNil(),
+		).
+		Where(where...).
+		Select(ent_node.FieldID, ent_node.FieldSlug, ent_node.FieldUpdatedAt).
+		Order(ent.Asc(ent_node.FieldCreatedAt), ent.Asc(ent_node.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dt.Map(nodes, func(n *ent.Node) Entry {
+		return Entry{ID: n.ID, Slug: n.Slug, Updated: n.UpdatedAt}
+	}), nil
+}
+
+func (q *Querier) profiles(ctx context.Context, offset, limit int, where ...predicate.Account) ([]Entry, error) {
+	accounts, err := q.db.Account.Query().
+		Where(ent_account.DeletedAtIsNil()).
+		Where(where...).
+		Select(ent_account.FieldID, ent_account.FieldHandle, ent_account.FieldUpdatedAt).
+		Order(ent.Asc(ent_account.FieldCreatedAt), ent.Asc(ent_account.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dt.Map(accounts, func(a *ent.Account) Entry {
+		return Entry{ID: a.ID, Slug: a.Handle, Updated: a.UpdatedAt}
+	}), nil
+}
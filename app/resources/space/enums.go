@@ -0,0 +1,27 @@
+package space
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type policyEnum string
+
+const (
+	policyOpen    policyEnum = "open"
+	policyRequest policyEnum = "request"
+	policyInvite  policyEnum = "invite"
+)
+
+type roleEnum string
+
+const (
+	roleOwner     roleEnum = "owner"
+	roleModerator roleEnum = "moderator"
+	roleMember    roleEnum = "member"
+)
+
+type statusEnum string
+
+const (
+	statusActive    statusEnum = "active"
+	statusRequested statusEnum = "requested"
+	statusInvited   statusEnum = "invited"
+)
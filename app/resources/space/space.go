@@ -0,0 +1,108 @@
+// Package space describes member-created groups. A space has its own threads
+// and members, and its join policy decides whether anyone may join, whether
+// members must be approved or whether they must be invited. Threads in spaces
+// that are not open can only be read by the space's members.
+package space
+
+import (
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type SpaceID xid.ID
+
+func (i SpaceID) String() string { return xid.ID(i).String() }
+
+type Space struct {
+	ID          SpaceID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Name        string
+	Slug        string
+	Description string
+	Policy      Policy
+	MemberCount int
+
+	// Set by the querier for the member viewing the space.
+	Membership opt.Optional[Member]
+}
+
+// IsPublic reports whether the space's threads can be read by anyone.
+func (s *Space) IsPublic() bool {
+	return s.Policy == PolicyOpen
+}
+
+// IsMember reports whether the viewing member has joined the space.
+func (s *Space) IsMember() bool {
+	m, ok := s.Membership.Get()
+	return ok && m.Status == StatusActive
+}
+
+// CanManage reports whether the viewing member moderates the space.
+func (s *Space) CanManage() bool {
+	m, ok := s.Membership.Get()
+	return ok && m.CanManage()
+}
+
+type Member struct {
+	Profile  profile.Ref
+	Role     Role
+	Status   Status
+	JoinedAt time.Time
+}
+
+func (m *Member) CanManage() bool {
+	return m.Status == StatusActive && (m.Role == RoleOwner || m.Role == RoleModerator)
+}
+
+func Map(in *ent.Space) (*Space, error) {
+	policy, err := NewPolicy(in.JoinPolicy)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Space{
+		ID:          SpaceID(in.ID),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Name:        in.Name,
+		Slug:        in.Slug,
+		Description: in.Description,
+		Policy:      policy,
+	}, nil
+}
+
+func MapMember(in *ent.SpaceMember) (*Member, error) {
+	accountEdge, err := in.Edges.AccountOrErr()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	pro, err := profile.MapRef(accountEdge)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	role, err := NewRole(in.Role)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	status, err := NewStatus(in.Status)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Member{
+		Profile:  *pro,
+		Role:     role,
+		Status:   status,
+		JoinedAt: in.CreatedAt,
+	}, nil
+}
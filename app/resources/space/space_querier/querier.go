@@ -0,0 +1,164 @@
+package space_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/space"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	ent_space "github.com/Southclaws/storyden/internal/ent/space"
+	ent_member "github.com/Southclaws/storyden/internal/ent/spacemember"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// List returns every space, newest first, since spaces are discoverable even
+// when their threads are not.
+func (q *Querier) List(ctx context.Context, page pagination.Parameters, viewer opt.Optional[account.AccountID]) (*pagination.Result[*space.Space], error) {
+	query := q.db.Space.Query()
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := query.
+		Order(ent.Desc(ent_space.FieldCreatedAt)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	spaces, err := dt.MapErr(r, space.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, s := range spaces {
+		if err := q.hydrate(ctx, s, viewer); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	result := pagination.NewPageResult(page, total, spaces)
+	return &result, nil
+}
+
+func (q *Querier) Get(ctx context.Context, slug string, viewer opt.Optional[account.AccountID]) (*space.Space, error) {
+	return q.get(ctx, ent_space.Slug(slug), viewer)
+}
+
+func (q *Querier) GetByID(ctx context.Context, id space.SpaceID, viewer opt.Optional[account.AccountID]) (*space.Space, error) {
+	return q.get(ctx, ent_space.ID(xid.ID(id)), viewer)
+}
+
+// ListMembers returns the space's members with any of the given statuses,
+// owners and moderators first.
+func (q *Querier) ListMembers(ctx context.Context, id space.SpaceID, statuses ...space.Status) ([]*space.Member, error) {
+	r, err := q.db.SpaceMember.Query().
+		Where(
+			ent_member.SpaceID(xid.ID(id)),
+			ent_member.StatusIn(dt.Map(statuses, func(s space.Status) string { return s.String() })...),
+		).
+		WithAccount().
+		Order(ent.Asc(ent_member.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	members, err := dt.MapErr(r, space.MapMember)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	managers := dt.Filter(members, func(m *space.Member) bool { return m.CanManage() })
+	others := dt.Filter(members, func(m *space.Member) bool { return !m.CanManage() })
+
+	return append(managers, others...), nil
+}
+
+func (q *Querier) GetMember(ctx context.Context, id space.SpaceID, accountID account.AccountID) (opt.Optional[space.Member], error) {
+	r, err := q.db.SpaceMember.Query().
+		Where(
+			ent_member.SpaceID(xid.ID(id)),
+			ent_member.AccountID(xid.ID(accountID)),
+		).
+		WithAccount().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return opt.NewEmpty[space.Member](), nil
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m, err := space.MapMember(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.New(*m), nil
+}
+
+func (q *Querier) get(ctx context.Context, p predicate.Space, viewer opt.Optional[account.AccountID]) (*space.Space, error) {
+	r, err := q.db.Space.Query().
+		Where(p).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := space.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := q.hydrate(ctx, s, viewer); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s, nil
+}
+
+func (q *Querier) hydrate(ctx context.Context, s *space.Space, viewer opt.Optional[account.AccountID]) error {
+	n, err := q.db.SpaceMember.Query().
+		Where(
+			ent_member.SpaceID(xid.ID(s.ID)),
+			ent_member.Status(space.StatusActive.String()),
+		).
+		Count(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	s.MemberCount = n
+
+	if accountID, ok := viewer.Get(); ok {
+		s.Membership, err = q.GetMember(ctx, s.ID, accountID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
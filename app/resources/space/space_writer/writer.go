@@ -0,0 +1,146 @@
+package space_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/space"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_member "github.com/Southclaws/storyden/internal/ent/spacemember"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.SpaceMutation)
+
+func WithName(v string) Option {
+	return func(m *ent.SpaceMutation) { m.SetName(v) }
+}
+
+func WithSlug(v string) Option {
+	return func(m *ent.SpaceMutation) { m.SetSlug(v) }
+}
+
+func WithDescription(v string) Option {
+	return func(m *ent.SpaceMutation) { m.SetDescription(v) }
+}
+
+func WithPolicy(v space.Policy) Option {
+	return func(m *ent.SpaceMutation) { m.SetJoinPolicy(v.String()) }
+}
+
+// Create adds a space with the given member as its owner.
+func (w *Writer) Create(ctx context.Context, ownerID account.AccountID, name, slug string, opts ...Option) (space.SpaceID, error) {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return space.SpaceID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	create := tx.Space.Create()
+	mutation := create.Mutation()
+
+	mutation.SetName(name)
+	mutation.SetSlug(slug)
+	mutation.SetJoinPolicy(space.PolicyOpen.String())
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	s, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return space.SpaceID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return space.SpaceID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = tx.SpaceMember.Create().
+		SetSpaceID(s.ID).
+		SetAccountID(xid.ID(ownerID)).
+		SetRole(space.RoleOwner.String()).
+		SetStatus(space.StatusActive.String()).
+		Exec(ctx)
+	if err != nil {
+		return space.SpaceID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return space.SpaceID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return space.SpaceID(s.ID), nil
+}
+
+func (w *Writer) Update(ctx context.Context, id space.SpaceID, opts ...Option) error {
+	update := w.db.Space.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Delete removes the space and its memberships, its threads are kept and
+// become ordinary threads.
+func (w *Writer) Delete(ctx context.Context, id space.SpaceID) error {
+	if err := w.db.Space.DeleteOneID(xid.ID(id)).Exec(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) SetMember(ctx context.Context, id space.SpaceID, accountID account.AccountID, role space.Role, status space.Status) error {
+	err := w.db.SpaceMember.Create().
+		SetSpaceID(xid.ID(id)).
+		SetAccountID(xid.ID(accountID)).
+		SetRole(role.String()).
+		SetStatus(status.String()).
+		OnConflictColumns(ent_member.FieldAccountID, ent_member.FieldSpaceID).
+		UpdateRole().
+		UpdateStatus().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) RemoveMember(ctx context.Context, id space.SpaceID, accountID account.AccountID) error {
+	_, err := w.db.SpaceMember.Delete().
+		Where(
+			ent_member.SpaceID(xid.ID(id)),
+			ent_member.AccountID(xid.ID(accountID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
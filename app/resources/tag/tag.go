@@ -2,6 +2,8 @@ package tag
 
 import (
 	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
@@ -12,7 +14,10 @@ import (
 type Tag struct {
 	tag_ref.Tag
 
-	Items []datagraph.Item
+	Parent   opt.Optional[tag_ref.Name]
+	Children tag_ref.Names
+	Synonyms tag_ref.Names
+	Items    []datagraph.Item
 }
 
 type Tags []*Tag
@@ -50,8 +55,23 @@ func Map(in *ent.Tag) (*Tag, error) {
 		items = append(items, node)
 	}
 
+	parent := opt.Map(opt.NewPtr(in.Edges.Parent), func(p ent.Tag) tag_ref.Name {
+		return tag_ref.NewName(p.Name)
+	})
+
+	children := dt.Map(in.Edges.Children, func(c *ent.Tag) tag_ref.Name {
+		return tag_ref.NewName(c.Name)
+	})
+
+	synonyms := dt.Map(in.Edges.Synonyms, func(s *ent.TagSynonym) tag_ref.Name {
+		return tag_ref.NewName(s.Name)
+	})
+
 	return &Tag{
-		Tag:   *tag,
-		Items: items,
+		Tag:      *tag,
+		Parent:   parent,
+		Children: children,
+		Synonyms: synonyms,
+		Items:    items,
 	}, nil
 }
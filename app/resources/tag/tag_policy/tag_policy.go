@@ -0,0 +1,20 @@
+// Package tag_policy describes who may create new tags. Members who can't create
+// tags may still apply tags which already exist, subject to each tag's roles.
+package tag_policy
+
+import (
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+)
+
+// Settings restricts creating new tags to trusted members, either those holding
+// one of the roles or those who have earned enough reputation. With neither
+// set, any member may create tags.
+type Settings struct {
+	Roles      []xid.ID
+	Reputation opt.Optional[int]
+}
+
+func (s Settings) Restricted() bool {
+	return len(s.Roles) > 0 || s.Reputation.Ok()
+}
@@ -8,13 +8,17 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/tag"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/internal/ent"
 	ent_node "github.com/Southclaws/storyden/internal/ent/node"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
 	ent_tag "github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
 )
 
 type Querier struct {
@@ -79,9 +83,51 @@ func (q *Querier) Search(ctx context.Context, query string) (tag_ref.Tags, error
 	return tags, nil
 }
 
+func (q *Querier) ListFollowedBy(ctx context.Context, accountID account.AccountID) (tag_ref.Tags, error) {
+	r, err := q.db.Tag.Query().
+		Where(
+			ent_tag.HasFollowersWith(tagfollow.AccountID(xid.ID(accountID))),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var counts tag_ref.TagItemsResults
+	err = q.raw.SelectContext(ctx, &counts, tagItemsCountManyQuery)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tags := tag_ref.Tags(dt.Map(r, tag_ref.Map(counts)))
+
+	sort.Sort(tags)
+
+	return tags, nil
+}
+
+// ListByNames returns the tags which already exist out of the given names.
+func (q *Querier) ListByNames(ctx context.Context, names ...tag_ref.Name) (tag_ref.Tags, error) {
+	r, err := q.db.Tag.Query().
+		Where(ent_tag.NameIn(tag_ref.Names(names).Strings()...)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, tag_ref.Map(nil)), nil
+}
+
+// Get returns a tag by its name or by the name of one of its synonyms.
 func (q *Querier) Get(ctx context.Context, name tag_ref.Name) (*tag.Tag, error) {
 	r, err := q.db.Tag.Query().
-		Where(ent_tag.Name(name.String())).
+		Where(ent_tag.Or(
+			ent_tag.Name(name.String()),
+			ent_tag.HasSynonymsWith(tagsynonym.Name(name.String())),
+		)).
+		WithParent().
+		WithChildren().
+		WithSynonyms().
 		WithAccounts().
 		WithPosts(func(pq *ent.PostQuery) {
 			pq.Where(
@@ -111,3 +157,76 @@ func (q *Querier) Get(ctx context.Context, name tag_ref.Name) (*tag.Tag, error)
 
 	return tag, nil
 }
+
+// maxTagDepth bounds the walk down a tag hierarchy, the writer prevents cycles
+// but this guards against an unreasonably deep tree being walked on each query.
+const maxTagDepth = 16
+
+// WithDescendants expands a set of tag IDs to include every tag beneath them in
+// the tag hierarchy, so filtering by a parent tag also matches its children.
+func (q *Querier) WithDescendants(ctx context.Context, ids ...xid.ID) ([]xid.ID, error) {
+	seen := make(map[xid.ID]bool, len(ids))
+	out := make([]xid.ID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+
+	frontier := out
+	for depth := 0; len(frontier) > 0 && depth < maxTagDepth; depth++ {
+		children, err := q.db.Tag.Query().
+			Where(ent_tag.ParentTagIDIn(frontier...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		frontier = nil
+		for _, id := range children {
+			if !seen[id] {
+				seen[id] = true
+				out = append(out, id)
+				frontier = append(frontier, id)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// ListForItems returns the names of the tags applied to each of the given
+// threads and library pages, keyed by the item's ID.
+func (q *Querier) ListForItems(ctx context.Context, ids ...xid.ID) (map[xid.ID]tag_ref.Names, error) {
+	out := make(map[xid.ID]tag_ref.Names, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	posts, err := q.db.Post.Query().
+		Where(ent_post.IDIn(ids...)).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := q.db.Node.Query().
+		Where(ent_node.IDIn(ids...)).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, p := range posts {
+		out[p.ID] = dt.Map(p.Edges.Tags, func(t *ent.Tag) tag_ref.Name { return tag_ref.NewName(t.Name) })
+	}
+
+	for _, n := range nodes {
+		out[n.ID] = dt.Map(n.Edges.Tags, func(t *ent.Tag) tag_ref.Name { return tag_ref.NewName(t.Name) })
+	}
+
+	return out, nil
+}
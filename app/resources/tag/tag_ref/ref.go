@@ -6,6 +6,7 @@ import (
 	"github.com/rs/xid"
 	"github.com/samber/lo"
 
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/mark"
 	"github.com/Southclaws/storyden/internal/ent"
 )
@@ -41,8 +42,14 @@ type Tag struct {
 	Name      Name
 	Colour    string
 	ItemCount int
+
+	// Roles, when not empty, restricts applying the tag to members holding at
+	// least one of the roles, such as staff-only tags like "announcement".
+	Roles []role.RoleID
 }
 
+func (t *Tag) Restricted() bool { return len(t.Roles) > 0 }
+
 type Tags []*Tag
 
 func (a Tags) Len() int           { return len(a) }
@@ -62,10 +69,21 @@ func Map(counts TagItemsResults) func(in *ent.Tag) *Tag {
 			Name:      NewName(in.Name),
 			Colour:    deriveTagColour(in.Name),
 			ItemCount: counts.Get(in.ID),
+			Roles:     mapRoles(in.Roles),
 		}
 	}
 }
 
+func mapRoles(in []string) []role.RoleID {
+	return dt.Reduce(in, func(acc []role.RoleID, s string) []role.RoleID {
+		id, err := xid.FromString(s)
+		if err != nil {
+			return acc
+		}
+		return append(acc, role.RoleID(id))
+	}, []role.RoleID{})
+}
+
 type TagItemsResult struct {
 	TagID xid.ID `db:"tag_id"`
 	Count int    `db:"items"`
@@ -6,10 +6,28 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_category "github.com/Southclaws/storyden/internal/ent/category"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
 	ent_tag "github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
+)
+
+var (
+	ErrSynonymIsTag = fault.New("synonym is already a tag", ftag.With(ftag.AlreadyExists))
+	ErrMergeSelf    = fault.New("cannot merge a tag into itself", ftag.With(ftag.InvalidArgument))
+	ErrParentCycle  = fault.New("tag parent would create a cycle", ftag.With(ftag.InvalidArgument))
 )
 
 type Writer struct {
@@ -20,7 +38,51 @@ func New(db *ent.Client) *Writer {
 	return &Writer{db}
 }
 
+// Resolve maps any synonyms in the list of names to their canonical tag names,
+// names which aren't synonyms are left as-is. Duplicates are removed, so both
+// "js" and "javascript" resolve to a single "javascript".
+func (w *Writer) Resolve(ctx context.Context, names ...tag_ref.Name) (tag_ref.Names, error) {
+	if len(names) == 0 {
+		return tag_ref.Names{}, nil
+	}
+
+	synonyms, err := w.db.TagSynonym.Query().
+		Where(tagsynonym.NameIn(tag_ref.Names(names).Strings()...)).
+		WithTag().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	canonical := make(map[string]tag_ref.Name, len(synonyms))
+	for _, s := range synonyms {
+		if s.Edges.Tag != nil {
+			canonical[s.Name] = tag_ref.NewName(s.Edges.Tag.Name)
+		}
+	}
+
+	seen := make(map[tag_ref.Name]bool, len(names))
+	out := make(tag_ref.Names, 0, len(names))
+	for _, n := range names {
+		if c, ok := canonical[n.String()]; ok {
+			n = c
+		}
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		out = append(out, n)
+	}
+
+	return out, nil
+}
+
 func (w *Writer) Add(ctx context.Context, names ...tag_ref.Name) ([]*tag_ref.Tag, error) {
+	names, err := w.Resolve(ctx, names...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	nameStrings := tag_ref.Names(names).Strings()
 
 	newTags := dt.Map(nameStrings, func(n string) *ent.TagCreate {
@@ -32,7 +94,7 @@ func (w *Writer) Add(ctx context.Context, names ...tag_ref.Name) ([]*tag_ref.Tag
 		OnConflictColumns(ent_tag.FieldName).
 		DoNothing()
 
-	err := create.Exec(ctx)
+	err = create.Exec(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -59,3 +121,266 @@ func (w *Writer) Remove(ctx context.Context, names ...tag_ref.Name) error {
 
 	return nil
 }
+
+// SetParent moves a tag beneath another tag in the hierarchy, or to the top
+// level when the parent is empty. A tag can't be moved beneath its own child.
+func (w *Writer) SetParent(ctx context.Context, name tag_ref.Name, parent opt.Optional[tag_ref.Name]) error {
+	t, err := w.get(ctx, w.db, name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p, ok := parent.Get()
+	if !ok {
+		err = w.db.Tag.UpdateOne(t).ClearParentTagID().Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		return nil
+	}
+
+	pt, err := w.get(ctx, w.db, p)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Walk up from the new parent, if we reach the tag being moved, the move
+	// would make the tag an ancestor of itself.
+	for cur := pt; cur != nil; {
+		if cur.ID == t.ID {
+			return fault.Wrap(ErrParentCycle, fctx.With(ctx))
+		}
+		if cur.ParentTagID == nil {
+			break
+		}
+		cur, err = w.db.Tag.Get(ctx, *cur.ParentTagID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	err = w.db.Tag.UpdateOne(t).SetParentTagID(pt.ID).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// SetRoles restricts applying a tag to members holding one of the roles, an
+// empty list allows any member to apply the tag.
+func (w *Writer) SetRoles(ctx context.Context, name tag_ref.Name, roles []role.RoleID) error {
+	t, err := w.get(ctx, w.db, name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ids := dt.Map(roles, func(r role.RoleID) string { return r.String() })
+
+	err = w.db.Tag.UpdateOne(t).SetRoles(ids).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// AddSynonym maps an alternative name onto a tag. If the synonym already maps to
+// another tag, it's moved. A name which is already a tag in its own right can't
+// become a synonym, those must be merged instead so existing items are moved.
+func (w *Writer) AddSynonym(ctx context.Context, name tag_ref.Name, synonym tag_ref.Name) error {
+	t, err := w.get(ctx, w.db, name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	exists, err := w.db.Tag.Query().Where(ent_tag.Name(synonym.String())).Exist(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if exists {
+		return fault.Wrap(ErrSynonymIsTag,
+			fctx.With(ctx),
+			fmsg.WithDesc("synonym is a tag", "A tag with this name already exists, merge the tags instead."))
+	}
+
+	err = w.db.TagSynonym.Create().
+		SetName(synonym.String()).
+		SetTagID(t.ID).
+		OnConflictColumns(tagsynonym.FieldName).
+		UpdateTagID().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) RemoveSynonym(ctx context.Context, name tag_ref.Name, synonym tag_ref.Name) error {
+	n, err := w.db.TagSynonym.Delete().
+		Where(
+			tagsynonym.Name(synonym.String()),
+			tagsynonym.HasTagWith(ent_tag.Name(name.String())),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if n == 0 {
+		return fault.New("synonym not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return nil
+}
+
+// Merge folds a duplicate tag into another within a single transaction. Every
+// thread, page, account, follower and category requirement of the source tag is
+// moved to the target, the source's children and synonyms are re-parented and
+// the source's name is kept as a synonym of the target so it continues to
+// resolve when posting.
+func (w *Writer) Merge(ctx context.Context, source tag_ref.Name, target tag_ref.Name) (*tag_ref.Tag, error) {
+	if source == target {
+		return nil, fault.Wrap(ErrMergeSelf, fctx.With(ctx))
+	}
+
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	src, err := w.get(ctx, tx.Client(), source)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	dst, err := w.get(ctx, tx.Client(), target)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	notOnTarget := ent_tag.ID(dst.ID)
+
+	postIDs, err := tx.Tag.QueryPosts(src).
+		Where(ent_post.Not(ent_post.HasTagsWith(notOnTarget))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodeIDs, err := tx.Tag.QueryNodes(src).
+		Where(ent_node.Not(ent_node.HasTagsWith(notOnTarget))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accountIDs, err := tx.Tag.QueryAccounts(src).
+		Where(ent_account.Not(ent_account.HasTagsWith(notOnTarget))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	categoryIDs, err := tx.Tag.QueryRequiredBy(src).
+		Where(ent_category.Not(ent_category.HasRequiredTagsWith(notOnTarget))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = tx.Tag.UpdateOne(dst).
+		AddPostIDs(postIDs...).
+		AddNodeIDs(nodeIDs...).
+		AddAccountIDs(accountIDs...).
+		AddRequiredByIDs(categoryIDs...).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Followers of both tags keep their existing follow of the target, the
+	// remaining follows of the source are deleted along with the source.
+	following, err := tx.TagFollow.Query().
+		Where(tagfollow.TagID(dst.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	moveFollows := []predicate.TagFollow{tagfollow.TagID(src.ID)}
+	if len(following) > 0 {
+		moveFollows = append(moveFollows, tagfollow.AccountIDNotIn(dt.Map(following, func(f *ent.TagFollow) xid.ID { return f.AccountID })...))
+	}
+
+	_, err = tx.TagFollow.Update().
+		Where(moveFollows...).
+		SetTagID(dst.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// If the target was beneath the source, it takes the source's place.
+	if dst.ParentTagID != nil && *dst.ParentTagID == src.ID {
+		err = tx.Tag.UpdateOne(dst).
+			SetNillableParentTagID(src.ParentTagID).
+			Exec(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	_, err = tx.Tag.Update().
+		Where(
+			ent_tag.ParentTagID(src.ID),
+			ent_tag.IDNEQ(dst.ID),
+		).
+		SetParentTagID(dst.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	_, err = tx.TagSynonym.Update().
+		Where(tagsynonym.TagID(src.ID)).
+		SetTagID(dst.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = tx.Tag.DeleteOne(src).Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = tx.TagSynonym.Create().
+		SetName(src.Name).
+		SetTagID(dst.ID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return tag_ref.Map(nil)(dst), nil
+}
+
+func (w *Writer) get(ctx context.Context, db *ent.Client, name tag_ref.Name) (*ent.Tag, error) {
+	t, err := db.Tag.Query().Where(ent_tag.Name(name.String())).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return t, nil
+}
@@ -0,0 +1,71 @@
+// Package telegram_message maps the notifications sent by the Telegram bot to
+// the posts they were about, so replies in Telegram can be posted to them.
+package telegram_message
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
+)
+
+type Message struct {
+	ChatID    int64
+	MessageID int64
+	PostID    post.ID
+	ThreadID  post.ID
+}
+
+func mapMessage(in *ent.TelegramMessage) *Message {
+	return &Message{
+		ChatID:    in.ChatID,
+		MessageID: in.MessageID,
+		PostID:    post.ID(in.PostID),
+		ThreadID:  post.ID(in.ThreadID),
+	}
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(ctx context.Context, m Message) error {
+	err := r.db.TelegramMessage.Create().
+		SetChatID(m.ChatID).
+		SetMessageID(m.MessageID).
+		SetPostID(xid.ID(m.PostID)).
+		SetThreadID(xid.ID(m.ThreadID)).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, chatID int64, messageID int64) (*Message, error) {
+	m, err := r.db.TelegramMessage.Query().
+		Where(
+			telegrammessage.ChatID(chatID),
+			telegrammessage.MessageID(messageID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapMessage(m), nil
+}
@@ -0,0 +1,113 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+	enttenant "github.com/Southclaws/storyden/internal/ent/tenant"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) List(ctx context.Context) ([]*Tenant, error) {
+	rows, err := r.db.Tenant.Query().
+		Order(ent.Asc(enttenant.FieldHostname)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(rows, Map), nil
+}
+
+func (r *Repository) Get(ctx context.Context, id ID) (*Tenant, error) {
+	row, err := r.db.Tenant.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(row), nil
+}
+
+func (r *Repository) Create(ctx context.Context, hostname string, name string) (*Tenant, error) {
+	row, err := r.db.Tenant.Create().
+		SetHostname(normaliseHostname(hostname)).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists), fmsg.WithDesc("hostname taken", "Another community is already using this hostname."))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(row), nil
+}
+
+type Option func(*ent.TenantMutation)
+
+func WithHostname(v string) Option {
+	return func(m *ent.TenantMutation) {
+		m.SetHostname(normaliseHostname(v))
+	}
+}
+
+func WithName(v string) Option {
+	return func(m *ent.TenantMutation) {
+		m.SetName(v)
+	}
+}
+
+func (r *Repository) Update(ctx context.Context, id ID, opts ...Option) (*Tenant, error) {
+	update := r.db.Tenant.UpdateOneID(xid.ID(id))
+
+	for _, fn := range opts {
+		fn(update.Mutation())
+	}
+
+	row, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists), fmsg.WithDesc("hostname taken", "Another community is already using this hostname."))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Map(row), nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id ID) error {
+	err := r.db.Tenant.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func normaliseHostname(h string) string {
+	return strings.ToLower(strings.TrimSpace(h))
+}
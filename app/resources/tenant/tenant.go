@@ -0,0 +1,33 @@
+// Package tenant stores the communities hosted alongside the primary one,
+// each is served on its own hostname from a database of its own.
+package tenant
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+type Tenant struct {
+	ID        ID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Hostname  string
+	Name      string
+}
+
+func Map(in *ent.Tenant) *Tenant {
+	return &Tenant{
+		ID:        ID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Hostname:  in.Hostname,
+		Name:      in.Name,
+	}
+}
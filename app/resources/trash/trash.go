@@ -0,0 +1,105 @@
+// Package trash describes deleted threads, replies and library pages which are
+// kept for a while so staff can restore them before they're purged for good.
+package trash
+
+import (
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+var ErrNotDeleted = fault.New("item is not in the trash", ftag.With(ftag.NotFound))
+
+// DefaultRetentionDays is how long deleted content stays restorable when the
+// instance's settings do not specify a retention period.
+const DefaultRetentionDays = 30
+
+// RetentionDays resolves the configured retention period, falling back to the
+// default when it's unset or invalid.
+func RetentionDays(days opt.Optional[int]) int {
+	d := days.Or(DefaultRetentionDays)
+	if d <= 0 {
+		return DefaultRetentionDays
+	}
+	return d
+}
+
+func Retention(days opt.Optional[int]) time.Duration {
+	return time.Duration(RetentionDays(days)) * 24 * time.Hour
+}
+
+type Item struct {
+	datagraph.Ref
+	Name      string
+	Slug      opt.Optional[string]
+	ThreadID  opt.Optional[xid.ID]
+	Author    account.Account
+	DeletedAt time.Time
+}
+
+// RestorableUntil is when the item will no longer be restorable and becomes
+// eligible to be purged permanently.
+func (i *Item) RestorableUntil(retention time.Duration) time.Time {
+	return i.DeletedAt.Add(retention)
+}
+
+type Items []*Item
+
+func MapPost(in *ent.Post) (*Item, error) {
+	if in.DeletedAt == nil {
+		return nil, ErrNotDeleted
+	}
+
+	author, err := account.MapRef(in.Edges.Author)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := datagraph.KindThread
+	name := in.Title
+	slug := opt.New(in.Slug)
+	threadID := opt.NewEmpty[xid.ID]()
+	if in.RootPostID != nil {
+		kind = datagraph.KindReply
+		slug = opt.NewEmpty[string]()
+		threadID = opt.New(*in.RootPostID)
+		if root := in.Edges.Root; root != nil {
+			name = root.Title
+		}
+	}
+
+	return &Item{
+		Ref:       datagraph.Ref{ID: in.ID, Kind: kind},
+		Name:      name,
+		Slug:      slug,
+		ThreadID:  threadID,
+		Author:    *author,
+		DeletedAt: *in.DeletedAt,
+	}, nil
+}
+
+func MapNode(in *ent.Node) (*Item, error) {
+	if in.DeletedAt == nil {
+		return nil, ErrNotDeleted
+	}
+
+	author, err := account.MapRef(in.Edges.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Item{
+		Ref:       datagraph.Ref{ID: in.ID, Kind: datagraph.KindNode},
+		Name:      in.Name,
+		Slug:      opt.New(in.Slug),
+		Author:    *author,
+		DeletedAt: *in.DeletedAt,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package trash_querier
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/trash"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// List returns everything deleted since the given time, newest first. Replies
+// deleted along with their thread are not listed as they're restored with it.
+func (q *Querier) List(ctx context.Context, since time.Time) (trash.Items, error) {
+	posts, err := q.db.Post.Query().
+		Where(ent_post.DeletedAtGT(since)).
+		WithAuthor().
+		WithRoot().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := q.db.Node.Query().
+		Where(ent_node.DeletedAtGT(since)).
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	posts = dt.Filter(posts, func(p *ent.Post) bool {
+		if p.Edges.Root == nil {
+			return true
+		}
+		return !deletedTogether(p.DeletedAt, p.Edges.Root.DeletedAt)
+	})
+
+	postItems, err := dt.MapErr(posts, trash.MapPost)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodeItems, err := dt.MapErr(nodes, trash.MapNode)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items := append(postItems, nodeItems...)
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+// Count returns how many threads, replies and pages were moved to the trash
+// before the given time, which is how many a purge would remove.
+func (q *Querier) Count(ctx context.Context, before time.Time) (int, error) {
+	posts, err := q.db.Post.Query().
+		Where(ent_post.DeletedAtLT(before)).
+		Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := q.db.Node.Query().
+		Where(ent_node.DeletedAtLT(before)).
+		Count(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return posts + nodes, nil
+}
+
+// Get returns the deleted thread, reply or page with the given ID.
+func (q *Querier) Get(ctx context.Context, id xid.ID) (*trash.Item, error) {
+	p, err := q.db.Post.Query().
+		Where(ent_post.ID(id), ent_post.DeletedAtNotNil()).
+		WithAuthor().
+		WithRoot().
+		Only(ctx)
+	if err == nil {
+		item, err := trash.MapPost(p)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		return item, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	n, err := q.db.Node.Query().
+		Where(ent_node.ID(id), ent_node.DeletedAtNotNil()).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(trash.ErrNotDeleted, fctx.With(ctx))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	item, err := trash.MapNode(n)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return item, nil
+}
+
+// ThreadInTrash reports whether the item is a reply to a thread which is also
+// in the trash, in which case it cannot be restored on its own.
+func (q *Querier) ThreadInTrash(ctx context.Context, item *trash.Item) (bool, error) {
+	threadID, ok := item.ThreadID.Get()
+	if !ok {
+		return false, nil
+	}
+
+	exists, err := q.db.Post.Query().
+		Where(ent_post.ID(threadID), ent_post.DeletedAtNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return exists, nil
+}
+
+func deletedTogether(child, parent *time.Time) bool {
+	return child != nil && parent != nil && child.Equal(*parent)
+}
@@ -0,0 +1,97 @@
+package trash_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/trash"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// Restore takes the item out of the trash, restoring a thread also restores
+// the replies which were deleted along with it.
+func (w *Writer) Restore(ctx context.Context, item *trash.Item) error {
+	switch item.Kind {
+	case datagraph.KindThread:
+		err := w.db.Post.Update().
+			Where(
+				ent_post.Or(
+					ent_post.ID(item.ID),
+					ent_post.RootPostID(item.ID),
+				),
+				ent_post.DeletedAt(item.DeletedAt),
+			).
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+	case datagraph.KindReply:
+		err := w.db.Post.UpdateOneID(item.ID).
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+	case datagraph.KindNode:
+		err := w.db.Node.UpdateOneID(item.ID).
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// Delete permanently removes a single item from the trash.
+func (w *Writer) Delete(ctx context.Context, item *trash.Item) error {
+	var err error
+	if item.Kind == datagraph.KindNode {
+		err = w.db.Node.DeleteOneID(item.ID).Exec(ctx)
+	} else {
+		err = w.db.Post.DeleteOneID(item.ID).Exec(ctx)
+	}
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Purge permanently deletes everything which was moved to the trash before the
+// given time and returns how many threads, replies and pages were removed.
+func (w *Writer) Purge(ctx context.Context, before time.Time) (int, error) {
+	posts, err := w.db.Post.Delete().
+		Where(ent_post.DeletedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := w.db.Node.Delete().
+		Where(ent_node.DeletedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return posts + nodes, nil
+}
@@ -0,0 +1,81 @@
+// Package trending describes precomputed trending scores for threads and nodes
+// over a set of sliding time windows.
+package trending
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type windowEnum string
+
+const (
+	windowDay   windowEnum = "day"
+	windowWeek  windowEnum = "week"
+	windowMonth windowEnum = "month"
+)
+
+var Windows = []Window{WindowDay, WindowWeek, WindowMonth}
+
+func (w Window) Duration() time.Duration {
+	switch w {
+	case WindowWeek:
+		return time.Hour * 24 * 7
+	case WindowMonth:
+		return time.Hour * 24 * 30
+	default:
+		return time.Hour * 24
+	}
+}
+
+// HalfLife is the age at which a signal is worth half of a fresh one.
+func (w Window) HalfLife() time.Duration {
+	return w.Duration() / 4
+}
+
+type signalTypeEnum string
+
+const (
+	signalTypeView    signalTypeEnum = "view"
+	signalTypeReply   signalTypeEnum = "reply"
+	signalTypeReact   signalTypeEnum = "react"
+	signalTypeLike    signalTypeEnum = "like"
+	signalTypeCollect signalTypeEnum = "collect"
+)
+
+// Signal is a single piece of activity on an item at a point in time.
+type Signal struct {
+	Kind   datagraph.Kind
+	ItemID xid.ID
+	Type   SignalType
+	At     time.Time
+}
+
+type Score struct {
+	Kind       datagraph.Kind
+	ItemID     xid.ID
+	Score      float64
+	ComputedAt time.Time
+}
+
+type Scores []*Score
+
+func Map(in *ent.TrendingScore) (*Score, error) {
+	kind, err := datagraph.NewKind(in.ItemKind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Score{
+		Kind:       kind,
+		ItemID:     in.ItemID,
+		Score:      in.Score,
+		ComputedAt: in.CreatedAt,
+	}, nil
+}
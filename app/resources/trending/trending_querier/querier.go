@@ -0,0 +1,195 @@
+package trending_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/trending"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/collectionnode"
+	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/likepost"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) List(ctx context.Context, window trending.Window, kinds []datagraph.Kind, limit int) (trending.Scores, error) {
+	// Scores are recalculated periodically so are never up to the second.
+	ctx = db.AllowStale(ctx, db.ListingStaleness)
+
+	query := q.db.TrendingScore.Query().
+		Where(trendingscore.Window(window.String()))
+
+	if len(kinds) > 0 {
+		query.Where(trendingscore.ItemKindIn(dt.Map(kinds, func(k datagraph.Kind) string { return k.String() })...))
+	}
+
+	r, err := query.
+		Order(ent.Desc(trendingscore.FieldScore)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	scores, err := dt.MapErr(r, trending.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return scores, nil
+}
+
+// ThreadSignals gathers all activity on published threads since the given
+// time. Activity on replies is attributed to the thread they belong to.
+func (q *Querier) ThreadSignals(ctx context.Context, since time.Time) ([]*trending.Signal, error) {
+	signals := []*trending.Signal{}
+
+	add := func(id xid.ID, t trending.SignalType, at time.Time) {
+		signals = append(signals, &trending.Signal{Kind: datagraph.KindThread, ItemID: id, Type: t, At: at})
+	}
+
+	rootOf := func(p *ent.Post) xid.ID {
+		if p.RootPostID != nil {
+			return *p.RootPostID
+		}
+		return p.ID
+	}
+
+	selectRoot := func(pq *ent.PostQuery) {
+		pq.Select(ent_post.FieldID, ent_post.FieldRootPostID)
+	}
+
+	views, err := q.db.PostRead.Query().
+		Where(postread.LastSeenAtGTE(since)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, v := range views {
+		add(v.RootPostID, trending.SignalTypeView, v.LastSeenAt)
+	}
+
+	replies, err := q.db.Post.Query().
+		Where(
+			ent_post.RootPostIDNotNil(),
+			ent_post.DeletedAtIsNil(),
+			ent_post.CreatedAtGTE(since),
+		).
+		Select(ent_post.FieldID, ent_post.FieldRootPostID, ent_post.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, r := range replies {
+		add(rootOf(r), trending.SignalTypeReply, r.CreatedAt)
+	}
+
+	reacts, err := q.db.React.Query().
+		Where(react.CreatedAtGTE(since)).
+		WithPost(selectRoot).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, r := range reacts {
+		if r.Edges.Post != nil {
+			add(rootOf(r.Edges.Post), trending.SignalTypeReact, r.CreatedAt)
+		}
+	}
+
+	likes, err := q.db.LikePost.Query().
+		Where(likepost.CreatedAtGTE(since)).
+		WithPost(selectRoot).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, l := range likes {
+		if l.Edges.Post != nil {
+			add(rootOf(l.Edges.Post), trending.SignalTypeLike, l.CreatedAt)
+		}
+	}
+
+	collected, err := q.db.CollectionPost.Query().
+		Where(collectionpost.CreatedAtGTE(since)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, c := range collected {
+		add(c.PostID, trending.SignalTypeCollect, c.CreatedAt)
+	}
+
+	eligible, err := q.db.Post.Query().
+		Where(
+			ent_post.IDIn(dt.Map(signals, func(s *trending.Signal) xid.ID { return s.ItemID })...),
+			ent_post.RootPostIDIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+			ent_post.DeletedAtIsNil(),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return filterEligible(signals, eligible), nil
+}
+
+// NodeSignals gathers activity on published library pages since the given
+// time. Pages have no read tracking, so only collection saves contribute.
+func (q *Querier) NodeSignals(ctx context.Context, since time.Time) ([]*trending.Signal, error) {
+	collected, err := q.db.CollectionNode.Query().
+		Where(collectionnode.CreatedAtGTE(since)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	signals := dt.Map(collected, func(c *ent.CollectionNode) *trending.Signal {
+		return &trending.Signal{Kind: datagraph.KindNode, ItemID: c.NodeID, Type: trending.SignalTypeCollect, At: c.CreatedAt}
+	})
+
+	eligible, err := q.db.Node.Query().
+		Where(
+			ent_node.IDIn(dt.Map(signals, func(s *trending.Signal) xid.ID { return s.ItemID })...),
+			ent_node.VisibilityEQ(ent_node.VisibilityPublished),
+			ent_node.DeletedAtIsNil(),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return filterEligible(signals, eligible), nil
+}
+
+func filterEligible(signals []*trending.Signal, eligible []xid.ID) []*trending.Signal {
+	set := make(map[xid.ID]struct{}, len(eligible))
+	for _, id := range eligible {
+		set[id] = struct{}{}
+	}
+
+	return dt.Filter(signals, func(s *trending.Signal) bool {
+		_, ok := set[s.ItemID]
+		return ok
+	})
+}
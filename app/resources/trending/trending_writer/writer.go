@@ -0,0 +1,62 @@
+package trending_writer
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/trending"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// Replace swaps every score stored for the window with the given scores in a
+// single transaction so readers never observe a partially computed window.
+func (w *Writer) Replace(ctx context.Context, window trending.Window, scores trending.Scores) error {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer tx.Rollback()
+
+	_, err = tx.TrendingScore.Delete().
+		Where(trendingscore.Window(window.String())).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	creates := dt.Map(scores, func(s *trending.Score) *ent.TrendingScoreCreate {
+		return tx.TrendingScore.Create().
+			SetWindow(window.String()).
+			SetItemKind(s.Kind.String()).
+			SetItemID(s.ItemID).
+			SetScore(s.Score).
+			SetCreatedAt(s.ComputedAt)
+	})
+
+	for chunk := range slices.Chunk(creates, 500) {
+		err = tx.TrendingScore.CreateBulk(chunk...).Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Package warning describes formal warnings moderators issue to members and
+// the restrictions applied once enough of them have accumulated.
+package warning
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type severityEnum string
+
+const (
+	severityMinor    severityEnum = "minor"
+	severityModerate severityEnum = "moderate"
+	severitySevere   severityEnum = "severe"
+)
+
+// Points is how much a warning of this severity counts towards thresholds.
+func (s Severity) Points() int {
+	switch s {
+	case SeverityModerate:
+		return 2
+	case SeveritySevere:
+		return 3
+	default:
+		return 1
+	}
+}
+
+type WarningID xid.ID
+
+func (i WarningID) String() string { return xid.ID(i).String() }
+
+type Warning struct {
+	ID        WarningID
+	CreatedAt time.Time
+	AccountID account.AccountID
+	IssuedBy  opt.Optional[account.Account]
+	Severity  Severity
+	Reason    string
+	ExpiresAt opt.Optional[time.Time]
+	RevokedAt opt.Optional[time.Time]
+}
+
+// Active reports whether the warning still counts towards thresholds.
+func (w *Warning) Active(now time.Time) bool {
+	if w.RevokedAt.Ok() {
+		return false
+	}
+
+	if exp, ok := w.ExpiresAt.Get(); ok && !exp.After(now) {
+		return false
+	}
+
+	return true
+}
+
+type Warnings []*Warning
+
+// Points is the total of all the active warnings in the list.
+func (ws Warnings) Points(now time.Time) int {
+	total := 0
+	for _, w := range ws {
+		if w.Active(now) {
+			total += w.Severity.Points()
+		}
+	}
+	return total
+}
+
+// Threshold withholds a permission from members whose active warnings add up
+// to at least the given number of points.
+type Threshold struct {
+	Permission rbac.Permission
+	Points     int
+}
+
+type Settings struct {
+	Thresholds []Threshold
+}
+
+func (s Settings) ThresholdFor(p rbac.Permission) (int, bool) {
+	for _, t := range s.Thresholds {
+		if t.Permission == p {
+			return t.Points, true
+		}
+	}
+	return 0, false
+}
+
+// DefaultSettings stops members posting once they have three minor warnings,
+// or fewer warnings of a higher severity.
+var DefaultSettings = Settings{
+	Thresholds: []Threshold{
+		{Permission: rbac.PermissionCreatePost, Points: 3},
+	},
+}
+
+func Map(in *ent.Warning) (*Warning, error) {
+	severity, err := NewSeverity(in.Severity)
+	if err != nil {
+		return nil, err
+	}
+
+	issuedBy, err := opt.MapErr(opt.NewPtr(in.Edges.IssuedBy), func(a ent.Account) (account.Account, error) {
+		acc, err := account.MapRef(&a)
+		if err != nil {
+			return account.Account{}, err
+		}
+		return *acc, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Warning{
+		ID:        WarningID(in.ID),
+		CreatedAt: in.CreatedAt,
+		AccountID: account.AccountID(in.AccountID),
+		IssuedBy:  issuedBy,
+		Severity:  severity,
+		Reason:    in.Reason,
+		ExpiresAt: opt.NewPtr(in.ExpiresAt),
+		RevokedAt: opt.NewPtr(in.RevokedAt),
+	}, nil
+}
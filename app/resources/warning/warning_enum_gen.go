@@ -0,0 +1,66 @@
+// Code generated by enumerator. DO NOT EDIT.
+
+package warning
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type Severity struct {
+	v severityEnum
+}
+
+var (
+	SeverityMinor    = Severity{severityMinor}
+	SeverityModerate = Severity{severityModerate}
+	SeveritySevere   = Severity{severitySevere}
+)
+
+func (r Severity) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r Severity) String() string {
+	return string(r.v)
+}
+func (r Severity) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *Severity) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewSeverity(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r Severity) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *Severity) Scan(__iNpUt__ any) error {
+	s, err := NewSeverity(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewSeverity(__iNpUt__ string) (Severity, error) {
+	switch __iNpUt__ {
+	case string(severityMinor):
+		return SeverityMinor, nil
+	case string(severityModerate):
+		return SeverityModerate, nil
+	case string(severitySevere):
+		return SeveritySevere, nil
+	default:
+		return Severity{}, fmt.Errorf("invalid value for type 'Severity': '%s'", __iNpUt__)
+	}
+}
@@ -0,0 +1,90 @@
+package warning_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/warning"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_warning "github.com/Southclaws/storyden/internal/ent/warning"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// List returns every warning issued to the account, newest first, including
+// those which have expired or been revoked.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID) (warning.Warnings, error) {
+	r, err := q.db.Warning.Query().
+		Where(ent_warning.AccountID(xid.ID(accountID))).
+		WithIssuedBy().
+		Order(ent.Desc(ent_warning.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	warnings, err := dt.MapErr(r, warning.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return warnings, nil
+}
+
+// ListActive returns only the warnings which currently count towards
+// thresholds, without loading who issued them.
+func (q *Querier) ListActive(ctx context.Context, accountID account.AccountID) (warning.Warnings, error) {
+	r, err := q.db.Warning.Query().
+		Where(
+			ent_warning.AccountID(xid.ID(accountID)),
+			ent_warning.RevokedAtIsNil(),
+			ent_warning.Or(
+				ent_warning.ExpiresAtIsNil(),
+				ent_warning.ExpiresAtGT(time.Now()),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	warnings, err := dt.MapErr(r, warning.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return warnings, nil
+}
+
+func (q *Querier) Get(ctx context.Context, id warning.WarningID) (*warning.Warning, error) {
+	r, err := q.db.Warning.Query().
+		Where(ent_warning.ID(xid.ID(id))).
+		WithIssuedBy().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	w, err := warning.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w, nil
+}
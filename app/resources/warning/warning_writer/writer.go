@@ -0,0 +1,82 @@
+package warning_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/warning"
+	"github.com/Southclaws/storyden/app/resources/warning/warning_querier"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Writer struct {
+	db      *ent.Client
+	querier *warning_querier.Querier
+}
+
+func New(db *ent.Client, querier *warning_querier.Querier) *Writer {
+	return &Writer{db: db, querier: querier}
+}
+
+type Option func(*ent.WarningMutation)
+
+func WithIssuedBy(v account.AccountID) Option {
+	return func(m *ent.WarningMutation) {
+		m.SetIssuedByID(xid.ID(v))
+	}
+}
+
+func WithExpiry(v time.Time) Option {
+	return func(m *ent.WarningMutation) {
+		m.SetExpiresAt(v)
+	}
+}
+
+func (w *Writer) Create(
+	ctx context.Context,
+	accountID account.AccountID,
+	severity warning.Severity,
+	reason string,
+	opts ...Option,
+) (*warning.Warning, error) {
+	create := w.db.Warning.Create()
+	mutation := create.Mutation()
+
+	mutation.SetAccountID(xid.ID(accountID))
+	mutation.SetSeverity(severity.String())
+	mutation.SetReason(reason)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, warning.WarningID(r.ID))
+}
+
+func (w *Writer) Revoke(ctx context.Context, id warning.WarningID) (*warning.Warning, error) {
+	err := w.db.Warning.UpdateOneID(xid.ID(id)).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.querier.Get(ctx, id)
+}
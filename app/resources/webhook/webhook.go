@@ -0,0 +1,130 @@
+// Package webhook describes HTTP endpoints which are notified of activity on
+// the instance and the log of deliveries made to them. Most are registered by
+// administrators, REST hook subscriptions are owned by the subscribing account.
+package webhook
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type eventEnum string
+
+const (
+	eventThreadCreated  eventEnum = "thread.created"
+	eventPostCreated    eventEnum = "post.created"
+	eventAccountCreated eventEnum = "account.created"
+	eventReportFiled    eventEnum = "report.filed"
+)
+
+type statusEnum string
+
+const (
+	statusPending   statusEnum = "pending"
+	statusSucceeded statusEnum = "succeeded"
+	statusFailed    statusEnum = "failed"
+)
+
+type WebhookID xid.ID
+
+func (i WebhookID) String() string { return xid.ID(i).String() }
+
+type DeliveryID xid.ID
+
+func (i DeliveryID) String() string { return xid.ID(i).String() }
+
+type Webhook struct {
+	ID        WebhookID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	URL       string
+	Secret    string
+	Events    []Event
+	Enabled   bool
+
+	// Owner is set for REST hook subscriptions, which are managed by the
+	// account which subscribed rather than by administrators.
+	Owner opt.Optional[account.AccountID]
+}
+
+func (w *Webhook) Subscribed(e Event) bool {
+	for _, s := range w.Events {
+		if s == e {
+			return true
+		}
+	}
+	return false
+}
+
+type Webhooks []*Webhook
+
+type Delivery struct {
+	ID             DeliveryID
+	WebhookID      WebhookID
+	CreatedAt      time.Time
+	Event          Event
+	Payload        string
+	Status         Status
+	Attempts       int
+	ResponseStatus opt.Optional[int]
+	Error          opt.Optional[string]
+	NextAttemptAt  opt.Optional[time.Time]
+	DeliveredAt    opt.Optional[time.Time]
+}
+
+type Deliveries []*Delivery
+
+func Map(in *ent.Webhook) *Webhook {
+	events := make([]Event, 0, len(in.Events))
+	for _, e := range in.Events {
+		if ev, err := NewEvent(e); err == nil {
+			events = append(events, ev)
+		}
+	}
+
+	return &Webhook{
+		ID:        WebhookID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Name:      in.Name,
+		URL:       in.URL,
+		Secret:    in.Secret,
+		Events:    events,
+		Enabled:   in.Enabled,
+		Owner:     opt.Map(opt.NewPtr(in.AccountID), func(id xid.ID) account.AccountID { return account.AccountID(id) }),
+	}
+}
+
+func MapDelivery(in *ent.WebhookDelivery) (*Delivery, error) {
+	event, err := NewEvent(in.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := NewStatus(in.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Delivery{
+		ID:             DeliveryID(in.ID),
+		WebhookID:      WebhookID(in.WebhookID),
+		CreatedAt:      in.CreatedAt,
+		Event:          event,
+		Payload:        in.Payload,
+		Status:         status,
+		Attempts:       in.Attempts,
+		ResponseStatus: opt.NewPtr(in.ResponseStatus),
+		Error:          opt.NewPtr(in.Error),
+		NextAttemptAt:  opt.NewPtr(in.NextAttemptAt),
+		DeliveredAt:    opt.NewPtr(in.DeliveredAt),
+	}, nil
+}
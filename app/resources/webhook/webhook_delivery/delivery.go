@@ -0,0 +1,155 @@
+package webhook_delivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(ctx context.Context, webhookID webhook.WebhookID, event webhook.Event, payload string) (*webhook.Delivery, error) {
+	d, err := r.db.WebhookDelivery.Create().
+		SetWebhookID(xid.ID(webhookID)).
+		SetEvent(event.String()).
+		SetPayload(payload).
+		SetStatus(webhook.StatusPending.String()).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.MapDelivery(d)
+}
+
+func (r *Repository) Get(ctx context.Context, id webhook.DeliveryID) (*webhook.Delivery, error) {
+	d, err := r.db.WebhookDelivery.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.MapDelivery(d)
+}
+
+// List returns the most recent deliveries to a webhook, newest first.
+func (r *Repository) List(ctx context.Context, webhookID webhook.WebhookID, limit int) (webhook.Deliveries, error) {
+	ds, err := r.db.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookID(xid.ID(webhookID))).
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out, err := dt.MapErr(ds, webhook.MapDelivery)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return out, nil
+}
+
+type Attempt struct {
+	ResponseStatus opt.Optional[int]
+	Error          opt.Optional[string]
+	// NextAttemptAt is empty when the attempt succeeded or no more attempts
+	// will be made, in which case the delivery is marked failed.
+	NextAttemptAt opt.Optional[time.Time]
+}
+
+func (r *Repository) RecordAttempt(ctx context.Context, id webhook.DeliveryID, at time.Time, a Attempt) (*webhook.Delivery, error) {
+	update := r.db.WebhookDelivery.UpdateOneID(xid.ID(id)).
+		AddAttempts(1)
+
+	if v, ok := a.ResponseStatus.Get(); ok {
+		update.SetResponseStatus(v)
+	} else {
+		update.ClearResponseStatus()
+	}
+
+	if v, ok := a.NextAttemptAt.Get(); ok {
+		update.SetNextAttemptAt(v)
+	} else {
+		update.ClearNextAttemptAt()
+	}
+
+	switch {
+	case !a.Error.Ok():
+		update.SetStatus(webhook.StatusSucceeded.String()).
+			ClearError().
+			SetDeliveredAt(at)
+
+	case a.NextAttemptAt.Ok():
+		update.SetStatus(webhook.StatusPending.String()).
+			SetNillableError(a.Error.Ptr())
+
+	default:
+		update.SetStatus(webhook.StatusFailed.String()).
+			SetNillableError(a.Error.Ptr())
+	}
+
+	d, err := update.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.MapDelivery(d)
+}
+
+// ClaimDue returns pending deliveries whose retry time has passed and clears
+// their retry time so a concurrent scan won't pick up the same delivery.
+func (r *Repository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]webhook.DeliveryID, error) {
+	ids, err := r.db.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.Status(webhook.StatusPending.String()),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	claimed := []webhook.DeliveryID{}
+	for _, id := range ids {
+		n, err := r.db.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(id),
+				webhookdelivery.NextAttemptAtLTE(now),
+			).
+			ClearNextAttemptAt().
+			Save(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			claimed = append(claimed, webhook.DeliveryID(id))
+		}
+	}
+
+	return claimed, nil
+}
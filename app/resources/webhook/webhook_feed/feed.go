@@ -0,0 +1,134 @@
+// Package webhook_feed reads the most recent occurrences of webhook events from
+// the records they refer to, for clients which poll instead of subscribing.
+package webhook_feed
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	ent_report "github.com/Southclaws/storyden/internal/ent/report"
+)
+
+// Item is one occurrence of an event, Data holds the same identifiers as the
+// data of a webhook delivery for the event.
+type Item struct {
+	ID        xid.ID
+	CreatedAt time.Time
+	Data      map[string]any
+}
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// threads queries the published threads the account can read, excluding those
+// in categories its roles can't read and private spaces it isn't a member of.
+func (q *Querier) threads(accountID account.AccountID, roles role.Roles) *ent.PostQuery {
+	query := q.db.Post.Query().
+		Where(
+			ent_post.RootPostIDIsNil(),
+			ent_post.DeletedAtIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+			thread_querier.SpaceReadableBy(opt.New(accountID)),
+		)
+
+	if p, ok := thread_querier.CategoryReadableBy(roles).Get(); ok {
+		query.Where(p)
+	}
+
+	return query
+}
+
+// CanReadThread reports whether the account can read a published thread.
+func (q *Querier) CanReadThread(ctx context.Context, id xid.ID, accountID account.AccountID, roles role.Roles) (bool, error) {
+	ok, err := q.threads(accountID, roles).Where(ent_post.ID(id)).Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ok, nil
+}
+
+// Recent returns the latest occurrences of an event the account can see,
+// newest first.
+func (q *Querier) Recent(ctx context.Context, e webhook.Event, limit int, accountID account.AccountID, roles role.Roles) ([]*Item, error) {
+	switch e {
+	case webhook.EventThreadCreated:
+		r, err := q.threads(accountID, roles).
+			Order(ent.Desc(ent_post.FieldCreatedAt)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return dt.Map(r, func(p *ent.Post) *Item {
+			return &Item{
+				ID:        p.ID,
+				CreatedAt: p.CreatedAt,
+				Data:      map[string]any{"thread_id": p.ID.String()},
+			}
+		}), nil
+
+	case webhook.EventAccountCreated:
+		r, err := q.db.Account.Query().
+			Where(ent_account.DeletedAtIsNil()).
+			Order(ent.Desc(ent_account.FieldCreatedAt)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return dt.Map(r, func(a *ent.Account) *Item {
+			return &Item{
+				ID:        a.ID,
+				CreatedAt: a.CreatedAt,
+				Data:      map[string]any{"account_id": a.ID.String()},
+			}
+		}), nil
+
+	case webhook.EventReportFiled:
+		r, err := q.db.Report.Query().
+			Order(ent.Desc(ent_report.FieldCreatedAt)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return dt.Map(r, func(rp *ent.Report) *Item {
+			return &Item{
+				ID:        rp.ID,
+				CreatedAt: rp.CreatedAt,
+				Data: map[string]any{
+					"report_id":   rp.ID.String(),
+					"reported_by": rp.ReportedByID.String(),
+					"target_kind": rp.TargetKind,
+					"target_id":   rp.TargetID.String(),
+				},
+			}
+		}), nil
+
+	default:
+		return nil, fault.Wrap(fault.Newf("event %s cannot be polled", e), fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+}
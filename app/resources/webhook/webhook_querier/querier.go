@@ -0,0 +1,74 @@
+package webhook_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_webhook "github.com/Southclaws/storyden/internal/ent/webhook"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) List(ctx context.Context) (webhook.Webhooks, error) {
+	r, err := q.db.Webhook.Query().
+		Order(ent.Asc(ent_webhook.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, webhook.Map), nil
+}
+
+// ListByOwner returns the REST hook subscriptions made by an account.
+func (q *Querier) ListByOwner(ctx context.Context, accountID account.AccountID) (webhook.Webhooks, error) {
+	r, err := q.db.Webhook.Query().
+		Where(ent_webhook.AccountID(xid.ID(accountID))).
+		Order(ent.Asc(ent_webhook.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(r, webhook.Map), nil
+}
+
+// ListSubscribed returns the enabled webhooks which receive the given event.
+func (q *Querier) ListSubscribed(ctx context.Context, e webhook.Event) (webhook.Webhooks, error) {
+	r, err := q.db.Webhook.Query().
+		Where(ent_webhook.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Filter(dt.Map(r, webhook.Map), func(w *webhook.Webhook) bool {
+		return w.Subscribed(e)
+	}), nil
+}
+
+func (q *Querier) Get(ctx context.Context, id webhook.WebhookID) (*webhook.Webhook, error) {
+	r, err := q.db.Webhook.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.Map(r), nil
+}
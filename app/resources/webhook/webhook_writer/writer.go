@@ -0,0 +1,116 @@
+package webhook_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.WebhookMutation)
+
+func WithName(v string) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetName(v)
+	}
+}
+
+func WithURL(v string) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetURL(v)
+	}
+}
+
+func WithSecret(v string) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetSecret(v)
+	}
+}
+
+func WithEvents(v []webhook.Event) Option {
+	return func(m *ent.WebhookMutation) {
+		events := make([]string, len(v))
+		for i, e := range v {
+			events[i] = e.String()
+		}
+		m.SetEvents(events)
+	}
+}
+
+func WithEnabled(v bool) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetEnabled(v)
+	}
+}
+
+func WithOwner(id account.AccountID) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetAccountID(xid.ID(id))
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, name string, url string, secret string, events []webhook.Event, opts ...Option) (*webhook.Webhook, error) {
+	create := w.db.Webhook.Create()
+	mutation := create.Mutation()
+
+	mutation.SetName(name)
+	mutation.SetURL(url)
+	mutation.SetSecret(secret)
+	WithEvents(events)(mutation)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.Map(r), nil
+}
+
+func (w *Writer) Update(ctx context.Context, id webhook.WebhookID, opts ...Option) (*webhook.Webhook, error) {
+	update := w.db.Webhook.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.Map(r), nil
+}
+
+func (w *Writer) Delete(ctx context.Context, id webhook.WebhookID) error {
+	err := w.db.Webhook.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Package word_filter describes administrator configured terms which are
+// blocked, held for review or replaced in posts and display names.
+package word_filter
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type actionEnum string
+
+const (
+	actionBlock   actionEnum = "block"   // Not saved, the author is told why.
+	actionReview  actionEnum = "review"  // New posts are placed in the review queue.
+	actionReplace actionEnum = "replace" // The term is swapped for the replacement.
+)
+
+type scopeEnum string
+
+const (
+	scopeTitles scopeEnum = "titles" // Thread titles and display names.
+	scopeAll    scopeEnum = "all"    // Titles, display names and post content.
+)
+
+// Covers reports whether a filter with this scope applies to the field.
+func (s Scope) Covers(f Field) bool {
+	return s == ScopeAll || f != FieldContent
+}
+
+// Field is the part of a post or profile a filter is being applied to.
+type Field int
+
+const (
+	FieldTitle Field = iota
+	FieldName
+	FieldContent
+)
+
+type FilterID xid.ID
+
+func (i FilterID) String() string { return xid.ID(i).String() }
+
+type Filter struct {
+	ID          FilterID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Term        string
+	Action      Action
+	Replacement opt.Optional[string]
+	Scope       Scope
+	Enabled     bool
+}
+
+type Filters []*Filter
+
+func Map(in *ent.WordFilter) (*Filter, error) {
+	action, err := NewAction(in.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := NewScope(in.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Filter{
+		ID:          FilterID(in.ID),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Term:        in.Term,
+		Action:      action,
+		Replacement: opt.NewPtr(in.Replacement),
+		Scope:       scope,
+		Enabled:     in.Enabled,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package word_filter_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/word_filter"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_word_filter "github.com/Southclaws/storyden/internal/ent/wordfilter"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+func (q *Querier) List(ctx context.Context) (word_filter.Filters, error) {
+	r, err := q.db.WordFilter.Query().
+		Order(ent.Asc(ent_word_filter.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filters, err := dt.MapErr(r, word_filter.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return filters, nil
+}
+
+func (q *Querier) ListEnabled(ctx context.Context) (word_filter.Filters, error) {
+	r, err := q.db.WordFilter.Query().
+		Where(ent_word_filter.Enabled(true)).
+		Order(ent.Asc(ent_word_filter.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filters, err := dt.MapErr(r, word_filter.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return filters, nil
+}
+
+func (q *Querier) Get(ctx context.Context, id word_filter.FilterID) (*word_filter.Filter, error) {
+	r, err := q.db.WordFilter.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filter, err := word_filter.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,118 @@
+package word_filter_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/word_filter"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.WordFilterMutation)
+
+func WithTerm(v string) Option {
+	return func(m *ent.WordFilterMutation) {
+		m.SetTerm(v)
+	}
+}
+
+func WithAction(v word_filter.Action) Option {
+	return func(m *ent.WordFilterMutation) {
+		m.SetAction(v.String())
+	}
+}
+
+func WithReplacement(v string) Option {
+	return func(m *ent.WordFilterMutation) {
+		if v == "" {
+			m.ClearReplacement()
+			return
+		}
+		m.SetReplacement(v)
+	}
+}
+
+func WithScope(v word_filter.Scope) Option {
+	return func(m *ent.WordFilterMutation) {
+		m.SetScope(v.String())
+	}
+}
+
+func WithEnabled(v bool) Option {
+	return func(m *ent.WordFilterMutation) {
+		m.SetEnabled(v)
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, term string, action word_filter.Action, scope word_filter.Scope, opts ...Option) (*word_filter.Filter, error) {
+	create := w.db.WordFilter.Create()
+	mutation := create.Mutation()
+
+	WithTerm(term)(mutation)
+	WithAction(action)(mutation)
+	WithScope(scope)(mutation)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filter, err := word_filter.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return filter, nil
+}
+
+func (w *Writer) Update(ctx context.Context, id word_filter.FilterID, opts ...Option) (*word_filter.Filter, error) {
+	update := w.db.WordFilter.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	r, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filter, err := word_filter.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return filter, nil
+}
+
+func (w *Writer) Delete(ctx context.Context, id word_filter.FilterID) error {
+	err := w.db.WordFilter.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
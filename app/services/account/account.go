@@ -3,15 +3,35 @@ package account
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/services/account/account_gate"
 	"github.com/Southclaws/storyden/app/services/account/account_manage"
 	"github.com/Southclaws/storyden/app/services/account/account_update"
+	"github.com/Southclaws/storyden/app/services/account/application_gate"
+	"github.com/Southclaws/storyden/app/services/account/application_manager"
+	"github.com/Southclaws/storyden/app/services/account/application_notify"
+	"github.com/Southclaws/storyden/app/services/account/bot_manager"
+	"github.com/Southclaws/storyden/app/services/account/email_domain_policy"
+	"github.com/Southclaws/storyden/app/services/account/invitation_manager"
+	"github.com/Southclaws/storyden/app/services/account/onboarding_tracker"
 	"github.com/Southclaws/storyden/app/services/account/profile_semdex"
+	"github.com/Southclaws/storyden/app/services/account/reading_tracker"
+	"github.com/Southclaws/storyden/app/services/account/verification_manager"
 )
 
 func Build() fx.Option {
 	return fx.Options(
 		fx.Provide(account_manage.New),
 		fx.Provide(account_update.New),
+		fx.Provide(invitation_manager.New),
+		fx.Provide(application_manager.New),
+		fx.Provide(application_gate.New),
+		fx.Provide(account_gate.New),
+		fx.Provide(email_domain_policy.New),
+		fx.Provide(verification_manager.New),
+		fx.Provide(bot_manager.New),
+		application_notify.Build(),
+		onboarding_tracker.Build(),
 		profile_semdex.Build(),
+		reading_tracker.Build(),
 	)
 }
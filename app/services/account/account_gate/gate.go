@@ -0,0 +1,77 @@
+// Package account_gate applies the checks the HTTP API makes before any
+// operation which needs a permission to actions which arrive some other way,
+// such as replies from chat bridges or email, so they can't be used to get
+// around a suspension, warning or any of the other gates.
+package account_gate
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/account/application_gate"
+	"github.com/Southclaws/storyden/app/services/moderation/warning_gate"
+	"github.com/Southclaws/storyden/app/services/policy/policy_gate"
+	"github.com/Southclaws/storyden/app/services/reputation/reputation_gate"
+)
+
+type Gate struct {
+	applicationGate *application_gate.Gate
+	reputationGate  *reputation_gate.Gate
+	warningGate     *warning_gate.Gate
+	policyGate      *policy_gate.Gate
+}
+
+func New(
+	applicationGate *application_gate.Gate,
+	reputationGate *reputation_gate.Gate,
+	warningGate *warning_gate.Gate,
+	policyGate *policy_gate.Gate,
+) *Gate {
+	return &Gate{
+		applicationGate: applicationGate,
+		reputationGate:  reputationGate,
+		warningGate:     warningGate,
+		policyGate:      policyGate,
+	}
+}
+
+// Check returns an error if the account may not use the permission right now.
+func (g *Gate) Check(ctx context.Context, acc *account.AccountWithEdges, perm rbac.Permission) error {
+	if err := acc.RejectSuspended(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := g.applicationGate.Check(ctx, acc.ID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	perms := acc.Roles.Permissions()
+
+	if err := perms.Authorise(ctx, nil, perm); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Administrators are never held back by reputation or warning thresholds,
+	// nor asked to accept policies before posting.
+	if perms.HasAny(rbac.PermissionAdministrator) {
+		return nil
+	}
+
+	if err := g.reputationGate.Check(ctx, acc.ID, perm); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := g.warningGate.Check(ctx, acc.ID, perm); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := g.policyGate.Check(ctx, acc.ID, perm); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	authentication_repo "github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/moderation_note"
 	"github.com/Southclaws/storyden/app/services/authentication"
+	"github.com/Southclaws/storyden/app/services/moderation/case_file"
 )
 
 type Service interface {
@@ -29,6 +31,7 @@ type service struct {
 	account_writer *account_writer.Writer
 
 	auth_svc *authentication.Manager
+	cases    *case_file.Manager
 }
 
 func New(
@@ -36,11 +39,13 @@ func New(
 	account_writer *account_writer.Writer,
 
 	auth_svc *authentication.Manager,
+	cases *case_file.Manager,
 ) Service {
 	return &service{
 		auth_repo:      auth_repo,
 		account_writer: account_writer,
 		auth_svc:       auth_svc,
+		cases:          cases,
 	}
 }
 
@@ -50,6 +55,11 @@ func (s *service) Suspend(ctx context.Context, id account.AccountID) (*account.A
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	_, err = s.cases.Record(ctx, id, moderation_note.KindSuspension, opt.NewEmpty[string]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	return acc, nil
 }
 
@@ -59,5 +69,10 @@ func (s *service) Reinstate(ctx context.Context, id account.AccountID) (*account
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	_, err = s.cases.Record(ctx, id, moderation_note.KindReinstatement, opt.NewEmpty[string]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	return acc, nil
 }
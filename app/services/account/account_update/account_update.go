@@ -11,22 +11,30 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/reading_history"
+	"github.com/Southclaws/storyden/app/services/moderation/content_policy"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
 // TODO: Should be named profile updater tbh, is not account-specific.
 type Updater struct {
-	writer *account_writer.Writer
-	bus    *pubsub.Bus
+	writer        *account_writer.Writer
+	historyWriter *reading_history.Writer
+	bus           *pubsub.Bus
+	cpm           *content_policy.Manager
 }
 
 func New(
 	writer *account_writer.Writer,
+	historyWriter *reading_history.Writer,
 	bus *pubsub.Bus,
+	cpm *content_policy.Manager,
 ) *Updater {
 	return &Updater{
-		writer: writer,
-		bus:    bus,
+		writer:        writer,
+		historyWriter: historyWriter,
+		bus:           bus,
+		cpm:           cpm,
 	}
 }
 
@@ -37,6 +45,22 @@ type Partial struct {
 	Interests opt.Optional[[]xid.ID]
 	Links     opt.Optional[[]account.ExternalLink]
 	Meta      opt.Optional[map[string]any]
+
+	// Locale is the language the account is sent emails and notifications
+	// in, an empty string clears it so the instance's default is used.
+	Locale opt.Optional[string]
+
+	// Timezone is used for when digests are sent and how dates are written,
+	// an empty string clears it so the instance's default is used.
+	Timezone opt.Optional[string]
+
+	// ReadingHistory turns recording of reading history on or off, turning
+	// it off also forgets any history recorded so far.
+	ReadingHistory opt.Optional[bool]
+
+	// HiddenActivity replaces the kinds of activity hidden from others on the
+	// member's profile timeline.
+	HiddenActivity opt.Optional[[]account.ActivityKind]
 }
 
 func (u *Updater) Update(ctx context.Context, id account.AccountID, params Partial) (*account.AccountWithEdges, error) {
@@ -50,6 +74,11 @@ func (u *Updater) Update(ctx context.Context, id account.AccountID, params Parti
 		opts = append(opts, account_writer.SetHandle(v))
 	}
 	if v, ok := params.Name.Get(); ok {
+		v, err := u.cpm.FilterName(ctx, v)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
 		opts = append(opts, account_writer.SetName(v))
 	}
 	if v, ok := params.Bio.Get(); ok {
@@ -64,12 +93,50 @@ func (u *Updater) Update(ctx context.Context, id account.AccountID, params Parti
 	if v, ok := params.Meta.Get(); ok {
 		opts = append(opts, account_writer.SetMetadata(v))
 	}
+	if v, ok := params.Locale.Get(); ok {
+		if v == "" {
+			opts = append(opts, account_writer.SetLocale(opt.NewEmpty[string]()))
+		} else {
+			v, err := account.ValidateLocale(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+
+			opts = append(opts, account_writer.SetLocale(opt.New(v)))
+		}
+	}
+	if v, ok := params.Timezone.Get(); ok {
+		if v == "" {
+			opts = append(opts, account_writer.SetTimezone(opt.NewEmpty[string]()))
+		} else {
+			v, err := account.ValidateTimezone(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+
+			opts = append(opts, account_writer.SetTimezone(opt.New(v)))
+		}
+	}
+
+	if v, ok := params.ReadingHistory.Get(); ok {
+		opts = append(opts, account_writer.SetReadingHistory(v))
+	}
+
+	if v, ok := params.HiddenActivity.Get(); ok {
+		opts = append(opts, account_writer.SetHiddenActivity(v))
+	}
 
 	acc, err := u.writer.Update(ctx, id, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if v, ok := params.ReadingHistory.Get(); ok && !v {
+		if err := u.historyWriter.Clear(ctx, id); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	u.bus.Publish(ctx, &message.EventAccountUpdated{
 		ID: id,
 	})
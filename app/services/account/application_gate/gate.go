@@ -0,0 +1,45 @@
+// Package application_gate withholds permissions from members whose
+// application to join has not been approved.
+package application_gate
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/application/application_querier"
+)
+
+var errAwaitingApproval = fault.New("awaiting approval", ftag.With(ftag.PermissionDenied))
+
+type Gate struct {
+	querier *application_querier.Querier
+}
+
+func New(querier *application_querier.Querier) *Gate {
+	return &Gate{querier: querier}
+}
+
+// Check returns an error if the account applied to join and has not yet been
+// approved. Members who registered before approval was required never applied
+// so are never held back.
+func (g *Gate) Check(ctx context.Context, accountID account.AccountID) error {
+	held, err := g.querier.IsHeld(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if held {
+		return fault.Wrap(errAwaitingApproval,
+			fctx.With(ctx),
+			fmsg.WithDesc("awaiting approval",
+				"You can't do this until your application to join has been approved."),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+// Package application_manager runs the approval queue for instances where new
+// members must be accepted by staff before they can take part.
+package application_manager
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/application"
+	"github.com/Southclaws/storyden/app/resources/account/application/application_querier"
+	"github.com/Southclaws/storyden/app/resources/account/application/application_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrAlreadyReviewed = fault.New("application already reviewed", ftag.With(ftag.InvalidArgument))
+	ErrUnknownQuestion = fault.New("unknown question", ftag.With(ftag.InvalidArgument))
+	ErrMissingAnswer   = fault.New("required question not answered", ftag.With(ftag.InvalidArgument))
+	ErrNoApplications  = fault.New("no applications given", ftag.With(ftag.InvalidArgument))
+)
+
+type Manager struct {
+	settings *settings.SettingsRepository
+	querier  *application_querier.Querier
+	writer   *application_writer.Writer
+	bus      *pubsub.Bus
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	querier *application_querier.Querier,
+	writer *application_writer.Writer,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		settings: settings,
+		querier:  querier,
+		writer:   writer,
+		bus:      bus,
+	}
+}
+
+// Open places a newly registered account in the approval queue when the
+// instance requires approval. If there are no questions to answer, the
+// application is submitted straight away.
+func (m *Manager) Open(ctx context.Context, accountID account.AccountID) error {
+	s, err := m.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	cfg := s.Applications.OrZero()
+	if !cfg.Required {
+		return nil
+	}
+
+	app, err := m.writer.Create(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(cfg.Questions) > 0 {
+		return nil
+	}
+
+	if _, err := m.submit(ctx, app, nil); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Own returns the application of the session's account along with the
+// questions it should answer.
+func (m *Manager) Own(ctx context.Context) (*application.Application, []application.Question, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := m.settings.Get(ctx)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	app, err := m.querier.GetByAccount(ctx, accountID)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return app, s.Applications.OrZero().Questions, nil
+}
+
+// Submit answers the questionnaire for the session's own application, it may
+// be answered again until the application has been reviewed.
+func (m *Manager) Submit(ctx context.Context, answers []application.Answer) (*application.Application, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := m.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	app, err := m.querier.GetByAccount(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if app.Status != application.StatusPending {
+		return nil, fault.Wrap(ErrAlreadyReviewed, fctx.With(ctx),
+			fmsg.WithDesc("reviewed", "Your application has already been reviewed."))
+	}
+
+	if err := validate(s.Applications.OrZero().Questions, answers); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.submit(ctx, app, answers)
+}
+
+func (m *Manager) submit(ctx context.Context, app *application.Application, answers []application.Answer) (*application.Application, error) {
+	first := !app.SubmittedAt.Ok()
+
+	app, err := m.writer.Submit(ctx, app.ID, answers)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Staff are only told about an application once, changes to the answers
+	// afterwards show up in the queue without another notification.
+	if first {
+		m.bus.Publish(ctx, &message.EventAccountApplicationSubmitted{
+			ID:        app.ID,
+			AccountID: app.Account.ID,
+		})
+	}
+
+	return app, nil
+}
+
+func (m *Manager) List(ctx context.Context, status opt.Optional[application.Status]) ([]*application.Application, error) {
+	apps, err := m.querier.List(ctx, status)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return apps, nil
+}
+
+// Approve lets the applicants take part in the community. Applications which
+// were already reviewed are skipped, the number approved is returned.
+func (m *Manager) Approve(ctx context.Context, ids []application.ApplicationID) (int, error) {
+	return m.review(ctx, ids, application.StatusApproved, opt.NewEmpty[string]())
+}
+
+// Reject refuses the applications, the accounts remain but are held back from
+// doing anything which requires a permission.
+func (m *Manager) Reject(ctx context.Context, ids []application.ApplicationID, reason opt.Optional[string]) (int, error) {
+	return m.review(ctx, ids, application.StatusRejected, reason)
+}
+
+// AutoApprove approves the account's pending application without a reviewer,
+// for members who qualify automatically such as by their email domain.
+func (m *Manager) AutoApprove(ctx context.Context, accountID account.AccountID) error {
+	held, err := m.querier.IsHeld(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !held {
+		return nil
+	}
+
+	app, err := m.querier.GetByAccount(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.writer.Review(ctx, []application.ApplicationID{app.ID}, application.StatusApproved, opt.NewEmpty[account.AccountID](), opt.NewEmpty[string]()); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) review(ctx context.Context, ids []application.ApplicationID, status application.Status, reason opt.Optional[string]) (int, error) {
+	reviewer, err := session.GetAccountID(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(ids) == 0 {
+		return 0, fault.Wrap(ErrNoApplications, fctx.With(ctx))
+	}
+
+	n, err := m.writer.Review(ctx, ids, status, opt.New(reviewer), reason)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+func validate(questions []application.Question, answers []application.Answer) error {
+	given := map[string]string{}
+	for _, a := range answers {
+		if !lo.ContainsBy(questions, func(q application.Question) bool { return q.ID == a.QuestionID }) {
+			return fault.Wrap(ErrUnknownQuestion,
+				fmsg.WithDesc("unknown question", "One of the answers is for a question which isn't asked."))
+		}
+		given[a.QuestionID] = strings.TrimSpace(a.Answer)
+	}
+
+	for _, q := range questions {
+		if q.Required && given[q.ID] == "" {
+			return fault.Wrap(ErrMissingAnswer,
+				fmsg.WithDesc("missing answer", "Please answer: "+q.Prompt))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package application_notify
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(func(
+		ctx context.Context,
+		lc fx.Lifecycle,
+		bus *pubsub.Bus,
+		notifier *notify.Notifier,
+		accountQuerier *account_querier.Querier,
+	) {
+		consumer := func(hctx context.Context) error {
+			// Application submitted
+			// Notify members who can approve or reject it.
+			if _, err := pubsub.Subscribe(hctx, bus, "application_notify.application_submitted", func(ctx context.Context, evt *message.EventAccountApplicationSubmitted) error {
+				return sendSubmitted(ctx, notifier, accountQuerier, evt)
+			}); err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		lc.Append(fx.StartHook(consumer))
+	})
+}
+
+func sendSubmitted(
+	ctx context.Context,
+	notifier *notify.Notifier,
+	accountQuerier *account_querier.Querier,
+	evt *message.EventAccountApplicationSubmitted,
+) error {
+	accs, err := accountQuerier.ListByHeldPermission(ctx, rbac.PermissionAdministrator, rbac.PermissionManageSuspensions)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, acc := range accs {
+		if err := notifier.Send(ctx, acc.ID, opt.New(evt.AccountID), notification.EventApplicationSubmitted, nil); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+// Package bot_manager lets members create bot accounts for their automated
+// clients and issue the access keys bots authenticate with. Members manage
+// their own bots and administrators may manage any bot.
+package bot_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
+	"github.com/Southclaws/storyden/app/resources/account/bot"
+	"github.com/Southclaws/storyden/app/resources/account/bot/bot_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrBotOwner = fault.New("bots cannot own other bots", ftag.With(ftag.PermissionDenied))
+	ErrNotOwner = fault.New("not the owner of the bot", ftag.With(ftag.PermissionDenied))
+)
+
+type Partial struct {
+	Handle opt.Optional[string]
+	Name   opt.Optional[string]
+	Bio    opt.Optional[datagraph.Content]
+}
+
+type Manager struct {
+	accountWriter *account_writer.Writer
+	querier       *bot_querier.Querier
+	accessKeys    *access_key.Repository
+	bus           *pubsub.Bus
+}
+
+func New(
+	accountWriter *account_writer.Writer,
+	querier *bot_querier.Querier,
+	accessKeys *access_key.Repository,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		accountWriter: accountWriter,
+		querier:       querier,
+		accessKeys:    accessKeys,
+		bus:           bus,
+	}
+}
+
+// Create makes a new bot owned by the session's account.
+func (m *Manager) Create(ctx context.Context, handle string, name opt.Optional[string], bio opt.Optional[datagraph.Content]) (*bot.Bot, error) {
+	owner, err := session.GetAccount(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if owner.Kind == account.AccountKindBot {
+		return nil, fault.Wrap(ErrBotOwner, fctx.With(ctx),
+			fmsg.WithDesc("bot owner", "Bots can only be created by members."))
+	}
+
+	opts := []account_writer.Option{
+		account_writer.WithKind(account.AccountKindBot),
+		account_writer.WithOwner(owner.ID),
+	}
+	if v, ok := name.Get(); ok {
+		opts = append(opts, account_writer.WithName(v))
+	}
+	if v, ok := bio.Get(); ok {
+		opts = append(opts, account_writer.WithBio(v))
+	}
+
+	acc, err := m.accountWriter.Create(ctx, handle, opts...)
+	if err != nil {
+		if ftag.Get(err) == ftag.AlreadyExists {
+			return nil, fault.Wrap(err, fctx.With(ctx),
+				fmsg.WithDesc("handle taken", "The specified handle has already been used."))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventAccountCreated{
+		ID: acc.ID,
+	})
+
+	return m.querier.GetByHandle(ctx, acc.Handle)
+}
+
+// List returns the bots owned by the session's account.
+func (m *Manager) List(ctx context.Context) ([]*bot.Bot, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	bots, err := m.querier.ListByOwner(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return bots, nil
+}
+
+func (m *Manager) Get(ctx context.Context, handle string) (*bot.Bot, error) {
+	b, err := m.querier.GetByHandle(ctx, handle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.authorise(ctx, b); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+func (m *Manager) Update(ctx context.Context, handle string, p Partial) (*bot.Bot, error) {
+	b, err := m.Get(ctx, handle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	opts := []account_writer.Mutation{}
+	if v, ok := p.Handle.Get(); ok {
+		opts = append(opts, account_writer.SetHandle(v))
+	}
+	if v, ok := p.Name.Get(); ok {
+		opts = append(opts, account_writer.SetName(v))
+	}
+	if v, ok := p.Bio.Get(); ok {
+		opts = append(opts, account_writer.SetBio(v.HTML()))
+	}
+
+	acc, err := m.accountWriter.Update(ctx, b.Account.ID, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventAccountUpdated{
+		ID: acc.ID,
+	})
+
+	return m.querier.GetByHandle(ctx, acc.Handle)
+}
+
+// Delete suspends the bot and revokes all of its access keys. The bot's posts
+// are kept, attributed to the suspended account, as when suspending a member.
+func (m *Manager) Delete(ctx context.Context, handle string) error {
+	b, err := m.Get(ctx, handle)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.revokeAll(ctx, b.Account.ID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.accountWriter.Update(ctx, b.Account.ID, account_writer.SetDeleted(opt.New(time.Now()))); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventAccountUpdated{
+		ID: b.Account.ID,
+	})
+
+	return nil
+}
+
+func (m *Manager) ListAccessKeys(ctx context.Context, handle string) ([]*authentication.Authentication, error) {
+	b, err := m.Get(ctx, handle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	keys, err := m.accessKeys.List(ctx, b.Account.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return keys, nil
+}
+
+func (m *Manager) CreateAccessKey(ctx context.Context, handle string, name string, expiry opt.Optional[time.Time]) (*access_key.AccessKeyRecordWithSecret, error) {
+	b, err := m.Get(ctx, handle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	key, err := m.accessKeys.Create(ctx, b.Account.ID, access_key.AccessKeyKindBot, name, expiry)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return key, nil
+}
+
+func (m *Manager) RevokeAccessKey(ctx context.Context, handle string, id authentication.ID) error {
+	b, err := m.Get(ctx, handle)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.accessKeys.Revoke(ctx, b.Account.ID, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) revokeAll(ctx context.Context, accountID account.AccountID) error {
+	keys, err := m.accessKeys.List(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	active := dt.Filter(keys, func(k *authentication.Authentication) bool { return !k.Disabled })
+	for _, k := range active {
+		if _, err := m.accessKeys.Revoke(ctx, accountID, k.ID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// authorise allows the bot's owner and administrators to manage the bot.
+func (m *Manager) authorise(ctx context.Context, b *bot.Bot) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return session.Authorise(ctx, func() error {
+		if owner, ok := b.Owner.Get(); ok && owner.ID == accountID {
+			return nil
+		}
+		return ErrNotOwner
+	}, rbac.PermissionAdministrator)
+}
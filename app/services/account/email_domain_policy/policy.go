@@ -0,0 +1,95 @@
+// Package email_domain_policy scopes registration to configured email domains
+// and grants what each domain's rule carries once an address is verified.
+package email_domain_policy
+
+import (
+	"context"
+	"net/mail"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_assign"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/account/application_manager"
+)
+
+var ErrDomainNotAllowed = fault.New("email domain not allowed", ftag.With(ftag.PermissionDenied))
+
+type Policy struct {
+	settings     *settings.SettingsRepository
+	applications *application_manager.Manager
+	roleQuerier  *role_querier.Querier
+	roleAssign   *role_assign.Assignment
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	applications *application_manager.Manager,
+	roleQuerier *role_querier.Querier,
+	roleAssign *role_assign.Assignment,
+) *Policy {
+	return &Policy{
+		settings:     settings,
+		applications: applications,
+		roleQuerier:  roleQuerier,
+		roleAssign:   roleAssign,
+	}
+}
+
+// Check rejects addresses outside the allowed domains when the instance is
+// restricted to a set of domains.
+func (p *Policy) Check(ctx context.Context, address mail.Address) error {
+	s, err := p.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !s.EmailDomains.OrZero().Allows(address) {
+		return fault.Wrap(ErrDomainNotAllowed, fctx.With(ctx),
+			fmsg.WithDesc("domain not allowed", "Email addresses at this domain cannot be used on this community."))
+	}
+
+	return nil
+}
+
+// Apply is called once an address has been verified and approves the account
+// or grants roles according to the rule matching the address's domain.
+func (p *Policy) Apply(ctx context.Context, accountID account.AccountID, address mail.Address) error {
+	s, err := p.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rule, ok := s.EmailDomains.OrZero().Match(address).Get()
+	if !ok {
+		return nil
+	}
+
+	if rule.AutoApprove {
+		if err := p.applications.AutoApprove(ctx, accountID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	for _, id := range rule.Roles {
+		// A role may have been deleted since the rule was configured, that
+		// shouldn't prevent the member from verifying their address.
+		if _, err := p.roleQuerier.Get(ctx, id); err != nil {
+			if ftag.Get(err) == ftag.NotFound {
+				continue
+			}
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if _, err := p.roleAssign.UpdateRoles(ctx, accountID, role_assign.Add(id)); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
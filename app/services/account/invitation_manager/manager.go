@@ -0,0 +1,261 @@
+// Package invitation_manager handles invitations from creation, subject to the
+// member's quota, through to redemption when someone registers with one.
+package invitation_manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/invitation"
+	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_querier"
+	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_assign"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var (
+	ErrQuotaReached       = fault.New("invitation quota reached", ftag.With(ftag.PermissionDenied))
+	ErrCannotGrantRole    = fault.New("cannot grant role", ftag.With(ftag.PermissionDenied))
+	ErrInvalid            = fault.New("invalid invitation", ftag.With(ftag.InvalidArgument))
+	ErrUnusable           = fault.New("invitation unusable", ftag.With(ftag.InvalidArgument))
+	ErrInvitationRequired = fault.New("invitation required", ftag.With(ftag.PermissionDenied))
+	ErrNotCreator         = fault.New("not the creator of this invitation", ftag.With(ftag.PermissionDenied))
+)
+
+type Manager struct {
+	settings       *settings.SettingsRepository
+	accountQuerier *account_querier.Querier
+	querier        *invitation_querier.Querier
+	writer         *invitation_writer.Writer
+	roleQuerier    *role_querier.Querier
+	roleAssign     *role_assign.Assignment
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	accountQuerier *account_querier.Querier,
+	querier *invitation_querier.Querier,
+	writer *invitation_writer.Writer,
+	roleQuerier *role_querier.Querier,
+	roleAssign *role_assign.Assignment,
+) *Manager {
+	return &Manager{
+		settings:       settings,
+		accountQuerier: accountQuerier,
+		querier:        querier,
+		writer:         writer,
+		roleQuerier:    roleQuerier,
+		roleAssign:     roleAssign,
+	}
+}
+
+type Partial struct {
+	Message   opt.Optional[string]
+	MaxUses   opt.Optional[int]
+	ExpiresAt opt.Optional[time.Time]
+	Role      opt.Optional[role.RoleID]
+}
+
+func (m *Manager) Create(ctx context.Context, p Partial) (*invitation.Invitation, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := m.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	perms := acc.Roles.Permissions()
+
+	opts := []invitation_writer.Option{}
+
+	if n, ok := p.MaxUses.Get(); ok {
+		if n < 1 {
+			return nil, fault.Wrap(ErrInvalid, fctx.With(ctx),
+				fmsg.WithDesc("invalid max uses", "An invitation must allow at least one use."))
+		}
+		opts = append(opts, invitation_writer.WithMaxUses(n))
+	}
+
+	if t, ok := p.ExpiresAt.Get(); ok {
+		if !t.After(time.Now()) {
+			return nil, fault.Wrap(ErrInvalid, fctx.With(ctx),
+				fmsg.WithDesc("expiry in the past", "An invitation's expiry must be in the future."))
+		}
+		opts = append(opts, invitation_writer.WithExpiry(t))
+	}
+
+	if id, ok := p.Role.Get(); ok {
+		if err := m.canGrant(ctx, perms, id); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		opts = append(opts, invitation_writer.WithRole(id))
+	}
+
+	if !perms.HasAny(rbac.PermissionAdministrator) {
+		if err := m.checkQuota(ctx, accountID); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	inv, err := m.writer.Create(ctx, accountID, p.Message, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return inv, nil
+}
+
+// Invited lists the members who registered with the invitation, only its
+// creator and administrators may see who used it.
+func (m *Manager) Invited(ctx context.Context, id xid.ID) ([]*account.Account, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := m.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	inv, err := m.querier.GetByID(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = acc.Roles.Permissions().Authorise(ctx, func() error {
+		if inv.Creator.ID == acc.ID {
+			return nil
+		}
+		return fault.Wrap(ErrNotCreator, fctx.With(ctx))
+	}, rbac.PermissionAdministrator)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accs, err := m.querier.ListInvited(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return accs, nil
+}
+
+// Redeemable resolves the invitation a new member is registering with, if
+// any, and rejects the registration if the invitation can't be used or if
+// registration is invitation-only and none was given.
+func (m *Manager) Redeemable(ctx context.Context, code opt.Optional[xid.ID]) (opt.Optional[invitation.Invitation], error) {
+	id, ok := code.Get()
+	if !ok {
+		s, err := m.settings.Get(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if s.Invitations.OrZero().Required {
+			return nil, fault.Wrap(ErrInvitationRequired, fctx.With(ctx),
+				fmsg.WithDesc("invitation required", "Registration is by invitation only."))
+		}
+
+		return opt.NewEmpty[invitation.Invitation](), nil
+	}
+
+	inv, err := m.querier.GetByID(ctx, id)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return nil, fault.Wrap(ErrUnusable, fctx.With(ctx),
+				fmsg.WithDesc("not found", "This invitation does not exist."))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !inv.Usable(time.Now()) {
+		return nil, fault.Wrap(ErrUnusable, fctx.With(ctx),
+			fmsg.WithDesc("unusable", "This invitation has expired or has already been used up."))
+	}
+
+	return opt.New(*inv), nil
+}
+
+// Redeem grants the new member whatever role the invitation carries.
+func (m *Manager) Redeem(ctx context.Context, accountID account.AccountID, inv invitation.Invitation) error {
+	id, ok := inv.Role.Get()
+	if !ok {
+		return nil
+	}
+
+	if _, err := m.roleAssign.UpdateRoles(ctx, accountID, role_assign.Add(id)); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// canGrant ensures only members who can manage roles attach one to an
+// invitation, and only administrators may hand out the administrator role.
+func (m *Manager) canGrant(ctx context.Context, perms rbac.Permissions, id role.RoleID) error {
+	if !perms.HasAny(rbac.PermissionAdministrator, rbac.PermissionManageRoles) {
+		return fault.Wrap(ErrCannotGrantRole, fctx.With(ctx),
+			fmsg.WithDesc("cannot grant roles", "You do not have permission to grant roles with an invitation."))
+	}
+
+	if id == role.DefaultRoleAdminID && !perms.HasAny(rbac.PermissionAdministrator) {
+		return fault.Wrap(ErrCannotGrantRole, fctx.With(ctx),
+			fmsg.WithDesc("cannot grant admin", "Only administrators may invite other administrators."))
+	}
+
+	if id == role.DefaultRoleMemberID || id == role.DefaultRoleGuestID || id == role.DefaultRoleBotID {
+		return fault.Wrap(ErrInvalid, fctx.With(ctx),
+			fmsg.WithDesc("default role", "Every member already has the default roles."))
+	}
+
+	if _, err := m.roleQuerier.Get(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) checkQuota(ctx context.Context, accountID account.AccountID) error {
+	s, err := m.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	quota := s.Invitations.OrZero().Quota
+	if quota <= 0 {
+		return nil
+	}
+
+	invs, err := m.querier.List(ctx, invitation_querier.WithCreator(accountID))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	now := time.Now()
+	usable := dt.Filter(invs, func(i *invitation.Invitation) bool { return i.Usable(now) })
+
+	if len(usable) >= quota {
+		return fault.Wrap(ErrQuotaReached, fctx.With(ctx),
+			fmsg.WithDesc("quota reached", fmt.Sprintf("You can only have %d unused invitations at a time.", quota)))
+	}
+
+	return nil
+}
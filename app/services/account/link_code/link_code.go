@@ -0,0 +1,87 @@
+// Package link_code issues the short-lived codes members use to prove which
+// Storyden account a chat identity, such as a Matrix or Telegram user, belongs
+// to. A code identifies the account and when it was issued, only the holder of
+// the secret can create a valid one.
+package link_code
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+)
+
+var ErrInvalid = fault.New("invalid link code", ftag.With(ftag.InvalidArgument))
+
+const (
+	Lifetime = 15 * time.Minute
+	macSize  = 10
+)
+
+// Lowercase letters and digits only, so codes fit in a Telegram start
+// parameter and can be typed on a phone.
+var encoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+type Codes struct {
+	key   []byte
+	label string
+}
+
+// New creates codes signed with the key. The label keeps each integration's
+// codes distinct so a code for one can't be used with another.
+func New(key []byte, label string) Codes {
+	return Codes{key: key, label: label}
+}
+
+// Issue returns a new code for the account and when it stops being valid.
+func (c Codes) Issue(accountID account.AccountID, now time.Time) (string, time.Time) {
+	ts := uint32(now.Unix())
+
+	b := make([]byte, 0, 16+macSize)
+	b = append(b, xid.ID(accountID).Bytes()...)
+	b = binary.BigEndian.AppendUint32(b, ts)
+	b = append(b, c.mac(accountID, ts)...)
+
+	return encoding.EncodeToString(b), time.Unix(int64(ts), 0).Add(Lifetime)
+}
+
+// Decode returns the account a code was issued for and when it was issued.
+func (c Codes) Decode(code string, now time.Time) (account.AccountID, time.Time, error) {
+	b, err := encoding.DecodeString(strings.ToLower(strings.TrimSpace(code)))
+	if err != nil || len(b) != 16+macSize {
+		return account.AccountID{}, time.Time{}, ErrInvalid
+	}
+
+	accountID, err := xid.FromBytes(b[:12])
+	if err != nil {
+		return account.AccountID{}, time.Time{}, ErrInvalid
+	}
+
+	ts := binary.BigEndian.Uint32(b[12:16])
+	if !hmac.Equal(b[16:], c.mac(account.AccountID(accountID), ts)) {
+		return account.AccountID{}, time.Time{}, ErrInvalid
+	}
+
+	issued := time.Unix(int64(ts), 0)
+	if now.Sub(issued) > Lifetime {
+		return account.AccountID{}, time.Time{}, ErrInvalid
+	}
+
+	return account.AccountID(accountID), issued, nil
+}
+
+func (c Codes) mac(accountID account.AccountID, issued uint32) []byte {
+	h := hmac.New(sha256.New, c.key)
+	h.Write([]byte(c.label))
+	h.Write(xid.ID(accountID).Bytes())
+	h.Write(binary.BigEndian.AppendUint32(nil, issued))
+	return h.Sum(nil)[:macSize]
+}
@@ -0,0 +1,56 @@
+package link_code
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+)
+
+func TestCodes(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	c := New([]byte("00000000000000000000000000000000"), "test:")
+	accountID := account.AccountID(xid.New())
+	now := time.Now()
+
+	code, expires := c.Issue(accountID, now)
+	a.LessOrEqual(len(code), 64)
+	a.WithinDuration(now.Add(Lifetime), expires, time.Second)
+
+	got, issued, err := c.Decode(code, now)
+	r.NoError(err)
+	a.Equal(accountID, got)
+	a.Equal(now.Unix(), issued.Unix())
+
+	t.Run("case_insensitive", func(t *testing.T) {
+		_, _, err := c.Decode(" "+strings.ToUpper(code)+" ", now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		_, _, err := c.Decode(code, now.Add(Lifetime+time.Minute))
+		assert.Error(t, err)
+	})
+
+	t.Run("other_label", func(t *testing.T) {
+		_, _, err := New([]byte("00000000000000000000000000000000"), "other:").Decode(code, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("other_secret", func(t *testing.T) {
+		_, _, err := New([]byte("11111111111111111111111111111111"), "test:").Decode(code, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		_, _, err := c.Decode("link", now)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,146 @@
+// Package onboarding_tracker marks steps of the onboarding checklist as done
+// when members do the corresponding thing anywhere in the product.
+package onboarding_tracker
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/onboarding_checklist"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(func(lc fx.Lifecycle, bus *pubsub.Bus, t *Tracker, rq *reputation_querier.Querier) {
+			lc.Append(fx.StartHook(func(hctx context.Context) error {
+				if _, err := pubsub.Subscribe(hctx, bus, "onboarding.email_verified", func(ctx context.Context, evt *message.EventAccountEmailVerified) error {
+					return t.complete(ctx, evt.ID, onboarding_checklist.StepVerifyEmail)
+				}); err != nil {
+					return err
+				}
+
+				if _, err := pubsub.Subscribe(hctx, bus, "onboarding.avatar_updated", func(ctx context.Context, evt *message.EventAccountAvatarUpdated) error {
+					return t.complete(ctx, evt.ID, onboarding_checklist.StepSetAvatar)
+				}); err != nil {
+					return err
+				}
+
+				if _, err := pubsub.Subscribe(hctx, bus, "onboarding.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+					author, err := rq.PostAuthor(ctx, evt.ID)
+					if err != nil {
+						return fault.Wrap(err, fctx.With(ctx))
+					}
+
+					return t.complete(ctx, author, onboarding_checklist.StepFirstPost)
+				}); err != nil {
+					return err
+				}
+
+				if _, err := pubsub.Subscribe(hctx, bus, "onboarding.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+					return t.complete(ctx, evt.ReplyAuthorID, onboarding_checklist.StepFirstPost)
+				}); err != nil {
+					return err
+				}
+
+				if _, err := pubsub.Subscribe(hctx, bus, "onboarding.category_followed", func(ctx context.Context, evt *message.EventCategoryFollowed) error {
+					return t.complete(ctx, evt.AccountID, onboarding_checklist.StepJoinCategory)
+				}); err != nil {
+					return err
+				}
+
+				return nil
+			}))
+		}),
+	)
+}
+
+type Tracker struct {
+	settings *settings.SettingsRepository
+	repo     *onboarding_checklist.Repository
+	bus      *pubsub.Bus
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	repo *onboarding_checklist.Repository,
+	bus *pubsub.Bus,
+) *Tracker {
+	return &Tracker{
+		settings: settings,
+		repo:     repo,
+		bus:      bus,
+	}
+}
+
+// Own returns the session account's progress through the checklist.
+func (t *Tracker) Own(ctx context.Context) (*onboarding_checklist.Checklist, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	cfg, err := t.config(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	completed, err := t.repo.Completed(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	checklist := onboarding_checklist.Build(cfg, completed)
+
+	return &checklist, nil
+}
+
+func (t *Tracker) Funnel(ctx context.Context) (*onboarding_checklist.Funnel, error) {
+	cfg, err := t.config(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	funnel, err := t.repo.Funnel(ctx, cfg.Steps)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return funnel, nil
+}
+
+func (t *Tracker) config(ctx context.Context) (onboarding_checklist.Settings, error) {
+	s, err := t.settings.Get(ctx)
+	if err != nil {
+		return onboarding_checklist.Settings{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.OnboardingChecklist.Or(onboarding_checklist.DefaultSettings), nil
+}
+
+// complete records the step even when it's not currently on the checklist so
+// progress isn't lost if an administrator adds the step later on.
+func (t *Tracker) complete(ctx context.Context, accountID account.AccountID, step onboarding_checklist.Step) error {
+	first, err := t.repo.Complete(ctx, accountID, step)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if first {
+		t.bus.Publish(ctx, &message.EventOnboardingStepCompleted{
+			AccountID: accountID,
+			Step:      step,
+		})
+	}
+
+	return nil
+}
@@ -6,12 +6,15 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/semdex/index_job"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
@@ -26,7 +29,6 @@ type semdexer struct {
 	logger        *slog.Logger
 	profileQuery  *profile_querier.Querier
 	semdexMutator semdex.Mutator
-	bus           *pubsub.Bus
 }
 
 func newProfileSemdexer(
@@ -37,6 +39,7 @@ func newProfileSemdexer(
 	profileQuery *profile_querier.Querier,
 	semdexMutator semdex.Mutator,
 	bus *pubsub.Bus,
+	queue *index_job.Queuer,
 ) {
 	if cfg.SemdexProvider == "" {
 		return
@@ -46,19 +49,18 @@ func newProfileSemdexer(
 		logger:        logger,
 		profileQuery:  profileQuery,
 		semdexMutator: semdexMutator,
-		bus:           bus,
 	}
 
 	lc.Append(fx.StartHook(func(hctx context.Context) error {
 		_, err := pubsub.Subscribe(hctx, bus, "profile_semdex.index_created", func(ctx context.Context, evt *message.EventAccountCreated) error {
-			return bus.SendCommand(ctx, &message.CommandProfileIndex{ID: evt.ID})
+			return queue.Index(ctx, datagraph.KindProfile, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "profile_semdex.index_updated", func(ctx context.Context, evt *message.EventAccountUpdated) error {
-			return bus.SendCommand(ctx, &message.CommandProfileIndex{ID: evt.ID})
+			return queue.Index(ctx, datagraph.KindProfile, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
@@ -67,16 +69,10 @@ func newProfileSemdexer(
 		return nil
 	}))
 
-	lc.Append(fx.StartHook(func(hctx context.Context) error {
-		_, err := pubsub.SubscribeCommand(hctx, bus, "profile_semdex.index", func(ctx context.Context, cmd *message.CommandProfileIndex) error {
-			return s.indexProfile(ctx, cmd.ID)
-		})
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}))
+	queue.Handle(datagraph.KindProfile,
+		func(ctx context.Context, id xid.ID) error { return s.indexProfile(ctx, account.AccountID(id)) },
+		nil,
+	)
 }
 
 func (s *semdexer) indexProfile(ctx context.Context, id account.AccountID) error {
@@ -0,0 +1,119 @@
+// Package reading_tracker keeps members' reading history up to date from the
+// beacons their clients send and lets them review, remove and clear it.
+package reading_tracker
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/reading_history"
+)
+
+// MaxPositionLength bounds the opaque position marker clients may store.
+const MaxPositionLength = 128
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Tracker struct {
+	querier  *reading_history.Querier
+	writer   *reading_history.Writer
+	hydrator *hydrate.Hydrator
+}
+
+func New(
+	querier *reading_history.Querier,
+	writer *reading_history.Writer,
+	hydrator *hydrate.Hydrator,
+) *Tracker {
+	return &Tracker{
+		querier:  querier,
+		writer:   writer,
+		hydrator: hydrator,
+	}
+}
+
+// Record handles a beacon for a thread or library page. Nothing is stored for
+// members who have opted out or for items they aren't allowed to see.
+func (t *Tracker) Record(ctx context.Context, cmd *message.CommandSendBeacon) error {
+	accountID, ok := cmd.Subject.Get()
+	if !ok {
+		return nil
+	}
+
+	switch cmd.Item.Kind {
+	case datagraph.KindThread, datagraph.KindNode:
+	default:
+		return nil
+	}
+
+	enabled, err := t.querier.Enabled(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !enabled {
+		return nil
+	}
+
+	items, err := t.hydrator.Hydrate(ctx, &cmd.Item)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if len(items) == 0 || !hydrate.VisibleTo(items[0], accountID) {
+		return nil
+	}
+
+	position := opt.NewSafe(cmd.Position, cmd.Position != "" && len(cmd.Position) <= MaxPositionLength)
+
+	if err := t.writer.Record(ctx, accountID, cmd.Item, position); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (t *Tracker) List(ctx context.Context, accountID account.AccountID, page pagination.Parameters, kinds ...datagraph.Kind) (pagination.Result[*reading_history.Entry], error) {
+	result, err := t.querier.List(ctx, accountID, page, reading_history.WithKind(kinds...))
+	if err != nil {
+		return pagination.Result[*reading_history.Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return result, nil
+}
+
+// Get returns where the member left off in an item, for continuing reading.
+func (t *Tracker) Get(ctx context.Context, accountID account.AccountID, itemID xid.ID) (*reading_history.Entry, error) {
+	e, err := t.querier.Get(ctx, accountID, itemID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return e, nil
+}
+
+func (t *Tracker) Remove(ctx context.Context, accountID account.AccountID, itemID xid.ID) error {
+	if err := t.writer.Remove(ctx, accountID, itemID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (t *Tracker) Clear(ctx context.Context, accountID account.AccountID) error {
+	if err := t.writer.Clear(ctx, accountID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
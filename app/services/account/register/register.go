@@ -10,6 +10,7 @@ import (
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/opt"
 	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/rs/xid"
 	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/app/resources/account"
@@ -17,10 +18,14 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/account/invitation"
 	"github.com/Southclaws/storyden/app/resources/mark"
 	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/account/application_manager"
+	"github.com/Southclaws/storyden/app/services/account/invitation_manager"
 	"github.com/Southclaws/storyden/app/services/authentication/email_verify"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/moderation/netban_guard"
 	"github.com/Southclaws/storyden/app/services/onboarding"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 	"github.com/Southclaws/storyden/internal/otp"
@@ -41,6 +46,9 @@ type Registrar struct {
 	emailVerify    *email_verify.Verifier
 	authRepo       authentication.Repository
 	onboarding     onboarding.Service
+	netbans        *netban_guard.Guard
+	invitations    *invitation_manager.Manager
+	applications   *application_manager.Manager
 	bus            *pubsub.Bus
 }
 
@@ -52,6 +60,9 @@ func New(
 	emailVerify *email_verify.Verifier,
 	authRepo authentication.Repository,
 	onboarding onboarding.Service,
+	netbans *netban_guard.Guard,
+	invitations *invitation_manager.Manager,
+	applications *application_manager.Manager,
 	bus *pubsub.Bus,
 ) *Registrar {
 	return &Registrar{
@@ -62,11 +73,20 @@ func New(
 		emailVerify:    emailVerify,
 		authRepo:       authRepo,
 		onboarding:     onboarding,
+		netbans:        netbans,
+		invitations:    invitations,
+		applications:   applications,
 		bus:            bus,
 	}
 }
 
-func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], opts ...account_writer.Option) (*account.Account, error) {
+// Create registers a new account, redeeming the invitation it was created with
+// if there is one. The first account never needs an invitation.
+func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], inviteCode opt.Optional[xid.ID], opts ...account_writer.Option) (*account.Account, error) {
+	if err := s.netbans.CheckRegistration(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	status, err := s.onboarding.GetOnboardingStatus(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err,
@@ -74,11 +94,21 @@ func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], opt
 			fmsg.WithDesc("failed to check onboarding status", "Unable to verify system setup. Please try again or contact site administration."))
 	}
 
-	if status == &onboarding.StatusRequiresFirstAccount {
+	inv := opt.NewEmpty[invitation.Invitation]()
+	first := status == &onboarding.StatusRequiresFirstAccount
+
+	if first {
 		// If we're doing first-time-setup then set the first account to admin.
 		opts = append(opts, account_writer.WithAdmin(true))
+	} else {
+		inv, err = s.invitations.Redeemable(ctx, inviteCode)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
 	}
 
+	inv.Call(func(i invitation.Invitation) { opts = append(opts, account_writer.WithInvitedBy(i.ID)) })
+
 	// If no handle was given, generate one using adjective-animal.
 	handleOrGenerated := handle.Or(petname.Generate(2, "-"))
 
@@ -93,6 +123,18 @@ func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], opt
 			fmsg.WithDesc("failed to create account", "Unable to create your account."))
 	}
 
+	if i, ok := inv.Get(); ok {
+		if err := s.invitations.Redeem(ctx, acc.Account.ID, i); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if !first {
+		if err := s.applications.Open(ctx, acc.Account.ID); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	s.bus.Publish(ctx, &message.EventAccountCreated{
 		ID: acc.Account.ID,
 	})
@@ -243,6 +285,10 @@ func (s *Registrar) GetOrCreateViaEmail(
 	case !authMethodExists && !emailExists:
 		// Nothing exists for this member yet, create a new account.
 
+		if err := s.emailVerify.CheckDomain(ctx, email); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
 		newAccount, err := s.CreateWithHandle(ctx, service, authName, identifier, token, name, handle)
 		if err != nil {
 			return nil, fault.Wrap(err, fmsg.With("failed to create new account"), fctx.With(ctx))
@@ -405,7 +451,7 @@ func (s *Registrar) CreateWithRandomHandle(
 
 	randomHandle := petname.Generate(3, "-")
 
-	newAccount, err := s.Create(ctx, opt.New(randomHandle),
+	newAccount, err := s.Create(ctx, opt.New(randomHandle), opt.NewEmpty[xid.ID](),
 		account_writer.WithName(name))
 	if err != nil {
 		return nil, fault.Wrap(err, fmsg.With("failed to create new account"), fctx.With(ctx))
@@ -441,7 +487,7 @@ func (s *Registrar) CreateWithHandle(
 		)
 	}
 
-	newAccount, err := s.Create(ctx, opt.New(handle),
+	newAccount, err := s.Create(ctx, opt.New(handle), opt.NewEmpty[xid.ID](),
 		account_writer.WithName(name))
 	if err != nil {
 		return nil, fault.Wrap(err, fmsg.With("failed to create new account"), fctx.With(ctx))
@@ -0,0 +1,178 @@
+// Package verification_manager lets administrators give accounts verification
+// badges, either directly or by reviewing requests from members.
+package verification_manager
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/verification"
+	"github.com/Southclaws/storyden/app/resources/account/verification/verification_querier"
+	"github.com/Southclaws/storyden/app/resources/account/verification/verification_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrNotRequestable  = fault.New("verification badge cannot be requested", ftag.With(ftag.InvalidArgument))
+	ErrAlreadyVerified = fault.New("account already has this verification badge", ftag.With(ftag.InvalidArgument))
+	ErrAlreadyPending  = fault.New("verification request already pending", ftag.With(ftag.AlreadyExists))
+	ErrAlreadyReviewed = fault.New("verification request already reviewed", ftag.With(ftag.InvalidArgument))
+)
+
+type Manager struct {
+	accountQuerier *account_querier.Querier
+	accountWriter  *account_writer.Writer
+	querier        *verification_querier.Querier
+	writer         *verification_writer.Writer
+	bus            *pubsub.Bus
+}
+
+func New(
+	accountQuerier *account_querier.Querier,
+	accountWriter *account_writer.Writer,
+	querier *verification_querier.Querier,
+	writer *verification_writer.Writer,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		accountQuerier: accountQuerier,
+		accountWriter:  accountWriter,
+		querier:        querier,
+		writer:         writer,
+		bus:            bus,
+	}
+}
+
+// Request asks staff to give the session's account a verification badge. Only
+// one request may be waiting for review at a time.
+func (m *Manager) Request(ctx context.Context, badge account.Verification, note opt.Optional[string]) (*verification.Request, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !badge.Requestable() {
+		return nil, fault.Wrap(ErrNotRequestable, fctx.With(ctx),
+			fmsg.WithDesc("not requestable", "Staff and bot badges are only given out by administrators."))
+	}
+
+	acc, err := m.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if acc.Verification.OrZero() == badge {
+		return nil, fault.Wrap(ErrAlreadyVerified, fctx.With(ctx),
+			fmsg.WithDesc("already verified", "Your account already has this badge."))
+	}
+
+	pending, err := m.querier.HasPending(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if pending {
+		return nil, fault.Wrap(ErrAlreadyPending, fctx.With(ctx),
+			fmsg.WithDesc("pending", "You already have a verification request waiting to be reviewed."))
+	}
+
+	req, err := m.writer.Create(ctx, accountID, badge, note)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return req, nil
+}
+
+// Own returns the session account's most recent request.
+func (m *Manager) Own(ctx context.Context) (*verification.Request, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := m.querier.GetLatest(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return req, nil
+}
+
+func (m *Manager) List(ctx context.Context, status opt.Optional[verification.Status]) ([]*verification.Request, error) {
+	reqs, err := m.querier.List(ctx, status)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return reqs, nil
+}
+
+// Approve gives the member the badge they asked for, replacing any other.
+func (m *Manager) Approve(ctx context.Context, id verification.RequestID) (*verification.Request, error) {
+	req, err := m.review(ctx, id, verification.StatusApproved, opt.NewEmpty[string]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.Set(ctx, req.Account.ID, opt.New(req.Badge)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return req, nil
+}
+
+func (m *Manager) Reject(ctx context.Context, id verification.RequestID, reason opt.Optional[string]) (*verification.Request, error) {
+	req, err := m.review(ctx, id, verification.StatusRejected, reason)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return req, nil
+}
+
+// Set gives the account a badge directly, or removes it if empty.
+func (m *Manager) Set(ctx context.Context, accountID account.AccountID, badge opt.Optional[account.Verification]) error {
+	if _, err := m.accountWriter.Update(ctx, accountID, account_writer.SetVerification(badge)); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventAccountUpdated{
+		ID: accountID,
+	})
+
+	return nil
+}
+
+func (m *Manager) review(ctx context.Context, id verification.RequestID, status verification.Status, reason opt.Optional[string]) (*verification.Request, error) {
+	reviewer, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := m.querier.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if req.Status != verification.StatusPending {
+		return nil, fault.Wrap(ErrAlreadyReviewed, fctx.With(ctx),
+			fmsg.WithDesc("reviewed", "This verification request has already been reviewed."))
+	}
+
+	req, err = m.writer.Review(ctx, id, status, reviewer, reason)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return req, nil
+}
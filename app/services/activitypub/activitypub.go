@@ -0,0 +1,89 @@
+// Package activitypub federates published threads and library pages so that
+// they can be followed and boosted from Mastodon and other fediverse software.
+//
+// Each member is a Person actor whose outbox contains the threads they write,
+// and the instance itself is a Service actor which announces everything that
+// is published. Remote content is not displayed, only follows are handled.
+package activitypub
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/app/resources/federation/federation_follower"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(NewDirectory, NewDelivery, NewInbox),
+		fx.Invoke(runPublisher),
+	)
+}
+
+func runPublisher(
+	lc fx.Lifecycle,
+	cfg config.Config,
+	bus *pubsub.Bus,
+	directory *Directory,
+	delivery *Delivery,
+	followers *federation_follower.Repository,
+) {
+	if !cfg.ActivityPubEnabled {
+		return
+	}
+
+	publish := func(ctx context.Context, o *Object) error {
+		author, ok := directory.ParseActorURI(o.AttributedTo)
+		if !ok {
+			return nil
+		}
+
+		authorInboxes, err := followers.Inboxes(ctx, author)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		instanceInboxes, err := followers.Inboxes(ctx, federation.InstanceActor)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		delivery.Broadcast(ctx, author, authorInboxes, directory.Create(o))
+		delivery.Broadcast(ctx, federation.InstanceActor, instanceInboxes, directory.Announce(o))
+
+		return nil
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if _, err := pubsub.Subscribe(hctx, bus, "activitypub.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			o, err := directory.Thread(ctx, evt.ID)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return publish(ctx, o)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "activitypub.node_published", func(ctx context.Context, evt *message.EventNodePublished) error {
+			o, err := directory.Node(ctx, evt.ID)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return publish(ctx, o)
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	}))
+}
@@ -0,0 +1,172 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/app/resources/federation/federation_key"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpsig"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	maxActorSize   = 1 << 20
+)
+
+var errInsecureURL = fault.New("remote actor and inbox URLs must use https", ftag.With(ftag.InvalidArgument))
+
+type Delivery struct {
+	logger    *slog.Logger
+	directory *Directory
+	keys      *federation_key.Repository
+	client    *http.Client
+}
+
+func NewDelivery(
+	logger *slog.Logger,
+	directory *Directory,
+	keys *federation_key.Repository,
+	policy *safehttp.Policy,
+) *Delivery {
+	return &Delivery{
+		logger:    logger,
+		directory: directory,
+		keys:      keys,
+		client:    policy.Client(requestTimeout),
+	}
+}
+
+// requireHTTPS rejects remote URLs which aren't https. Actor and inbox URLs
+// come from unauthenticated requests and remote documents, so anything else is
+// refused before a request is made.
+func requireHTTPS(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return errInsecureURL
+	}
+	return nil
+}
+
+// Send posts an activity to a remote inbox, signed with the sending actor's
+// key. Remote servers reject unsigned deliveries.
+func (d *Delivery) Send(ctx context.Context, from federation.LocalActor, inbox string, activity *Activity) error {
+	if err := requireHTTPS(inbox); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	activity.Context = ContextActivityStreams
+
+	body, err := json.Marshal(activity)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, inbox, bytes.NewReader(body))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Content-Type", ContentType)
+
+	if err := d.sign(ctx, req, from, body); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fault.Newf("inbox %s responded with %d", inbox, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Broadcast delivers an activity to every follower of the actor, failures are
+// logged and do not prevent delivery to the remaining inboxes.
+func (d *Delivery) Broadcast(ctx context.Context, from federation.LocalActor, inboxes []string, activity *Activity) {
+	for _, inbox := range inboxes {
+		if err := d.Send(ctx, from, inbox, activity); err != nil {
+			d.logger.Warn("failed to deliver activity",
+				slog.String("activity", activity.ID),
+				slog.String("inbox", inbox),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+// FetchActor retrieves a remote actor document. The request is signed by the
+// instance actor for servers which require authorised fetches.
+func (d *Delivery) FetchActor(ctx context.Context, uri string) (*remoteActor, error) {
+	if err := requireHTTPS(uri); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+	req.Header.Set("Accept", ContentType)
+
+	if err := d.sign(ctx, req, federation.InstanceActor, nil); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fault.Newf("actor %s responded with %d", uri, resp.StatusCode)
+	}
+
+	var actor remoteActor
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxActorSize)).Decode(&actor); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if actor.Inbox == "" || actor.PublicKey == nil {
+		return nil, fault.Newf("actor %s has no inbox or public key", uri)
+	}
+
+	if actor.ID != uri {
+		return nil, fault.Newf("actor %s identifies itself as %s", uri, actor.ID)
+	}
+
+	if err := requireHTTPS(actor.Inbox); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if actor.Endpoints != nil && actor.Endpoints.SharedInbox != "" {
+		if err := requireHTTPS(actor.Endpoints.SharedInbox); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return &actor, nil
+}
+
+func (d *Delivery) sign(ctx context.Context, req *http.Request, from federation.LocalActor, body []byte) error {
+	key, err := d.keys.Get(ctx, from)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return httpsig.Sign(req, d.directory.KeyID(from), key.Private, body)
+}
@@ -0,0 +1,30 @@
+package activitypub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
+)
+
+func TestDeliveryRequiresHTTPS(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+
+	d := NewDelivery(nil, nil, nil, &safehttp.Policy{})
+
+	for _, uri := range []string{
+		"http://169.254.169.254/latest/meta-data",
+		"file:///etc/passwd",
+		"//example.com/actor",
+	} {
+		_, err := d.FetchActor(ctx, uri)
+		a.ErrorIs(err, errInsecureURL, uri)
+
+		err = d.Send(ctx, federation.InstanceActor, uri, &Activity{Type: "Accept"})
+		a.ErrorIs(err, errInsecureURL, uri)
+	}
+}
@@ -0,0 +1,409 @@
+package activitypub
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/app/resources/federation/federation_follower"
+	"github.com/Southclaws/storyden/app/resources/federation/federation_key"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const outboxSize = 20
+
+var errNotFederated = fault.New("not available over ActivityPub", ftag.With(ftag.NotFound))
+
+// Directory builds the ActivityPub representation of local actors and the
+// content they publish. Every URI is rooted at the public API address.
+type Directory struct {
+	apiAddress     url.URL
+	webAddress     url.URL
+	settings       *settings.SettingsRepository
+	accountQuerier *account_querier.Querier
+	roleQuerier    *role_querier.Querier
+	profileQuerier *profile_querier.Querier
+	threadQuerier  *thread_querier.Querier
+	nodeQuerier    *node_querier.Querier
+	keys           *federation_key.Repository
+	followers      *federation_follower.Repository
+}
+
+func NewDirectory(
+	cfg config.Config,
+	settings *settings.SettingsRepository,
+	accountQuerier *account_querier.Querier,
+	roleQuerier *role_querier.Querier,
+	profileQuerier *profile_querier.Querier,
+	threadQuerier *thread_querier.Querier,
+	nodeQuerier *node_querier.Querier,
+	keys *federation_key.Repository,
+	followers *federation_follower.Repository,
+) *Directory {
+	return &Directory{
+		apiAddress:     cfg.PublicAPIAddress,
+		webAddress:     cfg.PublicWebAddress,
+		settings:       settings,
+		accountQuerier: accountQuerier,
+		roleQuerier:    roleQuerier,
+		profileQuerier: profileQuerier,
+		threadQuerier:  threadQuerier,
+		nodeQuerier:    nodeQuerier,
+		keys:           keys,
+		followers:      followers,
+	}
+}
+
+// Domain is the host used in WebFinger "acct:" addresses.
+func (d *Directory) Domain() string {
+	return d.apiAddress.Host
+}
+
+func (d *Directory) ActorURI(a federation.LocalActor) string {
+	if id, ok := a.AccountID().Get(); ok {
+		return d.apiAddress.JoinPath("ap", "users", id.String()).String()
+	}
+	return d.apiAddress.JoinPath("ap", "instance").String()
+}
+
+func (d *Directory) KeyID(a federation.LocalActor) string {
+	return d.ActorURI(a) + "#main-key"
+}
+
+func (d *Directory) SharedInbox() string {
+	return d.apiAddress.JoinPath("ap", "inbox").String()
+}
+
+func (d *Directory) ThreadURI(id post.ID) string {
+	return d.apiAddress.JoinPath("ap", "threads", id.String()).String()
+}
+
+func (d *Directory) NodeURI(id library.NodeID) string {
+	return d.apiAddress.JoinPath("ap", "nodes", id.String()).String()
+}
+
+// ParseActorURI is the inverse of ActorURI, used to identify the local actor
+// targeted by a remote activity.
+func (d *Directory) ParseActorURI(uri string) (federation.LocalActor, bool) {
+	if uri == d.ActorURI(federation.InstanceActor) {
+		return federation.InstanceActor, true
+	}
+
+	prefix := d.apiAddress.JoinPath("ap", "users").String() + "/"
+	raw, ok := strings.CutPrefix(uri, prefix)
+	if !ok {
+		return "", false
+	}
+
+	id, err := xid.FromString(raw)
+	if err != nil {
+		return "", false
+	}
+
+	return federation.AccountActor(account.AccountID(id)), true
+}
+
+// Resolve finds the local actor for a WebFinger resource, either an "acct:"
+// address or an actor URI. The instance actor's username is the domain.
+func (d *Directory) Resolve(ctx context.Context, resource string) (federation.LocalActor, error) {
+	if a, ok := d.ParseActorURI(resource); ok {
+		return a, nil
+	}
+
+	acct, ok := strings.CutPrefix(resource, "acct:")
+	if !ok {
+		return "", fault.Wrap(errNotFederated, fctx.With(ctx))
+	}
+
+	username, domain, ok := strings.Cut(strings.TrimPrefix(acct, "@"), "@")
+	if !ok || !strings.EqualFold(domain, d.Domain()) {
+		return "", fault.Wrap(errNotFederated, fctx.With(ctx))
+	}
+
+	if strings.EqualFold(username, d.Domain()) {
+		return federation.InstanceActor, nil
+	}
+
+	acc, exists, err := d.accountQuerier.LookupByHandle(ctx, username)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+	if !exists || acc.DeletedAt.Ok() {
+		return "", fault.Wrap(errNotFederated, fctx.With(ctx))
+	}
+
+	return federation.AccountActor(acc.ID), nil
+}
+
+func (d *Directory) WebFinger(ctx context.Context, resource string) (*WebFinger, error) {
+	a, err := d.Resolve(ctx, resource)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	actor, err := d.Actor(ctx, a)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &WebFinger{
+		Subject: "acct:" + actor.PreferredUsername + "@" + d.Domain(),
+		Aliases: []string{actor.ID},
+		Links: []WebFingerLink{
+			{Rel: "self", Type: ContentType, Href: actor.ID},
+			{Rel: "http://webfinger.net/rel/profile-page", Type: "text/html", Href: actor.URL},
+		},
+	}, nil
+}
+
+func (d *Directory) Actor(ctx context.Context, a federation.LocalActor) (*Actor, error) {
+	key, err := d.keys.Get(ctx, a)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	uri := d.ActorURI(a)
+	actor := &Actor{
+		Context:      actorContext,
+		ID:           uri,
+		Inbox:        uri + "/inbox",
+		Outbox:       uri + "/outbox",
+		Followers:    uri + "/followers",
+		Endpoints:    &Endpoints{SharedInbox: d.SharedInbox()},
+		PublicKey:    &PublicKey{ID: d.KeyID(a), Owner: uri, PublicKeyPem: key.PublicPEM},
+		Discoverable: true,
+	}
+
+	if id, ok := a.AccountID().Get(); ok {
+		p, err := d.profileQuerier.GetByID(ctx, id)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if p.Deleted.Ok() {
+			return nil, fault.Wrap(errNotFederated, fctx.With(ctx))
+		}
+
+		actor.Type = "Person"
+		actor.PreferredUsername = p.Handle
+		actor.Name = p.Name
+		actor.Summary = p.Bio.HTML()
+		actor.URL = d.webAddress.JoinPath("m", p.Handle).String()
+		actor.Published = &p.Created
+
+		return actor, nil
+	}
+
+	s, err := d.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	actor.Type = "Service"
+	actor.PreferredUsername = d.Domain()
+	actor.Name = s.Title.Or("Storyden")
+	actor.Summary = s.Description.OrZero()
+	actor.URL = d.webAddress.String()
+
+	return actor, nil
+}
+
+// Thread returns the object for a published thread, threads are represented
+// as notes so their full content is shown by microblogging software.
+func (d *Directory) Thread(ctx context.Context, id post.ID) (*Object, error) {
+	thr, err := d.threadQuerier.Get(ctx, id, pagination.Parameters{}, nil)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if thr.Visibility != visibility.VisibilityPublished || thr.DeletedAt.Ok() {
+		return nil, fault.Wrap(errNotFederated, fctx.With(ctx))
+	}
+
+	public, err := d.publicFilters(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ok, err := d.threadQuerier.Matches(ctx, id, public...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		return nil, fault.Wrap(errNotFederated, fctx.With(ctx))
+	}
+
+	return d.threadObject(thr), nil
+}
+
+// publicFilters restricts threads to those a guest can read, anything else is
+// not federated as remote servers make it visible to anyone.
+func (d *Directory) publicFilters(ctx context.Context) ([]thread_querier.Query, error) {
+	guest, err := d.roleQuerier.GetGuestRole(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return []thread_querier.Query{
+		thread_querier.IsInReadableCategory(role.Roles{guest}),
+		thread_querier.IsReadableBy(opt.NewEmpty[account.AccountID]()),
+	}, nil
+}
+
+func (d *Directory) threadObject(thr *thread.Thread) *Object {
+	author := d.ActorURI(federation.AccountActor(thr.Author.ID))
+	link := d.webAddress.JoinPath("t", mark.NewMark(xid.ID(thr.ID), thr.Slug).String()).String()
+
+	body := thr.Content.HTML()
+	if thr.Content.IsEmpty() {
+		body = "<p>" + thr.Short + "</p>"
+	}
+
+	return &Object{
+		ID:           d.ThreadURI(thr.ID),
+		Type:         "Note",
+		AttributedTo: author,
+		Name:         thr.Title,
+		Content:      "<p><strong>" + escape(thr.Title) + "</strong></p>" + body + `<p><a href="` + escape(link) + `">` + escape(link) + "</a></p>",
+		URL:          link,
+		Published:    thr.CreatedAt,
+		To:           []string{publicCollection},
+		Cc:           []string{author + "/followers"},
+	}
+}
+
+// Node returns the object for a published library page, pages are articles.
+func (d *Directory) Node(ctx context.Context, id library.NodeID) (*Object, error) {
+	n, err := d.nodeQuerier.Probe(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if n.Visibility != visibility.VisibilityPublished {
+		return nil, fault.Wrap(errNotFederated, fctx.With(ctx))
+	}
+
+	author := d.ActorURI(federation.AccountActor(n.Owner.ID))
+	content := n.Content.OrZero()
+
+	return &Object{
+		ID:           d.NodeURI(library.NodeID(n.Mark.ID())),
+		Type:         "Article",
+		AttributedTo: author,
+		Name:         n.Name,
+		Summary:      n.Description.Or(content.Short()),
+		Content:      content.HTML(),
+		URL:          d.webAddress.JoinPath("l", n.Mark.Slug()).String(),
+		Published:    n.CreatedAt,
+		To:           []string{publicCollection},
+		Cc:           []string{author + "/followers"},
+	}, nil
+}
+
+// Create wraps an object authored by a member in the activity sent to their
+// followers and listed in their outbox.
+func (d *Directory) Create(o *Object) *Activity {
+	return &Activity{
+		ID:        o.ID + "#create",
+		Type:      "Create",
+		Actor:     o.AttributedTo,
+		Object:    o,
+		Published: o.Published,
+		To:        o.To,
+		Cc:        o.Cc,
+	}
+}
+
+// Announce is the instance actor boosting an object so that followers of the
+// instance see everything published on it.
+func (d *Directory) Announce(o *Object) *Activity {
+	instance := d.ActorURI(federation.InstanceActor)
+	return &Activity{
+		ID:        o.ID + "#announce",
+		Type:      "Announce",
+		Actor:     instance,
+		Object:    o.ID,
+		Published: o.Published,
+		To:        []string{publicCollection},
+		Cc:        []string{instance + "/followers", o.AttributedTo},
+	}
+}
+
+func (d *Directory) Outbox(ctx context.Context, a federation.LocalActor) (*OrderedCollection, error) {
+	public, err := d.publicFilters(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filters := append([]thread_querier.Query{
+		thread_querier.HasStatus(visibility.VisibilityPublished),
+		thread_querier.HasNotBeenDeleted(),
+	}, public...)
+
+	accountID, isMember := a.AccountID().Get()
+	if isMember {
+		filters = append(filters, thread_querier.HasAuthor(accountID))
+	}
+
+	result, err := d.threadQuerier.List(ctx, 0, outboxSize, opt.NewEmpty[account.AccountID](), filters...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items := dt.Map(result.Threads, func(t *thread.Thread) any {
+		o := d.threadObject(t)
+		if isMember {
+			return d.Create(o)
+		}
+		return d.Announce(o)
+	})
+
+	return &OrderedCollection{
+		Context:      ContextActivityStreams,
+		ID:           d.ActorURI(a) + "/outbox",
+		Type:         "OrderedCollection",
+		TotalItems:   result.Results,
+		OrderedItems: items,
+	}, nil
+}
+
+func (d *Directory) Followers(ctx context.Context, a federation.LocalActor) (*OrderedCollection, error) {
+	n, err := d.followers.Count(ctx, a)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &OrderedCollection{
+		Context:    ContextActivityStreams,
+		ID:         d.ActorURI(a) + "/followers",
+		Type:       "OrderedCollection",
+		TotalItems: n,
+	}, nil
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
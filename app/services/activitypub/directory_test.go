@@ -0,0 +1,56 @@
+package activitypub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/federation"
+)
+
+func TestActorURI(t *testing.T) {
+	a := assert.New(t)
+
+	api, _ := url.Parse("https://api.example.com")
+	d := &Directory{apiAddress: *api}
+
+	id := account.AccountID(xid.New())
+	member := federation.AccountActor(id)
+
+	a.Equal("https://api.example.com/ap/instance", d.ActorURI(federation.InstanceActor))
+	a.Equal("https://api.example.com/ap/users/"+id.String(), d.ActorURI(member))
+	a.Equal("https://api.example.com/ap/users/"+id.String()+"#main-key", d.KeyID(member))
+
+	parsed, ok := d.ParseActorURI(d.ActorURI(member))
+	a.True(ok)
+	a.Equal(member, parsed)
+
+	parsed, ok = d.ParseActorURI(d.ActorURI(federation.InstanceActor))
+	a.True(ok)
+	a.Equal(federation.InstanceActor, parsed)
+
+	_, ok = d.ParseActorURI("https://other.example.com/ap/users/" + id.String())
+	a.False(ok)
+
+	_, ok = d.ParseActorURI("https://api.example.com/ap/users/nope")
+	a.False(ok)
+
+	instance, err := d.Resolve(context.Background(), "acct:api.example.com@api.example.com")
+	a.NoError(err)
+	a.Equal(federation.InstanceActor, instance)
+
+	_, err = d.Resolve(context.Background(), "acct:someone@other.example.com")
+	a.Error(err)
+}
+
+func TestObjectID(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("https://a.example/1", objectID("https://a.example/1"))
+	a.Equal("https://a.example/2", objectID(map[string]any{"id": "https://a.example/2"}))
+	a.Equal("", objectID(nil))
+}
@@ -0,0 +1,150 @@
+package activitypub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/app/resources/federation/federation_follower"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpsig"
+)
+
+// Inbox accepts activities from remote servers. Only following is handled,
+// everything else is acknowledged and discarded since this instance does not
+// yet display remote content.
+type Inbox struct {
+	directory *Directory
+	delivery  *Delivery
+	followers *federation_follower.Repository
+}
+
+func NewInbox(
+	directory *Directory,
+	delivery *Delivery,
+	followers *federation_follower.Repository,
+) *Inbox {
+	return &Inbox{
+		directory: directory,
+		delivery:  delivery,
+		followers: followers,
+	}
+}
+
+func (i *Inbox) Receive(ctx context.Context, r *http.Request, body []byte) error {
+	var activity inboundActivity
+	if err := json.Unmarshal(body, &activity); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	switch activity.Type {
+	case "Follow", "Undo":
+	default:
+		return nil
+	}
+
+	sender, err := i.verify(ctx, r, body, activity.Actor)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if activity.Type == "Follow" {
+		return i.follow(ctx, sender, activity)
+	}
+
+	undone, ok := activity.Object.(map[string]any)
+	if !ok || undone["type"] != "Follow" {
+		return nil
+	}
+
+	target, ok := i.directory.ParseActorURI(objectID(undone["object"]))
+	if !ok {
+		return nil
+	}
+
+	return i.followers.Remove(ctx, target, sender.ID)
+}
+
+// verify checks the request was signed by the actor named in the activity,
+// preventing one server from following or unfollowing on behalf of another.
+func (i *Inbox) verify(ctx context.Context, r *http.Request, body []byte, actorURI string) (*remoteActor, error) {
+	sig, err := httpsig.Parse(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	owner, _, _ := strings.Cut(sig.KeyID, "#")
+	if owner != actorURI {
+		return nil, fault.New("signature key does not belong to the activity actor", fctx.With(ctx), ftag.With(ftag.PermissionDenied))
+	}
+
+	sender, err := i.delivery.FetchActor(ctx, actorURI)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Unauthenticated))
+	}
+
+	pub, err := httpsig.ParsePublicKey(sender.PublicKey.PublicKeyPem)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := httpsig.Verify(r, sig, pub, body); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return sender, nil
+}
+
+func (i *Inbox) follow(ctx context.Context, sender *remoteActor, activity inboundActivity) error {
+	target, ok := i.directory.ParseActorURI(objectID(activity.Object))
+	if !ok {
+		return fault.Wrap(errNotFederated, fctx.With(ctx))
+	}
+
+	// Ensures the target exists and has not been deleted.
+	if _, err := i.directory.Actor(ctx, target); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	follower := federation.Follower{
+		LocalActor: target,
+		ActorURI:   sender.ID,
+		Inbox:      sender.Inbox,
+	}
+	if sender.Endpoints != nil && sender.Endpoints.SharedInbox != "" {
+		follower.SharedInbox = opt.New(sender.Endpoints.SharedInbox)
+	}
+
+	if err := i.followers.Add(ctx, follower); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	actorURI := i.directory.ActorURI(target)
+	accept := &Activity{
+		ID:     actorURI + "#accepts/" + activity.ID,
+		Type:   "Accept",
+		Actor:  actorURI,
+		Object: activity,
+	}
+
+	return i.delivery.Send(ctx, target, sender.Inbox, accept)
+}
+
+// objectID reads the ID of an activity's object, which may be either a bare
+// URI or an embedded object.
+func objectID(o any) string {
+	switch v := o.(type) {
+	case string:
+		return v
+	case map[string]any:
+		id, _ := v["id"].(string)
+		return id
+	}
+	return ""
+}
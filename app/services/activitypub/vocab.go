@@ -0,0 +1,102 @@
+package activitypub
+
+import "time"
+
+const (
+	ContentType = "application/activity+json"
+
+	ContextActivityStreams = "https://www.w3.org/ns/activitystreams"
+	contextSecurity        = "https://w3id.org/security/v1"
+	publicCollection       = "https://www.w3.org/ns/activitystreams#Public"
+)
+
+var actorContext = []string{ContextActivityStreams, contextSecurity}
+
+type PublicKey struct {
+	ID           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+type Endpoints struct {
+	SharedInbox string `json:"sharedInbox,omitempty"`
+}
+
+type Actor struct {
+	Context                   any        `json:"@context,omitempty"`
+	ID                        string     `json:"id"`
+	Type                      string     `json:"type"`
+	PreferredUsername         string     `json:"preferredUsername"`
+	Name                      string     `json:"name,omitempty"`
+	Summary                   string     `json:"summary,omitempty"`
+	URL                       string     `json:"url,omitempty"`
+	Inbox                     string     `json:"inbox"`
+	Outbox                    string     `json:"outbox,omitempty"`
+	Followers                 string     `json:"followers,omitempty"`
+	Endpoints                 *Endpoints `json:"endpoints,omitempty"`
+	PublicKey                 *PublicKey `json:"publicKey,omitempty"`
+	Published                 *time.Time `json:"published,omitempty"`
+	ManuallyApprovesFollowers bool       `json:"manuallyApprovesFollowers"`
+	Discoverable              bool       `json:"discoverable"`
+}
+
+type Object struct {
+	Context      any       `json:"@context,omitempty"`
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	AttributedTo string    `json:"attributedTo"`
+	Name         string    `json:"name,omitempty"`
+	Summary      string    `json:"summary,omitempty"`
+	Content      string    `json:"content"`
+	URL          string    `json:"url"`
+	Published    time.Time `json:"published"`
+	To           []string  `json:"to"`
+	Cc           []string  `json:"cc,omitempty"`
+}
+
+type Activity struct {
+	Context   any       `json:"@context,omitempty"`
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Actor     string    `json:"actor"`
+	Object    any       `json:"object"`
+	Published time.Time `json:"published,omitempty"`
+	To        []string  `json:"to,omitempty"`
+	Cc        []string  `json:"cc,omitempty"`
+}
+
+type OrderedCollection struct {
+	Context      any    `json:"@context,omitempty"`
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	TotalItems   int    `json:"totalItems"`
+	OrderedItems []any  `json:"orderedItems,omitempty"`
+}
+
+type WebFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href"`
+}
+
+type WebFinger struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases,omitempty"`
+	Links   []WebFingerLink `json:"links"`
+}
+
+// inboundActivity is the minimal shape of an activity received in an inbox,
+// the object may be a URI or an embedded object depending on the sender.
+type inboundActivity struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Actor  string `json:"actor"`
+	Object any    `json:"object"`
+}
+
+type remoteActor struct {
+	ID        string     `json:"id"`
+	Inbox     string     `json:"inbox"`
+	Endpoints *Endpoints `json:"endpoints"`
+	PublicKey *PublicKey `json:"publicKey"`
+}
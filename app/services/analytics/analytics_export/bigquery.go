@@ -0,0 +1,116 @@
+package analytics_export
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// bigQuerySink replaces each partition with a load job which truncates just
+// that day of a table partitioned by day, creating the table if needed.
+type bigQuerySink struct {
+	cfg     config.Config
+	service *bigquery.Service
+}
+
+var bigQueryPollInterval = time.Second
+
+func newBigQuerySink(ctx context.Context, cfg config.Config) (*bigQuerySink, error) {
+	if cfg.BigQueryProject == "" || cfg.BigQueryDataset == "" {
+		return nil, fault.New("BIGQUERY_PROJECT and BIGQUERY_DATASET must be set when exporting analytics to bigquery")
+	}
+
+	opts := []option.ClientOption{option.WithScopes(bigquery.BigqueryScope)}
+	if cfg.BigQueryCredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(cfg.BigQueryCredentialsFile))
+	}
+
+	service, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &bigQuerySink{
+		cfg:     cfg,
+		service: service,
+	}, nil
+}
+
+func (s *bigQuerySink) Name() string { return "bigquery" }
+
+func (s *bigQuerySink) Put(ctx context.Context, p Partition) error {
+	rows, err := p.JSONLines()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	fields := make([]*bigquery.TableFieldSchema, len(p.Table.Columns))
+	for i, c := range p.Table.Columns {
+		typ := "STRING"
+		switch c.Type {
+		case columnDate:
+			typ = "DATE"
+		case columnInt:
+			typ = "INTEGER"
+		}
+		fields[i] = &bigquery.TableFieldSchema{Name: c.Name, Type: typ, Mode: "REQUIRED"}
+	}
+
+	job := &bigquery.Job{
+		Configuration: &bigquery.JobConfiguration{
+			Load: &bigquery.JobConfigurationLoad{
+				DestinationTable: &bigquery.TableReference{
+					ProjectId: s.cfg.BigQueryProject,
+					DatasetId: s.cfg.BigQueryDataset,
+					// The partition decorator limits the truncate to this day.
+					TableId: tableName(s.cfg, p.Table) + "$" + p.Date.Format("20060102"),
+				},
+				Schema:            &bigquery.TableSchema{Fields: fields},
+				SourceFormat:      "NEWLINE_DELIMITED_JSON",
+				CreateDisposition: "CREATE_IF_NEEDED",
+				WriteDisposition:  "WRITE_TRUNCATE",
+				TimePartitioning: &bigquery.TimePartitioning{
+					Type:  "DAY",
+					Field: p.Table.PartitionColumn(),
+				},
+			},
+		},
+	}
+
+	job, err = s.service.Jobs.Insert(s.cfg.BigQueryProject, job).
+		Media(bytes.NewReader(rows)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for job.Status == nil || job.Status.State != "DONE" {
+		select {
+		case <-ctx.Done():
+			return fault.Wrap(ctx.Err(), fctx.With(ctx))
+		case <-time.After(bigQueryPollInterval):
+		}
+
+		job, err = s.service.Jobs.Get(s.cfg.BigQueryProject, job.JobReference.JobId).
+			Location(job.JobReference.Location).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if e := job.Status.ErrorResult; e != nil {
+		return fault.Newf("bigquery load job %s failed: %s", job.JobReference.JobId, e.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package analytics_export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+// clickHouseSink writes to ClickHouse over its HTTP interface. Each partition
+// is replaced with a lightweight delete followed by an insert, which needs
+// ClickHouse 23.3 or later.
+type clickHouseSink struct {
+	cfg     config.Config
+	client  *http.Client
+	url     string
+	created sync.Map
+}
+
+func newClickHouseSink(cfg config.Config) (*clickHouseSink, error) {
+	if cfg.ClickHouseURL == "" {
+		return nil, fault.New("CLICKHOUSE_URL must be set when exporting analytics to clickhouse")
+	}
+
+	return &clickHouseSink{
+		cfg:    cfg,
+		client: &http.Client{Timeout: time.Minute, Transport: outbound.Transport(nil)},
+		url:    strings.TrimSuffix(cfg.ClickHouseURL, "/") + "/",
+	}, nil
+}
+
+func (s *clickHouseSink) Name() string { return "clickhouse" }
+
+func (s *clickHouseSink) Put(ctx context.Context, p Partition) error {
+	table := clickHouseIdent(tableName(s.cfg, p.Table))
+
+	if _, ok := s.created.Load(table); !ok {
+		if err := s.exec(ctx, clickHouseCreateTable(table, p.Table), nil); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		s.created.Store(table, struct{}{})
+	}
+
+	del := fmt.Sprintf("DELETE FROM %s WHERE %s = '%s'",
+		table,
+		clickHouseIdent(p.Table.PartitionColumn()),
+		p.Date.Format(time.DateOnly),
+	)
+	if err := s.exec(ctx, del, nil); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rows, err := p.JSONLines()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.exec(ctx, fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", table), rows); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *clickHouseSink) exec(ctx context.Context, query string, body []byte) error {
+	q := url.Values{"query": {query}}
+	if s.cfg.ClickHouseDatabase != "" {
+		q.Set("database", s.cfg.ClickHouseDatabase)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url+"?"+q.Encode(), bytes.NewReader(body))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if s.cfg.ClickHouseUsername != "" {
+		req.Header.Set("X-ClickHouse-User", s.cfg.ClickHouseUsername)
+	}
+	if s.cfg.ClickHousePassword != "" {
+		req.Header.Set("X-ClickHouse-Key", s.cfg.ClickHousePassword)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fault.Newf("clickhouse responded with %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return nil
+}
+
+func clickHouseCreateTable(table string, t Table) string {
+	cols := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		typ := "String"
+		switch c.Type {
+		case columnDate:
+			typ = "Date"
+		case columnInt:
+			typ = "Int64"
+		}
+		cols[i] = clickHouseIdent(c.Name) + " " + typ
+	}
+
+	partition := clickHouseIdent(t.PartitionColumn())
+
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s) ENGINE = MergeTree PARTITION BY toYYYYMM(%s) ORDER BY %s",
+		table,
+		strings.Join(cols, ", "),
+		partition,
+		partition,
+	)
+}
+
+func clickHouseIdent(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "\\`") + "`"
+}
@@ -0,0 +1,75 @@
+package analytics_export
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func TestClickHouseSink(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	var queries []string
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		a.Equal("analytics", req.URL.Query().Get("database"))
+		a.Equal("storyden", req.Header.Get("X-ClickHouse-User"))
+		a.Equal("secret", req.Header.Get("X-ClickHouse-Key"))
+
+		body, _ := io.ReadAll(req.Body)
+		queries = append(queries, req.URL.Query().Get("query"))
+		bodies = append(bodies, string(body))
+
+		if strings.Contains(req.URL.Query().Get("query"), "broken") {
+			http.Error(w, "Code: 60. Table does not exist", http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	sink, err := newClickHouseSink(config.Config{
+		Namespace:                  "my-forum",
+		AnalyticsExportTablePrefix: "storyden_",
+		ClickHouseURL:              srv.URL,
+		ClickHouseDatabase:         "analytics",
+		ClickHouseUsername:         "storyden",
+		ClickHousePassword:         "secret",
+	})
+	r.NoError(err)
+
+	ctx := context.Background()
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	p := Partition{
+		Table: TableDays,
+		Date:  date,
+		Rows:  [][]any{{date, 1, 2, 3, 4, 5, 6, 7, 8}},
+	}
+
+	r.NoError(sink.Put(ctx, p))
+	r.Len(queries, 3)
+	a.Contains(queries[0], "CREATE TABLE IF NOT EXISTS `storyden_my_forum_days`")
+	a.Contains(queries[0], "`date` Date")
+	a.Contains(queries[0], "`signups` Int64")
+	a.Equal("DELETE FROM `storyden_my_forum_days` WHERE `date` = '2025-03-10'", queries[1])
+	a.Equal("INSERT INTO `storyden_my_forum_days` FORMAT JSONEachRow", queries[2])
+	a.JSONEq(`{"date":"2025-03-10","signups":1,"daily_active":2,"weekly_active":3,"monthly_active":4,"threads":5,"replies":6,"reactions":7,"likes":8}`, bodies[2])
+
+	// The table is only created once.
+	r.NoError(sink.Put(ctx, p))
+	r.Len(queries, 5)
+
+	broken := p
+	broken.Table.Name = "broken"
+	err = sink.Put(ctx, broken)
+	r.Error(err)
+	a.Contains(err.Error(), "Table does not exist")
+}
@@ -0,0 +1,257 @@
+// Package analytics_export periodically ships the analytics roll ups to
+// external stores such as ClickHouse, BigQuery or Parquet files on S3 so data
+// teams can analyse community health alongside their other data. Every export
+// replaces whole days, so days which are rolled up again are corrected in each
+// sink on the next run.
+package analytics_export
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_querier"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_writer"
+	"github.com/Southclaws/storyden/app/resources/content_view"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(register),
+	)
+}
+
+const DefaultSchedule = "@daily"
+
+var DefaultBackfillDays = 90 // how far back the very first export to a sink goes
+
+type Exporter struct {
+	logger  *slog.Logger
+	querier *analytics_querier.Querier
+	writer  *analytics_writer.Writer
+	views   *content_view.Repository
+	sinks   []Sink
+}
+
+func New(
+	ctx context.Context,
+	cfg config.Config,
+	logger *slog.Logger,
+	querier *analytics_querier.Querier,
+	writer *analytics_writer.Writer,
+	views *content_view.Repository,
+) (*Exporter, error) {
+	sinks, err := newSinks(ctx, cfg)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Exporter{
+		logger:  logger,
+		querier: querier,
+		writer:  writer,
+		views:   views,
+		sinks:   sinks,
+	}, nil
+}
+
+func register(sched *scheduler.Scheduler, e *Exporter) {
+	if len(e.sinks) == 0 {
+		return
+	}
+
+	sched.Register("analytics_export", DefaultSchedule, func(ctx context.Context) error {
+		return e.Run(ctx, time.Now())
+	})
+}
+
+// Run exports every day which may have changed since the last export to each
+// sink, up to and including the day now falls on. A sink which fails doesn't
+// stop the others and picks up from where it last succeeded on the next run.
+func (e *Exporter) Run(ctx context.Context, now time.Time) error {
+	var errs []error
+
+	for _, s := range e.sinks {
+		if err := e.export(ctx, s, now); err != nil {
+			e.logger.Error("failed to export analytics",
+				slog.String("sink", s.Name()),
+				slog.String("error", err.Error()),
+			)
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (e *Exporter) export(ctx context.Context, s Sink, now time.Time) error {
+	today := analytics.StartOfDay(now)
+
+	through, err := e.querier.ExportedThrough(ctx, s.Name())
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The day before the last export is sent again as the roll up revisits it
+	// to pick up activity which arrived late.
+	start := today.AddDate(0, 0, -DefaultBackfillDays)
+	if t, ok := through.Get(); ok {
+		start = t.AddDate(0, 0, -1)
+	}
+
+	put := func(p Partition) error {
+		return s.Put(ctx, p)
+	}
+
+	if err := e.partitions(ctx, start, today, put); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := e.writer.PutExported(ctx, s.Name(), today); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	e.logger.Debug("exported analytics",
+		slog.String("sink", s.Name()),
+		slog.Time("from", start),
+		slog.Time("to", today),
+	)
+
+	return nil
+}
+
+// partitions reads every partition which may have changed between start and
+// end, one at a time so a long backfill isn't held in memory all at once.
+func (e *Exporter) partitions(ctx context.Context, start, end time.Time, fn func(Partition) error) error {
+	days, err := e.querier.Days(ctx, start, end)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, d := range days {
+		if err := fn(daysPartition(d)); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		content, err := e.querier.ContentOn(ctx, d)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		// Days without activity are skipped rather than sent empty, content
+		// activity is only ever added to so there's nothing to clear.
+		if len(content) > 0 {
+			if err := fn(contentPartition(d, content)); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+
+		views, err := e.views.Daily(ctx, d)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if len(views) > 0 {
+			if err := fn(contentViewsPartition(d, views)); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+	}
+
+	// The roll up revisits every cohort which was still being followed during
+	// the days being exported, so they're all sent again.
+	since := analytics.StartOfWeek(start).AddDate(0, 0, -7*analytics.CohortWeeks)
+
+	cohorts, err := e.querier.Cohorts(ctx, since)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, p := range cohortPartitions(cohorts) {
+		if err := fn(p); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func daysPartition(d *analytics.Day) Partition {
+	return Partition{
+		Table: TableDays,
+		Date:  d.Date,
+		Rows: [][]any{{
+			d.Date,
+			d.Signups,
+			d.DailyActive,
+			d.WeeklyActive,
+			d.MonthlyActive,
+			d.Threads,
+			d.Replies,
+			d.Reactions,
+			d.Likes,
+		}},
+	}
+}
+
+func contentPartition(date time.Time, content []*analytics.Content) Partition {
+	p := Partition{Table: TableContent, Date: date}
+	for _, c := range content {
+		p.Rows = append(p.Rows, []any{
+			date,
+			c.Kind.String(),
+			c.ItemID.String(),
+			c.Views,
+			c.Replies,
+			c.Reactions,
+			c.Likes,
+			c.Collections,
+		})
+	}
+	return p
+}
+
+func contentViewsPartition(date time.Time, views []*content_view.ItemViews) Partition {
+	p := Partition{Table: TableContentViews, Date: date}
+	for _, v := range views {
+		p.Rows = append(p.Rows, []any{
+			date,
+			v.Kind.String(),
+			v.ItemID.String(),
+			v.AuthorID.String(),
+			v.Counts.Views,
+			v.Counts.UniqueViewers,
+		})
+	}
+	return p
+}
+
+// cohortPartitions groups the weekly rows, which are ordered by cohort then
+// week, into one partition per cohort.
+func cohortPartitions(cohorts []*analytics.Cohort) []Partition {
+	out := []Partition{}
+	for _, c := range cohorts {
+		if len(out) == 0 || !out[len(out)-1].Date.Equal(c.Cohort) {
+			out = append(out, Partition{Table: TableCohorts, Date: c.Cohort})
+		}
+
+		last := &out[len(out)-1]
+		last.Rows = append(last.Rows, []any{c.Cohort, c.Week, c.Size, c.Retained})
+	}
+	return out
+}
@@ -0,0 +1,256 @@
+package analytics_export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// The subset of Parquet needed to write a single row group of required, flat
+// columns with plain encoding and no compression. Files are small enough that
+// a dependency on a full Parquet implementation isn't worth it.
+
+const parquetMagic = "PAR1"
+
+const (
+	parquetInt32     = 1
+	parquetInt64     = 2
+	parquetByteArray = 6
+
+	parquetUTF8 = 0
+	parquetDate = 6
+
+	parquetPlain = 0
+	parquetRLE   = 3
+)
+
+// writeParquet encodes the rows of a partition as a Parquet file.
+func writeParquet(p Partition) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(parquetMagic)
+
+	type chunk struct {
+		column Column
+		offset int64
+		size   int64
+	}
+	chunks := make([]chunk, 0, len(p.Table.Columns))
+
+	for i, c := range p.Table.Columns {
+		values, err := parquetValues(c, p.Rows, i)
+		if err != nil {
+			return nil, err
+		}
+
+		var header thriftWriter
+		header.i32(1, 0) // DATA_PAGE
+		header.i32(2, int32(len(values)))
+		header.i32(3, int32(len(values)))
+		header.structBegin(5)
+		header.i32(1, int32(len(p.Rows)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.structEnd()
+		header.stop()
+
+		offset := int64(buf.Len())
+		buf.Write(header.buf.Bytes())
+		buf.Write(values)
+
+		chunks = append(chunks, chunk{
+			column: c,
+			offset: offset,
+			size:   int64(buf.Len()) - offset,
+		})
+	}
+
+	var total int64
+	for _, c := range chunks {
+		total += c.size
+	}
+
+	var meta thriftWriter
+	meta.i32(1, 1)
+
+	meta.listBegin(2, thriftStruct, len(chunks)+1)
+	meta.elemBegin()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(chunks)))
+	meta.elemEnd()
+	for _, c := range chunks {
+		meta.elemBegin()
+		meta.i32(1, parquetType(c.column.Type))
+		meta.i32(3, 0) // REQUIRED
+		meta.binary(4, c.column.Name)
+		switch c.column.Type {
+		case columnDate:
+			meta.i32(6, parquetDate)
+		case columnString:
+			meta.i32(6, parquetUTF8)
+		}
+		meta.elemEnd()
+	}
+
+	meta.i64(3, int64(len(p.Rows)))
+
+	meta.listBegin(4, thriftStruct, 1)
+	meta.elemBegin()
+	meta.listBegin(1, thriftStruct, len(chunks))
+	for _, c := range chunks {
+		meta.elemBegin()
+		meta.i64(2, c.offset)
+		meta.structBegin(3)
+		meta.i32(1, parquetType(c.column.Type))
+		meta.listBegin(2, thriftI32, 2)
+		meta.elemI32(parquetPlain)
+		meta.elemI32(parquetRLE)
+		meta.listBegin(3, thriftBinary, 1)
+		meta.elemBinary(c.column.Name)
+		meta.i32(4, 0) // UNCOMPRESSED
+		meta.i64(5, int64(len(p.Rows)))
+		meta.i64(6, c.size)
+		meta.i64(7, c.size)
+		meta.i64(9, c.offset)
+		meta.structEnd()
+		meta.elemEnd()
+	}
+	meta.i64(2, total)
+	meta.i64(3, int64(len(p.Rows)))
+	meta.elemEnd()
+
+	meta.binary(6, "storyden")
+	meta.stop()
+
+	buf.Write(meta.buf.Bytes())
+	buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(meta.buf.Len())))
+	buf.WriteString(parquetMagic)
+
+	return buf.Bytes(), nil
+}
+
+func parquetType(t columnType) int32 {
+	switch t {
+	case columnDate:
+		return parquetInt32
+	case columnInt:
+		return parquetInt64
+	default:
+		return parquetByteArray
+	}
+}
+
+func parquetValues(c Column, rows [][]any, i int) ([]byte, error) {
+	var out []byte
+
+	for _, row := range rows {
+		switch v := row[i].(type) {
+		case time.Time:
+			days := v.UTC().Unix() / int64(24*time.Hour/time.Second)
+			out = binary.LittleEndian.AppendUint32(out, uint32(int32(days)))
+		case int:
+			out = binary.LittleEndian.AppendUint64(out, uint64(int64(v)))
+		case string:
+			out = binary.LittleEndian.AppendUint32(out, uint32(len(v)))
+			out = append(out, v...)
+		default:
+			return nil, fmt.Errorf("unsupported value for column %s: %T", c.Name, v)
+		}
+	}
+
+	return out, nil
+}
+
+// thriftWriter writes the Thrift compact protocol, which Parquet uses for its
+// page headers and file metadata.
+type thriftWriter struct {
+	buf    bytes.Buffer
+	lastID int16
+	stack  []int16
+}
+
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	delta := id - w.lastID
+	if delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.varint(uint64((id << 1) ^ (id >> 15)))
+	}
+	w.lastID = id
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	w.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) binary(id int16, v string) {
+	w.field(id, thriftBinary)
+	w.elemBinary(v)
+}
+
+func (w *thriftWriter) structBegin(id int16) {
+	w.field(id, thriftStruct)
+	w.elemBegin()
+}
+
+func (w *thriftWriter) structEnd() {
+	w.elemEnd()
+}
+
+func (w *thriftWriter) listBegin(id int16, elem byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | elem)
+	} else {
+		w.buf.WriteByte(0xf0 | elem)
+		w.varint(uint64(n))
+	}
+}
+
+func (w *thriftWriter) elemBegin() {
+	w.stack = append(w.stack, w.lastID)
+	w.lastID = 0
+}
+
+func (w *thriftWriter) elemEnd() {
+	w.stop()
+	w.lastID = w.stack[len(w.stack)-1]
+	w.stack = w.stack[:len(w.stack)-1]
+}
+
+func (w *thriftWriter) elemI32(v int32) {
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) elemBinary(v string) {
+	w.varint(uint64(len(v)))
+	w.buf.WriteString(v)
+}
+
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
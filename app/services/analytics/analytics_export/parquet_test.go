@@ -0,0 +1,51 @@
+package analytics_export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteParquet(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	file, err := writeParquet(Partition{
+		Table: TableContentViews,
+		Date:  date,
+		Rows: [][]any{
+			{date, "thread", "cv1", "author1", 12, 5},
+			{date, "node", "cv2", "author2", 3, 1},
+		},
+	})
+	r.NoError(err)
+
+	r.True(bytes.HasPrefix(file, []byte(parquetMagic)))
+	r.True(bytes.HasSuffix(file, []byte(parquetMagic)))
+
+	metaLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	r.Less(metaLen, len(file)-12)
+	meta := file[len(file)-8-metaLen : len(file)-8]
+
+	for _, c := range TableContentViews.Columns {
+		a.True(bytes.Contains(meta, []byte(c.Name)), c.Name)
+	}
+
+	// Values are plain encoded, little endian with strings length prefixed.
+	a.True(bytes.Contains(file, binary.LittleEndian.AppendUint32(nil, 20157)))
+	a.True(bytes.Contains(file, append(binary.LittleEndian.AppendUint32(nil, 3), "cv1"...)))
+	a.True(bytes.Contains(file, binary.LittleEndian.AppendUint64(nil, 12)))
+
+	_, err = writeParquet(Partition{
+		Table: TableDays,
+		Date:  date,
+		Rows:  [][]any{{date, 1.5, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	a.Error(err)
+}
@@ -0,0 +1,80 @@
+package analytics_export
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+// s3Sink writes each partition as a Parquet file at a Hive style path such as
+// analytics/days/date=2025-03-10/data.parquet, replacing the last export.
+type s3Sink struct {
+	cfg    config.Config
+	mu     sync.Mutex
+	storer object.Storer
+}
+
+func newS3Sink(cfg config.Config) (*s3Sink, error) {
+	if cfg.AnalyticsExportS3Bucket == "" {
+		return nil, fault.New("ANALYTICS_EXPORT_S3_BUCKET must be set when exporting analytics to s3")
+	}
+
+	cfg.S3Bucket = cfg.AnalyticsExportS3Bucket
+
+	return &s3Sink{cfg: cfg}, nil
+}
+
+func (s *s3Sink) Name() string { return "s3" }
+
+func (s *s3Sink) Put(ctx context.Context, p Partition) error {
+	storer, err := s.connect(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	file, err := writeParquet(p)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	key := path.Join(
+		s.cfg.Namespace,
+		s.cfg.AnalyticsExportS3Prefix,
+		p.Table.Name,
+		p.Table.PartitionColumn()+"="+p.Date.Format(time.DateOnly),
+		"data.parquet",
+	)
+
+	if err := storer.Write(ctx, key, bytes.NewReader(file), int64(len(file))); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// connect creates the bucket on first use rather than on startup so storage
+// being unavailable only fails exports, not the whole process.
+func (s *s3Sink) connect(ctx context.Context) (object.Storer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.storer != nil {
+		return s.storer, nil
+	}
+
+	storer, err := object.NewS3Storer(ctx, s.cfg)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s.storer = storer
+	return storer, nil
+}
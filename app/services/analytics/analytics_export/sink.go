@@ -0,0 +1,63 @@
+package analytics_export
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// Sink is an external store analytics are exported to.
+type Sink interface {
+	Name() string
+
+	// Put replaces every row previously exported to the partition's table for
+	// the partition's day with the partition's rows.
+	Put(ctx context.Context, p Partition) error
+}
+
+func newSinks(ctx context.Context, cfg config.Config) ([]Sink, error) {
+	sinks := []Sink{}
+
+	for _, name := range cfg.AnalyticsExportSinks {
+		var s Sink
+		var err error
+
+		switch strings.TrimSpace(name) {
+		case "":
+			continue
+		case "clickhouse":
+			s, err = newClickHouseSink(cfg)
+		case "bigquery":
+			s, err = newBigQuerySink(ctx, cfg)
+		case "s3":
+			s, err = newS3Sink(cfg)
+		default:
+			err = fault.Newf("ANALYTICS_EXPORT_SINKS must only contain clickhouse, bigquery or s3, got %q", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sinks = append(sinks, s)
+	}
+
+	return sinks, nil
+}
+
+// tableName is the name of the table in databases shared with other
+// deployments or tenants, which are told apart by their namespace.
+func tableName(cfg config.Config, t Table) string {
+	name := cfg.AnalyticsExportTablePrefix
+	if cfg.Namespace != "" {
+		name += strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+				return r
+			}
+			return '_'
+		}, cfg.Namespace) + "_"
+	}
+	return name + t.Name
+}
@@ -0,0 +1,115 @@
+package analytics_export
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+type columnType int
+
+const (
+	columnDate columnType = iota
+	columnInt
+	columnString
+)
+
+type Column struct {
+	Name string
+	Type columnType
+}
+
+// Table is the shape of one kind of exported analytics. The first column is
+// the day each row belongs to, tables are partitioned by it in every sink.
+type Table struct {
+	Name    string
+	Columns []Column
+}
+
+func (t Table) PartitionColumn() string {
+	return t.Columns[0].Name
+}
+
+var (
+	TableDays = Table{
+		Name: "days",
+		Columns: []Column{
+			{"date", columnDate},
+			{"signups", columnInt},
+			{"daily_active", columnInt},
+			{"weekly_active", columnInt},
+			{"monthly_active", columnInt},
+			{"threads", columnInt},
+			{"replies", columnInt},
+			{"reactions", columnInt},
+			{"likes", columnInt},
+		},
+	}
+	TableContent = Table{
+		Name: "content",
+		Columns: []Column{
+			{"date", columnDate},
+			{"item_kind", columnString},
+			{"item_id", columnString},
+			{"views", columnInt},
+			{"replies", columnInt},
+			{"reactions", columnInt},
+			{"likes", columnInt},
+			{"collections", columnInt},
+		},
+	}
+	TableContentViews = Table{
+		Name: "content_views",
+		Columns: []Column{
+			{"date", columnDate},
+			{"item_kind", columnString},
+			{"item_id", columnString},
+			{"author_id", columnString},
+			{"views", columnInt},
+			{"unique_viewers", columnInt},
+		},
+	}
+	TableCohorts = Table{
+		Name: "cohorts",
+		Columns: []Column{
+			{"cohort", columnDate},
+			{"week", columnInt},
+			{"size", columnInt},
+			{"retained", columnInt},
+		},
+	}
+)
+
+var Tables = []Table{TableDays, TableContent, TableContentViews, TableCohorts}
+
+// Partition is every row of a table for one day. Values are in the same order
+// as the table's columns, dates are time.Time, integers int and strings string.
+type Partition struct {
+	Table Table
+	Date  time.Time
+	Rows  [][]any
+}
+
+// JSONLines encodes the rows as newline delimited JSON objects with dates
+// formatted as YYYY-MM-DD, as loaded by both ClickHouse and BigQuery.
+func (p Partition) JSONLines() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+
+	for _, row := range p.Rows {
+		obj := make(map[string]any, len(row))
+		for i, c := range p.Table.Columns {
+			if c.Type == columnDate {
+				obj[c.Name] = row[i].(time.Time).Format(time.DateOnly)
+				continue
+			}
+			obj[c.Name] = row[i]
+		}
+
+		if err := enc.Encode(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
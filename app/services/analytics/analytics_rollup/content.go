@@ -0,0 +1,66 @@
+package analytics_rollup
+
+import (
+	"slices"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/trending"
+)
+
+type contentKey struct {
+	date time.Time
+	kind datagraph.Kind
+	id   xid.ID
+}
+
+// countContent counts the signals on each item for each day they fall on and
+// keeps the most active items of each day.
+func countContent(signals []*trending.Signal, limit int) map[time.Time][]*analytics.Content {
+	counts := map[contentKey]*analytics.Content{}
+	for _, s := range signals {
+		k := contentKey{analytics.StartOfDay(s.At), s.Kind, s.ItemID}
+
+		c, ok := counts[k]
+		if !ok {
+			c = &analytics.Content{Kind: s.Kind, ItemID: s.ItemID}
+			counts[k] = c
+		}
+
+		switch s.Type {
+		case trending.SignalTypeView:
+			c.Views++
+		case trending.SignalTypeReply:
+			c.Replies++
+		case trending.SignalTypeReact:
+			c.Reactions++
+		case trending.SignalTypeLike:
+			c.Likes++
+		case trending.SignalTypeCollect:
+			c.Collections++
+		}
+	}
+
+	byDay := map[time.Time][]*analytics.Content{}
+	for k, c := range counts {
+		byDay[k.date] = append(byDay[k.date], c)
+	}
+
+	for d, content := range byDay {
+		slices.SortFunc(content, func(a, b *analytics.Content) int {
+			if a.Total() == b.Total() {
+				return b.ItemID.Compare(a.ItemID)
+			}
+			return b.Total() - a.Total()
+		})
+
+		if len(content) > limit {
+			byDay[d] = content[:limit]
+		}
+	}
+
+	return byDay
+}
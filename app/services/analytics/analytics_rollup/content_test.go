@@ -0,0 +1,67 @@
+package analytics_rollup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/trending"
+)
+
+func TestCountContent(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	day1 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	busy := xid.New()
+	quiet := xid.New()
+	page := xid.New()
+
+	signal := func(id xid.ID, kind datagraph.Kind, t trending.SignalType, at time.Time) *trending.Signal {
+		return &trending.Signal{Kind: kind, ItemID: id, Type: t, At: at}
+	}
+
+	signals := []*trending.Signal{
+		signal(busy, datagraph.KindThread, trending.SignalTypeView, day1.Add(time.Hour)),
+		signal(busy, datagraph.KindThread, trending.SignalTypeReply, day1.Add(2*time.Hour)),
+		signal(busy, datagraph.KindThread, trending.SignalTypeReply, day1.Add(23*time.Hour)),
+		signal(busy, datagraph.KindThread, trending.SignalTypeLike, day2.Add(time.Hour)),
+		signal(quiet, datagraph.KindThread, trending.SignalTypeReact, day1.Add(3*time.Hour)),
+		signal(page, datagraph.KindNode, trending.SignalTypeCollect, day1.Add(4*time.Hour)),
+		signal(page, datagraph.KindNode, trending.SignalTypeCollect, day1.Add(5*time.Hour)),
+	}
+
+	t.Run("counts_per_day", func(t *testing.T) {
+		byDay := countContent(signals, 10)
+		r.Len(byDay, 2)
+
+		first := byDay[day1]
+		r.Len(first, 3)
+		a.Equal(busy, first[0].ItemID)
+		a.Equal(1, first[0].Views)
+		a.Equal(2, first[0].Replies)
+		a.Equal(0, first[0].Likes)
+		a.Equal(page, first[1].ItemID)
+		a.Equal(datagraph.KindNode, first[1].Kind)
+		a.Equal(2, first[1].Collections)
+		a.Equal(quiet, first[2].ItemID)
+		a.Equal(1, first[2].Reactions)
+
+		second := byDay[day2]
+		r.Len(second, 1)
+		a.Equal(busy, second[0].ItemID)
+		a.Equal(1, second[0].Likes)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		byDay := countContent(signals, 1)
+		r.Len(byDay[day1], 1)
+		a.Equal(busy, byDay[day1][0].ItemID)
+	})
+}
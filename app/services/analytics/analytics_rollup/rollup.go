@@ -0,0 +1,156 @@
+// Package analytics_rollup periodically summarises community activity into the
+// analytics tables which reports are read from. Each run rolls up every day
+// since the last one, along with the day before it to pick up activity which
+// arrived late, so a run that's missed is caught up on the next.
+package analytics_rollup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_querier"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_writer"
+	"github.com/Southclaws/storyden/app/resources/trending/trending_querier"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(register),
+	)
+}
+
+const DefaultSchedule = "@hourly"
+
+var (
+	DefaultBackfillDays  = 90  // how far back the very first roll up goes
+	DefaultContentPerDay = 100 // most active items kept for each day
+)
+
+type Rollup struct {
+	logger          *slog.Logger
+	querier         *analytics_querier.Querier
+	writer          *analytics_writer.Writer
+	trendingQuerier *trending_querier.Querier
+}
+
+func New(
+	logger *slog.Logger,
+	querier *analytics_querier.Querier,
+	writer *analytics_writer.Writer,
+	trendingQuerier *trending_querier.Querier,
+) *Rollup {
+	return &Rollup{
+		logger:          logger,
+		querier:         querier,
+		writer:          writer,
+		trendingQuerier: trendingQuerier,
+	}
+}
+
+func register(sched *scheduler.Scheduler, r *Rollup) {
+	sched.Register("analytics", DefaultSchedule, func(ctx context.Context) error {
+		return r.Run(ctx, time.Now())
+	})
+}
+
+// Run rolls up every day which may have changed since the last run, up to and
+// including the day now falls on.
+func (r *Rollup) Run(ctx context.Context, now time.Time) error {
+	today := analytics.StartOfDay(now)
+
+	latest, err := r.querier.LatestDay(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	start := today.AddDate(0, 0, -DefaultBackfillDays)
+	if l, ok := latest.Get(); ok {
+		start = l.AddDate(0, 0, -1)
+	}
+
+	if err := r.days(ctx, start, today); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := r.content(ctx, start, today); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := r.cohorts(ctx, start, today); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r.logger.Debug("rolled up analytics",
+		slog.Time("from", start),
+		slog.Time("to", today),
+	)
+
+	return nil
+}
+
+func (r *Rollup) days(ctx context.Context, start, end time.Time) error {
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		day, err := r.querier.Activity(ctx, d)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := r.writer.PutDay(ctx, day); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (r *Rollup) content(ctx context.Context, start, end time.Time) error {
+	threads, err := r.trendingQuerier.ThreadSignals(ctx, start)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := r.trendingQuerier.NodeSignals(ctx, start)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	byDay := countContent(append(threads, nodes...), DefaultContentPerDay)
+
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if err := r.writer.ReplaceContent(ctx, d, byDay[d]); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// cohorts rolls up the retention of every cohort for each week which overlaps
+// the days being rolled up. Older weeks can't change so are left alone.
+func (r *Rollup) cohorts(ctx context.Context, start, end time.Time) error {
+	for w := analytics.StartOfWeek(start); !w.After(end); w = w.AddDate(0, 0, 7) {
+		for week := 0; week <= analytics.CohortWeeks; week++ {
+			c, err := r.querier.Cohort(ctx, w.AddDate(0, 0, -7*week), week)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			if c.Size == 0 {
+				continue
+			}
+
+			if err := r.writer.PutCohort(ctx, c); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Package api_usage counts the API requests made by each account and access key
+// in memory and periodically adds the counts to the stored hourly totals, so
+// counting doesn't cost a database write for every request.
+package api_usage
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/api_usage"
+)
+
+// DefaultFlushInterval is how often counts are written to the database, which
+// is also roughly how far behind the usage reports may be.
+var DefaultFlushInterval = time.Minute
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type key struct {
+	accountID xid.ID
+	accessKey xid.ID
+	bucket    int64
+}
+
+type Recorder struct {
+	logger *slog.Logger
+	repo   *api_usage.Repository
+
+	mu      sync.Mutex
+	pending map[key]*api_usage.Counts
+}
+
+func New(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	repo *api_usage.Repository,
+) *Recorder {
+	r := &Recorder{
+		logger:  logger,
+		repo:    repo,
+		pending: map[key]*api_usage.Counts{},
+	}
+
+	wctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				r.run(wctx)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			<-done
+
+			// Anything counted since the last flush would otherwise be lost.
+			return r.Flush(ctx)
+		},
+	})
+
+	return r
+}
+
+// Record counts a request made by an account, optionally with an access key,
+// which was responded to with the given status code.
+func (r *Recorder) Record(accountID account.AccountID, accessKey opt.Optional[authentication.ID], at time.Time, status int) {
+	k := key{
+		accountID: xid.ID(accountID),
+		accessKey: xid.ID(accessKey.OrZero()),
+		bucket:    at.Truncate(api_usage.Bucket).Unix(),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.pending[k]
+	if !ok {
+		c = &api_usage.Counts{}
+		r.pending[k] = c
+	}
+	c.Count(status)
+}
+
+// Flush writes every pending count to the database. Counts which fail to write
+// are dropped rather than retried, usage is only an estimate and keeping them
+// could grow without bound while the database is unavailable.
+func (r *Recorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = map[key]*api_usage.Counts{}
+	r.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	entries := make([]api_usage.Entry, 0, len(pending))
+	for k, c := range pending {
+		accessKey := opt.NewEmpty[authentication.ID]()
+		if !k.accessKey.IsNil() {
+			accessKey = opt.New(authentication.ID(k.accessKey))
+		}
+
+		entries = append(entries, api_usage.Entry{
+			Subject: api_usage.Subject{
+				AccountID: account.AccountID(k.accountID),
+				AccessKey: accessKey,
+			},
+			Bucket: time.Unix(k.bucket, 0).UTC(),
+			Counts: *c,
+		})
+	}
+
+	if err := r.repo.Add(ctx, entries); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Recorder) run(ctx context.Context) {
+	ticker := time.NewTicker(DefaultFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil {
+				r.logger.Error("failed to flush api usage", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/library/node_writer"
 	"github.com/Southclaws/storyden/app/resources/mark"
 	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
 )
 
 func (c *analyseConsumer) downloadAsset(ctx context.Context, src string, fillrule opt.Optional[asset.ContentFillCommand]) error {
@@ -23,7 +24,8 @@ func (c *analyseConsumer) downloadAsset(ctx context.Context, src string, fillrul
 	}
 
 	client := &http.Client{
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
+		Transport: outbound.Transport(nil),
 	}
 
 	resp, err := client.Do(req)
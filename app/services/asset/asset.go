@@ -5,18 +5,41 @@ import (
 
 	"github.com/Southclaws/storyden/app/services/asset/analyse"
 	"github.com/Southclaws/storyden/app/services/asset/analyse_job"
+	"github.com/Southclaws/storyden/app/services/asset/asset_access"
+	"github.com/Southclaws/storyden/app/services/asset/asset_delete"
 	"github.com/Southclaws/storyden/app/services/asset/asset_download"
+	"github.com/Southclaws/storyden/app/services/asset/asset_quota"
+	"github.com/Southclaws/storyden/app/services/asset/asset_scan"
 	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
+	"github.com/Southclaws/storyden/app/services/asset/asset_variant"
+	"github.com/Southclaws/storyden/app/services/asset/malware"
+	"github.com/Southclaws/storyden/app/services/asset/resumable"
+	"github.com/Southclaws/storyden/app/services/asset/resumable_expiry"
+	"github.com/Southclaws/storyden/app/services/asset/scan_notify"
+	"github.com/Southclaws/storyden/app/services/asset/storage_tiering"
+	"github.com/Southclaws/storyden/app/services/asset/video"
+	"github.com/Southclaws/storyden/app/services/asset/video_job"
 )
 
 func Build() fx.Option {
 	return fx.Options(
 		analyse_job.Build(),
+		resumable_expiry.Build(),
+		scan_notify.Build(),
+		storage_tiering.Build(),
+		video_job.Build(),
 		fx.Provide(
 			analyse.New,
+			asset_access.New,
 			asset_upload.New,
 			asset_download.New,
-			
+			asset_delete.New,
+			asset_quota.New,
+			asset_scan.New,
+			asset_variant.New,
+			malware.NewScanner,
+			resumable.New,
+			video.New,
 		),
 	)
 }
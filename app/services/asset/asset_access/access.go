@@ -0,0 +1,163 @@
+// Package asset_access decides who may download private assets. Members may
+// fetch a private asset if they uploaded it, administer the site, can read
+// published content it is attached to or take part in a conversation it was
+// sent in. Anyone else needs a signed URL, which
+// is only issued to those same members and expires shortly after.
+package asset_access
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/endec"
+)
+
+// Private assets are reported as missing rather than forbidden so their names
+// cannot be probed for.
+var ErrNotFound = fault.New("asset not found", ftag.With(ftag.NotFound))
+
+// The claim key is distinct from other tokens so a signed URL cannot be used
+// anywhere else.
+const (
+	signedAssetKey = "signed_asset"
+	URLLifespan    = 15 * time.Minute
+)
+
+type SignedURL struct {
+	URL       string
+	Token     string
+	ExpiresAt time.Time
+}
+
+type Checker struct {
+	querier             *asset_querier.Querier
+	conversationQuerier *conversation_querier.Querier
+	endec               endec.EncrypterDecrypter
+}
+
+func New(querier *asset_querier.Querier, conversationQuerier *conversation_querier.Querier, endec endec.EncrypterDecrypter) *Checker {
+	return &Checker{querier: querier, conversationQuerier: conversationQuerier, endec: endec}
+}
+
+// Authorise returns the asset if it may be downloaded by the current session
+// or with the given token. Public assets are always available and quarantined
+// assets never are.
+func (c *Checker) Authorise(ctx context.Context, name asset.Filename, token opt.Optional[string]) (*asset.Asset, error) {
+	a, err := c.querier.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if a.IsQuarantined() {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	if !a.Private {
+		return a, nil
+	}
+
+	if t, ok := token.Get(); ok && c.verify(a, t) {
+		return a, nil
+	}
+
+	allowed, err := c.canAccess(ctx, a)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !allowed {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	return a, nil
+}
+
+// Sign issues a short-lived URL for an asset the current session can access.
+func (c *Checker) Sign(ctx context.Context, name asset.Filename) (*SignedURL, error) {
+	if c.endec == nil {
+		return nil, fault.New("signed asset URLs require JWT_SECRET to be set")
+	}
+
+	a, err := c.Authorise(ctx, name, opt.NewEmpty[string]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	expiresAt := time.Now().Add(URLLifespan)
+
+	token, err := c.endec.Encrypt(endec.Claims{
+		signedAssetKey: a.ID.String(),
+	}, URLLifespan)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &SignedURL{
+		URL:       fmt.Sprintf("/api/assets/%s?token=%s", a.Name.String(), url.QueryEscape(token)),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (c *Checker) verify(a *asset.Asset, token string) bool {
+	if c.endec == nil {
+		return false
+	}
+
+	claims, err := c.endec.Decrypt(token)
+	if err != nil {
+		return false
+	}
+
+	id, ok := claims[signedAssetKey].(string)
+	if !ok {
+		return false
+	}
+
+	return id == a.ID.String()
+}
+
+func (c *Checker) canAccess(ctx context.Context, a *asset.Asset) (bool, error) {
+	roles := session.GetRoles(ctx)
+	perms := roles.Permissions()
+
+	if perms.HasAny(rbac.PermissionAdministrator) {
+		return true, nil
+	}
+
+	accountID, signedIn := session.GetOptAccountID(ctx).Get()
+	if signedIn && xid.ID(accountID) == a.OwnerID {
+		return true, nil
+	}
+
+	attachments, err := c.querier.Attachments(ctx, a.ID, roles)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if attachments.PublishedPosts && perms.HasAny(rbac.PermissionReadPublishedThreads) {
+		return true, nil
+	}
+
+	if attachments.PublishedNodes && perms.HasAny(rbac.PermissionReadPublishedLibrary) {
+		return true, nil
+	}
+
+	if signedIn {
+		return c.conversationQuerier.CanSeeAsset(ctx, accountID, a.ID)
+	}
+
+	return false, nil
+}
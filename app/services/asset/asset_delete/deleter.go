@@ -0,0 +1,69 @@
+package asset_delete
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_writer"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+var ErrNotOwner = fault.New("only the uploader of an asset may delete it", ftag.With(ftag.PermissionDenied))
+
+type Deleter struct {
+	querier *asset_querier.Querier
+	writer  *asset_writer.Writer
+	objects object.Storer
+}
+
+func New(
+	querier *asset_querier.Querier,
+	writer *asset_writer.Writer,
+	objects object.Storer,
+) *Deleter {
+	return &Deleter{
+		querier: querier,
+		writer:  writer,
+		objects: objects,
+	}
+}
+
+// Delete removes an asset. Deduplicated assets share their stored contents so
+// the blob is only removed once no other asset refers to it.
+func (d *Deleter) Delete(ctx context.Context, name asset.Filename) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a, err := d.querier.Get(ctx, name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if a.OwnerID != xid.ID(accountID) && !session.GetRoles(ctx).Permissions().HasAny(rbac.PermissionAdministrator) {
+		return fault.Wrap(ErrNotOwner, fctx.With(ctx))
+	}
+
+	refs, err := d.writer.Remove(ctx, a.OwnerID, a.Name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if refs > 0 {
+		return nil
+	}
+
+	if err := d.objects.Delete(ctx, a.Path()); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
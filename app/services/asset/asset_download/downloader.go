@@ -33,7 +33,7 @@ func (d *Downloader) Get(ctx context.Context, id asset.Filename) (*asset.Asset,
 		return nil, nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	path := asset.BuildAssetPath(a.Name)
+	path := a.Path()
 	ctx = fctx.WithMeta(ctx, "path", path, "asset_id", id.String())
 
 	r, size, err := d.objects.Read(ctx, path)
@@ -52,7 +52,7 @@ func (d *Downloader) GetByID(ctx context.Context, id asset.AssetID) (*asset.Asse
 		return nil, nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	path := asset.BuildAssetPath(a.Name)
+	path := a.Path()
 	ctx = fctx.WithMeta(ctx, "path", path, "asset_id", id.String())
 
 	r, size, err := d.objects.Read(ctx, path)
@@ -0,0 +1,96 @@
+// Package asset_quota limits how much storage members may use for uploads
+// based on the quotas set on the roles they hold.
+package asset_quota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/asset/asset_usage"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var ErrQuotaExceeded = fault.New("upload would exceed storage quota", ftag.With(ftag.PermissionDenied))
+
+type Usage struct {
+	asset_usage.Usage
+	Quota opt.Optional[int64]
+}
+
+type Checker struct {
+	usage *asset_usage.Querier
+}
+
+func New(usage *asset_usage.Querier) *Checker {
+	return &Checker{usage: usage}
+}
+
+// Get returns the current member's storage usage and the quota their roles
+// give them, if any.
+func (c *Checker) Get(ctx context.Context) (*Usage, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	u, err := c.usage.ForAccount(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Usage{
+		Usage: *u,
+		Quota: session.GetRoles(ctx).UploadQuota(),
+	}, nil
+}
+
+// Check fails if storing another size bytes would take the current member
+// over their quota.
+func (c *Checker) Check(ctx context.Context, size int64) error {
+	u, err := c.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	quota, ok := u.Quota.Get()
+	if !ok {
+		return nil
+	}
+
+	if u.Bytes+size <= quota {
+		return nil
+	}
+
+	remaining := max(quota-u.Bytes, 0)
+
+	return fault.Wrap(ErrQuotaExceeded,
+		fctx.With(ctx),
+		fmsg.WithDesc("quota exceeded", fmt.Sprintf(
+			"This file is %s but you only have %s of your %s storage quota remaining. Delete some of your uploads to free up space.",
+			formatBytes(size),
+			formatBytes(remaining),
+			formatBytes(quota),
+		)),
+	)
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
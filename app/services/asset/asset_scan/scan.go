@@ -0,0 +1,98 @@
+// Package asset_scan runs uploads through the configured malware scanner and
+// decides, based on the enforcement mode, whether a flagged upload is rejected
+// and quarantined or accepted with a warning.
+package asset_scan
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/services/asset/malware"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+var ErrMalwareDetected = fault.New("upload was flagged by the malware scanner", ftag.With(ftag.InvalidArgument))
+
+// Result is the outcome of scanning an upload. Status is empty when scanning
+// is disabled or the scanner failed while enforcement is set to warn.
+type Result struct {
+	Status    opt.Optional[asset.ScanStatus]
+	Signature string
+}
+
+func (r *Result) Flagged() bool {
+	s, ok := r.Status.Get()
+	return ok && s != asset.ScanStatusClean
+}
+
+type Screener struct {
+	logger  *slog.Logger
+	scanner malware.Scanner
+	block   bool
+}
+
+func New(
+	logger *slog.Logger,
+	cfg config.Config,
+	scanner malware.Scanner,
+) (*Screener, error) {
+	var block bool
+	switch cfg.MalwareScanEnforcement {
+	case "", "block":
+		block = true
+	case "warn":
+		block = false
+	default:
+		return nil, fault.Newf("unknown malware scan enforcement: '%s'", cfg.MalwareScanEnforcement)
+	}
+
+	return &Screener{
+		logger:  logger,
+		scanner: scanner,
+		block:   block,
+	}, nil
+}
+
+// Screen scans an upload. When blocking, a scanner which fails rejects the
+// upload since it cannot be shown to be safe, when warning it's let through
+// unscanned.
+func (s *Screener) Screen(ctx context.Context, r io.Reader) (*Result, error) {
+	if s.scanner == nil {
+		return &Result{}, nil
+	}
+
+	verdict, err := s.scanner.Scan(ctx, r)
+	if err != nil {
+		if s.block {
+			return nil, fault.Wrap(err,
+				fctx.With(ctx),
+				fmsg.WithDesc("scan failed", "The upload could not be checked for malware, please try again later."),
+			)
+		}
+
+		s.logger.Warn("failed to scan upload for malware", slog.String("error", err.Error()))
+		return &Result{}, nil
+	}
+
+	if !verdict.Infected {
+		return &Result{Status: opt.New(asset.ScanStatusClean)}, nil
+	}
+
+	status := asset.ScanStatusFlagged
+	if s.block {
+		status = asset.ScanStatusQuarantined
+	}
+
+	return &Result{
+		Status:    opt.New(status),
+		Signature: verdict.Signature,
+	}, nil
+}
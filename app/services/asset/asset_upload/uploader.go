@@ -1,20 +1,31 @@
 package asset_upload
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"log/slog"
+	"os"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/asset/asset_writer"
 	"github.com/Southclaws/storyden/app/resources/library/node_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/asset/asset_quota"
+	"github.com/Southclaws/storyden/app/services/asset/asset_scan"
+	"github.com/Southclaws/storyden/app/services/asset/video"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/imageproc"
 	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 	"github.com/Southclaws/storyden/internal/mime"
 )
 
@@ -23,6 +34,10 @@ type Uploader struct {
 	nodewriter *node_writer.Writer
 	assets     *asset_writer.Writer
 	objects    object.Storer
+	video      *video.Processor
+	quota      *asset_quota.Checker
+	scan       *asset_scan.Screener
+	bus        *pubsub.Bus
 }
 
 func New(
@@ -31,17 +46,26 @@ func New(
 	nodewriter *node_writer.Writer,
 	assets *asset_writer.Writer,
 	objects object.Storer,
+	video *video.Processor,
+	quota *asset_quota.Checker,
+	scan *asset_scan.Screener,
+	bus *pubsub.Bus,
 ) *Uploader {
 	return &Uploader{
 		logger:     logger,
 		nodewriter: nodewriter,
 		assets:     assets,
 		objects:    objects,
+		video:      video,
+		quota:      quota,
+		scan:       scan,
+		bus:        bus,
 	}
 }
 
 type Options struct {
 	ParentID opt.Optional[asset.AssetID]
+	Private  bool
 }
 
 func (s *Uploader) Upload(ctx context.Context, or io.Reader, size int64, name asset.Filename, opts Options) (*asset.Asset, error) {
@@ -55,20 +79,103 @@ func (s *Uploader) Upload(ctx context.Context, or io.Reader, size int64, name as
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if f, ok := imageproc.FormatFromMIME(mt.String()); ok && (f == imageproc.FormatJPEG || f == imageproc.FormatPNG) {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		b = imageproc.StripLocation(b)
+		r = bytes.NewReader(b)
+		size = int64(len(b))
+	}
+
+	spool, err := os.CreateTemp("", "storyden-upload-")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	hasher := sha256.New()
+	size, err = io.Copy(io.MultiWriter(spool, hasher), r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.quota.Check(ctx, size); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	scan, err := s.scan.Screen(ctx, spool)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	hash := hex.EncodeToString(hasher.Sum(nil))
+
+	// Quarantined files are kept apart from the shared blobs so they are never
+	// served and never deduplicated against a clean upload.
+	var wopts []asset_writer.Option
+	if scan.Status.OrZero() != asset.ScanStatusQuarantined {
+		wopts = append(wopts, asset_writer.WithContentHash(hash))
+	}
+	if status, ok := scan.Status.Get(); ok {
+		wopts = append(wopts, asset_writer.WithScan(status, scan.Signature))
+	}
+	if opts.Private {
+		wopts = append(wopts, asset_writer.WithPrivate())
+	}
+
 	a, err := func() (asset *asset.Asset, err error) {
 		if pid, ok := opts.ParentID.Get(); ok {
-			return s.assets.AddVersion(ctx, xid.ID(accountID), name, int(size), *mt, pid)
+			return s.assets.AddVersion(ctx, xid.ID(accountID), name, int(size), *mt, pid, wopts...)
 		} else {
-			return s.assets.Add(ctx, xid.ID(accountID), name, int(size), *mt)
+			return s.assets.Add(ctx, xid.ID(accountID), name, int(size), *mt, wopts...)
 		}
 	}()
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	path := asset.BuildAssetPath(a.Name)
+	// Identical files share one blob, so only the first upload of some content
+	// actually writes it to the object store.
+	path := a.Path()
+
+	exists, err := s.objects.Exists(ctx, path)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !exists {
+		if err := s.objects.Write(ctx, path, spool, size); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if scan.Flagged() {
+		s.bus.Publish(ctx, &message.EventAssetFlagged{
+			ID:        a.ID,
+			OwnerID:   accountID,
+			Status:    scan.Status.OrZero(),
+			Signature: scan.Signature,
+		})
+	}
+
+	if a.IsQuarantined() {
+		return nil, fault.Wrap(asset_scan.ErrMalwareDetected,
+			fctx.With(ctx),
+			fmsg.WithDesc("malware detected", "This file was flagged by the malware scanner and has been sent to the moderators for review."),
+		)
+	}
 
-	if err := s.objects.Write(ctx, path, r, size); err != nil {
+	if err := s.video.Enqueue(ctx, a); err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
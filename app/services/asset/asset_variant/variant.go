@@ -0,0 +1,178 @@
+// Package asset_variant serves resized and re-encoded versions of image assets.
+// Variants are generated the first time they are requested and then cached in
+// the object store alongside the original.
+package asset_variant
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"slices"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/disintegration/imaging"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/internal/infrastructure/imageproc"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+var ErrNotProcessed = fault.New("asset has not been processed", ftag.With(ftag.NotFound))
+
+// Widths are the sizes a requested width is rounded up to. Limiting variants to
+// a fixed set stops clients from filling storage with arbitrary sizes.
+var Widths = []int{64, 128, 256, 512, 768, 1024, 1536, 2048}
+
+type Options struct {
+	Width  opt.Optional[int]
+	Format opt.Optional[imageproc.Format]
+}
+
+type Variant struct {
+	MIME string
+	Size int64
+	Body io.Reader
+}
+
+type Server struct {
+	assets  *asset_querier.Querier
+	objects object.Storer
+	encoder imageproc.Encoder
+}
+
+func New(
+	assets *asset_querier.Querier,
+	objects object.Storer,
+	encoder imageproc.Encoder,
+) *Server {
+	return &Server{
+		assets:  assets,
+		objects: objects,
+		encoder: encoder,
+	}
+}
+
+// SnapWidth rounds a requested width up to the nearest supported width, or the
+// largest one if the request is bigger than all of them.
+func SnapWidth(w int) int {
+	i, _ := slices.BinarySearch(Widths, w)
+	if i == len(Widths) {
+		return Widths[len(Widths)-1]
+	}
+	return Widths[i]
+}
+
+// Get returns the variant of an asset closest to the requested options. Assets
+// which are not images, or formats which cannot be produced, are served as the
+// original file or in the original format respectively.
+func (s *Server) Get(ctx context.Context, name asset.Filename, opts Options) (*Variant, error) {
+	a, err := s.assets.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	source, ok := imageproc.FormatFromMIME(a.MIME.String())
+	if !ok || !s.encoder.Supports(source) {
+		return s.original(ctx, a)
+	}
+
+	format := opts.Format.Or(source)
+	if !s.encoder.Supports(format) {
+		format = source
+	}
+
+	width := opt.Map(opts.Width, SnapWidth).Or(0)
+	if width == 0 && format == source {
+		return s.original(ctx, a)
+	}
+
+	key := fmt.Sprintf("%d", width)
+	if width == 0 {
+		key = "original"
+	}
+	variantPath := asset.BuildVariantPath(a.Name, key+"."+format.Extension())
+	ctx = fctx.WithMeta(ctx, "path", variantPath, "asset_id", a.ID.String())
+
+	exists, err := s.objects.Exists(ctx, variantPath)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if exists {
+		r, size, err := s.objects.Read(ctx, variantPath)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return &Variant{MIME: format.MIME(), Size: size, Body: r}, nil
+	}
+
+	r, _, err := s.objects.Read(ctx, a.Path())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if width > 0 && width < img.Bounds().Dx() {
+		img = imaging.Resize(img, width, 0, imaging.Lanczos)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := s.encoder.Encode(ctx, buf, img, format); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	encoded := buf.Bytes()
+
+	if err := s.objects.Write(ctx, variantPath, bytes.NewReader(encoded), int64(len(encoded))); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Variant{
+		MIME: format.MIME(),
+		Size: int64(len(encoded)),
+		Body: bytes.NewReader(encoded),
+	}, nil
+}
+
+// GetProcessed returns a file produced by processing an asset in the
+// background, such as the stream or poster of a transcoded video.
+func (s *Server) GetProcessed(ctx context.Context, name asset.Filename, variant string, mime string) (*Variant, error) {
+	a, err := s.assets.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ps, ok := a.Processing.Get(); !ok || ps != asset.ProcessingStatusReady {
+		return nil, fault.Wrap(ErrNotProcessed, fctx.With(ctx))
+	}
+
+	r, size, err := s.objects.Read(ctx, asset.BuildVariantPath(a.Name, variant))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Variant{MIME: mime, Size: size, Body: r}, nil
+}
+
+func (s *Server) original(ctx context.Context, a *asset.Asset) (*Variant, error) {
+	r, size, err := s.objects.Read(ctx, a.Path())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Variant{MIME: a.MIME.String(), Size: size, Body: r}, nil
+}
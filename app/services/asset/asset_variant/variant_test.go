@@ -0,0 +1,18 @@
+package asset_variant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapWidth(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(64, SnapWidth(1))
+	a.Equal(64, SnapWidth(64))
+	a.Equal(128, SnapWidth(65))
+	a.Equal(1024, SnapWidth(800))
+	a.Equal(2048, SnapWidth(2048))
+	a.Equal(2048, SnapWidth(9000))
+}
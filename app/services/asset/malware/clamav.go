@@ -0,0 +1,124 @@
+package malware
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const (
+	clamavTimeout   = 2 * time.Minute
+	clamavChunkSize = 64 * 1024
+)
+
+var ErrClamAVFailed = fault.New("clamd responded with an error")
+
+// clamavScanner streams files to a clamd daemon with the INSTREAM command.
+// https://docs.clamav.net/manual/Usage/Scanning.html#clamd
+type clamavScanner struct {
+	network string
+	address string
+}
+
+func newClamAVScanner(cfg config.Config) (*clamavScanner, error) {
+	u, err := url.Parse(cfg.ClamAVAddress)
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("CLAMAV_ADDRESS must be a tcp:// or unix:// URL"))
+	}
+
+	switch u.Scheme {
+	case "tcp":
+		return &clamavScanner{network: "tcp", address: u.Host}, nil
+
+	case "unix":
+		return &clamavScanner{network: "unix", address: u.Path}, nil
+
+	default:
+		return nil, fault.Newf("CLAMAV_ADDRESS must be a tcp:// or unix:// URL, got '%s'", cfg.ClamAVAddress)
+	}
+}
+
+func (s *clamavScanner) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, clamavTimeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	buf := make([]byte, clamavChunkSize)
+	size := make([]byte, 4)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n))
+			if _, err := conn.Write(size); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+			if _, err := conn.Write(buf[:n]); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err := conn.Write(size); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && err != io.EOF {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return parseClamAVReply(ctx, reply)
+}
+
+// parseClamAVReply reads a reply such as "stream: OK" or
+// "stream: Win.Test.EICAR_HDB-1 FOUND".
+func parseClamAVReply(ctx context.Context, reply string) (*Result, error) {
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+	reply = strings.TrimPrefix(reply, "stream: ")
+
+	switch {
+	case reply == "OK":
+		return &Result{}, nil
+
+	case strings.HasSuffix(reply, " FOUND"):
+		return &Result{
+			Infected:  true,
+			Signature: strings.TrimSuffix(reply, " FOUND"),
+		}, nil
+
+	default:
+		return nil, fault.Wrap(ErrClamAVFailed, fctx.With(ctx), fmsg.With(reply))
+	}
+}
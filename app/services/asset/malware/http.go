@@ -0,0 +1,73 @@
+package malware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+const httpScanTimeout = 2 * time.Minute
+
+// httpScanner POSTs files to an external service which responds with a JSON
+// verdict.
+type httpScanner struct {
+	client   *http.Client
+	endpoint string
+	key      string
+}
+
+type httpScanResponse struct {
+	Infected  bool   `json:"infected"`
+	Signature string `json:"signature"`
+}
+
+func newHTTPScanner(cfg config.Config) (*httpScanner, error) {
+	if cfg.MalwareScanURL == "" {
+		return nil, fault.New("MALWARE_SCAN_URL must be set when MALWARE_SCANNER is http")
+	}
+
+	return &httpScanner{
+		client:   &http.Client{Timeout: httpScanTimeout, Transport: outbound.Transport(nil)},
+		endpoint: cfg.MalwareScanURL,
+		key:      cfg.MalwareScanAPIKey,
+	}, nil
+}
+
+func (s *httpScanner) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if s.key != "" {
+		req.Header.Set("Authorization", "Bearer "+s.key)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fault.Newf("malware scanner responded with %s", res.Status)
+	}
+
+	var body httpScanResponse
+	if err := json.NewDecoder(io.LimitReader(res.Body, 64*1024)).Decode(&body); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Result{
+		Infected:  body.Infected,
+		Signature: body.Signature,
+	}, nil
+}
@@ -0,0 +1,38 @@
+// Package malware provides scanners which check uploaded files for malware.
+package malware
+
+import (
+	"context"
+	"io"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// Result is the verdict for a scanned file, Signature names what was found.
+type Result struct {
+	Infected  bool
+	Signature string
+}
+
+// Scanner describes a service which checks the contents of a file for malware.
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (*Result, error)
+}
+
+func NewScanner(cfg config.Config) (Scanner, error) {
+	switch cfg.MalwareScanner {
+	case "":
+		return nil, nil
+
+	case "clamav":
+		return newClamAVScanner(cfg)
+
+	case "http":
+		return newHTTPScanner(cfg)
+
+	default:
+		return nil, fault.Newf("unknown malware scanner: '%s'", cfg.MalwareScanner)
+	}
+}
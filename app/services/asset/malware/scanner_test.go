@@ -0,0 +1,138 @@
+package malware
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const malwareMarker = "STORYDEN-TEST-MALWARE"
+
+// fakeClamd accepts INSTREAM requests and flags any stream containing the test
+// string, replying the way clamd does.
+func fakeClamd(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				cmd := make([]byte, len("zINSTREAM\x00"))
+				if _, err := io.ReadFull(conn, cmd); err != nil {
+					return
+				}
+
+				var stream bytes.Buffer
+				size := make([]byte, 4)
+				for {
+					if _, err := io.ReadFull(conn, size); err != nil {
+						return
+					}
+					n := binary.BigEndian.Uint32(size)
+					if n == 0 {
+						break
+					}
+					if _, err := io.CopyN(&stream, conn, int64(n)); err != nil {
+						return
+					}
+				}
+
+				if strings.Contains(stream.String(), malwareMarker) {
+					conn.Write([]byte("stream: Test.Marker FOUND\x00"))
+				} else {
+					conn.Write([]byte("stream: OK\x00"))
+				}
+			}()
+		}
+	}()
+
+	return "tcp://" + l.Addr().String()
+}
+
+func TestClamAVScanner(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := NewScanner(config.Config{
+		MalwareScanner: "clamav",
+		ClamAVAddress:  fakeClamd(t),
+	})
+	require.NoError(t, err)
+
+	t.Run("clean", func(t *testing.T) {
+		r, err := s.Scan(ctx, strings.NewReader(strings.Repeat("harmless ", clamavChunkSize)))
+		require.NoError(t, err)
+		assert.False(t, r.Infected)
+	})
+
+	t.Run("infected", func(t *testing.T) {
+		r, err := s.Scan(ctx, strings.NewReader(malwareMarker))
+		require.NoError(t, err)
+		assert.True(t, r.Infected)
+		assert.Equal(t, "Test.Marker", r.Signature)
+	})
+}
+
+func TestClamAVReply(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := parseClamAVReply(ctx, "INSTREAM size limit exceeded. ERROR\x00")
+	assert.ErrorIs(t, err, ErrClamAVFailed)
+}
+
+func TestHTTPScanner(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		infected := bytes.Contains(b, []byte(malwareMarker))
+		signature := ""
+		if infected {
+			signature = "Test.Marker"
+		}
+
+		json.NewEncoder(w).Encode(httpScanResponse{Infected: infected, Signature: signature})
+	}))
+	defer srv.Close()
+
+	s, err := NewScanner(config.Config{
+		MalwareScanner:    "http",
+		MalwareScanURL:    srv.URL,
+		MalwareScanAPIKey: "key",
+	})
+	require.NoError(t, err)
+
+	r, err := s.Scan(ctx, strings.NewReader("harmless"))
+	require.NoError(t, err)
+	assert.False(t, r.Infected)
+
+	r, err = s.Scan(ctx, strings.NewReader(malwareMarker))
+	require.NoError(t, err)
+	assert.True(t, r.Infected)
+	assert.Equal(t, "Test.Marker", r.Signature)
+}
@@ -0,0 +1,318 @@
+// Package resumable accepts large uploads in parts so that an interrupted
+// transfer can pick up from the last byte the server received instead of
+// starting again. Parts are held in the object store until the upload is
+// completed and handed to the regular uploader as a single stream.
+package resumable
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/upload_session"
+	"github.com/Southclaws/storyden/app/services/asset/asset_quota"
+	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+var (
+	ErrInvalidSize      = fault.New("upload size must be greater than zero", ftag.With(ftag.InvalidArgument))
+	ErrPartOutOfRange   = fault.New("part extends beyond the declared upload size", ftag.With(ftag.InvalidArgument))
+	ErrPartIncomplete   = fault.New("part body was shorter than its content length", ftag.With(ftag.InvalidArgument))
+	ErrUploadIncomplete = fault.New("upload has not received all of its bytes", ftag.With(ftag.InvalidArgument))
+)
+
+type Manager struct {
+	sessions *upload_session.Repository
+	uploader *asset_upload.Uploader
+	objects  object.Storer
+	quota    *asset_quota.Checker
+}
+
+func New(
+	sessions *upload_session.Repository,
+	uploader *asset_upload.Uploader,
+	objects object.Storer,
+	quota *asset_quota.Checker,
+) *Manager {
+	return &Manager{
+		sessions: sessions,
+		uploader: uploader,
+		objects:  objects,
+		quota:    quota,
+	}
+}
+
+type Options struct {
+	ParentID opt.Optional[asset.AssetID]
+	Private  bool
+}
+
+func (m *Manager) Create(ctx context.Context, name asset.Filename, size int64, opts Options) (*upload_session.Session, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if size <= 0 {
+		return nil, fault.Wrap(ErrInvalidSize, fctx.With(ctx))
+	}
+
+	// Checked up front so members are not left uploading parts of a file
+	// which will be rejected when it is completed.
+	if err := m.quota.Check(ctx, size); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sopts := []upload_session.Option{}
+	if pid, ok := opts.ParentID.Get(); ok {
+		sopts = append(sopts, upload_session.WithParent(pid))
+	}
+	if opts.Private {
+		sopts = append(sopts, upload_session.WithPrivate())
+	}
+
+	s, err := m.sessions.Create(ctx, accountID, name.String(), size, sopts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s, nil
+}
+
+// Get returns a session owned by the current member. Sessions belonging to
+// anyone else are reported as not found.
+func (m *Manager) Get(ctx context.Context, id upload_session.SessionID) (*upload_session.Session, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := m.sessions.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if s.AccountID != accountID {
+		return nil, fault.Wrap(upload_session.ErrNotFound, fctx.With(ctx))
+	}
+
+	return s, nil
+}
+
+// Append stores the next part of an upload. The offset must be exactly the
+// number of bytes received so far, clients which lose track of this can ask
+// for the session's progress and resume from there.
+func (m *Manager) Append(ctx context.Context, id upload_session.SessionID, offset int64, r io.Reader, length int64) (*upload_session.Session, error) {
+	s, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if offset != s.Received {
+		return nil, fault.Wrap(upload_session.ErrOffsetMismatch,
+			fctx.With(ctx),
+			fmsg.WithDesc("offset mismatch", "The upload is expected to resume from a different offset, fetch the upload's progress and try again."),
+		)
+	}
+
+	if length <= 0 || offset+length > s.Size {
+		return nil, fault.Wrap(ErrPartOutOfRange, fctx.With(ctx))
+	}
+
+	// Parts are written somewhere of their own first so that a retry racing
+	// the original, both sending the same offset, can't overwrite the part
+	// which was accepted. Only the append which advances the session gets to
+	// put its part in place.
+	path := s.PartPath(offset)
+	temp := path + "." + xid.New().String()
+	counter := &countingReader{r: io.LimitReader(r, length)}
+
+	if err := m.objects.Write(ctx, temp, counter, length); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if counter.n != length {
+		if err := m.objects.Delete(ctx, temp); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		return nil, fault.Wrap(ErrPartIncomplete, fctx.With(ctx))
+	}
+
+	advanced, err := m.sessions.Advance(ctx, id, offset, length)
+	if err != nil {
+		if derr := m.objects.Delete(ctx, temp); derr != nil {
+			return nil, fault.Wrap(derr, fctx.With(ctx))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := object.Move(ctx, m.objects, temp, path); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return advanced, nil
+}
+
+// Complete assembles the parts of a fully received upload into an asset and
+// removes the session.
+func (m *Manager) Complete(ctx context.Context, id upload_session.SessionID) (*asset.Asset, error) {
+	s, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !s.Complete() {
+		return nil, fault.Wrap(ErrUploadIncomplete, fctx.With(ctx))
+	}
+
+	parts := &partsReader{ctx: ctx, objects: m.objects, session: s}
+
+	a, err := m.uploader.Upload(ctx, parts, s.Size, asset.NewFilename(s.Filename), asset_upload.Options{
+		ParentID: s.ParentID,
+		Private:  s.Private,
+	})
+	parts.close()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.remove(ctx, s); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return a, nil
+}
+
+func (m *Manager) Cancel(ctx context.Context, id upload_session.SessionID) error {
+	s, err := m.Get(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.remove(ctx, s)
+}
+
+// Expire removes sessions which have not received a part within the expiry
+// window along with any parts they had received.
+func (m *Manager) Expire(ctx context.Context, now time.Time) (int, error) {
+	sessions, err := m.sessions.ListExpired(ctx, now)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, s := range sessions {
+		if err := m.remove(ctx, s); err != nil {
+			return 0, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return len(sessions), nil
+}
+
+func (m *Manager) remove(ctx context.Context, s *upload_session.Session) error {
+	for offset := int64(0); offset < s.Received; {
+		path := s.PartPath(offset)
+
+		r, n, err := m.objects.Read(ctx, path)
+		if err != nil {
+			if ftag.Get(err) == ftag.NotFound {
+				break
+			}
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		closeReader(r)
+
+		if err := m.objects.Delete(ctx, path); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if n <= 0 {
+			break
+		}
+		offset += n
+	}
+
+	if err := m.sessions.Delete(ctx, s.ID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func closeReader(r io.Reader) {
+	if c, ok := r.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+// partsReader reads an upload's parts in order, only opening each part once
+// the previous one has been read so an upload of many parts doesn't hold them
+// all open at once.
+type partsReader struct {
+	ctx     context.Context
+	objects object.Storer
+	session *upload_session.Session
+	offset  int64
+	current io.Reader
+}
+
+func (p *partsReader) Read(b []byte) (int, error) {
+	for {
+		if p.current == nil {
+			if p.offset >= p.session.Size {
+				return 0, io.EOF
+			}
+
+			r, n, err := p.objects.Read(p.ctx, p.session.PartPath(p.offset))
+			if err != nil {
+				return 0, fault.Wrap(err, fctx.With(p.ctx))
+			}
+			if n <= 0 {
+				closeReader(r)
+				return 0, fault.Wrap(ErrUploadIncomplete, fctx.With(p.ctx))
+			}
+
+			p.current = r
+			p.offset += n
+		}
+
+		n, err := p.current.Read(b)
+		if err == io.EOF {
+			p.close()
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+
+		return n, err
+	}
+}
+
+func (p *partsReader) close() {
+	if p.current != nil {
+		closeReader(p.current)
+		p.current = nil
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
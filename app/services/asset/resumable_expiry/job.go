@@ -0,0 +1,51 @@
+// Package resumable_expiry periodically removes resumable uploads which have
+// been abandoned part way through, freeing the storage held by their parts.
+package resumable_expiry
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/asset/resumable"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newExpiryJob)
+}
+
+const DefaultSchedule = "@hourly"
+
+type expiryJob struct {
+	logger    *slog.Logger
+	resumable *resumable.Manager
+}
+
+func newExpiryJob(
+	sched *scheduler.Scheduler,
+	logger *slog.Logger,
+	resumable *resumable.Manager,
+) {
+	j := &expiryJob{
+		logger:    logger,
+		resumable: resumable,
+	}
+
+	sched.Register("resumable_expiry", DefaultSchedule, j.run)
+}
+
+func (j *expiryJob) run(ctx context.Context) error {
+	expired, err := j.resumable.Expire(ctx, time.Now())
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	j.logger.Debug("expired abandoned uploads", slog.Int("uploads", expired))
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package scan_notify
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(func(
+		ctx context.Context,
+		lc fx.Lifecycle,
+		bus *pubsub.Bus,
+		notifier *notify.Notifier,
+		accountQuerier *account_querier.Querier,
+	) {
+		consumer := func(hctx context.Context) error {
+			// Malware scanner flagged an upload
+			// Notify members who handle reports, the uploader is only told
+			// when their upload is blocked.
+			if _, err := pubsub.Subscribe(hctx, bus, "scan_notify.asset_flagged", func(ctx context.Context, evt *message.EventAssetFlagged) error {
+				return sendFlagged(ctx, notifier, accountQuerier, evt)
+			}); err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		lc.Append(fx.StartHook(consumer))
+	})
+}
+
+func sendFlagged(
+	ctx context.Context,
+	notifier *notify.Notifier,
+	accountQuerier *account_querier.Querier,
+	evt *message.EventAssetFlagged,
+) error {
+	accs, err := accountQuerier.ListByHeldPermission(ctx, rbac.PermissionAdministrator, rbac.PermissionManageReports)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, acc := range accs {
+		if err := notifier.Send(ctx, acc.ID, opt.New(evt.OwnerID), notification.EventAssetFlagged, nil); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
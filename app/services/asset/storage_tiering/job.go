@@ -0,0 +1,57 @@
+// Package storage_tiering periodically moves files which have been on local
+// disk for longer than the hot period to cold storage when tiered storage is
+// configured.
+package storage_tiering
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/scheduler"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newTieringJob)
+}
+
+const DefaultSchedule = "@hourly"
+
+type tieringJob struct {
+	logger *slog.Logger
+	tiered *object.TieredStorer
+}
+
+func newTieringJob(
+	sched *scheduler.Scheduler,
+	logger *slog.Logger,
+	objects object.Storer,
+) {
+	tiered, ok := objects.(*object.TieredStorer)
+	if !ok {
+		return
+	}
+
+	j := &tieringJob{
+		logger: logger,
+		tiered: tiered,
+	}
+
+	sched.Register("storage_tiering", DefaultSchedule, j.run)
+}
+
+func (j *tieringJob) run(ctx context.Context) error {
+	moved, err := j.tiered.Demote(ctx, time.Now())
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	j.logger.Debug("moved files to cold storage", slog.Int("files", moved))
+
+	return nil
+}
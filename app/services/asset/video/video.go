@@ -0,0 +1,182 @@
+// Package video transcodes uploaded videos in the background so they can be
+// streamed in browsers regardless of the container or codec they arrived in.
+package video
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+	"github.com/Southclaws/storyden/internal/infrastructure/transcoder"
+)
+
+type Processor struct {
+	logger     *slog.Logger
+	querier    *asset_querier.Querier
+	writer     *asset_writer.Writer
+	objects    object.Storer
+	bus        *pubsub.Bus
+	transcoder transcoder.Transcoder
+}
+
+func New(
+	logger *slog.Logger,
+	querier *asset_querier.Querier,
+	writer *asset_writer.Writer,
+	objects object.Storer,
+	bus *pubsub.Bus,
+	transcoder transcoder.Transcoder,
+) *Processor {
+	return &Processor{
+		logger:     logger,
+		querier:    querier,
+		writer:     writer,
+		objects:    objects,
+		bus:        bus,
+		transcoder: transcoder,
+	}
+}
+
+func (p *Processor) Enabled() bool {
+	_, disabled := p.transcoder.(*transcoder.Disabled)
+	return !disabled
+}
+
+// Enqueue marks a newly uploaded video as pending and queues it for
+// transcoding. Other files, or any file when transcoding is disabled, are
+// left alone.
+func (p *Processor) Enqueue(ctx context.Context, a *asset.Asset) error {
+	if !p.Enabled() || !strings.HasPrefix(a.MIME.String(), "video/") {
+		return nil
+	}
+
+	if err := p.writer.SetProcessingStatus(ctx, a.ID, asset.ProcessingStatusPending); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := p.bus.SendCommand(ctx, &message.CommandTranscodeVideo{ID: a.ID}); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a.Processing = opt.New(asset.ProcessingStatusPending)
+
+	return nil
+}
+
+// Process transcodes a video and stores the result and its poster alongside
+// the original upload. Failures are recorded on the asset rather than retried
+// as transcoding the same file again is unlikely to succeed.
+func (p *Processor) Process(ctx context.Context, id asset.AssetID) error {
+	a, err := p.querier.GetByID(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := p.writer.SetProcessingStatus(ctx, id, asset.ProcessingStatusProcessing); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	metadata, err := p.transcode(ctx, a)
+	if err != nil {
+		p.logger.Error("failed to transcode video",
+			slog.String("error", err.Error()),
+			slog.String("asset_id", id.String()),
+		)
+
+		if err := p.writer.SetProcessingStatus(ctx, id, asset.ProcessingStatusFailed); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return nil
+	}
+
+	if err := p.writer.SetProcessed(ctx, id, metadata); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (p *Processor) transcode(ctx context.Context, a *asset.Asset) (asset.Metadata, error) {
+	dir, err := os.MkdirTemp("", "storyden-video-")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer os.RemoveAll(dir)
+
+	r, _, err := p.objects.Read(ctx, a.Path())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	src := filepath.Join(dir, "source")
+	if err := writeFile(src, r); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := filepath.Join(dir, "out")
+	if err := os.Mkdir(out, 0o755); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := p.transcoder.Transcode(ctx, src, out)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := p.store(ctx, result.Video, asset.BuildVariantPath(a.Name, asset.VideoStreamVariant)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := p.store(ctx, result.Poster, asset.BuildVariantPath(a.Name, asset.VideoPosterVariant)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return asset.Metadata{
+		"width":    float64(result.Width),
+		"height":   float64(result.Height),
+		"duration": result.Duration.Seconds(),
+	}, nil
+}
+
+func (p *Processor) store(ctx context.Context, file string, path string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return p.objects.Write(ctx, path, f, info.Size())
+}
+
+func writeFile(path string, r io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	return err
+}
@@ -0,0 +1,33 @@
+package video_job
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/asset/video"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newVideoJob)
+}
+
+func newVideoJob(
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	processor *video.Processor,
+) {
+	if !processor.Enabled() {
+		return
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.SubscribeCommand(hctx, bus, "video_job.transcode", func(ctx context.Context, cmd *message.CommandTranscodeVideo) error {
+			return processor.Process(ctx, cmd.ID)
+		})
+
+		return err
+	}))
+}
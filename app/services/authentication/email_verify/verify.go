@@ -2,7 +2,6 @@ package email_verify
 
 import (
 	"context"
-	"fmt"
 	"net/mail"
 
 	"github.com/Southclaws/fault"
@@ -12,8 +11,12 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/account/email_domain_policy"
 	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/internal/i18n"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
 var (
@@ -25,20 +28,32 @@ type Verifier struct {
 	emailRepo *email.Repository
 	mailqueue *mailqueue.Queuer
 	settings  *settings.SettingsRepository
+	domains   *email_domain_policy.Policy
+	bus       *pubsub.Bus
 }
 
 func New(
 	emailRepo *email.Repository,
 	mailqueue *mailqueue.Queuer,
 	settings *settings.SettingsRepository,
+	domains *email_domain_policy.Policy,
+	bus *pubsub.Bus,
 ) *Verifier {
 	return &Verifier{
 		emailRepo: emailRepo,
 		mailqueue: mailqueue,
 		settings:  settings,
+		domains:   domains,
+		bus:       bus,
 	}
 }
 
+// CheckDomain lets registration flows reject an address before an account is
+// created for it, BeginEmailVerification performs the same check regardless.
+func (s *Verifier) CheckDomain(ctx context.Context, address mail.Address) error {
+	return s.domains.Check(ctx, address)
+}
+
 // BeginEmailVerification adds an email record for the specified account, sets
 // it to unverified and sends an email to the address with a verification code.
 // You can also optionally supply an authentication record ID to link the email
@@ -49,6 +64,10 @@ func (s *Verifier) BeginEmailVerification(
 	address mail.Address,
 	code string,
 ) (*account.EmailAddress, error) {
+	if err := s.domains.Check(ctx, address); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	ae, err := s.emailRepo.Add(ctx, accountID, address, code)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -65,11 +84,11 @@ func (s *Verifier) sendVerification(ctx context.Context, address mail.Address, c
 
 	recipientName := address.Address
 	instanceTitle := set.Title.Or(settings.DefaultTitle)
-	welcome := fmt.Sprintf("Welcome to %s!", instanceTitle)
+	welcome := i18n.T(ctx, "Welcome to {community}!", "community", instanceTitle)
 
 	return s.mailqueue.Queue(ctx, address, recipientName, welcome, []string{welcome}, []hermes.Action{
 		{
-			Instructions: "Please use the following code to verify your account:",
+			Instructions: i18n.T(ctx, "Please use the following code to verify your account:"),
 			InviteCode:   code,
 		},
 	})
@@ -102,5 +121,11 @@ func (s *Verifier) Verify(ctx context.Context, emailAddress mail.Address, code s
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if err := s.domains.Apply(ctx, acc.ID, emailAddress); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s.bus.Publish(ctx, &message.EventAccountEmailVerified{ID: acc.ID})
+
 	return acc, nil
 }
@@ -14,7 +14,6 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
-	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/email"
 	"github.com/Southclaws/storyden/app/resources/settings"
@@ -100,6 +99,10 @@ func (p *Provider) Register(ctx context.Context, email mail.Address, handle opt.
 		}
 	}
 
+	if err := p.sender.CheckDomain(ctx, email); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	_, exists, err := p.er.LookupAccount(ctx, email)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -118,10 +121,7 @@ func (p *Provider) Register(ctx context.Context, email mail.Address, handle opt.
 			fmsg.WithDesc("exists", "The specified email address has already been registered."))
 	}
 
-	opts := []account_writer.Option{}
-	inviteCode.Call(func(id xid.ID) { opts = append(opts, account_writer.WithInvitedBy(id)) })
-
-	account, err := p.register.Create(ctx, handle, opts...)
+	account, err := p.register.Create(ctx, handle, inviteCode)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create account"))
 	}
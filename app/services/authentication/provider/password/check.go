@@ -0,0 +1,43 @@
+package password
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/alexedwards/argon2id"
+
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/password/legacy_password"
+)
+
+// checkPassword compares a password with an account's stored hash. Accounts
+// imported from other forum software may still have the hash from there, which
+// is replaced with a Storyden hash the first time it's used successfully.
+func (p *Provider) checkPassword(ctx context.Context, auth *authentication.Authentication, password string) (bool, error) {
+	match, err := legacy_password.Check(password, auth.Token)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to compare secure password hash"))
+	}
+
+	if !match || !legacy_password.IsLegacy(auth.Token) {
+		return match, nil
+	}
+
+	hashed, err := argon2id.CreateHash(password, argon2id.DefaultParams)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create secure password hash"))
+	}
+
+	if _, err := p.auth.Update(ctx, auth.ID, authentication.WithToken(hashed)); err != nil {
+		// The member can still sign in, the upgrade is tried again next time.
+		p.logger.Warn("failed to upgrade legacy password hash",
+			slog.String("account_id", auth.Account.ID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return true, nil
+}
@@ -9,11 +9,9 @@ import (
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
-	"github.com/alexedwards/argon2id"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
-	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	"github.com/Southclaws/storyden/app/services/authentication/provider/password/password_reset"
 )
 
@@ -47,6 +45,10 @@ func (p *Provider) RegisterWithEmail(ctx context.Context, email mail.Address, pa
 		}
 	}
 
+	if err := p.sender.CheckDomain(ctx, email); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	_, exists, err := p.er.LookupAccount(ctx, email)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -58,10 +60,7 @@ func (p *Provider) RegisterWithEmail(ctx context.Context, email mail.Address, pa
 			fmsg.WithDesc("exists", "The specified email has already been registered."))
 	}
 
-	opts := []account_writer.Option{}
-	inviteCode.Call(func(id xid.ID) { opts = append(opts, account_writer.WithInvitedBy(id)) })
-
-	account, err := p.register.Create(ctx, handle, opts...)
+	account, err := p.register.Create(ctx, handle, inviteCode)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create account"))
 	}
@@ -117,9 +116,9 @@ func (p *Provider) LoginWithEmail(ctx context.Context, emailAddress mail.Address
 			fmsg.WithDesc("no password", "The specified account does not use email-password authentication. Please try a different method."))
 	}
 
-	match, _, err := argon2id.CheckHash(password, a.Token)
+	match, err := p.checkPassword(ctx, a, password)
 	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to compare secure password hash"))
+		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
 	if !match {
@@ -0,0 +1,148 @@
+// Package legacy_password verifies password hashes imported from other forum
+// software so members can sign in with the password they already had. Once a
+// legacy hash has been used to sign in, it should be replaced with a hash of
+// Storyden's own so the weaker schemes don't stick around.
+package legacy_password
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/alexedwards/argon2id"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Schemes describe how the hash was made. The scheme is stored along with the
+// hash so the right algorithm is used to check it.
+const (
+	// SchemePHPBB covers every hash phpBB has used: phpass portable hashes,
+	// bcrypt, argon2id and the plain MD5 of passwords converted from phpBB 2.
+	SchemePHPBB = "phpbb"
+
+	// SchemeVBulletin is vBulletin 3 and 4's md5(md5(password) + salt).
+	SchemeVBulletin = "vbulletin"
+)
+
+const prefix = "legacy:"
+
+// Encode returns the token to store for an imported hash. The vBulletin scheme
+// needs the salt, which is stored with the hash.
+func Encode(scheme string, hash string, salt string) string {
+	if salt != "" {
+		return prefix + scheme + ":" + hash + ":" + salt
+	}
+	return prefix + scheme + ":" + hash
+}
+
+// IsLegacy is true for tokens made with Encode.
+func IsLegacy(token string) bool {
+	return strings.HasPrefix(token, prefix)
+}
+
+// Check compares a password against a stored token, which may be either an
+// argon2id hash made by Storyden or a legacy hash made with Encode.
+func Check(password string, token string) (bool, error) {
+	rest, ok := strings.CutPrefix(token, prefix)
+	if !ok {
+		match, _, err := argon2id.CheckHash(password, token)
+		return match, err
+	}
+
+	scheme, hash, _ := strings.Cut(rest, ":")
+	switch scheme {
+	case SchemePHPBB:
+		return checkPHPBB(password, hash), nil
+
+	case SchemeVBulletin:
+		hash, salt, _ := strings.Cut(hash, ":")
+		sum := md5Hex(md5Hex(password) + salt)
+		return equal(sum, strings.ToLower(hash)), nil
+
+	default:
+		return false, nil
+	}
+}
+
+func checkPHPBB(password string, hash string) bool {
+	switch {
+	case strings.HasPrefix(hash, "$H$"), strings.HasPrefix(hash, "$P$"):
+		return equal(phpass(password, hash), hash)
+
+	case strings.HasPrefix(hash, "$2"):
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+
+	case strings.HasPrefix(hash, "$argon2id$"):
+		match, _, err := argon2id.CheckHash(password, hash)
+		return err == nil && match
+
+	case strings.HasPrefix(hash, "$CP$"):
+		return checkPHPBB(password, strings.TrimPrefix(hash, "$CP$"))
+
+	case len(hash) == 32:
+		return equal(md5Hex(password), strings.ToLower(hash))
+
+	default:
+		return false
+	}
+}
+
+const itoa64 = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// phpass computes the portable hash used by phpBB 3.0 and WordPress: MD5
+// iterated 2^n times over a salt, encoded in its own base64 alphabet.
+func phpass(password string, setting string) string {
+	if len(setting) < 12 {
+		return ""
+	}
+
+	rounds := strings.IndexByte(itoa64, setting[3])
+	if rounds < 7 || rounds > 30 {
+		return ""
+	}
+
+	salt := setting[4:12]
+	sum := md5.Sum([]byte(salt + password))
+	for range 1 << rounds {
+		sum = md5.Sum(append(sum[:], password...))
+	}
+
+	return setting[:12] + encode64(sum[:])
+}
+
+func encode64(in []byte) string {
+	var sb strings.Builder
+	for i := 0; i < len(in); {
+		v := int(in[i])
+		i++
+		sb.WriteByte(itoa64[v&0x3f])
+		if i < len(in) {
+			v |= int(in[i]) << 8
+		}
+		sb.WriteByte(itoa64[(v>>6)&0x3f])
+		if i >= len(in) {
+			break
+		}
+		i++
+		if i < len(in) {
+			v |= int(in[i]) << 16
+		}
+		sb.WriteByte(itoa64[(v>>12)&0x3f])
+		if i >= len(in) {
+			break
+		}
+		i++
+		sb.WriteByte(itoa64[(v>>18)&0x3f])
+	}
+	return sb.String()
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func equal(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,50 @@
+package legacy_password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCheck(t *testing.T) {
+	r := require.New(t)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	r.NoError(err)
+	// PHP's password_hash writes the same hashes with a 2y prefix.
+	phpBcrypt := "$2y$" + strings.TrimPrefix(string(bcryptHash), "$2a$")
+
+	argonHash, err := argon2id.CreateHash("correct horse", argon2id.DefaultParams)
+	r.NoError(err)
+
+	cases := []struct {
+		name     string
+		token    string
+		password string
+		match    bool
+	}{
+		{"storyden", argonHash, "correct horse", true},
+		{"storyden_wrong", argonHash, "battery staple", false},
+		{"phpass", Encode(SchemePHPBB, "$H$9abcdefghPDonBA7cb6Wv7y6IfLfEF.", ""), "correct horse", true},
+		{"phpass_wrong", Encode(SchemePHPBB, "$H$9abcdefghPDonBA7cb6Wv7y6IfLfEF.", ""), "correct horsE", false},
+		{"phpbb_bcrypt", Encode(SchemePHPBB, phpBcrypt, ""), "correct horse", true},
+		{"phpbb_argon2id", Encode(SchemePHPBB, argonHash, ""), "correct horse", true},
+		{"phpbb2_md5", Encode(SchemePHPBB, "cb95015a436fe976eb38e45455372032", ""), "hunter22", true},
+		{"phpbb_converted", Encode(SchemePHPBB, "$CP$cb95015a436fe976eb38e45455372032", ""), "hunter22", true},
+		{"vbulletin", Encode(SchemeVBulletin, "00436c135bbae9a57708bd8985de9f55", "x:y"), "hunter22", true},
+		{"vbulletin_wrong_salt", Encode(SchemeVBulletin, "00436c135bbae9a57708bd8985de9f55", "x:z"), "hunter22", false},
+		{"unknown_scheme", "legacy:smf:abc", "hunter22", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			match, err := Check(c.password, c.token)
+			require.NoError(t, err)
+			assert.Equal(t, c.match, match)
+		})
+	}
+}
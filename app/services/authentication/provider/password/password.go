@@ -145,9 +145,9 @@ func (b *Provider) UpdatePassword(ctx context.Context, aid account.AccountID, ol
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	match, _, err := argon2id.CheckHash(oldpassword, auth.Token)
+	match, err := b.checkPassword(ctx, auth, oldpassword)
 	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to compare secure password hash"))
+		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
 	if !match {
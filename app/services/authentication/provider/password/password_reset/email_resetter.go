@@ -2,7 +2,6 @@ package password_reset
 
 import (
 	"context"
-	"fmt"
 	"net/mail"
 
 	"github.com/Southclaws/fault"
@@ -15,6 +14,8 @@ import (
 	"github.com/Southclaws/storyden/app/resources/settings"
 	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
 	"github.com/Southclaws/storyden/app/services/comms/mailtemplate"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/internal/i18n"
 	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
 )
 
@@ -29,6 +30,7 @@ type EmailResetter struct {
 	sender        mailer.Sender
 	mailqueue     *mailqueue.Queuer
 	settings      *settings.SettingsRepository
+	locales       *locale.Resolver
 }
 
 func NewEmailResetter(
@@ -37,6 +39,7 @@ func NewEmailResetter(
 	sender mailer.Sender,
 	mailqueue *mailqueue.Queuer,
 	settings *settings.SettingsRepository,
+	locales *locale.Resolver,
 ) *EmailResetter {
 	return &EmailResetter{
 		tokenProvider: tokenProvider,
@@ -44,6 +47,7 @@ func NewEmailResetter(
 		sender:        sender,
 		mailqueue:     mailqueue,
 		settings:      settings,
+		locales:       locales,
 	}
 }
 
@@ -60,6 +64,13 @@ func (s *EmailResetter) SendPasswordReset(
 
 	link := lt.GetURL(token)
 
+	// The person asking for the reset isn't signed in, so the email is written
+	// in the account's language rather than the language of the request.
+	ctx, err = s.locales.ForAccountID(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
 	return s.sendResetEmail(ctx, address, link)
 }
 
@@ -71,13 +82,13 @@ func (s *EmailResetter) sendResetEmail(ctx context.Context, address mail.Address
 
 	recipientName := address.Address
 	instanceTitle := set.Title.Or(settings.DefaultTitle)
-	welcome := fmt.Sprintf("Reset your password on %s!", instanceTitle)
+	welcome := i18n.T(ctx, "Reset your password on {community}!", "community", instanceTitle)
 
 	return s.mailqueue.Queue(ctx, address, recipientName, welcome, []string{welcome}, []mailtemplate.Action{
 		{
-			Instructions: "Click the link below to reset your password.",
+			Instructions: i18n.T(ctx, "Click the link below to reset your password."),
 			Button: hermes.Button{
-				Text: "Reset password",
+				Text: i18n.T(ctx, "Reset password"),
 				Link: link,
 			},
 		},
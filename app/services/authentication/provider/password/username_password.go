@@ -8,11 +8,9 @@ import (
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
-	"github.com/alexedwards/argon2id"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
-	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/services/authentication/provider"
 )
@@ -41,10 +39,7 @@ func (p *Provider) RegisterWithHandle(ctx context.Context, handle string, passwo
 			fmsg.WithDesc("exists", "The specified handle has already been registered."))
 	}
 
-	opts := []account_writer.Option{}
-	inviteCode.Call(func(id xid.ID) { opts = append(opts, account_writer.WithInvitedBy(id)) })
-
-	account, err := p.register.Create(ctx, opt.New(handle), opts...)
+	account, err := p.register.Create(ctx, opt.New(handle), inviteCode)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create account"))
 	}
@@ -92,9 +87,9 @@ func (b *Provider) LoginWithHandle(ctx context.Context, handle string, password
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	match, _, err := argon2id.CheckHash(password, a.Token)
+	match, err := b.checkPassword(ctx, a, password)
 	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to compare secure password hash"))
+		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
 	if !match {
@@ -23,6 +23,7 @@ import (
 	"github.com/Southclaws/storyden/app/services/authentication/provider/phone"
 	"github.com/Southclaws/storyden/app/services/authentication/provider/webauthn"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/locale"
 )
 
 type Manager struct {
@@ -48,6 +49,7 @@ func Build() fx.Option {
 		fx.Provide(email_verify.New),
 		fx.Provide(password_reset.NewTokenProvider, password_reset.NewEmailResetter),
 		fx.Provide(New, session.NewValidator, session.NewIssuer),
+		fx.Provide(func(r *locale.Resolver) session.Hinter { return r }),
 	)
 }
 
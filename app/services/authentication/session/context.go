@@ -11,6 +11,7 @@ import (
 	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/rbac"
 )
@@ -33,6 +34,9 @@ type sessionContext struct {
 	// sessionToken stores the session token for later revocation during logout.
 	// This is only populated for browser sessions, not access keys.
 	sessionToken opt.Optional[string]
+
+	// accessKey is the ID of the access key used to authenticate the request.
+	accessKey opt.Optional[authentication.ID]
 }
 
 func WithAccount(ctx context.Context, u account.Account, roles role.Roles) context.Context {
@@ -53,12 +57,13 @@ func WithAccountAndToken(ctx context.Context, u account.Account, roles role.Role
 	})
 }
 
-func WithAccessKey(ctx context.Context, u account.Account, roles role.Roles) context.Context {
+func WithAccessKey(ctx context.Context, u account.Account, roles role.Roles, keyID authentication.ID) context.Context {
 	return context.WithValue(ctx, contextKey, sessionContext{
 		account:        opt.New(u),
 		roles:          roles,
 		securityScheme: "access_key",
 		sessionToken:   opt.NewEmpty[string](),
+		accessKey:      opt.New(keyID),
 	})
 }
 
@@ -108,6 +113,27 @@ func GetRoles(ctx context.Context) role.Roles {
 	return sc.roles
 }
 
+// GetOptRoles is GetRoles for callers which may run before a session context
+// has been set up, such as HTTP middleware, it returns no roles in that case.
+func GetOptRoles(ctx context.Context) role.Roles {
+	sc, ok := ctx.Value(contextKey).(sessionContext)
+	if !ok {
+		return nil
+	}
+
+	return sc.roles
+}
+
+// GetOptAccessKeyID returns the ID of the access key used for the request.
+func GetOptAccessKeyID(ctx context.Context) opt.Optional[authentication.ID] {
+	sc, ok := ctx.Value(contextKey).(sessionContext)
+	if !ok {
+		return opt.NewEmpty[authentication.ID]()
+	}
+
+	return sc.accessKey
+}
+
 // GetAccountID pulls out an account ID associated with the call.
 func GetAccountID(ctx context.Context) (account.AccountID, error) {
 	value := ctx.Value(contextKey)
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
@@ -10,13 +11,23 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/token"
 )
 
+// Hinter fills in an account's preferences, such as its language and timezone,
+// from hints sent with the request which signed it in.
+type Hinter interface {
+	ApplyHints(ctx context.Context, accountID account.AccountID) error
+}
+
 type Issuer struct {
+	logger    *slog.Logger
 	tokenRepo token.Repository
+	hinter    Hinter
 }
 
-func NewIssuer(tokenRepo token.Repository) *Issuer {
+func NewIssuer(logger *slog.Logger, tokenRepo token.Repository, hinter Hinter) *Issuer {
 	return &Issuer{
+		logger:    logger,
 		tokenRepo: tokenRepo,
+		hinter:    hinter,
 	}
 }
 
@@ -26,5 +37,12 @@ func (s *Issuer) Issue(ctx context.Context, accountID account.AccountID) (*token
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// Signing in is the first chance to learn the member's language and
+	// timezone from their browser, but not being able to isn't worth failing
+	// the sign in over.
+	if err := s.hinter.ApplyHints(ctx, accountID); err != nil {
+		s.logger.Warn("failed to apply locale hints", slog.String("error", err.Error()))
+	}
+
 	return &t.Token, nil
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 
+	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
 	"github.com/Southclaws/storyden/app/resources/account/role"
@@ -13,6 +15,11 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/token"
 )
 
+var (
+	errBotSession    = fault.New("bot accounts can only authenticate with bot access keys", ftag.With(ftag.Unauthenticated))
+	errAccessKeyKind = fault.New("access key kind does not match account kind", ftag.With(ftag.Unauthenticated))
+)
+
 type Validator struct {
 	tokenRepo      token.Repository
 	accountQuerier *account_querier.Querier
@@ -62,6 +69,10 @@ func (v *Validator) ValidateSessionToken(ctx context.Context, raw string) (conte
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if acc.Kind == account.AccountKindBot {
+		return nil, fault.Wrap(errBotSession, fctx.With(ctx))
+	}
+
 	return WithAccountAndToken(ctx, acc.Account, acc.Roles.Roles(), raw), nil
 }
 
@@ -96,7 +107,13 @@ func (v *Validator) ValidateAccessKeyToken(ctx context.Context, raw string) (con
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	return WithAccessKey(ctx, ar.Account, acc.Roles.Roles()), nil
+	// Bot keys are only issued to bots and bots can't use personal keys.
+	isBot := acc.Kind == account.AccountKindBot
+	if isBot != (ak.GetKind() == access_key.AccessKeyKindBot) {
+		return nil, fault.Wrap(errAccessKeyKind, fctx.With(ctx))
+	}
+
+	return WithAccessKey(ctx, ar.Account, acc.Roles.Roles(), ar.ID), nil
 }
 
 func (v *Validator) WithUnauthenticatedRoles(ctx context.Context) (context.Context, error) {
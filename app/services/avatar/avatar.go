@@ -10,6 +10,7 @@ import (
 	"github.com/Southclaws/fault/fctx"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/message"
 )
 
 func avatarPath(aid account.AccountID) string {
@@ -30,6 +31,8 @@ func (s *service) Set(ctx context.Context, accountID account.AccountID, stream i
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
+	s.bus.Publish(ctx, &message.EventAccountAvatarUpdated{ID: accountID})
+
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/services/avatar_gen"
 	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
 type Service interface {
@@ -26,16 +27,19 @@ type service struct {
 	accountQuery *account_querier.Querier
 	generator    avatar_gen.AvatarGenerator
 	storage      object.Storer
+	bus          *pubsub.Bus
 }
 
 func New(
 	accountQuery *account_querier.Querier,
 	generator avatar_gen.AvatarGenerator,
 	storage object.Storer,
+	bus *pubsub.Bus,
 ) Service {
 	return &service{
 		accountQuery: accountQuery,
 		generator:    generator,
 		storage:      storage,
+		bus:          bus,
 	}
 }
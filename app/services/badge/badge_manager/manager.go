@@ -0,0 +1,115 @@
+package badge_manager
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/badge"
+	"github.com/Southclaws/storyden/app/resources/badge/badge_querier"
+	"github.com/Southclaws/storyden/app/resources/badge/badge_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/internal/deletable"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+type Manager struct {
+	badgeQuerier *badge_querier.Querier
+	badgeWriter  *badge_writer.Writer
+	bus          *pubsub.Bus
+}
+
+func New(
+	badgeQuerier *badge_querier.Querier,
+	badgeWriter *badge_writer.Writer,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		badgeQuerier: badgeQuerier,
+		badgeWriter:  badgeWriter,
+		bus:          bus,
+	}
+}
+
+type Partial struct {
+	Name        opt.Optional[string]
+	Description opt.Optional[string]
+	Icon        deletable.Value[string]
+	Rule        deletable.Value[badge.Rule]
+}
+
+func (p Partial) Opts() []badge_writer.Option {
+	opts := []badge_writer.Option{}
+
+	p.Name.Call(func(v string) { opts = append(opts, badge_writer.WithName(v)) })
+	p.Description.Call(func(v string) { opts = append(opts, badge_writer.WithDescription(v)) })
+	p.Icon.Call(
+		func(v string) { opts = append(opts, badge_writer.WithIcon(opt.New(v))) },
+		func() { opts = append(opts, badge_writer.WithIcon(opt.NewEmpty[string]())) },
+	)
+	p.Rule.Call(
+		func(v badge.Rule) { opts = append(opts, badge_writer.WithRule(opt.New(v))) },
+		func() { opts = append(opts, badge_writer.WithRule(opt.NewEmpty[badge.Rule]())) },
+	)
+
+	return opts
+}
+
+func (m *Manager) Create(ctx context.Context, name string, description string, partial Partial) (*badge.Badge, error) {
+	b, err := m.badgeWriter.Create(ctx, name, description, partial.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+func (m *Manager) Update(ctx context.Context, id badge.BadgeID, partial Partial) (*badge.Badge, error) {
+	b, err := m.badgeWriter.Update(ctx, id, partial.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, id badge.BadgeID) error {
+	if err := m.badgeWriter.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Award manually gives a badge to a member, regardless of the badge's rule.
+func (m *Manager) Award(ctx context.Context, accountID account.AccountID, id badge.BadgeID) (badge.Awards, error) {
+	if _, err := m.badgeQuerier.Get(ctx, id); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	awarded, err := m.badgeWriter.Award(ctx, accountID, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if awarded {
+		m.bus.Publish(ctx, &message.EventBadgeAwarded{
+			AccountID: accountID,
+			BadgeID:   xid.ID(id),
+		})
+	}
+
+	return m.badgeQuerier.ListAwarded(ctx, accountID)
+}
+
+func (m *Manager) Revoke(ctx context.Context, accountID account.AccountID, id badge.BadgeID) (badge.Awards, error) {
+	if err := m.badgeWriter.Revoke(ctx, accountID, id); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.badgeQuerier.ListAwarded(ctx, accountID)
+}
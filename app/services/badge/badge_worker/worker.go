@@ -0,0 +1,167 @@
+// Package badge_worker evaluates badge rules as members take part in the
+// community and awards any badges whose criteria have been met.
+package badge_worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/badge"
+	"github.com/Southclaws/storyden/app/resources/badge/badge_querier"
+	"github.com/Southclaws/storyden/app/resources/badge/badge_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_querier"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newWorker)
+}
+
+type worker struct {
+	logger            *slog.Logger
+	bus               *pubsub.Bus
+	badgeQuerier      *badge_querier.Querier
+	badgeWriter       *badge_writer.Writer
+	reputationQuerier *reputation_querier.Querier
+}
+
+func newWorker(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	badgeQuerier *badge_querier.Querier,
+	badgeWriter *badge_writer.Writer,
+	reputationQuerier *reputation_querier.Querier,
+) {
+	w := &worker{
+		logger:            logger,
+		bus:               bus,
+		badgeQuerier:      badgeQuerier,
+		badgeWriter:       badgeWriter,
+		reputationQuerier: reputationQuerier,
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if err := w.seed(hctx); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "badge.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			return w.evaluateForPostAuthor(ctx, evt.ID, badge.TriggerThreadPublished)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "badge.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+			return w.evaluate(ctx, evt.ReplyAuthorID, badge.TriggerReplyCreated)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "badge.post_liked", func(ctx context.Context, evt *message.EventPostLiked) error {
+			return w.evaluateForPostAuthor(ctx, evt.PostID, badge.TriggerLikeReceived)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "badge.post_reacted", func(ctx context.Context, evt *message.EventPostReacted) error {
+			return w.evaluateForPostAuthor(ctx, evt.PostID, badge.TriggerReactionReceived)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "badge.answer_accepted", func(ctx context.Context, evt *message.EventThreadAnswerAccepted) error {
+			return w.evaluateForPostAuthor(ctx, evt.ReplyID, badge.TriggerAnswerAccepted)
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	}))
+}
+
+func (w *worker) seed(ctx context.Context) error {
+	existing, err := w.badgeQuerier.List(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(existing) > 0 {
+		return nil
+	}
+
+	return w.badgeWriter.EnsureBuiltin(ctx, badge.Builtin)
+}
+
+func (w *worker) evaluateForPostAuthor(ctx context.Context, postID post.ID, trigger badge.Trigger) error {
+	author, err := w.reputationQuerier.PostAuthor(ctx, postID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w.evaluate(ctx, author, trigger)
+}
+
+// evaluate checks the badges for the trigger as well as reputation badges, as
+// almost any activity may have changed the member's reputation too.
+func (w *worker) evaluate(ctx context.Context, accountID account.AccountID, trigger badge.Trigger) error {
+	for _, t := range []badge.Trigger{trigger, badge.TriggerReputation} {
+		if err := w.evaluateTrigger(ctx, accountID, t); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (w *worker) evaluateTrigger(ctx context.Context, accountID account.AccountID, trigger badge.Trigger) error {
+	badges, err := w.badgeQuerier.ListByTrigger(ctx, trigger)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(badges) == 0 {
+		return nil
+	}
+
+	progress, err := w.badgeQuerier.Progress(ctx, accountID, trigger)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, b := range badges {
+		rule, ok := b.Rule.Get()
+		if !ok || !rule.Met(progress) {
+			continue
+		}
+
+		awarded, err := w.badgeWriter.Award(ctx, accountID, b.ID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if !awarded {
+			continue
+		}
+
+		w.logger.Debug("awarded badge",
+			slog.String("account_id", accountID.String()),
+			slog.String("badge", b.Name),
+		)
+
+		w.bus.Publish(ctx, &message.EventBadgeAwarded{
+			AccountID: accountID,
+			BadgeID:   xid.ID(b.ID),
+		})
+	}
+
+	return nil
+}
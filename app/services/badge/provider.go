@@ -0,0 +1,15 @@
+package badge
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/badge/badge_manager"
+	"github.com/Southclaws/storyden/app/services/badge/badge_worker"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(badge_manager.New),
+		badge_worker.Build(),
+	)
+}
@@ -0,0 +1,101 @@
+// Package batch applies the same action to many resources in one request.
+//
+// Every item is checked before anything is changed. If any item can't be
+// acted on, nothing is applied and the result reports why for each item that
+// failed. Otherwise all items are applied together in a single transaction.
+package batch
+
+import (
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_item"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/library/node_writer"
+	"github.com/Southclaws/storyden/app/resources/post/post_search"
+	"github.com/Southclaws/storyden/app/resources/post/post_writer"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+	"github.com/Southclaws/storyden/app/services/collection/collection_item_manager"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// MaxItems is the most items a single batch may contain.
+const MaxItems = 100
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Result struct {
+	// Applied is true when every item was applied, it's never partial.
+	Applied bool
+	Items   []ItemResult
+}
+
+type ItemResult struct {
+	ID    xid.ID
+	Error opt.Optional[error]
+}
+
+type Batcher struct {
+	accountQuery *account_querier.Querier
+	postSearch   post_search.Repository
+	postWriter   *post_writer.PostWriter
+	colQuerier   *collection_querier.Querier
+	itemRepo     *collection_item.Repository
+	itemManager  *collection_item_manager.Manager
+	nodeQuerier  *node_querier.Querier
+	nodeWriter   *node_writer.Writer
+	tagWriter    *tag_writer.Writer
+	bus          *pubsub.Bus
+}
+
+func New(
+	accountQuery *account_querier.Querier,
+	postSearch post_search.Repository,
+	postWriter *post_writer.PostWriter,
+	colQuerier *collection_querier.Querier,
+	itemRepo *collection_item.Repository,
+	itemManager *collection_item_manager.Manager,
+	nodeQuerier *node_querier.Querier,
+	nodeWriter *node_writer.Writer,
+	tagWriter *tag_writer.Writer,
+	bus *pubsub.Bus,
+) *Batcher {
+	return &Batcher{
+		accountQuery: accountQuery,
+		postSearch:   postSearch,
+		postWriter:   postWriter,
+		colQuerier:   colQuerier,
+		itemRepo:     itemRepo,
+		itemManager:  itemManager,
+		nodeQuerier:  nodeQuerier,
+		nodeWriter:   nodeWriter,
+		tagWriter:    tagWriter,
+		bus:          bus,
+	}
+}
+
+// check runs fn against each item and collects the results, the returned bool
+// is true only if every item passed.
+func check[T any](items []T, id func(T) xid.ID, fn func(T) error) ([]ItemResult, bool) {
+	ok := true
+	results := make([]ItemResult, 0, len(items))
+
+	for _, item := range items {
+		err := fn(item)
+		if err != nil {
+			ok = false
+		}
+
+		results = append(results, ItemResult{
+			ID:    id(item),
+			Error: opt.NewIf(err, func(e error) bool { return e != nil }),
+		})
+	}
+
+	return results, ok
+}
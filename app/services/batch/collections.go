@@ -0,0 +1,83 @@
+package batch
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_item"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+// AddToCollection adds posts and nodes to a collection. Items are submitted for
+// review or added directly following the same rules as adding a single item.
+func (b *Batcher) AddToCollection(ctx context.Context, qk collection.QueryKey, posts []post.ID, nodes []library.NodeID) (*Result, error) {
+	posts = lo.Uniq(posts)
+	nodes = lo.Uniq(nodes)
+	if err := validateSize(ctx, len(posts)+len(nodes)); err != nil {
+		return nil, err
+	}
+
+	if _, err := session.GetAccountID(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := b.colQuerier.Probe(ctx, qk); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	opts := make([]collection_item.ItemOption, 0, len(posts)+len(nodes))
+
+	found, err := b.getPosts(ctx, posts)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	postResults, postsOK := check(posts, func(id post.ID) xid.ID { return xid.ID(id) }, func(id post.ID) error {
+		if _, err := found.get(ctx, id); err != nil {
+			return err
+		}
+
+		mt, err := b.itemManager.AuthoriseSubmission(ctx, qk, xid.ID(id))
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		opts = append(opts, collection_item.WithPost(id, mt))
+
+		return nil
+	})
+
+	nodeResults, nodesOK := check(nodes, func(id library.NodeID) xid.ID { return xid.ID(id) }, func(id library.NodeID) error {
+		if _, err := b.nodeQuerier.Probe(ctx, id); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		mt, err := b.itemManager.AuthoriseSubmission(ctx, qk, xid.ID(id))
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		opts = append(opts, collection_item.WithNode(id, mt))
+
+		return nil
+	})
+
+	results := append(postResults, nodeResults...)
+
+	if !postsOK || !nodesOK {
+		return &Result{Items: results}, nil
+	}
+
+	if _, err := b.itemRepo.UpdateItems(ctx, qk, opts...); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Result{Applied: true, Items: results}, nil
+}
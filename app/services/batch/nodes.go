@@ -0,0 +1,86 @@
+package batch
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/library/node_auth"
+)
+
+// TagNodes adds tags to every node, tags which don't exist yet are created.
+// Existing tags on the nodes are left as they are.
+func (b *Batcher) TagNodes(ctx context.Context, ids []library.NodeID, tags tag_ref.Names) (*Result, error) {
+	ids = lo.Uniq(ids)
+	if err := validateSize(ctx, len(ids)); err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		return nil, fault.New("no tags",
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("no tags", "At least one tag must be specified."),
+		)
+	}
+
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := b.accountQuery.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes := make([]*library.Node, 0, len(ids))
+
+	results, ok := check(ids, func(id library.NodeID) xid.ID { return xid.ID(id) }, func(id library.NodeID) error {
+		n, err := b.nodeQuerier.Probe(ctx, id)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := node_auth.AuthoriseNodeMutation(ctx, acc, n); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		nodes = append(nodes, n)
+
+		return nil
+	})
+	if !ok {
+		return &Result{Items: results}, nil
+	}
+
+	created, err := b.tagWriter.Add(ctx, tags...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tagIDs := dt.Map(created, func(t *tag_ref.Tag) tag_ref.ID { return t.ID })
+
+	if err := b.nodeWriter.AddTags(ctx, ids, tagIDs...); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, n := range nodes {
+		b.bus.Publish(ctx, &message.EventNodeUpdated{
+			ID:   library.NodeID(n.Mark.ID()),
+			Slug: n.GetSlug(),
+		})
+	}
+
+	return &Result{Applied: true, Items: results}, nil
+}
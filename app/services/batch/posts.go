@@ -0,0 +1,116 @@
+package batch
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+// DeletePosts deletes threads and replies, the same permissions apply as when
+// deleting each post individually.
+func (b *Batcher) DeletePosts(ctx context.Context, ids []post.ID) (*Result, error) {
+	ids = lo.Uniq(ids)
+	if err := validateSize(ctx, len(ids)); err != nil {
+		return nil, err
+	}
+
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := b.accountQuery.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	posts, err := b.getPosts(ctx, ids)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	results, ok := check(ids, func(id post.ID) xid.ID { return xid.ID(id) }, func(id post.ID) error {
+		p, err := posts.get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return acc.Roles.Permissions().Authorise(ctx, func() error {
+			if p.Author.ID != accountID {
+				return fault.Wrap(rbac.ErrPermissions,
+					fctx.With(ctx),
+					fmsg.WithDesc("not owner", "You are not the owner of the post and do not have the Manage Posts permission."))
+			}
+			return nil
+		}, rbac.PermissionManagePosts)
+	})
+	if !ok {
+		return &Result{Items: results}, nil
+	}
+
+	if err := b.postWriter.DeleteMany(ctx, ids...); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, id := range ids {
+		p := posts[id]
+		if p.Root == p.ID {
+			b.bus.Publish(ctx, &message.EventThreadDeleted{
+				ID: p.ID,
+			})
+		} else {
+			b.bus.Publish(ctx, &message.EventThreadReplyDeleted{
+				ThreadID: p.Root,
+				ReplyID:  p.ID,
+			})
+		}
+	}
+
+	return &Result{Applied: true, Items: results}, nil
+}
+
+type postLookup map[post.ID]*post.Post
+
+func (b *Batcher) getPosts(ctx context.Context, ids []post.ID) (postLookup, error) {
+	posts, err := b.postSearch.GetMany(ctx, ids...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return lo.KeyBy(posts, func(p *post.Post) post.ID { return p.ID }), nil
+}
+
+func (l postLookup) get(ctx context.Context, id post.ID) (*post.Post, error) {
+	p, ok := l[id]
+	if !ok || p.DeletedAt.Ok() {
+		return nil, fault.New("post not found",
+			fctx.With(ctx),
+			ftag.With(ftag.NotFound),
+			fmsg.WithDesc("not found", "The post does not exist or has been deleted."),
+		)
+	}
+
+	return p, nil
+}
+
+func validateSize(ctx context.Context, n int) error {
+	if n == 0 || n > MaxItems {
+		return fault.New("invalid batch size",
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid batch size", "A batch must contain between 1 and 100 items."),
+		)
+	}
+
+	return nil
+}
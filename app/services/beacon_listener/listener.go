@@ -10,18 +10,21 @@ import (
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/post_read_state"
+	"github.com/Southclaws/storyden/app/services/account/reading_tracker"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
 type listener struct {
 	logger              *slog.Logger
 	postReadStateWriter *post_read_state.Writer
+	readingTracker      *reading_tracker.Tracker
 }
 
-func newListener(logger *slog.Logger, postReadStateWriter *post_read_state.Writer) *listener {
+func newListener(logger *slog.Logger, postReadStateWriter *post_read_state.Writer, readingTracker *reading_tracker.Tracker) *listener {
 	return &listener{
 		logger:              logger,
 		postReadStateWriter: postReadStateWriter,
+		readingTracker:      readingTracker,
 	}
 }
 
@@ -47,6 +50,10 @@ func (l *listener) handleBeacon(ctx context.Context, cmd *message.CommandSendBea
 		}
 	}
 
+	if err := l.readingTracker.Record(ctx, cmd); err != nil {
+		log.Error("failed to record reading history", slog.String("error", err.Error()))
+	}
+
 	return nil
 }
 
@@ -0,0 +1,94 @@
+// Package bookmarker manages members' private bookmarks of threads, replies,
+// library pages and profiles.
+package bookmarker
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/bookmark"
+	"github.com/Southclaws/storyden/app/resources/bookmark/bookmark_querier"
+	"github.com/Southclaws/storyden/app/resources/bookmark/bookmark_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+)
+
+var (
+	ErrUnsupportedKind = fault.New("items of this kind cannot be bookmarked", ftag.With(ftag.InvalidArgument))
+	ErrItemNotFound    = fault.New("bookmarked item not found", ftag.With(ftag.NotFound))
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Bookmarker struct {
+	querier  *bookmark_querier.Querier
+	writer   *bookmark_writer.Writer
+	hydrator *hydrate.Hydrator
+}
+
+func New(
+	querier *bookmark_querier.Querier,
+	writer *bookmark_writer.Writer,
+	hydrator *hydrate.Hydrator,
+) *Bookmarker {
+	return &Bookmarker{
+		querier:  querier,
+		writer:   writer,
+		hydrator: hydrator,
+	}
+}
+
+func (b *Bookmarker) List(ctx context.Context, accountID account.AccountID, page pagination.Parameters, kinds ...datagraph.Kind) (pagination.Result[*bookmark.Bookmark], error) {
+	result, err := b.querier.List(ctx, accountID, page, bookmark_querier.WithKind(kinds...))
+	if err != nil {
+		return pagination.Result[*bookmark.Bookmark]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return result, nil
+}
+
+// Add bookmarks an item the member can see, repeating it only updates the note.
+func (b *Bookmarker) Add(ctx context.Context, accountID account.AccountID, target datagraph.Ref, note opt.Optional[string]) (*bookmark.Bookmark, error) {
+	switch target.Kind {
+	case datagraph.KindPost, datagraph.KindThread, datagraph.KindReply, datagraph.KindNode, datagraph.KindProfile:
+	default:
+		return nil, fault.Wrap(ErrUnsupportedKind,
+			fctx.With(ctx),
+			fmsg.WithDesc("unsupported", "Only threads, replies, library pages and profiles can be bookmarked."),
+		)
+	}
+
+	items, err := b.hydrator.Hydrate(ctx, &target)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if len(items) == 0 || !hydrate.VisibleTo(items[0], accountID) {
+		return nil, fault.Wrap(ErrItemNotFound, fctx.With(ctx))
+	}
+
+	bm, err := b.writer.Add(ctx, accountID, target, note)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return bm, nil
+}
+
+func (b *Bookmarker) Remove(ctx context.Context, accountID account.AccountID, targetID xid.ID) error {
+	if err := b.writer.Remove(ctx, accountID, targetID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -5,8 +5,9 @@ import (
 
 	"github.com/Southclaws/storyden/app/services/branding/banner"
 	"github.com/Southclaws/storyden/app/services/branding/icon"
+	"github.com/Southclaws/storyden/app/services/branding/og_image"
 )
 
 func Build() fx.Option {
-	return fx.Provide(icon.New, banner.New)
+	return fx.Provide(icon.New, banner.New, og_image.New)
 }
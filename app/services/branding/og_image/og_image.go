@@ -0,0 +1,265 @@
+// Package og_image renders the images social platforms show alongside links to
+// threads and library pages. An image is rendered the first time it's asked for
+// and stored as an asset named after everything drawn on it, so when any of
+// that changes a new image is rendered instead of the old one being replaced.
+package og_image
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_writer"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/sitemap"
+	"github.com/Southclaws/storyden/app/services/asset/asset_download"
+	"github.com/Southclaws/storyden/app/services/branding/icon"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/mime"
+)
+
+// version is part of every image's name, changing it when the layout changes
+// means images are rendered again with the new one.
+const version = "1"
+
+var errNotFound = fault.New("not found", ftag.With(ftag.NotFound))
+
+type Generator struct {
+	settings      *settings.SettingsRepository
+	sitemap       *sitemap.Querier
+	threadQuerier *thread_querier.Querier
+	nodeQuerier   *node_querier.Querier
+	icons         icon.Service
+	downloader    *asset_download.Downloader
+	assets        *asset_writer.Writer
+	objects       object.Storer
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	sitemapQuerier *sitemap.Querier,
+	threadQuerier *thread_querier.Querier,
+	nodeQuerier *node_querier.Querier,
+	icons icon.Service,
+	downloader *asset_download.Downloader,
+	assets *asset_writer.Writer,
+	objects object.Storer,
+) *Generator {
+	return &Generator{
+		settings:      settings,
+		sitemap:       sitemapQuerier,
+		threadQuerier: threadQuerier,
+		nodeQuerier:   nodeQuerier,
+		icons:         icons,
+		downloader:    downloader,
+		assets:        assets,
+		objects:       objects,
+	}
+}
+
+// subject is the item an image is for, with what's drawn about it.
+type subject struct {
+	kind       string
+	id         xid.ID
+	owner      account.AccountID
+	title      string
+	author     string
+	context    string
+	background *asset.Asset
+}
+
+// Thread returns the image for a published thread.
+func (g *Generator) Thread(ctx context.Context, id post.ID) (*asset.Asset, io.Reader, error) {
+	set, err := g.settings.Get(ctx)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	e, err := g.lookup(ctx, set, sitemap.SectionThreads, id.String())
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thr, err := g.threadQuerier.Get(ctx, post.ID(e.ID), pagination.Parameters{}, nil)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s := subject{
+		kind:   "thread",
+		id:     e.ID,
+		owner:  thr.Author.ID,
+		title:  thr.Title,
+		author: thr.Author.Name,
+	}
+	if c, ok := thr.Category.Get(); ok {
+		s.context = c.Name
+	}
+
+	return g.get(ctx, set, s)
+}
+
+// Node returns the image for a published library page, drawn over the page's
+// primary image if it has one.
+func (g *Generator) Node(ctx context.Context, slug string) (*asset.Asset, io.Reader, error) {
+	set, err := g.settings.Get(ctx)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	e, err := g.lookup(ctx, set, sitemap.SectionNodes, slug)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	n, err := g.nodeQuerier.Get(ctx, library.NewID(e.ID))
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s := subject{
+		kind:   "node",
+		id:     e.ID,
+		owner:  n.Owner.ID,
+		title:  n.Name,
+		author: n.Owner.Name,
+	}
+	if a, ok := n.PrimaryImage.Get(); ok && !a.Private {
+		s.background = &a
+	}
+
+	return g.get(ctx, set, s)
+}
+
+// lookup finds published content the same way the sitemap does, so there are
+// only images for what's listed there.
+func (g *Generator) lookup(ctx context.Context, set *settings.Settings, section sitemap.Section, key string) (*sitemap.Entry, error) {
+	if !set.Public.Or(true) {
+		return nil, fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	e, ok, err := g.sitemap.Lookup(ctx, section, key)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		return nil, fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	return e, nil
+}
+
+func (g *Generator) get(ctx context.Context, set *settings.Settings, s subject) (*asset.Asset, io.Reader, error) {
+	logo, lr, err := g.icons.Get(ctx, "512x512")
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c := card{
+		community: set.Title.Or(settings.DefaultTitle),
+		colour:    set.AccentColour.Or(settings.DefaultColour),
+		title:     s.title,
+		author:    s.author,
+		context:   s.context,
+	}
+
+	name := filename(s, c, logo.ID)
+	ctx = fctx.WithMeta(ctx, "filename", name.String())
+
+	a, r, err := g.downloader.Get(ctx, name)
+	if err == nil {
+		return a, r, nil
+	}
+	if ftag.Get(err) != ftag.NotFound {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Images which can't be decoded, such as an unsupported format, are left
+	// off rather than failing the whole render.
+	if img, _, err := image.Decode(lr); err == nil {
+		c.icon = img
+	}
+
+	if bg := s.background; bg != nil {
+		_, br, err := g.downloader.Get(ctx, bg.Name)
+		if err != nil {
+			return nil, nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if img, _, err := image.Decode(br); err == nil {
+			c.background = img
+		}
+	}
+
+	b, err := render(c)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a, err = g.store(ctx, s.owner, name, b)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return a, bytes.NewReader(b), nil
+}
+
+// store writes the image before the asset which refers to it, so the asset is
+// never found without it.
+func (g *Generator) store(ctx context.Context, owner account.AccountID, name asset.Filename, b []byte) (*asset.Asset, error) {
+	sum := sha256.Sum256(b)
+	hash := hex.EncodeToString(sum[:])
+	path := asset.BuildBlobPath(hash)
+
+	exists, err := g.objects.Exists(ctx, path)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !exists {
+		if err := g.objects.Write(ctx, path, bytes.NewReader(b), int64(len(b))); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	a, err := g.assets.Add(ctx, xid.ID(owner), name, len(b), mime.New("image/png"), asset_writer.WithContentHash(hash))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return a, nil
+}
+
+// filename is named after the item and a hash of everything drawn on the image.
+func filename(s subject, c card, logo asset.AssetID) asset.Filename {
+	h := sha256.New()
+	for _, v := range []string{version, c.community, c.colour, logo.String(), c.title, c.author, c.context} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	if s.background != nil {
+		h.Write([]byte(s.background.ID.String()))
+	}
+
+	key := hex.EncodeToString(h.Sum(nil))[:16]
+
+	return asset.NewFilepathFilename(fmt.Sprintf("og-%s-%s-%s.png", s.kind, s.id, key))
+}
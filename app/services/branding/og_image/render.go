@@ -0,0 +1,252 @@
+package og_image
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/disintegration/imaging"
+	"github.com/mazznoer/colorgrad"
+	"github.com/mazznoer/csscolorparser"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gomedium"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/Southclaws/storyden/app/resources/settings"
+)
+
+const (
+	width   = 1200
+	height  = 630
+	padding = 80
+
+	iconSize   = 72
+	titleSize  = 68
+	titleLines = 3
+	labelSize  = 32
+)
+
+var (
+	boldFont    = mustParse(gobold.TTF)
+	mediumFont  = mustParse(gomedium.TTF)
+	regularFont = mustParse(goregular.TTF)
+)
+
+func mustParse(ttf []byte) *opentype.Font {
+	f, err := opentype.Parse(ttf)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// card is everything drawn on an image.
+type card struct {
+	community  string
+	colour     string
+	icon       image.Image
+	background image.Image
+	title      string
+	author     string
+	context    string
+}
+
+func render(c card) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	text := color.Color(color.White)
+
+	if c.background != nil {
+		draw.Draw(img, img.Bounds(), imaging.Fill(c.background, width, height, imaging.Center, imaging.Lanczos), image.Point{}, draw.Src)
+
+		// The text sits on a shade so it can be read over any photo.
+		shade := image.NewUniform(color.NRGBA{A: 150})
+		draw.Draw(img, img.Bounds(), shade, image.Point{}, draw.Over)
+	} else {
+		accent, err := csscolorparser.Parse(c.colour)
+		if err != nil {
+			accent, _ = csscolorparser.Parse(settings.DefaultColour)
+		}
+
+		if err := fillGradient(img, accent); err != nil {
+			return nil, err
+		}
+
+		if luminance(accent) > 0.6 {
+			text = color.NRGBA{R: 24, G: 24, B: 27, A: 255}
+		}
+	}
+
+	ink := image.NewUniform(text)
+
+	x := padding
+	if c.icon != nil {
+		icon := imaging.Fill(c.icon, iconSize, iconSize, imaging.Center, imaging.Lanczos)
+		draw.Draw(img, image.Rect(padding, padding, padding+iconSize, padding+iconSize), icon, image.Point{}, draw.Over)
+		x += iconSize + 24
+	}
+
+	label, err := newFace(mediumFont, labelSize)
+	if err != nil {
+		return nil, err
+	}
+	defer label.Close()
+
+	drawText(img, ink, label, x, padding+iconSize/2+labelSize/3, fit(label, c.community, width-padding-x))
+
+	title, err := newFace(boldFont, titleSize)
+	if err != nil {
+		return nil, err
+	}
+	defer title.Close()
+
+	lineHeight := titleSize * 6 / 5
+	lines := wrap(title, c.title, width-padding*2, titleLines)
+	for i, line := range lines {
+		drawText(img, ink, title, padding, padding+iconSize+64+titleSize+i*lineHeight, line)
+	}
+
+	byline, err := newFace(regularFont, labelSize)
+	if err != nil {
+		return nil, err
+	}
+	defer byline.Close()
+
+	footer := c.author
+	if c.context != "" {
+		footer += " · " + c.context
+	}
+	drawText(img, ink, byline, padding, height-padding, fit(byline, footer, width-padding*2))
+
+	buf := bytes.NewBuffer(nil)
+	if err := png.Encode(buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// fillGradient shades the accent colour darker towards the bottom right, in
+// the same way as generated avatars.
+func fillGradient(img *image.RGBA, accent csscolorparser.Color) error {
+	end := csscolorparser.Color{R: accent.R * 0.45, G: accent.G * 0.45, B: accent.B * 0.45, A: 1}
+
+	grad, err := colorgrad.
+		NewGradient().
+		Colors(accent, end).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	span := float64(width + height)
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, grad.At(float64(x+y)/span))
+		}
+	}
+
+	return nil
+}
+
+func luminance(c csscolorparser.Color) float64 {
+	return 0.2126*c.R + 0.7152*c.G + 0.0722*c.B
+}
+
+func newFace(f *opentype.Font, size float64) (font.Face, error) {
+	return opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+}
+
+func drawText(dst draw.Image, src image.Image, face font.Face, x, y int, s string) {
+	d := &font.Drawer{
+		Dst:  dst,
+		Src:  src,
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(s)
+}
+
+func measure(face font.Face, s string) int {
+	return font.MeasureString(face, s).Ceil()
+}
+
+// wrap breaks s into at most max lines no wider than w, the last line ends in
+// an ellipsis if the text didn't fit.
+func wrap(face font.Face, s string, w int, max int) []string {
+	lines := []string{}
+	line := ""
+
+	for _, word := range strings.Fields(s) {
+		next := word
+		if line != "" {
+			next = line + " " + word
+		}
+
+		if measure(face, next) <= w {
+			line = next
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+
+		// A word which is too long on its own line is broken wherever it
+		// reaches the edge.
+		for measure(face, word) > w {
+			n := cut(face, word, w)
+			lines = append(lines, word[:n])
+			word = word[n:]
+		}
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	if len(lines) > max {
+		lines = lines[:max]
+		lines[max-1] = truncate(face, lines[max-1], w)
+	}
+
+	return lines
+}
+
+// fit shortens s with an ellipsis if it's wider than w.
+func fit(face font.Face, s string, w int) string {
+	if measure(face, s) <= w {
+		return s
+	}
+	return truncate(face, s, w)
+}
+
+func truncate(face font.Face, s string, w int) string {
+	n := cut(face, s, w-measure(face, "…"))
+	return strings.TrimRight(s[:n], " ") + "…"
+}
+
+// cut is how many bytes of s fit within w, always at least one rune so that
+// callers make progress.
+func cut(face font.Face, s string, w int) int {
+	n := 0
+	for i, r := range s {
+		size := utf8.RuneLen(r)
+		if n > 0 && measure(face, s[:i+size]) > w {
+			break
+		}
+		n = i + size
+	}
+	return n
+}
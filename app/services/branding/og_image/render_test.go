@@ -0,0 +1,107 @@
+package og_image
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("gradient", func(t *testing.T) {
+		b, err := render(card{
+			community: "Storyden",
+			colour:    "hsl(157, 65%, 44%)",
+			title:     "A thread with a title long enough that it has to be wrapped onto more than one line to fit",
+			author:    "Odin",
+			context:   "General",
+		})
+		require.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(b))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, width, height), img.Bounds())
+	})
+
+	t.Run("background", func(t *testing.T) {
+		bg := image.NewRGBA(image.Rect(0, 0, 300, 200))
+		draw.Draw(bg, bg.Bounds(), image.NewUniform(color.NRGBA{R: 200, A: 255}), image.Point{}, draw.Src)
+
+		b, err := render(card{
+			community:  "Storyden",
+			colour:     "not a colour",
+			icon:       bg,
+			background: bg,
+			title:      "A library page",
+			author:     "Odin",
+		})
+		require.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(b))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, width, height), img.Bounds())
+	})
+}
+
+func TestWrap(t *testing.T) {
+	face, err := newFace(boldFont, titleSize)
+	require.NoError(t, err)
+	defer face.Close()
+
+	t.Run("short", func(t *testing.T) {
+		assert.Equal(t, []string{"Hello world"}, wrap(face, "  Hello   world ", 1040, 3))
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		lines := wrap(face, strings.Repeat("word ", 40), 1040, 3)
+		require.Len(t, lines, 3)
+		for _, l := range lines {
+			assert.LessOrEqual(t, measure(face, l), 1040)
+		}
+		assert.True(t, strings.HasSuffix(lines[2], "…"))
+		assert.False(t, strings.HasSuffix(lines[0], "…"))
+	})
+
+	t.Run("long_word", func(t *testing.T) {
+		lines := wrap(face, strings.Repeat("x", 100), 1040, 3)
+		require.Len(t, lines, 3)
+		for _, l := range lines {
+			assert.LessOrEqual(t, measure(face, l), 1040)
+		}
+	})
+
+	t.Run("fit", func(t *testing.T) {
+		assert.Equal(t, "Short", fit(face, "Short", 1040))
+
+		s := fit(face, strings.Repeat("long ", 50), 1040)
+		assert.LessOrEqual(t, measure(face, s), 1040)
+		assert.True(t, strings.HasSuffix(s, "…"))
+	})
+}
+
+func TestFilename(t *testing.T) {
+	s := subject{kind: "thread", id: xid.New()}
+	c := card{community: "Storyden", colour: "#fff", title: "Title", author: "Odin"}
+	logo := xid.New()
+
+	a := filename(s, c, logo)
+	assert.True(t, strings.HasPrefix(a.String(), "og-thread-"+s.id.String()+"-"))
+	assert.Equal(t, a, filename(s, c, logo), "the same content is the same image")
+
+	changed := c
+	changed.title = "New title"
+	assert.NotEqual(t, a, filename(s, changed, logo))
+	assert.NotEqual(t, a, filename(s, c, xid.New()))
+
+	s.background = &asset.Asset{ID: xid.New()}
+	assert.NotEqual(t, a, filename(s, c, logo))
+}
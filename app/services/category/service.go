@@ -2,7 +2,9 @@ package category
 
 import (
 	"context"
+	"time"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
@@ -14,17 +16,25 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/category_cache"
+	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
 	"github.com/Southclaws/storyden/internal/deletable"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
-var errInvalidCategoryCreate = fault.New("invalid create args", ftag.With(ftag.InvalidArgument))
+var (
+	errInvalidCategoryCreate = fault.New("invalid create args", ftag.With(ftag.InvalidArgument))
+	errInvalidCategoryWindow = fault.New("invalid open window", ftag.With(ftag.InvalidArgument))
+)
 
 type Service interface {
 	Create(ctx context.Context, partial Partial) (*category.Category, error)
 	Update(ctx context.Context, slug string, partial Partial) (*category.Category, error)
 	Move(ctx context.Context, slug string, move Move) ([]*category.Category, error)
 	Delete(ctx context.Context, slug string, moveToID category.CategoryID) (*category.Category, error)
+	SetPermissions(ctx context.Context, slug string, perms category.Permissions) (category.Permissions, error)
 }
 
 type Partial struct {
@@ -35,6 +45,48 @@ type Partial struct {
 	Parent            opt.Optional[category.CategoryID]
 	CoverImageAssetID deletable.Value[*xid.ID]
 	Meta              opt.Optional[map[string]any]
+	Archived          opt.Optional[bool]
+	OpensAt           deletable.Value[time.Time]
+	ClosesAt          deletable.Value[time.Time]
+	RequiredTags      opt.Optional[tag_ref.Names]
+}
+
+// windowOpts maps the partial's open and close times to options, both times
+// must be in order when both are given.
+func (p Partial) windowOpts(ctx context.Context) ([]category.Option, error) {
+	opens, _ := p.OpensAt.Get()
+	closes, _ := p.ClosesAt.Get()
+
+	if o, ok := opens.Get(); ok {
+		if c, ok := closes.Get(); ok && !c.After(o) {
+			return nil, fault.Wrap(errInvalidCategoryWindow, fctx.With(ctx),
+				fmsg.WithDesc("invalid window", "A category must close after it opens."))
+		}
+	}
+
+	opts := []category.Option{}
+	p.OpensAt.Call(func(t time.Time) { opts = append(opts, category.WithOpensAt(&t)) }, func() { opts = append(opts, category.WithOpensAt(nil)) })
+	p.ClosesAt.Call(func(t time.Time) { opts = append(opts, category.WithClosesAt(&t)) }, func() { opts = append(opts, category.WithClosesAt(nil)) })
+
+	return opts, nil
+}
+
+// requiredTagsOpts creates any required tags which don't exist yet so that a
+// category can be set up before anything has been tagged.
+func (s *service) requiredTagsOpts(ctx context.Context, p Partial) ([]category.Option, error) {
+	names, ok := p.RequiredTags.Get()
+	if !ok {
+		return nil, nil
+	}
+
+	tags, err := s.tagWriter.Add(ctx, names...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ids := dt.Map(tags, func(t *tag_ref.Tag) xid.ID { return xid.ID(t.ID) })
+
+	return []category.Option{category.WithRequiredTags(ids...)}, nil
 }
 
 type Move struct {
@@ -50,17 +102,26 @@ func Build() fx.Option {
 type service struct {
 	accountQuery  *account_querier.Querier
 	category_repo *category.Repository
+	categoryCache *category_cache.Cache
+	threadCache   *thread_cache.Cache
+	tagWriter     *tag_writer.Writer
 	bus           *pubsub.Bus
 }
 
 func New(
 	accountQuery *account_querier.Querier,
 	category_repo *category.Repository,
+	categoryCache *category_cache.Cache,
+	threadCache *thread_cache.Cache,
+	tagWriter *tag_writer.Writer,
 	bus *pubsub.Bus,
 ) Service {
 	return &service{
 		accountQuery:  accountQuery,
 		category_repo: category_repo,
+		categoryCache: categoryCache,
+		threadCache:   threadCache,
+		tagWriter:     tagWriter,
 		bus:           bus,
 	}
 }
@@ -86,6 +147,18 @@ func (s *service) Create(ctx context.Context, partial Partial) (*category.Catego
 		opts = append(opts, category.WithMeta(v))
 	}
 
+	window, err := partial.windowOpts(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	opts = append(opts, window...)
+
+	requiredTags, err := s.requiredTagsOpts(ctx, partial)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	opts = append(opts, requiredTags...)
+
 	name, ok := partial.Name.Get()
 	if !ok {
 		return nil, fault.Wrap(errInvalidCategoryCreate, fctx.With(ctx), fmsg.WithDesc("missing name", "Category name is required."))
@@ -136,6 +209,27 @@ func (s *service) Update(ctx context.Context, slug string, partial Partial) (*ca
 		opts = append(opts, category.WithMeta(v))
 	}
 
+	if v, ok := partial.Archived.Get(); ok {
+		if v {
+			now := time.Now()
+			opts = append(opts, category.WithArchivedAt(&now))
+		} else {
+			opts = append(opts, category.WithArchivedAt(nil))
+		}
+	}
+
+	window, err := partial.windowOpts(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	opts = append(opts, window...)
+
+	requiredTags, err := s.requiredTagsOpts(ctx, partial)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	opts = append(opts, requiredTags...)
+
 	cat, err := s.category_repo.UpdateCategory(ctx, slug, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -196,3 +290,24 @@ func (s *service) Delete(ctx context.Context, slug string, moveToID category.Cat
 
 	return cat, nil
 }
+
+func (s *service) SetPermissions(ctx context.Context, slug string, perms category.Permissions) (category.Permissions, error) {
+	updated, err := s.category_repo.SetPermissions(ctx, slug, perms)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Permissions change who can read what, so the caches are updated before
+	// responding rather than waiting for the event's subscribers to do it.
+	if err := s.categoryCache.Store(ctx, slug, time.Now()); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.threadCache.Listings().Invalidate(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s.bus.Publish(ctx, &message.EventCategoryUpdated{Slug: slug})
+
+	return updated, nil
+}
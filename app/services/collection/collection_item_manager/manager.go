@@ -15,6 +15,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/rbac"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/collection/collection_auth"
+	"github.com/Southclaws/storyden/internal/deletable"
 )
 
 type Manager struct {
@@ -33,7 +34,7 @@ func New(
 }
 
 func (m *Manager) PostAdd(ctx context.Context, qk collection.QueryKey, pid post.ID) (*collection.CollectionWithItems, error) {
-	mt, err := m.authoriseSubmission(ctx, qk, xid.ID(pid))
+	mt, err := m.AuthoriseSubmission(ctx, qk, xid.ID(pid))
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +57,7 @@ func (m *Manager) PostRemove(ctx context.Context, qk collection.QueryKey, pid po
 }
 
 func (m *Manager) NodeAdd(ctx context.Context, qk collection.QueryKey, id library.NodeID) (*collection.CollectionWithItems, error) {
-	mt, err := m.authoriseSubmission(ctx, qk, xid.ID(id))
+	mt, err := m.AuthoriseSubmission(ctx, qk, xid.ID(id))
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +83,7 @@ func (m *Manager) NodeRemove(ctx context.Context, qk collection.QueryKey, id lib
 	return col, nil
 }
 
-func (m *Manager) authoriseSubmission(ctx context.Context, qk collection.QueryKey, iid xid.ID) (collection.MembershipType, error) {
+func (m *Manager) AuthoriseSubmission(ctx context.Context, qk collection.QueryKey, iid xid.ID) (collection.MembershipType, error) {
 	acc, err := session.GetAccount(ctx)
 	if err != nil {
 		return collection.MembershipType{}, fault.Wrap(err, fctx.With(ctx))
@@ -115,3 +116,39 @@ func (m *Manager) authoriseDirectUpdate(ctx context.Context, qk collection.Query
 
 	return collection_auth.CheckCollectionMutationPermissions(ctx, *col)
 }
+
+type MoveOptions struct {
+	Position collection.Position
+	Section  deletable.Value[collection.SectionID]
+}
+
+func (m *Manager) Move(ctx context.Context, qk collection.QueryKey, itemID xid.ID, opts MoveOptions) (*collection.CollectionWithItems, error) {
+	if err := m.authoriseDirectUpdate(ctx, qk); err != nil {
+		return nil, err
+	}
+
+	var col *collection.CollectionWithItems
+
+	section, remove := opts.Section.Get()
+	if _, ok := section.Get(); ok || remove {
+		var err error
+		col, err = m.repo.SetSection(ctx, qk, itemID, section)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if opts.Position.Before.Ok() || opts.Position.After.Ok() {
+		var err error
+		col, err = m.repo.Move(ctx, qk, itemID, opts.Position)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if col == nil {
+		return m.colQuerier.Get(ctx, qk)
+	}
+
+	return col, nil
+}
@@ -0,0 +1,105 @@
+package collection_section_manager
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_section"
+	"github.com/Southclaws/storyden/app/services/collection/collection_auth"
+)
+
+type Manager struct {
+	colQuerier *collection_querier.Querier
+	repo       *collection_section.Repository
+}
+
+func New(
+	colQuerier *collection_querier.Querier,
+	repo *collection_section.Repository,
+) *Manager {
+	return &Manager{
+		colQuerier: colQuerier,
+		repo:       repo,
+	}
+}
+
+type Partial struct {
+	Name        opt.Optional[string]
+	Description opt.Optional[string]
+	Position    collection.Position
+}
+
+func (m *Manager) Create(ctx context.Context, qk collection.QueryKey, name string, partial Partial) (*collection.Section, error) {
+	if err := m.authoriseDirectUpdate(ctx, qk); err != nil {
+		return nil, err
+	}
+
+	opts := []collection_section.Option{}
+
+	partial.Description.Call(func(v string) { opts = append(opts, collection_section.WithDescription(v)) })
+
+	s, err := m.repo.Create(ctx, qk, name, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if partial.Position.Before.Ok() || partial.Position.After.Ok() {
+		s, err = m.repo.Move(ctx, qk, s.ID, partial.Position)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return s, nil
+}
+
+func (m *Manager) Update(ctx context.Context, qk collection.QueryKey, id collection.SectionID, partial Partial) (*collection.Section, error) {
+	if err := m.authoriseDirectUpdate(ctx, qk); err != nil {
+		return nil, err
+	}
+
+	opts := []collection_section.Option{}
+
+	partial.Name.Call(func(v string) { opts = append(opts, collection_section.WithName(v)) })
+	partial.Description.Call(func(v string) { opts = append(opts, collection_section.WithDescription(v)) })
+
+	s, err := m.repo.Update(ctx, qk, id, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if partial.Position.Before.Ok() || partial.Position.After.Ok() {
+		s, err = m.repo.Move(ctx, qk, id, partial.Position)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return s, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, qk collection.QueryKey, id collection.SectionID) error {
+	if err := m.authoriseDirectUpdate(ctx, qk); err != nil {
+		return err
+	}
+
+	if err := m.repo.Delete(ctx, qk, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) authoriseDirectUpdate(ctx context.Context, qk collection.QueryKey) error {
+	col, err := m.colQuerier.Probe(ctx, qk)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return collection_auth.CheckCollectionMutationPermissions(ctx, *col)
+}
@@ -0,0 +1,179 @@
+package collection_share_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/alexedwards/argon2id"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_share"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/collection/collection_auth"
+	"github.com/Southclaws/storyden/internal/infrastructure/rate"
+)
+
+var (
+	ErrPasscodeRequired  = fault.New("passcode required", ftag.With(ftag.Unauthenticated))
+	ErrPasscodeIncorrect = fault.New("passcode incorrect", ftag.With(ftag.PermissionDenied))
+)
+
+// Incorrect passcodes are counted per share rather than per client so that a
+// passcode can't be guessed by spreading attempts across many addresses.
+var (
+	PasscodeAttemptLimit  = 10
+	PasscodeAttemptPeriod = time.Hour
+	PasscodeAttemptBucket = time.Minute
+)
+
+type Manager struct {
+	colQuerier *collection_querier.Querier
+	repo       *collection_share.Repository
+	attempts   rate.Limiter
+}
+
+func New(
+	colQuerier *collection_querier.Querier,
+	repo *collection_share.Repository,
+	ratelimit *rate.LimiterFactory,
+) *Manager {
+	return &Manager{
+		colQuerier: colQuerier,
+		repo:       repo,
+		attempts:   ratelimit.NewLimiter(PasscodeAttemptLimit, PasscodeAttemptPeriod, PasscodeAttemptBucket),
+	}
+}
+
+type Options struct {
+	Passcode  opt.Optional[string]
+	ExpiresAt opt.Optional[time.Time]
+}
+
+func (m *Manager) Create(ctx context.Context, qk collection.QueryKey, opts Options) (*collection_share.Share, error) {
+	if err := m.authoriseDirectUpdate(ctx, qk); err != nil {
+		return nil, err
+	}
+
+	shareOpts := []collection_share.Option{}
+
+	if passcode, ok := opts.Passcode.Get(); ok && passcode != "" {
+		hash, err := argon2id.CreateHash(passcode, argon2id.DefaultParams)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		shareOpts = append(shareOpts, collection_share.WithPasscodeHash(hash))
+	}
+
+	if exp, ok := opts.ExpiresAt.Get(); ok {
+		if exp.Before(time.Now()) {
+			return nil, fault.New("expiry must be in the future", fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+
+		shareOpts = append(shareOpts, collection_share.WithExpiry(exp))
+	}
+
+	s, err := m.repo.Create(ctx, qk, shareOpts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s, nil
+}
+
+func (m *Manager) List(ctx context.Context, qk collection.QueryKey) ([]*collection_share.Share, error) {
+	if err := m.authoriseDirectUpdate(ctx, qk); err != nil {
+		return nil, err
+	}
+
+	shares, err := m.repo.List(ctx, qk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return shares, nil
+}
+
+func (m *Manager) Revoke(ctx context.Context, qk collection.QueryKey, id collection_share.ShareID) error {
+	if err := m.authoriseDirectUpdate(ctx, qk); err != nil {
+		return err
+	}
+
+	if err := m.repo.Delete(ctx, qk, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Open resolves a share token to a read-only view of the collection. No session
+// is required, the token itself grants access and only published items which
+// have been accepted into the collection are visible via a share link.
+func (m *Manager) Open(ctx context.Context, token string, passcode opt.Optional[string]) (*collection.CollectionWithItems, error) {
+	s, err := m.repo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if s.Expired(time.Now()) {
+		return nil, fault.Wrap(collection_share.ErrNotFound, fctx.With(ctx))
+	}
+
+	if hash, ok := s.PasscodeHash.Get(); ok {
+		pc, ok := passcode.Get()
+		if !ok || pc == "" {
+			return nil, fault.Wrap(ErrPasscodeRequired, fctx.With(ctx),
+				fmsg.WithDesc("passcode required", "This shared collection is protected by a passcode."))
+		}
+
+		key := "collection_share_passcode:" + s.ID.String()
+
+		if err := m.attempts.Check(ctx, key, 0); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx),
+				fmsg.WithDesc("too many attempts", "Too many incorrect passcodes have been tried for this shared collection, try again later."))
+		}
+
+		match, _, err := argon2id.CheckHash(pc, hash)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !match {
+			if _, _, err := m.attempts.Increment(ctx, key, 1); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return nil, fault.Wrap(ErrPasscodeIncorrect, fctx.With(ctx),
+				fmsg.WithDesc("passcode incorrect", "The passcode provided for this shared collection is incorrect."))
+		}
+	}
+
+	col, err := m.colQuerier.Get(ctx,
+		collection.NewID(s.CollectionID),
+		collection_querier.WithVisibility(visibility.VisibilityPublished),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	col.Items = dt.Filter(col.Items, func(i *collection.CollectionItem) bool {
+		return i.MembershipType != collection.MembershipTypeSubmissionReview
+	})
+
+	return col, nil
+}
+
+func (m *Manager) authoriseDirectUpdate(ctx context.Context, qk collection.QueryKey) error {
+	col, err := m.colQuerier.Probe(ctx, qk)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return collection_auth.CheckCollectionMutationPermissions(ctx, *col)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/Southclaws/storyden/app/services/collection/collection_item_manager"
 	"github.com/Southclaws/storyden/app/services/collection/collection_manager"
 	"github.com/Southclaws/storyden/app/services/collection/collection_read"
+	"github.com/Southclaws/storyden/app/services/collection/collection_section_manager"
+	"github.com/Southclaws/storyden/app/services/collection/collection_share_manager"
 )
 
 func Build() fx.Option {
@@ -13,5 +15,7 @@ func Build() fx.Option {
 		collection_item_manager.New,
 		collection_manager.New,
 		collection_read.New,
+		collection_section_manager.New,
+		collection_share_manager.New,
 	)
 }
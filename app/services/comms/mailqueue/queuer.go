@@ -8,12 +8,12 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
 	"go.uber.org/fx"
 
-	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/services/comms/mailtemplate"
+	"github.com/Southclaws/storyden/app/services/job_queue"
 	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
-	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 	"github.com/Southclaws/storyden/internal/infrastructure/rate"
 )
 
@@ -23,50 +23,60 @@ var (
 	EmailRateLimitReset  = time.Minute * 10
 )
 
+// JobSendEmail is the kind of job which delivers a single composed email.
+const JobSendEmail = "mail.send"
+
 type Queuer struct {
 	templates *mailtemplate.Builder
 	limiter   rate.Limiter
-	bus       *pubsub.Bus
+	jobs      *job_queue.Queue
 	sender    mailer.Sender
 }
 
 func Build() fx.Option {
 	return fx.Options(
 		fx.Provide(func(
-			ctx context.Context,
-			lc fx.Lifecycle,
 			logger *slog.Logger,
-
 			templates *mailtemplate.Builder,
 			ratelimit *rate.LimiterFactory,
-			bus *pubsub.Bus,
+			jobs *job_queue.Queue,
 			sender mailer.Sender,
 		) *Queuer {
 			q := &Queuer{
 				templates: templates,
 				limiter:   ratelimit.NewLimiter(EmailRateLimit, EmailRateLimitPeriod, EmailRateLimitReset),
-				bus:       bus,
+				jobs:      jobs,
 				sender:    sender,
 			}
 
-			lc.Append(fx.StartHook(func(hctx context.Context) error {
-				_, err := pubsub.SubscribeCommand(hctx, bus, "mailqueue.send_email", func(ctx context.Context, cmd *message.CommandSendEmail) error {
-					if err := sender.Send(ctx, cmd.Message); err != nil {
-						logger.Error("failed to send email", slog.String("error", err.Error()))
-						return err
-					}
-					return nil
-				})
+			job_queue.Register(jobs, JobSendEmail, func(ctx context.Context, msg mailer.Message) error {
+				if sender == nil {
+					return fault.New("email sending is not enabled")
+				}
 
-				return err
-			}))
+				if err := sender.Send(ctx, msg); err != nil {
+					logger.Error("failed to send email", slog.String("error", err.Error()))
+					return err
+				}
+				return nil
+			})
 
 			return q
 		}),
 	)
 }
 
-func (q *Queuer) Queue(ctx context.Context, address mail.Address, name string, subject string, intros []string, actions []mailtemplate.Action) error {
+type Option func(*mailer.Message)
+
+// WithReplyTo directs replies to the email to another address than the one it
+// was sent from.
+func WithReplyTo(address mail.Address) Option {
+	return func(m *mailer.Message) {
+		m.ReplyTo = opt.New(address)
+	}
+}
+
+func (q *Queuer) Queue(ctx context.Context, address mail.Address, name string, subject string, intros []string, actions []mailtemplate.Action, opts ...Option) error {
 	if q.sender == nil {
 		return fault.New("email sending is not enabled")
 	}
@@ -85,10 +95,11 @@ func (q *Queuer) Queue(ctx context.Context, address mail.Address, name string, s
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
+	for _, o := range opts {
+		o(msg)
+	}
 
-	if err := q.bus.SendCommand(ctx, &message.CommandSendEmail{
-		Message: *msg,
-	}); err != nil {
+	if _, err := job_queue.Enqueue(ctx, q.jobs, JobSendEmail, *msg); err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
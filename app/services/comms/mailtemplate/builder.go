@@ -10,6 +10,7 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/settings"
 	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/i18n"
 	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
 )
 
@@ -45,16 +46,19 @@ func (b *Builder) Build(ctx context.Context, name string, intros []string, actio
 			Name:      instanceTitle,
 			Link:      instanceURL.String(),
 			Copyright: "-",
+			// {ACTION} is replaced by hermes with the button's text.
+			TroubleText: i18n.T(ctx, "If you’re having trouble with the button '{ACTION}', copy and paste the URL below into your web browser."),
 		},
 	}
 
 	template := hermes.Email{
 		Body: hermes.Body{
 			Name:      name,
+			Greeting:  i18n.T(ctx, "Hi"),
 			Intros:    intros,
 			Actions:   actions,
 			Outros:    []string{},
-			Signature: "Thanks",
+			Signature: i18n.T(ctx, "Thanks"),
 		},
 	}
 
@@ -0,0 +1,15 @@
+package conversation
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/conversation/conversation_manager"
+	"github.com/Southclaws/storyden/app/services/conversation/conversation_notify"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(conversation_manager.New),
+		conversation_notify.Build(),
+	)
+}
@@ -0,0 +1,342 @@
+// Package conversation_manager lets members message each other privately,
+// either one-to-one or in small groups. Conversations are only visible to the
+// members taking part, anyone else is told they do not exist.
+package conversation_manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_querier"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrNotFound      = fault.New("conversation not found", ftag.With(ftag.NotFound))
+	ErrNoRecipients  = fault.New("no recipients", ftag.With(ftag.InvalidArgument))
+	ErrEmptyMessage  = fault.New("empty message", ftag.With(ftag.InvalidArgument))
+	ErrCannotMessage = fault.New("cannot message member", ftag.With(ftag.PermissionDenied))
+	ErrAssetNotOwned = fault.New("asset not owned by sender", ftag.With(ftag.PermissionDenied))
+)
+
+type Manager struct {
+	accountQuerier *account_querier.Querier
+	assetQuerier   *asset_querier.Querier
+	blockQuerier   *block_querier.Querier
+	querier        *conversation_querier.Querier
+	writer         *conversation_writer.Writer
+	bus            *pubsub.Bus
+}
+
+func New(
+	accountQuerier *account_querier.Querier,
+	assetQuerier *asset_querier.Querier,
+	blockQuerier *block_querier.Querier,
+	querier *conversation_querier.Querier,
+	writer *conversation_writer.Writer,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		accountQuerier: accountQuerier,
+		assetQuerier:   assetQuerier,
+		blockQuerier:   blockQuerier,
+		querier:        querier,
+		writer:         writer,
+		bus:            bus,
+	}
+}
+
+type Inbox struct {
+	Conversations *pagination.Result[*conversation.Conversation]
+	UnreadTotal   int
+}
+
+func (m *Manager) List(ctx context.Context, page pagination.Parameters) (*Inbox, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	conversations, err := m.querier.List(ctx, accountID, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	unread, err := m.querier.UnreadTotal(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Inbox{
+		Conversations: conversations,
+		UnreadTotal:   unread,
+	}, nil
+}
+
+type Partial struct {
+	Title   opt.Optional[string]
+	Content datagraph.Content
+	Assets  []asset.AssetID
+}
+
+// Start sends the first message to the given recipients. A message to a single
+// member without a title continues the existing conversation between the two
+// of them if there is one.
+func (m *Manager) Start(ctx context.Context, recipients []account.AccountID, p Partial) (*conversation.Conversation, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	recipients = lo.Without(lo.Uniq(recipients), accountID)
+	if len(recipients) == 0 {
+		return nil, fault.Wrap(ErrNoRecipients, fctx.With(ctx),
+			fmsg.WithDesc("no recipients", "A conversation needs at least one other member."))
+	}
+	if len(recipients)+1 > conversation.MaxParticipants {
+		return nil, fault.Wrap(ErrNoRecipients, fctx.With(ctx),
+			fmsg.WithDesc("too many recipients", fmt.Sprintf("A conversation can have at most %d members.", conversation.MaxParticipants)))
+	}
+
+	if err := m.validate(ctx, accountID, p); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, r := range recipients {
+		if err := m.canMessage(ctx, accountID, r); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	id, err := m.findOrCreate(ctx, accountID, recipients, p.Title)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.send(ctx, id, accountID, p); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.querier.Get(ctx, id, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+func (m *Manager) Send(ctx context.Context, id conversation.ConversationID, p Partial) (*conversation.Message, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.get(ctx, id, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.validate(ctx, accountID, p); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Group conversations carry on regardless of any one member, but a direct
+	// conversation is only open while the other member may be messaged.
+	if !c.IsGroup() {
+		for _, other := range c.Participants {
+			if other.Profile.ID == accountID || !other.Active() {
+				continue
+			}
+			if err := m.canMessage(ctx, accountID, other.Profile.ID); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+	}
+
+	msg, err := m.send(ctx, id, accountID, p)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return msg, nil
+}
+
+func (m *Manager) Get(ctx context.Context, id conversation.ConversationID, page pagination.Parameters) (*conversation.Conversation, *pagination.Result[*conversation.Message], error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.get(ctx, id, accountID)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	messages, err := m.querier.ListMessages(ctx, id, page)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, messages, nil
+}
+
+func (m *Manager) MarkRead(ctx context.Context, id conversation.ConversationID) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.get(ctx, id, accountID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.MarkRead(ctx, id, accountID, time.Now()); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) Leave(ctx context.Context, id conversation.ConversationID) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.get(ctx, id, accountID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.Leave(ctx, id, accountID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) get(ctx context.Context, id conversation.ConversationID, accountID account.AccountID) (*conversation.Conversation, error) {
+	c, err := m.querier.Get(ctx, id, accountID)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !c.IsMember(accountID) {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+func (m *Manager) findOrCreate(ctx context.Context, accountID account.AccountID, recipients []account.AccountID, title opt.Optional[string]) (conversation.ConversationID, error) {
+	if len(recipients) == 1 && !title.Ok() {
+		existing, err := m.querier.FindDirect(ctx, accountID, recipients[0])
+		if err != nil {
+			return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if id, ok := existing.Get(); ok {
+			return id, nil
+		}
+	}
+
+	id, err := m.writer.Create(ctx, title, append([]account.AccountID{accountID}, recipients...))
+	if err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return id, nil
+}
+
+func (m *Manager) send(ctx context.Context, id conversation.ConversationID, accountID account.AccountID, p Partial) (*conversation.Message, error) {
+	messageID, err := m.writer.AddMessage(ctx, id, accountID, p.Content, p.Assets)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventDirectMessageSent{
+		ID:             messageID,
+		ConversationID: id,
+		AuthorID:       accountID,
+	})
+
+	msg, err := m.querier.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return msg, nil
+}
+
+// validate rejects empty messages and attachments the sender did not upload,
+// which would otherwise grant the recipients access to someone else's private
+// assets.
+func (m *Manager) validate(ctx context.Context, accountID account.AccountID, p Partial) error {
+	if p.Content.IsEmpty() && len(p.Assets) == 0 {
+		return fault.Wrap(ErrEmptyMessage, fctx.With(ctx),
+			fmsg.WithDesc("empty message", "A message needs some text or an attachment."))
+	}
+
+	for _, id := range p.Assets {
+		a, err := m.assetQuerier.GetByID(ctx, id)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if a.OwnerID != xid.ID(accountID) {
+			return fault.Wrap(ErrAssetNotOwned, fctx.With(ctx),
+				fmsg.WithDesc("asset not owned", "Only files you uploaded can be attached to a message."))
+		}
+	}
+
+	return nil
+}
+
+// canMessage decides whether the sender may start or continue a conversation
+// with the recipient, which is never the case once either has blocked the
+// other.
+func (m *Manager) canMessage(ctx context.Context, senderID, recipientID account.AccountID) error {
+	recipient, err := m.accountQuerier.GetByID(ctx, recipientID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if recipient.IsSuspended() {
+		return fault.Wrap(ErrCannotMessage, fctx.With(ctx),
+			fmsg.WithDesc("recipient suspended", "This member's account is suspended."))
+	}
+
+	blocked, err := m.blockQuerier.IsBlocked(ctx, senderID, recipientID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if blocked {
+		return fault.Wrap(ErrCannotMessage, fctx.With(ctx),
+			fmsg.WithDesc("blocked", "You can't message this member."))
+	}
+
+	return nil
+}
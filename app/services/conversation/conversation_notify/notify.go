@@ -0,0 +1,72 @@
+package conversation_notify
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_querier"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(func(
+		lc fx.Lifecycle,
+		bus *pubsub.Bus,
+		notifier *notify.Notifier,
+		querier *conversation_querier.Querier,
+	) {
+		consumer := func(hctx context.Context) error {
+			if _, err := pubsub.Subscribe(hctx, bus, "conversation_notify.direct_message_sent", func(ctx context.Context, evt *message.EventDirectMessageSent) error {
+				return sendDirectMessage(ctx, notifier, querier, evt)
+			}); err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		lc.Append(fx.StartHook(consumer))
+	})
+}
+
+// sendDirectMessage notifies the other members of a conversation, but only for
+// the first message they haven't read so a busy conversation doesn't produce a
+// notification for every message.
+func sendDirectMessage(
+	ctx context.Context,
+	notifier *notify.Notifier,
+	querier *conversation_querier.Querier,
+	evt *message.EventDirectMessageSent,
+) error {
+	c, err := querier.Get(ctx, evt.ConversationID, evt.AuthorID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, p := range c.Participants {
+		if p.Profile.ID == evt.AuthorID || !p.Active() {
+			continue
+		}
+
+		seen, err := querier.Get(ctx, evt.ConversationID, p.Profile.ID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if seen.Unread != 1 {
+			continue
+		}
+
+		if err := notifier.Send(ctx, p.Profile.ID, opt.New(evt.AuthorID), notification.EventDirectMessage, nil); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Package discord_bot runs a Discord bot which mirrors new Storyden threads
+// in mapped categories to Discord forum posts and syncs replies written in
+// those forum posts back to Storyden, attributed to the Storyden account that
+// the Discord author has linked via Discord sign-in.
+package discord_bot
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+
+	"github.com/Southclaws/fault"
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/discord_bridge"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/reply"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(newBridge),
+		fx.Invoke(runBridge),
+	)
+}
+
+// forumClient is the subset of the Discord API used to mirror threads.
+type forumClient interface {
+	ForumThreadStart(channelID, name string, archiveDuration int, content string, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+}
+
+type bridge struct {
+	logger        *slog.Logger
+	address       url.URL
+	settings      *settings.SettingsRepository
+	threadQuerier *thread_querier.Querier
+	links         *discord_bridge.Repository
+	authRepo      authentication.Repository
+	replyService  reply.Service
+	client        forumClient
+}
+
+func newBridge(
+	cfg config.Config,
+	logger *slog.Logger,
+	settings *settings.SettingsRepository,
+	threadQuerier *thread_querier.Querier,
+	links *discord_bridge.Repository,
+	authRepo authentication.Repository,
+	replyService reply.Service,
+) *bridge {
+	return &bridge{
+		logger:        logger,
+		address:       cfg.PublicWebAddress,
+		settings:      settings,
+		threadQuerier: threadQuerier,
+		links:         links,
+		authRepo:      authRepo,
+		replyService:  replyService,
+	}
+}
+
+func runBridge(
+	ctx context.Context,
+	cfg config.Config,
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	b *bridge,
+) error {
+	if cfg.DiscordBotToken == "" {
+		return nil
+	}
+
+	session, err := discordgo.New("Bot " + cfg.DiscordBotToken)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	session.Identify.Intents = discordgo.IntentsGuildMessages | discordgo.IntentMessageContent
+
+	session.AddHandler(func(s *discordgo.Session, m *discordgo.MessageCreate) {
+		if s.State.User != nil && m.Author != nil && m.Author.ID == s.State.User.ID {
+			return
+		}
+
+		if err := b.onMessage(ctx, newInboundMessage(m.Message)); err != nil {
+			b.logger.Error("failed to sync discord message",
+				slog.String("channel_id", m.ChannelID),
+				slog.String("message_id", m.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+
+	b.client = session
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if err := session.Open(); err != nil {
+			return fault.Wrap(err)
+		}
+
+		_, err := pubsub.Subscribe(hctx, bus, "discord_bridge.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			return b.onThreadPublished(ctx, evt.ID)
+		})
+		return err
+	}))
+
+	lc.Append(fx.StopHook(func() error {
+		return session.Close()
+	}))
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package discord_bot
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/discord_bridge"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/services/reply"
+)
+
+const (
+	// Discord's limits for forum post titles and message content.
+	maxTitleLength   = 100
+	maxContentLength = 2000
+
+	// Forum posts are archived after a week of inactivity, the longest period
+	// Discord allows.
+	archiveDuration = 10080
+)
+
+// inboundMessage is the part of a Discord message needed to sync it.
+type inboundMessage struct {
+	ID        string
+	ChannelID string
+	AuthorID  string
+	Bot       bool
+	Content   string
+	ReplyToID string
+}
+
+func newInboundMessage(m *discordgo.Message) inboundMessage {
+	in := inboundMessage{
+		ID:        m.ID,
+		ChannelID: m.ChannelID,
+		Content:   m.Content,
+	}
+
+	if m.Author != nil {
+		in.AuthorID = m.Author.ID
+		in.Bot = m.Author.Bot
+	}
+
+	if m.MessageReference != nil {
+		in.ReplyToID = m.MessageReference.MessageID
+	}
+
+	for _, a := range m.Attachments {
+		in.Content += "\n\n" + a.URL
+	}
+
+	return in
+}
+
+func (b *bridge) onThreadPublished(ctx context.Context, id post.ID) error {
+	if _, err := b.links.GetByPost(ctx, id); err == nil {
+		return nil
+	} else if ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := b.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thr, err := b.threadQuerier.Get(ctx, id, pagination.Parameters{}, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	cat := opt.Map(thr.Category, func(c category.Category) category.CategoryID { return c.ID })
+	channelID, ok := s.DiscordBridge.OrZero().ForumFor(cat)
+	if !ok {
+		return nil
+	}
+
+	link := b.address.JoinPath("t", mark.NewMark(xid.ID(thr.ID), thr.Slug).String()).String()
+
+	forumPost, err := b.client.ForumThreadStart(channelID,
+		truncate(thr.Title, maxTitleLength),
+		archiveDuration,
+		formatThread(thr.Author.Name, thr.Content.Plaintext(), link),
+		discordgo.WithContext(ctx),
+	)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// A forum post's starter message shares the ID of the post's channel.
+	err = b.links.Create(ctx, discord_bridge.Link{
+		PostID:    thr.ID,
+		ThreadID:  thr.ID,
+		ChannelID: forumPost.ID,
+		MessageID: forumPost.ID,
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (b *bridge) onMessage(ctx context.Context, m inboundMessage) error {
+	if m.Bot || strings.TrimSpace(m.Content) == "" {
+		return nil
+	}
+
+	threadID, err := b.links.GetThreadByChannel(ctx, m.ChannelID)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := b.links.GetByMessage(ctx, m.ID); err == nil {
+		return nil
+	} else if ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	auth, linked, err := b.authRepo.LookupByIdentifier(ctx, authentication.ServiceOAuthDiscord, m.AuthorID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !linked || auth.Disabled || auth.Account.DeletedAt.Ok() {
+		b.logger.Debug("skipping discord message from unlinked author",
+			slog.String("message_id", m.ID),
+			slog.String("author_id", m.AuthorID),
+		)
+		return nil
+	}
+
+	content, err := datagraph.NewRichTextFromMarkdown(m.Content)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	replyTo := opt.NewEmpty[post.ID]()
+	if m.ReplyToID != "" {
+		if parent, err := b.links.GetByMessage(ctx, m.ReplyToID); err == nil && parent.PostID != threadID {
+			replyTo = opt.New(parent.PostID)
+		}
+	}
+
+	r, err := b.replyService.Create(ctx, auth.Account.ID, threadID, reply.Partial{
+		Content: opt.New(content),
+		ReplyTo: replyTo,
+		Meta:    opt.New(map[string]any{"discord_message_id": m.ID}),
+	})
+	if err != nil {
+		if ftag.Get(err) == ftag.PermissionDenied {
+			b.logger.Debug("skipping discord message from author who can't reply",
+				slog.String("message_id", m.ID),
+				slog.String("author_id", m.AuthorID),
+			)
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = b.links.Create(ctx, discord_bridge.Link{
+		PostID:    r.ID,
+		ThreadID:  threadID,
+		ChannelID: m.ChannelID,
+		MessageID: m.ID,
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func formatThread(author string, body string, link string) string {
+	footer := "\n\n— " + author + " on " + link
+	return truncate(strings.TrimSpace(body), maxContentLength-len([]rune(footer))) + footer
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
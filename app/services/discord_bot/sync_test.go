@@ -0,0 +1,40 @@
+package discord_bot
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInboundMessage(t *testing.T) {
+	a := assert.New(t)
+
+	m := newInboundMessage(&discordgo.Message{
+		ID:               "2",
+		ChannelID:        "1",
+		Content:          "hello",
+		Author:           &discordgo.User{ID: "3"},
+		MessageReference: &discordgo.MessageReference{MessageID: "4"},
+		Attachments:      []*discordgo.MessageAttachment{{URL: "https://cdn.discordapp.com/a.png"}},
+	})
+
+	a.Equal("2", m.ID)
+	a.Equal("1", m.ChannelID)
+	a.Equal("3", m.AuthorID)
+	a.False(m.Bot)
+	a.Equal("4", m.ReplyToID)
+	a.Equal("hello\n\nhttps://cdn.discordapp.com/a.png", m.Content)
+}
+
+func TestFormatThread(t *testing.T) {
+	a := assert.New(t)
+
+	short := formatThread("Odin", "hello", "https://example.com/t/hello")
+	a.Equal("hello\n\n— Odin on https://example.com/t/hello", short)
+
+	long := formatThread("Odin", strings.Repeat("a", 5000), "https://example.com/t/hello")
+	a.Len([]rune(long), maxContentLength)
+	a.True(strings.HasSuffix(long, "— Odin on https://example.com/t/hello"))
+}
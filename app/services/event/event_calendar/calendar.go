@@ -0,0 +1,168 @@
+// Package event_calendar publishes events as iCalendar documents so members
+// can subscribe to a community's meetups from their own calendar app.
+package event_calendar
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/event"
+	"github.com/Southclaws/storyden/app/resources/event/event_querier"
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/event/location"
+	"github.com/Southclaws/storyden/app/resources/event/participation"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/app/services/syndication"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/i18n"
+)
+
+// Past events stay on subscribed calendars for a while so they don't vanish
+// from a member's history the moment they end.
+const history = 90 * 24 * time.Hour
+
+var errNotFound = fault.New("event not found", ftag.With(ftag.NotFound))
+
+type Calendar struct {
+	webAddress url.URL
+	settings   *settings.SettingsRepository
+	querier    *event_querier.Querier
+	locales    *locale.Resolver
+}
+
+func New(
+	cfg config.Config,
+	settings *settings.SettingsRepository,
+	querier *event_querier.Querier,
+	locales *locale.Resolver,
+) *Calendar {
+	return &Calendar{
+		webAddress: cfg.PublicWebAddress,
+		settings:   settings,
+		querier:    querier,
+		locales:    locales,
+	}
+}
+
+// Events is every published event that hasn't long since ended.
+func (c *Calendar) Events(ctx context.Context) (*syndication.Document, error) {
+	set, err := c.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tz, err := c.locales.DefaultTimezone(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	evts, err := c.querier.ListWithThreads(ctx,
+		event_querier.HasStatus(visibility.VisibilityPublished),
+		event_querier.HasNotBeenDeleted(),
+		event_querier.HasEndedAfter(time.Now().Add(-history)),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Render(&Feed{
+		Name:        set.Title.Or("Storyden") + " events",
+		Description: set.Description.OrZero(),
+		Timezone:    tz.String(),
+		Entries:     dt.Map(evts, c.entry),
+	}), nil
+}
+
+// Participating is the events a member is attending, including unlisted ones
+// they were given a link to.
+func (c *Calendar) Participating(ctx context.Context, accountID account.AccountID) (*syndication.Document, error) {
+	set, err := c.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The member's own calendar is shown in their timezone.
+	ctx, err = c.locales.ForAccountID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	evts, err := c.querier.ListWithThreads(ctx,
+		event_querier.HasStatus(visibility.VisibilityPublished, visibility.VisibilityUnlisted),
+		event_querier.HasNotBeenDeleted(),
+		event_querier.HasEndedAfter(time.Now().Add(-history)),
+		event_querier.HasParticipant(accountID, participation.StatusAttending),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return Render(&Feed{
+		Name:     set.Title.Or("Storyden") + " — My events",
+		Timezone: i18n.Timezone(ctx).String(),
+		Entries:  dt.Map(evts, c.entry),
+	}), nil
+}
+
+// Event is a single event, for one-off "add to calendar" downloads.
+func (c *Calendar) Event(ctx context.Context, mk event_ref.QueryKey) (*syndication.Document, error) {
+	evt, err := c.querier.Get(ctx, mk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if evt.DeletedAt.Ok() {
+		return nil, fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	if evt.Visibility != visibility.VisibilityPublished && evt.Visibility != visibility.VisibilityUnlisted {
+		return nil, fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	return Render(&Feed{
+		Name:    evt.Name,
+		Entries: []Entry{c.entry(evt)},
+	}), nil
+}
+
+func (c *Calendar) entry(e *event.Event) Entry {
+	entry := Entry{
+		UID:         e.ID.String() + "@" + c.webAddress.Hostname(),
+		Summary:     e.Name,
+		Description: e.GetDesc(),
+		URL:         c.webAddress.JoinPath("t", mark.NewMark(xid.ID(e.Thread.ID), e.Thread.Slug).String()).String(),
+		Start:       e.TimeRange.Start,
+		End:         e.TimeRange.End,
+		Created:     e.CreatedAt,
+		Updated:     e.UpdatedAt,
+	}
+
+	switch l := e.Location.(type) {
+	case *location.Physical:
+		entry.Location = joinNonEmpty(l.Name, l.Address.OrZero())
+		entry.Latitude = l.Latitude
+		entry.Longitude = l.Longitude
+
+	case *location.Virtual:
+		entry.Location = joinNonEmpty(l.Name, opt.Map(l.URL, func(u url.URL) string { return u.String() }).OrZero())
+	}
+
+	return entry
+}
+
+func joinNonEmpty(parts ...string) string {
+	return strings.Join(dt.Filter(parts, func(s string) bool { return s != "" }), ", ")
+}
@@ -0,0 +1,150 @@
+package event_calendar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/services/syndication"
+)
+
+const contentType = "text/calendar; charset=utf-8"
+
+// RFC 5545 limits content lines to 75 octets excluding the line break.
+const maxLineLength = 75
+
+type Feed struct {
+	Name        string
+	Description string
+
+	// Timezone is the IANA time zone calendar apps should show the events in,
+	// the events themselves are always written in UTC.
+	Timezone string
+	Entries  []Entry
+}
+
+type Entry struct {
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Location    string
+	Latitude    opt.Optional[float64]
+	Longitude   opt.Optional[float64]
+	Start       time.Time
+	End         time.Time
+	Created     time.Time
+	Updated     time.Time
+}
+
+// Render encodes a calendar as an iCalendar document. Every timestamp in the
+// output is derived from the entries so identical calendars hash identically.
+func Render(f *Feed) *syndication.Document {
+	w := &writer{}
+
+	w.prop("BEGIN", "VCALENDAR")
+	w.prop("VERSION", "2.0")
+	w.prop("PRODID", "-//Storyden//Events//EN")
+	w.prop("CALSCALE", "GREGORIAN")
+	w.prop("METHOD", "PUBLISH")
+	w.prop("X-WR-CALNAME", escape(f.Name))
+	if f.Description != "" {
+		w.prop("X-WR-CALDESC", escape(f.Description))
+	}
+	if f.Timezone != "" {
+		w.prop("X-WR-TIMEZONE", f.Timezone)
+	}
+
+	var updated time.Time
+	for _, e := range f.Entries {
+		if e.Updated.After(updated) {
+			updated = e.Updated
+		}
+
+		w.prop("BEGIN", "VEVENT")
+		w.prop("UID", escape(e.UID))
+		w.prop("DTSTAMP", timestamp(e.Updated))
+		w.prop("DTSTART", timestamp(e.Start))
+		w.prop("DTEND", timestamp(e.End))
+		w.prop("CREATED", timestamp(e.Created))
+		w.prop("LAST-MODIFIED", timestamp(e.Updated))
+		w.prop("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			w.prop("DESCRIPTION", escape(e.Description))
+		}
+		if e.Location != "" {
+			w.prop("LOCATION", escape(e.Location))
+		}
+		lat, latOK := e.Latitude.Get()
+		long, longOK := e.Longitude.Get()
+		if latOK && longOK {
+			w.prop("GEO", fmt.Sprintf("%f;%f", lat, long))
+		}
+		if e.URL != "" {
+			w.prop("URL", e.URL)
+		}
+		w.prop("END", "VEVENT")
+	}
+
+	w.prop("END", "VCALENDAR")
+
+	body := w.buf.Bytes()
+	sum := sha256.Sum256(body)
+
+	return &syndication.Document{
+		Body:         body,
+		ContentType:  contentType,
+		LastModified: updated,
+		ETag:         `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`,
+	}
+}
+
+type writer struct {
+	buf bytes.Buffer
+}
+
+// prop writes a content line, folding it onto continuation lines without ever
+// splitting a multi-byte character.
+func (w *writer) prop(name, value string) {
+	line := name + ":" + value
+
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		w.buf.WriteString(line[:cut])
+		w.buf.WriteString("\r\n ")
+		line = line[cut:]
+
+		// Continuation lines lose one octet to the leading space.
+		limit = maxLineLength - 1
+	}
+
+	w.buf.WriteString(line)
+	w.buf.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
@@ -0,0 +1,82 @@
+package event_calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	start := time.Date(2025, 3, 14, 18, 30, 0, 0, time.FixedZone("CET", 3600))
+	updated := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	f := &Feed{
+		Name: "Storyden events",
+		Entries: []Entry{{
+			UID:         "abc@example.com",
+			Summary:     "Beers, code; design",
+			Description: "Line one\nLine two",
+			URL:         "https://example.com/t/abc",
+			Location:    "The Pub, 1 High Street",
+			Latitude:    opt.New(51.5),
+			Longitude:   opt.New(-0.12),
+			Start:       start,
+			End:         start.Add(3 * time.Hour),
+			Created:     updated,
+			Updated:     updated,
+		}},
+	}
+
+	doc := Render(f)
+	body := string(doc.Body)
+
+	a.Equal("text/calendar; charset=utf-8", doc.ContentType)
+	a.Equal(updated, doc.LastModified)
+	a.NotEmpty(doc.ETag)
+
+	a.True(strings.HasPrefix(body, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	a.True(strings.HasSuffix(body, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	a.Contains(body, "DTSTART:20250314T173000Z\r\n")
+	a.Contains(body, "DTEND:20250314T203000Z\r\n")
+	a.Contains(body, `SUMMARY:Beers\, code\; design`)
+	a.Contains(body, `DESCRIPTION:Line one\nLine two`)
+	a.Contains(body, `LOCATION:The Pub\, 1 High Street`)
+	a.Contains(body, "GEO:51.500000;-0.120000\r\n")
+
+	t.Run("folds_long_lines", func(t *testing.T) {
+		long := &Feed{Name: strings.Repeat("é", 100)}
+
+		doc := Render(long)
+
+		lines := strings.Split(strings.TrimSuffix(string(doc.Body), "\r\n"), "\r\n")
+		var unfolded strings.Builder
+		for _, l := range lines {
+			r.LessOrEqual(len(l), maxLineLength)
+			if strings.HasPrefix(l, " ") {
+				unfolded.WriteString(l[1:])
+			} else {
+				unfolded.WriteString("\n" + l)
+			}
+		}
+
+		a.Contains(unfolded.String(), "X-WR-CALNAME:"+strings.Repeat("é", 100))
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		a.NotContains(body, "X-WR-TIMEZONE")
+
+		doc := Render(&Feed{Name: "Storyden events", Timezone: "Europe/Berlin"})
+		a.Contains(string(doc.Body), "X-WR-TIMEZONE:Europe/Berlin\r\n")
+	})
+
+	t.Run("etag_is_stable", func(t *testing.T) {
+		a.Equal(Render(f).ETag, Render(f).ETag)
+	})
+}
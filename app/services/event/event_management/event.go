@@ -64,6 +64,7 @@ type Partial struct {
 	Name                opt.Optional[string]
 	Slug                opt.Optional[string]
 	Description         opt.Optional[string]
+	Content             opt.Optional[datagraph.Content]
 	TimeRange           opt.Optional[event_ref.TimeRange]
 	Image               opt.Optional[asset.AssetID]
 	ParticipationPolicy opt.Optional[participation.Policy]
@@ -189,6 +190,21 @@ func (m *Manager) Update(ctx context.Context, mk event_ref.QueryKey, partial Par
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to update event"))
 	}
 
+	// The event's long-form content lives on its discussion thread.
+	if content, ok := partial.Content.Get(); ok {
+		_, err = m.threadWriter.Update(ctx, current.Thread.ID, thread.Partial{
+			Content: opt.New(content),
+		})
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		evt, err = m.querier.Get(ctx, event_ref.NewID(xid.ID(evt.ID)))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	m.bus.Publish(ctx, &message.EventActivityUpdated{
 		ID: evt.ID,
 	})
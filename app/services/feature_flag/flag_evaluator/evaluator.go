@@ -0,0 +1,145 @@
+// Package flag_evaluator decides which feature flags are on for the account
+// making a request. Flags are cached in memory and reloaded whenever any
+// instance changes one.
+package flag_evaluator
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/feature_flag"
+	"github.com/Southclaws/storyden/app/resources/feature_flag/feature_flag_querier"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var ErrFeatureDisabled = fault.New("feature not enabled", ftag.With(ftag.NotFound))
+
+type Evaluator struct {
+	flagQuerier *feature_flag_querier.Querier
+
+	mu    sync.RWMutex
+	flags map[string]*feature_flag.Flag
+}
+
+func New(
+	lc fx.Lifecycle,
+	flagQuerier *feature_flag_querier.Querier,
+	bus *pubsub.Bus,
+) *Evaluator {
+	e := &Evaluator{flagQuerier: flagQuerier}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.Subscribe(hctx, bus, "flag_evaluator.reload", func(ctx context.Context, evt *message.EventFeatureFlagUpdated) error {
+			e.mu.Lock()
+			e.flags = nil
+			e.mu.Unlock()
+			return nil
+		})
+		return err
+	}))
+
+	return e
+}
+
+// Enabled reports whether the flag is on for the session's account. Unknown
+// flags are always off.
+func (e *Evaluator) Enabled(ctx context.Context, key string) (bool, error) {
+	flags, err := e.load(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	f, ok := flags[key]
+	if !ok {
+		return false, nil
+	}
+
+	accountID, roles := subject(ctx)
+
+	return f.Evaluate(accountID, roles), nil
+}
+
+// Require fails with a not found error when the flag is off so features which
+// haven't been rolled out to the member appear not to exist.
+func (e *Evaluator) Require(ctx context.Context, key string) error {
+	enabled, err := e.Enabled(ctx, key)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !enabled {
+		return fault.Wrap(ErrFeatureDisabled, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// List returns the keys of every flag which is on for the session's account.
+func (e *Evaluator) List(ctx context.Context) ([]string, error) {
+	flags, err := e.load(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accountID, roles := subject(ctx)
+
+	keys := []string{}
+	for key, f := range flags {
+		if f.Evaluate(accountID, roles) {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys, nil
+}
+
+func (e *Evaluator) load(ctx context.Context) (map[string]*feature_flag.Flag, error) {
+	e.mu.RLock()
+	flags := e.flags
+	e.mu.RUnlock()
+
+	if flags != nil {
+		return flags, nil
+	}
+
+	list, err := e.flagQuerier.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	flags = make(map[string]*feature_flag.Flag, len(list))
+	for _, f := range list {
+		flags[f.Key] = f
+	}
+
+	e.mu.Lock()
+	e.flags = flags
+	e.mu.Unlock()
+
+	return flags, nil
+}
+
+func subject(ctx context.Context) (opt.Optional[account.AccountID], []role.RoleID) {
+	accountID := session.GetOptAccountID(ctx)
+	if !accountID.Ok() {
+		return accountID, nil
+	}
+
+	roles := dt.Map(session.GetRoles(ctx), func(r *role.Role) role.RoleID { return r.ID })
+
+	return accountID, roles
+}
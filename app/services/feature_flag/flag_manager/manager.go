@@ -0,0 +1,117 @@
+package flag_manager
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/feature_flag"
+	"github.com/Southclaws/storyden/app/resources/feature_flag/feature_flag_querier"
+	"github.com/Southclaws/storyden/app/resources/feature_flag/feature_flag_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var ErrInvalidFlag = fault.New("invalid feature flag", ftag.With(ftag.InvalidArgument))
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+type Manager struct {
+	flagQuerier *feature_flag_querier.Querier
+	flagWriter  *feature_flag_writer.Writer
+	bus         *pubsub.Bus
+}
+
+func New(
+	flagQuerier *feature_flag_querier.Querier,
+	flagWriter *feature_flag_writer.Writer,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		flagQuerier: flagQuerier,
+		flagWriter:  flagWriter,
+		bus:         bus,
+	}
+}
+
+type Partial struct {
+	Description opt.Optional[string]
+	Enabled     opt.Optional[bool]
+	Percentage  opt.Optional[int]
+	Roles       opt.Optional[[]role.RoleID]
+	Accounts    opt.Optional[[]account.AccountID]
+}
+
+func (p Partial) Opts() (opts []feature_flag_writer.Option) {
+	p.Description.Call(func(v string) { opts = append(opts, feature_flag_writer.WithDescription(v)) })
+	p.Enabled.Call(func(v bool) { opts = append(opts, feature_flag_writer.WithEnabled(v)) })
+	p.Percentage.Call(func(v int) { opts = append(opts, feature_flag_writer.WithPercentage(v)) })
+	p.Roles.Call(func(v []role.RoleID) { opts = append(opts, feature_flag_writer.WithRoles(v)) })
+	p.Accounts.Call(func(v []account.AccountID) { opts = append(opts, feature_flag_writer.WithAccounts(v)) })
+	return
+}
+
+func (m *Manager) List(ctx context.Context) (feature_flag.Flags, error) {
+	return m.flagQuerier.List(ctx)
+}
+
+func (m *Manager) Create(ctx context.Context, key string, p Partial) (*feature_flag.Flag, error) {
+	if !keyPattern.MatchString(key) {
+		return nil, fault.Wrap(ErrInvalidFlag, fctx.With(ctx),
+			fmsg.WithDesc("invalid key", "Feature flag keys may only contain lowercase letters, numbers, dots, dashes and underscores."))
+	}
+
+	if err := validate(p); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	f, err := m.flagWriter.Create(ctx, key, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventFeatureFlagUpdated{Key: f.Key})
+
+	return f, nil
+}
+
+func (m *Manager) Update(ctx context.Context, key string, p Partial) (*feature_flag.Flag, error) {
+	if err := validate(p); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	f, err := m.flagWriter.Update(ctx, key, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventFeatureFlagUpdated{Key: f.Key})
+
+	return f, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, key string) error {
+	if err := m.flagWriter.Delete(ctx, key); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventFeatureFlagUpdated{Key: key})
+
+	return nil
+}
+
+func validate(p Partial) error {
+	if v, ok := p.Percentage.Get(); ok && (v < 0 || v > 100) {
+		return fault.Wrap(ErrInvalidFlag,
+			fmsg.WithDesc("invalid percentage", "The rollout percentage must be between 0 and 100."))
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package feature_flag
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/feature_flag/flag_evaluator"
+	"github.com/Southclaws/storyden/app/services/feature_flag/flag_manager"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(flag_manager.New),
+		fx.Provide(flag_evaluator.New),
+	)
+}
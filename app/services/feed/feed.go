@@ -0,0 +1,150 @@
+// Package feed assembles a member's personalised home timeline from the
+// accounts, categories and tags they follow and the threads they have taken
+// part in, ordered by a pluggable ranking Strategy.
+package feed
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/follow_querier"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+)
+
+// Ranking happens in memory over a window of the most recently active
+// candidate threads, the window is fetched in pages of candidatePageSize.
+const (
+	candidatePageSize = 100
+	candidatePages    = 3
+)
+
+var errUnsupportedMode = fault.New("unsupported feed mode", ftag.With(ftag.InvalidArgument))
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Feed struct {
+	accountQuerier *account_querier.Querier
+	threadQuerier  *thread_querier.Querier
+	followQuerier  *follow_querier.Querier
+	strategies     map[Mode]Strategy
+}
+
+func New(
+	accountQuerier *account_querier.Querier,
+	threadQuerier *thread_querier.Querier,
+	followQuerier *follow_querier.Querier,
+) *Feed {
+	return &Feed{
+		accountQuerier: accountQuerier,
+		threadQuerier:  threadQuerier,
+		followQuerier:  followQuerier,
+		strategies: map[Mode]Strategy{
+			ModeLatest:      latest{},
+			ModeTop:         top{},
+			ModeRecommended: recommended{gravity: 1.5},
+		},
+	}
+}
+
+func (f *Feed) List(ctx context.Context, accountID account.AccountID, mode Mode, page int, size int) (*thread_querier.Result, error) {
+	strategy, ok := f.strategies[mode]
+	if !ok {
+		return nil, fault.Wrap(errUnsupportedMode, fctx.With(ctx), fmsg.WithDesc("unsupported mode", "The requested feed mode is not supported."))
+	}
+
+	candidates, err := f.candidates(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	subs, err := f.followQuerier.GetSubscriptionSet(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	now := time.Now()
+
+	type scored struct {
+		item  *Item
+		score float64
+	}
+
+	ranked := dt.Map(candidates, func(t *thread.Thread) scored {
+		item := newItem(t, subs)
+		return scored{item: item, score: strategy.Score(now, item)}
+	})
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	threads := dt.Map(ranked, func(s scored) *thread.Thread { return s.item.Thread })
+
+	return paginate(threads, page, size), nil
+}
+
+func (f *Feed) candidates(ctx context.Context, accountID account.AccountID) ([]*thread.Thread, error) {
+	acc, err := f.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threads := []*thread.Thread{}
+
+	for page := range candidatePages {
+		r, err := f.threadQuerier.List(ctx, page, candidatePageSize, opt.New(accountID),
+			thread_querier.HasNotBeenDeleted(),
+			thread_querier.HasStatus(visibility.VisibilityPublished),
+			thread_querier.IsInFeedOf(accountID),
+			thread_querier.IsNotHiddenFrom(accountID),
+			thread_querier.IsReadableBy(opt.New(accountID)),
+			thread_querier.IsInReadableCategory(acc.Roles.Roles()),
+		)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to list feed candidates"))
+		}
+
+		threads = append(threads, r.Threads...)
+
+		if !r.NextPage.Ok() {
+			break
+		}
+	}
+
+	return threads, nil
+}
+
+func paginate(threads []*thread.Thread, page int, size int) *thread_querier.Result {
+	if size < 1 {
+		size = 1
+	}
+
+	total := len(threads)
+	start := min(page*size, total)
+	end := min(start+size, total)
+
+	return &thread_querier.Result{
+		PageSize:    size,
+		Results:     end - start,
+		TotalPages:  int(math.Ceil(float64(total) / float64(size))),
+		CurrentPage: page,
+		NextPage:    opt.NewSafe(page+1, end < total),
+		Threads:     threads[start:end],
+	}
+}
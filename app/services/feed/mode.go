@@ -0,0 +1,11 @@
+package feed
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type modeEnum string
+
+const (
+	modeLatest      modeEnum = "latest"
+	modeTop         modeEnum = "top"
+	modeRecommended modeEnum = "recommended"
+)
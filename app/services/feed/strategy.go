@@ -0,0 +1,106 @@
+package feed
+
+import (
+	"math"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/profile/follow_querier"
+)
+
+// Strategy scores a candidate thread for a member's feed, higher scores are
+// ranked first. New ranking modes are added by implementing this interface and
+// registering the implementation against a Mode in New.
+type Strategy interface {
+	Score(now time.Time, item *Item) float64
+}
+
+// Item is a candidate thread along with how strongly it relates to the member
+// the feed is being built for.
+type Item struct {
+	Thread   *thread.Thread
+	Affinity float64
+}
+
+const (
+	affinityAuthor      = 3.0
+	affinityCategory    = 2.0
+	affinityTag         = 1.0
+	affinityTagMax      = 2.0
+	affinityReplied     = 1.0
+	affinityLiked       = 0.5
+	engagementLikeScore = 2.0
+)
+
+func newItem(t *thread.Thread, subs *follow_querier.SubscriptionSet) *Item {
+	affinity := 0.0
+
+	if _, ok := subs.Accounts[xid.ID(t.Author.ID)]; ok {
+		affinity += affinityAuthor
+	}
+
+	if c, ok := t.Category.Get(); ok {
+		if _, ok := subs.Categories[xid.ID(c.ID)]; ok {
+			affinity += affinityCategory
+		}
+	}
+
+	tagAffinity := 0.0
+	for _, tag := range t.Tags {
+		if _, ok := subs.Tags[xid.ID(tag.ID)]; ok {
+			tagAffinity += affinityTag
+		}
+	}
+	affinity += math.Min(tagAffinity, affinityTagMax)
+
+	if t.ReplyStatus.Replied > 0 {
+		affinity += affinityReplied
+	}
+
+	if t.Likes.Status {
+		affinity += affinityLiked
+	}
+
+	return &Item{Thread: t, Affinity: affinity}
+}
+
+func (i *Item) lastActivity() time.Time {
+	return i.Thread.LastReplyAt.Or(i.Thread.CreatedAt)
+}
+
+func (i *Item) engagement() float64 {
+	t := i.Thread
+	return float64(t.ReplyStatus.Count) + float64(t.Likes.Count)*engagementLikeScore + float64(len(t.Reacts))
+}
+
+// latest orders threads by most recent activity.
+type latest struct{}
+
+func (latest) Score(now time.Time, item *Item) float64 {
+	return float64(item.lastActivity().Unix())
+}
+
+// top orders threads by raw engagement regardless of age.
+type top struct{}
+
+func (top) Score(now time.Time, item *Item) float64 {
+	return item.engagement()
+}
+
+// recommended weighs engagement and affinity against age, using the same
+// gravity approach popularised by Hacker News so fresh activity rises and
+// older threads gradually sink.
+type recommended struct {
+	gravity float64
+}
+
+func (r recommended) Score(now time.Time, item *Item) float64 {
+	age := now.Sub(item.lastActivity()).Hours()
+	if age < 0 {
+		age = 0
+	}
+
+	return (item.engagement() + 1) * (item.Affinity + 1) / math.Pow(age+2, r.gravity)
+}
@@ -0,0 +1,227 @@
+// Package summary_job generates summaries for long threads and library pages
+// in the background whenever they're published or change significantly.
+package summary_job
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/summary"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/services/generative"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// maxReplies and maxInput bound how much of a very long thread is sent to the
+// language model, the opening post and earliest replies usually carry the
+// substance of a discussion.
+const (
+	maxReplies = 500
+	maxInput   = 48000
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newSummaryJob)
+}
+
+type summaryJob struct {
+	logger        *slog.Logger
+	minLength     int
+	threshold     float64
+	threadQuerier *thread_querier.Querier
+	nodeQuerier   *node_querier.Querier
+	summaries     *summary.Repository
+	summariser    generative.Summariser
+}
+
+func newSummaryJob(
+	lc fx.Lifecycle,
+	cfg config.Config,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	threadQuerier *thread_querier.Querier,
+	nodeQuerier *node_querier.Querier,
+	summaries *summary.Repository,
+	summariser generative.Summariser,
+) {
+	if !cfg.ContentSummariesEnabled || cfg.LanguageModelProvider == "" {
+		return
+	}
+
+	j := &summaryJob{
+		logger:        logger,
+		minLength:     cfg.ContentSummaryMinLength,
+		threshold:     cfg.ContentSummaryChangeThreshold,
+		threadQuerier: threadQuerier,
+		nodeQuerier:   nodeQuerier,
+		summaries:     summaries,
+		summariser:    summariser,
+	}
+
+	summarise := func(ctx context.Context, kind datagraph.Kind, id xid.ID) error {
+		return bus.SendCommand(ctx, &message.CommandSummarise{Item: datagraph.Ref{ID: id, Kind: kind}})
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if _, err := pubsub.Subscribe(hctx, bus, "summary_job.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			return summarise(ctx, datagraph.KindThread, xid.ID(evt.ID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "summary_job.thread_updated", func(ctx context.Context, evt *message.EventThreadUpdated) error {
+			return summarise(ctx, datagraph.KindThread, xid.ID(evt.ID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "summary_job.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+			return summarise(ctx, datagraph.KindThread, xid.ID(evt.ThreadID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "summary_job.thread_deleted", func(ctx context.Context, evt *message.EventThreadDeleted) error {
+			return summaries.Delete(ctx, xid.ID(evt.ID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "summary_job.node_published", func(ctx context.Context, evt *message.EventNodePublished) error {
+			return summarise(ctx, datagraph.KindNode, xid.ID(evt.ID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "summary_job.node_updated", func(ctx context.Context, evt *message.EventNodeUpdated) error {
+			return summarise(ctx, datagraph.KindNode, xid.ID(evt.ID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "summary_job.node_deleted", func(ctx context.Context, evt *message.EventNodeDeleted) error {
+			return summaries.Delete(ctx, xid.ID(evt.ID))
+		}); err != nil {
+			return err
+		}
+
+		_, err := pubsub.SubscribeCommand(hctx, bus, "summary_job.summarise", func(ctx context.Context, cmd *message.CommandSummarise) error {
+			if err := j.summarise(ctx, cmd.Item); err != nil {
+				logger.Error("failed to summarise content",
+					slog.String("error", err.Error()),
+					slog.String("kind", cmd.Item.Kind.String()),
+					slog.String("id", cmd.Item.ID.String()),
+				)
+				return err
+			}
+			return nil
+		})
+
+		return err
+	}))
+}
+
+func (j *summaryJob) summarise(ctx context.Context, ref datagraph.Ref) error {
+	text, err := j.text(ctx, ref)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if utf8.RuneCountInString(text) < j.minLength {
+		return nil
+	}
+
+	sig := summary.NewSignature(text)
+
+	existing, err := j.summaries.Get(ctx, ref.ID)
+	if err != nil && ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if existing != nil && existing.Signature.Similarity(sig) >= 1-j.threshold {
+		return nil
+	}
+
+	content, err := datagraph.NewRichTextFromMarkdown(truncate(text, maxInput))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := j.summariser.Summarise(ctx, content)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := j.summaries.Store(ctx, ref.Kind, ref.ID, result, sig); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// text returns the plain text of an item which is summarised, for threads
+// this includes the replies.
+func (j *summaryJob) text(ctx context.Context, ref datagraph.Ref) (string, error) {
+	switch ref.Kind {
+	case datagraph.KindThread:
+		thr, err := j.threadQuerier.Get(ctx, post.ID(ref.ID), pagination.NewPageParams(1, maxReplies), opt.NewEmpty[account.AccountID]())
+		if err != nil {
+			return "", fault.Wrap(err, fctx.With(ctx))
+		}
+
+		b := strings.Builder{}
+		b.WriteString(thr.Title)
+		b.WriteString("\n\n")
+		b.WriteString(thr.Content.Plaintext())
+
+		for _, r := range thr.Replies.Items {
+			b.WriteString("\n\n")
+			b.WriteString(r.Content.Plaintext())
+		}
+
+		return b.String(), nil
+
+	case datagraph.KindNode:
+		n, err := j.nodeQuerier.Get(ctx, library.NewID(ref.ID))
+		if err != nil {
+			return "", fault.Wrap(err, fctx.With(ctx))
+		}
+
+		b := strings.Builder{}
+		b.WriteString(n.Name)
+		n.Content.Call(func(c datagraph.Content) {
+			b.WriteString("\n\n")
+			b.WriteString(c.Plaintext())
+		})
+
+		return b.String(), nil
+
+	default:
+		return "", fault.Newf("content kind cannot be summarised: %s", ref.Kind)
+	}
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,196 @@
+// Package archive unpacks the exports importers read. Most platforms export a
+// database dump alongside a directory of uploaded files, either as a tarball
+// or just the dump on its own, either of which may be gzipped.
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+)
+
+var ErrNoDump = fault.New("archive does not contain a database dump")
+
+// Dump is the database dump of an unpacked archive.
+type Dump struct {
+	io.Reader
+	close func() error
+}
+
+func (d *Dump) Close() error {
+	if d.close == nil {
+		return nil
+	}
+	return d.close()
+}
+
+// Unpack opens the export at path. If it's a tarball, the files for which keep
+// returns true are extracted into dir and the first file isDump matches is
+// opened as the dump. Anything else is read as a bare dump.
+func Unpack(path string, dir string, isDump func(name string) bool, keep func(name string) bool) (*Dump, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	r, err := decompress(bufio.NewReader(f))
+	if err != nil {
+		f.Close()
+		return nil, fault.Wrap(err)
+	}
+
+	if !isTar(r) {
+		return &Dump{Reader: r, close: f.Close}, nil
+	}
+
+	dumpPath, err := extract(r, dir, isDump, keep)
+	f.Close()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return Open(dumpPath)
+}
+
+// Extract is for exports made of several files rather than one dump. If the
+// export at path is a tarball, the files for which keep returns true are
+// extracted into dir and their paths returned, otherwise it's the only file.
+func Extract(path string, dir string, keep func(name string) bool) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	defer f.Close()
+
+	r, err := decompress(bufio.NewReader(f))
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	if !isTar(r) {
+		return []string{path}, nil
+	}
+
+	paths := []string{}
+	_, err = extract(r, dir, nil, func(name string) bool {
+		if !keep(name) {
+			return false
+		}
+		paths = append(paths, filepath.Join(dir, filepath.FromSlash(name)))
+		return true
+	})
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return paths, nil
+}
+
+// Open opens a file from an export, decompressing it if it's gzipped.
+func Open(path string) (*Dump, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	r, err := decompress(bufio.NewReader(f))
+	if err != nil {
+		f.Close()
+		return nil, fault.Wrap(err)
+	}
+
+	return &Dump{Reader: r, close: f.Close}, nil
+}
+
+// decompress unwraps gzip if the stream is compressed.
+func decompress(r *bufio.Reader) (*bufio.Reader, error) {
+	magic, err := r.Peek(2)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		return r, nil
+	}
+
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return bufio.NewReader(zr), nil
+}
+
+func isTar(r *bufio.Reader) bool {
+	header, err := r.Peek(263)
+	if err != nil {
+		return false
+	}
+	return bytes.HasPrefix(header[257:], []byte("ustar"))
+}
+
+// extract writes the dump and kept files from the archive into dir and returns
+// the path of the dump, if isDump is set. Names are relative to the archive's
+// root, any entries which would end up outside of dir are ignored.
+func extract(r io.Reader, dir string, isDump func(name string) bool, keep func(name string) bool) (string, error) {
+	tr := tar.NewReader(r)
+	dump := ""
+
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := strings.TrimPrefix(filepath.ToSlash(filepath.Clean(h.Name)), "./")
+		if !filepath.IsLocal(name) {
+			continue
+		}
+
+		switch {
+		case dump == "" && isDump != nil && isDump(name):
+			dump = filepath.Join(dir, filepath.FromSlash(name))
+		case keep(name):
+		default:
+			continue
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return "", err
+		}
+
+		out, err := os.Create(target)
+		if err != nil {
+			return "", err
+		}
+
+		_, err = io.Copy(out, tr)
+		out.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if dump == "" && isDump != nil {
+		return "", fault.Wrap(ErrNoDump,
+			fmsg.WithDesc("no dump", "The archive has no database dump, make sure it's an export from the platform being imported."))
+	}
+
+	return dump, nil
+}
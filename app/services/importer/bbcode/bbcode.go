@@ -0,0 +1,388 @@
+// Package bbcode converts the BBCode markup used by most legacy forum software
+// into HTML. Only the widely supported tags are understood, styling such as
+// colours and fonts is dropped and any tags it doesn't know are left as text.
+package bbcode
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// File is what an inline attachment tag refers to.
+type File struct {
+	URL   string
+	Name  string
+	Image bool
+}
+
+// Attachment resolves inline attachment tags. The argument is whatever the
+// forum put in the tag, such as an index, and the body is the text between
+// the tags, which is usually the filename or an ID.
+type Attachment func(arg, body string) (File, bool)
+
+type options struct {
+	attachment map[string]Attachment
+}
+
+type Option func(*options)
+
+// WithAttachment handles the named tag, such as attachment or attach, as an
+// inline attachment.
+func WithAttachment(tag string, fn Attachment) Option {
+	return func(o *options) {
+		o.attachment[tag] = fn
+	}
+}
+
+// ToHTML converts BBCode to HTML. The input must be plain text, any HTML in it
+// is escaped.
+func ToHTML(in string, opts ...Option) string {
+	o := options{attachment: map[string]Attachment{}}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	in = strings.ReplaceAll(in, "\r\n", "\n")
+
+	root := parse(tokenise(in, o))
+	r := renderer{options: o}
+	return r.blocks(root.children)
+}
+
+type tokenKind int
+
+const (
+	tokenText tokenKind = iota
+	tokenOpen
+	tokenClose
+)
+
+type token struct {
+	kind tokenKind
+	tag  string
+	arg  string
+	raw  string
+}
+
+var tagPattern = regexp.MustCompile(`\[(/?)([a-zA-Z*]+)(?:=("[^"\]]*"|[^\]]*))?\]`)
+
+var known = map[string]bool{
+	"b": true, "i": true, "u": true, "s": true, "strike": true,
+	"url": true, "email": true, "img": true,
+	"quote": true, "code": true, "php": true, "html": true,
+	"list": true, "*": true,
+	"color": true, "colour": true, "size": true, "font": true, "highlight": true,
+	"center": true, "left": true, "right": true, "indent": true,
+	"sub": true, "sup": true, "noparse": true,
+}
+
+// block tags end whatever paragraph they're in.
+var block = map[string]bool{
+	"quote": true, "code": true, "php": true, "html": true, "list": true,
+}
+
+func tokenise(in string, o options) []token {
+	tokens := []token{}
+	last := 0
+
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(in, -1) {
+		tag := strings.ToLower(in[m[4]:m[5]])
+		if !known[tag] && o.attachment[tag] == nil {
+			continue
+		}
+
+		if m[0] > last {
+			tokens = append(tokens, token{kind: tokenText, raw: in[last:m[0]]})
+		}
+
+		t := token{kind: tokenOpen, tag: tag, raw: in[m[0]:m[1]]}
+		if m[3] > m[2] {
+			t.kind = tokenClose
+		}
+		if m[6] >= 0 {
+			t.arg = strings.Trim(in[m[6]:m[7]], `"`)
+		}
+
+		tokens = append(tokens, t)
+		last = m[1]
+	}
+
+	if last < len(in) {
+		tokens = append(tokens, token{kind: tokenText, raw: in[last:]})
+	}
+
+	return tokens
+}
+
+type node struct {
+	tag      string
+	arg      string
+	raw      string
+	text     string
+	children []*node
+	closed   bool
+}
+
+// parse builds a tree from the tokens. Closing tags without a matching open
+// tag are kept as text and tags which are never closed end with their parent.
+func parse(tokens []token) *node {
+	root := &node{}
+	stack := []*node{root}
+
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		top := stack[len(stack)-1]
+
+		switch t.kind {
+		case tokenText:
+			top.children = append(top.children, &node{text: t.raw})
+
+		case tokenOpen:
+			// Code blocks hold their contents as text.
+			if t.tag == "code" || t.tag == "php" || t.tag == "html" || t.tag == "noparse" {
+				n := &node{tag: t.tag, arg: t.arg, raw: t.raw}
+				var sb strings.Builder
+				j := i + 1
+				for ; j < len(tokens); j++ {
+					if tokens[j].kind == tokenClose && tokens[j].tag == t.tag {
+						n.closed = true
+						break
+					}
+					sb.WriteString(tokens[j].raw)
+				}
+				n.children = []*node{{text: sb.String()}}
+				top.children = append(top.children, n)
+				i = j
+				continue
+			}
+
+			// List items end at the next item or the end of their list.
+			if t.tag == "*" && top.tag == "*" {
+				stack = stack[:len(stack)-1]
+				top = stack[len(stack)-1]
+			}
+
+			n := &node{tag: t.tag, arg: t.arg, raw: t.raw}
+			top.children = append(top.children, n)
+			stack = append(stack, n)
+
+		case tokenClose:
+			match := -1
+			for j := len(stack) - 1; j > 0; j-- {
+				if stack[j].tag == t.tag {
+					match = j
+					break
+				}
+			}
+			if match < 0 {
+				if t.tag != "*" {
+					top.children = append(top.children, &node{text: t.raw})
+				}
+				continue
+			}
+			stack[match].closed = true
+			stack = stack[:match]
+		}
+	}
+
+	return root
+}
+
+type renderer struct {
+	options
+}
+
+// blocks renders a sequence of nodes as block level HTML, wrapping runs of
+// inline content in paragraphs which are separated by blank lines.
+func (r *renderer) blocks(nodes []*node) string {
+	var out strings.Builder
+	var para strings.Builder
+
+	flush := func() {
+		lines := strings.Trim(para.String(), "\n")
+		para.Reset()
+		for _, p := range strings.Split(lines, "\n\n") {
+			p = strings.Trim(p, "\n")
+			if strings.TrimSpace(p) == "" {
+				continue
+			}
+			out.WriteString("<p>")
+			out.WriteString(strings.ReplaceAll(p, "\n", "<br>"))
+			out.WriteString("</p>")
+		}
+	}
+
+	for _, n := range nodes {
+		if block[n.tag] {
+			flush()
+			out.WriteString(r.node(n))
+			continue
+		}
+		para.WriteString(r.node(n))
+	}
+	flush()
+
+	return out.String()
+}
+
+func (r *renderer) inline(nodes []*node) string {
+	var sb strings.Builder
+	for _, n := range nodes {
+		sb.WriteString(r.node(n))
+	}
+	return sb.String()
+}
+
+func (r *renderer) node(n *node) string {
+	if n.tag == "" {
+		return html.EscapeString(n.text)
+	}
+
+	if fn, ok := r.attachment[n.tag]; ok {
+		body := plain(n.children)
+		f, ok := fn(n.arg, body)
+		if !ok {
+			return html.EscapeString(body)
+		}
+		name := html.EscapeString(lo.CoalesceOrEmpty(f.Name, body))
+		if f.Image {
+			return `<img src="` + html.EscapeString(f.URL) + `" alt="` + name + `">`
+		}
+		return `<a href="` + html.EscapeString(f.URL) + `">` + name + `</a>`
+	}
+
+	switch n.tag {
+	case "b":
+		return "<strong>" + r.inline(n.children) + "</strong>"
+	case "i":
+		return "<em>" + r.inline(n.children) + "</em>"
+	case "u":
+		return "<u>" + r.inline(n.children) + "</u>"
+	case "s", "strike":
+		return "<s>" + r.inline(n.children) + "</s>"
+	case "sub":
+		return "<sub>" + r.inline(n.children) + "</sub>"
+	case "sup":
+		return "<sup>" + r.inline(n.children) + "</sup>"
+
+	case "url":
+		target := n.arg
+		if target == "" {
+			target = plain(n.children)
+		}
+		href, ok := safeURL(target)
+		if !ok {
+			return r.inline(n.children)
+		}
+		return `<a href="` + html.EscapeString(href) + `">` + r.inline(n.children) + `</a>`
+
+	case "email":
+		address := n.arg
+		if address == "" {
+			address = plain(n.children)
+		}
+		address = strings.TrimSpace(address)
+		if strings.ContainsAny(address, " <>\"") || !strings.Contains(address, "@") {
+			return r.inline(n.children)
+		}
+		return `<a href="mailto:` + html.EscapeString(address) + `">` + r.inline(n.children) + `</a>`
+
+	case "img":
+		src, ok := safeURL(plain(n.children))
+		if !ok {
+			return ""
+		}
+		return `<img src="` + html.EscapeString(src) + `">`
+
+	case "quote":
+		inner := r.blocks(n.children)
+		if author := quoteAuthor(n.arg); author != "" {
+			inner = "<p><strong>" + html.EscapeString(author) + " wrote:</strong></p>" + inner
+		}
+		return "<blockquote>" + inner + "</blockquote>"
+
+	case "code", "php", "html":
+		return "<pre><code>" + html.EscapeString(strings.Trim(plain(n.children), "\n")) + "</code></pre>"
+
+	case "noparse":
+		return html.EscapeString(plain(n.children))
+
+	case "list":
+		tag := "ul"
+		if n.arg != "" {
+			tag = "ol"
+		}
+		var sb strings.Builder
+		sb.WriteString("<" + tag + ">")
+		for _, c := range n.children {
+			if c.tag != "*" {
+				continue
+			}
+			sb.WriteString("<li>" + strings.Trim(r.inline(c.children), "\n") + "</li>")
+		}
+		sb.WriteString("</" + tag + ">")
+		return sb.String()
+
+	case "*":
+		return r.inline(n.children)
+
+	default:
+		// Styling which isn't carried over, such as colours and sizes.
+		return r.inline(n.children)
+	}
+}
+
+// plain returns the text of the nodes as written, including any tags.
+func plain(nodes []*node) string {
+	var sb strings.Builder
+	for _, n := range nodes {
+		if n.tag == "" {
+			sb.WriteString(n.text)
+			continue
+		}
+		sb.WriteString(n.raw)
+		sb.WriteString(plain(n.children))
+		if n.closed {
+			sb.WriteString("[/" + n.tag + "]")
+		}
+	}
+	return sb.String()
+}
+
+// quoteAuthor reads the name from quote arguments, which some forums follow
+// with a post reference such as "name;123" or name post_id=123.
+func quoteAuthor(arg string) string {
+	arg, _, _ = strings.Cut(arg, ";")
+	if i := strings.Index(arg, `" `); i >= 0 {
+		arg = arg[:i]
+	}
+	if i := strings.Index(arg, " post_id="); i >= 0 {
+		arg = arg[:i]
+	}
+	return strings.TrimSpace(strings.Trim(arg, `"`))
+}
+
+func safeURL(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "www.") {
+		raw = "http://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+
+	switch u.Scheme {
+	case "http", "https", "mailto":
+		return u.String(), true
+	case "":
+		return raw, strings.HasPrefix(raw, "/") && !strings.HasPrefix(raw, "//")
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,49 @@
+package bbcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"paragraphs", "one\ntwo\n\nthree", "<p>one<br>two</p><p>three</p>"},
+		{"escapes", "<script>&", "<p>&lt;script&gt;&amp;</p>"},
+		{"inline", "[b]bold[/b] [I]it[/I] [u]u[/u] [s]s[/s]", "<p><strong>bold</strong> <em>it</em> <u>u</u> <s>s</s></p>"},
+		{"url", "[url=https://example.com/?a=1&b=2]site[/url] [url]https://x.org[/url]", `<p><a href="https://example.com/?a=1&amp;b=2">site</a> <a href="https://x.org">https://x.org</a></p>`},
+		{"unsafe_url", "[url=javascript:alert(1)]click[/url]", "<p>click</p>"},
+		{"img", "[img]https://example.com/a.png[/img]", `<p><img src="https://example.com/a.png"></p>`},
+		{"quote", `before[quote="Odin"]wise[/quote]after`, "<p>before</p><blockquote><p><strong>Odin wrote:</strong></p><p>wise</p></blockquote><p>after</p>"},
+		{"quote_phpbb", `[quote="Odin" post_id=1 time=2 user_id=3]hi[/quote]`, "<blockquote><p><strong>Odin wrote:</strong></p><p>hi</p></blockquote>"},
+		{"quote_vbulletin", `[QUOTE=Odin;123]hi[/QUOTE]`, "<blockquote><p><strong>Odin wrote:</strong></p><p>hi</p></blockquote>"},
+		{"code", "[code]a [b]not bold[/b]\n<x>[/code]", "<pre><code>a [b]not bold[/b]\n&lt;x&gt;</code></pre>"},
+		{"list", "[list][*]one[*]two[/list]", "<ul><li>one</li><li>two</li></ul>"},
+		{"ordered_list", "[list=1]\n[*]one\n[*]two\n[/list]", "<ol><li>one</li><li>two</li></ol>"},
+		{"styling", "[color=red][size=150]big[/size][/color]", "<p>big</p>"},
+		{"unknown", "[spoiler]x[/spoiler] [b]unclosed", "<p>[spoiler]x[/spoiler] <strong>unclosed</strong></p>"},
+		{"stray_close", "text[/b]", "<p>text[/b]</p>"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, ToHTML(c.in))
+		})
+	}
+}
+
+func TestAttachment(t *testing.T) {
+	out := ToHTML("see [attachment=0]cat.png[/attachment] and [attachment=1]gone.zip[/attachment]",
+		WithAttachment("attachment", func(arg, body string) (File, bool) {
+			if arg != "0" {
+				return File{}, false
+			}
+			return File{URL: "/files/1", Name: body, Image: true}, true
+		}))
+
+	assert.Equal(t, `<p>see <img src="/files/1" alt="cat.png"> and gone.zip</p>`, out)
+}
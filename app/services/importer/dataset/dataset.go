@@ -0,0 +1,100 @@
+// Package dataset is the platform neutral shape every importer reads its
+// source into before anything is written. Items refer to each other by their
+// IDs in the source, which are also what imports are resumed by.
+package dataset
+
+import (
+	"io"
+	"time"
+
+	"github.com/Southclaws/storyden/app/resources/import_run"
+)
+
+type Dataset struct {
+	Members    []Member
+	Categories []Category
+	Uploads    []Upload
+	Threads    []Thread
+	Posts      []Post
+	Likes      []Like
+	Skipped    []Skip
+
+	// Close releases anything the source needed while importing, such as the
+	// directory an archive was extracted to.
+	Close func() error
+}
+
+type Member struct {
+	ExternalID string
+	Handle     string
+	Name       string
+	Email      string
+	Bio        string
+	CreatedAt  time.Time
+
+	// PasswordHash is the member's password hash from the source, encoded with
+	// legacy_password.Encode, so they can keep signing in with it.
+	PasswordHash string
+
+	// Unclaimed members are only known by their email address, such as authors
+	// in a mailing list archive. Whoever can verify the address claims them.
+	Unclaimed bool
+}
+
+type Category struct {
+	ExternalID  string
+	ParentID    string
+	Name        string
+	Slug        string
+	Description string
+	Colour      string
+	Sort        int
+}
+
+type Upload struct {
+	ExternalID string
+	AuthorID   string
+	Filename   string
+
+	// URLs are how content in the source links to the upload, including any
+	// resized copies. Links to any of them point at the imported asset.
+	URLs []string
+
+	Open func() (io.ReadCloser, error)
+}
+
+// Thread bodies are HTML.
+type Thread struct {
+	ExternalID string
+	CategoryID string
+	AuthorID   string
+	Title      string
+	Body       string
+	CreatedAt  time.Time
+}
+
+// Post is a reply to a thread, ReplyToID optionally refers to another post in
+// the same thread. Bodies are HTML.
+type Post struct {
+	ExternalID string
+	ThreadID   string
+	ReplyToID  string
+	AuthorID   string
+	Body       string
+	CreatedAt  time.Time
+}
+
+// Like is on either a thread or a post.
+type Like struct {
+	ThreadID string
+	PostID   string
+	AuthorID string
+}
+
+// Skip is something in the source which won't be imported, such as a private
+// message, kept so the import's records explain what happened to it.
+type Skip struct {
+	Kind       import_run.Kind
+	ExternalID string
+	Reason     string
+}
@@ -0,0 +1,330 @@
+// Package discourse reads Discourse backups, the .tar.gz archives created from
+// a Discourse site's admin backup page. Only the database dump and uploads in
+// the archive are read. Uploads kept in S3 rather than in the backup can't be
+// imported.
+package discourse
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/import_run"
+	"github.com/Southclaws/storyden/app/services/importer/archive"
+	"github.com/Southclaws/storyden/app/services/importer/dataset"
+)
+
+const Name = "discourse"
+
+const (
+	postTypeRegular         = 1
+	postTypeModeratorAction = 2
+	postActionLike          = 2
+)
+
+var tables = map[string]bool{
+	"users":            true,
+	"user_emails":      true,
+	"user_profiles":    true,
+	"categories":       true,
+	"topics":           true,
+	"posts":            true,
+	"uploads":          true,
+	"post_actions":     true,
+	"optimized_images": true,
+}
+
+// Parse reads a backup archive at path. A bare dump.sql or dump.sql.gz is also
+// accepted, in which case there are no uploads.
+func Parse(ctx context.Context, path string) (*dataset.Dataset, error) {
+	dir, err := os.MkdirTemp("", "storyden-import-discourse-")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ds, err := parse(ctx, path, dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ds.Close = func() error { return os.RemoveAll(dir) }
+
+	return ds, nil
+}
+
+func parse(ctx context.Context, path string, dir string) (*dataset.Dataset, error) {
+	dump, err := archive.Unpack(path, dir, isDump, isUpload)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+	defer dump.Close()
+
+	b := newBuilder(dir)
+	if err := scanDump(dump, tables, b.add); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid dump", "The Discourse database dump could not be read."))
+	}
+
+	return b.build(), nil
+}
+
+func isDump(name string) bool {
+	return name == "dump.sql" || name == "dump.sql.gz"
+}
+
+func isUpload(name string) bool {
+	return strings.HasPrefix(name, "uploads/")
+}
+
+type topic struct {
+	row       row
+	firstPost string
+	postIDs   map[int]string
+}
+
+type builder struct {
+	dir string
+
+	users    []row
+	emails   map[string]string
+	bios     map[string]string
+	cats     []row
+	topics   map[string]*topic
+	topicIDs []string
+	posts    []row
+	uploads  []row
+	resized  map[string][]string
+	likes    []row
+}
+
+func newBuilder(dir string) *builder {
+	return &builder{
+		dir:     dir,
+		emails:  map[string]string{},
+		bios:    map[string]string{},
+		topics:  map[string]*topic{},
+		resized: map[string][]string{},
+	}
+}
+
+func (b *builder) add(table string, r row) error {
+	switch table {
+	case "users":
+		b.users = append(b.users, r)
+	case "user_emails":
+		if r.bool("primary") {
+			b.emails[r.str("user_id")] = r.str("email")
+		}
+	case "user_profiles":
+		b.bios[r.str("user_id")] = r.str("bio_cooked")
+	case "categories":
+		b.cats = append(b.cats, r)
+	case "topics":
+		id := r.str("id")
+		b.topics[id] = &topic{row: r, postIDs: map[int]string{}}
+		b.topicIDs = append(b.topicIDs, id)
+	case "posts":
+		b.posts = append(b.posts, r)
+	case "uploads":
+		b.uploads = append(b.uploads, r)
+	case "optimized_images":
+		id := r.str("upload_id")
+		b.resized[id] = append(b.resized[id], r.str("url"))
+	case "post_actions":
+		if r.int("post_action_type_id") == postActionLike && r.null("deleted_at") {
+			b.likes = append(b.likes, r)
+		}
+	}
+	return nil
+}
+
+func (b *builder) build() *dataset.Dataset {
+	ds := &dataset.Dataset{}
+
+	system := map[string]bool{}
+	for _, u := range b.users {
+		id := u.str("id")
+
+		// Negative IDs are Discourse's own accounts, such as system and
+		// discobot, which post automated messages.
+		if u.int("id") <= 0 {
+			system[id] = true
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindMember, ExternalID: id, Reason: "Discourse system account"})
+			continue
+		}
+
+		ds.Members = append(ds.Members, dataset.Member{
+			ExternalID: id,
+			Handle:     u.str("username"),
+			Name:       u.str("name"),
+			Email:      b.emails[id],
+			Bio:        b.bios[id],
+			CreatedAt:  u.time("created_at"),
+		})
+	}
+
+	for _, c := range b.cats {
+		ds.Categories = append(ds.Categories, dataset.Category{
+			ExternalID:  c.str("id"),
+			ParentID:    c.str("parent_category_id"),
+			Name:        c.str("name"),
+			Slug:        c.str("slug"),
+			Description: c.str("description"),
+			Colour:      colour(c.str("color")),
+			Sort:        c.int("position"),
+		})
+	}
+
+	for _, u := range b.uploads {
+		url := u.str("url")
+		local := filepath.Join(b.dir, filepath.FromSlash(strings.TrimPrefix(url, "/")))
+
+		ds.Uploads = append(ds.Uploads, dataset.Upload{
+			ExternalID: u.str("id"),
+			AuthorID:   u.str("user_id"),
+			Filename:   u.str("original_filename"),
+			URLs:       append([]string{url}, b.resized[u.str("id")]...),
+			Open: func() (io.ReadCloser, error) {
+				if !strings.HasPrefix(url, "/uploads/") {
+					return nil, fault.New("upload is not stored in the backup")
+				}
+				return os.Open(local)
+			},
+		})
+	}
+
+	// Posts are sorted so each thread's opening post is found before replies
+	// and replies are imported in the order they were written.
+	sort.SliceStable(b.posts, func(i, j int) bool {
+		return b.posts[i].int("post_number") < b.posts[j].int("post_number")
+	})
+
+	for _, p := range b.posts {
+		if t, ok := b.topics[p.str("topic_id")]; ok {
+			t.postIDs[p.int("post_number")] = p.str("id")
+		}
+	}
+
+	firstPosts := map[string]row{}
+	for _, p := range b.posts {
+		if p.int("post_number") == 1 {
+			firstPosts[p.str("topic_id")] = p
+		}
+	}
+
+	threads := map[string]bool{}
+	for _, id := range b.topicIDs {
+		t := b.topics[id].row
+		first, hasFirst := firstPosts[id]
+
+		skip := func(reason string) {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindThread, ExternalID: id, Reason: reason})
+		}
+
+		switch {
+		case t.str("archetype") == "private_message":
+			skip("private message")
+		case !t.null("deleted_at"):
+			skip("deleted in Discourse")
+		case !hasFirst || !first.null("deleted_at"):
+			skip("opening post is missing or deleted")
+		case system[t.str("user_id")]:
+			skip("written by a Discourse system account")
+		default:
+			threads[id] = true
+			b.topics[id].firstPost = first.str("id")
+			ds.Threads = append(ds.Threads, dataset.Thread{
+				ExternalID: id,
+				CategoryID: t.str("category_id"),
+				AuthorID:   first.str("user_id"),
+				Title:      t.str("title"),
+				Body:       first.str("cooked"),
+				CreatedAt:  t.time("created_at"),
+			})
+		}
+	}
+
+	for _, p := range b.posts {
+		if p.int("post_number") == 1 {
+			continue
+		}
+
+		id := p.str("id")
+		topicID := p.str("topic_id")
+
+		skip := func(reason string) {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindPost, ExternalID: id, Reason: reason})
+		}
+
+		postType := p.int("post_type")
+
+		switch {
+		case !threads[topicID]:
+			skip("thread was not imported")
+		case postType != postTypeRegular && postType != postTypeModeratorAction:
+			skip("whisper or automated notice")
+		case !p.null("deleted_at"):
+			skip("deleted in Discourse")
+		case p.bool("hidden"):
+			skip("hidden in Discourse")
+		case system[p.str("user_id")]:
+			skip("written by a Discourse system account")
+		default:
+			replyTo := ""
+			if n := p.int("reply_to_post_number"); n > 1 {
+				replyTo = b.topics[topicID].postIDs[n]
+			}
+
+			ds.Posts = append(ds.Posts, dataset.Post{
+				ExternalID: id,
+				ThreadID:   topicID,
+				ReplyToID:  replyTo,
+				AuthorID:   p.str("user_id"),
+				Body:       p.str("cooked"),
+				CreatedAt:  p.time("created_at"),
+			})
+		}
+	}
+
+	postTopics := map[string]string{}
+	for _, p := range b.posts {
+		postTopics[p.str("id")] = p.str("topic_id")
+	}
+
+	for _, l := range b.likes {
+		postID := l.str("post_id")
+		topicID, ok := postTopics[postID]
+		if !ok || !threads[topicID] {
+			continue
+		}
+
+		like := dataset.Like{AuthorID: l.str("user_id")}
+		if b.topics[topicID].firstPost == postID {
+			like.ThreadID = topicID
+		} else {
+			like.PostID = postID
+		}
+
+		ds.Likes = append(ds.Likes, like)
+	}
+
+	return ds
+}
+
+// Discourse stores colours as hex without the leading hash.
+func colour(hex string) string {
+	hex = strings.TrimPrefix(hex, "#")
+	if hex == "" {
+		return ""
+	}
+	return "#" + hex
+}
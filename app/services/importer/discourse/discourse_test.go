@@ -0,0 +1,178 @@
+package discourse
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dump = `--
+-- PostgreSQL database dump
+--
+
+SET statement_timeout = 0;
+
+COPY public.users (id, username, name, created_at, admin) FROM stdin;
+-1	system	system	2020-01-01 00:00:00	t
+1	odin	Odin Allfather	2020-01-02 03:04:05.123456	t
+2	thor	\N	2020-01-03 00:00:00	f
+\.
+
+COPY public.user_emails (id, user_id, email, "primary") FROM stdin;
+1	1	odin@example.com	t
+2	1	old@example.com	f
+\.
+
+COPY public.categories (id, name, slug, color, description, parent_category_id, "position") FROM stdin;
+2	Sub	sub	\N	\N	1	2
+1	General	general	0088CC	<p>Talk</p>	\N	1
+\.
+
+COPY public.topics (id, title, created_at, user_id, category_id, archetype, deleted_at) FROM stdin;
+10	Hello world	2020-02-01 00:00:00	1	1	regular	\N
+11	A secret	2020-02-01 00:00:00	1	\N	private_message	\N
+\.
+
+COPY public.posts (id, user_id, topic_id, post_number, cooked, created_at, reply_to_post_number, post_type, deleted_at, hidden) FROM stdin;
+101	2	10	2	<p>Hi\tthere\nfriend</p>	2020-02-02 00:00:00	\N	1	\N	f
+100	1	10	1	<p>First <img src="/uploads/default/original/1X/abc.png"></p>	2020-02-01 00:00:00	\N	1	\N	f
+102	1	10	3	<p>Replying</p>	2020-02-03 00:00:00	2	1	\N	f
+103	-1	10	4	<p>Closed</p>	2020-02-04 00:00:00	\N	3	\N	f
+104	1	11	1	<p>psst</p>	2020-02-01 00:00:00	\N	1	\N	f
+\.
+
+COPY public.uploads (id, user_id, original_filename, url) FROM stdin;
+5	1	abc.png	/uploads/default/original/1X/abc.png
+\.
+
+COPY public.optimized_images (id, upload_id, url) FROM stdin;
+1	5	/uploads/default/optimized/1X/abc_2_100x100.png
+\.
+
+COPY public.post_actions (id, post_id, user_id, post_action_type_id, deleted_at) FROM stdin;
+1	100	2	2	\N
+2	101	1	2	\N
+3	102	2	2	2020-03-01 00:00:00
+\.
+`
+
+func backup(t *testing.T) string {
+	t.Helper()
+
+	var sql bytes.Buffer
+	gz := gzip.NewWriter(&sql)
+	_, err := gz.Write([]byte(dump))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	var archive bytes.Buffer
+	zw := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(zw)
+
+	files := map[string][]byte{
+		"dump.sql.gz":                         sql.Bytes(),
+		"uploads/default/original/1X/abc.png": []byte("png"),
+		"../escape.txt":                       []byte("nope"),
+		"meta.json":                           []byte("{}"),
+	}
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, zw.Close())
+
+	path := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, os.WriteFile(path, archive.Bytes(), 0o600))
+	return path
+}
+
+func TestParse(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	ds, err := Parse(context.Background(), backup(t))
+	r.NoError(err)
+	defer ds.Close()
+
+	r.Len(ds.Members, 2)
+	a.Equal("odin", ds.Members[0].Handle)
+	a.Equal("odin@example.com", ds.Members[0].Email)
+	a.Equal(123456000, ds.Members[0].CreatedAt.Nanosecond())
+	a.Empty(ds.Members[1].Email)
+
+	r.Len(ds.Categories, 2)
+	a.Equal("1", ds.Categories[0].ParentID)
+	a.Empty(ds.Categories[0].Colour)
+	a.Equal("#0088CC", ds.Categories[1].Colour)
+
+	r.Len(ds.Uploads, 1)
+	a.Equal([]string{
+		"/uploads/default/original/1X/abc.png",
+		"/uploads/default/optimized/1X/abc_2_100x100.png",
+	}, ds.Uploads[0].URLs)
+	f, err := ds.Uploads[0].Open()
+	r.NoError(err)
+	content, err := io.ReadAll(f)
+	f.Close()
+	r.NoError(err)
+	a.Equal("png", string(content))
+
+	r.Len(ds.Threads, 1)
+	a.Equal("Hello world", ds.Threads[0].Title)
+	a.Equal("1", ds.Threads[0].CategoryID)
+	a.Contains(ds.Threads[0].Body, "abc.png")
+
+	r.Len(ds.Posts, 2)
+	a.Equal("101", ds.Posts[0].ExternalID)
+	a.Equal("<p>Hi\tthere\nfriend</p>", ds.Posts[0].Body)
+	a.Equal("102", ds.Posts[1].ExternalID)
+	a.Equal("101", ds.Posts[1].ReplyToID)
+
+	r.Len(ds.Likes, 2)
+	a.Equal("10", ds.Likes[0].ThreadID)
+	a.Equal("101", ds.Likes[1].PostID)
+
+	skipped := map[string]string{}
+	for _, s := range ds.Skipped {
+		skipped[s.Kind.String()+":"+s.ExternalID] = s.Reason
+	}
+	a.Equal(map[string]string{
+		"member:-1": "Discourse system account",
+		"thread:11": "private message",
+		"post:103":  "whisper or automated notice",
+	}, skipped)
+}
+
+func TestParseNoDump(t *testing.T) {
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "meta.json", Mode: 0o644, Size: 2, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("{}"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	path := filepath.Join(t.TempDir(), "backup.tar")
+	require.NoError(t, os.WriteFile(path, archive.Bytes(), 0o600))
+
+	_, err = Parse(context.Background(), path)
+	assert.Error(t, err)
+}
+
+func TestUnescape(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("a\tb", unescape(`a\tb`))
+	a.Equal("line\nbreak", unescape(`line\nbreak`))
+	a.Equal(`back\slash`, unescape(`back\\slash`))
+	a.Equal("A", unescape(`\101`))
+}
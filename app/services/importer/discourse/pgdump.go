@@ -0,0 +1,164 @@
+package discourse
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+)
+
+// row is one row of a table's COPY block, NULL columns are absent.
+type row map[string]string
+
+func (r row) str(col string) string {
+	return r[col]
+}
+
+func (r row) null(col string) bool {
+	_, ok := r[col]
+	return !ok
+}
+
+func (r row) bool(col string) bool {
+	return r[col] == "t"
+}
+
+func (r row) int(col string) int {
+	n, _ := strconv.Atoi(r[col])
+	return n
+}
+
+func (r row) time(col string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04:05.999999", r[col])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// scanDump reads the COPY blocks of a plain text pg_dump, which is what a
+// Discourse backup contains, and calls fn for each row of the wanted tables.
+// Everything else in the dump is ignored.
+func scanDump(r io.Reader, tables map[string]bool, fn func(table string, r row) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+
+	var (
+		table   string
+		columns []string
+	)
+
+	for sc.Scan() {
+		line := sc.Text()
+
+		if columns == nil {
+			t, cols, ok := parseCopy(line)
+			if ok && tables[t] {
+				table, columns = t, cols
+			}
+			continue
+		}
+
+		if line == `\.` {
+			table, columns = "", nil
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) != len(columns) {
+			return fault.Newf("malformed row in table %s: expected %d columns, got %d", table, len(columns), len(fields))
+		}
+
+		rw := make(row, len(columns))
+		for i, f := range fields {
+			if f == `\N` {
+				continue
+			}
+			rw[columns[i]] = unescape(f)
+		}
+
+		if err := fn(table, rw); err != nil {
+			return err
+		}
+	}
+
+	return sc.Err()
+}
+
+// parseCopy reads a statement such as:
+//
+//	COPY public.users (id, username, name) FROM stdin;
+func parseCopy(line string) (string, []string, bool) {
+	rest, ok := strings.CutPrefix(line, "COPY ")
+	if !ok {
+		return "", nil, false
+	}
+
+	name, rest, ok := strings.Cut(rest, " (")
+	if !ok {
+		return "", nil, false
+	}
+
+	list, _, ok := strings.Cut(rest, ") FROM stdin;")
+	if !ok {
+		return "", nil, false
+	}
+
+	name = strings.TrimPrefix(name, "public.")
+
+	columns := strings.Split(list, ", ")
+	for i, c := range columns {
+		columns[i] = strings.Trim(c, `"`)
+	}
+
+	return strings.Trim(name, `"`), columns, true
+}
+
+// unescape decodes the backslash escapes of the COPY text format.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 == len(s) {
+			b.WriteByte(c)
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'v':
+			b.WriteByte('\v')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+			n, _ := strconv.ParseUint(s[i:j], 8, 8)
+			b.WriteByte(byte(n))
+			i = j - 1
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
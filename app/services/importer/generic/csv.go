@@ -0,0 +1,144 @@
+package generic
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+)
+
+// Columns of the CSV file for each kind of item, the same as the fields in a
+// JSON document. Files must start with a header row naming their columns, in
+// any order, and may leave optional columns out.
+var columns = map[string][]string{
+	"members":    {"id", "handle", "name", "email", "bio", "created_at"},
+	"categories": {"id", "parent_id", "name", "slug", "description", "colour"},
+	"threads":    {"id", "category_id", "author_id", "title", "body", "created_at"},
+	"posts":      {"id", "thread_id", "reply_to_id", "author_id", "body", "created_at"},
+	"likes":      {"thread_id", "post_id", "author_id"},
+}
+
+type row map[string]string
+
+func (r row) time(column string) (time.Time, error) {
+	v := r[column]
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC 3339 date-time: %w", column, err)
+	}
+	return t, nil
+}
+
+func readCSV(kind string, r io.Reader, doc *Document) error {
+	known, ok := columns[kind]
+	if !ok {
+		return fmt.Errorf("%s.csv is not one of the files the format has", kind)
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, h := range header {
+		if !slices.Contains(known, h) {
+			return fmt.Errorf("unknown column %q", h)
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		rw := row{}
+		for i, h := range header {
+			if i < len(record) {
+				rw[h] = record[i]
+			}
+		}
+
+		if err := add(kind, rw, doc); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+func add(kind string, r row, doc *Document) error {
+	switch kind {
+	case "members":
+		created, err := r.time("created_at")
+		if err != nil {
+			return err
+		}
+		doc.Members = append(doc.Members, Member{
+			ID:        r["id"],
+			Handle:    r["handle"],
+			Name:      r["name"],
+			Email:     r["email"],
+			Bio:       r["bio"],
+			CreatedAt: created,
+		})
+
+	case "categories":
+		doc.Categories = append(doc.Categories, Category{
+			ID:          r["id"],
+			ParentID:    r["parent_id"],
+			Name:        r["name"],
+			Slug:        r["slug"],
+			Description: r["description"],
+			Colour:      r["colour"],
+		})
+
+	case "threads":
+		created, err := r.time("created_at")
+		if err != nil {
+			return err
+		}
+		doc.Threads = append(doc.Threads, Thread{
+			ID:         r["id"],
+			CategoryID: r["category_id"],
+			AuthorID:   r["author_id"],
+			Title:      r["title"],
+			Body:       r["body"],
+			CreatedAt:  created,
+		})
+
+	case "posts":
+		created, err := r.time("created_at")
+		if err != nil {
+			return err
+		}
+		doc.Posts = append(doc.Posts, Post{
+			ID:        r["id"],
+			ThreadID:  r["thread_id"],
+			ReplyToID: r["reply_to_id"],
+			AuthorID:  r["author_id"],
+			Body:      r["body"],
+			CreatedAt: created,
+		})
+
+	case "likes":
+		doc.Likes = append(doc.Likes, Like{
+			ThreadID: r["thread_id"],
+			PostID:   r["post_id"],
+			AuthorID: r["author_id"],
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+// Package generic reads Storyden's own platform neutral import format, for
+// communities moving from a platform there's no importer for which would
+// rather script their own export. The format is a JSON document, or a tarball
+// of CSV files, one for each kind of item, whose columns are the same as the
+// document's fields. Every item has an ID of its own choosing which other items
+// refer to it by, and which later imports recognise it by.
+package generic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/services/importer/archive"
+	"github.com/Southclaws/storyden/app/services/importer/dataset"
+)
+
+const Name = "generic"
+
+type Document struct {
+	Members    []Member   `json:"members,omitempty"`
+	Categories []Category `json:"categories,omitempty"`
+	Threads    []Thread   `json:"threads,omitempty"`
+	Posts      []Post     `json:"posts,omitempty"`
+	Likes      []Like     `json:"likes,omitempty"`
+}
+
+type Member struct {
+	ID        string    `json:"id"`
+	Handle    string    `json:"handle"`
+	Name      string    `json:"name,omitempty"`
+	Email     string    `json:"email,omitempty"`
+	Bio       string    `json:"bio,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+type Category struct {
+	ID          string `json:"id"`
+	ParentID    string `json:"parent_id,omitempty"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	Colour      string `json:"colour,omitempty"`
+}
+
+type Thread struct {
+	ID         string    `json:"id"`
+	CategoryID string    `json:"category_id,omitempty"`
+	AuthorID   string    `json:"author_id,omitempty"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at,omitzero"`
+}
+
+type Post struct {
+	ID        string    `json:"id"`
+	ThreadID  string    `json:"thread_id"`
+	ReplyToID string    `json:"reply_to_id,omitempty"`
+	AuthorID  string    `json:"author_id,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+type Like struct {
+	ThreadID string `json:"thread_id,omitempty"`
+	PostID   string `json:"post_id,omitempty"`
+	AuthorID string `json:"author_id"`
+}
+
+// Parse reads a document, or an archive of CSV files, at path.
+func Parse(ctx context.Context, path string) (*dataset.Dataset, error) {
+	dir, err := os.MkdirTemp("", "storyden-import-generic-")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer os.RemoveAll(dir)
+
+	paths, err := archive.Extract(path, dir, isFile)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	doc := &Document{}
+	for _, p := range paths {
+		if err := readFile(p, doc); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+				fmsg.WithDesc("invalid document", fmt.Sprintf("%s could not be read: %s", filepath.Base(p), err)))
+		}
+	}
+
+	if err := Validate(doc); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return build(doc), nil
+}
+
+// Read decodes a document, fields which aren't part of the format are rejected
+// so that mistakes in a script aren't silently ignored.
+func Read(r io.Reader) (*Document, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	doc := &Document{}
+	if err := dec.Decode(doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Check reads and validates a document sent to the API, so problems with it are
+// reported straight away rather than when the import runs.
+func Check(ctx context.Context, b []byte) error {
+	doc, err := Read(bytes.NewReader(b))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid document", "The import document could not be read: "+err.Error()))
+	}
+
+	if err := Validate(doc); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func isFile(name string) bool {
+	name = strings.TrimSuffix(name, ".gz")
+	return strings.HasSuffix(name, ".json") || strings.HasSuffix(name, ".csv")
+}
+
+func readFile(p string, doc *Document) error {
+	f, err := archive.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	name := strings.TrimSuffix(filepath.Base(p), ".gz")
+	if strings.HasSuffix(name, ".csv") {
+		return readCSV(strings.TrimSuffix(name, ".csv"), f, doc)
+	}
+
+	// Anything else is a document, including an upload with no extension.
+	d, err := Read(f)
+	if err != nil {
+		return err
+	}
+
+	doc.Members = append(doc.Members, d.Members...)
+	doc.Categories = append(doc.Categories, d.Categories...)
+	doc.Threads = append(doc.Threads, d.Threads...)
+	doc.Posts = append(doc.Posts, d.Posts...)
+	doc.Likes = append(doc.Likes, d.Likes...)
+
+	return nil
+}
+
+// Validate checks every item has what it needs to be imported and can be told
+// apart from the others of its kind. References to items which aren't in the
+// document are allowed, they may have been imported already.
+func Validate(doc *Document) error {
+	errs := []string{}
+	invalid := func(kind string, i int, problem string) {
+		errs = append(errs, fmt.Sprintf("%s[%d]: %s", kind, i, problem))
+	}
+
+	unique := func(kind string, ids []string) {
+		seen := map[string]bool{}
+		for i, id := range ids {
+			switch {
+			case id == "":
+				invalid(kind, i, "id is required")
+			case seen[id]:
+				invalid(kind, i, fmt.Sprintf("id %q is used more than once", id))
+			}
+			seen[id] = true
+		}
+	}
+
+	unique("members", dt.Map(doc.Members, func(m Member) string { return m.ID }))
+	unique("categories", dt.Map(doc.Categories, func(c Category) string { return c.ID }))
+	unique("threads", dt.Map(doc.Threads, func(t Thread) string { return t.ID }))
+	unique("posts", dt.Map(doc.Posts, func(p Post) string { return p.ID }))
+
+	for i, m := range doc.Members {
+		if m.Handle == "" {
+			invalid("members", i, "handle is required")
+		}
+	}
+	for i, c := range doc.Categories {
+		if c.Name == "" {
+			invalid("categories", i, "name is required")
+		}
+	}
+	for i, t := range doc.Threads {
+		if t.Title == "" {
+			invalid("threads", i, "title is required")
+		}
+	}
+	for i, p := range doc.Posts {
+		if p.ThreadID == "" {
+			invalid("posts", i, "thread_id is required")
+		}
+	}
+	for i, l := range doc.Likes {
+		if l.AuthorID == "" {
+			invalid("likes", i, "author_id is required")
+		}
+		if (l.ThreadID == "") == (l.PostID == "") {
+			invalid("likes", i, "exactly one of thread_id or post_id is required")
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	const shown = 10
+	desc := strings.Join(errs[:min(len(errs), shown)], "; ")
+	if len(errs) > shown {
+		desc += fmt.Sprintf("; and %d more", len(errs)-shown)
+	}
+
+	return fault.New("invalid import document", ftag.With(ftag.InvalidArgument),
+		fmsg.WithDesc("invalid document", "The import document has problems: "+desc))
+}
+
+func build(doc *Document) *dataset.Dataset {
+	ds := &dataset.Dataset{}
+
+	for _, m := range doc.Members {
+		ds.Members = append(ds.Members, dataset.Member{
+			ExternalID: m.ID,
+			Handle:     m.Handle,
+			Name:       m.Name,
+			Email:      m.Email,
+			Bio:        m.Bio,
+			CreatedAt:  m.CreatedAt,
+		})
+	}
+
+	for i, c := range doc.Categories {
+		ds.Categories = append(ds.Categories, dataset.Category{
+			ExternalID:  c.ID,
+			ParentID:    c.ParentID,
+			Name:        c.Name,
+			Slug:        c.Slug,
+			Description: c.Description,
+			Colour:      c.Colour,
+			Sort:        i,
+		})
+	}
+
+	for _, t := range doc.Threads {
+		ds.Threads = append(ds.Threads, dataset.Thread{
+			ExternalID: t.ID,
+			CategoryID: t.CategoryID,
+			AuthorID:   t.AuthorID,
+			Title:      t.Title,
+			Body:       t.Body,
+			CreatedAt:  t.CreatedAt,
+		})
+	}
+
+	for _, p := range doc.Posts {
+		ds.Posts = append(ds.Posts, dataset.Post{
+			ExternalID: p.ID,
+			ThreadID:   p.ThreadID,
+			ReplyToID:  p.ReplyToID,
+			AuthorID:   p.AuthorID,
+			Body:       p.Body,
+			CreatedAt:  p.CreatedAt,
+		})
+	}
+
+	for _, l := range doc.Likes {
+		ds.Likes = append(ds.Likes, dataset.Like{
+			ThreadID: l.ThreadID,
+			PostID:   l.PostID,
+			AuthorID: l.AuthorID,
+		})
+	}
+
+	return ds
+}
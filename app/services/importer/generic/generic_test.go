@@ -0,0 +1,136 @@
+package generic
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const document = `{
+  "members": [{"id": "1", "handle": "odin", "name": "Odin", "created_at": "2020-01-06T10:00:00Z"}],
+  "categories": [{"id": "c", "name": "Asgard"}],
+  "threads": [{"id": "t", "category_id": "c", "author_id": "1", "title": "Rota", "body": "<p>Who?</p>"}],
+  "posts": [{"id": "p", "thread_id": "t", "author_id": "2", "body": "<p>Me</p>"}],
+  "likes": [{"post_id": "p", "author_id": "1"}]
+}`
+
+func TestParseDocument(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "import.json")
+	r.NoError(os.WriteFile(path, []byte(document), 0o644))
+
+	ds, err := Parse(context.Background(), path)
+	r.NoError(err)
+
+	r.Len(ds.Members, 1)
+	a.Equal("1", ds.Members[0].ExternalID)
+	a.Equal("Odin", ds.Members[0].Name)
+	a.Equal(time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC), ds.Members[0].CreatedAt)
+
+	r.Len(ds.Categories, 1)
+	r.Len(ds.Threads, 1)
+	a.Equal("c", ds.Threads[0].CategoryID)
+
+	r.Len(ds.Posts, 1)
+	a.Equal("t", ds.Posts[0].ThreadID)
+	a.Equal("2", ds.Posts[0].AuthorID, "references to earlier imports are allowed")
+
+	r.Len(ds.Likes, 1)
+	a.Equal("p", ds.Likes[0].PostID)
+}
+
+func TestParseCSV(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range map[string]string{
+		"export/members.csv": "handle,id\nodin,1\n",
+		"export/threads.csv": "id,title,body,created_at\nt,\"Rota, again\",\"<p>a\nb</p>\",2020-01-06T10:00:00Z\n",
+		"export/posts.csv":   "id,thread_id,body\np,t,<p>Me</p>\n",
+		"export/README.md":   "ignored",
+	} {
+		r.NoError(tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		r.NoError(err)
+	}
+	r.NoError(tw.Close())
+	r.NoError(gz.Close())
+
+	path := filepath.Join(t.TempDir(), "export.tar.gz")
+	r.NoError(os.WriteFile(path, buf.Bytes(), 0o644))
+
+	ds, err := Parse(context.Background(), path)
+	r.NoError(err)
+
+	r.Len(ds.Members, 1)
+	a.Equal("odin", ds.Members[0].Handle)
+
+	r.Len(ds.Threads, 1)
+	a.Equal("Rota, again", ds.Threads[0].Title)
+	a.Equal("<p>a\nb</p>", ds.Threads[0].Body)
+	a.Equal(2020, ds.Threads[0].CreatedAt.Year())
+
+	r.Len(ds.Posts, 1)
+	a.Equal("t", ds.Posts[0].ThreadID)
+}
+
+func TestReadCSV(t *testing.T) {
+	a := assert.New(t)
+
+	a.ErrorContains(readCSV("members", strings.NewReader("id,handle,age\n"), &Document{}), `unknown column "age"`)
+	a.ErrorContains(readCSV("members", strings.NewReader("id,handle,created_at\n1,odin,yesterday\n"), &Document{}), "line 2")
+	a.Error(readCSV("users", strings.NewReader("id\n"), &Document{}))
+	a.NoError(readCSV("likes", strings.NewReader(""), &Document{}))
+}
+
+func TestCheck(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+
+	a.NoError(Check(ctx, []byte(document)))
+	a.Error(Check(ctx, []byte(`{"members": [{"id": "1", "handle": "odin", "age": 1000}]}`)), "unknown fields are rejected")
+	a.Error(Check(ctx, []byte(`{"threads": [`)))
+}
+
+func TestValidate(t *testing.T) {
+	a := assert.New(t)
+
+	a.NoError(Validate(&Document{}))
+
+	err := Validate(&Document{
+		Members: []Member{{Handle: "odin"}},
+		Threads: []Thread{{ID: "t", Title: "a"}, {ID: "t", Title: "b"}},
+		Posts:   []Post{{ID: "p"}},
+		Likes:   []Like{{ThreadID: "t", PostID: "p", AuthorID: "1"}, {}},
+	})
+	a.Equal(ftag.InvalidArgument, ftag.Get(err))
+
+	issue := fmsg.GetIssue(err)
+	a.Contains(issue, "members[0]: id is required")
+	a.Contains(issue, `threads[1]: id "t" is used more than once`)
+	a.Contains(issue, "posts[0]: thread_id is required")
+	a.Contains(issue, "likes[0]: exactly one of thread_id or post_id is required")
+	a.Contains(issue, "likes[1]: author_id is required")
+
+	doc := &Document{}
+	for i := range 12 {
+		doc.Threads = append(doc.Threads, Thread{ID: strings.Repeat("t", i+1)})
+	}
+	a.Contains(fmsg.GetIssue(Validate(doc)), "; and 2 more")
+}
@@ -0,0 +1,351 @@
+// Package importer moves content from other platforms into Storyden. Each
+// source is read into a neutral dataset which background jobs then write out,
+// recording what every item became. Because items which already have a record
+// are skipped, an interrupted import carries on where it stopped and importing
+// the same archive twice doesn't create duplicates.
+package importer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/import_run"
+	"github.com/Southclaws/storyden/app/resources/like/like_writer"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/services/asset/asset_download"
+	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	category_svc "github.com/Southclaws/storyden/app/services/category"
+	"github.com/Southclaws/storyden/app/services/importer/dataset"
+	"github.com/Southclaws/storyden/app/services/importer/discourse"
+	"github.com/Southclaws/storyden/app/services/importer/generic"
+	"github.com/Southclaws/storyden/app/services/importer/mbox"
+	"github.com/Southclaws/storyden/app/services/importer/phpbb"
+	"github.com/Southclaws/storyden/app/services/importer/vbulletin"
+	"github.com/Southclaws/storyden/app/services/job_queue"
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+// JobRun is the kind of job which works through an import. Long imports are
+// split across several of these, each continuing from the last.
+const JobRun = "import.run"
+
+// Each job stops this long before the queue's timeout and hands over to the
+// next one, so work is never cut off part way through an item.
+var jobMargin = time.Minute
+
+// Parser reads an archive, stored at path, into a dataset.
+type Parser func(ctx context.Context, path string) (*dataset.Dataset, error)
+
+var parsers = map[string]Parser{
+	discourse.Name: discourse.Parse,
+	generic.Name:   generic.Parse,
+	mbox.Name:      mbox.Parse,
+	phpbb.Name:     phpbb.Parse,
+	vbulletin.Name: vbulletin.Parse,
+}
+
+// Sources lists the platforms archives can be imported from.
+func Sources() []string {
+	names := make([]string, 0, len(parsers))
+	for name := range parsers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+type Importer struct {
+	logger         *slog.Logger
+	runs           *import_run.Repository
+	jobs           *job_queue.Queue
+	accountQuerier *account_querier.Querier
+	accountWriter  *account_writer.Writer
+	emails         *email.Repository
+	auth           authentication.Repository
+	categories     category_svc.Service
+	categoryRepo   *category.Repository
+	threadWriter   *thread_writer.Writer
+	replies        reply.Repository
+	likes          *like_writer.LikeWriter
+	assetQuerier   *asset_querier.Querier
+	uploader       *asset_upload.Uploader
+	downloader     *asset_download.Downloader
+}
+
+type runArgs struct {
+	RunID string
+}
+
+func New(
+	logger *slog.Logger,
+	runs *import_run.Repository,
+	jobs *job_queue.Queue,
+	accountQuerier *account_querier.Querier,
+	accountWriter *account_writer.Writer,
+	emails *email.Repository,
+	auth authentication.Repository,
+	categories category_svc.Service,
+	categoryRepo *category.Repository,
+	threadWriter *thread_writer.Writer,
+	replies reply.Repository,
+	likes *like_writer.LikeWriter,
+	assetQuerier *asset_querier.Querier,
+	uploader *asset_upload.Uploader,
+	downloader *asset_download.Downloader,
+) *Importer {
+	i := &Importer{
+		logger:         logger,
+		runs:           runs,
+		jobs:           jobs,
+		accountQuerier: accountQuerier,
+		accountWriter:  accountWriter,
+		emails:         emails,
+		auth:           auth,
+		categories:     categories,
+		categoryRepo:   categoryRepo,
+		threadWriter:   threadWriter,
+		replies:        replies,
+		likes:          likes,
+		assetQuerier:   assetQuerier,
+		uploader:       uploader,
+		downloader:     downloader,
+	}
+
+	// Failures are recorded on the run rather than retried by the queue, an
+	// admin can resume a failed run once they've seen what went wrong.
+	job_queue.Register(jobs, JobRun, func(ctx context.Context, args runArgs) error {
+		id, err := xid.FromString(args.RunID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return i.process(ctx, import_run.RunID(id))
+	}, job_queue.WithMaxAttempts(1))
+
+	return i
+}
+
+// Start queues an import of an uploaded archive. The archive is read from the
+// asset store by the job, so it's only needed until the import finishes.
+func (i *Importer) Start(ctx context.Context, source string, assetID asset.AssetID) (*import_run.Run, error) {
+	if _, ok := parsers[source]; !ok {
+		return nil, fault.New("unknown import source",
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("unknown source", "Storyden can't import from "+source+"."),
+		)
+	}
+
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := i.assetQuerier.GetByID(ctx, assetID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	run, err := i.runs.Create(ctx, source, accountID, opt.New(assetID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := i.enqueue(ctx, run.ID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return run, nil
+}
+
+// StartDocument queues an import of a document in the generic format sent
+// directly rather than uploaded first. It's stored as an asset the same as an
+// uploaded archive so the import can be resumed.
+func (i *Importer) StartDocument(ctx context.Context, doc []byte) (*import_run.Run, error) {
+	if err := generic.Check(ctx, doc); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a, err := i.uploader.Upload(ctx, bytes.NewReader(doc), int64(len(doc)), asset.NewFilename("import.json"), asset_upload.Options{Private: true})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return i.Start(ctx, generic.Name, a.ID)
+}
+
+// Resume continues a failed import from where it stopped.
+func (i *Importer) Resume(ctx context.Context, id import_run.RunID) (*import_run.Run, error) {
+	run, err := i.runs.Resume(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := i.enqueue(ctx, run.ID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return run, nil
+}
+
+func (i *Importer) Get(ctx context.Context, id import_run.RunID) (*import_run.Run, []*import_run.KindStats, error) {
+	run, err := i.runs.Get(ctx, id)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	stats, err := i.runs.Stats(ctx, id)
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return run, stats, nil
+}
+
+func (i *Importer) List(ctx context.Context, page pagination.Parameters) (pagination.Result[*import_run.Run], error) {
+	return i.runs.List(ctx, page)
+}
+
+func (i *Importer) Records(ctx context.Context, id import_run.RunID, page pagination.Parameters, opts ...import_run.RecordQuery) (pagination.Result[*import_run.Record], error) {
+	if _, err := i.runs.Get(ctx, id); err != nil {
+		return pagination.Result[*import_run.Record]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return i.runs.ListRecords(ctx, id, page, opts...)
+}
+
+func (i *Importer) enqueue(ctx context.Context, id import_run.RunID) error {
+	_, err := job_queue.Enqueue(ctx, i.jobs, JobRun, runArgs{RunID: id.String()})
+	return err
+}
+
+func (i *Importer) process(ctx context.Context, id import_run.RunID) error {
+	started, err := i.runs.Start(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !started {
+		return nil
+	}
+
+	run, err := i.runs.Get(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Record keeping must happen even if the job's context has run out.
+	rctx := context.WithoutCancel(ctx)
+
+	err = i.apply(ctx, run)
+	switch {
+	case err == nil:
+		return i.runs.Succeed(rctx, id, time.Now())
+
+	case errors.Is(err, errOutOfTime):
+		if err := i.runs.Pause(rctx, id); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		return i.enqueue(rctx, id)
+
+	default:
+		i.logger.Warn("import failed",
+			slog.String("import_run_id", id.String()),
+			slog.String("source", run.Source),
+			slog.String("error", err.Error()),
+		)
+
+		return i.runs.Fail(rctx, id, time.Now(), err.Error())
+	}
+}
+
+func (i *Importer) apply(ctx context.Context, run *import_run.Run) error {
+	parse, ok := parsers[run.Source]
+	if !ok {
+		return fault.Newf("no parser for import source '%s'", run.Source)
+	}
+
+	assetID, ok := run.AssetID.Get()
+	if !ok {
+		return fault.New("import has no archive")
+	}
+
+	// Uploads belong to, and are counted against the quota of, whoever
+	// started the import.
+	acc, err := i.accountQuerier.GetByID(ctx, run.AccountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	ctx = session.WithAccount(ctx, acc.Account, acc.Roles.Roles())
+
+	path, err := i.spool(ctx, assetID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer os.Remove(path)
+
+	ds, err := parse(ctx, path)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if ds.Close != nil {
+		defer ds.Close()
+	}
+
+	deadline := time.Now().Add(job_queue.DefaultTimeout - jobMargin)
+	if d, ok := ctx.Deadline(); ok && d.Add(-jobMargin).Before(deadline) {
+		deadline = d.Add(-jobMargin)
+	}
+
+	return newWriter(i, run, deadline).write(ctx, ds)
+}
+
+// spool copies the archive to a local file as most formats need to be read
+// more than once or out of order.
+func (i *Importer) spool(ctx context.Context, id asset.AssetID) (string, error) {
+	_, r, err := i.downloader.GetByID(ctx, id)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+	if rc, ok := r.(io.Closer); ok {
+		defer rc.Close()
+	}
+
+	f, err := os.CreateTemp("", "storyden-import-")
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		os.Remove(f.Name())
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return f.Name(), nil
+}
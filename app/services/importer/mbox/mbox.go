@@ -0,0 +1,308 @@
+// Package mbox reads mailing list archives in the mbox format, either a single
+// mbox file, which may be gzipped, or a tarball of them such as the monthly
+// archives Mailman publishes. Messages are threaded by their Message-ID,
+// In-Reply-To and References headers.
+package mbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/services/importer/archive"
+	"github.com/Southclaws/storyden/app/services/importer/dataset"
+)
+
+const Name = "mbox"
+
+// Attachments have no URL of their own so they're given one which message
+// bodies refer to them by.
+const attachmentPath = "/mbox/attachments/"
+
+// Parse reads an mbox file, or an archive of them, at path.
+func Parse(ctx context.Context, path string) (*dataset.Dataset, error) {
+	dir, err := os.MkdirTemp("", "storyden-import-mbox-")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ds, err := parse(ctx, path, dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ds.Close = func() error { return os.RemoveAll(dir) }
+
+	return ds, nil
+}
+
+func parse(ctx context.Context, path string, dir string) (*dataset.Dataset, error) {
+	paths, err := archive.Extract(path, dir, isMbox)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	r := &reader{dir: filepath.Join(dir, "attachments")}
+	for _, p := range paths {
+		if err := r.file(p); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+				fmsg.WithDesc("invalid mbox", "The mailing list archive could not be read."))
+		}
+	}
+
+	if len(r.messages) == 0 {
+		return nil, fault.New("no messages in archive", fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("no messages", "The archive doesn't contain any mbox files with messages in them."))
+	}
+
+	return build(r.messages), nil
+}
+
+func isMbox(name string) bool {
+	name = strings.TrimSuffix(name, ".gz")
+	switch path.Ext(name) {
+	case ".mbox", ".mbx", ".txt":
+		return true
+	}
+	return path.Base(name) == "mbox"
+}
+
+func (r *reader) file(p string) error {
+	f, err := archive.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return split(f, func(raw []byte) error {
+		m, err := r.message(raw)
+		if err != nil {
+			// One malformed message shouldn't stop the rest of the archive.
+			return nil
+		}
+		r.messages = append(r.messages, m)
+		return nil
+	})
+}
+
+var escapedFrom = regexp.MustCompile(`^>+From `)
+
+// split calls fn with each message in an mbox. A message starts at a "From "
+// line at the start of the file or after a blank line. Lines in the body which
+// would look like one are escaped with ">", which is undone here.
+func split(r io.Reader, fn func(raw []byte) error) error {
+	br := bufio.NewReader(r)
+
+	var buf bytes.Buffer
+	started := false
+	blank := true
+
+	flush := func() error {
+		if !started {
+			return nil
+		}
+		raw := bytes.Clone(buf.Bytes())
+		buf.Reset()
+		return fn(raw)
+	}
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			switch {
+			case blank && bytes.HasPrefix(line, []byte("From ")):
+				if err := flush(); err != nil {
+					return err
+				}
+				started = true
+
+			case started:
+				if escapedFrom.Match(line) {
+					line = line[1:]
+				}
+				buf.Write(line)
+			}
+
+			blank = len(bytes.TrimRight(line, "\r\n")) == 0
+		}
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return flush()
+}
+
+func build(messages []*message) *dataset.Dataset {
+	ds := &dataset.Dataset{}
+
+	// Archives which overlap, such as a list's monthly archives alongside one
+	// for the whole year, contain the same message more than once.
+	byID := map[string]*message{}
+	unique := []*message{}
+	for _, m := range messages {
+		if _, ok := byID[m.id]; ok {
+			continue
+		}
+		byID[m.id] = m
+		unique = append(unique, m)
+	}
+
+	sort.SliceStable(unique, func(i, j int) bool { return unique[i].date.Before(unique[j].date) })
+
+	members := map[string]int{}
+	lists := map[string]bool{}
+
+	for _, m := range unique {
+		if m.from != "" {
+			if i, ok := members[m.from]; ok {
+				if ds.Members[i].Name == ds.Members[i].Handle && m.name != "" {
+					ds.Members[i].Name = m.name
+				}
+			} else {
+				local, _, _ := strings.Cut(m.from, "@")
+				members[m.from] = len(ds.Members)
+				ds.Members = append(ds.Members, dataset.Member{
+					ExternalID: m.from,
+					Handle:     local,
+					Name:       lo.CoalesceOrEmpty(m.name, local),
+					Email:      m.from,
+					CreatedAt:  m.date,
+					Unclaimed:  true,
+				})
+			}
+		}
+
+		if m.list.id != "" && !lists[m.list.id] {
+			lists[m.list.id] = true
+			ds.Categories = append(ds.Categories, dataset.Category{
+				ExternalID: m.list.id,
+				Name:       lo.CoalesceOrEmpty(m.list.name, m.list.id),
+				Sort:       len(ds.Categories),
+			})
+		}
+	}
+
+	for _, m := range unique {
+		for _, f := range m.files {
+			ds.Uploads = append(ds.Uploads, dataset.Upload{
+				ExternalID: f.id,
+				AuthorID:   m.from,
+				Filename:   f.name,
+				URLs:       []string{f.url()},
+				Open:       func() (io.ReadCloser, error) { return os.Open(f.path) },
+			})
+		}
+
+		parent := parentOf(m, byID)
+		root := rootOf(m, byID)
+
+		if root == m {
+			ds.Threads = append(ds.Threads, dataset.Thread{
+				ExternalID: m.id,
+				CategoryID: m.list.id,
+				AuthorID:   m.from,
+				Title:      title(m.subject),
+				Body:       m.content(),
+				CreatedAt:  m.date,
+			})
+			continue
+		}
+
+		// Replies to the first message are replies to the thread itself.
+		replyTo := parent.id
+		if parent == root {
+			replyTo = ""
+		}
+
+		ds.Posts = append(ds.Posts, dataset.Post{
+			ExternalID: m.id,
+			ThreadID:   root.id,
+			ReplyToID:  replyTo,
+			AuthorID:   m.from,
+			Body:       m.content(),
+			CreatedAt:  m.date,
+		})
+	}
+
+	return ds
+}
+
+// parentOf finds the message m replies to. In-Reply-To names the parent, but
+// not every client sets it so the closest message in References which is in
+// the archive is used otherwise.
+func parentOf(m *message, byID map[string]*message) *message {
+	for _, id := range m.inReplyTo {
+		if p, ok := byID[id]; ok && p != m {
+			return p
+		}
+	}
+	for i := len(m.references) - 1; i >= 0; i-- {
+		if p, ok := byID[m.references[i]]; ok && p != m {
+			return p
+		}
+	}
+	return nil
+}
+
+// rootOf follows parents up to the message which started the thread, or the
+// first one seen again if the headers go round in a loop.
+func rootOf(m *message, byID map[string]*message) *message {
+	seen := map[*message]bool{}
+	for {
+		seen[m] = true
+		p := parentOf(m, byID)
+		if p == nil || seen[p] {
+			return m
+		}
+		m = p
+	}
+}
+
+var replyPrefix = regexp.MustCompile(`(?i)^\s*((re|fw|fwd|aw|sv|antw)\s*(\[\d+\])?\s*:|\[[^\]]*\])\s*`)
+
+// title removes reply and forward prefixes and list tags from a subject.
+func title(subject string) string {
+	for {
+		trimmed := replyPrefix.ReplaceAllString(subject, "")
+		if trimmed == subject {
+			return strings.TrimSpace(trimmed)
+		}
+		subject = trimmed
+	}
+}
+
+// syntheticID stands in for the Message-ID of messages without one, so that
+// they're still the same item when an import is resumed.
+func syntheticID(raw []byte) string {
+	sum := sha1.Sum(raw)
+	return "sha1:" + hex.EncodeToString(sum[:])
+}
+
+func orZero(t time.Time, err error) time.Time {
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
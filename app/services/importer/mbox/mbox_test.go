@@ -0,0 +1,150 @@
+package mbox
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mbox = `From odin@example.com Mon Jan  6 10:00:00 2020
+From: "Odin" <Odin@Example.com>
+Subject: [asgard] Mead hall rota
+Date: Mon, 6 Jan 2020 10:00:00 +0000
+Message-ID: <1@example.com>
+List-Id: Asgard chat <asgard.lists.example.com>
+
+Who's on duty?
+See https://example.com/rota.
+
+From thor at example.com  Mon Jan  6 12:00:00 2020
+From: thor at example.com (Thor)
+Subject: Re: [asgard] Mead hall rota
+Date: Mon, 6 Jan 2020 12:00:00 +0000
+Message-ID: <2@example.com>
+In-Reply-To: <1@example.com>
+List-Id: Asgard chat <asgard.lists.example.com>
+Content-Type: multipart/mixed; boundary="b"
+
+--b
+Content-Type: text/plain; charset=iso-8859-1
+Content-Transfer-Encoding: quoted-printable
+
+> Who's on duty?
+
+Me, caf=E9 later.
+>From the hall.
+--=20
+Thor
+--b
+Content-Type: image/png; name="hammer.png"
+Content-Disposition: attachment; filename="hammer.png"
+Content-Transfer-Encoding: base64
+
+aGFtbWVy
+--b--
+
+From loki@example.com Mon Jan  6 11:00:00 2020
+From: loki@example.com
+Subject: =?utf-8?q?Re:_Mead_hall_rota?=
+Date: Mon, 6 Jan 2020 13:00:00 +0000
+Message-ID: <3@example.com>
+References: <1@example.com> <2@example.com>
+
+Not me.
+
+From odin@example.com Mon Jan  6 10:00:00 2020
+From: "Odin" <odin@example.com>
+Subject: [asgard] Mead hall rota
+Date: Mon, 6 Jan 2020 10:00:00 +0000
+Message-ID: <1@example.com>
+
+A duplicate.
+`
+
+func TestParse(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "asgard.mbox")
+	r.NoError(os.WriteFile(path, []byte(mbox), 0o644))
+
+	ds, err := Parse(context.Background(), path)
+	r.NoError(err)
+	defer ds.Close()
+
+	r.Len(ds.Members, 3)
+	a.Equal("odin@example.com", ds.Members[0].ExternalID)
+	a.Equal("odin", ds.Members[0].Handle)
+	a.Equal("Odin", ds.Members[0].Name)
+	a.True(ds.Members[0].Unclaimed)
+	a.Equal("Thor", ds.Members[1].Name, "archive addresses are read")
+	a.Equal("loki", ds.Members[2].Name)
+
+	r.Len(ds.Categories, 1)
+	a.Equal("asgard.lists.example.com", ds.Categories[0].ExternalID)
+	a.Equal("Asgard chat", ds.Categories[0].Name)
+
+	r.Len(ds.Threads, 1)
+	a.Equal("Mead hall rota", ds.Threads[0].Title)
+	a.Equal("asgard.lists.example.com", ds.Threads[0].CategoryID)
+	a.Equal(`<p>Who&#39;s on duty?<br>See <a href="https://example.com/rota">https://example.com/rota</a>.</p>`, ds.Threads[0].Body)
+
+	r.Len(ds.Posts, 2)
+	reply := ds.Posts[0]
+	a.Equal("2@example.com", reply.ExternalID)
+	a.Equal("1@example.com", reply.ThreadID)
+	a.Empty(reply.ReplyToID)
+	a.Contains(reply.Body, "<blockquote><p>Who&#39;s on duty?</p></blockquote>")
+	a.Contains(reply.Body, "Me, café later.<br>From the hall.</p>")
+	a.NotContains(reply.Body, "Thor", "signatures are dropped")
+
+	r.Len(ds.Uploads, 1)
+	a.Equal("hammer.png", ds.Uploads[0].Filename)
+	a.Equal("thor@example.com", ds.Uploads[0].AuthorID)
+	a.Contains(reply.Body, `<img src="`+ds.Uploads[0].URLs[0]+`" alt="hammer.png">`)
+
+	f, err := ds.Uploads[0].Open()
+	r.NoError(err)
+	data, _ := io.ReadAll(f)
+	f.Close()
+	a.Equal("hammer", string(data))
+
+	a.Equal("3@example.com", ds.Posts[1].ExternalID)
+	a.Equal("2@example.com", ds.Posts[1].ReplyToID, "the closest reference is the parent")
+}
+
+func TestParseEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.mbox")
+	require.NoError(t, os.WriteFile(path, []byte("not an mbox\n"), 0o644))
+
+	_, err := Parse(context.Background(), path)
+	assert.Error(t, err)
+}
+
+func TestTitle(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("Hello", title("Re: RE: [list] Fwd: Hello"))
+	a.Equal("Hello [world]", title("AW: Hello [world]"))
+	a.Equal("", title("Re:"))
+}
+
+func TestToHTML(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("<p>a<br>b</p><p>c</p>", toHTML("a\nb\n\n\nc\n"))
+	a.Equal("<blockquote><blockquote><p>deep</p></blockquote><p>shallow</p></blockquote><p>mine</p>", toHTML(">> deep\n> shallow\nmine"))
+	a.Equal("<p>&lt;b&gt;</p>", toHTML("<b>"))
+	a.Equal(`<p>&lt;<a href="http://x.y/?a=1&amp;b=2">http://x.y/?a=1&amp;b=2</a>&gt;</p>`, toHTML("<http://x.y/?a=1&b=2>"))
+	a.Equal("<p>body</p>", toHTML(strings.Join([]string{
+		"body",
+		"_______________________________________________",
+		"asgard mailing list",
+	}, "\n")))
+}
@@ -0,0 +1,303 @@
+package mbox
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/net/html/charset"
+)
+
+const maxDepth = 10
+
+type reader struct {
+	dir      string
+	files    int
+	messages []*message
+}
+
+type message struct {
+	id         string
+	from       string
+	name       string
+	subject    string
+	date       time.Time
+	inReplyTo  []string
+	references []string
+	list       list
+
+	text  string
+	html  string
+	files []file
+}
+
+type list struct {
+	id   string
+	name string
+}
+
+type file struct {
+	id   string
+	name string
+	path string
+	mime string
+}
+
+func (f file) url() string {
+	sum := sha1.Sum([]byte(f.id))
+	return attachmentPath + hex.EncodeToString(sum[:])
+}
+
+var decoder = &mime.WordDecoder{CharsetReader: charset.NewReaderLabel}
+
+func (r *reader) message(raw []byte) (*message, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	h := msg.Header
+
+	m := &message{
+		id:         first(messageIDs(h.Get("Message-Id"))),
+		subject:    decodeHeader(h.Get("Subject")),
+		date:       orZero(h.Date()),
+		inReplyTo:  messageIDs(h.Get("In-Reply-To")),
+		references: messageIDs(h.Get("References")),
+		list:       parseList(h.Get("List-Id")),
+	}
+	if m.id == "" {
+		m.id = syntheticID(raw)
+	}
+
+	if addr, ok := parseFrom(h.Get("From")); ok {
+		m.from = strings.ToLower(addr.Address)
+		m.name = addr.Name
+	}
+
+	if err := r.entity(m, h.Get, msg.Body, 0); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// entity reads a message body or one part of a multipart body. The first plain
+// text and HTML parts which aren't attachments are the message's content.
+func (r *reader) entity(m *message, get func(string) string, body io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	disposition, dparams, _ := mime.ParseMediaType(get("Content-Disposition"))
+	filename := decodeHeader(lo.CoalesceOrEmpty(dparams["filename"], params["name"]))
+	attached := disposition == "attachment" || filename != ""
+
+	body = decodeTransfer(get("Content-Transfer-Encoding"), body)
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if depth >= maxDepth {
+			return nil
+		}
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				// Truncated messages keep whatever parts could be read.
+				return nil
+			}
+			if err := r.entity(m, p.Header.Get, p, depth+1); err != nil {
+				return err
+			}
+		}
+
+	case mediaType == "text/plain" && !attached:
+		if m.text == "" {
+			m.text = decodeCharset(params["charset"], body)
+		}
+
+	case mediaType == "text/html" && !attached:
+		if m.html == "" {
+			m.html = decodeCharset(params["charset"], body)
+		}
+
+	case mediaType == "application/pgp-signature", mediaType == "application/pkcs7-signature":
+
+	default:
+		return r.attachment(m, mediaType, filename, body)
+	}
+
+	return nil
+}
+
+func (r *reader) attachment(m *message, mediaType string, filename string, body io.Reader) error {
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return err
+	}
+
+	r.files++
+	p := filepath.Join(r.dir, fmt.Sprint(r.files))
+
+	out, err := os.Create(p)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, body)
+	out.Close()
+	if err != nil {
+		return err
+	}
+
+	if filename == "" {
+		filename = "attachment"
+		if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+			filename += exts[0]
+		}
+	}
+
+	m.files = append(m.files, file{
+		id:   fmt.Sprintf("%s#%d", m.id, len(m.files)+1),
+		name: filepath.Base(filename),
+		path: p,
+		mime: mediaType,
+	})
+
+	return nil
+}
+
+// content is the message's body as HTML, with attachments after it. Plain text
+// is preferred as HTML email is mostly styling which wouldn't suit a post.
+func (m *message) content() string {
+	var out string
+	if strings.TrimSpace(m.text) != "" || m.html == "" {
+		out = toHTML(m.text)
+	} else {
+		out = m.html
+	}
+
+	for _, f := range m.files {
+		src := html.EscapeString(f.url())
+		name := html.EscapeString(f.name)
+		if strings.HasPrefix(f.mime, "image/") {
+			out += `<p><img src="` + src + `" alt="` + name + `"></p>`
+		} else {
+			out += `<p><a href="` + src + `">` + name + `</a></p>`
+		}
+	}
+
+	return out
+}
+
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	}
+	return body
+}
+
+func decodeCharset(label string, body io.Reader) string {
+	if label != "" {
+		if cr, err := charset.NewReaderLabel(label, body); err == nil {
+			body = cr
+		}
+	}
+	b, _ := io.ReadAll(body)
+	return strings.ToValidUTF8(string(b), "�")
+}
+
+func decodeHeader(s string) string {
+	d, err := decoder.DecodeHeader(s)
+	if err != nil {
+		return s
+	}
+	return strings.TrimSpace(d)
+}
+
+// parseFrom reads the sender's address, including the "name at example.com"
+// form Mailman uses to hide addresses in its public archives.
+func parseFrom(s string) (*mail.Address, bool) {
+	p := mail.AddressParser{WordDecoder: decoder}
+
+	if addr, err := p.Parse(s); err == nil {
+		return addr, true
+	}
+
+	if addr, err := p.Parse(strings.Replace(s, " at ", "@", 1)); err == nil {
+		return addr, true
+	}
+
+	return nil, false
+}
+
+// messageIDs reads the message IDs in a header, without their angle brackets.
+func messageIDs(s string) []string {
+	ids := []string{}
+	for {
+		start := strings.IndexByte(s, '<')
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(s[start:], '>')
+		if end == -1 {
+			break
+		}
+		if id := strings.TrimSpace(s[start+1 : start+end]); id != "" {
+			ids = append(ids, id)
+		}
+		s = s[start+end+1:]
+	}
+
+	// Some clients leave the brackets off a lone ID.
+	if len(ids) == 0 {
+		if id := strings.TrimSpace(s); id != "" && !strings.ContainsAny(id, " \t") {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// parseList reads a List-Id header such as "Go Nuts <golang-nuts.googlegroups.com>".
+func parseList(s string) list {
+	s = decodeHeader(s)
+
+	ids := messageIDs(s)
+	if len(ids) == 0 {
+		return list{}
+	}
+
+	name := s
+	if i := strings.IndexByte(s, '<'); i >= 0 {
+		name = s[:i]
+	}
+	name = strings.Trim(strings.TrimSpace(name), `"`)
+
+	return list{id: strings.ToLower(ids[0]), name: name}
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
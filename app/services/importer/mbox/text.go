@@ -0,0 +1,87 @@
+package mbox
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	link       = regexp.MustCompile(`https?://[^\s<>"]+`)
+	footerRule = regexp.MustCompile(`^_{20,}\s*$`)
+)
+
+// toHTML formats a plain text message. Quoted lines become blockquotes, blank
+// lines separate paragraphs and the sender's signature and any footer the list
+// added are dropped.
+func toHTML(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(text, "\n")
+
+	for i, l := range lines {
+		if l == "-- " || footerRule.MatchString(l) && strings.Contains(strings.Join(lines[i+1:], "\n"), "mailing list") {
+			lines = lines[:i]
+			break
+		}
+	}
+
+	return blocks(lines)
+}
+
+func blocks(lines []string) string {
+	var out strings.Builder
+	var para []string
+
+	endParagraph := func() {
+		if len(para) > 0 {
+			out.WriteString("<p>" + strings.Join(para, "<br>") + "</p>")
+			para = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		l := strings.TrimRight(lines[i], " \t")
+
+		if strings.HasPrefix(l, ">") {
+			endParagraph()
+
+			quoted := []string{}
+			for ; i < len(lines) && strings.HasPrefix(lines[i], ">"); i++ {
+				q := strings.TrimPrefix(lines[i], ">")
+				quoted = append(quoted, strings.TrimPrefix(q, " "))
+			}
+			i--
+
+			out.WriteString("<blockquote>" + blocks(quoted) + "</blockquote>")
+			continue
+		}
+
+		if strings.TrimSpace(l) == "" {
+			endParagraph()
+			continue
+		}
+
+		para = append(para, linkify(l))
+	}
+
+	endParagraph()
+
+	return out.String()
+}
+
+// linkify escapes a line of text, turning any URLs in it into links.
+func linkify(line string) string {
+	var out strings.Builder
+	last := 0
+
+	for _, loc := range link.FindAllStringIndex(line, -1) {
+		u := strings.TrimRight(line[loc[0]:loc[1]], ".,;:!?)'")
+		out.WriteString(html.EscapeString(line[last:loc[0]]))
+		out.WriteString(`<a href="` + html.EscapeString(u) + `">` + html.EscapeString(u) + `</a>`)
+		last = loc[0] + len(u)
+	}
+
+	out.WriteString(html.EscapeString(line[last:]))
+
+	return out.String()
+}
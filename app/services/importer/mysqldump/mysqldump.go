@@ -0,0 +1,454 @@
+// Package mysqldump reads the rows out of the SQL files produced by mysqldump
+// and phpMyAdmin, which is how most PHP forum software is backed up. Only
+// CREATE TABLE and INSERT statements are understood, everything else in the
+// dump is ignored.
+package mysqldump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Row is one row of a table, NULL columns are absent.
+type Row map[string]string
+
+func (r Row) Str(col string) string {
+	return r[col]
+}
+
+func (r Row) Null(col string) bool {
+	_, ok := r[col]
+	return !ok
+}
+
+func (r Row) Int(col string) int {
+	n, _ := strconv.Atoi(r[col])
+	return n
+}
+
+// Unix reads a column holding a unix timestamp, which is how most PHP forum
+// software stores dates.
+func (r Row) Unix(col string) time.Time {
+	n, err := strconv.ParseInt(r[col], 10, 64)
+	if err != nil || n <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(n, 0).UTC()
+}
+
+// Scan calls fn for every row inserted into the tables want returns true for.
+func Scan(r io.Reader, want func(table string) bool, fn func(table string, r Row) error) error {
+	columns := map[string][]string{}
+	br := bufio.NewReaderSize(r, 1024*1024)
+
+	for {
+		stmt, err := next(br)
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if len(stmt) > 0 {
+			if err := statement(stmt, columns, want, fn); err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+func statement(stmt []byte, columns map[string][]string, want func(string) bool, fn func(string, Row) error) error {
+	p := &parser{b: stmt}
+
+	switch {
+	case p.keywords("CREATE", "TABLE"):
+		p.keywords("IF", "NOT", "EXISTS")
+		table := p.identifier()
+		if table == "" || !want(table) {
+			return nil
+		}
+		columns[table] = tableColumns(p.b[p.i:])
+
+	case p.keywords("INSERT", "INTO"), p.keywords("INSERT", "IGNORE", "INTO"), p.keywords("REPLACE", "INTO"):
+		table := p.identifier()
+		if table == "" || !want(table) {
+			return nil
+		}
+
+		cols := columns[table]
+		if p.peek('(') {
+			cols = p.columnList()
+		}
+		if cols == nil {
+			return fmt.Errorf("no columns are known for table %s", table)
+		}
+
+		if !p.keywords("VALUES") && !p.keywords("VALUE") {
+			return fmt.Errorf("unsupported insert into %s", table)
+		}
+
+		for {
+			values, err := p.tuple()
+			if err != nil {
+				return fmt.Errorf("table %s: %w", table, err)
+			}
+
+			row := make(Row, len(cols))
+			for i, v := range values {
+				if i < len(cols) && v != nil {
+					row[cols[i]] = *v
+				}
+			}
+
+			if err := fn(table, row); err != nil {
+				return err
+			}
+
+			p.space()
+			if !p.peek(',') {
+				return nil
+			}
+			p.i++
+		}
+	}
+
+	return nil
+}
+
+// tableColumns reads column names from the body of a CREATE TABLE statement,
+// which dumps always write one per line.
+func tableColumns(body []byte) []string {
+	cols := []string{}
+	for _, line := range bytes.Split(body, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		line = bytes.TrimPrefix(line, []byte("("))
+		line = bytes.TrimSpace(line)
+		if len(line) < 2 || line[0] != '`' {
+			continue
+		}
+		end := bytes.IndexByte(line[1:], '`')
+		if end < 0 {
+			continue
+		}
+		cols = append(cols, string(line[1:end+1]))
+	}
+	return cols
+}
+
+// next reads up to the end of the next statement, skipping comments. MySQL's
+// versioned comments, such as /*!40101 SET ... */, are skipped too as they
+// only hold settings.
+func next(r *bufio.Reader) ([]byte, error) {
+	var buf []byte
+	var quote byte
+
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return bytes.TrimSpace(buf), err
+		}
+
+		if quote != 0 {
+			buf = append(buf, c)
+			switch c {
+			case '\\':
+				if quote != '`' {
+					e, err := r.ReadByte()
+					if err != nil {
+						return nil, io.ErrUnexpectedEOF
+					}
+					buf = append(buf, e)
+				}
+			case quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch c {
+		case '\'', '"', '`':
+			quote = c
+			buf = append(buf, c)
+
+		case ';':
+			return bytes.TrimSpace(buf), nil
+
+		case '#':
+			if _, err := r.ReadBytes('\n'); err != nil {
+				return bytes.TrimSpace(buf), err
+			}
+
+		case '-':
+			if b, _ := r.Peek(1); len(b) == 1 && b[0] == '-' {
+				if _, err := r.ReadBytes('\n'); err != nil {
+					return bytes.TrimSpace(buf), err
+				}
+				continue
+			}
+			buf = append(buf, c)
+
+		case '/':
+			if b, _ := r.Peek(1); len(b) == 1 && b[0] == '*' {
+				if err := skipComment(r); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			buf = append(buf, c)
+
+		default:
+			buf = append(buf, c)
+		}
+	}
+}
+
+func skipComment(r *bufio.Reader) error {
+	prev := byte(0)
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return io.ErrUnexpectedEOF
+		}
+		if prev == '*' && c == '/' {
+			return nil
+		}
+		prev = c
+	}
+}
+
+type parser struct {
+	b []byte
+	i int
+}
+
+func (p *parser) space() {
+	for p.i < len(p.b) && isSpace(p.b[p.i]) {
+		p.i++
+	}
+}
+
+func (p *parser) peek(c byte) bool {
+	p.space()
+	return p.i < len(p.b) && p.b[p.i] == c
+}
+
+// keywords consumes the keywords if the statement continues with all of them.
+func (p *parser) keywords(words ...string) bool {
+	start := p.i
+	for _, w := range words {
+		p.space()
+		end := p.i + len(w)
+		if end > len(p.b) || !strings.EqualFold(string(p.b[p.i:end]), w) || (end < len(p.b) && isWord(p.b[end])) {
+			p.i = start
+			return false
+		}
+		p.i = end
+	}
+	return true
+}
+
+// identifier reads a possibly quoted and possibly schema qualified name and
+// returns the last part of it.
+func (p *parser) identifier() string {
+	name := ""
+	for {
+		p.space()
+		if p.i >= len(p.b) {
+			return name
+		}
+
+		if p.b[p.i] == '`' {
+			end := bytes.IndexByte(p.b[p.i+1:], '`')
+			if end < 0 {
+				return ""
+			}
+			name = string(p.b[p.i+1 : p.i+1+end])
+			p.i += end + 2
+		} else {
+			start := p.i
+			for p.i < len(p.b) && isWord(p.b[p.i]) {
+				p.i++
+			}
+			name = string(p.b[start:p.i])
+		}
+
+		if p.i < len(p.b) && p.b[p.i] == '.' {
+			p.i++
+			continue
+		}
+
+		return name
+	}
+}
+
+func (p *parser) columnList() []string {
+	p.i++ // (
+	cols := []string{}
+	for {
+		cols = append(cols, p.identifier())
+		p.space()
+		if p.i >= len(p.b) {
+			return cols
+		}
+		c := p.b[p.i]
+		p.i++
+		if c == ')' {
+			return cols
+		}
+	}
+}
+
+// tuple reads a parenthesised list of values, nil values are NULL.
+func (p *parser) tuple() ([]*string, error) {
+	if !p.peek('(') {
+		return nil, fmt.Errorf("expected ( at offset %d", p.i)
+	}
+	p.i++
+
+	values := []*string{}
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+
+		p.space()
+		if p.i >= len(p.b) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		c := p.b[p.i]
+		p.i++
+		switch c {
+		case ',':
+		case ')':
+			return values, nil
+		default:
+			return nil, fmt.Errorf("unexpected %q at offset %d", c, p.i-1)
+		}
+	}
+}
+
+func (p *parser) value() (*string, error) {
+	p.space()
+	if p.i >= len(p.b) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	// Character set introducers such as _binary 'data' or _utf8mb4'text'.
+	if p.b[p.i] == '_' {
+		for p.i < len(p.b) && isWord(p.b[p.i]) {
+			p.i++
+		}
+		p.space()
+	}
+
+	c := p.b[p.i]
+	switch {
+	case c == '\'' || c == '"':
+		return p.str(c)
+
+	case (c == 'x' || c == 'X') && p.i+1 < len(p.b) && p.b[p.i+1] == '\'':
+		p.i++
+		s, err := p.str('\'')
+		if err != nil {
+			return nil, err
+		}
+		return decodeHex(*s)
+
+	case c == '0' && p.i+1 < len(p.b) && (p.b[p.i+1] == 'x' || p.b[p.i+1] == 'X'):
+		start := p.i + 2
+		p.i = start
+		for p.i < len(p.b) && isWord(p.b[p.i]) {
+			p.i++
+		}
+		return decodeHex(string(p.b[start:p.i]))
+
+	default:
+		start := p.i
+		for p.i < len(p.b) && !isSpace(p.b[p.i]) && p.b[p.i] != ',' && p.b[p.i] != ')' {
+			p.i++
+		}
+		word := string(p.b[start:p.i])
+		if strings.EqualFold(word, "NULL") {
+			return nil, nil
+		}
+		return &word, nil
+	}
+}
+
+func (p *parser) str(quote byte) (*string, error) {
+	p.i++
+	var sb strings.Builder
+	for p.i < len(p.b) {
+		c := p.b[p.i]
+		p.i++
+
+		switch c {
+		case '\\':
+			if p.i >= len(p.b) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			sb.WriteByte(unescape(p.b[p.i]))
+			p.i++
+
+		case quote:
+			// Quotes inside strings may also be escaped by doubling them.
+			if p.i < len(p.b) && p.b[p.i] == quote {
+				sb.WriteByte(quote)
+				p.i++
+				continue
+			}
+			s := sb.String()
+			return &s, nil
+
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+func unescape(c byte) byte {
+	switch c {
+	case '0':
+		return 0
+	case 'b':
+		return '\b'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'Z':
+		return 0x1a
+	default:
+		return c
+	}
+}
+
+func decodeHex(s string) (*string, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	v := string(b)
+	return &v, nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t'
+}
+
+func isWord(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,67 @@
+package mysqldump
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dump = "-- MySQL dump 10.13\n" +
+	"/*!40101 SET NAMES utf8mb4 */;\n" +
+	"DROP TABLE IF EXISTS `phpbb_posts`;\n" +
+	"CREATE TABLE `phpbb_posts` (\n" +
+	"  `post_id` int unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `post_text` mediumtext COMMENT 'it''s; text',\n" +
+	"  `post_subject` varchar(255) DEFAULT NULL,\n" +
+	"  `post_data` blob,\n" +
+	"  PRIMARY KEY (`post_id`)\n" +
+	") ENGINE=InnoDB;\n" +
+	"LOCK TABLES `phpbb_posts` WRITE;\n" +
+	"INSERT INTO `phpbb_posts` VALUES (1,'It\\'s a \\\"test\\\";\\nnew line','Hi',0x6869),(2,'semi; colon and ''doubled''',NULL,_binary 'raw');\n" +
+	"INSERT INTO `other_table` VALUES (1,'ignored');\n" +
+	"INSERT INTO phpbb_posts (`post_subject`, `post_id`) VALUES ('Columns', 3);\n" +
+	"UNLOCK TABLES;\n"
+
+func TestScan(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	rows := []Row{}
+	err := Scan(strings.NewReader(dump), func(table string) bool { return table == "phpbb_posts" }, func(table string, row Row) error {
+		rows = append(rows, row)
+		return nil
+	})
+	r.NoError(err)
+	r.Len(rows, 3)
+
+	a.Equal("It's a \"test\";\nnew line", rows[0].Str("post_text"))
+	a.Equal("hi", rows[0].Str("post_data"))
+	a.Equal(1, rows[0].Int("post_id"))
+
+	a.Equal("semi; colon and 'doubled'", rows[1].Str("post_text"))
+	a.True(rows[1].Null("post_subject"))
+	a.Equal("raw", rows[1].Str("post_data"))
+
+	a.Equal("Columns", rows[2].Str("post_subject"))
+	a.Equal("3", rows[2].Str("post_id"))
+	a.True(rows[2].Null("post_text"))
+}
+
+func TestTables(t *testing.T) {
+	a := assert.New(t)
+
+	tables := NewTables("user", "post")
+	a.True(tables.Want("vb_user"))
+	a.False(tables.Want("vb_usergroup"))
+
+	tables.Add("vb_user", Row{"userid": "1"})
+	tables.Add("vb_post", Row{"postid": "1"})
+	tables.Add("vb_blog_user", Row{"userid": "2"})
+
+	rows := tables.Rows()
+	a.Len(rows["user"], 1)
+	a.Equal("1", rows["user"][0].Str("userid"))
+	a.Len(rows["post"], 1)
+}
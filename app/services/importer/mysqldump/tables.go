@@ -0,0 +1,67 @@
+package mysqldump
+
+import (
+	"strings"
+)
+
+// Tables collects the rows of the tables an importer reads. Forum software
+// lets admins choose a prefix for table names, such as phpbb_, and a database
+// may hold more than one installation or add-ons which use similar names. So
+// tables are matched by name ignoring any prefix and once the dump has been
+// read, the prefix with the most matching tables wins.
+type Tables struct {
+	names    []string
+	prefixes map[string]map[string][]Row
+}
+
+func NewTables(names ...string) *Tables {
+	return &Tables{
+		names:    names,
+		prefixes: map[string]map[string][]Row{},
+	}
+}
+
+// Want is for passing to Scan.
+func (t *Tables) Want(table string) bool {
+	for _, name := range t.names {
+		if strings.HasSuffix(table, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add is for passing to Scan.
+func (t *Tables) Add(table string, r Row) error {
+	for _, name := range t.names {
+		prefix, ok := strings.CutSuffix(table, name)
+		if !ok {
+			continue
+		}
+
+		tables, ok := t.prefixes[prefix]
+		if !ok {
+			tables = map[string][]Row{}
+			t.prefixes[prefix] = tables
+		}
+		tables[name] = append(tables[name], r)
+	}
+	return nil
+}
+
+// Rows returns the rows of each table, by name without the prefix.
+func (t *Tables) Rows() map[string][]Row {
+	best := ""
+	for prefix, tables := range t.prefixes {
+		current := t.prefixes[best]
+		if len(tables) > len(current) || len(tables) == len(current) && prefix < best {
+			best = prefix
+		}
+	}
+
+	rows := t.prefixes[best]
+	if rows == nil {
+		return map[string][]Row{}
+	}
+	return rows
+}
@@ -0,0 +1,377 @@
+// Package phpbb reads phpBB 3 databases, from a mysqldump of the database on
+// its own or a tarball of the dump along with phpBB's files directory, which
+// is where attachments are stored.
+package phpbb
+
+import (
+	"context"
+	"html"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/import_run"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/password/legacy_password"
+	"github.com/Southclaws/storyden/app/services/importer/archive"
+	"github.com/Southclaws/storyden/app/services/importer/bbcode"
+	"github.com/Southclaws/storyden/app/services/importer/dataset"
+	"github.com/Southclaws/storyden/app/services/importer/mysqldump"
+)
+
+const Name = "phpbb"
+
+const (
+	userTypeIgnore = 2 // Guests and bots.
+	forumTypeLink  = 2
+	itemApproved   = 1
+)
+
+// Attachments have no URL of their own in phpBB so they're given one which
+// post content refers to them by.
+const attachmentPath = "/phpbb/attachments/"
+
+// Parse reads a dump, or an archive containing one, at path.
+func Parse(ctx context.Context, path string) (*dataset.Dataset, error) {
+	dir, err := os.MkdirTemp("", "storyden-import-phpbb-")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ds, err := parse(ctx, path, dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ds.Close = func() error { return os.RemoveAll(dir) }
+
+	return ds, nil
+}
+
+func parse(ctx context.Context, path string, dir string) (*dataset.Dataset, error) {
+	dump, err := archive.Unpack(path, dir, isDump, isFile)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+	defer dump.Close()
+
+	t := mysqldump.NewTables("users", "forums", "topics", "posts", "attachments")
+	if err := mysqldump.Scan(dump, t.Want, t.Add); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid dump", "The phpBB database dump could not be read."))
+	}
+
+	rows := t.Rows()
+	if len(rows["users"]) == 0 || len(rows["posts"]) == 0 {
+		return nil, fault.New("no phpBB tables in dump", fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("not phpbb", "The database dump doesn't contain phpBB's users and posts tables."))
+	}
+
+	files, err := index(dir)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return build(rows, files), nil
+}
+
+func isDump(name string) bool {
+	return strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, ".sql.gz")
+}
+
+func isFile(name string) bool {
+	return strings.HasPrefix(name, "files/") || strings.Contains(name, "/files/")
+}
+
+// index maps the names of attachment files to where they were extracted.
+// phpBB names them uniquely so the directory they were in doesn't matter.
+func index(dir string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		files[d.Name()] = p
+		return nil
+	})
+	return files, err
+}
+
+func build(rows map[string][]mysqldump.Row, files map[string]string) *dataset.Dataset {
+	ds := &dataset.Dataset{}
+
+	ignored := map[string]bool{}
+	for _, u := range rows["users"] {
+		id := u.Str("user_id")
+
+		if u.Int("user_type") == userTypeIgnore {
+			ignored[id] = true
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindMember, ExternalID: id, Reason: "guest or bot account"})
+			continue
+		}
+
+		m := dataset.Member{
+			ExternalID: id,
+			Handle:     html.UnescapeString(u.Str("username")),
+			Name:       html.UnescapeString(u.Str("username")),
+			Email:      u.Str("user_email"),
+			CreatedAt:  u.Unix("user_regdate"),
+		}
+		if hash := u.Str("user_password"); hash != "" {
+			m.PasswordHash = legacy_password.Encode(legacy_password.SchemePHPBB, hash, "")
+		}
+
+		ds.Members = append(ds.Members, m)
+	}
+
+	forums := rows["forums"]
+	sort.SliceStable(forums, func(i, j int) bool { return forums[i].Int("left_id") < forums[j].Int("left_id") })
+
+	for _, f := range forums {
+		id := f.Str("forum_id")
+
+		if f.Int("forum_type") == forumTypeLink {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindCategory, ExternalID: id, Reason: "link forum"})
+			continue
+		}
+
+		parent := f.Str("parent_id")
+		if parent == "0" {
+			parent = ""
+		}
+
+		ds.Categories = append(ds.Categories, dataset.Category{
+			ExternalID:  id,
+			ParentID:    parent,
+			Name:        html.UnescapeString(f.Str("forum_name")),
+			Description: plainText(f.Str("forum_desc")),
+			Sort:        f.Int("left_id"),
+		})
+	}
+
+	// Attachments for each post, ordered the way phpBB numbers them for inline
+	// attachment tags.
+	attachments := map[string][]mysqldump.Row{}
+	for _, a := range rows["attachments"] {
+		id := a.Str("attach_id")
+
+		switch {
+		case a.Int("in_message") == 1:
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindUpload, ExternalID: id, Reason: "private message attachment"})
+			continue
+		case a.Int("is_orphan") == 1:
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindUpload, ExternalID: id, Reason: "never attached to a post"})
+			continue
+		}
+
+		local, ok := files[a.Str("physical_filename")]
+		ds.Uploads = append(ds.Uploads, dataset.Upload{
+			ExternalID: id,
+			AuthorID:   a.Str("poster_id"),
+			Filename:   a.Str("real_filename"),
+			URLs:       []string{attachmentPath + id},
+			Open: func() (io.ReadCloser, error) {
+				if !ok {
+					return nil, fault.New("attachment file is not in the archive")
+				}
+				return os.Open(local)
+			},
+		})
+
+		postID := a.Str("post_msg_id")
+		attachments[postID] = append(attachments[postID], a)
+	}
+	for _, list := range attachments {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Int("filetime") > list[j].Int("filetime") })
+	}
+
+	posts := rows["posts"]
+	sort.SliceStable(posts, func(i, j int) bool {
+		if posts[i].Int("post_time") != posts[j].Int("post_time") {
+			return posts[i].Int("post_time") < posts[j].Int("post_time")
+		}
+		return posts[i].Int("post_id") < posts[j].Int("post_id")
+	})
+
+	byID := map[string]mysqldump.Row{}
+	for _, p := range posts {
+		byID[p.Str("post_id")] = p
+	}
+
+	threads := map[string]bool{}
+	firstPosts := map[string]bool{}
+	for _, t := range rows["topics"] {
+		id := t.Str("topic_id")
+		first, hasFirst := byID[t.Str("topic_first_post_id")]
+
+		skip := func(reason string) {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindThread, ExternalID: id, Reason: reason})
+		}
+
+		switch {
+		case t.Int("topic_moved_id") != 0:
+			skip("shadow of a moved topic")
+		case !approved(t, "topic"):
+			skip("deleted or awaiting approval in phpBB")
+		case !hasFirst:
+			skip("first post is missing")
+		default:
+			threads[id] = true
+			firstPosts[first.Str("post_id")] = true
+			ds.Threads = append(ds.Threads, dataset.Thread{
+				ExternalID: id,
+				CategoryID: t.Str("forum_id"),
+				AuthorID:   author(first, ignored),
+				Title:      html.UnescapeString(t.Str("topic_title")),
+				Body:       content(first, attachments[first.Str("post_id")]),
+				CreatedAt:  t.Unix("topic_time"),
+			})
+		}
+	}
+
+	for _, p := range posts {
+		id := p.Str("post_id")
+		if firstPosts[id] {
+			continue
+		}
+
+		skip := func(reason string) {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindPost, ExternalID: id, Reason: reason})
+		}
+
+		switch {
+		case !threads[p.Str("topic_id")]:
+			skip("thread was not imported")
+		case !approved(p, "post"):
+			skip("deleted or awaiting approval in phpBB")
+		default:
+			ds.Posts = append(ds.Posts, dataset.Post{
+				ExternalID: id,
+				ThreadID:   p.Str("topic_id"),
+				AuthorID:   author(p, ignored),
+				Body:       content(p, attachments[id]),
+				CreatedAt:  p.Unix("post_time"),
+			})
+		}
+	}
+
+	return ds
+}
+
+// approved reads phpBB 3.1's visibility column, or 3.0's approved flag.
+func approved(r mysqldump.Row, prefix string) bool {
+	if !r.Null(prefix + "_visibility") {
+		return r.Int(prefix+"_visibility") == itemApproved
+	}
+	if !r.Null(prefix + "_approved") {
+		return r.Int(prefix+"_approved") == itemApproved
+	}
+	return true
+}
+
+// author is empty for guests, whose posts are attributed to the importer.
+func author(p mysqldump.Row, ignored map[string]bool) string {
+	id := p.Str("poster_id")
+	if ignored[id] {
+		return ""
+	}
+	return id
+}
+
+var (
+	smiley    = regexp.MustCompile(`<!-- s(.*?) --><img[^>]*><!-- s.*? -->`)
+	magicLink = regexp.MustCompile(`<!-- [mlwe] --><a[^>]*href="([^"]*)"[^>]*>(.*?)</a><!-- [mlwe] -->`)
+	comment   = regexp.MustCompile(`<!--.*?-->`)
+	lineBreak = regexp.MustCompile(`<br\s*/?>`)
+	markup    = regexp.MustCompile(`<[^>]+>`)
+)
+
+// content converts a post's text to HTML. phpBB 3.0 stores BBCode with each
+// tag marked with the post's ID and a few constructs already as HTML, from 3.2
+// the text is stored as XML wrapping the BBCode as it was written.
+func content(p mysqldump.Row, attachments []mysqldump.Row) string {
+	text := p.Str("post_text")
+
+	if strings.HasPrefix(text, "<r>") || strings.HasPrefix(text, "<t>") {
+		text = lineBreak.ReplaceAllString(text, "\n")
+		text = markup.ReplaceAllString(text, "")
+	} else {
+		if uid := p.Str("bbcode_uid"); uid != "" {
+			text = regexp.MustCompile(`:(?:[a-z]:)?`+regexp.QuoteMeta(uid)+`\]`).ReplaceAllString(text, "]")
+		}
+		text = smiley.ReplaceAllString(text, "$1")
+		text = magicLink.ReplaceAllString(text, "[url=$1]$2[/url]")
+		text = comment.ReplaceAllString(text, "")
+	}
+
+	text = html.UnescapeString(text)
+
+	inline := map[string]bool{}
+	out := bbcode.ToHTML(text, bbcode.WithAttachment("attachment", func(arg, body string) (bbcode.File, bool) {
+		a, ok := find(attachments, arg, body)
+		if !ok {
+			return bbcode.File{}, false
+		}
+		inline[a.Str("attach_id")] = true
+		return bbcode.File{URL: attachmentPath + a.Str("attach_id"), Name: a.Str("real_filename"), Image: isImage(a)}, true
+	}))
+
+	// Attachments which weren't placed in the text are shown after it.
+	for i := len(attachments) - 1; i >= 0; i-- {
+		a := attachments[i]
+		if inline[a.Str("attach_id")] {
+			continue
+		}
+		src := html.EscapeString(attachmentPath + a.Str("attach_id"))
+		name := html.EscapeString(a.Str("real_filename"))
+		if isImage(a) {
+			out += `<p><img src="` + src + `" alt="` + name + `"></p>`
+		} else {
+			out += `<p><a href="` + src + `">` + name + `</a></p>`
+		}
+	}
+
+	return out
+}
+
+// find matches an inline attachment tag by filename, falling back to its
+// index as the filename isn't always kept in the tag.
+func find(attachments []mysqldump.Row, index, filename string) (mysqldump.Row, bool) {
+	for _, a := range attachments {
+		if a.Str("real_filename") == filename {
+			return a, true
+		}
+	}
+
+	i, err := strconv.Atoi(index)
+	if err != nil || i < 0 || i >= len(attachments) {
+		return nil, false
+	}
+
+	return attachments[i], true
+}
+
+func isImage(a mysqldump.Row) bool {
+	if strings.HasPrefix(a.Str("mimetype"), "image/") {
+		return true
+	}
+	switch strings.ToLower(path.Ext(a.Str("real_filename"))) {
+	case ".png", ".jpg", ".jpeg", ".gif", ".webp":
+		return true
+	}
+	return false
+}
+
+func plainText(s string) string {
+	return strings.TrimSpace(html.UnescapeString(markup.ReplaceAllString(comment.ReplaceAllString(s, ""), "")))
+}
@@ -0,0 +1,333 @@
+// Package vbulletin reads vBulletin 3 and 4 databases, from a mysqldump of the
+// database on its own or a tarball of the dump along with the attachments
+// directory for forums which store attachments as files.
+package vbulletin
+
+import (
+	"context"
+	"html"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/import_run"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/password/legacy_password"
+	"github.com/Southclaws/storyden/app/services/importer/archive"
+	"github.com/Southclaws/storyden/app/services/importer/bbcode"
+	"github.com/Southclaws/storyden/app/services/importer/dataset"
+	"github.com/Southclaws/storyden/app/services/importer/mysqldump"
+)
+
+const Name = "vbulletin"
+
+const (
+	visible        = 1
+	threadRedirect = 10
+	contentPost    = "Post"
+)
+
+// Attachments are only served through vBulletin's attachment.php so they're
+// given a URL which post content refers to them by.
+const attachmentPath = "/vbulletin/attachments/"
+
+// Parse reads a dump, or an archive containing one, at path.
+func Parse(ctx context.Context, path string) (*dataset.Dataset, error) {
+	dir, err := os.MkdirTemp("", "storyden-import-vbulletin-")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ds, err := parse(ctx, path, dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ds.Close = func() error { return os.RemoveAll(dir) }
+
+	return ds, nil
+}
+
+func parse(ctx context.Context, path string, dir string) (*dataset.Dataset, error) {
+	dump, err := archive.Unpack(path, dir, isDump, isAttachment)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+	defer dump.Close()
+
+	t := mysqldump.NewTables("user", "forum", "thread", "post", "attachment", "filedata", "contenttype")
+	if err := mysqldump.Scan(dump, t.Want, t.Add); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid dump", "The vBulletin database dump could not be read."))
+	}
+
+	rows := t.Rows()
+	if len(rows["user"]) == 0 || len(rows["post"]) == 0 {
+		return nil, fault.New("no vBulletin tables in dump", fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("not vbulletin", "The database dump doesn't contain vBulletin's user and post tables."))
+	}
+
+	return build(rows, dir), nil
+}
+
+func isDump(name string) bool {
+	return strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, ".sql.gz")
+}
+
+func isAttachment(name string) bool {
+	return strings.HasPrefix(name, "attachments/") && strings.HasSuffix(name, ".attach")
+}
+
+func build(rows map[string][]mysqldump.Row, dir string) *dataset.Dataset {
+	ds := &dataset.Dataset{}
+
+	for _, u := range rows["user"] {
+		m := dataset.Member{
+			ExternalID: u.Str("userid"),
+			Handle:     html.UnescapeString(u.Str("username")),
+			Name:       html.UnescapeString(u.Str("username")),
+			Email:      u.Str("email"),
+			CreatedAt:  u.Unix("joindate"),
+		}
+		if hash := u.Str("password"); len(hash) == 32 {
+			m.PasswordHash = legacy_password.Encode(legacy_password.SchemeVBulletin, hash, u.Str("salt"))
+		}
+
+		ds.Members = append(ds.Members, m)
+	}
+
+	forums := rows["forum"]
+	sort.SliceStable(forums, func(i, j int) bool { return forums[i].Int("displayorder") < forums[j].Int("displayorder") })
+
+	for _, f := range forums {
+		id := f.Str("forumid")
+
+		if f.Str("link") != "" {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindCategory, ExternalID: id, Reason: "link forum"})
+			continue
+		}
+
+		parent := f.Str("parentid")
+		if parent == "-1" || parent == "0" {
+			parent = ""
+		}
+
+		ds.Categories = append(ds.Categories, dataset.Category{
+			ExternalID:  id,
+			ParentID:    parent,
+			Name:        html.UnescapeString(f.Str("title")),
+			Description: html.UnescapeString(f.Str("description")),
+			Sort:        f.Int("displayorder"),
+		})
+	}
+
+	attachments := uploads(ds, rows, dir)
+
+	posts := rows["post"]
+	sort.SliceStable(posts, func(i, j int) bool {
+		if posts[i].Int("dateline") != posts[j].Int("dateline") {
+			return posts[i].Int("dateline") < posts[j].Int("dateline")
+		}
+		return posts[i].Int("postid") < posts[j].Int("postid")
+	})
+
+	byID := map[string]mysqldump.Row{}
+	for _, p := range posts {
+		byID[p.Str("postid")] = p
+	}
+
+	threads := map[string]bool{}
+	firstPosts := map[string]bool{}
+	for _, t := range rows["thread"] {
+		id := t.Str("threadid")
+		first, hasFirst := byID[t.Str("firstpostid")]
+
+		skip := func(reason string) {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindThread, ExternalID: id, Reason: reason})
+		}
+
+		switch {
+		case t.Int("open") == threadRedirect:
+			skip("redirect to a moved thread")
+		case t.Int("visible") != visible:
+			skip("deleted or awaiting moderation in vBulletin")
+		case !hasFirst:
+			skip("first post is missing")
+		default:
+			threads[id] = true
+			firstPosts[first.Str("postid")] = true
+			ds.Threads = append(ds.Threads, dataset.Thread{
+				ExternalID: id,
+				CategoryID: t.Str("forumid"),
+				AuthorID:   author(first),
+				Title:      html.UnescapeString(t.Str("title")),
+				Body:       content(first.Str("pagetext"), attachments[first.Str("postid")]),
+				CreatedAt:  t.Unix("dateline"),
+			})
+		}
+	}
+
+	for _, p := range posts {
+		id := p.Str("postid")
+		if firstPosts[id] {
+			continue
+		}
+
+		skip := func(reason string) {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindPost, ExternalID: id, Reason: reason})
+		}
+
+		switch {
+		case !threads[p.Str("threadid")]:
+			skip("thread was not imported")
+		case p.Int("visible") != visible:
+			skip("deleted or awaiting moderation in vBulletin")
+		default:
+			// Replies to the first post are replies to the thread itself.
+			replyTo := p.Str("parentid")
+			if replyTo == "0" || firstPosts[replyTo] {
+				replyTo = ""
+			}
+
+			ds.Posts = append(ds.Posts, dataset.Post{
+				ExternalID: id,
+				ThreadID:   p.Str("threadid"),
+				ReplyToID:  replyTo,
+				AuthorID:   author(p),
+				Body:       content(p.Str("pagetext"), attachments[id]),
+				CreatedAt:  p.Unix("dateline"),
+			})
+		}
+	}
+
+	return ds
+}
+
+type attachment struct {
+	id       string
+	filename string
+}
+
+// uploads reads attachments, which vBulletin 3 keeps in one table and 4 splits
+// between attachments and the file data they refer to. The data is either in
+// the database or a file under the attachments directory, in a directory for
+// each digit of the uploader's ID.
+func uploads(ds *dataset.Dataset, rows map[string][]mysqldump.Row, dir string) map[string][]attachment {
+	filedata := map[string]mysqldump.Row{}
+	for _, f := range rows["filedata"] {
+		filedata[f.Str("filedataid")] = f
+	}
+
+	postType := ""
+	for _, c := range rows["contenttype"] {
+		if c.Str("class") == contentPost {
+			postType = c.Str("contenttypeid")
+		}
+	}
+
+	byPost := map[string][]attachment{}
+	for _, a := range rows["attachment"] {
+		id := a.Str("attachmentid")
+
+		postID, data, fileID := a.Str("postid"), a, id
+		if postID == "" {
+			// vBulletin 4 attaches files to any kind of content.
+			if postType != "" && a.Str("contenttypeid") != postType {
+				ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindUpload, ExternalID: id, Reason: "not attached to a post"})
+				continue
+			}
+			postID = a.Str("contentid")
+			fileID = a.Str("filedataid")
+			data = filedata[fileID]
+		}
+
+		if state := a.Str("state"); state != "" && state != "visible" || !a.Null("visible") && a.Int("visible") != visible {
+			ds.Skipped = append(ds.Skipped, dataset.Skip{Kind: import_run.KindUpload, ExternalID: id, Reason: "awaiting moderation in vBulletin"})
+			continue
+		}
+
+		blob := data.Str("filedata")
+		local := filepath.Join(dir, "attachments", filepath.Join(strings.Split(data.Str("userid"), "")...), fileID+".attach")
+
+		ds.Uploads = append(ds.Uploads, dataset.Upload{
+			ExternalID: id,
+			AuthorID:   a.Str("userid"),
+			Filename:   a.Str("filename"),
+			URLs:       []string{attachmentPath + id},
+			Open: func() (io.ReadCloser, error) {
+				if blob != "" {
+					return io.NopCloser(strings.NewReader(blob)), nil
+				}
+				return os.Open(local)
+			},
+		})
+
+		byPost[postID] = append(byPost[postID], attachment{id: id, filename: a.Str("filename")})
+	}
+
+	return byPost
+}
+
+// author is empty for guests, whose posts are attributed to the importer.
+func author(p mysqldump.Row) string {
+	id := p.Str("userid")
+	if id == "0" {
+		return ""
+	}
+	return id
+}
+
+func content(text string, attachments []attachment) string {
+	inline := map[string]bool{}
+	out := bbcode.ToHTML(text, bbcode.WithAttachment("attach", func(arg, body string) (bbcode.File, bool) {
+		id := strings.TrimSpace(body)
+		if _, err := strconv.Atoi(id); err != nil {
+			return bbcode.File{}, false
+		}
+		inline[id] = true
+		name := filename(attachments, id)
+		return bbcode.File{URL: attachmentPath + id, Name: name, Image: isImage(name)}, true
+	}))
+
+	// Attachments which weren't placed in the text are shown after it.
+	for _, a := range attachments {
+		if inline[a.id] {
+			continue
+		}
+		src := html.EscapeString(attachmentPath + a.id)
+		name := html.EscapeString(a.filename)
+		if isImage(a.filename) {
+			out += `<p><img src="` + src + `" alt="` + name + `"></p>`
+		} else {
+			out += `<p><a href="` + src + `">` + name + `</a></p>`
+		}
+	}
+
+	return out
+}
+
+func filename(attachments []attachment, id string) string {
+	for _, a := range attachments {
+		if a.id == id {
+			return a.filename
+		}
+	}
+	return ""
+}
+
+func isImage(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".png", ".jpg", ".jpeg", ".gif", ".webp":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,688 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/mail"
+	"net/url"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/import_run"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
+	category_svc "github.com/Southclaws/storyden/app/services/category"
+	"github.com/Southclaws/storyden/app/services/importer/dataset"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/otp"
+)
+
+var errOutOfTime = fault.New("import job ran out of time")
+
+const defaultCategoryColour = "#000000"
+
+// skipped is returned when an item can't be imported because of something
+// else in the dataset, such as a reply to a thread which wasn't imported.
+type skipped string
+
+func (s skipped) Error() string { return string(s) }
+
+type writer struct {
+	*Importer
+	run      *import_run.Run
+	deadline time.Time
+
+	// Internal IDs of everything imported so far, by kind and external ID.
+	ids map[string]xid.ID
+
+	// Imported uploads by the URL paths content in the source used for them.
+	assets map[string]*asset.Asset
+}
+
+func newWriter(i *Importer, run *import_run.Run, deadline time.Time) *writer {
+	return &writer{
+		Importer: i,
+		run:      run,
+		deadline: deadline,
+		ids:      map[string]xid.ID{},
+		assets:   map[string]*asset.Asset{},
+	}
+}
+
+func (w *writer) write(ctx context.Context, ds *dataset.Dataset) error {
+	for _, s := range ds.Skipped {
+		if err := w.skip(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range ds.Members {
+		if err := w.item(ctx, import_run.KindMember, m.ExternalID, func() (xid.ID, string, error) {
+			return w.member(ctx, m)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := w.writeCategories(ctx, ds.Categories); err != nil {
+		return err
+	}
+
+	for _, u := range ds.Uploads {
+		if err := w.item(ctx, import_run.KindUpload, u.ExternalID, func() (xid.ID, string, error) {
+			return w.upload(ctx, u)
+		}); err != nil {
+			return err
+		}
+
+		if err := w.mapUpload(ctx, u); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range ds.Threads {
+		if err := w.item(ctx, import_run.KindThread, t.ExternalID, func() (xid.ID, string, error) {
+			return w.thread(ctx, t)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range ds.Posts {
+		if err := w.item(ctx, import_run.KindPost, p.ExternalID, func() (xid.ID, string, error) {
+			return w.post(ctx, p)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range ds.Likes {
+		if err := w.item(ctx, import_run.KindLike, likeID(l), func() (xid.ID, string, error) {
+			return w.like(ctx, l)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return w.setLastReplies(ctx, ds)
+}
+
+// item imports one thing unless it already has been. Problems with the item
+// itself are recorded against it and the import carries on, any other error
+// stops the import.
+func (w *writer) item(ctx context.Context, kind import_run.Kind, externalID string, fn func() (xid.ID, string, error)) error {
+	if time.Now().After(w.deadline) {
+		return errOutOfTime
+	}
+
+	rec, exists, err := w.runs.Lookup(ctx, w.run.Source, kind, externalID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if exists && rec.Status != import_run.RecordStatusFailed {
+		if id, ok := rec.InternalID.Get(); ok {
+			w.ids[key(kind, externalID)] = id
+		}
+		return nil
+	}
+
+	id, message, err := fn()
+	if err != nil {
+		if ctx.Err() != nil {
+			return fault.Wrap(ctx.Err(), fctx.With(ctx))
+		}
+
+		status := import_run.RecordStatusFailed
+		if s := skipped(""); errors.As(err, &s) {
+			status = import_run.RecordStatusSkipped
+		}
+
+		return w.put(ctx, kind, externalID, opt.NewEmpty[xid.ID](), status, describe(err))
+	}
+
+	w.ids[key(kind, externalID)] = id
+
+	return w.put(ctx, kind, externalID, opt.New(id), import_run.RecordStatusImported, message)
+}
+
+func (w *writer) skip(ctx context.Context, s dataset.Skip) error {
+	_, exists, err := w.runs.Lookup(ctx, w.run.Source, s.Kind, s.ExternalID)
+	if err != nil || exists {
+		return err
+	}
+
+	return w.put(ctx, s.Kind, s.ExternalID, opt.NewEmpty[xid.ID](), import_run.RecordStatusSkipped, s.Reason)
+}
+
+func (w *writer) put(ctx context.Context, kind import_run.Kind, externalID string, id opt.Optional[xid.ID], status import_run.RecordStatus, message string) error {
+	err := w.runs.Put(ctx, w.run.ID, w.run.Source, kind, externalID, id, status, opt.NewIf(message, func(s string) bool { return s != "" }))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	return nil
+}
+
+func (w *writer) lookup(ctx context.Context, kind import_run.Kind, externalID string) (xid.ID, bool, error) {
+	if externalID == "" {
+		return xid.ID{}, false, nil
+	}
+
+	if id, ok := w.ids[key(kind, externalID)]; ok {
+		return id, true, nil
+	}
+
+	rec, exists, err := w.runs.Lookup(ctx, w.run.Source, kind, externalID)
+	if err != nil || !exists {
+		return xid.ID{}, false, err
+	}
+
+	id, ok := rec.InternalID.Get()
+	if ok {
+		w.ids[key(kind, externalID)] = id
+	}
+
+	return id, ok, nil
+}
+
+// author returns the member who wrote something, or whoever started the import
+// when the author wasn't imported, for example because they were deleted.
+func (w *writer) author(ctx context.Context, externalID string) (account.AccountID, string, error) {
+	id, ok, err := w.lookup(ctx, import_run.KindMember, externalID)
+	if err != nil {
+		return account.AccountID{}, "", err
+	}
+	if !ok {
+		return w.run.AccountID, "author was not imported, attributed to whoever started the import", nil
+	}
+	return account.AccountID(id), "", nil
+}
+
+func (w *writer) member(ctx context.Context, m dataset.Member) (xid.ID, string, error) {
+	addr, err := mail.ParseAddress(m.Email)
+	hasEmail := m.Email != "" && err == nil
+
+	if hasEmail {
+		acc, exists, err := w.emails.LookupAccount(ctx, *addr)
+		if err != nil {
+			return xid.ID{}, "", err
+		}
+		if exists {
+			return xid.ID(acc.ID), "matched an existing account by email address", nil
+		}
+	}
+
+	handle, err := w.handle(ctx, m)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	created := orNow(m.CreatedAt)
+
+	opts := []account_writer.Option{
+		account_writer.WithID(account.AccountID(xid.NewWithTime(created))),
+		func(am *ent.AccountMutation) { am.SetCreatedAt(created) },
+	}
+	if m.Name != "" {
+		opts = append(opts, account_writer.WithName(m.Name))
+	}
+	if m.Bio != "" {
+		if bio, err := datagraph.NewRichText(m.Bio); err == nil {
+			opts = append(opts, account_writer.WithBio(bio))
+		}
+	}
+
+	acc, err := w.accountWriter.Create(ctx, handle, opts...)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	message := ""
+	if handle != m.Handle {
+		message = fmt.Sprintf("handle changed from %q to %q", m.Handle, handle)
+	}
+
+	if m.PasswordHash != "" {
+		_, err := w.auth.Create(ctx, acc.ID, authentication.ServicePassword, authentication.TokenTypePasswordHash, acc.ID.String(), m.PasswordHash, nil)
+		if err != nil {
+			return xid.ID(acc.ID), "password was not imported: " + describe(err), nil
+		}
+	}
+
+	// The address stays unverified, members claim their account by verifying
+	// it, for example by resetting their password.
+	if hasEmail {
+		if m.Unclaimed {
+			if err := w.unclaimed(ctx, acc.ID, *addr); err != nil {
+				return xid.ID(acc.ID), "email sign in was not set up: " + describe(err), nil
+			}
+		} else if _, err := w.emails.Add(ctx, acc.ID, *addr, ""); err != nil {
+			return xid.ID(acc.ID), "email address was not added: " + describe(err), nil
+		}
+	}
+
+	return xid.ID(acc.ID), message, nil
+}
+
+// unclaimed sets up an account the same way as one registered with only an
+// email address, so signing in with the address sends a verification code and
+// whoever verifies it owns the account.
+func (w *writer) unclaimed(ctx context.Context, accountID account.AccountID, addr mail.Address) error {
+	code, err := otp.Generate()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.auth.Create(ctx, accountID, authentication.ServiceEmailVerify, authentication.TokenTypeNone, accountID.String(), xid.New().String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.emails.Add(ctx, accountID, addr, code)
+	return err
+}
+
+const maxHandleLength = 30
+
+// handle picks a valid, unused handle as close to the member's original one
+// as possible.
+func (w *writer) handle(ctx context.Context, m dataset.Member) (string, error) {
+	base := mark.Slugify(m.Handle)
+	if base == "" {
+		base = mark.Slugify("member-" + m.ExternalID)
+	}
+
+	for n := 1; n <= 10; n++ {
+		suffix := ""
+		if n > 1 {
+			suffix = fmt.Sprintf("-%d", n)
+		}
+
+		h := truncate(base, maxHandleLength-len(suffix)) + suffix
+
+		_, exists, err := w.accountQuerier.LookupByHandle(ctx, h)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return h, nil
+		}
+	}
+
+	return "", fault.New("no free handle", fmsg.WithDesc("handle taken", "The member's handle and its alternatives are already taken."))
+}
+
+// writeCategories imports parents before their children. Any categories left
+// whose parents can't be found, such as in a cycle, are imported at the top.
+func (w *writer) writeCategories(ctx context.Context, cats []dataset.Category) error {
+	inDataset := map[string]bool{}
+	for _, c := range cats {
+		inDataset[c.ExternalID] = true
+	}
+
+	done := map[string]bool{}
+	pending := cats
+	for len(pending) > 0 {
+		next := []dataset.Category{}
+		for _, c := range pending {
+			if c.ParentID != "" && inDataset[c.ParentID] && !done[c.ParentID] {
+				next = append(next, c)
+				continue
+			}
+
+			if err := w.item(ctx, import_run.KindCategory, c.ExternalID, func() (xid.ID, string, error) {
+				return w.category(ctx, c)
+			}); err != nil {
+				return err
+			}
+			done[c.ExternalID] = true
+		}
+
+		if len(next) == len(pending) {
+			for i := range next {
+				next[i].ParentID = ""
+			}
+		}
+		pending = next
+	}
+
+	return nil
+}
+
+func (w *writer) category(ctx context.Context, c dataset.Category) (xid.ID, string, error) {
+	slug := mark.Slugify(c.Slug)
+	if slug == "" {
+		slug = mark.Slugify(c.Name)
+	}
+
+	existing, err := w.categoryRepo.Get(ctx, slug)
+	if err == nil {
+		return xid.ID(existing.ID), "matched an existing category by slug", nil
+	}
+	if ftag.Get(err) != ftag.NotFound {
+		return xid.ID{}, "", err
+	}
+
+	description := c.Description
+	if content, err := datagraph.NewRichText(description); err == nil {
+		description = content.Plaintext()
+	}
+
+	partial := category_svc.Partial{
+		Name:        opt.New(c.Name),
+		Slug:        opt.New(slug),
+		Description: opt.New(description),
+		Colour:      opt.New(lo.CoalesceOrEmpty(c.Colour, defaultCategoryColour)),
+	}
+
+	parentID, ok, err := w.lookup(ctx, import_run.KindCategory, c.ParentID)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+	if ok {
+		partial.Parent = opt.New(category.CategoryID(parentID))
+	}
+
+	cat, err := w.categories.Create(ctx, partial)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	return xid.ID(cat.ID), "", nil
+}
+
+func (w *writer) upload(ctx context.Context, u dataset.Upload) (xid.ID, string, error) {
+	if u.Open == nil {
+		return xid.ID{}, "", skipped("file is not in the archive")
+	}
+
+	r, err := u.Open()
+	if err != nil {
+		return xid.ID{}, "", fault.Wrap(err, fmsg.WithDesc("missing file", "The file could not be read from the archive."))
+	}
+	defer r.Close()
+
+	name := u.Filename
+	if name == "" && len(u.URLs) > 0 {
+		name = path.Base(u.URLs[0])
+	}
+
+	a, err := w.uploader.Upload(ctx, r, 0, asset.NewFilename(name), asset_upload.Options{})
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	return a.ID, "", nil
+}
+
+// mapUpload remembers which asset each of an upload's URLs now refer to so
+// links in content can be changed.
+func (w *writer) mapUpload(ctx context.Context, u dataset.Upload) error {
+	id, ok := w.ids[key(import_run.KindUpload, u.ExternalID)]
+	if !ok {
+		return nil
+	}
+
+	a, err := w.assetQuerier.GetByID(ctx, id)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, raw := range u.URLs {
+		if p, ok := urlPath(raw); ok {
+			w.assets[p] = a
+		}
+	}
+
+	return nil
+}
+
+var linkAttribute = regexp.MustCompile(`(src|href)="([^"]*)"`)
+
+// content changes links to uploads into links to the imported assets and
+// returns the assets the content uses.
+func (w *writer) content(raw string) (datagraph.Content, []asset.AssetID, error) {
+	ids := []asset.AssetID{}
+
+	rewritten := linkAttribute.ReplaceAllStringFunc(raw, func(attr string) string {
+		m := linkAttribute.FindStringSubmatch(attr)
+
+		p, ok := urlPath(html.UnescapeString(m[2]))
+		if !ok {
+			return attr
+		}
+
+		a, ok := w.assets[p]
+		if !ok {
+			return attr
+		}
+
+		ids = append(ids, a.ID)
+		return fmt.Sprintf(`%s="/api/assets/%s"`, m[1], a.Name.String())
+	})
+
+	c, err := datagraph.NewRichText(rewritten)
+	if err != nil {
+		return datagraph.Content{}, nil, err
+	}
+
+	return c, lo.Uniq(ids), nil
+}
+
+func (w *writer) thread(ctx context.Context, t dataset.Thread) (xid.ID, string, error) {
+	authorID, message, err := w.author(ctx, t.AuthorID)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	content, assets, err := w.content(t.Body)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	created := orNow(t.CreatedAt)
+
+	opts := []thread_writer.Option{
+		thread_writer.WithID(post.ID(xid.NewWithTime(created))),
+		thread_writer.WithContent(content),
+		thread_writer.WithVisibility(visibility.VisibilityPublished),
+		thread_writer.WithAssets(assets),
+		createdAt(created),
+	}
+
+	categoryID, ok, err := w.lookup(ctx, import_run.KindCategory, t.CategoryID)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+	if ok {
+		opts = append(opts, thread_writer.WithCategory(categoryID))
+	}
+
+	title := lo.CoalesceOrEmpty(t.Title, "Untitled")
+
+	thr, err := w.threadWriter.Create(ctx, title, authorID, opts...)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	return xid.ID(thr.ID), message, nil
+}
+
+func (w *writer) post(ctx context.Context, p dataset.Post) (xid.ID, string, error) {
+	threadID, ok, err := w.lookup(ctx, import_run.KindThread, p.ThreadID)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+	if !ok {
+		return xid.ID{}, "", skipped("thread was not imported")
+	}
+
+	authorID, message, err := w.author(ctx, p.AuthorID)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	content, assets, err := w.content(p.Body)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	created := orNow(p.CreatedAt)
+
+	opts := []reply.Option{
+		reply.WithID(post.ID(xid.NewWithTime(created))),
+		reply.WithContent(content),
+		reply.WithVisibility(visibility.VisibilityPublished),
+		reply.WithAssets(assets...),
+		createdAt(created),
+	}
+
+	replyTo, ok, err := w.lookup(ctx, import_run.KindPost, p.ReplyToID)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+	if ok {
+		opts = append(opts, reply.WithReplyTo(post.ID(replyTo)))
+	}
+
+	r, err := w.replies.Create(ctx, authorID, post.ID(threadID), opts...)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+
+	return xid.ID(r.ID), message, nil
+}
+
+func likeID(l dataset.Like) string {
+	if l.ThreadID != "" {
+		return "thread:" + l.ThreadID + ":" + l.AuthorID
+	}
+	return "post:" + l.PostID + ":" + l.AuthorID
+}
+
+func (w *writer) like(ctx context.Context, l dataset.Like) (xid.ID, string, error) {
+	kind, target := import_run.KindPost, l.PostID
+	if l.ThreadID != "" {
+		kind, target = import_run.KindThread, l.ThreadID
+	}
+
+	postID, ok, err := w.lookup(ctx, kind, target)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+	if !ok {
+		return xid.ID{}, "", skipped("liked " + kind.String() + " was not imported")
+	}
+
+	accountID, ok, err := w.lookup(ctx, import_run.KindMember, l.AuthorID)
+	if err != nil {
+		return xid.ID{}, "", err
+	}
+	if !ok {
+		return xid.ID{}, "", skipped("member was not imported")
+	}
+
+	if err := w.likes.AddPostLike(ctx, account.AccountID(accountID), post.ID(postID)); err != nil {
+		return xid.ID{}, "", err
+	}
+
+	return postID, "", nil
+}
+
+// setLastReplies sets when each imported thread was last replied to in the
+// source, which is what threads are ordered by.
+func (w *writer) setLastReplies(ctx context.Context, ds *dataset.Dataset) error {
+	latest := map[string]time.Time{}
+	for _, t := range ds.Threads {
+		latest[t.ExternalID] = t.CreatedAt
+	}
+	for _, p := range ds.Posts {
+		if t, ok := latest[p.ThreadID]; ok && p.CreatedAt.After(t) {
+			latest[p.ThreadID] = p.CreatedAt
+		}
+	}
+
+	for externalID, t := range latest {
+		id, ok, err := w.lookup(ctx, import_run.KindThread, externalID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if !ok || t.IsZero() {
+			continue
+		}
+
+		_, err = w.threadWriter.Update(ctx, post.ID(id), func(m *ent.PostMutation) {
+			m.SetLastReplyAt(t)
+		})
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func createdAt(t time.Time) func(*ent.PostMutation) {
+	return func(m *ent.PostMutation) {
+		m.SetCreatedAt(t)
+		m.SetUpdatedAt(t)
+	}
+}
+
+func key(kind import_run.Kind, externalID string) string {
+	return kind.String() + ":" + externalID
+}
+
+func orNow(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Now()
+	}
+	return t
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+func urlPath(raw string) (string, bool) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Path == "" {
+		return "", false
+	}
+	return u.Path, true
+}
+
+// describe prefers the message written for people over the raw error.
+func describe(err error) string {
+	if issue := fmsg.GetIssue(err); issue != "" {
+		return issue
+	}
+	return err.Error()
+}
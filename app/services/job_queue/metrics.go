@@ -0,0 +1,68 @@
+package job_queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/Southclaws/storyden/app/resources/job"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/metrics"
+)
+
+type queueMetrics struct {
+	duration metric.Float64Histogram
+}
+
+// newQueueMetrics records how long jobs take and, whenever metrics are
+// collected, how many jobs of each kind are in each state.
+func newQueueMetrics(meter metric.Meter, repo *job.Repository) (*queueMetrics, error) {
+	duration, err := meter.Float64Histogram("storyden.job.duration",
+		metric.WithDescription("Duration of background job runs."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(metrics.LatencyBuckets...),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	_, err = meter.Int64ObservableGauge("storyden.jobs",
+		metric.WithDescription("Number of background jobs in each state."),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			stats, err := repo.Stats(ctx)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+
+			for _, s := range stats {
+				for status, n := range map[job.Status]int{
+					job.StatusPending:   s.Pending,
+					job.StatusRunning:   s.Running,
+					job.StatusSucceeded: s.Succeeded,
+					job.StatusDead:      s.Dead,
+				} {
+					o.Observe(int64(n), metric.WithAttributes(
+						attribute.String("kind", s.Kind),
+						attribute.String("status", status.String()),
+					))
+				}
+			}
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &queueMetrics{duration: duration}, nil
+}
+
+func (m *queueMetrics) observe(ctx context.Context, kind string, start time.Time, err error) {
+	m.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("kind", kind),
+		attribute.Bool("error", err != nil),
+	))
+}
@@ -0,0 +1,340 @@
+// Package job_queue runs background work stored in the database. Services
+// register a handler for each kind of job and enqueue jobs with arguments, any
+// instance with a handler for that kind may run it. Failed jobs are retried
+// with exponential backoff and once out of attempts are left as dead jobs.
+//
+// The queue is for work which must not be lost, such as email, digests, imports
+// and semantic indexing. Other reactions to events, such as cache invalidation,
+// realtime updates and notifications, still run on the message bus.
+package job_queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/job"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+var (
+	DefaultMaxAttempts  = 5
+	DefaultPollInterval = 5 * time.Second
+	DefaultBackoff      = 10 * time.Second
+	DefaultMaxBackoff   = time.Hour
+	DefaultTimeout      = 10 * time.Minute
+	staleCheckInterval  = time.Minute
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type handler struct {
+	fn          func(ctx context.Context, payload []byte) error
+	maxAttempts int
+}
+
+type Queue struct {
+	logger   *slog.Logger
+	repo     *job.Repository
+	metrics  *queueMetrics
+	interval time.Duration
+
+	mu       sync.RWMutex
+	handlers map[string]handler
+
+	wake        chan struct{}
+	slots       chan struct{}
+	wg          sync.WaitGroup
+	lastRelease time.Time
+}
+
+func New(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	cfg config.Config,
+	logger *slog.Logger,
+	meter metric.Meter,
+	repo *job.Repository,
+) (*Queue, error) {
+	metrics, err := newQueueMetrics(meter, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Queue{
+		logger:   logger,
+		repo:     repo,
+		metrics:  metrics,
+		interval: DefaultPollInterval,
+		handlers: map[string]handler{},
+		wake:     make(chan struct{}, 1),
+		slots:    make(chan struct{}, max(cfg.JobWorkers, 1)),
+	}
+
+	if cfg.JobPollInterval > 0 {
+		q.interval = cfg.JobPollInterval
+	}
+
+	wctx, cancel := context.WithCancel(ctx)
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go q.run(wctx)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			q.wg.Wait()
+			return nil
+		},
+	})
+
+	return q, nil
+}
+
+type RegisterOption func(*handler)
+
+func WithMaxAttempts(n int) RegisterOption {
+	return func(h *handler) {
+		h.maxAttempts = n
+	}
+}
+
+// Register sets the handler for a kind of job. Arguments are decoded from the
+// JSON payload the job was enqueued with. Handlers must be registered before
+// the application starts.
+func Register[T any](q *Queue, kind string, fn func(ctx context.Context, args T) error, opts ...RegisterOption) {
+	h := handler{
+		fn: func(ctx context.Context, payload []byte) error {
+			var args T
+			if err := json.Unmarshal(payload, &args); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			return fn(ctx, args)
+		},
+		maxAttempts: DefaultMaxAttempts,
+	}
+
+	for _, o := range opts {
+		o(&h)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.handlers[kind] = h
+}
+
+// Enqueue stores a job to be run as soon as a worker is free, or at the time
+// set with job.WithRunAt. The kind must have a registered handler.
+func Enqueue[T any](ctx context.Context, q *Queue, kind string, args T, opts ...job.Option) (*job.Job, error) {
+	h, ok := q.handler(kind)
+	if !ok {
+		return nil, fault.Newf("no handler registered for job kind '%s'", kind)
+	}
+
+	payload, err := json.Marshal(args)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	j, err := q.repo.Enqueue(ctx, kind, payload, h.maxAttempts, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	q.notify()
+
+	return j, nil
+}
+
+func (q *Queue) List(ctx context.Context, page pagination.Parameters, opts ...job.Query) (pagination.Result[*job.Job], error) {
+	return q.repo.List(ctx, page, opts...)
+}
+
+func (q *Queue) Get(ctx context.Context, id job.JobID) (*job.Job, error) {
+	return q.repo.Get(ctx, id)
+}
+
+func (q *Queue) Stats(ctx context.Context) ([]*job.KindStats, error) {
+	return q.repo.Stats(ctx)
+}
+
+func (q *Queue) Retry(ctx context.Context, id job.JobID) (*job.Job, error) {
+	j, err := q.repo.Retry(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	q.notify()
+
+	return j, nil
+}
+
+func (q *Queue) Delete(ctx context.Context, id job.JobID) error {
+	return q.repo.Delete(ctx, id)
+}
+
+// Prune removes jobs which succeeded before the given time.
+func (q *Queue) Prune(ctx context.Context, before time.Time) (int, error) {
+	return q.repo.DeleteSucceeded(ctx, before)
+}
+
+func (q *Queue) handler(kind string) (handler, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	h, ok := q.handlers[kind]
+	return h, ok
+}
+
+func (q *Queue) kinds() []string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	kinds := make([]string, 0, len(q.handlers))
+	for k := range q.handlers {
+		kinds = append(kinds, k)
+	}
+	slices.Sort(kinds)
+
+	return kinds
+}
+
+func (q *Queue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *Queue) run(ctx context.Context) {
+	ticker := time.NewTicker(q.interval)
+	defer ticker.Stop()
+
+	for {
+		q.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+func (q *Queue) poll(ctx context.Context) {
+	now := time.Now()
+
+	if now.Sub(q.lastRelease) > staleCheckInterval {
+		q.lastRelease = now
+		n, err := q.repo.ReleaseStale(ctx, now.Add(-2*DefaultTimeout))
+		if err != nil {
+			q.logger.Error("failed to release stale jobs", slog.String("error", err.Error()))
+		} else if n > 0 {
+			q.logger.Warn("released interrupted jobs", slog.Int("count", n))
+		}
+	}
+
+	free := cap(q.slots) - len(q.slots)
+
+	jobs, err := q.repo.Claim(ctx, q.kinds(), now, free)
+	if err != nil {
+		if ctx.Err() == nil {
+			q.logger.Error("failed to claim jobs", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	for _, j := range jobs {
+		q.slots <- struct{}{}
+		q.wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-q.slots
+				q.wg.Done()
+				q.notify()
+			}()
+
+			q.execute(ctx, j)
+		}()
+	}
+}
+
+func (q *Queue) execute(ctx context.Context, j *job.Job) {
+	// Outcomes are recorded even when the queue is stopping so the job isn't
+	// left running until it's released as stale.
+	rctx := context.WithoutCancel(ctx)
+
+	start := time.Now()
+	err := q.call(ctx, j)
+	now := time.Now()
+
+	q.metrics.observe(rctx, j.Kind, start, err)
+
+	if err == nil {
+		if err := q.repo.Succeed(rctx, j.ID, now); err != nil {
+			q.logger.Error("failed to record job success", slog.String("job_id", j.ID.String()), slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	retryAt := opt.NewEmpty[time.Time]()
+	if j.Attempts < j.MaxAttempts {
+		retryAt = opt.New(now.Add(backoff(j.Attempts)))
+	}
+
+	q.logger.Warn("job failed",
+		slog.String("job_id", j.ID.String()),
+		slog.String("kind", j.Kind),
+		slog.Int("attempt", j.Attempts),
+		slog.Bool("retrying", retryAt.Ok()),
+		slog.String("error", err.Error()),
+	)
+
+	if err := q.repo.Fail(rctx, j.ID, now, err.Error(), retryAt); err != nil {
+		q.logger.Error("failed to record job failure", slog.String("job_id", j.ID.String()), slog.String("error", err.Error()))
+	}
+}
+
+func (q *Queue) call(ctx context.Context, j *job.Job) (err error) {
+	h, ok := q.handler(j.Kind)
+	if !ok {
+		return fault.Newf("no handler registered for job kind '%s'", j.Kind)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fault.New(fmt.Sprintf("job panicked: %v", r))
+		}
+	}()
+
+	return h.fn(ctx, j.Payload)
+}
+
+// backoff doubles the wait after each failed attempt up to a ceiling.
+func backoff(attempts int) time.Duration {
+	d := DefaultBackoff
+	for i := 1; i < attempts && d < DefaultMaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, DefaultMaxBackoff)
+}
@@ -37,7 +37,7 @@ func (s *Manager) Create(ctx context.Context,
 		}
 	}
 
-	pre, err := s.preMutation(ctx, p, opt.NewEmpty[library.Node]())
+	pre, err := s.preMutation(ctx, owner, p, opt.NewEmpty[library.Node]())
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/Southclaws/storyden/app/services/generative"
 	"github.com/Southclaws/storyden/app/services/link/fetcher"
 	"github.com/Southclaws/storyden/app/services/tag/autotagger"
+	"github.com/Southclaws/storyden/app/services/tag/tagging"
 	"github.com/Southclaws/storyden/internal/deletable"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
@@ -51,6 +52,7 @@ type Manager struct {
 	tagWriter    *tag_writer.Writer
 	titler       generative.Titler
 	tagger       *autotagger.Tagger
+	tagging      *tagging.Manager
 	nc           *node_children.Writer
 	fetcher      *fetcher.Fetcher
 	summariser   generative.Summariser
@@ -66,6 +68,7 @@ func New(
 	tagWriter *tag_writer.Writer,
 	titler generative.Titler,
 	tagger *autotagger.Tagger,
+	tagging *tagging.Manager,
 	nc *node_children.Writer,
 	fetcher *fetcher.Fetcher,
 	summariser generative.Summariser,
@@ -80,6 +83,7 @@ func New(
 		tagWriter:    tagWriter,
 		titler:       titler,
 		tagger:       tagger,
+		tagging:      tagging,
 		nc:           nc,
 		fetcher:      fetcher,
 		summariser:   summariser,
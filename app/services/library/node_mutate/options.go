@@ -10,6 +10,7 @@ import (
 	"github.com/rs/xid"
 	"github.com/samber/lo"
 
+	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/library"
@@ -24,7 +25,7 @@ type preMutationResult struct {
 }
 
 // preMutation constructs node_writer options for a create or partial update.
-func (s *Manager) preMutation(ctx context.Context, p Partial, current opt.Optional[library.Node]) (*preMutationResult, error) {
+func (s *Manager) preMutation(ctx context.Context, accountID account.AccountID, p Partial, current opt.Optional[library.Node]) (*preMutationResult, error) {
 	opts := []node_writer.Option{}
 
 	// Apply all primitive options. These are just basic partial updates.
@@ -87,13 +88,13 @@ func (s *Manager) preMutation(ctx context.Context, p Partial, current opt.Option
 	if t, ok := p.Tags.Get(); ok {
 		n, ok := current.Get()
 		if ok {
-			tagOpts, err := s.createDeleteTagsForExistingNode(ctx, &n, t)
+			tagOpts, err := s.createDeleteTagsForExistingNode(ctx, accountID, &n, t)
 			if err != nil {
 				return nil, fault.Wrap(err, fctx.With(ctx))
 			}
 			opts = append(opts, tagOpts...)
 		} else {
-			tagOpts, err := s.createDeleteTagsForNewNode(ctx, t)
+			tagOpts, err := s.createDeleteTagsForNewNode(ctx, accountID, t)
 			if err != nil {
 				return nil, fault.Wrap(err, fctx.With(ctx))
 			}
@@ -121,10 +122,10 @@ func (s *Manager) buildAssetSourcesOpts(ctx context.Context, sources []string) (
 	return opts, nil
 }
 
-func (s *Manager) createDeleteTagsForNewNode(ctx context.Context, tags tag_ref.Names) ([]node_writer.Option, error) {
+func (s *Manager) createDeleteTagsForNewNode(ctx context.Context, accountID account.AccountID, tags tag_ref.Names) ([]node_writer.Option, error) {
 	opts := []node_writer.Option{}
 
-	newTags, err := s.tagWriter.Add(ctx, tags...)
+	newTags, err := s.tagging.Apply(ctx, accountID, tags)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -136,25 +137,31 @@ func (s *Manager) createDeleteTagsForNewNode(ctx context.Context, tags tag_ref.N
 	return opts, nil
 }
 
-func (s *Manager) createDeleteTagsForExistingNode(ctx context.Context, n *library.Node, tags tag_ref.Names) ([]node_writer.Option, error) {
+func (s *Manager) createDeleteTagsForExistingNode(ctx context.Context, accountID account.AccountID, n *library.Node, tags tag_ref.Names) ([]node_writer.Option, error) {
 	opts := []node_writer.Option{}
 
+	tags, err := s.tagWriter.Resolve(ctx, tags...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	currentTagNames := n.Tags.Names()
 
 	toCreate, toRemove := lo.Difference(tags, currentTagNames)
 
-	newTags, err := s.tagWriter.Add(ctx, toCreate...)
+	newTags, err := s.tagging.Apply(ctx, accountID, toCreate)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	removing := dt.Filter(n.Tags, func(t *tag_ref.Tag) bool { return lo.Contains(toRemove, t.Name) })
+	removable, err := s.tagging.Removable(ctx, accountID, removing)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
 	addIDs := dt.Map(newTags, func(t *tag_ref.Tag) tag_ref.ID { return t.ID })
-	removeIDs := dt.Reduce(n.Tags, func(acc []tag_ref.ID, prev *tag_ref.Tag) []tag_ref.ID {
-		if lo.Contains(toRemove, prev.Name) {
-			acc = append(acc, prev.ID)
-		}
-		return acc
-	}, []tag_ref.ID{})
+	removeIDs := dt.Map(removable, func(t *tag_ref.Tag) tag_ref.ID { return t.ID })
 
 	opts = append(opts, node_writer.WithTagsAdd(addIDs...))
 	opts = append(opts, node_writer.WithTagsRemove(removeIDs...))
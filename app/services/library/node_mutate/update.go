@@ -36,7 +36,7 @@ func (s *Manager) Update(ctx context.Context, qk library.QueryKey, p Partial) (*
 
 	oldVisibility := n.Visibility
 
-	pre, err := s.preMutation(ctx, p, opt.NewPtr(n))
+	pre, err := s.preMutation(ctx, accountID, p, opt.NewPtr(n))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
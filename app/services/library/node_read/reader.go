@@ -2,26 +2,40 @@ package node_read
 
 import (
 	"context"
+	"log/slog"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/datagraph/summary"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/library/node_querier"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/config"
 )
 
 type HydratedQuerier struct {
-	nodereader *node_querier.Querier
+	logger           *slog.Logger
+	nodereader       *node_querier.Querier
+	summariesEnabled bool
+	summaries        *summary.Repository
 }
 
 func New(
+	cfg config.Config,
+	logger *slog.Logger,
 	nodereader *node_querier.Querier,
+	summaries *summary.Repository,
 ) *HydratedQuerier {
 	return &HydratedQuerier{
-		nodereader: nodereader,
+		logger:           logger,
+		nodereader:       nodereader,
+		summariesEnabled: cfg.ContentSummariesEnabled,
+		summaries:        summaries,
 	}
 }
 
@@ -45,6 +59,8 @@ func (q *HydratedQuerier) GetBySlug(ctx context.Context, qk library.QueryKey, so
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	q.hydrateSummaries(ctx, n)
+
 	return n, nil
 }
 
@@ -62,5 +78,29 @@ func (q *HydratedQuerier) ListChildren(ctx context.Context, qk library.QueryKey,
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	q.hydrateSummaries(ctx, r.Items...)
+
 	return r, nil
 }
+
+// hydrateSummaries attaches generated summaries to nodes which have one.
+// Summaries are supplementary so failing to load them doesn't fail the read.
+func (q *HydratedQuerier) hydrateSummaries(ctx context.Context, nodes ...*library.Node) {
+	if !q.summariesEnabled || len(nodes) == 0 {
+		return
+	}
+
+	ids := dt.Map(nodes, func(n *library.Node) xid.ID { return n.Mark.ID() })
+
+	summaries, err := q.summaries.GetMany(ctx, ids...)
+	if err != nil {
+		q.logger.Warn("failed to hydrate node summaries", slog.String("error", err.Error()))
+		return
+	}
+
+	for _, n := range nodes {
+		if sm, ok := summaries[n.Mark.ID()]; ok {
+			n.Summary = opt.New(sm.Text)
+		}
+	}
+}
@@ -5,14 +5,19 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/rs/xid"
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/library/node_querier"
 	"github.com/Southclaws/storyden/app/resources/library/node_writer"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
 	"github.com/Southclaws/storyden/app/services/library/node_mutate"
+	"github.com/Southclaws/storyden/app/services/scheduler"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/semdex/index_job"
 	"github.com/Southclaws/storyden/app/services/tag/autotagger"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/ent"
@@ -29,7 +34,7 @@ func Build() fx.Option {
 // with duplicate messages since there's no checksum mechanism built currently.
 // TODO: Make these parameters configurable by the SD instance administrator.
 var (
-	DefaultReindexSchedule  = time.Hour * 21 // how frequently do we reindex
+	DefaultReindexSchedule  = "@every 21h"   // how frequently do we reindex
 	DefaultReindexThreshold = time.Hour * 24 // ignore indexed_at after this
 	DefaultReindexChunk     = 100            // size of query per reindex
 )
@@ -42,7 +47,7 @@ type semdexer struct {
 	nodeWriter  *node_writer.Writer
 	nodeUpdater *node_mutate.Manager
 
-	bus *pubsub.Bus
+	queue *index_job.Queuer
 
 	semdexMutator semdex.Mutator
 	semdexQuerier semdex.Querier
@@ -52,9 +57,9 @@ type semdexer struct {
 }
 
 func newSemdexer(
-	ctx context.Context,
 	lc fx.Lifecycle,
 	cfg config.Config,
+	sched *scheduler.Scheduler,
 	logger *slog.Logger,
 
 	db *ent.Client,
@@ -62,6 +67,7 @@ func newSemdexer(
 	nodeWriter *node_writer.Writer,
 	nodeUpdater *node_mutate.Manager,
 	bus *pubsub.Bus,
+	queue *index_job.Queuer,
 	semdexMutator semdex.Mutator,
 	semdexQuerier semdex.Querier,
 
@@ -78,7 +84,7 @@ func newSemdexer(
 		nodeQuerier:   nodeQuerier,
 		nodeWriter:    nodeWriter,
 		nodeUpdater:   nodeUpdater,
-		bus:           bus,
+		queue:         queue,
 		semdexMutator: semdexMutator,
 		semdexQuerier: semdexQuerier,
 
@@ -86,39 +92,27 @@ func newSemdexer(
 		tagWriter: tagWriter,
 	}
 
-	lc.Append(fx.StartHook(func(hctx context.Context) error {
-		go func() {
-			// TODO: Use something cleverer for this. Perhaps an event emitted
-			// once the http server does its boot at the root of the DI tree.
-			time.Sleep(time.Second * 10)
-			err := re.reindex(hctx, DefaultReindexThreshold, DefaultReindexChunk)
-			if err != nil {
-				re.logger.Error("failed to run initial reindex job", slog.String("error", err.Error()))
-			}
-		}()
-
-		go re.schedule(ctx, DefaultReindexSchedule, DefaultReindexThreshold, DefaultReindexChunk)
-
-		return nil
-	}))
+	sched.Register("node_reindex", DefaultReindexSchedule, func(ctx context.Context) error {
+		return re.reindex(ctx, DefaultReindexThreshold, DefaultReindexChunk)
+	})
 
 	lc.Append(fx.StartHook(func(hctx context.Context) error {
 		_, err := pubsub.Subscribe(hctx, bus, "node_semdex.published", func(ctx context.Context, evt *message.EventNodePublished) error {
-			return bus.SendCommand(ctx, &message.CommandNodeIndex{ID: evt.ID})
+			return queue.Index(ctx, datagraph.KindNode, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "node_semdex.unpublished", func(ctx context.Context, evt *message.EventNodeUnpublished) error {
-			return bus.SendCommand(ctx, &message.CommandNodeDeindex{ID: evt.ID})
+			return queue.Deindex(ctx, datagraph.KindNode, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "node_semdex.deleted", func(ctx context.Context, evt *message.EventNodeDeleted) error {
-			return bus.SendCommand(ctx, &message.CommandNodeDeindex{ID: evt.ID})
+			return queue.Deindex(ctx, datagraph.KindNode, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
@@ -127,21 +121,8 @@ func newSemdexer(
 		return nil
 	}))
 
-	lc.Append(fx.StartHook(func(hctx context.Context) error {
-		_, err := pubsub.SubscribeCommand(hctx, bus, "node_semdex.index", func(ctx context.Context, cmd *message.CommandNodeIndex) error {
-			return re.index(ctx, cmd.ID)
-		})
-		if err != nil {
-			return err
-		}
-
-		_, err = pubsub.SubscribeCommand(hctx, bus, "node_semdex.deindex", func(ctx context.Context, cmd *message.CommandNodeDeindex) error {
-			return re.deindex(ctx, cmd.ID)
-		})
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}))
+	queue.Handle(datagraph.KindNode,
+		func(ctx context.Context, id xid.ID) error { return re.index(ctx, library.NodeID(id)) },
+		func(ctx context.Context, id xid.ID) error { return re.deindex(ctx, library.NodeID(id)) },
+	)
 }
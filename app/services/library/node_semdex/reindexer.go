@@ -11,21 +11,11 @@ import (
 	"github.com/rs/xid"
 	"github.com/samber/lo"
 
-	"github.com/Southclaws/storyden/app/resources/library"
-	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/internal/ent"
 	ent_node "github.com/Southclaws/storyden/internal/ent/node"
 )
 
-func (r *semdexer) schedule(ctx context.Context, schedule time.Duration, reindexThreshold time.Duration, reindexChunk int) {
-	for range time.NewTicker(schedule).C {
-		err := r.reindex(ctx, reindexThreshold, reindexChunk)
-		if err != nil {
-			r.logger.Error("failed to run reindex job", slog.String("error", err.Error()))
-		}
-	}
-}
-
 func (r *semdexer) reindex(ctx context.Context, reindexThreshold time.Duration, reindexChunk int) error {
 	nodes, err := r.db.Node.Query().
 		Select(
@@ -61,19 +51,16 @@ func (r *semdexer) reindex(ctx context.Context, reindexThreshold time.Duration,
 		slog.Int("deleted", len(deleted)),
 	)
 
-	toIndex := dt.Map(updated, func(id xid.ID) any {
-		return message.CommandNodeIndex{ID: library.NodeID(id)}
-	})
-	toDelete := dt.Map(deleted, func(id xid.ID) any {
-		return message.CommandNodeDeindex{ID: library.NodeID(id)}
-	})
-
-	if err := r.bus.MustPublishMany(ctx, toIndex...); err != nil {
-		return fault.Wrap(err, fctx.With(ctx))
+	for _, id := range updated {
+		if err := r.queue.Index(ctx, datagraph.KindNode, id); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
 	}
 
-	if err := r.bus.MustPublishMany(ctx, toDelete...); err != nil {
-		return fault.Wrap(err, fctx.With(ctx))
+	for _, id := range deleted {
+		if err := r.queue.Deindex(ctx, datagraph.KindNode, id); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
 	}
 
 	return nil
@@ -32,7 +32,8 @@ func (l *PostLiker) AddPostLike(ctx context.Context, accountID account.AccountID
 	}
 
 	l.bus.Publish(ctx, &message.EventPostLiked{
-		PostID: postID,
+		PostID:    postID,
+		AccountID: accountID,
 	})
 
 	return nil
@@ -45,7 +46,8 @@ func (l *PostLiker) RemovePostLike(ctx context.Context, accountID account.Accoun
 	}
 
 	l.bus.Publish(ctx, &message.EventPostUnliked{
-		PostID: postID,
+		PostID:    postID,
+		AccountID: accountID,
 	})
 
 	return nil
@@ -6,11 +6,13 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -19,9 +21,12 @@ import (
 	"github.com/Southclaws/storyden/app/resources/link/link_writer"
 	"github.com/Southclaws/storyden/app/resources/mark"
 	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/settings"
 	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
 	"github.com/Southclaws/storyden/app/services/link/scrape"
+	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
 )
 
 var errEmptyLink = fault.New("empty link")
@@ -33,15 +38,30 @@ type Fetcher struct {
 	lr       *link_writer.LinkWriter
 	sc       scrape.Scraper
 	bus      *pubsub.Bus
+	settings *settings.SettingsRepository
+	client   *http.Client
+	ttl      time.Duration
+
+	// snapshots and wayback are the environment's defaults, the instance
+	// settings may override either at runtime.
+	snapshots bool
+	wayback   bool
+
+	// inflight collapses concurrent scrapes of the same URL, a link shared in
+	// a busy thread would otherwise be fetched once for every post.
+	inflight singleflight.Group
 }
 
 func New(
+	cfg config.Config,
 	logger *slog.Logger,
 	uploader *asset_upload.Uploader,
 	lq *link_querier.LinkQuerier,
 	lr *link_writer.LinkWriter,
 	sc scrape.Scraper,
 	bus *pubsub.Bus,
+	settings *settings.SettingsRepository,
+	policy *safehttp.Policy,
 ) *Fetcher {
 	return &Fetcher{
 		logger:   logger,
@@ -50,6 +70,12 @@ func New(
 		lr:       lr,
 		sc:       sc,
 		bus:      bus,
+		settings: settings,
+		client:   policy.Client(15 * time.Second),
+		ttl:      cfg.LinkPreviewTTL,
+
+		snapshots: cfg.LinkSnapshots,
+		wayback:   cfg.LinkWaybackArchive,
 	}
 }
 
@@ -62,18 +88,20 @@ func (s *Fetcher) Fetch(ctx context.Context, u url.URL, opts Options) (*link_ref
 		return nil, fault.Wrap(errEmptyLink, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
 	}
 
-	r, err := s.lq.Search(ctx, 0, 1, link_querier.WithURL(u.String()))
-	if err != nil {
+	existing, err := s.lq.GetByURL(ctx, u.String())
+	if err != nil && ftag.Get(err) != ftag.NotFound {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
-	if len(r.Links) > 0 {
-		if err := s.bus.SendCommand(ctx, &message.CommandScrapeLink{URL: u}); err != nil {
-			return nil, fault.Wrap(err, fctx.With(ctx))
+	if existing != nil {
+		if existing.IsStale(time.Now(), s.ttl) {
+			if err := s.bus.SendCommand(ctx, &message.CommandScrapeLink{URL: u}); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
 		}
-		return r.Links[0], nil
+		return existing, nil
 	}
 
-	lr, _, err := s.ScrapeAndStore(ctx, u)
+	lr, err := s.Unfurl(ctx, u)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -81,6 +109,28 @@ func (s *Fetcher) Fetch(ctx context.Context, u url.URL, opts Options) (*link_ref
 	return lr, nil
 }
 
+// Unfurl returns the stored preview of a link, scraping the page again only if
+// the preview is older than the configured TTL or the link is new.
+func (s *Fetcher) Unfurl(ctx context.Context, u url.URL) (*link_ref.LinkRef, error) {
+	existing, err := s.lq.GetByURL(ctx, u.String())
+	if err != nil && ftag.Get(err) != ftag.NotFound {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if existing != nil && !existing.IsStale(time.Now(), s.ttl) {
+		return existing, nil
+	}
+
+	v, err, _ := s.inflight.Do(u.String(), func() (any, error) {
+		ln, _, err := s.ScrapeAndStore(ctx, u)
+		return ln, err
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return v.(*link_ref.LinkRef), nil
+}
+
 // HydrateContentURLs takes all the URLs mentioned in the content of an item and
 // queues them for hydration. This process visits each URL and fetches metadata.
 // Then, stores that metadata in the database and relates them back to the item.
@@ -114,13 +164,30 @@ func (s *Fetcher) queueForItem(ctx context.Context, u url.URL, item datagraph.It
 }
 
 func (s *Fetcher) ScrapeAndStore(ctx context.Context, u url.URL) (*link_ref.LinkRef, *scrape.WebContent, error) {
+	now := time.Now()
+
 	wc, err := s.sc.Scrape(ctx, u)
 	if err != nil {
+		// A failed refresh keeps whatever preview was fetched previously, the
+		// page may only be temporarily unavailable.
+		existing, lerr := s.lq.GetByURL(ctx, u.String())
+		if lerr == nil {
+			s.logger.Warn("failed to refresh URL, keeping previously scraped information",
+				slog.String("error", err.Error()),
+				slog.String("url", u.String()))
+
+			if err := s.lr.MarkFetched(ctx, existing.ID, now); err != nil {
+				return nil, nil, fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return existing, &scrape.WebContent{}, nil
+		}
+
 		s.logger.Warn("failed to scrape URL, storing link with basic information only",
 			slog.String("error", err.Error()),
 			slog.String("url", u.String()))
 
-		ln, err := s.lr.Store(ctx, u.String(), "", "", []link_writer.Option{}...)
+		ln, err := s.lr.Store(ctx, u.String(), "", "", link_writer.WithFetchedAt(now))
 		if err != nil {
 			return nil, nil, fault.Wrap(err, fctx.With(ctx))
 		}
@@ -128,7 +195,11 @@ func (s *Fetcher) ScrapeAndStore(ctx context.Context, u url.URL) (*link_ref.Link
 		return ln, &scrape.WebContent{}, nil
 	}
 
-	opts := []link_writer.Option{}
+	opts := []link_writer.Option{link_writer.WithFetchedAt(now)}
+
+	if wc.SiteName != "" {
+		opts = append(opts, link_writer.WithSiteName(wc.SiteName))
+	}
 
 	if wc.Favicon != "" {
 		a, err := s.CopyAsset(ctx, wc.Favicon)
@@ -153,14 +224,46 @@ func (s *Fetcher) ScrapeAndStore(ctx context.Context, u url.URL) (*link_ref.Link
 		return nil, nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	features := s.features(ctx)
+
+	// Snapshots are only taken once, later refreshes keep the page as it was
+	// when it was first shared.
+	if features.LinkSnapshots.Or(s.snapshots) && !ln.Snapshot.Ok() {
+		if err := s.snapshot(ctx, ln, wc); err != nil {
+			s.logger.Warn("failed to capture snapshot of web content", slog.String("error", err.Error()), slog.String("url", u.String()))
+		}
+	}
+
+	if features.LinkWaybackArchive.Or(s.wayback) && !ln.ArchiveURL.Ok() {
+		if err := s.bus.SendCommand(ctx, &message.CommandArchiveLink{ID: ln.ID, URL: u}); err != nil {
+			return nil, nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	return ln, wc, nil
 }
 
+func (s *Fetcher) features(ctx context.Context) settings.Features {
+	set, err := s.settings.Get(ctx)
+	if err != nil {
+		s.logger.Warn("failed to read settings for link features", slog.String("error", err.Error()))
+		return settings.Features{}
+	}
+
+	return set.Features.OrZero()
+}
+
 func (s *Fetcher) CopyAsset(ctx context.Context, url string) (*asset.Asset, error) {
-	resp, err := http.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	resp, err := s.client.Do(req)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		ctx = fctx.WithMeta(ctx, "status", resp.Status)
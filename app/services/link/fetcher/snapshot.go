@@ -0,0 +1,58 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/link/link_ref"
+	"github.com/Southclaws/storyden/app/resources/link/link_writer"
+	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
+	"github.com/Southclaws/storyden/app/services/link/scrape"
+)
+
+var errEmptySnapshot = fault.New("page has no readable text to snapshot")
+
+// snapshot stores the readable text of a scraped page as a plain text asset
+// attached to the link.
+func (s *Fetcher) snapshot(ctx context.Context, ln *link_ref.LinkRef, wc *scrape.WebContent) error {
+	text := strings.TrimSpace(wc.Content.Plaintext())
+	if text == "" {
+		return fault.Wrap(errEmptySnapshot, fctx.With(ctx))
+	}
+
+	doc := formatSnapshot(ln.URL, wc.Title, time.Now(), text)
+	name := asset.NewFilename(fmt.Sprintf("%s-snapshot.txt", ln.Slug))
+
+	a, err := s.uploader.Upload(ctx, strings.NewReader(doc), int64(len(doc)), name, asset_upload.Options{})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.lr.Update(ctx, ln.ID, link_writer.WithSnapshot(a.ID, text)); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ln.Snapshot = opt.New(*a)
+
+	return nil
+}
+
+func formatSnapshot(url, title string, at time.Time, text string) string {
+	b := strings.Builder{}
+
+	if title != "" {
+		b.WriteString(title + "\n")
+	}
+	b.WriteString(url + "\n")
+	b.WriteString("Captured " + at.UTC().Format(time.RFC3339) + "\n\n")
+	b.WriteString(text + "\n")
+
+	return b.String()
+}
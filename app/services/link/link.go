@@ -2,6 +2,8 @@ package link
 
 import (
 	"github.com/Southclaws/storyden/app/services/link/fetcher"
+	"github.com/Southclaws/storyden/app/services/link/link_archive"
+	"github.com/Southclaws/storyden/app/services/link/link_checker"
 	"github.com/Southclaws/storyden/app/services/link/scrape"
 	"github.com/Southclaws/storyden/app/services/link/scrape_job"
 	"go.uber.org/fx"
@@ -14,5 +16,7 @@ func Build() fx.Option {
 			scrape.New,
 		),
 		scrape_job.Build(),
+		link_checker.Build(),
+		link_archive.Build(),
 	)
 }
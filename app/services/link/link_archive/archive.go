@@ -0,0 +1,62 @@
+// Package link_archive submits shared links to the Wayback Machine so a copy
+// of the page exists after it goes away.
+package link_archive
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/link/link_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// Capturing a page can take a while, the Wayback Machine loads it fully first.
+const archiveTimeout = 2 * time.Minute
+
+func Build() fx.Option {
+	return fx.Invoke(runArchiveConsumer)
+}
+
+type archiveConsumer struct {
+	wayback    *wayback
+	linkWriter *link_writer.LinkWriter
+}
+
+func runArchiveConsumer(
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	linkWriter *link_writer.LinkWriter,
+) {
+	// The consumer always runs as archiving may be enabled at runtime via the
+	// instance settings, commands are only sent while it's enabled.
+	ac := archiveConsumer{
+		wayback: &wayback{
+			client:   &http.Client{Timeout: archiveTimeout, Transport: outbound.Transport(nil)},
+			endpoint: waybackEndpoint,
+		},
+		linkWriter: linkWriter,
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.SubscribeCommand(hctx, bus, "link_archive.archive", func(ctx context.Context, cmd *message.CommandArchiveLink) error {
+			archived, err := ac.wayback.save(ctx, cmd.URL)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			if err := ac.linkWriter.Update(ctx, cmd.ID, link_writer.WithArchiveURL(archived)); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return nil
+		})
+		return err
+	}))
+}
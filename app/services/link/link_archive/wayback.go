@@ -0,0 +1,54 @@
+package link_archive
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+)
+
+const waybackEndpoint = "https://web.archive.org"
+
+var errNotArchived = fault.New("the wayback machine did not report where the page was archived")
+
+type wayback struct {
+	client   *http.Client
+	endpoint string
+}
+
+// save asks the Wayback Machine to capture the page and returns the address of
+// the capture. https://web.archive.org/save responds with, or redirects to, the
+// capture's /web/ address once the page has been archived.
+func (w *wayback) save(ctx context.Context, u url.URL) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.endpoint+"/save/"+u.String(), nil)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1024*1024))
+
+	if resp.StatusCode != http.StatusOK {
+		ctx = fctx.WithMeta(ctx, "status", resp.Status)
+		return "", fault.Wrap(errNotArchived, fctx.With(ctx))
+	}
+
+	if loc := resp.Header.Get("Content-Location"); strings.HasPrefix(loc, "/web/") {
+		return w.endpoint + loc, nil
+	}
+
+	if final := resp.Request.URL; strings.HasPrefix(final.Path, "/web/") {
+		return final.String(), nil
+	}
+
+	return "", fault.Wrap(errNotArchived, fctx.With(ctx))
+}
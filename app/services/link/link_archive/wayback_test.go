@@ -0,0 +1,71 @@
+package link_archive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaybackSave(t *testing.T) {
+	t.Parallel()
+
+	page, err := url.Parse("https://example.com/article")
+	require.NoError(t, err)
+
+	// Not a ServeMux, it would clean the "//" out of the archived URLs.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target, ok := strings.CutPrefix(r.URL.Path, "/save/")
+		if !ok {
+			return
+		}
+
+		switch {
+		case strings.Contains(target, "header"):
+			w.Header().Set("Content-Location", "/web/20260101000000/"+target)
+		case strings.Contains(target, "fail"):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case strings.Contains(target, "nowhere"):
+		default:
+			w.Header().Set("Location", "/web/20260101000000/"+target)
+			w.WriteHeader(http.StatusFound)
+		}
+	}))
+	defer srv.Close()
+
+	wb := &wayback{client: srv.Client(), endpoint: srv.URL}
+	ctx := context.Background()
+
+	t.Run("redirect", func(t *testing.T) {
+		got, err := wb.save(ctx, *page)
+		require.NoError(t, err)
+		assert.Equal(t, srv.URL+"/web/20260101000000/https://example.com/article", got)
+	})
+
+	t.Run("content_location", func(t *testing.T) {
+		u := *page
+		u.Path = "/header"
+		got, err := wb.save(ctx, u)
+		require.NoError(t, err)
+		assert.Equal(t, srv.URL+"/web/20260101000000/https://example.com/header", got)
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		u := *page
+		u.Path = "/fail"
+		_, err := wb.save(ctx, u)
+		assert.Error(t, err)
+	})
+
+	t.Run("no_capture", func(t *testing.T) {
+		u := *page
+		u.Path = "/nowhere"
+		_, err := wb.save(ctx, u)
+		assert.Error(t, err)
+	})
+}
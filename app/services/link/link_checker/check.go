@@ -0,0 +1,62 @@
+package link_checker
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/link/link_check"
+)
+
+type checker struct {
+	client *http.Client
+}
+
+// check requests the URL and decides whether the link should be considered
+// failing. Sites often reject automated requests with 401, 403 or 429, these
+// say nothing about whether the page exists so only responses which suggest
+// the page is gone or the site is down count as failures.
+func (c *checker) check(ctx context.Context, url string) link_check.Result {
+	status, err := c.request(ctx, http.MethodHead, url)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		status, err = c.request(ctx, http.MethodGet, url)
+	}
+	if err != nil {
+		return link_check.Result{
+			Error:  opt.New(err.Error()),
+			Failed: true,
+		}
+	}
+
+	return link_check.Result{
+		StatusCode: opt.New(status),
+		Failed:     isFailure(status),
+	}
+}
+
+func (c *checker) request(ctx context.Context, method, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("User-Agent", "Storyden link checker")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	return resp.StatusCode, nil
+}
+
+func isFailure(status int) bool {
+	return status == http.StatusNotFound ||
+		status == http.StatusGone ||
+		status >= http.StatusInternalServerError
+}
@@ -0,0 +1,63 @@
+package link_checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	})
+	mux.HandleFunc("/forbidden", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := &checker{client: srv.Client()}
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		path   string
+		status int
+		failed bool
+	}{
+		{"/ok", http.StatusOK, false},
+		{"/missing", http.StatusNotFound, true},
+		{"/gone", http.StatusGone, true},
+		{"/forbidden", http.StatusForbidden, false},
+		{"/error", http.StatusBadGateway, true},
+		{"/get-only", http.StatusOK, false},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			r := c.check(ctx, srv.URL+tc.path)
+			assert.Equal(t, tc.status, r.StatusCode.OrZero())
+			assert.Equal(t, tc.failed, r.Failed)
+			assert.False(t, r.Error.Ok())
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		r := c.check(ctx, "http://127.0.0.1:1/")
+		assert.True(t, r.Failed)
+		assert.True(t, r.Error.Ok())
+		assert.False(t, r.StatusCode.Ok())
+	})
+}
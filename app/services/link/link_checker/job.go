@@ -0,0 +1,94 @@
+// Package link_checker periodically requests every stored link to find those
+// which no longer resolve and flags them as broken for curators to fix.
+package link_checker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/link/link_check"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newCheckerJob)
+}
+
+const DefaultSchedule = "@hourly"
+
+const (
+	// batchSize is the most links checked in a single run, links which are not
+	// reached are checked by the next run.
+	batchSize = 200
+
+	// failureThreshold is how many consecutive failed checks flag a link as
+	// broken, so a site which is briefly down is not reported.
+	failureThreshold = 3
+
+	checkTimeout = 15 * time.Second
+)
+
+type checkerJob struct {
+	logger   *slog.Logger
+	checks   *link_check.Repository
+	checker  *checker
+	interval time.Duration
+}
+
+func newCheckerJob(
+	sched *scheduler.Scheduler,
+	cfg config.Config,
+	logger *slog.Logger,
+	checks *link_check.Repository,
+	policy *safehttp.Policy,
+) {
+	if cfg.LinkCheckInterval <= 0 {
+		return
+	}
+
+	j := &checkerJob{
+		logger:   logger,
+		checks:   checks,
+		checker:  &checker{client: policy.Client(checkTimeout)},
+		interval: cfg.LinkCheckInterval,
+	}
+
+	sched.Register("link_check", DefaultSchedule, j.run)
+}
+
+func (j *checkerJob) run(ctx context.Context) error {
+	now := time.Now()
+
+	due, err := j.checks.ListDue(ctx, now.Add(-j.interval), batchSize)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	broken := 0
+	for _, l := range due {
+		result := j.checker.check(ctx, l.URL)
+
+		isBroken, err := j.checks.Record(ctx, l.ID, result, time.Now(), failureThreshold)
+		if err != nil {
+			j.logger.Error("failed to record link check",
+				slog.String("error", err.Error()),
+				slog.String("url", l.URL))
+			continue
+		}
+
+		if isBroken {
+			broken++
+		}
+	}
+
+	j.logger.Debug("checked links", slog.Int("checked", len(due)), slog.Int("broken", broken))
+
+	return nil
+}
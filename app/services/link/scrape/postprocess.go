@@ -3,8 +3,10 @@ package scrape
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/url"
+	"strings"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/Southclaws/dt"
@@ -51,15 +53,25 @@ func (s *webScraper) postprocess(ctx context.Context, addr url.URL, r io.Reader)
 		return addr.ResolveReference(u).String()
 	}
 
+	image := t["og:image"]
+	if image == "" {
+		image = t["twitter:image"]
+	}
+
 	wc := &WebContent{
-		Title:       title(t),
+		Title:       title(t, strings.TrimSpace(doc.Find("head > title").First().Text())),
 		Description: description(t),
+		SiteName:    t["og:site_name"],
 		Text:        text,
 		Favicon:     withBaseURL(favicon(doc)),
-		Image:       withBaseURL(t["og:image"]),
+		Image:       withBaseURL(image),
 		Content:     rc,
 	}
 
+	if href, ok := doc.Find(fmt.Sprintf("link[type='%s']", oembedMediaType)).Attr("href"); ok {
+		wc.oembed = withBaseURL(href)
+	}
+
 	return wc, nil
 }
 
@@ -98,30 +110,35 @@ func ogtable(attrs []html.Attribute) (k string, v string) {
 	return
 }
 
-func title(t map[string]string) string {
+// title prefers the page's metadata, the title element is often suffixed with
+// the site's name so it's only used when there's nothing better.
+func title(t map[string]string, element string) string {
 	if t["og:title"] != "" {
 		return t["og:title"]
 	}
 	if t["title"] != "" {
 		return t["title"]
 	}
+	if t["twitter:title"] != "" {
+		return t["twitter:title"]
+	}
 	if t["og:site_name"] != "" {
 		return t["og:site_name"]
 	}
 	if t["og:url"] != "" {
 		return t["og:url"]
 	}
-	if t["title"] != "" {
-		return t["title"]
-	}
 
-	return ""
+	return element
 }
 
 func description(t map[string]string) string {
 	if t["og:description"] != "" {
 		return t["og:description"]
 	}
+	if t["twitter:description"] != "" {
+		return t["twitter:description"]
+	}
 	if t["description"] != "" {
 		return t["description"]
 	}
@@ -8,8 +8,10 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +47,36 @@ func Test_webScraper_postprocess(t *testing.T) {
 		os.WriteFile(filepath.Join("data", e.Name()), []byte(wc.Text), fs.ModePerm)
 	}
 }
+
+func Test_webScraper_postprocess_metadata(t *testing.T) {
+	ctx := context.Background()
+	w := webScraper{}
+	u, _ := url.Parse("https://example.com/posts/1")
+
+	t.Run("twitter_card", func(t *testing.T) {
+		page := `<html><head>
+			<title>Fallback title</title>
+			<meta name="twitter:title" content="Card title">
+			<meta name="twitter:description" content="Card description">
+			<meta name="twitter:image" content="/card.png">
+			<meta property="og:site_name" content="Example">
+			<link rel="alternate" type="application/json+oembed" href="/oembed?url=posts/1">
+		</head><body><p>Hello</p></body></html>`
+
+		wc, err := w.postprocess(ctx, *u, strings.NewReader(page))
+		require.NoError(t, err)
+		assert.Equal(t, "Card title", wc.Title)
+		assert.Equal(t, "Card description", wc.Description)
+		assert.Equal(t, "https://example.com/card.png", wc.Image)
+		assert.Equal(t, "Example", wc.SiteName)
+		assert.Equal(t, "https://example.com/oembed?url=posts/1", wc.oembed)
+	})
+
+	t.Run("title_element", func(t *testing.T) {
+		page := `<html><head><title> Only a title </title></head><body></body></html>`
+
+		wc, err := w.postprocess(ctx, *u, strings.NewReader(page))
+		require.NoError(t, err)
+		assert.Equal(t, "Only a title", wc.Title)
+	})
+}
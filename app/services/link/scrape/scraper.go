@@ -2,18 +2,29 @@ package scrape
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
 )
 
 var errFailedToScrape = fault.New("failed to scrape")
 
+const (
+	scrapeTimeout   = 15 * time.Second
+	maxPageSize     = 5 * 1024 * 1024
+	maxOEmbedSize   = 256 * 1024
+	oembedMediaType = "application/json+oembed"
+)
+
 type Scraper interface {
 	Scrape(ctx context.Context, url url.URL) (*WebContent, error)
 }
@@ -21,16 +32,23 @@ type Scraper interface {
 type WebContent struct {
 	Title       string
 	Description string
+	SiteName    string
 	Text        string
 	Favicon     string
 	Image       string
 	Content     datagraph.Content
+
+	oembed string
 }
 
-type webScraper struct{}
+type webScraper struct {
+	client *http.Client
+}
 
-func New() Scraper {
-	return &webScraper{}
+func New(policy *safehttp.Policy) Scraper {
+	return &webScraper{
+		client: policy.Client(scrapeTimeout),
+	}
 }
 
 func (s *webScraper) Scrape(ctx context.Context, addr url.URL) (*WebContent, error) {
@@ -61,19 +79,70 @@ func (s *webScraper) Scrape(ctx context.Context, addr url.URL) (*WebContent, err
 	req.Header.Add("Upgrade-Insecure-Requests", "1")
 	req.Header.Add("User-Agent", `Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36`)
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := s.client.Do(req)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, fault.Wrap(errFailedToScrape, fctx.With(ctx))
 	}
 
-	wc, err := s.postprocess(ctx, addr, resp.Body)
+	wc, err := s.postprocess(ctx, addr, io.LimitReader(resp.Body, maxPageSize))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// Pages which support oEmbed, such as videos and social media posts, often
+	// describe themselves better there than in their meta tags. If it fails the
+	// meta tags are still good enough.
+	if wc.oembed != "" {
+		_ = s.applyOEmbed(ctx, wc)
+	}
+
 	return wc, nil
 }
+
+// https://oembed.com/#section2.3
+type oembedResponse struct {
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	ProviderName string `json:"provider_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+func (s *webScraper) applyOEmbed(ctx context.Context, wc *WebContent) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wc.oembed, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fault.Wrap(errFailedToScrape, fctx.With(ctx))
+	}
+
+	var oe oembedResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOEmbedSize)).Decode(&oe); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if wc.Title == "" {
+		wc.Title = oe.Title
+	}
+	if wc.SiteName == "" {
+		wc.SiteName = oe.ProviderName
+	}
+	if wc.Image == "" {
+		wc.Image = oe.ThumbnailURL
+	}
+
+	return nil
+}
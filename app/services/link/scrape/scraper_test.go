@@ -7,11 +7,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
 )
 
 func Test_scraper_Scrape(t *testing.T) {
 	ctx := context.Background()
-	sc := New()
+	sc := New(&safehttp.Policy{})
 
 	u, _ := url.Parse("https://ogp.me/")
 
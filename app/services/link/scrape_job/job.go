@@ -122,7 +122,7 @@ func runScrapeConsumer(
 }
 
 func (s *scrapeConsumer) scrapeLink(ctx context.Context, u url.URL, item opt.Optional[datagraph.Ref]) error {
-	ln, _, err := s.fetcher.ScrapeAndStore(ctx, u)
+	ln, err := s.fetcher.Unfurl(ctx, u)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
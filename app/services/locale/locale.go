@@ -0,0 +1,167 @@
+// Package locale decides which language text written for someone is translated
+// into and which timezone dates in it are written in. An account's own choice
+// comes first, then for requests the hints sent by their browser, and finally
+// the instance's defaults.
+package locale
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"golang.org/x/text/language"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+	"github.com/Southclaws/storyden/internal/i18n"
+)
+
+type Resolver struct {
+	settings       *settings.SettingsRepository
+	accountQuerier *account_querier.Querier
+	accountWriter  *account_writer.Writer
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	accountQuerier *account_querier.Querier,
+	accountWriter *account_writer.Writer,
+) *Resolver {
+	return &Resolver{
+		settings:       settings,
+		accountQuerier: accountQuerier,
+		accountWriter:  accountWriter,
+	}
+}
+
+// ForAccount sets the language and timezone for text written for an account,
+// such as an email or a notification, which isn't necessarily the account
+// making the current request.
+func (r *Resolver) ForAccount(ctx context.Context, acc *account.Account) (context.Context, error) {
+	return r.resolve(ctx, []string{acc.Locale.OrZero()}, []string{acc.Timezone.OrZero()})
+}
+
+// ForAccountID is ForAccount for when only the account's ID is at hand.
+func (r *Resolver) ForAccountID(ctx context.Context, id account.AccountID) (context.Context, error) {
+	acc, err := r.accountQuerier.GetByID(ctx, id)
+	if err != nil {
+		return ctx, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r.ForAccount(ctx, &acc.Account)
+}
+
+// ForRequest sets the language and timezone for responses to the current
+// request, which uses the signed in account's choices over the hints sent in
+// the Accept-Language and timezone headers.
+func (r *Resolver) ForRequest(ctx context.Context, acc opt.Optional[account.Account], acceptLanguage, timezone string) (context.Context, error) {
+	locales := []string{}
+	timezones := []string{}
+	if a, ok := acc.Get(); ok {
+		locales = append(locales, a.Locale.OrZero())
+		timezones = append(timezones, a.Timezone.OrZero())
+	}
+	locales = append(locales, acceptLanguage)
+	timezones = append(timezones, timezone)
+
+	return r.resolve(ctx, locales, timezones)
+}
+
+// Default sets the instance's default language and timezone, for text which
+// isn't written for anyone in particular or for people without an account.
+func (r *Resolver) Default(ctx context.Context) (context.Context, error) {
+	return r.resolve(ctx, nil, nil)
+}
+
+// DefaultTimezone is the instance's default timezone, or UTC if it's not set.
+func (r *Resolver) DefaultTimezone(ctx context.Context) (*time.Location, error) {
+	s, err := r.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return defaultTimezone(s), nil
+}
+
+// ApplyHints fills in an account's language and timezone from the hints sent
+// with the current request, when the account hasn't chosen them already. This
+// is done when signing in, so emails are written in the member's language and
+// timezone without them having to find the settings first.
+func (r *Resolver) ApplyHints(ctx context.Context, id account.AccountID) error {
+	acc, err := r.accountQuerier.GetByID(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	opts := []account_writer.Mutation{}
+
+	if _, ok := acc.Timezone.Get(); !ok {
+		if loc, ok := i18n.LoadTimezone(reqinfo.GetTimezone(ctx)); ok {
+			opts = append(opts, account_writer.SetTimezone(opt.New(loc.String())))
+		}
+	}
+
+	if _, ok := acc.Locale.Get(); !ok {
+		if tag, ok := i18n.Match(reqinfo.GetAcceptLanguage(ctx)); ok {
+			opts = append(opts, account_writer.SetLocale(opt.New(tag.String())))
+		}
+	}
+
+	if len(opts) == 0 {
+		return nil
+	}
+
+	_, err = r.accountWriter.Update(ctx, id, opts...)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Resolver) resolve(ctx context.Context, locales []string, timezones []string) (context.Context, error) {
+	s, err := r.settings.Get(ctx)
+	if err != nil {
+		return ctx, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ctx = i18n.WithLocale(ctx, matchLocale(s, locales))
+	ctx = i18n.WithTimezone(ctx, matchTimezone(s, timezones))
+
+	return ctx, nil
+}
+
+func matchLocale(s *settings.Settings, preferences []string) language.Tag {
+	for _, p := range preferences {
+		if tag, ok := i18n.Match(p); ok {
+			return tag
+		}
+	}
+
+	tag, _ := i18n.Match(s.DefaultLocale.OrZero())
+
+	return tag
+}
+
+func matchTimezone(s *settings.Settings, preferences []string) *time.Location {
+	for _, p := range preferences {
+		if loc, ok := i18n.LoadTimezone(p); ok {
+			return loc
+		}
+	}
+
+	return defaultTimezone(s)
+}
+
+func defaultTimezone(s *settings.Settings) *time.Location {
+	if loc, ok := i18n.LoadTimezone(s.DefaultTimezone.OrZero()); ok {
+		return loc
+	}
+
+	return time.UTC
+}
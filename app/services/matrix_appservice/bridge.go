@@ -0,0 +1,110 @@
+// Package matrix_appservice runs a Matrix application service which announces
+// new Storyden threads in mapped categories to Matrix rooms and syncs replies
+// written in those announcements' Matrix threads back to Storyden, attributed
+// to the Storyden account that the Matrix user has linked.
+package matrix_appservice
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/matrix_bridge"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/account/link_code"
+	"github.com/Southclaws/storyden/app/services/reply"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(run),
+	)
+}
+
+var ErrDisabled = fault.New("the matrix bridge is not enabled", ftag.With(ftag.NotFound))
+
+type Bridge struct {
+	logger        *slog.Logger
+	address       url.URL
+	botUserID     string
+	hsToken       string
+	settings      *settings.SettingsRepository
+	threadQuerier *thread_querier.Querier
+	links         *matrix_bridge.Repository
+	authRepo      authentication.Repository
+	replyService  reply.Service
+	codes         link_code.Codes
+	client        homeserver
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	settings *settings.SettingsRepository,
+	threadQuerier *thread_querier.Querier,
+	links *matrix_bridge.Repository,
+	authRepo authentication.Repository,
+	replyService reply.Service,
+) *Bridge {
+	b := &Bridge{
+		logger:        logger,
+		address:       cfg.PublicWebAddress,
+		botUserID:     cfg.MatrixBotUserID,
+		hsToken:       cfg.MatrixHSToken,
+		settings:      settings,
+		threadQuerier: threadQuerier,
+		links:         links,
+		authRepo:      authRepo,
+		replyService:  replyService,
+		codes:         link_code.New(cfg.JWTSecret, "matrix_link:"),
+	}
+
+	// Link codes are signed with the JWT secret so the bridge can't run
+	// without one.
+	if cfg.MatrixHomeserverURL.Host != "" && cfg.MatrixHSToken != "" && len(cfg.JWTSecret) > 0 {
+		b.client = newClient(cfg.MatrixHomeserverURL, cfg.MatrixASToken)
+	}
+
+	return b
+}
+
+func (b *Bridge) Enabled() bool {
+	return b.client != nil
+}
+
+// HSToken is the token the homeserver authenticates itself with when pushing
+// transactions to the application service.
+func (b *Bridge) HSToken() string {
+	return b.hsToken
+}
+
+func (b *Bridge) BotUserID() string {
+	return b.botUserID
+}
+
+func run(
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	b *Bridge,
+) {
+	if !b.Enabled() {
+		return
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.Subscribe(hctx, bus, "matrix_bridge.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			return b.onThreadPublished(ctx, evt.ID)
+		})
+		return err
+	}))
+}
@@ -0,0 +1,102 @@
+package matrix_appservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+// homeserver is the subset of the Matrix client-server API used by the bridge.
+type homeserver interface {
+	SendMessage(ctx context.Context, roomID string, txnID string, content any) (string, error)
+	JoinRoom(ctx context.Context, roomID string) error
+}
+
+// client calls the homeserver as the application service's bot using the
+// registration's as_token.
+type client struct {
+	http    *http.Client
+	address url.URL
+	token   string
+}
+
+func newClient(address url.URL, token string) *client {
+	return &client{
+		http:    &http.Client{Timeout: 30 * time.Second, Transport: outbound.Transport(nil)},
+		address: address,
+		token:   token,
+	}
+}
+
+func (c *client) SendMessage(ctx context.Context, roomID string, txnID string, content any) (string, error) {
+	var res struct {
+		EventID string `json:"event_id"`
+	}
+
+	// The transaction ID makes retries of the same message idempotent.
+	u := c.address.JoinPath("_matrix/client/v3/rooms", roomID, "send/m.room.message", txnID)
+	if err := c.do(ctx, http.MethodPut, u, content, &res); err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return res.EventID, nil
+}
+
+func (c *client) JoinRoom(ctx context.Context, roomID string) error {
+	u := c.address.JoinPath("_matrix/client/v3/rooms", roomID, "join")
+	if err := c.do(ctx, http.MethodPost, u, struct{}{}, nil); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (c *client) do(ctx context.Context, method string, u *url.URL, body any, out any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		var merr matrixError
+		_ = json.NewDecoder(res.Body).Decode(&merr)
+		return fault.Wrap(fmt.Errorf("homeserver responded %d %s: %s", res.StatusCode, merr.ErrCode, merr.Error), fctx.With(ctx))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+type matrixError struct {
+	ErrCode string `json:"errcode"`
+	Error   string `json:"error"`
+}
@@ -0,0 +1,112 @@
+package matrix_appservice
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Transaction is a batch of events pushed to the application service by the
+// homeserver.
+type Transaction struct {
+	Events []Event `json:"events"`
+}
+
+type Event struct {
+	Type     string          `json:"type"`
+	EventID  string          `json:"event_id"`
+	RoomID   string          `json:"room_id"`
+	Sender   string          `json:"sender"`
+	StateKey *string         `json:"state_key,omitempty"`
+	Content  json.RawMessage `json:"content"`
+}
+
+type messageContent struct {
+	MsgType       string     `json:"msgtype"`
+	Body          string     `json:"body"`
+	Format        string     `json:"format,omitempty"`
+	FormattedBody string     `json:"formatted_body,omitempty"`
+	RelatesTo     *relatesTo `json:"m.relates_to,omitempty"`
+}
+
+type relatesTo struct {
+	RelType       string     `json:"rel_type,omitempty"`
+	EventID       string     `json:"event_id,omitempty"`
+	IsFallingBack bool       `json:"is_falling_back,omitempty"`
+	InReplyTo     *inReplyTo `json:"m.in_reply_to,omitempty"`
+}
+
+type inReplyTo struct {
+	EventID string `json:"event_id"`
+}
+
+type memberContent struct {
+	Membership string `json:"membership"`
+}
+
+// inboundMessage is the part of a Matrix message needed to sync it.
+type inboundMessage struct {
+	ID           string
+	RoomID       string
+	Sender       string
+	Body         string
+	ThreadRootID string
+	ReplyToID    string
+}
+
+// newInboundMessage returns false for messages which can't be synced, such as
+// edits, reactions and media.
+func newInboundMessage(e Event) (inboundMessage, bool) {
+	var c messageContent
+	if err := json.Unmarshal(e.Content, &c); err != nil {
+		return inboundMessage{}, false
+	}
+
+	if c.MsgType != "m.text" && c.MsgType != "m.emote" {
+		return inboundMessage{}, false
+	}
+
+	m := inboundMessage{
+		ID:     e.EventID,
+		RoomID: e.RoomID,
+		Sender: e.Sender,
+		Body:   strings.TrimSpace(c.Body),
+	}
+
+	if r := c.RelatesTo; r != nil {
+		if r.InReplyTo != nil {
+			m.Body = stripReplyFallback(c.Body)
+		}
+
+		switch r.RelType {
+		case "":
+		case "m.thread":
+			m.ThreadRootID = r.EventID
+		default:
+			return inboundMessage{}, false
+		}
+
+		// Clients set a fallback reply to the thread's latest message for
+		// clients which don't support threads, which isn't a real reply.
+		if r.InReplyTo != nil && !r.IsFallingBack {
+			m.ReplyToID = r.InReplyTo.EventID
+		}
+	}
+
+	return m, true
+}
+
+// stripReplyFallback removes the quote of the replied-to message that older
+// clients prepend to the body of a reply.
+func stripReplyFallback(body string) string {
+	lines := strings.Split(body, "\n")
+
+	i := 0
+	for i < len(lines) && strings.HasPrefix(lines[i], ">") {
+		i++
+	}
+	if i > 0 && i < len(lines) && lines[i] == "" {
+		i++
+	}
+
+	return strings.TrimSpace(strings.Join(lines[i:], "\n"))
+}
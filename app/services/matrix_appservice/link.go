@@ -0,0 +1,136 @@
+package matrix_appservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+)
+
+// Members link their Matrix account by sending this command followed by a
+// code from LinkCode in any room the bot is in.
+const linkCommand = "!link"
+
+// LinkCode issues a code the member sends to the bridge's bot to prove which
+// Storyden account their Matrix account belongs to.
+func (b *Bridge) LinkCode(ctx context.Context, accountID account.AccountID) (string, time.Time, error) {
+	if !b.Enabled() {
+		return "", time.Time{}, fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	code, expires := b.codes.Issue(accountID, time.Now())
+
+	return code, expires, nil
+}
+
+// Linked returns the Matrix user ID linked to the account, if any.
+func (b *Bridge) Linked(ctx context.Context, accountID account.AccountID) (opt.Optional[string], error) {
+	if !b.Enabled() {
+		return nil, fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	auth, err := b.linked(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.Map(auth, func(a authentication.Authentication) string { return a.Identifier }), nil
+}
+
+func (b *Bridge) Unlink(ctx context.Context, accountID account.AccountID) error {
+	if !b.Enabled() {
+		return fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	auth, err := b.linked(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if a, ok := auth.Get(); ok {
+		if _, err := b.authRepo.Delete(ctx, accountID, a.Identifier, authentication.ServiceMatrix); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (b *Bridge) linked(ctx context.Context, accountID account.AccountID) (opt.Optional[authentication.Authentication], error) {
+	auths, err := b.authRepo.GetAuthMethods(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	matrix, ok := lo.Find(auths, func(a *authentication.Authentication) bool {
+		return a.Service == authentication.ServiceMatrix
+	})
+	if !ok {
+		return opt.NewEmpty[authentication.Authentication](), nil
+	}
+
+	return opt.New(*matrix), nil
+}
+
+func (b *Bridge) onLinkCommand(ctx context.Context, m inboundMessage, code string) error {
+	accountID, issued, err := b.codes.Decode(code, time.Now())
+	if err != nil {
+		return b.notice(ctx, m, "That link code is invalid or has expired, get a new one from your account settings.")
+	}
+
+	existing, found, err := b.authRepo.LookupByIdentifier(ctx, authentication.ServiceMatrix, m.Sender)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if found {
+		if existing.Account.ID == accountID {
+			return b.notice(ctx, m, "Your Matrix account is already linked to @"+existing.Account.Handle+".")
+		}
+		return b.notice(ctx, m, "Your Matrix account is already linked to another account, unlink it from that account's settings first.")
+	}
+
+	current, err := b.linked(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if c, ok := current.Get(); ok {
+		// Codes may be sent in a room others can read, so each can only be
+		// used once.
+		if !c.Created.Before(issued) {
+			return b.notice(ctx, m, "That link code has already been used, get a new one from your account settings.")
+		}
+
+		if _, err := b.authRepo.Delete(ctx, accountID, c.Identifier, authentication.ServiceMatrix); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	// There's no secret involved, the token is only set as it's required.
+	auth, err := b.authRepo.Create(ctx, accountID, authentication.ServiceMatrix, authentication.TokenTypeNone, m.Sender, xid.New().String(), nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b.notice(ctx, m, "Your Matrix account is now linked to @"+auth.Account.Handle+", your replies to threads will be posted from that account.")
+}
+
+// notice replies to a member's message with a message from the bot.
+func (b *Bridge) notice(ctx context.Context, m inboundMessage, body string) error {
+	_, err := b.client.SendMessage(ctx, m.RoomID, xid.New().String(), messageContent{
+		MsgType:   "m.notice",
+		Body:      body,
+		RelatesTo: &relatesTo{InReplyTo: &inReplyTo{EventID: m.ID}},
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package matrix_appservice
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"log/slog"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/matrix_bridge"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/services/reply"
+)
+
+// Announcements only include the start of the thread, the rest is a click
+// away.
+const maxContentLength = 1000
+
+func (b *Bridge) onThreadPublished(ctx context.Context, id post.ID) error {
+	if _, err := b.links.GetByPost(ctx, id); err == nil {
+		return nil
+	} else if ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := b.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thr, err := b.threadQuerier.Get(ctx, id, pagination.Parameters{}, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	cat := opt.Map(thr.Category, func(c category.Category) category.CategoryID { return c.ID })
+	roomID, ok := s.MatrixBridge.OrZero().RoomFor(cat)
+	if !ok {
+		return nil
+	}
+
+	link := b.address.JoinPath("t", mark.NewMark(xid.ID(thr.ID), thr.Slug).String()).String()
+
+	eventID, err := b.client.SendMessage(ctx, roomID, thr.ID.String(),
+		formatThread(thr.Title, thr.Author.Name, thr.Content.Plaintext(), link))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = b.links.Create(ctx, matrix_bridge.Link{
+		PostID:   thr.ID,
+		ThreadID: thr.ID,
+		RoomID:   roomID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// HandleTransaction processes the events pushed by the homeserver. Events
+// which fail are logged and skipped, otherwise the homeserver would retry the
+// whole transaction indefinitely.
+func (b *Bridge) HandleTransaction(ctx context.Context, txn Transaction) {
+	for _, e := range txn.Events {
+		if err := b.onEvent(ctx, e); err != nil {
+			b.logger.Error("failed to sync matrix event",
+				slog.String("room_id", e.RoomID),
+				slog.String("event_id", e.EventID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+func (b *Bridge) onEvent(ctx context.Context, e Event) error {
+	if e.Sender == b.botUserID {
+		return nil
+	}
+
+	switch e.Type {
+	case "m.room.member":
+		// Accept invites so admins can add the bot to the rooms they map and
+		// members can message it directly to link their account.
+		var c memberContent
+		if err := json.Unmarshal(e.Content, &c); err != nil {
+			return nil
+		}
+		if e.StateKey == nil || *e.StateKey != b.botUserID || c.Membership != "invite" {
+			return nil
+		}
+
+		return b.client.JoinRoom(ctx, e.RoomID)
+
+	case "m.room.message":
+		m, ok := newInboundMessage(e)
+		if !ok || m.Body == "" {
+			return nil
+		}
+
+		if code, ok := strings.CutPrefix(m.Body, linkCommand); ok {
+			return b.onLinkCommand(ctx, m, code)
+		}
+
+		return b.onMessage(ctx, m)
+	}
+
+	return nil
+}
+
+func (b *Bridge) onMessage(ctx context.Context, m inboundMessage) error {
+	rootID := m.ThreadRootID
+	if rootID == "" {
+		rootID = m.ReplyToID
+	}
+	if rootID == "" {
+		return nil
+	}
+
+	root, err := b.links.GetByEvent(ctx, rootID)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if root.RoomID != m.RoomID {
+		return nil
+	}
+
+	if _, err := b.links.GetByEvent(ctx, m.ID); err == nil {
+		return nil
+	} else if ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	auth, linked, err := b.authRepo.LookupByIdentifier(ctx, authentication.ServiceMatrix, m.Sender)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !linked || auth.Disabled || auth.Account.DeletedAt.Ok() {
+		b.logger.Debug("skipping matrix message from unlinked sender",
+			slog.String("event_id", m.ID),
+			slog.String("sender", m.Sender),
+		)
+		return nil
+	}
+
+	content, err := datagraph.NewRichTextFromMarkdown(m.Body)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	replyTo := opt.NewEmpty[post.ID]()
+	if m.ReplyToID != "" && m.ReplyToID != root.EventID {
+		if parent, err := b.links.GetByEvent(ctx, m.ReplyToID); err == nil && parent.ThreadID == root.ThreadID {
+			replyTo = opt.New(parent.PostID)
+		}
+	}
+
+	r, err := b.replyService.Create(ctx, auth.Account.ID, root.ThreadID, reply.Partial{
+		Content: opt.New(content),
+		ReplyTo: replyTo,
+		Meta:    opt.New(map[string]any{"matrix_event_id": m.ID}),
+	})
+	if err != nil {
+		if ftag.Get(err) == ftag.PermissionDenied {
+			b.logger.Debug("skipping matrix message from sender who can't reply",
+				slog.String("event_id", m.ID),
+				slog.String("sender", m.Sender),
+			)
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = b.links.Create(ctx, matrix_bridge.Link{
+		PostID:   r.ID,
+		ThreadID: root.ThreadID,
+		RoomID:   m.RoomID,
+		EventID:  m.ID,
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func formatThread(title string, author string, body string, link string) messageContent {
+	body = truncate(strings.TrimSpace(body), maxContentLength)
+
+	return messageContent{
+		MsgType: "m.notice",
+		Body:    title + "\n\n" + body + "\n\n— " + author + " on " + link,
+		Format:  "org.matrix.custom.html",
+		FormattedBody: "<strong>" + html.EscapeString(title) + "</strong>" +
+			"<p>" + html.EscapeString(body) + "</p>" +
+			"<p>— " + html.EscapeString(author) + " on <a href=\"" + html.EscapeString(link) + "\">" + html.EscapeString(link) + "</a></p>",
+	}
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
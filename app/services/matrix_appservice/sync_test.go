@@ -0,0 +1,72 @@
+package matrix_appservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInboundMessage(t *testing.T) {
+	t.Run("thread_reply", func(t *testing.T) {
+		a := assert.New(t)
+
+		m, ok := newInboundMessage(Event{
+			Type:    "m.room.message",
+			EventID: "$2",
+			RoomID:  "!1:example.com",
+			Sender:  "@odin:example.com",
+			Content: []byte(`{"msgtype":"m.text","body":"> <@loki:example.com> hi\n\nhello","m.relates_to":{"rel_type":"m.thread","event_id":"$1","m.in_reply_to":{"event_id":"$3"}}}`),
+		})
+		a.True(ok)
+		a.Equal("$2", m.ID)
+		a.Equal("!1:example.com", m.RoomID)
+		a.Equal("@odin:example.com", m.Sender)
+		a.Equal("hello", m.Body)
+		a.Equal("$1", m.ThreadRootID)
+		a.Equal("$3", m.ReplyToID)
+	})
+
+	t.Run("falling_back", func(t *testing.T) {
+		m, ok := newInboundMessage(Event{
+			Content: []byte(`{"msgtype":"m.text","body":"hello","m.relates_to":{"rel_type":"m.thread","event_id":"$1","is_falling_back":true,"m.in_reply_to":{"event_id":"$3"}}}`),
+		})
+		assert.True(t, ok)
+		assert.Empty(t, m.ReplyToID)
+	})
+
+	t.Run("quote_without_reply", func(t *testing.T) {
+		m, ok := newInboundMessage(Event{
+			Content: []byte(`{"msgtype":"m.text","body":"> quoted\n\nhello"}`),
+		})
+		assert.True(t, ok)
+		assert.Equal(t, "> quoted\n\nhello", m.Body)
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		_, ok := newInboundMessage(Event{
+			Content: []byte(`{"msgtype":"m.text","body":"* hello","m.relates_to":{"rel_type":"m.replace","event_id":"$1"}}`),
+		})
+		assert.False(t, ok)
+	})
+
+	t.Run("image", func(t *testing.T) {
+		_, ok := newInboundMessage(Event{
+			Content: []byte(`{"msgtype":"m.image","body":"cat.png"}`),
+		})
+		assert.False(t, ok)
+	})
+}
+
+func TestFormatThread(t *testing.T) {
+	a := assert.New(t)
+
+	c := formatThread("Hello <world>", "Odin", "hello", "https://example.com/t/hello")
+	a.Equal("m.notice", c.MsgType)
+	a.Equal("Hello <world>\n\nhello\n\n— Odin on https://example.com/t/hello", c.Body)
+	a.Contains(c.FormattedBody, "<strong>Hello &lt;world&gt;</strong>")
+	a.Contains(c.FormattedBody, `<a href="https://example.com/t/hello">`)
+
+	long := formatThread("Hello", "Odin", strings.Repeat("a", 5000), "https://example.com/t/hello")
+	a.Less(len([]rune(long.Body)), maxContentLength+100)
+}
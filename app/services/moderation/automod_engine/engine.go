@@ -0,0 +1,238 @@
+// Package automod_engine evaluates the administrator configured automod rules
+// against new posts and reports the strictest action of the rules which match.
+package automod_engine
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/automod"
+	"github.com/Southclaws/storyden/app/resources/automod/automod_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var ErrRejected = fault.New("content rejected by automod rule", ftag.With(ftag.InvalidArgument))
+
+// Verdict is the outcome of evaluating a post. When no rules match, Action is
+// empty and the post is created as normal.
+type Verdict struct {
+	Action opt.Optional[automod.Action]
+	Rules  automod.Rules
+}
+
+// Hidden reports whether the post must be kept out of public view.
+func (v *Verdict) Hidden() bool {
+	a, ok := v.Action.Get()
+	return ok && (a == automod.ActionHold || a == automod.ActionHide)
+}
+
+// Subject is what a rule is evaluated against.
+type Subject struct {
+	Author  *account.AccountWithEdges
+	Content datagraph.Content
+	// Posts is the number of posts the author made within the rule's window.
+	Posts func(window time.Duration) (int, error)
+}
+
+type Engine struct {
+	accountQuerier *account_querier.Querier
+	ruleQuerier    *automod_querier.Querier
+	bus            *pubsub.Bus
+}
+
+func New(
+	accountQuerier *account_querier.Querier,
+	ruleQuerier *automod_querier.Querier,
+	bus *pubsub.Bus,
+) *Engine {
+	return &Engine{
+		accountQuerier: accountQuerier,
+		ruleQuerier:    ruleQuerier,
+		bus:            bus,
+	}
+}
+
+// Evaluate checks a post about to be written by the given author against every
+// enabled rule. Members who can manage posts are exempt. If the verdict is to
+// reject the post, an error describing the matching rule's reason is returned.
+func (e *Engine) Evaluate(ctx context.Context, authorID account.AccountID, content datagraph.Content) (*Verdict, error) {
+	rules, err := e.ruleQuerier.ListEnabled(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(rules) == 0 {
+		return &Verdict{}, nil
+	}
+
+	acc, err := e.accountQuerier.GetByID(ctx, authorID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if acc.Roles.Permissions().HasAny(rbac.PermissionAdministrator, rbac.PermissionManagePosts) {
+		return &Verdict{}, nil
+	}
+
+	subject := Subject{
+		Author:  acc,
+		Content: content,
+		Posts: func(window time.Duration) (int, error) {
+			return e.ruleQuerier.CountPostsSince(ctx, authorID, time.Now().Add(-window))
+		},
+	}
+
+	verdict, err := Judge(rules, subject)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if a, ok := verdict.Action.Get(); ok && a == automod.ActionReject {
+		message := "Your post was rejected by an automated moderation rule."
+		for _, r := range verdict.Rules {
+			if r.Action == automod.ActionReject && r.Reason.Ok() {
+				message = r.Reason.OrZero()
+				break
+			}
+		}
+
+		return nil, fault.Wrap(ErrRejected,
+			fctx.With(ctx),
+			fmsg.WithDesc("rejected", message))
+	}
+
+	return verdict, nil
+}
+
+// Record publishes the outcome of a verdict once the post it was evaluated for
+// has been written so that moderators can be notified.
+func (e *Engine) Record(ctx context.Context, target datagraph.Item, authorID account.AccountID, verdict *Verdict) {
+	action, ok := verdict.Action.Get()
+	if !ok {
+		return
+	}
+
+	e.bus.Publish(ctx, &message.EventAutomodTriggered{
+		Target:   *datagraph.NewRef(target),
+		AuthorID: authorID,
+		Action:   action,
+		Rules:    dt.Map(verdict.Rules, func(r *automod.Rule) automod.RuleID { return r.ID }),
+	})
+}
+
+// Judge evaluates rules against a subject without any side effects.
+func Judge(rules automod.Rules, s Subject) (*Verdict, error) {
+	v := &Verdict{}
+
+	for _, r := range rules {
+		matched, err := Match(r, s)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+
+		v.Rules = append(v.Rules, r)
+
+		if current, ok := v.Action.Get(); !ok || r.Action.Severity() > current.Severity() {
+			v.Action = opt.New(r.Action)
+		}
+	}
+
+	return v, nil
+}
+
+func Match(r *automod.Rule, s Subject) (bool, error) {
+	switch r.Kind {
+	case automod.KindPattern:
+		pattern, ok := r.Pattern.Get()
+		if !ok {
+			return false, nil
+		}
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fault.Wrap(err)
+		}
+
+		return re.MatchString(s.Content.Plaintext()), nil
+
+	case automod.KindWords:
+		re := WordsPattern(r.Words)
+		if re == nil {
+			return false, nil
+		}
+
+		return re.MatchString(s.Content.Plaintext()), nil
+
+	case automod.KindLinkCount:
+		threshold, ok := r.Threshold.Get()
+		if !ok {
+			return false, nil
+		}
+
+		return len(s.Content.Links()) > threshold, nil
+
+	case automod.KindAccountAge:
+		window, ok := r.Window.Get()
+		if !ok || s.Author == nil {
+			return false, nil
+		}
+
+		return time.Since(s.Author.CreatedAt) < window, nil
+
+	case automod.KindVelocity:
+		threshold, ok := r.Threshold.Get()
+		if !ok {
+			return false, nil
+		}
+		window, ok := r.Window.Get()
+		if !ok || s.Posts == nil {
+			return false, nil
+		}
+
+		n, err := s.Posts(window)
+		if err != nil {
+			return false, err
+		}
+
+		// The post being evaluated hasn't been written yet so it's counted here.
+		return n+1 > threshold, nil
+	}
+
+	return false, nil
+}
+
+// WordsPattern builds a case insensitive expression which matches any of the
+// words or phrases on word boundaries, or nil if there are none.
+func WordsPattern(words []string) *regexp.Regexp {
+	quoted := []string{}
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		quoted = append(quoted, regexp.QuoteMeta(w))
+	}
+
+	if len(quoted) == 0 {
+		return nil
+	}
+
+	return regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+}
@@ -0,0 +1,135 @@
+package automod_engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/automod"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+func content(t *testing.T, s string) datagraph.Content {
+	c, err := datagraph.NewRichText(s)
+	require.NoError(t, err)
+	return c
+}
+
+func author(age time.Duration) *account.AccountWithEdges {
+	return &account.AccountWithEdges{Account: account.Account{CreatedAt: time.Now().Add(-age)}}
+}
+
+func posts(n int) func(time.Duration) (int, error) {
+	return func(time.Duration) (int, error) { return n, nil }
+}
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		name    string
+		rule    automod.Rule
+		subject Subject
+		want    bool
+	}{
+		{
+			name:    "pattern_match",
+			rule:    automod.Rule{Kind: automod.KindPattern, Pattern: opt.New(`(?i)buy\s+now`)},
+			subject: Subject{Content: content(t, "<p>BUY   now!</p>")},
+			want:    true,
+		},
+		{
+			name:    "pattern_no_match",
+			rule:    automod.Rule{Kind: automod.KindPattern, Pattern: opt.New(`(?i)buy\s+now`)},
+			subject: Subject{Content: content(t, "<p>buying nothing</p>")},
+			want:    false,
+		},
+		{
+			name:    "words_whole_word",
+			rule:    automod.Rule{Kind: automod.KindWords, Words: []string{"heck", "dang it"}},
+			subject: Subject{Content: content(t, "<p>well Dang It all</p>")},
+			want:    true,
+		},
+		{
+			name:    "words_substring",
+			rule:    automod.Rule{Kind: automod.KindWords, Words: []string{"heck"}},
+			subject: Subject{Content: content(t, "<p>checked</p>")},
+			want:    false,
+		},
+		{
+			name:    "words_empty",
+			rule:    automod.Rule{Kind: automod.KindWords, Words: []string{" "}},
+			subject: Subject{Content: content(t, "<p>anything</p>")},
+			want:    false,
+		},
+		{
+			name:    "link_count_over",
+			rule:    automod.Rule{Kind: automod.KindLinkCount, Threshold: opt.New(1)},
+			subject: Subject{Content: content(t, `<p><a href="https://a.com">a</a> <a href="https://b.com">b</a></p>`)},
+			want:    true,
+		},
+		{
+			name:    "link_count_at",
+			rule:    automod.Rule{Kind: automod.KindLinkCount, Threshold: opt.New(1)},
+			subject: Subject{Content: content(t, `<p><a href="https://a.com">a</a></p>`)},
+			want:    false,
+		},
+		{
+			name:    "account_age_new",
+			rule:    automod.Rule{Kind: automod.KindAccountAge, Window: opt.New(24 * time.Hour)},
+			subject: Subject{Author: author(time.Hour)},
+			want:    true,
+		},
+		{
+			name:    "account_age_old",
+			rule:    automod.Rule{Kind: automod.KindAccountAge, Window: opt.New(24 * time.Hour)},
+			subject: Subject{Author: author(48 * time.Hour)},
+			want:    false,
+		},
+		{
+			name:    "velocity_over",
+			rule:    automod.Rule{Kind: automod.KindVelocity, Threshold: opt.New(3), Window: opt.New(time.Minute)},
+			subject: Subject{Posts: posts(3)},
+			want:    true,
+		},
+		{
+			name:    "velocity_under",
+			rule:    automod.Rule{Kind: automod.KindVelocity, Threshold: opt.New(3), Window: opt.New(time.Minute)},
+			subject: Subject{Posts: posts(2)},
+			want:    false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Match(&c.rule, c.subject)
+			require.NoError(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestJudge(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	rules := automod.Rules{
+		{Kind: automod.KindWords, Words: []string{"spam"}, Action: automod.ActionFlag},
+		{Kind: automod.KindWords, Words: []string{"spam"}, Action: automod.ActionHold},
+		{Kind: automod.KindWords, Words: []string{"other"}, Action: automod.ActionReject},
+		{Kind: automod.KindLinkCount, Threshold: opt.New(0), Action: automod.ActionHide},
+	}
+
+	v, err := Judge(rules, Subject{Content: content(t, "<p>spam</p>")})
+	r.NoError(err)
+	a.Equal(automod.ActionHold, v.Action.OrZero())
+	a.Len(v.Rules, 2)
+	a.True(v.Hidden())
+
+	v, err = Judge(rules, Subject{Content: content(t, "<p>fine</p>")})
+	r.NoError(err)
+	a.False(v.Action.Ok())
+	a.False(v.Hidden())
+}
@@ -0,0 +1,165 @@
+package automod_manager
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/automod"
+	"github.com/Southclaws/storyden/app/resources/automod/automod_querier"
+	"github.com/Southclaws/storyden/app/resources/automod/automod_writer"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_engine"
+)
+
+var ErrInvalidRule = fault.New("invalid automod rule", ftag.With(ftag.InvalidArgument))
+
+type Manager struct {
+	ruleQuerier *automod_querier.Querier
+	ruleWriter  *automod_writer.Writer
+}
+
+func New(
+	ruleQuerier *automod_querier.Querier,
+	ruleWriter *automod_writer.Writer,
+) *Manager {
+	return &Manager{
+		ruleQuerier: ruleQuerier,
+		ruleWriter:  ruleWriter,
+	}
+}
+
+type Partial struct {
+	Name      opt.Optional[string]
+	Kind      opt.Optional[automod.Kind]
+	Action    opt.Optional[automod.Action]
+	Pattern   opt.Optional[string]
+	Words     opt.Optional[[]string]
+	Threshold opt.Optional[int]
+	Window    opt.Optional[time.Duration]
+	Reason    opt.Optional[string]
+	Enabled   opt.Optional[bool]
+}
+
+func (p Partial) Opts() (opts []automod_writer.Option) {
+	p.Name.Call(func(v string) { opts = append(opts, automod_writer.WithName(v)) })
+	p.Kind.Call(func(v automod.Kind) { opts = append(opts, automod_writer.WithKind(v)) })
+	p.Action.Call(func(v automod.Action) { opts = append(opts, automod_writer.WithAction(v)) })
+	p.Pattern.Call(func(v string) { opts = append(opts, automod_writer.WithPattern(v)) })
+	p.Words.Call(func(v []string) { opts = append(opts, automod_writer.WithWords(v)) })
+	p.Threshold.Call(func(v int) { opts = append(opts, automod_writer.WithThreshold(v)) })
+	p.Window.Call(func(v time.Duration) { opts = append(opts, automod_writer.WithWindow(v)) })
+	p.Reason.Call(func(v string) { opts = append(opts, automod_writer.WithReason(v)) })
+	p.Enabled.Call(func(v bool) { opts = append(opts, automod_writer.WithEnabled(v)) })
+	return
+}
+
+func (p Partial) apply(r automod.Rule) *automod.Rule {
+	p.Name.Call(func(v string) { r.Name = v })
+	p.Kind.Call(func(v automod.Kind) { r.Kind = v })
+	p.Action.Call(func(v automod.Action) { r.Action = v })
+	p.Pattern.Call(func(v string) { r.Pattern = opt.NewSafe(v, v != "") })
+	p.Words.Call(func(v []string) { r.Words = v })
+	p.Threshold.Call(func(v int) { r.Threshold = opt.New(v) })
+	p.Window.Call(func(v time.Duration) { r.Window = opt.New(v) })
+	return &r
+}
+
+func (m *Manager) List(ctx context.Context) (automod.Rules, error) {
+	return m.ruleQuerier.List(ctx)
+}
+
+func (m *Manager) Get(ctx context.Context, id automod.RuleID) (*automod.Rule, error) {
+	return m.ruleQuerier.Get(ctx, id)
+}
+
+func (m *Manager) Create(ctx context.Context, name string, kind automod.Kind, action automod.Action, p Partial) (*automod.Rule, error) {
+	p.Name = opt.NewEmpty[string]()
+	p.Kind = opt.NewEmpty[automod.Kind]()
+	p.Action = opt.NewEmpty[automod.Action]()
+
+	if err := validate(p.apply(automod.Rule{Name: name, Kind: kind, Action: action})); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := m.ruleWriter.Create(ctx, name, kind, action, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+func (m *Manager) Update(ctx context.Context, id automod.RuleID, p Partial) (*automod.Rule, error) {
+	current, err := m.ruleQuerier.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := validate(p.apply(*current)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := m.ruleWriter.Update(ctx, id, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, id automod.RuleID) error {
+	if err := m.ruleWriter.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func validate(r *automod.Rule) error {
+	invalid := func(message string) error {
+		return fault.Wrap(ErrInvalidRule, fmsg.WithDesc("invalid rule", message))
+	}
+
+	if r.Name == "" {
+		return invalid("Rules must have a name.")
+	}
+
+	switch r.Kind {
+	case automod.KindPattern:
+		pattern, ok := r.Pattern.Get()
+		if !ok {
+			return invalid("Pattern rules require a pattern.")
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return invalid("The pattern is not a valid regular expression: " + err.Error())
+		}
+
+	case automod.KindWords:
+		if automod_engine.WordsPattern(r.Words) == nil {
+			return invalid("Word list rules require at least one word.")
+		}
+
+	case automod.KindLinkCount:
+		if !r.Threshold.Ok() || r.Threshold.OrZero() < 0 {
+			return invalid("Link count rules require a threshold of zero or more.")
+		}
+
+	case automod.KindAccountAge:
+		if r.Window.OrZero() <= 0 {
+			return invalid("Account age rules require a window.")
+		}
+
+	case automod.KindVelocity:
+		if r.Threshold.OrZero() < 1 || r.Window.OrZero() <= 0 {
+			return invalid("Velocity rules require a threshold of at least one and a window.")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package automod_notify
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(func(
+		ctx context.Context,
+		lc fx.Lifecycle,
+		bus *pubsub.Bus,
+		notifier *notify.Notifier,
+		accountQuerier *account_querier.Querier,
+	) {
+		consumer := func(hctx context.Context) error {
+			// Automod rule matched a new post
+			// Notify members who handle reports, the author is never told.
+			if _, err := pubsub.Subscribe(hctx, bus, "automod_notify.automod_triggered", func(ctx context.Context, evt *message.EventAutomodTriggered) error {
+				return sendTriggered(ctx, notifier, accountQuerier, evt)
+			}); err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		lc.Append(fx.StartHook(consumer))
+	})
+}
+
+func sendTriggered(
+	ctx context.Context,
+	notifier *notify.Notifier,
+	accountQuerier *account_querier.Querier,
+	evt *message.EventAutomodTriggered,
+) error {
+	accs, err := accountQuerier.ListByHeldPermission(ctx, rbac.PermissionAdministrator, rbac.PermissionManageReports)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, acc := range accs {
+		if err := notifier.Send(ctx, acc.ID, opt.NewEmpty[account.AccountID](), notification.EventReportSubmitted, &evt.Target); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
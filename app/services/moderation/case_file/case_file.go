@@ -0,0 +1,186 @@
+// Package case_file manages the private record moderators keep about members:
+// notes on the member or their content and a history of actions taken.
+package case_file
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/moderation_note"
+	"github.com/Southclaws/storyden/app/resources/moderation_note/moderation_note_querier"
+	"github.com/Southclaws/storyden/app/resources/moderation_note/moderation_note_writer"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var (
+	ErrEmptyNote   = fault.New("note content is empty", ftag.With(ftag.InvalidArgument))
+	ErrNotEditable = fault.New("case file entry is not a note", ftag.With(ftag.PermissionDenied))
+	ErrNotAuthor   = fault.New("not the author of the note", ftag.With(ftag.PermissionDenied))
+)
+
+type Manager struct {
+	noteQuerier *moderation_note_querier.Querier
+	noteWriter  *moderation_note_writer.Writer
+}
+
+func New(
+	noteQuerier *moderation_note_querier.Querier,
+	noteWriter *moderation_note_writer.Writer,
+) *Manager {
+	return &Manager{
+		noteQuerier: noteQuerier,
+		noteWriter:  noteWriter,
+	}
+}
+
+func (m *Manager) Get(ctx context.Context, accountID account.AccountID) (moderation_note.Notes, error) {
+	notes, err := m.noteQuerier.ListForAccount(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return notes, nil
+}
+
+func (m *Manager) ListNotes(ctx context.Context, targetID xid.ID) (moderation_note.Notes, error) {
+	notes, err := m.noteQuerier.ListForTarget(ctx, targetID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return notes, nil
+}
+
+// AddNote attaches a note to a member or a piece of content, notes on content
+// are filed in the case file of the content's author.
+func (m *Manager) AddNote(ctx context.Context, target datagraph.Ref, content string) (*moderation_note.Note, error) {
+	authorID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	content, err = validateContent(content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	subject, err := m.noteQuerier.Subject(ctx, target)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	note, err := m.noteWriter.Create(ctx, subject, target, moderation_note.KindNote,
+		moderation_note_writer.WithAuthor(authorID),
+		moderation_note_writer.WithContent(content),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return note, nil
+}
+
+// Record adds an entry for an action taken against a member, attributed to
+// the member who took it if there is one.
+func (m *Manager) Record(ctx context.Context, accountID account.AccountID, kind moderation_note.Kind, content opt.Optional[string]) (*moderation_note.Note, error) {
+	opts := []moderation_note_writer.Option{}
+
+	session.GetOptAccountID(ctx).Call(func(id account.AccountID) {
+		opts = append(opts, moderation_note_writer.WithAuthor(id))
+	})
+	content.Call(func(s string) {
+		opts = append(opts, moderation_note_writer.WithContent(s))
+	})
+
+	target := datagraph.Ref{ID: xid.ID(accountID), Kind: datagraph.KindProfile}
+
+	note, err := m.noteWriter.Create(ctx, accountID, target, kind, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return note, nil
+}
+
+func (m *Manager) UpdateNote(ctx context.Context, id moderation_note.NoteID, content string) (*moderation_note.Note, error) {
+	if _, err := m.authorise(ctx, id, false); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	content, err := validateContent(content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	note, err := m.noteWriter.Update(ctx, id, moderation_note_writer.WithContent(content))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return note, nil
+}
+
+func (m *Manager) DeleteNote(ctx context.Context, id moderation_note.NoteID) error {
+	if _, err := m.authorise(ctx, id, true); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.noteWriter.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// authorise only allows notes to be changed by their author, administrators
+// may also delete other moderators' notes.
+func (m *Manager) authorise(ctx context.Context, id moderation_note.NoteID, deleting bool) (*moderation_note.Note, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	note, err := m.noteQuerier.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !note.Kind.Editable() {
+		return nil, fault.Wrap(ErrNotEditable,
+			fctx.With(ctx),
+			fmsg.WithDesc("not editable", "Records of moderation actions cannot be changed."),
+		)
+	}
+
+	isAuthor := opt.Map(note.Author, func(a account.Account) bool { return a.ID == accountID }).OrZero()
+	if isAuthor {
+		return note, nil
+	}
+
+	if deleting && session.GetRoles(ctx).Permissions().HasAny(rbac.PermissionAdministrator) {
+		return note, nil
+	}
+
+	return nil, fault.Wrap(ErrNotAuthor,
+		fctx.With(ctx),
+		fmsg.WithDesc("not author", "Only the moderator who wrote a note can change it."),
+	)
+}
+
+func validateContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", fault.Wrap(ErrEmptyNote, fmsg.WithDesc("empty", "Notes must not be empty."))
+	}
+	return content, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/word_filter/word_filter_querier"
 	"github.com/Southclaws/storyden/app/services/moderation/spam"
 )
 
@@ -21,12 +22,14 @@ var (
 )
 
 type Manager struct {
-	spamDetector spam.Detector
+	spamDetector  spam.Detector
+	filterQuerier *word_filter_querier.Querier
 }
 
-func New(d spam.Detector) *Manager {
+func New(d spam.Detector, filterQuerier *word_filter_querier.Querier) *Manager {
 	return &Manager{
-		spamDetector: d,
+		spamDetector:  d,
+		filterQuerier: filterQuerier,
 	}
 }
 
@@ -0,0 +1,138 @@
+package content_policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/word_filter"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_engine"
+)
+
+var ErrBlockedTerm = fault.New("content contains a blocked term", ftag.With(ftag.InvalidArgument))
+
+// Filtered is a post's title and content after word filters were applied.
+type Filtered struct {
+	Title   opt.Optional[string]
+	Content opt.Optional[datagraph.Content]
+	// Review is set when a term matched which holds new posts for review.
+	Review bool
+}
+
+// FilterPost applies the enabled word filters to a post's title and content.
+// Terms with a replacement are swapped out and if a blocked term is found, an
+// error is returned which tells the author which term it was.
+func (m *Manager) FilterPost(ctx context.Context, title opt.Optional[string], content opt.Optional[datagraph.Content]) (*Filtered, error) {
+	filters, err := m.filterQuerier.ListEnabled(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := &Filtered{Title: title, Content: content}
+	if len(filters) == 0 {
+		return out, nil
+	}
+
+	result := &match{}
+
+	if t, ok := title.Get(); ok {
+		out.Title = opt.New(apply(filters, word_filter.FieldTitle, t, result))
+	}
+
+	if c, ok := content.Get(); ok {
+		replaced, err := c.ReplaceText(func(s string) string {
+			return apply(filters, word_filter.FieldContent, s, result)
+		})
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		out.Content = opt.New(replaced)
+	}
+
+	if term, ok := result.blocked.Get(); ok {
+		return nil, fault.Wrap(ErrBlockedTerm,
+			fctx.With(ctx),
+			fmsg.WithDesc("blocked", fmt.Sprintf("Your post contains a blocked word: %q.", term)))
+	}
+
+	out.Review = result.review
+
+	return out, nil
+}
+
+// FilterName applies the enabled word filters to a display name. Names can't
+// be held for review so terms which would hold a post block the name instead.
+func (m *Manager) FilterName(ctx context.Context, name string) (string, error) {
+	filters, err := m.filterQuerier.ListEnabled(ctx)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := &match{}
+	name = apply(filters, word_filter.FieldName, name, result)
+
+	term, blocked := result.blocked.Get()
+	if !blocked && result.review {
+		term, blocked = result.reviewed, true
+	}
+
+	if blocked {
+		return "", fault.Wrap(ErrBlockedTerm,
+			fctx.With(ctx),
+			fmsg.WithDesc("blocked", fmt.Sprintf("Your name contains a blocked word: %q.", term)))
+	}
+
+	return name, nil
+}
+
+type match struct {
+	blocked  opt.Optional[string]
+	review   bool
+	reviewed string
+}
+
+// apply runs each filter covering the field over the text, returning the text
+// with replacements made and recording any blocking or review matches.
+func apply(filters word_filter.Filters, field word_filter.Field, text string, m *match) string {
+	for _, f := range filters {
+		if !f.Scope.Covers(field) {
+			continue
+		}
+
+		re := automod_engine.WordsPattern([]string{f.Term})
+		if re == nil {
+			continue
+		}
+
+		switch f.Action {
+		case word_filter.ActionBlock:
+			if !m.blocked.Ok() && re.MatchString(text) {
+				m.blocked = opt.New(f.Term)
+			}
+
+		case word_filter.ActionReview:
+			if !m.review && re.MatchString(text) {
+				m.review = true
+				m.reviewed = f.Term
+			}
+
+		case word_filter.ActionReplace:
+			text = re.ReplaceAllStringFunc(text, func(s string) string {
+				if r, ok := f.Replacement.Get(); ok {
+					return r
+				}
+				return strings.Repeat("*", utf8.RuneCountInString(s))
+			})
+		}
+	}
+
+	return text
+}
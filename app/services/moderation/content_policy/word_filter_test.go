@@ -0,0 +1,84 @@
+package content_policy
+
+import (
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/app/resources/word_filter"
+)
+
+func TestApply(t *testing.T) {
+	filters := word_filter.Filters{
+		{Term: "darn", Action: word_filter.ActionReplace, Scope: word_filter.ScopeAll},
+		{Term: "heck", Action: word_filter.ActionReplace, Replacement: opt.New("h*ck"), Scope: word_filter.ScopeAll},
+		{Term: "clickbait", Action: word_filter.ActionBlock, Scope: word_filter.ScopeTitles},
+		{Term: "casino", Action: word_filter.ActionReview, Scope: word_filter.ScopeAll},
+	}
+
+	cases := []struct {
+		name    string
+		field   word_filter.Field
+		text    string
+		want    string
+		blocked opt.Optional[string]
+		review  bool
+	}{
+		{
+			name:  "masked",
+			field: word_filter.FieldContent,
+			text:  "well Darn it",
+			want:  "well **** it",
+		},
+		{
+			name:  "replaced",
+			field: word_filter.FieldTitle,
+			text:  "what the heck",
+			want:  "what the h*ck",
+		},
+		{
+			name:  "whole_words_only",
+			field: word_filter.FieldContent,
+			text:  "darning socks",
+			want:  "darning socks",
+		},
+		{
+			name:    "blocked_in_title",
+			field:   word_filter.FieldTitle,
+			text:    "pure clickbait",
+			want:    "pure clickbait",
+			blocked: opt.New("clickbait"),
+		},
+		{
+			name:  "title_scope_ignores_content",
+			field: word_filter.FieldContent,
+			text:  "pure clickbait",
+			want:  "pure clickbait",
+		},
+		{
+			name:    "title_scope_covers_names",
+			field:   word_filter.FieldName,
+			text:    "Clickbait King",
+			want:    "Clickbait King",
+			blocked: opt.New("clickbait"),
+		},
+		{
+			name:   "review",
+			field:  word_filter.FieldContent,
+			text:   "visit my casino",
+			want:   "visit my casino",
+			review: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := &match{}
+			got := apply(filters, c.field, c.text, m)
+			assert.Equal(t, c.want, got)
+			assert.Equal(t, c.blocked, m.blocked)
+			assert.Equal(t, c.review, m.review)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Package netban_guard enforces network bans when accounts are registered and
+// when posts are written, based on the address the request came from.
+package netban_guard
+
+import (
+	"context"
+	"log/slog"
+	"net/netip"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/netban"
+	"github.com/Southclaws/storyden/app/resources/netban/netban_querier"
+	"github.com/Southclaws/storyden/app/resources/netban/netban_writer"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+	"github.com/Southclaws/storyden/internal/infrastructure/asn"
+)
+
+var ErrBanned = fault.New("network is banned", ftag.With(ftag.PermissionDenied))
+
+type Guard struct {
+	logger         *slog.Logger
+	accountQuerier *account_querier.Querier
+	banQuerier     *netban_querier.Querier
+	banWriter      *netban_writer.Writer
+	asn            asn.Resolver
+}
+
+func New(
+	logger *slog.Logger,
+	accountQuerier *account_querier.Querier,
+	banQuerier *netban_querier.Querier,
+	banWriter *netban_writer.Writer,
+	asn asn.Resolver,
+) *Guard {
+	return &Guard{
+		logger:         logger,
+		accountQuerier: accountQuerier,
+		banQuerier:     banQuerier,
+		banWriter:      banWriter,
+		asn:            asn,
+	}
+}
+
+// CheckRegistration returns ErrBanned if the request comes from a banned network.
+func (g *Guard) CheckRegistration(ctx context.Context) error {
+	return g.check(ctx)
+}
+
+// CheckPost returns ErrBanned if the request comes from a banned network, unless
+// the author is an administrator, so they can't lock themselves out.
+func (g *Guard) CheckPost(ctx context.Context, authorID account.AccountID) error {
+	acc, err := g.accountQuerier.GetByID(ctx, authorID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if acc.Roles.Permissions().HasAny(rbac.PermissionAdministrator) {
+		return nil
+	}
+
+	return g.check(ctx)
+}
+
+func (g *Guard) check(ctx context.Context) error {
+	addr, err := netip.ParseAddr(reqinfo.GetClientAddress(ctx))
+	if err != nil {
+		return nil
+	}
+	addr = addr.Unmap()
+
+	bans, err := g.banQuerier.ListActive(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(bans) == 0 {
+		return nil
+	}
+
+	asns := g.lookup(ctx, addr, bans)
+
+	var allowed, blocked netban.Bans
+	for _, b := range bans {
+		if !b.Target.Matches(addr, asns) {
+			continue
+		}
+
+		switch b.List {
+		case netban.ListAllow:
+			allowed = append(allowed, b)
+		case netban.ListBlock:
+			blocked = append(blocked, b)
+		}
+	}
+
+	hits := allowed
+	if len(allowed) == 0 {
+		hits = blocked
+	}
+
+	ids := make([]netban.BanID, len(hits))
+	for i, b := range hits {
+		ids[i] = b.ID
+	}
+
+	if err := g.banWriter.RecordHit(ctx, ids...); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(allowed) > 0 || len(blocked) == 0 {
+		return nil
+	}
+
+	message := "Your network has been banned from this community."
+	if reason, ok := blocked[0].Reason.Get(); ok {
+		message = "Your network has been banned from this community: " + reason
+	}
+
+	return fault.Wrap(ErrBanned,
+		fctx.With(ctx),
+		fmsg.WithDesc("banned", message),
+	)
+}
+
+// lookup only resolves the address's autonomous systems when there are bans on
+// autonomous systems to check it against.
+func (g *Guard) lookup(ctx context.Context, addr netip.Addr, bans netban.Bans) []uint32 {
+	if g.asn == nil {
+		return nil
+	}
+
+	needed := false
+	for _, b := range bans {
+		if b.Target.ASN.Ok() {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil
+	}
+
+	asns, err := g.asn.Lookup(ctx, addr)
+	if err != nil {
+		g.logger.Warn("failed to look up autonomous system for address",
+			slog.String("error", err.Error()),
+			slog.String("address", addr.String()),
+		)
+		return nil
+	}
+
+	return asns
+}
@@ -0,0 +1,85 @@
+package netban_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/netban"
+	"github.com/Southclaws/storyden/app/resources/netban/netban_querier"
+	"github.com/Southclaws/storyden/app/resources/netban/netban_writer"
+	"github.com/Southclaws/storyden/internal/deletable"
+)
+
+type Manager struct {
+	banQuerier *netban_querier.Querier
+	banWriter  *netban_writer.Writer
+}
+
+func New(
+	banQuerier *netban_querier.Querier,
+	banWriter *netban_writer.Writer,
+) *Manager {
+	return &Manager{
+		banQuerier: banQuerier,
+		banWriter:  banWriter,
+	}
+}
+
+type Partial struct {
+	Target    opt.Optional[netban.Target]
+	List      opt.Optional[netban.List]
+	Reason    opt.Optional[string]
+	ExpiresAt deletable.Value[time.Time]
+}
+
+func (p Partial) Opts() (opts []netban_writer.Option) {
+	p.Target.Call(func(v netban.Target) { opts = append(opts, netban_writer.WithTarget(v)) })
+	p.List.Call(func(v netban.List) { opts = append(opts, netban_writer.WithList(v)) })
+	p.Reason.Call(func(v string) { opts = append(opts, netban_writer.WithReason(v)) })
+	p.ExpiresAt.Call(
+		func(v time.Time) { opts = append(opts, netban_writer.WithExpiresAt(v)) },
+		func() { opts = append(opts, netban_writer.WithoutExpiry()) },
+	)
+	return
+}
+
+func (m *Manager) List(ctx context.Context) (netban.Bans, error) {
+	return m.banQuerier.List(ctx)
+}
+
+func (m *Manager) Get(ctx context.Context, id netban.BanID) (*netban.Ban, error) {
+	return m.banQuerier.Get(ctx, id)
+}
+
+func (m *Manager) Create(ctx context.Context, target netban.Target, list netban.List, p Partial) (*netban.Ban, error) {
+	p.Target = opt.NewEmpty[netban.Target]()
+	p.List = opt.NewEmpty[netban.List]()
+
+	b, err := m.banWriter.Create(ctx, target, list, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+func (m *Manager) Update(ctx context.Context, id netban.BanID, p Partial) (*netban.Ban, error) {
+	b, err := m.banWriter.Update(ctx, id, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, id netban.BanID) error {
+	if err := m.banWriter.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+// Package post_queue manages the queue of threads and replies which are held for
+// review, either by automod or because their author is a new member who hasn't
+// yet had enough posts approved to be trusted to publish without review.
+package post_queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_review"
+	"github.com/Southclaws/storyden/app/resources/post/post_writer"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var errUnknownAction = fault.New("unknown queue action", ftag.With(ftag.InvalidArgument))
+
+type Action int
+
+const (
+	ActionApprove Action = iota
+	ActionRemove
+)
+
+type Queue struct {
+	settings       *settings.SettingsRepository
+	accountQuerier *account_querier.Querier
+	reviewQuerier  *post_review.Querier
+	postWriter     *post_writer.PostWriter
+	threadWriter   *thread_writer.Writer
+	replyRepo      reply.Repository
+	bus            *pubsub.Bus
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	accountQuerier *account_querier.Querier,
+	reviewQuerier *post_review.Querier,
+	postWriter *post_writer.PostWriter,
+	threadWriter *thread_writer.Writer,
+	replyRepo reply.Repository,
+	bus *pubsub.Bus,
+) *Queue {
+	return &Queue{
+		settings:       settings,
+		accountQuerier: accountQuerier,
+		reviewQuerier:  reviewQuerier,
+		postWriter:     postWriter,
+		threadWriter:   threadWriter,
+		replyRepo:      replyRepo,
+		bus:            bus,
+	}
+}
+
+// Required reports whether a post by the given author must be held for review
+// because they haven't yet had enough posts approved. Members who can manage
+// posts are never held.
+func (q *Queue) Required(ctx context.Context, authorID account.AccountID) (bool, error) {
+	s, err := q.settings.Get(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	required := s.NewMemberApprovals.OrZero()
+	if required <= 0 {
+		return false, nil
+	}
+
+	acc, err := q.accountQuerier.GetByID(ctx, authorID)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if acc.Roles.Permissions().HasAny(rbac.PermissionAdministrator, rbac.PermissionManagePosts) {
+		return false, nil
+	}
+
+	approved, err := q.reviewQuerier.CountApproved(ctx, authorID)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return approved < required, nil
+}
+
+func (q *Queue) List(ctx context.Context, page pagination.Parameters) (*pagination.Result[*post.Post], error) {
+	r, err := q.reviewQuerier.List(ctx, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+// Handle approves or removes a held post. Approving publishes it as if it had
+// just been posted, removing deletes it. Once a new member has had enough of
+// their posts approved, their subsequent posts are no longer held.
+func (q *Queue) Handle(ctx context.Context, id post.ID, action Action) (*post.Post, error) {
+	p, err := q.reviewQuerier.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	isThread := p.Root == p.ID
+
+	switch action {
+	case ActionApprove:
+		p, err = q.postWriter.Update(ctx, id, post_writer.WithVisibility(visibility.VisibilityPublished))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if isThread {
+			q.bus.Publish(ctx, &message.EventThreadPublished{
+				ID: p.ID,
+			})
+			return p, nil
+		}
+
+		r, err := q.replyRepo.Get(ctx, id)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		q.bus.Publish(ctx, &message.EventThreadReplyCreated{
+			ThreadID:       r.RootPostID,
+			ReplyID:        r.ID,
+			ThreadAuthorID: r.RootAuthor.ID,
+			ReplyAuthorID:  r.Author.ID,
+		})
+
+		return p, nil
+
+	case ActionRemove:
+		if isThread {
+			err = q.threadWriter.Delete(ctx, id)
+		} else {
+			err = q.replyRepo.Delete(ctx, id)
+		}
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		p.DeletedAt = opt.New(time.Now())
+
+		return p, nil
+	}
+
+	return nil, fault.Wrap(errUnknownAction, fctx.With(ctx))
+}
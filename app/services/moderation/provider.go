@@ -3,13 +3,38 @@ package moderation
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/services/moderation/automod_engine"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_manager"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_notify"
+	"github.com/Southclaws/storyden/app/services/moderation/case_file"
 	"github.com/Southclaws/storyden/app/services/moderation/content_policy"
+	"github.com/Southclaws/storyden/app/services/moderation/netban_guard"
+	"github.com/Southclaws/storyden/app/services/moderation/netban_manager"
+	"github.com/Southclaws/storyden/app/services/moderation/post_queue"
 	"github.com/Southclaws/storyden/app/services/moderation/spam"
+	"github.com/Southclaws/storyden/app/services/moderation/spam_screen"
+	"github.com/Southclaws/storyden/app/services/moderation/trash_manager"
+	"github.com/Southclaws/storyden/app/services/moderation/warning_gate"
+	"github.com/Southclaws/storyden/app/services/moderation/warning_manager"
+	"github.com/Southclaws/storyden/app/services/moderation/word_filter_manager"
 )
 
 func Build() fx.Option {
 	return fx.Options(
 		fx.Provide(spam.New),
+		fx.Provide(spam.NewChecker),
+		fx.Provide(spam_screen.New),
 		fx.Provide(content_policy.New),
+		fx.Provide(automod_engine.New),
+		fx.Provide(automod_manager.New),
+		fx.Provide(word_filter_manager.New),
+		automod_notify.Build(),
+		fx.Provide(post_queue.New),
+		fx.Provide(netban_guard.New),
+		fx.Provide(netban_manager.New),
+		fx.Provide(case_file.New),
+		fx.Provide(warning_gate.New),
+		fx.Provide(warning_manager.New),
+		fx.Provide(trash_manager.New),
 	)
 }
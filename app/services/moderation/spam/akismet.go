@@ -0,0 +1,108 @@
+package spam
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+const (
+	akismetEndpoint = "https://rest.akismet.com/1.1/comment-check"
+	akismetTimeout  = 10 * time.Second
+)
+
+var ErrAkismetFailed = fault.New("akismet responded with an unexpected result")
+
+// Akismet only says whether something is spam or not, with a "discard" hint
+// for the most blatant spam, so its answers are mapped onto fixed scores.
+const (
+	akismetScoreHam     = 0.0
+	akismetScoreSpam    = 0.9
+	akismetScoreDiscard = 1.0
+)
+
+type akismetChecker struct {
+	client   *http.Client
+	endpoint string
+	key      string
+	blog     string
+}
+
+func newAkismetChecker(cfg config.Config) (*akismetChecker, error) {
+	if cfg.AkismetAPIKey == "" {
+		return nil, fault.New("AKISMET_API_KEY must be set when SPAM_PROVIDER is akismet")
+	}
+
+	return &akismetChecker{
+		client:   &http.Client{Timeout: akismetTimeout, Transport: outbound.Transport(nil)},
+		endpoint: akismetEndpoint,
+		key:      cfg.AkismetAPIKey,
+		blog:     cfg.PublicWebAddress.String(),
+	}, nil
+}
+
+func (c *akismetChecker) Check(ctx context.Context, s Submission) (float64, error) {
+	commentType := "forum-post"
+	if s.Kind == SubmissionReply {
+		commentType = "reply"
+	}
+
+	content := s.Content.Plaintext()
+	if s.Title != "" {
+		content = s.Title + "\n\n" + content
+	}
+
+	form := url.Values{
+		"api_key":         {c.key},
+		"blog":            {c.blog},
+		"user_ip":         {s.ClientAddress},
+		"user_agent":      {s.UserAgent},
+		"comment_type":    {commentType},
+		"comment_author":  {s.AuthorName},
+		"comment_content": {content},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1024))
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	switch strings.TrimSpace(string(body)) {
+	case "false":
+		return akismetScoreHam, nil
+
+	case "true":
+		if res.Header.Get("X-akismet-pro-tip") == "discard" {
+			return akismetScoreDiscard, nil
+		}
+		return akismetScoreSpam, nil
+
+	default:
+		return 0, fault.Wrap(ErrAkismetFailed,
+			fctx.With(ctx),
+			fmsg.With(res.Header.Get("X-akismet-debug-help")),
+		)
+	}
+}
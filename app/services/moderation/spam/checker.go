@@ -0,0 +1,50 @@
+package spam
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+type SubmissionKind string
+
+const (
+	SubmissionThread SubmissionKind = "thread"
+	SubmissionReply  SubmissionKind = "reply"
+)
+
+// Submission is a new post along with what is known about who submitted it.
+type Submission struct {
+	Kind          SubmissionKind
+	AuthorHandle  string
+	AuthorName    string
+	Title         string
+	Content       datagraph.Content
+	ClientAddress string
+	UserAgent     string
+}
+
+// Checker describes a service which scores new posts for how likely they are
+// to be spam, from 0 for certainly not spam up to 1 for certainly spam.
+type Checker interface {
+	Check(ctx context.Context, s Submission) (float64, error)
+}
+
+func NewChecker(cfg config.Config) (Checker, error) {
+	switch cfg.SpamProvider {
+	case "", "heuristic":
+		return newHeuristicChecker(), nil
+
+	case "akismet":
+		return newAkismetChecker(cfg)
+
+	case "none":
+		return nil, nil
+
+	default:
+		return nil, fault.Newf("unknown spam provider: '%s'", cfg.SpamProvider)
+	}
+}
@@ -0,0 +1,113 @@
+package spam
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+func submission(t *testing.T, title, body string) Submission {
+	t.Helper()
+
+	c, err := datagraph.NewRichText(body)
+	require.NoError(t, err)
+
+	return Submission{Kind: SubmissionThread, Title: title, Content: c}
+}
+
+func TestHeuristicChecker(t *testing.T) {
+	ctx := context.Background()
+	c := newHeuristicChecker()
+
+	check := func(name string, s Submission, spam bool) {
+		t.Run(name, func(t *testing.T) {
+			score, err := c.Check(ctx, s)
+			require.NoError(t, err)
+
+			assert.GreaterOrEqual(t, score, 0.0)
+			assert.LessOrEqual(t, score, 1.0)
+
+			if spam {
+				assert.GreaterOrEqual(t, score, 0.8)
+			} else {
+				assert.Less(t, score, 0.8)
+			}
+		})
+	}
+
+	check("ordinary", submission(t, "Help with my garden",
+		`<p>My tomatoes keep splitting after it rains. I water them every morning, is that too much? Any advice appreciated.</p>`,
+	), false)
+
+	check("single_link", submission(t, "",
+		`<p><a href="https://example.com/guide">https://example.com/guide</a></p>`,
+	), false)
+
+	check("few_links", submission(t, "Useful resources",
+		`<p>Here are the guides I used when I started out, the first covers the basics and the others go into more detail about pruning and feeding.</p>
+		<p><a href="https://example.com/a">basics</a>, <a href="https://example.com/b">pruning</a> and <a href="https://example.com/c">feeding</a>.</p>`,
+	), false)
+
+	check("link_dump", submission(t, "",
+		`<p><a href="https://spam.example/1">a</a> <a href="https://spam.example/2">b</a> <a href="https://spam.example/3">c</a> <a href="https://spam.example/4">d</a></p>`,
+	), true)
+
+	check("phrases_and_shouting", submission(t, "CLICK HERE",
+		`<p>BUY NOW AND MAKE MONEY FAST WITH THIS LIMITED TIME OFFER FROM OUR CASINO</p>`,
+	), true)
+
+	check("repetition", submission(t, "",
+		"<p>"+strings.Repeat("cheap deals today ", 30)+"</p>",
+	), true)
+}
+
+func TestAkismetChecker(t *testing.T) {
+	ctx := context.Background()
+
+	respond := func(body, tip string) *akismetChecker {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "key", r.PostForm.Get("api_key"))
+			assert.Equal(t, "forum-post", r.PostForm.Get("comment_type"))
+			assert.Equal(t, "127.0.0.1", r.PostForm.Get("user_ip"))
+
+			if tip != "" {
+				w.Header().Set("X-akismet-pro-tip", tip)
+			}
+			w.Write([]byte(body))
+		}))
+		t.Cleanup(srv.Close)
+
+		return &akismetChecker{
+			client:   srv.Client(),
+			endpoint: srv.URL,
+			key:      "key",
+			blog:     "http://localhost:3000",
+		}
+	}
+
+	s := submission(t, "title", "<p>hello</p>")
+	s.ClientAddress = "127.0.0.1"
+
+	score, err := respond("false", "").Check(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, akismetScoreHam, score)
+
+	score, err = respond("true", "").Check(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, akismetScoreSpam, score)
+
+	score, err = respond("true", "discard").Check(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, akismetScoreDiscard, score)
+
+	_, err = respond("invalid", "").Check(ctx, s)
+	assert.ErrorIs(t, err, ErrAkismetFailed)
+}
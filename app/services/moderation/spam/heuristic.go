@@ -0,0 +1,138 @@
+package spam
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+var spamPhrases = []string{
+	"buy now",
+	"click here",
+	"limited time offer",
+	"act now",
+	"100% free",
+	"risk free",
+	"work from home",
+	"make money fast",
+	"earn money online",
+	"guaranteed income",
+	"cheap pills",
+	"online pharmacy",
+	"viagra",
+	"cialis",
+	"casino",
+	"betting tips",
+	"crypto giveaway",
+	"double your bitcoin",
+	"whatsapp me",
+	"telegram me",
+	"seo services",
+	"backlinks",
+}
+
+// heuristicChecker scores posts locally using a handful of simple signals. Each
+// signal produces its own score and they are combined such that any one strong
+// signal, or several weak ones together, result in a high overall score.
+type heuristicChecker struct{}
+
+func newHeuristicChecker() *heuristicChecker {
+	return &heuristicChecker{}
+}
+
+func (c *heuristicChecker) Check(ctx context.Context, s Submission) (float64, error) {
+	text := s.Content.Plaintext()
+	if s.Title != "" {
+		text = s.Title + "\n" + text
+	}
+
+	words := strings.Fields(text)
+	links := len(s.Content.Links())
+
+	signals := []float64{
+		scoreLinks(links, len(words)),
+		scoreRepetition(words),
+		scoreShouting(text),
+		scorePhrases(text),
+	}
+
+	remainder := 1.0
+	for _, v := range signals {
+		remainder *= 1 - clamp(v)
+	}
+
+	return 1 - remainder, nil
+}
+
+// scoreLinks scores posts which are mostly links or contain a lot of them.
+func scoreLinks(links, words int) float64 {
+	if links == 0 {
+		return 0
+	}
+
+	count := float64(links-2) / 8
+
+	if links < 2 {
+		return count
+	}
+
+	perWord := float64(links) / float64(max(words, 1))
+	density := (perWord - 0.1) * 2.5
+
+	return max(count, density)
+}
+
+// scoreRepetition scores posts which are the same few words over and over.
+func scoreRepetition(words []string) float64 {
+	if len(words) < 20 {
+		return 0
+	}
+
+	unique := map[string]struct{}{}
+	for _, w := range words {
+		unique[strings.ToLower(w)] = struct{}{}
+	}
+
+	ratio := float64(len(unique)) / float64(len(words))
+
+	return (0.3 - ratio) / 0.2
+}
+
+// scoreShouting scores posts which are written mostly in capital letters.
+func scoreShouting(text string) float64 {
+	letters, upper := 0, 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+
+	if letters < 20 {
+		return 0
+	}
+
+	ratio := float64(upper) / float64(letters)
+
+	return (ratio - 0.6) / 0.4 * 0.6
+}
+
+// scorePhrases scores posts containing phrases which are common in spam.
+func scorePhrases(text string) float64 {
+	lower := strings.ToLower(text)
+
+	hits := 0
+	for _, p := range spamPhrases {
+		if strings.Contains(lower, p) {
+			hits++
+		}
+	}
+
+	return float64(hits) * 0.3
+}
+
+func clamp(v float64) float64 {
+	return min(max(v, 0), 1)
+}
@@ -0,0 +1,120 @@
+// Package spam_screen scores new posts with the configured spam checker and
+// decides whether they score highly enough to be held in the review queue.
+package spam_screen
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/moderation/spam"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const defaultThreshold = 0.8
+
+// Result is the outcome of screening a post. Score is empty when the post was
+// not scored, either because spam checking is disabled, the author is exempt
+// or the checker failed.
+type Result struct {
+	Score opt.Optional[float64]
+	Held  bool
+}
+
+type Screener struct {
+	logger         *slog.Logger
+	accountQuerier *account_querier.Querier
+	settings       *settings.SettingsRepository
+	checker        spam.Checker
+	threshold      float64
+}
+
+func New(
+	logger *slog.Logger,
+	cfg config.Config,
+	accountQuerier *account_querier.Querier,
+	settings *settings.SettingsRepository,
+	checker spam.Checker,
+) *Screener {
+	threshold := cfg.SpamThreshold
+	if threshold <= 0 {
+		threshold = defaultThreshold
+	}
+
+	return &Screener{
+		logger:         logger,
+		accountQuerier: accountQuerier,
+		settings:       settings,
+		checker:        checker,
+		threshold:      threshold,
+	}
+}
+
+// Screen scores a post about to be written by the given author. A checker which
+// fails does not prevent the post from being written, it's left unscored.
+func (s *Screener) Screen(
+	ctx context.Context,
+	authorID account.AccountID,
+	kind spam.SubmissionKind,
+	title string,
+	content datagraph.Content,
+) (*Result, error) {
+	if s.checker == nil {
+		return &Result{}, nil
+	}
+
+	acc, err := s.accountQuerier.GetByID(ctx, authorID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if acc.Roles.Permissions().HasAny(rbac.PermissionAdministrator, rbac.PermissionManagePosts) {
+		return &Result{}, nil
+	}
+
+	score, err := s.checker.Check(ctx, spam.Submission{
+		Kind:          kind,
+		AuthorHandle:  acc.Handle,
+		AuthorName:    acc.Name,
+		Title:         title,
+		Content:       content,
+		ClientAddress: reqinfo.GetClientAddress(ctx),
+		UserAgent:     reqinfo.GetUserAgent(ctx),
+	})
+	if err != nil {
+		s.logger.Warn("failed to check post for spam",
+			slog.String("error", err.Error()),
+			slog.String("author_id", authorID.String()),
+		)
+		return &Result{}, nil
+	}
+
+	return &Result{
+		Score: opt.New(score),
+		Held:  score >= s.thresholdFor(ctx),
+	}, nil
+}
+
+// thresholdFor prefers the threshold in the instance settings, which may be
+// changed at runtime, over the one configured by the environment.
+func (s *Screener) thresholdFor(ctx context.Context) float64 {
+	set, err := s.settings.Get(ctx)
+	if err != nil {
+		return s.threshold
+	}
+
+	if t := set.Limits.OrZero().SpamThreshold; t > 0 {
+		return t
+	}
+
+	return s.threshold
+}
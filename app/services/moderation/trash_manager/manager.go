@@ -0,0 +1,188 @@
+// Package trash_manager lets staff browse, restore and permanently delete the
+// threads, replies and library pages which have been moved to the trash.
+package trash_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/trash"
+	"github.com/Southclaws/storyden/app/resources/trash/trash_querier"
+	"github.com/Southclaws/storyden/app/resources/trash/trash_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrRestoreWindowElapsed = fault.New("restore window has elapsed", ftag.With(ftag.NotFound))
+	ErrThreadInTrash        = fault.New("reply belongs to a deleted thread", ftag.With(ftag.InvalidArgument))
+)
+
+type Manager struct {
+	settings     *settings.SettingsRepository
+	trashQuerier *trash_querier.Querier
+	trashWriter  *trash_writer.Writer
+	nodeWriter   *node_writer.Writer
+	bus          *pubsub.Bus
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	trashQuerier *trash_querier.Querier,
+	trashWriter *trash_writer.Writer,
+	nodeWriter *node_writer.Writer,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		settings:     settings,
+		trashQuerier: trashQuerier,
+		trashWriter:  trashWriter,
+		nodeWriter:   nodeWriter,
+		bus:          bus,
+	}
+}
+
+func (m *Manager) Retention(ctx context.Context) (time.Duration, error) {
+	s, err := m.settings.Get(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return trash.Retention(s.TrashRetentionDays), nil
+}
+
+// List returns the items which are still restorable. Posts are only listed for
+// members who can manage posts and pages for those who can manage the library.
+func (m *Manager) List(ctx context.Context) (trash.Items, time.Duration, error) {
+	perms := session.GetRoles(ctx).Permissions()
+	canPosts := perms.HasAny(rbac.PermissionAdministrator, rbac.PermissionManagePosts)
+	canNodes := perms.HasAny(rbac.PermissionAdministrator, rbac.PermissionManageLibrary)
+	if !canPosts && !canNodes {
+		return nil, 0, fault.Wrap(rbac.ErrPermissions, fctx.With(ctx))
+	}
+
+	retention, err := m.Retention(ctx)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := m.trashQuerier.List(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items = dt.Filter(items, func(i *trash.Item) bool {
+		if i.Kind == datagraph.KindNode {
+			return canNodes
+		}
+		return canPosts
+	})
+
+	return items, retention, nil
+}
+
+func (m *Manager) Restore(ctx context.Context, id xid.ID) (*trash.Item, time.Duration, error) {
+	item, retention, err := m.get(ctx, id)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	inTrash, err := m.trashQuerier.ThreadInTrash(ctx, item)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+	if inTrash {
+		return nil, 0, fault.Wrap(ErrThreadInTrash,
+			fctx.With(ctx),
+			fmsg.WithDesc("thread in trash", "This reply belongs to a thread which is also in the trash, restore the thread first."),
+		)
+	}
+
+	if err := m.trashWriter.Restore(ctx, item); err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	switch item.Kind {
+	case datagraph.KindThread:
+		m.bus.Publish(ctx, &message.EventThreadUpdated{
+			ID: post.ID(item.ID),
+		})
+
+	case datagraph.KindReply:
+		m.bus.Publish(ctx, &message.EventThreadReplyUpdated{
+			ThreadID: post.ID(item.ThreadID.OrZero()),
+			ReplyID:  post.ID(item.ID),
+		})
+
+	case datagraph.KindNode:
+		m.bus.Publish(ctx, &message.EventNodeUpdated{
+			ID:   library.NodeID(item.ID),
+			Slug: item.Slug.OrZero(),
+		})
+	}
+
+	return item, retention, nil
+}
+
+// Delete permanently removes an item from the trash without waiting for it to
+// be purged.
+func (m *Manager) Delete(ctx context.Context, id xid.ID) error {
+	item, _, err := m.get(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.trashWriter.Delete(ctx, item); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if item.Kind == datagraph.KindNode {
+		m.nodeWriter.CleanupOrphanedSchemas(ctx)
+	}
+
+	return nil
+}
+
+func (m *Manager) get(ctx context.Context, id xid.ID) (*trash.Item, time.Duration, error) {
+	item, err := m.trashQuerier.Get(ctx, id)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	required := rbac.PermissionManagePosts
+	if item.Kind == datagraph.KindNode {
+		required = rbac.PermissionManageLibrary
+	}
+
+	if !session.GetRoles(ctx).Permissions().HasAny(rbac.PermissionAdministrator, required) {
+		return nil, 0, fault.Wrap(rbac.ErrPermissions, fctx.With(ctx))
+	}
+
+	retention, err := m.Retention(ctx)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if time.Now().After(item.RestorableUntil(retention)) {
+		return nil, 0, fault.Wrap(ErrRestoreWindowElapsed,
+			fctx.With(ctx),
+			fmsg.WithDesc("restore window elapsed", "This item has been in the trash for too long to be restored and will be purged soon."),
+		)
+	}
+
+	return item, retention, nil
+}
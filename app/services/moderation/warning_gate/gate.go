@@ -0,0 +1,66 @@
+// Package warning_gate withholds permissions from members whose active
+// warnings have reached a threshold configured in the instance settings.
+package warning_gate
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/warning"
+	"github.com/Southclaws/storyden/app/resources/warning/warning_querier"
+)
+
+var errRestricted = fault.New("restricted by warnings", ftag.With(ftag.PermissionDenied))
+
+type Gate struct {
+	settings       *settings.SettingsRepository
+	warningQuerier *warning_querier.Querier
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	warningQuerier *warning_querier.Querier,
+) *Gate {
+	return &Gate{
+		settings:       settings,
+		warningQuerier: warningQuerier,
+	}
+}
+
+// Check returns an error if the permission has a warning threshold and the
+// account's active warnings have reached it. Restrictions lift on their own
+// as warnings expire or are revoked.
+func (g *Gate) Check(ctx context.Context, accountID account.AccountID, perm rbac.Permission) error {
+	s, err := g.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threshold, ok := s.Warnings.Or(warning.DefaultSettings).ThresholdFor(perm)
+	if !ok {
+		return nil
+	}
+
+	active, err := g.warningQuerier.ListActive(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if active.Points(time.Now()) >= threshold {
+		return fault.Wrap(errRestricted,
+			fctx.With(ctx),
+			fmsg.WithDesc("restricted",
+				"You can't do this while you have active warnings, restrictions are lifted when your warnings expire."),
+		)
+	}
+
+	return nil
+}
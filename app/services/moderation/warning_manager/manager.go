@@ -0,0 +1,160 @@
+// Package warning_manager issues and revokes formal warnings and reports the
+// restrictions a member's warnings currently place on them.
+package warning_manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/moderation_note"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/warning"
+	"github.com/Southclaws/storyden/app/resources/warning/warning_querier"
+	"github.com/Southclaws/storyden/app/resources/warning/warning_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/moderation/case_file"
+)
+
+var (
+	ErrEmptyReason    = fault.New("warning reason is empty", ftag.With(ftag.InvalidArgument))
+	ErrExpiryInPast   = fault.New("warning expiry is in the past", ftag.With(ftag.InvalidArgument))
+	ErrAlreadyRevoked = fault.New("warning already revoked", ftag.With(ftag.AlreadyExists))
+)
+
+type Manager struct {
+	settings       *settings.SettingsRepository
+	warningQuerier *warning_querier.Querier
+	warningWriter  *warning_writer.Writer
+	cases          *case_file.Manager
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	warningQuerier *warning_querier.Querier,
+	warningWriter *warning_writer.Writer,
+	cases *case_file.Manager,
+) *Manager {
+	return &Manager{
+		settings:       settings,
+		warningQuerier: warningQuerier,
+		warningWriter:  warningWriter,
+		cases:          cases,
+	}
+}
+
+// Standing is a member's warning history along with the points their active
+// warnings add up to and the permissions currently withheld because of them.
+type Standing struct {
+	Warnings     warning.Warnings
+	Points       int
+	Restrictions []rbac.Permission
+}
+
+func (m *Manager) Get(ctx context.Context, accountID account.AccountID) (*Standing, error) {
+	s, err := m.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	warnings, err := m.warningQuerier.List(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	points := warnings.Points(time.Now())
+
+	restrictions := dt.Map(
+		dt.Filter(s.Warnings.Or(warning.DefaultSettings).Thresholds, func(t warning.Threshold) bool {
+			return points >= t.Points
+		}),
+		func(t warning.Threshold) rbac.Permission { return t.Permission },
+	)
+
+	return &Standing{
+		Warnings:     warnings,
+		Points:       points,
+		Restrictions: restrictions,
+	}, nil
+}
+
+func (m *Manager) Issue(
+	ctx context.Context,
+	accountID account.AccountID,
+	severity warning.Severity,
+	reason string,
+	expiresAt opt.Optional[time.Time],
+) (*warning.Warning, error) {
+	issuerID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fault.Wrap(ErrEmptyReason,
+			fctx.With(ctx),
+			fmsg.WithDesc("empty", "Warnings must include a reason."),
+		)
+	}
+
+	opts := []warning_writer.Option{warning_writer.WithIssuedBy(issuerID)}
+
+	if exp, ok := expiresAt.Get(); ok {
+		if !exp.After(time.Now()) {
+			return nil, fault.Wrap(ErrExpiryInPast,
+				fctx.With(ctx),
+				fmsg.WithDesc("expiry in past", "Warnings must expire in the future."),
+			)
+		}
+		opts = append(opts, warning_writer.WithExpiry(exp))
+	}
+
+	w, err := m.warningWriter.Create(ctx, accountID, severity, reason, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	_, err = m.cases.Record(ctx, accountID, moderation_note.KindWarning, opt.New(fmt.Sprintf("%s: %s", severity, reason)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w, nil
+}
+
+func (m *Manager) Revoke(ctx context.Context, id warning.WarningID) (*warning.Warning, error) {
+	w, err := m.warningQuerier.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if w.RevokedAt.Ok() {
+		return nil, fault.Wrap(ErrAlreadyRevoked,
+			fctx.With(ctx),
+			fmsg.WithDesc("already revoked", "This warning has already been revoked."),
+		)
+	}
+
+	w, err = m.warningWriter.Revoke(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	_, err = m.cases.Record(ctx, w.AccountID, moderation_note.KindWarningRevoke, opt.New(w.Reason))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w, nil
+}
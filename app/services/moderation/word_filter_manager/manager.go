@@ -0,0 +1,119 @@
+package word_filter_manager
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/word_filter"
+	"github.com/Southclaws/storyden/app/resources/word_filter/word_filter_querier"
+	"github.com/Southclaws/storyden/app/resources/word_filter/word_filter_writer"
+)
+
+var ErrInvalidFilter = fault.New("invalid word filter", ftag.With(ftag.InvalidArgument))
+
+type Manager struct {
+	filterQuerier *word_filter_querier.Querier
+	filterWriter  *word_filter_writer.Writer
+}
+
+func New(
+	filterQuerier *word_filter_querier.Querier,
+	filterWriter *word_filter_writer.Writer,
+) *Manager {
+	return &Manager{
+		filterQuerier: filterQuerier,
+		filterWriter:  filterWriter,
+	}
+}
+
+type Partial struct {
+	Term        opt.Optional[string]
+	Action      opt.Optional[word_filter.Action]
+	Replacement opt.Optional[string]
+	Scope       opt.Optional[word_filter.Scope]
+	Enabled     opt.Optional[bool]
+}
+
+func (p Partial) Opts() (opts []word_filter_writer.Option) {
+	p.Term.Call(func(v string) { opts = append(opts, word_filter_writer.WithTerm(strings.TrimSpace(v))) })
+	p.Action.Call(func(v word_filter.Action) { opts = append(opts, word_filter_writer.WithAction(v)) })
+	p.Replacement.Call(func(v string) { opts = append(opts, word_filter_writer.WithReplacement(v)) })
+	p.Scope.Call(func(v word_filter.Scope) { opts = append(opts, word_filter_writer.WithScope(v)) })
+	p.Enabled.Call(func(v bool) { opts = append(opts, word_filter_writer.WithEnabled(v)) })
+	return
+}
+
+func (p Partial) apply(f word_filter.Filter) *word_filter.Filter {
+	p.Term.Call(func(v string) { f.Term = strings.TrimSpace(v) })
+	p.Action.Call(func(v word_filter.Action) { f.Action = v })
+	p.Replacement.Call(func(v string) { f.Replacement = opt.NewSafe(v, v != "") })
+	p.Scope.Call(func(v word_filter.Scope) { f.Scope = v })
+	return &f
+}
+
+func (m *Manager) List(ctx context.Context) (word_filter.Filters, error) {
+	return m.filterQuerier.List(ctx)
+}
+
+func (m *Manager) Create(ctx context.Context, term string, action word_filter.Action, scope word_filter.Scope, p Partial) (*word_filter.Filter, error) {
+	p.Term = opt.NewEmpty[string]()
+	p.Action = opt.NewEmpty[word_filter.Action]()
+	p.Scope = opt.NewEmpty[word_filter.Scope]()
+
+	term = strings.TrimSpace(term)
+
+	if err := validate(p.apply(word_filter.Filter{Term: term, Action: action, Scope: scope})); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	f, err := m.filterWriter.Create(ctx, term, action, scope, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return f, nil
+}
+
+func (m *Manager) Update(ctx context.Context, id word_filter.FilterID, p Partial) (*word_filter.Filter, error) {
+	current, err := m.filterQuerier.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := validate(p.apply(*current)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	f, err := m.filterWriter.Update(ctx, id, p.Opts()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return f, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, id word_filter.FilterID) error {
+	if err := m.filterWriter.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func validate(f *word_filter.Filter) error {
+	invalid := func(message string) error {
+		return fault.Wrap(ErrInvalidFilter, fmsg.WithDesc("invalid filter", message))
+	}
+
+	if f.Term == "" {
+		return invalid("Word filters must have a term.")
+	}
+
+	return nil
+}
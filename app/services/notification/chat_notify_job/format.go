@@ -0,0 +1,110 @@
+package chat_notify_job
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/Southclaws/storyden/app/resources/chat_notify"
+)
+
+// maxDescription keeps messages well within both providers' limits, Discord
+// embed descriptions are capped at 4096 characters and Slack sections at 3000.
+const maxDescription = 1000
+
+type Message struct {
+	Title       string
+	URL         string
+	Description string
+	Footer      string
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackPayload struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type discordFooter struct {
+	Text string `json:"text"`
+}
+
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	URL         string         `json:"url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Footer      *discordFooter `json:"footer,omitempty"`
+}
+
+type discordPayload struct {
+	Embeds []discordEmbed `json:"embeds"`
+}
+
+// Encode renders the message as the JSON body expected by the provider's
+// incoming webhook endpoint.
+func Encode(p chat_notify.Provider, m Message) ([]byte, error) {
+	description := truncate(m.Description, maxDescription)
+
+	switch p {
+	case chat_notify.ProviderSlack:
+		heading := escapeSlack(m.Title)
+		if m.URL != "" {
+			heading = fmt.Sprintf("<%s|%s>", m.URL, heading)
+		}
+
+		text := "*" + heading + "*"
+		if description != "" {
+			text += "\n" + escapeSlack(description)
+		}
+
+		blocks := []slackBlock{{Type: "section", Text: &slackText{Type: "mrkdwn", Text: text}}}
+		if m.Footer != "" {
+			blocks = append(blocks, slackBlock{
+				Type:     "context",
+				Elements: []slackText{{Type: "mrkdwn", Text: escapeSlack(m.Footer)}},
+			})
+		}
+
+		return json.Marshal(slackPayload{Text: m.Title, Blocks: blocks})
+
+	case chat_notify.ProviderDiscord:
+		embed := discordEmbed{
+			Title:       m.Title,
+			URL:         m.URL,
+			Description: description,
+		}
+		if m.Footer != "" {
+			embed.Footer = &discordFooter{Text: m.Footer}
+		}
+
+		return json.Marshal(discordPayload{Embeds: []discordEmbed{embed}})
+	}
+
+	return nil, fault.Newf("unsupported chat provider: %s", p)
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escapeSlack(s string) string {
+	return slackEscaper.Replace(s)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
@@ -0,0 +1,54 @@
+package chat_notify_job
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/chat_notify"
+)
+
+func TestEncode(t *testing.T) {
+	m := Message{
+		Title:       "Hello <world> & friends",
+		URL:         "https://example.com/t/hello",
+		Description: strings.Repeat("a", maxDescription+10),
+		Footer:      "New thread by Odin",
+	}
+
+	t.Run("slack", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		b, err := Encode(chat_notify.ProviderSlack, m)
+		r.NoError(err)
+
+		var p slackPayload
+		r.NoError(json.Unmarshal(b, &p))
+
+		a.Equal(m.Title, p.Text)
+		r.Len(p.Blocks, 2)
+		a.True(strings.HasPrefix(p.Blocks[0].Text.Text, "*<https://example.com/t/hello|Hello &lt;world&gt; &amp; friends>*\n"))
+		a.Equal("New thread by Odin", p.Blocks[1].Elements[0].Text)
+	})
+
+	t.Run("discord", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		b, err := Encode(chat_notify.ProviderDiscord, m)
+		r.NoError(err)
+
+		var p discordPayload
+		r.NoError(json.Unmarshal(b, &p))
+
+		r.Len(p.Embeds, 1)
+		a.Equal(m.Title, p.Embeds[0].Title)
+		a.Equal(m.URL, p.Embeds[0].URL)
+		a.Len([]rune(p.Embeds[0].Description), maxDescription)
+		a.Equal("New thread by Odin", p.Embeds[0].Footer.Text)
+	})
+}
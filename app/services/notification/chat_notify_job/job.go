@@ -0,0 +1,188 @@
+// Package chat_notify_job posts a formatted message to the Slack and Discord
+// channels configured in the instance settings when selected activity occurs.
+// Delivery is best-effort, a channel which fails to accept a message is logged
+// and not retried. For reliable delivery, use webhooks instead.
+package chat_notify_job
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/chat_notify"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/report/report_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+const requestTimeout = 10 * time.Second
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(newNotifier),
+		fx.Invoke(runChatNotifyJob),
+	)
+}
+
+type notifier struct {
+	logger         *slog.Logger
+	address        url.URL
+	settings       *settings.SettingsRepository
+	threadQuerier  *thread_querier.Querier
+	accountQuerier *account_querier.Querier
+	reportQuerier  *report_querier.Querier
+	client         *http.Client
+}
+
+func newNotifier(
+	cfg config.Config,
+	logger *slog.Logger,
+	settings *settings.SettingsRepository,
+	threadQuerier *thread_querier.Querier,
+	accountQuerier *account_querier.Querier,
+	reportQuerier *report_querier.Querier,
+) *notifier {
+	return &notifier{
+		logger:         logger,
+		address:        cfg.PublicWebAddress,
+		settings:       settings,
+		threadQuerier:  threadQuerier,
+		accountQuerier: accountQuerier,
+		reportQuerier:  reportQuerier,
+		client:         &http.Client{Timeout: requestTimeout, Transport: outbound.Transport(nil)},
+	}
+}
+
+func runChatNotifyJob(
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	n *notifier,
+) {
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if _, err := pubsub.Subscribe(hctx, bus, "chat_notify.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			thr, err := n.threadQuerier.Get(ctx, evt.ID, pagination.Parameters{}, nil)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			footer := fmt.Sprintf("New thread by %s", thr.Author.Name)
+			cat := opt.Map(thr.Category, func(c category.Category) category.CategoryID { return c.ID })
+			thr.Category.Call(func(c category.Category) { footer += " in " + c.Name })
+
+			return n.send(ctx, chat_notify.TriggerThreadCreated, cat, Message{
+				Title:       thr.Title,
+				URL:         n.address.JoinPath("t", mark.NewMark(xid.ID(thr.ID), thr.Slug).String()).String(),
+				Description: thr.Short,
+				Footer:      footer,
+			})
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "chat_notify.account_created", func(ctx context.Context, evt *message.EventAccountCreated) error {
+			acc, err := n.accountQuerier.GetByID(ctx, evt.ID)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return n.send(ctx, chat_notify.TriggerMemberJoined, opt.NewEmpty[category.CategoryID](), Message{
+				Title:  fmt.Sprintf("%s (@%s) joined", acc.Name, acc.Handle),
+				URL:    n.address.JoinPath("m", acc.Handle).String(),
+				Footer: "New member",
+			})
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "chat_notify.report_created", func(ctx context.Context, evt *message.EventReportCreated) error {
+			r, err := n.reportQuerier.Get(ctx, evt.ID)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			title := fmt.Sprintf("%s reported a %s", r.ReportedBy.Name, r.TargetItemKind)
+			if r.TargetItem != nil {
+				title = fmt.Sprintf("%s reported %s %q", r.ReportedBy.Name, r.TargetItemKind, r.TargetItem.GetName())
+			}
+
+			return n.send(ctx, chat_notify.TriggerReportFiled, opt.NewEmpty[category.CategoryID](), Message{
+				Title:       title,
+				URL:         n.address.JoinPath("reports").String(),
+				Description: r.Comment.OrZero(),
+				Footer:      "New report",
+			})
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	}))
+}
+
+func (n *notifier) send(ctx context.Context, t chat_notify.Trigger, cat opt.Optional[category.CategoryID], m Message) error {
+	s, err := n.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, ch := range s.ChatNotifications.OrZero().Channels {
+		if !ch.Wants(t, cat) {
+			continue
+		}
+
+		if err := n.post(ctx, ch, m); err != nil {
+			n.logger.Warn("failed to send chat notification",
+				slog.String("channel", ch.Name),
+				slog.String("provider", ch.Provider.String()),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (n *notifier) post(ctx context.Context, ch chat_notify.Channel, m Message) error {
+	body, err := Encode(ch.Provider, m)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ch.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fault.Newf("channel responded with %d: %s", resp.StatusCode, b)
+	}
+
+	return nil
+}
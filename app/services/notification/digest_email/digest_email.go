@@ -0,0 +1,252 @@
+// Package digest_email periodically emails members who have opted in a summary
+// of their unread notifications, busy threads in the categories they follow
+// and pages newly published to the library.
+package digest_email
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/matcornic/hermes/v2"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification/digest"
+	"github.com/Southclaws/storyden/app/resources/job"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/app/services/comms/mailtemplate"
+	"github.com/Southclaws/storyden/app/services/job_queue"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/i18n"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New, NewUnsubscriber),
+		fx.Invoke(runDigestJob),
+	)
+}
+
+// JobSendDigest is the kind of job which composes and sends one member's digest.
+const JobSendDigest = "digest.send"
+
+var (
+	DefaultSchedule   = "@hourly"
+	DefaultMaxThreads = 5
+	DefaultMaxPages   = 5
+)
+
+type Digester struct {
+	logger         *slog.Logger
+	address        url.URL
+	enabled        bool
+	digests        *digest.Repository
+	content        *digest.Querier
+	accountQuerier *account_querier.Querier
+	locales        *locale.Resolver
+	mailqueue      *mailqueue.Queuer
+	unsubscriber   *Unsubscriber
+	jobs           *job_queue.Queue
+}
+
+type sendArgs struct {
+	AccountID string
+	Frequency string
+	Since     time.Time
+	Now       time.Time
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	digests *digest.Repository,
+	content *digest.Querier,
+	accountQuerier *account_querier.Querier,
+	locales *locale.Resolver,
+	mailqueue *mailqueue.Queuer,
+	unsubscriber *Unsubscriber,
+	jobs *job_queue.Queue,
+) *Digester {
+	d := &Digester{
+		logger:         logger,
+		address:        cfg.PublicWebAddress,
+		enabled:        cfg.EmailProvider != "",
+		digests:        digests,
+		content:        content,
+		accountQuerier: accountQuerier,
+		locales:        locales,
+		mailqueue:      mailqueue,
+		unsubscriber:   unsubscriber,
+		jobs:           jobs,
+	}
+
+	job_queue.Register(jobs, JobSendDigest, func(ctx context.Context, args sendArgs) error {
+		id, err := xid.FromString(args.AccountID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		f, err := digest.NewFrequency(args.Frequency)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return d.Send(ctx, account.AccountID(id), f, args.Since, args.Now)
+	})
+
+	return d
+}
+
+func runDigestJob(sched *scheduler.Scheduler, d *Digester) {
+	if !d.enabled {
+		return
+	}
+
+	sched.Register("digest_email", DefaultSchedule, func(ctx context.Context) error {
+		d.Run(ctx, time.Now())
+		return nil
+	})
+}
+
+// Run queues a job to send every digest which is due at the given time. Each
+// member's digest is a separate job so failures are retried independently and
+// a member is never queued twice while their digest is still being sent.
+func (d *Digester) Run(ctx context.Context, now time.Time) {
+	defaultTimezone, err := d.locales.DefaultTimezone(ctx)
+	if err != nil {
+		d.logger.Error("failed to get default timezone", slog.String("error", err.Error()))
+		return
+	}
+
+	for _, f := range []digest.Frequency{digest.FrequencyDaily, digest.FrequencyWeekly} {
+		due, err := d.digests.ListDue(ctx, f, now, defaultTimezone)
+		if err != nil {
+			d.logger.Error("failed to list due digests", slog.String("frequency", f.String()), slog.String("error", err.Error()))
+			continue
+		}
+
+		for _, m := range due {
+			_, err := job_queue.Enqueue(ctx, d.jobs, JobSendDigest, sendArgs{
+				AccountID: m.AccountID.String(),
+				Frequency: f.String(),
+				Since:     m.LastSentAt,
+				Now:       now,
+			}, job.WithUniqueKey(JobSendDigest+":"+m.AccountID.String()))
+			if err != nil {
+				d.logger.Warn("failed to queue digest",
+					slog.String("account_id", m.AccountID.String()),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// Send composes and queues a single member's digest covering activity since
+// the given time. Nothing is sent when there is nothing to report but the
+// member is still marked as sent so the next digest covers a fresh interval.
+func (d *Digester) Send(ctx context.Context, accountID account.AccountID, f digest.Frequency, since, now time.Time) error {
+	acc, err := d.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	unread, err := d.content.CountUnread(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threads, err := d.content.TopThreads(ctx, accountID, since, DefaultMaxThreads)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	pages, err := d.content.NewPages(ctx, since, DefaultMaxPages)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	address, hasAddress := lo.Find(acc.EmailAddresses, func(a *account.EmailAddress) bool { return a.Verified })
+
+	if hasAddress && (unread > 0 || len(threads) > 0 || len(pages) > 0) {
+		token, err := d.unsubscriber.Token(ctx, accountID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		ctx, err = d.locales.ForAccount(ctx, &acc.Account)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		subject := lo.Ternary(f == digest.FrequencyWeekly, i18n.T(ctx, "Your weekly summary"), i18n.T(ctx, "Your daily summary"))
+		intros, actions := d.compose(ctx, f, since, unread, threads, pages, token)
+
+		err = d.mailqueue.Queue(ctx, address.Email, acc.Name, subject, intros, actions)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return d.digests.MarkSent(ctx, accountID, now)
+}
+
+func (d *Digester) compose(ctx context.Context, f digest.Frequency, since time.Time, unread int, threads []digest.Thread, pages []digest.Page, token string) ([]string, []mailtemplate.Action) {
+	intros := []string{
+		i18n.T(ctx, "Here's what's new since {date}.", "date", i18n.DateTime(ctx, since)),
+	}
+	actions := []mailtemplate.Action{}
+
+	if unread > 0 {
+		intros = append(intros, i18n.N(ctx, unread, "You have {count} unread notification.", "You have {count} unread notifications."))
+		actions = append(actions, mailtemplate.Action{
+			Button: hermes.Button{
+				Text: i18n.T(ctx, "View notifications"),
+				Link: d.address.JoinPath("notifications").String(),
+			},
+		})
+	}
+
+	if len(threads) > 0 {
+		intros = append(intros, i18n.T(ctx, "Popular in categories you follow:"))
+		for _, t := range threads {
+			link := d.address.JoinPath("t", mark.NewMark(t.ID, t.Slug).String()).String()
+			replies := i18n.N(ctx, t.Replies, "{count} reply", "{count} replies")
+			intros = append(intros, fmt.Sprintf("%s (%s) %s", t.Title, replies, link))
+		}
+	}
+
+	if len(pages) > 0 {
+		intros = append(intros, i18n.T(ctx, "New in the library:"))
+		for _, p := range pages {
+			intros = append(intros, fmt.Sprintf("%s %s", p.Name, d.address.JoinPath("l", p.Slug).String()))
+		}
+	}
+
+	unsubscribe := d.address.JoinPath("unsubscribe")
+	unsubscribe.RawQuery = url.Values{"token": {token}}.Encode()
+
+	actions = append(actions, mailtemplate.Action{
+		Instructions: lo.Ternary(f == digest.FrequencyWeekly,
+			i18n.T(ctx, "You're receiving this because you chose a weekly summary, you can change this in your settings."),
+			i18n.T(ctx, "You're receiving this because you chose a daily summary, you can change this in your settings."),
+		),
+		Button: hermes.Button{
+			Text: i18n.T(ctx, "Unsubscribe"),
+			Link: unsubscribe.String(),
+		},
+	})
+
+	return intros, actions
+}
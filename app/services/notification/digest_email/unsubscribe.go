@@ -0,0 +1,72 @@
+package digest_email
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/notification/digest"
+	"github.com/Southclaws/storyden/internal/infrastructure/endec"
+)
+
+var errInvalidToken = fault.New("invalid unsubscribe token", ftag.With(ftag.InvalidArgument))
+
+// The claim key is deliberately distinct from other account tokens so a link
+// from a digest email can never be replayed as, say, a password reset token.
+const (
+	unsubscribeAccountIDKey = "digest_unsubscribe_account_id"
+	unsubscribeLifespan     = 90 * 24 * time.Hour
+)
+
+type Unsubscriber struct {
+	endec   endec.EncrypterDecrypter
+	digests *digest.Repository
+}
+
+func NewUnsubscriber(endec endec.EncrypterDecrypter, digests *digest.Repository) *Unsubscriber {
+	return &Unsubscriber{endec: endec, digests: digests}
+}
+
+func (u *Unsubscriber) Token(ctx context.Context, accountID account.AccountID) (string, error) {
+	if u.endec == nil {
+		return "", fault.New("unsubscribe tokens require JWT_SECRET to be set")
+	}
+
+	token, err := u.endec.Encrypt(endec.Claims{
+		unsubscribeAccountIDKey: accountID.String(),
+	}, unsubscribeLifespan)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return token, nil
+}
+
+func (u *Unsubscriber) Unsubscribe(ctx context.Context, token string) error {
+	if u.endec == nil {
+		return fault.Wrap(errInvalidToken, fctx.With(ctx))
+	}
+
+	claims, err := u.endec.Decrypt(token)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument), fmsg.WithDesc("invalid token", "This unsubscribe link is invalid or has expired."))
+	}
+
+	raw, ok := claims[unsubscribeAccountIDKey].(string)
+	if !ok {
+		return fault.Wrap(errInvalidToken, fctx.With(ctx))
+	}
+
+	id, err := xid.FromString(raw)
+	if err != nil {
+		return fault.Wrap(errInvalidToken, fctx.With(ctx))
+	}
+
+	return u.digests.Set(ctx, account.AccountID(id), digest.FrequencyNever)
+}
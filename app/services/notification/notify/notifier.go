@@ -11,6 +11,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/notification"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
@@ -22,13 +23,28 @@ func New(bus *pubsub.Bus) *Notifier {
 	return &Notifier{bus: bus}
 }
 
-func (n *Notifier) Send(ctx context.Context, targetID account.AccountID, sourceID opt.Optional[account.AccountID], event notification.Event, item *datagraph.Ref) error {
-	if err := n.bus.SendCommand(ctx, &message.CommandSendNotification{
+type Option func(*message.CommandSendNotification)
+
+// WithPost includes the post's content in full when the notification is
+// emailed, rather than only a link to it.
+func WithPost(id post.ID) Option {
+	return func(c *message.CommandSendNotification) {
+		c.PostID = opt.New(id)
+	}
+}
+
+func (n *Notifier) Send(ctx context.Context, targetID account.AccountID, sourceID opt.Optional[account.AccountID], event notification.Event, item *datagraph.Ref, opts ...Option) error {
+	cmd := &message.CommandSendNotification{
 		Event:    event,
 		Item:     item,
 		TargetID: targetID,
 		SourceID: sourceID,
-	}); err != nil {
+	}
+	for _, o := range opts {
+		o(cmd)
+	}
+
+	if err := n.bus.SendCommand(ctx, cmd); err != nil {
 		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to publish notification command"))
 	}
 	return nil
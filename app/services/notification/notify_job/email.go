@@ -0,0 +1,180 @@
+package notify_job
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/matcornic/hermes/v2"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/app/services/comms/mailtemplate"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/app/services/reply/reply_email"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/i18n"
+)
+
+type emailer struct {
+	enabled        bool
+	address        url.URL
+	accountQuerier *account_querier.Querier
+	replies        reply.Repository
+	mailbox        *reply_email.Mailbox
+	locales        *locale.Resolver
+	mailqueue      *mailqueue.Queuer
+}
+
+func newEmailer(
+	cfg config.Config,
+	accountQuerier *account_querier.Querier,
+	replies reply.Repository,
+	mailbox *reply_email.Mailbox,
+	locales *locale.Resolver,
+	mailqueue *mailqueue.Queuer,
+) *emailer {
+	return &emailer{
+		enabled:        cfg.EmailProvider != "",
+		address:        cfg.PublicWebAddress,
+		accountQuerier: accountQuerier,
+		replies:        replies,
+		mailbox:        mailbox,
+		locales:        locales,
+		mailqueue:      mailqueue,
+	}
+}
+
+func (e *emailer) send(ctx context.Context, targetID account.AccountID, sourceID opt.Optional[account.AccountID], event notification.Event, postID opt.Optional[post.ID]) error {
+	if !e.enabled {
+		return nil
+	}
+
+	target, err := e.accountQuerier.GetByID(ctx, targetID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	address, ok := lo.Find(target.EmailAddresses, func(a *account.EmailAddress) bool { return a.Verified })
+	if !ok {
+		return nil
+	}
+
+	ctx, err = e.locales.ForAccount(ctx, &target.Account)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if id, ok := postID.Get(); ok && event == notification.EventThreadReply {
+		return e.sendReply(ctx, target, address.Email, id)
+	}
+
+	source, err := sourceName(ctx, e.accountQuerier, sourceID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	subject := describe(ctx, event, source)
+	link := e.address.JoinPath("notifications").String()
+
+	return e.mailqueue.Queue(ctx, address.Email, target.Name, subject, []string{subject}, []mailtemplate.Action{
+		{
+			Instructions: i18n.T(ctx, "You can change which notifications are emailed to you in your settings."),
+			Button: hermes.Button{
+				Text: i18n.T(ctx, "View notifications"),
+				Link: link,
+			},
+		},
+	})
+}
+
+// sendReply emails the reply in full so it can be read without leaving the
+// inbox and, when replying by email is enabled, answered from it too.
+func (e *emailer) sendReply(ctx context.Context, target *account.AccountWithEdges, address mail.Address, replyID post.ID) error {
+	r, err := e.replies.Get(ctx, replyID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	subject := i18n.T(ctx, "{name} replied to {title}", "name", r.Author.Name, "title", r.RootThreadTitle)
+	intros := append([]string{subject + ":"}, r.Content.Paragraphs()...)
+
+	link := e.address.JoinPath("t", r.RootThreadMark)
+	link.Fragment = r.ID.String()
+
+	instructions := i18n.T(ctx, "You can change which notifications are emailed to you in your settings.")
+	opts := []mailqueue.Option{}
+	if replyTo, ok := e.mailbox.Address(target.ID, r.RootPostID).Get(); ok {
+		instructions = i18n.T(ctx, "Reply to this email to post your reply in the thread. You can change which notifications are emailed to you in your settings.")
+		opts = append(opts, mailqueue.WithReplyTo(replyTo))
+	}
+
+	return e.mailqueue.Queue(ctx, address, target.Name, subject, intros, []mailtemplate.Action{
+		{
+			Instructions: instructions,
+			Button: hermes.Button{
+				Text: i18n.T(ctx, "View reply"),
+				Link: link.String(),
+			},
+		},
+	}, opts...)
+}
+
+func sourceName(ctx context.Context, accountQuerier *account_querier.Querier, sourceID opt.Optional[account.AccountID]) (string, error) {
+	id, ok := sourceID.Get()
+	if !ok {
+		return i18n.T(ctx, "Someone"), nil
+	}
+
+	acc, err := accountQuerier.GetByID(ctx, id)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return acc.Name, nil
+}
+
+func describe(ctx context.Context, event notification.Event, source string) string {
+	switch event {
+	case notification.EventThreadReply:
+		return i18n.T(ctx, "{name} replied to your thread", "name", source)
+	case notification.EventPostLike:
+		return i18n.T(ctx, "{name} liked your post", "name", source)
+	case notification.EventFollow:
+		return i18n.T(ctx, "{name} followed you", "name", source)
+	case notification.EventProfileMention:
+		return i18n.T(ctx, "{name} mentioned you", "name", source)
+	case notification.EventEventHostAdded:
+		return i18n.T(ctx, "{name} added you as an event host", "name", source)
+	case notification.EventMemberAttendingEvent:
+		return i18n.T(ctx, "{name} is attending your event", "name", source)
+	case notification.EventMemberDeclinedEvent:
+		return i18n.T(ctx, "{name} declined your event", "name", source)
+	case notification.EventAttendeeRemoved:
+		return i18n.T(ctx, "You were removed from an event")
+	case notification.EventReportSubmitted:
+		return i18n.T(ctx, "A new report was submitted")
+	case notification.EventReportUpdated:
+		return i18n.T(ctx, "A report you're involved in was updated")
+	case notification.EventReportEscalated:
+		return i18n.T(ctx, "A report was escalated to administrators")
+	case notification.EventFollowedThread:
+		return i18n.T(ctx, "There's new activity in a thread you follow")
+	case notification.EventDirectMessage:
+		return i18n.T(ctx, "{name} sent you a message", "name", source)
+	case notification.EventAssetFlagged:
+		return i18n.T(ctx, "A file uploaded by {name} was flagged by the malware scanner", "name", source)
+	case notification.EventApplicationSubmitted:
+		return i18n.T(ctx, "{name} applied to join and is waiting for approval", "name", source)
+	default:
+		return i18n.T(ctx, "You have a new notification")
+	}
+}
@@ -2,25 +2,52 @@ package notify_job
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/opt"
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_pref"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
 type notifyConsumer struct {
+	logger       *slog.Logger
 	notifyWriter *notify_writer.Writer
+	prefs        *notify_pref.Repository
+	blockQuerier *block_querier.Querier
+	emailer      *emailer
+	pusher       *pusher
+	telegramer   *telegramer
+	bus          *pubsub.Bus
 }
 
 func newNotifyConsumer(
+	logger *slog.Logger,
 	notifyWriter *notify_writer.Writer,
+	prefs *notify_pref.Repository,
+	blockQuerier *block_querier.Querier,
+	emailer *emailer,
+	pusher *pusher,
+	telegramer *telegramer,
+	bus *pubsub.Bus,
 ) *notifyConsumer {
 	return &notifyConsumer{
+		logger:       logger,
 		notifyWriter: notifyWriter,
+		prefs:        prefs,
+		blockQuerier: blockQuerier,
+		emailer:      emailer,
+		pusher:       pusher,
+		telegramer:   telegramer,
+		bus:          bus,
 	}
 }
 
@@ -29,15 +56,73 @@ func (s *notifyConsumer) notify(ctx context.Context,
 	sourceID opt.Optional[account.AccountID],
 	event notification.Event,
 	item *datagraph.Ref,
+	postID opt.Optional[post.ID],
 ) error {
-	itemref := opt.Map(opt.NewPtr(item), func(i datagraph.Ref) datagraph.ItemRef {
-		return &i
-	})
+	// Nothing a blocked member does reaches the member who blocked them, which
+	// is also what stops them mentioning or replying to get their attention.
+	if source, ok := sourceID.Get(); ok {
+		blocked, err := s.blockQuerier.IsBlocked(ctx, targetID, source)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if blocked {
+			return nil
+		}
+	}
 
-	_, err := s.notifyWriter.Notification(ctx, targetID, event, itemref, sourceID)
+	channels, err := s.prefs.Resolve(ctx, targetID, event)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if channels.InApp {
+		itemref := opt.Map(opt.NewPtr(item), func(i datagraph.Ref) datagraph.ItemRef {
+			return &i
+		})
+
+		n, err := s.notifyWriter.Notification(ctx, targetID, event, itemref, sourceID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		s.bus.Publish(ctx, &message.EventNotificationCreated{
+			ID:       n.ID,
+			Event:    event,
+			TargetID: targetID,
+		})
+	}
+
+	// Out-of-band channels are best-effort, a failed delivery must not cause the
+	// command to be retried and the in-app notification written twice.
+	if channels.Email {
+		if err := s.emailer.send(ctx, targetID, sourceID, event, postID); err != nil {
+			s.logger.Warn("failed to email notification",
+				slog.String("error", err.Error()),
+				slog.String("event", event.String()),
+				slog.String("account_id", targetID.String()),
+			)
+		}
+	}
+
+	if channels.WebPush {
+		if err := s.pusher.send(ctx, targetID, sourceID, event); err != nil {
+			s.logger.Warn("failed to push notification",
+				slog.String("error", err.Error()),
+				slog.String("event", event.String()),
+				slog.String("account_id", targetID.String()),
+			)
+		}
+	}
+
+	if channels.Telegram {
+		if err := s.telegramer.send(ctx, targetID, sourceID, event, postID); err != nil {
+			s.logger.Warn("failed to send telegram notification",
+				slog.String("error", err.Error()),
+				slog.String("event", event.String()),
+				slog.String("account_id", targetID.String()),
+			)
+		}
+	}
+
 	return nil
 }
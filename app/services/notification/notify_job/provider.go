@@ -8,7 +8,7 @@ import (
 
 func Build() fx.Option {
 	return fx.Options(
-		fx.Provide(newNotifyConsumer),
+		fx.Provide(newNotifyConsumer, newEmailer, newPusher, newTelegramer),
 		fx.Invoke(runNotifyConsumer),
 
 		fx.Provide(notify.New),
@@ -0,0 +1,126 @@
+package notify_job
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/notification/push_subscription"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/webpush"
+)
+
+type pushPayload struct {
+	Title string `json:"title"`
+	Event string `json:"event"`
+	URL   string `json:"url"`
+}
+
+type pusher struct {
+	logger         *slog.Logger
+	address        url.URL
+	sender         *webpush.Sender
+	subscriptions  *push_subscription.Repository
+	accountQuerier *account_querier.Querier
+	locales        *locale.Resolver
+}
+
+func newPusher(
+	cfg config.Config,
+	logger *slog.Logger,
+	sender *webpush.Sender,
+	subscriptions *push_subscription.Repository,
+	accountQuerier *account_querier.Querier,
+	locales *locale.Resolver,
+) *pusher {
+	return &pusher{
+		logger:         logger,
+		address:        cfg.PublicWebAddress,
+		sender:         sender,
+		subscriptions:  subscriptions,
+		accountQuerier: accountQuerier,
+		locales:        locales,
+	}
+}
+
+func (p *pusher) send(ctx context.Context, targetID account.AccountID, sourceID opt.Optional[account.AccountID], event notification.Event) error {
+	if p.sender == nil {
+		return nil
+	}
+
+	subs, err := p.subscriptions.List(ctx, targetID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	ctx, err = p.locales.ForAccountID(ctx, targetID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	source, err := sourceName(ctx, p.accountQuerier, sourceID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	payload, err := json.Marshal(pushPayload{
+		Title: describe(ctx, event, source),
+		Event: event.String(),
+		URL:   p.address.JoinPath("notifications").String(),
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	now := time.Now()
+	for _, sub := range subs {
+		if sub.Expired(now) {
+			p.prune(ctx, sub)
+			continue
+		}
+
+		ws, err := webpush.ParseSubscription(sub.Endpoint, sub.P256DH, sub.Auth)
+		if err != nil {
+			p.prune(ctx, sub)
+			continue
+		}
+
+		if err := p.sender.Send(ctx, ws, payload); err != nil {
+			if ftag.Get(err) == ftag.NotFound {
+				p.prune(ctx, sub)
+				continue
+			}
+
+			// One device failing must not stop delivery to the member's others.
+			p.logger.Warn("failed to deliver push notification",
+				slog.String("error", err.Error()),
+				slog.String("subscription_id", sub.ID.String()),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (p *pusher) prune(ctx context.Context, sub *push_subscription.Subscription) {
+	if err := p.subscriptions.Prune(ctx, sub.ID); err != nil {
+		p.logger.Warn("failed to prune push subscription",
+			slog.String("error", err.Error()),
+			slog.String("subscription_id", sub.ID.String()),
+		)
+	}
+}
@@ -0,0 +1,114 @@
+package notify_job
+
+import (
+	"context"
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/app/services/telegram_bot"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/i18n"
+)
+
+// Long replies are cut short well within Telegram's message limit, the rest is
+// a click away.
+const maxTelegramReplyLength = 1000
+
+type telegramer struct {
+	address        url.URL
+	bot            *telegram_bot.Bot
+	accountQuerier *account_querier.Querier
+	replies        reply.Repository
+	locales        *locale.Resolver
+}
+
+func newTelegramer(
+	cfg config.Config,
+	bot *telegram_bot.Bot,
+	accountQuerier *account_querier.Querier,
+	replies reply.Repository,
+	locales *locale.Resolver,
+) *telegramer {
+	return &telegramer{
+		address:        cfg.PublicWebAddress,
+		bot:            bot,
+		accountQuerier: accountQuerier,
+		replies:        replies,
+		locales:        locales,
+	}
+}
+
+func (t *telegramer) send(ctx context.Context, targetID account.AccountID, sourceID opt.Optional[account.AccountID], event notification.Event, postID opt.Optional[post.ID]) error {
+	if !t.bot.Enabled() {
+		return nil
+	}
+
+	ctx, err := t.locales.ForAccountID(ctx, targetID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if id, ok := postID.Get(); ok && event == notification.EventThreadReply {
+		return t.sendReply(ctx, targetID, id)
+	}
+
+	source, err := sourceName(ctx, t.accountQuerier, sourceID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	text := html.EscapeString(describe(ctx, event, source)) + "\n\n" +
+		telegramLink(t.address.JoinPath("notifications").String(), i18n.T(ctx, "View notifications"))
+
+	return t.bot.Notify(ctx, targetID, telegram_bot.Notification{Text: text})
+}
+
+// sendReply includes the reply itself so it can be read and answered without
+// leaving the chat.
+func (t *telegramer) sendReply(ctx context.Context, targetID account.AccountID, replyID post.ID) error {
+	r, err := t.replies.Get(ctx, replyID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	link := t.address.JoinPath("t", r.RootThreadMark)
+	link.Fragment = r.ID.String()
+
+	body := truncate(strings.Join(r.Content.Paragraphs(), "\n\n"), maxTelegramReplyLength)
+
+	text := "<b>" + html.EscapeString(i18n.T(ctx, "{name} replied to {title}", "name", r.Author.Name, "title", r.RootThreadTitle)) + "</b>\n\n" +
+		html.EscapeString(body) + "\n\n" +
+		telegramLink(link.String(), i18n.T(ctx, "View reply")) + "\n" +
+		"<i>" + html.EscapeString(i18n.T(ctx, "Reply to this message to post your reply in the thread.")) + "</i>"
+
+	return t.bot.Notify(ctx, targetID, telegram_bot.Notification{
+		Text: text,
+		ReplyTo: opt.New(telegram_bot.ReplyTarget{
+			PostID:   r.ID,
+			ThreadID: r.RootPostID,
+		}),
+	})
+}
+
+func telegramLink(href string, text string) string {
+	return "<a href=\"" + html.EscapeString(href) + "\">" + html.EscapeString(text) + "</a>"
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
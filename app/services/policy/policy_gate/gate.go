@@ -0,0 +1,57 @@
+// Package policy_gate withholds posting permissions from members who have not
+// accepted the current version of every required policy document.
+package policy_gate
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/policy/policy_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+)
+
+var errAcceptanceRequired = fault.New("policy acceptance required", ftag.With(ftag.PermissionDenied))
+
+// gated are the permissions used to contribute to the community.
+var gated = []rbac.Permission{
+	rbac.PermissionCreatePost,
+	rbac.PermissionCreateReaction,
+	rbac.PermissionSubmitLibraryNode,
+}
+
+type Gate struct {
+	querier *policy_querier.Querier
+}
+
+func New(querier *policy_querier.Querier) *Gate {
+	return &Gate{querier: querier}
+}
+
+func (g *Gate) Check(ctx context.Context, accountID account.AccountID, perm rbac.Permission) error {
+	if !slices.Contains(gated, perm) {
+		return nil
+	}
+
+	statuses, err := g.querier.Statuses(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, s := range statuses {
+		if s.Pending() {
+			return fault.Wrap(errAcceptanceRequired,
+				fctx.With(ctx),
+				fmsg.WithDesc("acceptance required",
+					"Please review and accept the latest "+s.Document.Title+" before posting."),
+			)
+		}
+	}
+
+	return nil
+}
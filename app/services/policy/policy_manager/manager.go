@@ -0,0 +1,251 @@
+// Package policy_manager publishes versions of documents such as the terms of
+// service and records members accepting them.
+package policy_manager
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/policy"
+	"github.com/Southclaws/storyden/app/resources/policy/policy_querier"
+	"github.com/Southclaws/storyden/app/resources/policy/policy_writer"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrInvalidSlug      = fault.New("invalid policy slug", ftag.With(ftag.InvalidArgument))
+	ErrAlreadyPublished = fault.New("version already published", ftag.With(ftag.InvalidArgument))
+	ErrNotCurrent       = fault.New("version is not current", ftag.With(ftag.InvalidArgument))
+)
+
+type Manager struct {
+	querier *policy_querier.Querier
+	writer  *policy_writer.Writer
+	bus     *pubsub.Bus
+}
+
+func New(
+	querier *policy_querier.Querier,
+	writer *policy_writer.Writer,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		querier: querier,
+		writer:  writer,
+		bus:     bus,
+	}
+}
+
+type Partial struct {
+	Title    opt.Optional[string]
+	Required opt.Optional[bool]
+}
+
+func (p Partial) Opts() []policy_writer.Option {
+	opts := []policy_writer.Option{}
+
+	p.Title.Call(func(v string) { opts = append(opts, policy_writer.WithTitle(v)) })
+	p.Required.Call(func(v bool) { opts = append(opts, policy_writer.WithRequired(v)) })
+
+	return opts
+}
+
+// List returns the documents which have been published, staff who manage the
+// instance's settings also see documents which have no published version.
+func (m *Manager) List(ctx context.Context) ([]*policy.Document, error) {
+	docs, err := m.querier.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if session.Authorise(ctx, nil, rbac.PermissionManageSettings) == nil {
+		return docs, nil
+	}
+
+	return dt.Filter(docs, func(d *policy.Document) bool { return d.Current.Ok() }), nil
+}
+
+func (m *Manager) Get(ctx context.Context, slug string) (*policy.Document, error) {
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return doc, nil
+}
+
+func (m *Manager) Create(ctx context.Context, slug string, title string, partial Partial) (*policy.Document, error) {
+	s := mark.Slugify(slug)
+	if s == "" {
+		return nil, fault.Wrap(ErrInvalidSlug, fctx.With(ctx),
+			fmsg.WithDesc("invalid slug", "The policy's slug must contain at least one letter or number."))
+	}
+
+	if _, err := m.writer.Create(ctx, s, title, partial.Opts()...); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.Get(ctx, s)
+}
+
+func (m *Manager) Update(ctx context.Context, slug string, partial Partial) (*policy.Document, error) {
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.Update(ctx, doc.ID, partial.Opts()...); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.Get(ctx, slug)
+}
+
+// Delete removes the document along with every version and acceptance record.
+func (m *Manager) Delete(ctx context.Context, slug string) error {
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.Delete(ctx, doc.ID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) Versions(ctx context.Context, slug string) ([]*policy.Version, error) {
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	versions, err := m.querier.ListVersions(ctx, doc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return versions, nil
+}
+
+// Draft adds a new unpublished version, members aren't asked to accept it
+// until it's published.
+func (m *Manager) Draft(ctx context.Context, slug string, content datagraph.Content, summary opt.Optional[string]) (*policy.Version, error) {
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	v, err := m.writer.CreateVersion(ctx, doc.ID, content, summary)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return v, nil
+}
+
+// Publish makes the version current. If the document is required, members
+// must accept it before they can post again.
+func (m *Manager) Publish(ctx context.Context, slug string, versionID policy.VersionID) (*policy.Document, error) {
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	v, err := m.querier.GetVersion(ctx, doc.ID, versionID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if v.Published() {
+		return nil, fault.Wrap(ErrAlreadyPublished, fctx.With(ctx),
+			fmsg.WithDesc("already published", "This version has already been published."))
+	}
+
+	if cur, ok := doc.Current.Get(); ok && cur.Version > v.Version {
+		return nil, fault.Wrap(ErrNotCurrent, fctx.With(ctx),
+			fmsg.WithDesc("outdated", "A newer version of this policy has already been published."))
+	}
+
+	if err := m.writer.Publish(ctx, v.ID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventPolicyPublished{
+		DocumentID: doc.ID,
+		VersionID:  v.ID,
+	})
+
+	return m.Get(ctx, slug)
+}
+
+func (m *Manager) Acceptances(ctx context.Context, slug string, versionID policy.VersionID) ([]*policy.Acceptance, error) {
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.querier.GetVersion(ctx, doc.ID, versionID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acceptances, err := m.querier.ListAcceptances(ctx, versionID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return acceptances, nil
+}
+
+// Own returns the session account's standing with every published document.
+func (m *Manager) Own(ctx context.Context) ([]*policy.Status, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	statuses, err := m.querier.Statuses(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return statuses, nil
+}
+
+// Accept records the session account accepting the version, which must be
+// the document's current version so members can't accept outdated terms.
+func (m *Manager) Accept(ctx context.Context, slug string, versionID policy.VersionID) ([]*policy.Status, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	doc, err := m.querier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	cur, ok := doc.Current.Get()
+	if !ok || cur.ID != versionID {
+		return nil, fault.Wrap(ErrNotCurrent, fctx.With(ctx),
+			fmsg.WithDesc("not current", "This isn't the current version of the policy, please review the latest version."))
+	}
+
+	if err := m.writer.Accept(ctx, accountID, versionID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.Own(ctx)
+}
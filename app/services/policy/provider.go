@@ -0,0 +1,15 @@
+package policy
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/policy/policy_gate"
+	"github.com/Southclaws/storyden/app/services/policy/policy_manager"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(policy_manager.New),
+		fx.Provide(policy_gate.New),
+	)
+}
@@ -0,0 +1,98 @@
+package blocking
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile/block"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/block_writer"
+	"github.com/Southclaws/storyden/app/resources/profile/follow_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var ErrSelf = fault.New("cannot block self", ftag.With(ftag.InvalidArgument))
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type BlockManager struct {
+	blockQuerier *block_querier.Querier
+	blockWriter  *block_writer.Writer
+	followWriter *follow_writer.Writer
+}
+
+func New(
+	blockQuerier *block_querier.Querier,
+	blockWriter *block_writer.Writer,
+	followWriter *follow_writer.Writer,
+) *BlockManager {
+	return &BlockManager{
+		blockQuerier: blockQuerier,
+		blockWriter:  blockWriter,
+		followWriter: followWriter,
+	}
+}
+
+func (m *BlockManager) List(ctx context.Context) ([]*block.Block, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	blocks, err := m.blockQuerier.List(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return blocks, nil
+}
+
+// Set blocks or mutes the target. Blocking also ends any follow between the
+// two members so neither keeps seeing the other's activity.
+func (m *BlockManager) Set(ctx context.Context, targetID account.AccountID, kind block.Kind) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if accountID == targetID {
+		return fault.Wrap(ErrSelf, fctx.With(ctx),
+			fmsg.WithDesc("cannot block self", "You can't block or mute yourself."))
+	}
+
+	if err := m.blockWriter.Set(ctx, accountID, targetID, kind); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if kind == block.KindBlock {
+		if err := m.followWriter.Unfollow(ctx, accountID, targetID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if err := m.followWriter.Unfollow(ctx, targetID, accountID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (m *BlockManager) Remove(ctx context.Context, targetID account.AccountID) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.blockWriter.Remove(ctx, accountID, targetID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package follow_notify
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/follow_querier"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(func(
+		ctx context.Context,
+		lc fx.Lifecycle,
+		logger *slog.Logger,
+		bus *pubsub.Bus,
+		followQuerier *follow_querier.Querier,
+		accountQuerier *account_querier.Querier,
+		threadQuerier *thread_querier.Querier,
+		notifier *notify.Notifier,
+	) {
+		consumer := func(hctx context.Context) error {
+			_, err := pubsub.Subscribe(hctx, bus, "follow_notify.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+				subs, err := followQuerier.GetThreadSubscribers(ctx, evt.ID)
+				if err != nil {
+					return err
+				}
+
+				for _, id := range subs.Subscribers {
+					acc, err := accountQuerier.GetByID(ctx, id)
+					if err != nil {
+						logger.Error("failed to get follower", slog.String("error", err.Error()))
+						continue
+					}
+
+					// Followers of an author or tag may not be permitted to
+					// read the category or space the thread was published in.
+					readable, err := threadQuerier.Matches(ctx, evt.ID,
+						thread_querier.IsInReadableCategory(acc.Roles.Roles()),
+						thread_querier.IsReadableBy(opt.New(id)),
+					)
+					if err != nil {
+						logger.Error("failed to check follower can read thread", slog.String("error", err.Error()))
+						continue
+					}
+					if !readable {
+						continue
+					}
+
+					err = notifier.Send(ctx,
+						id,
+						opt.New(subs.AuthorID),
+						notification.EventFollowedThread,
+						&datagraph.Ref{
+							ID:   xid.ID(evt.ID),
+							Kind: datagraph.KindPost,
+						},
+					)
+					if err != nil {
+						logger.Error("failed to notify follower", slog.String("error", err.Error()))
+					}
+				}
+
+				return nil
+			})
+			return err
+		}
+
+		lc.Append(fx.StartHook(consumer))
+	})
+}
@@ -5,24 +5,53 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
 	"github.com/Southclaws/storyden/app/resources/profile/follow_writer"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/app/services/profile/following/follow_notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		follow_notify.Build(),
+	)
+}
+
+var ErrBlocked = fault.New("cannot follow blocked member", ftag.With(ftag.PermissionDenied))
+
 type FollowManager struct {
 	followWriter *follow_writer.Writer
+	blockQuerier *block_querier.Querier
 	notifier     *notify.Notifier
+	bus          *pubsub.Bus
 }
 
-func New(followWriter *follow_writer.Writer, notifier *notify.Notifier) *FollowManager {
-	return &FollowManager{followWriter: followWriter, notifier: notifier}
+func New(followWriter *follow_writer.Writer, blockQuerier *block_querier.Querier, notifier *notify.Notifier, bus *pubsub.Bus) *FollowManager {
+	return &FollowManager{followWriter: followWriter, blockQuerier: blockQuerier, notifier: notifier, bus: bus}
 }
 
 func (f *FollowManager) Follow(ctx context.Context, follower, following account.AccountID) error {
-	err := f.followWriter.Follow(ctx, follower, following)
+	blocked, err := f.blockQuerier.IsBlocked(ctx, follower, following)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if blocked {
+		return fault.Wrap(ErrBlocked, fctx.With(ctx),
+			fmsg.WithDesc("blocked", "You can't follow this member."))
+	}
+
+	err = f.followWriter.Follow(ctx, follower, following)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
@@ -40,3 +69,44 @@ func (f *FollowManager) Unfollow(ctx context.Context, follower, following accoun
 
 	return nil
 }
+
+func (f *FollowManager) FollowTag(ctx context.Context, follower account.AccountID, name tag_ref.Name) error {
+	err := f.followWriter.FollowTag(ctx, follower, name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (f *FollowManager) UnfollowTag(ctx context.Context, follower account.AccountID, name tag_ref.Name) error {
+	err := f.followWriter.UnfollowTag(ctx, follower, name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (f *FollowManager) FollowCategory(ctx context.Context, follower account.AccountID, slug string) error {
+	err := f.followWriter.FollowCategory(ctx, follower, slug)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	f.bus.Publish(ctx, &message.EventCategoryFollowed{
+		AccountID: follower,
+		Slug:      slug,
+	})
+
+	return nil
+}
+
+func (f *FollowManager) UnfollowCategory(ctx context.Context, follower account.AccountID, slug string) error {
+	err := f.followWriter.UnfollowCategory(ctx, follower, slug)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+// Package pinning lets members choose threads and library pages to feature on
+// their profile, in their own order.
+package pinning
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_pin"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrUnsupportedKind = fault.New("only threads and library pages can be pinned", ftag.With(ftag.InvalidArgument))
+	ErrTooMany         = fault.New("too many pinned items", ftag.With(ftag.InvalidArgument))
+	ErrNotPinnable     = fault.New("item cannot be pinned", ftag.With(ftag.InvalidArgument))
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Pinner struct {
+	settings *settings.SettingsRepository
+	pins     *profile_pin.Repository
+	hydrator *hydrate.Hydrator
+	bus      *pubsub.Bus
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	pins *profile_pin.Repository,
+	hydrator *hydrate.Hydrator,
+	bus *pubsub.Bus,
+) *Pinner {
+	return &Pinner{
+		settings: settings,
+		pins:     pins,
+		hydrator: hydrator,
+		bus:      bus,
+	}
+}
+
+// List returns the member's pinned items in order. Items which have since been
+// deleted, unpublished or given away are skipped, as are any beyond the limit
+// if it has been lowered since they were pinned.
+func (p *Pinner) List(ctx context.Context, accountID account.AccountID) (datagraph.ItemList, error) {
+	limit, err := p.limit(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs, err := p.pins.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := p.hydrate(ctx, accountID, refs)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return lo.Slice(items, 0, limit), nil
+}
+
+// Set replaces the member's pinned items with the given list, in that order.
+// Only the member's own published threads and library pages may be pinned.
+func (p *Pinner) Set(ctx context.Context, accountID account.AccountID, refs []datagraph.Ref) (datagraph.ItemList, error) {
+	limit, err := p.limit(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs = lo.UniqBy(refs, func(r datagraph.Ref) xid.ID { return r.ID })
+
+	if len(refs) > limit {
+		return nil, fault.Wrap(ErrTooMany,
+			fctx.With(ctx),
+			fmsg.WithDesc("too many", "You've pinned more items than this community allows on a profile."),
+		)
+	}
+
+	for _, r := range refs {
+		switch r.Kind {
+		case datagraph.KindThread, datagraph.KindNode:
+		default:
+			return nil, fault.Wrap(ErrUnsupportedKind,
+				fctx.With(ctx),
+				fmsg.WithDesc("unsupported", "Only threads and library pages can be pinned to a profile."),
+			)
+		}
+	}
+
+	items, err := p.hydrate(ctx, accountID, refs)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if len(items) != len(refs) {
+		return nil, fault.Wrap(ErrNotPinnable,
+			fctx.With(ctx),
+			fmsg.WithDesc("not pinnable", "Only your own published threads and library pages can be pinned to your profile."),
+		)
+	}
+
+	if err := p.pins.Set(ctx, accountID, refs); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p.bus.Publish(ctx, &message.EventAccountUpdated{
+		ID: accountID,
+	})
+
+	return items, nil
+}
+
+func (p *Pinner) limit(ctx context.Context) (int, error) {
+	s, err := p.settings.Get(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return profile_pin.Limit(s.ProfilePinLimit), nil
+}
+
+// hydrate looks up the pinned items, keeping the member's order and dropping
+// any which no longer exist or may not be shown on their profile.
+func (p *Pinner) hydrate(ctx context.Context, accountID account.AccountID, refs []datagraph.Ref) (datagraph.ItemList, error) {
+	if len(refs) == 0 {
+		return datagraph.ItemList{}, nil
+	}
+
+	items, err := p.hydrator.Hydrate(ctx, dt.Map(refs, func(r datagraph.Ref) *datagraph.Ref { return &r })...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	byID := lo.KeyBy(items, func(i datagraph.Item) xid.ID { return i.GetID() })
+
+	out := datagraph.ItemList{}
+	for _, r := range refs {
+		if item, ok := byID[r.ID]; ok && pinnable(item, accountID) {
+			out = append(out, item)
+		}
+	}
+
+	return out, nil
+}
+
+func pinnable(item datagraph.Item, accountID account.AccountID) bool {
+	switch v := item.(type) {
+	case *thread.Thread:
+		return v.Visibility == visibility.VisibilityPublished && v.Author.ID == accountID
+	case *library.Node:
+		return v.Visibility == visibility.VisibilityPublished && v.Owner.ID == accountID
+	}
+
+	return false
+}
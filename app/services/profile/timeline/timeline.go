@@ -0,0 +1,71 @@
+// Package timeline lists a member's activity for their profile page, leaving
+// out the kinds of activity the member has chosen to hide from others.
+package timeline
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_activity"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Timeline struct {
+	accountQuerier *account_querier.Querier
+	activity       *profile_activity.Querier
+}
+
+func New(
+	accountQuerier *account_querier.Querier,
+	activity *profile_activity.Querier,
+) *Timeline {
+	return &Timeline{
+		accountQuerier: accountQuerier,
+		activity:       activity,
+	}
+}
+
+// List pages through the member's activity, optionally only the given kinds.
+// Members always see all of their own activity, everyone else only sees the
+// kinds the member hasn't hidden.
+func (t *Timeline) List(ctx context.Context, accountID account.AccountID, page pagination.Parameters, kinds ...account.ActivityKind) (pagination.Result[*profile_activity.Activity], error) {
+	acc, err := t.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return pagination.Result[*profile_activity.Activity]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(kinds) == 0 {
+		kinds = profile_activity.AllKinds
+	}
+
+	viewer := profile_activity.Viewer{
+		AccountID: session.GetOptAccountID(ctx),
+		Roles:     session.GetOptRoles(ctx),
+	}
+
+	if viewer.AccountID.OrZero() != accountID {
+		kinds = lo.Without(kinds, acc.Privacy.HiddenActivity...)
+	}
+
+	if len(kinds) == 0 {
+		return pagination.NewPageResult(page, 0, []*profile_activity.Activity{}), nil
+	}
+
+	result, err := t.activity.List(ctx, accountID, viewer, kinds, page)
+	if err != nil {
+		return pagination.Result[*profile_activity.Activity]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return result, nil
+}
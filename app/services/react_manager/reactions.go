@@ -47,7 +47,11 @@ func (s *Reactor) Add(ctx context.Context, postID post.ID, emoji string) (*react
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	s.bus.Publish(ctx, &message.EventPostReacted{PostID: postID})
+	s.bus.Publish(ctx, &message.EventPostReacted{
+		PostID:    postID,
+		ReactID:   xid.ID(r.ID),
+		AccountID: accountID,
+	})
 
 	return r, nil
 }
@@ -85,7 +89,9 @@ func (s *Reactor) Remove(ctx context.Context, reactID reaction.ReactID) error {
 	}
 
 	s.bus.Publish(ctx, &message.EventPostUnreacted{
-		PostID: targetID,
+		PostID:    targetID,
+		ReactID:   xid.ID(reactID),
+		AccountID: accountID,
 	})
 
 	return nil
@@ -0,0 +1,74 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/broadcast"
+)
+
+type adminEventTypeEnum string
+
+const (
+	adminEventTypeReportFiled          adminEventTypeEnum = "report.filed"
+	adminEventTypeAccountCreated       adminEventTypeEnum = "account.created"
+	adminEventTypeApplicationSubmitted adminEventTypeEnum = "application.submitted"
+	adminEventTypePostHeld             adminEventTypeEnum = "post.held"
+	adminEventTypeNodeSubmitted        adminEventTypeEnum = "node.submitted"
+)
+
+// AdminEvent is shaped like a webhook payload so the same handling code can be
+// used for both. Data carries identifiers only.
+type AdminEvent struct {
+	Event     AdminEventType `json:"event"`
+	Timestamp time.Time      `json:"timestamp"`
+	Data      map[string]any `json:"data"`
+}
+
+const adminChannel = "admin"
+
+// ConnectAdmin streams administrative events until ctx is done. Only members
+// with the Administrator permission may connect. When types is empty, every
+// kind of event is sent.
+func (g *Gateway) ConnectAdmin(ctx context.Context, types ...AdminEventType) (<-chan AdminEvent, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	wanted := make(map[AdminEventType]bool, len(types))
+	for _, t := range types {
+		wanted[t] = true
+	}
+
+	messages := g.broadcaster.Subscribe(ctx, adminChannel)
+	events := make(chan AdminEvent, broadcast.SubscriberBuffer)
+
+	go func() {
+		defer close(events)
+
+		for payload := range messages {
+			var e AdminEvent
+			if err := json.Unmarshal(payload, &e); err != nil {
+				continue
+			}
+
+			if len(wanted) > 0 && !wanted[e.Event] {
+				continue
+			}
+
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
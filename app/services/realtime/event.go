@@ -0,0 +1,50 @@
+package realtime
+
+import (
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type eventTypeEnum string
+
+const (
+	eventTypeWatching     eventTypeEnum = "watching"
+	eventTypeReplyCreated eventTypeEnum = "reply_created"
+	eventTypeReplyUpdated eventTypeEnum = "reply_updated"
+	eventTypeReplyDeleted eventTypeEnum = "reply_deleted"
+	eventTypeNotification eventTypeEnum = "notification"
+	eventTypePresence     eventTypeEnum = "presence"
+	eventTypeError        eventTypeEnum = "error"
+)
+
+// Event is sent to clients as JSON. Events only identify what changed, clients
+// read the content itself from the API so the usual visibility rules apply.
+type Event struct {
+	Type           EventType `json:"type"`
+	ThreadID       string    `json:"thread_id,omitempty"`
+	ReplyID        string    `json:"reply_id,omitempty"`
+	NotificationID string    `json:"notification_id,omitempty"`
+	Event          string    `json:"event,omitempty"`
+	Presence       *Presence `json:"presence,omitempty"`
+	Error          string    `json:"error,omitempty"`
+}
+
+type Presence struct {
+	Viewers []Viewer `json:"viewers"`
+}
+
+type Viewer struct {
+	ID     string `json:"id"`
+	Handle string `json:"handle"`
+	Name   string `json:"name"`
+}
+
+func threadChannel(id post.ID) string {
+	return "thread:" + id.String()
+}
+
+func accountChannel(id account.AccountID) string {
+	return "account:" + id.String()
+}
@@ -0,0 +1,186 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	thread_service "github.com/Southclaws/storyden/app/services/thread"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/internal/infrastructure/broadcast"
+)
+
+// MaxWatched is how many threads a single connection may watch at once.
+const MaxWatched = 50
+
+var errTooManyWatched = fault.New("too many threads watched", ftag.With(ftag.InvalidArgument))
+
+type Gateway struct {
+	logger      *slog.Logger
+	broadcaster broadcast.Broadcaster
+	presence    *presence
+	threads     thread_service.Service
+	threadMarks thread_mark.Service
+}
+
+func New(
+	logger *slog.Logger,
+	broadcaster broadcast.Broadcaster,
+	presence *presence,
+	threads thread_service.Service,
+	threadMarks thread_mark.Service,
+) *Gateway {
+	return &Gateway{
+		logger:      logger,
+		broadcaster: broadcaster,
+		presence:    presence,
+		threads:     threads,
+		threadMarks: threadMarks,
+	}
+}
+
+// Connection is one client's stream of events. Members automatically receive
+// their own notifications, anyone can watch threads they're able to read.
+type Connection struct {
+	g         *Gateway
+	ctx       context.Context
+	cancel    context.CancelFunc
+	accountID opt.Optional[account.AccountID]
+	events    chan Event
+
+	mu       sync.Mutex
+	watching map[post.ID]context.CancelFunc
+}
+
+// Connect opens a connection for the session in ctx, it must be closed once
+// the client disconnects.
+func (g *Gateway) Connect(ctx context.Context) *Connection {
+	ctx, cancel := context.WithCancel(ctx)
+
+	c := &Connection{
+		g:         g,
+		ctx:       ctx,
+		cancel:    cancel,
+		accountID: session.GetOptAccountID(ctx),
+		events:    make(chan Event, broadcast.SubscriberBuffer),
+		watching:  make(map[post.ID]context.CancelFunc),
+	}
+
+	if id, ok := c.accountID.Get(); ok {
+		c.forward(ctx, accountChannel(id))
+	}
+
+	return c
+}
+
+func (c *Connection) Events() <-chan Event {
+	return c.events
+}
+
+func (c *Connection) Watch(mark string) (post.ID, error) {
+	id, err := c.g.threadMarks.Lookup(c.ctx, mark)
+	if err != nil {
+		return post.ID{}, fault.Wrap(err, fctx.With(c.ctx))
+	}
+
+	// Reading the thread applies the same visibility rules as the API.
+	if _, err := c.g.threads.Get(c.ctx, id, pagination.NewPageParams(1, 1)); err != nil {
+		return post.ID{}, fault.Wrap(err, fctx.With(c.ctx), fmsg.WithDesc("not visible", "The thread doesn't exist or you can't see it."))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.watching[id]; ok {
+		return id, nil
+	}
+
+	if len(c.watching) >= MaxWatched {
+		return post.ID{}, fault.Wrap(errTooManyWatched, fctx.With(c.ctx))
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.watching[id] = cancel
+	c.forward(ctx, threadChannel(id))
+
+	if accountID, ok := c.accountID.Get(); ok {
+		if err := c.g.presence.join(c.ctx, id, accountID); err != nil {
+			c.g.logger.Warn("failed to update thread presence", slog.String("error", err.Error()))
+		}
+	}
+
+	return id, nil
+}
+
+func (c *Connection) Unwatch(mark string) error {
+	id, err := c.g.threadMarks.Lookup(c.ctx, mark)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(c.ctx))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.unwatch(id)
+
+	return nil
+}
+
+func (c *Connection) unwatch(id post.ID) {
+	cancel, ok := c.watching[id]
+	if !ok {
+		return
+	}
+
+	cancel()
+	delete(c.watching, id)
+
+	if accountID, ok := c.accountID.Get(); ok {
+		// The connection may already be closing, presence must still be removed.
+		ctx := context.WithoutCancel(c.ctx)
+		if err := c.g.presence.leave(ctx, id, accountID); err != nil {
+			c.g.logger.Warn("failed to update thread presence", slog.String("error", err.Error()))
+		}
+	}
+}
+
+func (c *Connection) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id := range c.watching {
+		c.unwatch(id)
+	}
+
+	c.cancel()
+}
+
+func (c *Connection) forward(ctx context.Context, channel string) {
+	messages := c.g.broadcaster.Subscribe(ctx, channel)
+
+	go func() {
+		for payload := range messages {
+			var e Event
+			if err := json.Unmarshal(payload, &e); err != nil {
+				continue
+			}
+
+			select {
+			case c.events <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
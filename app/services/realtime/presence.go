@@ -0,0 +1,134 @@
+package realtime
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/internal/infrastructure/broadcast"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+)
+
+// presenceTTL bounds how long viewers left behind by a replica which stopped
+// without disconnecting its clients are shown for.
+const presenceTTL = time.Hour
+
+// presence counts each member's connections watching a thread in the cache so
+// every replica sees the same viewers. A member with several tabs open is
+// counted once per tab and shown until the last one leaves.
+type presence struct {
+	mu          sync.Mutex
+	store       cache.Store
+	profiles    *profile_querier.Querier
+	broadcaster broadcast.Broadcaster
+}
+
+func newPresence(store cache.Store, profiles *profile_querier.Querier, broadcaster broadcast.Broadcaster) *presence {
+	return &presence{
+		store:       store,
+		profiles:    profiles,
+		broadcaster: broadcaster,
+	}
+}
+
+func presenceKey(id post.ID) string {
+	return "realtime:presence:" + id.String()
+}
+
+func (p *presence) join(ctx context.Context, threadID post.ID, accountID account.AccountID) error {
+	key := presenceKey(threadID)
+
+	p.mu.Lock()
+	_, err := p.store.HIncrBy(ctx, key, accountID.String(), 1)
+	if err == nil {
+		err = p.store.Expire(ctx, key, presenceTTL)
+	}
+	p.mu.Unlock()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return p.announce(ctx, threadID)
+}
+
+func (p *presence) leave(ctx context.Context, threadID post.ID, accountID account.AccountID) error {
+	key := presenceKey(threadID)
+
+	p.mu.Lock()
+	n, err := p.store.HIncrBy(ctx, key, accountID.String(), -1)
+	if err == nil && n <= 0 {
+		err = p.store.HDel(ctx, key, accountID.String())
+	}
+	p.mu.Unlock()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return p.announce(ctx, threadID)
+}
+
+func (p *presence) viewers(ctx context.Context, threadID post.ID) ([]Viewer, error) {
+	counts, err := p.store.HGetAll(ctx, presenceKey(threadID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ids := []account.AccountID{}
+	for field, count := range counts {
+		if n, err := strconv.Atoi(count); err != nil || n <= 0 {
+			continue
+		}
+
+		id, err := xid.FromString(field)
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, account.AccountID(id))
+	}
+
+	if len(ids) == 0 {
+		return []Viewer{}, nil
+	}
+
+	profiles, err := p.profiles.GetMany(ctx, ids...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	viewers := dt.Map(profiles, func(p *profile.Public) Viewer {
+		return Viewer{
+			ID:     p.ID.String(),
+			Handle: p.Handle,
+			Name:   p.Name,
+		}
+	})
+
+	sort.Slice(viewers, func(i, j int) bool { return viewers[i].Handle < viewers[j].Handle })
+
+	return viewers, nil
+}
+
+func (p *presence) announce(ctx context.Context, threadID post.ID) error {
+	viewers, err := p.viewers(ctx, threadID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return publish(ctx, p.broadcaster, threadChannel(threadID), Event{
+		Type:     EventTypePresence,
+		ThreadID: threadID.String(),
+		Presence: &Presence{Viewers: viewers},
+	})
+}
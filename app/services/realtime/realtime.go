@@ -0,0 +1,16 @@
+// Package realtime pushes live events to connected clients: new and changed
+// replies in threads they're watching, their own notifications and who else is
+// currently viewing a thread. Events from the message queue are relayed to a
+// broadcaster so every replica can deliver them to its own connections.
+package realtime
+
+import (
+	"go.uber.org/fx"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(newPresence, New),
+		fx.Invoke(runRelay),
+	)
+}
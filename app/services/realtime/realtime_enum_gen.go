@@ -0,0 +1,142 @@
+// Code generated by enumerator. DO NOT EDIT.
+
+package realtime
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type AdminEventType struct {
+	v adminEventTypeEnum
+}
+
+var (
+	AdminEventTypeReportFiled          = AdminEventType{adminEventTypeReportFiled}
+	AdminEventTypeAccountCreated       = AdminEventType{adminEventTypeAccountCreated}
+	AdminEventTypeApplicationSubmitted = AdminEventType{adminEventTypeApplicationSubmitted}
+	AdminEventTypePostHeld             = AdminEventType{adminEventTypePostHeld}
+	AdminEventTypeNodeSubmitted        = AdminEventType{adminEventTypeNodeSubmitted}
+)
+
+func (r AdminEventType) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r AdminEventType) String() string {
+	return string(r.v)
+}
+func (r AdminEventType) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *AdminEventType) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewAdminEventType(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r AdminEventType) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *AdminEventType) Scan(__iNpUt__ any) error {
+	s, err := NewAdminEventType(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewAdminEventType(__iNpUt__ string) (AdminEventType, error) {
+	switch __iNpUt__ {
+	case string(adminEventTypeReportFiled):
+		return AdminEventTypeReportFiled, nil
+	case string(adminEventTypeAccountCreated):
+		return AdminEventTypeAccountCreated, nil
+	case string(adminEventTypeApplicationSubmitted):
+		return AdminEventTypeApplicationSubmitted, nil
+	case string(adminEventTypePostHeld):
+		return AdminEventTypePostHeld, nil
+	case string(adminEventTypeNodeSubmitted):
+		return AdminEventTypeNodeSubmitted, nil
+	default:
+		return AdminEventType{}, fmt.Errorf("invalid value for type 'AdminEventType': '%s'", __iNpUt__)
+	}
+}
+
+type EventType struct {
+	v eventTypeEnum
+}
+
+var (
+	EventTypeWatching     = EventType{eventTypeWatching}
+	EventTypeReplyCreated = EventType{eventTypeReplyCreated}
+	EventTypeReplyUpdated = EventType{eventTypeReplyUpdated}
+	EventTypeReplyDeleted = EventType{eventTypeReplyDeleted}
+	EventTypeNotification = EventType{eventTypeNotification}
+	EventTypePresence     = EventType{eventTypePresence}
+	EventTypeError        = EventType{eventTypeError}
+)
+
+func (r EventType) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r EventType) String() string {
+	return string(r.v)
+}
+func (r EventType) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *EventType) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewEventType(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r EventType) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *EventType) Scan(__iNpUt__ any) error {
+	s, err := NewEventType(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewEventType(__iNpUt__ string) (EventType, error) {
+	switch __iNpUt__ {
+	case string(eventTypeWatching):
+		return EventTypeWatching, nil
+	case string(eventTypeReplyCreated):
+		return EventTypeReplyCreated, nil
+	case string(eventTypeReplyUpdated):
+		return EventTypeReplyUpdated, nil
+	case string(eventTypeReplyDeleted):
+		return EventTypeReplyDeleted, nil
+	case string(eventTypeNotification):
+		return EventTypeNotification, nil
+	case string(eventTypePresence):
+		return EventTypePresence, nil
+	case string(eventTypeError):
+		return EventTypeError, nil
+	default:
+		return EventType{}, fmt.Errorf("invalid value for type 'EventType': '%s'", __iNpUt__)
+	}
+}
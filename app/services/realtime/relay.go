@@ -0,0 +1,175 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/internal/infrastructure/broadcast"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// relay republishes queue events to the broadcaster. With a shared queue only
+// one replica consumes each event, the broadcaster fans it out to all of them.
+type relay struct {
+	logger      *slog.Logger
+	broadcaster broadcast.Broadcaster
+}
+
+func runRelay(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	broadcaster broadcast.Broadcaster,
+) {
+	r := &relay{
+		logger:      logger,
+		broadcaster: broadcaster,
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+			r.publish(ctx, threadChannel(evt.ThreadID), Event{
+				Type:     EventTypeReplyCreated,
+				ThreadID: evt.ThreadID.String(),
+				ReplyID:  evt.ReplyID.String(),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.reply_updated", func(ctx context.Context, evt *message.EventThreadReplyUpdated) error {
+			r.publish(ctx, threadChannel(evt.ThreadID), Event{
+				Type:     EventTypeReplyUpdated,
+				ThreadID: evt.ThreadID.String(),
+				ReplyID:  evt.ReplyID.String(),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.reply_deleted", func(ctx context.Context, evt *message.EventThreadReplyDeleted) error {
+			r.publish(ctx, threadChannel(evt.ThreadID), Event{
+				Type:     EventTypeReplyDeleted,
+				ThreadID: evt.ThreadID.String(),
+				ReplyID:  evt.ReplyID.String(),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.admin_report_created", func(ctx context.Context, evt *message.EventReportCreated) error {
+			data := map[string]any{
+				"report_id":   evt.ID.String(),
+				"reported_by": evt.ReportedBy.String(),
+			}
+			if evt.Target != nil {
+				data["target_kind"] = evt.Target.Kind.String()
+				data["target_id"] = evt.Target.ID.String()
+			}
+			r.publishAdmin(ctx, AdminEventTypeReportFiled, data)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.admin_account_created", func(ctx context.Context, evt *message.EventAccountCreated) error {
+			r.publishAdmin(ctx, AdminEventTypeAccountCreated, map[string]any{
+				"account_id": evt.ID.String(),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.admin_application_submitted", func(ctx context.Context, evt *message.EventAccountApplicationSubmitted) error {
+			r.publishAdmin(ctx, AdminEventTypeApplicationSubmitted, map[string]any{
+				"application_id": evt.ID.String(),
+				"account_id":     evt.AccountID.String(),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.admin_post_held", func(ctx context.Context, evt *message.EventPostHeldForReview) error {
+			r.publishAdmin(ctx, AdminEventTypePostHeld, map[string]any{
+				"thread_id": evt.ThreadID.String(),
+				"post_id":   evt.PostID.String(),
+				"author_id": evt.AuthorID.String(),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.admin_node_submitted", func(ctx context.Context, evt *message.EventNodeSubmittedForReview) error {
+			r.publishAdmin(ctx, AdminEventTypeNodeSubmitted, map[string]any{
+				"node_id": evt.ID.String(),
+				"slug":    evt.Slug,
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "realtime.notification_created", func(ctx context.Context, evt *message.EventNotificationCreated) error {
+			r.publish(ctx, accountChannel(evt.TargetID), Event{
+				Type:           EventTypeNotification,
+				NotificationID: evt.ID.String(),
+				Event:          evt.Event.String(),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	}))
+}
+
+// publish is best-effort, live events are only useful right now so a failure
+// isn't retried and clients catch up from the API when they reconnect.
+func (r *relay) publish(ctx context.Context, channel string, e Event) {
+	if err := publish(ctx, r.broadcaster, channel, e); err != nil {
+		r.logger.Warn("failed to broadcast realtime event",
+			slog.String("error", err.Error()),
+			slog.String("channel", channel),
+			slog.String("type", e.Type.String()),
+		)
+	}
+}
+
+func (r *relay) publishAdmin(ctx context.Context, t AdminEventType, data map[string]any) {
+	payload, err := json.Marshal(AdminEvent{
+		Event:     t,
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return
+	}
+
+	if err := r.broadcaster.Publish(ctx, adminChannel, payload); err != nil {
+		r.logger.Warn("failed to broadcast admin event",
+			slog.String("error", err.Error()),
+			slog.String("event", t.String()),
+		)
+	}
+}
+
+func publish(ctx context.Context, b broadcast.Broadcaster, channel string, e Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return b.Publish(ctx, channel, payload)
+}
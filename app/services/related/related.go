@@ -0,0 +1,250 @@
+// Package related recommends threads and library pages related to a given
+// item by blending semantic similarity from semdex with co-visitation, the
+// other threads most often read by the same members.
+package related
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_read_state"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+const (
+	DefaultLimit = 10
+	maxRelated   = 25
+
+	// Semantic similarity is the stronger signal, co-visitation mostly
+	// reorders and supplements it with items readers actually move between.
+	similarityWeight = 0.7
+	coVisitWeight    = 0.3
+
+	cachePrefix = "related:"
+	cacheTTL    = time.Hour
+)
+
+type Finder struct {
+	logger       *slog.Logger
+	store        cache.Store
+	recommender  semdex.Recommender
+	readQuerier  *post_read_state.Querier
+	facetQuerier *facet.Querier
+	hydrator     *hydrate.Hydrator
+}
+
+func New(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	store cache.Store,
+	bus *pubsub.Bus,
+	recommender semdex.Recommender,
+	readQuerier *post_read_state.Querier,
+	facetQuerier *facet.Querier,
+	hydrator *hydrate.Hydrator,
+) *Finder {
+	f := &Finder{
+		logger:       logger,
+		store:        store,
+		recommender:  recommender,
+		readQuerier:  readQuerier,
+		facetQuerier: facetQuerier,
+		hydrator:     hydrator,
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		return f.subscribe(hctx, bus)
+	}))
+
+	return f
+}
+
+type cachedRef struct {
+	ID    xid.ID         `json:"id"`
+	Kind  datagraph.Kind `json:"kind"`
+	Score float64        `json:"score"`
+}
+
+// Related returns up to limit published items related to the given thread or
+// library page, most related first.
+func (f *Finder) Related(ctx context.Context, item datagraph.Item, limit int) (datagraph.ItemList, error) {
+	if limit <= 0 || limit > maxRelated {
+		limit = DefaultLimit
+	}
+
+	refs, ok := f.cached(ctx, item.GetID())
+	if !ok {
+		var err error
+		refs, err = f.rank(ctx, item)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		f.put(ctx, item.GetID(), refs)
+	}
+
+	// The cached ranking is shared by everyone, so it's narrowed down to the
+	// categories the member can read before it's cut to size.
+	refs, err := f.facetQuerier.FilterRefs(ctx, refs, facet.Filter{
+		ReadableBy: opt.New(session.GetOptRoles(ctx)),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(refs) > limit {
+		refs = refs[:limit]
+	}
+
+	items, err := f.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return items, nil
+}
+
+func (f *Finder) rank(ctx context.Context, item datagraph.Item) ([]*datagraph.Ref, error) {
+	scores := map[xid.ID]*datagraph.Ref{}
+
+	similar, err := f.recommender.RecommendRefs(ctx, item)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, r := range similar {
+		if r.Kind != datagraph.KindThread && r.Kind != datagraph.KindNode {
+			continue
+		}
+		if existing, ok := scores[r.ID]; ok {
+			existing.Relevance = max(existing.Relevance, r.Relevance*similarityWeight)
+			continue
+		}
+		scores[r.ID] = &datagraph.Ref{ID: r.ID, Kind: r.Kind, Relevance: r.Relevance * similarityWeight}
+	}
+
+	if item.GetKind() == datagraph.KindThread {
+		visits, err := f.readQuerier.CoVisited(ctx, post.ID(item.GetID()), maxRelated)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if len(visits) > 0 {
+			most := float64(visits[0].Visits)
+			for _, v := range visits {
+				score := coVisitWeight * float64(v.Visits) / most
+				if existing, ok := scores[xid.ID(v.ID)]; ok {
+					existing.Relevance += score
+					continue
+				}
+				scores[xid.ID(v.ID)] = &datagraph.Ref{ID: xid.ID(v.ID), Kind: datagraph.KindThread, Relevance: score}
+			}
+		}
+	}
+
+	delete(scores, item.GetID())
+
+	refs := lo.Values(scores)
+	sort.Sort(datagraph.RefList(refs))
+
+	if len(refs) > maxRelated {
+		refs = refs[:maxRelated]
+	}
+
+	return refs, nil
+}
+
+func (f *Finder) cacheKey(id xid.ID) string {
+	return cachePrefix + id.String()
+}
+
+func (f *Finder) cached(ctx context.Context, id xid.ID) ([]*datagraph.Ref, bool) {
+	val, err := f.store.Get(ctx, f.cacheKey(id))
+	if err != nil {
+		return nil, false
+	}
+
+	var cached []cachedRef
+	if err := json.Unmarshal([]byte(val), &cached); err != nil {
+		_ = f.store.Delete(ctx, f.cacheKey(id))
+		return nil, false
+	}
+
+	return dt.Map(cached, func(c cachedRef) *datagraph.Ref {
+		return &datagraph.Ref{ID: c.ID, Kind: c.Kind, Relevance: c.Score}
+	}), true
+}
+
+func (f *Finder) put(ctx context.Context, id xid.ID, refs []*datagraph.Ref) {
+	b, err := json.Marshal(dt.Map(refs, func(r *datagraph.Ref) cachedRef {
+		return cachedRef{ID: r.ID, Kind: r.Kind, Score: r.Relevance}
+	}))
+	if err != nil {
+		return
+	}
+
+	if err := f.store.Set(ctx, f.cacheKey(id), string(b), cacheTTL); err != nil {
+		f.logger.Warn("failed to cache related items", slog.String("error", err.Error()))
+	}
+}
+
+func (f *Finder) invalidate(ctx context.Context, id xid.ID) error {
+	return f.store.Delete(ctx, f.cacheKey(id))
+}
+
+func (f *Finder) subscribe(ctx context.Context, bus *pubsub.Bus) error {
+	if _, err := pubsub.Subscribe(ctx, bus, "related.thread_updated", func(ctx context.Context, evt *message.EventThreadUpdated) error {
+		return f.invalidate(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "related.thread_unpublished", func(ctx context.Context, evt *message.EventThreadUnpublished) error {
+		return f.invalidate(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "related.thread_deleted", func(ctx context.Context, evt *message.EventThreadDeleted) error {
+		return f.invalidate(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "related.node_updated", func(ctx context.Context, evt *message.EventNodeUpdated) error {
+		return f.invalidate(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "related.node_unpublished", func(ctx context.Context, evt *message.EventNodeUnpublished) error {
+		return f.invalidate(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	_, err := pubsub.Subscribe(ctx, bus, "related.node_deleted", func(ctx context.Context, evt *message.EventNodeDeleted) error {
+		return f.invalidate(ctx, xid.ID(evt.ID))
+	})
+
+	return err
+}
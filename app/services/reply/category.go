@@ -0,0 +1,78 @@
+package reply
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+)
+
+var (
+	ErrCategoryReplyDenied  = fault.New("roles may not reply in the category", ftag.With(ftag.PermissionDenied))
+	ErrCategoryAttachDenied = fault.New("roles may not attach media in the category", ftag.With(ftag.PermissionDenied))
+)
+
+func (s *service) authoriseCategoryReply(ctx context.Context, roles role.Roles, threadID post.ID, content opt.Optional[datagraph.Content]) error {
+	cat, ok, err := s.categoryRepo.LookupByThread(ctx, xid.ID(threadID))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		return nil
+	}
+
+	if !cat.Permissions.CanReply(roles) {
+		return fault.Wrap(ErrCategoryReplyDenied, fctx.With(ctx),
+			fmsg.WithDesc("not permitted", "You do not have permission to reply in this category."))
+	}
+
+	if err := cat.CheckWritable(ctx, time.Now(), roles); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return authoriseCategoryAttach(ctx, cat.Permissions, roles, content)
+}
+
+// authoriseCategoryEdit applies the same checks as replying, except for the
+// reply permission itself which the author had when the reply was created.
+func (s *service) authoriseCategoryEdit(ctx context.Context, roles role.Roles, threadID post.ID, content opt.Optional[datagraph.Content]) error {
+	cat, ok, err := s.categoryRepo.LookupByThread(ctx, xid.ID(threadID))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		return nil
+	}
+
+	if content.Ok() {
+		if err := cat.CheckWritable(ctx, time.Now(), roles); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return authoriseCategoryAttach(ctx, cat.Permissions, roles, content)
+}
+
+func authoriseCategoryAttach(ctx context.Context, perms category.Permissions, roles role.Roles, content opt.Optional[datagraph.Content]) error {
+	c, ok := content.Get()
+	if !ok || len(c.Media()) == 0 {
+		return nil
+	}
+
+	if !perms.CanAttach(roles) {
+		return fault.Wrap(ErrCategoryAttachDenied, fctx.With(ctx),
+			fmsg.WithDesc("not permitted", "You do not have permission to attach files in this category."))
+	}
+
+	return nil
+}
@@ -6,11 +6,15 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/moderation/spam"
 )
 
 func (s *service) Create(
@@ -19,19 +23,81 @@ func (s *service) Create(
 	parentID post.ID,
 	partial Partial,
 ) (*reply.Reply, error) {
+	if err := s.netbans.CheckPost(ctx, authorID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := s.accountQuery.GetByID(ctx, authorID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Replies also arrive from chat bridges and email, not only the HTTP API,
+	// so every gate the API applies is checked here too.
+	if err := s.accountGate.Check(ctx, acc, rbac.PermissionCreatePost); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filtered, err := s.cpm.FilterPost(ctx, opt.NewEmpty[string](), partial.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	partial.Content = filtered.Content
+
 	if content, ok := partial.Content.Get(); ok {
 		if err := s.cpm.CheckContent(ctx, content); err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
 		}
 	}
 
+	if err := s.authoriseCategoryReply(ctx, acc.Roles.Roles(), parentID, partial.Content); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	verdict, err := s.automod.Evaluate(ctx, authorID, partial.Content.OrZero())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	held, err := s.postQueue.Required(ctx, authorID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	screened, err := s.spamScreen.Screen(ctx, authorID, spam.SubmissionReply, "", partial.Content.OrZero())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	held = held || verdict.Hidden() || screened.Held || filtered.Review
+
 	opts := partial.Opts()
 
+	if score, ok := screened.Score.Get(); ok {
+		opts = append(opts, reply.WithSpamScore(score))
+	}
+
+	if held {
+		opts = append(opts, reply.WithVisibility(visibility.VisibilityReview))
+	}
+
 	p, err := s.post_repo.Create(ctx, authorID, parentID, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create reply post in thread"))
 	}
 
+	s.automod.Record(ctx, p, authorID, verdict)
+
+	if held {
+		s.bus.Publish(ctx, &message.EventPostHeldForReview{
+			ThreadID: p.RootPostID,
+			PostID:   p.ID,
+			AuthorID: authorID,
+		})
+
+		return p, nil
+	}
+
 	s.bus.Publish(ctx, &message.EventThreadReplyCreated{
 		ThreadID:       p.RootPostID,
 		ReplyID:        p.ID,
@@ -39,6 +105,5 @@ func (s *service) Create(
 		ReplyAuthorID:  authorID,
 	})
 
-
 	return p, nil
 }
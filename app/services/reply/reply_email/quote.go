@@ -0,0 +1,42 @@
+package reply_email
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Most clients introduce the quoted message with a line such as "On Mon,
+	// 10 Mar 2025 at 09:00, Storyden <reply@...> wrote:" which may wrap.
+	attribution = regexp.MustCompile(`(?is)^on\s.+wrote:$`)
+	separator   = regexp.MustCompile(`^-{2,}\s*(original message|forwarded message)?\s*-*$`)
+)
+
+// stripQuoted removes the quoted message and signature most email clients add
+// below a reply, leaving only what the member wrote.
+func stripQuoted(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		// "-- " is the conventional signature delimiter.
+		if strings.HasPrefix(trimmed, ">") || line == "-- " || separator.MatchString(strings.ToLower(trimmed)) {
+			lines = lines[:i]
+			break
+		}
+
+		if strings.HasPrefix(strings.ToLower(trimmed), "on ") {
+			joined := trimmed
+			if i+1 < len(lines) {
+				joined += " " + strings.TrimSpace(lines[i+1])
+			}
+			if attribution.MatchString(trimmed) || attribution.MatchString(joined) {
+				lines = lines[:i]
+				break
+			}
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,196 @@
+// Package reply_email lets members reply to threads by answering the emails
+// they're sent about new replies. Each email is sent with a reply address
+// unique to the member and thread, mail to that address is then posted to the
+// thread as a reply from the member.
+package reply_email
+
+import (
+	"context"
+	"html"
+	"log/slog"
+	"net/mail"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/account/account_gate"
+	reply_service "github.com/Southclaws/storyden/app/services/reply"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+var (
+	ErrDisabled     = fault.New("replying by email is not enabled", ftag.With(ftag.NotFound))
+	errNoToken      = fault.New("no reply address among recipients", ftag.With(ftag.InvalidArgument))
+	errWrongSender  = fault.New("email was not sent from the member's address", ftag.With(ftag.PermissionDenied))
+	errEmptyMessage = fault.New("email has no reply text", ftag.With(ftag.InvalidArgument))
+)
+
+// Inbound is an email received at the reply domain.
+type Inbound struct {
+	To   []string
+	From string
+	Text string
+}
+
+type Mailbox struct {
+	logger         *slog.Logger
+	domain         string
+	tokens         tokens
+	accountQuerier *account_querier.Querier
+	accountGate    *account_gate.Gate
+	replyService   reply_service.Service
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	accountQuerier *account_querier.Querier,
+	accountGate *account_gate.Gate,
+	replyService reply_service.Service,
+) *Mailbox {
+	domain := cfg.EmailReplyDomain
+	if len(cfg.JWTSecret) == 0 {
+		domain = ""
+	}
+
+	return &Mailbox{
+		logger:         logger,
+		domain:         strings.ToLower(strings.TrimPrefix(domain, "@")),
+		tokens:         tokens{key: cfg.JWTSecret},
+		accountQuerier: accountQuerier,
+		accountGate:    accountGate,
+		replyService:   replyService,
+	}
+}
+
+func (m *Mailbox) Enabled() bool {
+	return m.domain != ""
+}
+
+// Address is where the member can send their reply to the thread, if replying
+// by email is enabled.
+func (m *Mailbox) Address(accountID account.AccountID, threadID post.ID) opt.Optional[mail.Address] {
+	if !m.Enabled() {
+		return opt.NewEmpty[mail.Address]()
+	}
+
+	return opt.New(mail.Address{
+		Address: m.tokens.encode(accountID, threadID) + "@" + m.domain,
+	})
+}
+
+// Receive posts an email sent to a reply address as a reply to its thread.
+// The email must come from one of the member's verified addresses, which
+// stops a forwarded notification being used to post on the member's behalf.
+func (m *Mailbox) Receive(ctx context.Context, in Inbound) (*reply.Reply, error) {
+	if !m.Enabled() {
+		return nil, fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	accountID, threadID, err := m.resolve(in.To)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ctx = fctx.WithMeta(ctx, "account_id", accountID.String(), "thread_id", threadID.String())
+
+	from, err := mail.ParseAddress(in.From)
+	if err != nil {
+		return nil, fault.Wrap(errWrongSender, fctx.With(ctx))
+	}
+
+	acc, err := m.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	_, verified := lo.Find(acc.EmailAddresses, func(a *account.EmailAddress) bool {
+		return a.Verified && strings.EqualFold(a.Email.Address, from.Address)
+	})
+	if !verified {
+		return nil, fault.Wrap(errWrongSender, fctx.With(ctx))
+	}
+
+	if err := m.accountGate.Check(ctx, acc, rbac.PermissionCreatePost); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	text := stripQuoted(in.Text)
+	if text == "" {
+		return nil, fault.Wrap(errEmptyMessage, fctx.With(ctx))
+	}
+
+	content, err := datagraph.NewRichText(paragraphs(text))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	r, err := m.replyService.Create(ctx, accountID, threadID, reply_service.Partial{
+		Content: opt.New(content),
+		Meta:    opt.New(map[string]any{"via": "email"}),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to post reply from email"))
+	}
+
+	m.logger.Debug("posted reply from email",
+		slog.String("account_id", accountID.String()),
+		slog.String("reply_id", r.ID.String()),
+	)
+
+	return r, nil
+}
+
+func (m *Mailbox) resolve(to []string) (account.AccountID, post.ID, error) {
+	for _, raw := range to {
+		addresses, err := mail.ParseAddressList(raw)
+		if err != nil {
+			continue
+		}
+
+		for _, a := range addresses {
+			local, domain, ok := strings.Cut(a.Address, "@")
+			if !ok || !strings.EqualFold(domain, m.domain) {
+				continue
+			}
+
+			return m.tokens.decode(local)
+		}
+	}
+
+	return account.AccountID{}, post.ID{}, errNoToken
+}
+
+// paragraphs turns plain text into HTML, blank lines separate paragraphs and
+// single line breaks are kept.
+func paragraphs(text string) string {
+	var b strings.Builder
+	for _, p := range strings.Split(text, "\n\n") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		lines := strings.Split(p, "\n")
+		for i, l := range lines {
+			lines[i] = html.EscapeString(strings.TrimSpace(l))
+		}
+		b.WriteString("<p>" + strings.Join(lines, "<br>") + "</p>")
+	}
+	return b.String()
+}
@@ -0,0 +1,85 @@
+package reply_email
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+)
+
+var errInvalidToken = fault.New("invalid reply token", ftag.With(ftag.InvalidArgument))
+
+// Tokens are the local part of a reply address so must fit in the 64 octets
+// allowed by RFC 5321, which rules out the encrypted tokens used elsewhere.
+// Two 12 byte IDs and a truncated MAC encode to 55 characters.
+const macSize = 10
+
+const alphabet = "abcdefghijklmnopqrstuvwxyz234567"
+
+var encoding = base32.NewEncoding(alphabet).WithPadding(base32.NoPadding)
+
+// The label keeps these MACs distinct from anything else keyed on the secret.
+const macLabel = "reply_email:"
+
+type tokens struct {
+	key []byte
+}
+
+func (t tokens) mac(accountID account.AccountID, threadID post.ID) []byte {
+	h := hmac.New(sha256.New, t.key)
+	h.Write([]byte(macLabel))
+	h.Write(xid.ID(accountID).Bytes())
+	h.Write(xid.ID(threadID).Bytes())
+	return h.Sum(nil)[:macSize]
+}
+
+// encode creates a token which identifies who a reply is from and which
+// thread it's to, only the holder of the secret can create a valid one.
+func (t tokens) encode(accountID account.AccountID, threadID post.ID) string {
+	b := make([]byte, 0, 24+macSize)
+	b = append(b, xid.ID(accountID).Bytes()...)
+	b = append(b, xid.ID(threadID).Bytes()...)
+	b = append(b, t.mac(accountID, threadID)...)
+	return encoding.EncodeToString(b)
+}
+
+func (t tokens) decode(token string) (account.AccountID, post.ID, error) {
+	token = strings.ToLower(token)
+	if len(token) != encoding.EncodedLen(24+macSize) {
+		return account.AccountID{}, post.ID{}, errInvalidToken
+	}
+
+	b, err := encoding.DecodeString(token)
+	if err != nil || len(b) != 24+macSize {
+		return account.AccountID{}, post.ID{}, errInvalidToken
+	}
+
+	// The last character has spare bits which decoding ignores, so only the
+	// canonical encoding is accepted, otherwise one address has several forms.
+	if !hmac.Equal([]byte(encoding.EncodeToString(b)), []byte(token)) {
+		return account.AccountID{}, post.ID{}, errInvalidToken
+	}
+
+	accountID, err := xid.FromBytes(b[:12])
+	if err != nil {
+		return account.AccountID{}, post.ID{}, errInvalidToken
+	}
+
+	threadID, err := xid.FromBytes(b[12:24])
+	if err != nil {
+		return account.AccountID{}, post.ID{}, errInvalidToken
+	}
+
+	if !hmac.Equal(b[24:], t.mac(account.AccountID(accountID), post.ID(threadID))) {
+		return account.AccountID{}, post.ID{}, errInvalidToken
+	}
+
+	return account.AccountID(accountID), post.ID(threadID), nil
+}
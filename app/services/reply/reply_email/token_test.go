@@ -0,0 +1,87 @@
+package reply_email
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+)
+
+func TestTokens(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	tk := tokens{key: []byte("00000000000000000000000000000000")}
+	accountID := account.AccountID(xid.New())
+	threadID := post.ID(xid.New())
+
+	token := tk.encode(accountID, threadID)
+	a.LessOrEqual(len(token), 64)
+
+	gotAccount, gotThread, err := tk.decode(token)
+	r.NoError(err)
+	a.Equal(accountID, gotAccount)
+	a.Equal(threadID, gotThread)
+
+	t.Run("case_insensitive", func(t *testing.T) {
+		_, _, err := tk.decode(string(token[0]-32) + token[1:])
+		assert.NoError(t, err)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		other := tk.encode(account.AccountID(xid.New()), threadID)
+		_, _, err := tk.decode(other[:20] + token[20:])
+		assert.Error(t, err)
+	})
+
+	t.Run("trailing_bits", func(t *testing.T) {
+		last := strings.IndexByte(alphabet, token[len(token)-1])
+		for i := 1; i < 8; i++ {
+			_, _, err := tk.decode(token[:len(token)-1] + string(alphabet[last^i]))
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("length", func(t *testing.T) {
+		_, _, err := tk.decode(token + "a")
+		assert.Error(t, err)
+
+		_, _, err = tk.decode(token[:len(token)-1])
+		assert.Error(t, err)
+	})
+
+	t.Run("other_secret", func(t *testing.T) {
+		_, _, err := tokens{key: []byte("11111111111111111111111111111111")}.decode(token)
+		assert.Error(t, err)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		_, _, err := tk.decode("reply")
+		assert.Error(t, err)
+	})
+}
+
+func TestStripQuoted(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "Thanks!\n\nSounds good.", "Thanks!\n\nSounds good."},
+		{"quoted", "Thanks!\r\n\r\n> original\r\n> text", "Thanks!"},
+		{"attribution", "Agreed.\n\nOn Mon, 10 Mar 2025 at 09:00, Storyden <abc@reply.example.com> wrote:\n> original", "Agreed."},
+		{"wrapped_attribution", "Agreed.\n\nOn Mon, 10 Mar 2025 at 09:00, Storyden\n<abc@reply.example.com> wrote:\n\nHi", "Agreed."},
+		{"signature", "Yes please\n-- \nSent from my phone", "Yes please"},
+		{"original_message", "Nope\n\n-----Original Message-----\nFrom: Storyden", "Nope"},
+		{"on_in_text", "On second thoughts, no.", "On second thoughts, no."},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, stripQuoted(tc.in))
+		})
+	}
+}
@@ -35,6 +35,7 @@ func Build() fx.Option {
 						ID:   xid.ID(evt.ThreadID),
 						Kind: datagraph.KindPost,
 					},
+					notify.WithPost(evt.ReplyID),
 				)
 			})
 			return err
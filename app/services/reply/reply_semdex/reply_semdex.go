@@ -9,10 +9,12 @@ import (
 	"github.com/rs/xid"
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/semdex/index_job"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
@@ -28,7 +30,6 @@ type semdexer struct {
 	replyQuerier  reply.Repository
 	replyWriter   reply.Repository
 	semdexMutator semdex.Mutator
-	bus           *pubsub.Bus
 }
 
 func newReplySemdexer(
@@ -40,6 +41,7 @@ func newReplySemdexer(
 	replyWriter reply.Repository,
 	semdexMutator semdex.Mutator,
 	bus *pubsub.Bus,
+	queue *index_job.Queuer,
 ) {
 	if cfg.SemdexProvider == "" {
 		return
@@ -50,26 +52,25 @@ func newReplySemdexer(
 		replyQuerier:  replyQuerier,
 		replyWriter:   replyWriter,
 		semdexMutator: semdexMutator,
-		bus:           bus,
 	}
 
 	lc.Append(fx.StartHook(func(hctx context.Context) error {
 		_, err := pubsub.Subscribe(hctx, bus, "reply_semdex.index_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
-			return bus.SendCommand(ctx, &message.CommandReplyIndex{ID: evt.ReplyID})
+			return queue.Index(ctx, datagraph.KindReply, xid.ID(evt.ReplyID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "reply_semdex.index_updated", func(ctx context.Context, evt *message.EventThreadReplyUpdated) error {
-			return bus.SendCommand(ctx, &message.CommandReplyIndex{ID: evt.ReplyID})
+			return queue.Index(ctx, datagraph.KindReply, xid.ID(evt.ReplyID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "reply_semdex.deindex_deleted", func(ctx context.Context, evt *message.EventThreadReplyDeleted) error {
-			return bus.SendCommand(ctx, &message.CommandReplyDeindex{ID: evt.ReplyID})
+			return queue.Deindex(ctx, datagraph.KindReply, xid.ID(evt.ReplyID))
 		})
 		if err != nil {
 			return err
@@ -78,23 +79,10 @@ func newReplySemdexer(
 		return nil
 	}))
 
-	lc.Append(fx.StartHook(func(hctx context.Context) error {
-		_, err := pubsub.SubscribeCommand(hctx, bus, "reply_semdex.index", func(ctx context.Context, cmd *message.CommandReplyIndex) error {
-			return re.indexReply(ctx, cmd.ID)
-		})
-		if err != nil {
-			return err
-		}
-
-		_, err = pubsub.SubscribeCommand(hctx, bus, "reply_semdex.deindex", func(ctx context.Context, cmd *message.CommandReplyDeindex) error {
-			return re.deindexReply(ctx, cmd.ID)
-		})
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}))
+	queue.Handle(datagraph.KindReply,
+		func(ctx context.Context, id xid.ID) error { return re.indexReply(ctx, post.ID(id)) },
+		func(ctx context.Context, id xid.ID) error { return re.deindexReply(ctx, post.ID(id)) },
+	)
 }
 
 func (s *semdexer) indexReply(ctx context.Context, id post.ID) error {
@@ -11,9 +11,15 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/services/account/account_gate"
 	"github.com/Southclaws/storyden/app/services/link/fetcher"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_engine"
 	"github.com/Southclaws/storyden/app/services/moderation/content_policy"
+	"github.com/Southclaws/storyden/app/services/moderation/netban_guard"
+	"github.com/Southclaws/storyden/app/services/moderation/post_queue"
+	"github.com/Southclaws/storyden/app/services/moderation/spam_screen"
 	"github.com/Southclaws/storyden/app/services/reply/reply_notify"
 	"github.com/Southclaws/storyden/app/services/reply/reply_semdex"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
@@ -57,23 +63,41 @@ func Build() fx.Option {
 type service struct {
 	accountQuery *account_querier.Querier
 	post_repo    reply.Repository
+	categoryRepo *category.Repository
 	fetcher      *fetcher.Fetcher
 	bus          *pubsub.Bus
 	cpm          *content_policy.Manager
+	automod      *automod_engine.Engine
+	postQueue    *post_queue.Queue
+	spamScreen   *spam_screen.Screener
+	netbans      *netban_guard.Guard
+	accountGate  *account_gate.Gate
 }
 
 func New(
 	accountQuery *account_querier.Querier,
 	post_repo reply.Repository,
+	categoryRepo *category.Repository,
 	fetcher *fetcher.Fetcher,
 	bus *pubsub.Bus,
 	cpm *content_policy.Manager,
+	automod *automod_engine.Engine,
+	postQueue *post_queue.Queue,
+	spamScreen *spam_screen.Screener,
+	netbans *netban_guard.Guard,
+	accountGate *account_gate.Gate,
 ) Service {
 	return &service{
 		accountQuery: accountQuery,
 		post_repo:    post_repo,
+		categoryRepo: categoryRepo,
 		fetcher:      fetcher,
 		bus:          bus,
 		cpm:          cpm,
+		automod:      automod,
+		postQueue:    postQueue,
+		spamScreen:   spamScreen,
+		netbans:      netbans,
+		accountGate:  accountGate,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/post"
@@ -15,6 +16,12 @@ import (
 )
 
 func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial) (*reply.Reply, error) {
+	filtered, err := s.cpm.FilterPost(ctx, opt.NewEmpty[string](), partial.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	partial.Content = filtered.Content
+
 	if content, ok := partial.Content.Get(); ok {
 		if err := s.cpm.CheckContent(ctx, content); err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
@@ -47,6 +54,10 @@ func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial)
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if err := s.authoriseCategoryEdit(ctx, acc.Roles.Roles(), p.RootPostID, partial.Content); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	opts := partial.Opts()
 
 	p, err = s.post_repo.Update(ctx, threadID, opts...)
@@ -59,6 +70,5 @@ func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial)
 		ReplyID:  p.ID,
 	})
 
-
 	return p, nil
 }
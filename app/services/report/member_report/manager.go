@@ -42,6 +42,7 @@ func (m *Manager) Submit(
 	ctx context.Context,
 	targetID xid.ID,
 	targetKind datagraph.Kind,
+	reason opt.Optional[report.Reason],
 	comment opt.Optional[string],
 ) (*report.Report, error) {
 	acc, err := session.GetAccount(ctx)
@@ -49,7 +50,7 @@ func (m *Manager) Submit(
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	rep, err := m.reportWriter.Create(ctx, targetID, targetKind, acc.ID, comment)
+	rep, err := m.reportWriter.Create(ctx, targetID, targetKind, acc.ID, reason, comment)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -117,5 +119,94 @@ func (m *Manager) Update(
 		Status: rep.Status,
 	})
 
+	if rep.Status == report.StatusEscalated {
+		m.bus.Publish(ctx, &message.EventReportEscalated{
+			Target:      datagraph.Ref{ID: rep.TargetItemID, Kind: rep.TargetItemKind},
+			EscalatedBy: acc.ID,
+		})
+	}
+
 	return rep, nil
 }
+
+// ListTargets is the moderation queue: reports grouped by what they target.
+// Without a status filter only open reports are included.
+func (m *Manager) ListTargets(
+	ctx context.Context,
+	page pagination.Parameters,
+	opts ListOpts,
+) (pagination.Result[*report.Target], error) {
+	queryOpts := []report_querier.Query{
+		report_querier.WithStatus(opts.Status.Or(report.OpenStatuses)...),
+	}
+
+	opts.Kind.Call(func(kinds []datagraph.Kind) {
+		queryOpts = append(queryOpts, report_querier.WithKind(kinds...))
+	})
+
+	targets, err := m.reportQuerier.ListTargets(ctx, page, queryOpts...)
+	if err != nil {
+		return pagination.Result[*report.Target]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return targets, nil
+}
+
+// HandleTarget applies a moderation decision to every open report against a
+// target. Resolving or dismissing closes them, escalating keeps them open but
+// hands them over to administrators.
+func (m *Manager) HandleTarget(
+	ctx context.Context,
+	targetID xid.ID,
+	status report.Status,
+) (*report.Target, error) {
+	acc, err := session.GetAccount(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	from := report.OpenStatuses
+	switch status {
+	case report.StatusResolved, report.StatusDismissed:
+	case report.StatusEscalated:
+		from = []report.Status{report.StatusSubmitted, report.StatusAcknowledged}
+	default:
+		return nil, fault.New("reports can only be resolved, dismissed or escalated as a group",
+			fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	reports, err := m.reportWriter.UpdateTarget(ctx, targetID, from, status, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, rep := range reports {
+		var targetRef *datagraph.Ref
+		if rep.TargetItem != nil {
+			targetRef = datagraph.NewRef(rep.TargetItem)
+		}
+
+		m.bus.Publish(ctx, &message.EventReportUpdated{
+			ID:         rep.ID,
+			Target:     targetRef,
+			ReportedBy: rep.ReportedBy.ID,
+			HandledBy:  opt.New(acc.ID),
+			Status:     rep.Status,
+		})
+	}
+
+	targets := report.Aggregate(reports)
+	if len(targets) == 0 {
+		return nil, fault.New("no reports for target", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+	target := targets[0]
+
+	if status == report.StatusEscalated {
+		m.bus.Publish(ctx, &message.EventReportEscalated{
+			Target:      datagraph.Ref{ID: target.ID, Kind: target.Kind},
+			EscalatedBy: acc.ID,
+		})
+	}
+
+	return target, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/notification"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/report"
 	"github.com/Southclaws/storyden/app/resources/report/report_querier"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/notification/notify"
@@ -47,6 +48,14 @@ func Build() fx.Option {
 				return err
 			}
 
+			// Report escalated
+			// Notify administrators, the reporters aren't told until it's closed.
+			if _, err := pubsub.Subscribe(hctx, bus, "report_notify.report_escalated", func(ctx context.Context, evt *message.EventReportEscalated) error {
+				return sendReportEscalated(ctx, notifier, accountQuerier, evt)
+			}); err != nil {
+				return err
+			}
+
 			return nil
 		}
 
@@ -94,6 +103,10 @@ func sendReportUpdated(
 	}
 
 	if rep.ReportedBy.ID != source {
+		if rep.Status == report.StatusEscalated {
+			return nil
+		}
+
 		// Moderator updated the report; notify author.
 
 		if err := notifier.Send(
@@ -123,3 +136,27 @@ func sendReportUpdated(
 
 	return nil
 }
+
+func sendReportEscalated(
+	ctx context.Context,
+	notifier *notify.Notifier,
+	accountQuerier *account_querier.Querier,
+	evt *message.EventReportEscalated,
+) error {
+	accs, err := accountQuerier.ListByHeldPermission(ctx, rbac.PermissionAdministrator)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, acc := range accs {
+		if acc.ID == evt.EscalatedBy {
+			continue
+		}
+
+		if err := notifier.Send(ctx, acc.ID, opt.New(evt.EscalatedBy), notification.EventReportEscalated, &evt.Target); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
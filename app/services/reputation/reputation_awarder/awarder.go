@@ -0,0 +1,193 @@
+// Package reputation_awarder listens for community activity and records the
+// reputation earned by the authors of the content involved.
+package reputation_awarder
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/reputation"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_querier"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_writer"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newAwarder)
+}
+
+type awarder struct {
+	logger            *slog.Logger
+	settings          *settings.SettingsRepository
+	reputationQuerier *reputation_querier.Querier
+	reputationWriter  *reputation_writer.Writer
+}
+
+func newAwarder(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	settings *settings.SettingsRepository,
+	reputationQuerier *reputation_querier.Querier,
+	reputationWriter *reputation_writer.Writer,
+) {
+	a := &awarder{
+		logger:            logger,
+		settings:          settings,
+		reputationQuerier: reputationQuerier,
+		reputationWriter:  reputationWriter,
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.post_liked", func(ctx context.Context, evt *message.EventPostLiked) error {
+			return a.awardForPost(ctx, evt.PostID, reputation.ReasonLikeReceived, evt.AccountID, xid.ID(evt.AccountID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.post_unliked", func(ctx context.Context, evt *message.EventPostUnliked) error {
+			return a.reputationWriter.Revoke(ctx, reputation.ReasonLikeReceived, xid.ID(evt.PostID), xid.ID(evt.AccountID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.post_reacted", func(ctx context.Context, evt *message.EventPostReacted) error {
+			return a.awardForPost(ctx, evt.PostID, reputation.ReasonReactionReceived, evt.AccountID, evt.ReactID)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.post_unreacted", func(ctx context.Context, evt *message.EventPostUnreacted) error {
+			return a.reputationWriter.Revoke(ctx, reputation.ReasonReactionReceived, xid.ID(evt.PostID), evt.ReactID)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.answer_accepted", func(ctx context.Context, evt *message.EventThreadAnswerAccepted) error {
+			threadAuthor, err := a.reputationQuerier.PostAuthor(ctx, evt.ThreadID)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return a.awardForPost(ctx, evt.ReplyID, reputation.ReasonAnswerAccepted, threadAuthor, xid.ID(evt.ThreadID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.answer_unaccepted", func(ctx context.Context, evt *message.EventThreadAnswerUnaccepted) error {
+			return a.reputationWriter.Revoke(ctx, reputation.ReasonAnswerAccepted, xid.ID(evt.ReplyID), xid.ID(evt.ThreadID))
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			author, err := a.reputationQuerier.PostAuthor(ctx, evt.ID)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			return a.awardMilestones(ctx, author, evt.ID)
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "reputation.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+			return a.awardMilestones(ctx, evt.ReplyAuthorID, evt.ReplyID)
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	}))
+}
+
+func (a *awarder) weights(ctx context.Context) (reputation.Weights, error) {
+	s, err := a.settings.Get(ctx)
+	if err != nil {
+		return reputation.Weights{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.Reputation.Or(reputation.DefaultSettings).Weights, nil
+}
+
+// awardForPost awards the author of a post for something another member did,
+// members never earn reputation from their own activity on their own posts.
+func (a *awarder) awardForPost(ctx context.Context, postID post.ID, reason reputation.Reason, actor account.AccountID, source xid.ID) error {
+	author, err := a.reputationQuerier.PostAuthor(ctx, postID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if author == actor {
+		return nil
+	}
+
+	weights, err := a.weights(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	points := weights.For(reason)
+	if points == 0 {
+		return nil
+	}
+
+	ref := datagraph.Ref{ID: xid.ID(postID), Kind: datagraph.KindPost}
+
+	err = a.reputationWriter.Award(ctx, author, reason, points, opt.New(ref), opt.New(source))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (a *awarder) awardMilestones(ctx context.Context, accountID account.AccountID, postID post.ID) error {
+	weights, err := a.weights(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if weights.Milestone == 0 {
+		return nil
+	}
+
+	contributions, err := a.reputationQuerier.Contributions(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	awarded, err := a.reputationQuerier.MilestonesAwarded(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if awarded >= len(reputation.Milestones) || contributions < reputation.Milestones[awarded] {
+		return nil
+	}
+
+	ref := datagraph.Ref{ID: xid.ID(postID), Kind: datagraph.KindPost}
+
+	err = a.reputationWriter.Award(ctx, accountID, reputation.ReasonMilestone, weights.Milestone, opt.New(ref), opt.New(xid.ID(postID)))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a.logger.Debug("awarded reputation milestone",
+		slog.String("account_id", accountID.String()),
+		slog.Int("contributions", contributions),
+	)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Package reputation_gate restricts permissions to members who have earned a
+// minimum amount of reputation, as configured in the instance settings.
+package reputation_gate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/reputation"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+)
+
+var errInsufficientReputation = fault.New("insufficient reputation", ftag.With(ftag.PermissionDenied))
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Gate struct {
+	settings          *settings.SettingsRepository
+	reputationQuerier *reputation_querier.Querier
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	reputationQuerier *reputation_querier.Querier,
+) *Gate {
+	return &Gate{
+		settings:          settings,
+		reputationQuerier: reputationQuerier,
+	}
+}
+
+// Check returns an error if the permission has a reputation threshold and the
+// account has not yet earned enough reputation to meet it.
+func (g *Gate) Check(ctx context.Context, accountID account.AccountID, perm rbac.Permission) error {
+	s, err := g.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threshold, ok := s.Reputation.Or(reputation.DefaultSettings).ThresholdFor(perm)
+	if !ok {
+		return nil
+	}
+
+	total, err := g.reputationQuerier.Total(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if total < threshold {
+		return fault.Wrap(errInsufficientReputation,
+			fctx.With(ctx),
+			fmsg.WithDesc("below threshold",
+				fmt.Sprintf("You need at least %d reputation to do this, you currently have %d.", threshold, total)),
+		)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package reqinfo
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -13,10 +14,19 @@ import (
 )
 
 type Info struct {
-	UserAgent  useragent.UserAgent
-	CacheQuery cachecontrol.Query
+	UserAgent     useragent.UserAgent
+	CacheQuery    cachecontrol.Query
+	ClientAddress string
+
+	// Hints about the person making the request, used to fill in their
+	// preferences when they haven't chosen any.
+	AcceptLanguage string
+	Timezone       string
 }
 
+// TimezoneHeader is sent by clients with the IANA time zone of the device.
+const TimezoneHeader = "X-Storyden-Timezone"
+
 type infoKey struct{}
 
 func WithRequestInfo(ctx context.Context, r *http.Request) context.Context {
@@ -33,8 +43,12 @@ func WithRequestInfo(ctx context.Context, r *http.Request) context.Context {
 	}
 
 	info := Info{
-		UserAgent:  ua,
-		CacheQuery: cachecontrol.NewQuery(ifNoneMatch, ifModifiedSince),
+		UserAgent:     ua,
+		CacheQuery:    cachecontrol.NewQuery(ifNoneMatch, ifModifiedSince),
+		ClientAddress: clientAddress(r),
+
+		AcceptLanguage: r.Header.Get("Accept-Language"),
+		Timezone:       r.Header.Get(TimezoneHeader),
 	}
 
 	return context.WithValue(ctx, infoKey{}, info)
@@ -62,6 +76,76 @@ func GetCacheQuery(ctx context.Context) cachecontrol.Query {
 	return i.CacheQuery
 }
 
+// GetClientAddress returns the IP address of the client which made the request,
+// preferring addresses forwarded by a reverse proxy or CDN.
+func GetClientAddress(ctx context.Context) string {
+	v := ctx.Value(infoKey{})
+	i, ok := v.(Info)
+	if !ok {
+		return ""
+	}
+
+	return i.ClientAddress
+}
+
+func GetUserAgent(ctx context.Context) string {
+	v := ctx.Value(infoKey{})
+	i, ok := v.(Info)
+	if !ok {
+		return ""
+	}
+
+	return i.UserAgent.String
+}
+
+// IsBot reports whether the request came from a known crawler or bot.
+func IsBot(ctx context.Context) bool {
+	v := ctx.Value(infoKey{})
+	i, ok := v.(Info)
+	if !ok {
+		return false
+	}
+
+	return i.UserAgent.Bot
+}
+
+// GetAcceptLanguage returns the languages the client asked for, if any.
+func GetAcceptLanguage(ctx context.Context) string {
+	v := ctx.Value(infoKey{})
+	i, ok := v.(Info)
+	if !ok {
+		return ""
+	}
+
+	return i.AcceptLanguage
+}
+
+// GetTimezone returns the time zone the client said its device is in, if any.
+func GetTimezone(ctx context.Context) string {
+	v := ctx.Value(infoKey{})
+	i, ok := v.(Info)
+	if !ok {
+		return ""
+	}
+
+	return i.Timezone
+}
+
+func clientAddress(r *http.Request) string {
+	for _, h := range []string{"CF-Connecting-IP", "X-Real-IP", "True-Client-IP"} {
+		if v := r.Header.Get(h); v != "" {
+			return v
+		}
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return ip
+}
+
 func notEmpty(s string) bool {
 	return s != ""
 }
@@ -0,0 +1,358 @@
+// Package scheduler runs recurring tasks on cron schedules. Each replica checks
+// which tasks are due but only the one which advances a task's next run time
+// queues it, the task then runs as a background job like any other.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/job"
+	"github.com/Southclaws/storyden/app/resources/scheduled_task"
+	"github.com/Southclaws/storyden/app/services/job_queue"
+	"github.com/Southclaws/storyden/internal/cron"
+)
+
+var (
+	DefaultCheckInterval = 15 * time.Second
+	DefaultJobRetention  = 7 * 24 * time.Hour
+	minCheckInterval     = time.Second
+)
+
+var ErrNotFound = fault.New("scheduled task not found", ftag.With(ftag.NotFound))
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type task struct {
+	name     string
+	expr     string
+	schedule cron.Schedule
+	fn       func(ctx context.Context) error
+}
+
+// Task is a registered task along with its stored run state.
+type Task struct {
+	scheduled_task.Task
+	DefaultSchedule string
+}
+
+// EffectiveSchedule is the admin's override if set, otherwise the schedule the
+// task was registered with.
+func (t *Task) EffectiveSchedule() string {
+	return t.Schedule.Or(t.DefaultSchedule)
+}
+
+type Scheduler struct {
+	logger *slog.Logger
+	repo   *scheduled_task.Repository
+	jobs   *job_queue.Queue
+
+	mu    sync.RWMutex
+	tasks map[string]*task
+
+	wake chan struct{}
+}
+
+func New(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	repo *scheduled_task.Repository,
+	jobs *job_queue.Queue,
+) *Scheduler {
+	s := &Scheduler{
+		logger: logger,
+		repo:   repo,
+		jobs:   jobs,
+		tasks:  map[string]*task{},
+		wake:   make(chan struct{}, 1),
+	}
+
+	// Finished jobs are kept for a while to inspect, dead jobs are kept until
+	// an admin retries or removes them.
+	s.Register("job_cleanup", "@daily", func(ctx context.Context) error {
+		_, err := jobs.Prune(ctx, time.Now().Add(-DefaultJobRetention))
+		return err
+	})
+
+	wctx, cancel := context.WithCancel(ctx)
+
+	lc.Append(fx.Hook{
+		OnStart: func(hctx context.Context) error {
+			now := time.Now()
+			for _, t := range s.registered() {
+				if err := repo.Ensure(hctx, t.name, t.schedule.Next(now)); err != nil {
+					return fault.Wrap(err, fctx.With(hctx))
+				}
+			}
+
+			go s.run(wctx)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+
+	return s
+}
+
+type runArgs struct{}
+
+// Register adds a recurring task run on the given cron expression. Tasks must
+// be registered before the application starts. A task is never queued again
+// while a previous run is still going and failed runs aren't retried, the next
+// scheduled run is the retry.
+func (s *Scheduler) Register(name string, expr string, fn func(ctx context.Context) error) {
+	t := &task{
+		name:     name,
+		expr:     expr,
+		schedule: cron.MustParse(expr),
+		fn:       fn,
+	}
+
+	s.mu.Lock()
+	s.tasks[name] = t
+	s.mu.Unlock()
+
+	job_queue.Register(s.jobs, jobKind(name), func(ctx context.Context, _ runArgs) error {
+		return s.execute(ctx, t)
+	}, job_queue.WithMaxAttempts(1))
+}
+
+func (s *Scheduler) List(ctx context.Context) ([]*Task, error) {
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tasks := []*Task{}
+	for _, r := range rows {
+		t, ok := s.task(r.Name)
+		if !ok {
+			continue
+		}
+		tasks = append(tasks, &Task{Task: *r, DefaultSchedule: t.expr})
+	}
+
+	return tasks, nil
+}
+
+func (s *Scheduler) Get(ctx context.Context, name string) (*Task, error) {
+	t, ok := s.task(name)
+	if !ok {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	r, err := s.repo.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Task{Task: *r, DefaultSchedule: t.expr}, nil
+}
+
+type Partial struct {
+	// Schedule overrides the registered cron expression, an empty string goes
+	// back to the registered schedule.
+	Schedule opt.Optional[string]
+	Enabled  opt.Optional[bool]
+}
+
+func (s *Scheduler) Update(ctx context.Context, name string, p Partial) (*Task, error) {
+	t, ok := s.task(name)
+	if !ok {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	current, err := s.repo.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	opts := []scheduled_task.Option{}
+	schedule := s.scheduleFor(t, current)
+
+	if v, ok := p.Schedule.Get(); ok {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			schedule = t.schedule
+			opts = append(opts, scheduled_task.WithSchedule(opt.NewEmpty[string]()))
+		} else {
+			parsed, err := cron.Parse(v)
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument), fmsg.WithDesc("invalid schedule", "The schedule is not a valid cron expression."))
+			}
+			schedule = parsed
+			opts = append(opts, scheduled_task.WithSchedule(opt.New(v)))
+		}
+	}
+
+	if v, ok := p.Enabled.Get(); ok {
+		opts = append(opts, scheduled_task.WithEnabled(v))
+	}
+
+	opts = append(opts, scheduled_task.WithNextRunAt(schedule.Next(time.Now())))
+
+	updated, err := s.repo.Update(ctx, name, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s.notify()
+
+	return &Task{Task: *updated, DefaultSchedule: t.expr}, nil
+}
+
+// Trigger queues a run of the task straight away, outside of its schedule. If
+// the task is already queued or running that job is returned instead.
+func (s *Scheduler) Trigger(ctx context.Context, name string) (*job.Job, error) {
+	if _, ok := s.task(name); !ok {
+		return nil, fault.Wrap(ErrNotFound, fctx.With(ctx))
+	}
+
+	j, err := s.enqueue(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return j, nil
+}
+
+func (s *Scheduler) enqueue(ctx context.Context, name string) (*job.Job, error) {
+	kind := jobKind(name)
+	return job_queue.Enqueue(ctx, s.jobs, kind, runArgs{}, job.WithUniqueKey(kind))
+}
+
+func (s *Scheduler) execute(ctx context.Context, t *task) error {
+	if err := s.repo.RecordStart(ctx, t.name, time.Now()); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	runErr := t.fn(ctx)
+
+	if err := s.repo.RecordFinish(context.WithoutCancel(ctx), t.name, time.Now(), runErr); err != nil {
+		s.logger.Error("failed to record scheduled task result", slog.String("task", t.name), slog.String("error", err.Error()))
+	}
+
+	return runErr
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-s.wake:
+		}
+
+		timer.Reset(s.tick(ctx, time.Now()))
+	}
+}
+
+// tick queues every task which is due and returns how long to wait until the
+// next one is.
+func (s *Scheduler) tick(ctx context.Context, now time.Time) time.Duration {
+	wait := DefaultCheckInterval
+
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("failed to list scheduled tasks", slog.String("error", err.Error()))
+		}
+		return wait
+	}
+
+	for _, r := range rows {
+		t, ok := s.task(r.Name)
+		if !ok || !r.Enabled {
+			continue
+		}
+
+		next := r.NextRunAt
+		if !next.After(now) {
+			next = s.scheduleFor(t, r).Next(now)
+
+			won, err := s.repo.Advance(ctx, t.name, now, next)
+			if err != nil {
+				s.logger.Error("failed to advance scheduled task", slog.String("task", t.name), slog.String("error", err.Error()))
+				continue
+			}
+
+			if won {
+				if _, err := s.enqueue(ctx, t.name); err != nil {
+					s.logger.Error("failed to queue scheduled task", slog.String("task", t.name), slog.String("error", err.Error()))
+				}
+			}
+		}
+
+		wait = min(wait, next.Sub(now))
+	}
+
+	return max(wait, minCheckInterval)
+}
+
+func (s *Scheduler) scheduleFor(t *task, r *scheduled_task.Task) cron.Schedule {
+	expr, ok := r.Schedule.Get()
+	if !ok {
+		return t.schedule
+	}
+
+	parsed, err := cron.Parse(expr)
+	if err != nil {
+		s.logger.Warn("ignoring invalid scheduled task override", slog.String("task", t.name), slog.String("schedule", expr))
+		return t.schedule
+	}
+
+	return parsed
+}
+
+func (s *Scheduler) task(name string) (*task, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.tasks[name]
+	return t, ok
+}
+
+func (s *Scheduler) registered() []*task {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tasks := make([]*task, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		tasks = append(tasks, t)
+	}
+	slices.SortFunc(tasks, func(a, b *task) int { return strings.Compare(a.name, b.name) })
+
+	return tasks
+}
+
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func jobKind(name string) string {
+	return "scheduled." + name
+}
@@ -0,0 +1,64 @@
+package fulltextsearch
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
+	"github.com/Southclaws/storyden/app/resources/datagraph/fulltext"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/services/search/searcher"
+)
+
+type Searcher struct {
+	querier  *fulltext.Querier
+	hydrator *hydrate.Hydrator
+}
+
+func New(querier *fulltext.Querier, hydrator *hydrate.Hydrator) *Searcher {
+	return &Searcher{querier: querier, hydrator: hydrator}
+}
+
+func (s *Searcher) Search(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[datagraph.Item], error) {
+	refs, err := s.querier.Search(ctx, q, p, opts.Kinds.OrZero(), opts.Filter)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := s.hydrator.Hydrate(ctx, refs.Items...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := pagination.ConvertPageResult(*refs, items)
+
+	return &result, nil
+}
+
+func (s *Searcher) Facets(ctx context.Context, q string, opts searcher.Options) (*facet.Facets, error) {
+	facets, err := s.querier.Facets(ctx, q, opts.Kinds.OrZero(), opts.Filter)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return facets, nil
+}
+
+func (s *Searcher) Highlight(ctx context.Context, q string, items []datagraph.Item) (map[xid.ID]string, error) {
+	refs := make([]*datagraph.Ref, 0, len(items))
+	for _, i := range items {
+		refs = append(refs, datagraph.NewRef(i))
+	}
+
+	highlights, err := s.querier.Highlight(ctx, q, refs)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return highlights, nil
+}
@@ -0,0 +1,259 @@
+// Package hybridsearch routes a search to the keyword or semantic searcher
+// based on the requested mode and, for hybrid mode, merges both result lists
+// using weighted reciprocal rank fusion.
+package hybridsearch
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/search/searcher"
+)
+
+// rrfK dampens the influence of the highest ranks, 60 is the value used in the
+// original reciprocal rank fusion paper and works well without tuning.
+const rrfK = 60
+
+// candidateCount is how many results are taken from each searcher to be fused,
+// hybrid results are then paginated from the fused list.
+const candidateCount = 100
+
+var ErrSemanticDisabled = fault.New("semantic search is not enabled", ftag.With(ftag.InvalidArgument))
+
+type Searcher struct {
+	keyword        searcher.Searcher
+	semantic       opt.Optional[searcher.Searcher]
+	semanticWeight float64
+	facetQuerier   *facet.Querier
+}
+
+func New(keyword searcher.Searcher, semantic opt.Optional[searcher.Searcher], semanticWeight float64, facetQuerier *facet.Querier) (*Searcher, error) {
+	if semanticWeight < 0 || semanticWeight > 1 {
+		return nil, fault.New("SEARCH_HYBRID_SEMANTIC_WEIGHT must be between 0 and 1")
+	}
+
+	return &Searcher{
+		keyword:        keyword,
+		semantic:       semantic,
+		semanticWeight: semanticWeight,
+		facetQuerier:   facetQuerier,
+	}, nil
+}
+
+func (s *Searcher) Search(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[datagraph.Item], error) {
+	opts = readable(ctx, opts)
+
+	switch s.mode(opts) {
+	case searcher.ModeKeyword:
+		return s.keyword.Search(ctx, q, p, opts)
+
+	case searcher.ModeSemantic:
+		semantic, ok := s.semantic.Get()
+		if !ok {
+			return nil, fault.Wrap(ErrSemanticDisabled, fctx.With(ctx), fmsg.WithDesc("disabled", "Semantic search requires a Semdex provider to be configured."))
+		}
+
+		if opts.Filter.IsEmpty() {
+			return semantic.Search(ctx, q, p, opts)
+		}
+
+		items, err := s.semanticCandidates(ctx, semantic, q, opts)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return page(p, items), nil
+
+	default:
+		return s.hybrid(ctx, q, p, opts)
+	}
+}
+
+func (s *Searcher) mode(opts searcher.Options) searcher.Mode {
+	if m, ok := opts.Mode.Get(); ok {
+		return m
+	}
+
+	if s.semantic.Ok() {
+		return searcher.ModeHybrid
+	}
+
+	return searcher.ModeKeyword
+}
+
+func (s *Searcher) hybrid(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[datagraph.Item], error) {
+	semantic, ok := s.semantic.Get()
+	if !ok {
+		return nil, fault.Wrap(ErrSemanticDisabled, fctx.With(ctx), fmsg.WithDesc("disabled", "Hybrid search requires a Semdex provider to be configured."))
+	}
+
+	cp := pagination.NewPageParams(1, candidateCount)
+
+	var keywordResults, semanticResults []datagraph.Item
+
+	eg, ectx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		r, err := s.keyword.Search(ectx, q, cp, opts)
+		if err != nil {
+			return err
+		}
+		keywordResults = r.Items
+		return nil
+	})
+
+	eg.Go(func() error {
+		items, err := s.semanticCandidates(ectx, semantic, q, opts)
+		if err != nil {
+			return err
+		}
+		semanticResults = items
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	fused := Fuse(
+		Ranking{Items: keywordResults, Weight: 1 - s.semanticWeight},
+		Ranking{Items: semanticResults, Weight: s.semanticWeight},
+	)
+
+	return page(p, fused), nil
+}
+
+// semanticCandidates returns the top semantic matches with the filter applied.
+// Vector indexes only know about content kinds so the remaining filters are
+// applied by the database to the candidate set before it is paginated.
+func (s *Searcher) semanticCandidates(ctx context.Context, semantic searcher.Searcher, q string, opts searcher.Options) ([]datagraph.Item, error) {
+	r, err := semantic.Search(ctx, q, pagination.NewPageParams(1, candidateCount), opts)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if opts.Filter.IsEmpty() {
+		return r.Items, nil
+	}
+
+	refs, err := s.facetQuerier.FilterRefs(ctx, dt.Map(r.Items, datagraph.NewRef), opts.Filter)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	keep := lo.SliceToMap(refs, func(r *datagraph.Ref) (xid.ID, struct{}) { return r.ID, struct{}{} })
+
+	return dt.Filter(r.Items, func(i datagraph.Item) bool {
+		_, ok := keep[i.GetID()]
+		return ok
+	}), nil
+}
+
+func page(p pagination.Parameters, items []datagraph.Item) *pagination.Result[datagraph.Item] {
+	total := len(items)
+	start := min(p.Offset(), total)
+	end := min(start+p.Limit(), total)
+
+	result := pagination.NewPageResult(p, total, items[start:end])
+
+	return &result
+}
+
+// Facets delegates to the keyword searcher, facet counts describe the whole
+// set of keyword matches which semantic search has no equivalent of.
+func (s *Searcher) Facets(ctx context.Context, q string, opts searcher.Options) (*facet.Facets, error) {
+	f, ok := s.keyword.(searcher.Faceter)
+	if !ok {
+		return nil, nil
+	}
+
+	return f.Facets(ctx, q, readable(ctx, opts))
+}
+
+// readable hides posts in categories the session's roles can't read, unless
+// the caller has already chosen whose roles to search as.
+func readable(ctx context.Context, opts searcher.Options) searcher.Options {
+	if !opts.Filter.ReadableBy.Ok() {
+		opts.Filter.ReadableBy = opt.New(session.GetOptRoles(ctx))
+	}
+
+	return opts
+}
+
+// Highlight delegates to the keyword searcher when it supports highlighting,
+// matched terms are useful regardless of which searcher produced the result.
+func (s *Searcher) Highlight(ctx context.Context, q string, items []datagraph.Item) (map[xid.ID]string, error) {
+	h, ok := s.keyword.(searcher.Highlighter)
+	if !ok {
+		return nil, nil
+	}
+
+	return h.Highlight(ctx, q, items)
+}
+
+type Ranking struct {
+	Items  []datagraph.Item
+	Weight float64
+}
+
+// Fuse merges ranked lists using weighted reciprocal rank fusion. Each item
+// scores weight/(k+rank) for every list it appears in, so items found by more
+// than one searcher are boosted above those found by only one of them.
+func Fuse(rankings ...Ranking) []datagraph.Item {
+	type entry struct {
+		item  datagraph.Item
+		score float64
+		first int
+	}
+
+	entries := map[xid.ID]*entry{}
+	order := 0
+
+	for _, r := range rankings {
+		for rank, item := range r.Items {
+			id := item.GetID()
+
+			e, ok := entries[id]
+			if !ok {
+				e = &entry{item: item, first: order}
+				entries[id] = e
+				order++
+			}
+
+			e.score += r.Weight / float64(rrfK+rank+1)
+		}
+	}
+
+	list := make([]*entry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, e)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].score == list[j].score {
+			return list[i].first < list[j].first
+		}
+		return list[i].score > list[j].score
+	})
+
+	items := make([]datagraph.Item, len(list))
+	for i, e := range list {
+		items[i] = e.item
+	}
+
+	return items
+}
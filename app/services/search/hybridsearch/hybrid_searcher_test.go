@@ -0,0 +1,60 @@
+package hybridsearch
+
+import (
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+)
+
+func TestFuse(t *testing.T) {
+	node := func() datagraph.Item {
+		return &library.Node{Mark: library.NewMark(xid.New(), "n")}
+	}
+
+	x, y, z := node(), node(), node()
+
+	ids := func(items []datagraph.Item) []xid.ID {
+		out := []xid.ID{}
+		for _, i := range items {
+			out = append(out, i.GetID())
+		}
+		return out
+	}
+
+	t.Run("shared_items_rank_first", func(t *testing.T) {
+		a := assert.New(t)
+
+		fused := Fuse(
+			Ranking{Items: []datagraph.Item{x, y}, Weight: 0.5},
+			Ranking{Items: []datagraph.Item{z, y}, Weight: 0.5},
+		)
+
+		a.Equal([]xid.ID{y.GetID(), x.GetID(), z.GetID()}, ids(fused))
+	})
+
+	t.Run("weighting", func(t *testing.T) {
+		a := assert.New(t)
+
+		fused := Fuse(
+			Ranking{Items: []datagraph.Item{x}, Weight: 0.2},
+			Ranking{Items: []datagraph.Item{z}, Weight: 0.8},
+		)
+
+		a.Equal([]xid.ID{z.GetID(), x.GetID()}, ids(fused))
+	})
+
+	t.Run("zero_weight_keeps_items", func(t *testing.T) {
+		a := assert.New(t)
+
+		fused := Fuse(
+			Ranking{Items: []datagraph.Item{x, y}, Weight: 1},
+			Ranking{Items: []datagraph.Item{z}, Weight: 0},
+		)
+
+		a.Equal([]xid.ID{x.GetID(), y.GetID(), z.GetID()}, ids(fused))
+	})
+}
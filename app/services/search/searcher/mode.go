@@ -0,0 +1,11 @@
+package searcher
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type modeEnum string
+
+const (
+	modeKeyword  modeEnum = "keyword"
+	modeSemantic modeEnum = "semantic"
+	modeHybrid   modeEnum = "hybrid"
+)
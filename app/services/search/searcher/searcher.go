@@ -4,12 +4,17 @@ import (
 	"context"
 
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 )
 
 type Options struct {
-	Kinds opt.Optional[[]datagraph.Kind]
+	Kinds  opt.Optional[[]datagraph.Kind]
+	Mode   opt.Optional[Mode]
+	Filter facet.Filter
 }
 
 type Searcher interface {
@@ -17,5 +22,17 @@ type Searcher interface {
 }
 
 type SingleKindSearcher interface {
-	Search(ctx context.Context, query string, p pagination.Parameters) (*pagination.Result[datagraph.Item], error)
+	Search(ctx context.Context, query string, p pagination.Parameters, f facet.Filter) (*pagination.Result[datagraph.Item], error)
+}
+
+// Highlighter may be implemented by a Searcher which is able to produce a short
+// excerpt for each result with the terms matching the query marked up.
+type Highlighter interface {
+	Highlight(ctx context.Context, q string, items []datagraph.Item) (map[xid.ID]string, error)
+}
+
+// Faceter may be implemented by a Searcher which is able to count every match
+// of a query grouped by each of the filterable dimensions.
+type Faceter interface {
+	Facets(ctx context.Context, q string, opts Options) (*facet.Facets, error)
 }
@@ -1,8 +1,12 @@
 package search
 
 import (
+	"github.com/Southclaws/opt"
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
+	"github.com/Southclaws/storyden/app/services/search/fulltextsearch"
+	"github.com/Southclaws/storyden/app/services/search/hybridsearch"
 	"github.com/Southclaws/storyden/app/services/search/searcher"
 	"github.com/Southclaws/storyden/app/services/search/simplesearch"
 	"github.com/Southclaws/storyden/app/services/semdex"
@@ -12,15 +16,26 @@ import (
 func New(
 	cfg config.Config,
 	simpleSearcher *simplesearch.ParallelSearcher,
+	fullTextSearcher *fulltextsearch.Searcher,
 	semdexSearcher semdex.Searcher,
-) searcher.Searcher {
-	switch cfg.SemdexProvider {
-	case "chromem", "weaviate", "pinecone":
-		return semdexSearcher
+	facetQuerier *facet.Querier,
+) (searcher.Searcher, error) {
+	var keyword searcher.Searcher
+	switch cfg.SearchProvider {
+	case "postgres", "fulltext":
+		keyword = fullTextSearcher
 
 	default:
-		return simpleSearcher
+		keyword = simpleSearcher
 	}
+
+	semantic := opt.NewEmpty[searcher.Searcher]()
+	switch cfg.SemdexProvider {
+	case "chromem", "weaviate", "pinecone", "pgvector", "qdrant":
+		semantic = opt.New[searcher.Searcher](semdexSearcher)
+	}
+
+	return hybridsearch.New(keyword, semantic, cfg.SearchHybridSemanticWeight, facetQuerier)
 }
 
 func Build() fx.Option {
@@ -28,6 +43,7 @@ func Build() fx.Option {
 		fx.Provide(
 			New,
 			simplesearch.NewParallelSearcher,
+			fulltextsearch.New,
 		),
 	)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/Southclaws/fault/fctx"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/library/node_search"
 	"github.com/Southclaws/storyden/app/resources/pagination"
@@ -17,8 +18,18 @@ type nodeSearcher struct {
 	node_search node_search.Search
 }
 
-func (s *nodeSearcher) Search(ctx context.Context, query string, p pagination.Parameters) (*pagination.Result[datagraph.Item], error) {
-	rs, err := s.node_search.Search(ctx, p, node_search.WithNameContains(query), node_search.WithContentContains(query))
+func (s *nodeSearcher) Search(ctx context.Context, query string, p pagination.Parameters, f facet.Filter) (*pagination.Result[datagraph.Item], error) {
+	predicates, ok := f.NodePredicates()
+	if !ok {
+		result := pagination.NewPageResult(p, 0, []datagraph.Item{})
+		return &result, nil
+	}
+
+	rs, err := s.node_search.Search(ctx, p,
+		node_search.WithNameContains(query),
+		node_search.WithContentContains(query),
+		node_search.WithPredicates(predicates...),
+	)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/Southclaws/fault/fctx"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/post_search"
@@ -17,8 +18,8 @@ type postSearcher struct {
 	post_search post_search.Repository
 }
 
-func (s *postSearcher) Search(ctx context.Context, query string, p pagination.Parameters) (*pagination.Result[datagraph.Item], error) {
-	rs, err := s.post_search.Search(ctx, p, post_search.WithKeywords(query))
+func (s *postSearcher) Search(ctx context.Context, query string, p pagination.Parameters, f facet.Filter) (*pagination.Result[datagraph.Item], error) {
+	rs, err := s.post_search.Search(ctx, p, post_search.WithKeywords(query), post_search.WithPredicates(f.PostPredicates()...))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
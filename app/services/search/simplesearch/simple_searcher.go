@@ -65,7 +65,7 @@ func (s *ParallelSearcher) Search(ctx context.Context, q string, p pagination.Pa
 	for _, v := range searchers {
 		v := v
 		eg.Go(func() error {
-			r, err := v.Search(ctx, q, subsearchParams)
+			r, err := v.Search(ctx, q, subsearchParams, opts.Filter)
 			if err != nil {
 				return err
 			}
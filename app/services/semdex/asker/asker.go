@@ -1,11 +1,14 @@
 package asker
 
 import (
+	"cmp"
 	"context"
 	"html/template"
 	"log/slog"
+	"slices"
 	"strings"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
@@ -86,14 +89,18 @@ Sources:
 
 const maxContextForRAG = 10
 
-func buildContextPrompt(ctx context.Context, s semdex.Searcher, q string) (string, error) {
+// confidenceSample is how many of the most relevant sources are considered
+// when scoring how confident an answer is.
+const confidenceSample = 3
+
+func buildContextPrompt(ctx context.Context, s semdex.Searcher, q string) (string, []*semdex.Chunk, error) {
 	chunks, err := s.SearchChunks(ctx, q, pagination.NewPageParams(1, 200), searcher.Options{})
 	if err != nil {
-		return "", fault.Wrap(err, fctx.With(ctx))
+		return "", nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
 	if len(chunks) == 0 {
-		return "", fault.New("no context found for question", fctx.With(ctx), ftag.With(ftag.NotFound))
+		return "", nil, fault.New("no context found for question", fctx.With(ctx), ftag.With(ftag.NotFound))
 	}
 
 	if len(chunks) > maxContextForRAG {
@@ -106,8 +113,44 @@ func buildContextPrompt(ctx context.Context, s semdex.Searcher, q string) (strin
 		"Question": q,
 	})
 	if err != nil {
-		return "", fault.Wrap(err, fctx.With(ctx))
+		return "", nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	return t.String(), nil
+	return t.String(), chunks, nil
+}
+
+// confidence scores an answer by the mean relevance of the best sources it
+// was given, so an answer grounded in a few strong matches scores higher than
+// one assembled from many weak ones.
+func confidence(chunks []*semdex.Chunk) float64 {
+	scores := dt.Map(chunks, func(c *semdex.Chunk) float64 { return c.Relevance })
+	slices.SortFunc(scores, func(a, b float64) int { return cmp.Compare(b, a) })
+
+	n := min(len(scores), confidenceSample)
+	if n == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range scores[:n] {
+		sum += s
+	}
+
+	return sum / float64(n)
+}
+
+// withSources yields the retrieved sources before the rest of the answer.
+func withSources(chunks []*semdex.Chunk, iter semdex.AskResponseIterator) semdex.AskResponseIterator {
+	return func(yield func(semdex.AskResponseChunk, error) bool) {
+		sources := &semdex.AskResponseChunkSources{
+			Sources:    chunks,
+			Confidence: confidence(chunks),
+		}
+
+		if !yield(sources, nil) {
+			return
+		}
+
+		iter(yield)
+	}
 }
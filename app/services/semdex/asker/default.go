@@ -19,7 +19,7 @@ type defaultAsker struct {
 }
 
 func (a *defaultAsker) Ask(ctx context.Context, q string, parent opt.Optional[xid.ID]) (semdex.AskResponseIterator, error) {
-	t, err := buildContextPrompt(ctx, a.searcher, q)
+	t, chunks, err := buildContextPrompt(ctx, a.searcher, q)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -29,5 +29,5 @@ func (a *defaultAsker) Ask(ctx context.Context, q string, parent opt.Optional[xi
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	return streamExtractor(iter), nil
+	return withSources(chunks, streamExtractor(iter)), nil
 }
@@ -0,0 +1,93 @@
+package asker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/opt"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/services/search/searcher"
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/internal/infrastructure/ai"
+)
+
+type chunkSearcher struct {
+	chunks []*semdex.Chunk
+}
+
+func (s *chunkSearcher) Search(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[datagraph.Item], error) {
+	return nil, nil
+}
+
+func (s *chunkSearcher) SearchRefs(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[*datagraph.Ref], error) {
+	return nil, nil
+}
+
+func (s *chunkSearcher) SearchChunks(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) ([]*semdex.Chunk, error) {
+	return s.chunks, nil
+}
+
+func Test_confidence(t *testing.T) {
+	a := assert.New(t)
+
+	a.Zero(confidence(nil))
+	a.InDelta(0.5, confidence([]*semdex.Chunk{{Relevance: 0.5}}), 0.0001)
+	a.InDelta(0.8, confidence([]*semdex.Chunk{
+		{Relevance: 0.1},
+		{Relevance: 0.9},
+		{Relevance: 0.7},
+		{Relevance: 0.8},
+	}), 0.0001)
+}
+
+func Test_defaultAsker(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+	ctx := context.Background()
+
+	chunks := []*semdex.Chunk{
+		{ID: xid.New(), Kind: datagraph.KindThread, Content: "first", Relevance: 0.9},
+		{ID: xid.New(), Kind: datagraph.KindNode, Content: "second", Relevance: 0.6},
+	}
+
+	asker := &defaultAsker{
+		searcher: &chunkSearcher{chunks: chunks},
+		prompter: &ai.Mock{},
+	}
+
+	iter, err := asker.Ask(ctx, "question", opt.NewEmpty[xid.ID]())
+	r.NoError(err)
+
+	var got []semdex.AskResponseChunk
+	for chunk, err := range iter {
+		r.NoError(err)
+		got = append(got, chunk)
+	}
+
+	r.NotEmpty(got)
+
+	sources, ok := got[0].(*semdex.AskResponseChunkSources)
+	r.True(ok, "the first chunk must list the sources")
+	a.Equal(chunks, sources.Sources)
+	a.InDelta(0.75, sources.Confidence, 0.0001)
+
+	for _, chunk := range got[1:] {
+		_, isSources := chunk.(*semdex.AskResponseChunkSources)
+		a.False(isSources)
+	}
+}
+
+func Test_defaultAsker_noContext(t *testing.T) {
+	asker := &defaultAsker{
+		searcher: &chunkSearcher{},
+		prompter: &ai.Mock{},
+	}
+
+	_, err := asker.Ask(context.Background(), "question", opt.NewEmpty[xid.ID]())
+	assert.Error(t, err)
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/Southclaws/storyden/app/services/semdex"
 	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
 )
 
 const (
@@ -49,7 +50,7 @@ func newPerplexityAsker(cfg config.Config, searcher semdex.Searcher) (*Perplexit
 		apiKey:      cfg.PerplexityAPIKey,
 		endpoint:    DefaultEndpoint,
 		model:       Llama_3_1SonarSmall_128kOnline,
-		httpClient:  &http.Client{},
+		httpClient:  &http.Client{Transport: outbound.Transport(nil)},
 		httpTimeout: DefautTimeout,
 		searcher:    searcher,
 	}
@@ -57,7 +58,7 @@ func newPerplexityAsker(cfg config.Config, searcher semdex.Searcher) (*Perplexit
 }
 
 func (a *Perplexity) Ask(ctx context.Context, q string, parent opt.Optional[xid.ID]) (semdex.AskResponseIterator, error) {
-	t, err := buildContextPrompt(ctx, a.searcher, q)
+	t, chunks, err := buildContextPrompt(ctx, a.searcher, q)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -138,7 +139,7 @@ func (a *Perplexity) Ask(ctx context.Context, q string, parent opt.Optional[xid.
 		}
 	}
 
-	return streamExtractor(iter), nil
+	return withSources(chunks, streamExtractor(iter)), nil
 }
 
 type Message struct {
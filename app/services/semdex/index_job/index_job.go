@@ -0,0 +1,81 @@
+// Package index_job queues changes to the semantic index as durable jobs, so
+// an item isn't left out of the index when the provider is briefly unavailable
+// or the instance restarts before it's been indexed.
+package index_job
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/services/job_queue"
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type args struct {
+	ID string
+}
+
+// Queuer enqueues index and deindex jobs for each kind of item. The semdexer
+// for a kind registers how its items are indexed with Handle.
+type Queuer struct {
+	jobs *job_queue.Queue
+}
+
+func New(jobs *job_queue.Queue) *Queuer {
+	return &Queuer{jobs: jobs}
+}
+
+// JobIndex is the kind of job which indexes one item of the given kind.
+func JobIndex(kind datagraph.Kind) string {
+	return "semdex.index." + kind.String()
+}
+
+// JobDeindex is the kind of job which removes one item of the given kind.
+func JobDeindex(kind datagraph.Kind) string {
+	return "semdex.deindex." + kind.String()
+}
+
+// Handle registers the handlers for a kind of item, deindex may be nil for
+// kinds which are never removed from the index.
+func (q *Queuer) Handle(kind datagraph.Kind, index func(ctx context.Context, id xid.ID) error, deindex func(ctx context.Context, id xid.ID) error) {
+	job_queue.Register(q.jobs, JobIndex(kind), handler(index))
+
+	if deindex != nil {
+		job_queue.Register(q.jobs, JobDeindex(kind), handler(deindex))
+	}
+}
+
+func (q *Queuer) Index(ctx context.Context, kind datagraph.Kind, id xid.ID) error {
+	return q.enqueue(ctx, JobIndex(kind), id)
+}
+
+func (q *Queuer) Deindex(ctx context.Context, kind datagraph.Kind, id xid.ID) error {
+	return q.enqueue(ctx, JobDeindex(kind), id)
+}
+
+func (q *Queuer) enqueue(ctx context.Context, kind string, id xid.ID) error {
+	if _, err := job_queue.Enqueue(ctx, q.jobs, kind, args{ID: id.String()}); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func handler(fn func(ctx context.Context, id xid.ID) error) func(ctx context.Context, a args) error {
+	return func(ctx context.Context, a args) error {
+		id, err := xid.FromString(a.ID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return fn(ctx, id)
+	}
+}
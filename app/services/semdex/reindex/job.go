@@ -0,0 +1,65 @@
+package reindex
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/index_status"
+)
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type statusEnum string
+
+const (
+	statusRunning   statusEnum = "running"
+	statusCompleted statusEnum = "completed"
+	statusFailed    statusEnum = "failed"
+)
+
+type Progress struct {
+	Kind datagraph.Kind
+	// Total is the number of items in scope for the job.
+	Total int
+	// Indexed is how many items have been queued for indexing.
+	Indexed int
+	// Removed is how many unpublished or deleted items have been queued for
+	// removal from the index.
+	Removed int
+}
+
+type Job struct {
+	ID         xid.ID
+	Status     Status
+	Range      index_status.Range
+	StartedAt  time.Time
+	FinishedAt opt.Optional[time.Time]
+	Error      opt.Optional[string]
+	Progress   []Progress
+}
+
+// Full is true when the job covers all content rather than a date range.
+func (j Job) Full() bool {
+	return !j.Range.From.Ok() && !j.Range.To.Ok()
+}
+
+type KindHealth struct {
+	index_status.Health
+	// InIndex is the number of distinct items stored in the index, only when
+	// the Semdex provider supports counting.
+	InIndex opt.Optional[int]
+}
+
+// Drift is how many items need to be indexed, or removed when negative, for
+// the index to match the database. When the provider can't count items, only
+// the database bookkeeping is considered.
+func (h KindHealth) Drift() int {
+	if n, ok := h.InIndex.Get(); ok {
+		return h.Published - n
+	}
+
+	return h.Stale + h.Missing
+}
@@ -0,0 +1,239 @@
+// Package reindex provides administrative tooling for rebuilding the semantic
+// index and reporting on how far it has drifted from the database.
+package reindex
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/index_status"
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/semdex/index_job"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+var (
+	ErrDisabled       = fault.New("semdex is not enabled", ftag.With(ftag.InvalidArgument))
+	ErrAlreadyRunning = fault.New("a reindex job is already running", ftag.With(ftag.AlreadyExists))
+)
+
+// batchSize is how many items are read from the database at a time.
+const batchSize = 500
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+// Manager runs at most one reindex job at a time. Job state is held in memory
+// so it's only visible on the instance which started it and is lost on restart,
+// which is fine as a new job can always be started to pick up where it left off.
+type Manager struct {
+	ctx      context.Context
+	logger   *slog.Logger
+	enabled  bool
+	provider string
+	status   *index_status.Querier
+	semdex   semdex.Querier
+	queue    *index_job.Queuer
+
+	mu  sync.Mutex
+	job *Job
+}
+
+func New(
+	ctx context.Context,
+	cfg config.Config,
+	logger *slog.Logger,
+	status *index_status.Querier,
+	semdexQuerier semdex.Querier,
+	queue *index_job.Queuer,
+) *Manager {
+	return &Manager{
+		ctx:      ctx,
+		logger:   logger,
+		enabled:  cfg.SemdexProvider != "",
+		provider: cfg.SemdexProvider,
+		status:   status,
+		semdex:   semdexQuerier,
+		queue:    queue,
+	}
+}
+
+// Start begins a reindex of the given kinds, or all indexed kinds if empty.
+// When the range is empty every item is reindexed, otherwise only the items
+// updated within the range are.
+func (m *Manager) Start(ctx context.Context, r index_status.Range, kinds []datagraph.Kind) (*Job, error) {
+	if !m.enabled {
+		return nil, fault.Wrap(ErrDisabled, fctx.With(ctx), fmsg.WithDesc("disabled", "A Semdex provider must be configured to rebuild the index."))
+	}
+
+	if from, ok := r.From.Get(); ok {
+		if to, ok := r.To.Get(); ok && !to.After(from) {
+			return nil, fault.Wrap(fault.New("range end must be after range start"), fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+	}
+
+	if len(kinds) == 0 {
+		kinds = index_status.Kinds
+	}
+
+	progress := make([]Progress, 0, len(kinds))
+	for _, k := range kinds {
+		total, err := m.status.Count(ctx, k, r)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		progress = append(progress, Progress{Kind: k, Total: total})
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.job != nil && m.job.Status == StatusRunning {
+		return nil, fault.Wrap(ErrAlreadyRunning, fctx.With(ctx))
+	}
+
+	m.job = &Job{
+		ID:        xid.New(),
+		Status:    StatusRunning,
+		Range:     r,
+		StartedAt: time.Now(),
+		Progress:  progress,
+	}
+
+	job := *m.job
+
+	// The job outlives the request which started it so it runs on the
+	// application's context rather than the request's.
+	go m.run(m.ctx, job)
+
+	return &job, nil
+}
+
+// Current returns a snapshot of the most recent job, if any has been started.
+func (m *Manager) Current() opt.Optional[Job] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.job == nil {
+		return opt.NewEmpty[Job]()
+	}
+
+	job := *m.job
+	job.Progress = append([]Progress(nil), m.job.Progress...)
+
+	return opt.New(job)
+}
+
+func (m *Manager) Health(ctx context.Context) ([]KindHealth, error) {
+	health, err := m.status.Health(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var inIndex map[datagraph.Kind]int
+	if c, ok := m.semdex.(semdex.Counter); ok && m.enabled {
+		inIndex, err = c.Count(ctx)
+		if err != nil && !errors.Is(err, semdex.ErrCountUnsupported) {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	out := make([]KindHealth, len(health))
+	for i, h := range health {
+		out[i] = KindHealth{Health: *h}
+		if inIndex != nil {
+			out[i].InIndex = opt.New(inIndex[h.Kind])
+		}
+	}
+
+	return out, nil
+}
+
+func (m *Manager) Provider() string {
+	return m.provider
+}
+
+func (m *Manager) run(ctx context.Context, job Job) {
+	err := func() error {
+		for i, p := range job.Progress {
+			if err := m.runKind(ctx, job, i, p.Kind); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.job.FinishedAt = opt.New(time.Now())
+	if err != nil {
+		m.logger.Error("reindex job failed", slog.String("job_id", job.ID.String()), slog.String("error", err.Error()))
+		m.job.Status = StatusFailed
+		m.job.Error = opt.New(err.Error())
+		return
+	}
+
+	m.logger.Info("reindex job completed", slog.String("job_id", job.ID.String()))
+	m.job.Status = StatusCompleted
+}
+
+func (m *Manager) runKind(ctx context.Context, job Job, i int, kind datagraph.Kind) error {
+	cursor := xid.NilID()
+
+	for {
+		targets, err := m.status.Targets(ctx, kind, job.Range, cursor, batchSize)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if len(targets) == 0 {
+			return nil
+		}
+
+		for _, t := range targets {
+			if err := m.send(ctx, kind, t); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			m.mu.Lock()
+			if t.Live {
+				m.job.Progress[i].Indexed++
+			} else {
+				m.job.Progress[i].Removed++
+			}
+			m.mu.Unlock()
+		}
+
+		cursor = targets[len(targets)-1].ID
+	}
+}
+
+func (m *Manager) send(ctx context.Context, kind datagraph.Kind, t index_status.Target) error {
+	switch kind {
+	case datagraph.KindThread, datagraph.KindReply, datagraph.KindNode:
+		if t.Live {
+			return m.queue.Index(ctx, kind, t.ID)
+		}
+		return m.queue.Deindex(ctx, kind, t.ID)
+
+	case datagraph.KindProfile:
+		return m.queue.Index(ctx, kind, t.ID)
+
+	default:
+		return fault.Wrap(index_status.ErrUnsupportedKind, fctx.With(ctx))
+	}
+}
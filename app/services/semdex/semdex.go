@@ -5,6 +5,7 @@ import (
 	"context"
 	"net/url"
 
+	"github.com/Southclaws/fault"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/opt"
@@ -28,11 +29,28 @@ type Querier interface {
 	Recommender
 }
 
+var ErrCountUnsupported = fault.New("semdex provider does not support counting items")
+
+// Counter may be implemented by a Semdexer which is able to report how many
+// distinct items of each kind are currently stored in the index.
+type Counter interface {
+	Count(ctx context.Context) (map[datagraph.Kind]int, error)
+}
+
+// Pinger may be implemented by a Semdexer backed by a remote index to check
+// the index is reachable.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
 type Chunk struct {
 	ID      xid.ID
 	Kind    datagraph.Kind
 	URL     url.URL
 	Content string
+	// Relevance is the chunk's similarity to the query between 0 and 1, zero
+	// when the provider does not score chunks.
+	Relevance float64
 }
 
 type Searcher interface {
@@ -60,6 +78,15 @@ type AskResponseChunkMeta struct {
 
 func (c *AskResponseChunkMeta) Type() int { return 1 }
 
+// AskResponseChunkSources is yielded before the answer and lists the content
+// retrieved from the index which the answer is based on.
+type AskResponseChunkSources struct {
+	Sources    []*Chunk
+	Confidence float64
+}
+
+func (c *AskResponseChunkSources) Type() int { return 2 }
+
 type Asker interface {
 	Ask(ctx context.Context, q string, parent opt.Optional[xid.ID]) (AskResponseIterator, error)
 }
@@ -266,10 +266,11 @@ func mapChunk(r chromem.Result) (*semdex.Chunk, error) {
 	}
 
 	return &semdex.Chunk{
-		ID:      id,
-		Kind:    k,
-		URL:     *sdr,
-		Content: r.Content,
+		ID:        id,
+		Kind:      k,
+		URL:       *sdr,
+		Content:   r.Content,
+		Relevance: float64(r.Similarity),
 	}, nil
 }
 
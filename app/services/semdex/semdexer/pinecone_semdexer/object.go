@@ -25,10 +25,11 @@ type Objects []*Object
 
 func (o *Object) ToChunk() *semdex.Chunk {
 	return &semdex.Chunk{
-		ID:      o.ID,
-		Kind:    o.Kind,
-		URL:     o.URL,
-		Content: o.Content,
+		ID:        o.ID,
+		Kind:      o.Kind,
+		URL:       o.URL,
+		Content:   o.Content,
+		Relevance: o.Relevance,
 	}
 }
 
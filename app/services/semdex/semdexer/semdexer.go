@@ -3,7 +3,6 @@ package semdexer
 import (
 	"context"
 
-	"github.com/weaviate/weaviate-go-client/v5/weaviate"
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/fault"
@@ -12,20 +11,23 @@ import (
 	"github.com/Southclaws/storyden/app/services/semdex/asker"
 	"github.com/Southclaws/storyden/app/services/semdex/semdexer/chromem_semdexer"
 	"github.com/Southclaws/storyden/app/services/semdex/semdexer/pinecone_semdexer"
-	"github.com/Southclaws/storyden/app/services/semdex/semdexer/weaviate_semdexer"
+	"github.com/Southclaws/storyden/app/services/semdex/semdexer/vector_semdexer"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/ai"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector/pgvector"
 	"github.com/Southclaws/storyden/internal/infrastructure/vector/pinecone"
-	weaviate_infra "github.com/Southclaws/storyden/internal/infrastructure/weaviate"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector/qdrant"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector/weaviate"
 )
 
 func newSemdexer(
 	ctx context.Context,
 	cfg config.Config,
-	wc *weaviate.Client,
 	pc *pinecone.Client,
+	pgv *pgvector.Store,
+	qc *qdrant.Client,
+	wc *weaviate.Client,
 
-	weaviateClassName weaviate_infra.WeaviateClassName,
 	hydrator *hydrate.Hydrator,
 	prompter ai.Prompter,
 ) (semdex.Semdexer, error) {
@@ -33,16 +35,26 @@ func newSemdexer(
 		return nil, fault.New("semdex requires a language model provider to be enabled")
 	}
 
+	if cfg.SemdexProvider != "" && prompter.EmbeddingFunc() == nil {
+		return nil, fault.New("semdex requires a language model provider which supports embeddings")
+	}
+
 	switch cfg.SemdexProvider {
 	case "chromem":
 		return chromem_semdexer.New(cfg, hydrator, prompter)
 
 	case "weaviate":
-		return weaviate_semdexer.New(wc, weaviateClassName, hydrator), nil
+		return vector_semdexer.New(wc, hydrator, prompter)
 
 	case "pinecone":
 		return pinecone_semdexer.New(ctx, cfg, pc, hydrator, prompter)
 
+	case "pgvector":
+		return vector_semdexer.New(pgv, hydrator, prompter)
+
+	case "qdrant":
+		return vector_semdexer.New(qc, hydrator, prompter)
+
 	default:
 		return &semdex.Disabled{}, nil
 	}
@@ -0,0 +1,111 @@
+package vector_semdexer
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/alitto/pond/v2"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+func (s *vectorSemdexer) Index(ctx context.Context, object datagraph.Item) (int, error) {
+	inserts, deletes, err := s.buildIndexOps(ctx, object)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.driver.Upsert(ctx, inserts); err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.driver.Delete(ctx, deletes); err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return len(inserts) - len(deletes), nil
+}
+
+func (s *vectorSemdexer) Delete(ctx context.Context, object xid.ID) (int, error) {
+	ids, err := s.driver.List(ctx, object.String())
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.driver.Delete(ctx, ids); err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return len(ids), nil
+}
+
+func (s *vectorSemdexer) buildIndexOps(ctx context.Context, object datagraph.Item) ([]*vector.Vector, []string, error) {
+	allChunks := chunksFor(object)
+	if len(allChunks) == 0 {
+		return nil, nil, nil
+	}
+
+	objectID := object.GetID()
+
+	inputChunkTable := lo.SliceToMap(allChunks, func(c chunk) (string, chunk) {
+		return c.id, c
+	})
+
+	indexed, err := s.driver.List(ctx, objectID.String())
+	if err != nil {
+		return nil, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	indexedChunkTable := lo.SliceToMap(indexed, func(id string) (string, struct{}) {
+		return id, struct{}{}
+	})
+
+	pending := dt.Filter(lo.Values(inputChunkTable), func(c chunk) bool {
+		_, exists := indexedChunkTable[c.id]
+		return !exists
+	})
+
+	inserts := []*vector.Vector{}
+	if len(pending) > 0 {
+		pool := pond.NewResultPool[*vector.Vector](min(runtime.NumCPU(), len(pending)))
+		group := pool.NewGroupContext(ctx)
+
+		for _, c := range pending {
+			group.SubmitErr(func() (*vector.Vector, error) {
+				vec, err := s.ef(ctx, c.content)
+				if err != nil {
+					return nil, err
+				}
+
+				return &vector.Vector{
+					ID:     c.id,
+					Values: vec,
+					Metadata: vector.Metadata{
+						DatagraphID:   objectID.String(),
+						DatagraphType: object.GetKind().String(),
+						Name:          object.GetName(),
+						Content:       c.content,
+					},
+				}, nil
+			})
+		}
+
+		inserts, err = group.Wait()
+		if err != nil {
+			return nil, nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	deletes := dt.Filter(indexed, func(id string) bool {
+		_, exists := inputChunkTable[id]
+		return !exists
+	})
+
+	return inserts, deletes, nil
+}
@@ -0,0 +1,79 @@
+package vector_semdexer
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+type Object struct {
+	ID        xid.ID
+	Kind      datagraph.Kind
+	Relevance float64
+	URL       url.URL
+	Content   string
+}
+
+type Objects []*Object
+
+func (o *Object) ToChunk() *semdex.Chunk {
+	return &semdex.Chunk{
+		ID:        o.ID,
+		Kind:      o.Kind,
+		URL:       o.URL,
+		Content:   o.Content,
+		Relevance: o.Relevance,
+	}
+}
+
+func (o *Object) ToRef() *datagraph.Ref {
+	return &datagraph.Ref{
+		ID:        o.ID,
+		Kind:      o.Kind,
+		Relevance: o.Relevance,
+	}
+}
+
+func (o Objects) ToChunks() []*semdex.Chunk {
+	return dt.Map(o, func(object *Object) *semdex.Chunk { return object.ToChunk() })
+}
+
+func (o Objects) ToRefs() datagraph.RefList {
+	return dt.Map(o, func(object *Object) *datagraph.Ref { return object.ToRef() })
+}
+
+func mapScoredVector(v *vector.ScoredVector) (*Object, error) {
+	id, err := xid.FromString(v.Metadata.DatagraphID)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	dk, err := datagraph.NewKind(v.Metadata.DatagraphType)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	sdr, err := url.Parse(fmt.Sprintf("%s:%s/%s", datagraph.RefScheme, dk.String(), id.String()))
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Object{
+		ID:        id,
+		Kind:      dk,
+		Relevance: float64((v.Score + 1) / 2),
+		URL:       *sdr,
+		Content:   v.Metadata.Content,
+	}, nil
+}
+
+func mapScoredVectors(vs []*vector.ScoredVector) (Objects, error) {
+	return dt.MapErr(vs, mapScoredVector)
+}
@@ -0,0 +1,93 @@
+package vector_semdexer
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+const recommendationCount = 10
+
+func (s *vectorSemdexer) Recommend(ctx context.Context, object datagraph.Item) (datagraph.ItemList, error) {
+	refs, err := s.RecommendRefs(ctx, object)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := s.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return items, nil
+}
+
+func (s *vectorSemdexer) RecommendRefs(ctx context.Context, object datagraph.Item) (datagraph.RefList, error) {
+	chunkIDs := dt.Map(chunksFor(object), func(c chunk) string { return c.id })
+	if len(chunkIDs) == 0 {
+		return nil, nil
+	}
+
+	vectors, err := s.driver.Fetch(ctx, chunkIDs)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(vectors) == 0 {
+		return nil, nil
+	}
+
+	target := averageVectors(dt.Map(vectors, func(v *vector.Vector) []float32 { return v.Values }))
+
+	matches, err := s.driver.Query(ctx, vector.Query{
+		Vector: target,
+		TopK:   recommendationCount,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	objects, err := mapScoredVectors(matches)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filtered := filterChunks(dedupeChunks(objects.ToRefs()))
+
+	withoutSource := dt.Filter(filtered, func(r *datagraph.Ref) bool {
+		return r.ID != object.GetID()
+	})
+
+	return withoutSource, nil
+}
+
+func averageVectors(vectors [][]float32) []float32 {
+	if len(vectors) == 0 || len(vectors[0]) == 0 {
+		return []float32{}
+	}
+
+	size := len(vectors[0])
+	sum := make([]float32, size)
+	count := 0
+
+	for _, v := range vectors {
+		if len(v) != size {
+			continue
+		}
+		for i := range size {
+			sum[i] += v[i]
+		}
+		count++
+	}
+
+	for i := range sum {
+		sum[i] /= float32(count)
+	}
+
+	return sum
+}
@@ -0,0 +1,107 @@
+package vector_semdexer
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/services/search/searcher"
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+func (s *vectorSemdexer) Search(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[datagraph.Item], error) {
+	refs, err := s.SearchRefs(ctx, q, p, opts)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := s.hydrator.Hydrate(ctx, refs.Items...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := pagination.NewPageResult(p, refs.Results, items)
+	return &result, nil
+}
+
+func (s *vectorSemdexer) SearchRefs(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[*datagraph.Ref], error) {
+	objects, err := s.searchObjects(ctx, q, p, opts)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	results := objects.ToRefs()
+
+	filtered := filterChunks(dedupeChunks(results))
+
+	pagedResult := pagination.NewPageResult(p, len(results), filtered)
+
+	return &pagedResult, nil
+}
+
+func (s *vectorSemdexer) SearchChunks(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) ([]*semdex.Chunk, error) {
+	objects, err := s.searchObjects(ctx, q, p, opts)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return objects.ToChunks(), nil
+}
+
+func (s *vectorSemdexer) searchObjects(ctx context.Context, q string, p pagination.Parameters, opts searcher.Options) (Objects, error) {
+	vec, err := s.ef(ctx, q)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	query := vector.Query{
+		Vector: vec,
+		TopK:   p.Limit(),
+	}
+
+	opts.Kinds.Call(func(kinds []datagraph.Kind) {
+		query.Kinds = dt.Map(kinds, func(k datagraph.Kind) string { return k.String() })
+	})
+
+	matches, err := s.driver.Query(ctx, query)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return mapScoredVectors(matches)
+}
+
+func filterChunks(results []*datagraph.Ref) []*datagraph.Ref {
+	return dt.Filter(results, func(r *datagraph.Ref) bool {
+		return r.Relevance > 0.5
+	})
+}
+
+// dedupeChunks flattens multiple chunk matches for the same item into a single
+// reference, keeping the highest relevance of any of the item's chunks.
+func dedupeChunks(results []*datagraph.Ref) []*datagraph.Ref {
+	groupedByID := lo.GroupBy(results, func(r *datagraph.Ref) xid.ID { return r.ID })
+
+	deduped := lo.MapToSlice(groupedByID, func(_ xid.ID, refs []*datagraph.Ref) *datagraph.Ref {
+		scores := dt.Map(refs, func(r *datagraph.Ref) float64 { return r.Relevance })
+
+		return &datagraph.Ref{
+			ID:        refs[0].ID,
+			Kind:      refs[0].Kind,
+			Relevance: slices.Max(scores),
+		}
+	})
+
+	sort.Sort(datagraph.RefList(deduped))
+
+	return deduped
+}
@@ -0,0 +1,102 @@
+// Package vector_semdexer implements the Semdex on top of any vector.Driver.
+// Chunking, embedding and result ranking live here so that drivers are only
+// responsible for storing and querying vectors.
+package vector_semdexer
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/internal/infrastructure/ai"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+type vectorSemdexer struct {
+	driver   vector.Driver
+	hydrator *hydrate.Hydrator
+	ef       ai.Embedder
+}
+
+func New(driver vector.Driver, rh *hydrate.Hydrator, aip ai.Prompter) (semdex.Semdexer, error) {
+	if _, ok := aip.(*ai.Disabled); ok {
+		return nil, fault.New("a language model provider must be enabled for the semdexer to be enabled")
+	}
+
+	return &vectorSemdexer{
+		driver:   driver,
+		hydrator: rh,
+		ef:       aip.EmbeddingFunc(),
+	}, nil
+}
+
+// Count is only supported when the underlying driver can count items, callers
+// should check for semdex.Counter and handle ErrCountUnsupported.
+func (s *vectorSemdexer) Count(ctx context.Context) (map[datagraph.Kind]int, error) {
+	c, ok := s.driver.(vector.Counter)
+	if !ok {
+		return nil, fault.Wrap(semdex.ErrCountUnsupported, fctx.With(ctx))
+	}
+
+	counts, err := c.Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make(map[datagraph.Kind]int, len(counts))
+	for k, n := range counts {
+		kind, err := datagraph.NewKind(k)
+		if err != nil {
+			continue
+		}
+		out[kind] = n
+	}
+
+	return out, nil
+}
+
+// Ping checks the driver's store is reachable, drivers without a remote store
+// are always considered reachable.
+func (s *vectorSemdexer) Ping(ctx context.Context) error {
+	p, ok := s.driver.(vector.Pinger)
+	if !ok {
+		return nil
+	}
+
+	if err := p.Ping(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func generateChunkID(id xid.ID, chunk string) string {
+	hash := uuid.NewHash(fnv.New128(), uuid.NameSpaceOID, []byte(chunk), 4)
+
+	return fmt.Sprintf("%s/%s", id.String(), hash)
+}
+
+type chunk struct {
+	id      string
+	content string
+}
+
+func chunksFor(object datagraph.Item) []chunk {
+	id := object.GetID()
+
+	return dt.Map(object.GetContent().Split(), func(c string) chunk {
+		return chunk{
+			id:      generateChunkID(id, c),
+			content: c,
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package vector_semdexer
+
+import (
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+func Test_dedupeChunks(t *testing.T) {
+	a := assert.New(t)
+
+	id1 := xid.New()
+	id2 := xid.New()
+
+	deduped := dedupeChunks([]*datagraph.Ref{
+		{ID: id1, Kind: datagraph.KindPost, Relevance: 0.6},
+		{ID: id2, Kind: datagraph.KindNode, Relevance: 0.7},
+		{ID: id1, Kind: datagraph.KindPost, Relevance: 0.9},
+	})
+
+	a.Len(deduped, 2)
+	a.Equal(id1, deduped[0].ID)
+	a.Equal(0.9, deduped[0].Relevance)
+	a.Equal(id2, deduped[1].ID)
+}
+
+func Test_averageVectors(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]float32{2, 3}, averageVectors([][]float32{{1, 2}, {3, 4}}))
+	a.Equal([]float32{1, 2}, averageVectors([][]float32{{1, 2}, {5}}))
+	a.Empty(averageVectors(nil))
+}
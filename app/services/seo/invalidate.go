@@ -0,0 +1,122 @@
+package seo
+
+import (
+	"context"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/sitemap"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func (g *Generator) subscribe(ctx context.Context, bus *pubsub.Bus) error {
+	if bus == nil {
+		return nil
+	}
+
+	threads := func(ctx context.Context, id xid.ID) error { return g.invalidate(ctx, sitemap.SectionThreads, id) }
+	nodes := func(ctx context.Context, id xid.ID) error { return g.invalidate(ctx, sitemap.SectionNodes, id) }
+	profiles := func(ctx context.Context, id xid.ID) error { return g.invalidate(ctx, sitemap.SectionProfiles, id) }
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+		return threads(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.thread_updated", func(ctx context.Context, evt *message.EventThreadUpdated) error {
+		return threads(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.thread_unpublished", func(ctx context.Context, evt *message.EventThreadUnpublished) error {
+		return threads(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.thread_deleted", func(ctx context.Context, evt *message.EventThreadDeleted) error {
+		return threads(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+		return threads(ctx, xid.ID(evt.ThreadID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.reply_deleted", func(ctx context.Context, evt *message.EventThreadReplyDeleted) error {
+		return threads(ctx, xid.ID(evt.ThreadID))
+	}); err != nil {
+		return err
+	}
+
+	// Likes only change a thread's structured data, not when it was updated.
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.post_liked", func(ctx context.Context, evt *message.EventPostLiked) error {
+		return g.data[sitemap.SectionThreads].Delete(ctx, evt.PostID.String())
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.post_unliked", func(ctx context.Context, evt *message.EventPostUnliked) error {
+		return g.data[sitemap.SectionThreads].Delete(ctx, evt.PostID.String())
+	}); err != nil {
+		return err
+	}
+
+	// Any thread may be in a category whose permissions changed, or which was
+	// deleted and its threads moved, so every thread is described again.
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.category_updated", func(ctx context.Context, evt *message.EventCategoryUpdated) error {
+		return g.invalidateSection(ctx, sitemap.SectionThreads)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.category_deleted", func(ctx context.Context, evt *message.EventCategoryDeleted) error {
+		return g.invalidateSection(ctx, sitemap.SectionThreads)
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.node_published", func(ctx context.Context, evt *message.EventNodePublished) error {
+		return nodes(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.node_updated", func(ctx context.Context, evt *message.EventNodeUpdated) error {
+		return nodes(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.node_unpublished", func(ctx context.Context, evt *message.EventNodeUnpublished) error {
+		return nodes(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.node_deleted", func(ctx context.Context, evt *message.EventNodeDeleted) error {
+		return nodes(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.account_created", func(ctx context.Context, evt *message.EventAccountCreated) error {
+		return profiles(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	if _, err := pubsub.Subscribe(ctx, bus, "seo.account_updated", func(ctx context.Context, evt *message.EventAccountUpdated) error {
+		return profiles(ctx, xid.ID(evt.ID))
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
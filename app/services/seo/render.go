@@ -0,0 +1,90 @@
+package seo
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"time"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/Southclaws/storyden/app/services/syndication"
+)
+
+const sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+func newDocument(body []byte, contentType string, lastModified time.Time) *syndication.Document {
+	sum := sha256.Sum256(body)
+
+	return &syndication.Document{
+		Body:         body,
+		ContentType:  contentType,
+		LastModified: lastModified,
+		ETag:         `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`,
+	}
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	NS       string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapRef `xml:"sitemap"`
+}
+
+type sitemapRef struct {
+	Loc     string    `xml:"loc"`
+	LastMod time.Time `xml:"lastmod"`
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	NS      string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string    `xml:"loc"`
+	LastMod time.Time `xml:"lastmod"`
+}
+
+func renderIndex(sitemaps []sitemapRef) (*syndication.Document, error) {
+	updated := time.Time{}
+	for i, s := range sitemaps {
+		sitemaps[i].LastMod = s.LastMod.UTC().Truncate(time.Second)
+		if s.LastMod.After(updated) {
+			updated = s.LastMod
+		}
+	}
+
+	return renderXML(sitemapIndex{NS: sitemapNS, Sitemaps: sitemaps}, updated)
+}
+
+func renderSitemap(urls []sitemapURL) (*syndication.Document, error) {
+	updated := time.Time{}
+	for i, u := range urls {
+		urls[i].LastMod = u.LastMod.UTC().Truncate(time.Second)
+		if u.LastMod.After(updated) {
+			updated = u.LastMod
+		}
+	}
+
+	return renderXML(urlSet{NS: sitemapNS, URLs: urls}, updated)
+}
+
+func renderXML(doc any, updated time.Time) (*syndication.Document, error) {
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return newDocument(append([]byte(xml.Header), b...), "application/xml; charset=utf-8", updated), nil
+}
+
+func renderData(data any, updated time.Time) (*syndication.Document, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return newDocument(b, "application/ld+json", updated), nil
+}
@@ -0,0 +1,60 @@
+package seo
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSitemap(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	older := time.Date(2025, 1, 2, 3, 4, 5, 678, time.FixedZone("", 3600))
+	newer := older.Add(time.Hour)
+
+	doc, err := renderSitemap([]sitemapURL{
+		{Loc: "https://example.com/t/a&b", LastMod: older},
+		{Loc: "https://example.com/t/c", LastMod: newer},
+	})
+	r.NoError(err)
+
+	a.Equal("application/xml; charset=utf-8", doc.ContentType)
+	a.Equal(newer, doc.LastModified)
+	a.NotEmpty(doc.ETag)
+
+	body := string(doc.Body)
+	a.Contains(body, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	a.Contains(body, "<loc>https://example.com/t/a&amp;b</loc>")
+	a.Contains(body, "<lastmod>2025-01-02T02:04:05Z</lastmod>", "dates are in UTC to the second")
+
+	var parsed urlSet
+	r.NoError(xml.Unmarshal(doc.Body, &parsed))
+	a.Len(parsed.URLs, 2)
+
+	again, err := renderSitemap([]sitemapURL{
+		{Loc: "https://example.com/t/a&b", LastMod: older},
+		{Loc: "https://example.com/t/c", LastMod: newer},
+	})
+	r.NoError(err)
+	a.Equal(doc.ETag, again.ETag)
+}
+
+func TestRenderIndex(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	updated := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	doc, err := renderIndex([]sitemapRef{
+		{Loc: "https://api.example.com/api/sitemaps/threads/1", LastMod: updated},
+	})
+	r.NoError(err)
+
+	a.Contains(string(doc.Body), `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	a.Contains(string(doc.Body), "<loc>https://api.example.com/api/sitemaps/threads/1</loc>")
+	a.Equal(updated, doc.LastModified)
+}
@@ -0,0 +1,304 @@
+// Package seo produces sitemaps and structured data so search engines can find
+// and describe a public instance's content. Both are rendered once and kept in
+// the cache, each section of the sitemap and each item's structured data is
+// only rendered again after something in it changes.
+package seo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/sitemap"
+	"github.com/Southclaws/storyden/app/services/syndication"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// pageSize is how many addresses each sitemap lists, well under the protocol's
+// limit of 50,000 so a page stays quick to render.
+const pageSize = 10_000
+
+// Entries are dropped as soon as what they include changes so they can be kept
+// for a long time, this only bounds how long a missed event goes unnoticed.
+const cacheTTL = 6 * time.Hour
+
+var errNotFound = fault.New("not found", ftag.With(ftag.NotFound))
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Generator struct {
+	webAddress     url.URL
+	apiAddress     url.URL
+	settings       *settings.SettingsRepository
+	sitemap        *sitemap.Querier
+	roleQuerier    *role_querier.Querier
+	categoryRepo   *category.Repository
+	threadQuerier  *thread_querier.Querier
+	nodeQuerier    *node_querier.Querier
+	profileQuerier *profile_querier.Querier
+
+	index    *cache.Namespace
+	sections map[sitemap.Section]*cache.Namespace
+	data     map[sitemap.Section]*cache.Namespace
+}
+
+func New(
+	lc fx.Lifecycle,
+	cfg config.Config,
+	store cache.Store,
+	bus *pubsub.Bus,
+	settings *settings.SettingsRepository,
+	sitemapQuerier *sitemap.Querier,
+	roleQuerier *role_querier.Querier,
+	categoryRepo *category.Repository,
+	threadQuerier *thread_querier.Querier,
+	nodeQuerier *node_querier.Querier,
+	profileQuerier *profile_querier.Querier,
+) *Generator {
+	g := &Generator{
+		webAddress:     cfg.PublicWebAddress,
+		apiAddress:     cfg.PublicAPIAddress,
+		settings:       settings,
+		sitemap:        sitemapQuerier,
+		roleQuerier:    roleQuerier,
+		categoryRepo:   categoryRepo,
+		threadQuerier:  threadQuerier,
+		nodeQuerier:    nodeQuerier,
+		profileQuerier: profileQuerier,
+		index:          cache.NewNamespace(store, "seo:sitemap", cacheTTL),
+		sections:       map[sitemap.Section]*cache.Namespace{},
+		data:           map[sitemap.Section]*cache.Namespace{},
+	}
+
+	for _, s := range sitemap.Sections {
+		g.sections[s] = cache.NewNamespace(store, "seo:sitemap:"+s.String(), cacheTTL)
+		g.data[s] = cache.NewNamespace(store, "seo:data:"+s.String(), cacheTTL)
+	}
+
+	lc.Append(fx.StartHook(func(ctx context.Context) error {
+		return g.subscribe(ctx, bus)
+	}))
+
+	return g
+}
+
+// Index lists a sitemap for every page of every section.
+func (g *Generator) Index(ctx context.Context) (*syndication.Document, error) {
+	if err := g.authorise(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return cache.Fetch(ctx, g.index, "index", func(ctx context.Context) (*syndication.Document, error) {
+		sitemaps := []sitemapRef{}
+		for _, s := range sitemap.Sections {
+			pages, err := cache.Fetch(ctx, g.sections[s], "pages", func(ctx context.Context) ([]time.Time, error) {
+				return g.sitemap.Pages(ctx, s, pageSize)
+			})
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+
+			for i, updated := range pages {
+				sitemaps = append(sitemaps, sitemapRef{
+					Loc:     g.apiAddress.JoinPath("api", "sitemaps", s.String(), fmt.Sprint(i+1)).String(),
+					LastMod: updated,
+				})
+			}
+		}
+
+		return renderIndex(sitemaps)
+	})
+}
+
+// Sitemap lists the addresses on one page, starting from 1, of a section.
+func (g *Generator) Sitemap(ctx context.Context, section sitemap.Section, page int) (*syndication.Document, error) {
+	if err := g.authorise(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if page < 1 {
+		return nil, fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	return cache.Fetch(ctx, g.sections[section], fmt.Sprint(page), func(ctx context.Context) (*syndication.Document, error) {
+		entries, err := g.sitemap.List(ctx, section, (page-1)*pageSize, pageSize)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if len(entries) == 0 {
+			return nil, fault.Wrap(errNotFound, fctx.With(ctx))
+		}
+
+		urls := make([]sitemapURL, 0, len(entries))
+		for _, e := range entries {
+			urls = append(urls, sitemapURL{
+				Loc:     g.link(section, e),
+				LastMod: e.Updated,
+			})
+		}
+
+		return renderSitemap(urls)
+	})
+}
+
+// Thread describes a published thread as a discussion forum posting.
+func (g *Generator) Thread(ctx context.Context, id post.ID) (*syndication.Document, error) {
+	return g.structured(ctx, sitemap.SectionThreads, id.String(), func(ctx context.Context, e *sitemap.Entry) (any, error) {
+		thr, err := g.threadQuerier.Get(ctx, post.ID(e.ID), pagination.Parameters{}, nil)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := g.authoriseThread(ctx, thr); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return g.threadData(thr), nil
+	})
+}
+
+// Node describes a published library page as an article.
+func (g *Generator) Node(ctx context.Context, slug string) (*syndication.Document, error) {
+	return g.structured(ctx, sitemap.SectionNodes, slug, func(ctx context.Context, e *sitemap.Entry) (any, error) {
+		n, err := g.nodeQuerier.Probe(ctx, library.NodeID(e.ID))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return g.nodeData(n), nil
+	})
+}
+
+// Profile describes a member's profile page.
+func (g *Generator) Profile(ctx context.Context, handle string) (*syndication.Document, error) {
+	return g.structured(ctx, sitemap.SectionProfiles, handle, func(ctx context.Context, e *sitemap.Entry) (any, error) {
+		p, err := g.profileQuerier.GetByID(ctx, account.AccountID(e.ID))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return g.profileData(p), nil
+	})
+}
+
+// structured looks up an item by whatever its address uses for it, cached
+// under its ID so the entry is found again when the item changes.
+func (g *Generator) structured(ctx context.Context, section sitemap.Section, key string, describe func(context.Context, *sitemap.Entry) (any, error)) (*syndication.Document, error) {
+	if err := g.authorise(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	e, ok, err := g.sitemap.Lookup(ctx, section, key)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		return nil, fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	return cache.Fetch(ctx, g.data[section], e.ID.String(), func(ctx context.Context) (*syndication.Document, error) {
+		data, err := describe(ctx, e)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return renderData(data, e.Updated)
+	})
+}
+
+// Nothing is listed for search engines on a private instance.
+func (g *Generator) authorise(ctx context.Context) error {
+	set, err := g.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !set.Public.Or(true) {
+		return fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// A thread is only described when a guest can read its category, the sitemap
+// already leaves these out but structured data must never describe one either.
+func (g *Generator) authoriseThread(ctx context.Context, thr *thread.Thread) error {
+	c, ok := thr.Category.Get()
+	if !ok {
+		return nil
+	}
+
+	guest, err := g.roleQuerier.GetGuestRole(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	perms, err := g.categoryRepo.GetPermissions(ctx, c.Slug)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !perms.CanRead(role.Roles{guest}) {
+		return fault.Wrap(errNotFound, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (g *Generator) link(section sitemap.Section, e sitemap.Entry) string {
+	switch section {
+	case sitemap.SectionThreads:
+		return g.webAddress.JoinPath("t", e.Slug).String()
+	case sitemap.SectionNodes:
+		return g.webAddress.JoinPath("l", e.Slug).String()
+	default:
+		return g.webAddress.JoinPath("m", e.Slug).String()
+	}
+}
+
+func (g *Generator) invalidate(ctx context.Context, section sitemap.Section, id xid.ID) error {
+	if err := g.data[section].Delete(ctx, id.String()); err != nil {
+		return err
+	}
+
+	if err := g.sections[section].Invalidate(ctx); err != nil {
+		return err
+	}
+
+	return g.index.Invalidate(ctx)
+}
+
+func (g *Generator) invalidateSection(ctx context.Context, section sitemap.Section) error {
+	if err := g.data[section].Invalidate(ctx); err != nil {
+		return err
+	}
+
+	if err := g.sections[section].Invalidate(ctx); err != nil {
+		return err
+	}
+
+	return g.index.Invalidate(ctx)
+}
@@ -0,0 +1,167 @@
+package seo
+
+import (
+	"time"
+
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+)
+
+const schemaContext = "https://schema.org"
+
+type person struct {
+	Type          string `json:"@type"`
+	ID            string `json:"@id,omitempty"`
+	Name          string `json:"name"`
+	AlternateName string `json:"alternateName,omitempty"`
+	Description   string `json:"description,omitempty"`
+	URL           string `json:"url"`
+	Image         string `json:"image,omitempty"`
+}
+
+type counter struct {
+	Type                 string `json:"@type"`
+	InteractionType      string `json:"interactionType"`
+	UserInteractionCount int    `json:"userInteractionCount"`
+}
+
+type posting struct {
+	Context              string    `json:"@context"`
+	Type                 string    `json:"@type"`
+	ID                   string    `json:"@id"`
+	URL                  string    `json:"url"`
+	Headline             string    `json:"headline"`
+	Text                 string    `json:"text,omitempty"`
+	DatePublished        time.Time `json:"datePublished"`
+	DateModified         time.Time `json:"dateModified"`
+	Author               person    `json:"author"`
+	ArticleSection       string    `json:"articleSection,omitempty"`
+	Keywords             []string  `json:"keywords,omitempty"`
+	Image                string    `json:"image,omitempty"`
+	CommentCount         int       `json:"commentCount"`
+	InteractionStatistic []counter `json:"interactionStatistic"`
+}
+
+type article struct {
+	Context       string    `json:"@context"`
+	Type          string    `json:"@type"`
+	ID            string    `json:"@id"`
+	URL           string    `json:"url"`
+	Headline      string    `json:"headline"`
+	Description   string    `json:"description,omitempty"`
+	ArticleBody   string    `json:"articleBody,omitempty"`
+	Image         string    `json:"image,omitempty"`
+	DatePublished time.Time `json:"datePublished"`
+	DateModified  time.Time `json:"dateModified"`
+	Author        person    `json:"author"`
+	Keywords      []string  `json:"keywords,omitempty"`
+}
+
+type profilePage struct {
+	Context      string    `json:"@context"`
+	Type         string    `json:"@type"`
+	ID           string    `json:"@id"`
+	URL          string    `json:"url"`
+	DateCreated  time.Time `json:"dateCreated"`
+	DateModified time.Time `json:"dateModified"`
+	MainEntity   person    `json:"mainEntity"`
+}
+
+func (g *Generator) threadData(thr *thread.Thread) posting {
+	link := g.webAddress.JoinPath("t", thr.Slug).String()
+
+	updated := thr.UpdatedAt
+	if r, ok := thr.LastReplyAt.Get(); ok && r.After(updated) {
+		updated = r
+	}
+
+	section := ""
+	if c, ok := thr.Category.Get(); ok {
+		section = c.Name
+	}
+
+	return posting{
+		Context:        schemaContext,
+		Type:           "DiscussionForumPosting",
+		ID:             link,
+		URL:            link,
+		Headline:       thr.Title,
+		Text:           thr.Content.Plaintext(),
+		DatePublished:  thr.CreatedAt,
+		DateModified:   updated,
+		Author:         g.author(thr.Author),
+		ArticleSection: section,
+		Keywords:       keywords(thr.Tags),
+		Image:          g.apiAddress.JoinPath("api", "og", "threads", thr.Slug).String(),
+		CommentCount:   thr.ReplyStatus.Count,
+		InteractionStatistic: []counter{
+			{Type: "InteractionCounter", InteractionType: "https://schema.org/LikeAction", UserInteractionCount: thr.Likes.Count},
+			{Type: "InteractionCounter", InteractionType: "https://schema.org/CommentAction", UserInteractionCount: thr.ReplyStatus.Count},
+		},
+	}
+}
+
+func (g *Generator) nodeData(n *library.Node) article {
+	link := g.webAddress.JoinPath("l", n.Mark.Slug()).String()
+	content := n.Content.OrZero()
+
+	image := g.apiAddress.JoinPath("api", "og", "nodes", n.Mark.Slug()).String()
+	if a, ok := n.PrimaryImage.Get(); ok && !a.Private {
+		image = g.apiAddress.JoinPath("api", "assets", a.Name.String()).String()
+	}
+
+	return article{
+		Context:       schemaContext,
+		Type:          "Article",
+		ID:            link,
+		URL:           link,
+		Headline:      n.Name,
+		Description:   n.Description.Or(content.Short()),
+		ArticleBody:   content.Plaintext(),
+		Image:         image,
+		DatePublished: n.CreatedAt,
+		DateModified:  n.UpdatedAt,
+		Author:        g.author(n.Owner),
+		Keywords:      keywords(n.Tags),
+	}
+}
+
+func (g *Generator) profileData(p *profile.Public) profilePage {
+	link := g.webAddress.JoinPath("m", p.Handle).String()
+
+	author := g.author(p.Ref)
+	author.AlternateName = p.Handle
+	author.Description = p.Bio.Plaintext()
+	author.Image = g.apiAddress.JoinPath("api", "accounts", p.Handle, "avatar").String()
+
+	return profilePage{
+		Context:      schemaContext,
+		Type:         "ProfilePage",
+		ID:           link,
+		URL:          link,
+		DateCreated:  p.Created,
+		DateModified: p.Updated,
+		MainEntity:   author,
+	}
+}
+
+func (g *Generator) author(p profile.Ref) person {
+	link := g.webAddress.JoinPath("m", p.Handle).String()
+
+	return person{
+		Type: "Person",
+		ID:   link + "#person",
+		Name: p.Name,
+		URL:  link,
+	}
+}
+
+func keywords(tags tag_ref.Tags) []string {
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.Name.String())
+	}
+	return names
+}
@@ -8,34 +8,76 @@ import (
 	"github.com/Southclaws/storyden/app/services/account/account_email"
 	"github.com/Southclaws/storyden/app/services/account/account_suspension"
 	"github.com/Southclaws/storyden/app/services/account/register"
+	"github.com/Southclaws/storyden/app/services/activitypub"
+	"github.com/Southclaws/storyden/app/services/analytics/analytics_export"
+	"github.com/Southclaws/storyden/app/services/analytics/analytics_rollup"
+	"github.com/Southclaws/storyden/app/services/api_usage"
 	"github.com/Southclaws/storyden/app/services/asset"
 	"github.com/Southclaws/storyden/app/services/authentication"
 	"github.com/Southclaws/storyden/app/services/avatar"
 	"github.com/Southclaws/storyden/app/services/avatar_gen"
+	"github.com/Southclaws/storyden/app/services/badge"
+	"github.com/Southclaws/storyden/app/services/batch"
 	"github.com/Southclaws/storyden/app/services/beacon_listener"
+	"github.com/Southclaws/storyden/app/services/bookmark/bookmarker"
 	"github.com/Southclaws/storyden/app/services/branding"
 	"github.com/Southclaws/storyden/app/services/category"
 	"github.com/Southclaws/storyden/app/services/collection"
 	"github.com/Southclaws/storyden/app/services/comms"
+	"github.com/Southclaws/storyden/app/services/conversation"
+	"github.com/Southclaws/storyden/app/services/discord_bot"
 	"github.com/Southclaws/storyden/app/services/event"
+	"github.com/Southclaws/storyden/app/services/feature_flag"
+	"github.com/Southclaws/storyden/app/services/feed"
 	"github.com/Southclaws/storyden/app/services/generative"
+	"github.com/Southclaws/storyden/app/services/generative/summary_job"
+	"github.com/Southclaws/storyden/app/services/importer"
+	"github.com/Southclaws/storyden/app/services/job_queue"
 	"github.com/Southclaws/storyden/app/services/library"
 	"github.com/Southclaws/storyden/app/services/like/post_liker"
 	"github.com/Southclaws/storyden/app/services/link"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/app/services/matrix_appservice"
 	"github.com/Southclaws/storyden/app/services/mention/mention_job"
 	"github.com/Southclaws/storyden/app/services/moderation"
+	"github.com/Southclaws/storyden/app/services/notification/chat_notify_job"
+	"github.com/Southclaws/storyden/app/services/notification/digest_email"
 	"github.com/Southclaws/storyden/app/services/notification/notify_job"
 	"github.com/Southclaws/storyden/app/services/onboarding"
+	"github.com/Southclaws/storyden/app/services/policy"
+	"github.com/Southclaws/storyden/app/services/profile/blocking"
 	"github.com/Southclaws/storyden/app/services/profile/following"
+	"github.com/Southclaws/storyden/app/services/profile/pinning"
+	"github.com/Southclaws/storyden/app/services/profile/timeline"
 	"github.com/Southclaws/storyden/app/services/react_manager"
+	"github.com/Southclaws/storyden/app/services/realtime"
+	"github.com/Southclaws/storyden/app/services/related"
 	"github.com/Southclaws/storyden/app/services/reply"
+	"github.com/Southclaws/storyden/app/services/reply/reply_email"
 	"github.com/Southclaws/storyden/app/services/report"
+	"github.com/Southclaws/storyden/app/services/reputation/reputation_awarder"
+	"github.com/Southclaws/storyden/app/services/reputation/reputation_gate"
+	"github.com/Southclaws/storyden/app/services/scheduler"
 	"github.com/Southclaws/storyden/app/services/search"
+	"github.com/Southclaws/storyden/app/services/semdex/index_job"
+	"github.com/Southclaws/storyden/app/services/semdex/reindex"
 	"github.com/Southclaws/storyden/app/services/semdex/semdexer"
+	"github.com/Southclaws/storyden/app/services/seo"
+	"github.com/Southclaws/storyden/app/services/space"
+	"github.com/Southclaws/storyden/app/services/syndication"
+	"github.com/Southclaws/storyden/app/services/system/health"
 	"github.com/Southclaws/storyden/app/services/system/instance_info"
+	"github.com/Southclaws/storyden/app/services/system/retention_enforcer"
 	"github.com/Southclaws/storyden/app/services/tag/autotagger"
+	"github.com/Southclaws/storyden/app/services/tag/tag_suggest"
+	"github.com/Southclaws/storyden/app/services/tag/tagging"
+	"github.com/Southclaws/storyden/app/services/telegram_bot"
+	"github.com/Southclaws/storyden/app/services/tenancy"
 	"github.com/Southclaws/storyden/app/services/thread"
 	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/app/services/trending/trending_job"
+	"github.com/Southclaws/storyden/app/services/view_counter"
+	"github.com/Southclaws/storyden/app/services/webhook"
 )
 
 func Build() fx.Option {
@@ -49,8 +91,10 @@ func Build() fx.Option {
 		category.Build(),
 		thread.Build(),
 		reply.Build(),
+		reply_email.Build(),
 		report.Build(),
 		post_liker.Build(),
+		bookmarker.Build(),
 		react_manager.Build(),
 		search.Build(),
 		avatar.Build(),
@@ -58,19 +102,59 @@ func Build() fx.Option {
 		thread_mark.Build(),
 		collection.Build(),
 		library.Build(),
+		job_queue.Build(),
+		scheduler.Build(),
+		retention_enforcer.Build(),
+		tenancy.Build(),
 		comms.Build(),
 		link.Build(),
 		notify_job.Build(),
+		digest_email.Build(),
+		chat_notify_job.Build(),
+		discord_bot.Build(),
+		matrix_appservice.Build(),
+		telegram_bot.Build(),
+		activitypub.Build(),
+		syndication.Build(),
+		seo.Build(),
 		mention_job.Build(),
 		beacon_listener.Build(),
 		generative.Build(),
+		summary_job.Build(),
 		semdexer.Build(),
+		reindex.Build(),
+		index_job.Build(),
 		event.Build(),
 		moderation.Build(),
+		following.Build(),
+		blocking.Build(),
+		pinning.Build(),
+		timeline.Build(),
+		feed.Build(),
+		trending_job.Build(),
+		analytics_rollup.Build(),
+		analytics_export.Build(),
+		reputation_awarder.Build(),
+		reputation_gate.Build(),
+		badge.Build(),
+		policy.Build(),
+		feature_flag.Build(),
+		webhook.Build(),
+		conversation.Build(),
+		realtime.Build(),
+		space.Build(),
+		batch.Build(),
+		importer.Build(),
+		api_usage.Build(),
+		view_counter.Build(),
 		fx.Provide(avatar_gen.New),
-		fx.Provide(following.New),
+		tag_suggest.Build(),
+		tagging.Build(),
 		fx.Provide(autotagger.New),
+		fx.Provide(related.New),
 		fx.Provide(instance_info.New),
+		fx.Provide(locale.New),
+		fx.Provide(health.New),
 		fx.Provide(account_auth.New, account_email.New),
 	)
 }
@@ -0,0 +1,11 @@
+package space
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/space/space_manager"
+)
+
+func Build() fx.Option {
+	return fx.Provide(space_manager.New)
+}
@@ -0,0 +1,191 @@
+// Package space_manager lets members create and run their own spaces. Whoever
+// creates a space owns it and may appoint moderators, who between them decide
+// who gets in when the space isn't open to everyone.
+package space_manager
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/space"
+	"github.com/Southclaws/storyden/app/resources/space/space_querier"
+	"github.com/Southclaws/storyden/app/resources/space/space_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var (
+	ErrNotManager = fault.New("not a space owner or moderator", ftag.With(ftag.PermissionDenied))
+	ErrNotOwner   = fault.New("not a space owner", ftag.With(ftag.PermissionDenied))
+	ErrInvalid    = fault.New("invalid space", ftag.With(ftag.InvalidArgument))
+)
+
+type Manager struct {
+	accountQuerier *account_querier.Querier
+	querier        *space_querier.Querier
+	writer         *space_writer.Writer
+}
+
+func New(
+	accountQuerier *account_querier.Querier,
+	querier *space_querier.Querier,
+	writer *space_writer.Writer,
+) *Manager {
+	return &Manager{
+		accountQuerier: accountQuerier,
+		querier:        querier,
+		writer:         writer,
+	}
+}
+
+type Partial struct {
+	Name        opt.Optional[string]
+	Slug        opt.Optional[string]
+	Description opt.Optional[string]
+	Policy      opt.Optional[space.Policy]
+}
+
+func (p Partial) Opts() (opts []space_writer.Option) {
+	p.Name.Call(func(v string) { opts = append(opts, space_writer.WithName(v)) })
+	p.Slug.Call(func(v string) { opts = append(opts, space_writer.WithSlug(mark.Slugify(v))) })
+	p.Description.Call(func(v string) { opts = append(opts, space_writer.WithDescription(v)) })
+	p.Policy.Call(func(v space.Policy) { opts = append(opts, space_writer.WithPolicy(v)) })
+	return
+}
+
+func (m *Manager) List(ctx context.Context, page pagination.Parameters) (*pagination.Result[*space.Space], error) {
+	spaces, err := m.querier.List(ctx, page, session.GetOptAccountID(ctx))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return spaces, nil
+}
+
+func (m *Manager) Get(ctx context.Context, slug string) (*space.Space, error) {
+	sp, err := m.querier.Get(ctx, slug, session.GetOptAccountID(ctx))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return sp, nil
+}
+
+func (m *Manager) Create(ctx context.Context, name string, p Partial) (*space.Space, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	slug := mark.Slugify(p.Slug.Or(name))
+	if slug == "" {
+		return nil, fault.Wrap(ErrInvalid, fctx.With(ctx),
+			fmsg.WithDesc("empty slug", "A space needs a name that can be used in its address."))
+	}
+
+	id, err := m.writer.Create(ctx, accountID, name, slug, Partial{
+		Description: p.Description,
+		Policy:      p.Policy,
+	}.Opts()...)
+	if err != nil {
+		if ftag.Get(err) == ftag.AlreadyExists {
+			return nil, fault.Wrap(err, fctx.With(ctx),
+				fmsg.WithDesc("slug taken", "A space with that address already exists."))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sp, err := m.querier.GetByID(ctx, id, opt.New(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return sp, nil
+}
+
+func (m *Manager) Update(ctx context.Context, slug string, p Partial) (*space.Space, error) {
+	sp, err := m.authoriseManager(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.Update(ctx, sp.ID, p.Opts()...); err != nil {
+		if ftag.Get(err) == ftag.AlreadyExists {
+			return nil, fault.Wrap(err, fctx.With(ctx),
+				fmsg.WithDesc("slug taken", "A space with that address already exists."))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sp, err = m.querier.GetByID(ctx, sp.ID, session.GetOptAccountID(ctx))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return sp, nil
+}
+
+// Delete removes the space, only its owners or site moderators may do this.
+func (m *Manager) Delete(ctx context.Context, slug string) error {
+	sp, err := m.authorise(ctx, slug, func(sp *space.Space) error {
+		mem, ok := sp.Membership.Get()
+		if !ok || !mem.CanManage() || mem.Role != space.RoleOwner {
+			return ErrNotOwner
+		}
+		return nil
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.Delete(ctx, sp.ID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) authoriseManager(ctx context.Context, slug string) (*space.Space, error) {
+	return m.authorise(ctx, slug, func(sp *space.Space) error {
+		if !sp.CanManage() {
+			return ErrNotManager
+		}
+		return nil
+	})
+}
+
+// authorise loads the space as seen by the session's account and runs the
+// check against it, members holding MANAGE_CATEGORIES pass regardless.
+func (m *Manager) authorise(ctx context.Context, slug string, check func(*space.Space) error) (*space.Space, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := m.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sp, err := m.querier.Get(ctx, slug, opt.New(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = acc.Roles.Permissions().Authorise(ctx, func() error {
+		return check(sp)
+	}, rbac.PermissionManageCategories)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return sp, nil
+}
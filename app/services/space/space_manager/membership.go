@@ -0,0 +1,250 @@
+package space_manager
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/space"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var (
+	ErrInviteOnly     = fault.New("space is invite only", ftag.With(ftag.PermissionDenied))
+	ErrMembersHidden  = fault.New("members of this space are hidden", ftag.With(ftag.PermissionDenied))
+	ErrLastOwner      = fault.New("space must keep an owner", ftag.With(ftag.InvalidArgument))
+	ErrNotMember      = fault.New("not a member of the space", ftag.With(ftag.NotFound))
+	ErrCannotRemove   = fault.New("cannot remove member", ftag.With(ftag.PermissionDenied))
+	ErrCannotSetRoles = fault.New("only owners can change roles", ftag.With(ftag.PermissionDenied))
+)
+
+// Members lists who has joined the space. Anyone may see the members of an open
+// space, otherwise only its members can. Owners and moderators also see who
+// has asked to join and who has been invited.
+func (m *Manager) Members(ctx context.Context, slug string) ([]*space.Member, error) {
+	sp, err := m.querier.Get(ctx, slug, session.GetOptAccountID(ctx))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !sp.IsPublic() && !sp.IsMember() {
+		return nil, fault.Wrap(ErrMembersHidden, fctx.With(ctx),
+			fmsg.WithDesc("members hidden", "Only members of this space can see who else is in it."))
+	}
+
+	statuses := []space.Status{space.StatusActive}
+	if sp.CanManage() {
+		statuses = append(statuses, space.StatusRequested, space.StatusInvited)
+	}
+
+	members, err := m.querier.ListMembers(ctx, sp.ID, statuses...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return members, nil
+}
+
+// Join adds the session's account to the space. Anyone may join an open space,
+// spaces that require approval record a request for the moderators and invite
+// only spaces can only be joined by accepting an invitation.
+func (m *Manager) Join(ctx context.Context, slug string) (*space.Space, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sp, err := m.querier.Get(ctx, slug, opt.New(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	current, isKnown := sp.Membership.Get()
+	if isKnown && current.Status == space.StatusActive {
+		return sp, nil
+	}
+
+	invited := isKnown && current.Status == space.StatusInvited
+
+	status := space.StatusActive
+	switch sp.Policy {
+	case space.PolicyRequest:
+		if !invited {
+			status = space.StatusRequested
+		}
+
+	case space.PolicyInvite:
+		if !invited {
+			return nil, fault.Wrap(ErrInviteOnly, fctx.With(ctx),
+				fmsg.WithDesc("invite only", "This space can only be joined by invitation."))
+		}
+	}
+
+	if err := m.writer.SetMember(ctx, sp.ID, accountID, space.RoleMember, status); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sp, err = m.querier.GetByID(ctx, sp.ID, opt.New(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return sp, nil
+}
+
+// Leave removes the session's account from the space, which also withdraws a
+// request to join or declines an invitation. The last owner can't leave.
+func (m *Manager) Leave(ctx context.Context, slug string) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sp, err := m.querier.Get(ctx, slug, opt.New(accountID))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	current, ok := sp.Membership.Get()
+	if !ok {
+		return nil
+	}
+
+	if current.Role == space.RoleOwner {
+		if err := m.keepsOwner(ctx, sp.ID, accountID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := m.writer.RemoveMember(ctx, sp.ID, accountID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// SetMember is how owners and moderators let people in. Approving someone who
+// asked to join makes them a member straight away, anyone else is invited and
+// joins once they accept. Only owners may change a member's role.
+func (m *Manager) SetMember(ctx context.Context, slug string, targetID account.AccountID, role opt.Optional[space.Role]) (*space.Member, error) {
+	sp, err := m.authoriseManager(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	current, err := m.querier.GetMember(ctx, sp.ID, targetID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	status := space.StatusInvited
+	newRole := space.RoleMember
+	if c, ok := current.Get(); ok {
+		newRole = c.Role
+		switch c.Status {
+		case space.StatusActive, space.StatusRequested:
+			status = space.StatusActive
+		}
+	}
+
+	if r, ok := role.Get(); ok && r != newRole {
+		if !m.isOwner(sp) {
+			return nil, fault.Wrap(ErrCannotSetRoles, fctx.With(ctx))
+		}
+
+		if newRole == space.RoleOwner {
+			if err := m.keepsOwner(ctx, sp.ID, targetID); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+
+		newRole = r
+	}
+
+	if err := m.writer.SetMember(ctx, sp.ID, targetID, newRole, status); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	updated, err := m.querier.GetMember(ctx, sp.ID, targetID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	mem, ok := updated.Get()
+	if !ok {
+		return nil, fault.Wrap(ErrNotMember, fctx.With(ctx))
+	}
+
+	return &mem, nil
+}
+
+// RemoveMember removes someone from the space, rejects their request to join
+// or withdraws their invitation. Moderators can only remove plain members.
+func (m *Manager) RemoveMember(ctx context.Context, slug string, targetID account.AccountID) error {
+	sp, err := m.authoriseManager(ctx, slug)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	current, err := m.querier.GetMember(ctx, sp.ID, targetID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	target, ok := current.Get()
+	if !ok {
+		return fault.Wrap(ErrNotMember, fctx.With(ctx))
+	}
+
+	if target.Role != space.RoleMember && !m.isOwner(sp) {
+		return fault.Wrap(ErrCannotRemove, fctx.With(ctx),
+			fmsg.WithDesc("cannot remove", "Only owners can remove other owners and moderators."))
+	}
+
+	if target.Role == space.RoleOwner {
+		if err := m.keepsOwner(ctx, sp.ID, targetID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := m.writer.RemoveMember(ctx, sp.ID, targetID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// isOwner is true for the space's owners and for site moderators, who have
+// passed authorise without any membership at all.
+func (m *Manager) isOwner(sp *space.Space) bool {
+	mem, ok := sp.Membership.Get()
+	if !ok || mem.Status != space.StatusActive {
+		return true
+	}
+
+	return mem.Role == space.RoleOwner
+}
+
+// keepsOwner fails if the given owner is the only one the space has left.
+func (m *Manager) keepsOwner(ctx context.Context, id space.SpaceID, ownerID account.AccountID) error {
+	members, err := m.querier.ListMembers(ctx, id, space.StatusActive)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	others := dt.Filter(members, func(mem *space.Member) bool {
+		return mem.Role == space.RoleOwner && mem.Profile.ID != ownerID
+	})
+	if len(others) == 0 {
+		return fault.Wrap(ErrLastOwner, fctx.With(ctx),
+			fmsg.WithDesc("last owner", "A space must always have an owner, appoint another before stepping down."))
+	}
+
+	return nil
+}
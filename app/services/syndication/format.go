@@ -0,0 +1,17 @@
+package syndication
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type formatEnum string
+
+const (
+	formatRSS  formatEnum = "rss"
+	formatAtom formatEnum = "atom"
+)
+
+func (f Format) ContentType() string {
+	if f == FormatAtom {
+		return "application/atom+xml; charset=utf-8"
+	}
+	return "application/rss+xml; charset=utf-8"
+}
@@ -0,0 +1,190 @@
+package syndication
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"time"
+
+	"github.com/Southclaws/fault"
+)
+
+type Document struct {
+	Body         []byte
+	ContentType  string
+	LastModified time.Time
+	ETag         string
+}
+
+// Render encodes a feed in the requested format. The self URL is the address
+// the feed was requested from, Atom requires it and RSS readers use it too.
+func Render(format Format, f *Feed, self string) (*Document, error) {
+	var doc any
+	if format == FormatAtom {
+		doc = toAtom(f, self)
+	} else {
+		doc = toRSS(f, self)
+	}
+
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	body := append([]byte(xml.Header), b...)
+	sum := sha256.Sum256(body)
+
+	return &Document{
+		Body:         body,
+		ContentType:  format.ContentType(),
+		LastModified: f.Updated,
+		ETag:         `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`,
+	}, nil
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	DCNS    string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	Author      string  `xml:"dc:creator,omitempty"`
+	Category    string  `xml:"category,omitempty"`
+	Description string  `xml:"description"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID        string        `xml:"id"`
+	Title     string        `xml:"title"`
+	Link      atomLink      `xml:"link"`
+	Author    *atomAuthor   `xml:"author,omitempty"`
+	Category  *atomCategory `xml:"category,omitempty"`
+	Summary   string        `xml:"summary,omitempty"`
+	Content   atomContent   `xml:"content"`
+	Published string        `xml:"published"`
+	Updated   string        `xml:"updated"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func toRSS(f *Feed, self string) rss {
+	items := make([]rssItem, 0, len(f.Items))
+	for _, i := range f.Items {
+		items = append(items, rssItem{
+			Title:       i.Title,
+			Link:        i.Link,
+			GUID:        rssGUID{IsPermaLink: true, Value: i.ID},
+			Author:      i.Author,
+			Category:    i.Category,
+			Description: i.Content,
+			PubDate:     i.Published.UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	channel := rssChannel{
+		Title:       f.Title,
+		Link:        f.Link,
+		Description: f.Description,
+		AtomLink:    atomLink{Href: self, Rel: "self", Type: "application/rss+xml"},
+		Items:       items,
+	}
+	if !f.Updated.IsZero() {
+		channel.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	return rss{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		DCNS:    "http://purl.org/dc/elements/1.1/",
+		Channel: channel,
+	}
+}
+
+func toAtom(f *Feed, self string) atomFeed {
+	entries := make([]atomEntry, 0, len(f.Items))
+	for _, i := range f.Items {
+		e := atomEntry{
+			ID:        i.ID,
+			Title:     i.Title,
+			Link:      atomLink{Href: i.Link, Rel: "alternate", Type: "text/html"},
+			Summary:   i.Summary,
+			Content:   atomContent{Type: "html", Value: i.Content},
+			Published: i.Published.UTC().Format(time.RFC3339),
+			Updated:   i.Updated.UTC().Format(time.RFC3339),
+		}
+		if i.Author != "" {
+			e.Author = &atomAuthor{Name: i.Author}
+		}
+		if i.Category != "" {
+			e.Category = &atomCategory{Term: i.Category}
+		}
+		entries = append(entries, e)
+	}
+
+	// An empty feed still needs an updated date, the epoch is stable so that
+	// the document's ETag doesn't change on every request.
+	updated := f.Updated
+	if updated.IsZero() {
+		updated = time.Unix(0, 0)
+	}
+
+	return atomFeed{
+		ID:       self,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: self, Rel: "self", Type: "application/atom+xml"},
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+		},
+		Entries: entries,
+	}
+}
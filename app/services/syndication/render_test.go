@@ -0,0 +1,100 @@
+package syndication
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	published := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	updated := published.Add(time.Hour)
+
+	f := &Feed{
+		Title:       "Storyden",
+		Description: "A forum",
+		Link:        "https://example.com",
+		Updated:     updated,
+		Items: []Item{{
+			ID:        "https://example.com/t/hello",
+			Title:     "Hello & welcome",
+			Link:      "https://example.com/t/hello",
+			Author:    "Odin",
+			Category:  "General",
+			Summary:   "hi",
+			Content:   "<p>hi</p>",
+			Published: published,
+			Updated:   updated,
+		}},
+	}
+
+	t.Run("rss", func(t *testing.T) {
+		doc, err := Render(FormatRSS, f, "https://api.example.com/api/feeds/rss")
+		r.NoError(err)
+
+		a.Equal("application/rss+xml; charset=utf-8", doc.ContentType)
+		a.Equal(updated, doc.LastModified)
+		a.NotEmpty(doc.ETag)
+
+		var parsed struct {
+			Channel struct {
+				Title string `xml:"title"`
+				Items []struct {
+					Title       string `xml:"title"`
+					GUID        string `xml:"guid"`
+					Description string `xml:"description"`
+					PubDate     string `xml:"pubDate"`
+				} `xml:"item"`
+			} `xml:"channel"`
+		}
+		r.NoError(xml.Unmarshal(doc.Body, &parsed))
+
+		a.Equal("Storyden", parsed.Channel.Title)
+		r.Len(parsed.Channel.Items, 1)
+		a.Equal("Hello & welcome", parsed.Channel.Items[0].Title)
+		a.Equal("https://example.com/t/hello", parsed.Channel.Items[0].GUID)
+		a.Equal("<p>hi</p>", parsed.Channel.Items[0].Description)
+		a.Equal("Thu, 02 Jan 2025 03:04:05 +0000", parsed.Channel.Items[0].PubDate)
+	})
+
+	t.Run("atom", func(t *testing.T) {
+		doc, err := Render(FormatAtom, f, "https://api.example.com/api/feeds/atom")
+		r.NoError(err)
+
+		a.Equal("application/atom+xml; charset=utf-8", doc.ContentType)
+
+		var parsed struct {
+			XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+			ID      string   `xml:"id"`
+			Updated string   `xml:"updated"`
+			Entries []struct {
+				Title   string `xml:"title"`
+				Author  string `xml:"author>name"`
+				Content string `xml:"content"`
+				Updated string `xml:"updated"`
+			} `xml:"entry"`
+		}
+		r.NoError(xml.Unmarshal(doc.Body, &parsed))
+
+		a.Equal("https://api.example.com/api/feeds/atom", parsed.ID)
+		a.Equal("2025-01-02T04:04:05Z", parsed.Updated)
+		r.Len(parsed.Entries, 1)
+		a.Equal("Odin", parsed.Entries[0].Author)
+		a.Equal("<p>hi</p>", parsed.Entries[0].Content)
+	})
+
+	t.Run("etag_is_stable", func(t *testing.T) {
+		first, err := Render(FormatAtom, &Feed{Title: "Empty"}, "https://api.example.com/api/feeds/atom")
+		r.NoError(err)
+		second, err := Render(FormatAtom, &Feed{Title: "Empty"}, "https://api.example.com/api/feeds/atom")
+		r.NoError(err)
+
+		a.Equal(first.ETag, second.ETag)
+	})
+}
@@ -0,0 +1,289 @@
+// Package syndication produces RSS and Atom feeds of published threads for the
+// whole instance, a category, a tag or a member. Feeds are public unless the
+// instance is private, in which case a member's feed token is required. A
+// token also unlocks the member's personalised home feed.
+package syndication
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/feed_token"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/feed"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const feedSize = 30
+
+var errPrivateInstance = fault.New("feeds on a private instance require a token", ftag.With(ftag.Unauthenticated))
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Feed struct {
+	Title       string
+	Description string
+	Link        string
+	Updated     time.Time
+	Items       []Item
+}
+
+type Item struct {
+	ID        string
+	Title     string
+	Link      string
+	Author    string
+	Category  string
+	Summary   string
+	Content   string
+	Published time.Time
+	Updated   time.Time
+}
+
+type Syndicator struct {
+	webAddress     url.URL
+	settings       *settings.SettingsRepository
+	accountQuerier *account_querier.Querier
+	roleQuerier    *role_querier.Querier
+	tokens         *feed_token.Repository
+	threadQuerier  *thread_querier.Querier
+	categoryRepo   *category.Repository
+	tagQuerier     *tag_querier.Querier
+	profileQuerier *profile_querier.Querier
+	homeFeed       *feed.Feed
+}
+
+func New(
+	cfg config.Config,
+	settings *settings.SettingsRepository,
+	accountQuerier *account_querier.Querier,
+	roleQuerier *role_querier.Querier,
+	tokens *feed_token.Repository,
+	threadQuerier *thread_querier.Querier,
+	categoryRepo *category.Repository,
+	tagQuerier *tag_querier.Querier,
+	profileQuerier *profile_querier.Querier,
+	homeFeed *feed.Feed,
+) *Syndicator {
+	return &Syndicator{
+		webAddress:     cfg.PublicWebAddress,
+		settings:       settings,
+		accountQuerier: accountQuerier,
+		roleQuerier:    roleQuerier,
+		tokens:         tokens,
+		threadQuerier:  threadQuerier,
+		categoryRepo:   categoryRepo,
+		tagQuerier:     tagQuerier,
+		profileQuerier: profileQuerier,
+		homeFeed:       homeFeed,
+	}
+}
+
+// Authorise resolves who a feed is being read as from an optional token, the
+// viewer is empty for guests which are only permitted on a public instance.
+func (s *Syndicator) Authorise(ctx context.Context, token opt.Optional[string]) (opt.Optional[account.AccountID], error) {
+	if t, ok := token.Get(); ok {
+		accountID, err := s.tokens.Lookup(ctx, t)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), fmsg.WithDesc("invalid token", "This feed URL is invalid or its token has been revoked."))
+		}
+
+		return opt.New(accountID), nil
+	}
+
+	set, err := s.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !set.Public.Or(true) {
+		return nil, fault.Wrap(errPrivateInstance, fctx.With(ctx))
+	}
+
+	return opt.NewEmpty[account.AccountID](), nil
+}
+
+func (s *Syndicator) Timeline(ctx context.Context, viewer opt.Optional[account.AccountID]) (*Feed, error) {
+	set, err := s.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.list(ctx, viewer, Feed{
+		Title:       set.Title.Or("Storyden"),
+		Description: set.Description.OrZero(),
+		Link:        s.webAddress.String(),
+	})
+}
+
+func (s *Syndicator) Category(ctx context.Context, viewer opt.Optional[account.AccountID], slug string) (*Feed, error) {
+	cat, err := s.categoryRepo.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	roles, err := s.roles(ctx, viewer)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !cat.Permissions.CanRead(roles) {
+		return nil, fault.New("category not readable", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return s.list(ctx, viewer, Feed{
+		Title:       cat.Name,
+		Description: cat.Description,
+		Link:        s.webAddress.JoinPath("d", cat.Slug).String(),
+	}, thread_querier.HasCategories(thread_querier.CategoryFilter{Slugs: []string{cat.Slug}}))
+}
+
+func (s *Syndicator) Tag(ctx context.Context, viewer opt.Optional[account.AccountID], name string) (*Feed, error) {
+	t, err := s.tagQuerier.Get(ctx, tag_ref.NewName(name))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.list(ctx, viewer, Feed{
+		Title: "#" + t.Name.String(),
+		Link:  s.webAddress.JoinPath("tags", t.Name.String()).String(),
+	}, thread_querier.HasTags([]xid.ID{xid.ID(t.ID)}))
+}
+
+func (s *Syndicator) Profile(ctx context.Context, viewer opt.Optional[account.AccountID], handle string) (*Feed, error) {
+	p, exists, err := s.profileQuerier.LookupByHandle(ctx, handle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !exists || p.Deleted.Ok() {
+		return nil, fault.New("profile not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return s.list(ctx, viewer, Feed{
+		Title:       p.Name,
+		Description: p.Bio.Short(),
+		Link:        s.webAddress.JoinPath("m", p.Handle).String(),
+	}, thread_querier.HasAuthor(p.ID))
+}
+
+// Home is the token owner's personalised feed, the same timeline they see on
+// the home page when signed in.
+func (s *Syndicator) Home(ctx context.Context, viewer opt.Optional[account.AccountID]) (*Feed, error) {
+	accountID, ok := viewer.Get()
+	if !ok {
+		return nil, fault.New("home feed requires a token", fctx.With(ctx), ftag.With(ftag.Unauthenticated))
+	}
+
+	set, err := s.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := s.homeFeed.List(ctx, accountID, feed.ModeLatest, 0, feedSize)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.build(Feed{
+		Title: set.Title.Or("Storyden") + " — Home",
+		Link:  s.webAddress.String(),
+	}, result.Threads), nil
+}
+
+func (s *Syndicator) list(ctx context.Context, viewer opt.Optional[account.AccountID], f Feed, filters ...thread_querier.Query) (*Feed, error) {
+	roles, err := s.roles(ctx, viewer)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filters = append(filters,
+		thread_querier.HasStatus(visibility.VisibilityPublished),
+		thread_querier.HasNotBeenDeleted(),
+		thread_querier.IsInReadableCategory(roles),
+	)
+
+	result, err := s.threadQuerier.List(ctx, 0, feedSize, viewer, filters...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.build(f, result.Threads), nil
+}
+
+// roles resolves the roles of whoever a feed is being read as, the request
+// itself is usually unauthenticated so the session's roles can't be used.
+func (s *Syndicator) roles(ctx context.Context, viewer opt.Optional[account.AccountID]) (role.Roles, error) {
+	accountID, ok := viewer.Get()
+	if !ok {
+		guest, err := s.roleQuerier.GetGuestRole(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return role.Roles{guest}, nil
+	}
+
+	acc, err := s.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return acc.Roles.Roles(), nil
+}
+
+func (s *Syndicator) build(f Feed, threads []*thread.Thread) *Feed {
+	f.Items = dt.Map(threads, s.item)
+
+	for _, i := range f.Items {
+		if i.Updated.After(f.Updated) {
+			f.Updated = i.Updated
+		}
+	}
+
+	return &f
+}
+
+func (s *Syndicator) item(t *thread.Thread) Item {
+	link := s.webAddress.JoinPath("t", mark.NewMark(xid.ID(t.ID), t.Slug).String()).String()
+
+	updated := t.UpdatedAt
+	if r, ok := t.LastReplyAt.Get(); ok && r.After(updated) {
+		updated = r
+	}
+
+	return Item{
+		ID:        link,
+		Title:     t.Title,
+		Link:      link,
+		Author:    t.Author.Name,
+		Category:  opt.Map(t.Category, func(c category.Category) string { return c.Name }).OrZero(),
+		Summary:   t.Short,
+		Content:   t.Content.HTML(),
+		Published: t.CreatedAt,
+		Updated:   updated,
+	}
+}
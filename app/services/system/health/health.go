@@ -0,0 +1,182 @@
+// Package health checks the services an instance depends on so orchestrators
+// and load balancers can decide whether to send it traffic. Only the database
+// is critical, an instance without working storage, cache or search can still
+// serve most requests so those dependencies degrade the instance instead.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	// DefaultCheckTimeout bounds each dependency check so a hanging service
+	// is reported as down rather than stalling the probe.
+	DefaultCheckTimeout = 2 * time.Second
+
+	// DefaultCacheFor reuses a recent report so frequent probes from several
+	// orchestrators don't each hit every dependency.
+	DefaultCacheFor = 2 * time.Second
+)
+
+type Status string
+
+const (
+	StatusOK       Status = "ok"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+type Dependency struct {
+	Name     string
+	Status   Status
+	Critical bool
+	Duration time.Duration
+}
+
+type Report struct {
+	Status       Status
+	Draining     bool
+	Dependencies []Dependency
+	CheckedAt    time.Time
+}
+
+// Ready is whether the instance should receive traffic.
+func (r *Report) Ready() bool {
+	return r.Status != StatusDown && !r.Draining
+}
+
+type check struct {
+	name     string
+	critical bool
+	fn       func(ctx context.Context) error
+}
+
+type Checker struct {
+	logger   *slog.Logger
+	checks   []check
+	draining atomic.Bool
+
+	mu   sync.Mutex
+	last *Report
+}
+
+func New(
+	logger *slog.Logger,
+	db *sql.DB,
+	storer object.Storer,
+	bus *pubsub.Bus,
+	store cache.Store,
+	sdx semdex.Semdexer,
+) *Checker {
+	c := &Checker{logger: logger}
+
+	c.checks = []check{
+		{name: "database", critical: true, fn: db.PingContext},
+		{name: "object_storage", fn: func(ctx context.Context) error {
+			_, err := storer.Exists(ctx, "healthz")
+			return err
+		}},
+		{name: "queue", fn: bus.Ping},
+		{name: "cache", fn: func(ctx context.Context) error {
+			return store.Set(ctx, "healthz", time.Now().Format(time.RFC3339), time.Minute)
+		}},
+	}
+
+	if p, ok := sdx.(semdex.Pinger); ok {
+		c.checks = append(c.checks, check{name: "search_index", fn: p.Ping})
+	}
+
+	return c
+}
+
+// Drain fails readiness from now on so load balancers stop routing new
+// requests to the instance while it shuts down.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+func (c *Checker) Check(ctx context.Context) *Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.last != nil && time.Since(c.last.CheckedAt) < DefaultCacheFor {
+		r := *c.last
+		r.Draining = c.draining.Load()
+		return &r
+	}
+
+	deps := make([]Dependency, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, ch := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deps[i] = c.run(ctx, ch)
+		}()
+	}
+	wg.Wait()
+
+	status := StatusOK
+	for _, d := range deps {
+		if d.Status != StatusDown {
+			continue
+		}
+		if d.Critical {
+			status = StatusDown
+			break
+		}
+		status = StatusDegraded
+	}
+
+	c.last = &Report{
+		Status:       status,
+		Draining:     c.draining.Load(),
+		Dependencies: deps,
+		CheckedAt:    time.Now(),
+	}
+
+	r := *c.last
+	return &r
+}
+
+func (c *Checker) run(ctx context.Context, ch check) Dependency {
+	ctx, cancel := context.WithTimeout(ctx, DefaultCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := ch.fn(ctx)
+
+	d := Dependency{
+		Name:     ch.name,
+		Status:   StatusOK,
+		Critical: ch.critical,
+		Duration: time.Since(start),
+	}
+
+	if err != nil {
+		d.Status = StatusDown
+
+		// Errors are logged rather than reported as probes are unauthenticated
+		// and error messages may include internal addresses.
+		c.logger.Warn("health check failed",
+			slog.String("dependency", ch.name),
+			slog.String("error", fault.Wrap(err, fctx.With(ctx)).Error()),
+		)
+	}
+
+	return d
+}
@@ -0,0 +1,152 @@
+// Package retention_enforcer applies the instance's retention rules, purging
+// trash, expired sessions and other old data once it's been kept long enough.
+// Rules can be reported on without removing anything to preview their effect.
+package retention_enforcer
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/library/node_writer"
+	"github.com/Southclaws/storyden/app/resources/retention"
+	"github.com/Southclaws/storyden/app/resources/retention/retention_repo"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/trash"
+	"github.com/Southclaws/storyden/app/resources/trash/trash_querier"
+	"github.com/Southclaws/storyden/app/resources/trash/trash_writer"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(register),
+	)
+}
+
+const DefaultSchedule = "@hourly"
+
+type Enforcer struct {
+	logger       *slog.Logger
+	settings     *settings.SettingsRepository
+	repo         *retention_repo.Repository
+	trashQuerier *trash_querier.Querier
+	trashWriter  *trash_writer.Writer
+	nodeWriter   *node_writer.Writer
+}
+
+func New(
+	logger *slog.Logger,
+	settings *settings.SettingsRepository,
+	repo *retention_repo.Repository,
+	trashQuerier *trash_querier.Querier,
+	trashWriter *trash_writer.Writer,
+	nodeWriter *node_writer.Writer,
+) *Enforcer {
+	return &Enforcer{
+		logger:       logger,
+		settings:     settings,
+		repo:         repo,
+		trashQuerier: trashQuerier,
+		trashWriter:  trashWriter,
+		nodeWriter:   nodeWriter,
+	}
+}
+
+func register(sched *scheduler.Scheduler, e *Enforcer) {
+	sched.Register("retention", DefaultSchedule, func(ctx context.Context) error {
+		_, err := e.Enforce(ctx, time.Now())
+		return err
+	})
+}
+
+// Report counts what each active rule would remove at the given time without
+// removing anything.
+func (e *Enforcer) Report(ctx context.Context, now time.Time) (*retention.Report, error) {
+	return e.apply(ctx, now, true)
+}
+
+// Enforce permanently removes everything the active rules cover.
+func (e *Enforcer) Enforce(ctx context.Context, now time.Time) (*retention.Report, error) {
+	r, err := e.apply(ctx, now, false)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, res := range r.Results {
+		if res.Count > 0 {
+			e.logger.Info("purged data past retention",
+				slog.String("rule", res.Rule.String()),
+				slog.Int("days", res.Days),
+				slog.Int("items", res.Count))
+		}
+	}
+
+	return r, nil
+}
+
+func (e *Enforcer) apply(ctx context.Context, now time.Time, dryRun bool) (*retention.Report, error) {
+	s, err := e.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	report := &retention.Report{DryRun: dryRun}
+
+	rules := s.Retention.OrZero()
+
+	for _, rule := range retention.Rules {
+		d, ok := rules.Days(rule).Get()
+		if rule == retention.RuleTrash {
+			d, ok = trash.RetentionDays(s.TrashRetentionDays), true
+		}
+		if !ok {
+			continue
+		}
+
+		before := now.Add(-time.Duration(d) * 24 * time.Hour)
+
+		n, err := e.applyRule(ctx, rule, before, dryRun)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		report.Results = append(report.Results, retention.Result{
+			Rule:   rule,
+			Days:   d,
+			Before: before,
+			Count:  n,
+		})
+	}
+
+	return report, nil
+}
+
+func (e *Enforcer) applyRule(ctx context.Context, rule retention.Rule, before time.Time, dryRun bool) (int, error) {
+	if rule != retention.RuleTrash {
+		if dryRun {
+			return e.repo.Count(ctx, rule, before)
+		}
+		return e.repo.Purge(ctx, rule, before)
+	}
+
+	if dryRun {
+		return e.trashQuerier.Count(ctx, before)
+	}
+
+	purged, err := e.trashWriter.Purge(ctx, before)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if purged > 0 {
+		e.nodeWriter.CleanupOrphanedSchemas(ctx)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,183 @@
+package tag_suggest
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/library/node_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+type autoApplier struct {
+	logger        *slog.Logger
+	threshold     float64
+	limit         int
+	suggester     *Suggester
+	threadQuerier *thread_querier.Querier
+	threadWriter  *thread_writer.Writer
+	nodeQuerier   *node_querier.Querier
+	nodeWriter    *node_writer.Writer
+	tagWriter     *tag_writer.Writer
+}
+
+func newAutoApplier(
+	lc fx.Lifecycle,
+	cfg config.Config,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	suggester *Suggester,
+	threadQuerier *thread_querier.Querier,
+	threadWriter *thread_writer.Writer,
+	nodeQuerier *node_querier.Querier,
+	nodeWriter *node_writer.Writer,
+	tagWriter *tag_writer.Writer,
+) {
+	if !cfg.TagAutoApplyEnabled {
+		return
+	}
+
+	a := &autoApplier{
+		logger:        logger,
+		threshold:     cfg.TagAutoApplyThreshold,
+		limit:         cfg.TagAutoApplyLimit,
+		suggester:     suggester,
+		threadQuerier: threadQuerier,
+		threadWriter:  threadWriter,
+		nodeQuerier:   nodeQuerier,
+		nodeWriter:    nodeWriter,
+		tagWriter:     tagWriter,
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if _, err := pubsub.Subscribe(hctx, bus, "tag_suggest.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			return a.logged(ctx, datagraph.KindThread, xid.ID(evt.ID), a.applyThread(ctx, evt.ID))
+		}); err != nil {
+			return err
+		}
+
+		_, err := pubsub.Subscribe(hctx, bus, "tag_suggest.node_published", func(ctx context.Context, evt *message.EventNodePublished) error {
+			return a.logged(ctx, datagraph.KindNode, xid.ID(evt.ID), a.applyNode(ctx, evt.ID))
+		})
+
+		return err
+	}))
+}
+
+func (a *autoApplier) logged(ctx context.Context, kind datagraph.Kind, id xid.ID, err error) error {
+	if err != nil {
+		a.logger.Error("failed to auto-apply tags",
+			slog.String("error", err.Error()),
+			slog.String("kind", kind.String()),
+			slog.String("id", id.String()),
+		)
+	}
+	return err
+}
+
+func (a *autoApplier) applyThread(ctx context.Context, id post.ID) error {
+	thr, err := a.threadQuerier.Get(ctx, id, pagination.NewPageParams(1, 1), opt.NewEmpty[account.AccountID]())
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(thr.Tags) > 0 {
+		return nil
+	}
+
+	ids, err := a.choose(ctx, thr.Title, thr.Content, xid.ID(id))
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	_, err = a.threadWriter.Update(ctx, id, thread_writer.WithTagsAdd(ids...))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (a *autoApplier) applyNode(ctx context.Context, id library.NodeID) error {
+	n, err := a.nodeQuerier.Get(ctx, library.NewID(xid.ID(id)))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(n.Tags) > 0 {
+		return nil
+	}
+
+	content, ok := n.Content.Get()
+	if !ok {
+		return nil
+	}
+
+	ids, err := a.choose(ctx, n.Name, content, xid.ID(id))
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	_, err = a.nodeWriter.Update(ctx, library.NewID(xid.ID(id)), node_writer.WithTagsAdd(ids...))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// choose picks the existing tags which score above the threshold, new terms
+// are never created automatically as they'd quickly clutter the tag list.
+func (a *autoApplier) choose(ctx context.Context, title string, content datagraph.Content, self xid.ID) ([]tag_ref.ID, error) {
+	suggestions, err := a.suggester.Suggest(ctx, title, content, MaxLimit, self)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	chosen := dt.Filter(suggestions, func(s *Suggestion) bool {
+		return s.Existing && s.Score >= a.threshold
+	})
+	if len(chosen) == 0 {
+		return nil, nil
+	}
+
+	tags, err := a.tagWriter.Add(ctx, dt.Map(chosen, func(s *Suggestion) tag_ref.Name { return s.Name })...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Restricted tags are left for the members who hold the tag's roles.
+	byName := make(map[tag_ref.Name]*tag_ref.Tag, len(tags))
+	for _, t := range tags {
+		if !t.Restricted() {
+			byName[t.Name] = t
+		}
+	}
+
+	ids := []tag_ref.ID{}
+	for _, s := range chosen {
+		if t, ok := byName[s.Name]; ok && len(ids) < a.limit {
+			ids = append(ids, t.ID)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,122 @@
+package tag_suggest
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+)
+
+// minTermLength and minTermCount decide which frequent words in the content are
+// worth proposing as brand new tags when they don't match an existing one.
+const (
+	minTermLength = 4
+	minTermCount  = 2
+	newTermWeight = 0.5
+)
+
+var stopwords = map[string]struct{}{
+	"about": {}, "after": {}, "again": {}, "also": {}, "because": {}, "been": {},
+	"before": {}, "being": {}, "could": {}, "does": {}, "doing": {}, "down": {},
+	"each": {}, "even": {}, "from": {}, "have": {}, "having": {}, "here": {},
+	"into": {}, "just": {}, "like": {}, "made": {}, "make": {}, "many": {},
+	"more": {}, "most": {}, "much": {}, "must": {}, "only": {}, "other": {},
+	"over": {}, "really": {}, "same": {}, "should": {}, "some": {}, "such": {},
+	"than": {}, "that": {}, "their": {}, "them": {}, "then": {}, "there": {},
+	"these": {}, "they": {}, "thing": {}, "things": {}, "think": {}, "this": {},
+	"those": {}, "through": {}, "very": {}, "want": {}, "well": {}, "were": {},
+	"what": {}, "when": {}, "where": {}, "which": {}, "while": {}, "will": {},
+	"with": {}, "would": {}, "your": {}, "yours": {},
+}
+
+func tokenise(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// keywords scores existing tags by how often their words appear in the text
+// and proposes frequently used terms which aren't tags yet.
+func keywords(text string, available tag_ref.Names) map[tag_ref.Name]*Suggestion {
+	tokens := tokenise(text)
+	out := map[tag_ref.Name]*Suggestion{}
+
+	matched := map[string]struct{}{}
+	for _, name := range available {
+		phrase := tokenise(name.String())
+		if len(phrase) == 0 {
+			continue
+		}
+
+		n := occurrences(tokens, phrase)
+		if n == 0 {
+			continue
+		}
+
+		for _, w := range phrase {
+			matched[w] = struct{}{}
+		}
+
+		out[name] = &Suggestion{Name: name, Score: saturate(n), Existing: true}
+	}
+
+	counts := map[string]int{}
+	for _, t := range tokens {
+		if utf8.RuneCountInString(t) < minTermLength {
+			continue
+		}
+		if _, ok := stopwords[t]; ok {
+			continue
+		}
+		if _, ok := matched[t]; ok {
+			continue
+		}
+		counts[t]++
+	}
+
+	for term, n := range counts {
+		if n < minTermCount {
+			continue
+		}
+
+		name := tag_ref.NewName(term)
+		if _, ok := out[name]; ok {
+			continue
+		}
+
+		out[name] = &Suggestion{Name: name, Score: saturate(n) * newTermWeight}
+	}
+
+	return out
+}
+
+// occurrences counts the appearances of a phrase in the token stream, allowing
+// a trailing "s" on the final word so plurals match singular tag names.
+func occurrences(tokens []string, phrase []string) int {
+	n := 0
+	for i := 0; i+len(phrase) <= len(tokens); i++ {
+		if matchAt(tokens[i:i+len(phrase)], phrase) {
+			n++
+		}
+	}
+	return n
+}
+
+func matchAt(window []string, phrase []string) bool {
+	last := len(phrase) - 1
+	for i, w := range phrase {
+		if window[i] == w {
+			continue
+		}
+		if i == last && window[i] == w+"s" {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func saturate(n int) float64 {
+	return 1 - 1/float64(n+1)
+}
@@ -0,0 +1,64 @@
+package tag_suggest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+)
+
+func TestKeywords(t *testing.T) {
+	t.Parallel()
+
+	available := tag_ref.Names{
+		tag_ref.NewName("golang"),
+		tag_ref.NewName("machine learning"),
+		tag_ref.NewName("rust"),
+	}
+
+	t.Run("existing_tags", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		got := keywords("Golang is great for Machine Learning, golang more so.", available)
+
+		golang, ok := got[tag_ref.NewName("golang")]
+		r.True(ok)
+		a.True(golang.Existing)
+
+		ml, ok := got[tag_ref.NewName("machine learning")]
+		r.True(ok)
+		a.True(ml.Existing)
+		a.Greater(golang.Score, ml.Score)
+
+		_, ok = got[tag_ref.NewName("rust")]
+		a.False(ok)
+	})
+
+	t.Run("plurals", func(t *testing.T) {
+		got := keywords("I love machine learnings", available)
+
+		_, ok := got[tag_ref.NewName("machine learning")]
+		assert.True(t, ok)
+	})
+
+	t.Run("new_terms", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		got := keywords("Gardening tips: gardening in winter, which is what gardening needs. That that that.", available)
+
+		g, ok := got[tag_ref.NewName("gardening")]
+		r.True(ok)
+		a.False(g.Existing)
+		a.LessOrEqual(g.Score, newTermWeight)
+
+		_, ok = got[tag_ref.NewName("that")]
+		a.False(ok, "stopwords are not suggested")
+
+		_, ok = got[tag_ref.NewName("winter")]
+		a.False(ok, "terms used once are not suggested")
+	})
+}
@@ -0,0 +1,175 @@
+// Package tag_suggest ranks tags for a piece of content being composed, using
+// the tags already in use and, when semdex is enabled, the tags applied to
+// semantically similar threads and library pages.
+package tag_suggest
+
+import (
+	"context"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/services/search/searcher"
+	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const (
+	DefaultLimit = 10
+	MaxLimit     = 50
+
+	minScore     = 0.1
+	similarItems = 10
+	maxQuery     = 2000
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(newAutoApplier),
+	)
+}
+
+type Suggestion struct {
+	Name  tag_ref.Name
+	Score float64
+	// Existing is false for terms extracted from the content which would be
+	// created as a new tag if applied.
+	Existing bool
+}
+
+type Suggester struct {
+	semdexEnabled bool
+	tagQuerier    *tag_querier.Querier
+	semdexQuerier semdex.Querier
+}
+
+func New(
+	cfg config.Config,
+	tagQuerier *tag_querier.Querier,
+	semdexQuerier semdex.Querier,
+) *Suggester {
+	return &Suggester{
+		semdexEnabled: cfg.SemdexProvider != "",
+		tagQuerier:    tagQuerier,
+		semdexQuerier: semdexQuerier,
+	}
+}
+
+// Suggest returns up to limit tags ranked by score, excluding the given items
+// from the similarity search so an item never votes for its own tags.
+func (s *Suggester) Suggest(ctx context.Context, title string, content datagraph.Content, limit int, exclude ...xid.ID) ([]*Suggestion, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	available, err := s.tagQuerier.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The title is counted twice as it's usually the densest summary of what
+	// the content is about.
+	text := title + "\n" + title + "\n" + content.Plaintext()
+
+	scores := keywords(text, available.Names())
+
+	if s.semdexEnabled {
+		similar, err := s.similar(ctx, title+"\n"+content.Plaintext(), exclude)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		for name, score := range similar {
+			if existing, ok := scores[name]; ok {
+				existing.Score = combine(existing.Score, score)
+				existing.Existing = true
+				continue
+			}
+			scores[name] = &Suggestion{Name: name, Score: score, Existing: true}
+		}
+	}
+
+	out := dt.Filter(lo.Values(scores), func(s *Suggestion) bool {
+		return s.Score >= minScore
+	})
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].Name.String() < out[j].Name.String()
+	})
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+
+	return out, nil
+}
+
+// similar scores each tag by the relevance-weighted share of similar items
+// which carry that tag.
+func (s *Suggester) similar(ctx context.Context, text string, exclude []xid.ID) (map[tag_ref.Name]float64, error) {
+	if utf8.RuneCountInString(text) > maxQuery {
+		text = string([]rune(text)[:maxQuery])
+	}
+
+	refs, err := s.semdexQuerier.SearchRefs(ctx, text, pagination.NewPageParams(1, uint(similarItems+len(exclude))), searcher.Options{
+		Kinds: opt.New([]datagraph.Kind{datagraph.KindThread, datagraph.KindNode}),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	excluded := map[xid.ID]struct{}{}
+	for _, id := range exclude {
+		excluded[id] = struct{}{}
+	}
+
+	relevant := dt.Filter(refs.Items, func(r *datagraph.Ref) bool {
+		_, skip := excluded[r.ID]
+		return !skip && r.Relevance > 0
+	})
+	if len(relevant) == 0 {
+		return nil, nil
+	}
+
+	tags, err := s.tagQuerier.ListForItems(ctx, dt.Map(relevant, func(r *datagraph.Ref) xid.ID { return r.ID })...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	total := 0.0
+	out := map[tag_ref.Name]float64{}
+	for _, r := range relevant {
+		total += r.Relevance
+		for _, name := range tags[r.ID] {
+			out[name] += r.Relevance
+		}
+	}
+
+	for name := range out {
+		out[name] /= total
+	}
+
+	return out, nil
+}
+
+// combine merges two independent signals so agreement between them ranks a
+// tag higher than either signal alone while staying between 0 and 1.
+func combine(a, b float64) float64 {
+	return 1 - (1-a)*(1-b)
+}
@@ -0,0 +1,214 @@
+// Package tagging applies tags to threads and library pages on behalf of a
+// member while enforcing the instance's tag policies: who may create new tags,
+// which roles may apply restricted tags and which tags a category requires.
+package tagging
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+)
+
+var (
+	ErrCreateDenied     = fault.New("account may not create tags", ftag.With(ftag.PermissionDenied))
+	ErrRestrictedTag    = fault.New("account may not apply a restricted tag", ftag.With(ftag.PermissionDenied))
+	ErrRequiredTagUnmet = fault.New("category requires a tag", ftag.With(ftag.InvalidArgument))
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Manager struct {
+	settings          *settings.SettingsRepository
+	accountQuerier    *account_querier.Querier
+	reputationQuerier *reputation_querier.Querier
+	categoryRepo      *category.Repository
+	tagQuerier        *tag_querier.Querier
+	tagWriter         *tag_writer.Writer
+}
+
+func New(
+	settings *settings.SettingsRepository,
+	accountQuerier *account_querier.Querier,
+	reputationQuerier *reputation_querier.Querier,
+	categoryRepo *category.Repository,
+	tagQuerier *tag_querier.Querier,
+	tagWriter *tag_writer.Writer,
+) *Manager {
+	return &Manager{
+		settings:          settings,
+		accountQuerier:    accountQuerier,
+		reputationQuerier: reputationQuerier,
+		categoryRepo:      categoryRepo,
+		tagQuerier:        tagQuerier,
+		tagWriter:         tagWriter,
+	}
+}
+
+// Apply resolves synonyms and returns the tags for the given names, creating
+// any which don't exist yet. Restricted tags may only be applied by members who
+// hold one of the tag's roles and new tags may only be created by members who
+// are trusted to, as configured in the instance settings.
+func (m *Manager) Apply(ctx context.Context, accountID account.AccountID, names tag_ref.Names) ([]*tag_ref.Tag, error) {
+	if len(names) == 0 {
+		return []*tag_ref.Tag{}, nil
+	}
+
+	names, err := m.tagWriter.Resolve(ctx, names...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := m.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	roles := acc.Roles.Roles()
+
+	if !bypasses(roles) {
+		existing, err := m.tagQuerier.ListByNames(ctx, names...)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		for _, t := range existing {
+			if !canApply(roles, t) {
+				return nil, fault.Wrap(ErrRestrictedTag,
+					fctx.With(ctx),
+					fmsg.WithDesc("restricted tag", fmt.Sprintf("You do not have permission to apply the %q tag.", t.Name.String())))
+			}
+		}
+
+		existingNames := tag_ref.Tags(existing).Names()
+		created := dt.Filter(names, func(n tag_ref.Name) bool {
+			return !slices.Contains(existingNames, n)
+		})
+		if len(created) > 0 {
+			if err := m.authoriseCreate(ctx, acc.ID, roles); err != nil {
+				return nil, fault.Wrap(err,
+					fctx.With(ctx),
+					fmsg.WithDesc("cannot create tags", fmt.Sprintf("You can't create new tags such as %q yet, please choose from existing tags.", created[0].String())))
+			}
+		}
+	}
+
+	tags, err := m.tagWriter.Add(ctx, names...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return tags, nil
+}
+
+// Removable filters a list of tags being removed from an item down to those the
+// member may remove. Restricted tags can only be removed by members who could
+// apply them, so a member editing their own thread can't strip staff tags.
+func (m *Manager) Removable(ctx context.Context, accountID account.AccountID, tags []*tag_ref.Tag) ([]*tag_ref.Tag, error) {
+	acc, err := m.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	roles := acc.Roles.Roles()
+
+	if bypasses(roles) {
+		return tags, nil
+	}
+
+	return dt.Filter(tags, func(t *tag_ref.Tag) bool { return canApply(roles, t) }), nil
+}
+
+// CheckRequired returns an error if the category requires threads to have one
+// of a set of tags and none of the given tags are in that set.
+func (m *Manager) CheckRequired(ctx context.Context, id category.CategoryID, names tag_ref.Names) error {
+	cat, err := m.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(cat.RequiredTags) == 0 {
+		return nil
+	}
+
+	for _, n := range names {
+		if slices.Contains(cat.RequiredTags, n) {
+			return nil
+		}
+	}
+
+	return fault.Wrap(ErrRequiredTagUnmet,
+		fctx.With(ctx),
+		fmsg.WithDesc("missing required tag",
+			fmt.Sprintf("Threads in %s must have at least one of these tags: %s.", cat.Name, strings.Join(cat.RequiredTags.Strings(), ", "))))
+}
+
+func (m *Manager) authoriseCreate(ctx context.Context, accountID account.AccountID, roles role.Roles) error {
+	s, err := m.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	policy := s.TagCreation.OrZero()
+	if !policy.Restricted() {
+		return nil
+	}
+
+	for _, r := range roles {
+		if slices.Contains(policy.Roles, xid.ID(r.ID)) {
+			return nil
+		}
+	}
+
+	if threshold, ok := policy.Reputation.Get(); ok {
+		total, err := m.reputationQuerier.Total(ctx, accountID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if total >= threshold {
+			return nil
+		}
+	}
+
+	return fault.Wrap(ErrCreateDenied, fctx.With(ctx))
+}
+
+// bypasses reports whether the roles are exempt from tag restrictions, members
+// who manage posts are trusted with every tag.
+func bypasses(roles role.Roles) bool {
+	return roles.Permissions().HasAny(rbac.PermissionAdministrator, rbac.PermissionManagePosts)
+}
+
+func canApply(roles role.Roles, t *tag_ref.Tag) bool {
+	if !t.Restricted() {
+		return true
+	}
+
+	for _, r := range roles {
+		if slices.Contains(t.Roles, r.ID) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+// Package telegram_bot runs a Telegram bot which sends notifications to members
+// who have linked their Telegram account and posts their replies to thread
+// reply notifications as replies in the thread.
+package telegram_bot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/url"
+	"sync"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/telegram_message"
+	"github.com/Southclaws/storyden/app/services/account/link_code"
+	"github.com/Southclaws/storyden/app/services/reply"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(run),
+	)
+}
+
+var ErrDisabled = fault.New("the telegram bot is not enabled", ftag.With(ftag.NotFound))
+
+type Bot struct {
+	logger       *slog.Logger
+	address      url.URL
+	webhook      url.URL
+	secret       string
+	messages     *telegram_message.Repository
+	authRepo     authentication.Repository
+	replyService reply.Service
+	codes        link_code.Codes
+	client       botAPI
+
+	mu       sync.Mutex
+	username string
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	messages *telegram_message.Repository,
+	authRepo authentication.Repository,
+	replyService reply.Service,
+) *Bot {
+	b := &Bot{
+		logger:       logger,
+		address:      cfg.PublicWebAddress,
+		webhook:      *cfg.PublicAPIAddress.JoinPath("telegram/webhook"),
+		messages:     messages,
+		authRepo:     authRepo,
+		replyService: replyService,
+		codes:        link_code.New(cfg.JWTSecret, "telegram_link:"),
+	}
+
+	// Link codes and the webhook secret are derived from the JWT secret so the
+	// bot can't run without one.
+	if cfg.TelegramBotToken != "" && cfg.TelegramAPIURL.Host != "" && len(cfg.JWTSecret) > 0 {
+		b.client = newClient(cfg.TelegramAPIURL, cfg.TelegramBotToken)
+
+		mac := hmac.New(sha256.New, cfg.JWTSecret)
+		mac.Write([]byte("telegram_webhook:" + cfg.TelegramBotToken))
+		b.secret = hex.EncodeToString(mac.Sum(nil))
+	}
+
+	return b
+}
+
+func (b *Bot) Enabled() bool {
+	return b.client != nil
+}
+
+// Secret is the token Telegram sends with every webhook request, it's set when
+// the webhook is registered.
+func (b *Bot) Secret() string {
+	return b.secret
+}
+
+// Username returns the bot's username, which members need to find the bot.
+func (b *Bot) Username(ctx context.Context) (string, error) {
+	if !b.Enabled() {
+		return "", fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.username != "" {
+		return b.username, nil
+	}
+
+	me, err := b.client.GetMe(ctx)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	b.username = me.Username
+
+	return b.username, nil
+}
+
+func run(
+	lc fx.Lifecycle,
+	b *Bot,
+) {
+	if !b.Enabled() {
+		return
+	}
+
+	// Telegram being unreachable shouldn't stop Storyden from starting, the
+	// webhook stays registered from the previous start in most cases anyway.
+	lc.Append(fx.StartHook(func(hctx context.Context) {
+		if _, err := b.Username(hctx); err != nil {
+			b.logger.Warn("failed to get telegram bot details", slog.String("error", err.Error()))
+			return
+		}
+
+		if err := b.client.SetWebhook(hctx, b.webhook.String(), b.secret); err != nil {
+			b.logger.Warn("failed to register telegram webhook", slog.String("error", err.Error()))
+		}
+	}))
+}
@@ -0,0 +1,134 @@
+package telegram_bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+// botAPI is the subset of the Telegram Bot API used by the bot.
+type botAPI interface {
+	GetMe(ctx context.Context) (*User, error)
+	SetWebhook(ctx context.Context, address string, secret string) error
+	SendMessage(ctx context.Context, m outboundMessage) (*Message, error)
+}
+
+type outboundMessage struct {
+	ChatID             int64               `json:"chat_id"`
+	Text               string              `json:"text"`
+	ParseMode          string              `json:"parse_mode,omitempty"`
+	LinkPreviewOptions *linkPreviewOptions `json:"link_preview_options,omitempty"`
+	ReplyParameters    *replyParameters    `json:"reply_parameters,omitempty"`
+}
+
+type linkPreviewOptions struct {
+	IsDisabled bool `json:"is_disabled"`
+}
+
+type replyParameters struct {
+	MessageID int64 `json:"message_id"`
+}
+
+// client calls the Bot API over HTTPS, the token is part of every method's
+// path so it must never be logged.
+type client struct {
+	http    *http.Client
+	address url.URL
+	token   string
+}
+
+func newClient(address url.URL, token string) *client {
+	return &client{
+		http:    &http.Client{Timeout: 30 * time.Second, Transport: outbound.Transport(nil)},
+		address: address,
+		token:   token,
+	}
+}
+
+func (c *client) GetMe(ctx context.Context) (*User, error) {
+	var u User
+	if err := c.do(ctx, "getMe", struct{}{}, &u); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &u, nil
+}
+
+func (c *client) SetWebhook(ctx context.Context, address string, secret string) error {
+	err := c.do(ctx, "setWebhook", map[string]any{
+		"url":             address,
+		"secret_token":    secret,
+		"allowed_updates": []string{"message"},
+	}, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (c *client) SendMessage(ctx context.Context, m outboundMessage) (*Message, error) {
+	var res Message
+	if err := c.do(ctx, "sendMessage", m, &res); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &res, nil
+}
+
+func (c *client) do(ctx context.Context, method string, body any, out any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	u := c.address.JoinPath("bot"+c.token, method)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		// The error includes the URL and therefore the token.
+		return fault.Wrap(fmt.Errorf("bot api request %s failed", method), fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	var r apiResponse
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return fault.Wrap(fmt.Errorf("bot api responded %d to %s", res.StatusCode, method), fctx.With(ctx))
+	}
+
+	if !r.OK {
+		return fault.Wrap(fmt.Errorf("bot api responded %d to %s: %s", r.ErrorCode, method, r.Description), fctx.With(ctx))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.Result, out); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Result      json.RawMessage `json:"result"`
+	ErrorCode   int             `json:"error_code"`
+	Description string          `json:"description"`
+}
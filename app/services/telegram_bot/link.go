@@ -0,0 +1,147 @@
+package telegram_bot
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+)
+
+type LinkCode struct {
+	BotUsername string
+	Code        string
+	URL         string
+	Expires     time.Time
+}
+
+// Link is the Telegram account linked to a member's account. The Telegram user
+// ID doubles as the ID of the member's private chat with the bot.
+type Link struct {
+	UserID   string
+	Username opt.Optional[string]
+}
+
+// LinkCode issues a code the member sends to the bot with /start to prove
+// which Storyden account their Telegram account belongs to. The URL opens the
+// chat with the code filled in, so that's usually all a member needs.
+func (b *Bot) LinkCode(ctx context.Context, accountID account.AccountID) (*LinkCode, error) {
+	username, err := b.Username(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	code, expires := b.codes.Issue(accountID, time.Now())
+
+	return &LinkCode{
+		BotUsername: username,
+		Code:        code,
+		URL:         "https://t.me/" + username + "?start=" + code,
+		Expires:     expires,
+	}, nil
+}
+
+func (b *Bot) Linked(ctx context.Context, accountID account.AccountID) (opt.Optional[Link], error) {
+	if !b.Enabled() {
+		return nil, fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	auth, err := b.linked(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.Map(auth, func(a authentication.Authentication) Link {
+		return Link{UserID: a.Identifier, Username: a.Name}
+	}), nil
+}
+
+func (b *Bot) Unlink(ctx context.Context, accountID account.AccountID) error {
+	if !b.Enabled() {
+		return fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	auth, err := b.linked(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if a, ok := auth.Get(); ok {
+		if _, err := b.authRepo.Delete(ctx, accountID, a.Identifier, authentication.ServiceTelegram); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (b *Bot) linked(ctx context.Context, accountID account.AccountID) (opt.Optional[authentication.Authentication], error) {
+	auths, err := b.authRepo.GetAuthMethods(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	telegram, ok := lo.Find(auths, func(a *authentication.Authentication) bool {
+		return a.Service == authentication.ServiceTelegram
+	})
+	if !ok {
+		return opt.NewEmpty[authentication.Authentication](), nil
+	}
+
+	return opt.New(*telegram), nil
+}
+
+func (b *Bot) onStart(ctx context.Context, m *Message, code string) error {
+	accountID, issued, err := b.codes.Decode(code, time.Now())
+	if err != nil {
+		return b.respond(ctx, m, "That link is invalid or has expired, get a new one from your account settings.")
+	}
+
+	userID := strconv.FormatInt(m.From.ID, 10)
+
+	existing, found, err := b.authRepo.LookupByIdentifier(ctx, authentication.ServiceTelegram, userID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if found {
+		if existing.Account.ID == accountID {
+			return b.respond(ctx, m, "Your Telegram account is already linked to @"+existing.Account.Handle+".")
+		}
+		return b.respond(ctx, m, "Your Telegram account is already linked to another account, unlink it from that account's settings first.")
+	}
+
+	current, err := b.linked(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if c, ok := current.Get(); ok {
+		// Links can be forwarded, so each can only be used once.
+		if !c.Created.Before(issued) {
+			return b.respond(ctx, m, "That link has already been used, get a new one from your account settings.")
+		}
+
+		if _, err := b.authRepo.Delete(ctx, accountID, c.Identifier, authentication.ServiceTelegram); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	opts := []authentication.Option{}
+	if m.From.Username != "" {
+		opts = append(opts, authentication.WithName(m.From.Username))
+	}
+
+	// There's no secret involved, the token is only set as it's required.
+	auth, err := b.authRepo.Create(ctx, accountID, authentication.ServiceTelegram, authentication.TokenTypeNone, userID, xid.New().String(), nil, opts...)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b.respond(ctx, m, "Your Telegram account is now linked to @"+auth.Account.Handle+". You'll get the notifications you've chosen for Telegram here and you can reply to thread replies to answer them.")
+}
@@ -0,0 +1,209 @@
+package telegram_bot
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/telegram_message"
+	"github.com/Southclaws/storyden/app/services/reply"
+)
+
+const startCommand = "/start"
+
+const help = "Replies to your threads and other notifications you've chosen for Telegram are sent here. Reply to a thread reply notification to answer it in the thread."
+
+// Notification is a message sent to a member's linked Telegram account. The
+// text is formatted with Telegram's subset of HTML and must fit within its
+// 4096 character limit.
+type Notification struct {
+	Text string
+
+	// When set, replying to the message in Telegram replies to this post.
+	ReplyTo opt.Optional[ReplyTarget]
+}
+
+type ReplyTarget struct {
+	PostID   post.ID
+	ThreadID post.ID
+}
+
+// Notify sends the notification to the member's linked Telegram account, if
+// they have one.
+func (b *Bot) Notify(ctx context.Context, accountID account.AccountID, n Notification) error {
+	if !b.Enabled() {
+		return nil
+	}
+
+	auth, err := b.linked(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	a, ok := auth.Get()
+	if !ok {
+		return nil
+	}
+
+	chatID, err := strconv.ParseInt(a.Identifier, 10, 64)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sent, err := b.client.SendMessage(ctx, outboundMessage{
+		ChatID:             chatID,
+		Text:               n.Text,
+		ParseMode:          "HTML",
+		LinkPreviewOptions: &linkPreviewOptions{IsDisabled: true},
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if t, ok := n.ReplyTo.Get(); ok {
+		err = b.messages.Create(ctx, telegram_message.Message{
+			ChatID:    chatID,
+			MessageID: sent.MessageID,
+			PostID:    t.PostID,
+			ThreadID:  t.ThreadID,
+		})
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// HandleUpdate processes an update pushed to the webhook. Failures are logged
+// rather than returned, otherwise Telegram would keep redelivering it.
+func (b *Bot) HandleUpdate(ctx context.Context, u Update) {
+	if err := b.onUpdate(ctx, u); err != nil {
+		b.logger.Error("failed to handle telegram update",
+			slog.Int64("update_id", u.UpdateID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func (b *Bot) onUpdate(ctx context.Context, u Update) error {
+	m := u.Message
+	if m == nil || m.From == nil || m.From.IsBot {
+		return nil
+	}
+
+	// Notifications are personal so the bot only talks in private chats.
+	if m.Chat.Type != "private" {
+		return nil
+	}
+
+	text := strings.TrimSpace(m.Text)
+	if text == "" {
+		return nil
+	}
+
+	if m.ReplyToMessage != nil {
+		return b.onReply(ctx, m, text)
+	}
+
+	// Deep links send the code as the start command's parameter.
+	if args, ok := strings.CutPrefix(text, startCommand); ok {
+		if code := strings.TrimSpace(args); code != "" {
+			return b.onStart(ctx, m, code)
+		}
+	}
+
+	return b.respond(ctx, m, help)
+}
+
+func (b *Bot) onReply(ctx context.Context, m *Message, text string) error {
+	target, err := b.messages.Get(ctx, m.Chat.ID, m.ReplyToMessage.MessageID)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return b.respond(ctx, m, help)
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Telegram redelivers updates it didn't get a timely response to.
+	if _, err := b.messages.Get(ctx, m.Chat.ID, m.MessageID); err == nil {
+		return nil
+	} else if ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	auth, linked, err := b.authRepo.LookupByIdentifier(ctx, authentication.ServiceTelegram, strconv.FormatInt(m.From.ID, 10))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !linked || auth.Disabled || auth.Account.DeletedAt.Ok() {
+		return b.respond(ctx, m, "Your Telegram account isn't linked to an account any more, link it again from your account settings to reply.")
+	}
+
+	content, err := datagraph.NewRichTextFromMarkdown(text)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	replyTo := opt.NewEmpty[post.ID]()
+	if target.PostID != target.ThreadID {
+		replyTo = opt.New(target.PostID)
+	}
+
+	r, err := b.replyService.Create(ctx, auth.Account.ID, target.ThreadID, reply.Partial{
+		Content: opt.New(content),
+		ReplyTo: replyTo,
+		Meta:    opt.New(map[string]any{"telegram_message_id": m.MessageID}),
+	})
+	if err != nil {
+		if ftag.Get(err) == ftag.PermissionDenied {
+			// Warnings, policies and applications explain what to do next.
+			if issue := fmsg.GetIssue(err); issue != "" {
+				return b.respond(ctx, m, issue)
+			}
+			return b.respond(ctx, m, "You can't reply to that thread.")
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Replying to your own reply in Telegram replies to it in the thread too.
+	err = b.messages.Create(ctx, telegram_message.Message{
+		ChatID:    m.Chat.ID,
+		MessageID: m.MessageID,
+		PostID:    r.ID,
+		ThreadID:  target.ThreadID,
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	link := b.address.JoinPath("t", r.RootThreadMark)
+	link.Fragment = r.ID.String()
+
+	return b.respond(ctx, m, "Your reply was posted: "+link.String())
+}
+
+// respond replies to a member's message with plain text.
+func (b *Bot) respond(ctx context.Context, m *Message, text string) error {
+	_, err := b.client.SendMessage(ctx, outboundMessage{
+		ChatID:             m.Chat.ID,
+		Text:               text,
+		LinkPreviewOptions: &linkPreviewOptions{IsDisabled: true},
+		ReplyParameters:    &replyParameters{MessageID: m.MessageID},
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package telegram_bot
+
+// Update is the payload Telegram posts to the webhook, only messages are
+// requested when the webhook is registered.
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message"`
+}
+
+type Message struct {
+	MessageID      int64    `json:"message_id"`
+	From           *User    `json:"from"`
+	Chat           Chat     `json:"chat"`
+	Text           string   `json:"text"`
+	ReplyToMessage *Message `json:"reply_to_message"`
+}
+
+type User struct {
+	ID        int64  `json:"id"`
+	IsBot     bool   `json:"is_bot"`
+	FirstName string `json:"first_name"`
+	Username  string `json:"username"`
+}
+
+type Chat struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
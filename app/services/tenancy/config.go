@@ -0,0 +1,57 @@
+package tenancy
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/Southclaws/storyden/app/resources/tenant"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// tenantConfig derives a tenant's configuration from the primary's. Tenants
+// share providers such as email and language models but everything which
+// holds community data is either a separate database or namespaced.
+func tenantConfig(primary config.Config, t *tenant.Tenant) config.Config {
+	id := t.ID.String()
+	cfg := primary
+
+	cfg.DatabaseURL = strings.ReplaceAll(primary.TenantDatabaseURL, "{tenant}", id)
+	cfg.TenantDatabaseURL = ""
+	cfg.DatabaseReplicaURLs = nil
+
+	cfg.Namespace = "tenant_" + id
+	if primary.Namespace != "" {
+		cfg.Namespace = primary.Namespace + "_" + cfg.Namespace
+	}
+
+	cfg.PublicWebAddress = url.URL{Scheme: primary.PublicWebAddress.Scheme, Host: t.Hostname}
+	cfg.PublicAPIAddress = url.URL{Scheme: primary.PublicAPIAddress.Scheme, Host: t.Hostname}
+
+	// Process-wide concerns are handled by the primary only.
+	cfg.RunFrontend = ""
+	cfg.OTELProvider = ""
+	cfg.MetricsProvider = ""
+
+	// A bot token can only be connected to one community.
+	cfg.DiscordBotToken = ""
+
+	// The same goes for a Matrix application service registration and a
+	// Telegram bot's webhook.
+	cfg.MatrixHomeserverURL = url.URL{}
+	cfg.TelegramBotToken = ""
+
+	// Reply addresses only carry an account and thread ID, so mail to the
+	// primary's reply domain can't be routed to a tenant.
+	cfg.EmailReplyDomain = ""
+	cfg.EmailInboundSecret = ""
+
+	cfg.SemdexLocalPath = filepath.Join(primary.SemdexLocalPath, id)
+	cfg.QdrantCollection = primary.QdrantCollection + "_" + id
+	cfg.WeaviateClassName = primary.WeaviateClassName + "_" + id
+	if primary.PineconeIndex != "" {
+		cfg.PineconeIndex = primary.PineconeIndex + "-" + id
+	}
+
+	return cfg
+}
@@ -0,0 +1,269 @@
+package tenancy
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/tenant"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func (m *Manager) run(ctx context.Context) {
+	ticker := time.NewTicker(DefaultSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		m.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync starts tenants which aren't running, restarts those whose hostname has
+// changed and stops those which have been removed, possibly via a replica.
+func (m *Manager) sync(ctx context.Context) {
+	// Only tenants running before the list are candidates for stopping, one
+	// provisioned while listing isn't in the list but hasn't been removed.
+	running := m.running()
+
+	tenants, err := m.repo.List(ctx)
+	if err != nil {
+		m.logger.Error("failed to list tenants", slog.String("error", err.Error()))
+		return
+	}
+
+	listed := map[tenant.ID]struct{}{}
+	for _, t := range tenants {
+		listed[t.ID] = struct{}{}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if _, err := m.apply(ctx, t); err != nil {
+			m.logger.Error("failed to start tenant",
+				slog.String("tenant", t.Hostname),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	for _, id := range running {
+		if _, ok := listed[id]; !ok {
+			m.remove(ctx, id)
+		}
+	}
+}
+
+func (m *Manager) running() []tenant.ID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Collect(maps.Keys(m.instances))
+}
+
+// lock serialises starting and stopping a single tenant, other tenants and
+// requests to running tenants aren't held up while it boots.
+func (m *Manager) lock(id tenant.ID) func() {
+	m.mu.Lock()
+	l, ok := m.locks[id]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[id] = l
+	}
+	m.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// apply makes sure a tenant is running under its current hostname.
+func (m *Manager) apply(ctx context.Context, t *tenant.Tenant) (*instance, error) {
+	defer m.lock(t.ID)()
+
+	m.mu.Lock()
+	prev, ok := m.instances[t.ID]
+	if ok && prev.tenant.Hostname == t.Hostname {
+		prev.tenant = t
+		m.mu.Unlock()
+		return prev, nil
+	}
+	delete(m.instances, t.ID)
+	m.mu.Unlock()
+
+	if ok {
+		m.unsetHosts(t.ID)
+		m.stop(ctx, prev)
+	}
+
+	return m.start(ctx, t)
+}
+
+// remove stops a tenant if it's running.
+func (m *Manager) remove(ctx context.Context, id tenant.ID) {
+	defer m.lock(id)()
+
+	m.mu.Lock()
+	inst, ok := m.instances[id]
+	delete(m.instances, id)
+	m.mu.Unlock()
+
+	m.unsetHosts(id)
+
+	if ok {
+		m.stop(ctx, inst)
+	}
+}
+
+// start boots a tenant's application. The caller must hold the tenant's lock.
+func (m *Manager) start(ctx context.Context, t *tenant.Tenant) (*instance, error) {
+	// Until the tenant is running, requests to its hostname are turned away
+	// rather than falling through to the primary community.
+	m.unsetHosts(t.ID)
+	m.setHost(t.Hostname, &instance{tenant: t})
+
+	cfg := tenantConfig(m.cfg, t)
+
+	if err := prepareDatabase(ctx, cfg.DatabaseURL); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tctx, cancel := context.WithCancel(context.Background())
+
+	inst := &instance{tenant: t, cancel: cancel}
+	var mux *http.ServeMux
+
+	app := fx.New(
+		fx.NopLogger,
+		fx.Provide(func() context.Context { return tctx }),
+		fx.Provide(func() config.Config { return cfg }),
+		m.compose(),
+		fx.Decorate(func(l *slog.Logger) *slog.Logger {
+			return l.With(slog.String("tenant", t.Hostname))
+		}),
+		fx.Populate(&mux, &inst.settings),
+	)
+
+	sctx, scancel := context.WithTimeout(ctx, DefaultStartTimeout)
+	defer scancel()
+
+	if err := app.Start(sctx); err != nil {
+		cancel()
+		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to start tenant"))
+	}
+
+	inst.app = app
+	inst.handler = mux
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		m.unsetHosts(t.ID)
+		m.stop(ctx, inst)
+		return nil, fault.Wrap(ErrClosed, fctx.With(ctx))
+	}
+	m.instances[t.ID] = inst
+	m.mu.Unlock()
+
+	m.setHost(t.Hostname, inst)
+
+	m.logger.Info("tenant started", slog.String("tenant", t.Hostname))
+
+	return inst, nil
+}
+
+// stop shuts a tenant's application down once it's been removed from the
+// running tenants.
+func (m *Manager) stop(ctx context.Context, inst *instance) {
+	if err := inst.app.Stop(ctx); err != nil {
+		m.logger.Error("failed to stop tenant",
+			slog.String("tenant", inst.tenant.Hostname),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	inst.cancel()
+
+	m.logger.Info("tenant stopped", slog.String("tenant", inst.tenant.Hostname))
+}
+
+func (m *Manager) stopAll(ctx context.Context) {
+	m.mu.Lock()
+	m.closed = true
+	instances := m.instances
+	m.instances = map[tenant.ID]*instance{}
+	m.mu.Unlock()
+
+	for id, inst := range instances {
+		m.unsetHosts(id)
+		m.stop(ctx, inst)
+	}
+}
+
+func (m *Manager) setHost(hostname string, inst *instance) {
+	m.hostsMu.Lock()
+	defer m.hostsMu.Unlock()
+
+	m.hosts[hostname] = inst
+}
+
+func (m *Manager) unsetHosts(id tenant.ID) {
+	m.hostsMu.Lock()
+	defer m.hostsMu.Unlock()
+
+	for hostname, inst := range m.hosts {
+		if inst.tenant.ID == id {
+			delete(m.hosts, hostname)
+		}
+	}
+}
+
+// prepareDatabase creates the tenant's PostgreSQL schema when the database URL
+// template places tenants in their own schema. SQLite files are created when
+// they're first opened so need no preparation.
+func prepareDatabase(ctx context.Context, databaseURL string) error {
+	u, err := url.Parse(databaseURL)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to parse TENANT_DATABASE_URL"))
+	}
+
+	if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+		return nil
+	}
+
+	schema, _, _ := strings.Cut(u.Query().Get("search_path"), ",")
+	schema = strings.TrimSpace(schema)
+	if schema == "" {
+		return nil
+	}
+
+	db, err := sql.Open("pgx", databaseURL)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, "create schema if not exists "+pgx.Identifier{schema}.Sanitize()); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create tenant schema"))
+	}
+
+	return nil
+}
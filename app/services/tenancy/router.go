@@ -0,0 +1,43 @@
+package tenancy
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Route sends requests for a tenant's hostname to that tenant's handler, any
+// other hostname is served by the primary community.
+func (m *Manager) Route(primary http.Handler) http.Handler {
+	if !m.Enabled() {
+		return primary
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.hostsMu.RLock()
+		inst, ok := m.hosts[requestHostname(r)]
+		m.hostsMu.RUnlock()
+
+		if !ok {
+			primary.ServeHTTP(w, r)
+			return
+		}
+
+		if inst.handler == nil {
+			w.Header().Set("Retry-After", "10")
+			http.Error(w, "community is starting", http.StatusServiceUnavailable)
+			return
+		}
+
+		inst.handler.ServeHTTP(w, r)
+	})
+}
+
+func requestHostname(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
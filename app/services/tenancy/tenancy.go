@@ -0,0 +1,232 @@
+// Package tenancy hosts several communities from one deployment. The primary
+// community keeps a list of tenants, each of which runs as a full instance of
+// the application with its own database and is served on its own hostname.
+// Keeping each tenant's data in a separate database means every repository,
+// cache and search index is isolated without having to scope every query.
+package tenancy
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/tenant"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+var (
+	// DefaultSyncInterval is how often the list of tenants is reloaded so
+	// replicas pick up tenants provisioned via another instance.
+	DefaultSyncInterval = 30 * time.Second
+
+	// DefaultStartTimeout bounds how long a tenant may take to boot, which is
+	// mostly spent migrating its database.
+	DefaultStartTimeout = 2 * time.Minute
+)
+
+var (
+	ErrDisabled        = fault.New("multi-tenancy is not enabled", ftag.With(ftag.InvalidArgument))
+	ErrInvalidHostname = fault.New("invalid hostname", ftag.With(ftag.InvalidArgument))
+	ErrClosed          = fault.New("tenancy is shutting down", ftag.With(ftag.Cancelled))
+)
+
+// Composition builds the application for a tenant. It's supplied by the
+// composition root as services can't depend on the transports which serve
+// them. When not supplied, multi-tenancy is unavailable.
+type Composition func() fx.Option
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type instance struct {
+	tenant   *tenant.Tenant
+	app      *fx.App
+	cancel   context.CancelFunc
+	handler  http.Handler
+	settings *settings.SettingsRepository
+}
+
+type Manager struct {
+	logger  *slog.Logger
+	cfg     config.Config
+	repo    *tenant.Repository
+	compose Composition
+
+	// mu guards the running tenants and is only held to read or swap entries.
+	// Booting happens under a per-tenant lock so a provisioning request and a
+	// sync never boot the same tenant twice, without one slow tenant holding
+	// up the rest.
+	mu        sync.Mutex
+	instances map[tenant.ID]*instance
+	locks     map[tenant.ID]*sync.Mutex
+	closed    bool
+
+	hostsMu sync.RWMutex
+	hosts   map[string]*instance
+}
+
+type params struct {
+	fx.In
+
+	Ctx     context.Context
+	LC      fx.Lifecycle
+	Logger  *slog.Logger
+	Cfg     config.Config
+	Repo    *tenant.Repository
+	Compose Composition `optional:"true"`
+}
+
+func New(p params) *Manager {
+	m := &Manager{
+		logger:    p.Logger,
+		cfg:       p.Cfg,
+		repo:      p.Repo,
+		compose:   p.Compose,
+		instances: map[tenant.ID]*instance{},
+		locks:     map[tenant.ID]*sync.Mutex{},
+		hosts:     map[string]*instance{},
+	}
+
+	if p.Cfg.TenantDatabaseURL != "" && p.Compose == nil {
+		p.Logger.Warn("TENANT_DATABASE_URL is set but this build cannot host tenants, multi-tenancy is disabled")
+	}
+
+	if !m.Enabled() {
+		return m
+	}
+
+	wctx, cancel := context.WithCancel(p.Ctx)
+
+	p.LC.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			// Tenants boot in the background so a slow migration for one
+			// community doesn't hold up the primary.
+			go m.run(wctx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			m.stopAll(ctx)
+			return nil
+		},
+	})
+
+	return m
+}
+
+// Enabled is false for tenants themselves, tenants never host tenants.
+func (m *Manager) Enabled() bool {
+	return m.cfg.TenantDatabaseURL != "" && m.compose != nil
+}
+
+func (m *Manager) List(ctx context.Context) ([]*tenant.Tenant, error) {
+	if !m.Enabled() {
+		return nil, fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	return m.repo.List(ctx)
+}
+
+// Create provisions a tenant and boots it, the community is ready to use once
+// this returns. The first member to register becomes its admin.
+func (m *Manager) Create(ctx context.Context, hostname string, name string) (*tenant.Tenant, error) {
+	if !m.Enabled() {
+		return nil, fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	if err := m.validateHostname(hostname); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	t, err := m.repo.Create(ctx, hostname, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	inst, err := m.apply(ctx, t)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("tenant was created but failed to start"))
+	}
+
+	if _, err := inst.settings.Set(ctx, settings.Settings{Title: opt.New(name)}); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return t, nil
+}
+
+// Update changes a tenant's hostname or name, changing the hostname restarts
+// the tenant as its public addresses are derived from it.
+func (m *Manager) Update(ctx context.Context, id tenant.ID, hostname opt.Optional[string], name opt.Optional[string]) (*tenant.Tenant, error) {
+	if !m.Enabled() {
+		return nil, fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	opts := []tenant.Option{}
+
+	if v, ok := hostname.Get(); ok {
+		if err := m.validateHostname(v); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		opts = append(opts, tenant.WithHostname(v))
+	}
+
+	if v, ok := name.Get(); ok {
+		opts = append(opts, tenant.WithName(v))
+	}
+
+	t, err := m.repo.Update(ctx, id, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := m.apply(ctx, t); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return t, nil
+}
+
+// Delete stops serving a tenant. Its database and assets are kept so it can be
+// restored by an operator, removing them is left to the operator.
+func (m *Manager) Delete(ctx context.Context, id tenant.ID) error {
+	if !m.Enabled() {
+		return fault.Wrap(ErrDisabled, fctx.With(ctx))
+	}
+
+	if err := m.repo.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.remove(ctx, id)
+
+	return nil
+}
+
+func (m *Manager) validateHostname(hostname string) error {
+	h := strings.ToLower(strings.TrimSpace(hostname))
+
+	if h == "" || strings.ContainsAny(h, "/:@?# ") {
+		return fault.Wrap(ErrInvalidHostname, fmsg.WithDesc("invalid hostname",
+			"The hostname must be a domain name without a scheme, port or path, such as community.example.com."))
+	}
+
+	if h == m.cfg.PublicWebAddress.Hostname() || h == m.cfg.PublicAPIAddress.Hostname() {
+		return fault.Wrap(ErrInvalidHostname, fmsg.WithDesc("hostname in use",
+			"This hostname is used by the primary community."))
+	}
+
+	return nil
+}
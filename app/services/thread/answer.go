@@ -0,0 +1,81 @@
+package thread
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var errNotThreadReply = fault.New("reply does not belong to thread", ftag.With(ftag.InvalidArgument))
+
+func (s *service) SetAnswer(ctx context.Context, threadID post.ID, replyID opt.Optional[post.ID]) (*thread.Thread, error) {
+	aid, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := s.accountQuery.GetByID(ctx, aid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thr, err := s.threadQuerier.Get(ctx, threadID, pagination.Parameters{}, opt.NewEmpty[account.AccountID]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := authoriseThreadUpdate(ctx, acc, thr); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if id, ok := replyID.Get(); ok {
+		r, err := s.replyRepo.Get(ctx, id)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if r.RootPostID != thr.ID {
+			return nil, fault.Wrap(errNotThreadReply, fctx.With(ctx),
+				fmsg.WithDesc("not thread reply", "The accepted answer must be a reply within the same thread."))
+		}
+	}
+
+	previous := thr.AcceptedReplyID
+
+	updated, err := s.threadWriter.Update(ctx, threadID, thread_writer.WithAcceptedReply(replyID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if prev, ok := previous.Get(); ok && prev != replyID.OrZero() {
+		s.bus.Publish(ctx, &message.EventThreadAnswerUnaccepted{
+			ThreadID: thr.ID,
+			ReplyID:  prev,
+		})
+	}
+
+	if id, ok := replyID.Get(); ok && id != previous.OrZero() {
+		s.bus.Publish(ctx, &message.EventThreadAnswerAccepted{
+			ThreadID: thr.ID,
+			ReplyID:  id,
+		})
+	}
+
+	s.bus.Publish(ctx, &message.EventThreadUpdated{
+		ID: thr.ID,
+	})
+
+	return updated, nil
+}
@@ -0,0 +1,114 @@
+package thread
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+)
+
+var (
+	ErrCategoryPostDenied   = fault.New("roles may not post in the category", ftag.With(ftag.PermissionDenied))
+	ErrCategoryAttachDenied = fault.New("roles may not attach media in the category", ftag.With(ftag.PermissionDenied))
+	ErrCategoryThreadHidden = fault.New("thread is in a category the roles can't read", ftag.With(ftag.NotFound))
+)
+
+func (s *service) authoriseCategoryPost(ctx context.Context, roles role.Roles, id category.CategoryID, content opt.Optional[datagraph.Content]) error {
+	cat, err := s.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !cat.Permissions.CanCreateThread(roles) {
+		return fault.Wrap(ErrCategoryPostDenied, fctx.With(ctx),
+			fmsg.WithDesc("not permitted", "You do not have permission to post in this category."))
+	}
+
+	if err := cat.CheckWritable(ctx, time.Now(), roles); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return authoriseCategoryAttach(ctx, cat.Permissions, roles, content)
+}
+
+// authoriseCategoryUpdate checks the destination category when a thread moves
+// and otherwise whether the thread's title and content may still be changed.
+func (s *service) authoriseCategoryUpdate(ctx context.Context, roles role.Roles, thr *thread.Thread, partial Partial) error {
+	current, hasCategory := thr.Category.Get()
+
+	if id, ok := partial.Category.Get(); ok && (!hasCategory || xid.ID(current.ID) != id) {
+		return s.authoriseCategoryPost(ctx, roles, category.CategoryID(id), partial.Content)
+	}
+
+	if !hasCategory {
+		return nil
+	}
+
+	cat, err := s.categoryRepo.GetByID(ctx, current.ID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if partial.Title.Ok() || partial.Content.Ok() {
+		if err := cat.CheckWritable(ctx, time.Now(), roles); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return authoriseCategoryAttach(ctx, cat.Permissions, roles, partial.Content)
+}
+
+// checkRequiredTags checks the tags a thread will have after an update against
+// the tags required by the category the thread will be in.
+func (s *service) checkRequiredTags(ctx context.Context, thr *thread.Thread, partial Partial, tags tag_ref.Names) error {
+	id, ok := partial.Category.Get()
+	if !ok {
+		current, hasCategory := thr.Category.Get()
+		if !hasCategory {
+			return nil
+		}
+		id = xid.ID(current.ID)
+	}
+
+	return s.tagging.CheckRequired(ctx, category.CategoryID(id), tags)
+}
+
+// authoriseCategoryRead hides threads in categories the viewer's roles can't
+// read, as if the thread didn't exist.
+func (s *service) authoriseCategoryRead(ctx context.Context, roles role.Roles, id category.CategoryID) error {
+	cat, err := s.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !cat.Permissions.CanRead(roles) {
+		return fault.Wrap(ErrCategoryThreadHidden, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func authoriseCategoryAttach(ctx context.Context, perms category.Permissions, roles role.Roles, content opt.Optional[datagraph.Content]) error {
+	c, ok := content.Get()
+	if !ok || len(c.Media()) == 0 {
+		return nil
+	}
+
+	if !perms.CanAttach(roles) {
+		return fault.Wrap(ErrCategoryAttachDenied, fctx.With(ctx),
+			fmsg.WithDesc("not permitted", "You do not have permission to attach files in this category."))
+	}
+
+	return nil
+}
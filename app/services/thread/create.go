@@ -7,16 +7,19 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post/category"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/app/services/link/fetcher"
+	"github.com/Southclaws/storyden/app/services/moderation/spam"
 )
 
 func (s *service) Create(ctx context.Context,
@@ -25,17 +28,72 @@ func (s *service) Create(ctx context.Context,
 	meta map[string]any,
 	partial Partial,
 ) (*thread.Thread, error) {
+	if err := s.netbans.CheckPost(ctx, authorID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filtered, err := s.cpm.FilterPost(ctx, opt.New(title), partial.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	title = filtered.Title.OrZero()
+	partial.Content = filtered.Content
+
 	if content, ok := partial.Content.Get(); ok {
 		if err := s.cpm.CheckContent(ctx, content); err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
 		}
 	}
 
+	verdict, err := s.automod.Evaluate(ctx, authorID, partial.Content.OrZero())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	screened, err := s.spamScreen.Screen(ctx, authorID, spam.SubmissionThread, title, partial.Content.OrZero())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	opts := partial.Opts()
 	opts = append(opts,
 		thread_writer.WithMeta(meta),
 	)
 
+	if id, ok := partial.Category.Get(); ok {
+		acc, err := s.accountQuery.GetByID(ctx, authorID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := s.authoriseCategoryPost(ctx, acc.Roles.Roles(), category.CategoryID(id), partial.Content); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if id, ok := partial.Space.Get(); ok {
+		if err := s.authoriseSpacePost(ctx, authorID, id); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		opts = append(opts, thread_writer.WithSpace(xid.ID(id)))
+	}
+
+	if score, ok := screened.Score.Get(); ok {
+		opts = append(opts, thread_writer.WithSpamScore(score))
+	}
+
+	if partial.Visibility.OrZero() == visibility.VisibilityPublished {
+		held, err := s.postQueue.Required(ctx, authorID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if held || verdict.Hidden() || screened.Held || filtered.Review {
+			opts = append(opts, thread_writer.WithVisibility(visibility.VisibilityReview))
+		}
+	}
+
 	// Small hack: default to zero-value of content, which is actually not zero
 	// it's <body></body>. Why? who knows... oh, me, yes I should know. I don't.
 	if !partial.Content.Ok() {
@@ -50,8 +108,19 @@ func (s *service) Create(ctx context.Context,
 		}
 	}
 
-	if tags, ok := partial.Tags.Get(); ok {
-		newTags, err := s.tagWriter.Add(ctx, tags...)
+	tags, err := s.tagWriter.Resolve(ctx, partial.Tags.OrZero()...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if id, ok := partial.Category.Get(); ok {
+		if err := s.tagging.CheckRequired(ctx, category.CategoryID(id), tags); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if partial.Tags.Ok() {
+		newTags, err := s.tagging.Apply(ctx, authorID, tags)
 		if err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
 		}
@@ -70,12 +139,22 @@ func (s *service) Create(ctx context.Context,
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create thread"))
 	}
 
-	if thr.Visibility == visibility.VisibilityPublished {
+	switch thr.Visibility {
+	case visibility.VisibilityPublished:
 		s.bus.Publish(ctx, &message.EventThreadPublished{
 			ID: thr.ID,
 		})
+
+	case visibility.VisibilityReview:
+		s.bus.Publish(ctx, &message.EventPostHeldForReview{
+			ThreadID: thr.ID,
+			PostID:   thr.ID,
+			AuthorID: authorID,
+		})
 	}
 
+	s.automod.Record(ctx, thr, authorID, verdict)
+
 	// TODO: Do this using event consumer.
 	s.mentioner.Send(ctx, authorID, *datagraph.NewRef(thr), thr.Content.References()...)
 
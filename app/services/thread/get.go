@@ -12,6 +12,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/space"
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 )
@@ -26,6 +27,7 @@ func (s *service) Get(
 	ctx, span := s.ins.Instrument(ctx)
 	defer span.End()
 
+	roles := session.GetOptRoles(ctx)
 	session := session.GetOptAccountID(ctx)
 
 	thr, err := s.threadQuerier.Get(ctx, threadID, pageParams, session)
@@ -33,6 +35,18 @@ func (s *service) Get(
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to get thread"))
 	}
 
+	if id, ok := thr.SpaceID.Get(); ok {
+		if err := s.authoriseSpaceRead(ctx, session, space.SpaceID(id)); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if c, ok := thr.Category.Get(); ok {
+		if err := s.authoriseCategoryRead(ctx, roles, c.ID); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	if thr.Visibility != visibility.VisibilityPublished {
 		accountID, ok := session.Get()
 		if !ok {
@@ -55,6 +69,8 @@ func (s *service) Get(
 		}
 	}
 
+	s.hydrateSummaries(ctx, thr)
+
 	// recommendations, err := s.recommender.Recommend(ctx, thr)
 	// if err != nil {
 	// 	s.l.Warn("failed to aggregate recommendations", slog.String("error", err.Error()))
@@ -11,9 +11,11 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
 )
 
 type Params struct {
@@ -24,12 +26,38 @@ type Params struct {
 	Visibility    opt.Optional[[]visibility.Visibility]
 	Tags          opt.Optional[[]xid.ID]
 	Categories    opt.Optional[thread_querier.CategoryFilter]
+	Space         opt.Optional[string]
+	Cursor        opt.Optional[pagination.Cursor]
 }
 
 func (s *service) List(ctx context.Context,
 	page int,
 	size int,
 	opts Params,
+) (*thread_querier.Result, error) {
+	// Members see their own read state and hidden threads so only the listings
+	// served to guests, which are the same for everyone, are cached.
+	if session.GetOptAccountID(ctx).Ok() {
+		return s.list(ctx, page, size, opts)
+	}
+
+	key := cache.Key(listingKey{Page: page, Size: size, Params: opts})
+
+	return cache.Fetch(ctx, s.threadCache.Listings(), key, func(ctx context.Context) (*thread_querier.Result, error) {
+		return s.list(ctx, page, size, opts)
+	})
+}
+
+type listingKey struct {
+	Page   int
+	Size   int
+	Params Params
+}
+
+func (s *service) list(ctx context.Context,
+	page int,
+	size int,
+	opts Params,
 ) (*thread_querier.Result, error) {
 	accountID := session.GetOptAccountID(ctx)
 
@@ -41,8 +69,30 @@ func (s *service) List(ctx context.Context,
 	opts.CreatedBefore.Call(func(value time.Time) { q = append(q, thread_querier.HasCreatedDateBefore(value)) })
 	opts.UpdatedBefore.Call(func(value time.Time) { q = append(q, thread_querier.HasUpdatedDateBefore(value)) })
 	opts.AccountID.Call(func(a account.AccountID) { q = append(q, thread_querier.HasAuthor(a)) })
-	opts.Tags.Call(func(a []xid.ID) { q = append(q, thread_querier.HasTags(a)) })
 	opts.Categories.Call(func(cf thread_querier.CategoryFilter) { q = append(q, thread_querier.HasCategories(cf)) })
+	opts.Space.Call(func(slug string) { q = append(q, thread_querier.HasSpace(slug)) })
+	accountID.Call(func(a account.AccountID) { q = append(q, thread_querier.IsNotHiddenFrom(a)) })
+
+	if tags, ok := opts.Tags.Get(); ok {
+		tags, err := s.tagQuerier.WithDescendants(ctx, tags...)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		q = append(q, thread_querier.HasTags(tags))
+	}
+
+	if c, ok := opts.Cursor.Get(); ok {
+		cq, err := thread_querier.HasCursor(c)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		q = append(q, cq)
+	}
+
+	q = append(q,
+		thread_querier.IsReadableBy(accountID),
+		thread_querier.IsInReadableCategory(session.GetOptRoles(ctx)),
+	)
 
 	vq := func() thread_querier.Query {
 		v, ok := opts.Visibility.Get()
@@ -80,5 +130,7 @@ func (s *service) List(ctx context.Context,
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to list threads"))
 	}
 
+	s.hydrateSummaries(ctx, thr.Threads...)
+
 	return thr, nil
 }
@@ -4,6 +4,7 @@ package thread
 
 import (
 	"context"
+	"log/slog"
 	"net/url"
 
 	"github.com/Southclaws/opt"
@@ -13,19 +14,32 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/summary"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
 	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/space"
+	"github.com/Southclaws/storyden/app/resources/space/space_querier"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/app/services/link/fetcher"
 	"github.com/Southclaws/storyden/app/services/mention/mentioner"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_engine"
 	"github.com/Southclaws/storyden/app/services/moderation/content_policy"
+	"github.com/Southclaws/storyden/app/services/moderation/netban_guard"
+	"github.com/Southclaws/storyden/app/services/moderation/post_queue"
+	"github.com/Southclaws/storyden/app/services/moderation/spam_screen"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/tag/tagging"
 	"github.com/Southclaws/storyden/app/services/thread/thread_semdex"
+	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/spanner"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
@@ -44,6 +58,10 @@ type Service interface {
 
 	Delete(ctx context.Context, id post.ID) error
 
+	// SetAnswer marks one of the thread's replies as the accepted answer or,
+	// if no reply is given, clears the currently accepted answer.
+	SetAnswer(ctx context.Context, threadID post.ID, replyID opt.Optional[post.ID]) (*thread.Thread, error)
+
 	List(ctx context.Context,
 		page int,
 		size int,
@@ -62,6 +80,7 @@ type Partial struct {
 	Title      opt.Optional[string]
 	Content    opt.Optional[datagraph.Content]
 	Category   opt.Optional[xid.ID]
+	Space      opt.Optional[space.SpaceID]
 	Tags       opt.Optional[tag_ref.Names]
 	Visibility opt.Optional[visibility.Visibility]
 	URL        opt.Optional[url.URL]
@@ -85,43 +104,84 @@ func Build() fx.Option {
 }
 
 type service struct {
-	ins spanner.Instrumentation
+	ins    spanner.Instrumentation
+	logger *slog.Logger
 
 	accountQuery  *account_querier.Querier
 	threadQuerier *thread_querier.Querier
+	threadCache   *thread_cache.Cache
 	threadWriter  *thread_writer.Writer
+	replyRepo     reply.Repository
+	categoryRepo  *category.Repository
+	tagQuerier    *tag_querier.Querier
 	tagWriter     *tag_writer.Writer
+	tagging       *tagging.Manager
+	spaceQuerier  *space_querier.Querier
 	fetcher       *fetcher.Fetcher
 	recommender   semdex.Recommender
 	bus           *pubsub.Bus
 	mentioner     *mentioner.Mentioner
 	cpm           *content_policy.Manager
+	automod       *automod_engine.Engine
+	postQueue     *post_queue.Queue
+	spamScreen    *spam_screen.Screener
+	netbans       *netban_guard.Guard
+
+	summariesEnabled bool
+	summaries        *summary.Repository
 }
 
 func New(
 	ins spanner.Builder,
+	cfg config.Config,
+	logger *slog.Logger,
 
 	accountQuery *account_querier.Querier,
 	threadQuerier *thread_querier.Querier,
+	threadCache *thread_cache.Cache,
 	threadWriter *thread_writer.Writer,
+	replyRepo reply.Repository,
+	categoryRepo *category.Repository,
+	tagQuerier *tag_querier.Querier,
 	tagWriter *tag_writer.Writer,
+	tagging *tagging.Manager,
+	spaceQuerier *space_querier.Querier,
 	fetcher *fetcher.Fetcher,
 	recommender semdex.Recommender,
 	bus *pubsub.Bus,
 	mentioner *mentioner.Mentioner,
 	cpm *content_policy.Manager,
+	automod *automod_engine.Engine,
+	postQueue *post_queue.Queue,
+	spamScreen *spam_screen.Screener,
+	netbans *netban_guard.Guard,
+	summaries *summary.Repository,
 ) Service {
 	return &service{
-		ins: ins.Build(),
+		ins:    ins.Build(),
+		logger: logger,
 
 		accountQuery:  accountQuery,
 		threadQuerier: threadQuerier,
+		threadCache:   threadCache,
 		threadWriter:  threadWriter,
+		replyRepo:     replyRepo,
+		categoryRepo:  categoryRepo,
+		tagQuerier:    tagQuerier,
 		tagWriter:     tagWriter,
+		tagging:       tagging,
+		spaceQuerier:  spaceQuerier,
 		fetcher:       fetcher,
 		recommender:   recommender,
 		bus:           bus,
 		mentioner:     mentioner,
 		cpm:           cpm,
+		automod:       automod,
+		postQueue:     postQueue,
+		spamScreen:    spamScreen,
+		netbans:       netbans,
+
+		summariesEnabled: cfg.ContentSummariesEnabled,
+		summaries:        summaries,
 	}
 }
@@ -0,0 +1,48 @@
+package thread
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/space"
+)
+
+var (
+	ErrNotSpaceMember    = fault.New("not a member of the space", ftag.With(ftag.PermissionDenied))
+	ErrSpaceThreadHidden = fault.New("thread is in a space the member has not joined", ftag.With(ftag.NotFound))
+)
+
+func (s *service) authoriseSpacePost(ctx context.Context, authorID account.AccountID, id space.SpaceID) error {
+	sp, err := s.spaceQuerier.GetByID(ctx, id, opt.New(authorID))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !sp.IsMember() {
+		return fault.Wrap(ErrNotSpaceMember, fctx.With(ctx),
+			fmsg.WithDesc("not a member", "Only members of this space can post in it."))
+	}
+
+	return nil
+}
+
+// authoriseSpaceRead hides threads in spaces that aren't open from anyone who
+// hasn't joined, as if the thread didn't exist.
+func (s *service) authoriseSpaceRead(ctx context.Context, viewer opt.Optional[account.AccountID], id space.SpaceID) error {
+	sp, err := s.spaceQuerier.GetByID(ctx, id, viewer)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !sp.IsPublic() && !sp.IsMember() {
+		return fault.Wrap(ErrSpaceThreadHidden, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package thread
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+)
+
+// hydrateSummaries attaches generated summaries to threads which have one.
+// Summaries are supplementary so failing to load them doesn't fail the read.
+func (s *service) hydrateSummaries(ctx context.Context, threads ...*thread.Thread) {
+	if !s.summariesEnabled || len(threads) == 0 {
+		return
+	}
+
+	ids := dt.Map(threads, func(t *thread.Thread) xid.ID { return xid.ID(t.ID) })
+
+	summaries, err := s.summaries.GetMany(ctx, ids...)
+	if err != nil {
+		s.logger.Warn("failed to hydrate thread summaries", slog.String("error", err.Error()))
+		return
+	}
+
+	for _, t := range threads {
+		if sm, ok := summaries[xid.ID(t.ID)]; ok {
+			t.Summary = opt.New(sm.Text)
+		}
+	}
+}
@@ -15,15 +15,6 @@ import (
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
 )
 
-func (r *semdexer) schedule(ctx context.Context, schedule time.Duration, reindexThreshold time.Duration, reindexChunk int) {
-	for range time.NewTicker(schedule).C {
-		err := r.reindex(ctx, reindexThreshold, reindexChunk)
-		if err != nil {
-			r.logger.Error("failed to run reindex job", slog.String("error", err.Error()))
-		}
-	}
-}
-
 func (r *semdexer) reindex(ctx context.Context, reindexThreshold time.Duration, reindexChunk int) error {
 	updated, deleted, err := r.gatherTargets(ctx, reindexThreshold, reindexChunk)
 	if err != nil {
@@ -5,12 +5,17 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/rs/xid"
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
 	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/services/scheduler"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/semdex/index_job"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/ent"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
@@ -26,7 +31,7 @@ func Build() fx.Option {
 // with duplicate messages since there's no checksum mechanism built currently.
 // TODO: Make these parameters configurable by the SD instance administrator.
 var (
-	DefaultReindexSchedule  = time.Hour      // how frequently do we reindex
+	DefaultReindexSchedule  = "@hourly"      // how frequently do we reindex
 	DefaultReindexThreshold = time.Hour * 24 // ignore indexed_at after this
 	DefaultReindexChunk     = 100            // size of query per reindex
 )
@@ -38,13 +43,12 @@ type semdexer struct {
 	threadWriter  *thread_writer.Writer
 	semdexMutator semdex.Mutator
 	semdexQuerier semdex.Querier
-	bus           *pubsub.Bus
 }
 
 func newSemdexer(
-	ctx context.Context,
 	lc fx.Lifecycle,
 	cfg config.Config,
+	sched *scheduler.Scheduler,
 	logger *slog.Logger,
 
 	db *ent.Client,
@@ -53,6 +57,7 @@ func newSemdexer(
 	semdexMutator semdex.Mutator,
 	semdexQuerier semdex.Querier,
 	bus *pubsub.Bus,
+	queue *index_job.Queuer,
 ) {
 	if cfg.SemdexProvider == "" {
 		return
@@ -65,33 +70,32 @@ func newSemdexer(
 		threadWriter:  threadWriter,
 		semdexMutator: semdexMutator,
 		semdexQuerier: semdexQuerier,
-		bus:           bus,
 	}
 
 	lc.Append(fx.StartHook(func(hctx context.Context) error {
 		_, err := pubsub.Subscribe(hctx, bus, "thread_semdex.index_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
-			return bus.SendCommand(ctx, &message.CommandThreadIndex{ID: evt.ID})
+			return queue.Index(ctx, datagraph.KindThread, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "thread_semdex.update_indexed", func(ctx context.Context, evt *message.EventThreadUpdated) error {
-			return bus.SendCommand(ctx, &message.CommandThreadIndex{ID: evt.ID})
+			return queue.Index(ctx, datagraph.KindThread, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "thread_semdex.remove_unpublished", func(ctx context.Context, evt *message.EventThreadUnpublished) error {
-			return bus.SendCommand(ctx, &message.CommandThreadDeindex{ID: evt.ID})
+			return queue.Deindex(ctx, datagraph.KindThread, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
 		}
 
 		_, err = pubsub.Subscribe(hctx, bus, "thread_semdex.remove_deleted", func(ctx context.Context, evt *message.EventThreadDeleted) error {
-			return bus.SendCommand(ctx, &message.CommandThreadDeindex{ID: evt.ID})
+			return queue.Deindex(ctx, datagraph.KindThread, xid.ID(evt.ID))
 		})
 		if err != nil {
 			return err
@@ -100,33 +104,12 @@ func newSemdexer(
 		return nil
 	}))
 
-	lc.Append(fx.StartHook(func(hctx context.Context) error {
-		_, err := pubsub.SubscribeCommand(hctx, bus, "thread_semdex.index", func(ctx context.Context, cmd *message.CommandThreadIndex) error {
-			return re.indexThread(ctx, cmd.ID)
-		})
-		if err != nil {
-			return err
-		}
-
-		_, err = pubsub.SubscribeCommand(hctx, bus, "thread_semdex.deindex", func(ctx context.Context, cmd *message.CommandThreadDeindex) error {
-			return re.deindexThread(ctx, cmd.ID)
-		})
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}))
+	queue.Handle(datagraph.KindThread,
+		func(ctx context.Context, id xid.ID) error { return re.indexThread(ctx, post.ID(id)) },
+		func(ctx context.Context, id xid.ID) error { return re.deindexThread(ctx, post.ID(id)) },
+	)
 
-	lc.Append(fx.StartHook(func(hctx context.Context) error {
-		go func() {
-			time.Sleep(time.Second * 10)
-			err := re.reindex(hctx, DefaultReindexThreshold, DefaultReindexChunk)
-			if err != nil {
-				re.logger.Error("failed to run initial reindex job", slog.String("error", err.Error()))
-			}
-		}()
-		go re.schedule(ctx, DefaultReindexSchedule, DefaultReindexThreshold, DefaultReindexChunk)
-		return nil
-	}))
+	sched.Register("thread_reindex", DefaultReindexSchedule, func(ctx context.Context) error {
+		return re.reindex(ctx, DefaultReindexThreshold, DefaultReindexChunk)
+	})
 }
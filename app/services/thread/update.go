@@ -22,9 +22,18 @@ import (
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/link/fetcher"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_engine"
+	"github.com/Southclaws/storyden/app/services/moderation/spam"
 )
 
 func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial) (*thread.Thread, error) {
+	filtered, err := s.cpm.FilterPost(ctx, partial.Title, partial.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	partial.Title = filtered.Title
+	partial.Content = filtered.Content
+
 	if content, ok := partial.Content.Get(); ok {
 		if err := s.cpm.CheckContent(ctx, content); err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
@@ -50,29 +59,80 @@ func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial)
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if err := s.authoriseCategoryUpdate(ctx, acc.Roles.Roles(), thr, partial); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	oldVisibility := thr.Visibility
 	opts := partial.Opts()
 
+	// Authors publishing their own thread go through automod, moderators
+	// publishing a thread held for review do not.
+	var verdict *automod_engine.Verdict
+	publishing := partial.Visibility.OrZero() == visibility.VisibilityPublished && oldVisibility != visibility.VisibilityPublished
+	if publishing && thr.Author.ID == acc.ID {
+		verdict, err = s.automod.Evaluate(ctx, acc.ID, partial.Content.Or(thr.Content))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		held, err := s.postQueue.Required(ctx, acc.ID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		screened, err := s.spamScreen.Screen(ctx, acc.ID, spam.SubmissionThread, partial.Title.Or(thr.Title), partial.Content.Or(thr.Content))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if score, ok := screened.Score.Get(); ok {
+			opts = append(opts, thread_writer.WithSpamScore(score))
+		}
+
+		if held || verdict.Hidden() || screened.Held || filtered.Review {
+			opts = append(opts, thread_writer.WithVisibility(visibility.VisibilityReview))
+		}
+	}
+
 	if tags, ok := partial.Tags.Get(); ok {
+		tags, err := s.tagWriter.Resolve(ctx, tags...)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
 		currentTagNames := thr.Tags.Names()
 
 		toCreate, toRemove := lo.Difference(tags, currentTagNames)
 
-		newTags, err := s.tagWriter.Add(ctx, toCreate...)
+		removing := dt.Filter(thr.Tags, func(t *tag_ref.Tag) bool { return lo.Contains(toRemove, t.Name) })
+		removable, err := s.tagging.Removable(ctx, acc.ID, removing)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		// Restricted tags the member can't remove stay on the thread.
+		kept, _ := lo.Difference(removing, removable)
+		tags = append(tags, tag_ref.Tags(kept).Names()...)
+
+		if err := s.checkRequiredTags(ctx, thr, partial, tags); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		newTags, err := s.tagging.Apply(ctx, acc.ID, toCreate)
 		if err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
 		}
 
 		addIDs := dt.Map(newTags, func(t *tag_ref.Tag) tag_ref.ID { return t.ID })
-		removeIDs := dt.Reduce(thr.Tags, func(acc []tag_ref.ID, prev *tag_ref.Tag) []tag_ref.ID {
-			if lo.Contains(toRemove, prev.Name) {
-				acc = append(acc, prev.ID)
-			}
-			return acc
-		}, []tag_ref.ID{})
+		removeIDs := dt.Map(removable, func(t *tag_ref.Tag) tag_ref.ID { return t.ID })
 
 		opts = append(opts, thread_writer.WithTagsAdd(addIDs...))
 		opts = append(opts, thread_writer.WithTagsRemove(removeIDs...))
+	} else if partial.Category.Ok() {
+		if err := s.checkRequiredTags(ctx, thr, partial, thr.Tags.Names()); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
 	}
 
 	if u, ok := partial.URL.Get(); ok {
@@ -87,6 +147,10 @@ func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial)
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if verdict != nil {
+		s.automod.Record(ctx, thr, acc.ID, verdict)
+	}
+
 	// Always emit a general update event
 	s.bus.Publish(ctx, &message.EventThreadUpdated{
 		ID: thr.ID,
@@ -103,6 +167,14 @@ func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial)
 				ID: thr.ID,
 			})
 		}
+
+		if thr.Visibility == visibility.VisibilityReview {
+			s.bus.Publish(ctx, &message.EventPostHeldForReview{
+				ThreadID: thr.ID,
+				PostID:   thr.ID,
+				AuthorID: thr.Author.ID,
+			})
+		}
 	}
 
 	return thr, nil
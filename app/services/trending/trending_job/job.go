@@ -0,0 +1,85 @@
+package trending_job
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/trending"
+	"github.com/Southclaws/storyden/app/resources/trending/trending_querier"
+	"github.com/Southclaws/storyden/app/resources/trending/trending_writer"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+)
+
+func Build() fx.Option {
+	return fx.Invoke(newTrendingJob)
+}
+
+// TODO: Make these parameters configurable by the SD instance administrator.
+var (
+	DefaultSchedule = "*/15 * * * *" // how frequently scores are recomputed
+	DefaultMaxItems = 500            // scores kept per window
+)
+
+type trendingJob struct {
+	logger          *slog.Logger
+	trendingQuerier *trending_querier.Querier
+	trendingWriter  *trending_writer.Writer
+}
+
+func newTrendingJob(
+	sched *scheduler.Scheduler,
+	logger *slog.Logger,
+	trendingQuerier *trending_querier.Querier,
+	trendingWriter *trending_writer.Writer,
+) {
+	j := &trendingJob{
+		logger:          logger,
+		trendingQuerier: trendingQuerier,
+		trendingWriter:  trendingWriter,
+	}
+
+	sched.Register("trending", DefaultSchedule, j.run)
+}
+
+func (j *trendingJob) run(ctx context.Context) error {
+	for _, w := range trending.Windows {
+		if err := j.compute(ctx, w, time.Now()); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (j *trendingJob) compute(ctx context.Context, window trending.Window, now time.Time) error {
+	since := now.Add(-window.Duration())
+
+	threads, err := j.trendingQuerier.ThreadSignals(ctx, since)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := j.trendingQuerier.NodeSignals(ctx, since)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	scores := score(now, window, append(threads, nodes...), DefaultMaxItems)
+
+	err = j.trendingWriter.Replace(ctx, window, scores)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	j.logger.Debug("computed trending scores",
+		slog.String("window", window.String()),
+		slog.Int("items", len(scores)),
+	)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package trending_job
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/trending"
+)
+
+var weights = map[trending.SignalType]float64{
+	trending.SignalTypeView:    1,
+	trending.SignalTypeReply:   3,
+	trending.SignalTypeReact:   2,
+	trending.SignalTypeLike:    2,
+	trending.SignalTypeCollect: 4,
+}
+
+type itemKey struct {
+	kind datagraph.Kind
+	id   xid.ID
+}
+
+// score sums the weight of every signal, exponentially decayed by its age
+// relative to the window's half-life, and returns the top scoring items.
+func score(now time.Time, window trending.Window, signals []*trending.Signal, limit int) trending.Scores {
+	halfLife := window.HalfLife().Seconds()
+
+	totals := map[itemKey]float64{}
+	for _, s := range signals {
+		age := math.Max(0, now.Sub(s.At).Seconds())
+		decay := math.Pow(0.5, age/halfLife)
+		totals[itemKey{s.Kind, s.ItemID}] += weights[s.Type] * decay
+	}
+
+	scores := make(trending.Scores, 0, len(totals))
+	for k, v := range totals {
+		scores = append(scores, &trending.Score{
+			Kind:       k.kind,
+			ItemID:     k.id,
+			Score:      v,
+			ComputedAt: now,
+		})
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score == scores[j].Score {
+			return scores[i].ItemID.Compare(scores[j].ItemID) > 0
+		}
+		return scores[i].Score > scores[j].Score
+	})
+
+	if len(scores) > limit {
+		scores = scores[:limit]
+	}
+
+	return scores
+}
@@ -0,0 +1,50 @@
+package trending_job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/trending"
+)
+
+func TestScore(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	now := time.Now()
+	halfLife := trending.WindowDay.HalfLife()
+
+	fresh := xid.New()
+	stale := xid.New()
+	node := xid.New()
+
+	signals := []*trending.Signal{
+		{Kind: datagraph.KindThread, ItemID: fresh, Type: trending.SignalTypeReply, At: now},
+		{Kind: datagraph.KindThread, ItemID: fresh, Type: trending.SignalTypeView, At: now},
+		{Kind: datagraph.KindThread, ItemID: stale, Type: trending.SignalTypeReply, At: now.Add(-halfLife)},
+		{Kind: datagraph.KindThread, ItemID: stale, Type: trending.SignalTypeView, At: now.Add(-halfLife)},
+		{Kind: datagraph.KindNode, ItemID: node, Type: trending.SignalTypeCollect, At: now.Add(-2 * halfLife)},
+	}
+
+	scores := score(now, trending.WindowDay, signals, 10)
+	r.Len(scores, 3)
+
+	a.Equal(fresh, scores[0].ItemID)
+	a.InDelta(4.0, scores[0].Score, 0.0001)
+
+	a.Equal(stale, scores[1].ItemID)
+	a.InDelta(2.0, scores[1].Score, 0.0001)
+
+	a.Equal(node, scores[2].ItemID)
+	a.Equal(datagraph.KindNode, scores[2].Kind)
+	a.InDelta(1.0, scores[2].Score, 0.0001)
+
+	limited := score(now, trending.WindowDay, signals, 1)
+	r.Len(limited, 1)
+	a.Equal(fresh, limited[0].ItemID)
+}
@@ -0,0 +1,202 @@
+// Package view_counter counts views of threads and library pages in memory and
+// periodically adds them to the stored hourly and daily totals.
+//
+// Each viewer is only counted once per hour and once per day. They're told apart
+// by a keyed hash of their account, or of their address and browser when they
+// aren't signed in. The key is random, never stored and replaced at the start of
+// every day, so hashes can't be reversed or linked across days, and the hashes
+// themselves are forgotten when their day ends. Addresses are never stored.
+package view_counter
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/content_view"
+)
+
+// DefaultFlushInterval is how often counts are written to the database, which
+// is also roughly how far behind view statistics may be.
+var DefaultFlushInterval = time.Minute
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type bucketKey struct {
+	period content_view.Period
+	bucket int64
+	item   xid.ID
+}
+
+type viewerKey struct {
+	bucketKey
+	viewer [16]byte
+}
+
+type Counter struct {
+	logger *slog.Logger
+	repo   *content_view.Repository
+
+	mu      sync.Mutex
+	day     time.Time
+	key     []byte
+	seen    map[viewerKey]struct{}
+	pending map[bucketKey]*content_view.Counts
+}
+
+func New(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	repo *content_view.Repository,
+) *Counter {
+	c := &Counter{
+		logger:  logger,
+		repo:    repo,
+		seen:    map[viewerKey]struct{}{},
+		pending: map[bucketKey]*content_view.Counts{},
+	}
+
+	wctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				c.run(wctx)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			<-done
+
+			// Anything counted since the last flush would otherwise be lost.
+			return c.Flush(ctx)
+		},
+	})
+
+	return c
+}
+
+// Viewer identifies who made a view, by their account when they're signed in
+// or otherwise by their address and browser. It's only ever hashed.
+func Viewer(accountID opt.Optional[account.AccountID], address, userAgent string) string {
+	if id, ok := accountID.Get(); ok {
+		return "account:" + id.String()
+	}
+	return "address:" + address + "\x00" + userAgent
+}
+
+// Record counts a view of a thread or library page. Views of anything else are
+// dropped when they're flushed.
+func (c *Counter) Record(item xid.ID, viewer string, at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rotate(at)
+
+	v := c.hash(viewer)
+
+	for _, p := range content_view.Periods {
+		k := bucketKey{period: p, bucket: p.Truncate(at).Unix(), item: item}
+
+		counts, ok := c.pending[k]
+		if !ok {
+			counts = &content_view.Counts{}
+			c.pending[k] = counts
+		}
+		counts.Views++
+
+		if _, ok := c.seen[viewerKey{k, v}]; !ok {
+			c.seen[viewerKey{k, v}] = struct{}{}
+			counts.UniqueViewers++
+		}
+	}
+}
+
+// rotate replaces the key and forgets every viewer when a new day starts.
+func (c *Counter) rotate(at time.Time) {
+	day := content_view.PeriodDay.Truncate(at)
+	if c.key != nil && !day.After(c.day) {
+		return
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+
+	c.day = day
+	c.key = key
+	c.seen = map[viewerKey]struct{}{}
+}
+
+func (c *Counter) hash(viewer string) [16]byte {
+	m := hmac.New(sha256.New, c.key)
+	m.Write([]byte(viewer))
+
+	var out [16]byte
+	copy(out[:], m.Sum(nil))
+	return out
+}
+
+// Flush writes every pending count to the database. Counts which fail to write
+// are dropped rather than retried, views are only an estimate and keeping them
+// could grow without bound while the database is unavailable.
+func (c *Counter) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = map[bucketKey]*content_view.Counts{}
+	c.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	entries := make([]content_view.Entry, 0, len(pending))
+	for k, counts := range pending {
+		entries = append(entries, content_view.Entry{
+			Period: k.period,
+			Bucket: time.Unix(k.bucket, 0).UTC(),
+			ItemID: k.item,
+			Counts: *counts,
+		})
+	}
+
+	if err := c.repo.Add(ctx, entries); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (c *Counter) run(ctx context.Context) {
+	ticker := time.NewTicker(DefaultFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				c.logger.Error("failed to flush content views", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package view_counter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/content_view"
+)
+
+func newCounter() *Counter {
+	return &Counter{
+		seen:    map[viewerKey]struct{}{},
+		pending: map[bucketKey]*content_view.Counts{},
+	}
+}
+
+func counts(c *Counter, p content_view.Period, at time.Time, item xid.ID) content_view.Counts {
+	v, ok := c.pending[bucketKey{period: p, bucket: p.Truncate(at).Unix(), item: item}]
+	if !ok {
+		return content_view.Counts{}
+	}
+	return *v
+}
+
+func TestRecord(t *testing.T) {
+	a := assert.New(t)
+
+	morning := time.Date(2025, 3, 10, 9, 15, 0, 0, time.UTC)
+	afternoon := morning.Add(5 * time.Hour)
+	tomorrow := morning.AddDate(0, 0, 1)
+
+	item := xid.New()
+	other := xid.New()
+
+	member := Viewer(opt.New(account.AccountID(xid.New())), "10.0.0.1", "Firefox")
+	guest := Viewer(opt.NewEmpty[account.AccountID](), "10.0.0.2", "Firefox")
+	guestOtherBrowser := Viewer(opt.NewEmpty[account.AccountID](), "10.0.0.2", "Safari")
+
+	t.Run("unique_per_hour_and_day", func(t *testing.T) {
+		c := newCounter()
+
+		c.Record(item, member, morning)
+		c.Record(item, member, morning.Add(time.Minute))
+		c.Record(item, guest, morning)
+		c.Record(item, guestOtherBrowser, morning)
+		c.Record(item, member, afternoon)
+		c.Record(other, member, morning)
+
+		a.Equal(content_view.Counts{Views: 4, UniqueViewers: 3}, counts(c, content_view.PeriodHour, morning, item))
+		a.Equal(content_view.Counts{Views: 1, UniqueViewers: 1}, counts(c, content_view.PeriodHour, afternoon, item))
+		a.Equal(content_view.Counts{Views: 5, UniqueViewers: 3}, counts(c, content_view.PeriodDay, morning, item))
+		a.Equal(content_view.Counts{Views: 1, UniqueViewers: 1}, counts(c, content_view.PeriodDay, morning, other))
+	})
+
+	t.Run("forgets_viewers_each_day", func(t *testing.T) {
+		c := newCounter()
+
+		c.Record(item, member, morning)
+		key := c.key
+		a.Len(c.seen, 2)
+
+		c.Record(item, member, tomorrow)
+
+		a.NotEqual(key, c.key)
+		a.Len(c.seen, 2, "only the new day's viewer is remembered")
+		a.Equal(content_view.Counts{Views: 1, UniqueViewers: 1}, counts(c, content_view.PeriodDay, tomorrow, item))
+	})
+
+	t.Run("viewer_is_never_stored", func(t *testing.T) {
+		c := newCounter()
+
+		c.Record(item, guest, morning)
+
+		for k := range c.seen {
+			a.NotContains(string(k.viewer[:]), "10.0.0.2")
+		}
+	})
+}
@@ -0,0 +1,267 @@
+package webhook_dispatch
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_delivery"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_feed"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_querier"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_writer"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
+)
+
+const (
+	HeaderEvent     = "X-Storyden-Event"
+	HeaderDelivery  = "X-Storyden-Delivery"
+	HeaderSignature = "X-Storyden-Signature"
+)
+
+var (
+	DefaultMaxAttempts   = 8
+	DefaultBackoff       = 30 * time.Second
+	DefaultMaxBackoff    = 6 * time.Hour
+	DefaultRetrySchedule = 30 * time.Second
+	requestTimeout       = 15 * time.Second
+	maxErrorBody         = 1024
+)
+
+// Sign returns the signature header value for a request body sent at the given
+// time. Receivers recompute the HMAC-SHA256 of "<t>.<body>" using the webhook's
+// secret and compare it to v1, rejecting old timestamps to prevent replays.
+func Sign(secret string, at time.Time, body []byte) string {
+	ts := strconv.FormatInt(at.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// backoff doubles the wait after each failed attempt up to a ceiling.
+func backoff(attempts int) time.Duration {
+	d := DefaultBackoff
+	for i := 1; i < attempts && d < DefaultMaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, DefaultMaxBackoff)
+}
+
+type sender struct {
+	logger         *slog.Logger
+	client         *http.Client
+	accountQuerier *account_querier.Querier
+	webhookQuerier *webhook_querier.Querier
+	webhookWriter  *webhook_writer.Writer
+	deliveries     *webhook_delivery.Repository
+	feed           *webhook_feed.Querier
+}
+
+func newSender(
+	logger *slog.Logger,
+	policy *safehttp.Policy,
+	accountQuerier *account_querier.Querier,
+	webhookQuerier *webhook_querier.Querier,
+	webhookWriter *webhook_writer.Writer,
+	deliveries *webhook_delivery.Repository,
+	feed *webhook_feed.Querier,
+) *sender {
+	return &sender{
+		logger:         logger,
+		client:         policy.Client(requestTimeout),
+		accountQuerier: accountQuerier,
+		webhookQuerier: webhookQuerier,
+		webhookWriter:  webhookWriter,
+		deliveries:     deliveries,
+		feed:           feed,
+	}
+}
+
+func runDeliveryWorker(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	s *sender,
+) {
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.SubscribeCommand(hctx, bus, "webhook.deliver", func(ctx context.Context, cmd *message.CommandDeliverWebhook) error {
+			// Failures are recorded on the delivery and retried on a schedule
+			// rather than by redelivering the command.
+			if err := s.deliver(ctx, cmd.ID); err != nil {
+				logger.Error("failed to deliver webhook", slog.String("delivery_id", cmd.ID.String()), slog.String("error", err.Error()))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			for range time.NewTicker(DefaultRetrySchedule).C {
+				ids, err := s.deliveries.ClaimDue(ctx, time.Now(), 100)
+				if err != nil {
+					logger.Error("failed to claim webhook retries", slog.String("error", err.Error()))
+					continue
+				}
+
+				for _, id := range ids {
+					if err := bus.SendCommand(ctx, &message.CommandDeliverWebhook{ID: id}); err != nil {
+						logger.Error("failed to queue webhook retry", slog.String("delivery_id", id.String()), slog.String("error", err.Error()))
+					}
+				}
+			}
+		}()
+
+		return nil
+	}))
+}
+
+func (s *sender) deliver(ctx context.Context, id webhook.DeliveryID) error {
+	d, err := s.deliveries.Get(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if d.Status != webhook.StatusPending {
+		return nil
+	}
+
+	w, err := s.webhookQuerier.Get(ctx, d.WebhookID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	now := time.Now()
+
+	// Subscribers may have lost access since subscribing, or the thread may be
+	// somewhere they can't read, so REST hooks are checked on every delivery.
+	if owner, ok := w.Owner.Get(); ok {
+		permitted, err := s.permitted(ctx, owner, d)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if !permitted {
+			attempt := webhook_delivery.Attempt{Error: opt.New("subscriber is not permitted to receive this event")}
+			if _, err := s.deliveries.RecordAttempt(ctx, id, now, attempt); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			return nil
+		}
+	}
+
+	status, sendErr := s.send(ctx, w, d, now)
+
+	// A REST hook subscriber responds with 410 Gone once the subscription is
+	// no longer wanted, such as when a Zap has been turned off.
+	if w.Owner.Ok() && status.OrZero() == http.StatusGone {
+		s.logger.Info("removing gone webhook subscription", slog.String("webhook_id", w.ID.String()))
+		if err := s.webhookWriter.Delete(ctx, w.ID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		return nil
+	}
+
+	attempt := webhook_delivery.Attempt{ResponseStatus: status}
+	if sendErr != nil {
+		attempt.Error = opt.New(sendErr.Error())
+		if d.Attempts+1 < DefaultMaxAttempts && w.Enabled {
+			attempt.NextAttemptAt = opt.New(now.Add(backoff(d.Attempts + 1)))
+		}
+	}
+
+	if _, err := s.deliveries.RecordAttempt(ctx, id, now, attempt); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *sender) permitted(ctx context.Context, owner account.AccountID, d *webhook.Delivery) (bool, error) {
+	perm, ok := SubscriberPermission(d.Event)
+	if !ok {
+		return false, nil
+	}
+
+	acc, err := s.accountQuerier.GetByID(ctx, owner)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return false, nil
+		}
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	roles := acc.Roles.Roles()
+	if err := roles.Permissions().Authorise(ctx, nil, perm); err != nil {
+		return false, nil
+	}
+
+	if d.Event != webhook.EventThreadCreated {
+		return true, nil
+	}
+
+	var p Payload
+	if err := json.Unmarshal([]byte(d.Payload), &p); err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threadID, err := xid.FromString(p.ID)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.feed.CanReadThread(ctx, threadID, owner, roles)
+}
+
+func (s *sender) send(ctx context.Context, w *webhook.Webhook, d *webhook.Delivery, at time.Time) (opt.Optional[int], error) {
+	body := []byte(d.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return opt.NewEmpty[int](), err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Storyden-Webhook")
+	req.Header.Set(HeaderEvent, d.Event.String())
+	req.Header.Set(HeaderDelivery, d.ID.String())
+	req.Header.Set(HeaderSignature, Sign(w.Secret, at, body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return opt.NewEmpty[int](), err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(res.Body, int64(maxErrorBody)))
+		return opt.New(res.StatusCode), fault.Newf("endpoint responded with %d: %s", res.StatusCode, bytes.TrimSpace(snippet))
+	}
+
+	return opt.New(res.StatusCode), nil
+}
@@ -0,0 +1,153 @@
+// Package webhook_dispatch turns activity on the instance into webhook
+// deliveries, sends them with a signature and retries failures with backoff.
+package webhook_dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_delivery"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_querier"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(newSender),
+		fx.Invoke(runDispatcher, runDeliveryWorker),
+	)
+}
+
+// Payload is the JSON body of every webhook request. ID is the identifier of
+// the thread, post, account or report the event is about, which automation
+// services use to tell deliveries apart from each other. Data carries
+// identifiers only, receivers should fetch anything else they need from the API.
+type Payload struct {
+	ID        string         `json:"id"`
+	Event     string         `json:"event"`
+	Timestamp time.Time      `json:"timestamp"`
+	Data      map[string]any `json:"data"`
+}
+
+// subscriberPermissions lists the events available to REST hooks along with
+// what the subscriber must be allowed to do to receive them.
+var subscriberPermissions = map[webhook.Event]rbac.Permission{
+	webhook.EventThreadCreated:  rbac.PermissionReadPublishedThreads,
+	webhook.EventAccountCreated: rbac.PermissionListProfiles,
+	webhook.EventReportFiled:    rbac.PermissionManageReports,
+}
+
+// SubscriberPermission returns the permission an account needs to receive an
+// event through a REST hook, events which aren't listed can't be subscribed to.
+func SubscriberPermission(e webhook.Event) (rbac.Permission, bool) {
+	p, ok := subscriberPermissions[e]
+	return p, ok
+}
+
+type dispatcher struct {
+	logger         *slog.Logger
+	bus            *pubsub.Bus
+	webhookQuerier *webhook_querier.Querier
+	deliveries     *webhook_delivery.Repository
+}
+
+func runDispatcher(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	webhookQuerier *webhook_querier.Querier,
+	deliveries *webhook_delivery.Repository,
+) {
+	d := &dispatcher{
+		logger:         logger,
+		bus:            bus,
+		webhookQuerier: webhookQuerier,
+		deliveries:     deliveries,
+	}
+
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		if _, err := pubsub.Subscribe(hctx, bus, "webhook.thread_published", func(ctx context.Context, evt *message.EventThreadPublished) error {
+			return d.dispatch(ctx, webhook.EventThreadCreated, evt.ID.String(), map[string]any{
+				"thread_id": evt.ID.String(),
+			})
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "webhook.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+			return d.dispatch(ctx, webhook.EventPostCreated, evt.ReplyID.String(), map[string]any{
+				"thread_id": evt.ThreadID.String(),
+				"post_id":   evt.ReplyID.String(),
+				"author_id": evt.ReplyAuthorID.String(),
+			})
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "webhook.account_created", func(ctx context.Context, evt *message.EventAccountCreated) error {
+			return d.dispatch(ctx, webhook.EventAccountCreated, evt.ID.String(), map[string]any{
+				"account_id": evt.ID.String(),
+			})
+		}); err != nil {
+			return err
+		}
+
+		if _, err := pubsub.Subscribe(hctx, bus, "webhook.report_created", func(ctx context.Context, evt *message.EventReportCreated) error {
+			data := map[string]any{
+				"report_id":   evt.ID.String(),
+				"reported_by": evt.ReportedBy.String(),
+			}
+			if evt.Target != nil {
+				data["target_kind"] = evt.Target.Kind.String()
+				data["target_id"] = evt.Target.ID.String()
+			}
+			return d.dispatch(ctx, webhook.EventReportFiled, evt.ID.String(), data)
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	}))
+}
+
+func (d *dispatcher) dispatch(ctx context.Context, event webhook.Event, id string, data map[string]any) error {
+	hooks, err := d.webhookQuerier.ListSubscribed(ctx, event)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(Payload{
+		ID:        id,
+		Event:     event.String(),
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, h := range hooks {
+		delivery, err := d.deliveries.Create(ctx, h.ID, event, string(body))
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := d.bus.SendCommand(ctx, &message.CommandDeliverWebhook{ID: delivery.ID}); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package webhook_manager
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_delivery"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_querier"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_writer"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
+)
+
+const deliveryLogLimit = 100
+
+type Manager struct {
+	webhookQuerier *webhook_querier.Querier
+	webhookWriter  *webhook_writer.Writer
+	deliveries     *webhook_delivery.Repository
+	bus            *pubsub.Bus
+	policy         *safehttp.Policy
+}
+
+func New(
+	webhookQuerier *webhook_querier.Querier,
+	webhookWriter *webhook_writer.Writer,
+	deliveries *webhook_delivery.Repository,
+	bus *pubsub.Bus,
+	policy *safehttp.Policy,
+) *Manager {
+	return &Manager{
+		webhookQuerier: webhookQuerier,
+		webhookWriter:  webhookWriter,
+		deliveries:     deliveries,
+		bus:            bus,
+		policy:         policy,
+	}
+}
+
+type Partial struct {
+	Name         opt.Optional[string]
+	URL          opt.Optional[string]
+	Events       opt.Optional[[]webhook.Event]
+	Enabled      opt.Optional[bool]
+	RotateSecret bool
+}
+
+func (m *Manager) List(ctx context.Context) (webhook.Webhooks, error) {
+	return m.webhookQuerier.List(ctx)
+}
+
+func (m *Manager) Get(ctx context.Context, id webhook.WebhookID) (*webhook.Webhook, error) {
+	return m.webhookQuerier.Get(ctx, id)
+}
+
+// Create registers a new webhook with a freshly generated signing secret. The
+// secret is only ever returned by this call and when it's rotated.
+func (m *Manager) Create(ctx context.Context, name string, rawURL string, events []webhook.Event, enabled opt.Optional[bool], opts ...webhook_writer.Option) (*webhook.Webhook, error) {
+	if err := m.validateURL(ctx, rawURL); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	enabled.Call(func(v bool) { opts = append(opts, webhook_writer.WithEnabled(v)) })
+
+	w, err := m.webhookWriter.Create(ctx, name, rawURL, secret, events, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w, nil
+}
+
+func (m *Manager) Update(ctx context.Context, id webhook.WebhookID, p Partial) (*webhook.Webhook, error) {
+	opts := []webhook_writer.Option{}
+
+	if v, ok := p.URL.Get(); ok {
+		if err := m.validateURL(ctx, v); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		opts = append(opts, webhook_writer.WithURL(v))
+	}
+
+	p.Name.Call(func(v string) { opts = append(opts, webhook_writer.WithName(v)) })
+	p.Events.Call(func(v []webhook.Event) { opts = append(opts, webhook_writer.WithEvents(v)) })
+	p.Enabled.Call(func(v bool) { opts = append(opts, webhook_writer.WithEnabled(v)) })
+
+	if p.RotateSecret {
+		secret, err := newSecret()
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		opts = append(opts, webhook_writer.WithSecret(secret))
+	}
+
+	w, err := m.webhookWriter.Update(ctx, id, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, id webhook.WebhookID) error {
+	if err := m.webhookWriter.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) ListDeliveries(ctx context.Context, id webhook.WebhookID) (webhook.Deliveries, error) {
+	if _, err := m.webhookQuerier.Get(ctx, id); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.deliveries.List(ctx, id, deliveryLogLimit)
+}
+
+// Redeliver sends a previous delivery's payload again as a new delivery, the
+// original is kept in the log unchanged.
+func (m *Manager) Redeliver(ctx context.Context, id webhook.WebhookID, deliveryID webhook.DeliveryID) (*webhook.Delivery, error) {
+	original, err := m.deliveries.Get(ctx, deliveryID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if original.WebhookID != id {
+		return nil, fault.New("delivery does not belong to webhook", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	d, err := m.deliveries.Create(ctx, id, original.Event, original.Payload)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.bus.SendCommand(ctx, &message.CommandDeliverWebhook{ID: d.ID}); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d, nil
+}
+
+func (m *Manager) validateURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fault.New("webhook url must be an absolute http or https URL",
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid url", "The webhook URL must be an absolute http:// or https:// address."),
+		)
+	}
+
+	if err := m.policy.CheckURL(ctx, u); err != nil {
+		return fault.Wrap(err,
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("forbidden address", "The webhook URL must point at a public address."),
+		)
+	}
+
+	return nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fault.Wrap(err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,172 @@
+// Package webhook_subscription implements REST hooks, the subscription model
+// used by automation services such as Zapier and Make. A service subscribes a
+// target URL to an event on behalf of a member and unsubscribes it when the
+// automation is turned off, polling and sample payloads let the service show
+// example data while the automation is being set up.
+package webhook_subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_feed"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_querier"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_dispatch"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_manager"
+)
+
+const pollLimit = 50
+
+var ErrEventNotSubscribable = fault.New("event cannot be subscribed to", ftag.With(ftag.InvalidArgument))
+
+type Manager struct {
+	webhookManager *webhook_manager.Manager
+	webhookQuerier *webhook_querier.Querier
+	webhookWriter  *webhook_writer.Writer
+	feed           *webhook_feed.Querier
+}
+
+func New(
+	webhookManager *webhook_manager.Manager,
+	webhookQuerier *webhook_querier.Querier,
+	webhookWriter *webhook_writer.Writer,
+	feed *webhook_feed.Querier,
+) *Manager {
+	return &Manager{
+		webhookManager: webhookManager,
+		webhookQuerier: webhookQuerier,
+		webhookWriter:  webhookWriter,
+		feed:           feed,
+	}
+}
+
+// List returns the subscriptions made by the session's account.
+func (m *Manager) List(ctx context.Context) (webhook.Webhooks, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.webhookQuerier.ListByOwner(ctx, accountID)
+}
+
+func (m *Manager) Subscribe(ctx context.Context, event webhook.Event, targetURL string) (*webhook.Webhook, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := authorise(ctx, event); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	w, err := m.webhookManager.Create(ctx, "REST hook: "+event.String(), targetURL, []webhook.Event{event}, opt.New(true), webhook_writer.WithOwner(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return w, nil
+}
+
+// Unsubscribe removes one of the session account's subscriptions. Other
+// webhooks are reported as not found, administrators manage those directly.
+func (m *Manager) Unsubscribe(ctx context.Context, id webhook.WebhookID) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	w, err := m.webhookQuerier.Get(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if owner, ok := w.Owner.Get(); !ok || owner != accountID {
+		return fault.New("subscription not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	if err := m.webhookWriter.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Poll returns the latest occurrences of an event in the same shape as the
+// payloads delivered to subscribers, newest first.
+func (m *Manager) Poll(ctx context.Context, event webhook.Event) ([]webhook_dispatch.Payload, error) {
+	if err := authorise(ctx, event); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := m.feed.Recent(ctx, event, pollLimit, accountID, session.GetRoles(ctx))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(items, func(i *webhook_feed.Item) webhook_dispatch.Payload {
+		return webhook_dispatch.Payload{
+			ID:        i.ID.String(),
+			Event:     event.String(),
+			Timestamp: i.CreatedAt.UTC(),
+			Data:      i.Data,
+		}
+	}), nil
+}
+
+// Sample returns an example payload for an event made up of placeholder
+// identifiers, for setting up automations before the event has ever occurred.
+func (m *Manager) Sample(ctx context.Context, event webhook.Event) (*webhook_dispatch.Payload, error) {
+	if err := authorise(ctx, event); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	id := xid.New().String()
+	data := map[string]any{}
+
+	switch event {
+	case webhook.EventThreadCreated:
+		data["thread_id"] = id
+	case webhook.EventAccountCreated:
+		data["account_id"] = id
+	case webhook.EventReportFiled:
+		data["report_id"] = id
+		data["reported_by"] = xid.New().String()
+		data["target_kind"] = datagraph.KindPost.String()
+		data["target_id"] = xid.New().String()
+	}
+
+	return &webhook_dispatch.Payload{
+		ID:        id,
+		Event:     event.String(),
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	}, nil
+}
+
+func authorise(ctx context.Context, event webhook.Event) error {
+	perm, ok := webhook_dispatch.SubscriberPermission(event)
+	if !ok {
+		return fault.Wrap(ErrEventNotSubscribable, fctx.With(ctx),
+			fmsg.WithDesc("not subscribable", "Only new threads, members and reports are available to automation services."))
+	}
+
+	return session.Authorise(ctx, nil, perm)
+}
@@ -0,0 +1,119 @@
+// Package appservice implements the Matrix application service API, which the
+// homeserver calls to push the events in rooms the Matrix bridge's bot is in.
+package appservice
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/matrix_appservice"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
+)
+
+// Homeservers batch events into transactions which can get quite large.
+const maxTransactionSize = 8 << 20
+
+func Build() fx.Option {
+	return fx.Invoke(MountAppService)
+}
+
+type server struct {
+	token  string
+	bridge *matrix_appservice.Bridge
+}
+
+func MountAppService(
+	lc fx.Lifecycle,
+	bridge *matrix_appservice.Bridge,
+	mux *http.ServeMux,
+	lo *reqlog.Middleware,
+) {
+	if !bridge.Enabled() {
+		return
+	}
+
+	s := &server{
+		token:  bridge.HSToken(),
+		bridge: bridge,
+	}
+
+	lc.Append(fx.StartHook(func() error {
+		routes := http.NewServeMux()
+
+		routes.HandleFunc("PUT /_matrix/app/v1/transactions/{txnId}", s.transaction)
+		routes.HandleFunc("POST /_matrix/app/v1/ping", s.ping)
+
+		// The bridge doesn't provision users or room aliases on demand.
+		routes.HandleFunc("GET /_matrix/app/v1/users/{userId}", s.notFound)
+		routes.HandleFunc("GET /_matrix/app/v1/rooms/{roomAlias}", s.notFound)
+
+		mux.Handle("/_matrix/app/", httpserver.Apply(s.authenticate(routes),
+			lo.WithLogger(),
+		))
+
+		return nil
+	}))
+}
+
+// authenticate checks the request was made by the homeserver using the
+// registration's hs_token. Older homeservers send it as a query parameter.
+func (s *server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			token = r.URL.Query().Get("access_token")
+		}
+
+		if token == "" {
+			writeError(w, http.StatusUnauthorized, "M_UNAUTHORIZED", "Missing homeserver token.")
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			writeError(w, http.StatusForbidden, "M_FORBIDDEN", "Invalid homeserver token.")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// transaction receives a batch of events. Homeservers retry a transaction
+// until it's acknowledged, the bridge ignores events it has already synced.
+func (s *server) transaction(w http.ResponseWriter, r *http.Request) {
+	var txn matrix_appservice.Transaction
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTransactionSize)).Decode(&txn); err != nil {
+		writeError(w, http.StatusBadRequest, "M_NOT_JSON", "Invalid transaction body.")
+		return
+	}
+
+	s.bridge.HandleTransaction(r.Context(), txn)
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (s *server) ping(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (s *server) notFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotFound, "M_NOT_FOUND", "Not provisioned by this application service.")
+}
+
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, map[string]string{
+		"errcode": code,
+		"error":   message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
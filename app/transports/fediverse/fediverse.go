@@ -0,0 +1,13 @@
+// Package fediverse serves the ActivityPub and WebFinger endpoints which allow
+// remote servers to discover, follow and read members and the instance.
+package fediverse
+
+import (
+	"go.uber.org/fx"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Invoke(MountActivityPub),
+	)
+}
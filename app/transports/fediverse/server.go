@@ -0,0 +1,255 @@
+package fediverse
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/federation"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/services/activitypub"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
+)
+
+const maxInboxSize = 1 << 20
+
+type server struct {
+	logger    *slog.Logger
+	directory *activitypub.Directory
+	inbox     *activitypub.Inbox
+}
+
+func MountActivityPub(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	cfg config.Config,
+
+	directory *activitypub.Directory,
+	inbox *activitypub.Inbox,
+
+	mux *http.ServeMux,
+
+	lo *reqlog.Middleware,
+	rl *limiter.Middleware,
+) {
+	if !cfg.ActivityPubEnabled {
+		return
+	}
+
+	s := &server{
+		logger:    logger,
+		directory: directory,
+		inbox:     inbox,
+	}
+
+	lc.Append(fx.StartHook(func() error {
+		routes := http.NewServeMux()
+
+		routes.HandleFunc("GET /.well-known/webfinger", s.webfinger)
+
+		routes.HandleFunc("GET /ap/instance", s.actor(instance))
+		routes.HandleFunc("GET /ap/instance/outbox", s.outbox(instance))
+		routes.HandleFunc("GET /ap/instance/followers", s.followers(instance))
+		routes.HandleFunc("POST /ap/instance/inbox", s.receive)
+
+		routes.HandleFunc("GET /ap/users/{id}", s.actor(member))
+		routes.HandleFunc("GET /ap/users/{id}/outbox", s.outbox(member))
+		routes.HandleFunc("GET /ap/users/{id}/followers", s.followers(member))
+		routes.HandleFunc("POST /ap/users/{id}/inbox", s.receive)
+
+		routes.HandleFunc("POST /ap/inbox", s.receive)
+
+		routes.HandleFunc("GET /ap/threads/{id}", s.thread)
+		routes.HandleFunc("GET /ap/nodes/{id}", s.node)
+
+		applied := httpserver.Apply(routes,
+			lo.WithLogger(),
+			rl.WithRequestSizeLimiter(),
+			rl.WithRateLimit(),
+		)
+
+		mux.Handle("/.well-known/webfinger", applied)
+		mux.Handle("/ap/", applied)
+
+		return nil
+	}))
+}
+
+type actorFromRequest func(r *http.Request) (federation.LocalActor, error)
+
+func instance(r *http.Request) (federation.LocalActor, error) {
+	return federation.InstanceActor, nil
+}
+
+func member(r *http.Request) (federation.LocalActor, error) {
+	id, err := xid.FromString(r.PathValue("id"))
+	if err != nil {
+		return "", fault.Wrap(err, ftag.With(ftag.NotFound))
+	}
+
+	return federation.AccountActor(account.AccountID(id)), nil
+}
+
+func (s *server) webfinger(w http.ResponseWriter, r *http.Request) {
+	wf, err := s.directory.WebFinger(r.Context(), r.URL.Query().Get("resource"))
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+
+	s.write(w, "application/jrd+json", wf)
+}
+
+func (s *server) actor(from actorFromRequest) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a, err := from(r)
+		if err != nil {
+			s.fail(w, r, err)
+			return
+		}
+
+		actor, err := s.directory.Actor(r.Context(), a)
+		if err != nil {
+			s.fail(w, r, err)
+			return
+		}
+
+		s.write(w, activitypub.ContentType, actor)
+	}
+}
+
+func (s *server) outbox(from actorFromRequest) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a, err := from(r)
+		if err != nil {
+			s.fail(w, r, err)
+			return
+		}
+
+		if _, err := s.directory.Actor(r.Context(), a); err != nil {
+			s.fail(w, r, err)
+			return
+		}
+
+		outbox, err := s.directory.Outbox(r.Context(), a)
+		if err != nil {
+			s.fail(w, r, err)
+			return
+		}
+
+		s.write(w, activitypub.ContentType, outbox)
+	}
+}
+
+func (s *server) followers(from actorFromRequest) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a, err := from(r)
+		if err != nil {
+			s.fail(w, r, err)
+			return
+		}
+
+		followers, err := s.directory.Followers(r.Context(), a)
+		if err != nil {
+			s.fail(w, r, err)
+			return
+		}
+
+		s.write(w, activitypub.ContentType, followers)
+	}
+}
+
+func (s *server) thread(w http.ResponseWriter, r *http.Request) {
+	id, err := xid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.fail(w, r, fault.Wrap(err, ftag.With(ftag.NotFound)))
+		return
+	}
+
+	o, err := s.directory.Thread(r.Context(), post.ID(id))
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+
+	o.Context = activitypub.ContextActivityStreams
+	s.write(w, activitypub.ContentType, o)
+}
+
+func (s *server) node(w http.ResponseWriter, r *http.Request) {
+	id, err := xid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.fail(w, r, fault.Wrap(err, ftag.With(ftag.NotFound)))
+		return
+	}
+
+	o, err := s.directory.Node(r.Context(), library.NodeID(id))
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+
+	o.Context = activitypub.ContextActivityStreams
+	s.write(w, activitypub.ContentType, o)
+}
+
+// receive handles all inboxes, the target actor is read from the activity.
+func (s *server) receive(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxInboxSize))
+	if err != nil {
+		s.fail(w, r, fault.Wrap(err, ftag.With(ftag.InvalidArgument)))
+		return
+	}
+
+	if err := s.inbox.Receive(r.Context(), r, body); err != nil {
+		s.fail(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *server) write(w http.ResponseWriter, contentType string, v any) {
+	w.Header().Set("Content-Type", contentType)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.logger.Error("failed to write activitypub response", slog.String("error", err.Error()))
+	}
+}
+
+func (s *server) fail(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	switch ftag.Get(err) {
+	case ftag.InvalidArgument:
+		status = http.StatusBadRequest
+	case ftag.NotFound:
+		status = http.StatusNotFound
+	case ftag.Unauthenticated:
+		status = http.StatusUnauthorized
+	case ftag.PermissionDenied:
+		status = http.StatusForbidden
+	}
+
+	if ent.IsNotFound(err) {
+		status = http.StatusNotFound
+	}
+
+	if status == http.StatusInternalServerError && r.Context().Err() == nil {
+		s.logger.Error("activitypub request failed",
+			slog.String("path", r.URL.Path),
+			slog.String("error", err.Error()))
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
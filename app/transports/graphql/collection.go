@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+type collectionResolver struct {
+	r *Resolver
+	c *collection.Collection
+
+	// items is only present when the collection was read individually, list
+	// results don't include items so they're read on demand.
+	items opt.Optional[collection.CollectionItems]
+}
+
+func (c *collectionResolver) ID() graphql.ID       { return toID(c.c.Mark.ID()) }
+func (c *collectionResolver) Slug() string         { return c.c.Mark.Slug() }
+func (c *collectionResolver) Name() string         { return c.c.Name }
+func (c *collectionResolver) Description() *string { return c.c.Description.Ptr() }
+func (c *collectionResolver) ItemCount() int32     { return int32(c.c.ItemCount) }
+func (c *collectionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: c.c.CreatedAt}
+}
+func (c *collectionResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: c.c.UpdatedAt}
+}
+
+func (c *collectionResolver) Owner(ctx context.Context) (*profileResolver, error) {
+	return loadProfile(ctx, c.r, c.c.Owner)
+}
+
+func (c *collectionResolver) Items(ctx context.Context) ([]*collectionItemResolver, error) {
+	items, ok := c.items.Get()
+	if !ok {
+		col, err := c.r.collectionReader.GetCollection(ctx, collection.NewID(c.c.Mark.ID()))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		items = col.Items
+	}
+
+	return dt.Map(items, func(i *collection.CollectionItem) *collectionItemResolver {
+		return &collectionItemResolver{i}
+	}), nil
+}
+
+type collectionItemResolver struct{ i *collection.CollectionItem }
+
+func (c *collectionItemResolver) AddedAt() graphql.Time  { return graphql.Time{Time: c.i.Added} }
+func (c *collectionItemResolver) MembershipType() string { return c.i.MembershipType.String() }
+func (c *collectionItemResolver) Item() *itemResolver    { return &itemResolver{c.i.Item} }
+
+type itemResolver struct{ i datagraph.Item }
+
+func (i *itemResolver) ID() graphql.ID      { return toID(i.i.GetID()) }
+func (i *itemResolver) Kind() string        { return i.i.GetKind().String() }
+func (i *itemResolver) Name() string        { return i.i.GetName() }
+func (i *itemResolver) Slug() string        { return i.i.GetSlug() }
+func (i *itemResolver) Description() string { return i.i.GetDesc() }
+func (i *itemResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: i.i.GetCreated()}
+}
+func (i *itemResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: i.i.GetUpdated()}
+}
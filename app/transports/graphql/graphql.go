@@ -0,0 +1,164 @@
+// Package graphql provides a read-only GraphQL API over threads, profiles,
+// library pages and collections. Resolvers call the same services as the REST
+// API so visibility rules are identical, and related profiles are batched with
+// dataloaders so nested queries don't issue a query per item.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	graphql "github.com/graph-gophers/graphql-go"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// DefaultMaxParallelism bounds how many fields of one query resolve at once.
+const DefaultMaxParallelism = 10
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(newResolver),
+		fx.Invoke(MountGraphQL),
+	)
+}
+
+func MountGraphQL(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	cfg config.Config,
+	resolver *Resolver,
+	profiles *profile_querier.Querier,
+	mux *http.ServeMux,
+
+	// NOTE: Duplicated from the OpenAPI router, see the MCP transport.
+	co *origin.Middleware,
+	lo *reqlog.Middleware,
+	cj *session_cookie.Jar,
+	rl *limiter.Middleware,
+) {
+	if !cfg.GraphQLEnabled {
+		return
+	}
+
+	lc.Append(fx.StartHook(func() error {
+		schema, err := graphql.ParseSchema(schemaSDL, resolver,
+			graphql.UseStringDescriptions(),
+			graphql.MaxDepth(cfg.GraphQLMaxDepth),
+			graphql.MaxParallelism(DefaultMaxParallelism),
+		)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+
+		h := &handler{
+			logger:   logger,
+			schema:   schema,
+			profiles: profiles,
+		}
+
+		mux.Handle("/graphql", httpserver.Apply(h,
+			co.WithCORS(),
+			lo.WithLogger(),
+			cj.WithAuth(),
+			rl.WithRequestSizeLimiter(),
+			rl.WithRateLimit(),
+		))
+
+		return nil
+	}))
+}
+
+type handler struct {
+	logger   *slog.Logger
+	schema   *graphql.Schema
+	profiles *profile_querier.Querier
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if v := r.URL.Query().Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				http.Error(w, "variables must be a JSON object", http.StatusBadRequest)
+				return
+			}
+		}
+
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "request body must be a JSON object with a query", http.StatusBadRequest)
+			return
+		}
+
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := withLoaders(r.Context(), newLoaders(h.profiles))
+
+	res := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	for _, qe := range res.Errors {
+		if qe.ResolverError == nil {
+			continue
+		}
+
+		kind := ftag.Get(qe.ResolverError)
+		qe.Extensions = map[string]any{"code": string(kind)}
+
+		if kind == ftag.Internal {
+			h.logger.Error(qe.ResolverError.Error(),
+				slog.String("package", "graphql"),
+				slog.Any("path", qe.Path),
+				slog.Any("metadata", fctx.Unwrap(qe.ResolverError)),
+			)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Error("failed to write graphql response", slog.String("error", err.Error()))
+	}
+}
+
+// found turns a not found error into an empty result, nullable fields resolve
+// to null for things which don't exist or can't be seen.
+func found[T any](ctx context.Context, v *T, err error) (*T, error) {
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound || ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return v, nil
+}
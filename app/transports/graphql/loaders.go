@@ -0,0 +1,87 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/graph-gophers/dataloader/v7"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+)
+
+var errProfileNotFound = fault.New("profile not found", ftag.With(ftag.NotFound))
+
+// DefaultLoaderWait is how long a loader collects keys before fetching them,
+// long enough for sibling fields resolving in parallel to join the batch.
+const DefaultLoaderWait = 2 * time.Millisecond
+
+// loaders batch lookups made while resolving a single request. They're created
+// per request so cached results never outlive it or leak between members.
+type loaders struct {
+	profiles *dataloader.Loader[account.AccountID, *profile.Public]
+}
+
+type loadersKey struct{}
+
+func newLoaders(pq *profile_querier.Querier) *loaders {
+	return &loaders{
+		profiles: dataloader.NewBatchedLoader(batchProfiles(pq),
+			dataloader.WithWait[account.AccountID, *profile.Public](DefaultLoaderWait),
+		),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func getLoaders(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func batchProfiles(pq *profile_querier.Querier) dataloader.BatchFunc[account.AccountID, *profile.Public] {
+	return func(ctx context.Context, ids []account.AccountID) []*dataloader.Result[*profile.Public] {
+		results := make([]*dataloader.Result[*profile.Public], len(ids))
+
+		profiles, err := pq.GetMany(ctx, ids...)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*profile.Public]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[account.AccountID]*profile.Public, len(profiles))
+		for _, p := range profiles {
+			byID[p.ID] = p
+		}
+
+		for i, id := range ids {
+			if p, ok := byID[id]; ok {
+				results[i] = &dataloader.Result[*profile.Public]{Data: p}
+			} else {
+				results[i] = &dataloader.Result[*profile.Public]{Error: errProfileNotFound}
+			}
+		}
+
+		return results
+	}
+}
+
+// loadProfile resolves the full profile for a reference embedded in another
+// resource, falling back to the reference itself if the account is gone.
+func loadProfile(ctx context.Context, r *Resolver, ref profile.Ref) (*profileResolver, error) {
+	p, err := getLoaders(ctx).profiles.Load(ctx, ref.ID)()
+	if err != nil {
+		if ftag.Get(err) != ftag.NotFound {
+			return nil, err
+		}
+		p = &profile.Public{Ref: ref}
+	}
+
+	return &profileResolver{r: r, p: p}, nil
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+)
+
+type nodePageResolver struct {
+	info  pageInfo
+	nodes []*nodeResolver
+}
+
+func (p *nodePageResolver) PageInfo() pageInfo     { return p.info }
+func (p *nodePageResolver) Nodes() []*nodeResolver { return p.nodes }
+
+type nodeResolver struct {
+	r *Resolver
+	n *library.Node
+}
+
+func (n *nodeResolver) ID() graphql.ID      { return toID(n.n.Mark.ID()) }
+func (n *nodeResolver) Slug() string        { return n.n.Mark.Slug() }
+func (n *nodeResolver) Name() string        { return n.n.Name }
+func (n *nodeResolver) Description() string { return n.n.Description.OrZero() }
+func (n *nodeResolver) Content() *string {
+	return opt.PtrMap(n.n.Content, func(c datagraph.Content) string { return c.HTML() })
+}
+func (n *nodeResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: n.n.CreatedAt}
+}
+func (n *nodeResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: n.n.UpdatedAt}
+}
+func (n *nodeResolver) Tags() []*tagResolver { return tags(n.n.Tags) }
+
+func (n *nodeResolver) Owner(ctx context.Context) (*profileResolver, error) {
+	return loadProfile(ctx, n.r, n.n.Owner)
+}
+
+func (n *nodeResolver) Parent() *nodeResolver {
+	p, ok := n.n.Parent.Get()
+	if !ok {
+		return nil
+	}
+
+	return &nodeResolver{r: n.r, n: &p}
+}
+
+func (n *nodeResolver) Children(ctx context.Context, args struct{ Page *int32 }) (*nodePageResolver, error) {
+	result, err := n.r.nodes.ListChildren(ctx, library.NewID(n.n.Mark.ID()), pageParams(args.Page, nodePageSize))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &nodePageResolver{
+		info: pageInfoFrom(*result),
+		nodes: dt.Map(result.Items, func(c *library.Node) *nodeResolver {
+			return &nodeResolver{r: n.r, n: c}
+		}),
+	}, nil
+}
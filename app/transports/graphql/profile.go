@@ -0,0 +1,51 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	thread_service "github.com/Southclaws/storyden/app/services/thread"
+)
+
+type profileResolver struct {
+	r *Resolver
+	p *profile.Public
+}
+
+func (p *profileResolver) ID() graphql.ID            { return toID(xid.ID(p.p.ID)) }
+func (p *profileResolver) Handle() string            { return p.p.Handle }
+func (p *profileResolver) Name() string              { return p.p.Name }
+func (p *profileResolver) Bio() string               { return p.p.Bio.HTML() }
+func (p *profileResolver) Followers() int32          { return int32(p.p.Followers) }
+func (p *profileResolver) Following() int32          { return int32(p.p.Following) }
+func (p *profileResolver) LikeScore() int32          { return int32(p.p.LikeScore) }
+func (p *profileResolver) Interests() []*tagResolver { return tags(p.p.Interests) }
+func (p *profileResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: p.p.Created}
+}
+
+func (p *profileResolver) Threads(ctx context.Context, args struct{ Page *int32 }) (*threadPageResolver, error) {
+	return p.r.listThreads(ctx, args.Page, thread_service.Params{
+		AccountID: opt.New(p.p.ID),
+	})
+}
+
+func (p *profileResolver) Collections(ctx context.Context) ([]*collectionResolver, error) {
+	cs, err := p.r.collections.List(ctx, collection_querier.WithOwnerHandle(p.p.Handle))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(cs, func(c *collection.Collection) *collectionResolver {
+		return &collectionResolver{r: p.r, c: c}
+	}), nil
+}
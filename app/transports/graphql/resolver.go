@@ -0,0 +1,218 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/services/collection/collection_read"
+	"github.com/Southclaws/storyden/app/services/library/node_read"
+	thread_service "github.com/Southclaws/storyden/app/services/thread"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+const (
+	threadPageSize = 50
+	replyPageSize  = 50
+	nodePageSize   = 100
+)
+
+// Resolver is the root query resolver, every type's resolver holds a pointer
+// to it to reach the services needed for nested fields.
+type Resolver struct {
+	threads          thread_service.Service
+	threadMarks      thread_mark.Service
+	profiles         *profile_querier.Querier
+	nodes            *node_read.HydratedQuerier
+	collections      *collection_querier.Querier
+	collectionReader *collection_read.Hydrator
+}
+
+func newResolver(
+	threads thread_service.Service,
+	threadMarks thread_mark.Service,
+	profiles *profile_querier.Querier,
+	nodes *node_read.HydratedQuerier,
+	collections *collection_querier.Querier,
+	collectionReader *collection_read.Hydrator,
+) *Resolver {
+	return &Resolver{
+		threads:          threads,
+		threadMarks:      threadMarks,
+		profiles:         profiles,
+		nodes:            nodes,
+		collections:      collections,
+		collectionReader: collectionReader,
+	}
+}
+
+func (r *Resolver) Thread(ctx context.Context, args struct{ Mark string }) (*threadResolver, error) {
+	id, err := r.threadMarks.Lookup(ctx, args.Mark)
+	if err != nil {
+		return found[threadResolver](ctx, nil, err)
+	}
+
+	t, err := r.threads.Get(ctx, id, pagination.NewPageParams(1, replyPageSize))
+	if err != nil {
+		return found[threadResolver](ctx, nil, err)
+	}
+
+	return &threadResolver{r: r, t: t, hydrated: true}, nil
+}
+
+type threadsArgs struct {
+	Page       *int32
+	Query      *string
+	Author     *string
+	Categories *[]string
+	Tags       *[]graphql.ID
+}
+
+func (r *Resolver) Threads(ctx context.Context, args threadsArgs) (*threadPageResolver, error) {
+	author, err := opt.MapErr(opt.NewPtr(args.Author), func(h string) (account.AccountID, error) {
+		return openapi.ResolveHandle(ctx, r.profiles, openapi.AccountHandle(h))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tags, err := opt.MapErr(opt.NewPtr(args.Tags), func(ids []graphql.ID) ([]xid.ID, error) {
+		return dt.MapErr(ids, parseID)
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r.listThreads(ctx, args.Page, thread_service.Params{
+		Query:     opt.NewPtr(args.Query),
+		AccountID: author,
+		Tags:      tags,
+		Categories: opt.NewPtrMap(args.Categories, func(slugs []string) thread_querier.CategoryFilter {
+			return thread_querier.CategoryFilter{Slugs: slugs}
+		}),
+	})
+}
+
+func (r *Resolver) listThreads(ctx context.Context, page *int32, params thread_service.Params) (*threadPageResolver, error) {
+	// The thread service's pages are zero-indexed.
+	p := pageNumber(page) - 1
+
+	result, err := r.threads.List(ctx, p, threadPageSize, params)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &threadPageResolver{
+		info: pageInfo{
+			currentPage: result.CurrentPage + 1,
+			nextPage:    opt.Map(result.NextPage, func(i int) int { return i + 1 }),
+			totalPages:  result.TotalPages,
+			results:     result.Results,
+		},
+		threads: dt.Map(result.Threads, r.newThread),
+	}, nil
+}
+
+func (r *Resolver) Profile(ctx context.Context, args struct{ Handle string }) (*profileResolver, error) {
+	id, err := openapi.ResolveHandle(ctx, r.profiles, openapi.AccountHandle(args.Handle))
+	if err != nil {
+		return found[profileResolver](ctx, nil, err)
+	}
+
+	p, err := getLoaders(ctx).profiles.Load(ctx, id)()
+	if err != nil {
+		return found[profileResolver](ctx, nil, err)
+	}
+
+	return &profileResolver{r: r, p: p}, nil
+}
+
+func (r *Resolver) Node(ctx context.Context, args struct{ Slug string }) (*nodeResolver, error) {
+	n, err := r.nodes.GetBySlug(ctx, library.NewKey(args.Slug), opt.NewEmpty[node_querier.ChildSortRule]())
+	if err != nil {
+		return found[nodeResolver](ctx, nil, err)
+	}
+
+	return &nodeResolver{r: r, n: n}, nil
+}
+
+func (r *Resolver) Collection(ctx context.Context, args struct{ Mark string }) (*collectionResolver, error) {
+	c, err := r.collectionReader.GetCollection(ctx, collection.NewKey(args.Mark))
+	if err != nil {
+		return found[collectionResolver](ctx, nil, err)
+	}
+
+	return &collectionResolver{r: r, c: &c.Collection, items: opt.New(c.Items)}, nil
+}
+
+func (r *Resolver) Collections(ctx context.Context, args struct{ Owner *string }) ([]*collectionResolver, error) {
+	opts := []collection_querier.Option{}
+	if v := args.Owner; v != nil {
+		opts = append(opts, collection_querier.WithOwnerHandle(*v))
+	}
+
+	cs, err := r.collections.List(ctx, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Map(cs, func(c *collection.Collection) *collectionResolver {
+		return &collectionResolver{r: r, c: c}
+	}), nil
+}
+
+type pageInfo struct {
+	currentPage int
+	nextPage    opt.Optional[int]
+	totalPages  int
+	results     int
+}
+
+func pageInfoFrom[T any](p pagination.Result[T]) pageInfo {
+	return pageInfo{
+		currentPage: p.CurrentPage,
+		nextPage:    p.NextPage,
+		totalPages:  p.TotalPages,
+		results:     p.Results,
+	}
+}
+
+func (p pageInfo) CurrentPage() int32 { return int32(p.currentPage) }
+func (p pageInfo) NextPage() *int32 {
+	return opt.PtrMap(p.nextPage, func(i int) int32 { return int32(i) })
+}
+func (p pageInfo) TotalPages() int32 { return int32(p.totalPages) }
+func (p pageInfo) Results() int32    { return int32(p.results) }
+
+func pageNumber(p *int32) int {
+	if p == nil || *p < 1 {
+		return 1
+	}
+	return int(*p)
+}
+
+func pageParams(p *int32, size uint) pagination.Parameters {
+	return pagination.NewPageParams(uint(pageNumber(p)), size)
+}
+
+func parseID(id graphql.ID) (xid.ID, error) {
+	return xid.FromString(string(id))
+}
+
+func toID(id xid.ID) graphql.ID {
+	return graphql.ID(id.String())
+}
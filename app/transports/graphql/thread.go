@@ -0,0 +1,134 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+)
+
+type threadPageResolver struct {
+	info    pageInfo
+	threads []*threadResolver
+}
+
+func (p *threadPageResolver) PageInfo() pageInfo         { return p.info }
+func (p *threadPageResolver) Threads() []*threadResolver { return p.threads }
+
+type threadResolver struct {
+	r *Resolver
+	t *thread.Thread
+
+	// hydrated is set when the thread was read individually, which includes the
+	// first page of replies, rather than from a listing which doesn't.
+	hydrated bool
+}
+
+func (r *Resolver) newThread(t *thread.Thread) *threadResolver {
+	return &threadResolver{r: r, t: t}
+}
+
+func (t *threadResolver) ID() graphql.ID      { return toID(xid.ID(t.t.ID)) }
+func (t *threadResolver) Slug() string        { return t.t.Slug }
+func (t *threadResolver) Title() string       { return t.t.Title }
+func (t *threadResolver) Description() string { return t.t.Short }
+func (t *threadResolver) Body() string        { return t.t.Content.HTML() }
+func (t *threadResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: t.t.CreatedAt}
+}
+func (t *threadResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: t.t.UpdatedAt}
+}
+func (t *threadResolver) LastReplyAt() *graphql.Time {
+	return opt.PtrMap(t.t.LastReplyAt, func(v time.Time) graphql.Time { return graphql.Time{Time: v} })
+}
+func (t *threadResolver) Pinned() bool      { return t.t.Pinned }
+func (t *threadResolver) ReplyCount() int32 { return int32(t.t.ReplyStatus.Count) }
+
+func (t *threadResolver) Author(ctx context.Context) (*profileResolver, error) {
+	return loadProfile(ctx, t.r, t.t.Author)
+}
+
+func (t *threadResolver) Category() *categoryResolver {
+	return opt.PtrMap(t.t.Category, func(c category.Category) categoryResolver {
+		return categoryResolver{c}
+	})
+}
+
+func (t *threadResolver) Tags() []*tagResolver { return tags(t.t.Tags) }
+
+func (t *threadResolver) Replies(ctx context.Context, args struct{ Page *int32 }) (*replyPageResolver, error) {
+	replies := t.t.Replies
+
+	if !t.hydrated || pageNumber(args.Page) != 1 {
+		th, err := t.r.threads.Get(ctx, t.t.ID, pageParams(args.Page, replyPageSize))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		replies = th.Replies
+	}
+
+	return &replyPageResolver{
+		info: pageInfoFrom(replies),
+		replies: dt.Map(replies.Items, func(r *reply.Reply) *replyResolver {
+			return &replyResolver{r: t.r, p: r}
+		}),
+	}, nil
+}
+
+type replyPageResolver struct {
+	info    pageInfo
+	replies []*replyResolver
+}
+
+func (p *replyPageResolver) PageInfo() pageInfo        { return p.info }
+func (p *replyPageResolver) Replies() []*replyResolver { return p.replies }
+
+type replyResolver struct {
+	r *Resolver
+	p *reply.Reply
+}
+
+func (r *replyResolver) ID() graphql.ID { return toID(xid.ID(r.p.ID)) }
+func (r *replyResolver) Body() string   { return r.p.Content.HTML() }
+func (r *replyResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.p.CreatedAt}
+}
+func (r *replyResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.p.UpdatedAt}
+}
+func (r *replyResolver) ReplyTo() *graphql.ID {
+	return opt.PtrMap(r.p.ReplyTo, func(id post.ID) graphql.ID { return toID(xid.ID(id)) })
+}
+
+func (r *replyResolver) Author(ctx context.Context) (*profileResolver, error) {
+	return loadProfile(ctx, r.r, r.p.Author)
+}
+
+type categoryResolver struct{ c category.Category }
+
+func (c categoryResolver) ID() graphql.ID      { return toID(xid.ID(c.c.ID)) }
+func (c categoryResolver) Name() string        { return c.c.Name }
+func (c categoryResolver) Slug() string        { return c.c.Slug }
+func (c categoryResolver) Description() string { return c.c.Description }
+func (c categoryResolver) Colour() string      { return c.c.Colour }
+
+type tagResolver struct{ t *tag_ref.Tag }
+
+func (t *tagResolver) Name() string   { return t.t.Name.String() }
+func (t *tagResolver) Colour() string { return t.t.Colour }
+
+func tags(in tag_ref.Tags) []*tagResolver {
+	return dt.Map(in, func(t *tag_ref.Tag) *tagResolver { return &tagResolver{t} })
+}
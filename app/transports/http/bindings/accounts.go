@@ -15,19 +15,27 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/feed_token"
+	"github.com/Southclaws/storyden/app/resources/account/notification/digest"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_pref"
 	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/account/role/role_assign"
 	"github.com/Southclaws/storyden/app/resources/account/role/role_badge"
+	"github.com/Southclaws/storyden/app/resources/api_usage"
+	"github.com/Southclaws/storyden/app/resources/profile/follow_querier"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_cache"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
 	"github.com/Southclaws/storyden/app/services/account/account_auth"
 	"github.com/Southclaws/storyden/app/services/account/account_email"
 	"github.com/Southclaws/storyden/app/services/account/account_manage"
 	"github.com/Southclaws/storyden/app/services/account/account_update"
+	"github.com/Southclaws/storyden/app/services/account/reading_tracker"
 	"github.com/Southclaws/storyden/app/services/authentication"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/avatar"
+	"github.com/Southclaws/storyden/app/services/profile/pinning"
 	"github.com/Southclaws/storyden/app/services/reqinfo"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 	"github.com/Southclaws/storyden/internal/config"
@@ -45,6 +53,14 @@ type Accounts struct {
 	accountManage *account_manage.Manager
 	roleAssign    *role_assign.Assignment
 	roleBadge     *role_badge.Writer
+	followQuery   *follow_querier.Querier
+	tagQuery      *tag_querier.Querier
+	notifyPrefs   *notify_pref.Repository
+	digests       *digest.Repository
+	feedTokens    *feed_token.Repository
+	apiUsage      *api_usage.Repository
+	reading       *reading_tracker.Tracker
+	pinner        *pinning.Pinner
 	webAddress    url.URL
 }
 
@@ -61,6 +77,14 @@ func NewAccounts(
 	accountManage *account_manage.Manager,
 	roleAssign *role_assign.Assignment,
 	roleBadge *role_badge.Writer,
+	followQuery *follow_querier.Querier,
+	tagQuery *tag_querier.Querier,
+	notifyPrefs *notify_pref.Repository,
+	digests *digest.Repository,
+	feedTokens *feed_token.Repository,
+	apiUsage *api_usage.Repository,
+	reading *reading_tracker.Tracker,
+	pinner *pinning.Pinner,
 ) Accounts {
 	return Accounts{
 		profile_cache: profile_cache,
@@ -74,6 +98,14 @@ func NewAccounts(
 		accountManage: accountManage,
 		roleAssign:    roleAssign,
 		roleBadge:     roleBadge,
+		followQuery:   followQuery,
+		tagQuery:      tagQuery,
+		notifyPrefs:   notifyPrefs,
+		digests:       digests,
+		feedTokens:    feedTokens,
+		apiUsage:      apiUsage,
+		reading:       reading,
+		pinner:        pinner,
 		webAddress:    cfg.PublicWebAddress,
 	}
 }
@@ -160,13 +192,28 @@ func (i *Accounts) AccountUpdate(ctx context.Context, request openapi.AccountUpd
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	readingHistory := opt.NewEmpty[bool]()
+	hiddenActivity := opt.NewEmpty[[]account.ActivityKind]()
+	if request.Body.Privacy != nil {
+		readingHistory = opt.NewPtr(request.Body.Privacy.ReadingHistory)
+
+		hiddenActivity, err = opt.MapErr(opt.NewPtr(request.Body.Privacy.HiddenActivity), deserialiseActivityKindList)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	acc, err := i.accountUpdate.Update(ctx, accountID, account_update.Partial{
-		Handle:    opt.NewPtrMap(request.Body.Handle, func(i openapi.AccountHandle) string { return string(i) }),
-		Name:      opt.NewPtr(request.Body.Name),
-		Bio:       opt.NewPtr(request.Body.Bio),
-		Links:     links,
-		Meta:      opt.NewPtr((*map[string]any)(request.Body.Meta)),
-		Interests: opt.NewPtrMap(request.Body.Interests, tagsIDs),
+		Handle:         opt.NewPtrMap(request.Body.Handle, func(i openapi.AccountHandle) string { return string(i) }),
+		Name:           opt.NewPtr(request.Body.Name),
+		Bio:            opt.NewPtr(request.Body.Bio),
+		Links:          links,
+		Meta:           opt.NewPtr((*map[string]any)(request.Body.Meta)),
+		Locale:         opt.NewPtr(request.Body.Locale),
+		Timezone:       opt.NewPtr(request.Body.Timezone),
+		Interests:      opt.NewPtrMap(request.Body.Interests, tagsIDs),
+		ReadingHistory: readingHistory,
+		HiddenActivity: hiddenActivity,
 	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -329,7 +376,7 @@ func (h *Accounts) AccountRemoveRole(ctx context.Context, request openapi.Accoun
 			return nil, fault.Wrap(ErrSelfAdminRoleChange, fctx.With(ctx))
 		}
 	}
-	if roleID == role.DefaultRoleMemberID {
+	if roleID == role.DefaultRoleMemberID || roleID == role.DefaultRoleBotID {
 		return nil, fault.Wrap(ErrEveryoneRole, fctx.With(ctx))
 	}
 
@@ -366,7 +413,7 @@ func (h *Accounts) AccountAddRole(ctx context.Context, request openapi.AccountAd
 			return nil, fault.Wrap(ErrSelfAdminRoleChange, fctx.With(ctx))
 		}
 	}
-	if roleID == role.DefaultRoleMemberID {
+	if roleID == role.DefaultRoleMemberID || roleID == role.DefaultRoleBotID {
 		return nil, fault.Wrap(ErrEveryoneRole, fctx.With(ctx))
 	}
 
@@ -0,0 +1,101 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/api_usage"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+const (
+	apiUsageDaysDefault   = 30
+	apiUsageReportDays    = 7
+	apiUsageReportDefault = 50
+)
+
+func (h *Accounts) AccountAPIUsageGet(ctx context.Context, request openapi.AccountAPIUsageGetRequestObject) (openapi.AccountAPIUsageGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	since := apiUsageSince(opt.NewPtr(request.Params.Days).Or(apiUsageDaysDefault))
+
+	usage, err := h.apiUsage.ForAccount(ctx, accountID, since)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountAPIUsageGet200JSONResponse{
+		AccountAPIUsageGetOKJSONResponse: openapi.AccountAPIUsageGetOKJSONResponse{
+			Since:      usage.Since,
+			Total:      serialiseAPIUsageCounts(usage.Total),
+			AccessKeys: dt.Map(usage.AccessKeys, serialiseAPIUsageKeyUsage),
+			Days: dt.Map(usage.Days, func(d api_usage.Day) openapi.APIUsageDay {
+				c := serialiseAPIUsageCounts(d.Counts)
+				return openapi.APIUsageDay{
+					Date:         d.Date,
+					Requests:     c.Requests,
+					ClientErrors: c.ClientErrors,
+					ServerErrors: c.ServerErrors,
+					RateLimited:  c.RateLimited,
+					ErrorRate:    c.ErrorRate,
+				}
+			}),
+		},
+	}, nil
+}
+
+func apiUsageSince(days int) time.Time {
+	return time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+}
+
+func serialiseAPIUsageCounts(in api_usage.Counts) openapi.APIUsageCounts {
+	return openapi.APIUsageCounts{
+		Requests:     in.Requests,
+		ClientErrors: in.ClientErrors,
+		ServerErrors: in.ServerErrors,
+		RateLimited:  in.RateLimited,
+		ErrorRate:    in.ErrorRate(),
+	}
+}
+
+func serialiseAPIUsageAccessKey(in api_usage.AccessKey) openapi.APIUsageAccessKey {
+	return openapi.APIUsageAccessKey{
+		Id:      in.ID.String(),
+		Name:    in.Name.OrZero(),
+		Enabled: in.Enabled,
+	}
+}
+
+func serialiseAPIUsageKeyUsage(in api_usage.KeyUsage) openapi.APIUsageKeyUsage {
+	c := serialiseAPIUsageCounts(in.Counts)
+	return openapi.APIUsageKeyUsage{
+		AccessKey:    opt.Map(in.AccessKey, serialiseAPIUsageAccessKey).Ptr(),
+		Requests:     c.Requests,
+		ClientErrors: c.ClientErrors,
+		ServerErrors: c.ServerErrors,
+		RateLimited:  c.RateLimited,
+		ErrorRate:    c.ErrorRate,
+	}
+}
+
+func serialiseAPIUsageReportEntry(in *api_usage.ReportEntry) openapi.APIUsageReportEntry {
+	k := serialiseAPIUsageKeyUsage(in.KeyUsage)
+	return openapi.APIUsageReportEntry{
+		Account:      serialiseProfileReference(in.Account),
+		AccessKey:    k.AccessKey,
+		Requests:     k.Requests,
+		ClientErrors: k.ClientErrors,
+		ServerErrors: k.ServerErrors,
+		RateLimited:  k.RateLimited,
+		ErrorRate:    k.ErrorRate,
+	}
+}
@@ -0,0 +1,42 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func (h *Accounts) AccountFeedTokenGet(ctx context.Context, request openapi.AccountFeedTokenGetRequestObject) (openapi.AccountFeedTokenGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	token, err := h.feedTokens.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountFeedTokenGet200JSONResponse{
+		AccountFeedTokenOKJSONResponse: openapi.AccountFeedTokenOKJSONResponse{
+			Token: token,
+		},
+	}, nil
+}
+
+func (h *Accounts) AccountFeedTokenRevoke(ctx context.Context, request openapi.AccountFeedTokenRevokeRequestObject) (openapi.AccountFeedTokenRevokeResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := h.feedTokens.Revoke(ctx, accountID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountFeedTokenRevoke204Response{}, nil
+}
@@ -0,0 +1,110 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/notification/digest"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_pref"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func (h *Accounts) AccountNotificationPreferencesGet(ctx context.Context, request openapi.AccountNotificationPreferencesGetRequestObject) (openapi.AccountNotificationPreferencesGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	prefs, err := h.notifyPrefs.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	freq, err := h.digests.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountNotificationPreferencesGet200JSONResponse{
+		AccountNotificationPreferencesOKJSONResponse: openapi.AccountNotificationPreferencesOKJSONResponse{
+			Preferences:     serialiseNotificationPreferences(prefs),
+			DigestFrequency: serialiseDigestFrequency(freq),
+		},
+	}, nil
+}
+
+func (h *Accounts) AccountNotificationPreferencesUpdate(ctx context.Context, request openapi.AccountNotificationPreferencesUpdateRequestObject) (openapi.AccountNotificationPreferencesUpdateResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	update, err := deserialiseNotificationPreferences(request.Body.Preferences)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	if request.Body.DigestFrequency != nil {
+		freq, err := digest.NewFrequency(string(*request.Body.DigestFrequency))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+
+		if err := h.digests.Set(ctx, accountID, freq); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	prefs, err := h.notifyPrefs.Update(ctx, accountID, update)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	freq, err := h.digests.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountNotificationPreferencesUpdate200JSONResponse{
+		AccountNotificationPreferencesOKJSONResponse: openapi.AccountNotificationPreferencesOKJSONResponse{
+			Preferences:     serialiseNotificationPreferences(prefs),
+			DigestFrequency: serialiseDigestFrequency(freq),
+		},
+	}, nil
+}
+
+func serialiseNotificationPreferences(in notify_pref.Preferences) openapi.NotificationPreferenceList {
+	return dt.Map(notify_pref.Events, func(e notification.Event) openapi.NotificationPreference {
+		c := in[e]
+		return openapi.NotificationPreference{
+			Event:    openapi.NotificationEvent(e.String()),
+			InApp:    c.InApp,
+			Email:    c.Email,
+			WebPush:  c.WebPush,
+			Telegram: c.Telegram,
+		}
+	})
+}
+
+func serialiseDigestFrequency(in digest.Frequency) *openapi.DigestFrequency {
+	f := openapi.DigestFrequency(in.String())
+	return &f
+}
+
+func deserialiseNotificationPreferences(in openapi.NotificationPreferenceList) (notify_pref.Preferences, error) {
+	out := make(notify_pref.Preferences, len(in))
+	for _, p := range in {
+		event, err := notification.NewEvent(string(p.Event))
+		if err != nil {
+			return nil, err
+		}
+		out[event] = notify_pref.Channels{InApp: p.InApp, Email: p.Email, WebPush: p.WebPush, Telegram: p.Telegram}
+	}
+	return out, nil
+}
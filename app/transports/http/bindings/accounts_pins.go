@@ -0,0 +1,49 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func (h *Accounts) AccountPinsUpdate(ctx context.Context, request openapi.AccountPinsUpdateRequestObject) (openapi.AccountPinsUpdateResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs, err := dt.MapErr(request.Body.Items, deserialiseProfilePinRef)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	pinned, err := h.pinner.Set(ctx, accountID, refs)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountPinsUpdate200JSONResponse{
+		AccountPinsUpdateOKJSONResponse: openapi.AccountPinsUpdateOKJSONResponse{
+			Items: serialiseDatagraphItemList(pinned),
+		},
+	}, nil
+}
+
+func deserialiseProfilePinRef(in openapi.ProfilePinRef) (datagraph.Ref, error) {
+	kind, err := datagraph.NewKind(string(in.Kind))
+	if err != nil {
+		return datagraph.Ref{}, err
+	}
+
+	return datagraph.Ref{
+		ID:   deserialiseID(in.Id),
+		Kind: kind,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/reading_history"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func (h *Accounts) AccountReadingHistoryList(ctx context.Context, request openapi.AccountReadingHistoryListRequestObject) (openapi.AccountReadingHistoryListResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	kinds := []datagraph.Kind{}
+	if request.Params.Kind != nil {
+		kind, err := datagraph.NewKind(string(*request.Params.Kind))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		kinds = append(kinds, kind)
+	}
+
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	result, err := h.reading.List(ctx, accountID, page, kinds...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountReadingHistoryList200JSONResponse{
+		AccountReadingHistoryListOKJSONResponse: openapi.AccountReadingHistoryListOKJSONResponse(serialiseReadingHistoryList(result)),
+	}, nil
+}
+
+func (h *Accounts) AccountReadingHistoryClear(ctx context.Context, request openapi.AccountReadingHistoryClearRequestObject) (openapi.AccountReadingHistoryClearResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := h.reading.Clear(ctx, accountID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountReadingHistoryClear204Response{}, nil
+}
+
+func (h *Accounts) AccountReadingHistoryGet(ctx context.Context, request openapi.AccountReadingHistoryGetRequestObject) (openapi.AccountReadingHistoryGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	e, err := h.reading.Get(ctx, accountID, deserialiseID(request.ReadingItemId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountReadingHistoryGet200JSONResponse{
+		AccountReadingHistoryGetOKJSONResponse: openapi.AccountReadingHistoryGetOKJSONResponse(serialiseReadingHistoryEntry(e)),
+	}, nil
+}
+
+func (h *Accounts) AccountReadingHistoryRemove(ctx context.Context, request openapi.AccountReadingHistoryRemoveRequestObject) (openapi.AccountReadingHistoryRemoveResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := h.reading.Remove(ctx, accountID, deserialiseID(request.ReadingItemId)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountReadingHistoryRemove204Response{}, nil
+}
+
+func serialiseReadingHistoryEntry(in *reading_history.Entry) openapi.ReadingHistoryEntry {
+	item := opt.Map(opt.NewSafe(in.Item, in.Item != nil), serialiseDatagraphItem)
+
+	return openapi.ReadingHistoryEntry{
+		Id:          in.ID.String(),
+		FirstReadAt: in.FirstReadAt,
+		LastReadAt:  in.LastReadAt,
+		ItemId:      in.Target.ID.String(),
+		ItemKind:    openapi.DatagraphItemKind(in.Target.Kind.String()),
+		Item:        item.Ptr(),
+		Position:    in.Position.Ptr(),
+	}
+}
+
+func serialiseReadingHistoryList(in pagination.Result[*reading_history.Entry]) openapi.ReadingHistoryListResult {
+	return openapi.ReadingHistoryListResult{
+		History:     dt.Map(in.Items, serialiseReadingHistoryEntry),
+		CurrentPage: in.CurrentPage,
+		NextPage:    in.NextPage.Ptr(),
+		PageSize:    in.Size,
+		Results:     in.Results,
+		TotalPages:  in.TotalPages,
+	}
+}
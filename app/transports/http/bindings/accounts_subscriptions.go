@@ -0,0 +1,36 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func (h *Accounts) AccountSubscriptionsGet(ctx context.Context, request openapi.AccountSubscriptionsGetRequestObject) (openapi.AccountSubscriptionsGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tags, err := h.tagQuery.ListFollowedBy(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	cats, err := h.followQuery.GetFollowedCategories(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountSubscriptionsGet200JSONResponse{
+		AccountSubscriptionsGetOKJSONResponse: openapi.AccountSubscriptionsGetOKJSONResponse{
+			Tags:       serialiseTagReferenceList(tags),
+			Categories: dt.Map(cats, serialiseCategoryReferencePtr),
+		},
+	}, nil
+}
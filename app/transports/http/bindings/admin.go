@@ -2,22 +2,38 @@ package bindings
 
 import (
 	"context"
+	"time"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
 
+	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
+	"github.com/Southclaws/storyden/app/resources/account/onboarding_checklist"
+	"github.com/Southclaws/storyden/app/resources/api_usage"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_usage"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/index_status"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_pin"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/reputation"
+	"github.com/Southclaws/storyden/app/resources/retention"
 	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/trash"
+	"github.com/Southclaws/storyden/app/resources/warning"
 	"github.com/Southclaws/storyden/app/services/account/account_suspension"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/semdex/reindex"
+	"github.com/Southclaws/storyden/app/services/system/retention_enforcer"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
 
@@ -29,6 +45,11 @@ type Admin struct {
 	as           account_suspension.Service
 	sr           *settings.SettingsRepository
 	akr          *access_key.Repository
+	reindexer    *reindex.Manager
+	usage        *asset_usage.Querier
+	assetQuery   *asset_querier.Querier
+	retention    *retention_enforcer.Enforcer
+	apiUsage     *api_usage.Repository
 }
 
 func NewAdmin(
@@ -37,6 +58,11 @@ func NewAdmin(
 	as account_suspension.Service,
 	sr *settings.SettingsRepository,
 	akr *access_key.Repository,
+	reindexer *reindex.Manager,
+	usage *asset_usage.Querier,
+	assetQuery *asset_querier.Querier,
+	retention *retention_enforcer.Enforcer,
+	apiUsage *api_usage.Repository,
 ) Admin {
 	return Admin{
 		accountQuery: accountQuery,
@@ -44,9 +70,25 @@ func NewAdmin(
 		as:           as,
 		sr:           sr,
 		akr:          akr,
+		reindexer:    reindexer,
+		usage:        usage,
+		assetQuery:   assetQuery,
+		retention:    retention,
+		apiUsage:     apiUsage,
 	}
 }
 
+func (a *Admin) AdminSettingsGet(ctx context.Context, request openapi.AdminSettingsGetRequestObject) (openapi.AdminSettingsGetResponseObject, error) {
+	settings, err := a.sr.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminSettingsGet200JSONResponse{
+		AdminSettingsGetOKJSONResponse: openapi.AdminSettingsGetOKJSONResponse(serialiseSettings(settings)),
+	}, nil
+}
+
 func (a *Admin) AdminSettingsUpdate(ctx context.Context, request openapi.AdminSettingsUpdateRequestObject) (openapi.AdminSettingsUpdateResponseObject, error) {
 	accountID, err := session.GetAccountID(ctx)
 	if err != nil {
@@ -72,13 +114,86 @@ func (a *Admin) AdminSettingsUpdate(ctx context.Context, request openapi.AdminSe
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	reputationSettings, err := opt.MapErr(opt.NewPtr(request.Body.Reputation), deserialiseReputationSettings)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	chatNotifications, err := opt.MapErr(opt.NewPtr(request.Body.ChatNotifications), deserialiseChatNotificationSettings)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	discordBridge, err := opt.MapErr(opt.NewPtr(request.Body.DiscordBridge), deserialiseDiscordBridgeSettings)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	matrixBridge, err := opt.MapErr(opt.NewPtr(request.Body.MatrixBridge), deserialiseMatrixBridgeSettings)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	warningSettings, err := opt.MapErr(opt.NewPtr(request.Body.Warnings), deserialiseWarningSettings)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	emailDomains, err := opt.MapErr(opt.NewPtr(request.Body.EmailDomains), deserialiseEmailDomainSettings)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	onboardingChecklist, err := opt.MapErr(opt.NewPtr(request.Body.OnboardingChecklist), deserialiseOnboardingChecklistSettings)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	limits, err := opt.MapErr(opt.NewPtr(request.Body.Limits), deserialiseInstanceLimits)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	defaultLocale, err := opt.MapErr(opt.NewPtr(request.Body.DefaultLocale), func(l string) (string, error) {
+		return account.ValidateLocale(ctx, l)
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	defaultTimezone, err := opt.MapErr(opt.NewPtr(request.Body.DefaultTimezone), func(tz string) (string, error) {
+		return account.ValidateTimezone(ctx, tz)
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	settings, err := a.sr.Set(ctx, settings.Settings{
-		Title:              opt.NewPtr(request.Body.Title),
-		Description:        opt.NewPtr(request.Body.Description),
-		Content:            content,
-		AccentColour:       opt.NewPtr(request.Body.AccentColour),
-		AuthenticationMode: authMode,
-		Metadata:           opt.NewPtr((*map[string]any)(request.Body.Metadata)),
+		Title:               opt.NewPtr(request.Body.Title),
+		Description:         opt.NewPtr(request.Body.Description),
+		Content:             content,
+		AccentColour:        opt.NewPtr(request.Body.AccentColour),
+		DefaultLocale:       defaultLocale,
+		DefaultTimezone:     defaultTimezone,
+		AuthenticationMode:  authMode,
+		Invitations:         opt.Map(opt.NewPtr(request.Body.Invitations), deserialiseInvitationSettings),
+		Applications:        opt.Map(opt.NewPtr(request.Body.Applications), deserialiseApplicationSettings),
+		EmailDomains:        emailDomains,
+		OnboardingChecklist: onboardingChecklist,
+		Reputation:          reputationSettings,
+		ChatNotifications:   chatNotifications,
+		DiscordBridge:       discordBridge,
+		MatrixBridge:        matrixBridge,
+		NewMemberApprovals:  opt.NewPtr(request.Body.NewMemberApprovals),
+		Warnings:            warningSettings,
+		TagCreation:         opt.Map(opt.NewPtr(request.Body.TagCreation), deserialiseTagCreationSettings),
+		ProfilePinLimit:     opt.NewPtr(request.Body.ProfilePinLimit),
+		TrashRetentionDays:  opt.NewPtr(request.Body.TrashRetentionDays),
+		Retention:           opt.Map(opt.NewPtr(request.Body.Retention), deserialiseRetentionSettings),
+		Limits:              limits,
+		RateLimits:          opt.Map(opt.NewPtr(request.Body.RateLimits), deserialiseRateLimitSettings),
+		Features:            opt.Map(opt.NewPtr(request.Body.Features), deserialiseInstanceFeatures),
+		Metadata:            opt.NewPtr((*map[string]any)(request.Body.Metadata)),
 	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -153,6 +268,161 @@ func (i *Admin) AdminAccountBanRemove(ctx context.Context, request openapi.Admin
 	}, nil
 }
 
+func (i *Admin) AdminSearchIndexStatus(ctx context.Context, request openapi.AdminSearchIndexStatusRequestObject) (openapi.AdminSearchIndexStatusResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	health, err := i.reindexer.Health(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	job := opt.Map(i.reindexer.Current(), serialiseSearchIndexJob)
+
+	return openapi.AdminSearchIndexStatus200JSONResponse{
+		AdminSearchIndexStatusOKJSONResponse: openapi.AdminSearchIndexStatusOKJSONResponse{
+			Provider: i.reindexer.Provider(),
+			Health:   dt.Map(health, serialiseSearchIndexKindHealth),
+			Job:      job.Ptr(),
+		},
+	}, nil
+}
+
+func (i *Admin) AdminSearchIndexRebuild(ctx context.Context, request openapi.AdminSearchIndexRebuildRequestObject) (openapi.AdminSearchIndexRebuildResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var kinds []datagraph.Kind
+	if request.Body.Kinds != nil {
+		k, err := deserialiseDatagraphKindList(*request.Body.Kinds)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		kinds = k
+	}
+
+	job, err := i.reindexer.Start(ctx, index_status.Range{
+		From: opt.NewPtr(request.Body.From),
+		To:   opt.NewPtr(request.Body.To),
+	}, kinds)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminSearchIndexRebuild200JSONResponse{
+		AdminSearchIndexRebuildOKJSONResponse: openapi.AdminSearchIndexRebuildOKJSONResponse(serialiseSearchIndexJob(*job)),
+	}, nil
+}
+
+const storageReportMax = 50
+
+func (i *Admin) AdminRetentionReport(ctx context.Context, request openapi.AdminRetentionReportRequestObject) (openapi.AdminRetentionReportResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	report, err := i.retention.Report(ctx, time.Now())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminRetentionReport200JSONResponse{
+		AdminRetentionReportOKJSONResponse: openapi.AdminRetentionReportOKJSONResponse{
+			Rules: dt.Map(report.Results, func(r retention.Result) openapi.RetentionRuleResult {
+				return openapi.RetentionRuleResult{
+					Rule:   openapi.RetentionRule(r.Rule.String()),
+					Days:   r.Days,
+					Before: r.Before,
+					Count:  r.Count,
+				}
+			}),
+			Total: report.Total(),
+		},
+	}, nil
+}
+
+func (i *Admin) AdminStorageUsageReport(ctx context.Context, request openapi.AdminStorageUsageReportRequestObject) (openapi.AdminStorageUsageReportResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	total, err := i.usage.Total(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	consumers, err := i.usage.Largest(ctx, storageReportMax)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ids := dt.Map(consumers, func(c *asset_usage.Consumer) account.AccountID { return c.AccountID })
+
+	profiles, err := i.profileQuery.GetMany(ctx, ids...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	lookup := lo.KeyBy(profiles, func(p *profile.Public) account.AccountID { return p.ID })
+
+	return openapi.AdminStorageUsageReport200JSONResponse{
+		AdminStorageUsageReportOKJSONResponse: openapi.AdminStorageUsageReportOKJSONResponse{
+			Used: total.Bytes,
+			Consumers: dt.Reduce(consumers, func(acc []openapi.StorageConsumer, c *asset_usage.Consumer) []openapi.StorageConsumer {
+				pro, ok := lookup[c.AccountID]
+				if !ok {
+					return acc
+				}
+				return append(acc, openapi.StorageConsumer{
+					Profile: serialiseProfileReference(pro.Ref),
+					Used:    c.Bytes,
+					Assets:  c.Assets,
+				})
+			}, []openapi.StorageConsumer{}),
+		},
+	}, nil
+}
+
+func (i *Admin) AdminAPIUsageReport(ctx context.Context, request openapi.AdminAPIUsageReportRequestObject) (openapi.AdminAPIUsageReportResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	since := apiUsageSince(opt.NewPtr(request.Params.Days).Or(apiUsageReportDays))
+	limit := opt.NewPtr(request.Params.Limit).Or(apiUsageReportDefault)
+
+	entries, err := i.apiUsage.Report(ctx, since, limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAPIUsageReport200JSONResponse{
+		AdminAPIUsageReportOKJSONResponse: openapi.AdminAPIUsageReportOKJSONResponse{
+			Since:   since,
+			Entries: dt.Map(entries, serialiseAPIUsageReportEntry),
+		},
+	}, nil
+}
+
+func (i *Admin) AdminFlaggedAssetList(ctx context.Context, request openapi.AdminFlaggedAssetListRequestObject) (openapi.AdminFlaggedAssetListResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	assets, err := i.assetQuery.ListFlagged(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminFlaggedAssetList200JSONResponse{
+		AdminFlaggedAssetListOKJSONResponse: openapi.AdminFlaggedAssetListOKJSONResponse{
+			Assets: dt.Map(assets, serialiseAssetPtr),
+		},
+	}, nil
+}
+
 func (i *Admin) AdminAccessKeyList(ctx context.Context, request openapi.AdminAccessKeyListRequestObject) (openapi.AdminAccessKeyListResponseObject, error) {
 	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -184,13 +454,67 @@ func (i *Admin) AdminAccessKeyDelete(ctx context.Context, request openapi.AdminA
 }
 
 func serialiseSettings(in *settings.Settings) openapi.AdminSettingsProps {
+	reputationSettings := serialiseReputationSettings(in.Reputation.Or(reputation.DefaultSettings))
+	chatNotifications := serialiseChatNotificationSettings(in.ChatNotifications.OrZero())
+	discordBridge := serialiseDiscordBridgeSettings(in.DiscordBridge.OrZero())
+	matrixBridge := serialiseMatrixBridgeSettings(in.MatrixBridge.OrZero())
+	warningSettings := serialiseWarningSettings(in.Warnings.Or(warning.DefaultSettings))
+	tagCreation := serialiseTagCreationSettings(in.TagCreation.OrZero())
+	invitationSettings := serialiseInvitationSettings(in.Invitations.OrZero())
+	applicationSettings := serialiseApplicationSettings(in.Applications.OrZero())
+	emailDomainSettings := serialiseEmailDomainSettings(in.EmailDomains.OrZero())
+	onboardingChecklist := serialiseOnboardingChecklistSettings(in.OnboardingChecklist.Or(onboarding_checklist.DefaultSettings))
+	retentionSettings := serialiseRetentionSettings(in.Retention.OrZero())
+	limits := serialiseInstanceLimits(in.Limits.OrZero())
+	rateLimits := serialiseRateLimitSettings(in.RateLimits.OrZero())
+	features := serialiseInstanceFeatures(in.Features.OrZero())
+
 	return openapi.AdminSettingsProps{
-		AccentColour:       in.AccentColour.OrZero(),
-		Description:        in.Description.OrZero(),
-		Content:            in.Content.OrZero().HTML(),
-		Title:              in.Title.OrZero(),
-		AuthenticationMode: openapi.AuthMode(in.AuthenticationMode.Or(authentication.ModeHandle).String()),
-		Metadata:           (*openapi.Metadata)(in.Metadata.Ptr()),
+		AccentColour:        in.AccentColour.OrZero(),
+		DefaultLocale:       in.DefaultLocale.Ptr(),
+		DefaultTimezone:     in.DefaultTimezone.Ptr(),
+		Description:         in.Description.OrZero(),
+		Content:             in.Content.OrZero().HTML(),
+		Title:               in.Title.OrZero(),
+		AuthenticationMode:  openapi.AuthMode(in.AuthenticationMode.Or(authentication.ModeHandle).String()),
+		Invitations:         &invitationSettings,
+		Applications:        &applicationSettings,
+		EmailDomains:        &emailDomainSettings,
+		OnboardingChecklist: &onboardingChecklist,
+		Reputation:          &reputationSettings,
+		ChatNotifications:   &chatNotifications,
+		DiscordBridge:       &discordBridge,
+		MatrixBridge:        &matrixBridge,
+		NewMemberApprovals:  opt.New(in.NewMemberApprovals.OrZero()).Ptr(),
+		Warnings:            &warningSettings,
+		TagCreation:         &tagCreation,
+		ProfilePinLimit:     opt.New(profile_pin.Limit(in.ProfilePinLimit)).Ptr(),
+		TrashRetentionDays:  opt.New(trash.RetentionDays(in.TrashRetentionDays)).Ptr(),
+		Retention:           &retentionSettings,
+		Limits:              &limits,
+		RateLimits:          &rateLimits,
+		Features:            &features,
+		Metadata:            (*openapi.Metadata)(in.Metadata.Ptr()),
+	}
+}
+
+func serialiseRetentionSettings(in retention.Settings) openapi.RetentionSettings {
+	return openapi.RetentionSettings{
+		SessionDays:         in.SessionDays.Ptr(),
+		UnverifiedEmailDays: in.UnverifiedEmailDays.Ptr(),
+		WebhookDeliveryDays: in.WebhookDeliveryDays.Ptr(),
+		ApiUsageDays:        in.APIUsageDays.Ptr(),
+		HourlyViewDays:      in.HourlyViewDays.Ptr(),
+	}
+}
+
+func deserialiseRetentionSettings(in openapi.RetentionSettings) retention.Settings {
+	return retention.Settings{
+		SessionDays:         opt.NewPtr(in.SessionDays),
+		UnverifiedEmailDays: opt.NewPtr(in.UnverifiedEmailDays),
+		WebhookDeliveryDays: opt.NewPtr(in.WebhookDeliveryDays),
+		APIUsageDays:        opt.NewPtr(in.ApiUsageDays),
+		HourlyViewDays:      opt.NewPtr(in.HourlyViewDays),
 	}
 }
 
@@ -208,3 +532,36 @@ func serialiseOwnedAccessKey(in *authentication.Authentication) openapi.OwnedAcc
 func serialiseOwnedAccessKeyList(in []*authentication.Authentication) openapi.OwnedAccessKeyList {
 	return dt.Map(in, serialiseOwnedAccessKey)
 }
+
+func serialiseSearchIndexKindHealth(in reindex.KindHealth) openapi.SearchIndexKindHealth {
+	return openapi.SearchIndexKindHealth{
+		Kind:      openapi.DatagraphItemKind(in.Kind.String()),
+		Published: in.Published,
+		Indexed:   in.Indexed,
+		Stale:     in.Stale,
+		Missing:   in.Missing,
+		InIndex:   in.InIndex.Ptr(),
+		Drift:     in.Drift(),
+	}
+}
+
+func serialiseSearchIndexJob(in reindex.Job) openapi.SearchIndexJob {
+	return openapi.SearchIndexJob{
+		Id:         openapi.Identifier(in.ID.String()),
+		Status:     openapi.SearchIndexJobStatus(in.Status.String()),
+		Full:       in.Full(),
+		From:       in.Range.From.Ptr(),
+		To:         in.Range.To.Ptr(),
+		StartedAt:  in.StartedAt,
+		FinishedAt: in.FinishedAt.Ptr(),
+		Error:      in.Error.Ptr(),
+		Progress: dt.Map(in.Progress, func(p reindex.Progress) openapi.SearchIndexJobProgress {
+			return openapi.SearchIndexJobProgress{
+				Kind:    openapi.DatagraphItemKind(p.Kind.String()),
+				Total:   p.Total,
+				Indexed: p.Indexed,
+				Removed: p.Removed,
+			}
+		}),
+	}
+}
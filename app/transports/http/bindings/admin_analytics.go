@@ -0,0 +1,172 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+const (
+	analyticsDaysDefault        = 30
+	analyticsTopDaysDefault     = 7
+	analyticsTopLimitDefault    = 20
+	analyticsCohortWeeksDefault = 12
+)
+
+type AdminAnalytics struct {
+	querier  *analytics_querier.Querier
+	hydrator *hydrate.Hydrator
+}
+
+func NewAdminAnalytics(querier *analytics_querier.Querier, hydrator *hydrate.Hydrator) AdminAnalytics {
+	return AdminAnalytics{querier: querier, hydrator: hydrator}
+}
+
+func (a *AdminAnalytics) AdminAnalyticsGet(ctx context.Context, request openapi.AdminAnalyticsGetRequestObject) (openapi.AdminAnalyticsGetResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	now := time.Now()
+	from := analyticsSince(now, opt.NewPtr(request.Params.Days).Or(analyticsDaysDefault))
+
+	days, err := a.querier.Days(ctx, from, now)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAnalyticsGet200JSONResponse{
+		AdminAnalyticsGetOKJSONResponse: openapi.AdminAnalyticsGetOKJSONResponse{
+			Days: dt.Map(days, serialiseAnalyticsDay),
+		},
+	}, nil
+}
+
+func (a *AdminAnalytics) AdminAnalyticsTopContent(ctx context.Context, request openapi.AdminAnalyticsTopContentRequestObject) (openapi.AdminAnalyticsTopContentResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	now := time.Now()
+	from := analyticsSince(now, opt.NewPtr(request.Params.Days).Or(analyticsTopDaysDefault))
+	limit := opt.NewPtr(request.Params.Limit).Or(analyticsTopLimitDefault)
+
+	content, err := a.querier.TopContent(ctx, from, now, limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs := dt.Map(content, func(c *analytics.Content) *datagraph.Ref {
+		return &datagraph.Ref{ID: c.ItemID, Kind: c.Kind}
+	})
+
+	items, err := a.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Items which have since been deleted or unpublished aren't hydrated.
+	lookup := lo.KeyBy(items, func(i datagraph.Item) xid.ID { return i.GetID() })
+
+	return openapi.AdminAnalyticsTopContent200JSONResponse{
+		AdminAnalyticsTopContentOKJSONResponse: openapi.AdminAnalyticsTopContentOKJSONResponse{
+			Items: dt.Reduce(content, func(acc []openapi.AnalyticsContentItem, c *analytics.Content) []openapi.AnalyticsContentItem {
+				item, ok := lookup[c.ItemID]
+				if !ok {
+					return acc
+				}
+				return append(acc, openapi.AnalyticsContentItem{
+					Item:        serialiseDatagraphItem(item),
+					Views:       c.Views,
+					Replies:     c.Replies,
+					Reactions:   c.Reactions,
+					Likes:       c.Likes,
+					Collections: c.Collections,
+				})
+			}, []openapi.AnalyticsContentItem{}),
+		},
+	}, nil
+}
+
+func (a *AdminAnalytics) AdminAnalyticsCohorts(ctx context.Context, request openapi.AdminAnalyticsCohortsRequestObject) (openapi.AdminAnalyticsCohortsResponseObject, error) {
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	weeks := opt.NewPtr(request.Params.Weeks).Or(analyticsCohortWeeksDefault)
+	since := analytics.StartOfWeek(time.Now()).AddDate(0, 0, -7*(weeks-1))
+
+	rows, err := a.querier.Cohorts(ctx, since)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAnalyticsCohorts200JSONResponse{
+		AdminAnalyticsCohortsOKJSONResponse: openapi.AdminAnalyticsCohortsOKJSONResponse{
+			Cohorts: serialiseAnalyticsCohorts(rows),
+		},
+	}, nil
+}
+
+// analyticsSince is the start of the earliest day included when reporting on
+// the given number of days, including today.
+func analyticsSince(now time.Time, days int) time.Time {
+	return analytics.StartOfDay(now).AddDate(0, 0, -(days - 1))
+}
+
+func serialiseAnalyticsDay(in *analytics.Day) openapi.AnalyticsDay {
+	return openapi.AnalyticsDay{
+		Date:          in.Date,
+		Signups:       in.Signups,
+		DailyActive:   in.DailyActive,
+		WeeklyActive:  in.WeeklyActive,
+		MonthlyActive: in.MonthlyActive,
+		Threads:       in.Threads,
+		Replies:       in.Replies,
+		Reactions:     in.Reactions,
+		Likes:         in.Likes,
+		UpdatedAt:     in.UpdatedAt,
+	}
+}
+
+// serialiseAnalyticsCohorts groups the weekly rows, which are ordered by cohort
+// then week, into one entry per cohort.
+func serialiseAnalyticsCohorts(in []*analytics.Cohort) []openapi.AnalyticsCohort {
+	out := []openapi.AnalyticsCohort{}
+
+	for _, c := range in {
+		if len(out) == 0 || !out[len(out)-1].Cohort.Equal(c.Cohort) {
+			out = append(out, openapi.AnalyticsCohort{
+				Cohort: c.Cohort,
+				Weeks:  []openapi.AnalyticsCohortWeek{},
+			})
+		}
+
+		last := &out[len(out)-1]
+
+		// The size is counted again each week, the latest is the most accurate
+		// as it accounts for members who have since been deleted.
+		last.Size = c.Size
+		last.Weeks = append(last.Weeks, openapi.AnalyticsCohortWeek{
+			Week:     c.Week,
+			Retained: c.Retained,
+			Rate:     c.Rate(),
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,100 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/services/realtime"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+// Comment lines are sent while the stream is idle so proxies don't close it.
+const adminEventKeepAlive = 30 * time.Second
+
+type AdminEvents struct {
+	gateway *realtime.Gateway
+}
+
+func NewAdminEvents(gateway *realtime.Gateway) AdminEvents {
+	return AdminEvents{gateway: gateway}
+}
+
+func (a *AdminEvents) AdminEventStream(ctx context.Context, request openapi.AdminEventStreamRequestObject) (openapi.AdminEventStreamResponseObject, error) {
+	var types []realtime.AdminEventType
+	if request.Params.Event != nil {
+		var err error
+		types, err = dt.MapErr(*request.Params.Event, deserialiseAdminEventType)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+	}
+
+	events, err := a.gateway.ConnectAdmin(ctx, types...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return adminEventStreamResponse{ctx: ctx, events: events}, nil
+}
+
+func deserialiseAdminEventType(in openapi.AdminEventType) (realtime.AdminEventType, error) {
+	return realtime.NewAdminEventType(string(in))
+}
+
+type adminEventStreamResponse struct {
+	ctx    context.Context
+	events <-chan realtime.AdminEvent
+}
+
+func (r adminEventStreamResponse) VisitAdminEventStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		return fault.Wrap(err, fctx.With(r.ctx))
+	}
+
+	keepAlive := time.NewTicker(adminEventKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return nil
+
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+
+		case e, ok := <-r.events:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(e)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(r.ctx))
+			}
+
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Event, data); err != nil {
+				return nil
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package bindings
+
+import (
+	"net/url"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/chat_notify"
+	"github.com/Southclaws/storyden/app/resources/discord_bridge"
+	"github.com/Southclaws/storyden/app/resources/matrix_bridge"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func serialiseChatNotificationSettings(in chat_notify.Settings) openapi.ChatNotificationSettings {
+	return openapi.ChatNotificationSettings{
+		Channels: dt.Map(in.Channels, func(c chat_notify.Channel) openapi.ChatNotificationChannel {
+			categories := dt.Map(c.Categories, func(id xid.ID) openapi.Identifier { return id.String() })
+
+			return openapi.ChatNotificationChannel{
+				Name:       c.Name,
+				Provider:   openapi.ChatNotificationProvider(c.Provider.String()),
+				WebhookUrl: c.WebhookURL,
+				Triggers: dt.Map(c.Triggers, func(t chat_notify.Trigger) openapi.ChatNotificationTrigger {
+					return openapi.ChatNotificationTrigger(t.String())
+				}),
+				Categories: &categories,
+			}
+		}),
+	}
+}
+
+func deserialiseChatNotificationSettings(in openapi.ChatNotificationSettings) (chat_notify.Settings, error) {
+	channels, err := dt.MapErr(in.Channels, deserialiseChatNotificationChannel)
+	if err != nil {
+		return chat_notify.Settings{}, err
+	}
+
+	return chat_notify.Settings{Channels: channels}, nil
+}
+
+func deserialiseChatNotificationChannel(in openapi.ChatNotificationChannel) (chat_notify.Channel, error) {
+	provider, err := chat_notify.NewProvider(string(in.Provider))
+	if err != nil {
+		return chat_notify.Channel{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	u, err := url.Parse(in.WebhookUrl)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return chat_notify.Channel{}, fault.New("chat notification webhook url must be an absolute http or https URL",
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid url", "The channel's webhook URL must be an absolute http:// or https:// address."),
+		)
+	}
+
+	triggers, err := dt.MapErr(in.Triggers, func(t openapi.ChatNotificationTrigger) (chat_notify.Trigger, error) {
+		return chat_notify.NewTrigger(string(t))
+	})
+	if err != nil {
+		return chat_notify.Channel{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	categories, err := dt.MapErr(opt.NewPtr(in.Categories).OrZero(), xid.FromString)
+	if err != nil {
+		return chat_notify.Channel{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	return chat_notify.Channel{
+		Name:       in.Name,
+		Provider:   provider,
+		WebhookURL: in.WebhookUrl,
+		Triggers:   triggers,
+		Categories: categories,
+	}, nil
+}
+
+func serialiseDiscordBridgeSettings(in discord_bridge.Settings) openapi.DiscordBridgeSettings {
+	return openapi.DiscordBridgeSettings{
+		Forums: dt.Map(in.Forums, func(f discord_bridge.Forum) openapi.DiscordBridgeForum {
+			return openapi.DiscordBridgeForum{
+				Category:  f.CategoryID.String(),
+				ChannelId: f.ChannelID,
+			}
+		}),
+	}
+}
+
+func deserialiseDiscordBridgeSettings(in openapi.DiscordBridgeSettings) (discord_bridge.Settings, error) {
+	forums, err := dt.MapErr(in.Forums, func(f openapi.DiscordBridgeForum) (discord_bridge.Forum, error) {
+		id, err := xid.FromString(f.Category)
+		if err != nil {
+			return discord_bridge.Forum{}, err
+		}
+
+		return discord_bridge.Forum{CategoryID: id, ChannelID: f.ChannelId}, nil
+	})
+	if err != nil {
+		return discord_bridge.Settings{}, err
+	}
+
+	return discord_bridge.Settings{Forums: forums}, nil
+}
+
+func serialiseMatrixBridgeSettings(in matrix_bridge.Settings) openapi.MatrixBridgeSettings {
+	return openapi.MatrixBridgeSettings{
+		Rooms: dt.Map(in.Rooms, func(r matrix_bridge.Room) openapi.MatrixBridgeRoom {
+			return openapi.MatrixBridgeRoom{
+				Category: r.CategoryID.String(),
+				RoomId:   r.RoomID,
+			}
+		}),
+	}
+}
+
+func deserialiseMatrixBridgeSettings(in openapi.MatrixBridgeSettings) (matrix_bridge.Settings, error) {
+	rooms, err := dt.MapErr(in.Rooms, func(r openapi.MatrixBridgeRoom) (matrix_bridge.Room, error) {
+		id, err := xid.FromString(r.Category)
+		if err != nil {
+			return matrix_bridge.Room{}, err
+		}
+
+		return matrix_bridge.Room{CategoryID: id, RoomID: r.RoomId}, nil
+	})
+	if err != nil {
+		return matrix_bridge.Settings{}, err
+	}
+
+	return matrix_bridge.Settings{Rooms: rooms}, nil
+}
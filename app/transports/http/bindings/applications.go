@@ -0,0 +1,151 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account/application"
+	"github.com/Southclaws/storyden/app/services/account/application_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Applications struct {
+	applicationManager *application_manager.Manager
+}
+
+func NewApplications(applicationManager *application_manager.Manager) Applications {
+	return Applications{applicationManager: applicationManager}
+}
+
+func (h Applications) AccountApplicationGet(ctx context.Context, request openapi.AccountApplicationGetRequestObject) (openapi.AccountApplicationGetResponseObject, error) {
+	app, questions, err := h.applicationManager.Own(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountApplicationGet200JSONResponse{
+		AccountApplicationGetOKJSONResponse: openapi.AccountApplicationGetOKJSONResponse{
+			Application: serialiseApplication(app),
+			Questions:   dt.Map(questions, serialiseApplicationQuestion),
+		},
+	}, nil
+}
+
+func (h Applications) AccountApplicationSubmit(ctx context.Context, request openapi.AccountApplicationSubmitRequestObject) (openapi.AccountApplicationSubmitResponseObject, error) {
+	_, err := h.applicationManager.Submit(ctx, dt.Map(request.Body.Answers, func(a openapi.ApplicationAnswer) application.Answer {
+		return application.Answer{QuestionID: a.QuestionId, Answer: a.Answer}
+	}))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	app, questions, err := h.applicationManager.Own(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountApplicationSubmit200JSONResponse{
+		AccountApplicationGetOKJSONResponse: openapi.AccountApplicationGetOKJSONResponse{
+			Application: serialiseApplication(app),
+			Questions:   dt.Map(questions, serialiseApplicationQuestion),
+		},
+	}, nil
+}
+
+func (h Applications) AdminApplicationList(ctx context.Context, request openapi.AdminApplicationListRequestObject) (openapi.AdminApplicationListResponseObject, error) {
+	status, err := opt.MapErr(opt.NewPtr(request.Params.Status), func(s openapi.ApplicationStatus) (application.Status, error) {
+		return application.NewStatus(string(s))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	apps, err := h.applicationManager.List(ctx, status)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminApplicationList200JSONResponse{
+		AdminApplicationListOKJSONResponse: openapi.AdminApplicationListOKJSONResponse{
+			Applications: dt.Map(apps, serialiseApplication),
+		},
+	}, nil
+}
+
+func (h Applications) AdminApplicationApprove(ctx context.Context, request openapi.AdminApplicationApproveRequestObject) (openapi.AdminApplicationApproveResponseObject, error) {
+	n, err := h.applicationManager.Approve(ctx, deserialiseApplicationIDs(request.Body.ApplicationIds))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminApplicationApprove200JSONResponse{
+		AdminApplicationReviewOKJSONResponse: openapi.AdminApplicationReviewOKJSONResponse{
+			Reviewed: n,
+		},
+	}, nil
+}
+
+func (h Applications) AdminApplicationReject(ctx context.Context, request openapi.AdminApplicationRejectRequestObject) (openapi.AdminApplicationRejectResponseObject, error) {
+	n, err := h.applicationManager.Reject(ctx, deserialiseApplicationIDs(request.Body.ApplicationIds), opt.NewPtr(request.Body.Reason))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminApplicationReject200JSONResponse{
+		AdminApplicationReviewOKJSONResponse: openapi.AdminApplicationReviewOKJSONResponse{
+			Reviewed: n,
+		},
+	}, nil
+}
+
+func deserialiseApplicationIDs(in []openapi.Identifier) []application.ApplicationID {
+	return dt.Map(in, func(id openapi.Identifier) application.ApplicationID {
+		return application.ApplicationID(deserialiseID(id))
+	})
+}
+
+func serialiseApplication(in *application.Application) openapi.Application {
+	return openapi.Application{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		Account:   serialiseProfileReferenceFromAccount(in.Account),
+		Status:    openapi.ApplicationStatus(in.Status.String()),
+		Answers: dt.Map(in.Answers, func(a application.Answer) openapi.ApplicationAnswer {
+			return openapi.ApplicationAnswer{QuestionId: a.QuestionID, Answer: a.Answer}
+		}),
+		SubmittedAt: in.SubmittedAt.Ptr(),
+		ReviewedAt:  in.ReviewedAt.Ptr(),
+		Reason:      in.Reason.Ptr(),
+	}
+}
+
+func serialiseApplicationQuestion(in application.Question) openapi.ApplicationQuestion {
+	return openapi.ApplicationQuestion{
+		Id:       in.ID,
+		Prompt:   in.Prompt,
+		Required: in.Required,
+	}
+}
+
+func serialiseApplicationSettings(in application.Settings) openapi.ApplicationSettings {
+	questions := dt.Map(in.Questions, serialiseApplicationQuestion)
+
+	return openapi.ApplicationSettings{
+		Required:  &in.Required,
+		Questions: &questions,
+	}
+}
+
+func deserialiseApplicationSettings(in openapi.ApplicationSettings) application.Settings {
+	return application.Settings{
+		Required: opt.NewPtr(in.Required).OrZero(),
+		Questions: dt.Map(opt.NewPtr(in.Questions).OrZero(), func(q openapi.ApplicationQuestion) application.Question {
+			return application.Question{ID: q.Id, Prompt: q.Prompt, Required: q.Required}
+		}),
+	}
+}
@@ -11,23 +11,90 @@ import (
 	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/upload_session"
+	"github.com/Southclaws/storyden/app/resources/cachecontrol"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/asset/asset_access"
+	"github.com/Southclaws/storyden/app/services/asset/asset_delete"
 	"github.com/Southclaws/storyden/app/services/asset/asset_download"
+	"github.com/Southclaws/storyden/app/services/asset/asset_quota"
 	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
+	"github.com/Southclaws/storyden/app/services/asset/asset_variant"
+	"github.com/Southclaws/storyden/app/services/asset/resumable"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/imageproc"
+	"strconv"
 )
 
 type Assets struct {
 	uploader   *asset_upload.Uploader
 	downloader *asset_download.Downloader
+	variants   *asset_variant.Server
+	resumable  *resumable.Manager
+	deleter    *asset_delete.Deleter
+	quota      *asset_quota.Checker
+	access     *asset_access.Checker
 }
 
-func NewAssets(uploader *asset_upload.Uploader, downloader *asset_download.Downloader) Assets {
-	return Assets{uploader, downloader}
+func NewAssets(uploader *asset_upload.Uploader, downloader *asset_download.Downloader, variants *asset_variant.Server, resumable *resumable.Manager, deleter *asset_delete.Deleter, quota *asset_quota.Checker, access *asset_access.Checker) Assets {
+	return Assets{uploader, downloader, variants, resumable, deleter, quota, access}
 }
 
 func (i *Assets) AssetGet(ctx context.Context, request openapi.AssetGetRequestObject) (openapi.AssetGetResponseObject, error) {
+	a, err := i.access.Authorise(ctx, asset.NewFilepathFilename(request.AssetFilename), opt.NewPtr(request.Params.Token))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	cacheControl := "public, max-age=31536000"
+	if a.Private {
+		cacheControl = fmt.Sprintf("private, max-age=%d", int(asset_access.URLLifespan.Seconds()))
+	}
+
+	etag := cachecontrol.NewResourceETag(
+		request.AssetFilename,
+		string(opt.NewPtr(request.Params.Variant).OrZero()),
+		strconv.Itoa(opt.NewPtr(request.Params.W).OrZero()),
+		string(opt.NewPtr(request.Params.Format).OrZero()),
+	)
+
+	if reqinfo.GetCacheQuery(ctx).MatchesETag(etag) {
+		return openapi.AssetGet304Response{
+			Headers: openapi.NotModifiedResponseHeaders{
+				CacheControl: cacheControl,
+				ETag:         etag,
+			},
+		}, nil
+	}
+
+	if request.Params.Variant != nil {
+		variant, mime := asset.VideoPosterVariant, "image/jpeg"
+		if *request.Params.Variant == openapi.AssetProcessedVariantStream {
+			variant, mime = asset.VideoStreamVariant, "video/mp4"
+		}
+
+		v, err := i.variants.GetProcessed(ctx, asset.NewFilepathFilename(request.AssetFilename), variant, mime)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return serialiseAssetVariant(v, cacheControl, etag), nil
+	}
+
+	if request.Params.W != nil || request.Params.Format != nil {
+		v, err := i.variants.Get(ctx, asset.NewFilepathFilename(request.AssetFilename), asset_variant.Options{
+			Width:  opt.NewPtr(request.Params.W),
+			Format: opt.NewPtrMap(request.Params.Format, func(f openapi.AssetImageFormat) imageproc.Format { return imageproc.Format(f) }),
+		})
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return serialiseAssetVariant(v, cacheControl, etag), nil
+	}
+
 	a, r, err := i.downloader.Get(ctx, asset.NewFilepathFilename(request.AssetFilename))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -39,12 +106,37 @@ func (i *Assets) AssetGet(ctx context.Context, request openapi.AssetGetRequestOb
 			ContentType:   a.MIME.String(),
 			ContentLength: int64(a.Size),
 			Headers: openapi.AssetGetOKResponseHeaders{
-				CacheControl: "public, max-age=31536000",
+				CacheControl: cacheControl,
+				ETag:         etag,
 			},
 		},
 	}, nil
 }
 
+func (i *Assets) AssetSignedURLCreate(ctx context.Context, request openapi.AssetSignedURLCreateRequestObject) (openapi.AssetSignedURLCreateResponseObject, error) {
+	signed, err := i.access.Sign(ctx, asset.NewFilepathFilename(request.AssetFilename))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetSignedURLCreate200JSONResponse{
+		AssetSignedURLOKJSONResponse: openapi.AssetSignedURLOKJSONResponse{
+			Url:       signed.URL,
+			Token:     signed.Token,
+			ExpiresAt: signed.ExpiresAt,
+		},
+	}, nil
+}
+
+func (i *Assets) AssetDelete(ctx context.Context, request openapi.AssetDeleteRequestObject) (openapi.AssetDeleteResponseObject, error) {
+	err := i.deleter.Delete(ctx, asset.NewFilepathFilename(request.AssetFilename))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetDelete204Response{}, nil
+}
+
 func (i *Assets) AssetUpload(ctx context.Context, request openapi.AssetUploadRequestObject) (openapi.AssetUploadResponseObject, error) {
 	// NOTE: This op doesn't run the authorisation validator for some reason.
 	if !session.GetOptAccountID(ctx).Ok() {
@@ -65,6 +157,7 @@ func (i *Assets) AssetUpload(ctx context.Context, request openapi.AssetUploadReq
 
 	opts := asset_upload.Options{
 		ParentID: parentID,
+		Private:  opt.NewPtr(request.Params.Private).OrZero(),
 	}
 
 	a, err := i.uploader.Upload(ctx, request.Body, request.Params.ContentLength, filename, opts)
@@ -77,6 +170,119 @@ func (i *Assets) AssetUpload(ctx context.Context, request openapi.AssetUploadReq
 	}, nil
 }
 
+func (i *Assets) AssetUsageGet(ctx context.Context, request openapi.AssetUsageGetRequestObject) (openapi.AssetUsageGetResponseObject, error) {
+	u, err := i.quota.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetUsageGet200JSONResponse{
+		AssetUsageGetOKJSONResponse: openapi.AssetUsageGetOKJSONResponse{
+			Used:   u.Bytes,
+			Assets: u.Assets,
+			Quota:  u.Quota.Ptr(),
+		},
+	}, nil
+}
+
+func (i *Assets) AssetUploadSessionCreate(ctx context.Context, request openapi.AssetUploadSessionCreateRequestObject) (openapi.AssetUploadSessionCreateResponseObject, error) {
+	filename := asset.NewFilename(opt.NewPtr(request.Body.Filename).Or("untitled"))
+
+	s, err := i.resumable.Create(ctx, filename, request.Body.Size, resumable.Options{
+		ParentID: opt.NewPtrMap(request.Body.ParentAssetId, deserialiseAssetID),
+		Private:  opt.NewPtr(request.Body.Private).OrZero(),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetUploadSessionCreate200JSONResponse{
+		AssetUploadSessionOKJSONResponse: openapi.AssetUploadSessionOKJSONResponse(serialiseUploadSession(s)),
+	}, nil
+}
+
+func (i *Assets) AssetUploadSessionGet(ctx context.Context, request openapi.AssetUploadSessionGetRequestObject) (openapi.AssetUploadSessionGetResponseObject, error) {
+	s, err := i.resumable.Get(ctx, upload_session.SessionID(deserialiseID(request.UploadId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetUploadSessionGet200JSONResponse{
+		AssetUploadSessionOKJSONResponse: openapi.AssetUploadSessionOKJSONResponse(serialiseUploadSession(s)),
+	}, nil
+}
+
+func (i *Assets) AssetUploadSessionAppend(ctx context.Context, request openapi.AssetUploadSessionAppendRequestObject) (openapi.AssetUploadSessionAppendResponseObject, error) {
+	// NOTE: Like AssetUpload, binary bodies skip the authorisation validator.
+	if !session.GetOptAccountID(ctx).Ok() {
+		return nil, fault.Wrap(fault.New("session required for upload", fctx.With(ctx)), fctx.With(ctx), ftag.With(ftag.Unauthenticated))
+	}
+
+	if err := session.Authorise(ctx, nil, rbac.PermissionUploadAsset); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.PermissionDenied))
+	}
+
+	s, err := i.resumable.Append(ctx,
+		upload_session.SessionID(deserialiseID(request.UploadId)),
+		request.Params.Offset,
+		request.Body,
+		request.Params.ContentLength,
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetUploadSessionAppend200JSONResponse{
+		AssetUploadSessionOKJSONResponse: openapi.AssetUploadSessionOKJSONResponse(serialiseUploadSession(s)),
+	}, nil
+}
+
+func (i *Assets) AssetUploadSessionCancel(ctx context.Context, request openapi.AssetUploadSessionCancelRequestObject) (openapi.AssetUploadSessionCancelResponseObject, error) {
+	err := i.resumable.Cancel(ctx, upload_session.SessionID(deserialiseID(request.UploadId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetUploadSessionCancel204Response{}, nil
+}
+
+func (i *Assets) AssetUploadSessionComplete(ctx context.Context, request openapi.AssetUploadSessionCompleteRequestObject) (openapi.AssetUploadSessionCompleteResponseObject, error) {
+	a, err := i.resumable.Complete(ctx, upload_session.SessionID(deserialiseID(request.UploadId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AssetUploadSessionComplete200JSONResponse{
+		AssetUploadOKJSONResponse: openapi.AssetUploadOKJSONResponse(serialiseAssetPtr(a)),
+	}, nil
+}
+
+func serialiseAssetVariant(v *asset_variant.Variant, cacheControl string, etag string) openapi.AssetGet200AsteriskResponse {
+	return openapi.AssetGet200AsteriskResponse{
+		AssetGetOKAsteriskResponse: openapi.AssetGetOKAsteriskResponse{
+			Body:          v.Body,
+			ContentType:   v.MIME,
+			ContentLength: v.Size,
+			Headers: openapi.AssetGetOKResponseHeaders{
+				CacheControl: cacheControl,
+				ETag:         etag,
+			},
+		},
+	}
+}
+
+func serialiseUploadSession(in *upload_session.Session) openapi.AssetUploadSession {
+	return openapi.AssetUploadSession{
+		Id:            in.ID.String(),
+		CreatedAt:     in.CreatedAt,
+		ExpiresAt:     in.ExpiresAt,
+		Filename:      in.Filename,
+		Size:          in.Size,
+		Received:      in.Received,
+		ParentAssetId: opt.Map(in.ParentID, func(id asset.AssetID) string { return id.String() }).Ptr(),
+	}
+}
+
 func serialiseAsset(a asset.Asset) openapi.Asset {
 	path := fmt.Sprintf(`/api/assets/%s`, a.Name.String())
 
@@ -88,7 +294,25 @@ func serialiseAsset(a asset.Asset) openapi.Asset {
 		MimeType: a.MIME.String(),
 		Width:    float32(a.Metadata.GetWidth()),
 		Height:   float32(a.Metadata.GetHeight()),
+		Duration: serialiseAssetDuration(a),
+		ProcessingStatus: opt.Map(a.Processing, func(ps asset.ProcessingStatus) openapi.AssetProcessingStatus {
+			return openapi.AssetProcessingStatus(ps.String())
+		}).Ptr(),
+		Private: &a.Private,
+		ScanStatus: opt.Map(a.Scan, func(ss asset.ScanStatus) openapi.AssetScanStatus {
+			return openapi.AssetScanStatus(ss.String())
+		}).Ptr(),
+		ScanSignature: a.ScanSignature.Ptr(),
+	}
+}
+
+func serialiseAssetDuration(a asset.Asset) *float32 {
+	d := a.Metadata.GetDuration()
+	if d == 0 {
+		return nil
 	}
+	v := float32(d)
+	return &v
 }
 
 func serialiseAssetPtr(a *asset.Asset) openapi.Asset {
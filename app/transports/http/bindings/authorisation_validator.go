@@ -14,16 +14,24 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/account/application_gate"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/moderation/warning_gate"
+	"github.com/Southclaws/storyden/app/services/policy/policy_gate"
+	"github.com/Southclaws/storyden/app/services/reputation/reputation_gate"
 	"github.com/Southclaws/storyden/app/transports/http/bindings/openapi_rbac"
 )
 
 type Authorisation struct {
-	accountQuery *account_querier.Querier
+	accountQuery    *account_querier.Querier
+	gate            *reputation_gate.Gate
+	warningGate     *warning_gate.Gate
+	applicationGate *application_gate.Gate
+	policyGate      *policy_gate.Gate
 }
 
-func newAuthorisation(aq *account_querier.Querier) *Authorisation {
-	return &Authorisation{accountQuery: aq}
+func newAuthorisation(aq *account_querier.Querier, gate *reputation_gate.Gate, warningGate *warning_gate.Gate, applicationGate *application_gate.Gate, policyGate *policy_gate.Gate) *Authorisation {
+	return &Authorisation{accountQuery: aq, gate: gate, warningGate: warningGate, applicationGate: applicationGate, policyGate: policyGate}
 }
 
 func (i *Authorisation) validator(oapictx context.Context, ai *openapi3filter.AuthenticationInput) error {
@@ -80,6 +88,12 @@ func (i *Authorisation) validator(oapictx context.Context, ai *openapi3filter.Au
 		if err := acc.RejectSuspended(); err != nil {
 			return fault.Wrap(err, fctx.With(ctx))
 		}
+
+		// Members awaiting approval may only use operations which need no
+		// specific permission, such as managing their own account.
+		if err := i.applicationGate.Check(ctx, acc.ID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
 	} else if sessionRequired {
 		// If the operation requires a session but no account is present, then
 		// reject with an Unauthenticated error which maps to 401 Unauthorized.
@@ -90,6 +104,22 @@ func (i *Authorisation) validator(oapictx context.Context, ai *openapi3filter.Au
 		return fault.New("required role not held", fctx.With(ctx), ftag.With(ftag.PermissionDenied))
 	}
 
+	// Administrators are never held back by reputation or warning thresholds,
+	// nor asked to accept policies before posting.
+	if ok && session.Authorise(ctx, nil, rbac.PermissionAdministrator) != nil {
+		if err := i.gate.Check(ctx, acc.ID, *perm); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := i.warningGate.Check(ctx, acc.ID, *perm); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := i.policyGate.Check(ctx, acc.ID, *perm); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	return nil
 }
 
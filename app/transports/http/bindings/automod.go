@@ -0,0 +1,150 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/automod"
+	"github.com/Southclaws/storyden/app/services/moderation/automod_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Automod struct {
+	automodManager *automod_manager.Manager
+}
+
+func NewAutomod(
+	automodManager *automod_manager.Manager,
+) Automod {
+	return Automod{
+		automodManager: automodManager,
+	}
+}
+
+func (h Automod) AdminAutomodRuleList(ctx context.Context, request openapi.AdminAutomodRuleListRequestObject) (openapi.AdminAutomodRuleListResponseObject, error) {
+	rules, err := h.automodManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAutomodRuleList200JSONResponse{
+		AdminAutomodRuleListOKJSONResponse: openapi.AdminAutomodRuleListOKJSONResponse{
+			Rules: dt.Map(rules, serialiseAutomodRule),
+		},
+	}, nil
+}
+
+func (h Automod) AdminAutomodRuleCreate(ctx context.Context, request openapi.AdminAutomodRuleCreateRequestObject) (openapi.AdminAutomodRuleCreateResponseObject, error) {
+	kind, err := automod.NewKind(string(request.Body.Kind))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	action, err := automod.NewAction(string(request.Body.Action))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	r, err := h.automodManager.Create(ctx, request.Body.Name, kind, action, automod_manager.Partial{
+		Pattern:   opt.NewPtr(request.Body.Pattern),
+		Words:     opt.NewPtr(request.Body.Words),
+		Threshold: opt.NewPtr(request.Body.Threshold),
+		Window:    opt.Map(opt.NewPtr(request.Body.WindowSeconds), deserialiseAutomodWindow),
+		Reason:    opt.NewPtr(request.Body.Reason),
+		Enabled:   opt.NewPtr(request.Body.Enabled),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAutomodRuleCreate200JSONResponse{
+		AdminAutomodRuleOKJSONResponse: openapi.AdminAutomodRuleOKJSONResponse(serialiseAutomodRule(r)),
+	}, nil
+}
+
+func (h Automod) AdminAutomodRuleGet(ctx context.Context, request openapi.AdminAutomodRuleGetRequestObject) (openapi.AdminAutomodRuleGetResponseObject, error) {
+	r, err := h.automodManager.Get(ctx, automod.RuleID(deserialiseID(request.AutomodRuleId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAutomodRuleGet200JSONResponse{
+		AdminAutomodRuleOKJSONResponse: openapi.AdminAutomodRuleOKJSONResponse(serialiseAutomodRule(r)),
+	}, nil
+}
+
+func (h Automod) AdminAutomodRuleUpdate(ctx context.Context, request openapi.AdminAutomodRuleUpdateRequestObject) (openapi.AdminAutomodRuleUpdateResponseObject, error) {
+	kind, err := opt.MapErr(opt.NewPtr(request.Body.Kind), func(k openapi.AutomodRuleKind) (automod.Kind, error) {
+		return automod.NewKind(string(k))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	action, err := opt.MapErr(opt.NewPtr(request.Body.Action), func(a openapi.AutomodRuleAction) (automod.Action, error) {
+		return automod.NewAction(string(a))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	r, err := h.automodManager.Update(ctx, automod.RuleID(deserialiseID(request.AutomodRuleId)), automod_manager.Partial{
+		Name:      opt.NewPtr(request.Body.Name),
+		Kind:      kind,
+		Action:    action,
+		Pattern:   opt.NewPtr(request.Body.Pattern),
+		Words:     opt.NewPtr(request.Body.Words),
+		Threshold: opt.NewPtr(request.Body.Threshold),
+		Window:    opt.Map(opt.NewPtr(request.Body.WindowSeconds), deserialiseAutomodWindow),
+		Reason:    opt.NewPtr(request.Body.Reason),
+		Enabled:   opt.NewPtr(request.Body.Enabled),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAutomodRuleUpdate200JSONResponse{
+		AdminAutomodRuleOKJSONResponse: openapi.AdminAutomodRuleOKJSONResponse(serialiseAutomodRule(r)),
+	}, nil
+}
+
+func (h Automod) AdminAutomodRuleDelete(ctx context.Context, request openapi.AdminAutomodRuleDeleteRequestObject) (openapi.AdminAutomodRuleDeleteResponseObject, error) {
+	err := h.automodManager.Delete(ctx, automod.RuleID(deserialiseID(request.AutomodRuleId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAutomodRuleDelete204Response{}, nil
+}
+
+func serialiseAutomodRule(in *automod.Rule) openapi.AutomodRule {
+	words := in.Words
+	if words == nil {
+		words = []string{}
+	}
+
+	return openapi.AutomodRule{
+		Id:            in.ID.String(),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Name:          in.Name,
+		Kind:          openapi.AutomodRuleKind(in.Kind.String()),
+		Action:        openapi.AutomodRuleAction(in.Action.String()),
+		Pattern:       in.Pattern.Ptr(),
+		Words:         words,
+		Threshold:     in.Threshold.Ptr(),
+		WindowSeconds: opt.Map(in.Window, func(d time.Duration) int { return int(d.Seconds()) }).Ptr(),
+		Reason:        in.Reason.Ptr(),
+		Enabled:       in.Enabled,
+	}
+}
+
+func deserialiseAutomodWindow(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,196 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/badge"
+	"github.com/Southclaws/storyden/app/resources/badge/badge_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/services/badge/badge_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/deletable"
+)
+
+type Badges struct {
+	profileQuery *profile_querier.Querier
+	badgeQuerier *badge_querier.Querier
+	badgeManager *badge_manager.Manager
+}
+
+func NewBadges(
+	profileQuery *profile_querier.Querier,
+	badgeQuerier *badge_querier.Querier,
+	badgeManager *badge_manager.Manager,
+) Badges {
+	return Badges{
+		profileQuery: profileQuery,
+		badgeQuerier: badgeQuerier,
+		badgeManager: badgeManager,
+	}
+}
+
+func (h Badges) BadgeList(ctx context.Context, request openapi.BadgeListRequestObject) (openapi.BadgeListResponseObject, error) {
+	badges, err := h.badgeQuerier.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BadgeList200JSONResponse{
+		BadgeListOKJSONResponse: openapi.BadgeListOKJSONResponse{
+			Badges: dt.Map(badges, serialiseBadge),
+		},
+	}, nil
+}
+
+func (h Badges) BadgeCreate(ctx context.Context, request openapi.BadgeCreateRequestObject) (openapi.BadgeCreateResponseObject, error) {
+	rule, err := opt.MapErr(opt.NewPtr(request.Body.Rule), deserialiseBadgeRule)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	b, err := h.badgeManager.Create(ctx, request.Body.Name, request.Body.Description, badge_manager.Partial{
+		Icon: deletable.Skip(opt.NewPtr(request.Body.Icon)),
+		Rule: deletable.Skip(rule),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BadgeCreate200JSONResponse{
+		BadgeCreateOKJSONResponse: openapi.BadgeCreateOKJSONResponse(serialiseBadge(b)),
+	}, nil
+}
+
+func (h Badges) BadgeUpdate(ctx context.Context, request openapi.BadgeUpdateRequestObject) (openapi.BadgeUpdateResponseObject, error) {
+	rule, err := deletable.NewMapErr(request.Body.Rule, deserialiseBadgeRule)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	b, err := h.badgeManager.Update(ctx, badge.BadgeID(deserialiseID(request.BadgeId)), badge_manager.Partial{
+		Name:        opt.NewPtr(request.Body.Name),
+		Description: opt.NewPtr(request.Body.Description),
+		Icon:        deletable.New(request.Body.Icon),
+		Rule:        rule,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BadgeUpdate200JSONResponse{
+		BadgeUpdateOKJSONResponse: openapi.BadgeUpdateOKJSONResponse(serialiseBadge(b)),
+	}, nil
+}
+
+func (h Badges) BadgeDelete(ctx context.Context, request openapi.BadgeDeleteRequestObject) (openapi.BadgeDeleteResponseObject, error) {
+	err := h.badgeManager.Delete(ctx, badge.BadgeID(deserialiseID(request.BadgeId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BadgeDelete200Response{}, nil
+}
+
+func (h Badges) ProfileBadgeList(ctx context.Context, request openapi.ProfileBadgeListRequestObject) (openapi.ProfileBadgeListResponseObject, error) {
+	accountID, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	awards, err := h.badgeQuerier.ListAwarded(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileBadgeList200JSONResponse{
+		ProfileBadgeListOKJSONResponse: openapi.ProfileBadgeListOKJSONResponse{
+			Badges: dt.Map(awards, serialiseBadgeAward),
+		},
+	}, nil
+}
+
+func (h Badges) ProfileBadgeAward(ctx context.Context, request openapi.ProfileBadgeAwardRequestObject) (openapi.ProfileBadgeAwardResponseObject, error) {
+	accountID, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	awards, err := h.badgeManager.Award(ctx, accountID, badge.BadgeID(deserialiseID(request.BadgeId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileBadgeAward200JSONResponse{
+		ProfileBadgeListOKJSONResponse: openapi.ProfileBadgeListOKJSONResponse{
+			Badges: dt.Map(awards, serialiseBadgeAward),
+		},
+	}, nil
+}
+
+func (h Badges) ProfileBadgeRevoke(ctx context.Context, request openapi.ProfileBadgeRevokeRequestObject) (openapi.ProfileBadgeRevokeResponseObject, error) {
+	accountID, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	awards, err := h.badgeManager.Revoke(ctx, accountID, badge.BadgeID(deserialiseID(request.BadgeId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileBadgeRevoke200JSONResponse{
+		ProfileBadgeListOKJSONResponse: openapi.ProfileBadgeListOKJSONResponse{
+			Badges: dt.Map(awards, serialiseBadgeAward),
+		},
+	}, nil
+}
+
+func serialiseBadge(in *badge.Badge) openapi.Badge {
+	return openapi.Badge{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Name:        in.Name,
+		Description: in.Description,
+		Icon:        in.Icon.Ptr(),
+		Rule:        opt.Map(in.Rule, serialiseBadgeRule).Ptr(),
+	}
+}
+
+func serialiseBadgeAward(in *badge.Award) openapi.BadgeAward {
+	return openapi.BadgeAward{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Name:        in.Name,
+		Description: in.Description,
+		Icon:        in.Icon.Ptr(),
+		Rule:        opt.Map(in.Rule, serialiseBadgeRule).Ptr(),
+		AwardedAt:   in.AwardedAt,
+	}
+}
+
+func serialiseBadgeRule(in badge.Rule) openapi.BadgeRule {
+	return openapi.BadgeRule{
+		Trigger:   openapi.BadgeTrigger(in.Trigger.String()),
+		Threshold: in.Threshold,
+	}
+}
+
+func deserialiseBadgeRule(in openapi.BadgeRule) (badge.Rule, error) {
+	trigger, err := badge.NewTrigger(string(in.Trigger))
+	if err != nil {
+		return badge.Rule{}, err
+	}
+
+	return badge.Rule{
+		Trigger:   trigger,
+		Threshold: in.Threshold,
+	}, nil
+}
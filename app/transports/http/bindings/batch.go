@@ -0,0 +1,121 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/collection"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/services/batch"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Batch struct {
+	batcher *batch.Batcher
+}
+
+func NewBatch(batcher *batch.Batcher) Batch {
+	return Batch{batcher: batcher}
+}
+
+func (h Batch) BatchPostDelete(ctx context.Context, request openapi.BatchPostDeleteRequestObject) (openapi.BatchPostDeleteResponseObject, error) {
+	result, err := h.batcher.DeletePosts(ctx, deserialisePostIDs(request.Body.PostIds))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !result.Applied {
+		return openapi.BatchPostDelete422JSONResponse{
+			BatchFailedJSONResponse: openapi.BatchFailedJSONResponse(serialiseBatchResult(ctx, result)),
+		}, nil
+	}
+
+	return openapi.BatchPostDelete200JSONResponse{
+		BatchOKJSONResponse: openapi.BatchOKJSONResponse(serialiseBatchResult(ctx, result)),
+	}, nil
+}
+
+func (h Batch) BatchCollectionItemAdd(ctx context.Context, request openapi.BatchCollectionItemAddRequestObject) (openapi.BatchCollectionItemAddResponseObject, error) {
+	posts := deserialisePostIDs(opt.NewPtr(request.Body.PostIds).OrZero())
+	nodes := dt.Map(opt.NewPtr(request.Body.NodeIds).OrZero(), func(id openapi.Identifier) library.NodeID {
+		return library.NodeID(deserialiseID(id))
+	})
+
+	result, err := h.batcher.AddToCollection(ctx, collection.NewKey(request.CollectionMark), posts, nodes)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !result.Applied {
+		return openapi.BatchCollectionItemAdd422JSONResponse{
+			BatchFailedJSONResponse: openapi.BatchFailedJSONResponse(serialiseBatchResult(ctx, result)),
+		}, nil
+	}
+
+	return openapi.BatchCollectionItemAdd200JSONResponse{
+		BatchOKJSONResponse: openapi.BatchOKJSONResponse(serialiseBatchResult(ctx, result)),
+	}, nil
+}
+
+func (h Batch) BatchNodeTagAdd(ctx context.Context, request openapi.BatchNodeTagAddRequestObject) (openapi.BatchNodeTagAddResponseObject, error) {
+	nodes := dt.Map(request.Body.NodeIds, func(id openapi.Identifier) library.NodeID {
+		return library.NodeID(deserialiseID(id))
+	})
+
+	result, err := h.batcher.TagNodes(ctx, nodes, dt.Map(request.Body.Tags, deserialiseTagName))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !result.Applied {
+		return openapi.BatchNodeTagAdd422JSONResponse{
+			BatchFailedJSONResponse: openapi.BatchFailedJSONResponse(serialiseBatchResult(ctx, result)),
+		}, nil
+	}
+
+	return openapi.BatchNodeTagAdd200JSONResponse{
+		BatchOKJSONResponse: openapi.BatchOKJSONResponse(serialiseBatchResult(ctx, result)),
+	}, nil
+}
+
+func deserialisePostIDs(in []openapi.Identifier) []post.ID {
+	return dt.Map(in, func(id openapi.Identifier) post.ID {
+		return post.ID(deserialiseID(id))
+	})
+}
+
+func serialiseBatchResult(ctx context.Context, in *batch.Result) openapi.BatchResult {
+	return openapi.BatchResult{
+		Applied: in.Applied,
+		Items: dt.Map(in.Items, func(i batch.ItemResult) openapi.BatchItemResult {
+			return serialiseBatchItemResult(ctx, i)
+		}),
+	}
+}
+
+func serialiseBatchItemResult(ctx context.Context, in batch.ItemResult) openapi.BatchItemResult {
+	err, failed := in.Error.Get()
+	if !failed {
+		return openapi.BatchItemResult{
+			Id: in.ID.String(),
+			Ok: true,
+		}
+	}
+
+	message := openapi.Issue(ctx, err)
+
+	return openapi.BatchItemResult{
+		Id:     in.ID.String(),
+		Ok:     false,
+		Status: opt.New(openapi.ErrorStatus(err)).Ptr(),
+		Error: &openapi.APIError{
+			Error:   err.Error(),
+			Message: opt.NewIf(message, func(s string) bool { return s != "" }).Ptr(),
+		},
+	}
+}
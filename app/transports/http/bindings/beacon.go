@@ -4,31 +4,37 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
+	"time"
 
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+	"github.com/Southclaws/storyden/app/services/view_counter"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
 type Beacon struct {
-	logger *slog.Logger
-	bus    *pubsub.Bus
+	logger  *slog.Logger
+	bus     *pubsub.Bus
+	counter *view_counter.Counter
 }
 
-func NewBeacon(logger *slog.Logger, bus *pubsub.Bus) Beacon {
+func NewBeacon(logger *slog.Logger, bus *pubsub.Bus, counter *view_counter.Counter) Beacon {
 	return Beacon{
-		logger: logger,
-		bus:    bus,
+		logger:  logger,
+		bus:     bus,
+		counter: counter,
 	}
 }
 
 type Message struct {
-	Kind datagraph.Kind `json:"k"`
-	ID   xid.ID         `json:"id"`
+	Kind     datagraph.Kind `json:"k"`
+	ID       xid.ID         `json:"id"`
+	Position string         `json:"p"`
 }
 
 // NOTE: Does not handle errors or return anything other than 202.
@@ -57,12 +63,21 @@ func (b *Beacon) SendBeacon(ctx context.Context, request openapi.SendBeaconReque
 		return openapi.SendBeacon202Response{}, nil
 	}
 
+	switch m.Kind {
+	case datagraph.KindThread, datagraph.KindNode:
+		if !reqinfo.IsBot(ctx) {
+			viewer := view_counter.Viewer(accountID, reqinfo.GetClientAddress(ctx), reqinfo.GetUserAgent(ctx))
+			b.counter.Record(m.ID, viewer, time.Now())
+		}
+	}
+
 	if err := b.bus.SendCommand(ctx, &message.CommandSendBeacon{
 		Item: datagraph.Ref{
 			Kind: m.Kind,
 			ID:   m.ID,
 		},
-		Subject: accountID,
+		Subject:  accountID,
+		Position: m.Position,
 	}); err != nil {
 		log.Error("failed to send beacon command", slog.String("error", err.Error()))
 	}
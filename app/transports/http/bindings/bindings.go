@@ -42,6 +42,9 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/transports/http/middleware/idempotency"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/replica"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqmetrics"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
 
@@ -65,15 +68,45 @@ type Bindings struct {
 	Info
 	Beacon
 	Admin
+	AdminEvents
+	AdminAnalytics
+	ContentViews
 	Roles
 	Authentication
 	WebAuthn
 	PhoneAuth
 	Accounts
 	Invitations
+	Applications
+	Verifications
+	Bots
+	OnboardingChecklist
+	Policies
+	FeatureFlags
 	Notifications
+	Conversations
+	Spaces
 	Reports
+	Moderation
+	Warnings
+	Trash
 	Profiles
+	Blocks
+	Badges
+	Webhooks
+	Hooks
+	Matrix
+	Telegram
+	Jobs
+	Imports
+	ScheduledTasks
+	Automod
+	WordFilters
+	NetworkBans
+	Tenants
+	Feeds
+	Calendars
+	SEO
 	Categories
 	Tags
 	Posts
@@ -82,11 +115,13 @@ type Bindings struct {
 	Reacts
 	Assets
 	Likes
+	Bookmarks
 	Collections
 	Nodes
 	Links
 	Datagraph
 	Events
+	Batch
 }
 
 // bindingsProviders provides to the application the necessary implementations
@@ -99,15 +134,45 @@ func bindingsProviders() fx.Option {
 		NewInfo,
 		NewBeacon,
 		NewAdmin,
+		NewAdminEvents,
+		NewAdminAnalytics,
+		NewContentViews,
 		NewRoles,
 		NewAuthentication,
 		NewWebAuthn,
 		NewPhoneAuth,
 		NewAccounts,
 		NewInvitations,
+		NewApplications,
+		NewVerifications,
+		NewBots,
+		NewOnboardingChecklist,
+		NewPolicies,
+		NewFeatureFlags,
 		NewNotifications,
+		NewConversations,
+		NewSpaces,
 		NewReports,
+		NewModeration,
+		NewWarnings,
+		NewTrash,
 		NewProfiles,
+		NewBlocks,
+		NewBadges,
+		NewWebhooks,
+		NewHooks,
+		NewMatrix,
+		NewTelegram,
+		NewJobs,
+		NewImports,
+		NewScheduledTasks,
+		NewAutomod,
+		NewWordFilters,
+		NewNetworkBans,
+		NewTenants,
+		NewFeeds,
+		NewCalendars,
+		NewSEO,
 		NewCategories,
 		NewTags,
 		NewPosts,
@@ -116,11 +181,13 @@ func bindingsProviders() fx.Option {
 		NewReacts,
 		NewAssets,
 		NewLikes,
+		NewBookmarks,
 		NewCollections,
 		NewNodes,
 		NewLinks,
 		NewDatagraph,
 		NewEvents,
+		NewBatch,
 	)
 }
 
@@ -155,6 +222,9 @@ func mount(
 	router *echo.Echo,
 	auth *Authorisation,
 	si openapi.StrictServerInterface,
+	rm *reqmetrics.Middleware,
+	rw *replica.Middleware,
+	im *idempotency.Middleware,
 ) error {
 	spec, err := openapi.GetSwagger()
 	if err != nil {
@@ -200,8 +270,11 @@ func mount(
 	openapi.RegisterHandlersWithBaseURL(router, openapi.NewStrictHandler(si, nil), apiPathPrefix)
 
 	router.Use(
+		rm.WithMetrics(),
+		rw.WithReadYourWrites(),
 		requestValidatorMiddleware,
 		openapi.ParameterContext,
+		im.WithIdempotency(),
 	)
 
 	logger.Debug("mounted OpenAPI to service bindings",
@@ -0,0 +1,83 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/profile/block"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/services/profile/blocking"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Blocks struct {
+	profileQuery *profile_querier.Querier
+	blockManager *blocking.BlockManager
+}
+
+func NewBlocks(
+	profileQuery *profile_querier.Querier,
+	blockManager *blocking.BlockManager,
+) Blocks {
+	return Blocks{
+		profileQuery: profileQuery,
+		blockManager: blockManager,
+	}
+}
+
+func (h Blocks) AccountBlockList(ctx context.Context, request openapi.AccountBlockListRequestObject) (openapi.AccountBlockListResponseObject, error) {
+	blocks, err := h.blockManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountBlockList200JSONResponse{
+		AccountBlockListOKJSONResponse: openapi.AccountBlockListOKJSONResponse{
+			Blocks: dt.Map(blocks, serialiseAccountBlock),
+		},
+	}, nil
+}
+
+func (h Blocks) ProfileBlockSet(ctx context.Context, request openapi.ProfileBlockSetRequestObject) (openapi.ProfileBlockSetResponseObject, error) {
+	targetID, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	kind, err := block.NewKind(string(request.Body.Kind))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	if err := h.blockManager.Set(ctx, targetID, kind); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileBlockSet204Response{}, nil
+}
+
+func (h Blocks) ProfileBlockRemove(ctx context.Context, request openapi.ProfileBlockRemoveRequestObject) (openapi.ProfileBlockRemoveResponseObject, error) {
+	targetID, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := h.blockManager.Remove(ctx, targetID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileBlockRemove204Response{}, nil
+}
+
+func serialiseAccountBlock(in *block.Block) openapi.AccountBlock {
+	return openapi.AccountBlock{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		Kind:      openapi.BlockKind(in.Kind.String()),
+		Profile:   serialiseProfileReference(in.Target),
+	}
+}
@@ -0,0 +1,118 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/bookmark"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/bookmark/bookmarker"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Bookmarks struct {
+	bookmarker *bookmarker.Bookmarker
+}
+
+func NewBookmarks(bookmarker *bookmarker.Bookmarker) Bookmarks {
+	return Bookmarks{bookmarker: bookmarker}
+}
+
+func (h *Bookmarks) BookmarkList(ctx context.Context, request openapi.BookmarkListRequestObject) (openapi.BookmarkListResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	kinds := []datagraph.Kind{}
+	if request.Params.Kind != nil {
+		kind, err := datagraph.NewKind(string(*request.Params.Kind))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		kinds = append(kinds, kind)
+	}
+
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	result, err := h.bookmarker.List(ctx, accountID, page, kinds...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BookmarkList200JSONResponse{
+		BookmarkListOKJSONResponse: openapi.BookmarkListOKJSONResponse(serialiseBookmarkList(result)),
+	}, nil
+}
+
+func (h *Bookmarks) BookmarkAdd(ctx context.Context, request openapi.BookmarkAddRequestObject) (openapi.BookmarkAddResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	kind, err := datagraph.NewKind(string(request.Body.Kind))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	target := datagraph.Ref{
+		ID:   deserialiseID(request.BookmarkItemId),
+		Kind: kind,
+	}
+
+	bm, err := h.bookmarker.Add(ctx, accountID, target, opt.NewPtr(request.Body.Note))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BookmarkAdd200JSONResponse{
+		BookmarkAddOKJSONResponse: openapi.BookmarkAddOKJSONResponse(serialiseBookmark(bm)),
+	}, nil
+}
+
+func (h *Bookmarks) BookmarkRemove(ctx context.Context, request openapi.BookmarkRemoveRequestObject) (openapi.BookmarkRemoveResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = h.bookmarker.Remove(ctx, accountID, deserialiseID(request.BookmarkItemId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BookmarkRemove204Response{}, nil
+}
+
+func serialiseBookmark(in *bookmark.Bookmark) openapi.Bookmark {
+	item := opt.Map(opt.NewSafe(in.Item, in.Item != nil), serialiseDatagraphItem)
+
+	return openapi.Bookmark{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		ItemId:    in.Target.ID.String(),
+		ItemKind:  openapi.DatagraphItemKind(in.Target.Kind.String()),
+		Item:      item.Ptr(),
+		Note:      in.Note.Ptr(),
+	}
+}
+
+func serialiseBookmarkList(in pagination.Result[*bookmark.Bookmark]) openapi.BookmarkListResult {
+	return openapi.BookmarkListResult{
+		Bookmarks:   dt.Map(in.Items, serialiseBookmark),
+		CurrentPage: in.CurrentPage,
+		NextPage:    in.NextPage.Ptr(),
+		PageSize:    in.Size,
+		Results:     in.Results,
+		TotalPages:  in.TotalPages,
+	}
+}
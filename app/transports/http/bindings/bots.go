@@ -0,0 +1,145 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account/bot"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/services/account/bot_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Bots struct {
+	botManager *bot_manager.Manager
+}
+
+func NewBots(
+	botManager *bot_manager.Manager,
+) Bots {
+	return Bots{
+		botManager: botManager,
+	}
+}
+
+func (h Bots) BotList(ctx context.Context, request openapi.BotListRequestObject) (openapi.BotListResponseObject, error) {
+	bots, err := h.botManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotList200JSONResponse{
+		BotListOKJSONResponse: openapi.BotListOKJSONResponse{
+			Bots: dt.Map(bots, serialiseBot),
+		},
+	}, nil
+}
+
+func (h Bots) BotCreate(ctx context.Context, request openapi.BotCreateRequestObject) (openapi.BotCreateResponseObject, error) {
+	bio, err := opt.MapErr(opt.NewPtr(request.Body.Bio), datagraph.NewRichText)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	b, err := h.botManager.Create(ctx, request.Body.Handle, opt.NewPtr(request.Body.Name), bio)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotCreate200JSONResponse{
+		BotOKJSONResponse: openapi.BotOKJSONResponse(serialiseBot(b)),
+	}, nil
+}
+
+func (h Bots) BotGet(ctx context.Context, request openapi.BotGetRequestObject) (openapi.BotGetResponseObject, error) {
+	b, err := h.botManager.Get(ctx, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotGet200JSONResponse{
+		BotOKJSONResponse: openapi.BotOKJSONResponse(serialiseBot(b)),
+	}, nil
+}
+
+func (h Bots) BotUpdate(ctx context.Context, request openapi.BotUpdateRequestObject) (openapi.BotUpdateResponseObject, error) {
+	bio, err := opt.MapErr(opt.NewPtr(request.Body.Bio), datagraph.NewRichText)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	b, err := h.botManager.Update(ctx, request.AccountHandle, bot_manager.Partial{
+		Handle: opt.NewPtr(request.Body.Handle),
+		Name:   opt.NewPtr(request.Body.Name),
+		Bio:    bio,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotUpdate200JSONResponse{
+		BotOKJSONResponse: openapi.BotOKJSONResponse(serialiseBot(b)),
+	}, nil
+}
+
+func (h Bots) BotDelete(ctx context.Context, request openapi.BotDeleteRequestObject) (openapi.BotDeleteResponseObject, error) {
+	if err := h.botManager.Delete(ctx, request.AccountHandle); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotDelete204Response{}, nil
+}
+
+func (h Bots) BotAccessKeyList(ctx context.Context, request openapi.BotAccessKeyListRequestObject) (openapi.BotAccessKeyListResponseObject, error) {
+	list, err := h.botManager.ListAccessKeys(ctx, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotAccessKeyList200JSONResponse{
+		AccessKeyListOKJSONResponse: openapi.AccessKeyListOKJSONResponse{
+			Keys: serialiseAccessKeyList(list),
+		},
+	}, nil
+}
+
+func (h Bots) BotAccessKeyCreate(ctx context.Context, request openapi.BotAccessKeyCreateRequestObject) (openapi.BotAccessKeyCreateResponseObject, error) {
+	aks, err := h.botManager.CreateAccessKey(ctx, request.AccountHandle, request.Body.Name, opt.NewPtr(request.Body.ExpiresAt))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotAccessKeyCreate200JSONResponse{
+		AccessKeyCreateOKJSONResponse: openapi.AccessKeyCreateOKJSONResponse(openapi.AccessKeyIssued{
+			Id:        openapi.Identifier(aks.AuthID.String()),
+			CreatedAt: aks.CreatedAt,
+			ExpiresAt: aks.Expires.Ptr(),
+			Name:      aks.Name,
+			Secret:    aks.String(),
+		}),
+	}, nil
+}
+
+func (h Bots) BotAccessKeyDelete(ctx context.Context, request openapi.BotAccessKeyDeleteRequestObject) (openapi.BotAccessKeyDeleteResponseObject, error) {
+	if err := h.botManager.RevokeAccessKey(ctx, request.AccountHandle, deserialiseID(request.AccessKeyId)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.BotAccessKeyDelete204Response{}, nil
+}
+
+func serialiseBot(in *bot.Bot) openapi.Bot {
+	return openapi.Bot{
+		Id:     openapi.Identifier(in.Account.ID.String()),
+		Joined: in.Account.CreatedAt,
+		Handle: in.Account.Handle,
+		Name:   in.Account.Name,
+		Bio:    in.Account.Bio.HTML(),
+		Owner:  opt.Map(in.Owner, serialiseProfileReferenceFromAccount).Ptr(),
+	}
+}
@@ -0,0 +1,91 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/services/event/event_calendar"
+	"github.com/Southclaws/storyden/app/services/syndication"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Calendars struct {
+	syndicator *syndication.Syndicator
+	calendar   *event_calendar.Calendar
+}
+
+func NewCalendars(syndicator *syndication.Syndicator, calendar *event_calendar.Calendar) Calendars {
+	return Calendars{
+		syndicator: syndicator,
+		calendar:   calendar,
+	}
+}
+
+func (h *Calendars) CalendarGet(ctx context.Context, request openapi.CalendarGetRequestObject) (openapi.CalendarGetResponseObject, error) {
+	if _, err := h.syndicator.Authorise(ctx, opt.NewPtr(request.Params.Token)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	doc, err := h.calendar.Events(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, request.Params.Token != nil)
+	if r.notModified {
+		return openapi.CalendarGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.CalendarGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
+
+func (h *Calendars) CalendarParticipatingGet(ctx context.Context, request openapi.CalendarParticipatingGetRequestObject) (openapi.CalendarParticipatingGetResponseObject, error) {
+	if request.Params.Token == nil {
+		return nil, fault.New("participating calendar requires a token", fctx.With(ctx), ftag.With(ftag.Unauthenticated))
+	}
+
+	viewer, err := h.syndicator.Authorise(ctx, opt.NewPtr(request.Params.Token))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accountID, ok := viewer.Get()
+	if !ok {
+		return nil, fault.New("participating calendar requires a token", fctx.With(ctx), ftag.With(ftag.Unauthenticated))
+	}
+
+	doc, err := h.calendar.Participating(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, true)
+	if r.notModified {
+		return openapi.CalendarParticipatingGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.CalendarParticipatingGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
+
+func (h *Calendars) EventCalendarGet(ctx context.Context, request openapi.EventCalendarGetRequestObject) (openapi.EventCalendarGetResponseObject, error) {
+	if _, err := h.syndicator.Authorise(ctx, opt.NewPtr(request.Params.Token)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	doc, err := h.calendar.Event(ctx, event_ref.NewKey(request.EventMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, request.Params.Token != nil)
+	if r.notModified {
+		return openapi.EventCalendarGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.EventCalendarGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
@@ -7,12 +7,17 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/post/category"
 	"github.com/Southclaws/storyden/app/resources/post/category_cache"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
 	category_svc "github.com/Southclaws/storyden/app/services/category"
+	"github.com/Southclaws/storyden/app/services/profile/following"
 	"github.com/Southclaws/storyden/app/services/reqinfo"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 	"github.com/Southclaws/storyden/internal/deletable"
@@ -22,14 +27,16 @@ type Categories struct {
 	category_repo  *category.Repository
 	category_svc   category_svc.Service
 	category_cache *category_cache.Cache
+	followManager  *following.FollowManager
 }
 
 func NewCategories(
 	category_repo *category.Repository,
 	category_svc category_svc.Service,
 	category_cache *category_cache.Cache,
+	followManager *following.FollowManager,
 ) Categories {
-	return Categories{category_repo, category_svc, category_cache}
+	return Categories{category_repo, category_svc, category_cache, followManager}
 }
 
 func (c Categories) CategoryCreate(ctx context.Context, request openapi.CategoryCreateRequestObject) (openapi.CategoryCreateResponseObject, error) {
@@ -44,6 +51,10 @@ func (c Categories) CategoryCreate(ctx context.Context, request openapi.Category
 		coverImageAssetID = deletable.Skip(opt.New(&xidValue))
 	}
 
+	requiredTags := opt.Map(opt.NewPtr(request.Body.RequiredTags), func(tags openapi.TagNameList) tag_ref.Names {
+		return dt.Map(tags, deserialiseTagName)
+	})
+
 	cat, err := c.category_svc.Create(ctx, category_svc.Partial{
 		Name:              opt.New(request.Body.Name),
 		Slug:              opt.NewPtr(request.Body.Slug),
@@ -52,6 +63,9 @@ func (c Categories) CategoryCreate(ctx context.Context, request openapi.Category
 		Parent:            parentID,
 		CoverImageAssetID: coverImageAssetID,
 		Meta:              opt.NewPtr((*map[string]any)(request.Body.Meta)),
+		OpensAt:           deletable.Skip(opt.NewPtr(request.Body.OpensAt)),
+		ClosesAt:          deletable.Skip(opt.NewPtr(request.Body.ClosesAt)),
+		RequiredTags:      requiredTags,
 	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -68,6 +82,8 @@ func (c Categories) CategoryList(ctx context.Context, request openapi.CategoryLi
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	cats = category.FilterReadable(cats, session.GetRoles(ctx))
+
 	return openapi.CategoryList200JSONResponse{
 		CategoryListOKJSONResponse: openapi.CategoryListOKJSONResponse{
 			Categories: dt.Map(cats, serialiseCategory),
@@ -97,7 +113,7 @@ func (c Categories) CategoryGet(ctx context.Context, request openapi.CategoryGet
 		}, nil
 	}
 
-	cat, err := c.category_repo.Get(ctx, request.CategorySlug)
+	cat, err := c.getReadable(ctx, request.CategorySlug)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -160,6 +176,10 @@ func (c Categories) CategoryUpdate(ctx context.Context, request openapi.Category
 		return &xidValue
 	})
 
+	requiredTags := opt.Map(opt.NewPtr(request.Body.RequiredTags), func(tags openapi.TagNameList) tag_ref.Names {
+		return dt.Map(tags, deserialiseTagName)
+	})
+
 	cat, err := c.category_svc.Update(ctx, request.CategorySlug, category_svc.Partial{
 		Name:              opt.NewPtr(request.Body.Name),
 		Slug:              opt.NewPtr(request.Body.Slug),
@@ -167,6 +187,10 @@ func (c Categories) CategoryUpdate(ctx context.Context, request openapi.Category
 		Colour:            opt.NewPtr(request.Body.Colour),
 		CoverImageAssetID: coverImageAssetID,
 		Meta:              opt.NewPtr((*map[string]any)(request.Body.Meta)),
+		Archived:          opt.NewPtr(request.Body.Archived),
+		OpensAt:           deletable.New(request.Body.OpensAt),
+		ClosesAt:          deletable.New(request.Body.ClosesAt),
+		RequiredTags:      requiredTags,
 	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -190,6 +214,103 @@ func (c Categories) CategoryDelete(ctx context.Context, request openapi.Category
 	}, nil
 }
 
+func (c Categories) CategoryFollowersAdd(ctx context.Context, request openapi.CategoryFollowersAddRequestObject) (openapi.CategoryFollowersAddResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := c.getReadable(ctx, request.CategorySlug); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = c.followManager.FollowCategory(ctx, accountID, request.CategorySlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CategoryFollowersAdd200Response{}, nil
+}
+
+func (c Categories) CategoryFollowersRemove(ctx context.Context, request openapi.CategoryFollowersRemoveRequestObject) (openapi.CategoryFollowersRemoveResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = c.followManager.UnfollowCategory(ctx, accountID, request.CategorySlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CategoryFollowersRemove200Response{}, nil
+}
+
+func (c Categories) CategoryPermissionsGet(ctx context.Context, request openapi.CategoryPermissionsGetRequestObject) (openapi.CategoryPermissionsGetResponseObject, error) {
+	perms, err := c.category_repo.GetPermissions(ctx, request.CategorySlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CategoryPermissionsGet200JSONResponse{
+		CategoryPermissionsOKJSONResponse: openapi.CategoryPermissionsOKJSONResponse(serialiseCategoryPermissions(perms)),
+	}, nil
+}
+
+func (c Categories) CategoryPermissionsUpdate(ctx context.Context, request openapi.CategoryPermissionsUpdateRequestObject) (openapi.CategoryPermissionsUpdateResponseObject, error) {
+	perms := dt.Map(request.Body.Roles, func(in openapi.CategoryRolePermission) category.RolePermission {
+		return category.RolePermission{
+			RoleID:       role.RoleID(deserialiseID(in.RoleId)),
+			Read:         in.Read,
+			CreateThread: in.CreateThread,
+			Reply:        in.Reply,
+			Attach:       in.Attach,
+		}
+	})
+
+	updated, err := c.category_svc.SetPermissions(ctx, request.CategorySlug, perms)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CategoryPermissionsUpdate200JSONResponse{
+		CategoryPermissionsOKJSONResponse: openapi.CategoryPermissionsOKJSONResponse(serialiseCategoryPermissions(updated)),
+	}, nil
+}
+
+// getReadable hides categories the session's roles can't read as if they did
+// not exist.
+func (c Categories) getReadable(ctx context.Context, slug string) (*category.Category, error) {
+	cat, err := c.category_repo.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	roles := session.GetRoles(ctx)
+
+	if !cat.Permissions.CanRead(roles) {
+		return nil, fault.New("category not readable", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	cat.Children = category.FilterReadable(cat.Children, roles)
+
+	return cat, nil
+}
+
+func serialiseCategoryPermissions(perms category.Permissions) openapi.CategoryPermissions {
+	return openapi.CategoryPermissions{
+		Roles: dt.Map(perms, func(rp category.RolePermission) openapi.CategoryRolePermission {
+			return openapi.CategoryRolePermission{
+				RoleId:       rp.RoleID.String(),
+				Read:         rp.Read,
+				CreateThread: rp.CreateThread,
+				Reply:        rp.Reply,
+				Attach:       rp.Attach,
+			}
+		}),
+	}
+}
+
 func serialiseCategory(c *category.Category) openapi.Category {
 	var parentID *openapi.NullableIdentifier
 	if c.ParentID != nil {
@@ -200,20 +321,34 @@ func serialiseCategory(c *category.Category) openapi.Category {
 	children := dt.Map(c.Children, serialiseCategory)
 
 	return openapi.Category{
-		Id:          *openapi.IdentifierFrom(xid.ID(c.ID)),
-		Name:        c.Name,
-		Slug:        c.Slug,
-		Colour:      c.Colour,
-		Description: c.Description,
-		PostCount:   c.PostCount,
-		Sort:        c.Sort,
-		Parent:      parentID,
-		CoverImage:  opt.Map(c.CoverImage, serialiseAsset).Ptr(),
-		Children:    children,
-		Meta:        (*openapi.Metadata)(&c.Metadata),
+		Id:           *openapi.IdentifierFrom(xid.ID(c.ID)),
+		Name:         c.Name,
+		Slug:         c.Slug,
+		Colour:       c.Colour,
+		Description:  c.Description,
+		PostCount:    c.PostCount,
+		Sort:         c.Sort,
+		Parent:       parentID,
+		CoverImage:   opt.Map(c.CoverImage, serialiseAsset).Ptr(),
+		Children:     children,
+		Meta:         (*openapi.Metadata)(&c.Metadata),
+		State:        openapi.CategoryState(c.State(time.Now()).String()),
+		ArchivedAt:   c.ArchivedAt.Ptr(),
+		OpensAt:      c.OpensAt.Ptr(),
+		ClosesAt:     c.ClosesAt.Ptr(),
+		RequiredTags: serialiseRequiredTags(c.RequiredTags),
 	}
 }
 
+func serialiseRequiredTags(in tag_ref.Names) *openapi.TagNameList {
+	if len(in) == 0 {
+		return nil
+	}
+
+	names := openapi.TagNameList(in.Strings())
+	return &names
+}
+
 func serialiseCategoryReference(c category.Category) openapi.CategoryReference {
 	var parentID *openapi.NullableIdentifier
 	if c.ParentID != nil {
@@ -224,16 +359,21 @@ func serialiseCategoryReference(c category.Category) openapi.CategoryReference {
 	children := dt.Map(c.Children, serialiseCategory)
 
 	return openapi.CategoryReference{
-		Id:          *openapi.IdentifierFrom(xid.ID(c.ID)),
-		Name:        c.Name,
-		Slug:        c.Slug,
-		Colour:      c.Colour,
-		Description: c.Description,
-		Sort:        c.Sort,
-		Parent:      parentID,
-		CoverImage:  opt.Map(c.CoverImage, serialiseAsset).Ptr(),
-		Children:    children,
-		Meta:        (*openapi.Metadata)(&c.Metadata),
+		Id:           *openapi.IdentifierFrom(xid.ID(c.ID)),
+		Name:         c.Name,
+		Slug:         c.Slug,
+		Colour:       c.Colour,
+		Description:  c.Description,
+		Sort:         c.Sort,
+		Parent:       parentID,
+		CoverImage:   opt.Map(c.CoverImage, serialiseAsset).Ptr(),
+		Children:     children,
+		Meta:         (*openapi.Metadata)(&c.Metadata),
+		State:        openapi.CategoryState(c.State(time.Now()).String()),
+		ArchivedAt:   c.ArchivedAt.Ptr(),
+		OpensAt:      c.OpensAt.Ptr(),
+		ClosesAt:     c.ClosesAt.Ptr(),
+		RequiredTags: serialiseRequiredTags(c.RequiredTags),
 	}
 }
 
@@ -6,25 +6,33 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/collection"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_item_status"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
+	"github.com/Southclaws/storyden/app/resources/collection/collection_share"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/collection/collection_item_manager"
 	"github.com/Southclaws/storyden/app/services/collection/collection_manager"
 	"github.com/Southclaws/storyden/app/services/collection/collection_read"
+	"github.com/Southclaws/storyden/app/services/collection/collection_section_manager"
+	"github.com/Southclaws/storyden/app/services/collection/collection_share_manager"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/deletable"
 )
 
 type Collections struct {
-	colQuerier     *collection_querier.Querier
-	colReader      *collection_read.Hydrator
-	colManager     *collection_manager.Manager
-	colItemManager *collection_item_manager.Manager
+	colQuerier        *collection_querier.Querier
+	colReader         *collection_read.Hydrator
+	colManager        *collection_manager.Manager
+	colItemManager    *collection_item_manager.Manager
+	colSectionManager *collection_section_manager.Manager
+	colShareManager   *collection_share_manager.Manager
 }
 
 func NewCollections(
@@ -32,12 +40,16 @@ func NewCollections(
 	colReader *collection_read.Hydrator,
 	colManager *collection_manager.Manager,
 	colItemManager *collection_item_manager.Manager,
+	colSectionManager *collection_section_manager.Manager,
+	colShareManager *collection_share_manager.Manager,
 ) Collections {
 	return Collections{
-		colQuerier:     colQuerier,
-		colReader:      colReader,
-		colManager:     colManager,
-		colItemManager: colItemManager,
+		colQuerier:        colQuerier,
+		colReader:         colReader,
+		colManager:        colManager,
+		colItemManager:    colItemManager,
+		colSectionManager: colSectionManager,
+		colShareManager:   colShareManager,
 	}
 }
 
@@ -173,6 +185,166 @@ func (i *Collections) CollectionRemoveNode(ctx context.Context, request openapi.
 	}, nil
 }
 
+func (i *Collections) CollectionItemUpdate(ctx context.Context, request openapi.CollectionItemUpdateRequestObject) (openapi.CollectionItemUpdateResponseObject, error) {
+	position, err := deserialisePosition(request.Body.Before, request.Body.After)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	section, err := deletable.NewMapErr(request.Body.SectionId, func(s string) (collection.SectionID, error) {
+		id, err := xid.FromString(s)
+		return collection.SectionID(id), err
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	c, err := i.colItemManager.Move(ctx,
+		collection.NewKey(request.CollectionMark),
+		deserialiseID(request.ItemId),
+		collection_item_manager.MoveOptions{
+			Position: position,
+			Section:  section,
+		})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionItemUpdate200JSONResponse{
+		CollectionItemUpdateOKJSONResponse: openapi.CollectionItemUpdateOKJSONResponse(serialiseCollectionWithItems(c)),
+	}, nil
+}
+
+func (i *Collections) CollectionSectionCreate(ctx context.Context, request openapi.CollectionSectionCreateRequestObject) (openapi.CollectionSectionCreateResponseObject, error) {
+	position, err := deserialisePosition(request.Body.Before, request.Body.After)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := i.colSectionManager.Create(ctx,
+		collection.NewKey(request.CollectionMark),
+		request.Body.Name,
+		collection_section_manager.Partial{
+			Description: opt.NewPtr(request.Body.Description),
+			Position:    position,
+		})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionSectionCreate200JSONResponse{
+		CollectionSectionCreateOKJSONResponse: openapi.CollectionSectionCreateOKJSONResponse(serialiseCollectionSection(s)),
+	}, nil
+}
+
+func (i *Collections) CollectionSectionUpdate(ctx context.Context, request openapi.CollectionSectionUpdateRequestObject) (openapi.CollectionSectionUpdateResponseObject, error) {
+	position, err := deserialisePosition(request.Body.Before, request.Body.After)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := i.colSectionManager.Update(ctx,
+		collection.NewKey(request.CollectionMark),
+		collection.SectionID(deserialiseID(request.SectionId)),
+		collection_section_manager.Partial{
+			Name:        opt.NewPtr(request.Body.Name),
+			Description: opt.NewPtr(request.Body.Description),
+			Position:    position,
+		})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionSectionUpdate200JSONResponse{
+		CollectionSectionUpdateOKJSONResponse: openapi.CollectionSectionUpdateOKJSONResponse(serialiseCollectionSection(s)),
+	}, nil
+}
+
+func (i *Collections) CollectionSectionDelete(ctx context.Context, request openapi.CollectionSectionDeleteRequestObject) (openapi.CollectionSectionDeleteResponseObject, error) {
+	err := i.colSectionManager.Delete(ctx,
+		collection.NewKey(request.CollectionMark),
+		collection.SectionID(deserialiseID(request.SectionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionSectionDelete200Response{}, nil
+}
+
+func (i *Collections) CollectionShareCreate(ctx context.Context, request openapi.CollectionShareCreateRequestObject) (openapi.CollectionShareCreateResponseObject, error) {
+	share, err := i.colShareManager.Create(ctx,
+		collection.NewKey(request.CollectionMark),
+		collection_share_manager.Options{
+			Passcode:  opt.NewPtr(request.Body.Passcode),
+			ExpiresAt: opt.NewPtr(request.Body.ExpiresAt),
+		})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionShareCreate200JSONResponse{
+		CollectionShareCreateOKJSONResponse: openapi.CollectionShareCreateOKJSONResponse(serialiseCollectionShare(share)),
+	}, nil
+}
+
+func (i *Collections) CollectionShareList(ctx context.Context, request openapi.CollectionShareListRequestObject) (openapi.CollectionShareListResponseObject, error) {
+	shares, err := i.colShareManager.List(ctx, collection.NewKey(request.CollectionMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionShareList200JSONResponse{
+		CollectionShareListOKJSONResponse: openapi.CollectionShareListOKJSONResponse{
+			Shares: dt.Map(shares, serialiseCollectionShare),
+		},
+	}, nil
+}
+
+func (i *Collections) CollectionShareRevoke(ctx context.Context, request openapi.CollectionShareRevokeRequestObject) (openapi.CollectionShareRevokeResponseObject, error) {
+	err := i.colShareManager.Revoke(ctx,
+		collection.NewKey(request.CollectionMark),
+		collection_share.ShareID(deserialiseID(request.ShareId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionShareRevoke200Response{}, nil
+}
+
+func (i *Collections) CollectionShareOpen(ctx context.Context, request openapi.CollectionShareOpenRequestObject) (openapi.CollectionShareOpenResponseObject, error) {
+	passcode := opt.NewEmpty[string]()
+	if request.Body != nil {
+		passcode = opt.NewPtr(request.Body.Passcode)
+	}
+
+	c, err := i.colShareManager.Open(ctx, request.ShareToken, passcode)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.CollectionShareOpen200JSONResponse{
+		CollectionShareOpenOKJSONResponse: openapi.CollectionShareOpenOKJSONResponse(serialiseCollectionWithItems(c)),
+	}, nil
+}
+
+func deserialisePosition(before, after *string) (collection.Position, error) {
+	if before != nil && after != nil {
+		return collection.Position{}, fault.New("only one of before or after may be specified", ftag.With(ftag.InvalidArgument))
+	}
+
+	b, err := opt.MapErr(opt.NewPtr(before), xid.FromString)
+	if err != nil {
+		return collection.Position{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	a, err := opt.MapErr(opt.NewPtr(after), xid.FromString)
+	if err != nil {
+		return collection.Position{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	return collection.Position{Before: b, After: a}, nil
+}
+
 func serialiseCollection(in *collection.Collection) openapi.Collection {
 	return openapi.Collection{
 		Id:             in.Mark.ID().String(),
@@ -196,10 +368,31 @@ func serialiseCollectionWithItems(in *collection.CollectionWithItems) openapi.Co
 		Slug:        in.Mark.String(),
 		Description: in.Description.Ptr(),
 		Owner:       serialiseProfileReference(in.Owner),
+		Sections:    dt.Map(in.Sections, serialiseCollectionSection),
 		Items:       dt.Map(in.Items, serialiseCollectionItem),
 	}
 }
 
+func serialiseCollectionSection(in *collection.Section) openapi.CollectionSection {
+	return openapi.CollectionSection{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Name:        in.Name,
+		Description: in.Description.Ptr(),
+	}
+}
+
+func serialiseCollectionShare(in *collection_share.Share) openapi.CollectionShare {
+	return openapi.CollectionShare{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		ExpiresAt:   in.ExpiresAt.Ptr(),
+		Token:       in.Token,
+		HasPasscode: in.HasPasscode,
+	}
+}
+
 func serialiseCollectionItem(in *collection.CollectionItem) openapi.CollectionItem {
 	score := opt.PtrMap(in.RelevanceScore, func(s float64) float32 { return float32(s) })
 
@@ -211,6 +404,7 @@ func serialiseCollectionItem(in *collection.CollectionItem) openapi.CollectionIt
 		AddedAt:        in.Added,
 		MembershipType: openapi.CollectionItemMembershipType(in.MembershipType.String()),
 		RelevanceScore: score,
+		SectionId:      opt.PtrMap(in.Section, collection.SectionID.String),
 		Item:           serialiseDatagraphItem(in.Item),
 	}
 }
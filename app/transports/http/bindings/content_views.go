@@ -0,0 +1,195 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/content_view"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+const (
+	contentViewDaysDefault  = 30
+	contentViewHours        = 48
+	contentViewLimitDefault = 20
+)
+
+type ContentViews struct {
+	views        *content_view.Repository
+	threadMark   thread_mark.Service
+	nodeQuerier  *node_querier.Querier
+	profileQuery *profile_querier.Querier
+	hydrator     *hydrate.Hydrator
+}
+
+func NewContentViews(
+	views *content_view.Repository,
+	threadMark thread_mark.Service,
+	nodeQuerier *node_querier.Querier,
+	profileQuery *profile_querier.Querier,
+	hydrator *hydrate.Hydrator,
+) ContentViews {
+	return ContentViews{
+		views:        views,
+		threadMark:   threadMark,
+		nodeQuerier:  nodeQuerier,
+		profileQuery: profileQuery,
+		hydrator:     hydrator,
+	}
+}
+
+func (v *ContentViews) ThreadViewsGet(ctx context.Context, request openapi.ThreadViewsGetRequestObject) (openapi.ThreadViewsGetResponseObject, error) {
+	postID, err := v.threadMark.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	stats, err := v.itemStats(ctx, xid.ID(postID), request.Params.Days)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ThreadViewsGet200JSONResponse{
+		ContentViewStatsOKJSONResponse: openapi.ContentViewStatsOKJSONResponse(serialiseContentViewStats(stats)),
+	}, nil
+}
+
+func (v *ContentViews) NodeViewsGet(ctx context.Context, request openapi.NodeViewsGetRequestObject) (openapi.NodeViewsGetResponseObject, error) {
+	node, err := v.nodeQuerier.Get(ctx, deserialiseNodeMark(request.NodeSlug))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	stats, err := v.itemStats(ctx, node.GetID(), request.Params.Days)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NodeViewsGet200JSONResponse{
+		ContentViewStatsOKJSONResponse: openapi.ContentViewStatsOKJSONResponse(serialiseContentViewStats(stats)),
+	}, nil
+}
+
+func (v *ContentViews) ProfileViewsGet(ctx context.Context, request openapi.ProfileViewsGetRequestObject) (openapi.ProfileViewsGetResponseObject, error) {
+	targetID, err := openapi.ResolveHandle(ctx, v.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := authoriseContentViews(ctx, targetID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	since := analyticsSince(time.Now(), opt.NewPtr(request.Params.Days).Or(contentViewDaysDefault))
+	limit := opt.NewPtr(request.Params.Limit).Or(contentViewLimitDefault)
+
+	stats, err := v.views.ForAuthor(ctx, targetID, since, limit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs := dt.Map(stats.Items, func(i content_view.ItemCounts) *datagraph.Ref {
+		return &datagraph.Ref{ID: i.ItemID, Kind: i.Kind}
+	})
+
+	items, err := v.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Items which have since been deleted or unpublished aren't hydrated.
+	lookup := lo.KeyBy(items, func(i datagraph.Item) xid.ID { return i.GetID() })
+
+	return openapi.ProfileViewsGet200JSONResponse{
+		ProfileViewsGetOKJSONResponse: openapi.ProfileViewsGetOKJSONResponse{
+			Total: serialiseContentViewCounts(stats.Total),
+			Days:  dt.Map(stats.Days, serialiseContentViewPoint),
+			Items: dt.Reduce(stats.Items, func(acc []openapi.ProfileViewStatsItem, c content_view.ItemCounts) []openapi.ProfileViewStatsItem {
+				item, ok := lookup[c.ItemID]
+				if !ok {
+					return acc
+				}
+				return append(acc, openapi.ProfileViewStatsItem{
+					Item:          serialiseDatagraphItem(item),
+					Views:         c.Counts.Views,
+					UniqueViewers: c.Counts.UniqueViewers,
+				})
+			}, []openapi.ProfileViewStatsItem{}),
+		},
+	}, nil
+}
+
+func (v *ContentViews) itemStats(ctx context.Context, id xid.ID, days *openapi.ContentViewDaysQuery) (*content_view.ItemStats, error) {
+	now := time.Now()
+	since := analyticsSince(now, opt.NewPtr(days).Or(contentViewDaysDefault))
+	hoursSince := now.Add(-(contentViewHours - 1) * time.Hour)
+
+	stats, err := v.views.ForItem(ctx, id, since, hoursSince)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := authoriseContentViews(ctx, stats.AuthorID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return stats, nil
+}
+
+// authoriseContentViews only lets the author of some content, or an admin, see
+// how many times it has been viewed.
+func authoriseContentViews(ctx context.Context, authorID account.AccountID) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return session.Authorise(ctx, func() error {
+		if accountID != authorID {
+			return fault.Wrap(rbac.ErrPermissions,
+				fctx.With(ctx),
+				fmsg.WithDesc("not author", "Only the author and administrators can see view statistics."),
+			)
+		}
+		return nil
+	})
+}
+
+func serialiseContentViewStats(in *content_view.ItemStats) openapi.ContentViewStats {
+	return openapi.ContentViewStats{
+		Total: serialiseContentViewCounts(in.Total),
+		Days:  dt.Map(in.Days, serialiseContentViewPoint),
+		Hours: dt.Map(in.Hours, serialiseContentViewPoint),
+	}
+}
+
+func serialiseContentViewCounts(in content_view.Counts) openapi.ContentViewCounts {
+	return openapi.ContentViewCounts{
+		Views:         in.Views,
+		UniqueViewers: in.UniqueViewers,
+	}
+}
+
+func serialiseContentViewPoint(in content_view.Point) openapi.ContentViewPoint {
+	return openapi.ContentViewPoint{
+		Bucket:        in.Bucket,
+		Views:         in.Counts.Views,
+		UniqueViewers: in.Counts.UniqueViewers,
+	}
+}
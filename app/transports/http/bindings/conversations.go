@@ -0,0 +1,192 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/services/conversation/conversation_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Conversations struct {
+	manager *conversation_manager.Manager
+}
+
+func NewConversations(manager *conversation_manager.Manager) Conversations {
+	return Conversations{manager: manager}
+}
+
+func (h *Conversations) ConversationList(ctx context.Context, request openapi.ConversationListRequestObject) (openapi.ConversationListResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	inbox, err := h.manager.List(ctx, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationList200JSONResponse{
+		ConversationListOKJSONResponse: openapi.ConversationListOKJSONResponse{
+			Conversations: dt.Map(inbox.Conversations.Items, serialiseConversation),
+			UnreadTotal:   inbox.UnreadTotal,
+			CurrentPage:   inbox.Conversations.CurrentPage,
+			NextPage:      inbox.Conversations.NextPage.Ptr(),
+			PageSize:      inbox.Conversations.Size,
+			Results:       inbox.Conversations.Results,
+			TotalPages:    inbox.Conversations.TotalPages,
+		},
+	}, nil
+}
+
+func (h *Conversations) ConversationCreate(ctx context.Context, request openapi.ConversationCreateRequestObject) (openapi.ConversationCreateResponseObject, error) {
+	partial, err := deserialiseDirectMessage(request.Body.Body, request.Body.AssetIds)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	partial.Title = opt.NewPtr(request.Body.Title)
+
+	recipients := dt.Map(request.Body.Recipients, func(id openapi.Identifier) account.AccountID {
+		return account.AccountID(openapi.ParseID(id))
+	})
+
+	c, err := h.manager.Start(ctx, recipients, partial)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationCreate200JSONResponse{
+		ConversationCreateOKJSONResponse: openapi.ConversationCreateOKJSONResponse(serialiseConversation(c)),
+	}, nil
+}
+
+func (h *Conversations) ConversationGet(ctx context.Context, request openapi.ConversationGetRequestObject) (openapi.ConversationGetResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	c, messages, err := h.manager.Get(ctx, conversation.ConversationID(openapi.ParseID(request.ConversationId)), page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sc := serialiseConversation(c)
+
+	return openapi.ConversationGet200JSONResponse{
+		ConversationGetOKJSONResponse: openapi.ConversationGetOKJSONResponse{
+			Id:            sc.Id,
+			CreatedAt:     sc.CreatedAt,
+			UpdatedAt:     sc.UpdatedAt,
+			Title:         sc.Title,
+			Participants:  sc.Participants,
+			LastMessageAt: sc.LastMessageAt,
+			LastMessage:   sc.LastMessage,
+			Unread:        sc.Unread,
+			Messages:      serialiseDirectMessageList(messages),
+		},
+	}, nil
+}
+
+func (h *Conversations) ConversationLeave(ctx context.Context, request openapi.ConversationLeaveRequestObject) (openapi.ConversationLeaveResponseObject, error) {
+	err := h.manager.Leave(ctx, conversation.ConversationID(openapi.ParseID(request.ConversationId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationLeave204Response{}, nil
+}
+
+func (h *Conversations) ConversationMessageCreate(ctx context.Context, request openapi.ConversationMessageCreateRequestObject) (openapi.ConversationMessageCreateResponseObject, error) {
+	partial, err := deserialiseDirectMessage(request.Body.Body, request.Body.AssetIds)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m, err := h.manager.Send(ctx, conversation.ConversationID(openapi.ParseID(request.ConversationId)), partial)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationMessageCreate200JSONResponse{
+		ConversationMessageCreateOKJSONResponse: openapi.ConversationMessageCreateOKJSONResponse(serialiseDirectMessage(m)),
+	}, nil
+}
+
+func (h *Conversations) ConversationMarkRead(ctx context.Context, request openapi.ConversationMarkReadRequestObject) (openapi.ConversationMarkReadResponseObject, error) {
+	err := h.manager.MarkRead(ctx, conversation.ConversationID(openapi.ParseID(request.ConversationId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationMarkRead204Response{}, nil
+}
+
+func deserialiseDirectMessage(body openapi.PostContent, assetIDs *openapi.AssetIDs) (conversation_manager.Partial, error) {
+	content, err := datagraph.NewRichText(body)
+	if err != nil {
+		return conversation_manager.Partial{}, fault.Wrap(err)
+	}
+
+	var assets []string
+	if assetIDs != nil {
+		assets = *assetIDs
+	}
+
+	return conversation_manager.Partial{
+		Content: content,
+		Assets:  deserialiseAssetIDs(assets),
+	}, nil
+}
+
+func serialiseConversation(in *conversation.Conversation) openapi.Conversation {
+	return openapi.Conversation{
+		Id:            in.ID.String(),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Title:         in.Title.Ptr(),
+		Participants:  dt.Map(in.Participants, serialiseConversationParticipant),
+		LastMessageAt: in.LastMessageAt,
+		LastMessage:   opt.Map(in.LastMessage, serialiseDirectMessageValue).Ptr(),
+		Unread:        in.Unread,
+	}
+}
+
+func serialiseConversationParticipant(in *conversation.Participant) openapi.ConversationParticipant {
+	return openapi.ConversationParticipant{
+		Profile:    serialiseProfileReference(in.Profile),
+		JoinedAt:   in.JoinedAt,
+		LastReadAt: in.LastReadAt.Ptr(),
+		LeftAt:     in.LeftAt.Ptr(),
+	}
+}
+
+func serialiseDirectMessage(in *conversation.Message) openapi.DirectMessage {
+	return openapi.DirectMessage{
+		Id:             in.ID.String(),
+		CreatedAt:      in.CreatedAt,
+		UpdatedAt:      in.UpdatedAt,
+		ConversationId: in.ConversationID.String(),
+		Author:         serialiseProfileReference(in.Author),
+		Body:           in.Content.HTML(),
+		Assets:         dt.Map(in.Assets, serialiseAssetPtr),
+	}
+}
+
+func serialiseDirectMessageValue(in conversation.Message) openapi.DirectMessage {
+	return serialiseDirectMessage(&in)
+}
+
+func serialiseDirectMessageList(in *pagination.Result[*conversation.Message]) openapi.DirectMessageListResult {
+	return openapi.DirectMessageListResult{
+		Messages:    dt.Map(in.Items, serialiseDirectMessage),
+		CurrentPage: in.CurrentPage,
+		NextPage:    in.NextPage.Ptr(),
+		PageSize:    in.Size,
+		Results:     in.Results,
+		TotalPages:  in.TotalPages,
+	}
+}
@@ -11,16 +11,22 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/datagraph/facet"
+	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/trending"
+	"github.com/Southclaws/storyden/app/resources/trending/trending_querier"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/search/searcher"
 	"github.com/Southclaws/storyden/app/services/semdex"
 	"github.com/Southclaws/storyden/app/services/system/instance_info"
@@ -28,19 +34,28 @@ import (
 )
 
 type Datagraph struct {
-	searcher searcher.Searcher
-	asker    semdex.Asker
+	searcher        searcher.Searcher
+	asker           semdex.Asker
+	trendingQuerier *trending_querier.Querier
+	facetQuerier    *facet.Querier
+	hydrator        *hydrate.Hydrator
 }
 
 func NewDatagraph(
 	info *instance_info.Provider,
 	searcher searcher.Searcher,
 	asker semdex.Asker,
+	trendingQuerier *trending_querier.Querier,
+	facetQuerier *facet.Querier,
+	hydrator *hydrate.Hydrator,
 	router *echo.Echo,
 ) Datagraph {
 	d := Datagraph{
-		searcher: searcher,
-		asker:    asker,
+		searcher:        searcher,
+		asker:           asker,
+		trendingQuerier: trendingQuerier,
+		facetQuerier:    facetQuerier,
+		hydrator:        hydrator,
 	}
 
 	// The generated OpenAPI code does not expose the underlying ResponseWriter
@@ -109,6 +124,14 @@ func NewDatagraph(
 						}
 
 						msg = fmt.Sprintf("event: meta\ndata: %s\n\n", string(b))
+
+					case *semdex.AskResponseChunkSources:
+						b, err := serialiseAskResponseChunkSources(*v)
+						if err != nil {
+							return err
+						}
+
+						msg = fmt.Sprintf("event: sources\ndata: %s\n\n", string(b))
 					}
 
 					if _, err := w.Write([]byte(msg)); err != nil {
@@ -157,8 +180,15 @@ func (d Datagraph) DatagraphSearch(ctx context.Context, request openapi.Datagrap
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	mode, err := opt.MapErr(opt.NewPtr(request.Params.Mode), deserialiseSearchMode)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
 	opts := searcher.Options{
-		Kinds: kindFilter,
+		Kinds:  kindFilter,
+		Mode:   mode,
+		Filter: deserialiseSearchFilter(request.Params),
 	}
 
 	r, err := d.searcher.Search(ctx, request.Params.Q, pp, opts)
@@ -166,10 +196,36 @@ func (d Datagraph) DatagraphSearch(ctx context.Context, request openapi.Datagrap
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	var highlights *openapi.DatagraphSearchHighlights
+	if h, ok := d.searcher.(searcher.Highlighter); ok {
+		hl, err := h.Highlight(ctx, request.Params.Q, r.Items)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if hl != nil {
+			highlights = serialiseSearchHighlights(hl)
+		}
+	}
+
+	var facets *openapi.DatagraphSearchFacets
+	if f, ok := d.searcher.(searcher.Faceter); ok {
+		fc, err := f.Facets(ctx, request.Params.Q, opts)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if fc != nil {
+			facets = serialiseSearchFacets(fc)
+		}
+	}
+
 	return openapi.DatagraphSearch200JSONResponse{
 		DatagraphSearchOKJSONResponse: openapi.DatagraphSearchOKJSONResponse{
 			CurrentPage: r.CurrentPage,
 			Items:       dt.Map(r.Items, serialiseDatagraphItem),
+			Highlights:  highlights,
+			Facets:      facets,
 			NextPage:    r.NextPage.Ptr(),
 			PageSize:    r.Size,
 			Results:     r.Results,
@@ -178,11 +234,112 @@ func (d Datagraph) DatagraphSearch(ctx context.Context, request openapi.Datagrap
 	}, nil
 }
 
+func deserialiseSearchMode(v openapi.SearchMode) (searcher.Mode, error) {
+	return searcher.NewMode(string(v))
+}
+
+func deserialiseSearchFilter(p openapi.DatagraphSearchParams) facet.Filter {
+	f := facet.Filter{
+		CreatedAfter:  opt.NewPtr(p.CreatedAfter),
+		CreatedBefore: opt.NewPtr(p.CreatedBefore),
+		Solved:        opt.NewPtr(p.Solved),
+	}
+
+	if p.Author != nil {
+		f.Authors = *p.Author
+	}
+	if p.Tags != nil {
+		f.Tags = dt.Map(*p.Tags, func(t openapi.TagName) string { return deserialiseTagName(t).String() })
+	}
+	if p.Category != nil {
+		f.Categories = *p.Category
+	}
+
+	return f
+}
+
+func serialiseSearchFacets(in *facet.Facets) *openapi.DatagraphSearchFacets {
+	return &openapi.DatagraphSearchFacets{
+		Kinds:      dt.Map(in.Kinds, serialiseSearchFacetCount),
+		Authors:    dt.Map(in.Authors, serialiseSearchFacetCount),
+		Categories: dt.Map(in.Categories, serialiseSearchFacetCount),
+		Tags:       dt.Map(in.Tags, serialiseSearchFacetCount),
+		Solved:     in.Solved,
+		Unsolved:   in.Unsolved,
+	}
+}
+
+func serialiseSearchFacetCount(in facet.Count) openapi.SearchFacetCount {
+	return openapi.SearchFacetCount{
+		Value: in.Value,
+		Label: in.Label,
+		Count: in.Count,
+	}
+}
+
+func serialiseSearchHighlights(in map[xid.ID]string) *openapi.DatagraphSearchHighlights {
+	out := make(openapi.DatagraphSearchHighlights, len(in))
+	for id, h := range in {
+		out[id.String()] = h
+	}
+	return &out
+}
+
 func (d Datagraph) DatagraphAsk(ctx context.Context, request openapi.DatagraphAskRequestObject) (openapi.DatagraphAskResponseObject, error) {
 	// NOTE: Unused stub, see middleware above.
 	return nil, nil
 }
 
+const datagraphTrendingLimit = 50
+
+func (d Datagraph) DatagraphTrending(ctx context.Context, request openapi.DatagraphTrendingRequestObject) (openapi.DatagraphTrendingResponseObject, error) {
+	window, err := opt.MapErr(opt.NewPtr(request.Params.Window), deserialiseTrendingWindow)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	kindFilter, err := opt.MapErr(opt.NewPtr(request.Params.Kind), deserialiseDatagraphKindList)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	w := window.Or(trending.WindowDay)
+
+	scores, err := d.trendingQuerier.List(ctx, w, kindFilter.OrZero(), datagraphTrendingLimit)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	refs := dt.Map(scores, func(s *trending.Score) *datagraph.Ref {
+		return &datagraph.Ref{ID: s.ItemID, Kind: s.Kind, Relevance: s.Score}
+	})
+
+	// Scores are counted for everyone so threads in categories the member
+	// can't read are removed here.
+	refs, err = d.facetQuerier.FilterRefs(ctx, refs, facet.Filter{
+		ReadableBy: opt.New(session.GetOptRoles(ctx)),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := d.hydrator.Hydrate(ctx, refs...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.DatagraphTrending200JSONResponse{
+		DatagraphTrendingOKJSONResponse: openapi.DatagraphTrendingOKJSONResponse{
+			Window: openapi.TrendingWindow(w.String()),
+			Items:  serialiseDatagraphItemList(items),
+		},
+	}, nil
+}
+
+func deserialiseTrendingWindow(v openapi.TrendingWindow) (trending.Window, error) {
+	return trending.NewWindow(string(v))
+}
+
 func deserialiseDatagraphKindList(ks []openapi.DatagraphItemKind) ([]datagraph.Kind, error) {
 	return dt.MapErr(ks, deserialiseDatagraphKind)
 }
@@ -261,6 +418,22 @@ func serialiseDatagraphItemList(in datagraph.ItemList) openapi.DatagraphItemList
 	return dt.Map(in, serialiseDatagraphItem)
 }
 
+func serialiseAskResponseChunkSources(in semdex.AskResponseChunkSources) ([]byte, error) {
+	sources := dt.Map(in.Sources, func(c *semdex.Chunk) map[string]any {
+		return map[string]any{
+			"id":        c.ID.String(),
+			"kind":      c.Kind.String(),
+			"url":       c.URL.String(),
+			"relevance": c.Relevance,
+		}
+	})
+
+	return json.Marshal(map[string]any{
+		"sources":    sources,
+		"confidence": in.Confidence,
+	})
+}
+
 func serialiseAskResponseChunkMeta(in semdex.AskResponseChunkMeta) ([]byte, error) {
 	urls := dt.Map(in.URLs, func(u url.URL) string {
 		return u.String()
@@ -0,0 +1,54 @@
+package bindings
+
+import (
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/email_domain"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func serialiseEmailDomainSettings(in email_domain.Settings) openapi.EmailDomainSettings {
+	rules := dt.Map(in.Rules, func(r email_domain.Rule) openapi.EmailDomainRule {
+		roles := dt.Map(r.Roles, func(id role.RoleID) openapi.Identifier { return id.String() })
+
+		return openapi.EmailDomainRule{
+			Domain:      r.Domain,
+			AutoApprove: &r.AutoApprove,
+			Roles:       &roles,
+		}
+	})
+
+	return openapi.EmailDomainSettings{
+		Restricted: &in.Restricted,
+		Rules:      &rules,
+	}
+}
+
+func deserialiseEmailDomainSettings(in openapi.EmailDomainSettings) (email_domain.Settings, error) {
+	rules, err := dt.MapErr(opt.NewPtr(in.Rules).OrZero(), func(r openapi.EmailDomainRule) (email_domain.Rule, error) {
+		roles, err := dt.MapErr(opt.NewPtr(r.Roles).OrZero(), func(id openapi.Identifier) (role.RoleID, error) {
+			parsed, err := xid.FromString(id)
+			return role.RoleID(parsed), err
+		})
+		if err != nil {
+			return email_domain.Rule{}, err
+		}
+
+		return email_domain.Rule{
+			Domain:      email_domain.Normalise(r.Domain),
+			AutoApprove: opt.NewPtr(r.AutoApprove).OrZero(),
+			Roles:       roles,
+		}, nil
+	})
+	if err != nil {
+		return email_domain.Settings{}, err
+	}
+
+	return email_domain.Settings{
+		Restricted: opt.NewPtr(in.Restricted).OrZero(),
+		Rules:      rules,
+	}, nil
+}
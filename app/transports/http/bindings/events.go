@@ -8,8 +8,12 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/event"
 	"github.com/Southclaws/storyden/app/resources/event/event_querier"
@@ -18,21 +22,25 @@ import (
 	"github.com/Southclaws/storyden/app/resources/event/participation"
 	"github.com/Southclaws/storyden/app/resources/post/category"
 	"github.com/Southclaws/storyden/app/services/event/event_management"
+	"github.com/Southclaws/storyden/app/services/event/event_participation"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
 
 type Events struct {
-	eventQuerier *event_querier.Querier
-	eventManager *event_management.Manager
+	eventQuerier       *event_querier.Querier
+	eventManager       *event_management.Manager
+	participantManager event_participation.Manager
 }
 
 func NewEvents(
 	eventQuerier *event_querier.Querier,
 	eventManager *event_management.Manager,
+	participantManager event_participation.Manager,
 ) Events {
 	return Events{
-		eventQuerier: eventQuerier,
-		eventManager: eventManager,
+		eventQuerier:       eventQuerier,
+		eventManager:       eventManager,
+		participantManager: participantManager,
 	}
 }
 
@@ -104,8 +112,12 @@ func (h *Events) EventCreate(ctx context.Context, request openapi.EventCreateReq
 }
 
 func (h *Events) EventDelete(ctx context.Context, request openapi.EventDeleteRequestObject) (openapi.EventDeleteResponseObject, error) {
-	// event_manager
-	return nil, nil
+	_, err := h.eventManager.Delete(ctx, event_ref.NewKey(request.EventMark), event_management.Partial{})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.EventDelete200Response{}, nil
 }
 
 func (h *Events) EventGet(ctx context.Context, request openapi.EventGetRequestObject) (openapi.EventGetResponseObject, error) {
@@ -122,18 +134,114 @@ func (h *Events) EventGet(ctx context.Context, request openapi.EventGetRequestOb
 }
 
 func (h *Events) EventUpdate(ctx context.Context, request openapi.EventUpdateRequestObject) (openapi.EventUpdateResponseObject, error) {
-	// event_manager
-	return nil, nil
+	content, err := opt.MapErr(opt.NewPtr(request.Body.Content), datagraph.NewRichText)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	participationPolicy, err := opt.MapErr(opt.NewPtr(request.Body.ParticipationPolicy), func(p openapi.EventParticipationPolicy) (participation.Policy, error) {
+		return participation.NewPolicy(string(p))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	visibility, err := opt.MapErr(opt.NewPtr(request.Body.Visibility), deserialiseVisibility)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	location, err := opt.MapErr(opt.NewPtr(request.Body.Location), deserialiseLocation)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	timeRange := opt.Map(opt.NewPtr(request.Body.TimeRange), func(tr openapi.EventTimeRange) event_ref.TimeRange {
+		return event_ref.TimeRange{Start: tr.Start, End: tr.End, Duration: tr.End.Sub(tr.Start)}
+	})
+
+	if tr, ok := timeRange.Get(); ok && !tr.End.After(tr.Start) {
+		return nil, fault.New("event must end after it starts", fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	evt, err := h.eventManager.Update(ctx, event_ref.NewKey(request.EventMark), event_management.Partial{
+		Name:                opt.NewPtr(request.Body.Name),
+		Slug:                opt.NewPtr(request.Body.Slug),
+		Description:         opt.NewPtr(request.Body.Description),
+		Content:             content,
+		TimeRange:           timeRange,
+		Image:               opt.Map(opt.NewPtr(request.Body.PrimaryImageAssetId), deserialiseAssetID),
+		ParticipationPolicy: participationPolicy,
+		Visibility:          visibility,
+		Location:            location,
+		Capacity:            opt.NewPtr(request.Body.Capacity),
+		Metadata:            opt.NewPtr((*map[string]any)(request.Body.Meta)),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.EventUpdate200JSONResponse{
+		EventUpdateOKJSONResponse: openapi.EventUpdateOKJSONResponse(serialiseEventPtr(evt)),
+	}, nil
 }
 
 func (h *Events) EventParticipantRemove(ctx context.Context, request openapi.EventParticipantRemoveRequestObject) (openapi.EventParticipantRemoveResponseObject, error) {
-	// participation_manager
-	return nil, nil
+	accountID, err := deserialiseParticipantAccountID(request.AccountId)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	_, err = h.participantManager.Update(ctx, event_ref.NewKey(request.EventMark), event_participation.Change{
+		AccountID: accountID,
+		Delete:    true,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.EventParticipantRemove200Response{}, nil
 }
 
 func (h *Events) EventParticipantUpdate(ctx context.Context, request openapi.EventParticipantUpdateRequestObject) (openapi.EventParticipantUpdateResponseObject, error) {
-	// participation_manager
-	return nil, nil
+	accountID, err := deserialiseParticipantAccountID(request.AccountId)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	role, err := opt.MapErr(opt.NewPtr(request.Body.Role), func(r openapi.EventParticipantRole) (participation.Role, error) {
+		return participation.NewRole(string(r))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	status, err := opt.MapErr(opt.NewPtr(request.Body.Status), func(s openapi.EventParticipationStatus) (participation.Status, error) {
+		return participation.NewStatus(string(s))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	_, err = h.participantManager.Update(ctx, event_ref.NewKey(request.EventMark), event_participation.Change{
+		AccountID: accountID,
+		Role:      role,
+		Status:    status,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.EventParticipantUpdate200Response{}, nil
+}
+
+func deserialiseParticipantAccountID(in openapi.AccountIDParam) (account.AccountID, error) {
+	id, err := xid.FromString(in)
+	if err != nil {
+		return account.AccountID{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument), fmsg.WithDesc("invalid account ID", "The account ID provided is invalid."))
+	}
+
+	return account.AccountID(id), nil
 }
 
 func serialiseEventPtr(in *event.Event) openapi.Event {
@@ -0,0 +1,150 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/feature_flag"
+	"github.com/Southclaws/storyden/app/services/feature_flag/flag_evaluator"
+	"github.com/Southclaws/storyden/app/services/feature_flag/flag_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type FeatureFlags struct {
+	flagManager   *flag_manager.Manager
+	flagEvaluator *flag_evaluator.Evaluator
+}
+
+func NewFeatureFlags(
+	flagManager *flag_manager.Manager,
+	flagEvaluator *flag_evaluator.Evaluator,
+) FeatureFlags {
+	return FeatureFlags{
+		flagManager:   flagManager,
+		flagEvaluator: flagEvaluator,
+	}
+}
+
+func (h FeatureFlags) FeatureList(ctx context.Context, request openapi.FeatureListRequestObject) (openapi.FeatureListResponseObject, error) {
+	keys, err := h.flagEvaluator.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.FeatureList200JSONResponse{
+		FeatureListOKJSONResponse: openapi.FeatureListOKJSONResponse{
+			Features: keys,
+		},
+	}, nil
+}
+
+func (h FeatureFlags) AdminFeatureFlagList(ctx context.Context, request openapi.AdminFeatureFlagListRequestObject) (openapi.AdminFeatureFlagListResponseObject, error) {
+	flags, err := h.flagManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminFeatureFlagList200JSONResponse{
+		AdminFeatureFlagListOKJSONResponse: openapi.AdminFeatureFlagListOKJSONResponse{
+			Flags: dt.Map(flags, serialiseFeatureFlag),
+		},
+	}, nil
+}
+
+func (h FeatureFlags) AdminFeatureFlagCreate(ctx context.Context, request openapi.AdminFeatureFlagCreateRequestObject) (openapi.AdminFeatureFlagCreateResponseObject, error) {
+	partial, err := deserialiseFeatureFlagRollout(openapi.FeatureFlagRollout{
+		Description: request.Body.Description,
+		Enabled:     request.Body.Enabled,
+		Percentage:  request.Body.Percentage,
+		Roles:       request.Body.Roles,
+		Accounts:    request.Body.Accounts,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	f, err := h.flagManager.Create(ctx, request.Body.Key, partial)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminFeatureFlagCreate200JSONResponse{
+		AdminFeatureFlagOKJSONResponse: openapi.AdminFeatureFlagOKJSONResponse(serialiseFeatureFlag(f)),
+	}, nil
+}
+
+func (h FeatureFlags) AdminFeatureFlagUpdate(ctx context.Context, request openapi.AdminFeatureFlagUpdateRequestObject) (openapi.AdminFeatureFlagUpdateResponseObject, error) {
+	partial, err := deserialiseFeatureFlagRollout(*request.Body)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	f, err := h.flagManager.Update(ctx, request.FeatureFlagKey, partial)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminFeatureFlagUpdate200JSONResponse{
+		AdminFeatureFlagOKJSONResponse: openapi.AdminFeatureFlagOKJSONResponse(serialiseFeatureFlag(f)),
+	}, nil
+}
+
+func (h FeatureFlags) AdminFeatureFlagDelete(ctx context.Context, request openapi.AdminFeatureFlagDeleteRequestObject) (openapi.AdminFeatureFlagDeleteResponseObject, error) {
+	if err := h.flagManager.Delete(ctx, request.FeatureFlagKey); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminFeatureFlagDelete204Response{}, nil
+}
+
+func deserialiseFeatureFlagRollout(in openapi.FeatureFlagRollout) (flag_manager.Partial, error) {
+	roles, err := opt.MapErr(opt.NewPtr(in.Roles), func(ids []openapi.Identifier) ([]role.RoleID, error) {
+		return dt.MapErr(ids, func(id openapi.Identifier) (role.RoleID, error) {
+			parsed, err := xid.FromString(id)
+			return role.RoleID(parsed), err
+		})
+	})
+	if err != nil {
+		return flag_manager.Partial{}, err
+	}
+
+	accounts, err := opt.MapErr(opt.NewPtr(in.Accounts), func(ids []openapi.Identifier) ([]account.AccountID, error) {
+		return dt.MapErr(ids, func(id openapi.Identifier) (account.AccountID, error) {
+			parsed, err := xid.FromString(id)
+			return account.AccountID(parsed), err
+		})
+	})
+	if err != nil {
+		return flag_manager.Partial{}, err
+	}
+
+	return flag_manager.Partial{
+		Description: opt.NewPtr(in.Description),
+		Enabled:     opt.NewPtr(in.Enabled),
+		Percentage:  opt.NewPtr(in.Percentage),
+		Roles:       roles,
+		Accounts:    accounts,
+	}, nil
+}
+
+func serialiseFeatureFlag(in *feature_flag.Flag) openapi.FeatureFlag {
+	return openapi.FeatureFlag{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Key:         in.Key,
+		Description: in.Description.Ptr(),
+		Enabled:     in.Enabled,
+		Percentage:  in.Percentage,
+		Roles:       dt.Map(in.Roles, func(id role.RoleID) openapi.Identifier { return id.String() }),
+		Accounts:    dt.Map(in.Accounts, func(id account.AccountID) openapi.Identifier { return id.String() }),
+	}
+}
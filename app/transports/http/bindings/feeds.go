@@ -0,0 +1,229 @@
+package bindings
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+	"github.com/Southclaws/storyden/app/services/syndication"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// Feed readers poll frequently, a short max-age plus conditional requests keep
+// that cheap. Token-authenticated feeds must never be stored by shared caches.
+const (
+	feedPublicCacheControl  = "public, max-age=300"
+	feedPrivateCacheControl = "private, max-age=300"
+)
+
+type Feeds struct {
+	apiAddress url.URL
+	syndicator *syndication.Syndicator
+}
+
+func NewFeeds(cfg config.Config, syndicator *syndication.Syndicator) Feeds {
+	return Feeds{
+		apiAddress: cfg.PublicAPIAddress,
+		syndicator: syndicator,
+	}
+}
+
+type feedBuilder func(ctx context.Context, viewer opt.Optional[account.AccountID]) (*syndication.Feed, error)
+
+type feedResult struct {
+	doc          *syndication.Document
+	cacheControl string
+	notModified  bool
+}
+
+func (h *Feeds) serve(ctx context.Context, format openapi.FeedFormat, token *string, build feedBuilder, path ...string) (*feedResult, error) {
+	f, err := syndication.NewFormat(string(format))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	tok := opt.NewPtr(token)
+
+	viewer, err := h.syndicator.Authorise(ctx, tok)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	feed, err := build(ctx, viewer)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	self := h.apiAddress.JoinPath(append([]string{"api", "feeds", f.String()}, path...)...)
+	if t, ok := tok.Get(); ok {
+		self.RawQuery = url.Values{"token": {t}}.Encode()
+	}
+
+	doc, err := syndication.Render(f, feed, self.String())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return newFeedResult(ctx, doc, tok.Ok()), nil
+}
+
+func newFeedResult(ctx context.Context, doc *syndication.Document, private bool) *feedResult {
+	cacheControl := feedPublicCacheControl
+	if private {
+		cacheControl = feedPrivateCacheControl
+	}
+
+	q := reqinfo.GetCacheQuery(ctx)
+	notModified := q.MatchesETag(doc.ETag) ||
+		!doc.LastModified.IsZero() && q.NotModified(func() *time.Time { return &doc.LastModified })
+
+	return &feedResult{
+		doc:          doc,
+		cacheControl: cacheControl,
+		notModified:  notModified,
+	}
+}
+
+func (r *feedResult) lastModified() string {
+	if r.doc.LastModified.IsZero() {
+		return ""
+	}
+	return r.doc.LastModified.UTC().Format(time.RFC1123)
+}
+
+func (r *feedResult) notModifiedHeaders() openapi.NotModifiedResponseHeaders {
+	return openapi.NotModifiedResponseHeaders{
+		CacheControl: r.cacheControl,
+		ETag:         r.doc.ETag,
+		LastModified: r.lastModified(),
+	}
+}
+
+func (r *feedResult) ok() openapi.FeedOKAsteriskResponse {
+	return openapi.FeedOKAsteriskResponse{
+		Body:          bytes.NewReader(r.doc.Body),
+		ContentType:   r.doc.ContentType,
+		ContentLength: int64(len(r.doc.Body)),
+		Headers: openapi.FeedOKResponseHeaders{
+			CacheControl: r.cacheControl,
+			ETag:         r.doc.ETag,
+			LastModified: r.lastModified(),
+		},
+	}
+}
+
+func (r *feedResult) sitemap() openapi.SitemapOKAsteriskResponse {
+	return openapi.SitemapOKAsteriskResponse{
+		Body:          bytes.NewReader(r.doc.Body),
+		ContentType:   r.doc.ContentType,
+		ContentLength: int64(len(r.doc.Body)),
+		Headers: openapi.SitemapOKResponseHeaders{
+			CacheControl: r.cacheControl,
+			ETag:         r.doc.ETag,
+			LastModified: r.lastModified(),
+		},
+	}
+}
+
+func (r *feedResult) structuredData() openapi.StructuredDataOKAsteriskResponse {
+	return openapi.StructuredDataOKAsteriskResponse{
+		Body:          bytes.NewReader(r.doc.Body),
+		ContentType:   r.doc.ContentType,
+		ContentLength: int64(len(r.doc.Body)),
+		Headers: openapi.StructuredDataOKResponseHeaders{
+			CacheControl: r.cacheControl,
+			ETag:         r.doc.ETag,
+			LastModified: r.lastModified(),
+		},
+	}
+}
+
+func (h *Feeds) FeedTimelineGet(ctx context.Context, request openapi.FeedTimelineGetRequestObject) (openapi.FeedTimelineGetResponseObject, error) {
+	r, err := h.serve(ctx, request.FeedFormat, request.Params.Token, h.syndicator.Timeline)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if r.notModified {
+		return openapi.FeedTimelineGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.FeedTimelineGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
+
+func (h *Feeds) FeedHomeGet(ctx context.Context, request openapi.FeedHomeGetRequestObject) (openapi.FeedHomeGetResponseObject, error) {
+	if request.Params.Token == nil {
+		return nil, fault.New("home feed requires a token", fctx.With(ctx), ftag.With(ftag.Unauthenticated))
+	}
+
+	r, err := h.serve(ctx, request.FeedFormat, request.Params.Token, h.syndicator.Home, "home")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if r.notModified {
+		return openapi.FeedHomeGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.FeedHomeGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
+
+func (h *Feeds) FeedCategoryGet(ctx context.Context, request openapi.FeedCategoryGetRequestObject) (openapi.FeedCategoryGetResponseObject, error) {
+	build := func(ctx context.Context, viewer opt.Optional[account.AccountID]) (*syndication.Feed, error) {
+		return h.syndicator.Category(ctx, viewer, request.CategorySlug)
+	}
+
+	r, err := h.serve(ctx, request.FeedFormat, request.Params.Token, build, "categories", request.CategorySlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if r.notModified {
+		return openapi.FeedCategoryGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.FeedCategoryGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
+
+func (h *Feeds) FeedTagGet(ctx context.Context, request openapi.FeedTagGetRequestObject) (openapi.FeedTagGetResponseObject, error) {
+	build := func(ctx context.Context, viewer opt.Optional[account.AccountID]) (*syndication.Feed, error) {
+		return h.syndicator.Tag(ctx, viewer, request.TagName)
+	}
+
+	r, err := h.serve(ctx, request.FeedFormat, request.Params.Token, build, "tags", request.TagName)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if r.notModified {
+		return openapi.FeedTagGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.FeedTagGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
+
+func (h *Feeds) FeedProfileGet(ctx context.Context, request openapi.FeedProfileGetRequestObject) (openapi.FeedProfileGetResponseObject, error) {
+	build := func(ctx context.Context, viewer opt.Optional[account.AccountID]) (*syndication.Feed, error) {
+		return h.syndicator.Profile(ctx, viewer, string(request.AccountHandle))
+	}
+
+	r, err := h.serve(ctx, request.FeedFormat, request.Params.Token, build, "profiles", string(request.AccountHandle))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if r.notModified {
+		return openapi.FeedProfileGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.FeedProfileGet200AsteriskResponse{FeedOKAsteriskResponse: r.ok()}, nil
+}
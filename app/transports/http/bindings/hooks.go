@@ -0,0 +1,122 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_dispatch"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_subscription"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Hooks struct {
+	subscriptions *webhook_subscription.Manager
+}
+
+func NewHooks(
+	subscriptions *webhook_subscription.Manager,
+) Hooks {
+	return Hooks{
+		subscriptions: subscriptions,
+	}
+}
+
+func (h Hooks) HookSubscriptionList(ctx context.Context, request openapi.HookSubscriptionListRequestObject) (openapi.HookSubscriptionListResponseObject, error) {
+	list, err := h.subscriptions.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.HookSubscriptionList200JSONResponse{
+		HookSubscriptionListOKJSONResponse: openapi.HookSubscriptionListOKJSONResponse{
+			Subscriptions: dt.Map(list, serialiseHookSubscription),
+		},
+	}, nil
+}
+
+func (h Hooks) HookSubscribe(ctx context.Context, request openapi.HookSubscribeRequestObject) (openapi.HookSubscribeResponseObject, error) {
+	event, err := webhook.NewEvent(string(request.Body.Event))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	w, err := h.subscriptions.Subscribe(ctx, event, request.Body.TargetUrl)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.HookSubscribe200JSONResponse{
+		HookSubscriptionOKJSONResponse: openapi.HookSubscriptionOKJSONResponse(serialiseHookSubscription(w)),
+	}, nil
+}
+
+func (h Hooks) HookUnsubscribe(ctx context.Context, request openapi.HookUnsubscribeRequestObject) (openapi.HookUnsubscribeResponseObject, error) {
+	if err := h.subscriptions.Unsubscribe(ctx, webhook.WebhookID(deserialiseID(request.WebhookId))); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.HookUnsubscribe204Response{}, nil
+}
+
+func (h Hooks) HookEventPoll(ctx context.Context, request openapi.HookEventPollRequestObject) (openapi.HookEventPollResponseObject, error) {
+	event, err := webhook.NewEvent(string(request.WebhookEvent))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	items, err := h.subscriptions.Poll(ctx, event)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.HookEventPoll200JSONResponse{
+		HookEventPollOKJSONResponse: openapi.HookEventPollOKJSONResponse{
+			Items: dt.Map(items, serialiseHookPayload),
+		},
+	}, nil
+}
+
+func (h Hooks) HookEventSample(ctx context.Context, request openapi.HookEventSampleRequestObject) (openapi.HookEventSampleResponseObject, error) {
+	event, err := webhook.NewEvent(string(request.WebhookEvent))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	sample, err := h.subscriptions.Sample(ctx, event)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.HookEventSample200JSONResponse{
+		HookPayloadOKJSONResponse: openapi.HookPayloadOKJSONResponse(serialiseHookPayload(*sample)),
+	}, nil
+}
+
+func serialiseHookSubscription(in *webhook.Webhook) openapi.HookSubscription {
+	// Subscriptions are always made for a single event.
+	event := openapi.WebhookEvent("")
+	if len(in.Events) > 0 {
+		event = openapi.WebhookEvent(in.Events[0].String())
+	}
+
+	return openapi.HookSubscription{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		Event:     event,
+		TargetUrl: in.URL,
+	}
+}
+
+func serialiseHookPayload(in webhook_dispatch.Payload) openapi.HookPayload {
+	return openapi.HookPayload{
+		Id:        in.ID,
+		Event:     openapi.WebhookEvent(in.Event),
+		Timestamp: in.Timestamp,
+		Data:      in.Data,
+	}
+}
@@ -0,0 +1,183 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/import_run"
+	"github.com/Southclaws/storyden/app/services/importer"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Imports struct {
+	importer *importer.Importer
+}
+
+func NewImports(importer *importer.Importer) Imports {
+	return Imports{importer: importer}
+}
+
+func (h Imports) AdminImportList(ctx context.Context, request openapi.AdminImportListRequestObject) (openapi.AdminImportListResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	result, err := h.importer.List(ctx, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminImportList200JSONResponse{
+		AdminImportListOKJSONResponse: openapi.AdminImportListOKJSONResponse{
+			CurrentPage: result.CurrentPage,
+			NextPage:    result.NextPage.Ptr(),
+			PageSize:    result.Size,
+			Results:     result.Results,
+			TotalPages:  result.TotalPages,
+			Imports:     dt.Map(result.Items, serialiseImportRun),
+		},
+	}, nil
+}
+
+func (h Imports) AdminImportStart(ctx context.Context, request openapi.AdminImportStartRequestObject) (openapi.AdminImportStartResponseObject, error) {
+	run, err := h.importer.Start(ctx, string(request.Body.Source), asset.AssetID(deserialiseID(request.Body.AssetId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminImportStart200JSONResponse{
+		AdminImportOKJSONResponse: openapi.AdminImportOKJSONResponse(serialiseImportRunWithStats(run, nil)),
+	}, nil
+}
+
+func (h Imports) AdminImportDocument(ctx context.Context, request openapi.AdminImportDocumentRequestObject) (openapi.AdminImportDocumentResponseObject, error) {
+	doc, err := json.Marshal(request.Body)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	run, err := h.importer.StartDocument(ctx, doc)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminImportDocument200JSONResponse{
+		AdminImportOKJSONResponse: openapi.AdminImportOKJSONResponse(serialiseImportRunWithStats(run, nil)),
+	}, nil
+}
+
+func (h Imports) AdminImportGet(ctx context.Context, request openapi.AdminImportGetRequestObject) (openapi.AdminImportGetResponseObject, error) {
+	run, stats, err := h.importer.Get(ctx, import_run.RunID(deserialiseID(request.ImportRunId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminImportGet200JSONResponse{
+		AdminImportOKJSONResponse: openapi.AdminImportOKJSONResponse(serialiseImportRunWithStats(run, stats)),
+	}, nil
+}
+
+func (h Imports) AdminImportResume(ctx context.Context, request openapi.AdminImportResumeRequestObject) (openapi.AdminImportResumeResponseObject, error) {
+	run, err := h.importer.Resume(ctx, import_run.RunID(deserialiseID(request.ImportRunId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminImportResume200JSONResponse{
+		AdminImportOKJSONResponse: openapi.AdminImportOKJSONResponse(serialiseImportRunWithStats(run, nil)),
+	}, nil
+}
+
+func (h Imports) AdminImportRecordList(ctx context.Context, request openapi.AdminImportRecordListRequestObject) (openapi.AdminImportRecordListResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 100)
+
+	opts := []import_run.RecordQuery{}
+
+	if request.Params.Status != nil {
+		status, err := import_run.NewRecordStatus(string(*request.Params.Status))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		opts = append(opts, import_run.WithRecordStatus(status))
+	}
+
+	if request.Params.Kind != nil {
+		kind, err := import_run.NewKind(string(*request.Params.Kind))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		opts = append(opts, import_run.WithRecordKind(kind))
+	}
+
+	result, err := h.importer.Records(ctx, import_run.RunID(deserialiseID(request.ImportRunId)), page, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminImportRecordList200JSONResponse{
+		AdminImportRecordListOKJSONResponse: openapi.AdminImportRecordListOKJSONResponse{
+			CurrentPage: result.CurrentPage,
+			NextPage:    result.NextPage.Ptr(),
+			PageSize:    result.Size,
+			Results:     result.Results,
+			TotalPages:  result.TotalPages,
+			Records:     dt.Map(result.Items, serialiseImportRecord),
+		},
+	}, nil
+}
+
+func serialiseImportRun(in *import_run.Run) openapi.ImportRun {
+	return openapi.ImportRun{
+		Id:         in.ID.String(),
+		CreatedAt:  in.CreatedAt,
+		UpdatedAt:  in.UpdatedAt,
+		Source:     openapi.ImportSource(in.Source),
+		AccountId:  in.AccountID.String(),
+		AssetId:    opt.Map(in.AssetID, func(id asset.AssetID) string { return id.String() }).Ptr(),
+		Status:     openapi.ImportRunStatus(in.Status.String()),
+		Error:      in.Error.Ptr(),
+		FinishedAt: in.FinishedAt.Ptr(),
+	}
+}
+
+func serialiseImportRunWithStats(in *import_run.Run, stats []*import_run.KindStats) openapi.ImportRunWithStats {
+	r := serialiseImportRun(in)
+	return openapi.ImportRunWithStats{
+		Id:         r.Id,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+		Source:     r.Source,
+		AccountId:  r.AccountId,
+		AssetId:    r.AssetId,
+		Status:     r.Status,
+		Error:      r.Error,
+		FinishedAt: r.FinishedAt,
+		Stats: dt.Map(stats, func(s *import_run.KindStats) openapi.ImportKindStats {
+			return openapi.ImportKindStats{
+				Kind:     openapi.ImportRecordKind(s.Kind.String()),
+				Imported: s.Imported,
+				Skipped:  s.Skipped,
+				Failed:   s.Failed,
+			}
+		}),
+	}
+}
+
+func serialiseImportRecord(in *import_run.Record) openapi.ImportRecord {
+	return openapi.ImportRecord{
+		Id:         in.ID.String(),
+		CreatedAt:  in.CreatedAt,
+		UpdatedAt:  in.UpdatedAt,
+		Kind:       openapi.ImportRecordKind(in.Kind.String()),
+		ExternalId: in.ExternalID,
+		InternalId: opt.Map(in.InternalID, func(id xid.ID) string { return id.String() }).Ptr(),
+		Status:     openapi.ImportRecordStatus(in.Status.String()),
+		Message:    in.Message.Ptr(),
+	}
+}
@@ -2,16 +2,19 @@ package bindings
 
 import (
 	"context"
+	"time"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"golang.org/x/text/language"
 
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/services/branding/banner"
 	"github.com/Southclaws/storyden/app/services/branding/icon"
 	"github.com/Southclaws/storyden/app/services/system/instance_info"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/i18n"
 )
 
 type Info struct {
@@ -94,15 +97,27 @@ func (i Info) BannerUpload(ctx context.Context, request openapi.BannerUploadRequ
 }
 
 func serialiseInfo(info *instance_info.Info) openapi.Info {
+	var registrationDomains *[]string
+	if d := info.Settings.EmailDomains.OrZero(); d.Restricted {
+		domains := d.Domains()
+		registrationDomains = &domains
+	}
+
 	return openapi.Info{
-		Title:              info.Settings.Title.OrZero(),
-		Description:        info.Settings.Description.OrZero(),
-		Content:            info.Settings.Content.OrZero().HTML(),
-		AccentColour:       info.Settings.AccentColour.OrZero(),
-		OnboardingStatus:   openapi.OnboardingStatus(info.OnboardingStatus.String()),
-		AuthenticationMode: openapi.AuthMode(info.Settings.AuthenticationMode.Or(authentication.ModeHandle).String()),
-		Capabilities:       serialiseCapabilitiesList(info.Capabilities),
-		Metadata:           (*openapi.Metadata)(info.Settings.Metadata.Ptr()),
+		Title:               info.Settings.Title.OrZero(),
+		Description:         info.Settings.Description.OrZero(),
+		Content:             info.Settings.Content.OrZero().HTML(),
+		AccentColour:        info.Settings.AccentColour.OrZero(),
+		DefaultLocale:       info.Settings.DefaultLocale.Or(i18n.Default.String()),
+		DefaultTimezone:     info.Settings.DefaultTimezone.Or(time.UTC.String()),
+		Locales:             dt.Map(i18n.Supported(), func(t language.Tag) string { return t.String() }),
+		OnboardingStatus:    openapi.OnboardingStatus(info.OnboardingStatus.String()),
+		AuthenticationMode:  openapi.AuthMode(info.Settings.AuthenticationMode.Or(authentication.ModeHandle).String()),
+		InvitationRequired:  info.Settings.Invitations.OrZero().Required,
+		ApprovalRequired:    info.Settings.Applications.OrZero().Required,
+		RegistrationDomains: registrationDomains,
+		Capabilities:        serialiseCapabilitiesList(info.Capabilities),
+		Metadata:            (*openapi.Metadata)(info.Settings.Metadata.Ptr()),
 	}
 }
 
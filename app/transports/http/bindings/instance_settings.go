@@ -0,0 +1,114 @@
+package bindings
+
+import (
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/rate_limit"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+var (
+	errInvalidRequestSize   = fault.New("max request size must not be negative")
+	errInvalidSpamThreshold = fault.New("spam threshold must be between 0 and 1")
+)
+
+func serialiseInstanceLimits(in settings.Limits) openapi.InstanceLimits {
+	return openapi.InstanceLimits{
+		MaxRequestSize: &in.MaxRequestSize,
+		SpamThreshold:  &in.SpamThreshold,
+	}
+}
+
+func deserialiseInstanceLimits(in openapi.InstanceLimits) (settings.Limits, error) {
+	out := settings.Limits{
+		MaxRequestSize: opt.NewPtr(in.MaxRequestSize).OrZero(),
+		SpamThreshold:  opt.NewPtr(in.SpamThreshold).OrZero(),
+	}
+
+	if out.MaxRequestSize < 0 {
+		return settings.Limits{}, errInvalidRequestSize
+	}
+
+	if out.SpamThreshold < 0 || out.SpamThreshold > 1 {
+		return settings.Limits{}, errInvalidSpamThreshold
+	}
+
+	return out, nil
+}
+
+func serialiseInstanceFeatures(in settings.Features) openapi.InstanceFeatures {
+	return openapi.InstanceFeatures{
+		LinkSnapshots:      in.LinkSnapshots.Ptr(),
+		LinkWaybackArchive: in.LinkWaybackArchive.Ptr(),
+	}
+}
+
+func deserialiseInstanceFeatures(in openapi.InstanceFeatures) settings.Features {
+	return settings.Features{
+		LinkSnapshots:      opt.NewPtr(in.LinkSnapshots),
+		LinkWaybackArchive: opt.NewPtr(in.LinkWaybackArchive),
+	}
+}
+
+func serialiseRateLimitSettings(in rate_limit.Settings) openapi.RateLimitSettings {
+	roles := dt.Map(in.Roles, func(r rate_limit.RoleLimits) openapi.RoleRateLimits {
+		return openapi.RoleRateLimits{
+			RoleId: r.RoleID.String(),
+			Limits: serialiseRateLimits(r.Limits),
+		}
+	})
+
+	keys := dt.Map(in.AccessKeys, func(k rate_limit.AccessKeyLimits) openapi.AccessKeyRateLimits {
+		return openapi.AccessKeyRateLimits{
+			AccessKeyId: k.AccessKeyID.String(),
+			Limits:      serialiseRateLimits(k.Limits),
+		}
+	})
+
+	def := serialiseRateLimits(in.Default)
+
+	return openapi.RateLimitSettings{
+		Default:    &def,
+		Roles:      &roles,
+		AccessKeys: &keys,
+	}
+}
+
+func serialiseRateLimits(in rate_limit.Limits) openapi.RateLimits {
+	return openapi.RateLimits{
+		Read:   in.Read.Ptr(),
+		Write:  in.Write.Ptr(),
+		Auth:   in.Auth.Ptr(),
+		Upload: in.Upload.Ptr(),
+	}
+}
+
+func deserialiseRateLimitSettings(in openapi.RateLimitSettings) rate_limit.Settings {
+	return rate_limit.Settings{
+		Default: deserialiseRateLimits(opt.NewPtr(in.Default).OrZero()),
+		Roles: dt.Map(opt.NewPtr(in.Roles).OrZero(), func(r openapi.RoleRateLimits) rate_limit.RoleLimits {
+			return rate_limit.RoleLimits{
+				RoleID: deserialiseID(r.RoleId),
+				Limits: deserialiseRateLimits(r.Limits),
+			}
+		}),
+		AccessKeys: dt.Map(opt.NewPtr(in.AccessKeys).OrZero(), func(k openapi.AccessKeyRateLimits) rate_limit.AccessKeyLimits {
+			return rate_limit.AccessKeyLimits{
+				AccessKeyID: deserialiseID(k.AccessKeyId),
+				Limits:      deserialiseRateLimits(k.Limits),
+			}
+		}),
+	}
+}
+
+func deserialiseRateLimits(in openapi.RateLimits) rate_limit.Limits {
+	return rate_limit.Limits{
+		Read:   opt.NewPtr(in.Read),
+		Write:  opt.NewPtr(in.Write),
+		Auth:   opt.NewPtr(in.Auth),
+		Upload: opt.NewPtr(in.Upload),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/invitation"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_querier"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/account/invitation_manager"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
@@ -23,13 +26,15 @@ type Invitations struct {
 	accountQuerier *account_querier.Querier
 	invQuerier     *invitation_querier.Querier
 	invWriter      *invitation_writer.Writer
+	invManager     *invitation_manager.Manager
 }
 
-func NewInvitations(accountQuerier *account_querier.Querier, invQuerier *invitation_querier.Querier, invWriter *invitation_writer.Writer) Invitations {
+func NewInvitations(accountQuerier *account_querier.Querier, invQuerier *invitation_querier.Querier, invWriter *invitation_writer.Writer, invManager *invitation_manager.Manager) Invitations {
 	return Invitations{
 		accountQuerier: accountQuerier,
 		invQuerier:     invQuerier,
 		invWriter:      invWriter,
+		invManager:     invManager,
 	}
 }
 
@@ -77,17 +82,14 @@ func (h *Invitations) InvitationList(ctx context.Context, request openapi.Invita
 }
 
 func (h *Invitations) InvitationCreate(ctx context.Context, request openapi.InvitationCreateRequestObject) (openapi.InvitationCreateResponseObject, error) {
-	session, err := session.GetAccountID(ctx)
-	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
-	}
-
-	acc, err := h.accountQuerier.GetByID(ctx, session)
-	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
-	}
-
-	inv, err := h.invWriter.Create(ctx, account.AccountID(acc.ID), opt.NewPtr(request.Body.Message))
+	inv, err := h.invManager.Create(ctx, invitation_manager.Partial{
+		Message:   opt.NewPtr(request.Body.Message),
+		MaxUses:   opt.NewPtr(request.Body.MaxUses),
+		ExpiresAt: opt.NewPtr(request.Body.ExpiresAt),
+		Role: opt.Map(opt.NewPtr(request.Body.RoleId), func(id openapi.Identifier) role.RoleID {
+			return role.RoleID(openapi.ParseID(id))
+		}),
+	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -152,6 +154,26 @@ func (h *Invitations) InvitationGet(ctx context.Context, request openapi.Invitat
 	}, nil
 }
 
+func (h *Invitations) InvitationMemberList(ctx context.Context, request openapi.InvitationMemberListRequestObject) (openapi.InvitationMemberListResponseObject, error) {
+	invid, err := xid.FromString(request.InvitationId)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	accs, err := h.invManager.Invited(ctx, invid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.InvitationMemberList200JSONResponse{
+		InvitationMemberListOKJSONResponse: openapi.InvitationMemberListOKJSONResponse{
+			Members: dt.Map(accs, func(a *account.Account) openapi.ProfileReference {
+				return serialiseProfileReferenceFromAccount(*a)
+			}),
+		},
+	}, nil
+}
+
 func serialiseInvitationPtr(inv *invitation.Invitation) openapi.Invitation {
 	return openapi.Invitation{
 		Id:        inv.ID.String(),
@@ -160,6 +182,24 @@ func serialiseInvitationPtr(inv *invitation.Invitation) openapi.Invitation {
 		DeletedAt: inv.DeletedAt.Ptr(),
 		Creator:   serialiseProfileReferenceFromAccount(inv.Creator),
 		Message:   inv.Message.Ptr(),
+		MaxUses:   inv.MaxUses.Ptr(),
+		ExpiresAt: inv.ExpiresAt.Ptr(),
+		RoleId:    opt.Map(inv.Role, func(id role.RoleID) openapi.Identifier { return openapi.Identifier(id.String()) }).Ptr(),
+		Uses:      inv.Uses,
+	}
+}
+
+func serialiseInvitationSettings(in invitation.Settings) openapi.InvitationSettings {
+	return openapi.InvitationSettings{
+		Required: &in.Required,
+		Quota:    &in.Quota,
+	}
+}
+
+func deserialiseInvitationSettings(in openapi.InvitationSettings) invitation.Settings {
+	return invitation.Settings{
+		Required: opt.NewPtr(in.Required).OrZero(),
+		Quota:    opt.NewPtr(in.Quota).OrZero(),
 	}
 }
 
@@ -0,0 +1,125 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/job"
+	"github.com/Southclaws/storyden/app/services/job_queue"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Jobs struct {
+	jobQueue *job_queue.Queue
+}
+
+func NewJobs(jobQueue *job_queue.Queue) Jobs {
+	return Jobs{jobQueue: jobQueue}
+}
+
+func (h Jobs) AdminJobList(ctx context.Context, request openapi.AdminJobListRequestObject) (openapi.AdminJobListResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	opts := []job.Query{}
+
+	if request.Params.Status != nil {
+		status, err := job.NewStatus(string(*request.Params.Status))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		opts = append(opts, job.WithStatus(status))
+	}
+
+	if request.Params.Kind != nil {
+		opts = append(opts, job.WithKind(*request.Params.Kind))
+	}
+
+	result, err := h.jobQueue.List(ctx, page, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminJobList200JSONResponse{
+		AdminJobListOKJSONResponse: openapi.AdminJobListOKJSONResponse{
+			CurrentPage: result.CurrentPage,
+			NextPage:    result.NextPage.Ptr(),
+			PageSize:    result.Size,
+			Results:     result.Results,
+			TotalPages:  result.TotalPages,
+			Jobs:        dt.Map(result.Items, serialiseJob),
+		},
+	}, nil
+}
+
+func (h Jobs) AdminJobStats(ctx context.Context, request openapi.AdminJobStatsRequestObject) (openapi.AdminJobStatsResponseObject, error) {
+	stats, err := h.jobQueue.Stats(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminJobStats200JSONResponse{
+		AdminJobStatsOKJSONResponse: openapi.AdminJobStatsOKJSONResponse{
+			Kinds: dt.Map(stats, func(s *job.KindStats) openapi.JobKindStats {
+				return openapi.JobKindStats{
+					Kind:      s.Kind,
+					Pending:   s.Pending,
+					Running:   s.Running,
+					Succeeded: s.Succeeded,
+					Dead:      s.Dead,
+				}
+			}),
+		},
+	}, nil
+}
+
+func (h Jobs) AdminJobGet(ctx context.Context, request openapi.AdminJobGetRequestObject) (openapi.AdminJobGetResponseObject, error) {
+	j, err := h.jobQueue.Get(ctx, job.JobID(deserialiseID(request.JobId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminJobGet200JSONResponse{
+		AdminJobOKJSONResponse: openapi.AdminJobOKJSONResponse(serialiseJob(j)),
+	}, nil
+}
+
+func (h Jobs) AdminJobDelete(ctx context.Context, request openapi.AdminJobDeleteRequestObject) (openapi.AdminJobDeleteResponseObject, error) {
+	err := h.jobQueue.Delete(ctx, job.JobID(deserialiseID(request.JobId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminJobDelete204Response{}, nil
+}
+
+func (h Jobs) AdminJobRetry(ctx context.Context, request openapi.AdminJobRetryRequestObject) (openapi.AdminJobRetryResponseObject, error) {
+	j, err := h.jobQueue.Retry(ctx, job.JobID(deserialiseID(request.JobId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminJobRetry200JSONResponse{
+		AdminJobOKJSONResponse: openapi.AdminJobOKJSONResponse(serialiseJob(j)),
+	}, nil
+}
+
+func serialiseJob(in *job.Job) openapi.Job {
+	return openapi.Job{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Kind:        in.Kind,
+		Status:      openapi.JobStatus(in.Status.String()),
+		Attempts:    in.Attempts,
+		MaxAttempts: in.MaxAttempts,
+		RunAt:       in.RunAt,
+		StartedAt:   in.StartedAt.Ptr(),
+		FinishedAt:  in.FinishedAt.Ptr(),
+		Payload:     string(in.Payload),
+		LastError:   in.LastError.Ptr(),
+	}
+}
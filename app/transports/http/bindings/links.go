@@ -13,6 +13,7 @@ import (
 	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/link"
+	"github.com/Southclaws/storyden/app/resources/link/link_check"
 	"github.com/Southclaws/storyden/app/resources/link/link_querier"
 	"github.com/Southclaws/storyden/app/resources/link/link_ref"
 	"github.com/Southclaws/storyden/app/resources/link/link_writer"
@@ -25,17 +26,20 @@ type Links struct {
 	fetcher     *fetcher.Fetcher
 	linkWriter  *link_writer.LinkWriter
 	linkQuerier *link_querier.LinkQuerier
+	linkChecks  *link_check.Repository
 }
 
 func NewLinks(
 	fetcher *fetcher.Fetcher,
 	linkWriter *link_writer.LinkWriter,
 	linkQuerier *link_querier.LinkQuerier,
+	linkChecks *link_check.Repository,
 ) Links {
 	return Links{
 		fetcher:     fetcher,
 		linkWriter:  linkWriter,
 		linkQuerier: linkQuerier,
+		linkChecks:  linkChecks,
 	}
 }
 
@@ -110,6 +114,35 @@ func (i *Links) LinkGet(ctx context.Context, request openapi.LinkGetRequestObjec
 	}, nil
 }
 
+func (i *Links) LinkBrokenList(ctx context.Context, request openapi.LinkBrokenListRequestObject) (openapi.LinkBrokenListResponseObject, error) {
+	broken, err := i.linkChecks.ListBroken(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.LinkBrokenList200JSONResponse{
+		LinkBrokenListOKJSONResponse: openapi.LinkBrokenListOKJSONResponse{
+			Links: dt.Map(broken, serialiseBrokenLink),
+		},
+	}, nil
+}
+
+func serialiseBrokenLink(in *link_check.BrokenLink) openapi.BrokenLink {
+	return openapi.BrokenLink{
+		Link:     serialiseLinkRef(*in.Link),
+		Failures: in.Failures,
+		History:  dt.Map(in.History, serialiseLinkCheck),
+	}
+}
+
+func serialiseLinkCheck(in *link_check.Check) openapi.LinkCheck {
+	return openapi.LinkCheck{
+		CheckedAt:  in.CheckedAt,
+		StatusCode: in.StatusCode.Ptr(),
+		Error:      in.Error.Ptr(),
+	}
+}
+
 func serialiseLink(in *link.Link) openapi.Link {
 	return openapi.Link{
 		Id:             in.ID.String(),
@@ -120,8 +153,12 @@ func serialiseLink(in *link.Link) openapi.Link {
 		Domain:         in.Domain,
 		Title:          in.Title.Ptr(),
 		Description:    in.Description.Ptr(),
+		SiteName:       in.SiteName.Ptr(),
+		BrokenAt:       in.BrokenAt.Ptr(),
 		FaviconImage:   opt.Map(in.FaviconImage, serialiseAsset).Ptr(),
 		PrimaryImage:   opt.Map(in.PrimaryImage, serialiseAsset).Ptr(),
+		Snapshot:       opt.Map(in.Snapshot, serialiseAsset).Ptr(),
+		ArchiveUrl:     in.ArchiveURL.Ptr(),
 		Assets:         dt.Map(in.Assets, serialiseAssetPtr),
 		Nodes:          dt.Map(in.Nodes, serialiseNode),
 		Posts:          dt.Map(in.Posts, serialisePostRef),
@@ -139,8 +176,12 @@ func serialiseLinkRef(in link_ref.LinkRef) openapi.LinkReference {
 		Domain:       in.Domain,
 		Title:        in.Title.Ptr(),
 		Description:  in.Description.Ptr(),
+		SiteName:     in.SiteName.Ptr(),
+		BrokenAt:     in.BrokenAt.Ptr(),
 		FaviconImage: opt.Map(in.FaviconImage, serialiseAsset).Ptr(),
 		PrimaryImage: opt.Map(in.PrimaryImage, serialiseAsset).Ptr(),
+		Snapshot:     opt.Map(in.Snapshot, serialiseAsset).Ptr(),
+		ArchiveUrl:   in.ArchiveURL.Ptr(),
 	}
 }
 
@@ -0,0 +1,72 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/matrix_appservice"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Matrix struct {
+	bridge *matrix_appservice.Bridge
+}
+
+func NewMatrix(bridge *matrix_appservice.Bridge) Matrix {
+	return Matrix{bridge: bridge}
+}
+
+func (h Matrix) AccountMatrixLinkGet(ctx context.Context, request openapi.AccountMatrixLinkGetRequestObject) (openapi.AccountMatrixLinkGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	userID, err := h.bridge.Linked(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountMatrixLinkGet200JSONResponse{
+		AccountMatrixLinkOKJSONResponse: openapi.AccountMatrixLinkOKJSONResponse{
+			BotUserId: h.bridge.BotUserID(),
+			UserId:    userID.Ptr(),
+		},
+	}, nil
+}
+
+func (h Matrix) AccountMatrixLinkCreate(ctx context.Context, request openapi.AccountMatrixLinkCreateRequestObject) (openapi.AccountMatrixLinkCreateResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	code, expires, err := h.bridge.LinkCode(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountMatrixLinkCreate200JSONResponse{
+		AccountMatrixLinkCodeOKJSONResponse: openapi.AccountMatrixLinkCodeOKJSONResponse{
+			BotUserId: h.bridge.BotUserID(),
+			Code:      code,
+			ExpiresAt: expires,
+		},
+	}, nil
+}
+
+func (h Matrix) AccountMatrixLinkRemove(ctx context.Context, request openapi.AccountMatrixLinkRemoveRequestObject) (openapi.AccountMatrixLinkRemoveResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := h.bridge.Unlink(ctx, accountID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountMatrixLinkRemove204Response{}, nil
+}
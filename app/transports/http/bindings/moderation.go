@@ -0,0 +1,133 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/moderation_note"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/services/moderation/case_file"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Moderation struct {
+	accountQuery *account_querier.Querier
+	profileQuery *profile_querier.Querier
+	caseFile     *case_file.Manager
+}
+
+func NewModeration(
+	accountQuery *account_querier.Querier,
+	profileQuery *profile_querier.Querier,
+	caseFile *case_file.Manager,
+) Moderation {
+	return Moderation{
+		accountQuery: accountQuery,
+		profileQuery: profileQuery,
+		caseFile:     caseFile,
+	}
+}
+
+func (h Moderation) ModerationNoteList(ctx context.Context, request openapi.ModerationNoteListRequestObject) (openapi.ModerationNoteListResponseObject, error) {
+	targetID, err := xid.FromString(request.Params.TargetId)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	notes, err := h.caseFile.ListNotes(ctx, targetID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationNoteList200JSONResponse{
+		ModerationNoteListOKJSONResponse: openapi.ModerationNoteListOKJSONResponse{
+			Notes: dt.Map(notes, serialiseModerationNote),
+		},
+	}, nil
+}
+
+func (h Moderation) ModerationNoteCreate(ctx context.Context, request openapi.ModerationNoteCreateRequestObject) (openapi.ModerationNoteCreateResponseObject, error) {
+	targetID, err := xid.FromString(request.Body.TargetId)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	targetKind, err := datagraph.NewKind(string(request.Body.TargetKind))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	note, err := h.caseFile.AddNote(ctx, datagraph.Ref{ID: targetID, Kind: targetKind}, request.Body.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationNoteCreate200JSONResponse{
+		ModerationNoteOKJSONResponse: openapi.ModerationNoteOKJSONResponse(serialiseModerationNote(note)),
+	}, nil
+}
+
+func (h Moderation) ModerationNoteUpdate(ctx context.Context, request openapi.ModerationNoteUpdateRequestObject) (openapi.ModerationNoteUpdateResponseObject, error) {
+	note, err := h.caseFile.UpdateNote(ctx, moderation_note.NoteID(deserialiseID(request.ModerationNoteId)), request.Body.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationNoteUpdate200JSONResponse{
+		ModerationNoteOKJSONResponse: openapi.ModerationNoteOKJSONResponse(serialiseModerationNote(note)),
+	}, nil
+}
+
+func (h Moderation) ModerationNoteDelete(ctx context.Context, request openapi.ModerationNoteDeleteRequestObject) (openapi.ModerationNoteDeleteResponseObject, error) {
+	err := h.caseFile.DeleteNote(ctx, moderation_note.NoteID(deserialiseID(request.ModerationNoteId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationNoteDelete204Response{}, nil
+}
+
+func (h Moderation) ModerationCaseFileGet(ctx context.Context, request openapi.ModerationCaseFileGetRequestObject) (openapi.ModerationCaseFileGetResponseObject, error) {
+	id, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := h.accountQuery.GetByID(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	notes, err := h.caseFile.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationCaseFileGet200JSONResponse{
+		ModerationCaseFileGetOKJSONResponse: openapi.ModerationCaseFileGetOKJSONResponse{
+			Account: serialiseProfileReferenceFromAccount(acc.Account),
+			Entries: dt.Map(notes, serialiseModerationNote),
+		},
+	}, nil
+}
+
+func serialiseModerationNote(in *moderation_note.Note) openapi.ModerationNote {
+	return openapi.ModerationNote{
+		Id:         in.ID.String(),
+		CreatedAt:  in.CreatedAt,
+		UpdatedAt:  in.UpdatedAt,
+		Kind:       openapi.ModerationNoteKind(in.Kind.String()),
+		TargetId:   in.Target.ID.String(),
+		TargetKind: openapi.DatagraphItemKind(in.Target.Kind.String()),
+		Author:     opt.Map(in.Author, serialiseProfileReferenceFromAccount).Ptr(),
+		Content:    in.Content.Ptr(),
+	}
+}
@@ -0,0 +1,122 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/netban"
+	"github.com/Southclaws/storyden/app/services/moderation/netban_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/deletable"
+)
+
+type NetworkBans struct {
+	netbanManager *netban_manager.Manager
+}
+
+func NewNetworkBans(
+	netbanManager *netban_manager.Manager,
+) NetworkBans {
+	return NetworkBans{
+		netbanManager: netbanManager,
+	}
+}
+
+func (h NetworkBans) AdminNetworkBanList(ctx context.Context, request openapi.AdminNetworkBanListRequestObject) (openapi.AdminNetworkBanListResponseObject, error) {
+	bans, err := h.netbanManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	now := time.Now()
+
+	return openapi.AdminNetworkBanList200JSONResponse{
+		AdminNetworkBanListOKJSONResponse: openapi.AdminNetworkBanListOKJSONResponse{
+			Bans: dt.Map(bans, func(b *netban.Ban) openapi.NetworkBan { return serialiseNetworkBan(b, now) }),
+		},
+	}, nil
+}
+
+func (h NetworkBans) AdminNetworkBanCreate(ctx context.Context, request openapi.AdminNetworkBanCreateRequestObject) (openapi.AdminNetworkBanCreateResponseObject, error) {
+	target, err := netban.ParseTarget(string(request.Body.Target))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	list, err := netban.NewList(string(request.Body.List))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	b, err := h.netbanManager.Create(ctx, target, list, netban_manager.Partial{
+		Reason:    opt.NewPtr(request.Body.Reason),
+		ExpiresAt: deletable.Skip(opt.NewPtr(request.Body.ExpiresAt)),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminNetworkBanCreate200JSONResponse{
+		AdminNetworkBanOKJSONResponse: openapi.AdminNetworkBanOKJSONResponse(serialiseNetworkBan(b, time.Now())),
+	}, nil
+}
+
+func (h NetworkBans) AdminNetworkBanUpdate(ctx context.Context, request openapi.AdminNetworkBanUpdateRequestObject) (openapi.AdminNetworkBanUpdateResponseObject, error) {
+	target, err := opt.MapErr(opt.NewPtr(request.Body.Target), func(t openapi.NetworkBanTarget) (netban.Target, error) {
+		return netban.ParseTarget(string(t))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	list, err := opt.MapErr(opt.NewPtr(request.Body.List), func(l openapi.NetworkBanListKind) (netban.List, error) {
+		return netban.NewList(string(l))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	b, err := h.netbanManager.Update(ctx, netban.BanID(deserialiseID(request.NetworkBanId)), netban_manager.Partial{
+		Target:    target,
+		List:      list,
+		Reason:    opt.NewPtr(request.Body.Reason),
+		ExpiresAt: deletable.New(request.Body.ExpiresAt),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminNetworkBanUpdate200JSONResponse{
+		AdminNetworkBanOKJSONResponse: openapi.AdminNetworkBanOKJSONResponse(serialiseNetworkBan(b, time.Now())),
+	}, nil
+}
+
+func (h NetworkBans) AdminNetworkBanDelete(ctx context.Context, request openapi.AdminNetworkBanDeleteRequestObject) (openapi.AdminNetworkBanDeleteResponseObject, error) {
+	err := h.netbanManager.Delete(ctx, netban.BanID(deserialiseID(request.NetworkBanId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminNetworkBanDelete204Response{}, nil
+}
+
+func serialiseNetworkBan(in *netban.Ban, now time.Time) openapi.NetworkBan {
+	return openapi.NetworkBan{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Target:    openapi.NetworkBanTarget(in.Target.String()),
+		List:      openapi.NetworkBanListKind(in.List.String()),
+		Reason:    in.Reason.Ptr(),
+		ExpiresAt: in.ExpiresAt.Ptr(),
+		Active:    in.Active(now),
+		Hits:      in.Hits,
+		LastHitAt: in.LastHitAt.Ptr(),
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/library/node_querier"
 	"github.com/Southclaws/storyden/app/resources/library/node_traversal"
 	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/rbac"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/app/resources/visibility"
@@ -34,6 +35,7 @@ import (
 	"github.com/Southclaws/storyden/app/services/library/node_read"
 	"github.com/Southclaws/storyden/app/services/library/node_visibility"
 	"github.com/Southclaws/storyden/app/services/library/nodetree"
+	"github.com/Southclaws/storyden/app/services/related"
 	"github.com/Southclaws/storyden/app/services/reqinfo"
 	"github.com/Southclaws/storyden/app/services/tag/autotagger"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
@@ -53,6 +55,7 @@ type Nodes struct {
 	ntr           node_traversal.Repository
 	schemaUpdater *node_property_schema.Updater
 	node_cache    *node_cache.Cache
+	relatedFinder *related.Finder
 }
 
 func NewNodes(
@@ -68,6 +71,7 @@ func NewNodes(
 	ntr node_traversal.Repository,
 	schemaUpdater *node_property_schema.Updater,
 	node_cache *node_cache.Cache,
+	relatedFinder *related.Finder,
 ) Nodes {
 	return Nodes{
 		accountQuery:  accountQuery,
@@ -82,6 +86,7 @@ func NewNodes(
 		ntr:           ntr,
 		schemaUpdater: schemaUpdater,
 		node_cache:    node_cache,
+		relatedFinder: relatedFinder,
 	}
 }
 
@@ -219,18 +224,69 @@ func (c *Nodes) NodeList(ctx context.Context, request openapi.NodeListRequestObj
 
 	flatten := opt.NewPtr(request.Params.Format).Or(openapi.NodeListParamsFormatTree) == openapi.NodeListParamsFormatFlat
 
+	pp, err := deserialiseCursorParams(request.Params.Page, request.Params.Cursor, 100)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	cs, err = c.ntr.Subtree(ctx, nid, flatten, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	result := pageNodeList(pp, cs, flatten)
+
 	return openapi.NodeList200JSONResponse{
 		NodeListOKJSONResponse: openapi.NodeListOKJSONResponse{
-			Nodes: dt.Map(cs, serialiseNodeWithItems),
+			CurrentPage: result.CurrentPage,
+			NextPage:    result.NextPage.Ptr(),
+			NextCursor:  serialiseCursor(result.NextCursor),
+			PageSize:    result.Size,
+			Results:     result.Results,
+			TotalPages:  result.TotalPages,
+			Nodes:       dt.Map(result.Items, serialiseNodeWithItems),
 		},
 	}, nil
 }
 
+// pageNodeList pages through the top level of a node list by sort key, child
+// nodes are always included with their top level node. Flat lists contain the
+// children alongside their parents so those are kept for the page's nodes too.
+func pageNodeList(pp pagination.Parameters, cs []*library.Node, flatten bool) pagination.Result[*library.Node] {
+	present := lo.SliceToMap(cs, func(n *library.Node) (xid.ID, bool) { return n.Mark.ID(), true })
+	parentOf := func(n *library.Node) (xid.ID, bool) {
+		p, ok := n.Parent.Get()
+		if !ok || !present[p.Mark.ID()] {
+			return xid.ID{}, false
+		}
+		return p.Mark.ID(), true
+	}
+
+	top := dt.Filter(cs, func(n *library.Node) bool {
+		_, hasParent := parentOf(n)
+		return !hasParent
+	})
+
+	result := pagination.NewSliceResult(pp, top, func(n *library.Node) pagination.Cursor {
+		return pagination.NewCursor(n.SortKey.String(), n.Mark.ID())
+	})
+
+	if !flatten {
+		return result
+	}
+
+	included := lo.SliceToMap(result.Items, func(n *library.Node) (xid.ID, bool) { return n.Mark.ID(), true })
+	for _, n := range cs {
+		if p, ok := parentOf(n); ok && included[p] {
+			included[n.Mark.ID()] = true
+		}
+	}
+
+	return pagination.ConvertPageResult(result, dt.Filter(cs, func(n *library.Node) bool {
+		return included[n.Mark.ID()]
+	}))
+}
+
 const nodeGetCacheControl = "public, max-age=1, stale-while-revalidate=120"
 
 func (c *Nodes) NodeGet(ctx context.Context, request openapi.NodeGetRequestObject) (openapi.NodeGetResponseObject, error) {
@@ -424,6 +480,24 @@ func (c *Nodes) NodeGenerateTags(ctx context.Context, request openapi.NodeGenera
 	}, nil
 }
 
+func (c *Nodes) NodeRelated(ctx context.Context, request openapi.NodeRelatedRequestObject) (openapi.NodeRelatedResponseObject, error) {
+	node, err := c.nodeReader.GetBySlug(ctx, deserialiseNodeMark(request.NodeSlug), opt.NewEmpty[node_querier.ChildSortRule]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := c.relatedFinder.Related(ctx, node, opt.NewPtr(request.Params.Limit).OrZero())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NodeRelated200JSONResponse{
+		DatagraphRelatedOKJSONResponse: openapi.DatagraphRelatedOKJSONResponse{
+			Items: serialiseDatagraphItemList(items),
+		},
+	}, nil
+}
+
 func (c *Nodes) NodeGenerateTitle(ctx context.Context, request openapi.NodeGenerateTitleRequestObject) (openapi.NodeGenerateTitleResponseObject, error) {
 	content, err := datagraph.NewRichText(request.Body.Content)
 	if err != nil {
@@ -680,6 +754,7 @@ func serialiseNode(in *library.Node) openapi.Node {
 		Tags:          serialiseTagReferenceList(in.Tags),
 		Visibility:    serialiseVisibility(in.Visibility),
 		Meta:          in.Metadata,
+		Summary:       in.Summary.Ptr(),
 	}
 }
 
@@ -710,6 +785,7 @@ func serialiseNodeWithItems(in *library.Node) openapi.NodeWithChildren {
 		Visibility:          serialiseVisibility(in.Visibility),
 		RelevanceScore:      rs.Ptr(),
 		Meta:                in.Metadata,
+		Summary:             in.Summary.Ptr(),
 		Children:            dt.Map(in.Nodes, serialiseNodeWithItems),
 	}
 }
@@ -10,22 +10,34 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/notification"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_querier"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_writer"
+	"github.com/Southclaws/storyden/app/resources/account/notification/push_subscription"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/notification/digest_email"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/webpush"
 )
 
 type Notifications struct {
-	notifyReader *notify_querier.Querier
-	notifyWriter *notify_writer.Writer
+	notifyReader  *notify_querier.Querier
+	notifyWriter  *notify_writer.Writer
+	subscriptions *push_subscription.Repository
+	pushSender    *webpush.Sender
+	unsubscriber  *digest_email.Unsubscriber
 }
 
 func NewNotifications(
 	notifyReader *notify_querier.Querier,
 	notifyWriter *notify_writer.Writer,
+	subscriptions *push_subscription.Repository,
+	pushSender *webpush.Sender,
+	unsubscriber *digest_email.Unsubscriber,
 ) Notifications {
 	return Notifications{
-		notifyReader: notifyReader,
-		notifyWriter: notifyWriter,
+		notifyReader:  notifyReader,
+		notifyWriter:  notifyWriter,
+		subscriptions: subscriptions,
+		pushSender:    pushSender,
+		unsubscriber:  unsubscriber,
 	}
 }
 
@@ -35,22 +47,25 @@ func (h *Notifications) NotificationList(ctx context.Context, request openapi.No
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	list, err := h.notifyReader.ListNotifications(ctx, session)
+	pp, err := deserialiseCursorParams(request.Params.Page, request.Params.Cursor, 50)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	notifications := dt.Map(list, serialiseNotification)
+	result, err := h.notifyReader.ListNotifications(ctx, session, pp)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
 
 	return openapi.NotificationList200JSONResponse{
 		NotificationListOKJSONResponse: openapi.NotificationListOKJSONResponse{
-			// TODO: Pagination at resource layer.
-			CurrentPage:   1,
-			NextPage:      new(int),
-			Notifications: notifications,
-			PageSize:      len(notifications),
-			Results:       len(notifications),
-			TotalPages:    1,
+			CurrentPage:   result.CurrentPage,
+			NextPage:      result.NextPage.Ptr(),
+			NextCursor:    serialiseCursor(result.NextCursor),
+			Notifications: dt.Map(result.Items, serialiseNotification),
+			PageSize:      result.Size,
+			Results:       result.Results,
+			TotalPages:    result.TotalPages,
 		},
 	}, nil
 }
@@ -116,6 +131,14 @@ func (h *Notifications) NotificationUpdateMany(ctx context.Context, request open
 	}, nil
 }
 
+func (h *Notifications) NotificationDigestUnsubscribe(ctx context.Context, request openapi.NotificationDigestUnsubscribeRequestObject) (openapi.NotificationDigestUnsubscribeResponseObject, error) {
+	if err := h.unsubscriber.Unsubscribe(ctx, request.Body.Token); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NotificationDigestUnsubscribe204Response{}, nil
+}
+
 func serialiseNotification(in *notification.Notification) openapi.Notification {
 	item := opt.Map(opt.NewSafe(in.Item, in.Item != nil), serialiseDatagraphItem)
 
@@ -0,0 +1,98 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account/notification/push_subscription"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/webpush"
+)
+
+func (h *Notifications) NotificationPushSubscriptionList(ctx context.Context, request openapi.NotificationPushSubscriptionListRequestObject) (openapi.NotificationPushSubscriptionListResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	subs, err := h.subscriptions.List(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var key *string
+	if h.pushSender != nil {
+		key = opt.New(h.pushSender.PublicKey()).Ptr()
+	}
+
+	return openapi.NotificationPushSubscriptionList200JSONResponse{
+		NotificationPushSubscriptionListOKJSONResponse: openapi.NotificationPushSubscriptionListOKJSONResponse{
+			VapidPublicKey: key,
+			Subscriptions:  dt.Map(subs, serialisePushSubscription),
+		},
+	}, nil
+}
+
+func (h *Notifications) NotificationPushSubscriptionCreate(ctx context.Context, request openapi.NotificationPushSubscriptionCreateRequestObject) (openapi.NotificationPushSubscriptionCreateResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if h.pushSender == nil {
+		return nil, fault.New("web push is not enabled",
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("disabled", "Push notifications are not enabled on this instance."),
+		)
+	}
+
+	_, err = webpush.ParseSubscription(request.Body.Endpoint, request.Body.Keys.P256dh, request.Body.Keys.Auth)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.WithDesc("invalid subscription", "The push subscription provided by the browser is not valid."))
+	}
+
+	sub, err := h.subscriptions.Add(ctx, accountID,
+		request.Body.Endpoint,
+		request.Body.Keys.P256dh,
+		request.Body.Keys.Auth,
+		opt.NewPtr(request.Body.ExpirationTime),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NotificationPushSubscriptionCreate200JSONResponse{
+		NotificationPushSubscriptionCreateOKJSONResponse: openapi.NotificationPushSubscriptionCreateOKJSONResponse(serialisePushSubscription(sub)),
+	}, nil
+}
+
+func (h *Notifications) NotificationPushSubscriptionDelete(ctx context.Context, request openapi.NotificationPushSubscriptionDeleteRequestObject) (openapi.NotificationPushSubscriptionDeleteResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = h.subscriptions.Delete(ctx, accountID, push_subscription.ID(openapi.ParseID(request.PushSubscriptionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NotificationPushSubscriptionDelete204Response{}, nil
+}
+
+func serialisePushSubscription(in *push_subscription.Subscription) openapi.PushSubscription {
+	return openapi.PushSubscription{
+		Id:             in.ID.String(),
+		CreatedAt:      in.CreatedAt,
+		Endpoint:       in.Endpoint,
+		ExpirationTime: in.ExpiresAt.Ptr(),
+	}
+}
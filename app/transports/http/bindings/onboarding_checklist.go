@@ -0,0 +1,81 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account/onboarding_checklist"
+	"github.com/Southclaws/storyden/app/services/account/onboarding_tracker"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type OnboardingChecklist struct {
+	tracker *onboarding_tracker.Tracker
+}
+
+func NewOnboardingChecklist(tracker *onboarding_tracker.Tracker) OnboardingChecklist {
+	return OnboardingChecklist{tracker: tracker}
+}
+
+func (h OnboardingChecklist) AccountOnboardingGet(ctx context.Context, request openapi.AccountOnboardingGetRequestObject) (openapi.AccountOnboardingGetResponseObject, error) {
+	checklist, err := h.tracker.Own(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountOnboardingGet200JSONResponse{
+		AccountOnboardingGetOKJSONResponse: openapi.AccountOnboardingGetOKJSONResponse{
+			Items: dt.Map(checklist.Items, func(i onboarding_checklist.Item) openapi.OnboardingChecklistItem {
+				return openapi.OnboardingChecklistItem{
+					Step:        openapi.OnboardingStep(i.Step.String()),
+					CompletedAt: i.CompletedAt.Ptr(),
+				}
+			}),
+			Complete: checklist.Complete(),
+		},
+	}, nil
+}
+
+func (h OnboardingChecklist) AdminOnboardingFunnel(ctx context.Context, request openapi.AdminOnboardingFunnelRequestObject) (openapi.AdminOnboardingFunnelResponseObject, error) {
+	funnel, err := h.tracker.Funnel(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminOnboardingFunnel200JSONResponse{
+		AdminOnboardingFunnelOKJSONResponse: openapi.AdminOnboardingFunnelOKJSONResponse{
+			Accounts: funnel.Accounts,
+			Steps: dt.Map(funnel.Steps, func(s onboarding_checklist.StepCount) openapi.OnboardingFunnelStep {
+				return openapi.OnboardingFunnelStep{
+					Step:      openapi.OnboardingStep(s.Step.String()),
+					Completed: s.Completed,
+				}
+			}),
+			Completed: funnel.Completed,
+		},
+	}, nil
+}
+
+func serialiseOnboardingChecklistSettings(in onboarding_checklist.Settings) openapi.OnboardingChecklistSettings {
+	steps := dt.Map(in.Steps, func(s onboarding_checklist.Step) openapi.OnboardingStep {
+		return openapi.OnboardingStep(s.String())
+	})
+
+	return openapi.OnboardingChecklistSettings{Steps: &steps}
+}
+
+func deserialiseOnboardingChecklistSettings(in openapi.OnboardingChecklistSettings) (onboarding_checklist.Settings, error) {
+	steps, err := dt.MapErr(opt.NewPtr(in.Steps).OrZero(), func(s openapi.OnboardingStep) (onboarding_checklist.Step, error) {
+		return onboarding_checklist.NewStep(string(s))
+	})
+	if err != nil {
+		return onboarding_checklist.Settings{}, err
+	}
+
+	return onboarding_checklist.Settings{Steps: lo.Uniq(steps)}, nil
+}
@@ -22,6 +22,10 @@ func (m *Mapping) GetInfo() (bool, *rbac.Permission) {
 	return false, nil // Public
 }
 
+func (m *Mapping) FeatureList() (bool, *rbac.Permission) {
+	return false, nil
+}
+
 func (m *Mapping) IconGet() (bool, *rbac.Permission) {
 	return false, nil // Public
 }
@@ -42,6 +46,10 @@ func (m *Mapping) SendBeacon() (bool, *rbac.Permission) {
 	return false, nil // Public
 }
 
+func (m *Mapping) AdminSettingsGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
+}
+
 func (m *Mapping) AdminSettingsUpdate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageSettings
 }
@@ -50,6 +58,42 @@ func (m *Mapping) AdminAccountBanCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageSuspensions
 }
 
+func (m *Mapping) AdminSearchIndexStatus() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminSearchIndexRebuild() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminStorageUsageReport() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAnalyticsGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAnalyticsTopContent() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAnalyticsCohorts() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAPIUsageReport() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminRetentionReport() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminFlaggedAssetList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
 func (m *Mapping) AdminAccessKeyList() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionAdministrator
 }
@@ -58,6 +102,206 @@ func (m *Mapping) AdminAccessKeyDelete() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionAdministrator
 }
 
+func (m *Mapping) AdminWebhookList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWebhookCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWebhookGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWebhookUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWebhookDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWebhookDeliveryList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWebhookDeliveryRedeliver() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAutomodRuleList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAutomodRuleCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAutomodRuleGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAutomodRuleUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAutomodRuleDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWordFilterList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWordFilterCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWordFilterUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminWordFilterDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminFeatureFlagList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
+}
+
+func (m *Mapping) AdminFeatureFlagCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
+}
+
+func (m *Mapping) AdminFeatureFlagUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
+}
+
+func (m *Mapping) AdminFeatureFlagDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
+}
+
+func (m *Mapping) AdminJobList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminJobStats() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminJobGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminJobDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminJobRetry() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminImportList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminImportStart() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminImportDocument() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminImportGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminImportResume() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminImportRecordList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminScheduledTaskList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminScheduledTaskUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminScheduledTaskRun() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminTenantList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminTenantCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminTenantUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminTenantDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminNetworkBanList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminNetworkBanCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminNetworkBanUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminNetworkBanDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminOnboardingFunnel() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminEventStream() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminApplicationList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSuspensions
+}
+
+func (m *Mapping) AdminApplicationApprove() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSuspensions
+}
+
+func (m *Mapping) AdminApplicationReject() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSuspensions
+}
+
+func (m *Mapping) AdminVerificationRequestList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageRoles
+}
+
+func (m *Mapping) AdminVerificationRequestApprove() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageRoles
+}
+
+func (m *Mapping) AdminVerificationRequestReject() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageRoles
+}
+
 func (m *Mapping) AdminAccountBanRemove() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageSuspensions
 }
@@ -202,6 +446,110 @@ func (m *Mapping) AccountEmailRemove() (bool, *rbac.Permission) {
 	return true, nil
 }
 
+func (m *Mapping) AccountSubscriptionsGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountWarningsGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountApplicationGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountApplicationSubmit() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountVerificationRequestGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountVerificationRequestCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountOnboardingGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountPolicyList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountPolicyAccept() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountBlockList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountAPIUsageGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountFeedTokenGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountFeedTokenRevoke() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountMatrixLinkGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountMatrixLinkCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountMatrixLinkRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountTelegramLinkGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountTelegramLinkCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountTelegramLinkRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountNotificationPreferencesGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountNotificationPreferencesUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountReadingHistoryList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountReadingHistoryClear() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountReadingHistoryGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountReadingHistoryRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountPinsUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) AccountSetAvatar() (bool, *rbac.Permission) {
 	return true, nil
 }
@@ -214,80 +562,312 @@ func (m *Mapping) AccountAddRole() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageRoles
 }
 
-func (m *Mapping) AccountRemoveRole() (bool, *rbac.Permission) {
-	return true, &rbac.PermissionManageRoles
+func (m *Mapping) AccountRemoveRole() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageRoles
+}
+
+func (m *Mapping) AccountVerificationSet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageRoles
+}
+
+func (m *Mapping) AccountVerificationRemove() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageRoles
+}
+
+func (m *Mapping) AccountRoleSetBadge() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountRoleRemoveBadge() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) InvitationList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) InvitationCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionCreateInvitation
+}
+
+func (m *Mapping) InvitationGet() (bool, *rbac.Permission) {
+	return false, nil
+}
+
+func (m *Mapping) InvitationDelete() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) InvitationMemberList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationUpdateMany() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationDigestUnsubscribe() (bool, *rbac.Permission) {
+	return false, nil
+}
+
+func (m *Mapping) NotificationPushSubscriptionList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationPushSubscriptionCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationPushSubscriptionDelete() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationLeave() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationMessageCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationMarkRead() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) SpaceList() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
+func (m *Mapping) SpaceCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionCreatePost
+}
+
+func (m *Mapping) SpaceGet() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
+func (m *Mapping) SpaceUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) SpaceDelete() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) SpaceMemberList() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
+func (m *Mapping) SpaceJoin() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) SpaceLeave() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) SpaceMemberUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) SpaceMemberRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ReportCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ReportList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ReportUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ReportQueueList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ReportQueueUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationNoteList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationNoteCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationNoteUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationNoteDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationCaseFileGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationWarningList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationWarningIssue() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationWarningRevoke() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageReports
+}
+
+func (m *Mapping) ModerationTrashList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ModerationTrashDelete() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ModerationTrashRestore() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ProfileList() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionListProfiles
+}
+
+func (m *Mapping) ProfileGet() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadProfile
+}
+
+func (m *Mapping) ProfileFollowersGet() (bool, *rbac.Permission) {
+	return false, nil
+}
+
+func (m *Mapping) ProfileFollowersAdd() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ProfileFollowersRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ProfileFollowingGet() (bool, *rbac.Permission) {
+	return false, nil
+}
+
+func (m *Mapping) ProfileBlockSet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ProfileBlockRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ProfileActivityList() (bool, *rbac.Permission) {
+	return false, nil
+}
+
+func (m *Mapping) ProfileReputationGet() (bool, *rbac.Permission) {
+	return false, nil
 }
 
-func (m *Mapping) AccountRoleSetBadge() (bool, *rbac.Permission) {
+func (m *Mapping) ProfileViewsGet() (bool, *rbac.Permission) {
 	return true, nil
 }
 
-func (m *Mapping) AccountRoleRemoveBadge() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) ProfileBadgeList() (bool, *rbac.Permission) {
+	return false, nil
 }
 
-func (m *Mapping) InvitationList() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) ProfileBadgeAward() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) InvitationCreate() (bool, *rbac.Permission) {
-	return true, &rbac.PermissionCreateInvitation
+func (m *Mapping) ProfileBadgeRevoke() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) InvitationGet() (bool, *rbac.Permission) {
+func (m *Mapping) ReputationLeaderboard() (bool, *rbac.Permission) {
 	return false, nil
 }
 
-func (m *Mapping) InvitationDelete() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) BadgeList() (bool, *rbac.Permission) {
+	return false, nil
 }
 
-func (m *Mapping) NotificationList() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) BadgeCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) NotificationUpdate() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) BadgeUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) NotificationUpdateMany() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) BadgeDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) ReportCreate() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) PolicyList() (bool, *rbac.Permission) {
+	return false, nil
 }
 
-func (m *Mapping) ReportList() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) PolicyCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) ReportUpdate() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) PolicyGet() (bool, *rbac.Permission) {
+	return false, nil
 }
 
-func (m *Mapping) ProfileList() (bool, *rbac.Permission) {
-	return false, &rbac.PermissionListProfiles
+func (m *Mapping) PolicyUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) ProfileGet() (bool, *rbac.Permission) {
-	return false, &rbac.PermissionReadProfile
+func (m *Mapping) PolicyDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) ProfileFollowersGet() (bool, *rbac.Permission) {
-	return false, nil
+func (m *Mapping) PolicyVersionList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) ProfileFollowersAdd() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) PolicyVersionCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) ProfileFollowersRemove() (bool, *rbac.Permission) {
-	return true, nil
+func (m *Mapping) PolicyVersionPublish() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
-func (m *Mapping) ProfileFollowingGet() (bool, *rbac.Permission) {
-	return false, nil
+func (m *Mapping) PolicyAcceptanceList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSettings
 }
 
 func (m *Mapping) CategoryCreate() (bool, *rbac.Permission) {
@@ -306,6 +886,22 @@ func (m *Mapping) CategoryUpdatePosition() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageCategories
 }
 
+func (m *Mapping) CategoryPermissionsGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageCategories
+}
+
+func (m *Mapping) CategoryPermissionsUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageCategories
+}
+
+func (m *Mapping) CategoryFollowersAdd() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) CategoryFollowersRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) CategoryUpdate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageCategories
 }
@@ -318,10 +914,38 @@ func (m *Mapping) TagList() (bool, *rbac.Permission) {
 	return false, nil
 }
 
+func (m *Mapping) TagSuggest() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) TagGet() (bool, *rbac.Permission) {
 	return false, nil
 }
 
+func (m *Mapping) TagUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) TagSynonymAdd() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) TagSynonymRemove() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) TagMerge() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) TagFollowersAdd() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) TagFollowersRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) ThreadCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionCreatePost
 }
@@ -342,14 +966,42 @@ func (m *Mapping) ThreadDelete() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
 
+func (m *Mapping) ThreadAnswerSet() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) ThreadAnswerRemove() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) ThreadRelated() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
+func (m *Mapping) ThreadViewsGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) ReplyCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionCreatePost
 }
 
+func (m *Mapping) FeedList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionReadPublishedThreads
+}
+
 func (m *Mapping) PostUpdate() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
 
+func (m *Mapping) PostQueueList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) PostQueueUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
 func (m *Mapping) PostDelete() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
@@ -370,10 +1022,42 @@ func (m *Mapping) AssetUpload() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionUploadAsset
 }
 
+func (m *Mapping) AssetUsageGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AssetUploadSessionCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionUploadAsset
+}
+
+func (m *Mapping) AssetUploadSessionGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionUploadAsset
+}
+
+func (m *Mapping) AssetUploadSessionAppend() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionUploadAsset
+}
+
+func (m *Mapping) AssetUploadSessionCancel() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionUploadAsset
+}
+
+func (m *Mapping) AssetUploadSessionComplete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionUploadAsset
+}
+
 func (m *Mapping) AssetGet() (bool, *rbac.Permission) {
 	return false, nil // Public
 }
 
+func (m *Mapping) AssetDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionUploadAsset
+}
+
+func (m *Mapping) AssetSignedURLCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) LikePostGet() (bool, *rbac.Permission) {
 	return false, nil
 }
@@ -390,6 +1074,18 @@ func (m *Mapping) LikeProfileGet() (bool, *rbac.Permission) {
 	return false, nil
 }
 
+func (m *Mapping) BookmarkList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BookmarkAdd() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BookmarkRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) CollectionCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionCreateCollection
 }
@@ -426,6 +1122,38 @@ func (m *Mapping) CollectionRemoveNode() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
 
+func (m *Mapping) CollectionItemUpdate() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) CollectionSectionCreate() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) CollectionSectionUpdate() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) CollectionSectionDelete() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) CollectionShareCreate() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) CollectionShareList() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) CollectionShareRevoke() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) CollectionShareOpen() (bool, *rbac.Permission) {
+	return false, nil // Public
+}
+
 func (m *Mapping) NodeCreate() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
@@ -454,6 +1182,14 @@ func (m *Mapping) NodeGenerateTags() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
 
+func (m *Mapping) NodeRelated() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedLibrary
+}
+
+func (m *Mapping) NodeViewsGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) NodeGenerateTitle() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
@@ -506,6 +1242,10 @@ func (m *Mapping) LinkList() (bool, *rbac.Permission) {
 	return false, &rbac.PermissionReadPublishedLibrary
 }
 
+func (m *Mapping) LinkBrokenList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageLibrary
+}
+
 func (m *Mapping) LinkGet() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionReadPublishedLibrary
 }
@@ -518,6 +1258,10 @@ func (m *Mapping) DatagraphAsk() (bool, *rbac.Permission) {
 	return false, nil
 }
 
+func (m *Mapping) DatagraphTrending() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
 func (m *Mapping) EventList() (bool, *rbac.Permission) {
 	return false, nil
 }
@@ -547,3 +1291,127 @@ func (m *Mapping) EventParticipantRemove() (bool, *rbac.Permission) {
 	// Requires PermissionManageEvents unless deleting self
 	return true, nil
 }
+
+func (m *Mapping) EventCalendarGet() (bool, *rbac.Permission) {
+	return false, nil // Public, or authenticated by feed token
+}
+
+func (m *Mapping) CalendarGet() (bool, *rbac.Permission) {
+	return false, nil // Public, or authenticated by feed token
+}
+
+func (m *Mapping) CalendarParticipatingGet() (bool, *rbac.Permission) {
+	return false, nil // Authenticated by feed token
+}
+
+func (m *Mapping) FeedTimelineGet() (bool, *rbac.Permission) {
+	return false, nil // Public, or authenticated by feed token
+}
+
+func (m *Mapping) FeedHomeGet() (bool, *rbac.Permission) {
+	return false, nil // Authenticated by feed token
+}
+
+func (m *Mapping) FeedCategoryGet() (bool, *rbac.Permission) {
+	return false, nil // Public, or authenticated by feed token
+}
+
+func (m *Mapping) FeedTagGet() (bool, *rbac.Permission) {
+	return false, nil // Public, or authenticated by feed token
+}
+
+func (m *Mapping) FeedProfileGet() (bool, *rbac.Permission) {
+	return false, nil // Public, or authenticated by feed token
+}
+
+func (m *Mapping) BatchPostDelete() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) BatchCollectionItemAdd() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) BatchNodeTagAdd() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) SitemapIndexGet() (bool, *rbac.Permission) {
+	return false, nil // Public
+}
+
+func (m *Mapping) SitemapGet() (bool, *rbac.Permission) {
+	return false, nil // Public
+}
+
+func (m *Mapping) StructuredDataThreadGet() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
+func (m *Mapping) StructuredDataNodeGet() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedLibrary
+}
+
+func (m *Mapping) StructuredDataProfileGet() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadProfile
+}
+
+func (m *Mapping) OpenGraphImageThreadGet() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
+func (m *Mapping) OpenGraphImageNodeGet() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedLibrary
+}
+
+func (m *Mapping) BotList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BotCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionUsePersonalAccessKeys
+}
+
+func (m *Mapping) BotGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BotUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BotDelete() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BotAccessKeyList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BotAccessKeyCreate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) BotAccessKeyDelete() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) HookSubscriptionList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) HookSubscribe() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) HookUnsubscribe() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) HookEventPoll() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) HookEventSample() (bool, *rbac.Permission) {
+	return true, nil
+}
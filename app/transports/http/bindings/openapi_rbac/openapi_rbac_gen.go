@@ -7,16 +7,77 @@ type OperationPermissions interface {
 	GetSpec() (bool, *rbac.Permission)
 	GetDocs() (bool, *rbac.Permission)
 	GetInfo() (bool, *rbac.Permission)
+	FeatureList() (bool, *rbac.Permission)
 	IconGet() (bool, *rbac.Permission)
 	IconUpload() (bool, *rbac.Permission)
 	BannerGet() (bool, *rbac.Permission)
 	BannerUpload() (bool, *rbac.Permission)
 	SendBeacon() (bool, *rbac.Permission)
+	AdminSettingsGet() (bool, *rbac.Permission)
 	AdminSettingsUpdate() (bool, *rbac.Permission)
 	AdminAccountBanCreate() (bool, *rbac.Permission)
 	AdminAccountBanRemove() (bool, *rbac.Permission)
+	AdminSearchIndexStatus() (bool, *rbac.Permission)
+	AdminSearchIndexRebuild() (bool, *rbac.Permission)
+	AdminStorageUsageReport() (bool, *rbac.Permission)
+	AdminAnalyticsGet() (bool, *rbac.Permission)
+	AdminAnalyticsTopContent() (bool, *rbac.Permission)
+	AdminAnalyticsCohorts() (bool, *rbac.Permission)
+	AdminAPIUsageReport() (bool, *rbac.Permission)
+	AdminRetentionReport() (bool, *rbac.Permission)
+	AdminFlaggedAssetList() (bool, *rbac.Permission)
 	AdminAccessKeyList() (bool, *rbac.Permission)
 	AdminAccessKeyDelete() (bool, *rbac.Permission)
+	AdminWebhookList() (bool, *rbac.Permission)
+	AdminWebhookCreate() (bool, *rbac.Permission)
+	AdminWebhookGet() (bool, *rbac.Permission)
+	AdminWebhookUpdate() (bool, *rbac.Permission)
+	AdminWebhookDelete() (bool, *rbac.Permission)
+	AdminWebhookDeliveryList() (bool, *rbac.Permission)
+	AdminWebhookDeliveryRedeliver() (bool, *rbac.Permission)
+	AdminAutomodRuleList() (bool, *rbac.Permission)
+	AdminAutomodRuleCreate() (bool, *rbac.Permission)
+	AdminAutomodRuleGet() (bool, *rbac.Permission)
+	AdminAutomodRuleUpdate() (bool, *rbac.Permission)
+	AdminAutomodRuleDelete() (bool, *rbac.Permission)
+	AdminWordFilterList() (bool, *rbac.Permission)
+	AdminWordFilterCreate() (bool, *rbac.Permission)
+	AdminWordFilterUpdate() (bool, *rbac.Permission)
+	AdminWordFilterDelete() (bool, *rbac.Permission)
+	AdminFeatureFlagList() (bool, *rbac.Permission)
+	AdminFeatureFlagCreate() (bool, *rbac.Permission)
+	AdminFeatureFlagUpdate() (bool, *rbac.Permission)
+	AdminFeatureFlagDelete() (bool, *rbac.Permission)
+	AdminJobList() (bool, *rbac.Permission)
+	AdminJobStats() (bool, *rbac.Permission)
+	AdminJobGet() (bool, *rbac.Permission)
+	AdminJobDelete() (bool, *rbac.Permission)
+	AdminJobRetry() (bool, *rbac.Permission)
+	AdminImportList() (bool, *rbac.Permission)
+	AdminImportStart() (bool, *rbac.Permission)
+	AdminImportDocument() (bool, *rbac.Permission)
+	AdminImportGet() (bool, *rbac.Permission)
+	AdminImportResume() (bool, *rbac.Permission)
+	AdminImportRecordList() (bool, *rbac.Permission)
+	AdminScheduledTaskList() (bool, *rbac.Permission)
+	AdminScheduledTaskUpdate() (bool, *rbac.Permission)
+	AdminScheduledTaskRun() (bool, *rbac.Permission)
+	AdminTenantList() (bool, *rbac.Permission)
+	AdminTenantCreate() (bool, *rbac.Permission)
+	AdminTenantUpdate() (bool, *rbac.Permission)
+	AdminTenantDelete() (bool, *rbac.Permission)
+	AdminNetworkBanList() (bool, *rbac.Permission)
+	AdminNetworkBanCreate() (bool, *rbac.Permission)
+	AdminNetworkBanUpdate() (bool, *rbac.Permission)
+	AdminNetworkBanDelete() (bool, *rbac.Permission)
+	AdminOnboardingFunnel() (bool, *rbac.Permission)
+	AdminEventStream() (bool, *rbac.Permission)
+	AdminApplicationList() (bool, *rbac.Permission)
+	AdminApplicationApprove() (bool, *rbac.Permission)
+	AdminApplicationReject() (bool, *rbac.Permission)
+	AdminVerificationRequestList() (bool, *rbac.Permission)
+	AdminVerificationRequestApprove() (bool, *rbac.Permission)
+	AdminVerificationRequestReject() (bool, *rbac.Permission)
 	RoleCreate() (bool, *rbac.Permission)
 	RoleList() (bool, *rbac.Permission)
 	RoleGet() (bool, *rbac.Permission)
@@ -52,52 +113,178 @@ type OperationPermissions interface {
 	AccountAuthMethodDelete() (bool, *rbac.Permission)
 	AccountEmailAdd() (bool, *rbac.Permission)
 	AccountEmailRemove() (bool, *rbac.Permission)
+	AccountSubscriptionsGet() (bool, *rbac.Permission)
+	AccountWarningsGet() (bool, *rbac.Permission)
+	AccountApplicationGet() (bool, *rbac.Permission)
+	AccountApplicationSubmit() (bool, *rbac.Permission)
+	AccountVerificationRequestGet() (bool, *rbac.Permission)
+	AccountVerificationRequestCreate() (bool, *rbac.Permission)
+	AccountOnboardingGet() (bool, *rbac.Permission)
+	AccountPolicyList() (bool, *rbac.Permission)
+	AccountPolicyAccept() (bool, *rbac.Permission)
+	AccountBlockList() (bool, *rbac.Permission)
+	AccountFeedTokenGet() (bool, *rbac.Permission)
+	AccountFeedTokenRevoke() (bool, *rbac.Permission)
+	AccountMatrixLinkGet() (bool, *rbac.Permission)
+	AccountMatrixLinkCreate() (bool, *rbac.Permission)
+	AccountMatrixLinkRemove() (bool, *rbac.Permission)
+	AccountTelegramLinkGet() (bool, *rbac.Permission)
+	AccountTelegramLinkCreate() (bool, *rbac.Permission)
+	AccountTelegramLinkRemove() (bool, *rbac.Permission)
+	AccountAPIUsageGet() (bool, *rbac.Permission)
+	AccountNotificationPreferencesGet() (bool, *rbac.Permission)
+	AccountNotificationPreferencesUpdate() (bool, *rbac.Permission)
+	AccountReadingHistoryList() (bool, *rbac.Permission)
+	AccountReadingHistoryClear() (bool, *rbac.Permission)
+	AccountReadingHistoryGet() (bool, *rbac.Permission)
+	AccountReadingHistoryRemove() (bool, *rbac.Permission)
+	AccountPinsUpdate() (bool, *rbac.Permission)
 	AccountSetAvatar() (bool, *rbac.Permission)
 	AccountGetAvatar() (bool, *rbac.Permission)
 	AccountAddRole() (bool, *rbac.Permission)
 	AccountRemoveRole() (bool, *rbac.Permission)
+	AccountVerificationSet() (bool, *rbac.Permission)
+	AccountVerificationRemove() (bool, *rbac.Permission)
+	BotList() (bool, *rbac.Permission)
+	BotCreate() (bool, *rbac.Permission)
+	BotGet() (bool, *rbac.Permission)
+	BotUpdate() (bool, *rbac.Permission)
+	BotDelete() (bool, *rbac.Permission)
+	BotAccessKeyList() (bool, *rbac.Permission)
+	BotAccessKeyCreate() (bool, *rbac.Permission)
+	BotAccessKeyDelete() (bool, *rbac.Permission)
+	HookSubscriptionList() (bool, *rbac.Permission)
+	HookSubscribe() (bool, *rbac.Permission)
+	HookUnsubscribe() (bool, *rbac.Permission)
+	HookEventPoll() (bool, *rbac.Permission)
+	HookEventSample() (bool, *rbac.Permission)
 	AccountRoleSetBadge() (bool, *rbac.Permission)
 	AccountRoleRemoveBadge() (bool, *rbac.Permission)
 	InvitationList() (bool, *rbac.Permission)
 	InvitationCreate() (bool, *rbac.Permission)
 	InvitationGet() (bool, *rbac.Permission)
 	InvitationDelete() (bool, *rbac.Permission)
+	InvitationMemberList() (bool, *rbac.Permission)
 	NotificationList() (bool, *rbac.Permission)
 	NotificationUpdateMany() (bool, *rbac.Permission)
 	NotificationUpdate() (bool, *rbac.Permission)
+	NotificationDigestUnsubscribe() (bool, *rbac.Permission)
+	NotificationPushSubscriptionList() (bool, *rbac.Permission)
+	NotificationPushSubscriptionCreate() (bool, *rbac.Permission)
+	NotificationPushSubscriptionDelete() (bool, *rbac.Permission)
+	ConversationList() (bool, *rbac.Permission)
+	ConversationCreate() (bool, *rbac.Permission)
+	ConversationGet() (bool, *rbac.Permission)
+	ConversationLeave() (bool, *rbac.Permission)
+	ConversationMessageCreate() (bool, *rbac.Permission)
+	ConversationMarkRead() (bool, *rbac.Permission)
+	SpaceList() (bool, *rbac.Permission)
+	SpaceCreate() (bool, *rbac.Permission)
+	SpaceGet() (bool, *rbac.Permission)
+	SpaceUpdate() (bool, *rbac.Permission)
+	SpaceDelete() (bool, *rbac.Permission)
+	SpaceMemberList() (bool, *rbac.Permission)
+	SpaceJoin() (bool, *rbac.Permission)
+	SpaceLeave() (bool, *rbac.Permission)
+	SpaceMemberUpdate() (bool, *rbac.Permission)
+	SpaceMemberRemove() (bool, *rbac.Permission)
 	ReportCreate() (bool, *rbac.Permission)
 	ReportList() (bool, *rbac.Permission)
 	ReportUpdate() (bool, *rbac.Permission)
+	ReportQueueList() (bool, *rbac.Permission)
+	ReportQueueUpdate() (bool, *rbac.Permission)
+	ModerationNoteList() (bool, *rbac.Permission)
+	ModerationNoteCreate() (bool, *rbac.Permission)
+	ModerationNoteUpdate() (bool, *rbac.Permission)
+	ModerationNoteDelete() (bool, *rbac.Permission)
+	ModerationCaseFileGet() (bool, *rbac.Permission)
+	ModerationWarningList() (bool, *rbac.Permission)
+	ModerationWarningIssue() (bool, *rbac.Permission)
+	ModerationWarningRevoke() (bool, *rbac.Permission)
+	ModerationTrashList() (bool, *rbac.Permission)
+	ModerationTrashDelete() (bool, *rbac.Permission)
+	ModerationTrashRestore() (bool, *rbac.Permission)
 	ProfileList() (bool, *rbac.Permission)
 	ProfileGet() (bool, *rbac.Permission)
 	ProfileFollowersGet() (bool, *rbac.Permission)
 	ProfileFollowersAdd() (bool, *rbac.Permission)
 	ProfileFollowersRemove() (bool, *rbac.Permission)
 	ProfileFollowingGet() (bool, *rbac.Permission)
+	ProfileBlockSet() (bool, *rbac.Permission)
+	ProfileBlockRemove() (bool, *rbac.Permission)
+	ProfileActivityList() (bool, *rbac.Permission)
+	ProfileReputationGet() (bool, *rbac.Permission)
+	ProfileViewsGet() (bool, *rbac.Permission)
+	ProfileBadgeList() (bool, *rbac.Permission)
+	ProfileBadgeAward() (bool, *rbac.Permission)
+	ProfileBadgeRevoke() (bool, *rbac.Permission)
+	ReputationLeaderboard() (bool, *rbac.Permission)
+	BadgeList() (bool, *rbac.Permission)
+	BadgeCreate() (bool, *rbac.Permission)
+	BadgeUpdate() (bool, *rbac.Permission)
+	BadgeDelete() (bool, *rbac.Permission)
+	PolicyList() (bool, *rbac.Permission)
+	PolicyCreate() (bool, *rbac.Permission)
+	PolicyGet() (bool, *rbac.Permission)
+	PolicyUpdate() (bool, *rbac.Permission)
+	PolicyDelete() (bool, *rbac.Permission)
+	PolicyVersionList() (bool, *rbac.Permission)
+	PolicyVersionCreate() (bool, *rbac.Permission)
+	PolicyVersionPublish() (bool, *rbac.Permission)
+	PolicyAcceptanceList() (bool, *rbac.Permission)
 	CategoryCreate() (bool, *rbac.Permission)
 	CategoryList() (bool, *rbac.Permission)
 	CategoryGet() (bool, *rbac.Permission)
 	CategoryUpdate() (bool, *rbac.Permission)
 	CategoryDelete() (bool, *rbac.Permission)
 	CategoryUpdatePosition() (bool, *rbac.Permission)
+	CategoryPermissionsGet() (bool, *rbac.Permission)
+	CategoryPermissionsUpdate() (bool, *rbac.Permission)
+	CategoryFollowersAdd() (bool, *rbac.Permission)
+	CategoryFollowersRemove() (bool, *rbac.Permission)
 	TagList() (bool, *rbac.Permission)
+	TagSuggest() (bool, *rbac.Permission)
 	TagGet() (bool, *rbac.Permission)
+	TagUpdate() (bool, *rbac.Permission)
+	TagSynonymAdd() (bool, *rbac.Permission)
+	TagSynonymRemove() (bool, *rbac.Permission)
+	TagMerge() (bool, *rbac.Permission)
+	TagFollowersAdd() (bool, *rbac.Permission)
+	TagFollowersRemove() (bool, *rbac.Permission)
 	ThreadCreate() (bool, *rbac.Permission)
 	ThreadList() (bool, *rbac.Permission)
 	ThreadGet() (bool, *rbac.Permission)
 	ThreadUpdate() (bool, *rbac.Permission)
 	ThreadDelete() (bool, *rbac.Permission)
+	ThreadAnswerSet() (bool, *rbac.Permission)
+	ThreadAnswerRemove() (bool, *rbac.Permission)
+	ThreadRelated() (bool, *rbac.Permission)
+	ThreadViewsGet() (bool, *rbac.Permission)
 	ReplyCreate() (bool, *rbac.Permission)
+	FeedList() (bool, *rbac.Permission)
+	PostQueueList() (bool, *rbac.Permission)
+	PostQueueUpdate() (bool, *rbac.Permission)
 	PostUpdate() (bool, *rbac.Permission)
 	PostDelete() (bool, *rbac.Permission)
 	PostReactAdd() (bool, *rbac.Permission)
 	PostReactRemove() (bool, *rbac.Permission)
 	AssetUpload() (bool, *rbac.Permission)
+	AssetUsageGet() (bool, *rbac.Permission)
+	AssetUploadSessionCreate() (bool, *rbac.Permission)
+	AssetUploadSessionGet() (bool, *rbac.Permission)
+	AssetUploadSessionAppend() (bool, *rbac.Permission)
+	AssetUploadSessionCancel() (bool, *rbac.Permission)
+	AssetUploadSessionComplete() (bool, *rbac.Permission)
 	AssetGet() (bool, *rbac.Permission)
+	AssetDelete() (bool, *rbac.Permission)
+	AssetSignedURLCreate() (bool, *rbac.Permission)
 	LikePostGet() (bool, *rbac.Permission)
 	LikePostAdd() (bool, *rbac.Permission)
 	LikePostRemove() (bool, *rbac.Permission)
 	LikeProfileGet() (bool, *rbac.Permission)
+	BookmarkList() (bool, *rbac.Permission)
+	BookmarkAdd() (bool, *rbac.Permission)
+	BookmarkRemove() (bool, *rbac.Permission)
 	CollectionCreate() (bool, *rbac.Permission)
 	CollectionList() (bool, *rbac.Permission)
 	CollectionGet() (bool, *rbac.Permission)
@@ -107,6 +294,14 @@ type OperationPermissions interface {
 	CollectionRemovePost() (bool, *rbac.Permission)
 	CollectionAddNode() (bool, *rbac.Permission)
 	CollectionRemoveNode() (bool, *rbac.Permission)
+	CollectionItemUpdate() (bool, *rbac.Permission)
+	CollectionSectionCreate() (bool, *rbac.Permission)
+	CollectionSectionUpdate() (bool, *rbac.Permission)
+	CollectionSectionDelete() (bool, *rbac.Permission)
+	CollectionShareCreate() (bool, *rbac.Permission)
+	CollectionShareList() (bool, *rbac.Permission)
+	CollectionShareRevoke() (bool, *rbac.Permission)
+	CollectionShareOpen() (bool, *rbac.Permission)
 	NodeCreate() (bool, *rbac.Permission)
 	NodeList() (bool, *rbac.Permission)
 	NodeGet() (bool, *rbac.Permission)
@@ -114,6 +309,8 @@ type OperationPermissions interface {
 	NodeDelete() (bool, *rbac.Permission)
 	NodeGenerateTitle() (bool, *rbac.Permission)
 	NodeGenerateTags() (bool, *rbac.Permission)
+	NodeRelated() (bool, *rbac.Permission)
+	NodeViewsGet() (bool, *rbac.Permission)
 	NodeGenerateContent() (bool, *rbac.Permission)
 	NodeListChildren() (bool, *rbac.Permission)
 	NodeUpdateChildrenPropertySchema() (bool, *rbac.Permission)
@@ -127,9 +324,11 @@ type OperationPermissions interface {
 	NodeUpdatePosition() (bool, *rbac.Permission)
 	LinkCreate() (bool, *rbac.Permission)
 	LinkList() (bool, *rbac.Permission)
+	LinkBrokenList() (bool, *rbac.Permission)
 	LinkGet() (bool, *rbac.Permission)
 	DatagraphSearch() (bool, *rbac.Permission)
 	DatagraphAsk() (bool, *rbac.Permission)
+	DatagraphTrending() (bool, *rbac.Permission)
 	EventList() (bool, *rbac.Permission)
 	EventCreate() (bool, *rbac.Permission)
 	EventGet() (bool, *rbac.Permission)
@@ -137,6 +336,24 @@ type OperationPermissions interface {
 	EventDelete() (bool, *rbac.Permission)
 	EventParticipantUpdate() (bool, *rbac.Permission)
 	EventParticipantRemove() (bool, *rbac.Permission)
+	EventCalendarGet() (bool, *rbac.Permission)
+	CalendarGet() (bool, *rbac.Permission)
+	CalendarParticipatingGet() (bool, *rbac.Permission)
+	FeedTimelineGet() (bool, *rbac.Permission)
+	FeedHomeGet() (bool, *rbac.Permission)
+	FeedCategoryGet() (bool, *rbac.Permission)
+	FeedTagGet() (bool, *rbac.Permission)
+	FeedProfileGet() (bool, *rbac.Permission)
+	SitemapIndexGet() (bool, *rbac.Permission)
+	SitemapGet() (bool, *rbac.Permission)
+	StructuredDataThreadGet() (bool, *rbac.Permission)
+	StructuredDataNodeGet() (bool, *rbac.Permission)
+	StructuredDataProfileGet() (bool, *rbac.Permission)
+	OpenGraphImageThreadGet() (bool, *rbac.Permission)
+	OpenGraphImageNodeGet() (bool, *rbac.Permission)
+	BatchPostDelete() (bool, *rbac.Permission)
+	BatchCollectionItemAdd() (bool, *rbac.Permission)
+	BatchNodeTagAdd() (bool, *rbac.Permission)
 }
 
 func GetOperationPermission(optable OperationPermissions, op string) (bool, *rbac.Permission) {
@@ -149,6 +366,8 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.GetDocs()
 	case "GetInfo":
 		return optable.GetInfo()
+	case "FeatureList":
+		return optable.FeatureList()
 	case "IconGet":
 		return optable.IconGet()
 	case "IconUpload":
@@ -159,16 +378,136 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.BannerUpload()
 	case "SendBeacon":
 		return optable.SendBeacon()
+	case "AdminSettingsGet":
+		return optable.AdminSettingsGet()
 	case "AdminSettingsUpdate":
 		return optable.AdminSettingsUpdate()
 	case "AdminAccountBanCreate":
 		return optable.AdminAccountBanCreate()
 	case "AdminAccountBanRemove":
 		return optable.AdminAccountBanRemove()
+	case "AdminSearchIndexStatus":
+		return optable.AdminSearchIndexStatus()
+	case "AdminSearchIndexRebuild":
+		return optable.AdminSearchIndexRebuild()
+	case "AdminStorageUsageReport":
+		return optable.AdminStorageUsageReport()
+	case "AdminAnalyticsGet":
+		return optable.AdminAnalyticsGet()
+	case "AdminAnalyticsTopContent":
+		return optable.AdminAnalyticsTopContent()
+	case "AdminAnalyticsCohorts":
+		return optable.AdminAnalyticsCohorts()
+	case "AdminAPIUsageReport":
+		return optable.AdminAPIUsageReport()
+	case "AdminRetentionReport":
+		return optable.AdminRetentionReport()
+	case "AdminFlaggedAssetList":
+		return optable.AdminFlaggedAssetList()
 	case "AdminAccessKeyList":
 		return optable.AdminAccessKeyList()
 	case "AdminAccessKeyDelete":
 		return optable.AdminAccessKeyDelete()
+	case "AdminWebhookList":
+		return optable.AdminWebhookList()
+	case "AdminWebhookCreate":
+		return optable.AdminWebhookCreate()
+	case "AdminWebhookGet":
+		return optable.AdminWebhookGet()
+	case "AdminWebhookUpdate":
+		return optable.AdminWebhookUpdate()
+	case "AdminWebhookDelete":
+		return optable.AdminWebhookDelete()
+	case "AdminWebhookDeliveryList":
+		return optable.AdminWebhookDeliveryList()
+	case "AdminWebhookDeliveryRedeliver":
+		return optable.AdminWebhookDeliveryRedeliver()
+	case "AdminAutomodRuleList":
+		return optable.AdminAutomodRuleList()
+	case "AdminAutomodRuleCreate":
+		return optable.AdminAutomodRuleCreate()
+	case "AdminAutomodRuleGet":
+		return optable.AdminAutomodRuleGet()
+	case "AdminAutomodRuleUpdate":
+		return optable.AdminAutomodRuleUpdate()
+	case "AdminAutomodRuleDelete":
+		return optable.AdminAutomodRuleDelete()
+	case "AdminWordFilterList":
+		return optable.AdminWordFilterList()
+	case "AdminWordFilterCreate":
+		return optable.AdminWordFilterCreate()
+	case "AdminWordFilterUpdate":
+		return optable.AdminWordFilterUpdate()
+	case "AdminWordFilterDelete":
+		return optable.AdminWordFilterDelete()
+	case "AdminFeatureFlagList":
+		return optable.AdminFeatureFlagList()
+	case "AdminFeatureFlagCreate":
+		return optable.AdminFeatureFlagCreate()
+	case "AdminFeatureFlagUpdate":
+		return optable.AdminFeatureFlagUpdate()
+	case "AdminFeatureFlagDelete":
+		return optable.AdminFeatureFlagDelete()
+	case "AdminJobList":
+		return optable.AdminJobList()
+	case "AdminJobStats":
+		return optable.AdminJobStats()
+	case "AdminJobGet":
+		return optable.AdminJobGet()
+	case "AdminJobDelete":
+		return optable.AdminJobDelete()
+	case "AdminJobRetry":
+		return optable.AdminJobRetry()
+	case "AdminImportList":
+		return optable.AdminImportList()
+	case "AdminImportStart":
+		return optable.AdminImportStart()
+	case "AdminImportDocument":
+		return optable.AdminImportDocument()
+	case "AdminImportGet":
+		return optable.AdminImportGet()
+	case "AdminImportResume":
+		return optable.AdminImportResume()
+	case "AdminImportRecordList":
+		return optable.AdminImportRecordList()
+	case "AdminScheduledTaskList":
+		return optable.AdminScheduledTaskList()
+	case "AdminScheduledTaskUpdate":
+		return optable.AdminScheduledTaskUpdate()
+	case "AdminScheduledTaskRun":
+		return optable.AdminScheduledTaskRun()
+	case "AdminTenantList":
+		return optable.AdminTenantList()
+	case "AdminTenantCreate":
+		return optable.AdminTenantCreate()
+	case "AdminTenantUpdate":
+		return optable.AdminTenantUpdate()
+	case "AdminTenantDelete":
+		return optable.AdminTenantDelete()
+	case "AdminNetworkBanList":
+		return optable.AdminNetworkBanList()
+	case "AdminNetworkBanCreate":
+		return optable.AdminNetworkBanCreate()
+	case "AdminNetworkBanUpdate":
+		return optable.AdminNetworkBanUpdate()
+	case "AdminNetworkBanDelete":
+		return optable.AdminNetworkBanDelete()
+	case "AdminOnboardingFunnel":
+		return optable.AdminOnboardingFunnel()
+	case "AdminEventStream":
+		return optable.AdminEventStream()
+	case "AdminApplicationList":
+		return optable.AdminApplicationList()
+	case "AdminApplicationApprove":
+		return optable.AdminApplicationApprove()
+	case "AdminApplicationReject":
+		return optable.AdminApplicationReject()
+	case "AdminVerificationRequestList":
+		return optable.AdminVerificationRequestList()
+	case "AdminVerificationRequestApprove":
+		return optable.AdminVerificationRequestApprove()
+	case "AdminVerificationRequestReject":
+		return optable.AdminVerificationRequestReject()
 	case "RoleCreate":
 		return optable.RoleCreate()
 	case "RoleList":
@@ -239,6 +578,58 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.AccountEmailAdd()
 	case "AccountEmailRemove":
 		return optable.AccountEmailRemove()
+	case "AccountSubscriptionsGet":
+		return optable.AccountSubscriptionsGet()
+	case "AccountWarningsGet":
+		return optable.AccountWarningsGet()
+	case "AccountApplicationGet":
+		return optable.AccountApplicationGet()
+	case "AccountApplicationSubmit":
+		return optable.AccountApplicationSubmit()
+	case "AccountVerificationRequestGet":
+		return optable.AccountVerificationRequestGet()
+	case "AccountVerificationRequestCreate":
+		return optable.AccountVerificationRequestCreate()
+	case "AccountOnboardingGet":
+		return optable.AccountOnboardingGet()
+	case "AccountPolicyList":
+		return optable.AccountPolicyList()
+	case "AccountPolicyAccept":
+		return optable.AccountPolicyAccept()
+	case "AccountBlockList":
+		return optable.AccountBlockList()
+	case "AccountFeedTokenGet":
+		return optable.AccountFeedTokenGet()
+	case "AccountFeedTokenRevoke":
+		return optable.AccountFeedTokenRevoke()
+	case "AccountMatrixLinkGet":
+		return optable.AccountMatrixLinkGet()
+	case "AccountMatrixLinkCreate":
+		return optable.AccountMatrixLinkCreate()
+	case "AccountMatrixLinkRemove":
+		return optable.AccountMatrixLinkRemove()
+	case "AccountTelegramLinkGet":
+		return optable.AccountTelegramLinkGet()
+	case "AccountTelegramLinkCreate":
+		return optable.AccountTelegramLinkCreate()
+	case "AccountTelegramLinkRemove":
+		return optable.AccountTelegramLinkRemove()
+	case "AccountAPIUsageGet":
+		return optable.AccountAPIUsageGet()
+	case "AccountNotificationPreferencesGet":
+		return optable.AccountNotificationPreferencesGet()
+	case "AccountNotificationPreferencesUpdate":
+		return optable.AccountNotificationPreferencesUpdate()
+	case "AccountReadingHistoryList":
+		return optable.AccountReadingHistoryList()
+	case "AccountReadingHistoryClear":
+		return optable.AccountReadingHistoryClear()
+	case "AccountReadingHistoryGet":
+		return optable.AccountReadingHistoryGet()
+	case "AccountReadingHistoryRemove":
+		return optable.AccountReadingHistoryRemove()
+	case "AccountPinsUpdate":
+		return optable.AccountPinsUpdate()
 	case "AccountSetAvatar":
 		return optable.AccountSetAvatar()
 	case "AccountGetAvatar":
@@ -247,6 +638,36 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.AccountAddRole()
 	case "AccountRemoveRole":
 		return optable.AccountRemoveRole()
+	case "AccountVerificationSet":
+		return optable.AccountVerificationSet()
+	case "AccountVerificationRemove":
+		return optable.AccountVerificationRemove()
+	case "BotList":
+		return optable.BotList()
+	case "BotCreate":
+		return optable.BotCreate()
+	case "BotGet":
+		return optable.BotGet()
+	case "BotUpdate":
+		return optable.BotUpdate()
+	case "BotDelete":
+		return optable.BotDelete()
+	case "BotAccessKeyList":
+		return optable.BotAccessKeyList()
+	case "BotAccessKeyCreate":
+		return optable.BotAccessKeyCreate()
+	case "BotAccessKeyDelete":
+		return optable.BotAccessKeyDelete()
+	case "HookSubscriptionList":
+		return optable.HookSubscriptionList()
+	case "HookSubscribe":
+		return optable.HookSubscribe()
+	case "HookUnsubscribe":
+		return optable.HookUnsubscribe()
+	case "HookEventPoll":
+		return optable.HookEventPoll()
+	case "HookEventSample":
+		return optable.HookEventSample()
 	case "AccountRoleSetBadge":
 		return optable.AccountRoleSetBadge()
 	case "AccountRoleRemoveBadge":
@@ -259,18 +680,86 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.InvitationGet()
 	case "InvitationDelete":
 		return optable.InvitationDelete()
+	case "InvitationMemberList":
+		return optable.InvitationMemberList()
 	case "NotificationList":
 		return optable.NotificationList()
 	case "NotificationUpdateMany":
 		return optable.NotificationUpdateMany()
 	case "NotificationUpdate":
 		return optable.NotificationUpdate()
+	case "NotificationDigestUnsubscribe":
+		return optable.NotificationDigestUnsubscribe()
+	case "NotificationPushSubscriptionList":
+		return optable.NotificationPushSubscriptionList()
+	case "NotificationPushSubscriptionCreate":
+		return optable.NotificationPushSubscriptionCreate()
+	case "NotificationPushSubscriptionDelete":
+		return optable.NotificationPushSubscriptionDelete()
+	case "ConversationList":
+		return optable.ConversationList()
+	case "ConversationCreate":
+		return optable.ConversationCreate()
+	case "ConversationGet":
+		return optable.ConversationGet()
+	case "ConversationLeave":
+		return optable.ConversationLeave()
+	case "ConversationMessageCreate":
+		return optable.ConversationMessageCreate()
+	case "ConversationMarkRead":
+		return optable.ConversationMarkRead()
+	case "SpaceList":
+		return optable.SpaceList()
+	case "SpaceCreate":
+		return optable.SpaceCreate()
+	case "SpaceGet":
+		return optable.SpaceGet()
+	case "SpaceUpdate":
+		return optable.SpaceUpdate()
+	case "SpaceDelete":
+		return optable.SpaceDelete()
+	case "SpaceMemberList":
+		return optable.SpaceMemberList()
+	case "SpaceJoin":
+		return optable.SpaceJoin()
+	case "SpaceLeave":
+		return optable.SpaceLeave()
+	case "SpaceMemberUpdate":
+		return optable.SpaceMemberUpdate()
+	case "SpaceMemberRemove":
+		return optable.SpaceMemberRemove()
 	case "ReportCreate":
 		return optable.ReportCreate()
 	case "ReportList":
 		return optable.ReportList()
 	case "ReportUpdate":
 		return optable.ReportUpdate()
+	case "ReportQueueList":
+		return optable.ReportQueueList()
+	case "ReportQueueUpdate":
+		return optable.ReportQueueUpdate()
+	case "ModerationNoteList":
+		return optable.ModerationNoteList()
+	case "ModerationNoteCreate":
+		return optable.ModerationNoteCreate()
+	case "ModerationNoteUpdate":
+		return optable.ModerationNoteUpdate()
+	case "ModerationNoteDelete":
+		return optable.ModerationNoteDelete()
+	case "ModerationCaseFileGet":
+		return optable.ModerationCaseFileGet()
+	case "ModerationWarningList":
+		return optable.ModerationWarningList()
+	case "ModerationWarningIssue":
+		return optable.ModerationWarningIssue()
+	case "ModerationWarningRevoke":
+		return optable.ModerationWarningRevoke()
+	case "ModerationTrashList":
+		return optable.ModerationTrashList()
+	case "ModerationTrashDelete":
+		return optable.ModerationTrashDelete()
+	case "ModerationTrashRestore":
+		return optable.ModerationTrashRestore()
 	case "ProfileList":
 		return optable.ProfileList()
 	case "ProfileGet":
@@ -283,6 +772,50 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.ProfileFollowersRemove()
 	case "ProfileFollowingGet":
 		return optable.ProfileFollowingGet()
+	case "ProfileBlockSet":
+		return optable.ProfileBlockSet()
+	case "ProfileBlockRemove":
+		return optable.ProfileBlockRemove()
+	case "ProfileActivityList":
+		return optable.ProfileActivityList()
+	case "ProfileReputationGet":
+		return optable.ProfileReputationGet()
+	case "ProfileViewsGet":
+		return optable.ProfileViewsGet()
+	case "ProfileBadgeList":
+		return optable.ProfileBadgeList()
+	case "ProfileBadgeAward":
+		return optable.ProfileBadgeAward()
+	case "ProfileBadgeRevoke":
+		return optable.ProfileBadgeRevoke()
+	case "ReputationLeaderboard":
+		return optable.ReputationLeaderboard()
+	case "BadgeList":
+		return optable.BadgeList()
+	case "BadgeCreate":
+		return optable.BadgeCreate()
+	case "BadgeUpdate":
+		return optable.BadgeUpdate()
+	case "BadgeDelete":
+		return optable.BadgeDelete()
+	case "PolicyList":
+		return optable.PolicyList()
+	case "PolicyCreate":
+		return optable.PolicyCreate()
+	case "PolicyGet":
+		return optable.PolicyGet()
+	case "PolicyUpdate":
+		return optable.PolicyUpdate()
+	case "PolicyDelete":
+		return optable.PolicyDelete()
+	case "PolicyVersionList":
+		return optable.PolicyVersionList()
+	case "PolicyVersionCreate":
+		return optable.PolicyVersionCreate()
+	case "PolicyVersionPublish":
+		return optable.PolicyVersionPublish()
+	case "PolicyAcceptanceList":
+		return optable.PolicyAcceptanceList()
 	case "CategoryCreate":
 		return optable.CategoryCreate()
 	case "CategoryList":
@@ -295,10 +828,32 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.CategoryDelete()
 	case "CategoryUpdatePosition":
 		return optable.CategoryUpdatePosition()
+	case "CategoryPermissionsGet":
+		return optable.CategoryPermissionsGet()
+	case "CategoryPermissionsUpdate":
+		return optable.CategoryPermissionsUpdate()
+	case "CategoryFollowersAdd":
+		return optable.CategoryFollowersAdd()
+	case "CategoryFollowersRemove":
+		return optable.CategoryFollowersRemove()
 	case "TagList":
 		return optable.TagList()
+	case "TagSuggest":
+		return optable.TagSuggest()
 	case "TagGet":
 		return optable.TagGet()
+	case "TagUpdate":
+		return optable.TagUpdate()
+	case "TagSynonymAdd":
+		return optable.TagSynonymAdd()
+	case "TagSynonymRemove":
+		return optable.TagSynonymRemove()
+	case "TagMerge":
+		return optable.TagMerge()
+	case "TagFollowersAdd":
+		return optable.TagFollowersAdd()
+	case "TagFollowersRemove":
+		return optable.TagFollowersRemove()
 	case "ThreadCreate":
 		return optable.ThreadCreate()
 	case "ThreadList":
@@ -309,8 +864,22 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.ThreadUpdate()
 	case "ThreadDelete":
 		return optable.ThreadDelete()
+	case "ThreadAnswerSet":
+		return optable.ThreadAnswerSet()
+	case "ThreadAnswerRemove":
+		return optable.ThreadAnswerRemove()
+	case "ThreadRelated":
+		return optable.ThreadRelated()
+	case "ThreadViewsGet":
+		return optable.ThreadViewsGet()
 	case "ReplyCreate":
 		return optable.ReplyCreate()
+	case "FeedList":
+		return optable.FeedList()
+	case "PostQueueList":
+		return optable.PostQueueList()
+	case "PostQueueUpdate":
+		return optable.PostQueueUpdate()
 	case "PostUpdate":
 		return optable.PostUpdate()
 	case "PostDelete":
@@ -321,8 +890,24 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.PostReactRemove()
 	case "AssetUpload":
 		return optable.AssetUpload()
+	case "AssetUsageGet":
+		return optable.AssetUsageGet()
+	case "AssetUploadSessionCreate":
+		return optable.AssetUploadSessionCreate()
+	case "AssetUploadSessionGet":
+		return optable.AssetUploadSessionGet()
+	case "AssetUploadSessionAppend":
+		return optable.AssetUploadSessionAppend()
+	case "AssetUploadSessionCancel":
+		return optable.AssetUploadSessionCancel()
+	case "AssetUploadSessionComplete":
+		return optable.AssetUploadSessionComplete()
 	case "AssetGet":
 		return optable.AssetGet()
+	case "AssetDelete":
+		return optable.AssetDelete()
+	case "AssetSignedURLCreate":
+		return optable.AssetSignedURLCreate()
 	case "LikePostGet":
 		return optable.LikePostGet()
 	case "LikePostAdd":
@@ -331,6 +916,12 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.LikePostRemove()
 	case "LikeProfileGet":
 		return optable.LikeProfileGet()
+	case "BookmarkList":
+		return optable.BookmarkList()
+	case "BookmarkAdd":
+		return optable.BookmarkAdd()
+	case "BookmarkRemove":
+		return optable.BookmarkRemove()
 	case "CollectionCreate":
 		return optable.CollectionCreate()
 	case "CollectionList":
@@ -349,6 +940,22 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.CollectionAddNode()
 	case "CollectionRemoveNode":
 		return optable.CollectionRemoveNode()
+	case "CollectionItemUpdate":
+		return optable.CollectionItemUpdate()
+	case "CollectionSectionCreate":
+		return optable.CollectionSectionCreate()
+	case "CollectionSectionUpdate":
+		return optable.CollectionSectionUpdate()
+	case "CollectionSectionDelete":
+		return optable.CollectionSectionDelete()
+	case "CollectionShareCreate":
+		return optable.CollectionShareCreate()
+	case "CollectionShareList":
+		return optable.CollectionShareList()
+	case "CollectionShareRevoke":
+		return optable.CollectionShareRevoke()
+	case "CollectionShareOpen":
+		return optable.CollectionShareOpen()
 	case "NodeCreate":
 		return optable.NodeCreate()
 	case "NodeList":
@@ -363,6 +970,10 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.NodeGenerateTitle()
 	case "NodeGenerateTags":
 		return optable.NodeGenerateTags()
+	case "NodeRelated":
+		return optable.NodeRelated()
+	case "NodeViewsGet":
+		return optable.NodeViewsGet()
 	case "NodeGenerateContent":
 		return optable.NodeGenerateContent()
 	case "NodeListChildren":
@@ -389,12 +1000,16 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.LinkCreate()
 	case "LinkList":
 		return optable.LinkList()
+	case "LinkBrokenList":
+		return optable.LinkBrokenList()
 	case "LinkGet":
 		return optable.LinkGet()
 	case "DatagraphSearch":
 		return optable.DatagraphSearch()
 	case "DatagraphAsk":
 		return optable.DatagraphAsk()
+	case "DatagraphTrending":
+		return optable.DatagraphTrending()
 	case "EventList":
 		return optable.EventList()
 	case "EventCreate":
@@ -409,6 +1024,42 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.EventParticipantUpdate()
 	case "EventParticipantRemove":
 		return optable.EventParticipantRemove()
+	case "EventCalendarGet":
+		return optable.EventCalendarGet()
+	case "CalendarGet":
+		return optable.CalendarGet()
+	case "CalendarParticipatingGet":
+		return optable.CalendarParticipatingGet()
+	case "FeedTimelineGet":
+		return optable.FeedTimelineGet()
+	case "FeedHomeGet":
+		return optable.FeedHomeGet()
+	case "FeedCategoryGet":
+		return optable.FeedCategoryGet()
+	case "FeedTagGet":
+		return optable.FeedTagGet()
+	case "FeedProfileGet":
+		return optable.FeedProfileGet()
+	case "SitemapIndexGet":
+		return optable.SitemapIndexGet()
+	case "SitemapGet":
+		return optable.SitemapGet()
+	case "StructuredDataThreadGet":
+		return optable.StructuredDataThreadGet()
+	case "StructuredDataNodeGet":
+		return optable.StructuredDataNodeGet()
+	case "StructuredDataProfileGet":
+		return optable.StructuredDataProfileGet()
+	case "OpenGraphImageThreadGet":
+		return optable.OpenGraphImageThreadGet()
+	case "OpenGraphImageNodeGet":
+		return optable.OpenGraphImageNodeGet()
+	case "BatchPostDelete":
+		return optable.BatchPostDelete()
+	case "BatchCollectionItemAdd":
+		return optable.BatchCollectionItemAdd()
+	case "BatchNodeTagAdd":
+		return optable.BatchNodeTagAdd()
 	default:
 		panic("unknown operation, must re-run rbacgen")
 	}
@@ -0,0 +1,202 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/policy"
+	"github.com/Southclaws/storyden/app/services/policy/policy_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Policies struct {
+	policyManager *policy_manager.Manager
+}
+
+func NewPolicies(policyManager *policy_manager.Manager) Policies {
+	return Policies{policyManager: policyManager}
+}
+
+func (h Policies) PolicyList(ctx context.Context, request openapi.PolicyListRequestObject) (openapi.PolicyListResponseObject, error) {
+	docs, err := h.policyManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyList200JSONResponse{
+		PolicyListOKJSONResponse: openapi.PolicyListOKJSONResponse{
+			Policies: dt.Map(docs, serialisePolicy),
+		},
+	}, nil
+}
+
+func (h Policies) PolicyCreate(ctx context.Context, request openapi.PolicyCreateRequestObject) (openapi.PolicyCreateResponseObject, error) {
+	doc, err := h.policyManager.Create(ctx, request.Body.Slug, request.Body.Title, policy_manager.Partial{
+		Required: opt.NewPtr(request.Body.Required),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyCreate200JSONResponse{
+		PolicyGetOKJSONResponse: openapi.PolicyGetOKJSONResponse(serialisePolicy(doc)),
+	}, nil
+}
+
+func (h Policies) PolicyGet(ctx context.Context, request openapi.PolicyGetRequestObject) (openapi.PolicyGetResponseObject, error) {
+	doc, err := h.policyManager.Get(ctx, request.PolicySlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyGet200JSONResponse{
+		PolicyGetOKJSONResponse: openapi.PolicyGetOKJSONResponse(serialisePolicy(doc)),
+	}, nil
+}
+
+func (h Policies) PolicyUpdate(ctx context.Context, request openapi.PolicyUpdateRequestObject) (openapi.PolicyUpdateResponseObject, error) {
+	doc, err := h.policyManager.Update(ctx, request.PolicySlug, policy_manager.Partial{
+		Title:    opt.NewPtr(request.Body.Title),
+		Required: opt.NewPtr(request.Body.Required),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyUpdate200JSONResponse{
+		PolicyGetOKJSONResponse: openapi.PolicyGetOKJSONResponse(serialisePolicy(doc)),
+	}, nil
+}
+
+func (h Policies) PolicyDelete(ctx context.Context, request openapi.PolicyDeleteRequestObject) (openapi.PolicyDeleteResponseObject, error) {
+	if err := h.policyManager.Delete(ctx, request.PolicySlug); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyDelete200Response{}, nil
+}
+
+func (h Policies) PolicyVersionList(ctx context.Context, request openapi.PolicyVersionListRequestObject) (openapi.PolicyVersionListResponseObject, error) {
+	versions, err := h.policyManager.Versions(ctx, request.PolicySlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyVersionList200JSONResponse{
+		PolicyVersionListOKJSONResponse: openapi.PolicyVersionListOKJSONResponse{
+			Versions: dt.Map(versions, func(v *policy.Version) openapi.PolicyVersion {
+				return serialisePolicyVersion(*v)
+			}),
+		},
+	}, nil
+}
+
+func (h Policies) PolicyVersionCreate(ctx context.Context, request openapi.PolicyVersionCreateRequestObject) (openapi.PolicyVersionCreateResponseObject, error) {
+	content, err := datagraph.NewRichText(request.Body.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	v, err := h.policyManager.Draft(ctx, request.PolicySlug, content, opt.NewPtr(request.Body.Summary))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyVersionCreate200JSONResponse{
+		PolicyVersionCreateOKJSONResponse: openapi.PolicyVersionCreateOKJSONResponse(serialisePolicyVersion(*v)),
+	}, nil
+}
+
+func (h Policies) PolicyVersionPublish(ctx context.Context, request openapi.PolicyVersionPublishRequestObject) (openapi.PolicyVersionPublishResponseObject, error) {
+	doc, err := h.policyManager.Publish(ctx, request.PolicySlug, policy.VersionID(deserialiseID(request.PolicyVersionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyVersionPublish200JSONResponse{
+		PolicyGetOKJSONResponse: openapi.PolicyGetOKJSONResponse(serialisePolicy(doc)),
+	}, nil
+}
+
+func (h Policies) PolicyAcceptanceList(ctx context.Context, request openapi.PolicyAcceptanceListRequestObject) (openapi.PolicyAcceptanceListResponseObject, error) {
+	acceptances, err := h.policyManager.Acceptances(ctx, request.PolicySlug, policy.VersionID(deserialiseID(request.PolicyVersionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PolicyAcceptanceList200JSONResponse{
+		PolicyAcceptanceListOKJSONResponse: openapi.PolicyAcceptanceListOKJSONResponse{
+			Acceptances: dt.Map(acceptances, func(a *policy.Acceptance) openapi.PolicyAcceptance {
+				return openapi.PolicyAcceptance{
+					Profile:    serialiseProfileReferenceFromAccount(a.Account),
+					AcceptedAt: a.AcceptedAt,
+				}
+			}),
+		},
+	}, nil
+}
+
+func (h Policies) AccountPolicyList(ctx context.Context, request openapi.AccountPolicyListRequestObject) (openapi.AccountPolicyListResponseObject, error) {
+	statuses, err := h.policyManager.Own(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountPolicyList200JSONResponse{
+		AccountPolicyListOKJSONResponse: serialisePolicyStatuses(statuses),
+	}, nil
+}
+
+func (h Policies) AccountPolicyAccept(ctx context.Context, request openapi.AccountPolicyAcceptRequestObject) (openapi.AccountPolicyAcceptResponseObject, error) {
+	statuses, err := h.policyManager.Accept(ctx, request.PolicySlug, policy.VersionID(deserialiseID(request.Body.VersionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountPolicyAccept200JSONResponse{
+		AccountPolicyListOKJSONResponse: serialisePolicyStatuses(statuses),
+	}, nil
+}
+
+func serialisePolicy(in *policy.Document) openapi.Policy {
+	return openapi.Policy{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Slug:      in.Slug,
+		Title:     in.Title,
+		Required:  in.Required,
+		Current:   opt.Map(in.Current, serialisePolicyVersion).Ptr(),
+	}
+}
+
+func serialisePolicyVersion(in policy.Version) openapi.PolicyVersion {
+	return openapi.PolicyVersion{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		Version:     in.Version,
+		Content:     serialiseContentHTML(in.Content),
+		Summary:     in.Summary.Ptr(),
+		PublishedAt: in.PublishedAt.Ptr(),
+		Acceptances: in.Acceptances,
+	}
+}
+
+func serialisePolicyStatuses(in []*policy.Status) openapi.AccountPolicyListOKJSONResponse {
+	return openapi.AccountPolicyListOKJSONResponse{
+		Policies: dt.Map(in, func(s *policy.Status) openapi.PolicyStatus {
+			return openapi.PolicyStatus{
+				Policy:          serialisePolicy(&s.Document),
+				AcceptedAt:      s.AcceptedAt.Ptr(),
+				PreviousVersion: s.Previously.Ptr(),
+				Pending:         s.Pending(),
+			}
+		}),
+	}
+}
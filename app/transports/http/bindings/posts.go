@@ -3,12 +3,15 @@ package bindings
 import (
 	"context"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/services/moderation/post_queue"
 	reply_service "github.com/Southclaws/storyden/app/services/reply"
 	"github.com/Southclaws/storyden/app/services/thread_mark"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
@@ -17,15 +20,18 @@ import (
 type Posts struct {
 	reply_svc       reply_service.Service
 	thread_mark_svc thread_mark.Service
+	postQueue       *post_queue.Queue
 }
 
 func NewPosts(
 	reply_svc reply_service.Service,
 	thread_mark_svc thread_mark.Service,
+	postQueue *post_queue.Queue,
 ) Posts {
 	return Posts{
 		reply_svc:       reply_svc,
 		thread_mark_svc: thread_mark_svc,
+		postQueue:       postQueue,
 	}
 }
 
@@ -68,3 +74,63 @@ func (p *Posts) PostDelete(ctx context.Context, request openapi.PostDeleteReques
 
 	return openapi.PostDelete200Response{}, nil
 }
+
+func (p *Posts) PostQueueList(ctx context.Context, request openapi.PostQueueListRequestObject) (openapi.PostQueueListResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	result, err := p.postQueue.List(ctx, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PostQueueList200JSONResponse{
+		PostQueueListOKJSONResponse: openapi.PostQueueListOKJSONResponse{
+			Posts:       dt.Map(result.Items, serialiseQueuedPost),
+			CurrentPage: result.CurrentPage,
+			NextPage:    result.NextPage.Ptr(),
+			PageSize:    result.Size,
+			Results:     result.Results,
+			TotalPages:  result.TotalPages,
+		},
+	}, nil
+}
+
+func (p *Posts) PostQueueUpdate(ctx context.Context, request openapi.PostQueueUpdateRequestObject) (openapi.PostQueueUpdateResponseObject, error) {
+	action, err := deserialisePostQueueAction(request.Body.Action)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	queued, err := p.postQueue.Handle(ctx, deserialisePostID(request.PostId), action)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PostQueueUpdate200JSONResponse{
+		PostQueueUpdateOKJSONResponse: openapi.PostQueueUpdateOKJSONResponse(serialiseQueuedPost(queued)),
+	}, nil
+}
+
+func serialiseQueuedPost(in *post.Post) openapi.QueuedPost {
+	kind := openapi.DatagraphItemKindReply
+	if in.Root == in.ID {
+		kind = openapi.DatagraphItemKindThread
+	}
+
+	return openapi.QueuedPost{
+		Kind:      kind,
+		Post:      serialisePost(in),
+		SpamScore: opt.PtrMap(in.SpamScore, func(s float64) float32 { return float32(s) }),
+	}
+}
+
+func deserialisePostQueueAction(in openapi.PostQueueAction) (post_queue.Action, error) {
+	switch in {
+	case openapi.Approve:
+		return post_queue.ActionApprove, nil
+	case openapi.Remove:
+		return post_queue.ActionRemove, nil
+	default:
+		return 0, fault.Newf("invalid post queue action: %s", in)
+	}
+}
@@ -18,11 +18,15 @@ import (
 	"github.com/Southclaws/storyden/app/resources/profile/profile_cache"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_search"
+	"github.com/Southclaws/storyden/app/resources/reputation/reputation_querier"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/profile/following"
+	"github.com/Southclaws/storyden/app/services/profile/pinning"
+	"github.com/Southclaws/storyden/app/services/profile/timeline"
 	"github.com/Southclaws/storyden/app/services/reqinfo"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
 )
 
 type Profiles struct {
@@ -32,6 +36,10 @@ type Profiles struct {
 	ps            profile_search.Repository
 	followQuerier *follow_querier.Querier
 	followManager *following.FollowManager
+	pinner        *pinning.Pinner
+	timeline      *timeline.Timeline
+
+	reputationQuerier *reputation_querier.Querier
 }
 
 func NewProfiles(
@@ -41,6 +49,9 @@ func NewProfiles(
 	ps profile_search.Repository,
 	followQuerier *follow_querier.Querier,
 	followManager *following.FollowManager,
+	pinner *pinning.Pinner,
+	timeline *timeline.Timeline,
+	reputationQuerier *reputation_querier.Querier,
 ) Profiles {
 	return Profiles{
 		apiAddress:    cfg.PublicWebAddress,
@@ -49,6 +60,10 @@ func NewProfiles(
 		ps:            ps,
 		followQuerier: followQuerier,
 		followManager: followManager,
+		pinner:        pinner,
+		timeline:      timeline,
+
+		reputationQuerier: reputationQuerier,
 	}
 }
 
@@ -72,6 +87,19 @@ func (p *Profiles) ProfileList(ctx context.Context, request openapi.ProfileListR
 		)
 	}
 
+	cursor, err := deserialiseCursor(request.Params.Cursor)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if c, ok := cursor.Get(); ok {
+		f, err := profile_search.WithCursor(c)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		opts = append(opts, f)
+		page = 1
+	}
+
 	// API is 1-indexed, internally it's 0-indexed.
 	page = max(0, page-1)
 
@@ -90,6 +118,7 @@ func (p *Profiles) ProfileList(ctx context.Context, request openapi.ProfileListR
 			TotalPages:  result.TotalPages,
 			CurrentPage: page,
 			NextPage:    result.NextPage.Ptr(),
+			NextCursor:  serialiseCursor(result.NextCursor),
 			Profiles:    dt.Map(result.Profiles, serialiseProfile),
 		},
 	}, nil
@@ -97,6 +126,11 @@ func (p *Profiles) ProfileList(ctx context.Context, request openapi.ProfileListR
 
 const profileGetCacheControl = "public, max-age=60, stale-while-revalidate=120"
 
+type cachedProfile struct {
+	Profile openapi.PublicProfile
+	Updated time.Time
+}
+
 func (p *Profiles) ProfileGet(ctx context.Context, request openapi.ProfileGetRequestObject) (openapi.ProfileGetResponseObject, error) {
 	id, err := openapi.ResolveHandle(ctx, p.profileQuery, request.AccountHandle)
 	if err != nil {
@@ -117,7 +151,14 @@ func (p *Profiles) ProfileGet(ctx context.Context, request openapi.ProfileGetReq
 		}, nil
 	}
 
-	pro, err := p.profileQuery.GetByID(ctx, id)
+	pro, err := cache.Fetch(ctx, p.profile_cache.Profiles(), id.String(), func(ctx context.Context) (cachedProfile, error) {
+		pro, err := p.profileQuery.GetByID(ctx, id)
+		if err != nil {
+			return cachedProfile{}, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return cachedProfile{Profile: serialiseProfile(pro), Updated: pro.Updated}, nil
+	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -127,9 +168,17 @@ func (p *Profiles) ProfileGet(ctx context.Context, request openapi.ProfileGetReq
 		lastModified = pro.Updated.Format(time.RFC1123)
 	}
 
+	// Pinned items are looked up fresh so that anything unpublished or deleted
+	// since the profile was cached isn't shown.
+	pinned, err := p.pinner.List(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	pro.Profile.Pinned = opt.New(serialiseDatagraphItemList(pinned)).Ptr()
+
 	return openapi.ProfileGet200JSONResponse{
 		ProfileGetOKJSONResponse: openapi.ProfileGetOKJSONResponse{
-			Body: serialiseProfile(pro),
+			Body: pro.Profile,
 			Headers: openapi.ProfileGetOKResponseHeaders{
 				CacheControl: profileGetCacheControl,
 				LastModified: lastModified,
@@ -260,43 +309,51 @@ func serialiseProfile(in *profile.Public) openapi.PublicProfile {
 	invitedBy := opt.Map(in.InvitedBy, func(ib profile.Ref) openapi.ProfileReference {
 		return serialiseProfileReference(ib)
 	})
+	owner := opt.Map(in.Owner, serialiseProfileReference)
 
 	return openapi.PublicProfile{
-		Id:        openapi.Identifier(in.ID.String()),
-		CreatedAt: in.Created.Format(time.RFC3339),
-		Joined:    in.Created,
-		Suspended: in.Deleted.Ptr(),
-		DeletedAt: in.Deleted.Ptr(),
-		Bio:       in.Bio.HTML(),
-		Handle:    in.Handle,
-		Name:      in.Name,
-		Roles:     serialiseHeldRoleList(in.Roles),
-		Followers: in.Followers,
-		Following: in.Following,
-		LikeScore: in.LikeScore,
-		Links:     serialiseExternalLinks(in.ExternalLinks),
-		InvitedBy: invitedBy.Ptr(),
-		Meta:      in.Metadata,
+		Id:           openapi.Identifier(in.ID.String()),
+		CreatedAt:    in.Created.Format(time.RFC3339),
+		Joined:       in.Created,
+		Suspended:    in.Deleted.Ptr(),
+		DeletedAt:    in.Deleted.Ptr(),
+		Bio:          in.Bio.HTML(),
+		Handle:       in.Handle,
+		Name:         in.Name,
+		Kind:         openapi.AccountKind(in.Kind.String()),
+		Owner:        owner.Ptr(),
+		Roles:        serialiseHeldRoleList(in.Roles),
+		Followers:    in.Followers,
+		Following:    in.Following,
+		LikeScore:    in.LikeScore,
+		Links:        serialiseExternalLinks(in.ExternalLinks),
+		InvitedBy:    invitedBy.Ptr(),
+		Meta:         in.Metadata,
+		Verification: serialiseVerificationBadge(in.Verification),
 	}
 }
 
 func serialiseProfileReference(a profile.Ref) openapi.ProfileReference {
 	return openapi.ProfileReference{
-		Id:        *openapi.IdentifierFrom(xid.ID(a.ID)),
-		Joined:    a.Created,
-		Suspended: a.Deleted.Ptr(),
-		Handle:    (openapi.AccountHandle)(a.Handle),
-		Name:      a.Name,
+		Id:           *openapi.IdentifierFrom(xid.ID(a.ID)),
+		Joined:       a.Created,
+		Suspended:    a.Deleted.Ptr(),
+		Handle:       (openapi.AccountHandle)(a.Handle),
+		Name:         a.Name,
+		Kind:         openapi.AccountKind(a.Kind.String()),
+		Verification: serialiseVerificationBadge(a.Verification),
 	}
 }
 
 func serialiseProfileReferenceFromAccount(a account.Account) openapi.ProfileReference {
 	return openapi.ProfileReference{
-		Id:        *openapi.IdentifierFrom(xid.ID(a.ID)),
-		Joined:    a.CreatedAt,
-		Suspended: a.DeletedAt.Ptr(),
-		Handle:    (openapi.AccountHandle)(a.Handle),
-		Name:      a.Name,
+		Id:           *openapi.IdentifierFrom(xid.ID(a.ID)),
+		Joined:       a.CreatedAt,
+		Suspended:    a.DeletedAt.Ptr(),
+		Handle:       (openapi.AccountHandle)(a.Handle),
+		Name:         a.Name,
+		Kind:         openapi.AccountKind(a.Kind.String()),
+		Verification: serialiseVerificationBadge(a.Verification),
 	}
 }
 
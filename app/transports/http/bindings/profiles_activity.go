@@ -0,0 +1,89 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_activity"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func (p *Profiles) ProfileActivityList(ctx context.Context, request openapi.ProfileActivityListRequestObject) (openapi.ProfileActivityListResponseObject, error) {
+	id, err := openapi.ResolveHandle(ctx, p.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	kinds := []account.ActivityKind{}
+	if request.Params.Kind != nil {
+		kind, err := account.NewActivityKind(string(*request.Params.Kind))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		kinds = append(kinds, kind)
+	}
+
+	pp, err := deserialiseCursorParams(request.Params.Page, request.Params.Cursor, 50)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := p.timeline.List(ctx, id, pp, kinds...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileActivityList200JSONResponse{
+		ProfileActivityListOKJSONResponse: openapi.ProfileActivityListOKJSONResponse{
+			CurrentPage: result.CurrentPage,
+			NextPage:    result.NextPage.Ptr(),
+			NextCursor:  serialiseCursor(result.NextCursor),
+			Activity:    dt.Map(result.Items, serialiseProfileActivity),
+			PageSize:    result.Size,
+			Results:     result.Results,
+			TotalPages:  result.TotalPages,
+		},
+	}, nil
+}
+
+func serialiseProfileActivity(in *profile_activity.Activity) openapi.ProfileActivity {
+	out := openapi.ProfileActivity{
+		Id:        in.ID.String(),
+		Kind:      openapi.ProfileActivityKind(in.Kind.String()),
+		CreatedAt: in.Time,
+	}
+
+	if in.Item != nil {
+		item := serialiseDatagraphItem(in.Item)
+		out.Item = &item
+	}
+
+	if in.Collection != nil {
+		c := serialiseCollection(in.Collection)
+		out.Collection = &c
+	}
+
+	return out
+}
+
+func deserialiseActivityKindList(in openapi.ProfileActivityKindList) ([]account.ActivityKind, error) {
+	kinds, err := dt.MapErr(in, func(k openapi.ProfileActivityKind) (account.ActivityKind, error) {
+		return account.NewActivityKind(string(k))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	return kinds, nil
+}
+
+func serialiseActivityKindList(in []account.ActivityKind) openapi.ProfileActivityKindList {
+	return dt.Map(in, func(k account.ActivityKind) openapi.ProfileActivityKind {
+		return openapi.ProfileActivityKind(k.String())
+	})
+}
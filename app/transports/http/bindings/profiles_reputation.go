@@ -0,0 +1,163 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/reputation"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+const (
+	reputationLedgerPageSize = 50
+	reputationLeaderboardMax = 50
+)
+
+func (p *Profiles) ProfileReputationGet(ctx context.Context, request openapi.ProfileReputationGetRequestObject) (openapi.ProfileReputationGetResponseObject, error) {
+	targetID, err := openapi.ResolveHandle(ctx, p.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	pp := deserialisePageParams(request.Params.Page, reputationLedgerPageSize)
+
+	total, err := p.reputationQuerier.Total(ctx, targetID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ledger, err := p.reputationQuerier.Ledger(ctx, targetID, pp)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileReputationGet200JSONResponse{
+		ProfileReputationGetOKJSONResponse: openapi.ProfileReputationGetOKJSONResponse{
+			CurrentPage: ledger.CurrentPage,
+			NextPage:    ledger.NextPage.Ptr(),
+			PageSize:    ledger.Size,
+			Results:     ledger.Results,
+			TotalPages:  ledger.TotalPages,
+			Total:       total,
+			Ledger:      dt.Map(ledger.Items, serialiseReputationEntry),
+		},
+	}, nil
+}
+
+func (p *Profiles) ReputationLeaderboard(ctx context.Context, request openapi.ReputationLeaderboardRequestObject) (openapi.ReputationLeaderboardResponseObject, error) {
+	window := opt.NewPtr(request.Params.Window).Or(openapi.LeaderboardWindow("all"))
+
+	since, err := deserialiseLeaderboardWindow(window)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	standings, err := p.reputationQuerier.Leaderboard(ctx, since, reputationLeaderboardMax)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ids := dt.Map(standings, func(s *reputation.Standing) account.AccountID { return s.AccountID })
+
+	profiles, err := p.profileQuery.GetMany(ctx, ids...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	lookup := lo.KeyBy(profiles, func(p *profile.Public) account.AccountID { return p.ID })
+
+	return openapi.ReputationLeaderboard200JSONResponse{
+		ReputationLeaderboardOKJSONResponse: openapi.ReputationLeaderboardOKJSONResponse{
+			Window: window,
+			Standings: dt.Reduce(standings, func(acc []openapi.ReputationStanding, s *reputation.Standing) []openapi.ReputationStanding {
+				pro, ok := lookup[s.AccountID]
+				if !ok {
+					return acc
+				}
+				return append(acc, openapi.ReputationStanding{
+					Profile: serialiseProfileReference(pro.Ref),
+					Points:  s.Points,
+				})
+			}, []openapi.ReputationStanding{}),
+		},
+	}, nil
+}
+
+func deserialiseLeaderboardWindow(in openapi.LeaderboardWindow) (opt.Optional[time.Time], error) {
+	switch in {
+	case "week":
+		return opt.New(time.Now().Add(-time.Hour * 24 * 7)), nil
+	case "month":
+		return opt.New(time.Now().Add(-time.Hour * 24 * 30)), nil
+	case "all":
+		return opt.NewEmpty[time.Time](), nil
+	default:
+		return opt.NewEmpty[time.Time](), fault.Newf("invalid leaderboard window: %s", in)
+	}
+}
+
+func serialiseReputationEntry(in *reputation.Entry) openapi.ReputationEntry {
+	return openapi.ReputationEntry{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		Reason:    openapi.ReputationReason(in.Reason.String()),
+		Points:    in.Points,
+		ItemKind: opt.PtrMap(in.Item, func(r datagraph.Ref) openapi.DatagraphItemKind {
+			return openapi.DatagraphItemKind(r.Kind.String())
+		}),
+		ItemId: opt.PtrMap(in.Item, func(r datagraph.Ref) openapi.Identifier {
+			return r.ID.String()
+		}),
+	}
+}
+
+func serialiseReputationSettings(in reputation.Settings) openapi.ReputationSettings {
+	return openapi.ReputationSettings{
+		Weights: openapi.ReputationWeights{
+			AnswerAccepted:   in.Weights.AnswerAccepted,
+			LikeReceived:     in.Weights.LikeReceived,
+			ReactionReceived: in.Weights.ReactionReceived,
+			Milestone:        in.Weights.Milestone,
+		},
+		Thresholds: dt.Map(in.Thresholds, func(t reputation.Threshold) openapi.ReputationThreshold {
+			return openapi.ReputationThreshold{
+				Permission: serialisePermission(t.Permission),
+				Points:     t.Points,
+			}
+		}),
+	}
+}
+
+func deserialiseReputationSettings(in openapi.ReputationSettings) (reputation.Settings, error) {
+	thresholds, err := dt.MapErr(in.Thresholds, func(t openapi.ReputationThreshold) (reputation.Threshold, error) {
+		perm, err := deserialisePermission(t.Permission)
+		if err != nil {
+			return reputation.Threshold{}, err
+		}
+
+		return reputation.Threshold{Permission: perm, Points: t.Points}, nil
+	})
+	if err != nil {
+		return reputation.Settings{}, err
+	}
+
+	return reputation.Settings{
+		Weights: reputation.Weights{
+			AnswerAccepted:   in.Weights.AnswerAccepted,
+			LikeReceived:     in.Weights.LikeReceived,
+			ReactionReceived: in.Weights.ReactionReceived,
+			Milestone:        in.Weights.Milestone,
+		},
+		Thresholds: thresholds,
+	}, nil
+}
@@ -2,6 +2,7 @@ package bindings
 
 import (
 	"context"
+	"sort"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
@@ -9,6 +10,7 @@ import (
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
+	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -47,10 +49,16 @@ func (h *Reports) ReportCreate(ctx context.Context, request openapi.ReportCreate
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	reason, err := opt.MapErr(opt.NewPtr(request.Body.Reason), deserialiseReportReason)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
 	r, err := h.memberReportMgr.Submit(
 		ctx,
 		targetID,
 		targetKind,
+		reason,
 		opt.NewPtr(request.Body.Comment),
 	)
 	if err != nil {
@@ -175,6 +183,72 @@ func (h *Reports) ReportUpdate(ctx context.Context, request openapi.ReportUpdate
 	}, nil
 }
 
+func (h *Reports) ReportQueueList(ctx context.Context, request openapi.ReportQueueListRequestObject) (openapi.ReportQueueListResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 10)
+
+	opts := report_manager.ListOpts{}
+
+	if request.Params.Status != nil {
+		status, err := report.NewStatus(string(*request.Params.Status))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		opts.Status = opt.New([]report.Status{status})
+	}
+
+	if request.Params.Kind != nil {
+		kind, err := datagraph.NewKind(*request.Params.Kind)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		opts.Kind = opt.New([]datagraph.Kind{kind})
+	}
+
+	result, err := h.reportMgr.ListTargets(ctx, page, opts)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ReportQueueList200JSONResponse{
+		ReportQueueListOKJSONResponse: openapi.ReportQueueListOKJSONResponse{
+			Targets:     dt.Map(result.Items, serialiseReportTarget),
+			CurrentPage: result.CurrentPage,
+			NextPage:    result.NextPage.Ptr(),
+			PageSize:    result.Size,
+			Results:     result.Results,
+			TotalPages:  result.TotalPages,
+		},
+	}, nil
+}
+
+func (h *Reports) ReportQueueUpdate(ctx context.Context, request openapi.ReportQueueUpdateRequestObject) (openapi.ReportQueueUpdateResponseObject, error) {
+	targetID, err := xid.FromString(request.ReportTargetId)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	var status report.Status
+	switch request.Body.Action {
+	case openapi.Resolve:
+		status = report.StatusResolved
+	case openapi.Dismiss:
+		status = report.StatusDismissed
+	case openapi.Escalate:
+		status = report.StatusEscalated
+	default:
+		return nil, fault.Newf("unknown report queue action: %s", request.Body.Action)
+	}
+
+	target, err := h.reportMgr.HandleTarget(ctx, targetID, status)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ReportQueueUpdate200JSONResponse{
+		ReportQueueUpdateOKJSONResponse: openapi.ReportQueueUpdateOKJSONResponse(serialiseReportTarget(target)),
+	}, nil
+}
+
 func serialiseReport(in *report.Report) openapi.Report {
 	item := opt.Map(opt.NewSafe(in.TargetItem, in.TargetItem != nil), serialiseDatagraphItem)
 
@@ -187,11 +261,49 @@ func serialiseReport(in *report.Report) openapi.Report {
 		Item:       item.Ptr(),
 		ReportedBy: serialiseProfileReferenceFromAccount(in.ReportedBy),
 		HandledBy:  opt.Map(in.HandledBy, serialiseProfileReferenceFromAccount).Ptr(),
+		Reason:     opt.Map(in.Reason, serialiseReportReason).Ptr(),
 		Comment:    in.Comment.Ptr(),
 		Status:     openapi.ReportStatus(in.Status.String()),
 	}
 }
 
+func serialiseReportTarget(in *report.Target) openapi.ReportTarget {
+	item := opt.Map(opt.NewSafe(in.Item, in.Item != nil), serialiseDatagraphItem)
+
+	reasons := dt.Map(lo.Entries(in.Reasons), func(e lo.Entry[report.Reason, int]) openapi.ReportReasonCount {
+		return openapi.ReportReasonCount{
+			Reason: serialiseReportReason(e.Key),
+			Count:  e.Value,
+		}
+	})
+	sort.Slice(reasons, func(i, j int) bool {
+		if reasons[i].Count != reasons[j].Count {
+			return reasons[i].Count > reasons[j].Count
+		}
+		return reasons[i].Reason < reasons[j].Reason
+	})
+
+	return openapi.ReportTarget{
+		TargetId:        in.ID.String(),
+		TargetKind:      openapi.DatagraphItemKind(in.Kind.String()),
+		Item:            item.Ptr(),
+		Status:          openapi.ReportStatus(in.Status.String()),
+		ReportCount:     in.Count(),
+		Reasons:         reasons,
+		FirstReportedAt: in.FirstReportAt,
+		LastReportedAt:  in.LastReportAt,
+		Reports:         dt.Map(in.Reports, serialiseReport),
+	}
+}
+
+func serialiseReportReason(in report.Reason) openapi.ReportReason {
+	return openapi.ReportReason(in.String())
+}
+
+func deserialiseReportReason(in openapi.ReportReason) (report.Reason, error) {
+	return report.NewReason(string(in))
+}
+
 func serialiseReportList(in pagination.Result[*report.Report]) openapi.ReportListResult {
 	items := dt.Map(in.Items, serialiseReport)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/role"
@@ -43,7 +44,12 @@ func (h *Roles) RoleCreate(ctx context.Context, request openapi.RoleCreateReques
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	role, err := h.roleWriter.Create(ctx, request.Body.Name, request.Body.Colour, perms)
+	opts := []role_writer.Mutation{}
+	if q := request.Body.UploadQuota; q != nil && *q > 0 {
+		opts = append(opts, role_writer.WithUploadQuota(opt.New(*q)))
+	}
+
+	role, err := h.roleWriter.Create(ctx, request.Body.Name, request.Body.Colour, perms, opts...)
 	if err != nil {
 		if ent.IsConstraintError(err) {
 			err = fault.Wrap(err, fmsg.WithDesc("unique", "A role with that name already exists"), ftag.With(ftag.AlreadyExists))
@@ -104,6 +110,15 @@ func (h *Roles) RoleUpdate(ctx context.Context, request openapi.RoleUpdateReques
 		opts = append(opts, role_writer.WithPermissions(perms))
 	}
 
+	if q := request.Body.UploadQuota; q != nil {
+		quota := opt.New(*q)
+		if *q <= 0 {
+			quota = opt.NewEmpty[int64]()
+		}
+
+		opts = append(opts, role_writer.WithUploadQuota(quota))
+	}
+
 	role, err := h.roleWriter.Update(ctx, id, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -129,6 +144,7 @@ func serialiseRole(in role.Role) openapi.Role {
 		Name:        in.Name,
 		Colour:      in.Colour,
 		Permissions: serialisePermissionList(in.Permissions),
+		UploadQuota: in.UploadQuota.Ptr(),
 		CreatedAt:   in.CreatedAt,
 	}
 }
@@ -150,6 +166,7 @@ func serialiseHeldRole(in held.Role) openapi.AccountRole {
 		Name:        in.Name,
 		Colour:      in.Colour,
 		Permissions: serialisePermissionList(in.Permissions),
+		UploadQuota: in.UploadQuota.Ptr(),
 		Badge:       in.Badge,
 		Default:     in.Default,
 		CreatedAt:   in.CreatedAt,
@@ -0,0 +1,78 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/scheduled_task"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type ScheduledTasks struct {
+	scheduler *scheduler.Scheduler
+}
+
+func NewScheduledTasks(scheduler *scheduler.Scheduler) ScheduledTasks {
+	return ScheduledTasks{scheduler: scheduler}
+}
+
+func (h ScheduledTasks) AdminScheduledTaskList(ctx context.Context, request openapi.AdminScheduledTaskListRequestObject) (openapi.AdminScheduledTaskListResponseObject, error) {
+	tasks, err := h.scheduler.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminScheduledTaskList200JSONResponse{
+		AdminScheduledTaskListOKJSONResponse: openapi.AdminScheduledTaskListOKJSONResponse{
+			Tasks: dt.Map(tasks, serialiseScheduledTask),
+		},
+	}, nil
+}
+
+func (h ScheduledTasks) AdminScheduledTaskUpdate(ctx context.Context, request openapi.AdminScheduledTaskUpdateRequestObject) (openapi.AdminScheduledTaskUpdateResponseObject, error) {
+	t, err := h.scheduler.Update(ctx, request.ScheduledTaskName, scheduler.Partial{
+		Schedule: opt.NewPtr(request.Body.Schedule),
+		Enabled:  opt.NewPtr(request.Body.Enabled),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminScheduledTaskUpdate200JSONResponse{
+		AdminScheduledTaskOKJSONResponse: openapi.AdminScheduledTaskOKJSONResponse(serialiseScheduledTask(t)),
+	}, nil
+}
+
+func (h ScheduledTasks) AdminScheduledTaskRun(ctx context.Context, request openapi.AdminScheduledTaskRunRequestObject) (openapi.AdminScheduledTaskRunResponseObject, error) {
+	j, err := h.scheduler.Trigger(ctx, request.ScheduledTaskName)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminScheduledTaskRun200JSONResponse{
+		AdminJobOKJSONResponse: openapi.AdminJobOKJSONResponse(serialiseJob(j)),
+	}, nil
+}
+
+func serialiseScheduledTask(in *scheduler.Task) openapi.ScheduledTask {
+	status := opt.Map(in.LastStatus, func(s scheduled_task.Status) openapi.ScheduledTaskStatus {
+		return openapi.ScheduledTaskStatus(s.String())
+	})
+
+	return openapi.ScheduledTask{
+		Name:            in.Name,
+		Schedule:        in.EffectiveSchedule(),
+		DefaultSchedule: in.DefaultSchedule,
+		Enabled:         in.Enabled,
+		NextRunAt:       in.NextRunAt,
+		LastStartedAt:   in.LastStartedAt.Ptr(),
+		LastFinishedAt:  in.LastFinishedAt.Ptr(),
+		LastStatus:      status.Ptr(),
+		LastError:       in.LastError.Ptr(),
+	}
+}
@@ -0,0 +1,146 @@
+package bindings
+
+import (
+	"context"
+	"io"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/sitemap"
+	"github.com/Southclaws/storyden/app/services/branding/og_image"
+	"github.com/Southclaws/storyden/app/services/seo"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type SEO struct {
+	generator       *seo.Generator
+	images          *og_image.Generator
+	thread_mark_svc thread_mark.Service
+}
+
+func NewSEO(generator *seo.Generator, images *og_image.Generator, thread_mark_svc thread_mark.Service) SEO {
+	return SEO{
+		generator:       generator,
+		images:          images,
+		thread_mark_svc: thread_mark_svc,
+	}
+}
+
+func (h *SEO) SitemapIndexGet(ctx context.Context, request openapi.SitemapIndexGetRequestObject) (openapi.SitemapIndexGetResponseObject, error) {
+	doc, err := h.generator.Index(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, false)
+	if r.notModified {
+		return openapi.SitemapIndexGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.SitemapIndexGet200AsteriskResponse{SitemapOKAsteriskResponse: r.sitemap()}, nil
+}
+
+func (h *SEO) SitemapGet(ctx context.Context, request openapi.SitemapGetRequestObject) (openapi.SitemapGetResponseObject, error) {
+	section, err := sitemap.NewSection(string(request.SitemapSection))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	doc, err := h.generator.Sitemap(ctx, section, request.SitemapPage)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, false)
+	if r.notModified {
+		return openapi.SitemapGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.SitemapGet200AsteriskResponse{SitemapOKAsteriskResponse: r.sitemap()}, nil
+}
+
+func (h *SEO) StructuredDataThreadGet(ctx context.Context, request openapi.StructuredDataThreadGetRequestObject) (openapi.StructuredDataThreadGetResponseObject, error) {
+	postID, err := h.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	doc, err := h.generator.Thread(ctx, postID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, false)
+	if r.notModified {
+		return openapi.StructuredDataThreadGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.StructuredDataThreadGet200AsteriskResponse{StructuredDataOKAsteriskResponse: r.structuredData()}, nil
+}
+
+func (h *SEO) StructuredDataNodeGet(ctx context.Context, request openapi.StructuredDataNodeGetRequestObject) (openapi.StructuredDataNodeGetResponseObject, error) {
+	doc, err := h.generator.Node(ctx, request.NodeSlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, false)
+	if r.notModified {
+		return openapi.StructuredDataNodeGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.StructuredDataNodeGet200AsteriskResponse{StructuredDataOKAsteriskResponse: r.structuredData()}, nil
+}
+
+func (h *SEO) StructuredDataProfileGet(ctx context.Context, request openapi.StructuredDataProfileGetRequestObject) (openapi.StructuredDataProfileGetResponseObject, error) {
+	doc, err := h.generator.Profile(ctx, string(request.AccountHandle))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r := newFeedResult(ctx, doc, false)
+	if r.notModified {
+		return openapi.StructuredDataProfileGet304Response{Headers: r.notModifiedHeaders()}, nil
+	}
+
+	return openapi.StructuredDataProfileGet200AsteriskResponse{StructuredDataOKAsteriskResponse: r.structuredData()}, nil
+}
+
+func (h *SEO) OpenGraphImageThreadGet(ctx context.Context, request openapi.OpenGraphImageThreadGetRequestObject) (openapi.OpenGraphImageThreadGetResponseObject, error) {
+	postID, err := h.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a, r, err := h.images.Thread(ctx, postID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.OpenGraphImageThreadGet200AsteriskResponse{AssetGetOKAsteriskResponse: openGraphImage(a, r)}, nil
+}
+
+func (h *SEO) OpenGraphImageNodeGet(ctx context.Context, request openapi.OpenGraphImageNodeGetRequestObject) (openapi.OpenGraphImageNodeGetResponseObject, error) {
+	a, r, err := h.images.Node(ctx, request.NodeSlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.OpenGraphImageNodeGet200AsteriskResponse{AssetGetOKAsteriskResponse: openGraphImage(a, r)}, nil
+}
+
+// Images are only requested again once this expires, the short lifetime is so
+// that changes to what's shown on them appear in new previews reasonably soon.
+func openGraphImage(a *asset.Asset, r io.Reader) openapi.AssetGetOKAsteriskResponse {
+	return openapi.AssetGetOKAsteriskResponse{
+		Body:          r,
+		ContentType:   a.MIME.String(),
+		ContentLength: int64(a.Size),
+		Headers: openapi.AssetGetOKResponseHeaders{
+			CacheControl: "public, max-age=3600",
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/resources/space"
+	"github.com/Southclaws/storyden/app/services/space/space_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Spaces struct {
+	profileQuery *profile_querier.Querier
+	manager      *space_manager.Manager
+}
+
+func NewSpaces(
+	profileQuery *profile_querier.Querier,
+	manager *space_manager.Manager,
+) Spaces {
+	return Spaces{
+		profileQuery: profileQuery,
+		manager:      manager,
+	}
+}
+
+func (h *Spaces) SpaceList(ctx context.Context, request openapi.SpaceListRequestObject) (openapi.SpaceListResponseObject, error) {
+	page := deserialisePageParams(request.Params.Page, 50)
+
+	spaces, err := h.manager.List(ctx, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceList200JSONResponse{
+		SpaceListOKJSONResponse: openapi.SpaceListOKJSONResponse{
+			Spaces:      dt.Map(spaces.Items, serialiseSpace),
+			CurrentPage: spaces.CurrentPage,
+			NextPage:    spaces.NextPage.Ptr(),
+			PageSize:    spaces.Size,
+			Results:     spaces.Results,
+			TotalPages:  spaces.TotalPages,
+		},
+	}, nil
+}
+
+func (h *Spaces) SpaceCreate(ctx context.Context, request openapi.SpaceCreateRequestObject) (openapi.SpaceCreateResponseObject, error) {
+	policy, err := opt.MapErr(opt.NewPtr(request.Body.JoinPolicy), deserialiseSpaceJoinPolicy)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	sp, err := h.manager.Create(ctx, request.Body.Name, space_manager.Partial{
+		Slug:        opt.NewPtr(request.Body.Slug),
+		Description: opt.NewPtr(request.Body.Description),
+		Policy:      policy,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceCreate200JSONResponse{
+		SpaceCreateOKJSONResponse: openapi.SpaceCreateOKJSONResponse(serialiseSpace(sp)),
+	}, nil
+}
+
+func (h *Spaces) SpaceGet(ctx context.Context, request openapi.SpaceGetRequestObject) (openapi.SpaceGetResponseObject, error) {
+	sp, err := h.manager.Get(ctx, request.SpaceSlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceGet200JSONResponse{
+		SpaceGetOKJSONResponse: openapi.SpaceGetOKJSONResponse(serialiseSpace(sp)),
+	}, nil
+}
+
+func (h *Spaces) SpaceUpdate(ctx context.Context, request openapi.SpaceUpdateRequestObject) (openapi.SpaceUpdateResponseObject, error) {
+	policy, err := opt.MapErr(opt.NewPtr(request.Body.JoinPolicy), deserialiseSpaceJoinPolicy)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	sp, err := h.manager.Update(ctx, request.SpaceSlug, space_manager.Partial{
+		Name:        opt.NewPtr(request.Body.Name),
+		Slug:        opt.NewPtr(request.Body.Slug),
+		Description: opt.NewPtr(request.Body.Description),
+		Policy:      policy,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceUpdate200JSONResponse{
+		SpaceUpdateOKJSONResponse: openapi.SpaceUpdateOKJSONResponse(serialiseSpace(sp)),
+	}, nil
+}
+
+func (h *Spaces) SpaceDelete(ctx context.Context, request openapi.SpaceDeleteRequestObject) (openapi.SpaceDeleteResponseObject, error) {
+	if err := h.manager.Delete(ctx, request.SpaceSlug); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceDelete204Response{}, nil
+}
+
+func (h *Spaces) SpaceMemberList(ctx context.Context, request openapi.SpaceMemberListRequestObject) (openapi.SpaceMemberListResponseObject, error) {
+	members, err := h.manager.Members(ctx, request.SpaceSlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceMemberList200JSONResponse{
+		SpaceMemberListOKJSONResponse: openapi.SpaceMemberListOKJSONResponse{
+			Members: dt.Map(members, serialiseSpaceMember),
+		},
+	}, nil
+}
+
+func (h *Spaces) SpaceJoin(ctx context.Context, request openapi.SpaceJoinRequestObject) (openapi.SpaceJoinResponseObject, error) {
+	sp, err := h.manager.Join(ctx, request.SpaceSlug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceJoin200JSONResponse{
+		SpaceGetOKJSONResponse: openapi.SpaceGetOKJSONResponse(serialiseSpace(sp)),
+	}, nil
+}
+
+func (h *Spaces) SpaceLeave(ctx context.Context, request openapi.SpaceLeaveRequestObject) (openapi.SpaceLeaveResponseObject, error) {
+	if err := h.manager.Leave(ctx, request.SpaceSlug); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceLeave204Response{}, nil
+}
+
+func (h *Spaces) SpaceMemberUpdate(ctx context.Context, request openapi.SpaceMemberUpdateRequestObject) (openapi.SpaceMemberUpdateResponseObject, error) {
+	targetID, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	role, err := opt.MapErr(opt.NewPtr(request.Body.Role), func(r openapi.SpaceMemberRole) (space.Role, error) {
+		return space.NewRole(string(r))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	mem, err := h.manager.SetMember(ctx, request.SpaceSlug, targetID, role)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceMemberUpdate200JSONResponse{
+		SpaceMemberUpdateOKJSONResponse: openapi.SpaceMemberUpdateOKJSONResponse(serialiseSpaceMember(mem)),
+	}, nil
+}
+
+func (h *Spaces) SpaceMemberRemove(ctx context.Context, request openapi.SpaceMemberRemoveRequestObject) (openapi.SpaceMemberRemoveResponseObject, error) {
+	targetID, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := h.manager.RemoveMember(ctx, request.SpaceSlug, targetID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.SpaceMemberRemove204Response{}, nil
+}
+
+func deserialiseSpaceJoinPolicy(in openapi.SpaceJoinPolicy) (space.Policy, error) {
+	return space.NewPolicy(string(in))
+}
+
+func serialiseSpace(in *space.Space) openapi.Space {
+	return openapi.Space{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Name:        in.Name,
+		Slug:        in.Slug,
+		Description: in.Description,
+		JoinPolicy:  openapi.SpaceJoinPolicy(in.Policy.String()),
+		MemberCount: in.MemberCount,
+		Membership: opt.Map(in.Membership, func(m space.Member) openapi.SpaceMember {
+			return serialiseSpaceMember(&m)
+		}).Ptr(),
+	}
+}
+
+func serialiseSpaceMember(in *space.Member) openapi.SpaceMember {
+	return openapi.SpaceMember{
+		Profile:  serialiseProfileReference(in.Profile),
+		Role:     openapi.SpaceMemberRole(in.Role.String()),
+		Status:   openapi.SpaceMemberStatus(in.Status.String()),
+		JoinedAt: in.JoinedAt,
+	}
+}
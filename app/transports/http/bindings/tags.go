@@ -6,20 +6,32 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/tag"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_policy"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/profile/following"
+	"github.com/Southclaws/storyden/app/services/tag/tag_suggest"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
 
 type Tags struct {
-	tagQuerier *tag_querier.Querier
+	tagQuerier    *tag_querier.Querier
+	tagWriter     *tag_writer.Writer
+	followManager *following.FollowManager
+	suggester     *tag_suggest.Suggester
 }
 
-func NewTags(tagQuerier *tag_querier.Querier) Tags {
-	return Tags{tagQuerier: tagQuerier}
+func NewTags(tagQuerier *tag_querier.Querier, tagWriter *tag_writer.Writer, followManager *following.FollowManager, suggester *tag_suggest.Suggester) Tags {
+	return Tags{tagQuerier: tagQuerier, tagWriter: tagWriter, followManager: followManager, suggester: suggester}
 }
 
 func (h Tags) TagList(ctx context.Context, request openapi.TagListRequestObject) (openapi.TagListResponseObject, error) {
@@ -43,6 +55,28 @@ func (h Tags) TagList(ctx context.Context, request openapi.TagListRequestObject)
 	}, nil
 }
 
+func (h Tags) TagSuggest(ctx context.Context, request openapi.TagSuggestRequestObject) (openapi.TagSuggestResponseObject, error) {
+	content, err := datagraph.NewRichText(request.Body.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	suggestions, err := h.suggester.Suggest(ctx,
+		opt.NewPtr(request.Body.Title).OrZero(),
+		content,
+		opt.NewPtr(request.Body.Limit).OrZero(),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagSuggest200JSONResponse{
+		TagSuggestOKJSONResponse: openapi.TagSuggestOKJSONResponse{
+			Suggestions: dt.Map(suggestions, serialiseTagSuggestion),
+		},
+	}, nil
+}
+
 func (h Tags) TagGet(ctx context.Context, request openapi.TagGetRequestObject) (openapi.TagGetResponseObject, error) {
 	name := tag_ref.NewName(request.TagName)
 
@@ -56,16 +90,146 @@ func (h Tags) TagGet(ctx context.Context, request openapi.TagGetRequestObject) (
 	}, nil
 }
 
+func (h Tags) TagUpdate(ctx context.Context, request openapi.TagUpdateRequestObject) (openapi.TagUpdateResponseObject, error) {
+	name := deserialiseTagName(request.TagName)
+
+	if request.Body.Parent.IsSpecified() {
+		parent := opt.NewEmpty[tag_ref.Name]()
+		if !request.Body.Parent.IsNull() {
+			parent = opt.New(deserialiseTagName(request.Body.Parent.MustGet()))
+		}
+
+		err := h.tagWriter.SetParent(ctx, name, parent)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if request.Body.Roles != nil {
+		roles := dt.Map(*request.Body.Roles, func(id string) role.RoleID { return role.RoleID(deserialiseID(id)) })
+
+		err := h.tagWriter.SetRoles(ctx, name, roles)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	tag, err := h.tagQuerier.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagUpdate200JSONResponse{
+		TagGetOKJSONResponse: openapi.TagGetOKJSONResponse(serialiseTag(tag)),
+	}, nil
+}
+
+func (h Tags) TagSynonymAdd(ctx context.Context, request openapi.TagSynonymAddRequestObject) (openapi.TagSynonymAddResponseObject, error) {
+	name := deserialiseTagName(request.TagName)
+
+	err := h.tagWriter.AddSynonym(ctx, name, deserialiseTagName(request.TagSynonym))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tag, err := h.tagQuerier.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagSynonymAdd200JSONResponse{
+		TagGetOKJSONResponse: openapi.TagGetOKJSONResponse(serialiseTag(tag)),
+	}, nil
+}
+
+func (h Tags) TagSynonymRemove(ctx context.Context, request openapi.TagSynonymRemoveRequestObject) (openapi.TagSynonymRemoveResponseObject, error) {
+	name := deserialiseTagName(request.TagName)
+
+	err := h.tagWriter.RemoveSynonym(ctx, name, deserialiseTagName(request.TagSynonym))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tag, err := h.tagQuerier.Get(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagSynonymRemove200JSONResponse{
+		TagGetOKJSONResponse: openapi.TagGetOKJSONResponse(serialiseTag(tag)),
+	}, nil
+}
+
+func (h Tags) TagMerge(ctx context.Context, request openapi.TagMergeRequestObject) (openapi.TagMergeResponseObject, error) {
+	target, err := h.tagWriter.Merge(ctx,
+		deserialiseTagName(request.TagName),
+		deserialiseTagName(request.Body.Target),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tag, err := h.tagQuerier.Get(ctx, target.Name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagMerge200JSONResponse{
+		TagGetOKJSONResponse: openapi.TagGetOKJSONResponse(serialiseTag(tag)),
+	}, nil
+}
+
+func (h Tags) TagFollowersAdd(ctx context.Context, request openapi.TagFollowersAddRequestObject) (openapi.TagFollowersAddResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = h.followManager.FollowTag(ctx, accountID, deserialiseTagName(request.TagName))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagFollowersAdd200Response{}, nil
+}
+
+func (h Tags) TagFollowersRemove(ctx context.Context, request openapi.TagFollowersRemoveRequestObject) (openapi.TagFollowersRemoveResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = h.followManager.UnfollowTag(ctx, accountID, deserialiseTagName(request.TagName))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagFollowersRemove200Response{}, nil
+}
+
 func serialiseTag(in *tag.Tag) openapi.Tag {
 	return openapi.Tag{
 		Id:        in.ID.String(),
 		Name:      in.Name.String(),
 		Colour:    in.Colour,
 		ItemCount: in.ItemCount,
+		Parent:    opt.Map(in.Parent, func(n tag_ref.Name) string { return n.String() }).Ptr(),
+		Children:  in.Children.Strings(),
+		Synonyms:  in.Synonyms.Strings(),
+		Roles:     serialiseTagRoles(in.Roles),
 		Items:     serialiseDatagraphItemList(in.Items),
 	}
 }
 
+func serialiseTagRoles(in []role.RoleID) *[]string {
+	if len(in) == 0 {
+		return nil
+	}
+
+	roles := dt.Map(in, func(r role.RoleID) string { return r.String() })
+	return &roles
+}
+
 func serialiseTagReference(in *tag_ref.Tag) openapi.TagReference {
 	return openapi.TagReference{
 		Name:      in.Name.String(),
@@ -78,6 +242,20 @@ func serialiseTagReferenceList(in tag_ref.Tags) []openapi.TagReference {
 	return dt.Map(in, serialiseTagReference)
 }
 
+func serialiseTagCreationSettings(in tag_policy.Settings) openapi.TagCreationSettings {
+	return openapi.TagCreationSettings{
+		Roles:      opt.New(dt.Map(in.Roles, func(id xid.ID) openapi.Identifier { return id.String() })).Ptr(),
+		Reputation: in.Reputation.Ptr(),
+	}
+}
+
+func deserialiseTagCreationSettings(in openapi.TagCreationSettings) tag_policy.Settings {
+	return tag_policy.Settings{
+		Roles:      dt.Map(opt.NewPtr(in.Roles).OrZero(), deserialiseID),
+		Reputation: opt.NewPtr(in.Reputation),
+	}
+}
+
 func deserialiseTagName(in string) tag_ref.Name {
 	return tag_ref.NewName(in)
 }
@@ -85,3 +263,11 @@ func deserialiseTagName(in string) tag_ref.Name {
 func tagsIDs(i openapi.TagListIDs) []xid.ID {
 	return dt.Map(i, deserialiseID)
 }
+
+func serialiseTagSuggestion(in *tag_suggest.Suggestion) openapi.TagSuggestion {
+	return openapi.TagSuggestion{
+		Name:     in.Name.String(),
+		Score:    float32(in.Score),
+		Existing: in.Existing,
+	}
+}
@@ -0,0 +1,83 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/telegram_bot"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Telegram struct {
+	bot *telegram_bot.Bot
+}
+
+func NewTelegram(bot *telegram_bot.Bot) Telegram {
+	return Telegram{bot: bot}
+}
+
+func (h Telegram) AccountTelegramLinkGet(ctx context.Context, request openapi.AccountTelegramLinkGetRequestObject) (openapi.AccountTelegramLinkGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	link, err := h.bot.Linked(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	username, err := h.bot.Username(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	res := openapi.AccountTelegramLinkOKJSONResponse{
+		BotUsername: username,
+	}
+	if l, ok := link.Get(); ok {
+		res.UserId = &l.UserID
+		res.Username = l.Username.Ptr()
+	}
+
+	return openapi.AccountTelegramLinkGet200JSONResponse{
+		AccountTelegramLinkOKJSONResponse: res,
+	}, nil
+}
+
+func (h Telegram) AccountTelegramLinkCreate(ctx context.Context, request openapi.AccountTelegramLinkCreateRequestObject) (openapi.AccountTelegramLinkCreateResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	code, err := h.bot.LinkCode(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountTelegramLinkCreate200JSONResponse{
+		AccountTelegramLinkCodeOKJSONResponse: openapi.AccountTelegramLinkCodeOKJSONResponse{
+			BotUsername: code.BotUsername,
+			Code:        code.Code,
+			Url:         code.URL,
+			ExpiresAt:   code.Expires,
+		},
+	}, nil
+}
+
+func (h Telegram) AccountTelegramLinkRemove(ctx context.Context, request openapi.AccountTelegramLinkRemoveRequestObject) (openapi.AccountTelegramLinkRemoveResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := h.bot.Unlink(ctx, accountID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountTelegramLinkRemove204Response{}, nil
+}
@@ -0,0 +1,80 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/tenant"
+	"github.com/Southclaws/storyden/app/services/tenancy"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Tenants struct {
+	tenants *tenancy.Manager
+}
+
+func NewTenants(tenants *tenancy.Manager) Tenants {
+	return Tenants{tenants: tenants}
+}
+
+func (h Tenants) AdminTenantList(ctx context.Context, request openapi.AdminTenantListRequestObject) (openapi.AdminTenantListResponseObject, error) {
+	tenants, err := h.tenants.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminTenantList200JSONResponse{
+		AdminTenantListOKJSONResponse: openapi.AdminTenantListOKJSONResponse{
+			Tenants: dt.Map(tenants, serialiseTenant),
+		},
+	}, nil
+}
+
+func (h Tenants) AdminTenantCreate(ctx context.Context, request openapi.AdminTenantCreateRequestObject) (openapi.AdminTenantCreateResponseObject, error) {
+	t, err := h.tenants.Create(ctx, request.Body.Hostname, request.Body.Name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminTenantCreate200JSONResponse{
+		AdminTenantOKJSONResponse: openapi.AdminTenantOKJSONResponse(serialiseTenant(t)),
+	}, nil
+}
+
+func (h Tenants) AdminTenantUpdate(ctx context.Context, request openapi.AdminTenantUpdateRequestObject) (openapi.AdminTenantUpdateResponseObject, error) {
+	t, err := h.tenants.Update(ctx,
+		tenant.ID(deserialiseID(request.TenantId)),
+		opt.NewPtr(request.Body.Hostname),
+		opt.NewPtr(request.Body.Name),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminTenantUpdate200JSONResponse{
+		AdminTenantOKJSONResponse: openapi.AdminTenantOKJSONResponse(serialiseTenant(t)),
+	}, nil
+}
+
+func (h Tenants) AdminTenantDelete(ctx context.Context, request openapi.AdminTenantDeleteRequestObject) (openapi.AdminTenantDeleteResponseObject, error) {
+	err := h.tenants.Delete(ctx, tenant.ID(deserialiseID(request.TenantId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminTenantDelete204Response{}, nil
+}
+
+func serialiseTenant(in *tenant.Tenant) openapi.Tenant {
+	return openapi.Tenant{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Hostname:  in.Hostname,
+		Name:      in.Name,
+	}
+}
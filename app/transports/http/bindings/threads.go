@@ -19,10 +19,15 @@ import (
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/space"
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/feed"
+	"github.com/Southclaws/storyden/app/services/related"
 	"github.com/Southclaws/storyden/app/services/reqinfo"
 	thread_service "github.com/Southclaws/storyden/app/services/thread"
 	"github.com/Southclaws/storyden/app/services/thread_mark"
@@ -35,6 +40,8 @@ type Threads struct {
 	thread_mark_svc thread_mark.Service
 	accountQuery    *account_querier.Querier
 	profileQuery    *profile_querier.Querier
+	feed_svc        *feed.Feed
+	relatedFinder   *related.Finder
 }
 
 func NewThreads(
@@ -43,8 +50,10 @@ func NewThreads(
 	thread_mark_svc thread_mark.Service,
 	accountQuery *account_querier.Querier,
 	profileQuery *profile_querier.Querier,
+	feed_svc *feed.Feed,
+	relatedFinder *related.Finder,
 ) Threads {
-	return Threads{thread_cache, thread_svc, thread_mark_svc, accountQuery, profileQuery}
+	return Threads{thread_cache, thread_svc, thread_mark_svc, accountQuery, profileQuery, feed_svc, relatedFinder}
 }
 
 func (i *Threads) ThreadCreate(ctx context.Context, request openapi.ThreadCreateRequestObject) (openapi.ThreadCreateResponseObject, error) {
@@ -76,6 +85,10 @@ func (i *Threads) ThreadCreate(ctx context.Context, request openapi.ThreadCreate
 		return openapi.ParseID(cat)
 	})
 
+	spaceID := opt.NewPtrMap(request.Body.Space, func(id openapi.Identifier) space.SpaceID {
+		return space.SpaceID(openapi.ParseID(id))
+	})
+
 	url, err := opt.MapErr(opt.NewPtr(request.Body.Url), func(s string) (url.URL, error) {
 		u, err := url.Parse(s)
 		if err != nil {
@@ -94,6 +107,7 @@ func (i *Threads) ThreadCreate(ctx context.Context, request openapi.ThreadCreate
 		thread_service.Partial{
 			Content:    richContent,
 			Category:   category,
+			Space:      spaceID,
 			Tags:       tags,
 			Visibility: status,
 			URL:        url,
@@ -144,6 +158,61 @@ func (i *Threads) ThreadUpdate(ctx context.Context, request openapi.ThreadUpdate
 	}, nil
 }
 
+func (i *Threads) ThreadAnswerSet(ctx context.Context, request openapi.ThreadAnswerSetRequestObject) (openapi.ThreadAnswerSetResponseObject, error) {
+	postID, err := i.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thread, err := i.thread_svc.SetAnswer(ctx, postID, opt.New(deserialisePostID(request.Body.ReplyId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ThreadAnswerSet200JSONResponse{
+		ThreadUpdateOKJSONResponse: openapi.ThreadUpdateOKJSONResponse(serialiseThread(thread)),
+	}, nil
+}
+
+func (i *Threads) ThreadAnswerRemove(ctx context.Context, request openapi.ThreadAnswerRemoveRequestObject) (openapi.ThreadAnswerRemoveResponseObject, error) {
+	postID, err := i.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thread, err := i.thread_svc.SetAnswer(ctx, postID, opt.NewEmpty[post.ID]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ThreadAnswerRemove200JSONResponse{
+		ThreadUpdateOKJSONResponse: openapi.ThreadUpdateOKJSONResponse(serialiseThread(thread)),
+	}, nil
+}
+
+func (i *Threads) ThreadRelated(ctx context.Context, request openapi.ThreadRelatedRequestObject) (openapi.ThreadRelatedResponseObject, error) {
+	postID, err := i.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thread, err := i.thread_svc.Get(ctx, postID, pagination.NewPageParams(1, 1))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	items, err := i.relatedFinder.Related(ctx, thread, opt.NewPtr(request.Params.Limit).OrZero())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ThreadRelated200JSONResponse{
+		DatagraphRelatedOKJSONResponse: openapi.DatagraphRelatedOKJSONResponse{
+			Items: serialiseDatagraphItemList(items),
+		},
+	}, nil
+}
+
 func (i *Threads) ThreadDelete(ctx context.Context, request openapi.ThreadDeleteRequestObject) (openapi.ThreadDeleteResponseObject, error) {
 	postID, err := i.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
 	if err != nil {
@@ -190,6 +259,14 @@ func (i *Threads) ThreadList(ctx context.Context, request openapi.ThreadListRequ
 
 	cats := deserialiseCategorySlugQueryParam(request.Params.Categories)
 
+	cursor, err := deserialiseCursor(request.Params.Cursor)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if cursor.Ok() {
+		page = 1
+	}
+
 	page = max(0, page-1)
 	result, err := i.thread_svc.List(ctx, page, pageSize, thread_service.Params{
 		Query:      query,
@@ -197,15 +274,65 @@ func (i *Threads) ThreadList(ctx context.Context, request openapi.ThreadListRequ
 		Visibility: visibilities,
 		Tags:       tags,
 		Categories: cats,
+		Space:      opt.NewPtr(request.Params.Space),
+		Cursor:     cursor,
 	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	page = result.CurrentPage + 1
 	nextPage := opt.Map(result.NextPage, func(i int) int { return i + 1 })
 
 	return openapi.ThreadList200JSONResponse{
+		ThreadListOKJSONResponse: openapi.ThreadListOKJSONResponse{
+			Body: openapi.ThreadListResult{
+				CurrentPage: result.CurrentPage + 1,
+				NextPage:    nextPage.Ptr(),
+				NextCursor:  serialiseCursor(result.NextCursor),
+				PageSize:    result.PageSize,
+				Results:     result.Results,
+				Threads:     dt.Map(result.Threads, serialiseThreadReference),
+				TotalPages:  result.TotalPages,
+			},
+			Headers: openapi.ThreadListOKResponseHeaders{
+				CacheControl: "no-store",
+			},
+		},
+	}, nil
+}
+
+func (i *Threads) FeedList(ctx context.Context, request openapi.FeedListRequestObject) (openapi.FeedListResponseObject, error) {
+	pageSize := 50
+
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	page := opt.NewPtrMap(request.Params.Page, func(s string) int {
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return 0
+		}
+
+		return max(1, int(v))
+	}).Or(1)
+
+	mode, err := opt.MapErr(opt.NewPtr(request.Params.Mode), deserialiseFeedMode)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	page = max(0, page-1)
+	result, err := i.feed_svc.List(ctx, accountID, mode.Or(feed.ModeLatest), page, pageSize)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	page = result.CurrentPage + 1
+	nextPage := opt.Map(result.NextPage, func(i int) int { return i + 1 })
+
+	return openapi.FeedList200JSONResponse{
 		ThreadListOKJSONResponse: openapi.ThreadListOKJSONResponse{
 			Body: openapi.ThreadListResult{
 				CurrentPage: page,
@@ -245,7 +372,10 @@ func (i *Threads) ThreadGet(ctx context.Context, request openapi.ThreadGetReques
 		}, nil
 	}
 
-	pp := deserialisePageParams(request.Params.Page, 50)
+	pp, err := deserialiseCursorParams(request.Params.Page, request.Params.Cursor, 50)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
 
 	thread, err := i.thread_svc.Get(ctx, postID, pp)
 	if err != nil {
@@ -297,3 +427,7 @@ func deserialiseCategorySlugQueryParam(in *openapi.CategorySlugListQuery) opt.Op
 		Uncategorised: false,
 	})
 }
+
+func deserialiseFeedMode(in openapi.FeedMode) (feed.Mode, error) {
+	return feed.NewMode(string(in))
+}
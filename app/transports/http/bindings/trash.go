@@ -0,0 +1,77 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/trash"
+	"github.com/Southclaws/storyden/app/services/moderation/trash_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Trash struct {
+	trashManager *trash_manager.Manager
+}
+
+func NewTrash(
+	trashManager *trash_manager.Manager,
+) Trash {
+	return Trash{
+		trashManager: trashManager,
+	}
+}
+
+func (h Trash) ModerationTrashList(ctx context.Context, request openapi.ModerationTrashListRequestObject) (openapi.ModerationTrashListResponseObject, error) {
+	items, retention, err := h.trashManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationTrashList200JSONResponse{
+		TrashListOKJSONResponse: openapi.TrashListOKJSONResponse{
+			Items:         dt.Map(items, serialiseTrashItem(retention)),
+			RetentionDays: int(retention / (24 * time.Hour)),
+		},
+	}, nil
+}
+
+func (h Trash) ModerationTrashDelete(ctx context.Context, request openapi.ModerationTrashDeleteRequestObject) (openapi.ModerationTrashDeleteResponseObject, error) {
+	err := h.trashManager.Delete(ctx, deserialiseID(request.TrashItemId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationTrashDelete204Response{}, nil
+}
+
+func (h Trash) ModerationTrashRestore(ctx context.Context, request openapi.ModerationTrashRestoreRequestObject) (openapi.ModerationTrashRestoreResponseObject, error) {
+	item, retention, err := h.trashManager.Restore(ctx, deserialiseID(request.TrashItemId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationTrashRestore200JSONResponse{
+		TrashItemOKJSONResponse: openapi.TrashItemOKJSONResponse(serialiseTrashItem(retention)(item)),
+	}, nil
+}
+
+func serialiseTrashItem(retention time.Duration) func(*trash.Item) openapi.TrashItem {
+	return func(in *trash.Item) openapi.TrashItem {
+		return openapi.TrashItem{
+			Id:              in.ID.String(),
+			Kind:            openapi.DatagraphItemKind(in.Kind.String()),
+			Name:            in.Name,
+			Slug:            in.Slug.Ptr(),
+			ThreadId:        opt.Map(in.ThreadID, func(id xid.ID) string { return id.String() }).Ptr(),
+			Author:          serialiseProfileReferenceFromAccount(in.Author),
+			DeletedAt:       in.DeletedAt,
+			RestorableUntil: in.RestorableUntil(retention),
+		}
+	}
+}
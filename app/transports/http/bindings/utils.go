@@ -32,6 +32,7 @@ func serialiseAccount(acc *account.AccountWithEdges) openapi.Account {
 		Suspended:      acc.DeletedAt.Ptr(),
 		Handle:         acc.Handle,
 		Name:           acc.Name,
+		Kind:           openapi.AccountKind(acc.Kind.String()),
 		Bio:            acc.Bio.HTML(),
 		Meta:           acc.Metadata,
 		Links:          serialiseExternalLinks(acc.ExternalLinks),
@@ -41,8 +42,15 @@ func serialiseAccount(acc *account.AccountWithEdges) openapi.Account {
 		Admin:          acc.Admin,
 		VerifiedStatus: openapi.AccountVerifiedStatus(acc.VerifiedStatus.String()),
 		EmailAddresses: dt.Map(acc.EmailAddresses, serialiseEmailAddressPtr),
+		Verification:   serialiseVerificationBadge(acc.Verification),
 		Roles:          serialiseHeldRoleList(acc.Roles),
 		InvitedBy:      invitedBy.Ptr(),
+		Locale:         acc.Locale.Ptr(),
+		Timezone:       acc.Timezone.Ptr(),
+		Privacy: &openapi.AccountPrivacy{
+			ReadingHistory: &acc.Privacy.ReadingHistory,
+			HiddenActivity: opt.New(serialiseActivityKindList(acc.Privacy.HiddenActivity)).Ptr(),
+		},
 	}
 }
 
@@ -79,9 +87,13 @@ func serialiseThreadReference(t *thread.Thread) openapi.ThreadReference {
 		Slug:        t.Slug,
 		Description: &t.Short,
 		Body:        t.Content.HTML(),
+		BodyLinks:   serialiseLinkRefs(t.ContentLinks),
 		Meta:        (*openapi.Metadata)(&t.Meta),
 		LastReplyAt: t.LastReplyAt.Ptr(),
 
+		AcceptedReplyId: opt.PtrMap(t.AcceptedReplyID, serialisePostID),
+		SpaceId:         opt.PtrMap(t.SpaceID, serialiseXID),
+
 		Category:    opt.Map(t.Category, serialiseCategoryReference).Ptr(),
 		Visibility:  serialiseVisibility(t.Visibility),
 		Pinned:      t.Pinned,
@@ -93,6 +105,7 @@ func serialiseThreadReference(t *thread.Thread) openapi.ThreadReference {
 		Assets:      dt.Map(t.Assets, serialiseAssetPtr),
 		Collections: serialiseCollectionStatus(t.Collections),
 		Link:        opt.Map(t.WebLink, serialiseLinkRef).Ptr(),
+		Summary:     t.Summary.Ptr(),
 	}
 }
 
@@ -105,6 +118,7 @@ func serialiseThread(t *thread.Thread) openapi.Thread {
 		Assets:         dt.Map(t.Assets, serialiseAssetPtr),
 		Author:         serialiseProfileReference(t.Author),
 		Body:           serialiseContentHTML(t.Content),
+		BodyLinks:      serialiseLinkRefs(t.ContentLinks),
 		Category:       opt.Map(t.Category, serialiseCategoryReference).Ptr(),
 		Likes:          serialiseLikeStatus(&t.Likes),
 		Collections:    serialiseCollectionStatus(t.Collections),
@@ -126,13 +140,26 @@ func serialiseThread(t *thread.Thread) openapi.Thread {
 		Title:          t.Title,
 		UpdatedAt:      t.UpdatedAt,
 		LastReplyAt:    t.LastReplyAt.Ptr(),
+
+		AcceptedReplyId: opt.PtrMap(t.AcceptedReplyID, serialisePostID),
+		SpaceId:         opt.PtrMap(t.SpaceID, serialiseXID),
+		Summary:         t.Summary.Ptr(),
 	}
 }
 
+func serialiseXID(id xid.ID) openapi.Identifier {
+	return openapi.Identifier(id.String())
+}
+
+func serialisePostID(id post.ID) openapi.Identifier {
+	return openapi.Identifier(id.String())
+}
+
 func serialiseThreadRepliesPaginatedList(in pagination.Result[*reply.Reply]) openapi.PaginatedReplyList {
 	return openapi.PaginatedReplyList{
 		CurrentPage: in.CurrentPage,
 		NextPage:    in.NextPage.Ptr(),
+		NextCursor:  serialiseCursor(in.NextCursor),
 		PageSize:    in.Size,
 		Results:     in.Results,
 		Replies:     dt.Map(in.Items, serialiseReply),
@@ -163,6 +190,7 @@ func serialiseReply(p *reply.Reply) openapi.Reply {
 		RootId:    p.RootPostID.String(),
 		RootSlug:  p.Slug,
 		Body:      p.Content.HTML(),
+		BodyLinks: serialiseLinkRefs(p.ContentLinks),
 		Author:    serialiseProfileReference(p.Author),
 		Likes:     serialiseLikeStatus(&p.Likes),
 		Reacts:    dt.Map(p.Reacts, serialiseReact),
@@ -182,6 +210,7 @@ func serialisePost(p *post.Post) openapi.Post {
 		Description: &description,
 		Slug:        p.Slug,
 		Body:        p.Content.HTML(),
+		BodyLinks:   serialiseLinkRefs(p.ContentLinks),
 		Author:      serialiseProfileReference(p.Author),
 		Assets:      dt.Map(p.Assets, serialiseAssetPtr),
 		Collections: openapi.CollectionStatus{},
@@ -264,3 +293,26 @@ func deserialisePageParams(p *string, pageSize uint) pagination.Parameters {
 
 	return pagination.NewPageParams(pageNumber, pageSize)
 }
+
+func deserialiseCursor(c *string) (opt.Optional[pagination.Cursor], error) {
+	return opt.MapErr(opt.NewPtr(c), pagination.ParseCursor)
+}
+
+// deserialiseCursorParams prefers the cursor over the page number when a client
+// provides both so that clients may switch to cursors without dropping page.
+func deserialiseCursorParams(p *string, c *string, pageSize uint) (pagination.Parameters, error) {
+	cursor, err := deserialiseCursor(c)
+	if err != nil {
+		return pagination.Parameters{}, err
+	}
+
+	if cursor.Ok() {
+		return pagination.NewCursorParams(cursor, pageSize), nil
+	}
+
+	return deserialisePageParams(p, pageSize), nil
+}
+
+func serialiseCursor(c opt.Optional[pagination.Cursor]) *string {
+	return opt.PtrMap(c, pagination.Cursor.String)
+}
@@ -0,0 +1,177 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/verification"
+	"github.com/Southclaws/storyden/app/services/account/verification_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Verifications struct {
+	accountQuery        *account_querier.Querier
+	verificationManager *verification_manager.Manager
+}
+
+func NewVerifications(
+	accountQuery *account_querier.Querier,
+	verificationManager *verification_manager.Manager,
+) Verifications {
+	return Verifications{
+		accountQuery:        accountQuery,
+		verificationManager: verificationManager,
+	}
+}
+
+func (h Verifications) AccountVerificationRequestGet(ctx context.Context, request openapi.AccountVerificationRequestGetRequestObject) (openapi.AccountVerificationRequestGetResponseObject, error) {
+	req, err := h.verificationManager.Own(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountVerificationRequestGet200JSONResponse{
+		VerificationRequestOKJSONResponse: openapi.VerificationRequestOKJSONResponse(serialiseVerificationRequest(req)),
+	}, nil
+}
+
+func (h Verifications) AccountVerificationRequestCreate(ctx context.Context, request openapi.AccountVerificationRequestCreateRequestObject) (openapi.AccountVerificationRequestCreateResponseObject, error) {
+	badge, err := deserialiseVerificationBadge(request.Body.Badge)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := h.verificationManager.Request(ctx, badge, opt.NewPtr(request.Body.Note))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountVerificationRequestCreate200JSONResponse{
+		VerificationRequestOKJSONResponse: openapi.VerificationRequestOKJSONResponse(serialiseVerificationRequest(req)),
+	}, nil
+}
+
+func (h Verifications) AdminVerificationRequestList(ctx context.Context, request openapi.AdminVerificationRequestListRequestObject) (openapi.AdminVerificationRequestListResponseObject, error) {
+	status, err := opt.MapErr(opt.NewPtr(request.Params.Status), func(s openapi.VerificationRequestStatus) (verification.Status, error) {
+		return verification.NewStatus(string(s))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	reqs, err := h.verificationManager.List(ctx, status)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminVerificationRequestList200JSONResponse{
+		AdminVerificationRequestListOKJSONResponse: openapi.AdminVerificationRequestListOKJSONResponse{
+			Requests: dt.Map(reqs, serialiseVerificationRequest),
+		},
+	}, nil
+}
+
+func (h Verifications) AdminVerificationRequestApprove(ctx context.Context, request openapi.AdminVerificationRequestApproveRequestObject) (openapi.AdminVerificationRequestApproveResponseObject, error) {
+	req, err := h.verificationManager.Approve(ctx, verification.RequestID(deserialiseID(request.VerificationRequestId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminVerificationRequestApprove200JSONResponse{
+		VerificationRequestOKJSONResponse: openapi.VerificationRequestOKJSONResponse(serialiseVerificationRequest(req)),
+	}, nil
+}
+
+func (h Verifications) AdminVerificationRequestReject(ctx context.Context, request openapi.AdminVerificationRequestRejectRequestObject) (openapi.AdminVerificationRequestRejectResponseObject, error) {
+	req, err := h.verificationManager.Reject(ctx, verification.RequestID(deserialiseID(request.VerificationRequestId)), opt.NewPtr(request.Body.Reason))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminVerificationRequestReject200JSONResponse{
+		VerificationRequestOKJSONResponse: openapi.VerificationRequestOKJSONResponse(serialiseVerificationRequest(req)),
+	}, nil
+}
+
+func (h Verifications) AccountVerificationSet(ctx context.Context, request openapi.AccountVerificationSetRequestObject) (openapi.AccountVerificationSetResponseObject, error) {
+	badge, err := deserialiseVerificationBadge(request.Body.Badge)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := h.set(ctx, request.AccountHandle, opt.New(badge))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountVerificationSet200JSONResponse{
+		AccountUpdateOKJSONResponse: openapi.AccountUpdateOKJSONResponse(serialiseAccount(acc)),
+	}, nil
+}
+
+func (h Verifications) AccountVerificationRemove(ctx context.Context, request openapi.AccountVerificationRemoveRequestObject) (openapi.AccountVerificationRemoveResponseObject, error) {
+	acc, err := h.set(ctx, request.AccountHandle, opt.NewEmpty[account.Verification]())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountVerificationRemove200JSONResponse{
+		AccountUpdateOKJSONResponse: openapi.AccountUpdateOKJSONResponse(serialiseAccount(acc)),
+	}, nil
+}
+
+func (h Verifications) set(ctx context.Context, handle string, badge opt.Optional[account.Verification]) (*account.AccountWithEdges, error) {
+	acc, found, err := h.accountQuery.LookupByHandle(ctx, handle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !found {
+		return nil, fault.New("account not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	if err := h.verificationManager.Set(ctx, acc.ID, badge); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err = h.accountQuery.GetByID(ctx, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return acc, nil
+}
+
+func deserialiseVerificationBadge(in openapi.VerificationBadge) (account.Verification, error) {
+	v, err := account.NewVerification(string(in))
+	if err != nil {
+		return account.Verification{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	return v, nil
+}
+
+func serialiseVerificationBadge(in opt.Optional[account.Verification]) *openapi.VerificationBadge {
+	return opt.Map(in, func(v account.Verification) openapi.VerificationBadge {
+		return openapi.VerificationBadge(v.String())
+	}).Ptr()
+}
+
+func serialiseVerificationRequest(in *verification.Request) openapi.VerificationRequest {
+	return openapi.VerificationRequest{
+		Id:         in.ID.String(),
+		CreatedAt:  in.CreatedAt,
+		Account:    serialiseProfileReferenceFromAccount(in.Account),
+		Badge:      openapi.VerificationBadge(in.Badge.String()),
+		Note:       in.Note.Ptr(),
+		Status:     openapi.VerificationRequestStatus(in.Status.String()),
+		ReviewedAt: in.ReviewedAt.Ptr(),
+		Reason:     in.Reason.Ptr(),
+	}
+}
@@ -0,0 +1,154 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/resources/warning"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/moderation/warning_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Warnings struct {
+	profileQuery   *profile_querier.Querier
+	warningManager *warning_manager.Manager
+}
+
+func NewWarnings(
+	profileQuery *profile_querier.Querier,
+	warningManager *warning_manager.Manager,
+) Warnings {
+	return Warnings{
+		profileQuery:   profileQuery,
+		warningManager: warningManager,
+	}
+}
+
+func (h Warnings) AccountWarningsGet(ctx context.Context, request openapi.AccountWarningsGetRequestObject) (openapi.AccountWarningsGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	standing, err := h.warningManager.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Members see why they were warned but not which moderator warned them.
+	for _, w := range standing.Warnings {
+		w.IssuedBy = opt.NewEmpty[account.Account]()
+	}
+
+	return openapi.AccountWarningsGet200JSONResponse{
+		WarningStandingOKJSONResponse: openapi.WarningStandingOKJSONResponse(serialiseWarningStanding(standing)),
+	}, nil
+}
+
+func (h Warnings) ModerationWarningList(ctx context.Context, request openapi.ModerationWarningListRequestObject) (openapi.ModerationWarningListResponseObject, error) {
+	id, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	standing, err := h.warningManager.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationWarningList200JSONResponse{
+		WarningStandingOKJSONResponse: openapi.WarningStandingOKJSONResponse(serialiseWarningStanding(standing)),
+	}, nil
+}
+
+func (h Warnings) ModerationWarningIssue(ctx context.Context, request openapi.ModerationWarningIssueRequestObject) (openapi.ModerationWarningIssueResponseObject, error) {
+	id, err := openapi.ResolveHandle(ctx, h.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	severity, err := warning.NewSeverity(string(request.Body.Severity))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	w, err := h.warningManager.Issue(ctx, id, severity, request.Body.Reason, opt.NewPtr(request.Body.ExpiresAt))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationWarningIssue200JSONResponse{
+		WarningOKJSONResponse: openapi.WarningOKJSONResponse(serialiseWarning(w, time.Now())),
+	}, nil
+}
+
+func (h Warnings) ModerationWarningRevoke(ctx context.Context, request openapi.ModerationWarningRevokeRequestObject) (openapi.ModerationWarningRevokeResponseObject, error) {
+	w, err := h.warningManager.Revoke(ctx, warning.WarningID(deserialiseID(request.WarningId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ModerationWarningRevoke200JSONResponse{
+		WarningOKJSONResponse: openapi.WarningOKJSONResponse(serialiseWarning(w, time.Now())),
+	}, nil
+}
+
+func serialiseWarningStanding(in *warning_manager.Standing) openapi.WarningStanding {
+	now := time.Now()
+
+	return openapi.WarningStanding{
+		Warnings:     dt.Map(in.Warnings, func(w *warning.Warning) openapi.Warning { return serialiseWarning(w, now) }),
+		Points:       in.Points,
+		Restrictions: dt.Map(in.Restrictions, serialisePermission),
+	}
+}
+
+func serialiseWarning(in *warning.Warning, now time.Time) openapi.Warning {
+	return openapi.Warning{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		Severity:  openapi.WarningSeverity(in.Severity.String()),
+		Reason:    in.Reason,
+		Points:    in.Severity.Points(),
+		Active:    in.Active(now),
+		ExpiresAt: in.ExpiresAt.Ptr(),
+		RevokedAt: in.RevokedAt.Ptr(),
+		IssuedBy:  opt.Map(in.IssuedBy, serialiseProfileReferenceFromAccount).Ptr(),
+	}
+}
+
+func serialiseWarningSettings(in warning.Settings) openapi.WarningSettings {
+	return openapi.WarningSettings{
+		Thresholds: dt.Map(in.Thresholds, func(t warning.Threshold) openapi.WarningThreshold {
+			return openapi.WarningThreshold{
+				Permission: serialisePermission(t.Permission),
+				Points:     t.Points,
+			}
+		}),
+	}
+}
+
+func deserialiseWarningSettings(in openapi.WarningSettings) (warning.Settings, error) {
+	thresholds, err := dt.MapErr(in.Thresholds, func(t openapi.WarningThreshold) (warning.Threshold, error) {
+		perm, err := deserialisePermission(t.Permission)
+		if err != nil {
+			return warning.Threshold{}, err
+		}
+
+		return warning.Threshold{Permission: perm, Points: t.Points}, nil
+	})
+	if err != nil {
+		return warning.Settings{}, err
+	}
+
+	return warning.Settings{Thresholds: thresholds}, nil
+}
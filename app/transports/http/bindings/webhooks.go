@@ -0,0 +1,184 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Webhooks struct {
+	webhookManager *webhook_manager.Manager
+}
+
+func NewWebhooks(
+	webhookManager *webhook_manager.Manager,
+) Webhooks {
+	return Webhooks{
+		webhookManager: webhookManager,
+	}
+}
+
+func (h Webhooks) AdminWebhookList(ctx context.Context, request openapi.AdminWebhookListRequestObject) (openapi.AdminWebhookListResponseObject, error) {
+	webhooks, err := h.webhookManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWebhookList200JSONResponse{
+		AdminWebhookListOKJSONResponse: openapi.AdminWebhookListOKJSONResponse{
+			Webhooks: dt.Map(webhooks, serialiseWebhook),
+		},
+	}, nil
+}
+
+func (h Webhooks) AdminWebhookCreate(ctx context.Context, request openapi.AdminWebhookCreateRequestObject) (openapi.AdminWebhookCreateResponseObject, error) {
+	events, err := deserialiseWebhookEvents(request.Body.Events)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	w, err := h.webhookManager.Create(ctx, request.Body.Name, request.Body.Url, events, opt.NewPtr(request.Body.Enabled))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWebhookCreate200JSONResponse{
+		AdminWebhookSecretOKJSONResponse: openapi.AdminWebhookSecretOKJSONResponse(serialiseWebhookWithSecret(w, true)),
+	}, nil
+}
+
+func (h Webhooks) AdminWebhookGet(ctx context.Context, request openapi.AdminWebhookGetRequestObject) (openapi.AdminWebhookGetResponseObject, error) {
+	w, err := h.webhookManager.Get(ctx, webhook.WebhookID(deserialiseID(request.WebhookId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWebhookGet200JSONResponse{
+		AdminWebhookOKJSONResponse: openapi.AdminWebhookOKJSONResponse(serialiseWebhook(w)),
+	}, nil
+}
+
+func (h Webhooks) AdminWebhookUpdate(ctx context.Context, request openapi.AdminWebhookUpdateRequestObject) (openapi.AdminWebhookUpdateResponseObject, error) {
+	events, err := opt.MapErr(opt.NewPtr(request.Body.Events), deserialiseWebhookEvents)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	rotate := opt.NewPtr(request.Body.RotateSecret).OrZero()
+
+	w, err := h.webhookManager.Update(ctx, webhook.WebhookID(deserialiseID(request.WebhookId)), webhook_manager.Partial{
+		Name:         opt.NewPtr(request.Body.Name),
+		URL:          opt.NewPtr(request.Body.Url),
+		Events:       events,
+		Enabled:      opt.NewPtr(request.Body.Enabled),
+		RotateSecret: rotate,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWebhookUpdate200JSONResponse{
+		AdminWebhookSecretOKJSONResponse: openapi.AdminWebhookSecretOKJSONResponse(serialiseWebhookWithSecret(w, rotate)),
+	}, nil
+}
+
+func (h Webhooks) AdminWebhookDelete(ctx context.Context, request openapi.AdminWebhookDeleteRequestObject) (openapi.AdminWebhookDeleteResponseObject, error) {
+	err := h.webhookManager.Delete(ctx, webhook.WebhookID(deserialiseID(request.WebhookId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWebhookDelete204Response{}, nil
+}
+
+func (h Webhooks) AdminWebhookDeliveryList(ctx context.Context, request openapi.AdminWebhookDeliveryListRequestObject) (openapi.AdminWebhookDeliveryListResponseObject, error) {
+	deliveries, err := h.webhookManager.ListDeliveries(ctx, webhook.WebhookID(deserialiseID(request.WebhookId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWebhookDeliveryList200JSONResponse{
+		AdminWebhookDeliveryListOKJSONResponse: openapi.AdminWebhookDeliveryListOKJSONResponse{
+			Deliveries: dt.Map(deliveries, serialiseWebhookDelivery),
+		},
+	}, nil
+}
+
+func (h Webhooks) AdminWebhookDeliveryRedeliver(ctx context.Context, request openapi.AdminWebhookDeliveryRedeliverRequestObject) (openapi.AdminWebhookDeliveryRedeliverResponseObject, error) {
+	d, err := h.webhookManager.Redeliver(ctx,
+		webhook.WebhookID(deserialiseID(request.WebhookId)),
+		webhook.DeliveryID(deserialiseID(request.WebhookDeliveryId)),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWebhookDeliveryRedeliver200JSONResponse{
+		AdminWebhookDeliveryOKJSONResponse: openapi.AdminWebhookDeliveryOKJSONResponse(serialiseWebhookDelivery(d)),
+	}, nil
+}
+
+func serialiseWebhook(in *webhook.Webhook) openapi.Webhook {
+	return openapi.Webhook{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Name:      in.Name,
+		Url:       in.URL,
+		Events:    serialiseWebhookEvents(in.Events),
+		Enabled:   in.Enabled,
+	}
+}
+
+func serialiseWebhookWithSecret(in *webhook.Webhook, includeSecret bool) openapi.WebhookWithSecret {
+	out := openapi.WebhookWithSecret{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Name:      in.Name,
+		Url:       in.URL,
+		Events:    serialiseWebhookEvents(in.Events),
+		Enabled:   in.Enabled,
+	}
+
+	if includeSecret {
+		out.Secret = &in.Secret
+	}
+
+	return out
+}
+
+func serialiseWebhookEvents(in []webhook.Event) openapi.WebhookEventList {
+	return dt.Map(in, func(e webhook.Event) openapi.WebhookEvent {
+		return openapi.WebhookEvent(e.String())
+	})
+}
+
+func deserialiseWebhookEvents(in openapi.WebhookEventList) ([]webhook.Event, error) {
+	return dt.MapErr(in, func(e openapi.WebhookEvent) (webhook.Event, error) {
+		return webhook.NewEvent(string(e))
+	})
+}
+
+func serialiseWebhookDelivery(in *webhook.Delivery) openapi.WebhookDelivery {
+	return openapi.WebhookDelivery{
+		Id:             in.ID.String(),
+		CreatedAt:      in.CreatedAt,
+		Event:          openapi.WebhookEvent(in.Event.String()),
+		Status:         openapi.WebhookDeliveryStatus(in.Status.String()),
+		Attempts:       in.Attempts,
+		Payload:        in.Payload,
+		ResponseStatus: in.ResponseStatus.Ptr(),
+		Error:          in.Error.Ptr(),
+		NextAttemptAt:  in.NextAttemptAt.Ptr(),
+		DeliveredAt:    in.DeliveredAt.Ptr(),
+	}
+}
@@ -0,0 +1,117 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/word_filter"
+	"github.com/Southclaws/storyden/app/services/moderation/word_filter_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type WordFilters struct {
+	filterManager *word_filter_manager.Manager
+}
+
+func NewWordFilters(
+	filterManager *word_filter_manager.Manager,
+) WordFilters {
+	return WordFilters{
+		filterManager: filterManager,
+	}
+}
+
+func (h WordFilters) AdminWordFilterList(ctx context.Context, request openapi.AdminWordFilterListRequestObject) (openapi.AdminWordFilterListResponseObject, error) {
+	filters, err := h.filterManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWordFilterList200JSONResponse{
+		AdminWordFilterListOKJSONResponse: openapi.AdminWordFilterListOKJSONResponse{
+			Filters: dt.Map(filters, serialiseWordFilter),
+		},
+	}, nil
+}
+
+func (h WordFilters) AdminWordFilterCreate(ctx context.Context, request openapi.AdminWordFilterCreateRequestObject) (openapi.AdminWordFilterCreateResponseObject, error) {
+	action, err := word_filter.NewAction(string(request.Body.Action))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	scope, err := word_filter.NewScope(string(request.Body.Scope))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	f, err := h.filterManager.Create(ctx, request.Body.Term, action, scope, word_filter_manager.Partial{
+		Replacement: opt.NewPtr(request.Body.Replacement),
+		Enabled:     opt.NewPtr(request.Body.Enabled),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWordFilterCreate200JSONResponse{
+		AdminWordFilterOKJSONResponse: openapi.AdminWordFilterOKJSONResponse(serialiseWordFilter(f)),
+	}, nil
+}
+
+func (h WordFilters) AdminWordFilterUpdate(ctx context.Context, request openapi.AdminWordFilterUpdateRequestObject) (openapi.AdminWordFilterUpdateResponseObject, error) {
+	action, err := opt.MapErr(opt.NewPtr(request.Body.Action), func(a openapi.WordFilterAction) (word_filter.Action, error) {
+		return word_filter.NewAction(string(a))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	scope, err := opt.MapErr(opt.NewPtr(request.Body.Scope), func(s openapi.WordFilterScope) (word_filter.Scope, error) {
+		return word_filter.NewScope(string(s))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	f, err := h.filterManager.Update(ctx, word_filter.FilterID(deserialiseID(request.WordFilterId)), word_filter_manager.Partial{
+		Term:        opt.NewPtr(request.Body.Term),
+		Action:      action,
+		Replacement: opt.NewPtr(request.Body.Replacement),
+		Scope:       scope,
+		Enabled:     opt.NewPtr(request.Body.Enabled),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWordFilterUpdate200JSONResponse{
+		AdminWordFilterOKJSONResponse: openapi.AdminWordFilterOKJSONResponse(serialiseWordFilter(f)),
+	}, nil
+}
+
+func (h WordFilters) AdminWordFilterDelete(ctx context.Context, request openapi.AdminWordFilterDeleteRequestObject) (openapi.AdminWordFilterDeleteResponseObject, error) {
+	err := h.filterManager.Delete(ctx, word_filter.FilterID(deserialiseID(request.WordFilterId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminWordFilterDelete204Response{}, nil
+}
+
+func serialiseWordFilter(in *word_filter.Filter) openapi.WordFilter {
+	return openapi.WordFilter{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
+		Term:        in.Term,
+		Action:      openapi.WordFilterAction(in.Action.String()),
+		Replacement: in.Replacement.Ptr(),
+		Scope:       openapi.WordFilterScope(in.Scope.String()),
+		Enabled:     in.Enabled,
+	}
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Southclaws/storyden/app/services/system/health"
+)
+
+type healthDependency struct {
+	Name       string        `json:"name"`
+	Status     health.Status `json:"status"`
+	Critical   bool          `json:"critical"`
+	DurationMS int64         `json:"duration_ms"`
+}
+
+type healthResponse struct {
+	Status       health.Status      `json:"status"`
+	Ready        bool               `json:"ready"`
+	Draining     bool               `json:"draining"`
+	Dependencies []healthDependency `json:"dependencies"`
+}
+
+// healthHandler reports the status of each dependency. Liveness always
+// responds OK while the process can serve requests, a database outage should
+// take an instance out of rotation via readiness, not restart it. Readiness
+// fails when a critical dependency is down or the instance is shutting down.
+func healthHandler(hc *health.Checker, readiness bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := hc.Check(r.Context())
+
+		res := healthResponse{
+			Status:       report.Status,
+			Ready:        report.Ready(),
+			Draining:     report.Draining,
+			Dependencies: make([]healthDependency, 0, len(report.Dependencies)),
+		}
+		for _, d := range report.Dependencies {
+			res.Dependencies = append(res.Dependencies, healthDependency{
+				Name:       d.Name,
+				Status:     d.Status,
+				Critical:   d.Critical,
+				DurationMS: d.Duration.Milliseconds(),
+			})
+		}
+
+		status := http.StatusOK
+		if readiness && !res.Ready {
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(status)
+
+		if r.Method != http.MethodHead {
+			_ = json.NewEncoder(w).Encode(res)
+		}
+	}
+}
@@ -7,8 +7,11 @@
 package http
 
 import (
+	"net/http"
+
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/services/tenancy"
 	"github.com/Southclaws/storyden/app/transports/http/bindings"
 	"github.com/Southclaws/storyden/app/transports/http/middleware"
 	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
@@ -26,6 +29,25 @@ func Build() fx.Option {
 		// Binds all the generated spec code for services to the *http.ServeMux.
 		bindings.Build(),
 
+		// Requests for tenants' hostnames are routed to the tenant, the rest
+		// are served by the router above.
+		fx.Provide(newHandler),
+
+		fx.Invoke(MountOpenAPI),
+	)
+}
+
+func newHandler(mux *http.ServeMux, tm *tenancy.Manager) http.Handler {
+	return tm.Route(mux)
+}
+
+// BuildTenant is the same as Build but without a server, a tenant's requests
+// are routed to its router by the primary community's server.
+func BuildTenant() fx.Option {
+	return fx.Options(
+		fx.Provide(httpserver.NewRouter),
+		middleware.Build(),
+		bindings.Build(),
 		fx.Invoke(MountOpenAPI),
 	)
 }
@@ -0,0 +1,134 @@
+package etag
+
+import (
+	"bytes"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/Southclaws/storyden/app/resources/cachecontrol"
+)
+
+// Responses larger than this are streamed without an ETag rather than held in
+// memory, read endpoints are well under this so it only guards against abuse.
+const maxBufferedSize = 4 << 20
+
+type Middleware struct{}
+
+func New() *Middleware {
+	return &Middleware{}
+}
+
+// WithETag adds a strong ETag to successful JSON responses to GET requests, so
+// polling clients can revalidate with If-None-Match and receive an empty 304
+// when nothing changed. The tag is a hash of the response body which means it
+// also accounts for the parts of a response that depend on who is asking, such
+// as read states and likes. Handlers which set their own ETag are left alone.
+func (m *Middleware) WithETag() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bw := &bufferedWriter{ResponseWriter: w}
+
+			next.ServeHTTP(bw, r)
+
+			bw.finish(r)
+		})
+	}
+}
+
+type bufferedWriter struct {
+	http.ResponseWriter
+	status      int
+	buffering   bool
+	wroteHeader bool
+	buf         bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+
+	w.buffering = status == http.StatusOK &&
+		w.Header().Get("ETag") == "" &&
+		isJSON(w.Header().Get("Content-Type"))
+
+	if !w.buffering {
+		w.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if !w.buffering {
+		return w.ResponseWriter.Write(b)
+	}
+
+	w.buf.Write(b)
+
+	if w.buf.Len() > maxBufferedSize {
+		w.stopBuffering()
+	}
+
+	return len(b), nil
+}
+
+// Flush only flushes once the response is no longer being buffered, buffered
+// responses are always written out in full by finish.
+func (w *bufferedWriter) Flush() {
+	if w.buffering {
+		return
+	}
+
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *bufferedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *bufferedWriter) stopBuffering() {
+	w.buffering = false
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.buf.WriteTo(w.ResponseWriter)
+}
+
+func (w *bufferedWriter) finish(r *http.Request) {
+	if !w.buffering {
+		return
+	}
+
+	tag := cachecontrol.NewETag(w.buf.Bytes())
+	h := w.Header()
+	h.Set("ETag", tag)
+
+	if cachecontrol.ETagMatches(r.Header.Get("If-None-Match"), tag) {
+		h.Del("Content-Type")
+		h.Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.Set("Content-Length", strconv.Itoa(w.buf.Len()))
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.buf.WriteTo(w.ResponseWriter)
+}
+
+func isJSON(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mt == "application/json"
+}
@@ -0,0 +1,241 @@
+// Package idempotency allows clients to safely retry requests which create
+// resources by sending an Idempotency-Key header. The first successful response
+// for a key is stored and replayed for any retry, so a client on a flaky
+// connection which never saw the response doesn't create a duplicate.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+)
+
+const (
+	HeaderKey      = "Idempotency-Key"
+	HeaderReplayed = "Idempotency-Replayed"
+)
+
+// Matches the prefix the OpenAPI routes are mounted under.
+const apiPathPrefix = "/api"
+
+const (
+	// How long a response is kept for replay after the original request.
+	responseTTL = 24 * time.Hour
+
+	// Upper bound on how long a request holds its key, in case the instance
+	// handling it dies before it can release the lock.
+	lockTTL = time.Minute
+)
+
+type Middleware struct {
+	logger *slog.Logger
+	store  cache.Store
+	routes map[string]bool
+}
+
+func New(logger *slog.Logger, store cache.Store) (*Middleware, error) {
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("failed to get openapi specification"))
+	}
+
+	return &Middleware{
+		logger: logger,
+		store:  store,
+		routes: routes(spec),
+	}, nil
+}
+
+// routes finds the operations which list the Idempotency-Key header as a
+// parameter, keyed by method and Echo route path.
+func routes(spec *openapi3.T) map[string]bool {
+	out := map[string]bool{}
+
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			for _, p := range op.Parameters {
+				if p.Value == nil || p.Value.In != openapi3.ParameterInHeader || !strings.EqualFold(p.Value.Name, HeaderKey) {
+					continue
+				}
+
+				out[method+" "+routePath(path)] = true
+			}
+		}
+	}
+
+	return out
+}
+
+// routePath turns a spec path such as /threads/{thread_mark} into the path the
+// route is registered with, /api/threads/:thread_mark.
+func routePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			segments[i] = ":" + s[1:len(s)-1]
+		}
+	}
+	return apiPathPrefix + strings.Join(segments, "/")
+}
+
+type response struct {
+	RequestHash string `json:"request_hash"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// WithIdempotency must be applied to the Echo router as it relies on the route
+// path to decide whether the request's operation supports idempotency keys.
+func (m *Middleware) WithIdempotency() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+			ctx := r.Context()
+
+			idempotencyKey := r.Header.Get(HeaderKey)
+			if idempotencyKey == "" || !m.routes[r.Method+" "+c.Path()] {
+				return next(c)
+			}
+
+			// Keys are scoped to the account, guests can't create anything.
+			accountID, ok := session.GetOptAccountID(ctx).Get()
+			if !ok {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			sum := sha256.Sum256(body)
+			requestHash := hex.EncodeToString(sum[:])
+
+			key := strings.Join([]string{"idempotency", accountID.String(), r.URL.Path, idempotencyKey}, ":")
+			lockKey := key + ":lock"
+
+			if replayed, err := m.replay(c, key, requestHash); err != nil || replayed {
+				return err
+			}
+
+			locked, err := m.store.SetNX(ctx, lockKey, requestHash, lockTTL)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			if !locked {
+				return fault.New("idempotency key in use",
+					fctx.With(ctx),
+					ftag.With(ftag.AlreadyExists),
+					fmsg.WithDesc("in progress", "A request with the same Idempotency-Key is still being processed, retry once it has finished."),
+				)
+			}
+
+			// Lock and response writes must happen even if the client has gone.
+			bg := context.WithoutCancel(ctx)
+			defer func() {
+				if err := m.store.Delete(bg, lockKey); err != nil {
+					m.logger.Warn("failed to release idempotency key", slog.String("error", err.Error()))
+				}
+			}()
+
+			// The original request may have finished between the first check
+			// and acquiring the lock, in which case it must not be repeated.
+			if replayed, err := m.replay(c, key, requestHash); err != nil || replayed {
+				return err
+			}
+
+			rec := &recorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = rec
+
+			if err := next(c); err != nil {
+				return err
+			}
+
+			// Failed requests are not stored so they can be retried with the
+			// same key once whatever caused the failure has been resolved.
+			status := c.Response().Status
+			if status < 200 || status > 299 {
+				return nil
+			}
+
+			b, err := json.Marshal(response{
+				RequestHash: requestHash,
+				Status:      status,
+				ContentType: c.Response().Header().Get(echo.HeaderContentType),
+				Body:        rec.buf.Bytes(),
+			})
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			if err := m.store.Set(bg, key, string(b), responseTTL); err != nil {
+				m.logger.Warn("failed to store idempotent response", slog.String("error", err.Error()))
+			}
+
+			return nil
+		}
+	}
+}
+
+func (m *Middleware) replay(c echo.Context, key string, requestHash string) (bool, error) {
+	ctx := c.Request().Context()
+
+	raw, err := m.store.Get(ctx, key)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return false, nil
+		}
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var stored response
+	if err := json.Unmarshal([]byte(raw), &stored); err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if stored.RequestHash != requestHash {
+		return false, fault.Wrap(echo.NewHTTPError(http.StatusUnprocessableEntity),
+			fctx.With(ctx),
+			fmsg.WithDesc("idempotency key reused", "This Idempotency-Key was already used for a request with a different body."),
+		)
+	}
+
+	c.Response().Header().Set(HeaderReplayed, "true")
+
+	return true, c.Blob(stored.Status, stored.ContentType, stored.Body)
+}
+
+// recorder keeps a copy of the response body while passing it through.
+type recorder struct {
+	http.ResponseWriter
+	buf bytes.Buffer
+}
+
+func (w *recorder) Write(b []byte) (int, error) {
+	w.buf.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
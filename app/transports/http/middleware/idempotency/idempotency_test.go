@@ -0,0 +1,22 @@
+package idempotency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func TestRoutes(t *testing.T) {
+	r := require.New(t)
+
+	spec, err := openapi.GetSwagger()
+	r.NoError(err)
+
+	r.Equal(map[string]bool{
+		"POST /api/threads":                            true,
+		"POST /api/threads/:thread_mark/replies":       true,
+		"POST /api/assets/uploads/:upload_id/complete": true,
+	}, routes(spec))
+}
@@ -1,11 +1,20 @@
 package limiter
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Southclaws/dt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/rate_limit"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/rate"
 )
@@ -18,56 +27,101 @@ const (
 	MaxRequestSizeBytes = 10 * 1024 * 1024
 )
 
+// Standard headers from the IETF RateLimit header fields draft, the X- prefixed
+// headers above are still sent for clients which already read them.
+const (
+	StandardRateLimitLimit     = "RateLimit-Limit"
+	StandardRateLimitRemaining = "RateLimit-Remaining"
+	StandardRateLimitReset     = "RateLimit-Reset"
+	StandardRateLimitPolicy    = "RateLimit-Policy"
+)
+
 type Middleware struct {
-	rl        rate.Limiter
+	f         *rate.LimiterFactory
 	kf        KeyFunc
+	sr        *settings.SettingsRepository
 	sizeLimit int64
+	limits    map[rate_limit.Class]int
+	period    time.Duration
+	expire    time.Duration
 }
 
 func New(
 	cfg config.Config,
 
 	f *rate.LimiterFactory,
+	sr *settings.SettingsRepository,
 ) *Middleware {
-	rl := f.NewLimiter(cfg.RateLimit, cfg.RateLimitPeriod, cfg.RateLimitExpire)
+	// Signing in and uploading are far more expensive and more commonly abused
+	// than anything else, so they get a fraction of the configured limit.
+	strict := max(cfg.RateLimit/10, 1)
 
 	return &Middleware{
-		rl:        rl,
+		f:         f,
 		kf:        fromIP("CF-Connecting-IP", "X-Real-IP", "True-Client-IP"),
-		sizeLimit: MaxRequestSizeBytes, // TODO: cfg.MaxRequestSize
+		sr:        sr,
+		sizeLimit: MaxRequestSizeBytes,
+		limits: map[rate_limit.Class]int{
+			rate_limit.ClassRead:   cfg.RateLimit,
+			rate_limit.ClassWrite:  cfg.RateLimit,
+			rate_limit.ClassAuth:   strict,
+			rate_limit.ClassUpload: strict,
+		},
+		period: cfg.RateLimitPeriod,
+		expire: cfg.RateLimitExpire,
 	}
 }
 
+// WithRateLimit counts each request against a limit for the class of route it
+// belongs to. Requests are counted per access key, then per account and then
+// per IP address for guests, so members behind a shared address such as an
+// office or mobile carrier network don't use up each other's limits. Limits may
+// be adjusted for roles and access keys by administrators in the settings.
 func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 
-			key, err := m.kf(r)
+			subject, err := m.subject(r)
 			if err != nil {
 				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 				return
 			}
 
+			class := classify(r)
+
+			limit := m.limit(r, class)
+			if limit == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key := strings.Join([]string{"ratelimit", class.String(), subject}, ":")
+
 			// TODO: Generate costs per-operation from OpenAPI spec
 			cost := 1
 
-			status, allowed, err := m.rl.Increment(ctx, key, cost)
+			status, allowed, err := m.f.NewLimiter(limit, m.period, m.expire).Increment(ctx, key, cost)
 			if err != nil {
 				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 				return
 			}
 
-			limit := status.Limit
 			remaining := status.Remaining
 			resetTime := status.Reset.UTC().Format(time.RFC1123)
+			resetSeconds := strconv.Itoa(int(time.Until(status.Reset).Seconds()))
 
-			w.Header().Set(RateLimitLimit, strconv.FormatUint(uint64(limit), 10))
-			w.Header().Set(RateLimitRemaining, strconv.FormatUint(uint64(remaining), 10))
-			w.Header().Set(RateLimitReset, resetTime)
+			h := w.Header()
+			h.Set(RateLimitLimit, strconv.Itoa(limit))
+			h.Set(RateLimitRemaining, strconv.Itoa(remaining))
+			h.Set(RateLimitReset, resetTime)
+			h.Set(StandardRateLimitLimit, strconv.Itoa(limit))
+			h.Set(StandardRateLimitRemaining, strconv.Itoa(remaining))
+			h.Set(StandardRateLimitReset, resetSeconds)
+			h.Set(StandardRateLimitPolicy, fmt.Sprintf("%d;w=%d;comment=%q", limit, int(m.period.Seconds()), class.String()))
 
 			if !allowed {
-				w.Header().Set(RetryAfter, resetTime)
+				h.Set(RetryAfter, resetTime)
 				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
 				return
 			}
@@ -77,6 +131,64 @@ func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
 	}
 }
 
+// subject identifies who a request is counted against, this relies on session
+// middleware having already run so the account and access key are known.
+func (m *Middleware) subject(r *http.Request) (string, error) {
+	ctx := r.Context()
+
+	if id, ok := session.GetOptAccessKeyID(ctx).Get(); ok {
+		return "key:" + id.String(), nil
+	}
+
+	if id, ok := session.GetOptAccountID(ctx).Get(); ok {
+		return "account:" + id.String(), nil
+	}
+
+	ip, err := m.kf(r)
+	if err != nil {
+		return "", err
+	}
+
+	return "ip:" + ip, nil
+}
+
+// limit reads the limit from the instance settings on each request so changes
+// made by an administrator apply without a restart.
+func (m *Middleware) limit(r *http.Request, class rate_limit.Class) int {
+	ctx := r.Context()
+	fallback := m.limits[class]
+
+	s, err := m.sr.Get(ctx)
+	if err != nil {
+		return fallback
+	}
+
+	roles := dt.Map(session.GetOptRoles(ctx), func(r *role.Role) xid.ID { return xid.ID(r.ID) })
+
+	return s.RateLimits.OrZero().Limit(class, roles, session.GetOptAccessKeyID(ctx), fallback)
+}
+
+func classify(r *http.Request) rate_limit.Class {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return rate_limit.ClassRead
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/api/auth/"):
+		return rate_limit.ClassAuth
+
+	case strings.HasPrefix(r.URL.Path, "/api/assets"):
+		return rate_limit.ClassUpload
+
+	// The GraphQL API is read-only, queries are only sent as POST requests.
+	case r.URL.Path == "/graphql":
+		return rate_limit.ClassRead
+	}
+
+	return rate_limit.ClassWrite
+}
+
 type KeyFunc func(r *http.Request) (string, error)
 
 func fromIP(headers ...string) KeyFunc {
@@ -98,8 +210,23 @@ func fromIP(headers ...string) KeyFunc {
 func (m *Middleware) WithRequestSizeLimiter() func(http.Handler) http.Handler {
 	return func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			r.Body = http.MaxBytesReader(w, r.Body, m.sizeLimit)
+			r.Body = http.MaxBytesReader(w, r.Body, m.requestSizeLimit(r))
 			h.ServeHTTP(w, r)
 		})
 	}
 }
+
+// requestSizeLimit reads the limit from the instance settings on each request
+// so changes made by an administrator apply without a restart.
+func (m *Middleware) requestSizeLimit(r *http.Request) int64 {
+	s, err := m.sr.Get(r.Context())
+	if err != nil {
+		return m.sizeLimit
+	}
+
+	if limit := s.Limits.OrZero().MaxRequestSize; limit > 0 {
+		return limit
+	}
+
+	return m.sizeLimit
+}
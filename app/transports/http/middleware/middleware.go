@@ -4,11 +4,17 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/transports/http/middleware/chaos"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/etag"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/frontend"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/headers"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/idempotency"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/replica"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlocale"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqmetrics"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/requsage"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
 )
 
@@ -16,10 +22,16 @@ func Build() fx.Option {
 	return fx.Provide(
 		origin.New,
 		reqlog.New,
+		reqmetrics.New,
+		replica.New,
 		frontend.New,
 		headers.New,
 		session_cookie.New,
+		reqlocale.New,
+		requsage.New,
 		limiter.New,
 		chaos.New,
+		etag.New,
+		idempotency.New,
 	)
 }
@@ -35,6 +35,7 @@ func (m *Middleware) WithCORS() func(next http.Handler) http.Handler {
 		"X-CSRF-Token",
 		"X-Correlation-ID",
 		"X-Forwarded-Host",
+		"X-Storyden-Timezone",
 	}
 
 	exposedHeaders := []string{
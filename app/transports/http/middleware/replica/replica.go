@@ -0,0 +1,66 @@
+// Package replica keeps a client's reads on the primary database for a short
+// while after it writes, so reads which may otherwise be served by a lagging
+// read replica never miss the client's own changes.
+package replica
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
+)
+
+const cookieName = "storyden-primary"
+
+type Middleware struct {
+	enabled bool
+	maxLag  time.Duration
+}
+
+func New(cfg config.Config) *Middleware {
+	return &Middleware{
+		enabled: len(cfg.DatabaseReplicaURLs) > 0,
+		maxLag:  cfg.DatabaseReplicaMaxLag,
+	}
+}
+
+// WithReadYourWrites must be applied to the Echo router so the cookie can be
+// set once the handler has run but before the response is written.
+func (m *Middleware) WithReadYourWrites() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !m.enabled {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			r := c.Request()
+
+			_, err := r.Cookie(cookieName)
+			ctx, session := db.WithSession(r.Context(), err == nil)
+			c.SetRequest(r.WithContext(ctx))
+
+			c.Response().Before(func() {
+				if !session.Wrote() {
+					return
+				}
+
+				// Any replica further behind than the max lag isn't used, so
+				// once the cookie expires every usable replica has the write.
+				http.SetCookie(c.Response(), &http.Cookie{
+					Name:     cookieName,
+					Value:    "1",
+					Path:     "/",
+					MaxAge:   int(m.maxLag.Seconds()) + 1,
+					SameSite: http.SameSiteLaxMode,
+					Secure:   true,
+					HttpOnly: true,
+				})
+			})
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Package reqlocale sets the language and timezone responses are written in,
+// such as error messages, from the signed in account or the browser's hints.
+package reqlocale
+
+import (
+	"net/http"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/locale"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+)
+
+type Middleware struct {
+	resolver *locale.Resolver
+}
+
+func New(resolver *locale.Resolver) *Middleware {
+	return &Middleware{resolver: resolver}
+}
+
+// WithLocale must be applied after the session middleware so that the signed
+// in account's choice of language is known.
+func (m *Middleware) WithLocale() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			// The defaults are used if they can't be resolved, which isn't
+			// worth failing the request over.
+			ctx, _ = m.resolver.ForRequest(ctx,
+				session.GetOptAccount(ctx),
+				r.Header.Get("Accept-Language"),
+				r.Header.Get(reqinfo.TimezoneHeader),
+			)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
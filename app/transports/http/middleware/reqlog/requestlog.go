@@ -7,6 +7,9 @@ import (
 	"runtime/debug"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
 	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/kv"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/spanner"
@@ -48,7 +51,10 @@ func (m *Middleware) WithLogger() func(http.Handler) http.Handler {
 
 			wr := &withStatus{ResponseWriter: w}
 
-			ctx, span := m.ins.InstrumentNamed(r.Context(), title,
+			// Continue the trace if the request came from an instrumented client.
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			ctx, span := m.ins.InstrumentNamed(ctx, title,
 				kv.String("http.request.header.origin", origin),
 				kv.String("client.address", r.RemoteAddr),
 				kv.String("http.request.method", r.Method),
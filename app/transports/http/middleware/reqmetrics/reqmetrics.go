@@ -0,0 +1,62 @@
+// Package reqmetrics records the latency and status of API requests per route.
+package reqmetrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/metrics"
+)
+
+type Middleware struct {
+	duration metric.Float64Histogram
+}
+
+func New(meter metric.Meter) (*Middleware, error) {
+	duration, err := meter.Float64Histogram("http.server.request.duration",
+		metric.WithDescription("Duration of HTTP server requests."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(metrics.LatencyBuckets...),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Middleware{duration: duration}, nil
+}
+
+// WithMetrics must be applied to the Echo router rather than the mux so the
+// matched route template is known, keeping the number of series bounded.
+func (m *Middleware) WithMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			// Errors are written here rather than by the router so the final
+			// status code is known when recording.
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+
+			m.duration.Record(c.Request().Context(), time.Since(start).Seconds(),
+				metric.WithAttributes(
+					attribute.String("http.request.method", c.Request().Method),
+					attribute.String("http.route", route),
+					attribute.String("http.response.status_code", strconv.Itoa(c.Response().Status)),
+				),
+			)
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Package requsage counts the API requests made by each signed in account and
+// access key, along with how many of them failed.
+package requsage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Southclaws/storyden/app/services/api_usage"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+type Middleware struct {
+	recorder *api_usage.Recorder
+}
+
+func New(recorder *api_usage.Recorder) *Middleware {
+	return &Middleware{recorder: recorder}
+}
+
+type withStatus struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *withStatus) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *withStatus) WriteHeader(code int) {
+	if w.statusCode == 0 {
+		w.statusCode = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// WithUsage must be applied after the session middleware so the account and
+// access key are known, and before the rate limiter so requests which were
+// turned away are counted too. Guests aren't counted.
+func (m *Middleware) WithUsage() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			accountID, ok := session.GetOptAccountID(ctx).Get()
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			wr := &withStatus{ResponseWriter: w}
+
+			next.ServeHTTP(wr, r)
+
+			status := wr.statusCode
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			m.recorder.Record(accountID, session.GetOptAccessKeyID(ctx), time.Now(), status)
+		})
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"strings"
 	"syscall"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
@@ -17,6 +19,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/i18n"
 )
 
 // HTTPErrorHandler provides an error handler function for use with the Echo
@@ -29,7 +32,7 @@ func HTTPErrorHandler(logger *slog.Logger) func(err error, c echo.Context) {
 		errmsg := err.Error()
 		errtag, status := categorise(err)
 		errctx := fctx.Unwrap(err)
-		message := fmsg.GetIssue(err)
+		message := Issue(c.Request().Context(), err)
 		chain := fault.Flatten(err)
 
 		if status == http.StatusInternalServerError {
@@ -56,6 +59,23 @@ func HTTPErrorHandler(logger *slog.Logger) func(err error, c echo.Context) {
 	}
 }
 
+// Issue is the error's user-facing description, translated into the language
+// set on ctx. Each description is translated on its own as they're joined.
+func Issue(ctx context.Context, err error) string {
+	issues := dt.Map(fmsg.GetIssues(err), func(s fmsg.Issue) string {
+		return i18n.T(ctx, s)
+	})
+
+	return strings.Join(issues, " ")
+}
+
+// ErrorStatus is the HTTP status code which the error handler would respond
+// with for the given error.
+func ErrorStatus(err error) int {
+	_, status := categorise(err)
+	return status
+}
+
 func categorise(err error) (ftag.Kind, int) {
 	errtag := ftag.Get(err)
 	status := statusFromErrorKind(errtag)
@@ -6,12 +6,16 @@ import (
 	"github.com/labstack/echo/v4"
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/services/system/health"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/chaos"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/etag"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/frontend"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/headers"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlocale"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/requsage"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
@@ -32,8 +36,13 @@ func MountOpenAPI(
 	fe *frontend.Provider,
 	ri *headers.Middleware,
 	cj *session_cookie.Jar,
+	lr *reqlocale.Middleware,
+	us *requsage.Middleware,
 	rl *limiter.Middleware,
 	cm *chaos.Middleware,
+	et *etag.Middleware,
+
+	hc *health.Checker,
 ) {
 	lc.Append(fx.StartHook(func() {
 		applied := httpserver.Apply(router,
@@ -42,18 +51,23 @@ func MountOpenAPI(
 			fe.WithFrontendProxy(),
 			ri.WithHeaderContext(),
 			cj.WithAuth(),
+			lr.WithLocale(),
+			us.WithUsage(),
 			rl.WithRequestSizeLimiter(),
 			rl.WithRateLimit(),
 			cm.WithChaos(),
+			et.WithETag(),
 		)
 
-		// Health check endpoint does not need any middleware, mounted directly.
-		mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusOK)
-		})
+		// Health check endpoints do not need any middleware, mounted directly.
+		mux.HandleFunc("/healthz", healthHandler(hc, false))
+		mux.HandleFunc("/readyz", healthHandler(hc, true))
 
 		// Mounting the Echo router must happen after all Echo's middleware and
 		// routes have been set up so it's done inside the start lifecycle hook.
 		mux.Handle("/", applied)
 	}))
+
+	// Stop hooks run in reverse so this runs before dependencies shut down.
+	lc.Append(fx.StopHook(hc.Drain))
 }
@@ -0,0 +1,107 @@
+// Package inbound_email receives mail forwarded by an email provider, such as
+// with SendGrid's Inbound Parse webhook, for replying to threads by email.
+package inbound_email
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/reply/reply_email"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
+)
+
+// Providers include attachments, which are ignored, so allow for a few.
+const (
+	maxInboundSize   = 32 << 20
+	maxInboundMemory = 1 << 20
+)
+
+func Build() fx.Option {
+	return fx.Invoke(MountInboundEmail)
+}
+
+type server struct {
+	logger  *slog.Logger
+	secret  string
+	mailbox *reply_email.Mailbox
+}
+
+func MountInboundEmail(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	cfg config.Config,
+	mailbox *reply_email.Mailbox,
+	mux *http.ServeMux,
+	lo *reqlog.Middleware,
+) {
+	if !mailbox.Enabled() {
+		return
+	}
+
+	s := &server{
+		logger:  logger,
+		secret:  cfg.EmailInboundSecret,
+		mailbox: mailbox,
+	}
+
+	// Senders are easily forged, so without the secret anyone who has seen a
+	// reply address could post as its member. Nothing is accepted until set.
+	if s.secret == "" {
+		logger.Error("EMAIL_INBOUND_SECRET is not set, all inbound email will be rejected")
+	}
+
+	lc.Append(fx.StartHook(func() error {
+		routes := http.NewServeMux()
+
+		routes.HandleFunc("POST /email/inbound", s.receive)
+
+		mux.Handle("/email/inbound", httpserver.Apply(routes,
+			lo.WithLogger(),
+		))
+
+		return nil
+	}))
+}
+
+// receive accepts the form fields posted by SendGrid's Inbound Parse webhook.
+// Mail which can never be posted is acknowledged anyway, as providers retry
+// anything else for days.
+func (s *server) receive(w http.ResponseWriter, r *http.Request) {
+	if s.secret == "" || subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("secret")), []byte(s.secret)) != 1 {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxInboundSize)
+	if err := r.ParseMultipartForm(maxInboundMemory); err != nil && err != http.ErrNotMultipart {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if r.MultipartForm != nil {
+		defer r.MultipartForm.RemoveAll()
+	}
+
+	_, err := s.mailbox.Receive(r.Context(), reply_email.Inbound{
+		To:   r.Form["to"],
+		From: r.FormValue("from"),
+		Text: r.FormValue("text"),
+	})
+	if err != nil {
+		switch ftag.Get(err) {
+		case ftag.InvalidArgument, ftag.PermissionDenied, ftag.NotFound:
+			s.logger.Warn("rejected inbound email", slog.String("error", err.Error()))
+		default:
+			s.logger.Error("failed to receive inbound email", slog.String("error", err.Error()))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
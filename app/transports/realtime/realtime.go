@@ -0,0 +1,182 @@
+// Package realtime serves the WebSocket endpoint which streams live events from
+// the realtime gateway to browsers and other clients.
+package realtime
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"go.uber.org/fx"
+
+	realtime_service "github.com/Southclaws/storyden/app/services/realtime"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
+)
+
+const (
+	pingInterval = 30 * time.Second
+	writeTimeout = 10 * time.Second
+
+	// Clients only send small commands, anything larger is a misbehaving client.
+	readLimit = 4096
+)
+
+func Build() fx.Option {
+	return fx.Invoke(MountRealtime)
+}
+
+func MountRealtime(
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	cfg config.Config,
+	gateway *realtime_service.Gateway,
+	mux *http.ServeMux,
+
+	// NOTE: Duplicated from the OpenAPI router, see the MCP transport. Request
+	// logging is left out as its response writer can't be hijacked.
+	co *origin.Middleware,
+	cj *session_cookie.Jar,
+	rl *limiter.Middleware,
+) {
+	lc.Append(fx.StartHook(func() {
+		h := &handler{
+			logger:  logger,
+			gateway: gateway,
+			origins: []string{cfg.PublicWebAddress.Host},
+		}
+
+		mux.Handle("/realtime", httpserver.Apply(h,
+			co.WithCORS(),
+			cj.WithAuth(),
+			rl.WithRateLimit(),
+		))
+	}))
+}
+
+type handler struct {
+	logger  *slog.Logger
+	gateway *realtime_service.Gateway
+	origins []string
+}
+
+type command struct {
+	Type   string `json:"type"`
+	Thread string `json:"thread"`
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		// Cookies are SameSite=Lax so other sites can't connect as a member,
+		// but the origin is still checked as browsers don't apply CORS here.
+		OriginPatterns: h.origins,
+	})
+	if err != nil {
+		return
+	}
+	defer conn.CloseNow()
+
+	conn.SetReadLimit(readLimit)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	c := h.gateway.Connect(ctx)
+	defer c.Close()
+
+	go func() {
+		defer cancel()
+		h.read(ctx, conn, c)
+	}()
+
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			conn.Close(websocket.StatusNormalClosure, "")
+			return
+
+		case e := <-c.Events():
+			if err := write(ctx, conn, e); err != nil {
+				return
+			}
+
+		case <-ping.C:
+			pctx, pcancel := context.WithTimeout(ctx, writeTimeout)
+			err := conn.Ping(pctx)
+			pcancel()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (h *handler) read(ctx context.Context, conn *websocket.Conn, c *realtime_service.Connection) {
+	for {
+		var cmd command
+		if err := wsjson.Read(ctx, conn, &cmd); err != nil {
+			var ce websocket.CloseError
+			if !errors.As(err, &ce) && ctx.Err() == nil {
+				h.logger.Debug("realtime connection read failed", slog.String("error", err.Error()))
+			}
+			return
+		}
+
+		var reply realtime_service.Event
+
+		switch cmd.Type {
+		case "watch":
+			id, err := c.Watch(cmd.Thread)
+			if err != nil {
+				reply = errorEvent(cmd.Thread, err)
+				break
+			}
+			reply = realtime_service.Event{Type: realtime_service.EventTypeWatching, ThreadID: id.String()}
+
+		case "unwatch":
+			if err := c.Unwatch(cmd.Thread); err != nil {
+				reply = errorEvent(cmd.Thread, err)
+				break
+			}
+			continue
+
+		default:
+			reply = realtime_service.Event{Type: realtime_service.EventTypeError, Error: "unknown command type"}
+		}
+
+		if err := write(ctx, conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func write(ctx context.Context, conn *websocket.Conn, e realtime_service.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+
+	return wsjson.Write(ctx, conn, e)
+}
+
+func errorEvent(thread string, err error) realtime_service.Event {
+	msg := fmsg.GetIssue(err)
+	if msg == "" {
+		msg = "The thread doesn't exist or you can't see it."
+	}
+
+	return realtime_service.Event{
+		Type:     realtime_service.EventTypeError,
+		ThreadID: thread,
+		Error:    msg,
+	}
+}
@@ -0,0 +1,62 @@
+// Package telegram receives the updates Telegram pushes to the bot's webhook.
+package telegram
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/telegram_bot"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
+	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
+)
+
+// Updates only carry a single message, anything larger isn't from Telegram.
+const maxUpdateSize = 1 << 20
+
+func Build() fx.Option {
+	return fx.Invoke(MountWebhook)
+}
+
+func MountWebhook(
+	lc fx.Lifecycle,
+	bot *telegram_bot.Bot,
+	mux *http.ServeMux,
+	lo *reqlog.Middleware,
+) {
+	if !bot.Enabled() {
+		return
+	}
+
+	lc.Append(fx.StartHook(func() error {
+		mux.Handle("POST /telegram/webhook", httpserver.Apply(webhook(bot),
+			lo.WithLogger(),
+		))
+
+		return nil
+	}))
+}
+
+func webhook(bot *telegram_bot.Bot) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(bot.Secret())) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var u telegram_bot.Update
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxUpdateSize)).Decode(&u); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// Telegram waits for the response before delivering the next update in
+		// the chat, which keeps a member's replies in order.
+		bot.HandleUpdate(r.Context(), u)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
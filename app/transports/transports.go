@@ -6,13 +6,39 @@ package transports
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/transports/appservice"
+	"github.com/Southclaws/storyden/app/transports/fediverse"
+	"github.com/Southclaws/storyden/app/transports/graphql"
 	"github.com/Southclaws/storyden/app/transports/http"
+	"github.com/Southclaws/storyden/app/transports/inbound_email"
 	"github.com/Southclaws/storyden/app/transports/mcp"
+	"github.com/Southclaws/storyden/app/transports/realtime"
+	"github.com/Southclaws/storyden/app/transports/telegram"
 )
 
 func Build() fx.Option {
 	return fx.Options(
 		http.Build(),
 		mcp.Build(),
+		graphql.Build(),
+		realtime.Build(),
+		fediverse.Build(),
+		inbound_email.Build(),
+		appservice.Build(),
+		telegram.Build(),
+	)
+}
+
+// BuildTenant provides the transports for a tenant hosted by the deployment.
+func BuildTenant() fx.Option {
+	return fx.Options(
+		http.BuildTenant(),
+		mcp.Build(),
+		graphql.Build(),
+		realtime.Build(),
+		fediverse.Build(),
+		inbound_email.Build(),
+		appservice.Build(),
+		telegram.Build(),
 	)
 }
@@ -15,6 +15,7 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources"
 	"github.com/Southclaws/storyden/app/services"
+	"github.com/Southclaws/storyden/app/services/tenancy"
 	transport "github.com/Southclaws/storyden/app/transports"
 	"github.com/Southclaws/storyden/internal/boot_time"
 	"github.com/Southclaws/storyden/internal/config"
@@ -35,6 +36,8 @@ func Start(ctx context.Context) {
 		resources.Build(),
 		services.Build(),
 		transport.Build(),
+
+		fx.Supply(tenancy.Composition(tenant)),
 	)
 
 	err := app.Start(ctx)
@@ -60,6 +63,17 @@ func Start(ctx context.Context) {
 	}
 }
 
+// tenant composes a tenant hosted by this deployment. Tenants are built from
+// the same layers but are served by the primary's HTTP server.
+func tenant() fx.Option {
+	return fx.Options(
+		infrastructure.Build(),
+		resources.Build(),
+		services.Build(),
+		transport.BuildTenant(),
+	)
+}
+
 func main() {
 	boot_time.StartedAt = time.Now()
 
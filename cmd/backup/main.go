@@ -0,0 +1,207 @@
+// Command backup creates, verifies and restores backup bundles of a Storyden
+// instance. The database and asset storage are configured with the usual
+// environment variables.
+//
+//	go run ./cmd/backup create -out storyden.tar.gz -assets
+//	go run ./cmd/backup verify -in storyden.tar.gz
+//	go run ./cmd/backup restore -in storyden.tar.gz
+//
+// Creating a backup is safe while the instance is running. Restoring requires
+// a new, empty database, which is migrated to the current schema first.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/joho/godotenv/autoload"
+	"go.uber.org/dig"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/backup"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/db"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation"
+	"github.com/Southclaws/storyden/internal/infrastructure/logger"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: backup <command> [flags]
+
+commands:
+  create   write a backup bundle of the database and assets
+  verify   check a bundle's integrity without restoring it
+  restore  load a bundle into a new, empty instance`)
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	ctx, cf := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cf()
+
+	var err error
+	switch os.Args[1] {
+	case "create":
+		fs := flag.NewFlagSet("create", flag.ExitOnError)
+		out := fs.String("out", fmt.Sprintf("storyden-%s.tar.gz", time.Now().UTC().Format("20060102-150405")), "path to write the bundle to")
+		assets := fs.Bool("assets", false, "include the contents of stored assets, not just the list of them")
+		fs.Parse(os.Args[2:])
+
+		err = create(ctx, *out, *assets)
+
+	case "verify":
+		fs := flag.NewFlagSet("verify", flag.ExitOnError)
+		in := fs.String("in", "", "path of the bundle to verify")
+		fs.Parse(os.Args[2:])
+		if *in == "" {
+			fs.Usage()
+			os.Exit(2)
+		}
+
+		err = verify(ctx, *in)
+
+	case "restore":
+		fs := flag.NewFlagSet("restore", flag.ExitOnError)
+		in := fs.String("in", "", "path of the bundle to restore")
+		fs.Parse(os.Args[2:])
+		if *in == "" {
+			fs.Usage()
+			os.Exit(2)
+		}
+
+		err = restore(ctx, *in)
+
+	default:
+		usage()
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// start builds just the database and storage. When migrate is set the ent
+// client is constructed too which applies the schema on start.
+func start(ctx context.Context, migrate bool, targets ...any) (func(), error) {
+	opts := []fx.Option{
+		fx.NopLogger,
+		fx.Provide(func() context.Context { return ctx }),
+		config.Build(),
+		logger.Build(),
+		instrumentation.Build(),
+		db.Build(),
+		object.Build(),
+		fx.Populate(targets...),
+	}
+	if migrate {
+		opts = append(opts, fx.Invoke(func(*ent.Client) {}))
+	}
+
+	app := fx.New(opts...)
+	if err := app.Start(ctx); err != nil {
+		return nil, dig.RootCause(err)
+	}
+
+	return func() {
+		ctx, cf := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cf()
+		app.Stop(ctx)
+	}, nil
+}
+
+func create(ctx context.Context, path string, assets bool) error {
+	var raw *sqlx.DB
+	var store object.Storer
+	stop, err := start(ctx, false, &raw, &store)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	m, err := backup.Create(ctx, f, raw, store, backup.CreateOptions{IncludeAssets: assets}, os.Stderr)
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		return err
+	}
+
+	rows := 0
+	for _, t := range m.Tables {
+		rows += t.Rows
+	}
+
+	fmt.Printf("wrote %s: %d rows from %d tables, %d assets listed, %d included\n",
+		path, rows, len(m.Tables), len(m.Assets), m.IncludedAssets())
+
+	return nil
+}
+
+func verify(ctx context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	m, err := backup.Verify(ctx, f, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is valid: created %s by %s from %s\n", path, m.CreatedAt.Format(time.RFC3339), m.Version, m.Driver)
+
+	return nil
+}
+
+func restore(ctx context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var raw *sqlx.DB
+	var store object.Storer
+	stop, err := start(ctx, true, &raw, &store)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	result, err := backup.Restore(ctx, f, raw, store, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("restored %d rows and %d assets from %s\n", result.Rows, result.Assets, path)
+
+	if len(result.Missing) > 0 {
+		fmt.Printf("%d assets listed in the backup are not in storage, copy them over with storagemigrate:\n", len(result.Missing))
+		for _, p := range result.Missing {
+			fmt.Println("  " + p)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+// Command storagemigrate copies stored files, such as uploaded assets and
+// avatars, from one storage backend to another. Both backends are configured
+// with the usual environment variables. For example, to move from local disk
+// to Azure Blob Storage:
+//
+//	AZURE_STORAGE_ACCOUNT=... go run ./cmd/storagemigrate -from local -to azure
+//
+// Files already present at the destination are skipped so an interrupted run
+// can be repeated. Once complete, set ASSET_STORAGE_TYPE to the destination.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/kelseyhightower/envconfig"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+func main() {
+	from := flag.String("from", "", "storage to copy from: local, s3, azure, gcs or tiered")
+	to := flag.String("to", "", "storage to copy to: local, s3, azure, gcs or tiered")
+	overwrite := flag.Bool("overwrite", false, "replace files which already exist at the destination")
+	flag.Parse()
+
+	kinds := map[string]bool{"local": true, "s3": true, "azure": true, "gcs": true, "tiered": true}
+	if !kinds[*from] || !kinds[*to] || *from == *to {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cf := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cf()
+
+	if err := run(ctx, *from, *to, *overwrite); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, from, to string, overwrite bool) error {
+	var cfg config.Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return err
+	}
+
+	source, err := object.New(ctx, cfg, from)
+	if err != nil {
+		return err
+	}
+
+	destination, err := object.New(ctx, cfg, to)
+	if err != nil {
+		return err
+	}
+
+	result, err := object.Migrate(ctx, source, destination, overwrite)
+	if result != nil {
+		fmt.Printf("copied %d files, skipped %d already present\n", result.Copied, result.Skipped)
+	}
+
+	return err
+}
@@ -0,0 +1,269 @@
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent/migrate"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+type CreateOptions struct {
+	// IncludeAssets copies the contents of every stored object into the bundle
+	// rather than only listing them.
+	IncludeAssets bool
+}
+
+// Create writes a bundle of the database and stored assets to w. The tables
+// are read in a single read-only transaction so the dump is consistent even
+// while the instance is serving requests.
+func Create(ctx context.Context, w io.Writer, db *sqlx.DB, store object.Storer, opts CreateOptions, progress io.Writer) (*Manifest, error) {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	m := &Manifest{
+		FormatVersion: FormatVersion,
+		Version:       config.Version,
+		CreatedAt:     time.Now().UTC(),
+		Driver:        db.DriverName(),
+		Tables:        []TableEntry{},
+		Assets:        []AssetEntry{},
+	}
+
+	if err := dumpTables(ctx, tw, db, m, progress); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := dumpAssets(ctx, tw, store, m, opts.IncludeAssets, progress); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := writeEntry(tw, manifestName, int64(len(manifest)), strings.NewReader(string(manifest))); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m, nil
+}
+
+func dumpTables(ctx context.Context, tw *tar.Writer, db *sqlx.DB, m *Manifest, progress io.Writer) error {
+	txo := &sql.TxOptions{}
+	if db.DriverName() == "pgx" {
+		txo = &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	}
+
+	tx, err := db.BeginTx(ctx, txo)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to start a read transaction"))
+	}
+	defer tx.Rollback()
+
+	for _, t := range migrate.Tables {
+		entry, err := dumpTable(ctx, tw, tx, t)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to dump table %s", t.Name)))
+		}
+
+		m.Tables = append(m.Tables, *entry)
+		fmt.Fprintf(progress, "database: %s (%d rows)\n", t.Name, entry.Rows)
+	}
+
+	return nil
+}
+
+// dumpTable spools the table to a temporary file first as the size of each
+// entry must be known before it's written to the archive.
+func dumpTable(ctx context.Context, tw *tar.Writer, tx *sql.Tx, t *schema.Table) (*TableEntry, error) {
+	f, err := os.CreateTemp("", "storyden-backup-*.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	columns := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		columns[i] = c.Name
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`select %s from %s`, quoteAll(columns), quote(t.Name)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buf := bufio.NewWriter(f)
+	enc := json.NewEncoder(buf)
+
+	count := 0
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		row := make([]any, len(columns))
+		for i, c := range t.Columns {
+			row[i] = encode(c, values[i])
+		}
+
+		if err := enc.Encode(row); err != nil {
+			return nil, err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := buf.Flush(); err != nil {
+		return nil, err
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	hr := newHashingReader(f)
+	if err := writeEntry(tw, tableFile(t.Name), size, hr); err != nil {
+		return nil, err
+	}
+
+	return &TableEntry{Name: t.Name, Columns: columns, Rows: count, SHA256: hr.Sum()}, nil
+}
+
+func dumpAssets(ctx context.Context, tw *tar.Writer, store object.Storer, m *Manifest, include bool, progress io.Writer) error {
+	err := store.Walk(ctx, func(path string) error {
+		ctx := fctx.WithMeta(ctx, "path", path)
+
+		r, size, err := store.Read(ctx, path)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		defer closeReader(r)
+
+		entry := AssetEntry{Path: path, Size: size, Included: include}
+
+		if include {
+			if size < 0 {
+				f, n, err := spool(r)
+				if err != nil {
+					return fault.Wrap(err, fctx.With(ctx))
+				}
+				defer os.Remove(f.Name())
+				defer f.Close()
+
+				r, size, entry.Size = f, n, n
+			}
+
+			hr := newHashingReader(r)
+			if err := writeEntry(tw, assetFile(path), size, hr); err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			entry.SHA256 = hr.Sum()
+		}
+
+		m.Assets = append(m.Assets, entry)
+		if len(m.Assets)%100 == 0 {
+			fmt.Fprintf(progress, "assets: %d\n", len(m.Assets))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	fmt.Fprintf(progress, "assets: %d (%d included)\n", len(m.Assets), m.IncludedAssets())
+
+	return nil
+}
+
+// spool copies a stream of unknown length to a temporary file.
+func spool(r io.Reader) (*os.File, int64, error) {
+	f, err := os.CreateTemp("", "storyden-backup-*")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	n, err := io.Copy(f, r)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, 0, err
+	}
+
+	return f, n, nil
+}
+
+func writeEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(tw, r, size)
+	return err
+}
+
+func closeReader(r io.Reader) {
+	if c, ok := r.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+func quote(name string) string {
+	return `"` + name + `"`
+}
+
+func quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = quote(n)
+	}
+	return strings.Join(quoted, ", ")
+}
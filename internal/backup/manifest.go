@@ -0,0 +1,83 @@
+// Package backup produces and restores backup bundles. A bundle is a gzipped
+// tar archive holding a portable dump of every table, one JSON array per row,
+// and the list of stored assets, optionally with their contents. The manifest
+// records checksums of every entry so a bundle can be verified before use.
+//
+// Rows are written independently of the database, so a bundle created from a
+// SQLite database can be restored into Postgres and vice versa.
+package backup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"time"
+)
+
+// FormatVersion is incremented whenever the bundle layout changes in a way
+// older versions of the restore command can't read.
+const FormatVersion = 1
+
+const (
+	manifestName = "manifest.json"
+	tablesDir    = "database/"
+	assetsDir    = "assets/"
+)
+
+type Manifest struct {
+	FormatVersion int          `json:"format_version"`
+	Version       string       `json:"version"`
+	CreatedAt     time.Time    `json:"created_at"`
+	Driver        string       `json:"driver"`
+	Tables        []TableEntry `json:"tables"`
+	Assets        []AssetEntry `json:"assets"`
+}
+
+type TableEntry struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int      `json:"rows"`
+	SHA256  string   `json:"sha256"`
+}
+
+// AssetEntry lists an object present in storage when the backup was taken.
+// When the contents are included in the bundle, the checksum is also set.
+type AssetEntry struct {
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	Included bool   `json:"included"`
+	SHA256   string `json:"sha256,omitempty"`
+}
+
+func tableFile(name string) string { return tablesDir + name + ".jsonl" }
+func assetFile(path string) string { return assetsDir + path }
+
+func (m *Manifest) IncludedAssets() int {
+	n := 0
+	for _, a := range m.Assets {
+		if a.Included {
+			n++
+		}
+	}
+	return n
+}
+
+type hashingReader struct {
+	r io.Reader
+	h hash.Hash
+}
+
+func newHashingReader(r io.Reader) *hashingReader {
+	return &hashingReader{r: r, h: sha256.New()}
+}
+
+func (h *hashingReader) Read(p []byte) (int, error) {
+	n, err := h.r.Read(p)
+	h.h.Write(p[:n])
+	return n, err
+}
+
+func (h *hashingReader) Sum() string {
+	return hex.EncodeToString(h.h.Sum(nil))
+}
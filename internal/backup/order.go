@@ -0,0 +1,100 @@
+package backup
+
+import (
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/Southclaws/fault"
+)
+
+// step inserts one table. Deferred columns are foreign keys which form a cycle,
+// such as a post referencing its thread's root post. They're inserted as null
+// and filled in once every table has been restored.
+type step struct {
+	table    *schema.Table
+	deferred map[string]bool
+}
+
+// plan orders the tables so that every row is inserted after the rows it
+// references, breaking cycles by deferring nullable foreign key columns.
+func plan(tables []*schema.Table) ([]step, error) {
+	deferred := map[string]map[string]bool{}
+	deferColumns := func(t *schema.Table, cols []*schema.Column) bool {
+		for _, c := range cols {
+			if !c.Nullable {
+				return false
+			}
+		}
+		if deferred[t.Name] == nil {
+			deferred[t.Name] = map[string]bool{}
+		}
+		for _, c := range cols {
+			deferred[t.Name][c.Name] = true
+		}
+		return true
+	}
+
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable == t && !deferColumns(t, fk.Columns) {
+				return nil, fault.Newf("table %s references itself with a required column", t.Name)
+			}
+		}
+	}
+
+	placed := map[string]bool{}
+	remaining := append([]*schema.Table{}, tables...)
+	steps := []step{}
+
+	isDeferred := func(t *schema.Table, fk *schema.ForeignKey) bool {
+		for _, c := range fk.Columns {
+			if !deferred[t.Name][c.Name] {
+				return false
+			}
+		}
+		return true
+	}
+
+	pending := func(t *schema.Table) []*schema.ForeignKey {
+		fks := []*schema.ForeignKey{}
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable != t && !placed[fk.RefTable.Name] && !isDeferred(t, fk) {
+				fks = append(fks, fk)
+			}
+		}
+		return fks
+	}
+
+	for len(remaining) > 0 {
+		next := -1
+		for i, t := range remaining {
+			if len(pending(t)) == 0 {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			broken := false
+			for _, t := range remaining {
+				for _, fk := range pending(t) {
+					if deferColumns(t, fk.Columns) {
+						broken = true
+					}
+				}
+				if broken {
+					break
+				}
+			}
+			if !broken {
+				return nil, fault.New("tables reference each other with required columns and can't be ordered")
+			}
+			continue
+		}
+
+		t := remaining[next]
+		steps = append(steps, step{table: t, deferred: deferred[t.Name]})
+		placed[t.Name] = true
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+
+	return steps, nil
+}
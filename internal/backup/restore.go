@@ -0,0 +1,332 @@
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Southclaws/storyden/internal/ent/migrate"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+type RestoreResult struct {
+	Rows   int
+	Assets int
+	// Missing lists assets which were only listed in the manifest and are not
+	// present in the configured storage.
+	Missing []string
+}
+
+// Restore verifies the bundle and then loads it into an empty database, which
+// must already have the schema applied, and copies any included assets into
+// storage. The database is restored in a single transaction so a failure
+// leaves it empty and the restore can simply be retried.
+func Restore(ctx context.Context, r io.ReadSeeker, db *sqlx.DB, store object.Storer, progress io.Writer) (*RestoreResult, error) {
+	m, err := Verify(ctx, r, progress)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := ensureEmpty(ctx, db); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	steps, err := plan(migrate.Tables)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	dir, err := os.MkdirTemp("", "storyden-restore-*")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer os.RemoveAll(dir)
+
+	result := &RestoreResult{}
+
+	if err := extract(ctx, r, dir, store, result, progress); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := loadTables(ctx, db, m, steps, dir, result, progress); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, a := range m.Assets {
+		if a.Included {
+			continue
+		}
+
+		exists, err := store.Exists(ctx, a.Path)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !exists {
+			result.Missing = append(result.Missing, a.Path)
+		}
+	}
+
+	return result, nil
+}
+
+func ensureEmpty(ctx context.Context, db *sqlx.DB) error {
+	for _, t := range migrate.Tables {
+		var one int
+		err := db.QueryRowContext(ctx, fmt.Sprintf(`select 1 from %s limit 1`, quote(t.Name))).Scan(&one)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return fault.Newf("table %s is not empty, a backup can only be restored into a new database", t.Name)
+	}
+
+	return nil
+}
+
+// extract writes the table dumps to dir, so they can be loaded in dependency
+// order, and writes included assets straight into storage.
+func extract(ctx context.Context, r io.Reader, dir string, store object.Storer, result *RestoreResult, progress io.Writer) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		switch {
+		case strings.HasPrefix(h.Name, tablesDir):
+			name := filepath.Base(h.Name)
+			f, err := os.Create(filepath.Join(dir, name))
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+		case strings.HasPrefix(h.Name, assetsDir):
+			path := strings.TrimPrefix(h.Name, assetsDir)
+			if err := store.Write(ctx, path, tr, h.Size); err != nil {
+				return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to restore asset %s", path)))
+			}
+
+			result.Assets++
+			if result.Assets%100 == 0 {
+				fmt.Fprintf(progress, "assets: %d\n", result.Assets)
+			}
+		}
+	}
+
+	fmt.Fprintf(progress, "assets: %d restored\n", result.Assets)
+
+	return nil
+}
+
+type deferredUpdate struct {
+	table   *schema.Table
+	keys    []any
+	columns []string
+	values  []any
+}
+
+func loadTables(ctx context.Context, db *sqlx.DB, m *Manifest, steps []step, dir string, result *RestoreResult, progress io.Writer) error {
+	entries := map[string]TableEntry{}
+	for _, t := range m.Tables {
+		entries[t.Name] = t
+		if !tableExists(t.Name) {
+			fmt.Fprintf(progress, "skipped table %s which no longer exists\n", t.Name)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer tx.Rollback()
+
+	updates := []deferredUpdate{}
+
+	for _, s := range steps {
+		entry, ok := entries[s.table.Name]
+		if !ok {
+			continue
+		}
+
+		n, u, err := loadTable(ctx, tx, s, entry, filepath.Join(dir, filepath.Base(tableFile(entry.Name))), progress)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to restore table %s", entry.Name)))
+		}
+
+		updates = append(updates, u...)
+		result.Rows += n
+		fmt.Fprintf(progress, "database: %s (%d rows)\n", entry.Name, n)
+	}
+
+	for _, u := range updates {
+		set := make([]string, len(u.columns))
+		for i, c := range u.columns {
+			set[i] = fmt.Sprintf("%s = $%d", quote(c), i+1)
+		}
+
+		where := make([]string, len(u.table.PrimaryKey))
+		for i, c := range u.table.PrimaryKey {
+			where[i] = fmt.Sprintf("%s = $%d", quote(c.Name), len(u.columns)+i+1)
+		}
+
+		q := fmt.Sprintf(`update %s set %s where %s`, quote(u.table.Name), strings.Join(set, ", "), strings.Join(where, " and "))
+		if _, err := tx.ExecContext(ctx, q, append(u.values, u.keys...)...); err != nil {
+			return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to restore references in %s", u.table.Name)))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func loadTable(ctx context.Context, tx *sql.Tx, s step, entry TableEntry, path string, progress io.Writer) (int, []deferredUpdate, error) {
+	columns := map[string]*schema.Column{}
+	for _, c := range s.table.Columns {
+		columns[c.Name] = c
+	}
+
+	// Columns are matched by name so bundles from older versions still load,
+	// columns which have since been removed are skipped.
+	known := []int{}
+	names := []string{}
+	for i, name := range entry.Columns {
+		if _, ok := columns[name]; !ok {
+			fmt.Fprintf(progress, "skipped column %s.%s which no longer exists\n", entry.Name, name)
+			continue
+		}
+		known = append(known, i)
+		names = append(names, name)
+	}
+
+	keys := make([]int, len(s.table.PrimaryKey))
+	for i, pk := range s.table.PrimaryKey {
+		keys[i] = -1
+		for j, name := range names {
+			if name == pk.Name {
+				keys[i] = j
+			}
+		}
+	}
+
+	placeholders := make([]string, len(names))
+	for i := range names {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf(`insert into %s (%s) values (%s)`,
+		quote(entry.Name), quoteAll(names), strings.Join(placeholders, ", ")))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer stmt.Close()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer f.Close()
+
+	updates := []deferredUpdate{}
+	count := 0
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 1024*1024), 256*1024*1024)
+
+	for sc.Scan() {
+		raw := []json.RawMessage{}
+		if err := json.Unmarshal(sc.Bytes(), &raw); err != nil {
+			return 0, nil, err
+		}
+		if len(raw) != len(entry.Columns) {
+			return 0, nil, fault.Newf("row %d has %d values, expected %d", count+1, len(raw), len(entry.Columns))
+		}
+
+		values := make([]any, len(names))
+		update := deferredUpdate{table: s.table}
+
+		for i, idx := range known {
+			v, err := decode(columns[names[i]], raw[idx])
+			if err != nil {
+				return 0, nil, err
+			}
+
+			if s.deferred[names[i]] && v != nil {
+				update.columns = append(update.columns, names[i])
+				update.values = append(update.values, v)
+				v = nil
+			}
+
+			values[i] = v
+		}
+
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return 0, nil, err
+		}
+
+		if len(update.columns) > 0 {
+			for _, k := range keys {
+				if k == -1 {
+					return 0, nil, fault.Newf("table %s has references to restore but its primary key is missing from the bundle", entry.Name)
+				}
+				update.keys = append(update.keys, values[k])
+			}
+			updates = append(updates, update)
+		}
+
+		count++
+	}
+	if err := sc.Err(); err != nil {
+		return 0, nil, err
+	}
+
+	return count, updates, nil
+}
+
+func tableExists(name string) bool {
+	for _, t := range migrate.Tables {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package backup
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/fault"
+)
+
+// SQLite may return times as text when the value wasn't written by the driver.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
+
+// encode normalises a scanned value so the dump is the same regardless of the
+// database it came from: SQLite has no booleans and the drivers disagree on
+// whether text and JSON are strings or byte slices.
+func encode(c *schema.Column, v any) any {
+	if v == nil {
+		return nil
+	}
+
+	switch c.Type {
+	case field.TypeBool:
+		if i, ok := v.(int64); ok {
+			return i != 0
+		}
+
+	case field.TypeTime:
+		if t, ok := v.(time.Time); ok {
+			return t.UTC().Format(time.RFC3339Nano)
+		}
+
+	case field.TypeJSON:
+		switch j := v.(type) {
+		case []byte:
+			if json.Valid(j) {
+				return json.RawMessage(j)
+			}
+		case string:
+			if json.Valid([]byte(j)) {
+				return json.RawMessage(j)
+			}
+		}
+
+	case field.TypeBytes:
+		return v
+
+	default:
+		if b, ok := v.([]byte); ok {
+			return string(b)
+		}
+	}
+
+	return v
+}
+
+// decode turns a value from the dump back into one that both drivers accept.
+func decode(c *schema.Column, raw json.RawMessage) (any, error) {
+	if string(raw) == "null" {
+		return nil, nil
+	}
+
+	switch c.Type {
+	case field.TypeBool:
+		var b bool
+		err := json.Unmarshal(raw, &b)
+		return b, err
+
+	case field.TypeTime:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fault.Newf("invalid time value %q for column %s", s, c.Name)
+
+	case field.TypeJSON:
+		return string(raw), nil
+
+	case field.TypeBytes:
+		var b []byte
+		err := json.Unmarshal(raw, &b)
+		return b, err
+
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		var i int64
+		err := json.Unmarshal(raw, &i)
+		return i, err
+
+	case field.TypeFloat32, field.TypeFloat64:
+		var f float64
+		err := json.Unmarshal(raw, &f)
+		return f, err
+	}
+
+	var s string
+	err := json.Unmarshal(raw, &s)
+	return s, err
+}
@@ -0,0 +1,149 @@
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+)
+
+var ErrCorrupt = errors.New("backup bundle failed verification")
+
+type entryDigest struct {
+	sha256 string
+	lines  int
+}
+
+// Verify reads the whole bundle and checks every table and included asset is
+// present and matches the checksum recorded in the manifest.
+func Verify(ctx context.Context, r io.Reader, progress io.Writer) (*Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("not a backup bundle"))
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+
+	digests := map[string]entryDigest{}
+	var manifest *Manifest
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to read backup bundle"))
+		}
+
+		if h.Name == manifestName {
+			manifest = &Manifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to read backup manifest"))
+			}
+			continue
+		}
+
+		d, err := digest(tr, strings.HasPrefix(h.Name, tablesDir))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to read backup bundle"))
+		}
+		digests[h.Name] = d
+	}
+
+	// The archive can end before the compressed stream does, reading the rest
+	// checks the gzip trailer so corruption anywhere in the file is caught.
+	if _, err := io.Copy(io.Discard, gz); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to read backup bundle"))
+	}
+
+	if manifest == nil {
+		return nil, fault.Wrap(ErrCorrupt, fctx.With(ctx), fmsg.With("the bundle has no manifest"))
+	}
+
+	if manifest.FormatVersion > FormatVersion {
+		return nil, fault.Newf("the bundle uses format %d but only format %d and older are supported, upgrade Storyden to restore it",
+			manifest.FormatVersion, FormatVersion)
+	}
+
+	problems := []string{}
+
+	for _, t := range manifest.Tables {
+		d, ok := digests[tableFile(t.Name)]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("table %s is missing", t.Name))
+		case d.sha256 != t.SHA256:
+			problems = append(problems, fmt.Sprintf("table %s checksum does not match", t.Name))
+		case d.lines != t.Rows:
+			problems = append(problems, fmt.Sprintf("table %s has %d rows, expected %d", t.Name, d.lines, t.Rows))
+		}
+	}
+	fmt.Fprintf(progress, "verified %d tables\n", len(manifest.Tables))
+
+	for _, a := range manifest.Assets {
+		if !a.Included {
+			continue
+		}
+
+		d, ok := digests[assetFile(a.Path)]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("asset %s is missing", a.Path))
+		case d.sha256 != a.SHA256:
+			problems = append(problems, fmt.Sprintf("asset %s checksum does not match", a.Path))
+		}
+	}
+	fmt.Fprintf(progress, "verified %d included assets of %d listed\n", manifest.IncludedAssets(), len(manifest.Assets))
+
+	if len(problems) > 0 {
+		for _, p := range problems {
+			fmt.Fprintln(progress, p)
+		}
+		return nil, fault.Wrap(ErrCorrupt, fctx.With(ctx), fmsg.With(fmt.Sprintf("%d problems found in the bundle", len(problems))))
+	}
+
+	return manifest, nil
+}
+
+func digest(r io.Reader, countLines bool) (entryDigest, error) {
+	hr := newHashingReader(r)
+
+	if !countLines {
+		_, err := io.Copy(io.Discard, hr)
+		return entryDigest{sha256: hr.Sum()}, err
+	}
+
+	lines := 0
+	br := bufio.NewReader(hr)
+	for {
+		chunk, err := br.ReadSlice('\n')
+		lines += bytes.Count(chunk, []byte{'\n'})
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return entryDigest{}, err
+		}
+	}
+
+	return entryDigest{sha256: hr.Sum(), lines: lines}, nil
+}
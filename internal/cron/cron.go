@@ -0,0 +1,257 @@
+// Package cron parses cron expressions and computes when they next fire.
+//
+// Expressions use the standard five fields: minute, hour, day of month, month
+// and day of week. Fields accept "*" or "?", single values, ranges "a-b",
+// lists "a,b" and steps "*/n" or "a-b/n". Months and days of the week may be written as
+// three letter names. The macros @yearly, @monthly, @weekly, @daily and
+// @hourly are supported as well as "@every <duration>" for fixed intervals.
+// All schedules are evaluated in UTC.
+package cron
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+)
+
+type Schedule interface {
+	// Next returns the first time after t that the schedule fires.
+	Next(t time.Time) time.Time
+}
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type bounds struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minutes     = bounds{0, 59, nil}
+	hours       = bounds{0, 23, nil}
+	daysOfMonth = bounds{1, 31, nil}
+	months      = bounds{1, 12, map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	daysOfWeek = bounds{0, 6, map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+func Parse(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	if d, ok := strings.CutPrefix(expr, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		if interval < time.Second {
+			return nil, fault.Newf("interval must be at least one second: %s", d)
+		}
+		return every{interval: interval}, nil
+	}
+
+	if m, ok := macros[strings.ToLower(expr)]; ok {
+		expr = m
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fault.Newf("expected 5 fields in cron expression, got %d", len(fields))
+	}
+
+	var s spec
+	var err error
+
+	if s.minute, err = parseField(fields[0], minutes); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if s.hour, err = parseField(fields[1], hours); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if s.dom, err = parseField(fields[2], daysOfMonth); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if s.month, err = parseField(fields[3], months); err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	// 7 is accepted as Sunday for compatibility with other implementations.
+	dow := daysOfWeek
+	dow.max = 7
+	if s.dow, err = parseField(fields[4], dow); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+
+	// A field is unrestricted when it matches every value, however it's written.
+	s.domAny = covers(s.dom, daysOfMonth)
+	s.dowAny = covers(s.dow, daysOfWeek)
+
+	return s, nil
+}
+
+// MustParse is for schedules written in code, it panics on an invalid expression.
+func MustParse(expr string) Schedule {
+	s, err := Parse(expr)
+	if err != nil {
+		panic(fmt.Sprintf("invalid cron expression %q: %v", expr, err))
+	}
+	return s
+}
+
+type every struct {
+	interval time.Duration
+}
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Truncate(time.Second).Add(e.interval)
+}
+
+type spec struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+func (s spec) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+
+	// Every valid expression fires at least once within a few years, such as
+	// the 29th of February, so this bounds the search for those that don't.
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches follows the traditional rule where, when both the day of month
+// and day of week are restricted, a day matching either of them fires.
+func (s spec) dayMatches(t time.Time) bool {
+	dom := has(s.dom, t.Day())
+	dow := has(s.dow, int(t.Weekday()))
+
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<uint(v)) != 0
+}
+
+func covers(set uint64, b bounds) bool {
+	for v := b.min; v <= b.max; v++ {
+		if !has(set, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var set uint64
+
+	for part := range strings.SplitSeq(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n < 1 {
+				return 0, fault.Newf("invalid step %q", stepStr)
+			}
+			step = n
+		}
+
+		lo, hi := b.min, b.max
+		switch {
+		case rng == "*" || rng == "?":
+		case strings.Contains(rng, "-"):
+			from, to, _ := strings.Cut(rng, "-")
+
+			var err error
+			if lo, err = parseValue(from, b); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(to, b); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fault.Newf("invalid range %q", rng)
+			}
+		default:
+			v, err := parseValue(rng, b)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			if hasStep {
+				hi = b.max
+			} else {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	if bits.OnesCount64(set) == 0 {
+		return 0, fault.Newf("field %q matches nothing", field)
+	}
+
+	return set, nil
+}
+
+func parseValue(s string, b bounds) (int, error) {
+	if v, ok := b.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fault.Newf("invalid value %q", s)
+	}
+	if v < b.min || v > b.max {
+		return 0, fault.Newf("value %d out of range %d-%d", v, b.min, b.max)
+	}
+
+	return v, nil
+}
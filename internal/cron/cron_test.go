@@ -0,0 +1,66 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	from := time.Date(2024, time.January, 31, 10, 7, 30, 0, time.UTC) // Wednesday
+
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 31, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 31, 10, 15, 0, 0, time.UTC)},
+		{"0 * * * *", time.Date(2024, 1, 31, 11, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 1, 31, 11, 0, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2024, 2, 1, 2, 30, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 0", time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either may match.
+		{"0 0 13 * fri", time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)},
+		// A field covering every value is unrestricted however it's written.
+		{"0 0 */1 * fri", time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * 0-6", time.Date(2024, 2, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * ?", time.Date(2024, 2, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 0 ? * mon", time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", time.Date(2024, 1, 31, 11, 37, 30, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			s, err := Parse(c.expr)
+			require.NoError(t, err)
+			assert.Equal(t, c.want, s.Next(from))
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * * funday",
+		"@every 10ms",
+		"@every soon",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/schema"
 	"github.com/rs/xid"
@@ -41,10 +44,24 @@ type Account struct {
 	Admin bool `json:"admin,omitempty"`
 	// Links holds the value of the "links" field.
 	Links []schema.ExternalLink `json:"links,omitempty"`
+	// PinnedItems holds the value of the "pinned_items" field.
+	PinnedItems []schema.PinnedItem `json:"pinned_items,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale *string `json:"locale,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone *string `json:"timezone,omitempty"`
+	// ReadingHistoryEnabled holds the value of the "reading_history_enabled" field.
+	ReadingHistoryEnabled bool `json:"reading_history_enabled,omitempty"`
+	// HiddenActivity holds the value of the "hidden_activity" field.
+	HiddenActivity []string `json:"hidden_activity,omitempty"`
+	// The account's verification badge, one of staff, verified, bot or organization.
+	Verification *string `json:"verification,omitempty"`
 	// InvitedByID holds the value of the "invited_by_id" field.
 	InvitedByID *xid.ID `json:"invited_by_id,omitempty"`
+	// The member responsible for a bot account.
+	OwnerID *xid.ID `json:"owner_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AccountQuery when eager-loading is set.
 	Edges        AccountEdges `json:"edges"`
@@ -65,10 +82,26 @@ type AccountEdges struct {
 	Following []*AccountFollow `json:"following,omitempty"`
 	// FollowedBy holds the value of the followed_by edge.
 	FollowedBy []*AccountFollow `json:"followed_by,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*AccountBlock `json:"blocks,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*AccountBlock `json:"blocked_by,omitempty"`
+	// TagFollows holds the value of the tag_follows edge.
+	TagFollows []*TagFollow `json:"tag_follows,omitempty"`
+	// CategoryFollows holds the value of the category_follows edge.
+	CategoryFollows []*CategoryFollow `json:"category_follows,omitempty"`
+	// Reputation holds the value of the reputation edge.
+	Reputation []*ReputationEntry `json:"reputation,omitempty"`
+	// Badges holds the value of the badges edge.
+	Badges []*AccountBadge `json:"badges,omitempty"`
 	// Invitations holds the value of the invitations edge.
 	Invitations []*Invitation `json:"invitations,omitempty"`
 	// InvitedBy holds the value of the invited_by edge.
 	InvitedBy *Invitation `json:"invited_by,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *Account `json:"owner,omitempty"`
+	// Bots holds the value of the bots edge.
+	Bots []*Account `json:"bots,omitempty"`
 	// Posts holds the value of the posts edge.
 	Posts []*Post `json:"posts,omitempty"`
 	// Questions holds the value of the questions edge.
@@ -95,15 +128,61 @@ type AccountEdges struct {
 	Events []*EventParticipant `json:"events,omitempty"`
 	// PostReads holds the value of the post_reads edge.
 	PostReads []*PostRead `json:"post_reads,omitempty"`
+	// NotificationPreferences holds the value of the notification_preferences edge.
+	NotificationPreferences []*NotificationPreference `json:"notification_preferences,omitempty"`
+	// PushSubscriptions holds the value of the push_subscriptions edge.
+	PushSubscriptions []*PushSubscription `json:"push_subscriptions,omitempty"`
+	// DigestSubscription holds the value of the digest_subscription edge.
+	DigestSubscription *DigestSubscription `json:"digest_subscription,omitempty"`
+	// FeedToken holds the value of the feed_token edge.
+	FeedToken *FeedToken `json:"feed_token,omitempty"`
+	// APIUsage holds the value of the api_usage edge.
+	APIUsage []*APIUsage `json:"api_usage,omitempty"`
+	// ContentViews holds the value of the content_views edge.
+	ContentViews []*ContentView `json:"content_views,omitempty"`
 	// Reports holds the value of the reports edge.
 	Reports []*Report `json:"reports,omitempty"`
 	// HandledReports holds the value of the handled_reports edge.
 	HandledReports []*Report `json:"handled_reports,omitempty"`
+	// ModerationNotes holds the value of the moderation_notes edge.
+	ModerationNotes []*ModerationNote `json:"moderation_notes,omitempty"`
+	// AuthoredModerationNotes holds the value of the authored_moderation_notes edge.
+	AuthoredModerationNotes []*ModerationNote `json:"authored_moderation_notes,omitempty"`
+	// Warnings holds the value of the warnings edge.
+	Warnings []*Warning `json:"warnings,omitempty"`
+	// IssuedWarnings holds the value of the issued_warnings edge.
+	IssuedWarnings []*Warning `json:"issued_warnings,omitempty"`
+	// UploadSessions holds the value of the upload_sessions edge.
+	UploadSessions []*UploadSession `json:"upload_sessions,omitempty"`
+	// Conversations holds the value of the conversations edge.
+	Conversations []*ConversationParticipant `json:"conversations,omitempty"`
+	// DirectMessages holds the value of the direct_messages edge.
+	DirectMessages []*DirectMessage `json:"direct_messages,omitempty"`
+	// Spaces holds the value of the spaces edge.
+	Spaces []*SpaceMember `json:"spaces,omitempty"`
+	// Application holds the value of the application edge.
+	Application *AccountApplication `json:"application,omitempty"`
+	// ReviewedApplications holds the value of the reviewed_applications edge.
+	ReviewedApplications []*AccountApplication `json:"reviewed_applications,omitempty"`
+	// OnboardingSteps holds the value of the onboarding_steps edge.
+	OnboardingSteps []*AccountOnboardingStep `json:"onboarding_steps,omitempty"`
+	// PolicyAcceptances holds the value of the policy_acceptances edge.
+	PolicyAcceptances []*PolicyAcceptance `json:"policy_acceptances,omitempty"`
+	// Bookmarks holds the value of the bookmarks edge.
+	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
+	// ReadingHistory holds the value of the reading_history edge.
+	ReadingHistory []*ReadingHistory `json:"reading_history,omitempty"`
+	// VerificationRequests holds the value of the verification_requests edge.
+	VerificationRequests []*VerificationRequest `json:"verification_requests,omitempty"`
+	// ReviewedVerificationRequests holds the value of the reviewed_verification_requests edge.
+	ReviewedVerificationRequests []*VerificationRequest `json:"reviewed_verification_requests,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// AccountRoles holds the value of the account_roles edge.
 	AccountRoles []*AccountRoles `json:"account_roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [24]bool
+	loadedTypes [55]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -160,10 +239,64 @@ func (e AccountEdges) FollowedByOrErr() ([]*AccountFollow, error) {
 	return nil, &NotLoadedError{edge: "followed_by"}
 }
 
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BlocksOrErr() ([]*AccountBlock, error) {
+	if e.loadedTypes[6] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BlockedByOrErr() ([]*AccountBlock, error) {
+	if e.loadedTypes[7] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// TagFollowsOrErr returns the TagFollows value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) TagFollowsOrErr() ([]*TagFollow, error) {
+	if e.loadedTypes[8] {
+		return e.TagFollows, nil
+	}
+	return nil, &NotLoadedError{edge: "tag_follows"}
+}
+
+// CategoryFollowsOrErr returns the CategoryFollows value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) CategoryFollowsOrErr() ([]*CategoryFollow, error) {
+	if e.loadedTypes[9] {
+		return e.CategoryFollows, nil
+	}
+	return nil, &NotLoadedError{edge: "category_follows"}
+}
+
+// ReputationOrErr returns the Reputation value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ReputationOrErr() ([]*ReputationEntry, error) {
+	if e.loadedTypes[10] {
+		return e.Reputation, nil
+	}
+	return nil, &NotLoadedError{edge: "reputation"}
+}
+
+// BadgesOrErr returns the Badges value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BadgesOrErr() ([]*AccountBadge, error) {
+	if e.loadedTypes[11] {
+		return e.Badges, nil
+	}
+	return nil, &NotLoadedError{edge: "badges"}
+}
+
 // InvitationsOrErr returns the Invitations value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) InvitationsOrErr() ([]*Invitation, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[12] {
 		return e.Invitations, nil
 	}
 	return nil, &NotLoadedError{edge: "invitations"}
@@ -174,16 +307,36 @@ func (e AccountEdges) InvitationsOrErr() ([]*Invitation, error) {
 func (e AccountEdges) InvitedByOrErr() (*Invitation, error) {
 	if e.InvitedBy != nil {
 		return e.InvitedBy, nil
-	} else if e.loadedTypes[7] {
+	} else if e.loadedTypes[13] {
 		return nil, &NotFoundError{label: invitation.Label}
 	}
 	return nil, &NotLoadedError{edge: "invited_by"}
 }
 
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) OwnerOrErr() (*Account, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[14] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// BotsOrErr returns the Bots value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BotsOrErr() ([]*Account, error) {
+	if e.loadedTypes[15] {
+		return e.Bots, nil
+	}
+	return nil, &NotLoadedError{edge: "bots"}
+}
+
 // PostsOrErr returns the Posts value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) PostsOrErr() ([]*Post, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[16] {
 		return e.Posts, nil
 	}
 	return nil, &NotLoadedError{edge: "posts"}
@@ -192,7 +345,7 @@ func (e AccountEdges) PostsOrErr() ([]*Post, error) {
 // QuestionsOrErr returns the Questions value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) QuestionsOrErr() ([]*Question, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[17] {
 		return e.Questions, nil
 	}
 	return nil, &NotLoadedError{edge: "questions"}
@@ -201,7 +354,7 @@ func (e AccountEdges) QuestionsOrErr() ([]*Question, error) {
 // ReactsOrErr returns the Reacts value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) ReactsOrErr() ([]*React, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[18] {
 		return e.Reacts, nil
 	}
 	return nil, &NotLoadedError{edge: "reacts"}
@@ -210,7 +363,7 @@ func (e AccountEdges) ReactsOrErr() ([]*React, error) {
 // LikesOrErr returns the Likes value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) LikesOrErr() ([]*LikePost, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[19] {
 		return e.Likes, nil
 	}
 	return nil, &NotLoadedError{edge: "likes"}
@@ -219,7 +372,7 @@ func (e AccountEdges) LikesOrErr() ([]*LikePost, error) {
 // MentionsOrErr returns the Mentions value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) MentionsOrErr() ([]*MentionProfile, error) {
-	if e.loadedTypes[12] {
+	if e.loadedTypes[20] {
 		return e.Mentions, nil
 	}
 	return nil, &NotLoadedError{edge: "mentions"}
@@ -228,7 +381,7 @@ func (e AccountEdges) MentionsOrErr() ([]*MentionProfile, error) {
 // RolesOrErr returns the Roles value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) RolesOrErr() ([]*Role, error) {
-	if e.loadedTypes[13] {
+	if e.loadedTypes[21] {
 		return e.Roles, nil
 	}
 	return nil, &NotLoadedError{edge: "roles"}
@@ -237,7 +390,7 @@ func (e AccountEdges) RolesOrErr() ([]*Role, error) {
 // AuthenticationOrErr returns the Authentication value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) AuthenticationOrErr() ([]*Authentication, error) {
-	if e.loadedTypes[14] {
+	if e.loadedTypes[22] {
 		return e.Authentication, nil
 	}
 	return nil, &NotLoadedError{edge: "authentication"}
@@ -246,7 +399,7 @@ func (e AccountEdges) AuthenticationOrErr() ([]*Authentication, error) {
 // TagsOrErr returns the Tags value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) TagsOrErr() ([]*Tag, error) {
-	if e.loadedTypes[15] {
+	if e.loadedTypes[23] {
 		return e.Tags, nil
 	}
 	return nil, &NotLoadedError{edge: "tags"}
@@ -255,7 +408,7 @@ func (e AccountEdges) TagsOrErr() ([]*Tag, error) {
 // CollectionsOrErr returns the Collections value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) CollectionsOrErr() ([]*Collection, error) {
-	if e.loadedTypes[16] {
+	if e.loadedTypes[24] {
 		return e.Collections, nil
 	}
 	return nil, &NotLoadedError{edge: "collections"}
@@ -264,7 +417,7 @@ func (e AccountEdges) CollectionsOrErr() ([]*Collection, error) {
 // NodesOrErr returns the Nodes value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) NodesOrErr() ([]*Node, error) {
-	if e.loadedTypes[17] {
+	if e.loadedTypes[25] {
 		return e.Nodes, nil
 	}
 	return nil, &NotLoadedError{edge: "nodes"}
@@ -273,7 +426,7 @@ func (e AccountEdges) NodesOrErr() ([]*Node, error) {
 // AssetsOrErr returns the Assets value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) AssetsOrErr() ([]*Asset, error) {
-	if e.loadedTypes[18] {
+	if e.loadedTypes[26] {
 		return e.Assets, nil
 	}
 	return nil, &NotLoadedError{edge: "assets"}
@@ -282,7 +435,7 @@ func (e AccountEdges) AssetsOrErr() ([]*Asset, error) {
 // EventsOrErr returns the Events value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) EventsOrErr() ([]*EventParticipant, error) {
-	if e.loadedTypes[19] {
+	if e.loadedTypes[27] {
 		return e.Events, nil
 	}
 	return nil, &NotLoadedError{edge: "events"}
@@ -291,16 +444,74 @@ func (e AccountEdges) EventsOrErr() ([]*EventParticipant, error) {
 // PostReadsOrErr returns the PostReads value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) PostReadsOrErr() ([]*PostRead, error) {
-	if e.loadedTypes[20] {
+	if e.loadedTypes[28] {
 		return e.PostReads, nil
 	}
 	return nil, &NotLoadedError{edge: "post_reads"}
 }
 
+// NotificationPreferencesOrErr returns the NotificationPreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) NotificationPreferencesOrErr() ([]*NotificationPreference, error) {
+	if e.loadedTypes[29] {
+		return e.NotificationPreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_preferences"}
+}
+
+// PushSubscriptionsOrErr returns the PushSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) PushSubscriptionsOrErr() ([]*PushSubscription, error) {
+	if e.loadedTypes[30] {
+		return e.PushSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "push_subscriptions"}
+}
+
+// DigestSubscriptionOrErr returns the DigestSubscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) DigestSubscriptionOrErr() (*DigestSubscription, error) {
+	if e.DigestSubscription != nil {
+		return e.DigestSubscription, nil
+	} else if e.loadedTypes[31] {
+		return nil, &NotFoundError{label: digestsubscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "digest_subscription"}
+}
+
+// FeedTokenOrErr returns the FeedToken value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) FeedTokenOrErr() (*FeedToken, error) {
+	if e.FeedToken != nil {
+		return e.FeedToken, nil
+	} else if e.loadedTypes[32] {
+		return nil, &NotFoundError{label: feedtoken.Label}
+	}
+	return nil, &NotLoadedError{edge: "feed_token"}
+}
+
+// APIUsageOrErr returns the APIUsage value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) APIUsageOrErr() ([]*APIUsage, error) {
+	if e.loadedTypes[33] {
+		return e.APIUsage, nil
+	}
+	return nil, &NotLoadedError{edge: "api_usage"}
+}
+
+// ContentViewsOrErr returns the ContentViews value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ContentViewsOrErr() ([]*ContentView, error) {
+	if e.loadedTypes[34] {
+		return e.ContentViews, nil
+	}
+	return nil, &NotLoadedError{edge: "content_views"}
+}
+
 // ReportsOrErr returns the Reports value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) ReportsOrErr() ([]*Report, error) {
-	if e.loadedTypes[21] {
+	if e.loadedTypes[35] {
 		return e.Reports, nil
 	}
 	return nil, &NotLoadedError{edge: "reports"}
@@ -309,16 +520,171 @@ func (e AccountEdges) ReportsOrErr() ([]*Report, error) {
 // HandledReportsOrErr returns the HandledReports value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) HandledReportsOrErr() ([]*Report, error) {
-	if e.loadedTypes[22] {
+	if e.loadedTypes[36] {
 		return e.HandledReports, nil
 	}
 	return nil, &NotLoadedError{edge: "handled_reports"}
 }
 
+// ModerationNotesOrErr returns the ModerationNotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ModerationNotesOrErr() ([]*ModerationNote, error) {
+	if e.loadedTypes[37] {
+		return e.ModerationNotes, nil
+	}
+	return nil, &NotLoadedError{edge: "moderation_notes"}
+}
+
+// AuthoredModerationNotesOrErr returns the AuthoredModerationNotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) AuthoredModerationNotesOrErr() ([]*ModerationNote, error) {
+	if e.loadedTypes[38] {
+		return e.AuthoredModerationNotes, nil
+	}
+	return nil, &NotLoadedError{edge: "authored_moderation_notes"}
+}
+
+// WarningsOrErr returns the Warnings value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) WarningsOrErr() ([]*Warning, error) {
+	if e.loadedTypes[39] {
+		return e.Warnings, nil
+	}
+	return nil, &NotLoadedError{edge: "warnings"}
+}
+
+// IssuedWarningsOrErr returns the IssuedWarnings value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) IssuedWarningsOrErr() ([]*Warning, error) {
+	if e.loadedTypes[40] {
+		return e.IssuedWarnings, nil
+	}
+	return nil, &NotLoadedError{edge: "issued_warnings"}
+}
+
+// UploadSessionsOrErr returns the UploadSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) UploadSessionsOrErr() ([]*UploadSession, error) {
+	if e.loadedTypes[41] {
+		return e.UploadSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "upload_sessions"}
+}
+
+// ConversationsOrErr returns the Conversations value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ConversationsOrErr() ([]*ConversationParticipant, error) {
+	if e.loadedTypes[42] {
+		return e.Conversations, nil
+	}
+	return nil, &NotLoadedError{edge: "conversations"}
+}
+
+// DirectMessagesOrErr returns the DirectMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) DirectMessagesOrErr() ([]*DirectMessage, error) {
+	if e.loadedTypes[43] {
+		return e.DirectMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "direct_messages"}
+}
+
+// SpacesOrErr returns the Spaces value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) SpacesOrErr() ([]*SpaceMember, error) {
+	if e.loadedTypes[44] {
+		return e.Spaces, nil
+	}
+	return nil, &NotLoadedError{edge: "spaces"}
+}
+
+// ApplicationOrErr returns the Application value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) ApplicationOrErr() (*AccountApplication, error) {
+	if e.Application != nil {
+		return e.Application, nil
+	} else if e.loadedTypes[45] {
+		return nil, &NotFoundError{label: accountapplication.Label}
+	}
+	return nil, &NotLoadedError{edge: "application"}
+}
+
+// ReviewedApplicationsOrErr returns the ReviewedApplications value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ReviewedApplicationsOrErr() ([]*AccountApplication, error) {
+	if e.loadedTypes[46] {
+		return e.ReviewedApplications, nil
+	}
+	return nil, &NotLoadedError{edge: "reviewed_applications"}
+}
+
+// OnboardingStepsOrErr returns the OnboardingSteps value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) OnboardingStepsOrErr() ([]*AccountOnboardingStep, error) {
+	if e.loadedTypes[47] {
+		return e.OnboardingSteps, nil
+	}
+	return nil, &NotLoadedError{edge: "onboarding_steps"}
+}
+
+// PolicyAcceptancesOrErr returns the PolicyAcceptances value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) PolicyAcceptancesOrErr() ([]*PolicyAcceptance, error) {
+	if e.loadedTypes[48] {
+		return e.PolicyAcceptances, nil
+	}
+	return nil, &NotLoadedError{edge: "policy_acceptances"}
+}
+
+// BookmarksOrErr returns the Bookmarks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BookmarksOrErr() ([]*Bookmark, error) {
+	if e.loadedTypes[49] {
+		return e.Bookmarks, nil
+	}
+	return nil, &NotLoadedError{edge: "bookmarks"}
+}
+
+// ReadingHistoryOrErr returns the ReadingHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ReadingHistoryOrErr() ([]*ReadingHistory, error) {
+	if e.loadedTypes[50] {
+		return e.ReadingHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "reading_history"}
+}
+
+// VerificationRequestsOrErr returns the VerificationRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) VerificationRequestsOrErr() ([]*VerificationRequest, error) {
+	if e.loadedTypes[51] {
+		return e.VerificationRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "verification_requests"}
+}
+
+// ReviewedVerificationRequestsOrErr returns the ReviewedVerificationRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ReviewedVerificationRequestsOrErr() ([]*VerificationRequest, error) {
+	if e.loadedTypes[52] {
+		return e.ReviewedVerificationRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "reviewed_verification_requests"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[53] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // AccountRolesOrErr returns the AccountRoles value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) AccountRolesOrErr() ([]*AccountRoles, error) {
-	if e.loadedTypes[23] {
+	if e.loadedTypes[54] {
 		return e.AccountRoles, nil
 	}
 	return nil, &NotLoadedError{edge: "account_roles"}
@@ -329,13 +695,13 @@ func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case account.FieldInvitedByID:
+		case account.FieldInvitedByID, account.FieldOwnerID:
 			values[i] = &sql.NullScanner{S: new(xid.ID)}
-		case account.FieldLinks, account.FieldMetadata:
+		case account.FieldLinks, account.FieldPinnedItems, account.FieldMetadata, account.FieldHiddenActivity:
 			values[i] = new([]byte)
-		case account.FieldAdmin:
+		case account.FieldAdmin, account.FieldReadingHistoryEnabled:
 			values[i] = new(sql.NullBool)
-		case account.FieldHandle, account.FieldName, account.FieldBio, account.FieldKind:
+		case account.FieldHandle, account.FieldName, account.FieldBio, account.FieldKind, account.FieldLocale, account.FieldTimezone, account.FieldVerification:
 			values[i] = new(sql.NullString)
 		case account.FieldCreatedAt, account.FieldUpdatedAt, account.FieldDeletedAt, account.FieldIndexedAt:
 			values[i] = new(sql.NullTime)
@@ -426,6 +792,14 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field links: %w", err)
 				}
 			}
+		case account.FieldPinnedItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PinnedItems); err != nil {
+					return fmt.Errorf("unmarshal field pinned_items: %w", err)
+				}
+			}
 		case account.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -434,6 +808,41 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case account.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = new(string)
+				*_m.Locale = value.String
+			}
+		case account.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = new(string)
+				*_m.Timezone = value.String
+			}
+		case account.FieldReadingHistoryEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field reading_history_enabled", values[i])
+			} else if value.Valid {
+				_m.ReadingHistoryEnabled = value.Bool
+			}
+		case account.FieldHiddenActivity:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden_activity", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HiddenActivity); err != nil {
+					return fmt.Errorf("unmarshal field hidden_activity: %w", err)
+				}
+			}
+		case account.FieldVerification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification", values[i])
+			} else if value.Valid {
+				_m.Verification = new(string)
+				*_m.Verification = value.String
+			}
 		case account.FieldInvitedByID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field invited_by_id", values[i])
@@ -441,6 +850,13 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 				_m.InvitedByID = new(xid.ID)
 				*_m.InvitedByID = *value.S.(*xid.ID)
 			}
+		case account.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = new(xid.ID)
+				*_m.OwnerID = *value.S.(*xid.ID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -484,6 +900,36 @@ func (_m *Account) QueryFollowedBy() *AccountFollowQuery {
 	return NewAccountClient(_m.config).QueryFollowedBy(_m)
 }
 
+// QueryBlocks queries the "blocks" edge of the Account entity.
+func (_m *Account) QueryBlocks() *AccountBlockQuery {
+	return NewAccountClient(_m.config).QueryBlocks(_m)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the Account entity.
+func (_m *Account) QueryBlockedBy() *AccountBlockQuery {
+	return NewAccountClient(_m.config).QueryBlockedBy(_m)
+}
+
+// QueryTagFollows queries the "tag_follows" edge of the Account entity.
+func (_m *Account) QueryTagFollows() *TagFollowQuery {
+	return NewAccountClient(_m.config).QueryTagFollows(_m)
+}
+
+// QueryCategoryFollows queries the "category_follows" edge of the Account entity.
+func (_m *Account) QueryCategoryFollows() *CategoryFollowQuery {
+	return NewAccountClient(_m.config).QueryCategoryFollows(_m)
+}
+
+// QueryReputation queries the "reputation" edge of the Account entity.
+func (_m *Account) QueryReputation() *ReputationEntryQuery {
+	return NewAccountClient(_m.config).QueryReputation(_m)
+}
+
+// QueryBadges queries the "badges" edge of the Account entity.
+func (_m *Account) QueryBadges() *AccountBadgeQuery {
+	return NewAccountClient(_m.config).QueryBadges(_m)
+}
+
 // QueryInvitations queries the "invitations" edge of the Account entity.
 func (_m *Account) QueryInvitations() *InvitationQuery {
 	return NewAccountClient(_m.config).QueryInvitations(_m)
@@ -494,6 +940,16 @@ func (_m *Account) QueryInvitedBy() *InvitationQuery {
 	return NewAccountClient(_m.config).QueryInvitedBy(_m)
 }
 
+// QueryOwner queries the "owner" edge of the Account entity.
+func (_m *Account) QueryOwner() *AccountQuery {
+	return NewAccountClient(_m.config).QueryOwner(_m)
+}
+
+// QueryBots queries the "bots" edge of the Account entity.
+func (_m *Account) QueryBots() *AccountQuery {
+	return NewAccountClient(_m.config).QueryBots(_m)
+}
+
 // QueryPosts queries the "posts" edge of the Account entity.
 func (_m *Account) QueryPosts() *PostQuery {
 	return NewAccountClient(_m.config).QueryPosts(_m)
@@ -559,6 +1015,36 @@ func (_m *Account) QueryPostReads() *PostReadQuery {
 	return NewAccountClient(_m.config).QueryPostReads(_m)
 }
 
+// QueryNotificationPreferences queries the "notification_preferences" edge of the Account entity.
+func (_m *Account) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	return NewAccountClient(_m.config).QueryNotificationPreferences(_m)
+}
+
+// QueryPushSubscriptions queries the "push_subscriptions" edge of the Account entity.
+func (_m *Account) QueryPushSubscriptions() *PushSubscriptionQuery {
+	return NewAccountClient(_m.config).QueryPushSubscriptions(_m)
+}
+
+// QueryDigestSubscription queries the "digest_subscription" edge of the Account entity.
+func (_m *Account) QueryDigestSubscription() *DigestSubscriptionQuery {
+	return NewAccountClient(_m.config).QueryDigestSubscription(_m)
+}
+
+// QueryFeedToken queries the "feed_token" edge of the Account entity.
+func (_m *Account) QueryFeedToken() *FeedTokenQuery {
+	return NewAccountClient(_m.config).QueryFeedToken(_m)
+}
+
+// QueryAPIUsage queries the "api_usage" edge of the Account entity.
+func (_m *Account) QueryAPIUsage() *APIUsageQuery {
+	return NewAccountClient(_m.config).QueryAPIUsage(_m)
+}
+
+// QueryContentViews queries the "content_views" edge of the Account entity.
+func (_m *Account) QueryContentViews() *ContentViewQuery {
+	return NewAccountClient(_m.config).QueryContentViews(_m)
+}
+
 // QueryReports queries the "reports" edge of the Account entity.
 func (_m *Account) QueryReports() *ReportQuery {
 	return NewAccountClient(_m.config).QueryReports(_m)
@@ -569,6 +1055,91 @@ func (_m *Account) QueryHandledReports() *ReportQuery {
 	return NewAccountClient(_m.config).QueryHandledReports(_m)
 }
 
+// QueryModerationNotes queries the "moderation_notes" edge of the Account entity.
+func (_m *Account) QueryModerationNotes() *ModerationNoteQuery {
+	return NewAccountClient(_m.config).QueryModerationNotes(_m)
+}
+
+// QueryAuthoredModerationNotes queries the "authored_moderation_notes" edge of the Account entity.
+func (_m *Account) QueryAuthoredModerationNotes() *ModerationNoteQuery {
+	return NewAccountClient(_m.config).QueryAuthoredModerationNotes(_m)
+}
+
+// QueryWarnings queries the "warnings" edge of the Account entity.
+func (_m *Account) QueryWarnings() *WarningQuery {
+	return NewAccountClient(_m.config).QueryWarnings(_m)
+}
+
+// QueryIssuedWarnings queries the "issued_warnings" edge of the Account entity.
+func (_m *Account) QueryIssuedWarnings() *WarningQuery {
+	return NewAccountClient(_m.config).QueryIssuedWarnings(_m)
+}
+
+// QueryUploadSessions queries the "upload_sessions" edge of the Account entity.
+func (_m *Account) QueryUploadSessions() *UploadSessionQuery {
+	return NewAccountClient(_m.config).QueryUploadSessions(_m)
+}
+
+// QueryConversations queries the "conversations" edge of the Account entity.
+func (_m *Account) QueryConversations() *ConversationParticipantQuery {
+	return NewAccountClient(_m.config).QueryConversations(_m)
+}
+
+// QueryDirectMessages queries the "direct_messages" edge of the Account entity.
+func (_m *Account) QueryDirectMessages() *DirectMessageQuery {
+	return NewAccountClient(_m.config).QueryDirectMessages(_m)
+}
+
+// QuerySpaces queries the "spaces" edge of the Account entity.
+func (_m *Account) QuerySpaces() *SpaceMemberQuery {
+	return NewAccountClient(_m.config).QuerySpaces(_m)
+}
+
+// QueryApplication queries the "application" edge of the Account entity.
+func (_m *Account) QueryApplication() *AccountApplicationQuery {
+	return NewAccountClient(_m.config).QueryApplication(_m)
+}
+
+// QueryReviewedApplications queries the "reviewed_applications" edge of the Account entity.
+func (_m *Account) QueryReviewedApplications() *AccountApplicationQuery {
+	return NewAccountClient(_m.config).QueryReviewedApplications(_m)
+}
+
+// QueryOnboardingSteps queries the "onboarding_steps" edge of the Account entity.
+func (_m *Account) QueryOnboardingSteps() *AccountOnboardingStepQuery {
+	return NewAccountClient(_m.config).QueryOnboardingSteps(_m)
+}
+
+// QueryPolicyAcceptances queries the "policy_acceptances" edge of the Account entity.
+func (_m *Account) QueryPolicyAcceptances() *PolicyAcceptanceQuery {
+	return NewAccountClient(_m.config).QueryPolicyAcceptances(_m)
+}
+
+// QueryBookmarks queries the "bookmarks" edge of the Account entity.
+func (_m *Account) QueryBookmarks() *BookmarkQuery {
+	return NewAccountClient(_m.config).QueryBookmarks(_m)
+}
+
+// QueryReadingHistory queries the "reading_history" edge of the Account entity.
+func (_m *Account) QueryReadingHistory() *ReadingHistoryQuery {
+	return NewAccountClient(_m.config).QueryReadingHistory(_m)
+}
+
+// QueryVerificationRequests queries the "verification_requests" edge of the Account entity.
+func (_m *Account) QueryVerificationRequests() *VerificationRequestQuery {
+	return NewAccountClient(_m.config).QueryVerificationRequests(_m)
+}
+
+// QueryReviewedVerificationRequests queries the "reviewed_verification_requests" edge of the Account entity.
+func (_m *Account) QueryReviewedVerificationRequests() *VerificationRequestQuery {
+	return NewAccountClient(_m.config).QueryReviewedVerificationRequests(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Account entity.
+func (_m *Account) QueryWebhooks() *WebhookQuery {
+	return NewAccountClient(_m.config).QueryWebhooks(_m)
+}
+
 // QueryAccountRoles queries the "account_roles" edge of the Account entity.
 func (_m *Account) QueryAccountRoles() *AccountRolesQuery {
 	return NewAccountClient(_m.config).QueryAccountRoles(_m)
@@ -631,13 +1202,42 @@ func (_m *Account) String() string {
 	builder.WriteString("links=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Links))
 	builder.WriteString(", ")
+	builder.WriteString("pinned_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PinnedItems))
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
+	if v := _m.Locale; v != nil {
+		builder.WriteString("locale=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Timezone; v != nil {
+		builder.WriteString("timezone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reading_history_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReadingHistoryEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("hidden_activity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HiddenActivity))
+	builder.WriteString(", ")
+	if v := _m.Verification; v != nil {
+		builder.WriteString("verification=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.InvitedByID; v != nil {
 		builder.WriteString("invited_by_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
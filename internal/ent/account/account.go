@@ -36,10 +36,24 @@ const (
 	FieldAdmin = "admin"
 	// FieldLinks holds the string denoting the links field in the database.
 	FieldLinks = "links"
+	// FieldPinnedItems holds the string denoting the pinned_items field in the database.
+	FieldPinnedItems = "pinned_items"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldReadingHistoryEnabled holds the string denoting the reading_history_enabled field in the database.
+	FieldReadingHistoryEnabled = "reading_history_enabled"
+	// FieldHiddenActivity holds the string denoting the hidden_activity field in the database.
+	FieldHiddenActivity = "hidden_activity"
+	// FieldVerification holds the string denoting the verification field in the database.
+	FieldVerification = "verification"
 	// FieldInvitedByID holds the string denoting the invited_by_id field in the database.
 	FieldInvitedByID = "invited_by_id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
 	// EdgeSessions holds the string denoting the sessions edge name in mutations.
 	EdgeSessions = "sessions"
 	// EdgeEmails holds the string denoting the emails edge name in mutations.
@@ -52,10 +66,26 @@ const (
 	EdgeFollowing = "following"
 	// EdgeFollowedBy holds the string denoting the followed_by edge name in mutations.
 	EdgeFollowedBy = "followed_by"
+	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
+	EdgeBlocks = "blocks"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgeTagFollows holds the string denoting the tag_follows edge name in mutations.
+	EdgeTagFollows = "tag_follows"
+	// EdgeCategoryFollows holds the string denoting the category_follows edge name in mutations.
+	EdgeCategoryFollows = "category_follows"
+	// EdgeReputation holds the string denoting the reputation edge name in mutations.
+	EdgeReputation = "reputation"
+	// EdgeBadges holds the string denoting the badges edge name in mutations.
+	EdgeBadges = "badges"
 	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
 	EdgeInvitations = "invitations"
 	// EdgeInvitedBy holds the string denoting the invited_by edge name in mutations.
 	EdgeInvitedBy = "invited_by"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// EdgeBots holds the string denoting the bots edge name in mutations.
+	EdgeBots = "bots"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgeQuestions holds the string denoting the questions edge name in mutations.
@@ -82,10 +112,56 @@ const (
 	EdgeEvents = "events"
 	// EdgePostReads holds the string denoting the post_reads edge name in mutations.
 	EdgePostReads = "post_reads"
+	// EdgeNotificationPreferences holds the string denoting the notification_preferences edge name in mutations.
+	EdgeNotificationPreferences = "notification_preferences"
+	// EdgePushSubscriptions holds the string denoting the push_subscriptions edge name in mutations.
+	EdgePushSubscriptions = "push_subscriptions"
+	// EdgeDigestSubscription holds the string denoting the digest_subscription edge name in mutations.
+	EdgeDigestSubscription = "digest_subscription"
+	// EdgeFeedToken holds the string denoting the feed_token edge name in mutations.
+	EdgeFeedToken = "feed_token"
+	// EdgeAPIUsage holds the string denoting the api_usage edge name in mutations.
+	EdgeAPIUsage = "api_usage"
+	// EdgeContentViews holds the string denoting the content_views edge name in mutations.
+	EdgeContentViews = "content_views"
 	// EdgeReports holds the string denoting the reports edge name in mutations.
 	EdgeReports = "reports"
 	// EdgeHandledReports holds the string denoting the handled_reports edge name in mutations.
 	EdgeHandledReports = "handled_reports"
+	// EdgeModerationNotes holds the string denoting the moderation_notes edge name in mutations.
+	EdgeModerationNotes = "moderation_notes"
+	// EdgeAuthoredModerationNotes holds the string denoting the authored_moderation_notes edge name in mutations.
+	EdgeAuthoredModerationNotes = "authored_moderation_notes"
+	// EdgeWarnings holds the string denoting the warnings edge name in mutations.
+	EdgeWarnings = "warnings"
+	// EdgeIssuedWarnings holds the string denoting the issued_warnings edge name in mutations.
+	EdgeIssuedWarnings = "issued_warnings"
+	// EdgeUploadSessions holds the string denoting the upload_sessions edge name in mutations.
+	EdgeUploadSessions = "upload_sessions"
+	// EdgeConversations holds the string denoting the conversations edge name in mutations.
+	EdgeConversations = "conversations"
+	// EdgeDirectMessages holds the string denoting the direct_messages edge name in mutations.
+	EdgeDirectMessages = "direct_messages"
+	// EdgeSpaces holds the string denoting the spaces edge name in mutations.
+	EdgeSpaces = "spaces"
+	// EdgeApplication holds the string denoting the application edge name in mutations.
+	EdgeApplication = "application"
+	// EdgeReviewedApplications holds the string denoting the reviewed_applications edge name in mutations.
+	EdgeReviewedApplications = "reviewed_applications"
+	// EdgeOnboardingSteps holds the string denoting the onboarding_steps edge name in mutations.
+	EdgeOnboardingSteps = "onboarding_steps"
+	// EdgePolicyAcceptances holds the string denoting the policy_acceptances edge name in mutations.
+	EdgePolicyAcceptances = "policy_acceptances"
+	// EdgeBookmarks holds the string denoting the bookmarks edge name in mutations.
+	EdgeBookmarks = "bookmarks"
+	// EdgeReadingHistory holds the string denoting the reading_history edge name in mutations.
+	EdgeReadingHistory = "reading_history"
+	// EdgeVerificationRequests holds the string denoting the verification_requests edge name in mutations.
+	EdgeVerificationRequests = "verification_requests"
+	// EdgeReviewedVerificationRequests holds the string denoting the reviewed_verification_requests edge name in mutations.
+	EdgeReviewedVerificationRequests = "reviewed_verification_requests"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
 	// EdgeAccountRoles holds the string denoting the account_roles edge name in mutations.
 	EdgeAccountRoles = "account_roles"
 	// Table holds the table name of the account in the database.
@@ -132,6 +208,48 @@ const (
 	FollowedByInverseTable = "account_follows"
 	// FollowedByColumn is the table column denoting the followed_by relation/edge.
 	FollowedByColumn = "following_account_id"
+	// BlocksTable is the table that holds the blocks relation/edge.
+	BlocksTable = "account_blocks"
+	// BlocksInverseTable is the table name for the AccountBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "accountblock" package.
+	BlocksInverseTable = "account_blocks"
+	// BlocksColumn is the table column denoting the blocks relation/edge.
+	BlocksColumn = "account_id"
+	// BlockedByTable is the table that holds the blocked_by relation/edge.
+	BlockedByTable = "account_blocks"
+	// BlockedByInverseTable is the table name for the AccountBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "accountblock" package.
+	BlockedByInverseTable = "account_blocks"
+	// BlockedByColumn is the table column denoting the blocked_by relation/edge.
+	BlockedByColumn = "target_account_id"
+	// TagFollowsTable is the table that holds the tag_follows relation/edge.
+	TagFollowsTable = "tag_follows"
+	// TagFollowsInverseTable is the table name for the TagFollow entity.
+	// It exists in this package in order to avoid circular dependency with the "tagfollow" package.
+	TagFollowsInverseTable = "tag_follows"
+	// TagFollowsColumn is the table column denoting the tag_follows relation/edge.
+	TagFollowsColumn = "account_id"
+	// CategoryFollowsTable is the table that holds the category_follows relation/edge.
+	CategoryFollowsTable = "category_follows"
+	// CategoryFollowsInverseTable is the table name for the CategoryFollow entity.
+	// It exists in this package in order to avoid circular dependency with the "categoryfollow" package.
+	CategoryFollowsInverseTable = "category_follows"
+	// CategoryFollowsColumn is the table column denoting the category_follows relation/edge.
+	CategoryFollowsColumn = "account_id"
+	// ReputationTable is the table that holds the reputation relation/edge.
+	ReputationTable = "reputation_entries"
+	// ReputationInverseTable is the table name for the ReputationEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "reputationentry" package.
+	ReputationInverseTable = "reputation_entries"
+	// ReputationColumn is the table column denoting the reputation relation/edge.
+	ReputationColumn = "account_id"
+	// BadgesTable is the table that holds the badges relation/edge.
+	BadgesTable = "account_badges"
+	// BadgesInverseTable is the table name for the AccountBadge entity.
+	// It exists in this package in order to avoid circular dependency with the "accountbadge" package.
+	BadgesInverseTable = "account_badges"
+	// BadgesColumn is the table column denoting the badges relation/edge.
+	BadgesColumn = "account_id"
 	// InvitationsTable is the table that holds the invitations relation/edge.
 	InvitationsTable = "invitations"
 	// InvitationsInverseTable is the table name for the Invitation entity.
@@ -146,6 +264,14 @@ const (
 	InvitedByInverseTable = "invitations"
 	// InvitedByColumn is the table column denoting the invited_by relation/edge.
 	InvitedByColumn = "invited_by_id"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "accounts"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "owner_id"
+	// BotsTable is the table that holds the bots relation/edge.
+	BotsTable = "accounts"
+	// BotsColumn is the table column denoting the bots relation/edge.
+	BotsColumn = "owner_id"
 	// PostsTable is the table that holds the posts relation/edge.
 	PostsTable = "posts"
 	// PostsInverseTable is the table name for the Post entity.
@@ -233,6 +359,48 @@ const (
 	PostReadsInverseTable = "post_reads"
 	// PostReadsColumn is the table column denoting the post_reads relation/edge.
 	PostReadsColumn = "account_id"
+	// NotificationPreferencesTable is the table that holds the notification_preferences relation/edge.
+	NotificationPreferencesTable = "notification_preferences"
+	// NotificationPreferencesInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferencesInverseTable = "notification_preferences"
+	// NotificationPreferencesColumn is the table column denoting the notification_preferences relation/edge.
+	NotificationPreferencesColumn = "account_id"
+	// PushSubscriptionsTable is the table that holds the push_subscriptions relation/edge.
+	PushSubscriptionsTable = "push_subscriptions"
+	// PushSubscriptionsInverseTable is the table name for the PushSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "pushsubscription" package.
+	PushSubscriptionsInverseTable = "push_subscriptions"
+	// PushSubscriptionsColumn is the table column denoting the push_subscriptions relation/edge.
+	PushSubscriptionsColumn = "account_id"
+	// DigestSubscriptionTable is the table that holds the digest_subscription relation/edge.
+	DigestSubscriptionTable = "digest_subscriptions"
+	// DigestSubscriptionInverseTable is the table name for the DigestSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "digestsubscription" package.
+	DigestSubscriptionInverseTable = "digest_subscriptions"
+	// DigestSubscriptionColumn is the table column denoting the digest_subscription relation/edge.
+	DigestSubscriptionColumn = "account_id"
+	// FeedTokenTable is the table that holds the feed_token relation/edge.
+	FeedTokenTable = "feed_tokens"
+	// FeedTokenInverseTable is the table name for the FeedToken entity.
+	// It exists in this package in order to avoid circular dependency with the "feedtoken" package.
+	FeedTokenInverseTable = "feed_tokens"
+	// FeedTokenColumn is the table column denoting the feed_token relation/edge.
+	FeedTokenColumn = "account_id"
+	// APIUsageTable is the table that holds the api_usage relation/edge.
+	APIUsageTable = "api_usages"
+	// APIUsageInverseTable is the table name for the APIUsage entity.
+	// It exists in this package in order to avoid circular dependency with the "apiusage" package.
+	APIUsageInverseTable = "api_usages"
+	// APIUsageColumn is the table column denoting the api_usage relation/edge.
+	APIUsageColumn = "account_id"
+	// ContentViewsTable is the table that holds the content_views relation/edge.
+	ContentViewsTable = "content_views"
+	// ContentViewsInverseTable is the table name for the ContentView entity.
+	// It exists in this package in order to avoid circular dependency with the "contentview" package.
+	ContentViewsInverseTable = "content_views"
+	// ContentViewsColumn is the table column denoting the content_views relation/edge.
+	ContentViewsColumn = "author_id"
 	// ReportsTable is the table that holds the reports relation/edge.
 	ReportsTable = "reports"
 	// ReportsInverseTable is the table name for the Report entity.
@@ -247,6 +415,125 @@ const (
 	HandledReportsInverseTable = "reports"
 	// HandledReportsColumn is the table column denoting the handled_reports relation/edge.
 	HandledReportsColumn = "handled_by_id"
+	// ModerationNotesTable is the table that holds the moderation_notes relation/edge.
+	ModerationNotesTable = "moderation_notes"
+	// ModerationNotesInverseTable is the table name for the ModerationNote entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationnote" package.
+	ModerationNotesInverseTable = "moderation_notes"
+	// ModerationNotesColumn is the table column denoting the moderation_notes relation/edge.
+	ModerationNotesColumn = "account_id"
+	// AuthoredModerationNotesTable is the table that holds the authored_moderation_notes relation/edge.
+	AuthoredModerationNotesTable = "moderation_notes"
+	// AuthoredModerationNotesInverseTable is the table name for the ModerationNote entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationnote" package.
+	AuthoredModerationNotesInverseTable = "moderation_notes"
+	// AuthoredModerationNotesColumn is the table column denoting the authored_moderation_notes relation/edge.
+	AuthoredModerationNotesColumn = "author_id"
+	// WarningsTable is the table that holds the warnings relation/edge.
+	WarningsTable = "warnings"
+	// WarningsInverseTable is the table name for the Warning entity.
+	// It exists in this package in order to avoid circular dependency with the "warning" package.
+	WarningsInverseTable = "warnings"
+	// WarningsColumn is the table column denoting the warnings relation/edge.
+	WarningsColumn = "account_id"
+	// IssuedWarningsTable is the table that holds the issued_warnings relation/edge.
+	IssuedWarningsTable = "warnings"
+	// IssuedWarningsInverseTable is the table name for the Warning entity.
+	// It exists in this package in order to avoid circular dependency with the "warning" package.
+	IssuedWarningsInverseTable = "warnings"
+	// IssuedWarningsColumn is the table column denoting the issued_warnings relation/edge.
+	IssuedWarningsColumn = "issued_by_id"
+	// UploadSessionsTable is the table that holds the upload_sessions relation/edge.
+	UploadSessionsTable = "upload_sessions"
+	// UploadSessionsInverseTable is the table name for the UploadSession entity.
+	// It exists in this package in order to avoid circular dependency with the "uploadsession" package.
+	UploadSessionsInverseTable = "upload_sessions"
+	// UploadSessionsColumn is the table column denoting the upload_sessions relation/edge.
+	UploadSessionsColumn = "account_id"
+	// ConversationsTable is the table that holds the conversations relation/edge.
+	ConversationsTable = "conversation_participants"
+	// ConversationsInverseTable is the table name for the ConversationParticipant entity.
+	// It exists in this package in order to avoid circular dependency with the "conversationparticipant" package.
+	ConversationsInverseTable = "conversation_participants"
+	// ConversationsColumn is the table column denoting the conversations relation/edge.
+	ConversationsColumn = "account_id"
+	// DirectMessagesTable is the table that holds the direct_messages relation/edge.
+	DirectMessagesTable = "direct_messages"
+	// DirectMessagesInverseTable is the table name for the DirectMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "directmessage" package.
+	DirectMessagesInverseTable = "direct_messages"
+	// DirectMessagesColumn is the table column denoting the direct_messages relation/edge.
+	DirectMessagesColumn = "account_id"
+	// SpacesTable is the table that holds the spaces relation/edge.
+	SpacesTable = "space_members"
+	// SpacesInverseTable is the table name for the SpaceMember entity.
+	// It exists in this package in order to avoid circular dependency with the "spacemember" package.
+	SpacesInverseTable = "space_members"
+	// SpacesColumn is the table column denoting the spaces relation/edge.
+	SpacesColumn = "account_id"
+	// ApplicationTable is the table that holds the application relation/edge.
+	ApplicationTable = "account_applications"
+	// ApplicationInverseTable is the table name for the AccountApplication entity.
+	// It exists in this package in order to avoid circular dependency with the "accountapplication" package.
+	ApplicationInverseTable = "account_applications"
+	// ApplicationColumn is the table column denoting the application relation/edge.
+	ApplicationColumn = "account_id"
+	// ReviewedApplicationsTable is the table that holds the reviewed_applications relation/edge.
+	ReviewedApplicationsTable = "account_applications"
+	// ReviewedApplicationsInverseTable is the table name for the AccountApplication entity.
+	// It exists in this package in order to avoid circular dependency with the "accountapplication" package.
+	ReviewedApplicationsInverseTable = "account_applications"
+	// ReviewedApplicationsColumn is the table column denoting the reviewed_applications relation/edge.
+	ReviewedApplicationsColumn = "reviewed_by_id"
+	// OnboardingStepsTable is the table that holds the onboarding_steps relation/edge.
+	OnboardingStepsTable = "account_onboarding_steps"
+	// OnboardingStepsInverseTable is the table name for the AccountOnboardingStep entity.
+	// It exists in this package in order to avoid circular dependency with the "accountonboardingstep" package.
+	OnboardingStepsInverseTable = "account_onboarding_steps"
+	// OnboardingStepsColumn is the table column denoting the onboarding_steps relation/edge.
+	OnboardingStepsColumn = "account_id"
+	// PolicyAcceptancesTable is the table that holds the policy_acceptances relation/edge.
+	PolicyAcceptancesTable = "policy_acceptances"
+	// PolicyAcceptancesInverseTable is the table name for the PolicyAcceptance entity.
+	// It exists in this package in order to avoid circular dependency with the "policyacceptance" package.
+	PolicyAcceptancesInverseTable = "policy_acceptances"
+	// PolicyAcceptancesColumn is the table column denoting the policy_acceptances relation/edge.
+	PolicyAcceptancesColumn = "account_id"
+	// BookmarksTable is the table that holds the bookmarks relation/edge.
+	BookmarksTable = "bookmarks"
+	// BookmarksInverseTable is the table name for the Bookmark entity.
+	// It exists in this package in order to avoid circular dependency with the "bookmark" package.
+	BookmarksInverseTable = "bookmarks"
+	// BookmarksColumn is the table column denoting the bookmarks relation/edge.
+	BookmarksColumn = "account_id"
+	// ReadingHistoryTable is the table that holds the reading_history relation/edge.
+	ReadingHistoryTable = "reading_histories"
+	// ReadingHistoryInverseTable is the table name for the ReadingHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "readinghistory" package.
+	ReadingHistoryInverseTable = "reading_histories"
+	// ReadingHistoryColumn is the table column denoting the reading_history relation/edge.
+	ReadingHistoryColumn = "account_id"
+	// VerificationRequestsTable is the table that holds the verification_requests relation/edge.
+	VerificationRequestsTable = "verification_requests"
+	// VerificationRequestsInverseTable is the table name for the VerificationRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "verificationrequest" package.
+	VerificationRequestsInverseTable = "verification_requests"
+	// VerificationRequestsColumn is the table column denoting the verification_requests relation/edge.
+	VerificationRequestsColumn = "account_id"
+	// ReviewedVerificationRequestsTable is the table that holds the reviewed_verification_requests relation/edge.
+	ReviewedVerificationRequestsTable = "verification_requests"
+	// ReviewedVerificationRequestsInverseTable is the table name for the VerificationRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "verificationrequest" package.
+	ReviewedVerificationRequestsInverseTable = "verification_requests"
+	// ReviewedVerificationRequestsColumn is the table column denoting the reviewed_verification_requests relation/edge.
+	ReviewedVerificationRequestsColumn = "reviewed_by_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "account_id"
 	// AccountRolesTable is the table that holds the account_roles relation/edge.
 	AccountRolesTable = "account_roles"
 	// AccountRolesInverseTable is the table name for the AccountRoles entity.
@@ -269,8 +556,15 @@ var Columns = []string{
 	FieldKind,
 	FieldAdmin,
 	FieldLinks,
+	FieldPinnedItems,
 	FieldMetadata,
+	FieldLocale,
+	FieldTimezone,
+	FieldReadingHistoryEnabled,
+	FieldHiddenActivity,
+	FieldVerification,
 	FieldInvitedByID,
+	FieldOwnerID,
 }
 
 var (
@@ -305,6 +599,8 @@ var (
 	NameValidator func(string) error
 	// DefaultAdmin holds the default value on creation for the "admin" field.
 	DefaultAdmin bool
+	// DefaultReadingHistoryEnabled holds the default value on creation for the "reading_history_enabled" field.
+	DefaultReadingHistoryEnabled bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() xid.ID
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -390,11 +686,36 @@ func ByAdmin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdmin, opts...).ToFunc()
 }
 
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByReadingHistoryEnabled orders the results by the reading_history_enabled field.
+func ByReadingHistoryEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadingHistoryEnabled, opts...).ToFunc()
+}
+
+// ByVerification orders the results by the verification field.
+func ByVerification(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerification, opts...).ToFunc()
+}
+
 // ByInvitedByID orders the results by the invited_by_id field.
 func ByInvitedByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInvitedByID, opts...).ToFunc()
 }
 
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
 // BySessionsCount orders the results by sessions count.
 func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -479,6 +800,90 @@ func ByFollowedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByBlocksCount orders the results by blocks count.
+func ByBlocksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlocksStep(), opts...)
+	}
+}
+
+// ByBlocks orders the results by blocks terms.
+func ByBlocks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlocksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagFollowsCount orders the results by tag_follows count.
+func ByTagFollowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagFollowsStep(), opts...)
+	}
+}
+
+// ByTagFollows orders the results by tag_follows terms.
+func ByTagFollows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagFollowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCategoryFollowsCount orders the results by category_follows count.
+func ByCategoryFollowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCategoryFollowsStep(), opts...)
+	}
+}
+
+// ByCategoryFollows orders the results by category_follows terms.
+func ByCategoryFollows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryFollowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReputationCount orders the results by reputation count.
+func ByReputationCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReputationStep(), opts...)
+	}
+}
+
+// ByReputation orders the results by reputation terms.
+func ByReputation(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReputationStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBadgesCount orders the results by badges count.
+func ByBadgesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBadgesStep(), opts...)
+	}
+}
+
+// ByBadges orders the results by badges terms.
+func ByBadges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBadgesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByInvitationsCount orders the results by invitations count.
 func ByInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -500,6 +905,27 @@ func ByInvitedByField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBotsCount orders the results by bots count.
+func ByBotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBotsStep(), opts...)
+	}
+}
+
+// ByBots orders the results by bots terms.
+func ByBots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -682,6 +1108,76 @@ func ByPostReads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByNotificationPreferencesCount orders the results by notification_preferences count.
+func ByNotificationPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationPreferencesStep(), opts...)
+	}
+}
+
+// ByNotificationPreferences orders the results by notification_preferences terms.
+func ByNotificationPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPushSubscriptionsCount orders the results by push_subscriptions count.
+func ByPushSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPushSubscriptionsStep(), opts...)
+	}
+}
+
+// ByPushSubscriptions orders the results by push_subscriptions terms.
+func ByPushSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPushSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDigestSubscriptionField orders the results by digest_subscription field.
+func ByDigestSubscriptionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDigestSubscriptionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFeedTokenField orders the results by feed_token field.
+func ByFeedTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFeedTokenStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAPIUsageCount orders the results by api_usage count.
+func ByAPIUsageCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPIUsageStep(), opts...)
+	}
+}
+
+// ByAPIUsage orders the results by api_usage terms.
+func ByAPIUsage(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIUsageStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContentViewsCount orders the results by content_views count.
+func ByContentViewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContentViewsStep(), opts...)
+	}
+}
+
+// ByContentViews orders the results by content_views terms.
+func ByContentViews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentViewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByReportsCount orders the results by reports count.
 func ByReportsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -710,6 +1206,237 @@ func ByHandledReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByModerationNotesCount orders the results by moderation_notes count.
+func ByModerationNotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModerationNotesStep(), opts...)
+	}
+}
+
+// ByModerationNotes orders the results by moderation_notes terms.
+func ByModerationNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationNotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuthoredModerationNotesCount orders the results by authored_moderation_notes count.
+func ByAuthoredModerationNotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuthoredModerationNotesStep(), opts...)
+	}
+}
+
+// ByAuthoredModerationNotes orders the results by authored_moderation_notes terms.
+func ByAuthoredModerationNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuthoredModerationNotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWarningsCount orders the results by warnings count.
+func ByWarningsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWarningsStep(), opts...)
+	}
+}
+
+// ByWarnings orders the results by warnings terms.
+func ByWarnings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarningsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIssuedWarningsCount orders the results by issued_warnings count.
+func ByIssuedWarningsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIssuedWarningsStep(), opts...)
+	}
+}
+
+// ByIssuedWarnings orders the results by issued_warnings terms.
+func ByIssuedWarnings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIssuedWarningsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUploadSessionsCount orders the results by upload_sessions count.
+func ByUploadSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUploadSessionsStep(), opts...)
+	}
+}
+
+// ByUploadSessions orders the results by upload_sessions terms.
+func ByUploadSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUploadSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConversationsCount orders the results by conversations count.
+func ByConversationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConversationsStep(), opts...)
+	}
+}
+
+// ByConversations orders the results by conversations terms.
+func ByConversations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDirectMessagesCount orders the results by direct_messages count.
+func ByDirectMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDirectMessagesStep(), opts...)
+	}
+}
+
+// ByDirectMessages orders the results by direct_messages terms.
+func ByDirectMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDirectMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySpacesCount orders the results by spaces count.
+func BySpacesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSpacesStep(), opts...)
+	}
+}
+
+// BySpaces orders the results by spaces terms.
+func BySpaces(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSpacesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByApplicationField orders the results by application field.
+func ByApplicationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newApplicationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReviewedApplicationsCount orders the results by reviewed_applications count.
+func ByReviewedApplicationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewedApplicationsStep(), opts...)
+	}
+}
+
+// ByReviewedApplications orders the results by reviewed_applications terms.
+func ByReviewedApplications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewedApplicationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOnboardingStepsCount orders the results by onboarding_steps count.
+func ByOnboardingStepsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOnboardingStepsStep(), opts...)
+	}
+}
+
+// ByOnboardingSteps orders the results by onboarding_steps terms.
+func ByOnboardingSteps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOnboardingStepsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPolicyAcceptancesCount orders the results by policy_acceptances count.
+func ByPolicyAcceptancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPolicyAcceptancesStep(), opts...)
+	}
+}
+
+// ByPolicyAcceptances orders the results by policy_acceptances terms.
+func ByPolicyAcceptances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPolicyAcceptancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBookmarksCount orders the results by bookmarks count.
+func ByBookmarksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBookmarksStep(), opts...)
+	}
+}
+
+// ByBookmarks orders the results by bookmarks terms.
+func ByBookmarks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookmarksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReadingHistoryCount orders the results by reading_history count.
+func ByReadingHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReadingHistoryStep(), opts...)
+	}
+}
+
+// ByReadingHistory orders the results by reading_history terms.
+func ByReadingHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReadingHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVerificationRequestsCount orders the results by verification_requests count.
+func ByVerificationRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVerificationRequestsStep(), opts...)
+	}
+}
+
+// ByVerificationRequests orders the results by verification_requests terms.
+func ByVerificationRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVerificationRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewedVerificationRequestsCount orders the results by reviewed_verification_requests count.
+func ByReviewedVerificationRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewedVerificationRequestsStep(), opts...)
+	}
+}
+
+// ByReviewedVerificationRequests orders the results by reviewed_verification_requests terms.
+func ByReviewedVerificationRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewedVerificationRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAccountRolesCount orders the results by account_roles count.
 func ByAccountRolesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -765,6 +1492,48 @@ func newFollowedByStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FollowedByTable, FollowedByColumn),
 	)
 }
+func newBlocksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlocksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockedByTable, BlockedByColumn),
+	)
+}
+func newTagFollowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagFollowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagFollowsTable, TagFollowsColumn),
+	)
+}
+func newCategoryFollowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryFollowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CategoryFollowsTable, CategoryFollowsColumn),
+	)
+}
+func newReputationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReputationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReputationTable, ReputationColumn),
+	)
+}
+func newBadgesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BadgesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BadgesTable, BadgesColumn),
+	)
+}
 func newInvitationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -779,6 +1548,20 @@ func newInvitedByStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, InvitedByTable, InvitedByColumn),
 	)
 }
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
+func newBotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BotsTable, BotsColumn),
+	)
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -870,6 +1653,48 @@ func newPostReadsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PostReadsTable, PostReadsColumn),
 	)
 }
+func newNotificationPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+	)
+}
+func newPushSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PushSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PushSubscriptionsTable, PushSubscriptionsColumn),
+	)
+}
+func newDigestSubscriptionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DigestSubscriptionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, DigestSubscriptionTable, DigestSubscriptionColumn),
+	)
+}
+func newFeedTokenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FeedTokenInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, FeedTokenTable, FeedTokenColumn),
+	)
+}
+func newAPIUsageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APIUsageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIUsageTable, APIUsageColumn),
+	)
+}
+func newContentViewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentViewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContentViewsTable, ContentViewsColumn),
+	)
+}
 func newReportsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -884,6 +1709,125 @@ func newHandledReportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, HandledReportsTable, HandledReportsColumn),
 	)
 }
+func newModerationNotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationNotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModerationNotesTable, ModerationNotesColumn),
+	)
+}
+func newAuthoredModerationNotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuthoredModerationNotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuthoredModerationNotesTable, AuthoredModerationNotesColumn),
+	)
+}
+func newWarningsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarningsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WarningsTable, WarningsColumn),
+	)
+}
+func newIssuedWarningsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IssuedWarningsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IssuedWarningsTable, IssuedWarningsColumn),
+	)
+}
+func newUploadSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UploadSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UploadSessionsTable, UploadSessionsColumn),
+	)
+}
+func newConversationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConversationsTable, ConversationsColumn),
+	)
+}
+func newDirectMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DirectMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DirectMessagesTable, DirectMessagesColumn),
+	)
+}
+func newSpacesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SpacesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SpacesTable, SpacesColumn),
+	)
+}
+func newApplicationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ApplicationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ApplicationTable, ApplicationColumn),
+	)
+}
+func newReviewedApplicationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewedApplicationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewedApplicationsTable, ReviewedApplicationsColumn),
+	)
+}
+func newOnboardingStepsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OnboardingStepsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OnboardingStepsTable, OnboardingStepsColumn),
+	)
+}
+func newPolicyAcceptancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PolicyAcceptancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PolicyAcceptancesTable, PolicyAcceptancesColumn),
+	)
+}
+func newBookmarksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookmarksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BookmarksTable, BookmarksColumn),
+	)
+}
+func newReadingHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReadingHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReadingHistoryTable, ReadingHistoryColumn),
+	)
+}
+func newVerificationRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VerificationRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VerificationRequestsTable, VerificationRequestsColumn),
+	)
+}
+func newReviewedVerificationRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewedVerificationRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewedVerificationRequestsTable, ReviewedVerificationRequestsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
 func newAccountRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -96,11 +96,36 @@ func Admin(v bool) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldAdmin, v))
 }
 
+// Locale applies equality check predicate on the "locale" field. It's identical to LocaleEQ.
+func Locale(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldLocale, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldTimezone, v))
+}
+
+// ReadingHistoryEnabled applies equality check predicate on the "reading_history_enabled" field. It's identical to ReadingHistoryEnabledEQ.
+func ReadingHistoryEnabled(v bool) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldReadingHistoryEnabled, v))
+}
+
+// Verification applies equality check predicate on the "verification" field. It's identical to VerificationEQ.
+func Verification(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldVerification, v))
+}
+
 // InvitedByID applies equality check predicate on the "invited_by_id" field. It's identical to InvitedByIDEQ.
 func InvitedByID(v xid.ID) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldInvitedByID, v))
 }
 
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldOwnerID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -526,6 +551,16 @@ func LinksNotNil() predicate.Account {
 	return predicate.Account(sql.FieldNotNull(FieldLinks))
 }
 
+// PinnedItemsIsNil applies the IsNil predicate on the "pinned_items" field.
+func PinnedItemsIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldPinnedItems))
+}
+
+// PinnedItemsNotNil applies the NotNil predicate on the "pinned_items" field.
+func PinnedItemsNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldPinnedItems))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Account {
 	return predicate.Account(sql.FieldIsNull(FieldMetadata))
@@ -536,6 +571,251 @@ func MetadataNotNil() predicate.Account {
 	return predicate.Account(sql.FieldNotNull(FieldMetadata))
 }
 
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// LocaleGT applies the GT predicate on the "locale" field.
+func LocaleGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldLocale, v))
+}
+
+// LocaleGTE applies the GTE predicate on the "locale" field.
+func LocaleGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldLocale, v))
+}
+
+// LocaleLT applies the LT predicate on the "locale" field.
+func LocaleLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldLocale, v))
+}
+
+// LocaleLTE applies the LTE predicate on the "locale" field.
+func LocaleLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldLocale, v))
+}
+
+// LocaleContains applies the Contains predicate on the "locale" field.
+func LocaleContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldLocale, v))
+}
+
+// LocaleHasPrefix applies the HasPrefix predicate on the "locale" field.
+func LocaleHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldLocale, v))
+}
+
+// LocaleHasSuffix applies the HasSuffix predicate on the "locale" field.
+func LocaleHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldLocale, v))
+}
+
+// LocaleIsNil applies the IsNil predicate on the "locale" field.
+func LocaleIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldLocale))
+}
+
+// LocaleNotNil applies the NotNil predicate on the "locale" field.
+func LocaleNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldLocale))
+}
+
+// LocaleEqualFold applies the EqualFold predicate on the "locale" field.
+func LocaleEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldLocale, v))
+}
+
+// LocaleContainsFold applies the ContainsFold predicate on the "locale" field.
+func LocaleContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldLocale, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// ReadingHistoryEnabledEQ applies the EQ predicate on the "reading_history_enabled" field.
+func ReadingHistoryEnabledEQ(v bool) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldReadingHistoryEnabled, v))
+}
+
+// ReadingHistoryEnabledNEQ applies the NEQ predicate on the "reading_history_enabled" field.
+func ReadingHistoryEnabledNEQ(v bool) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldReadingHistoryEnabled, v))
+}
+
+// HiddenActivityIsNil applies the IsNil predicate on the "hidden_activity" field.
+func HiddenActivityIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldHiddenActivity))
+}
+
+// HiddenActivityNotNil applies the NotNil predicate on the "hidden_activity" field.
+func HiddenActivityNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldHiddenActivity))
+}
+
+// VerificationEQ applies the EQ predicate on the "verification" field.
+func VerificationEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldVerification, v))
+}
+
+// VerificationNEQ applies the NEQ predicate on the "verification" field.
+func VerificationNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldVerification, v))
+}
+
+// VerificationIn applies the In predicate on the "verification" field.
+func VerificationIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldVerification, vs...))
+}
+
+// VerificationNotIn applies the NotIn predicate on the "verification" field.
+func VerificationNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldVerification, vs...))
+}
+
+// VerificationGT applies the GT predicate on the "verification" field.
+func VerificationGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldVerification, v))
+}
+
+// VerificationGTE applies the GTE predicate on the "verification" field.
+func VerificationGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldVerification, v))
+}
+
+// VerificationLT applies the LT predicate on the "verification" field.
+func VerificationLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldVerification, v))
+}
+
+// VerificationLTE applies the LTE predicate on the "verification" field.
+func VerificationLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldVerification, v))
+}
+
+// VerificationContains applies the Contains predicate on the "verification" field.
+func VerificationContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldVerification, v))
+}
+
+// VerificationHasPrefix applies the HasPrefix predicate on the "verification" field.
+func VerificationHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldVerification, v))
+}
+
+// VerificationHasSuffix applies the HasSuffix predicate on the "verification" field.
+func VerificationHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldVerification, v))
+}
+
+// VerificationIsNil applies the IsNil predicate on the "verification" field.
+func VerificationIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldVerification))
+}
+
+// VerificationNotNil applies the NotNil predicate on the "verification" field.
+func VerificationNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldVerification))
+}
+
+// VerificationEqualFold applies the EqualFold predicate on the "verification" field.
+func VerificationEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldVerification, v))
+}
+
+// VerificationContainsFold applies the ContainsFold predicate on the "verification" field.
+func VerificationContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldVerification, v))
+}
+
 // InvitedByIDEQ applies the EQ predicate on the "invited_by_id" field.
 func InvitedByIDEQ(v xid.ID) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldInvitedByID, v))
@@ -616,6 +896,86 @@ func InvitedByIDContainsFold(v xid.ID) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldInvitedByID, vc))
 }
 
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldContains(FieldOwnerID, vc))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldHasPrefix(FieldOwnerID, vc))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldHasSuffix(FieldOwnerID, vc))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldEqualFold(FieldOwnerID, vc))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldContainsFold(FieldOwnerID, vc))
+}
+
 // HasSessions applies the HasEdge predicate on the "sessions" edge.
 func HasSessions() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -754,21 +1114,21 @@ func HasFollowedByWith(preds ...predicate.AccountFollow) predicate.Account {
 	})
 }
 
-// HasInvitations applies the HasEdge predicate on the "invitations" edge.
-func HasInvitations() predicate.Account {
+// HasBlocks applies the HasEdge predicate on the "blocks" edge.
+func HasBlocks() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
-func HasInvitationsWith(preds ...predicate.Invitation) predicate.Account {
+// HasBlocksWith applies the HasEdge predicate on the "blocks" edge with a given conditions (other predicates).
+func HasBlocksWith(preds ...predicate.AccountBlock) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newInvitationsStep()
+		step := newBlocksStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -777,21 +1137,21 @@ func HasInvitationsWith(preds ...predicate.Invitation) predicate.Account {
 	})
 }
 
-// HasInvitedBy applies the HasEdge predicate on the "invited_by" edge.
-func HasInvitedBy() predicate.Account {
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, InvitedByTable, InvitedByColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlockedByTable, BlockedByColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasInvitedByWith applies the HasEdge predicate on the "invited_by" edge with a given conditions (other predicates).
-func HasInvitedByWith(preds ...predicate.Invitation) predicate.Account {
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.AccountBlock) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newInvitedByStep()
+		step := newBlockedByStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -800,21 +1160,21 @@ func HasInvitedByWith(preds ...predicate.Invitation) predicate.Account {
 	})
 }
 
-// HasPosts applies the HasEdge predicate on the "posts" edge.
-func HasPosts() predicate.Account {
+// HasTagFollows applies the HasEdge predicate on the "tag_follows" edge.
+func HasTagFollows() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, PostsTable, PostsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagFollowsTable, TagFollowsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasPostsWith applies the HasEdge predicate on the "posts" edge with a given conditions (other predicates).
-func HasPostsWith(preds ...predicate.Post) predicate.Account {
+// HasTagFollowsWith applies the HasEdge predicate on the "tag_follows" edge with a given conditions (other predicates).
+func HasTagFollowsWith(preds ...predicate.TagFollow) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newPostsStep()
+		step := newTagFollowsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -823,21 +1183,21 @@ func HasPostsWith(preds ...predicate.Post) predicate.Account {
 	})
 }
 
-// HasQuestions applies the HasEdge predicate on the "questions" edge.
-func HasQuestions() predicate.Account {
+// HasCategoryFollows applies the HasEdge predicate on the "category_follows" edge.
+func HasCategoryFollows() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, QuestionsTable, QuestionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, CategoryFollowsTable, CategoryFollowsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasQuestionsWith applies the HasEdge predicate on the "questions" edge with a given conditions (other predicates).
-func HasQuestionsWith(preds ...predicate.Question) predicate.Account {
+// HasCategoryFollowsWith applies the HasEdge predicate on the "category_follows" edge with a given conditions (other predicates).
+func HasCategoryFollowsWith(preds ...predicate.CategoryFollow) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newQuestionsStep()
+		step := newCategoryFollowsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -846,21 +1206,21 @@ func HasQuestionsWith(preds ...predicate.Question) predicate.Account {
 	})
 }
 
-// HasReacts applies the HasEdge predicate on the "reacts" edge.
-func HasReacts() predicate.Account {
+// HasReputation applies the HasEdge predicate on the "reputation" edge.
+func HasReputation() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ReactsTable, ReactsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReputationTable, ReputationColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasReactsWith applies the HasEdge predicate on the "reacts" edge with a given conditions (other predicates).
-func HasReactsWith(preds ...predicate.React) predicate.Account {
+// HasReputationWith applies the HasEdge predicate on the "reputation" edge with a given conditions (other predicates).
+func HasReputationWith(preds ...predicate.ReputationEntry) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newReactsStep()
+		step := newReputationStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -869,21 +1229,21 @@ func HasReactsWith(preds ...predicate.React) predicate.Account {
 	})
 }
 
-// HasLikes applies the HasEdge predicate on the "likes" edge.
-func HasLikes() predicate.Account {
+// HasBadges applies the HasEdge predicate on the "badges" edge.
+func HasBadges() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, LikesTable, LikesColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, BadgesTable, BadgesColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasLikesWith applies the HasEdge predicate on the "likes" edge with a given conditions (other predicates).
-func HasLikesWith(preds ...predicate.LikePost) predicate.Account {
+// HasBadgesWith applies the HasEdge predicate on the "badges" edge with a given conditions (other predicates).
+func HasBadgesWith(preds ...predicate.AccountBadge) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newLikesStep()
+		step := newBadgesStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -892,21 +1252,21 @@ func HasLikesWith(preds ...predicate.LikePost) predicate.Account {
 	})
 }
 
-// HasMentions applies the HasEdge predicate on the "mentions" edge.
-func HasMentions() predicate.Account {
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, MentionsTable, MentionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasMentionsWith applies the HasEdge predicate on the "mentions" edge with a given conditions (other predicates).
-func HasMentionsWith(preds ...predicate.MentionProfile) predicate.Account {
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.Invitation) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newMentionsStep()
+		step := newInvitationsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -915,21 +1275,21 @@ func HasMentionsWith(preds ...predicate.MentionProfile) predicate.Account {
 	})
 }
 
-// HasRoles applies the HasEdge predicate on the "roles" edge.
-func HasRoles() predicate.Account {
+// HasInvitedBy applies the HasEdge predicate on the "invited_by" edge.
+func HasInvitedBy() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvitedByTable, InvitedByColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
-func HasRolesWith(preds ...predicate.Role) predicate.Account {
+// HasInvitedByWith applies the HasEdge predicate on the "invited_by" edge with a given conditions (other predicates).
+func HasInvitedByWith(preds ...predicate.Invitation) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newRolesStep()
+		step := newInvitedByStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -938,21 +1298,21 @@ func HasRolesWith(preds ...predicate.Role) predicate.Account {
 	})
 }
 
-// HasAuthentication applies the HasEdge predicate on the "authentication" edge.
-func HasAuthentication() predicate.Account {
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, AuthenticationTable, AuthenticationColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasAuthenticationWith applies the HasEdge predicate on the "authentication" edge with a given conditions (other predicates).
-func HasAuthenticationWith(preds ...predicate.Authentication) predicate.Account {
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.Account) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newAuthenticationStep()
+		step := newOwnerStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -961,21 +1321,21 @@ func HasAuthenticationWith(preds ...predicate.Authentication) predicate.Account
 	})
 }
 
-// HasTags applies the HasEdge predicate on the "tags" edge.
-func HasTags() predicate.Account {
+// HasBots applies the HasEdge predicate on the "bots" edge.
+func HasBots() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.O2M, false, BotsTable, BotsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
-func HasTagsWith(preds ...predicate.Tag) predicate.Account {
+// HasBotsWith applies the HasEdge predicate on the "bots" edge with a given conditions (other predicates).
+func HasBotsWith(preds ...predicate.Account) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newTagsStep()
+		step := newBotsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -984,21 +1344,21 @@ func HasTagsWith(preds ...predicate.Tag) predicate.Account {
 	})
 }
 
-// HasCollections applies the HasEdge predicate on the "collections" edge.
-func HasCollections() predicate.Account {
+// HasPosts applies the HasEdge predicate on the "posts" edge.
+func HasPosts() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, CollectionsTable, CollectionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, PostsTable, PostsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasCollectionsWith applies the HasEdge predicate on the "collections" edge with a given conditions (other predicates).
-func HasCollectionsWith(preds ...predicate.Collection) predicate.Account {
+// HasPostsWith applies the HasEdge predicate on the "posts" edge with a given conditions (other predicates).
+func HasPostsWith(preds ...predicate.Post) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newCollectionsStep()
+		step := newPostsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -1007,21 +1367,21 @@ func HasCollectionsWith(preds ...predicate.Collection) predicate.Account {
 	})
 }
 
-// HasNodes applies the HasEdge predicate on the "nodes" edge.
-func HasNodes() predicate.Account {
+// HasQuestions applies the HasEdge predicate on the "questions" edge.
+func HasQuestions() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, NodesTable, NodesColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuestionsTable, QuestionsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasNodesWith applies the HasEdge predicate on the "nodes" edge with a given conditions (other predicates).
-func HasNodesWith(preds ...predicate.Node) predicate.Account {
+// HasQuestionsWith applies the HasEdge predicate on the "questions" edge with a given conditions (other predicates).
+func HasQuestionsWith(preds ...predicate.Question) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newNodesStep()
+		step := newQuestionsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -1030,21 +1390,21 @@ func HasNodesWith(preds ...predicate.Node) predicate.Account {
 	})
 }
 
-// HasAssets applies the HasEdge predicate on the "assets" edge.
-func HasAssets() predicate.Account {
+// HasReacts applies the HasEdge predicate on the "reacts" edge.
+func HasReacts() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, AssetsTable, AssetsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReactsTable, ReactsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasAssetsWith applies the HasEdge predicate on the "assets" edge with a given conditions (other predicates).
-func HasAssetsWith(preds ...predicate.Asset) predicate.Account {
+// HasReactsWith applies the HasEdge predicate on the "reacts" edge with a given conditions (other predicates).
+func HasReactsWith(preds ...predicate.React) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newAssetsStep()
+		step := newReactsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -1053,7 +1413,191 @@ func HasAssetsWith(preds ...predicate.Asset) predicate.Account {
 	})
 }
 
-// HasEvents applies the HasEdge predicate on the "events" edge.
+// HasLikes applies the HasEdge predicate on the "likes" edge.
+func HasLikes() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LikesTable, LikesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLikesWith applies the HasEdge predicate on the "likes" edge with a given conditions (other predicates).
+func HasLikesWith(preds ...predicate.LikePost) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newLikesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentions applies the HasEdge predicate on the "mentions" edge.
+func HasMentions() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MentionsTable, MentionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionsWith applies the HasEdge predicate on the "mentions" edge with a given conditions (other predicates).
+func HasMentionsWith(preds ...predicate.MentionProfile) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.Role) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuthentication applies the HasEdge predicate on the "authentication" edge.
+func HasAuthentication() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuthenticationTable, AuthenticationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthenticationWith applies the HasEdge predicate on the "authentication" edge with a given conditions (other predicates).
+func HasAuthenticationWith(preds ...predicate.Authentication) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAuthenticationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCollections applies the HasEdge predicate on the "collections" edge.
+func HasCollections() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CollectionsTable, CollectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionsWith applies the HasEdge predicate on the "collections" edge with a given conditions (other predicates).
+func HasCollectionsWith(preds ...predicate.Collection) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newCollectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNodes applies the HasEdge predicate on the "nodes" edge.
+func HasNodes() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NodesTable, NodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNodesWith applies the HasEdge predicate on the "nodes" edge with a given conditions (other predicates).
+func HasNodesWith(preds ...predicate.Node) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newNodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssets applies the HasEdge predicate on the "assets" edge.
+func HasAssets() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssetsTable, AssetsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssetsWith applies the HasEdge predicate on the "assets" edge with a given conditions (other predicates).
+func HasAssetsWith(preds ...predicate.Asset) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAssetsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
 func HasEvents() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
@@ -1099,21 +1643,21 @@ func HasPostReadsWith(preds ...predicate.PostRead) predicate.Account {
 	})
 }
 
-// HasReports applies the HasEdge predicate on the "reports" edge.
-func HasReports() predicate.Account {
+// HasNotificationPreferences applies the HasEdge predicate on the "notification_preferences" edge.
+func HasNotificationPreferences() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ReportsTable, ReportsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasReportsWith applies the HasEdge predicate on the "reports" edge with a given conditions (other predicates).
-func HasReportsWith(preds ...predicate.Report) predicate.Account {
+// HasNotificationPreferencesWith applies the HasEdge predicate on the "notification_preferences" edge with a given conditions (other predicates).
+func HasNotificationPreferencesWith(preds ...predicate.NotificationPreference) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newReportsStep()
+		step := newNotificationPreferencesStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -1122,21 +1666,550 @@ func HasReportsWith(preds ...predicate.Report) predicate.Account {
 	})
 }
 
-// HasHandledReports applies the HasEdge predicate on the "handled_reports" edge.
-func HasHandledReports() predicate.Account {
+// HasPushSubscriptions applies the HasEdge predicate on the "push_subscriptions" edge.
+func HasPushSubscriptions() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, HandledReportsTable, HandledReportsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, PushSubscriptionsTable, PushSubscriptionsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasHandledReportsWith applies the HasEdge predicate on the "handled_reports" edge with a given conditions (other predicates).
-func HasHandledReportsWith(preds ...predicate.Report) predicate.Account {
+// HasPushSubscriptionsWith applies the HasEdge predicate on the "push_subscriptions" edge with a given conditions (other predicates).
+func HasPushSubscriptionsWith(preds ...predicate.PushSubscription) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
-		step := newHandledReportsStep()
+		step := newPushSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDigestSubscription applies the HasEdge predicate on the "digest_subscription" edge.
+func HasDigestSubscription() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, DigestSubscriptionTable, DigestSubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDigestSubscriptionWith applies the HasEdge predicate on the "digest_subscription" edge with a given conditions (other predicates).
+func HasDigestSubscriptionWith(preds ...predicate.DigestSubscription) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newDigestSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFeedToken applies the HasEdge predicate on the "feed_token" edge.
+func HasFeedToken() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, FeedTokenTable, FeedTokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeedTokenWith applies the HasEdge predicate on the "feed_token" edge with a given conditions (other predicates).
+func HasFeedTokenWith(preds ...predicate.FeedToken) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newFeedTokenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAPIUsage applies the HasEdge predicate on the "api_usage" edge.
+func HasAPIUsage() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIUsageTable, APIUsageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPIUsageWith applies the HasEdge predicate on the "api_usage" edge with a given conditions (other predicates).
+func HasAPIUsageWith(preds ...predicate.APIUsage) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAPIUsageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContentViews applies the HasEdge predicate on the "content_views" edge.
+func HasContentViews() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContentViewsTable, ContentViewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentViewsWith applies the HasEdge predicate on the "content_views" edge with a given conditions (other predicates).
+func HasContentViewsWith(preds ...predicate.ContentView) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newContentViewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReports applies the HasEdge predicate on the "reports" edge.
+func HasReports() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReportsTable, ReportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportsWith applies the HasEdge predicate on the "reports" edge with a given conditions (other predicates).
+func HasReportsWith(preds ...predicate.Report) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newReportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHandledReports applies the HasEdge predicate on the "handled_reports" edge.
+func HasHandledReports() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HandledReportsTable, HandledReportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHandledReportsWith applies the HasEdge predicate on the "handled_reports" edge with a given conditions (other predicates).
+func HasHandledReportsWith(preds ...predicate.Report) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newHandledReportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModerationNotes applies the HasEdge predicate on the "moderation_notes" edge.
+func HasModerationNotes() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ModerationNotesTable, ModerationNotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModerationNotesWith applies the HasEdge predicate on the "moderation_notes" edge with a given conditions (other predicates).
+func HasModerationNotesWith(preds ...predicate.ModerationNote) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newModerationNotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuthoredModerationNotes applies the HasEdge predicate on the "authored_moderation_notes" edge.
+func HasAuthoredModerationNotes() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuthoredModerationNotesTable, AuthoredModerationNotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthoredModerationNotesWith applies the HasEdge predicate on the "authored_moderation_notes" edge with a given conditions (other predicates).
+func HasAuthoredModerationNotesWith(preds ...predicate.ModerationNote) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAuthoredModerationNotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWarnings applies the HasEdge predicate on the "warnings" edge.
+func HasWarnings() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WarningsTable, WarningsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarningsWith applies the HasEdge predicate on the "warnings" edge with a given conditions (other predicates).
+func HasWarningsWith(preds ...predicate.Warning) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newWarningsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIssuedWarnings applies the HasEdge predicate on the "issued_warnings" edge.
+func HasIssuedWarnings() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IssuedWarningsTable, IssuedWarningsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIssuedWarningsWith applies the HasEdge predicate on the "issued_warnings" edge with a given conditions (other predicates).
+func HasIssuedWarningsWith(preds ...predicate.Warning) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newIssuedWarningsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUploadSessions applies the HasEdge predicate on the "upload_sessions" edge.
+func HasUploadSessions() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UploadSessionsTable, UploadSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUploadSessionsWith applies the HasEdge predicate on the "upload_sessions" edge with a given conditions (other predicates).
+func HasUploadSessionsWith(preds ...predicate.UploadSession) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newUploadSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConversations applies the HasEdge predicate on the "conversations" edge.
+func HasConversations() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConversationsTable, ConversationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationsWith applies the HasEdge predicate on the "conversations" edge with a given conditions (other predicates).
+func HasConversationsWith(preds ...predicate.ConversationParticipant) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newConversationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDirectMessages applies the HasEdge predicate on the "direct_messages" edge.
+func HasDirectMessages() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DirectMessagesTable, DirectMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDirectMessagesWith applies the HasEdge predicate on the "direct_messages" edge with a given conditions (other predicates).
+func HasDirectMessagesWith(preds ...predicate.DirectMessage) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newDirectMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSpaces applies the HasEdge predicate on the "spaces" edge.
+func HasSpaces() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SpacesTable, SpacesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSpacesWith applies the HasEdge predicate on the "spaces" edge with a given conditions (other predicates).
+func HasSpacesWith(preds ...predicate.SpaceMember) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newSpacesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasApplication applies the HasEdge predicate on the "application" edge.
+func HasApplication() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ApplicationTable, ApplicationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApplicationWith applies the HasEdge predicate on the "application" edge with a given conditions (other predicates).
+func HasApplicationWith(preds ...predicate.AccountApplication) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newApplicationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewedApplications applies the HasEdge predicate on the "reviewed_applications" edge.
+func HasReviewedApplications() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewedApplicationsTable, ReviewedApplicationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewedApplicationsWith applies the HasEdge predicate on the "reviewed_applications" edge with a given conditions (other predicates).
+func HasReviewedApplicationsWith(preds ...predicate.AccountApplication) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newReviewedApplicationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOnboardingSteps applies the HasEdge predicate on the "onboarding_steps" edge.
+func HasOnboardingSteps() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OnboardingStepsTable, OnboardingStepsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOnboardingStepsWith applies the HasEdge predicate on the "onboarding_steps" edge with a given conditions (other predicates).
+func HasOnboardingStepsWith(preds ...predicate.AccountOnboardingStep) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newOnboardingStepsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPolicyAcceptances applies the HasEdge predicate on the "policy_acceptances" edge.
+func HasPolicyAcceptances() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PolicyAcceptancesTable, PolicyAcceptancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPolicyAcceptancesWith applies the HasEdge predicate on the "policy_acceptances" edge with a given conditions (other predicates).
+func HasPolicyAcceptancesWith(preds ...predicate.PolicyAcceptance) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newPolicyAcceptancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBookmarks applies the HasEdge predicate on the "bookmarks" edge.
+func HasBookmarks() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookmarksTable, BookmarksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookmarksWith applies the HasEdge predicate on the "bookmarks" edge with a given conditions (other predicates).
+func HasBookmarksWith(preds ...predicate.Bookmark) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newBookmarksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReadingHistory applies the HasEdge predicate on the "reading_history" edge.
+func HasReadingHistory() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReadingHistoryTable, ReadingHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReadingHistoryWith applies the HasEdge predicate on the "reading_history" edge with a given conditions (other predicates).
+func HasReadingHistoryWith(preds ...predicate.ReadingHistory) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newReadingHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVerificationRequests applies the HasEdge predicate on the "verification_requests" edge.
+func HasVerificationRequests() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VerificationRequestsTable, VerificationRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVerificationRequestsWith applies the HasEdge predicate on the "verification_requests" edge with a given conditions (other predicates).
+func HasVerificationRequestsWith(preds ...predicate.VerificationRequest) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newVerificationRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewedVerificationRequests applies the HasEdge predicate on the "reviewed_verification_requests" edge.
+func HasReviewedVerificationRequests() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewedVerificationRequestsTable, ReviewedVerificationRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewedVerificationRequestsWith applies the HasEdge predicate on the "reviewed_verification_requests" edge with a given conditions (other predicates).
+func HasReviewedVerificationRequestsWith(preds ...predicate.VerificationRequest) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newReviewedVerificationRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newWebhooksStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
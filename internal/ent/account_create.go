@@ -13,27 +13,51 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/pushsubscription"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
 	"github.com/Southclaws/storyden/internal/ent/report"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
 	"github.com/Southclaws/storyden/internal/ent/role"
 	"github.com/Southclaws/storyden/internal/ent/schema"
 	"github.com/Southclaws/storyden/internal/ent/session"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
 	"github.com/rs/xid"
 )
 
@@ -161,12 +185,80 @@ func (_c *AccountCreate) SetLinks(v []schema.ExternalLink) *AccountCreate {
 	return _c
 }
 
+// SetPinnedItems sets the "pinned_items" field.
+func (_c *AccountCreate) SetPinnedItems(v []schema.PinnedItem) *AccountCreate {
+	_c.mutation.SetPinnedItems(v)
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *AccountCreate) SetMetadata(v map[string]interface{}) *AccountCreate {
 	_c.mutation.SetMetadata(v)
 	return _c
 }
 
+// SetLocale sets the "locale" field.
+func (_c *AccountCreate) SetLocale(v string) *AccountCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableLocale(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetLocale(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *AccountCreate) SetTimezone(v string) *AccountCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableTimezone(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetReadingHistoryEnabled sets the "reading_history_enabled" field.
+func (_c *AccountCreate) SetReadingHistoryEnabled(v bool) *AccountCreate {
+	_c.mutation.SetReadingHistoryEnabled(v)
+	return _c
+}
+
+// SetNillableReadingHistoryEnabled sets the "reading_history_enabled" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableReadingHistoryEnabled(v *bool) *AccountCreate {
+	if v != nil {
+		_c.SetReadingHistoryEnabled(*v)
+	}
+	return _c
+}
+
+// SetHiddenActivity sets the "hidden_activity" field.
+func (_c *AccountCreate) SetHiddenActivity(v []string) *AccountCreate {
+	_c.mutation.SetHiddenActivity(v)
+	return _c
+}
+
+// SetVerification sets the "verification" field.
+func (_c *AccountCreate) SetVerification(v string) *AccountCreate {
+	_c.mutation.SetVerification(v)
+	return _c
+}
+
+// SetNillableVerification sets the "verification" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableVerification(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetVerification(*v)
+	}
+	return _c
+}
+
 // SetInvitedByID sets the "invited_by_id" field.
 func (_c *AccountCreate) SetInvitedByID(v xid.ID) *AccountCreate {
 	_c.mutation.SetInvitedByID(v)
@@ -181,6 +273,20 @@ func (_c *AccountCreate) SetNillableInvitedByID(v *xid.ID) *AccountCreate {
 	return _c
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (_c *AccountCreate) SetOwnerID(v xid.ID) *AccountCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableOwnerID(v *xid.ID) *AccountCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AccountCreate) SetID(v xid.ID) *AccountCreate {
 	_c.mutation.SetID(v)
@@ -285,6 +391,96 @@ func (_c *AccountCreate) AddFollowedBy(v ...*AccountFollow) *AccountCreate {
 	return _c.AddFollowedByIDs(ids...)
 }
 
+// AddBlockIDs adds the "blocks" edge to the AccountBlock entity by IDs.
+func (_c *AccountCreate) AddBlockIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddBlockIDs(ids...)
+	return _c
+}
+
+// AddBlocks adds the "blocks" edges to the AccountBlock entity.
+func (_c *AccountCreate) AddBlocks(v ...*AccountBlock) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the AccountBlock entity by IDs.
+func (_c *AccountCreate) AddBlockedByIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddBlockedByIDs(ids...)
+	return _c
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the AccountBlock entity.
+func (_c *AccountCreate) AddBlockedBy(v ...*AccountBlock) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockedByIDs(ids...)
+}
+
+// AddTagFollowIDs adds the "tag_follows" edge to the TagFollow entity by IDs.
+func (_c *AccountCreate) AddTagFollowIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddTagFollowIDs(ids...)
+	return _c
+}
+
+// AddTagFollows adds the "tag_follows" edges to the TagFollow entity.
+func (_c *AccountCreate) AddTagFollows(v ...*TagFollow) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagFollowIDs(ids...)
+}
+
+// AddCategoryFollowIDs adds the "category_follows" edge to the CategoryFollow entity by IDs.
+func (_c *AccountCreate) AddCategoryFollowIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddCategoryFollowIDs(ids...)
+	return _c
+}
+
+// AddCategoryFollows adds the "category_follows" edges to the CategoryFollow entity.
+func (_c *AccountCreate) AddCategoryFollows(v ...*CategoryFollow) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCategoryFollowIDs(ids...)
+}
+
+// AddReputationIDs adds the "reputation" edge to the ReputationEntry entity by IDs.
+func (_c *AccountCreate) AddReputationIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddReputationIDs(ids...)
+	return _c
+}
+
+// AddReputation adds the "reputation" edges to the ReputationEntry entity.
+func (_c *AccountCreate) AddReputation(v ...*ReputationEntry) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReputationIDs(ids...)
+}
+
+// AddBadgeIDs adds the "badges" edge to the AccountBadge entity by IDs.
+func (_c *AccountCreate) AddBadgeIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddBadgeIDs(ids...)
+	return _c
+}
+
+// AddBadges adds the "badges" edges to the AccountBadge entity.
+func (_c *AccountCreate) AddBadges(v ...*AccountBadge) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBadgeIDs(ids...)
+}
+
 // AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
 func (_c *AccountCreate) AddInvitationIDs(ids ...xid.ID) *AccountCreate {
 	_c.mutation.AddInvitationIDs(ids...)
@@ -305,6 +501,26 @@ func (_c *AccountCreate) SetInvitedBy(v *Invitation) *AccountCreate {
 	return _c.SetInvitedByID(v.ID)
 }
 
+// SetOwner sets the "owner" edge to the Account entity.
+func (_c *AccountCreate) SetOwner(v *Account) *AccountCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// AddBotIDs adds the "bots" edge to the Account entity by IDs.
+func (_c *AccountCreate) AddBotIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddBotIDs(ids...)
+	return _c
+}
+
+// AddBots adds the "bots" edges to the Account entity.
+func (_c *AccountCreate) AddBots(v ...*Account) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBotIDs(ids...)
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (_c *AccountCreate) AddPostIDs(ids ...xid.ID) *AccountCreate {
 	_c.mutation.AddPostIDs(ids...)
@@ -500,6 +716,104 @@ func (_c *AccountCreate) AddPostReads(v ...*PostRead) *AccountCreate {
 	return _c.AddPostReadIDs(ids...)
 }
 
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_c *AccountCreate) AddNotificationPreferenceIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddNotificationPreferenceIDs(ids...)
+	return _c
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_c *AccountCreate) AddNotificationPreferences(v ...*NotificationPreference) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationPreferenceIDs(ids...)
+}
+
+// AddPushSubscriptionIDs adds the "push_subscriptions" edge to the PushSubscription entity by IDs.
+func (_c *AccountCreate) AddPushSubscriptionIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddPushSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddPushSubscriptions adds the "push_subscriptions" edges to the PushSubscription entity.
+func (_c *AccountCreate) AddPushSubscriptions(v ...*PushSubscription) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPushSubscriptionIDs(ids...)
+}
+
+// SetDigestSubscriptionID sets the "digest_subscription" edge to the DigestSubscription entity by ID.
+func (_c *AccountCreate) SetDigestSubscriptionID(id xid.ID) *AccountCreate {
+	_c.mutation.SetDigestSubscriptionID(id)
+	return _c
+}
+
+// SetNillableDigestSubscriptionID sets the "digest_subscription" edge to the DigestSubscription entity by ID if the given value is not nil.
+func (_c *AccountCreate) SetNillableDigestSubscriptionID(id *xid.ID) *AccountCreate {
+	if id != nil {
+		_c = _c.SetDigestSubscriptionID(*id)
+	}
+	return _c
+}
+
+// SetDigestSubscription sets the "digest_subscription" edge to the DigestSubscription entity.
+func (_c *AccountCreate) SetDigestSubscription(v *DigestSubscription) *AccountCreate {
+	return _c.SetDigestSubscriptionID(v.ID)
+}
+
+// SetFeedTokenID sets the "feed_token" edge to the FeedToken entity by ID.
+func (_c *AccountCreate) SetFeedTokenID(id xid.ID) *AccountCreate {
+	_c.mutation.SetFeedTokenID(id)
+	return _c
+}
+
+// SetNillableFeedTokenID sets the "feed_token" edge to the FeedToken entity by ID if the given value is not nil.
+func (_c *AccountCreate) SetNillableFeedTokenID(id *xid.ID) *AccountCreate {
+	if id != nil {
+		_c = _c.SetFeedTokenID(*id)
+	}
+	return _c
+}
+
+// SetFeedToken sets the "feed_token" edge to the FeedToken entity.
+func (_c *AccountCreate) SetFeedToken(v *FeedToken) *AccountCreate {
+	return _c.SetFeedTokenID(v.ID)
+}
+
+// AddAPIUsageIDs adds the "api_usage" edge to the APIUsage entity by IDs.
+func (_c *AccountCreate) AddAPIUsageIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddAPIUsageIDs(ids...)
+	return _c
+}
+
+// AddAPIUsage adds the "api_usage" edges to the APIUsage entity.
+func (_c *AccountCreate) AddAPIUsage(v ...*APIUsage) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPIUsageIDs(ids...)
+}
+
+// AddContentViewIDs adds the "content_views" edge to the ContentView entity by IDs.
+func (_c *AccountCreate) AddContentViewIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddContentViewIDs(ids...)
+	return _c
+}
+
+// AddContentViews adds the "content_views" edges to the ContentView entity.
+func (_c *AccountCreate) AddContentViews(v ...*ContentView) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContentViewIDs(ids...)
+}
+
 // AddReportIDs adds the "reports" edge to the Report entity by IDs.
 func (_c *AccountCreate) AddReportIDs(ids ...xid.ID) *AccountCreate {
 	_c.mutation.AddReportIDs(ids...)
@@ -530,79 +844,342 @@ func (_c *AccountCreate) AddHandledReports(v ...*Report) *AccountCreate {
 	return _c.AddHandledReportIDs(ids...)
 }
 
-// AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
-func (_c *AccountCreate) AddAccountRoleIDs(ids ...xid.ID) *AccountCreate {
-	_c.mutation.AddAccountRoleIDs(ids...)
+// AddModerationNoteIDs adds the "moderation_notes" edge to the ModerationNote entity by IDs.
+func (_c *AccountCreate) AddModerationNoteIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddModerationNoteIDs(ids...)
 	return _c
 }
 
-// AddAccountRoles adds the "account_roles" edges to the AccountRoles entity.
-func (_c *AccountCreate) AddAccountRoles(v ...*AccountRoles) *AccountCreate {
+// AddModerationNotes adds the "moderation_notes" edges to the ModerationNote entity.
+func (_c *AccountCreate) AddModerationNotes(v ...*ModerationNote) *AccountCreate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _c.AddAccountRoleIDs(ids...)
+	return _c.AddModerationNoteIDs(ids...)
 }
 
-// Mutation returns the AccountMutation object of the builder.
-func (_c *AccountCreate) Mutation() *AccountMutation {
-	return _c.mutation
+// AddAuthoredModerationNoteIDs adds the "authored_moderation_notes" edge to the ModerationNote entity by IDs.
+func (_c *AccountCreate) AddAuthoredModerationNoteIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddAuthoredModerationNoteIDs(ids...)
+	return _c
 }
 
-// Save creates the Account in the database.
-func (_c *AccountCreate) Save(ctx context.Context) (*Account, error) {
-	_c.defaults()
-	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+// AddAuthoredModerationNotes adds the "authored_moderation_notes" edges to the ModerationNote entity.
+func (_c *AccountCreate) AddAuthoredModerationNotes(v ...*ModerationNote) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuthoredModerationNoteIDs(ids...)
 }
 
-// SaveX calls Save and panics if Save returns an error.
-func (_c *AccountCreate) SaveX(ctx context.Context) *Account {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddWarningIDs adds the "warnings" edge to the Warning entity by IDs.
+func (_c *AccountCreate) AddWarningIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddWarningIDs(ids...)
+	return _c
+}
+
+// AddWarnings adds the "warnings" edges to the Warning entity.
+func (_c *AccountCreate) AddWarnings(v ...*Warning) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return v
+	return _c.AddWarningIDs(ids...)
 }
 
-// Exec executes the query.
-func (_c *AccountCreate) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// AddIssuedWarningIDs adds the "issued_warnings" edge to the Warning entity by IDs.
+func (_c *AccountCreate) AddIssuedWarningIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddIssuedWarningIDs(ids...)
+	return _c
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *AccountCreate) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
-		panic(err)
+// AddIssuedWarnings adds the "issued_warnings" edges to the Warning entity.
+func (_c *AccountCreate) AddIssuedWarnings(v ...*Warning) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _c.AddIssuedWarningIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (_c *AccountCreate) defaults() {
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		v := account.DefaultCreatedAt()
-		_c.mutation.SetCreatedAt(v)
-	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		v := account.DefaultUpdatedAt()
-		_c.mutation.SetUpdatedAt(v)
+// AddUploadSessionIDs adds the "upload_sessions" edge to the UploadSession entity by IDs.
+func (_c *AccountCreate) AddUploadSessionIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddUploadSessionIDs(ids...)
+	return _c
+}
+
+// AddUploadSessions adds the "upload_sessions" edges to the UploadSession entity.
+func (_c *AccountCreate) AddUploadSessions(v ...*UploadSession) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _, ok := _c.mutation.Kind(); !ok {
-		v := account.DefaultKind
-		_c.mutation.SetKind(v)
+	return _c.AddUploadSessionIDs(ids...)
+}
+
+// AddConversationIDs adds the "conversations" edge to the ConversationParticipant entity by IDs.
+func (_c *AccountCreate) AddConversationIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddConversationIDs(ids...)
+	return _c
+}
+
+// AddConversations adds the "conversations" edges to the ConversationParticipant entity.
+func (_c *AccountCreate) AddConversations(v ...*ConversationParticipant) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _, ok := _c.mutation.Admin(); !ok {
-		v := account.DefaultAdmin
-		_c.mutation.SetAdmin(v)
+	return _c.AddConversationIDs(ids...)
+}
+
+// AddDirectMessageIDs adds the "direct_messages" edge to the DirectMessage entity by IDs.
+func (_c *AccountCreate) AddDirectMessageIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddDirectMessageIDs(ids...)
+	return _c
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DirectMessage entity.
+func (_c *AccountCreate) AddDirectMessages(v ...*DirectMessage) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _, ok := _c.mutation.ID(); !ok {
-		v := account.DefaultID()
-		_c.mutation.SetID(v)
+	return _c.AddDirectMessageIDs(ids...)
+}
+
+// AddSpaceIDs adds the "spaces" edge to the SpaceMember entity by IDs.
+func (_c *AccountCreate) AddSpaceIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddSpaceIDs(ids...)
+	return _c
+}
+
+// AddSpaces adds the "spaces" edges to the SpaceMember entity.
+func (_c *AccountCreate) AddSpaces(v ...*SpaceMember) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _c.AddSpaceIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
+// SetApplicationID sets the "application" edge to the AccountApplication entity by ID.
+func (_c *AccountCreate) SetApplicationID(id xid.ID) *AccountCreate {
+	_c.mutation.SetApplicationID(id)
+	return _c
+}
+
+// SetNillableApplicationID sets the "application" edge to the AccountApplication entity by ID if the given value is not nil.
+func (_c *AccountCreate) SetNillableApplicationID(id *xid.ID) *AccountCreate {
+	if id != nil {
+		_c = _c.SetApplicationID(*id)
+	}
+	return _c
+}
+
+// SetApplication sets the "application" edge to the AccountApplication entity.
+func (_c *AccountCreate) SetApplication(v *AccountApplication) *AccountCreate {
+	return _c.SetApplicationID(v.ID)
+}
+
+// AddReviewedApplicationIDs adds the "reviewed_applications" edge to the AccountApplication entity by IDs.
+func (_c *AccountCreate) AddReviewedApplicationIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddReviewedApplicationIDs(ids...)
+	return _c
+}
+
+// AddReviewedApplications adds the "reviewed_applications" edges to the AccountApplication entity.
+func (_c *AccountCreate) AddReviewedApplications(v ...*AccountApplication) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReviewedApplicationIDs(ids...)
+}
+
+// AddOnboardingStepIDs adds the "onboarding_steps" edge to the AccountOnboardingStep entity by IDs.
+func (_c *AccountCreate) AddOnboardingStepIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddOnboardingStepIDs(ids...)
+	return _c
+}
+
+// AddOnboardingSteps adds the "onboarding_steps" edges to the AccountOnboardingStep entity.
+func (_c *AccountCreate) AddOnboardingSteps(v ...*AccountOnboardingStep) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOnboardingStepIDs(ids...)
+}
+
+// AddPolicyAcceptanceIDs adds the "policy_acceptances" edge to the PolicyAcceptance entity by IDs.
+func (_c *AccountCreate) AddPolicyAcceptanceIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddPolicyAcceptanceIDs(ids...)
+	return _c
+}
+
+// AddPolicyAcceptances adds the "policy_acceptances" edges to the PolicyAcceptance entity.
+func (_c *AccountCreate) AddPolicyAcceptances(v ...*PolicyAcceptance) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPolicyAcceptanceIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (_c *AccountCreate) AddBookmarkIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddBookmarkIDs(ids...)
+	return _c
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (_c *AccountCreate) AddBookmarks(v ...*Bookmark) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBookmarkIDs(ids...)
+}
+
+// AddReadingHistoryIDs adds the "reading_history" edge to the ReadingHistory entity by IDs.
+func (_c *AccountCreate) AddReadingHistoryIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddReadingHistoryIDs(ids...)
+	return _c
+}
+
+// AddReadingHistory adds the "reading_history" edges to the ReadingHistory entity.
+func (_c *AccountCreate) AddReadingHistory(v ...*ReadingHistory) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReadingHistoryIDs(ids...)
+}
+
+// AddVerificationRequestIDs adds the "verification_requests" edge to the VerificationRequest entity by IDs.
+func (_c *AccountCreate) AddVerificationRequestIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddVerificationRequestIDs(ids...)
+	return _c
+}
+
+// AddVerificationRequests adds the "verification_requests" edges to the VerificationRequest entity.
+func (_c *AccountCreate) AddVerificationRequests(v ...*VerificationRequest) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVerificationRequestIDs(ids...)
+}
+
+// AddReviewedVerificationRequestIDs adds the "reviewed_verification_requests" edge to the VerificationRequest entity by IDs.
+func (_c *AccountCreate) AddReviewedVerificationRequestIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddReviewedVerificationRequestIDs(ids...)
+	return _c
+}
+
+// AddReviewedVerificationRequests adds the "reviewed_verification_requests" edges to the VerificationRequest entity.
+func (_c *AccountCreate) AddReviewedVerificationRequests(v ...*VerificationRequest) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReviewedVerificationRequestIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *AccountCreate) AddWebhookIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *AccountCreate) AddWebhooks(v ...*Webhook) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
+// AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
+func (_c *AccountCreate) AddAccountRoleIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddAccountRoleIDs(ids...)
+	return _c
+}
+
+// AddAccountRoles adds the "account_roles" edges to the AccountRoles entity.
+func (_c *AccountCreate) AddAccountRoles(v ...*AccountRoles) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAccountRoleIDs(ids...)
+}
+
+// Mutation returns the AccountMutation object of the builder.
+func (_c *AccountCreate) Mutation() *AccountMutation {
+	return _c.mutation
+}
+
+// Save creates the Account in the database.
+func (_c *AccountCreate) Save(ctx context.Context) (*Account, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountCreate) SaveX(ctx context.Context) *Account {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := account.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := account.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := account.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.Admin(); !ok {
+		v := account.DefaultAdmin
+		_c.mutation.SetAdmin(v)
+	}
+	if _, ok := _c.mutation.ReadingHistoryEnabled(); !ok {
+		v := account.DefaultReadingHistoryEnabled
+		_c.mutation.SetReadingHistoryEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := account.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
 func (_c *AccountCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Account.created_at"`)}
@@ -637,6 +1214,9 @@ func (_c *AccountCreate) check() error {
 	if _, ok := _c.mutation.Admin(); !ok {
 		return &ValidationError{Name: "admin", err: errors.New(`ent: missing required field "Account.admin"`)}
 	}
+	if _, ok := _c.mutation.ReadingHistoryEnabled(); !ok {
+		return &ValidationError{Name: "reading_history_enabled", err: errors.New(`ent: missing required field "Account.reading_history_enabled"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := account.IDValidator(v.String()); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Account.id": %w`, err)}
@@ -718,10 +1298,34 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldLinks, field.TypeJSON, value)
 		_node.Links = value
 	}
+	if value, ok := _c.mutation.PinnedItems(); ok {
+		_spec.SetField(account.FieldPinnedItems, field.TypeJSON, value)
+		_node.PinnedItems = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(account.FieldLocale, field.TypeString, value)
+		_node.Locale = &value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(account.FieldTimezone, field.TypeString, value)
+		_node.Timezone = &value
+	}
+	if value, ok := _c.mutation.ReadingHistoryEnabled(); ok {
+		_spec.SetField(account.FieldReadingHistoryEnabled, field.TypeBool, value)
+		_node.ReadingHistoryEnabled = value
+	}
+	if value, ok := _c.mutation.HiddenActivity(); ok {
+		_spec.SetField(account.FieldHiddenActivity, field.TypeJSON, value)
+		_node.HiddenActivity = value
+	}
+	if value, ok := _c.mutation.Verification(); ok {
+		_spec.SetField(account.FieldVerification, field.TypeString, value)
+		_node.Verification = &value
+	}
 	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -818,15 +1422,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.InvitationsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.BlocksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -834,32 +1438,31 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.InvitedByIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.BlockedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   account.InvitedByTable,
-			Columns: []string{account.InvitedByColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.InvitedByID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.PostsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.TagFollowsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.TagFollowsTable,
+			Columns: []string{account.TagFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -867,15 +1470,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.QuestionsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.CategoryFollowsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.CategoryFollowsTable,
+			Columns: []string{account.CategoryFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -883,15 +1486,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.ReactsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.ReputationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.ReputationTable,
+			Columns: []string{account.ReputationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -899,15 +1502,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.BadgesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.BadgesTable,
+			Columns: []string{account.BadgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -915,15 +1518,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.MentionsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.InvitationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -931,38 +1534,536 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.InvitedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Table:   account.InvitedByTable,
+			Columns: []string{account.InvitedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &AccountRolesCreate{config: _c.config, mutation: newAccountRolesMutation(_c.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_node.InvitedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.OwnerTable,
+			Columns: []string{account.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.AuthenticationIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.BotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.BotsTable,
+			Columns: []string{account.BotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &AccountRolesCreate{config: _c.config, mutation: newAccountRolesMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthenticationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PostReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PushSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PushSubscriptionsTable,
+			Columns: []string{account.PushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushsubscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DigestSubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.DigestSubscriptionTable,
+			Columns: []string{account.DigestSubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FeedTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.FeedTokenTable,
+			Columns: []string{account.FeedTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedtoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.APIUsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.APIUsageTable,
+			Columns: []string{account.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContentViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ContentViewsTable,
+			Columns: []string{account.ContentViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HandledReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModerationNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ModerationNotesTable,
+			Columns: []string{account.ModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthoredModerationNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthoredModerationNotesTable,
+			Columns: []string{account.AuthoredModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WarningsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.WarningsTable,
+			Columns: []string{account.WarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IssuedWarningsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IssuedWarningsTable,
+			Columns: []string{account.IssuedWarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UploadSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UploadSessionsTable,
+			Columns: []string{account.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ConversationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DirectMessagesTable,
+			Columns: []string{account.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SpacesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SpacesTable,
+			Columns: []string{account.SpacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ApplicationTable,
+			Columns: []string{account.ApplicationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -970,15 +2071,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.ReviewedApplicationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.ReviewedApplicationsTable,
+			Columns: []string{account.ReviewedApplicationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -986,15 +2087,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.CollectionsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.OnboardingStepsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.OnboardingStepsTable,
+			Columns: []string{account.OnboardingStepsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1002,15 +2103,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.NodesIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.PolicyAcceptancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.PolicyAcceptancesTable,
+			Columns: []string{account.PolicyAcceptancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1018,15 +2119,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.AssetsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.BookmarksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.BookmarksTable,
+			Columns: []string{account.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1034,15 +2135,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.ReadingHistoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.ReadingHistoryTable,
+			Columns: []string{account.ReadingHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1050,15 +2151,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.PostReadsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.VerificationRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.VerificationRequestsTable,
+			Columns: []string{account.VerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1066,15 +2167,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.ReportsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.ReviewedVerificationRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Table:   account.ReviewedVerificationRequestsTable,
+			Columns: []string{account.ReviewedVerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1082,15 +2183,15 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.HandledReportsIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.WebhooksTable,
+			Columns: []string{account.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1298,6 +2399,24 @@ func (u *AccountUpsert) ClearLinks() *AccountUpsert {
 	return u
 }
 
+// SetPinnedItems sets the "pinned_items" field.
+func (u *AccountUpsert) SetPinnedItems(v []schema.PinnedItem) *AccountUpsert {
+	u.Set(account.FieldPinnedItems, v)
+	return u
+}
+
+// UpdatePinnedItems sets the "pinned_items" field to the value that was provided on create.
+func (u *AccountUpsert) UpdatePinnedItems() *AccountUpsert {
+	u.SetExcluded(account.FieldPinnedItems)
+	return u
+}
+
+// ClearPinnedItems clears the value of the "pinned_items" field.
+func (u *AccountUpsert) ClearPinnedItems() *AccountUpsert {
+	u.SetNull(account.FieldPinnedItems)
+	return u
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *AccountUpsert) SetMetadata(v map[string]interface{}) *AccountUpsert {
 	u.Set(account.FieldMetadata, v)
@@ -1316,6 +2435,90 @@ func (u *AccountUpsert) ClearMetadata() *AccountUpsert {
 	return u
 }
 
+// SetLocale sets the "locale" field.
+func (u *AccountUpsert) SetLocale(v string) *AccountUpsert {
+	u.Set(account.FieldLocale, v)
+	return u
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateLocale() *AccountUpsert {
+	u.SetExcluded(account.FieldLocale)
+	return u
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (u *AccountUpsert) ClearLocale() *AccountUpsert {
+	u.SetNull(account.FieldLocale)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *AccountUpsert) SetTimezone(v string) *AccountUpsert {
+	u.Set(account.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateTimezone() *AccountUpsert {
+	u.SetExcluded(account.FieldTimezone)
+	return u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *AccountUpsert) ClearTimezone() *AccountUpsert {
+	u.SetNull(account.FieldTimezone)
+	return u
+}
+
+// SetReadingHistoryEnabled sets the "reading_history_enabled" field.
+func (u *AccountUpsert) SetReadingHistoryEnabled(v bool) *AccountUpsert {
+	u.Set(account.FieldReadingHistoryEnabled, v)
+	return u
+}
+
+// UpdateReadingHistoryEnabled sets the "reading_history_enabled" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateReadingHistoryEnabled() *AccountUpsert {
+	u.SetExcluded(account.FieldReadingHistoryEnabled)
+	return u
+}
+
+// SetHiddenActivity sets the "hidden_activity" field.
+func (u *AccountUpsert) SetHiddenActivity(v []string) *AccountUpsert {
+	u.Set(account.FieldHiddenActivity, v)
+	return u
+}
+
+// UpdateHiddenActivity sets the "hidden_activity" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateHiddenActivity() *AccountUpsert {
+	u.SetExcluded(account.FieldHiddenActivity)
+	return u
+}
+
+// ClearHiddenActivity clears the value of the "hidden_activity" field.
+func (u *AccountUpsert) ClearHiddenActivity() *AccountUpsert {
+	u.SetNull(account.FieldHiddenActivity)
+	return u
+}
+
+// SetVerification sets the "verification" field.
+func (u *AccountUpsert) SetVerification(v string) *AccountUpsert {
+	u.Set(account.FieldVerification, v)
+	return u
+}
+
+// UpdateVerification sets the "verification" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateVerification() *AccountUpsert {
+	u.SetExcluded(account.FieldVerification)
+	return u
+}
+
+// ClearVerification clears the value of the "verification" field.
+func (u *AccountUpsert) ClearVerification() *AccountUpsert {
+	u.SetNull(account.FieldVerification)
+	return u
+}
+
 // SetInvitedByID sets the "invited_by_id" field.
 func (u *AccountUpsert) SetInvitedByID(v xid.ID) *AccountUpsert {
 	u.Set(account.FieldInvitedByID, v)
@@ -1334,6 +2537,24 @@ func (u *AccountUpsert) ClearInvitedByID() *AccountUpsert {
 	return u
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (u *AccountUpsert) SetOwnerID(v xid.ID) *AccountUpsert {
+	u.Set(account.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateOwnerID() *AccountUpsert {
+	u.SetExcluded(account.FieldOwnerID)
+	return u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (u *AccountUpsert) ClearOwnerID() *AccountUpsert {
+	u.SetNull(account.FieldOwnerID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1539,6 +2760,27 @@ func (u *AccountUpsertOne) ClearLinks() *AccountUpsertOne {
 	})
 }
 
+// SetPinnedItems sets the "pinned_items" field.
+func (u *AccountUpsertOne) SetPinnedItems(v []schema.PinnedItem) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetPinnedItems(v)
+	})
+}
+
+// UpdatePinnedItems sets the "pinned_items" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdatePinnedItems() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdatePinnedItems()
+	})
+}
+
+// ClearPinnedItems clears the value of the "pinned_items" field.
+func (u *AccountUpsertOne) ClearPinnedItems() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearPinnedItems()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *AccountUpsertOne) SetMetadata(v map[string]interface{}) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
@@ -1560,6 +2802,104 @@ func (u *AccountUpsertOne) ClearMetadata() *AccountUpsertOne {
 	})
 }
 
+// SetLocale sets the "locale" field.
+func (u *AccountUpsertOne) SetLocale(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetLocale(v)
+	})
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateLocale() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateLocale()
+	})
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (u *AccountUpsertOne) ClearLocale() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearLocale()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *AccountUpsertOne) SetTimezone(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateTimezone() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *AccountUpsertOne) ClearTimezone() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearTimezone()
+	})
+}
+
+// SetReadingHistoryEnabled sets the "reading_history_enabled" field.
+func (u *AccountUpsertOne) SetReadingHistoryEnabled(v bool) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetReadingHistoryEnabled(v)
+	})
+}
+
+// UpdateReadingHistoryEnabled sets the "reading_history_enabled" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateReadingHistoryEnabled() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateReadingHistoryEnabled()
+	})
+}
+
+// SetHiddenActivity sets the "hidden_activity" field.
+func (u *AccountUpsertOne) SetHiddenActivity(v []string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetHiddenActivity(v)
+	})
+}
+
+// UpdateHiddenActivity sets the "hidden_activity" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateHiddenActivity() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateHiddenActivity()
+	})
+}
+
+// ClearHiddenActivity clears the value of the "hidden_activity" field.
+func (u *AccountUpsertOne) ClearHiddenActivity() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearHiddenActivity()
+	})
+}
+
+// SetVerification sets the "verification" field.
+func (u *AccountUpsertOne) SetVerification(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetVerification(v)
+	})
+}
+
+// UpdateVerification sets the "verification" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateVerification() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateVerification()
+	})
+}
+
+// ClearVerification clears the value of the "verification" field.
+func (u *AccountUpsertOne) ClearVerification() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearVerification()
+	})
+}
+
 // SetInvitedByID sets the "invited_by_id" field.
 func (u *AccountUpsertOne) SetInvitedByID(v xid.ID) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
@@ -1581,6 +2921,27 @@ func (u *AccountUpsertOne) ClearInvitedByID() *AccountUpsertOne {
 	})
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (u *AccountUpsertOne) SetOwnerID(v xid.ID) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateOwnerID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (u *AccountUpsertOne) ClearOwnerID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearOwnerID()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1953,6 +3314,27 @@ func (u *AccountUpsertBulk) ClearLinks() *AccountUpsertBulk {
 	})
 }
 
+// SetPinnedItems sets the "pinned_items" field.
+func (u *AccountUpsertBulk) SetPinnedItems(v []schema.PinnedItem) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetPinnedItems(v)
+	})
+}
+
+// UpdatePinnedItems sets the "pinned_items" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdatePinnedItems() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdatePinnedItems()
+	})
+}
+
+// ClearPinnedItems clears the value of the "pinned_items" field.
+func (u *AccountUpsertBulk) ClearPinnedItems() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearPinnedItems()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *AccountUpsertBulk) SetMetadata(v map[string]interface{}) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
@@ -1974,6 +3356,104 @@ func (u *AccountUpsertBulk) ClearMetadata() *AccountUpsertBulk {
 	})
 }
 
+// SetLocale sets the "locale" field.
+func (u *AccountUpsertBulk) SetLocale(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetLocale(v)
+	})
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateLocale() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateLocale()
+	})
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (u *AccountUpsertBulk) ClearLocale() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearLocale()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *AccountUpsertBulk) SetTimezone(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateTimezone() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *AccountUpsertBulk) ClearTimezone() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearTimezone()
+	})
+}
+
+// SetReadingHistoryEnabled sets the "reading_history_enabled" field.
+func (u *AccountUpsertBulk) SetReadingHistoryEnabled(v bool) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetReadingHistoryEnabled(v)
+	})
+}
+
+// UpdateReadingHistoryEnabled sets the "reading_history_enabled" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateReadingHistoryEnabled() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateReadingHistoryEnabled()
+	})
+}
+
+// SetHiddenActivity sets the "hidden_activity" field.
+func (u *AccountUpsertBulk) SetHiddenActivity(v []string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetHiddenActivity(v)
+	})
+}
+
+// UpdateHiddenActivity sets the "hidden_activity" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateHiddenActivity() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateHiddenActivity()
+	})
+}
+
+// ClearHiddenActivity clears the value of the "hidden_activity" field.
+func (u *AccountUpsertBulk) ClearHiddenActivity() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearHiddenActivity()
+	})
+}
+
+// SetVerification sets the "verification" field.
+func (u *AccountUpsertBulk) SetVerification(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetVerification(v)
+	})
+}
+
+// UpdateVerification sets the "verification" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateVerification() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateVerification()
+	})
+}
+
+// ClearVerification clears the value of the "verification" field.
+func (u *AccountUpsertBulk) ClearVerification() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearVerification()
+	})
+}
+
 // SetInvitedByID sets the "invited_by_id" field.
 func (u *AccountUpsertBulk) SetInvitedByID(v xid.ID) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
@@ -1995,6 +3475,27 @@ func (u *AccountUpsertBulk) ClearInvitedByID() *AccountUpsertBulk {
 	})
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (u *AccountUpsertBulk) SetOwnerID(v xid.ID) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateOwnerID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (u *AccountUpsertBulk) ClearOwnerID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearOwnerID()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
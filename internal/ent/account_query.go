@@ -13,62 +13,117 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/pushsubscription"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
 	"github.com/Southclaws/storyden/internal/ent/report"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
 	"github.com/Southclaws/storyden/internal/ent/role"
 	"github.com/Southclaws/storyden/internal/ent/session"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
 	"github.com/rs/xid"
 )
 
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	ctx                        *QueryContext
-	order                      []account.OrderOption
-	inters                     []Interceptor
-	predicates                 []predicate.Account
-	withSessions               *SessionQuery
-	withEmails                 *EmailQuery
-	withNotifications          *NotificationQuery
-	withTriggeredNotifications *NotificationQuery
-	withFollowing              *AccountFollowQuery
-	withFollowedBy             *AccountFollowQuery
-	withInvitations            *InvitationQuery
-	withInvitedBy              *InvitationQuery
-	withPosts                  *PostQuery
-	withQuestions              *QuestionQuery
-	withReacts                 *ReactQuery
-	withLikes                  *LikePostQuery
-	withMentions               *MentionProfileQuery
-	withRoles                  *RoleQuery
-	withAuthentication         *AuthenticationQuery
-	withTags                   *TagQuery
-	withCollections            *CollectionQuery
-	withNodes                  *NodeQuery
-	withAssets                 *AssetQuery
-	withEvents                 *EventParticipantQuery
-	withPostReads              *PostReadQuery
-	withReports                *ReportQuery
-	withHandledReports         *ReportQuery
-	withAccountRoles           *AccountRolesQuery
-	modifiers                  []func(*sql.Selector)
+	ctx                              *QueryContext
+	order                            []account.OrderOption
+	inters                           []Interceptor
+	predicates                       []predicate.Account
+	withSessions                     *SessionQuery
+	withEmails                       *EmailQuery
+	withNotifications                *NotificationQuery
+	withTriggeredNotifications       *NotificationQuery
+	withFollowing                    *AccountFollowQuery
+	withFollowedBy                   *AccountFollowQuery
+	withBlocks                       *AccountBlockQuery
+	withBlockedBy                    *AccountBlockQuery
+	withTagFollows                   *TagFollowQuery
+	withCategoryFollows              *CategoryFollowQuery
+	withReputation                   *ReputationEntryQuery
+	withBadges                       *AccountBadgeQuery
+	withInvitations                  *InvitationQuery
+	withInvitedBy                    *InvitationQuery
+	withOwner                        *AccountQuery
+	withBots                         *AccountQuery
+	withPosts                        *PostQuery
+	withQuestions                    *QuestionQuery
+	withReacts                       *ReactQuery
+	withLikes                        *LikePostQuery
+	withMentions                     *MentionProfileQuery
+	withRoles                        *RoleQuery
+	withAuthentication               *AuthenticationQuery
+	withTags                         *TagQuery
+	withCollections                  *CollectionQuery
+	withNodes                        *NodeQuery
+	withAssets                       *AssetQuery
+	withEvents                       *EventParticipantQuery
+	withPostReads                    *PostReadQuery
+	withNotificationPreferences      *NotificationPreferenceQuery
+	withPushSubscriptions            *PushSubscriptionQuery
+	withDigestSubscription           *DigestSubscriptionQuery
+	withFeedToken                    *FeedTokenQuery
+	withAPIUsage                     *APIUsageQuery
+	withContentViews                 *ContentViewQuery
+	withReports                      *ReportQuery
+	withHandledReports               *ReportQuery
+	withModerationNotes              *ModerationNoteQuery
+	withAuthoredModerationNotes      *ModerationNoteQuery
+	withWarnings                     *WarningQuery
+	withIssuedWarnings               *WarningQuery
+	withUploadSessions               *UploadSessionQuery
+	withConversations                *ConversationParticipantQuery
+	withDirectMessages               *DirectMessageQuery
+	withSpaces                       *SpaceMemberQuery
+	withApplication                  *AccountApplicationQuery
+	withReviewedApplications         *AccountApplicationQuery
+	withOnboardingSteps              *AccountOnboardingStepQuery
+	withPolicyAcceptances            *PolicyAcceptanceQuery
+	withBookmarks                    *BookmarkQuery
+	withReadingHistory               *ReadingHistoryQuery
+	withVerificationRequests         *VerificationRequestQuery
+	withReviewedVerificationRequests *VerificationRequestQuery
+	withWebhooks                     *WebhookQuery
+	withAccountRoles                 *AccountRolesQuery
+	modifiers                        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -237,6 +292,138 @@ func (_q *AccountQuery) QueryFollowedBy() *AccountFollowQuery {
 	return query
 }
 
+// QueryBlocks chains the current query on the "blocks" edge.
+func (_q *AccountQuery) QueryBlocks() *AccountBlockQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountblock.Table, accountblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BlocksTable, account.BlocksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedBy chains the current query on the "blocked_by" edge.
+func (_q *AccountQuery) QueryBlockedBy() *AccountBlockQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountblock.Table, accountblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BlockedByTable, account.BlockedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTagFollows chains the current query on the "tag_follows" edge.
+func (_q *AccountQuery) QueryTagFollows() *TagFollowQuery {
+	query := (&TagFollowClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(tagfollow.Table, tagfollow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.TagFollowsTable, account.TagFollowsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCategoryFollows chains the current query on the "category_follows" edge.
+func (_q *AccountQuery) QueryCategoryFollows() *CategoryFollowQuery {
+	query := (&CategoryFollowClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(categoryfollow.Table, categoryfollow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.CategoryFollowsTable, account.CategoryFollowsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReputation chains the current query on the "reputation" edge.
+func (_q *AccountQuery) QueryReputation() *ReputationEntryQuery {
+	query := (&ReputationEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(reputationentry.Table, reputationentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReputationTable, account.ReputationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBadges chains the current query on the "badges" edge.
+func (_q *AccountQuery) QueryBadges() *AccountBadgeQuery {
+	query := (&AccountBadgeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountbadge.Table, accountbadge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BadgesTable, account.BadgesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryInvitations chains the current query on the "invitations" edge.
 func (_q *AccountQuery) QueryInvitations() *InvitationQuery {
 	query := (&InvitationClient{config: _q.config}).Query()
@@ -281,6 +468,50 @@ func (_q *AccountQuery) QueryInvitedBy() *InvitationQuery {
 	return query
 }
 
+// QueryOwner chains the current query on the "owner" edge.
+func (_q *AccountQuery) QueryOwner() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, account.OwnerTable, account.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBots chains the current query on the "bots" edge.
+func (_q *AccountQuery) QueryBots() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BotsTable, account.BotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPosts chains the current query on the "posts" edge.
 func (_q *AccountQuery) QueryPosts() *PostQuery {
 	query := (&PostClient{config: _q.config}).Query()
@@ -567,9 +798,9 @@ func (_q *AccountQuery) QueryPostReads() *PostReadQuery {
 	return query
 }
 
-// QueryReports chains the current query on the "reports" edge.
-func (_q *AccountQuery) QueryReports() *ReportQuery {
-	query := (&ReportClient{config: _q.config}).Query()
+// QueryNotificationPreferences chains the current query on the "notification_preferences" edge.
+func (_q *AccountQuery) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -580,8 +811,8 @@ func (_q *AccountQuery) QueryReports() *ReportQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(account.Table, account.FieldID, selector),
-			sqlgraph.To(report.Table, report.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, account.ReportsTable, account.ReportsColumn),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.NotificationPreferencesTable, account.NotificationPreferencesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -589,9 +820,9 @@ func (_q *AccountQuery) QueryReports() *ReportQuery {
 	return query
 }
 
-// QueryHandledReports chains the current query on the "handled_reports" edge.
-func (_q *AccountQuery) QueryHandledReports() *ReportQuery {
-	query := (&ReportClient{config: _q.config}).Query()
+// QueryPushSubscriptions chains the current query on the "push_subscriptions" edge.
+func (_q *AccountQuery) QueryPushSubscriptions() *PushSubscriptionQuery {
+	query := (&PushSubscriptionClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -602,8 +833,8 @@ func (_q *AccountQuery) QueryHandledReports() *ReportQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(account.Table, account.FieldID, selector),
-			sqlgraph.To(report.Table, report.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, account.HandledReportsTable, account.HandledReportsColumn),
+			sqlgraph.To(pushsubscription.Table, pushsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.PushSubscriptionsTable, account.PushSubscriptionsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -611,9 +842,9 @@ func (_q *AccountQuery) QueryHandledReports() *ReportQuery {
 	return query
 }
 
-// QueryAccountRoles chains the current query on the "account_roles" edge.
-func (_q *AccountQuery) QueryAccountRoles() *AccountRolesQuery {
-	query := (&AccountRolesClient{config: _q.config}).Query()
+// QueryDigestSubscription chains the current query on the "digest_subscription" edge.
+func (_q *AccountQuery) QueryDigestSubscription() *DigestSubscriptionQuery {
+	query := (&DigestSubscriptionClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -624,8 +855,8 @@ func (_q *AccountQuery) QueryAccountRoles() *AccountRolesQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(account.Table, account.FieldID, selector),
-			sqlgraph.To(accountroles.Table, accountroles.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, account.AccountRolesTable, account.AccountRolesColumn),
+			sqlgraph.To(digestsubscription.Table, digestsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.DigestSubscriptionTable, account.DigestSubscriptionColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -633,792 +864,2876 @@ func (_q *AccountQuery) QueryAccountRoles() *AccountRolesQuery {
 	return query
 }
 
-// First returns the first Account entity from the query.
-// Returns a *NotFoundError when no Account was found.
-func (_q *AccountQuery) First(ctx context.Context) (*Account, error) {
-	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{account.Label}
+// QueryFeedToken chains the current query on the "feed_token" edge.
+func (_q *AccountQuery) QueryFeedToken() *FeedTokenQuery {
+	query := (&FeedTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(feedtoken.Table, feedtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.FeedTokenTable, account.FeedTokenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes[0], nil
+	return query
 }
 
-// FirstX is like First, but panics if an error occurs.
-func (_q *AccountQuery) FirstX(ctx context.Context) *Account {
-	node, err := _q.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryAPIUsage chains the current query on the "api_usage" edge.
+func (_q *AccountQuery) QueryAPIUsage() *APIUsageQuery {
+	query := (&APIUsageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(apiusage.Table, apiusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.APIUsageTable, account.APIUsageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// FirstID returns the first Account ID from the query.
-// Returns a *NotFoundError when no Account ID was found.
-func (_q *AccountQuery) FirstID(ctx context.Context) (id xid.ID, err error) {
-	var ids []xid.ID
-	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{account.Label}
-		return
+// QueryContentViews chains the current query on the "content_views" edge.
+func (_q *AccountQuery) QueryContentViews() *ContentViewQuery {
+	query := (&ContentViewClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(contentview.Table, contentview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ContentViewsTable, account.ContentViewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids[0], nil
+	return query
 }
 
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (_q *AccountQuery) FirstIDX(ctx context.Context) xid.ID {
-	id, err := _q.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryReports chains the current query on the "reports" edge.
+func (_q *AccountQuery) QueryReports() *ReportQuery {
+	query := (&ReportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReportsTable, account.ReportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// Only returns a single Account entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one Account entity is found.
-// Returns a *NotFoundError when no Account entities are found.
-func (_q *AccountQuery) Only(ctx context.Context) (*Account, error) {
-	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
-	if err != nil {
-		return nil, err
-	}
-	switch len(nodes) {
-	case 1:
-		return nodes[0], nil
-	case 0:
-		return nil, &NotFoundError{account.Label}
-	default:
-		return nil, &NotSingularError{account.Label}
+// QueryHandledReports chains the current query on the "handled_reports" edge.
+func (_q *AccountQuery) QueryHandledReports() *ReportQuery {
+	query := (&ReportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.HandledReportsTable, account.HandledReportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// OnlyX is like Only, but panics if an error occurs.
-func (_q *AccountQuery) OnlyX(ctx context.Context) *Account {
-	node, err := _q.Only(ctx)
-	if err != nil {
-		panic(err)
+// QueryModerationNotes chains the current query on the "moderation_notes" edge.
+func (_q *AccountQuery) QueryModerationNotes() *ModerationNoteQuery {
+	query := (&ModerationNoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(moderationnote.Table, moderationnote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ModerationNotesTable, account.ModerationNotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// OnlyID is like Only, but returns the only Account ID in the query.
-// Returns a *NotSingularError when more than one Account ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (_q *AccountQuery) OnlyID(ctx context.Context) (id xid.ID, err error) {
-	var ids []xid.ID
-	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{account.Label}
-	default:
-		err = &NotSingularError{account.Label}
+// QueryAuthoredModerationNotes chains the current query on the "authored_moderation_notes" edge.
+func (_q *AccountQuery) QueryAuthoredModerationNotes() *ModerationNoteQuery {
+	query := (&ModerationNoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(moderationnote.Table, moderationnote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.AuthoredModerationNotesTable, account.AuthoredModerationNotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return
+	return query
 }
 
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (_q *AccountQuery) OnlyIDX(ctx context.Context) xid.ID {
-	id, err := _q.OnlyID(ctx)
-	if err != nil {
-		panic(err)
+// QueryWarnings chains the current query on the "warnings" edge.
+func (_q *AccountQuery) QueryWarnings() *WarningQuery {
+	query := (&WarningClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(warning.Table, warning.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.WarningsTable, account.WarningsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// All executes the query and returns a list of Accounts.
-func (_q *AccountQuery) All(ctx context.Context) ([]*Account, error) {
-	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
-	if err := _q.prepareQuery(ctx); err != nil {
-		return nil, err
+// QueryIssuedWarnings chains the current query on the "issued_warnings" edge.
+func (_q *AccountQuery) QueryIssuedWarnings() *WarningQuery {
+	query := (&WarningClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(warning.Table, warning.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.IssuedWarningsTable, account.IssuedWarningsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	qr := querierAll[[]*Account, *AccountQuery]()
-	return withInterceptors[[]*Account](ctx, _q, qr, _q.inters)
+	return query
 }
 
-// AllX is like All, but panics if an error occurs.
-func (_q *AccountQuery) AllX(ctx context.Context) []*Account {
-	nodes, err := _q.All(ctx)
-	if err != nil {
-		panic(err)
+// QueryUploadSessions chains the current query on the "upload_sessions" edge.
+func (_q *AccountQuery) QueryUploadSessions() *UploadSessionQuery {
+	query := (&UploadSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(uploadsession.Table, uploadsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.UploadSessionsTable, account.UploadSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes
+	return query
 }
 
-// IDs executes the query and returns a list of Account IDs.
-func (_q *AccountQuery) IDs(ctx context.Context) (ids []xid.ID, err error) {
-	if _q.ctx.Unique == nil && _q.path != nil {
-		_q.Unique(true)
+// QueryConversations chains the current query on the "conversations" edge.
+func (_q *AccountQuery) QueryConversations() *ConversationParticipantQuery {
+	query := (&ConversationParticipantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(conversationparticipant.Table, conversationparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ConversationsTable, account.ConversationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
-	if err = _q.Select(account.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
+	return query
+}
+
+// QueryDirectMessages chains the current query on the "direct_messages" edge.
+func (_q *AccountQuery) QueryDirectMessages() *DirectMessageQuery {
+	query := (&DirectMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(directmessage.Table, directmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.DirectMessagesTable, account.DirectMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids, nil
+	return query
 }
 
-// IDsX is like IDs, but panics if an error occurs.
-func (_q *AccountQuery) IDsX(ctx context.Context) []xid.ID {
-	ids, err := _q.IDs(ctx)
-	if err != nil {
-		panic(err)
+// QuerySpaces chains the current query on the "spaces" edge.
+func (_q *AccountQuery) QuerySpaces() *SpaceMemberQuery {
+	query := (&SpaceMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(spacemember.Table, spacemember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.SpacesTable, account.SpacesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids
+	return query
 }
 
-// Count returns the count of the given query.
-func (_q *AccountQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
-	if err := _q.prepareQuery(ctx); err != nil {
-		return 0, err
+// QueryApplication chains the current query on the "application" edge.
+func (_q *AccountQuery) QueryApplication() *AccountApplicationQuery {
+	query := (&AccountApplicationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountapplication.Table, accountapplication.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.ApplicationTable, account.ApplicationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return withInterceptors[int](ctx, _q, querierCount[*AccountQuery](), _q.inters)
+	return query
 }
 
-// CountX is like Count, but panics if an error occurs.
-func (_q *AccountQuery) CountX(ctx context.Context) int {
-	count, err := _q.Count(ctx)
-	if err != nil {
-		panic(err)
+// QueryReviewedApplications chains the current query on the "reviewed_applications" edge.
+func (_q *AccountQuery) QueryReviewedApplications() *AccountApplicationQuery {
+	query := (&AccountApplicationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountapplication.Table, accountapplication.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReviewedApplicationsTable, account.ReviewedApplicationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return count
+	return query
 }
 
-// Exist returns true if the query has elements in the graph.
-func (_q *AccountQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
-	switch _, err := _q.FirstID(ctx); {
-	case IsNotFound(err):
-		return false, nil
-	case err != nil:
-		return false, fmt.Errorf("ent: check existence: %w", err)
-	default:
-		return true, nil
+// QueryOnboardingSteps chains the current query on the "onboarding_steps" edge.
+func (_q *AccountQuery) QueryOnboardingSteps() *AccountOnboardingStepQuery {
+	query := (&AccountOnboardingStepClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountonboardingstep.Table, accountonboardingstep.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.OnboardingStepsTable, account.OnboardingStepsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// ExistX is like Exist, but panics if an error occurs.
-func (_q *AccountQuery) ExistX(ctx context.Context) bool {
-	exist, err := _q.Exist(ctx)
-	if err != nil {
-		panic(err)
+// QueryPolicyAcceptances chains the current query on the "policy_acceptances" edge.
+func (_q *AccountQuery) QueryPolicyAcceptances() *PolicyAcceptanceQuery {
+	query := (&PolicyAcceptanceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(policyacceptance.Table, policyacceptance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.PolicyAcceptancesTable, account.PolicyAcceptancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return exist
+	return query
 }
 
-// Clone returns a duplicate of the AccountQuery builder, including all associated steps. It can be
-// used to prepare common query builders and use them differently after the clone is made.
-func (_q *AccountQuery) Clone() *AccountQuery {
-	if _q == nil {
-		return nil
+// QueryBookmarks chains the current query on the "bookmarks" edge.
+func (_q *AccountQuery) QueryBookmarks() *BookmarkQuery {
+	query := (&BookmarkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(bookmark.Table, bookmark.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BookmarksTable, account.BookmarksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return &AccountQuery{
-		config:                     _q.config,
-		ctx:                        _q.ctx.Clone(),
-		order:                      append([]account.OrderOption{}, _q.order...),
-		inters:                     append([]Interceptor{}, _q.inters...),
-		predicates:                 append([]predicate.Account{}, _q.predicates...),
-		withSessions:               _q.withSessions.Clone(),
-		withEmails:                 _q.withEmails.Clone(),
-		withNotifications:          _q.withNotifications.Clone(),
-		withTriggeredNotifications: _q.withTriggeredNotifications.Clone(),
-		withFollowing:              _q.withFollowing.Clone(),
-		withFollowedBy:             _q.withFollowedBy.Clone(),
-		withInvitations:            _q.withInvitations.Clone(),
-		withInvitedBy:              _q.withInvitedBy.Clone(),
-		withPosts:                  _q.withPosts.Clone(),
-		withQuestions:              _q.withQuestions.Clone(),
-		withReacts:                 _q.withReacts.Clone(),
-		withLikes:                  _q.withLikes.Clone(),
-		withMentions:               _q.withMentions.Clone(),
-		withRoles:                  _q.withRoles.Clone(),
-		withAuthentication:         _q.withAuthentication.Clone(),
-		withTags:                   _q.withTags.Clone(),
-		withCollections:            _q.withCollections.Clone(),
-		withNodes:                  _q.withNodes.Clone(),
-		withAssets:                 _q.withAssets.Clone(),
-		withEvents:                 _q.withEvents.Clone(),
-		withPostReads:              _q.withPostReads.Clone(),
-		withReports:                _q.withReports.Clone(),
-		withHandledReports:         _q.withHandledReports.Clone(),
-		withAccountRoles:           _q.withAccountRoles.Clone(),
-		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	return query
+}
+
+// QueryReadingHistory chains the current query on the "reading_history" edge.
+func (_q *AccountQuery) QueryReadingHistory() *ReadingHistoryQuery {
+	query := (&ReadingHistoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(readinghistory.Table, readinghistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReadingHistoryTable, account.ReadingHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// WithSessions tells the query-builder to eager-load the nodes that are connected to
-// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithSessions(opts ...func(*SessionQuery)) *AccountQuery {
-	query := (&SessionClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// QueryVerificationRequests chains the current query on the "verification_requests" edge.
+func (_q *AccountQuery) QueryVerificationRequests() *VerificationRequestQuery {
+	query := (&VerificationRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(verificationrequest.Table, verificationrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.VerificationRequestsTable, account.VerificationRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	_q.withSessions = query
-	return _q
+	return query
 }
 
-// WithEmails tells the query-builder to eager-load the nodes that are connected to
-// the "emails" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithEmails(opts ...func(*EmailQuery)) *AccountQuery {
-	query := (&EmailClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// QueryReviewedVerificationRequests chains the current query on the "reviewed_verification_requests" edge.
+func (_q *AccountQuery) QueryReviewedVerificationRequests() *VerificationRequestQuery {
+	query := (&VerificationRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(verificationrequest.Table, verificationrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReviewedVerificationRequestsTable, account.ReviewedVerificationRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	_q.withEmails = query
-	return _q
+	return query
 }
 
-// WithNotifications tells the query-builder to eager-load the nodes that are connected to
-// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithNotifications(opts ...func(*NotificationQuery)) *AccountQuery {
-	query := (&NotificationClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (_q *AccountQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.WebhooksTable, account.WebhooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	_q.withNotifications = query
-	return _q
+	return query
 }
 
-// WithTriggeredNotifications tells the query-builder to eager-load the nodes that are connected to
-// the "triggered_notifications" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithTriggeredNotifications(opts ...func(*NotificationQuery)) *AccountQuery {
-	query := (&NotificationClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// QueryAccountRoles chains the current query on the "account_roles" edge.
+func (_q *AccountQuery) QueryAccountRoles() *AccountRolesQuery {
+	query := (&AccountRolesClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountroles.Table, accountroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.AccountRolesTable, account.AccountRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	_q.withTriggeredNotifications = query
-	return _q
+	return query
 }
 
-// WithFollowing tells the query-builder to eager-load the nodes that are connected to
-// the "following" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithFollowing(opts ...func(*AccountFollowQuery)) *AccountQuery {
-	query := (&AccountFollowClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// First returns the first Account entity from the query.
+// Returns a *NotFoundError when no Account was found.
+func (_q *AccountQuery) First(ctx context.Context) (*Account, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
 	}
-	_q.withFollowing = query
-	return _q
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{account.Label}
+	}
+	return nodes[0], nil
 }
 
-// WithFollowedBy tells the query-builder to eager-load the nodes that are connected to
-// the "followed_by" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithFollowedBy(opts ...func(*AccountFollowQuery)) *AccountQuery {
-	query := (&AccountFollowClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// FirstX is like First, but panics if an error occurs.
+func (_q *AccountQuery) FirstX(ctx context.Context) *Account {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
 	}
-	_q.withFollowedBy = query
-	return _q
+	return node
 }
 
-// WithInvitations tells the query-builder to eager-load the nodes that are connected to
-// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithInvitations(opts ...func(*InvitationQuery)) *AccountQuery {
-	query := (&InvitationClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// FirstID returns the first Account ID from the query.
+// Returns a *NotFoundError when no Account ID was found.
+func (_q *AccountQuery) FirstID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
 	}
-	_q.withInvitations = query
-	return _q
+	if len(ids) == 0 {
+		err = &NotFoundError{account.Label}
+		return
+	}
+	return ids[0], nil
 }
 
-// WithInvitedBy tells the query-builder to eager-load the nodes that are connected to
-// the "invited_by" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithInvitedBy(opts ...func(*InvitationQuery)) *AccountQuery {
-	query := (&InvitationClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *AccountQuery) FirstIDX(ctx context.Context) xid.ID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
 	}
-	_q.withInvitedBy = query
-	return _q
+	return id
 }
 
-// WithPosts tells the query-builder to eager-load the nodes that are connected to
-// the "posts" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithPosts(opts ...func(*PostQuery)) *AccountQuery {
-	query := (&PostClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// Only returns a single Account entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Account entity is found.
+// Returns a *NotFoundError when no Account entities are found.
+func (_q *AccountQuery) Only(ctx context.Context) (*Account, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{account.Label}
+	default:
+		return nil, &NotSingularError{account.Label}
 	}
-	_q.withPosts = query
-	return _q
 }
 
-// WithQuestions tells the query-builder to eager-load the nodes that are connected to
-// the "questions" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithQuestions(opts ...func(*QuestionQuery)) *AccountQuery {
-	query := (&QuestionClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *AccountQuery) OnlyX(ctx context.Context) *Account {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
 	}
-	_q.withQuestions = query
-	return _q
+	return node
 }
 
-// WithReacts tells the query-builder to eager-load the nodes that are connected to
-// the "reacts" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithReacts(opts ...func(*ReactQuery)) *AccountQuery {
-	query := (&ReactClient{config: _q.config}).Query()
+// OnlyID is like Only, but returns the only Account ID in the query.
+// Returns a *NotSingularError when more than one Account ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *AccountQuery) OnlyID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{account.Label}
+	default:
+		err = &NotSingularError{account.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *AccountQuery) OnlyIDX(ctx context.Context) xid.ID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Accounts.
+func (_q *AccountQuery) All(ctx context.Context) ([]*Account, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Account, *AccountQuery]()
+	return withInterceptors[[]*Account](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *AccountQuery) AllX(ctx context.Context) []*Account {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Account IDs.
+func (_q *AccountQuery) IDs(ctx context.Context) (ids []xid.ID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(account.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *AccountQuery) IDsX(ctx context.Context) []xid.ID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *AccountQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*AccountQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *AccountQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *AccountQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *AccountQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AccountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *AccountQuery) Clone() *AccountQuery {
+	if _q == nil {
+		return nil
+	}
+	return &AccountQuery{
+		config:                           _q.config,
+		ctx:                              _q.ctx.Clone(),
+		order:                            append([]account.OrderOption{}, _q.order...),
+		inters:                           append([]Interceptor{}, _q.inters...),
+		predicates:                       append([]predicate.Account{}, _q.predicates...),
+		withSessions:                     _q.withSessions.Clone(),
+		withEmails:                       _q.withEmails.Clone(),
+		withNotifications:                _q.withNotifications.Clone(),
+		withTriggeredNotifications:       _q.withTriggeredNotifications.Clone(),
+		withFollowing:                    _q.withFollowing.Clone(),
+		withFollowedBy:                   _q.withFollowedBy.Clone(),
+		withBlocks:                       _q.withBlocks.Clone(),
+		withBlockedBy:                    _q.withBlockedBy.Clone(),
+		withTagFollows:                   _q.withTagFollows.Clone(),
+		withCategoryFollows:              _q.withCategoryFollows.Clone(),
+		withReputation:                   _q.withReputation.Clone(),
+		withBadges:                       _q.withBadges.Clone(),
+		withInvitations:                  _q.withInvitations.Clone(),
+		withInvitedBy:                    _q.withInvitedBy.Clone(),
+		withOwner:                        _q.withOwner.Clone(),
+		withBots:                         _q.withBots.Clone(),
+		withPosts:                        _q.withPosts.Clone(),
+		withQuestions:                    _q.withQuestions.Clone(),
+		withReacts:                       _q.withReacts.Clone(),
+		withLikes:                        _q.withLikes.Clone(),
+		withMentions:                     _q.withMentions.Clone(),
+		withRoles:                        _q.withRoles.Clone(),
+		withAuthentication:               _q.withAuthentication.Clone(),
+		withTags:                         _q.withTags.Clone(),
+		withCollections:                  _q.withCollections.Clone(),
+		withNodes:                        _q.withNodes.Clone(),
+		withAssets:                       _q.withAssets.Clone(),
+		withEvents:                       _q.withEvents.Clone(),
+		withPostReads:                    _q.withPostReads.Clone(),
+		withNotificationPreferences:      _q.withNotificationPreferences.Clone(),
+		withPushSubscriptions:            _q.withPushSubscriptions.Clone(),
+		withDigestSubscription:           _q.withDigestSubscription.Clone(),
+		withFeedToken:                    _q.withFeedToken.Clone(),
+		withAPIUsage:                     _q.withAPIUsage.Clone(),
+		withContentViews:                 _q.withContentViews.Clone(),
+		withReports:                      _q.withReports.Clone(),
+		withHandledReports:               _q.withHandledReports.Clone(),
+		withModerationNotes:              _q.withModerationNotes.Clone(),
+		withAuthoredModerationNotes:      _q.withAuthoredModerationNotes.Clone(),
+		withWarnings:                     _q.withWarnings.Clone(),
+		withIssuedWarnings:               _q.withIssuedWarnings.Clone(),
+		withUploadSessions:               _q.withUploadSessions.Clone(),
+		withConversations:                _q.withConversations.Clone(),
+		withDirectMessages:               _q.withDirectMessages.Clone(),
+		withSpaces:                       _q.withSpaces.Clone(),
+		withApplication:                  _q.withApplication.Clone(),
+		withReviewedApplications:         _q.withReviewedApplications.Clone(),
+		withOnboardingSteps:              _q.withOnboardingSteps.Clone(),
+		withPolicyAcceptances:            _q.withPolicyAcceptances.Clone(),
+		withBookmarks:                    _q.withBookmarks.Clone(),
+		withReadingHistory:               _q.withReadingHistory.Clone(),
+		withVerificationRequests:         _q.withVerificationRequests.Clone(),
+		withReviewedVerificationRequests: _q.withReviewedVerificationRequests.Clone(),
+		withWebhooks:                     _q.withWebhooks.Clone(),
+		withAccountRoles:                 _q.withAccountRoles.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithSessions(opts ...func(*SessionQuery)) *AccountQuery {
+	query := (&SessionClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withReacts = query
+	_q.withSessions = query
 	return _q
 }
 
-// WithLikes tells the query-builder to eager-load the nodes that are connected to
-// the "likes" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithLikes(opts ...func(*LikePostQuery)) *AccountQuery {
-	query := (&LikePostClient{config: _q.config}).Query()
+// WithEmails tells the query-builder to eager-load the nodes that are connected to
+// the "emails" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithEmails(opts ...func(*EmailQuery)) *AccountQuery {
+	query := (&EmailClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withLikes = query
+	_q.withEmails = query
 	return _q
 }
 
-// WithMentions tells the query-builder to eager-load the nodes that are connected to
-// the "mentions" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithMentions(opts ...func(*MentionProfileQuery)) *AccountQuery {
-	query := (&MentionProfileClient{config: _q.config}).Query()
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithNotifications(opts ...func(*NotificationQuery)) *AccountQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withMentions = query
+	_q.withNotifications = query
 	return _q
 }
 
-// WithRoles tells the query-builder to eager-load the nodes that are connected to
-// the "roles" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithRoles(opts ...func(*RoleQuery)) *AccountQuery {
-	query := (&RoleClient{config: _q.config}).Query()
+// WithTriggeredNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "triggered_notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithTriggeredNotifications(opts ...func(*NotificationQuery)) *AccountQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withRoles = query
+	_q.withTriggeredNotifications = query
 	return _q
 }
 
-// WithAuthentication tells the query-builder to eager-load the nodes that are connected to
-// the "authentication" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithAuthentication(opts ...func(*AuthenticationQuery)) *AccountQuery {
-	query := (&AuthenticationClient{config: _q.config}).Query()
+// WithFollowing tells the query-builder to eager-load the nodes that are connected to
+// the "following" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithFollowing(opts ...func(*AccountFollowQuery)) *AccountQuery {
+	query := (&AccountFollowClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withAuthentication = query
+	_q.withFollowing = query
 	return _q
 }
 
-// WithTags tells the query-builder to eager-load the nodes that are connected to
-// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithTags(opts ...func(*TagQuery)) *AccountQuery {
-	query := (&TagClient{config: _q.config}).Query()
+// WithFollowedBy tells the query-builder to eager-load the nodes that are connected to
+// the "followed_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithFollowedBy(opts ...func(*AccountFollowQuery)) *AccountQuery {
+	query := (&AccountFollowClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withTags = query
+	_q.withFollowedBy = query
 	return _q
 }
 
-// WithCollections tells the query-builder to eager-load the nodes that are connected to
-// the "collections" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithCollections(opts ...func(*CollectionQuery)) *AccountQuery {
-	query := (&CollectionClient{config: _q.config}).Query()
+// WithBlocks tells the query-builder to eager-load the nodes that are connected to
+// the "blocks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBlocks(opts ...func(*AccountBlockQuery)) *AccountQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withCollections = query
+	_q.withBlocks = query
 	return _q
 }
 
-// WithNodes tells the query-builder to eager-load the nodes that are connected to
-// the "nodes" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithNodes(opts ...func(*NodeQuery)) *AccountQuery {
-	query := (&NodeClient{config: _q.config}).Query()
+// WithBlockedBy tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBlockedBy(opts ...func(*AccountBlockQuery)) *AccountQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withNodes = query
+	_q.withBlockedBy = query
 	return _q
 }
 
-// WithAssets tells the query-builder to eager-load the nodes that are connected to
-// the "assets" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithAssets(opts ...func(*AssetQuery)) *AccountQuery {
-	query := (&AssetClient{config: _q.config}).Query()
+// WithTagFollows tells the query-builder to eager-load the nodes that are connected to
+// the "tag_follows" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithTagFollows(opts ...func(*TagFollowQuery)) *AccountQuery {
+	query := (&TagFollowClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withAssets = query
+	_q.withTagFollows = query
 	return _q
 }
 
-// WithEvents tells the query-builder to eager-load the nodes that are connected to
-// the "events" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithEvents(opts ...func(*EventParticipantQuery)) *AccountQuery {
-	query := (&EventParticipantClient{config: _q.config}).Query()
+// WithCategoryFollows tells the query-builder to eager-load the nodes that are connected to
+// the "category_follows" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithCategoryFollows(opts ...func(*CategoryFollowQuery)) *AccountQuery {
+	query := (&CategoryFollowClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withEvents = query
+	_q.withCategoryFollows = query
 	return _q
 }
 
-// WithPostReads tells the query-builder to eager-load the nodes that are connected to
-// the "post_reads" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithPostReads(opts ...func(*PostReadQuery)) *AccountQuery {
-	query := (&PostReadClient{config: _q.config}).Query()
+// WithReputation tells the query-builder to eager-load the nodes that are connected to
+// the "reputation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithReputation(opts ...func(*ReputationEntryQuery)) *AccountQuery {
+	query := (&ReputationEntryClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withPostReads = query
+	_q.withReputation = query
 	return _q
 }
 
-// WithReports tells the query-builder to eager-load the nodes that are connected to
-// the "reports" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithReports(opts ...func(*ReportQuery)) *AccountQuery {
-	query := (&ReportClient{config: _q.config}).Query()
+// WithBadges tells the query-builder to eager-load the nodes that are connected to
+// the "badges" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBadges(opts ...func(*AccountBadgeQuery)) *AccountQuery {
+	query := (&AccountBadgeClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withReports = query
+	_q.withBadges = query
 	return _q
 }
 
-// WithHandledReports tells the query-builder to eager-load the nodes that are connected to
-// the "handled_reports" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithHandledReports(opts ...func(*ReportQuery)) *AccountQuery {
-	query := (&ReportClient{config: _q.config}).Query()
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithInvitations(opts ...func(*InvitationQuery)) *AccountQuery {
+	query := (&InvitationClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withHandledReports = query
+	_q.withInvitations = query
 	return _q
 }
 
-// WithAccountRoles tells the query-builder to eager-load the nodes that are connected to
-// the "account_roles" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *AccountQuery) WithAccountRoles(opts ...func(*AccountRolesQuery)) *AccountQuery {
-	query := (&AccountRolesClient{config: _q.config}).Query()
+// WithInvitedBy tells the query-builder to eager-load the nodes that are connected to
+// the "invited_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithInvitedBy(opts ...func(*InvitationQuery)) *AccountQuery {
+	query := (&InvitationClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withAccountRoles = query
+	_q.withInvitedBy = query
 	return _q
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.Account.Query().
-//		GroupBy(account.FieldCreatedAt).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (_q *AccountQuery) GroupBy(field string, fields ...string) *AccountGroupBy {
-	_q.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &AccountGroupBy{build: _q}
-	grbuild.flds = &_q.ctx.Fields
-	grbuild.label = account.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithOwner(opts ...func(*AccountQuery)) *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOwner = query
+	return _q
 }
 
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
-//	}
-//
-//	client.Account.Query().
-//		Select(account.FieldCreatedAt).
-//		Scan(ctx, &v)
-func (_q *AccountQuery) Select(fields ...string) *AccountSelect {
-	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
-	sbuild := &AccountSelect{AccountQuery: _q}
-	sbuild.label = account.Label
-	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
-	return sbuild
+// WithBots tells the query-builder to eager-load the nodes that are connected to
+// the "bots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBots(opts ...func(*AccountQuery)) *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBots = query
+	return _q
 }
 
-// Aggregate returns a AccountSelect configured with the given aggregations.
-func (_q *AccountQuery) Aggregate(fns ...AggregateFunc) *AccountSelect {
-	return _q.Select().Aggregate(fns...)
+// WithPosts tells the query-builder to eager-load the nodes that are connected to
+// the "posts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithPosts(opts ...func(*PostQuery)) *AccountQuery {
+	query := (&PostClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPosts = query
+	return _q
 }
 
-func (_q *AccountQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range _q.inters {
-		if inter == nil {
-			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
-		}
-		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, _q); err != nil {
-				return err
+// WithQuestions tells the query-builder to eager-load the nodes that are connected to
+// the "questions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithQuestions(opts ...func(*QuestionQuery)) *AccountQuery {
+	query := (&QuestionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuestions = query
+	return _q
+}
+
+// WithReacts tells the query-builder to eager-load the nodes that are connected to
+// the "reacts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithReacts(opts ...func(*ReactQuery)) *AccountQuery {
+	query := (&ReactClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReacts = query
+	return _q
+}
+
+// WithLikes tells the query-builder to eager-load the nodes that are connected to
+// the "likes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithLikes(opts ...func(*LikePostQuery)) *AccountQuery {
+	query := (&LikePostClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLikes = query
+	return _q
+}
+
+// WithMentions tells the query-builder to eager-load the nodes that are connected to
+// the "mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithMentions(opts ...func(*MentionProfileQuery)) *AccountQuery {
+	query := (&MentionProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMentions = query
+	return _q
+}
+
+// WithRoles tells the query-builder to eager-load the nodes that are connected to
+// the "roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithRoles(opts ...func(*RoleQuery)) *AccountQuery {
+	query := (&RoleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRoles = query
+	return _q
+}
+
+// WithAuthentication tells the query-builder to eager-load the nodes that are connected to
+// the "authentication" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithAuthentication(opts ...func(*AuthenticationQuery)) *AccountQuery {
+	query := (&AuthenticationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuthentication = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithTags(opts ...func(*TagQuery)) *AccountQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithCollections tells the query-builder to eager-load the nodes that are connected to
+// the "collections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithCollections(opts ...func(*CollectionQuery)) *AccountQuery {
+	query := (&CollectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCollections = query
+	return _q
+}
+
+// WithNodes tells the query-builder to eager-load the nodes that are connected to
+// the "nodes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithNodes(opts ...func(*NodeQuery)) *AccountQuery {
+	query := (&NodeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNodes = query
+	return _q
+}
+
+// WithAssets tells the query-builder to eager-load the nodes that are connected to
+// the "assets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithAssets(opts ...func(*AssetQuery)) *AccountQuery {
+	query := (&AssetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssets = query
+	return _q
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithEvents(opts ...func(*EventParticipantQuery)) *AccountQuery {
+	query := (&EventParticipantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEvents = query
+	return _q
+}
+
+// WithPostReads tells the query-builder to eager-load the nodes that are connected to
+// the "post_reads" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithPostReads(opts ...func(*PostReadQuery)) *AccountQuery {
+	query := (&PostReadClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPostReads = query
+	return _q
+}
+
+// WithNotificationPreferences tells the query-builder to eager-load the nodes that are connected to
+// the "notification_preferences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithNotificationPreferences(opts ...func(*NotificationPreferenceQuery)) *AccountQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationPreferences = query
+	return _q
+}
+
+// WithPushSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "push_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithPushSubscriptions(opts ...func(*PushSubscriptionQuery)) *AccountQuery {
+	query := (&PushSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPushSubscriptions = query
+	return _q
+}
+
+// WithDigestSubscription tells the query-builder to eager-load the nodes that are connected to
+// the "digest_subscription" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithDigestSubscription(opts ...func(*DigestSubscriptionQuery)) *AccountQuery {
+	query := (&DigestSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDigestSubscription = query
+	return _q
+}
+
+// WithFeedToken tells the query-builder to eager-load the nodes that are connected to
+// the "feed_token" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithFeedToken(opts ...func(*FeedTokenQuery)) *AccountQuery {
+	query := (&FeedTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFeedToken = query
+	return _q
+}
+
+// WithAPIUsage tells the query-builder to eager-load the nodes that are connected to
+// the "api_usage" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithAPIUsage(opts ...func(*APIUsageQuery)) *AccountQuery {
+	query := (&APIUsageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAPIUsage = query
+	return _q
+}
+
+// WithContentViews tells the query-builder to eager-load the nodes that are connected to
+// the "content_views" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithContentViews(opts ...func(*ContentViewQuery)) *AccountQuery {
+	query := (&ContentViewClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContentViews = query
+	return _q
+}
+
+// WithReports tells the query-builder to eager-load the nodes that are connected to
+// the "reports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithReports(opts ...func(*ReportQuery)) *AccountQuery {
+	query := (&ReportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReports = query
+	return _q
+}
+
+// WithHandledReports tells the query-builder to eager-load the nodes that are connected to
+// the "handled_reports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithHandledReports(opts ...func(*ReportQuery)) *AccountQuery {
+	query := (&ReportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHandledReports = query
+	return _q
+}
+
+// WithModerationNotes tells the query-builder to eager-load the nodes that are connected to
+// the "moderation_notes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithModerationNotes(opts ...func(*ModerationNoteQuery)) *AccountQuery {
+	query := (&ModerationNoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withModerationNotes = query
+	return _q
+}
+
+// WithAuthoredModerationNotes tells the query-builder to eager-load the nodes that are connected to
+// the "authored_moderation_notes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithAuthoredModerationNotes(opts ...func(*ModerationNoteQuery)) *AccountQuery {
+	query := (&ModerationNoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuthoredModerationNotes = query
+	return _q
+}
+
+// WithWarnings tells the query-builder to eager-load the nodes that are connected to
+// the "warnings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithWarnings(opts ...func(*WarningQuery)) *AccountQuery {
+	query := (&WarningClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWarnings = query
+	return _q
+}
+
+// WithIssuedWarnings tells the query-builder to eager-load the nodes that are connected to
+// the "issued_warnings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithIssuedWarnings(opts ...func(*WarningQuery)) *AccountQuery {
+	query := (&WarningClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withIssuedWarnings = query
+	return _q
+}
+
+// WithUploadSessions tells the query-builder to eager-load the nodes that are connected to
+// the "upload_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithUploadSessions(opts ...func(*UploadSessionQuery)) *AccountQuery {
+	query := (&UploadSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUploadSessions = query
+	return _q
+}
+
+// WithConversations tells the query-builder to eager-load the nodes that are connected to
+// the "conversations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithConversations(opts ...func(*ConversationParticipantQuery)) *AccountQuery {
+	query := (&ConversationParticipantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConversations = query
+	return _q
+}
+
+// WithDirectMessages tells the query-builder to eager-load the nodes that are connected to
+// the "direct_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithDirectMessages(opts ...func(*DirectMessageQuery)) *AccountQuery {
+	query := (&DirectMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDirectMessages = query
+	return _q
+}
+
+// WithSpaces tells the query-builder to eager-load the nodes that are connected to
+// the "spaces" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithSpaces(opts ...func(*SpaceMemberQuery)) *AccountQuery {
+	query := (&SpaceMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSpaces = query
+	return _q
+}
+
+// WithApplication tells the query-builder to eager-load the nodes that are connected to
+// the "application" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithApplication(opts ...func(*AccountApplicationQuery)) *AccountQuery {
+	query := (&AccountApplicationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withApplication = query
+	return _q
+}
+
+// WithReviewedApplications tells the query-builder to eager-load the nodes that are connected to
+// the "reviewed_applications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithReviewedApplications(opts ...func(*AccountApplicationQuery)) *AccountQuery {
+	query := (&AccountApplicationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReviewedApplications = query
+	return _q
+}
+
+// WithOnboardingSteps tells the query-builder to eager-load the nodes that are connected to
+// the "onboarding_steps" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithOnboardingSteps(opts ...func(*AccountOnboardingStepQuery)) *AccountQuery {
+	query := (&AccountOnboardingStepClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOnboardingSteps = query
+	return _q
+}
+
+// WithPolicyAcceptances tells the query-builder to eager-load the nodes that are connected to
+// the "policy_acceptances" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithPolicyAcceptances(opts ...func(*PolicyAcceptanceQuery)) *AccountQuery {
+	query := (&PolicyAcceptanceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPolicyAcceptances = query
+	return _q
+}
+
+// WithBookmarks tells the query-builder to eager-load the nodes that are connected to
+// the "bookmarks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBookmarks(opts ...func(*BookmarkQuery)) *AccountQuery {
+	query := (&BookmarkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBookmarks = query
+	return _q
+}
+
+// WithReadingHistory tells the query-builder to eager-load the nodes that are connected to
+// the "reading_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithReadingHistory(opts ...func(*ReadingHistoryQuery)) *AccountQuery {
+	query := (&ReadingHistoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReadingHistory = query
+	return _q
+}
+
+// WithVerificationRequests tells the query-builder to eager-load the nodes that are connected to
+// the "verification_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithVerificationRequests(opts ...func(*VerificationRequestQuery)) *AccountQuery {
+	query := (&VerificationRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVerificationRequests = query
+	return _q
+}
+
+// WithReviewedVerificationRequests tells the query-builder to eager-load the nodes that are connected to
+// the "reviewed_verification_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithReviewedVerificationRequests(opts ...func(*VerificationRequestQuery)) *AccountQuery {
+	query := (&VerificationRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReviewedVerificationRequests = query
+	return _q
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithWebhooks(opts ...func(*WebhookQuery)) *AccountQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhooks = query
+	return _q
+}
+
+// WithAccountRoles tells the query-builder to eager-load the nodes that are connected to
+// the "account_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithAccountRoles(opts ...func(*AccountRolesQuery)) *AccountQuery {
+	query := (&AccountRolesClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccountRoles = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Account.Query().
+//		GroupBy(account.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *AccountQuery) GroupBy(field string, fields ...string) *AccountGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AccountGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = account.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Account.Query().
+//		Select(account.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *AccountQuery) Select(fields ...string) *AccountSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &AccountSelect{AccountQuery: _q}
+	sbuild.label = account.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AccountSelect configured with the given aggregations.
+func (_q *AccountQuery) Aggregate(fns ...AggregateFunc) *AccountSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *AccountQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !account.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Account, error) {
+	var (
+		nodes       = []*Account{}
+		_spec       = _q.querySpec()
+		loadedTypes = [55]bool{
+			_q.withSessions != nil,
+			_q.withEmails != nil,
+			_q.withNotifications != nil,
+			_q.withTriggeredNotifications != nil,
+			_q.withFollowing != nil,
+			_q.withFollowedBy != nil,
+			_q.withBlocks != nil,
+			_q.withBlockedBy != nil,
+			_q.withTagFollows != nil,
+			_q.withCategoryFollows != nil,
+			_q.withReputation != nil,
+			_q.withBadges != nil,
+			_q.withInvitations != nil,
+			_q.withInvitedBy != nil,
+			_q.withOwner != nil,
+			_q.withBots != nil,
+			_q.withPosts != nil,
+			_q.withQuestions != nil,
+			_q.withReacts != nil,
+			_q.withLikes != nil,
+			_q.withMentions != nil,
+			_q.withRoles != nil,
+			_q.withAuthentication != nil,
+			_q.withTags != nil,
+			_q.withCollections != nil,
+			_q.withNodes != nil,
+			_q.withAssets != nil,
+			_q.withEvents != nil,
+			_q.withPostReads != nil,
+			_q.withNotificationPreferences != nil,
+			_q.withPushSubscriptions != nil,
+			_q.withDigestSubscription != nil,
+			_q.withFeedToken != nil,
+			_q.withAPIUsage != nil,
+			_q.withContentViews != nil,
+			_q.withReports != nil,
+			_q.withHandledReports != nil,
+			_q.withModerationNotes != nil,
+			_q.withAuthoredModerationNotes != nil,
+			_q.withWarnings != nil,
+			_q.withIssuedWarnings != nil,
+			_q.withUploadSessions != nil,
+			_q.withConversations != nil,
+			_q.withDirectMessages != nil,
+			_q.withSpaces != nil,
+			_q.withApplication != nil,
+			_q.withReviewedApplications != nil,
+			_q.withOnboardingSteps != nil,
+			_q.withPolicyAcceptances != nil,
+			_q.withBookmarks != nil,
+			_q.withReadingHistory != nil,
+			_q.withVerificationRequests != nil,
+			_q.withReviewedVerificationRequests != nil,
+			_q.withWebhooks != nil,
+			_q.withAccountRoles != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Account).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Account{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withSessions; query != nil {
+		if err := _q.loadSessions(ctx, query, nodes,
+			func(n *Account) { n.Edges.Sessions = []*Session{} },
+			func(n *Account, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEmails; query != nil {
+		if err := _q.loadEmails(ctx, query, nodes,
+			func(n *Account) { n.Edges.Emails = []*Email{} },
+			func(n *Account, e *Email) { n.Edges.Emails = append(n.Edges.Emails, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotifications; query != nil {
+		if err := _q.loadNotifications(ctx, query, nodes,
+			func(n *Account) { n.Edges.Notifications = []*Notification{} },
+			func(n *Account, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTriggeredNotifications; query != nil {
+		if err := _q.loadTriggeredNotifications(ctx, query, nodes,
+			func(n *Account) { n.Edges.TriggeredNotifications = []*Notification{} },
+			func(n *Account, e *Notification) {
+				n.Edges.TriggeredNotifications = append(n.Edges.TriggeredNotifications, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFollowing; query != nil {
+		if err := _q.loadFollowing(ctx, query, nodes,
+			func(n *Account) { n.Edges.Following = []*AccountFollow{} },
+			func(n *Account, e *AccountFollow) { n.Edges.Following = append(n.Edges.Following, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFollowedBy; query != nil {
+		if err := _q.loadFollowedBy(ctx, query, nodes,
+			func(n *Account) { n.Edges.FollowedBy = []*AccountFollow{} },
+			func(n *Account, e *AccountFollow) { n.Edges.FollowedBy = append(n.Edges.FollowedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBlocks; query != nil {
+		if err := _q.loadBlocks(ctx, query, nodes,
+			func(n *Account) { n.Edges.Blocks = []*AccountBlock{} },
+			func(n *Account, e *AccountBlock) { n.Edges.Blocks = append(n.Edges.Blocks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBlockedBy; query != nil {
+		if err := _q.loadBlockedBy(ctx, query, nodes,
+			func(n *Account) { n.Edges.BlockedBy = []*AccountBlock{} },
+			func(n *Account, e *AccountBlock) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTagFollows; query != nil {
+		if err := _q.loadTagFollows(ctx, query, nodes,
+			func(n *Account) { n.Edges.TagFollows = []*TagFollow{} },
+			func(n *Account, e *TagFollow) { n.Edges.TagFollows = append(n.Edges.TagFollows, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCategoryFollows; query != nil {
+		if err := _q.loadCategoryFollows(ctx, query, nodes,
+			func(n *Account) { n.Edges.CategoryFollows = []*CategoryFollow{} },
+			func(n *Account, e *CategoryFollow) { n.Edges.CategoryFollows = append(n.Edges.CategoryFollows, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReputation; query != nil {
+		if err := _q.loadReputation(ctx, query, nodes,
+			func(n *Account) { n.Edges.Reputation = []*ReputationEntry{} },
+			func(n *Account, e *ReputationEntry) { n.Edges.Reputation = append(n.Edges.Reputation, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBadges; query != nil {
+		if err := _q.loadBadges(ctx, query, nodes,
+			func(n *Account) { n.Edges.Badges = []*AccountBadge{} },
+			func(n *Account, e *AccountBadge) { n.Edges.Badges = append(n.Edges.Badges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvitations; query != nil {
+		if err := _q.loadInvitations(ctx, query, nodes,
+			func(n *Account) { n.Edges.Invitations = []*Invitation{} },
+			func(n *Account, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvitedBy; query != nil {
+		if err := _q.loadInvitedBy(ctx, query, nodes, nil,
+			func(n *Account, e *Invitation) { n.Edges.InvitedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOwner; query != nil {
+		if err := _q.loadOwner(ctx, query, nodes, nil,
+			func(n *Account, e *Account) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBots; query != nil {
+		if err := _q.loadBots(ctx, query, nodes,
+			func(n *Account) { n.Edges.Bots = []*Account{} },
+			func(n *Account, e *Account) { n.Edges.Bots = append(n.Edges.Bots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPosts; query != nil {
+		if err := _q.loadPosts(ctx, query, nodes,
+			func(n *Account) { n.Edges.Posts = []*Post{} },
+			func(n *Account, e *Post) { n.Edges.Posts = append(n.Edges.Posts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withQuestions; query != nil {
+		if err := _q.loadQuestions(ctx, query, nodes,
+			func(n *Account) { n.Edges.Questions = []*Question{} },
+			func(n *Account, e *Question) { n.Edges.Questions = append(n.Edges.Questions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReacts; query != nil {
+		if err := _q.loadReacts(ctx, query, nodes,
+			func(n *Account) { n.Edges.Reacts = []*React{} },
+			func(n *Account, e *React) { n.Edges.Reacts = append(n.Edges.Reacts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLikes; query != nil {
+		if err := _q.loadLikes(ctx, query, nodes,
+			func(n *Account) { n.Edges.Likes = []*LikePost{} },
+			func(n *Account, e *LikePost) { n.Edges.Likes = append(n.Edges.Likes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMentions; query != nil {
+		if err := _q.loadMentions(ctx, query, nodes,
+			func(n *Account) { n.Edges.Mentions = []*MentionProfile{} },
+			func(n *Account, e *MentionProfile) { n.Edges.Mentions = append(n.Edges.Mentions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRoles; query != nil {
+		if err := _q.loadRoles(ctx, query, nodes,
+			func(n *Account) { n.Edges.Roles = []*Role{} },
+			func(n *Account, e *Role) { n.Edges.Roles = append(n.Edges.Roles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAuthentication; query != nil {
+		if err := _q.loadAuthentication(ctx, query, nodes,
+			func(n *Account) { n.Edges.Authentication = []*Authentication{} },
+			func(n *Account, e *Authentication) { n.Edges.Authentication = append(n.Edges.Authentication, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Account) { n.Edges.Tags = []*Tag{} },
+			func(n *Account, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCollections; query != nil {
+		if err := _q.loadCollections(ctx, query, nodes,
+			func(n *Account) { n.Edges.Collections = []*Collection{} },
+			func(n *Account, e *Collection) { n.Edges.Collections = append(n.Edges.Collections, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNodes; query != nil {
+		if err := _q.loadNodes(ctx, query, nodes,
+			func(n *Account) { n.Edges.Nodes = []*Node{} },
+			func(n *Account, e *Node) { n.Edges.Nodes = append(n.Edges.Nodes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAssets; query != nil {
+		if err := _q.loadAssets(ctx, query, nodes,
+			func(n *Account) { n.Edges.Assets = []*Asset{} },
+			func(n *Account, e *Asset) { n.Edges.Assets = append(n.Edges.Assets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEvents; query != nil {
+		if err := _q.loadEvents(ctx, query, nodes,
+			func(n *Account) { n.Edges.Events = []*EventParticipant{} },
+			func(n *Account, e *EventParticipant) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPostReads; query != nil {
+		if err := _q.loadPostReads(ctx, query, nodes,
+			func(n *Account) { n.Edges.PostReads = []*PostRead{} },
+			func(n *Account, e *PostRead) { n.Edges.PostReads = append(n.Edges.PostReads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationPreferences; query != nil {
+		if err := _q.loadNotificationPreferences(ctx, query, nodes,
+			func(n *Account) { n.Edges.NotificationPreferences = []*NotificationPreference{} },
+			func(n *Account, e *NotificationPreference) {
+				n.Edges.NotificationPreferences = append(n.Edges.NotificationPreferences, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPushSubscriptions; query != nil {
+		if err := _q.loadPushSubscriptions(ctx, query, nodes,
+			func(n *Account) { n.Edges.PushSubscriptions = []*PushSubscription{} },
+			func(n *Account, e *PushSubscription) {
+				n.Edges.PushSubscriptions = append(n.Edges.PushSubscriptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDigestSubscription; query != nil {
+		if err := _q.loadDigestSubscription(ctx, query, nodes, nil,
+			func(n *Account, e *DigestSubscription) { n.Edges.DigestSubscription = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFeedToken; query != nil {
+		if err := _q.loadFeedToken(ctx, query, nodes, nil,
+			func(n *Account, e *FeedToken) { n.Edges.FeedToken = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAPIUsage; query != nil {
+		if err := _q.loadAPIUsage(ctx, query, nodes,
+			func(n *Account) { n.Edges.APIUsage = []*APIUsage{} },
+			func(n *Account, e *APIUsage) { n.Edges.APIUsage = append(n.Edges.APIUsage, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContentViews; query != nil {
+		if err := _q.loadContentViews(ctx, query, nodes,
+			func(n *Account) { n.Edges.ContentViews = []*ContentView{} },
+			func(n *Account, e *ContentView) { n.Edges.ContentViews = append(n.Edges.ContentViews, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReports; query != nil {
+		if err := _q.loadReports(ctx, query, nodes,
+			func(n *Account) { n.Edges.Reports = []*Report{} },
+			func(n *Account, e *Report) { n.Edges.Reports = append(n.Edges.Reports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHandledReports; query != nil {
+		if err := _q.loadHandledReports(ctx, query, nodes,
+			func(n *Account) { n.Edges.HandledReports = []*Report{} },
+			func(n *Account, e *Report) { n.Edges.HandledReports = append(n.Edges.HandledReports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withModerationNotes; query != nil {
+		if err := _q.loadModerationNotes(ctx, query, nodes,
+			func(n *Account) { n.Edges.ModerationNotes = []*ModerationNote{} },
+			func(n *Account, e *ModerationNote) { n.Edges.ModerationNotes = append(n.Edges.ModerationNotes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAuthoredModerationNotes; query != nil {
+		if err := _q.loadAuthoredModerationNotes(ctx, query, nodes,
+			func(n *Account) { n.Edges.AuthoredModerationNotes = []*ModerationNote{} },
+			func(n *Account, e *ModerationNote) {
+				n.Edges.AuthoredModerationNotes = append(n.Edges.AuthoredModerationNotes, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWarnings; query != nil {
+		if err := _q.loadWarnings(ctx, query, nodes,
+			func(n *Account) { n.Edges.Warnings = []*Warning{} },
+			func(n *Account, e *Warning) { n.Edges.Warnings = append(n.Edges.Warnings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withIssuedWarnings; query != nil {
+		if err := _q.loadIssuedWarnings(ctx, query, nodes,
+			func(n *Account) { n.Edges.IssuedWarnings = []*Warning{} },
+			func(n *Account, e *Warning) { n.Edges.IssuedWarnings = append(n.Edges.IssuedWarnings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUploadSessions; query != nil {
+		if err := _q.loadUploadSessions(ctx, query, nodes,
+			func(n *Account) { n.Edges.UploadSessions = []*UploadSession{} },
+			func(n *Account, e *UploadSession) { n.Edges.UploadSessions = append(n.Edges.UploadSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withConversations; query != nil {
+		if err := _q.loadConversations(ctx, query, nodes,
+			func(n *Account) { n.Edges.Conversations = []*ConversationParticipant{} },
+			func(n *Account, e *ConversationParticipant) { n.Edges.Conversations = append(n.Edges.Conversations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDirectMessages; query != nil {
+		if err := _q.loadDirectMessages(ctx, query, nodes,
+			func(n *Account) { n.Edges.DirectMessages = []*DirectMessage{} },
+			func(n *Account, e *DirectMessage) { n.Edges.DirectMessages = append(n.Edges.DirectMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSpaces; query != nil {
+		if err := _q.loadSpaces(ctx, query, nodes,
+			func(n *Account) { n.Edges.Spaces = []*SpaceMember{} },
+			func(n *Account, e *SpaceMember) { n.Edges.Spaces = append(n.Edges.Spaces, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withApplication; query != nil {
+		if err := _q.loadApplication(ctx, query, nodes, nil,
+			func(n *Account, e *AccountApplication) { n.Edges.Application = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReviewedApplications; query != nil {
+		if err := _q.loadReviewedApplications(ctx, query, nodes,
+			func(n *Account) { n.Edges.ReviewedApplications = []*AccountApplication{} },
+			func(n *Account, e *AccountApplication) {
+				n.Edges.ReviewedApplications = append(n.Edges.ReviewedApplications, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOnboardingSteps; query != nil {
+		if err := _q.loadOnboardingSteps(ctx, query, nodes,
+			func(n *Account) { n.Edges.OnboardingSteps = []*AccountOnboardingStep{} },
+			func(n *Account, e *AccountOnboardingStep) {
+				n.Edges.OnboardingSteps = append(n.Edges.OnboardingSteps, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPolicyAcceptances; query != nil {
+		if err := _q.loadPolicyAcceptances(ctx, query, nodes,
+			func(n *Account) { n.Edges.PolicyAcceptances = []*PolicyAcceptance{} },
+			func(n *Account, e *PolicyAcceptance) {
+				n.Edges.PolicyAcceptances = append(n.Edges.PolicyAcceptances, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBookmarks; query != nil {
+		if err := _q.loadBookmarks(ctx, query, nodes,
+			func(n *Account) { n.Edges.Bookmarks = []*Bookmark{} },
+			func(n *Account, e *Bookmark) { n.Edges.Bookmarks = append(n.Edges.Bookmarks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReadingHistory; query != nil {
+		if err := _q.loadReadingHistory(ctx, query, nodes,
+			func(n *Account) { n.Edges.ReadingHistory = []*ReadingHistory{} },
+			func(n *Account, e *ReadingHistory) { n.Edges.ReadingHistory = append(n.Edges.ReadingHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVerificationRequests; query != nil {
+		if err := _q.loadVerificationRequests(ctx, query, nodes,
+			func(n *Account) { n.Edges.VerificationRequests = []*VerificationRequest{} },
+			func(n *Account, e *VerificationRequest) {
+				n.Edges.VerificationRequests = append(n.Edges.VerificationRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReviewedVerificationRequests; query != nil {
+		if err := _q.loadReviewedVerificationRequests(ctx, query, nodes,
+			func(n *Account) { n.Edges.ReviewedVerificationRequests = []*VerificationRequest{} },
+			func(n *Account, e *VerificationRequest) {
+				n.Edges.ReviewedVerificationRequests = append(n.Edges.ReviewedVerificationRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhooks; query != nil {
+		if err := _q.loadWebhooks(ctx, query, nodes,
+			func(n *Account) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *Account, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccountRoles; query != nil {
+		if err := _q.loadAccountRoles(ctx, query, nodes,
+			func(n *Account) { n.Edges.AccountRoles = []*AccountRoles{} },
+			func(n *Account, e *AccountRoles) { n.Edges.AccountRoles = append(n.Edges.AccountRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *AccountQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*Account, init func(*Account), assign func(*Account, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(session.FieldAccountID)
+	}
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadEmails(ctx context.Context, query *EmailQuery, nodes []*Account, init func(*Account), assign func(*Account, *Email)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(email.FieldAccountID)
+	}
+	query.Where(predicate.Email(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.EmailsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldOwnerAccountID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadTriggeredNotifications(ctx context.Context, query *NotificationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldSourceAccountID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.TriggeredNotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SourceAccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "source_account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "source_account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadFollowing(ctx context.Context, query *AccountFollowQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountFollow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountfollow.FieldFollowerAccountID)
+	}
+	query.Where(predicate.AccountFollow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.FollowingColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FollowerAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "follower_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadFollowedBy(ctx context.Context, query *AccountFollowQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountFollow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountfollow.FieldFollowingAccountID)
+	}
+	query.Where(predicate.AccountFollow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.FollowedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FollowingAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "following_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBlocks(ctx context.Context, query *AccountBlockQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountBlock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountblock.FieldAccountID)
+	}
+	query.Where(predicate.AccountBlock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BlocksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBlockedBy(ctx context.Context, query *AccountBlockQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountBlock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountblock.FieldTargetAccountID)
+	}
+	query.Where(predicate.AccountBlock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BlockedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadTagFollows(ctx context.Context, query *TagFollowQuery, nodes []*Account, init func(*Account), assign func(*Account, *TagFollow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tagfollow.FieldAccountID)
+	}
+	query.Where(predicate.TagFollow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.TagFollowsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadCategoryFollows(ctx context.Context, query *CategoryFollowQuery, nodes []*Account, init func(*Account), assign func(*Account, *CategoryFollow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(categoryfollow.FieldAccountID)
+	}
+	query.Where(predicate.CategoryFollow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.CategoryFollowsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadReputation(ctx context.Context, query *ReputationEntryQuery, nodes []*Account, init func(*Account), assign func(*Account, *ReputationEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reputationentry.FieldAccountID)
+	}
+	query.Where(predicate.ReputationEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ReputationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBadges(ctx context.Context, query *AccountBadgeQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountBadge)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountbadge.FieldAccountID)
+	}
+	query.Where(predicate.AccountBadge(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BadgesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Invitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invitation.FieldCreatorAccountID)
+	}
+	query.Where(predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatorAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "creator_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadInvitedBy(ctx context.Context, query *InvitationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Invitation)) error {
+	ids := make([]xid.ID, 0, len(nodes))
+	nodeids := make(map[xid.ID][]*Account)
+	for i := range nodes {
+		if nodes[i].InvitedByID == nil {
+			continue
+		}
+		fk := *nodes[i].InvitedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(invitation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "invited_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *AccountQuery) loadOwner(ctx context.Context, query *AccountQuery, nodes []*Account, init func(*Account), assign func(*Account, *Account)) error {
+	ids := make([]xid.ID, 0, len(nodes))
+	nodeids := make(map[xid.ID][]*Account)
+	for i := range nodes {
+		if nodes[i].OwnerID == nil {
+			continue
+		}
+		fk := *nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBots(ctx context.Context, query *AccountQuery, nodes []*Account, init func(*Account), assign func(*Account, *Account)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(account.FieldOwnerID)
+	}
+	query.Where(predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "owner_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*Account, init func(*Account), assign func(*Account, *Post)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(post.FieldAccountPosts)
+	}
+	query.Where(predicate.Post(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.PostsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountPosts
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_posts" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadQuestions(ctx context.Context, query *QuestionQuery, nodes []*Account, init func(*Account), assign func(*Account, *Question)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(question.FieldAccountID)
+	}
+	query.Where(predicate.Question(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.QuestionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadReacts(ctx context.Context, query *ReactQuery, nodes []*Account, init func(*Account), assign func(*Account, *React)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(react.FieldAccountID)
+	}
+	query.Where(predicate.React(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ReactsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadLikes(ctx context.Context, query *LikePostQuery, nodes []*Account, init func(*Account), assign func(*Account, *LikePost)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(likepost.FieldAccountID)
+	}
+	query.Where(predicate.LikePost(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.LikesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadMentions(ctx context.Context, query *MentionProfileQuery, nodes []*Account, init func(*Account), assign func(*Account, *MentionProfile)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(mentionprofile.FieldAccountID)
+	}
+	query.Where(predicate.MentionProfile(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.MentionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadRoles(ctx context.Context, query *RoleQuery, nodes []*Account, init func(*Account), assign func(*Account, *Role)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[xid.ID]*Account)
+	nids := make(map[xid.ID]map[*Account]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(account.RolesTable)
+		s.Join(joinT).On(s.C(role.FieldID), joinT.C(account.RolesPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(account.RolesPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(account.RolesPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(xid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*xid.ID)
+				inValue := *values[1].(*xid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Account]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
 			}
-		}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Role](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
 	}
-	for _, f := range _q.ctx.Fields {
-		if !account.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "roles" node returned %v`, n.ID)
 		}
-	}
-	if _q.path != nil {
-		prev, err := _q.path(ctx)
-		if err != nil {
-			return err
+		for kn := range nodes {
+			assign(kn, n)
 		}
-		_q.sql = prev
 	}
 	return nil
 }
-
-func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Account, error) {
-	var (
-		nodes       = []*Account{}
-		_spec       = _q.querySpec()
-		loadedTypes = [24]bool{
-			_q.withSessions != nil,
-			_q.withEmails != nil,
-			_q.withNotifications != nil,
-			_q.withTriggeredNotifications != nil,
-			_q.withFollowing != nil,
-			_q.withFollowedBy != nil,
-			_q.withInvitations != nil,
-			_q.withInvitedBy != nil,
-			_q.withPosts != nil,
-			_q.withQuestions != nil,
-			_q.withReacts != nil,
-			_q.withLikes != nil,
-			_q.withMentions != nil,
-			_q.withRoles != nil,
-			_q.withAuthentication != nil,
-			_q.withTags != nil,
-			_q.withCollections != nil,
-			_q.withNodes != nil,
-			_q.withAssets != nil,
-			_q.withEvents != nil,
-			_q.withPostReads != nil,
-			_q.withReports != nil,
-			_q.withHandledReports != nil,
-			_q.withAccountRoles != nil,
+func (_q *AccountQuery) loadAuthentication(ctx context.Context, query *AuthenticationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Authentication)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-	)
-	_spec.ScanValues = func(columns []string) ([]any, error) {
-		return (*Account).scanValues(nil, columns)
-	}
-	_spec.Assign = func(columns []string, values []any) error {
-		node := &Account{config: _q.config}
-		nodes = append(nodes, node)
-		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(columns, values)
 	}
-	if len(_q.modifiers) > 0 {
-		_spec.Modifiers = _q.modifiers
-	}
-	for i := range hooks {
-		hooks[i](ctx, _spec)
-	}
-	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
-		return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(authentication.FieldAccountAuthentication)
 	}
-	if len(nodes) == 0 {
-		return nodes, nil
+	query.Where(predicate.Authentication(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.AuthenticationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := _q.withSessions; query != nil {
-		if err := _q.loadSessions(ctx, query, nodes,
-			func(n *Account) { n.Edges.Sessions = []*Session{} },
-			func(n *Account, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.AccountAuthentication
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_authentication" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := _q.withEmails; query != nil {
-		if err := _q.loadEmails(ctx, query, nodes,
-			func(n *Account) { n.Edges.Emails = []*Email{} },
-			func(n *Account, e *Email) { n.Edges.Emails = append(n.Edges.Emails, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (_q *AccountQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Account, init func(*Account), assign func(*Account, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[xid.ID]*Account)
+	nids := make(map[xid.ID]map[*Account]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
 		}
 	}
-	if query := _q.withNotifications; query != nil {
-		if err := _q.loadNotifications(ctx, query, nodes,
-			func(n *Account) { n.Edges.Notifications = []*Notification{} },
-			func(n *Account, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(account.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(account.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(account.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(account.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
 	}
-	if query := _q.withTriggeredNotifications; query != nil {
-		if err := _q.loadTriggeredNotifications(ctx, query, nodes,
-			func(n *Account) { n.Edges.TriggeredNotifications = []*Notification{} },
-			func(n *Account, e *Notification) {
-				n.Edges.TriggeredNotifications = append(n.Edges.TriggeredNotifications, e)
-			}); err != nil {
-			return nil, err
-		}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(xid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*xid.ID)
+				inValue := *values[1].(*xid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Account]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
 	}
-	if query := _q.withFollowing; query != nil {
-		if err := _q.loadFollowing(ctx, query, nodes,
-			func(n *Account) { n.Edges.Following = []*AccountFollow{} },
-			func(n *Account, e *AccountFollow) { n.Edges.Following = append(n.Edges.Following, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
 		}
-	}
-	if query := _q.withFollowedBy; query != nil {
-		if err := _q.loadFollowedBy(ctx, query, nodes,
-			func(n *Account) { n.Edges.FollowedBy = []*AccountFollow{} },
-			func(n *Account, e *AccountFollow) { n.Edges.FollowedBy = append(n.Edges.FollowedBy, e) }); err != nil {
-			return nil, err
+		for kn := range nodes {
+			assign(kn, n)
 		}
 	}
-	if query := _q.withInvitations; query != nil {
-		if err := _q.loadInvitations(ctx, query, nodes,
-			func(n *Account) { n.Edges.Invitations = []*Invitation{} },
-			func(n *Account, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (_q *AccountQuery) loadCollections(ctx context.Context, query *CollectionQuery, nodes []*Account, init func(*Account), assign func(*Account, *Collection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := _q.withInvitedBy; query != nil {
-		if err := _q.loadInvitedBy(ctx, query, nodes, nil,
-			func(n *Account, e *Invitation) { n.Edges.InvitedBy = e }); err != nil {
-			return nil, err
-		}
+	query.withFKs = true
+	query.Where(predicate.Collection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.CollectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := _q.withPosts; query != nil {
-		if err := _q.loadPosts(ctx, query, nodes,
-			func(n *Account) { n.Edges.Posts = []*Post{} },
-			func(n *Account, e *Post) { n.Edges.Posts = append(n.Edges.Posts, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.account_collections
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_collections" is nil for node %v`, n.ID)
 		}
-	}
-	if query := _q.withQuestions; query != nil {
-		if err := _q.loadQuestions(ctx, query, nodes,
-			func(n *Account) { n.Edges.Questions = []*Question{} },
-			func(n *Account, e *Question) { n.Edges.Questions = append(n.Edges.Questions, e) }); err != nil {
-			return nil, err
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_collections" returned %v for node %v`, *fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := _q.withReacts; query != nil {
-		if err := _q.loadReacts(ctx, query, nodes,
-			func(n *Account) { n.Edges.Reacts = []*React{} },
-			func(n *Account, e *React) { n.Edges.Reacts = append(n.Edges.Reacts, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (_q *AccountQuery) loadNodes(ctx context.Context, query *NodeQuery, nodes []*Account, init func(*Account), assign func(*Account, *Node)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := _q.withLikes; query != nil {
-		if err := _q.loadLikes(ctx, query, nodes,
-			func(n *Account) { n.Edges.Likes = []*LikePost{} },
-			func(n *Account, e *LikePost) { n.Edges.Likes = append(n.Edges.Likes, e) }); err != nil {
-			return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(node.FieldAccountID)
+	}
+	query.Where(predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.NodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := _q.withMentions; query != nil {
-		if err := _q.loadMentions(ctx, query, nodes,
-			func(n *Account) { n.Edges.Mentions = []*MentionProfile{} },
-			func(n *Account, e *MentionProfile) { n.Edges.Mentions = append(n.Edges.Mentions, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (_q *AccountQuery) loadAssets(ctx context.Context, query *AssetQuery, nodes []*Account, init func(*Account), assign func(*Account, *Asset)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := _q.withRoles; query != nil {
-		if err := _q.loadRoles(ctx, query, nodes,
-			func(n *Account) { n.Edges.Roles = []*Role{} },
-			func(n *Account, e *Role) { n.Edges.Roles = append(n.Edges.Roles, e) }); err != nil {
-			return nil, err
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(asset.FieldAccountID)
 	}
-	if query := _q.withAuthentication; query != nil {
-		if err := _q.loadAuthentication(ctx, query, nodes,
-			func(n *Account) { n.Edges.Authentication = []*Authentication{} },
-			func(n *Account, e *Authentication) { n.Edges.Authentication = append(n.Edges.Authentication, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.Asset(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.AssetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := _q.withTags; query != nil {
-		if err := _q.loadTags(ctx, query, nodes,
-			func(n *Account) { n.Edges.Tags = []*Tag{} },
-			func(n *Account, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := _q.withCollections; query != nil {
-		if err := _q.loadCollections(ctx, query, nodes,
-			func(n *Account) { n.Edges.Collections = []*Collection{} },
-			func(n *Account, e *Collection) { n.Edges.Collections = append(n.Edges.Collections, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (_q *AccountQuery) loadEvents(ctx context.Context, query *EventParticipantQuery, nodes []*Account, init func(*Account), assign func(*Account, *EventParticipant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := _q.withNodes; query != nil {
-		if err := _q.loadNodes(ctx, query, nodes,
-			func(n *Account) { n.Edges.Nodes = []*Node{} },
-			func(n *Account, e *Node) { n.Edges.Nodes = append(n.Edges.Nodes, e) }); err != nil {
-			return nil, err
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(eventparticipant.FieldAccountID)
 	}
-	if query := _q.withAssets; query != nil {
-		if err := _q.loadAssets(ctx, query, nodes,
-			func(n *Account) { n.Edges.Assets = []*Asset{} },
-			func(n *Account, e *Asset) { n.Edges.Assets = append(n.Edges.Assets, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.EventParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := _q.withEvents; query != nil {
-		if err := _q.loadEvents(ctx, query, nodes,
-			func(n *Account) { n.Edges.Events = []*EventParticipant{} },
-			func(n *Account, e *EventParticipant) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := _q.withPostReads; query != nil {
-		if err := _q.loadPostReads(ctx, query, nodes,
-			func(n *Account) { n.Edges.PostReads = []*PostRead{} },
-			func(n *Account, e *PostRead) { n.Edges.PostReads = append(n.Edges.PostReads, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (_q *AccountQuery) loadPostReads(ctx context.Context, query *PostReadQuery, nodes []*Account, init func(*Account), assign func(*Account, *PostRead)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := _q.withReports; query != nil {
-		if err := _q.loadReports(ctx, query, nodes,
-			func(n *Account) { n.Edges.Reports = []*Report{} },
-			func(n *Account, e *Report) { n.Edges.Reports = append(n.Edges.Reports, e) }); err != nil {
-			return nil, err
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(postread.FieldAccountID)
 	}
-	if query := _q.withHandledReports; query != nil {
-		if err := _q.loadHandledReports(ctx, query, nodes,
-			func(n *Account) { n.Edges.HandledReports = []*Report{} },
-			func(n *Account, e *Report) { n.Edges.HandledReports = append(n.Edges.HandledReports, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.PostRead(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.PostReadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := _q.withAccountRoles; query != nil {
-		if err := _q.loadAccountRoles(ctx, query, nodes,
-			func(n *Account) { n.Edges.AccountRoles = []*AccountRoles{} },
-			func(n *Account, e *AccountRoles) { n.Edges.AccountRoles = append(n.Edges.AccountRoles, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	return nodes, nil
+	return nil
 }
-
-func (_q *AccountQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*Account, init func(*Account), assign func(*Account, *Session)) error {
+func (_q *AccountQuery) loadNotificationPreferences(ctx context.Context, query *NotificationPreferenceQuery, nodes []*Account, init func(*Account), assign func(*Account, *NotificationPreference)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1429,10 +3744,10 @@ func (_q *AccountQuery) loadSessions(ctx context.Context, query *SessionQuery, n
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(session.FieldAccountID)
+		query.ctx.AppendFieldOnce(notificationpreference.FieldAccountID)
 	}
-	query.Where(predicate.Session(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.SessionsColumn), fks...))
+	query.Where(predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.NotificationPreferencesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1448,7 +3763,7 @@ func (_q *AccountQuery) loadSessions(ctx context.Context, query *SessionQuery, n
 	}
 	return nil
 }
-func (_q *AccountQuery) loadEmails(ctx context.Context, query *EmailQuery, nodes []*Account, init func(*Account), assign func(*Account, *Email)) error {
+func (_q *AccountQuery) loadPushSubscriptions(ctx context.Context, query *PushSubscriptionQuery, nodes []*Account, init func(*Account), assign func(*Account, *PushSubscription)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1459,10 +3774,10 @@ func (_q *AccountQuery) loadEmails(ctx context.Context, query *EmailQuery, nodes
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(email.FieldAccountID)
+		query.ctx.AppendFieldOnce(pushsubscription.FieldAccountID)
 	}
-	query.Where(predicate.Email(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.EmailsColumn), fks...))
+	query.Where(predicate.PushSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.PushSubscriptionsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1470,18 +3785,69 @@ func (_q *AccountQuery) loadEmails(ctx context.Context, query *EmailQuery, nodes
 	}
 	for _, n := range neighbors {
 		fk := n.AccountID
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
-		node, ok := nodeids[*fk]
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadDigestSubscription(ctx context.Context, query *DigestSubscriptionQuery, nodes []*Account, init func(*Account), assign func(*Account, *DigestSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(digestsubscription.FieldAccountID)
+	}
+	query.Where(predicate.DigestSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.DigestSubscriptionColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Notification)) error {
+func (_q *AccountQuery) loadFeedToken(ctx context.Context, query *FeedTokenQuery, nodes []*Account, init func(*Account), assign func(*Account, *FeedToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(feedtoken.FieldAccountID)
+	}
+	query.Where(predicate.FeedToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.FeedTokenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadAPIUsage(ctx context.Context, query *APIUsageQuery, nodes []*Account, init func(*Account), assign func(*Account, *APIUsage)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1492,26 +3858,26 @@ func (_q *AccountQuery) loadNotifications(ctx context.Context, query *Notificati
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(notification.FieldOwnerAccountID)
+		query.ctx.AppendFieldOnce(apiusage.FieldAccountID)
 	}
-	query.Where(predicate.Notification(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.NotificationsColumn), fks...))
+	query.Where(predicate.APIUsage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.APIUsageColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.OwnerAccountID
+		fk := n.AccountID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "owner_account_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadTriggeredNotifications(ctx context.Context, query *NotificationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Notification)) error {
+func (_q *AccountQuery) loadContentViews(ctx context.Context, query *ContentViewQuery, nodes []*Account, init func(*Account), assign func(*Account, *ContentView)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1522,29 +3888,26 @@ func (_q *AccountQuery) loadTriggeredNotifications(ctx context.Context, query *N
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(notification.FieldSourceAccountID)
+		query.ctx.AppendFieldOnce(contentview.FieldAuthorID)
 	}
-	query.Where(predicate.Notification(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.TriggeredNotificationsColumn), fks...))
+	query.Where(predicate.ContentView(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ContentViewsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.SourceAccountID
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "source_account_id" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.AuthorID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "source_account_id" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "author_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadFollowing(ctx context.Context, query *AccountFollowQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountFollow)) error {
+func (_q *AccountQuery) loadReports(ctx context.Context, query *ReportQuery, nodes []*Account, init func(*Account), assign func(*Account, *Report)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1555,26 +3918,26 @@ func (_q *AccountQuery) loadFollowing(ctx context.Context, query *AccountFollowQ
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(accountfollow.FieldFollowerAccountID)
+		query.ctx.AppendFieldOnce(report.FieldReportedByID)
 	}
-	query.Where(predicate.AccountFollow(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.FollowingColumn), fks...))
+	query.Where(predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ReportsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.FollowerAccountID
+		fk := n.ReportedByID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "follower_account_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "reported_by_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadFollowedBy(ctx context.Context, query *AccountFollowQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountFollow)) error {
+func (_q *AccountQuery) loadHandledReports(ctx context.Context, query *ReportQuery, nodes []*Account, init func(*Account), assign func(*Account, *Report)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1585,26 +3948,29 @@ func (_q *AccountQuery) loadFollowedBy(ctx context.Context, query *AccountFollow
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(accountfollow.FieldFollowingAccountID)
+		query.ctx.AppendFieldOnce(report.FieldHandledByID)
 	}
-	query.Where(predicate.AccountFollow(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.FollowedByColumn), fks...))
+	query.Where(predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.HandledReportsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.FollowingAccountID
-		node, ok := nodeids[fk]
+		fk := n.HandledByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "handled_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "following_account_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "handled_by_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Invitation)) error {
+func (_q *AccountQuery) loadModerationNotes(ctx context.Context, query *ModerationNoteQuery, nodes []*Account, init func(*Account), assign func(*Account, *ModerationNote)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1615,58 +3981,59 @@ func (_q *AccountQuery) loadInvitations(ctx context.Context, query *InvitationQu
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(invitation.FieldCreatorAccountID)
+		query.ctx.AppendFieldOnce(moderationnote.FieldAccountID)
 	}
-	query.Where(predicate.Invitation(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.InvitationsColumn), fks...))
+	query.Where(predicate.ModerationNote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ModerationNotesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.CreatorAccountID
+		fk := n.AccountID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "creator_account_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadInvitedBy(ctx context.Context, query *InvitationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Invitation)) error {
-	ids := make([]xid.ID, 0, len(nodes))
-	nodeids := make(map[xid.ID][]*Account)
+func (_q *AccountQuery) loadAuthoredModerationNotes(ctx context.Context, query *ModerationNoteQuery, nodes []*Account, init func(*Account), assign func(*Account, *ModerationNote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
-		if nodes[i].InvitedByID == nil {
-			continue
-		}
-		fk := *nodes[i].InvitedByID
-		if _, ok := nodeids[fk]; !ok {
-			ids = append(ids, fk)
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(moderationnote.FieldAuthorID)
 	}
-	query.Where(invitation.IDIn(ids...))
+	query.Where(predicate.ModerationNote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.AuthoredModerationNotesColumn), fks...))
+	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "invited_by_id" returned %v`, n.ID)
+		fk := n.AuthorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "author_id" is nil for node %v`, n.ID)
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "author_id" returned %v for node %v`, *fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*Account, init func(*Account), assign func(*Account, *Post)) error {
+func (_q *AccountQuery) loadWarnings(ctx context.Context, query *WarningQuery, nodes []*Account, init func(*Account), assign func(*Account, *Warning)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1677,26 +4044,26 @@ func (_q *AccountQuery) loadPosts(ctx context.Context, query *PostQuery, nodes [
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(post.FieldAccountPosts)
+		query.ctx.AppendFieldOnce(warning.FieldAccountID)
 	}
-	query.Where(predicate.Post(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.PostsColumn), fks...))
+	query.Where(predicate.Warning(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.WarningsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.AccountPosts
+		fk := n.AccountID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "account_posts" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadQuestions(ctx context.Context, query *QuestionQuery, nodes []*Account, init func(*Account), assign func(*Account, *Question)) error {
+func (_q *AccountQuery) loadIssuedWarnings(ctx context.Context, query *WarningQuery, nodes []*Account, init func(*Account), assign func(*Account, *Warning)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1707,26 +4074,29 @@ func (_q *AccountQuery) loadQuestions(ctx context.Context, query *QuestionQuery,
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(question.FieldAccountID)
+		query.ctx.AppendFieldOnce(warning.FieldIssuedByID)
 	}
-	query.Where(predicate.Question(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.QuestionsColumn), fks...))
+	query.Where(predicate.Warning(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.IssuedWarningsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.AccountID
-		node, ok := nodeids[fk]
+		fk := n.IssuedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "issued_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "issued_by_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadReacts(ctx context.Context, query *ReactQuery, nodes []*Account, init func(*Account), assign func(*Account, *React)) error {
+func (_q *AccountQuery) loadUploadSessions(ctx context.Context, query *UploadSessionQuery, nodes []*Account, init func(*Account), assign func(*Account, *UploadSession)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1737,10 +4107,10 @@ func (_q *AccountQuery) loadReacts(ctx context.Context, query *ReactQuery, nodes
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(react.FieldAccountID)
+		query.ctx.AppendFieldOnce(uploadsession.FieldAccountID)
 	}
-	query.Where(predicate.React(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.ReactsColumn), fks...))
+	query.Where(predicate.UploadSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.UploadSessionsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1756,7 +4126,7 @@ func (_q *AccountQuery) loadReacts(ctx context.Context, query *ReactQuery, nodes
 	}
 	return nil
 }
-func (_q *AccountQuery) loadLikes(ctx context.Context, query *LikePostQuery, nodes []*Account, init func(*Account), assign func(*Account, *LikePost)) error {
+func (_q *AccountQuery) loadConversations(ctx context.Context, query *ConversationParticipantQuery, nodes []*Account, init func(*Account), assign func(*Account, *ConversationParticipant)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1767,10 +4137,10 @@ func (_q *AccountQuery) loadLikes(ctx context.Context, query *LikePostQuery, nod
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(likepost.FieldAccountID)
+		query.ctx.AppendFieldOnce(conversationparticipant.FieldAccountID)
 	}
-	query.Where(predicate.LikePost(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.LikesColumn), fks...))
+	query.Where(predicate.ConversationParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ConversationsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1786,7 +4156,7 @@ func (_q *AccountQuery) loadLikes(ctx context.Context, query *LikePostQuery, nod
 	}
 	return nil
 }
-func (_q *AccountQuery) loadMentions(ctx context.Context, query *MentionProfileQuery, nodes []*Account, init func(*Account), assign func(*Account, *MentionProfile)) error {
+func (_q *AccountQuery) loadDirectMessages(ctx context.Context, query *DirectMessageQuery, nodes []*Account, init func(*Account), assign func(*Account, *DirectMessage)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1797,10 +4167,10 @@ func (_q *AccountQuery) loadMentions(ctx context.Context, query *MentionProfileQ
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(mentionprofile.FieldAccountID)
+		query.ctx.AppendFieldOnce(directmessage.FieldAccountID)
 	}
-	query.Where(predicate.MentionProfile(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.MentionsColumn), fks...))
+	query.Where(predicate.DirectMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.DirectMessagesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1816,159 +4186,97 @@ func (_q *AccountQuery) loadMentions(ctx context.Context, query *MentionProfileQ
 	}
 	return nil
 }
-func (_q *AccountQuery) loadRoles(ctx context.Context, query *RoleQuery, nodes []*Account, init func(*Account), assign func(*Account, *Role)) error {
-	edgeIDs := make([]driver.Value, len(nodes))
-	byID := make(map[xid.ID]*Account)
-	nids := make(map[xid.ID]map[*Account]struct{})
-	for i, node := range nodes {
-		edgeIDs[i] = node.ID
-		byID[node.ID] = node
+func (_q *AccountQuery) loadSpaces(ctx context.Context, query *SpaceMemberQuery, nodes []*Account, init func(*Account), assign func(*Account, *SpaceMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
 		if init != nil {
-			init(node)
+			init(nodes[i])
 		}
 	}
-	query.Where(func(s *sql.Selector) {
-		joinT := sql.Table(account.RolesTable)
-		s.Join(joinT).On(s.C(role.FieldID), joinT.C(account.RolesPrimaryKey[0]))
-		s.Where(sql.InValues(joinT.C(account.RolesPrimaryKey[1]), edgeIDs...))
-		columns := s.SelectedColumns()
-		s.Select(joinT.C(account.RolesPrimaryKey[1]))
-		s.AppendSelect(columns...)
-		s.SetDistinct(false)
-	})
-	if err := query.prepareQuery(ctx); err != nil {
-		return err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(spacemember.FieldAccountID)
 	}
-	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
-		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
-			assign := spec.Assign
-			values := spec.ScanValues
-			spec.ScanValues = func(columns []string) ([]any, error) {
-				values, err := values(columns[1:])
-				if err != nil {
-					return nil, err
-				}
-				return append([]any{new(xid.ID)}, values...), nil
-			}
-			spec.Assign = func(columns []string, values []any) error {
-				outValue := *values[0].(*xid.ID)
-				inValue := *values[1].(*xid.ID)
-				if nids[inValue] == nil {
-					nids[inValue] = map[*Account]struct{}{byID[outValue]: {}}
-					return assign(columns[1:], values[1:])
-				}
-				nids[inValue][byID[outValue]] = struct{}{}
-				return nil
-			}
-		})
-	})
-	neighbors, err := withInterceptors[[]*Role](ctx, query, qr, query.inters)
+	query.Where(predicate.SpaceMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.SpacesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nids[n.ID]
+		fk := n.AccountID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected "roles" node returned %v`, n.ID)
-		}
-		for kn := range nodes {
-			assign(kn, n)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadAuthentication(ctx context.Context, query *AuthenticationQuery, nodes []*Account, init func(*Account), assign func(*Account, *Authentication)) error {
+func (_q *AccountQuery) loadApplication(ctx context.Context, query *AccountApplicationQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountApplication)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
-		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(authentication.FieldAccountAuthentication)
+		query.ctx.AppendFieldOnce(accountapplication.FieldAccountID)
 	}
-	query.Where(predicate.Authentication(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.AuthenticationColumn), fks...))
+	query.Where(predicate.AccountApplication(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ApplicationColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.AccountAuthentication
+		fk := n.AccountID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "account_authentication" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Account, init func(*Account), assign func(*Account, *Tag)) error {
-	edgeIDs := make([]driver.Value, len(nodes))
-	byID := make(map[xid.ID]*Account)
-	nids := make(map[xid.ID]map[*Account]struct{})
-	for i, node := range nodes {
-		edgeIDs[i] = node.ID
-		byID[node.ID] = node
+func (_q *AccountQuery) loadReviewedApplications(ctx context.Context, query *AccountApplicationQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountApplication)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
 		if init != nil {
-			init(node)
+			init(nodes[i])
 		}
 	}
-	query.Where(func(s *sql.Selector) {
-		joinT := sql.Table(account.TagsTable)
-		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(account.TagsPrimaryKey[1]))
-		s.Where(sql.InValues(joinT.C(account.TagsPrimaryKey[0]), edgeIDs...))
-		columns := s.SelectedColumns()
-		s.Select(joinT.C(account.TagsPrimaryKey[0]))
-		s.AppendSelect(columns...)
-		s.SetDistinct(false)
-	})
-	if err := query.prepareQuery(ctx); err != nil {
-		return err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountapplication.FieldReviewedByID)
 	}
-	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
-		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
-			assign := spec.Assign
-			values := spec.ScanValues
-			spec.ScanValues = func(columns []string) ([]any, error) {
-				values, err := values(columns[1:])
-				if err != nil {
-					return nil, err
-				}
-				return append([]any{new(xid.ID)}, values...), nil
-			}
-			spec.Assign = func(columns []string, values []any) error {
-				outValue := *values[0].(*xid.ID)
-				inValue := *values[1].(*xid.ID)
-				if nids[inValue] == nil {
-					nids[inValue] = map[*Account]struct{}{byID[outValue]: {}}
-					return assign(columns[1:], values[1:])
-				}
-				nids[inValue][byID[outValue]] = struct{}{}
-				return nil
-			}
-		})
-	})
-	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	query.Where(predicate.AccountApplication(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ReviewedApplicationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		fk := n.ReviewedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reviewed_by_id" is nil for node %v`, n.ID)
 		}
-		for kn := range nodes {
-			assign(kn, n)
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reviewed_by_id" returned %v for node %v`, *fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadCollections(ctx context.Context, query *CollectionQuery, nodes []*Account, init func(*Account), assign func(*Account, *Collection)) error {
+func (_q *AccountQuery) loadOnboardingSteps(ctx context.Context, query *AccountOnboardingStepQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountOnboardingStep)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -1978,28 +4286,27 @@ func (_q *AccountQuery) loadCollections(ctx context.Context, query *CollectionQu
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Collection(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.CollectionsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountonboardingstep.FieldAccountID)
+	}
+	query.Where(predicate.AccountOnboardingStep(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.OnboardingStepsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.account_collections
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "account_collections" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.AccountID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "account_collections" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadNodes(ctx context.Context, query *NodeQuery, nodes []*Account, init func(*Account), assign func(*Account, *Node)) error {
+func (_q *AccountQuery) loadPolicyAcceptances(ctx context.Context, query *PolicyAcceptanceQuery, nodes []*Account, init func(*Account), assign func(*Account, *PolicyAcceptance)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -2010,10 +4317,10 @@ func (_q *AccountQuery) loadNodes(ctx context.Context, query *NodeQuery, nodes [
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(node.FieldAccountID)
+		query.ctx.AppendFieldOnce(policyacceptance.FieldAccountID)
 	}
-	query.Where(predicate.Node(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.NodesColumn), fks...))
+	query.Where(predicate.PolicyAcceptance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.PolicyAcceptancesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -2029,7 +4336,7 @@ func (_q *AccountQuery) loadNodes(ctx context.Context, query *NodeQuery, nodes [
 	}
 	return nil
 }
-func (_q *AccountQuery) loadAssets(ctx context.Context, query *AssetQuery, nodes []*Account, init func(*Account), assign func(*Account, *Asset)) error {
+func (_q *AccountQuery) loadBookmarks(ctx context.Context, query *BookmarkQuery, nodes []*Account, init func(*Account), assign func(*Account, *Bookmark)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -2040,10 +4347,10 @@ func (_q *AccountQuery) loadAssets(ctx context.Context, query *AssetQuery, nodes
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(asset.FieldAccountID)
+		query.ctx.AppendFieldOnce(bookmark.FieldAccountID)
 	}
-	query.Where(predicate.Asset(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.AssetsColumn), fks...))
+	query.Where(predicate.Bookmark(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BookmarksColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -2059,7 +4366,7 @@ func (_q *AccountQuery) loadAssets(ctx context.Context, query *AssetQuery, nodes
 	}
 	return nil
 }
-func (_q *AccountQuery) loadEvents(ctx context.Context, query *EventParticipantQuery, nodes []*Account, init func(*Account), assign func(*Account, *EventParticipant)) error {
+func (_q *AccountQuery) loadReadingHistory(ctx context.Context, query *ReadingHistoryQuery, nodes []*Account, init func(*Account), assign func(*Account, *ReadingHistory)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -2070,10 +4377,10 @@ func (_q *AccountQuery) loadEvents(ctx context.Context, query *EventParticipantQ
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(eventparticipant.FieldAccountID)
+		query.ctx.AppendFieldOnce(readinghistory.FieldAccountID)
 	}
-	query.Where(predicate.EventParticipant(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.EventsColumn), fks...))
+	query.Where(predicate.ReadingHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ReadingHistoryColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -2089,7 +4396,7 @@ func (_q *AccountQuery) loadEvents(ctx context.Context, query *EventParticipantQ
 	}
 	return nil
 }
-func (_q *AccountQuery) loadPostReads(ctx context.Context, query *PostReadQuery, nodes []*Account, init func(*Account), assign func(*Account, *PostRead)) error {
+func (_q *AccountQuery) loadVerificationRequests(ctx context.Context, query *VerificationRequestQuery, nodes []*Account, init func(*Account), assign func(*Account, *VerificationRequest)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -2100,10 +4407,10 @@ func (_q *AccountQuery) loadPostReads(ctx context.Context, query *PostReadQuery,
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(postread.FieldAccountID)
+		query.ctx.AppendFieldOnce(verificationrequest.FieldAccountID)
 	}
-	query.Where(predicate.PostRead(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.PostReadsColumn), fks...))
+	query.Where(predicate.VerificationRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.VerificationRequestsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -2119,7 +4426,7 @@ func (_q *AccountQuery) loadPostReads(ctx context.Context, query *PostReadQuery,
 	}
 	return nil
 }
-func (_q *AccountQuery) loadReports(ctx context.Context, query *ReportQuery, nodes []*Account, init func(*Account), assign func(*Account, *Report)) error {
+func (_q *AccountQuery) loadReviewedVerificationRequests(ctx context.Context, query *VerificationRequestQuery, nodes []*Account, init func(*Account), assign func(*Account, *VerificationRequest)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -2130,26 +4437,29 @@ func (_q *AccountQuery) loadReports(ctx context.Context, query *ReportQuery, nod
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(report.FieldReportedByID)
+		query.ctx.AppendFieldOnce(verificationrequest.FieldReviewedByID)
 	}
-	query.Where(predicate.Report(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.ReportsColumn), fks...))
+	query.Where(predicate.VerificationRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ReviewedVerificationRequestsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.ReportedByID
-		node, ok := nodeids[fk]
+		fk := n.ReviewedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reviewed_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "reported_by_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "reviewed_by_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *AccountQuery) loadHandledReports(ctx context.Context, query *ReportQuery, nodes []*Account, init func(*Account), assign func(*Account, *Report)) error {
+func (_q *AccountQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*Account, init func(*Account), assign func(*Account, *Webhook)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
 	for i := range nodes {
@@ -2160,23 +4470,23 @@ func (_q *AccountQuery) loadHandledReports(ctx context.Context, query *ReportQue
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(report.FieldHandledByID)
+		query.ctx.AppendFieldOnce(webhook.FieldAccountID)
 	}
-	query.Where(predicate.Report(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(account.HandledReportsColumn), fks...))
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.WebhooksColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.HandledByID
+		fk := n.AccountID
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "handled_by_id" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "handled_by_id" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -2244,6 +4554,9 @@ func (_q *AccountQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withInvitedBy != nil {
 			_spec.Node.AddColumnOnce(account.FieldInvitedByID)
 		}
+		if _q.withOwner != nil {
+			_spec.Node.AddColumnOnce(account.FieldOwnerID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
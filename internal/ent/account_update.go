@@ -13,28 +13,52 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/pushsubscription"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
 	"github.com/Southclaws/storyden/internal/ent/report"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
 	"github.com/Southclaws/storyden/internal/ent/role"
 	"github.com/Southclaws/storyden/internal/ent/schema"
 	"github.com/Southclaws/storyden/internal/ent/session"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
 	"github.com/rs/xid"
 )
 
@@ -192,6 +216,24 @@ func (_u *AccountUpdate) ClearLinks() *AccountUpdate {
 	return _u
 }
 
+// SetPinnedItems sets the "pinned_items" field.
+func (_u *AccountUpdate) SetPinnedItems(v []schema.PinnedItem) *AccountUpdate {
+	_u.mutation.SetPinnedItems(v)
+	return _u
+}
+
+// AppendPinnedItems appends value to the "pinned_items" field.
+func (_u *AccountUpdate) AppendPinnedItems(v []schema.PinnedItem) *AccountUpdate {
+	_u.mutation.AppendPinnedItems(v)
+	return _u
+}
+
+// ClearPinnedItems clears the value of the "pinned_items" field.
+func (_u *AccountUpdate) ClearPinnedItems() *AccountUpdate {
+	_u.mutation.ClearPinnedItems()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *AccountUpdate) SetMetadata(v map[string]interface{}) *AccountUpdate {
 	_u.mutation.SetMetadata(v)
@@ -204,6 +246,98 @@ func (_u *AccountUpdate) ClearMetadata() *AccountUpdate {
 	return _u
 }
 
+// SetLocale sets the "locale" field.
+func (_u *AccountUpdate) SetLocale(v string) *AccountUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableLocale(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (_u *AccountUpdate) ClearLocale() *AccountUpdate {
+	_u.mutation.ClearLocale()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *AccountUpdate) SetTimezone(v string) *AccountUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableTimezone(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *AccountUpdate) ClearTimezone() *AccountUpdate {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
+// SetReadingHistoryEnabled sets the "reading_history_enabled" field.
+func (_u *AccountUpdate) SetReadingHistoryEnabled(v bool) *AccountUpdate {
+	_u.mutation.SetReadingHistoryEnabled(v)
+	return _u
+}
+
+// SetNillableReadingHistoryEnabled sets the "reading_history_enabled" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableReadingHistoryEnabled(v *bool) *AccountUpdate {
+	if v != nil {
+		_u.SetReadingHistoryEnabled(*v)
+	}
+	return _u
+}
+
+// SetHiddenActivity sets the "hidden_activity" field.
+func (_u *AccountUpdate) SetHiddenActivity(v []string) *AccountUpdate {
+	_u.mutation.SetHiddenActivity(v)
+	return _u
+}
+
+// AppendHiddenActivity appends value to the "hidden_activity" field.
+func (_u *AccountUpdate) AppendHiddenActivity(v []string) *AccountUpdate {
+	_u.mutation.AppendHiddenActivity(v)
+	return _u
+}
+
+// ClearHiddenActivity clears the value of the "hidden_activity" field.
+func (_u *AccountUpdate) ClearHiddenActivity() *AccountUpdate {
+	_u.mutation.ClearHiddenActivity()
+	return _u
+}
+
+// SetVerification sets the "verification" field.
+func (_u *AccountUpdate) SetVerification(v string) *AccountUpdate {
+	_u.mutation.SetVerification(v)
+	return _u
+}
+
+// SetNillableVerification sets the "verification" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableVerification(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetVerification(*v)
+	}
+	return _u
+}
+
+// ClearVerification clears the value of the "verification" field.
+func (_u *AccountUpdate) ClearVerification() *AccountUpdate {
+	_u.mutation.ClearVerification()
+	return _u
+}
+
 // SetInvitedByID sets the "invited_by_id" field.
 func (_u *AccountUpdate) SetInvitedByID(v xid.ID) *AccountUpdate {
 	_u.mutation.SetInvitedByID(v)
@@ -224,6 +358,26 @@ func (_u *AccountUpdate) ClearInvitedByID() *AccountUpdate {
 	return _u
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (_u *AccountUpdate) SetOwnerID(v xid.ID) *AccountUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableOwnerID(v *xid.ID) *AccountUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *AccountUpdate) ClearOwnerID() *AccountUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (_u *AccountUpdate) AddSessionIDs(ids ...xid.ID) *AccountUpdate {
 	_u.mutation.AddSessionIDs(ids...)
@@ -314,6 +468,96 @@ func (_u *AccountUpdate) AddFollowedBy(v ...*AccountFollow) *AccountUpdate {
 	return _u.AddFollowedByIDs(ids...)
 }
 
+// AddBlockIDs adds the "blocks" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdate) AddBlockIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddBlockIDs(ids...)
+	return _u
+}
+
+// AddBlocks adds the "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdate) AddBlocks(v ...*AccountBlock) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdate) AddBlockedByIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddBlockedByIDs(ids...)
+	return _u
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdate) AddBlockedBy(v ...*AccountBlock) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedByIDs(ids...)
+}
+
+// AddTagFollowIDs adds the "tag_follows" edge to the TagFollow entity by IDs.
+func (_u *AccountUpdate) AddTagFollowIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddTagFollowIDs(ids...)
+	return _u
+}
+
+// AddTagFollows adds the "tag_follows" edges to the TagFollow entity.
+func (_u *AccountUpdate) AddTagFollows(v ...*TagFollow) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagFollowIDs(ids...)
+}
+
+// AddCategoryFollowIDs adds the "category_follows" edge to the CategoryFollow entity by IDs.
+func (_u *AccountUpdate) AddCategoryFollowIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddCategoryFollowIDs(ids...)
+	return _u
+}
+
+// AddCategoryFollows adds the "category_follows" edges to the CategoryFollow entity.
+func (_u *AccountUpdate) AddCategoryFollows(v ...*CategoryFollow) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCategoryFollowIDs(ids...)
+}
+
+// AddReputationIDs adds the "reputation" edge to the ReputationEntry entity by IDs.
+func (_u *AccountUpdate) AddReputationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddReputationIDs(ids...)
+	return _u
+}
+
+// AddReputation adds the "reputation" edges to the ReputationEntry entity.
+func (_u *AccountUpdate) AddReputation(v ...*ReputationEntry) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReputationIDs(ids...)
+}
+
+// AddBadgeIDs adds the "badges" edge to the AccountBadge entity by IDs.
+func (_u *AccountUpdate) AddBadgeIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddBadgeIDs(ids...)
+	return _u
+}
+
+// AddBadges adds the "badges" edges to the AccountBadge entity.
+func (_u *AccountUpdate) AddBadges(v ...*AccountBadge) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBadgeIDs(ids...)
+}
+
 // AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
 func (_u *AccountUpdate) AddInvitationIDs(ids ...xid.ID) *AccountUpdate {
 	_u.mutation.AddInvitationIDs(ids...)
@@ -334,6 +578,26 @@ func (_u *AccountUpdate) SetInvitedBy(v *Invitation) *AccountUpdate {
 	return _u.SetInvitedByID(v.ID)
 }
 
+// SetOwner sets the "owner" edge to the Account entity.
+func (_u *AccountUpdate) SetOwner(v *Account) *AccountUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// AddBotIDs adds the "bots" edge to the Account entity by IDs.
+func (_u *AccountUpdate) AddBotIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddBotIDs(ids...)
+	return _u
+}
+
+// AddBots adds the "bots" edges to the Account entity.
+func (_u *AccountUpdate) AddBots(v ...*Account) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBotIDs(ids...)
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (_u *AccountUpdate) AddPostIDs(ids ...xid.ID) *AccountUpdate {
 	_u.mutation.AddPostIDs(ids...)
@@ -529,1035 +793,1682 @@ func (_u *AccountUpdate) AddPostReads(v ...*PostRead) *AccountUpdate {
 	return _u.AddPostReadIDs(ids...)
 }
 
-// AddReportIDs adds the "reports" edge to the Report entity by IDs.
-func (_u *AccountUpdate) AddReportIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.AddReportIDs(ids...)
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *AccountUpdate) AddNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
 	return _u
 }
 
-// AddReports adds the "reports" edges to the Report entity.
-func (_u *AccountUpdate) AddReports(v ...*Report) *AccountUpdate {
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdate) AddNotificationPreferences(v ...*NotificationPreference) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddReportIDs(ids...)
+	return _u.AddNotificationPreferenceIDs(ids...)
 }
 
-// AddHandledReportIDs adds the "handled_reports" edge to the Report entity by IDs.
-func (_u *AccountUpdate) AddHandledReportIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.AddHandledReportIDs(ids...)
+// AddPushSubscriptionIDs adds the "push_subscriptions" edge to the PushSubscription entity by IDs.
+func (_u *AccountUpdate) AddPushSubscriptionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddPushSubscriptionIDs(ids...)
 	return _u
 }
 
-// AddHandledReports adds the "handled_reports" edges to the Report entity.
-func (_u *AccountUpdate) AddHandledReports(v ...*Report) *AccountUpdate {
+// AddPushSubscriptions adds the "push_subscriptions" edges to the PushSubscription entity.
+func (_u *AccountUpdate) AddPushSubscriptions(v ...*PushSubscription) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddHandledReportIDs(ids...)
+	return _u.AddPushSubscriptionIDs(ids...)
 }
 
-// AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
-func (_u *AccountUpdate) AddAccountRoleIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.AddAccountRoleIDs(ids...)
+// SetDigestSubscriptionID sets the "digest_subscription" edge to the DigestSubscription entity by ID.
+func (_u *AccountUpdate) SetDigestSubscriptionID(id xid.ID) *AccountUpdate {
+	_u.mutation.SetDigestSubscriptionID(id)
 	return _u
 }
 
-// AddAccountRoles adds the "account_roles" edges to the AccountRoles entity.
-func (_u *AccountUpdate) AddAccountRoles(v ...*AccountRoles) *AccountUpdate {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableDigestSubscriptionID sets the "digest_subscription" edge to the DigestSubscription entity by ID if the given value is not nil.
+func (_u *AccountUpdate) SetNillableDigestSubscriptionID(id *xid.ID) *AccountUpdate {
+	if id != nil {
+		_u = _u.SetDigestSubscriptionID(*id)
 	}
-	return _u.AddAccountRoleIDs(ids...)
+	return _u
 }
 
-// Mutation returns the AccountMutation object of the builder.
-func (_u *AccountUpdate) Mutation() *AccountMutation {
-	return _u.mutation
+// SetDigestSubscription sets the "digest_subscription" edge to the DigestSubscription entity.
+func (_u *AccountUpdate) SetDigestSubscription(v *DigestSubscription) *AccountUpdate {
+	return _u.SetDigestSubscriptionID(v.ID)
 }
 
-// ClearSessions clears all "sessions" edges to the Session entity.
-func (_u *AccountUpdate) ClearSessions() *AccountUpdate {
-	_u.mutation.ClearSessions()
+// SetFeedTokenID sets the "feed_token" edge to the FeedToken entity by ID.
+func (_u *AccountUpdate) SetFeedTokenID(id xid.ID) *AccountUpdate {
+	_u.mutation.SetFeedTokenID(id)
 	return _u
 }
 
-// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
-func (_u *AccountUpdate) RemoveSessionIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveSessionIDs(ids...)
+// SetNillableFeedTokenID sets the "feed_token" edge to the FeedToken entity by ID if the given value is not nil.
+func (_u *AccountUpdate) SetNillableFeedTokenID(id *xid.ID) *AccountUpdate {
+	if id != nil {
+		_u = _u.SetFeedTokenID(*id)
+	}
 	return _u
 }
 
-// RemoveSessions removes "sessions" edges to Session entities.
-func (_u *AccountUpdate) RemoveSessions(v ...*Session) *AccountUpdate {
+// SetFeedToken sets the "feed_token" edge to the FeedToken entity.
+func (_u *AccountUpdate) SetFeedToken(v *FeedToken) *AccountUpdate {
+	return _u.SetFeedTokenID(v.ID)
+}
+
+// AddAPIUsageIDs adds the "api_usage" edge to the APIUsage entity by IDs.
+func (_u *AccountUpdate) AddAPIUsageIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddAPIUsageIDs(ids...)
+	return _u
+}
+
+// AddAPIUsage adds the "api_usage" edges to the APIUsage entity.
+func (_u *AccountUpdate) AddAPIUsage(v ...*APIUsage) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSessionIDs(ids...)
-}
-
-// ClearEmails clears all "emails" edges to the Email entity.
-func (_u *AccountUpdate) ClearEmails() *AccountUpdate {
-	_u.mutation.ClearEmails()
-	return _u
+	return _u.AddAPIUsageIDs(ids...)
 }
 
-// RemoveEmailIDs removes the "emails" edge to Email entities by IDs.
-func (_u *AccountUpdate) RemoveEmailIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveEmailIDs(ids...)
+// AddContentViewIDs adds the "content_views" edge to the ContentView entity by IDs.
+func (_u *AccountUpdate) AddContentViewIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddContentViewIDs(ids...)
 	return _u
 }
 
-// RemoveEmails removes "emails" edges to Email entities.
-func (_u *AccountUpdate) RemoveEmails(v ...*Email) *AccountUpdate {
+// AddContentViews adds the "content_views" edges to the ContentView entity.
+func (_u *AccountUpdate) AddContentViews(v ...*ContentView) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEmailIDs(ids...)
-}
-
-// ClearNotifications clears all "notifications" edges to the Notification entity.
-func (_u *AccountUpdate) ClearNotifications() *AccountUpdate {
-	_u.mutation.ClearNotifications()
-	return _u
+	return _u.AddContentViewIDs(ids...)
 }
 
-// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
-func (_u *AccountUpdate) RemoveNotificationIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveNotificationIDs(ids...)
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (_u *AccountUpdate) AddReportIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddReportIDs(ids...)
 	return _u
 }
 
-// RemoveNotifications removes "notifications" edges to Notification entities.
-func (_u *AccountUpdate) RemoveNotifications(v ...*Notification) *AccountUpdate {
+// AddReports adds the "reports" edges to the Report entity.
+func (_u *AccountUpdate) AddReports(v ...*Report) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNotificationIDs(ids...)
-}
-
-// ClearTriggeredNotifications clears all "triggered_notifications" edges to the Notification entity.
-func (_u *AccountUpdate) ClearTriggeredNotifications() *AccountUpdate {
-	_u.mutation.ClearTriggeredNotifications()
-	return _u
+	return _u.AddReportIDs(ids...)
 }
 
-// RemoveTriggeredNotificationIDs removes the "triggered_notifications" edge to Notification entities by IDs.
-func (_u *AccountUpdate) RemoveTriggeredNotificationIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveTriggeredNotificationIDs(ids...)
+// AddHandledReportIDs adds the "handled_reports" edge to the Report entity by IDs.
+func (_u *AccountUpdate) AddHandledReportIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddHandledReportIDs(ids...)
 	return _u
 }
 
-// RemoveTriggeredNotifications removes "triggered_notifications" edges to Notification entities.
-func (_u *AccountUpdate) RemoveTriggeredNotifications(v ...*Notification) *AccountUpdate {
+// AddHandledReports adds the "handled_reports" edges to the Report entity.
+func (_u *AccountUpdate) AddHandledReports(v ...*Report) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTriggeredNotificationIDs(ids...)
+	return _u.AddHandledReportIDs(ids...)
 }
 
-// ClearFollowing clears all "following" edges to the AccountFollow entity.
-func (_u *AccountUpdate) ClearFollowing() *AccountUpdate {
-	_u.mutation.ClearFollowing()
+// AddModerationNoteIDs adds the "moderation_notes" edge to the ModerationNote entity by IDs.
+func (_u *AccountUpdate) AddModerationNoteIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddModerationNoteIDs(ids...)
 	return _u
 }
 
-// RemoveFollowingIDs removes the "following" edge to AccountFollow entities by IDs.
-func (_u *AccountUpdate) RemoveFollowingIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveFollowingIDs(ids...)
+// AddModerationNotes adds the "moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdate) AddModerationNotes(v ...*ModerationNote) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModerationNoteIDs(ids...)
+}
+
+// AddAuthoredModerationNoteIDs adds the "authored_moderation_notes" edge to the ModerationNote entity by IDs.
+func (_u *AccountUpdate) AddAuthoredModerationNoteIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddAuthoredModerationNoteIDs(ids...)
 	return _u
 }
 
-// RemoveFollowing removes "following" edges to AccountFollow entities.
-func (_u *AccountUpdate) RemoveFollowing(v ...*AccountFollow) *AccountUpdate {
+// AddAuthoredModerationNotes adds the "authored_moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdate) AddAuthoredModerationNotes(v ...*ModerationNote) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveFollowingIDs(ids...)
+	return _u.AddAuthoredModerationNoteIDs(ids...)
 }
 
-// ClearFollowedBy clears all "followed_by" edges to the AccountFollow entity.
-func (_u *AccountUpdate) ClearFollowedBy() *AccountUpdate {
-	_u.mutation.ClearFollowedBy()
+// AddWarningIDs adds the "warnings" edge to the Warning entity by IDs.
+func (_u *AccountUpdate) AddWarningIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddWarningIDs(ids...)
 	return _u
 }
 
-// RemoveFollowedByIDs removes the "followed_by" edge to AccountFollow entities by IDs.
-func (_u *AccountUpdate) RemoveFollowedByIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveFollowedByIDs(ids...)
+// AddWarnings adds the "warnings" edges to the Warning entity.
+func (_u *AccountUpdate) AddWarnings(v ...*Warning) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWarningIDs(ids...)
+}
+
+// AddIssuedWarningIDs adds the "issued_warnings" edge to the Warning entity by IDs.
+func (_u *AccountUpdate) AddIssuedWarningIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddIssuedWarningIDs(ids...)
 	return _u
 }
 
-// RemoveFollowedBy removes "followed_by" edges to AccountFollow entities.
-func (_u *AccountUpdate) RemoveFollowedBy(v ...*AccountFollow) *AccountUpdate {
+// AddIssuedWarnings adds the "issued_warnings" edges to the Warning entity.
+func (_u *AccountUpdate) AddIssuedWarnings(v ...*Warning) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveFollowedByIDs(ids...)
+	return _u.AddIssuedWarningIDs(ids...)
 }
 
-// ClearInvitations clears all "invitations" edges to the Invitation entity.
-func (_u *AccountUpdate) ClearInvitations() *AccountUpdate {
-	_u.mutation.ClearInvitations()
+// AddUploadSessionIDs adds the "upload_sessions" edge to the UploadSession entity by IDs.
+func (_u *AccountUpdate) AddUploadSessionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddUploadSessionIDs(ids...)
 	return _u
 }
 
-// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
-func (_u *AccountUpdate) RemoveInvitationIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveInvitationIDs(ids...)
+// AddUploadSessions adds the "upload_sessions" edges to the UploadSession entity.
+func (_u *AccountUpdate) AddUploadSessions(v ...*UploadSession) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadSessionIDs(ids...)
+}
+
+// AddConversationIDs adds the "conversations" edge to the ConversationParticipant entity by IDs.
+func (_u *AccountUpdate) AddConversationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddConversationIDs(ids...)
 	return _u
 }
 
-// RemoveInvitations removes "invitations" edges to Invitation entities.
-func (_u *AccountUpdate) RemoveInvitations(v ...*Invitation) *AccountUpdate {
+// AddConversations adds the "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdate) AddConversations(v ...*ConversationParticipant) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInvitationIDs(ids...)
+	return _u.AddConversationIDs(ids...)
 }
 
-// ClearInvitedBy clears the "invited_by" edge to the Invitation entity.
-func (_u *AccountUpdate) ClearInvitedBy() *AccountUpdate {
-	_u.mutation.ClearInvitedBy()
+// AddDirectMessageIDs adds the "direct_messages" edge to the DirectMessage entity by IDs.
+func (_u *AccountUpdate) AddDirectMessageIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddDirectMessageIDs(ids...)
 	return _u
 }
 
-// ClearPosts clears all "posts" edges to the Post entity.
-func (_u *AccountUpdate) ClearPosts() *AccountUpdate {
-	_u.mutation.ClearPosts()
-	return _u
+// AddDirectMessages adds the "direct_messages" edges to the DirectMessage entity.
+func (_u *AccountUpdate) AddDirectMessages(v ...*DirectMessage) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDirectMessageIDs(ids...)
 }
 
-// RemovePostIDs removes the "posts" edge to Post entities by IDs.
-func (_u *AccountUpdate) RemovePostIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemovePostIDs(ids...)
+// AddSpaceIDs adds the "spaces" edge to the SpaceMember entity by IDs.
+func (_u *AccountUpdate) AddSpaceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddSpaceIDs(ids...)
 	return _u
 }
 
-// RemovePosts removes "posts" edges to Post entities.
-func (_u *AccountUpdate) RemovePosts(v ...*Post) *AccountUpdate {
+// AddSpaces adds the "spaces" edges to the SpaceMember entity.
+func (_u *AccountUpdate) AddSpaces(v ...*SpaceMember) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePostIDs(ids...)
+	return _u.AddSpaceIDs(ids...)
 }
 
-// ClearQuestions clears all "questions" edges to the Question entity.
-func (_u *AccountUpdate) ClearQuestions() *AccountUpdate {
-	_u.mutation.ClearQuestions()
+// SetApplicationID sets the "application" edge to the AccountApplication entity by ID.
+func (_u *AccountUpdate) SetApplicationID(id xid.ID) *AccountUpdate {
+	_u.mutation.SetApplicationID(id)
 	return _u
 }
 
-// RemoveQuestionIDs removes the "questions" edge to Question entities by IDs.
-func (_u *AccountUpdate) RemoveQuestionIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveQuestionIDs(ids...)
+// SetNillableApplicationID sets the "application" edge to the AccountApplication entity by ID if the given value is not nil.
+func (_u *AccountUpdate) SetNillableApplicationID(id *xid.ID) *AccountUpdate {
+	if id != nil {
+		_u = _u.SetApplicationID(*id)
+	}
 	return _u
 }
 
-// RemoveQuestions removes "questions" edges to Question entities.
-func (_u *AccountUpdate) RemoveQuestions(v ...*Question) *AccountUpdate {
+// SetApplication sets the "application" edge to the AccountApplication entity.
+func (_u *AccountUpdate) SetApplication(v *AccountApplication) *AccountUpdate {
+	return _u.SetApplicationID(v.ID)
+}
+
+// AddReviewedApplicationIDs adds the "reviewed_applications" edge to the AccountApplication entity by IDs.
+func (_u *AccountUpdate) AddReviewedApplicationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddReviewedApplicationIDs(ids...)
+	return _u
+}
+
+// AddReviewedApplications adds the "reviewed_applications" edges to the AccountApplication entity.
+func (_u *AccountUpdate) AddReviewedApplications(v ...*AccountApplication) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveQuestionIDs(ids...)
+	return _u.AddReviewedApplicationIDs(ids...)
 }
 
-// ClearReacts clears all "reacts" edges to the React entity.
-func (_u *AccountUpdate) ClearReacts() *AccountUpdate {
-	_u.mutation.ClearReacts()
+// AddOnboardingStepIDs adds the "onboarding_steps" edge to the AccountOnboardingStep entity by IDs.
+func (_u *AccountUpdate) AddOnboardingStepIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddOnboardingStepIDs(ids...)
 	return _u
 }
 
-// RemoveReactIDs removes the "reacts" edge to React entities by IDs.
-func (_u *AccountUpdate) RemoveReactIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveReactIDs(ids...)
+// AddOnboardingSteps adds the "onboarding_steps" edges to the AccountOnboardingStep entity.
+func (_u *AccountUpdate) AddOnboardingSteps(v ...*AccountOnboardingStep) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOnboardingStepIDs(ids...)
+}
+
+// AddPolicyAcceptanceIDs adds the "policy_acceptances" edge to the PolicyAcceptance entity by IDs.
+func (_u *AccountUpdate) AddPolicyAcceptanceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddPolicyAcceptanceIDs(ids...)
 	return _u
 }
 
-// RemoveReacts removes "reacts" edges to React entities.
-func (_u *AccountUpdate) RemoveReacts(v ...*React) *AccountUpdate {
+// AddPolicyAcceptances adds the "policy_acceptances" edges to the PolicyAcceptance entity.
+func (_u *AccountUpdate) AddPolicyAcceptances(v ...*PolicyAcceptance) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReactIDs(ids...)
+	return _u.AddPolicyAcceptanceIDs(ids...)
 }
 
-// ClearLikes clears all "likes" edges to the LikePost entity.
-func (_u *AccountUpdate) ClearLikes() *AccountUpdate {
-	_u.mutation.ClearLikes()
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (_u *AccountUpdate) AddBookmarkIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddBookmarkIDs(ids...)
 	return _u
 }
 
-// RemoveLikeIDs removes the "likes" edge to LikePost entities by IDs.
-func (_u *AccountUpdate) RemoveLikeIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveLikeIDs(ids...)
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (_u *AccountUpdate) AddBookmarks(v ...*Bookmark) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookmarkIDs(ids...)
+}
+
+// AddReadingHistoryIDs adds the "reading_history" edge to the ReadingHistory entity by IDs.
+func (_u *AccountUpdate) AddReadingHistoryIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddReadingHistoryIDs(ids...)
 	return _u
 }
 
-// RemoveLikes removes "likes" edges to LikePost entities.
-func (_u *AccountUpdate) RemoveLikes(v ...*LikePost) *AccountUpdate {
+// AddReadingHistory adds the "reading_history" edges to the ReadingHistory entity.
+func (_u *AccountUpdate) AddReadingHistory(v ...*ReadingHistory) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLikeIDs(ids...)
+	return _u.AddReadingHistoryIDs(ids...)
 }
 
-// ClearMentions clears all "mentions" edges to the MentionProfile entity.
-func (_u *AccountUpdate) ClearMentions() *AccountUpdate {
-	_u.mutation.ClearMentions()
+// AddVerificationRequestIDs adds the "verification_requests" edge to the VerificationRequest entity by IDs.
+func (_u *AccountUpdate) AddVerificationRequestIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddVerificationRequestIDs(ids...)
 	return _u
 }
 
-// RemoveMentionIDs removes the "mentions" edge to MentionProfile entities by IDs.
-func (_u *AccountUpdate) RemoveMentionIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveMentionIDs(ids...)
+// AddVerificationRequests adds the "verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdate) AddVerificationRequests(v ...*VerificationRequest) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationRequestIDs(ids...)
+}
+
+// AddReviewedVerificationRequestIDs adds the "reviewed_verification_requests" edge to the VerificationRequest entity by IDs.
+func (_u *AccountUpdate) AddReviewedVerificationRequestIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddReviewedVerificationRequestIDs(ids...)
 	return _u
 }
 
-// RemoveMentions removes "mentions" edges to MentionProfile entities.
-func (_u *AccountUpdate) RemoveMentions(v ...*MentionProfile) *AccountUpdate {
+// AddReviewedVerificationRequests adds the "reviewed_verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdate) AddReviewedVerificationRequests(v ...*VerificationRequest) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMentionIDs(ids...)
+	return _u.AddReviewedVerificationRequestIDs(ids...)
 }
 
-// ClearRoles clears all "roles" edges to the Role entity.
-func (_u *AccountUpdate) ClearRoles() *AccountUpdate {
-	_u.mutation.ClearRoles()
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *AccountUpdate) AddWebhookIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
 	return _u
 }
 
-// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
-func (_u *AccountUpdate) RemoveRoleIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveRoleIDs(ids...)
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *AccountUpdate) AddWebhooks(v ...*Webhook) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
+func (_u *AccountUpdate) AddAccountRoleIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddAccountRoleIDs(ids...)
 	return _u
 }
 
-// RemoveRoles removes "roles" edges to Role entities.
-func (_u *AccountUpdate) RemoveRoles(v ...*Role) *AccountUpdate {
+// AddAccountRoles adds the "account_roles" edges to the AccountRoles entity.
+func (_u *AccountUpdate) AddAccountRoles(v ...*AccountRoles) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveRoleIDs(ids...)
+	return _u.AddAccountRoleIDs(ids...)
 }
 
-// ClearAuthentication clears all "authentication" edges to the Authentication entity.
-func (_u *AccountUpdate) ClearAuthentication() *AccountUpdate {
-	_u.mutation.ClearAuthentication()
+// Mutation returns the AccountMutation object of the builder.
+func (_u *AccountUpdate) Mutation() *AccountMutation {
+	return _u.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *AccountUpdate) ClearSessions() *AccountUpdate {
+	_u.mutation.ClearSessions()
 	return _u
 }
 
-// RemoveAuthenticationIDs removes the "authentication" edge to Authentication entities by IDs.
-func (_u *AccountUpdate) RemoveAuthenticationIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveAuthenticationIDs(ids...)
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *AccountUpdate) RemoveSessionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
 	return _u
 }
 
-// RemoveAuthentication removes "authentication" edges to Authentication entities.
-func (_u *AccountUpdate) RemoveAuthentication(v ...*Authentication) *AccountUpdate {
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *AccountUpdate) RemoveSessions(v ...*Session) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAuthenticationIDs(ids...)
+	return _u.RemoveSessionIDs(ids...)
 }
 
-// ClearTags clears all "tags" edges to the Tag entity.
-func (_u *AccountUpdate) ClearTags() *AccountUpdate {
-	_u.mutation.ClearTags()
+// ClearEmails clears all "emails" edges to the Email entity.
+func (_u *AccountUpdate) ClearEmails() *AccountUpdate {
+	_u.mutation.ClearEmails()
 	return _u
 }
 
-// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
-func (_u *AccountUpdate) RemoveTagIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveTagIDs(ids...)
+// RemoveEmailIDs removes the "emails" edge to Email entities by IDs.
+func (_u *AccountUpdate) RemoveEmailIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveEmailIDs(ids...)
 	return _u
 }
 
-// RemoveTags removes "tags" edges to Tag entities.
-func (_u *AccountUpdate) RemoveTags(v ...*Tag) *AccountUpdate {
+// RemoveEmails removes "emails" edges to Email entities.
+func (_u *AccountUpdate) RemoveEmails(v ...*Email) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTagIDs(ids...)
+	return _u.RemoveEmailIDs(ids...)
 }
 
-// ClearCollections clears all "collections" edges to the Collection entity.
-func (_u *AccountUpdate) ClearCollections() *AccountUpdate {
-	_u.mutation.ClearCollections()
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *AccountUpdate) ClearNotifications() *AccountUpdate {
+	_u.mutation.ClearNotifications()
 	return _u
 }
 
-// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
-func (_u *AccountUpdate) RemoveCollectionIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveCollectionIDs(ids...)
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *AccountUpdate) RemoveNotificationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
 	return _u
 }
 
-// RemoveCollections removes "collections" edges to Collection entities.
-func (_u *AccountUpdate) RemoveCollections(v ...*Collection) *AccountUpdate {
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *AccountUpdate) RemoveNotifications(v ...*Notification) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCollectionIDs(ids...)
+	return _u.RemoveNotificationIDs(ids...)
 }
 
-// ClearNodes clears all "nodes" edges to the Node entity.
-func (_u *AccountUpdate) ClearNodes() *AccountUpdate {
-	_u.mutation.ClearNodes()
+// ClearTriggeredNotifications clears all "triggered_notifications" edges to the Notification entity.
+func (_u *AccountUpdate) ClearTriggeredNotifications() *AccountUpdate {
+	_u.mutation.ClearTriggeredNotifications()
 	return _u
 }
 
-// RemoveNodeIDs removes the "nodes" edge to Node entities by IDs.
-func (_u *AccountUpdate) RemoveNodeIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveNodeIDs(ids...)
+// RemoveTriggeredNotificationIDs removes the "triggered_notifications" edge to Notification entities by IDs.
+func (_u *AccountUpdate) RemoveTriggeredNotificationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveTriggeredNotificationIDs(ids...)
 	return _u
 }
 
-// RemoveNodes removes "nodes" edges to Node entities.
-func (_u *AccountUpdate) RemoveNodes(v ...*Node) *AccountUpdate {
+// RemoveTriggeredNotifications removes "triggered_notifications" edges to Notification entities.
+func (_u *AccountUpdate) RemoveTriggeredNotifications(v ...*Notification) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNodeIDs(ids...)
+	return _u.RemoveTriggeredNotificationIDs(ids...)
 }
 
-// ClearAssets clears all "assets" edges to the Asset entity.
-func (_u *AccountUpdate) ClearAssets() *AccountUpdate {
-	_u.mutation.ClearAssets()
+// ClearFollowing clears all "following" edges to the AccountFollow entity.
+func (_u *AccountUpdate) ClearFollowing() *AccountUpdate {
+	_u.mutation.ClearFollowing()
 	return _u
 }
 
-// RemoveAssetIDs removes the "assets" edge to Asset entities by IDs.
-func (_u *AccountUpdate) RemoveAssetIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveAssetIDs(ids...)
+// RemoveFollowingIDs removes the "following" edge to AccountFollow entities by IDs.
+func (_u *AccountUpdate) RemoveFollowingIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveFollowingIDs(ids...)
 	return _u
 }
 
-// RemoveAssets removes "assets" edges to Asset entities.
-func (_u *AccountUpdate) RemoveAssets(v ...*Asset) *AccountUpdate {
+// RemoveFollowing removes "following" edges to AccountFollow entities.
+func (_u *AccountUpdate) RemoveFollowing(v ...*AccountFollow) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssetIDs(ids...)
+	return _u.RemoveFollowingIDs(ids...)
 }
 
-// ClearEvents clears all "events" edges to the EventParticipant entity.
-func (_u *AccountUpdate) ClearEvents() *AccountUpdate {
-	_u.mutation.ClearEvents()
+// ClearFollowedBy clears all "followed_by" edges to the AccountFollow entity.
+func (_u *AccountUpdate) ClearFollowedBy() *AccountUpdate {
+	_u.mutation.ClearFollowedBy()
 	return _u
 }
 
-// RemoveEventIDs removes the "events" edge to EventParticipant entities by IDs.
-func (_u *AccountUpdate) RemoveEventIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveEventIDs(ids...)
+// RemoveFollowedByIDs removes the "followed_by" edge to AccountFollow entities by IDs.
+func (_u *AccountUpdate) RemoveFollowedByIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveFollowedByIDs(ids...)
 	return _u
 }
 
-// RemoveEvents removes "events" edges to EventParticipant entities.
-func (_u *AccountUpdate) RemoveEvents(v ...*EventParticipant) *AccountUpdate {
+// RemoveFollowedBy removes "followed_by" edges to AccountFollow entities.
+func (_u *AccountUpdate) RemoveFollowedBy(v ...*AccountFollow) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEventIDs(ids...)
+	return _u.RemoveFollowedByIDs(ids...)
 }
 
-// ClearPostReads clears all "post_reads" edges to the PostRead entity.
-func (_u *AccountUpdate) ClearPostReads() *AccountUpdate {
-	_u.mutation.ClearPostReads()
+// ClearBlocks clears all "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdate) ClearBlocks() *AccountUpdate {
+	_u.mutation.ClearBlocks()
 	return _u
 }
 
-// RemovePostReadIDs removes the "post_reads" edge to PostRead entities by IDs.
-func (_u *AccountUpdate) RemovePostReadIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemovePostReadIDs(ids...)
+// RemoveBlockIDs removes the "blocks" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdate) RemoveBlockIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveBlockIDs(ids...)
 	return _u
 }
 
-// RemovePostReads removes "post_reads" edges to PostRead entities.
-func (_u *AccountUpdate) RemovePostReads(v ...*PostRead) *AccountUpdate {
+// RemoveBlocks removes "blocks" edges to AccountBlock entities.
+func (_u *AccountUpdate) RemoveBlocks(v ...*AccountBlock) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePostReadIDs(ids...)
+	return _u.RemoveBlockIDs(ids...)
 }
 
-// ClearReports clears all "reports" edges to the Report entity.
-func (_u *AccountUpdate) ClearReports() *AccountUpdate {
-	_u.mutation.ClearReports()
+// ClearBlockedBy clears all "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdate) ClearBlockedBy() *AccountUpdate {
+	_u.mutation.ClearBlockedBy()
 	return _u
 }
 
-// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
-func (_u *AccountUpdate) RemoveReportIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveReportIDs(ids...)
+// RemoveBlockedByIDs removes the "blocked_by" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdate) RemoveBlockedByIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveBlockedByIDs(ids...)
 	return _u
 }
 
-// RemoveReports removes "reports" edges to Report entities.
-func (_u *AccountUpdate) RemoveReports(v ...*Report) *AccountUpdate {
+// RemoveBlockedBy removes "blocked_by" edges to AccountBlock entities.
+func (_u *AccountUpdate) RemoveBlockedBy(v ...*AccountBlock) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReportIDs(ids...)
+	return _u.RemoveBlockedByIDs(ids...)
 }
 
-// ClearHandledReports clears all "handled_reports" edges to the Report entity.
-func (_u *AccountUpdate) ClearHandledReports() *AccountUpdate {
-	_u.mutation.ClearHandledReports()
+// ClearTagFollows clears all "tag_follows" edges to the TagFollow entity.
+func (_u *AccountUpdate) ClearTagFollows() *AccountUpdate {
+	_u.mutation.ClearTagFollows()
 	return _u
 }
 
-// RemoveHandledReportIDs removes the "handled_reports" edge to Report entities by IDs.
-func (_u *AccountUpdate) RemoveHandledReportIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveHandledReportIDs(ids...)
+// RemoveTagFollowIDs removes the "tag_follows" edge to TagFollow entities by IDs.
+func (_u *AccountUpdate) RemoveTagFollowIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveTagFollowIDs(ids...)
 	return _u
 }
 
-// RemoveHandledReports removes "handled_reports" edges to Report entities.
-func (_u *AccountUpdate) RemoveHandledReports(v ...*Report) *AccountUpdate {
+// RemoveTagFollows removes "tag_follows" edges to TagFollow entities.
+func (_u *AccountUpdate) RemoveTagFollows(v ...*TagFollow) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveHandledReportIDs(ids...)
+	return _u.RemoveTagFollowIDs(ids...)
 }
 
-// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
-func (_u *AccountUpdate) ClearAccountRoles() *AccountUpdate {
-	_u.mutation.ClearAccountRoles()
+// ClearCategoryFollows clears all "category_follows" edges to the CategoryFollow entity.
+func (_u *AccountUpdate) ClearCategoryFollows() *AccountUpdate {
+	_u.mutation.ClearCategoryFollows()
 	return _u
 }
 
-// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
-func (_u *AccountUpdate) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveAccountRoleIDs(ids...)
+// RemoveCategoryFollowIDs removes the "category_follows" edge to CategoryFollow entities by IDs.
+func (_u *AccountUpdate) RemoveCategoryFollowIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveCategoryFollowIDs(ids...)
 	return _u
 }
 
-// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
-func (_u *AccountUpdate) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdate {
+// RemoveCategoryFollows removes "category_follows" edges to CategoryFollow entities.
+func (_u *AccountUpdate) RemoveCategoryFollows(v ...*CategoryFollow) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAccountRoleIDs(ids...)
+	return _u.RemoveCategoryFollowIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearReputation clears all "reputation" edges to the ReputationEntry entity.
+func (_u *AccountUpdate) ClearReputation() *AccountUpdate {
+	_u.mutation.ClearReputation()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *AccountUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveReputationIDs removes the "reputation" edge to ReputationEntry entities by IDs.
+func (_u *AccountUpdate) RemoveReputationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveReputationIDs(ids...)
+	return _u
+}
+
+// RemoveReputation removes "reputation" edges to ReputationEntry entities.
+func (_u *AccountUpdate) RemoveReputation(v ...*ReputationEntry) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveReputationIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *AccountUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearBadges clears all "badges" edges to the AccountBadge entity.
+func (_u *AccountUpdate) ClearBadges() *AccountUpdate {
+	_u.mutation.ClearBadges()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *AccountUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveBadgeIDs removes the "badges" edge to AccountBadge entities by IDs.
+func (_u *AccountUpdate) RemoveBadgeIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveBadgeIDs(ids...)
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *AccountUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := account.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+// RemoveBadges removes "badges" edges to AccountBadge entities.
+func (_u *AccountUpdate) RemoveBadges(v ...*AccountBadge) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveBadgeIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *AccountUpdate) check() error {
-	if v, ok := _u.mutation.Handle(); ok {
-		if err := account.HandleValidator(v); err != nil {
-			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.Name(); ok {
-		if err := account.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.Kind(); ok {
-		if err := account.KindValidator(v); err != nil {
-			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
-		}
-	}
-	return nil
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (_u *AccountUpdate) ClearInvitations() *AccountUpdate {
+	_u.mutation.ClearInvitations()
+	return _u
 }
 
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *AccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdate {
-	_u.modifiers = append(_u.modifiers, modifiers...)
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (_u *AccountUpdate) RemoveInvitationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveInvitationIDs(ids...)
 	return _u
 }
 
-func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (_u *AccountUpdate) RemoveInvitations(v ...*Invitation) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u.RemoveInvitationIDs(ids...)
+}
+
+// ClearInvitedBy clears the "invited_by" edge to the Invitation entity.
+func (_u *AccountUpdate) ClearInvitedBy() *AccountUpdate {
+	_u.mutation.ClearInvitedBy()
+	return _u
+}
+
+// ClearOwner clears the "owner" edge to the Account entity.
+func (_u *AccountUpdate) ClearOwner() *AccountUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBots clears all "bots" edges to the Account entity.
+func (_u *AccountUpdate) ClearBots() *AccountUpdate {
+	_u.mutation.ClearBots()
+	return _u
+}
+
+// RemoveBotIDs removes the "bots" edge to Account entities by IDs.
+func (_u *AccountUpdate) RemoveBotIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveBotIDs(ids...)
+	return _u
+}
+
+// RemoveBots removes "bots" edges to Account entities.
+func (_u *AccountUpdate) RemoveBots(v ...*Account) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+	return _u.RemoveBotIDs(ids...)
+}
+
+// ClearPosts clears all "posts" edges to the Post entity.
+func (_u *AccountUpdate) ClearPosts() *AccountUpdate {
+	_u.mutation.ClearPosts()
+	return _u
+}
+
+// RemovePostIDs removes the "posts" edge to Post entities by IDs.
+func (_u *AccountUpdate) RemovePostIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemovePostIDs(ids...)
+	return _u
+}
+
+// RemovePosts removes "posts" edges to Post entities.
+func (_u *AccountUpdate) RemovePosts(v ...*Post) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	return _u.RemovePostIDs(ids...)
+}
+
+// ClearQuestions clears all "questions" edges to the Question entity.
+func (_u *AccountUpdate) ClearQuestions() *AccountUpdate {
+	_u.mutation.ClearQuestions()
+	return _u
+}
+
+// RemoveQuestionIDs removes the "questions" edge to Question entities by IDs.
+func (_u *AccountUpdate) RemoveQuestionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveQuestionIDs(ids...)
+	return _u
+}
+
+// RemoveQuestions removes "questions" edges to Question entities.
+func (_u *AccountUpdate) RemoveQuestions(v ...*Question) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	return _u.RemoveQuestionIDs(ids...)
+}
+
+// ClearReacts clears all "reacts" edges to the React entity.
+func (_u *AccountUpdate) ClearReacts() *AccountUpdate {
+	_u.mutation.ClearReacts()
+	return _u
+}
+
+// RemoveReactIDs removes the "reacts" edge to React entities by IDs.
+func (_u *AccountUpdate) RemoveReactIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveReactIDs(ids...)
+	return _u
+}
+
+// RemoveReacts removes "reacts" edges to React entities.
+func (_u *AccountUpdate) RemoveReacts(v ...*React) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.IndexedAt(); ok {
-		_spec.SetField(account.FieldIndexedAt, field.TypeTime, value)
+	return _u.RemoveReactIDs(ids...)
+}
+
+// ClearLikes clears all "likes" edges to the LikePost entity.
+func (_u *AccountUpdate) ClearLikes() *AccountUpdate {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// RemoveLikeIDs removes the "likes" edge to LikePost entities by IDs.
+func (_u *AccountUpdate) RemoveLikeIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveLikeIDs(ids...)
+	return _u
+}
+
+// RemoveLikes removes "likes" edges to LikePost entities.
+func (_u *AccountUpdate) RemoveLikes(v ...*LikePost) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.IndexedAtCleared() {
-		_spec.ClearField(account.FieldIndexedAt, field.TypeTime)
+	return _u.RemoveLikeIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MentionProfile entity.
+func (_u *AccountUpdate) ClearMentions() *AccountUpdate {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MentionProfile entities by IDs.
+func (_u *AccountUpdate) RemoveMentionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveMentionIDs(ids...)
+	return _u
+}
+
+// RemoveMentions removes "mentions" edges to MentionProfile entities.
+func (_u *AccountUpdate) RemoveMentions(v ...*MentionProfile) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Handle(); ok {
-		_spec.SetField(account.FieldHandle, field.TypeString, value)
+	return _u.RemoveMentionIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *AccountUpdate) ClearRoles() *AccountUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *AccountUpdate) RemoveRoleIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *AccountUpdate) RemoveRoles(v ...*Role) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(account.FieldName, field.TypeString, value)
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearAuthentication clears all "authentication" edges to the Authentication entity.
+func (_u *AccountUpdate) ClearAuthentication() *AccountUpdate {
+	_u.mutation.ClearAuthentication()
+	return _u
+}
+
+// RemoveAuthenticationIDs removes the "authentication" edge to Authentication entities by IDs.
+func (_u *AccountUpdate) RemoveAuthenticationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveAuthenticationIDs(ids...)
+	return _u
+}
+
+// RemoveAuthentication removes "authentication" edges to Authentication entities.
+func (_u *AccountUpdate) RemoveAuthentication(v ...*Authentication) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Bio(); ok {
-		_spec.SetField(account.FieldBio, field.TypeString, value)
-	}
-	if _u.mutation.BioCleared() {
-		_spec.ClearField(account.FieldBio, field.TypeString)
+	return _u.RemoveAuthenticationIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *AccountUpdate) ClearTags() *AccountUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *AccountUpdate) RemoveTagIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *AccountUpdate) RemoveTags(v ...*Tag) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Kind(); ok {
-		_spec.SetField(account.FieldKind, field.TypeEnum, value)
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *AccountUpdate) ClearCollections() *AccountUpdate {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *AccountUpdate) RemoveCollectionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *AccountUpdate) RemoveCollections(v ...*Collection) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Admin(); ok {
-		_spec.SetField(account.FieldAdmin, field.TypeBool, value)
+	return _u.RemoveCollectionIDs(ids...)
+}
+
+// ClearNodes clears all "nodes" edges to the Node entity.
+func (_u *AccountUpdate) ClearNodes() *AccountUpdate {
+	_u.mutation.ClearNodes()
+	return _u
+}
+
+// RemoveNodeIDs removes the "nodes" edge to Node entities by IDs.
+func (_u *AccountUpdate) RemoveNodeIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveNodeIDs(ids...)
+	return _u
+}
+
+// RemoveNodes removes "nodes" edges to Node entities.
+func (_u *AccountUpdate) RemoveNodes(v ...*Node) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Links(); ok {
-		_spec.SetField(account.FieldLinks, field.TypeJSON, value)
+	return _u.RemoveNodeIDs(ids...)
+}
+
+// ClearAssets clears all "assets" edges to the Asset entity.
+func (_u *AccountUpdate) ClearAssets() *AccountUpdate {
+	_u.mutation.ClearAssets()
+	return _u
+}
+
+// RemoveAssetIDs removes the "assets" edge to Asset entities by IDs.
+func (_u *AccountUpdate) RemoveAssetIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveAssetIDs(ids...)
+	return _u
+}
+
+// RemoveAssets removes "assets" edges to Asset entities.
+func (_u *AccountUpdate) RemoveAssets(v ...*Asset) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.AppendedLinks(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, account.FieldLinks, value)
-		})
+	return _u.RemoveAssetIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the EventParticipant entity.
+func (_u *AccountUpdate) ClearEvents() *AccountUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to EventParticipant entities by IDs.
+func (_u *AccountUpdate) RemoveEventIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to EventParticipant entities.
+func (_u *AccountUpdate) RemoveEvents(v ...*EventParticipant) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.LinksCleared() {
-		_spec.ClearField(account.FieldLinks, field.TypeJSON)
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearPostReads clears all "post_reads" edges to the PostRead entity.
+func (_u *AccountUpdate) ClearPostReads() *AccountUpdate {
+	_u.mutation.ClearPostReads()
+	return _u
+}
+
+// RemovePostReadIDs removes the "post_reads" edge to PostRead entities by IDs.
+func (_u *AccountUpdate) RemovePostReadIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemovePostReadIDs(ids...)
+	return _u
+}
+
+// RemovePostReads removes "post_reads" edges to PostRead entities.
+func (_u *AccountUpdate) RemovePostReads(v ...*PostRead) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Metadata(); ok {
-		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
+	return _u.RemovePostReadIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdate) ClearNotificationPreferences() *AccountUpdate {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *AccountUpdate) RemoveNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *AccountUpdate) RemoveNotificationPreferences(v ...*NotificationPreference) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.MetadataCleared() {
-		_spec.ClearField(account.FieldMetadata, field.TypeJSON)
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
+// ClearPushSubscriptions clears all "push_subscriptions" edges to the PushSubscription entity.
+func (_u *AccountUpdate) ClearPushSubscriptions() *AccountUpdate {
+	_u.mutation.ClearPushSubscriptions()
+	return _u
+}
+
+// RemovePushSubscriptionIDs removes the "push_subscriptions" edge to PushSubscription entities by IDs.
+func (_u *AccountUpdate) RemovePushSubscriptionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemovePushSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemovePushSubscriptions removes "push_subscriptions" edges to PushSubscription entities.
+func (_u *AccountUpdate) RemovePushSubscriptions(v ...*PushSubscription) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.SessionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemovePushSubscriptionIDs(ids...)
+}
+
+// ClearDigestSubscription clears the "digest_subscription" edge to the DigestSubscription entity.
+func (_u *AccountUpdate) ClearDigestSubscription() *AccountUpdate {
+	_u.mutation.ClearDigestSubscription()
+	return _u
+}
+
+// ClearFeedToken clears the "feed_token" edge to the FeedToken entity.
+func (_u *AccountUpdate) ClearFeedToken() *AccountUpdate {
+	_u.mutation.ClearFeedToken()
+	return _u
+}
+
+// ClearAPIUsage clears all "api_usage" edges to the APIUsage entity.
+func (_u *AccountUpdate) ClearAPIUsage() *AccountUpdate {
+	_u.mutation.ClearAPIUsage()
+	return _u
+}
+
+// RemoveAPIUsageIDs removes the "api_usage" edge to APIUsage entities by IDs.
+func (_u *AccountUpdate) RemoveAPIUsageIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveAPIUsageIDs(ids...)
+	return _u
+}
+
+// RemoveAPIUsage removes "api_usage" edges to APIUsage entities.
+func (_u *AccountUpdate) RemoveAPIUsage(v ...*APIUsage) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveAPIUsageIDs(ids...)
+}
+
+// ClearContentViews clears all "content_views" edges to the ContentView entity.
+func (_u *AccountUpdate) ClearContentViews() *AccountUpdate {
+	_u.mutation.ClearContentViews()
+	return _u
+}
+
+// RemoveContentViewIDs removes the "content_views" edge to ContentView entities by IDs.
+func (_u *AccountUpdate) RemoveContentViewIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveContentViewIDs(ids...)
+	return _u
+}
+
+// RemoveContentViews removes "content_views" edges to ContentView entities.
+func (_u *AccountUpdate) RemoveContentViews(v ...*ContentView) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveContentViewIDs(ids...)
+}
+
+// ClearReports clears all "reports" edges to the Report entity.
+func (_u *AccountUpdate) ClearReports() *AccountUpdate {
+	_u.mutation.ClearReports()
+	return _u
+}
+
+// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
+func (_u *AccountUpdate) RemoveReportIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveReportIDs(ids...)
+	return _u
+}
+
+// RemoveReports removes "reports" edges to Report entities.
+func (_u *AccountUpdate) RemoveReports(v ...*Report) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.EmailsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !_u.mutation.EmailsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveReportIDs(ids...)
+}
+
+// ClearHandledReports clears all "handled_reports" edges to the Report entity.
+func (_u *AccountUpdate) ClearHandledReports() *AccountUpdate {
+	_u.mutation.ClearHandledReports()
+	return _u
+}
+
+// RemoveHandledReportIDs removes the "handled_reports" edge to Report entities by IDs.
+func (_u *AccountUpdate) RemoveHandledReportIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveHandledReportIDs(ids...)
+	return _u
+}
+
+// RemoveHandledReports removes "handled_reports" edges to Report entities.
+func (_u *AccountUpdate) RemoveHandledReports(v ...*Report) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.EmailsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveHandledReportIDs(ids...)
+}
+
+// ClearModerationNotes clears all "moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdate) ClearModerationNotes() *AccountUpdate {
+	_u.mutation.ClearModerationNotes()
+	return _u
+}
+
+// RemoveModerationNoteIDs removes the "moderation_notes" edge to ModerationNote entities by IDs.
+func (_u *AccountUpdate) RemoveModerationNoteIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveModerationNoteIDs(ids...)
+	return _u
+}
+
+// RemoveModerationNotes removes "moderation_notes" edges to ModerationNote entities.
+func (_u *AccountUpdate) RemoveModerationNotes(v ...*ModerationNote) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.NotificationsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveModerationNoteIDs(ids...)
+}
+
+// ClearAuthoredModerationNotes clears all "authored_moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdate) ClearAuthoredModerationNotes() *AccountUpdate {
+	_u.mutation.ClearAuthoredModerationNotes()
+	return _u
+}
+
+// RemoveAuthoredModerationNoteIDs removes the "authored_moderation_notes" edge to ModerationNote entities by IDs.
+func (_u *AccountUpdate) RemoveAuthoredModerationNoteIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveAuthoredModerationNoteIDs(ids...)
+	return _u
+}
+
+// RemoveAuthoredModerationNotes removes "authored_moderation_notes" edges to ModerationNote entities.
+func (_u *AccountUpdate) RemoveAuthoredModerationNotes(v ...*ModerationNote) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveAuthoredModerationNoteIDs(ids...)
+}
+
+// ClearWarnings clears all "warnings" edges to the Warning entity.
+func (_u *AccountUpdate) ClearWarnings() *AccountUpdate {
+	_u.mutation.ClearWarnings()
+	return _u
+}
+
+// RemoveWarningIDs removes the "warnings" edge to Warning entities by IDs.
+func (_u *AccountUpdate) RemoveWarningIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveWarningIDs(ids...)
+	return _u
+}
+
+// RemoveWarnings removes "warnings" edges to Warning entities.
+func (_u *AccountUpdate) RemoveWarnings(v ...*Warning) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveWarningIDs(ids...)
+}
+
+// ClearIssuedWarnings clears all "issued_warnings" edges to the Warning entity.
+func (_u *AccountUpdate) ClearIssuedWarnings() *AccountUpdate {
+	_u.mutation.ClearIssuedWarnings()
+	return _u
+}
+
+// RemoveIssuedWarningIDs removes the "issued_warnings" edge to Warning entities by IDs.
+func (_u *AccountUpdate) RemoveIssuedWarningIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveIssuedWarningIDs(ids...)
+	return _u
+}
+
+// RemoveIssuedWarnings removes "issued_warnings" edges to Warning entities.
+func (_u *AccountUpdate) RemoveIssuedWarnings(v ...*Warning) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.TriggeredNotificationsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveIssuedWarningIDs(ids...)
+}
+
+// ClearUploadSessions clears all "upload_sessions" edges to the UploadSession entity.
+func (_u *AccountUpdate) ClearUploadSessions() *AccountUpdate {
+	_u.mutation.ClearUploadSessions()
+	return _u
+}
+
+// RemoveUploadSessionIDs removes the "upload_sessions" edge to UploadSession entities by IDs.
+func (_u *AccountUpdate) RemoveUploadSessionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveUploadSessionIDs(ids...)
+	return _u
+}
+
+// RemoveUploadSessions removes "upload_sessions" edges to UploadSession entities.
+func (_u *AccountUpdate) RemoveUploadSessions(v ...*UploadSession) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.RemovedTriggeredNotificationsIDs(); len(nodes) > 0 && !_u.mutation.TriggeredNotificationsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveUploadSessionIDs(ids...)
+}
+
+// ClearConversations clears all "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdate) ClearConversations() *AccountUpdate {
+	_u.mutation.ClearConversations()
+	return _u
+}
+
+// RemoveConversationIDs removes the "conversations" edge to ConversationParticipant entities by IDs.
+func (_u *AccountUpdate) RemoveConversationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveConversationIDs(ids...)
+	return _u
+}
+
+// RemoveConversations removes "conversations" edges to ConversationParticipant entities.
+func (_u *AccountUpdate) RemoveConversations(v ...*ConversationParticipant) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.TriggeredNotificationsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveConversationIDs(ids...)
+}
+
+// ClearDirectMessages clears all "direct_messages" edges to the DirectMessage entity.
+func (_u *AccountUpdate) ClearDirectMessages() *AccountUpdate {
+	_u.mutation.ClearDirectMessages()
+	return _u
+}
+
+// RemoveDirectMessageIDs removes the "direct_messages" edge to DirectMessage entities by IDs.
+func (_u *AccountUpdate) RemoveDirectMessageIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveDirectMessageIDs(ids...)
+	return _u
+}
+
+// RemoveDirectMessages removes "direct_messages" edges to DirectMessage entities.
+func (_u *AccountUpdate) RemoveDirectMessages(v ...*DirectMessage) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.FollowingCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveDirectMessageIDs(ids...)
+}
+
+// ClearSpaces clears all "spaces" edges to the SpaceMember entity.
+func (_u *AccountUpdate) ClearSpaces() *AccountUpdate {
+	_u.mutation.ClearSpaces()
+	return _u
+}
+
+// RemoveSpaceIDs removes the "spaces" edge to SpaceMember entities by IDs.
+func (_u *AccountUpdate) RemoveSpaceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveSpaceIDs(ids...)
+	return _u
+}
+
+// RemoveSpaces removes "spaces" edges to SpaceMember entities.
+func (_u *AccountUpdate) RemoveSpaces(v ...*SpaceMember) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveSpaceIDs(ids...)
+}
+
+// ClearApplication clears the "application" edge to the AccountApplication entity.
+func (_u *AccountUpdate) ClearApplication() *AccountUpdate {
+	_u.mutation.ClearApplication()
+	return _u
+}
+
+// ClearReviewedApplications clears all "reviewed_applications" edges to the AccountApplication entity.
+func (_u *AccountUpdate) ClearReviewedApplications() *AccountUpdate {
+	_u.mutation.ClearReviewedApplications()
+	return _u
+}
+
+// RemoveReviewedApplicationIDs removes the "reviewed_applications" edge to AccountApplication entities by IDs.
+func (_u *AccountUpdate) RemoveReviewedApplicationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveReviewedApplicationIDs(ids...)
+	return _u
+}
+
+// RemoveReviewedApplications removes "reviewed_applications" edges to AccountApplication entities.
+func (_u *AccountUpdate) RemoveReviewedApplications(v ...*AccountApplication) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveReviewedApplicationIDs(ids...)
+}
+
+// ClearOnboardingSteps clears all "onboarding_steps" edges to the AccountOnboardingStep entity.
+func (_u *AccountUpdate) ClearOnboardingSteps() *AccountUpdate {
+	_u.mutation.ClearOnboardingSteps()
+	return _u
+}
+
+// RemoveOnboardingStepIDs removes the "onboarding_steps" edge to AccountOnboardingStep entities by IDs.
+func (_u *AccountUpdate) RemoveOnboardingStepIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveOnboardingStepIDs(ids...)
+	return _u
+}
+
+// RemoveOnboardingSteps removes "onboarding_steps" edges to AccountOnboardingStep entities.
+func (_u *AccountUpdate) RemoveOnboardingSteps(v ...*AccountOnboardingStep) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.FollowedByCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveOnboardingStepIDs(ids...)
+}
+
+// ClearPolicyAcceptances clears all "policy_acceptances" edges to the PolicyAcceptance entity.
+func (_u *AccountUpdate) ClearPolicyAcceptances() *AccountUpdate {
+	_u.mutation.ClearPolicyAcceptances()
+	return _u
+}
+
+// RemovePolicyAcceptanceIDs removes the "policy_acceptances" edge to PolicyAcceptance entities by IDs.
+func (_u *AccountUpdate) RemovePolicyAcceptanceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemovePolicyAcceptanceIDs(ids...)
+	return _u
+}
+
+// RemovePolicyAcceptances removes "policy_acceptances" edges to PolicyAcceptance entities.
+func (_u *AccountUpdate) RemovePolicyAcceptances(v ...*PolicyAcceptance) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !_u.mutation.FollowedByCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemovePolicyAcceptanceIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (_u *AccountUpdate) ClearBookmarks() *AccountUpdate {
+	_u.mutation.ClearBookmarks()
+	return _u
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (_u *AccountUpdate) RemoveBookmarkIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveBookmarkIDs(ids...)
+	return _u
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (_u *AccountUpdate) RemoveBookmarks(v ...*Bookmark) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.FollowedByIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveBookmarkIDs(ids...)
+}
+
+// ClearReadingHistory clears all "reading_history" edges to the ReadingHistory entity.
+func (_u *AccountUpdate) ClearReadingHistory() *AccountUpdate {
+	_u.mutation.ClearReadingHistory()
+	return _u
+}
+
+// RemoveReadingHistoryIDs removes the "reading_history" edge to ReadingHistory entities by IDs.
+func (_u *AccountUpdate) RemoveReadingHistoryIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveReadingHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveReadingHistory removes "reading_history" edges to ReadingHistory entities.
+func (_u *AccountUpdate) RemoveReadingHistory(v ...*ReadingHistory) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.InvitationsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveReadingHistoryIDs(ids...)
+}
+
+// ClearVerificationRequests clears all "verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdate) ClearVerificationRequests() *AccountUpdate {
+	_u.mutation.ClearVerificationRequests()
+	return _u
+}
+
+// RemoveVerificationRequestIDs removes the "verification_requests" edge to VerificationRequest entities by IDs.
+func (_u *AccountUpdate) RemoveVerificationRequestIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveVerificationRequestIDs(ids...)
+	return _u
+}
+
+// RemoveVerificationRequests removes "verification_requests" edges to VerificationRequest entities.
+func (_u *AccountUpdate) RemoveVerificationRequests(v ...*VerificationRequest) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveVerificationRequestIDs(ids...)
+}
+
+// ClearReviewedVerificationRequests clears all "reviewed_verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdate) ClearReviewedVerificationRequests() *AccountUpdate {
+	_u.mutation.ClearReviewedVerificationRequests()
+	return _u
+}
+
+// RemoveReviewedVerificationRequestIDs removes the "reviewed_verification_requests" edge to VerificationRequest entities by IDs.
+func (_u *AccountUpdate) RemoveReviewedVerificationRequestIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveReviewedVerificationRequestIDs(ids...)
+	return _u
+}
+
+// RemoveReviewedVerificationRequests removes "reviewed_verification_requests" edges to VerificationRequest entities.
+func (_u *AccountUpdate) RemoveReviewedVerificationRequests(v ...*VerificationRequest) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveReviewedVerificationRequestIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *AccountUpdate) ClearWebhooks() *AccountUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *AccountUpdate) RemoveWebhookIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *AccountUpdate) RemoveWebhooks(v ...*Webhook) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.InvitedByCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   account.InvitedByTable,
-			Columns: []string{account.InvitedByColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
+func (_u *AccountUpdate) ClearAccountRoles() *AccountUpdate {
+	_u.mutation.ClearAccountRoles()
+	return _u
+}
+
+// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
+func (_u *AccountUpdate) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveAccountRoleIDs(ids...)
+	return _u
+}
+
+// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
+func (_u *AccountUpdate) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   account.InvitedByTable,
-			Columns: []string{account.InvitedByColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u.RemoveAccountRoleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
 	}
-	if _u.mutation.PostsCleared() {
-		edge := &sqlgraph.EdgeSpec{
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := account.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountUpdate) check() error {
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := account.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := account.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := account.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IndexedAt(); ok {
+		_spec.SetField(account.FieldIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IndexedAtCleared() {
+		_spec.ClearField(account.FieldIndexedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(account.FieldHandle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(account.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(account.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(account.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(account.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Admin(); ok {
+		_spec.SetField(account.FieldAdmin, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(account.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(account.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PinnedItems(); ok {
+		_spec.SetField(account.FieldPinnedItems, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPinnedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldPinnedItems, value)
+		})
+	}
+	if _u.mutation.PinnedItemsCleared() {
+		_spec.ClearField(account.FieldPinnedItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(account.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(account.FieldLocale, field.TypeString, value)
+	}
+	if _u.mutation.LocaleCleared() {
+		_spec.ClearField(account.FieldLocale, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(account.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(account.FieldTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadingHistoryEnabled(); ok {
+		_spec.SetField(account.FieldReadingHistoryEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HiddenActivity(); ok {
+		_spec.SetField(account.FieldHiddenActivity, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHiddenActivity(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldHiddenActivity, value)
+		})
+	}
+	if _u.mutation.HiddenActivityCleared() {
+		_spec.ClearField(account.FieldHiddenActivity, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Verification(); ok {
+		_spec.SetField(account.FieldVerification, field.TypeString, value)
+	}
+	if _u.mutation.VerificationCleared() {
+		_spec.ClearField(account.FieldVerification, field.TypeString)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1565,15 +2476,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1581,28 +2492,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.QuestionsCleared() {
+	if _u.mutation.EmailsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+	if nodes := _u.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !_u.mutation.EmailsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1610,15 +2521,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EmailsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1626,28 +2537,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReactsCleared() {
+	if _u.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReactsIDs(); len(nodes) > 0 && !_u.mutation.ReactsCleared() {
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1655,15 +2566,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReactsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1671,28 +2582,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LikesCleared() {
+	if _u.mutation.TriggeredNotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+	if nodes := _u.mutation.RemovedTriggeredNotificationsIDs(); len(nodes) > 0 && !_u.mutation.TriggeredNotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1700,15 +2611,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TriggeredNotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1716,28 +2627,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MentionsCleared() {
+	if _u.mutation.FollowingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1745,15 +2656,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1761,94 +2672,73 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RolesCleared() {
+	if _u.mutation.FollowedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
-			Bidi:    false,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
 			},
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+	if nodes := _u.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !_u.mutation.FollowedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.FollowedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AuthenticationCleared() {
+	if _u.mutation.BlocksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAuthenticationIDs(); len(nodes) > 0 && !_u.mutation.AuthenticationCleared() {
+	if nodes := _u.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !_u.mutation.BlocksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1856,15 +2746,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AuthenticationIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BlocksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1872,28 +2762,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TagsCleared() {
+	if _u.mutation.BlockedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+	if nodes := _u.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !_u.mutation.BlockedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1901,15 +2791,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BlockedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1917,28 +2807,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CollectionsCleared() {
+	if _u.mutation.TagFollowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.TagFollowsTable,
+			Columns: []string{account.TagFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+	if nodes := _u.mutation.RemovedTagFollowsIDs(); len(nodes) > 0 && !_u.mutation.TagFollowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.TagFollowsTable,
+			Columns: []string{account.TagFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1946,15 +2836,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TagFollowsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.TagFollowsTable,
+			Columns: []string{account.TagFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1962,28 +2852,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NodesCleared() {
+	if _u.mutation.CategoryFollowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.CategoryFollowsTable,
+			Columns: []string{account.CategoryFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
+	if nodes := _u.mutation.RemovedCategoryFollowsIDs(); len(nodes) > 0 && !_u.mutation.CategoryFollowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.CategoryFollowsTable,
+			Columns: []string{account.CategoryFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1991,15 +2881,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CategoryFollowsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.CategoryFollowsTable,
+			Columns: []string{account.CategoryFollowsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2007,28 +2897,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetsCleared() {
+	if _u.mutation.ReputationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.ReputationTable,
+			Columns: []string{account.ReputationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
+	if nodes := _u.mutation.RemovedReputationIDs(); len(nodes) > 0 && !_u.mutation.ReputationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.ReputationTable,
+			Columns: []string{account.ReputationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2036,15 +2926,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReputationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.ReputationTable,
+			Columns: []string{account.ReputationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2052,28 +2942,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EventsCleared() {
+	if _u.mutation.BadgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.BadgesTable,
+			Columns: []string{account.BadgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+	if nodes := _u.mutation.RemovedBadgesIDs(); len(nodes) > 0 && !_u.mutation.BadgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.BadgesTable,
+			Columns: []string{account.BadgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2081,15 +2971,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BadgesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.BadgesTable,
+			Columns: []string{account.BadgesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2097,28 +2987,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PostReadsCleared() {
+	if _u.mutation.InvitationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPostReadsIDs(); len(nodes) > 0 && !_u.mutation.PostReadsCleared() {
+	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2126,15 +3016,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PostReadsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2142,44 +3032,57 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsCleared() {
+	if _u.mutation.InvitedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.InvitedByTable,
+			Columns: []string{account.InvitedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
+	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.InvitedByTable,
+			Columns: []string{account.InvitedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.OwnerTable,
+			Columns: []string{account.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.OwnerTable,
+			Columns: []string{account.OwnerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2187,28 +3090,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.HandledReportsCleared() {
+	if _u.mutation.BotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.BotsTable,
+			Columns: []string{account.BotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedHandledReportsIDs(); len(nodes) > 0 && !_u.mutation.HandledReportsCleared() {
+	if nodes := _u.mutation.RemovedBotsIDs(); len(nodes) > 0 && !_u.mutation.BotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.BotsTable,
+			Columns: []string{account.BotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2216,15 +3119,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.HandledReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.BotsTable,
+			Columns: []string{account.BotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2232,28 +3135,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AccountRolesCleared() {
+	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   account.AccountRolesTable,
-			Columns: []string{account.AccountRolesColumn},
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAccountRolesIDs(); len(nodes) > 0 && !_u.mutation.AccountRolesCleared() {
+	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   account.AccountRolesTable,
-			Columns: []string{account.AccountRolesColumn},
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2261,15 +3164,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AccountRolesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   account.AccountRolesTable,
-			Columns: []string{account.AccountRolesColumn},
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2277,1220 +3180,5383 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{account.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
+	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReactsIDs(); len(nodes) > 0 && !_u.mutation.ReactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthenticationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthenticationIDs(); len(nodes) > 0 && !_u.mutation.AuthenticationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthenticationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostReadsIDs(); len(nodes) > 0 && !_u.mutation.PostReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PushSubscriptionsTable,
+			Columns: []string{account.PushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushsubscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPushSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.PushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PushSubscriptionsTable,
+			Columns: []string{account.PushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushsubscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PushSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PushSubscriptionsTable,
+			Columns: []string{account.PushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushsubscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DigestSubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.DigestSubscriptionTable,
+			Columns: []string{account.DigestSubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DigestSubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.DigestSubscriptionTable,
+			Columns: []string{account.DigestSubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FeedTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.FeedTokenTable,
+			Columns: []string{account.FeedTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedtoken.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FeedTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.FeedTokenTable,
+			Columns: []string{account.FeedTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedtoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APIUsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.APIUsageTable,
+			Columns: []string{account.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIUsageIDs(); len(nodes) > 0 && !_u.mutation.APIUsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.APIUsageTable,
+			Columns: []string{account.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIUsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.APIUsageTable,
+			Columns: []string{account.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ContentViewsTable,
+			Columns: []string{account.ContentViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentViewsIDs(); len(nodes) > 0 && !_u.mutation.ContentViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ContentViewsTable,
+			Columns: []string{account.ContentViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ContentViewsTable,
+			Columns: []string{account.ContentViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HandledReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHandledReportsIDs(); len(nodes) > 0 && !_u.mutation.HandledReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandledReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ModerationNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ModerationNotesTable,
+			Columns: []string{account.ModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModerationNotesIDs(); len(nodes) > 0 && !_u.mutation.ModerationNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ModerationNotesTable,
+			Columns: []string{account.ModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ModerationNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ModerationNotesTable,
+			Columns: []string{account.ModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthoredModerationNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthoredModerationNotesTable,
+			Columns: []string{account.AuthoredModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthoredModerationNotesIDs(); len(nodes) > 0 && !_u.mutation.AuthoredModerationNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthoredModerationNotesTable,
+			Columns: []string{account.AuthoredModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthoredModerationNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthoredModerationNotesTable,
+			Columns: []string{account.AuthoredModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WarningsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.WarningsTable,
+			Columns: []string{account.WarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWarningsIDs(); len(nodes) > 0 && !_u.mutation.WarningsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.WarningsTable,
+			Columns: []string{account.WarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WarningsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.WarningsTable,
+			Columns: []string{account.WarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IssuedWarningsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IssuedWarningsTable,
+			Columns: []string{account.IssuedWarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIssuedWarningsIDs(); len(nodes) > 0 && !_u.mutation.IssuedWarningsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IssuedWarningsTable,
+			Columns: []string{account.IssuedWarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IssuedWarningsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IssuedWarningsTable,
+			Columns: []string{account.IssuedWarningsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UploadSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UploadSessionsTable,
+			Columns: []string{account.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUploadSessionsIDs(); len(nodes) > 0 && !_u.mutation.UploadSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UploadSessionsTable,
+			Columns: []string{account.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UploadSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UploadSessionsTable,
+			Columns: []string{account.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConversationsIDs(); len(nodes) > 0 && !_u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DirectMessagesTable,
+			Columns: []string{account.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDirectMessagesIDs(); len(nodes) > 0 && !_u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DirectMessagesTable,
+			Columns: []string{account.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DirectMessagesTable,
+			Columns: []string{account.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SpacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SpacesTable,
+			Columns: []string{account.SpacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSpacesIDs(); len(nodes) > 0 && !_u.mutation.SpacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SpacesTable,
+			Columns: []string{account.SpacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SpacesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SpacesTable,
+			Columns: []string{account.SpacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ApplicationTable,
+			Columns: []string{account.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ApplicationTable,
+			Columns: []string{account.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedApplicationsTable,
+			Columns: []string{account.ReviewedApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewedApplicationsIDs(); len(nodes) > 0 && !_u.mutation.ReviewedApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedApplicationsTable,
+			Columns: []string{account.ReviewedApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedApplicationsTable,
+			Columns: []string{account.ReviewedApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OnboardingStepsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OnboardingStepsTable,
+			Columns: []string{account.OnboardingStepsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOnboardingStepsIDs(); len(nodes) > 0 && !_u.mutation.OnboardingStepsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OnboardingStepsTable,
+			Columns: []string{account.OnboardingStepsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OnboardingStepsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OnboardingStepsTable,
+			Columns: []string{account.OnboardingStepsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PolicyAcceptancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PolicyAcceptancesTable,
+			Columns: []string{account.PolicyAcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPolicyAcceptancesIDs(); len(nodes) > 0 && !_u.mutation.PolicyAcceptancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PolicyAcceptancesTable,
+			Columns: []string{account.PolicyAcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PolicyAcceptancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PolicyAcceptancesTable,
+			Columns: []string{account.PolicyAcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BookmarksTable,
+			Columns: []string{account.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !_u.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BookmarksTable,
+			Columns: []string{account.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BookmarksTable,
+			Columns: []string{account.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReadingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReadingHistoryTable,
+			Columns: []string{account.ReadingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadingHistoryIDs(); len(nodes) > 0 && !_u.mutation.ReadingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReadingHistoryTable,
+			Columns: []string{account.ReadingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadingHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReadingHistoryTable,
+			Columns: []string{account.ReadingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VerificationRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.VerificationRequestsTable,
+			Columns: []string{account.VerificationRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVerificationRequestsIDs(); len(nodes) > 0 && !_u.mutation.VerificationRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.VerificationRequestsTable,
+			Columns: []string{account.VerificationRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.VerificationRequestsTable,
+			Columns: []string{account.VerificationRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedVerificationRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedVerificationRequestsTable,
+			Columns: []string{account.ReviewedVerificationRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewedVerificationRequestsIDs(); len(nodes) > 0 && !_u.mutation.ReviewedVerificationRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedVerificationRequestsTable,
+			Columns: []string{account.ReviewedVerificationRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedVerificationRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedVerificationRequestsTable,
+			Columns: []string{account.ReviewedVerificationRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.WebhooksTable,
+			Columns: []string{account.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.WebhooksTable,
+			Columns: []string{account.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.WebhooksTable,
+			Columns: []string{account.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AccountRolesTable,
+			Columns: []string{account.AccountRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccountRolesIDs(); len(nodes) > 0 && !_u.mutation.AccountRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AccountRolesTable,
+			Columns: []string{account.AccountRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AccountRolesTable,
+			Columns: []string{account.AccountRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
 	return _node, nil
 }
 
-// AccountUpdateOne is the builder for updating a single Account entity.
-type AccountUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *AccountMutation
-	modifiers []func(*sql.UpdateBuilder)
+// AccountUpdateOne is the builder for updating a single Account entity.
+type AccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountUpdateOne) SetUpdatedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AccountUpdateOne) SetDeletedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableDeletedAt(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (_u *AccountUpdateOne) SetIndexedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetIndexedAt(v)
+	return _u
+}
+
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableIndexedAt(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetIndexedAt(*v)
+	}
+	return _u
+}
+
+// ClearIndexedAt clears the value of the "indexed_at" field.
+func (_u *AccountUpdateOne) ClearIndexedAt() *AccountUpdateOne {
+	_u.mutation.ClearIndexedAt()
+	return _u
+}
+
+// SetHandle sets the "handle" field.
+func (_u *AccountUpdateOne) SetHandle(v string) *AccountUpdateOne {
+	_u.mutation.SetHandle(v)
+	return _u
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableHandle(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetHandle(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AccountUpdateOne) SetName(v string) *AccountUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableName(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *AccountUpdateOne) SetBio(v string) *AccountUpdateOne {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableBio(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *AccountUpdateOne) ClearBio() *AccountUpdateOne {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AccountUpdateOne) SetKind(v account.Kind) *AccountUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableKind(v *account.Kind) *AccountUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAdmin sets the "admin" field.
+func (_u *AccountUpdateOne) SetAdmin(v bool) *AccountUpdateOne {
+	_u.mutation.SetAdmin(v)
+	return _u
+}
+
+// SetNillableAdmin sets the "admin" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableAdmin(v *bool) *AccountUpdateOne {
+	if v != nil {
+		_u.SetAdmin(*v)
+	}
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *AccountUpdateOne) SetLinks(v []schema.ExternalLink) *AccountUpdateOne {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *AccountUpdateOne) AppendLinks(v []schema.ExternalLink) *AccountUpdateOne {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (_u *AccountUpdateOne) ClearLinks() *AccountUpdateOne {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// SetPinnedItems sets the "pinned_items" field.
+func (_u *AccountUpdateOne) SetPinnedItems(v []schema.PinnedItem) *AccountUpdateOne {
+	_u.mutation.SetPinnedItems(v)
+	return _u
+}
+
+// AppendPinnedItems appends value to the "pinned_items" field.
+func (_u *AccountUpdateOne) AppendPinnedItems(v []schema.PinnedItem) *AccountUpdateOne {
+	_u.mutation.AppendPinnedItems(v)
+	return _u
+}
+
+// ClearPinnedItems clears the value of the "pinned_items" field.
+func (_u *AccountUpdateOne) ClearPinnedItems() *AccountUpdateOne {
+	_u.mutation.ClearPinnedItems()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *AccountUpdateOne) SetMetadata(v map[string]interface{}) *AccountUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *AccountUpdateOne) ClearMetadata() *AccountUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *AccountUpdateOne) SetLocale(v string) *AccountUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableLocale(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (_u *AccountUpdateOne) ClearLocale() *AccountUpdateOne {
+	_u.mutation.ClearLocale()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *AccountUpdateOne) SetTimezone(v string) *AccountUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableTimezone(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *AccountUpdateOne) ClearTimezone() *AccountUpdateOne {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
+// SetReadingHistoryEnabled sets the "reading_history_enabled" field.
+func (_u *AccountUpdateOne) SetReadingHistoryEnabled(v bool) *AccountUpdateOne {
+	_u.mutation.SetReadingHistoryEnabled(v)
+	return _u
+}
+
+// SetNillableReadingHistoryEnabled sets the "reading_history_enabled" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableReadingHistoryEnabled(v *bool) *AccountUpdateOne {
+	if v != nil {
+		_u.SetReadingHistoryEnabled(*v)
+	}
+	return _u
+}
+
+// SetHiddenActivity sets the "hidden_activity" field.
+func (_u *AccountUpdateOne) SetHiddenActivity(v []string) *AccountUpdateOne {
+	_u.mutation.SetHiddenActivity(v)
+	return _u
+}
+
+// AppendHiddenActivity appends value to the "hidden_activity" field.
+func (_u *AccountUpdateOne) AppendHiddenActivity(v []string) *AccountUpdateOne {
+	_u.mutation.AppendHiddenActivity(v)
+	return _u
+}
+
+// ClearHiddenActivity clears the value of the "hidden_activity" field.
+func (_u *AccountUpdateOne) ClearHiddenActivity() *AccountUpdateOne {
+	_u.mutation.ClearHiddenActivity()
+	return _u
+}
+
+// SetVerification sets the "verification" field.
+func (_u *AccountUpdateOne) SetVerification(v string) *AccountUpdateOne {
+	_u.mutation.SetVerification(v)
+	return _u
+}
+
+// SetNillableVerification sets the "verification" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableVerification(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetVerification(*v)
+	}
+	return _u
+}
+
+// ClearVerification clears the value of the "verification" field.
+func (_u *AccountUpdateOne) ClearVerification() *AccountUpdateOne {
+	_u.mutation.ClearVerification()
+	return _u
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (_u *AccountUpdateOne) SetInvitedByID(v xid.ID) *AccountUpdateOne {
+	_u.mutation.SetInvitedByID(v)
+	return _u
+}
+
+// SetNillableInvitedByID sets the "invited_by_id" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableInvitedByID(v *xid.ID) *AccountUpdateOne {
+	if v != nil {
+		_u.SetInvitedByID(*v)
+	}
+	return _u
+}
+
+// ClearInvitedByID clears the value of the "invited_by_id" field.
+func (_u *AccountUpdateOne) ClearInvitedByID() *AccountUpdateOne {
+	_u.mutation.ClearInvitedByID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *AccountUpdateOne) SetOwnerID(v xid.ID) *AccountUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableOwnerID(v *xid.ID) *AccountUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *AccountUpdateOne) ClearOwnerID() *AccountUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *AccountUpdateOne) AddSessionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *AccountUpdateOne) AddSessions(v ...*Session) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddEmailIDs adds the "emails" edge to the Email entity by IDs.
+func (_u *AccountUpdateOne) AddEmailIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddEmailIDs(ids...)
+	return _u
+}
+
+// AddEmails adds the "emails" edges to the Email entity.
+func (_u *AccountUpdateOne) AddEmails(v ...*Email) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmailIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *AccountUpdateOne) AddNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *AccountUpdateOne) AddNotifications(v ...*Notification) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddTriggeredNotificationIDs adds the "triggered_notifications" edge to the Notification entity by IDs.
+func (_u *AccountUpdateOne) AddTriggeredNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddTriggeredNotificationIDs(ids...)
+	return _u
+}
+
+// AddTriggeredNotifications adds the "triggered_notifications" edges to the Notification entity.
+func (_u *AccountUpdateOne) AddTriggeredNotifications(v ...*Notification) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTriggeredNotificationIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the AccountFollow entity by IDs.
+func (_u *AccountUpdateOne) AddFollowingIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddFollowingIDs(ids...)
+	return _u
+}
+
+// AddFollowing adds the "following" edges to the AccountFollow entity.
+func (_u *AccountUpdateOne) AddFollowing(v ...*AccountFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowingIDs(ids...)
+}
+
+// AddFollowedByIDs adds the "followed_by" edge to the AccountFollow entity by IDs.
+func (_u *AccountUpdateOne) AddFollowedByIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddFollowedByIDs(ids...)
+	return _u
+}
+
+// AddFollowedBy adds the "followed_by" edges to the AccountFollow entity.
+func (_u *AccountUpdateOne) AddFollowedBy(v ...*AccountFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowedByIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdateOne) AddBlockIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddBlockIDs(ids...)
+	return _u
+}
+
+// AddBlocks adds the "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) AddBlocks(v ...*AccountBlock) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdateOne) AddBlockedByIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddBlockedByIDs(ids...)
+	return _u
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) AddBlockedBy(v ...*AccountBlock) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedByIDs(ids...)
+}
+
+// AddTagFollowIDs adds the "tag_follows" edge to the TagFollow entity by IDs.
+func (_u *AccountUpdateOne) AddTagFollowIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddTagFollowIDs(ids...)
+	return _u
+}
+
+// AddTagFollows adds the "tag_follows" edges to the TagFollow entity.
+func (_u *AccountUpdateOne) AddTagFollows(v ...*TagFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagFollowIDs(ids...)
+}
+
+// AddCategoryFollowIDs adds the "category_follows" edge to the CategoryFollow entity by IDs.
+func (_u *AccountUpdateOne) AddCategoryFollowIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddCategoryFollowIDs(ids...)
+	return _u
+}
+
+// AddCategoryFollows adds the "category_follows" edges to the CategoryFollow entity.
+func (_u *AccountUpdateOne) AddCategoryFollows(v ...*CategoryFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCategoryFollowIDs(ids...)
+}
+
+// AddReputationIDs adds the "reputation" edge to the ReputationEntry entity by IDs.
+func (_u *AccountUpdateOne) AddReputationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddReputationIDs(ids...)
+	return _u
+}
+
+// AddReputation adds the "reputation" edges to the ReputationEntry entity.
+func (_u *AccountUpdateOne) AddReputation(v ...*ReputationEntry) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReputationIDs(ids...)
+}
+
+// AddBadgeIDs adds the "badges" edge to the AccountBadge entity by IDs.
+func (_u *AccountUpdateOne) AddBadgeIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddBadgeIDs(ids...)
+	return _u
+}
+
+// AddBadges adds the "badges" edges to the AccountBadge entity.
+func (_u *AccountUpdateOne) AddBadges(v ...*AccountBadge) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBadgeIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (_u *AccountUpdateOne) AddInvitationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddInvitationIDs(ids...)
+	return _u
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (_u *AccountUpdateOne) AddInvitations(v ...*Invitation) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvitationIDs(ids...)
+}
+
+// SetInvitedBy sets the "invited_by" edge to the Invitation entity.
+func (_u *AccountUpdateOne) SetInvitedBy(v *Invitation) *AccountUpdateOne {
+	return _u.SetInvitedByID(v.ID)
+}
+
+// SetOwner sets the "owner" edge to the Account entity.
+func (_u *AccountUpdateOne) SetOwner(v *Account) *AccountUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// AddBotIDs adds the "bots" edge to the Account entity by IDs.
+func (_u *AccountUpdateOne) AddBotIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddBotIDs(ids...)
+	return _u
+}
+
+// AddBots adds the "bots" edges to the Account entity.
+func (_u *AccountUpdateOne) AddBots(v ...*Account) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBotIDs(ids...)
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by IDs.
+func (_u *AccountUpdateOne) AddPostIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPostIDs(ids...)
+	return _u
+}
+
+// AddPosts adds the "posts" edges to the Post entity.
+func (_u *AccountUpdateOne) AddPosts(v ...*Post) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostIDs(ids...)
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by IDs.
+func (_u *AccountUpdateOne) AddQuestionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddQuestionIDs(ids...)
+	return _u
+}
+
+// AddQuestions adds the "questions" edges to the Question entity.
+func (_u *AccountUpdateOne) AddQuestions(v ...*Question) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionIDs(ids...)
+}
+
+// AddReactIDs adds the "reacts" edge to the React entity by IDs.
+func (_u *AccountUpdateOne) AddReactIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddReactIDs(ids...)
+	return _u
+}
+
+// AddReacts adds the "reacts" edges to the React entity.
+func (_u *AccountUpdateOne) AddReacts(v ...*React) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReactIDs(ids...)
+}
+
+// AddLikeIDs adds the "likes" edge to the LikePost entity by IDs.
+func (_u *AccountUpdateOne) AddLikeIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddLikeIDs(ids...)
+	return _u
+}
+
+// AddLikes adds the "likes" edges to the LikePost entity.
+func (_u *AccountUpdateOne) AddLikes(v ...*LikePost) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLikeIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MentionProfile entity by IDs.
+func (_u *AccountUpdateOne) AddMentionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddMentionIDs(ids...)
+	return _u
+}
+
+// AddMentions adds the "mentions" edges to the MentionProfile entity.
+func (_u *AccountUpdateOne) AddMentions(v ...*MentionProfile) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *AccountUpdateOne) AddRoleIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *AccountUpdateOne) AddRoles(v ...*Role) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
+// AddAuthenticationIDs adds the "authentication" edge to the Authentication entity by IDs.
+func (_u *AccountUpdateOne) AddAuthenticationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddAuthenticationIDs(ids...)
+	return _u
+}
+
+// AddAuthentication adds the "authentication" edges to the Authentication entity.
+func (_u *AccountUpdateOne) AddAuthentication(v ...*Authentication) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthenticationIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *AccountUpdateOne) AddTagIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *AccountUpdateOne) AddTags(v ...*Tag) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_u *AccountUpdateOne) AddCollectionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddCollectionIDs(ids...)
+	return _u
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *AccountUpdateOne) AddCollections(v ...*Collection) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
+// AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
+func (_u *AccountUpdateOne) AddNodeIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddNodeIDs(ids...)
+	return _u
+}
+
+// AddNodes adds the "nodes" edges to the Node entity.
+func (_u *AccountUpdateOne) AddNodes(v ...*Node) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNodeIDs(ids...)
+}
+
+// AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
+func (_u *AccountUpdateOne) AddAssetIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddAssetIDs(ids...)
+	return _u
+}
+
+// AddAssets adds the "assets" edges to the Asset entity.
+func (_u *AccountUpdateOne) AddAssets(v ...*Asset) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssetIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the EventParticipant entity by IDs.
+func (_u *AccountUpdateOne) AddEventIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the EventParticipant entity.
+func (_u *AccountUpdateOne) AddEvents(v ...*EventParticipant) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddPostReadIDs adds the "post_reads" edge to the PostRead entity by IDs.
+func (_u *AccountUpdateOne) AddPostReadIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPostReadIDs(ids...)
+	return _u
+}
+
+// AddPostReads adds the "post_reads" edges to the PostRead entity.
+func (_u *AccountUpdateOne) AddPostReads(v ...*PostRead) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostReadIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *AccountUpdateOne) AddNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdateOne) AddNotificationPreferences(v ...*NotificationPreference) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
+// AddPushSubscriptionIDs adds the "push_subscriptions" edge to the PushSubscription entity by IDs.
+func (_u *AccountUpdateOne) AddPushSubscriptionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPushSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddPushSubscriptions adds the "push_subscriptions" edges to the PushSubscription entity.
+func (_u *AccountUpdateOne) AddPushSubscriptions(v ...*PushSubscription) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPushSubscriptionIDs(ids...)
+}
+
+// SetDigestSubscriptionID sets the "digest_subscription" edge to the DigestSubscription entity by ID.
+func (_u *AccountUpdateOne) SetDigestSubscriptionID(id xid.ID) *AccountUpdateOne {
+	_u.mutation.SetDigestSubscriptionID(id)
+	return _u
+}
+
+// SetNillableDigestSubscriptionID sets the "digest_subscription" edge to the DigestSubscription entity by ID if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableDigestSubscriptionID(id *xid.ID) *AccountUpdateOne {
+	if id != nil {
+		_u = _u.SetDigestSubscriptionID(*id)
+	}
+	return _u
+}
+
+// SetDigestSubscription sets the "digest_subscription" edge to the DigestSubscription entity.
+func (_u *AccountUpdateOne) SetDigestSubscription(v *DigestSubscription) *AccountUpdateOne {
+	return _u.SetDigestSubscriptionID(v.ID)
+}
+
+// SetFeedTokenID sets the "feed_token" edge to the FeedToken entity by ID.
+func (_u *AccountUpdateOne) SetFeedTokenID(id xid.ID) *AccountUpdateOne {
+	_u.mutation.SetFeedTokenID(id)
+	return _u
+}
+
+// SetNillableFeedTokenID sets the "feed_token" edge to the FeedToken entity by ID if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableFeedTokenID(id *xid.ID) *AccountUpdateOne {
+	if id != nil {
+		_u = _u.SetFeedTokenID(*id)
+	}
+	return _u
+}
+
+// SetFeedToken sets the "feed_token" edge to the FeedToken entity.
+func (_u *AccountUpdateOne) SetFeedToken(v *FeedToken) *AccountUpdateOne {
+	return _u.SetFeedTokenID(v.ID)
+}
+
+// AddAPIUsageIDs adds the "api_usage" edge to the APIUsage entity by IDs.
+func (_u *AccountUpdateOne) AddAPIUsageIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddAPIUsageIDs(ids...)
+	return _u
+}
+
+// AddAPIUsage adds the "api_usage" edges to the APIUsage entity.
+func (_u *AccountUpdateOne) AddAPIUsage(v ...*APIUsage) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIUsageIDs(ids...)
+}
+
+// AddContentViewIDs adds the "content_views" edge to the ContentView entity by IDs.
+func (_u *AccountUpdateOne) AddContentViewIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddContentViewIDs(ids...)
+	return _u
+}
+
+// AddContentViews adds the "content_views" edges to the ContentView entity.
+func (_u *AccountUpdateOne) AddContentViews(v ...*ContentView) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentViewIDs(ids...)
+}
+
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (_u *AccountUpdateOne) AddReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddReportIDs(ids...)
+	return _u
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (_u *AccountUpdateOne) AddReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReportIDs(ids...)
+}
+
+// AddHandledReportIDs adds the "handled_reports" edge to the Report entity by IDs.
+func (_u *AccountUpdateOne) AddHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddHandledReportIDs(ids...)
+	return _u
+}
+
+// AddHandledReports adds the "handled_reports" edges to the Report entity.
+func (_u *AccountUpdateOne) AddHandledReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHandledReportIDs(ids...)
+}
+
+// AddModerationNoteIDs adds the "moderation_notes" edge to the ModerationNote entity by IDs.
+func (_u *AccountUpdateOne) AddModerationNoteIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddModerationNoteIDs(ids...)
+	return _u
+}
+
+// AddModerationNotes adds the "moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdateOne) AddModerationNotes(v ...*ModerationNote) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModerationNoteIDs(ids...)
+}
+
+// AddAuthoredModerationNoteIDs adds the "authored_moderation_notes" edge to the ModerationNote entity by IDs.
+func (_u *AccountUpdateOne) AddAuthoredModerationNoteIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddAuthoredModerationNoteIDs(ids...)
+	return _u
+}
+
+// AddAuthoredModerationNotes adds the "authored_moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdateOne) AddAuthoredModerationNotes(v ...*ModerationNote) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthoredModerationNoteIDs(ids...)
+}
+
+// AddWarningIDs adds the "warnings" edge to the Warning entity by IDs.
+func (_u *AccountUpdateOne) AddWarningIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddWarningIDs(ids...)
+	return _u
+}
+
+// AddWarnings adds the "warnings" edges to the Warning entity.
+func (_u *AccountUpdateOne) AddWarnings(v ...*Warning) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWarningIDs(ids...)
+}
+
+// AddIssuedWarningIDs adds the "issued_warnings" edge to the Warning entity by IDs.
+func (_u *AccountUpdateOne) AddIssuedWarningIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddIssuedWarningIDs(ids...)
+	return _u
+}
+
+// AddIssuedWarnings adds the "issued_warnings" edges to the Warning entity.
+func (_u *AccountUpdateOne) AddIssuedWarnings(v ...*Warning) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIssuedWarningIDs(ids...)
+}
+
+// AddUploadSessionIDs adds the "upload_sessions" edge to the UploadSession entity by IDs.
+func (_u *AccountUpdateOne) AddUploadSessionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddUploadSessionIDs(ids...)
+	return _u
+}
+
+// AddUploadSessions adds the "upload_sessions" edges to the UploadSession entity.
+func (_u *AccountUpdateOne) AddUploadSessions(v ...*UploadSession) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadSessionIDs(ids...)
+}
+
+// AddConversationIDs adds the "conversations" edge to the ConversationParticipant entity by IDs.
+func (_u *AccountUpdateOne) AddConversationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddConversationIDs(ids...)
+	return _u
+}
+
+// AddConversations adds the "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdateOne) AddConversations(v ...*ConversationParticipant) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConversationIDs(ids...)
+}
+
+// AddDirectMessageIDs adds the "direct_messages" edge to the DirectMessage entity by IDs.
+func (_u *AccountUpdateOne) AddDirectMessageIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddDirectMessageIDs(ids...)
+	return _u
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DirectMessage entity.
+func (_u *AccountUpdateOne) AddDirectMessages(v ...*DirectMessage) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDirectMessageIDs(ids...)
+}
+
+// AddSpaceIDs adds the "spaces" edge to the SpaceMember entity by IDs.
+func (_u *AccountUpdateOne) AddSpaceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddSpaceIDs(ids...)
+	return _u
+}
+
+// AddSpaces adds the "spaces" edges to the SpaceMember entity.
+func (_u *AccountUpdateOne) AddSpaces(v ...*SpaceMember) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSpaceIDs(ids...)
+}
+
+// SetApplicationID sets the "application" edge to the AccountApplication entity by ID.
+func (_u *AccountUpdateOne) SetApplicationID(id xid.ID) *AccountUpdateOne {
+	_u.mutation.SetApplicationID(id)
+	return _u
+}
+
+// SetNillableApplicationID sets the "application" edge to the AccountApplication entity by ID if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableApplicationID(id *xid.ID) *AccountUpdateOne {
+	if id != nil {
+		_u = _u.SetApplicationID(*id)
+	}
+	return _u
+}
+
+// SetApplication sets the "application" edge to the AccountApplication entity.
+func (_u *AccountUpdateOne) SetApplication(v *AccountApplication) *AccountUpdateOne {
+	return _u.SetApplicationID(v.ID)
+}
+
+// AddReviewedApplicationIDs adds the "reviewed_applications" edge to the AccountApplication entity by IDs.
+func (_u *AccountUpdateOne) AddReviewedApplicationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddReviewedApplicationIDs(ids...)
+	return _u
+}
+
+// AddReviewedApplications adds the "reviewed_applications" edges to the AccountApplication entity.
+func (_u *AccountUpdateOne) AddReviewedApplications(v ...*AccountApplication) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewedApplicationIDs(ids...)
+}
+
+// AddOnboardingStepIDs adds the "onboarding_steps" edge to the AccountOnboardingStep entity by IDs.
+func (_u *AccountUpdateOne) AddOnboardingStepIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddOnboardingStepIDs(ids...)
+	return _u
+}
+
+// AddOnboardingSteps adds the "onboarding_steps" edges to the AccountOnboardingStep entity.
+func (_u *AccountUpdateOne) AddOnboardingSteps(v ...*AccountOnboardingStep) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOnboardingStepIDs(ids...)
+}
+
+// AddPolicyAcceptanceIDs adds the "policy_acceptances" edge to the PolicyAcceptance entity by IDs.
+func (_u *AccountUpdateOne) AddPolicyAcceptanceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPolicyAcceptanceIDs(ids...)
+	return _u
+}
+
+// AddPolicyAcceptances adds the "policy_acceptances" edges to the PolicyAcceptance entity.
+func (_u *AccountUpdateOne) AddPolicyAcceptances(v ...*PolicyAcceptance) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPolicyAcceptanceIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (_u *AccountUpdateOne) AddBookmarkIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddBookmarkIDs(ids...)
+	return _u
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (_u *AccountUpdateOne) AddBookmarks(v ...*Bookmark) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookmarkIDs(ids...)
+}
+
+// AddReadingHistoryIDs adds the "reading_history" edge to the ReadingHistory entity by IDs.
+func (_u *AccountUpdateOne) AddReadingHistoryIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddReadingHistoryIDs(ids...)
+	return _u
+}
+
+// AddReadingHistory adds the "reading_history" edges to the ReadingHistory entity.
+func (_u *AccountUpdateOne) AddReadingHistory(v ...*ReadingHistory) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingHistoryIDs(ids...)
+}
+
+// AddVerificationRequestIDs adds the "verification_requests" edge to the VerificationRequest entity by IDs.
+func (_u *AccountUpdateOne) AddVerificationRequestIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddVerificationRequestIDs(ids...)
+	return _u
+}
+
+// AddVerificationRequests adds the "verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdateOne) AddVerificationRequests(v ...*VerificationRequest) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationRequestIDs(ids...)
+}
+
+// AddReviewedVerificationRequestIDs adds the "reviewed_verification_requests" edge to the VerificationRequest entity by IDs.
+func (_u *AccountUpdateOne) AddReviewedVerificationRequestIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddReviewedVerificationRequestIDs(ids...)
+	return _u
+}
+
+// AddReviewedVerificationRequests adds the "reviewed_verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdateOne) AddReviewedVerificationRequests(v ...*VerificationRequest) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewedVerificationRequestIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *AccountUpdateOne) AddWebhookIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *AccountUpdateOne) AddWebhooks(v ...*Webhook) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
+func (_u *AccountUpdateOne) AddAccountRoleIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddAccountRoleIDs(ids...)
+	return _u
+}
+
+// AddAccountRoles adds the "account_roles" edges to the AccountRoles entity.
+func (_u *AccountUpdateOne) AddAccountRoles(v ...*AccountRoles) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccountRoleIDs(ids...)
+}
+
+// Mutation returns the AccountMutation object of the builder.
+func (_u *AccountUpdateOne) Mutation() *AccountMutation {
+	return _u.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *AccountUpdateOne) ClearSessions() *AccountUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *AccountUpdateOne) RemoveSessionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *AccountUpdateOne) RemoveSessions(v ...*Session) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearEmails clears all "emails" edges to the Email entity.
+func (_u *AccountUpdateOne) ClearEmails() *AccountUpdateOne {
+	_u.mutation.ClearEmails()
+	return _u
+}
+
+// RemoveEmailIDs removes the "emails" edge to Email entities by IDs.
+func (_u *AccountUpdateOne) RemoveEmailIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveEmailIDs(ids...)
+	return _u
+}
+
+// RemoveEmails removes "emails" edges to Email entities.
+func (_u *AccountUpdateOne) RemoveEmails(v ...*Email) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *AccountUpdateOne) ClearNotifications() *AccountUpdateOne {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *AccountUpdateOne) RemoveNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *AccountUpdateOne) RemoveNotifications(v ...*Notification) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearTriggeredNotifications clears all "triggered_notifications" edges to the Notification entity.
+func (_u *AccountUpdateOne) ClearTriggeredNotifications() *AccountUpdateOne {
+	_u.mutation.ClearTriggeredNotifications()
+	return _u
+}
+
+// RemoveTriggeredNotificationIDs removes the "triggered_notifications" edge to Notification entities by IDs.
+func (_u *AccountUpdateOne) RemoveTriggeredNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveTriggeredNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveTriggeredNotifications removes "triggered_notifications" edges to Notification entities.
+func (_u *AccountUpdateOne) RemoveTriggeredNotifications(v ...*Notification) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTriggeredNotificationIDs(ids...)
+}
+
+// ClearFollowing clears all "following" edges to the AccountFollow entity.
+func (_u *AccountUpdateOne) ClearFollowing() *AccountUpdateOne {
+	_u.mutation.ClearFollowing()
+	return _u
+}
+
+// RemoveFollowingIDs removes the "following" edge to AccountFollow entities by IDs.
+func (_u *AccountUpdateOne) RemoveFollowingIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveFollowingIDs(ids...)
+	return _u
+}
+
+// RemoveFollowing removes "following" edges to AccountFollow entities.
+func (_u *AccountUpdateOne) RemoveFollowing(v ...*AccountFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowingIDs(ids...)
+}
+
+// ClearFollowedBy clears all "followed_by" edges to the AccountFollow entity.
+func (_u *AccountUpdateOne) ClearFollowedBy() *AccountUpdateOne {
+	_u.mutation.ClearFollowedBy()
+	return _u
+}
+
+// RemoveFollowedByIDs removes the "followed_by" edge to AccountFollow entities by IDs.
+func (_u *AccountUpdateOne) RemoveFollowedByIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveFollowedByIDs(ids...)
+	return _u
+}
+
+// RemoveFollowedBy removes "followed_by" edges to AccountFollow entities.
+func (_u *AccountUpdateOne) RemoveFollowedBy(v ...*AccountFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowedByIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) ClearBlocks() *AccountUpdateOne {
+	_u.mutation.ClearBlocks()
+	return _u
+}
+
+// RemoveBlockIDs removes the "blocks" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdateOne) RemoveBlockIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveBlockIDs(ids...)
+	return _u
+}
+
+// RemoveBlocks removes "blocks" edges to AccountBlock entities.
+func (_u *AccountUpdateOne) RemoveBlocks(v ...*AccountBlock) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) ClearBlockedBy() *AccountUpdateOne {
+	_u.mutation.ClearBlockedBy()
+	return _u
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdateOne) RemoveBlockedByIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveBlockedByIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to AccountBlock entities.
+func (_u *AccountUpdateOne) RemoveBlockedBy(v ...*AccountBlock) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByIDs(ids...)
+}
+
+// ClearTagFollows clears all "tag_follows" edges to the TagFollow entity.
+func (_u *AccountUpdateOne) ClearTagFollows() *AccountUpdateOne {
+	_u.mutation.ClearTagFollows()
+	return _u
+}
+
+// RemoveTagFollowIDs removes the "tag_follows" edge to TagFollow entities by IDs.
+func (_u *AccountUpdateOne) RemoveTagFollowIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveTagFollowIDs(ids...)
+	return _u
+}
+
+// RemoveTagFollows removes "tag_follows" edges to TagFollow entities.
+func (_u *AccountUpdateOne) RemoveTagFollows(v ...*TagFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagFollowIDs(ids...)
+}
+
+// ClearCategoryFollows clears all "category_follows" edges to the CategoryFollow entity.
+func (_u *AccountUpdateOne) ClearCategoryFollows() *AccountUpdateOne {
+	_u.mutation.ClearCategoryFollows()
+	return _u
+}
+
+// RemoveCategoryFollowIDs removes the "category_follows" edge to CategoryFollow entities by IDs.
+func (_u *AccountUpdateOne) RemoveCategoryFollowIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveCategoryFollowIDs(ids...)
+	return _u
+}
+
+// RemoveCategoryFollows removes "category_follows" edges to CategoryFollow entities.
+func (_u *AccountUpdateOne) RemoveCategoryFollows(v ...*CategoryFollow) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCategoryFollowIDs(ids...)
+}
+
+// ClearReputation clears all "reputation" edges to the ReputationEntry entity.
+func (_u *AccountUpdateOne) ClearReputation() *AccountUpdateOne {
+	_u.mutation.ClearReputation()
+	return _u
+}
+
+// RemoveReputationIDs removes the "reputation" edge to ReputationEntry entities by IDs.
+func (_u *AccountUpdateOne) RemoveReputationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveReputationIDs(ids...)
+	return _u
+}
+
+// RemoveReputation removes "reputation" edges to ReputationEntry entities.
+func (_u *AccountUpdateOne) RemoveReputation(v ...*ReputationEntry) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReputationIDs(ids...)
+}
+
+// ClearBadges clears all "badges" edges to the AccountBadge entity.
+func (_u *AccountUpdateOne) ClearBadges() *AccountUpdateOne {
+	_u.mutation.ClearBadges()
+	return _u
+}
+
+// RemoveBadgeIDs removes the "badges" edge to AccountBadge entities by IDs.
+func (_u *AccountUpdateOne) RemoveBadgeIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveBadgeIDs(ids...)
+	return _u
+}
+
+// RemoveBadges removes "badges" edges to AccountBadge entities.
+func (_u *AccountUpdateOne) RemoveBadges(v ...*AccountBadge) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBadgeIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (_u *AccountUpdateOne) ClearInvitations() *AccountUpdateOne {
+	_u.mutation.ClearInvitations()
+	return _u
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (_u *AccountUpdateOne) RemoveInvitationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (_u *AccountUpdateOne) RemoveInvitations(v ...*Invitation) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvitationIDs(ids...)
+}
+
+// ClearInvitedBy clears the "invited_by" edge to the Invitation entity.
+func (_u *AccountUpdateOne) ClearInvitedBy() *AccountUpdateOne {
+	_u.mutation.ClearInvitedBy()
+	return _u
+}
+
+// ClearOwner clears the "owner" edge to the Account entity.
+func (_u *AccountUpdateOne) ClearOwner() *AccountUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBots clears all "bots" edges to the Account entity.
+func (_u *AccountUpdateOne) ClearBots() *AccountUpdateOne {
+	_u.mutation.ClearBots()
+	return _u
+}
+
+// RemoveBotIDs removes the "bots" edge to Account entities by IDs.
+func (_u *AccountUpdateOne) RemoveBotIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveBotIDs(ids...)
+	return _u
+}
+
+// RemoveBots removes "bots" edges to Account entities.
+func (_u *AccountUpdateOne) RemoveBots(v ...*Account) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBotIDs(ids...)
+}
+
+// ClearPosts clears all "posts" edges to the Post entity.
+func (_u *AccountUpdateOne) ClearPosts() *AccountUpdateOne {
+	_u.mutation.ClearPosts()
+	return _u
+}
+
+// RemovePostIDs removes the "posts" edge to Post entities by IDs.
+func (_u *AccountUpdateOne) RemovePostIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemovePostIDs(ids...)
+	return _u
+}
+
+// RemovePosts removes "posts" edges to Post entities.
+func (_u *AccountUpdateOne) RemovePosts(v ...*Post) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostIDs(ids...)
+}
+
+// ClearQuestions clears all "questions" edges to the Question entity.
+func (_u *AccountUpdateOne) ClearQuestions() *AccountUpdateOne {
+	_u.mutation.ClearQuestions()
+	return _u
+}
+
+// RemoveQuestionIDs removes the "questions" edge to Question entities by IDs.
+func (_u *AccountUpdateOne) RemoveQuestionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveQuestionIDs(ids...)
+	return _u
+}
+
+// RemoveQuestions removes "questions" edges to Question entities.
+func (_u *AccountUpdateOne) RemoveQuestions(v ...*Question) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionIDs(ids...)
+}
+
+// ClearReacts clears all "reacts" edges to the React entity.
+func (_u *AccountUpdateOne) ClearReacts() *AccountUpdateOne {
+	_u.mutation.ClearReacts()
+	return _u
+}
+
+// RemoveReactIDs removes the "reacts" edge to React entities by IDs.
+func (_u *AccountUpdateOne) RemoveReactIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveReactIDs(ids...)
+	return _u
+}
+
+// RemoveReacts removes "reacts" edges to React entities.
+func (_u *AccountUpdateOne) RemoveReacts(v ...*React) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReactIDs(ids...)
+}
+
+// ClearLikes clears all "likes" edges to the LikePost entity.
+func (_u *AccountUpdateOne) ClearLikes() *AccountUpdateOne {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// RemoveLikeIDs removes the "likes" edge to LikePost entities by IDs.
+func (_u *AccountUpdateOne) RemoveLikeIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveLikeIDs(ids...)
+	return _u
+}
+
+// RemoveLikes removes "likes" edges to LikePost entities.
+func (_u *AccountUpdateOne) RemoveLikes(v ...*LikePost) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLikeIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MentionProfile entity.
+func (_u *AccountUpdateOne) ClearMentions() *AccountUpdateOne {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MentionProfile entities by IDs.
+func (_u *AccountUpdateOne) RemoveMentionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveMentionIDs(ids...)
+	return _u
+}
+
+// RemoveMentions removes "mentions" edges to MentionProfile entities.
+func (_u *AccountUpdateOne) RemoveMentions(v ...*MentionProfile) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *AccountUpdateOne) ClearRoles() *AccountUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *AccountUpdateOne) RemoveRoleIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *AccountUpdateOne) RemoveRoles(v ...*Role) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearAuthentication clears all "authentication" edges to the Authentication entity.
+func (_u *AccountUpdateOne) ClearAuthentication() *AccountUpdateOne {
+	_u.mutation.ClearAuthentication()
+	return _u
+}
+
+// RemoveAuthenticationIDs removes the "authentication" edge to Authentication entities by IDs.
+func (_u *AccountUpdateOne) RemoveAuthenticationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveAuthenticationIDs(ids...)
+	return _u
+}
+
+// RemoveAuthentication removes "authentication" edges to Authentication entities.
+func (_u *AccountUpdateOne) RemoveAuthentication(v ...*Authentication) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthenticationIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *AccountUpdateOne) ClearTags() *AccountUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *AccountUpdateOne) RemoveTagIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *AccountUpdateOne) RemoveTags(v ...*Tag) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *AccountUpdateOne) ClearCollections() *AccountUpdateOne {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *AccountUpdateOne) RemoveCollectionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *AccountUpdateOne) RemoveCollections(v ...*Collection) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionIDs(ids...)
+}
+
+// ClearNodes clears all "nodes" edges to the Node entity.
+func (_u *AccountUpdateOne) ClearNodes() *AccountUpdateOne {
+	_u.mutation.ClearNodes()
+	return _u
+}
+
+// RemoveNodeIDs removes the "nodes" edge to Node entities by IDs.
+func (_u *AccountUpdateOne) RemoveNodeIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveNodeIDs(ids...)
+	return _u
+}
+
+// RemoveNodes removes "nodes" edges to Node entities.
+func (_u *AccountUpdateOne) RemoveNodes(v ...*Node) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNodeIDs(ids...)
+}
+
+// ClearAssets clears all "assets" edges to the Asset entity.
+func (_u *AccountUpdateOne) ClearAssets() *AccountUpdateOne {
+	_u.mutation.ClearAssets()
+	return _u
+}
+
+// RemoveAssetIDs removes the "assets" edge to Asset entities by IDs.
+func (_u *AccountUpdateOne) RemoveAssetIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveAssetIDs(ids...)
+	return _u
+}
+
+// RemoveAssets removes "assets" edges to Asset entities.
+func (_u *AccountUpdateOne) RemoveAssets(v ...*Asset) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssetIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the EventParticipant entity.
+func (_u *AccountUpdateOne) ClearEvents() *AccountUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to EventParticipant entities by IDs.
+func (_u *AccountUpdateOne) RemoveEventIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to EventParticipant entities.
+func (_u *AccountUpdateOne) RemoveEvents(v ...*EventParticipant) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearPostReads clears all "post_reads" edges to the PostRead entity.
+func (_u *AccountUpdateOne) ClearPostReads() *AccountUpdateOne {
+	_u.mutation.ClearPostReads()
+	return _u
+}
+
+// RemovePostReadIDs removes the "post_reads" edge to PostRead entities by IDs.
+func (_u *AccountUpdateOne) RemovePostReadIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemovePostReadIDs(ids...)
+	return _u
+}
+
+// RemovePostReads removes "post_reads" edges to PostRead entities.
+func (_u *AccountUpdateOne) RemovePostReads(v ...*PostRead) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostReadIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdateOne) ClearNotificationPreferences() *AccountUpdateOne {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *AccountUpdateOne) RemoveNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *AccountUpdateOne) RemoveNotificationPreferences(v ...*NotificationPreference) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
+// ClearPushSubscriptions clears all "push_subscriptions" edges to the PushSubscription entity.
+func (_u *AccountUpdateOne) ClearPushSubscriptions() *AccountUpdateOne {
+	_u.mutation.ClearPushSubscriptions()
+	return _u
+}
+
+// RemovePushSubscriptionIDs removes the "push_subscriptions" edge to PushSubscription entities by IDs.
+func (_u *AccountUpdateOne) RemovePushSubscriptionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemovePushSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemovePushSubscriptions removes "push_subscriptions" edges to PushSubscription entities.
+func (_u *AccountUpdateOne) RemovePushSubscriptions(v ...*PushSubscription) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePushSubscriptionIDs(ids...)
+}
+
+// ClearDigestSubscription clears the "digest_subscription" edge to the DigestSubscription entity.
+func (_u *AccountUpdateOne) ClearDigestSubscription() *AccountUpdateOne {
+	_u.mutation.ClearDigestSubscription()
+	return _u
+}
+
+// ClearFeedToken clears the "feed_token" edge to the FeedToken entity.
+func (_u *AccountUpdateOne) ClearFeedToken() *AccountUpdateOne {
+	_u.mutation.ClearFeedToken()
+	return _u
+}
+
+// ClearAPIUsage clears all "api_usage" edges to the APIUsage entity.
+func (_u *AccountUpdateOne) ClearAPIUsage() *AccountUpdateOne {
+	_u.mutation.ClearAPIUsage()
+	return _u
+}
+
+// RemoveAPIUsageIDs removes the "api_usage" edge to APIUsage entities by IDs.
+func (_u *AccountUpdateOne) RemoveAPIUsageIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveAPIUsageIDs(ids...)
+	return _u
+}
+
+// RemoveAPIUsage removes "api_usage" edges to APIUsage entities.
+func (_u *AccountUpdateOne) RemoveAPIUsage(v ...*APIUsage) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIUsageIDs(ids...)
+}
+
+// ClearContentViews clears all "content_views" edges to the ContentView entity.
+func (_u *AccountUpdateOne) ClearContentViews() *AccountUpdateOne {
+	_u.mutation.ClearContentViews()
+	return _u
+}
+
+// RemoveContentViewIDs removes the "content_views" edge to ContentView entities by IDs.
+func (_u *AccountUpdateOne) RemoveContentViewIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveContentViewIDs(ids...)
+	return _u
+}
+
+// RemoveContentViews removes "content_views" edges to ContentView entities.
+func (_u *AccountUpdateOne) RemoveContentViews(v ...*ContentView) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContentViewIDs(ids...)
+}
+
+// ClearReports clears all "reports" edges to the Report entity.
+func (_u *AccountUpdateOne) ClearReports() *AccountUpdateOne {
+	_u.mutation.ClearReports()
+	return _u
+}
+
+// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
+func (_u *AccountUpdateOne) RemoveReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveReportIDs(ids...)
+	return _u
+}
+
+// RemoveReports removes "reports" edges to Report entities.
+func (_u *AccountUpdateOne) RemoveReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReportIDs(ids...)
+}
+
+// ClearHandledReports clears all "handled_reports" edges to the Report entity.
+func (_u *AccountUpdateOne) ClearHandledReports() *AccountUpdateOne {
+	_u.mutation.ClearHandledReports()
+	return _u
+}
+
+// RemoveHandledReportIDs removes the "handled_reports" edge to Report entities by IDs.
+func (_u *AccountUpdateOne) RemoveHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveHandledReportIDs(ids...)
+	return _u
+}
+
+// RemoveHandledReports removes "handled_reports" edges to Report entities.
+func (_u *AccountUpdateOne) RemoveHandledReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHandledReportIDs(ids...)
+}
+
+// ClearModerationNotes clears all "moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdateOne) ClearModerationNotes() *AccountUpdateOne {
+	_u.mutation.ClearModerationNotes()
+	return _u
+}
+
+// RemoveModerationNoteIDs removes the "moderation_notes" edge to ModerationNote entities by IDs.
+func (_u *AccountUpdateOne) RemoveModerationNoteIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveModerationNoteIDs(ids...)
+	return _u
+}
+
+// RemoveModerationNotes removes "moderation_notes" edges to ModerationNote entities.
+func (_u *AccountUpdateOne) RemoveModerationNotes(v ...*ModerationNote) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveModerationNoteIDs(ids...)
+}
+
+// ClearAuthoredModerationNotes clears all "authored_moderation_notes" edges to the ModerationNote entity.
+func (_u *AccountUpdateOne) ClearAuthoredModerationNotes() *AccountUpdateOne {
+	_u.mutation.ClearAuthoredModerationNotes()
+	return _u
+}
+
+// RemoveAuthoredModerationNoteIDs removes the "authored_moderation_notes" edge to ModerationNote entities by IDs.
+func (_u *AccountUpdateOne) RemoveAuthoredModerationNoteIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveAuthoredModerationNoteIDs(ids...)
+	return _u
+}
+
+// RemoveAuthoredModerationNotes removes "authored_moderation_notes" edges to ModerationNote entities.
+func (_u *AccountUpdateOne) RemoveAuthoredModerationNotes(v ...*ModerationNote) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthoredModerationNoteIDs(ids...)
+}
+
+// ClearWarnings clears all "warnings" edges to the Warning entity.
+func (_u *AccountUpdateOne) ClearWarnings() *AccountUpdateOne {
+	_u.mutation.ClearWarnings()
+	return _u
+}
+
+// RemoveWarningIDs removes the "warnings" edge to Warning entities by IDs.
+func (_u *AccountUpdateOne) RemoveWarningIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveWarningIDs(ids...)
+	return _u
+}
+
+// RemoveWarnings removes "warnings" edges to Warning entities.
+func (_u *AccountUpdateOne) RemoveWarnings(v ...*Warning) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWarningIDs(ids...)
+}
+
+// ClearIssuedWarnings clears all "issued_warnings" edges to the Warning entity.
+func (_u *AccountUpdateOne) ClearIssuedWarnings() *AccountUpdateOne {
+	_u.mutation.ClearIssuedWarnings()
+	return _u
+}
+
+// RemoveIssuedWarningIDs removes the "issued_warnings" edge to Warning entities by IDs.
+func (_u *AccountUpdateOne) RemoveIssuedWarningIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveIssuedWarningIDs(ids...)
+	return _u
+}
+
+// RemoveIssuedWarnings removes "issued_warnings" edges to Warning entities.
+func (_u *AccountUpdateOne) RemoveIssuedWarnings(v ...*Warning) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIssuedWarningIDs(ids...)
+}
+
+// ClearUploadSessions clears all "upload_sessions" edges to the UploadSession entity.
+func (_u *AccountUpdateOne) ClearUploadSessions() *AccountUpdateOne {
+	_u.mutation.ClearUploadSessions()
+	return _u
+}
+
+// RemoveUploadSessionIDs removes the "upload_sessions" edge to UploadSession entities by IDs.
+func (_u *AccountUpdateOne) RemoveUploadSessionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveUploadSessionIDs(ids...)
+	return _u
+}
+
+// RemoveUploadSessions removes "upload_sessions" edges to UploadSession entities.
+func (_u *AccountUpdateOne) RemoveUploadSessions(v ...*UploadSession) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadSessionIDs(ids...)
+}
+
+// ClearConversations clears all "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdateOne) ClearConversations() *AccountUpdateOne {
+	_u.mutation.ClearConversations()
+	return _u
+}
+
+// RemoveConversationIDs removes the "conversations" edge to ConversationParticipant entities by IDs.
+func (_u *AccountUpdateOne) RemoveConversationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveConversationIDs(ids...)
+	return _u
+}
+
+// RemoveConversations removes "conversations" edges to ConversationParticipant entities.
+func (_u *AccountUpdateOne) RemoveConversations(v ...*ConversationParticipant) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationIDs(ids...)
+}
+
+// ClearDirectMessages clears all "direct_messages" edges to the DirectMessage entity.
+func (_u *AccountUpdateOne) ClearDirectMessages() *AccountUpdateOne {
+	_u.mutation.ClearDirectMessages()
+	return _u
+}
+
+// RemoveDirectMessageIDs removes the "direct_messages" edge to DirectMessage entities by IDs.
+func (_u *AccountUpdateOne) RemoveDirectMessageIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveDirectMessageIDs(ids...)
+	return _u
+}
+
+// RemoveDirectMessages removes "direct_messages" edges to DirectMessage entities.
+func (_u *AccountUpdateOne) RemoveDirectMessages(v ...*DirectMessage) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDirectMessageIDs(ids...)
+}
+
+// ClearSpaces clears all "spaces" edges to the SpaceMember entity.
+func (_u *AccountUpdateOne) ClearSpaces() *AccountUpdateOne {
+	_u.mutation.ClearSpaces()
+	return _u
+}
+
+// RemoveSpaceIDs removes the "spaces" edge to SpaceMember entities by IDs.
+func (_u *AccountUpdateOne) RemoveSpaceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveSpaceIDs(ids...)
+	return _u
+}
+
+// RemoveSpaces removes "spaces" edges to SpaceMember entities.
+func (_u *AccountUpdateOne) RemoveSpaces(v ...*SpaceMember) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSpaceIDs(ids...)
+}
+
+// ClearApplication clears the "application" edge to the AccountApplication entity.
+func (_u *AccountUpdateOne) ClearApplication() *AccountUpdateOne {
+	_u.mutation.ClearApplication()
+	return _u
+}
+
+// ClearReviewedApplications clears all "reviewed_applications" edges to the AccountApplication entity.
+func (_u *AccountUpdateOne) ClearReviewedApplications() *AccountUpdateOne {
+	_u.mutation.ClearReviewedApplications()
+	return _u
+}
+
+// RemoveReviewedApplicationIDs removes the "reviewed_applications" edge to AccountApplication entities by IDs.
+func (_u *AccountUpdateOne) RemoveReviewedApplicationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveReviewedApplicationIDs(ids...)
+	return _u
+}
+
+// RemoveReviewedApplications removes "reviewed_applications" edges to AccountApplication entities.
+func (_u *AccountUpdateOne) RemoveReviewedApplications(v ...*AccountApplication) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewedApplicationIDs(ids...)
+}
+
+// ClearOnboardingSteps clears all "onboarding_steps" edges to the AccountOnboardingStep entity.
+func (_u *AccountUpdateOne) ClearOnboardingSteps() *AccountUpdateOne {
+	_u.mutation.ClearOnboardingSteps()
+	return _u
+}
+
+// RemoveOnboardingStepIDs removes the "onboarding_steps" edge to AccountOnboardingStep entities by IDs.
+func (_u *AccountUpdateOne) RemoveOnboardingStepIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveOnboardingStepIDs(ids...)
+	return _u
+}
+
+// RemoveOnboardingSteps removes "onboarding_steps" edges to AccountOnboardingStep entities.
+func (_u *AccountUpdateOne) RemoveOnboardingSteps(v ...*AccountOnboardingStep) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOnboardingStepIDs(ids...)
+}
+
+// ClearPolicyAcceptances clears all "policy_acceptances" edges to the PolicyAcceptance entity.
+func (_u *AccountUpdateOne) ClearPolicyAcceptances() *AccountUpdateOne {
+	_u.mutation.ClearPolicyAcceptances()
+	return _u
+}
+
+// RemovePolicyAcceptanceIDs removes the "policy_acceptances" edge to PolicyAcceptance entities by IDs.
+func (_u *AccountUpdateOne) RemovePolicyAcceptanceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemovePolicyAcceptanceIDs(ids...)
+	return _u
+}
+
+// RemovePolicyAcceptances removes "policy_acceptances" edges to PolicyAcceptance entities.
+func (_u *AccountUpdateOne) RemovePolicyAcceptances(v ...*PolicyAcceptance) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePolicyAcceptanceIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (_u *AccountUpdateOne) ClearBookmarks() *AccountUpdateOne {
+	_u.mutation.ClearBookmarks()
+	return _u
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (_u *AccountUpdateOne) RemoveBookmarkIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveBookmarkIDs(ids...)
+	return _u
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (_u *AccountUpdateOne) RemoveBookmarks(v ...*Bookmark) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookmarkIDs(ids...)
+}
+
+// ClearReadingHistory clears all "reading_history" edges to the ReadingHistory entity.
+func (_u *AccountUpdateOne) ClearReadingHistory() *AccountUpdateOne {
+	_u.mutation.ClearReadingHistory()
+	return _u
+}
+
+// RemoveReadingHistoryIDs removes the "reading_history" edge to ReadingHistory entities by IDs.
+func (_u *AccountUpdateOne) RemoveReadingHistoryIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveReadingHistoryIDs(ids...)
+	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *AccountUpdateOne) SetUpdatedAt(v time.Time) *AccountUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// RemoveReadingHistory removes "reading_history" edges to ReadingHistory entities.
+func (_u *AccountUpdateOne) RemoveReadingHistory(v ...*ReadingHistory) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadingHistoryIDs(ids...)
+}
+
+// ClearVerificationRequests clears all "verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdateOne) ClearVerificationRequests() *AccountUpdateOne {
+	_u.mutation.ClearVerificationRequests()
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *AccountUpdateOne) SetDeletedAt(v time.Time) *AccountUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// RemoveVerificationRequestIDs removes the "verification_requests" edge to VerificationRequest entities by IDs.
+func (_u *AccountUpdateOne) RemoveVerificationRequestIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveVerificationRequestIDs(ids...)
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableDeletedAt(v *time.Time) *AccountUpdateOne {
-	if v != nil {
-		_u.SetDeletedAt(*v)
+// RemoveVerificationRequests removes "verification_requests" edges to VerificationRequest entities.
+func (_u *AccountUpdateOne) RemoveVerificationRequests(v ...*VerificationRequest) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVerificationRequestIDs(ids...)
+}
+
+// ClearReviewedVerificationRequests clears all "reviewed_verification_requests" edges to the VerificationRequest entity.
+func (_u *AccountUpdateOne) ClearReviewedVerificationRequests() *AccountUpdateOne {
+	_u.mutation.ClearReviewedVerificationRequests()
+	return _u
+}
+
+// RemoveReviewedVerificationRequestIDs removes the "reviewed_verification_requests" edge to VerificationRequest entities by IDs.
+func (_u *AccountUpdateOne) RemoveReviewedVerificationRequestIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveReviewedVerificationRequestIDs(ids...)
+	return _u
+}
+
+// RemoveReviewedVerificationRequests removes "reviewed_verification_requests" edges to VerificationRequest entities.
+func (_u *AccountUpdateOne) RemoveReviewedVerificationRequests(v ...*VerificationRequest) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewedVerificationRequestIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *AccountUpdateOne) ClearWebhooks() *AccountUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *AccountUpdateOne) RemoveWebhookIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *AccountUpdateOne) RemoveWebhooks(v ...*Webhook) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
+func (_u *AccountUpdateOne) ClearAccountRoles() *AccountUpdateOne {
+	_u.mutation.ClearAccountRoles()
+	return _u
+}
+
+// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
+func (_u *AccountUpdateOne) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveAccountRoleIDs(ids...)
+	return _u
+}
+
+// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
+func (_u *AccountUpdateOne) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccountRoleIDs(ids...)
+}
+
+// Where appends a list predicates to the AccountUpdate builder.
+func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Account entity.
+func (_u *AccountUpdateOne) Save(ctx context.Context) (*Account, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountUpdateOne) SaveX(ctx context.Context) *Account {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := account.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountUpdateOne) check() error {
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := account.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := account.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := account.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Account.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, account.FieldID)
+		for _, f := range fields {
+			if !account.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != account.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IndexedAt(); ok {
+		_spec.SetField(account.FieldIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IndexedAtCleared() {
+		_spec.ClearField(account.FieldIndexedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(account.FieldHandle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(account.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(account.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(account.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(account.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Admin(); ok {
+		_spec.SetField(account.FieldAdmin, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(account.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(account.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PinnedItems(); ok {
+		_spec.SetField(account.FieldPinnedItems, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPinnedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldPinnedItems, value)
+		})
+	}
+	if _u.mutation.PinnedItemsCleared() {
+		_spec.ClearField(account.FieldPinnedItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(account.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(account.FieldLocale, field.TypeString, value)
+	}
+	if _u.mutation.LocaleCleared() {
+		_spec.ClearField(account.FieldLocale, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(account.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(account.FieldTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadingHistoryEnabled(); ok {
+		_spec.SetField(account.FieldReadingHistoryEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HiddenActivity(); ok {
+		_spec.SetField(account.FieldHiddenActivity, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHiddenActivity(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldHiddenActivity, value)
+		})
+	}
+	if _u.mutation.HiddenActivityCleared() {
+		_spec.ClearField(account.FieldHiddenActivity, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Verification(); ok {
+		_spec.SetField(account.FieldVerification, field.TypeString, value)
+	}
+	if _u.mutation.VerificationCleared() {
+		_spec.ClearField(account.FieldVerification, field.TypeString)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
-	_u.mutation.ClearDeletedAt()
-	return _u
-}
-
-// SetIndexedAt sets the "indexed_at" field.
-func (_u *AccountUpdateOne) SetIndexedAt(v time.Time) *AccountUpdateOne {
-	_u.mutation.SetIndexedAt(v)
-	return _u
-}
-
-// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableIndexedAt(v *time.Time) *AccountUpdateOne {
-	if v != nil {
-		_u.SetIndexedAt(*v)
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearIndexedAt clears the value of the "indexed_at" field.
-func (_u *AccountUpdateOne) ClearIndexedAt() *AccountUpdateOne {
-	_u.mutation.ClearIndexedAt()
-	return _u
-}
-
-// SetHandle sets the "handle" field.
-func (_u *AccountUpdateOne) SetHandle(v string) *AccountUpdateOne {
-	_u.mutation.SetHandle(v)
-	return _u
-}
-
-// SetNillableHandle sets the "handle" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableHandle(v *string) *AccountUpdateOne {
-	if v != nil {
-		_u.SetHandle(*v)
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *AccountUpdateOne) SetName(v string) *AccountUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableName(v *string) *AccountUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if _u.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetBio sets the "bio" field.
-func (_u *AccountUpdateOne) SetBio(v string) *AccountUpdateOne {
-	_u.mutation.SetBio(v)
-	return _u
-}
-
-// SetNillableBio sets the "bio" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableBio(v *string) *AccountUpdateOne {
-	if v != nil {
-		_u.SetBio(*v)
+	if nodes := _u.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !_u.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearBio clears the value of the "bio" field.
-func (_u *AccountUpdateOne) ClearBio() *AccountUpdateOne {
-	_u.mutation.ClearBio()
-	return _u
-}
-
-// SetKind sets the "kind" field.
-func (_u *AccountUpdateOne) SetKind(v account.Kind) *AccountUpdateOne {
-	_u.mutation.SetKind(v)
-	return _u
-}
-
-// SetNillableKind sets the "kind" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableKind(v *account.Kind) *AccountUpdateOne {
-	if v != nil {
-		_u.SetKind(*v)
+	if nodes := _u.mutation.EmailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetAdmin sets the "admin" field.
-func (_u *AccountUpdateOne) SetAdmin(v bool) *AccountUpdateOne {
-	_u.mutation.SetAdmin(v)
-	return _u
-}
-
-// SetNillableAdmin sets the "admin" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableAdmin(v *bool) *AccountUpdateOne {
-	if v != nil {
-		_u.SetAdmin(*v)
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetLinks sets the "links" field.
-func (_u *AccountUpdateOne) SetLinks(v []schema.ExternalLink) *AccountUpdateOne {
-	_u.mutation.SetLinks(v)
-	return _u
-}
-
-// AppendLinks appends value to the "links" field.
-func (_u *AccountUpdateOne) AppendLinks(v []schema.ExternalLink) *AccountUpdateOne {
-	_u.mutation.AppendLinks(v)
-	return _u
-}
-
-// ClearLinks clears the value of the "links" field.
-func (_u *AccountUpdateOne) ClearLinks() *AccountUpdateOne {
-	_u.mutation.ClearLinks()
-	return _u
-}
-
-// SetMetadata sets the "metadata" field.
-func (_u *AccountUpdateOne) SetMetadata(v map[string]interface{}) *AccountUpdateOne {
-	_u.mutation.SetMetadata(v)
-	return _u
-}
-
-// ClearMetadata clears the value of the "metadata" field.
-func (_u *AccountUpdateOne) ClearMetadata() *AccountUpdateOne {
-	_u.mutation.ClearMetadata()
-	return _u
-}
-
-// SetInvitedByID sets the "invited_by_id" field.
-func (_u *AccountUpdateOne) SetInvitedByID(v xid.ID) *AccountUpdateOne {
-	_u.mutation.SetInvitedByID(v)
-	return _u
-}
-
-// SetNillableInvitedByID sets the "invited_by_id" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableInvitedByID(v *xid.ID) *AccountUpdateOne {
-	if v != nil {
-		_u.SetInvitedByID(*v)
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearInvitedByID clears the value of the "invited_by_id" field.
-func (_u *AccountUpdateOne) ClearInvitedByID() *AccountUpdateOne {
-	_u.mutation.ClearInvitedByID()
-	return _u
-}
-
-// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
-func (_u *AccountUpdateOne) AddSessionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddSessionIDs(ids...)
-	return _u
-}
-
-// AddSessions adds the "sessions" edges to the Session entity.
-func (_u *AccountUpdateOne) AddSessions(v ...*Session) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.TriggeredNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddSessionIDs(ids...)
-}
-
-// AddEmailIDs adds the "emails" edge to the Email entity by IDs.
-func (_u *AccountUpdateOne) AddEmailIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddEmailIDs(ids...)
-	return _u
-}
-
-// AddEmails adds the "emails" edges to the Email entity.
-func (_u *AccountUpdateOne) AddEmails(v ...*Email) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedTriggeredNotificationsIDs(); len(nodes) > 0 && !_u.mutation.TriggeredNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddEmailIDs(ids...)
-}
-
-// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
-func (_u *AccountUpdateOne) AddNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddNotificationIDs(ids...)
-	return _u
-}
-
-// AddNotifications adds the "notifications" edges to the Notification entity.
-func (_u *AccountUpdateOne) AddNotifications(v ...*Notification) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.TriggeredNotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddNotificationIDs(ids...)
-}
-
-// AddTriggeredNotificationIDs adds the "triggered_notifications" edge to the Notification entity by IDs.
-func (_u *AccountUpdateOne) AddTriggeredNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddTriggeredNotificationIDs(ids...)
-	return _u
-}
-
-// AddTriggeredNotifications adds the "triggered_notifications" edges to the Notification entity.
-func (_u *AccountUpdateOne) AddTriggeredNotifications(v ...*Notification) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddTriggeredNotificationIDs(ids...)
-}
-
-// AddFollowingIDs adds the "following" edge to the AccountFollow entity by IDs.
-func (_u *AccountUpdateOne) AddFollowingIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddFollowingIDs(ids...)
-	return _u
-}
-
-// AddFollowing adds the "following" edges to the AccountFollow entity.
-func (_u *AccountUpdateOne) AddFollowing(v ...*AccountFollow) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddFollowingIDs(ids...)
-}
-
-// AddFollowedByIDs adds the "followed_by" edge to the AccountFollow entity by IDs.
-func (_u *AccountUpdateOne) AddFollowedByIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddFollowedByIDs(ids...)
-	return _u
-}
-
-// AddFollowedBy adds the "followed_by" edges to the AccountFollow entity.
-func (_u *AccountUpdateOne) AddFollowedBy(v ...*AccountFollow) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddFollowedByIDs(ids...)
-}
-
-// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
-func (_u *AccountUpdateOne) AddInvitationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddInvitationIDs(ids...)
-	return _u
-}
-
-// AddInvitations adds the "invitations" edges to the Invitation entity.
-func (_u *AccountUpdateOne) AddInvitations(v ...*Invitation) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddInvitationIDs(ids...)
-}
-
-// SetInvitedBy sets the "invited_by" edge to the Invitation entity.
-func (_u *AccountUpdateOne) SetInvitedBy(v *Invitation) *AccountUpdateOne {
-	return _u.SetInvitedByID(v.ID)
-}
-
-// AddPostIDs adds the "posts" edge to the Post entity by IDs.
-func (_u *AccountUpdateOne) AddPostIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddPostIDs(ids...)
-	return _u
-}
-
-// AddPosts adds the "posts" edges to the Post entity.
-func (_u *AccountUpdateOne) AddPosts(v ...*Post) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !_u.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddPostIDs(ids...)
-}
-
-// AddQuestionIDs adds the "questions" edge to the Question entity by IDs.
-func (_u *AccountUpdateOne) AddQuestionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddQuestionIDs(ids...)
-	return _u
-}
-
-// AddQuestions adds the "questions" edges to the Question entity.
-func (_u *AccountUpdateOne) AddQuestions(v ...*Question) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.FollowedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddQuestionIDs(ids...)
-}
-
-// AddReactIDs adds the "reacts" edge to the React entity by IDs.
-func (_u *AccountUpdateOne) AddReactIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddReactIDs(ids...)
-	return _u
-}
-
-// AddReacts adds the "reacts" edges to the React entity.
-func (_u *AccountUpdateOne) AddReacts(v ...*React) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddReactIDs(ids...)
-}
-
-// AddLikeIDs adds the "likes" edge to the LikePost entity by IDs.
-func (_u *AccountUpdateOne) AddLikeIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddLikeIDs(ids...)
-	return _u
-}
-
-// AddLikes adds the "likes" edges to the LikePost entity.
-func (_u *AccountUpdateOne) AddLikes(v ...*LikePost) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !_u.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddLikeIDs(ids...)
-}
-
-// AddMentionIDs adds the "mentions" edge to the MentionProfile entity by IDs.
-func (_u *AccountUpdateOne) AddMentionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddMentionIDs(ids...)
-	return _u
-}
-
-// AddMentions adds the "mentions" edges to the MentionProfile entity.
-func (_u *AccountUpdateOne) AddMentions(v ...*MentionProfile) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddMentionIDs(ids...)
-}
-
-// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
-func (_u *AccountUpdateOne) AddRoleIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddRoleIDs(ids...)
-	return _u
-}
-
-// AddRoles adds the "roles" edges to the Role entity.
-func (_u *AccountUpdateOne) AddRoles(v ...*Role) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddRoleIDs(ids...)
-}
-
-// AddAuthenticationIDs adds the "authentication" edge to the Authentication entity by IDs.
-func (_u *AccountUpdateOne) AddAuthenticationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddAuthenticationIDs(ids...)
-	return _u
-}
-
-// AddAuthentication adds the "authentication" edges to the Authentication entity.
-func (_u *AccountUpdateOne) AddAuthentication(v ...*Authentication) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !_u.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddAuthenticationIDs(ids...)
-}
-
-// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
-func (_u *AccountUpdateOne) AddTagIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddTagIDs(ids...)
-	return _u
-}
-
-// AddTags adds the "tags" edges to the Tag entity.
-func (_u *AccountUpdateOne) AddTags(v ...*Tag) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddTagIDs(ids...)
-}
-
-// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
-func (_u *AccountUpdateOne) AddCollectionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddCollectionIDs(ids...)
-	return _u
-}
-
-// AddCollections adds the "collections" edges to the Collection entity.
-func (_u *AccountUpdateOne) AddCollections(v ...*Collection) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.TagFollowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TagFollowsTable,
+			Columns: []string{account.TagFollowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddCollectionIDs(ids...)
-}
-
-// AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
-func (_u *AccountUpdateOne) AddNodeIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddNodeIDs(ids...)
-	return _u
-}
-
-// AddNodes adds the "nodes" edges to the Node entity.
-func (_u *AccountUpdateOne) AddNodes(v ...*Node) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedTagFollowsIDs(); len(nodes) > 0 && !_u.mutation.TagFollowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TagFollowsTable,
+			Columns: []string{account.TagFollowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddNodeIDs(ids...)
-}
-
-// AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
-func (_u *AccountUpdateOne) AddAssetIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddAssetIDs(ids...)
-	return _u
-}
-
-// AddAssets adds the "assets" edges to the Asset entity.
-func (_u *AccountUpdateOne) AddAssets(v ...*Asset) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.TagFollowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TagFollowsTable,
+			Columns: []string{account.TagFollowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddAssetIDs(ids...)
-}
-
-// AddEventIDs adds the "events" edge to the EventParticipant entity by IDs.
-func (_u *AccountUpdateOne) AddEventIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddEventIDs(ids...)
-	return _u
-}
-
-// AddEvents adds the "events" edges to the EventParticipant entity.
-func (_u *AccountUpdateOne) AddEvents(v ...*EventParticipant) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.CategoryFollowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CategoryFollowsTable,
+			Columns: []string{account.CategoryFollowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCategoryFollowsIDs(); len(nodes) > 0 && !_u.mutation.CategoryFollowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CategoryFollowsTable,
+			Columns: []string{account.CategoryFollowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddEventIDs(ids...)
-}
-
-// AddPostReadIDs adds the "post_reads" edge to the PostRead entity by IDs.
-func (_u *AccountUpdateOne) AddPostReadIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddPostReadIDs(ids...)
-	return _u
-}
-
-// AddPostReads adds the "post_reads" edges to the PostRead entity.
-func (_u *AccountUpdateOne) AddPostReads(v ...*PostRead) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.CategoryFollowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CategoryFollowsTable,
+			Columns: []string{account.CategoryFollowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddPostReadIDs(ids...)
-}
-
-// AddReportIDs adds the "reports" edge to the Report entity by IDs.
-func (_u *AccountUpdateOne) AddReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddReportIDs(ids...)
-	return _u
-}
-
-// AddReports adds the "reports" edges to the Report entity.
-func (_u *AccountUpdateOne) AddReports(v ...*Report) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.ReputationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReputationTable,
+			Columns: []string{account.ReputationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddReportIDs(ids...)
-}
-
-// AddHandledReportIDs adds the "handled_reports" edge to the Report entity by IDs.
-func (_u *AccountUpdateOne) AddHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddHandledReportIDs(ids...)
-	return _u
-}
-
-// AddHandledReports adds the "handled_reports" edges to the Report entity.
-func (_u *AccountUpdateOne) AddHandledReports(v ...*Report) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedReputationIDs(); len(nodes) > 0 && !_u.mutation.ReputationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReputationTable,
+			Columns: []string{account.ReputationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddHandledReportIDs(ids...)
-}
-
-// AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
-func (_u *AccountUpdateOne) AddAccountRoleIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddAccountRoleIDs(ids...)
-	return _u
-}
-
-// AddAccountRoles adds the "account_roles" edges to the AccountRoles entity.
-func (_u *AccountUpdateOne) AddAccountRoles(v ...*AccountRoles) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.ReputationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReputationTable,
+			Columns: []string{account.ReputationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddAccountRoleIDs(ids...)
-}
-
-// Mutation returns the AccountMutation object of the builder.
-func (_u *AccountUpdateOne) Mutation() *AccountMutation {
-	return _u.mutation
-}
-
-// ClearSessions clears all "sessions" edges to the Session entity.
-func (_u *AccountUpdateOne) ClearSessions() *AccountUpdateOne {
-	_u.mutation.ClearSessions()
-	return _u
-}
-
-// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
-func (_u *AccountUpdateOne) RemoveSessionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveSessionIDs(ids...)
-	return _u
-}
-
-// RemoveSessions removes "sessions" edges to Session entities.
-func (_u *AccountUpdateOne) RemoveSessions(v ...*Session) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.BadgesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BadgesTable,
+			Columns: []string{account.BadgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveSessionIDs(ids...)
-}
-
-// ClearEmails clears all "emails" edges to the Email entity.
-func (_u *AccountUpdateOne) ClearEmails() *AccountUpdateOne {
-	_u.mutation.ClearEmails()
-	return _u
-}
-
-// RemoveEmailIDs removes the "emails" edge to Email entities by IDs.
-func (_u *AccountUpdateOne) RemoveEmailIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveEmailIDs(ids...)
-	return _u
-}
-
-// RemoveEmails removes "emails" edges to Email entities.
-func (_u *AccountUpdateOne) RemoveEmails(v ...*Email) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedBadgesIDs(); len(nodes) > 0 && !_u.mutation.BadgesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BadgesTable,
+			Columns: []string{account.BadgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveEmailIDs(ids...)
-}
-
-// ClearNotifications clears all "notifications" edges to the Notification entity.
-func (_u *AccountUpdateOne) ClearNotifications() *AccountUpdateOne {
-	_u.mutation.ClearNotifications()
-	return _u
-}
-
-// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
-func (_u *AccountUpdateOne) RemoveNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveNotificationIDs(ids...)
-	return _u
-}
-
-// RemoveNotifications removes "notifications" edges to Notification entities.
-func (_u *AccountUpdateOne) RemoveNotifications(v ...*Notification) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.BadgesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BadgesTable,
+			Columns: []string{account.BadgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveNotificationIDs(ids...)
-}
-
-// ClearTriggeredNotifications clears all "triggered_notifications" edges to the Notification entity.
-func (_u *AccountUpdateOne) ClearTriggeredNotifications() *AccountUpdateOne {
-	_u.mutation.ClearTriggeredNotifications()
-	return _u
-}
-
-// RemoveTriggeredNotificationIDs removes the "triggered_notifications" edge to Notification entities by IDs.
-func (_u *AccountUpdateOne) RemoveTriggeredNotificationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveTriggeredNotificationIDs(ids...)
-	return _u
-}
-
-// RemoveTriggeredNotifications removes "triggered_notifications" edges to Notification entities.
-func (_u *AccountUpdateOne) RemoveTriggeredNotifications(v ...*Notification) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveTriggeredNotificationIDs(ids...)
-}
-
-// ClearFollowing clears all "following" edges to the AccountFollow entity.
-func (_u *AccountUpdateOne) ClearFollowing() *AccountUpdateOne {
-	_u.mutation.ClearFollowing()
-	return _u
-}
-
-// RemoveFollowingIDs removes the "following" edge to AccountFollow entities by IDs.
-func (_u *AccountUpdateOne) RemoveFollowingIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveFollowingIDs(ids...)
-	return _u
-}
-
-// RemoveFollowing removes "following" edges to AccountFollow entities.
-func (_u *AccountUpdateOne) RemoveFollowing(v ...*AccountFollow) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveFollowingIDs(ids...)
-}
-
-// ClearFollowedBy clears all "followed_by" edges to the AccountFollow entity.
-func (_u *AccountUpdateOne) ClearFollowedBy() *AccountUpdateOne {
-	_u.mutation.ClearFollowedBy()
-	return _u
-}
-
-// RemoveFollowedByIDs removes the "followed_by" edge to AccountFollow entities by IDs.
-func (_u *AccountUpdateOne) RemoveFollowedByIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveFollowedByIDs(ids...)
-	return _u
-}
-
-// RemoveFollowedBy removes "followed_by" edges to AccountFollow entities.
-func (_u *AccountUpdateOne) RemoveFollowedBy(v ...*AccountFollow) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveFollowedByIDs(ids...)
-}
-
-// ClearInvitations clears all "invitations" edges to the Invitation entity.
-func (_u *AccountUpdateOne) ClearInvitations() *AccountUpdateOne {
-	_u.mutation.ClearInvitations()
-	return _u
-}
-
-// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
-func (_u *AccountUpdateOne) RemoveInvitationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveInvitationIDs(ids...)
-	return _u
-}
-
-// RemoveInvitations removes "invitations" edges to Invitation entities.
-func (_u *AccountUpdateOne) RemoveInvitations(v ...*Invitation) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.InvitedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.InvitedByTable,
+			Columns: []string{account.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveInvitationIDs(ids...)
-}
-
-// ClearInvitedBy clears the "invited_by" edge to the Invitation entity.
-func (_u *AccountUpdateOne) ClearInvitedBy() *AccountUpdateOne {
-	_u.mutation.ClearInvitedBy()
-	return _u
-}
-
-// ClearPosts clears all "posts" edges to the Post entity.
-func (_u *AccountUpdateOne) ClearPosts() *AccountUpdateOne {
-	_u.mutation.ClearPosts()
-	return _u
-}
-
-// RemovePostIDs removes the "posts" edge to Post entities by IDs.
-func (_u *AccountUpdateOne) RemovePostIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemovePostIDs(ids...)
-	return _u
-}
-
-// RemovePosts removes "posts" edges to Post entities.
-func (_u *AccountUpdateOne) RemovePosts(v ...*Post) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.InvitedByTable,
+			Columns: []string{account.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemovePostIDs(ids...)
-}
-
-// ClearQuestions clears all "questions" edges to the Question entity.
-func (_u *AccountUpdateOne) ClearQuestions() *AccountUpdateOne {
-	_u.mutation.ClearQuestions()
-	return _u
-}
-
-// RemoveQuestionIDs removes the "questions" edge to Question entities by IDs.
-func (_u *AccountUpdateOne) RemoveQuestionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveQuestionIDs(ids...)
-	return _u
-}
-
-// RemoveQuestions removes "questions" edges to Question entities.
-func (_u *AccountUpdateOne) RemoveQuestions(v ...*Question) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.OwnerTable,
+			Columns: []string{account.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveQuestionIDs(ids...)
-}
-
-// ClearReacts clears all "reacts" edges to the React entity.
-func (_u *AccountUpdateOne) ClearReacts() *AccountUpdateOne {
-	_u.mutation.ClearReacts()
-	return _u
-}
-
-// RemoveReactIDs removes the "reacts" edge to React entities by IDs.
-func (_u *AccountUpdateOne) RemoveReactIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveReactIDs(ids...)
-	return _u
-}
-
-// RemoveReacts removes "reacts" edges to React entities.
-func (_u *AccountUpdateOne) RemoveReacts(v ...*React) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.OwnerTable,
+			Columns: []string{account.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveReactIDs(ids...)
-}
-
-// ClearLikes clears all "likes" edges to the LikePost entity.
-func (_u *AccountUpdateOne) ClearLikes() *AccountUpdateOne {
-	_u.mutation.ClearLikes()
-	return _u
-}
-
-// RemoveLikeIDs removes the "likes" edge to LikePost entities by IDs.
-func (_u *AccountUpdateOne) RemoveLikeIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveLikeIDs(ids...)
-	return _u
-}
-
-// RemoveLikes removes "likes" edges to LikePost entities.
-func (_u *AccountUpdateOne) RemoveLikes(v ...*LikePost) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.BotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BotsTable,
+			Columns: []string{account.BotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveLikeIDs(ids...)
-}
-
-// ClearMentions clears all "mentions" edges to the MentionProfile entity.
-func (_u *AccountUpdateOne) ClearMentions() *AccountUpdateOne {
-	_u.mutation.ClearMentions()
-	return _u
-}
-
-// RemoveMentionIDs removes the "mentions" edge to MentionProfile entities by IDs.
-func (_u *AccountUpdateOne) RemoveMentionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveMentionIDs(ids...)
-	return _u
-}
-
-// RemoveMentions removes "mentions" edges to MentionProfile entities.
-func (_u *AccountUpdateOne) RemoveMentions(v ...*MentionProfile) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedBotsIDs(); len(nodes) > 0 && !_u.mutation.BotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BotsTable,
+			Columns: []string{account.BotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveMentionIDs(ids...)
-}
-
-// ClearRoles clears all "roles" edges to the Role entity.
-func (_u *AccountUpdateOne) ClearRoles() *AccountUpdateOne {
-	_u.mutation.ClearRoles()
-	return _u
-}
-
-// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
-func (_u *AccountUpdateOne) RemoveRoleIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveRoleIDs(ids...)
-	return _u
-}
-
-// RemoveRoles removes "roles" edges to Role entities.
-func (_u *AccountUpdateOne) RemoveRoles(v ...*Role) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.BotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BotsTable,
+			Columns: []string{account.BotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveRoleIDs(ids...)
-}
-
-// ClearAuthentication clears all "authentication" edges to the Authentication entity.
-func (_u *AccountUpdateOne) ClearAuthentication() *AccountUpdateOne {
-	_u.mutation.ClearAuthentication()
-	return _u
-}
-
-// RemoveAuthenticationIDs removes the "authentication" edge to Authentication entities by IDs.
-func (_u *AccountUpdateOne) RemoveAuthenticationIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveAuthenticationIDs(ids...)
-	return _u
-}
-
-// RemoveAuthentication removes "authentication" edges to Authentication entities.
-func (_u *AccountUpdateOne) RemoveAuthentication(v ...*Authentication) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveAuthenticationIDs(ids...)
-}
-
-// ClearTags clears all "tags" edges to the Tag entity.
-func (_u *AccountUpdateOne) ClearTags() *AccountUpdateOne {
-	_u.mutation.ClearTags()
-	return _u
-}
-
-// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
-func (_u *AccountUpdateOne) RemoveTagIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveTagIDs(ids...)
-	return _u
-}
-
-// RemoveTags removes "tags" edges to Tag entities.
-func (_u *AccountUpdateOne) RemoveTags(v ...*Tag) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveTagIDs(ids...)
-}
-
-// ClearCollections clears all "collections" edges to the Collection entity.
-func (_u *AccountUpdateOne) ClearCollections() *AccountUpdateOne {
-	_u.mutation.ClearCollections()
-	return _u
-}
-
-// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
-func (_u *AccountUpdateOne) RemoveCollectionIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveCollectionIDs(ids...)
-	return _u
-}
-
-// RemoveCollections removes "collections" edges to Collection entities.
-func (_u *AccountUpdateOne) RemoveCollections(v ...*Collection) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveCollectionIDs(ids...)
-}
-
-// ClearNodes clears all "nodes" edges to the Node entity.
-func (_u *AccountUpdateOne) ClearNodes() *AccountUpdateOne {
-	_u.mutation.ClearNodes()
-	return _u
-}
-
-// RemoveNodeIDs removes the "nodes" edge to Node entities by IDs.
-func (_u *AccountUpdateOne) RemoveNodeIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveNodeIDs(ids...)
-	return _u
-}
-
-// RemoveNodes removes "nodes" edges to Node entities.
-func (_u *AccountUpdateOne) RemoveNodes(v ...*Node) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveNodeIDs(ids...)
-}
-
-// ClearAssets clears all "assets" edges to the Asset entity.
-func (_u *AccountUpdateOne) ClearAssets() *AccountUpdateOne {
-	_u.mutation.ClearAssets()
-	return _u
-}
-
-// RemoveAssetIDs removes the "assets" edge to Asset entities by IDs.
-func (_u *AccountUpdateOne) RemoveAssetIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveAssetIDs(ids...)
-	return _u
-}
-
-// RemoveAssets removes "assets" edges to Asset entities.
-func (_u *AccountUpdateOne) RemoveAssets(v ...*Asset) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveAssetIDs(ids...)
-}
-
-// ClearEvents clears all "events" edges to the EventParticipant entity.
-func (_u *AccountUpdateOne) ClearEvents() *AccountUpdateOne {
-	_u.mutation.ClearEvents()
-	return _u
-}
-
-// RemoveEventIDs removes the "events" edge to EventParticipant entities by IDs.
-func (_u *AccountUpdateOne) RemoveEventIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveEventIDs(ids...)
-	return _u
-}
-
-// RemoveEvents removes "events" edges to EventParticipant entities.
-func (_u *AccountUpdateOne) RemoveEvents(v ...*EventParticipant) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveEventIDs(ids...)
-}
-
-// ClearPostReads clears all "post_reads" edges to the PostRead entity.
-func (_u *AccountUpdateOne) ClearPostReads() *AccountUpdateOne {
-	_u.mutation.ClearPostReads()
-	return _u
-}
-
-// RemovePostReadIDs removes the "post_reads" edge to PostRead entities by IDs.
-func (_u *AccountUpdateOne) RemovePostReadIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemovePostReadIDs(ids...)
-	return _u
-}
-
-// RemovePostReads removes "post_reads" edges to PostRead entities.
-func (_u *AccountUpdateOne) RemovePostReads(v ...*PostRead) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.ReactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReactsIDs(); len(nodes) > 0 && !_u.mutation.ReactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemovePostReadIDs(ids...)
-}
-
-// ClearReports clears all "reports" edges to the Report entity.
-func (_u *AccountUpdateOne) ClearReports() *AccountUpdateOne {
-	_u.mutation.ClearReports()
-	return _u
-}
-
-// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
-func (_u *AccountUpdateOne) RemoveReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveReportIDs(ids...)
-	return _u
-}
-
-// RemoveReports removes "reports" edges to Report entities.
-func (_u *AccountUpdateOne) RemoveReports(v ...*Report) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.ReactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveReportIDs(ids...)
-}
-
-// ClearHandledReports clears all "handled_reports" edges to the Report entity.
-func (_u *AccountUpdateOne) ClearHandledReports() *AccountUpdateOne {
-	_u.mutation.ClearHandledReports()
-	return _u
-}
-
-// RemoveHandledReportIDs removes the "handled_reports" edge to Report entities by IDs.
-func (_u *AccountUpdateOne) RemoveHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveHandledReportIDs(ids...)
-	return _u
-}
-
-// RemoveHandledReports removes "handled_reports" edges to Report entities.
-func (_u *AccountUpdateOne) RemoveHandledReports(v ...*Report) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveHandledReportIDs(ids...)
-}
-
-// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
-func (_u *AccountUpdateOne) ClearAccountRoles() *AccountUpdateOne {
-	_u.mutation.ClearAccountRoles()
-	return _u
-}
-
-// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
-func (_u *AccountUpdateOne) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveAccountRoleIDs(ids...)
-	return _u
-}
-
-// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
-func (_u *AccountUpdateOne) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveAccountRoleIDs(ids...)
-}
-
-// Where appends a list predicates to the AccountUpdate builder.
-func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated Account entity.
-func (_u *AccountUpdateOne) Save(ctx context.Context) (*Account, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *AccountUpdateOne) SaveX(ctx context.Context) *Account {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *AccountUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *AccountUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if _u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (_u *AccountUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := account.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (_u *AccountUpdateOne) check() error {
-	if v, ok := _u.mutation.Handle(); ok {
-		if err := account.HandleValidator(v); err != nil {
-			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.Name(); ok {
-		if err := account.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.Kind(); ok {
-		if err := account.KindValidator(v); err != nil {
-			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *AccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdateOne {
-	_u.modifiers = append(_u.modifiers, modifiers...)
-	return _u
-}
-
-func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Account.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, account.FieldID)
-		for _, f := range fields {
-			if !account.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != account.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if _u.mutation.AuthenticationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.RemovedAuthenticationIDs(); len(nodes) > 0 && !_u.mutation.AuthenticationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := _u.mutation.AuthenticationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.IndexedAt(); ok {
-		_spec.SetField(account.FieldIndexedAt, field.TypeTime, value)
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.IndexedAtCleared() {
-		_spec.ClearField(account.FieldIndexedAt, field.TypeTime)
+	if _u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Handle(); ok {
-		_spec.SetField(account.FieldHandle, field.TypeString, value)
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(account.FieldName, field.TypeString, value)
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Bio(); ok {
-		_spec.SetField(account.FieldBio, field.TypeString, value)
+	if _u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.BioCleared() {
-		_spec.ClearField(account.FieldBio, field.TypeString)
+	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Kind(); ok {
-		_spec.SetField(account.FieldKind, field.TypeEnum, value)
+	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Admin(); ok {
-		_spec.SetField(account.FieldAdmin, field.TypeBool, value)
+	if _u.mutation.AssetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Links(); ok {
-		_spec.SetField(account.FieldLinks, field.TypeJSON, value)
+	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.AppendedLinks(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, account.FieldLinks, value)
-		})
+	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LinksCleared() {
-		_spec.ClearField(account.FieldLinks, field.TypeJSON)
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Metadata(); ok {
-		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.MetadataCleared() {
-		_spec.ClearField(account.FieldMetadata, field.TypeJSON)
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SessionsCleared() {
+	if _u.mutation.PostReadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+	if nodes := _u.mutation.RemovedPostReadsIDs(); len(nodes) > 0 && !_u.mutation.PostReadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3498,15 +8564,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PostReadsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3514,28 +8580,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EmailsCleared() {
+	if _u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !_u.mutation.EmailsCleared() {
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3543,15 +8609,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EmailsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3559,28 +8625,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NotificationsCleared() {
+	if _u.mutation.PushSubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
+			Table:   account.PushSubscriptionsTable,
+			Columns: []string{account.PushSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pushsubscription.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+	if nodes := _u.mutation.RemovedPushSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.PushSubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
+			Table:   account.PushSubscriptionsTable,
+			Columns: []string{account.PushSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pushsubscription.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3588,15 +8654,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PushSubscriptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
+			Table:   account.PushSubscriptionsTable,
+			Columns: []string{account.PushSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pushsubscription.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3604,28 +8670,86 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TriggeredNotificationsCleared() {
+	if _u.mutation.DigestSubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.DigestSubscriptionTable,
+			Columns: []string{account.DigestSubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DigestSubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.DigestSubscriptionTable,
+			Columns: []string{account.DigestSubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FeedTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.FeedTokenTable,
+			Columns: []string{account.FeedTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedtoken.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FeedTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.FeedTokenTable,
+			Columns: []string{account.FeedTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedtoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APIUsageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
+			Table:   account.APIUsageTable,
+			Columns: []string{account.APIUsageColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTriggeredNotificationsIDs(); len(nodes) > 0 && !_u.mutation.TriggeredNotificationsCleared() {
+	if nodes := _u.mutation.RemovedAPIUsageIDs(); len(nodes) > 0 && !_u.mutation.APIUsageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
+			Table:   account.APIUsageTable,
+			Columns: []string{account.APIUsageColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3633,15 +8757,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TriggeredNotificationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.APIUsageIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
+			Table:   account.APIUsageTable,
+			Columns: []string{account.APIUsageColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3649,28 +8773,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.FollowingCleared() {
+	if _u.mutation.ContentViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
+			Table:   account.ContentViewsTable,
+			Columns: []string{account.ContentViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
+	if nodes := _u.mutation.RemovedContentViewsIDs(); len(nodes) > 0 && !_u.mutation.ContentViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
+			Table:   account.ContentViewsTable,
+			Columns: []string{account.ContentViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3678,15 +8802,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ContentViewsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
+			Table:   account.ContentViewsTable,
+			Columns: []string{account.ContentViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3694,28 +8818,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.FollowedByCleared() {
+	if _u.mutation.ReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !_u.mutation.FollowedByCleared() {
+	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3723,15 +8847,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.FollowedByIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3739,28 +8863,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InvitationsCleared() {
+	if _u.mutation.HandledReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+	if nodes := _u.mutation.RemovedHandledReportsIDs(); len(nodes) > 0 && !_u.mutation.HandledReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3768,15 +8892,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.HandledReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3784,28 +8908,44 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InvitedByCleared() {
+	if _u.mutation.ModerationNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ModerationNotesTable,
+			Columns: []string{account.ModerationNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModerationNotesIDs(); len(nodes) > 0 && !_u.mutation.ModerationNotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   account.InvitedByTable,
-			Columns: []string{account.InvitedByColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ModerationNotesTable,
+			Columns: []string{account.ModerationNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ModerationNotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   account.InvitedByTable,
-			Columns: []string{account.InvitedByColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ModerationNotesTable,
+			Columns: []string{account.ModerationNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3813,28 +8953,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PostsCleared() {
+	if _u.mutation.AuthoredModerationNotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.AuthoredModerationNotesTable,
+			Columns: []string{account.AuthoredModerationNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
+	if nodes := _u.mutation.RemovedAuthoredModerationNotesIDs(); len(nodes) > 0 && !_u.mutation.AuthoredModerationNotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.AuthoredModerationNotesTable,
+			Columns: []string{account.AuthoredModerationNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3842,15 +8982,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AuthoredModerationNotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.AuthoredModerationNotesTable,
+			Columns: []string{account.AuthoredModerationNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3858,28 +8998,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.QuestionsCleared() {
+	if _u.mutation.WarningsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.WarningsTable,
+			Columns: []string{account.WarningsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+	if nodes := _u.mutation.RemovedWarningsIDs(); len(nodes) > 0 && !_u.mutation.WarningsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.WarningsTable,
+			Columns: []string{account.WarningsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3887,15 +9027,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WarningsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.WarningsTable,
+			Columns: []string{account.WarningsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3903,28 +9043,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReactsCleared() {
+	if _u.mutation.IssuedWarningsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.IssuedWarningsTable,
+			Columns: []string{account.IssuedWarningsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReactsIDs(); len(nodes) > 0 && !_u.mutation.ReactsCleared() {
+	if nodes := _u.mutation.RemovedIssuedWarningsIDs(); len(nodes) > 0 && !_u.mutation.IssuedWarningsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.IssuedWarningsTable,
+			Columns: []string{account.IssuedWarningsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3932,15 +9072,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReactsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.IssuedWarningsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.IssuedWarningsTable,
+			Columns: []string{account.IssuedWarningsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3948,28 +9088,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LikesCleared() {
+	if _u.mutation.UploadSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.UploadSessionsTable,
+			Columns: []string{account.UploadSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+	if nodes := _u.mutation.RemovedUploadSessionsIDs(); len(nodes) > 0 && !_u.mutation.UploadSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.UploadSessionsTable,
+			Columns: []string{account.UploadSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3977,15 +9117,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.UploadSessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.UploadSessionsTable,
+			Columns: []string{account.UploadSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3993,28 +9133,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MentionsCleared() {
+	if _u.mutation.ConversationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+	if nodes := _u.mutation.RemovedConversationsIDs(); len(nodes) > 0 && !_u.mutation.ConversationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4022,15 +9162,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ConversationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4038,94 +9178,73 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RolesCleared() {
+	if _u.mutation.DirectMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DirectMessagesTable,
+			Columns: []string{account.DirectMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
 			},
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+	if nodes := _u.mutation.RemovedDirectMessagesIDs(); len(nodes) > 0 && !_u.mutation.DirectMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DirectMessagesTable,
+			Columns: []string{account.DirectMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DirectMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DirectMessagesTable,
+			Columns: []string{account.DirectMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AuthenticationCleared() {
+	if _u.mutation.SpacesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.SpacesTable,
+			Columns: []string{account.SpacesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAuthenticationIDs(); len(nodes) > 0 && !_u.mutation.AuthenticationCleared() {
+	if nodes := _u.mutation.RemovedSpacesIDs(); len(nodes) > 0 && !_u.mutation.SpacesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.SpacesTable,
+			Columns: []string{account.SpacesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4133,15 +9252,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AuthenticationIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SpacesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.SpacesTable,
+			Columns: []string{account.SpacesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4149,44 +9268,73 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TagsCleared() {
+	if _u.mutation.ApplicationCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.ApplicationTable,
+			Columns: []string{account.ApplicationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+	if nodes := _u.mutation.ApplicationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.ApplicationTable,
+			Columns: []string{account.ApplicationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedApplicationsTable,
+			Columns: []string{account.ReviewedApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedReviewedApplicationsIDs(); len(nodes) > 0 && !_u.mutation.ReviewedApplicationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.ReviewedApplicationsTable,
+			Columns: []string{account.ReviewedApplicationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReviewedApplicationsTable,
+			Columns: []string{account.ReviewedApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4194,28 +9342,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CollectionsCleared() {
+	if _u.mutation.OnboardingStepsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.OnboardingStepsTable,
+			Columns: []string{account.OnboardingStepsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+	if nodes := _u.mutation.RemovedOnboardingStepsIDs(); len(nodes) > 0 && !_u.mutation.OnboardingStepsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.OnboardingStepsTable,
+			Columns: []string{account.OnboardingStepsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4223,15 +9371,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.OnboardingStepsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.OnboardingStepsTable,
+			Columns: []string{account.OnboardingStepsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4239,28 +9387,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NodesCleared() {
+	if _u.mutation.PolicyAcceptancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.PolicyAcceptancesTable,
+			Columns: []string{account.PolicyAcceptancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
+	if nodes := _u.mutation.RemovedPolicyAcceptancesIDs(); len(nodes) > 0 && !_u.mutation.PolicyAcceptancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.PolicyAcceptancesTable,
+			Columns: []string{account.PolicyAcceptancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4268,15 +9416,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PolicyAcceptancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.PolicyAcceptancesTable,
+			Columns: []string{account.PolicyAcceptancesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4284,28 +9432,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetsCleared() {
+	if _u.mutation.BookmarksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.BookmarksTable,
+			Columns: []string{account.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
+	if nodes := _u.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !_u.mutation.BookmarksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.BookmarksTable,
+			Columns: []string{account.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4313,15 +9461,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BookmarksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.BookmarksTable,
+			Columns: []string{account.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4329,28 +9477,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EventsCleared() {
+	if _u.mutation.ReadingHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.ReadingHistoryTable,
+			Columns: []string{account.ReadingHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+	if nodes := _u.mutation.RemovedReadingHistoryIDs(); len(nodes) > 0 && !_u.mutation.ReadingHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.ReadingHistoryTable,
+			Columns: []string{account.ReadingHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4358,15 +9506,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReadingHistoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.ReadingHistoryTable,
+			Columns: []string{account.ReadingHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4374,28 +9522,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PostReadsCleared() {
+	if _u.mutation.VerificationRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.VerificationRequestsTable,
+			Columns: []string{account.VerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPostReadsIDs(); len(nodes) > 0 && !_u.mutation.PostReadsCleared() {
+	if nodes := _u.mutation.RemovedVerificationRequestsIDs(); len(nodes) > 0 && !_u.mutation.VerificationRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.VerificationRequestsTable,
+			Columns: []string{account.VerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4403,15 +9551,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PostReadsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.VerificationRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.VerificationRequestsTable,
+			Columns: []string{account.VerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4419,28 +9567,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsCleared() {
+	if _u.mutation.ReviewedVerificationRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Table:   account.ReviewedVerificationRequestsTable,
+			Columns: []string{account.ReviewedVerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
+	if nodes := _u.mutation.RemovedReviewedVerificationRequestsIDs(); len(nodes) > 0 && !_u.mutation.ReviewedVerificationRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Table:   account.ReviewedVerificationRequestsTable,
+			Columns: []string{account.ReviewedVerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4448,15 +9596,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReviewedVerificationRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Table:   account.ReviewedVerificationRequestsTable,
+			Columns: []string{account.ReviewedVerificationRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4464,28 +9612,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.HandledReportsCleared() {
+	if _u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.WebhooksTable,
+			Columns: []string{account.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedHandledReportsIDs(); len(nodes) > 0 && !_u.mutation.HandledReportsCleared() {
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.WebhooksTable,
+			Columns: []string{account.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4493,15 +9641,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.HandledReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.WebhooksTable,
+			Columns: []string{account.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
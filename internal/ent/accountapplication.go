@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/schema"
+	"github.com/rs/xid"
+)
+
+// AccountApplication is the model entity for the AccountApplication schema.
+type AccountApplication struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// One of pending, approved or rejected.
+	Status string `json:"status,omitempty"`
+	// Answers holds the value of the "answers" field.
+	Answers []schema.ApplicationAnswer `json:"answers,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// ReviewedByID holds the value of the "reviewed_by_id" field.
+	ReviewedByID *xid.ID `json:"reviewed_by_id,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountApplicationQuery when eager-loading is set.
+	Edges        AccountApplicationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountApplicationEdges holds the relations/edges for other nodes in the graph.
+type AccountApplicationEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// ReviewedBy holds the value of the reviewed_by edge.
+	ReviewedBy *Account `json:"reviewed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountApplicationEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// ReviewedByOrErr returns the ReviewedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountApplicationEdges) ReviewedByOrErr() (*Account, error) {
+	if e.ReviewedBy != nil {
+		return e.ReviewedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "reviewed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountApplication) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountapplication.FieldReviewedByID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case accountapplication.FieldAnswers:
+			values[i] = new([]byte)
+		case accountapplication.FieldStatus, accountapplication.FieldReason:
+			values[i] = new(sql.NullString)
+		case accountapplication.FieldCreatedAt, accountapplication.FieldUpdatedAt, accountapplication.FieldSubmittedAt, accountapplication.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		case accountapplication.FieldID, accountapplication.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountApplication fields.
+func (_m *AccountApplication) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountapplication.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case accountapplication.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case accountapplication.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case accountapplication.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case accountapplication.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case accountapplication.FieldAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Answers); err != nil {
+					return fmt.Errorf("unmarshal field answers: %w", err)
+				}
+			}
+		case accountapplication.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = new(time.Time)
+				*_m.SubmittedAt = value.Time
+			}
+		case accountapplication.FieldReviewedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by_id", values[i])
+			} else if value.Valid {
+				_m.ReviewedByID = new(xid.ID)
+				*_m.ReviewedByID = *value.S.(*xid.ID)
+			}
+		case accountapplication.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case accountapplication.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountApplication.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountApplication) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the AccountApplication entity.
+func (_m *AccountApplication) QueryAccount() *AccountQuery {
+	return NewAccountApplicationClient(_m.config).QueryAccount(_m)
+}
+
+// QueryReviewedBy queries the "reviewed_by" edge of the AccountApplication entity.
+func (_m *AccountApplication) QueryReviewedBy() *AccountQuery {
+	return NewAccountApplicationClient(_m.config).QueryReviewedBy(_m)
+}
+
+// Update returns a builder for updating this AccountApplication.
+// Note that you need to call AccountApplication.Unwrap() before calling this method if this AccountApplication
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountApplication) Update() *AccountApplicationUpdateOne {
+	return NewAccountApplicationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountApplication entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountApplication) Unwrap() *AccountApplication {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountApplication is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountApplication) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountApplication(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("answers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Answers))
+	builder.WriteString(", ")
+	if v := _m.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedByID; v != nil {
+		builder.WriteString("reviewed_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountApplications is a parsable slice of AccountApplication.
+type AccountApplications []*AccountApplication
@@ -0,0 +1,638 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountapplication
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldStatus, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// ReviewedByID applies equality check predicate on the "reviewed_by_id" field. It's identical to ReviewedByIDEQ.
+func ReviewedByID(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldReviewedByID, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AnswersIsNil applies the IsNil predicate on the "answers" field.
+func AnswersIsNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIsNull(FieldAnswers))
+}
+
+// AnswersNotNil applies the NotNil predicate on the "answers" field.
+func AnswersNotNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotNull(FieldAnswers))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// ReviewedByIDEQ applies the EQ predicate on the "reviewed_by_id" field.
+func ReviewedByIDEQ(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldReviewedByID, v))
+}
+
+// ReviewedByIDNEQ applies the NEQ predicate on the "reviewed_by_id" field.
+func ReviewedByIDNEQ(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldReviewedByID, v))
+}
+
+// ReviewedByIDIn applies the In predicate on the "reviewed_by_id" field.
+func ReviewedByIDIn(vs ...xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldReviewedByID, vs...))
+}
+
+// ReviewedByIDNotIn applies the NotIn predicate on the "reviewed_by_id" field.
+func ReviewedByIDNotIn(vs ...xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldReviewedByID, vs...))
+}
+
+// ReviewedByIDGT applies the GT predicate on the "reviewed_by_id" field.
+func ReviewedByIDGT(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldReviewedByID, v))
+}
+
+// ReviewedByIDGTE applies the GTE predicate on the "reviewed_by_id" field.
+func ReviewedByIDGTE(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldReviewedByID, v))
+}
+
+// ReviewedByIDLT applies the LT predicate on the "reviewed_by_id" field.
+func ReviewedByIDLT(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldReviewedByID, v))
+}
+
+// ReviewedByIDLTE applies the LTE predicate on the "reviewed_by_id" field.
+func ReviewedByIDLTE(v xid.ID) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldReviewedByID, v))
+}
+
+// ReviewedByIDContains applies the Contains predicate on the "reviewed_by_id" field.
+func ReviewedByIDContains(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldContains(FieldReviewedByID, vc))
+}
+
+// ReviewedByIDHasPrefix applies the HasPrefix predicate on the "reviewed_by_id" field.
+func ReviewedByIDHasPrefix(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldHasPrefix(FieldReviewedByID, vc))
+}
+
+// ReviewedByIDHasSuffix applies the HasSuffix predicate on the "reviewed_by_id" field.
+func ReviewedByIDHasSuffix(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldHasSuffix(FieldReviewedByID, vc))
+}
+
+// ReviewedByIDIsNil applies the IsNil predicate on the "reviewed_by_id" field.
+func ReviewedByIDIsNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIsNull(FieldReviewedByID))
+}
+
+// ReviewedByIDNotNil applies the NotNil predicate on the "reviewed_by_id" field.
+func ReviewedByIDNotNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotNull(FieldReviewedByID))
+}
+
+// ReviewedByIDEqualFold applies the EqualFold predicate on the "reviewed_by_id" field.
+func ReviewedByIDEqualFold(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldEqualFold(FieldReviewedByID, vc))
+}
+
+// ReviewedByIDContainsFold applies the ContainsFold predicate on the "reviewed_by_id" field.
+func ReviewedByIDContainsFold(v xid.ID) predicate.AccountApplication {
+	vc := v.String()
+	return predicate.AccountApplication(sql.FieldContainsFold(FieldReviewedByID, vc))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.FieldContainsFold(FieldReason, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.AccountApplication {
+	return predicate.AccountApplication(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.AccountApplication {
+	return predicate.AccountApplication(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewedBy applies the HasEdge predicate on the "reviewed_by" edge.
+func HasReviewedBy() predicate.AccountApplication {
+	return predicate.AccountApplication(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReviewedByTable, ReviewedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewedByWith applies the HasEdge predicate on the "reviewed_by" edge with a given conditions (other predicates).
+func HasReviewedByWith(preds ...predicate.Account) predicate.AccountApplication {
+	return predicate.AccountApplication(func(s *sql.Selector) {
+		step := newReviewedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountApplication) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountApplication) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountApplication) predicate.AccountApplication {
+	return predicate.AccountApplication(sql.NotPredicates(p))
+}
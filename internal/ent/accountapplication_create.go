@@ -0,0 +1,1086 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/schema"
+	"github.com/rs/xid"
+)
+
+// AccountApplicationCreate is the builder for creating a AccountApplication entity.
+type AccountApplicationCreate struct {
+	config
+	mutation *AccountApplicationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountApplicationCreate) SetCreatedAt(v time.Time) *AccountApplicationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountApplicationCreate) SetNillableCreatedAt(v *time.Time) *AccountApplicationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AccountApplicationCreate) SetUpdatedAt(v time.Time) *AccountApplicationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AccountApplicationCreate) SetNillableUpdatedAt(v *time.Time) *AccountApplicationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *AccountApplicationCreate) SetAccountID(v xid.ID) *AccountApplicationCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AccountApplicationCreate) SetStatus(v string) *AccountApplicationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetAnswers sets the "answers" field.
+func (_c *AccountApplicationCreate) SetAnswers(v []schema.ApplicationAnswer) *AccountApplicationCreate {
+	_c.mutation.SetAnswers(v)
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *AccountApplicationCreate) SetSubmittedAt(v time.Time) *AccountApplicationCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *AccountApplicationCreate) SetNillableSubmittedAt(v *time.Time) *AccountApplicationCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_c *AccountApplicationCreate) SetReviewedByID(v xid.ID) *AccountApplicationCreate {
+	_c.mutation.SetReviewedByID(v)
+	return _c
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_c *AccountApplicationCreate) SetNillableReviewedByID(v *xid.ID) *AccountApplicationCreate {
+	if v != nil {
+		_c.SetReviewedByID(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *AccountApplicationCreate) SetReviewedAt(v time.Time) *AccountApplicationCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *AccountApplicationCreate) SetNillableReviewedAt(v *time.Time) *AccountApplicationCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AccountApplicationCreate) SetReason(v string) *AccountApplicationCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *AccountApplicationCreate) SetNillableReason(v *string) *AccountApplicationCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountApplicationCreate) SetID(v xid.ID) *AccountApplicationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AccountApplicationCreate) SetNillableID(v *xid.ID) *AccountApplicationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *AccountApplicationCreate) SetAccount(v *Account) *AccountApplicationCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_c *AccountApplicationCreate) SetReviewedBy(v *Account) *AccountApplicationCreate {
+	return _c.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the AccountApplicationMutation object of the builder.
+func (_c *AccountApplicationCreate) Mutation() *AccountApplicationMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountApplication in the database.
+func (_c *AccountApplicationCreate) Save(ctx context.Context) (*AccountApplication, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountApplicationCreate) SaveX(ctx context.Context) *AccountApplication {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountApplicationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountApplicationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountApplicationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountapplication.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := accountapplication.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := accountapplication.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountApplicationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountApplication.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AccountApplication.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "AccountApplication.account_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AccountApplication.status"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accountapplication.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AccountApplication.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "AccountApplication.account"`)}
+	}
+	return nil
+}
+
+func (_c *AccountApplicationCreate) sqlSave(ctx context.Context) (*AccountApplication, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountApplicationCreate) createSpec() (*AccountApplication, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountApplication{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountapplication.Table, sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountapplication.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountapplication.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(accountapplication.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Answers(); ok {
+		_spec.SetField(accountapplication.FieldAnswers, field.TypeJSON, value)
+		_node.Answers = value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(accountapplication.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(accountapplication.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(accountapplication.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   accountapplication.AccountTable,
+			Columns: []string{accountapplication.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountapplication.ReviewedByTable,
+			Columns: []string{accountapplication.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountApplication.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountApplicationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountApplicationCreate) OnConflict(opts ...sql.ConflictOption) *AccountApplicationUpsertOne {
+	_c.conflict = opts
+	return &AccountApplicationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountApplication.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountApplicationCreate) OnConflictColumns(columns ...string) *AccountApplicationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountApplicationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccountApplicationUpsertOne is the builder for "upsert"-ing
+	//  one AccountApplication node.
+	AccountApplicationUpsertOne struct {
+		create *AccountApplicationCreate
+	}
+
+	// AccountApplicationUpsert is the "OnConflict" setter.
+	AccountApplicationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccountApplicationUpsert) SetUpdatedAt(v time.Time) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateUpdatedAt() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountApplicationUpsert) SetAccountID(v xid.ID) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateAccountID() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldAccountID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AccountApplicationUpsert) SetStatus(v string) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateStatus() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldStatus)
+	return u
+}
+
+// SetAnswers sets the "answers" field.
+func (u *AccountApplicationUpsert) SetAnswers(v []schema.ApplicationAnswer) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldAnswers, v)
+	return u
+}
+
+// UpdateAnswers sets the "answers" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateAnswers() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldAnswers)
+	return u
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (u *AccountApplicationUpsert) ClearAnswers() *AccountApplicationUpsert {
+	u.SetNull(accountapplication.FieldAnswers)
+	return u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *AccountApplicationUpsert) SetSubmittedAt(v time.Time) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldSubmittedAt, v)
+	return u
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateSubmittedAt() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldSubmittedAt)
+	return u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *AccountApplicationUpsert) ClearSubmittedAt() *AccountApplicationUpsert {
+	u.SetNull(accountapplication.FieldSubmittedAt)
+	return u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (u *AccountApplicationUpsert) SetReviewedByID(v xid.ID) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldReviewedByID, v)
+	return u
+}
+
+// UpdateReviewedByID sets the "reviewed_by_id" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateReviewedByID() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldReviewedByID)
+	return u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (u *AccountApplicationUpsert) ClearReviewedByID() *AccountApplicationUpsert {
+	u.SetNull(accountapplication.FieldReviewedByID)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *AccountApplicationUpsert) SetReviewedAt(v time.Time) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateReviewedAt() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *AccountApplicationUpsert) ClearReviewedAt() *AccountApplicationUpsert {
+	u.SetNull(accountapplication.FieldReviewedAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *AccountApplicationUpsert) SetReason(v string) *AccountApplicationUpsert {
+	u.Set(accountapplication.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AccountApplicationUpsert) UpdateReason() *AccountApplicationUpsert {
+	u.SetExcluded(accountapplication.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *AccountApplicationUpsert) ClearReason() *AccountApplicationUpsert {
+	u.SetNull(accountapplication.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccountApplication.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountapplication.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountApplicationUpsertOne) UpdateNewValues() *AccountApplicationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accountapplication.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accountapplication.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountApplication.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountApplicationUpsertOne) Ignore() *AccountApplicationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountApplicationUpsertOne) DoNothing() *AccountApplicationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountApplicationCreate.OnConflict
+// documentation for more info.
+func (u *AccountApplicationUpsertOne) Update(set func(*AccountApplicationUpsert)) *AccountApplicationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountApplicationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccountApplicationUpsertOne) SetUpdatedAt(v time.Time) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateUpdatedAt() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountApplicationUpsertOne) SetAccountID(v xid.ID) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateAccountID() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AccountApplicationUpsertOne) SetStatus(v string) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateStatus() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAnswers sets the "answers" field.
+func (u *AccountApplicationUpsertOne) SetAnswers(v []schema.ApplicationAnswer) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetAnswers(v)
+	})
+}
+
+// UpdateAnswers sets the "answers" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateAnswers() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateAnswers()
+	})
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (u *AccountApplicationUpsertOne) ClearAnswers() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearAnswers()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *AccountApplicationUpsertOne) SetSubmittedAt(v time.Time) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateSubmittedAt() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *AccountApplicationUpsertOne) ClearSubmittedAt() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (u *AccountApplicationUpsertOne) SetReviewedByID(v xid.ID) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetReviewedByID(v)
+	})
+}
+
+// UpdateReviewedByID sets the "reviewed_by_id" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateReviewedByID() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateReviewedByID()
+	})
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (u *AccountApplicationUpsertOne) ClearReviewedByID() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearReviewedByID()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *AccountApplicationUpsertOne) SetReviewedAt(v time.Time) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateReviewedAt() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *AccountApplicationUpsertOne) ClearReviewedAt() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *AccountApplicationUpsertOne) SetReason(v string) *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AccountApplicationUpsertOne) UpdateReason() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *AccountApplicationUpsertOne) ClearReason() *AccountApplicationUpsertOne {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountApplicationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountApplicationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountApplicationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountApplicationUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AccountApplicationUpsertOne.ID is not supported by MySQL driver. Use AccountApplicationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountApplicationUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountApplicationCreateBulk is the builder for creating many AccountApplication entities in bulk.
+type AccountApplicationCreateBulk struct {
+	config
+	err      error
+	builders []*AccountApplicationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountApplication entities in the database.
+func (_c *AccountApplicationCreateBulk) Save(ctx context.Context) ([]*AccountApplication, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountApplication, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountApplicationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountApplicationCreateBulk) SaveX(ctx context.Context) []*AccountApplication {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountApplicationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountApplicationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountApplication.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountApplicationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountApplicationCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountApplicationUpsertBulk {
+	_c.conflict = opts
+	return &AccountApplicationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountApplication.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountApplicationCreateBulk) OnConflictColumns(columns ...string) *AccountApplicationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountApplicationUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccountApplicationUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountApplication nodes.
+type AccountApplicationUpsertBulk struct {
+	create *AccountApplicationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountApplication.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountapplication.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountApplicationUpsertBulk) UpdateNewValues() *AccountApplicationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accountapplication.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accountapplication.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountApplication.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountApplicationUpsertBulk) Ignore() *AccountApplicationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountApplicationUpsertBulk) DoNothing() *AccountApplicationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountApplicationCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountApplicationUpsertBulk) Update(set func(*AccountApplicationUpsert)) *AccountApplicationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountApplicationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccountApplicationUpsertBulk) SetUpdatedAt(v time.Time) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateUpdatedAt() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountApplicationUpsertBulk) SetAccountID(v xid.ID) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateAccountID() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AccountApplicationUpsertBulk) SetStatus(v string) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateStatus() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAnswers sets the "answers" field.
+func (u *AccountApplicationUpsertBulk) SetAnswers(v []schema.ApplicationAnswer) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetAnswers(v)
+	})
+}
+
+// UpdateAnswers sets the "answers" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateAnswers() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateAnswers()
+	})
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (u *AccountApplicationUpsertBulk) ClearAnswers() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearAnswers()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *AccountApplicationUpsertBulk) SetSubmittedAt(v time.Time) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateSubmittedAt() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *AccountApplicationUpsertBulk) ClearSubmittedAt() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (u *AccountApplicationUpsertBulk) SetReviewedByID(v xid.ID) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetReviewedByID(v)
+	})
+}
+
+// UpdateReviewedByID sets the "reviewed_by_id" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateReviewedByID() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateReviewedByID()
+	})
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (u *AccountApplicationUpsertBulk) ClearReviewedByID() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearReviewedByID()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *AccountApplicationUpsertBulk) SetReviewedAt(v time.Time) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateReviewedAt() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *AccountApplicationUpsertBulk) ClearReviewedAt() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *AccountApplicationUpsertBulk) SetReason(v string) *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AccountApplicationUpsertBulk) UpdateReason() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *AccountApplicationUpsertBulk) ClearReason() *AccountApplicationUpsertBulk {
+	return u.Update(func(s *AccountApplicationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountApplicationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountApplicationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountApplicationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountApplicationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/schema"
+	"github.com/rs/xid"
+)
+
+// AccountApplicationUpdate is the builder for updating AccountApplication entities.
+type AccountApplicationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountApplicationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountApplicationUpdate builder.
+func (_u *AccountApplicationUpdate) Where(ps ...predicate.AccountApplication) *AccountApplicationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountApplicationUpdate) SetUpdatedAt(v time.Time) *AccountApplicationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountApplicationUpdate) SetAccountID(v xid.ID) *AccountApplicationUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountApplicationUpdate) SetNillableAccountID(v *xid.ID) *AccountApplicationUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AccountApplicationUpdate) SetStatus(v string) *AccountApplicationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AccountApplicationUpdate) SetNillableStatus(v *string) *AccountApplicationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAnswers sets the "answers" field.
+func (_u *AccountApplicationUpdate) SetAnswers(v []schema.ApplicationAnswer) *AccountApplicationUpdate {
+	_u.mutation.SetAnswers(v)
+	return _u
+}
+
+// AppendAnswers appends value to the "answers" field.
+func (_u *AccountApplicationUpdate) AppendAnswers(v []schema.ApplicationAnswer) *AccountApplicationUpdate {
+	_u.mutation.AppendAnswers(v)
+	return _u
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (_u *AccountApplicationUpdate) ClearAnswers() *AccountApplicationUpdate {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *AccountApplicationUpdate) SetSubmittedAt(v time.Time) *AccountApplicationUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *AccountApplicationUpdate) SetNillableSubmittedAt(v *time.Time) *AccountApplicationUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *AccountApplicationUpdate) ClearSubmittedAt() *AccountApplicationUpdate {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *AccountApplicationUpdate) SetReviewedByID(v xid.ID) *AccountApplicationUpdate {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *AccountApplicationUpdate) SetNillableReviewedByID(v *xid.ID) *AccountApplicationUpdate {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *AccountApplicationUpdate) ClearReviewedByID() *AccountApplicationUpdate {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *AccountApplicationUpdate) SetReviewedAt(v time.Time) *AccountApplicationUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *AccountApplicationUpdate) SetNillableReviewedAt(v *time.Time) *AccountApplicationUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *AccountApplicationUpdate) ClearReviewedAt() *AccountApplicationUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AccountApplicationUpdate) SetReason(v string) *AccountApplicationUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AccountApplicationUpdate) SetNillableReason(v *string) *AccountApplicationUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AccountApplicationUpdate) ClearReason() *AccountApplicationUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountApplicationUpdate) SetAccount(v *Account) *AccountApplicationUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_u *AccountApplicationUpdate) SetReviewedBy(v *Account) *AccountApplicationUpdate {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the AccountApplicationMutation object of the builder.
+func (_u *AccountApplicationUpdate) Mutation() *AccountApplicationMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountApplicationUpdate) ClearAccount() *AccountApplicationUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the Account entity.
+func (_u *AccountApplicationUpdate) ClearReviewedBy() *AccountApplicationUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountApplicationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountApplicationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountApplicationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountApplicationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountApplicationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := accountapplication.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountApplicationUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountApplication.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountApplicationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountApplicationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountApplicationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountapplication.Table, accountapplication.Columns, sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountapplication.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(accountapplication.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answers(); ok {
+		_spec.SetField(accountapplication.FieldAnswers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accountapplication.FieldAnswers, value)
+		})
+	}
+	if _u.mutation.AnswersCleared() {
+		_spec.ClearField(accountapplication.FieldAnswers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(accountapplication.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(accountapplication.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(accountapplication.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(accountapplication.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(accountapplication.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(accountapplication.FieldReason, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   accountapplication.AccountTable,
+			Columns: []string{accountapplication.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   accountapplication.AccountTable,
+			Columns: []string{accountapplication.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountapplication.ReviewedByTable,
+			Columns: []string{accountapplication.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountapplication.ReviewedByTable,
+			Columns: []string{accountapplication.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountapplication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountApplicationUpdateOne is the builder for updating a single AccountApplication entity.
+type AccountApplicationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountApplicationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountApplicationUpdateOne) SetUpdatedAt(v time.Time) *AccountApplicationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountApplicationUpdateOne) SetAccountID(v xid.ID) *AccountApplicationUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountApplicationUpdateOne) SetNillableAccountID(v *xid.ID) *AccountApplicationUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AccountApplicationUpdateOne) SetStatus(v string) *AccountApplicationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AccountApplicationUpdateOne) SetNillableStatus(v *string) *AccountApplicationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAnswers sets the "answers" field.
+func (_u *AccountApplicationUpdateOne) SetAnswers(v []schema.ApplicationAnswer) *AccountApplicationUpdateOne {
+	_u.mutation.SetAnswers(v)
+	return _u
+}
+
+// AppendAnswers appends value to the "answers" field.
+func (_u *AccountApplicationUpdateOne) AppendAnswers(v []schema.ApplicationAnswer) *AccountApplicationUpdateOne {
+	_u.mutation.AppendAnswers(v)
+	return _u
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (_u *AccountApplicationUpdateOne) ClearAnswers() *AccountApplicationUpdateOne {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *AccountApplicationUpdateOne) SetSubmittedAt(v time.Time) *AccountApplicationUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *AccountApplicationUpdateOne) SetNillableSubmittedAt(v *time.Time) *AccountApplicationUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *AccountApplicationUpdateOne) ClearSubmittedAt() *AccountApplicationUpdateOne {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *AccountApplicationUpdateOne) SetReviewedByID(v xid.ID) *AccountApplicationUpdateOne {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *AccountApplicationUpdateOne) SetNillableReviewedByID(v *xid.ID) *AccountApplicationUpdateOne {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *AccountApplicationUpdateOne) ClearReviewedByID() *AccountApplicationUpdateOne {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *AccountApplicationUpdateOne) SetReviewedAt(v time.Time) *AccountApplicationUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *AccountApplicationUpdateOne) SetNillableReviewedAt(v *time.Time) *AccountApplicationUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *AccountApplicationUpdateOne) ClearReviewedAt() *AccountApplicationUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AccountApplicationUpdateOne) SetReason(v string) *AccountApplicationUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AccountApplicationUpdateOne) SetNillableReason(v *string) *AccountApplicationUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AccountApplicationUpdateOne) ClearReason() *AccountApplicationUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountApplicationUpdateOne) SetAccount(v *Account) *AccountApplicationUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_u *AccountApplicationUpdateOne) SetReviewedBy(v *Account) *AccountApplicationUpdateOne {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the AccountApplicationMutation object of the builder.
+func (_u *AccountApplicationUpdateOne) Mutation() *AccountApplicationMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountApplicationUpdateOne) ClearAccount() *AccountApplicationUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the Account entity.
+func (_u *AccountApplicationUpdateOne) ClearReviewedBy() *AccountApplicationUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Where appends a list predicates to the AccountApplicationUpdate builder.
+func (_u *AccountApplicationUpdateOne) Where(ps ...predicate.AccountApplication) *AccountApplicationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountApplicationUpdateOne) Select(field string, fields ...string) *AccountApplicationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountApplication entity.
+func (_u *AccountApplicationUpdateOne) Save(ctx context.Context) (*AccountApplication, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountApplicationUpdateOne) SaveX(ctx context.Context) *AccountApplication {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountApplicationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountApplicationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountApplicationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := accountapplication.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountApplicationUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountApplication.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountApplicationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountApplicationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountApplicationUpdateOne) sqlSave(ctx context.Context) (_node *AccountApplication, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountapplication.Table, accountapplication.Columns, sqlgraph.NewFieldSpec(accountapplication.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountApplication.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountapplication.FieldID)
+		for _, f := range fields {
+			if !accountapplication.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountapplication.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountapplication.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(accountapplication.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answers(); ok {
+		_spec.SetField(accountapplication.FieldAnswers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accountapplication.FieldAnswers, value)
+		})
+	}
+	if _u.mutation.AnswersCleared() {
+		_spec.ClearField(accountapplication.FieldAnswers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(accountapplication.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(accountapplication.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(accountapplication.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(accountapplication.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(accountapplication.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(accountapplication.FieldReason, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   accountapplication.AccountTable,
+			Columns: []string{accountapplication.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   accountapplication.AccountTable,
+			Columns: []string{accountapplication.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountapplication.ReviewedByTable,
+			Columns: []string{accountapplication.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountapplication.ReviewedByTable,
+			Columns: []string{accountapplication.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AccountApplication{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountapplication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
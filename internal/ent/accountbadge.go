@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/rs/xid"
+)
+
+// AccountBadge is the model entity for the AccountBadge schema.
+type AccountBadge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// BadgeID holds the value of the "badge_id" field.
+	BadgeID xid.ID `json:"badge_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountBadgeQuery when eager-loading is set.
+	Edges        AccountBadgeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountBadgeEdges holds the relations/edges for other nodes in the graph.
+type AccountBadgeEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Badge holds the value of the badge edge.
+	Badge *Badge `json:"badge,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountBadgeEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// BadgeOrErr returns the Badge value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountBadgeEdges) BadgeOrErr() (*Badge, error) {
+	if e.Badge != nil {
+		return e.Badge, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: badge.Label}
+	}
+	return nil, &NotLoadedError{edge: "badge"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountBadge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountbadge.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case accountbadge.FieldID, accountbadge.FieldAccountID, accountbadge.FieldBadgeID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountBadge fields.
+func (_m *AccountBadge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountbadge.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case accountbadge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case accountbadge.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case accountbadge.FieldBadgeID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field badge_id", values[i])
+			} else if value != nil {
+				_m.BadgeID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountBadge.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountBadge) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the AccountBadge entity.
+func (_m *AccountBadge) QueryAccount() *AccountQuery {
+	return NewAccountBadgeClient(_m.config).QueryAccount(_m)
+}
+
+// QueryBadge queries the "badge" edge of the AccountBadge entity.
+func (_m *AccountBadge) QueryBadge() *BadgeQuery {
+	return NewAccountBadgeClient(_m.config).QueryBadge(_m)
+}
+
+// Update returns a builder for updating this AccountBadge.
+// Note that you need to call AccountBadge.Unwrap() before calling this method if this AccountBadge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountBadge) Update() *AccountBadgeUpdateOne {
+	return NewAccountBadgeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountBadge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountBadge) Unwrap() *AccountBadge {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountBadge is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountBadge) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountBadge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("badge_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BadgeID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountBadges is a parsable slice of AccountBadge.
+type AccountBadges []*AccountBadge
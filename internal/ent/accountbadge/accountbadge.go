@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountbadge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the accountbadge type in the database.
+	Label = "account_badge"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldBadgeID holds the string denoting the badge_id field in the database.
+	FieldBadgeID = "badge_id"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeBadge holds the string denoting the badge edge name in mutations.
+	EdgeBadge = "badge"
+	// Table holds the table name of the accountbadge in the database.
+	Table = "account_badges"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "account_badges"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// BadgeTable is the table that holds the badge relation/edge.
+	BadgeTable = "account_badges"
+	// BadgeInverseTable is the table name for the Badge entity.
+	// It exists in this package in order to avoid circular dependency with the "badge" package.
+	BadgeInverseTable = "badges"
+	// BadgeColumn is the table column denoting the badge relation/edge.
+	BadgeColumn = "badge_id"
+)
+
+// Columns holds all SQL columns for accountbadge fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldAccountID,
+	FieldBadgeID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the AccountBadge queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByBadgeID orders the results by the badge_id field.
+func ByBadgeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBadgeID, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBadgeField orders the results by badge field.
+func ByBadgeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBadgeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newBadgeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BadgeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BadgeTable, BadgeColumn),
+	)
+}
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountbadge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldAccountID, v))
+}
+
+// BadgeID applies equality check predicate on the "badge_id" field. It's identical to BadgeIDEQ.
+func BadgeID(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldBadgeID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// BadgeIDEQ applies the EQ predicate on the "badge_id" field.
+func BadgeIDEQ(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldEQ(FieldBadgeID, v))
+}
+
+// BadgeIDNEQ applies the NEQ predicate on the "badge_id" field.
+func BadgeIDNEQ(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNEQ(FieldBadgeID, v))
+}
+
+// BadgeIDIn applies the In predicate on the "badge_id" field.
+func BadgeIDIn(vs ...xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldIn(FieldBadgeID, vs...))
+}
+
+// BadgeIDNotIn applies the NotIn predicate on the "badge_id" field.
+func BadgeIDNotIn(vs ...xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldNotIn(FieldBadgeID, vs...))
+}
+
+// BadgeIDGT applies the GT predicate on the "badge_id" field.
+func BadgeIDGT(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGT(FieldBadgeID, v))
+}
+
+// BadgeIDGTE applies the GTE predicate on the "badge_id" field.
+func BadgeIDGTE(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldGTE(FieldBadgeID, v))
+}
+
+// BadgeIDLT applies the LT predicate on the "badge_id" field.
+func BadgeIDLT(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLT(FieldBadgeID, v))
+}
+
+// BadgeIDLTE applies the LTE predicate on the "badge_id" field.
+func BadgeIDLTE(v xid.ID) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.FieldLTE(FieldBadgeID, v))
+}
+
+// BadgeIDContains applies the Contains predicate on the "badge_id" field.
+func BadgeIDContains(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldContains(FieldBadgeID, vc))
+}
+
+// BadgeIDHasPrefix applies the HasPrefix predicate on the "badge_id" field.
+func BadgeIDHasPrefix(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldHasPrefix(FieldBadgeID, vc))
+}
+
+// BadgeIDHasSuffix applies the HasSuffix predicate on the "badge_id" field.
+func BadgeIDHasSuffix(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldHasSuffix(FieldBadgeID, vc))
+}
+
+// BadgeIDEqualFold applies the EqualFold predicate on the "badge_id" field.
+func BadgeIDEqualFold(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldEqualFold(FieldBadgeID, vc))
+}
+
+// BadgeIDContainsFold applies the ContainsFold predicate on the "badge_id" field.
+func BadgeIDContainsFold(v xid.ID) predicate.AccountBadge {
+	vc := v.String()
+	return predicate.AccountBadge(sql.FieldContainsFold(FieldBadgeID, vc))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.AccountBadge {
+	return predicate.AccountBadge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.AccountBadge {
+	return predicate.AccountBadge(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBadge applies the HasEdge predicate on the "badge" edge.
+func HasBadge() predicate.AccountBadge {
+	return predicate.AccountBadge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BadgeTable, BadgeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBadgeWith applies the HasEdge predicate on the "badge" edge with a given conditions (other predicates).
+func HasBadgeWith(preds ...predicate.Badge) predicate.AccountBadge {
+	return predicate.AccountBadge(func(s *sql.Selector) {
+		step := newBadgeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountBadge) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountBadge) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountBadge) predicate.AccountBadge {
+	return predicate.AccountBadge(sql.NotPredicates(p))
+}
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/rs/xid"
+)
+
+// AccountBadgeCreate is the builder for creating a AccountBadge entity.
+type AccountBadgeCreate struct {
+	config
+	mutation *AccountBadgeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountBadgeCreate) SetCreatedAt(v time.Time) *AccountBadgeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountBadgeCreate) SetNillableCreatedAt(v *time.Time) *AccountBadgeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *AccountBadgeCreate) SetAccountID(v xid.ID) *AccountBadgeCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (_c *AccountBadgeCreate) SetBadgeID(v xid.ID) *AccountBadgeCreate {
+	_c.mutation.SetBadgeID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountBadgeCreate) SetID(v xid.ID) *AccountBadgeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AccountBadgeCreate) SetNillableID(v *xid.ID) *AccountBadgeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *AccountBadgeCreate) SetAccount(v *Account) *AccountBadgeCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetBadge sets the "badge" edge to the Badge entity.
+func (_c *AccountBadgeCreate) SetBadge(v *Badge) *AccountBadgeCreate {
+	return _c.SetBadgeID(v.ID)
+}
+
+// Mutation returns the AccountBadgeMutation object of the builder.
+func (_c *AccountBadgeCreate) Mutation() *AccountBadgeMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountBadge in the database.
+func (_c *AccountBadgeCreate) Save(ctx context.Context) (*AccountBadge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountBadgeCreate) SaveX(ctx context.Context) *AccountBadge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountBadgeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountBadgeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountBadgeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountbadge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := accountbadge.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountBadgeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountBadge.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "AccountBadge.account_id"`)}
+	}
+	if _, ok := _c.mutation.BadgeID(); !ok {
+		return &ValidationError{Name: "badge_id", err: errors.New(`ent: missing required field "AccountBadge.badge_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accountbadge.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AccountBadge.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "AccountBadge.account"`)}
+	}
+	if len(_c.mutation.BadgeIDs()) == 0 {
+		return &ValidationError{Name: "badge", err: errors.New(`ent: missing required edge "AccountBadge.badge"`)}
+	}
+	return nil
+}
+
+func (_c *AccountBadgeCreate) sqlSave(ctx context.Context) (*AccountBadge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountBadgeCreate) createSpec() (*AccountBadge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountBadge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountbadge.Table, sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountbadge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.AccountTable,
+			Columns: []string{accountbadge.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BadgeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.BadgeTable,
+			Columns: []string{accountbadge.BadgeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(badge.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BadgeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountBadge.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountBadgeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountBadgeCreate) OnConflict(opts ...sql.ConflictOption) *AccountBadgeUpsertOne {
+	_c.conflict = opts
+	return &AccountBadgeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountBadge.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountBadgeCreate) OnConflictColumns(columns ...string) *AccountBadgeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountBadgeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccountBadgeUpsertOne is the builder for "upsert"-ing
+	//  one AccountBadge node.
+	AccountBadgeUpsertOne struct {
+		create *AccountBadgeCreate
+	}
+
+	// AccountBadgeUpsert is the "OnConflict" setter.
+	AccountBadgeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountBadgeUpsert) SetAccountID(v xid.ID) *AccountBadgeUpsert {
+	u.Set(accountbadge.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountBadgeUpsert) UpdateAccountID() *AccountBadgeUpsert {
+	u.SetExcluded(accountbadge.FieldAccountID)
+	return u
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (u *AccountBadgeUpsert) SetBadgeID(v xid.ID) *AccountBadgeUpsert {
+	u.Set(accountbadge.FieldBadgeID, v)
+	return u
+}
+
+// UpdateBadgeID sets the "badge_id" field to the value that was provided on create.
+func (u *AccountBadgeUpsert) UpdateBadgeID() *AccountBadgeUpsert {
+	u.SetExcluded(accountbadge.FieldBadgeID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccountBadge.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountbadge.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountBadgeUpsertOne) UpdateNewValues() *AccountBadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accountbadge.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accountbadge.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountBadge.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountBadgeUpsertOne) Ignore() *AccountBadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountBadgeUpsertOne) DoNothing() *AccountBadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountBadgeCreate.OnConflict
+// documentation for more info.
+func (u *AccountBadgeUpsertOne) Update(set func(*AccountBadgeUpsert)) *AccountBadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountBadgeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountBadgeUpsertOne) SetAccountID(v xid.ID) *AccountBadgeUpsertOne {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountBadgeUpsertOne) UpdateAccountID() *AccountBadgeUpsertOne {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (u *AccountBadgeUpsertOne) SetBadgeID(v xid.ID) *AccountBadgeUpsertOne {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.SetBadgeID(v)
+	})
+}
+
+// UpdateBadgeID sets the "badge_id" field to the value that was provided on create.
+func (u *AccountBadgeUpsertOne) UpdateBadgeID() *AccountBadgeUpsertOne {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.UpdateBadgeID()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountBadgeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountBadgeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountBadgeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountBadgeUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AccountBadgeUpsertOne.ID is not supported by MySQL driver. Use AccountBadgeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountBadgeUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountBadgeCreateBulk is the builder for creating many AccountBadge entities in bulk.
+type AccountBadgeCreateBulk struct {
+	config
+	err      error
+	builders []*AccountBadgeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountBadge entities in the database.
+func (_c *AccountBadgeCreateBulk) Save(ctx context.Context) ([]*AccountBadge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountBadge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountBadgeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountBadgeCreateBulk) SaveX(ctx context.Context) []*AccountBadge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountBadgeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountBadgeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountBadge.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountBadgeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountBadgeCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountBadgeUpsertBulk {
+	_c.conflict = opts
+	return &AccountBadgeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountBadge.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountBadgeCreateBulk) OnConflictColumns(columns ...string) *AccountBadgeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountBadgeUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccountBadgeUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountBadge nodes.
+type AccountBadgeUpsertBulk struct {
+	create *AccountBadgeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountBadge.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountbadge.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountBadgeUpsertBulk) UpdateNewValues() *AccountBadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accountbadge.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accountbadge.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountBadge.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountBadgeUpsertBulk) Ignore() *AccountBadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountBadgeUpsertBulk) DoNothing() *AccountBadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountBadgeCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountBadgeUpsertBulk) Update(set func(*AccountBadgeUpsert)) *AccountBadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountBadgeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountBadgeUpsertBulk) SetAccountID(v xid.ID) *AccountBadgeUpsertBulk {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountBadgeUpsertBulk) UpdateAccountID() *AccountBadgeUpsertBulk {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (u *AccountBadgeUpsertBulk) SetBadgeID(v xid.ID) *AccountBadgeUpsertBulk {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.SetBadgeID(v)
+	})
+}
+
+// UpdateBadgeID sets the "badge_id" field to the value that was provided on create.
+func (u *AccountBadgeUpsertBulk) UpdateBadgeID() *AccountBadgeUpsertBulk {
+	return u.Update(func(s *AccountBadgeUpsert) {
+		s.UpdateBadgeID()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountBadgeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountBadgeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountBadgeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountBadgeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
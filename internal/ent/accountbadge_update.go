@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// AccountBadgeUpdate is the builder for updating AccountBadge entities.
+type AccountBadgeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountBadgeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountBadgeUpdate builder.
+func (_u *AccountBadgeUpdate) Where(ps ...predicate.AccountBadge) *AccountBadgeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountBadgeUpdate) SetAccountID(v xid.ID) *AccountBadgeUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountBadgeUpdate) SetNillableAccountID(v *xid.ID) *AccountBadgeUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (_u *AccountBadgeUpdate) SetBadgeID(v xid.ID) *AccountBadgeUpdate {
+	_u.mutation.SetBadgeID(v)
+	return _u
+}
+
+// SetNillableBadgeID sets the "badge_id" field if the given value is not nil.
+func (_u *AccountBadgeUpdate) SetNillableBadgeID(v *xid.ID) *AccountBadgeUpdate {
+	if v != nil {
+		_u.SetBadgeID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountBadgeUpdate) SetAccount(v *Account) *AccountBadgeUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetBadge sets the "badge" edge to the Badge entity.
+func (_u *AccountBadgeUpdate) SetBadge(v *Badge) *AccountBadgeUpdate {
+	return _u.SetBadgeID(v.ID)
+}
+
+// Mutation returns the AccountBadgeMutation object of the builder.
+func (_u *AccountBadgeUpdate) Mutation() *AccountBadgeMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountBadgeUpdate) ClearAccount() *AccountBadgeUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearBadge clears the "badge" edge to the Badge entity.
+func (_u *AccountBadgeUpdate) ClearBadge() *AccountBadgeUpdate {
+	_u.mutation.ClearBadge()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountBadgeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountBadgeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountBadgeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountBadgeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountBadgeUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBadge.account"`)
+	}
+	if _u.mutation.BadgeCleared() && len(_u.mutation.BadgeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBadge.badge"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountBadgeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountBadgeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountBadgeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountbadge.Table, accountbadge.Columns, sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.AccountTable,
+			Columns: []string{accountbadge.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.AccountTable,
+			Columns: []string{accountbadge.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BadgeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.BadgeTable,
+			Columns: []string{accountbadge.BadgeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(badge.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BadgeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.BadgeTable,
+			Columns: []string{accountbadge.BadgeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(badge.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountbadge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountBadgeUpdateOne is the builder for updating a single AccountBadge entity.
+type AccountBadgeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountBadgeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountBadgeUpdateOne) SetAccountID(v xid.ID) *AccountBadgeUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountBadgeUpdateOne) SetNillableAccountID(v *xid.ID) *AccountBadgeUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (_u *AccountBadgeUpdateOne) SetBadgeID(v xid.ID) *AccountBadgeUpdateOne {
+	_u.mutation.SetBadgeID(v)
+	return _u
+}
+
+// SetNillableBadgeID sets the "badge_id" field if the given value is not nil.
+func (_u *AccountBadgeUpdateOne) SetNillableBadgeID(v *xid.ID) *AccountBadgeUpdateOne {
+	if v != nil {
+		_u.SetBadgeID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountBadgeUpdateOne) SetAccount(v *Account) *AccountBadgeUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetBadge sets the "badge" edge to the Badge entity.
+func (_u *AccountBadgeUpdateOne) SetBadge(v *Badge) *AccountBadgeUpdateOne {
+	return _u.SetBadgeID(v.ID)
+}
+
+// Mutation returns the AccountBadgeMutation object of the builder.
+func (_u *AccountBadgeUpdateOne) Mutation() *AccountBadgeMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountBadgeUpdateOne) ClearAccount() *AccountBadgeUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearBadge clears the "badge" edge to the Badge entity.
+func (_u *AccountBadgeUpdateOne) ClearBadge() *AccountBadgeUpdateOne {
+	_u.mutation.ClearBadge()
+	return _u
+}
+
+// Where appends a list predicates to the AccountBadgeUpdate builder.
+func (_u *AccountBadgeUpdateOne) Where(ps ...predicate.AccountBadge) *AccountBadgeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountBadgeUpdateOne) Select(field string, fields ...string) *AccountBadgeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountBadge entity.
+func (_u *AccountBadgeUpdateOne) Save(ctx context.Context) (*AccountBadge, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountBadgeUpdateOne) SaveX(ctx context.Context) *AccountBadge {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountBadgeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountBadgeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountBadgeUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBadge.account"`)
+	}
+	if _u.mutation.BadgeCleared() && len(_u.mutation.BadgeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBadge.badge"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountBadgeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountBadgeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountBadgeUpdateOne) sqlSave(ctx context.Context) (_node *AccountBadge, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountbadge.Table, accountbadge.Columns, sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountBadge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountbadge.FieldID)
+		for _, f := range fields {
+			if !accountbadge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountbadge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.AccountTable,
+			Columns: []string{accountbadge.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.AccountTable,
+			Columns: []string{accountbadge.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BadgeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.BadgeTable,
+			Columns: []string{accountbadge.BadgeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(badge.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BadgeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountbadge.BadgeTable,
+			Columns: []string{accountbadge.BadgeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(badge.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AccountBadge{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountbadge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
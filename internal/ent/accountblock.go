@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+	"github.com/rs/xid"
+)
+
+// AccountBlock is the model entity for the AccountBlock schema.
+type AccountBlock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// TargetAccountID holds the value of the "target_account_id" field.
+	TargetAccountID xid.ID `json:"target_account_id,omitempty"`
+	// Either block or mute, a member has at most one relationship with another.
+	Kind string `json:"kind,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountBlockQuery when eager-loading is set.
+	Edges        AccountBlockEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountBlockEdges holds the relations/edges for other nodes in the graph.
+type AccountBlockEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Target holds the value of the target edge.
+	Target *Account `json:"target,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountBlockEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountBlockEdges) TargetOrErr() (*Account, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountBlock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountblock.FieldKind:
+			values[i] = new(sql.NullString)
+		case accountblock.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case accountblock.FieldID, accountblock.FieldAccountID, accountblock.FieldTargetAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountBlock fields.
+func (_m *AccountBlock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountblock.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case accountblock.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case accountblock.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case accountblock.FieldTargetAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_account_id", values[i])
+			} else if value != nil {
+				_m.TargetAccountID = *value
+			}
+		case accountblock.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountBlock.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountBlock) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the AccountBlock entity.
+func (_m *AccountBlock) QueryAccount() *AccountQuery {
+	return NewAccountBlockClient(_m.config).QueryAccount(_m)
+}
+
+// QueryTarget queries the "target" edge of the AccountBlock entity.
+func (_m *AccountBlock) QueryTarget() *AccountQuery {
+	return NewAccountBlockClient(_m.config).QueryTarget(_m)
+}
+
+// Update returns a builder for updating this AccountBlock.
+// Note that you need to call AccountBlock.Unwrap() before calling this method if this AccountBlock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountBlock) Update() *AccountBlockUpdateOne {
+	return NewAccountBlockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountBlock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountBlock) Unwrap() *AccountBlock {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountBlock is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountBlock) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountBlock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("target_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetAccountID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountBlocks is a parsable slice of AccountBlock.
+type AccountBlocks []*AccountBlock
@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountblock
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldAccountID, v))
+}
+
+// TargetAccountID applies equality check predicate on the "target_account_id" field. It's identical to TargetAccountIDEQ.
+func TargetAccountID(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldTargetAccountID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldKind, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// TargetAccountIDEQ applies the EQ predicate on the "target_account_id" field.
+func TargetAccountIDEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldTargetAccountID, v))
+}
+
+// TargetAccountIDNEQ applies the NEQ predicate on the "target_account_id" field.
+func TargetAccountIDNEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldTargetAccountID, v))
+}
+
+// TargetAccountIDIn applies the In predicate on the "target_account_id" field.
+func TargetAccountIDIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldTargetAccountID, vs...))
+}
+
+// TargetAccountIDNotIn applies the NotIn predicate on the "target_account_id" field.
+func TargetAccountIDNotIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldTargetAccountID, vs...))
+}
+
+// TargetAccountIDGT applies the GT predicate on the "target_account_id" field.
+func TargetAccountIDGT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldTargetAccountID, v))
+}
+
+// TargetAccountIDGTE applies the GTE predicate on the "target_account_id" field.
+func TargetAccountIDGTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldTargetAccountID, v))
+}
+
+// TargetAccountIDLT applies the LT predicate on the "target_account_id" field.
+func TargetAccountIDLT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldTargetAccountID, v))
+}
+
+// TargetAccountIDLTE applies the LTE predicate on the "target_account_id" field.
+func TargetAccountIDLTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldTargetAccountID, v))
+}
+
+// TargetAccountIDContains applies the Contains predicate on the "target_account_id" field.
+func TargetAccountIDContains(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContains(FieldTargetAccountID, vc))
+}
+
+// TargetAccountIDHasPrefix applies the HasPrefix predicate on the "target_account_id" field.
+func TargetAccountIDHasPrefix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasPrefix(FieldTargetAccountID, vc))
+}
+
+// TargetAccountIDHasSuffix applies the HasSuffix predicate on the "target_account_id" field.
+func TargetAccountIDHasSuffix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasSuffix(FieldTargetAccountID, vc))
+}
+
+// TargetAccountIDEqualFold applies the EqualFold predicate on the "target_account_id" field.
+func TargetAccountIDEqualFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldEqualFold(FieldTargetAccountID, vc))
+}
+
+// TargetAccountIDContainsFold applies the ContainsFold predicate on the "target_account_id" field.
+func TargetAccountIDContainsFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContainsFold(FieldTargetAccountID, vc))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldContainsFold(FieldKind, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTarget applies the HasEdge predicate on the "target" edge.
+func HasTarget() predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TargetTable, TargetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetWith applies the HasEdge predicate on the "target" edge with a given conditions (other predicates).
+func HasTargetWith(preds ...predicate.Account) predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := newTargetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountBlock) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountBlock) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountBlock) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.NotPredicates(p))
+}
@@ -0,0 +1,700 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+	"github.com/rs/xid"
+)
+
+// AccountBlockCreate is the builder for creating a AccountBlock entity.
+type AccountBlockCreate struct {
+	config
+	mutation *AccountBlockMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountBlockCreate) SetCreatedAt(v time.Time) *AccountBlockCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountBlockCreate) SetNillableCreatedAt(v *time.Time) *AccountBlockCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *AccountBlockCreate) SetAccountID(v xid.ID) *AccountBlockCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (_c *AccountBlockCreate) SetTargetAccountID(v xid.ID) *AccountBlockCreate {
+	_c.mutation.SetTargetAccountID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *AccountBlockCreate) SetKind(v string) *AccountBlockCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountBlockCreate) SetID(v xid.ID) *AccountBlockCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AccountBlockCreate) SetNillableID(v *xid.ID) *AccountBlockCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *AccountBlockCreate) SetAccount(v *Account) *AccountBlockCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetTargetID sets the "target" edge to the Account entity by ID.
+func (_c *AccountBlockCreate) SetTargetID(id xid.ID) *AccountBlockCreate {
+	_c.mutation.SetTargetID(id)
+	return _c
+}
+
+// SetTarget sets the "target" edge to the Account entity.
+func (_c *AccountBlockCreate) SetTarget(v *Account) *AccountBlockCreate {
+	return _c.SetTargetID(v.ID)
+}
+
+// Mutation returns the AccountBlockMutation object of the builder.
+func (_c *AccountBlockCreate) Mutation() *AccountBlockMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountBlock in the database.
+func (_c *AccountBlockCreate) Save(ctx context.Context) (*AccountBlock, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountBlockCreate) SaveX(ctx context.Context) *AccountBlock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountBlockCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountBlockCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountBlockCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountblock.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := accountblock.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountBlockCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountBlock.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "AccountBlock.account_id"`)}
+	}
+	if _, ok := _c.mutation.TargetAccountID(); !ok {
+		return &ValidationError{Name: "target_account_id", err: errors.New(`ent: missing required field "AccountBlock.target_account_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "AccountBlock.kind"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accountblock.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AccountBlock.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "AccountBlock.account"`)}
+	}
+	if len(_c.mutation.TargetIDs()) == 0 {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required edge "AccountBlock.target"`)}
+	}
+	return nil
+}
+
+func (_c *AccountBlockCreate) sqlSave(ctx context.Context) (*AccountBlock, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountBlockCreate) createSpec() (*AccountBlock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountBlock{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountblock.Table, sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountblock.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(accountblock.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.AccountTable,
+			Columns: []string{accountblock.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.TargetTable,
+			Columns: []string{accountblock.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetAccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountBlock.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountBlockUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountBlockCreate) OnConflict(opts ...sql.ConflictOption) *AccountBlockUpsertOne {
+	_c.conflict = opts
+	return &AccountBlockUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountBlock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountBlockCreate) OnConflictColumns(columns ...string) *AccountBlockUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountBlockUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccountBlockUpsertOne is the builder for "upsert"-ing
+	//  one AccountBlock node.
+	AccountBlockUpsertOne struct {
+		create *AccountBlockCreate
+	}
+
+	// AccountBlockUpsert is the "OnConflict" setter.
+	AccountBlockUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountBlockUpsert) SetAccountID(v xid.ID) *AccountBlockUpsert {
+	u.Set(accountblock.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountBlockUpsert) UpdateAccountID() *AccountBlockUpsert {
+	u.SetExcluded(accountblock.FieldAccountID)
+	return u
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (u *AccountBlockUpsert) SetTargetAccountID(v xid.ID) *AccountBlockUpsert {
+	u.Set(accountblock.FieldTargetAccountID, v)
+	return u
+}
+
+// UpdateTargetAccountID sets the "target_account_id" field to the value that was provided on create.
+func (u *AccountBlockUpsert) UpdateTargetAccountID() *AccountBlockUpsert {
+	u.SetExcluded(accountblock.FieldTargetAccountID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *AccountBlockUpsert) SetKind(v string) *AccountBlockUpsert {
+	u.Set(accountblock.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AccountBlockUpsert) UpdateKind() *AccountBlockUpsert {
+	u.SetExcluded(accountblock.FieldKind)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccountBlock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountblock.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountBlockUpsertOne) UpdateNewValues() *AccountBlockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accountblock.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accountblock.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountBlock.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountBlockUpsertOne) Ignore() *AccountBlockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountBlockUpsertOne) DoNothing() *AccountBlockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountBlockCreate.OnConflict
+// documentation for more info.
+func (u *AccountBlockUpsertOne) Update(set func(*AccountBlockUpsert)) *AccountBlockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountBlockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountBlockUpsertOne) SetAccountID(v xid.ID) *AccountBlockUpsertOne {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountBlockUpsertOne) UpdateAccountID() *AccountBlockUpsertOne {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (u *AccountBlockUpsertOne) SetTargetAccountID(v xid.ID) *AccountBlockUpsertOne {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.SetTargetAccountID(v)
+	})
+}
+
+// UpdateTargetAccountID sets the "target_account_id" field to the value that was provided on create.
+func (u *AccountBlockUpsertOne) UpdateTargetAccountID() *AccountBlockUpsertOne {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.UpdateTargetAccountID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AccountBlockUpsertOne) SetKind(v string) *AccountBlockUpsertOne {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AccountBlockUpsertOne) UpdateKind() *AccountBlockUpsertOne {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountBlockUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountBlockCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountBlockUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountBlockUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AccountBlockUpsertOne.ID is not supported by MySQL driver. Use AccountBlockUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountBlockUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountBlockCreateBulk is the builder for creating many AccountBlock entities in bulk.
+type AccountBlockCreateBulk struct {
+	config
+	err      error
+	builders []*AccountBlockCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountBlock entities in the database.
+func (_c *AccountBlockCreateBulk) Save(ctx context.Context) ([]*AccountBlock, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountBlock, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountBlockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountBlockCreateBulk) SaveX(ctx context.Context) []*AccountBlock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountBlockCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountBlockCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountBlock.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountBlockUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountBlockCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountBlockUpsertBulk {
+	_c.conflict = opts
+	return &AccountBlockUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountBlock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountBlockCreateBulk) OnConflictColumns(columns ...string) *AccountBlockUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountBlockUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccountBlockUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountBlock nodes.
+type AccountBlockUpsertBulk struct {
+	create *AccountBlockCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountBlock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountblock.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountBlockUpsertBulk) UpdateNewValues() *AccountBlockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accountblock.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accountblock.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountBlock.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountBlockUpsertBulk) Ignore() *AccountBlockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountBlockUpsertBulk) DoNothing() *AccountBlockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountBlockCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountBlockUpsertBulk) Update(set func(*AccountBlockUpsert)) *AccountBlockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountBlockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountBlockUpsertBulk) SetAccountID(v xid.ID) *AccountBlockUpsertBulk {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountBlockUpsertBulk) UpdateAccountID() *AccountBlockUpsertBulk {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (u *AccountBlockUpsertBulk) SetTargetAccountID(v xid.ID) *AccountBlockUpsertBulk {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.SetTargetAccountID(v)
+	})
+}
+
+// UpdateTargetAccountID sets the "target_account_id" field to the value that was provided on create.
+func (u *AccountBlockUpsertBulk) UpdateTargetAccountID() *AccountBlockUpsertBulk {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.UpdateTargetAccountID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AccountBlockUpsertBulk) SetKind(v string) *AccountBlockUpsertBulk {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AccountBlockUpsertBulk) UpdateKind() *AccountBlockUpsertBulk {
+	return u.Update(func(s *AccountBlockUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountBlockUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountBlockCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountBlockCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountBlockUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// AccountBlockUpdate is the builder for updating AccountBlock entities.
+type AccountBlockUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountBlockMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountBlockUpdate builder.
+func (_u *AccountBlockUpdate) Where(ps ...predicate.AccountBlock) *AccountBlockUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountBlockUpdate) SetAccountID(v xid.ID) *AccountBlockUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountBlockUpdate) SetNillableAccountID(v *xid.ID) *AccountBlockUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (_u *AccountBlockUpdate) SetTargetAccountID(v xid.ID) *AccountBlockUpdate {
+	_u.mutation.SetTargetAccountID(v)
+	return _u
+}
+
+// SetNillableTargetAccountID sets the "target_account_id" field if the given value is not nil.
+func (_u *AccountBlockUpdate) SetNillableTargetAccountID(v *xid.ID) *AccountBlockUpdate {
+	if v != nil {
+		_u.SetTargetAccountID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AccountBlockUpdate) SetKind(v string) *AccountBlockUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AccountBlockUpdate) SetNillableKind(v *string) *AccountBlockUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountBlockUpdate) SetAccount(v *Account) *AccountBlockUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetTargetID sets the "target" edge to the Account entity by ID.
+func (_u *AccountBlockUpdate) SetTargetID(id xid.ID) *AccountBlockUpdate {
+	_u.mutation.SetTargetID(id)
+	return _u
+}
+
+// SetTarget sets the "target" edge to the Account entity.
+func (_u *AccountBlockUpdate) SetTarget(v *Account) *AccountBlockUpdate {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the AccountBlockMutation object of the builder.
+func (_u *AccountBlockUpdate) Mutation() *AccountBlockMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountBlockUpdate) ClearAccount() *AccountBlockUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearTarget clears the "target" edge to the Account entity.
+func (_u *AccountBlockUpdate) ClearTarget() *AccountBlockUpdate {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountBlockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountBlockUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountBlockUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountBlockUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountBlockUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBlock.account"`)
+	}
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBlock.target"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountBlockUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountBlockUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountBlockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountblock.Table, accountblock.Columns, sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(accountblock.FieldKind, field.TypeString, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.AccountTable,
+			Columns: []string{accountblock.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.AccountTable,
+			Columns: []string{accountblock.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.TargetTable,
+			Columns: []string{accountblock.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.TargetTable,
+			Columns: []string{accountblock.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountblock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountBlockUpdateOne is the builder for updating a single AccountBlock entity.
+type AccountBlockUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountBlockMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountBlockUpdateOne) SetAccountID(v xid.ID) *AccountBlockUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountBlockUpdateOne) SetNillableAccountID(v *xid.ID) *AccountBlockUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (_u *AccountBlockUpdateOne) SetTargetAccountID(v xid.ID) *AccountBlockUpdateOne {
+	_u.mutation.SetTargetAccountID(v)
+	return _u
+}
+
+// SetNillableTargetAccountID sets the "target_account_id" field if the given value is not nil.
+func (_u *AccountBlockUpdateOne) SetNillableTargetAccountID(v *xid.ID) *AccountBlockUpdateOne {
+	if v != nil {
+		_u.SetTargetAccountID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AccountBlockUpdateOne) SetKind(v string) *AccountBlockUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AccountBlockUpdateOne) SetNillableKind(v *string) *AccountBlockUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountBlockUpdateOne) SetAccount(v *Account) *AccountBlockUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetTargetID sets the "target" edge to the Account entity by ID.
+func (_u *AccountBlockUpdateOne) SetTargetID(id xid.ID) *AccountBlockUpdateOne {
+	_u.mutation.SetTargetID(id)
+	return _u
+}
+
+// SetTarget sets the "target" edge to the Account entity.
+func (_u *AccountBlockUpdateOne) SetTarget(v *Account) *AccountBlockUpdateOne {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the AccountBlockMutation object of the builder.
+func (_u *AccountBlockUpdateOne) Mutation() *AccountBlockMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountBlockUpdateOne) ClearAccount() *AccountBlockUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearTarget clears the "target" edge to the Account entity.
+func (_u *AccountBlockUpdateOne) ClearTarget() *AccountBlockUpdateOne {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Where appends a list predicates to the AccountBlockUpdate builder.
+func (_u *AccountBlockUpdateOne) Where(ps ...predicate.AccountBlock) *AccountBlockUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountBlockUpdateOne) Select(field string, fields ...string) *AccountBlockUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountBlock entity.
+func (_u *AccountBlockUpdateOne) Save(ctx context.Context) (*AccountBlock, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountBlockUpdateOne) SaveX(ctx context.Context) *AccountBlock {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountBlockUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountBlockUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountBlockUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBlock.account"`)
+	}
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountBlock.target"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountBlockUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountBlockUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountBlockUpdateOne) sqlSave(ctx context.Context) (_node *AccountBlock, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountblock.Table, accountblock.Columns, sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountBlock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountblock.FieldID)
+		for _, f := range fields {
+			if !accountblock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountblock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(accountblock.FieldKind, field.TypeString, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.AccountTable,
+			Columns: []string{accountblock.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.AccountTable,
+			Columns: []string{accountblock.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.TargetTable,
+			Columns: []string{accountblock.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountblock.TargetTable,
+			Columns: []string{accountblock.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AccountBlock{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountblock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
+	"github.com/rs/xid"
+)
+
+// AccountOnboardingStep is the model entity for the AccountOnboardingStep schema.
+type AccountOnboardingStep struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// Step holds the value of the "step" field.
+	Step string `json:"step,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountOnboardingStepQuery when eager-loading is set.
+	Edges        AccountOnboardingStepEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountOnboardingStepEdges holds the relations/edges for other nodes in the graph.
+type AccountOnboardingStepEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountOnboardingStepEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountOnboardingStep) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountonboardingstep.FieldStep:
+			values[i] = new(sql.NullString)
+		case accountonboardingstep.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case accountonboardingstep.FieldID, accountonboardingstep.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountOnboardingStep fields.
+func (_m *AccountOnboardingStep) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountonboardingstep.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case accountonboardingstep.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case accountonboardingstep.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case accountonboardingstep.FieldStep:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field step", values[i])
+			} else if value.Valid {
+				_m.Step = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountOnboardingStep.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountOnboardingStep) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the AccountOnboardingStep entity.
+func (_m *AccountOnboardingStep) QueryAccount() *AccountQuery {
+	return NewAccountOnboardingStepClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this AccountOnboardingStep.
+// Note that you need to call AccountOnboardingStep.Unwrap() before calling this method if this AccountOnboardingStep
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountOnboardingStep) Update() *AccountOnboardingStepUpdateOne {
+	return NewAccountOnboardingStepClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountOnboardingStep entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountOnboardingStep) Unwrap() *AccountOnboardingStep {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountOnboardingStep is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountOnboardingStep) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountOnboardingStep(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("step=")
+	builder.WriteString(_m.Step)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountOnboardingSteps is a parsable slice of AccountOnboardingStep.
+type AccountOnboardingSteps []*AccountOnboardingStep
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountonboardingstep
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Step applies equality check predicate on the "step" field. It's identical to StepEQ.
+func Step(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldStep, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.AccountOnboardingStep {
+	vc := v.String()
+	return predicate.AccountOnboardingStep(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.AccountOnboardingStep {
+	vc := v.String()
+	return predicate.AccountOnboardingStep(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.AccountOnboardingStep {
+	vc := v.String()
+	return predicate.AccountOnboardingStep(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.AccountOnboardingStep {
+	vc := v.String()
+	return predicate.AccountOnboardingStep(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.AccountOnboardingStep {
+	vc := v.String()
+	return predicate.AccountOnboardingStep(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// StepEQ applies the EQ predicate on the "step" field.
+func StepEQ(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEQ(FieldStep, v))
+}
+
+// StepNEQ applies the NEQ predicate on the "step" field.
+func StepNEQ(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNEQ(FieldStep, v))
+}
+
+// StepIn applies the In predicate on the "step" field.
+func StepIn(vs ...string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldIn(FieldStep, vs...))
+}
+
+// StepNotIn applies the NotIn predicate on the "step" field.
+func StepNotIn(vs ...string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldNotIn(FieldStep, vs...))
+}
+
+// StepGT applies the GT predicate on the "step" field.
+func StepGT(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGT(FieldStep, v))
+}
+
+// StepGTE applies the GTE predicate on the "step" field.
+func StepGTE(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldGTE(FieldStep, v))
+}
+
+// StepLT applies the LT predicate on the "step" field.
+func StepLT(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLT(FieldStep, v))
+}
+
+// StepLTE applies the LTE predicate on the "step" field.
+func StepLTE(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldLTE(FieldStep, v))
+}
+
+// StepContains applies the Contains predicate on the "step" field.
+func StepContains(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldContains(FieldStep, v))
+}
+
+// StepHasPrefix applies the HasPrefix predicate on the "step" field.
+func StepHasPrefix(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldHasPrefix(FieldStep, v))
+}
+
+// StepHasSuffix applies the HasSuffix predicate on the "step" field.
+func StepHasSuffix(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldHasSuffix(FieldStep, v))
+}
+
+// StepEqualFold applies the EqualFold predicate on the "step" field.
+func StepEqualFold(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldEqualFold(FieldStep, v))
+}
+
+// StepContainsFold applies the ContainsFold predicate on the "step" field.
+func StepContainsFold(v string) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.FieldContainsFold(FieldStep, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountOnboardingStep) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountOnboardingStep) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountOnboardingStep) predicate.AccountOnboardingStep {
+	return predicate.AccountOnboardingStep(sql.NotPredicates(p))
+}
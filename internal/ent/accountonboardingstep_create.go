@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
+	"github.com/rs/xid"
+)
+
+// AccountOnboardingStepCreate is the builder for creating a AccountOnboardingStep entity.
+type AccountOnboardingStepCreate struct {
+	config
+	mutation *AccountOnboardingStepMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountOnboardingStepCreate) SetCreatedAt(v time.Time) *AccountOnboardingStepCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountOnboardingStepCreate) SetNillableCreatedAt(v *time.Time) *AccountOnboardingStepCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *AccountOnboardingStepCreate) SetAccountID(v xid.ID) *AccountOnboardingStepCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetStep sets the "step" field.
+func (_c *AccountOnboardingStepCreate) SetStep(v string) *AccountOnboardingStepCreate {
+	_c.mutation.SetStep(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountOnboardingStepCreate) SetID(v xid.ID) *AccountOnboardingStepCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AccountOnboardingStepCreate) SetNillableID(v *xid.ID) *AccountOnboardingStepCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *AccountOnboardingStepCreate) SetAccount(v *Account) *AccountOnboardingStepCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the AccountOnboardingStepMutation object of the builder.
+func (_c *AccountOnboardingStepCreate) Mutation() *AccountOnboardingStepMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountOnboardingStep in the database.
+func (_c *AccountOnboardingStepCreate) Save(ctx context.Context) (*AccountOnboardingStep, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountOnboardingStepCreate) SaveX(ctx context.Context) *AccountOnboardingStep {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountOnboardingStepCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountOnboardingStepCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountOnboardingStepCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountonboardingstep.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := accountonboardingstep.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountOnboardingStepCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountOnboardingStep.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "AccountOnboardingStep.account_id"`)}
+	}
+	if _, ok := _c.mutation.Step(); !ok {
+		return &ValidationError{Name: "step", err: errors.New(`ent: missing required field "AccountOnboardingStep.step"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accountonboardingstep.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AccountOnboardingStep.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "AccountOnboardingStep.account"`)}
+	}
+	return nil
+}
+
+func (_c *AccountOnboardingStepCreate) sqlSave(ctx context.Context) (*AccountOnboardingStep, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountOnboardingStepCreate) createSpec() (*AccountOnboardingStep, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountOnboardingStep{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountonboardingstep.Table, sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountonboardingstep.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Step(); ok {
+		_spec.SetField(accountonboardingstep.FieldStep, field.TypeString, value)
+		_node.Step = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountonboardingstep.AccountTable,
+			Columns: []string{accountonboardingstep.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountOnboardingStep.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountOnboardingStepUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountOnboardingStepCreate) OnConflict(opts ...sql.ConflictOption) *AccountOnboardingStepUpsertOne {
+	_c.conflict = opts
+	return &AccountOnboardingStepUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountOnboardingStep.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountOnboardingStepCreate) OnConflictColumns(columns ...string) *AccountOnboardingStepUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountOnboardingStepUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccountOnboardingStepUpsertOne is the builder for "upsert"-ing
+	//  one AccountOnboardingStep node.
+	AccountOnboardingStepUpsertOne struct {
+		create *AccountOnboardingStepCreate
+	}
+
+	// AccountOnboardingStepUpsert is the "OnConflict" setter.
+	AccountOnboardingStepUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountOnboardingStepUpsert) SetAccountID(v xid.ID) *AccountOnboardingStepUpsert {
+	u.Set(accountonboardingstep.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountOnboardingStepUpsert) UpdateAccountID() *AccountOnboardingStepUpsert {
+	u.SetExcluded(accountonboardingstep.FieldAccountID)
+	return u
+}
+
+// SetStep sets the "step" field.
+func (u *AccountOnboardingStepUpsert) SetStep(v string) *AccountOnboardingStepUpsert {
+	u.Set(accountonboardingstep.FieldStep, v)
+	return u
+}
+
+// UpdateStep sets the "step" field to the value that was provided on create.
+func (u *AccountOnboardingStepUpsert) UpdateStep() *AccountOnboardingStepUpsert {
+	u.SetExcluded(accountonboardingstep.FieldStep)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccountOnboardingStep.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountonboardingstep.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountOnboardingStepUpsertOne) UpdateNewValues() *AccountOnboardingStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accountonboardingstep.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accountonboardingstep.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountOnboardingStep.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountOnboardingStepUpsertOne) Ignore() *AccountOnboardingStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountOnboardingStepUpsertOne) DoNothing() *AccountOnboardingStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountOnboardingStepCreate.OnConflict
+// documentation for more info.
+func (u *AccountOnboardingStepUpsertOne) Update(set func(*AccountOnboardingStepUpsert)) *AccountOnboardingStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountOnboardingStepUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountOnboardingStepUpsertOne) SetAccountID(v xid.ID) *AccountOnboardingStepUpsertOne {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountOnboardingStepUpsertOne) UpdateAccountID() *AccountOnboardingStepUpsertOne {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetStep sets the "step" field.
+func (u *AccountOnboardingStepUpsertOne) SetStep(v string) *AccountOnboardingStepUpsertOne {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.SetStep(v)
+	})
+}
+
+// UpdateStep sets the "step" field to the value that was provided on create.
+func (u *AccountOnboardingStepUpsertOne) UpdateStep() *AccountOnboardingStepUpsertOne {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.UpdateStep()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountOnboardingStepUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountOnboardingStepCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountOnboardingStepUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountOnboardingStepUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AccountOnboardingStepUpsertOne.ID is not supported by MySQL driver. Use AccountOnboardingStepUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountOnboardingStepUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountOnboardingStepCreateBulk is the builder for creating many AccountOnboardingStep entities in bulk.
+type AccountOnboardingStepCreateBulk struct {
+	config
+	err      error
+	builders []*AccountOnboardingStepCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountOnboardingStep entities in the database.
+func (_c *AccountOnboardingStepCreateBulk) Save(ctx context.Context) ([]*AccountOnboardingStep, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountOnboardingStep, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountOnboardingStepMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountOnboardingStepCreateBulk) SaveX(ctx context.Context) []*AccountOnboardingStep {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountOnboardingStepCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountOnboardingStepCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountOnboardingStep.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountOnboardingStepUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountOnboardingStepCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountOnboardingStepUpsertBulk {
+	_c.conflict = opts
+	return &AccountOnboardingStepUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountOnboardingStep.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountOnboardingStepCreateBulk) OnConflictColumns(columns ...string) *AccountOnboardingStepUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountOnboardingStepUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccountOnboardingStepUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountOnboardingStep nodes.
+type AccountOnboardingStepUpsertBulk struct {
+	create *AccountOnboardingStepCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountOnboardingStep.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountonboardingstep.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountOnboardingStepUpsertBulk) UpdateNewValues() *AccountOnboardingStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accountonboardingstep.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accountonboardingstep.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountOnboardingStep.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountOnboardingStepUpsertBulk) Ignore() *AccountOnboardingStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountOnboardingStepUpsertBulk) DoNothing() *AccountOnboardingStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountOnboardingStepCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountOnboardingStepUpsertBulk) Update(set func(*AccountOnboardingStepUpsert)) *AccountOnboardingStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountOnboardingStepUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountOnboardingStepUpsertBulk) SetAccountID(v xid.ID) *AccountOnboardingStepUpsertBulk {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountOnboardingStepUpsertBulk) UpdateAccountID() *AccountOnboardingStepUpsertBulk {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetStep sets the "step" field.
+func (u *AccountOnboardingStepUpsertBulk) SetStep(v string) *AccountOnboardingStepUpsertBulk {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.SetStep(v)
+	})
+}
+
+// UpdateStep sets the "step" field to the value that was provided on create.
+func (u *AccountOnboardingStepUpsertBulk) UpdateStep() *AccountOnboardingStepUpsertBulk {
+	return u.Update(func(s *AccountOnboardingStepUpsert) {
+		s.UpdateStep()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountOnboardingStepUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountOnboardingStepCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountOnboardingStepCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountOnboardingStepUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
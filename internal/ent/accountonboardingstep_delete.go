@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AccountOnboardingStepDelete is the builder for deleting a AccountOnboardingStep entity.
+type AccountOnboardingStepDelete struct {
+	config
+	hooks    []Hook
+	mutation *AccountOnboardingStepMutation
+}
+
+// Where appends a list predicates to the AccountOnboardingStepDelete builder.
+func (_d *AccountOnboardingStepDelete) Where(ps ...predicate.AccountOnboardingStep) *AccountOnboardingStepDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AccountOnboardingStepDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccountOnboardingStepDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AccountOnboardingStepDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(accountonboardingstep.Table, sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AccountOnboardingStepDeleteOne is the builder for deleting a single AccountOnboardingStep entity.
+type AccountOnboardingStepDeleteOne struct {
+	_d *AccountOnboardingStepDelete
+}
+
+// Where appends a list predicates to the AccountOnboardingStepDelete builder.
+func (_d *AccountOnboardingStepDeleteOne) Where(ps ...predicate.AccountOnboardingStep) *AccountOnboardingStepDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AccountOnboardingStepDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{accountonboardingstep.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccountOnboardingStepDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// AccountOnboardingStepUpdate is the builder for updating AccountOnboardingStep entities.
+type AccountOnboardingStepUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountOnboardingStepMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountOnboardingStepUpdate builder.
+func (_u *AccountOnboardingStepUpdate) Where(ps ...predicate.AccountOnboardingStep) *AccountOnboardingStepUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountOnboardingStepUpdate) SetAccountID(v xid.ID) *AccountOnboardingStepUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountOnboardingStepUpdate) SetNillableAccountID(v *xid.ID) *AccountOnboardingStepUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *AccountOnboardingStepUpdate) SetStep(v string) *AccountOnboardingStepUpdate {
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *AccountOnboardingStepUpdate) SetNillableStep(v *string) *AccountOnboardingStepUpdate {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountOnboardingStepUpdate) SetAccount(v *Account) *AccountOnboardingStepUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the AccountOnboardingStepMutation object of the builder.
+func (_u *AccountOnboardingStepUpdate) Mutation() *AccountOnboardingStepMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountOnboardingStepUpdate) ClearAccount() *AccountOnboardingStepUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountOnboardingStepUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountOnboardingStepUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountOnboardingStepUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountOnboardingStepUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountOnboardingStepUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountOnboardingStep.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountOnboardingStepUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountOnboardingStepUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountOnboardingStepUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountonboardingstep.Table, accountonboardingstep.Columns, sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(accountonboardingstep.FieldStep, field.TypeString, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountonboardingstep.AccountTable,
+			Columns: []string{accountonboardingstep.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountonboardingstep.AccountTable,
+			Columns: []string{accountonboardingstep.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountonboardingstep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountOnboardingStepUpdateOne is the builder for updating a single AccountOnboardingStep entity.
+type AccountOnboardingStepUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountOnboardingStepMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountOnboardingStepUpdateOne) SetAccountID(v xid.ID) *AccountOnboardingStepUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountOnboardingStepUpdateOne) SetNillableAccountID(v *xid.ID) *AccountOnboardingStepUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *AccountOnboardingStepUpdateOne) SetStep(v string) *AccountOnboardingStepUpdateOne {
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *AccountOnboardingStepUpdateOne) SetNillableStep(v *string) *AccountOnboardingStepUpdateOne {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *AccountOnboardingStepUpdateOne) SetAccount(v *Account) *AccountOnboardingStepUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the AccountOnboardingStepMutation object of the builder.
+func (_u *AccountOnboardingStepUpdateOne) Mutation() *AccountOnboardingStepMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *AccountOnboardingStepUpdateOne) ClearAccount() *AccountOnboardingStepUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the AccountOnboardingStepUpdate builder.
+func (_u *AccountOnboardingStepUpdateOne) Where(ps ...predicate.AccountOnboardingStep) *AccountOnboardingStepUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountOnboardingStepUpdateOne) Select(field string, fields ...string) *AccountOnboardingStepUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountOnboardingStep entity.
+func (_u *AccountOnboardingStepUpdateOne) Save(ctx context.Context) (*AccountOnboardingStep, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountOnboardingStepUpdateOne) SaveX(ctx context.Context) *AccountOnboardingStep {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountOnboardingStepUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountOnboardingStepUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountOnboardingStepUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountOnboardingStep.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountOnboardingStepUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountOnboardingStepUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountOnboardingStepUpdateOne) sqlSave(ctx context.Context) (_node *AccountOnboardingStep, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountonboardingstep.Table, accountonboardingstep.Columns, sqlgraph.NewFieldSpec(accountonboardingstep.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountOnboardingStep.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountonboardingstep.FieldID)
+		for _, f := range fields {
+			if !accountonboardingstep.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountonboardingstep.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(accountonboardingstep.FieldStep, field.TypeString, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountonboardingstep.AccountTable,
+			Columns: []string{accountonboardingstep.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountonboardingstep.AccountTable,
+			Columns: []string{accountonboardingstep.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AccountOnboardingStep{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountonboardingstep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
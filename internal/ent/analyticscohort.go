@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/rs/xid"
+)
+
+// AnalyticsCohort is the model entity for the AnalyticsCohort schema.
+type AnalyticsCohort struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Cohort holds the value of the "cohort" field.
+	Cohort time.Time `json:"cohort,omitempty"`
+	// Week holds the value of the "week" field.
+	Week int `json:"week,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int `json:"size,omitempty"`
+	// Retained holds the value of the "retained" field.
+	Retained     int `json:"retained,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnalyticsCohort) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case analyticscohort.FieldWeek, analyticscohort.FieldSize, analyticscohort.FieldRetained:
+			values[i] = new(sql.NullInt64)
+		case analyticscohort.FieldCreatedAt, analyticscohort.FieldUpdatedAt, analyticscohort.FieldCohort:
+			values[i] = new(sql.NullTime)
+		case analyticscohort.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnalyticsCohort fields.
+func (_m *AnalyticsCohort) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case analyticscohort.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case analyticscohort.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case analyticscohort.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case analyticscohort.FieldCohort:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cohort", values[i])
+			} else if value.Valid {
+				_m.Cohort = value.Time
+			}
+		case analyticscohort.FieldWeek:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field week", values[i])
+			} else if value.Valid {
+				_m.Week = int(value.Int64)
+			}
+		case analyticscohort.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = int(value.Int64)
+			}
+		case analyticscohort.FieldRetained:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retained", values[i])
+			} else if value.Valid {
+				_m.Retained = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnalyticsCohort.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnalyticsCohort) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AnalyticsCohort.
+// Note that you need to call AnalyticsCohort.Unwrap() before calling this method if this AnalyticsCohort
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnalyticsCohort) Update() *AnalyticsCohortUpdateOne {
+	return NewAnalyticsCohortClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnalyticsCohort entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnalyticsCohort) Unwrap() *AnalyticsCohort {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnalyticsCohort is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnalyticsCohort) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnalyticsCohort(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cohort=")
+	builder.WriteString(_m.Cohort.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("week=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Week))
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("retained=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Retained))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnalyticsCohorts is a parsable slice of AnalyticsCohort.
+type AnalyticsCohorts []*AnalyticsCohort
@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticscohort
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the analyticscohort type in the database.
+	Label = "analytics_cohort"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCohort holds the string denoting the cohort field in the database.
+	FieldCohort = "cohort"
+	// FieldWeek holds the string denoting the week field in the database.
+	FieldWeek = "week"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldRetained holds the string denoting the retained field in the database.
+	FieldRetained = "retained"
+	// Table holds the table name of the analyticscohort in the database.
+	Table = "analytics_cohorts"
+)
+
+// Columns holds all SQL columns for analyticscohort fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCohort,
+	FieldWeek,
+	FieldSize,
+	FieldRetained,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultSize holds the default value on creation for the "size" field.
+	DefaultSize int
+	// DefaultRetained holds the default value on creation for the "retained" field.
+	DefaultRetained int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the AnalyticsCohort queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCohort orders the results by the cohort field.
+func ByCohort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCohort, opts...).ToFunc()
+}
+
+// ByWeek orders the results by the week field.
+func ByWeek(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeek, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByRetained orders the results by the retained field.
+func ByRetained(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetained, opts...).ToFunc()
+}
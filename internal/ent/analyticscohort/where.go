@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticscohort
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Cohort applies equality check predicate on the "cohort" field. It's identical to CohortEQ.
+func Cohort(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldCohort, v))
+}
+
+// Week applies equality check predicate on the "week" field. It's identical to WeekEQ.
+func Week(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldWeek, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldSize, v))
+}
+
+// Retained applies equality check predicate on the "retained" field. It's identical to RetainedEQ.
+func Retained(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldRetained, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CohortEQ applies the EQ predicate on the "cohort" field.
+func CohortEQ(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldCohort, v))
+}
+
+// CohortNEQ applies the NEQ predicate on the "cohort" field.
+func CohortNEQ(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNEQ(FieldCohort, v))
+}
+
+// CohortIn applies the In predicate on the "cohort" field.
+func CohortIn(vs ...time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldIn(FieldCohort, vs...))
+}
+
+// CohortNotIn applies the NotIn predicate on the "cohort" field.
+func CohortNotIn(vs ...time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNotIn(FieldCohort, vs...))
+}
+
+// CohortGT applies the GT predicate on the "cohort" field.
+func CohortGT(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGT(FieldCohort, v))
+}
+
+// CohortGTE applies the GTE predicate on the "cohort" field.
+func CohortGTE(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGTE(FieldCohort, v))
+}
+
+// CohortLT applies the LT predicate on the "cohort" field.
+func CohortLT(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLT(FieldCohort, v))
+}
+
+// CohortLTE applies the LTE predicate on the "cohort" field.
+func CohortLTE(v time.Time) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLTE(FieldCohort, v))
+}
+
+// WeekEQ applies the EQ predicate on the "week" field.
+func WeekEQ(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldWeek, v))
+}
+
+// WeekNEQ applies the NEQ predicate on the "week" field.
+func WeekNEQ(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNEQ(FieldWeek, v))
+}
+
+// WeekIn applies the In predicate on the "week" field.
+func WeekIn(vs ...int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldIn(FieldWeek, vs...))
+}
+
+// WeekNotIn applies the NotIn predicate on the "week" field.
+func WeekNotIn(vs ...int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNotIn(FieldWeek, vs...))
+}
+
+// WeekGT applies the GT predicate on the "week" field.
+func WeekGT(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGT(FieldWeek, v))
+}
+
+// WeekGTE applies the GTE predicate on the "week" field.
+func WeekGTE(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGTE(FieldWeek, v))
+}
+
+// WeekLT applies the LT predicate on the "week" field.
+func WeekLT(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLT(FieldWeek, v))
+}
+
+// WeekLTE applies the LTE predicate on the "week" field.
+func WeekLTE(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLTE(FieldWeek, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLTE(FieldSize, v))
+}
+
+// RetainedEQ applies the EQ predicate on the "retained" field.
+func RetainedEQ(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldEQ(FieldRetained, v))
+}
+
+// RetainedNEQ applies the NEQ predicate on the "retained" field.
+func RetainedNEQ(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNEQ(FieldRetained, v))
+}
+
+// RetainedIn applies the In predicate on the "retained" field.
+func RetainedIn(vs ...int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldIn(FieldRetained, vs...))
+}
+
+// RetainedNotIn applies the NotIn predicate on the "retained" field.
+func RetainedNotIn(vs ...int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldNotIn(FieldRetained, vs...))
+}
+
+// RetainedGT applies the GT predicate on the "retained" field.
+func RetainedGT(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGT(FieldRetained, v))
+}
+
+// RetainedGTE applies the GTE predicate on the "retained" field.
+func RetainedGTE(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldGTE(FieldRetained, v))
+}
+
+// RetainedLT applies the LT predicate on the "retained" field.
+func RetainedLT(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLT(FieldRetained, v))
+}
+
+// RetainedLTE applies the LTE predicate on the "retained" field.
+func RetainedLTE(v int) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.FieldLTE(FieldRetained, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnalyticsCohort) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnalyticsCohort) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnalyticsCohort) predicate.AnalyticsCohort {
+	return predicate.AnalyticsCohort(sql.NotPredicates(p))
+}
@@ -0,0 +1,853 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/rs/xid"
+)
+
+// AnalyticsCohortCreate is the builder for creating a AnalyticsCohort entity.
+type AnalyticsCohortCreate struct {
+	config
+	mutation *AnalyticsCohortMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AnalyticsCohortCreate) SetCreatedAt(v time.Time) *AnalyticsCohortCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AnalyticsCohortCreate) SetNillableCreatedAt(v *time.Time) *AnalyticsCohortCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AnalyticsCohortCreate) SetUpdatedAt(v time.Time) *AnalyticsCohortCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AnalyticsCohortCreate) SetNillableUpdatedAt(v *time.Time) *AnalyticsCohortCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCohort sets the "cohort" field.
+func (_c *AnalyticsCohortCreate) SetCohort(v time.Time) *AnalyticsCohortCreate {
+	_c.mutation.SetCohort(v)
+	return _c
+}
+
+// SetWeek sets the "week" field.
+func (_c *AnalyticsCohortCreate) SetWeek(v int) *AnalyticsCohortCreate {
+	_c.mutation.SetWeek(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *AnalyticsCohortCreate) SetSize(v int) *AnalyticsCohortCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_c *AnalyticsCohortCreate) SetNillableSize(v *int) *AnalyticsCohortCreate {
+	if v != nil {
+		_c.SetSize(*v)
+	}
+	return _c
+}
+
+// SetRetained sets the "retained" field.
+func (_c *AnalyticsCohortCreate) SetRetained(v int) *AnalyticsCohortCreate {
+	_c.mutation.SetRetained(v)
+	return _c
+}
+
+// SetNillableRetained sets the "retained" field if the given value is not nil.
+func (_c *AnalyticsCohortCreate) SetNillableRetained(v *int) *AnalyticsCohortCreate {
+	if v != nil {
+		_c.SetRetained(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnalyticsCohortCreate) SetID(v xid.ID) *AnalyticsCohortCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AnalyticsCohortCreate) SetNillableID(v *xid.ID) *AnalyticsCohortCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AnalyticsCohortMutation object of the builder.
+func (_c *AnalyticsCohortCreate) Mutation() *AnalyticsCohortMutation {
+	return _c.mutation
+}
+
+// Save creates the AnalyticsCohort in the database.
+func (_c *AnalyticsCohortCreate) Save(ctx context.Context) (*AnalyticsCohort, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnalyticsCohortCreate) SaveX(ctx context.Context) *AnalyticsCohort {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsCohortCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsCohortCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnalyticsCohortCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := analyticscohort.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := analyticscohort.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		v := analyticscohort.DefaultSize
+		_c.mutation.SetSize(v)
+	}
+	if _, ok := _c.mutation.Retained(); !ok {
+		v := analyticscohort.DefaultRetained
+		_c.mutation.SetRetained(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := analyticscohort.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnalyticsCohortCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AnalyticsCohort.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AnalyticsCohort.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Cohort(); !ok {
+		return &ValidationError{Name: "cohort", err: errors.New(`ent: missing required field "AnalyticsCohort.cohort"`)}
+	}
+	if _, ok := _c.mutation.Week(); !ok {
+		return &ValidationError{Name: "week", err: errors.New(`ent: missing required field "AnalyticsCohort.week"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "AnalyticsCohort.size"`)}
+	}
+	if _, ok := _c.mutation.Retained(); !ok {
+		return &ValidationError{Name: "retained", err: errors.New(`ent: missing required field "AnalyticsCohort.retained"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := analyticscohort.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AnalyticsCohort.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AnalyticsCohortCreate) sqlSave(ctx context.Context) (*AnalyticsCohort, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnalyticsCohortCreate) createSpec() (*AnalyticsCohort, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnalyticsCohort{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(analyticscohort.Table, sqlgraph.NewFieldSpec(analyticscohort.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(analyticscohort.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticscohort.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Cohort(); ok {
+		_spec.SetField(analyticscohort.FieldCohort, field.TypeTime, value)
+		_node.Cohort = value
+	}
+	if value, ok := _c.mutation.Week(); ok {
+		_spec.SetField(analyticscohort.FieldWeek, field.TypeInt, value)
+		_node.Week = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(analyticscohort.FieldSize, field.TypeInt, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Retained(); ok {
+		_spec.SetField(analyticscohort.FieldRetained, field.TypeInt, value)
+		_node.Retained = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsCohort.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsCohortUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsCohortCreate) OnConflict(opts ...sql.ConflictOption) *AnalyticsCohortUpsertOne {
+	_c.conflict = opts
+	return &AnalyticsCohortUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsCohort.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsCohortCreate) OnConflictColumns(columns ...string) *AnalyticsCohortUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsCohortUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnalyticsCohortUpsertOne is the builder for "upsert"-ing
+	//  one AnalyticsCohort node.
+	AnalyticsCohortUpsertOne struct {
+		create *AnalyticsCohortCreate
+	}
+
+	// AnalyticsCohortUpsert is the "OnConflict" setter.
+	AnalyticsCohortUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsCohortUpsert) SetUpdatedAt(v time.Time) *AnalyticsCohortUpsert {
+	u.Set(analyticscohort.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsert) UpdateUpdatedAt() *AnalyticsCohortUpsert {
+	u.SetExcluded(analyticscohort.FieldUpdatedAt)
+	return u
+}
+
+// SetCohort sets the "cohort" field.
+func (u *AnalyticsCohortUpsert) SetCohort(v time.Time) *AnalyticsCohortUpsert {
+	u.Set(analyticscohort.FieldCohort, v)
+	return u
+}
+
+// UpdateCohort sets the "cohort" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsert) UpdateCohort() *AnalyticsCohortUpsert {
+	u.SetExcluded(analyticscohort.FieldCohort)
+	return u
+}
+
+// SetWeek sets the "week" field.
+func (u *AnalyticsCohortUpsert) SetWeek(v int) *AnalyticsCohortUpsert {
+	u.Set(analyticscohort.FieldWeek, v)
+	return u
+}
+
+// UpdateWeek sets the "week" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsert) UpdateWeek() *AnalyticsCohortUpsert {
+	u.SetExcluded(analyticscohort.FieldWeek)
+	return u
+}
+
+// AddWeek adds v to the "week" field.
+func (u *AnalyticsCohortUpsert) AddWeek(v int) *AnalyticsCohortUpsert {
+	u.Add(analyticscohort.FieldWeek, v)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *AnalyticsCohortUpsert) SetSize(v int) *AnalyticsCohortUpsert {
+	u.Set(analyticscohort.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsert) UpdateSize() *AnalyticsCohortUpsert {
+	u.SetExcluded(analyticscohort.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *AnalyticsCohortUpsert) AddSize(v int) *AnalyticsCohortUpsert {
+	u.Add(analyticscohort.FieldSize, v)
+	return u
+}
+
+// SetRetained sets the "retained" field.
+func (u *AnalyticsCohortUpsert) SetRetained(v int) *AnalyticsCohortUpsert {
+	u.Set(analyticscohort.FieldRetained, v)
+	return u
+}
+
+// UpdateRetained sets the "retained" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsert) UpdateRetained() *AnalyticsCohortUpsert {
+	u.SetExcluded(analyticscohort.FieldRetained)
+	return u
+}
+
+// AddRetained adds v to the "retained" field.
+func (u *AnalyticsCohortUpsert) AddRetained(v int) *AnalyticsCohortUpsert {
+	u.Add(analyticscohort.FieldRetained, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsCohort.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticscohort.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsCohortUpsertOne) UpdateNewValues() *AnalyticsCohortUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(analyticscohort.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(analyticscohort.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsCohort.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnalyticsCohortUpsertOne) Ignore() *AnalyticsCohortUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsCohortUpsertOne) DoNothing() *AnalyticsCohortUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsCohortCreate.OnConflict
+// documentation for more info.
+func (u *AnalyticsCohortUpsertOne) Update(set func(*AnalyticsCohortUpsert)) *AnalyticsCohortUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsCohortUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsCohortUpsertOne) SetUpdatedAt(v time.Time) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertOne) UpdateUpdatedAt() *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCohort sets the "cohort" field.
+func (u *AnalyticsCohortUpsertOne) SetCohort(v time.Time) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetCohort(v)
+	})
+}
+
+// UpdateCohort sets the "cohort" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertOne) UpdateCohort() *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateCohort()
+	})
+}
+
+// SetWeek sets the "week" field.
+func (u *AnalyticsCohortUpsertOne) SetWeek(v int) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetWeek(v)
+	})
+}
+
+// AddWeek adds v to the "week" field.
+func (u *AnalyticsCohortUpsertOne) AddWeek(v int) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.AddWeek(v)
+	})
+}
+
+// UpdateWeek sets the "week" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertOne) UpdateWeek() *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateWeek()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AnalyticsCohortUpsertOne) SetSize(v int) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AnalyticsCohortUpsertOne) AddSize(v int) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertOne) UpdateSize() *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetRetained sets the "retained" field.
+func (u *AnalyticsCohortUpsertOne) SetRetained(v int) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetRetained(v)
+	})
+}
+
+// AddRetained adds v to the "retained" field.
+func (u *AnalyticsCohortUpsertOne) AddRetained(v int) *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.AddRetained(v)
+	})
+}
+
+// UpdateRetained sets the "retained" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertOne) UpdateRetained() *AnalyticsCohortUpsertOne {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateRetained()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsCohortUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsCohortCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsCohortUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnalyticsCohortUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AnalyticsCohortUpsertOne.ID is not supported by MySQL driver. Use AnalyticsCohortUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnalyticsCohortUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnalyticsCohortCreateBulk is the builder for creating many AnalyticsCohort entities in bulk.
+type AnalyticsCohortCreateBulk struct {
+	config
+	err      error
+	builders []*AnalyticsCohortCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AnalyticsCohort entities in the database.
+func (_c *AnalyticsCohortCreateBulk) Save(ctx context.Context) ([]*AnalyticsCohort, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnalyticsCohort, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnalyticsCohortMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnalyticsCohortCreateBulk) SaveX(ctx context.Context) []*AnalyticsCohort {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsCohortCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsCohortCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsCohort.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsCohortUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsCohortCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnalyticsCohortUpsertBulk {
+	_c.conflict = opts
+	return &AnalyticsCohortUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsCohort.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsCohortCreateBulk) OnConflictColumns(columns ...string) *AnalyticsCohortUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsCohortUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnalyticsCohortUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnalyticsCohort nodes.
+type AnalyticsCohortUpsertBulk struct {
+	create *AnalyticsCohortCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnalyticsCohort.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticscohort.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsCohortUpsertBulk) UpdateNewValues() *AnalyticsCohortUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(analyticscohort.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(analyticscohort.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsCohort.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnalyticsCohortUpsertBulk) Ignore() *AnalyticsCohortUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsCohortUpsertBulk) DoNothing() *AnalyticsCohortUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsCohortCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnalyticsCohortUpsertBulk) Update(set func(*AnalyticsCohortUpsert)) *AnalyticsCohortUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsCohortUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsCohortUpsertBulk) SetUpdatedAt(v time.Time) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertBulk) UpdateUpdatedAt() *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCohort sets the "cohort" field.
+func (u *AnalyticsCohortUpsertBulk) SetCohort(v time.Time) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetCohort(v)
+	})
+}
+
+// UpdateCohort sets the "cohort" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertBulk) UpdateCohort() *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateCohort()
+	})
+}
+
+// SetWeek sets the "week" field.
+func (u *AnalyticsCohortUpsertBulk) SetWeek(v int) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetWeek(v)
+	})
+}
+
+// AddWeek adds v to the "week" field.
+func (u *AnalyticsCohortUpsertBulk) AddWeek(v int) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.AddWeek(v)
+	})
+}
+
+// UpdateWeek sets the "week" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertBulk) UpdateWeek() *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateWeek()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AnalyticsCohortUpsertBulk) SetSize(v int) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AnalyticsCohortUpsertBulk) AddSize(v int) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertBulk) UpdateSize() *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetRetained sets the "retained" field.
+func (u *AnalyticsCohortUpsertBulk) SetRetained(v int) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.SetRetained(v)
+	})
+}
+
+// AddRetained adds v to the "retained" field.
+func (u *AnalyticsCohortUpsertBulk) AddRetained(v int) *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.AddRetained(v)
+	})
+}
+
+// UpdateRetained sets the "retained" field to the value that was provided on create.
+func (u *AnalyticsCohortUpsertBulk) UpdateRetained() *AnalyticsCohortUpsertBulk {
+	return u.Update(func(s *AnalyticsCohortUpsert) {
+		s.UpdateRetained()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsCohortUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnalyticsCohortCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsCohortCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsCohortUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AnalyticsCohortDelete is the builder for deleting a AnalyticsCohort entity.
+type AnalyticsCohortDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnalyticsCohortMutation
+}
+
+// Where appends a list predicates to the AnalyticsCohortDelete builder.
+func (_d *AnalyticsCohortDelete) Where(ps ...predicate.AnalyticsCohort) *AnalyticsCohortDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnalyticsCohortDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsCohortDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnalyticsCohortDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(analyticscohort.Table, sqlgraph.NewFieldSpec(analyticscohort.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnalyticsCohortDeleteOne is the builder for deleting a single AnalyticsCohort entity.
+type AnalyticsCohortDeleteOne struct {
+	_d *AnalyticsCohortDelete
+}
+
+// Where appends a list predicates to the AnalyticsCohortDelete builder.
+func (_d *AnalyticsCohortDeleteOne) Where(ps ...predicate.AnalyticsCohort) *AnalyticsCohortDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnalyticsCohortDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{analyticscohort.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsCohortDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
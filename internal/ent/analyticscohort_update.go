@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AnalyticsCohortUpdate is the builder for updating AnalyticsCohort entities.
+type AnalyticsCohortUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnalyticsCohortMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnalyticsCohortUpdate builder.
+func (_u *AnalyticsCohortUpdate) Where(ps ...predicate.AnalyticsCohort) *AnalyticsCohortUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnalyticsCohortUpdate) SetUpdatedAt(v time.Time) *AnalyticsCohortUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCohort sets the "cohort" field.
+func (_u *AnalyticsCohortUpdate) SetCohort(v time.Time) *AnalyticsCohortUpdate {
+	_u.mutation.SetCohort(v)
+	return _u
+}
+
+// SetNillableCohort sets the "cohort" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdate) SetNillableCohort(v *time.Time) *AnalyticsCohortUpdate {
+	if v != nil {
+		_u.SetCohort(*v)
+	}
+	return _u
+}
+
+// SetWeek sets the "week" field.
+func (_u *AnalyticsCohortUpdate) SetWeek(v int) *AnalyticsCohortUpdate {
+	_u.mutation.ResetWeek()
+	_u.mutation.SetWeek(v)
+	return _u
+}
+
+// SetNillableWeek sets the "week" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdate) SetNillableWeek(v *int) *AnalyticsCohortUpdate {
+	if v != nil {
+		_u.SetWeek(*v)
+	}
+	return _u
+}
+
+// AddWeek adds value to the "week" field.
+func (_u *AnalyticsCohortUpdate) AddWeek(v int) *AnalyticsCohortUpdate {
+	_u.mutation.AddWeek(v)
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AnalyticsCohortUpdate) SetSize(v int) *AnalyticsCohortUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdate) SetNillableSize(v *int) *AnalyticsCohortUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AnalyticsCohortUpdate) AddSize(v int) *AnalyticsCohortUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetRetained sets the "retained" field.
+func (_u *AnalyticsCohortUpdate) SetRetained(v int) *AnalyticsCohortUpdate {
+	_u.mutation.ResetRetained()
+	_u.mutation.SetRetained(v)
+	return _u
+}
+
+// SetNillableRetained sets the "retained" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdate) SetNillableRetained(v *int) *AnalyticsCohortUpdate {
+	if v != nil {
+		_u.SetRetained(*v)
+	}
+	return _u
+}
+
+// AddRetained adds value to the "retained" field.
+func (_u *AnalyticsCohortUpdate) AddRetained(v int) *AnalyticsCohortUpdate {
+	_u.mutation.AddRetained(v)
+	return _u
+}
+
+// Mutation returns the AnalyticsCohortMutation object of the builder.
+func (_u *AnalyticsCohortUpdate) Mutation() *AnalyticsCohortMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnalyticsCohortUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsCohortUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnalyticsCohortUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsCohortUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnalyticsCohortUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := analyticscohort.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsCohortUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsCohortUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsCohortUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticscohort.Table, analyticscohort.Columns, sqlgraph.NewFieldSpec(analyticscohort.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticscohort.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Cohort(); ok {
+		_spec.SetField(analyticscohort.FieldCohort, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Week(); ok {
+		_spec.SetField(analyticscohort.FieldWeek, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeek(); ok {
+		_spec.AddField(analyticscohort.FieldWeek, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(analyticscohort.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(analyticscohort.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Retained(); ok {
+		_spec.SetField(analyticscohort.FieldRetained, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetained(); ok {
+		_spec.AddField(analyticscohort.FieldRetained, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticscohort.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnalyticsCohortUpdateOne is the builder for updating a single AnalyticsCohort entity.
+type AnalyticsCohortUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnalyticsCohortMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnalyticsCohortUpdateOne) SetUpdatedAt(v time.Time) *AnalyticsCohortUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCohort sets the "cohort" field.
+func (_u *AnalyticsCohortUpdateOne) SetCohort(v time.Time) *AnalyticsCohortUpdateOne {
+	_u.mutation.SetCohort(v)
+	return _u
+}
+
+// SetNillableCohort sets the "cohort" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdateOne) SetNillableCohort(v *time.Time) *AnalyticsCohortUpdateOne {
+	if v != nil {
+		_u.SetCohort(*v)
+	}
+	return _u
+}
+
+// SetWeek sets the "week" field.
+func (_u *AnalyticsCohortUpdateOne) SetWeek(v int) *AnalyticsCohortUpdateOne {
+	_u.mutation.ResetWeek()
+	_u.mutation.SetWeek(v)
+	return _u
+}
+
+// SetNillableWeek sets the "week" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdateOne) SetNillableWeek(v *int) *AnalyticsCohortUpdateOne {
+	if v != nil {
+		_u.SetWeek(*v)
+	}
+	return _u
+}
+
+// AddWeek adds value to the "week" field.
+func (_u *AnalyticsCohortUpdateOne) AddWeek(v int) *AnalyticsCohortUpdateOne {
+	_u.mutation.AddWeek(v)
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AnalyticsCohortUpdateOne) SetSize(v int) *AnalyticsCohortUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdateOne) SetNillableSize(v *int) *AnalyticsCohortUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AnalyticsCohortUpdateOne) AddSize(v int) *AnalyticsCohortUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetRetained sets the "retained" field.
+func (_u *AnalyticsCohortUpdateOne) SetRetained(v int) *AnalyticsCohortUpdateOne {
+	_u.mutation.ResetRetained()
+	_u.mutation.SetRetained(v)
+	return _u
+}
+
+// SetNillableRetained sets the "retained" field if the given value is not nil.
+func (_u *AnalyticsCohortUpdateOne) SetNillableRetained(v *int) *AnalyticsCohortUpdateOne {
+	if v != nil {
+		_u.SetRetained(*v)
+	}
+	return _u
+}
+
+// AddRetained adds value to the "retained" field.
+func (_u *AnalyticsCohortUpdateOne) AddRetained(v int) *AnalyticsCohortUpdateOne {
+	_u.mutation.AddRetained(v)
+	return _u
+}
+
+// Mutation returns the AnalyticsCohortMutation object of the builder.
+func (_u *AnalyticsCohortUpdateOne) Mutation() *AnalyticsCohortMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AnalyticsCohortUpdate builder.
+func (_u *AnalyticsCohortUpdateOne) Where(ps ...predicate.AnalyticsCohort) *AnalyticsCohortUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnalyticsCohortUpdateOne) Select(field string, fields ...string) *AnalyticsCohortUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnalyticsCohort entity.
+func (_u *AnalyticsCohortUpdateOne) Save(ctx context.Context) (*AnalyticsCohort, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsCohortUpdateOne) SaveX(ctx context.Context) *AnalyticsCohort {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnalyticsCohortUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsCohortUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnalyticsCohortUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := analyticscohort.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsCohortUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsCohortUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsCohortUpdateOne) sqlSave(ctx context.Context) (_node *AnalyticsCohort, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticscohort.Table, analyticscohort.Columns, sqlgraph.NewFieldSpec(analyticscohort.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnalyticsCohort.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, analyticscohort.FieldID)
+		for _, f := range fields {
+			if !analyticscohort.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != analyticscohort.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticscohort.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Cohort(); ok {
+		_spec.SetField(analyticscohort.FieldCohort, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Week(); ok {
+		_spec.SetField(analyticscohort.FieldWeek, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeek(); ok {
+		_spec.AddField(analyticscohort.FieldWeek, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(analyticscohort.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(analyticscohort.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Retained(); ok {
+		_spec.SetField(analyticscohort.FieldRetained, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetained(); ok {
+		_spec.AddField(analyticscohort.FieldRetained, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AnalyticsCohort{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticscohort.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
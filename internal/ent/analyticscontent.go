@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/rs/xid"
+)
+
+// AnalyticsContent is the model entity for the AnalyticsContent schema.
+type AnalyticsContent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// ItemKind holds the value of the "item_kind" field.
+	ItemKind string `json:"item_kind,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID xid.ID `json:"item_id,omitempty"`
+	// Views holds the value of the "views" field.
+	Views int `json:"views,omitempty"`
+	// Replies holds the value of the "replies" field.
+	Replies int `json:"replies,omitempty"`
+	// Reactions holds the value of the "reactions" field.
+	Reactions int `json:"reactions,omitempty"`
+	// Likes holds the value of the "likes" field.
+	Likes int `json:"likes,omitempty"`
+	// Collections holds the value of the "collections" field.
+	Collections  int `json:"collections,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnalyticsContent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case analyticscontent.FieldViews, analyticscontent.FieldReplies, analyticscontent.FieldReactions, analyticscontent.FieldLikes, analyticscontent.FieldCollections:
+			values[i] = new(sql.NullInt64)
+		case analyticscontent.FieldItemKind:
+			values[i] = new(sql.NullString)
+		case analyticscontent.FieldCreatedAt, analyticscontent.FieldDate:
+			values[i] = new(sql.NullTime)
+		case analyticscontent.FieldID, analyticscontent.FieldItemID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnalyticsContent fields.
+func (_m *AnalyticsContent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case analyticscontent.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case analyticscontent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case analyticscontent.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case analyticscontent.FieldItemKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_kind", values[i])
+			} else if value.Valid {
+				_m.ItemKind = value.String
+			}
+		case analyticscontent.FieldItemID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				_m.ItemID = *value
+			}
+		case analyticscontent.FieldViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field views", values[i])
+			} else if value.Valid {
+				_m.Views = int(value.Int64)
+			}
+		case analyticscontent.FieldReplies:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field replies", values[i])
+			} else if value.Valid {
+				_m.Replies = int(value.Int64)
+			}
+		case analyticscontent.FieldReactions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reactions", values[i])
+			} else if value.Valid {
+				_m.Reactions = int(value.Int64)
+			}
+		case analyticscontent.FieldLikes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field likes", values[i])
+			} else if value.Valid {
+				_m.Likes = int(value.Int64)
+			}
+		case analyticscontent.FieldCollections:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field collections", values[i])
+			} else if value.Valid {
+				_m.Collections = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnalyticsContent.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnalyticsContent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AnalyticsContent.
+// Note that you need to call AnalyticsContent.Unwrap() before calling this method if this AnalyticsContent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnalyticsContent) Update() *AnalyticsContentUpdateOne {
+	return NewAnalyticsContentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnalyticsContent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnalyticsContent) Unwrap() *AnalyticsContent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnalyticsContent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnalyticsContent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnalyticsContent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_kind=")
+	builder.WriteString(_m.ItemKind)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("views=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Views))
+	builder.WriteString(", ")
+	builder.WriteString("replies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Replies))
+	builder.WriteString(", ")
+	builder.WriteString("reactions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reactions))
+	builder.WriteString(", ")
+	builder.WriteString("likes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Likes))
+	builder.WriteString(", ")
+	builder.WriteString("collections=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Collections))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnalyticsContents is a parsable slice of AnalyticsContent.
+type AnalyticsContents []*AnalyticsContent
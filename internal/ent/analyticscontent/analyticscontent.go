@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticscontent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the analyticscontent type in the database.
+	Label = "analytics_content"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldItemKind holds the string denoting the item_kind field in the database.
+	FieldItemKind = "item_kind"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldViews holds the string denoting the views field in the database.
+	FieldViews = "views"
+	// FieldReplies holds the string denoting the replies field in the database.
+	FieldReplies = "replies"
+	// FieldReactions holds the string denoting the reactions field in the database.
+	FieldReactions = "reactions"
+	// FieldLikes holds the string denoting the likes field in the database.
+	FieldLikes = "likes"
+	// FieldCollections holds the string denoting the collections field in the database.
+	FieldCollections = "collections"
+	// Table holds the table name of the analyticscontent in the database.
+	Table = "analytics_contents"
+)
+
+// Columns holds all SQL columns for analyticscontent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldDate,
+	FieldItemKind,
+	FieldItemID,
+	FieldViews,
+	FieldReplies,
+	FieldReactions,
+	FieldLikes,
+	FieldCollections,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultViews holds the default value on creation for the "views" field.
+	DefaultViews int
+	// DefaultReplies holds the default value on creation for the "replies" field.
+	DefaultReplies int
+	// DefaultReactions holds the default value on creation for the "reactions" field.
+	DefaultReactions int
+	// DefaultLikes holds the default value on creation for the "likes" field.
+	DefaultLikes int
+	// DefaultCollections holds the default value on creation for the "collections" field.
+	DefaultCollections int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the AnalyticsContent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByItemKind orders the results by the item_kind field.
+func ByItemKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemKind, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByViews orders the results by the views field.
+func ByViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViews, opts...).ToFunc()
+}
+
+// ByReplies orders the results by the replies field.
+func ByReplies(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplies, opts...).ToFunc()
+}
+
+// ByReactions orders the results by the reactions field.
+func ByReactions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReactions, opts...).ToFunc()
+}
+
+// ByLikes orders the results by the likes field.
+func ByLikes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLikes, opts...).ToFunc()
+}
+
+// ByCollections orders the results by the collections field.
+func ByCollections(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollections, opts...).ToFunc()
+}
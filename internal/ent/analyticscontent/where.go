@@ -0,0 +1,531 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticscontent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldDate, v))
+}
+
+// ItemKind applies equality check predicate on the "item_kind" field. It's identical to ItemKindEQ.
+func ItemKind(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldItemID, v))
+}
+
+// Views applies equality check predicate on the "views" field. It's identical to ViewsEQ.
+func Views(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldViews, v))
+}
+
+// Replies applies equality check predicate on the "replies" field. It's identical to RepliesEQ.
+func Replies(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldReplies, v))
+}
+
+// Reactions applies equality check predicate on the "reactions" field. It's identical to ReactionsEQ.
+func Reactions(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldReactions, v))
+}
+
+// Likes applies equality check predicate on the "likes" field. It's identical to LikesEQ.
+func Likes(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldLikes, v))
+}
+
+// Collections applies equality check predicate on the "collections" field. It's identical to CollectionsEQ.
+func Collections(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldCollections, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldDate, v))
+}
+
+// ItemKindEQ applies the EQ predicate on the "item_kind" field.
+func ItemKindEQ(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemKindNEQ applies the NEQ predicate on the "item_kind" field.
+func ItemKindNEQ(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldItemKind, v))
+}
+
+// ItemKindIn applies the In predicate on the "item_kind" field.
+func ItemKindIn(vs ...string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldItemKind, vs...))
+}
+
+// ItemKindNotIn applies the NotIn predicate on the "item_kind" field.
+func ItemKindNotIn(vs ...string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldItemKind, vs...))
+}
+
+// ItemKindGT applies the GT predicate on the "item_kind" field.
+func ItemKindGT(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldItemKind, v))
+}
+
+// ItemKindGTE applies the GTE predicate on the "item_kind" field.
+func ItemKindGTE(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldItemKind, v))
+}
+
+// ItemKindLT applies the LT predicate on the "item_kind" field.
+func ItemKindLT(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldItemKind, v))
+}
+
+// ItemKindLTE applies the LTE predicate on the "item_kind" field.
+func ItemKindLTE(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldItemKind, v))
+}
+
+// ItemKindContains applies the Contains predicate on the "item_kind" field.
+func ItemKindContains(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldContains(FieldItemKind, v))
+}
+
+// ItemKindHasPrefix applies the HasPrefix predicate on the "item_kind" field.
+func ItemKindHasPrefix(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldHasPrefix(FieldItemKind, v))
+}
+
+// ItemKindHasSuffix applies the HasSuffix predicate on the "item_kind" field.
+func ItemKindHasSuffix(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldHasSuffix(FieldItemKind, v))
+}
+
+// ItemKindEqualFold applies the EqualFold predicate on the "item_kind" field.
+func ItemKindEqualFold(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEqualFold(FieldItemKind, v))
+}
+
+// ItemKindContainsFold applies the ContainsFold predicate on the "item_kind" field.
+func ItemKindContainsFold(v string) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldContainsFold(FieldItemKind, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v xid.ID) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v xid.ID) predicate.AnalyticsContent {
+	vc := v.String()
+	return predicate.AnalyticsContent(sql.FieldContains(FieldItemID, vc))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v xid.ID) predicate.AnalyticsContent {
+	vc := v.String()
+	return predicate.AnalyticsContent(sql.FieldHasPrefix(FieldItemID, vc))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v xid.ID) predicate.AnalyticsContent {
+	vc := v.String()
+	return predicate.AnalyticsContent(sql.FieldHasSuffix(FieldItemID, vc))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v xid.ID) predicate.AnalyticsContent {
+	vc := v.String()
+	return predicate.AnalyticsContent(sql.FieldEqualFold(FieldItemID, vc))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v xid.ID) predicate.AnalyticsContent {
+	vc := v.String()
+	return predicate.AnalyticsContent(sql.FieldContainsFold(FieldItemID, vc))
+}
+
+// ViewsEQ applies the EQ predicate on the "views" field.
+func ViewsEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldViews, v))
+}
+
+// ViewsNEQ applies the NEQ predicate on the "views" field.
+func ViewsNEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldViews, v))
+}
+
+// ViewsIn applies the In predicate on the "views" field.
+func ViewsIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldViews, vs...))
+}
+
+// ViewsNotIn applies the NotIn predicate on the "views" field.
+func ViewsNotIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldViews, vs...))
+}
+
+// ViewsGT applies the GT predicate on the "views" field.
+func ViewsGT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldViews, v))
+}
+
+// ViewsGTE applies the GTE predicate on the "views" field.
+func ViewsGTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldViews, v))
+}
+
+// ViewsLT applies the LT predicate on the "views" field.
+func ViewsLT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldViews, v))
+}
+
+// ViewsLTE applies the LTE predicate on the "views" field.
+func ViewsLTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldViews, v))
+}
+
+// RepliesEQ applies the EQ predicate on the "replies" field.
+func RepliesEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldReplies, v))
+}
+
+// RepliesNEQ applies the NEQ predicate on the "replies" field.
+func RepliesNEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldReplies, v))
+}
+
+// RepliesIn applies the In predicate on the "replies" field.
+func RepliesIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldReplies, vs...))
+}
+
+// RepliesNotIn applies the NotIn predicate on the "replies" field.
+func RepliesNotIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldReplies, vs...))
+}
+
+// RepliesGT applies the GT predicate on the "replies" field.
+func RepliesGT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldReplies, v))
+}
+
+// RepliesGTE applies the GTE predicate on the "replies" field.
+func RepliesGTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldReplies, v))
+}
+
+// RepliesLT applies the LT predicate on the "replies" field.
+func RepliesLT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldReplies, v))
+}
+
+// RepliesLTE applies the LTE predicate on the "replies" field.
+func RepliesLTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldReplies, v))
+}
+
+// ReactionsEQ applies the EQ predicate on the "reactions" field.
+func ReactionsEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldReactions, v))
+}
+
+// ReactionsNEQ applies the NEQ predicate on the "reactions" field.
+func ReactionsNEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldReactions, v))
+}
+
+// ReactionsIn applies the In predicate on the "reactions" field.
+func ReactionsIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldReactions, vs...))
+}
+
+// ReactionsNotIn applies the NotIn predicate on the "reactions" field.
+func ReactionsNotIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldReactions, vs...))
+}
+
+// ReactionsGT applies the GT predicate on the "reactions" field.
+func ReactionsGT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldReactions, v))
+}
+
+// ReactionsGTE applies the GTE predicate on the "reactions" field.
+func ReactionsGTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldReactions, v))
+}
+
+// ReactionsLT applies the LT predicate on the "reactions" field.
+func ReactionsLT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldReactions, v))
+}
+
+// ReactionsLTE applies the LTE predicate on the "reactions" field.
+func ReactionsLTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldReactions, v))
+}
+
+// LikesEQ applies the EQ predicate on the "likes" field.
+func LikesEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldLikes, v))
+}
+
+// LikesNEQ applies the NEQ predicate on the "likes" field.
+func LikesNEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldLikes, v))
+}
+
+// LikesIn applies the In predicate on the "likes" field.
+func LikesIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldLikes, vs...))
+}
+
+// LikesNotIn applies the NotIn predicate on the "likes" field.
+func LikesNotIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldLikes, vs...))
+}
+
+// LikesGT applies the GT predicate on the "likes" field.
+func LikesGT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldLikes, v))
+}
+
+// LikesGTE applies the GTE predicate on the "likes" field.
+func LikesGTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldLikes, v))
+}
+
+// LikesLT applies the LT predicate on the "likes" field.
+func LikesLT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldLikes, v))
+}
+
+// LikesLTE applies the LTE predicate on the "likes" field.
+func LikesLTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldLikes, v))
+}
+
+// CollectionsEQ applies the EQ predicate on the "collections" field.
+func CollectionsEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldEQ(FieldCollections, v))
+}
+
+// CollectionsNEQ applies the NEQ predicate on the "collections" field.
+func CollectionsNEQ(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNEQ(FieldCollections, v))
+}
+
+// CollectionsIn applies the In predicate on the "collections" field.
+func CollectionsIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldIn(FieldCollections, vs...))
+}
+
+// CollectionsNotIn applies the NotIn predicate on the "collections" field.
+func CollectionsNotIn(vs ...int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldNotIn(FieldCollections, vs...))
+}
+
+// CollectionsGT applies the GT predicate on the "collections" field.
+func CollectionsGT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGT(FieldCollections, v))
+}
+
+// CollectionsGTE applies the GTE predicate on the "collections" field.
+func CollectionsGTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldGTE(FieldCollections, v))
+}
+
+// CollectionsLT applies the LT predicate on the "collections" field.
+func CollectionsLT(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLT(FieldCollections, v))
+}
+
+// CollectionsLTE applies the LTE predicate on the "collections" field.
+func CollectionsLTE(v int) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.FieldLTE(FieldCollections, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnalyticsContent) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnalyticsContent) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnalyticsContent) predicate.AnalyticsContent {
+	return predicate.AnalyticsContent(sql.NotPredicates(p))
+}
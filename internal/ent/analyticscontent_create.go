@@ -0,0 +1,1076 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/rs/xid"
+)
+
+// AnalyticsContentCreate is the builder for creating a AnalyticsContent entity.
+type AnalyticsContentCreate struct {
+	config
+	mutation *AnalyticsContentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AnalyticsContentCreate) SetCreatedAt(v time.Time) *AnalyticsContentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AnalyticsContentCreate) SetNillableCreatedAt(v *time.Time) *AnalyticsContentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *AnalyticsContentCreate) SetDate(v time.Time) *AnalyticsContentCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_c *AnalyticsContentCreate) SetItemKind(v string) *AnalyticsContentCreate {
+	_c.mutation.SetItemKind(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *AnalyticsContentCreate) SetItemID(v xid.ID) *AnalyticsContentCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetViews sets the "views" field.
+func (_c *AnalyticsContentCreate) SetViews(v int) *AnalyticsContentCreate {
+	_c.mutation.SetViews(v)
+	return _c
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_c *AnalyticsContentCreate) SetNillableViews(v *int) *AnalyticsContentCreate {
+	if v != nil {
+		_c.SetViews(*v)
+	}
+	return _c
+}
+
+// SetReplies sets the "replies" field.
+func (_c *AnalyticsContentCreate) SetReplies(v int) *AnalyticsContentCreate {
+	_c.mutation.SetReplies(v)
+	return _c
+}
+
+// SetNillableReplies sets the "replies" field if the given value is not nil.
+func (_c *AnalyticsContentCreate) SetNillableReplies(v *int) *AnalyticsContentCreate {
+	if v != nil {
+		_c.SetReplies(*v)
+	}
+	return _c
+}
+
+// SetReactions sets the "reactions" field.
+func (_c *AnalyticsContentCreate) SetReactions(v int) *AnalyticsContentCreate {
+	_c.mutation.SetReactions(v)
+	return _c
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_c *AnalyticsContentCreate) SetNillableReactions(v *int) *AnalyticsContentCreate {
+	if v != nil {
+		_c.SetReactions(*v)
+	}
+	return _c
+}
+
+// SetLikes sets the "likes" field.
+func (_c *AnalyticsContentCreate) SetLikes(v int) *AnalyticsContentCreate {
+	_c.mutation.SetLikes(v)
+	return _c
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (_c *AnalyticsContentCreate) SetNillableLikes(v *int) *AnalyticsContentCreate {
+	if v != nil {
+		_c.SetLikes(*v)
+	}
+	return _c
+}
+
+// SetCollections sets the "collections" field.
+func (_c *AnalyticsContentCreate) SetCollections(v int) *AnalyticsContentCreate {
+	_c.mutation.SetCollections(v)
+	return _c
+}
+
+// SetNillableCollections sets the "collections" field if the given value is not nil.
+func (_c *AnalyticsContentCreate) SetNillableCollections(v *int) *AnalyticsContentCreate {
+	if v != nil {
+		_c.SetCollections(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnalyticsContentCreate) SetID(v xid.ID) *AnalyticsContentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AnalyticsContentCreate) SetNillableID(v *xid.ID) *AnalyticsContentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AnalyticsContentMutation object of the builder.
+func (_c *AnalyticsContentCreate) Mutation() *AnalyticsContentMutation {
+	return _c.mutation
+}
+
+// Save creates the AnalyticsContent in the database.
+func (_c *AnalyticsContentCreate) Save(ctx context.Context) (*AnalyticsContent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnalyticsContentCreate) SaveX(ctx context.Context) *AnalyticsContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsContentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsContentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnalyticsContentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := analyticscontent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Views(); !ok {
+		v := analyticscontent.DefaultViews
+		_c.mutation.SetViews(v)
+	}
+	if _, ok := _c.mutation.Replies(); !ok {
+		v := analyticscontent.DefaultReplies
+		_c.mutation.SetReplies(v)
+	}
+	if _, ok := _c.mutation.Reactions(); !ok {
+		v := analyticscontent.DefaultReactions
+		_c.mutation.SetReactions(v)
+	}
+	if _, ok := _c.mutation.Likes(); !ok {
+		v := analyticscontent.DefaultLikes
+		_c.mutation.SetLikes(v)
+	}
+	if _, ok := _c.mutation.Collections(); !ok {
+		v := analyticscontent.DefaultCollections
+		_c.mutation.SetCollections(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := analyticscontent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnalyticsContentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AnalyticsContent.created_at"`)}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "AnalyticsContent.date"`)}
+	}
+	if _, ok := _c.mutation.ItemKind(); !ok {
+		return &ValidationError{Name: "item_kind", err: errors.New(`ent: missing required field "AnalyticsContent.item_kind"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "AnalyticsContent.item_id"`)}
+	}
+	if _, ok := _c.mutation.Views(); !ok {
+		return &ValidationError{Name: "views", err: errors.New(`ent: missing required field "AnalyticsContent.views"`)}
+	}
+	if _, ok := _c.mutation.Replies(); !ok {
+		return &ValidationError{Name: "replies", err: errors.New(`ent: missing required field "AnalyticsContent.replies"`)}
+	}
+	if _, ok := _c.mutation.Reactions(); !ok {
+		return &ValidationError{Name: "reactions", err: errors.New(`ent: missing required field "AnalyticsContent.reactions"`)}
+	}
+	if _, ok := _c.mutation.Likes(); !ok {
+		return &ValidationError{Name: "likes", err: errors.New(`ent: missing required field "AnalyticsContent.likes"`)}
+	}
+	if _, ok := _c.mutation.Collections(); !ok {
+		return &ValidationError{Name: "collections", err: errors.New(`ent: missing required field "AnalyticsContent.collections"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := analyticscontent.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AnalyticsContent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AnalyticsContentCreate) sqlSave(ctx context.Context) (*AnalyticsContent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnalyticsContentCreate) createSpec() (*AnalyticsContent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnalyticsContent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(analyticscontent.Table, sqlgraph.NewFieldSpec(analyticscontent.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(analyticscontent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(analyticscontent.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.ItemKind(); ok {
+		_spec.SetField(analyticscontent.FieldItemKind, field.TypeString, value)
+		_node.ItemKind = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(analyticscontent.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.Views(); ok {
+		_spec.SetField(analyticscontent.FieldViews, field.TypeInt, value)
+		_node.Views = value
+	}
+	if value, ok := _c.mutation.Replies(); ok {
+		_spec.SetField(analyticscontent.FieldReplies, field.TypeInt, value)
+		_node.Replies = value
+	}
+	if value, ok := _c.mutation.Reactions(); ok {
+		_spec.SetField(analyticscontent.FieldReactions, field.TypeInt, value)
+		_node.Reactions = value
+	}
+	if value, ok := _c.mutation.Likes(); ok {
+		_spec.SetField(analyticscontent.FieldLikes, field.TypeInt, value)
+		_node.Likes = value
+	}
+	if value, ok := _c.mutation.Collections(); ok {
+		_spec.SetField(analyticscontent.FieldCollections, field.TypeInt, value)
+		_node.Collections = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsContent.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsContentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsContentCreate) OnConflict(opts ...sql.ConflictOption) *AnalyticsContentUpsertOne {
+	_c.conflict = opts
+	return &AnalyticsContentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsContent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsContentCreate) OnConflictColumns(columns ...string) *AnalyticsContentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsContentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnalyticsContentUpsertOne is the builder for "upsert"-ing
+	//  one AnalyticsContent node.
+	AnalyticsContentUpsertOne struct {
+		create *AnalyticsContentCreate
+	}
+
+	// AnalyticsContentUpsert is the "OnConflict" setter.
+	AnalyticsContentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDate sets the "date" field.
+func (u *AnalyticsContentUpsert) SetDate(v time.Time) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateDate() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldDate)
+	return u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *AnalyticsContentUpsert) SetItemKind(v string) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldItemKind, v)
+	return u
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateItemKind() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldItemKind)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *AnalyticsContentUpsert) SetItemID(v xid.ID) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateItemID() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldItemID)
+	return u
+}
+
+// SetViews sets the "views" field.
+func (u *AnalyticsContentUpsert) SetViews(v int) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldViews, v)
+	return u
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateViews() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldViews)
+	return u
+}
+
+// AddViews adds v to the "views" field.
+func (u *AnalyticsContentUpsert) AddViews(v int) *AnalyticsContentUpsert {
+	u.Add(analyticscontent.FieldViews, v)
+	return u
+}
+
+// SetReplies sets the "replies" field.
+func (u *AnalyticsContentUpsert) SetReplies(v int) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldReplies, v)
+	return u
+}
+
+// UpdateReplies sets the "replies" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateReplies() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldReplies)
+	return u
+}
+
+// AddReplies adds v to the "replies" field.
+func (u *AnalyticsContentUpsert) AddReplies(v int) *AnalyticsContentUpsert {
+	u.Add(analyticscontent.FieldReplies, v)
+	return u
+}
+
+// SetReactions sets the "reactions" field.
+func (u *AnalyticsContentUpsert) SetReactions(v int) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldReactions, v)
+	return u
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateReactions() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldReactions)
+	return u
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *AnalyticsContentUpsert) AddReactions(v int) *AnalyticsContentUpsert {
+	u.Add(analyticscontent.FieldReactions, v)
+	return u
+}
+
+// SetLikes sets the "likes" field.
+func (u *AnalyticsContentUpsert) SetLikes(v int) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldLikes, v)
+	return u
+}
+
+// UpdateLikes sets the "likes" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateLikes() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldLikes)
+	return u
+}
+
+// AddLikes adds v to the "likes" field.
+func (u *AnalyticsContentUpsert) AddLikes(v int) *AnalyticsContentUpsert {
+	u.Add(analyticscontent.FieldLikes, v)
+	return u
+}
+
+// SetCollections sets the "collections" field.
+func (u *AnalyticsContentUpsert) SetCollections(v int) *AnalyticsContentUpsert {
+	u.Set(analyticscontent.FieldCollections, v)
+	return u
+}
+
+// UpdateCollections sets the "collections" field to the value that was provided on create.
+func (u *AnalyticsContentUpsert) UpdateCollections() *AnalyticsContentUpsert {
+	u.SetExcluded(analyticscontent.FieldCollections)
+	return u
+}
+
+// AddCollections adds v to the "collections" field.
+func (u *AnalyticsContentUpsert) AddCollections(v int) *AnalyticsContentUpsert {
+	u.Add(analyticscontent.FieldCollections, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsContent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticscontent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsContentUpsertOne) UpdateNewValues() *AnalyticsContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(analyticscontent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(analyticscontent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsContent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnalyticsContentUpsertOne) Ignore() *AnalyticsContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsContentUpsertOne) DoNothing() *AnalyticsContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsContentCreate.OnConflict
+// documentation for more info.
+func (u *AnalyticsContentUpsertOne) Update(set func(*AnalyticsContentUpsert)) *AnalyticsContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsContentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *AnalyticsContentUpsertOne) SetDate(v time.Time) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateDate() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *AnalyticsContentUpsertOne) SetItemKind(v string) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateItemKind() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *AnalyticsContentUpsertOne) SetItemID(v xid.ID) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateItemID() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetViews sets the "views" field.
+func (u *AnalyticsContentUpsertOne) SetViews(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetViews(v)
+	})
+}
+
+// AddViews adds v to the "views" field.
+func (u *AnalyticsContentUpsertOne) AddViews(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddViews(v)
+	})
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateViews() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateViews()
+	})
+}
+
+// SetReplies sets the "replies" field.
+func (u *AnalyticsContentUpsertOne) SetReplies(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetReplies(v)
+	})
+}
+
+// AddReplies adds v to the "replies" field.
+func (u *AnalyticsContentUpsertOne) AddReplies(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddReplies(v)
+	})
+}
+
+// UpdateReplies sets the "replies" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateReplies() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateReplies()
+	})
+}
+
+// SetReactions sets the "reactions" field.
+func (u *AnalyticsContentUpsertOne) SetReactions(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetReactions(v)
+	})
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *AnalyticsContentUpsertOne) AddReactions(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddReactions(v)
+	})
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateReactions() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateReactions()
+	})
+}
+
+// SetLikes sets the "likes" field.
+func (u *AnalyticsContentUpsertOne) SetLikes(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetLikes(v)
+	})
+}
+
+// AddLikes adds v to the "likes" field.
+func (u *AnalyticsContentUpsertOne) AddLikes(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddLikes(v)
+	})
+}
+
+// UpdateLikes sets the "likes" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateLikes() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateLikes()
+	})
+}
+
+// SetCollections sets the "collections" field.
+func (u *AnalyticsContentUpsertOne) SetCollections(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetCollections(v)
+	})
+}
+
+// AddCollections adds v to the "collections" field.
+func (u *AnalyticsContentUpsertOne) AddCollections(v int) *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddCollections(v)
+	})
+}
+
+// UpdateCollections sets the "collections" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertOne) UpdateCollections() *AnalyticsContentUpsertOne {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateCollections()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsContentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsContentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsContentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnalyticsContentUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AnalyticsContentUpsertOne.ID is not supported by MySQL driver. Use AnalyticsContentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnalyticsContentUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnalyticsContentCreateBulk is the builder for creating many AnalyticsContent entities in bulk.
+type AnalyticsContentCreateBulk struct {
+	config
+	err      error
+	builders []*AnalyticsContentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AnalyticsContent entities in the database.
+func (_c *AnalyticsContentCreateBulk) Save(ctx context.Context) ([]*AnalyticsContent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnalyticsContent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnalyticsContentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnalyticsContentCreateBulk) SaveX(ctx context.Context) []*AnalyticsContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsContentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsContentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsContent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsContentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsContentCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnalyticsContentUpsertBulk {
+	_c.conflict = opts
+	return &AnalyticsContentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsContent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsContentCreateBulk) OnConflictColumns(columns ...string) *AnalyticsContentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsContentUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnalyticsContentUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnalyticsContent nodes.
+type AnalyticsContentUpsertBulk struct {
+	create *AnalyticsContentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnalyticsContent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticscontent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsContentUpsertBulk) UpdateNewValues() *AnalyticsContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(analyticscontent.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(analyticscontent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsContent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnalyticsContentUpsertBulk) Ignore() *AnalyticsContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsContentUpsertBulk) DoNothing() *AnalyticsContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsContentCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnalyticsContentUpsertBulk) Update(set func(*AnalyticsContentUpsert)) *AnalyticsContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsContentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *AnalyticsContentUpsertBulk) SetDate(v time.Time) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateDate() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *AnalyticsContentUpsertBulk) SetItemKind(v string) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateItemKind() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *AnalyticsContentUpsertBulk) SetItemID(v xid.ID) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateItemID() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetViews sets the "views" field.
+func (u *AnalyticsContentUpsertBulk) SetViews(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetViews(v)
+	})
+}
+
+// AddViews adds v to the "views" field.
+func (u *AnalyticsContentUpsertBulk) AddViews(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddViews(v)
+	})
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateViews() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateViews()
+	})
+}
+
+// SetReplies sets the "replies" field.
+func (u *AnalyticsContentUpsertBulk) SetReplies(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetReplies(v)
+	})
+}
+
+// AddReplies adds v to the "replies" field.
+func (u *AnalyticsContentUpsertBulk) AddReplies(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddReplies(v)
+	})
+}
+
+// UpdateReplies sets the "replies" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateReplies() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateReplies()
+	})
+}
+
+// SetReactions sets the "reactions" field.
+func (u *AnalyticsContentUpsertBulk) SetReactions(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetReactions(v)
+	})
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *AnalyticsContentUpsertBulk) AddReactions(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddReactions(v)
+	})
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateReactions() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateReactions()
+	})
+}
+
+// SetLikes sets the "likes" field.
+func (u *AnalyticsContentUpsertBulk) SetLikes(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetLikes(v)
+	})
+}
+
+// AddLikes adds v to the "likes" field.
+func (u *AnalyticsContentUpsertBulk) AddLikes(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddLikes(v)
+	})
+}
+
+// UpdateLikes sets the "likes" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateLikes() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateLikes()
+	})
+}
+
+// SetCollections sets the "collections" field.
+func (u *AnalyticsContentUpsertBulk) SetCollections(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.SetCollections(v)
+	})
+}
+
+// AddCollections adds v to the "collections" field.
+func (u *AnalyticsContentUpsertBulk) AddCollections(v int) *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.AddCollections(v)
+	})
+}
+
+// UpdateCollections sets the "collections" field to the value that was provided on create.
+func (u *AnalyticsContentUpsertBulk) UpdateCollections() *AnalyticsContentUpsertBulk {
+	return u.Update(func(s *AnalyticsContentUpsert) {
+		s.UpdateCollections()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsContentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnalyticsContentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsContentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsContentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
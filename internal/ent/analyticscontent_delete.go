@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AnalyticsContentDelete is the builder for deleting a AnalyticsContent entity.
+type AnalyticsContentDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnalyticsContentMutation
+}
+
+// Where appends a list predicates to the AnalyticsContentDelete builder.
+func (_d *AnalyticsContentDelete) Where(ps ...predicate.AnalyticsContent) *AnalyticsContentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnalyticsContentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsContentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnalyticsContentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(analyticscontent.Table, sqlgraph.NewFieldSpec(analyticscontent.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnalyticsContentDeleteOne is the builder for deleting a single AnalyticsContent entity.
+type AnalyticsContentDeleteOne struct {
+	_d *AnalyticsContentDelete
+}
+
+// Where appends a list predicates to the AnalyticsContentDelete builder.
+func (_d *AnalyticsContentDeleteOne) Where(ps ...predicate.AnalyticsContent) *AnalyticsContentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnalyticsContentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{analyticscontent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsContentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// AnalyticsContentUpdate is the builder for updating AnalyticsContent entities.
+type AnalyticsContentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnalyticsContentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnalyticsContentUpdate builder.
+func (_u *AnalyticsContentUpdate) Where(ps ...predicate.AnalyticsContent) *AnalyticsContentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *AnalyticsContentUpdate) SetDate(v time.Time) *AnalyticsContentUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableDate(v *time.Time) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *AnalyticsContentUpdate) SetItemKind(v string) *AnalyticsContentUpdate {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableItemKind(v *string) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *AnalyticsContentUpdate) SetItemID(v xid.ID) *AnalyticsContentUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableItemID(v *xid.ID) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetViews sets the "views" field.
+func (_u *AnalyticsContentUpdate) SetViews(v int) *AnalyticsContentUpdate {
+	_u.mutation.ResetViews()
+	_u.mutation.SetViews(v)
+	return _u
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableViews(v *int) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetViews(*v)
+	}
+	return _u
+}
+
+// AddViews adds value to the "views" field.
+func (_u *AnalyticsContentUpdate) AddViews(v int) *AnalyticsContentUpdate {
+	_u.mutation.AddViews(v)
+	return _u
+}
+
+// SetReplies sets the "replies" field.
+func (_u *AnalyticsContentUpdate) SetReplies(v int) *AnalyticsContentUpdate {
+	_u.mutation.ResetReplies()
+	_u.mutation.SetReplies(v)
+	return _u
+}
+
+// SetNillableReplies sets the "replies" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableReplies(v *int) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetReplies(*v)
+	}
+	return _u
+}
+
+// AddReplies adds value to the "replies" field.
+func (_u *AnalyticsContentUpdate) AddReplies(v int) *AnalyticsContentUpdate {
+	_u.mutation.AddReplies(v)
+	return _u
+}
+
+// SetReactions sets the "reactions" field.
+func (_u *AnalyticsContentUpdate) SetReactions(v int) *AnalyticsContentUpdate {
+	_u.mutation.ResetReactions()
+	_u.mutation.SetReactions(v)
+	return _u
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableReactions(v *int) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetReactions(*v)
+	}
+	return _u
+}
+
+// AddReactions adds value to the "reactions" field.
+func (_u *AnalyticsContentUpdate) AddReactions(v int) *AnalyticsContentUpdate {
+	_u.mutation.AddReactions(v)
+	return _u
+}
+
+// SetLikes sets the "likes" field.
+func (_u *AnalyticsContentUpdate) SetLikes(v int) *AnalyticsContentUpdate {
+	_u.mutation.ResetLikes()
+	_u.mutation.SetLikes(v)
+	return _u
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableLikes(v *int) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetLikes(*v)
+	}
+	return _u
+}
+
+// AddLikes adds value to the "likes" field.
+func (_u *AnalyticsContentUpdate) AddLikes(v int) *AnalyticsContentUpdate {
+	_u.mutation.AddLikes(v)
+	return _u
+}
+
+// SetCollections sets the "collections" field.
+func (_u *AnalyticsContentUpdate) SetCollections(v int) *AnalyticsContentUpdate {
+	_u.mutation.ResetCollections()
+	_u.mutation.SetCollections(v)
+	return _u
+}
+
+// SetNillableCollections sets the "collections" field if the given value is not nil.
+func (_u *AnalyticsContentUpdate) SetNillableCollections(v *int) *AnalyticsContentUpdate {
+	if v != nil {
+		_u.SetCollections(*v)
+	}
+	return _u
+}
+
+// AddCollections adds value to the "collections" field.
+func (_u *AnalyticsContentUpdate) AddCollections(v int) *AnalyticsContentUpdate {
+	_u.mutation.AddCollections(v)
+	return _u
+}
+
+// Mutation returns the AnalyticsContentMutation object of the builder.
+func (_u *AnalyticsContentUpdate) Mutation() *AnalyticsContentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnalyticsContentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsContentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnalyticsContentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsContentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsContentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsContentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsContentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticscontent.Table, analyticscontent.Columns, sqlgraph.NewFieldSpec(analyticscontent.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(analyticscontent.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(analyticscontent.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(analyticscontent.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Views(); ok {
+		_spec.SetField(analyticscontent.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViews(); ok {
+		_spec.AddField(analyticscontent.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Replies(); ok {
+		_spec.SetField(analyticscontent.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplies(); ok {
+		_spec.AddField(analyticscontent.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reactions(); ok {
+		_spec.SetField(analyticscontent.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReactions(); ok {
+		_spec.AddField(analyticscontent.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Likes(); ok {
+		_spec.SetField(analyticscontent.FieldLikes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLikes(); ok {
+		_spec.AddField(analyticscontent.FieldLikes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Collections(); ok {
+		_spec.SetField(analyticscontent.FieldCollections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCollections(); ok {
+		_spec.AddField(analyticscontent.FieldCollections, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticscontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnalyticsContentUpdateOne is the builder for updating a single AnalyticsContent entity.
+type AnalyticsContentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnalyticsContentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDate sets the "date" field.
+func (_u *AnalyticsContentUpdateOne) SetDate(v time.Time) *AnalyticsContentUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableDate(v *time.Time) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *AnalyticsContentUpdateOne) SetItemKind(v string) *AnalyticsContentUpdateOne {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableItemKind(v *string) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *AnalyticsContentUpdateOne) SetItemID(v xid.ID) *AnalyticsContentUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableItemID(v *xid.ID) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetViews sets the "views" field.
+func (_u *AnalyticsContentUpdateOne) SetViews(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.ResetViews()
+	_u.mutation.SetViews(v)
+	return _u
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableViews(v *int) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetViews(*v)
+	}
+	return _u
+}
+
+// AddViews adds value to the "views" field.
+func (_u *AnalyticsContentUpdateOne) AddViews(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.AddViews(v)
+	return _u
+}
+
+// SetReplies sets the "replies" field.
+func (_u *AnalyticsContentUpdateOne) SetReplies(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.ResetReplies()
+	_u.mutation.SetReplies(v)
+	return _u
+}
+
+// SetNillableReplies sets the "replies" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableReplies(v *int) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetReplies(*v)
+	}
+	return _u
+}
+
+// AddReplies adds value to the "replies" field.
+func (_u *AnalyticsContentUpdateOne) AddReplies(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.AddReplies(v)
+	return _u
+}
+
+// SetReactions sets the "reactions" field.
+func (_u *AnalyticsContentUpdateOne) SetReactions(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.ResetReactions()
+	_u.mutation.SetReactions(v)
+	return _u
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableReactions(v *int) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetReactions(*v)
+	}
+	return _u
+}
+
+// AddReactions adds value to the "reactions" field.
+func (_u *AnalyticsContentUpdateOne) AddReactions(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.AddReactions(v)
+	return _u
+}
+
+// SetLikes sets the "likes" field.
+func (_u *AnalyticsContentUpdateOne) SetLikes(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.ResetLikes()
+	_u.mutation.SetLikes(v)
+	return _u
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableLikes(v *int) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetLikes(*v)
+	}
+	return _u
+}
+
+// AddLikes adds value to the "likes" field.
+func (_u *AnalyticsContentUpdateOne) AddLikes(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.AddLikes(v)
+	return _u
+}
+
+// SetCollections sets the "collections" field.
+func (_u *AnalyticsContentUpdateOne) SetCollections(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.ResetCollections()
+	_u.mutation.SetCollections(v)
+	return _u
+}
+
+// SetNillableCollections sets the "collections" field if the given value is not nil.
+func (_u *AnalyticsContentUpdateOne) SetNillableCollections(v *int) *AnalyticsContentUpdateOne {
+	if v != nil {
+		_u.SetCollections(*v)
+	}
+	return _u
+}
+
+// AddCollections adds value to the "collections" field.
+func (_u *AnalyticsContentUpdateOne) AddCollections(v int) *AnalyticsContentUpdateOne {
+	_u.mutation.AddCollections(v)
+	return _u
+}
+
+// Mutation returns the AnalyticsContentMutation object of the builder.
+func (_u *AnalyticsContentUpdateOne) Mutation() *AnalyticsContentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AnalyticsContentUpdate builder.
+func (_u *AnalyticsContentUpdateOne) Where(ps ...predicate.AnalyticsContent) *AnalyticsContentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnalyticsContentUpdateOne) Select(field string, fields ...string) *AnalyticsContentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnalyticsContent entity.
+func (_u *AnalyticsContentUpdateOne) Save(ctx context.Context) (*AnalyticsContent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsContentUpdateOne) SaveX(ctx context.Context) *AnalyticsContent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnalyticsContentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsContentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsContentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsContentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsContentUpdateOne) sqlSave(ctx context.Context) (_node *AnalyticsContent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticscontent.Table, analyticscontent.Columns, sqlgraph.NewFieldSpec(analyticscontent.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnalyticsContent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, analyticscontent.FieldID)
+		for _, f := range fields {
+			if !analyticscontent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != analyticscontent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(analyticscontent.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(analyticscontent.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(analyticscontent.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Views(); ok {
+		_spec.SetField(analyticscontent.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViews(); ok {
+		_spec.AddField(analyticscontent.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Replies(); ok {
+		_spec.SetField(analyticscontent.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplies(); ok {
+		_spec.AddField(analyticscontent.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reactions(); ok {
+		_spec.SetField(analyticscontent.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReactions(); ok {
+		_spec.AddField(analyticscontent.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Likes(); ok {
+		_spec.SetField(analyticscontent.FieldLikes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLikes(); ok {
+		_spec.AddField(analyticscontent.FieldLikes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Collections(); ok {
+		_spec.SetField(analyticscontent.FieldCollections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCollections(); ok {
+		_spec.AddField(analyticscontent.FieldCollections, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AnalyticsContent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticscontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/rs/xid"
+)
+
+// AnalyticsDay is the model entity for the AnalyticsDay schema.
+type AnalyticsDay struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Signups holds the value of the "signups" field.
+	Signups int `json:"signups,omitempty"`
+	// DailyActive holds the value of the "daily_active" field.
+	DailyActive int `json:"daily_active,omitempty"`
+	// WeeklyActive holds the value of the "weekly_active" field.
+	WeeklyActive int `json:"weekly_active,omitempty"`
+	// MonthlyActive holds the value of the "monthly_active" field.
+	MonthlyActive int `json:"monthly_active,omitempty"`
+	// Threads holds the value of the "threads" field.
+	Threads int `json:"threads,omitempty"`
+	// Replies holds the value of the "replies" field.
+	Replies int `json:"replies,omitempty"`
+	// Reactions holds the value of the "reactions" field.
+	Reactions int `json:"reactions,omitempty"`
+	// Likes holds the value of the "likes" field.
+	Likes        int `json:"likes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnalyticsDay) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case analyticsday.FieldSignups, analyticsday.FieldDailyActive, analyticsday.FieldWeeklyActive, analyticsday.FieldMonthlyActive, analyticsday.FieldThreads, analyticsday.FieldReplies, analyticsday.FieldReactions, analyticsday.FieldLikes:
+			values[i] = new(sql.NullInt64)
+		case analyticsday.FieldCreatedAt, analyticsday.FieldUpdatedAt, analyticsday.FieldDate:
+			values[i] = new(sql.NullTime)
+		case analyticsday.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnalyticsDay fields.
+func (_m *AnalyticsDay) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case analyticsday.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case analyticsday.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case analyticsday.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case analyticsday.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case analyticsday.FieldSignups:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field signups", values[i])
+			} else if value.Valid {
+				_m.Signups = int(value.Int64)
+			}
+		case analyticsday.FieldDailyActive:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_active", values[i])
+			} else if value.Valid {
+				_m.DailyActive = int(value.Int64)
+			}
+		case analyticsday.FieldWeeklyActive:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weekly_active", values[i])
+			} else if value.Valid {
+				_m.WeeklyActive = int(value.Int64)
+			}
+		case analyticsday.FieldMonthlyActive:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_active", values[i])
+			} else if value.Valid {
+				_m.MonthlyActive = int(value.Int64)
+			}
+		case analyticsday.FieldThreads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field threads", values[i])
+			} else if value.Valid {
+				_m.Threads = int(value.Int64)
+			}
+		case analyticsday.FieldReplies:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field replies", values[i])
+			} else if value.Valid {
+				_m.Replies = int(value.Int64)
+			}
+		case analyticsday.FieldReactions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reactions", values[i])
+			} else if value.Valid {
+				_m.Reactions = int(value.Int64)
+			}
+		case analyticsday.FieldLikes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field likes", values[i])
+			} else if value.Valid {
+				_m.Likes = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnalyticsDay.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnalyticsDay) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AnalyticsDay.
+// Note that you need to call AnalyticsDay.Unwrap() before calling this method if this AnalyticsDay
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnalyticsDay) Update() *AnalyticsDayUpdateOne {
+	return NewAnalyticsDayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnalyticsDay entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnalyticsDay) Unwrap() *AnalyticsDay {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnalyticsDay is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnalyticsDay) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnalyticsDay(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("signups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Signups))
+	builder.WriteString(", ")
+	builder.WriteString("daily_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DailyActive))
+	builder.WriteString(", ")
+	builder.WriteString("weekly_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WeeklyActive))
+	builder.WriteString(", ")
+	builder.WriteString("monthly_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonthlyActive))
+	builder.WriteString(", ")
+	builder.WriteString("threads=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Threads))
+	builder.WriteString(", ")
+	builder.WriteString("replies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Replies))
+	builder.WriteString(", ")
+	builder.WriteString("reactions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reactions))
+	builder.WriteString(", ")
+	builder.WriteString("likes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Likes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnalyticsDays is a parsable slice of AnalyticsDay.
+type AnalyticsDays []*AnalyticsDay
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticsday
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the analyticsday type in the database.
+	Label = "analytics_day"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldSignups holds the string denoting the signups field in the database.
+	FieldSignups = "signups"
+	// FieldDailyActive holds the string denoting the daily_active field in the database.
+	FieldDailyActive = "daily_active"
+	// FieldWeeklyActive holds the string denoting the weekly_active field in the database.
+	FieldWeeklyActive = "weekly_active"
+	// FieldMonthlyActive holds the string denoting the monthly_active field in the database.
+	FieldMonthlyActive = "monthly_active"
+	// FieldThreads holds the string denoting the threads field in the database.
+	FieldThreads = "threads"
+	// FieldReplies holds the string denoting the replies field in the database.
+	FieldReplies = "replies"
+	// FieldReactions holds the string denoting the reactions field in the database.
+	FieldReactions = "reactions"
+	// FieldLikes holds the string denoting the likes field in the database.
+	FieldLikes = "likes"
+	// Table holds the table name of the analyticsday in the database.
+	Table = "analytics_days"
+)
+
+// Columns holds all SQL columns for analyticsday fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDate,
+	FieldSignups,
+	FieldDailyActive,
+	FieldWeeklyActive,
+	FieldMonthlyActive,
+	FieldThreads,
+	FieldReplies,
+	FieldReactions,
+	FieldLikes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultSignups holds the default value on creation for the "signups" field.
+	DefaultSignups int
+	// DefaultDailyActive holds the default value on creation for the "daily_active" field.
+	DefaultDailyActive int
+	// DefaultWeeklyActive holds the default value on creation for the "weekly_active" field.
+	DefaultWeeklyActive int
+	// DefaultMonthlyActive holds the default value on creation for the "monthly_active" field.
+	DefaultMonthlyActive int
+	// DefaultThreads holds the default value on creation for the "threads" field.
+	DefaultThreads int
+	// DefaultReplies holds the default value on creation for the "replies" field.
+	DefaultReplies int
+	// DefaultReactions holds the default value on creation for the "reactions" field.
+	DefaultReactions int
+	// DefaultLikes holds the default value on creation for the "likes" field.
+	DefaultLikes int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the AnalyticsDay queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// BySignups orders the results by the signups field.
+func BySignups(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignups, opts...).ToFunc()
+}
+
+// ByDailyActive orders the results by the daily_active field.
+func ByDailyActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyActive, opts...).ToFunc()
+}
+
+// ByWeeklyActive orders the results by the weekly_active field.
+func ByWeeklyActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeeklyActive, opts...).ToFunc()
+}
+
+// ByMonthlyActive orders the results by the monthly_active field.
+func ByMonthlyActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyActive, opts...).ToFunc()
+}
+
+// ByThreads orders the results by the threads field.
+func ByThreads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreads, opts...).ToFunc()
+}
+
+// ByReplies orders the results by the replies field.
+func ByReplies(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplies, opts...).ToFunc()
+}
+
+// ByReactions orders the results by the reactions field.
+func ByReactions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReactions, opts...).ToFunc()
+}
+
+// ByLikes orders the results by the likes field.
+func ByLikes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLikes, opts...).ToFunc()
+}
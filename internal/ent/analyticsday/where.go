@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticsday
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldDate, v))
+}
+
+// Signups applies equality check predicate on the "signups" field. It's identical to SignupsEQ.
+func Signups(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldSignups, v))
+}
+
+// DailyActive applies equality check predicate on the "daily_active" field. It's identical to DailyActiveEQ.
+func DailyActive(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldDailyActive, v))
+}
+
+// WeeklyActive applies equality check predicate on the "weekly_active" field. It's identical to WeeklyActiveEQ.
+func WeeklyActive(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldWeeklyActive, v))
+}
+
+// MonthlyActive applies equality check predicate on the "monthly_active" field. It's identical to MonthlyActiveEQ.
+func MonthlyActive(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldMonthlyActive, v))
+}
+
+// Threads applies equality check predicate on the "threads" field. It's identical to ThreadsEQ.
+func Threads(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldThreads, v))
+}
+
+// Replies applies equality check predicate on the "replies" field. It's identical to RepliesEQ.
+func Replies(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldReplies, v))
+}
+
+// Reactions applies equality check predicate on the "reactions" field. It's identical to ReactionsEQ.
+func Reactions(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldReactions, v))
+}
+
+// Likes applies equality check predicate on the "likes" field. It's identical to LikesEQ.
+func Likes(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldLikes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldDate, v))
+}
+
+// SignupsEQ applies the EQ predicate on the "signups" field.
+func SignupsEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldSignups, v))
+}
+
+// SignupsNEQ applies the NEQ predicate on the "signups" field.
+func SignupsNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldSignups, v))
+}
+
+// SignupsIn applies the In predicate on the "signups" field.
+func SignupsIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldSignups, vs...))
+}
+
+// SignupsNotIn applies the NotIn predicate on the "signups" field.
+func SignupsNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldSignups, vs...))
+}
+
+// SignupsGT applies the GT predicate on the "signups" field.
+func SignupsGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldSignups, v))
+}
+
+// SignupsGTE applies the GTE predicate on the "signups" field.
+func SignupsGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldSignups, v))
+}
+
+// SignupsLT applies the LT predicate on the "signups" field.
+func SignupsLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldSignups, v))
+}
+
+// SignupsLTE applies the LTE predicate on the "signups" field.
+func SignupsLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldSignups, v))
+}
+
+// DailyActiveEQ applies the EQ predicate on the "daily_active" field.
+func DailyActiveEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldDailyActive, v))
+}
+
+// DailyActiveNEQ applies the NEQ predicate on the "daily_active" field.
+func DailyActiveNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldDailyActive, v))
+}
+
+// DailyActiveIn applies the In predicate on the "daily_active" field.
+func DailyActiveIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldDailyActive, vs...))
+}
+
+// DailyActiveNotIn applies the NotIn predicate on the "daily_active" field.
+func DailyActiveNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldDailyActive, vs...))
+}
+
+// DailyActiveGT applies the GT predicate on the "daily_active" field.
+func DailyActiveGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldDailyActive, v))
+}
+
+// DailyActiveGTE applies the GTE predicate on the "daily_active" field.
+func DailyActiveGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldDailyActive, v))
+}
+
+// DailyActiveLT applies the LT predicate on the "daily_active" field.
+func DailyActiveLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldDailyActive, v))
+}
+
+// DailyActiveLTE applies the LTE predicate on the "daily_active" field.
+func DailyActiveLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldDailyActive, v))
+}
+
+// WeeklyActiveEQ applies the EQ predicate on the "weekly_active" field.
+func WeeklyActiveEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldWeeklyActive, v))
+}
+
+// WeeklyActiveNEQ applies the NEQ predicate on the "weekly_active" field.
+func WeeklyActiveNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldWeeklyActive, v))
+}
+
+// WeeklyActiveIn applies the In predicate on the "weekly_active" field.
+func WeeklyActiveIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldWeeklyActive, vs...))
+}
+
+// WeeklyActiveNotIn applies the NotIn predicate on the "weekly_active" field.
+func WeeklyActiveNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldWeeklyActive, vs...))
+}
+
+// WeeklyActiveGT applies the GT predicate on the "weekly_active" field.
+func WeeklyActiveGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldWeeklyActive, v))
+}
+
+// WeeklyActiveGTE applies the GTE predicate on the "weekly_active" field.
+func WeeklyActiveGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldWeeklyActive, v))
+}
+
+// WeeklyActiveLT applies the LT predicate on the "weekly_active" field.
+func WeeklyActiveLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldWeeklyActive, v))
+}
+
+// WeeklyActiveLTE applies the LTE predicate on the "weekly_active" field.
+func WeeklyActiveLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldWeeklyActive, v))
+}
+
+// MonthlyActiveEQ applies the EQ predicate on the "monthly_active" field.
+func MonthlyActiveEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldMonthlyActive, v))
+}
+
+// MonthlyActiveNEQ applies the NEQ predicate on the "monthly_active" field.
+func MonthlyActiveNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldMonthlyActive, v))
+}
+
+// MonthlyActiveIn applies the In predicate on the "monthly_active" field.
+func MonthlyActiveIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldMonthlyActive, vs...))
+}
+
+// MonthlyActiveNotIn applies the NotIn predicate on the "monthly_active" field.
+func MonthlyActiveNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldMonthlyActive, vs...))
+}
+
+// MonthlyActiveGT applies the GT predicate on the "monthly_active" field.
+func MonthlyActiveGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldMonthlyActive, v))
+}
+
+// MonthlyActiveGTE applies the GTE predicate on the "monthly_active" field.
+func MonthlyActiveGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldMonthlyActive, v))
+}
+
+// MonthlyActiveLT applies the LT predicate on the "monthly_active" field.
+func MonthlyActiveLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldMonthlyActive, v))
+}
+
+// MonthlyActiveLTE applies the LTE predicate on the "monthly_active" field.
+func MonthlyActiveLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldMonthlyActive, v))
+}
+
+// ThreadsEQ applies the EQ predicate on the "threads" field.
+func ThreadsEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldThreads, v))
+}
+
+// ThreadsNEQ applies the NEQ predicate on the "threads" field.
+func ThreadsNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldThreads, v))
+}
+
+// ThreadsIn applies the In predicate on the "threads" field.
+func ThreadsIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldThreads, vs...))
+}
+
+// ThreadsNotIn applies the NotIn predicate on the "threads" field.
+func ThreadsNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldThreads, vs...))
+}
+
+// ThreadsGT applies the GT predicate on the "threads" field.
+func ThreadsGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldThreads, v))
+}
+
+// ThreadsGTE applies the GTE predicate on the "threads" field.
+func ThreadsGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldThreads, v))
+}
+
+// ThreadsLT applies the LT predicate on the "threads" field.
+func ThreadsLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldThreads, v))
+}
+
+// ThreadsLTE applies the LTE predicate on the "threads" field.
+func ThreadsLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldThreads, v))
+}
+
+// RepliesEQ applies the EQ predicate on the "replies" field.
+func RepliesEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldReplies, v))
+}
+
+// RepliesNEQ applies the NEQ predicate on the "replies" field.
+func RepliesNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldReplies, v))
+}
+
+// RepliesIn applies the In predicate on the "replies" field.
+func RepliesIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldReplies, vs...))
+}
+
+// RepliesNotIn applies the NotIn predicate on the "replies" field.
+func RepliesNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldReplies, vs...))
+}
+
+// RepliesGT applies the GT predicate on the "replies" field.
+func RepliesGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldReplies, v))
+}
+
+// RepliesGTE applies the GTE predicate on the "replies" field.
+func RepliesGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldReplies, v))
+}
+
+// RepliesLT applies the LT predicate on the "replies" field.
+func RepliesLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldReplies, v))
+}
+
+// RepliesLTE applies the LTE predicate on the "replies" field.
+func RepliesLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldReplies, v))
+}
+
+// ReactionsEQ applies the EQ predicate on the "reactions" field.
+func ReactionsEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldReactions, v))
+}
+
+// ReactionsNEQ applies the NEQ predicate on the "reactions" field.
+func ReactionsNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldReactions, v))
+}
+
+// ReactionsIn applies the In predicate on the "reactions" field.
+func ReactionsIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldReactions, vs...))
+}
+
+// ReactionsNotIn applies the NotIn predicate on the "reactions" field.
+func ReactionsNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldReactions, vs...))
+}
+
+// ReactionsGT applies the GT predicate on the "reactions" field.
+func ReactionsGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldReactions, v))
+}
+
+// ReactionsGTE applies the GTE predicate on the "reactions" field.
+func ReactionsGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldReactions, v))
+}
+
+// ReactionsLT applies the LT predicate on the "reactions" field.
+func ReactionsLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldReactions, v))
+}
+
+// ReactionsLTE applies the LTE predicate on the "reactions" field.
+func ReactionsLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldReactions, v))
+}
+
+// LikesEQ applies the EQ predicate on the "likes" field.
+func LikesEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldEQ(FieldLikes, v))
+}
+
+// LikesNEQ applies the NEQ predicate on the "likes" field.
+func LikesNEQ(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNEQ(FieldLikes, v))
+}
+
+// LikesIn applies the In predicate on the "likes" field.
+func LikesIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldIn(FieldLikes, vs...))
+}
+
+// LikesNotIn applies the NotIn predicate on the "likes" field.
+func LikesNotIn(vs ...int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldNotIn(FieldLikes, vs...))
+}
+
+// LikesGT applies the GT predicate on the "likes" field.
+func LikesGT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGT(FieldLikes, v))
+}
+
+// LikesGTE applies the GTE predicate on the "likes" field.
+func LikesGTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldGTE(FieldLikes, v))
+}
+
+// LikesLT applies the LT predicate on the "likes" field.
+func LikesLT(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLT(FieldLikes, v))
+}
+
+// LikesLTE applies the LTE predicate on the "likes" field.
+func LikesLTE(v int) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.FieldLTE(FieldLikes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnalyticsDay) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnalyticsDay) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnalyticsDay) predicate.AnalyticsDay {
+	return predicate.AnalyticsDay(sql.NotPredicates(p))
+}
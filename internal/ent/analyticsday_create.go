@@ -0,0 +1,1290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/rs/xid"
+)
+
+// AnalyticsDayCreate is the builder for creating a AnalyticsDay entity.
+type AnalyticsDayCreate struct {
+	config
+	mutation *AnalyticsDayMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AnalyticsDayCreate) SetCreatedAt(v time.Time) *AnalyticsDayCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableCreatedAt(v *time.Time) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AnalyticsDayCreate) SetUpdatedAt(v time.Time) *AnalyticsDayCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableUpdatedAt(v *time.Time) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *AnalyticsDayCreate) SetDate(v time.Time) *AnalyticsDayCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetSignups sets the "signups" field.
+func (_c *AnalyticsDayCreate) SetSignups(v int) *AnalyticsDayCreate {
+	_c.mutation.SetSignups(v)
+	return _c
+}
+
+// SetNillableSignups sets the "signups" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableSignups(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetSignups(*v)
+	}
+	return _c
+}
+
+// SetDailyActive sets the "daily_active" field.
+func (_c *AnalyticsDayCreate) SetDailyActive(v int) *AnalyticsDayCreate {
+	_c.mutation.SetDailyActive(v)
+	return _c
+}
+
+// SetNillableDailyActive sets the "daily_active" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableDailyActive(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetDailyActive(*v)
+	}
+	return _c
+}
+
+// SetWeeklyActive sets the "weekly_active" field.
+func (_c *AnalyticsDayCreate) SetWeeklyActive(v int) *AnalyticsDayCreate {
+	_c.mutation.SetWeeklyActive(v)
+	return _c
+}
+
+// SetNillableWeeklyActive sets the "weekly_active" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableWeeklyActive(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetWeeklyActive(*v)
+	}
+	return _c
+}
+
+// SetMonthlyActive sets the "monthly_active" field.
+func (_c *AnalyticsDayCreate) SetMonthlyActive(v int) *AnalyticsDayCreate {
+	_c.mutation.SetMonthlyActive(v)
+	return _c
+}
+
+// SetNillableMonthlyActive sets the "monthly_active" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableMonthlyActive(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetMonthlyActive(*v)
+	}
+	return _c
+}
+
+// SetThreads sets the "threads" field.
+func (_c *AnalyticsDayCreate) SetThreads(v int) *AnalyticsDayCreate {
+	_c.mutation.SetThreads(v)
+	return _c
+}
+
+// SetNillableThreads sets the "threads" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableThreads(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetThreads(*v)
+	}
+	return _c
+}
+
+// SetReplies sets the "replies" field.
+func (_c *AnalyticsDayCreate) SetReplies(v int) *AnalyticsDayCreate {
+	_c.mutation.SetReplies(v)
+	return _c
+}
+
+// SetNillableReplies sets the "replies" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableReplies(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetReplies(*v)
+	}
+	return _c
+}
+
+// SetReactions sets the "reactions" field.
+func (_c *AnalyticsDayCreate) SetReactions(v int) *AnalyticsDayCreate {
+	_c.mutation.SetReactions(v)
+	return _c
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableReactions(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetReactions(*v)
+	}
+	return _c
+}
+
+// SetLikes sets the "likes" field.
+func (_c *AnalyticsDayCreate) SetLikes(v int) *AnalyticsDayCreate {
+	_c.mutation.SetLikes(v)
+	return _c
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableLikes(v *int) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetLikes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnalyticsDayCreate) SetID(v xid.ID) *AnalyticsDayCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AnalyticsDayCreate) SetNillableID(v *xid.ID) *AnalyticsDayCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AnalyticsDayMutation object of the builder.
+func (_c *AnalyticsDayCreate) Mutation() *AnalyticsDayMutation {
+	return _c.mutation
+}
+
+// Save creates the AnalyticsDay in the database.
+func (_c *AnalyticsDayCreate) Save(ctx context.Context) (*AnalyticsDay, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnalyticsDayCreate) SaveX(ctx context.Context) *AnalyticsDay {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsDayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsDayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnalyticsDayCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := analyticsday.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := analyticsday.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Signups(); !ok {
+		v := analyticsday.DefaultSignups
+		_c.mutation.SetSignups(v)
+	}
+	if _, ok := _c.mutation.DailyActive(); !ok {
+		v := analyticsday.DefaultDailyActive
+		_c.mutation.SetDailyActive(v)
+	}
+	if _, ok := _c.mutation.WeeklyActive(); !ok {
+		v := analyticsday.DefaultWeeklyActive
+		_c.mutation.SetWeeklyActive(v)
+	}
+	if _, ok := _c.mutation.MonthlyActive(); !ok {
+		v := analyticsday.DefaultMonthlyActive
+		_c.mutation.SetMonthlyActive(v)
+	}
+	if _, ok := _c.mutation.Threads(); !ok {
+		v := analyticsday.DefaultThreads
+		_c.mutation.SetThreads(v)
+	}
+	if _, ok := _c.mutation.Replies(); !ok {
+		v := analyticsday.DefaultReplies
+		_c.mutation.SetReplies(v)
+	}
+	if _, ok := _c.mutation.Reactions(); !ok {
+		v := analyticsday.DefaultReactions
+		_c.mutation.SetReactions(v)
+	}
+	if _, ok := _c.mutation.Likes(); !ok {
+		v := analyticsday.DefaultLikes
+		_c.mutation.SetLikes(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := analyticsday.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnalyticsDayCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AnalyticsDay.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AnalyticsDay.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "AnalyticsDay.date"`)}
+	}
+	if _, ok := _c.mutation.Signups(); !ok {
+		return &ValidationError{Name: "signups", err: errors.New(`ent: missing required field "AnalyticsDay.signups"`)}
+	}
+	if _, ok := _c.mutation.DailyActive(); !ok {
+		return &ValidationError{Name: "daily_active", err: errors.New(`ent: missing required field "AnalyticsDay.daily_active"`)}
+	}
+	if _, ok := _c.mutation.WeeklyActive(); !ok {
+		return &ValidationError{Name: "weekly_active", err: errors.New(`ent: missing required field "AnalyticsDay.weekly_active"`)}
+	}
+	if _, ok := _c.mutation.MonthlyActive(); !ok {
+		return &ValidationError{Name: "monthly_active", err: errors.New(`ent: missing required field "AnalyticsDay.monthly_active"`)}
+	}
+	if _, ok := _c.mutation.Threads(); !ok {
+		return &ValidationError{Name: "threads", err: errors.New(`ent: missing required field "AnalyticsDay.threads"`)}
+	}
+	if _, ok := _c.mutation.Replies(); !ok {
+		return &ValidationError{Name: "replies", err: errors.New(`ent: missing required field "AnalyticsDay.replies"`)}
+	}
+	if _, ok := _c.mutation.Reactions(); !ok {
+		return &ValidationError{Name: "reactions", err: errors.New(`ent: missing required field "AnalyticsDay.reactions"`)}
+	}
+	if _, ok := _c.mutation.Likes(); !ok {
+		return &ValidationError{Name: "likes", err: errors.New(`ent: missing required field "AnalyticsDay.likes"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := analyticsday.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AnalyticsDay.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AnalyticsDayCreate) sqlSave(ctx context.Context) (*AnalyticsDay, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnalyticsDayCreate) createSpec() (*AnalyticsDay, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnalyticsDay{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(analyticsday.Table, sqlgraph.NewFieldSpec(analyticsday.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(analyticsday.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticsday.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(analyticsday.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Signups(); ok {
+		_spec.SetField(analyticsday.FieldSignups, field.TypeInt, value)
+		_node.Signups = value
+	}
+	if value, ok := _c.mutation.DailyActive(); ok {
+		_spec.SetField(analyticsday.FieldDailyActive, field.TypeInt, value)
+		_node.DailyActive = value
+	}
+	if value, ok := _c.mutation.WeeklyActive(); ok {
+		_spec.SetField(analyticsday.FieldWeeklyActive, field.TypeInt, value)
+		_node.WeeklyActive = value
+	}
+	if value, ok := _c.mutation.MonthlyActive(); ok {
+		_spec.SetField(analyticsday.FieldMonthlyActive, field.TypeInt, value)
+		_node.MonthlyActive = value
+	}
+	if value, ok := _c.mutation.Threads(); ok {
+		_spec.SetField(analyticsday.FieldThreads, field.TypeInt, value)
+		_node.Threads = value
+	}
+	if value, ok := _c.mutation.Replies(); ok {
+		_spec.SetField(analyticsday.FieldReplies, field.TypeInt, value)
+		_node.Replies = value
+	}
+	if value, ok := _c.mutation.Reactions(); ok {
+		_spec.SetField(analyticsday.FieldReactions, field.TypeInt, value)
+		_node.Reactions = value
+	}
+	if value, ok := _c.mutation.Likes(); ok {
+		_spec.SetField(analyticsday.FieldLikes, field.TypeInt, value)
+		_node.Likes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsDay.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsDayUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsDayCreate) OnConflict(opts ...sql.ConflictOption) *AnalyticsDayUpsertOne {
+	_c.conflict = opts
+	return &AnalyticsDayUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsDay.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsDayCreate) OnConflictColumns(columns ...string) *AnalyticsDayUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsDayUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnalyticsDayUpsertOne is the builder for "upsert"-ing
+	//  one AnalyticsDay node.
+	AnalyticsDayUpsertOne struct {
+		create *AnalyticsDayCreate
+	}
+
+	// AnalyticsDayUpsert is the "OnConflict" setter.
+	AnalyticsDayUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsDayUpsert) SetUpdatedAt(v time.Time) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateUpdatedAt() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldUpdatedAt)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *AnalyticsDayUpsert) SetDate(v time.Time) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateDate() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldDate)
+	return u
+}
+
+// SetSignups sets the "signups" field.
+func (u *AnalyticsDayUpsert) SetSignups(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldSignups, v)
+	return u
+}
+
+// UpdateSignups sets the "signups" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateSignups() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldSignups)
+	return u
+}
+
+// AddSignups adds v to the "signups" field.
+func (u *AnalyticsDayUpsert) AddSignups(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldSignups, v)
+	return u
+}
+
+// SetDailyActive sets the "daily_active" field.
+func (u *AnalyticsDayUpsert) SetDailyActive(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldDailyActive, v)
+	return u
+}
+
+// UpdateDailyActive sets the "daily_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateDailyActive() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldDailyActive)
+	return u
+}
+
+// AddDailyActive adds v to the "daily_active" field.
+func (u *AnalyticsDayUpsert) AddDailyActive(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldDailyActive, v)
+	return u
+}
+
+// SetWeeklyActive sets the "weekly_active" field.
+func (u *AnalyticsDayUpsert) SetWeeklyActive(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldWeeklyActive, v)
+	return u
+}
+
+// UpdateWeeklyActive sets the "weekly_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateWeeklyActive() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldWeeklyActive)
+	return u
+}
+
+// AddWeeklyActive adds v to the "weekly_active" field.
+func (u *AnalyticsDayUpsert) AddWeeklyActive(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldWeeklyActive, v)
+	return u
+}
+
+// SetMonthlyActive sets the "monthly_active" field.
+func (u *AnalyticsDayUpsert) SetMonthlyActive(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldMonthlyActive, v)
+	return u
+}
+
+// UpdateMonthlyActive sets the "monthly_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateMonthlyActive() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldMonthlyActive)
+	return u
+}
+
+// AddMonthlyActive adds v to the "monthly_active" field.
+func (u *AnalyticsDayUpsert) AddMonthlyActive(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldMonthlyActive, v)
+	return u
+}
+
+// SetThreads sets the "threads" field.
+func (u *AnalyticsDayUpsert) SetThreads(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldThreads, v)
+	return u
+}
+
+// UpdateThreads sets the "threads" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateThreads() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldThreads)
+	return u
+}
+
+// AddThreads adds v to the "threads" field.
+func (u *AnalyticsDayUpsert) AddThreads(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldThreads, v)
+	return u
+}
+
+// SetReplies sets the "replies" field.
+func (u *AnalyticsDayUpsert) SetReplies(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldReplies, v)
+	return u
+}
+
+// UpdateReplies sets the "replies" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateReplies() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldReplies)
+	return u
+}
+
+// AddReplies adds v to the "replies" field.
+func (u *AnalyticsDayUpsert) AddReplies(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldReplies, v)
+	return u
+}
+
+// SetReactions sets the "reactions" field.
+func (u *AnalyticsDayUpsert) SetReactions(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldReactions, v)
+	return u
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateReactions() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldReactions)
+	return u
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *AnalyticsDayUpsert) AddReactions(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldReactions, v)
+	return u
+}
+
+// SetLikes sets the "likes" field.
+func (u *AnalyticsDayUpsert) SetLikes(v int) *AnalyticsDayUpsert {
+	u.Set(analyticsday.FieldLikes, v)
+	return u
+}
+
+// UpdateLikes sets the "likes" field to the value that was provided on create.
+func (u *AnalyticsDayUpsert) UpdateLikes() *AnalyticsDayUpsert {
+	u.SetExcluded(analyticsday.FieldLikes)
+	return u
+}
+
+// AddLikes adds v to the "likes" field.
+func (u *AnalyticsDayUpsert) AddLikes(v int) *AnalyticsDayUpsert {
+	u.Add(analyticsday.FieldLikes, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsDay.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticsday.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsDayUpsertOne) UpdateNewValues() *AnalyticsDayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(analyticsday.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(analyticsday.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsDay.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnalyticsDayUpsertOne) Ignore() *AnalyticsDayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsDayUpsertOne) DoNothing() *AnalyticsDayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsDayCreate.OnConflict
+// documentation for more info.
+func (u *AnalyticsDayUpsertOne) Update(set func(*AnalyticsDayUpsert)) *AnalyticsDayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsDayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsDayUpsertOne) SetUpdatedAt(v time.Time) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateUpdatedAt() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *AnalyticsDayUpsertOne) SetDate(v time.Time) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateDate() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetSignups sets the "signups" field.
+func (u *AnalyticsDayUpsertOne) SetSignups(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetSignups(v)
+	})
+}
+
+// AddSignups adds v to the "signups" field.
+func (u *AnalyticsDayUpsertOne) AddSignups(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddSignups(v)
+	})
+}
+
+// UpdateSignups sets the "signups" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateSignups() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateSignups()
+	})
+}
+
+// SetDailyActive sets the "daily_active" field.
+func (u *AnalyticsDayUpsertOne) SetDailyActive(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetDailyActive(v)
+	})
+}
+
+// AddDailyActive adds v to the "daily_active" field.
+func (u *AnalyticsDayUpsertOne) AddDailyActive(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddDailyActive(v)
+	})
+}
+
+// UpdateDailyActive sets the "daily_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateDailyActive() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateDailyActive()
+	})
+}
+
+// SetWeeklyActive sets the "weekly_active" field.
+func (u *AnalyticsDayUpsertOne) SetWeeklyActive(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetWeeklyActive(v)
+	})
+}
+
+// AddWeeklyActive adds v to the "weekly_active" field.
+func (u *AnalyticsDayUpsertOne) AddWeeklyActive(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddWeeklyActive(v)
+	})
+}
+
+// UpdateWeeklyActive sets the "weekly_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateWeeklyActive() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateWeeklyActive()
+	})
+}
+
+// SetMonthlyActive sets the "monthly_active" field.
+func (u *AnalyticsDayUpsertOne) SetMonthlyActive(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetMonthlyActive(v)
+	})
+}
+
+// AddMonthlyActive adds v to the "monthly_active" field.
+func (u *AnalyticsDayUpsertOne) AddMonthlyActive(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddMonthlyActive(v)
+	})
+}
+
+// UpdateMonthlyActive sets the "monthly_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateMonthlyActive() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateMonthlyActive()
+	})
+}
+
+// SetThreads sets the "threads" field.
+func (u *AnalyticsDayUpsertOne) SetThreads(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetThreads(v)
+	})
+}
+
+// AddThreads adds v to the "threads" field.
+func (u *AnalyticsDayUpsertOne) AddThreads(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddThreads(v)
+	})
+}
+
+// UpdateThreads sets the "threads" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateThreads() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateThreads()
+	})
+}
+
+// SetReplies sets the "replies" field.
+func (u *AnalyticsDayUpsertOne) SetReplies(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetReplies(v)
+	})
+}
+
+// AddReplies adds v to the "replies" field.
+func (u *AnalyticsDayUpsertOne) AddReplies(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddReplies(v)
+	})
+}
+
+// UpdateReplies sets the "replies" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateReplies() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateReplies()
+	})
+}
+
+// SetReactions sets the "reactions" field.
+func (u *AnalyticsDayUpsertOne) SetReactions(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetReactions(v)
+	})
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *AnalyticsDayUpsertOne) AddReactions(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddReactions(v)
+	})
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateReactions() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateReactions()
+	})
+}
+
+// SetLikes sets the "likes" field.
+func (u *AnalyticsDayUpsertOne) SetLikes(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetLikes(v)
+	})
+}
+
+// AddLikes adds v to the "likes" field.
+func (u *AnalyticsDayUpsertOne) AddLikes(v int) *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddLikes(v)
+	})
+}
+
+// UpdateLikes sets the "likes" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertOne) UpdateLikes() *AnalyticsDayUpsertOne {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateLikes()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsDayUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsDayCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsDayUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnalyticsDayUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AnalyticsDayUpsertOne.ID is not supported by MySQL driver. Use AnalyticsDayUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnalyticsDayUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnalyticsDayCreateBulk is the builder for creating many AnalyticsDay entities in bulk.
+type AnalyticsDayCreateBulk struct {
+	config
+	err      error
+	builders []*AnalyticsDayCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AnalyticsDay entities in the database.
+func (_c *AnalyticsDayCreateBulk) Save(ctx context.Context) ([]*AnalyticsDay, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnalyticsDay, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnalyticsDayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnalyticsDayCreateBulk) SaveX(ctx context.Context) []*AnalyticsDay {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsDayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsDayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsDay.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsDayUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsDayCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnalyticsDayUpsertBulk {
+	_c.conflict = opts
+	return &AnalyticsDayUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsDay.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsDayCreateBulk) OnConflictColumns(columns ...string) *AnalyticsDayUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsDayUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnalyticsDayUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnalyticsDay nodes.
+type AnalyticsDayUpsertBulk struct {
+	create *AnalyticsDayCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnalyticsDay.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticsday.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsDayUpsertBulk) UpdateNewValues() *AnalyticsDayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(analyticsday.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(analyticsday.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsDay.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnalyticsDayUpsertBulk) Ignore() *AnalyticsDayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsDayUpsertBulk) DoNothing() *AnalyticsDayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsDayCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnalyticsDayUpsertBulk) Update(set func(*AnalyticsDayUpsert)) *AnalyticsDayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsDayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsDayUpsertBulk) SetUpdatedAt(v time.Time) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateUpdatedAt() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *AnalyticsDayUpsertBulk) SetDate(v time.Time) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateDate() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetSignups sets the "signups" field.
+func (u *AnalyticsDayUpsertBulk) SetSignups(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetSignups(v)
+	})
+}
+
+// AddSignups adds v to the "signups" field.
+func (u *AnalyticsDayUpsertBulk) AddSignups(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddSignups(v)
+	})
+}
+
+// UpdateSignups sets the "signups" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateSignups() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateSignups()
+	})
+}
+
+// SetDailyActive sets the "daily_active" field.
+func (u *AnalyticsDayUpsertBulk) SetDailyActive(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetDailyActive(v)
+	})
+}
+
+// AddDailyActive adds v to the "daily_active" field.
+func (u *AnalyticsDayUpsertBulk) AddDailyActive(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddDailyActive(v)
+	})
+}
+
+// UpdateDailyActive sets the "daily_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateDailyActive() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateDailyActive()
+	})
+}
+
+// SetWeeklyActive sets the "weekly_active" field.
+func (u *AnalyticsDayUpsertBulk) SetWeeklyActive(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetWeeklyActive(v)
+	})
+}
+
+// AddWeeklyActive adds v to the "weekly_active" field.
+func (u *AnalyticsDayUpsertBulk) AddWeeklyActive(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddWeeklyActive(v)
+	})
+}
+
+// UpdateWeeklyActive sets the "weekly_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateWeeklyActive() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateWeeklyActive()
+	})
+}
+
+// SetMonthlyActive sets the "monthly_active" field.
+func (u *AnalyticsDayUpsertBulk) SetMonthlyActive(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetMonthlyActive(v)
+	})
+}
+
+// AddMonthlyActive adds v to the "monthly_active" field.
+func (u *AnalyticsDayUpsertBulk) AddMonthlyActive(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddMonthlyActive(v)
+	})
+}
+
+// UpdateMonthlyActive sets the "monthly_active" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateMonthlyActive() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateMonthlyActive()
+	})
+}
+
+// SetThreads sets the "threads" field.
+func (u *AnalyticsDayUpsertBulk) SetThreads(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetThreads(v)
+	})
+}
+
+// AddThreads adds v to the "threads" field.
+func (u *AnalyticsDayUpsertBulk) AddThreads(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddThreads(v)
+	})
+}
+
+// UpdateThreads sets the "threads" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateThreads() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateThreads()
+	})
+}
+
+// SetReplies sets the "replies" field.
+func (u *AnalyticsDayUpsertBulk) SetReplies(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetReplies(v)
+	})
+}
+
+// AddReplies adds v to the "replies" field.
+func (u *AnalyticsDayUpsertBulk) AddReplies(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddReplies(v)
+	})
+}
+
+// UpdateReplies sets the "replies" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateReplies() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateReplies()
+	})
+}
+
+// SetReactions sets the "reactions" field.
+func (u *AnalyticsDayUpsertBulk) SetReactions(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetReactions(v)
+	})
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *AnalyticsDayUpsertBulk) AddReactions(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddReactions(v)
+	})
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateReactions() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateReactions()
+	})
+}
+
+// SetLikes sets the "likes" field.
+func (u *AnalyticsDayUpsertBulk) SetLikes(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.SetLikes(v)
+	})
+}
+
+// AddLikes adds v to the "likes" field.
+func (u *AnalyticsDayUpsertBulk) AddLikes(v int) *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.AddLikes(v)
+	})
+}
+
+// UpdateLikes sets the "likes" field to the value that was provided on create.
+func (u *AnalyticsDayUpsertBulk) UpdateLikes() *AnalyticsDayUpsertBulk {
+	return u.Update(func(s *AnalyticsDayUpsert) {
+		s.UpdateLikes()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsDayUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnalyticsDayCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsDayCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsDayUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AnalyticsDayDelete is the builder for deleting a AnalyticsDay entity.
+type AnalyticsDayDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnalyticsDayMutation
+}
+
+// Where appends a list predicates to the AnalyticsDayDelete builder.
+func (_d *AnalyticsDayDelete) Where(ps ...predicate.AnalyticsDay) *AnalyticsDayDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnalyticsDayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsDayDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnalyticsDayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(analyticsday.Table, sqlgraph.NewFieldSpec(analyticsday.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnalyticsDayDeleteOne is the builder for deleting a single AnalyticsDay entity.
+type AnalyticsDayDeleteOne struct {
+	_d *AnalyticsDayDelete
+}
+
+// Where appends a list predicates to the AnalyticsDayDelete builder.
+func (_d *AnalyticsDayDeleteOne) Where(ps ...predicate.AnalyticsDay) *AnalyticsDayDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnalyticsDayDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{analyticsday.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsDayDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AnalyticsDayUpdate is the builder for updating AnalyticsDay entities.
+type AnalyticsDayUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnalyticsDayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnalyticsDayUpdate builder.
+func (_u *AnalyticsDayUpdate) Where(ps ...predicate.AnalyticsDay) *AnalyticsDayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnalyticsDayUpdate) SetUpdatedAt(v time.Time) *AnalyticsDayUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *AnalyticsDayUpdate) SetDate(v time.Time) *AnalyticsDayUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableDate(v *time.Time) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetSignups sets the "signups" field.
+func (_u *AnalyticsDayUpdate) SetSignups(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetSignups()
+	_u.mutation.SetSignups(v)
+	return _u
+}
+
+// SetNillableSignups sets the "signups" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableSignups(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetSignups(*v)
+	}
+	return _u
+}
+
+// AddSignups adds value to the "signups" field.
+func (_u *AnalyticsDayUpdate) AddSignups(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddSignups(v)
+	return _u
+}
+
+// SetDailyActive sets the "daily_active" field.
+func (_u *AnalyticsDayUpdate) SetDailyActive(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetDailyActive()
+	_u.mutation.SetDailyActive(v)
+	return _u
+}
+
+// SetNillableDailyActive sets the "daily_active" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableDailyActive(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetDailyActive(*v)
+	}
+	return _u
+}
+
+// AddDailyActive adds value to the "daily_active" field.
+func (_u *AnalyticsDayUpdate) AddDailyActive(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddDailyActive(v)
+	return _u
+}
+
+// SetWeeklyActive sets the "weekly_active" field.
+func (_u *AnalyticsDayUpdate) SetWeeklyActive(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetWeeklyActive()
+	_u.mutation.SetWeeklyActive(v)
+	return _u
+}
+
+// SetNillableWeeklyActive sets the "weekly_active" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableWeeklyActive(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetWeeklyActive(*v)
+	}
+	return _u
+}
+
+// AddWeeklyActive adds value to the "weekly_active" field.
+func (_u *AnalyticsDayUpdate) AddWeeklyActive(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddWeeklyActive(v)
+	return _u
+}
+
+// SetMonthlyActive sets the "monthly_active" field.
+func (_u *AnalyticsDayUpdate) SetMonthlyActive(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetMonthlyActive()
+	_u.mutation.SetMonthlyActive(v)
+	return _u
+}
+
+// SetNillableMonthlyActive sets the "monthly_active" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableMonthlyActive(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetMonthlyActive(*v)
+	}
+	return _u
+}
+
+// AddMonthlyActive adds value to the "monthly_active" field.
+func (_u *AnalyticsDayUpdate) AddMonthlyActive(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddMonthlyActive(v)
+	return _u
+}
+
+// SetThreads sets the "threads" field.
+func (_u *AnalyticsDayUpdate) SetThreads(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetThreads()
+	_u.mutation.SetThreads(v)
+	return _u
+}
+
+// SetNillableThreads sets the "threads" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableThreads(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetThreads(*v)
+	}
+	return _u
+}
+
+// AddThreads adds value to the "threads" field.
+func (_u *AnalyticsDayUpdate) AddThreads(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddThreads(v)
+	return _u
+}
+
+// SetReplies sets the "replies" field.
+func (_u *AnalyticsDayUpdate) SetReplies(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetReplies()
+	_u.mutation.SetReplies(v)
+	return _u
+}
+
+// SetNillableReplies sets the "replies" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableReplies(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetReplies(*v)
+	}
+	return _u
+}
+
+// AddReplies adds value to the "replies" field.
+func (_u *AnalyticsDayUpdate) AddReplies(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddReplies(v)
+	return _u
+}
+
+// SetReactions sets the "reactions" field.
+func (_u *AnalyticsDayUpdate) SetReactions(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetReactions()
+	_u.mutation.SetReactions(v)
+	return _u
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableReactions(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetReactions(*v)
+	}
+	return _u
+}
+
+// AddReactions adds value to the "reactions" field.
+func (_u *AnalyticsDayUpdate) AddReactions(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddReactions(v)
+	return _u
+}
+
+// SetLikes sets the "likes" field.
+func (_u *AnalyticsDayUpdate) SetLikes(v int) *AnalyticsDayUpdate {
+	_u.mutation.ResetLikes()
+	_u.mutation.SetLikes(v)
+	return _u
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (_u *AnalyticsDayUpdate) SetNillableLikes(v *int) *AnalyticsDayUpdate {
+	if v != nil {
+		_u.SetLikes(*v)
+	}
+	return _u
+}
+
+// AddLikes adds value to the "likes" field.
+func (_u *AnalyticsDayUpdate) AddLikes(v int) *AnalyticsDayUpdate {
+	_u.mutation.AddLikes(v)
+	return _u
+}
+
+// Mutation returns the AnalyticsDayMutation object of the builder.
+func (_u *AnalyticsDayUpdate) Mutation() *AnalyticsDayMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnalyticsDayUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsDayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnalyticsDayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsDayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnalyticsDayUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := analyticsday.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsDayUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsDayUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsDayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticsday.Table, analyticsday.Columns, sqlgraph.NewFieldSpec(analyticsday.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticsday.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(analyticsday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Signups(); ok {
+		_spec.SetField(analyticsday.FieldSignups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSignups(); ok {
+		_spec.AddField(analyticsday.FieldSignups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DailyActive(); ok {
+		_spec.SetField(analyticsday.FieldDailyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDailyActive(); ok {
+		_spec.AddField(analyticsday.FieldDailyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WeeklyActive(); ok {
+		_spec.SetField(analyticsday.FieldWeeklyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeeklyActive(); ok {
+		_spec.AddField(analyticsday.FieldWeeklyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MonthlyActive(); ok {
+		_spec.SetField(analyticsday.FieldMonthlyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyActive(); ok {
+		_spec.AddField(analyticsday.FieldMonthlyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Threads(); ok {
+		_spec.SetField(analyticsday.FieldThreads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreads(); ok {
+		_spec.AddField(analyticsday.FieldThreads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Replies(); ok {
+		_spec.SetField(analyticsday.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplies(); ok {
+		_spec.AddField(analyticsday.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reactions(); ok {
+		_spec.SetField(analyticsday.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReactions(); ok {
+		_spec.AddField(analyticsday.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Likes(); ok {
+		_spec.SetField(analyticsday.FieldLikes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLikes(); ok {
+		_spec.AddField(analyticsday.FieldLikes, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticsday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnalyticsDayUpdateOne is the builder for updating a single AnalyticsDay entity.
+type AnalyticsDayUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnalyticsDayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnalyticsDayUpdateOne) SetUpdatedAt(v time.Time) *AnalyticsDayUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *AnalyticsDayUpdateOne) SetDate(v time.Time) *AnalyticsDayUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableDate(v *time.Time) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetSignups sets the "signups" field.
+func (_u *AnalyticsDayUpdateOne) SetSignups(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetSignups()
+	_u.mutation.SetSignups(v)
+	return _u
+}
+
+// SetNillableSignups sets the "signups" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableSignups(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetSignups(*v)
+	}
+	return _u
+}
+
+// AddSignups adds value to the "signups" field.
+func (_u *AnalyticsDayUpdateOne) AddSignups(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddSignups(v)
+	return _u
+}
+
+// SetDailyActive sets the "daily_active" field.
+func (_u *AnalyticsDayUpdateOne) SetDailyActive(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetDailyActive()
+	_u.mutation.SetDailyActive(v)
+	return _u
+}
+
+// SetNillableDailyActive sets the "daily_active" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableDailyActive(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetDailyActive(*v)
+	}
+	return _u
+}
+
+// AddDailyActive adds value to the "daily_active" field.
+func (_u *AnalyticsDayUpdateOne) AddDailyActive(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddDailyActive(v)
+	return _u
+}
+
+// SetWeeklyActive sets the "weekly_active" field.
+func (_u *AnalyticsDayUpdateOne) SetWeeklyActive(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetWeeklyActive()
+	_u.mutation.SetWeeklyActive(v)
+	return _u
+}
+
+// SetNillableWeeklyActive sets the "weekly_active" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableWeeklyActive(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetWeeklyActive(*v)
+	}
+	return _u
+}
+
+// AddWeeklyActive adds value to the "weekly_active" field.
+func (_u *AnalyticsDayUpdateOne) AddWeeklyActive(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddWeeklyActive(v)
+	return _u
+}
+
+// SetMonthlyActive sets the "monthly_active" field.
+func (_u *AnalyticsDayUpdateOne) SetMonthlyActive(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetMonthlyActive()
+	_u.mutation.SetMonthlyActive(v)
+	return _u
+}
+
+// SetNillableMonthlyActive sets the "monthly_active" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableMonthlyActive(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetMonthlyActive(*v)
+	}
+	return _u
+}
+
+// AddMonthlyActive adds value to the "monthly_active" field.
+func (_u *AnalyticsDayUpdateOne) AddMonthlyActive(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddMonthlyActive(v)
+	return _u
+}
+
+// SetThreads sets the "threads" field.
+func (_u *AnalyticsDayUpdateOne) SetThreads(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetThreads()
+	_u.mutation.SetThreads(v)
+	return _u
+}
+
+// SetNillableThreads sets the "threads" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableThreads(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetThreads(*v)
+	}
+	return _u
+}
+
+// AddThreads adds value to the "threads" field.
+func (_u *AnalyticsDayUpdateOne) AddThreads(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddThreads(v)
+	return _u
+}
+
+// SetReplies sets the "replies" field.
+func (_u *AnalyticsDayUpdateOne) SetReplies(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetReplies()
+	_u.mutation.SetReplies(v)
+	return _u
+}
+
+// SetNillableReplies sets the "replies" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableReplies(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetReplies(*v)
+	}
+	return _u
+}
+
+// AddReplies adds value to the "replies" field.
+func (_u *AnalyticsDayUpdateOne) AddReplies(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddReplies(v)
+	return _u
+}
+
+// SetReactions sets the "reactions" field.
+func (_u *AnalyticsDayUpdateOne) SetReactions(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetReactions()
+	_u.mutation.SetReactions(v)
+	return _u
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableReactions(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetReactions(*v)
+	}
+	return _u
+}
+
+// AddReactions adds value to the "reactions" field.
+func (_u *AnalyticsDayUpdateOne) AddReactions(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddReactions(v)
+	return _u
+}
+
+// SetLikes sets the "likes" field.
+func (_u *AnalyticsDayUpdateOne) SetLikes(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.ResetLikes()
+	_u.mutation.SetLikes(v)
+	return _u
+}
+
+// SetNillableLikes sets the "likes" field if the given value is not nil.
+func (_u *AnalyticsDayUpdateOne) SetNillableLikes(v *int) *AnalyticsDayUpdateOne {
+	if v != nil {
+		_u.SetLikes(*v)
+	}
+	return _u
+}
+
+// AddLikes adds value to the "likes" field.
+func (_u *AnalyticsDayUpdateOne) AddLikes(v int) *AnalyticsDayUpdateOne {
+	_u.mutation.AddLikes(v)
+	return _u
+}
+
+// Mutation returns the AnalyticsDayMutation object of the builder.
+func (_u *AnalyticsDayUpdateOne) Mutation() *AnalyticsDayMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AnalyticsDayUpdate builder.
+func (_u *AnalyticsDayUpdateOne) Where(ps ...predicate.AnalyticsDay) *AnalyticsDayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnalyticsDayUpdateOne) Select(field string, fields ...string) *AnalyticsDayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnalyticsDay entity.
+func (_u *AnalyticsDayUpdateOne) Save(ctx context.Context) (*AnalyticsDay, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsDayUpdateOne) SaveX(ctx context.Context) *AnalyticsDay {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnalyticsDayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsDayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnalyticsDayUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := analyticsday.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsDayUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsDayUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsDayUpdateOne) sqlSave(ctx context.Context) (_node *AnalyticsDay, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticsday.Table, analyticsday.Columns, sqlgraph.NewFieldSpec(analyticsday.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnalyticsDay.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, analyticsday.FieldID)
+		for _, f := range fields {
+			if !analyticsday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != analyticsday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticsday.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(analyticsday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Signups(); ok {
+		_spec.SetField(analyticsday.FieldSignups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSignups(); ok {
+		_spec.AddField(analyticsday.FieldSignups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DailyActive(); ok {
+		_spec.SetField(analyticsday.FieldDailyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDailyActive(); ok {
+		_spec.AddField(analyticsday.FieldDailyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WeeklyActive(); ok {
+		_spec.SetField(analyticsday.FieldWeeklyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeeklyActive(); ok {
+		_spec.AddField(analyticsday.FieldWeeklyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MonthlyActive(); ok {
+		_spec.SetField(analyticsday.FieldMonthlyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyActive(); ok {
+		_spec.AddField(analyticsday.FieldMonthlyActive, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Threads(); ok {
+		_spec.SetField(analyticsday.FieldThreads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreads(); ok {
+		_spec.AddField(analyticsday.FieldThreads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Replies(); ok {
+		_spec.SetField(analyticsday.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplies(); ok {
+		_spec.AddField(analyticsday.FieldReplies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reactions(); ok {
+		_spec.SetField(analyticsday.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReactions(); ok {
+		_spec.AddField(analyticsday.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Likes(); ok {
+		_spec.SetField(analyticsday.FieldLikes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLikes(); ok {
+		_spec.AddField(analyticsday.FieldLikes, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AnalyticsDay{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticsday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/rs/xid"
+)
+
+// AnalyticsExport is the model entity for the AnalyticsExport schema.
+type AnalyticsExport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Sink holds the value of the "sink" field.
+	Sink string `json:"sink,omitempty"`
+	// ExportedThrough holds the value of the "exported_through" field.
+	ExportedThrough time.Time `json:"exported_through,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnalyticsExport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case analyticsexport.FieldSink:
+			values[i] = new(sql.NullString)
+		case analyticsexport.FieldCreatedAt, analyticsexport.FieldUpdatedAt, analyticsexport.FieldExportedThrough:
+			values[i] = new(sql.NullTime)
+		case analyticsexport.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnalyticsExport fields.
+func (_m *AnalyticsExport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case analyticsexport.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case analyticsexport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case analyticsexport.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case analyticsexport.FieldSink:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sink", values[i])
+			} else if value.Valid {
+				_m.Sink = value.String
+			}
+		case analyticsexport.FieldExportedThrough:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field exported_through", values[i])
+			} else if value.Valid {
+				_m.ExportedThrough = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnalyticsExport.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnalyticsExport) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AnalyticsExport.
+// Note that you need to call AnalyticsExport.Unwrap() before calling this method if this AnalyticsExport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnalyticsExport) Update() *AnalyticsExportUpdateOne {
+	return NewAnalyticsExportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnalyticsExport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnalyticsExport) Unwrap() *AnalyticsExport {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnalyticsExport is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnalyticsExport) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnalyticsExport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sink=")
+	builder.WriteString(_m.Sink)
+	builder.WriteString(", ")
+	builder.WriteString("exported_through=")
+	builder.WriteString(_m.ExportedThrough.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnalyticsExports is a parsable slice of AnalyticsExport.
+type AnalyticsExports []*AnalyticsExport
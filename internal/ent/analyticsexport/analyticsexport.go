@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticsexport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the analyticsexport type in the database.
+	Label = "analytics_export"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSink holds the string denoting the sink field in the database.
+	FieldSink = "sink"
+	// FieldExportedThrough holds the string denoting the exported_through field in the database.
+	FieldExportedThrough = "exported_through"
+	// Table holds the table name of the analyticsexport in the database.
+	Table = "analytics_exports"
+)
+
+// Columns holds all SQL columns for analyticsexport fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSink,
+	FieldExportedThrough,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the AnalyticsExport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySink orders the results by the sink field.
+func BySink(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSink, opts...).ToFunc()
+}
+
+// ByExportedThrough orders the results by the exported_through field.
+func ByExportedThrough(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExportedThrough, opts...).ToFunc()
+}
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analyticsexport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Sink applies equality check predicate on the "sink" field. It's identical to SinkEQ.
+func Sink(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldSink, v))
+}
+
+// ExportedThrough applies equality check predicate on the "exported_through" field. It's identical to ExportedThroughEQ.
+func ExportedThrough(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldExportedThrough, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SinkEQ applies the EQ predicate on the "sink" field.
+func SinkEQ(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldSink, v))
+}
+
+// SinkNEQ applies the NEQ predicate on the "sink" field.
+func SinkNEQ(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNEQ(FieldSink, v))
+}
+
+// SinkIn applies the In predicate on the "sink" field.
+func SinkIn(vs ...string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldIn(FieldSink, vs...))
+}
+
+// SinkNotIn applies the NotIn predicate on the "sink" field.
+func SinkNotIn(vs ...string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNotIn(FieldSink, vs...))
+}
+
+// SinkGT applies the GT predicate on the "sink" field.
+func SinkGT(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGT(FieldSink, v))
+}
+
+// SinkGTE applies the GTE predicate on the "sink" field.
+func SinkGTE(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGTE(FieldSink, v))
+}
+
+// SinkLT applies the LT predicate on the "sink" field.
+func SinkLT(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLT(FieldSink, v))
+}
+
+// SinkLTE applies the LTE predicate on the "sink" field.
+func SinkLTE(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLTE(FieldSink, v))
+}
+
+// SinkContains applies the Contains predicate on the "sink" field.
+func SinkContains(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldContains(FieldSink, v))
+}
+
+// SinkHasPrefix applies the HasPrefix predicate on the "sink" field.
+func SinkHasPrefix(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldHasPrefix(FieldSink, v))
+}
+
+// SinkHasSuffix applies the HasSuffix predicate on the "sink" field.
+func SinkHasSuffix(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldHasSuffix(FieldSink, v))
+}
+
+// SinkEqualFold applies the EqualFold predicate on the "sink" field.
+func SinkEqualFold(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEqualFold(FieldSink, v))
+}
+
+// SinkContainsFold applies the ContainsFold predicate on the "sink" field.
+func SinkContainsFold(v string) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldContainsFold(FieldSink, v))
+}
+
+// ExportedThroughEQ applies the EQ predicate on the "exported_through" field.
+func ExportedThroughEQ(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldEQ(FieldExportedThrough, v))
+}
+
+// ExportedThroughNEQ applies the NEQ predicate on the "exported_through" field.
+func ExportedThroughNEQ(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNEQ(FieldExportedThrough, v))
+}
+
+// ExportedThroughIn applies the In predicate on the "exported_through" field.
+func ExportedThroughIn(vs ...time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldIn(FieldExportedThrough, vs...))
+}
+
+// ExportedThroughNotIn applies the NotIn predicate on the "exported_through" field.
+func ExportedThroughNotIn(vs ...time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldNotIn(FieldExportedThrough, vs...))
+}
+
+// ExportedThroughGT applies the GT predicate on the "exported_through" field.
+func ExportedThroughGT(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGT(FieldExportedThrough, v))
+}
+
+// ExportedThroughGTE applies the GTE predicate on the "exported_through" field.
+func ExportedThroughGTE(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldGTE(FieldExportedThrough, v))
+}
+
+// ExportedThroughLT applies the LT predicate on the "exported_through" field.
+func ExportedThroughLT(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLT(FieldExportedThrough, v))
+}
+
+// ExportedThroughLTE applies the LTE predicate on the "exported_through" field.
+func ExportedThroughLTE(v time.Time) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.FieldLTE(FieldExportedThrough, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnalyticsExport) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnalyticsExport) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnalyticsExport) predicate.AnalyticsExport {
+	return predicate.AnalyticsExport(sql.NotPredicates(p))
+}
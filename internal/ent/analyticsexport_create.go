@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/rs/xid"
+)
+
+// AnalyticsExportCreate is the builder for creating a AnalyticsExport entity.
+type AnalyticsExportCreate struct {
+	config
+	mutation *AnalyticsExportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AnalyticsExportCreate) SetCreatedAt(v time.Time) *AnalyticsExportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AnalyticsExportCreate) SetNillableCreatedAt(v *time.Time) *AnalyticsExportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AnalyticsExportCreate) SetUpdatedAt(v time.Time) *AnalyticsExportCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AnalyticsExportCreate) SetNillableUpdatedAt(v *time.Time) *AnalyticsExportCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSink sets the "sink" field.
+func (_c *AnalyticsExportCreate) SetSink(v string) *AnalyticsExportCreate {
+	_c.mutation.SetSink(v)
+	return _c
+}
+
+// SetExportedThrough sets the "exported_through" field.
+func (_c *AnalyticsExportCreate) SetExportedThrough(v time.Time) *AnalyticsExportCreate {
+	_c.mutation.SetExportedThrough(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnalyticsExportCreate) SetID(v xid.ID) *AnalyticsExportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AnalyticsExportCreate) SetNillableID(v *xid.ID) *AnalyticsExportCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AnalyticsExportMutation object of the builder.
+func (_c *AnalyticsExportCreate) Mutation() *AnalyticsExportMutation {
+	return _c.mutation
+}
+
+// Save creates the AnalyticsExport in the database.
+func (_c *AnalyticsExportCreate) Save(ctx context.Context) (*AnalyticsExport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnalyticsExportCreate) SaveX(ctx context.Context) *AnalyticsExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsExportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsExportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnalyticsExportCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := analyticsexport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := analyticsexport.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := analyticsexport.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnalyticsExportCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AnalyticsExport.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AnalyticsExport.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Sink(); !ok {
+		return &ValidationError{Name: "sink", err: errors.New(`ent: missing required field "AnalyticsExport.sink"`)}
+	}
+	if _, ok := _c.mutation.ExportedThrough(); !ok {
+		return &ValidationError{Name: "exported_through", err: errors.New(`ent: missing required field "AnalyticsExport.exported_through"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := analyticsexport.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AnalyticsExport.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AnalyticsExportCreate) sqlSave(ctx context.Context) (*AnalyticsExport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnalyticsExportCreate) createSpec() (*AnalyticsExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnalyticsExport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(analyticsexport.Table, sqlgraph.NewFieldSpec(analyticsexport.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(analyticsexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticsexport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Sink(); ok {
+		_spec.SetField(analyticsexport.FieldSink, field.TypeString, value)
+		_node.Sink = value
+	}
+	if value, ok := _c.mutation.ExportedThrough(); ok {
+		_spec.SetField(analyticsexport.FieldExportedThrough, field.TypeTime, value)
+		_node.ExportedThrough = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsExport.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsExportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsExportCreate) OnConflict(opts ...sql.ConflictOption) *AnalyticsExportUpsertOne {
+	_c.conflict = opts
+	return &AnalyticsExportUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsExportCreate) OnConflictColumns(columns ...string) *AnalyticsExportUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsExportUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnalyticsExportUpsertOne is the builder for "upsert"-ing
+	//  one AnalyticsExport node.
+	AnalyticsExportUpsertOne struct {
+		create *AnalyticsExportCreate
+	}
+
+	// AnalyticsExportUpsert is the "OnConflict" setter.
+	AnalyticsExportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsExportUpsert) SetUpdatedAt(v time.Time) *AnalyticsExportUpsert {
+	u.Set(analyticsexport.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsExportUpsert) UpdateUpdatedAt() *AnalyticsExportUpsert {
+	u.SetExcluded(analyticsexport.FieldUpdatedAt)
+	return u
+}
+
+// SetSink sets the "sink" field.
+func (u *AnalyticsExportUpsert) SetSink(v string) *AnalyticsExportUpsert {
+	u.Set(analyticsexport.FieldSink, v)
+	return u
+}
+
+// UpdateSink sets the "sink" field to the value that was provided on create.
+func (u *AnalyticsExportUpsert) UpdateSink() *AnalyticsExportUpsert {
+	u.SetExcluded(analyticsexport.FieldSink)
+	return u
+}
+
+// SetExportedThrough sets the "exported_through" field.
+func (u *AnalyticsExportUpsert) SetExportedThrough(v time.Time) *AnalyticsExportUpsert {
+	u.Set(analyticsexport.FieldExportedThrough, v)
+	return u
+}
+
+// UpdateExportedThrough sets the "exported_through" field to the value that was provided on create.
+func (u *AnalyticsExportUpsert) UpdateExportedThrough() *AnalyticsExportUpsert {
+	u.SetExcluded(analyticsexport.FieldExportedThrough)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticsexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsExportUpsertOne) UpdateNewValues() *AnalyticsExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(analyticsexport.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(analyticsexport.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsExport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnalyticsExportUpsertOne) Ignore() *AnalyticsExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsExportUpsertOne) DoNothing() *AnalyticsExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsExportCreate.OnConflict
+// documentation for more info.
+func (u *AnalyticsExportUpsertOne) Update(set func(*AnalyticsExportUpsert)) *AnalyticsExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsExportUpsertOne) SetUpdatedAt(v time.Time) *AnalyticsExportUpsertOne {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsExportUpsertOne) UpdateUpdatedAt() *AnalyticsExportUpsertOne {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSink sets the "sink" field.
+func (u *AnalyticsExportUpsertOne) SetSink(v string) *AnalyticsExportUpsertOne {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.SetSink(v)
+	})
+}
+
+// UpdateSink sets the "sink" field to the value that was provided on create.
+func (u *AnalyticsExportUpsertOne) UpdateSink() *AnalyticsExportUpsertOne {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.UpdateSink()
+	})
+}
+
+// SetExportedThrough sets the "exported_through" field.
+func (u *AnalyticsExportUpsertOne) SetExportedThrough(v time.Time) *AnalyticsExportUpsertOne {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.SetExportedThrough(v)
+	})
+}
+
+// UpdateExportedThrough sets the "exported_through" field to the value that was provided on create.
+func (u *AnalyticsExportUpsertOne) UpdateExportedThrough() *AnalyticsExportUpsertOne {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.UpdateExportedThrough()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsExportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsExportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsExportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnalyticsExportUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AnalyticsExportUpsertOne.ID is not supported by MySQL driver. Use AnalyticsExportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnalyticsExportUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnalyticsExportCreateBulk is the builder for creating many AnalyticsExport entities in bulk.
+type AnalyticsExportCreateBulk struct {
+	config
+	err      error
+	builders []*AnalyticsExportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AnalyticsExport entities in the database.
+func (_c *AnalyticsExportCreateBulk) Save(ctx context.Context) ([]*AnalyticsExport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnalyticsExport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnalyticsExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnalyticsExportCreateBulk) SaveX(ctx context.Context) []*AnalyticsExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalyticsExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalyticsExportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalyticsExport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalyticsExportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalyticsExportCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnalyticsExportUpsertBulk {
+	_c.conflict = opts
+	return &AnalyticsExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalyticsExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalyticsExportCreateBulk) OnConflictColumns(columns ...string) *AnalyticsExportUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalyticsExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnalyticsExportUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnalyticsExport nodes.
+type AnalyticsExportUpsertBulk struct {
+	create *AnalyticsExportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnalyticsExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analyticsexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalyticsExportUpsertBulk) UpdateNewValues() *AnalyticsExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(analyticsexport.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(analyticsexport.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalyticsExport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnalyticsExportUpsertBulk) Ignore() *AnalyticsExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalyticsExportUpsertBulk) DoNothing() *AnalyticsExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalyticsExportCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnalyticsExportUpsertBulk) Update(set func(*AnalyticsExportUpsert)) *AnalyticsExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalyticsExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalyticsExportUpsertBulk) SetUpdatedAt(v time.Time) *AnalyticsExportUpsertBulk {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalyticsExportUpsertBulk) UpdateUpdatedAt() *AnalyticsExportUpsertBulk {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSink sets the "sink" field.
+func (u *AnalyticsExportUpsertBulk) SetSink(v string) *AnalyticsExportUpsertBulk {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.SetSink(v)
+	})
+}
+
+// UpdateSink sets the "sink" field to the value that was provided on create.
+func (u *AnalyticsExportUpsertBulk) UpdateSink() *AnalyticsExportUpsertBulk {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.UpdateSink()
+	})
+}
+
+// SetExportedThrough sets the "exported_through" field.
+func (u *AnalyticsExportUpsertBulk) SetExportedThrough(v time.Time) *AnalyticsExportUpsertBulk {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.SetExportedThrough(v)
+	})
+}
+
+// UpdateExportedThrough sets the "exported_through" field to the value that was provided on create.
+func (u *AnalyticsExportUpsertBulk) UpdateExportedThrough() *AnalyticsExportUpsertBulk {
+	return u.Update(func(s *AnalyticsExportUpsert) {
+		s.UpdateExportedThrough()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalyticsExportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnalyticsExportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalyticsExportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalyticsExportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
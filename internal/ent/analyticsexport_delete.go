@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AnalyticsExportDelete is the builder for deleting a AnalyticsExport entity.
+type AnalyticsExportDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnalyticsExportMutation
+}
+
+// Where appends a list predicates to the AnalyticsExportDelete builder.
+func (_d *AnalyticsExportDelete) Where(ps ...predicate.AnalyticsExport) *AnalyticsExportDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnalyticsExportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsExportDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnalyticsExportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(analyticsexport.Table, sqlgraph.NewFieldSpec(analyticsexport.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnalyticsExportDeleteOne is the builder for deleting a single AnalyticsExport entity.
+type AnalyticsExportDeleteOne struct {
+	_d *AnalyticsExportDelete
+}
+
+// Where appends a list predicates to the AnalyticsExportDelete builder.
+func (_d *AnalyticsExportDeleteOne) Where(ps ...predicate.AnalyticsExport) *AnalyticsExportDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnalyticsExportDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{analyticsexport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalyticsExportDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AnalyticsExportUpdate is the builder for updating AnalyticsExport entities.
+type AnalyticsExportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnalyticsExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnalyticsExportUpdate builder.
+func (_u *AnalyticsExportUpdate) Where(ps ...predicate.AnalyticsExport) *AnalyticsExportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnalyticsExportUpdate) SetUpdatedAt(v time.Time) *AnalyticsExportUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSink sets the "sink" field.
+func (_u *AnalyticsExportUpdate) SetSink(v string) *AnalyticsExportUpdate {
+	_u.mutation.SetSink(v)
+	return _u
+}
+
+// SetNillableSink sets the "sink" field if the given value is not nil.
+func (_u *AnalyticsExportUpdate) SetNillableSink(v *string) *AnalyticsExportUpdate {
+	if v != nil {
+		_u.SetSink(*v)
+	}
+	return _u
+}
+
+// SetExportedThrough sets the "exported_through" field.
+func (_u *AnalyticsExportUpdate) SetExportedThrough(v time.Time) *AnalyticsExportUpdate {
+	_u.mutation.SetExportedThrough(v)
+	return _u
+}
+
+// SetNillableExportedThrough sets the "exported_through" field if the given value is not nil.
+func (_u *AnalyticsExportUpdate) SetNillableExportedThrough(v *time.Time) *AnalyticsExportUpdate {
+	if v != nil {
+		_u.SetExportedThrough(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AnalyticsExportMutation object of the builder.
+func (_u *AnalyticsExportUpdate) Mutation() *AnalyticsExportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnalyticsExportUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnalyticsExportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsExportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnalyticsExportUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := analyticsexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsExportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsExportUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticsexport.Table, analyticsexport.Columns, sqlgraph.NewFieldSpec(analyticsexport.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticsexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Sink(); ok {
+		_spec.SetField(analyticsexport.FieldSink, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExportedThrough(); ok {
+		_spec.SetField(analyticsexport.FieldExportedThrough, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticsexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnalyticsExportUpdateOne is the builder for updating a single AnalyticsExport entity.
+type AnalyticsExportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnalyticsExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnalyticsExportUpdateOne) SetUpdatedAt(v time.Time) *AnalyticsExportUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSink sets the "sink" field.
+func (_u *AnalyticsExportUpdateOne) SetSink(v string) *AnalyticsExportUpdateOne {
+	_u.mutation.SetSink(v)
+	return _u
+}
+
+// SetNillableSink sets the "sink" field if the given value is not nil.
+func (_u *AnalyticsExportUpdateOne) SetNillableSink(v *string) *AnalyticsExportUpdateOne {
+	if v != nil {
+		_u.SetSink(*v)
+	}
+	return _u
+}
+
+// SetExportedThrough sets the "exported_through" field.
+func (_u *AnalyticsExportUpdateOne) SetExportedThrough(v time.Time) *AnalyticsExportUpdateOne {
+	_u.mutation.SetExportedThrough(v)
+	return _u
+}
+
+// SetNillableExportedThrough sets the "exported_through" field if the given value is not nil.
+func (_u *AnalyticsExportUpdateOne) SetNillableExportedThrough(v *time.Time) *AnalyticsExportUpdateOne {
+	if v != nil {
+		_u.SetExportedThrough(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AnalyticsExportMutation object of the builder.
+func (_u *AnalyticsExportUpdateOne) Mutation() *AnalyticsExportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AnalyticsExportUpdate builder.
+func (_u *AnalyticsExportUpdateOne) Where(ps ...predicate.AnalyticsExport) *AnalyticsExportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnalyticsExportUpdateOne) Select(field string, fields ...string) *AnalyticsExportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnalyticsExport entity.
+func (_u *AnalyticsExportUpdateOne) Save(ctx context.Context) (*AnalyticsExport, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalyticsExportUpdateOne) SaveX(ctx context.Context) *AnalyticsExport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnalyticsExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalyticsExportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnalyticsExportUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := analyticsexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalyticsExportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalyticsExportUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalyticsExportUpdateOne) sqlSave(ctx context.Context) (_node *AnalyticsExport, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analyticsexport.Table, analyticsexport.Columns, sqlgraph.NewFieldSpec(analyticsexport.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnalyticsExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, analyticsexport.FieldID)
+		for _, f := range fields {
+			if !analyticsexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != analyticsexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(analyticsexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Sink(); ok {
+		_spec.SetField(analyticsexport.FieldSink, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExportedThrough(); ok {
+		_spec.SetField(analyticsexport.FieldExportedThrough, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AnalyticsExport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analyticsexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
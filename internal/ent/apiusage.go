@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
+	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/rs/xid"
+)
+
+// APIUsage is the model entity for the APIUsage schema.
+type APIUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// AccessKeyID holds the value of the "access_key_id" field.
+	AccessKeyID *xid.ID `json:"access_key_id,omitempty"`
+	// Bucket holds the value of the "bucket" field.
+	Bucket time.Time `json:"bucket,omitempty"`
+	// Requests holds the value of the "requests" field.
+	Requests int `json:"requests,omitempty"`
+	// ClientErrors holds the value of the "client_errors" field.
+	ClientErrors int `json:"client_errors,omitempty"`
+	// ServerErrors holds the value of the "server_errors" field.
+	ServerErrors int `json:"server_errors,omitempty"`
+	// RateLimited holds the value of the "rate_limited" field.
+	RateLimited int `json:"rate_limited,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APIUsageQuery when eager-loading is set.
+	Edges        APIUsageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// APIUsageEdges holds the relations/edges for other nodes in the graph.
+type APIUsageEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// AccessKey holds the value of the access_key edge.
+	AccessKey *Authentication `json:"access_key,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIUsageEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// AccessKeyOrErr returns the AccessKey value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIUsageEdges) AccessKeyOrErr() (*Authentication, error) {
+	if e.AccessKey != nil {
+		return e.AccessKey, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: authentication.Label}
+	}
+	return nil, &NotLoadedError{edge: "access_key"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apiusage.FieldAccessKeyID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case apiusage.FieldRequests, apiusage.FieldClientErrors, apiusage.FieldServerErrors, apiusage.FieldRateLimited:
+			values[i] = new(sql.NullInt64)
+		case apiusage.FieldCreatedAt, apiusage.FieldUpdatedAt, apiusage.FieldBucket:
+			values[i] = new(sql.NullTime)
+		case apiusage.FieldID, apiusage.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIUsage fields.
+func (_m *APIUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apiusage.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case apiusage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case apiusage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case apiusage.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case apiusage.FieldAccessKeyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_id", values[i])
+			} else if value.Valid {
+				_m.AccessKeyID = new(xid.ID)
+				*_m.AccessKeyID = *value.S.(*xid.ID)
+			}
+		case apiusage.FieldBucket:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				_m.Bucket = value.Time
+			}
+		case apiusage.FieldRequests:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requests", values[i])
+			} else if value.Valid {
+				_m.Requests = int(value.Int64)
+			}
+		case apiusage.FieldClientErrors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_errors", values[i])
+			} else if value.Valid {
+				_m.ClientErrors = int(value.Int64)
+			}
+		case apiusage.FieldServerErrors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field server_errors", values[i])
+			} else if value.Valid {
+				_m.ServerErrors = int(value.Int64)
+			}
+		case apiusage.FieldRateLimited:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limited", values[i])
+			} else if value.Valid {
+				_m.RateLimited = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the APIUsage.
+// This includes values selected through modifiers, order, etc.
+func (_m *APIUsage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the APIUsage entity.
+func (_m *APIUsage) QueryAccount() *AccountQuery {
+	return NewAPIUsageClient(_m.config).QueryAccount(_m)
+}
+
+// QueryAccessKey queries the "access_key" edge of the APIUsage entity.
+func (_m *APIUsage) QueryAccessKey() *AuthenticationQuery {
+	return NewAPIUsageClient(_m.config).QueryAccessKey(_m)
+}
+
+// Update returns a builder for updating this APIUsage.
+// Note that you need to call APIUsage.Unwrap() before calling this method if this APIUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *APIUsage) Update() *APIUsageUpdateOne {
+	return NewAPIUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the APIUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *APIUsage) Unwrap() *APIUsage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APIUsage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *APIUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.AccessKeyID; v != nil {
+		builder.WriteString("access_key_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("bucket=")
+	builder.WriteString(_m.Bucket.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("requests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Requests))
+	builder.WriteString(", ")
+	builder.WriteString("client_errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientErrors))
+	builder.WriteString(", ")
+	builder.WriteString("server_errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ServerErrors))
+	builder.WriteString(", ")
+	builder.WriteString("rate_limited=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RateLimited))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APIUsages is a parsable slice of APIUsage.
+type APIUsages []*APIUsage
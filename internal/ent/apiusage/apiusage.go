@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apiusage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the apiusage type in the database.
+	Label = "api_usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldAccessKeyID holds the string denoting the access_key_id field in the database.
+	FieldAccessKeyID = "access_key_id"
+	// FieldBucket holds the string denoting the bucket field in the database.
+	FieldBucket = "bucket"
+	// FieldRequests holds the string denoting the requests field in the database.
+	FieldRequests = "requests"
+	// FieldClientErrors holds the string denoting the client_errors field in the database.
+	FieldClientErrors = "client_errors"
+	// FieldServerErrors holds the string denoting the server_errors field in the database.
+	FieldServerErrors = "server_errors"
+	// FieldRateLimited holds the string denoting the rate_limited field in the database.
+	FieldRateLimited = "rate_limited"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeAccessKey holds the string denoting the access_key edge name in mutations.
+	EdgeAccessKey = "access_key"
+	// Table holds the table name of the apiusage in the database.
+	Table = "api_usages"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "api_usages"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// AccessKeyTable is the table that holds the access_key relation/edge.
+	AccessKeyTable = "api_usages"
+	// AccessKeyInverseTable is the table name for the Authentication entity.
+	// It exists in this package in order to avoid circular dependency with the "authentication" package.
+	AccessKeyInverseTable = "authentications"
+	// AccessKeyColumn is the table column denoting the access_key relation/edge.
+	AccessKeyColumn = "access_key_id"
+)
+
+// Columns holds all SQL columns for apiusage fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldAccountID,
+	FieldAccessKeyID,
+	FieldBucket,
+	FieldRequests,
+	FieldClientErrors,
+	FieldServerErrors,
+	FieldRateLimited,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRequests holds the default value on creation for the "requests" field.
+	DefaultRequests int
+	// DefaultClientErrors holds the default value on creation for the "client_errors" field.
+	DefaultClientErrors int
+	// DefaultServerErrors holds the default value on creation for the "server_errors" field.
+	DefaultServerErrors int
+	// DefaultRateLimited holds the default value on creation for the "rate_limited" field.
+	DefaultRateLimited int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the APIUsage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByAccessKeyID orders the results by the access_key_id field.
+func ByAccessKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKeyID, opts...).ToFunc()
+}
+
+// ByBucket orders the results by the bucket field.
+func ByBucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucket, opts...).ToFunc()
+}
+
+// ByRequests orders the results by the requests field.
+func ByRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequests, opts...).ToFunc()
+}
+
+// ByClientErrors orders the results by the client_errors field.
+func ByClientErrors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientErrors, opts...).ToFunc()
+}
+
+// ByServerErrors orders the results by the server_errors field.
+func ByServerErrors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServerErrors, opts...).ToFunc()
+}
+
+// ByRateLimited orders the results by the rate_limited field.
+func ByRateLimited(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimited, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccessKeyField orders the results by access_key field.
+func ByAccessKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessKeyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newAccessKeyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessKeyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccessKeyTable, AccessKeyColumn),
+	)
+}
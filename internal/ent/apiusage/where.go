@@ -0,0 +1,593 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apiusage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccessKeyID applies equality check predicate on the "access_key_id" field. It's identical to AccessKeyIDEQ.
+func AccessKeyID(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// Bucket applies equality check predicate on the "bucket" field. It's identical to BucketEQ.
+func Bucket(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldBucket, v))
+}
+
+// Requests applies equality check predicate on the "requests" field. It's identical to RequestsEQ.
+func Requests(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldRequests, v))
+}
+
+// ClientErrors applies equality check predicate on the "client_errors" field. It's identical to ClientErrorsEQ.
+func ClientErrors(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldClientErrors, v))
+}
+
+// ServerErrors applies equality check predicate on the "server_errors" field. It's identical to ServerErrorsEQ.
+func ServerErrors(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldServerErrors, v))
+}
+
+// RateLimited applies equality check predicate on the "rate_limited" field. It's identical to RateLimitedEQ.
+func RateLimited(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldRateLimited, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// AccessKeyIDEQ applies the EQ predicate on the "access_key_id" field.
+func AccessKeyIDEQ(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDNEQ applies the NEQ predicate on the "access_key_id" field.
+func AccessKeyIDNEQ(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDIn applies the In predicate on the "access_key_id" field.
+func AccessKeyIDIn(vs ...xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDNotIn applies the NotIn predicate on the "access_key_id" field.
+func AccessKeyIDNotIn(vs ...xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDGT applies the GT predicate on the "access_key_id" field.
+func AccessKeyIDGT(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDGTE applies the GTE predicate on the "access_key_id" field.
+func AccessKeyIDGTE(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLT applies the LT predicate on the "access_key_id" field.
+func AccessKeyIDLT(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLTE applies the LTE predicate on the "access_key_id" field.
+func AccessKeyIDLTE(v xid.ID) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDContains applies the Contains predicate on the "access_key_id" field.
+func AccessKeyIDContains(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldContains(FieldAccessKeyID, vc))
+}
+
+// AccessKeyIDHasPrefix applies the HasPrefix predicate on the "access_key_id" field.
+func AccessKeyIDHasPrefix(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldHasPrefix(FieldAccessKeyID, vc))
+}
+
+// AccessKeyIDHasSuffix applies the HasSuffix predicate on the "access_key_id" field.
+func AccessKeyIDHasSuffix(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldHasSuffix(FieldAccessKeyID, vc))
+}
+
+// AccessKeyIDIsNil applies the IsNil predicate on the "access_key_id" field.
+func AccessKeyIDIsNil() predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIsNull(FieldAccessKeyID))
+}
+
+// AccessKeyIDNotNil applies the NotNil predicate on the "access_key_id" field.
+func AccessKeyIDNotNil() predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotNull(FieldAccessKeyID))
+}
+
+// AccessKeyIDEqualFold applies the EqualFold predicate on the "access_key_id" field.
+func AccessKeyIDEqualFold(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldEqualFold(FieldAccessKeyID, vc))
+}
+
+// AccessKeyIDContainsFold applies the ContainsFold predicate on the "access_key_id" field.
+func AccessKeyIDContainsFold(v xid.ID) predicate.APIUsage {
+	vc := v.String()
+	return predicate.APIUsage(sql.FieldContainsFold(FieldAccessKeyID, vc))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldBucket, v))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldBucket, v))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldBucket, vs...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldBucket, vs...))
+}
+
+// BucketGT applies the GT predicate on the "bucket" field.
+func BucketGT(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldBucket, v))
+}
+
+// BucketGTE applies the GTE predicate on the "bucket" field.
+func BucketGTE(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldBucket, v))
+}
+
+// BucketLT applies the LT predicate on the "bucket" field.
+func BucketLT(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldBucket, v))
+}
+
+// BucketLTE applies the LTE predicate on the "bucket" field.
+func BucketLTE(v time.Time) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldBucket, v))
+}
+
+// RequestsEQ applies the EQ predicate on the "requests" field.
+func RequestsEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldRequests, v))
+}
+
+// RequestsNEQ applies the NEQ predicate on the "requests" field.
+func RequestsNEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldRequests, v))
+}
+
+// RequestsIn applies the In predicate on the "requests" field.
+func RequestsIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldRequests, vs...))
+}
+
+// RequestsNotIn applies the NotIn predicate on the "requests" field.
+func RequestsNotIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldRequests, vs...))
+}
+
+// RequestsGT applies the GT predicate on the "requests" field.
+func RequestsGT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldRequests, v))
+}
+
+// RequestsGTE applies the GTE predicate on the "requests" field.
+func RequestsGTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldRequests, v))
+}
+
+// RequestsLT applies the LT predicate on the "requests" field.
+func RequestsLT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldRequests, v))
+}
+
+// RequestsLTE applies the LTE predicate on the "requests" field.
+func RequestsLTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldRequests, v))
+}
+
+// ClientErrorsEQ applies the EQ predicate on the "client_errors" field.
+func ClientErrorsEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldClientErrors, v))
+}
+
+// ClientErrorsNEQ applies the NEQ predicate on the "client_errors" field.
+func ClientErrorsNEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldClientErrors, v))
+}
+
+// ClientErrorsIn applies the In predicate on the "client_errors" field.
+func ClientErrorsIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldClientErrors, vs...))
+}
+
+// ClientErrorsNotIn applies the NotIn predicate on the "client_errors" field.
+func ClientErrorsNotIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldClientErrors, vs...))
+}
+
+// ClientErrorsGT applies the GT predicate on the "client_errors" field.
+func ClientErrorsGT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldClientErrors, v))
+}
+
+// ClientErrorsGTE applies the GTE predicate on the "client_errors" field.
+func ClientErrorsGTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldClientErrors, v))
+}
+
+// ClientErrorsLT applies the LT predicate on the "client_errors" field.
+func ClientErrorsLT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldClientErrors, v))
+}
+
+// ClientErrorsLTE applies the LTE predicate on the "client_errors" field.
+func ClientErrorsLTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldClientErrors, v))
+}
+
+// ServerErrorsEQ applies the EQ predicate on the "server_errors" field.
+func ServerErrorsEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldServerErrors, v))
+}
+
+// ServerErrorsNEQ applies the NEQ predicate on the "server_errors" field.
+func ServerErrorsNEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldServerErrors, v))
+}
+
+// ServerErrorsIn applies the In predicate on the "server_errors" field.
+func ServerErrorsIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldServerErrors, vs...))
+}
+
+// ServerErrorsNotIn applies the NotIn predicate on the "server_errors" field.
+func ServerErrorsNotIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldServerErrors, vs...))
+}
+
+// ServerErrorsGT applies the GT predicate on the "server_errors" field.
+func ServerErrorsGT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldServerErrors, v))
+}
+
+// ServerErrorsGTE applies the GTE predicate on the "server_errors" field.
+func ServerErrorsGTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldServerErrors, v))
+}
+
+// ServerErrorsLT applies the LT predicate on the "server_errors" field.
+func ServerErrorsLT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldServerErrors, v))
+}
+
+// ServerErrorsLTE applies the LTE predicate on the "server_errors" field.
+func ServerErrorsLTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldServerErrors, v))
+}
+
+// RateLimitedEQ applies the EQ predicate on the "rate_limited" field.
+func RateLimitedEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldEQ(FieldRateLimited, v))
+}
+
+// RateLimitedNEQ applies the NEQ predicate on the "rate_limited" field.
+func RateLimitedNEQ(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNEQ(FieldRateLimited, v))
+}
+
+// RateLimitedIn applies the In predicate on the "rate_limited" field.
+func RateLimitedIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldIn(FieldRateLimited, vs...))
+}
+
+// RateLimitedNotIn applies the NotIn predicate on the "rate_limited" field.
+func RateLimitedNotIn(vs ...int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldNotIn(FieldRateLimited, vs...))
+}
+
+// RateLimitedGT applies the GT predicate on the "rate_limited" field.
+func RateLimitedGT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGT(FieldRateLimited, v))
+}
+
+// RateLimitedGTE applies the GTE predicate on the "rate_limited" field.
+func RateLimitedGTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldGTE(FieldRateLimited, v))
+}
+
+// RateLimitedLT applies the LT predicate on the "rate_limited" field.
+func RateLimitedLT(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLT(FieldRateLimited, v))
+}
+
+// RateLimitedLTE applies the LTE predicate on the "rate_limited" field.
+func RateLimitedLTE(v int) predicate.APIUsage {
+	return predicate.APIUsage(sql.FieldLTE(FieldRateLimited, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.APIUsage {
+	return predicate.APIUsage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.APIUsage {
+	return predicate.APIUsage(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessKey applies the HasEdge predicate on the "access_key" edge.
+func HasAccessKey() predicate.APIUsage {
+	return predicate.APIUsage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccessKeyTable, AccessKeyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessKeyWith applies the HasEdge predicate on the "access_key" edge with a given conditions (other predicates).
+func HasAccessKeyWith(preds ...predicate.Authentication) predicate.APIUsage {
+	return predicate.APIUsage(func(s *sql.Selector) {
+		step := newAccessKeyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.APIUsage) predicate.APIUsage {
+	return predicate.APIUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.APIUsage) predicate.APIUsage {
+	return predicate.APIUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.APIUsage) predicate.APIUsage {
+	return predicate.APIUsage(sql.NotPredicates(p))
+}
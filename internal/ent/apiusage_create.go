@@ -0,0 +1,1122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
+	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/rs/xid"
+)
+
+// APIUsageCreate is the builder for creating a APIUsage entity.
+type APIUsageCreate struct {
+	config
+	mutation *APIUsageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *APIUsageCreate) SetCreatedAt(v time.Time) *APIUsageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableCreatedAt(v *time.Time) *APIUsageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *APIUsageCreate) SetUpdatedAt(v time.Time) *APIUsageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableUpdatedAt(v *time.Time) *APIUsageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *APIUsageCreate) SetAccountID(v xid.ID) *APIUsageCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (_c *APIUsageCreate) SetAccessKeyID(v xid.ID) *APIUsageCreate {
+	_c.mutation.SetAccessKeyID(v)
+	return _c
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableAccessKeyID(v *xid.ID) *APIUsageCreate {
+	if v != nil {
+		_c.SetAccessKeyID(*v)
+	}
+	return _c
+}
+
+// SetBucket sets the "bucket" field.
+func (_c *APIUsageCreate) SetBucket(v time.Time) *APIUsageCreate {
+	_c.mutation.SetBucket(v)
+	return _c
+}
+
+// SetRequests sets the "requests" field.
+func (_c *APIUsageCreate) SetRequests(v int) *APIUsageCreate {
+	_c.mutation.SetRequests(v)
+	return _c
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableRequests(v *int) *APIUsageCreate {
+	if v != nil {
+		_c.SetRequests(*v)
+	}
+	return _c
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (_c *APIUsageCreate) SetClientErrors(v int) *APIUsageCreate {
+	_c.mutation.SetClientErrors(v)
+	return _c
+}
+
+// SetNillableClientErrors sets the "client_errors" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableClientErrors(v *int) *APIUsageCreate {
+	if v != nil {
+		_c.SetClientErrors(*v)
+	}
+	return _c
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (_c *APIUsageCreate) SetServerErrors(v int) *APIUsageCreate {
+	_c.mutation.SetServerErrors(v)
+	return _c
+}
+
+// SetNillableServerErrors sets the "server_errors" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableServerErrors(v *int) *APIUsageCreate {
+	if v != nil {
+		_c.SetServerErrors(*v)
+	}
+	return _c
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (_c *APIUsageCreate) SetRateLimited(v int) *APIUsageCreate {
+	_c.mutation.SetRateLimited(v)
+	return _c
+}
+
+// SetNillableRateLimited sets the "rate_limited" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableRateLimited(v *int) *APIUsageCreate {
+	if v != nil {
+		_c.SetRateLimited(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *APIUsageCreate) SetID(v xid.ID) *APIUsageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *APIUsageCreate) SetNillableID(v *xid.ID) *APIUsageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *APIUsageCreate) SetAccount(v *Account) *APIUsageCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetAccessKey sets the "access_key" edge to the Authentication entity.
+func (_c *APIUsageCreate) SetAccessKey(v *Authentication) *APIUsageCreate {
+	return _c.SetAccessKeyID(v.ID)
+}
+
+// Mutation returns the APIUsageMutation object of the builder.
+func (_c *APIUsageCreate) Mutation() *APIUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the APIUsage in the database.
+func (_c *APIUsageCreate) Save(ctx context.Context) (*APIUsage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *APIUsageCreate) SaveX(ctx context.Context) *APIUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *APIUsageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := apiusage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := apiusage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Requests(); !ok {
+		v := apiusage.DefaultRequests
+		_c.mutation.SetRequests(v)
+	}
+	if _, ok := _c.mutation.ClientErrors(); !ok {
+		v := apiusage.DefaultClientErrors
+		_c.mutation.SetClientErrors(v)
+	}
+	if _, ok := _c.mutation.ServerErrors(); !ok {
+		v := apiusage.DefaultServerErrors
+		_c.mutation.SetServerErrors(v)
+	}
+	if _, ok := _c.mutation.RateLimited(); !ok {
+		v := apiusage.DefaultRateLimited
+		_c.mutation.SetRateLimited(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := apiusage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *APIUsageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "APIUsage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "APIUsage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "APIUsage.account_id"`)}
+	}
+	if _, ok := _c.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "APIUsage.bucket"`)}
+	}
+	if _, ok := _c.mutation.Requests(); !ok {
+		return &ValidationError{Name: "requests", err: errors.New(`ent: missing required field "APIUsage.requests"`)}
+	}
+	if _, ok := _c.mutation.ClientErrors(); !ok {
+		return &ValidationError{Name: "client_errors", err: errors.New(`ent: missing required field "APIUsage.client_errors"`)}
+	}
+	if _, ok := _c.mutation.ServerErrors(); !ok {
+		return &ValidationError{Name: "server_errors", err: errors.New(`ent: missing required field "APIUsage.server_errors"`)}
+	}
+	if _, ok := _c.mutation.RateLimited(); !ok {
+		return &ValidationError{Name: "rate_limited", err: errors.New(`ent: missing required field "APIUsage.rate_limited"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := apiusage.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "APIUsage.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "APIUsage.account"`)}
+	}
+	return nil
+}
+
+func (_c *APIUsageCreate) sqlSave(ctx context.Context) (*APIUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *APIUsageCreate) createSpec() (*APIUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(apiusage.Table, sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(apiusage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiusage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Bucket(); ok {
+		_spec.SetField(apiusage.FieldBucket, field.TypeTime, value)
+		_node.Bucket = value
+	}
+	if value, ok := _c.mutation.Requests(); ok {
+		_spec.SetField(apiusage.FieldRequests, field.TypeInt, value)
+		_node.Requests = value
+	}
+	if value, ok := _c.mutation.ClientErrors(); ok {
+		_spec.SetField(apiusage.FieldClientErrors, field.TypeInt, value)
+		_node.ClientErrors = value
+	}
+	if value, ok := _c.mutation.ServerErrors(); ok {
+		_spec.SetField(apiusage.FieldServerErrors, field.TypeInt, value)
+		_node.ServerErrors = value
+	}
+	if value, ok := _c.mutation.RateLimited(); ok {
+		_spec.SetField(apiusage.FieldRateLimited, field.TypeInt, value)
+		_node.RateLimited = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccountTable,
+			Columns: []string{apiusage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccessKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccessKeyTable,
+			Columns: []string{apiusage.AccessKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccessKeyID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIUsage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIUsageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *APIUsageCreate) OnConflict(opts ...sql.ConflictOption) *APIUsageUpsertOne {
+	_c.conflict = opts
+	return &APIUsageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *APIUsageCreate) OnConflictColumns(columns ...string) *APIUsageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &APIUsageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// APIUsageUpsertOne is the builder for "upsert"-ing
+	//  one APIUsage node.
+	APIUsageUpsertOne struct {
+		create *APIUsageCreate
+	}
+
+	// APIUsageUpsert is the "OnConflict" setter.
+	APIUsageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIUsageUpsert) SetUpdatedAt(v time.Time) *APIUsageUpsert {
+	u.Set(apiusage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateUpdatedAt() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *APIUsageUpsert) SetAccountID(v xid.ID) *APIUsageUpsert {
+	u.Set(apiusage.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateAccountID() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldAccountID)
+	return u
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *APIUsageUpsert) SetAccessKeyID(v xid.ID) *APIUsageUpsert {
+	u.Set(apiusage.FieldAccessKeyID, v)
+	return u
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateAccessKeyID() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldAccessKeyID)
+	return u
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (u *APIUsageUpsert) ClearAccessKeyID() *APIUsageUpsert {
+	u.SetNull(apiusage.FieldAccessKeyID)
+	return u
+}
+
+// SetBucket sets the "bucket" field.
+func (u *APIUsageUpsert) SetBucket(v time.Time) *APIUsageUpsert {
+	u.Set(apiusage.FieldBucket, v)
+	return u
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateBucket() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldBucket)
+	return u
+}
+
+// SetRequests sets the "requests" field.
+func (u *APIUsageUpsert) SetRequests(v int) *APIUsageUpsert {
+	u.Set(apiusage.FieldRequests, v)
+	return u
+}
+
+// UpdateRequests sets the "requests" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateRequests() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldRequests)
+	return u
+}
+
+// AddRequests adds v to the "requests" field.
+func (u *APIUsageUpsert) AddRequests(v int) *APIUsageUpsert {
+	u.Add(apiusage.FieldRequests, v)
+	return u
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (u *APIUsageUpsert) SetClientErrors(v int) *APIUsageUpsert {
+	u.Set(apiusage.FieldClientErrors, v)
+	return u
+}
+
+// UpdateClientErrors sets the "client_errors" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateClientErrors() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldClientErrors)
+	return u
+}
+
+// AddClientErrors adds v to the "client_errors" field.
+func (u *APIUsageUpsert) AddClientErrors(v int) *APIUsageUpsert {
+	u.Add(apiusage.FieldClientErrors, v)
+	return u
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (u *APIUsageUpsert) SetServerErrors(v int) *APIUsageUpsert {
+	u.Set(apiusage.FieldServerErrors, v)
+	return u
+}
+
+// UpdateServerErrors sets the "server_errors" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateServerErrors() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldServerErrors)
+	return u
+}
+
+// AddServerErrors adds v to the "server_errors" field.
+func (u *APIUsageUpsert) AddServerErrors(v int) *APIUsageUpsert {
+	u.Add(apiusage.FieldServerErrors, v)
+	return u
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (u *APIUsageUpsert) SetRateLimited(v int) *APIUsageUpsert {
+	u.Set(apiusage.FieldRateLimited, v)
+	return u
+}
+
+// UpdateRateLimited sets the "rate_limited" field to the value that was provided on create.
+func (u *APIUsageUpsert) UpdateRateLimited() *APIUsageUpsert {
+	u.SetExcluded(apiusage.FieldRateLimited)
+	return u
+}
+
+// AddRateLimited adds v to the "rate_limited" field.
+func (u *APIUsageUpsert) AddRateLimited(v int) *APIUsageUpsert {
+	u.Add(apiusage.FieldRateLimited, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.APIUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiusage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIUsageUpsertOne) UpdateNewValues() *APIUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apiusage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apiusage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIUsage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *APIUsageUpsertOne) Ignore() *APIUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIUsageUpsertOne) DoNothing() *APIUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIUsageCreate.OnConflict
+// documentation for more info.
+func (u *APIUsageUpsertOne) Update(set func(*APIUsageUpsert)) *APIUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIUsageUpsertOne) SetUpdatedAt(v time.Time) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateUpdatedAt() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *APIUsageUpsertOne) SetAccountID(v xid.ID) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateAccountID() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *APIUsageUpsertOne) SetAccessKeyID(v xid.ID) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetAccessKeyID(v)
+	})
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateAccessKeyID() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateAccessKeyID()
+	})
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (u *APIUsageUpsertOne) ClearAccessKeyID() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.ClearAccessKeyID()
+	})
+}
+
+// SetBucket sets the "bucket" field.
+func (u *APIUsageUpsertOne) SetBucket(v time.Time) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetBucket(v)
+	})
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateBucket() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateBucket()
+	})
+}
+
+// SetRequests sets the "requests" field.
+func (u *APIUsageUpsertOne) SetRequests(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetRequests(v)
+	})
+}
+
+// AddRequests adds v to the "requests" field.
+func (u *APIUsageUpsertOne) AddRequests(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddRequests(v)
+	})
+}
+
+// UpdateRequests sets the "requests" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateRequests() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateRequests()
+	})
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (u *APIUsageUpsertOne) SetClientErrors(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetClientErrors(v)
+	})
+}
+
+// AddClientErrors adds v to the "client_errors" field.
+func (u *APIUsageUpsertOne) AddClientErrors(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddClientErrors(v)
+	})
+}
+
+// UpdateClientErrors sets the "client_errors" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateClientErrors() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateClientErrors()
+	})
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (u *APIUsageUpsertOne) SetServerErrors(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetServerErrors(v)
+	})
+}
+
+// AddServerErrors adds v to the "server_errors" field.
+func (u *APIUsageUpsertOne) AddServerErrors(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddServerErrors(v)
+	})
+}
+
+// UpdateServerErrors sets the "server_errors" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateServerErrors() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateServerErrors()
+	})
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (u *APIUsageUpsertOne) SetRateLimited(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetRateLimited(v)
+	})
+}
+
+// AddRateLimited adds v to the "rate_limited" field.
+func (u *APIUsageUpsertOne) AddRateLimited(v int) *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddRateLimited(v)
+	})
+}
+
+// UpdateRateLimited sets the "rate_limited" field to the value that was provided on create.
+func (u *APIUsageUpsertOne) UpdateRateLimited() *APIUsageUpsertOne {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateRateLimited()
+	})
+}
+
+// Exec executes the query.
+func (u *APIUsageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIUsageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIUsageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *APIUsageUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: APIUsageUpsertOne.ID is not supported by MySQL driver. Use APIUsageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *APIUsageUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// APIUsageCreateBulk is the builder for creating many APIUsage entities in bulk.
+type APIUsageCreateBulk struct {
+	config
+	err      error
+	builders []*APIUsageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the APIUsage entities in the database.
+func (_c *APIUsageCreateBulk) Save(ctx context.Context) ([]*APIUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*APIUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *APIUsageCreateBulk) SaveX(ctx context.Context) []*APIUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIUsage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIUsageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *APIUsageCreateBulk) OnConflict(opts ...sql.ConflictOption) *APIUsageUpsertBulk {
+	_c.conflict = opts
+	return &APIUsageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *APIUsageCreateBulk) OnConflictColumns(columns ...string) *APIUsageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &APIUsageUpsertBulk{
+		create: _c,
+	}
+}
+
+// APIUsageUpsertBulk is the builder for "upsert"-ing
+// a bulk of APIUsage nodes.
+type APIUsageUpsertBulk struct {
+	create *APIUsageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.APIUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiusage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIUsageUpsertBulk) UpdateNewValues() *APIUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apiusage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apiusage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIUsage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *APIUsageUpsertBulk) Ignore() *APIUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIUsageUpsertBulk) DoNothing() *APIUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIUsageCreateBulk.OnConflict
+// documentation for more info.
+func (u *APIUsageUpsertBulk) Update(set func(*APIUsageUpsert)) *APIUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIUsageUpsertBulk) SetUpdatedAt(v time.Time) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateUpdatedAt() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *APIUsageUpsertBulk) SetAccountID(v xid.ID) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateAccountID() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *APIUsageUpsertBulk) SetAccessKeyID(v xid.ID) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetAccessKeyID(v)
+	})
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateAccessKeyID() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateAccessKeyID()
+	})
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (u *APIUsageUpsertBulk) ClearAccessKeyID() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.ClearAccessKeyID()
+	})
+}
+
+// SetBucket sets the "bucket" field.
+func (u *APIUsageUpsertBulk) SetBucket(v time.Time) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetBucket(v)
+	})
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateBucket() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateBucket()
+	})
+}
+
+// SetRequests sets the "requests" field.
+func (u *APIUsageUpsertBulk) SetRequests(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetRequests(v)
+	})
+}
+
+// AddRequests adds v to the "requests" field.
+func (u *APIUsageUpsertBulk) AddRequests(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddRequests(v)
+	})
+}
+
+// UpdateRequests sets the "requests" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateRequests() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateRequests()
+	})
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (u *APIUsageUpsertBulk) SetClientErrors(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetClientErrors(v)
+	})
+}
+
+// AddClientErrors adds v to the "client_errors" field.
+func (u *APIUsageUpsertBulk) AddClientErrors(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddClientErrors(v)
+	})
+}
+
+// UpdateClientErrors sets the "client_errors" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateClientErrors() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateClientErrors()
+	})
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (u *APIUsageUpsertBulk) SetServerErrors(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetServerErrors(v)
+	})
+}
+
+// AddServerErrors adds v to the "server_errors" field.
+func (u *APIUsageUpsertBulk) AddServerErrors(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddServerErrors(v)
+	})
+}
+
+// UpdateServerErrors sets the "server_errors" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateServerErrors() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateServerErrors()
+	})
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (u *APIUsageUpsertBulk) SetRateLimited(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.SetRateLimited(v)
+	})
+}
+
+// AddRateLimited adds v to the "rate_limited" field.
+func (u *APIUsageUpsertBulk) AddRateLimited(v int) *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.AddRateLimited(v)
+	})
+}
+
+// UpdateRateLimited sets the "rate_limited" field to the value that was provided on create.
+func (u *APIUsageUpsertBulk) UpdateRateLimited() *APIUsageUpsertBulk {
+	return u.Update(func(s *APIUsageUpsert) {
+		s.UpdateRateLimited()
+	})
+}
+
+// Exec executes the query.
+func (u *APIUsageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the APIUsageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIUsageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIUsageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
+	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// APIUsageUpdate is the builder for updating APIUsage entities.
+type APIUsageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *APIUsageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the APIUsageUpdate builder.
+func (_u *APIUsageUpdate) Where(ps ...predicate.APIUsage) *APIUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *APIUsageUpdate) SetUpdatedAt(v time.Time) *APIUsageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *APIUsageUpdate) SetAccountID(v xid.ID) *APIUsageUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *APIUsageUpdate) SetNillableAccountID(v *xid.ID) *APIUsageUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (_u *APIUsageUpdate) SetAccessKeyID(v xid.ID) *APIUsageUpdate {
+	_u.mutation.SetAccessKeyID(v)
+	return _u
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (_u *APIUsageUpdate) SetNillableAccessKeyID(v *xid.ID) *APIUsageUpdate {
+	if v != nil {
+		_u.SetAccessKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (_u *APIUsageUpdate) ClearAccessKeyID() *APIUsageUpdate {
+	_u.mutation.ClearAccessKeyID()
+	return _u
+}
+
+// SetBucket sets the "bucket" field.
+func (_u *APIUsageUpdate) SetBucket(v time.Time) *APIUsageUpdate {
+	_u.mutation.SetBucket(v)
+	return _u
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (_u *APIUsageUpdate) SetNillableBucket(v *time.Time) *APIUsageUpdate {
+	if v != nil {
+		_u.SetBucket(*v)
+	}
+	return _u
+}
+
+// SetRequests sets the "requests" field.
+func (_u *APIUsageUpdate) SetRequests(v int) *APIUsageUpdate {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *APIUsageUpdate) SetNillableRequests(v *int) *APIUsageUpdate {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *APIUsageUpdate) AddRequests(v int) *APIUsageUpdate {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (_u *APIUsageUpdate) SetClientErrors(v int) *APIUsageUpdate {
+	_u.mutation.ResetClientErrors()
+	_u.mutation.SetClientErrors(v)
+	return _u
+}
+
+// SetNillableClientErrors sets the "client_errors" field if the given value is not nil.
+func (_u *APIUsageUpdate) SetNillableClientErrors(v *int) *APIUsageUpdate {
+	if v != nil {
+		_u.SetClientErrors(*v)
+	}
+	return _u
+}
+
+// AddClientErrors adds value to the "client_errors" field.
+func (_u *APIUsageUpdate) AddClientErrors(v int) *APIUsageUpdate {
+	_u.mutation.AddClientErrors(v)
+	return _u
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (_u *APIUsageUpdate) SetServerErrors(v int) *APIUsageUpdate {
+	_u.mutation.ResetServerErrors()
+	_u.mutation.SetServerErrors(v)
+	return _u
+}
+
+// SetNillableServerErrors sets the "server_errors" field if the given value is not nil.
+func (_u *APIUsageUpdate) SetNillableServerErrors(v *int) *APIUsageUpdate {
+	if v != nil {
+		_u.SetServerErrors(*v)
+	}
+	return _u
+}
+
+// AddServerErrors adds value to the "server_errors" field.
+func (_u *APIUsageUpdate) AddServerErrors(v int) *APIUsageUpdate {
+	_u.mutation.AddServerErrors(v)
+	return _u
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (_u *APIUsageUpdate) SetRateLimited(v int) *APIUsageUpdate {
+	_u.mutation.ResetRateLimited()
+	_u.mutation.SetRateLimited(v)
+	return _u
+}
+
+// SetNillableRateLimited sets the "rate_limited" field if the given value is not nil.
+func (_u *APIUsageUpdate) SetNillableRateLimited(v *int) *APIUsageUpdate {
+	if v != nil {
+		_u.SetRateLimited(*v)
+	}
+	return _u
+}
+
+// AddRateLimited adds value to the "rate_limited" field.
+func (_u *APIUsageUpdate) AddRateLimited(v int) *APIUsageUpdate {
+	_u.mutation.AddRateLimited(v)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *APIUsageUpdate) SetAccount(v *Account) *APIUsageUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetAccessKey sets the "access_key" edge to the Authentication entity.
+func (_u *APIUsageUpdate) SetAccessKey(v *Authentication) *APIUsageUpdate {
+	return _u.SetAccessKeyID(v.ID)
+}
+
+// Mutation returns the APIUsageMutation object of the builder.
+func (_u *APIUsageUpdate) Mutation() *APIUsageMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *APIUsageUpdate) ClearAccount() *APIUsageUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearAccessKey clears the "access_key" edge to the Authentication entity.
+func (_u *APIUsageUpdate) ClearAccessKey() *APIUsageUpdate {
+	_u.mutation.ClearAccessKey()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *APIUsageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *APIUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *APIUsageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := apiusage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIUsageUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIUsage.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *APIUsageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APIUsageUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *APIUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiusage.Table, apiusage.Columns, sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Bucket(); ok {
+		_spec.SetField(apiusage.FieldBucket, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(apiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(apiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ClientErrors(); ok {
+		_spec.SetField(apiusage.FieldClientErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClientErrors(); ok {
+		_spec.AddField(apiusage.FieldClientErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ServerErrors(); ok {
+		_spec.SetField(apiusage.FieldServerErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedServerErrors(); ok {
+		_spec.AddField(apiusage.FieldServerErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RateLimited(); ok {
+		_spec.SetField(apiusage.FieldRateLimited, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimited(); ok {
+		_spec.AddField(apiusage.FieldRateLimited, field.TypeInt, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccountTable,
+			Columns: []string{apiusage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccountTable,
+			Columns: []string{apiusage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccessKeyTable,
+			Columns: []string{apiusage.AccessKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccessKeyTable,
+			Columns: []string{apiusage.AccessKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// APIUsageUpdateOne is the builder for updating a single APIUsage entity.
+type APIUsageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *APIUsageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *APIUsageUpdateOne) SetUpdatedAt(v time.Time) *APIUsageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *APIUsageUpdateOne) SetAccountID(v xid.ID) *APIUsageUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *APIUsageUpdateOne) SetNillableAccountID(v *xid.ID) *APIUsageUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (_u *APIUsageUpdateOne) SetAccessKeyID(v xid.ID) *APIUsageUpdateOne {
+	_u.mutation.SetAccessKeyID(v)
+	return _u
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (_u *APIUsageUpdateOne) SetNillableAccessKeyID(v *xid.ID) *APIUsageUpdateOne {
+	if v != nil {
+		_u.SetAccessKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (_u *APIUsageUpdateOne) ClearAccessKeyID() *APIUsageUpdateOne {
+	_u.mutation.ClearAccessKeyID()
+	return _u
+}
+
+// SetBucket sets the "bucket" field.
+func (_u *APIUsageUpdateOne) SetBucket(v time.Time) *APIUsageUpdateOne {
+	_u.mutation.SetBucket(v)
+	return _u
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (_u *APIUsageUpdateOne) SetNillableBucket(v *time.Time) *APIUsageUpdateOne {
+	if v != nil {
+		_u.SetBucket(*v)
+	}
+	return _u
+}
+
+// SetRequests sets the "requests" field.
+func (_u *APIUsageUpdateOne) SetRequests(v int) *APIUsageUpdateOne {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *APIUsageUpdateOne) SetNillableRequests(v *int) *APIUsageUpdateOne {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *APIUsageUpdateOne) AddRequests(v int) *APIUsageUpdateOne {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (_u *APIUsageUpdateOne) SetClientErrors(v int) *APIUsageUpdateOne {
+	_u.mutation.ResetClientErrors()
+	_u.mutation.SetClientErrors(v)
+	return _u
+}
+
+// SetNillableClientErrors sets the "client_errors" field if the given value is not nil.
+func (_u *APIUsageUpdateOne) SetNillableClientErrors(v *int) *APIUsageUpdateOne {
+	if v != nil {
+		_u.SetClientErrors(*v)
+	}
+	return _u
+}
+
+// AddClientErrors adds value to the "client_errors" field.
+func (_u *APIUsageUpdateOne) AddClientErrors(v int) *APIUsageUpdateOne {
+	_u.mutation.AddClientErrors(v)
+	return _u
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (_u *APIUsageUpdateOne) SetServerErrors(v int) *APIUsageUpdateOne {
+	_u.mutation.ResetServerErrors()
+	_u.mutation.SetServerErrors(v)
+	return _u
+}
+
+// SetNillableServerErrors sets the "server_errors" field if the given value is not nil.
+func (_u *APIUsageUpdateOne) SetNillableServerErrors(v *int) *APIUsageUpdateOne {
+	if v != nil {
+		_u.SetServerErrors(*v)
+	}
+	return _u
+}
+
+// AddServerErrors adds value to the "server_errors" field.
+func (_u *APIUsageUpdateOne) AddServerErrors(v int) *APIUsageUpdateOne {
+	_u.mutation.AddServerErrors(v)
+	return _u
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (_u *APIUsageUpdateOne) SetRateLimited(v int) *APIUsageUpdateOne {
+	_u.mutation.ResetRateLimited()
+	_u.mutation.SetRateLimited(v)
+	return _u
+}
+
+// SetNillableRateLimited sets the "rate_limited" field if the given value is not nil.
+func (_u *APIUsageUpdateOne) SetNillableRateLimited(v *int) *APIUsageUpdateOne {
+	if v != nil {
+		_u.SetRateLimited(*v)
+	}
+	return _u
+}
+
+// AddRateLimited adds value to the "rate_limited" field.
+func (_u *APIUsageUpdateOne) AddRateLimited(v int) *APIUsageUpdateOne {
+	_u.mutation.AddRateLimited(v)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *APIUsageUpdateOne) SetAccount(v *Account) *APIUsageUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetAccessKey sets the "access_key" edge to the Authentication entity.
+func (_u *APIUsageUpdateOne) SetAccessKey(v *Authentication) *APIUsageUpdateOne {
+	return _u.SetAccessKeyID(v.ID)
+}
+
+// Mutation returns the APIUsageMutation object of the builder.
+func (_u *APIUsageUpdateOne) Mutation() *APIUsageMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *APIUsageUpdateOne) ClearAccount() *APIUsageUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearAccessKey clears the "access_key" edge to the Authentication entity.
+func (_u *APIUsageUpdateOne) ClearAccessKey() *APIUsageUpdateOne {
+	_u.mutation.ClearAccessKey()
+	return _u
+}
+
+// Where appends a list predicates to the APIUsageUpdate builder.
+func (_u *APIUsageUpdateOne) Where(ps ...predicate.APIUsage) *APIUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *APIUsageUpdateOne) Select(field string, fields ...string) *APIUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated APIUsage entity.
+func (_u *APIUsageUpdateOne) Save(ctx context.Context) (*APIUsage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIUsageUpdateOne) SaveX(ctx context.Context) *APIUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *APIUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *APIUsageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := apiusage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIUsageUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIUsage.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *APIUsageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APIUsageUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *APIUsageUpdateOne) sqlSave(ctx context.Context) (_node *APIUsage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiusage.Table, apiusage.Columns, sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apiusage.FieldID)
+		for _, f := range fields {
+			if !apiusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apiusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Bucket(); ok {
+		_spec.SetField(apiusage.FieldBucket, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(apiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(apiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ClientErrors(); ok {
+		_spec.SetField(apiusage.FieldClientErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClientErrors(); ok {
+		_spec.AddField(apiusage.FieldClientErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ServerErrors(); ok {
+		_spec.SetField(apiusage.FieldServerErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedServerErrors(); ok {
+		_spec.AddField(apiusage.FieldServerErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RateLimited(); ok {
+		_spec.SetField(apiusage.FieldRateLimited, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimited(); ok {
+		_spec.AddField(apiusage.FieldRateLimited, field.TypeInt, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccountTable,
+			Columns: []string{apiusage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccountTable,
+			Columns: []string{apiusage.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccessKeyTable,
+			Columns: []string{apiusage.AccessKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiusage.AccessKeyTable,
+			Columns: []string{apiusage.AccessKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &APIUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -32,6 +32,16 @@ type Asset struct {
 	MimeType string `json:"mime_type,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash *string `json:"content_hash,omitempty"`
+	// ProcessingStatus holds the value of the "processing_status" field.
+	ProcessingStatus *string `json:"processing_status,omitempty"`
+	// Private holds the value of the "private" field.
+	Private bool `json:"private,omitempty"`
+	// ScanStatus holds the value of the "scan_status" field.
+	ScanStatus *string `json:"scan_status,omitempty"`
+	// ScanSignature holds the value of the "scan_signature" field.
+	ScanSignature *string `json:"scan_signature,omitempty"`
 	// AccountID holds the value of the "account_id" field.
 	AccountID xid.ID `json:"account_id,omitempty"`
 	// ParentAssetID holds the value of the "parent_asset_id" field.
@@ -50,6 +60,8 @@ type AssetEdges struct {
 	Nodes []*Node `json:"nodes,omitempty"`
 	// Links holds the value of the links edge.
 	Links []*Link `json:"links,omitempty"`
+	// DirectMessages holds the value of the direct_messages edge.
+	DirectMessages []*DirectMessage `json:"direct_messages,omitempty"`
 	// Owner holds the value of the owner edge.
 	Owner *Account `json:"owner,omitempty"`
 	// Parent holds the value of the parent edge.
@@ -60,7 +72,7 @@ type AssetEdges struct {
 	Event []*Event `json:"event,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [8]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -90,12 +102,21 @@ func (e AssetEdges) LinksOrErr() ([]*Link, error) {
 	return nil, &NotLoadedError{edge: "links"}
 }
 
+// DirectMessagesOrErr returns the DirectMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e AssetEdges) DirectMessagesOrErr() ([]*DirectMessage, error) {
+	if e.loadedTypes[3] {
+		return e.DirectMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "direct_messages"}
+}
+
 // OwnerOrErr returns the Owner value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e AssetEdges) OwnerOrErr() (*Account, error) {
 	if e.Owner != nil {
 		return e.Owner, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: account.Label}
 	}
 	return nil, &NotLoadedError{edge: "owner"}
@@ -106,7 +127,7 @@ func (e AssetEdges) OwnerOrErr() (*Account, error) {
 func (e AssetEdges) ParentOrErr() (*Asset, error) {
 	if e.Parent != nil {
 		return e.Parent, nil
-	} else if e.loadedTypes[4] {
+	} else if e.loadedTypes[5] {
 		return nil, &NotFoundError{label: asset.Label}
 	}
 	return nil, &NotLoadedError{edge: "parent"}
@@ -115,7 +136,7 @@ func (e AssetEdges) ParentOrErr() (*Asset, error) {
 // AssetsOrErr returns the Assets value or an error if the edge
 // was not loaded in eager-loading.
 func (e AssetEdges) AssetsOrErr() ([]*Asset, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[6] {
 		return e.Assets, nil
 	}
 	return nil, &NotLoadedError{edge: "assets"}
@@ -124,7 +145,7 @@ func (e AssetEdges) AssetsOrErr() ([]*Asset, error) {
 // EventOrErr returns the Event value or an error if the edge
 // was not loaded in eager-loading.
 func (e AssetEdges) EventOrErr() ([]*Event, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.Event, nil
 	}
 	return nil, &NotLoadedError{edge: "event"}
@@ -139,9 +160,11 @@ func (*Asset) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(xid.ID)}
 		case asset.FieldMetadata:
 			values[i] = new([]byte)
+		case asset.FieldPrivate:
+			values[i] = new(sql.NullBool)
 		case asset.FieldSize:
 			values[i] = new(sql.NullInt64)
-		case asset.FieldFilename, asset.FieldMimeType:
+		case asset.FieldFilename, asset.FieldMimeType, asset.FieldContentHash, asset.FieldProcessingStatus, asset.FieldScanStatus, asset.FieldScanSignature:
 			values[i] = new(sql.NullString)
 		case asset.FieldCreatedAt, asset.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -206,6 +229,40 @@ func (_m *Asset) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case asset.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = new(string)
+				*_m.ContentHash = value.String
+			}
+		case asset.FieldProcessingStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field processing_status", values[i])
+			} else if value.Valid {
+				_m.ProcessingStatus = new(string)
+				*_m.ProcessingStatus = value.String
+			}
+		case asset.FieldPrivate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field private", values[i])
+			} else if value.Valid {
+				_m.Private = value.Bool
+			}
+		case asset.FieldScanStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_status", values[i])
+			} else if value.Valid {
+				_m.ScanStatus = new(string)
+				*_m.ScanStatus = value.String
+			}
+		case asset.FieldScanSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_signature", values[i])
+			} else if value.Valid {
+				_m.ScanSignature = new(string)
+				*_m.ScanSignature = value.String
+			}
 		case asset.FieldAccountID:
 			if value, ok := values[i].(*xid.ID); !ok {
 				return fmt.Errorf("unexpected type %T for field account_id", values[i])
@@ -247,6 +304,11 @@ func (_m *Asset) QueryLinks() *LinkQuery {
 	return NewAssetClient(_m.config).QueryLinks(_m)
 }
 
+// QueryDirectMessages queries the "direct_messages" edge of the Asset entity.
+func (_m *Asset) QueryDirectMessages() *DirectMessageQuery {
+	return NewAssetClient(_m.config).QueryDirectMessages(_m)
+}
+
 // QueryOwner queries the "owner" edge of the Asset entity.
 func (_m *Asset) QueryOwner() *AccountQuery {
 	return NewAssetClient(_m.config).QueryOwner(_m)
@@ -308,6 +370,29 @@ func (_m *Asset) String() string {
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
+	if v := _m.ContentHash; v != nil {
+		builder.WriteString("content_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ProcessingStatus; v != nil {
+		builder.WriteString("processing_status=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("private=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Private))
+	builder.WriteString(", ")
+	if v := _m.ScanStatus; v != nil {
+		builder.WriteString("scan_status=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ScanSignature; v != nil {
+		builder.WriteString("scan_signature=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("account_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
 	builder.WriteString(", ")
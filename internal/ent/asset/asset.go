@@ -27,6 +27,16 @@ const (
 	FieldMimeType = "mime_type"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldProcessingStatus holds the string denoting the processing_status field in the database.
+	FieldProcessingStatus = "processing_status"
+	// FieldPrivate holds the string denoting the private field in the database.
+	FieldPrivate = "private"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
+	// FieldScanSignature holds the string denoting the scan_signature field in the database.
+	FieldScanSignature = "scan_signature"
 	// FieldAccountID holds the string denoting the account_id field in the database.
 	FieldAccountID = "account_id"
 	// FieldParentAssetID holds the string denoting the parent_asset_id field in the database.
@@ -37,6 +47,8 @@ const (
 	EdgeNodes = "nodes"
 	// EdgeLinks holds the string denoting the links edge name in mutations.
 	EdgeLinks = "links"
+	// EdgeDirectMessages holds the string denoting the direct_messages edge name in mutations.
+	EdgeDirectMessages = "direct_messages"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
@@ -62,6 +74,11 @@ const (
 	// LinksInverseTable is the table name for the Link entity.
 	// It exists in this package in order to avoid circular dependency with the "link" package.
 	LinksInverseTable = "links"
+	// DirectMessagesTable is the table that holds the direct_messages relation/edge. The primary key declared below.
+	DirectMessagesTable = "direct_message_assets"
+	// DirectMessagesInverseTable is the table name for the DirectMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "directmessage" package.
+	DirectMessagesInverseTable = "direct_messages"
 	// OwnerTable is the table that holds the owner relation/edge.
 	OwnerTable = "assets"
 	// OwnerInverseTable is the table name for the Account entity.
@@ -95,6 +112,11 @@ var Columns = []string{
 	FieldSize,
 	FieldMimeType,
 	FieldMetadata,
+	FieldContentHash,
+	FieldProcessingStatus,
+	FieldPrivate,
+	FieldScanStatus,
+	FieldScanSignature,
 	FieldAccountID,
 	FieldParentAssetID,
 }
@@ -109,6 +131,9 @@ var (
 	// LinksPrimaryKey and LinksColumn2 are the table columns denoting the
 	// primary key for the links relation (M2M).
 	LinksPrimaryKey = []string{"link_id", "asset_id"}
+	// DirectMessagesPrimaryKey and DirectMessagesColumn2 are the table columns denoting the
+	// primary key for the direct_messages relation (M2M).
+	DirectMessagesPrimaryKey = []string{"direct_message_id", "asset_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -128,6 +153,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultPrivate holds the default value on creation for the "private" field.
+	DefaultPrivate bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() xid.ID
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -167,6 +194,31 @@ func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
 }
 
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByProcessingStatus orders the results by the processing_status field.
+func ByProcessingStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessingStatus, opts...).ToFunc()
+}
+
+// ByPrivate orders the results by the private field.
+func ByPrivate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrivate, opts...).ToFunc()
+}
+
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
+// ByScanSignature orders the results by the scan_signature field.
+func ByScanSignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanSignature, opts...).ToFunc()
+}
+
 // ByAccountID orders the results by the account_id field.
 func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
@@ -219,6 +271,20 @@ func ByLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByDirectMessagesCount orders the results by direct_messages count.
+func ByDirectMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDirectMessagesStep(), opts...)
+	}
+}
+
+// ByDirectMessages orders the results by direct_messages terms.
+func ByDirectMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDirectMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -281,6 +347,13 @@ func newLinksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, LinksTable, LinksPrimaryKey...),
 	)
 }
+func newDirectMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DirectMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, DirectMessagesTable, DirectMessagesPrimaryKey...),
+	)
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -81,6 +81,31 @@ func MimeType(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldMimeType, v))
 }
 
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ProcessingStatus applies equality check predicate on the "processing_status" field. It's identical to ProcessingStatusEQ.
+func ProcessingStatus(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldProcessingStatus, v))
+}
+
+// Private applies equality check predicate on the "private" field. It's identical to PrivateEQ.
+func Private(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldPrivate, v))
+}
+
+// ScanStatus applies equality check predicate on the "scan_status" field. It's identical to ScanStatusEQ.
+func ScanStatus(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanSignature applies equality check predicate on the "scan_signature" field. It's identical to ScanSignatureEQ.
+func ScanSignature(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldScanSignature, v))
+}
+
 // AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
 func AccountID(v xid.ID) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldAccountID, v))
@@ -351,6 +376,316 @@ func MetadataNotNil() predicate.Asset {
 	return predicate.Asset(sql.FieldNotNull(FieldMetadata))
 }
 
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// ProcessingStatusEQ applies the EQ predicate on the "processing_status" field.
+func ProcessingStatusEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusNEQ applies the NEQ predicate on the "processing_status" field.
+func ProcessingStatusNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusIn applies the In predicate on the "processing_status" field.
+func ProcessingStatusIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldProcessingStatus, vs...))
+}
+
+// ProcessingStatusNotIn applies the NotIn predicate on the "processing_status" field.
+func ProcessingStatusNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldProcessingStatus, vs...))
+}
+
+// ProcessingStatusGT applies the GT predicate on the "processing_status" field.
+func ProcessingStatusGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusGTE applies the GTE predicate on the "processing_status" field.
+func ProcessingStatusGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusLT applies the LT predicate on the "processing_status" field.
+func ProcessingStatusLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusLTE applies the LTE predicate on the "processing_status" field.
+func ProcessingStatusLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusContains applies the Contains predicate on the "processing_status" field.
+func ProcessingStatusContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusHasPrefix applies the HasPrefix predicate on the "processing_status" field.
+func ProcessingStatusHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusHasSuffix applies the HasSuffix predicate on the "processing_status" field.
+func ProcessingStatusHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusIsNil applies the IsNil predicate on the "processing_status" field.
+func ProcessingStatusIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldProcessingStatus))
+}
+
+// ProcessingStatusNotNil applies the NotNil predicate on the "processing_status" field.
+func ProcessingStatusNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldProcessingStatus))
+}
+
+// ProcessingStatusEqualFold applies the EqualFold predicate on the "processing_status" field.
+func ProcessingStatusEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldProcessingStatus, v))
+}
+
+// ProcessingStatusContainsFold applies the ContainsFold predicate on the "processing_status" field.
+func ProcessingStatusContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldProcessingStatus, v))
+}
+
+// PrivateEQ applies the EQ predicate on the "private" field.
+func PrivateEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldPrivate, v))
+}
+
+// PrivateNEQ applies the NEQ predicate on the "private" field.
+func PrivateNEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldPrivate, v))
+}
+
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusGT applies the GT predicate on the "scan_status" field.
+func ScanStatusGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldScanStatus, v))
+}
+
+// ScanStatusGTE applies the GTE predicate on the "scan_status" field.
+func ScanStatusGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldScanStatus, v))
+}
+
+// ScanStatusLT applies the LT predicate on the "scan_status" field.
+func ScanStatusLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldScanStatus, v))
+}
+
+// ScanStatusLTE applies the LTE predicate on the "scan_status" field.
+func ScanStatusLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldScanStatus, v))
+}
+
+// ScanStatusContains applies the Contains predicate on the "scan_status" field.
+func ScanStatusContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldScanStatus, v))
+}
+
+// ScanStatusHasPrefix applies the HasPrefix predicate on the "scan_status" field.
+func ScanStatusHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldScanStatus, v))
+}
+
+// ScanStatusHasSuffix applies the HasSuffix predicate on the "scan_status" field.
+func ScanStatusHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldScanStatus, v))
+}
+
+// ScanStatusIsNil applies the IsNil predicate on the "scan_status" field.
+func ScanStatusIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldScanStatus))
+}
+
+// ScanStatusNotNil applies the NotNil predicate on the "scan_status" field.
+func ScanStatusNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldScanStatus))
+}
+
+// ScanStatusEqualFold applies the EqualFold predicate on the "scan_status" field.
+func ScanStatusEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldScanStatus, v))
+}
+
+// ScanStatusContainsFold applies the ContainsFold predicate on the "scan_status" field.
+func ScanStatusContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldScanStatus, v))
+}
+
+// ScanSignatureEQ applies the EQ predicate on the "scan_signature" field.
+func ScanSignatureEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldScanSignature, v))
+}
+
+// ScanSignatureNEQ applies the NEQ predicate on the "scan_signature" field.
+func ScanSignatureNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldScanSignature, v))
+}
+
+// ScanSignatureIn applies the In predicate on the "scan_signature" field.
+func ScanSignatureIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldScanSignature, vs...))
+}
+
+// ScanSignatureNotIn applies the NotIn predicate on the "scan_signature" field.
+func ScanSignatureNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldScanSignature, vs...))
+}
+
+// ScanSignatureGT applies the GT predicate on the "scan_signature" field.
+func ScanSignatureGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldScanSignature, v))
+}
+
+// ScanSignatureGTE applies the GTE predicate on the "scan_signature" field.
+func ScanSignatureGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldScanSignature, v))
+}
+
+// ScanSignatureLT applies the LT predicate on the "scan_signature" field.
+func ScanSignatureLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldScanSignature, v))
+}
+
+// ScanSignatureLTE applies the LTE predicate on the "scan_signature" field.
+func ScanSignatureLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldScanSignature, v))
+}
+
+// ScanSignatureContains applies the Contains predicate on the "scan_signature" field.
+func ScanSignatureContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldScanSignature, v))
+}
+
+// ScanSignatureHasPrefix applies the HasPrefix predicate on the "scan_signature" field.
+func ScanSignatureHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldScanSignature, v))
+}
+
+// ScanSignatureHasSuffix applies the HasSuffix predicate on the "scan_signature" field.
+func ScanSignatureHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldScanSignature, v))
+}
+
+// ScanSignatureIsNil applies the IsNil predicate on the "scan_signature" field.
+func ScanSignatureIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldScanSignature))
+}
+
+// ScanSignatureNotNil applies the NotNil predicate on the "scan_signature" field.
+func ScanSignatureNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldScanSignature))
+}
+
+// ScanSignatureEqualFold applies the EqualFold predicate on the "scan_signature" field.
+func ScanSignatureEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldScanSignature, v))
+}
+
+// ScanSignatureContainsFold applies the ContainsFold predicate on the "scan_signature" field.
+func ScanSignatureContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldScanSignature, v))
+}
+
 // AccountIDEQ applies the EQ predicate on the "account_id" field.
 func AccountIDEQ(v xid.ID) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldAccountID, v))
@@ -570,6 +905,29 @@ func HasLinksWith(preds ...predicate.Link) predicate.Asset {
 	})
 }
 
+// HasDirectMessages applies the HasEdge predicate on the "direct_messages" edge.
+func HasDirectMessages() predicate.Asset {
+	return predicate.Asset(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, DirectMessagesTable, DirectMessagesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDirectMessagesWith applies the HasEdge predicate on the "direct_messages" edge with a given conditions (other predicates).
+func HasDirectMessagesWith(preds ...predicate.DirectMessage) predicate.Asset {
+	return predicate.Asset(func(s *sql.Selector) {
+		step := newDirectMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Asset {
 	return predicate.Asset(func(s *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/node"
@@ -81,6 +82,76 @@ func (_c *AssetCreate) SetMetadata(v map[string]interface{}) *AssetCreate {
 	return _c
 }
 
+// SetContentHash sets the "content_hash" field.
+func (_c *AssetCreate) SetContentHash(v string) *AssetCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableContentHash(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetContentHash(*v)
+	}
+	return _c
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (_c *AssetCreate) SetProcessingStatus(v string) *AssetCreate {
+	_c.mutation.SetProcessingStatus(v)
+	return _c
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableProcessingStatus(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetProcessingStatus(*v)
+	}
+	return _c
+}
+
+// SetPrivate sets the "private" field.
+func (_c *AssetCreate) SetPrivate(v bool) *AssetCreate {
+	_c.mutation.SetPrivate(v)
+	return _c
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (_c *AssetCreate) SetNillablePrivate(v *bool) *AssetCreate {
+	if v != nil {
+		_c.SetPrivate(*v)
+	}
+	return _c
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_c *AssetCreate) SetScanStatus(v string) *AssetCreate {
+	_c.mutation.SetScanStatus(v)
+	return _c
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableScanStatus(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetScanStatus(*v)
+	}
+	return _c
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_c *AssetCreate) SetScanSignature(v string) *AssetCreate {
+	_c.mutation.SetScanSignature(v)
+	return _c
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableScanSignature(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetScanSignature(*v)
+	}
+	return _c
+}
+
 // SetAccountID sets the "account_id" field.
 func (_c *AssetCreate) SetAccountID(v xid.ID) *AssetCreate {
 	_c.mutation.SetAccountID(v)
@@ -160,6 +231,21 @@ func (_c *AssetCreate) AddLinks(v ...*Link) *AssetCreate {
 	return _c.AddLinkIDs(ids...)
 }
 
+// AddDirectMessageIDs adds the "direct_messages" edge to the DirectMessage entity by IDs.
+func (_c *AssetCreate) AddDirectMessageIDs(ids ...xid.ID) *AssetCreate {
+	_c.mutation.AddDirectMessageIDs(ids...)
+	return _c
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DirectMessage entity.
+func (_c *AssetCreate) AddDirectMessages(v ...*DirectMessage) *AssetCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDirectMessageIDs(ids...)
+}
+
 // SetOwnerID sets the "owner" edge to the Account entity by ID.
 func (_c *AssetCreate) SetOwnerID(id xid.ID) *AssetCreate {
 	_c.mutation.SetOwnerID(id)
@@ -263,6 +349,10 @@ func (_c *AssetCreate) defaults() {
 		v := asset.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Private(); !ok {
+		v := asset.DefaultPrivate
+		_c.mutation.SetPrivate(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := asset.DefaultID()
 		_c.mutation.SetID(v)
@@ -286,6 +376,9 @@ func (_c *AssetCreate) check() error {
 	if _, ok := _c.mutation.MimeType(); !ok {
 		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "Asset.mime_type"`)}
 	}
+	if _, ok := _c.mutation.Private(); !ok {
+		return &ValidationError{Name: "private", err: errors.New(`ent: missing required field "Asset.private"`)}
+	}
 	if _, ok := _c.mutation.AccountID(); !ok {
 		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Asset.account_id"`)}
 	}
@@ -357,6 +450,26 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		_spec.SetField(asset.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(asset.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = &value
+	}
+	if value, ok := _c.mutation.ProcessingStatus(); ok {
+		_spec.SetField(asset.FieldProcessingStatus, field.TypeString, value)
+		_node.ProcessingStatus = &value
+	}
+	if value, ok := _c.mutation.Private(); ok {
+		_spec.SetField(asset.FieldPrivate, field.TypeBool, value)
+		_node.Private = value
+	}
+	if value, ok := _c.mutation.ScanStatus(); ok {
+		_spec.SetField(asset.FieldScanStatus, field.TypeString, value)
+		_node.ScanStatus = &value
+	}
+	if value, ok := _c.mutation.ScanSignature(); ok {
+		_spec.SetField(asset.FieldScanSignature, field.TypeString, value)
+		_node.ScanSignature = &value
+	}
 	if nodes := _c.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -405,6 +518,22 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   asset.DirectMessagesTable,
+			Columns: asset.DirectMessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -595,6 +724,90 @@ func (u *AssetUpsert) ClearMetadata() *AssetUpsert {
 	return u
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *AssetUpsert) SetContentHash(v string) *AssetUpsert {
+	u.Set(asset.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateContentHash() *AssetUpsert {
+	u.SetExcluded(asset.FieldContentHash)
+	return u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *AssetUpsert) ClearContentHash() *AssetUpsert {
+	u.SetNull(asset.FieldContentHash)
+	return u
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (u *AssetUpsert) SetProcessingStatus(v string) *AssetUpsert {
+	u.Set(asset.FieldProcessingStatus, v)
+	return u
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateProcessingStatus() *AssetUpsert {
+	u.SetExcluded(asset.FieldProcessingStatus)
+	return u
+}
+
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (u *AssetUpsert) ClearProcessingStatus() *AssetUpsert {
+	u.SetNull(asset.FieldProcessingStatus)
+	return u
+}
+
+// SetPrivate sets the "private" field.
+func (u *AssetUpsert) SetPrivate(v bool) *AssetUpsert {
+	u.Set(asset.FieldPrivate, v)
+	return u
+}
+
+// UpdatePrivate sets the "private" field to the value that was provided on create.
+func (u *AssetUpsert) UpdatePrivate() *AssetUpsert {
+	u.SetExcluded(asset.FieldPrivate)
+	return u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *AssetUpsert) SetScanStatus(v string) *AssetUpsert {
+	u.Set(asset.FieldScanStatus, v)
+	return u
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateScanStatus() *AssetUpsert {
+	u.SetExcluded(asset.FieldScanStatus)
+	return u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *AssetUpsert) ClearScanStatus() *AssetUpsert {
+	u.SetNull(asset.FieldScanStatus)
+	return u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (u *AssetUpsert) SetScanSignature(v string) *AssetUpsert {
+	u.Set(asset.FieldScanSignature, v)
+	return u
+}
+
+// UpdateScanSignature sets the "scan_signature" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateScanSignature() *AssetUpsert {
+	u.SetExcluded(asset.FieldScanSignature)
+	return u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (u *AssetUpsert) ClearScanSignature() *AssetUpsert {
+	u.SetNull(asset.FieldScanSignature)
+	return u
+}
+
 // SetAccountID sets the "account_id" field.
 func (u *AssetUpsert) SetAccountID(v xid.ID) *AssetUpsert {
 	u.Set(asset.FieldAccountID, v)
@@ -760,6 +973,104 @@ func (u *AssetUpsertOne) ClearMetadata() *AssetUpsertOne {
 	})
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *AssetUpsertOne) SetContentHash(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateContentHash() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *AssetUpsertOne) ClearContentHash() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (u *AssetUpsertOne) SetProcessingStatus(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetProcessingStatus(v)
+	})
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateProcessingStatus() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateProcessingStatus()
+	})
+}
+
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (u *AssetUpsertOne) ClearProcessingStatus() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearProcessingStatus()
+	})
+}
+
+// SetPrivate sets the "private" field.
+func (u *AssetUpsertOne) SetPrivate(v bool) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetPrivate(v)
+	})
+}
+
+// UpdatePrivate sets the "private" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdatePrivate() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdatePrivate()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *AssetUpsertOne) SetScanStatus(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateScanStatus() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *AssetUpsertOne) ClearScanStatus() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearScanStatus()
+	})
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (u *AssetUpsertOne) SetScanSignature(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetScanSignature(v)
+	})
+}
+
+// UpdateScanSignature sets the "scan_signature" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateScanSignature() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateScanSignature()
+	})
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (u *AssetUpsertOne) ClearScanSignature() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearScanSignature()
+	})
+}
+
 // SetAccountID sets the "account_id" field.
 func (u *AssetUpsertOne) SetAccountID(v xid.ID) *AssetUpsertOne {
 	return u.Update(func(s *AssetUpsert) {
@@ -1097,6 +1408,104 @@ func (u *AssetUpsertBulk) ClearMetadata() *AssetUpsertBulk {
 	})
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *AssetUpsertBulk) SetContentHash(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateContentHash() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *AssetUpsertBulk) ClearContentHash() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (u *AssetUpsertBulk) SetProcessingStatus(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetProcessingStatus(v)
+	})
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateProcessingStatus() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateProcessingStatus()
+	})
+}
+
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (u *AssetUpsertBulk) ClearProcessingStatus() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearProcessingStatus()
+	})
+}
+
+// SetPrivate sets the "private" field.
+func (u *AssetUpsertBulk) SetPrivate(v bool) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetPrivate(v)
+	})
+}
+
+// UpdatePrivate sets the "private" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdatePrivate() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdatePrivate()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *AssetUpsertBulk) SetScanStatus(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateScanStatus() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *AssetUpsertBulk) ClearScanStatus() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearScanStatus()
+	})
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (u *AssetUpsertBulk) SetScanSignature(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetScanSignature(v)
+	})
+}
+
+// UpdateScanSignature sets the "scan_signature" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateScanSignature() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateScanSignature()
+	})
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (u *AssetUpsertBulk) ClearScanSignature() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.ClearScanSignature()
+	})
+}
+
 // SetAccountID sets the "account_id" field.
 func (u *AssetUpsertBulk) SetAccountID(v xid.ID) *AssetUpsertBulk {
 	return u.Update(func(s *AssetUpsert) {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/node"
@@ -25,18 +26,19 @@ import (
 // AssetQuery is the builder for querying Asset entities.
 type AssetQuery struct {
 	config
-	ctx        *QueryContext
-	order      []asset.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Asset
-	withPosts  *PostQuery
-	withNodes  *NodeQuery
-	withLinks  *LinkQuery
-	withOwner  *AccountQuery
-	withParent *AssetQuery
-	withAssets *AssetQuery
-	withEvent  *EventQuery
-	modifiers  []func(*sql.Selector)
+	ctx                *QueryContext
+	order              []asset.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Asset
+	withPosts          *PostQuery
+	withNodes          *NodeQuery
+	withLinks          *LinkQuery
+	withDirectMessages *DirectMessageQuery
+	withOwner          *AccountQuery
+	withParent         *AssetQuery
+	withAssets         *AssetQuery
+	withEvent          *EventQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -139,6 +141,28 @@ func (_q *AssetQuery) QueryLinks() *LinkQuery {
 	return query
 }
 
+// QueryDirectMessages chains the current query on the "direct_messages" edge.
+func (_q *AssetQuery) QueryDirectMessages() *DirectMessageQuery {
+	query := (&DirectMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, selector),
+			sqlgraph.To(directmessage.Table, directmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, asset.DirectMessagesTable, asset.DirectMessagesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryOwner chains the current query on the "owner" edge.
 func (_q *AssetQuery) QueryOwner() *AccountQuery {
 	query := (&AccountClient{config: _q.config}).Query()
@@ -414,18 +438,19 @@ func (_q *AssetQuery) Clone() *AssetQuery {
 		return nil
 	}
 	return &AssetQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]asset.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Asset{}, _q.predicates...),
-		withPosts:  _q.withPosts.Clone(),
-		withNodes:  _q.withNodes.Clone(),
-		withLinks:  _q.withLinks.Clone(),
-		withOwner:  _q.withOwner.Clone(),
-		withParent: _q.withParent.Clone(),
-		withAssets: _q.withAssets.Clone(),
-		withEvent:  _q.withEvent.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]asset.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Asset{}, _q.predicates...),
+		withPosts:          _q.withPosts.Clone(),
+		withNodes:          _q.withNodes.Clone(),
+		withLinks:          _q.withLinks.Clone(),
+		withDirectMessages: _q.withDirectMessages.Clone(),
+		withOwner:          _q.withOwner.Clone(),
+		withParent:         _q.withParent.Clone(),
+		withAssets:         _q.withAssets.Clone(),
+		withEvent:          _q.withEvent.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -466,6 +491,17 @@ func (_q *AssetQuery) WithLinks(opts ...func(*LinkQuery)) *AssetQuery {
 	return _q
 }
 
+// WithDirectMessages tells the query-builder to eager-load the nodes that are connected to
+// the "direct_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AssetQuery) WithDirectMessages(opts ...func(*DirectMessageQuery)) *AssetQuery {
+	query := (&DirectMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDirectMessages = query
+	return _q
+}
+
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *AssetQuery) WithOwner(opts ...func(*AccountQuery)) *AssetQuery {
@@ -588,10 +624,11 @@ func (_q *AssetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Asset,
 	var (
 		nodes       = []*Asset{}
 		_spec       = _q.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [8]bool{
 			_q.withPosts != nil,
 			_q.withNodes != nil,
 			_q.withLinks != nil,
+			_q.withDirectMessages != nil,
 			_q.withOwner != nil,
 			_q.withParent != nil,
 			_q.withAssets != nil,
@@ -640,6 +677,13 @@ func (_q *AssetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Asset,
 			return nil, err
 		}
 	}
+	if query := _q.withDirectMessages; query != nil {
+		if err := _q.loadDirectMessages(ctx, query, nodes,
+			func(n *Asset) { n.Edges.DirectMessages = []*DirectMessage{} },
+			func(n *Asset, e *DirectMessage) { n.Edges.DirectMessages = append(n.Edges.DirectMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withOwner; query != nil {
 		if err := _q.loadOwner(ctx, query, nodes, nil,
 			func(n *Asset, e *Account) { n.Edges.Owner = e }); err != nil {
@@ -852,6 +896,67 @@ func (_q *AssetQuery) loadLinks(ctx context.Context, query *LinkQuery, nodes []*
 	}
 	return nil
 }
+func (_q *AssetQuery) loadDirectMessages(ctx context.Context, query *DirectMessageQuery, nodes []*Asset, init func(*Asset), assign func(*Asset, *DirectMessage)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[xid.ID]*Asset)
+	nids := make(map[xid.ID]map[*Asset]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(asset.DirectMessagesTable)
+		s.Join(joinT).On(s.C(directmessage.FieldID), joinT.C(asset.DirectMessagesPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(asset.DirectMessagesPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(asset.DirectMessagesPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(xid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*xid.ID)
+				inValue := *values[1].(*xid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Asset]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DirectMessage](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "direct_messages" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (_q *AssetQuery) loadOwner(ctx context.Context, query *AccountQuery, nodes []*Asset, init func(*Asset), assign func(*Asset, *Account)) error {
 	ids := make([]xid.ID, 0, len(nodes))
 	nodeids := make(map[xid.ID][]*Asset)
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/node"
@@ -102,6 +103,100 @@ func (_u *AssetUpdate) ClearMetadata() *AssetUpdate {
 	return _u
 }
 
+// SetContentHash sets the "content_hash" field.
+func (_u *AssetUpdate) SetContentHash(v string) *AssetUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableContentHash(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *AssetUpdate) ClearContentHash() *AssetUpdate {
+	_u.mutation.ClearContentHash()
+	return _u
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (_u *AssetUpdate) SetProcessingStatus(v string) *AssetUpdate {
+	_u.mutation.SetProcessingStatus(v)
+	return _u
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableProcessingStatus(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetProcessingStatus(*v)
+	}
+	return _u
+}
+
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (_u *AssetUpdate) ClearProcessingStatus() *AssetUpdate {
+	_u.mutation.ClearProcessingStatus()
+	return _u
+}
+
+// SetPrivate sets the "private" field.
+func (_u *AssetUpdate) SetPrivate(v bool) *AssetUpdate {
+	_u.mutation.SetPrivate(v)
+	return _u
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillablePrivate(v *bool) *AssetUpdate {
+	if v != nil {
+		_u.SetPrivate(*v)
+	}
+	return _u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_u *AssetUpdate) SetScanStatus(v string) *AssetUpdate {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableScanStatus(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *AssetUpdate) ClearScanStatus() *AssetUpdate {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_u *AssetUpdate) SetScanSignature(v string) *AssetUpdate {
+	_u.mutation.SetScanSignature(v)
+	return _u
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableScanSignature(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetScanSignature(*v)
+	}
+	return _u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (_u *AssetUpdate) ClearScanSignature() *AssetUpdate {
+	_u.mutation.ClearScanSignature()
+	return _u
+}
+
 // SetAccountID sets the "account_id" field.
 func (_u *AssetUpdate) SetAccountID(v xid.ID) *AssetUpdate {
 	_u.mutation.SetAccountID(v)
@@ -181,6 +276,21 @@ func (_u *AssetUpdate) AddLinks(v ...*Link) *AssetUpdate {
 	return _u.AddLinkIDs(ids...)
 }
 
+// AddDirectMessageIDs adds the "direct_messages" edge to the DirectMessage entity by IDs.
+func (_u *AssetUpdate) AddDirectMessageIDs(ids ...xid.ID) *AssetUpdate {
+	_u.mutation.AddDirectMessageIDs(ids...)
+	return _u
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DirectMessage entity.
+func (_u *AssetUpdate) AddDirectMessages(v ...*DirectMessage) *AssetUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDirectMessageIDs(ids...)
+}
+
 // SetOwnerID sets the "owner" edge to the Account entity by ID.
 func (_u *AssetUpdate) SetOwnerID(id xid.ID) *AssetUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -309,6 +419,27 @@ func (_u *AssetUpdate) RemoveLinks(v ...*Link) *AssetUpdate {
 	return _u.RemoveLinkIDs(ids...)
 }
 
+// ClearDirectMessages clears all "direct_messages" edges to the DirectMessage entity.
+func (_u *AssetUpdate) ClearDirectMessages() *AssetUpdate {
+	_u.mutation.ClearDirectMessages()
+	return _u
+}
+
+// RemoveDirectMessageIDs removes the "direct_messages" edge to DirectMessage entities by IDs.
+func (_u *AssetUpdate) RemoveDirectMessageIDs(ids ...xid.ID) *AssetUpdate {
+	_u.mutation.RemoveDirectMessageIDs(ids...)
+	return _u
+}
+
+// RemoveDirectMessages removes "direct_messages" edges to DirectMessage entities.
+func (_u *AssetUpdate) RemoveDirectMessages(v ...*DirectMessage) *AssetUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDirectMessageIDs(ids...)
+}
+
 // ClearOwner clears the "owner" edge to the Account entity.
 func (_u *AssetUpdate) ClearOwner() *AssetUpdate {
 	_u.mutation.ClearOwner()
@@ -446,6 +577,33 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(asset.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(asset.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(asset.FieldContentHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProcessingStatus(); ok {
+		_spec.SetField(asset.FieldProcessingStatus, field.TypeString, value)
+	}
+	if _u.mutation.ProcessingStatusCleared() {
+		_spec.ClearField(asset.FieldProcessingStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.Private(); ok {
+		_spec.SetField(asset.FieldPrivate, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(asset.FieldScanStatus, field.TypeString, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(asset.FieldScanStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScanSignature(); ok {
+		_spec.SetField(asset.FieldScanSignature, field.TypeString, value)
+	}
+	if _u.mutation.ScanSignatureCleared() {
+		_spec.ClearField(asset.FieldScanSignature, field.TypeString)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -581,6 +739,51 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   asset.DirectMessagesTable,
+			Columns: asset.DirectMessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDirectMessagesIDs(); len(nodes) > 0 && !_u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   asset.DirectMessagesTable,
+			Columns: asset.DirectMessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   asset.DirectMessagesTable,
+			Columns: asset.DirectMessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -818,6 +1021,100 @@ func (_u *AssetUpdateOne) ClearMetadata() *AssetUpdateOne {
 	return _u
 }
 
+// SetContentHash sets the "content_hash" field.
+func (_u *AssetUpdateOne) SetContentHash(v string) *AssetUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableContentHash(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *AssetUpdateOne) ClearContentHash() *AssetUpdateOne {
+	_u.mutation.ClearContentHash()
+	return _u
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (_u *AssetUpdateOne) SetProcessingStatus(v string) *AssetUpdateOne {
+	_u.mutation.SetProcessingStatus(v)
+	return _u
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableProcessingStatus(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetProcessingStatus(*v)
+	}
+	return _u
+}
+
+// ClearProcessingStatus clears the value of the "processing_status" field.
+func (_u *AssetUpdateOne) ClearProcessingStatus() *AssetUpdateOne {
+	_u.mutation.ClearProcessingStatus()
+	return _u
+}
+
+// SetPrivate sets the "private" field.
+func (_u *AssetUpdateOne) SetPrivate(v bool) *AssetUpdateOne {
+	_u.mutation.SetPrivate(v)
+	return _u
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillablePrivate(v *bool) *AssetUpdateOne {
+	if v != nil {
+		_u.SetPrivate(*v)
+	}
+	return _u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_u *AssetUpdateOne) SetScanStatus(v string) *AssetUpdateOne {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableScanStatus(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *AssetUpdateOne) ClearScanStatus() *AssetUpdateOne {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_u *AssetUpdateOne) SetScanSignature(v string) *AssetUpdateOne {
+	_u.mutation.SetScanSignature(v)
+	return _u
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableScanSignature(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetScanSignature(*v)
+	}
+	return _u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (_u *AssetUpdateOne) ClearScanSignature() *AssetUpdateOne {
+	_u.mutation.ClearScanSignature()
+	return _u
+}
+
 // SetAccountID sets the "account_id" field.
 func (_u *AssetUpdateOne) SetAccountID(v xid.ID) *AssetUpdateOne {
 	_u.mutation.SetAccountID(v)
@@ -897,6 +1194,21 @@ func (_u *AssetUpdateOne) AddLinks(v ...*Link) *AssetUpdateOne {
 	return _u.AddLinkIDs(ids...)
 }
 
+// AddDirectMessageIDs adds the "direct_messages" edge to the DirectMessage entity by IDs.
+func (_u *AssetUpdateOne) AddDirectMessageIDs(ids ...xid.ID) *AssetUpdateOne {
+	_u.mutation.AddDirectMessageIDs(ids...)
+	return _u
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DirectMessage entity.
+func (_u *AssetUpdateOne) AddDirectMessages(v ...*DirectMessage) *AssetUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDirectMessageIDs(ids...)
+}
+
 // SetOwnerID sets the "owner" edge to the Account entity by ID.
 func (_u *AssetUpdateOne) SetOwnerID(id xid.ID) *AssetUpdateOne {
 	_u.mutation.SetOwnerID(id)
@@ -1025,6 +1337,27 @@ func (_u *AssetUpdateOne) RemoveLinks(v ...*Link) *AssetUpdateOne {
 	return _u.RemoveLinkIDs(ids...)
 }
 
+// ClearDirectMessages clears all "direct_messages" edges to the DirectMessage entity.
+func (_u *AssetUpdateOne) ClearDirectMessages() *AssetUpdateOne {
+	_u.mutation.ClearDirectMessages()
+	return _u
+}
+
+// RemoveDirectMessageIDs removes the "direct_messages" edge to DirectMessage entities by IDs.
+func (_u *AssetUpdateOne) RemoveDirectMessageIDs(ids ...xid.ID) *AssetUpdateOne {
+	_u.mutation.RemoveDirectMessageIDs(ids...)
+	return _u
+}
+
+// RemoveDirectMessages removes "direct_messages" edges to DirectMessage entities.
+func (_u *AssetUpdateOne) RemoveDirectMessages(v ...*DirectMessage) *AssetUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDirectMessageIDs(ids...)
+}
+
 // ClearOwner clears the "owner" edge to the Account entity.
 func (_u *AssetUpdateOne) ClearOwner() *AssetUpdateOne {
 	_u.mutation.ClearOwner()
@@ -1192,6 +1525,33 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(asset.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(asset.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(asset.FieldContentHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProcessingStatus(); ok {
+		_spec.SetField(asset.FieldProcessingStatus, field.TypeString, value)
+	}
+	if _u.mutation.ProcessingStatusCleared() {
+		_spec.ClearField(asset.FieldProcessingStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.Private(); ok {
+		_spec.SetField(asset.FieldPrivate, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(asset.FieldScanStatus, field.TypeString, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(asset.FieldScanStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScanSignature(); ok {
+		_spec.SetField(asset.FieldScanSignature, field.TypeString, value)
+	}
+	if _u.mutation.ScanSignatureCleared() {
+		_spec.ClearField(asset.FieldScanSignature, field.TypeString)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1327,6 +1687,51 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   asset.DirectMessagesTable,
+			Columns: asset.DirectMessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDirectMessagesIDs(); len(nodes) > 0 && !_u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   asset.DirectMessagesTable,
+			Columns: asset.DirectMessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   asset.DirectMessagesTable,
+			Columns: asset.DirectMessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
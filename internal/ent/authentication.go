@@ -50,9 +50,11 @@ type Authentication struct {
 type AuthenticationEdges struct {
 	// Account holds the value of the account edge.
 	Account *Account `json:"account,omitempty"`
+	// APIUsage holds the value of the api_usage edge.
+	APIUsage []*APIUsage `json:"api_usage,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // AccountOrErr returns the Account value or an error if the edge
@@ -66,6 +68,15 @@ func (e AuthenticationEdges) AccountOrErr() (*Account, error) {
 	return nil, &NotLoadedError{edge: "account"}
 }
 
+// APIUsageOrErr returns the APIUsage value or an error if the edge
+// was not loaded in eager-loading.
+func (e AuthenticationEdges) APIUsageOrErr() ([]*APIUsage, error) {
+	if e.loadedTypes[1] {
+		return e.APIUsage, nil
+	}
+	return nil, &NotLoadedError{edge: "api_usage"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Authentication) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -184,6 +195,11 @@ func (_m *Authentication) QueryAccount() *AccountQuery {
 	return NewAuthenticationClient(_m.config).QueryAccount(_m)
 }
 
+// QueryAPIUsage queries the "api_usage" edge of the Authentication entity.
+func (_m *Authentication) QueryAPIUsage() *APIUsageQuery {
+	return NewAuthenticationClient(_m.config).QueryAPIUsage(_m)
+}
+
 // Update returns a builder for updating this Authentication.
 // Note that you need to call Authentication.Unwrap() before calling this method if this Authentication
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -37,6 +37,8 @@ const (
 	FieldAccountAuthentication = "account_authentication"
 	// EdgeAccount holds the string denoting the account edge name in mutations.
 	EdgeAccount = "account"
+	// EdgeAPIUsage holds the string denoting the api_usage edge name in mutations.
+	EdgeAPIUsage = "api_usage"
 	// Table holds the table name of the authentication in the database.
 	Table = "authentications"
 	// AccountTable is the table that holds the account relation/edge.
@@ -46,6 +48,13 @@ const (
 	AccountInverseTable = "accounts"
 	// AccountColumn is the table column denoting the account relation/edge.
 	AccountColumn = "account_authentication"
+	// APIUsageTable is the table that holds the api_usage relation/edge.
+	APIUsageTable = "api_usages"
+	// APIUsageInverseTable is the table name for the APIUsage entity.
+	// It exists in this package in order to avoid circular dependency with the "apiusage" package.
+	APIUsageInverseTable = "api_usages"
+	// APIUsageColumn is the table column denoting the api_usage relation/edge.
+	APIUsageColumn = "access_key_id"
 )
 
 // Columns holds all SQL columns for authentication fields.
@@ -149,6 +158,20 @@ func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByAPIUsageCount orders the results by api_usage count.
+func ByAPIUsageCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPIUsageStep(), opts...)
+	}
+}
+
+// ByAPIUsage orders the results by api_usage terms.
+func ByAPIUsage(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIUsageStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAccountStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -156,3 +179,10 @@ func newAccountStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
 	)
 }
+func newAPIUsageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APIUsageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIUsageTable, APIUsageColumn),
+	)
+}
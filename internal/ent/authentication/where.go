@@ -639,6 +639,29 @@ func HasAccountWith(preds ...predicate.Account) predicate.Authentication {
 	})
 }
 
+// HasAPIUsage applies the HasEdge predicate on the "api_usage" edge.
+func HasAPIUsage() predicate.Authentication {
+	return predicate.Authentication(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIUsageTable, APIUsageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPIUsageWith applies the HasEdge predicate on the "api_usage" edge with a given conditions (other predicates).
+func HasAPIUsageWith(preds ...predicate.APIUsage) predicate.Authentication {
+	return predicate.Authentication(func(s *sql.Selector) {
+		step := newAPIUsageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Authentication) predicate.Authentication {
 	return predicate.Authentication(sql.AndPredicates(predicates...))
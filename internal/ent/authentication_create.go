@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/rs/xid"
 )
@@ -142,6 +143,21 @@ func (_c *AuthenticationCreate) SetAccount(v *Account) *AuthenticationCreate {
 	return _c.SetAccountID(v.ID)
 }
 
+// AddAPIUsageIDs adds the "api_usage" edge to the APIUsage entity by IDs.
+func (_c *AuthenticationCreate) AddAPIUsageIDs(ids ...xid.ID) *AuthenticationCreate {
+	_c.mutation.AddAPIUsageIDs(ids...)
+	return _c
+}
+
+// AddAPIUsage adds the "api_usage" edges to the APIUsage entity.
+func (_c *AuthenticationCreate) AddAPIUsage(v ...*APIUsage) *AuthenticationCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPIUsageIDs(ids...)
+}
+
 // Mutation returns the AuthenticationMutation object of the builder.
 func (_c *AuthenticationCreate) Mutation() *AuthenticationMutation {
 	return _c.mutation
@@ -326,6 +342,22 @@ func (_c *AuthenticationCreate) createSpec() (*Authentication, *sqlgraph.CreateS
 		_node.AccountAuthentication = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.APIUsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authentication.APIUsageTable,
+			Columns: []string{authentication.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
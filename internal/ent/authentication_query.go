@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/rs/xid"
@@ -20,12 +22,13 @@ import (
 // AuthenticationQuery is the builder for querying Authentication entities.
 type AuthenticationQuery struct {
 	config
-	ctx         *QueryContext
-	order       []authentication.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Authentication
-	withAccount *AccountQuery
-	modifiers   []func(*sql.Selector)
+	ctx          *QueryContext
+	order        []authentication.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Authentication
+	withAccount  *AccountQuery
+	withAPIUsage *APIUsageQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +87,28 @@ func (_q *AuthenticationQuery) QueryAccount() *AccountQuery {
 	return query
 }
 
+// QueryAPIUsage chains the current query on the "api_usage" edge.
+func (_q *AuthenticationQuery) QueryAPIUsage() *APIUsageQuery {
+	query := (&APIUsageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authentication.Table, authentication.FieldID, selector),
+			sqlgraph.To(apiusage.Table, apiusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, authentication.APIUsageTable, authentication.APIUsageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Authentication entity from the query.
 // Returns a *NotFoundError when no Authentication was found.
 func (_q *AuthenticationQuery) First(ctx context.Context) (*Authentication, error) {
@@ -271,12 +296,13 @@ func (_q *AuthenticationQuery) Clone() *AuthenticationQuery {
 		return nil
 	}
 	return &AuthenticationQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]authentication.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Authentication{}, _q.predicates...),
-		withAccount: _q.withAccount.Clone(),
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]authentication.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.Authentication{}, _q.predicates...),
+		withAccount:  _q.withAccount.Clone(),
+		withAPIUsage: _q.withAPIUsage.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -295,6 +321,17 @@ func (_q *AuthenticationQuery) WithAccount(opts ...func(*AccountQuery)) *Authent
 	return _q
 }
 
+// WithAPIUsage tells the query-builder to eager-load the nodes that are connected to
+// the "api_usage" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AuthenticationQuery) WithAPIUsage(opts ...func(*APIUsageQuery)) *AuthenticationQuery {
+	query := (&APIUsageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAPIUsage = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +410,9 @@ func (_q *AuthenticationQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*Authentication{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withAccount != nil,
+			_q.withAPIUsage != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -404,6 +442,13 @@ func (_q *AuthenticationQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := _q.withAPIUsage; query != nil {
+		if err := _q.loadAPIUsage(ctx, query, nodes,
+			func(n *Authentication) { n.Edges.APIUsage = []*APIUsage{} },
+			func(n *Authentication, e *APIUsage) { n.Edges.APIUsage = append(n.Edges.APIUsage, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -436,6 +481,39 @@ func (_q *AuthenticationQuery) loadAccount(ctx context.Context, query *AccountQu
 	}
 	return nil
 }
+func (_q *AuthenticationQuery) loadAPIUsage(ctx context.Context, query *APIUsageQuery, nodes []*Authentication, init func(*Authentication), assign func(*Authentication, *APIUsage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Authentication)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(apiusage.FieldAccessKeyID)
+	}
+	query.Where(predicate.APIUsage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(authentication.APIUsageColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccessKeyID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "access_key_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "access_key_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AuthenticationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
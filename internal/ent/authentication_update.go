@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/rs/xid"
@@ -178,6 +179,21 @@ func (_u *AuthenticationUpdate) SetAccount(v *Account) *AuthenticationUpdate {
 	return _u.SetAccountID(v.ID)
 }
 
+// AddAPIUsageIDs adds the "api_usage" edge to the APIUsage entity by IDs.
+func (_u *AuthenticationUpdate) AddAPIUsageIDs(ids ...xid.ID) *AuthenticationUpdate {
+	_u.mutation.AddAPIUsageIDs(ids...)
+	return _u
+}
+
+// AddAPIUsage adds the "api_usage" edges to the APIUsage entity.
+func (_u *AuthenticationUpdate) AddAPIUsage(v ...*APIUsage) *AuthenticationUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIUsageIDs(ids...)
+}
+
 // Mutation returns the AuthenticationMutation object of the builder.
 func (_u *AuthenticationUpdate) Mutation() *AuthenticationMutation {
 	return _u.mutation
@@ -189,6 +205,27 @@ func (_u *AuthenticationUpdate) ClearAccount() *AuthenticationUpdate {
 	return _u
 }
 
+// ClearAPIUsage clears all "api_usage" edges to the APIUsage entity.
+func (_u *AuthenticationUpdate) ClearAPIUsage() *AuthenticationUpdate {
+	_u.mutation.ClearAPIUsage()
+	return _u
+}
+
+// RemoveAPIUsageIDs removes the "api_usage" edge to APIUsage entities by IDs.
+func (_u *AuthenticationUpdate) RemoveAPIUsageIDs(ids ...xid.ID) *AuthenticationUpdate {
+	_u.mutation.RemoveAPIUsageIDs(ids...)
+	return _u
+}
+
+// RemoveAPIUsage removes "api_usage" edges to APIUsage entities.
+func (_u *AuthenticationUpdate) RemoveAPIUsage(v ...*APIUsage) *AuthenticationUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIUsageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AuthenticationUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -319,6 +356,51 @@ func (_u *AuthenticationUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.APIUsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authentication.APIUsageTable,
+			Columns: []string{authentication.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIUsageIDs(); len(nodes) > 0 && !_u.mutation.APIUsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authentication.APIUsageTable,
+			Columns: []string{authentication.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIUsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authentication.APIUsageTable,
+			Columns: []string{authentication.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -488,6 +570,21 @@ func (_u *AuthenticationUpdateOne) SetAccount(v *Account) *AuthenticationUpdateO
 	return _u.SetAccountID(v.ID)
 }
 
+// AddAPIUsageIDs adds the "api_usage" edge to the APIUsage entity by IDs.
+func (_u *AuthenticationUpdateOne) AddAPIUsageIDs(ids ...xid.ID) *AuthenticationUpdateOne {
+	_u.mutation.AddAPIUsageIDs(ids...)
+	return _u
+}
+
+// AddAPIUsage adds the "api_usage" edges to the APIUsage entity.
+func (_u *AuthenticationUpdateOne) AddAPIUsage(v ...*APIUsage) *AuthenticationUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIUsageIDs(ids...)
+}
+
 // Mutation returns the AuthenticationMutation object of the builder.
 func (_u *AuthenticationUpdateOne) Mutation() *AuthenticationMutation {
 	return _u.mutation
@@ -499,6 +596,27 @@ func (_u *AuthenticationUpdateOne) ClearAccount() *AuthenticationUpdateOne {
 	return _u
 }
 
+// ClearAPIUsage clears all "api_usage" edges to the APIUsage entity.
+func (_u *AuthenticationUpdateOne) ClearAPIUsage() *AuthenticationUpdateOne {
+	_u.mutation.ClearAPIUsage()
+	return _u
+}
+
+// RemoveAPIUsageIDs removes the "api_usage" edge to APIUsage entities by IDs.
+func (_u *AuthenticationUpdateOne) RemoveAPIUsageIDs(ids ...xid.ID) *AuthenticationUpdateOne {
+	_u.mutation.RemoveAPIUsageIDs(ids...)
+	return _u
+}
+
+// RemoveAPIUsage removes "api_usage" edges to APIUsage entities.
+func (_u *AuthenticationUpdateOne) RemoveAPIUsage(v ...*APIUsage) *AuthenticationUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIUsageIDs(ids...)
+}
+
 // Where appends a list predicates to the AuthenticationUpdate builder.
 func (_u *AuthenticationUpdateOne) Where(ps ...predicate.Authentication) *AuthenticationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -659,6 +777,51 @@ func (_u *AuthenticationUpdateOne) sqlSave(ctx context.Context) (_node *Authenti
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.APIUsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authentication.APIUsageTable,
+			Columns: []string{authentication.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIUsageIDs(); len(nodes) > 0 && !_u.mutation.APIUsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authentication.APIUsageTable,
+			Columns: []string{authentication.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIUsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authentication.APIUsageTable,
+			Columns: []string{authentication.APIUsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiusage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Authentication{config: _u.config}
 	_spec.Assign = _node.assignValues
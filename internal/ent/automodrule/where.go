@@ -0,0 +1,666 @@
+// Code generated by ent, DO NOT EDIT.
+
+package automodrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldName, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldKind, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldAction, v))
+}
+
+// Pattern applies equality check predicate on the "pattern" field. It's identical to PatternEQ.
+func Pattern(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldPattern, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldThreshold, v))
+}
+
+// WindowSeconds applies equality check predicate on the "window_seconds" field. It's identical to WindowSecondsEQ.
+func WindowSeconds(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldWindowSeconds, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldReason, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContainsFold(FieldAction, v))
+}
+
+// PatternEQ applies the EQ predicate on the "pattern" field.
+func PatternEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldPattern, v))
+}
+
+// PatternNEQ applies the NEQ predicate on the "pattern" field.
+func PatternNEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldPattern, v))
+}
+
+// PatternIn applies the In predicate on the "pattern" field.
+func PatternIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldPattern, vs...))
+}
+
+// PatternNotIn applies the NotIn predicate on the "pattern" field.
+func PatternNotIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldPattern, vs...))
+}
+
+// PatternGT applies the GT predicate on the "pattern" field.
+func PatternGT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldPattern, v))
+}
+
+// PatternGTE applies the GTE predicate on the "pattern" field.
+func PatternGTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldPattern, v))
+}
+
+// PatternLT applies the LT predicate on the "pattern" field.
+func PatternLT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldPattern, v))
+}
+
+// PatternLTE applies the LTE predicate on the "pattern" field.
+func PatternLTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldPattern, v))
+}
+
+// PatternContains applies the Contains predicate on the "pattern" field.
+func PatternContains(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContains(FieldPattern, v))
+}
+
+// PatternHasPrefix applies the HasPrefix predicate on the "pattern" field.
+func PatternHasPrefix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasPrefix(FieldPattern, v))
+}
+
+// PatternHasSuffix applies the HasSuffix predicate on the "pattern" field.
+func PatternHasSuffix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasSuffix(FieldPattern, v))
+}
+
+// PatternIsNil applies the IsNil predicate on the "pattern" field.
+func PatternIsNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIsNull(FieldPattern))
+}
+
+// PatternNotNil applies the NotNil predicate on the "pattern" field.
+func PatternNotNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotNull(FieldPattern))
+}
+
+// PatternEqualFold applies the EqualFold predicate on the "pattern" field.
+func PatternEqualFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEqualFold(FieldPattern, v))
+}
+
+// PatternContainsFold applies the ContainsFold predicate on the "pattern" field.
+func PatternContainsFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContainsFold(FieldPattern, v))
+}
+
+// WordsIsNil applies the IsNil predicate on the "words" field.
+func WordsIsNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIsNull(FieldWords))
+}
+
+// WordsNotNil applies the NotNil predicate on the "words" field.
+func WordsNotNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotNull(FieldWords))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldThreshold, v))
+}
+
+// ThresholdIsNil applies the IsNil predicate on the "threshold" field.
+func ThresholdIsNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIsNull(FieldThreshold))
+}
+
+// ThresholdNotNil applies the NotNil predicate on the "threshold" field.
+func ThresholdNotNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotNull(FieldThreshold))
+}
+
+// WindowSecondsEQ applies the EQ predicate on the "window_seconds" field.
+func WindowSecondsEQ(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldWindowSeconds, v))
+}
+
+// WindowSecondsNEQ applies the NEQ predicate on the "window_seconds" field.
+func WindowSecondsNEQ(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldWindowSeconds, v))
+}
+
+// WindowSecondsIn applies the In predicate on the "window_seconds" field.
+func WindowSecondsIn(vs ...int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldWindowSeconds, vs...))
+}
+
+// WindowSecondsNotIn applies the NotIn predicate on the "window_seconds" field.
+func WindowSecondsNotIn(vs ...int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldWindowSeconds, vs...))
+}
+
+// WindowSecondsGT applies the GT predicate on the "window_seconds" field.
+func WindowSecondsGT(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldWindowSeconds, v))
+}
+
+// WindowSecondsGTE applies the GTE predicate on the "window_seconds" field.
+func WindowSecondsGTE(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldWindowSeconds, v))
+}
+
+// WindowSecondsLT applies the LT predicate on the "window_seconds" field.
+func WindowSecondsLT(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldWindowSeconds, v))
+}
+
+// WindowSecondsLTE applies the LTE predicate on the "window_seconds" field.
+func WindowSecondsLTE(v int) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldWindowSeconds, v))
+}
+
+// WindowSecondsIsNil applies the IsNil predicate on the "window_seconds" field.
+func WindowSecondsIsNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIsNull(FieldWindowSeconds))
+}
+
+// WindowSecondsNotNil applies the NotNil predicate on the "window_seconds" field.
+func WindowSecondsNotNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotNull(FieldWindowSeconds))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldContainsFold(FieldReason, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AutomodRule) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AutomodRule) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AutomodRule) predicate.AutomodRule {
+	return predicate.AutomodRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/automodrule"
+	"github.com/rs/xid"
+)
+
+// AutomodRuleCreate is the builder for creating a AutomodRule entity.
+type AutomodRuleCreate struct {
+	config
+	mutation *AutomodRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AutomodRuleCreate) SetCreatedAt(v time.Time) *AutomodRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillableCreatedAt(v *time.Time) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AutomodRuleCreate) SetUpdatedAt(v time.Time) *AutomodRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillableUpdatedAt(v *time.Time) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AutomodRuleCreate) SetName(v string) *AutomodRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *AutomodRuleCreate) SetKind(v string) *AutomodRuleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *AutomodRuleCreate) SetAction(v string) *AutomodRuleCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetPattern sets the "pattern" field.
+func (_c *AutomodRuleCreate) SetPattern(v string) *AutomodRuleCreate {
+	_c.mutation.SetPattern(v)
+	return _c
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillablePattern(v *string) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetPattern(*v)
+	}
+	return _c
+}
+
+// SetWords sets the "words" field.
+func (_c *AutomodRuleCreate) SetWords(v []string) *AutomodRuleCreate {
+	_c.mutation.SetWords(v)
+	return _c
+}
+
+// SetThreshold sets the "threshold" field.
+func (_c *AutomodRuleCreate) SetThreshold(v int) *AutomodRuleCreate {
+	_c.mutation.SetThreshold(v)
+	return _c
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillableThreshold(v *int) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetThreshold(*v)
+	}
+	return _c
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (_c *AutomodRuleCreate) SetWindowSeconds(v int) *AutomodRuleCreate {
+	_c.mutation.SetWindowSeconds(v)
+	return _c
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillableWindowSeconds(v *int) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetWindowSeconds(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AutomodRuleCreate) SetReason(v string) *AutomodRuleCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillableReason(v *string) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *AutomodRuleCreate) SetEnabled(v bool) *AutomodRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillableEnabled(v *bool) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AutomodRuleCreate) SetID(v xid.ID) *AutomodRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AutomodRuleCreate) SetNillableID(v *xid.ID) *AutomodRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AutomodRuleMutation object of the builder.
+func (_c *AutomodRuleCreate) Mutation() *AutomodRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the AutomodRule in the database.
+func (_c *AutomodRuleCreate) Save(ctx context.Context) (*AutomodRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AutomodRuleCreate) SaveX(ctx context.Context) *AutomodRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutomodRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutomodRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AutomodRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := automodrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := automodrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := automodrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := automodrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AutomodRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AutomodRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AutomodRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AutomodRule.name"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "AutomodRule.kind"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AutomodRule.action"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AutomodRule.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := automodrule.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AutomodRule.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AutomodRuleCreate) sqlSave(ctx context.Context) (*AutomodRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AutomodRuleCreate) createSpec() (*AutomodRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AutomodRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(automodrule.Table, sqlgraph.NewFieldSpec(automodrule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(automodrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(automodrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(automodrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(automodrule.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(automodrule.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Pattern(); ok {
+		_spec.SetField(automodrule.FieldPattern, field.TypeString, value)
+		_node.Pattern = &value
+	}
+	if value, ok := _c.mutation.Words(); ok {
+		_spec.SetField(automodrule.FieldWords, field.TypeJSON, value)
+		_node.Words = value
+	}
+	if value, ok := _c.mutation.Threshold(); ok {
+		_spec.SetField(automodrule.FieldThreshold, field.TypeInt, value)
+		_node.Threshold = &value
+	}
+	if value, ok := _c.mutation.WindowSeconds(); ok {
+		_spec.SetField(automodrule.FieldWindowSeconds, field.TypeInt, value)
+		_node.WindowSeconds = &value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(automodrule.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(automodrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AutomodRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AutomodRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AutomodRuleCreate) OnConflict(opts ...sql.ConflictOption) *AutomodRuleUpsertOne {
+	_c.conflict = opts
+	return &AutomodRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AutomodRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AutomodRuleCreate) OnConflictColumns(columns ...string) *AutomodRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AutomodRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AutomodRuleUpsertOne is the builder for "upsert"-ing
+	//  one AutomodRule node.
+	AutomodRuleUpsertOne struct {
+		create *AutomodRuleCreate
+	}
+
+	// AutomodRuleUpsert is the "OnConflict" setter.
+	AutomodRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AutomodRuleUpsert) SetUpdatedAt(v time.Time) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateUpdatedAt() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AutomodRuleUpsert) SetName(v string) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateName() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldName)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *AutomodRuleUpsert) SetKind(v string) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateKind() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldKind)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *AutomodRuleUpsert) SetAction(v string) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateAction() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldAction)
+	return u
+}
+
+// SetPattern sets the "pattern" field.
+func (u *AutomodRuleUpsert) SetPattern(v string) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldPattern, v)
+	return u
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdatePattern() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldPattern)
+	return u
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *AutomodRuleUpsert) ClearPattern() *AutomodRuleUpsert {
+	u.SetNull(automodrule.FieldPattern)
+	return u
+}
+
+// SetWords sets the "words" field.
+func (u *AutomodRuleUpsert) SetWords(v []string) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldWords, v)
+	return u
+}
+
+// UpdateWords sets the "words" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateWords() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldWords)
+	return u
+}
+
+// ClearWords clears the value of the "words" field.
+func (u *AutomodRuleUpsert) ClearWords() *AutomodRuleUpsert {
+	u.SetNull(automodrule.FieldWords)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *AutomodRuleUpsert) SetThreshold(v int) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateThreshold() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldThreshold)
+	return u
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *AutomodRuleUpsert) AddThreshold(v int) *AutomodRuleUpsert {
+	u.Add(automodrule.FieldThreshold, v)
+	return u
+}
+
+// ClearThreshold clears the value of the "threshold" field.
+func (u *AutomodRuleUpsert) ClearThreshold() *AutomodRuleUpsert {
+	u.SetNull(automodrule.FieldThreshold)
+	return u
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (u *AutomodRuleUpsert) SetWindowSeconds(v int) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldWindowSeconds, v)
+	return u
+}
+
+// UpdateWindowSeconds sets the "window_seconds" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateWindowSeconds() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldWindowSeconds)
+	return u
+}
+
+// AddWindowSeconds adds v to the "window_seconds" field.
+func (u *AutomodRuleUpsert) AddWindowSeconds(v int) *AutomodRuleUpsert {
+	u.Add(automodrule.FieldWindowSeconds, v)
+	return u
+}
+
+// ClearWindowSeconds clears the value of the "window_seconds" field.
+func (u *AutomodRuleUpsert) ClearWindowSeconds() *AutomodRuleUpsert {
+	u.SetNull(automodrule.FieldWindowSeconds)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *AutomodRuleUpsert) SetReason(v string) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateReason() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *AutomodRuleUpsert) ClearReason() *AutomodRuleUpsert {
+	u.SetNull(automodrule.FieldReason)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AutomodRuleUpsert) SetEnabled(v bool) *AutomodRuleUpsert {
+	u.Set(automodrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AutomodRuleUpsert) UpdateEnabled() *AutomodRuleUpsert {
+	u.SetExcluded(automodrule.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AutomodRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(automodrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AutomodRuleUpsertOne) UpdateNewValues() *AutomodRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(automodrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(automodrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AutomodRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AutomodRuleUpsertOne) Ignore() *AutomodRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AutomodRuleUpsertOne) DoNothing() *AutomodRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AutomodRuleCreate.OnConflict
+// documentation for more info.
+func (u *AutomodRuleUpsertOne) Update(set func(*AutomodRuleUpsert)) *AutomodRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AutomodRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AutomodRuleUpsertOne) SetUpdatedAt(v time.Time) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateUpdatedAt() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AutomodRuleUpsertOne) SetName(v string) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateName() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AutomodRuleUpsertOne) SetKind(v string) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateKind() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AutomodRuleUpsertOne) SetAction(v string) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateAction() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *AutomodRuleUpsertOne) SetPattern(v string) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdatePattern() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *AutomodRuleUpsertOne) ClearPattern() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetWords sets the "words" field.
+func (u *AutomodRuleUpsertOne) SetWords(v []string) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetWords(v)
+	})
+}
+
+// UpdateWords sets the "words" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateWords() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateWords()
+	})
+}
+
+// ClearWords clears the value of the "words" field.
+func (u *AutomodRuleUpsertOne) ClearWords() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearWords()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *AutomodRuleUpsertOne) SetThreshold(v int) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *AutomodRuleUpsertOne) AddThreshold(v int) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateThreshold() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// ClearThreshold clears the value of the "threshold" field.
+func (u *AutomodRuleUpsertOne) ClearThreshold() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearThreshold()
+	})
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (u *AutomodRuleUpsertOne) SetWindowSeconds(v int) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetWindowSeconds(v)
+	})
+}
+
+// AddWindowSeconds adds v to the "window_seconds" field.
+func (u *AutomodRuleUpsertOne) AddWindowSeconds(v int) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.AddWindowSeconds(v)
+	})
+}
+
+// UpdateWindowSeconds sets the "window_seconds" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateWindowSeconds() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateWindowSeconds()
+	})
+}
+
+// ClearWindowSeconds clears the value of the "window_seconds" field.
+func (u *AutomodRuleUpsertOne) ClearWindowSeconds() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearWindowSeconds()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *AutomodRuleUpsertOne) SetReason(v string) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateReason() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *AutomodRuleUpsertOne) ClearReason() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AutomodRuleUpsertOne) SetEnabled(v bool) *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AutomodRuleUpsertOne) UpdateEnabled() *AutomodRuleUpsertOne {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *AutomodRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AutomodRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AutomodRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AutomodRuleUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AutomodRuleUpsertOne.ID is not supported by MySQL driver. Use AutomodRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AutomodRuleUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AutomodRuleCreateBulk is the builder for creating many AutomodRule entities in bulk.
+type AutomodRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AutomodRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AutomodRule entities in the database.
+func (_c *AutomodRuleCreateBulk) Save(ctx context.Context) ([]*AutomodRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AutomodRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AutomodRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AutomodRuleCreateBulk) SaveX(ctx context.Context) []*AutomodRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutomodRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutomodRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AutomodRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AutomodRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AutomodRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *AutomodRuleUpsertBulk {
+	_c.conflict = opts
+	return &AutomodRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AutomodRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AutomodRuleCreateBulk) OnConflictColumns(columns ...string) *AutomodRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AutomodRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// AutomodRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of AutomodRule nodes.
+type AutomodRuleUpsertBulk struct {
+	create *AutomodRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AutomodRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(automodrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AutomodRuleUpsertBulk) UpdateNewValues() *AutomodRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(automodrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(automodrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AutomodRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AutomodRuleUpsertBulk) Ignore() *AutomodRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AutomodRuleUpsertBulk) DoNothing() *AutomodRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AutomodRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *AutomodRuleUpsertBulk) Update(set func(*AutomodRuleUpsert)) *AutomodRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AutomodRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AutomodRuleUpsertBulk) SetUpdatedAt(v time.Time) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateUpdatedAt() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AutomodRuleUpsertBulk) SetName(v string) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateName() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AutomodRuleUpsertBulk) SetKind(v string) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateKind() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AutomodRuleUpsertBulk) SetAction(v string) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateAction() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *AutomodRuleUpsertBulk) SetPattern(v string) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdatePattern() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *AutomodRuleUpsertBulk) ClearPattern() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetWords sets the "words" field.
+func (u *AutomodRuleUpsertBulk) SetWords(v []string) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetWords(v)
+	})
+}
+
+// UpdateWords sets the "words" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateWords() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateWords()
+	})
+}
+
+// ClearWords clears the value of the "words" field.
+func (u *AutomodRuleUpsertBulk) ClearWords() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearWords()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *AutomodRuleUpsertBulk) SetThreshold(v int) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *AutomodRuleUpsertBulk) AddThreshold(v int) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateThreshold() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// ClearThreshold clears the value of the "threshold" field.
+func (u *AutomodRuleUpsertBulk) ClearThreshold() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearThreshold()
+	})
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (u *AutomodRuleUpsertBulk) SetWindowSeconds(v int) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetWindowSeconds(v)
+	})
+}
+
+// AddWindowSeconds adds v to the "window_seconds" field.
+func (u *AutomodRuleUpsertBulk) AddWindowSeconds(v int) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.AddWindowSeconds(v)
+	})
+}
+
+// UpdateWindowSeconds sets the "window_seconds" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateWindowSeconds() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateWindowSeconds()
+	})
+}
+
+// ClearWindowSeconds clears the value of the "window_seconds" field.
+func (u *AutomodRuleUpsertBulk) ClearWindowSeconds() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearWindowSeconds()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *AutomodRuleUpsertBulk) SetReason(v string) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateReason() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *AutomodRuleUpsertBulk) ClearReason() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AutomodRuleUpsertBulk) SetEnabled(v bool) *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AutomodRuleUpsertBulk) UpdateEnabled() *AutomodRuleUpsertBulk {
+	return u.Update(func(s *AutomodRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *AutomodRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AutomodRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AutomodRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AutomodRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/automodrule"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// AutomodRuleUpdate is the builder for updating AutomodRule entities.
+type AutomodRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AutomodRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AutomodRuleUpdate builder.
+func (_u *AutomodRuleUpdate) Where(ps ...predicate.AutomodRule) *AutomodRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AutomodRuleUpdate) SetUpdatedAt(v time.Time) *AutomodRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AutomodRuleUpdate) SetName(v string) *AutomodRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillableName(v *string) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AutomodRuleUpdate) SetKind(v string) *AutomodRuleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillableKind(v *string) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AutomodRuleUpdate) SetAction(v string) *AutomodRuleUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillableAction(v *string) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *AutomodRuleUpdate) SetPattern(v string) *AutomodRuleUpdate {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillablePattern(v *string) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (_u *AutomodRuleUpdate) ClearPattern() *AutomodRuleUpdate {
+	_u.mutation.ClearPattern()
+	return _u
+}
+
+// SetWords sets the "words" field.
+func (_u *AutomodRuleUpdate) SetWords(v []string) *AutomodRuleUpdate {
+	_u.mutation.SetWords(v)
+	return _u
+}
+
+// AppendWords appends value to the "words" field.
+func (_u *AutomodRuleUpdate) AppendWords(v []string) *AutomodRuleUpdate {
+	_u.mutation.AppendWords(v)
+	return _u
+}
+
+// ClearWords clears the value of the "words" field.
+func (_u *AutomodRuleUpdate) ClearWords() *AutomodRuleUpdate {
+	_u.mutation.ClearWords()
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *AutomodRuleUpdate) SetThreshold(v int) *AutomodRuleUpdate {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillableThreshold(v *int) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *AutomodRuleUpdate) AddThreshold(v int) *AutomodRuleUpdate {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// ClearThreshold clears the value of the "threshold" field.
+func (_u *AutomodRuleUpdate) ClearThreshold() *AutomodRuleUpdate {
+	_u.mutation.ClearThreshold()
+	return _u
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (_u *AutomodRuleUpdate) SetWindowSeconds(v int) *AutomodRuleUpdate {
+	_u.mutation.ResetWindowSeconds()
+	_u.mutation.SetWindowSeconds(v)
+	return _u
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillableWindowSeconds(v *int) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetWindowSeconds(*v)
+	}
+	return _u
+}
+
+// AddWindowSeconds adds value to the "window_seconds" field.
+func (_u *AutomodRuleUpdate) AddWindowSeconds(v int) *AutomodRuleUpdate {
+	_u.mutation.AddWindowSeconds(v)
+	return _u
+}
+
+// ClearWindowSeconds clears the value of the "window_seconds" field.
+func (_u *AutomodRuleUpdate) ClearWindowSeconds() *AutomodRuleUpdate {
+	_u.mutation.ClearWindowSeconds()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AutomodRuleUpdate) SetReason(v string) *AutomodRuleUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillableReason(v *string) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AutomodRuleUpdate) ClearReason() *AutomodRuleUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AutomodRuleUpdate) SetEnabled(v bool) *AutomodRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AutomodRuleUpdate) SetNillableEnabled(v *bool) *AutomodRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AutomodRuleMutation object of the builder.
+func (_u *AutomodRuleUpdate) Mutation() *AutomodRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AutomodRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutomodRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AutomodRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutomodRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AutomodRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := automodrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AutomodRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AutomodRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AutomodRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(automodrule.Table, automodrule.Columns, sqlgraph.NewFieldSpec(automodrule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(automodrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(automodrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(automodrule.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(automodrule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(automodrule.FieldPattern, field.TypeString, value)
+	}
+	if _u.mutation.PatternCleared() {
+		_spec.ClearField(automodrule.FieldPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.Words(); ok {
+		_spec.SetField(automodrule.FieldWords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automodrule.FieldWords, value)
+		})
+	}
+	if _u.mutation.WordsCleared() {
+		_spec.ClearField(automodrule.FieldWords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(automodrule.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(automodrule.FieldThreshold, field.TypeInt, value)
+	}
+	if _u.mutation.ThresholdCleared() {
+		_spec.ClearField(automodrule.FieldThreshold, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WindowSeconds(); ok {
+		_spec.SetField(automodrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWindowSeconds(); ok {
+		_spec.AddField(automodrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.WindowSecondsCleared() {
+		_spec.ClearField(automodrule.FieldWindowSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(automodrule.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(automodrule.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(automodrule.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automodrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AutomodRuleUpdateOne is the builder for updating a single AutomodRule entity.
+type AutomodRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AutomodRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AutomodRuleUpdateOne) SetUpdatedAt(v time.Time) *AutomodRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AutomodRuleUpdateOne) SetName(v string) *AutomodRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillableName(v *string) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AutomodRuleUpdateOne) SetKind(v string) *AutomodRuleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillableKind(v *string) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AutomodRuleUpdateOne) SetAction(v string) *AutomodRuleUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillableAction(v *string) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *AutomodRuleUpdateOne) SetPattern(v string) *AutomodRuleUpdateOne {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillablePattern(v *string) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (_u *AutomodRuleUpdateOne) ClearPattern() *AutomodRuleUpdateOne {
+	_u.mutation.ClearPattern()
+	return _u
+}
+
+// SetWords sets the "words" field.
+func (_u *AutomodRuleUpdateOne) SetWords(v []string) *AutomodRuleUpdateOne {
+	_u.mutation.SetWords(v)
+	return _u
+}
+
+// AppendWords appends value to the "words" field.
+func (_u *AutomodRuleUpdateOne) AppendWords(v []string) *AutomodRuleUpdateOne {
+	_u.mutation.AppendWords(v)
+	return _u
+}
+
+// ClearWords clears the value of the "words" field.
+func (_u *AutomodRuleUpdateOne) ClearWords() *AutomodRuleUpdateOne {
+	_u.mutation.ClearWords()
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *AutomodRuleUpdateOne) SetThreshold(v int) *AutomodRuleUpdateOne {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillableThreshold(v *int) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *AutomodRuleUpdateOne) AddThreshold(v int) *AutomodRuleUpdateOne {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// ClearThreshold clears the value of the "threshold" field.
+func (_u *AutomodRuleUpdateOne) ClearThreshold() *AutomodRuleUpdateOne {
+	_u.mutation.ClearThreshold()
+	return _u
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (_u *AutomodRuleUpdateOne) SetWindowSeconds(v int) *AutomodRuleUpdateOne {
+	_u.mutation.ResetWindowSeconds()
+	_u.mutation.SetWindowSeconds(v)
+	return _u
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillableWindowSeconds(v *int) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetWindowSeconds(*v)
+	}
+	return _u
+}
+
+// AddWindowSeconds adds value to the "window_seconds" field.
+func (_u *AutomodRuleUpdateOne) AddWindowSeconds(v int) *AutomodRuleUpdateOne {
+	_u.mutation.AddWindowSeconds(v)
+	return _u
+}
+
+// ClearWindowSeconds clears the value of the "window_seconds" field.
+func (_u *AutomodRuleUpdateOne) ClearWindowSeconds() *AutomodRuleUpdateOne {
+	_u.mutation.ClearWindowSeconds()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AutomodRuleUpdateOne) SetReason(v string) *AutomodRuleUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillableReason(v *string) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AutomodRuleUpdateOne) ClearReason() *AutomodRuleUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AutomodRuleUpdateOne) SetEnabled(v bool) *AutomodRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AutomodRuleUpdateOne) SetNillableEnabled(v *bool) *AutomodRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AutomodRuleMutation object of the builder.
+func (_u *AutomodRuleUpdateOne) Mutation() *AutomodRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AutomodRuleUpdate builder.
+func (_u *AutomodRuleUpdateOne) Where(ps ...predicate.AutomodRule) *AutomodRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AutomodRuleUpdateOne) Select(field string, fields ...string) *AutomodRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AutomodRule entity.
+func (_u *AutomodRuleUpdateOne) Save(ctx context.Context) (*AutomodRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutomodRuleUpdateOne) SaveX(ctx context.Context) *AutomodRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AutomodRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutomodRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AutomodRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := automodrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AutomodRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AutomodRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AutomodRuleUpdateOne) sqlSave(ctx context.Context) (_node *AutomodRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(automodrule.Table, automodrule.Columns, sqlgraph.NewFieldSpec(automodrule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AutomodRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, automodrule.FieldID)
+		for _, f := range fields {
+			if !automodrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != automodrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(automodrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(automodrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(automodrule.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(automodrule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(automodrule.FieldPattern, field.TypeString, value)
+	}
+	if _u.mutation.PatternCleared() {
+		_spec.ClearField(automodrule.FieldPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.Words(); ok {
+		_spec.SetField(automodrule.FieldWords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automodrule.FieldWords, value)
+		})
+	}
+	if _u.mutation.WordsCleared() {
+		_spec.ClearField(automodrule.FieldWords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(automodrule.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(automodrule.FieldThreshold, field.TypeInt, value)
+	}
+	if _u.mutation.ThresholdCleared() {
+		_spec.ClearField(automodrule.FieldThreshold, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WindowSeconds(); ok {
+		_spec.SetField(automodrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWindowSeconds(); ok {
+		_spec.AddField(automodrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.WindowSecondsCleared() {
+		_spec.ClearField(automodrule.FieldWindowSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(automodrule.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(automodrule.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(automodrule.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AutomodRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automodrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/rs/xid"
+)
+
+// Badge is the model entity for the Badge schema.
+type Badge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Icon holds the value of the "icon" field.
+	Icon *string `json:"icon,omitempty"`
+	// The kind of activity which is counted towards the badge, unset badges are only awarded manually.
+	Trigger *string `json:"trigger,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold int `json:"threshold,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BadgeQuery when eager-loading is set.
+	Edges        BadgeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BadgeEdges holds the relations/edges for other nodes in the graph.
+type BadgeEdges struct {
+	// Awards holds the value of the awards edge.
+	Awards []*AccountBadge `json:"awards,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AwardsOrErr returns the Awards value or an error if the edge
+// was not loaded in eager-loading.
+func (e BadgeEdges) AwardsOrErr() ([]*AccountBadge, error) {
+	if e.loadedTypes[0] {
+		return e.Awards, nil
+	}
+	return nil, &NotLoadedError{edge: "awards"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Badge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case badge.FieldThreshold:
+			values[i] = new(sql.NullInt64)
+		case badge.FieldName, badge.FieldDescription, badge.FieldIcon, badge.FieldTrigger:
+			values[i] = new(sql.NullString)
+		case badge.FieldCreatedAt, badge.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case badge.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Badge fields.
+func (_m *Badge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case badge.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case badge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case badge.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case badge.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case badge.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case badge.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = new(string)
+				*_m.Icon = value.String
+			}
+		case badge.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = new(string)
+				*_m.Trigger = value.String
+			}
+		case badge.FieldThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				_m.Threshold = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Badge.
+// This includes values selected through modifiers, order, etc.
+func (_m *Badge) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAwards queries the "awards" edge of the Badge entity.
+func (_m *Badge) QueryAwards() *AccountBadgeQuery {
+	return NewBadgeClient(_m.config).QueryAwards(_m)
+}
+
+// Update returns a builder for updating this Badge.
+// Note that you need to call Badge.Unwrap() before calling this method if this Badge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Badge) Update() *BadgeUpdateOne {
+	return NewBadgeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Badge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Badge) Unwrap() *Badge {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Badge is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Badge) String() string {
+	var builder strings.Builder
+	builder.WriteString("Badge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	if v := _m.Icon; v != nil {
+		builder.WriteString("icon=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Trigger; v != nil {
+		builder.WriteString("trigger=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Threshold))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Badges is a parsable slice of Badge.
+type Badges []*Badge
@@ -0,0 +1,936 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/rs/xid"
+)
+
+// BadgeCreate is the builder for creating a Badge entity.
+type BadgeCreate struct {
+	config
+	mutation *BadgeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BadgeCreate) SetCreatedAt(v time.Time) *BadgeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableCreatedAt(v *time.Time) *BadgeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BadgeCreate) SetUpdatedAt(v time.Time) *BadgeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableUpdatedAt(v *time.Time) *BadgeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BadgeCreate) SetName(v string) *BadgeCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BadgeCreate) SetDescription(v string) *BadgeCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetIcon sets the "icon" field.
+func (_c *BadgeCreate) SetIcon(v string) *BadgeCreate {
+	_c.mutation.SetIcon(v)
+	return _c
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableIcon(v *string) *BadgeCreate {
+	if v != nil {
+		_c.SetIcon(*v)
+	}
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *BadgeCreate) SetTrigger(v string) *BadgeCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableTrigger(v *string) *BadgeCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetThreshold sets the "threshold" field.
+func (_c *BadgeCreate) SetThreshold(v int) *BadgeCreate {
+	_c.mutation.SetThreshold(v)
+	return _c
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableThreshold(v *int) *BadgeCreate {
+	if v != nil {
+		_c.SetThreshold(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BadgeCreate) SetID(v xid.ID) *BadgeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableID(v *xid.ID) *BadgeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddAwardIDs adds the "awards" edge to the AccountBadge entity by IDs.
+func (_c *BadgeCreate) AddAwardIDs(ids ...xid.ID) *BadgeCreate {
+	_c.mutation.AddAwardIDs(ids...)
+	return _c
+}
+
+// AddAwards adds the "awards" edges to the AccountBadge entity.
+func (_c *BadgeCreate) AddAwards(v ...*AccountBadge) *BadgeCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAwardIDs(ids...)
+}
+
+// Mutation returns the BadgeMutation object of the builder.
+func (_c *BadgeCreate) Mutation() *BadgeMutation {
+	return _c.mutation
+}
+
+// Save creates the Badge in the database.
+func (_c *BadgeCreate) Save(ctx context.Context) (*Badge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BadgeCreate) SaveX(ctx context.Context) *Badge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BadgeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BadgeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BadgeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := badge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := badge.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		v := badge.DefaultThreshold
+		_c.mutation.SetThreshold(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := badge.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BadgeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Badge.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Badge.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Badge.name"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Badge.description"`)}
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "Badge.threshold"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := badge.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Badge.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BadgeCreate) sqlSave(ctx context.Context) (*Badge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BadgeCreate) createSpec() (*Badge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Badge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(badge.Table, sqlgraph.NewFieldSpec(badge.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(badge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(badge.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(badge.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(badge.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Icon(); ok {
+		_spec.SetField(badge.FieldIcon, field.TypeString, value)
+		_node.Icon = &value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(badge.FieldTrigger, field.TypeString, value)
+		_node.Trigger = &value
+	}
+	if value, ok := _c.mutation.Threshold(); ok {
+		_spec.SetField(badge.FieldThreshold, field.TypeInt, value)
+		_node.Threshold = value
+	}
+	if nodes := _c.mutation.AwardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   badge.AwardsTable,
+			Columns: []string{badge.AwardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountbadge.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Badge.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BadgeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BadgeCreate) OnConflict(opts ...sql.ConflictOption) *BadgeUpsertOne {
+	_c.conflict = opts
+	return &BadgeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Badge.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BadgeCreate) OnConflictColumns(columns ...string) *BadgeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BadgeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BadgeUpsertOne is the builder for "upsert"-ing
+	//  one Badge node.
+	BadgeUpsertOne struct {
+		create *BadgeCreate
+	}
+
+	// BadgeUpsert is the "OnConflict" setter.
+	BadgeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BadgeUpsert) SetUpdatedAt(v time.Time) *BadgeUpsert {
+	u.Set(badge.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BadgeUpsert) UpdateUpdatedAt() *BadgeUpsert {
+	u.SetExcluded(badge.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BadgeUpsert) SetName(v string) *BadgeUpsert {
+	u.Set(badge.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BadgeUpsert) UpdateName() *BadgeUpsert {
+	u.SetExcluded(badge.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *BadgeUpsert) SetDescription(v string) *BadgeUpsert {
+	u.Set(badge.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BadgeUpsert) UpdateDescription() *BadgeUpsert {
+	u.SetExcluded(badge.FieldDescription)
+	return u
+}
+
+// SetIcon sets the "icon" field.
+func (u *BadgeUpsert) SetIcon(v string) *BadgeUpsert {
+	u.Set(badge.FieldIcon, v)
+	return u
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *BadgeUpsert) UpdateIcon() *BadgeUpsert {
+	u.SetExcluded(badge.FieldIcon)
+	return u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (u *BadgeUpsert) ClearIcon() *BadgeUpsert {
+	u.SetNull(badge.FieldIcon)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *BadgeUpsert) SetTrigger(v string) *BadgeUpsert {
+	u.Set(badge.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *BadgeUpsert) UpdateTrigger() *BadgeUpsert {
+	u.SetExcluded(badge.FieldTrigger)
+	return u
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (u *BadgeUpsert) ClearTrigger() *BadgeUpsert {
+	u.SetNull(badge.FieldTrigger)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BadgeUpsert) SetThreshold(v int) *BadgeUpsert {
+	u.Set(badge.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BadgeUpsert) UpdateThreshold() *BadgeUpsert {
+	u.SetExcluded(badge.FieldThreshold)
+	return u
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BadgeUpsert) AddThreshold(v int) *BadgeUpsert {
+	u.Add(badge.FieldThreshold, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Badge.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(badge.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BadgeUpsertOne) UpdateNewValues() *BadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(badge.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(badge.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Badge.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BadgeUpsertOne) Ignore() *BadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BadgeUpsertOne) DoNothing() *BadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BadgeCreate.OnConflict
+// documentation for more info.
+func (u *BadgeUpsertOne) Update(set func(*BadgeUpsert)) *BadgeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BadgeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BadgeUpsertOne) SetUpdatedAt(v time.Time) *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BadgeUpsertOne) UpdateUpdatedAt() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BadgeUpsertOne) SetName(v string) *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BadgeUpsertOne) UpdateName() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *BadgeUpsertOne) SetDescription(v string) *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BadgeUpsertOne) UpdateDescription() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetIcon sets the "icon" field.
+func (u *BadgeUpsertOne) SetIcon(v string) *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetIcon(v)
+	})
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *BadgeUpsertOne) UpdateIcon() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateIcon()
+	})
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (u *BadgeUpsertOne) ClearIcon() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.ClearIcon()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *BadgeUpsertOne) SetTrigger(v string) *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *BadgeUpsertOne) UpdateTrigger() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (u *BadgeUpsertOne) ClearTrigger() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.ClearTrigger()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BadgeUpsertOne) SetThreshold(v int) *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BadgeUpsertOne) AddThreshold(v int) *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BadgeUpsertOne) UpdateThreshold() *BadgeUpsertOne {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *BadgeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BadgeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BadgeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BadgeUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BadgeUpsertOne.ID is not supported by MySQL driver. Use BadgeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BadgeUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BadgeCreateBulk is the builder for creating many Badge entities in bulk.
+type BadgeCreateBulk struct {
+	config
+	err      error
+	builders []*BadgeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Badge entities in the database.
+func (_c *BadgeCreateBulk) Save(ctx context.Context) ([]*Badge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Badge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BadgeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BadgeCreateBulk) SaveX(ctx context.Context) []*Badge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BadgeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BadgeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Badge.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BadgeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BadgeCreateBulk) OnConflict(opts ...sql.ConflictOption) *BadgeUpsertBulk {
+	_c.conflict = opts
+	return &BadgeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Badge.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BadgeCreateBulk) OnConflictColumns(columns ...string) *BadgeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BadgeUpsertBulk{
+		create: _c,
+	}
+}
+
+// BadgeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Badge nodes.
+type BadgeUpsertBulk struct {
+	create *BadgeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Badge.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(badge.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BadgeUpsertBulk) UpdateNewValues() *BadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(badge.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(badge.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Badge.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BadgeUpsertBulk) Ignore() *BadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BadgeUpsertBulk) DoNothing() *BadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BadgeCreateBulk.OnConflict
+// documentation for more info.
+func (u *BadgeUpsertBulk) Update(set func(*BadgeUpsert)) *BadgeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BadgeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BadgeUpsertBulk) SetUpdatedAt(v time.Time) *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BadgeUpsertBulk) UpdateUpdatedAt() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BadgeUpsertBulk) SetName(v string) *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BadgeUpsertBulk) UpdateName() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *BadgeUpsertBulk) SetDescription(v string) *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BadgeUpsertBulk) UpdateDescription() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetIcon sets the "icon" field.
+func (u *BadgeUpsertBulk) SetIcon(v string) *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetIcon(v)
+	})
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *BadgeUpsertBulk) UpdateIcon() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateIcon()
+	})
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (u *BadgeUpsertBulk) ClearIcon() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.ClearIcon()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *BadgeUpsertBulk) SetTrigger(v string) *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *BadgeUpsertBulk) UpdateTrigger() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (u *BadgeUpsertBulk) ClearTrigger() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.ClearTrigger()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BadgeUpsertBulk) SetThreshold(v int) *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BadgeUpsertBulk) AddThreshold(v int) *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BadgeUpsertBulk) UpdateThreshold() *BadgeUpsertBulk {
+	return u.Update(func(s *BadgeUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *BadgeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BadgeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BadgeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BadgeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
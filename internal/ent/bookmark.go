@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
+	"github.com/rs/xid"
+)
+
+// Bookmark is the model entity for the Bookmark schema.
+type Bookmark struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// The ID of the bookmarked item. This is not a foreign key as bookmarks can refer to a variety of sources, discriminated by the 'target_kind' field.
+	TargetID xid.ID `json:"target_id,omitempty"`
+	// The datagraph kind of the bookmarked item.
+	TargetKind string `json:"target_kind,omitempty"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookmarkQuery when eager-loading is set.
+	Edges        BookmarkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BookmarkEdges holds the relations/edges for other nodes in the graph.
+type BookmarkEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookmarkEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Bookmark) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bookmark.FieldTargetKind, bookmark.FieldNote:
+			values[i] = new(sql.NullString)
+		case bookmark.FieldCreatedAt, bookmark.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case bookmark.FieldID, bookmark.FieldAccountID, bookmark.FieldTargetID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Bookmark fields.
+func (_m *Bookmark) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bookmark.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bookmark.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bookmark.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case bookmark.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case bookmark.FieldTargetID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value != nil {
+				_m.TargetID = *value
+			}
+		case bookmark.FieldTargetKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_kind", values[i])
+			} else if value.Valid {
+				_m.TargetKind = value.String
+			}
+		case bookmark.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = new(string)
+				*_m.Note = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Bookmark.
+// This includes values selected through modifiers, order, etc.
+func (_m *Bookmark) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Bookmark entity.
+func (_m *Bookmark) QueryAccount() *AccountQuery {
+	return NewBookmarkClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this Bookmark.
+// Note that you need to call Bookmark.Unwrap() before calling this method if this Bookmark
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Bookmark) Update() *BookmarkUpdateOne {
+	return NewBookmarkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Bookmark entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Bookmark) Unwrap() *Bookmark {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Bookmark is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Bookmark) String() string {
+	var builder strings.Builder
+	builder.WriteString("Bookmark(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("target_kind=")
+	builder.WriteString(_m.TargetKind)
+	builder.WriteString(", ")
+	if v := _m.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Bookmarks is a parsable slice of Bookmark.
+type Bookmarks []*Bookmark
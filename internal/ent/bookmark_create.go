@@ -0,0 +1,816 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
+	"github.com/rs/xid"
+)
+
+// BookmarkCreate is the builder for creating a Bookmark entity.
+type BookmarkCreate struct {
+	config
+	mutation *BookmarkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BookmarkCreate) SetCreatedAt(v time.Time) *BookmarkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BookmarkCreate) SetNillableCreatedAt(v *time.Time) *BookmarkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BookmarkCreate) SetUpdatedAt(v time.Time) *BookmarkCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BookmarkCreate) SetNillableUpdatedAt(v *time.Time) *BookmarkCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BookmarkCreate) SetAccountID(v xid.ID) *BookmarkCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *BookmarkCreate) SetTargetID(v xid.ID) *BookmarkCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (_c *BookmarkCreate) SetTargetKind(v string) *BookmarkCreate {
+	_c.mutation.SetTargetKind(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *BookmarkCreate) SetNote(v string) *BookmarkCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *BookmarkCreate) SetNillableNote(v *string) *BookmarkCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BookmarkCreate) SetID(v xid.ID) *BookmarkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BookmarkCreate) SetNillableID(v *xid.ID) *BookmarkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *BookmarkCreate) SetAccount(v *Account) *BookmarkCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the BookmarkMutation object of the builder.
+func (_c *BookmarkCreate) Mutation() *BookmarkMutation {
+	return _c.mutation
+}
+
+// Save creates the Bookmark in the database.
+func (_c *BookmarkCreate) Save(ctx context.Context) (*Bookmark, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BookmarkCreate) SaveX(ctx context.Context) *Bookmark {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookmarkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookmarkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BookmarkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bookmark.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := bookmark.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bookmark.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BookmarkCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Bookmark.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Bookmark.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Bookmark.account_id"`)}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "Bookmark.target_id"`)}
+	}
+	if _, ok := _c.mutation.TargetKind(); !ok {
+		return &ValidationError{Name: "target_kind", err: errors.New(`ent: missing required field "Bookmark.target_kind"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := bookmark.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Bookmark.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Bookmark.account"`)}
+	}
+	return nil
+}
+
+func (_c *BookmarkCreate) sqlSave(ctx context.Context) (*Bookmark, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BookmarkCreate) createSpec() (*Bookmark, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Bookmark{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bookmark.Table, sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bookmark.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bookmark.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(bookmark.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.TargetKind(); ok {
+		_spec.SetField(bookmark.FieldTargetKind, field.TypeString, value)
+		_node.TargetKind = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(bookmark.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.AccountTable,
+			Columns: []string{bookmark.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Bookmark.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookmarkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BookmarkCreate) OnConflict(opts ...sql.ConflictOption) *BookmarkUpsertOne {
+	_c.conflict = opts
+	return &BookmarkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Bookmark.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BookmarkCreate) OnConflictColumns(columns ...string) *BookmarkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BookmarkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BookmarkUpsertOne is the builder for "upsert"-ing
+	//  one Bookmark node.
+	BookmarkUpsertOne struct {
+		create *BookmarkCreate
+	}
+
+	// BookmarkUpsert is the "OnConflict" setter.
+	BookmarkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BookmarkUpsert) SetUpdatedAt(v time.Time) *BookmarkUpsert {
+	u.Set(bookmark.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BookmarkUpsert) UpdateUpdatedAt() *BookmarkUpsert {
+	u.SetExcluded(bookmark.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *BookmarkUpsert) SetAccountID(v xid.ID) *BookmarkUpsert {
+	u.Set(bookmark.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *BookmarkUpsert) UpdateAccountID() *BookmarkUpsert {
+	u.SetExcluded(bookmark.FieldAccountID)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BookmarkUpsert) SetTargetID(v xid.ID) *BookmarkUpsert {
+	u.Set(bookmark.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BookmarkUpsert) UpdateTargetID() *BookmarkUpsert {
+	u.SetExcluded(bookmark.FieldTargetID)
+	return u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *BookmarkUpsert) SetTargetKind(v string) *BookmarkUpsert {
+	u.Set(bookmark.FieldTargetKind, v)
+	return u
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *BookmarkUpsert) UpdateTargetKind() *BookmarkUpsert {
+	u.SetExcluded(bookmark.FieldTargetKind)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *BookmarkUpsert) SetNote(v string) *BookmarkUpsert {
+	u.Set(bookmark.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *BookmarkUpsert) UpdateNote() *BookmarkUpsert {
+	u.SetExcluded(bookmark.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *BookmarkUpsert) ClearNote() *BookmarkUpsert {
+	u.SetNull(bookmark.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Bookmark.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bookmark.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BookmarkUpsertOne) UpdateNewValues() *BookmarkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(bookmark.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(bookmark.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Bookmark.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BookmarkUpsertOne) Ignore() *BookmarkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookmarkUpsertOne) DoNothing() *BookmarkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookmarkCreate.OnConflict
+// documentation for more info.
+func (u *BookmarkUpsertOne) Update(set func(*BookmarkUpsert)) *BookmarkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookmarkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BookmarkUpsertOne) SetUpdatedAt(v time.Time) *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BookmarkUpsertOne) UpdateUpdatedAt() *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *BookmarkUpsertOne) SetAccountID(v xid.ID) *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *BookmarkUpsertOne) UpdateAccountID() *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BookmarkUpsertOne) SetTargetID(v xid.ID) *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BookmarkUpsertOne) UpdateTargetID() *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *BookmarkUpsertOne) SetTargetKind(v string) *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *BookmarkUpsertOne) UpdateTargetKind() *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *BookmarkUpsertOne) SetNote(v string) *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *BookmarkUpsertOne) UpdateNote() *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *BookmarkUpsertOne) ClearNote() *BookmarkUpsertOne {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *BookmarkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookmarkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookmarkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BookmarkUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BookmarkUpsertOne.ID is not supported by MySQL driver. Use BookmarkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BookmarkUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BookmarkCreateBulk is the builder for creating many Bookmark entities in bulk.
+type BookmarkCreateBulk struct {
+	config
+	err      error
+	builders []*BookmarkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Bookmark entities in the database.
+func (_c *BookmarkCreateBulk) Save(ctx context.Context) ([]*Bookmark, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Bookmark, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookmarkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BookmarkCreateBulk) SaveX(ctx context.Context) []*Bookmark {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookmarkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookmarkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Bookmark.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookmarkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BookmarkCreateBulk) OnConflict(opts ...sql.ConflictOption) *BookmarkUpsertBulk {
+	_c.conflict = opts
+	return &BookmarkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Bookmark.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BookmarkCreateBulk) OnConflictColumns(columns ...string) *BookmarkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BookmarkUpsertBulk{
+		create: _c,
+	}
+}
+
+// BookmarkUpsertBulk is the builder for "upsert"-ing
+// a bulk of Bookmark nodes.
+type BookmarkUpsertBulk struct {
+	create *BookmarkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Bookmark.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bookmark.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BookmarkUpsertBulk) UpdateNewValues() *BookmarkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(bookmark.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(bookmark.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Bookmark.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BookmarkUpsertBulk) Ignore() *BookmarkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookmarkUpsertBulk) DoNothing() *BookmarkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookmarkCreateBulk.OnConflict
+// documentation for more info.
+func (u *BookmarkUpsertBulk) Update(set func(*BookmarkUpsert)) *BookmarkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookmarkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BookmarkUpsertBulk) SetUpdatedAt(v time.Time) *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BookmarkUpsertBulk) UpdateUpdatedAt() *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *BookmarkUpsertBulk) SetAccountID(v xid.ID) *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *BookmarkUpsertBulk) UpdateAccountID() *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BookmarkUpsertBulk) SetTargetID(v xid.ID) *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BookmarkUpsertBulk) UpdateTargetID() *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *BookmarkUpsertBulk) SetTargetKind(v string) *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *BookmarkUpsertBulk) UpdateTargetKind() *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *BookmarkUpsertBulk) SetNote(v string) *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *BookmarkUpsertBulk) UpdateNote() *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *BookmarkUpsertBulk) ClearNote() *BookmarkUpsertBulk {
+	return u.Update(func(s *BookmarkUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *BookmarkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BookmarkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookmarkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookmarkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
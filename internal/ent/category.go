@@ -40,6 +40,12 @@ type Category struct {
 	ParentCategoryID xid.ID `json:"parent_category_id,omitempty"`
 	// CoverImageAssetID holds the value of the "cover_image_asset_id" field.
 	CoverImageAssetID *xid.ID `json:"cover_image_asset_id,omitempty"`
+	// When set, the category is read-only.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Optional start of the window in which posting is allowed.
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// Optional end of the window in which posting is allowed.
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
 	// Arbitrary metadata used by clients to store domain specific information.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -58,9 +64,15 @@ type CategoryEdges struct {
 	Children []*Category `json:"children,omitempty"`
 	// CoverImage holds the value of the cover_image edge.
 	CoverImage *Asset `json:"cover_image,omitempty"`
+	// Followers holds the value of the followers edge.
+	Followers []*CategoryFollow `json:"followers,omitempty"`
+	// Permissions holds the value of the permissions edge.
+	Permissions []*CategoryPermission `json:"permissions,omitempty"`
+	// Threads in the category must have at least one of these tags.
+	RequiredTags []*Tag `json:"required_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -103,6 +115,33 @@ func (e CategoryEdges) CoverImageOrErr() (*Asset, error) {
 	return nil, &NotLoadedError{edge: "cover_image"}
 }
 
+// FollowersOrErr returns the Followers value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) FollowersOrErr() ([]*CategoryFollow, error) {
+	if e.loadedTypes[4] {
+		return e.Followers, nil
+	}
+	return nil, &NotLoadedError{edge: "followers"}
+}
+
+// PermissionsOrErr returns the Permissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) PermissionsOrErr() ([]*CategoryPermission, error) {
+	if e.loadedTypes[5] {
+		return e.Permissions, nil
+	}
+	return nil, &NotLoadedError{edge: "permissions"}
+}
+
+// RequiredTagsOrErr returns the RequiredTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) RequiredTagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[6] {
+		return e.RequiredTags, nil
+	}
+	return nil, &NotLoadedError{edge: "required_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Category) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -118,7 +157,7 @@ func (*Category) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case category.FieldName, category.FieldSlug, category.FieldDescription, category.FieldColour:
 			values[i] = new(sql.NullString)
-		case category.FieldCreatedAt, category.FieldUpdatedAt:
+		case category.FieldCreatedAt, category.FieldUpdatedAt, category.FieldArchivedAt, category.FieldOpensAt, category.FieldClosesAt:
 			values[i] = new(sql.NullTime)
 		case category.FieldID, category.FieldParentCategoryID:
 			values[i] = new(xid.ID)
@@ -204,6 +243,27 @@ func (_m *Category) assignValues(columns []string, values []any) error {
 				_m.CoverImageAssetID = new(xid.ID)
 				*_m.CoverImageAssetID = *value.S.(*xid.ID)
 			}
+		case category.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
+		case category.FieldOpensAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opens_at", values[i])
+			} else if value.Valid {
+				_m.OpensAt = new(time.Time)
+				*_m.OpensAt = value.Time
+			}
+		case category.FieldClosesAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closes_at", values[i])
+			} else if value.Valid {
+				_m.ClosesAt = new(time.Time)
+				*_m.ClosesAt = value.Time
+			}
 		case category.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -245,6 +305,21 @@ func (_m *Category) QueryCoverImage() *AssetQuery {
 	return NewCategoryClient(_m.config).QueryCoverImage(_m)
 }
 
+// QueryFollowers queries the "followers" edge of the Category entity.
+func (_m *Category) QueryFollowers() *CategoryFollowQuery {
+	return NewCategoryClient(_m.config).QueryFollowers(_m)
+}
+
+// QueryPermissions queries the "permissions" edge of the Category entity.
+func (_m *Category) QueryPermissions() *CategoryPermissionQuery {
+	return NewCategoryClient(_m.config).QueryPermissions(_m)
+}
+
+// QueryRequiredTags queries the "required_tags" edge of the Category entity.
+func (_m *Category) QueryRequiredTags() *TagQuery {
+	return NewCategoryClient(_m.config).QueryRequiredTags(_m)
+}
+
 // Update returns a builder for updating this Category.
 // Note that you need to call Category.Unwrap() before calling this method if this Category
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -300,6 +375,21 @@ func (_m *Category) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.OpensAt; v != nil {
+		builder.WriteString("opens_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosesAt; v != nil {
+		builder.WriteString("closes_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteByte(')')
@@ -35,6 +35,12 @@ const (
 	FieldParentCategoryID = "parent_category_id"
 	// FieldCoverImageAssetID holds the string denoting the cover_image_asset_id field in the database.
 	FieldCoverImageAssetID = "cover_image_asset_id"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldOpensAt holds the string denoting the opens_at field in the database.
+	FieldOpensAt = "opens_at"
+	// FieldClosesAt holds the string denoting the closes_at field in the database.
+	FieldClosesAt = "closes_at"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
@@ -45,6 +51,12 @@ const (
 	EdgeChildren = "children"
 	// EdgeCoverImage holds the string denoting the cover_image edge name in mutations.
 	EdgeCoverImage = "cover_image"
+	// EdgeFollowers holds the string denoting the followers edge name in mutations.
+	EdgeFollowers = "followers"
+	// EdgePermissions holds the string denoting the permissions edge name in mutations.
+	EdgePermissions = "permissions"
+	// EdgeRequiredTags holds the string denoting the required_tags edge name in mutations.
+	EdgeRequiredTags = "required_tags"
 	// Table holds the table name of the category in the database.
 	Table = "categories"
 	// PostsTable is the table that holds the posts relation/edge.
@@ -69,6 +81,25 @@ const (
 	CoverImageInverseTable = "assets"
 	// CoverImageColumn is the table column denoting the cover_image relation/edge.
 	CoverImageColumn = "cover_image_asset_id"
+	// FollowersTable is the table that holds the followers relation/edge.
+	FollowersTable = "category_follows"
+	// FollowersInverseTable is the table name for the CategoryFollow entity.
+	// It exists in this package in order to avoid circular dependency with the "categoryfollow" package.
+	FollowersInverseTable = "category_follows"
+	// FollowersColumn is the table column denoting the followers relation/edge.
+	FollowersColumn = "category_id"
+	// PermissionsTable is the table that holds the permissions relation/edge.
+	PermissionsTable = "category_permissions"
+	// PermissionsInverseTable is the table name for the CategoryPermission entity.
+	// It exists in this package in order to avoid circular dependency with the "categorypermission" package.
+	PermissionsInverseTable = "category_permissions"
+	// PermissionsColumn is the table column denoting the permissions relation/edge.
+	PermissionsColumn = "category_id"
+	// RequiredTagsTable is the table that holds the required_tags relation/edge. The primary key declared below.
+	RequiredTagsTable = "category_required_tags"
+	// RequiredTagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	RequiredTagsInverseTable = "tags"
 )
 
 // Columns holds all SQL columns for category fields.
@@ -84,9 +115,18 @@ var Columns = []string{
 	FieldAdmin,
 	FieldParentCategoryID,
 	FieldCoverImageAssetID,
+	FieldArchivedAt,
+	FieldOpensAt,
+	FieldClosesAt,
 	FieldMetadata,
 }
 
+var (
+	// RequiredTagsPrimaryKey and RequiredTagsColumn2 are the table columns denoting the
+	// primary key for the required_tags relation (M2M).
+	RequiredTagsPrimaryKey = []string{"category_id", "tag_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -176,6 +216,21 @@ func ByCoverImageAssetID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCoverImageAssetID, opts...).ToFunc()
 }
 
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByOpensAt orders the results by the opens_at field.
+func ByOpensAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpensAt, opts...).ToFunc()
+}
+
+// ByClosesAt orders the results by the closes_at field.
+func ByClosesAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosesAt, opts...).ToFunc()
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -217,6 +272,48 @@ func ByCoverImageField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCoverImageStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByFollowersCount orders the results by followers count.
+func ByFollowersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowersStep(), opts...)
+	}
+}
+
+// ByFollowers orders the results by followers terms.
+func ByFollowers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPermissionsCount orders the results by permissions count.
+func ByPermissionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPermissionsStep(), opts...)
+	}
+}
+
+// ByPermissions orders the results by permissions terms.
+func ByPermissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPermissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequiredTagsCount orders the results by required_tags count.
+func ByRequiredTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequiredTagsStep(), opts...)
+	}
+}
+
+// ByRequiredTags orders the results by required_tags terms.
+func ByRequiredTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequiredTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -245,3 +342,24 @@ func newCoverImageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, CoverImageTable, CoverImageColumn),
 	)
 }
+func newFollowersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowersTable, FollowersColumn),
+	)
+}
+func newPermissionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PermissionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PermissionsTable, PermissionsColumn),
+	)
+}
+func newRequiredTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequiredTagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, RequiredTagsTable, RequiredTagsPrimaryKey...),
+	)
+}
@@ -14,7 +14,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
 	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/tag"
 	"github.com/rs/xid"
 )
 
@@ -150,6 +153,48 @@ func (_c *CategoryCreate) SetNillableCoverImageAssetID(v *xid.ID) *CategoryCreat
 	return _c
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_c *CategoryCreate) SetArchivedAt(v time.Time) *CategoryCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableArchivedAt(v *time.Time) *CategoryCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_c *CategoryCreate) SetOpensAt(v time.Time) *CategoryCreate {
+	_c.mutation.SetOpensAt(v)
+	return _c
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableOpensAt(v *time.Time) *CategoryCreate {
+	if v != nil {
+		_c.SetOpensAt(*v)
+	}
+	return _c
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_c *CategoryCreate) SetClosesAt(v time.Time) *CategoryCreate {
+	_c.mutation.SetClosesAt(v)
+	return _c
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableClosesAt(v *time.Time) *CategoryCreate {
+	if v != nil {
+		_c.SetClosesAt(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *CategoryCreate) SetMetadata(v map[string]interface{}) *CategoryCreate {
 	_c.mutation.SetMetadata(v)
@@ -238,6 +283,51 @@ func (_c *CategoryCreate) SetCoverImage(v *Asset) *CategoryCreate {
 	return _c.SetCoverImageID(v.ID)
 }
 
+// AddFollowerIDs adds the "followers" edge to the CategoryFollow entity by IDs.
+func (_c *CategoryCreate) AddFollowerIDs(ids ...xid.ID) *CategoryCreate {
+	_c.mutation.AddFollowerIDs(ids...)
+	return _c
+}
+
+// AddFollowers adds the "followers" edges to the CategoryFollow entity.
+func (_c *CategoryCreate) AddFollowers(v ...*CategoryFollow) *CategoryCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFollowerIDs(ids...)
+}
+
+// AddPermissionIDs adds the "permissions" edge to the CategoryPermission entity by IDs.
+func (_c *CategoryCreate) AddPermissionIDs(ids ...xid.ID) *CategoryCreate {
+	_c.mutation.AddPermissionIDs(ids...)
+	return _c
+}
+
+// AddPermissions adds the "permissions" edges to the CategoryPermission entity.
+func (_c *CategoryCreate) AddPermissions(v ...*CategoryPermission) *CategoryCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPermissionIDs(ids...)
+}
+
+// AddRequiredTagIDs adds the "required_tags" edge to the Tag entity by IDs.
+func (_c *CategoryCreate) AddRequiredTagIDs(ids ...xid.ID) *CategoryCreate {
+	_c.mutation.AddRequiredTagIDs(ids...)
+	return _c
+}
+
+// AddRequiredTags adds the "required_tags" edges to the Tag entity.
+func (_c *CategoryCreate) AddRequiredTags(v ...*Tag) *CategoryCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRequiredTagIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_c *CategoryCreate) Mutation() *CategoryMutation {
 	return _c.mutation
@@ -402,6 +492,18 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldAdmin, field.TypeBool, value)
 		_node.Admin = value
 	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(category.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.OpensAt(); ok {
+		_spec.SetField(category.FieldOpensAt, field.TypeTime, value)
+		_node.OpensAt = &value
+	}
+	if value, ok := _c.mutation.ClosesAt(); ok {
+		_spec.SetField(category.FieldClosesAt, field.TypeTime, value)
+		_node.ClosesAt = &value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -472,6 +574,54 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_node.CoverImageAssetID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.FollowersTable,
+			Columns: []string{category.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PermissionsTable,
+			Columns: []string{category.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RequiredTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.RequiredTagsTable,
+			Columns: category.RequiredTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -650,6 +800,60 @@ func (u *CategoryUpsert) ClearCoverImageAssetID() *CategoryUpsert {
 	return u
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (u *CategoryUpsert) SetArchivedAt(v time.Time) *CategoryUpsert {
+	u.Set(category.FieldArchivedAt, v)
+	return u
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateArchivedAt() *CategoryUpsert {
+	u.SetExcluded(category.FieldArchivedAt)
+	return u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *CategoryUpsert) ClearArchivedAt() *CategoryUpsert {
+	u.SetNull(category.FieldArchivedAt)
+	return u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (u *CategoryUpsert) SetOpensAt(v time.Time) *CategoryUpsert {
+	u.Set(category.FieldOpensAt, v)
+	return u
+}
+
+// UpdateOpensAt sets the "opens_at" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateOpensAt() *CategoryUpsert {
+	u.SetExcluded(category.FieldOpensAt)
+	return u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (u *CategoryUpsert) ClearOpensAt() *CategoryUpsert {
+	u.SetNull(category.FieldOpensAt)
+	return u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *CategoryUpsert) SetClosesAt(v time.Time) *CategoryUpsert {
+	u.Set(category.FieldClosesAt, v)
+	return u
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateClosesAt() *CategoryUpsert {
+	u.SetExcluded(category.FieldClosesAt)
+	return u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *CategoryUpsert) ClearClosesAt() *CategoryUpsert {
+	u.SetNull(category.FieldClosesAt)
+	return u
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *CategoryUpsert) SetMetadata(v map[string]interface{}) *CategoryUpsert {
 	u.Set(category.FieldMetadata, v)
@@ -866,6 +1070,69 @@ func (u *CategoryUpsertOne) ClearCoverImageAssetID() *CategoryUpsertOne {
 	})
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (u *CategoryUpsertOne) SetArchivedAt(v time.Time) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateArchivedAt() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *CategoryUpsertOne) ClearArchivedAt() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (u *CategoryUpsertOne) SetOpensAt(v time.Time) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetOpensAt(v)
+	})
+}
+
+// UpdateOpensAt sets the "opens_at" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateOpensAt() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateOpensAt()
+	})
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (u *CategoryUpsertOne) ClearOpensAt() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearOpensAt()
+	})
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *CategoryUpsertOne) SetClosesAt(v time.Time) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetClosesAt(v)
+	})
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateClosesAt() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateClosesAt()
+	})
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *CategoryUpsertOne) ClearClosesAt() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearClosesAt()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *CategoryUpsertOne) SetMetadata(v map[string]interface{}) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
@@ -1252,6 +1519,69 @@ func (u *CategoryUpsertBulk) ClearCoverImageAssetID() *CategoryUpsertBulk {
 	})
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (u *CategoryUpsertBulk) SetArchivedAt(v time.Time) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateArchivedAt() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *CategoryUpsertBulk) ClearArchivedAt() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (u *CategoryUpsertBulk) SetOpensAt(v time.Time) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetOpensAt(v)
+	})
+}
+
+// UpdateOpensAt sets the "opens_at" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateOpensAt() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateOpensAt()
+	})
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (u *CategoryUpsertBulk) ClearOpensAt() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearOpensAt()
+	})
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *CategoryUpsertBulk) SetClosesAt(v time.Time) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetClosesAt(v)
+	})
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateClosesAt() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateClosesAt()
+	})
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *CategoryUpsertBulk) ClearClosesAt() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearClosesAt()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *CategoryUpsertBulk) SetMetadata(v map[string]interface{}) *CategoryUpsertBulk {
 	return u.Update(func(s *CategoryUpsert) {
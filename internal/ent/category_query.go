@@ -14,23 +14,29 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/tag"
 	"github.com/rs/xid"
 )
 
 // CategoryQuery is the builder for querying Category entities.
 type CategoryQuery struct {
 	config
-	ctx            *QueryContext
-	order          []category.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Category
-	withPosts      *PostQuery
-	withParent     *CategoryQuery
-	withChildren   *CategoryQuery
-	withCoverImage *AssetQuery
-	modifiers      []func(*sql.Selector)
+	ctx              *QueryContext
+	order            []category.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Category
+	withPosts        *PostQuery
+	withParent       *CategoryQuery
+	withChildren     *CategoryQuery
+	withCoverImage   *AssetQuery
+	withFollowers    *CategoryFollowQuery
+	withPermissions  *CategoryPermissionQuery
+	withRequiredTags *TagQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +161,72 @@ func (_q *CategoryQuery) QueryCoverImage() *AssetQuery {
 	return query
 }
 
+// QueryFollowers chains the current query on the "followers" edge.
+func (_q *CategoryQuery) QueryFollowers() *CategoryFollowQuery {
+	query := (&CategoryFollowClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(categoryfollow.Table, categoryfollow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.FollowersTable, category.FollowersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPermissions chains the current query on the "permissions" edge.
+func (_q *CategoryQuery) QueryPermissions() *CategoryPermissionQuery {
+	query := (&CategoryPermissionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(categorypermission.Table, categorypermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.PermissionsTable, category.PermissionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequiredTags chains the current query on the "required_tags" edge.
+func (_q *CategoryQuery) QueryRequiredTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, category.RequiredTagsTable, category.RequiredTagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Category entity from the query.
 // Returns a *NotFoundError when no Category was found.
 func (_q *CategoryQuery) First(ctx context.Context) (*Category, error) {
@@ -342,15 +414,18 @@ func (_q *CategoryQuery) Clone() *CategoryQuery {
 		return nil
 	}
 	return &CategoryQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]category.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Category{}, _q.predicates...),
-		withPosts:      _q.withPosts.Clone(),
-		withParent:     _q.withParent.Clone(),
-		withChildren:   _q.withChildren.Clone(),
-		withCoverImage: _q.withCoverImage.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]category.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Category{}, _q.predicates...),
+		withPosts:        _q.withPosts.Clone(),
+		withParent:       _q.withParent.Clone(),
+		withChildren:     _q.withChildren.Clone(),
+		withCoverImage:   _q.withCoverImage.Clone(),
+		withFollowers:    _q.withFollowers.Clone(),
+		withPermissions:  _q.withPermissions.Clone(),
+		withRequiredTags: _q.withRequiredTags.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -402,6 +477,39 @@ func (_q *CategoryQuery) WithCoverImage(opts ...func(*AssetQuery)) *CategoryQuer
 	return _q
 }
 
+// WithFollowers tells the query-builder to eager-load the nodes that are connected to
+// the "followers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CategoryQuery) WithFollowers(opts ...func(*CategoryFollowQuery)) *CategoryQuery {
+	query := (&CategoryFollowClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFollowers = query
+	return _q
+}
+
+// WithPermissions tells the query-builder to eager-load the nodes that are connected to
+// the "permissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CategoryQuery) WithPermissions(opts ...func(*CategoryPermissionQuery)) *CategoryQuery {
+	query := (&CategoryPermissionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPermissions = query
+	return _q
+}
+
+// WithRequiredTags tells the query-builder to eager-load the nodes that are connected to
+// the "required_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CategoryQuery) WithRequiredTags(opts ...func(*TagQuery)) *CategoryQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRequiredTags = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,11 +588,14 @@ func (_q *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 	var (
 		nodes       = []*Category{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			_q.withPosts != nil,
 			_q.withParent != nil,
 			_q.withChildren != nil,
 			_q.withCoverImage != nil,
+			_q.withFollowers != nil,
+			_q.withPermissions != nil,
+			_q.withRequiredTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -534,6 +645,27 @@ func (_q *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 			return nil, err
 		}
 	}
+	if query := _q.withFollowers; query != nil {
+		if err := _q.loadFollowers(ctx, query, nodes,
+			func(n *Category) { n.Edges.Followers = []*CategoryFollow{} },
+			func(n *Category, e *CategoryFollow) { n.Edges.Followers = append(n.Edges.Followers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPermissions; query != nil {
+		if err := _q.loadPermissions(ctx, query, nodes,
+			func(n *Category) { n.Edges.Permissions = []*CategoryPermission{} },
+			func(n *Category, e *CategoryPermission) { n.Edges.Permissions = append(n.Edges.Permissions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRequiredTags; query != nil {
+		if err := _q.loadRequiredTags(ctx, query, nodes,
+			func(n *Category) { n.Edges.RequiredTags = []*Tag{} },
+			func(n *Category, e *Tag) { n.Edges.RequiredTags = append(n.Edges.RequiredTags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -658,6 +790,127 @@ func (_q *CategoryQuery) loadCoverImage(ctx context.Context, query *AssetQuery,
 	}
 	return nil
 }
+func (_q *CategoryQuery) loadFollowers(ctx context.Context, query *CategoryFollowQuery, nodes []*Category, init func(*Category), assign func(*Category, *CategoryFollow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Category)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(categoryfollow.FieldCategoryID)
+	}
+	query.Where(predicate.CategoryFollow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(category.FollowersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CategoryID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "category_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CategoryQuery) loadPermissions(ctx context.Context, query *CategoryPermissionQuery, nodes []*Category, init func(*Category), assign func(*Category, *CategoryPermission)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Category)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(categorypermission.FieldCategoryID)
+	}
+	query.Where(predicate.CategoryPermission(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(category.PermissionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CategoryID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "category_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CategoryQuery) loadRequiredTags(ctx context.Context, query *TagQuery, nodes []*Category, init func(*Category), assign func(*Category, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[xid.ID]*Category)
+	nids := make(map[xid.ID]map[*Category]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(category.RequiredTagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(category.RequiredTagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(category.RequiredTagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(category.RequiredTagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(xid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*xid.ID)
+				inValue := *values[1].(*xid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Category]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "required_tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *CategoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
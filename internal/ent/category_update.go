@@ -13,8 +13,11 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/tag"
 	"github.com/rs/xid"
 )
 
@@ -169,6 +172,66 @@ func (_u *CategoryUpdate) ClearCoverImageAssetID() *CategoryUpdate {
 	return _u
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_u *CategoryUpdate) SetArchivedAt(v time.Time) *CategoryUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableArchivedAt(v *time.Time) *CategoryUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *CategoryUpdate) ClearArchivedAt() *CategoryUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_u *CategoryUpdate) SetOpensAt(v time.Time) *CategoryUpdate {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableOpensAt(v *time.Time) *CategoryUpdate {
+	if v != nil {
+		_u.SetOpensAt(*v)
+	}
+	return _u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *CategoryUpdate) ClearOpensAt() *CategoryUpdate {
+	_u.mutation.ClearOpensAt()
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *CategoryUpdate) SetClosesAt(v time.Time) *CategoryUpdate {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableClosesAt(v *time.Time) *CategoryUpdate {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *CategoryUpdate) ClearClosesAt() *CategoryUpdate {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *CategoryUpdate) SetMetadata(v map[string]interface{}) *CategoryUpdate {
 	_u.mutation.SetMetadata(v)
@@ -249,6 +312,51 @@ func (_u *CategoryUpdate) SetCoverImage(v *Asset) *CategoryUpdate {
 	return _u.SetCoverImageID(v.ID)
 }
 
+// AddFollowerIDs adds the "followers" edge to the CategoryFollow entity by IDs.
+func (_u *CategoryUpdate) AddFollowerIDs(ids ...xid.ID) *CategoryUpdate {
+	_u.mutation.AddFollowerIDs(ids...)
+	return _u
+}
+
+// AddFollowers adds the "followers" edges to the CategoryFollow entity.
+func (_u *CategoryUpdate) AddFollowers(v ...*CategoryFollow) *CategoryUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowerIDs(ids...)
+}
+
+// AddPermissionIDs adds the "permissions" edge to the CategoryPermission entity by IDs.
+func (_u *CategoryUpdate) AddPermissionIDs(ids ...xid.ID) *CategoryUpdate {
+	_u.mutation.AddPermissionIDs(ids...)
+	return _u
+}
+
+// AddPermissions adds the "permissions" edges to the CategoryPermission entity.
+func (_u *CategoryUpdate) AddPermissions(v ...*CategoryPermission) *CategoryUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPermissionIDs(ids...)
+}
+
+// AddRequiredTagIDs adds the "required_tags" edge to the Tag entity by IDs.
+func (_u *CategoryUpdate) AddRequiredTagIDs(ids ...xid.ID) *CategoryUpdate {
+	_u.mutation.AddRequiredTagIDs(ids...)
+	return _u
+}
+
+// AddRequiredTags adds the "required_tags" edges to the Tag entity.
+func (_u *CategoryUpdate) AddRequiredTags(v ...*Tag) *CategoryUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRequiredTagIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdate) Mutation() *CategoryMutation {
 	return _u.mutation
@@ -308,6 +416,69 @@ func (_u *CategoryUpdate) ClearCoverImage() *CategoryUpdate {
 	return _u
 }
 
+// ClearFollowers clears all "followers" edges to the CategoryFollow entity.
+func (_u *CategoryUpdate) ClearFollowers() *CategoryUpdate {
+	_u.mutation.ClearFollowers()
+	return _u
+}
+
+// RemoveFollowerIDs removes the "followers" edge to CategoryFollow entities by IDs.
+func (_u *CategoryUpdate) RemoveFollowerIDs(ids ...xid.ID) *CategoryUpdate {
+	_u.mutation.RemoveFollowerIDs(ids...)
+	return _u
+}
+
+// RemoveFollowers removes "followers" edges to CategoryFollow entities.
+func (_u *CategoryUpdate) RemoveFollowers(v ...*CategoryFollow) *CategoryUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowerIDs(ids...)
+}
+
+// ClearPermissions clears all "permissions" edges to the CategoryPermission entity.
+func (_u *CategoryUpdate) ClearPermissions() *CategoryUpdate {
+	_u.mutation.ClearPermissions()
+	return _u
+}
+
+// RemovePermissionIDs removes the "permissions" edge to CategoryPermission entities by IDs.
+func (_u *CategoryUpdate) RemovePermissionIDs(ids ...xid.ID) *CategoryUpdate {
+	_u.mutation.RemovePermissionIDs(ids...)
+	return _u
+}
+
+// RemovePermissions removes "permissions" edges to CategoryPermission entities.
+func (_u *CategoryUpdate) RemovePermissions(v ...*CategoryPermission) *CategoryUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePermissionIDs(ids...)
+}
+
+// ClearRequiredTags clears all "required_tags" edges to the Tag entity.
+func (_u *CategoryUpdate) ClearRequiredTags() *CategoryUpdate {
+	_u.mutation.ClearRequiredTags()
+	return _u
+}
+
+// RemoveRequiredTagIDs removes the "required_tags" edge to Tag entities by IDs.
+func (_u *CategoryUpdate) RemoveRequiredTagIDs(ids ...xid.ID) *CategoryUpdate {
+	_u.mutation.RemoveRequiredTagIDs(ids...)
+	return _u
+}
+
+// RemoveRequiredTags removes "required_tags" edges to Tag entities.
+func (_u *CategoryUpdate) RemoveRequiredTags(v ...*Tag) *CategoryUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRequiredTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CategoryUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -383,6 +554,24 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Admin(); ok {
 		_spec.SetField(category.FieldAdmin, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(category.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(category.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(category.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(category.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(category.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(category.FieldClosesAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 	}
@@ -537,6 +726,141 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.FollowersTable,
+			Columns: []string{category.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !_u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.FollowersTable,
+			Columns: []string{category.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.FollowersTable,
+			Columns: []string{category.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PermissionsTable,
+			Columns: []string{category.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !_u.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PermissionsTable,
+			Columns: []string{category.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PermissionsTable,
+			Columns: []string{category.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequiredTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.RequiredTagsTable,
+			Columns: category.RequiredTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRequiredTagsIDs(); len(nodes) > 0 && !_u.mutation.RequiredTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.RequiredTagsTable,
+			Columns: category.RequiredTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequiredTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.RequiredTagsTable,
+			Columns: category.RequiredTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -696,6 +1020,66 @@ func (_u *CategoryUpdateOne) ClearCoverImageAssetID() *CategoryUpdateOne {
 	return _u
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_u *CategoryUpdateOne) SetArchivedAt(v time.Time) *CategoryUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableArchivedAt(v *time.Time) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *CategoryUpdateOne) ClearArchivedAt() *CategoryUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_u *CategoryUpdateOne) SetOpensAt(v time.Time) *CategoryUpdateOne {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableOpensAt(v *time.Time) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetOpensAt(*v)
+	}
+	return _u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *CategoryUpdateOne) ClearOpensAt() *CategoryUpdateOne {
+	_u.mutation.ClearOpensAt()
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *CategoryUpdateOne) SetClosesAt(v time.Time) *CategoryUpdateOne {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableClosesAt(v *time.Time) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *CategoryUpdateOne) ClearClosesAt() *CategoryUpdateOne {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *CategoryUpdateOne) SetMetadata(v map[string]interface{}) *CategoryUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -776,6 +1160,51 @@ func (_u *CategoryUpdateOne) SetCoverImage(v *Asset) *CategoryUpdateOne {
 	return _u.SetCoverImageID(v.ID)
 }
 
+// AddFollowerIDs adds the "followers" edge to the CategoryFollow entity by IDs.
+func (_u *CategoryUpdateOne) AddFollowerIDs(ids ...xid.ID) *CategoryUpdateOne {
+	_u.mutation.AddFollowerIDs(ids...)
+	return _u
+}
+
+// AddFollowers adds the "followers" edges to the CategoryFollow entity.
+func (_u *CategoryUpdateOne) AddFollowers(v ...*CategoryFollow) *CategoryUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowerIDs(ids...)
+}
+
+// AddPermissionIDs adds the "permissions" edge to the CategoryPermission entity by IDs.
+func (_u *CategoryUpdateOne) AddPermissionIDs(ids ...xid.ID) *CategoryUpdateOne {
+	_u.mutation.AddPermissionIDs(ids...)
+	return _u
+}
+
+// AddPermissions adds the "permissions" edges to the CategoryPermission entity.
+func (_u *CategoryUpdateOne) AddPermissions(v ...*CategoryPermission) *CategoryUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPermissionIDs(ids...)
+}
+
+// AddRequiredTagIDs adds the "required_tags" edge to the Tag entity by IDs.
+func (_u *CategoryUpdateOne) AddRequiredTagIDs(ids ...xid.ID) *CategoryUpdateOne {
+	_u.mutation.AddRequiredTagIDs(ids...)
+	return _u
+}
+
+// AddRequiredTags adds the "required_tags" edges to the Tag entity.
+func (_u *CategoryUpdateOne) AddRequiredTags(v ...*Tag) *CategoryUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRequiredTagIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdateOne) Mutation() *CategoryMutation {
 	return _u.mutation
@@ -835,6 +1264,69 @@ func (_u *CategoryUpdateOne) ClearCoverImage() *CategoryUpdateOne {
 	return _u
 }
 
+// ClearFollowers clears all "followers" edges to the CategoryFollow entity.
+func (_u *CategoryUpdateOne) ClearFollowers() *CategoryUpdateOne {
+	_u.mutation.ClearFollowers()
+	return _u
+}
+
+// RemoveFollowerIDs removes the "followers" edge to CategoryFollow entities by IDs.
+func (_u *CategoryUpdateOne) RemoveFollowerIDs(ids ...xid.ID) *CategoryUpdateOne {
+	_u.mutation.RemoveFollowerIDs(ids...)
+	return _u
+}
+
+// RemoveFollowers removes "followers" edges to CategoryFollow entities.
+func (_u *CategoryUpdateOne) RemoveFollowers(v ...*CategoryFollow) *CategoryUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowerIDs(ids...)
+}
+
+// ClearPermissions clears all "permissions" edges to the CategoryPermission entity.
+func (_u *CategoryUpdateOne) ClearPermissions() *CategoryUpdateOne {
+	_u.mutation.ClearPermissions()
+	return _u
+}
+
+// RemovePermissionIDs removes the "permissions" edge to CategoryPermission entities by IDs.
+func (_u *CategoryUpdateOne) RemovePermissionIDs(ids ...xid.ID) *CategoryUpdateOne {
+	_u.mutation.RemovePermissionIDs(ids...)
+	return _u
+}
+
+// RemovePermissions removes "permissions" edges to CategoryPermission entities.
+func (_u *CategoryUpdateOne) RemovePermissions(v ...*CategoryPermission) *CategoryUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePermissionIDs(ids...)
+}
+
+// ClearRequiredTags clears all "required_tags" edges to the Tag entity.
+func (_u *CategoryUpdateOne) ClearRequiredTags() *CategoryUpdateOne {
+	_u.mutation.ClearRequiredTags()
+	return _u
+}
+
+// RemoveRequiredTagIDs removes the "required_tags" edge to Tag entities by IDs.
+func (_u *CategoryUpdateOne) RemoveRequiredTagIDs(ids ...xid.ID) *CategoryUpdateOne {
+	_u.mutation.RemoveRequiredTagIDs(ids...)
+	return _u
+}
+
+// RemoveRequiredTags removes "required_tags" edges to Tag entities.
+func (_u *CategoryUpdateOne) RemoveRequiredTags(v ...*Tag) *CategoryUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRequiredTagIDs(ids...)
+}
+
 // Where appends a list predicates to the CategoryUpdate builder.
 func (_u *CategoryUpdateOne) Where(ps ...predicate.Category) *CategoryUpdateOne {
 	_u.mutation.Where(ps...)
@@ -940,6 +1432,24 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if value, ok := _u.mutation.Admin(); ok {
 		_spec.SetField(category.FieldAdmin, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(category.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(category.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(category.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(category.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(category.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(category.FieldClosesAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 	}
@@ -1094,6 +1604,141 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.FollowersTable,
+			Columns: []string{category.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !_u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.FollowersTable,
+			Columns: []string{category.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.FollowersTable,
+			Columns: []string{category.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PermissionsTable,
+			Columns: []string{category.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !_u.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PermissionsTable,
+			Columns: []string{category.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PermissionsTable,
+			Columns: []string{category.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequiredTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.RequiredTagsTable,
+			Columns: category.RequiredTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRequiredTagsIDs(); len(nodes) > 0 && !_u.mutation.RequiredTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.RequiredTagsTable,
+			Columns: category.RequiredTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequiredTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.RequiredTagsTable,
+			Columns: category.RequiredTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Category{config: _u.config}
 	_spec.Assign = _node.assignValues
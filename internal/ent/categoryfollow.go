@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/rs/xid"
+)
+
+// CategoryFollow is the model entity for the CategoryFollow schema.
+type CategoryFollow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID xid.ID `json:"category_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryFollowQuery when eager-loading is set.
+	Edges        CategoryFollowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CategoryFollowEdges holds the relations/edges for other nodes in the graph.
+type CategoryFollowEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryFollowEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryFollowEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryFollow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categoryfollow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case categoryfollow.FieldID, categoryfollow.FieldAccountID, categoryfollow.FieldCategoryID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryFollow fields.
+func (_m *CategoryFollow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categoryfollow.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case categoryfollow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case categoryfollow.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case categoryfollow.FieldCategoryID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value != nil {
+				_m.CategoryID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryFollow.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryFollow) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the CategoryFollow entity.
+func (_m *CategoryFollow) QueryAccount() *AccountQuery {
+	return NewCategoryFollowClient(_m.config).QueryAccount(_m)
+}
+
+// QueryCategory queries the "category" edge of the CategoryFollow entity.
+func (_m *CategoryFollow) QueryCategory() *CategoryQuery {
+	return NewCategoryFollowClient(_m.config).QueryCategory(_m)
+}
+
+// Update returns a builder for updating this CategoryFollow.
+// Note that you need to call CategoryFollow.Unwrap() before calling this method if this CategoryFollow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryFollow) Update() *CategoryFollowUpdateOne {
+	return NewCategoryFollowClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryFollow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryFollow) Unwrap() *CategoryFollow {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryFollow is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryFollow) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryFollow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryFollows is a parsable slice of CategoryFollow.
+type CategoryFollows []*CategoryFollow
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/rs/xid"
+)
+
+// CategoryFollowCreate is the builder for creating a CategoryFollow entity.
+type CategoryFollowCreate struct {
+	config
+	mutation *CategoryFollowMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategoryFollowCreate) SetCreatedAt(v time.Time) *CategoryFollowCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategoryFollowCreate) SetNillableCreatedAt(v *time.Time) *CategoryFollowCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *CategoryFollowCreate) SetAccountID(v xid.ID) *CategoryFollowCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *CategoryFollowCreate) SetCategoryID(v xid.ID) *CategoryFollowCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategoryFollowCreate) SetID(v xid.ID) *CategoryFollowCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CategoryFollowCreate) SetNillableID(v *xid.ID) *CategoryFollowCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *CategoryFollowCreate) SetAccount(v *Account) *CategoryFollowCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *CategoryFollowCreate) SetCategory(v *Category) *CategoryFollowCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryFollowMutation object of the builder.
+func (_c *CategoryFollowCreate) Mutation() *CategoryFollowMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryFollow in the database.
+func (_c *CategoryFollowCreate) Save(ctx context.Context) (*CategoryFollow, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryFollowCreate) SaveX(ctx context.Context) *CategoryFollow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryFollowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryFollowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategoryFollowCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categoryfollow.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := categoryfollow.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryFollowCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategoryFollow.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "CategoryFollow.account_id"`)}
+	}
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "CategoryFollow.category_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := categoryfollow.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CategoryFollow.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "CategoryFollow.account"`)}
+	}
+	if len(_c.mutation.CategoryIDs()) == 0 {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required edge "CategoryFollow.category"`)}
+	}
+	return nil
+}
+
+func (_c *CategoryFollowCreate) sqlSave(ctx context.Context) (*CategoryFollow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryFollowCreate) createSpec() (*CategoryFollow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryFollow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categoryfollow.Table, sqlgraph.NewFieldSpec(categoryfollow.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categoryfollow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categoryfollow.AccountTable,
+			Columns: []string{categoryfollow.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categoryfollow.CategoryTable,
+			Columns: []string{categoryfollow.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryFollow.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryFollowUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryFollowCreate) OnConflict(opts ...sql.ConflictOption) *CategoryFollowUpsertOne {
+	_c.conflict = opts
+	return &CategoryFollowUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryFollow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryFollowCreate) OnConflictColumns(columns ...string) *CategoryFollowUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryFollowUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryFollowUpsertOne is the builder for "upsert"-ing
+	//  one CategoryFollow node.
+	CategoryFollowUpsertOne struct {
+		create *CategoryFollowCreate
+	}
+
+	// CategoryFollowUpsert is the "OnConflict" setter.
+	CategoryFollowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *CategoryFollowUpsert) SetAccountID(v xid.ID) *CategoryFollowUpsert {
+	u.Set(categoryfollow.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *CategoryFollowUpsert) UpdateAccountID() *CategoryFollowUpsert {
+	u.SetExcluded(categoryfollow.FieldAccountID)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryFollowUpsert) SetCategoryID(v xid.ID) *CategoryFollowUpsert {
+	u.Set(categoryfollow.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryFollowUpsert) UpdateCategoryID() *CategoryFollowUpsert {
+	u.SetExcluded(categoryfollow.FieldCategoryID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategoryFollow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryfollow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryFollowUpsertOne) UpdateNewValues() *CategoryFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categoryfollow.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(categoryfollow.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryFollow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryFollowUpsertOne) Ignore() *CategoryFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryFollowUpsertOne) DoNothing() *CategoryFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryFollowCreate.OnConflict
+// documentation for more info.
+func (u *CategoryFollowUpsertOne) Update(set func(*CategoryFollowUpsert)) *CategoryFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryFollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *CategoryFollowUpsertOne) SetAccountID(v xid.ID) *CategoryFollowUpsertOne {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *CategoryFollowUpsertOne) UpdateAccountID() *CategoryFollowUpsertOne {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryFollowUpsertOne) SetCategoryID(v xid.ID) *CategoryFollowUpsertOne {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryFollowUpsertOne) UpdateCategoryID() *CategoryFollowUpsertOne {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryFollowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryFollowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryFollowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryFollowUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryFollowUpsertOne.ID is not supported by MySQL driver. Use CategoryFollowUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryFollowUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryFollowCreateBulk is the builder for creating many CategoryFollow entities in bulk.
+type CategoryFollowCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryFollowCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryFollow entities in the database.
+func (_c *CategoryFollowCreateBulk) Save(ctx context.Context) ([]*CategoryFollow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryFollow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryFollowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryFollowCreateBulk) SaveX(ctx context.Context) []*CategoryFollow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryFollowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryFollowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryFollow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryFollowUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryFollowCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryFollowUpsertBulk {
+	_c.conflict = opts
+	return &CategoryFollowUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryFollow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryFollowCreateBulk) OnConflictColumns(columns ...string) *CategoryFollowUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryFollowUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryFollowUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryFollow nodes.
+type CategoryFollowUpsertBulk struct {
+	create *CategoryFollowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryFollow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryfollow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryFollowUpsertBulk) UpdateNewValues() *CategoryFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categoryfollow.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(categoryfollow.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryFollow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryFollowUpsertBulk) Ignore() *CategoryFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryFollowUpsertBulk) DoNothing() *CategoryFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryFollowCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryFollowUpsertBulk) Update(set func(*CategoryFollowUpsert)) *CategoryFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryFollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *CategoryFollowUpsertBulk) SetAccountID(v xid.ID) *CategoryFollowUpsertBulk {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *CategoryFollowUpsertBulk) UpdateAccountID() *CategoryFollowUpsertBulk {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryFollowUpsertBulk) SetCategoryID(v xid.ID) *CategoryFollowUpsertBulk {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryFollowUpsertBulk) UpdateCategoryID() *CategoryFollowUpsertBulk {
+	return u.Update(func(s *CategoryFollowUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryFollowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryFollowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryFollowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryFollowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
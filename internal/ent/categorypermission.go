@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
+	"github.com/rs/xid"
+)
+
+// CategoryPermission is the model entity for the CategoryPermission schema.
+type CategoryPermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID xid.ID `json:"category_id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID xid.ID `json:"role_id,omitempty"`
+	// CanRead holds the value of the "can_read" field.
+	CanRead bool `json:"can_read,omitempty"`
+	// CanCreateThread holds the value of the "can_create_thread" field.
+	CanCreateThread bool `json:"can_create_thread,omitempty"`
+	// CanReply holds the value of the "can_reply" field.
+	CanReply bool `json:"can_reply,omitempty"`
+	// CanAttach holds the value of the "can_attach" field.
+	CanAttach bool `json:"can_attach,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryPermissionQuery when eager-loading is set.
+	Edges        CategoryPermissionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CategoryPermissionEdges holds the relations/edges for other nodes in the graph.
+type CategoryPermissionEdges struct {
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryPermissionEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryPermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categorypermission.FieldCanRead, categorypermission.FieldCanCreateThread, categorypermission.FieldCanReply, categorypermission.FieldCanAttach:
+			values[i] = new(sql.NullBool)
+		case categorypermission.FieldCreatedAt, categorypermission.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case categorypermission.FieldID, categorypermission.FieldCategoryID, categorypermission.FieldRoleID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryPermission fields.
+func (_m *CategoryPermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categorypermission.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case categorypermission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case categorypermission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case categorypermission.FieldCategoryID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value != nil {
+				_m.CategoryID = *value
+			}
+		case categorypermission.FieldRoleID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value != nil {
+				_m.RoleID = *value
+			}
+		case categorypermission.FieldCanRead:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field can_read", values[i])
+			} else if value.Valid {
+				_m.CanRead = value.Bool
+			}
+		case categorypermission.FieldCanCreateThread:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field can_create_thread", values[i])
+			} else if value.Valid {
+				_m.CanCreateThread = value.Bool
+			}
+		case categorypermission.FieldCanReply:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field can_reply", values[i])
+			} else if value.Valid {
+				_m.CanReply = value.Bool
+			}
+		case categorypermission.FieldCanAttach:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field can_attach", values[i])
+			} else if value.Valid {
+				_m.CanAttach = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryPermission.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryPermission) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCategory queries the "category" edge of the CategoryPermission entity.
+func (_m *CategoryPermission) QueryCategory() *CategoryQuery {
+	return NewCategoryPermissionClient(_m.config).QueryCategory(_m)
+}
+
+// Update returns a builder for updating this CategoryPermission.
+// Note that you need to call CategoryPermission.Unwrap() before calling this method if this CategoryPermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryPermission) Update() *CategoryPermissionUpdateOne {
+	return NewCategoryPermissionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryPermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryPermission) Unwrap() *CategoryPermission {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryPermission is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryPermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryPermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("can_read=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanRead))
+	builder.WriteString(", ")
+	builder.WriteString("can_create_thread=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanCreateThread))
+	builder.WriteString(", ")
+	builder.WriteString("can_reply=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanReply))
+	builder.WriteString(", ")
+	builder.WriteString("can_attach=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanAttach))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryPermissions is a parsable slice of CategoryPermission.
+type CategoryPermissions []*CategoryPermission
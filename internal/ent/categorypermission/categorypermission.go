@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorypermission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the categorypermission type in the database.
+	Label = "category_permission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldRoleID holds the string denoting the role_id field in the database.
+	FieldRoleID = "role_id"
+	// FieldCanRead holds the string denoting the can_read field in the database.
+	FieldCanRead = "can_read"
+	// FieldCanCreateThread holds the string denoting the can_create_thread field in the database.
+	FieldCanCreateThread = "can_create_thread"
+	// FieldCanReply holds the string denoting the can_reply field in the database.
+	FieldCanReply = "can_reply"
+	// FieldCanAttach holds the string denoting the can_attach field in the database.
+	FieldCanAttach = "can_attach"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// Table holds the table name of the categorypermission in the database.
+	Table = "category_permissions"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "category_permissions"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_id"
+)
+
+// Columns holds all SQL columns for categorypermission fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCategoryID,
+	FieldRoleID,
+	FieldCanRead,
+	FieldCanCreateThread,
+	FieldCanReply,
+	FieldCanAttach,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCanRead holds the default value on creation for the "can_read" field.
+	DefaultCanRead bool
+	// DefaultCanCreateThread holds the default value on creation for the "can_create_thread" field.
+	DefaultCanCreateThread bool
+	// DefaultCanReply holds the default value on creation for the "can_reply" field.
+	DefaultCanReply bool
+	// DefaultCanAttach holds the default value on creation for the "can_attach" field.
+	DefaultCanAttach bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the CategoryPermission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByRoleID orders the results by the role_id field.
+func ByRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleID, opts...).ToFunc()
+}
+
+// ByCanRead orders the results by the can_read field.
+func ByCanRead(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanRead, opts...).ToFunc()
+}
+
+// ByCanCreateThread orders the results by the can_create_thread field.
+func ByCanCreateThread(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanCreateThread, opts...).ToFunc()
+}
+
+// ByCanReply orders the results by the can_reply field.
+func ByCanReply(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanReply, opts...).ToFunc()
+}
+
+// ByCanAttach orders the results by the can_attach field.
+func ByCanAttach(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanAttach, opts...).ToFunc()
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+	)
+}
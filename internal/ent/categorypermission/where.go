@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorypermission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldRoleID, v))
+}
+
+// CanRead applies equality check predicate on the "can_read" field. It's identical to CanReadEQ.
+func CanRead(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanRead, v))
+}
+
+// CanCreateThread applies equality check predicate on the "can_create_thread" field. It's identical to CanCreateThreadEQ.
+func CanCreateThread(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanCreateThread, v))
+}
+
+// CanReply applies equality check predicate on the "can_reply" field. It's identical to CanReplyEQ.
+func CanReply(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanReply, v))
+}
+
+// CanAttach applies equality check predicate on the "can_attach" field. It's identical to CanAttachEQ.
+func CanAttach(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanAttach, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// CategoryIDContains applies the Contains predicate on the "category_id" field.
+func CategoryIDContains(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldContains(FieldCategoryID, vc))
+}
+
+// CategoryIDHasPrefix applies the HasPrefix predicate on the "category_id" field.
+func CategoryIDHasPrefix(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldHasPrefix(FieldCategoryID, vc))
+}
+
+// CategoryIDHasSuffix applies the HasSuffix predicate on the "category_id" field.
+func CategoryIDHasSuffix(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldHasSuffix(FieldCategoryID, vc))
+}
+
+// CategoryIDEqualFold applies the EqualFold predicate on the "category_id" field.
+func CategoryIDEqualFold(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldEqualFold(FieldCategoryID, vc))
+}
+
+// CategoryIDContainsFold applies the ContainsFold predicate on the "category_id" field.
+func CategoryIDContainsFold(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldContainsFold(FieldCategoryID, vc))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// RoleIDGT applies the GT predicate on the "role_id" field.
+func RoleIDGT(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldRoleID, v))
+}
+
+// RoleIDGTE applies the GTE predicate on the "role_id" field.
+func RoleIDGTE(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldRoleID, v))
+}
+
+// RoleIDLT applies the LT predicate on the "role_id" field.
+func RoleIDLT(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldRoleID, v))
+}
+
+// RoleIDLTE applies the LTE predicate on the "role_id" field.
+func RoleIDLTE(v xid.ID) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldRoleID, v))
+}
+
+// RoleIDContains applies the Contains predicate on the "role_id" field.
+func RoleIDContains(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldContains(FieldRoleID, vc))
+}
+
+// RoleIDHasPrefix applies the HasPrefix predicate on the "role_id" field.
+func RoleIDHasPrefix(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldHasPrefix(FieldRoleID, vc))
+}
+
+// RoleIDHasSuffix applies the HasSuffix predicate on the "role_id" field.
+func RoleIDHasSuffix(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldHasSuffix(FieldRoleID, vc))
+}
+
+// RoleIDEqualFold applies the EqualFold predicate on the "role_id" field.
+func RoleIDEqualFold(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldEqualFold(FieldRoleID, vc))
+}
+
+// RoleIDContainsFold applies the ContainsFold predicate on the "role_id" field.
+func RoleIDContainsFold(v xid.ID) predicate.CategoryPermission {
+	vc := v.String()
+	return predicate.CategoryPermission(sql.FieldContainsFold(FieldRoleID, vc))
+}
+
+// CanReadEQ applies the EQ predicate on the "can_read" field.
+func CanReadEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanRead, v))
+}
+
+// CanReadNEQ applies the NEQ predicate on the "can_read" field.
+func CanReadNEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCanRead, v))
+}
+
+// CanCreateThreadEQ applies the EQ predicate on the "can_create_thread" field.
+func CanCreateThreadEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanCreateThread, v))
+}
+
+// CanCreateThreadNEQ applies the NEQ predicate on the "can_create_thread" field.
+func CanCreateThreadNEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCanCreateThread, v))
+}
+
+// CanReplyEQ applies the EQ predicate on the "can_reply" field.
+func CanReplyEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanReply, v))
+}
+
+// CanReplyNEQ applies the NEQ predicate on the "can_reply" field.
+func CanReplyNEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCanReply, v))
+}
+
+// CanAttachEQ applies the EQ predicate on the "can_attach" field.
+func CanAttachEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCanAttach, v))
+}
+
+// CanAttachNEQ applies the NEQ predicate on the "can_attach" field.
+func CanAttachNEQ(v bool) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCanAttach, v))
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.CategoryPermission {
+	return predicate.CategoryPermission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.CategoryPermission {
+	return predicate.CategoryPermission(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryPermission) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryPermission) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryPermission) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.NotPredicates(p))
+}
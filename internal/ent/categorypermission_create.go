@@ -0,0 +1,945 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
+	"github.com/rs/xid"
+)
+
+// CategoryPermissionCreate is the builder for creating a CategoryPermission entity.
+type CategoryPermissionCreate struct {
+	config
+	mutation *CategoryPermissionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategoryPermissionCreate) SetCreatedAt(v time.Time) *CategoryPermissionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableCreatedAt(v *time.Time) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CategoryPermissionCreate) SetUpdatedAt(v time.Time) *CategoryPermissionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableUpdatedAt(v *time.Time) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *CategoryPermissionCreate) SetCategoryID(v xid.ID) *CategoryPermissionCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetRoleID sets the "role_id" field.
+func (_c *CategoryPermissionCreate) SetRoleID(v xid.ID) *CategoryPermissionCreate {
+	_c.mutation.SetRoleID(v)
+	return _c
+}
+
+// SetCanRead sets the "can_read" field.
+func (_c *CategoryPermissionCreate) SetCanRead(v bool) *CategoryPermissionCreate {
+	_c.mutation.SetCanRead(v)
+	return _c
+}
+
+// SetNillableCanRead sets the "can_read" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableCanRead(v *bool) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetCanRead(*v)
+	}
+	return _c
+}
+
+// SetCanCreateThread sets the "can_create_thread" field.
+func (_c *CategoryPermissionCreate) SetCanCreateThread(v bool) *CategoryPermissionCreate {
+	_c.mutation.SetCanCreateThread(v)
+	return _c
+}
+
+// SetNillableCanCreateThread sets the "can_create_thread" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableCanCreateThread(v *bool) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetCanCreateThread(*v)
+	}
+	return _c
+}
+
+// SetCanReply sets the "can_reply" field.
+func (_c *CategoryPermissionCreate) SetCanReply(v bool) *CategoryPermissionCreate {
+	_c.mutation.SetCanReply(v)
+	return _c
+}
+
+// SetNillableCanReply sets the "can_reply" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableCanReply(v *bool) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetCanReply(*v)
+	}
+	return _c
+}
+
+// SetCanAttach sets the "can_attach" field.
+func (_c *CategoryPermissionCreate) SetCanAttach(v bool) *CategoryPermissionCreate {
+	_c.mutation.SetCanAttach(v)
+	return _c
+}
+
+// SetNillableCanAttach sets the "can_attach" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableCanAttach(v *bool) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetCanAttach(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategoryPermissionCreate) SetID(v xid.ID) *CategoryPermissionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableID(v *xid.ID) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *CategoryPermissionCreate) SetCategory(v *Category) *CategoryPermissionCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryPermissionMutation object of the builder.
+func (_c *CategoryPermissionCreate) Mutation() *CategoryPermissionMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryPermission in the database.
+func (_c *CategoryPermissionCreate) Save(ctx context.Context) (*CategoryPermission, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryPermissionCreate) SaveX(ctx context.Context) *CategoryPermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryPermissionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryPermissionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategoryPermissionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categorypermission.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := categorypermission.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CanRead(); !ok {
+		v := categorypermission.DefaultCanRead
+		_c.mutation.SetCanRead(v)
+	}
+	if _, ok := _c.mutation.CanCreateThread(); !ok {
+		v := categorypermission.DefaultCanCreateThread
+		_c.mutation.SetCanCreateThread(v)
+	}
+	if _, ok := _c.mutation.CanReply(); !ok {
+		v := categorypermission.DefaultCanReply
+		_c.mutation.SetCanReply(v)
+	}
+	if _, ok := _c.mutation.CanAttach(); !ok {
+		v := categorypermission.DefaultCanAttach
+		_c.mutation.SetCanAttach(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := categorypermission.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryPermissionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategoryPermission.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CategoryPermission.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "CategoryPermission.category_id"`)}
+	}
+	if _, ok := _c.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "CategoryPermission.role_id"`)}
+	}
+	if _, ok := _c.mutation.CanRead(); !ok {
+		return &ValidationError{Name: "can_read", err: errors.New(`ent: missing required field "CategoryPermission.can_read"`)}
+	}
+	if _, ok := _c.mutation.CanCreateThread(); !ok {
+		return &ValidationError{Name: "can_create_thread", err: errors.New(`ent: missing required field "CategoryPermission.can_create_thread"`)}
+	}
+	if _, ok := _c.mutation.CanReply(); !ok {
+		return &ValidationError{Name: "can_reply", err: errors.New(`ent: missing required field "CategoryPermission.can_reply"`)}
+	}
+	if _, ok := _c.mutation.CanAttach(); !ok {
+		return &ValidationError{Name: "can_attach", err: errors.New(`ent: missing required field "CategoryPermission.can_attach"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := categorypermission.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CategoryIDs()) == 0 {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required edge "CategoryPermission.category"`)}
+	}
+	return nil
+}
+
+func (_c *CategoryPermissionCreate) sqlSave(ctx context.Context) (*CategoryPermission, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryPermissionCreate) createSpec() (*CategoryPermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryPermission{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categorypermission.Table, sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categorypermission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorypermission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.RoleID(); ok {
+		_spec.SetField(categorypermission.FieldRoleID, field.TypeString, value)
+		_node.RoleID = value
+	}
+	if value, ok := _c.mutation.CanRead(); ok {
+		_spec.SetField(categorypermission.FieldCanRead, field.TypeBool, value)
+		_node.CanRead = value
+	}
+	if value, ok := _c.mutation.CanCreateThread(); ok {
+		_spec.SetField(categorypermission.FieldCanCreateThread, field.TypeBool, value)
+		_node.CanCreateThread = value
+	}
+	if value, ok := _c.mutation.CanReply(); ok {
+		_spec.SetField(categorypermission.FieldCanReply, field.TypeBool, value)
+		_node.CanReply = value
+	}
+	if value, ok := _c.mutation.CanAttach(); ok {
+		_spec.SetField(categorypermission.FieldCanAttach, field.TypeBool, value)
+		_node.CanAttach = value
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorypermission.CategoryTable,
+			Columns: []string{categorypermission.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryPermission.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryPermissionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryPermissionCreate) OnConflict(opts ...sql.ConflictOption) *CategoryPermissionUpsertOne {
+	_c.conflict = opts
+	return &CategoryPermissionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryPermission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryPermissionCreate) OnConflictColumns(columns ...string) *CategoryPermissionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryPermissionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryPermissionUpsertOne is the builder for "upsert"-ing
+	//  one CategoryPermission node.
+	CategoryPermissionUpsertOne struct {
+		create *CategoryPermissionCreate
+	}
+
+	// CategoryPermissionUpsert is the "OnConflict" setter.
+	CategoryPermissionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryPermissionUpsert) SetUpdatedAt(v time.Time) *CategoryPermissionUpsert {
+	u.Set(categorypermission.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryPermissionUpsert) UpdateUpdatedAt() *CategoryPermissionUpsert {
+	u.SetExcluded(categorypermission.FieldUpdatedAt)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryPermissionUpsert) SetCategoryID(v xid.ID) *CategoryPermissionUpsert {
+	u.Set(categorypermission.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryPermissionUpsert) UpdateCategoryID() *CategoryPermissionUpsert {
+	u.SetExcluded(categorypermission.FieldCategoryID)
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *CategoryPermissionUpsert) SetRoleID(v xid.ID) *CategoryPermissionUpsert {
+	u.Set(categorypermission.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *CategoryPermissionUpsert) UpdateRoleID() *CategoryPermissionUpsert {
+	u.SetExcluded(categorypermission.FieldRoleID)
+	return u
+}
+
+// SetCanRead sets the "can_read" field.
+func (u *CategoryPermissionUpsert) SetCanRead(v bool) *CategoryPermissionUpsert {
+	u.Set(categorypermission.FieldCanRead, v)
+	return u
+}
+
+// UpdateCanRead sets the "can_read" field to the value that was provided on create.
+func (u *CategoryPermissionUpsert) UpdateCanRead() *CategoryPermissionUpsert {
+	u.SetExcluded(categorypermission.FieldCanRead)
+	return u
+}
+
+// SetCanCreateThread sets the "can_create_thread" field.
+func (u *CategoryPermissionUpsert) SetCanCreateThread(v bool) *CategoryPermissionUpsert {
+	u.Set(categorypermission.FieldCanCreateThread, v)
+	return u
+}
+
+// UpdateCanCreateThread sets the "can_create_thread" field to the value that was provided on create.
+func (u *CategoryPermissionUpsert) UpdateCanCreateThread() *CategoryPermissionUpsert {
+	u.SetExcluded(categorypermission.FieldCanCreateThread)
+	return u
+}
+
+// SetCanReply sets the "can_reply" field.
+func (u *CategoryPermissionUpsert) SetCanReply(v bool) *CategoryPermissionUpsert {
+	u.Set(categorypermission.FieldCanReply, v)
+	return u
+}
+
+// UpdateCanReply sets the "can_reply" field to the value that was provided on create.
+func (u *CategoryPermissionUpsert) UpdateCanReply() *CategoryPermissionUpsert {
+	u.SetExcluded(categorypermission.FieldCanReply)
+	return u
+}
+
+// SetCanAttach sets the "can_attach" field.
+func (u *CategoryPermissionUpsert) SetCanAttach(v bool) *CategoryPermissionUpsert {
+	u.Set(categorypermission.FieldCanAttach, v)
+	return u
+}
+
+// UpdateCanAttach sets the "can_attach" field to the value that was provided on create.
+func (u *CategoryPermissionUpsert) UpdateCanAttach() *CategoryPermissionUpsert {
+	u.SetExcluded(categorypermission.FieldCanAttach)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategoryPermission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorypermission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryPermissionUpsertOne) UpdateNewValues() *CategoryPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categorypermission.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(categorypermission.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryPermission.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryPermissionUpsertOne) Ignore() *CategoryPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryPermissionUpsertOne) DoNothing() *CategoryPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryPermissionCreate.OnConflict
+// documentation for more info.
+func (u *CategoryPermissionUpsertOne) Update(set func(*CategoryPermissionUpsert)) *CategoryPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryPermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryPermissionUpsertOne) SetUpdatedAt(v time.Time) *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertOne) UpdateUpdatedAt() *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryPermissionUpsertOne) SetCategoryID(v xid.ID) *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertOne) UpdateCategoryID() *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *CategoryPermissionUpsertOne) SetRoleID(v xid.ID) *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertOne) UpdateRoleID() *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetCanRead sets the "can_read" field.
+func (u *CategoryPermissionUpsertOne) SetCanRead(v bool) *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanRead(v)
+	})
+}
+
+// UpdateCanRead sets the "can_read" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertOne) UpdateCanRead() *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanRead()
+	})
+}
+
+// SetCanCreateThread sets the "can_create_thread" field.
+func (u *CategoryPermissionUpsertOne) SetCanCreateThread(v bool) *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanCreateThread(v)
+	})
+}
+
+// UpdateCanCreateThread sets the "can_create_thread" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertOne) UpdateCanCreateThread() *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanCreateThread()
+	})
+}
+
+// SetCanReply sets the "can_reply" field.
+func (u *CategoryPermissionUpsertOne) SetCanReply(v bool) *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanReply(v)
+	})
+}
+
+// UpdateCanReply sets the "can_reply" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertOne) UpdateCanReply() *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanReply()
+	})
+}
+
+// SetCanAttach sets the "can_attach" field.
+func (u *CategoryPermissionUpsertOne) SetCanAttach(v bool) *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanAttach(v)
+	})
+}
+
+// UpdateCanAttach sets the "can_attach" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertOne) UpdateCanAttach() *CategoryPermissionUpsertOne {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanAttach()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryPermissionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryPermissionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryPermissionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryPermissionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryPermissionUpsertOne.ID is not supported by MySQL driver. Use CategoryPermissionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryPermissionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryPermissionCreateBulk is the builder for creating many CategoryPermission entities in bulk.
+type CategoryPermissionCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryPermissionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryPermission entities in the database.
+func (_c *CategoryPermissionCreateBulk) Save(ctx context.Context) ([]*CategoryPermission, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryPermission, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryPermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryPermissionCreateBulk) SaveX(ctx context.Context) []*CategoryPermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryPermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryPermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryPermission.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryPermissionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryPermissionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryPermissionUpsertBulk {
+	_c.conflict = opts
+	return &CategoryPermissionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryPermission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryPermissionCreateBulk) OnConflictColumns(columns ...string) *CategoryPermissionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryPermissionUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryPermissionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryPermission nodes.
+type CategoryPermissionUpsertBulk struct {
+	create *CategoryPermissionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryPermission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorypermission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryPermissionUpsertBulk) UpdateNewValues() *CategoryPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categorypermission.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(categorypermission.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryPermission.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryPermissionUpsertBulk) Ignore() *CategoryPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryPermissionUpsertBulk) DoNothing() *CategoryPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryPermissionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryPermissionUpsertBulk) Update(set func(*CategoryPermissionUpsert)) *CategoryPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryPermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryPermissionUpsertBulk) SetUpdatedAt(v time.Time) *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertBulk) UpdateUpdatedAt() *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryPermissionUpsertBulk) SetCategoryID(v xid.ID) *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertBulk) UpdateCategoryID() *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *CategoryPermissionUpsertBulk) SetRoleID(v xid.ID) *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertBulk) UpdateRoleID() *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetCanRead sets the "can_read" field.
+func (u *CategoryPermissionUpsertBulk) SetCanRead(v bool) *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanRead(v)
+	})
+}
+
+// UpdateCanRead sets the "can_read" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertBulk) UpdateCanRead() *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanRead()
+	})
+}
+
+// SetCanCreateThread sets the "can_create_thread" field.
+func (u *CategoryPermissionUpsertBulk) SetCanCreateThread(v bool) *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanCreateThread(v)
+	})
+}
+
+// UpdateCanCreateThread sets the "can_create_thread" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertBulk) UpdateCanCreateThread() *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanCreateThread()
+	})
+}
+
+// SetCanReply sets the "can_reply" field.
+func (u *CategoryPermissionUpsertBulk) SetCanReply(v bool) *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanReply(v)
+	})
+}
+
+// UpdateCanReply sets the "can_reply" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertBulk) UpdateCanReply() *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanReply()
+	})
+}
+
+// SetCanAttach sets the "can_attach" field.
+func (u *CategoryPermissionUpsertBulk) SetCanAttach(v bool) *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.SetCanAttach(v)
+	})
+}
+
+// UpdateCanAttach sets the "can_attach" field to the value that was provided on create.
+func (u *CategoryPermissionUpsertBulk) UpdateCanAttach() *CategoryPermissionUpsertBulk {
+	return u.Update(func(s *CategoryPermissionUpsert) {
+		s.UpdateCanAttach()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryPermissionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryPermissionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryPermissionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryPermissionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// CategoryPermissionUpdate is the builder for updating CategoryPermission entities.
+type CategoryPermissionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CategoryPermissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CategoryPermissionUpdate builder.
+func (_u *CategoryPermissionUpdate) Where(ps ...predicate.CategoryPermission) *CategoryPermissionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryPermissionUpdate) SetUpdatedAt(v time.Time) *CategoryPermissionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryPermissionUpdate) SetCategoryID(v xid.ID) *CategoryPermissionUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableCategoryID(v *xid.ID) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *CategoryPermissionUpdate) SetRoleID(v xid.ID) *CategoryPermissionUpdate {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableRoleID(v *xid.ID) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetCanRead sets the "can_read" field.
+func (_u *CategoryPermissionUpdate) SetCanRead(v bool) *CategoryPermissionUpdate {
+	_u.mutation.SetCanRead(v)
+	return _u
+}
+
+// SetNillableCanRead sets the "can_read" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableCanRead(v *bool) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetCanRead(*v)
+	}
+	return _u
+}
+
+// SetCanCreateThread sets the "can_create_thread" field.
+func (_u *CategoryPermissionUpdate) SetCanCreateThread(v bool) *CategoryPermissionUpdate {
+	_u.mutation.SetCanCreateThread(v)
+	return _u
+}
+
+// SetNillableCanCreateThread sets the "can_create_thread" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableCanCreateThread(v *bool) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetCanCreateThread(*v)
+	}
+	return _u
+}
+
+// SetCanReply sets the "can_reply" field.
+func (_u *CategoryPermissionUpdate) SetCanReply(v bool) *CategoryPermissionUpdate {
+	_u.mutation.SetCanReply(v)
+	return _u
+}
+
+// SetNillableCanReply sets the "can_reply" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableCanReply(v *bool) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetCanReply(*v)
+	}
+	return _u
+}
+
+// SetCanAttach sets the "can_attach" field.
+func (_u *CategoryPermissionUpdate) SetCanAttach(v bool) *CategoryPermissionUpdate {
+	_u.mutation.SetCanAttach(v)
+	return _u
+}
+
+// SetNillableCanAttach sets the "can_attach" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableCanAttach(v *bool) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetCanAttach(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CategoryPermissionUpdate) SetCategory(v *Category) *CategoryPermissionUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryPermissionMutation object of the builder.
+func (_u *CategoryPermissionUpdate) Mutation() *CategoryPermissionMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CategoryPermissionUpdate) ClearCategory() *CategoryPermissionUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryPermissionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryPermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryPermissionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryPermissionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryPermissionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorypermission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryPermissionUpdate) check() error {
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryPermission.category"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryPermissionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryPermissionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryPermissionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorypermission.Table, categorypermission.Columns, sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorypermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RoleID(); ok {
+		_spec.SetField(categorypermission.FieldRoleID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CanRead(); ok {
+		_spec.SetField(categorypermission.FieldCanRead, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CanCreateThread(); ok {
+		_spec.SetField(categorypermission.FieldCanCreateThread, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CanReply(); ok {
+		_spec.SetField(categorypermission.FieldCanReply, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CanAttach(); ok {
+		_spec.SetField(categorypermission.FieldCanAttach, field.TypeBool, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorypermission.CategoryTable,
+			Columns: []string{categorypermission.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorypermission.CategoryTable,
+			Columns: []string{categorypermission.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorypermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryPermissionUpdateOne is the builder for updating a single CategoryPermission entity.
+type CategoryPermissionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CategoryPermissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryPermissionUpdateOne) SetUpdatedAt(v time.Time) *CategoryPermissionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryPermissionUpdateOne) SetCategoryID(v xid.ID) *CategoryPermissionUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableCategoryID(v *xid.ID) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *CategoryPermissionUpdateOne) SetRoleID(v xid.ID) *CategoryPermissionUpdateOne {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableRoleID(v *xid.ID) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetCanRead sets the "can_read" field.
+func (_u *CategoryPermissionUpdateOne) SetCanRead(v bool) *CategoryPermissionUpdateOne {
+	_u.mutation.SetCanRead(v)
+	return _u
+}
+
+// SetNillableCanRead sets the "can_read" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableCanRead(v *bool) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetCanRead(*v)
+	}
+	return _u
+}
+
+// SetCanCreateThread sets the "can_create_thread" field.
+func (_u *CategoryPermissionUpdateOne) SetCanCreateThread(v bool) *CategoryPermissionUpdateOne {
+	_u.mutation.SetCanCreateThread(v)
+	return _u
+}
+
+// SetNillableCanCreateThread sets the "can_create_thread" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableCanCreateThread(v *bool) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetCanCreateThread(*v)
+	}
+	return _u
+}
+
+// SetCanReply sets the "can_reply" field.
+func (_u *CategoryPermissionUpdateOne) SetCanReply(v bool) *CategoryPermissionUpdateOne {
+	_u.mutation.SetCanReply(v)
+	return _u
+}
+
+// SetNillableCanReply sets the "can_reply" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableCanReply(v *bool) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetCanReply(*v)
+	}
+	return _u
+}
+
+// SetCanAttach sets the "can_attach" field.
+func (_u *CategoryPermissionUpdateOne) SetCanAttach(v bool) *CategoryPermissionUpdateOne {
+	_u.mutation.SetCanAttach(v)
+	return _u
+}
+
+// SetNillableCanAttach sets the "can_attach" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableCanAttach(v *bool) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetCanAttach(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CategoryPermissionUpdateOne) SetCategory(v *Category) *CategoryPermissionUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryPermissionMutation object of the builder.
+func (_u *CategoryPermissionUpdateOne) Mutation() *CategoryPermissionMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CategoryPermissionUpdateOne) ClearCategory() *CategoryPermissionUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the CategoryPermissionUpdate builder.
+func (_u *CategoryPermissionUpdateOne) Where(ps ...predicate.CategoryPermission) *CategoryPermissionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryPermissionUpdateOne) Select(field string, fields ...string) *CategoryPermissionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryPermission entity.
+func (_u *CategoryPermissionUpdateOne) Save(ctx context.Context) (*CategoryPermission, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryPermissionUpdateOne) SaveX(ctx context.Context) *CategoryPermission {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryPermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryPermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryPermissionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorypermission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryPermissionUpdateOne) check() error {
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryPermission.category"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryPermissionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryPermissionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryPermissionUpdateOne) sqlSave(ctx context.Context) (_node *CategoryPermission, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorypermission.Table, categorypermission.Columns, sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryPermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorypermission.FieldID)
+		for _, f := range fields {
+			if !categorypermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorypermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorypermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RoleID(); ok {
+		_spec.SetField(categorypermission.FieldRoleID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CanRead(); ok {
+		_spec.SetField(categorypermission.FieldCanRead, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CanCreateThread(); ok {
+		_spec.SetField(categorypermission.FieldCanCreateThread, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CanReply(); ok {
+		_spec.SetField(categorypermission.FieldCanReply, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CanAttach(); ok {
+		_spec.SetField(categorypermission.FieldCanAttach, field.TypeBool, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorypermission.CategoryTable,
+			Columns: []string{categorypermission.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorypermission.CategoryTable,
+			Columns: []string{categorypermission.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CategoryPermission{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorypermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -17,35 +17,90 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/Southclaws/storyden/internal/ent/automodrule"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
 	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
+	"github.com/Southclaws/storyden/internal/ent/contentsummary"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
+	"github.com/Southclaws/storyden/internal/ent/featureflag"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+	"github.com/Southclaws/storyden/internal/ent/federationkey"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
+	"github.com/Southclaws/storyden/internal/ent/job"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
+	"github.com/Southclaws/storyden/internal/ent/networkban"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/property"
 	"github.com/Southclaws/storyden/internal/ent/propertyschema"
 	"github.com/Southclaws/storyden/internal/ent/propertyschemafield"
+	"github.com/Southclaws/storyden/internal/ent/pushsubscription"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
 	"github.com/Southclaws/storyden/internal/ent/report"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
 	"github.com/Southclaws/storyden/internal/ent/role"
+	"github.com/Southclaws/storyden/internal/ent/scheduledtask"
 	"github.com/Southclaws/storyden/internal/ent/session"
 	"github.com/Southclaws/storyden/internal/ent/setting"
+	"github.com/Southclaws/storyden/internal/ent/space"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
+	"github.com/Southclaws/storyden/internal/ent/tenant"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
+	"github.com/Southclaws/storyden/internal/ent/wordfilter"
 
 	stdsql "database/sql"
 )
@@ -55,42 +110,118 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIUsage is the client for interacting with the APIUsage builders.
+	APIUsage *APIUsageClient
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AccountApplication is the client for interacting with the AccountApplication builders.
+	AccountApplication *AccountApplicationClient
+	// AccountBadge is the client for interacting with the AccountBadge builders.
+	AccountBadge *AccountBadgeClient
+	// AccountBlock is the client for interacting with the AccountBlock builders.
+	AccountBlock *AccountBlockClient
 	// AccountFollow is the client for interacting with the AccountFollow builders.
 	AccountFollow *AccountFollowClient
+	// AccountOnboardingStep is the client for interacting with the AccountOnboardingStep builders.
+	AccountOnboardingStep *AccountOnboardingStepClient
 	// AccountRoles is the client for interacting with the AccountRoles builders.
 	AccountRoles *AccountRolesClient
+	// AnalyticsCohort is the client for interacting with the AnalyticsCohort builders.
+	AnalyticsCohort *AnalyticsCohortClient
+	// AnalyticsContent is the client for interacting with the AnalyticsContent builders.
+	AnalyticsContent *AnalyticsContentClient
+	// AnalyticsDay is the client for interacting with the AnalyticsDay builders.
+	AnalyticsDay *AnalyticsDayClient
+	// AnalyticsExport is the client for interacting with the AnalyticsExport builders.
+	AnalyticsExport *AnalyticsExportClient
 	// Asset is the client for interacting with the Asset builders.
 	Asset *AssetClient
 	// Authentication is the client for interacting with the Authentication builders.
 	Authentication *AuthenticationClient
+	// AutomodRule is the client for interacting with the AutomodRule builders.
+	AutomodRule *AutomodRuleClient
+	// Badge is the client for interacting with the Badge builders.
+	Badge *BadgeClient
+	// Bookmark is the client for interacting with the Bookmark builders.
+	Bookmark *BookmarkClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// CategoryFollow is the client for interacting with the CategoryFollow builders.
+	CategoryFollow *CategoryFollowClient
+	// CategoryPermission is the client for interacting with the CategoryPermission builders.
+	CategoryPermission *CategoryPermissionClient
 	// Collection is the client for interacting with the Collection builders.
 	Collection *CollectionClient
 	// CollectionNode is the client for interacting with the CollectionNode builders.
 	CollectionNode *CollectionNodeClient
 	// CollectionPost is the client for interacting with the CollectionPost builders.
 	CollectionPost *CollectionPostClient
+	// CollectionSection is the client for interacting with the CollectionSection builders.
+	CollectionSection *CollectionSectionClient
+	// CollectionShare is the client for interacting with the CollectionShare builders.
+	CollectionShare *CollectionShareClient
+	// ContentSummary is the client for interacting with the ContentSummary builders.
+	ContentSummary *ContentSummaryClient
+	// ContentView is the client for interacting with the ContentView builders.
+	ContentView *ContentViewClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// ConversationParticipant is the client for interacting with the ConversationParticipant builders.
+	ConversationParticipant *ConversationParticipantClient
+	// DigestSubscription is the client for interacting with the DigestSubscription builders.
+	DigestSubscription *DigestSubscriptionClient
+	// DirectMessage is the client for interacting with the DirectMessage builders.
+	DirectMessage *DirectMessageClient
+	// DiscordBridgeLink is the client for interacting with the DiscordBridgeLink builders.
+	DiscordBridgeLink *DiscordBridgeLinkClient
 	// Email is the client for interacting with the Email builders.
 	Email *EmailClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
 	// EventParticipant is the client for interacting with the EventParticipant builders.
 	EventParticipant *EventParticipantClient
+	// FeatureFlag is the client for interacting with the FeatureFlag builders.
+	FeatureFlag *FeatureFlagClient
+	// FederationFollower is the client for interacting with the FederationFollower builders.
+	FederationFollower *FederationFollowerClient
+	// FederationKey is the client for interacting with the FederationKey builders.
+	FederationKey *FederationKeyClient
+	// FeedToken is the client for interacting with the FeedToken builders.
+	FeedToken *FeedTokenClient
+	// ImportRecord is the client for interacting with the ImportRecord builders.
+	ImportRecord *ImportRecordClient
+	// ImportRun is the client for interacting with the ImportRun builders.
+	ImportRun *ImportRunClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// LikePost is the client for interacting with the LikePost builders.
 	LikePost *LikePostClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
+	// LinkCheck is the client for interacting with the LinkCheck builders.
+	LinkCheck *LinkCheckClient
+	// MatrixBridgeLink is the client for interacting with the MatrixBridgeLink builders.
+	MatrixBridgeLink *MatrixBridgeLinkClient
 	// MentionProfile is the client for interacting with the MentionProfile builders.
 	MentionProfile *MentionProfileClient
+	// ModerationNote is the client for interacting with the ModerationNote builders.
+	ModerationNote *ModerationNoteClient
+	// NetworkBan is the client for interacting with the NetworkBan builders.
+	NetworkBan *NetworkBanClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PolicyAcceptance is the client for interacting with the PolicyAcceptance builders.
+	PolicyAcceptance *PolicyAcceptanceClient
+	// PolicyDocument is the client for interacting with the PolicyDocument builders.
+	PolicyDocument *PolicyDocumentClient
+	// PolicyVersion is the client for interacting with the PolicyVersion builders.
+	PolicyVersion *PolicyVersionClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostRead is the client for interacting with the PostRead builders.
@@ -101,20 +232,54 @@ type Client struct {
 	PropertySchema *PropertySchemaClient
 	// PropertySchemaField is the client for interacting with the PropertySchemaField builders.
 	PropertySchemaField *PropertySchemaFieldClient
+	// PushSubscription is the client for interacting with the PushSubscription builders.
+	PushSubscription *PushSubscriptionClient
 	// Question is the client for interacting with the Question builders.
 	Question *QuestionClient
 	// React is the client for interacting with the React builders.
 	React *ReactClient
+	// ReadingHistory is the client for interacting with the ReadingHistory builders.
+	ReadingHistory *ReadingHistoryClient
 	// Report is the client for interacting with the Report builders.
 	Report *ReportClient
+	// ReputationEntry is the client for interacting with the ReputationEntry builders.
+	ReputationEntry *ReputationEntryClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// ScheduledTask is the client for interacting with the ScheduledTask builders.
+	ScheduledTask *ScheduledTaskClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// Space is the client for interacting with the Space builders.
+	Space *SpaceClient
+	// SpaceMember is the client for interacting with the SpaceMember builders.
+	SpaceMember *SpaceMemberClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagFollow is the client for interacting with the TagFollow builders.
+	TagFollow *TagFollowClient
+	// TagSynonym is the client for interacting with the TagSynonym builders.
+	TagSynonym *TagSynonymClient
+	// TelegramMessage is the client for interacting with the TelegramMessage builders.
+	TelegramMessage *TelegramMessageClient
+	// Tenant is the client for interacting with the Tenant builders.
+	Tenant *TenantClient
+	// TrendingScore is the client for interacting with the TrendingScore builders.
+	TrendingScore *TrendingScoreClient
+	// UploadSession is the client for interacting with the UploadSession builders.
+	UploadSession *UploadSessionClient
+	// VerificationRequest is the client for interacting with the VerificationRequest builders.
+	VerificationRequest *VerificationRequestClient
+	// Warning is the client for interacting with the Warning builders.
+	Warning *WarningClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WordFilter is the client for interacting with the WordFilter builders.
+	WordFilter *WordFilterClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -126,36 +291,91 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIUsage = NewAPIUsageClient(c.config)
 	c.Account = NewAccountClient(c.config)
+	c.AccountApplication = NewAccountApplicationClient(c.config)
+	c.AccountBadge = NewAccountBadgeClient(c.config)
+	c.AccountBlock = NewAccountBlockClient(c.config)
 	c.AccountFollow = NewAccountFollowClient(c.config)
+	c.AccountOnboardingStep = NewAccountOnboardingStepClient(c.config)
 	c.AccountRoles = NewAccountRolesClient(c.config)
+	c.AnalyticsCohort = NewAnalyticsCohortClient(c.config)
+	c.AnalyticsContent = NewAnalyticsContentClient(c.config)
+	c.AnalyticsDay = NewAnalyticsDayClient(c.config)
+	c.AnalyticsExport = NewAnalyticsExportClient(c.config)
 	c.Asset = NewAssetClient(c.config)
 	c.Authentication = NewAuthenticationClient(c.config)
+	c.AutomodRule = NewAutomodRuleClient(c.config)
+	c.Badge = NewBadgeClient(c.config)
+	c.Bookmark = NewBookmarkClient(c.config)
 	c.Category = NewCategoryClient(c.config)
+	c.CategoryFollow = NewCategoryFollowClient(c.config)
+	c.CategoryPermission = NewCategoryPermissionClient(c.config)
 	c.Collection = NewCollectionClient(c.config)
 	c.CollectionNode = NewCollectionNodeClient(c.config)
 	c.CollectionPost = NewCollectionPostClient(c.config)
+	c.CollectionSection = NewCollectionSectionClient(c.config)
+	c.CollectionShare = NewCollectionShareClient(c.config)
+	c.ContentSummary = NewContentSummaryClient(c.config)
+	c.ContentView = NewContentViewClient(c.config)
+	c.Conversation = NewConversationClient(c.config)
+	c.ConversationParticipant = NewConversationParticipantClient(c.config)
+	c.DigestSubscription = NewDigestSubscriptionClient(c.config)
+	c.DirectMessage = NewDirectMessageClient(c.config)
+	c.DiscordBridgeLink = NewDiscordBridgeLinkClient(c.config)
 	c.Email = NewEmailClient(c.config)
 	c.Event = NewEventClient(c.config)
 	c.EventParticipant = NewEventParticipantClient(c.config)
+	c.FeatureFlag = NewFeatureFlagClient(c.config)
+	c.FederationFollower = NewFederationFollowerClient(c.config)
+	c.FederationKey = NewFederationKeyClient(c.config)
+	c.FeedToken = NewFeedTokenClient(c.config)
+	c.ImportRecord = NewImportRecordClient(c.config)
+	c.ImportRun = NewImportRunClient(c.config)
 	c.Invitation = NewInvitationClient(c.config)
+	c.Job = NewJobClient(c.config)
 	c.LikePost = NewLikePostClient(c.config)
 	c.Link = NewLinkClient(c.config)
+	c.LinkCheck = NewLinkCheckClient(c.config)
+	c.MatrixBridgeLink = NewMatrixBridgeLinkClient(c.config)
 	c.MentionProfile = NewMentionProfileClient(c.config)
+	c.ModerationNote = NewModerationNoteClient(c.config)
+	c.NetworkBan = NewNetworkBanClient(c.config)
 	c.Node = NewNodeClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.PolicyAcceptance = NewPolicyAcceptanceClient(c.config)
+	c.PolicyDocument = NewPolicyDocumentClient(c.config)
+	c.PolicyVersion = NewPolicyVersionClient(c.config)
 	c.Post = NewPostClient(c.config)
 	c.PostRead = NewPostReadClient(c.config)
 	c.Property = NewPropertyClient(c.config)
 	c.PropertySchema = NewPropertySchemaClient(c.config)
 	c.PropertySchemaField = NewPropertySchemaFieldClient(c.config)
+	c.PushSubscription = NewPushSubscriptionClient(c.config)
 	c.Question = NewQuestionClient(c.config)
 	c.React = NewReactClient(c.config)
+	c.ReadingHistory = NewReadingHistoryClient(c.config)
 	c.Report = NewReportClient(c.config)
+	c.ReputationEntry = NewReputationEntryClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.ScheduledTask = NewScheduledTaskClient(c.config)
 	c.Session = NewSessionClient(c.config)
 	c.Setting = NewSettingClient(c.config)
+	c.Space = NewSpaceClient(c.config)
+	c.SpaceMember = NewSpaceMemberClient(c.config)
 	c.Tag = NewTagClient(c.config)
+	c.TagFollow = NewTagFollowClient(c.config)
+	c.TagSynonym = NewTagSynonymClient(c.config)
+	c.TelegramMessage = NewTelegramMessageClient(c.config)
+	c.Tenant = NewTenantClient(c.config)
+	c.TrendingScore = NewTrendingScoreClient(c.config)
+	c.UploadSession = NewUploadSessionClient(c.config)
+	c.VerificationRequest = NewVerificationRequestClient(c.config)
+	c.Warning = NewWarningClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WordFilter = NewWordFilterClient(c.config)
 }
 
 type (
@@ -246,38 +466,93 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		Account:             NewAccountClient(cfg),
-		AccountFollow:       NewAccountFollowClient(cfg),
-		AccountRoles:        NewAccountRolesClient(cfg),
-		Asset:               NewAssetClient(cfg),
-		Authentication:      NewAuthenticationClient(cfg),
-		Category:            NewCategoryClient(cfg),
-		Collection:          NewCollectionClient(cfg),
-		CollectionNode:      NewCollectionNodeClient(cfg),
-		CollectionPost:      NewCollectionPostClient(cfg),
-		Email:               NewEmailClient(cfg),
-		Event:               NewEventClient(cfg),
-		EventParticipant:    NewEventParticipantClient(cfg),
-		Invitation:          NewInvitationClient(cfg),
-		LikePost:            NewLikePostClient(cfg),
-		Link:                NewLinkClient(cfg),
-		MentionProfile:      NewMentionProfileClient(cfg),
-		Node:                NewNodeClient(cfg),
-		Notification:        NewNotificationClient(cfg),
-		Post:                NewPostClient(cfg),
-		PostRead:            NewPostReadClient(cfg),
-		Property:            NewPropertyClient(cfg),
-		PropertySchema:      NewPropertySchemaClient(cfg),
-		PropertySchemaField: NewPropertySchemaFieldClient(cfg),
-		Question:            NewQuestionClient(cfg),
-		React:               NewReactClient(cfg),
-		Report:              NewReportClient(cfg),
-		Role:                NewRoleClient(cfg),
-		Session:             NewSessionClient(cfg),
-		Setting:             NewSettingClient(cfg),
-		Tag:                 NewTagClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		APIUsage:                NewAPIUsageClient(cfg),
+		Account:                 NewAccountClient(cfg),
+		AccountApplication:      NewAccountApplicationClient(cfg),
+		AccountBadge:            NewAccountBadgeClient(cfg),
+		AccountBlock:            NewAccountBlockClient(cfg),
+		AccountFollow:           NewAccountFollowClient(cfg),
+		AccountOnboardingStep:   NewAccountOnboardingStepClient(cfg),
+		AccountRoles:            NewAccountRolesClient(cfg),
+		AnalyticsCohort:         NewAnalyticsCohortClient(cfg),
+		AnalyticsContent:        NewAnalyticsContentClient(cfg),
+		AnalyticsDay:            NewAnalyticsDayClient(cfg),
+		AnalyticsExport:         NewAnalyticsExportClient(cfg),
+		Asset:                   NewAssetClient(cfg),
+		Authentication:          NewAuthenticationClient(cfg),
+		AutomodRule:             NewAutomodRuleClient(cfg),
+		Badge:                   NewBadgeClient(cfg),
+		Bookmark:                NewBookmarkClient(cfg),
+		Category:                NewCategoryClient(cfg),
+		CategoryFollow:          NewCategoryFollowClient(cfg),
+		CategoryPermission:      NewCategoryPermissionClient(cfg),
+		Collection:              NewCollectionClient(cfg),
+		CollectionNode:          NewCollectionNodeClient(cfg),
+		CollectionPost:          NewCollectionPostClient(cfg),
+		CollectionSection:       NewCollectionSectionClient(cfg),
+		CollectionShare:         NewCollectionShareClient(cfg),
+		ContentSummary:          NewContentSummaryClient(cfg),
+		ContentView:             NewContentViewClient(cfg),
+		Conversation:            NewConversationClient(cfg),
+		ConversationParticipant: NewConversationParticipantClient(cfg),
+		DigestSubscription:      NewDigestSubscriptionClient(cfg),
+		DirectMessage:           NewDirectMessageClient(cfg),
+		DiscordBridgeLink:       NewDiscordBridgeLinkClient(cfg),
+		Email:                   NewEmailClient(cfg),
+		Event:                   NewEventClient(cfg),
+		EventParticipant:        NewEventParticipantClient(cfg),
+		FeatureFlag:             NewFeatureFlagClient(cfg),
+		FederationFollower:      NewFederationFollowerClient(cfg),
+		FederationKey:           NewFederationKeyClient(cfg),
+		FeedToken:               NewFeedTokenClient(cfg),
+		ImportRecord:            NewImportRecordClient(cfg),
+		ImportRun:               NewImportRunClient(cfg),
+		Invitation:              NewInvitationClient(cfg),
+		Job:                     NewJobClient(cfg),
+		LikePost:                NewLikePostClient(cfg),
+		Link:                    NewLinkClient(cfg),
+		LinkCheck:               NewLinkCheckClient(cfg),
+		MatrixBridgeLink:        NewMatrixBridgeLinkClient(cfg),
+		MentionProfile:          NewMentionProfileClient(cfg),
+		ModerationNote:          NewModerationNoteClient(cfg),
+		NetworkBan:              NewNetworkBanClient(cfg),
+		Node:                    NewNodeClient(cfg),
+		Notification:            NewNotificationClient(cfg),
+		NotificationPreference:  NewNotificationPreferenceClient(cfg),
+		PolicyAcceptance:        NewPolicyAcceptanceClient(cfg),
+		PolicyDocument:          NewPolicyDocumentClient(cfg),
+		PolicyVersion:           NewPolicyVersionClient(cfg),
+		Post:                    NewPostClient(cfg),
+		PostRead:                NewPostReadClient(cfg),
+		Property:                NewPropertyClient(cfg),
+		PropertySchema:          NewPropertySchemaClient(cfg),
+		PropertySchemaField:     NewPropertySchemaFieldClient(cfg),
+		PushSubscription:        NewPushSubscriptionClient(cfg),
+		Question:                NewQuestionClient(cfg),
+		React:                   NewReactClient(cfg),
+		ReadingHistory:          NewReadingHistoryClient(cfg),
+		Report:                  NewReportClient(cfg),
+		ReputationEntry:         NewReputationEntryClient(cfg),
+		Role:                    NewRoleClient(cfg),
+		ScheduledTask:           NewScheduledTaskClient(cfg),
+		Session:                 NewSessionClient(cfg),
+		Setting:                 NewSettingClient(cfg),
+		Space:                   NewSpaceClient(cfg),
+		SpaceMember:             NewSpaceMemberClient(cfg),
+		Tag:                     NewTagClient(cfg),
+		TagFollow:               NewTagFollowClient(cfg),
+		TagSynonym:              NewTagSynonymClient(cfg),
+		TelegramMessage:         NewTelegramMessageClient(cfg),
+		Tenant:                  NewTenantClient(cfg),
+		TrendingScore:           NewTrendingScoreClient(cfg),
+		UploadSession:           NewUploadSessionClient(cfg),
+		VerificationRequest:     NewVerificationRequestClient(cfg),
+		Warning:                 NewWarningClient(cfg),
+		Webhook:                 NewWebhookClient(cfg),
+		WebhookDelivery:         NewWebhookDeliveryClient(cfg),
+		WordFilter:              NewWordFilterClient(cfg),
 	}, nil
 }
 
@@ -295,45 +570,100 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		Account:             NewAccountClient(cfg),
-		AccountFollow:       NewAccountFollowClient(cfg),
-		AccountRoles:        NewAccountRolesClient(cfg),
-		Asset:               NewAssetClient(cfg),
-		Authentication:      NewAuthenticationClient(cfg),
-		Category:            NewCategoryClient(cfg),
-		Collection:          NewCollectionClient(cfg),
-		CollectionNode:      NewCollectionNodeClient(cfg),
-		CollectionPost:      NewCollectionPostClient(cfg),
-		Email:               NewEmailClient(cfg),
-		Event:               NewEventClient(cfg),
-		EventParticipant:    NewEventParticipantClient(cfg),
-		Invitation:          NewInvitationClient(cfg),
-		LikePost:            NewLikePostClient(cfg),
-		Link:                NewLinkClient(cfg),
-		MentionProfile:      NewMentionProfileClient(cfg),
-		Node:                NewNodeClient(cfg),
-		Notification:        NewNotificationClient(cfg),
-		Post:                NewPostClient(cfg),
-		PostRead:            NewPostReadClient(cfg),
-		Property:            NewPropertyClient(cfg),
-		PropertySchema:      NewPropertySchemaClient(cfg),
-		PropertySchemaField: NewPropertySchemaFieldClient(cfg),
-		Question:            NewQuestionClient(cfg),
-		React:               NewReactClient(cfg),
-		Report:              NewReportClient(cfg),
-		Role:                NewRoleClient(cfg),
-		Session:             NewSessionClient(cfg),
-		Setting:             NewSettingClient(cfg),
-		Tag:                 NewTagClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		APIUsage:                NewAPIUsageClient(cfg),
+		Account:                 NewAccountClient(cfg),
+		AccountApplication:      NewAccountApplicationClient(cfg),
+		AccountBadge:            NewAccountBadgeClient(cfg),
+		AccountBlock:            NewAccountBlockClient(cfg),
+		AccountFollow:           NewAccountFollowClient(cfg),
+		AccountOnboardingStep:   NewAccountOnboardingStepClient(cfg),
+		AccountRoles:            NewAccountRolesClient(cfg),
+		AnalyticsCohort:         NewAnalyticsCohortClient(cfg),
+		AnalyticsContent:        NewAnalyticsContentClient(cfg),
+		AnalyticsDay:            NewAnalyticsDayClient(cfg),
+		AnalyticsExport:         NewAnalyticsExportClient(cfg),
+		Asset:                   NewAssetClient(cfg),
+		Authentication:          NewAuthenticationClient(cfg),
+		AutomodRule:             NewAutomodRuleClient(cfg),
+		Badge:                   NewBadgeClient(cfg),
+		Bookmark:                NewBookmarkClient(cfg),
+		Category:                NewCategoryClient(cfg),
+		CategoryFollow:          NewCategoryFollowClient(cfg),
+		CategoryPermission:      NewCategoryPermissionClient(cfg),
+		Collection:              NewCollectionClient(cfg),
+		CollectionNode:          NewCollectionNodeClient(cfg),
+		CollectionPost:          NewCollectionPostClient(cfg),
+		CollectionSection:       NewCollectionSectionClient(cfg),
+		CollectionShare:         NewCollectionShareClient(cfg),
+		ContentSummary:          NewContentSummaryClient(cfg),
+		ContentView:             NewContentViewClient(cfg),
+		Conversation:            NewConversationClient(cfg),
+		ConversationParticipant: NewConversationParticipantClient(cfg),
+		DigestSubscription:      NewDigestSubscriptionClient(cfg),
+		DirectMessage:           NewDirectMessageClient(cfg),
+		DiscordBridgeLink:       NewDiscordBridgeLinkClient(cfg),
+		Email:                   NewEmailClient(cfg),
+		Event:                   NewEventClient(cfg),
+		EventParticipant:        NewEventParticipantClient(cfg),
+		FeatureFlag:             NewFeatureFlagClient(cfg),
+		FederationFollower:      NewFederationFollowerClient(cfg),
+		FederationKey:           NewFederationKeyClient(cfg),
+		FeedToken:               NewFeedTokenClient(cfg),
+		ImportRecord:            NewImportRecordClient(cfg),
+		ImportRun:               NewImportRunClient(cfg),
+		Invitation:              NewInvitationClient(cfg),
+		Job:                     NewJobClient(cfg),
+		LikePost:                NewLikePostClient(cfg),
+		Link:                    NewLinkClient(cfg),
+		LinkCheck:               NewLinkCheckClient(cfg),
+		MatrixBridgeLink:        NewMatrixBridgeLinkClient(cfg),
+		MentionProfile:          NewMentionProfileClient(cfg),
+		ModerationNote:          NewModerationNoteClient(cfg),
+		NetworkBan:              NewNetworkBanClient(cfg),
+		Node:                    NewNodeClient(cfg),
+		Notification:            NewNotificationClient(cfg),
+		NotificationPreference:  NewNotificationPreferenceClient(cfg),
+		PolicyAcceptance:        NewPolicyAcceptanceClient(cfg),
+		PolicyDocument:          NewPolicyDocumentClient(cfg),
+		PolicyVersion:           NewPolicyVersionClient(cfg),
+		Post:                    NewPostClient(cfg),
+		PostRead:                NewPostReadClient(cfg),
+		Property:                NewPropertyClient(cfg),
+		PropertySchema:          NewPropertySchemaClient(cfg),
+		PropertySchemaField:     NewPropertySchemaFieldClient(cfg),
+		PushSubscription:        NewPushSubscriptionClient(cfg),
+		Question:                NewQuestionClient(cfg),
+		React:                   NewReactClient(cfg),
+		ReadingHistory:          NewReadingHistoryClient(cfg),
+		Report:                  NewReportClient(cfg),
+		ReputationEntry:         NewReputationEntryClient(cfg),
+		Role:                    NewRoleClient(cfg),
+		ScheduledTask:           NewScheduledTaskClient(cfg),
+		Session:                 NewSessionClient(cfg),
+		Setting:                 NewSettingClient(cfg),
+		Space:                   NewSpaceClient(cfg),
+		SpaceMember:             NewSpaceMemberClient(cfg),
+		Tag:                     NewTagClient(cfg),
+		TagFollow:               NewTagFollowClient(cfg),
+		TagSynonym:              NewTagSynonymClient(cfg),
+		TelegramMessage:         NewTelegramMessageClient(cfg),
+		Tenant:                  NewTenantClient(cfg),
+		TrendingScore:           NewTrendingScoreClient(cfg),
+		UploadSession:           NewUploadSessionClient(cfg),
+		VerificationRequest:     NewVerificationRequestClient(cfg),
+		Warning:                 NewWarningClient(cfg),
+		Webhook:                 NewWebhookClient(cfg),
+		WebhookDelivery:         NewWebhookDeliveryClient(cfg),
+		WordFilter:              NewWordFilterClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Account.
+//		APIUsage.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -356,12 +686,25 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Account, c.AccountFollow, c.AccountRoles, c.Asset, c.Authentication,
-		c.Category, c.Collection, c.CollectionNode, c.CollectionPost, c.Email, c.Event,
-		c.EventParticipant, c.Invitation, c.LikePost, c.Link, c.MentionProfile, c.Node,
-		c.Notification, c.Post, c.PostRead, c.Property, c.PropertySchema,
-		c.PropertySchemaField, c.Question, c.React, c.Report, c.Role, c.Session,
-		c.Setting, c.Tag,
+		c.APIUsage, c.Account, c.AccountApplication, c.AccountBadge, c.AccountBlock,
+		c.AccountFollow, c.AccountOnboardingStep, c.AccountRoles, c.AnalyticsCohort,
+		c.AnalyticsContent, c.AnalyticsDay, c.AnalyticsExport, c.Asset,
+		c.Authentication, c.AutomodRule, c.Badge, c.Bookmark, c.Category,
+		c.CategoryFollow, c.CategoryPermission, c.Collection, c.CollectionNode,
+		c.CollectionPost, c.CollectionSection, c.CollectionShare, c.ContentSummary,
+		c.ContentView, c.Conversation, c.ConversationParticipant, c.DigestSubscription,
+		c.DirectMessage, c.DiscordBridgeLink, c.Email, c.Event, c.EventParticipant,
+		c.FeatureFlag, c.FederationFollower, c.FederationKey, c.FeedToken,
+		c.ImportRecord, c.ImportRun, c.Invitation, c.Job, c.LikePost, c.Link,
+		c.LinkCheck, c.MatrixBridgeLink, c.MentionProfile, c.ModerationNote,
+		c.NetworkBan, c.Node, c.Notification, c.NotificationPreference,
+		c.PolicyAcceptance, c.PolicyDocument, c.PolicyVersion, c.Post, c.PostRead,
+		c.Property, c.PropertySchema, c.PropertySchemaField, c.PushSubscription,
+		c.Question, c.React, c.ReadingHistory, c.Report, c.ReputationEntry, c.Role,
+		c.ScheduledTask, c.Session, c.Setting, c.Space, c.SpaceMember, c.Tag,
+		c.TagFollow, c.TagSynonym, c.TelegramMessage, c.Tenant, c.TrendingScore,
+		c.UploadSession, c.VerificationRequest, c.Warning, c.Webhook,
+		c.WebhookDelivery, c.WordFilter,
 	} {
 		n.Use(hooks...)
 	}
@@ -371,12 +714,25 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Account, c.AccountFollow, c.AccountRoles, c.Asset, c.Authentication,
-		c.Category, c.Collection, c.CollectionNode, c.CollectionPost, c.Email, c.Event,
-		c.EventParticipant, c.Invitation, c.LikePost, c.Link, c.MentionProfile, c.Node,
-		c.Notification, c.Post, c.PostRead, c.Property, c.PropertySchema,
-		c.PropertySchemaField, c.Question, c.React, c.Report, c.Role, c.Session,
-		c.Setting, c.Tag,
+		c.APIUsage, c.Account, c.AccountApplication, c.AccountBadge, c.AccountBlock,
+		c.AccountFollow, c.AccountOnboardingStep, c.AccountRoles, c.AnalyticsCohort,
+		c.AnalyticsContent, c.AnalyticsDay, c.AnalyticsExport, c.Asset,
+		c.Authentication, c.AutomodRule, c.Badge, c.Bookmark, c.Category,
+		c.CategoryFollow, c.CategoryPermission, c.Collection, c.CollectionNode,
+		c.CollectionPost, c.CollectionSection, c.CollectionShare, c.ContentSummary,
+		c.ContentView, c.Conversation, c.ConversationParticipant, c.DigestSubscription,
+		c.DirectMessage, c.DiscordBridgeLink, c.Email, c.Event, c.EventParticipant,
+		c.FeatureFlag, c.FederationFollower, c.FederationKey, c.FeedToken,
+		c.ImportRecord, c.ImportRun, c.Invitation, c.Job, c.LikePost, c.Link,
+		c.LinkCheck, c.MatrixBridgeLink, c.MentionProfile, c.ModerationNote,
+		c.NetworkBan, c.Node, c.Notification, c.NotificationPreference,
+		c.PolicyAcceptance, c.PolicyDocument, c.PolicyVersion, c.Post, c.PostRead,
+		c.Property, c.PropertySchema, c.PropertySchemaField, c.PushSubscription,
+		c.Question, c.React, c.ReadingHistory, c.Report, c.ReputationEntry, c.Role,
+		c.ScheduledTask, c.Session, c.Setting, c.Space, c.SpaceMember, c.Tag,
+		c.TagFollow, c.TagSynonym, c.TelegramMessage, c.Tenant, c.TrendingScore,
+		c.UploadSession, c.VerificationRequest, c.Warning, c.Webhook,
+		c.WebhookDelivery, c.WordFilter,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -385,42 +741,118 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIUsageMutation:
+		return c.APIUsage.mutate(ctx, m)
 	case *AccountMutation:
 		return c.Account.mutate(ctx, m)
+	case *AccountApplicationMutation:
+		return c.AccountApplication.mutate(ctx, m)
+	case *AccountBadgeMutation:
+		return c.AccountBadge.mutate(ctx, m)
+	case *AccountBlockMutation:
+		return c.AccountBlock.mutate(ctx, m)
 	case *AccountFollowMutation:
 		return c.AccountFollow.mutate(ctx, m)
+	case *AccountOnboardingStepMutation:
+		return c.AccountOnboardingStep.mutate(ctx, m)
 	case *AccountRolesMutation:
 		return c.AccountRoles.mutate(ctx, m)
+	case *AnalyticsCohortMutation:
+		return c.AnalyticsCohort.mutate(ctx, m)
+	case *AnalyticsContentMutation:
+		return c.AnalyticsContent.mutate(ctx, m)
+	case *AnalyticsDayMutation:
+		return c.AnalyticsDay.mutate(ctx, m)
+	case *AnalyticsExportMutation:
+		return c.AnalyticsExport.mutate(ctx, m)
 	case *AssetMutation:
 		return c.Asset.mutate(ctx, m)
 	case *AuthenticationMutation:
 		return c.Authentication.mutate(ctx, m)
+	case *AutomodRuleMutation:
+		return c.AutomodRule.mutate(ctx, m)
+	case *BadgeMutation:
+		return c.Badge.mutate(ctx, m)
+	case *BookmarkMutation:
+		return c.Bookmark.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
+	case *CategoryFollowMutation:
+		return c.CategoryFollow.mutate(ctx, m)
+	case *CategoryPermissionMutation:
+		return c.CategoryPermission.mutate(ctx, m)
 	case *CollectionMutation:
 		return c.Collection.mutate(ctx, m)
 	case *CollectionNodeMutation:
 		return c.CollectionNode.mutate(ctx, m)
 	case *CollectionPostMutation:
 		return c.CollectionPost.mutate(ctx, m)
+	case *CollectionSectionMutation:
+		return c.CollectionSection.mutate(ctx, m)
+	case *CollectionShareMutation:
+		return c.CollectionShare.mutate(ctx, m)
+	case *ContentSummaryMutation:
+		return c.ContentSummary.mutate(ctx, m)
+	case *ContentViewMutation:
+		return c.ContentView.mutate(ctx, m)
+	case *ConversationMutation:
+		return c.Conversation.mutate(ctx, m)
+	case *ConversationParticipantMutation:
+		return c.ConversationParticipant.mutate(ctx, m)
+	case *DigestSubscriptionMutation:
+		return c.DigestSubscription.mutate(ctx, m)
+	case *DirectMessageMutation:
+		return c.DirectMessage.mutate(ctx, m)
+	case *DiscordBridgeLinkMutation:
+		return c.DiscordBridgeLink.mutate(ctx, m)
 	case *EmailMutation:
 		return c.Email.mutate(ctx, m)
 	case *EventMutation:
 		return c.Event.mutate(ctx, m)
 	case *EventParticipantMutation:
 		return c.EventParticipant.mutate(ctx, m)
+	case *FeatureFlagMutation:
+		return c.FeatureFlag.mutate(ctx, m)
+	case *FederationFollowerMutation:
+		return c.FederationFollower.mutate(ctx, m)
+	case *FederationKeyMutation:
+		return c.FederationKey.mutate(ctx, m)
+	case *FeedTokenMutation:
+		return c.FeedToken.mutate(ctx, m)
+	case *ImportRecordMutation:
+		return c.ImportRecord.mutate(ctx, m)
+	case *ImportRunMutation:
+		return c.ImportRun.mutate(ctx, m)
 	case *InvitationMutation:
 		return c.Invitation.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
 	case *LikePostMutation:
 		return c.LikePost.mutate(ctx, m)
 	case *LinkMutation:
 		return c.Link.mutate(ctx, m)
+	case *LinkCheckMutation:
+		return c.LinkCheck.mutate(ctx, m)
+	case *MatrixBridgeLinkMutation:
+		return c.MatrixBridgeLink.mutate(ctx, m)
 	case *MentionProfileMutation:
 		return c.MentionProfile.mutate(ctx, m)
+	case *ModerationNoteMutation:
+		return c.ModerationNote.mutate(ctx, m)
+	case *NetworkBanMutation:
+		return c.NetworkBan.mutate(ctx, m)
 	case *NodeMutation:
 		return c.Node.mutate(ctx, m)
 	case *NotificationMutation:
 		return c.Notification.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *PolicyAcceptanceMutation:
+		return c.PolicyAcceptance.mutate(ctx, m)
+	case *PolicyDocumentMutation:
+		return c.PolicyDocument.mutate(ctx, m)
+	case *PolicyVersionMutation:
+		return c.PolicyVersion.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
 	case *PostReadMutation:
@@ -431,25 +863,224 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PropertySchema.mutate(ctx, m)
 	case *PropertySchemaFieldMutation:
 		return c.PropertySchemaField.mutate(ctx, m)
+	case *PushSubscriptionMutation:
+		return c.PushSubscription.mutate(ctx, m)
 	case *QuestionMutation:
 		return c.Question.mutate(ctx, m)
 	case *ReactMutation:
 		return c.React.mutate(ctx, m)
+	case *ReadingHistoryMutation:
+		return c.ReadingHistory.mutate(ctx, m)
 	case *ReportMutation:
 		return c.Report.mutate(ctx, m)
+	case *ReputationEntryMutation:
+		return c.ReputationEntry.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *ScheduledTaskMutation:
+		return c.ScheduledTask.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
+	case *SpaceMutation:
+		return c.Space.mutate(ctx, m)
+	case *SpaceMemberMutation:
+		return c.SpaceMember.mutate(ctx, m)
 	case *TagMutation:
 		return c.Tag.mutate(ctx, m)
+	case *TagFollowMutation:
+		return c.TagFollow.mutate(ctx, m)
+	case *TagSynonymMutation:
+		return c.TagSynonym.mutate(ctx, m)
+	case *TelegramMessageMutation:
+		return c.TelegramMessage.mutate(ctx, m)
+	case *TenantMutation:
+		return c.Tenant.mutate(ctx, m)
+	case *TrendingScoreMutation:
+		return c.TrendingScore.mutate(ctx, m)
+	case *UploadSessionMutation:
+		return c.UploadSession.mutate(ctx, m)
+	case *VerificationRequestMutation:
+		return c.VerificationRequest.mutate(ctx, m)
+	case *WarningMutation:
+		return c.Warning.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WordFilterMutation:
+		return c.WordFilter.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// APIUsageClient is a client for the APIUsage schema.
+type APIUsageClient struct {
+	config
+}
+
+// NewAPIUsageClient returns a client for the APIUsage from the given config.
+func NewAPIUsageClient(c config) *APIUsageClient {
+	return &APIUsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apiusage.Hooks(f(g(h())))`.
+func (c *APIUsageClient) Use(hooks ...Hook) {
+	c.hooks.APIUsage = append(c.hooks.APIUsage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apiusage.Intercept(f(g(h())))`.
+func (c *APIUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIUsage = append(c.inters.APIUsage, interceptors...)
+}
+
+// Create returns a builder for creating a APIUsage entity.
+func (c *APIUsageClient) Create() *APIUsageCreate {
+	mutation := newAPIUsageMutation(c.config, OpCreate)
+	return &APIUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIUsage entities.
+func (c *APIUsageClient) CreateBulk(builders ...*APIUsageCreate) *APIUsageCreateBulk {
+	return &APIUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIUsageClient) MapCreateBulk(slice any, setFunc func(*APIUsageCreate, int)) *APIUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIUsageCreateBulk{err: fmt.Errorf("calling to APIUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIUsage.
+func (c *APIUsageClient) Update() *APIUsageUpdate {
+	mutation := newAPIUsageMutation(c.config, OpUpdate)
+	return &APIUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIUsageClient) UpdateOne(_m *APIUsage) *APIUsageUpdateOne {
+	mutation := newAPIUsageMutation(c.config, OpUpdateOne, withAPIUsage(_m))
+	return &APIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIUsageClient) UpdateOneID(id xid.ID) *APIUsageUpdateOne {
+	mutation := newAPIUsageMutation(c.config, OpUpdateOne, withAPIUsageID(id))
+	return &APIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIUsage.
+func (c *APIUsageClient) Delete() *APIUsageDelete {
+	mutation := newAPIUsageMutation(c.config, OpDelete)
+	return &APIUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIUsageClient) DeleteOne(_m *APIUsage) *APIUsageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIUsageClient) DeleteOneID(id xid.ID) *APIUsageDeleteOne {
+	builder := c.Delete().Where(apiusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for APIUsage.
+func (c *APIUsageClient) Query() *APIUsageQuery {
+	return &APIUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIUsage entity by its id.
+func (c *APIUsageClient) Get(ctx context.Context, id xid.ID) (*APIUsage, error) {
+	return c.Query().Where(apiusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIUsageClient) GetX(ctx context.Context, id xid.ID) *APIUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a APIUsage.
+func (c *APIUsageClient) QueryAccount(_m *APIUsage) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiusage.Table, apiusage.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apiusage.AccountTable, apiusage.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessKey queries the access_key edge of a APIUsage.
+func (c *APIUsageClient) QueryAccessKey(_m *APIUsage) *AuthenticationQuery {
+	query := (&AuthenticationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiusage.Table, apiusage.FieldID, id),
+			sqlgraph.To(authentication.Table, authentication.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apiusage.AccessKeyTable, apiusage.AccessKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APIUsageClient) Hooks() []Hook {
+	return c.hooks.APIUsage
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIUsageClient) Interceptors() []Interceptor {
+	return c.inters.APIUsage
+}
+
+func (c *APIUsageClient) mutate(ctx context.Context, m *APIUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIUsage mutation op: %q", m.Op())
+	}
+}
+
 // AccountClient is a client for the Account schema.
 type AccountClient struct {
 	config
@@ -654,6 +1285,102 @@ func (c *AccountClient) QueryFollowedBy(_m *Account) *AccountFollowQuery {
 	return query
 }
 
+// QueryBlocks queries the blocks edge of a Account.
+func (c *AccountClient) QueryBlocks(_m *Account) *AccountBlockQuery {
+	query := (&AccountBlockClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(accountblock.Table, accountblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BlocksTable, account.BlocksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedBy queries the blocked_by edge of a Account.
+func (c *AccountClient) QueryBlockedBy(_m *Account) *AccountBlockQuery {
+	query := (&AccountBlockClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(accountblock.Table, accountblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BlockedByTable, account.BlockedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTagFollows queries the tag_follows edge of a Account.
+func (c *AccountClient) QueryTagFollows(_m *Account) *TagFollowQuery {
+	query := (&TagFollowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(tagfollow.Table, tagfollow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.TagFollowsTable, account.TagFollowsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategoryFollows queries the category_follows edge of a Account.
+func (c *AccountClient) QueryCategoryFollows(_m *Account) *CategoryFollowQuery {
+	query := (&CategoryFollowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(categoryfollow.Table, categoryfollow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.CategoryFollowsTable, account.CategoryFollowsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReputation queries the reputation edge of a Account.
+func (c *AccountClient) QueryReputation(_m *Account) *ReputationEntryQuery {
+	query := (&ReputationEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(reputationentry.Table, reputationentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReputationTable, account.ReputationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBadges queries the badges edge of a Account.
+func (c *AccountClient) QueryBadges(_m *Account) *AccountBadgeQuery {
+	query := (&AccountBadgeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(accountbadge.Table, accountbadge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BadgesTable, account.BadgesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryInvitations queries the invitations edge of a Account.
 func (c *AccountClient) QueryInvitations(_m *Account) *InvitationQuery {
 	query := (&InvitationClient{config: c.config}).Query()
@@ -686,6 +1413,38 @@ func (c *AccountClient) QueryInvitedBy(_m *Account) *InvitationQuery {
 	return query
 }
 
+// QueryOwner queries the owner edge of a Account.
+func (c *AccountClient) QueryOwner(_m *Account) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, account.OwnerTable, account.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBots queries the bots edge of a Account.
+func (c *AccountClient) QueryBots(_m *Account) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BotsTable, account.BotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryPosts queries the posts edge of a Account.
 func (c *AccountClient) QueryPosts(_m *Account) *PostQuery {
 	query := (&PostClient{config: c.config}).Query()
@@ -894,15 +1653,15 @@ func (c *AccountClient) QueryPostReads(_m *Account) *PostReadQuery {
 	return query
 }
 
-// QueryReports queries the reports edge of a Account.
-func (c *AccountClient) QueryReports(_m *Account) *ReportQuery {
-	query := (&ReportClient{config: c.config}).Query()
+// QueryNotificationPreferences queries the notification_preferences edge of a Account.
+func (c *AccountClient) QueryNotificationPreferences(_m *Account) *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(account.Table, account.FieldID, id),
-			sqlgraph.To(report.Table, report.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, account.ReportsTable, account.ReportsColumn),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.NotificationPreferencesTable, account.NotificationPreferencesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -910,15 +1669,15 @@ func (c *AccountClient) QueryReports(_m *Account) *ReportQuery {
 	return query
 }
 
-// QueryHandledReports queries the handled_reports edge of a Account.
-func (c *AccountClient) QueryHandledReports(_m *Account) *ReportQuery {
-	query := (&ReportClient{config: c.config}).Query()
+// QueryPushSubscriptions queries the push_subscriptions edge of a Account.
+func (c *AccountClient) QueryPushSubscriptions(_m *Account) *PushSubscriptionQuery {
+	query := (&PushSubscriptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(account.Table, account.FieldID, id),
-			sqlgraph.To(report.Table, report.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, account.HandledReportsTable, account.HandledReportsColumn),
+			sqlgraph.To(pushsubscription.Table, pushsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.PushSubscriptionsTable, account.PushSubscriptionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -926,15 +1685,15 @@ func (c *AccountClient) QueryHandledReports(_m *Account) *ReportQuery {
 	return query
 }
 
-// QueryAccountRoles queries the account_roles edge of a Account.
-func (c *AccountClient) QueryAccountRoles(_m *Account) *AccountRolesQuery {
-	query := (&AccountRolesClient{config: c.config}).Query()
+// QueryDigestSubscription queries the digest_subscription edge of a Account.
+func (c *AccountClient) QueryDigestSubscription(_m *Account) *DigestSubscriptionQuery {
+	query := (&DigestSubscriptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(account.Table, account.FieldID, id),
-			sqlgraph.To(accountroles.Table, accountroles.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, account.AccountRolesTable, account.AccountRolesColumn),
+			sqlgraph.To(digestsubscription.Table, digestsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.DigestSubscriptionTable, account.DigestSubscriptionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -942,132 +1701,9113 @@ func (c *AccountClient) QueryAccountRoles(_m *Account) *AccountRolesQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *AccountClient) Hooks() []Hook {
-	return c.hooks.Account
-}
-
-// Interceptors returns the client interceptors.
-func (c *AccountClient) Interceptors() []Interceptor {
-	return c.inters.Account
+// QueryFeedToken queries the feed_token edge of a Account.
+func (c *AccountClient) QueryFeedToken(_m *Account) *FeedTokenQuery {
+	query := (&FeedTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(feedtoken.Table, feedtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.FeedTokenTable, account.FeedTokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *AccountClient) mutate(ctx context.Context, m *AccountMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&AccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&AccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&AccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&AccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown Account mutation op: %q", m.Op())
+// QueryAPIUsage queries the api_usage edge of a Account.
+func (c *AccountClient) QueryAPIUsage(_m *Account) *APIUsageQuery {
+	query := (&APIUsageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(apiusage.Table, apiusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.APIUsageTable, account.APIUsageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// AccountFollowClient is a client for the AccountFollow schema.
-type AccountFollowClient struct {
-	config
+// QueryContentViews queries the content_views edge of a Account.
+func (c *AccountClient) QueryContentViews(_m *Account) *ContentViewQuery {
+	query := (&ContentViewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(contentview.Table, contentview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ContentViewsTable, account.ContentViewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// NewAccountFollowClient returns a client for the AccountFollow from the given config.
+// QueryReports queries the reports edge of a Account.
+func (c *AccountClient) QueryReports(_m *Account) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReportsTable, account.ReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHandledReports queries the handled_reports edge of a Account.
+func (c *AccountClient) QueryHandledReports(_m *Account) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.HandledReportsTable, account.HandledReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModerationNotes queries the moderation_notes edge of a Account.
+func (c *AccountClient) QueryModerationNotes(_m *Account) *ModerationNoteQuery {
+	query := (&ModerationNoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(moderationnote.Table, moderationnote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ModerationNotesTable, account.ModerationNotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthoredModerationNotes queries the authored_moderation_notes edge of a Account.
+func (c *AccountClient) QueryAuthoredModerationNotes(_m *Account) *ModerationNoteQuery {
+	query := (&ModerationNoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(moderationnote.Table, moderationnote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.AuthoredModerationNotesTable, account.AuthoredModerationNotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWarnings queries the warnings edge of a Account.
+func (c *AccountClient) QueryWarnings(_m *Account) *WarningQuery {
+	query := (&WarningClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(warning.Table, warning.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.WarningsTable, account.WarningsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIssuedWarnings queries the issued_warnings edge of a Account.
+func (c *AccountClient) QueryIssuedWarnings(_m *Account) *WarningQuery {
+	query := (&WarningClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(warning.Table, warning.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.IssuedWarningsTable, account.IssuedWarningsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUploadSessions queries the upload_sessions edge of a Account.
+func (c *AccountClient) QueryUploadSessions(_m *Account) *UploadSessionQuery {
+	query := (&UploadSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(uploadsession.Table, uploadsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.UploadSessionsTable, account.UploadSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConversations queries the conversations edge of a Account.
+func (c *AccountClient) QueryConversations(_m *Account) *ConversationParticipantQuery {
+	query := (&ConversationParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(conversationparticipant.Table, conversationparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ConversationsTable, account.ConversationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDirectMessages queries the direct_messages edge of a Account.
+func (c *AccountClient) QueryDirectMessages(_m *Account) *DirectMessageQuery {
+	query := (&DirectMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(directmessage.Table, directmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.DirectMessagesTable, account.DirectMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySpaces queries the spaces edge of a Account.
+func (c *AccountClient) QuerySpaces(_m *Account) *SpaceMemberQuery {
+	query := (&SpaceMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(spacemember.Table, spacemember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.SpacesTable, account.SpacesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApplication queries the application edge of a Account.
+func (c *AccountClient) QueryApplication(_m *Account) *AccountApplicationQuery {
+	query := (&AccountApplicationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(accountapplication.Table, accountapplication.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.ApplicationTable, account.ApplicationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewedApplications queries the reviewed_applications edge of a Account.
+func (c *AccountClient) QueryReviewedApplications(_m *Account) *AccountApplicationQuery {
+	query := (&AccountApplicationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(accountapplication.Table, accountapplication.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReviewedApplicationsTable, account.ReviewedApplicationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOnboardingSteps queries the onboarding_steps edge of a Account.
+func (c *AccountClient) QueryOnboardingSteps(_m *Account) *AccountOnboardingStepQuery {
+	query := (&AccountOnboardingStepClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(accountonboardingstep.Table, accountonboardingstep.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.OnboardingStepsTable, account.OnboardingStepsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPolicyAcceptances queries the policy_acceptances edge of a Account.
+func (c *AccountClient) QueryPolicyAcceptances(_m *Account) *PolicyAcceptanceQuery {
+	query := (&PolicyAcceptanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(policyacceptance.Table, policyacceptance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.PolicyAcceptancesTable, account.PolicyAcceptancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBookmarks queries the bookmarks edge of a Account.
+func (c *AccountClient) QueryBookmarks(_m *Account) *BookmarkQuery {
+	query := (&BookmarkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(bookmark.Table, bookmark.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BookmarksTable, account.BookmarksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReadingHistory queries the reading_history edge of a Account.
+func (c *AccountClient) QueryReadingHistory(_m *Account) *ReadingHistoryQuery {
+	query := (&ReadingHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(readinghistory.Table, readinghistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReadingHistoryTable, account.ReadingHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVerificationRequests queries the verification_requests edge of a Account.
+func (c *AccountClient) QueryVerificationRequests(_m *Account) *VerificationRequestQuery {
+	query := (&VerificationRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(verificationrequest.Table, verificationrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.VerificationRequestsTable, account.VerificationRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewedVerificationRequests queries the reviewed_verification_requests edge of a Account.
+func (c *AccountClient) QueryReviewedVerificationRequests(_m *Account) *VerificationRequestQuery {
+	query := (&VerificationRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(verificationrequest.Table, verificationrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ReviewedVerificationRequestsTable, account.ReviewedVerificationRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhooks queries the webhooks edge of a Account.
+func (c *AccountClient) QueryWebhooks(_m *Account) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.WebhooksTable, account.WebhooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccountRoles queries the account_roles edge of a Account.
+func (c *AccountClient) QueryAccountRoles(_m *Account) *AccountRolesQuery {
+	query := (&AccountRolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(accountroles.Table, accountroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, account.AccountRolesTable, account.AccountRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccountClient) Hooks() []Hook {
+	return c.hooks.Account
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountClient) Interceptors() []Interceptor {
+	return c.inters.Account
+}
+
+func (c *AccountClient) mutate(ctx context.Context, m *AccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Account mutation op: %q", m.Op())
+	}
+}
+
+// AccountApplicationClient is a client for the AccountApplication schema.
+type AccountApplicationClient struct {
+	config
+}
+
+// NewAccountApplicationClient returns a client for the AccountApplication from the given config.
+func NewAccountApplicationClient(c config) *AccountApplicationClient {
+	return &AccountApplicationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountapplication.Hooks(f(g(h())))`.
+func (c *AccountApplicationClient) Use(hooks ...Hook) {
+	c.hooks.AccountApplication = append(c.hooks.AccountApplication, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountapplication.Intercept(f(g(h())))`.
+func (c *AccountApplicationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountApplication = append(c.inters.AccountApplication, interceptors...)
+}
+
+// Create returns a builder for creating a AccountApplication entity.
+func (c *AccountApplicationClient) Create() *AccountApplicationCreate {
+	mutation := newAccountApplicationMutation(c.config, OpCreate)
+	return &AccountApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountApplication entities.
+func (c *AccountApplicationClient) CreateBulk(builders ...*AccountApplicationCreate) *AccountApplicationCreateBulk {
+	return &AccountApplicationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountApplicationClient) MapCreateBulk(slice any, setFunc func(*AccountApplicationCreate, int)) *AccountApplicationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountApplicationCreateBulk{err: fmt.Errorf("calling to AccountApplicationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountApplicationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountApplicationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountApplication.
+func (c *AccountApplicationClient) Update() *AccountApplicationUpdate {
+	mutation := newAccountApplicationMutation(c.config, OpUpdate)
+	return &AccountApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountApplicationClient) UpdateOne(_m *AccountApplication) *AccountApplicationUpdateOne {
+	mutation := newAccountApplicationMutation(c.config, OpUpdateOne, withAccountApplication(_m))
+	return &AccountApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountApplicationClient) UpdateOneID(id xid.ID) *AccountApplicationUpdateOne {
+	mutation := newAccountApplicationMutation(c.config, OpUpdateOne, withAccountApplicationID(id))
+	return &AccountApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountApplication.
+func (c *AccountApplicationClient) Delete() *AccountApplicationDelete {
+	mutation := newAccountApplicationMutation(c.config, OpDelete)
+	return &AccountApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountApplicationClient) DeleteOne(_m *AccountApplication) *AccountApplicationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountApplicationClient) DeleteOneID(id xid.ID) *AccountApplicationDeleteOne {
+	builder := c.Delete().Where(accountapplication.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountApplicationDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountApplication.
+func (c *AccountApplicationClient) Query() *AccountApplicationQuery {
+	return &AccountApplicationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountApplication},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountApplication entity by its id.
+func (c *AccountApplicationClient) Get(ctx context.Context, id xid.ID) (*AccountApplication, error) {
+	return c.Query().Where(accountapplication.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountApplicationClient) GetX(ctx context.Context, id xid.ID) *AccountApplication {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a AccountApplication.
+func (c *AccountApplicationClient) QueryAccount(_m *AccountApplication) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountapplication.Table, accountapplication.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, accountapplication.AccountTable, accountapplication.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewedBy queries the reviewed_by edge of a AccountApplication.
+func (c *AccountApplicationClient) QueryReviewedBy(_m *AccountApplication) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountapplication.Table, accountapplication.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountapplication.ReviewedByTable, accountapplication.ReviewedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccountApplicationClient) Hooks() []Hook {
+	return c.hooks.AccountApplication
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountApplicationClient) Interceptors() []Interceptor {
+	return c.inters.AccountApplication
+}
+
+func (c *AccountApplicationClient) mutate(ctx context.Context, m *AccountApplicationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccountApplication mutation op: %q", m.Op())
+	}
+}
+
+// AccountBadgeClient is a client for the AccountBadge schema.
+type AccountBadgeClient struct {
+	config
+}
+
+// NewAccountBadgeClient returns a client for the AccountBadge from the given config.
+func NewAccountBadgeClient(c config) *AccountBadgeClient {
+	return &AccountBadgeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountbadge.Hooks(f(g(h())))`.
+func (c *AccountBadgeClient) Use(hooks ...Hook) {
+	c.hooks.AccountBadge = append(c.hooks.AccountBadge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountbadge.Intercept(f(g(h())))`.
+func (c *AccountBadgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountBadge = append(c.inters.AccountBadge, interceptors...)
+}
+
+// Create returns a builder for creating a AccountBadge entity.
+func (c *AccountBadgeClient) Create() *AccountBadgeCreate {
+	mutation := newAccountBadgeMutation(c.config, OpCreate)
+	return &AccountBadgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountBadge entities.
+func (c *AccountBadgeClient) CreateBulk(builders ...*AccountBadgeCreate) *AccountBadgeCreateBulk {
+	return &AccountBadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountBadgeClient) MapCreateBulk(slice any, setFunc func(*AccountBadgeCreate, int)) *AccountBadgeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountBadgeCreateBulk{err: fmt.Errorf("calling to AccountBadgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountBadgeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountBadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountBadge.
+func (c *AccountBadgeClient) Update() *AccountBadgeUpdate {
+	mutation := newAccountBadgeMutation(c.config, OpUpdate)
+	return &AccountBadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountBadgeClient) UpdateOne(_m *AccountBadge) *AccountBadgeUpdateOne {
+	mutation := newAccountBadgeMutation(c.config, OpUpdateOne, withAccountBadge(_m))
+	return &AccountBadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountBadgeClient) UpdateOneID(id xid.ID) *AccountBadgeUpdateOne {
+	mutation := newAccountBadgeMutation(c.config, OpUpdateOne, withAccountBadgeID(id))
+	return &AccountBadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountBadge.
+func (c *AccountBadgeClient) Delete() *AccountBadgeDelete {
+	mutation := newAccountBadgeMutation(c.config, OpDelete)
+	return &AccountBadgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountBadgeClient) DeleteOne(_m *AccountBadge) *AccountBadgeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountBadgeClient) DeleteOneID(id xid.ID) *AccountBadgeDeleteOne {
+	builder := c.Delete().Where(accountbadge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountBadgeDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountBadge.
+func (c *AccountBadgeClient) Query() *AccountBadgeQuery {
+	return &AccountBadgeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountBadge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountBadge entity by its id.
+func (c *AccountBadgeClient) Get(ctx context.Context, id xid.ID) (*AccountBadge, error) {
+	return c.Query().Where(accountbadge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountBadgeClient) GetX(ctx context.Context, id xid.ID) *AccountBadge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a AccountBadge.
+func (c *AccountBadgeClient) QueryAccount(_m *AccountBadge) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountbadge.Table, accountbadge.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountbadge.AccountTable, accountbadge.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBadge queries the badge edge of a AccountBadge.
+func (c *AccountBadgeClient) QueryBadge(_m *AccountBadge) *BadgeQuery {
+	query := (&BadgeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountbadge.Table, accountbadge.FieldID, id),
+			sqlgraph.To(badge.Table, badge.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountbadge.BadgeTable, accountbadge.BadgeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccountBadgeClient) Hooks() []Hook {
+	return c.hooks.AccountBadge
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountBadgeClient) Interceptors() []Interceptor {
+	return c.inters.AccountBadge
+}
+
+func (c *AccountBadgeClient) mutate(ctx context.Context, m *AccountBadgeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountBadgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountBadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountBadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountBadgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccountBadge mutation op: %q", m.Op())
+	}
+}
+
+// AccountBlockClient is a client for the AccountBlock schema.
+type AccountBlockClient struct {
+	config
+}
+
+// NewAccountBlockClient returns a client for the AccountBlock from the given config.
+func NewAccountBlockClient(c config) *AccountBlockClient {
+	return &AccountBlockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountblock.Hooks(f(g(h())))`.
+func (c *AccountBlockClient) Use(hooks ...Hook) {
+	c.hooks.AccountBlock = append(c.hooks.AccountBlock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountblock.Intercept(f(g(h())))`.
+func (c *AccountBlockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountBlock = append(c.inters.AccountBlock, interceptors...)
+}
+
+// Create returns a builder for creating a AccountBlock entity.
+func (c *AccountBlockClient) Create() *AccountBlockCreate {
+	mutation := newAccountBlockMutation(c.config, OpCreate)
+	return &AccountBlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountBlock entities.
+func (c *AccountBlockClient) CreateBulk(builders ...*AccountBlockCreate) *AccountBlockCreateBulk {
+	return &AccountBlockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountBlockClient) MapCreateBulk(slice any, setFunc func(*AccountBlockCreate, int)) *AccountBlockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountBlockCreateBulk{err: fmt.Errorf("calling to AccountBlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountBlockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountBlockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountBlock.
+func (c *AccountBlockClient) Update() *AccountBlockUpdate {
+	mutation := newAccountBlockMutation(c.config, OpUpdate)
+	return &AccountBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountBlockClient) UpdateOne(_m *AccountBlock) *AccountBlockUpdateOne {
+	mutation := newAccountBlockMutation(c.config, OpUpdateOne, withAccountBlock(_m))
+	return &AccountBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountBlockClient) UpdateOneID(id xid.ID) *AccountBlockUpdateOne {
+	mutation := newAccountBlockMutation(c.config, OpUpdateOne, withAccountBlockID(id))
+	return &AccountBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountBlock.
+func (c *AccountBlockClient) Delete() *AccountBlockDelete {
+	mutation := newAccountBlockMutation(c.config, OpDelete)
+	return &AccountBlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountBlockClient) DeleteOne(_m *AccountBlock) *AccountBlockDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountBlockClient) DeleteOneID(id xid.ID) *AccountBlockDeleteOne {
+	builder := c.Delete().Where(accountblock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountBlockDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountBlock.
+func (c *AccountBlockClient) Query() *AccountBlockQuery {
+	return &AccountBlockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountBlock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountBlock entity by its id.
+func (c *AccountBlockClient) Get(ctx context.Context, id xid.ID) (*AccountBlock, error) {
+	return c.Query().Where(accountblock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountBlockClient) GetX(ctx context.Context, id xid.ID) *AccountBlock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a AccountBlock.
+func (c *AccountBlockClient) QueryAccount(_m *AccountBlock) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountblock.Table, accountblock.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountblock.AccountTable, accountblock.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTarget queries the target edge of a AccountBlock.
+func (c *AccountBlockClient) QueryTarget(_m *AccountBlock) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountblock.Table, accountblock.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountblock.TargetTable, accountblock.TargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccountBlockClient) Hooks() []Hook {
+	return c.hooks.AccountBlock
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountBlockClient) Interceptors() []Interceptor {
+	return c.inters.AccountBlock
+}
+
+func (c *AccountBlockClient) mutate(ctx context.Context, m *AccountBlockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountBlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountBlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccountBlock mutation op: %q", m.Op())
+	}
+}
+
+// AccountFollowClient is a client for the AccountFollow schema.
+type AccountFollowClient struct {
+	config
+}
+
+// NewAccountFollowClient returns a client for the AccountFollow from the given config.
 func NewAccountFollowClient(c config) *AccountFollowClient {
 	return &AccountFollowClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `accountfollow.Hooks(f(g(h())))`.
-func (c *AccountFollowClient) Use(hooks ...Hook) {
-	c.hooks.AccountFollow = append(c.hooks.AccountFollow, hooks...)
+// A call to `Use(f, g, h)` equals to `accountfollow.Hooks(f(g(h())))`.
+func (c *AccountFollowClient) Use(hooks ...Hook) {
+	c.hooks.AccountFollow = append(c.hooks.AccountFollow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountfollow.Intercept(f(g(h())))`.
+func (c *AccountFollowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountFollow = append(c.inters.AccountFollow, interceptors...)
+}
+
+// Create returns a builder for creating a AccountFollow entity.
+func (c *AccountFollowClient) Create() *AccountFollowCreate {
+	mutation := newAccountFollowMutation(c.config, OpCreate)
+	return &AccountFollowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountFollow entities.
+func (c *AccountFollowClient) CreateBulk(builders ...*AccountFollowCreate) *AccountFollowCreateBulk {
+	return &AccountFollowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountFollowClient) MapCreateBulk(slice any, setFunc func(*AccountFollowCreate, int)) *AccountFollowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountFollowCreateBulk{err: fmt.Errorf("calling to AccountFollowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountFollowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountFollowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountFollow.
+func (c *AccountFollowClient) Update() *AccountFollowUpdate {
+	mutation := newAccountFollowMutation(c.config, OpUpdate)
+	return &AccountFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountFollowClient) UpdateOne(_m *AccountFollow) *AccountFollowUpdateOne {
+	mutation := newAccountFollowMutation(c.config, OpUpdateOne, withAccountFollow(_m))
+	return &AccountFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountFollowClient) UpdateOneID(id xid.ID) *AccountFollowUpdateOne {
+	mutation := newAccountFollowMutation(c.config, OpUpdateOne, withAccountFollowID(id))
+	return &AccountFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountFollow.
+func (c *AccountFollowClient) Delete() *AccountFollowDelete {
+	mutation := newAccountFollowMutation(c.config, OpDelete)
+	return &AccountFollowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountFollowClient) DeleteOne(_m *AccountFollow) *AccountFollowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountFollowClient) DeleteOneID(id xid.ID) *AccountFollowDeleteOne {
+	builder := c.Delete().Where(accountfollow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountFollowDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountFollow.
+func (c *AccountFollowClient) Query() *AccountFollowQuery {
+	return &AccountFollowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountFollow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountFollow entity by its id.
+func (c *AccountFollowClient) Get(ctx context.Context, id xid.ID) (*AccountFollow, error) {
+	return c.Query().Where(accountfollow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountFollowClient) GetX(ctx context.Context, id xid.ID) *AccountFollow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFollower queries the follower edge of a AccountFollow.
+func (c *AccountFollowClient) QueryFollower(_m *AccountFollow) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountfollow.Table, accountfollow.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountfollow.FollowerTable, accountfollow.FollowerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFollowing queries the following edge of a AccountFollow.
+func (c *AccountFollowClient) QueryFollowing(_m *AccountFollow) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountfollow.Table, accountfollow.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountfollow.FollowingTable, accountfollow.FollowingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccountFollowClient) Hooks() []Hook {
+	return c.hooks.AccountFollow
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountFollowClient) Interceptors() []Interceptor {
+	return c.inters.AccountFollow
+}
+
+func (c *AccountFollowClient) mutate(ctx context.Context, m *AccountFollowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountFollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountFollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccountFollow mutation op: %q", m.Op())
+	}
+}
+
+// AccountOnboardingStepClient is a client for the AccountOnboardingStep schema.
+type AccountOnboardingStepClient struct {
+	config
+}
+
+// NewAccountOnboardingStepClient returns a client for the AccountOnboardingStep from the given config.
+func NewAccountOnboardingStepClient(c config) *AccountOnboardingStepClient {
+	return &AccountOnboardingStepClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountonboardingstep.Hooks(f(g(h())))`.
+func (c *AccountOnboardingStepClient) Use(hooks ...Hook) {
+	c.hooks.AccountOnboardingStep = append(c.hooks.AccountOnboardingStep, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountonboardingstep.Intercept(f(g(h())))`.
+func (c *AccountOnboardingStepClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountOnboardingStep = append(c.inters.AccountOnboardingStep, interceptors...)
+}
+
+// Create returns a builder for creating a AccountOnboardingStep entity.
+func (c *AccountOnboardingStepClient) Create() *AccountOnboardingStepCreate {
+	mutation := newAccountOnboardingStepMutation(c.config, OpCreate)
+	return &AccountOnboardingStepCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountOnboardingStep entities.
+func (c *AccountOnboardingStepClient) CreateBulk(builders ...*AccountOnboardingStepCreate) *AccountOnboardingStepCreateBulk {
+	return &AccountOnboardingStepCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountOnboardingStepClient) MapCreateBulk(slice any, setFunc func(*AccountOnboardingStepCreate, int)) *AccountOnboardingStepCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountOnboardingStepCreateBulk{err: fmt.Errorf("calling to AccountOnboardingStepClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountOnboardingStepCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountOnboardingStepCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountOnboardingStep.
+func (c *AccountOnboardingStepClient) Update() *AccountOnboardingStepUpdate {
+	mutation := newAccountOnboardingStepMutation(c.config, OpUpdate)
+	return &AccountOnboardingStepUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountOnboardingStepClient) UpdateOne(_m *AccountOnboardingStep) *AccountOnboardingStepUpdateOne {
+	mutation := newAccountOnboardingStepMutation(c.config, OpUpdateOne, withAccountOnboardingStep(_m))
+	return &AccountOnboardingStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountOnboardingStepClient) UpdateOneID(id xid.ID) *AccountOnboardingStepUpdateOne {
+	mutation := newAccountOnboardingStepMutation(c.config, OpUpdateOne, withAccountOnboardingStepID(id))
+	return &AccountOnboardingStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountOnboardingStep.
+func (c *AccountOnboardingStepClient) Delete() *AccountOnboardingStepDelete {
+	mutation := newAccountOnboardingStepMutation(c.config, OpDelete)
+	return &AccountOnboardingStepDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountOnboardingStepClient) DeleteOne(_m *AccountOnboardingStep) *AccountOnboardingStepDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountOnboardingStepClient) DeleteOneID(id xid.ID) *AccountOnboardingStepDeleteOne {
+	builder := c.Delete().Where(accountonboardingstep.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountOnboardingStepDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountOnboardingStep.
+func (c *AccountOnboardingStepClient) Query() *AccountOnboardingStepQuery {
+	return &AccountOnboardingStepQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountOnboardingStep},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountOnboardingStep entity by its id.
+func (c *AccountOnboardingStepClient) Get(ctx context.Context, id xid.ID) (*AccountOnboardingStep, error) {
+	return c.Query().Where(accountonboardingstep.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountOnboardingStepClient) GetX(ctx context.Context, id xid.ID) *AccountOnboardingStep {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a AccountOnboardingStep.
+func (c *AccountOnboardingStepClient) QueryAccount(_m *AccountOnboardingStep) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountonboardingstep.Table, accountonboardingstep.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountonboardingstep.AccountTable, accountonboardingstep.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccountOnboardingStepClient) Hooks() []Hook {
+	return c.hooks.AccountOnboardingStep
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountOnboardingStepClient) Interceptors() []Interceptor {
+	return c.inters.AccountOnboardingStep
+}
+
+func (c *AccountOnboardingStepClient) mutate(ctx context.Context, m *AccountOnboardingStepMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountOnboardingStepCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountOnboardingStepUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountOnboardingStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountOnboardingStepDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccountOnboardingStep mutation op: %q", m.Op())
+	}
+}
+
+// AccountRolesClient is a client for the AccountRoles schema.
+type AccountRolesClient struct {
+	config
+}
+
+// NewAccountRolesClient returns a client for the AccountRoles from the given config.
+func NewAccountRolesClient(c config) *AccountRolesClient {
+	return &AccountRolesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountroles.Hooks(f(g(h())))`.
+func (c *AccountRolesClient) Use(hooks ...Hook) {
+	c.hooks.AccountRoles = append(c.hooks.AccountRoles, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountroles.Intercept(f(g(h())))`.
+func (c *AccountRolesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountRoles = append(c.inters.AccountRoles, interceptors...)
+}
+
+// Create returns a builder for creating a AccountRoles entity.
+func (c *AccountRolesClient) Create() *AccountRolesCreate {
+	mutation := newAccountRolesMutation(c.config, OpCreate)
+	return &AccountRolesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountRoles entities.
+func (c *AccountRolesClient) CreateBulk(builders ...*AccountRolesCreate) *AccountRolesCreateBulk {
+	return &AccountRolesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountRolesClient) MapCreateBulk(slice any, setFunc func(*AccountRolesCreate, int)) *AccountRolesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountRolesCreateBulk{err: fmt.Errorf("calling to AccountRolesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountRolesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountRolesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountRoles.
+func (c *AccountRolesClient) Update() *AccountRolesUpdate {
+	mutation := newAccountRolesMutation(c.config, OpUpdate)
+	return &AccountRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountRolesClient) UpdateOne(_m *AccountRoles) *AccountRolesUpdateOne {
+	mutation := newAccountRolesMutation(c.config, OpUpdateOne, withAccountRoles(_m))
+	return &AccountRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountRolesClient) UpdateOneID(id xid.ID) *AccountRolesUpdateOne {
+	mutation := newAccountRolesMutation(c.config, OpUpdateOne, withAccountRolesID(id))
+	return &AccountRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountRoles.
+func (c *AccountRolesClient) Delete() *AccountRolesDelete {
+	mutation := newAccountRolesMutation(c.config, OpDelete)
+	return &AccountRolesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountRolesClient) DeleteOne(_m *AccountRoles) *AccountRolesDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountRolesClient) DeleteOneID(id xid.ID) *AccountRolesDeleteOne {
+	builder := c.Delete().Where(accountroles.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountRolesDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountRoles.
+func (c *AccountRolesClient) Query() *AccountRolesQuery {
+	return &AccountRolesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountRoles},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountRoles entity by its id.
+func (c *AccountRolesClient) Get(ctx context.Context, id xid.ID) (*AccountRoles, error) {
+	return c.Query().Where(accountroles.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountRolesClient) GetX(ctx context.Context, id xid.ID) *AccountRoles {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a AccountRoles.
+func (c *AccountRolesClient) QueryAccount(_m *AccountRoles) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountroles.Table, accountroles.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, accountroles.AccountTable, accountroles.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a AccountRoles.
+func (c *AccountRolesClient) QueryRole(_m *AccountRoles) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountroles.Table, accountroles.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, accountroles.RoleTable, accountroles.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccountRolesClient) Hooks() []Hook {
+	return c.hooks.AccountRoles
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountRolesClient) Interceptors() []Interceptor {
+	return c.inters.AccountRoles
+}
+
+func (c *AccountRolesClient) mutate(ctx context.Context, m *AccountRolesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountRolesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountRolesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccountRoles mutation op: %q", m.Op())
+	}
+}
+
+// AnalyticsCohortClient is a client for the AnalyticsCohort schema.
+type AnalyticsCohortClient struct {
+	config
+}
+
+// NewAnalyticsCohortClient returns a client for the AnalyticsCohort from the given config.
+func NewAnalyticsCohortClient(c config) *AnalyticsCohortClient {
+	return &AnalyticsCohortClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `analyticscohort.Hooks(f(g(h())))`.
+func (c *AnalyticsCohortClient) Use(hooks ...Hook) {
+	c.hooks.AnalyticsCohort = append(c.hooks.AnalyticsCohort, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `analyticscohort.Intercept(f(g(h())))`.
+func (c *AnalyticsCohortClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnalyticsCohort = append(c.inters.AnalyticsCohort, interceptors...)
+}
+
+// Create returns a builder for creating a AnalyticsCohort entity.
+func (c *AnalyticsCohortClient) Create() *AnalyticsCohortCreate {
+	mutation := newAnalyticsCohortMutation(c.config, OpCreate)
+	return &AnalyticsCohortCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AnalyticsCohort entities.
+func (c *AnalyticsCohortClient) CreateBulk(builders ...*AnalyticsCohortCreate) *AnalyticsCohortCreateBulk {
+	return &AnalyticsCohortCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnalyticsCohortClient) MapCreateBulk(slice any, setFunc func(*AnalyticsCohortCreate, int)) *AnalyticsCohortCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnalyticsCohortCreateBulk{err: fmt.Errorf("calling to AnalyticsCohortClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnalyticsCohortCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnalyticsCohortCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AnalyticsCohort.
+func (c *AnalyticsCohortClient) Update() *AnalyticsCohortUpdate {
+	mutation := newAnalyticsCohortMutation(c.config, OpUpdate)
+	return &AnalyticsCohortUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnalyticsCohortClient) UpdateOne(_m *AnalyticsCohort) *AnalyticsCohortUpdateOne {
+	mutation := newAnalyticsCohortMutation(c.config, OpUpdateOne, withAnalyticsCohort(_m))
+	return &AnalyticsCohortUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnalyticsCohortClient) UpdateOneID(id xid.ID) *AnalyticsCohortUpdateOne {
+	mutation := newAnalyticsCohortMutation(c.config, OpUpdateOne, withAnalyticsCohortID(id))
+	return &AnalyticsCohortUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AnalyticsCohort.
+func (c *AnalyticsCohortClient) Delete() *AnalyticsCohortDelete {
+	mutation := newAnalyticsCohortMutation(c.config, OpDelete)
+	return &AnalyticsCohortDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnalyticsCohortClient) DeleteOne(_m *AnalyticsCohort) *AnalyticsCohortDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnalyticsCohortClient) DeleteOneID(id xid.ID) *AnalyticsCohortDeleteOne {
+	builder := c.Delete().Where(analyticscohort.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnalyticsCohortDeleteOne{builder}
+}
+
+// Query returns a query builder for AnalyticsCohort.
+func (c *AnalyticsCohortClient) Query() *AnalyticsCohortQuery {
+	return &AnalyticsCohortQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnalyticsCohort},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AnalyticsCohort entity by its id.
+func (c *AnalyticsCohortClient) Get(ctx context.Context, id xid.ID) (*AnalyticsCohort, error) {
+	return c.Query().Where(analyticscohort.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnalyticsCohortClient) GetX(ctx context.Context, id xid.ID) *AnalyticsCohort {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnalyticsCohortClient) Hooks() []Hook {
+	return c.hooks.AnalyticsCohort
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnalyticsCohortClient) Interceptors() []Interceptor {
+	return c.inters.AnalyticsCohort
+}
+
+func (c *AnalyticsCohortClient) mutate(ctx context.Context, m *AnalyticsCohortMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnalyticsCohortCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnalyticsCohortUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnalyticsCohortUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnalyticsCohortDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AnalyticsCohort mutation op: %q", m.Op())
+	}
+}
+
+// AnalyticsContentClient is a client for the AnalyticsContent schema.
+type AnalyticsContentClient struct {
+	config
+}
+
+// NewAnalyticsContentClient returns a client for the AnalyticsContent from the given config.
+func NewAnalyticsContentClient(c config) *AnalyticsContentClient {
+	return &AnalyticsContentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `analyticscontent.Hooks(f(g(h())))`.
+func (c *AnalyticsContentClient) Use(hooks ...Hook) {
+	c.hooks.AnalyticsContent = append(c.hooks.AnalyticsContent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `analyticscontent.Intercept(f(g(h())))`.
+func (c *AnalyticsContentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnalyticsContent = append(c.inters.AnalyticsContent, interceptors...)
+}
+
+// Create returns a builder for creating a AnalyticsContent entity.
+func (c *AnalyticsContentClient) Create() *AnalyticsContentCreate {
+	mutation := newAnalyticsContentMutation(c.config, OpCreate)
+	return &AnalyticsContentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AnalyticsContent entities.
+func (c *AnalyticsContentClient) CreateBulk(builders ...*AnalyticsContentCreate) *AnalyticsContentCreateBulk {
+	return &AnalyticsContentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnalyticsContentClient) MapCreateBulk(slice any, setFunc func(*AnalyticsContentCreate, int)) *AnalyticsContentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnalyticsContentCreateBulk{err: fmt.Errorf("calling to AnalyticsContentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnalyticsContentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnalyticsContentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AnalyticsContent.
+func (c *AnalyticsContentClient) Update() *AnalyticsContentUpdate {
+	mutation := newAnalyticsContentMutation(c.config, OpUpdate)
+	return &AnalyticsContentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnalyticsContentClient) UpdateOne(_m *AnalyticsContent) *AnalyticsContentUpdateOne {
+	mutation := newAnalyticsContentMutation(c.config, OpUpdateOne, withAnalyticsContent(_m))
+	return &AnalyticsContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnalyticsContentClient) UpdateOneID(id xid.ID) *AnalyticsContentUpdateOne {
+	mutation := newAnalyticsContentMutation(c.config, OpUpdateOne, withAnalyticsContentID(id))
+	return &AnalyticsContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AnalyticsContent.
+func (c *AnalyticsContentClient) Delete() *AnalyticsContentDelete {
+	mutation := newAnalyticsContentMutation(c.config, OpDelete)
+	return &AnalyticsContentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnalyticsContentClient) DeleteOne(_m *AnalyticsContent) *AnalyticsContentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnalyticsContentClient) DeleteOneID(id xid.ID) *AnalyticsContentDeleteOne {
+	builder := c.Delete().Where(analyticscontent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnalyticsContentDeleteOne{builder}
+}
+
+// Query returns a query builder for AnalyticsContent.
+func (c *AnalyticsContentClient) Query() *AnalyticsContentQuery {
+	return &AnalyticsContentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnalyticsContent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AnalyticsContent entity by its id.
+func (c *AnalyticsContentClient) Get(ctx context.Context, id xid.ID) (*AnalyticsContent, error) {
+	return c.Query().Where(analyticscontent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnalyticsContentClient) GetX(ctx context.Context, id xid.ID) *AnalyticsContent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnalyticsContentClient) Hooks() []Hook {
+	return c.hooks.AnalyticsContent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnalyticsContentClient) Interceptors() []Interceptor {
+	return c.inters.AnalyticsContent
+}
+
+func (c *AnalyticsContentClient) mutate(ctx context.Context, m *AnalyticsContentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnalyticsContentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnalyticsContentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnalyticsContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnalyticsContentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AnalyticsContent mutation op: %q", m.Op())
+	}
+}
+
+// AnalyticsDayClient is a client for the AnalyticsDay schema.
+type AnalyticsDayClient struct {
+	config
+}
+
+// NewAnalyticsDayClient returns a client for the AnalyticsDay from the given config.
+func NewAnalyticsDayClient(c config) *AnalyticsDayClient {
+	return &AnalyticsDayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `analyticsday.Hooks(f(g(h())))`.
+func (c *AnalyticsDayClient) Use(hooks ...Hook) {
+	c.hooks.AnalyticsDay = append(c.hooks.AnalyticsDay, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `analyticsday.Intercept(f(g(h())))`.
+func (c *AnalyticsDayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnalyticsDay = append(c.inters.AnalyticsDay, interceptors...)
+}
+
+// Create returns a builder for creating a AnalyticsDay entity.
+func (c *AnalyticsDayClient) Create() *AnalyticsDayCreate {
+	mutation := newAnalyticsDayMutation(c.config, OpCreate)
+	return &AnalyticsDayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AnalyticsDay entities.
+func (c *AnalyticsDayClient) CreateBulk(builders ...*AnalyticsDayCreate) *AnalyticsDayCreateBulk {
+	return &AnalyticsDayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnalyticsDayClient) MapCreateBulk(slice any, setFunc func(*AnalyticsDayCreate, int)) *AnalyticsDayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnalyticsDayCreateBulk{err: fmt.Errorf("calling to AnalyticsDayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnalyticsDayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnalyticsDayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AnalyticsDay.
+func (c *AnalyticsDayClient) Update() *AnalyticsDayUpdate {
+	mutation := newAnalyticsDayMutation(c.config, OpUpdate)
+	return &AnalyticsDayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnalyticsDayClient) UpdateOne(_m *AnalyticsDay) *AnalyticsDayUpdateOne {
+	mutation := newAnalyticsDayMutation(c.config, OpUpdateOne, withAnalyticsDay(_m))
+	return &AnalyticsDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnalyticsDayClient) UpdateOneID(id xid.ID) *AnalyticsDayUpdateOne {
+	mutation := newAnalyticsDayMutation(c.config, OpUpdateOne, withAnalyticsDayID(id))
+	return &AnalyticsDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AnalyticsDay.
+func (c *AnalyticsDayClient) Delete() *AnalyticsDayDelete {
+	mutation := newAnalyticsDayMutation(c.config, OpDelete)
+	return &AnalyticsDayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnalyticsDayClient) DeleteOne(_m *AnalyticsDay) *AnalyticsDayDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnalyticsDayClient) DeleteOneID(id xid.ID) *AnalyticsDayDeleteOne {
+	builder := c.Delete().Where(analyticsday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnalyticsDayDeleteOne{builder}
+}
+
+// Query returns a query builder for AnalyticsDay.
+func (c *AnalyticsDayClient) Query() *AnalyticsDayQuery {
+	return &AnalyticsDayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnalyticsDay},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AnalyticsDay entity by its id.
+func (c *AnalyticsDayClient) Get(ctx context.Context, id xid.ID) (*AnalyticsDay, error) {
+	return c.Query().Where(analyticsday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnalyticsDayClient) GetX(ctx context.Context, id xid.ID) *AnalyticsDay {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnalyticsDayClient) Hooks() []Hook {
+	return c.hooks.AnalyticsDay
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnalyticsDayClient) Interceptors() []Interceptor {
+	return c.inters.AnalyticsDay
+}
+
+func (c *AnalyticsDayClient) mutate(ctx context.Context, m *AnalyticsDayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnalyticsDayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnalyticsDayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnalyticsDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnalyticsDayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AnalyticsDay mutation op: %q", m.Op())
+	}
+}
+
+// AnalyticsExportClient is a client for the AnalyticsExport schema.
+type AnalyticsExportClient struct {
+	config
+}
+
+// NewAnalyticsExportClient returns a client for the AnalyticsExport from the given config.
+func NewAnalyticsExportClient(c config) *AnalyticsExportClient {
+	return &AnalyticsExportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `analyticsexport.Hooks(f(g(h())))`.
+func (c *AnalyticsExportClient) Use(hooks ...Hook) {
+	c.hooks.AnalyticsExport = append(c.hooks.AnalyticsExport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `analyticsexport.Intercept(f(g(h())))`.
+func (c *AnalyticsExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnalyticsExport = append(c.inters.AnalyticsExport, interceptors...)
+}
+
+// Create returns a builder for creating a AnalyticsExport entity.
+func (c *AnalyticsExportClient) Create() *AnalyticsExportCreate {
+	mutation := newAnalyticsExportMutation(c.config, OpCreate)
+	return &AnalyticsExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AnalyticsExport entities.
+func (c *AnalyticsExportClient) CreateBulk(builders ...*AnalyticsExportCreate) *AnalyticsExportCreateBulk {
+	return &AnalyticsExportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnalyticsExportClient) MapCreateBulk(slice any, setFunc func(*AnalyticsExportCreate, int)) *AnalyticsExportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnalyticsExportCreateBulk{err: fmt.Errorf("calling to AnalyticsExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnalyticsExportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnalyticsExportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AnalyticsExport.
+func (c *AnalyticsExportClient) Update() *AnalyticsExportUpdate {
+	mutation := newAnalyticsExportMutation(c.config, OpUpdate)
+	return &AnalyticsExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnalyticsExportClient) UpdateOne(_m *AnalyticsExport) *AnalyticsExportUpdateOne {
+	mutation := newAnalyticsExportMutation(c.config, OpUpdateOne, withAnalyticsExport(_m))
+	return &AnalyticsExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnalyticsExportClient) UpdateOneID(id xid.ID) *AnalyticsExportUpdateOne {
+	mutation := newAnalyticsExportMutation(c.config, OpUpdateOne, withAnalyticsExportID(id))
+	return &AnalyticsExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AnalyticsExport.
+func (c *AnalyticsExportClient) Delete() *AnalyticsExportDelete {
+	mutation := newAnalyticsExportMutation(c.config, OpDelete)
+	return &AnalyticsExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnalyticsExportClient) DeleteOne(_m *AnalyticsExport) *AnalyticsExportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnalyticsExportClient) DeleteOneID(id xid.ID) *AnalyticsExportDeleteOne {
+	builder := c.Delete().Where(analyticsexport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnalyticsExportDeleteOne{builder}
+}
+
+// Query returns a query builder for AnalyticsExport.
+func (c *AnalyticsExportClient) Query() *AnalyticsExportQuery {
+	return &AnalyticsExportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnalyticsExport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AnalyticsExport entity by its id.
+func (c *AnalyticsExportClient) Get(ctx context.Context, id xid.ID) (*AnalyticsExport, error) {
+	return c.Query().Where(analyticsexport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnalyticsExportClient) GetX(ctx context.Context, id xid.ID) *AnalyticsExport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnalyticsExportClient) Hooks() []Hook {
+	return c.hooks.AnalyticsExport
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnalyticsExportClient) Interceptors() []Interceptor {
+	return c.inters.AnalyticsExport
+}
+
+func (c *AnalyticsExportClient) mutate(ctx context.Context, m *AnalyticsExportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnalyticsExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnalyticsExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnalyticsExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnalyticsExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AnalyticsExport mutation op: %q", m.Op())
+	}
+}
+
+// AssetClient is a client for the Asset schema.
+type AssetClient struct {
+	config
+}
+
+// NewAssetClient returns a client for the Asset from the given config.
+func NewAssetClient(c config) *AssetClient {
+	return &AssetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `asset.Hooks(f(g(h())))`.
+func (c *AssetClient) Use(hooks ...Hook) {
+	c.hooks.Asset = append(c.hooks.Asset, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `asset.Intercept(f(g(h())))`.
+func (c *AssetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Asset = append(c.inters.Asset, interceptors...)
+}
+
+// Create returns a builder for creating a Asset entity.
+func (c *AssetClient) Create() *AssetCreate {
+	mutation := newAssetMutation(c.config, OpCreate)
+	return &AssetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Asset entities.
+func (c *AssetClient) CreateBulk(builders ...*AssetCreate) *AssetCreateBulk {
+	return &AssetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AssetClient) MapCreateBulk(slice any, setFunc func(*AssetCreate, int)) *AssetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AssetCreateBulk{err: fmt.Errorf("calling to AssetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AssetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AssetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Asset.
+func (c *AssetClient) Update() *AssetUpdate {
+	mutation := newAssetMutation(c.config, OpUpdate)
+	return &AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AssetClient) UpdateOne(_m *Asset) *AssetUpdateOne {
+	mutation := newAssetMutation(c.config, OpUpdateOne, withAsset(_m))
+	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AssetClient) UpdateOneID(id xid.ID) *AssetUpdateOne {
+	mutation := newAssetMutation(c.config, OpUpdateOne, withAssetID(id))
+	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Asset.
+func (c *AssetClient) Delete() *AssetDelete {
+	mutation := newAssetMutation(c.config, OpDelete)
+	return &AssetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AssetClient) DeleteOne(_m *Asset) *AssetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AssetClient) DeleteOneID(id xid.ID) *AssetDeleteOne {
+	builder := c.Delete().Where(asset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AssetDeleteOne{builder}
+}
+
+// Query returns a query builder for Asset.
+func (c *AssetClient) Query() *AssetQuery {
+	return &AssetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAsset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Asset entity by its id.
+func (c *AssetClient) Get(ctx context.Context, id xid.ID) (*Asset, error) {
+	return c.Query().Where(asset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AssetClient) GetX(ctx context.Context, id xid.ID) *Asset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPosts queries the posts edge of a Asset.
+func (c *AssetClient) QueryPosts(_m *Asset) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, asset.PostsTable, asset.PostsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNodes queries the nodes edge of a Asset.
+func (c *AssetClient) QueryNodes(_m *Asset) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, asset.NodesTable, asset.NodesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinks queries the links edge of a Asset.
+func (c *AssetClient) QueryLinks(_m *Asset) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, asset.LinksTable, asset.LinksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDirectMessages queries the direct_messages edge of a Asset.
+func (c *AssetClient) QueryDirectMessages(_m *Asset) *DirectMessageQuery {
+	query := (&DirectMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(directmessage.Table, directmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, asset.DirectMessagesTable, asset.DirectMessagesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwner queries the owner edge of a Asset.
+func (c *AssetClient) QueryOwner(_m *Asset) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, asset.OwnerTable, asset.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Asset.
+func (c *AssetClient) QueryParent(_m *Asset) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, asset.ParentTable, asset.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssets queries the assets edge of a Asset.
+func (c *AssetClient) QueryAssets(_m *Asset) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, asset.AssetsTable, asset.AssetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvent queries the event edge of a Asset.
+func (c *AssetClient) QueryEvent(_m *Asset) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, asset.EventTable, asset.EventColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AssetClient) Hooks() []Hook {
+	return c.hooks.Asset
+}
+
+// Interceptors returns the client interceptors.
+func (c *AssetClient) Interceptors() []Interceptor {
+	return c.inters.Asset
+}
+
+func (c *AssetClient) mutate(ctx context.Context, m *AssetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AssetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AssetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Asset mutation op: %q", m.Op())
+	}
+}
+
+// AuthenticationClient is a client for the Authentication schema.
+type AuthenticationClient struct {
+	config
+}
+
+// NewAuthenticationClient returns a client for the Authentication from the given config.
+func NewAuthenticationClient(c config) *AuthenticationClient {
+	return &AuthenticationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `authentication.Hooks(f(g(h())))`.
+func (c *AuthenticationClient) Use(hooks ...Hook) {
+	c.hooks.Authentication = append(c.hooks.Authentication, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `authentication.Intercept(f(g(h())))`.
+func (c *AuthenticationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Authentication = append(c.inters.Authentication, interceptors...)
+}
+
+// Create returns a builder for creating a Authentication entity.
+func (c *AuthenticationClient) Create() *AuthenticationCreate {
+	mutation := newAuthenticationMutation(c.config, OpCreate)
+	return &AuthenticationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Authentication entities.
+func (c *AuthenticationClient) CreateBulk(builders ...*AuthenticationCreate) *AuthenticationCreateBulk {
+	return &AuthenticationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuthenticationClient) MapCreateBulk(slice any, setFunc func(*AuthenticationCreate, int)) *AuthenticationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuthenticationCreateBulk{err: fmt.Errorf("calling to AuthenticationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuthenticationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuthenticationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Authentication.
+func (c *AuthenticationClient) Update() *AuthenticationUpdate {
+	mutation := newAuthenticationMutation(c.config, OpUpdate)
+	return &AuthenticationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuthenticationClient) UpdateOne(_m *Authentication) *AuthenticationUpdateOne {
+	mutation := newAuthenticationMutation(c.config, OpUpdateOne, withAuthentication(_m))
+	return &AuthenticationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuthenticationClient) UpdateOneID(id xid.ID) *AuthenticationUpdateOne {
+	mutation := newAuthenticationMutation(c.config, OpUpdateOne, withAuthenticationID(id))
+	return &AuthenticationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Authentication.
+func (c *AuthenticationClient) Delete() *AuthenticationDelete {
+	mutation := newAuthenticationMutation(c.config, OpDelete)
+	return &AuthenticationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuthenticationClient) DeleteOne(_m *Authentication) *AuthenticationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuthenticationClient) DeleteOneID(id xid.ID) *AuthenticationDeleteOne {
+	builder := c.Delete().Where(authentication.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuthenticationDeleteOne{builder}
+}
+
+// Query returns a query builder for Authentication.
+func (c *AuthenticationClient) Query() *AuthenticationQuery {
+	return &AuthenticationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuthentication},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Authentication entity by its id.
+func (c *AuthenticationClient) Get(ctx context.Context, id xid.ID) (*Authentication, error) {
+	return c.Query().Where(authentication.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuthenticationClient) GetX(ctx context.Context, id xid.ID) *Authentication {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Authentication.
+func (c *AuthenticationClient) QueryAccount(_m *Authentication) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authentication.Table, authentication.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, authentication.AccountTable, authentication.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIUsage queries the api_usage edge of a Authentication.
+func (c *AuthenticationClient) QueryAPIUsage(_m *Authentication) *APIUsageQuery {
+	query := (&APIUsageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authentication.Table, authentication.FieldID, id),
+			sqlgraph.To(apiusage.Table, apiusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, authentication.APIUsageTable, authentication.APIUsageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuthenticationClient) Hooks() []Hook {
+	return c.hooks.Authentication
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuthenticationClient) Interceptors() []Interceptor {
+	return c.inters.Authentication
+}
+
+func (c *AuthenticationClient) mutate(ctx context.Context, m *AuthenticationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuthenticationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuthenticationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuthenticationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuthenticationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Authentication mutation op: %q", m.Op())
+	}
+}
+
+// AutomodRuleClient is a client for the AutomodRule schema.
+type AutomodRuleClient struct {
+	config
+}
+
+// NewAutomodRuleClient returns a client for the AutomodRule from the given config.
+func NewAutomodRuleClient(c config) *AutomodRuleClient {
+	return &AutomodRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `automodrule.Hooks(f(g(h())))`.
+func (c *AutomodRuleClient) Use(hooks ...Hook) {
+	c.hooks.AutomodRule = append(c.hooks.AutomodRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `automodrule.Intercept(f(g(h())))`.
+func (c *AutomodRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AutomodRule = append(c.inters.AutomodRule, interceptors...)
+}
+
+// Create returns a builder for creating a AutomodRule entity.
+func (c *AutomodRuleClient) Create() *AutomodRuleCreate {
+	mutation := newAutomodRuleMutation(c.config, OpCreate)
+	return &AutomodRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AutomodRule entities.
+func (c *AutomodRuleClient) CreateBulk(builders ...*AutomodRuleCreate) *AutomodRuleCreateBulk {
+	return &AutomodRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AutomodRuleClient) MapCreateBulk(slice any, setFunc func(*AutomodRuleCreate, int)) *AutomodRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AutomodRuleCreateBulk{err: fmt.Errorf("calling to AutomodRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AutomodRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AutomodRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AutomodRule.
+func (c *AutomodRuleClient) Update() *AutomodRuleUpdate {
+	mutation := newAutomodRuleMutation(c.config, OpUpdate)
+	return &AutomodRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AutomodRuleClient) UpdateOne(_m *AutomodRule) *AutomodRuleUpdateOne {
+	mutation := newAutomodRuleMutation(c.config, OpUpdateOne, withAutomodRule(_m))
+	return &AutomodRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AutomodRuleClient) UpdateOneID(id xid.ID) *AutomodRuleUpdateOne {
+	mutation := newAutomodRuleMutation(c.config, OpUpdateOne, withAutomodRuleID(id))
+	return &AutomodRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AutomodRule.
+func (c *AutomodRuleClient) Delete() *AutomodRuleDelete {
+	mutation := newAutomodRuleMutation(c.config, OpDelete)
+	return &AutomodRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AutomodRuleClient) DeleteOne(_m *AutomodRule) *AutomodRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AutomodRuleClient) DeleteOneID(id xid.ID) *AutomodRuleDeleteOne {
+	builder := c.Delete().Where(automodrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AutomodRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for AutomodRule.
+func (c *AutomodRuleClient) Query() *AutomodRuleQuery {
+	return &AutomodRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAutomodRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AutomodRule entity by its id.
+func (c *AutomodRuleClient) Get(ctx context.Context, id xid.ID) (*AutomodRule, error) {
+	return c.Query().Where(automodrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AutomodRuleClient) GetX(ctx context.Context, id xid.ID) *AutomodRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AutomodRuleClient) Hooks() []Hook {
+	return c.hooks.AutomodRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *AutomodRuleClient) Interceptors() []Interceptor {
+	return c.inters.AutomodRule
+}
+
+func (c *AutomodRuleClient) mutate(ctx context.Context, m *AutomodRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AutomodRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AutomodRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AutomodRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AutomodRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AutomodRule mutation op: %q", m.Op())
+	}
+}
+
+// BadgeClient is a client for the Badge schema.
+type BadgeClient struct {
+	config
+}
+
+// NewBadgeClient returns a client for the Badge from the given config.
+func NewBadgeClient(c config) *BadgeClient {
+	return &BadgeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `badge.Hooks(f(g(h())))`.
+func (c *BadgeClient) Use(hooks ...Hook) {
+	c.hooks.Badge = append(c.hooks.Badge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `badge.Intercept(f(g(h())))`.
+func (c *BadgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Badge = append(c.inters.Badge, interceptors...)
+}
+
+// Create returns a builder for creating a Badge entity.
+func (c *BadgeClient) Create() *BadgeCreate {
+	mutation := newBadgeMutation(c.config, OpCreate)
+	return &BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Badge entities.
+func (c *BadgeClient) CreateBulk(builders ...*BadgeCreate) *BadgeCreateBulk {
+	return &BadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BadgeClient) MapCreateBulk(slice any, setFunc func(*BadgeCreate, int)) *BadgeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BadgeCreateBulk{err: fmt.Errorf("calling to BadgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BadgeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Badge.
+func (c *BadgeClient) Update() *BadgeUpdate {
+	mutation := newBadgeMutation(c.config, OpUpdate)
+	return &BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BadgeClient) UpdateOne(_m *Badge) *BadgeUpdateOne {
+	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadge(_m))
+	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BadgeClient) UpdateOneID(id xid.ID) *BadgeUpdateOne {
+	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadgeID(id))
+	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Badge.
+func (c *BadgeClient) Delete() *BadgeDelete {
+	mutation := newBadgeMutation(c.config, OpDelete)
+	return &BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BadgeClient) DeleteOne(_m *Badge) *BadgeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BadgeClient) DeleteOneID(id xid.ID) *BadgeDeleteOne {
+	builder := c.Delete().Where(badge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BadgeDeleteOne{builder}
+}
+
+// Query returns a query builder for Badge.
+func (c *BadgeClient) Query() *BadgeQuery {
+	return &BadgeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBadge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Badge entity by its id.
+func (c *BadgeClient) Get(ctx context.Context, id xid.ID) (*Badge, error) {
+	return c.Query().Where(badge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BadgeClient) GetX(ctx context.Context, id xid.ID) *Badge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAwards queries the awards edge of a Badge.
+func (c *BadgeClient) QueryAwards(_m *Badge) *AccountBadgeQuery {
+	query := (&AccountBadgeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(badge.Table, badge.FieldID, id),
+			sqlgraph.To(accountbadge.Table, accountbadge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, badge.AwardsTable, badge.AwardsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BadgeClient) Hooks() []Hook {
+	return c.hooks.Badge
+}
+
+// Interceptors returns the client interceptors.
+func (c *BadgeClient) Interceptors() []Interceptor {
+	return c.inters.Badge
+}
+
+func (c *BadgeClient) mutate(ctx context.Context, m *BadgeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Badge mutation op: %q", m.Op())
+	}
+}
+
+// BookmarkClient is a client for the Bookmark schema.
+type BookmarkClient struct {
+	config
+}
+
+// NewBookmarkClient returns a client for the Bookmark from the given config.
+func NewBookmarkClient(c config) *BookmarkClient {
+	return &BookmarkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bookmark.Hooks(f(g(h())))`.
+func (c *BookmarkClient) Use(hooks ...Hook) {
+	c.hooks.Bookmark = append(c.hooks.Bookmark, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bookmark.Intercept(f(g(h())))`.
+func (c *BookmarkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Bookmark = append(c.inters.Bookmark, interceptors...)
+}
+
+// Create returns a builder for creating a Bookmark entity.
+func (c *BookmarkClient) Create() *BookmarkCreate {
+	mutation := newBookmarkMutation(c.config, OpCreate)
+	return &BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Bookmark entities.
+func (c *BookmarkClient) CreateBulk(builders ...*BookmarkCreate) *BookmarkCreateBulk {
+	return &BookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BookmarkClient) MapCreateBulk(slice any, setFunc func(*BookmarkCreate, int)) *BookmarkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BookmarkCreateBulk{err: fmt.Errorf("calling to BookmarkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BookmarkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Bookmark.
+func (c *BookmarkClient) Update() *BookmarkUpdate {
+	mutation := newBookmarkMutation(c.config, OpUpdate)
+	return &BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BookmarkClient) UpdateOne(_m *Bookmark) *BookmarkUpdateOne {
+	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmark(_m))
+	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BookmarkClient) UpdateOneID(id xid.ID) *BookmarkUpdateOne {
+	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmarkID(id))
+	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Bookmark.
+func (c *BookmarkClient) Delete() *BookmarkDelete {
+	mutation := newBookmarkMutation(c.config, OpDelete)
+	return &BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BookmarkClient) DeleteOne(_m *Bookmark) *BookmarkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BookmarkClient) DeleteOneID(id xid.ID) *BookmarkDeleteOne {
+	builder := c.Delete().Where(bookmark.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BookmarkDeleteOne{builder}
+}
+
+// Query returns a query builder for Bookmark.
+func (c *BookmarkClient) Query() *BookmarkQuery {
+	return &BookmarkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBookmark},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Bookmark entity by its id.
+func (c *BookmarkClient) Get(ctx context.Context, id xid.ID) (*Bookmark, error) {
+	return c.Query().Where(bookmark.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BookmarkClient) GetX(ctx context.Context, id xid.ID) *Bookmark {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Bookmark.
+func (c *BookmarkClient) QueryAccount(_m *Bookmark) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookmark.Table, bookmark.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bookmark.AccountTable, bookmark.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BookmarkClient) Hooks() []Hook {
+	return c.hooks.Bookmark
+}
+
+// Interceptors returns the client interceptors.
+func (c *BookmarkClient) Interceptors() []Interceptor {
+	return c.inters.Bookmark
+}
+
+func (c *BookmarkClient) mutate(ctx context.Context, m *BookmarkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Bookmark mutation op: %q", m.Op())
+	}
+}
+
+// CategoryClient is a client for the Category schema.
+type CategoryClient struct {
+	config
+}
+
+// NewCategoryClient returns a client for the Category from the given config.
+func NewCategoryClient(c config) *CategoryClient {
+	return &CategoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `category.Hooks(f(g(h())))`.
+func (c *CategoryClient) Use(hooks ...Hook) {
+	c.hooks.Category = append(c.hooks.Category, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `category.Intercept(f(g(h())))`.
+func (c *CategoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Category = append(c.inters.Category, interceptors...)
+}
+
+// Create returns a builder for creating a Category entity.
+func (c *CategoryClient) Create() *CategoryCreate {
+	mutation := newCategoryMutation(c.config, OpCreate)
+	return &CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Category entities.
+func (c *CategoryClient) CreateBulk(builders ...*CategoryCreate) *CategoryCreateBulk {
+	return &CategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryClient) MapCreateBulk(slice any, setFunc func(*CategoryCreate, int)) *CategoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryCreateBulk{err: fmt.Errorf("calling to CategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Category.
+func (c *CategoryClient) Update() *CategoryUpdate {
+	mutation := newCategoryMutation(c.config, OpUpdate)
+	return &CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryClient) UpdateOne(_m *Category) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategory(_m))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryClient) UpdateOneID(id xid.ID) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategoryID(id))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Category.
+func (c *CategoryClient) Delete() *CategoryDelete {
+	mutation := newCategoryMutation(c.config, OpDelete)
+	return &CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryClient) DeleteOne(_m *Category) *CategoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryClient) DeleteOneID(id xid.ID) *CategoryDeleteOne {
+	builder := c.Delete().Where(category.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryDeleteOne{builder}
+}
+
+// Query returns a query builder for Category.
+func (c *CategoryClient) Query() *CategoryQuery {
+	return &CategoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Category entity by its id.
+func (c *CategoryClient) Get(ctx context.Context, id xid.ID) (*Category, error) {
+	return c.Query().Where(category.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryClient) GetX(ctx context.Context, id xid.ID) *Category {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPosts queries the posts edge of a Category.
+func (c *CategoryClient) QueryPosts(_m *Category) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.PostsTable, category.PostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Category.
+func (c *CategoryClient) QueryParent(_m *Category) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, category.ParentTable, category.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Category.
+func (c *CategoryClient) QueryChildren(_m *Category) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.ChildrenTable, category.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCoverImage queries the cover_image edge of a Category.
+func (c *CategoryClient) QueryCoverImage(_m *Category) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, category.CoverImageTable, category.CoverImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFollowers queries the followers edge of a Category.
+func (c *CategoryClient) QueryFollowers(_m *Category) *CategoryFollowQuery {
+	query := (&CategoryFollowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(categoryfollow.Table, categoryfollow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.FollowersTable, category.FollowersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a Category.
+func (c *CategoryClient) QueryPermissions(_m *Category) *CategoryPermissionQuery {
+	query := (&CategoryPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(categorypermission.Table, categorypermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.PermissionsTable, category.PermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequiredTags queries the required_tags edge of a Category.
+func (c *CategoryClient) QueryRequiredTags(_m *Category) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, category.RequiredTagsTable, category.RequiredTagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryClient) Hooks() []Hook {
+	return c.hooks.Category
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryClient) Interceptors() []Interceptor {
+	return c.inters.Category
+}
+
+func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Category mutation op: %q", m.Op())
+	}
+}
+
+// CategoryFollowClient is a client for the CategoryFollow schema.
+type CategoryFollowClient struct {
+	config
+}
+
+// NewCategoryFollowClient returns a client for the CategoryFollow from the given config.
+func NewCategoryFollowClient(c config) *CategoryFollowClient {
+	return &CategoryFollowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categoryfollow.Hooks(f(g(h())))`.
+func (c *CategoryFollowClient) Use(hooks ...Hook) {
+	c.hooks.CategoryFollow = append(c.hooks.CategoryFollow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categoryfollow.Intercept(f(g(h())))`.
+func (c *CategoryFollowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryFollow = append(c.inters.CategoryFollow, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryFollow entity.
+func (c *CategoryFollowClient) Create() *CategoryFollowCreate {
+	mutation := newCategoryFollowMutation(c.config, OpCreate)
+	return &CategoryFollowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryFollow entities.
+func (c *CategoryFollowClient) CreateBulk(builders ...*CategoryFollowCreate) *CategoryFollowCreateBulk {
+	return &CategoryFollowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryFollowClient) MapCreateBulk(slice any, setFunc func(*CategoryFollowCreate, int)) *CategoryFollowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryFollowCreateBulk{err: fmt.Errorf("calling to CategoryFollowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryFollowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryFollowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryFollow.
+func (c *CategoryFollowClient) Update() *CategoryFollowUpdate {
+	mutation := newCategoryFollowMutation(c.config, OpUpdate)
+	return &CategoryFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryFollowClient) UpdateOne(_m *CategoryFollow) *CategoryFollowUpdateOne {
+	mutation := newCategoryFollowMutation(c.config, OpUpdateOne, withCategoryFollow(_m))
+	return &CategoryFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryFollowClient) UpdateOneID(id xid.ID) *CategoryFollowUpdateOne {
+	mutation := newCategoryFollowMutation(c.config, OpUpdateOne, withCategoryFollowID(id))
+	return &CategoryFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryFollow.
+func (c *CategoryFollowClient) Delete() *CategoryFollowDelete {
+	mutation := newCategoryFollowMutation(c.config, OpDelete)
+	return &CategoryFollowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryFollowClient) DeleteOne(_m *CategoryFollow) *CategoryFollowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryFollowClient) DeleteOneID(id xid.ID) *CategoryFollowDeleteOne {
+	builder := c.Delete().Where(categoryfollow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryFollowDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryFollow.
+func (c *CategoryFollowClient) Query() *CategoryFollowQuery {
+	return &CategoryFollowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryFollow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryFollow entity by its id.
+func (c *CategoryFollowClient) Get(ctx context.Context, id xid.ID) (*CategoryFollow, error) {
+	return c.Query().Where(categoryfollow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryFollowClient) GetX(ctx context.Context, id xid.ID) *CategoryFollow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a CategoryFollow.
+func (c *CategoryFollowClient) QueryAccount(_m *CategoryFollow) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(categoryfollow.Table, categoryfollow.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, categoryfollow.AccountTable, categoryfollow.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategory queries the category edge of a CategoryFollow.
+func (c *CategoryFollowClient) QueryCategory(_m *CategoryFollow) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(categoryfollow.Table, categoryfollow.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, categoryfollow.CategoryTable, categoryfollow.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryFollowClient) Hooks() []Hook {
+	return c.hooks.CategoryFollow
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryFollowClient) Interceptors() []Interceptor {
+	return c.inters.CategoryFollow
+}
+
+func (c *CategoryFollowClient) mutate(ctx context.Context, m *CategoryFollowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryFollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryFollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryFollow mutation op: %q", m.Op())
+	}
+}
+
+// CategoryPermissionClient is a client for the CategoryPermission schema.
+type CategoryPermissionClient struct {
+	config
+}
+
+// NewCategoryPermissionClient returns a client for the CategoryPermission from the given config.
+func NewCategoryPermissionClient(c config) *CategoryPermissionClient {
+	return &CategoryPermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categorypermission.Hooks(f(g(h())))`.
+func (c *CategoryPermissionClient) Use(hooks ...Hook) {
+	c.hooks.CategoryPermission = append(c.hooks.CategoryPermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categorypermission.Intercept(f(g(h())))`.
+func (c *CategoryPermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryPermission = append(c.inters.CategoryPermission, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryPermission entity.
+func (c *CategoryPermissionClient) Create() *CategoryPermissionCreate {
+	mutation := newCategoryPermissionMutation(c.config, OpCreate)
+	return &CategoryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryPermission entities.
+func (c *CategoryPermissionClient) CreateBulk(builders ...*CategoryPermissionCreate) *CategoryPermissionCreateBulk {
+	return &CategoryPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryPermissionClient) MapCreateBulk(slice any, setFunc func(*CategoryPermissionCreate, int)) *CategoryPermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryPermissionCreateBulk{err: fmt.Errorf("calling to CategoryPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryPermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryPermission.
+func (c *CategoryPermissionClient) Update() *CategoryPermissionUpdate {
+	mutation := newCategoryPermissionMutation(c.config, OpUpdate)
+	return &CategoryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryPermissionClient) UpdateOne(_m *CategoryPermission) *CategoryPermissionUpdateOne {
+	mutation := newCategoryPermissionMutation(c.config, OpUpdateOne, withCategoryPermission(_m))
+	return &CategoryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryPermissionClient) UpdateOneID(id xid.ID) *CategoryPermissionUpdateOne {
+	mutation := newCategoryPermissionMutation(c.config, OpUpdateOne, withCategoryPermissionID(id))
+	return &CategoryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryPermission.
+func (c *CategoryPermissionClient) Delete() *CategoryPermissionDelete {
+	mutation := newCategoryPermissionMutation(c.config, OpDelete)
+	return &CategoryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryPermissionClient) DeleteOne(_m *CategoryPermission) *CategoryPermissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryPermissionClient) DeleteOneID(id xid.ID) *CategoryPermissionDeleteOne {
+	builder := c.Delete().Where(categorypermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryPermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryPermission.
+func (c *CategoryPermissionClient) Query() *CategoryPermissionQuery {
+	return &CategoryPermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryPermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryPermission entity by its id.
+func (c *CategoryPermissionClient) Get(ctx context.Context, id xid.ID) (*CategoryPermission, error) {
+	return c.Query().Where(categorypermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryPermissionClient) GetX(ctx context.Context, id xid.ID) *CategoryPermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCategory queries the category edge of a CategoryPermission.
+func (c *CategoryPermissionClient) QueryCategory(_m *CategoryPermission) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(categorypermission.Table, categorypermission.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, categorypermission.CategoryTable, categorypermission.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryPermissionClient) Hooks() []Hook {
+	return c.hooks.CategoryPermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryPermissionClient) Interceptors() []Interceptor {
+	return c.inters.CategoryPermission
+}
+
+func (c *CategoryPermissionClient) mutate(ctx context.Context, m *CategoryPermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryPermission mutation op: %q", m.Op())
+	}
+}
+
+// CollectionClient is a client for the Collection schema.
+type CollectionClient struct {
+	config
+}
+
+// NewCollectionClient returns a client for the Collection from the given config.
+func NewCollectionClient(c config) *CollectionClient {
+	return &CollectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collection.Hooks(f(g(h())))`.
+func (c *CollectionClient) Use(hooks ...Hook) {
+	c.hooks.Collection = append(c.hooks.Collection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collection.Intercept(f(g(h())))`.
+func (c *CollectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Collection = append(c.inters.Collection, interceptors...)
+}
+
+// Create returns a builder for creating a Collection entity.
+func (c *CollectionClient) Create() *CollectionCreate {
+	mutation := newCollectionMutation(c.config, OpCreate)
+	return &CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Collection entities.
+func (c *CollectionClient) CreateBulk(builders ...*CollectionCreate) *CollectionCreateBulk {
+	return &CollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionClient) MapCreateBulk(slice any, setFunc func(*CollectionCreate, int)) *CollectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionCreateBulk{err: fmt.Errorf("calling to CollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Collection.
+func (c *CollectionClient) Update() *CollectionUpdate {
+	mutation := newCollectionMutation(c.config, OpUpdate)
+	return &CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionClient) UpdateOne(_m *Collection) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollection(_m))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollectionClient) UpdateOneID(id xid.ID) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollectionID(id))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Collection.
+func (c *CollectionClient) Delete() *CollectionDelete {
+	mutation := newCollectionMutation(c.config, OpDelete)
+	return &CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CollectionClient) DeleteOne(_m *Collection) *CollectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CollectionClient) DeleteOneID(id xid.ID) *CollectionDeleteOne {
+	builder := c.Delete().Where(collection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollectionDeleteOne{builder}
+}
+
+// Query returns a query builder for Collection.
+func (c *CollectionClient) Query() *CollectionQuery {
+	return &CollectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Collection entity by its id.
+func (c *CollectionClient) Get(ctx context.Context, id xid.ID) (*Collection, error) {
+	return c.Query().Where(collection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollectionClient) GetX(ctx context.Context, id xid.ID) *Collection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Collection.
+func (c *CollectionClient) QueryOwner(_m *Collection) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, collection.OwnerTable, collection.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCoverImage queries the cover_image edge of a Collection.
+func (c *CollectionClient) QueryCoverImage(_m *Collection) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, collection.CoverImageTable, collection.CoverImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPosts queries the posts edge of a Collection.
+func (c *CollectionClient) QueryPosts(_m *Collection) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, collection.PostsTable, collection.PostsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNodes queries the nodes edge of a Collection.
+func (c *CollectionClient) QueryNodes(_m *Collection) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, collection.NodesTable, collection.NodesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySections queries the sections edge of a Collection.
+func (c *CollectionClient) QuerySections(_m *Collection) *CollectionSectionQuery {
+	query := (&CollectionSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(collectionsection.Table, collectionsection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collection.SectionsTable, collection.SectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShares queries the shares edge of a Collection.
+func (c *CollectionClient) QueryShares(_m *Collection) *CollectionShareQuery {
+	query := (&CollectionShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(collectionshare.Table, collectionshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collection.SharesTable, collection.SharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollectionPosts queries the collection_posts edge of a Collection.
+func (c *CollectionClient) QueryCollectionPosts(_m *Collection) *CollectionPostQuery {
+	query := (&CollectionPostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(collectionpost.Table, collectionpost.CollectionColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, collection.CollectionPostsTable, collection.CollectionPostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollectionNodes queries the collection_nodes edge of a Collection.
+func (c *CollectionClient) QueryCollectionNodes(_m *Collection) *CollectionNodeQuery {
+	query := (&CollectionNodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(collectionnode.Table, collectionnode.CollectionColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, collection.CollectionNodesTable, collection.CollectionNodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionClient) Hooks() []Hook {
+	return c.hooks.Collection
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionClient) Interceptors() []Interceptor {
+	return c.inters.Collection
+}
+
+func (c *CollectionClient) mutate(ctx context.Context, m *CollectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Collection mutation op: %q", m.Op())
+	}
+}
+
+// CollectionNodeClient is a client for the CollectionNode schema.
+type CollectionNodeClient struct {
+	config
+}
+
+// NewCollectionNodeClient returns a client for the CollectionNode from the given config.
+func NewCollectionNodeClient(c config) *CollectionNodeClient {
+	return &CollectionNodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collectionnode.Hooks(f(g(h())))`.
+func (c *CollectionNodeClient) Use(hooks ...Hook) {
+	c.hooks.CollectionNode = append(c.hooks.CollectionNode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collectionnode.Intercept(f(g(h())))`.
+func (c *CollectionNodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollectionNode = append(c.inters.CollectionNode, interceptors...)
+}
+
+// Create returns a builder for creating a CollectionNode entity.
+func (c *CollectionNodeClient) Create() *CollectionNodeCreate {
+	mutation := newCollectionNodeMutation(c.config, OpCreate)
+	return &CollectionNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CollectionNode entities.
+func (c *CollectionNodeClient) CreateBulk(builders ...*CollectionNodeCreate) *CollectionNodeCreateBulk {
+	return &CollectionNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionNodeClient) MapCreateBulk(slice any, setFunc func(*CollectionNodeCreate, int)) *CollectionNodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionNodeCreateBulk{err: fmt.Errorf("calling to CollectionNodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionNodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CollectionNode.
+func (c *CollectionNodeClient) Update() *CollectionNodeUpdate {
+	mutation := newCollectionNodeMutation(c.config, OpUpdate)
+	return &CollectionNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionNodeClient) UpdateOne(_m *CollectionNode) *CollectionNodeUpdateOne {
+	mutation := newCollectionNodeMutation(c.config, OpUpdateOne)
+	mutation.collection = &_m.CollectionID
+	mutation.node = &_m.NodeID
+	return &CollectionNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CollectionNode.
+func (c *CollectionNodeClient) Delete() *CollectionNodeDelete {
+	mutation := newCollectionNodeMutation(c.config, OpDelete)
+	return &CollectionNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Query returns a query builder for CollectionNode.
+func (c *CollectionNodeClient) Query() *CollectionNodeQuery {
+	return &CollectionNodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollectionNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// QueryCollection queries the collection edge of a CollectionNode.
+func (c *CollectionNodeClient) QueryCollection(_m *CollectionNode) *CollectionQuery {
+	return c.Query().
+		Where(collectionnode.CollectionID(_m.CollectionID), collectionnode.NodeID(_m.NodeID)).
+		QueryCollection()
+}
+
+// QueryNode queries the node edge of a CollectionNode.
+func (c *CollectionNodeClient) QueryNode(_m *CollectionNode) *NodeQuery {
+	return c.Query().
+		Where(collectionnode.CollectionID(_m.CollectionID), collectionnode.NodeID(_m.NodeID)).
+		QueryNode()
+}
+
+// QuerySection queries the section edge of a CollectionNode.
+func (c *CollectionNodeClient) QuerySection(_m *CollectionNode) *CollectionSectionQuery {
+	return c.Query().
+		Where(collectionnode.CollectionID(_m.CollectionID), collectionnode.NodeID(_m.NodeID)).
+		QuerySection()
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionNodeClient) Hooks() []Hook {
+	return c.hooks.CollectionNode
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionNodeClient) Interceptors() []Interceptor {
+	return c.inters.CollectionNode
+}
+
+func (c *CollectionNodeClient) mutate(ctx context.Context, m *CollectionNodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionNodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionNodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CollectionNode mutation op: %q", m.Op())
+	}
+}
+
+// CollectionPostClient is a client for the CollectionPost schema.
+type CollectionPostClient struct {
+	config
+}
+
+// NewCollectionPostClient returns a client for the CollectionPost from the given config.
+func NewCollectionPostClient(c config) *CollectionPostClient {
+	return &CollectionPostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collectionpost.Hooks(f(g(h())))`.
+func (c *CollectionPostClient) Use(hooks ...Hook) {
+	c.hooks.CollectionPost = append(c.hooks.CollectionPost, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collectionpost.Intercept(f(g(h())))`.
+func (c *CollectionPostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollectionPost = append(c.inters.CollectionPost, interceptors...)
+}
+
+// Create returns a builder for creating a CollectionPost entity.
+func (c *CollectionPostClient) Create() *CollectionPostCreate {
+	mutation := newCollectionPostMutation(c.config, OpCreate)
+	return &CollectionPostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CollectionPost entities.
+func (c *CollectionPostClient) CreateBulk(builders ...*CollectionPostCreate) *CollectionPostCreateBulk {
+	return &CollectionPostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionPostClient) MapCreateBulk(slice any, setFunc func(*CollectionPostCreate, int)) *CollectionPostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionPostCreateBulk{err: fmt.Errorf("calling to CollectionPostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionPostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionPostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CollectionPost.
+func (c *CollectionPostClient) Update() *CollectionPostUpdate {
+	mutation := newCollectionPostMutation(c.config, OpUpdate)
+	return &CollectionPostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionPostClient) UpdateOne(_m *CollectionPost) *CollectionPostUpdateOne {
+	mutation := newCollectionPostMutation(c.config, OpUpdateOne)
+	mutation.collection = &_m.CollectionID
+	mutation.post = &_m.PostID
+	return &CollectionPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CollectionPost.
+func (c *CollectionPostClient) Delete() *CollectionPostDelete {
+	mutation := newCollectionPostMutation(c.config, OpDelete)
+	return &CollectionPostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Query returns a query builder for CollectionPost.
+func (c *CollectionPostClient) Query() *CollectionPostQuery {
+	return &CollectionPostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollectionPost},
+		inters: c.Interceptors(),
+	}
+}
+
+// QueryCollection queries the collection edge of a CollectionPost.
+func (c *CollectionPostClient) QueryCollection(_m *CollectionPost) *CollectionQuery {
+	return c.Query().
+		Where(collectionpost.CollectionID(_m.CollectionID), collectionpost.PostID(_m.PostID)).
+		QueryCollection()
+}
+
+// QueryPost queries the post edge of a CollectionPost.
+func (c *CollectionPostClient) QueryPost(_m *CollectionPost) *PostQuery {
+	return c.Query().
+		Where(collectionpost.CollectionID(_m.CollectionID), collectionpost.PostID(_m.PostID)).
+		QueryPost()
+}
+
+// QuerySection queries the section edge of a CollectionPost.
+func (c *CollectionPostClient) QuerySection(_m *CollectionPost) *CollectionSectionQuery {
+	return c.Query().
+		Where(collectionpost.CollectionID(_m.CollectionID), collectionpost.PostID(_m.PostID)).
+		QuerySection()
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionPostClient) Hooks() []Hook {
+	return c.hooks.CollectionPost
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionPostClient) Interceptors() []Interceptor {
+	return c.inters.CollectionPost
+}
+
+func (c *CollectionPostClient) mutate(ctx context.Context, m *CollectionPostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionPostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionPostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionPostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CollectionPost mutation op: %q", m.Op())
+	}
+}
+
+// CollectionSectionClient is a client for the CollectionSection schema.
+type CollectionSectionClient struct {
+	config
+}
+
+// NewCollectionSectionClient returns a client for the CollectionSection from the given config.
+func NewCollectionSectionClient(c config) *CollectionSectionClient {
+	return &CollectionSectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collectionsection.Hooks(f(g(h())))`.
+func (c *CollectionSectionClient) Use(hooks ...Hook) {
+	c.hooks.CollectionSection = append(c.hooks.CollectionSection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collectionsection.Intercept(f(g(h())))`.
+func (c *CollectionSectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollectionSection = append(c.inters.CollectionSection, interceptors...)
+}
+
+// Create returns a builder for creating a CollectionSection entity.
+func (c *CollectionSectionClient) Create() *CollectionSectionCreate {
+	mutation := newCollectionSectionMutation(c.config, OpCreate)
+	return &CollectionSectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CollectionSection entities.
+func (c *CollectionSectionClient) CreateBulk(builders ...*CollectionSectionCreate) *CollectionSectionCreateBulk {
+	return &CollectionSectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionSectionClient) MapCreateBulk(slice any, setFunc func(*CollectionSectionCreate, int)) *CollectionSectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionSectionCreateBulk{err: fmt.Errorf("calling to CollectionSectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionSectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionSectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CollectionSection.
+func (c *CollectionSectionClient) Update() *CollectionSectionUpdate {
+	mutation := newCollectionSectionMutation(c.config, OpUpdate)
+	return &CollectionSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionSectionClient) UpdateOne(_m *CollectionSection) *CollectionSectionUpdateOne {
+	mutation := newCollectionSectionMutation(c.config, OpUpdateOne, withCollectionSection(_m))
+	return &CollectionSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollectionSectionClient) UpdateOneID(id xid.ID) *CollectionSectionUpdateOne {
+	mutation := newCollectionSectionMutation(c.config, OpUpdateOne, withCollectionSectionID(id))
+	return &CollectionSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CollectionSection.
+func (c *CollectionSectionClient) Delete() *CollectionSectionDelete {
+	mutation := newCollectionSectionMutation(c.config, OpDelete)
+	return &CollectionSectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CollectionSectionClient) DeleteOne(_m *CollectionSection) *CollectionSectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CollectionSectionClient) DeleteOneID(id xid.ID) *CollectionSectionDeleteOne {
+	builder := c.Delete().Where(collectionsection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollectionSectionDeleteOne{builder}
+}
+
+// Query returns a query builder for CollectionSection.
+func (c *CollectionSectionClient) Query() *CollectionSectionQuery {
+	return &CollectionSectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollectionSection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CollectionSection entity by its id.
+func (c *CollectionSectionClient) Get(ctx context.Context, id xid.ID) (*CollectionSection, error) {
+	return c.Query().Where(collectionsection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollectionSectionClient) GetX(ctx context.Context, id xid.ID) *CollectionSection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCollection queries the collection edge of a CollectionSection.
+func (c *CollectionSectionClient) QueryCollection(_m *CollectionSection) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collectionsection.Table, collectionsection.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, collectionsection.CollectionTable, collectionsection.CollectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionSectionClient) Hooks() []Hook {
+	return c.hooks.CollectionSection
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionSectionClient) Interceptors() []Interceptor {
+	return c.inters.CollectionSection
+}
+
+func (c *CollectionSectionClient) mutate(ctx context.Context, m *CollectionSectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionSectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionSectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CollectionSection mutation op: %q", m.Op())
+	}
+}
+
+// CollectionShareClient is a client for the CollectionShare schema.
+type CollectionShareClient struct {
+	config
+}
+
+// NewCollectionShareClient returns a client for the CollectionShare from the given config.
+func NewCollectionShareClient(c config) *CollectionShareClient {
+	return &CollectionShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collectionshare.Hooks(f(g(h())))`.
+func (c *CollectionShareClient) Use(hooks ...Hook) {
+	c.hooks.CollectionShare = append(c.hooks.CollectionShare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collectionshare.Intercept(f(g(h())))`.
+func (c *CollectionShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollectionShare = append(c.inters.CollectionShare, interceptors...)
+}
+
+// Create returns a builder for creating a CollectionShare entity.
+func (c *CollectionShareClient) Create() *CollectionShareCreate {
+	mutation := newCollectionShareMutation(c.config, OpCreate)
+	return &CollectionShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CollectionShare entities.
+func (c *CollectionShareClient) CreateBulk(builders ...*CollectionShareCreate) *CollectionShareCreateBulk {
+	return &CollectionShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionShareClient) MapCreateBulk(slice any, setFunc func(*CollectionShareCreate, int)) *CollectionShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionShareCreateBulk{err: fmt.Errorf("calling to CollectionShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CollectionShare.
+func (c *CollectionShareClient) Update() *CollectionShareUpdate {
+	mutation := newCollectionShareMutation(c.config, OpUpdate)
+	return &CollectionShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionShareClient) UpdateOne(_m *CollectionShare) *CollectionShareUpdateOne {
+	mutation := newCollectionShareMutation(c.config, OpUpdateOne, withCollectionShare(_m))
+	return &CollectionShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollectionShareClient) UpdateOneID(id xid.ID) *CollectionShareUpdateOne {
+	mutation := newCollectionShareMutation(c.config, OpUpdateOne, withCollectionShareID(id))
+	return &CollectionShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CollectionShare.
+func (c *CollectionShareClient) Delete() *CollectionShareDelete {
+	mutation := newCollectionShareMutation(c.config, OpDelete)
+	return &CollectionShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CollectionShareClient) DeleteOne(_m *CollectionShare) *CollectionShareDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CollectionShareClient) DeleteOneID(id xid.ID) *CollectionShareDeleteOne {
+	builder := c.Delete().Where(collectionshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollectionShareDeleteOne{builder}
+}
+
+// Query returns a query builder for CollectionShare.
+func (c *CollectionShareClient) Query() *CollectionShareQuery {
+	return &CollectionShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollectionShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CollectionShare entity by its id.
+func (c *CollectionShareClient) Get(ctx context.Context, id xid.ID) (*CollectionShare, error) {
+	return c.Query().Where(collectionshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollectionShareClient) GetX(ctx context.Context, id xid.ID) *CollectionShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCollection queries the collection edge of a CollectionShare.
+func (c *CollectionShareClient) QueryCollection(_m *CollectionShare) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collectionshare.Table, collectionshare.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, collectionshare.CollectionTable, collectionshare.CollectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionShareClient) Hooks() []Hook {
+	return c.hooks.CollectionShare
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionShareClient) Interceptors() []Interceptor {
+	return c.inters.CollectionShare
+}
+
+func (c *CollectionShareClient) mutate(ctx context.Context, m *CollectionShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CollectionShare mutation op: %q", m.Op())
+	}
+}
+
+// ContentSummaryClient is a client for the ContentSummary schema.
+type ContentSummaryClient struct {
+	config
+}
+
+// NewContentSummaryClient returns a client for the ContentSummary from the given config.
+func NewContentSummaryClient(c config) *ContentSummaryClient {
+	return &ContentSummaryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contentsummary.Hooks(f(g(h())))`.
+func (c *ContentSummaryClient) Use(hooks ...Hook) {
+	c.hooks.ContentSummary = append(c.hooks.ContentSummary, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contentsummary.Intercept(f(g(h())))`.
+func (c *ContentSummaryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentSummary = append(c.inters.ContentSummary, interceptors...)
+}
+
+// Create returns a builder for creating a ContentSummary entity.
+func (c *ContentSummaryClient) Create() *ContentSummaryCreate {
+	mutation := newContentSummaryMutation(c.config, OpCreate)
+	return &ContentSummaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContentSummary entities.
+func (c *ContentSummaryClient) CreateBulk(builders ...*ContentSummaryCreate) *ContentSummaryCreateBulk {
+	return &ContentSummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContentSummaryClient) MapCreateBulk(slice any, setFunc func(*ContentSummaryCreate, int)) *ContentSummaryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContentSummaryCreateBulk{err: fmt.Errorf("calling to ContentSummaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContentSummaryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContentSummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContentSummary.
+func (c *ContentSummaryClient) Update() *ContentSummaryUpdate {
+	mutation := newContentSummaryMutation(c.config, OpUpdate)
+	return &ContentSummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContentSummaryClient) UpdateOne(_m *ContentSummary) *ContentSummaryUpdateOne {
+	mutation := newContentSummaryMutation(c.config, OpUpdateOne, withContentSummary(_m))
+	return &ContentSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContentSummaryClient) UpdateOneID(id xid.ID) *ContentSummaryUpdateOne {
+	mutation := newContentSummaryMutation(c.config, OpUpdateOne, withContentSummaryID(id))
+	return &ContentSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContentSummary.
+func (c *ContentSummaryClient) Delete() *ContentSummaryDelete {
+	mutation := newContentSummaryMutation(c.config, OpDelete)
+	return &ContentSummaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContentSummaryClient) DeleteOne(_m *ContentSummary) *ContentSummaryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContentSummaryClient) DeleteOneID(id xid.ID) *ContentSummaryDeleteOne {
+	builder := c.Delete().Where(contentsummary.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContentSummaryDeleteOne{builder}
+}
+
+// Query returns a query builder for ContentSummary.
+func (c *ContentSummaryClient) Query() *ContentSummaryQuery {
+	return &ContentSummaryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContentSummary},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContentSummary entity by its id.
+func (c *ContentSummaryClient) Get(ctx context.Context, id xid.ID) (*ContentSummary, error) {
+	return c.Query().Where(contentsummary.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContentSummaryClient) GetX(ctx context.Context, id xid.ID) *ContentSummary {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ContentSummaryClient) Hooks() []Hook {
+	return c.hooks.ContentSummary
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentSummaryClient) Interceptors() []Interceptor {
+	return c.inters.ContentSummary
+}
+
+func (c *ContentSummaryClient) mutate(ctx context.Context, m *ContentSummaryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentSummaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentSummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentSummaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContentSummary mutation op: %q", m.Op())
+	}
+}
+
+// ContentViewClient is a client for the ContentView schema.
+type ContentViewClient struct {
+	config
+}
+
+// NewContentViewClient returns a client for the ContentView from the given config.
+func NewContentViewClient(c config) *ContentViewClient {
+	return &ContentViewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contentview.Hooks(f(g(h())))`.
+func (c *ContentViewClient) Use(hooks ...Hook) {
+	c.hooks.ContentView = append(c.hooks.ContentView, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contentview.Intercept(f(g(h())))`.
+func (c *ContentViewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentView = append(c.inters.ContentView, interceptors...)
+}
+
+// Create returns a builder for creating a ContentView entity.
+func (c *ContentViewClient) Create() *ContentViewCreate {
+	mutation := newContentViewMutation(c.config, OpCreate)
+	return &ContentViewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContentView entities.
+func (c *ContentViewClient) CreateBulk(builders ...*ContentViewCreate) *ContentViewCreateBulk {
+	return &ContentViewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContentViewClient) MapCreateBulk(slice any, setFunc func(*ContentViewCreate, int)) *ContentViewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContentViewCreateBulk{err: fmt.Errorf("calling to ContentViewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContentViewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContentViewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContentView.
+func (c *ContentViewClient) Update() *ContentViewUpdate {
+	mutation := newContentViewMutation(c.config, OpUpdate)
+	return &ContentViewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContentViewClient) UpdateOne(_m *ContentView) *ContentViewUpdateOne {
+	mutation := newContentViewMutation(c.config, OpUpdateOne, withContentView(_m))
+	return &ContentViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContentViewClient) UpdateOneID(id xid.ID) *ContentViewUpdateOne {
+	mutation := newContentViewMutation(c.config, OpUpdateOne, withContentViewID(id))
+	return &ContentViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContentView.
+func (c *ContentViewClient) Delete() *ContentViewDelete {
+	mutation := newContentViewMutation(c.config, OpDelete)
+	return &ContentViewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContentViewClient) DeleteOne(_m *ContentView) *ContentViewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContentViewClient) DeleteOneID(id xid.ID) *ContentViewDeleteOne {
+	builder := c.Delete().Where(contentview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContentViewDeleteOne{builder}
+}
+
+// Query returns a query builder for ContentView.
+func (c *ContentViewClient) Query() *ContentViewQuery {
+	return &ContentViewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContentView},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContentView entity by its id.
+func (c *ContentViewClient) Get(ctx context.Context, id xid.ID) (*ContentView, error) {
+	return c.Query().Where(contentview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContentViewClient) GetX(ctx context.Context, id xid.ID) *ContentView {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAuthor queries the author edge of a ContentView.
+func (c *ContentViewClient) QueryAuthor(_m *ContentView) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentview.Table, contentview.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentview.AuthorTable, contentview.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContentViewClient) Hooks() []Hook {
+	return c.hooks.ContentView
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentViewClient) Interceptors() []Interceptor {
+	return c.inters.ContentView
+}
+
+func (c *ContentViewClient) mutate(ctx context.Context, m *ContentViewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentViewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentViewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentViewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContentView mutation op: %q", m.Op())
+	}
+}
+
+// ConversationClient is a client for the Conversation schema.
+type ConversationClient struct {
+	config
+}
+
+// NewConversationClient returns a client for the Conversation from the given config.
+func NewConversationClient(c config) *ConversationClient {
+	return &ConversationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversation.Hooks(f(g(h())))`.
+func (c *ConversationClient) Use(hooks ...Hook) {
+	c.hooks.Conversation = append(c.hooks.Conversation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversation.Intercept(f(g(h())))`.
+func (c *ConversationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Conversation = append(c.inters.Conversation, interceptors...)
+}
+
+// Create returns a builder for creating a Conversation entity.
+func (c *ConversationClient) Create() *ConversationCreate {
+	mutation := newConversationMutation(c.config, OpCreate)
+	return &ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Conversation entities.
+func (c *ConversationClient) CreateBulk(builders ...*ConversationCreate) *ConversationCreateBulk {
+	return &ConversationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConversationClient) MapCreateBulk(slice any, setFunc func(*ConversationCreate, int)) *ConversationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConversationCreateBulk{err: fmt.Errorf("calling to ConversationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConversationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConversationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Conversation.
+func (c *ConversationClient) Update() *ConversationUpdate {
+	mutation := newConversationMutation(c.config, OpUpdate)
+	return &ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationClient) UpdateOne(_m *Conversation) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversation(_m))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationClient) UpdateOneID(id xid.ID) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversationID(id))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Conversation.
+func (c *ConversationClient) Delete() *ConversationDelete {
+	mutation := newConversationMutation(c.config, OpDelete)
+	return &ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationClient) DeleteOne(_m *Conversation) *ConversationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationClient) DeleteOneID(id xid.ID) *ConversationDeleteOne {
+	builder := c.Delete().Where(conversation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationDeleteOne{builder}
+}
+
+// Query returns a query builder for Conversation.
+func (c *ConversationClient) Query() *ConversationQuery {
+	return &ConversationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Conversation entity by its id.
+func (c *ConversationClient) Get(ctx context.Context, id xid.ID) (*Conversation, error) {
+	return c.Query().Where(conversation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationClient) GetX(ctx context.Context, id xid.ID) *Conversation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParticipants queries the participants edge of a Conversation.
+func (c *ConversationClient) QueryParticipants(_m *Conversation) *ConversationParticipantQuery {
+	query := (&ConversationParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(conversationparticipant.Table, conversationparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, conversation.ParticipantsTable, conversation.ParticipantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessages queries the messages edge of a Conversation.
+func (c *ConversationClient) QueryMessages(_m *Conversation) *DirectMessageQuery {
+	query := (&DirectMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(directmessage.Table, directmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, conversation.MessagesTable, conversation.MessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationClient) Hooks() []Hook {
+	return c.hooks.Conversation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationClient) Interceptors() []Interceptor {
+	return c.inters.Conversation
+}
+
+func (c *ConversationClient) mutate(ctx context.Context, m *ConversationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Conversation mutation op: %q", m.Op())
+	}
+}
+
+// ConversationParticipantClient is a client for the ConversationParticipant schema.
+type ConversationParticipantClient struct {
+	config
+}
+
+// NewConversationParticipantClient returns a client for the ConversationParticipant from the given config.
+func NewConversationParticipantClient(c config) *ConversationParticipantClient {
+	return &ConversationParticipantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversationparticipant.Hooks(f(g(h())))`.
+func (c *ConversationParticipantClient) Use(hooks ...Hook) {
+	c.hooks.ConversationParticipant = append(c.hooks.ConversationParticipant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversationparticipant.Intercept(f(g(h())))`.
+func (c *ConversationParticipantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConversationParticipant = append(c.inters.ConversationParticipant, interceptors...)
+}
+
+// Create returns a builder for creating a ConversationParticipant entity.
+func (c *ConversationParticipantClient) Create() *ConversationParticipantCreate {
+	mutation := newConversationParticipantMutation(c.config, OpCreate)
+	return &ConversationParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConversationParticipant entities.
+func (c *ConversationParticipantClient) CreateBulk(builders ...*ConversationParticipantCreate) *ConversationParticipantCreateBulk {
+	return &ConversationParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConversationParticipantClient) MapCreateBulk(slice any, setFunc func(*ConversationParticipantCreate, int)) *ConversationParticipantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConversationParticipantCreateBulk{err: fmt.Errorf("calling to ConversationParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConversationParticipantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConversationParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConversationParticipant.
+func (c *ConversationParticipantClient) Update() *ConversationParticipantUpdate {
+	mutation := newConversationParticipantMutation(c.config, OpUpdate)
+	return &ConversationParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationParticipantClient) UpdateOne(_m *ConversationParticipant) *ConversationParticipantUpdateOne {
+	mutation := newConversationParticipantMutation(c.config, OpUpdateOne, withConversationParticipant(_m))
+	return &ConversationParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationParticipantClient) UpdateOneID(id xid.ID) *ConversationParticipantUpdateOne {
+	mutation := newConversationParticipantMutation(c.config, OpUpdateOne, withConversationParticipantID(id))
+	return &ConversationParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConversationParticipant.
+func (c *ConversationParticipantClient) Delete() *ConversationParticipantDelete {
+	mutation := newConversationParticipantMutation(c.config, OpDelete)
+	return &ConversationParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationParticipantClient) DeleteOne(_m *ConversationParticipant) *ConversationParticipantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationParticipantClient) DeleteOneID(id xid.ID) *ConversationParticipantDeleteOne {
+	builder := c.Delete().Where(conversationparticipant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationParticipantDeleteOne{builder}
+}
+
+// Query returns a query builder for ConversationParticipant.
+func (c *ConversationParticipantClient) Query() *ConversationParticipantQuery {
+	return &ConversationParticipantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversationParticipant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConversationParticipant entity by its id.
+func (c *ConversationParticipantClient) Get(ctx context.Context, id xid.ID) (*ConversationParticipant, error) {
+	return c.Query().Where(conversationparticipant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationParticipantClient) GetX(ctx context.Context, id xid.ID) *ConversationParticipant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConversation queries the conversation edge of a ConversationParticipant.
+func (c *ConversationParticipantClient) QueryConversation(_m *ConversationParticipant) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversationparticipant.Table, conversationparticipant.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, conversationparticipant.ConversationTable, conversationparticipant.ConversationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccount queries the account edge of a ConversationParticipant.
+func (c *ConversationParticipantClient) QueryAccount(_m *ConversationParticipant) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversationparticipant.Table, conversationparticipant.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, conversationparticipant.AccountTable, conversationparticipant.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationParticipantClient) Hooks() []Hook {
+	return c.hooks.ConversationParticipant
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationParticipantClient) Interceptors() []Interceptor {
+	return c.inters.ConversationParticipant
+}
+
+func (c *ConversationParticipantClient) mutate(ctx context.Context, m *ConversationParticipantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConversationParticipant mutation op: %q", m.Op())
+	}
+}
+
+// DigestSubscriptionClient is a client for the DigestSubscription schema.
+type DigestSubscriptionClient struct {
+	config
+}
+
+// NewDigestSubscriptionClient returns a client for the DigestSubscription from the given config.
+func NewDigestSubscriptionClient(c config) *DigestSubscriptionClient {
+	return &DigestSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `digestsubscription.Hooks(f(g(h())))`.
+func (c *DigestSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.DigestSubscription = append(c.hooks.DigestSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `digestsubscription.Intercept(f(g(h())))`.
+func (c *DigestSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DigestSubscription = append(c.inters.DigestSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a DigestSubscription entity.
+func (c *DigestSubscriptionClient) Create() *DigestSubscriptionCreate {
+	mutation := newDigestSubscriptionMutation(c.config, OpCreate)
+	return &DigestSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DigestSubscription entities.
+func (c *DigestSubscriptionClient) CreateBulk(builders ...*DigestSubscriptionCreate) *DigestSubscriptionCreateBulk {
+	return &DigestSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DigestSubscriptionClient) MapCreateBulk(slice any, setFunc func(*DigestSubscriptionCreate, int)) *DigestSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DigestSubscriptionCreateBulk{err: fmt.Errorf("calling to DigestSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DigestSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DigestSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DigestSubscription.
+func (c *DigestSubscriptionClient) Update() *DigestSubscriptionUpdate {
+	mutation := newDigestSubscriptionMutation(c.config, OpUpdate)
+	return &DigestSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DigestSubscriptionClient) UpdateOne(_m *DigestSubscription) *DigestSubscriptionUpdateOne {
+	mutation := newDigestSubscriptionMutation(c.config, OpUpdateOne, withDigestSubscription(_m))
+	return &DigestSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DigestSubscriptionClient) UpdateOneID(id xid.ID) *DigestSubscriptionUpdateOne {
+	mutation := newDigestSubscriptionMutation(c.config, OpUpdateOne, withDigestSubscriptionID(id))
+	return &DigestSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DigestSubscription.
+func (c *DigestSubscriptionClient) Delete() *DigestSubscriptionDelete {
+	mutation := newDigestSubscriptionMutation(c.config, OpDelete)
+	return &DigestSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DigestSubscriptionClient) DeleteOne(_m *DigestSubscription) *DigestSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DigestSubscriptionClient) DeleteOneID(id xid.ID) *DigestSubscriptionDeleteOne {
+	builder := c.Delete().Where(digestsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DigestSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for DigestSubscription.
+func (c *DigestSubscriptionClient) Query() *DigestSubscriptionQuery {
+	return &DigestSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDigestSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DigestSubscription entity by its id.
+func (c *DigestSubscriptionClient) Get(ctx context.Context, id xid.ID) (*DigestSubscription, error) {
+	return c.Query().Where(digestsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DigestSubscriptionClient) GetX(ctx context.Context, id xid.ID) *DigestSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a DigestSubscription.
+func (c *DigestSubscriptionClient) QueryAccount(_m *DigestSubscription) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(digestsubscription.Table, digestsubscription.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, digestsubscription.AccountTable, digestsubscription.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DigestSubscriptionClient) Hooks() []Hook {
+	return c.hooks.DigestSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *DigestSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.DigestSubscription
+}
+
+func (c *DigestSubscriptionClient) mutate(ctx context.Context, m *DigestSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DigestSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DigestSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DigestSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DigestSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DigestSubscription mutation op: %q", m.Op())
+	}
+}
+
+// DirectMessageClient is a client for the DirectMessage schema.
+type DirectMessageClient struct {
+	config
+}
+
+// NewDirectMessageClient returns a client for the DirectMessage from the given config.
+func NewDirectMessageClient(c config) *DirectMessageClient {
+	return &DirectMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `directmessage.Hooks(f(g(h())))`.
+func (c *DirectMessageClient) Use(hooks ...Hook) {
+	c.hooks.DirectMessage = append(c.hooks.DirectMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `directmessage.Intercept(f(g(h())))`.
+func (c *DirectMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DirectMessage = append(c.inters.DirectMessage, interceptors...)
+}
+
+// Create returns a builder for creating a DirectMessage entity.
+func (c *DirectMessageClient) Create() *DirectMessageCreate {
+	mutation := newDirectMessageMutation(c.config, OpCreate)
+	return &DirectMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DirectMessage entities.
+func (c *DirectMessageClient) CreateBulk(builders ...*DirectMessageCreate) *DirectMessageCreateBulk {
+	return &DirectMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DirectMessageClient) MapCreateBulk(slice any, setFunc func(*DirectMessageCreate, int)) *DirectMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DirectMessageCreateBulk{err: fmt.Errorf("calling to DirectMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DirectMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DirectMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DirectMessage.
+func (c *DirectMessageClient) Update() *DirectMessageUpdate {
+	mutation := newDirectMessageMutation(c.config, OpUpdate)
+	return &DirectMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DirectMessageClient) UpdateOne(_m *DirectMessage) *DirectMessageUpdateOne {
+	mutation := newDirectMessageMutation(c.config, OpUpdateOne, withDirectMessage(_m))
+	return &DirectMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DirectMessageClient) UpdateOneID(id xid.ID) *DirectMessageUpdateOne {
+	mutation := newDirectMessageMutation(c.config, OpUpdateOne, withDirectMessageID(id))
+	return &DirectMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DirectMessage.
+func (c *DirectMessageClient) Delete() *DirectMessageDelete {
+	mutation := newDirectMessageMutation(c.config, OpDelete)
+	return &DirectMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DirectMessageClient) DeleteOne(_m *DirectMessage) *DirectMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DirectMessageClient) DeleteOneID(id xid.ID) *DirectMessageDeleteOne {
+	builder := c.Delete().Where(directmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DirectMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for DirectMessage.
+func (c *DirectMessageClient) Query() *DirectMessageQuery {
+	return &DirectMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDirectMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DirectMessage entity by its id.
+func (c *DirectMessageClient) Get(ctx context.Context, id xid.ID) (*DirectMessage, error) {
+	return c.Query().Where(directmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DirectMessageClient) GetX(ctx context.Context, id xid.ID) *DirectMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConversation queries the conversation edge of a DirectMessage.
+func (c *DirectMessageClient) QueryConversation(_m *DirectMessage) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(directmessage.Table, directmessage.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, directmessage.ConversationTable, directmessage.ConversationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthor queries the author edge of a DirectMessage.
+func (c *DirectMessageClient) QueryAuthor(_m *DirectMessage) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(directmessage.Table, directmessage.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, directmessage.AuthorTable, directmessage.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssets queries the assets edge of a DirectMessage.
+func (c *DirectMessageClient) QueryAssets(_m *DirectMessage) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(directmessage.Table, directmessage.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, directmessage.AssetsTable, directmessage.AssetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DirectMessageClient) Hooks() []Hook {
+	return c.hooks.DirectMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *DirectMessageClient) Interceptors() []Interceptor {
+	return c.inters.DirectMessage
+}
+
+func (c *DirectMessageClient) mutate(ctx context.Context, m *DirectMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DirectMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DirectMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DirectMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DirectMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DirectMessage mutation op: %q", m.Op())
+	}
+}
+
+// DiscordBridgeLinkClient is a client for the DiscordBridgeLink schema.
+type DiscordBridgeLinkClient struct {
+	config
+}
+
+// NewDiscordBridgeLinkClient returns a client for the DiscordBridgeLink from the given config.
+func NewDiscordBridgeLinkClient(c config) *DiscordBridgeLinkClient {
+	return &DiscordBridgeLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordbridgelink.Hooks(f(g(h())))`.
+func (c *DiscordBridgeLinkClient) Use(hooks ...Hook) {
+	c.hooks.DiscordBridgeLink = append(c.hooks.DiscordBridgeLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordbridgelink.Intercept(f(g(h())))`.
+func (c *DiscordBridgeLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordBridgeLink = append(c.inters.DiscordBridgeLink, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordBridgeLink entity.
+func (c *DiscordBridgeLinkClient) Create() *DiscordBridgeLinkCreate {
+	mutation := newDiscordBridgeLinkMutation(c.config, OpCreate)
+	return &DiscordBridgeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordBridgeLink entities.
+func (c *DiscordBridgeLinkClient) CreateBulk(builders ...*DiscordBridgeLinkCreate) *DiscordBridgeLinkCreateBulk {
+	return &DiscordBridgeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordBridgeLinkClient) MapCreateBulk(slice any, setFunc func(*DiscordBridgeLinkCreate, int)) *DiscordBridgeLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordBridgeLinkCreateBulk{err: fmt.Errorf("calling to DiscordBridgeLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordBridgeLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordBridgeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordBridgeLink.
+func (c *DiscordBridgeLinkClient) Update() *DiscordBridgeLinkUpdate {
+	mutation := newDiscordBridgeLinkMutation(c.config, OpUpdate)
+	return &DiscordBridgeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordBridgeLinkClient) UpdateOne(_m *DiscordBridgeLink) *DiscordBridgeLinkUpdateOne {
+	mutation := newDiscordBridgeLinkMutation(c.config, OpUpdateOne, withDiscordBridgeLink(_m))
+	return &DiscordBridgeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordBridgeLinkClient) UpdateOneID(id xid.ID) *DiscordBridgeLinkUpdateOne {
+	mutation := newDiscordBridgeLinkMutation(c.config, OpUpdateOne, withDiscordBridgeLinkID(id))
+	return &DiscordBridgeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordBridgeLink.
+func (c *DiscordBridgeLinkClient) Delete() *DiscordBridgeLinkDelete {
+	mutation := newDiscordBridgeLinkMutation(c.config, OpDelete)
+	return &DiscordBridgeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordBridgeLinkClient) DeleteOne(_m *DiscordBridgeLink) *DiscordBridgeLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordBridgeLinkClient) DeleteOneID(id xid.ID) *DiscordBridgeLinkDeleteOne {
+	builder := c.Delete().Where(discordbridgelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordBridgeLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordBridgeLink.
+func (c *DiscordBridgeLinkClient) Query() *DiscordBridgeLinkQuery {
+	return &DiscordBridgeLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordBridgeLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordBridgeLink entity by its id.
+func (c *DiscordBridgeLinkClient) Get(ctx context.Context, id xid.ID) (*DiscordBridgeLink, error) {
+	return c.Query().Where(discordbridgelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordBridgeLinkClient) GetX(ctx context.Context, id xid.ID) *DiscordBridgeLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a DiscordBridgeLink.
+func (c *DiscordBridgeLinkClient) QueryPost(_m *DiscordBridgeLink) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordbridgelink.Table, discordbridgelink.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordbridgelink.PostTable, discordbridgelink.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordBridgeLinkClient) Hooks() []Hook {
+	return c.hooks.DiscordBridgeLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordBridgeLinkClient) Interceptors() []Interceptor {
+	return c.inters.DiscordBridgeLink
+}
+
+func (c *DiscordBridgeLinkClient) mutate(ctx context.Context, m *DiscordBridgeLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordBridgeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordBridgeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordBridgeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordBridgeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordBridgeLink mutation op: %q", m.Op())
+	}
+}
+
+// EmailClient is a client for the Email schema.
+type EmailClient struct {
+	config
+}
+
+// NewEmailClient returns a client for the Email from the given config.
+func NewEmailClient(c config) *EmailClient {
+	return &EmailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `email.Hooks(f(g(h())))`.
+func (c *EmailClient) Use(hooks ...Hook) {
+	c.hooks.Email = append(c.hooks.Email, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `email.Intercept(f(g(h())))`.
+func (c *EmailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Email = append(c.inters.Email, interceptors...)
+}
+
+// Create returns a builder for creating a Email entity.
+func (c *EmailClient) Create() *EmailCreate {
+	mutation := newEmailMutation(c.config, OpCreate)
+	return &EmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Email entities.
+func (c *EmailClient) CreateBulk(builders ...*EmailCreate) *EmailCreateBulk {
+	return &EmailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailClient) MapCreateBulk(slice any, setFunc func(*EmailCreate, int)) *EmailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailCreateBulk{err: fmt.Errorf("calling to EmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Email.
+func (c *EmailClient) Update() *EmailUpdate {
+	mutation := newEmailMutation(c.config, OpUpdate)
+	return &EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailClient) UpdateOne(_m *Email) *EmailUpdateOne {
+	mutation := newEmailMutation(c.config, OpUpdateOne, withEmail(_m))
+	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailClient) UpdateOneID(id xid.ID) *EmailUpdateOne {
+	mutation := newEmailMutation(c.config, OpUpdateOne, withEmailID(id))
+	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Email.
+func (c *EmailClient) Delete() *EmailDelete {
+	mutation := newEmailMutation(c.config, OpDelete)
+	return &EmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailClient) DeleteOne(_m *Email) *EmailDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailClient) DeleteOneID(id xid.ID) *EmailDeleteOne {
+	builder := c.Delete().Where(email.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailDeleteOne{builder}
+}
+
+// Query returns a query builder for Email.
+func (c *EmailClient) Query() *EmailQuery {
+	return &EmailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Email entity by its id.
+func (c *EmailClient) Get(ctx context.Context, id xid.ID) (*Email, error) {
+	return c.Query().Where(email.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailClient) GetX(ctx context.Context, id xid.ID) *Email {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Email.
+func (c *EmailClient) QueryAccount(_m *Email) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(email.Table, email.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, email.AccountTable, email.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailClient) Hooks() []Hook {
+	return c.hooks.Email
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailClient) Interceptors() []Interceptor {
+	return c.inters.Email
+}
+
+func (c *EmailClient) mutate(ctx context.Context, m *EmailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Email mutation op: %q", m.Op())
+	}
+}
+
+// EventClient is a client for the Event schema.
+type EventClient struct {
+	config
+}
+
+// NewEventClient returns a client for the Event from the given config.
+func NewEventClient(c config) *EventClient {
+	return &EventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
+func (c *EventClient) Use(hooks ...Hook) {
+	c.hooks.Event = append(c.hooks.Event, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
+func (c *EventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Event = append(c.inters.Event, interceptors...)
+}
+
+// Create returns a builder for creating a Event entity.
+func (c *EventClient) Create() *EventCreate {
+	mutation := newEventMutation(c.config, OpCreate)
+	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Event entities.
+func (c *EventClient) CreateBulk(builders ...*EventCreate) *EventCreateBulk {
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Event.
+func (c *EventClient) Update() *EventUpdate {
+	mutation := newEventMutation(c.config, OpUpdate)
+	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventClient) UpdateOneID(id xid.ID) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Event.
+func (c *EventClient) Delete() *EventDelete {
+	mutation := newEventMutation(c.config, OpDelete)
+	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventClient) DeleteOneID(id xid.ID) *EventDeleteOne {
+	builder := c.Delete().Where(event.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventDeleteOne{builder}
+}
+
+// Query returns a query builder for Event.
+func (c *EventClient) Query() *EventQuery {
+	return &EventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Event entity by its id.
+func (c *EventClient) Get(ctx context.Context, id xid.ID) (*Event, error) {
+	return c.Query().Where(event.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventClient) GetX(ctx context.Context, id xid.ID) *Event {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParticipants queries the participants edge of a Event.
+func (c *EventClient) QueryParticipants(_m *Event) *EventParticipantQuery {
+	query := (&EventParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(eventparticipant.Table, eventparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.ParticipantsTable, event.ParticipantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryThread queries the thread edge of a Event.
+func (c *EventClient) QueryThread(_m *Event) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.ThreadTable, event.ThreadColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrimaryImage queries the primary_image edge of a Event.
+func (c *EventClient) QueryPrimaryImage(_m *Event) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.PrimaryImageTable, event.PrimaryImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EventClient) Hooks() []Hook {
+	return c.hooks.Event
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventClient) Interceptors() []Interceptor {
+	return c.inters.Event
+}
+
+func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Event mutation op: %q", m.Op())
+	}
+}
+
+// EventParticipantClient is a client for the EventParticipant schema.
+type EventParticipantClient struct {
+	config
+}
+
+// NewEventParticipantClient returns a client for the EventParticipant from the given config.
+func NewEventParticipantClient(c config) *EventParticipantClient {
+	return &EventParticipantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventparticipant.Hooks(f(g(h())))`.
+func (c *EventParticipantClient) Use(hooks ...Hook) {
+	c.hooks.EventParticipant = append(c.hooks.EventParticipant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventparticipant.Intercept(f(g(h())))`.
+func (c *EventParticipantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventParticipant = append(c.inters.EventParticipant, interceptors...)
+}
+
+// Create returns a builder for creating a EventParticipant entity.
+func (c *EventParticipantClient) Create() *EventParticipantCreate {
+	mutation := newEventParticipantMutation(c.config, OpCreate)
+	return &EventParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventParticipant entities.
+func (c *EventParticipantClient) CreateBulk(builders ...*EventParticipantCreate) *EventParticipantCreateBulk {
+	return &EventParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventParticipantClient) MapCreateBulk(slice any, setFunc func(*EventParticipantCreate, int)) *EventParticipantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventParticipantCreateBulk{err: fmt.Errorf("calling to EventParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventParticipantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventParticipant.
+func (c *EventParticipantClient) Update() *EventParticipantUpdate {
+	mutation := newEventParticipantMutation(c.config, OpUpdate)
+	return &EventParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventParticipantClient) UpdateOne(_m *EventParticipant) *EventParticipantUpdateOne {
+	mutation := newEventParticipantMutation(c.config, OpUpdateOne, withEventParticipant(_m))
+	return &EventParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventParticipantClient) UpdateOneID(id xid.ID) *EventParticipantUpdateOne {
+	mutation := newEventParticipantMutation(c.config, OpUpdateOne, withEventParticipantID(id))
+	return &EventParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventParticipant.
+func (c *EventParticipantClient) Delete() *EventParticipantDelete {
+	mutation := newEventParticipantMutation(c.config, OpDelete)
+	return &EventParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventParticipantClient) DeleteOne(_m *EventParticipant) *EventParticipantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventParticipantClient) DeleteOneID(id xid.ID) *EventParticipantDeleteOne {
+	builder := c.Delete().Where(eventparticipant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventParticipantDeleteOne{builder}
+}
+
+// Query returns a query builder for EventParticipant.
+func (c *EventParticipantClient) Query() *EventParticipantQuery {
+	return &EventParticipantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventParticipant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventParticipant entity by its id.
+func (c *EventParticipantClient) Get(ctx context.Context, id xid.ID) (*EventParticipant, error) {
+	return c.Query().Where(eventparticipant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventParticipantClient) GetX(ctx context.Context, id xid.ID) *EventParticipant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a EventParticipant.
+func (c *EventParticipantClient) QueryAccount(_m *EventParticipant) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(eventparticipant.Table, eventparticipant.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, eventparticipant.AccountTable, eventparticipant.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvent queries the event edge of a EventParticipant.
+func (c *EventParticipantClient) QueryEvent(_m *EventParticipant) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(eventparticipant.Table, eventparticipant.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, eventparticipant.EventTable, eventparticipant.EventColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EventParticipantClient) Hooks() []Hook {
+	return c.hooks.EventParticipant
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventParticipantClient) Interceptors() []Interceptor {
+	return c.inters.EventParticipant
+}
+
+func (c *EventParticipantClient) mutate(ctx context.Context, m *EventParticipantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventParticipant mutation op: %q", m.Op())
+	}
+}
+
+// FeatureFlagClient is a client for the FeatureFlag schema.
+type FeatureFlagClient struct {
+	config
+}
+
+// NewFeatureFlagClient returns a client for the FeatureFlag from the given config.
+func NewFeatureFlagClient(c config) *FeatureFlagClient {
+	return &FeatureFlagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `featureflag.Hooks(f(g(h())))`.
+func (c *FeatureFlagClient) Use(hooks ...Hook) {
+	c.hooks.FeatureFlag = append(c.hooks.FeatureFlag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `featureflag.Intercept(f(g(h())))`.
+func (c *FeatureFlagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeatureFlag = append(c.inters.FeatureFlag, interceptors...)
+}
+
+// Create returns a builder for creating a FeatureFlag entity.
+func (c *FeatureFlagClient) Create() *FeatureFlagCreate {
+	mutation := newFeatureFlagMutation(c.config, OpCreate)
+	return &FeatureFlagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FeatureFlag entities.
+func (c *FeatureFlagClient) CreateBulk(builders ...*FeatureFlagCreate) *FeatureFlagCreateBulk {
+	return &FeatureFlagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeatureFlagClient) MapCreateBulk(slice any, setFunc func(*FeatureFlagCreate, int)) *FeatureFlagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeatureFlagCreateBulk{err: fmt.Errorf("calling to FeatureFlagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeatureFlagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeatureFlagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FeatureFlag.
+func (c *FeatureFlagClient) Update() *FeatureFlagUpdate {
+	mutation := newFeatureFlagMutation(c.config, OpUpdate)
+	return &FeatureFlagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeatureFlagClient) UpdateOne(_m *FeatureFlag) *FeatureFlagUpdateOne {
+	mutation := newFeatureFlagMutation(c.config, OpUpdateOne, withFeatureFlag(_m))
+	return &FeatureFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeatureFlagClient) UpdateOneID(id xid.ID) *FeatureFlagUpdateOne {
+	mutation := newFeatureFlagMutation(c.config, OpUpdateOne, withFeatureFlagID(id))
+	return &FeatureFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FeatureFlag.
+func (c *FeatureFlagClient) Delete() *FeatureFlagDelete {
+	mutation := newFeatureFlagMutation(c.config, OpDelete)
+	return &FeatureFlagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeatureFlagClient) DeleteOne(_m *FeatureFlag) *FeatureFlagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeatureFlagClient) DeleteOneID(id xid.ID) *FeatureFlagDeleteOne {
+	builder := c.Delete().Where(featureflag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeatureFlagDeleteOne{builder}
+}
+
+// Query returns a query builder for FeatureFlag.
+func (c *FeatureFlagClient) Query() *FeatureFlagQuery {
+	return &FeatureFlagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeatureFlag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FeatureFlag entity by its id.
+func (c *FeatureFlagClient) Get(ctx context.Context, id xid.ID) (*FeatureFlag, error) {
+	return c.Query().Where(featureflag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeatureFlagClient) GetX(ctx context.Context, id xid.ID) *FeatureFlag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FeatureFlagClient) Hooks() []Hook {
+	return c.hooks.FeatureFlag
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeatureFlagClient) Interceptors() []Interceptor {
+	return c.inters.FeatureFlag
+}
+
+func (c *FeatureFlagClient) mutate(ctx context.Context, m *FeatureFlagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeatureFlagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeatureFlagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeatureFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeatureFlagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FeatureFlag mutation op: %q", m.Op())
+	}
+}
+
+// FederationFollowerClient is a client for the FederationFollower schema.
+type FederationFollowerClient struct {
+	config
+}
+
+// NewFederationFollowerClient returns a client for the FederationFollower from the given config.
+func NewFederationFollowerClient(c config) *FederationFollowerClient {
+	return &FederationFollowerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `federationfollower.Hooks(f(g(h())))`.
+func (c *FederationFollowerClient) Use(hooks ...Hook) {
+	c.hooks.FederationFollower = append(c.hooks.FederationFollower, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `federationfollower.Intercept(f(g(h())))`.
+func (c *FederationFollowerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FederationFollower = append(c.inters.FederationFollower, interceptors...)
+}
+
+// Create returns a builder for creating a FederationFollower entity.
+func (c *FederationFollowerClient) Create() *FederationFollowerCreate {
+	mutation := newFederationFollowerMutation(c.config, OpCreate)
+	return &FederationFollowerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FederationFollower entities.
+func (c *FederationFollowerClient) CreateBulk(builders ...*FederationFollowerCreate) *FederationFollowerCreateBulk {
+	return &FederationFollowerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FederationFollowerClient) MapCreateBulk(slice any, setFunc func(*FederationFollowerCreate, int)) *FederationFollowerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FederationFollowerCreateBulk{err: fmt.Errorf("calling to FederationFollowerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FederationFollowerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FederationFollowerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FederationFollower.
+func (c *FederationFollowerClient) Update() *FederationFollowerUpdate {
+	mutation := newFederationFollowerMutation(c.config, OpUpdate)
+	return &FederationFollowerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FederationFollowerClient) UpdateOne(_m *FederationFollower) *FederationFollowerUpdateOne {
+	mutation := newFederationFollowerMutation(c.config, OpUpdateOne, withFederationFollower(_m))
+	return &FederationFollowerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FederationFollowerClient) UpdateOneID(id xid.ID) *FederationFollowerUpdateOne {
+	mutation := newFederationFollowerMutation(c.config, OpUpdateOne, withFederationFollowerID(id))
+	return &FederationFollowerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FederationFollower.
+func (c *FederationFollowerClient) Delete() *FederationFollowerDelete {
+	mutation := newFederationFollowerMutation(c.config, OpDelete)
+	return &FederationFollowerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FederationFollowerClient) DeleteOne(_m *FederationFollower) *FederationFollowerDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FederationFollowerClient) DeleteOneID(id xid.ID) *FederationFollowerDeleteOne {
+	builder := c.Delete().Where(federationfollower.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FederationFollowerDeleteOne{builder}
+}
+
+// Query returns a query builder for FederationFollower.
+func (c *FederationFollowerClient) Query() *FederationFollowerQuery {
+	return &FederationFollowerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFederationFollower},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FederationFollower entity by its id.
+func (c *FederationFollowerClient) Get(ctx context.Context, id xid.ID) (*FederationFollower, error) {
+	return c.Query().Where(federationfollower.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FederationFollowerClient) GetX(ctx context.Context, id xid.ID) *FederationFollower {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FederationFollowerClient) Hooks() []Hook {
+	return c.hooks.FederationFollower
+}
+
+// Interceptors returns the client interceptors.
+func (c *FederationFollowerClient) Interceptors() []Interceptor {
+	return c.inters.FederationFollower
+}
+
+func (c *FederationFollowerClient) mutate(ctx context.Context, m *FederationFollowerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FederationFollowerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FederationFollowerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FederationFollowerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FederationFollowerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FederationFollower mutation op: %q", m.Op())
+	}
+}
+
+// FederationKeyClient is a client for the FederationKey schema.
+type FederationKeyClient struct {
+	config
+}
+
+// NewFederationKeyClient returns a client for the FederationKey from the given config.
+func NewFederationKeyClient(c config) *FederationKeyClient {
+	return &FederationKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `federationkey.Hooks(f(g(h())))`.
+func (c *FederationKeyClient) Use(hooks ...Hook) {
+	c.hooks.FederationKey = append(c.hooks.FederationKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `federationkey.Intercept(f(g(h())))`.
+func (c *FederationKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FederationKey = append(c.inters.FederationKey, interceptors...)
+}
+
+// Create returns a builder for creating a FederationKey entity.
+func (c *FederationKeyClient) Create() *FederationKeyCreate {
+	mutation := newFederationKeyMutation(c.config, OpCreate)
+	return &FederationKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FederationKey entities.
+func (c *FederationKeyClient) CreateBulk(builders ...*FederationKeyCreate) *FederationKeyCreateBulk {
+	return &FederationKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FederationKeyClient) MapCreateBulk(slice any, setFunc func(*FederationKeyCreate, int)) *FederationKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FederationKeyCreateBulk{err: fmt.Errorf("calling to FederationKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FederationKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FederationKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FederationKey.
+func (c *FederationKeyClient) Update() *FederationKeyUpdate {
+	mutation := newFederationKeyMutation(c.config, OpUpdate)
+	return &FederationKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FederationKeyClient) UpdateOne(_m *FederationKey) *FederationKeyUpdateOne {
+	mutation := newFederationKeyMutation(c.config, OpUpdateOne, withFederationKey(_m))
+	return &FederationKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FederationKeyClient) UpdateOneID(id xid.ID) *FederationKeyUpdateOne {
+	mutation := newFederationKeyMutation(c.config, OpUpdateOne, withFederationKeyID(id))
+	return &FederationKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FederationKey.
+func (c *FederationKeyClient) Delete() *FederationKeyDelete {
+	mutation := newFederationKeyMutation(c.config, OpDelete)
+	return &FederationKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FederationKeyClient) DeleteOne(_m *FederationKey) *FederationKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FederationKeyClient) DeleteOneID(id xid.ID) *FederationKeyDeleteOne {
+	builder := c.Delete().Where(federationkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FederationKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for FederationKey.
+func (c *FederationKeyClient) Query() *FederationKeyQuery {
+	return &FederationKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFederationKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FederationKey entity by its id.
+func (c *FederationKeyClient) Get(ctx context.Context, id xid.ID) (*FederationKey, error) {
+	return c.Query().Where(federationkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FederationKeyClient) GetX(ctx context.Context, id xid.ID) *FederationKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FederationKeyClient) Hooks() []Hook {
+	return c.hooks.FederationKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *FederationKeyClient) Interceptors() []Interceptor {
+	return c.inters.FederationKey
+}
+
+func (c *FederationKeyClient) mutate(ctx context.Context, m *FederationKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FederationKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FederationKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FederationKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FederationKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FederationKey mutation op: %q", m.Op())
+	}
+}
+
+// FeedTokenClient is a client for the FeedToken schema.
+type FeedTokenClient struct {
+	config
+}
+
+// NewFeedTokenClient returns a client for the FeedToken from the given config.
+func NewFeedTokenClient(c config) *FeedTokenClient {
+	return &FeedTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `feedtoken.Hooks(f(g(h())))`.
+func (c *FeedTokenClient) Use(hooks ...Hook) {
+	c.hooks.FeedToken = append(c.hooks.FeedToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `feedtoken.Intercept(f(g(h())))`.
+func (c *FeedTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeedToken = append(c.inters.FeedToken, interceptors...)
+}
+
+// Create returns a builder for creating a FeedToken entity.
+func (c *FeedTokenClient) Create() *FeedTokenCreate {
+	mutation := newFeedTokenMutation(c.config, OpCreate)
+	return &FeedTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FeedToken entities.
+func (c *FeedTokenClient) CreateBulk(builders ...*FeedTokenCreate) *FeedTokenCreateBulk {
+	return &FeedTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeedTokenClient) MapCreateBulk(slice any, setFunc func(*FeedTokenCreate, int)) *FeedTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeedTokenCreateBulk{err: fmt.Errorf("calling to FeedTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeedTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeedTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FeedToken.
+func (c *FeedTokenClient) Update() *FeedTokenUpdate {
+	mutation := newFeedTokenMutation(c.config, OpUpdate)
+	return &FeedTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeedTokenClient) UpdateOne(_m *FeedToken) *FeedTokenUpdateOne {
+	mutation := newFeedTokenMutation(c.config, OpUpdateOne, withFeedToken(_m))
+	return &FeedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeedTokenClient) UpdateOneID(id xid.ID) *FeedTokenUpdateOne {
+	mutation := newFeedTokenMutation(c.config, OpUpdateOne, withFeedTokenID(id))
+	return &FeedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FeedToken.
+func (c *FeedTokenClient) Delete() *FeedTokenDelete {
+	mutation := newFeedTokenMutation(c.config, OpDelete)
+	return &FeedTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeedTokenClient) DeleteOne(_m *FeedToken) *FeedTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeedTokenClient) DeleteOneID(id xid.ID) *FeedTokenDeleteOne {
+	builder := c.Delete().Where(feedtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeedTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for FeedToken.
+func (c *FeedTokenClient) Query() *FeedTokenQuery {
+	return &FeedTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeedToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FeedToken entity by its id.
+func (c *FeedTokenClient) Get(ctx context.Context, id xid.ID) (*FeedToken, error) {
+	return c.Query().Where(feedtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeedTokenClient) GetX(ctx context.Context, id xid.ID) *FeedToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a FeedToken.
+func (c *FeedTokenClient) QueryAccount(_m *FeedToken) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(feedtoken.Table, feedtoken.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, feedtoken.AccountTable, feedtoken.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FeedTokenClient) Hooks() []Hook {
+	return c.hooks.FeedToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeedTokenClient) Interceptors() []Interceptor {
+	return c.inters.FeedToken
+}
+
+func (c *FeedTokenClient) mutate(ctx context.Context, m *FeedTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeedTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeedTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeedTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FeedToken mutation op: %q", m.Op())
+	}
+}
+
+// ImportRecordClient is a client for the ImportRecord schema.
+type ImportRecordClient struct {
+	config
+}
+
+// NewImportRecordClient returns a client for the ImportRecord from the given config.
+func NewImportRecordClient(c config) *ImportRecordClient {
+	return &ImportRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `importrecord.Hooks(f(g(h())))`.
+func (c *ImportRecordClient) Use(hooks ...Hook) {
+	c.hooks.ImportRecord = append(c.hooks.ImportRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `importrecord.Intercept(f(g(h())))`.
+func (c *ImportRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportRecord = append(c.inters.ImportRecord, interceptors...)
+}
+
+// Create returns a builder for creating a ImportRecord entity.
+func (c *ImportRecordClient) Create() *ImportRecordCreate {
+	mutation := newImportRecordMutation(c.config, OpCreate)
+	return &ImportRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImportRecord entities.
+func (c *ImportRecordClient) CreateBulk(builders ...*ImportRecordCreate) *ImportRecordCreateBulk {
+	return &ImportRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImportRecordClient) MapCreateBulk(slice any, setFunc func(*ImportRecordCreate, int)) *ImportRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImportRecordCreateBulk{err: fmt.Errorf("calling to ImportRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImportRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImportRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImportRecord.
+func (c *ImportRecordClient) Update() *ImportRecordUpdate {
+	mutation := newImportRecordMutation(c.config, OpUpdate)
+	return &ImportRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImportRecordClient) UpdateOne(_m *ImportRecord) *ImportRecordUpdateOne {
+	mutation := newImportRecordMutation(c.config, OpUpdateOne, withImportRecord(_m))
+	return &ImportRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImportRecordClient) UpdateOneID(id xid.ID) *ImportRecordUpdateOne {
+	mutation := newImportRecordMutation(c.config, OpUpdateOne, withImportRecordID(id))
+	return &ImportRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImportRecord.
+func (c *ImportRecordClient) Delete() *ImportRecordDelete {
+	mutation := newImportRecordMutation(c.config, OpDelete)
+	return &ImportRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImportRecordClient) DeleteOne(_m *ImportRecord) *ImportRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImportRecordClient) DeleteOneID(id xid.ID) *ImportRecordDeleteOne {
+	builder := c.Delete().Where(importrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImportRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for ImportRecord.
+func (c *ImportRecordClient) Query() *ImportRecordQuery {
+	return &ImportRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImportRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImportRecord entity by its id.
+func (c *ImportRecordClient) Get(ctx context.Context, id xid.ID) (*ImportRecord, error) {
+	return c.Query().Where(importrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImportRecordClient) GetX(ctx context.Context, id xid.ID) *ImportRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a ImportRecord.
+func (c *ImportRecordClient) QueryRun(_m *ImportRecord) *ImportRunQuery {
+	query := (&ImportRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importrecord.Table, importrecord.FieldID, id),
+			sqlgraph.To(importrun.Table, importrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, importrecord.RunTable, importrecord.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImportRecordClient) Hooks() []Hook {
+	return c.hooks.ImportRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImportRecordClient) Interceptors() []Interceptor {
+	return c.inters.ImportRecord
+}
+
+func (c *ImportRecordClient) mutate(ctx context.Context, m *ImportRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImportRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImportRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImportRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImportRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImportRecord mutation op: %q", m.Op())
+	}
+}
+
+// ImportRunClient is a client for the ImportRun schema.
+type ImportRunClient struct {
+	config
+}
+
+// NewImportRunClient returns a client for the ImportRun from the given config.
+func NewImportRunClient(c config) *ImportRunClient {
+	return &ImportRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `importrun.Hooks(f(g(h())))`.
+func (c *ImportRunClient) Use(hooks ...Hook) {
+	c.hooks.ImportRun = append(c.hooks.ImportRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `importrun.Intercept(f(g(h())))`.
+func (c *ImportRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportRun = append(c.inters.ImportRun, interceptors...)
+}
+
+// Create returns a builder for creating a ImportRun entity.
+func (c *ImportRunClient) Create() *ImportRunCreate {
+	mutation := newImportRunMutation(c.config, OpCreate)
+	return &ImportRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImportRun entities.
+func (c *ImportRunClient) CreateBulk(builders ...*ImportRunCreate) *ImportRunCreateBulk {
+	return &ImportRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImportRunClient) MapCreateBulk(slice any, setFunc func(*ImportRunCreate, int)) *ImportRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImportRunCreateBulk{err: fmt.Errorf("calling to ImportRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImportRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImportRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImportRun.
+func (c *ImportRunClient) Update() *ImportRunUpdate {
+	mutation := newImportRunMutation(c.config, OpUpdate)
+	return &ImportRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImportRunClient) UpdateOne(_m *ImportRun) *ImportRunUpdateOne {
+	mutation := newImportRunMutation(c.config, OpUpdateOne, withImportRun(_m))
+	return &ImportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImportRunClient) UpdateOneID(id xid.ID) *ImportRunUpdateOne {
+	mutation := newImportRunMutation(c.config, OpUpdateOne, withImportRunID(id))
+	return &ImportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImportRun.
+func (c *ImportRunClient) Delete() *ImportRunDelete {
+	mutation := newImportRunMutation(c.config, OpDelete)
+	return &ImportRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImportRunClient) DeleteOne(_m *ImportRun) *ImportRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImportRunClient) DeleteOneID(id xid.ID) *ImportRunDeleteOne {
+	builder := c.Delete().Where(importrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImportRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ImportRun.
+func (c *ImportRunClient) Query() *ImportRunQuery {
+	return &ImportRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImportRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImportRun entity by its id.
+func (c *ImportRunClient) Get(ctx context.Context, id xid.ID) (*ImportRun, error) {
+	return c.Query().Where(importrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImportRunClient) GetX(ctx context.Context, id xid.ID) *ImportRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRecords queries the records edge of a ImportRun.
+func (c *ImportRunClient) QueryRecords(_m *ImportRun) *ImportRecordQuery {
+	query := (&ImportRecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importrun.Table, importrun.FieldID, id),
+			sqlgraph.To(importrecord.Table, importrecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, importrun.RecordsTable, importrun.RecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImportRunClient) Hooks() []Hook {
+	return c.hooks.ImportRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImportRunClient) Interceptors() []Interceptor {
+	return c.inters.ImportRun
+}
+
+func (c *ImportRunClient) mutate(ctx context.Context, m *ImportRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImportRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImportRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImportRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImportRun mutation op: %q", m.Op())
+	}
+}
+
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
+	config
+}
+
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
+func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+}
+
+// Create returns a builder for creating a Invitation entity.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationClient) UpdateOne(_m *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(_m))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationClient) UpdateOneID(id xid.ID) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationClient) DeleteOne(_m *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationClient) DeleteOneID(id xid.ID) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id xid.ID) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationClient) GetX(ctx context.Context, id xid.ID) *Invitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreator queries the creator edge of a Invitation.
+func (c *InvitationClient) QueryCreator(_m *Invitation) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.CreatorTable, invitation.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvited queries the invited edge of a Invitation.
+func (c *InvitationClient) QueryInvited(_m *Invitation) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invitation.InvitedTable, invitation.InvitedColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationClient) Hooks() []Hook {
+	return c.hooks.Invitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvitationClient) Interceptors() []Interceptor {
+	return c.inters.Invitation
+}
+
+func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id xid.ID) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id xid.ID) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id xid.ID) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id xid.ID) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// LikePostClient is a client for the LikePost schema.
+type LikePostClient struct {
+	config
+}
+
+// NewLikePostClient returns a client for the LikePost from the given config.
+func NewLikePostClient(c config) *LikePostClient {
+	return &LikePostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `likepost.Hooks(f(g(h())))`.
+func (c *LikePostClient) Use(hooks ...Hook) {
+	c.hooks.LikePost = append(c.hooks.LikePost, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `likepost.Intercept(f(g(h())))`.
+func (c *LikePostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LikePost = append(c.inters.LikePost, interceptors...)
+}
+
+// Create returns a builder for creating a LikePost entity.
+func (c *LikePostClient) Create() *LikePostCreate {
+	mutation := newLikePostMutation(c.config, OpCreate)
+	return &LikePostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LikePost entities.
+func (c *LikePostClient) CreateBulk(builders ...*LikePostCreate) *LikePostCreateBulk {
+	return &LikePostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LikePostClient) MapCreateBulk(slice any, setFunc func(*LikePostCreate, int)) *LikePostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LikePostCreateBulk{err: fmt.Errorf("calling to LikePostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LikePostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LikePostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LikePost.
+func (c *LikePostClient) Update() *LikePostUpdate {
+	mutation := newLikePostMutation(c.config, OpUpdate)
+	return &LikePostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LikePostClient) UpdateOne(_m *LikePost) *LikePostUpdateOne {
+	mutation := newLikePostMutation(c.config, OpUpdateOne, withLikePost(_m))
+	return &LikePostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LikePostClient) UpdateOneID(id xid.ID) *LikePostUpdateOne {
+	mutation := newLikePostMutation(c.config, OpUpdateOne, withLikePostID(id))
+	return &LikePostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LikePost.
+func (c *LikePostClient) Delete() *LikePostDelete {
+	mutation := newLikePostMutation(c.config, OpDelete)
+	return &LikePostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LikePostClient) DeleteOne(_m *LikePost) *LikePostDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LikePostClient) DeleteOneID(id xid.ID) *LikePostDeleteOne {
+	builder := c.Delete().Where(likepost.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LikePostDeleteOne{builder}
+}
+
+// Query returns a query builder for LikePost.
+func (c *LikePostClient) Query() *LikePostQuery {
+	return &LikePostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLikePost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LikePost entity by its id.
+func (c *LikePostClient) Get(ctx context.Context, id xid.ID) (*LikePost, error) {
+	return c.Query().Where(likepost.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LikePostClient) GetX(ctx context.Context, id xid.ID) *LikePost {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a LikePost.
+func (c *LikePostClient) QueryAccount(_m *LikePost) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(likepost.Table, likepost.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, likepost.AccountTable, likepost.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPost queries the Post edge of a LikePost.
+func (c *LikePostClient) QueryPost(_m *LikePost) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(likepost.Table, likepost.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, likepost.PostTable, likepost.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LikePostClient) Hooks() []Hook {
+	return c.hooks.LikePost
+}
+
+// Interceptors returns the client interceptors.
+func (c *LikePostClient) Interceptors() []Interceptor {
+	return c.inters.LikePost
+}
+
+func (c *LikePostClient) mutate(ctx context.Context, m *LikePostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LikePostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LikePostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LikePostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LikePostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LikePost mutation op: %q", m.Op())
+	}
+}
+
+// LinkClient is a client for the Link schema.
+type LinkClient struct {
+	config
+}
+
+// NewLinkClient returns a client for the Link from the given config.
+func NewLinkClient(c config) *LinkClient {
+	return &LinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `link.Hooks(f(g(h())))`.
+func (c *LinkClient) Use(hooks ...Hook) {
+	c.hooks.Link = append(c.hooks.Link, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `link.Intercept(f(g(h())))`.
+func (c *LinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Link = append(c.inters.Link, interceptors...)
+}
+
+// Create returns a builder for creating a Link entity.
+func (c *LinkClient) Create() *LinkCreate {
+	mutation := newLinkMutation(c.config, OpCreate)
+	return &LinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Link entities.
+func (c *LinkClient) CreateBulk(builders ...*LinkCreate) *LinkCreateBulk {
+	return &LinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkClient) MapCreateBulk(slice any, setFunc func(*LinkCreate, int)) *LinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkCreateBulk{err: fmt.Errorf("calling to LinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Link.
+func (c *LinkClient) Update() *LinkUpdate {
+	mutation := newLinkMutation(c.config, OpUpdate)
+	return &LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkClient) UpdateOne(_m *Link) *LinkUpdateOne {
+	mutation := newLinkMutation(c.config, OpUpdateOne, withLink(_m))
+	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkClient) UpdateOneID(id xid.ID) *LinkUpdateOne {
+	mutation := newLinkMutation(c.config, OpUpdateOne, withLinkID(id))
+	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Link.
+func (c *LinkClient) Delete() *LinkDelete {
+	mutation := newLinkMutation(c.config, OpDelete)
+	return &LinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkClient) DeleteOne(_m *Link) *LinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkClient) DeleteOneID(id xid.ID) *LinkDeleteOne {
+	builder := c.Delete().Where(link.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkDeleteOne{builder}
+}
+
+// Query returns a query builder for Link.
+func (c *LinkClient) Query() *LinkQuery {
+	return &LinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Link entity by its id.
+func (c *LinkClient) Get(ctx context.Context, id xid.ID) (*Link, error) {
+	return c.Query().Where(link.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkClient) GetX(ctx context.Context, id xid.ID) *Link {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPosts queries the posts edge of a Link.
+func (c *LinkClient) QueryPosts(_m *Link) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, link.PostsTable, link.PostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPostContentReferences queries the post_content_references edge of a Link.
+func (c *LinkClient) QueryPostContentReferences(_m *Link) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, link.PostContentReferencesTable, link.PostContentReferencesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNodes queries the nodes edge of a Link.
+func (c *LinkClient) QueryNodes(_m *Link) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, link.NodesTable, link.NodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNodeContentReferences queries the node_content_references edge of a Link.
+func (c *LinkClient) QueryNodeContentReferences(_m *Link) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, link.NodeContentReferencesTable, link.NodeContentReferencesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrimaryImage queries the primary_image edge of a Link.
+func (c *LinkClient) QueryPrimaryImage(_m *Link) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, link.PrimaryImageTable, link.PrimaryImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFaviconImage queries the favicon_image edge of a Link.
+func (c *LinkClient) QueryFaviconImage(_m *Link) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, link.FaviconImageTable, link.FaviconImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySnapshot queries the snapshot edge of a Link.
+func (c *LinkClient) QuerySnapshot(_m *Link) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, link.SnapshotTable, link.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssets queries the assets edge of a Link.
+func (c *LinkClient) QueryAssets(_m *Link) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, link.AssetsTable, link.AssetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChecks queries the checks edge of a Link.
+func (c *LinkClient) QueryChecks(_m *Link) *LinkCheckQuery {
+	query := (&LinkCheckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(linkcheck.Table, linkcheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, link.ChecksTable, link.ChecksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkClient) Hooks() []Hook {
+	return c.hooks.Link
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkClient) Interceptors() []Interceptor {
+	return c.inters.Link
+}
+
+func (c *LinkClient) mutate(ctx context.Context, m *LinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Link mutation op: %q", m.Op())
+	}
+}
+
+// LinkCheckClient is a client for the LinkCheck schema.
+type LinkCheckClient struct {
+	config
+}
+
+// NewLinkCheckClient returns a client for the LinkCheck from the given config.
+func NewLinkCheckClient(c config) *LinkCheckClient {
+	return &LinkCheckClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkcheck.Hooks(f(g(h())))`.
+func (c *LinkCheckClient) Use(hooks ...Hook) {
+	c.hooks.LinkCheck = append(c.hooks.LinkCheck, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkcheck.Intercept(f(g(h())))`.
+func (c *LinkCheckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkCheck = append(c.inters.LinkCheck, interceptors...)
+}
+
+// Create returns a builder for creating a LinkCheck entity.
+func (c *LinkCheckClient) Create() *LinkCheckCreate {
+	mutation := newLinkCheckMutation(c.config, OpCreate)
+	return &LinkCheckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkCheck entities.
+func (c *LinkCheckClient) CreateBulk(builders ...*LinkCheckCreate) *LinkCheckCreateBulk {
+	return &LinkCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkCheckClient) MapCreateBulk(slice any, setFunc func(*LinkCheckCreate, int)) *LinkCheckCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkCheckCreateBulk{err: fmt.Errorf("calling to LinkCheckClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkCheckCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkCheck.
+func (c *LinkCheckClient) Update() *LinkCheckUpdate {
+	mutation := newLinkCheckMutation(c.config, OpUpdate)
+	return &LinkCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkCheckClient) UpdateOne(_m *LinkCheck) *LinkCheckUpdateOne {
+	mutation := newLinkCheckMutation(c.config, OpUpdateOne, withLinkCheck(_m))
+	return &LinkCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkCheckClient) UpdateOneID(id xid.ID) *LinkCheckUpdateOne {
+	mutation := newLinkCheckMutation(c.config, OpUpdateOne, withLinkCheckID(id))
+	return &LinkCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkCheck.
+func (c *LinkCheckClient) Delete() *LinkCheckDelete {
+	mutation := newLinkCheckMutation(c.config, OpDelete)
+	return &LinkCheckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkCheckClient) DeleteOne(_m *LinkCheck) *LinkCheckDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkCheckClient) DeleteOneID(id xid.ID) *LinkCheckDeleteOne {
+	builder := c.Delete().Where(linkcheck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkCheckDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkCheck.
+func (c *LinkCheckClient) Query() *LinkCheckQuery {
+	return &LinkCheckQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkCheck},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkCheck entity by its id.
+func (c *LinkCheckClient) Get(ctx context.Context, id xid.ID) (*LinkCheck, error) {
+	return c.Query().Where(linkcheck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkCheckClient) GetX(ctx context.Context, id xid.ID) *LinkCheck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLink queries the link edge of a LinkCheck.
+func (c *LinkCheckClient) QueryLink(_m *LinkCheck) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkcheck.Table, linkcheck.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, linkcheck.LinkTable, linkcheck.LinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkCheckClient) Hooks() []Hook {
+	return c.hooks.LinkCheck
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkCheckClient) Interceptors() []Interceptor {
+	return c.inters.LinkCheck
+}
+
+func (c *LinkCheckClient) mutate(ctx context.Context, m *LinkCheckMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkCheckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkCheckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkCheck mutation op: %q", m.Op())
+	}
+}
+
+// MatrixBridgeLinkClient is a client for the MatrixBridgeLink schema.
+type MatrixBridgeLinkClient struct {
+	config
+}
+
+// NewMatrixBridgeLinkClient returns a client for the MatrixBridgeLink from the given config.
+func NewMatrixBridgeLinkClient(c config) *MatrixBridgeLinkClient {
+	return &MatrixBridgeLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `matrixbridgelink.Hooks(f(g(h())))`.
+func (c *MatrixBridgeLinkClient) Use(hooks ...Hook) {
+	c.hooks.MatrixBridgeLink = append(c.hooks.MatrixBridgeLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `matrixbridgelink.Intercept(f(g(h())))`.
+func (c *MatrixBridgeLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MatrixBridgeLink = append(c.inters.MatrixBridgeLink, interceptors...)
+}
+
+// Create returns a builder for creating a MatrixBridgeLink entity.
+func (c *MatrixBridgeLinkClient) Create() *MatrixBridgeLinkCreate {
+	mutation := newMatrixBridgeLinkMutation(c.config, OpCreate)
+	return &MatrixBridgeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MatrixBridgeLink entities.
+func (c *MatrixBridgeLinkClient) CreateBulk(builders ...*MatrixBridgeLinkCreate) *MatrixBridgeLinkCreateBulk {
+	return &MatrixBridgeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MatrixBridgeLinkClient) MapCreateBulk(slice any, setFunc func(*MatrixBridgeLinkCreate, int)) *MatrixBridgeLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MatrixBridgeLinkCreateBulk{err: fmt.Errorf("calling to MatrixBridgeLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MatrixBridgeLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MatrixBridgeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MatrixBridgeLink.
+func (c *MatrixBridgeLinkClient) Update() *MatrixBridgeLinkUpdate {
+	mutation := newMatrixBridgeLinkMutation(c.config, OpUpdate)
+	return &MatrixBridgeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MatrixBridgeLinkClient) UpdateOne(_m *MatrixBridgeLink) *MatrixBridgeLinkUpdateOne {
+	mutation := newMatrixBridgeLinkMutation(c.config, OpUpdateOne, withMatrixBridgeLink(_m))
+	return &MatrixBridgeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MatrixBridgeLinkClient) UpdateOneID(id xid.ID) *MatrixBridgeLinkUpdateOne {
+	mutation := newMatrixBridgeLinkMutation(c.config, OpUpdateOne, withMatrixBridgeLinkID(id))
+	return &MatrixBridgeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MatrixBridgeLink.
+func (c *MatrixBridgeLinkClient) Delete() *MatrixBridgeLinkDelete {
+	mutation := newMatrixBridgeLinkMutation(c.config, OpDelete)
+	return &MatrixBridgeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MatrixBridgeLinkClient) DeleteOne(_m *MatrixBridgeLink) *MatrixBridgeLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MatrixBridgeLinkClient) DeleteOneID(id xid.ID) *MatrixBridgeLinkDeleteOne {
+	builder := c.Delete().Where(matrixbridgelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MatrixBridgeLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for MatrixBridgeLink.
+func (c *MatrixBridgeLinkClient) Query() *MatrixBridgeLinkQuery {
+	return &MatrixBridgeLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMatrixBridgeLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MatrixBridgeLink entity by its id.
+func (c *MatrixBridgeLinkClient) Get(ctx context.Context, id xid.ID) (*MatrixBridgeLink, error) {
+	return c.Query().Where(matrixbridgelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MatrixBridgeLinkClient) GetX(ctx context.Context, id xid.ID) *MatrixBridgeLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a MatrixBridgeLink.
+func (c *MatrixBridgeLinkClient) QueryPost(_m *MatrixBridgeLink) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(matrixbridgelink.Table, matrixbridgelink.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, matrixbridgelink.PostTable, matrixbridgelink.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MatrixBridgeLinkClient) Hooks() []Hook {
+	return c.hooks.MatrixBridgeLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *MatrixBridgeLinkClient) Interceptors() []Interceptor {
+	return c.inters.MatrixBridgeLink
+}
+
+func (c *MatrixBridgeLinkClient) mutate(ctx context.Context, m *MatrixBridgeLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MatrixBridgeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MatrixBridgeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MatrixBridgeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MatrixBridgeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MatrixBridgeLink mutation op: %q", m.Op())
+	}
+}
+
+// MentionProfileClient is a client for the MentionProfile schema.
+type MentionProfileClient struct {
+	config
+}
+
+// NewMentionProfileClient returns a client for the MentionProfile from the given config.
+func NewMentionProfileClient(c config) *MentionProfileClient {
+	return &MentionProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mentionprofile.Hooks(f(g(h())))`.
+func (c *MentionProfileClient) Use(hooks ...Hook) {
+	c.hooks.MentionProfile = append(c.hooks.MentionProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mentionprofile.Intercept(f(g(h())))`.
+func (c *MentionProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MentionProfile = append(c.inters.MentionProfile, interceptors...)
+}
+
+// Create returns a builder for creating a MentionProfile entity.
+func (c *MentionProfileClient) Create() *MentionProfileCreate {
+	mutation := newMentionProfileMutation(c.config, OpCreate)
+	return &MentionProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MentionProfile entities.
+func (c *MentionProfileClient) CreateBulk(builders ...*MentionProfileCreate) *MentionProfileCreateBulk {
+	return &MentionProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MentionProfileClient) MapCreateBulk(slice any, setFunc func(*MentionProfileCreate, int)) *MentionProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MentionProfileCreateBulk{err: fmt.Errorf("calling to MentionProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MentionProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MentionProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MentionProfile.
+func (c *MentionProfileClient) Update() *MentionProfileUpdate {
+	mutation := newMentionProfileMutation(c.config, OpUpdate)
+	return &MentionProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MentionProfileClient) UpdateOne(_m *MentionProfile) *MentionProfileUpdateOne {
+	mutation := newMentionProfileMutation(c.config, OpUpdateOne, withMentionProfile(_m))
+	return &MentionProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MentionProfileClient) UpdateOneID(id xid.ID) *MentionProfileUpdateOne {
+	mutation := newMentionProfileMutation(c.config, OpUpdateOne, withMentionProfileID(id))
+	return &MentionProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MentionProfile.
+func (c *MentionProfileClient) Delete() *MentionProfileDelete {
+	mutation := newMentionProfileMutation(c.config, OpDelete)
+	return &MentionProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MentionProfileClient) DeleteOne(_m *MentionProfile) *MentionProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MentionProfileClient) DeleteOneID(id xid.ID) *MentionProfileDeleteOne {
+	builder := c.Delete().Where(mentionprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MentionProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for MentionProfile.
+func (c *MentionProfileClient) Query() *MentionProfileQuery {
+	return &MentionProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMentionProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MentionProfile entity by its id.
+func (c *MentionProfileClient) Get(ctx context.Context, id xid.ID) (*MentionProfile, error) {
+	return c.Query().Where(mentionprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MentionProfileClient) GetX(ctx context.Context, id xid.ID) *MentionProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a MentionProfile.
+func (c *MentionProfileClient) QueryAccount(_m *MentionProfile) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mentionprofile.Table, mentionprofile.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mentionprofile.AccountTable, mentionprofile.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPost queries the Post edge of a MentionProfile.
+func (c *MentionProfileClient) QueryPost(_m *MentionProfile) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mentionprofile.Table, mentionprofile.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mentionprofile.PostTable, mentionprofile.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MentionProfileClient) Hooks() []Hook {
+	return c.hooks.MentionProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *MentionProfileClient) Interceptors() []Interceptor {
+	return c.inters.MentionProfile
+}
+
+func (c *MentionProfileClient) mutate(ctx context.Context, m *MentionProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MentionProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MentionProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MentionProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MentionProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MentionProfile mutation op: %q", m.Op())
+	}
+}
+
+// ModerationNoteClient is a client for the ModerationNote schema.
+type ModerationNoteClient struct {
+	config
+}
+
+// NewModerationNoteClient returns a client for the ModerationNote from the given config.
+func NewModerationNoteClient(c config) *ModerationNoteClient {
+	return &ModerationNoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moderationnote.Hooks(f(g(h())))`.
+func (c *ModerationNoteClient) Use(hooks ...Hook) {
+	c.hooks.ModerationNote = append(c.hooks.ModerationNote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moderationnote.Intercept(f(g(h())))`.
+func (c *ModerationNoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationNote = append(c.inters.ModerationNote, interceptors...)
+}
+
+// Create returns a builder for creating a ModerationNote entity.
+func (c *ModerationNoteClient) Create() *ModerationNoteCreate {
+	mutation := newModerationNoteMutation(c.config, OpCreate)
+	return &ModerationNoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModerationNote entities.
+func (c *ModerationNoteClient) CreateBulk(builders ...*ModerationNoteCreate) *ModerationNoteCreateBulk {
+	return &ModerationNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModerationNoteClient) MapCreateBulk(slice any, setFunc func(*ModerationNoteCreate, int)) *ModerationNoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModerationNoteCreateBulk{err: fmt.Errorf("calling to ModerationNoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModerationNoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModerationNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModerationNote.
+func (c *ModerationNoteClient) Update() *ModerationNoteUpdate {
+	mutation := newModerationNoteMutation(c.config, OpUpdate)
+	return &ModerationNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModerationNoteClient) UpdateOne(_m *ModerationNote) *ModerationNoteUpdateOne {
+	mutation := newModerationNoteMutation(c.config, OpUpdateOne, withModerationNote(_m))
+	return &ModerationNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModerationNoteClient) UpdateOneID(id xid.ID) *ModerationNoteUpdateOne {
+	mutation := newModerationNoteMutation(c.config, OpUpdateOne, withModerationNoteID(id))
+	return &ModerationNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModerationNote.
+func (c *ModerationNoteClient) Delete() *ModerationNoteDelete {
+	mutation := newModerationNoteMutation(c.config, OpDelete)
+	return &ModerationNoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModerationNoteClient) DeleteOne(_m *ModerationNote) *ModerationNoteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModerationNoteClient) DeleteOneID(id xid.ID) *ModerationNoteDeleteOne {
+	builder := c.Delete().Where(moderationnote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModerationNoteDeleteOne{builder}
+}
+
+// Query returns a query builder for ModerationNote.
+func (c *ModerationNoteClient) Query() *ModerationNoteQuery {
+	return &ModerationNoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModerationNote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModerationNote entity by its id.
+func (c *ModerationNoteClient) Get(ctx context.Context, id xid.ID) (*ModerationNote, error) {
+	return c.Query().Where(moderationnote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModerationNoteClient) GetX(ctx context.Context, id xid.ID) *ModerationNote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a ModerationNote.
+func (c *ModerationNoteClient) QueryAccount(_m *ModerationNote) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moderationnote.Table, moderationnote.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, moderationnote.AccountTable, moderationnote.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthor queries the author edge of a ModerationNote.
+func (c *ModerationNoteClient) QueryAuthor(_m *ModerationNote) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moderationnote.Table, moderationnote.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, moderationnote.AuthorTable, moderationnote.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModerationNoteClient) Hooks() []Hook {
+	return c.hooks.ModerationNote
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModerationNoteClient) Interceptors() []Interceptor {
+	return c.inters.ModerationNote
+}
+
+func (c *ModerationNoteClient) mutate(ctx context.Context, m *ModerationNoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModerationNoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModerationNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModerationNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModerationNoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModerationNote mutation op: %q", m.Op())
+	}
+}
+
+// NetworkBanClient is a client for the NetworkBan schema.
+type NetworkBanClient struct {
+	config
+}
+
+// NewNetworkBanClient returns a client for the NetworkBan from the given config.
+func NewNetworkBanClient(c config) *NetworkBanClient {
+	return &NetworkBanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `networkban.Hooks(f(g(h())))`.
+func (c *NetworkBanClient) Use(hooks ...Hook) {
+	c.hooks.NetworkBan = append(c.hooks.NetworkBan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `networkban.Intercept(f(g(h())))`.
+func (c *NetworkBanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NetworkBan = append(c.inters.NetworkBan, interceptors...)
+}
+
+// Create returns a builder for creating a NetworkBan entity.
+func (c *NetworkBanClient) Create() *NetworkBanCreate {
+	mutation := newNetworkBanMutation(c.config, OpCreate)
+	return &NetworkBanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NetworkBan entities.
+func (c *NetworkBanClient) CreateBulk(builders ...*NetworkBanCreate) *NetworkBanCreateBulk {
+	return &NetworkBanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NetworkBanClient) MapCreateBulk(slice any, setFunc func(*NetworkBanCreate, int)) *NetworkBanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NetworkBanCreateBulk{err: fmt.Errorf("calling to NetworkBanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NetworkBanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NetworkBanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NetworkBan.
+func (c *NetworkBanClient) Update() *NetworkBanUpdate {
+	mutation := newNetworkBanMutation(c.config, OpUpdate)
+	return &NetworkBanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NetworkBanClient) UpdateOne(_m *NetworkBan) *NetworkBanUpdateOne {
+	mutation := newNetworkBanMutation(c.config, OpUpdateOne, withNetworkBan(_m))
+	return &NetworkBanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NetworkBanClient) UpdateOneID(id xid.ID) *NetworkBanUpdateOne {
+	mutation := newNetworkBanMutation(c.config, OpUpdateOne, withNetworkBanID(id))
+	return &NetworkBanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NetworkBan.
+func (c *NetworkBanClient) Delete() *NetworkBanDelete {
+	mutation := newNetworkBanMutation(c.config, OpDelete)
+	return &NetworkBanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NetworkBanClient) DeleteOne(_m *NetworkBan) *NetworkBanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NetworkBanClient) DeleteOneID(id xid.ID) *NetworkBanDeleteOne {
+	builder := c.Delete().Where(networkban.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NetworkBanDeleteOne{builder}
+}
+
+// Query returns a query builder for NetworkBan.
+func (c *NetworkBanClient) Query() *NetworkBanQuery {
+	return &NetworkBanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNetworkBan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NetworkBan entity by its id.
+func (c *NetworkBanClient) Get(ctx context.Context, id xid.ID) (*NetworkBan, error) {
+	return c.Query().Where(networkban.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NetworkBanClient) GetX(ctx context.Context, id xid.ID) *NetworkBan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NetworkBanClient) Hooks() []Hook {
+	return c.hooks.NetworkBan
+}
+
+// Interceptors returns the client interceptors.
+func (c *NetworkBanClient) Interceptors() []Interceptor {
+	return c.inters.NetworkBan
+}
+
+func (c *NetworkBanClient) mutate(ctx context.Context, m *NetworkBanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NetworkBanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NetworkBanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NetworkBanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NetworkBanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NetworkBan mutation op: %q", m.Op())
+	}
+}
+
+// NodeClient is a client for the Node schema.
+type NodeClient struct {
+	config
+}
+
+// NewNodeClient returns a client for the Node from the given config.
+func NewNodeClient(c config) *NodeClient {
+	return &NodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `node.Hooks(f(g(h())))`.
+func (c *NodeClient) Use(hooks ...Hook) {
+	c.hooks.Node = append(c.hooks.Node, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `node.Intercept(f(g(h())))`.
+func (c *NodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Node = append(c.inters.Node, interceptors...)
+}
+
+// Create returns a builder for creating a Node entity.
+func (c *NodeClient) Create() *NodeCreate {
+	mutation := newNodeMutation(c.config, OpCreate)
+	return &NodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Node entities.
+func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeClient) MapCreateBulk(slice any, setFunc func(*NodeCreate, int)) *NodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeCreateBulk{err: fmt.Errorf("calling to NodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Node.
+func (c *NodeClient) Update() *NodeUpdate {
+	mutation := newNodeMutation(c.config, OpUpdate)
+	return &NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NodeClient) UpdateOne(_m *Node) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNode(_m))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NodeClient) UpdateOneID(id xid.ID) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNodeID(id))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Node.
+func (c *NodeClient) Delete() *NodeDelete {
+	mutation := newNodeMutation(c.config, OpDelete)
+	return &NodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NodeClient) DeleteOne(_m *Node) *NodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NodeClient) DeleteOneID(id xid.ID) *NodeDeleteOne {
+	builder := c.Delete().Where(node.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NodeDeleteOne{builder}
+}
+
+// Query returns a query builder for Node.
+func (c *NodeClient) Query() *NodeQuery {
+	return &NodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Node entity by its id.
+func (c *NodeClient) Get(ctx context.Context, id xid.ID) (*Node, error) {
+	return c.Query().Where(node.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NodeClient) GetX(ctx context.Context, id xid.ID) *Node {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Node.
+func (c *NodeClient) QueryOwner(_m *Node) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, node.OwnerTable, node.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Node.
+func (c *NodeClient) QueryParent(_m *Node) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, node.ParentTable, node.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNodes queries the nodes edge of a Node.
+func (c *NodeClient) QueryNodes(_m *Node) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.NodesTable, node.NodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrimaryImage queries the primary_image edge of a Node.
+func (c *NodeClient) QueryPrimaryImage(_m *Node) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, node.PrimaryImageTable, node.PrimaryImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssets queries the assets edge of a Node.
+func (c *NodeClient) QueryAssets(_m *Node) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, node.AssetsTable, node.AssetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Node.
+func (c *NodeClient) QueryTags(_m *Node) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, node.TagsTable, node.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProperties queries the properties edge of a Node.
+func (c *NodeClient) QueryProperties(_m *Node) *PropertyQuery {
+	query := (&PropertyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(property.Table, property.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.PropertiesTable, node.PropertiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPropertySchema queries the property_schema edge of a Node.
+func (c *NodeClient) QueryPropertySchema(_m *Node) *PropertySchemaQuery {
+	query := (&PropertySchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(propertyschema.Table, propertyschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, node.PropertySchemaTable, node.PropertySchemaColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLink queries the link edge of a Node.
+func (c *NodeClient) QueryLink(_m *Node) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, node.LinkTable, node.LinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContentLinks queries the content_links edge of a Node.
+func (c *NodeClient) QueryContentLinks(_m *Node) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, node.ContentLinksTable, node.ContentLinksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollections queries the collections edge of a Node.
+func (c *NodeClient) QueryCollections(_m *Node) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, node.CollectionsTable, node.CollectionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollectionNodes queries the collection_nodes edge of a Node.
+func (c *NodeClient) QueryCollectionNodes(_m *Node) *CollectionNodeQuery {
+	query := (&CollectionNodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(collectionnode.Table, collectionnode.NodeColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, node.CollectionNodesTable, node.CollectionNodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NodeClient) Hooks() []Hook {
+	return c.hooks.Node
+}
+
+// Interceptors returns the client interceptors.
+func (c *NodeClient) Interceptors() []Interceptor {
+	return c.inters.Node
+}
+
+func (c *NodeClient) mutate(ctx context.Context, m *NodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Node mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id xid.ID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id xid.ID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id xid.ID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id xid.ID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Notification.
+func (c *NotificationClient) QueryOwner(_m *Notification) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.OwnerTable, notification.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySource queries the source edge of a Notification.
+func (c *NotificationClient) QuerySource(_m *Notification) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.SourceTable, notification.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id xid.ID) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id xid.ID) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id xid.ID) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id xid.ID) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a NotificationPreference.
+func (c *NotificationPreferenceClient) QueryAccount(_m *NotificationPreference) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationpreference.Table, notificationpreference.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationpreference.AccountTable, notificationpreference.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// PolicyAcceptanceClient is a client for the PolicyAcceptance schema.
+type PolicyAcceptanceClient struct {
+	config
+}
+
+// NewPolicyAcceptanceClient returns a client for the PolicyAcceptance from the given config.
+func NewPolicyAcceptanceClient(c config) *PolicyAcceptanceClient {
+	return &PolicyAcceptanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policyacceptance.Hooks(f(g(h())))`.
+func (c *PolicyAcceptanceClient) Use(hooks ...Hook) {
+	c.hooks.PolicyAcceptance = append(c.hooks.PolicyAcceptance, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `accountfollow.Intercept(f(g(h())))`.
-func (c *AccountFollowClient) Intercept(interceptors ...Interceptor) {
-	c.inters.AccountFollow = append(c.inters.AccountFollow, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `policyacceptance.Intercept(f(g(h())))`.
+func (c *PolicyAcceptanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicyAcceptance = append(c.inters.PolicyAcceptance, interceptors...)
 }
 
-// Create returns a builder for creating a AccountFollow entity.
-func (c *AccountFollowClient) Create() *AccountFollowCreate {
-	mutation := newAccountFollowMutation(c.config, OpCreate)
-	return &AccountFollowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PolicyAcceptance entity.
+func (c *PolicyAcceptanceClient) Create() *PolicyAcceptanceCreate {
+	mutation := newPolicyAcceptanceMutation(c.config, OpCreate)
+	return &PolicyAcceptanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of AccountFollow entities.
-func (c *AccountFollowClient) CreateBulk(builders ...*AccountFollowCreate) *AccountFollowCreateBulk {
-	return &AccountFollowCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PolicyAcceptance entities.
+func (c *PolicyAcceptanceClient) CreateBulk(builders ...*PolicyAcceptanceCreate) *PolicyAcceptanceCreateBulk {
+	return &PolicyAcceptanceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *AccountFollowClient) MapCreateBulk(slice any, setFunc func(*AccountFollowCreate, int)) *AccountFollowCreateBulk {
+func (c *PolicyAcceptanceClient) MapCreateBulk(slice any, setFunc func(*PolicyAcceptanceCreate, int)) *PolicyAcceptanceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &AccountFollowCreateBulk{err: fmt.Errorf("calling to AccountFollowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PolicyAcceptanceCreateBulk{err: fmt.Errorf("calling to PolicyAcceptanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*AccountFollowCreate, rv.Len())
+	builders := make([]*PolicyAcceptanceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &AccountFollowCreateBulk{config: c.config, builders: builders}
+	return &PolicyAcceptanceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for AccountFollow.
-func (c *AccountFollowClient) Update() *AccountFollowUpdate {
-	mutation := newAccountFollowMutation(c.config, OpUpdate)
-	return &AccountFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PolicyAcceptance.
+func (c *PolicyAcceptanceClient) Update() *PolicyAcceptanceUpdate {
+	mutation := newPolicyAcceptanceMutation(c.config, OpUpdate)
+	return &PolicyAcceptanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *AccountFollowClient) UpdateOne(_m *AccountFollow) *AccountFollowUpdateOne {
-	mutation := newAccountFollowMutation(c.config, OpUpdateOne, withAccountFollow(_m))
-	return &AccountFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PolicyAcceptanceClient) UpdateOne(_m *PolicyAcceptance) *PolicyAcceptanceUpdateOne {
+	mutation := newPolicyAcceptanceMutation(c.config, OpUpdateOne, withPolicyAcceptance(_m))
+	return &PolicyAcceptanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *AccountFollowClient) UpdateOneID(id xid.ID) *AccountFollowUpdateOne {
-	mutation := newAccountFollowMutation(c.config, OpUpdateOne, withAccountFollowID(id))
-	return &AccountFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PolicyAcceptanceClient) UpdateOneID(id xid.ID) *PolicyAcceptanceUpdateOne {
+	mutation := newPolicyAcceptanceMutation(c.config, OpUpdateOne, withPolicyAcceptanceID(id))
+	return &PolicyAcceptanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PolicyAcceptance.
+func (c *PolicyAcceptanceClient) Delete() *PolicyAcceptanceDelete {
+	mutation := newPolicyAcceptanceMutation(c.config, OpDelete)
+	return &PolicyAcceptanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PolicyAcceptanceClient) DeleteOne(_m *PolicyAcceptance) *PolicyAcceptanceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PolicyAcceptanceClient) DeleteOneID(id xid.ID) *PolicyAcceptanceDeleteOne {
+	builder := c.Delete().Where(policyacceptance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PolicyAcceptanceDeleteOne{builder}
+}
+
+// Query returns a query builder for PolicyAcceptance.
+func (c *PolicyAcceptanceClient) Query() *PolicyAcceptanceQuery {
+	return &PolicyAcceptanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePolicyAcceptance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PolicyAcceptance entity by its id.
+func (c *PolicyAcceptanceClient) Get(ctx context.Context, id xid.ID) (*PolicyAcceptance, error) {
+	return c.Query().Where(policyacceptance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PolicyAcceptanceClient) GetX(ctx context.Context, id xid.ID) *PolicyAcceptance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a PolicyAcceptance.
+func (c *PolicyAcceptanceClient) QueryAccount(_m *PolicyAcceptance) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyacceptance.Table, policyacceptance.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, policyacceptance.AccountTable, policyacceptance.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVersion queries the version edge of a PolicyAcceptance.
+func (c *PolicyAcceptanceClient) QueryVersion(_m *PolicyAcceptance) *PolicyVersionQuery {
+	query := (&PolicyVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyacceptance.Table, policyacceptance.FieldID, id),
+			sqlgraph.To(policyversion.Table, policyversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, policyacceptance.VersionTable, policyacceptance.VersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PolicyAcceptanceClient) Hooks() []Hook {
+	return c.hooks.PolicyAcceptance
+}
+
+// Interceptors returns the client interceptors.
+func (c *PolicyAcceptanceClient) Interceptors() []Interceptor {
+	return c.inters.PolicyAcceptance
+}
+
+func (c *PolicyAcceptanceClient) mutate(ctx context.Context, m *PolicyAcceptanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PolicyAcceptanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PolicyAcceptanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PolicyAcceptanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PolicyAcceptanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PolicyAcceptance mutation op: %q", m.Op())
+	}
+}
+
+// PolicyDocumentClient is a client for the PolicyDocument schema.
+type PolicyDocumentClient struct {
+	config
+}
+
+// NewPolicyDocumentClient returns a client for the PolicyDocument from the given config.
+func NewPolicyDocumentClient(c config) *PolicyDocumentClient {
+	return &PolicyDocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policydocument.Hooks(f(g(h())))`.
+func (c *PolicyDocumentClient) Use(hooks ...Hook) {
+	c.hooks.PolicyDocument = append(c.hooks.PolicyDocument, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `policydocument.Intercept(f(g(h())))`.
+func (c *PolicyDocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicyDocument = append(c.inters.PolicyDocument, interceptors...)
+}
+
+// Create returns a builder for creating a PolicyDocument entity.
+func (c *PolicyDocumentClient) Create() *PolicyDocumentCreate {
+	mutation := newPolicyDocumentMutation(c.config, OpCreate)
+	return &PolicyDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PolicyDocument entities.
+func (c *PolicyDocumentClient) CreateBulk(builders ...*PolicyDocumentCreate) *PolicyDocumentCreateBulk {
+	return &PolicyDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PolicyDocumentClient) MapCreateBulk(slice any, setFunc func(*PolicyDocumentCreate, int)) *PolicyDocumentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PolicyDocumentCreateBulk{err: fmt.Errorf("calling to PolicyDocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PolicyDocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PolicyDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PolicyDocument.
+func (c *PolicyDocumentClient) Update() *PolicyDocumentUpdate {
+	mutation := newPolicyDocumentMutation(c.config, OpUpdate)
+	return &PolicyDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PolicyDocumentClient) UpdateOne(_m *PolicyDocument) *PolicyDocumentUpdateOne {
+	mutation := newPolicyDocumentMutation(c.config, OpUpdateOne, withPolicyDocument(_m))
+	return &PolicyDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PolicyDocumentClient) UpdateOneID(id xid.ID) *PolicyDocumentUpdateOne {
+	mutation := newPolicyDocumentMutation(c.config, OpUpdateOne, withPolicyDocumentID(id))
+	return &PolicyDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PolicyDocument.
+func (c *PolicyDocumentClient) Delete() *PolicyDocumentDelete {
+	mutation := newPolicyDocumentMutation(c.config, OpDelete)
+	return &PolicyDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PolicyDocumentClient) DeleteOne(_m *PolicyDocument) *PolicyDocumentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PolicyDocumentClient) DeleteOneID(id xid.ID) *PolicyDocumentDeleteOne {
+	builder := c.Delete().Where(policydocument.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PolicyDocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for PolicyDocument.
+func (c *PolicyDocumentClient) Query() *PolicyDocumentQuery {
+	return &PolicyDocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePolicyDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PolicyDocument entity by its id.
+func (c *PolicyDocumentClient) Get(ctx context.Context, id xid.ID) (*PolicyDocument, error) {
+	return c.Query().Where(policydocument.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PolicyDocumentClient) GetX(ctx context.Context, id xid.ID) *PolicyDocument {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVersions queries the versions edge of a PolicyDocument.
+func (c *PolicyDocumentClient) QueryVersions(_m *PolicyDocument) *PolicyVersionQuery {
+	query := (&PolicyVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policydocument.Table, policydocument.FieldID, id),
+			sqlgraph.To(policyversion.Table, policyversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, policydocument.VersionsTable, policydocument.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PolicyDocumentClient) Hooks() []Hook {
+	return c.hooks.PolicyDocument
+}
+
+// Interceptors returns the client interceptors.
+func (c *PolicyDocumentClient) Interceptors() []Interceptor {
+	return c.inters.PolicyDocument
+}
+
+func (c *PolicyDocumentClient) mutate(ctx context.Context, m *PolicyDocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PolicyDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PolicyDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PolicyDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PolicyDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PolicyDocument mutation op: %q", m.Op())
+	}
+}
+
+// PolicyVersionClient is a client for the PolicyVersion schema.
+type PolicyVersionClient struct {
+	config
+}
+
+// NewPolicyVersionClient returns a client for the PolicyVersion from the given config.
+func NewPolicyVersionClient(c config) *PolicyVersionClient {
+	return &PolicyVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policyversion.Hooks(f(g(h())))`.
+func (c *PolicyVersionClient) Use(hooks ...Hook) {
+	c.hooks.PolicyVersion = append(c.hooks.PolicyVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `policyversion.Intercept(f(g(h())))`.
+func (c *PolicyVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicyVersion = append(c.inters.PolicyVersion, interceptors...)
+}
+
+// Create returns a builder for creating a PolicyVersion entity.
+func (c *PolicyVersionClient) Create() *PolicyVersionCreate {
+	mutation := newPolicyVersionMutation(c.config, OpCreate)
+	return &PolicyVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PolicyVersion entities.
+func (c *PolicyVersionClient) CreateBulk(builders ...*PolicyVersionCreate) *PolicyVersionCreateBulk {
+	return &PolicyVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PolicyVersionClient) MapCreateBulk(slice any, setFunc func(*PolicyVersionCreate, int)) *PolicyVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PolicyVersionCreateBulk{err: fmt.Errorf("calling to PolicyVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PolicyVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PolicyVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PolicyVersion.
+func (c *PolicyVersionClient) Update() *PolicyVersionUpdate {
+	mutation := newPolicyVersionMutation(c.config, OpUpdate)
+	return &PolicyVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PolicyVersionClient) UpdateOne(_m *PolicyVersion) *PolicyVersionUpdateOne {
+	mutation := newPolicyVersionMutation(c.config, OpUpdateOne, withPolicyVersion(_m))
+	return &PolicyVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PolicyVersionClient) UpdateOneID(id xid.ID) *PolicyVersionUpdateOne {
+	mutation := newPolicyVersionMutation(c.config, OpUpdateOne, withPolicyVersionID(id))
+	return &PolicyVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for AccountFollow.
-func (c *AccountFollowClient) Delete() *AccountFollowDelete {
-	mutation := newAccountFollowMutation(c.config, OpDelete)
-	return &AccountFollowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PolicyVersion.
+func (c *PolicyVersionClient) Delete() *PolicyVersionDelete {
+	mutation := newPolicyVersionMutation(c.config, OpDelete)
+	return &PolicyVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *AccountFollowClient) DeleteOne(_m *AccountFollow) *AccountFollowDeleteOne {
+func (c *PolicyVersionClient) DeleteOne(_m *PolicyVersion) *PolicyVersionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AccountFollowClient) DeleteOneID(id xid.ID) *AccountFollowDeleteOne {
-	builder := c.Delete().Where(accountfollow.ID(id))
+func (c *PolicyVersionClient) DeleteOneID(id xid.ID) *PolicyVersionDeleteOne {
+	builder := c.Delete().Where(policyversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &AccountFollowDeleteOne{builder}
+	return &PolicyVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for AccountFollow.
-func (c *AccountFollowClient) Query() *AccountFollowQuery {
-	return &AccountFollowQuery{
+// Query returns a query builder for PolicyVersion.
+func (c *PolicyVersionClient) Query() *PolicyVersionQuery {
+	return &PolicyVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAccountFollow},
+		ctx:    &QueryContext{Type: TypePolicyVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a AccountFollow entity by its id.
-func (c *AccountFollowClient) Get(ctx context.Context, id xid.ID) (*AccountFollow, error) {
-	return c.Query().Where(accountfollow.ID(id)).Only(ctx)
+// Get returns a PolicyVersion entity by its id.
+func (c *PolicyVersionClient) Get(ctx context.Context, id xid.ID) (*PolicyVersion, error) {
+	return c.Query().Where(policyversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *AccountFollowClient) GetX(ctx context.Context, id xid.ID) *AccountFollow {
+func (c *PolicyVersionClient) GetX(ctx context.Context, id xid.ID) *PolicyVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1075,15 +10815,15 @@ func (c *AccountFollowClient) GetX(ctx context.Context, id xid.ID) *AccountFollo
 	return obj
 }
 
-// QueryFollower queries the follower edge of a AccountFollow.
-func (c *AccountFollowClient) QueryFollower(_m *AccountFollow) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
+// QueryDocument queries the document edge of a PolicyVersion.
+func (c *PolicyVersionClient) QueryDocument(_m *PolicyVersion) *PolicyDocumentQuery {
+	query := (&PolicyDocumentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(accountfollow.Table, accountfollow.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, accountfollow.FollowerTable, accountfollow.FollowerColumn),
+			sqlgraph.From(policyversion.Table, policyversion.FieldID, id),
+			sqlgraph.To(policydocument.Table, policydocument.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, policyversion.DocumentTable, policyversion.DocumentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1091,15 +10831,15 @@ func (c *AccountFollowClient) QueryFollower(_m *AccountFollow) *AccountQuery {
 	return query
 }
 
-// QueryFollowing queries the following edge of a AccountFollow.
-func (c *AccountFollowClient) QueryFollowing(_m *AccountFollow) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
+// QueryAcceptances queries the acceptances edge of a PolicyVersion.
+func (c *PolicyVersionClient) QueryAcceptances(_m *PolicyVersion) *PolicyAcceptanceQuery {
+	query := (&PolicyAcceptanceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(accountfollow.Table, accountfollow.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, accountfollow.FollowingTable, accountfollow.FollowingColumn),
+			sqlgraph.From(policyversion.Table, policyversion.FieldID, id),
+			sqlgraph.To(policyacceptance.Table, policyacceptance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, policyversion.AcceptancesTable, policyversion.AcceptancesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1108,131 +10848,131 @@ func (c *AccountFollowClient) QueryFollowing(_m *AccountFollow) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *AccountFollowClient) Hooks() []Hook {
-	return c.hooks.AccountFollow
+func (c *PolicyVersionClient) Hooks() []Hook {
+	return c.hooks.PolicyVersion
 }
 
 // Interceptors returns the client interceptors.
-func (c *AccountFollowClient) Interceptors() []Interceptor {
-	return c.inters.AccountFollow
+func (c *PolicyVersionClient) Interceptors() []Interceptor {
+	return c.inters.PolicyVersion
 }
 
-func (c *AccountFollowClient) mutate(ctx context.Context, m *AccountFollowMutation) (Value, error) {
+func (c *PolicyVersionClient) mutate(ctx context.Context, m *PolicyVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&AccountFollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PolicyVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&AccountFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PolicyVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&AccountFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PolicyVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&AccountFollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PolicyVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown AccountFollow mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PolicyVersion mutation op: %q", m.Op())
 	}
 }
 
-// AccountRolesClient is a client for the AccountRoles schema.
-type AccountRolesClient struct {
+// PostClient is a client for the Post schema.
+type PostClient struct {
 	config
 }
 
-// NewAccountRolesClient returns a client for the AccountRoles from the given config.
-func NewAccountRolesClient(c config) *AccountRolesClient {
-	return &AccountRolesClient{config: c}
+// NewPostClient returns a client for the Post from the given config.
+func NewPostClient(c config) *PostClient {
+	return &PostClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `accountroles.Hooks(f(g(h())))`.
-func (c *AccountRolesClient) Use(hooks ...Hook) {
-	c.hooks.AccountRoles = append(c.hooks.AccountRoles, hooks...)
+// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
+func (c *PostClient) Use(hooks ...Hook) {
+	c.hooks.Post = append(c.hooks.Post, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `accountroles.Intercept(f(g(h())))`.
-func (c *AccountRolesClient) Intercept(interceptors ...Interceptor) {
-	c.inters.AccountRoles = append(c.inters.AccountRoles, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
 }
 
-// Create returns a builder for creating a AccountRoles entity.
-func (c *AccountRolesClient) Create() *AccountRolesCreate {
-	mutation := newAccountRolesMutation(c.config, OpCreate)
-	return &AccountRolesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Post entity.
+func (c *PostClient) Create() *PostCreate {
+	mutation := newPostMutation(c.config, OpCreate)
+	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of AccountRoles entities.
-func (c *AccountRolesClient) CreateBulk(builders ...*AccountRolesCreate) *AccountRolesCreateBulk {
-	return &AccountRolesCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Post entities.
+func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
+	return &PostCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *AccountRolesClient) MapCreateBulk(slice any, setFunc func(*AccountRolesCreate, int)) *AccountRolesCreateBulk {
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &AccountRolesCreateBulk{err: fmt.Errorf("calling to AccountRolesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*AccountRolesCreate, rv.Len())
+	builders := make([]*PostCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &AccountRolesCreateBulk{config: c.config, builders: builders}
+	return &PostCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for AccountRoles.
-func (c *AccountRolesClient) Update() *AccountRolesUpdate {
-	mutation := newAccountRolesMutation(c.config, OpUpdate)
-	return &AccountRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Post.
+func (c *PostClient) Update() *PostUpdate {
+	mutation := newPostMutation(c.config, OpUpdate)
+	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *AccountRolesClient) UpdateOne(_m *AccountRoles) *AccountRolesUpdateOne {
-	mutation := newAccountRolesMutation(c.config, OpUpdateOne, withAccountRoles(_m))
-	return &AccountRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PostClient) UpdateOne(_m *Post) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPost(_m))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *AccountRolesClient) UpdateOneID(id xid.ID) *AccountRolesUpdateOne {
-	mutation := newAccountRolesMutation(c.config, OpUpdateOne, withAccountRolesID(id))
-	return &AccountRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PostClient) UpdateOneID(id xid.ID) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for AccountRoles.
-func (c *AccountRolesClient) Delete() *AccountRolesDelete {
-	mutation := newAccountRolesMutation(c.config, OpDelete)
-	return &AccountRolesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Post.
+func (c *PostClient) Delete() *PostDelete {
+	mutation := newPostMutation(c.config, OpDelete)
+	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *AccountRolesClient) DeleteOne(_m *AccountRoles) *AccountRolesDeleteOne {
+func (c *PostClient) DeleteOne(_m *Post) *PostDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AccountRolesClient) DeleteOneID(id xid.ID) *AccountRolesDeleteOne {
-	builder := c.Delete().Where(accountroles.ID(id))
+func (c *PostClient) DeleteOneID(id xid.ID) *PostDeleteOne {
+	builder := c.Delete().Where(post.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &AccountRolesDeleteOne{builder}
+	return &PostDeleteOne{builder}
 }
 
-// Query returns a query builder for AccountRoles.
-func (c *AccountRolesClient) Query() *AccountRolesQuery {
-	return &AccountRolesQuery{
+// Query returns a query builder for Post.
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAccountRoles},
+		ctx:    &QueryContext{Type: TypePost},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a AccountRoles entity by its id.
-func (c *AccountRolesClient) Get(ctx context.Context, id xid.ID) (*AccountRoles, error) {
-	return c.Query().Where(accountroles.ID(id)).Only(ctx)
+// Get returns a Post entity by its id.
+func (c *PostClient) Get(ctx context.Context, id xid.ID) (*Post, error) {
+	return c.Query().Where(post.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *AccountRolesClient) GetX(ctx context.Context, id xid.ID) *AccountRoles {
+func (c *PostClient) GetX(ctx context.Context, id xid.ID) *Post {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1240,15 +10980,143 @@ func (c *AccountRolesClient) GetX(ctx context.Context, id xid.ID) *AccountRoles
 	return obj
 }
 
-// QueryAccount queries the account edge of a AccountRoles.
-func (c *AccountRolesClient) QueryAccount(_m *AccountRoles) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
+// QueryAuthor queries the author edge of a Post.
+func (c *PostClient) QueryAuthor(_m *Post) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.AuthorTable, post.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategory queries the category edge of a Post.
+func (c *PostClient) QueryCategory(_m *Post) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.CategoryTable, post.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySpace queries the space edge of a Post.
+func (c *PostClient) QuerySpace(_m *Post) *SpaceQuery {
+	query := (&SpaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(space.Table, space.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.SpaceTable, post.SpaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Post.
+func (c *PostClient) QueryTags(_m *Post) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, post.TagsTable, post.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoot queries the root edge of a Post.
+func (c *PostClient) QueryRoot(_m *Post) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.RootTable, post.RootColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPosts queries the posts edge of a Post.
+func (c *PostClient) QueryPosts(_m *Post) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.PostsTable, post.PostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplyTo queries the replyTo edge of a Post.
+func (c *PostClient) QueryReplyTo(_m *Post) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.ReplyToTable, post.ReplyToColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a Post.
+func (c *PostClient) QueryReplies(_m *Post) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.RepliesTable, post.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReacts queries the reacts edge of a Post.
+func (c *PostClient) QueryReacts(_m *Post) *ReactQuery {
+	query := (&ReactClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(accountroles.Table, accountroles.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, accountroles.AccountTable, accountroles.AccountColumn),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(react.Table, react.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.ReactsTable, post.ReactsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1256,15 +11124,15 @@ func (c *AccountRolesClient) QueryAccount(_m *AccountRoles) *AccountQuery {
 	return query
 }
 
-// QueryRole queries the role edge of a AccountRoles.
-func (c *AccountRolesClient) QueryRole(_m *AccountRoles) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
+// QueryLikes queries the likes edge of a Post.
+func (c *PostClient) QueryLikes(_m *Post) *LikePostQuery {
+	query := (&LikePostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(accountroles.Table, accountroles.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, accountroles.RoleTable, accountroles.RoleColumn),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(likepost.Table, likepost.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.LikesTable, post.LikesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1272,148 +11140,47 @@ func (c *AccountRolesClient) QueryRole(_m *AccountRoles) *RoleQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *AccountRolesClient) Hooks() []Hook {
-	return c.hooks.AccountRoles
-}
-
-// Interceptors returns the client interceptors.
-func (c *AccountRolesClient) Interceptors() []Interceptor {
-	return c.inters.AccountRoles
-}
-
-func (c *AccountRolesClient) mutate(ctx context.Context, m *AccountRolesMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&AccountRolesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&AccountRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&AccountRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&AccountRolesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown AccountRoles mutation op: %q", m.Op())
-	}
-}
-
-// AssetClient is a client for the Asset schema.
-type AssetClient struct {
-	config
-}
-
-// NewAssetClient returns a client for the Asset from the given config.
-func NewAssetClient(c config) *AssetClient {
-	return &AssetClient{config: c}
-}
-
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `asset.Hooks(f(g(h())))`.
-func (c *AssetClient) Use(hooks ...Hook) {
-	c.hooks.Asset = append(c.hooks.Asset, hooks...)
-}
-
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `asset.Intercept(f(g(h())))`.
-func (c *AssetClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Asset = append(c.inters.Asset, interceptors...)
-}
-
-// Create returns a builder for creating a Asset entity.
-func (c *AssetClient) Create() *AssetCreate {
-	mutation := newAssetMutation(c.config, OpCreate)
-	return &AssetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// CreateBulk returns a builder for creating a bulk of Asset entities.
-func (c *AssetClient) CreateBulk(builders ...*AssetCreate) *AssetCreateBulk {
-	return &AssetCreateBulk{config: c.config, builders: builders}
-}
-
-// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
-// a builder and applies setFunc on it.
-func (c *AssetClient) MapCreateBulk(slice any, setFunc func(*AssetCreate, int)) *AssetCreateBulk {
-	rv := reflect.ValueOf(slice)
-	if rv.Kind() != reflect.Slice {
-		return &AssetCreateBulk{err: fmt.Errorf("calling to AssetClient.MapCreateBulk with wrong type %T, need slice", slice)}
-	}
-	builders := make([]*AssetCreate, rv.Len())
-	for i := 0; i < rv.Len(); i++ {
-		builders[i] = c.Create()
-		setFunc(builders[i], i)
-	}
-	return &AssetCreateBulk{config: c.config, builders: builders}
-}
-
-// Update returns an update builder for Asset.
-func (c *AssetClient) Update() *AssetUpdate {
-	mutation := newAssetMutation(c.config, OpUpdate)
-	return &AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOne returns an update builder for the given entity.
-func (c *AssetClient) UpdateOne(_m *Asset) *AssetUpdateOne {
-	mutation := newAssetMutation(c.config, OpUpdateOne, withAsset(_m))
-	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *AssetClient) UpdateOneID(id xid.ID) *AssetUpdateOne {
-	mutation := newAssetMutation(c.config, OpUpdateOne, withAssetID(id))
-	return &AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for Asset.
-func (c *AssetClient) Delete() *AssetDelete {
-	mutation := newAssetMutation(c.config, OpDelete)
-	return &AssetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// DeleteOne returns a builder for deleting the given entity.
-func (c *AssetClient) DeleteOne(_m *Asset) *AssetDeleteOne {
-	return c.DeleteOneID(_m.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AssetClient) DeleteOneID(id xid.ID) *AssetDeleteOne {
-	builder := c.Delete().Where(asset.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &AssetDeleteOne{builder}
-}
-
-// Query returns a query builder for Asset.
-func (c *AssetClient) Query() *AssetQuery {
-	return &AssetQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeAsset},
-		inters: c.Interceptors(),
+// QueryMentions queries the mentions edge of a Post.
+func (c *PostClient) QueryMentions(_m *Post) *MentionProfileQuery {
+	query := (&MentionProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(mentionprofile.Table, mentionprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.MentionsTable, post.MentionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// Get returns a Asset entity by its id.
-func (c *AssetClient) Get(ctx context.Context, id xid.ID) (*Asset, error) {
-	return c.Query().Where(asset.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *AssetClient) GetX(ctx context.Context, id xid.ID) *Asset {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// QueryAssets queries the assets edge of a Post.
+func (c *PostClient) QueryAssets(_m *Post) *AssetQuery {
+	query := (&AssetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, post.AssetsTable, post.AssetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
-// QueryPosts queries the posts edge of a Asset.
-func (c *AssetClient) QueryPosts(_m *Asset) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
+// QueryCollections queries the collections edge of a Post.
+func (c *PostClient) QueryCollections(_m *Post) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, asset.PostsTable, asset.PostsPrimaryKey...),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, post.CollectionsTable, post.CollectionsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1421,15 +11188,15 @@ func (c *AssetClient) QueryPosts(_m *Asset) *PostQuery {
 	return query
 }
 
-// QueryNodes queries the nodes edge of a Asset.
-func (c *AssetClient) QueryNodes(_m *Asset) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
+// QueryLink queries the link edge of a Post.
+func (c *PostClient) QueryLink(_m *Post) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, asset.NodesTable, asset.NodesPrimaryKey...),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.LinkTable, post.LinkColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1437,15 +11204,15 @@ func (c *AssetClient) QueryNodes(_m *Asset) *NodeQuery {
 	return query
 }
 
-// QueryLinks queries the links edge of a Asset.
-func (c *AssetClient) QueryLinks(_m *Asset) *LinkQuery {
+// QueryContentLinks queries the content_links edge of a Post.
+func (c *PostClient) QueryContentLinks(_m *Post) *LinkQuery {
 	query := (&LinkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
+			sqlgraph.From(post.Table, post.FieldID, id),
 			sqlgraph.To(link.Table, link.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, asset.LinksTable, asset.LinksPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2M, true, post.ContentLinksTable, post.ContentLinksPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1453,15 +11220,15 @@ func (c *AssetClient) QueryLinks(_m *Asset) *LinkQuery {
 	return query
 }
 
-// QueryOwner queries the owner edge of a Asset.
-func (c *AssetClient) QueryOwner(_m *Asset) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
+// QueryEvent queries the event edge of a Post.
+func (c *PostClient) QueryEvent(_m *Post) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, asset.OwnerTable, asset.OwnerColumn),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.EventTable, post.EventColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1469,15 +11236,15 @@ func (c *AssetClient) QueryOwner(_m *Asset) *AccountQuery {
 	return query
 }
 
-// QueryParent queries the parent edge of a Asset.
-func (c *AssetClient) QueryParent(_m *Asset) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
+// QueryPostReads queries the post_reads edge of a Post.
+func (c *PostClient) QueryPostReads(_m *Post) *PostReadQuery {
+	query := (&PostReadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, asset.ParentTable, asset.ParentColumn),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(postread.Table, postread.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.PostReadsTable, post.PostReadsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1485,15 +11252,15 @@ func (c *AssetClient) QueryParent(_m *Asset) *AssetQuery {
 	return query
 }
 
-// QueryAssets queries the assets edge of a Asset.
-func (c *AssetClient) QueryAssets(_m *Asset) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
+// QueryDiscordLinks queries the discord_links edge of a Post.
+func (c *PostClient) QueryDiscordLinks(_m *Post) *DiscordBridgeLinkQuery {
+	query := (&DiscordBridgeLinkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, asset.AssetsTable, asset.AssetsColumn),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(discordbridgelink.Table, discordbridgelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.DiscordLinksTable, post.DiscordLinksColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1501,15 +11268,31 @@ func (c *AssetClient) QueryAssets(_m *Asset) *AssetQuery {
 	return query
 }
 
-// QueryEvent queries the event edge of a Asset.
-func (c *AssetClient) QueryEvent(_m *Asset) *EventQuery {
-	query := (&EventClient{config: c.config}).Query()
+// QueryMatrixLinks queries the matrix_links edge of a Post.
+func (c *PostClient) QueryMatrixLinks(_m *Post) *MatrixBridgeLinkQuery {
+	query := (&MatrixBridgeLinkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(asset.Table, asset.FieldID, id),
-			sqlgraph.To(event.Table, event.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, asset.EventTable, asset.EventColumn),
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(matrixbridgelink.Table, matrixbridgelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.MatrixLinksTable, post.MatrixLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTelegramMessages queries the telegram_messages edge of a Post.
+func (c *PostClient) QueryTelegramMessages(_m *Post) *TelegramMessageQuery {
+	query := (&TelegramMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(telegrammessage.Table, telegrammessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.TelegramMessagesTable, post.TelegramMessagesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1518,131 +11301,131 @@ func (c *AssetClient) QueryEvent(_m *Asset) *EventQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *AssetClient) Hooks() []Hook {
-	return c.hooks.Asset
+func (c *PostClient) Hooks() []Hook {
+	return c.hooks.Post
 }
 
 // Interceptors returns the client interceptors.
-func (c *AssetClient) Interceptors() []Interceptor {
-	return c.inters.Asset
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
 }
 
-func (c *AssetClient) mutate(ctx context.Context, m *AssetMutation) (Value, error) {
+func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&AssetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&AssetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&AssetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&AssetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Asset mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
 	}
 }
 
-// AuthenticationClient is a client for the Authentication schema.
-type AuthenticationClient struct {
+// PostReadClient is a client for the PostRead schema.
+type PostReadClient struct {
 	config
 }
 
-// NewAuthenticationClient returns a client for the Authentication from the given config.
-func NewAuthenticationClient(c config) *AuthenticationClient {
-	return &AuthenticationClient{config: c}
+// NewPostReadClient returns a client for the PostRead from the given config.
+func NewPostReadClient(c config) *PostReadClient {
+	return &PostReadClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `authentication.Hooks(f(g(h())))`.
-func (c *AuthenticationClient) Use(hooks ...Hook) {
-	c.hooks.Authentication = append(c.hooks.Authentication, hooks...)
+// A call to `Use(f, g, h)` equals to `postread.Hooks(f(g(h())))`.
+func (c *PostReadClient) Use(hooks ...Hook) {
+	c.hooks.PostRead = append(c.hooks.PostRead, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `authentication.Intercept(f(g(h())))`.
-func (c *AuthenticationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Authentication = append(c.inters.Authentication, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `postread.Intercept(f(g(h())))`.
+func (c *PostReadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostRead = append(c.inters.PostRead, interceptors...)
 }
 
-// Create returns a builder for creating a Authentication entity.
-func (c *AuthenticationClient) Create() *AuthenticationCreate {
-	mutation := newAuthenticationMutation(c.config, OpCreate)
-	return &AuthenticationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PostRead entity.
+func (c *PostReadClient) Create() *PostReadCreate {
+	mutation := newPostReadMutation(c.config, OpCreate)
+	return &PostReadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Authentication entities.
-func (c *AuthenticationClient) CreateBulk(builders ...*AuthenticationCreate) *AuthenticationCreateBulk {
-	return &AuthenticationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PostRead entities.
+func (c *PostReadClient) CreateBulk(builders ...*PostReadCreate) *PostReadCreateBulk {
+	return &PostReadCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *AuthenticationClient) MapCreateBulk(slice any, setFunc func(*AuthenticationCreate, int)) *AuthenticationCreateBulk {
+func (c *PostReadClient) MapCreateBulk(slice any, setFunc func(*PostReadCreate, int)) *PostReadCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &AuthenticationCreateBulk{err: fmt.Errorf("calling to AuthenticationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PostReadCreateBulk{err: fmt.Errorf("calling to PostReadClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*AuthenticationCreate, rv.Len())
+	builders := make([]*PostReadCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &AuthenticationCreateBulk{config: c.config, builders: builders}
+	return &PostReadCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Authentication.
-func (c *AuthenticationClient) Update() *AuthenticationUpdate {
-	mutation := newAuthenticationMutation(c.config, OpUpdate)
-	return &AuthenticationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PostRead.
+func (c *PostReadClient) Update() *PostReadUpdate {
+	mutation := newPostReadMutation(c.config, OpUpdate)
+	return &PostReadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *AuthenticationClient) UpdateOne(_m *Authentication) *AuthenticationUpdateOne {
-	mutation := newAuthenticationMutation(c.config, OpUpdateOne, withAuthentication(_m))
-	return &AuthenticationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PostReadClient) UpdateOne(_m *PostRead) *PostReadUpdateOne {
+	mutation := newPostReadMutation(c.config, OpUpdateOne, withPostRead(_m))
+	return &PostReadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *AuthenticationClient) UpdateOneID(id xid.ID) *AuthenticationUpdateOne {
-	mutation := newAuthenticationMutation(c.config, OpUpdateOne, withAuthenticationID(id))
-	return &AuthenticationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PostReadClient) UpdateOneID(id xid.ID) *PostReadUpdateOne {
+	mutation := newPostReadMutation(c.config, OpUpdateOne, withPostReadID(id))
+	return &PostReadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Authentication.
-func (c *AuthenticationClient) Delete() *AuthenticationDelete {
-	mutation := newAuthenticationMutation(c.config, OpDelete)
-	return &AuthenticationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PostRead.
+func (c *PostReadClient) Delete() *PostReadDelete {
+	mutation := newPostReadMutation(c.config, OpDelete)
+	return &PostReadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *AuthenticationClient) DeleteOne(_m *Authentication) *AuthenticationDeleteOne {
+func (c *PostReadClient) DeleteOne(_m *PostRead) *PostReadDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AuthenticationClient) DeleteOneID(id xid.ID) *AuthenticationDeleteOne {
-	builder := c.Delete().Where(authentication.ID(id))
+func (c *PostReadClient) DeleteOneID(id xid.ID) *PostReadDeleteOne {
+	builder := c.Delete().Where(postread.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &AuthenticationDeleteOne{builder}
+	return &PostReadDeleteOne{builder}
 }
 
-// Query returns a query builder for Authentication.
-func (c *AuthenticationClient) Query() *AuthenticationQuery {
-	return &AuthenticationQuery{
+// Query returns a query builder for PostRead.
+func (c *PostReadClient) Query() *PostReadQuery {
+	return &PostReadQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAuthentication},
+		ctx:    &QueryContext{Type: TypePostRead},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Authentication entity by its id.
-func (c *AuthenticationClient) Get(ctx context.Context, id xid.ID) (*Authentication, error) {
-	return c.Query().Where(authentication.ID(id)).Only(ctx)
+// Get returns a PostRead entity by its id.
+func (c *PostReadClient) Get(ctx context.Context, id xid.ID) (*PostRead, error) {
+	return c.Query().Where(postread.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *AuthenticationClient) GetX(ctx context.Context, id xid.ID) *Authentication {
+func (c *PostReadClient) GetX(ctx context.Context, id xid.ID) *PostRead {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1650,15 +11433,31 @@ func (c *AuthenticationClient) GetX(ctx context.Context, id xid.ID) *Authenticat
 	return obj
 }
 
-// QueryAccount queries the account edge of a Authentication.
-func (c *AuthenticationClient) QueryAccount(_m *Authentication) *AccountQuery {
+// QueryRootPost queries the root_post edge of a PostRead.
+func (c *PostReadClient) QueryRootPost(_m *PostRead) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postread.Table, postread.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, postread.RootPostTable, postread.RootPostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccount queries the account edge of a PostRead.
+func (c *PostReadClient) QueryAccount(_m *PostRead) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(authentication.Table, authentication.FieldID, id),
+			sqlgraph.From(postread.Table, postread.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, authentication.AccountTable, authentication.AccountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, postread.AccountTable, postread.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1667,131 +11466,131 @@ func (c *AuthenticationClient) QueryAccount(_m *Authentication) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *AuthenticationClient) Hooks() []Hook {
-	return c.hooks.Authentication
+func (c *PostReadClient) Hooks() []Hook {
+	return c.hooks.PostRead
 }
 
 // Interceptors returns the client interceptors.
-func (c *AuthenticationClient) Interceptors() []Interceptor {
-	return c.inters.Authentication
+func (c *PostReadClient) Interceptors() []Interceptor {
+	return c.inters.PostRead
 }
 
-func (c *AuthenticationClient) mutate(ctx context.Context, m *AuthenticationMutation) (Value, error) {
+func (c *PostReadClient) mutate(ctx context.Context, m *PostReadMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&AuthenticationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostReadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&AuthenticationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostReadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&AuthenticationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostReadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&AuthenticationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PostReadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Authentication mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PostRead mutation op: %q", m.Op())
 	}
 }
 
-// CategoryClient is a client for the Category schema.
-type CategoryClient struct {
+// PropertyClient is a client for the Property schema.
+type PropertyClient struct {
 	config
 }
 
-// NewCategoryClient returns a client for the Category from the given config.
-func NewCategoryClient(c config) *CategoryClient {
-	return &CategoryClient{config: c}
+// NewPropertyClient returns a client for the Property from the given config.
+func NewPropertyClient(c config) *PropertyClient {
+	return &PropertyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `category.Hooks(f(g(h())))`.
-func (c *CategoryClient) Use(hooks ...Hook) {
-	c.hooks.Category = append(c.hooks.Category, hooks...)
+// A call to `Use(f, g, h)` equals to `property.Hooks(f(g(h())))`.
+func (c *PropertyClient) Use(hooks ...Hook) {
+	c.hooks.Property = append(c.hooks.Property, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `category.Intercept(f(g(h())))`.
-func (c *CategoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Category = append(c.inters.Category, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `property.Intercept(f(g(h())))`.
+func (c *PropertyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Property = append(c.inters.Property, interceptors...)
 }
 
-// Create returns a builder for creating a Category entity.
-func (c *CategoryClient) Create() *CategoryCreate {
-	mutation := newCategoryMutation(c.config, OpCreate)
-	return &CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Property entity.
+func (c *PropertyClient) Create() *PropertyCreate {
+	mutation := newPropertyMutation(c.config, OpCreate)
+	return &PropertyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Category entities.
-func (c *CategoryClient) CreateBulk(builders ...*CategoryCreate) *CategoryCreateBulk {
-	return &CategoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Property entities.
+func (c *PropertyClient) CreateBulk(builders ...*PropertyCreate) *PropertyCreateBulk {
+	return &PropertyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CategoryClient) MapCreateBulk(slice any, setFunc func(*CategoryCreate, int)) *CategoryCreateBulk {
+func (c *PropertyClient) MapCreateBulk(slice any, setFunc func(*PropertyCreate, int)) *PropertyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CategoryCreateBulk{err: fmt.Errorf("calling to CategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PropertyCreateBulk{err: fmt.Errorf("calling to PropertyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CategoryCreate, rv.Len())
+	builders := make([]*PropertyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CategoryCreateBulk{config: c.config, builders: builders}
+	return &PropertyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Category.
-func (c *CategoryClient) Update() *CategoryUpdate {
-	mutation := newCategoryMutation(c.config, OpUpdate)
-	return &CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Property.
+func (c *PropertyClient) Update() *PropertyUpdate {
+	mutation := newPropertyMutation(c.config, OpUpdate)
+	return &PropertyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CategoryClient) UpdateOne(_m *Category) *CategoryUpdateOne {
-	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategory(_m))
-	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PropertyClient) UpdateOne(_m *Property) *PropertyUpdateOne {
+	mutation := newPropertyMutation(c.config, OpUpdateOne, withProperty(_m))
+	return &PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CategoryClient) UpdateOneID(id xid.ID) *CategoryUpdateOne {
-	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategoryID(id))
-	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PropertyClient) UpdateOneID(id xid.ID) *PropertyUpdateOne {
+	mutation := newPropertyMutation(c.config, OpUpdateOne, withPropertyID(id))
+	return &PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Category.
-func (c *CategoryClient) Delete() *CategoryDelete {
-	mutation := newCategoryMutation(c.config, OpDelete)
-	return &CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Property.
+func (c *PropertyClient) Delete() *PropertyDelete {
+	mutation := newPropertyMutation(c.config, OpDelete)
+	return &PropertyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CategoryClient) DeleteOne(_m *Category) *CategoryDeleteOne {
+func (c *PropertyClient) DeleteOne(_m *Property) *PropertyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CategoryClient) DeleteOneID(id xid.ID) *CategoryDeleteOne {
-	builder := c.Delete().Where(category.ID(id))
+func (c *PropertyClient) DeleteOneID(id xid.ID) *PropertyDeleteOne {
+	builder := c.Delete().Where(property.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CategoryDeleteOne{builder}
+	return &PropertyDeleteOne{builder}
 }
 
-// Query returns a query builder for Category.
-func (c *CategoryClient) Query() *CategoryQuery {
-	return &CategoryQuery{
+// Query returns a query builder for Property.
+func (c *PropertyClient) Query() *PropertyQuery {
+	return &PropertyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCategory},
+		ctx:    &QueryContext{Type: TypeProperty},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Category entity by its id.
-func (c *CategoryClient) Get(ctx context.Context, id xid.ID) (*Category, error) {
-	return c.Query().Where(category.ID(id)).Only(ctx)
-}
-
+// Get returns a Property entity by its id.
+func (c *PropertyClient) Get(ctx context.Context, id xid.ID) (*Property, error) {
+	return c.Query().Where(property.ID(id)).Only(ctx)
+}
+
 // GetX is like Get, but panics if an error occurs.
-func (c *CategoryClient) GetX(ctx context.Context, id xid.ID) *Category {
+func (c *PropertyClient) GetX(ctx context.Context, id xid.ID) *Property {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1799,47 +11598,15 @@ func (c *CategoryClient) GetX(ctx context.Context, id xid.ID) *Category {
 	return obj
 }
 
-// QueryPosts queries the posts edge of a Category.
-func (c *CategoryClient) QueryPosts(_m *Category) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(category.Table, category.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, category.PostsTable, category.PostsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryParent queries the parent edge of a Category.
-func (c *CategoryClient) QueryParent(_m *Category) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(category.Table, category.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, category.ParentTable, category.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a Category.
-func (c *CategoryClient) QueryChildren(_m *Category) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
+// QueryNode queries the node edge of a Property.
+func (c *PropertyClient) QueryNode(_m *Property) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(category.Table, category.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, category.ChildrenTable, category.ChildrenColumn),
+			sqlgraph.From(property.Table, property.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, property.NodeTable, property.NodeColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1847,15 +11614,15 @@ func (c *CategoryClient) QueryChildren(_m *Category) *CategoryQuery {
 	return query
 }
 
-// QueryCoverImage queries the cover_image edge of a Category.
-func (c *CategoryClient) QueryCoverImage(_m *Category) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
+// QuerySchema queries the schema edge of a Property.
+func (c *PropertyClient) QuerySchema(_m *Property) *PropertySchemaFieldQuery {
+	query := (&PropertySchemaFieldClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(category.Table, category.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, category.CoverImageTable, category.CoverImageColumn),
+			sqlgraph.From(property.Table, property.FieldID, id),
+			sqlgraph.To(propertyschemafield.Table, propertyschemafield.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, property.SchemaTable, property.SchemaColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1864,131 +11631,131 @@ func (c *CategoryClient) QueryCoverImage(_m *Category) *AssetQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *CategoryClient) Hooks() []Hook {
-	return c.hooks.Category
+func (c *PropertyClient) Hooks() []Hook {
+	return c.hooks.Property
 }
 
 // Interceptors returns the client interceptors.
-func (c *CategoryClient) Interceptors() []Interceptor {
-	return c.inters.Category
+func (c *PropertyClient) Interceptors() []Interceptor {
+	return c.inters.Property
 }
 
-func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value, error) {
+func (c *PropertyClient) mutate(ctx context.Context, m *PropertyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PropertyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Category mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Property mutation op: %q", m.Op())
 	}
 }
 
-// CollectionClient is a client for the Collection schema.
-type CollectionClient struct {
+// PropertySchemaClient is a client for the PropertySchema schema.
+type PropertySchemaClient struct {
 	config
 }
 
-// NewCollectionClient returns a client for the Collection from the given config.
-func NewCollectionClient(c config) *CollectionClient {
-	return &CollectionClient{config: c}
+// NewPropertySchemaClient returns a client for the PropertySchema from the given config.
+func NewPropertySchemaClient(c config) *PropertySchemaClient {
+	return &PropertySchemaClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `collection.Hooks(f(g(h())))`.
-func (c *CollectionClient) Use(hooks ...Hook) {
-	c.hooks.Collection = append(c.hooks.Collection, hooks...)
+// A call to `Use(f, g, h)` equals to `propertyschema.Hooks(f(g(h())))`.
+func (c *PropertySchemaClient) Use(hooks ...Hook) {
+	c.hooks.PropertySchema = append(c.hooks.PropertySchema, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `collection.Intercept(f(g(h())))`.
-func (c *CollectionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Collection = append(c.inters.Collection, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `propertyschema.Intercept(f(g(h())))`.
+func (c *PropertySchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PropertySchema = append(c.inters.PropertySchema, interceptors...)
 }
 
-// Create returns a builder for creating a Collection entity.
-func (c *CollectionClient) Create() *CollectionCreate {
-	mutation := newCollectionMutation(c.config, OpCreate)
-	return &CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PropertySchema entity.
+func (c *PropertySchemaClient) Create() *PropertySchemaCreate {
+	mutation := newPropertySchemaMutation(c.config, OpCreate)
+	return &PropertySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Collection entities.
-func (c *CollectionClient) CreateBulk(builders ...*CollectionCreate) *CollectionCreateBulk {
-	return &CollectionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PropertySchema entities.
+func (c *PropertySchemaClient) CreateBulk(builders ...*PropertySchemaCreate) *PropertySchemaCreateBulk {
+	return &PropertySchemaCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CollectionClient) MapCreateBulk(slice any, setFunc func(*CollectionCreate, int)) *CollectionCreateBulk {
+func (c *PropertySchemaClient) MapCreateBulk(slice any, setFunc func(*PropertySchemaCreate, int)) *PropertySchemaCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CollectionCreateBulk{err: fmt.Errorf("calling to CollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PropertySchemaCreateBulk{err: fmt.Errorf("calling to PropertySchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CollectionCreate, rv.Len())
+	builders := make([]*PropertySchemaCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CollectionCreateBulk{config: c.config, builders: builders}
+	return &PropertySchemaCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Collection.
-func (c *CollectionClient) Update() *CollectionUpdate {
-	mutation := newCollectionMutation(c.config, OpUpdate)
-	return &CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PropertySchema.
+func (c *PropertySchemaClient) Update() *PropertySchemaUpdate {
+	mutation := newPropertySchemaMutation(c.config, OpUpdate)
+	return &PropertySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CollectionClient) UpdateOne(_m *Collection) *CollectionUpdateOne {
-	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollection(_m))
-	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PropertySchemaClient) UpdateOne(_m *PropertySchema) *PropertySchemaUpdateOne {
+	mutation := newPropertySchemaMutation(c.config, OpUpdateOne, withPropertySchema(_m))
+	return &PropertySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CollectionClient) UpdateOneID(id xid.ID) *CollectionUpdateOne {
-	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollectionID(id))
-	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PropertySchemaClient) UpdateOneID(id xid.ID) *PropertySchemaUpdateOne {
+	mutation := newPropertySchemaMutation(c.config, OpUpdateOne, withPropertySchemaID(id))
+	return &PropertySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Collection.
-func (c *CollectionClient) Delete() *CollectionDelete {
-	mutation := newCollectionMutation(c.config, OpDelete)
-	return &CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PropertySchema.
+func (c *PropertySchemaClient) Delete() *PropertySchemaDelete {
+	mutation := newPropertySchemaMutation(c.config, OpDelete)
+	return &PropertySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CollectionClient) DeleteOne(_m *Collection) *CollectionDeleteOne {
+func (c *PropertySchemaClient) DeleteOne(_m *PropertySchema) *PropertySchemaDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CollectionClient) DeleteOneID(id xid.ID) *CollectionDeleteOne {
-	builder := c.Delete().Where(collection.ID(id))
+func (c *PropertySchemaClient) DeleteOneID(id xid.ID) *PropertySchemaDeleteOne {
+	builder := c.Delete().Where(propertyschema.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CollectionDeleteOne{builder}
+	return &PropertySchemaDeleteOne{builder}
 }
 
-// Query returns a query builder for Collection.
-func (c *CollectionClient) Query() *CollectionQuery {
-	return &CollectionQuery{
+// Query returns a query builder for PropertySchema.
+func (c *PropertySchemaClient) Query() *PropertySchemaQuery {
+	return &PropertySchemaQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCollection},
+		ctx:    &QueryContext{Type: TypePropertySchema},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Collection entity by its id.
-func (c *CollectionClient) Get(ctx context.Context, id xid.ID) (*Collection, error) {
-	return c.Query().Where(collection.ID(id)).Only(ctx)
+// Get returns a PropertySchema entity by its id.
+func (c *PropertySchemaClient) Get(ctx context.Context, id xid.ID) (*PropertySchema, error) {
+	return c.Query().Where(propertyschema.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CollectionClient) GetX(ctx context.Context, id xid.ID) *Collection {
+func (c *PropertySchemaClient) GetX(ctx context.Context, id xid.ID) *PropertySchema {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1996,95 +11763,31 @@ func (c *CollectionClient) GetX(ctx context.Context, id xid.ID) *Collection {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Collection.
-func (c *CollectionClient) QueryOwner(_m *Collection) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(collection.Table, collection.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, collection.OwnerTable, collection.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCoverImage queries the cover_image edge of a Collection.
-func (c *CollectionClient) QueryCoverImage(_m *Collection) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(collection.Table, collection.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, collection.CoverImageTable, collection.CoverImageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPosts queries the posts edge of a Collection.
-func (c *CollectionClient) QueryPosts(_m *Collection) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(collection.Table, collection.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, collection.PostsTable, collection.PostsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryNodes queries the nodes edge of a Collection.
-func (c *CollectionClient) QueryNodes(_m *Collection) *NodeQuery {
+// QueryNode queries the node edge of a PropertySchema.
+func (c *PropertySchemaClient) QueryNode(_m *PropertySchema) *NodeQuery {
 	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.From(propertyschema.Table, propertyschema.FieldID, id),
 			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, collection.NodesTable, collection.NodesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCollectionPosts queries the collection_posts edge of a Collection.
-func (c *CollectionClient) QueryCollectionPosts(_m *Collection) *CollectionPostQuery {
-	query := (&CollectionPostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(collection.Table, collection.FieldID, id),
-			sqlgraph.To(collectionpost.Table, collectionpost.CollectionColumn),
-			sqlgraph.Edge(sqlgraph.O2M, true, collection.CollectionPostsTable, collection.CollectionPostsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, propertyschema.NodeTable, propertyschema.NodeColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
-}
-
-// QueryCollectionNodes queries the collection_nodes edge of a Collection.
-func (c *CollectionClient) QueryCollectionNodes(_m *Collection) *CollectionNodeQuery {
-	query := (&CollectionNodeClient{config: c.config}).Query()
+}
+
+// QueryFields queries the fields edge of a PropertySchema.
+func (c *PropertySchemaClient) QueryFields(_m *PropertySchema) *PropertySchemaFieldQuery {
+	query := (&PropertySchemaFieldClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(collection.Table, collection.FieldID, id),
-			sqlgraph.To(collectionnode.Table, collectionnode.CollectionColumn),
-			sqlgraph.Edge(sqlgraph.O2M, true, collection.CollectionNodesTable, collection.CollectionNodesColumn),
+			sqlgraph.From(propertyschema.Table, propertyschema.FieldID, id),
+			sqlgraph.To(propertyschemafield.Table, propertyschemafield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, propertyschema.FieldsTable, propertyschema.FieldsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2093,363 +11796,445 @@ func (c *CollectionClient) QueryCollectionNodes(_m *Collection) *CollectionNodeQ
 }
 
 // Hooks returns the client hooks.
-func (c *CollectionClient) Hooks() []Hook {
-	return c.hooks.Collection
+func (c *PropertySchemaClient) Hooks() []Hook {
+	return c.hooks.PropertySchema
 }
 
 // Interceptors returns the client interceptors.
-func (c *CollectionClient) Interceptors() []Interceptor {
-	return c.inters.Collection
+func (c *PropertySchemaClient) Interceptors() []Interceptor {
+	return c.inters.PropertySchema
 }
 
-func (c *CollectionClient) mutate(ctx context.Context, m *CollectionMutation) (Value, error) {
+func (c *PropertySchemaClient) mutate(ctx context.Context, m *PropertySchemaMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PropertySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Collection mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PropertySchema mutation op: %q", m.Op())
 	}
 }
 
-// CollectionNodeClient is a client for the CollectionNode schema.
-type CollectionNodeClient struct {
+// PropertySchemaFieldClient is a client for the PropertySchemaField schema.
+type PropertySchemaFieldClient struct {
 	config
 }
 
-// NewCollectionNodeClient returns a client for the CollectionNode from the given config.
-func NewCollectionNodeClient(c config) *CollectionNodeClient {
-	return &CollectionNodeClient{config: c}
+// NewPropertySchemaFieldClient returns a client for the PropertySchemaField from the given config.
+func NewPropertySchemaFieldClient(c config) *PropertySchemaFieldClient {
+	return &PropertySchemaFieldClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `collectionnode.Hooks(f(g(h())))`.
-func (c *CollectionNodeClient) Use(hooks ...Hook) {
-	c.hooks.CollectionNode = append(c.hooks.CollectionNode, hooks...)
+// A call to `Use(f, g, h)` equals to `propertyschemafield.Hooks(f(g(h())))`.
+func (c *PropertySchemaFieldClient) Use(hooks ...Hook) {
+	c.hooks.PropertySchemaField = append(c.hooks.PropertySchemaField, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `collectionnode.Intercept(f(g(h())))`.
-func (c *CollectionNodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CollectionNode = append(c.inters.CollectionNode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `propertyschemafield.Intercept(f(g(h())))`.
+func (c *PropertySchemaFieldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PropertySchemaField = append(c.inters.PropertySchemaField, interceptors...)
 }
 
-// Create returns a builder for creating a CollectionNode entity.
-func (c *CollectionNodeClient) Create() *CollectionNodeCreate {
-	mutation := newCollectionNodeMutation(c.config, OpCreate)
-	return &CollectionNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PropertySchemaField entity.
+func (c *PropertySchemaFieldClient) Create() *PropertySchemaFieldCreate {
+	mutation := newPropertySchemaFieldMutation(c.config, OpCreate)
+	return &PropertySchemaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CollectionNode entities.
-func (c *CollectionNodeClient) CreateBulk(builders ...*CollectionNodeCreate) *CollectionNodeCreateBulk {
-	return &CollectionNodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PropertySchemaField entities.
+func (c *PropertySchemaFieldClient) CreateBulk(builders ...*PropertySchemaFieldCreate) *PropertySchemaFieldCreateBulk {
+	return &PropertySchemaFieldCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CollectionNodeClient) MapCreateBulk(slice any, setFunc func(*CollectionNodeCreate, int)) *CollectionNodeCreateBulk {
+func (c *PropertySchemaFieldClient) MapCreateBulk(slice any, setFunc func(*PropertySchemaFieldCreate, int)) *PropertySchemaFieldCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CollectionNodeCreateBulk{err: fmt.Errorf("calling to CollectionNodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PropertySchemaFieldCreateBulk{err: fmt.Errorf("calling to PropertySchemaFieldClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CollectionNodeCreate, rv.Len())
+	builders := make([]*PropertySchemaFieldCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CollectionNodeCreateBulk{config: c.config, builders: builders}
+	return &PropertySchemaFieldCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CollectionNode.
-func (c *CollectionNodeClient) Update() *CollectionNodeUpdate {
-	mutation := newCollectionNodeMutation(c.config, OpUpdate)
-	return &CollectionNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PropertySchemaField.
+func (c *PropertySchemaFieldClient) Update() *PropertySchemaFieldUpdate {
+	mutation := newPropertySchemaFieldMutation(c.config, OpUpdate)
+	return &PropertySchemaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CollectionNodeClient) UpdateOne(_m *CollectionNode) *CollectionNodeUpdateOne {
-	mutation := newCollectionNodeMutation(c.config, OpUpdateOne)
-	mutation.collection = &_m.CollectionID
-	mutation.node = &_m.NodeID
-	return &CollectionNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PropertySchemaFieldClient) UpdateOne(_m *PropertySchemaField) *PropertySchemaFieldUpdateOne {
+	mutation := newPropertySchemaFieldMutation(c.config, OpUpdateOne, withPropertySchemaField(_m))
+	return &PropertySchemaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CollectionNode.
-func (c *CollectionNodeClient) Delete() *CollectionNodeDelete {
-	mutation := newCollectionNodeMutation(c.config, OpDelete)
-	return &CollectionNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// UpdateOneID returns an update builder for the given id.
+func (c *PropertySchemaFieldClient) UpdateOneID(id xid.ID) *PropertySchemaFieldUpdateOne {
+	mutation := newPropertySchemaFieldMutation(c.config, OpUpdateOne, withPropertySchemaFieldID(id))
+	return &PropertySchemaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Query returns a query builder for CollectionNode.
-func (c *CollectionNodeClient) Query() *CollectionNodeQuery {
-	return &CollectionNodeQuery{
+// Delete returns a delete builder for PropertySchemaField.
+func (c *PropertySchemaFieldClient) Delete() *PropertySchemaFieldDelete {
+	mutation := newPropertySchemaFieldMutation(c.config, OpDelete)
+	return &PropertySchemaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PropertySchemaFieldClient) DeleteOne(_m *PropertySchemaField) *PropertySchemaFieldDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PropertySchemaFieldClient) DeleteOneID(id xid.ID) *PropertySchemaFieldDeleteOne {
+	builder := c.Delete().Where(propertyschemafield.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PropertySchemaFieldDeleteOne{builder}
+}
+
+// Query returns a query builder for PropertySchemaField.
+func (c *PropertySchemaFieldClient) Query() *PropertySchemaFieldQuery {
+	return &PropertySchemaFieldQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCollectionNode},
+		ctx:    &QueryContext{Type: TypePropertySchemaField},
 		inters: c.Interceptors(),
 	}
 }
 
-// QueryCollection queries the collection edge of a CollectionNode.
-func (c *CollectionNodeClient) QueryCollection(_m *CollectionNode) *CollectionQuery {
-	return c.Query().
-		Where(collectionnode.CollectionID(_m.CollectionID), collectionnode.NodeID(_m.NodeID)).
-		QueryCollection()
+// Get returns a PropertySchemaField entity by its id.
+func (c *PropertySchemaFieldClient) Get(ctx context.Context, id xid.ID) (*PropertySchemaField, error) {
+	return c.Query().Where(propertyschemafield.ID(id)).Only(ctx)
 }
 
-// QueryNode queries the node edge of a CollectionNode.
-func (c *CollectionNodeClient) QueryNode(_m *CollectionNode) *NodeQuery {
-	return c.Query().
-		Where(collectionnode.CollectionID(_m.CollectionID), collectionnode.NodeID(_m.NodeID)).
-		QueryNode()
+// GetX is like Get, but panics if an error occurs.
+func (c *PropertySchemaFieldClient) GetX(ctx context.Context, id xid.ID) *PropertySchemaField {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySchema queries the schema edge of a PropertySchemaField.
+func (c *PropertySchemaFieldClient) QuerySchema(_m *PropertySchemaField) *PropertySchemaQuery {
+	query := (&PropertySchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(propertyschemafield.Table, propertyschemafield.FieldID, id),
+			sqlgraph.To(propertyschema.Table, propertyschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, propertyschemafield.SchemaTable, propertyschemafield.SchemaColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProperties queries the properties edge of a PropertySchemaField.
+func (c *PropertySchemaFieldClient) QueryProperties(_m *PropertySchemaField) *PropertyQuery {
+	query := (&PropertyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(propertyschemafield.Table, propertyschemafield.FieldID, id),
+			sqlgraph.To(property.Table, property.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, propertyschemafield.PropertiesTable, propertyschemafield.PropertiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
 // Hooks returns the client hooks.
-func (c *CollectionNodeClient) Hooks() []Hook {
-	return c.hooks.CollectionNode
+func (c *PropertySchemaFieldClient) Hooks() []Hook {
+	return c.hooks.PropertySchemaField
 }
 
 // Interceptors returns the client interceptors.
-func (c *CollectionNodeClient) Interceptors() []Interceptor {
-	return c.inters.CollectionNode
+func (c *PropertySchemaFieldClient) Interceptors() []Interceptor {
+	return c.inters.PropertySchemaField
 }
 
-func (c *CollectionNodeClient) mutate(ctx context.Context, m *CollectionNodeMutation) (Value, error) {
+func (c *PropertySchemaFieldClient) mutate(ctx context.Context, m *PropertySchemaFieldMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CollectionNodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertySchemaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CollectionNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertySchemaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CollectionNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PropertySchemaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CollectionNodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PropertySchemaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CollectionNode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PropertySchemaField mutation op: %q", m.Op())
 	}
 }
 
-// CollectionPostClient is a client for the CollectionPost schema.
-type CollectionPostClient struct {
+// PushSubscriptionClient is a client for the PushSubscription schema.
+type PushSubscriptionClient struct {
 	config
 }
 
-// NewCollectionPostClient returns a client for the CollectionPost from the given config.
-func NewCollectionPostClient(c config) *CollectionPostClient {
-	return &CollectionPostClient{config: c}
+// NewPushSubscriptionClient returns a client for the PushSubscription from the given config.
+func NewPushSubscriptionClient(c config) *PushSubscriptionClient {
+	return &PushSubscriptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `collectionpost.Hooks(f(g(h())))`.
-func (c *CollectionPostClient) Use(hooks ...Hook) {
-	c.hooks.CollectionPost = append(c.hooks.CollectionPost, hooks...)
+// A call to `Use(f, g, h)` equals to `pushsubscription.Hooks(f(g(h())))`.
+func (c *PushSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.PushSubscription = append(c.hooks.PushSubscription, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `collectionpost.Intercept(f(g(h())))`.
-func (c *CollectionPostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CollectionPost = append(c.inters.CollectionPost, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `pushsubscription.Intercept(f(g(h())))`.
+func (c *PushSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PushSubscription = append(c.inters.PushSubscription, interceptors...)
 }
 
-// Create returns a builder for creating a CollectionPost entity.
-func (c *CollectionPostClient) Create() *CollectionPostCreate {
-	mutation := newCollectionPostMutation(c.config, OpCreate)
-	return &CollectionPostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PushSubscription entity.
+func (c *PushSubscriptionClient) Create() *PushSubscriptionCreate {
+	mutation := newPushSubscriptionMutation(c.config, OpCreate)
+	return &PushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CollectionPost entities.
-func (c *CollectionPostClient) CreateBulk(builders ...*CollectionPostCreate) *CollectionPostCreateBulk {
-	return &CollectionPostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PushSubscription entities.
+func (c *PushSubscriptionClient) CreateBulk(builders ...*PushSubscriptionCreate) *PushSubscriptionCreateBulk {
+	return &PushSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CollectionPostClient) MapCreateBulk(slice any, setFunc func(*CollectionPostCreate, int)) *CollectionPostCreateBulk {
+func (c *PushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*PushSubscriptionCreate, int)) *PushSubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CollectionPostCreateBulk{err: fmt.Errorf("calling to CollectionPostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PushSubscriptionCreateBulk{err: fmt.Errorf("calling to PushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CollectionPostCreate, rv.Len())
+	builders := make([]*PushSubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CollectionPostCreateBulk{config: c.config, builders: builders}
+	return &PushSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CollectionPost.
-func (c *CollectionPostClient) Update() *CollectionPostUpdate {
-	mutation := newCollectionPostMutation(c.config, OpUpdate)
-	return &CollectionPostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PushSubscription.
+func (c *PushSubscriptionClient) Update() *PushSubscriptionUpdate {
+	mutation := newPushSubscriptionMutation(c.config, OpUpdate)
+	return &PushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CollectionPostClient) UpdateOne(_m *CollectionPost) *CollectionPostUpdateOne {
-	mutation := newCollectionPostMutation(c.config, OpUpdateOne)
-	mutation.collection = &_m.CollectionID
-	mutation.post = &_m.PostID
-	return &CollectionPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PushSubscriptionClient) UpdateOne(_m *PushSubscription) *PushSubscriptionUpdateOne {
+	mutation := newPushSubscriptionMutation(c.config, OpUpdateOne, withPushSubscription(_m))
+	return &PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CollectionPost.
-func (c *CollectionPostClient) Delete() *CollectionPostDelete {
-	mutation := newCollectionPostMutation(c.config, OpDelete)
-	return &CollectionPostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// UpdateOneID returns an update builder for the given id.
+func (c *PushSubscriptionClient) UpdateOneID(id xid.ID) *PushSubscriptionUpdateOne {
+	mutation := newPushSubscriptionMutation(c.config, OpUpdateOne, withPushSubscriptionID(id))
+	return &PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Query returns a query builder for CollectionPost.
-func (c *CollectionPostClient) Query() *CollectionPostQuery {
-	return &CollectionPostQuery{
+// Delete returns a delete builder for PushSubscription.
+func (c *PushSubscriptionClient) Delete() *PushSubscriptionDelete {
+	mutation := newPushSubscriptionMutation(c.config, OpDelete)
+	return &PushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PushSubscriptionClient) DeleteOne(_m *PushSubscription) *PushSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PushSubscriptionClient) DeleteOneID(id xid.ID) *PushSubscriptionDeleteOne {
+	builder := c.Delete().Where(pushsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PushSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PushSubscription.
+func (c *PushSubscriptionClient) Query() *PushSubscriptionQuery {
+	return &PushSubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCollectionPost},
+		ctx:    &QueryContext{Type: TypePushSubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// QueryCollection queries the collection edge of a CollectionPost.
-func (c *CollectionPostClient) QueryCollection(_m *CollectionPost) *CollectionQuery {
-	return c.Query().
-		Where(collectionpost.CollectionID(_m.CollectionID), collectionpost.PostID(_m.PostID)).
-		QueryCollection()
+// Get returns a PushSubscription entity by its id.
+func (c *PushSubscriptionClient) Get(ctx context.Context, id xid.ID) (*PushSubscription, error) {
+	return c.Query().Where(pushsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PushSubscriptionClient) GetX(ctx context.Context, id xid.ID) *PushSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
-// QueryPost queries the post edge of a CollectionPost.
-func (c *CollectionPostClient) QueryPost(_m *CollectionPost) *PostQuery {
-	return c.Query().
-		Where(collectionpost.CollectionID(_m.CollectionID), collectionpost.PostID(_m.PostID)).
-		QueryPost()
+// QueryAccount queries the account edge of a PushSubscription.
+func (c *PushSubscriptionClient) QueryAccount(_m *PushSubscription) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pushsubscription.Table, pushsubscription.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pushsubscription.AccountTable, pushsubscription.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
 // Hooks returns the client hooks.
-func (c *CollectionPostClient) Hooks() []Hook {
-	return c.hooks.CollectionPost
+func (c *PushSubscriptionClient) Hooks() []Hook {
+	return c.hooks.PushSubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *CollectionPostClient) Interceptors() []Interceptor {
-	return c.inters.CollectionPost
+func (c *PushSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.PushSubscription
 }
 
-func (c *CollectionPostClient) mutate(ctx context.Context, m *CollectionPostMutation) (Value, error) {
+func (c *PushSubscriptionClient) mutate(ctx context.Context, m *PushSubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CollectionPostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CollectionPostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CollectionPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CollectionPostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CollectionPost mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PushSubscription mutation op: %q", m.Op())
 	}
 }
 
-// EmailClient is a client for the Email schema.
-type EmailClient struct {
+// QuestionClient is a client for the Question schema.
+type QuestionClient struct {
 	config
 }
 
-// NewEmailClient returns a client for the Email from the given config.
-func NewEmailClient(c config) *EmailClient {
-	return &EmailClient{config: c}
+// NewQuestionClient returns a client for the Question from the given config.
+func NewQuestionClient(c config) *QuestionClient {
+	return &QuestionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `email.Hooks(f(g(h())))`.
-func (c *EmailClient) Use(hooks ...Hook) {
-	c.hooks.Email = append(c.hooks.Email, hooks...)
+// A call to `Use(f, g, h)` equals to `question.Hooks(f(g(h())))`.
+func (c *QuestionClient) Use(hooks ...Hook) {
+	c.hooks.Question = append(c.hooks.Question, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `email.Intercept(f(g(h())))`.
-func (c *EmailClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Email = append(c.inters.Email, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `question.Intercept(f(g(h())))`.
+func (c *QuestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Question = append(c.inters.Question, interceptors...)
 }
 
-// Create returns a builder for creating a Email entity.
-func (c *EmailClient) Create() *EmailCreate {
-	mutation := newEmailMutation(c.config, OpCreate)
-	return &EmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Question entity.
+func (c *QuestionClient) Create() *QuestionCreate {
+	mutation := newQuestionMutation(c.config, OpCreate)
+	return &QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Email entities.
-func (c *EmailClient) CreateBulk(builders ...*EmailCreate) *EmailCreateBulk {
-	return &EmailCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Question entities.
+func (c *QuestionClient) CreateBulk(builders ...*QuestionCreate) *QuestionCreateBulk {
+	return &QuestionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailClient) MapCreateBulk(slice any, setFunc func(*EmailCreate, int)) *EmailCreateBulk {
+func (c *QuestionClient) MapCreateBulk(slice any, setFunc func(*QuestionCreate, int)) *QuestionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailCreateBulk{err: fmt.Errorf("calling to EmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuestionCreateBulk{err: fmt.Errorf("calling to QuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailCreate, rv.Len())
+	builders := make([]*QuestionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailCreateBulk{config: c.config, builders: builders}
+	return &QuestionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Email.
-func (c *EmailClient) Update() *EmailUpdate {
-	mutation := newEmailMutation(c.config, OpUpdate)
-	return &EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Question.
+func (c *QuestionClient) Update() *QuestionUpdate {
+	mutation := newQuestionMutation(c.config, OpUpdate)
+	return &QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailClient) UpdateOne(_m *Email) *EmailUpdateOne {
-	mutation := newEmailMutation(c.config, OpUpdateOne, withEmail(_m))
-	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionClient) UpdateOne(_m *Question) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestion(_m))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailClient) UpdateOneID(id xid.ID) *EmailUpdateOne {
-	mutation := newEmailMutation(c.config, OpUpdateOne, withEmailID(id))
-	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuestionClient) UpdateOneID(id xid.ID) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestionID(id))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Email.
-func (c *EmailClient) Delete() *EmailDelete {
-	mutation := newEmailMutation(c.config, OpDelete)
-	return &EmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Question.
+func (c *QuestionClient) Delete() *QuestionDelete {
+	mutation := newQuestionMutation(c.config, OpDelete)
+	return &QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailClient) DeleteOne(_m *Email) *EmailDeleteOne {
+func (c *QuestionClient) DeleteOne(_m *Question) *QuestionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailClient) DeleteOneID(id xid.ID) *EmailDeleteOne {
-	builder := c.Delete().Where(email.ID(id))
+func (c *QuestionClient) DeleteOneID(id xid.ID) *QuestionDeleteOne {
+	builder := c.Delete().Where(question.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailDeleteOne{builder}
+	return &QuestionDeleteOne{builder}
 }
 
-// Query returns a query builder for Email.
-func (c *EmailClient) Query() *EmailQuery {
-	return &EmailQuery{
+// Query returns a query builder for Question.
+func (c *QuestionClient) Query() *QuestionQuery {
+	return &QuestionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmail},
+		ctx:    &QueryContext{Type: TypeQuestion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Email entity by its id.
-func (c *EmailClient) Get(ctx context.Context, id xid.ID) (*Email, error) {
-	return c.Query().Where(email.ID(id)).Only(ctx)
+// Get returns a Question entity by its id.
+func (c *QuestionClient) Get(ctx context.Context, id xid.ID) (*Question, error) {
+	return c.Query().Where(question.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailClient) GetX(ctx context.Context, id xid.ID) *Email {
+func (c *QuestionClient) GetX(ctx context.Context, id xid.ID) *Question {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2457,15 +12242,47 @@ func (c *EmailClient) GetX(ctx context.Context, id xid.ID) *Email {
 	return obj
 }
 
-// QueryAccount queries the account edge of a Email.
-func (c *EmailClient) QueryAccount(_m *Email) *AccountQuery {
+// QueryAuthor queries the author edge of a Question.
+func (c *QuestionClient) QueryAuthor(_m *Question) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(email.Table, email.FieldID, id),
+			sqlgraph.From(question.Table, question.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, email.AccountTable, email.AccountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.AuthorTable, question.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Question.
+func (c *QuestionClient) QueryParent(_m *Question) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.ParentTable, question.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParentQuestion queries the parent_question edge of a Question.
+func (c *QuestionClient) QueryParentQuestion(_m *Question) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.ParentQuestionTable, question.ParentQuestionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2474,131 +12291,131 @@ func (c *EmailClient) QueryAccount(_m *Email) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EmailClient) Hooks() []Hook {
-	return c.hooks.Email
+func (c *QuestionClient) Hooks() []Hook {
+	return c.hooks.Question
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailClient) Interceptors() []Interceptor {
-	return c.inters.Email
+func (c *QuestionClient) Interceptors() []Interceptor {
+	return c.inters.Question
 }
 
-func (c *EmailClient) mutate(ctx context.Context, m *EmailMutation) (Value, error) {
+func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Email mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
 	}
 }
 
-// EventClient is a client for the Event schema.
-type EventClient struct {
+// ReactClient is a client for the React schema.
+type ReactClient struct {
 	config
 }
 
-// NewEventClient returns a client for the Event from the given config.
-func NewEventClient(c config) *EventClient {
-	return &EventClient{config: c}
+// NewReactClient returns a client for the React from the given config.
+func NewReactClient(c config) *ReactClient {
+	return &ReactClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
-func (c *EventClient) Use(hooks ...Hook) {
-	c.hooks.Event = append(c.hooks.Event, hooks...)
+// A call to `Use(f, g, h)` equals to `react.Hooks(f(g(h())))`.
+func (c *ReactClient) Use(hooks ...Hook) {
+	c.hooks.React = append(c.hooks.React, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
-func (c *EventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Event = append(c.inters.Event, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `react.Intercept(f(g(h())))`.
+func (c *ReactClient) Intercept(interceptors ...Interceptor) {
+	c.inters.React = append(c.inters.React, interceptors...)
 }
 
-// Create returns a builder for creating a Event entity.
-func (c *EventClient) Create() *EventCreate {
-	mutation := newEventMutation(c.config, OpCreate)
-	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a React entity.
+func (c *ReactClient) Create() *ReactCreate {
+	mutation := newReactMutation(c.config, OpCreate)
+	return &ReactCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Event entities.
-func (c *EventClient) CreateBulk(builders ...*EventCreate) *EventCreateBulk {
-	return &EventCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of React entities.
+func (c *ReactClient) CreateBulk(builders ...*ReactCreate) *ReactCreateBulk {
+	return &ReactCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+func (c *ReactClient) MapCreateBulk(slice any, setFunc func(*ReactCreate, int)) *ReactCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReactCreateBulk{err: fmt.Errorf("calling to ReactClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EventCreate, rv.Len())
+	builders := make([]*ReactCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EventCreateBulk{config: c.config, builders: builders}
+	return &ReactCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Event.
-func (c *EventClient) Update() *EventUpdate {
-	mutation := newEventMutation(c.config, OpUpdate)
-	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for React.
+func (c *ReactClient) Update() *ReactUpdate {
+	mutation := newReactMutation(c.config, OpUpdate)
+	return &ReactUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
-	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
-	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReactClient) UpdateOne(_m *React) *ReactUpdateOne {
+	mutation := newReactMutation(c.config, OpUpdateOne, withReact(_m))
+	return &ReactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EventClient) UpdateOneID(id xid.ID) *EventUpdateOne {
-	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
-	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for Event.
-func (c *EventClient) Delete() *EventDelete {
-	mutation := newEventMutation(c.config, OpDelete)
-	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReactClient) UpdateOneID(id xid.ID) *ReactUpdateOne {
+	mutation := newReactMutation(c.config, OpUpdateOne, withReactID(id))
+	return &ReactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for React.
+func (c *ReactClient) Delete() *ReactDelete {
+	mutation := newReactMutation(c.config, OpDelete)
+	return &ReactDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+func (c *ReactClient) DeleteOne(_m *React) *ReactDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EventClient) DeleteOneID(id xid.ID) *EventDeleteOne {
-	builder := c.Delete().Where(event.ID(id))
+func (c *ReactClient) DeleteOneID(id xid.ID) *ReactDeleteOne {
+	builder := c.Delete().Where(react.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EventDeleteOne{builder}
+	return &ReactDeleteOne{builder}
 }
 
-// Query returns a query builder for Event.
-func (c *EventClient) Query() *EventQuery {
-	return &EventQuery{
+// Query returns a query builder for React.
+func (c *ReactClient) Query() *ReactQuery {
+	return &ReactQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEvent},
+		ctx:    &QueryContext{Type: TypeReact},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Event entity by its id.
-func (c *EventClient) Get(ctx context.Context, id xid.ID) (*Event, error) {
-	return c.Query().Where(event.ID(id)).Only(ctx)
+// Get returns a React entity by its id.
+func (c *ReactClient) Get(ctx context.Context, id xid.ID) (*React, error) {
+	return c.Query().Where(react.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EventClient) GetX(ctx context.Context, id xid.ID) *Event {
+func (c *ReactClient) GetX(ctx context.Context, id xid.ID) *React {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2606,15 +12423,15 @@ func (c *EventClient) GetX(ctx context.Context, id xid.ID) *Event {
 	return obj
 }
 
-// QueryParticipants queries the participants edge of a Event.
-func (c *EventClient) QueryParticipants(_m *Event) *EventParticipantQuery {
-	query := (&EventParticipantClient{config: c.config}).Query()
+// QueryAccount queries the account edge of a React.
+func (c *ReactClient) QueryAccount(_m *React) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(event.Table, event.FieldID, id),
-			sqlgraph.To(eventparticipant.Table, eventparticipant.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, event.ParticipantsTable, event.ParticipantsColumn),
+			sqlgraph.From(react.Table, react.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, react.AccountTable, react.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2622,31 +12439,15 @@ func (c *EventClient) QueryParticipants(_m *Event) *EventParticipantQuery {
 	return query
 }
 
-// QueryThread queries the thread edge of a Event.
-func (c *EventClient) QueryThread(_m *Event) *PostQuery {
+// QueryPost queries the Post edge of a React.
+func (c *ReactClient) QueryPost(_m *React) *PostQuery {
 	query := (&PostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.From(react.Table, react.FieldID, id),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, event.ThreadTable, event.ThreadColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPrimaryImage queries the primary_image edge of a Event.
-func (c *EventClient) QueryPrimaryImage(_m *Event) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(event.Table, event.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, event.PrimaryImageTable, event.PrimaryImageColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, react.PostTable, react.PostColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2655,131 +12456,131 @@ func (c *EventClient) QueryPrimaryImage(_m *Event) *AssetQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EventClient) Hooks() []Hook {
-	return c.hooks.Event
+func (c *ReactClient) Hooks() []Hook {
+	return c.hooks.React
 }
 
 // Interceptors returns the client interceptors.
-func (c *EventClient) Interceptors() []Interceptor {
-	return c.inters.Event
+func (c *ReactClient) Interceptors() []Interceptor {
+	return c.inters.React
 }
 
-func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+func (c *ReactClient) mutate(ctx context.Context, m *ReactMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReactCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReactUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReactDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Event mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown React mutation op: %q", m.Op())
 	}
 }
 
-// EventParticipantClient is a client for the EventParticipant schema.
-type EventParticipantClient struct {
+// ReadingHistoryClient is a client for the ReadingHistory schema.
+type ReadingHistoryClient struct {
 	config
 }
 
-// NewEventParticipantClient returns a client for the EventParticipant from the given config.
-func NewEventParticipantClient(c config) *EventParticipantClient {
-	return &EventParticipantClient{config: c}
+// NewReadingHistoryClient returns a client for the ReadingHistory from the given config.
+func NewReadingHistoryClient(c config) *ReadingHistoryClient {
+	return &ReadingHistoryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `eventparticipant.Hooks(f(g(h())))`.
-func (c *EventParticipantClient) Use(hooks ...Hook) {
-	c.hooks.EventParticipant = append(c.hooks.EventParticipant, hooks...)
+// A call to `Use(f, g, h)` equals to `readinghistory.Hooks(f(g(h())))`.
+func (c *ReadingHistoryClient) Use(hooks ...Hook) {
+	c.hooks.ReadingHistory = append(c.hooks.ReadingHistory, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `eventparticipant.Intercept(f(g(h())))`.
-func (c *EventParticipantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EventParticipant = append(c.inters.EventParticipant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `readinghistory.Intercept(f(g(h())))`.
+func (c *ReadingHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReadingHistory = append(c.inters.ReadingHistory, interceptors...)
 }
 
-// Create returns a builder for creating a EventParticipant entity.
-func (c *EventParticipantClient) Create() *EventParticipantCreate {
-	mutation := newEventParticipantMutation(c.config, OpCreate)
-	return &EventParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReadingHistory entity.
+func (c *ReadingHistoryClient) Create() *ReadingHistoryCreate {
+	mutation := newReadingHistoryMutation(c.config, OpCreate)
+	return &ReadingHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EventParticipant entities.
-func (c *EventParticipantClient) CreateBulk(builders ...*EventParticipantCreate) *EventParticipantCreateBulk {
-	return &EventParticipantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReadingHistory entities.
+func (c *ReadingHistoryClient) CreateBulk(builders ...*ReadingHistoryCreate) *ReadingHistoryCreateBulk {
+	return &ReadingHistoryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EventParticipantClient) MapCreateBulk(slice any, setFunc func(*EventParticipantCreate, int)) *EventParticipantCreateBulk {
+func (c *ReadingHistoryClient) MapCreateBulk(slice any, setFunc func(*ReadingHistoryCreate, int)) *ReadingHistoryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EventParticipantCreateBulk{err: fmt.Errorf("calling to EventParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReadingHistoryCreateBulk{err: fmt.Errorf("calling to ReadingHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EventParticipantCreate, rv.Len())
+	builders := make([]*ReadingHistoryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EventParticipantCreateBulk{config: c.config, builders: builders}
+	return &ReadingHistoryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EventParticipant.
-func (c *EventParticipantClient) Update() *EventParticipantUpdate {
-	mutation := newEventParticipantMutation(c.config, OpUpdate)
-	return &EventParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReadingHistory.
+func (c *ReadingHistoryClient) Update() *ReadingHistoryUpdate {
+	mutation := newReadingHistoryMutation(c.config, OpUpdate)
+	return &ReadingHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EventParticipantClient) UpdateOne(_m *EventParticipant) *EventParticipantUpdateOne {
-	mutation := newEventParticipantMutation(c.config, OpUpdateOne, withEventParticipant(_m))
-	return &EventParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReadingHistoryClient) UpdateOne(_m *ReadingHistory) *ReadingHistoryUpdateOne {
+	mutation := newReadingHistoryMutation(c.config, OpUpdateOne, withReadingHistory(_m))
+	return &ReadingHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EventParticipantClient) UpdateOneID(id xid.ID) *EventParticipantUpdateOne {
-	mutation := newEventParticipantMutation(c.config, OpUpdateOne, withEventParticipantID(id))
-	return &EventParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReadingHistoryClient) UpdateOneID(id xid.ID) *ReadingHistoryUpdateOne {
+	mutation := newReadingHistoryMutation(c.config, OpUpdateOne, withReadingHistoryID(id))
+	return &ReadingHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EventParticipant.
-func (c *EventParticipantClient) Delete() *EventParticipantDelete {
-	mutation := newEventParticipantMutation(c.config, OpDelete)
-	return &EventParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReadingHistory.
+func (c *ReadingHistoryClient) Delete() *ReadingHistoryDelete {
+	mutation := newReadingHistoryMutation(c.config, OpDelete)
+	return &ReadingHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EventParticipantClient) DeleteOne(_m *EventParticipant) *EventParticipantDeleteOne {
+func (c *ReadingHistoryClient) DeleteOne(_m *ReadingHistory) *ReadingHistoryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EventParticipantClient) DeleteOneID(id xid.ID) *EventParticipantDeleteOne {
-	builder := c.Delete().Where(eventparticipant.ID(id))
+func (c *ReadingHistoryClient) DeleteOneID(id xid.ID) *ReadingHistoryDeleteOne {
+	builder := c.Delete().Where(readinghistory.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EventParticipantDeleteOne{builder}
+	return &ReadingHistoryDeleteOne{builder}
 }
 
-// Query returns a query builder for EventParticipant.
-func (c *EventParticipantClient) Query() *EventParticipantQuery {
-	return &EventParticipantQuery{
+// Query returns a query builder for ReadingHistory.
+func (c *ReadingHistoryClient) Query() *ReadingHistoryQuery {
+	return &ReadingHistoryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEventParticipant},
+		ctx:    &QueryContext{Type: TypeReadingHistory},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EventParticipant entity by its id.
-func (c *EventParticipantClient) Get(ctx context.Context, id xid.ID) (*EventParticipant, error) {
-	return c.Query().Where(eventparticipant.ID(id)).Only(ctx)
+// Get returns a ReadingHistory entity by its id.
+func (c *ReadingHistoryClient) Get(ctx context.Context, id xid.ID) (*ReadingHistory, error) {
+	return c.Query().Where(readinghistory.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EventParticipantClient) GetX(ctx context.Context, id xid.ID) *EventParticipant {
+func (c *ReadingHistoryClient) GetX(ctx context.Context, id xid.ID) *ReadingHistory {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2787,31 +12588,15 @@ func (c *EventParticipantClient) GetX(ctx context.Context, id xid.ID) *EventPart
 	return obj
 }
 
-// QueryAccount queries the account edge of a EventParticipant.
-func (c *EventParticipantClient) QueryAccount(_m *EventParticipant) *AccountQuery {
+// QueryAccount queries the account edge of a ReadingHistory.
+func (c *ReadingHistoryClient) QueryAccount(_m *ReadingHistory) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(eventparticipant.Table, eventparticipant.FieldID, id),
+			sqlgraph.From(readinghistory.Table, readinghistory.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, eventparticipant.AccountTable, eventparticipant.AccountColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEvent queries the event edge of a EventParticipant.
-func (c *EventParticipantClient) QueryEvent(_m *EventParticipant) *EventQuery {
-	query := (&EventClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(eventparticipant.Table, eventparticipant.FieldID, id),
-			sqlgraph.To(event.Table, event.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, eventparticipant.EventTable, eventparticipant.EventColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, readinghistory.AccountTable, readinghistory.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2820,131 +12605,131 @@ func (c *EventParticipantClient) QueryEvent(_m *EventParticipant) *EventQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EventParticipantClient) Hooks() []Hook {
-	return c.hooks.EventParticipant
+func (c *ReadingHistoryClient) Hooks() []Hook {
+	return c.hooks.ReadingHistory
 }
 
 // Interceptors returns the client interceptors.
-func (c *EventParticipantClient) Interceptors() []Interceptor {
-	return c.inters.EventParticipant
+func (c *ReadingHistoryClient) Interceptors() []Interceptor {
+	return c.inters.ReadingHistory
 }
 
-func (c *EventParticipantClient) mutate(ctx context.Context, m *EventParticipantMutation) (Value, error) {
+func (c *ReadingHistoryClient) mutate(ctx context.Context, m *ReadingHistoryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EventParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReadingHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EventParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReadingHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EventParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReadingHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EventParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReadingHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EventParticipant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReadingHistory mutation op: %q", m.Op())
 	}
 }
 
-// InvitationClient is a client for the Invitation schema.
-type InvitationClient struct {
+// ReportClient is a client for the Report schema.
+type ReportClient struct {
 	config
 }
 
-// NewInvitationClient returns a client for the Invitation from the given config.
-func NewInvitationClient(c config) *InvitationClient {
-	return &InvitationClient{config: c}
+// NewReportClient returns a client for the Report from the given config.
+func NewReportClient(c config) *ReportClient {
+	return &ReportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
-func (c *InvitationClient) Use(hooks ...Hook) {
-	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+// A call to `Use(f, g, h)` equals to `report.Hooks(f(g(h())))`.
+func (c *ReportClient) Use(hooks ...Hook) {
+	c.hooks.Report = append(c.hooks.Report, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
-func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `report.Intercept(f(g(h())))`.
+func (c *ReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Report = append(c.inters.Report, interceptors...)
 }
 
-// Create returns a builder for creating a Invitation entity.
-func (c *InvitationClient) Create() *InvitationCreate {
-	mutation := newInvitationMutation(c.config, OpCreate)
-	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Report entity.
+func (c *ReportClient) Create() *ReportCreate {
+	mutation := newReportMutation(c.config, OpCreate)
+	return &ReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invitation entities.
-func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Report entities.
+func (c *ReportClient) CreateBulk(builders ...*ReportCreate) *ReportCreateBulk {
+	return &ReportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+func (c *ReportClient) MapCreateBulk(slice any, setFunc func(*ReportCreate, int)) *ReportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReportCreateBulk{err: fmt.Errorf("calling to ReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvitationCreate, rv.Len())
+	builders := make([]*ReportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+	return &ReportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invitation.
-func (c *InvitationClient) Update() *InvitationUpdate {
-	mutation := newInvitationMutation(c.config, OpUpdate)
-	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Report.
+func (c *ReportClient) Update() *ReportUpdate {
+	mutation := newReportMutation(c.config, OpUpdate)
+	return &ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvitationClient) UpdateOne(_m *Invitation) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(_m))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReportClient) UpdateOne(_m *Report) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReport(_m))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvitationClient) UpdateOneID(id xid.ID) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReportClient) UpdateOneID(id xid.ID) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReportID(id))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invitation.
-func (c *InvitationClient) Delete() *InvitationDelete {
-	mutation := newInvitationMutation(c.config, OpDelete)
-	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Report.
+func (c *ReportClient) Delete() *ReportDelete {
+	mutation := newReportMutation(c.config, OpDelete)
+	return &ReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvitationClient) DeleteOne(_m *Invitation) *InvitationDeleteOne {
+func (c *ReportClient) DeleteOne(_m *Report) *ReportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvitationClient) DeleteOneID(id xid.ID) *InvitationDeleteOne {
-	builder := c.Delete().Where(invitation.ID(id))
+func (c *ReportClient) DeleteOneID(id xid.ID) *ReportDeleteOne {
+	builder := c.Delete().Where(report.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvitationDeleteOne{builder}
+	return &ReportDeleteOne{builder}
 }
 
-// Query returns a query builder for Invitation.
-func (c *InvitationClient) Query() *InvitationQuery {
-	return &InvitationQuery{
+// Query returns a query builder for Report.
+func (c *ReportClient) Query() *ReportQuery {
+	return &ReportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvitation},
+		ctx:    &QueryContext{Type: TypeReport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Invitation entity by its id.
-func (c *InvitationClient) Get(ctx context.Context, id xid.ID) (*Invitation, error) {
-	return c.Query().Where(invitation.ID(id)).Only(ctx)
+// Get returns a Report entity by its id.
+func (c *ReportClient) Get(ctx context.Context, id xid.ID) (*Report, error) {
+	return c.Query().Where(report.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvitationClient) GetX(ctx context.Context, id xid.ID) *Invitation {
+func (c *ReportClient) GetX(ctx context.Context, id xid.ID) *Report {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2952,15 +12737,15 @@ func (c *InvitationClient) GetX(ctx context.Context, id xid.ID) *Invitation {
 	return obj
 }
 
-// QueryCreator queries the creator edge of a Invitation.
-func (c *InvitationClient) QueryCreator(_m *Invitation) *AccountQuery {
+// QueryReportedBy queries the reported_by edge of a Report.
+func (c *ReportClient) QueryReportedBy(_m *Report) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.From(report.Table, report.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invitation.CreatorTable, invitation.CreatorColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.ReportedByTable, report.ReportedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2968,15 +12753,15 @@ func (c *InvitationClient) QueryCreator(_m *Invitation) *AccountQuery {
 	return query
 }
 
-// QueryInvited queries the invited edge of a Invitation.
-func (c *InvitationClient) QueryInvited(_m *Invitation) *AccountQuery {
+// QueryHandledBy queries the handled_by edge of a Report.
+func (c *ReportClient) QueryHandledBy(_m *Report) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.From(report.Table, report.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, invitation.InvitedTable, invitation.InvitedColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.HandledByTable, report.HandledByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2985,131 +12770,131 @@ func (c *InvitationClient) QueryInvited(_m *Invitation) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *InvitationClient) Hooks() []Hook {
-	return c.hooks.Invitation
+func (c *ReportClient) Hooks() []Hook {
+	return c.hooks.Report
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvitationClient) Interceptors() []Interceptor {
-	return c.inters.Invitation
+func (c *ReportClient) Interceptors() []Interceptor {
+	return c.inters.Report
 }
 
-func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Report mutation op: %q", m.Op())
 	}
 }
 
-// LikePostClient is a client for the LikePost schema.
-type LikePostClient struct {
+// ReputationEntryClient is a client for the ReputationEntry schema.
+type ReputationEntryClient struct {
 	config
 }
 
-// NewLikePostClient returns a client for the LikePost from the given config.
-func NewLikePostClient(c config) *LikePostClient {
-	return &LikePostClient{config: c}
+// NewReputationEntryClient returns a client for the ReputationEntry from the given config.
+func NewReputationEntryClient(c config) *ReputationEntryClient {
+	return &ReputationEntryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `likepost.Hooks(f(g(h())))`.
-func (c *LikePostClient) Use(hooks ...Hook) {
-	c.hooks.LikePost = append(c.hooks.LikePost, hooks...)
+// A call to `Use(f, g, h)` equals to `reputationentry.Hooks(f(g(h())))`.
+func (c *ReputationEntryClient) Use(hooks ...Hook) {
+	c.hooks.ReputationEntry = append(c.hooks.ReputationEntry, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `likepost.Intercept(f(g(h())))`.
-func (c *LikePostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LikePost = append(c.inters.LikePost, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `reputationentry.Intercept(f(g(h())))`.
+func (c *ReputationEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReputationEntry = append(c.inters.ReputationEntry, interceptors...)
 }
 
-// Create returns a builder for creating a LikePost entity.
-func (c *LikePostClient) Create() *LikePostCreate {
-	mutation := newLikePostMutation(c.config, OpCreate)
-	return &LikePostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReputationEntry entity.
+func (c *ReputationEntryClient) Create() *ReputationEntryCreate {
+	mutation := newReputationEntryMutation(c.config, OpCreate)
+	return &ReputationEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LikePost entities.
-func (c *LikePostClient) CreateBulk(builders ...*LikePostCreate) *LikePostCreateBulk {
-	return &LikePostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReputationEntry entities.
+func (c *ReputationEntryClient) CreateBulk(builders ...*ReputationEntryCreate) *ReputationEntryCreateBulk {
+	return &ReputationEntryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LikePostClient) MapCreateBulk(slice any, setFunc func(*LikePostCreate, int)) *LikePostCreateBulk {
+func (c *ReputationEntryClient) MapCreateBulk(slice any, setFunc func(*ReputationEntryCreate, int)) *ReputationEntryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LikePostCreateBulk{err: fmt.Errorf("calling to LikePostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReputationEntryCreateBulk{err: fmt.Errorf("calling to ReputationEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LikePostCreate, rv.Len())
+	builders := make([]*ReputationEntryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LikePostCreateBulk{config: c.config, builders: builders}
+	return &ReputationEntryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LikePost.
-func (c *LikePostClient) Update() *LikePostUpdate {
-	mutation := newLikePostMutation(c.config, OpUpdate)
-	return &LikePostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReputationEntry.
+func (c *ReputationEntryClient) Update() *ReputationEntryUpdate {
+	mutation := newReputationEntryMutation(c.config, OpUpdate)
+	return &ReputationEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LikePostClient) UpdateOne(_m *LikePost) *LikePostUpdateOne {
-	mutation := newLikePostMutation(c.config, OpUpdateOne, withLikePost(_m))
-	return &LikePostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReputationEntryClient) UpdateOne(_m *ReputationEntry) *ReputationEntryUpdateOne {
+	mutation := newReputationEntryMutation(c.config, OpUpdateOne, withReputationEntry(_m))
+	return &ReputationEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LikePostClient) UpdateOneID(id xid.ID) *LikePostUpdateOne {
-	mutation := newLikePostMutation(c.config, OpUpdateOne, withLikePostID(id))
-	return &LikePostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReputationEntryClient) UpdateOneID(id xid.ID) *ReputationEntryUpdateOne {
+	mutation := newReputationEntryMutation(c.config, OpUpdateOne, withReputationEntryID(id))
+	return &ReputationEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LikePost.
-func (c *LikePostClient) Delete() *LikePostDelete {
-	mutation := newLikePostMutation(c.config, OpDelete)
-	return &LikePostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReputationEntry.
+func (c *ReputationEntryClient) Delete() *ReputationEntryDelete {
+	mutation := newReputationEntryMutation(c.config, OpDelete)
+	return &ReputationEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LikePostClient) DeleteOne(_m *LikePost) *LikePostDeleteOne {
+func (c *ReputationEntryClient) DeleteOne(_m *ReputationEntry) *ReputationEntryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LikePostClient) DeleteOneID(id xid.ID) *LikePostDeleteOne {
-	builder := c.Delete().Where(likepost.ID(id))
+func (c *ReputationEntryClient) DeleteOneID(id xid.ID) *ReputationEntryDeleteOne {
+	builder := c.Delete().Where(reputationentry.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LikePostDeleteOne{builder}
+	return &ReputationEntryDeleteOne{builder}
 }
 
-// Query returns a query builder for LikePost.
-func (c *LikePostClient) Query() *LikePostQuery {
-	return &LikePostQuery{
+// Query returns a query builder for ReputationEntry.
+func (c *ReputationEntryClient) Query() *ReputationEntryQuery {
+	return &ReputationEntryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLikePost},
+		ctx:    &QueryContext{Type: TypeReputationEntry},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LikePost entity by its id.
-func (c *LikePostClient) Get(ctx context.Context, id xid.ID) (*LikePost, error) {
-	return c.Query().Where(likepost.ID(id)).Only(ctx)
+// Get returns a ReputationEntry entity by its id.
+func (c *ReputationEntryClient) Get(ctx context.Context, id xid.ID) (*ReputationEntry, error) {
+	return c.Query().Where(reputationentry.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LikePostClient) GetX(ctx context.Context, id xid.ID) *LikePost {
+func (c *ReputationEntryClient) GetX(ctx context.Context, id xid.ID) *ReputationEntry {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3117,31 +12902,15 @@ func (c *LikePostClient) GetX(ctx context.Context, id xid.ID) *LikePost {
 	return obj
 }
 
-// QueryAccount queries the account edge of a LikePost.
-func (c *LikePostClient) QueryAccount(_m *LikePost) *AccountQuery {
+// QueryAccount queries the account edge of a ReputationEntry.
+func (c *ReputationEntryClient) QueryAccount(_m *ReputationEntry) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(likepost.Table, likepost.FieldID, id),
+			sqlgraph.From(reputationentry.Table, reputationentry.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, likepost.AccountTable, likepost.AccountColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPost queries the Post edge of a LikePost.
-func (c *LikePostClient) QueryPost(_m *LikePost) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(likepost.Table, likepost.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, likepost.PostTable, likepost.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, reputationentry.AccountTable, reputationentry.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3150,227 +12919,147 @@ func (c *LikePostClient) QueryPost(_m *LikePost) *PostQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LikePostClient) Hooks() []Hook {
-	return c.hooks.LikePost
+func (c *ReputationEntryClient) Hooks() []Hook {
+	return c.hooks.ReputationEntry
 }
 
 // Interceptors returns the client interceptors.
-func (c *LikePostClient) Interceptors() []Interceptor {
-	return c.inters.LikePost
+func (c *ReputationEntryClient) Interceptors() []Interceptor {
+	return c.inters.ReputationEntry
 }
 
-func (c *LikePostClient) mutate(ctx context.Context, m *LikePostMutation) (Value, error) {
+func (c *ReputationEntryClient) mutate(ctx context.Context, m *ReputationEntryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LikePostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReputationEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LikePostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReputationEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LikePostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReputationEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LikePostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReputationEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LikePost mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReputationEntry mutation op: %q", m.Op())
 	}
 }
 
-// LinkClient is a client for the Link schema.
-type LinkClient struct {
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
 	config
 }
 
-// NewLinkClient returns a client for the Link from the given config.
-func NewLinkClient(c config) *LinkClient {
-	return &LinkClient{config: c}
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `link.Hooks(f(g(h())))`.
-func (c *LinkClient) Use(hooks ...Hook) {
-	c.hooks.Link = append(c.hooks.Link, hooks...)
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `link.Intercept(f(g(h())))`.
-func (c *LinkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Link = append(c.inters.Link, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
 }
 
-// Create returns a builder for creating a Link entity.
-func (c *LinkClient) Create() *LinkCreate {
-	mutation := newLinkMutation(c.config, OpCreate)
-	return &LinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Link entities.
-func (c *LinkClient) CreateBulk(builders ...*LinkCreate) *LinkCreateBulk {
-	return &LinkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LinkClient) MapCreateBulk(slice any, setFunc func(*LinkCreate, int)) *LinkCreateBulk {
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LinkCreateBulk{err: fmt.Errorf("calling to LinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LinkCreate, rv.Len())
+	builders := make([]*RoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LinkCreateBulk{config: c.config, builders: builders}
+	return &RoleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Link.
-func (c *LinkClient) Update() *LinkUpdate {
-	mutation := newLinkMutation(c.config, OpUpdate)
-	return &LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LinkClient) UpdateOne(_m *Link) *LinkUpdateOne {
-	mutation := newLinkMutation(c.config, OpUpdateOne, withLink(_m))
-	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LinkClient) UpdateOneID(id xid.ID) *LinkUpdateOne {
-	mutation := newLinkMutation(c.config, OpUpdateOne, withLinkID(id))
-	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleClient) UpdateOneID(id xid.ID) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Link.
-func (c *LinkClient) Delete() *LinkDelete {
-	mutation := newLinkMutation(c.config, OpDelete)
-	return &LinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LinkClient) DeleteOne(_m *Link) *LinkDeleteOne {
-	return c.DeleteOneID(_m.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LinkClient) DeleteOneID(id xid.ID) *LinkDeleteOne {
-	builder := c.Delete().Where(link.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &LinkDeleteOne{builder}
-}
-
-// Query returns a query builder for Link.
-func (c *LinkClient) Query() *LinkQuery {
-	return &LinkQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeLink},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a Link entity by its id.
-func (c *LinkClient) Get(ctx context.Context, id xid.ID) (*Link, error) {
-	return c.Query().Where(link.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *LinkClient) GetX(ctx context.Context, id xid.ID) *Link {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryPosts queries the posts edge of a Link.
-func (c *LinkClient) QueryPosts(_m *Link) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, link.PostsTable, link.PostsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPostContentReferences queries the post_content_references edge of a Link.
-func (c *LinkClient) QueryPostContentReferences(_m *Link) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, link.PostContentReferencesTable, link.PostContentReferencesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
-// QueryNodes queries the nodes edge of a Link.
-func (c *LinkClient) QueryNodes(_m *Link) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, link.NodesTable, link.NodesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id xid.ID) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
 }
 
-// QueryNodeContentReferences queries the node_content_references edge of a Link.
-func (c *LinkClient) QueryNodeContentReferences(_m *Link) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, link.NodeContentReferencesTable, link.NodeContentReferencesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryPrimaryImage queries the primary_image edge of a Link.
-func (c *LinkClient) QueryPrimaryImage(_m *Link) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, link.PrimaryImageTable, link.PrimaryImageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id xid.ID) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id xid.ID) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryFaviconImage queries the favicon_image edge of a Link.
-func (c *LinkClient) QueryFaviconImage(_m *Link) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
+// QueryAccounts queries the accounts edge of a Role.
+func (c *RoleClient) QueryAccounts(_m *Role) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, link.FaviconImageTable, link.FaviconImageColumn),
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, role.AccountsTable, role.AccountsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3378,15 +13067,15 @@ func (c *LinkClient) QueryFaviconImage(_m *Link) *AssetQuery {
 	return query
 }
 
-// QueryAssets queries the assets edge of a Link.
-func (c *LinkClient) QueryAssets(_m *Link) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
+// QueryAccountRoles queries the account_roles edge of a Role.
+func (c *RoleClient) QueryAccountRoles(_m *Role) *AccountRolesQuery {
+	query := (&AccountRolesClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, link.AssetsTable, link.AssetsPrimaryKey...),
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(accountroles.Table, accountroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, role.AccountRolesTable, role.AccountRolesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3395,131 +13084,131 @@ func (c *LinkClient) QueryAssets(_m *Link) *AssetQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LinkClient) Hooks() []Hook {
-	return c.hooks.Link
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
 }
 
 // Interceptors returns the client interceptors.
-func (c *LinkClient) Interceptors() []Interceptor {
-	return c.inters.Link
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
 }
 
-func (c *LinkClient) mutate(ctx context.Context, m *LinkMutation) (Value, error) {
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Link mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
 	}
 }
 
-// MentionProfileClient is a client for the MentionProfile schema.
-type MentionProfileClient struct {
+// ScheduledTaskClient is a client for the ScheduledTask schema.
+type ScheduledTaskClient struct {
 	config
 }
 
-// NewMentionProfileClient returns a client for the MentionProfile from the given config.
-func NewMentionProfileClient(c config) *MentionProfileClient {
-	return &MentionProfileClient{config: c}
+// NewScheduledTaskClient returns a client for the ScheduledTask from the given config.
+func NewScheduledTaskClient(c config) *ScheduledTaskClient {
+	return &ScheduledTaskClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `mentionprofile.Hooks(f(g(h())))`.
-func (c *MentionProfileClient) Use(hooks ...Hook) {
-	c.hooks.MentionProfile = append(c.hooks.MentionProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `scheduledtask.Hooks(f(g(h())))`.
+func (c *ScheduledTaskClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledTask = append(c.hooks.ScheduledTask, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `mentionprofile.Intercept(f(g(h())))`.
-func (c *MentionProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MentionProfile = append(c.inters.MentionProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scheduledtask.Intercept(f(g(h())))`.
+func (c *ScheduledTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledTask = append(c.inters.ScheduledTask, interceptors...)
 }
 
-// Create returns a builder for creating a MentionProfile entity.
-func (c *MentionProfileClient) Create() *MentionProfileCreate {
-	mutation := newMentionProfileMutation(c.config, OpCreate)
-	return &MentionProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScheduledTask entity.
+func (c *ScheduledTaskClient) Create() *ScheduledTaskCreate {
+	mutation := newScheduledTaskMutation(c.config, OpCreate)
+	return &ScheduledTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MentionProfile entities.
-func (c *MentionProfileClient) CreateBulk(builders ...*MentionProfileCreate) *MentionProfileCreateBulk {
-	return &MentionProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScheduledTask entities.
+func (c *ScheduledTaskClient) CreateBulk(builders ...*ScheduledTaskCreate) *ScheduledTaskCreateBulk {
+	return &ScheduledTaskCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MentionProfileClient) MapCreateBulk(slice any, setFunc func(*MentionProfileCreate, int)) *MentionProfileCreateBulk {
+func (c *ScheduledTaskClient) MapCreateBulk(slice any, setFunc func(*ScheduledTaskCreate, int)) *ScheduledTaskCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MentionProfileCreateBulk{err: fmt.Errorf("calling to MentionProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScheduledTaskCreateBulk{err: fmt.Errorf("calling to ScheduledTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MentionProfileCreate, rv.Len())
+	builders := make([]*ScheduledTaskCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MentionProfileCreateBulk{config: c.config, builders: builders}
+	return &ScheduledTaskCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MentionProfile.
-func (c *MentionProfileClient) Update() *MentionProfileUpdate {
-	mutation := newMentionProfileMutation(c.config, OpUpdate)
-	return &MentionProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScheduledTask.
+func (c *ScheduledTaskClient) Update() *ScheduledTaskUpdate {
+	mutation := newScheduledTaskMutation(c.config, OpUpdate)
+	return &ScheduledTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MentionProfileClient) UpdateOne(_m *MentionProfile) *MentionProfileUpdateOne {
-	mutation := newMentionProfileMutation(c.config, OpUpdateOne, withMentionProfile(_m))
-	return &MentionProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledTaskClient) UpdateOne(_m *ScheduledTask) *ScheduledTaskUpdateOne {
+	mutation := newScheduledTaskMutation(c.config, OpUpdateOne, withScheduledTask(_m))
+	return &ScheduledTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MentionProfileClient) UpdateOneID(id xid.ID) *MentionProfileUpdateOne {
-	mutation := newMentionProfileMutation(c.config, OpUpdateOne, withMentionProfileID(id))
-	return &MentionProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledTaskClient) UpdateOneID(id xid.ID) *ScheduledTaskUpdateOne {
+	mutation := newScheduledTaskMutation(c.config, OpUpdateOne, withScheduledTaskID(id))
+	return &ScheduledTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MentionProfile.
-func (c *MentionProfileClient) Delete() *MentionProfileDelete {
-	mutation := newMentionProfileMutation(c.config, OpDelete)
-	return &MentionProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScheduledTask.
+func (c *ScheduledTaskClient) Delete() *ScheduledTaskDelete {
+	mutation := newScheduledTaskMutation(c.config, OpDelete)
+	return &ScheduledTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MentionProfileClient) DeleteOne(_m *MentionProfile) *MentionProfileDeleteOne {
+func (c *ScheduledTaskClient) DeleteOne(_m *ScheduledTask) *ScheduledTaskDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MentionProfileClient) DeleteOneID(id xid.ID) *MentionProfileDeleteOne {
-	builder := c.Delete().Where(mentionprofile.ID(id))
+func (c *ScheduledTaskClient) DeleteOneID(id xid.ID) *ScheduledTaskDeleteOne {
+	builder := c.Delete().Where(scheduledtask.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MentionProfileDeleteOne{builder}
+	return &ScheduledTaskDeleteOne{builder}
 }
 
-// Query returns a query builder for MentionProfile.
-func (c *MentionProfileClient) Query() *MentionProfileQuery {
-	return &MentionProfileQuery{
+// Query returns a query builder for ScheduledTask.
+func (c *ScheduledTaskClient) Query() *ScheduledTaskQuery {
+	return &ScheduledTaskQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMentionProfile},
+		ctx:    &QueryContext{Type: TypeScheduledTask},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MentionProfile entity by its id.
-func (c *MentionProfileClient) Get(ctx context.Context, id xid.ID) (*MentionProfile, error) {
-	return c.Query().Where(mentionprofile.ID(id)).Only(ctx)
+// Get returns a ScheduledTask entity by its id.
+func (c *ScheduledTaskClient) Get(ctx context.Context, id xid.ID) (*ScheduledTask, error) {
+	return c.Query().Where(scheduledtask.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MentionProfileClient) GetX(ctx context.Context, id xid.ID) *MentionProfile {
+func (c *ScheduledTaskClient) GetX(ctx context.Context, id xid.ID) *ScheduledTask {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3527,164 +13216,132 @@ func (c *MentionProfileClient) GetX(ctx context.Context, id xid.ID) *MentionProf
 	return obj
 }
 
-// QueryAccount queries the account edge of a MentionProfile.
-func (c *MentionProfileClient) QueryAccount(_m *MentionProfile) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mentionprofile.Table, mentionprofile.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, mentionprofile.AccountTable, mentionprofile.AccountColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPost queries the Post edge of a MentionProfile.
-func (c *MentionProfileClient) QueryPost(_m *MentionProfile) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mentionprofile.Table, mentionprofile.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, mentionprofile.PostTable, mentionprofile.PostColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *MentionProfileClient) Hooks() []Hook {
-	return c.hooks.MentionProfile
+func (c *ScheduledTaskClient) Hooks() []Hook {
+	return c.hooks.ScheduledTask
 }
 
 // Interceptors returns the client interceptors.
-func (c *MentionProfileClient) Interceptors() []Interceptor {
-	return c.inters.MentionProfile
+func (c *ScheduledTaskClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledTask
 }
 
-func (c *MentionProfileClient) mutate(ctx context.Context, m *MentionProfileMutation) (Value, error) {
+func (c *ScheduledTaskClient) mutate(ctx context.Context, m *ScheduledTaskMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MentionProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MentionProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MentionProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MentionProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScheduledTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MentionProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScheduledTask mutation op: %q", m.Op())
 	}
 }
 
-// NodeClient is a client for the Node schema.
-type NodeClient struct {
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
 	config
 }
 
-// NewNodeClient returns a client for the Node from the given config.
-func NewNodeClient(c config) *NodeClient {
-	return &NodeClient{config: c}
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `node.Hooks(f(g(h())))`.
-func (c *NodeClient) Use(hooks ...Hook) {
-	c.hooks.Node = append(c.hooks.Node, hooks...)
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `node.Intercept(f(g(h())))`.
-func (c *NodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Node = append(c.inters.Node, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
 }
 
-// Create returns a builder for creating a Node entity.
-func (c *NodeClient) Create() *NodeCreate {
-	mutation := newNodeMutation(c.config, OpCreate)
-	return &NodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Node entities.
-func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
-	return &NodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NodeClient) MapCreateBulk(slice any, setFunc func(*NodeCreate, int)) *NodeCreateBulk {
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NodeCreateBulk{err: fmt.Errorf("calling to NodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NodeCreate, rv.Len())
+	builders := make([]*SessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NodeCreateBulk{config: c.config, builders: builders}
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Node.
-func (c *NodeClient) Update() *NodeUpdate {
-	mutation := newNodeMutation(c.config, OpUpdate)
-	return &NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NodeClient) UpdateOne(_m *Node) *NodeUpdateOne {
-	mutation := newNodeMutation(c.config, OpUpdateOne, withNode(_m))
-	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOne(_m *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(_m))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NodeClient) UpdateOneID(id xid.ID) *NodeUpdateOne {
-	mutation := newNodeMutation(c.config, OpUpdateOne, withNodeID(id))
-	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOneID(id xid.ID) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Node.
-func (c *NodeClient) Delete() *NodeDelete {
-	mutation := newNodeMutation(c.config, OpDelete)
-	return &NodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NodeClient) DeleteOne(_m *Node) *NodeDeleteOne {
+func (c *SessionClient) DeleteOne(_m *Session) *SessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NodeClient) DeleteOneID(id xid.ID) *NodeDeleteOne {
-	builder := c.Delete().Where(node.ID(id))
+func (c *SessionClient) DeleteOneID(id xid.ID) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NodeDeleteOne{builder}
+	return &SessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Node.
-func (c *NodeClient) Query() *NodeQuery {
-	return &NodeQuery{
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNode},
+		ctx:    &QueryContext{Type: TypeSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Node entity by its id.
-func (c *NodeClient) Get(ctx context.Context, id xid.ID) (*Node, error) {
-	return c.Query().Where(node.ID(id)).Only(ctx)
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id xid.ID) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NodeClient) GetX(ctx context.Context, id xid.ID) *Node {
+func (c *SessionClient) GetX(ctx context.Context, id xid.ID) *Session {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3692,191 +13349,15 @@ func (c *NodeClient) GetX(ctx context.Context, id xid.ID) *Node {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Node.
-func (c *NodeClient) QueryOwner(_m *Node) *AccountQuery {
+// QueryAccount queries the account edge of a Session.
+func (c *SessionClient) QueryAccount(_m *Session) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.From(session.Table, session.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, node.OwnerTable, node.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryParent queries the parent edge of a Node.
-func (c *NodeClient) QueryParent(_m *Node) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, node.ParentTable, node.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryNodes queries the nodes edge of a Node.
-func (c *NodeClient) QueryNodes(_m *Node) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, node.NodesTable, node.NodesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPrimaryImage queries the primary_image edge of a Node.
-func (c *NodeClient) QueryPrimaryImage(_m *Node) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, node.PrimaryImageTable, node.PrimaryImageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAssets queries the assets edge of a Node.
-func (c *NodeClient) QueryAssets(_m *Node) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, node.AssetsTable, node.AssetsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTags queries the tags edge of a Node.
-func (c *NodeClient) QueryTags(_m *Node) *TagQuery {
-	query := (&TagClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, node.TagsTable, node.TagsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProperties queries the properties edge of a Node.
-func (c *NodeClient) QueryProperties(_m *Node) *PropertyQuery {
-	query := (&PropertyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(property.Table, property.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, node.PropertiesTable, node.PropertiesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPropertySchema queries the property_schema edge of a Node.
-func (c *NodeClient) QueryPropertySchema(_m *Node) *PropertySchemaQuery {
-	query := (&PropertySchemaClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(propertyschema.Table, propertyschema.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, node.PropertySchemaTable, node.PropertySchemaColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLink queries the link edge of a Node.
-func (c *NodeClient) QueryLink(_m *Node) *LinkQuery {
-	query := (&LinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(link.Table, link.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, node.LinkTable, node.LinkColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryContentLinks queries the content_links edge of a Node.
-func (c *NodeClient) QueryContentLinks(_m *Node) *LinkQuery {
-	query := (&LinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(link.Table, link.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, node.ContentLinksTable, node.ContentLinksPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCollections queries the collections edge of a Node.
-func (c *NodeClient) QueryCollections(_m *Node) *CollectionQuery {
-	query := (&CollectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(collection.Table, collection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, node.CollectionsTable, node.CollectionsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCollectionNodes queries the collection_nodes edge of a Node.
-func (c *NodeClient) QueryCollectionNodes(_m *Node) *CollectionNodeQuery {
-	query := (&CollectionNodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(collectionnode.Table, collectionnode.NodeColumn),
-			sqlgraph.Edge(sqlgraph.O2M, true, node.CollectionNodesTable, node.CollectionNodesColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.AccountTable, session.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3885,131 +13366,131 @@ func (c *NodeClient) QueryCollectionNodes(_m *Node) *CollectionNodeQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *NodeClient) Hooks() []Hook {
-	return c.hooks.Node
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
 }
 
 // Interceptors returns the client interceptors.
-func (c *NodeClient) Interceptors() []Interceptor {
-	return c.inters.Node
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
 }
 
-func (c *NodeClient) mutate(ctx context.Context, m *NodeMutation) (Value, error) {
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Node mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
 	}
 }
 
-// NotificationClient is a client for the Notification schema.
-type NotificationClient struct {
+// SettingClient is a client for the Setting schema.
+type SettingClient struct {
 	config
 }
 
-// NewNotificationClient returns a client for the Notification from the given config.
-func NewNotificationClient(c config) *NotificationClient {
-	return &NotificationClient{config: c}
+// NewSettingClient returns a client for the Setting from the given config.
+func NewSettingClient(c config) *SettingClient {
+	return &SettingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
-func (c *NotificationClient) Use(hooks ...Hook) {
-	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
+func (c *SettingClient) Use(hooks ...Hook) {
+	c.hooks.Setting = append(c.hooks.Setting, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
-func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notification = append(c.inters.Notification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
+func (c *SettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Setting = append(c.inters.Setting, interceptors...)
 }
 
-// Create returns a builder for creating a Notification entity.
-func (c *NotificationClient) Create() *NotificationCreate {
-	mutation := newNotificationMutation(c.config, OpCreate)
-	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Setting entity.
+func (c *SettingClient) Create() *SettingCreate {
+	mutation := newSettingMutation(c.config, OpCreate)
+	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notification entities.
-func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Setting entities.
+func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
+	return &SettingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationCreate, rv.Len())
+	builders := make([]*SettingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+	return &SettingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notification.
-func (c *NotificationClient) Update() *NotificationUpdate {
-	mutation := newNotificationMutation(c.config, OpUpdate)
-	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Setting.
+func (c *SettingClient) Update() *SettingUpdate {
+	mutation := newSettingMutation(c.config, OpUpdate)
+	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SettingClient) UpdateOne(_m *Setting) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(_m))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationClient) UpdateOneID(id xid.ID) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SettingClient) UpdateOneID(id string) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notification.
-func (c *NotificationClient) Delete() *NotificationDelete {
-	mutation := newNotificationMutation(c.config, OpDelete)
-	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Setting.
+func (c *SettingClient) Delete() *SettingDelete {
+	mutation := newSettingMutation(c.config, OpDelete)
+	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+func (c *SettingClient) DeleteOne(_m *Setting) *SettingDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationClient) DeleteOneID(id xid.ID) *NotificationDeleteOne {
-	builder := c.Delete().Where(notification.ID(id))
+func (c *SettingClient) DeleteOneID(id string) *SettingDeleteOne {
+	builder := c.Delete().Where(setting.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationDeleteOne{builder}
+	return &SettingDeleteOne{builder}
 }
 
-// Query returns a query builder for Notification.
-func (c *NotificationClient) Query() *NotificationQuery {
-	return &NotificationQuery{
+// Query returns a query builder for Setting.
+func (c *SettingClient) Query() *SettingQuery {
+	return &SettingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotification},
+		ctx:    &QueryContext{Type: TypeSetting},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notification entity by its id.
-func (c *NotificationClient) Get(ctx context.Context, id xid.ID) (*Notification, error) {
-	return c.Query().Where(notification.ID(id)).Only(ctx)
+// Get returns a Setting entity by its id.
+func (c *SettingClient) Get(ctx context.Context, id string) (*Setting, error) {
+	return c.Query().Where(setting.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationClient) GetX(ctx context.Context, id xid.ID) *Notification {
+func (c *SettingClient) GetX(ctx context.Context, id string) *Setting {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4017,260 +13498,148 @@ func (c *NotificationClient) GetX(ctx context.Context, id xid.ID) *Notification
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Notification.
-func (c *NotificationClient) QueryOwner(_m *Notification) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notification.OwnerTable, notification.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySource queries the source edge of a Notification.
-func (c *NotificationClient) QuerySource(_m *Notification) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notification.SourceTable, notification.SourceColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *NotificationClient) Hooks() []Hook {
-	return c.hooks.Notification
+func (c *SettingClient) Hooks() []Hook {
+	return c.hooks.Setting
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationClient) Interceptors() []Interceptor {
-	return c.inters.Notification
+func (c *SettingClient) Interceptors() []Interceptor {
+	return c.inters.Setting
 }
 
-func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
 	}
 }
 
-// PostClient is a client for the Post schema.
-type PostClient struct {
+// SpaceClient is a client for the Space schema.
+type SpaceClient struct {
 	config
 }
 
-// NewPostClient returns a client for the Post from the given config.
-func NewPostClient(c config) *PostClient {
-	return &PostClient{config: c}
+// NewSpaceClient returns a client for the Space from the given config.
+func NewSpaceClient(c config) *SpaceClient {
+	return &SpaceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
-func (c *PostClient) Use(hooks ...Hook) {
-	c.hooks.Post = append(c.hooks.Post, hooks...)
+// A call to `Use(f, g, h)` equals to `space.Hooks(f(g(h())))`.
+func (c *SpaceClient) Use(hooks ...Hook) {
+	c.hooks.Space = append(c.hooks.Space, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
-func (c *PostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Post = append(c.inters.Post, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `space.Intercept(f(g(h())))`.
+func (c *SpaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Space = append(c.inters.Space, interceptors...)
 }
 
-// Create returns a builder for creating a Post entity.
-func (c *PostClient) Create() *PostCreate {
-	mutation := newPostMutation(c.config, OpCreate)
-	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Space entity.
+func (c *SpaceClient) Create() *SpaceCreate {
+	mutation := newSpaceMutation(c.config, OpCreate)
+	return &SpaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Post entities.
-func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
-	return &PostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Space entities.
+func (c *SpaceClient) CreateBulk(builders ...*SpaceCreate) *SpaceCreateBulk {
+	return &SpaceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+func (c *SpaceClient) MapCreateBulk(slice any, setFunc func(*SpaceCreate, int)) *SpaceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SpaceCreateBulk{err: fmt.Errorf("calling to SpaceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostCreate, rv.Len())
+	builders := make([]*SpaceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostCreateBulk{config: c.config, builders: builders}
+	return &SpaceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Post.
-func (c *PostClient) Update() *PostUpdate {
-	mutation := newPostMutation(c.config, OpUpdate)
-	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Space.
+func (c *SpaceClient) Update() *SpaceUpdate {
+	mutation := newSpaceMutation(c.config, OpUpdate)
+	return &SpaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostClient) UpdateOne(_m *Post) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPost(_m))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SpaceClient) UpdateOne(_m *Space) *SpaceUpdateOne {
+	mutation := newSpaceMutation(c.config, OpUpdateOne, withSpace(_m))
+	return &SpaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostClient) UpdateOneID(id xid.ID) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SpaceClient) UpdateOneID(id xid.ID) *SpaceUpdateOne {
+	mutation := newSpaceMutation(c.config, OpUpdateOne, withSpaceID(id))
+	return &SpaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Post.
-func (c *PostClient) Delete() *PostDelete {
-	mutation := newPostMutation(c.config, OpDelete)
-	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Space.
+func (c *SpaceClient) Delete() *SpaceDelete {
+	mutation := newSpaceMutation(c.config, OpDelete)
+	return &SpaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostClient) DeleteOne(_m *Post) *PostDeleteOne {
+func (c *SpaceClient) DeleteOne(_m *Space) *SpaceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostClient) DeleteOneID(id xid.ID) *PostDeleteOne {
-	builder := c.Delete().Where(post.ID(id))
+func (c *SpaceClient) DeleteOneID(id xid.ID) *SpaceDeleteOne {
+	builder := c.Delete().Where(space.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostDeleteOne{builder}
+	return &SpaceDeleteOne{builder}
 }
 
-// Query returns a query builder for Post.
-func (c *PostClient) Query() *PostQuery {
-	return &PostQuery{
+// Query returns a query builder for Space.
+func (c *SpaceClient) Query() *SpaceQuery {
+	return &SpaceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePost},
+		ctx:    &QueryContext{Type: TypeSpace},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Post entity by its id.
-func (c *PostClient) Get(ctx context.Context, id xid.ID) (*Post, error) {
-	return c.Query().Where(post.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *PostClient) GetX(ctx context.Context, id xid.ID) *Post {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryAuthor queries the author edge of a Post.
-func (c *PostClient) QueryAuthor(_m *Post) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.AuthorTable, post.AuthorColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCategory queries the category edge of a Post.
-func (c *PostClient) QueryCategory(_m *Post) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.CategoryTable, post.CategoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTags queries the tags edge of a Post.
-func (c *PostClient) QueryTags(_m *Post) *TagQuery {
-	query := (&TagClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, post.TagsTable, post.TagsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRoot queries the root edge of a Post.
-func (c *PostClient) QueryRoot(_m *Post) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.RootTable, post.RootColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPosts queries the posts edge of a Post.
-func (c *PostClient) QueryPosts(_m *Post) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.PostsTable, post.PostsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a Space entity by its id.
+func (c *SpaceClient) Get(ctx context.Context, id xid.ID) (*Space, error) {
+	return c.Query().Where(space.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpaceClient) GetX(ctx context.Context, id xid.ID) *Space {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryReplyTo queries the replyTo edge of a Post.
-func (c *PostClient) QueryReplyTo(_m *Post) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
+// QueryMembers queries the members edge of a Space.
+func (c *SpaceClient) QueryMembers(_m *Space) *SpaceMemberQuery {
+	query := (&SpaceMemberClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.ReplyToTable, post.ReplyToColumn),
+			sqlgraph.From(space.Table, space.FieldID, id),
+			sqlgraph.To(spacemember.Table, spacemember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, space.MembersTable, space.MembersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4278,15 +13647,15 @@ func (c *PostClient) QueryReplyTo(_m *Post) *PostQuery {
 	return query
 }
 
-// QueryReplies queries the replies edge of a Post.
-func (c *PostClient) QueryReplies(_m *Post) *PostQuery {
+// QueryPosts queries the posts edge of a Space.
+func (c *SpaceClient) QueryPosts(_m *Space) *PostQuery {
 	query := (&PostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.From(space.Table, space.FieldID, id),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.RepliesTable, post.RepliesColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, space.PostsTable, space.PostsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4294,127 +13663,148 @@ func (c *PostClient) QueryReplies(_m *Post) *PostQuery {
 	return query
 }
 
-// QueryReacts queries the reacts edge of a Post.
-func (c *PostClient) QueryReacts(_m *Post) *ReactQuery {
-	query := (&ReactClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(react.Table, react.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.ReactsTable, post.ReactsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *SpaceClient) Hooks() []Hook {
+	return c.hooks.Space
 }
 
-// QueryLikes queries the likes edge of a Post.
-func (c *PostClient) QueryLikes(_m *Post) *LikePostQuery {
-	query := (&LikePostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(likepost.Table, likepost.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.LikesTable, post.LikesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Interceptors returns the client interceptors.
+func (c *SpaceClient) Interceptors() []Interceptor {
+	return c.inters.Space
 }
 
-// QueryMentions queries the mentions edge of a Post.
-func (c *PostClient) QueryMentions(_m *Post) *MentionProfileQuery {
-	query := (&MentionProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(mentionprofile.Table, mentionprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.MentionsTable, post.MentionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+func (c *SpaceClient) mutate(ctx context.Context, m *SpaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Space mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryAssets queries the assets edge of a Post.
-func (c *PostClient) QueryAssets(_m *Post) *AssetQuery {
-	query := (&AssetClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(asset.Table, asset.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, post.AssetsTable, post.AssetsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// SpaceMemberClient is a client for the SpaceMember schema.
+type SpaceMemberClient struct {
+	config
 }
 
-// QueryCollections queries the collections edge of a Post.
-func (c *PostClient) QueryCollections(_m *Post) *CollectionQuery {
-	query := (&CollectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(collection.Table, collection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, post.CollectionsTable, post.CollectionsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// NewSpaceMemberClient returns a client for the SpaceMember from the given config.
+func NewSpaceMemberClient(c config) *SpaceMemberClient {
+	return &SpaceMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spacemember.Hooks(f(g(h())))`.
+func (c *SpaceMemberClient) Use(hooks ...Hook) {
+	c.hooks.SpaceMember = append(c.hooks.SpaceMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spacemember.Intercept(f(g(h())))`.
+func (c *SpaceMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpaceMember = append(c.inters.SpaceMember, interceptors...)
+}
+
+// Create returns a builder for creating a SpaceMember entity.
+func (c *SpaceMemberClient) Create() *SpaceMemberCreate {
+	mutation := newSpaceMemberMutation(c.config, OpCreate)
+	return &SpaceMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpaceMember entities.
+func (c *SpaceMemberClient) CreateBulk(builders ...*SpaceMemberCreate) *SpaceMemberCreateBulk {
+	return &SpaceMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpaceMemberClient) MapCreateBulk(slice any, setFunc func(*SpaceMemberCreate, int)) *SpaceMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpaceMemberCreateBulk{err: fmt.Errorf("calling to SpaceMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*SpaceMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpaceMemberCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryLink queries the link edge of a Post.
-func (c *PostClient) QueryLink(_m *Post) *LinkQuery {
-	query := (&LinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(link.Table, link.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.LinkTable, post.LinkColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for SpaceMember.
+func (c *SpaceMemberClient) Update() *SpaceMemberUpdate {
+	mutation := newSpaceMemberMutation(c.config, OpUpdate)
+	return &SpaceMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpaceMemberClient) UpdateOne(_m *SpaceMember) *SpaceMemberUpdateOne {
+	mutation := newSpaceMemberMutation(c.config, OpUpdateOne, withSpaceMember(_m))
+	return &SpaceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpaceMemberClient) UpdateOneID(id xid.ID) *SpaceMemberUpdateOne {
+	mutation := newSpaceMemberMutation(c.config, OpUpdateOne, withSpaceMemberID(id))
+	return &SpaceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SpaceMember.
+func (c *SpaceMemberClient) Delete() *SpaceMemberDelete {
+	mutation := newSpaceMemberMutation(c.config, OpDelete)
+	return &SpaceMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpaceMemberClient) DeleteOne(_m *SpaceMember) *SpaceMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpaceMemberClient) DeleteOneID(id xid.ID) *SpaceMemberDeleteOne {
+	builder := c.Delete().Where(spacemember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpaceMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for SpaceMember.
+func (c *SpaceMemberClient) Query() *SpaceMemberQuery {
+	return &SpaceMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpaceMember},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryContentLinks queries the content_links edge of a Post.
-func (c *PostClient) QueryContentLinks(_m *Post) *LinkQuery {
-	query := (&LinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(link.Table, link.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, post.ContentLinksTable, post.ContentLinksPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a SpaceMember entity by its id.
+func (c *SpaceMemberClient) Get(ctx context.Context, id xid.ID) (*SpaceMember, error) {
+	return c.Query().Where(spacemember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpaceMemberClient) GetX(ctx context.Context, id xid.ID) *SpaceMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryEvent queries the event edge of a Post.
-func (c *PostClient) QueryEvent(_m *Post) *EventQuery {
-	query := (&EventClient{config: c.config}).Query()
+// QueryAccount queries the account edge of a SpaceMember.
+func (c *SpaceMemberClient) QueryAccount(_m *SpaceMember) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(event.Table, event.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.EventTable, post.EventColumn),
+			sqlgraph.From(spacemember.Table, spacemember.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, spacemember.AccountTable, spacemember.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4422,15 +13812,15 @@ func (c *PostClient) QueryEvent(_m *Post) *EventQuery {
 	return query
 }
 
-// QueryPostReads queries the post_reads edge of a Post.
-func (c *PostClient) QueryPostReads(_m *Post) *PostReadQuery {
-	query := (&PostReadClient{config: c.config}).Query()
+// QuerySpace queries the space edge of a SpaceMember.
+func (c *SpaceMemberClient) QuerySpace(_m *SpaceMember) *SpaceQuery {
+	query := (&SpaceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(postread.Table, postread.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.PostReadsTable, post.PostReadsColumn),
+			sqlgraph.From(spacemember.Table, spacemember.FieldID, id),
+			sqlgraph.To(space.Table, space.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, spacemember.SpaceTable, spacemember.SpaceColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4439,131 +13829,131 @@ func (c *PostClient) QueryPostReads(_m *Post) *PostReadQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PostClient) Hooks() []Hook {
-	return c.hooks.Post
+func (c *SpaceMemberClient) Hooks() []Hook {
+	return c.hooks.SpaceMember
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostClient) Interceptors() []Interceptor {
-	return c.inters.Post
+func (c *SpaceMemberClient) Interceptors() []Interceptor {
+	return c.inters.SpaceMember
 }
 
-func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+func (c *SpaceMemberClient) mutate(ctx context.Context, m *SpaceMemberMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpaceMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpaceMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpaceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SpaceMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SpaceMember mutation op: %q", m.Op())
 	}
 }
 
-// PostReadClient is a client for the PostRead schema.
-type PostReadClient struct {
+// TagClient is a client for the Tag schema.
+type TagClient struct {
 	config
 }
 
-// NewPostReadClient returns a client for the PostRead from the given config.
-func NewPostReadClient(c config) *PostReadClient {
-	return &PostReadClient{config: c}
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `postread.Hooks(f(g(h())))`.
-func (c *PostReadClient) Use(hooks ...Hook) {
-	c.hooks.PostRead = append(c.hooks.PostRead, hooks...)
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `postread.Intercept(f(g(h())))`.
-func (c *PostReadClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PostRead = append(c.inters.PostRead, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
 }
 
-// Create returns a builder for creating a PostRead entity.
-func (c *PostReadClient) Create() *PostReadCreate {
-	mutation := newPostReadMutation(c.config, OpCreate)
-	return &PostReadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PostRead entities.
-func (c *PostReadClient) CreateBulk(builders ...*PostReadCreate) *PostReadCreateBulk {
-	return &PostReadCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostReadClient) MapCreateBulk(slice any, setFunc func(*PostReadCreate, int)) *PostReadCreateBulk {
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostReadCreateBulk{err: fmt.Errorf("calling to PostReadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostReadCreate, rv.Len())
+	builders := make([]*TagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostReadCreateBulk{config: c.config, builders: builders}
-}
-
-// Update returns an update builder for PostRead.
-func (c *PostReadClient) Update() *PostReadUpdate {
-	mutation := newPostReadMutation(c.config, OpUpdate)
-	return &PostReadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostReadClient) UpdateOne(_m *PostRead) *PostReadUpdateOne {
-	mutation := newPostReadMutation(c.config, OpUpdateOne, withPostRead(_m))
-	return &PostReadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostReadClient) UpdateOneID(id xid.ID) *PostReadUpdateOne {
-	mutation := newPostReadMutation(c.config, OpUpdateOne, withPostReadID(id))
-	return &PostReadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOneID(id xid.ID) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PostRead.
-func (c *PostReadClient) Delete() *PostReadDelete {
-	mutation := newPostReadMutation(c.config, OpDelete)
-	return &PostReadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostReadClient) DeleteOne(_m *PostRead) *PostReadDeleteOne {
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostReadClient) DeleteOneID(id xid.ID) *PostReadDeleteOne {
-	builder := c.Delete().Where(postread.ID(id))
+func (c *TagClient) DeleteOneID(id xid.ID) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostReadDeleteOne{builder}
+	return &TagDeleteOne{builder}
 }
 
-// Query returns a query builder for PostRead.
-func (c *PostReadClient) Query() *PostReadQuery {
-	return &PostReadQuery{
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePostRead},
+		ctx:    &QueryContext{Type: TypeTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PostRead entity by its id.
-func (c *PostReadClient) Get(ctx context.Context, id xid.ID) (*PostRead, error) {
-	return c.Query().Where(postread.ID(id)).Only(ctx)
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id xid.ID) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostReadClient) GetX(ctx context.Context, id xid.ID) *PostRead {
+func (c *TagClient) GetX(ctx context.Context, id xid.ID) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4571,15 +13961,15 @@ func (c *PostReadClient) GetX(ctx context.Context, id xid.ID) *PostRead {
 	return obj
 }
 
-// QueryRootPost queries the root_post edge of a PostRead.
-func (c *PostReadClient) QueryRootPost(_m *PostRead) *PostQuery {
+// QueryPosts queries the posts edge of a Tag.
+func (c *TagClient) QueryPosts(_m *Tag) *PostQuery {
 	query := (&PostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(postread.Table, postread.FieldID, id),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, postread.RootPostTable, postread.RootPostColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.PostsTable, tag.PostsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4587,15 +13977,111 @@ func (c *PostReadClient) QueryRootPost(_m *PostRead) *PostQuery {
 	return query
 }
 
-// QueryAccount queries the account edge of a PostRead.
-func (c *PostReadClient) QueryAccount(_m *PostRead) *AccountQuery {
+// QueryNodes queries the nodes edge of a Tag.
+func (c *TagClient) QueryNodes(_m *Tag) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.NodesTable, tag.NodesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccounts queries the accounts edge of a Tag.
+func (c *TagClient) QueryAccounts(_m *Tag) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(postread.Table, postread.FieldID, id),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, postread.AccountTable, postread.AccountColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.AccountsTable, tag.AccountsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFollowers queries the followers edge of a Tag.
+func (c *TagClient) QueryFollowers(_m *Tag) *TagFollowQuery {
+	query := (&TagFollowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tagfollow.Table, tagfollow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.FollowersTable, tag.FollowersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Tag.
+func (c *TagClient) QueryParent(_m *Tag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.ParentTable, tag.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Tag.
+func (c *TagClient) QueryChildren(_m *Tag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.ChildrenTable, tag.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySynonyms queries the synonyms edge of a Tag.
+func (c *TagClient) QuerySynonyms(_m *Tag) *TagSynonymQuery {
+	query := (&TagSynonymClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tagsynonym.Table, tagsynonym.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.SynonymsTable, tag.SynonymsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequiredBy queries the required_by edge of a Tag.
+func (c *TagClient) QueryRequiredBy(_m *Tag) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.RequiredByTable, tag.RequiredByPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4604,131 +14090,131 @@ func (c *PostReadClient) QueryAccount(_m *PostRead) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PostReadClient) Hooks() []Hook {
-	return c.hooks.PostRead
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostReadClient) Interceptors() []Interceptor {
-	return c.inters.PostRead
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *PostReadClient) mutate(ctx context.Context, m *PostReadMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostReadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostReadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostReadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostReadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PostRead mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
 	}
 }
 
-// PropertyClient is a client for the Property schema.
-type PropertyClient struct {
+// TagFollowClient is a client for the TagFollow schema.
+type TagFollowClient struct {
 	config
 }
 
-// NewPropertyClient returns a client for the Property from the given config.
-func NewPropertyClient(c config) *PropertyClient {
-	return &PropertyClient{config: c}
+// NewTagFollowClient returns a client for the TagFollow from the given config.
+func NewTagFollowClient(c config) *TagFollowClient {
+	return &TagFollowClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `property.Hooks(f(g(h())))`.
-func (c *PropertyClient) Use(hooks ...Hook) {
-	c.hooks.Property = append(c.hooks.Property, hooks...)
+// A call to `Use(f, g, h)` equals to `tagfollow.Hooks(f(g(h())))`.
+func (c *TagFollowClient) Use(hooks ...Hook) {
+	c.hooks.TagFollow = append(c.hooks.TagFollow, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `property.Intercept(f(g(h())))`.
-func (c *PropertyClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Property = append(c.inters.Property, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tagfollow.Intercept(f(g(h())))`.
+func (c *TagFollowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagFollow = append(c.inters.TagFollow, interceptors...)
 }
 
-// Create returns a builder for creating a Property entity.
-func (c *PropertyClient) Create() *PropertyCreate {
-	mutation := newPropertyMutation(c.config, OpCreate)
-	return &PropertyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TagFollow entity.
+func (c *TagFollowClient) Create() *TagFollowCreate {
+	mutation := newTagFollowMutation(c.config, OpCreate)
+	return &TagFollowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Property entities.
-func (c *PropertyClient) CreateBulk(builders ...*PropertyCreate) *PropertyCreateBulk {
-	return &PropertyCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TagFollow entities.
+func (c *TagFollowClient) CreateBulk(builders ...*TagFollowCreate) *TagFollowCreateBulk {
+	return &TagFollowCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PropertyClient) MapCreateBulk(slice any, setFunc func(*PropertyCreate, int)) *PropertyCreateBulk {
+func (c *TagFollowClient) MapCreateBulk(slice any, setFunc func(*TagFollowCreate, int)) *TagFollowCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PropertyCreateBulk{err: fmt.Errorf("calling to PropertyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagFollowCreateBulk{err: fmt.Errorf("calling to TagFollowClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PropertyCreate, rv.Len())
+	builders := make([]*TagFollowCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PropertyCreateBulk{config: c.config, builders: builders}
+	return &TagFollowCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Property.
-func (c *PropertyClient) Update() *PropertyUpdate {
-	mutation := newPropertyMutation(c.config, OpUpdate)
-	return &PropertyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TagFollow.
+func (c *TagFollowClient) Update() *TagFollowUpdate {
+	mutation := newTagFollowMutation(c.config, OpUpdate)
+	return &TagFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PropertyClient) UpdateOne(_m *Property) *PropertyUpdateOne {
-	mutation := newPropertyMutation(c.config, OpUpdateOne, withProperty(_m))
-	return &PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagFollowClient) UpdateOne(_m *TagFollow) *TagFollowUpdateOne {
+	mutation := newTagFollowMutation(c.config, OpUpdateOne, withTagFollow(_m))
+	return &TagFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PropertyClient) UpdateOneID(id xid.ID) *PropertyUpdateOne {
-	mutation := newPropertyMutation(c.config, OpUpdateOne, withPropertyID(id))
-	return &PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagFollowClient) UpdateOneID(id xid.ID) *TagFollowUpdateOne {
+	mutation := newTagFollowMutation(c.config, OpUpdateOne, withTagFollowID(id))
+	return &TagFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Property.
-func (c *PropertyClient) Delete() *PropertyDelete {
-	mutation := newPropertyMutation(c.config, OpDelete)
-	return &PropertyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TagFollow.
+func (c *TagFollowClient) Delete() *TagFollowDelete {
+	mutation := newTagFollowMutation(c.config, OpDelete)
+	return &TagFollowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PropertyClient) DeleteOne(_m *Property) *PropertyDeleteOne {
+func (c *TagFollowClient) DeleteOne(_m *TagFollow) *TagFollowDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PropertyClient) DeleteOneID(id xid.ID) *PropertyDeleteOne {
-	builder := c.Delete().Where(property.ID(id))
+func (c *TagFollowClient) DeleteOneID(id xid.ID) *TagFollowDeleteOne {
+	builder := c.Delete().Where(tagfollow.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PropertyDeleteOne{builder}
+	return &TagFollowDeleteOne{builder}
 }
 
-// Query returns a query builder for Property.
-func (c *PropertyClient) Query() *PropertyQuery {
-	return &PropertyQuery{
+// Query returns a query builder for TagFollow.
+func (c *TagFollowClient) Query() *TagFollowQuery {
+	return &TagFollowQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProperty},
+		ctx:    &QueryContext{Type: TypeTagFollow},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Property entity by its id.
-func (c *PropertyClient) Get(ctx context.Context, id xid.ID) (*Property, error) {
-	return c.Query().Where(property.ID(id)).Only(ctx)
+// Get returns a TagFollow entity by its id.
+func (c *TagFollowClient) Get(ctx context.Context, id xid.ID) (*TagFollow, error) {
+	return c.Query().Where(tagfollow.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PropertyClient) GetX(ctx context.Context, id xid.ID) *Property {
+func (c *TagFollowClient) GetX(ctx context.Context, id xid.ID) *TagFollow {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4736,15 +14222,15 @@ func (c *PropertyClient) GetX(ctx context.Context, id xid.ID) *Property {
 	return obj
 }
 
-// QueryNode queries the node edge of a Property.
-func (c *PropertyClient) QueryNode(_m *Property) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
+// QueryAccount queries the account edge of a TagFollow.
+func (c *TagFollowClient) QueryAccount(_m *TagFollow) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(property.Table, property.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, property.NodeTable, property.NodeColumn),
+			sqlgraph.From(tagfollow.Table, tagfollow.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tagfollow.AccountTable, tagfollow.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4752,15 +14238,15 @@ func (c *PropertyClient) QueryNode(_m *Property) *NodeQuery {
 	return query
 }
 
-// QuerySchema queries the schema edge of a Property.
-func (c *PropertyClient) QuerySchema(_m *Property) *PropertySchemaFieldQuery {
-	query := (&PropertySchemaFieldClient{config: c.config}).Query()
+// QueryTag queries the tag edge of a TagFollow.
+func (c *TagFollowClient) QueryTag(_m *TagFollow) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(property.Table, property.FieldID, id),
-			sqlgraph.To(propertyschemafield.Table, propertyschemafield.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, property.SchemaTable, property.SchemaColumn),
+			sqlgraph.From(tagfollow.Table, tagfollow.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tagfollow.TagTable, tagfollow.TagColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4769,131 +14255,131 @@ func (c *PropertyClient) QuerySchema(_m *Property) *PropertySchemaFieldQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PropertyClient) Hooks() []Hook {
-	return c.hooks.Property
+func (c *TagFollowClient) Hooks() []Hook {
+	return c.hooks.TagFollow
 }
 
 // Interceptors returns the client interceptors.
-func (c *PropertyClient) Interceptors() []Interceptor {
-	return c.inters.Property
+func (c *TagFollowClient) Interceptors() []Interceptor {
+	return c.inters.TagFollow
 }
 
-func (c *PropertyClient) mutate(ctx context.Context, m *PropertyMutation) (Value, error) {
+func (c *TagFollowClient) mutate(ctx context.Context, m *TagFollowMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PropertyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagFollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PropertyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagFollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagFollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PropertyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagFollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Property mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TagFollow mutation op: %q", m.Op())
 	}
 }
 
-// PropertySchemaClient is a client for the PropertySchema schema.
-type PropertySchemaClient struct {
+// TagSynonymClient is a client for the TagSynonym schema.
+type TagSynonymClient struct {
 	config
 }
 
-// NewPropertySchemaClient returns a client for the PropertySchema from the given config.
-func NewPropertySchemaClient(c config) *PropertySchemaClient {
-	return &PropertySchemaClient{config: c}
+// NewTagSynonymClient returns a client for the TagSynonym from the given config.
+func NewTagSynonymClient(c config) *TagSynonymClient {
+	return &TagSynonymClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `propertyschema.Hooks(f(g(h())))`.
-func (c *PropertySchemaClient) Use(hooks ...Hook) {
-	c.hooks.PropertySchema = append(c.hooks.PropertySchema, hooks...)
+// A call to `Use(f, g, h)` equals to `tagsynonym.Hooks(f(g(h())))`.
+func (c *TagSynonymClient) Use(hooks ...Hook) {
+	c.hooks.TagSynonym = append(c.hooks.TagSynonym, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `propertyschema.Intercept(f(g(h())))`.
-func (c *PropertySchemaClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PropertySchema = append(c.inters.PropertySchema, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tagsynonym.Intercept(f(g(h())))`.
+func (c *TagSynonymClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagSynonym = append(c.inters.TagSynonym, interceptors...)
 }
 
-// Create returns a builder for creating a PropertySchema entity.
-func (c *PropertySchemaClient) Create() *PropertySchemaCreate {
-	mutation := newPropertySchemaMutation(c.config, OpCreate)
-	return &PropertySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TagSynonym entity.
+func (c *TagSynonymClient) Create() *TagSynonymCreate {
+	mutation := newTagSynonymMutation(c.config, OpCreate)
+	return &TagSynonymCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PropertySchema entities.
-func (c *PropertySchemaClient) CreateBulk(builders ...*PropertySchemaCreate) *PropertySchemaCreateBulk {
-	return &PropertySchemaCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TagSynonym entities.
+func (c *TagSynonymClient) CreateBulk(builders ...*TagSynonymCreate) *TagSynonymCreateBulk {
+	return &TagSynonymCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PropertySchemaClient) MapCreateBulk(slice any, setFunc func(*PropertySchemaCreate, int)) *PropertySchemaCreateBulk {
+func (c *TagSynonymClient) MapCreateBulk(slice any, setFunc func(*TagSynonymCreate, int)) *TagSynonymCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PropertySchemaCreateBulk{err: fmt.Errorf("calling to PropertySchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagSynonymCreateBulk{err: fmt.Errorf("calling to TagSynonymClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PropertySchemaCreate, rv.Len())
+	builders := make([]*TagSynonymCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PropertySchemaCreateBulk{config: c.config, builders: builders}
+	return &TagSynonymCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PropertySchema.
-func (c *PropertySchemaClient) Update() *PropertySchemaUpdate {
-	mutation := newPropertySchemaMutation(c.config, OpUpdate)
-	return &PropertySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TagSynonym.
+func (c *TagSynonymClient) Update() *TagSynonymUpdate {
+	mutation := newTagSynonymMutation(c.config, OpUpdate)
+	return &TagSynonymUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PropertySchemaClient) UpdateOne(_m *PropertySchema) *PropertySchemaUpdateOne {
-	mutation := newPropertySchemaMutation(c.config, OpUpdateOne, withPropertySchema(_m))
-	return &PropertySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagSynonymClient) UpdateOne(_m *TagSynonym) *TagSynonymUpdateOne {
+	mutation := newTagSynonymMutation(c.config, OpUpdateOne, withTagSynonym(_m))
+	return &TagSynonymUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PropertySchemaClient) UpdateOneID(id xid.ID) *PropertySchemaUpdateOne {
-	mutation := newPropertySchemaMutation(c.config, OpUpdateOne, withPropertySchemaID(id))
-	return &PropertySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagSynonymClient) UpdateOneID(id xid.ID) *TagSynonymUpdateOne {
+	mutation := newTagSynonymMutation(c.config, OpUpdateOne, withTagSynonymID(id))
+	return &TagSynonymUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PropertySchema.
-func (c *PropertySchemaClient) Delete() *PropertySchemaDelete {
-	mutation := newPropertySchemaMutation(c.config, OpDelete)
-	return &PropertySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TagSynonym.
+func (c *TagSynonymClient) Delete() *TagSynonymDelete {
+	mutation := newTagSynonymMutation(c.config, OpDelete)
+	return &TagSynonymDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PropertySchemaClient) DeleteOne(_m *PropertySchema) *PropertySchemaDeleteOne {
+func (c *TagSynonymClient) DeleteOne(_m *TagSynonym) *TagSynonymDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PropertySchemaClient) DeleteOneID(id xid.ID) *PropertySchemaDeleteOne {
-	builder := c.Delete().Where(propertyschema.ID(id))
+func (c *TagSynonymClient) DeleteOneID(id xid.ID) *TagSynonymDeleteOne {
+	builder := c.Delete().Where(tagsynonym.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PropertySchemaDeleteOne{builder}
+	return &TagSynonymDeleteOne{builder}
 }
 
-// Query returns a query builder for PropertySchema.
-func (c *PropertySchemaClient) Query() *PropertySchemaQuery {
-	return &PropertySchemaQuery{
+// Query returns a query builder for TagSynonym.
+func (c *TagSynonymClient) Query() *TagSynonymQuery {
+	return &TagSynonymQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePropertySchema},
+		ctx:    &QueryContext{Type: TypeTagSynonym},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PropertySchema entity by its id.
-func (c *PropertySchemaClient) Get(ctx context.Context, id xid.ID) (*PropertySchema, error) {
-	return c.Query().Where(propertyschema.ID(id)).Only(ctx)
+// Get returns a TagSynonym entity by its id.
+func (c *TagSynonymClient) Get(ctx context.Context, id xid.ID) (*TagSynonym, error) {
+	return c.Query().Where(tagsynonym.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PropertySchemaClient) GetX(ctx context.Context, id xid.ID) *PropertySchema {
+func (c *TagSynonymClient) GetX(ctx context.Context, id xid.ID) *TagSynonym {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4901,31 +14387,15 @@ func (c *PropertySchemaClient) GetX(ctx context.Context, id xid.ID) *PropertySch
 	return obj
 }
 
-// QueryNode queries the node edge of a PropertySchema.
-func (c *PropertySchemaClient) QueryNode(_m *PropertySchema) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(propertyschema.Table, propertyschema.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, propertyschema.NodeTable, propertyschema.NodeColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFields queries the fields edge of a PropertySchema.
-func (c *PropertySchemaClient) QueryFields(_m *PropertySchema) *PropertySchemaFieldQuery {
-	query := (&PropertySchemaFieldClient{config: c.config}).Query()
+// QueryTag queries the tag edge of a TagSynonym.
+func (c *TagSynonymClient) QueryTag(_m *TagSynonym) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(propertyschema.Table, propertyschema.FieldID, id),
-			sqlgraph.To(propertyschemafield.Table, propertyschemafield.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, propertyschema.FieldsTable, propertyschema.FieldsColumn),
+			sqlgraph.From(tagsynonym.Table, tagsynonym.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tagsynonym.TagTable, tagsynonym.TagColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4934,131 +14404,131 @@ func (c *PropertySchemaClient) QueryFields(_m *PropertySchema) *PropertySchemaFi
 }
 
 // Hooks returns the client hooks.
-func (c *PropertySchemaClient) Hooks() []Hook {
-	return c.hooks.PropertySchema
+func (c *TagSynonymClient) Hooks() []Hook {
+	return c.hooks.TagSynonym
 }
 
 // Interceptors returns the client interceptors.
-func (c *PropertySchemaClient) Interceptors() []Interceptor {
-	return c.inters.PropertySchema
+func (c *TagSynonymClient) Interceptors() []Interceptor {
+	return c.inters.TagSynonym
 }
 
-func (c *PropertySchemaClient) mutate(ctx context.Context, m *PropertySchemaMutation) (Value, error) {
+func (c *TagSynonymClient) mutate(ctx context.Context, m *TagSynonymMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PropertySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagSynonymCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PropertySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagSynonymUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PropertySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagSynonymUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PropertySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagSynonymDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PropertySchema mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TagSynonym mutation op: %q", m.Op())
 	}
 }
 
-// PropertySchemaFieldClient is a client for the PropertySchemaField schema.
-type PropertySchemaFieldClient struct {
+// TelegramMessageClient is a client for the TelegramMessage schema.
+type TelegramMessageClient struct {
 	config
 }
 
-// NewPropertySchemaFieldClient returns a client for the PropertySchemaField from the given config.
-func NewPropertySchemaFieldClient(c config) *PropertySchemaFieldClient {
-	return &PropertySchemaFieldClient{config: c}
+// NewTelegramMessageClient returns a client for the TelegramMessage from the given config.
+func NewTelegramMessageClient(c config) *TelegramMessageClient {
+	return &TelegramMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `propertyschemafield.Hooks(f(g(h())))`.
-func (c *PropertySchemaFieldClient) Use(hooks ...Hook) {
-	c.hooks.PropertySchemaField = append(c.hooks.PropertySchemaField, hooks...)
+// A call to `Use(f, g, h)` equals to `telegrammessage.Hooks(f(g(h())))`.
+func (c *TelegramMessageClient) Use(hooks ...Hook) {
+	c.hooks.TelegramMessage = append(c.hooks.TelegramMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `propertyschemafield.Intercept(f(g(h())))`.
-func (c *PropertySchemaFieldClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PropertySchemaField = append(c.inters.PropertySchemaField, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `telegrammessage.Intercept(f(g(h())))`.
+func (c *TelegramMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TelegramMessage = append(c.inters.TelegramMessage, interceptors...)
 }
 
-// Create returns a builder for creating a PropertySchemaField entity.
-func (c *PropertySchemaFieldClient) Create() *PropertySchemaFieldCreate {
-	mutation := newPropertySchemaFieldMutation(c.config, OpCreate)
-	return &PropertySchemaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TelegramMessage entity.
+func (c *TelegramMessageClient) Create() *TelegramMessageCreate {
+	mutation := newTelegramMessageMutation(c.config, OpCreate)
+	return &TelegramMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PropertySchemaField entities.
-func (c *PropertySchemaFieldClient) CreateBulk(builders ...*PropertySchemaFieldCreate) *PropertySchemaFieldCreateBulk {
-	return &PropertySchemaFieldCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TelegramMessage entities.
+func (c *TelegramMessageClient) CreateBulk(builders ...*TelegramMessageCreate) *TelegramMessageCreateBulk {
+	return &TelegramMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PropertySchemaFieldClient) MapCreateBulk(slice any, setFunc func(*PropertySchemaFieldCreate, int)) *PropertySchemaFieldCreateBulk {
+func (c *TelegramMessageClient) MapCreateBulk(slice any, setFunc func(*TelegramMessageCreate, int)) *TelegramMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PropertySchemaFieldCreateBulk{err: fmt.Errorf("calling to PropertySchemaFieldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TelegramMessageCreateBulk{err: fmt.Errorf("calling to TelegramMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PropertySchemaFieldCreate, rv.Len())
+	builders := make([]*TelegramMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PropertySchemaFieldCreateBulk{config: c.config, builders: builders}
+	return &TelegramMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PropertySchemaField.
-func (c *PropertySchemaFieldClient) Update() *PropertySchemaFieldUpdate {
-	mutation := newPropertySchemaFieldMutation(c.config, OpUpdate)
-	return &PropertySchemaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TelegramMessage.
+func (c *TelegramMessageClient) Update() *TelegramMessageUpdate {
+	mutation := newTelegramMessageMutation(c.config, OpUpdate)
+	return &TelegramMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PropertySchemaFieldClient) UpdateOne(_m *PropertySchemaField) *PropertySchemaFieldUpdateOne {
-	mutation := newPropertySchemaFieldMutation(c.config, OpUpdateOne, withPropertySchemaField(_m))
-	return &PropertySchemaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TelegramMessageClient) UpdateOne(_m *TelegramMessage) *TelegramMessageUpdateOne {
+	mutation := newTelegramMessageMutation(c.config, OpUpdateOne, withTelegramMessage(_m))
+	return &TelegramMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PropertySchemaFieldClient) UpdateOneID(id xid.ID) *PropertySchemaFieldUpdateOne {
-	mutation := newPropertySchemaFieldMutation(c.config, OpUpdateOne, withPropertySchemaFieldID(id))
-	return &PropertySchemaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TelegramMessageClient) UpdateOneID(id xid.ID) *TelegramMessageUpdateOne {
+	mutation := newTelegramMessageMutation(c.config, OpUpdateOne, withTelegramMessageID(id))
+	return &TelegramMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PropertySchemaField.
-func (c *PropertySchemaFieldClient) Delete() *PropertySchemaFieldDelete {
-	mutation := newPropertySchemaFieldMutation(c.config, OpDelete)
-	return &PropertySchemaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TelegramMessage.
+func (c *TelegramMessageClient) Delete() *TelegramMessageDelete {
+	mutation := newTelegramMessageMutation(c.config, OpDelete)
+	return &TelegramMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PropertySchemaFieldClient) DeleteOne(_m *PropertySchemaField) *PropertySchemaFieldDeleteOne {
+func (c *TelegramMessageClient) DeleteOne(_m *TelegramMessage) *TelegramMessageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PropertySchemaFieldClient) DeleteOneID(id xid.ID) *PropertySchemaFieldDeleteOne {
-	builder := c.Delete().Where(propertyschemafield.ID(id))
+func (c *TelegramMessageClient) DeleteOneID(id xid.ID) *TelegramMessageDeleteOne {
+	builder := c.Delete().Where(telegrammessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PropertySchemaFieldDeleteOne{builder}
+	return &TelegramMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for PropertySchemaField.
-func (c *PropertySchemaFieldClient) Query() *PropertySchemaFieldQuery {
-	return &PropertySchemaFieldQuery{
+// Query returns a query builder for TelegramMessage.
+func (c *TelegramMessageClient) Query() *TelegramMessageQuery {
+	return &TelegramMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePropertySchemaField},
+		ctx:    &QueryContext{Type: TypeTelegramMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PropertySchemaField entity by its id.
-func (c *PropertySchemaFieldClient) Get(ctx context.Context, id xid.ID) (*PropertySchemaField, error) {
-	return c.Query().Where(propertyschemafield.ID(id)).Only(ctx)
+// Get returns a TelegramMessage entity by its id.
+func (c *TelegramMessageClient) Get(ctx context.Context, id xid.ID) (*TelegramMessage, error) {
+	return c.Query().Where(telegrammessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PropertySchemaFieldClient) GetX(ctx context.Context, id xid.ID) *PropertySchemaField {
+func (c *TelegramMessageClient) GetX(ctx context.Context, id xid.ID) *TelegramMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5066,31 +14536,15 @@ func (c *PropertySchemaFieldClient) GetX(ctx context.Context, id xid.ID) *Proper
 	return obj
 }
 
-// QuerySchema queries the schema edge of a PropertySchemaField.
-func (c *PropertySchemaFieldClient) QuerySchema(_m *PropertySchemaField) *PropertySchemaQuery {
-	query := (&PropertySchemaClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(propertyschemafield.Table, propertyschemafield.FieldID, id),
-			sqlgraph.To(propertyschema.Table, propertyschema.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, propertyschemafield.SchemaTable, propertyschemafield.SchemaColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProperties queries the properties edge of a PropertySchemaField.
-func (c *PropertySchemaFieldClient) QueryProperties(_m *PropertySchemaField) *PropertyQuery {
-	query := (&PropertyClient{config: c.config}).Query()
+// QueryPost queries the post edge of a TelegramMessage.
+func (c *TelegramMessageClient) QueryPost(_m *TelegramMessage) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(propertyschemafield.Table, propertyschemafield.FieldID, id),
-			sqlgraph.To(property.Table, property.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, propertyschemafield.PropertiesTable, propertyschemafield.PropertiesColumn),
+			sqlgraph.From(telegrammessage.Table, telegrammessage.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, telegrammessage.PostTable, telegrammessage.PostColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5099,131 +14553,131 @@ func (c *PropertySchemaFieldClient) QueryProperties(_m *PropertySchemaField) *Pr
 }
 
 // Hooks returns the client hooks.
-func (c *PropertySchemaFieldClient) Hooks() []Hook {
-	return c.hooks.PropertySchemaField
+func (c *TelegramMessageClient) Hooks() []Hook {
+	return c.hooks.TelegramMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *PropertySchemaFieldClient) Interceptors() []Interceptor {
-	return c.inters.PropertySchemaField
+func (c *TelegramMessageClient) Interceptors() []Interceptor {
+	return c.inters.TelegramMessage
 }
 
-func (c *PropertySchemaFieldClient) mutate(ctx context.Context, m *PropertySchemaFieldMutation) (Value, error) {
+func (c *TelegramMessageClient) mutate(ctx context.Context, m *TelegramMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PropertySchemaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TelegramMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PropertySchemaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TelegramMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PropertySchemaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TelegramMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PropertySchemaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TelegramMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PropertySchemaField mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TelegramMessage mutation op: %q", m.Op())
 	}
 }
 
-// QuestionClient is a client for the Question schema.
-type QuestionClient struct {
+// TenantClient is a client for the Tenant schema.
+type TenantClient struct {
 	config
 }
 
-// NewQuestionClient returns a client for the Question from the given config.
-func NewQuestionClient(c config) *QuestionClient {
-	return &QuestionClient{config: c}
+// NewTenantClient returns a client for the Tenant from the given config.
+func NewTenantClient(c config) *TenantClient {
+	return &TenantClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `question.Hooks(f(g(h())))`.
-func (c *QuestionClient) Use(hooks ...Hook) {
-	c.hooks.Question = append(c.hooks.Question, hooks...)
+// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
+func (c *TenantClient) Use(hooks ...Hook) {
+	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `question.Intercept(f(g(h())))`.
-func (c *QuestionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Question = append(c.inters.Question, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
+func (c *TenantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
 }
 
-// Create returns a builder for creating a Question entity.
-func (c *QuestionClient) Create() *QuestionCreate {
-	mutation := newQuestionMutation(c.config, OpCreate)
-	return &QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tenant entity.
+func (c *TenantClient) Create() *TenantCreate {
+	mutation := newTenantMutation(c.config, OpCreate)
+	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Question entities.
-func (c *QuestionClient) CreateBulk(builders ...*QuestionCreate) *QuestionCreateBulk {
-	return &QuestionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tenant entities.
+func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
+	return &TenantCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *QuestionClient) MapCreateBulk(slice any, setFunc func(*QuestionCreate, int)) *QuestionCreateBulk {
+func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &QuestionCreateBulk{err: fmt.Errorf("calling to QuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*QuestionCreate, rv.Len())
+	builders := make([]*TenantCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &QuestionCreateBulk{config: c.config, builders: builders}
+	return &TenantCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Question.
-func (c *QuestionClient) Update() *QuestionUpdate {
-	mutation := newQuestionMutation(c.config, OpUpdate)
-	return &QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Tenant.
+func (c *TenantClient) Update() *TenantUpdate {
+	mutation := newTenantMutation(c.config, OpUpdate)
+	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *QuestionClient) UpdateOne(_m *Question) *QuestionUpdateOne {
-	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestion(_m))
-	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *QuestionClient) UpdateOneID(id xid.ID) *QuestionUpdateOne {
-	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestionID(id))
-	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantClient) UpdateOneID(id xid.ID) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Question.
-func (c *QuestionClient) Delete() *QuestionDelete {
-	mutation := newQuestionMutation(c.config, OpDelete)
-	return &QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tenant.
+func (c *TenantClient) Delete() *TenantDelete {
+	mutation := newTenantMutation(c.config, OpDelete)
+	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *QuestionClient) DeleteOne(_m *Question) *QuestionDeleteOne {
+func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QuestionClient) DeleteOneID(id xid.ID) *QuestionDeleteOne {
-	builder := c.Delete().Where(question.ID(id))
+func (c *TenantClient) DeleteOneID(id xid.ID) *TenantDeleteOne {
+	builder := c.Delete().Where(tenant.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QuestionDeleteOne{builder}
+	return &TenantDeleteOne{builder}
 }
 
-// Query returns a query builder for Question.
-func (c *QuestionClient) Query() *QuestionQuery {
-	return &QuestionQuery{
+// Query returns a query builder for Tenant.
+func (c *TenantClient) Query() *TenantQuery {
+	return &TenantQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQuestion},
+		ctx:    &QueryContext{Type: TypeTenant},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Question entity by its id.
-func (c *QuestionClient) Get(ctx context.Context, id xid.ID) (*Question, error) {
-	return c.Query().Where(question.ID(id)).Only(ctx)
+// Get returns a Tenant entity by its id.
+func (c *TenantClient) Get(ctx context.Context, id xid.ID) (*Tenant, error) {
+	return c.Query().Where(tenant.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QuestionClient) GetX(ctx context.Context, id xid.ID) *Question {
+func (c *TenantClient) GetX(ctx context.Context, id xid.ID) *Tenant {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5231,180 +14685,265 @@ func (c *QuestionClient) GetX(ctx context.Context, id xid.ID) *Question {
 	return obj
 }
 
-// QueryAuthor queries the author edge of a Question.
-func (c *QuestionClient) QueryAuthor(_m *Question) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, question.AuthorTable, question.AuthorColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *TenantClient) Hooks() []Hook {
+	return c.hooks.Tenant
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantClient) Interceptors() []Interceptor {
+	return c.inters.Tenant
+}
+
+func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tenant mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryParent queries the parent edge of a Question.
-func (c *QuestionClient) QueryParent(_m *Question) *QuestionQuery {
-	query := (&QuestionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, question.ParentTable, question.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// TrendingScoreClient is a client for the TrendingScore schema.
+type TrendingScoreClient struct {
+	config
+}
+
+// NewTrendingScoreClient returns a client for the TrendingScore from the given config.
+func NewTrendingScoreClient(c config) *TrendingScoreClient {
+	return &TrendingScoreClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `trendingscore.Hooks(f(g(h())))`.
+func (c *TrendingScoreClient) Use(hooks ...Hook) {
+	c.hooks.TrendingScore = append(c.hooks.TrendingScore, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `trendingscore.Intercept(f(g(h())))`.
+func (c *TrendingScoreClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TrendingScore = append(c.inters.TrendingScore, interceptors...)
+}
+
+// Create returns a builder for creating a TrendingScore entity.
+func (c *TrendingScoreClient) Create() *TrendingScoreCreate {
+	mutation := newTrendingScoreMutation(c.config, OpCreate)
+	return &TrendingScoreCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TrendingScore entities.
+func (c *TrendingScoreClient) CreateBulk(builders ...*TrendingScoreCreate) *TrendingScoreCreateBulk {
+	return &TrendingScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TrendingScoreClient) MapCreateBulk(slice any, setFunc func(*TrendingScoreCreate, int)) *TrendingScoreCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TrendingScoreCreateBulk{err: fmt.Errorf("calling to TrendingScoreClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TrendingScoreCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TrendingScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TrendingScore.
+func (c *TrendingScoreClient) Update() *TrendingScoreUpdate {
+	mutation := newTrendingScoreMutation(c.config, OpUpdate)
+	return &TrendingScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TrendingScoreClient) UpdateOne(_m *TrendingScore) *TrendingScoreUpdateOne {
+	mutation := newTrendingScoreMutation(c.config, OpUpdateOne, withTrendingScore(_m))
+	return &TrendingScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TrendingScoreClient) UpdateOneID(id xid.ID) *TrendingScoreUpdateOne {
+	mutation := newTrendingScoreMutation(c.config, OpUpdateOne, withTrendingScoreID(id))
+	return &TrendingScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TrendingScore.
+func (c *TrendingScoreClient) Delete() *TrendingScoreDelete {
+	mutation := newTrendingScoreMutation(c.config, OpDelete)
+	return &TrendingScoreDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TrendingScoreClient) DeleteOne(_m *TrendingScore) *TrendingScoreDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TrendingScoreClient) DeleteOneID(id xid.ID) *TrendingScoreDeleteOne {
+	builder := c.Delete().Where(trendingscore.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TrendingScoreDeleteOne{builder}
+}
+
+// Query returns a query builder for TrendingScore.
+func (c *TrendingScoreClient) Query() *TrendingScoreQuery {
+	return &TrendingScoreQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTrendingScore},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryParentQuestion queries the parent_question edge of a Question.
-func (c *QuestionClient) QueryParentQuestion(_m *Question) *QuestionQuery {
-	query := (&QuestionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, question.ParentQuestionTable, question.ParentQuestionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a TrendingScore entity by its id.
+func (c *TrendingScoreClient) Get(ctx context.Context, id xid.ID) (*TrendingScore, error) {
+	return c.Query().Where(trendingscore.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TrendingScoreClient) GetX(ctx context.Context, id xid.ID) *TrendingScore {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *QuestionClient) Hooks() []Hook {
-	return c.hooks.Question
+func (c *TrendingScoreClient) Hooks() []Hook {
+	return c.hooks.TrendingScore
 }
 
 // Interceptors returns the client interceptors.
-func (c *QuestionClient) Interceptors() []Interceptor {
-	return c.inters.Question
+func (c *TrendingScoreClient) Interceptors() []Interceptor {
+	return c.inters.TrendingScore
 }
 
-func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
+func (c *TrendingScoreClient) mutate(ctx context.Context, m *TrendingScoreMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TrendingScoreCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TrendingScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TrendingScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TrendingScoreDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TrendingScore mutation op: %q", m.Op())
 	}
 }
 
-// ReactClient is a client for the React schema.
-type ReactClient struct {
+// UploadSessionClient is a client for the UploadSession schema.
+type UploadSessionClient struct {
 	config
 }
 
-// NewReactClient returns a client for the React from the given config.
-func NewReactClient(c config) *ReactClient {
-	return &ReactClient{config: c}
+// NewUploadSessionClient returns a client for the UploadSession from the given config.
+func NewUploadSessionClient(c config) *UploadSessionClient {
+	return &UploadSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `react.Hooks(f(g(h())))`.
-func (c *ReactClient) Use(hooks ...Hook) {
-	c.hooks.React = append(c.hooks.React, hooks...)
+// A call to `Use(f, g, h)` equals to `uploadsession.Hooks(f(g(h())))`.
+func (c *UploadSessionClient) Use(hooks ...Hook) {
+	c.hooks.UploadSession = append(c.hooks.UploadSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `react.Intercept(f(g(h())))`.
-func (c *ReactClient) Intercept(interceptors ...Interceptor) {
-	c.inters.React = append(c.inters.React, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `uploadsession.Intercept(f(g(h())))`.
+func (c *UploadSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UploadSession = append(c.inters.UploadSession, interceptors...)
 }
 
-// Create returns a builder for creating a React entity.
-func (c *ReactClient) Create() *ReactCreate {
-	mutation := newReactMutation(c.config, OpCreate)
-	return &ReactCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UploadSession entity.
+func (c *UploadSessionClient) Create() *UploadSessionCreate {
+	mutation := newUploadSessionMutation(c.config, OpCreate)
+	return &UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of React entities.
-func (c *ReactClient) CreateBulk(builders ...*ReactCreate) *ReactCreateBulk {
-	return &ReactCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UploadSession entities.
+func (c *UploadSessionClient) CreateBulk(builders ...*UploadSessionCreate) *UploadSessionCreateBulk {
+	return &UploadSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReactClient) MapCreateBulk(slice any, setFunc func(*ReactCreate, int)) *ReactCreateBulk {
+func (c *UploadSessionClient) MapCreateBulk(slice any, setFunc func(*UploadSessionCreate, int)) *UploadSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReactCreateBulk{err: fmt.Errorf("calling to ReactClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UploadSessionCreateBulk{err: fmt.Errorf("calling to UploadSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReactCreate, rv.Len())
+	builders := make([]*UploadSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReactCreateBulk{config: c.config, builders: builders}
+	return &UploadSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for React.
-func (c *ReactClient) Update() *ReactUpdate {
-	mutation := newReactMutation(c.config, OpUpdate)
-	return &ReactUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UploadSession.
+func (c *UploadSessionClient) Update() *UploadSessionUpdate {
+	mutation := newUploadSessionMutation(c.config, OpUpdate)
+	return &UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReactClient) UpdateOne(_m *React) *ReactUpdateOne {
-	mutation := newReactMutation(c.config, OpUpdateOne, withReact(_m))
-	return &ReactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UploadSessionClient) UpdateOne(_m *UploadSession) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSession(_m))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReactClient) UpdateOneID(id xid.ID) *ReactUpdateOne {
-	mutation := newReactMutation(c.config, OpUpdateOne, withReactID(id))
-	return &ReactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UploadSessionClient) UpdateOneID(id xid.ID) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSessionID(id))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for React.
-func (c *ReactClient) Delete() *ReactDelete {
-	mutation := newReactMutation(c.config, OpDelete)
-	return &ReactDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UploadSession.
+func (c *UploadSessionClient) Delete() *UploadSessionDelete {
+	mutation := newUploadSessionMutation(c.config, OpDelete)
+	return &UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReactClient) DeleteOne(_m *React) *ReactDeleteOne {
+func (c *UploadSessionClient) DeleteOne(_m *UploadSession) *UploadSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReactClient) DeleteOneID(id xid.ID) *ReactDeleteOne {
-	builder := c.Delete().Where(react.ID(id))
+func (c *UploadSessionClient) DeleteOneID(id xid.ID) *UploadSessionDeleteOne {
+	builder := c.Delete().Where(uploadsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReactDeleteOne{builder}
+	return &UploadSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for React.
-func (c *ReactClient) Query() *ReactQuery {
-	return &ReactQuery{
+// Query returns a query builder for UploadSession.
+func (c *UploadSessionClient) Query() *UploadSessionQuery {
+	return &UploadSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReact},
+		ctx:    &QueryContext{Type: TypeUploadSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a React entity by its id.
-func (c *ReactClient) Get(ctx context.Context, id xid.ID) (*React, error) {
-	return c.Query().Where(react.ID(id)).Only(ctx)
+// Get returns a UploadSession entity by its id.
+func (c *UploadSessionClient) Get(ctx context.Context, id xid.ID) (*UploadSession, error) {
+	return c.Query().Where(uploadsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReactClient) GetX(ctx context.Context, id xid.ID) *React {
+func (c *UploadSessionClient) GetX(ctx context.Context, id xid.ID) *UploadSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5412,31 +14951,15 @@ func (c *ReactClient) GetX(ctx context.Context, id xid.ID) *React {
 	return obj
 }
 
-// QueryAccount queries the account edge of a React.
-func (c *ReactClient) QueryAccount(_m *React) *AccountQuery {
+// QueryAccount queries the account edge of a UploadSession.
+func (c *UploadSessionClient) QueryAccount(_m *UploadSession) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(react.Table, react.FieldID, id),
+			sqlgraph.From(uploadsession.Table, uploadsession.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, react.AccountTable, react.AccountColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPost queries the Post edge of a React.
-func (c *ReactClient) QueryPost(_m *React) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(react.Table, react.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, react.PostTable, react.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, uploadsession.AccountTable, uploadsession.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5445,131 +14968,131 @@ func (c *ReactClient) QueryPost(_m *React) *PostQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ReactClient) Hooks() []Hook {
-	return c.hooks.React
+func (c *UploadSessionClient) Hooks() []Hook {
+	return c.hooks.UploadSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReactClient) Interceptors() []Interceptor {
-	return c.inters.React
+func (c *UploadSessionClient) Interceptors() []Interceptor {
+	return c.inters.UploadSession
 }
 
-func (c *ReactClient) mutate(ctx context.Context, m *ReactMutation) (Value, error) {
+func (c *UploadSessionClient) mutate(ctx context.Context, m *UploadSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReactCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReactUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReactDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown React mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UploadSession mutation op: %q", m.Op())
 	}
 }
 
-// ReportClient is a client for the Report schema.
-type ReportClient struct {
+// VerificationRequestClient is a client for the VerificationRequest schema.
+type VerificationRequestClient struct {
 	config
 }
 
-// NewReportClient returns a client for the Report from the given config.
-func NewReportClient(c config) *ReportClient {
-	return &ReportClient{config: c}
+// NewVerificationRequestClient returns a client for the VerificationRequest from the given config.
+func NewVerificationRequestClient(c config) *VerificationRequestClient {
+	return &VerificationRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `report.Hooks(f(g(h())))`.
-func (c *ReportClient) Use(hooks ...Hook) {
-	c.hooks.Report = append(c.hooks.Report, hooks...)
+// A call to `Use(f, g, h)` equals to `verificationrequest.Hooks(f(g(h())))`.
+func (c *VerificationRequestClient) Use(hooks ...Hook) {
+	c.hooks.VerificationRequest = append(c.hooks.VerificationRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `report.Intercept(f(g(h())))`.
-func (c *ReportClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Report = append(c.inters.Report, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `verificationrequest.Intercept(f(g(h())))`.
+func (c *VerificationRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VerificationRequest = append(c.inters.VerificationRequest, interceptors...)
 }
 
-// Create returns a builder for creating a Report entity.
-func (c *ReportClient) Create() *ReportCreate {
-	mutation := newReportMutation(c.config, OpCreate)
-	return &ReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a VerificationRequest entity.
+func (c *VerificationRequestClient) Create() *VerificationRequestCreate {
+	mutation := newVerificationRequestMutation(c.config, OpCreate)
+	return &VerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Report entities.
-func (c *ReportClient) CreateBulk(builders ...*ReportCreate) *ReportCreateBulk {
-	return &ReportCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of VerificationRequest entities.
+func (c *VerificationRequestClient) CreateBulk(builders ...*VerificationRequestCreate) *VerificationRequestCreateBulk {
+	return &VerificationRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReportClient) MapCreateBulk(slice any, setFunc func(*ReportCreate, int)) *ReportCreateBulk {
+func (c *VerificationRequestClient) MapCreateBulk(slice any, setFunc func(*VerificationRequestCreate, int)) *VerificationRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReportCreateBulk{err: fmt.Errorf("calling to ReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VerificationRequestCreateBulk{err: fmt.Errorf("calling to VerificationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReportCreate, rv.Len())
+	builders := make([]*VerificationRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReportCreateBulk{config: c.config, builders: builders}
+	return &VerificationRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Report.
-func (c *ReportClient) Update() *ReportUpdate {
-	mutation := newReportMutation(c.config, OpUpdate)
-	return &ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for VerificationRequest.
+func (c *VerificationRequestClient) Update() *VerificationRequestUpdate {
+	mutation := newVerificationRequestMutation(c.config, OpUpdate)
+	return &VerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReportClient) UpdateOne(_m *Report) *ReportUpdateOne {
-	mutation := newReportMutation(c.config, OpUpdateOne, withReport(_m))
-	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VerificationRequestClient) UpdateOne(_m *VerificationRequest) *VerificationRequestUpdateOne {
+	mutation := newVerificationRequestMutation(c.config, OpUpdateOne, withVerificationRequest(_m))
+	return &VerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReportClient) UpdateOneID(id xid.ID) *ReportUpdateOne {
-	mutation := newReportMutation(c.config, OpUpdateOne, withReportID(id))
-	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VerificationRequestClient) UpdateOneID(id xid.ID) *VerificationRequestUpdateOne {
+	mutation := newVerificationRequestMutation(c.config, OpUpdateOne, withVerificationRequestID(id))
+	return &VerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Report.
-func (c *ReportClient) Delete() *ReportDelete {
-	mutation := newReportMutation(c.config, OpDelete)
-	return &ReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for VerificationRequest.
+func (c *VerificationRequestClient) Delete() *VerificationRequestDelete {
+	mutation := newVerificationRequestMutation(c.config, OpDelete)
+	return &VerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReportClient) DeleteOne(_m *Report) *ReportDeleteOne {
+func (c *VerificationRequestClient) DeleteOne(_m *VerificationRequest) *VerificationRequestDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReportClient) DeleteOneID(id xid.ID) *ReportDeleteOne {
-	builder := c.Delete().Where(report.ID(id))
+func (c *VerificationRequestClient) DeleteOneID(id xid.ID) *VerificationRequestDeleteOne {
+	builder := c.Delete().Where(verificationrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReportDeleteOne{builder}
+	return &VerificationRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for Report.
-func (c *ReportClient) Query() *ReportQuery {
-	return &ReportQuery{
+// Query returns a query builder for VerificationRequest.
+func (c *VerificationRequestClient) Query() *VerificationRequestQuery {
+	return &VerificationRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReport},
+		ctx:    &QueryContext{Type: TypeVerificationRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Report entity by its id.
-func (c *ReportClient) Get(ctx context.Context, id xid.ID) (*Report, error) {
-	return c.Query().Where(report.ID(id)).Only(ctx)
+// Get returns a VerificationRequest entity by its id.
+func (c *VerificationRequestClient) Get(ctx context.Context, id xid.ID) (*VerificationRequest, error) {
+	return c.Query().Where(verificationrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReportClient) GetX(ctx context.Context, id xid.ID) *Report {
+func (c *VerificationRequestClient) GetX(ctx context.Context, id xid.ID) *VerificationRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5577,15 +15100,15 @@ func (c *ReportClient) GetX(ctx context.Context, id xid.ID) *Report {
 	return obj
 }
 
-// QueryReportedBy queries the reported_by edge of a Report.
-func (c *ReportClient) QueryReportedBy(_m *Report) *AccountQuery {
+// QueryAccount queries the account edge of a VerificationRequest.
+func (c *VerificationRequestClient) QueryAccount(_m *VerificationRequest) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.From(verificationrequest.Table, verificationrequest.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, report.ReportedByTable, report.ReportedByColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, verificationrequest.AccountTable, verificationrequest.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5593,15 +15116,15 @@ func (c *ReportClient) QueryReportedBy(_m *Report) *AccountQuery {
 	return query
 }
 
-// QueryHandledBy queries the handled_by edge of a Report.
-func (c *ReportClient) QueryHandledBy(_m *Report) *AccountQuery {
+// QueryReviewedBy queries the reviewed_by edge of a VerificationRequest.
+func (c *VerificationRequestClient) QueryReviewedBy(_m *VerificationRequest) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.From(verificationrequest.Table, verificationrequest.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, report.HandledByTable, report.HandledByColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, verificationrequest.ReviewedByTable, verificationrequest.ReviewedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5610,131 +15133,131 @@ func (c *ReportClient) QueryHandledBy(_m *Report) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ReportClient) Hooks() []Hook {
-	return c.hooks.Report
+func (c *VerificationRequestClient) Hooks() []Hook {
+	return c.hooks.VerificationRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReportClient) Interceptors() []Interceptor {
-	return c.inters.Report
+func (c *VerificationRequestClient) Interceptors() []Interceptor {
+	return c.inters.VerificationRequest
 }
 
-func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, error) {
+func (c *VerificationRequestClient) mutate(ctx context.Context, m *VerificationRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Report mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VerificationRequest mutation op: %q", m.Op())
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// WarningClient is a client for the Warning schema.
+type WarningClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewWarningClient returns a client for the Warning from the given config.
+func NewWarningClient(c config) *WarningClient {
+	return &WarningClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `warning.Hooks(f(g(h())))`.
+func (c *WarningClient) Use(hooks ...Hook) {
+	c.hooks.Warning = append(c.hooks.Warning, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `warning.Intercept(f(g(h())))`.
+func (c *WarningClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Warning = append(c.inters.Warning, interceptors...)
 }
 
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Warning entity.
+func (c *WarningClient) Create() *WarningCreate {
+	mutation := newWarningMutation(c.config, OpCreate)
+	return &WarningCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Warning entities.
+func (c *WarningClient) CreateBulk(builders ...*WarningCreate) *WarningCreateBulk {
+	return &WarningCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+func (c *WarningClient) MapCreateBulk(slice any, setFunc func(*WarningCreate, int)) *WarningCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WarningCreateBulk{err: fmt.Errorf("calling to WarningClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleCreate, rv.Len())
+	builders := make([]*WarningCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleCreateBulk{config: c.config, builders: builders}
+	return &WarningCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Role.
-func (c *RoleClient) Update() *RoleUpdate {
-	mutation := newRoleMutation(c.config, OpUpdate)
-	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Warning.
+func (c *WarningClient) Update() *WarningUpdate {
+	mutation := newWarningMutation(c.config, OpUpdate)
+	return &WarningUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WarningClient) UpdateOne(_m *Warning) *WarningUpdateOne {
+	mutation := newWarningMutation(c.config, OpUpdateOne, withWarning(_m))
+	return &WarningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleClient) UpdateOneID(id xid.ID) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WarningClient) UpdateOneID(id xid.ID) *WarningUpdateOne {
+	mutation := newWarningMutation(c.config, OpUpdateOne, withWarningID(id))
+	return &WarningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Role.
-func (c *RoleClient) Delete() *RoleDelete {
-	mutation := newRoleMutation(c.config, OpDelete)
-	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Warning.
+func (c *WarningClient) Delete() *WarningDelete {
+	mutation := newWarningMutation(c.config, OpDelete)
+	return &WarningDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+func (c *WarningClient) DeleteOne(_m *Warning) *WarningDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleClient) DeleteOneID(id xid.ID) *RoleDeleteOne {
-	builder := c.Delete().Where(role.ID(id))
+func (c *WarningClient) DeleteOneID(id xid.ID) *WarningDeleteOne {
+	builder := c.Delete().Where(warning.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleDeleteOne{builder}
+	return &WarningDeleteOne{builder}
 }
 
-// Query returns a query builder for Role.
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{
+// Query returns a query builder for Warning.
+func (c *WarningClient) Query() *WarningQuery {
+	return &WarningQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRole},
+		ctx:    &QueryContext{Type: TypeWarning},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Role entity by its id.
-func (c *RoleClient) Get(ctx context.Context, id xid.ID) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+// Get returns a Warning entity by its id.
+func (c *WarningClient) Get(ctx context.Context, id xid.ID) (*Warning, error) {
+	return c.Query().Where(warning.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleClient) GetX(ctx context.Context, id xid.ID) *Role {
+func (c *WarningClient) GetX(ctx context.Context, id xid.ID) *Warning {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5742,15 +15265,15 @@ func (c *RoleClient) GetX(ctx context.Context, id xid.ID) *Role {
 	return obj
 }
 
-// QueryAccounts queries the accounts edge of a Role.
-func (c *RoleClient) QueryAccounts(_m *Role) *AccountQuery {
+// QueryAccount queries the account edge of a Warning.
+func (c *WarningClient) QueryAccount(_m *Warning) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.From(warning.Table, warning.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, role.AccountsTable, role.AccountsPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, warning.AccountTable, warning.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5758,15 +15281,15 @@ func (c *RoleClient) QueryAccounts(_m *Role) *AccountQuery {
 	return query
 }
 
-// QueryAccountRoles queries the account_roles edge of a Role.
-func (c *RoleClient) QueryAccountRoles(_m *Role) *AccountRolesQuery {
-	query := (&AccountRolesClient{config: c.config}).Query()
+// QueryIssuedBy queries the issued_by edge of a Warning.
+func (c *WarningClient) QueryIssuedBy(_m *Warning) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(accountroles.Table, accountroles.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, role.AccountRolesTable, role.AccountRolesColumn),
+			sqlgraph.From(warning.Table, warning.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, warning.IssuedByTable, warning.IssuedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5775,131 +15298,131 @@ func (c *RoleClient) QueryAccountRoles(_m *Role) *AccountRolesQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+func (c *WarningClient) Hooks() []Hook {
+	return c.hooks.Warning
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	return c.inters.Role
+func (c *WarningClient) Interceptors() []Interceptor {
+	return c.inters.Warning
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *WarningClient) mutate(ctx context.Context, m *WarningMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarningCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarningUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WarningDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Warning mutation op: %q", m.Op())
 	}
 }
 
-// SessionClient is a client for the Session schema.
-type SessionClient struct {
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
 	config
 }
 
-// NewSessionClient returns a client for the Session from the given config.
-func NewSessionClient(c config) *SessionClient {
-	return &SessionClient{config: c}
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
-func (c *SessionClient) Use(hooks ...Hook) {
-	c.hooks.Session = append(c.hooks.Session, hooks...)
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
-func (c *SessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Session = append(c.inters.Session, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
 }
 
-// Create returns a builder for creating a Session entity.
-func (c *SessionClient) Create() *SessionCreate {
-	mutation := newSessionMutation(c.config, OpCreate)
-	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Session entities.
-func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
-	return &SessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SessionCreate, rv.Len())
+	builders := make([]*WebhookCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SessionCreateBulk{config: c.config, builders: builders}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Session.
-func (c *SessionClient) Update() *SessionUpdate {
-	mutation := newSessionMutation(c.config, OpUpdate)
-	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SessionClient) UpdateOne(_m *Session) *SessionUpdateOne {
-	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(_m))
-	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookClient) UpdateOne(_m *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(_m))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SessionClient) UpdateOneID(id xid.ID) *SessionUpdateOne {
-	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
-	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookClient) UpdateOneID(id xid.ID) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Session.
-func (c *SessionClient) Delete() *SessionDelete {
-	mutation := newSessionMutation(c.config, OpDelete)
-	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SessionClient) DeleteOne(_m *Session) *SessionDeleteOne {
+func (c *WebhookClient) DeleteOne(_m *Webhook) *WebhookDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SessionClient) DeleteOneID(id xid.ID) *SessionDeleteOne {
-	builder := c.Delete().Where(session.ID(id))
+func (c *WebhookClient) DeleteOneID(id xid.ID) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SessionDeleteOne{builder}
+	return &WebhookDeleteOne{builder}
 }
 
-// Query returns a query builder for Session.
-func (c *SessionClient) Query() *SessionQuery {
-	return &SessionQuery{
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSession},
+		ctx:    &QueryContext{Type: TypeWebhook},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Session entity by its id.
-func (c *SessionClient) Get(ctx context.Context, id xid.ID) (*Session, error) {
-	return c.Query().Where(session.ID(id)).Only(ctx)
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id xid.ID) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SessionClient) GetX(ctx context.Context, id xid.ID) *Session {
+func (c *WebhookClient) GetX(ctx context.Context, id xid.ID) *Webhook {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5907,15 +15430,31 @@ func (c *SessionClient) GetX(ctx context.Context, id xid.ID) *Session {
 	return obj
 }
 
-// QueryAccount queries the account edge of a Session.
-func (c *SessionClient) QueryAccount(_m *Session) *AccountQuery {
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(_m *Webhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccount queries the account edge of a Webhook.
+func (c *WebhookClient) QueryAccount(_m *Webhook) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, session.AccountTable, session.AccountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhook.AccountTable, webhook.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5924,131 +15463,131 @@ func (c *SessionClient) QueryAccount(_m *Session) *AccountQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *SessionClient) Hooks() []Hook {
-	return c.hooks.Session
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
 }
 
 // Interceptors returns the client interceptors.
-func (c *SessionClient) Interceptors() []Interceptor {
-	return c.inters.Session
+func (c *WebhookClient) Interceptors() []Interceptor {
+	return c.inters.Webhook
 }
 
-func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Webhook mutation op: %q", m.Op())
 	}
 }
 
-// SettingClient is a client for the Setting schema.
-type SettingClient struct {
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
 	config
 }
 
-// NewSettingClient returns a client for the Setting from the given config.
-func NewSettingClient(c config) *SettingClient {
-	return &SettingClient{config: c}
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
-func (c *SettingClient) Use(hooks ...Hook) {
-	c.hooks.Setting = append(c.hooks.Setting, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
-func (c *SettingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Setting = append(c.inters.Setting, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a Setting entity.
-func (c *SettingClient) Create() *SettingCreate {
-	mutation := newSettingMutation(c.config, OpCreate)
-	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Setting entities.
-func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
-	return &SettingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SettingCreate, rv.Len())
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SettingCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Setting.
-func (c *SettingClient) Update() *SettingUpdate {
-	mutation := newSettingMutation(c.config, OpUpdate)
-	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SettingClient) UpdateOne(_m *Setting) *SettingUpdateOne {
-	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(_m))
-	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SettingClient) UpdateOneID(id string) *SettingUpdateOne {
-	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
-	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOneID(id xid.ID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Setting.
-func (c *SettingClient) Delete() *SettingDelete {
-	mutation := newSettingMutation(c.config, OpDelete)
-	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SettingClient) DeleteOne(_m *Setting) *SettingDeleteOne {
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SettingClient) DeleteOneID(id string) *SettingDeleteOne {
-	builder := c.Delete().Where(setting.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id xid.ID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SettingDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for Setting.
-func (c *SettingClient) Query() *SettingQuery {
-	return &SettingQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSetting},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Setting entity by its id.
-func (c *SettingClient) Get(ctx context.Context, id string) (*Setting, error) {
-	return c.Query().Where(setting.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id xid.ID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SettingClient) GetX(ctx context.Context, id string) *Setting {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id xid.ID) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -6056,132 +15595,148 @@ func (c *SettingClient) GetX(ctx context.Context, id string) *Setting {
 	return obj
 }
 
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(_m *WebhookDelivery) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *SettingClient) Hooks() []Hook {
-	return c.hooks.Setting
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
 }
 
 // Interceptors returns the client interceptors.
-func (c *SettingClient) Interceptors() []Interceptor {
-	return c.inters.Setting
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
 }
 
-// TagClient is a client for the Tag schema.
-type TagClient struct {
+// WordFilterClient is a client for the WordFilter schema.
+type WordFilterClient struct {
 	config
 }
 
-// NewTagClient returns a client for the Tag from the given config.
-func NewTagClient(c config) *TagClient {
-	return &TagClient{config: c}
+// NewWordFilterClient returns a client for the WordFilter from the given config.
+func NewWordFilterClient(c config) *WordFilterClient {
+	return &WordFilterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
-func (c *TagClient) Use(hooks ...Hook) {
-	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+// A call to `Use(f, g, h)` equals to `wordfilter.Hooks(f(g(h())))`.
+func (c *WordFilterClient) Use(hooks ...Hook) {
+	c.hooks.WordFilter = append(c.hooks.WordFilter, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
-func (c *TagClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Tag = append(c.inters.Tag, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `wordfilter.Intercept(f(g(h())))`.
+func (c *WordFilterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WordFilter = append(c.inters.WordFilter, interceptors...)
 }
 
-// Create returns a builder for creating a Tag entity.
-func (c *TagClient) Create() *TagCreate {
-	mutation := newTagMutation(c.config, OpCreate)
-	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WordFilter entity.
+func (c *WordFilterClient) Create() *WordFilterCreate {
+	mutation := newWordFilterMutation(c.config, OpCreate)
+	return &WordFilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Tag entities.
-func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
-	return &TagCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WordFilter entities.
+func (c *WordFilterClient) CreateBulk(builders ...*WordFilterCreate) *WordFilterCreateBulk {
+	return &WordFilterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+func (c *WordFilterClient) MapCreateBulk(slice any, setFunc func(*WordFilterCreate, int)) *WordFilterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WordFilterCreateBulk{err: fmt.Errorf("calling to WordFilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TagCreate, rv.Len())
+	builders := make([]*WordFilterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TagCreateBulk{config: c.config, builders: builders}
+	return &WordFilterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Tag.
-func (c *TagClient) Update() *TagUpdate {
-	mutation := newTagMutation(c.config, OpUpdate)
-	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WordFilter.
+func (c *WordFilterClient) Update() *WordFilterUpdate {
+	mutation := newWordFilterMutation(c.config, OpUpdate)
+	return &WordFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
-	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
-	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WordFilterClient) UpdateOne(_m *WordFilter) *WordFilterUpdateOne {
+	mutation := newWordFilterMutation(c.config, OpUpdateOne, withWordFilter(_m))
+	return &WordFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TagClient) UpdateOneID(id xid.ID) *TagUpdateOne {
-	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
-	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WordFilterClient) UpdateOneID(id xid.ID) *WordFilterUpdateOne {
+	mutation := newWordFilterMutation(c.config, OpUpdateOne, withWordFilterID(id))
+	return &WordFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Tag.
-func (c *TagClient) Delete() *TagDelete {
-	mutation := newTagMutation(c.config, OpDelete)
-	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WordFilter.
+func (c *WordFilterClient) Delete() *WordFilterDelete {
+	mutation := newWordFilterMutation(c.config, OpDelete)
+	return &WordFilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
+func (c *WordFilterClient) DeleteOne(_m *WordFilter) *WordFilterDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TagClient) DeleteOneID(id xid.ID) *TagDeleteOne {
-	builder := c.Delete().Where(tag.ID(id))
+func (c *WordFilterClient) DeleteOneID(id xid.ID) *WordFilterDeleteOne {
+	builder := c.Delete().Where(wordfilter.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TagDeleteOne{builder}
+	return &WordFilterDeleteOne{builder}
 }
 
-// Query returns a query builder for Tag.
-func (c *TagClient) Query() *TagQuery {
-	return &TagQuery{
+// Query returns a query builder for WordFilter.
+func (c *WordFilterClient) Query() *WordFilterQuery {
+	return &WordFilterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTag},
+		ctx:    &QueryContext{Type: TypeWordFilter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Tag entity by its id.
-func (c *TagClient) Get(ctx context.Context, id xid.ID) (*Tag, error) {
-	return c.Query().Where(tag.ID(id)).Only(ctx)
+// Get returns a WordFilter entity by its id.
+func (c *WordFilterClient) Get(ctx context.Context, id xid.ID) (*WordFilter, error) {
+	return c.Query().Where(wordfilter.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TagClient) GetX(ctx context.Context, id xid.ID) *Tag {
+func (c *WordFilterClient) GetX(ctx context.Context, id xid.ID) *WordFilter {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -6189,94 +15744,69 @@ func (c *TagClient) GetX(ctx context.Context, id xid.ID) *Tag {
 	return obj
 }
 
-// QueryPosts queries the posts edge of a Tag.
-func (c *TagClient) QueryPosts(_m *Tag) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tag.Table, tag.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, tag.PostsTable, tag.PostsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryNodes queries the nodes edge of a Tag.
-func (c *TagClient) QueryNodes(_m *Tag) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tag.Table, tag.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, tag.NodesTable, tag.NodesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAccounts queries the accounts edge of a Tag.
-func (c *TagClient) QueryAccounts(_m *Tag) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tag.Table, tag.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, tag.AccountsTable, tag.AccountsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TagClient) Hooks() []Hook {
-	return c.hooks.Tag
+func (c *WordFilterClient) Hooks() []Hook {
+	return c.hooks.WordFilter
 }
 
 // Interceptors returns the client interceptors.
-func (c *TagClient) Interceptors() []Interceptor {
-	return c.inters.Tag
+func (c *WordFilterClient) Interceptors() []Interceptor {
+	return c.inters.WordFilter
 }
 
-func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
+func (c *WordFilterClient) mutate(ctx context.Context, m *WordFilterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WordFilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WordFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WordFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WordFilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WordFilter mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Account, AccountFollow, AccountRoles, Asset, Authentication, Category,
-		Collection, CollectionNode, CollectionPost, Email, Event, EventParticipant,
-		Invitation, LikePost, Link, MentionProfile, Node, Notification, Post, PostRead,
-		Property, PropertySchema, PropertySchemaField, Question, React, Report, Role,
-		Session, Setting, Tag []ent.Hook
+		APIUsage, Account, AccountApplication, AccountBadge, AccountBlock,
+		AccountFollow, AccountOnboardingStep, AccountRoles, AnalyticsCohort,
+		AnalyticsContent, AnalyticsDay, AnalyticsExport, Asset, Authentication,
+		AutomodRule, Badge, Bookmark, Category, CategoryFollow, CategoryPermission,
+		Collection, CollectionNode, CollectionPost, CollectionSection, CollectionShare,
+		ContentSummary, ContentView, Conversation, ConversationParticipant,
+		DigestSubscription, DirectMessage, DiscordBridgeLink, Email, Event,
+		EventParticipant, FeatureFlag, FederationFollower, FederationKey, FeedToken,
+		ImportRecord, ImportRun, Invitation, Job, LikePost, Link, LinkCheck,
+		MatrixBridgeLink, MentionProfile, ModerationNote, NetworkBan, Node,
+		Notification, NotificationPreference, PolicyAcceptance, PolicyDocument,
+		PolicyVersion, Post, PostRead, Property, PropertySchema, PropertySchemaField,
+		PushSubscription, Question, React, ReadingHistory, Report, ReputationEntry,
+		Role, ScheduledTask, Session, Setting, Space, SpaceMember, Tag, TagFollow,
+		TagSynonym, TelegramMessage, Tenant, TrendingScore, UploadSession,
+		VerificationRequest, Warning, Webhook, WebhookDelivery, WordFilter []ent.Hook
 	}
 	inters struct {
-		Account, AccountFollow, AccountRoles, Asset, Authentication, Category,
-		Collection, CollectionNode, CollectionPost, Email, Event, EventParticipant,
-		Invitation, LikePost, Link, MentionProfile, Node, Notification, Post, PostRead,
-		Property, PropertySchema, PropertySchemaField, Question, React, Report, Role,
-		Session, Setting, Tag []ent.Interceptor
+		APIUsage, Account, AccountApplication, AccountBadge, AccountBlock,
+		AccountFollow, AccountOnboardingStep, AccountRoles, AnalyticsCohort,
+		AnalyticsContent, AnalyticsDay, AnalyticsExport, Asset, Authentication,
+		AutomodRule, Badge, Bookmark, Category, CategoryFollow, CategoryPermission,
+		Collection, CollectionNode, CollectionPost, CollectionSection, CollectionShare,
+		ContentSummary, ContentView, Conversation, ConversationParticipant,
+		DigestSubscription, DirectMessage, DiscordBridgeLink, Email, Event,
+		EventParticipant, FeatureFlag, FederationFollower, FederationKey, FeedToken,
+		ImportRecord, ImportRun, Invitation, Job, LikePost, Link, LinkCheck,
+		MatrixBridgeLink, MentionProfile, ModerationNote, NetworkBan, Node,
+		Notification, NotificationPreference, PolicyAcceptance, PolicyDocument,
+		PolicyVersion, Post, PostRead, Property, PropertySchema, PropertySchemaField,
+		PushSubscription, Question, React, ReadingHistory, Report, ReputationEntry,
+		Role, ScheduledTask, Session, Setting, Space, SpaceMember, Tag, TagFollow,
+		TagSynonym, TelegramMessage, Tenant, TrendingScore, UploadSession,
+		VerificationRequest, Warning, Webhook, WebhookDelivery,
+		WordFilter []ent.Interceptor
 	}
 )
 
@@ -53,13 +53,17 @@ type CollectionEdges struct {
 	Posts []*Post `json:"posts,omitempty"`
 	// Nodes holds the value of the nodes edge.
 	Nodes []*Node `json:"nodes,omitempty"`
+	// Sections holds the value of the sections edge.
+	Sections []*CollectionSection `json:"sections,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*CollectionShare `json:"shares,omitempty"`
 	// CollectionPosts holds the value of the collection_posts edge.
 	CollectionPosts []*CollectionPost `json:"collection_posts,omitempty"`
 	// CollectionNodes holds the value of the collection_nodes edge.
 	CollectionNodes []*CollectionNode `json:"collection_nodes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [8]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -102,10 +106,28 @@ func (e CollectionEdges) NodesOrErr() ([]*Node, error) {
 	return nil, &NotLoadedError{edge: "nodes"}
 }
 
+// SectionsOrErr returns the Sections value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollectionEdges) SectionsOrErr() ([]*CollectionSection, error) {
+	if e.loadedTypes[4] {
+		return e.Sections, nil
+	}
+	return nil, &NotLoadedError{edge: "sections"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollectionEdges) SharesOrErr() ([]*CollectionShare, error) {
+	if e.loadedTypes[5] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
 // CollectionPostsOrErr returns the CollectionPosts value or an error if the edge
 // was not loaded in eager-loading.
 func (e CollectionEdges) CollectionPostsOrErr() ([]*CollectionPost, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.CollectionPosts, nil
 	}
 	return nil, &NotLoadedError{edge: "collection_posts"}
@@ -114,7 +136,7 @@ func (e CollectionEdges) CollectionPostsOrErr() ([]*CollectionPost, error) {
 // CollectionNodesOrErr returns the CollectionNodes value or an error if the edge
 // was not loaded in eager-loading.
 func (e CollectionEdges) CollectionNodesOrErr() ([]*CollectionNode, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[7] {
 		return e.CollectionNodes, nil
 	}
 	return nil, &NotLoadedError{edge: "collection_nodes"}
@@ -247,6 +269,16 @@ func (_m *Collection) QueryNodes() *NodeQuery {
 	return NewCollectionClient(_m.config).QueryNodes(_m)
 }
 
+// QuerySections queries the "sections" edge of the Collection entity.
+func (_m *Collection) QuerySections() *CollectionSectionQuery {
+	return NewCollectionClient(_m.config).QuerySections(_m)
+}
+
+// QueryShares queries the "shares" edge of the Collection entity.
+func (_m *Collection) QueryShares() *CollectionShareQuery {
+	return NewCollectionClient(_m.config).QueryShares(_m)
+}
+
 // QueryCollectionPosts queries the "collection_posts" edge of the Collection entity.
 func (_m *Collection) QueryCollectionPosts() *CollectionPostQuery {
 	return NewCollectionClient(_m.config).QueryCollectionPosts(_m)
@@ -40,6 +40,10 @@ const (
 	EdgePosts = "posts"
 	// EdgeNodes holds the string denoting the nodes edge name in mutations.
 	EdgeNodes = "nodes"
+	// EdgeSections holds the string denoting the sections edge name in mutations.
+	EdgeSections = "sections"
+	// EdgeShares holds the string denoting the shares edge name in mutations.
+	EdgeShares = "shares"
 	// EdgeCollectionPosts holds the string denoting the collection_posts edge name in mutations.
 	EdgeCollectionPosts = "collection_posts"
 	// EdgeCollectionNodes holds the string denoting the collection_nodes edge name in mutations.
@@ -70,6 +74,20 @@ const (
 	// NodesInverseTable is the table name for the Node entity.
 	// It exists in this package in order to avoid circular dependency with the "node" package.
 	NodesInverseTable = "nodes"
+	// SectionsTable is the table that holds the sections relation/edge.
+	SectionsTable = "collection_sections"
+	// SectionsInverseTable is the table name for the CollectionSection entity.
+	// It exists in this package in order to avoid circular dependency with the "collectionsection" package.
+	SectionsInverseTable = "collection_sections"
+	// SectionsColumn is the table column denoting the sections relation/edge.
+	SectionsColumn = "collection_id"
+	// SharesTable is the table that holds the shares relation/edge.
+	SharesTable = "collection_shares"
+	// SharesInverseTable is the table name for the CollectionShare entity.
+	// It exists in this package in order to avoid circular dependency with the "collectionshare" package.
+	SharesInverseTable = "collection_shares"
+	// SharesColumn is the table column denoting the shares relation/edge.
+	SharesColumn = "collection_id"
 	// CollectionPostsTable is the table that holds the collection_posts relation/edge.
 	CollectionPostsTable = "collection_posts"
 	// CollectionPostsInverseTable is the table name for the CollectionPost entity.
@@ -260,6 +278,34 @@ func ByNodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// BySectionsCount orders the results by sections count.
+func BySectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSectionsStep(), opts...)
+	}
+}
+
+// BySections orders the results by sections terms.
+func BySections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySharesCount orders the results by shares count.
+func BySharesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharesStep(), opts...)
+	}
+}
+
+// ByShares orders the results by shares terms.
+func ByShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByCollectionPostsCount orders the results by collection_posts count.
 func ByCollectionPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -315,6 +361,20 @@ func newNodesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, NodesTable, NodesPrimaryKey...),
 	)
 }
+func newSectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+	)
+}
+func newSharesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
+	)
+}
 func newCollectionPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -15,6 +15,8 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/rs/xid"
@@ -206,6 +208,36 @@ func (_c *CollectionCreate) AddNodes(v ...*Node) *CollectionCreate {
 	return _c.AddNodeIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the CollectionSection entity by IDs.
+func (_c *CollectionCreate) AddSectionIDs(ids ...xid.ID) *CollectionCreate {
+	_c.mutation.AddSectionIDs(ids...)
+	return _c
+}
+
+// AddSections adds the "sections" edges to the CollectionSection entity.
+func (_c *CollectionCreate) AddSections(v ...*CollectionSection) *CollectionCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSectionIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the CollectionShare entity by IDs.
+func (_c *CollectionCreate) AddShareIDs(ids ...xid.ID) *CollectionCreate {
+	_c.mutation.AddShareIDs(ids...)
+	return _c
+}
+
+// AddShares adds the "shares" edges to the CollectionShare entity.
+func (_c *CollectionCreate) AddShares(v ...*CollectionShare) *CollectionCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareIDs(ids...)
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (_c *CollectionCreate) Mutation() *CollectionMutation {
 	return _c.mutation
@@ -424,6 +456,38 @@ func (_c *CollectionCreate) createSpec() (*Collection, *sqlgraph.CreateSpec) {
 		edge.Target.Fields = specE.Fields
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SectionsTable,
+			Columns: []string{collection.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SharesTable,
+			Columns: []string{collection.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
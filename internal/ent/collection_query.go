@@ -17,6 +17,8 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
@@ -34,6 +36,8 @@ type CollectionQuery struct {
 	withCoverImage      *AssetQuery
 	withPosts           *PostQuery
 	withNodes           *NodeQuery
+	withSections        *CollectionSectionQuery
+	withShares          *CollectionShareQuery
 	withCollectionPosts *CollectionPostQuery
 	withCollectionNodes *CollectionNodeQuery
 	withFKs             bool
@@ -162,6 +166,50 @@ func (_q *CollectionQuery) QueryNodes() *NodeQuery {
 	return query
 }
 
+// QuerySections chains the current query on the "sections" edge.
+func (_q *CollectionQuery) QuerySections() *CollectionSectionQuery {
+	query := (&CollectionSectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, selector),
+			sqlgraph.To(collectionsection.Table, collectionsection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collection.SectionsTable, collection.SectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShares chains the current query on the "shares" edge.
+func (_q *CollectionQuery) QueryShares() *CollectionShareQuery {
+	query := (&CollectionShareClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, selector),
+			sqlgraph.To(collectionshare.Table, collectionshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collection.SharesTable, collection.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCollectionPosts chains the current query on the "collection_posts" edge.
 func (_q *CollectionQuery) QueryCollectionPosts() *CollectionPostQuery {
 	query := (&CollectionPostClient{config: _q.config}).Query()
@@ -402,6 +450,8 @@ func (_q *CollectionQuery) Clone() *CollectionQuery {
 		withCoverImage:      _q.withCoverImage.Clone(),
 		withPosts:           _q.withPosts.Clone(),
 		withNodes:           _q.withNodes.Clone(),
+		withSections:        _q.withSections.Clone(),
+		withShares:          _q.withShares.Clone(),
 		withCollectionPosts: _q.withCollectionPosts.Clone(),
 		withCollectionNodes: _q.withCollectionNodes.Clone(),
 		// clone intermediate query.
@@ -455,6 +505,28 @@ func (_q *CollectionQuery) WithNodes(opts ...func(*NodeQuery)) *CollectionQuery
 	return _q
 }
 
+// WithSections tells the query-builder to eager-load the nodes that are connected to
+// the "sections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CollectionQuery) WithSections(opts ...func(*CollectionSectionQuery)) *CollectionQuery {
+	query := (&CollectionSectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSections = query
+	return _q
+}
+
+// WithShares tells the query-builder to eager-load the nodes that are connected to
+// the "shares" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CollectionQuery) WithShares(opts ...func(*CollectionShareQuery)) *CollectionQuery {
+	query := (&CollectionShareClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShares = query
+	return _q
+}
+
 // WithCollectionPosts tells the query-builder to eager-load the nodes that are connected to
 // the "collection_posts" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *CollectionQuery) WithCollectionPosts(opts ...func(*CollectionPostQuery)) *CollectionQuery {
@@ -556,11 +628,13 @@ func (_q *CollectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 		nodes       = []*Collection{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [8]bool{
 			_q.withOwner != nil,
 			_q.withCoverImage != nil,
 			_q.withPosts != nil,
 			_q.withNodes != nil,
+			_q.withSections != nil,
+			_q.withShares != nil,
 			_q.withCollectionPosts != nil,
 			_q.withCollectionNodes != nil,
 		}
@@ -618,6 +692,20 @@ func (_q *CollectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 			return nil, err
 		}
 	}
+	if query := _q.withSections; query != nil {
+		if err := _q.loadSections(ctx, query, nodes,
+			func(n *Collection) { n.Edges.Sections = []*CollectionSection{} },
+			func(n *Collection, e *CollectionSection) { n.Edges.Sections = append(n.Edges.Sections, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShares; query != nil {
+		if err := _q.loadShares(ctx, query, nodes,
+			func(n *Collection) { n.Edges.Shares = []*CollectionShare{} },
+			func(n *Collection, e *CollectionShare) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withCollectionPosts; query != nil {
 		if err := _q.loadCollectionPosts(ctx, query, nodes,
 			func(n *Collection) { n.Edges.CollectionPosts = []*CollectionPost{} },
@@ -821,6 +909,66 @@ func (_q *CollectionQuery) loadNodes(ctx context.Context, query *NodeQuery, node
 	}
 	return nil
 }
+func (_q *CollectionQuery) loadSections(ctx context.Context, query *CollectionSectionQuery, nodes []*Collection, init func(*Collection), assign func(*Collection, *CollectionSection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Collection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(collectionsection.FieldCollectionID)
+	}
+	query.Where(predicate.CollectionSection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(collection.SectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CollectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "collection_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CollectionQuery) loadShares(ctx context.Context, query *CollectionShareQuery, nodes []*Collection, init func(*Collection), assign func(*Collection, *CollectionShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Collection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(collectionshare.FieldCollectionID)
+	}
+	query.Where(predicate.CollectionShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(collection.SharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CollectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "collection_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *CollectionQuery) loadCollectionPosts(ctx context.Context, query *CollectionPostQuery, nodes []*Collection, init func(*Collection), assign func(*Collection, *CollectionPost)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Collection)
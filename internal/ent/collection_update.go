@@ -14,6 +14,8 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
@@ -210,6 +212,36 @@ func (_u *CollectionUpdate) AddNodes(v ...*Node) *CollectionUpdate {
 	return _u.AddNodeIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the CollectionSection entity by IDs.
+func (_u *CollectionUpdate) AddSectionIDs(ids ...xid.ID) *CollectionUpdate {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the CollectionSection entity.
+func (_u *CollectionUpdate) AddSections(v ...*CollectionSection) *CollectionUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the CollectionShare entity by IDs.
+func (_u *CollectionUpdate) AddShareIDs(ids ...xid.ID) *CollectionUpdate {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the CollectionShare entity.
+func (_u *CollectionUpdate) AddShares(v ...*CollectionShare) *CollectionUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (_u *CollectionUpdate) Mutation() *CollectionMutation {
 	return _u.mutation
@@ -269,6 +301,48 @@ func (_u *CollectionUpdate) RemoveNodes(v ...*Node) *CollectionUpdate {
 	return _u.RemoveNodeIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the CollectionSection entity.
+func (_u *CollectionUpdate) ClearSections() *CollectionUpdate {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to CollectionSection entities by IDs.
+func (_u *CollectionUpdate) RemoveSectionIDs(ids ...xid.ID) *CollectionUpdate {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to CollectionSection entities.
+func (_u *CollectionUpdate) RemoveSections(v ...*CollectionSection) *CollectionUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearShares clears all "shares" edges to the CollectionShare entity.
+func (_u *CollectionUpdate) ClearShares() *CollectionUpdate {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// RemoveShareIDs removes the "shares" edge to CollectionShare entities by IDs.
+func (_u *CollectionUpdate) RemoveShareIDs(ids ...xid.ID) *CollectionUpdate {
+	_u.mutation.RemoveShareIDs(ids...)
+	return _u
+}
+
+// RemoveShares removes "shares" edges to CollectionShare entities.
+func (_u *CollectionUpdate) RemoveShares(v ...*CollectionShare) *CollectionUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CollectionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -529,6 +603,96 @@ func (_u *CollectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SectionsTable,
+			Columns: []string{collection.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SectionsTable,
+			Columns: []string{collection.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SectionsTable,
+			Columns: []string{collection.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SharesTable,
+			Columns: []string{collection.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SharesTable,
+			Columns: []string{collection.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SharesTable,
+			Columns: []string{collection.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -727,6 +891,36 @@ func (_u *CollectionUpdateOne) AddNodes(v ...*Node) *CollectionUpdateOne {
 	return _u.AddNodeIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the CollectionSection entity by IDs.
+func (_u *CollectionUpdateOne) AddSectionIDs(ids ...xid.ID) *CollectionUpdateOne {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the CollectionSection entity.
+func (_u *CollectionUpdateOne) AddSections(v ...*CollectionSection) *CollectionUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the CollectionShare entity by IDs.
+func (_u *CollectionUpdateOne) AddShareIDs(ids ...xid.ID) *CollectionUpdateOne {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the CollectionShare entity.
+func (_u *CollectionUpdateOne) AddShares(v ...*CollectionShare) *CollectionUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (_u *CollectionUpdateOne) Mutation() *CollectionMutation {
 	return _u.mutation
@@ -786,6 +980,48 @@ func (_u *CollectionUpdateOne) RemoveNodes(v ...*Node) *CollectionUpdateOne {
 	return _u.RemoveNodeIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the CollectionSection entity.
+func (_u *CollectionUpdateOne) ClearSections() *CollectionUpdateOne {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to CollectionSection entities by IDs.
+func (_u *CollectionUpdateOne) RemoveSectionIDs(ids ...xid.ID) *CollectionUpdateOne {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to CollectionSection entities.
+func (_u *CollectionUpdateOne) RemoveSections(v ...*CollectionSection) *CollectionUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearShares clears all "shares" edges to the CollectionShare entity.
+func (_u *CollectionUpdateOne) ClearShares() *CollectionUpdateOne {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// RemoveShareIDs removes the "shares" edge to CollectionShare entities by IDs.
+func (_u *CollectionUpdateOne) RemoveShareIDs(ids ...xid.ID) *CollectionUpdateOne {
+	_u.mutation.RemoveShareIDs(ids...)
+	return _u
+}
+
+// RemoveShares removes "shares" edges to CollectionShare entities.
+func (_u *CollectionUpdateOne) RemoveShares(v ...*CollectionShare) *CollectionUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareIDs(ids...)
+}
+
 // Where appends a list predicates to the CollectionUpdate builder.
 func (_u *CollectionUpdateOne) Where(ps ...predicate.Collection) *CollectionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1076,6 +1312,96 @@ func (_u *CollectionUpdateOne) sqlSave(ctx context.Context) (_node *Collection,
 		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SectionsTable,
+			Columns: []string{collection.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SectionsTable,
+			Columns: []string{collection.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SectionsTable,
+			Columns: []string{collection.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SharesTable,
+			Columns: []string{collection.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SharesTable,
+			Columns: []string{collection.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.SharesTable,
+			Columns: []string{collection.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Collection{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -9,8 +9,10 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/rs/xid"
 )
@@ -26,6 +28,10 @@ type CollectionNode struct {
 	NodeID xid.ID `json:"node_id,omitempty"`
 	// MembershipType holds the value of the "membership_type" field.
 	MembershipType string `json:"membership_type,omitempty"`
+	// Sort holds the value of the "sort" field.
+	Sort *lexorank.Key `json:"sort,omitempty"`
+	// SectionID holds the value of the "section_id" field.
+	SectionID *xid.ID `json:"section_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CollectionNodeQuery when eager-loading is set.
 	Edges        CollectionNodeEdges `json:"edges"`
@@ -38,9 +44,11 @@ type CollectionNodeEdges struct {
 	Collection *Collection `json:"collection,omitempty"`
 	// Node holds the value of the node edge.
 	Node *Node `json:"node,omitempty"`
+	// Section holds the value of the section edge.
+	Section *CollectionSection `json:"section,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // CollectionOrErr returns the Collection value or an error if the edge
@@ -65,11 +73,26 @@ func (e CollectionNodeEdges) NodeOrErr() (*Node, error) {
 	return nil, &NotLoadedError{edge: "node"}
 }
 
+// SectionOrErr returns the Section value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollectionNodeEdges) SectionOrErr() (*CollectionSection, error) {
+	if e.Section != nil {
+		return e.Section, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: collectionsection.Label}
+	}
+	return nil, &NotLoadedError{edge: "section"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CollectionNode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case collectionnode.FieldSort:
+			values[i] = &sql.NullScanner{S: new(lexorank.Key)}
+		case collectionnode.FieldSectionID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
 		case collectionnode.FieldMembershipType:
 			values[i] = new(sql.NullString)
 		case collectionnode.FieldCreatedAt:
@@ -115,6 +138,20 @@ func (_m *CollectionNode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MembershipType = value.String
 			}
+		case collectionnode.FieldSort:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = new(lexorank.Key)
+				*_m.Sort = *value.S.(*lexorank.Key)
+			}
+		case collectionnode.FieldSectionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field section_id", values[i])
+			} else if value.Valid {
+				_m.SectionID = new(xid.ID)
+				*_m.SectionID = *value.S.(*xid.ID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -138,6 +175,11 @@ func (_m *CollectionNode) QueryNode() *NodeQuery {
 	return NewCollectionNodeClient(_m.config).QueryNode(_m)
 }
 
+// QuerySection queries the "section" edge of the CollectionNode entity.
+func (_m *CollectionNode) QuerySection() *CollectionSectionQuery {
+	return NewCollectionNodeClient(_m.config).QuerySection(_m)
+}
+
 // Update returns a builder for updating this CollectionNode.
 // Note that you need to call CollectionNode.Unwrap() before calling this method if this CollectionNode
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,6 +213,16 @@ func (_m *CollectionNode) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("membership_type=")
 	builder.WriteString(_m.MembershipType)
+	builder.WriteString(", ")
+	if v := _m.Sort; v != nil {
+		builder.WriteString("sort=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SectionID; v != nil {
+		builder.WriteString("section_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/rs/xid"
 )
@@ -31,6 +32,16 @@ func MembershipType(v string) predicate.CollectionNode {
 	return predicate.CollectionNode(sql.FieldEQ(FieldMembershipType, v))
 }
 
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldEQ(FieldSort, v))
+}
+
+// SectionID applies equality check predicate on the "section_id" field. It's identical to SectionIDEQ.
+func SectionID(v xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldEQ(FieldSectionID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.CollectionNode {
 	return predicate.CollectionNode(sql.FieldEQ(FieldCreatedAt, v))
@@ -276,6 +287,166 @@ func MembershipTypeContainsFold(v string) predicate.CollectionNode {
 	return predicate.CollectionNode(sql.FieldContainsFold(FieldMembershipType, v))
 }
 
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v lexorank.Key) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldLTE(FieldSort, v))
+}
+
+// SortContains applies the Contains predicate on the "sort" field.
+func SortContains(v lexorank.Key) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldContains(FieldSort, vc))
+}
+
+// SortHasPrefix applies the HasPrefix predicate on the "sort" field.
+func SortHasPrefix(v lexorank.Key) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldHasPrefix(FieldSort, vc))
+}
+
+// SortHasSuffix applies the HasSuffix predicate on the "sort" field.
+func SortHasSuffix(v lexorank.Key) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldHasSuffix(FieldSort, vc))
+}
+
+// SortIsNil applies the IsNil predicate on the "sort" field.
+func SortIsNil() predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldIsNull(FieldSort))
+}
+
+// SortNotNil applies the NotNil predicate on the "sort" field.
+func SortNotNil() predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldNotNull(FieldSort))
+}
+
+// SortEqualFold applies the EqualFold predicate on the "sort" field.
+func SortEqualFold(v lexorank.Key) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldEqualFold(FieldSort, vc))
+}
+
+// SortContainsFold applies the ContainsFold predicate on the "sort" field.
+func SortContainsFold(v lexorank.Key) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldContainsFold(FieldSort, vc))
+}
+
+// SectionIDEQ applies the EQ predicate on the "section_id" field.
+func SectionIDEQ(v xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldEQ(FieldSectionID, v))
+}
+
+// SectionIDNEQ applies the NEQ predicate on the "section_id" field.
+func SectionIDNEQ(v xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldNEQ(FieldSectionID, v))
+}
+
+// SectionIDIn applies the In predicate on the "section_id" field.
+func SectionIDIn(vs ...xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldIn(FieldSectionID, vs...))
+}
+
+// SectionIDNotIn applies the NotIn predicate on the "section_id" field.
+func SectionIDNotIn(vs ...xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldNotIn(FieldSectionID, vs...))
+}
+
+// SectionIDGT applies the GT predicate on the "section_id" field.
+func SectionIDGT(v xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldGT(FieldSectionID, v))
+}
+
+// SectionIDGTE applies the GTE predicate on the "section_id" field.
+func SectionIDGTE(v xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldGTE(FieldSectionID, v))
+}
+
+// SectionIDLT applies the LT predicate on the "section_id" field.
+func SectionIDLT(v xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldLT(FieldSectionID, v))
+}
+
+// SectionIDLTE applies the LTE predicate on the "section_id" field.
+func SectionIDLTE(v xid.ID) predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldLTE(FieldSectionID, v))
+}
+
+// SectionIDContains applies the Contains predicate on the "section_id" field.
+func SectionIDContains(v xid.ID) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldContains(FieldSectionID, vc))
+}
+
+// SectionIDHasPrefix applies the HasPrefix predicate on the "section_id" field.
+func SectionIDHasPrefix(v xid.ID) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldHasPrefix(FieldSectionID, vc))
+}
+
+// SectionIDHasSuffix applies the HasSuffix predicate on the "section_id" field.
+func SectionIDHasSuffix(v xid.ID) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldHasSuffix(FieldSectionID, vc))
+}
+
+// SectionIDIsNil applies the IsNil predicate on the "section_id" field.
+func SectionIDIsNil() predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldIsNull(FieldSectionID))
+}
+
+// SectionIDNotNil applies the NotNil predicate on the "section_id" field.
+func SectionIDNotNil() predicate.CollectionNode {
+	return predicate.CollectionNode(sql.FieldNotNull(FieldSectionID))
+}
+
+// SectionIDEqualFold applies the EqualFold predicate on the "section_id" field.
+func SectionIDEqualFold(v xid.ID) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldEqualFold(FieldSectionID, vc))
+}
+
+// SectionIDContainsFold applies the ContainsFold predicate on the "section_id" field.
+func SectionIDContainsFold(v xid.ID) predicate.CollectionNode {
+	vc := v.String()
+	return predicate.CollectionNode(sql.FieldContainsFold(FieldSectionID, vc))
+}
+
 // HasCollection applies the HasEdge predicate on the "collection" edge.
 func HasCollection() predicate.CollectionNode {
 	return predicate.CollectionNode(func(s *sql.Selector) {
@@ -322,6 +493,29 @@ func HasNodeWith(preds ...predicate.Node) predicate.CollectionNode {
 	})
 }
 
+// HasSection applies the HasEdge predicate on the "section" edge.
+func HasSection() predicate.CollectionNode {
+	return predicate.CollectionNode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, SectionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, SectionTable, SectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionWith applies the HasEdge predicate on the "section" edge with a given conditions (other predicates).
+func HasSectionWith(preds ...predicate.CollectionSection) predicate.CollectionNode {
+	return predicate.CollectionNode(func(s *sql.Selector) {
+		step := newSectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CollectionNode) predicate.CollectionNode {
 	return predicate.CollectionNode(sql.AndPredicates(predicates...))
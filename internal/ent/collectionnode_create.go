@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/rs/xid"
 )
@@ -81,6 +83,34 @@ func (_c *CollectionNodeCreate) SetNillableMembershipType(v *string) *Collection
 	return _c
 }
 
+// SetSort sets the "sort" field.
+func (_c *CollectionNodeCreate) SetSort(v lexorank.Key) *CollectionNodeCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *CollectionNodeCreate) SetNillableSort(v *lexorank.Key) *CollectionNodeCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetSectionID sets the "section_id" field.
+func (_c *CollectionNodeCreate) SetSectionID(v xid.ID) *CollectionNodeCreate {
+	_c.mutation.SetSectionID(v)
+	return _c
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_c *CollectionNodeCreate) SetNillableSectionID(v *xid.ID) *CollectionNodeCreate {
+	if v != nil {
+		_c.SetSectionID(*v)
+	}
+	return _c
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_c *CollectionNodeCreate) SetCollection(v *Collection) *CollectionNodeCreate {
 	return _c.SetCollectionID(v.ID)
@@ -91,6 +121,11 @@ func (_c *CollectionNodeCreate) SetNode(v *Node) *CollectionNodeCreate {
 	return _c.SetNodeID(v.ID)
 }
 
+// SetSection sets the "section" edge to the CollectionSection entity.
+func (_c *CollectionNodeCreate) SetSection(v *CollectionSection) *CollectionNodeCreate {
+	return _c.SetSectionID(v.ID)
+}
+
 // Mutation returns the CollectionNodeMutation object of the builder.
 func (_c *CollectionNodeCreate) Mutation() *CollectionNodeMutation {
 	return _c.mutation
@@ -205,6 +240,10 @@ func (_c *CollectionNodeCreate) createSpec() (*CollectionNode, *sqlgraph.CreateS
 		_spec.SetField(collectionnode.FieldMembershipType, field.TypeString, value)
 		_node.MembershipType = value
 	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(collectionnode.FieldSort, field.TypeString, value)
+		_node.Sort = &value
+	}
 	if nodes := _c.mutation.CollectionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -239,6 +278,23 @@ func (_c *CollectionNodeCreate) createSpec() (*CollectionNode, *sqlgraph.CreateS
 		_node.NodeID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionnode.SectionTable,
+			Columns: []string{collectionnode.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SectionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -327,6 +383,42 @@ func (u *CollectionNodeUpsert) UpdateMembershipType() *CollectionNodeUpsert {
 	return u
 }
 
+// SetSort sets the "sort" field.
+func (u *CollectionNodeUpsert) SetSort(v lexorank.Key) *CollectionNodeUpsert {
+	u.Set(collectionnode.FieldSort, v)
+	return u
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionNodeUpsert) UpdateSort() *CollectionNodeUpsert {
+	u.SetExcluded(collectionnode.FieldSort)
+	return u
+}
+
+// ClearSort clears the value of the "sort" field.
+func (u *CollectionNodeUpsert) ClearSort() *CollectionNodeUpsert {
+	u.SetNull(collectionnode.FieldSort)
+	return u
+}
+
+// SetSectionID sets the "section_id" field.
+func (u *CollectionNodeUpsert) SetSectionID(v xid.ID) *CollectionNodeUpsert {
+	u.Set(collectionnode.FieldSectionID, v)
+	return u
+}
+
+// UpdateSectionID sets the "section_id" field to the value that was provided on create.
+func (u *CollectionNodeUpsert) UpdateSectionID() *CollectionNodeUpsert {
+	u.SetExcluded(collectionnode.FieldSectionID)
+	return u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (u *CollectionNodeUpsert) ClearSectionID() *CollectionNodeUpsert {
+	u.SetNull(collectionnode.FieldSectionID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -414,6 +506,48 @@ func (u *CollectionNodeUpsertOne) UpdateMembershipType() *CollectionNodeUpsertOn
 	})
 }
 
+// SetSort sets the "sort" field.
+func (u *CollectionNodeUpsertOne) SetSort(v lexorank.Key) *CollectionNodeUpsertOne {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionNodeUpsertOne) UpdateSort() *CollectionNodeUpsertOne {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// ClearSort clears the value of the "sort" field.
+func (u *CollectionNodeUpsertOne) ClearSort() *CollectionNodeUpsertOne {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.ClearSort()
+	})
+}
+
+// SetSectionID sets the "section_id" field.
+func (u *CollectionNodeUpsertOne) SetSectionID(v xid.ID) *CollectionNodeUpsertOne {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.SetSectionID(v)
+	})
+}
+
+// UpdateSectionID sets the "section_id" field to the value that was provided on create.
+func (u *CollectionNodeUpsertOne) UpdateSectionID() *CollectionNodeUpsertOne {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.UpdateSectionID()
+	})
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (u *CollectionNodeUpsertOne) ClearSectionID() *CollectionNodeUpsertOne {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.ClearSectionID()
+	})
+}
+
 // Exec executes the query.
 func (u *CollectionNodeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -644,6 +778,48 @@ func (u *CollectionNodeUpsertBulk) UpdateMembershipType() *CollectionNodeUpsertB
 	})
 }
 
+// SetSort sets the "sort" field.
+func (u *CollectionNodeUpsertBulk) SetSort(v lexorank.Key) *CollectionNodeUpsertBulk {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionNodeUpsertBulk) UpdateSort() *CollectionNodeUpsertBulk {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// ClearSort clears the value of the "sort" field.
+func (u *CollectionNodeUpsertBulk) ClearSort() *CollectionNodeUpsertBulk {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.ClearSort()
+	})
+}
+
+// SetSectionID sets the "section_id" field.
+func (u *CollectionNodeUpsertBulk) SetSectionID(v xid.ID) *CollectionNodeUpsertBulk {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.SetSectionID(v)
+	})
+}
+
+// UpdateSectionID sets the "section_id" field to the value that was provided on create.
+func (u *CollectionNodeUpsertBulk) UpdateSectionID() *CollectionNodeUpsertBulk {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.UpdateSectionID()
+	})
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (u *CollectionNodeUpsertBulk) ClearSectionID() *CollectionNodeUpsertBulk {
+	return u.Update(func(s *CollectionNodeUpsert) {
+		s.ClearSectionID()
+	})
+}
+
 // Exec executes the query.
 func (u *CollectionNodeUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
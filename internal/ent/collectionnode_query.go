@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/rs/xid"
@@ -26,6 +27,7 @@ type CollectionNodeQuery struct {
 	predicates     []predicate.CollectionNode
 	withCollection *CollectionQuery
 	withNode       *NodeQuery
+	withSection    *CollectionSectionQuery
 	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -107,6 +109,28 @@ func (_q *CollectionNodeQuery) QueryNode() *NodeQuery {
 	return query
 }
 
+// QuerySection chains the current query on the "section" edge.
+func (_q *CollectionNodeQuery) QuerySection() *CollectionSectionQuery {
+	query := (&CollectionSectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collectionnode.Table, collectionnode.SectionColumn, selector),
+			sqlgraph.To(collectionsection.Table, collectionsection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, collectionnode.SectionTable, collectionnode.SectionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CollectionNode entity from the query.
 // Returns a *NotFoundError when no CollectionNode was found.
 func (_q *CollectionNodeQuery) First(ctx context.Context) (*CollectionNode, error) {
@@ -229,6 +253,7 @@ func (_q *CollectionNodeQuery) Clone() *CollectionNodeQuery {
 		predicates:     append([]predicate.CollectionNode{}, _q.predicates...),
 		withCollection: _q.withCollection.Clone(),
 		withNode:       _q.withNode.Clone(),
+		withSection:    _q.withSection.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -258,6 +283,17 @@ func (_q *CollectionNodeQuery) WithNode(opts ...func(*NodeQuery)) *CollectionNod
 	return _q
 }
 
+// WithSection tells the query-builder to eager-load the nodes that are connected to
+// the "section" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CollectionNodeQuery) WithSection(opts ...func(*CollectionSectionQuery)) *CollectionNodeQuery {
+	query := (&CollectionSectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSection = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -336,9 +372,10 @@ func (_q *CollectionNodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*CollectionNode{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withCollection != nil,
 			_q.withNode != nil,
+			_q.withSection != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -374,6 +411,12 @@ func (_q *CollectionNodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := _q.withSection; query != nil {
+		if err := _q.loadSection(ctx, query, nodes, nil,
+			func(n *CollectionNode, e *CollectionSection) { n.Edges.Section = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +478,38 @@ func (_q *CollectionNodeQuery) loadNode(ctx context.Context, query *NodeQuery, n
 	}
 	return nil
 }
+func (_q *CollectionNodeQuery) loadSection(ctx context.Context, query *CollectionSectionQuery, nodes []*CollectionNode, init func(*CollectionNode), assign func(*CollectionNode, *CollectionSection)) error {
+	ids := make([]xid.ID, 0, len(nodes))
+	nodeids := make(map[xid.ID][]*CollectionNode)
+	for i := range nodes {
+		if nodes[i].SectionID == nil {
+			continue
+		}
+		fk := *nodes[i].SectionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(collectionsection.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "section_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *CollectionNodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -465,6 +540,9 @@ func (_q *CollectionNodeQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withNode != nil {
 			_spec.Node.AddColumnOnce(collectionnode.FieldNodeID)
 		}
+		if _q.withSection != nil {
+			_spec.Node.AddColumnOnce(collectionnode.FieldSectionID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -10,8 +10,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/rs/xid"
@@ -73,6 +75,46 @@ func (_u *CollectionNodeUpdate) SetNillableMembershipType(v *string) *Collection
 	return _u
 }
 
+// SetSort sets the "sort" field.
+func (_u *CollectionNodeUpdate) SetSort(v lexorank.Key) *CollectionNodeUpdate {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *CollectionNodeUpdate) SetNillableSort(v *lexorank.Key) *CollectionNodeUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// ClearSort clears the value of the "sort" field.
+func (_u *CollectionNodeUpdate) ClearSort() *CollectionNodeUpdate {
+	_u.mutation.ClearSort()
+	return _u
+}
+
+// SetSectionID sets the "section_id" field.
+func (_u *CollectionNodeUpdate) SetSectionID(v xid.ID) *CollectionNodeUpdate {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *CollectionNodeUpdate) SetNillableSectionID(v *xid.ID) *CollectionNodeUpdate {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *CollectionNodeUpdate) ClearSectionID() *CollectionNodeUpdate {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_u *CollectionNodeUpdate) SetCollection(v *Collection) *CollectionNodeUpdate {
 	return _u.SetCollectionID(v.ID)
@@ -83,6 +125,11 @@ func (_u *CollectionNodeUpdate) SetNode(v *Node) *CollectionNodeUpdate {
 	return _u.SetNodeID(v.ID)
 }
 
+// SetSection sets the "section" edge to the CollectionSection entity.
+func (_u *CollectionNodeUpdate) SetSection(v *CollectionSection) *CollectionNodeUpdate {
+	return _u.SetSectionID(v.ID)
+}
+
 // Mutation returns the CollectionNodeMutation object of the builder.
 func (_u *CollectionNodeUpdate) Mutation() *CollectionNodeMutation {
 	return _u.mutation
@@ -100,6 +147,12 @@ func (_u *CollectionNodeUpdate) ClearNode() *CollectionNodeUpdate {
 	return _u
 }
 
+// ClearSection clears the "section" edge to the CollectionSection entity.
+func (_u *CollectionNodeUpdate) ClearSection() *CollectionNodeUpdate {
+	_u.mutation.ClearSection()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CollectionNodeUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -169,6 +222,12 @@ func (_u *CollectionNodeUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.MembershipType(); ok {
 		_spec.SetField(collectionnode.FieldMembershipType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(collectionnode.FieldSort, field.TypeString, value)
+	}
+	if _u.mutation.SortCleared() {
+		_spec.ClearField(collectionnode.FieldSort, field.TypeString)
+	}
 	if _u.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -227,6 +286,35 @@ func (_u *CollectionNodeUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionnode.SectionTable,
+			Columns: []string{collectionnode.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionnode.SectionTable,
+			Columns: []string{collectionnode.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -291,6 +379,46 @@ func (_u *CollectionNodeUpdateOne) SetNillableMembershipType(v *string) *Collect
 	return _u
 }
 
+// SetSort sets the "sort" field.
+func (_u *CollectionNodeUpdateOne) SetSort(v lexorank.Key) *CollectionNodeUpdateOne {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *CollectionNodeUpdateOne) SetNillableSort(v *lexorank.Key) *CollectionNodeUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// ClearSort clears the value of the "sort" field.
+func (_u *CollectionNodeUpdateOne) ClearSort() *CollectionNodeUpdateOne {
+	_u.mutation.ClearSort()
+	return _u
+}
+
+// SetSectionID sets the "section_id" field.
+func (_u *CollectionNodeUpdateOne) SetSectionID(v xid.ID) *CollectionNodeUpdateOne {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *CollectionNodeUpdateOne) SetNillableSectionID(v *xid.ID) *CollectionNodeUpdateOne {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *CollectionNodeUpdateOne) ClearSectionID() *CollectionNodeUpdateOne {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_u *CollectionNodeUpdateOne) SetCollection(v *Collection) *CollectionNodeUpdateOne {
 	return _u.SetCollectionID(v.ID)
@@ -301,6 +429,11 @@ func (_u *CollectionNodeUpdateOne) SetNode(v *Node) *CollectionNodeUpdateOne {
 	return _u.SetNodeID(v.ID)
 }
 
+// SetSection sets the "section" edge to the CollectionSection entity.
+func (_u *CollectionNodeUpdateOne) SetSection(v *CollectionSection) *CollectionNodeUpdateOne {
+	return _u.SetSectionID(v.ID)
+}
+
 // Mutation returns the CollectionNodeMutation object of the builder.
 func (_u *CollectionNodeUpdateOne) Mutation() *CollectionNodeMutation {
 	return _u.mutation
@@ -318,6 +451,12 @@ func (_u *CollectionNodeUpdateOne) ClearNode() *CollectionNodeUpdateOne {
 	return _u
 }
 
+// ClearSection clears the "section" edge to the CollectionSection entity.
+func (_u *CollectionNodeUpdateOne) ClearSection() *CollectionNodeUpdateOne {
+	_u.mutation.ClearSection()
+	return _u
+}
+
 // Where appends a list predicates to the CollectionNodeUpdate builder.
 func (_u *CollectionNodeUpdateOne) Where(ps ...predicate.CollectionNode) *CollectionNodeUpdateOne {
 	_u.mutation.Where(ps...)
@@ -419,6 +558,12 @@ func (_u *CollectionNodeUpdateOne) sqlSave(ctx context.Context) (_node *Collecti
 	if value, ok := _u.mutation.MembershipType(); ok {
 		_spec.SetField(collectionnode.FieldMembershipType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(collectionnode.FieldSort, field.TypeString, value)
+	}
+	if _u.mutation.SortCleared() {
+		_spec.ClearField(collectionnode.FieldSort, field.TypeString)
+	}
 	if _u.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -477,6 +622,35 @@ func (_u *CollectionNodeUpdateOne) sqlSave(ctx context.Context) (_node *Collecti
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionnode.SectionTable,
+			Columns: []string{collectionnode.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionnode.SectionTable,
+			Columns: []string{collectionnode.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &CollectionNode{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -9,8 +9,10 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/rs/xid"
 )
@@ -26,6 +28,10 @@ type CollectionPost struct {
 	PostID xid.ID `json:"post_id,omitempty"`
 	// MembershipType holds the value of the "membership_type" field.
 	MembershipType string `json:"membership_type,omitempty"`
+	// Sort holds the value of the "sort" field.
+	Sort *lexorank.Key `json:"sort,omitempty"`
+	// SectionID holds the value of the "section_id" field.
+	SectionID *xid.ID `json:"section_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CollectionPostQuery when eager-loading is set.
 	Edges        CollectionPostEdges `json:"edges"`
@@ -38,9 +44,11 @@ type CollectionPostEdges struct {
 	Collection *Collection `json:"collection,omitempty"`
 	// Post holds the value of the post edge.
 	Post *Post `json:"post,omitempty"`
+	// Section holds the value of the section edge.
+	Section *CollectionSection `json:"section,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // CollectionOrErr returns the Collection value or an error if the edge
@@ -65,11 +73,26 @@ func (e CollectionPostEdges) PostOrErr() (*Post, error) {
 	return nil, &NotLoadedError{edge: "post"}
 }
 
+// SectionOrErr returns the Section value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollectionPostEdges) SectionOrErr() (*CollectionSection, error) {
+	if e.Section != nil {
+		return e.Section, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: collectionsection.Label}
+	}
+	return nil, &NotLoadedError{edge: "section"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CollectionPost) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case collectionpost.FieldSort:
+			values[i] = &sql.NullScanner{S: new(lexorank.Key)}
+		case collectionpost.FieldSectionID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
 		case collectionpost.FieldMembershipType:
 			values[i] = new(sql.NullString)
 		case collectionpost.FieldCreatedAt:
@@ -115,6 +138,20 @@ func (_m *CollectionPost) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MembershipType = value.String
 			}
+		case collectionpost.FieldSort:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = new(lexorank.Key)
+				*_m.Sort = *value.S.(*lexorank.Key)
+			}
+		case collectionpost.FieldSectionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field section_id", values[i])
+			} else if value.Valid {
+				_m.SectionID = new(xid.ID)
+				*_m.SectionID = *value.S.(*xid.ID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -138,6 +175,11 @@ func (_m *CollectionPost) QueryPost() *PostQuery {
 	return NewCollectionPostClient(_m.config).QueryPost(_m)
 }
 
+// QuerySection queries the "section" edge of the CollectionPost entity.
+func (_m *CollectionPost) QuerySection() *CollectionSectionQuery {
+	return NewCollectionPostClient(_m.config).QuerySection(_m)
+}
+
 // Update returns a builder for updating this CollectionPost.
 // Note that you need to call CollectionPost.Unwrap() before calling this method if this CollectionPost
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,6 +213,16 @@ func (_m *CollectionPost) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("membership_type=")
 	builder.WriteString(_m.MembershipType)
+	builder.WriteString(", ")
+	if v := _m.Sort; v != nil {
+		builder.WriteString("sort=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SectionID; v != nil {
+		builder.WriteString("section_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
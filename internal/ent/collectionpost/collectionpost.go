@@ -21,14 +21,22 @@ const (
 	FieldPostID = "post_id"
 	// FieldMembershipType holds the string denoting the membership_type field in the database.
 	FieldMembershipType = "membership_type"
+	// FieldSort holds the string denoting the sort field in the database.
+	FieldSort = "sort"
+	// FieldSectionID holds the string denoting the section_id field in the database.
+	FieldSectionID = "section_id"
 	// EdgeCollection holds the string denoting the collection edge name in mutations.
 	EdgeCollection = "collection"
 	// EdgePost holds the string denoting the post edge name in mutations.
 	EdgePost = "post"
+	// EdgeSection holds the string denoting the section edge name in mutations.
+	EdgeSection = "section"
 	// CollectionFieldID holds the string denoting the ID field of the Collection.
 	CollectionFieldID = "id"
 	// PostFieldID holds the string denoting the ID field of the Post.
 	PostFieldID = "id"
+	// CollectionSectionFieldID holds the string denoting the ID field of the CollectionSection.
+	CollectionSectionFieldID = "id"
 	// Table holds the table name of the collectionpost in the database.
 	Table = "collection_posts"
 	// CollectionTable is the table that holds the collection relation/edge.
@@ -45,6 +53,13 @@ const (
 	PostInverseTable = "posts"
 	// PostColumn is the table column denoting the post relation/edge.
 	PostColumn = "post_id"
+	// SectionTable is the table that holds the section relation/edge.
+	SectionTable = "collection_posts"
+	// SectionInverseTable is the table name for the CollectionSection entity.
+	// It exists in this package in order to avoid circular dependency with the "collectionsection" package.
+	SectionInverseTable = "collection_sections"
+	// SectionColumn is the table column denoting the section relation/edge.
+	SectionColumn = "section_id"
 )
 
 // Columns holds all SQL columns for collectionpost fields.
@@ -53,6 +68,8 @@ var Columns = []string{
 	FieldCollectionID,
 	FieldPostID,
 	FieldMembershipType,
+	FieldSort,
+	FieldSectionID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -103,6 +120,16 @@ func ByMembershipType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMembershipType, opts...).ToFunc()
 }
 
+// BySort orders the results by the sort field.
+func BySort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSort, opts...).ToFunc()
+}
+
+// BySectionID orders the results by the section_id field.
+func BySectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSectionID, opts...).ToFunc()
+}
+
 // ByCollectionField orders the results by collection field.
 func ByCollectionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -116,6 +143,13 @@ func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPostStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySectionField orders the results by section field.
+func BySectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCollectionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, CollectionColumn),
@@ -130,3 +164,10 @@ func newPostStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, PostTable, PostColumn),
 	)
 }
+func newSectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, SectionColumn),
+		sqlgraph.To(SectionInverseTable, CollectionSectionFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SectionTable, SectionColumn),
+	)
+}
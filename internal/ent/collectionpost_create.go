@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/rs/xid"
 )
@@ -81,6 +83,34 @@ func (_c *CollectionPostCreate) SetNillableMembershipType(v *string) *Collection
 	return _c
 }
 
+// SetSort sets the "sort" field.
+func (_c *CollectionPostCreate) SetSort(v lexorank.Key) *CollectionPostCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *CollectionPostCreate) SetNillableSort(v *lexorank.Key) *CollectionPostCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetSectionID sets the "section_id" field.
+func (_c *CollectionPostCreate) SetSectionID(v xid.ID) *CollectionPostCreate {
+	_c.mutation.SetSectionID(v)
+	return _c
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_c *CollectionPostCreate) SetNillableSectionID(v *xid.ID) *CollectionPostCreate {
+	if v != nil {
+		_c.SetSectionID(*v)
+	}
+	return _c
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_c *CollectionPostCreate) SetCollection(v *Collection) *CollectionPostCreate {
 	return _c.SetCollectionID(v.ID)
@@ -91,6 +121,11 @@ func (_c *CollectionPostCreate) SetPost(v *Post) *CollectionPostCreate {
 	return _c.SetPostID(v.ID)
 }
 
+// SetSection sets the "section" edge to the CollectionSection entity.
+func (_c *CollectionPostCreate) SetSection(v *CollectionSection) *CollectionPostCreate {
+	return _c.SetSectionID(v.ID)
+}
+
 // Mutation returns the CollectionPostMutation object of the builder.
 func (_c *CollectionPostCreate) Mutation() *CollectionPostMutation {
 	return _c.mutation
@@ -205,6 +240,10 @@ func (_c *CollectionPostCreate) createSpec() (*CollectionPost, *sqlgraph.CreateS
 		_spec.SetField(collectionpost.FieldMembershipType, field.TypeString, value)
 		_node.MembershipType = value
 	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(collectionpost.FieldSort, field.TypeString, value)
+		_node.Sort = &value
+	}
 	if nodes := _c.mutation.CollectionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -239,6 +278,23 @@ func (_c *CollectionPostCreate) createSpec() (*CollectionPost, *sqlgraph.CreateS
 		_node.PostID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionpost.SectionTable,
+			Columns: []string{collectionpost.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SectionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -327,6 +383,42 @@ func (u *CollectionPostUpsert) UpdateMembershipType() *CollectionPostUpsert {
 	return u
 }
 
+// SetSort sets the "sort" field.
+func (u *CollectionPostUpsert) SetSort(v lexorank.Key) *CollectionPostUpsert {
+	u.Set(collectionpost.FieldSort, v)
+	return u
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionPostUpsert) UpdateSort() *CollectionPostUpsert {
+	u.SetExcluded(collectionpost.FieldSort)
+	return u
+}
+
+// ClearSort clears the value of the "sort" field.
+func (u *CollectionPostUpsert) ClearSort() *CollectionPostUpsert {
+	u.SetNull(collectionpost.FieldSort)
+	return u
+}
+
+// SetSectionID sets the "section_id" field.
+func (u *CollectionPostUpsert) SetSectionID(v xid.ID) *CollectionPostUpsert {
+	u.Set(collectionpost.FieldSectionID, v)
+	return u
+}
+
+// UpdateSectionID sets the "section_id" field to the value that was provided on create.
+func (u *CollectionPostUpsert) UpdateSectionID() *CollectionPostUpsert {
+	u.SetExcluded(collectionpost.FieldSectionID)
+	return u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (u *CollectionPostUpsert) ClearSectionID() *CollectionPostUpsert {
+	u.SetNull(collectionpost.FieldSectionID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -414,6 +506,48 @@ func (u *CollectionPostUpsertOne) UpdateMembershipType() *CollectionPostUpsertOn
 	})
 }
 
+// SetSort sets the "sort" field.
+func (u *CollectionPostUpsertOne) SetSort(v lexorank.Key) *CollectionPostUpsertOne {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionPostUpsertOne) UpdateSort() *CollectionPostUpsertOne {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// ClearSort clears the value of the "sort" field.
+func (u *CollectionPostUpsertOne) ClearSort() *CollectionPostUpsertOne {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.ClearSort()
+	})
+}
+
+// SetSectionID sets the "section_id" field.
+func (u *CollectionPostUpsertOne) SetSectionID(v xid.ID) *CollectionPostUpsertOne {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.SetSectionID(v)
+	})
+}
+
+// UpdateSectionID sets the "section_id" field to the value that was provided on create.
+func (u *CollectionPostUpsertOne) UpdateSectionID() *CollectionPostUpsertOne {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.UpdateSectionID()
+	})
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (u *CollectionPostUpsertOne) ClearSectionID() *CollectionPostUpsertOne {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.ClearSectionID()
+	})
+}
+
 // Exec executes the query.
 func (u *CollectionPostUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -644,6 +778,48 @@ func (u *CollectionPostUpsertBulk) UpdateMembershipType() *CollectionPostUpsertB
 	})
 }
 
+// SetSort sets the "sort" field.
+func (u *CollectionPostUpsertBulk) SetSort(v lexorank.Key) *CollectionPostUpsertBulk {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionPostUpsertBulk) UpdateSort() *CollectionPostUpsertBulk {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// ClearSort clears the value of the "sort" field.
+func (u *CollectionPostUpsertBulk) ClearSort() *CollectionPostUpsertBulk {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.ClearSort()
+	})
+}
+
+// SetSectionID sets the "section_id" field.
+func (u *CollectionPostUpsertBulk) SetSectionID(v xid.ID) *CollectionPostUpsertBulk {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.SetSectionID(v)
+	})
+}
+
+// UpdateSectionID sets the "section_id" field to the value that was provided on create.
+func (u *CollectionPostUpsertBulk) UpdateSectionID() *CollectionPostUpsertBulk {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.UpdateSectionID()
+	})
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (u *CollectionPostUpsertBulk) ClearSectionID() *CollectionPostUpsertBulk {
+	return u.Update(func(s *CollectionPostUpsert) {
+		s.ClearSectionID()
+	})
+}
+
 // Exec executes the query.
 func (u *CollectionPostUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
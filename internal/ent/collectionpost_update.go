@@ -10,8 +10,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/rs/xid"
@@ -73,6 +75,46 @@ func (_u *CollectionPostUpdate) SetNillableMembershipType(v *string) *Collection
 	return _u
 }
 
+// SetSort sets the "sort" field.
+func (_u *CollectionPostUpdate) SetSort(v lexorank.Key) *CollectionPostUpdate {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *CollectionPostUpdate) SetNillableSort(v *lexorank.Key) *CollectionPostUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// ClearSort clears the value of the "sort" field.
+func (_u *CollectionPostUpdate) ClearSort() *CollectionPostUpdate {
+	_u.mutation.ClearSort()
+	return _u
+}
+
+// SetSectionID sets the "section_id" field.
+func (_u *CollectionPostUpdate) SetSectionID(v xid.ID) *CollectionPostUpdate {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *CollectionPostUpdate) SetNillableSectionID(v *xid.ID) *CollectionPostUpdate {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *CollectionPostUpdate) ClearSectionID() *CollectionPostUpdate {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_u *CollectionPostUpdate) SetCollection(v *Collection) *CollectionPostUpdate {
 	return _u.SetCollectionID(v.ID)
@@ -83,6 +125,11 @@ func (_u *CollectionPostUpdate) SetPost(v *Post) *CollectionPostUpdate {
 	return _u.SetPostID(v.ID)
 }
 
+// SetSection sets the "section" edge to the CollectionSection entity.
+func (_u *CollectionPostUpdate) SetSection(v *CollectionSection) *CollectionPostUpdate {
+	return _u.SetSectionID(v.ID)
+}
+
 // Mutation returns the CollectionPostMutation object of the builder.
 func (_u *CollectionPostUpdate) Mutation() *CollectionPostMutation {
 	return _u.mutation
@@ -100,6 +147,12 @@ func (_u *CollectionPostUpdate) ClearPost() *CollectionPostUpdate {
 	return _u
 }
 
+// ClearSection clears the "section" edge to the CollectionSection entity.
+func (_u *CollectionPostUpdate) ClearSection() *CollectionPostUpdate {
+	_u.mutation.ClearSection()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CollectionPostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -169,6 +222,12 @@ func (_u *CollectionPostUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.MembershipType(); ok {
 		_spec.SetField(collectionpost.FieldMembershipType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(collectionpost.FieldSort, field.TypeString, value)
+	}
+	if _u.mutation.SortCleared() {
+		_spec.ClearField(collectionpost.FieldSort, field.TypeString)
+	}
 	if _u.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -227,6 +286,35 @@ func (_u *CollectionPostUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionpost.SectionTable,
+			Columns: []string{collectionpost.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionpost.SectionTable,
+			Columns: []string{collectionpost.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -291,6 +379,46 @@ func (_u *CollectionPostUpdateOne) SetNillableMembershipType(v *string) *Collect
 	return _u
 }
 
+// SetSort sets the "sort" field.
+func (_u *CollectionPostUpdateOne) SetSort(v lexorank.Key) *CollectionPostUpdateOne {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *CollectionPostUpdateOne) SetNillableSort(v *lexorank.Key) *CollectionPostUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// ClearSort clears the value of the "sort" field.
+func (_u *CollectionPostUpdateOne) ClearSort() *CollectionPostUpdateOne {
+	_u.mutation.ClearSort()
+	return _u
+}
+
+// SetSectionID sets the "section_id" field.
+func (_u *CollectionPostUpdateOne) SetSectionID(v xid.ID) *CollectionPostUpdateOne {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *CollectionPostUpdateOne) SetNillableSectionID(v *xid.ID) *CollectionPostUpdateOne {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *CollectionPostUpdateOne) ClearSectionID() *CollectionPostUpdateOne {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_u *CollectionPostUpdateOne) SetCollection(v *Collection) *CollectionPostUpdateOne {
 	return _u.SetCollectionID(v.ID)
@@ -301,6 +429,11 @@ func (_u *CollectionPostUpdateOne) SetPost(v *Post) *CollectionPostUpdateOne {
 	return _u.SetPostID(v.ID)
 }
 
+// SetSection sets the "section" edge to the CollectionSection entity.
+func (_u *CollectionPostUpdateOne) SetSection(v *CollectionSection) *CollectionPostUpdateOne {
+	return _u.SetSectionID(v.ID)
+}
+
 // Mutation returns the CollectionPostMutation object of the builder.
 func (_u *CollectionPostUpdateOne) Mutation() *CollectionPostMutation {
 	return _u.mutation
@@ -318,6 +451,12 @@ func (_u *CollectionPostUpdateOne) ClearPost() *CollectionPostUpdateOne {
 	return _u
 }
 
+// ClearSection clears the "section" edge to the CollectionSection entity.
+func (_u *CollectionPostUpdateOne) ClearSection() *CollectionPostUpdateOne {
+	_u.mutation.ClearSection()
+	return _u
+}
+
 // Where appends a list predicates to the CollectionPostUpdate builder.
 func (_u *CollectionPostUpdateOne) Where(ps ...predicate.CollectionPost) *CollectionPostUpdateOne {
 	_u.mutation.Where(ps...)
@@ -419,6 +558,12 @@ func (_u *CollectionPostUpdateOne) sqlSave(ctx context.Context) (_node *Collecti
 	if value, ok := _u.mutation.MembershipType(); ok {
 		_spec.SetField(collectionpost.FieldMembershipType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(collectionpost.FieldSort, field.TypeString, value)
+	}
+	if _u.mutation.SortCleared() {
+		_spec.ClearField(collectionpost.FieldSort, field.TypeString)
+	}
 	if _u.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -477,6 +622,35 @@ func (_u *CollectionPostUpdateOne) sqlSave(ctx context.Context) (_node *Collecti
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionpost.SectionTable,
+			Columns: []string{collectionpost.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   collectionpost.SectionTable,
+			Columns: []string{collectionpost.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &CollectionPost{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/lexorank"
+	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/rs/xid"
+)
+
+// CollectionSection is the model entity for the CollectionSection schema.
+type CollectionSection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CollectionID holds the value of the "collection_id" field.
+	CollectionID xid.ID `json:"collection_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Sort holds the value of the "sort" field.
+	Sort lexorank.Key `json:"sort,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollectionSectionQuery when eager-loading is set.
+	Edges        CollectionSectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CollectionSectionEdges holds the relations/edges for other nodes in the graph.
+type CollectionSectionEdges struct {
+	// Collection holds the value of the collection edge.
+	Collection *Collection `json:"collection,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CollectionOrErr returns the Collection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollectionSectionEdges) CollectionOrErr() (*Collection, error) {
+	if e.Collection != nil {
+		return e.Collection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: collection.Label}
+	}
+	return nil, &NotLoadedError{edge: "collection"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CollectionSection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collectionsection.FieldSort:
+			values[i] = new(lexorank.Key)
+		case collectionsection.FieldName, collectionsection.FieldDescription:
+			values[i] = new(sql.NullString)
+		case collectionsection.FieldCreatedAt, collectionsection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case collectionsection.FieldID, collectionsection.FieldCollectionID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CollectionSection fields.
+func (_m *CollectionSection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collectionsection.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case collectionsection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case collectionsection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case collectionsection.FieldCollectionID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field collection_id", values[i])
+			} else if value != nil {
+				_m.CollectionID = *value
+			}
+		case collectionsection.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case collectionsection.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case collectionsection.FieldSort:
+			if value, ok := values[i].(*lexorank.Key); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value != nil {
+				_m.Sort = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CollectionSection.
+// This includes values selected through modifiers, order, etc.
+func (_m *CollectionSection) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCollection queries the "collection" edge of the CollectionSection entity.
+func (_m *CollectionSection) QueryCollection() *CollectionQuery {
+	return NewCollectionSectionClient(_m.config).QueryCollection(_m)
+}
+
+// Update returns a builder for updating this CollectionSection.
+// Note that you need to call CollectionSection.Unwrap() before calling this method if this CollectionSection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CollectionSection) Update() *CollectionSectionUpdateOne {
+	return NewCollectionSectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CollectionSection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CollectionSection) Unwrap() *CollectionSection {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CollectionSection is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CollectionSection) String() string {
+	var builder strings.Builder
+	builder.WriteString("CollectionSection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("collection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectionID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CollectionSections is a parsable slice of CollectionSection.
+type CollectionSections []*CollectionSection
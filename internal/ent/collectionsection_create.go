@@ -0,0 +1,829 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/lexorank"
+	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/rs/xid"
+)
+
+// CollectionSectionCreate is the builder for creating a CollectionSection entity.
+type CollectionSectionCreate struct {
+	config
+	mutation *CollectionSectionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CollectionSectionCreate) SetCreatedAt(v time.Time) *CollectionSectionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CollectionSectionCreate) SetNillableCreatedAt(v *time.Time) *CollectionSectionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CollectionSectionCreate) SetUpdatedAt(v time.Time) *CollectionSectionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CollectionSectionCreate) SetNillableUpdatedAt(v *time.Time) *CollectionSectionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_c *CollectionSectionCreate) SetCollectionID(v xid.ID) *CollectionSectionCreate {
+	_c.mutation.SetCollectionID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CollectionSectionCreate) SetName(v string) *CollectionSectionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CollectionSectionCreate) SetDescription(v string) *CollectionSectionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CollectionSectionCreate) SetNillableDescription(v *string) *CollectionSectionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *CollectionSectionCreate) SetSort(v lexorank.Key) *CollectionSectionCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *CollectionSectionCreate) SetNillableSort(v *lexorank.Key) *CollectionSectionCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CollectionSectionCreate) SetID(v xid.ID) *CollectionSectionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CollectionSectionCreate) SetNillableID(v *xid.ID) *CollectionSectionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_c *CollectionSectionCreate) SetCollection(v *Collection) *CollectionSectionCreate {
+	return _c.SetCollectionID(v.ID)
+}
+
+// Mutation returns the CollectionSectionMutation object of the builder.
+func (_c *CollectionSectionCreate) Mutation() *CollectionSectionMutation {
+	return _c.mutation
+}
+
+// Save creates the CollectionSection in the database.
+func (_c *CollectionSectionCreate) Save(ctx context.Context) (*CollectionSection, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CollectionSectionCreate) SaveX(ctx context.Context) *CollectionSection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CollectionSectionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CollectionSectionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CollectionSectionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := collectionsection.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := collectionsection.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := collectionsection.DefaultSort()
+		_c.mutation.SetSort(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := collectionsection.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CollectionSectionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CollectionSection.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CollectionSection.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CollectionID(); !ok {
+		return &ValidationError{Name: "collection_id", err: errors.New(`ent: missing required field "CollectionSection.collection_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CollectionSection.name"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "CollectionSection.sort"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := collectionsection.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CollectionSection.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CollectionIDs()) == 0 {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required edge "CollectionSection.collection"`)}
+	}
+	return nil
+}
+
+func (_c *CollectionSectionCreate) sqlSave(ctx context.Context) (*CollectionSection, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CollectionSectionCreate) createSpec() (*CollectionSection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CollectionSection{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(collectionsection.Table, sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(collectionsection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(collectionsection.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(collectionsection.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(collectionsection.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(collectionsection.FieldSort, field.TypeString, value)
+		_node.Sort = value
+	}
+	if nodes := _c.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionsection.CollectionTable,
+			Columns: []string{collectionsection.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CollectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CollectionSection.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CollectionSectionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CollectionSectionCreate) OnConflict(opts ...sql.ConflictOption) *CollectionSectionUpsertOne {
+	_c.conflict = opts
+	return &CollectionSectionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CollectionSection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CollectionSectionCreate) OnConflictColumns(columns ...string) *CollectionSectionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CollectionSectionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CollectionSectionUpsertOne is the builder for "upsert"-ing
+	//  one CollectionSection node.
+	CollectionSectionUpsertOne struct {
+		create *CollectionSectionCreate
+	}
+
+	// CollectionSectionUpsert is the "OnConflict" setter.
+	CollectionSectionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CollectionSectionUpsert) SetUpdatedAt(v time.Time) *CollectionSectionUpsert {
+	u.Set(collectionsection.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CollectionSectionUpsert) UpdateUpdatedAt() *CollectionSectionUpsert {
+	u.SetExcluded(collectionsection.FieldUpdatedAt)
+	return u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (u *CollectionSectionUpsert) SetCollectionID(v xid.ID) *CollectionSectionUpsert {
+	u.Set(collectionsection.FieldCollectionID, v)
+	return u
+}
+
+// UpdateCollectionID sets the "collection_id" field to the value that was provided on create.
+func (u *CollectionSectionUpsert) UpdateCollectionID() *CollectionSectionUpsert {
+	u.SetExcluded(collectionsection.FieldCollectionID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CollectionSectionUpsert) SetName(v string) *CollectionSectionUpsert {
+	u.Set(collectionsection.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CollectionSectionUpsert) UpdateName() *CollectionSectionUpsert {
+	u.SetExcluded(collectionsection.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CollectionSectionUpsert) SetDescription(v string) *CollectionSectionUpsert {
+	u.Set(collectionsection.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CollectionSectionUpsert) UpdateDescription() *CollectionSectionUpsert {
+	u.SetExcluded(collectionsection.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CollectionSectionUpsert) ClearDescription() *CollectionSectionUpsert {
+	u.SetNull(collectionsection.FieldDescription)
+	return u
+}
+
+// SetSort sets the "sort" field.
+func (u *CollectionSectionUpsert) SetSort(v lexorank.Key) *CollectionSectionUpsert {
+	u.Set(collectionsection.FieldSort, v)
+	return u
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionSectionUpsert) UpdateSort() *CollectionSectionUpsert {
+	u.SetExcluded(collectionsection.FieldSort)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CollectionSection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(collectionsection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CollectionSectionUpsertOne) UpdateNewValues() *CollectionSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(collectionsection.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(collectionsection.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CollectionSection.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CollectionSectionUpsertOne) Ignore() *CollectionSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CollectionSectionUpsertOne) DoNothing() *CollectionSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CollectionSectionCreate.OnConflict
+// documentation for more info.
+func (u *CollectionSectionUpsertOne) Update(set func(*CollectionSectionUpsert)) *CollectionSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CollectionSectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CollectionSectionUpsertOne) SetUpdatedAt(v time.Time) *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CollectionSectionUpsertOne) UpdateUpdatedAt() *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (u *CollectionSectionUpsertOne) SetCollectionID(v xid.ID) *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetCollectionID(v)
+	})
+}
+
+// UpdateCollectionID sets the "collection_id" field to the value that was provided on create.
+func (u *CollectionSectionUpsertOne) UpdateCollectionID() *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateCollectionID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CollectionSectionUpsertOne) SetName(v string) *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CollectionSectionUpsertOne) UpdateName() *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CollectionSectionUpsertOne) SetDescription(v string) *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CollectionSectionUpsertOne) UpdateDescription() *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CollectionSectionUpsertOne) ClearDescription() *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSort sets the "sort" field.
+func (u *CollectionSectionUpsertOne) SetSort(v lexorank.Key) *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionSectionUpsertOne) UpdateSort() *CollectionSectionUpsertOne {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// Exec executes the query.
+func (u *CollectionSectionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CollectionSectionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CollectionSectionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CollectionSectionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CollectionSectionUpsertOne.ID is not supported by MySQL driver. Use CollectionSectionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CollectionSectionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CollectionSectionCreateBulk is the builder for creating many CollectionSection entities in bulk.
+type CollectionSectionCreateBulk struct {
+	config
+	err      error
+	builders []*CollectionSectionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CollectionSection entities in the database.
+func (_c *CollectionSectionCreateBulk) Save(ctx context.Context) ([]*CollectionSection, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CollectionSection, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CollectionSectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CollectionSectionCreateBulk) SaveX(ctx context.Context) []*CollectionSection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CollectionSectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CollectionSectionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CollectionSection.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CollectionSectionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CollectionSectionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CollectionSectionUpsertBulk {
+	_c.conflict = opts
+	return &CollectionSectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CollectionSection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CollectionSectionCreateBulk) OnConflictColumns(columns ...string) *CollectionSectionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CollectionSectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// CollectionSectionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CollectionSection nodes.
+type CollectionSectionUpsertBulk struct {
+	create *CollectionSectionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CollectionSection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(collectionsection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CollectionSectionUpsertBulk) UpdateNewValues() *CollectionSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(collectionsection.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(collectionsection.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CollectionSection.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CollectionSectionUpsertBulk) Ignore() *CollectionSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CollectionSectionUpsertBulk) DoNothing() *CollectionSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CollectionSectionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CollectionSectionUpsertBulk) Update(set func(*CollectionSectionUpsert)) *CollectionSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CollectionSectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CollectionSectionUpsertBulk) SetUpdatedAt(v time.Time) *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CollectionSectionUpsertBulk) UpdateUpdatedAt() *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (u *CollectionSectionUpsertBulk) SetCollectionID(v xid.ID) *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetCollectionID(v)
+	})
+}
+
+// UpdateCollectionID sets the "collection_id" field to the value that was provided on create.
+func (u *CollectionSectionUpsertBulk) UpdateCollectionID() *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateCollectionID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CollectionSectionUpsertBulk) SetName(v string) *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CollectionSectionUpsertBulk) UpdateName() *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CollectionSectionUpsertBulk) SetDescription(v string) *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CollectionSectionUpsertBulk) UpdateDescription() *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CollectionSectionUpsertBulk) ClearDescription() *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSort sets the "sort" field.
+func (u *CollectionSectionUpsertBulk) SetSort(v lexorank.Key) *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *CollectionSectionUpsertBulk) UpdateSort() *CollectionSectionUpsertBulk {
+	return u.Update(func(s *CollectionSectionUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// Exec executes the query.
+func (u *CollectionSectionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CollectionSectionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CollectionSectionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CollectionSectionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// CollectionSectionDelete is the builder for deleting a CollectionSection entity.
+type CollectionSectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CollectionSectionMutation
+}
+
+// Where appends a list predicates to the CollectionSectionDelete builder.
+func (_d *CollectionSectionDelete) Where(ps ...predicate.CollectionSection) *CollectionSectionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CollectionSectionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CollectionSectionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CollectionSectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(collectionsection.Table, sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CollectionSectionDeleteOne is the builder for deleting a single CollectionSection entity.
+type CollectionSectionDeleteOne struct {
+	_d *CollectionSectionDelete
+}
+
+// Where appends a list predicates to the CollectionSectionDelete builder.
+func (_d *CollectionSectionDeleteOne) Where(ps ...predicate.CollectionSection) *CollectionSectionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CollectionSectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{collectionsection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CollectionSectionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
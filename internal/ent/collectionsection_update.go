@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/lexorank"
+	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// CollectionSectionUpdate is the builder for updating CollectionSection entities.
+type CollectionSectionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CollectionSectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CollectionSectionUpdate builder.
+func (_u *CollectionSectionUpdate) Where(ps ...predicate.CollectionSection) *CollectionSectionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CollectionSectionUpdate) SetUpdatedAt(v time.Time) *CollectionSectionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *CollectionSectionUpdate) SetCollectionID(v xid.ID) *CollectionSectionUpdate {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *CollectionSectionUpdate) SetNillableCollectionID(v *xid.ID) *CollectionSectionUpdate {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CollectionSectionUpdate) SetName(v string) *CollectionSectionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CollectionSectionUpdate) SetNillableName(v *string) *CollectionSectionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CollectionSectionUpdate) SetDescription(v string) *CollectionSectionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CollectionSectionUpdate) SetNillableDescription(v *string) *CollectionSectionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CollectionSectionUpdate) ClearDescription() *CollectionSectionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *CollectionSectionUpdate) SetSort(v lexorank.Key) *CollectionSectionUpdate {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *CollectionSectionUpdate) SetNillableSort(v *lexorank.Key) *CollectionSectionUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_u *CollectionSectionUpdate) SetCollection(v *Collection) *CollectionSectionUpdate {
+	return _u.SetCollectionID(v.ID)
+}
+
+// Mutation returns the CollectionSectionMutation object of the builder.
+func (_u *CollectionSectionUpdate) Mutation() *CollectionSectionMutation {
+	return _u.mutation
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (_u *CollectionSectionUpdate) ClearCollection() *CollectionSectionUpdate {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CollectionSectionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CollectionSectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CollectionSectionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CollectionSectionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CollectionSectionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := collectionsection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CollectionSectionUpdate) check() error {
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CollectionSection.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionSectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionSectionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CollectionSectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectionsection.Table, collectionsection.Columns, sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(collectionsection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(collectionsection.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(collectionsection.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(collectionsection.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(collectionsection.FieldSort, field.TypeString, value)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionsection.CollectionTable,
+			Columns: []string{collectionsection.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionsection.CollectionTable,
+			Columns: []string{collectionsection.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectionsection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CollectionSectionUpdateOne is the builder for updating a single CollectionSection entity.
+type CollectionSectionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CollectionSectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CollectionSectionUpdateOne) SetUpdatedAt(v time.Time) *CollectionSectionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *CollectionSectionUpdateOne) SetCollectionID(v xid.ID) *CollectionSectionUpdateOne {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *CollectionSectionUpdateOne) SetNillableCollectionID(v *xid.ID) *CollectionSectionUpdateOne {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CollectionSectionUpdateOne) SetName(v string) *CollectionSectionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CollectionSectionUpdateOne) SetNillableName(v *string) *CollectionSectionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CollectionSectionUpdateOne) SetDescription(v string) *CollectionSectionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CollectionSectionUpdateOne) SetNillableDescription(v *string) *CollectionSectionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CollectionSectionUpdateOne) ClearDescription() *CollectionSectionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *CollectionSectionUpdateOne) SetSort(v lexorank.Key) *CollectionSectionUpdateOne {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *CollectionSectionUpdateOne) SetNillableSort(v *lexorank.Key) *CollectionSectionUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_u *CollectionSectionUpdateOne) SetCollection(v *Collection) *CollectionSectionUpdateOne {
+	return _u.SetCollectionID(v.ID)
+}
+
+// Mutation returns the CollectionSectionMutation object of the builder.
+func (_u *CollectionSectionUpdateOne) Mutation() *CollectionSectionMutation {
+	return _u.mutation
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (_u *CollectionSectionUpdateOne) ClearCollection() *CollectionSectionUpdateOne {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// Where appends a list predicates to the CollectionSectionUpdate builder.
+func (_u *CollectionSectionUpdateOne) Where(ps ...predicate.CollectionSection) *CollectionSectionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CollectionSectionUpdateOne) Select(field string, fields ...string) *CollectionSectionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CollectionSection entity.
+func (_u *CollectionSectionUpdateOne) Save(ctx context.Context) (*CollectionSection, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CollectionSectionUpdateOne) SaveX(ctx context.Context) *CollectionSection {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CollectionSectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CollectionSectionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CollectionSectionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := collectionsection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CollectionSectionUpdateOne) check() error {
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CollectionSection.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionSectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionSectionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CollectionSectionUpdateOne) sqlSave(ctx context.Context) (_node *CollectionSection, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectionsection.Table, collectionsection.Columns, sqlgraph.NewFieldSpec(collectionsection.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CollectionSection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collectionsection.FieldID)
+		for _, f := range fields {
+			if !collectionsection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collectionsection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(collectionsection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(collectionsection.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(collectionsection.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(collectionsection.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(collectionsection.FieldSort, field.TypeString, value)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionsection.CollectionTable,
+			Columns: []string{collectionsection.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionsection.CollectionTable,
+			Columns: []string{collectionsection.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CollectionSection{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectionsection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
+	"github.com/rs/xid"
+)
+
+// CollectionShare is the model entity for the CollectionShare schema.
+type CollectionShare struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CollectionID holds the value of the "collection_id" field.
+	CollectionID xid.ID `json:"collection_id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// PasscodeHash holds the value of the "passcode_hash" field.
+	PasscodeHash *string `json:"-"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollectionShareQuery when eager-loading is set.
+	Edges        CollectionShareEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CollectionShareEdges holds the relations/edges for other nodes in the graph.
+type CollectionShareEdges struct {
+	// Collection holds the value of the collection edge.
+	Collection *Collection `json:"collection,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CollectionOrErr returns the Collection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollectionShareEdges) CollectionOrErr() (*Collection, error) {
+	if e.Collection != nil {
+		return e.Collection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: collection.Label}
+	}
+	return nil, &NotLoadedError{edge: "collection"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CollectionShare) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collectionshare.FieldToken, collectionshare.FieldPasscodeHash:
+			values[i] = new(sql.NullString)
+		case collectionshare.FieldCreatedAt, collectionshare.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case collectionshare.FieldID, collectionshare.FieldCollectionID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CollectionShare fields.
+func (_m *CollectionShare) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collectionshare.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case collectionshare.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case collectionshare.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case collectionshare.FieldCollectionID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field collection_id", values[i])
+			} else if value != nil {
+				_m.CollectionID = *value
+			}
+		case collectionshare.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case collectionshare.FieldPasscodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field passcode_hash", values[i])
+			} else if value.Valid {
+				_m.PasscodeHash = new(string)
+				*_m.PasscodeHash = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CollectionShare.
+// This includes values selected through modifiers, order, etc.
+func (_m *CollectionShare) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCollection queries the "collection" edge of the CollectionShare entity.
+func (_m *CollectionShare) QueryCollection() *CollectionQuery {
+	return NewCollectionShareClient(_m.config).QueryCollection(_m)
+}
+
+// Update returns a builder for updating this CollectionShare.
+// Note that you need to call CollectionShare.Unwrap() before calling this method if this CollectionShare
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CollectionShare) Update() *CollectionShareUpdateOne {
+	return NewCollectionShareClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CollectionShare entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CollectionShare) Unwrap() *CollectionShare {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CollectionShare is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CollectionShare) String() string {
+	var builder strings.Builder
+	builder.WriteString("CollectionShare(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectionID))
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("passcode_hash=<sensitive>")
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CollectionShares is a parsable slice of CollectionShare.
+type CollectionShares []*CollectionShare
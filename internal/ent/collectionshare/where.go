@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collectionshare
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CollectionID applies equality check predicate on the "collection_id" field. It's identical to CollectionIDEQ.
+func CollectionID(v xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldToken, v))
+}
+
+// PasscodeHash applies equality check predicate on the "passcode_hash" field. It's identical to PasscodeHashEQ.
+func PasscodeHash(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldPasscodeHash, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
+func CollectionIDEQ(v xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
+func CollectionIDNEQ(v xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNEQ(FieldCollectionID, v))
+}
+
+// CollectionIDIn applies the In predicate on the "collection_id" field.
+func CollectionIDIn(vs ...xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
+func CollectionIDNotIn(vs ...xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDGT applies the GT predicate on the "collection_id" field.
+func CollectionIDGT(v xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGT(FieldCollectionID, v))
+}
+
+// CollectionIDGTE applies the GTE predicate on the "collection_id" field.
+func CollectionIDGTE(v xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGTE(FieldCollectionID, v))
+}
+
+// CollectionIDLT applies the LT predicate on the "collection_id" field.
+func CollectionIDLT(v xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLT(FieldCollectionID, v))
+}
+
+// CollectionIDLTE applies the LTE predicate on the "collection_id" field.
+func CollectionIDLTE(v xid.ID) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLTE(FieldCollectionID, v))
+}
+
+// CollectionIDContains applies the Contains predicate on the "collection_id" field.
+func CollectionIDContains(v xid.ID) predicate.CollectionShare {
+	vc := v.String()
+	return predicate.CollectionShare(sql.FieldContains(FieldCollectionID, vc))
+}
+
+// CollectionIDHasPrefix applies the HasPrefix predicate on the "collection_id" field.
+func CollectionIDHasPrefix(v xid.ID) predicate.CollectionShare {
+	vc := v.String()
+	return predicate.CollectionShare(sql.FieldHasPrefix(FieldCollectionID, vc))
+}
+
+// CollectionIDHasSuffix applies the HasSuffix predicate on the "collection_id" field.
+func CollectionIDHasSuffix(v xid.ID) predicate.CollectionShare {
+	vc := v.String()
+	return predicate.CollectionShare(sql.FieldHasSuffix(FieldCollectionID, vc))
+}
+
+// CollectionIDEqualFold applies the EqualFold predicate on the "collection_id" field.
+func CollectionIDEqualFold(v xid.ID) predicate.CollectionShare {
+	vc := v.String()
+	return predicate.CollectionShare(sql.FieldEqualFold(FieldCollectionID, vc))
+}
+
+// CollectionIDContainsFold applies the ContainsFold predicate on the "collection_id" field.
+func CollectionIDContainsFold(v xid.ID) predicate.CollectionShare {
+	vc := v.String()
+	return predicate.CollectionShare(sql.FieldContainsFold(FieldCollectionID, vc))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldContainsFold(FieldToken, v))
+}
+
+// PasscodeHashEQ applies the EQ predicate on the "passcode_hash" field.
+func PasscodeHashEQ(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEQ(FieldPasscodeHash, v))
+}
+
+// PasscodeHashNEQ applies the NEQ predicate on the "passcode_hash" field.
+func PasscodeHashNEQ(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNEQ(FieldPasscodeHash, v))
+}
+
+// PasscodeHashIn applies the In predicate on the "passcode_hash" field.
+func PasscodeHashIn(vs ...string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIn(FieldPasscodeHash, vs...))
+}
+
+// PasscodeHashNotIn applies the NotIn predicate on the "passcode_hash" field.
+func PasscodeHashNotIn(vs ...string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotIn(FieldPasscodeHash, vs...))
+}
+
+// PasscodeHashGT applies the GT predicate on the "passcode_hash" field.
+func PasscodeHashGT(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGT(FieldPasscodeHash, v))
+}
+
+// PasscodeHashGTE applies the GTE predicate on the "passcode_hash" field.
+func PasscodeHashGTE(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldGTE(FieldPasscodeHash, v))
+}
+
+// PasscodeHashLT applies the LT predicate on the "passcode_hash" field.
+func PasscodeHashLT(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLT(FieldPasscodeHash, v))
+}
+
+// PasscodeHashLTE applies the LTE predicate on the "passcode_hash" field.
+func PasscodeHashLTE(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldLTE(FieldPasscodeHash, v))
+}
+
+// PasscodeHashContains applies the Contains predicate on the "passcode_hash" field.
+func PasscodeHashContains(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldContains(FieldPasscodeHash, v))
+}
+
+// PasscodeHashHasPrefix applies the HasPrefix predicate on the "passcode_hash" field.
+func PasscodeHashHasPrefix(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldHasPrefix(FieldPasscodeHash, v))
+}
+
+// PasscodeHashHasSuffix applies the HasSuffix predicate on the "passcode_hash" field.
+func PasscodeHashHasSuffix(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldHasSuffix(FieldPasscodeHash, v))
+}
+
+// PasscodeHashIsNil applies the IsNil predicate on the "passcode_hash" field.
+func PasscodeHashIsNil() predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldIsNull(FieldPasscodeHash))
+}
+
+// PasscodeHashNotNil applies the NotNil predicate on the "passcode_hash" field.
+func PasscodeHashNotNil() predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldNotNull(FieldPasscodeHash))
+}
+
+// PasscodeHashEqualFold applies the EqualFold predicate on the "passcode_hash" field.
+func PasscodeHashEqualFold(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldEqualFold(FieldPasscodeHash, v))
+}
+
+// PasscodeHashContainsFold applies the ContainsFold predicate on the "passcode_hash" field.
+func PasscodeHashContainsFold(v string) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.FieldContainsFold(FieldPasscodeHash, v))
+}
+
+// HasCollection applies the HasEdge predicate on the "collection" edge.
+func HasCollection() predicate.CollectionShare {
+	return predicate.CollectionShare(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CollectionTable, CollectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionWith applies the HasEdge predicate on the "collection" edge with a given conditions (other predicates).
+func HasCollectionWith(preds ...predicate.Collection) predicate.CollectionShare {
+	return predicate.CollectionShare(func(s *sql.Selector) {
+		step := newCollectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CollectionShare) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CollectionShare) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CollectionShare) predicate.CollectionShare {
+	return predicate.CollectionShare(sql.NotPredicates(p))
+}
@@ -0,0 +1,781 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
+	"github.com/rs/xid"
+)
+
+// CollectionShareCreate is the builder for creating a CollectionShare entity.
+type CollectionShareCreate struct {
+	config
+	mutation *CollectionShareMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CollectionShareCreate) SetCreatedAt(v time.Time) *CollectionShareCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CollectionShareCreate) SetNillableCreatedAt(v *time.Time) *CollectionShareCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *CollectionShareCreate) SetExpiresAt(v time.Time) *CollectionShareCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *CollectionShareCreate) SetNillableExpiresAt(v *time.Time) *CollectionShareCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_c *CollectionShareCreate) SetCollectionID(v xid.ID) *CollectionShareCreate {
+	_c.mutation.SetCollectionID(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *CollectionShareCreate) SetToken(v string) *CollectionShareCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetPasscodeHash sets the "passcode_hash" field.
+func (_c *CollectionShareCreate) SetPasscodeHash(v string) *CollectionShareCreate {
+	_c.mutation.SetPasscodeHash(v)
+	return _c
+}
+
+// SetNillablePasscodeHash sets the "passcode_hash" field if the given value is not nil.
+func (_c *CollectionShareCreate) SetNillablePasscodeHash(v *string) *CollectionShareCreate {
+	if v != nil {
+		_c.SetPasscodeHash(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CollectionShareCreate) SetID(v xid.ID) *CollectionShareCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CollectionShareCreate) SetNillableID(v *xid.ID) *CollectionShareCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_c *CollectionShareCreate) SetCollection(v *Collection) *CollectionShareCreate {
+	return _c.SetCollectionID(v.ID)
+}
+
+// Mutation returns the CollectionShareMutation object of the builder.
+func (_c *CollectionShareCreate) Mutation() *CollectionShareMutation {
+	return _c.mutation
+}
+
+// Save creates the CollectionShare in the database.
+func (_c *CollectionShareCreate) Save(ctx context.Context) (*CollectionShare, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CollectionShareCreate) SaveX(ctx context.Context) *CollectionShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CollectionShareCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CollectionShareCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CollectionShareCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := collectionshare.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := collectionshare.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CollectionShareCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CollectionShare.created_at"`)}
+	}
+	if _, ok := _c.mutation.CollectionID(); !ok {
+		return &ValidationError{Name: "collection_id", err: errors.New(`ent: missing required field "CollectionShare.collection_id"`)}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "CollectionShare.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := collectionshare.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "CollectionShare.token": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := collectionshare.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CollectionShare.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CollectionIDs()) == 0 {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required edge "CollectionShare.collection"`)}
+	}
+	return nil
+}
+
+func (_c *CollectionShareCreate) sqlSave(ctx context.Context) (*CollectionShare, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CollectionShareCreate) createSpec() (*CollectionShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CollectionShare{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(collectionshare.Table, sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(collectionshare.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(collectionshare.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(collectionshare.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.PasscodeHash(); ok {
+		_spec.SetField(collectionshare.FieldPasscodeHash, field.TypeString, value)
+		_node.PasscodeHash = &value
+	}
+	if nodes := _c.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionshare.CollectionTable,
+			Columns: []string{collectionshare.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CollectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CollectionShare.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CollectionShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CollectionShareCreate) OnConflict(opts ...sql.ConflictOption) *CollectionShareUpsertOne {
+	_c.conflict = opts
+	return &CollectionShareUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CollectionShare.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CollectionShareCreate) OnConflictColumns(columns ...string) *CollectionShareUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CollectionShareUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CollectionShareUpsertOne is the builder for "upsert"-ing
+	//  one CollectionShare node.
+	CollectionShareUpsertOne struct {
+		create *CollectionShareCreate
+	}
+
+	// CollectionShareUpsert is the "OnConflict" setter.
+	CollectionShareUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CollectionShareUpsert) SetExpiresAt(v time.Time) *CollectionShareUpsert {
+	u.Set(collectionshare.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CollectionShareUpsert) UpdateExpiresAt() *CollectionShareUpsert {
+	u.SetExcluded(collectionshare.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *CollectionShareUpsert) ClearExpiresAt() *CollectionShareUpsert {
+	u.SetNull(collectionshare.FieldExpiresAt)
+	return u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (u *CollectionShareUpsert) SetCollectionID(v xid.ID) *CollectionShareUpsert {
+	u.Set(collectionshare.FieldCollectionID, v)
+	return u
+}
+
+// UpdateCollectionID sets the "collection_id" field to the value that was provided on create.
+func (u *CollectionShareUpsert) UpdateCollectionID() *CollectionShareUpsert {
+	u.SetExcluded(collectionshare.FieldCollectionID)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *CollectionShareUpsert) SetToken(v string) *CollectionShareUpsert {
+	u.Set(collectionshare.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *CollectionShareUpsert) UpdateToken() *CollectionShareUpsert {
+	u.SetExcluded(collectionshare.FieldToken)
+	return u
+}
+
+// SetPasscodeHash sets the "passcode_hash" field.
+func (u *CollectionShareUpsert) SetPasscodeHash(v string) *CollectionShareUpsert {
+	u.Set(collectionshare.FieldPasscodeHash, v)
+	return u
+}
+
+// UpdatePasscodeHash sets the "passcode_hash" field to the value that was provided on create.
+func (u *CollectionShareUpsert) UpdatePasscodeHash() *CollectionShareUpsert {
+	u.SetExcluded(collectionshare.FieldPasscodeHash)
+	return u
+}
+
+// ClearPasscodeHash clears the value of the "passcode_hash" field.
+func (u *CollectionShareUpsert) ClearPasscodeHash() *CollectionShareUpsert {
+	u.SetNull(collectionshare.FieldPasscodeHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CollectionShare.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(collectionshare.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CollectionShareUpsertOne) UpdateNewValues() *CollectionShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(collectionshare.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(collectionshare.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CollectionShare.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CollectionShareUpsertOne) Ignore() *CollectionShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CollectionShareUpsertOne) DoNothing() *CollectionShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CollectionShareCreate.OnConflict
+// documentation for more info.
+func (u *CollectionShareUpsertOne) Update(set func(*CollectionShareUpsert)) *CollectionShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CollectionShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CollectionShareUpsertOne) SetExpiresAt(v time.Time) *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CollectionShareUpsertOne) UpdateExpiresAt() *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *CollectionShareUpsertOne) ClearExpiresAt() *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (u *CollectionShareUpsertOne) SetCollectionID(v xid.ID) *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetCollectionID(v)
+	})
+}
+
+// UpdateCollectionID sets the "collection_id" field to the value that was provided on create.
+func (u *CollectionShareUpsertOne) UpdateCollectionID() *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdateCollectionID()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *CollectionShareUpsertOne) SetToken(v string) *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *CollectionShareUpsertOne) UpdateToken() *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetPasscodeHash sets the "passcode_hash" field.
+func (u *CollectionShareUpsertOne) SetPasscodeHash(v string) *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetPasscodeHash(v)
+	})
+}
+
+// UpdatePasscodeHash sets the "passcode_hash" field to the value that was provided on create.
+func (u *CollectionShareUpsertOne) UpdatePasscodeHash() *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdatePasscodeHash()
+	})
+}
+
+// ClearPasscodeHash clears the value of the "passcode_hash" field.
+func (u *CollectionShareUpsertOne) ClearPasscodeHash() *CollectionShareUpsertOne {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.ClearPasscodeHash()
+	})
+}
+
+// Exec executes the query.
+func (u *CollectionShareUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CollectionShareCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CollectionShareUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CollectionShareUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CollectionShareUpsertOne.ID is not supported by MySQL driver. Use CollectionShareUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CollectionShareUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CollectionShareCreateBulk is the builder for creating many CollectionShare entities in bulk.
+type CollectionShareCreateBulk struct {
+	config
+	err      error
+	builders []*CollectionShareCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CollectionShare entities in the database.
+func (_c *CollectionShareCreateBulk) Save(ctx context.Context) ([]*CollectionShare, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CollectionShare, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CollectionShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CollectionShareCreateBulk) SaveX(ctx context.Context) []*CollectionShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CollectionShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CollectionShareCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CollectionShare.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CollectionShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CollectionShareCreateBulk) OnConflict(opts ...sql.ConflictOption) *CollectionShareUpsertBulk {
+	_c.conflict = opts
+	return &CollectionShareUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CollectionShare.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CollectionShareCreateBulk) OnConflictColumns(columns ...string) *CollectionShareUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CollectionShareUpsertBulk{
+		create: _c,
+	}
+}
+
+// CollectionShareUpsertBulk is the builder for "upsert"-ing
+// a bulk of CollectionShare nodes.
+type CollectionShareUpsertBulk struct {
+	create *CollectionShareCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CollectionShare.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(collectionshare.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CollectionShareUpsertBulk) UpdateNewValues() *CollectionShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(collectionshare.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(collectionshare.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CollectionShare.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CollectionShareUpsertBulk) Ignore() *CollectionShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CollectionShareUpsertBulk) DoNothing() *CollectionShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CollectionShareCreateBulk.OnConflict
+// documentation for more info.
+func (u *CollectionShareUpsertBulk) Update(set func(*CollectionShareUpsert)) *CollectionShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CollectionShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CollectionShareUpsertBulk) SetExpiresAt(v time.Time) *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CollectionShareUpsertBulk) UpdateExpiresAt() *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *CollectionShareUpsertBulk) ClearExpiresAt() *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (u *CollectionShareUpsertBulk) SetCollectionID(v xid.ID) *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetCollectionID(v)
+	})
+}
+
+// UpdateCollectionID sets the "collection_id" field to the value that was provided on create.
+func (u *CollectionShareUpsertBulk) UpdateCollectionID() *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdateCollectionID()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *CollectionShareUpsertBulk) SetToken(v string) *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *CollectionShareUpsertBulk) UpdateToken() *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetPasscodeHash sets the "passcode_hash" field.
+func (u *CollectionShareUpsertBulk) SetPasscodeHash(v string) *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.SetPasscodeHash(v)
+	})
+}
+
+// UpdatePasscodeHash sets the "passcode_hash" field to the value that was provided on create.
+func (u *CollectionShareUpsertBulk) UpdatePasscodeHash() *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.UpdatePasscodeHash()
+	})
+}
+
+// ClearPasscodeHash clears the value of the "passcode_hash" field.
+func (u *CollectionShareUpsertBulk) ClearPasscodeHash() *CollectionShareUpsertBulk {
+	return u.Update(func(s *CollectionShareUpsert) {
+		s.ClearPasscodeHash()
+	})
+}
+
+// Exec executes the query.
+func (u *CollectionShareUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CollectionShareCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CollectionShareCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CollectionShareUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// CollectionShareUpdate is the builder for updating CollectionShare entities.
+type CollectionShareUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CollectionShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CollectionShareUpdate builder.
+func (_u *CollectionShareUpdate) Where(ps ...predicate.CollectionShare) *CollectionShareUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *CollectionShareUpdate) SetExpiresAt(v time.Time) *CollectionShareUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *CollectionShareUpdate) SetNillableExpiresAt(v *time.Time) *CollectionShareUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *CollectionShareUpdate) ClearExpiresAt() *CollectionShareUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *CollectionShareUpdate) SetCollectionID(v xid.ID) *CollectionShareUpdate {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *CollectionShareUpdate) SetNillableCollectionID(v *xid.ID) *CollectionShareUpdate {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *CollectionShareUpdate) SetToken(v string) *CollectionShareUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *CollectionShareUpdate) SetNillableToken(v *string) *CollectionShareUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetPasscodeHash sets the "passcode_hash" field.
+func (_u *CollectionShareUpdate) SetPasscodeHash(v string) *CollectionShareUpdate {
+	_u.mutation.SetPasscodeHash(v)
+	return _u
+}
+
+// SetNillablePasscodeHash sets the "passcode_hash" field if the given value is not nil.
+func (_u *CollectionShareUpdate) SetNillablePasscodeHash(v *string) *CollectionShareUpdate {
+	if v != nil {
+		_u.SetPasscodeHash(*v)
+	}
+	return _u
+}
+
+// ClearPasscodeHash clears the value of the "passcode_hash" field.
+func (_u *CollectionShareUpdate) ClearPasscodeHash() *CollectionShareUpdate {
+	_u.mutation.ClearPasscodeHash()
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_u *CollectionShareUpdate) SetCollection(v *Collection) *CollectionShareUpdate {
+	return _u.SetCollectionID(v.ID)
+}
+
+// Mutation returns the CollectionShareMutation object of the builder.
+func (_u *CollectionShareUpdate) Mutation() *CollectionShareMutation {
+	return _u.mutation
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (_u *CollectionShareUpdate) ClearCollection() *CollectionShareUpdate {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CollectionShareUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CollectionShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CollectionShareUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CollectionShareUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CollectionShareUpdate) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := collectionshare.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "CollectionShare.token": %w`, err)}
+		}
+	}
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CollectionShare.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionShareUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionShareUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CollectionShareUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectionshare.Table, collectionshare.Columns, sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(collectionshare.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(collectionshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(collectionshare.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PasscodeHash(); ok {
+		_spec.SetField(collectionshare.FieldPasscodeHash, field.TypeString, value)
+	}
+	if _u.mutation.PasscodeHashCleared() {
+		_spec.ClearField(collectionshare.FieldPasscodeHash, field.TypeString)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionshare.CollectionTable,
+			Columns: []string{collectionshare.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionshare.CollectionTable,
+			Columns: []string{collectionshare.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectionshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CollectionShareUpdateOne is the builder for updating a single CollectionShare entity.
+type CollectionShareUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CollectionShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *CollectionShareUpdateOne) SetExpiresAt(v time.Time) *CollectionShareUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *CollectionShareUpdateOne) SetNillableExpiresAt(v *time.Time) *CollectionShareUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *CollectionShareUpdateOne) ClearExpiresAt() *CollectionShareUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *CollectionShareUpdateOne) SetCollectionID(v xid.ID) *CollectionShareUpdateOne {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *CollectionShareUpdateOne) SetNillableCollectionID(v *xid.ID) *CollectionShareUpdateOne {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *CollectionShareUpdateOne) SetToken(v string) *CollectionShareUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *CollectionShareUpdateOne) SetNillableToken(v *string) *CollectionShareUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetPasscodeHash sets the "passcode_hash" field.
+func (_u *CollectionShareUpdateOne) SetPasscodeHash(v string) *CollectionShareUpdateOne {
+	_u.mutation.SetPasscodeHash(v)
+	return _u
+}
+
+// SetNillablePasscodeHash sets the "passcode_hash" field if the given value is not nil.
+func (_u *CollectionShareUpdateOne) SetNillablePasscodeHash(v *string) *CollectionShareUpdateOne {
+	if v != nil {
+		_u.SetPasscodeHash(*v)
+	}
+	return _u
+}
+
+// ClearPasscodeHash clears the value of the "passcode_hash" field.
+func (_u *CollectionShareUpdateOne) ClearPasscodeHash() *CollectionShareUpdateOne {
+	_u.mutation.ClearPasscodeHash()
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_u *CollectionShareUpdateOne) SetCollection(v *Collection) *CollectionShareUpdateOne {
+	return _u.SetCollectionID(v.ID)
+}
+
+// Mutation returns the CollectionShareMutation object of the builder.
+func (_u *CollectionShareUpdateOne) Mutation() *CollectionShareMutation {
+	return _u.mutation
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (_u *CollectionShareUpdateOne) ClearCollection() *CollectionShareUpdateOne {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// Where appends a list predicates to the CollectionShareUpdate builder.
+func (_u *CollectionShareUpdateOne) Where(ps ...predicate.CollectionShare) *CollectionShareUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CollectionShareUpdateOne) Select(field string, fields ...string) *CollectionShareUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CollectionShare entity.
+func (_u *CollectionShareUpdateOne) Save(ctx context.Context) (*CollectionShare, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CollectionShareUpdateOne) SaveX(ctx context.Context) *CollectionShare {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CollectionShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CollectionShareUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CollectionShareUpdateOne) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := collectionshare.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "CollectionShare.token": %w`, err)}
+		}
+	}
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CollectionShare.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionShareUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionShareUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CollectionShareUpdateOne) sqlSave(ctx context.Context) (_node *CollectionShare, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collectionshare.Table, collectionshare.Columns, sqlgraph.NewFieldSpec(collectionshare.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CollectionShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collectionshare.FieldID)
+		for _, f := range fields {
+			if !collectionshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collectionshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(collectionshare.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(collectionshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(collectionshare.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PasscodeHash(); ok {
+		_spec.SetField(collectionshare.FieldPasscodeHash, field.TypeString, value)
+	}
+	if _u.mutation.PasscodeHashCleared() {
+		_spec.ClearField(collectionshare.FieldPasscodeHash, field.TypeString)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionshare.CollectionTable,
+			Columns: []string{collectionshare.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collectionshare.CollectionTable,
+			Columns: []string{collectionshare.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CollectionShare{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collectionshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentsummary
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ItemKind applies equality check predicate on the "item_kind" field. It's identical to ItemKindEQ.
+func ItemKind(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldItemID, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldSummary, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ItemKindEQ applies the EQ predicate on the "item_kind" field.
+func ItemKindEQ(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemKindNEQ applies the NEQ predicate on the "item_kind" field.
+func ItemKindNEQ(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNEQ(FieldItemKind, v))
+}
+
+// ItemKindIn applies the In predicate on the "item_kind" field.
+func ItemKindIn(vs ...string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldIn(FieldItemKind, vs...))
+}
+
+// ItemKindNotIn applies the NotIn predicate on the "item_kind" field.
+func ItemKindNotIn(vs ...string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNotIn(FieldItemKind, vs...))
+}
+
+// ItemKindGT applies the GT predicate on the "item_kind" field.
+func ItemKindGT(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGT(FieldItemKind, v))
+}
+
+// ItemKindGTE applies the GTE predicate on the "item_kind" field.
+func ItemKindGTE(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGTE(FieldItemKind, v))
+}
+
+// ItemKindLT applies the LT predicate on the "item_kind" field.
+func ItemKindLT(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLT(FieldItemKind, v))
+}
+
+// ItemKindLTE applies the LTE predicate on the "item_kind" field.
+func ItemKindLTE(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLTE(FieldItemKind, v))
+}
+
+// ItemKindContains applies the Contains predicate on the "item_kind" field.
+func ItemKindContains(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldContains(FieldItemKind, v))
+}
+
+// ItemKindHasPrefix applies the HasPrefix predicate on the "item_kind" field.
+func ItemKindHasPrefix(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldHasPrefix(FieldItemKind, v))
+}
+
+// ItemKindHasSuffix applies the HasSuffix predicate on the "item_kind" field.
+func ItemKindHasSuffix(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldHasSuffix(FieldItemKind, v))
+}
+
+// ItemKindEqualFold applies the EqualFold predicate on the "item_kind" field.
+func ItemKindEqualFold(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEqualFold(FieldItemKind, v))
+}
+
+// ItemKindContainsFold applies the ContainsFold predicate on the "item_kind" field.
+func ItemKindContainsFold(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldContainsFold(FieldItemKind, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v xid.ID) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v xid.ID) predicate.ContentSummary {
+	vc := v.String()
+	return predicate.ContentSummary(sql.FieldContains(FieldItemID, vc))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v xid.ID) predicate.ContentSummary {
+	vc := v.String()
+	return predicate.ContentSummary(sql.FieldHasPrefix(FieldItemID, vc))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v xid.ID) predicate.ContentSummary {
+	vc := v.String()
+	return predicate.ContentSummary(sql.FieldHasSuffix(FieldItemID, vc))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v xid.ID) predicate.ContentSummary {
+	vc := v.String()
+	return predicate.ContentSummary(sql.FieldEqualFold(FieldItemID, vc))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v xid.ID) predicate.ContentSummary {
+	vc := v.String()
+	return predicate.ContentSummary(sql.FieldContainsFold(FieldItemID, vc))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentSummary) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentSummary) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentSummary) predicate.ContentSummary {
+	return predicate.ContentSummary(sql.NotPredicates(p))
+}
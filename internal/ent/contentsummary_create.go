@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/contentsummary"
+	"github.com/rs/xid"
+)
+
+// ContentSummaryCreate is the builder for creating a ContentSummary entity.
+type ContentSummaryCreate struct {
+	config
+	mutation *ContentSummaryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContentSummaryCreate) SetCreatedAt(v time.Time) *ContentSummaryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ContentSummaryCreate) SetNillableCreatedAt(v *time.Time) *ContentSummaryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ContentSummaryCreate) SetUpdatedAt(v time.Time) *ContentSummaryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ContentSummaryCreate) SetNillableUpdatedAt(v *time.Time) *ContentSummaryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_c *ContentSummaryCreate) SetItemKind(v string) *ContentSummaryCreate {
+	_c.mutation.SetItemKind(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ContentSummaryCreate) SetItemID(v xid.ID) *ContentSummaryCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *ContentSummaryCreate) SetSummary(v string) *ContentSummaryCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetSignature sets the "signature" field.
+func (_c *ContentSummaryCreate) SetSignature(v []uint64) *ContentSummaryCreate {
+	_c.mutation.SetSignature(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContentSummaryCreate) SetID(v xid.ID) *ContentSummaryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ContentSummaryCreate) SetNillableID(v *xid.ID) *ContentSummaryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ContentSummaryMutation object of the builder.
+func (_c *ContentSummaryCreate) Mutation() *ContentSummaryMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentSummary in the database.
+func (_c *ContentSummaryCreate) Save(ctx context.Context) (*ContentSummary, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentSummaryCreate) SaveX(ctx context.Context) *ContentSummary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentSummaryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentSummaryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentSummaryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := contentsummary.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := contentsummary.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := contentsummary.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentSummaryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContentSummary.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ContentSummary.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ItemKind(); !ok {
+		return &ValidationError{Name: "item_kind", err: errors.New(`ent: missing required field "ContentSummary.item_kind"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ContentSummary.item_id"`)}
+	}
+	if _, ok := _c.mutation.Summary(); !ok {
+		return &ValidationError{Name: "summary", err: errors.New(`ent: missing required field "ContentSummary.summary"`)}
+	}
+	if _, ok := _c.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "ContentSummary.signature"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := contentsummary.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ContentSummary.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ContentSummaryCreate) sqlSave(ctx context.Context) (*ContentSummary, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentSummaryCreate) createSpec() (*ContentSummary, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentSummary{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentsummary.Table, sqlgraph.NewFieldSpec(contentsummary.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contentsummary.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentsummary.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ItemKind(); ok {
+		_spec.SetField(contentsummary.FieldItemKind, field.TypeString, value)
+		_node.ItemKind = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(contentsummary.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(contentsummary.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.Signature(); ok {
+		_spec.SetField(contentsummary.FieldSignature, field.TypeJSON, value)
+		_node.Signature = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContentSummary.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContentSummaryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ContentSummaryCreate) OnConflict(opts ...sql.ConflictOption) *ContentSummaryUpsertOne {
+	_c.conflict = opts
+	return &ContentSummaryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContentSummary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ContentSummaryCreate) OnConflictColumns(columns ...string) *ContentSummaryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ContentSummaryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ContentSummaryUpsertOne is the builder for "upsert"-ing
+	//  one ContentSummary node.
+	ContentSummaryUpsertOne struct {
+		create *ContentSummaryCreate
+	}
+
+	// ContentSummaryUpsert is the "OnConflict" setter.
+	ContentSummaryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentSummaryUpsert) SetUpdatedAt(v time.Time) *ContentSummaryUpsert {
+	u.Set(contentsummary.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentSummaryUpsert) UpdateUpdatedAt() *ContentSummaryUpsert {
+	u.SetExcluded(contentsummary.FieldUpdatedAt)
+	return u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ContentSummaryUpsert) SetItemKind(v string) *ContentSummaryUpsert {
+	u.Set(contentsummary.FieldItemKind, v)
+	return u
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ContentSummaryUpsert) UpdateItemKind() *ContentSummaryUpsert {
+	u.SetExcluded(contentsummary.FieldItemKind)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ContentSummaryUpsert) SetItemID(v xid.ID) *ContentSummaryUpsert {
+	u.Set(contentsummary.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ContentSummaryUpsert) UpdateItemID() *ContentSummaryUpsert {
+	u.SetExcluded(contentsummary.FieldItemID)
+	return u
+}
+
+// SetSummary sets the "summary" field.
+func (u *ContentSummaryUpsert) SetSummary(v string) *ContentSummaryUpsert {
+	u.Set(contentsummary.FieldSummary, v)
+	return u
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ContentSummaryUpsert) UpdateSummary() *ContentSummaryUpsert {
+	u.SetExcluded(contentsummary.FieldSummary)
+	return u
+}
+
+// SetSignature sets the "signature" field.
+func (u *ContentSummaryUpsert) SetSignature(v []uint64) *ContentSummaryUpsert {
+	u.Set(contentsummary.FieldSignature, v)
+	return u
+}
+
+// UpdateSignature sets the "signature" field to the value that was provided on create.
+func (u *ContentSummaryUpsert) UpdateSignature() *ContentSummaryUpsert {
+	u.SetExcluded(contentsummary.FieldSignature)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ContentSummary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contentsummary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContentSummaryUpsertOne) UpdateNewValues() *ContentSummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(contentsummary.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(contentsummary.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContentSummary.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ContentSummaryUpsertOne) Ignore() *ContentSummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContentSummaryUpsertOne) DoNothing() *ContentSummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContentSummaryCreate.OnConflict
+// documentation for more info.
+func (u *ContentSummaryUpsertOne) Update(set func(*ContentSummaryUpsert)) *ContentSummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContentSummaryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentSummaryUpsertOne) SetUpdatedAt(v time.Time) *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentSummaryUpsertOne) UpdateUpdatedAt() *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ContentSummaryUpsertOne) SetItemKind(v string) *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ContentSummaryUpsertOne) UpdateItemKind() *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ContentSummaryUpsertOne) SetItemID(v xid.ID) *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ContentSummaryUpsertOne) UpdateItemID() *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ContentSummaryUpsertOne) SetSummary(v string) *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ContentSummaryUpsertOne) UpdateSummary() *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// SetSignature sets the "signature" field.
+func (u *ContentSummaryUpsertOne) SetSignature(v []uint64) *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetSignature(v)
+	})
+}
+
+// UpdateSignature sets the "signature" field to the value that was provided on create.
+func (u *ContentSummaryUpsertOne) UpdateSignature() *ContentSummaryUpsertOne {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateSignature()
+	})
+}
+
+// Exec executes the query.
+func (u *ContentSummaryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContentSummaryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContentSummaryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ContentSummaryUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ContentSummaryUpsertOne.ID is not supported by MySQL driver. Use ContentSummaryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ContentSummaryUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ContentSummaryCreateBulk is the builder for creating many ContentSummary entities in bulk.
+type ContentSummaryCreateBulk struct {
+	config
+	err      error
+	builders []*ContentSummaryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ContentSummary entities in the database.
+func (_c *ContentSummaryCreateBulk) Save(ctx context.Context) ([]*ContentSummary, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentSummary, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentSummaryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentSummaryCreateBulk) SaveX(ctx context.Context) []*ContentSummary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentSummaryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentSummaryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContentSummary.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContentSummaryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ContentSummaryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ContentSummaryUpsertBulk {
+	_c.conflict = opts
+	return &ContentSummaryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContentSummary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ContentSummaryCreateBulk) OnConflictColumns(columns ...string) *ContentSummaryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ContentSummaryUpsertBulk{
+		create: _c,
+	}
+}
+
+// ContentSummaryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ContentSummary nodes.
+type ContentSummaryUpsertBulk struct {
+	create *ContentSummaryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ContentSummary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contentsummary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContentSummaryUpsertBulk) UpdateNewValues() *ContentSummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(contentsummary.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(contentsummary.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContentSummary.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ContentSummaryUpsertBulk) Ignore() *ContentSummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContentSummaryUpsertBulk) DoNothing() *ContentSummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContentSummaryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ContentSummaryUpsertBulk) Update(set func(*ContentSummaryUpsert)) *ContentSummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContentSummaryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentSummaryUpsertBulk) SetUpdatedAt(v time.Time) *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentSummaryUpsertBulk) UpdateUpdatedAt() *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ContentSummaryUpsertBulk) SetItemKind(v string) *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ContentSummaryUpsertBulk) UpdateItemKind() *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ContentSummaryUpsertBulk) SetItemID(v xid.ID) *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ContentSummaryUpsertBulk) UpdateItemID() *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ContentSummaryUpsertBulk) SetSummary(v string) *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ContentSummaryUpsertBulk) UpdateSummary() *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// SetSignature sets the "signature" field.
+func (u *ContentSummaryUpsertBulk) SetSignature(v []uint64) *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.SetSignature(v)
+	})
+}
+
+// UpdateSignature sets the "signature" field to the value that was provided on create.
+func (u *ContentSummaryUpsertBulk) UpdateSignature() *ContentSummaryUpsertBulk {
+	return u.Update(func(s *ContentSummaryUpsert) {
+		s.UpdateSignature()
+	})
+}
+
+// Exec executes the query.
+func (u *ContentSummaryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ContentSummaryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContentSummaryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContentSummaryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
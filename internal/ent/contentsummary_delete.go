@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/contentsummary"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// ContentSummaryDelete is the builder for deleting a ContentSummary entity.
+type ContentSummaryDelete struct {
+	config
+	hooks    []Hook
+	mutation *ContentSummaryMutation
+}
+
+// Where appends a list predicates to the ContentSummaryDelete builder.
+func (_d *ContentSummaryDelete) Where(ps ...predicate.ContentSummary) *ContentSummaryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ContentSummaryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ContentSummaryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ContentSummaryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(contentsummary.Table, sqlgraph.NewFieldSpec(contentsummary.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ContentSummaryDeleteOne is the builder for deleting a single ContentSummary entity.
+type ContentSummaryDeleteOne struct {
+	_d *ContentSummaryDelete
+}
+
+// Where appends a list predicates to the ContentSummaryDelete builder.
+func (_d *ContentSummaryDeleteOne) Where(ps ...predicate.ContentSummary) *ContentSummaryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ContentSummaryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{contentsummary.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ContentSummaryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/contentsummary"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ContentSummaryUpdate is the builder for updating ContentSummary entities.
+type ContentSummaryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ContentSummaryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ContentSummaryUpdate builder.
+func (_u *ContentSummaryUpdate) Where(ps ...predicate.ContentSummary) *ContentSummaryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentSummaryUpdate) SetUpdatedAt(v time.Time) *ContentSummaryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ContentSummaryUpdate) SetItemKind(v string) *ContentSummaryUpdate {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ContentSummaryUpdate) SetNillableItemKind(v *string) *ContentSummaryUpdate {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ContentSummaryUpdate) SetItemID(v xid.ID) *ContentSummaryUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ContentSummaryUpdate) SetNillableItemID(v *xid.ID) *ContentSummaryUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *ContentSummaryUpdate) SetSummary(v string) *ContentSummaryUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *ContentSummaryUpdate) SetNillableSummary(v *string) *ContentSummaryUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// SetSignature sets the "signature" field.
+func (_u *ContentSummaryUpdate) SetSignature(v []uint64) *ContentSummaryUpdate {
+	_u.mutation.SetSignature(v)
+	return _u
+}
+
+// AppendSignature appends value to the "signature" field.
+func (_u *ContentSummaryUpdate) AppendSignature(v []uint64) *ContentSummaryUpdate {
+	_u.mutation.AppendSignature(v)
+	return _u
+}
+
+// Mutation returns the ContentSummaryMutation object of the builder.
+func (_u *ContentSummaryUpdate) Mutation() *ContentSummaryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContentSummaryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentSummaryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContentSummaryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentSummaryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentSummaryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := contentsummary.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContentSummaryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContentSummaryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContentSummaryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentsummary.Table, contentsummary.Columns, sqlgraph.NewFieldSpec(contentsummary.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentsummary.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(contentsummary.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(contentsummary.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(contentsummary.FieldSummary, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Signature(); ok {
+		_spec.SetField(contentsummary.FieldSignature, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSignature(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contentsummary.FieldSignature, value)
+		})
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentsummary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContentSummaryUpdateOne is the builder for updating a single ContentSummary entity.
+type ContentSummaryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ContentSummaryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentSummaryUpdateOne) SetUpdatedAt(v time.Time) *ContentSummaryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ContentSummaryUpdateOne) SetItemKind(v string) *ContentSummaryUpdateOne {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ContentSummaryUpdateOne) SetNillableItemKind(v *string) *ContentSummaryUpdateOne {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ContentSummaryUpdateOne) SetItemID(v xid.ID) *ContentSummaryUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ContentSummaryUpdateOne) SetNillableItemID(v *xid.ID) *ContentSummaryUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *ContentSummaryUpdateOne) SetSummary(v string) *ContentSummaryUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *ContentSummaryUpdateOne) SetNillableSummary(v *string) *ContentSummaryUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// SetSignature sets the "signature" field.
+func (_u *ContentSummaryUpdateOne) SetSignature(v []uint64) *ContentSummaryUpdateOne {
+	_u.mutation.SetSignature(v)
+	return _u
+}
+
+// AppendSignature appends value to the "signature" field.
+func (_u *ContentSummaryUpdateOne) AppendSignature(v []uint64) *ContentSummaryUpdateOne {
+	_u.mutation.AppendSignature(v)
+	return _u
+}
+
+// Mutation returns the ContentSummaryMutation object of the builder.
+func (_u *ContentSummaryUpdateOne) Mutation() *ContentSummaryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ContentSummaryUpdate builder.
+func (_u *ContentSummaryUpdateOne) Where(ps ...predicate.ContentSummary) *ContentSummaryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContentSummaryUpdateOne) Select(field string, fields ...string) *ContentSummaryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContentSummary entity.
+func (_u *ContentSummaryUpdateOne) Save(ctx context.Context) (*ContentSummary, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentSummaryUpdateOne) SaveX(ctx context.Context) *ContentSummary {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContentSummaryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentSummaryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentSummaryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := contentsummary.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContentSummaryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContentSummaryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContentSummaryUpdateOne) sqlSave(ctx context.Context) (_node *ContentSummary, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentsummary.Table, contentsummary.Columns, sqlgraph.NewFieldSpec(contentsummary.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentSummary.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentsummary.FieldID)
+		for _, f := range fields {
+			if !contentsummary.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentsummary.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentsummary.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(contentsummary.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(contentsummary.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(contentsummary.FieldSummary, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Signature(); ok {
+		_spec.SetField(contentsummary.FieldSignature, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSignature(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contentsummary.FieldSignature, value)
+		})
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ContentSummary{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentsummary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
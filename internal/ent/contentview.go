@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/rs/xid"
+)
+
+// ContentView is the model entity for the ContentView schema.
+type ContentView struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Period holds the value of the "period" field.
+	Period string `json:"period,omitempty"`
+	// Bucket holds the value of the "bucket" field.
+	Bucket time.Time `json:"bucket,omitempty"`
+	// ItemKind holds the value of the "item_kind" field.
+	ItemKind string `json:"item_kind,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID xid.ID `json:"item_id,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID xid.ID `json:"author_id,omitempty"`
+	// Views holds the value of the "views" field.
+	Views int `json:"views,omitempty"`
+	// UniqueViewers holds the value of the "unique_viewers" field.
+	UniqueViewers int `json:"unique_viewers,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContentViewQuery when eager-loading is set.
+	Edges        ContentViewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContentViewEdges holds the relations/edges for other nodes in the graph.
+type ContentViewEdges struct {
+	// Author holds the value of the author edge.
+	Author *Account `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentViewEdges) AuthorOrErr() (*Account, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentView) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentview.FieldViews, contentview.FieldUniqueViewers:
+			values[i] = new(sql.NullInt64)
+		case contentview.FieldPeriod, contentview.FieldItemKind:
+			values[i] = new(sql.NullString)
+		case contentview.FieldCreatedAt, contentview.FieldUpdatedAt, contentview.FieldBucket:
+			values[i] = new(sql.NullTime)
+		case contentview.FieldID, contentview.FieldItemID, contentview.FieldAuthorID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentView fields.
+func (_m *ContentView) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentview.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case contentview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case contentview.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case contentview.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				_m.Period = value.String
+			}
+		case contentview.FieldBucket:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				_m.Bucket = value.Time
+			}
+		case contentview.FieldItemKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_kind", values[i])
+			} else if value.Valid {
+				_m.ItemKind = value.String
+			}
+		case contentview.FieldItemID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				_m.ItemID = *value
+			}
+		case contentview.FieldAuthorID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value != nil {
+				_m.AuthorID = *value
+			}
+		case contentview.FieldViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field views", values[i])
+			} else if value.Valid {
+				_m.Views = int(value.Int64)
+			}
+		case contentview.FieldUniqueViewers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_viewers", values[i])
+			} else if value.Valid {
+				_m.UniqueViewers = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentView.
+// This includes values selected through modifiers, order, etc.
+func (_m *ContentView) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAuthor queries the "author" edge of the ContentView entity.
+func (_m *ContentView) QueryAuthor() *AccountQuery {
+	return NewContentViewClient(_m.config).QueryAuthor(_m)
+}
+
+// Update returns a builder for updating this ContentView.
+// Note that you need to call ContentView.Unwrap() before calling this method if this ContentView
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ContentView) Update() *ContentViewUpdateOne {
+	return NewContentViewClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ContentView entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ContentView) Unwrap() *ContentView {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContentView is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ContentView) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentView(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(_m.Period)
+	builder.WriteString(", ")
+	builder.WriteString("bucket=")
+	builder.WriteString(_m.Bucket.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_kind=")
+	builder.WriteString(_m.ItemKind)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AuthorID))
+	builder.WriteString(", ")
+	builder.WriteString("views=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Views))
+	builder.WriteString(", ")
+	builder.WriteString("unique_viewers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UniqueViewers))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentViews is a parsable slice of ContentView.
+type ContentViews []*ContentView
@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentview
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Period applies equality check predicate on the "period" field. It's identical to PeriodEQ.
+func Period(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldPeriod, v))
+}
+
+// Bucket applies equality check predicate on the "bucket" field. It's identical to BucketEQ.
+func Bucket(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldBucket, v))
+}
+
+// ItemKind applies equality check predicate on the "item_kind" field. It's identical to ItemKindEQ.
+func ItemKind(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldItemID, v))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// Views applies equality check predicate on the "views" field. It's identical to ViewsEQ.
+func Views(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldViews, v))
+}
+
+// UniqueViewers applies equality check predicate on the "unique_viewers" field. It's identical to UniqueViewersEQ.
+func UniqueViewers(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldUniqueViewers, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// PeriodGT applies the GT predicate on the "period" field.
+func PeriodGT(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldPeriod, v))
+}
+
+// PeriodGTE applies the GTE predicate on the "period" field.
+func PeriodGTE(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldPeriod, v))
+}
+
+// PeriodLT applies the LT predicate on the "period" field.
+func PeriodLT(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldPeriod, v))
+}
+
+// PeriodLTE applies the LTE predicate on the "period" field.
+func PeriodLTE(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldPeriod, v))
+}
+
+// PeriodContains applies the Contains predicate on the "period" field.
+func PeriodContains(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldContains(FieldPeriod, v))
+}
+
+// PeriodHasPrefix applies the HasPrefix predicate on the "period" field.
+func PeriodHasPrefix(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldHasPrefix(FieldPeriod, v))
+}
+
+// PeriodHasSuffix applies the HasSuffix predicate on the "period" field.
+func PeriodHasSuffix(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldHasSuffix(FieldPeriod, v))
+}
+
+// PeriodEqualFold applies the EqualFold predicate on the "period" field.
+func PeriodEqualFold(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEqualFold(FieldPeriod, v))
+}
+
+// PeriodContainsFold applies the ContainsFold predicate on the "period" field.
+func PeriodContainsFold(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldContainsFold(FieldPeriod, v))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldBucket, v))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldBucket, v))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldBucket, vs...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldBucket, vs...))
+}
+
+// BucketGT applies the GT predicate on the "bucket" field.
+func BucketGT(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldBucket, v))
+}
+
+// BucketGTE applies the GTE predicate on the "bucket" field.
+func BucketGTE(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldBucket, v))
+}
+
+// BucketLT applies the LT predicate on the "bucket" field.
+func BucketLT(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldBucket, v))
+}
+
+// BucketLTE applies the LTE predicate on the "bucket" field.
+func BucketLTE(v time.Time) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldBucket, v))
+}
+
+// ItemKindEQ applies the EQ predicate on the "item_kind" field.
+func ItemKindEQ(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemKindNEQ applies the NEQ predicate on the "item_kind" field.
+func ItemKindNEQ(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldItemKind, v))
+}
+
+// ItemKindIn applies the In predicate on the "item_kind" field.
+func ItemKindIn(vs ...string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldItemKind, vs...))
+}
+
+// ItemKindNotIn applies the NotIn predicate on the "item_kind" field.
+func ItemKindNotIn(vs ...string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldItemKind, vs...))
+}
+
+// ItemKindGT applies the GT predicate on the "item_kind" field.
+func ItemKindGT(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldItemKind, v))
+}
+
+// ItemKindGTE applies the GTE predicate on the "item_kind" field.
+func ItemKindGTE(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldItemKind, v))
+}
+
+// ItemKindLT applies the LT predicate on the "item_kind" field.
+func ItemKindLT(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldItemKind, v))
+}
+
+// ItemKindLTE applies the LTE predicate on the "item_kind" field.
+func ItemKindLTE(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldItemKind, v))
+}
+
+// ItemKindContains applies the Contains predicate on the "item_kind" field.
+func ItemKindContains(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldContains(FieldItemKind, v))
+}
+
+// ItemKindHasPrefix applies the HasPrefix predicate on the "item_kind" field.
+func ItemKindHasPrefix(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldHasPrefix(FieldItemKind, v))
+}
+
+// ItemKindHasSuffix applies the HasSuffix predicate on the "item_kind" field.
+func ItemKindHasSuffix(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldHasSuffix(FieldItemKind, v))
+}
+
+// ItemKindEqualFold applies the EqualFold predicate on the "item_kind" field.
+func ItemKindEqualFold(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEqualFold(FieldItemKind, v))
+}
+
+// ItemKindContainsFold applies the ContainsFold predicate on the "item_kind" field.
+func ItemKindContainsFold(v string) predicate.ContentView {
+	return predicate.ContentView(sql.FieldContainsFold(FieldItemKind, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldContains(FieldItemID, vc))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldHasPrefix(FieldItemID, vc))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldHasSuffix(FieldItemID, vc))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldEqualFold(FieldItemID, vc))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldContainsFold(FieldItemID, vc))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDGT applies the GT predicate on the "author_id" field.
+func AuthorIDGT(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldAuthorID, v))
+}
+
+// AuthorIDGTE applies the GTE predicate on the "author_id" field.
+func AuthorIDGTE(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldAuthorID, v))
+}
+
+// AuthorIDLT applies the LT predicate on the "author_id" field.
+func AuthorIDLT(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldAuthorID, v))
+}
+
+// AuthorIDLTE applies the LTE predicate on the "author_id" field.
+func AuthorIDLTE(v xid.ID) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldAuthorID, v))
+}
+
+// AuthorIDContains applies the Contains predicate on the "author_id" field.
+func AuthorIDContains(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldContains(FieldAuthorID, vc))
+}
+
+// AuthorIDHasPrefix applies the HasPrefix predicate on the "author_id" field.
+func AuthorIDHasPrefix(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldHasPrefix(FieldAuthorID, vc))
+}
+
+// AuthorIDHasSuffix applies the HasSuffix predicate on the "author_id" field.
+func AuthorIDHasSuffix(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldHasSuffix(FieldAuthorID, vc))
+}
+
+// AuthorIDEqualFold applies the EqualFold predicate on the "author_id" field.
+func AuthorIDEqualFold(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldEqualFold(FieldAuthorID, vc))
+}
+
+// AuthorIDContainsFold applies the ContainsFold predicate on the "author_id" field.
+func AuthorIDContainsFold(v xid.ID) predicate.ContentView {
+	vc := v.String()
+	return predicate.ContentView(sql.FieldContainsFold(FieldAuthorID, vc))
+}
+
+// ViewsEQ applies the EQ predicate on the "views" field.
+func ViewsEQ(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldViews, v))
+}
+
+// ViewsNEQ applies the NEQ predicate on the "views" field.
+func ViewsNEQ(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldViews, v))
+}
+
+// ViewsIn applies the In predicate on the "views" field.
+func ViewsIn(vs ...int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldViews, vs...))
+}
+
+// ViewsNotIn applies the NotIn predicate on the "views" field.
+func ViewsNotIn(vs ...int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldViews, vs...))
+}
+
+// ViewsGT applies the GT predicate on the "views" field.
+func ViewsGT(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldViews, v))
+}
+
+// ViewsGTE applies the GTE predicate on the "views" field.
+func ViewsGTE(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldViews, v))
+}
+
+// ViewsLT applies the LT predicate on the "views" field.
+func ViewsLT(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldViews, v))
+}
+
+// ViewsLTE applies the LTE predicate on the "views" field.
+func ViewsLTE(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldViews, v))
+}
+
+// UniqueViewersEQ applies the EQ predicate on the "unique_viewers" field.
+func UniqueViewersEQ(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldEQ(FieldUniqueViewers, v))
+}
+
+// UniqueViewersNEQ applies the NEQ predicate on the "unique_viewers" field.
+func UniqueViewersNEQ(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNEQ(FieldUniqueViewers, v))
+}
+
+// UniqueViewersIn applies the In predicate on the "unique_viewers" field.
+func UniqueViewersIn(vs ...int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldIn(FieldUniqueViewers, vs...))
+}
+
+// UniqueViewersNotIn applies the NotIn predicate on the "unique_viewers" field.
+func UniqueViewersNotIn(vs ...int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldNotIn(FieldUniqueViewers, vs...))
+}
+
+// UniqueViewersGT applies the GT predicate on the "unique_viewers" field.
+func UniqueViewersGT(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGT(FieldUniqueViewers, v))
+}
+
+// UniqueViewersGTE applies the GTE predicate on the "unique_viewers" field.
+func UniqueViewersGTE(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldGTE(FieldUniqueViewers, v))
+}
+
+// UniqueViewersLT applies the LT predicate on the "unique_viewers" field.
+func UniqueViewersLT(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLT(FieldUniqueViewers, v))
+}
+
+// UniqueViewersLTE applies the LTE predicate on the "unique_viewers" field.
+func UniqueViewersLTE(v int) predicate.ContentView {
+	return predicate.ContentView(sql.FieldLTE(FieldUniqueViewers, v))
+}
+
+// HasAuthor applies the HasEdge predicate on the "author" edge.
+func HasAuthor() predicate.ContentView {
+	return predicate.ContentView(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AuthorTable, AuthorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthorWith applies the HasEdge predicate on the "author" edge with a given conditions (other predicates).
+func HasAuthorWith(preds ...predicate.Account) predicate.ContentView {
+	return predicate.ContentView(func(s *sql.Selector) {
+		step := newAuthorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentView) predicate.ContentView {
+	return predicate.ContentView(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentView) predicate.ContentView {
+	return predicate.ContentView(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentView) predicate.ContentView {
+	return predicate.ContentView(sql.NotPredicates(p))
+}
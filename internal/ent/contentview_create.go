@@ -0,0 +1,1014 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/rs/xid"
+)
+
+// ContentViewCreate is the builder for creating a ContentView entity.
+type ContentViewCreate struct {
+	config
+	mutation *ContentViewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContentViewCreate) SetCreatedAt(v time.Time) *ContentViewCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ContentViewCreate) SetNillableCreatedAt(v *time.Time) *ContentViewCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ContentViewCreate) SetUpdatedAt(v time.Time) *ContentViewCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ContentViewCreate) SetNillableUpdatedAt(v *time.Time) *ContentViewCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *ContentViewCreate) SetPeriod(v string) *ContentViewCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetBucket sets the "bucket" field.
+func (_c *ContentViewCreate) SetBucket(v time.Time) *ContentViewCreate {
+	_c.mutation.SetBucket(v)
+	return _c
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_c *ContentViewCreate) SetItemKind(v string) *ContentViewCreate {
+	_c.mutation.SetItemKind(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ContentViewCreate) SetItemID(v xid.ID) *ContentViewCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *ContentViewCreate) SetAuthorID(v xid.ID) *ContentViewCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetViews sets the "views" field.
+func (_c *ContentViewCreate) SetViews(v int) *ContentViewCreate {
+	_c.mutation.SetViews(v)
+	return _c
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_c *ContentViewCreate) SetNillableViews(v *int) *ContentViewCreate {
+	if v != nil {
+		_c.SetViews(*v)
+	}
+	return _c
+}
+
+// SetUniqueViewers sets the "unique_viewers" field.
+func (_c *ContentViewCreate) SetUniqueViewers(v int) *ContentViewCreate {
+	_c.mutation.SetUniqueViewers(v)
+	return _c
+}
+
+// SetNillableUniqueViewers sets the "unique_viewers" field if the given value is not nil.
+func (_c *ContentViewCreate) SetNillableUniqueViewers(v *int) *ContentViewCreate {
+	if v != nil {
+		_c.SetUniqueViewers(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContentViewCreate) SetID(v xid.ID) *ContentViewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ContentViewCreate) SetNillableID(v *xid.ID) *ContentViewCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_c *ContentViewCreate) SetAuthor(v *Account) *ContentViewCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// Mutation returns the ContentViewMutation object of the builder.
+func (_c *ContentViewCreate) Mutation() *ContentViewMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentView in the database.
+func (_c *ContentViewCreate) Save(ctx context.Context) (*ContentView, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentViewCreate) SaveX(ctx context.Context) *ContentView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentViewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentViewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentViewCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := contentview.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := contentview.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Views(); !ok {
+		v := contentview.DefaultViews
+		_c.mutation.SetViews(v)
+	}
+	if _, ok := _c.mutation.UniqueViewers(); !ok {
+		v := contentview.DefaultUniqueViewers
+		_c.mutation.SetUniqueViewers(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := contentview.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentViewCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContentView.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ContentView.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "ContentView.period"`)}
+	}
+	if _, ok := _c.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "ContentView.bucket"`)}
+	}
+	if _, ok := _c.mutation.ItemKind(); !ok {
+		return &ValidationError{Name: "item_kind", err: errors.New(`ent: missing required field "ContentView.item_kind"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ContentView.item_id"`)}
+	}
+	if _, ok := _c.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`ent: missing required field "ContentView.author_id"`)}
+	}
+	if _, ok := _c.mutation.Views(); !ok {
+		return &ValidationError{Name: "views", err: errors.New(`ent: missing required field "ContentView.views"`)}
+	}
+	if _, ok := _c.mutation.UniqueViewers(); !ok {
+		return &ValidationError{Name: "unique_viewers", err: errors.New(`ent: missing required field "ContentView.unique_viewers"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := contentview.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ContentView.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "ContentView.author"`)}
+	}
+	return nil
+}
+
+func (_c *ContentViewCreate) sqlSave(ctx context.Context) (*ContentView, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentViewCreate) createSpec() (*ContentView, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentView{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentview.Table, sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contentview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentview.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(contentview.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.Bucket(); ok {
+		_spec.SetField(contentview.FieldBucket, field.TypeTime, value)
+		_node.Bucket = value
+	}
+	if value, ok := _c.mutation.ItemKind(); ok {
+		_spec.SetField(contentview.FieldItemKind, field.TypeString, value)
+		_node.ItemKind = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(contentview.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.Views(); ok {
+		_spec.SetField(contentview.FieldViews, field.TypeInt, value)
+		_node.Views = value
+	}
+	if value, ok := _c.mutation.UniqueViewers(); ok {
+		_spec.SetField(contentview.FieldUniqueViewers, field.TypeInt, value)
+		_node.UniqueViewers = value
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentview.AuthorTable,
+			Columns: []string{contentview.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContentView.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContentViewUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ContentViewCreate) OnConflict(opts ...sql.ConflictOption) *ContentViewUpsertOne {
+	_c.conflict = opts
+	return &ContentViewUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContentView.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ContentViewCreate) OnConflictColumns(columns ...string) *ContentViewUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ContentViewUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ContentViewUpsertOne is the builder for "upsert"-ing
+	//  one ContentView node.
+	ContentViewUpsertOne struct {
+		create *ContentViewCreate
+	}
+
+	// ContentViewUpsert is the "OnConflict" setter.
+	ContentViewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentViewUpsert) SetUpdatedAt(v time.Time) *ContentViewUpsert {
+	u.Set(contentview.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdateUpdatedAt() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldUpdatedAt)
+	return u
+}
+
+// SetPeriod sets the "period" field.
+func (u *ContentViewUpsert) SetPeriod(v string) *ContentViewUpsert {
+	u.Set(contentview.FieldPeriod, v)
+	return u
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdatePeriod() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldPeriod)
+	return u
+}
+
+// SetBucket sets the "bucket" field.
+func (u *ContentViewUpsert) SetBucket(v time.Time) *ContentViewUpsert {
+	u.Set(contentview.FieldBucket, v)
+	return u
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdateBucket() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldBucket)
+	return u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ContentViewUpsert) SetItemKind(v string) *ContentViewUpsert {
+	u.Set(contentview.FieldItemKind, v)
+	return u
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdateItemKind() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldItemKind)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ContentViewUpsert) SetItemID(v xid.ID) *ContentViewUpsert {
+	u.Set(contentview.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdateItemID() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldItemID)
+	return u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *ContentViewUpsert) SetAuthorID(v xid.ID) *ContentViewUpsert {
+	u.Set(contentview.FieldAuthorID, v)
+	return u
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdateAuthorID() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldAuthorID)
+	return u
+}
+
+// SetViews sets the "views" field.
+func (u *ContentViewUpsert) SetViews(v int) *ContentViewUpsert {
+	u.Set(contentview.FieldViews, v)
+	return u
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdateViews() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldViews)
+	return u
+}
+
+// AddViews adds v to the "views" field.
+func (u *ContentViewUpsert) AddViews(v int) *ContentViewUpsert {
+	u.Add(contentview.FieldViews, v)
+	return u
+}
+
+// SetUniqueViewers sets the "unique_viewers" field.
+func (u *ContentViewUpsert) SetUniqueViewers(v int) *ContentViewUpsert {
+	u.Set(contentview.FieldUniqueViewers, v)
+	return u
+}
+
+// UpdateUniqueViewers sets the "unique_viewers" field to the value that was provided on create.
+func (u *ContentViewUpsert) UpdateUniqueViewers() *ContentViewUpsert {
+	u.SetExcluded(contentview.FieldUniqueViewers)
+	return u
+}
+
+// AddUniqueViewers adds v to the "unique_viewers" field.
+func (u *ContentViewUpsert) AddUniqueViewers(v int) *ContentViewUpsert {
+	u.Add(contentview.FieldUniqueViewers, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ContentView.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contentview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContentViewUpsertOne) UpdateNewValues() *ContentViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(contentview.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(contentview.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContentView.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ContentViewUpsertOne) Ignore() *ContentViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContentViewUpsertOne) DoNothing() *ContentViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContentViewCreate.OnConflict
+// documentation for more info.
+func (u *ContentViewUpsertOne) Update(set func(*ContentViewUpsert)) *ContentViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContentViewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentViewUpsertOne) SetUpdatedAt(v time.Time) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdateUpdatedAt() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *ContentViewUpsertOne) SetPeriod(v string) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdatePeriod() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetBucket sets the "bucket" field.
+func (u *ContentViewUpsertOne) SetBucket(v time.Time) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetBucket(v)
+	})
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdateBucket() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateBucket()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ContentViewUpsertOne) SetItemKind(v string) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdateItemKind() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ContentViewUpsertOne) SetItemID(v xid.ID) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdateItemID() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *ContentViewUpsertOne) SetAuthorID(v xid.ID) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdateAuthorID() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetViews sets the "views" field.
+func (u *ContentViewUpsertOne) SetViews(v int) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetViews(v)
+	})
+}
+
+// AddViews adds v to the "views" field.
+func (u *ContentViewUpsertOne) AddViews(v int) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.AddViews(v)
+	})
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdateViews() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateViews()
+	})
+}
+
+// SetUniqueViewers sets the "unique_viewers" field.
+func (u *ContentViewUpsertOne) SetUniqueViewers(v int) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetUniqueViewers(v)
+	})
+}
+
+// AddUniqueViewers adds v to the "unique_viewers" field.
+func (u *ContentViewUpsertOne) AddUniqueViewers(v int) *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.AddUniqueViewers(v)
+	})
+}
+
+// UpdateUniqueViewers sets the "unique_viewers" field to the value that was provided on create.
+func (u *ContentViewUpsertOne) UpdateUniqueViewers() *ContentViewUpsertOne {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateUniqueViewers()
+	})
+}
+
+// Exec executes the query.
+func (u *ContentViewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContentViewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContentViewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ContentViewUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ContentViewUpsertOne.ID is not supported by MySQL driver. Use ContentViewUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ContentViewUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ContentViewCreateBulk is the builder for creating many ContentView entities in bulk.
+type ContentViewCreateBulk struct {
+	config
+	err      error
+	builders []*ContentViewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ContentView entities in the database.
+func (_c *ContentViewCreateBulk) Save(ctx context.Context) ([]*ContentView, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentView, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentViewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentViewCreateBulk) SaveX(ctx context.Context) []*ContentView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentViewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentViewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContentView.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContentViewUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ContentViewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ContentViewUpsertBulk {
+	_c.conflict = opts
+	return &ContentViewUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContentView.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ContentViewCreateBulk) OnConflictColumns(columns ...string) *ContentViewUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ContentViewUpsertBulk{
+		create: _c,
+	}
+}
+
+// ContentViewUpsertBulk is the builder for "upsert"-ing
+// a bulk of ContentView nodes.
+type ContentViewUpsertBulk struct {
+	create *ContentViewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ContentView.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contentview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContentViewUpsertBulk) UpdateNewValues() *ContentViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(contentview.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(contentview.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContentView.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ContentViewUpsertBulk) Ignore() *ContentViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContentViewUpsertBulk) DoNothing() *ContentViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContentViewCreateBulk.OnConflict
+// documentation for more info.
+func (u *ContentViewUpsertBulk) Update(set func(*ContentViewUpsert)) *ContentViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContentViewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentViewUpsertBulk) SetUpdatedAt(v time.Time) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdateUpdatedAt() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *ContentViewUpsertBulk) SetPeriod(v string) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdatePeriod() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetBucket sets the "bucket" field.
+func (u *ContentViewUpsertBulk) SetBucket(v time.Time) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetBucket(v)
+	})
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdateBucket() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateBucket()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ContentViewUpsertBulk) SetItemKind(v string) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdateItemKind() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ContentViewUpsertBulk) SetItemID(v xid.ID) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdateItemID() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *ContentViewUpsertBulk) SetAuthorID(v xid.ID) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdateAuthorID() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetViews sets the "views" field.
+func (u *ContentViewUpsertBulk) SetViews(v int) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetViews(v)
+	})
+}
+
+// AddViews adds v to the "views" field.
+func (u *ContentViewUpsertBulk) AddViews(v int) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.AddViews(v)
+	})
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdateViews() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateViews()
+	})
+}
+
+// SetUniqueViewers sets the "unique_viewers" field.
+func (u *ContentViewUpsertBulk) SetUniqueViewers(v int) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.SetUniqueViewers(v)
+	})
+}
+
+// AddUniqueViewers adds v to the "unique_viewers" field.
+func (u *ContentViewUpsertBulk) AddUniqueViewers(v int) *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.AddUniqueViewers(v)
+	})
+}
+
+// UpdateUniqueViewers sets the "unique_viewers" field to the value that was provided on create.
+func (u *ContentViewUpsertBulk) UpdateUniqueViewers() *ContentViewUpsertBulk {
+	return u.Update(func(s *ContentViewUpsert) {
+		s.UpdateUniqueViewers()
+	})
+}
+
+// Exec executes the query.
+func (u *ContentViewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ContentViewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContentViewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContentViewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ContentViewUpdate is the builder for updating ContentView entities.
+type ContentViewUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ContentViewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ContentViewUpdate builder.
+func (_u *ContentViewUpdate) Where(ps ...predicate.ContentView) *ContentViewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentViewUpdate) SetUpdatedAt(v time.Time) *ContentViewUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *ContentViewUpdate) SetPeriod(v string) *ContentViewUpdate {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *ContentViewUpdate) SetNillablePeriod(v *string) *ContentViewUpdate {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetBucket sets the "bucket" field.
+func (_u *ContentViewUpdate) SetBucket(v time.Time) *ContentViewUpdate {
+	_u.mutation.SetBucket(v)
+	return _u
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (_u *ContentViewUpdate) SetNillableBucket(v *time.Time) *ContentViewUpdate {
+	if v != nil {
+		_u.SetBucket(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ContentViewUpdate) SetItemKind(v string) *ContentViewUpdate {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ContentViewUpdate) SetNillableItemKind(v *string) *ContentViewUpdate {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ContentViewUpdate) SetItemID(v xid.ID) *ContentViewUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ContentViewUpdate) SetNillableItemID(v *xid.ID) *ContentViewUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *ContentViewUpdate) SetAuthorID(v xid.ID) *ContentViewUpdate {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *ContentViewUpdate) SetNillableAuthorID(v *xid.ID) *ContentViewUpdate {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// SetViews sets the "views" field.
+func (_u *ContentViewUpdate) SetViews(v int) *ContentViewUpdate {
+	_u.mutation.ResetViews()
+	_u.mutation.SetViews(v)
+	return _u
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_u *ContentViewUpdate) SetNillableViews(v *int) *ContentViewUpdate {
+	if v != nil {
+		_u.SetViews(*v)
+	}
+	return _u
+}
+
+// AddViews adds value to the "views" field.
+func (_u *ContentViewUpdate) AddViews(v int) *ContentViewUpdate {
+	_u.mutation.AddViews(v)
+	return _u
+}
+
+// SetUniqueViewers sets the "unique_viewers" field.
+func (_u *ContentViewUpdate) SetUniqueViewers(v int) *ContentViewUpdate {
+	_u.mutation.ResetUniqueViewers()
+	_u.mutation.SetUniqueViewers(v)
+	return _u
+}
+
+// SetNillableUniqueViewers sets the "unique_viewers" field if the given value is not nil.
+func (_u *ContentViewUpdate) SetNillableUniqueViewers(v *int) *ContentViewUpdate {
+	if v != nil {
+		_u.SetUniqueViewers(*v)
+	}
+	return _u
+}
+
+// AddUniqueViewers adds value to the "unique_viewers" field.
+func (_u *ContentViewUpdate) AddUniqueViewers(v int) *ContentViewUpdate {
+	_u.mutation.AddUniqueViewers(v)
+	return _u
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_u *ContentViewUpdate) SetAuthor(v *Account) *ContentViewUpdate {
+	return _u.SetAuthorID(v.ID)
+}
+
+// Mutation returns the ContentViewMutation object of the builder.
+func (_u *ContentViewUpdate) Mutation() *ContentViewMutation {
+	return _u.mutation
+}
+
+// ClearAuthor clears the "author" edge to the Account entity.
+func (_u *ContentViewUpdate) ClearAuthor() *ContentViewUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContentViewUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContentViewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentViewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentViewUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := contentview.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContentViewUpdate) check() error {
+	if _u.mutation.AuthorCleared() && len(_u.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentView.author"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContentViewUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContentViewUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContentViewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentview.Table, contentview.Columns, sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(contentview.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bucket(); ok {
+		_spec.SetField(contentview.FieldBucket, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(contentview.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(contentview.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Views(); ok {
+		_spec.SetField(contentview.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViews(); ok {
+		_spec.AddField(contentview.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UniqueViewers(); ok {
+		_spec.SetField(contentview.FieldUniqueViewers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUniqueViewers(); ok {
+		_spec.AddField(contentview.FieldUniqueViewers, field.TypeInt, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentview.AuthorTable,
+			Columns: []string{contentview.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentview.AuthorTable,
+			Columns: []string{contentview.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContentViewUpdateOne is the builder for updating a single ContentView entity.
+type ContentViewUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ContentViewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentViewUpdateOne) SetUpdatedAt(v time.Time) *ContentViewUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *ContentViewUpdateOne) SetPeriod(v string) *ContentViewUpdateOne {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *ContentViewUpdateOne) SetNillablePeriod(v *string) *ContentViewUpdateOne {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetBucket sets the "bucket" field.
+func (_u *ContentViewUpdateOne) SetBucket(v time.Time) *ContentViewUpdateOne {
+	_u.mutation.SetBucket(v)
+	return _u
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (_u *ContentViewUpdateOne) SetNillableBucket(v *time.Time) *ContentViewUpdateOne {
+	if v != nil {
+		_u.SetBucket(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ContentViewUpdateOne) SetItemKind(v string) *ContentViewUpdateOne {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ContentViewUpdateOne) SetNillableItemKind(v *string) *ContentViewUpdateOne {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ContentViewUpdateOne) SetItemID(v xid.ID) *ContentViewUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ContentViewUpdateOne) SetNillableItemID(v *xid.ID) *ContentViewUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *ContentViewUpdateOne) SetAuthorID(v xid.ID) *ContentViewUpdateOne {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *ContentViewUpdateOne) SetNillableAuthorID(v *xid.ID) *ContentViewUpdateOne {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// SetViews sets the "views" field.
+func (_u *ContentViewUpdateOne) SetViews(v int) *ContentViewUpdateOne {
+	_u.mutation.ResetViews()
+	_u.mutation.SetViews(v)
+	return _u
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_u *ContentViewUpdateOne) SetNillableViews(v *int) *ContentViewUpdateOne {
+	if v != nil {
+		_u.SetViews(*v)
+	}
+	return _u
+}
+
+// AddViews adds value to the "views" field.
+func (_u *ContentViewUpdateOne) AddViews(v int) *ContentViewUpdateOne {
+	_u.mutation.AddViews(v)
+	return _u
+}
+
+// SetUniqueViewers sets the "unique_viewers" field.
+func (_u *ContentViewUpdateOne) SetUniqueViewers(v int) *ContentViewUpdateOne {
+	_u.mutation.ResetUniqueViewers()
+	_u.mutation.SetUniqueViewers(v)
+	return _u
+}
+
+// SetNillableUniqueViewers sets the "unique_viewers" field if the given value is not nil.
+func (_u *ContentViewUpdateOne) SetNillableUniqueViewers(v *int) *ContentViewUpdateOne {
+	if v != nil {
+		_u.SetUniqueViewers(*v)
+	}
+	return _u
+}
+
+// AddUniqueViewers adds value to the "unique_viewers" field.
+func (_u *ContentViewUpdateOne) AddUniqueViewers(v int) *ContentViewUpdateOne {
+	_u.mutation.AddUniqueViewers(v)
+	return _u
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_u *ContentViewUpdateOne) SetAuthor(v *Account) *ContentViewUpdateOne {
+	return _u.SetAuthorID(v.ID)
+}
+
+// Mutation returns the ContentViewMutation object of the builder.
+func (_u *ContentViewUpdateOne) Mutation() *ContentViewMutation {
+	return _u.mutation
+}
+
+// ClearAuthor clears the "author" edge to the Account entity.
+func (_u *ContentViewUpdateOne) ClearAuthor() *ContentViewUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// Where appends a list predicates to the ContentViewUpdate builder.
+func (_u *ContentViewUpdateOne) Where(ps ...predicate.ContentView) *ContentViewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContentViewUpdateOne) Select(field string, fields ...string) *ContentViewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContentView entity.
+func (_u *ContentViewUpdateOne) Save(ctx context.Context) (*ContentView, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentViewUpdateOne) SaveX(ctx context.Context) *ContentView {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContentViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentViewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentViewUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := contentview.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContentViewUpdateOne) check() error {
+	if _u.mutation.AuthorCleared() && len(_u.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentView.author"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContentViewUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContentViewUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContentViewUpdateOne) sqlSave(ctx context.Context) (_node *ContentView, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentview.Table, contentview.Columns, sqlgraph.NewFieldSpec(contentview.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentview.FieldID)
+		for _, f := range fields {
+			if !contentview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(contentview.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bucket(); ok {
+		_spec.SetField(contentview.FieldBucket, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(contentview.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(contentview.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Views(); ok {
+		_spec.SetField(contentview.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViews(); ok {
+		_spec.AddField(contentview.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UniqueViewers(); ok {
+		_spec.SetField(contentview.FieldUniqueViewers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUniqueViewers(); ok {
+		_spec.AddField(contentview.FieldUniqueViewers, field.TypeInt, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentview.AuthorTable,
+			Columns: []string{contentview.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentview.AuthorTable,
+			Columns: []string{contentview.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ContentView{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
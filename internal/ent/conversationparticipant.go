@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/rs/xid"
+)
+
+// ConversationParticipant is the model entity for the ConversationParticipant schema.
+type ConversationParticipant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID xid.ID `json:"conversation_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// Messages sent after this are unread by the participant.
+	LastReadAt *time.Time `json:"last_read_at,omitempty"`
+	// Participants who leave can no longer see the conversation and are sent no more messages.
+	LeftAt *time.Time `json:"left_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConversationParticipantQuery when eager-loading is set.
+	Edges        ConversationParticipantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConversationParticipantEdges holds the relations/edges for other nodes in the graph.
+type ConversationParticipantEdges struct {
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConversationParticipantEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConversationParticipantEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConversationParticipant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversationparticipant.FieldCreatedAt, conversationparticipant.FieldLastReadAt, conversationparticipant.FieldLeftAt:
+			values[i] = new(sql.NullTime)
+		case conversationparticipant.FieldID, conversationparticipant.FieldConversationID, conversationparticipant.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConversationParticipant fields.
+func (_m *ConversationParticipant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversationparticipant.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case conversationparticipant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case conversationparticipant.FieldConversationID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value != nil {
+				_m.ConversationID = *value
+			}
+		case conversationparticipant.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case conversationparticipant.FieldLastReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_read_at", values[i])
+			} else if value.Valid {
+				_m.LastReadAt = new(time.Time)
+				*_m.LastReadAt = value.Time
+			}
+		case conversationparticipant.FieldLeftAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field left_at", values[i])
+			} else if value.Valid {
+				_m.LeftAt = new(time.Time)
+				*_m.LeftAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConversationParticipant.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConversationParticipant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConversation queries the "conversation" edge of the ConversationParticipant entity.
+func (_m *ConversationParticipant) QueryConversation() *ConversationQuery {
+	return NewConversationParticipantClient(_m.config).QueryConversation(_m)
+}
+
+// QueryAccount queries the "account" edge of the ConversationParticipant entity.
+func (_m *ConversationParticipant) QueryAccount() *AccountQuery {
+	return NewConversationParticipantClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this ConversationParticipant.
+// Note that you need to call ConversationParticipant.Unwrap() before calling this method if this ConversationParticipant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConversationParticipant) Update() *ConversationParticipantUpdateOne {
+	return NewConversationParticipantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConversationParticipant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConversationParticipant) Unwrap() *ConversationParticipant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConversationParticipant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConversationParticipant) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConversationParticipant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConversationID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.LastReadAt; v != nil {
+		builder.WriteString("last_read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LeftAt; v != nil {
+		builder.WriteString("left_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConversationParticipants is a parsable slice of ConversationParticipant.
+type ConversationParticipants []*ConversationParticipant
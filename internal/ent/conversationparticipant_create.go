@@ -0,0 +1,798 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/rs/xid"
+)
+
+// ConversationParticipantCreate is the builder for creating a ConversationParticipant entity.
+type ConversationParticipantCreate struct {
+	config
+	mutation *ConversationParticipantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationParticipantCreate) SetCreatedAt(v time.Time) *ConversationParticipantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationParticipantCreate) SetNillableCreatedAt(v *time.Time) *ConversationParticipantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *ConversationParticipantCreate) SetConversationID(v xid.ID) *ConversationParticipantCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ConversationParticipantCreate) SetAccountID(v xid.ID) *ConversationParticipantCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (_c *ConversationParticipantCreate) SetLastReadAt(v time.Time) *ConversationParticipantCreate {
+	_c.mutation.SetLastReadAt(v)
+	return _c
+}
+
+// SetNillableLastReadAt sets the "last_read_at" field if the given value is not nil.
+func (_c *ConversationParticipantCreate) SetNillableLastReadAt(v *time.Time) *ConversationParticipantCreate {
+	if v != nil {
+		_c.SetLastReadAt(*v)
+	}
+	return _c
+}
+
+// SetLeftAt sets the "left_at" field.
+func (_c *ConversationParticipantCreate) SetLeftAt(v time.Time) *ConversationParticipantCreate {
+	_c.mutation.SetLeftAt(v)
+	return _c
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (_c *ConversationParticipantCreate) SetNillableLeftAt(v *time.Time) *ConversationParticipantCreate {
+	if v != nil {
+		_c.SetLeftAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationParticipantCreate) SetID(v xid.ID) *ConversationParticipantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ConversationParticipantCreate) SetNillableID(v *xid.ID) *ConversationParticipantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_c *ConversationParticipantCreate) SetConversation(v *Conversation) *ConversationParticipantCreate {
+	return _c.SetConversationID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ConversationParticipantCreate) SetAccount(v *Account) *ConversationParticipantCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the ConversationParticipantMutation object of the builder.
+func (_c *ConversationParticipantCreate) Mutation() *ConversationParticipantMutation {
+	return _c.mutation
+}
+
+// Save creates the ConversationParticipant in the database.
+func (_c *ConversationParticipantCreate) Save(ctx context.Context) (*ConversationParticipant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationParticipantCreate) SaveX(ctx context.Context) *ConversationParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationParticipantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationParticipantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationParticipantCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := conversationparticipant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := conversationparticipant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationParticipantCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConversationParticipant.created_at"`)}
+	}
+	if _, ok := _c.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "ConversationParticipant.conversation_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ConversationParticipant.account_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := conversationparticipant.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "ConversationParticipant.conversation"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "ConversationParticipant.account"`)}
+	}
+	return nil
+}
+
+func (_c *ConversationParticipantCreate) sqlSave(ctx context.Context) (*ConversationParticipant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationParticipantCreate) createSpec() (*ConversationParticipant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationParticipant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversationparticipant.Table, sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationparticipant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastReadAt(); ok {
+		_spec.SetField(conversationparticipant.FieldLastReadAt, field.TypeTime, value)
+		_node.LastReadAt = &value
+	}
+	if value, ok := _c.mutation.LeftAt(); ok {
+		_spec.SetField(conversationparticipant.FieldLeftAt, field.TypeTime, value)
+		_node.LeftAt = &value
+	}
+	if nodes := _c.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.ConversationTable,
+			Columns: []string{conversationparticipant.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.AccountTable,
+			Columns: []string{conversationparticipant.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConversationParticipant.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationParticipantUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationParticipantCreate) OnConflict(opts ...sql.ConflictOption) *ConversationParticipantUpsertOne {
+	_c.conflict = opts
+	return &ConversationParticipantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConversationParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationParticipantCreate) OnConflictColumns(columns ...string) *ConversationParticipantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationParticipantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ConversationParticipantUpsertOne is the builder for "upsert"-ing
+	//  one ConversationParticipant node.
+	ConversationParticipantUpsertOne struct {
+		create *ConversationParticipantCreate
+	}
+
+	// ConversationParticipantUpsert is the "OnConflict" setter.
+	ConversationParticipantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationParticipantUpsert) SetConversationID(v xid.ID) *ConversationParticipantUpsert {
+	u.Set(conversationparticipant.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationParticipantUpsert) UpdateConversationID() *ConversationParticipantUpsert {
+	u.SetExcluded(conversationparticipant.FieldConversationID)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ConversationParticipantUpsert) SetAccountID(v xid.ID) *ConversationParticipantUpsert {
+	u.Set(conversationparticipant.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ConversationParticipantUpsert) UpdateAccountID() *ConversationParticipantUpsert {
+	u.SetExcluded(conversationparticipant.FieldAccountID)
+	return u
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (u *ConversationParticipantUpsert) SetLastReadAt(v time.Time) *ConversationParticipantUpsert {
+	u.Set(conversationparticipant.FieldLastReadAt, v)
+	return u
+}
+
+// UpdateLastReadAt sets the "last_read_at" field to the value that was provided on create.
+func (u *ConversationParticipantUpsert) UpdateLastReadAt() *ConversationParticipantUpsert {
+	u.SetExcluded(conversationparticipant.FieldLastReadAt)
+	return u
+}
+
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (u *ConversationParticipantUpsert) ClearLastReadAt() *ConversationParticipantUpsert {
+	u.SetNull(conversationparticipant.FieldLastReadAt)
+	return u
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *ConversationParticipantUpsert) SetLeftAt(v time.Time) *ConversationParticipantUpsert {
+	u.Set(conversationparticipant.FieldLeftAt, v)
+	return u
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *ConversationParticipantUpsert) UpdateLeftAt() *ConversationParticipantUpsert {
+	u.SetExcluded(conversationparticipant.FieldLeftAt)
+	return u
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *ConversationParticipantUpsert) ClearLeftAt() *ConversationParticipantUpsert {
+	u.SetNull(conversationparticipant.FieldLeftAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ConversationParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversationparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationParticipantUpsertOne) UpdateNewValues() *ConversationParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(conversationparticipant.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(conversationparticipant.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConversationParticipant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConversationParticipantUpsertOne) Ignore() *ConversationParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationParticipantUpsertOne) DoNothing() *ConversationParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationParticipantCreate.OnConflict
+// documentation for more info.
+func (u *ConversationParticipantUpsertOne) Update(set func(*ConversationParticipantUpsert)) *ConversationParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationParticipantUpsertOne) SetConversationID(v xid.ID) *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertOne) UpdateConversationID() *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ConversationParticipantUpsertOne) SetAccountID(v xid.ID) *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertOne) UpdateAccountID() *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (u *ConversationParticipantUpsertOne) SetLastReadAt(v time.Time) *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetLastReadAt(v)
+	})
+}
+
+// UpdateLastReadAt sets the "last_read_at" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertOne) UpdateLastReadAt() *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateLastReadAt()
+	})
+}
+
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (u *ConversationParticipantUpsertOne) ClearLastReadAt() *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.ClearLastReadAt()
+	})
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *ConversationParticipantUpsertOne) SetLeftAt(v time.Time) *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetLeftAt(v)
+	})
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertOne) UpdateLeftAt() *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateLeftAt()
+	})
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *ConversationParticipantUpsertOne) ClearLeftAt() *ConversationParticipantUpsertOne {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.ClearLeftAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationParticipantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationParticipantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationParticipantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConversationParticipantUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ConversationParticipantUpsertOne.ID is not supported by MySQL driver. Use ConversationParticipantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConversationParticipantUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConversationParticipantCreateBulk is the builder for creating many ConversationParticipant entities in bulk.
+type ConversationParticipantCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationParticipantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ConversationParticipant entities in the database.
+func (_c *ConversationParticipantCreateBulk) Save(ctx context.Context) ([]*ConversationParticipant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConversationParticipant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationParticipantCreateBulk) SaveX(ctx context.Context) []*ConversationParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationParticipantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationParticipantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConversationParticipant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationParticipantUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationParticipantCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConversationParticipantUpsertBulk {
+	_c.conflict = opts
+	return &ConversationParticipantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConversationParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationParticipantCreateBulk) OnConflictColumns(columns ...string) *ConversationParticipantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationParticipantUpsertBulk{
+		create: _c,
+	}
+}
+
+// ConversationParticipantUpsertBulk is the builder for "upsert"-ing
+// a bulk of ConversationParticipant nodes.
+type ConversationParticipantUpsertBulk struct {
+	create *ConversationParticipantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ConversationParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversationparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationParticipantUpsertBulk) UpdateNewValues() *ConversationParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(conversationparticipant.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(conversationparticipant.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConversationParticipant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConversationParticipantUpsertBulk) Ignore() *ConversationParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationParticipantUpsertBulk) DoNothing() *ConversationParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationParticipantCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConversationParticipantUpsertBulk) Update(set func(*ConversationParticipantUpsert)) *ConversationParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationParticipantUpsertBulk) SetConversationID(v xid.ID) *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertBulk) UpdateConversationID() *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ConversationParticipantUpsertBulk) SetAccountID(v xid.ID) *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertBulk) UpdateAccountID() *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (u *ConversationParticipantUpsertBulk) SetLastReadAt(v time.Time) *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetLastReadAt(v)
+	})
+}
+
+// UpdateLastReadAt sets the "last_read_at" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertBulk) UpdateLastReadAt() *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateLastReadAt()
+	})
+}
+
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (u *ConversationParticipantUpsertBulk) ClearLastReadAt() *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.ClearLastReadAt()
+	})
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *ConversationParticipantUpsertBulk) SetLeftAt(v time.Time) *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.SetLeftAt(v)
+	})
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *ConversationParticipantUpsertBulk) UpdateLeftAt() *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.UpdateLeftAt()
+	})
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *ConversationParticipantUpsertBulk) ClearLeftAt() *ConversationParticipantUpsertBulk {
+	return u.Update(func(s *ConversationParticipantUpsert) {
+		s.ClearLeftAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationParticipantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConversationParticipantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationParticipantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationParticipantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
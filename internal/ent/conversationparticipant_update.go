@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ConversationParticipantUpdate is the builder for updating ConversationParticipant entities.
+type ConversationParticipantUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ConversationParticipantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ConversationParticipantUpdate builder.
+func (_u *ConversationParticipantUpdate) Where(ps ...predicate.ConversationParticipant) *ConversationParticipantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *ConversationParticipantUpdate) SetConversationID(v xid.ID) *ConversationParticipantUpdate {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *ConversationParticipantUpdate) SetNillableConversationID(v *xid.ID) *ConversationParticipantUpdate {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ConversationParticipantUpdate) SetAccountID(v xid.ID) *ConversationParticipantUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ConversationParticipantUpdate) SetNillableAccountID(v *xid.ID) *ConversationParticipantUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (_u *ConversationParticipantUpdate) SetLastReadAt(v time.Time) *ConversationParticipantUpdate {
+	_u.mutation.SetLastReadAt(v)
+	return _u
+}
+
+// SetNillableLastReadAt sets the "last_read_at" field if the given value is not nil.
+func (_u *ConversationParticipantUpdate) SetNillableLastReadAt(v *time.Time) *ConversationParticipantUpdate {
+	if v != nil {
+		_u.SetLastReadAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (_u *ConversationParticipantUpdate) ClearLastReadAt() *ConversationParticipantUpdate {
+	_u.mutation.ClearLastReadAt()
+	return _u
+}
+
+// SetLeftAt sets the "left_at" field.
+func (_u *ConversationParticipantUpdate) SetLeftAt(v time.Time) *ConversationParticipantUpdate {
+	_u.mutation.SetLeftAt(v)
+	return _u
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (_u *ConversationParticipantUpdate) SetNillableLeftAt(v *time.Time) *ConversationParticipantUpdate {
+	if v != nil {
+		_u.SetLeftAt(*v)
+	}
+	return _u
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (_u *ConversationParticipantUpdate) ClearLeftAt() *ConversationParticipantUpdate {
+	_u.mutation.ClearLeftAt()
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_u *ConversationParticipantUpdate) SetConversation(v *Conversation) *ConversationParticipantUpdate {
+	return _u.SetConversationID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ConversationParticipantUpdate) SetAccount(v *Account) *ConversationParticipantUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ConversationParticipantMutation object of the builder.
+func (_u *ConversationParticipantUpdate) Mutation() *ConversationParticipantMutation {
+	return _u.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (_u *ConversationParticipantUpdate) ClearConversation() *ConversationParticipantUpdate {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ConversationParticipantUpdate) ClearAccount() *ConversationParticipantUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConversationParticipantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationParticipantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConversationParticipantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationParticipantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationParticipantUpdate) check() error {
+	if _u.mutation.ConversationCleared() && len(_u.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationParticipant.conversation"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationParticipant.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConversationParticipantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConversationParticipantUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConversationParticipantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationparticipant.Table, conversationparticipant.Columns, sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastReadAt(); ok {
+		_spec.SetField(conversationparticipant.FieldLastReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReadAtCleared() {
+		_spec.ClearField(conversationparticipant.FieldLastReadAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LeftAt(); ok {
+		_spec.SetField(conversationparticipant.FieldLeftAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeftAtCleared() {
+		_spec.ClearField(conversationparticipant.FieldLeftAt, field.TypeTime)
+	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.ConversationTable,
+			Columns: []string{conversationparticipant.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.ConversationTable,
+			Columns: []string{conversationparticipant.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.AccountTable,
+			Columns: []string{conversationparticipant.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.AccountTable,
+			Columns: []string{conversationparticipant.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConversationParticipantUpdateOne is the builder for updating a single ConversationParticipant entity.
+type ConversationParticipantUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ConversationParticipantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *ConversationParticipantUpdateOne) SetConversationID(v xid.ID) *ConversationParticipantUpdateOne {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *ConversationParticipantUpdateOne) SetNillableConversationID(v *xid.ID) *ConversationParticipantUpdateOne {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ConversationParticipantUpdateOne) SetAccountID(v xid.ID) *ConversationParticipantUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ConversationParticipantUpdateOne) SetNillableAccountID(v *xid.ID) *ConversationParticipantUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (_u *ConversationParticipantUpdateOne) SetLastReadAt(v time.Time) *ConversationParticipantUpdateOne {
+	_u.mutation.SetLastReadAt(v)
+	return _u
+}
+
+// SetNillableLastReadAt sets the "last_read_at" field if the given value is not nil.
+func (_u *ConversationParticipantUpdateOne) SetNillableLastReadAt(v *time.Time) *ConversationParticipantUpdateOne {
+	if v != nil {
+		_u.SetLastReadAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (_u *ConversationParticipantUpdateOne) ClearLastReadAt() *ConversationParticipantUpdateOne {
+	_u.mutation.ClearLastReadAt()
+	return _u
+}
+
+// SetLeftAt sets the "left_at" field.
+func (_u *ConversationParticipantUpdateOne) SetLeftAt(v time.Time) *ConversationParticipantUpdateOne {
+	_u.mutation.SetLeftAt(v)
+	return _u
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (_u *ConversationParticipantUpdateOne) SetNillableLeftAt(v *time.Time) *ConversationParticipantUpdateOne {
+	if v != nil {
+		_u.SetLeftAt(*v)
+	}
+	return _u
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (_u *ConversationParticipantUpdateOne) ClearLeftAt() *ConversationParticipantUpdateOne {
+	_u.mutation.ClearLeftAt()
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_u *ConversationParticipantUpdateOne) SetConversation(v *Conversation) *ConversationParticipantUpdateOne {
+	return _u.SetConversationID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ConversationParticipantUpdateOne) SetAccount(v *Account) *ConversationParticipantUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ConversationParticipantMutation object of the builder.
+func (_u *ConversationParticipantUpdateOne) Mutation() *ConversationParticipantMutation {
+	return _u.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (_u *ConversationParticipantUpdateOne) ClearConversation() *ConversationParticipantUpdateOne {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ConversationParticipantUpdateOne) ClearAccount() *ConversationParticipantUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the ConversationParticipantUpdate builder.
+func (_u *ConversationParticipantUpdateOne) Where(ps ...predicate.ConversationParticipant) *ConversationParticipantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConversationParticipantUpdateOne) Select(field string, fields ...string) *ConversationParticipantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConversationParticipant entity.
+func (_u *ConversationParticipantUpdateOne) Save(ctx context.Context) (*ConversationParticipant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationParticipantUpdateOne) SaveX(ctx context.Context) *ConversationParticipant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConversationParticipantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationParticipantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationParticipantUpdateOne) check() error {
+	if _u.mutation.ConversationCleared() && len(_u.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationParticipant.conversation"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationParticipant.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConversationParticipantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConversationParticipantUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConversationParticipantUpdateOne) sqlSave(ctx context.Context) (_node *ConversationParticipant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationparticipant.Table, conversationparticipant.Columns, sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConversationParticipant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationparticipant.FieldID)
+		for _, f := range fields {
+			if !conversationparticipant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversationparticipant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastReadAt(); ok {
+		_spec.SetField(conversationparticipant.FieldLastReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReadAtCleared() {
+		_spec.ClearField(conversationparticipant.FieldLastReadAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LeftAt(); ok {
+		_spec.SetField(conversationparticipant.FieldLeftAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeftAtCleared() {
+		_spec.ClearField(conversationparticipant.FieldLeftAt, field.TypeTime)
+	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.ConversationTable,
+			Columns: []string{conversationparticipant.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.ConversationTable,
+			Columns: []string{conversationparticipant.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.AccountTable,
+			Columns: []string{conversationparticipant.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationparticipant.AccountTable,
+			Columns: []string{conversationparticipant.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ConversationParticipant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/rs/xid"
+)
+
+// DigestSubscription is the model entity for the DigestSubscription schema.
+type DigestSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// Frequency holds the value of the "frequency" field.
+	Frequency string `json:"frequency,omitempty"`
+	// LastSentAt holds the value of the "last_sent_at" field.
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DigestSubscriptionQuery when eager-loading is set.
+	Edges        DigestSubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DigestSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type DigestSubscriptionEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DigestSubscriptionEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DigestSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case digestsubscription.FieldFrequency:
+			values[i] = new(sql.NullString)
+		case digestsubscription.FieldCreatedAt, digestsubscription.FieldUpdatedAt, digestsubscription.FieldLastSentAt:
+			values[i] = new(sql.NullTime)
+		case digestsubscription.FieldID, digestsubscription.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DigestSubscription fields.
+func (_m *DigestSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case digestsubscription.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case digestsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case digestsubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case digestsubscription.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case digestsubscription.FieldFrequency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field frequency", values[i])
+			} else if value.Valid {
+				_m.Frequency = value.String
+			}
+		case digestsubscription.FieldLastSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sent_at", values[i])
+			} else if value.Valid {
+				_m.LastSentAt = new(time.Time)
+				*_m.LastSentAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DigestSubscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *DigestSubscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the DigestSubscription entity.
+func (_m *DigestSubscription) QueryAccount() *AccountQuery {
+	return NewDigestSubscriptionClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this DigestSubscription.
+// Note that you need to call DigestSubscription.Unwrap() before calling this method if this DigestSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DigestSubscription) Update() *DigestSubscriptionUpdateOne {
+	return NewDigestSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DigestSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DigestSubscription) Unwrap() *DigestSubscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DigestSubscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DigestSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("DigestSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("frequency=")
+	builder.WriteString(_m.Frequency)
+	builder.WriteString(", ")
+	if v := _m.LastSentAt; v != nil {
+		builder.WriteString("last_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DigestSubscriptions is a parsable slice of DigestSubscription.
+type DigestSubscriptions []*DigestSubscription
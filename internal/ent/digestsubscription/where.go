@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package digestsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Frequency applies equality check predicate on the "frequency" field. It's identical to FrequencyEQ.
+func Frequency(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldFrequency, v))
+}
+
+// LastSentAt applies equality check predicate on the "last_sent_at" field. It's identical to LastSentAtEQ.
+func LastSentAt(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldLastSentAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.DigestSubscription {
+	vc := v.String()
+	return predicate.DigestSubscription(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.DigestSubscription {
+	vc := v.String()
+	return predicate.DigestSubscription(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.DigestSubscription {
+	vc := v.String()
+	return predicate.DigestSubscription(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.DigestSubscription {
+	vc := v.String()
+	return predicate.DigestSubscription(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.DigestSubscription {
+	vc := v.String()
+	return predicate.DigestSubscription(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// FrequencyEQ applies the EQ predicate on the "frequency" field.
+func FrequencyEQ(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldFrequency, v))
+}
+
+// FrequencyNEQ applies the NEQ predicate on the "frequency" field.
+func FrequencyNEQ(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNEQ(FieldFrequency, v))
+}
+
+// FrequencyIn applies the In predicate on the "frequency" field.
+func FrequencyIn(vs ...string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldIn(FieldFrequency, vs...))
+}
+
+// FrequencyNotIn applies the NotIn predicate on the "frequency" field.
+func FrequencyNotIn(vs ...string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNotIn(FieldFrequency, vs...))
+}
+
+// FrequencyGT applies the GT predicate on the "frequency" field.
+func FrequencyGT(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGT(FieldFrequency, v))
+}
+
+// FrequencyGTE applies the GTE predicate on the "frequency" field.
+func FrequencyGTE(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGTE(FieldFrequency, v))
+}
+
+// FrequencyLT applies the LT predicate on the "frequency" field.
+func FrequencyLT(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLT(FieldFrequency, v))
+}
+
+// FrequencyLTE applies the LTE predicate on the "frequency" field.
+func FrequencyLTE(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLTE(FieldFrequency, v))
+}
+
+// FrequencyContains applies the Contains predicate on the "frequency" field.
+func FrequencyContains(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldContains(FieldFrequency, v))
+}
+
+// FrequencyHasPrefix applies the HasPrefix predicate on the "frequency" field.
+func FrequencyHasPrefix(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldHasPrefix(FieldFrequency, v))
+}
+
+// FrequencyHasSuffix applies the HasSuffix predicate on the "frequency" field.
+func FrequencyHasSuffix(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldHasSuffix(FieldFrequency, v))
+}
+
+// FrequencyEqualFold applies the EqualFold predicate on the "frequency" field.
+func FrequencyEqualFold(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEqualFold(FieldFrequency, v))
+}
+
+// FrequencyContainsFold applies the ContainsFold predicate on the "frequency" field.
+func FrequencyContainsFold(v string) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldContainsFold(FieldFrequency, v))
+}
+
+// LastSentAtEQ applies the EQ predicate on the "last_sent_at" field.
+func LastSentAtEQ(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldEQ(FieldLastSentAt, v))
+}
+
+// LastSentAtNEQ applies the NEQ predicate on the "last_sent_at" field.
+func LastSentAtNEQ(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNEQ(FieldLastSentAt, v))
+}
+
+// LastSentAtIn applies the In predicate on the "last_sent_at" field.
+func LastSentAtIn(vs ...time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldIn(FieldLastSentAt, vs...))
+}
+
+// LastSentAtNotIn applies the NotIn predicate on the "last_sent_at" field.
+func LastSentAtNotIn(vs ...time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNotIn(FieldLastSentAt, vs...))
+}
+
+// LastSentAtGT applies the GT predicate on the "last_sent_at" field.
+func LastSentAtGT(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGT(FieldLastSentAt, v))
+}
+
+// LastSentAtGTE applies the GTE predicate on the "last_sent_at" field.
+func LastSentAtGTE(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldGTE(FieldLastSentAt, v))
+}
+
+// LastSentAtLT applies the LT predicate on the "last_sent_at" field.
+func LastSentAtLT(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLT(FieldLastSentAt, v))
+}
+
+// LastSentAtLTE applies the LTE predicate on the "last_sent_at" field.
+func LastSentAtLTE(v time.Time) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldLTE(FieldLastSentAt, v))
+}
+
+// LastSentAtIsNil applies the IsNil predicate on the "last_sent_at" field.
+func LastSentAtIsNil() predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldIsNull(FieldLastSentAt))
+}
+
+// LastSentAtNotNil applies the NotNil predicate on the "last_sent_at" field.
+func LastSentAtNotNil() predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.FieldNotNull(FieldLastSentAt))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.DigestSubscription {
+	return predicate.DigestSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.DigestSubscription {
+	return predicate.DigestSubscription(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DigestSubscription) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DigestSubscription) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DigestSubscription) predicate.DigestSubscription {
+	return predicate.DigestSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/rs/xid"
+)
+
+// DigestSubscriptionCreate is the builder for creating a DigestSubscription entity.
+type DigestSubscriptionCreate struct {
+	config
+	mutation *DigestSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DigestSubscriptionCreate) SetCreatedAt(v time.Time) *DigestSubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DigestSubscriptionCreate) SetNillableCreatedAt(v *time.Time) *DigestSubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DigestSubscriptionCreate) SetUpdatedAt(v time.Time) *DigestSubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DigestSubscriptionCreate) SetNillableUpdatedAt(v *time.Time) *DigestSubscriptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *DigestSubscriptionCreate) SetAccountID(v xid.ID) *DigestSubscriptionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetFrequency sets the "frequency" field.
+func (_c *DigestSubscriptionCreate) SetFrequency(v string) *DigestSubscriptionCreate {
+	_c.mutation.SetFrequency(v)
+	return _c
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_c *DigestSubscriptionCreate) SetLastSentAt(v time.Time) *DigestSubscriptionCreate {
+	_c.mutation.SetLastSentAt(v)
+	return _c
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_c *DigestSubscriptionCreate) SetNillableLastSentAt(v *time.Time) *DigestSubscriptionCreate {
+	if v != nil {
+		_c.SetLastSentAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DigestSubscriptionCreate) SetID(v xid.ID) *DigestSubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DigestSubscriptionCreate) SetNillableID(v *xid.ID) *DigestSubscriptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *DigestSubscriptionCreate) SetAccount(v *Account) *DigestSubscriptionCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the DigestSubscriptionMutation object of the builder.
+func (_c *DigestSubscriptionCreate) Mutation() *DigestSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the DigestSubscription in the database.
+func (_c *DigestSubscriptionCreate) Save(ctx context.Context) (*DigestSubscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DigestSubscriptionCreate) SaveX(ctx context.Context) *DigestSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DigestSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DigestSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DigestSubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := digestsubscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := digestsubscription.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := digestsubscription.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DigestSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DigestSubscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DigestSubscription.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "DigestSubscription.account_id"`)}
+	}
+	if _, ok := _c.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "DigestSubscription.frequency"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := digestsubscription.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DigestSubscription.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "DigestSubscription.account"`)}
+	}
+	return nil
+}
+
+func (_c *DigestSubscriptionCreate) sqlSave(ctx context.Context) (*DigestSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DigestSubscriptionCreate) createSpec() (*DigestSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DigestSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(digestsubscription.Table, sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(digestsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(digestsubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Frequency(); ok {
+		_spec.SetField(digestsubscription.FieldFrequency, field.TypeString, value)
+		_node.Frequency = value
+	}
+	if value, ok := _c.mutation.LastSentAt(); ok {
+		_spec.SetField(digestsubscription.FieldLastSentAt, field.TypeTime, value)
+		_node.LastSentAt = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   digestsubscription.AccountTable,
+			Columns: []string{digestsubscription.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DigestSubscription.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DigestSubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DigestSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *DigestSubscriptionUpsertOne {
+	_c.conflict = opts
+	return &DigestSubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DigestSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DigestSubscriptionCreate) OnConflictColumns(columns ...string) *DigestSubscriptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DigestSubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DigestSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one DigestSubscription node.
+	DigestSubscriptionUpsertOne struct {
+		create *DigestSubscriptionCreate
+	}
+
+	// DigestSubscriptionUpsert is the "OnConflict" setter.
+	DigestSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DigestSubscriptionUpsert) SetUpdatedAt(v time.Time) *DigestSubscriptionUpsert {
+	u.Set(digestsubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsert) UpdateUpdatedAt() *DigestSubscriptionUpsert {
+	u.SetExcluded(digestsubscription.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *DigestSubscriptionUpsert) SetAccountID(v xid.ID) *DigestSubscriptionUpsert {
+	u.Set(digestsubscription.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsert) UpdateAccountID() *DigestSubscriptionUpsert {
+	u.SetExcluded(digestsubscription.FieldAccountID)
+	return u
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *DigestSubscriptionUpsert) SetFrequency(v string) *DigestSubscriptionUpsert {
+	u.Set(digestsubscription.FieldFrequency, v)
+	return u
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsert) UpdateFrequency() *DigestSubscriptionUpsert {
+	u.SetExcluded(digestsubscription.FieldFrequency)
+	return u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *DigestSubscriptionUpsert) SetLastSentAt(v time.Time) *DigestSubscriptionUpsert {
+	u.Set(digestsubscription.FieldLastSentAt, v)
+	return u
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsert) UpdateLastSentAt() *DigestSubscriptionUpsert {
+	u.SetExcluded(digestsubscription.FieldLastSentAt)
+	return u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *DigestSubscriptionUpsert) ClearLastSentAt() *DigestSubscriptionUpsert {
+	u.SetNull(digestsubscription.FieldLastSentAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DigestSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(digestsubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DigestSubscriptionUpsertOne) UpdateNewValues() *DigestSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(digestsubscription.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(digestsubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DigestSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DigestSubscriptionUpsertOne) Ignore() *DigestSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DigestSubscriptionUpsertOne) DoNothing() *DigestSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DigestSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *DigestSubscriptionUpsertOne) Update(set func(*DigestSubscriptionUpsert)) *DigestSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DigestSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DigestSubscriptionUpsertOne) SetUpdatedAt(v time.Time) *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertOne) UpdateUpdatedAt() *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *DigestSubscriptionUpsertOne) SetAccountID(v xid.ID) *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertOne) UpdateAccountID() *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *DigestSubscriptionUpsertOne) SetFrequency(v string) *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertOne) UpdateFrequency() *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *DigestSubscriptionUpsertOne) SetLastSentAt(v time.Time) *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertOne) UpdateLastSentAt() *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *DigestSubscriptionUpsertOne) ClearLastSentAt() *DigestSubscriptionUpsertOne {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DigestSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DigestSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DigestSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DigestSubscriptionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DigestSubscriptionUpsertOne.ID is not supported by MySQL driver. Use DigestSubscriptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DigestSubscriptionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DigestSubscriptionCreateBulk is the builder for creating many DigestSubscription entities in bulk.
+type DigestSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*DigestSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DigestSubscription entities in the database.
+func (_c *DigestSubscriptionCreateBulk) Save(ctx context.Context) ([]*DigestSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DigestSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DigestSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DigestSubscriptionCreateBulk) SaveX(ctx context.Context) []*DigestSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DigestSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DigestSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DigestSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DigestSubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DigestSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *DigestSubscriptionUpsertBulk {
+	_c.conflict = opts
+	return &DigestSubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DigestSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DigestSubscriptionCreateBulk) OnConflictColumns(columns ...string) *DigestSubscriptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DigestSubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// DigestSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of DigestSubscription nodes.
+type DigestSubscriptionUpsertBulk struct {
+	create *DigestSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DigestSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(digestsubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DigestSubscriptionUpsertBulk) UpdateNewValues() *DigestSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(digestsubscription.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(digestsubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DigestSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DigestSubscriptionUpsertBulk) Ignore() *DigestSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DigestSubscriptionUpsertBulk) DoNothing() *DigestSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DigestSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *DigestSubscriptionUpsertBulk) Update(set func(*DigestSubscriptionUpsert)) *DigestSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DigestSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DigestSubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertBulk) UpdateUpdatedAt() *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *DigestSubscriptionUpsertBulk) SetAccountID(v xid.ID) *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertBulk) UpdateAccountID() *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *DigestSubscriptionUpsertBulk) SetFrequency(v string) *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertBulk) UpdateFrequency() *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *DigestSubscriptionUpsertBulk) SetLastSentAt(v time.Time) *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *DigestSubscriptionUpsertBulk) UpdateLastSentAt() *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *DigestSubscriptionUpsertBulk) ClearLastSentAt() *DigestSubscriptionUpsertBulk {
+	return u.Update(func(s *DigestSubscriptionUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DigestSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DigestSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DigestSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DigestSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
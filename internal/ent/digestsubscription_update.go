@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// DigestSubscriptionUpdate is the builder for updating DigestSubscription entities.
+type DigestSubscriptionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DigestSubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DigestSubscriptionUpdate builder.
+func (_u *DigestSubscriptionUpdate) Where(ps ...predicate.DigestSubscription) *DigestSubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DigestSubscriptionUpdate) SetUpdatedAt(v time.Time) *DigestSubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *DigestSubscriptionUpdate) SetAccountID(v xid.ID) *DigestSubscriptionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *DigestSubscriptionUpdate) SetNillableAccountID(v *xid.ID) *DigestSubscriptionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *DigestSubscriptionUpdate) SetFrequency(v string) *DigestSubscriptionUpdate {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *DigestSubscriptionUpdate) SetNillableFrequency(v *string) *DigestSubscriptionUpdate {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_u *DigestSubscriptionUpdate) SetLastSentAt(v time.Time) *DigestSubscriptionUpdate {
+	_u.mutation.SetLastSentAt(v)
+	return _u
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_u *DigestSubscriptionUpdate) SetNillableLastSentAt(v *time.Time) *DigestSubscriptionUpdate {
+	if v != nil {
+		_u.SetLastSentAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (_u *DigestSubscriptionUpdate) ClearLastSentAt() *DigestSubscriptionUpdate {
+	_u.mutation.ClearLastSentAt()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *DigestSubscriptionUpdate) SetAccount(v *Account) *DigestSubscriptionUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the DigestSubscriptionMutation object of the builder.
+func (_u *DigestSubscriptionUpdate) Mutation() *DigestSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *DigestSubscriptionUpdate) ClearAccount() *DigestSubscriptionUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DigestSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DigestSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DigestSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DigestSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DigestSubscriptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := digestsubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DigestSubscriptionUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DigestSubscription.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DigestSubscriptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DigestSubscriptionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DigestSubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(digestsubscription.Table, digestsubscription.Columns, sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(digestsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(digestsubscription.FieldFrequency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastSentAt(); ok {
+		_spec.SetField(digestsubscription.FieldLastSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSentAtCleared() {
+		_spec.ClearField(digestsubscription.FieldLastSentAt, field.TypeTime)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   digestsubscription.AccountTable,
+			Columns: []string{digestsubscription.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   digestsubscription.AccountTable,
+			Columns: []string{digestsubscription.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{digestsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DigestSubscriptionUpdateOne is the builder for updating a single DigestSubscription entity.
+type DigestSubscriptionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DigestSubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DigestSubscriptionUpdateOne) SetUpdatedAt(v time.Time) *DigestSubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *DigestSubscriptionUpdateOne) SetAccountID(v xid.ID) *DigestSubscriptionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *DigestSubscriptionUpdateOne) SetNillableAccountID(v *xid.ID) *DigestSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *DigestSubscriptionUpdateOne) SetFrequency(v string) *DigestSubscriptionUpdateOne {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *DigestSubscriptionUpdateOne) SetNillableFrequency(v *string) *DigestSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_u *DigestSubscriptionUpdateOne) SetLastSentAt(v time.Time) *DigestSubscriptionUpdateOne {
+	_u.mutation.SetLastSentAt(v)
+	return _u
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_u *DigestSubscriptionUpdateOne) SetNillableLastSentAt(v *time.Time) *DigestSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastSentAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (_u *DigestSubscriptionUpdateOne) ClearLastSentAt() *DigestSubscriptionUpdateOne {
+	_u.mutation.ClearLastSentAt()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *DigestSubscriptionUpdateOne) SetAccount(v *Account) *DigestSubscriptionUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the DigestSubscriptionMutation object of the builder.
+func (_u *DigestSubscriptionUpdateOne) Mutation() *DigestSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *DigestSubscriptionUpdateOne) ClearAccount() *DigestSubscriptionUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the DigestSubscriptionUpdate builder.
+func (_u *DigestSubscriptionUpdateOne) Where(ps ...predicate.DigestSubscription) *DigestSubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DigestSubscriptionUpdateOne) Select(field string, fields ...string) *DigestSubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DigestSubscription entity.
+func (_u *DigestSubscriptionUpdateOne) Save(ctx context.Context) (*DigestSubscription, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DigestSubscriptionUpdateOne) SaveX(ctx context.Context) *DigestSubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DigestSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DigestSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DigestSubscriptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := digestsubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DigestSubscriptionUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DigestSubscription.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DigestSubscriptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DigestSubscriptionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DigestSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *DigestSubscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(digestsubscription.Table, digestsubscription.Columns, sqlgraph.NewFieldSpec(digestsubscription.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DigestSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, digestsubscription.FieldID)
+		for _, f := range fields {
+			if !digestsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != digestsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(digestsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(digestsubscription.FieldFrequency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastSentAt(); ok {
+		_spec.SetField(digestsubscription.FieldLastSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSentAtCleared() {
+		_spec.ClearField(digestsubscription.FieldLastSentAt, field.TypeTime)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   digestsubscription.AccountTable,
+			Columns: []string{digestsubscription.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   digestsubscription.AccountTable,
+			Columns: []string{digestsubscription.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DigestSubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{digestsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
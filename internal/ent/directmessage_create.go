@@ -0,0 +1,876 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
+	"github.com/rs/xid"
+)
+
+// DirectMessageCreate is the builder for creating a DirectMessage entity.
+type DirectMessageCreate struct {
+	config
+	mutation *DirectMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DirectMessageCreate) SetCreatedAt(v time.Time) *DirectMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DirectMessageCreate) SetNillableCreatedAt(v *time.Time) *DirectMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DirectMessageCreate) SetUpdatedAt(v time.Time) *DirectMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DirectMessageCreate) SetNillableUpdatedAt(v *time.Time) *DirectMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *DirectMessageCreate) SetDeletedAt(v time.Time) *DirectMessageCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *DirectMessageCreate) SetNillableDeletedAt(v *time.Time) *DirectMessageCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *DirectMessageCreate) SetConversationID(v xid.ID) *DirectMessageCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *DirectMessageCreate) SetAccountID(v xid.ID) *DirectMessageCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *DirectMessageCreate) SetBody(v string) *DirectMessageCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DirectMessageCreate) SetID(v xid.ID) *DirectMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DirectMessageCreate) SetNillableID(v *xid.ID) *DirectMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_c *DirectMessageCreate) SetConversation(v *Conversation) *DirectMessageCreate {
+	return _c.SetConversationID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Account entity by ID.
+func (_c *DirectMessageCreate) SetAuthorID(id xid.ID) *DirectMessageCreate {
+	_c.mutation.SetAuthorID(id)
+	return _c
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_c *DirectMessageCreate) SetAuthor(v *Account) *DirectMessageCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
+func (_c *DirectMessageCreate) AddAssetIDs(ids ...xid.ID) *DirectMessageCreate {
+	_c.mutation.AddAssetIDs(ids...)
+	return _c
+}
+
+// AddAssets adds the "assets" edges to the Asset entity.
+func (_c *DirectMessageCreate) AddAssets(v ...*Asset) *DirectMessageCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssetIDs(ids...)
+}
+
+// Mutation returns the DirectMessageMutation object of the builder.
+func (_c *DirectMessageCreate) Mutation() *DirectMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the DirectMessage in the database.
+func (_c *DirectMessageCreate) Save(ctx context.Context) (*DirectMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DirectMessageCreate) SaveX(ctx context.Context) *DirectMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DirectMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DirectMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DirectMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := directmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := directmessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := directmessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DirectMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DirectMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DirectMessage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "DirectMessage.conversation_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "DirectMessage.account_id"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "DirectMessage.body"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := directmessage.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DirectMessage.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "DirectMessage.conversation"`)}
+	}
+	if len(_c.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "DirectMessage.author"`)}
+	}
+	return nil
+}
+
+func (_c *DirectMessageCreate) sqlSave(ctx context.Context) (*DirectMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DirectMessageCreate) createSpec() (*DirectMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DirectMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(directmessage.Table, sqlgraph.NewFieldSpec(directmessage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(directmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(directmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(directmessage.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(directmessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if nodes := _c.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   directmessage.ConversationTable,
+			Columns: []string{directmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   directmessage.AuthorTable,
+			Columns: []string{directmessage.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   directmessage.AssetsTable,
+			Columns: directmessage.AssetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DirectMessage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DirectMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DirectMessageCreate) OnConflict(opts ...sql.ConflictOption) *DirectMessageUpsertOne {
+	_c.conflict = opts
+	return &DirectMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DirectMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DirectMessageCreate) OnConflictColumns(columns ...string) *DirectMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DirectMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DirectMessageUpsertOne is the builder for "upsert"-ing
+	//  one DirectMessage node.
+	DirectMessageUpsertOne struct {
+		create *DirectMessageCreate
+	}
+
+	// DirectMessageUpsert is the "OnConflict" setter.
+	DirectMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DirectMessageUpsert) SetUpdatedAt(v time.Time) *DirectMessageUpsert {
+	u.Set(directmessage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DirectMessageUpsert) UpdateUpdatedAt() *DirectMessageUpsert {
+	u.SetExcluded(directmessage.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DirectMessageUpsert) SetDeletedAt(v time.Time) *DirectMessageUpsert {
+	u.Set(directmessage.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DirectMessageUpsert) UpdateDeletedAt() *DirectMessageUpsert {
+	u.SetExcluded(directmessage.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DirectMessageUpsert) ClearDeletedAt() *DirectMessageUpsert {
+	u.SetNull(directmessage.FieldDeletedAt)
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *DirectMessageUpsert) SetConversationID(v xid.ID) *DirectMessageUpsert {
+	u.Set(directmessage.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *DirectMessageUpsert) UpdateConversationID() *DirectMessageUpsert {
+	u.SetExcluded(directmessage.FieldConversationID)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *DirectMessageUpsert) SetAccountID(v xid.ID) *DirectMessageUpsert {
+	u.Set(directmessage.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *DirectMessageUpsert) UpdateAccountID() *DirectMessageUpsert {
+	u.SetExcluded(directmessage.FieldAccountID)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *DirectMessageUpsert) SetBody(v string) *DirectMessageUpsert {
+	u.Set(directmessage.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *DirectMessageUpsert) UpdateBody() *DirectMessageUpsert {
+	u.SetExcluded(directmessage.FieldBody)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DirectMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(directmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DirectMessageUpsertOne) UpdateNewValues() *DirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(directmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(directmessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DirectMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DirectMessageUpsertOne) Ignore() *DirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DirectMessageUpsertOne) DoNothing() *DirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DirectMessageCreate.OnConflict
+// documentation for more info.
+func (u *DirectMessageUpsertOne) Update(set func(*DirectMessageUpsert)) *DirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DirectMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DirectMessageUpsertOne) SetUpdatedAt(v time.Time) *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DirectMessageUpsertOne) UpdateUpdatedAt() *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DirectMessageUpsertOne) SetDeletedAt(v time.Time) *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DirectMessageUpsertOne) UpdateDeletedAt() *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DirectMessageUpsertOne) ClearDeletedAt() *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *DirectMessageUpsertOne) SetConversationID(v xid.ID) *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *DirectMessageUpsertOne) UpdateConversationID() *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *DirectMessageUpsertOne) SetAccountID(v xid.ID) *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *DirectMessageUpsertOne) UpdateAccountID() *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *DirectMessageUpsertOne) SetBody(v string) *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *DirectMessageUpsertOne) UpdateBody() *DirectMessageUpsertOne {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// Exec executes the query.
+func (u *DirectMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DirectMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DirectMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DirectMessageUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DirectMessageUpsertOne.ID is not supported by MySQL driver. Use DirectMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DirectMessageUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DirectMessageCreateBulk is the builder for creating many DirectMessage entities in bulk.
+type DirectMessageCreateBulk struct {
+	config
+	err      error
+	builders []*DirectMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DirectMessage entities in the database.
+func (_c *DirectMessageCreateBulk) Save(ctx context.Context) ([]*DirectMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DirectMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DirectMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DirectMessageCreateBulk) SaveX(ctx context.Context) []*DirectMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DirectMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DirectMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DirectMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DirectMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DirectMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *DirectMessageUpsertBulk {
+	_c.conflict = opts
+	return &DirectMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DirectMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DirectMessageCreateBulk) OnConflictColumns(columns ...string) *DirectMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DirectMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// DirectMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of DirectMessage nodes.
+type DirectMessageUpsertBulk struct {
+	create *DirectMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DirectMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(directmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DirectMessageUpsertBulk) UpdateNewValues() *DirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(directmessage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(directmessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DirectMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DirectMessageUpsertBulk) Ignore() *DirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DirectMessageUpsertBulk) DoNothing() *DirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DirectMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *DirectMessageUpsertBulk) Update(set func(*DirectMessageUpsert)) *DirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DirectMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DirectMessageUpsertBulk) SetUpdatedAt(v time.Time) *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DirectMessageUpsertBulk) UpdateUpdatedAt() *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DirectMessageUpsertBulk) SetDeletedAt(v time.Time) *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DirectMessageUpsertBulk) UpdateDeletedAt() *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DirectMessageUpsertBulk) ClearDeletedAt() *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *DirectMessageUpsertBulk) SetConversationID(v xid.ID) *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *DirectMessageUpsertBulk) UpdateConversationID() *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *DirectMessageUpsertBulk) SetAccountID(v xid.ID) *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *DirectMessageUpsertBulk) UpdateAccountID() *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *DirectMessageUpsertBulk) SetBody(v string) *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *DirectMessageUpsertBulk) UpdateBody() *DirectMessageUpsertBulk {
+	return u.Update(func(s *DirectMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// Exec executes the query.
+func (u *DirectMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DirectMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DirectMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DirectMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/rs/xid"
+)
+
+// DiscordBridgeLink is the model entity for the DiscordBridgeLink schema.
+type DiscordBridgeLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// PostID holds the value of the "post_id" field.
+	PostID xid.ID `json:"post_id,omitempty"`
+	// ThreadID holds the value of the "thread_id" field.
+	ThreadID xid.ID `json:"thread_id,omitempty"`
+	// DiscordChannelID holds the value of the "discord_channel_id" field.
+	DiscordChannelID string `json:"discord_channel_id,omitempty"`
+	// DiscordMessageID holds the value of the "discord_message_id" field.
+	DiscordMessageID string `json:"discord_message_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DiscordBridgeLinkQuery when eager-loading is set.
+	Edges        DiscordBridgeLinkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DiscordBridgeLinkEdges holds the relations/edges for other nodes in the graph.
+type DiscordBridgeLinkEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DiscordBridgeLinkEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscordBridgeLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discordbridgelink.FieldDiscordChannelID, discordbridgelink.FieldDiscordMessageID:
+			values[i] = new(sql.NullString)
+		case discordbridgelink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case discordbridgelink.FieldID, discordbridgelink.FieldPostID, discordbridgelink.FieldThreadID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscordBridgeLink fields.
+func (_m *DiscordBridgeLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discordbridgelink.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case discordbridgelink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case discordbridgelink.FieldPostID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value != nil {
+				_m.PostID = *value
+			}
+		case discordbridgelink.FieldThreadID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_id", values[i])
+			} else if value != nil {
+				_m.ThreadID = *value
+			}
+		case discordbridgelink.FieldDiscordChannelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discord_channel_id", values[i])
+			} else if value.Valid {
+				_m.DiscordChannelID = value.String
+			}
+		case discordbridgelink.FieldDiscordMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discord_message_id", values[i])
+			} else if value.Valid {
+				_m.DiscordMessageID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscordBridgeLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscordBridgeLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the DiscordBridgeLink entity.
+func (_m *DiscordBridgeLink) QueryPost() *PostQuery {
+	return NewDiscordBridgeLinkClient(_m.config).QueryPost(_m)
+}
+
+// Update returns a builder for updating this DiscordBridgeLink.
+// Note that you need to call DiscordBridgeLink.Unwrap() before calling this method if this DiscordBridgeLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscordBridgeLink) Update() *DiscordBridgeLinkUpdateOne {
+	return NewDiscordBridgeLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscordBridgeLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscordBridgeLink) Unwrap() *DiscordBridgeLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscordBridgeLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscordBridgeLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscordBridgeLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("post_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PostID))
+	builder.WriteString(", ")
+	builder.WriteString("thread_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ThreadID))
+	builder.WriteString(", ")
+	builder.WriteString("discord_channel_id=")
+	builder.WriteString(_m.DiscordChannelID)
+	builder.WriteString(", ")
+	builder.WriteString("discord_message_id=")
+	builder.WriteString(_m.DiscordMessageID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscordBridgeLinks is a parsable slice of DiscordBridgeLink.
+type DiscordBridgeLinks []*DiscordBridgeLink
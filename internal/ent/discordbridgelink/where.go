@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordbridgelink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldPostID, v))
+}
+
+// ThreadID applies equality check predicate on the "thread_id" field. It's identical to ThreadIDEQ.
+func ThreadID(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldThreadID, v))
+}
+
+// DiscordChannelID applies equality check predicate on the "discord_channel_id" field. It's identical to DiscordChannelIDEQ.
+func DiscordChannelID(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldDiscordChannelID, v))
+}
+
+// DiscordMessageID applies equality check predicate on the "discord_message_id" field. It's identical to DiscordMessageIDEQ.
+func DiscordMessageID(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldDiscordMessageID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// PostIDGT applies the GT predicate on the "post_id" field.
+func PostIDGT(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGT(FieldPostID, v))
+}
+
+// PostIDGTE applies the GTE predicate on the "post_id" field.
+func PostIDGTE(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGTE(FieldPostID, v))
+}
+
+// PostIDLT applies the LT predicate on the "post_id" field.
+func PostIDLT(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLT(FieldPostID, v))
+}
+
+// PostIDLTE applies the LTE predicate on the "post_id" field.
+func PostIDLTE(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLTE(FieldPostID, v))
+}
+
+// PostIDContains applies the Contains predicate on the "post_id" field.
+func PostIDContains(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldContains(FieldPostID, vc))
+}
+
+// PostIDHasPrefix applies the HasPrefix predicate on the "post_id" field.
+func PostIDHasPrefix(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldHasPrefix(FieldPostID, vc))
+}
+
+// PostIDHasSuffix applies the HasSuffix predicate on the "post_id" field.
+func PostIDHasSuffix(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldHasSuffix(FieldPostID, vc))
+}
+
+// PostIDEqualFold applies the EqualFold predicate on the "post_id" field.
+func PostIDEqualFold(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldEqualFold(FieldPostID, vc))
+}
+
+// PostIDContainsFold applies the ContainsFold predicate on the "post_id" field.
+func PostIDContainsFold(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldContainsFold(FieldPostID, vc))
+}
+
+// ThreadIDEQ applies the EQ predicate on the "thread_id" field.
+func ThreadIDEQ(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldThreadID, v))
+}
+
+// ThreadIDNEQ applies the NEQ predicate on the "thread_id" field.
+func ThreadIDNEQ(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNEQ(FieldThreadID, v))
+}
+
+// ThreadIDIn applies the In predicate on the "thread_id" field.
+func ThreadIDIn(vs ...xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldIn(FieldThreadID, vs...))
+}
+
+// ThreadIDNotIn applies the NotIn predicate on the "thread_id" field.
+func ThreadIDNotIn(vs ...xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNotIn(FieldThreadID, vs...))
+}
+
+// ThreadIDGT applies the GT predicate on the "thread_id" field.
+func ThreadIDGT(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGT(FieldThreadID, v))
+}
+
+// ThreadIDGTE applies the GTE predicate on the "thread_id" field.
+func ThreadIDGTE(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGTE(FieldThreadID, v))
+}
+
+// ThreadIDLT applies the LT predicate on the "thread_id" field.
+func ThreadIDLT(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLT(FieldThreadID, v))
+}
+
+// ThreadIDLTE applies the LTE predicate on the "thread_id" field.
+func ThreadIDLTE(v xid.ID) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLTE(FieldThreadID, v))
+}
+
+// ThreadIDContains applies the Contains predicate on the "thread_id" field.
+func ThreadIDContains(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldContains(FieldThreadID, vc))
+}
+
+// ThreadIDHasPrefix applies the HasPrefix predicate on the "thread_id" field.
+func ThreadIDHasPrefix(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldHasPrefix(FieldThreadID, vc))
+}
+
+// ThreadIDHasSuffix applies the HasSuffix predicate on the "thread_id" field.
+func ThreadIDHasSuffix(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldHasSuffix(FieldThreadID, vc))
+}
+
+// ThreadIDEqualFold applies the EqualFold predicate on the "thread_id" field.
+func ThreadIDEqualFold(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldEqualFold(FieldThreadID, vc))
+}
+
+// ThreadIDContainsFold applies the ContainsFold predicate on the "thread_id" field.
+func ThreadIDContainsFold(v xid.ID) predicate.DiscordBridgeLink {
+	vc := v.String()
+	return predicate.DiscordBridgeLink(sql.FieldContainsFold(FieldThreadID, vc))
+}
+
+// DiscordChannelIDEQ applies the EQ predicate on the "discord_channel_id" field.
+func DiscordChannelIDEQ(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDNEQ applies the NEQ predicate on the "discord_channel_id" field.
+func DiscordChannelIDNEQ(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNEQ(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDIn applies the In predicate on the "discord_channel_id" field.
+func DiscordChannelIDIn(vs ...string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldIn(FieldDiscordChannelID, vs...))
+}
+
+// DiscordChannelIDNotIn applies the NotIn predicate on the "discord_channel_id" field.
+func DiscordChannelIDNotIn(vs ...string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNotIn(FieldDiscordChannelID, vs...))
+}
+
+// DiscordChannelIDGT applies the GT predicate on the "discord_channel_id" field.
+func DiscordChannelIDGT(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGT(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDGTE applies the GTE predicate on the "discord_channel_id" field.
+func DiscordChannelIDGTE(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGTE(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDLT applies the LT predicate on the "discord_channel_id" field.
+func DiscordChannelIDLT(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLT(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDLTE applies the LTE predicate on the "discord_channel_id" field.
+func DiscordChannelIDLTE(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLTE(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDContains applies the Contains predicate on the "discord_channel_id" field.
+func DiscordChannelIDContains(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldContains(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDHasPrefix applies the HasPrefix predicate on the "discord_channel_id" field.
+func DiscordChannelIDHasPrefix(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldHasPrefix(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDHasSuffix applies the HasSuffix predicate on the "discord_channel_id" field.
+func DiscordChannelIDHasSuffix(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldHasSuffix(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDEqualFold applies the EqualFold predicate on the "discord_channel_id" field.
+func DiscordChannelIDEqualFold(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEqualFold(FieldDiscordChannelID, v))
+}
+
+// DiscordChannelIDContainsFold applies the ContainsFold predicate on the "discord_channel_id" field.
+func DiscordChannelIDContainsFold(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldContainsFold(FieldDiscordChannelID, v))
+}
+
+// DiscordMessageIDEQ applies the EQ predicate on the "discord_message_id" field.
+func DiscordMessageIDEQ(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEQ(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDNEQ applies the NEQ predicate on the "discord_message_id" field.
+func DiscordMessageIDNEQ(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNEQ(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDIn applies the In predicate on the "discord_message_id" field.
+func DiscordMessageIDIn(vs ...string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldIn(FieldDiscordMessageID, vs...))
+}
+
+// DiscordMessageIDNotIn applies the NotIn predicate on the "discord_message_id" field.
+func DiscordMessageIDNotIn(vs ...string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldNotIn(FieldDiscordMessageID, vs...))
+}
+
+// DiscordMessageIDGT applies the GT predicate on the "discord_message_id" field.
+func DiscordMessageIDGT(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGT(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDGTE applies the GTE predicate on the "discord_message_id" field.
+func DiscordMessageIDGTE(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldGTE(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDLT applies the LT predicate on the "discord_message_id" field.
+func DiscordMessageIDLT(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLT(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDLTE applies the LTE predicate on the "discord_message_id" field.
+func DiscordMessageIDLTE(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldLTE(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDContains applies the Contains predicate on the "discord_message_id" field.
+func DiscordMessageIDContains(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldContains(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDHasPrefix applies the HasPrefix predicate on the "discord_message_id" field.
+func DiscordMessageIDHasPrefix(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldHasPrefix(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDHasSuffix applies the HasSuffix predicate on the "discord_message_id" field.
+func DiscordMessageIDHasSuffix(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldHasSuffix(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDEqualFold applies the EqualFold predicate on the "discord_message_id" field.
+func DiscordMessageIDEqualFold(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldEqualFold(FieldDiscordMessageID, v))
+}
+
+// DiscordMessageIDContainsFold applies the ContainsFold predicate on the "discord_message_id" field.
+func DiscordMessageIDContainsFold(v string) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.FieldContainsFold(FieldDiscordMessageID, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscordBridgeLink) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscordBridgeLink) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscordBridgeLink) predicate.DiscordBridgeLink {
+	return predicate.DiscordBridgeLink(sql.NotPredicates(p))
+}
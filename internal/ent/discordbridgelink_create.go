@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/rs/xid"
+)
+
+// DiscordBridgeLinkCreate is the builder for creating a DiscordBridgeLink entity.
+type DiscordBridgeLinkCreate struct {
+	config
+	mutation *DiscordBridgeLinkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DiscordBridgeLinkCreate) SetCreatedAt(v time.Time) *DiscordBridgeLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DiscordBridgeLinkCreate) SetNillableCreatedAt(v *time.Time) *DiscordBridgeLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *DiscordBridgeLinkCreate) SetPostID(v xid.ID) *DiscordBridgeLinkCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_c *DiscordBridgeLinkCreate) SetThreadID(v xid.ID) *DiscordBridgeLinkCreate {
+	_c.mutation.SetThreadID(v)
+	return _c
+}
+
+// SetDiscordChannelID sets the "discord_channel_id" field.
+func (_c *DiscordBridgeLinkCreate) SetDiscordChannelID(v string) *DiscordBridgeLinkCreate {
+	_c.mutation.SetDiscordChannelID(v)
+	return _c
+}
+
+// SetDiscordMessageID sets the "discord_message_id" field.
+func (_c *DiscordBridgeLinkCreate) SetDiscordMessageID(v string) *DiscordBridgeLinkCreate {
+	_c.mutation.SetDiscordMessageID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordBridgeLinkCreate) SetID(v xid.ID) *DiscordBridgeLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DiscordBridgeLinkCreate) SetNillableID(v *xid.ID) *DiscordBridgeLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *DiscordBridgeLinkCreate) SetPost(v *Post) *DiscordBridgeLinkCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// Mutation returns the DiscordBridgeLinkMutation object of the builder.
+func (_c *DiscordBridgeLinkCreate) Mutation() *DiscordBridgeLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordBridgeLink in the database.
+func (_c *DiscordBridgeLinkCreate) Save(ctx context.Context) (*DiscordBridgeLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordBridgeLinkCreate) SaveX(ctx context.Context) *DiscordBridgeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordBridgeLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordBridgeLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscordBridgeLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := discordbridgelink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := discordbridgelink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordBridgeLinkCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DiscordBridgeLink.created_at"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "DiscordBridgeLink.post_id"`)}
+	}
+	if _, ok := _c.mutation.ThreadID(); !ok {
+		return &ValidationError{Name: "thread_id", err: errors.New(`ent: missing required field "DiscordBridgeLink.thread_id"`)}
+	}
+	if _, ok := _c.mutation.DiscordChannelID(); !ok {
+		return &ValidationError{Name: "discord_channel_id", err: errors.New(`ent: missing required field "DiscordBridgeLink.discord_channel_id"`)}
+	}
+	if _, ok := _c.mutation.DiscordMessageID(); !ok {
+		return &ValidationError{Name: "discord_message_id", err: errors.New(`ent: missing required field "DiscordBridgeLink.discord_message_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discordbridgelink.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordBridgeLink.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "DiscordBridgeLink.post"`)}
+	}
+	return nil
+}
+
+func (_c *DiscordBridgeLinkCreate) sqlSave(ctx context.Context) (*DiscordBridgeLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordBridgeLinkCreate) createSpec() (*DiscordBridgeLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordBridgeLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordbridgelink.Table, sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(discordbridgelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ThreadID(); ok {
+		_spec.SetField(discordbridgelink.FieldThreadID, field.TypeString, value)
+		_node.ThreadID = value
+	}
+	if value, ok := _c.mutation.DiscordChannelID(); ok {
+		_spec.SetField(discordbridgelink.FieldDiscordChannelID, field.TypeString, value)
+		_node.DiscordChannelID = value
+	}
+	if value, ok := _c.mutation.DiscordMessageID(); ok {
+		_spec.SetField(discordbridgelink.FieldDiscordMessageID, field.TypeString, value)
+		_node.DiscordMessageID = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordbridgelink.PostTable,
+			Columns: []string{discordbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordBridgeLink.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordBridgeLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordBridgeLinkCreate) OnConflict(opts ...sql.ConflictOption) *DiscordBridgeLinkUpsertOne {
+	_c.conflict = opts
+	return &DiscordBridgeLinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordBridgeLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordBridgeLinkCreate) OnConflictColumns(columns ...string) *DiscordBridgeLinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordBridgeLinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordBridgeLinkUpsertOne is the builder for "upsert"-ing
+	//  one DiscordBridgeLink node.
+	DiscordBridgeLinkUpsertOne struct {
+		create *DiscordBridgeLinkCreate
+	}
+
+	// DiscordBridgeLinkUpsert is the "OnConflict" setter.
+	DiscordBridgeLinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPostID sets the "post_id" field.
+func (u *DiscordBridgeLinkUpsert) SetPostID(v xid.ID) *DiscordBridgeLinkUpsert {
+	u.Set(discordbridgelink.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsert) UpdatePostID() *DiscordBridgeLinkUpsert {
+	u.SetExcluded(discordbridgelink.FieldPostID)
+	return u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *DiscordBridgeLinkUpsert) SetThreadID(v xid.ID) *DiscordBridgeLinkUpsert {
+	u.Set(discordbridgelink.FieldThreadID, v)
+	return u
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsert) UpdateThreadID() *DiscordBridgeLinkUpsert {
+	u.SetExcluded(discordbridgelink.FieldThreadID)
+	return u
+}
+
+// SetDiscordChannelID sets the "discord_channel_id" field.
+func (u *DiscordBridgeLinkUpsert) SetDiscordChannelID(v string) *DiscordBridgeLinkUpsert {
+	u.Set(discordbridgelink.FieldDiscordChannelID, v)
+	return u
+}
+
+// UpdateDiscordChannelID sets the "discord_channel_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsert) UpdateDiscordChannelID() *DiscordBridgeLinkUpsert {
+	u.SetExcluded(discordbridgelink.FieldDiscordChannelID)
+	return u
+}
+
+// SetDiscordMessageID sets the "discord_message_id" field.
+func (u *DiscordBridgeLinkUpsert) SetDiscordMessageID(v string) *DiscordBridgeLinkUpsert {
+	u.Set(discordbridgelink.FieldDiscordMessageID, v)
+	return u
+}
+
+// UpdateDiscordMessageID sets the "discord_message_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsert) UpdateDiscordMessageID() *DiscordBridgeLinkUpsert {
+	u.SetExcluded(discordbridgelink.FieldDiscordMessageID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordBridgeLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordbridgelink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordBridgeLinkUpsertOne) UpdateNewValues() *DiscordBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discordbridgelink.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(discordbridgelink.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordBridgeLink.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordBridgeLinkUpsertOne) Ignore() *DiscordBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordBridgeLinkUpsertOne) DoNothing() *DiscordBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordBridgeLinkCreate.OnConflict
+// documentation for more info.
+func (u *DiscordBridgeLinkUpsertOne) Update(set func(*DiscordBridgeLinkUpsert)) *DiscordBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordBridgeLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *DiscordBridgeLinkUpsertOne) SetPostID(v xid.ID) *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertOne) UpdatePostID() *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *DiscordBridgeLinkUpsertOne) SetThreadID(v xid.ID) *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertOne) UpdateThreadID() *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// SetDiscordChannelID sets the "discord_channel_id" field.
+func (u *DiscordBridgeLinkUpsertOne) SetDiscordChannelID(v string) *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetDiscordChannelID(v)
+	})
+}
+
+// UpdateDiscordChannelID sets the "discord_channel_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertOne) UpdateDiscordChannelID() *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdateDiscordChannelID()
+	})
+}
+
+// SetDiscordMessageID sets the "discord_message_id" field.
+func (u *DiscordBridgeLinkUpsertOne) SetDiscordMessageID(v string) *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetDiscordMessageID(v)
+	})
+}
+
+// UpdateDiscordMessageID sets the "discord_message_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertOne) UpdateDiscordMessageID() *DiscordBridgeLinkUpsertOne {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdateDiscordMessageID()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordBridgeLinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordBridgeLinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordBridgeLinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordBridgeLinkUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordBridgeLinkUpsertOne.ID is not supported by MySQL driver. Use DiscordBridgeLinkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordBridgeLinkUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordBridgeLinkCreateBulk is the builder for creating many DiscordBridgeLink entities in bulk.
+type DiscordBridgeLinkCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordBridgeLinkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordBridgeLink entities in the database.
+func (_c *DiscordBridgeLinkCreateBulk) Save(ctx context.Context) ([]*DiscordBridgeLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordBridgeLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordBridgeLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordBridgeLinkCreateBulk) SaveX(ctx context.Context) []*DiscordBridgeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordBridgeLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordBridgeLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordBridgeLink.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordBridgeLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordBridgeLinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordBridgeLinkUpsertBulk {
+	_c.conflict = opts
+	return &DiscordBridgeLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordBridgeLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordBridgeLinkCreateBulk) OnConflictColumns(columns ...string) *DiscordBridgeLinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordBridgeLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordBridgeLinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordBridgeLink nodes.
+type DiscordBridgeLinkUpsertBulk struct {
+	create *DiscordBridgeLinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordBridgeLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordbridgelink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordBridgeLinkUpsertBulk) UpdateNewValues() *DiscordBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discordbridgelink.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(discordbridgelink.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordBridgeLink.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordBridgeLinkUpsertBulk) Ignore() *DiscordBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordBridgeLinkUpsertBulk) DoNothing() *DiscordBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordBridgeLinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordBridgeLinkUpsertBulk) Update(set func(*DiscordBridgeLinkUpsert)) *DiscordBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordBridgeLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *DiscordBridgeLinkUpsertBulk) SetPostID(v xid.ID) *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertBulk) UpdatePostID() *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *DiscordBridgeLinkUpsertBulk) SetThreadID(v xid.ID) *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertBulk) UpdateThreadID() *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// SetDiscordChannelID sets the "discord_channel_id" field.
+func (u *DiscordBridgeLinkUpsertBulk) SetDiscordChannelID(v string) *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetDiscordChannelID(v)
+	})
+}
+
+// UpdateDiscordChannelID sets the "discord_channel_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertBulk) UpdateDiscordChannelID() *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdateDiscordChannelID()
+	})
+}
+
+// SetDiscordMessageID sets the "discord_message_id" field.
+func (u *DiscordBridgeLinkUpsertBulk) SetDiscordMessageID(v string) *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.SetDiscordMessageID(v)
+	})
+}
+
+// UpdateDiscordMessageID sets the "discord_message_id" field to the value that was provided on create.
+func (u *DiscordBridgeLinkUpsertBulk) UpdateDiscordMessageID() *DiscordBridgeLinkUpsertBulk {
+	return u.Update(func(s *DiscordBridgeLinkUpsert) {
+		s.UpdateDiscordMessageID()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordBridgeLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordBridgeLinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordBridgeLinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordBridgeLinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// DiscordBridgeLinkDelete is the builder for deleting a DiscordBridgeLink entity.
+type DiscordBridgeLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *DiscordBridgeLinkMutation
+}
+
+// Where appends a list predicates to the DiscordBridgeLinkDelete builder.
+func (_d *DiscordBridgeLinkDelete) Where(ps ...predicate.DiscordBridgeLink) *DiscordBridgeLinkDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DiscordBridgeLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscordBridgeLinkDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DiscordBridgeLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(discordbridgelink.Table, sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DiscordBridgeLinkDeleteOne is the builder for deleting a single DiscordBridgeLink entity.
+type DiscordBridgeLinkDeleteOne struct {
+	_d *DiscordBridgeLinkDelete
+}
+
+// Where appends a list predicates to the DiscordBridgeLinkDelete builder.
+func (_d *DiscordBridgeLinkDeleteOne) Where(ps ...predicate.DiscordBridgeLink) *DiscordBridgeLinkDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DiscordBridgeLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{discordbridgelink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscordBridgeLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
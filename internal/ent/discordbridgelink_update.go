@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// DiscordBridgeLinkUpdate is the builder for updating DiscordBridgeLink entities.
+type DiscordBridgeLinkUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DiscordBridgeLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DiscordBridgeLinkUpdate builder.
+func (_u *DiscordBridgeLinkUpdate) Where(ps ...predicate.DiscordBridgeLink) *DiscordBridgeLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *DiscordBridgeLinkUpdate) SetPostID(v xid.ID) *DiscordBridgeLinkUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdate) SetNillablePostID(v *xid.ID) *DiscordBridgeLinkUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *DiscordBridgeLinkUpdate) SetThreadID(v xid.ID) *DiscordBridgeLinkUpdate {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdate) SetNillableThreadID(v *xid.ID) *DiscordBridgeLinkUpdate {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// SetDiscordChannelID sets the "discord_channel_id" field.
+func (_u *DiscordBridgeLinkUpdate) SetDiscordChannelID(v string) *DiscordBridgeLinkUpdate {
+	_u.mutation.SetDiscordChannelID(v)
+	return _u
+}
+
+// SetNillableDiscordChannelID sets the "discord_channel_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdate) SetNillableDiscordChannelID(v *string) *DiscordBridgeLinkUpdate {
+	if v != nil {
+		_u.SetDiscordChannelID(*v)
+	}
+	return _u
+}
+
+// SetDiscordMessageID sets the "discord_message_id" field.
+func (_u *DiscordBridgeLinkUpdate) SetDiscordMessageID(v string) *DiscordBridgeLinkUpdate {
+	_u.mutation.SetDiscordMessageID(v)
+	return _u
+}
+
+// SetNillableDiscordMessageID sets the "discord_message_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdate) SetNillableDiscordMessageID(v *string) *DiscordBridgeLinkUpdate {
+	if v != nil {
+		_u.SetDiscordMessageID(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *DiscordBridgeLinkUpdate) SetPost(v *Post) *DiscordBridgeLinkUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the DiscordBridgeLinkMutation object of the builder.
+func (_u *DiscordBridgeLinkUpdate) Mutation() *DiscordBridgeLinkMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *DiscordBridgeLinkUpdate) ClearPost() *DiscordBridgeLinkUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordBridgeLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordBridgeLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordBridgeLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordBridgeLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordBridgeLinkUpdate) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordBridgeLink.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DiscordBridgeLinkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DiscordBridgeLinkUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DiscordBridgeLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordbridgelink.Table, discordbridgelink.Columns, sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(discordbridgelink.FieldThreadID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscordChannelID(); ok {
+		_spec.SetField(discordbridgelink.FieldDiscordChannelID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscordMessageID(); ok {
+		_spec.SetField(discordbridgelink.FieldDiscordMessageID, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordbridgelink.PostTable,
+			Columns: []string{discordbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordbridgelink.PostTable,
+			Columns: []string{discordbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordbridgelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordBridgeLinkUpdateOne is the builder for updating a single DiscordBridgeLink entity.
+type DiscordBridgeLinkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DiscordBridgeLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *DiscordBridgeLinkUpdateOne) SetPostID(v xid.ID) *DiscordBridgeLinkUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdateOne) SetNillablePostID(v *xid.ID) *DiscordBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *DiscordBridgeLinkUpdateOne) SetThreadID(v xid.ID) *DiscordBridgeLinkUpdateOne {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdateOne) SetNillableThreadID(v *xid.ID) *DiscordBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// SetDiscordChannelID sets the "discord_channel_id" field.
+func (_u *DiscordBridgeLinkUpdateOne) SetDiscordChannelID(v string) *DiscordBridgeLinkUpdateOne {
+	_u.mutation.SetDiscordChannelID(v)
+	return _u
+}
+
+// SetNillableDiscordChannelID sets the "discord_channel_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdateOne) SetNillableDiscordChannelID(v *string) *DiscordBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetDiscordChannelID(*v)
+	}
+	return _u
+}
+
+// SetDiscordMessageID sets the "discord_message_id" field.
+func (_u *DiscordBridgeLinkUpdateOne) SetDiscordMessageID(v string) *DiscordBridgeLinkUpdateOne {
+	_u.mutation.SetDiscordMessageID(v)
+	return _u
+}
+
+// SetNillableDiscordMessageID sets the "discord_message_id" field if the given value is not nil.
+func (_u *DiscordBridgeLinkUpdateOne) SetNillableDiscordMessageID(v *string) *DiscordBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetDiscordMessageID(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *DiscordBridgeLinkUpdateOne) SetPost(v *Post) *DiscordBridgeLinkUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the DiscordBridgeLinkMutation object of the builder.
+func (_u *DiscordBridgeLinkUpdateOne) Mutation() *DiscordBridgeLinkMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *DiscordBridgeLinkUpdateOne) ClearPost() *DiscordBridgeLinkUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Where appends a list predicates to the DiscordBridgeLinkUpdate builder.
+func (_u *DiscordBridgeLinkUpdateOne) Where(ps ...predicate.DiscordBridgeLink) *DiscordBridgeLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordBridgeLinkUpdateOne) Select(field string, fields ...string) *DiscordBridgeLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordBridgeLink entity.
+func (_u *DiscordBridgeLinkUpdateOne) Save(ctx context.Context) (*DiscordBridgeLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordBridgeLinkUpdateOne) SaveX(ctx context.Context) *DiscordBridgeLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordBridgeLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordBridgeLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordBridgeLinkUpdateOne) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordBridgeLink.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DiscordBridgeLinkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DiscordBridgeLinkUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DiscordBridgeLinkUpdateOne) sqlSave(ctx context.Context) (_node *DiscordBridgeLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordbridgelink.Table, discordbridgelink.Columns, sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordBridgeLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordbridgelink.FieldID)
+		for _, f := range fields {
+			if !discordbridgelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordbridgelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(discordbridgelink.FieldThreadID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscordChannelID(); ok {
+		_spec.SetField(discordbridgelink.FieldDiscordChannelID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscordMessageID(); ok {
+		_spec.SetField(discordbridgelink.FieldDiscordMessageID, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordbridgelink.PostTable,
+			Columns: []string{discordbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordbridgelink.PostTable,
+			Columns: []string{discordbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DiscordBridgeLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordbridgelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
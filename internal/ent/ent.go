@@ -13,35 +13,90 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/Southclaws/storyden/internal/ent/automodrule"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
 	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
+	"github.com/Southclaws/storyden/internal/ent/contentsummary"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
+	"github.com/Southclaws/storyden/internal/ent/featureflag"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+	"github.com/Southclaws/storyden/internal/ent/federationkey"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
+	"github.com/Southclaws/storyden/internal/ent/job"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
+	"github.com/Southclaws/storyden/internal/ent/networkban"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/property"
 	"github.com/Southclaws/storyden/internal/ent/propertyschema"
 	"github.com/Southclaws/storyden/internal/ent/propertyschemafield"
+	"github.com/Southclaws/storyden/internal/ent/pushsubscription"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
 	"github.com/Southclaws/storyden/internal/ent/report"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
 	"github.com/Southclaws/storyden/internal/ent/role"
+	"github.com/Southclaws/storyden/internal/ent/scheduledtask"
 	"github.com/Southclaws/storyden/internal/ent/session"
 	"github.com/Southclaws/storyden/internal/ent/setting"
+	"github.com/Southclaws/storyden/internal/ent/space"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
+	"github.com/Southclaws/storyden/internal/ent/tenant"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
+	"github.com/Southclaws/storyden/internal/ent/wordfilter"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -102,36 +157,91 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table:             account.ValidColumn,
-			accountfollow.Table:       accountfollow.ValidColumn,
-			accountroles.Table:        accountroles.ValidColumn,
-			asset.Table:               asset.ValidColumn,
-			authentication.Table:      authentication.ValidColumn,
-			category.Table:            category.ValidColumn,
-			collection.Table:          collection.ValidColumn,
-			collectionnode.Table:      collectionnode.ValidColumn,
-			collectionpost.Table:      collectionpost.ValidColumn,
-			email.Table:               email.ValidColumn,
-			event.Table:               event.ValidColumn,
-			eventparticipant.Table:    eventparticipant.ValidColumn,
-			invitation.Table:          invitation.ValidColumn,
-			likepost.Table:            likepost.ValidColumn,
-			link.Table:                link.ValidColumn,
-			mentionprofile.Table:      mentionprofile.ValidColumn,
-			node.Table:                node.ValidColumn,
-			notification.Table:        notification.ValidColumn,
-			post.Table:                post.ValidColumn,
-			postread.Table:            postread.ValidColumn,
-			property.Table:            property.ValidColumn,
-			propertyschema.Table:      propertyschema.ValidColumn,
-			propertyschemafield.Table: propertyschemafield.ValidColumn,
-			question.Table:            question.ValidColumn,
-			react.Table:               react.ValidColumn,
-			report.Table:              report.ValidColumn,
-			role.Table:                role.ValidColumn,
-			session.Table:             session.ValidColumn,
-			setting.Table:             setting.ValidColumn,
-			tag.Table:                 tag.ValidColumn,
+			apiusage.Table:                apiusage.ValidColumn,
+			account.Table:                 account.ValidColumn,
+			accountapplication.Table:      accountapplication.ValidColumn,
+			accountbadge.Table:            accountbadge.ValidColumn,
+			accountblock.Table:            accountblock.ValidColumn,
+			accountfollow.Table:           accountfollow.ValidColumn,
+			accountonboardingstep.Table:   accountonboardingstep.ValidColumn,
+			accountroles.Table:            accountroles.ValidColumn,
+			analyticscohort.Table:         analyticscohort.ValidColumn,
+			analyticscontent.Table:        analyticscontent.ValidColumn,
+			analyticsday.Table:            analyticsday.ValidColumn,
+			analyticsexport.Table:         analyticsexport.ValidColumn,
+			asset.Table:                   asset.ValidColumn,
+			authentication.Table:          authentication.ValidColumn,
+			automodrule.Table:             automodrule.ValidColumn,
+			badge.Table:                   badge.ValidColumn,
+			bookmark.Table:                bookmark.ValidColumn,
+			category.Table:                category.ValidColumn,
+			categoryfollow.Table:          categoryfollow.ValidColumn,
+			categorypermission.Table:      categorypermission.ValidColumn,
+			collection.Table:              collection.ValidColumn,
+			collectionnode.Table:          collectionnode.ValidColumn,
+			collectionpost.Table:          collectionpost.ValidColumn,
+			collectionsection.Table:       collectionsection.ValidColumn,
+			collectionshare.Table:         collectionshare.ValidColumn,
+			contentsummary.Table:          contentsummary.ValidColumn,
+			contentview.Table:             contentview.ValidColumn,
+			conversation.Table:            conversation.ValidColumn,
+			conversationparticipant.Table: conversationparticipant.ValidColumn,
+			digestsubscription.Table:      digestsubscription.ValidColumn,
+			directmessage.Table:           directmessage.ValidColumn,
+			discordbridgelink.Table:       discordbridgelink.ValidColumn,
+			email.Table:                   email.ValidColumn,
+			event.Table:                   event.ValidColumn,
+			eventparticipant.Table:        eventparticipant.ValidColumn,
+			featureflag.Table:             featureflag.ValidColumn,
+			federationfollower.Table:      federationfollower.ValidColumn,
+			federationkey.Table:           federationkey.ValidColumn,
+			feedtoken.Table:               feedtoken.ValidColumn,
+			importrecord.Table:            importrecord.ValidColumn,
+			importrun.Table:               importrun.ValidColumn,
+			invitation.Table:              invitation.ValidColumn,
+			job.Table:                     job.ValidColumn,
+			likepost.Table:                likepost.ValidColumn,
+			link.Table:                    link.ValidColumn,
+			linkcheck.Table:               linkcheck.ValidColumn,
+			matrixbridgelink.Table:        matrixbridgelink.ValidColumn,
+			mentionprofile.Table:          mentionprofile.ValidColumn,
+			moderationnote.Table:          moderationnote.ValidColumn,
+			networkban.Table:              networkban.ValidColumn,
+			node.Table:                    node.ValidColumn,
+			notification.Table:            notification.ValidColumn,
+			notificationpreference.Table:  notificationpreference.ValidColumn,
+			policyacceptance.Table:        policyacceptance.ValidColumn,
+			policydocument.Table:          policydocument.ValidColumn,
+			policyversion.Table:           policyversion.ValidColumn,
+			post.Table:                    post.ValidColumn,
+			postread.Table:                postread.ValidColumn,
+			property.Table:                property.ValidColumn,
+			propertyschema.Table:          propertyschema.ValidColumn,
+			propertyschemafield.Table:     propertyschemafield.ValidColumn,
+			pushsubscription.Table:        pushsubscription.ValidColumn,
+			question.Table:                question.ValidColumn,
+			react.Table:                   react.ValidColumn,
+			readinghistory.Table:          readinghistory.ValidColumn,
+			report.Table:                  report.ValidColumn,
+			reputationentry.Table:         reputationentry.ValidColumn,
+			role.Table:                    role.ValidColumn,
+			scheduledtask.Table:           scheduledtask.ValidColumn,
+			session.Table:                 session.ValidColumn,
+			setting.Table:                 setting.ValidColumn,
+			space.Table:                   space.ValidColumn,
+			spacemember.Table:             spacemember.ValidColumn,
+			tag.Table:                     tag.ValidColumn,
+			tagfollow.Table:               tagfollow.ValidColumn,
+			tagsynonym.Table:              tagsynonym.ValidColumn,
+			telegrammessage.Table:         telegrammessage.ValidColumn,
+			tenant.Table:                  tenant.ValidColumn,
+			trendingscore.Table:           trendingscore.ValidColumn,
+			uploadsession.Table:           uploadsession.ValidColumn,
+			verificationrequest.Table:     verificationrequest.ValidColumn,
+			warning.Table:                 warning.ValidColumn,
+			webhook.Table:                 webhook.ValidColumn,
+			webhookdelivery.Table:         webhookdelivery.ValidColumn,
+			wordfilter.Table:              wordfilter.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
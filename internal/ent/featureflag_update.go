@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/featureflag"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// FeatureFlagUpdate is the builder for updating FeatureFlag entities.
+type FeatureFlagUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FeatureFlagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FeatureFlagUpdate builder.
+func (_u *FeatureFlagUpdate) Where(ps ...predicate.FeatureFlag) *FeatureFlagUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FeatureFlagUpdate) SetUpdatedAt(v time.Time) *FeatureFlagUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *FeatureFlagUpdate) SetKey(v string) *FeatureFlagUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *FeatureFlagUpdate) SetNillableKey(v *string) *FeatureFlagUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *FeatureFlagUpdate) SetDescription(v string) *FeatureFlagUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *FeatureFlagUpdate) SetNillableDescription(v *string) *FeatureFlagUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *FeatureFlagUpdate) ClearDescription() *FeatureFlagUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *FeatureFlagUpdate) SetEnabled(v bool) *FeatureFlagUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *FeatureFlagUpdate) SetNillableEnabled(v *bool) *FeatureFlagUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *FeatureFlagUpdate) SetPercentage(v int) *FeatureFlagUpdate {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *FeatureFlagUpdate) SetNillablePercentage(v *int) *FeatureFlagUpdate {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *FeatureFlagUpdate) AddPercentage(v int) *FeatureFlagUpdate {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *FeatureFlagUpdate) SetRoles(v []string) *FeatureFlagUpdate {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *FeatureFlagUpdate) AppendRoles(v []string) *FeatureFlagUpdate {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (_u *FeatureFlagUpdate) ClearRoles() *FeatureFlagUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// SetAccounts sets the "accounts" field.
+func (_u *FeatureFlagUpdate) SetAccounts(v []string) *FeatureFlagUpdate {
+	_u.mutation.SetAccounts(v)
+	return _u
+}
+
+// AppendAccounts appends value to the "accounts" field.
+func (_u *FeatureFlagUpdate) AppendAccounts(v []string) *FeatureFlagUpdate {
+	_u.mutation.AppendAccounts(v)
+	return _u
+}
+
+// ClearAccounts clears the value of the "accounts" field.
+func (_u *FeatureFlagUpdate) ClearAccounts() *FeatureFlagUpdate {
+	_u.mutation.ClearAccounts()
+	return _u
+}
+
+// Mutation returns the FeatureFlagMutation object of the builder.
+func (_u *FeatureFlagUpdate) Mutation() *FeatureFlagMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FeatureFlagUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeatureFlagUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FeatureFlagUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeatureFlagUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FeatureFlagUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := featureflag.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FeatureFlagUpdate) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := featureflag.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "FeatureFlag.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FeatureFlagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FeatureFlagUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FeatureFlagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(featureflag.Table, featureflag.Columns, sqlgraph.NewFieldSpec(featureflag.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(featureflag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(featureflag.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(featureflag.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(featureflag.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(featureflag.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(featureflag.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(featureflag.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(featureflag.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflag.FieldRoles, value)
+		})
+	}
+	if _u.mutation.RolesCleared() {
+		_spec.ClearField(featureflag.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Accounts(); ok {
+		_spec.SetField(featureflag.FieldAccounts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAccounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflag.FieldAccounts, value)
+		})
+	}
+	if _u.mutation.AccountsCleared() {
+		_spec.ClearField(featureflag.FieldAccounts, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{featureflag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FeatureFlagUpdateOne is the builder for updating a single FeatureFlag entity.
+type FeatureFlagUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FeatureFlagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FeatureFlagUpdateOne) SetUpdatedAt(v time.Time) *FeatureFlagUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *FeatureFlagUpdateOne) SetKey(v string) *FeatureFlagUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *FeatureFlagUpdateOne) SetNillableKey(v *string) *FeatureFlagUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *FeatureFlagUpdateOne) SetDescription(v string) *FeatureFlagUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *FeatureFlagUpdateOne) SetNillableDescription(v *string) *FeatureFlagUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *FeatureFlagUpdateOne) ClearDescription() *FeatureFlagUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *FeatureFlagUpdateOne) SetEnabled(v bool) *FeatureFlagUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *FeatureFlagUpdateOne) SetNillableEnabled(v *bool) *FeatureFlagUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *FeatureFlagUpdateOne) SetPercentage(v int) *FeatureFlagUpdateOne {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *FeatureFlagUpdateOne) SetNillablePercentage(v *int) *FeatureFlagUpdateOne {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *FeatureFlagUpdateOne) AddPercentage(v int) *FeatureFlagUpdateOne {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *FeatureFlagUpdateOne) SetRoles(v []string) *FeatureFlagUpdateOne {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *FeatureFlagUpdateOne) AppendRoles(v []string) *FeatureFlagUpdateOne {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (_u *FeatureFlagUpdateOne) ClearRoles() *FeatureFlagUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// SetAccounts sets the "accounts" field.
+func (_u *FeatureFlagUpdateOne) SetAccounts(v []string) *FeatureFlagUpdateOne {
+	_u.mutation.SetAccounts(v)
+	return _u
+}
+
+// AppendAccounts appends value to the "accounts" field.
+func (_u *FeatureFlagUpdateOne) AppendAccounts(v []string) *FeatureFlagUpdateOne {
+	_u.mutation.AppendAccounts(v)
+	return _u
+}
+
+// ClearAccounts clears the value of the "accounts" field.
+func (_u *FeatureFlagUpdateOne) ClearAccounts() *FeatureFlagUpdateOne {
+	_u.mutation.ClearAccounts()
+	return _u
+}
+
+// Mutation returns the FeatureFlagMutation object of the builder.
+func (_u *FeatureFlagUpdateOne) Mutation() *FeatureFlagMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FeatureFlagUpdate builder.
+func (_u *FeatureFlagUpdateOne) Where(ps ...predicate.FeatureFlag) *FeatureFlagUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FeatureFlagUpdateOne) Select(field string, fields ...string) *FeatureFlagUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FeatureFlag entity.
+func (_u *FeatureFlagUpdateOne) Save(ctx context.Context) (*FeatureFlag, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeatureFlagUpdateOne) SaveX(ctx context.Context) *FeatureFlag {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FeatureFlagUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeatureFlagUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FeatureFlagUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := featureflag.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FeatureFlagUpdateOne) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := featureflag.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "FeatureFlag.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FeatureFlagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FeatureFlagUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FeatureFlagUpdateOne) sqlSave(ctx context.Context) (_node *FeatureFlag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(featureflag.Table, featureflag.Columns, sqlgraph.NewFieldSpec(featureflag.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FeatureFlag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, featureflag.FieldID)
+		for _, f := range fields {
+			if !featureflag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != featureflag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(featureflag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(featureflag.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(featureflag.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(featureflag.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(featureflag.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(featureflag.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(featureflag.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(featureflag.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflag.FieldRoles, value)
+		})
+	}
+	if _u.mutation.RolesCleared() {
+		_spec.ClearField(featureflag.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Accounts(); ok {
+		_spec.SetField(featureflag.FieldAccounts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAccounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflag.FieldAccounts, value)
+		})
+	}
+	if _u.mutation.AccountsCleared() {
+		_spec.ClearField(featureflag.FieldAccounts, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FeatureFlag{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{featureflag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
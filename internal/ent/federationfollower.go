@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+	"github.com/rs/xid"
+)
+
+// FederationFollower is the model entity for the FederationFollower schema.
+type FederationFollower struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// LocalActor holds the value of the "local_actor" field.
+	LocalActor string `json:"local_actor,omitempty"`
+	// ActorURI holds the value of the "actor_uri" field.
+	ActorURI string `json:"actor_uri,omitempty"`
+	// Inbox holds the value of the "inbox" field.
+	Inbox string `json:"inbox,omitempty"`
+	// SharedInbox holds the value of the "shared_inbox" field.
+	SharedInbox  *string `json:"shared_inbox,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FederationFollower) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case federationfollower.FieldLocalActor, federationfollower.FieldActorURI, federationfollower.FieldInbox, federationfollower.FieldSharedInbox:
+			values[i] = new(sql.NullString)
+		case federationfollower.FieldCreatedAt, federationfollower.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case federationfollower.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FederationFollower fields.
+func (_m *FederationFollower) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case federationfollower.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case federationfollower.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case federationfollower.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case federationfollower.FieldLocalActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field local_actor", values[i])
+			} else if value.Valid {
+				_m.LocalActor = value.String
+			}
+		case federationfollower.FieldActorURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_uri", values[i])
+			} else if value.Valid {
+				_m.ActorURI = value.String
+			}
+		case federationfollower.FieldInbox:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inbox", values[i])
+			} else if value.Valid {
+				_m.Inbox = value.String
+			}
+		case federationfollower.FieldSharedInbox:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shared_inbox", values[i])
+			} else if value.Valid {
+				_m.SharedInbox = new(string)
+				*_m.SharedInbox = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FederationFollower.
+// This includes values selected through modifiers, order, etc.
+func (_m *FederationFollower) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FederationFollower.
+// Note that you need to call FederationFollower.Unwrap() before calling this method if this FederationFollower
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FederationFollower) Update() *FederationFollowerUpdateOne {
+	return NewFederationFollowerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FederationFollower entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FederationFollower) Unwrap() *FederationFollower {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FederationFollower is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FederationFollower) String() string {
+	var builder strings.Builder
+	builder.WriteString("FederationFollower(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("local_actor=")
+	builder.WriteString(_m.LocalActor)
+	builder.WriteString(", ")
+	builder.WriteString("actor_uri=")
+	builder.WriteString(_m.ActorURI)
+	builder.WriteString(", ")
+	builder.WriteString("inbox=")
+	builder.WriteString(_m.Inbox)
+	builder.WriteString(", ")
+	if v := _m.SharedInbox; v != nil {
+		builder.WriteString("shared_inbox=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FederationFollowers is a parsable slice of FederationFollower.
+type FederationFollowers []*FederationFollower
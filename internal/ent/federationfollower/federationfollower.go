@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package federationfollower
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the federationfollower type in the database.
+	Label = "federation_follower"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldLocalActor holds the string denoting the local_actor field in the database.
+	FieldLocalActor = "local_actor"
+	// FieldActorURI holds the string denoting the actor_uri field in the database.
+	FieldActorURI = "actor_uri"
+	// FieldInbox holds the string denoting the inbox field in the database.
+	FieldInbox = "inbox"
+	// FieldSharedInbox holds the string denoting the shared_inbox field in the database.
+	FieldSharedInbox = "shared_inbox"
+	// Table holds the table name of the federationfollower in the database.
+	Table = "federation_followers"
+)
+
+// Columns holds all SQL columns for federationfollower fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldLocalActor,
+	FieldActorURI,
+	FieldInbox,
+	FieldSharedInbox,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the FederationFollower queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByLocalActor orders the results by the local_actor field.
+func ByLocalActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocalActor, opts...).ToFunc()
+}
+
+// ByActorURI orders the results by the actor_uri field.
+func ByActorURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorURI, opts...).ToFunc()
+}
+
+// ByInbox orders the results by the inbox field.
+func ByInbox(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInbox, opts...).ToFunc()
+}
+
+// BySharedInbox orders the results by the shared_inbox field.
+func BySharedInbox(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSharedInbox, opts...).ToFunc()
+}
@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package federationfollower
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LocalActor applies equality check predicate on the "local_actor" field. It's identical to LocalActorEQ.
+func LocalActor(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldLocalActor, v))
+}
+
+// ActorURI applies equality check predicate on the "actor_uri" field. It's identical to ActorURIEQ.
+func ActorURI(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldActorURI, v))
+}
+
+// Inbox applies equality check predicate on the "inbox" field. It's identical to InboxEQ.
+func Inbox(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldInbox, v))
+}
+
+// SharedInbox applies equality check predicate on the "shared_inbox" field. It's identical to SharedInboxEQ.
+func SharedInbox(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldSharedInbox, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// LocalActorEQ applies the EQ predicate on the "local_actor" field.
+func LocalActorEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldLocalActor, v))
+}
+
+// LocalActorNEQ applies the NEQ predicate on the "local_actor" field.
+func LocalActorNEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNEQ(FieldLocalActor, v))
+}
+
+// LocalActorIn applies the In predicate on the "local_actor" field.
+func LocalActorIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIn(FieldLocalActor, vs...))
+}
+
+// LocalActorNotIn applies the NotIn predicate on the "local_actor" field.
+func LocalActorNotIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotIn(FieldLocalActor, vs...))
+}
+
+// LocalActorGT applies the GT predicate on the "local_actor" field.
+func LocalActorGT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGT(FieldLocalActor, v))
+}
+
+// LocalActorGTE applies the GTE predicate on the "local_actor" field.
+func LocalActorGTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGTE(FieldLocalActor, v))
+}
+
+// LocalActorLT applies the LT predicate on the "local_actor" field.
+func LocalActorLT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLT(FieldLocalActor, v))
+}
+
+// LocalActorLTE applies the LTE predicate on the "local_actor" field.
+func LocalActorLTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLTE(FieldLocalActor, v))
+}
+
+// LocalActorContains applies the Contains predicate on the "local_actor" field.
+func LocalActorContains(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContains(FieldLocalActor, v))
+}
+
+// LocalActorHasPrefix applies the HasPrefix predicate on the "local_actor" field.
+func LocalActorHasPrefix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasPrefix(FieldLocalActor, v))
+}
+
+// LocalActorHasSuffix applies the HasSuffix predicate on the "local_actor" field.
+func LocalActorHasSuffix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasSuffix(FieldLocalActor, v))
+}
+
+// LocalActorEqualFold applies the EqualFold predicate on the "local_actor" field.
+func LocalActorEqualFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEqualFold(FieldLocalActor, v))
+}
+
+// LocalActorContainsFold applies the ContainsFold predicate on the "local_actor" field.
+func LocalActorContainsFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContainsFold(FieldLocalActor, v))
+}
+
+// ActorURIEQ applies the EQ predicate on the "actor_uri" field.
+func ActorURIEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldActorURI, v))
+}
+
+// ActorURINEQ applies the NEQ predicate on the "actor_uri" field.
+func ActorURINEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNEQ(FieldActorURI, v))
+}
+
+// ActorURIIn applies the In predicate on the "actor_uri" field.
+func ActorURIIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIn(FieldActorURI, vs...))
+}
+
+// ActorURINotIn applies the NotIn predicate on the "actor_uri" field.
+func ActorURINotIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotIn(FieldActorURI, vs...))
+}
+
+// ActorURIGT applies the GT predicate on the "actor_uri" field.
+func ActorURIGT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGT(FieldActorURI, v))
+}
+
+// ActorURIGTE applies the GTE predicate on the "actor_uri" field.
+func ActorURIGTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGTE(FieldActorURI, v))
+}
+
+// ActorURILT applies the LT predicate on the "actor_uri" field.
+func ActorURILT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLT(FieldActorURI, v))
+}
+
+// ActorURILTE applies the LTE predicate on the "actor_uri" field.
+func ActorURILTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLTE(FieldActorURI, v))
+}
+
+// ActorURIContains applies the Contains predicate on the "actor_uri" field.
+func ActorURIContains(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContains(FieldActorURI, v))
+}
+
+// ActorURIHasPrefix applies the HasPrefix predicate on the "actor_uri" field.
+func ActorURIHasPrefix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasPrefix(FieldActorURI, v))
+}
+
+// ActorURIHasSuffix applies the HasSuffix predicate on the "actor_uri" field.
+func ActorURIHasSuffix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasSuffix(FieldActorURI, v))
+}
+
+// ActorURIEqualFold applies the EqualFold predicate on the "actor_uri" field.
+func ActorURIEqualFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEqualFold(FieldActorURI, v))
+}
+
+// ActorURIContainsFold applies the ContainsFold predicate on the "actor_uri" field.
+func ActorURIContainsFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContainsFold(FieldActorURI, v))
+}
+
+// InboxEQ applies the EQ predicate on the "inbox" field.
+func InboxEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldInbox, v))
+}
+
+// InboxNEQ applies the NEQ predicate on the "inbox" field.
+func InboxNEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNEQ(FieldInbox, v))
+}
+
+// InboxIn applies the In predicate on the "inbox" field.
+func InboxIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIn(FieldInbox, vs...))
+}
+
+// InboxNotIn applies the NotIn predicate on the "inbox" field.
+func InboxNotIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotIn(FieldInbox, vs...))
+}
+
+// InboxGT applies the GT predicate on the "inbox" field.
+func InboxGT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGT(FieldInbox, v))
+}
+
+// InboxGTE applies the GTE predicate on the "inbox" field.
+func InboxGTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGTE(FieldInbox, v))
+}
+
+// InboxLT applies the LT predicate on the "inbox" field.
+func InboxLT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLT(FieldInbox, v))
+}
+
+// InboxLTE applies the LTE predicate on the "inbox" field.
+func InboxLTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLTE(FieldInbox, v))
+}
+
+// InboxContains applies the Contains predicate on the "inbox" field.
+func InboxContains(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContains(FieldInbox, v))
+}
+
+// InboxHasPrefix applies the HasPrefix predicate on the "inbox" field.
+func InboxHasPrefix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasPrefix(FieldInbox, v))
+}
+
+// InboxHasSuffix applies the HasSuffix predicate on the "inbox" field.
+func InboxHasSuffix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasSuffix(FieldInbox, v))
+}
+
+// InboxEqualFold applies the EqualFold predicate on the "inbox" field.
+func InboxEqualFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEqualFold(FieldInbox, v))
+}
+
+// InboxContainsFold applies the ContainsFold predicate on the "inbox" field.
+func InboxContainsFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContainsFold(FieldInbox, v))
+}
+
+// SharedInboxEQ applies the EQ predicate on the "shared_inbox" field.
+func SharedInboxEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEQ(FieldSharedInbox, v))
+}
+
+// SharedInboxNEQ applies the NEQ predicate on the "shared_inbox" field.
+func SharedInboxNEQ(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNEQ(FieldSharedInbox, v))
+}
+
+// SharedInboxIn applies the In predicate on the "shared_inbox" field.
+func SharedInboxIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIn(FieldSharedInbox, vs...))
+}
+
+// SharedInboxNotIn applies the NotIn predicate on the "shared_inbox" field.
+func SharedInboxNotIn(vs ...string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotIn(FieldSharedInbox, vs...))
+}
+
+// SharedInboxGT applies the GT predicate on the "shared_inbox" field.
+func SharedInboxGT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGT(FieldSharedInbox, v))
+}
+
+// SharedInboxGTE applies the GTE predicate on the "shared_inbox" field.
+func SharedInboxGTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldGTE(FieldSharedInbox, v))
+}
+
+// SharedInboxLT applies the LT predicate on the "shared_inbox" field.
+func SharedInboxLT(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLT(FieldSharedInbox, v))
+}
+
+// SharedInboxLTE applies the LTE predicate on the "shared_inbox" field.
+func SharedInboxLTE(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldLTE(FieldSharedInbox, v))
+}
+
+// SharedInboxContains applies the Contains predicate on the "shared_inbox" field.
+func SharedInboxContains(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContains(FieldSharedInbox, v))
+}
+
+// SharedInboxHasPrefix applies the HasPrefix predicate on the "shared_inbox" field.
+func SharedInboxHasPrefix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasPrefix(FieldSharedInbox, v))
+}
+
+// SharedInboxHasSuffix applies the HasSuffix predicate on the "shared_inbox" field.
+func SharedInboxHasSuffix(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldHasSuffix(FieldSharedInbox, v))
+}
+
+// SharedInboxIsNil applies the IsNil predicate on the "shared_inbox" field.
+func SharedInboxIsNil() predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldIsNull(FieldSharedInbox))
+}
+
+// SharedInboxNotNil applies the NotNil predicate on the "shared_inbox" field.
+func SharedInboxNotNil() predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldNotNull(FieldSharedInbox))
+}
+
+// SharedInboxEqualFold applies the EqualFold predicate on the "shared_inbox" field.
+func SharedInboxEqualFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldEqualFold(FieldSharedInbox, v))
+}
+
+// SharedInboxContainsFold applies the ContainsFold predicate on the "shared_inbox" field.
+func SharedInboxContainsFold(v string) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.FieldContainsFold(FieldSharedInbox, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FederationFollower) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FederationFollower) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FederationFollower) predicate.FederationFollower {
+	return predicate.FederationFollower(sql.NotPredicates(p))
+}
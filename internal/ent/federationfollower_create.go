@@ -0,0 +1,794 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+	"github.com/rs/xid"
+)
+
+// FederationFollowerCreate is the builder for creating a FederationFollower entity.
+type FederationFollowerCreate struct {
+	config
+	mutation *FederationFollowerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FederationFollowerCreate) SetCreatedAt(v time.Time) *FederationFollowerCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FederationFollowerCreate) SetNillableCreatedAt(v *time.Time) *FederationFollowerCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FederationFollowerCreate) SetUpdatedAt(v time.Time) *FederationFollowerCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FederationFollowerCreate) SetNillableUpdatedAt(v *time.Time) *FederationFollowerCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (_c *FederationFollowerCreate) SetLocalActor(v string) *FederationFollowerCreate {
+	_c.mutation.SetLocalActor(v)
+	return _c
+}
+
+// SetActorURI sets the "actor_uri" field.
+func (_c *FederationFollowerCreate) SetActorURI(v string) *FederationFollowerCreate {
+	_c.mutation.SetActorURI(v)
+	return _c
+}
+
+// SetInbox sets the "inbox" field.
+func (_c *FederationFollowerCreate) SetInbox(v string) *FederationFollowerCreate {
+	_c.mutation.SetInbox(v)
+	return _c
+}
+
+// SetSharedInbox sets the "shared_inbox" field.
+func (_c *FederationFollowerCreate) SetSharedInbox(v string) *FederationFollowerCreate {
+	_c.mutation.SetSharedInbox(v)
+	return _c
+}
+
+// SetNillableSharedInbox sets the "shared_inbox" field if the given value is not nil.
+func (_c *FederationFollowerCreate) SetNillableSharedInbox(v *string) *FederationFollowerCreate {
+	if v != nil {
+		_c.SetSharedInbox(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FederationFollowerCreate) SetID(v xid.ID) *FederationFollowerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FederationFollowerCreate) SetNillableID(v *xid.ID) *FederationFollowerCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FederationFollowerMutation object of the builder.
+func (_c *FederationFollowerCreate) Mutation() *FederationFollowerMutation {
+	return _c.mutation
+}
+
+// Save creates the FederationFollower in the database.
+func (_c *FederationFollowerCreate) Save(ctx context.Context) (*FederationFollower, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FederationFollowerCreate) SaveX(ctx context.Context) *FederationFollower {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FederationFollowerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FederationFollowerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FederationFollowerCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := federationfollower.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := federationfollower.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := federationfollower.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FederationFollowerCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FederationFollower.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FederationFollower.updated_at"`)}
+	}
+	if _, ok := _c.mutation.LocalActor(); !ok {
+		return &ValidationError{Name: "local_actor", err: errors.New(`ent: missing required field "FederationFollower.local_actor"`)}
+	}
+	if _, ok := _c.mutation.ActorURI(); !ok {
+		return &ValidationError{Name: "actor_uri", err: errors.New(`ent: missing required field "FederationFollower.actor_uri"`)}
+	}
+	if _, ok := _c.mutation.Inbox(); !ok {
+		return &ValidationError{Name: "inbox", err: errors.New(`ent: missing required field "FederationFollower.inbox"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := federationfollower.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "FederationFollower.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *FederationFollowerCreate) sqlSave(ctx context.Context) (*FederationFollower, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FederationFollowerCreate) createSpec() (*FederationFollower, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FederationFollower{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(federationfollower.Table, sqlgraph.NewFieldSpec(federationfollower.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(federationfollower.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(federationfollower.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.LocalActor(); ok {
+		_spec.SetField(federationfollower.FieldLocalActor, field.TypeString, value)
+		_node.LocalActor = value
+	}
+	if value, ok := _c.mutation.ActorURI(); ok {
+		_spec.SetField(federationfollower.FieldActorURI, field.TypeString, value)
+		_node.ActorURI = value
+	}
+	if value, ok := _c.mutation.Inbox(); ok {
+		_spec.SetField(federationfollower.FieldInbox, field.TypeString, value)
+		_node.Inbox = value
+	}
+	if value, ok := _c.mutation.SharedInbox(); ok {
+		_spec.SetField(federationfollower.FieldSharedInbox, field.TypeString, value)
+		_node.SharedInbox = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FederationFollower.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FederationFollowerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FederationFollowerCreate) OnConflict(opts ...sql.ConflictOption) *FederationFollowerUpsertOne {
+	_c.conflict = opts
+	return &FederationFollowerUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FederationFollower.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FederationFollowerCreate) OnConflictColumns(columns ...string) *FederationFollowerUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FederationFollowerUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// FederationFollowerUpsertOne is the builder for "upsert"-ing
+	//  one FederationFollower node.
+	FederationFollowerUpsertOne struct {
+		create *FederationFollowerCreate
+	}
+
+	// FederationFollowerUpsert is the "OnConflict" setter.
+	FederationFollowerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FederationFollowerUpsert) SetUpdatedAt(v time.Time) *FederationFollowerUpsert {
+	u.Set(federationfollower.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FederationFollowerUpsert) UpdateUpdatedAt() *FederationFollowerUpsert {
+	u.SetExcluded(federationfollower.FieldUpdatedAt)
+	return u
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (u *FederationFollowerUpsert) SetLocalActor(v string) *FederationFollowerUpsert {
+	u.Set(federationfollower.FieldLocalActor, v)
+	return u
+}
+
+// UpdateLocalActor sets the "local_actor" field to the value that was provided on create.
+func (u *FederationFollowerUpsert) UpdateLocalActor() *FederationFollowerUpsert {
+	u.SetExcluded(federationfollower.FieldLocalActor)
+	return u
+}
+
+// SetActorURI sets the "actor_uri" field.
+func (u *FederationFollowerUpsert) SetActorURI(v string) *FederationFollowerUpsert {
+	u.Set(federationfollower.FieldActorURI, v)
+	return u
+}
+
+// UpdateActorURI sets the "actor_uri" field to the value that was provided on create.
+func (u *FederationFollowerUpsert) UpdateActorURI() *FederationFollowerUpsert {
+	u.SetExcluded(federationfollower.FieldActorURI)
+	return u
+}
+
+// SetInbox sets the "inbox" field.
+func (u *FederationFollowerUpsert) SetInbox(v string) *FederationFollowerUpsert {
+	u.Set(federationfollower.FieldInbox, v)
+	return u
+}
+
+// UpdateInbox sets the "inbox" field to the value that was provided on create.
+func (u *FederationFollowerUpsert) UpdateInbox() *FederationFollowerUpsert {
+	u.SetExcluded(federationfollower.FieldInbox)
+	return u
+}
+
+// SetSharedInbox sets the "shared_inbox" field.
+func (u *FederationFollowerUpsert) SetSharedInbox(v string) *FederationFollowerUpsert {
+	u.Set(federationfollower.FieldSharedInbox, v)
+	return u
+}
+
+// UpdateSharedInbox sets the "shared_inbox" field to the value that was provided on create.
+func (u *FederationFollowerUpsert) UpdateSharedInbox() *FederationFollowerUpsert {
+	u.SetExcluded(federationfollower.FieldSharedInbox)
+	return u
+}
+
+// ClearSharedInbox clears the value of the "shared_inbox" field.
+func (u *FederationFollowerUpsert) ClearSharedInbox() *FederationFollowerUpsert {
+	u.SetNull(federationfollower.FieldSharedInbox)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.FederationFollower.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(federationfollower.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FederationFollowerUpsertOne) UpdateNewValues() *FederationFollowerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(federationfollower.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(federationfollower.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FederationFollower.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FederationFollowerUpsertOne) Ignore() *FederationFollowerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FederationFollowerUpsertOne) DoNothing() *FederationFollowerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FederationFollowerCreate.OnConflict
+// documentation for more info.
+func (u *FederationFollowerUpsertOne) Update(set func(*FederationFollowerUpsert)) *FederationFollowerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FederationFollowerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FederationFollowerUpsertOne) SetUpdatedAt(v time.Time) *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FederationFollowerUpsertOne) UpdateUpdatedAt() *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (u *FederationFollowerUpsertOne) SetLocalActor(v string) *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetLocalActor(v)
+	})
+}
+
+// UpdateLocalActor sets the "local_actor" field to the value that was provided on create.
+func (u *FederationFollowerUpsertOne) UpdateLocalActor() *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateLocalActor()
+	})
+}
+
+// SetActorURI sets the "actor_uri" field.
+func (u *FederationFollowerUpsertOne) SetActorURI(v string) *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetActorURI(v)
+	})
+}
+
+// UpdateActorURI sets the "actor_uri" field to the value that was provided on create.
+func (u *FederationFollowerUpsertOne) UpdateActorURI() *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateActorURI()
+	})
+}
+
+// SetInbox sets the "inbox" field.
+func (u *FederationFollowerUpsertOne) SetInbox(v string) *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetInbox(v)
+	})
+}
+
+// UpdateInbox sets the "inbox" field to the value that was provided on create.
+func (u *FederationFollowerUpsertOne) UpdateInbox() *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateInbox()
+	})
+}
+
+// SetSharedInbox sets the "shared_inbox" field.
+func (u *FederationFollowerUpsertOne) SetSharedInbox(v string) *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetSharedInbox(v)
+	})
+}
+
+// UpdateSharedInbox sets the "shared_inbox" field to the value that was provided on create.
+func (u *FederationFollowerUpsertOne) UpdateSharedInbox() *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateSharedInbox()
+	})
+}
+
+// ClearSharedInbox clears the value of the "shared_inbox" field.
+func (u *FederationFollowerUpsertOne) ClearSharedInbox() *FederationFollowerUpsertOne {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.ClearSharedInbox()
+	})
+}
+
+// Exec executes the query.
+func (u *FederationFollowerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FederationFollowerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FederationFollowerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FederationFollowerUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: FederationFollowerUpsertOne.ID is not supported by MySQL driver. Use FederationFollowerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FederationFollowerUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FederationFollowerCreateBulk is the builder for creating many FederationFollower entities in bulk.
+type FederationFollowerCreateBulk struct {
+	config
+	err      error
+	builders []*FederationFollowerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FederationFollower entities in the database.
+func (_c *FederationFollowerCreateBulk) Save(ctx context.Context) ([]*FederationFollower, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FederationFollower, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FederationFollowerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FederationFollowerCreateBulk) SaveX(ctx context.Context) []*FederationFollower {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FederationFollowerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FederationFollowerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FederationFollower.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FederationFollowerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FederationFollowerCreateBulk) OnConflict(opts ...sql.ConflictOption) *FederationFollowerUpsertBulk {
+	_c.conflict = opts
+	return &FederationFollowerUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FederationFollower.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FederationFollowerCreateBulk) OnConflictColumns(columns ...string) *FederationFollowerUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FederationFollowerUpsertBulk{
+		create: _c,
+	}
+}
+
+// FederationFollowerUpsertBulk is the builder for "upsert"-ing
+// a bulk of FederationFollower nodes.
+type FederationFollowerUpsertBulk struct {
+	create *FederationFollowerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FederationFollower.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(federationfollower.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FederationFollowerUpsertBulk) UpdateNewValues() *FederationFollowerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(federationfollower.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(federationfollower.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FederationFollower.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FederationFollowerUpsertBulk) Ignore() *FederationFollowerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FederationFollowerUpsertBulk) DoNothing() *FederationFollowerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FederationFollowerCreateBulk.OnConflict
+// documentation for more info.
+func (u *FederationFollowerUpsertBulk) Update(set func(*FederationFollowerUpsert)) *FederationFollowerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FederationFollowerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FederationFollowerUpsertBulk) SetUpdatedAt(v time.Time) *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FederationFollowerUpsertBulk) UpdateUpdatedAt() *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (u *FederationFollowerUpsertBulk) SetLocalActor(v string) *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetLocalActor(v)
+	})
+}
+
+// UpdateLocalActor sets the "local_actor" field to the value that was provided on create.
+func (u *FederationFollowerUpsertBulk) UpdateLocalActor() *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateLocalActor()
+	})
+}
+
+// SetActorURI sets the "actor_uri" field.
+func (u *FederationFollowerUpsertBulk) SetActorURI(v string) *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetActorURI(v)
+	})
+}
+
+// UpdateActorURI sets the "actor_uri" field to the value that was provided on create.
+func (u *FederationFollowerUpsertBulk) UpdateActorURI() *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateActorURI()
+	})
+}
+
+// SetInbox sets the "inbox" field.
+func (u *FederationFollowerUpsertBulk) SetInbox(v string) *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetInbox(v)
+	})
+}
+
+// UpdateInbox sets the "inbox" field to the value that was provided on create.
+func (u *FederationFollowerUpsertBulk) UpdateInbox() *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateInbox()
+	})
+}
+
+// SetSharedInbox sets the "shared_inbox" field.
+func (u *FederationFollowerUpsertBulk) SetSharedInbox(v string) *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.SetSharedInbox(v)
+	})
+}
+
+// UpdateSharedInbox sets the "shared_inbox" field to the value that was provided on create.
+func (u *FederationFollowerUpsertBulk) UpdateSharedInbox() *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.UpdateSharedInbox()
+	})
+}
+
+// ClearSharedInbox clears the value of the "shared_inbox" field.
+func (u *FederationFollowerUpsertBulk) ClearSharedInbox() *FederationFollowerUpsertBulk {
+	return u.Update(func(s *FederationFollowerUpsert) {
+		s.ClearSharedInbox()
+	})
+}
+
+// Exec executes the query.
+func (u *FederationFollowerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FederationFollowerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FederationFollowerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FederationFollowerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// FederationFollowerDelete is the builder for deleting a FederationFollower entity.
+type FederationFollowerDelete struct {
+	config
+	hooks    []Hook
+	mutation *FederationFollowerMutation
+}
+
+// Where appends a list predicates to the FederationFollowerDelete builder.
+func (_d *FederationFollowerDelete) Where(ps ...predicate.FederationFollower) *FederationFollowerDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FederationFollowerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FederationFollowerDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FederationFollowerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(federationfollower.Table, sqlgraph.NewFieldSpec(federationfollower.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FederationFollowerDeleteOne is the builder for deleting a single FederationFollower entity.
+type FederationFollowerDeleteOne struct {
+	_d *FederationFollowerDelete
+}
+
+// Where appends a list predicates to the FederationFollowerDelete builder.
+func (_d *FederationFollowerDeleteOne) Where(ps ...predicate.FederationFollower) *FederationFollowerDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FederationFollowerDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{federationfollower.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FederationFollowerDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
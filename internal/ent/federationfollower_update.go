@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// FederationFollowerUpdate is the builder for updating FederationFollower entities.
+type FederationFollowerUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FederationFollowerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FederationFollowerUpdate builder.
+func (_u *FederationFollowerUpdate) Where(ps ...predicate.FederationFollower) *FederationFollowerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FederationFollowerUpdate) SetUpdatedAt(v time.Time) *FederationFollowerUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (_u *FederationFollowerUpdate) SetLocalActor(v string) *FederationFollowerUpdate {
+	_u.mutation.SetLocalActor(v)
+	return _u
+}
+
+// SetNillableLocalActor sets the "local_actor" field if the given value is not nil.
+func (_u *FederationFollowerUpdate) SetNillableLocalActor(v *string) *FederationFollowerUpdate {
+	if v != nil {
+		_u.SetLocalActor(*v)
+	}
+	return _u
+}
+
+// SetActorURI sets the "actor_uri" field.
+func (_u *FederationFollowerUpdate) SetActorURI(v string) *FederationFollowerUpdate {
+	_u.mutation.SetActorURI(v)
+	return _u
+}
+
+// SetNillableActorURI sets the "actor_uri" field if the given value is not nil.
+func (_u *FederationFollowerUpdate) SetNillableActorURI(v *string) *FederationFollowerUpdate {
+	if v != nil {
+		_u.SetActorURI(*v)
+	}
+	return _u
+}
+
+// SetInbox sets the "inbox" field.
+func (_u *FederationFollowerUpdate) SetInbox(v string) *FederationFollowerUpdate {
+	_u.mutation.SetInbox(v)
+	return _u
+}
+
+// SetNillableInbox sets the "inbox" field if the given value is not nil.
+func (_u *FederationFollowerUpdate) SetNillableInbox(v *string) *FederationFollowerUpdate {
+	if v != nil {
+		_u.SetInbox(*v)
+	}
+	return _u
+}
+
+// SetSharedInbox sets the "shared_inbox" field.
+func (_u *FederationFollowerUpdate) SetSharedInbox(v string) *FederationFollowerUpdate {
+	_u.mutation.SetSharedInbox(v)
+	return _u
+}
+
+// SetNillableSharedInbox sets the "shared_inbox" field if the given value is not nil.
+func (_u *FederationFollowerUpdate) SetNillableSharedInbox(v *string) *FederationFollowerUpdate {
+	if v != nil {
+		_u.SetSharedInbox(*v)
+	}
+	return _u
+}
+
+// ClearSharedInbox clears the value of the "shared_inbox" field.
+func (_u *FederationFollowerUpdate) ClearSharedInbox() *FederationFollowerUpdate {
+	_u.mutation.ClearSharedInbox()
+	return _u
+}
+
+// Mutation returns the FederationFollowerMutation object of the builder.
+func (_u *FederationFollowerUpdate) Mutation() *FederationFollowerMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FederationFollowerUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FederationFollowerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FederationFollowerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FederationFollowerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FederationFollowerUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := federationfollower.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FederationFollowerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FederationFollowerUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FederationFollowerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(federationfollower.Table, federationfollower.Columns, sqlgraph.NewFieldSpec(federationfollower.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(federationfollower.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LocalActor(); ok {
+		_spec.SetField(federationfollower.FieldLocalActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorURI(); ok {
+		_spec.SetField(federationfollower.FieldActorURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Inbox(); ok {
+		_spec.SetField(federationfollower.FieldInbox, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SharedInbox(); ok {
+		_spec.SetField(federationfollower.FieldSharedInbox, field.TypeString, value)
+	}
+	if _u.mutation.SharedInboxCleared() {
+		_spec.ClearField(federationfollower.FieldSharedInbox, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{federationfollower.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FederationFollowerUpdateOne is the builder for updating a single FederationFollower entity.
+type FederationFollowerUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FederationFollowerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FederationFollowerUpdateOne) SetUpdatedAt(v time.Time) *FederationFollowerUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (_u *FederationFollowerUpdateOne) SetLocalActor(v string) *FederationFollowerUpdateOne {
+	_u.mutation.SetLocalActor(v)
+	return _u
+}
+
+// SetNillableLocalActor sets the "local_actor" field if the given value is not nil.
+func (_u *FederationFollowerUpdateOne) SetNillableLocalActor(v *string) *FederationFollowerUpdateOne {
+	if v != nil {
+		_u.SetLocalActor(*v)
+	}
+	return _u
+}
+
+// SetActorURI sets the "actor_uri" field.
+func (_u *FederationFollowerUpdateOne) SetActorURI(v string) *FederationFollowerUpdateOne {
+	_u.mutation.SetActorURI(v)
+	return _u
+}
+
+// SetNillableActorURI sets the "actor_uri" field if the given value is not nil.
+func (_u *FederationFollowerUpdateOne) SetNillableActorURI(v *string) *FederationFollowerUpdateOne {
+	if v != nil {
+		_u.SetActorURI(*v)
+	}
+	return _u
+}
+
+// SetInbox sets the "inbox" field.
+func (_u *FederationFollowerUpdateOne) SetInbox(v string) *FederationFollowerUpdateOne {
+	_u.mutation.SetInbox(v)
+	return _u
+}
+
+// SetNillableInbox sets the "inbox" field if the given value is not nil.
+func (_u *FederationFollowerUpdateOne) SetNillableInbox(v *string) *FederationFollowerUpdateOne {
+	if v != nil {
+		_u.SetInbox(*v)
+	}
+	return _u
+}
+
+// SetSharedInbox sets the "shared_inbox" field.
+func (_u *FederationFollowerUpdateOne) SetSharedInbox(v string) *FederationFollowerUpdateOne {
+	_u.mutation.SetSharedInbox(v)
+	return _u
+}
+
+// SetNillableSharedInbox sets the "shared_inbox" field if the given value is not nil.
+func (_u *FederationFollowerUpdateOne) SetNillableSharedInbox(v *string) *FederationFollowerUpdateOne {
+	if v != nil {
+		_u.SetSharedInbox(*v)
+	}
+	return _u
+}
+
+// ClearSharedInbox clears the value of the "shared_inbox" field.
+func (_u *FederationFollowerUpdateOne) ClearSharedInbox() *FederationFollowerUpdateOne {
+	_u.mutation.ClearSharedInbox()
+	return _u
+}
+
+// Mutation returns the FederationFollowerMutation object of the builder.
+func (_u *FederationFollowerUpdateOne) Mutation() *FederationFollowerMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FederationFollowerUpdate builder.
+func (_u *FederationFollowerUpdateOne) Where(ps ...predicate.FederationFollower) *FederationFollowerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FederationFollowerUpdateOne) Select(field string, fields ...string) *FederationFollowerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FederationFollower entity.
+func (_u *FederationFollowerUpdateOne) Save(ctx context.Context) (*FederationFollower, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FederationFollowerUpdateOne) SaveX(ctx context.Context) *FederationFollower {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FederationFollowerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FederationFollowerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FederationFollowerUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := federationfollower.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FederationFollowerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FederationFollowerUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FederationFollowerUpdateOne) sqlSave(ctx context.Context) (_node *FederationFollower, err error) {
+	_spec := sqlgraph.NewUpdateSpec(federationfollower.Table, federationfollower.Columns, sqlgraph.NewFieldSpec(federationfollower.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FederationFollower.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, federationfollower.FieldID)
+		for _, f := range fields {
+			if !federationfollower.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != federationfollower.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(federationfollower.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LocalActor(); ok {
+		_spec.SetField(federationfollower.FieldLocalActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorURI(); ok {
+		_spec.SetField(federationfollower.FieldActorURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Inbox(); ok {
+		_spec.SetField(federationfollower.FieldInbox, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SharedInbox(); ok {
+		_spec.SetField(federationfollower.FieldSharedInbox, field.TypeString, value)
+	}
+	if _u.mutation.SharedInboxCleared() {
+		_spec.ClearField(federationfollower.FieldSharedInbox, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FederationFollower{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{federationfollower.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/federationkey"
+	"github.com/rs/xid"
+)
+
+// FederationKey is the model entity for the FederationKey schema.
+type FederationKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LocalActor holds the value of the "local_actor" field.
+	LocalActor string `json:"local_actor,omitempty"`
+	// PrivateKey holds the value of the "private_key" field.
+	PrivateKey string `json:"-"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey    string `json:"public_key,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FederationKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case federationkey.FieldLocalActor, federationkey.FieldPrivateKey, federationkey.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case federationkey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case federationkey.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FederationKey fields.
+func (_m *FederationKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case federationkey.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case federationkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case federationkey.FieldLocalActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field local_actor", values[i])
+			} else if value.Valid {
+				_m.LocalActor = value.String
+			}
+		case federationkey.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				_m.PrivateKey = value.String
+			}
+		case federationkey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				_m.PublicKey = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FederationKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *FederationKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FederationKey.
+// Note that you need to call FederationKey.Unwrap() before calling this method if this FederationKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FederationKey) Update() *FederationKeyUpdateOne {
+	return NewFederationKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FederationKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FederationKey) Unwrap() *FederationKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FederationKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FederationKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("FederationKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("local_actor=")
+	builder.WriteString(_m.LocalActor)
+	builder.WriteString(", ")
+	builder.WriteString("private_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(_m.PublicKey)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FederationKeys is a parsable slice of FederationKey.
+type FederationKeys []*FederationKey
@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package federationkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LocalActor applies equality check predicate on the "local_actor" field. It's identical to LocalActorEQ.
+func LocalActor(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldLocalActor, v))
+}
+
+// PrivateKey applies equality check predicate on the "private_key" field. It's identical to PrivateKeyEQ.
+func PrivateKey(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// LocalActorEQ applies the EQ predicate on the "local_actor" field.
+func LocalActorEQ(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldLocalActor, v))
+}
+
+// LocalActorNEQ applies the NEQ predicate on the "local_actor" field.
+func LocalActorNEQ(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNEQ(FieldLocalActor, v))
+}
+
+// LocalActorIn applies the In predicate on the "local_actor" field.
+func LocalActorIn(vs ...string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldIn(FieldLocalActor, vs...))
+}
+
+// LocalActorNotIn applies the NotIn predicate on the "local_actor" field.
+func LocalActorNotIn(vs ...string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNotIn(FieldLocalActor, vs...))
+}
+
+// LocalActorGT applies the GT predicate on the "local_actor" field.
+func LocalActorGT(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGT(FieldLocalActor, v))
+}
+
+// LocalActorGTE applies the GTE predicate on the "local_actor" field.
+func LocalActorGTE(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGTE(FieldLocalActor, v))
+}
+
+// LocalActorLT applies the LT predicate on the "local_actor" field.
+func LocalActorLT(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLT(FieldLocalActor, v))
+}
+
+// LocalActorLTE applies the LTE predicate on the "local_actor" field.
+func LocalActorLTE(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLTE(FieldLocalActor, v))
+}
+
+// LocalActorContains applies the Contains predicate on the "local_actor" field.
+func LocalActorContains(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldContains(FieldLocalActor, v))
+}
+
+// LocalActorHasPrefix applies the HasPrefix predicate on the "local_actor" field.
+func LocalActorHasPrefix(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldHasPrefix(FieldLocalActor, v))
+}
+
+// LocalActorHasSuffix applies the HasSuffix predicate on the "local_actor" field.
+func LocalActorHasSuffix(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldHasSuffix(FieldLocalActor, v))
+}
+
+// LocalActorEqualFold applies the EqualFold predicate on the "local_actor" field.
+func LocalActorEqualFold(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEqualFold(FieldLocalActor, v))
+}
+
+// LocalActorContainsFold applies the ContainsFold predicate on the "local_actor" field.
+func LocalActorContainsFold(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldContainsFold(FieldLocalActor, v))
+}
+
+// PrivateKeyEQ applies the EQ predicate on the "private_key" field.
+func PrivateKeyEQ(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyNEQ applies the NEQ predicate on the "private_key" field.
+func PrivateKeyNEQ(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyIn applies the In predicate on the "private_key" field.
+func PrivateKeyIn(vs ...string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyNotIn applies the NotIn predicate on the "private_key" field.
+func PrivateKeyNotIn(vs ...string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNotIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyGT applies the GT predicate on the "private_key" field.
+func PrivateKeyGT(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGT(FieldPrivateKey, v))
+}
+
+// PrivateKeyGTE applies the GTE predicate on the "private_key" field.
+func PrivateKeyGTE(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyLT applies the LT predicate on the "private_key" field.
+func PrivateKeyLT(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLT(FieldPrivateKey, v))
+}
+
+// PrivateKeyLTE applies the LTE predicate on the "private_key" field.
+func PrivateKeyLTE(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyContains applies the Contains predicate on the "private_key" field.
+func PrivateKeyContains(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldContains(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasPrefix applies the HasPrefix predicate on the "private_key" field.
+func PrivateKeyHasPrefix(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldHasPrefix(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasSuffix applies the HasSuffix predicate on the "private_key" field.
+func PrivateKeyHasSuffix(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldHasSuffix(FieldPrivateKey, v))
+}
+
+// PrivateKeyEqualFold applies the EqualFold predicate on the "private_key" field.
+func PrivateKeyEqualFold(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEqualFold(FieldPrivateKey, v))
+}
+
+// PrivateKeyContainsFold applies the ContainsFold predicate on the "private_key" field.
+func PrivateKeyContainsFold(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldContainsFold(FieldPrivateKey, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// PublicKeyContains applies the Contains predicate on the "public_key" field.
+func PublicKeyContains(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldContains(FieldPublicKey, v))
+}
+
+// PublicKeyHasPrefix applies the HasPrefix predicate on the "public_key" field.
+func PublicKeyHasPrefix(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldHasPrefix(FieldPublicKey, v))
+}
+
+// PublicKeyHasSuffix applies the HasSuffix predicate on the "public_key" field.
+func PublicKeyHasSuffix(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldHasSuffix(FieldPublicKey, v))
+}
+
+// PublicKeyEqualFold applies the EqualFold predicate on the "public_key" field.
+func PublicKeyEqualFold(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldEqualFold(FieldPublicKey, v))
+}
+
+// PublicKeyContainsFold applies the ContainsFold predicate on the "public_key" field.
+func PublicKeyContainsFold(v string) predicate.FederationKey {
+	return predicate.FederationKey(sql.FieldContainsFold(FieldPublicKey, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FederationKey) predicate.FederationKey {
+	return predicate.FederationKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FederationKey) predicate.FederationKey {
+	return predicate.FederationKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FederationKey) predicate.FederationKey {
+	return predicate.FederationKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/federationkey"
+	"github.com/rs/xid"
+)
+
+// FederationKeyCreate is the builder for creating a FederationKey entity.
+type FederationKeyCreate struct {
+	config
+	mutation *FederationKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FederationKeyCreate) SetCreatedAt(v time.Time) *FederationKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FederationKeyCreate) SetNillableCreatedAt(v *time.Time) *FederationKeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (_c *FederationKeyCreate) SetLocalActor(v string) *FederationKeyCreate {
+	_c.mutation.SetLocalActor(v)
+	return _c
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_c *FederationKeyCreate) SetPrivateKey(v string) *FederationKeyCreate {
+	_c.mutation.SetPrivateKey(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *FederationKeyCreate) SetPublicKey(v string) *FederationKeyCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FederationKeyCreate) SetID(v xid.ID) *FederationKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FederationKeyCreate) SetNillableID(v *xid.ID) *FederationKeyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FederationKeyMutation object of the builder.
+func (_c *FederationKeyCreate) Mutation() *FederationKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the FederationKey in the database.
+func (_c *FederationKeyCreate) Save(ctx context.Context) (*FederationKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FederationKeyCreate) SaveX(ctx context.Context) *FederationKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FederationKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FederationKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FederationKeyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := federationkey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := federationkey.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FederationKeyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FederationKey.created_at"`)}
+	}
+	if _, ok := _c.mutation.LocalActor(); !ok {
+		return &ValidationError{Name: "local_actor", err: errors.New(`ent: missing required field "FederationKey.local_actor"`)}
+	}
+	if _, ok := _c.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "FederationKey.private_key"`)}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "FederationKey.public_key"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := federationkey.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "FederationKey.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *FederationKeyCreate) sqlSave(ctx context.Context) (*FederationKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FederationKeyCreate) createSpec() (*FederationKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FederationKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(federationkey.Table, sqlgraph.NewFieldSpec(federationkey.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(federationkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LocalActor(); ok {
+		_spec.SetField(federationkey.FieldLocalActor, field.TypeString, value)
+		_node.LocalActor = value
+	}
+	if value, ok := _c.mutation.PrivateKey(); ok {
+		_spec.SetField(federationkey.FieldPrivateKey, field.TypeString, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(federationkey.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FederationKey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FederationKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FederationKeyCreate) OnConflict(opts ...sql.ConflictOption) *FederationKeyUpsertOne {
+	_c.conflict = opts
+	return &FederationKeyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FederationKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FederationKeyCreate) OnConflictColumns(columns ...string) *FederationKeyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FederationKeyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// FederationKeyUpsertOne is the builder for "upsert"-ing
+	//  one FederationKey node.
+	FederationKeyUpsertOne struct {
+		create *FederationKeyCreate
+	}
+
+	// FederationKeyUpsert is the "OnConflict" setter.
+	FederationKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLocalActor sets the "local_actor" field.
+func (u *FederationKeyUpsert) SetLocalActor(v string) *FederationKeyUpsert {
+	u.Set(federationkey.FieldLocalActor, v)
+	return u
+}
+
+// UpdateLocalActor sets the "local_actor" field to the value that was provided on create.
+func (u *FederationKeyUpsert) UpdateLocalActor() *FederationKeyUpsert {
+	u.SetExcluded(federationkey.FieldLocalActor)
+	return u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (u *FederationKeyUpsert) SetPrivateKey(v string) *FederationKeyUpsert {
+	u.Set(federationkey.FieldPrivateKey, v)
+	return u
+}
+
+// UpdatePrivateKey sets the "private_key" field to the value that was provided on create.
+func (u *FederationKeyUpsert) UpdatePrivateKey() *FederationKeyUpsert {
+	u.SetExcluded(federationkey.FieldPrivateKey)
+	return u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *FederationKeyUpsert) SetPublicKey(v string) *FederationKeyUpsert {
+	u.Set(federationkey.FieldPublicKey, v)
+	return u
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *FederationKeyUpsert) UpdatePublicKey() *FederationKeyUpsert {
+	u.SetExcluded(federationkey.FieldPublicKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.FederationKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(federationkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FederationKeyUpsertOne) UpdateNewValues() *FederationKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(federationkey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(federationkey.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FederationKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FederationKeyUpsertOne) Ignore() *FederationKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FederationKeyUpsertOne) DoNothing() *FederationKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FederationKeyCreate.OnConflict
+// documentation for more info.
+func (u *FederationKeyUpsertOne) Update(set func(*FederationKeyUpsert)) *FederationKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FederationKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (u *FederationKeyUpsertOne) SetLocalActor(v string) *FederationKeyUpsertOne {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.SetLocalActor(v)
+	})
+}
+
+// UpdateLocalActor sets the "local_actor" field to the value that was provided on create.
+func (u *FederationKeyUpsertOne) UpdateLocalActor() *FederationKeyUpsertOne {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.UpdateLocalActor()
+	})
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (u *FederationKeyUpsertOne) SetPrivateKey(v string) *FederationKeyUpsertOne {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.SetPrivateKey(v)
+	})
+}
+
+// UpdatePrivateKey sets the "private_key" field to the value that was provided on create.
+func (u *FederationKeyUpsertOne) UpdatePrivateKey() *FederationKeyUpsertOne {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.UpdatePrivateKey()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *FederationKeyUpsertOne) SetPublicKey(v string) *FederationKeyUpsertOne {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *FederationKeyUpsertOne) UpdatePublicKey() *FederationKeyUpsertOne {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// Exec executes the query.
+func (u *FederationKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FederationKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FederationKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FederationKeyUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: FederationKeyUpsertOne.ID is not supported by MySQL driver. Use FederationKeyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FederationKeyUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FederationKeyCreateBulk is the builder for creating many FederationKey entities in bulk.
+type FederationKeyCreateBulk struct {
+	config
+	err      error
+	builders []*FederationKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FederationKey entities in the database.
+func (_c *FederationKeyCreateBulk) Save(ctx context.Context) ([]*FederationKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FederationKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FederationKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FederationKeyCreateBulk) SaveX(ctx context.Context) []*FederationKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FederationKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FederationKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FederationKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FederationKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FederationKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *FederationKeyUpsertBulk {
+	_c.conflict = opts
+	return &FederationKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FederationKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FederationKeyCreateBulk) OnConflictColumns(columns ...string) *FederationKeyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FederationKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// FederationKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of FederationKey nodes.
+type FederationKeyUpsertBulk struct {
+	create *FederationKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FederationKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(federationkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FederationKeyUpsertBulk) UpdateNewValues() *FederationKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(federationkey.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(federationkey.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FederationKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FederationKeyUpsertBulk) Ignore() *FederationKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FederationKeyUpsertBulk) DoNothing() *FederationKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FederationKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *FederationKeyUpsertBulk) Update(set func(*FederationKeyUpsert)) *FederationKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FederationKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (u *FederationKeyUpsertBulk) SetLocalActor(v string) *FederationKeyUpsertBulk {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.SetLocalActor(v)
+	})
+}
+
+// UpdateLocalActor sets the "local_actor" field to the value that was provided on create.
+func (u *FederationKeyUpsertBulk) UpdateLocalActor() *FederationKeyUpsertBulk {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.UpdateLocalActor()
+	})
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (u *FederationKeyUpsertBulk) SetPrivateKey(v string) *FederationKeyUpsertBulk {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.SetPrivateKey(v)
+	})
+}
+
+// UpdatePrivateKey sets the "private_key" field to the value that was provided on create.
+func (u *FederationKeyUpsertBulk) UpdatePrivateKey() *FederationKeyUpsertBulk {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.UpdatePrivateKey()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *FederationKeyUpsertBulk) SetPublicKey(v string) *FederationKeyUpsertBulk {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *FederationKeyUpsertBulk) UpdatePublicKey() *FederationKeyUpsertBulk {
+	return u.Update(func(s *FederationKeyUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// Exec executes the query.
+func (u *FederationKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FederationKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FederationKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FederationKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/federationkey"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// FederationKeyUpdate is the builder for updating FederationKey entities.
+type FederationKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FederationKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FederationKeyUpdate builder.
+func (_u *FederationKeyUpdate) Where(ps ...predicate.FederationKey) *FederationKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (_u *FederationKeyUpdate) SetLocalActor(v string) *FederationKeyUpdate {
+	_u.mutation.SetLocalActor(v)
+	return _u
+}
+
+// SetNillableLocalActor sets the "local_actor" field if the given value is not nil.
+func (_u *FederationKeyUpdate) SetNillableLocalActor(v *string) *FederationKeyUpdate {
+	if v != nil {
+		_u.SetLocalActor(*v)
+	}
+	return _u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_u *FederationKeyUpdate) SetPrivateKey(v string) *FederationKeyUpdate {
+	_u.mutation.SetPrivateKey(v)
+	return _u
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (_u *FederationKeyUpdate) SetNillablePrivateKey(v *string) *FederationKeyUpdate {
+	if v != nil {
+		_u.SetPrivateKey(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *FederationKeyUpdate) SetPublicKey(v string) *FederationKeyUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (_u *FederationKeyUpdate) SetNillablePublicKey(v *string) *FederationKeyUpdate {
+	if v != nil {
+		_u.SetPublicKey(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FederationKeyMutation object of the builder.
+func (_u *FederationKeyUpdate) Mutation() *FederationKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FederationKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FederationKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FederationKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FederationKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FederationKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FederationKeyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FederationKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(federationkey.Table, federationkey.Columns, sqlgraph.NewFieldSpec(federationkey.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LocalActor(); ok {
+		_spec.SetField(federationkey.FieldLocalActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrivateKey(); ok {
+		_spec.SetField(federationkey.FieldPrivateKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(federationkey.FieldPublicKey, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{federationkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FederationKeyUpdateOne is the builder for updating a single FederationKey entity.
+type FederationKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FederationKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLocalActor sets the "local_actor" field.
+func (_u *FederationKeyUpdateOne) SetLocalActor(v string) *FederationKeyUpdateOne {
+	_u.mutation.SetLocalActor(v)
+	return _u
+}
+
+// SetNillableLocalActor sets the "local_actor" field if the given value is not nil.
+func (_u *FederationKeyUpdateOne) SetNillableLocalActor(v *string) *FederationKeyUpdateOne {
+	if v != nil {
+		_u.SetLocalActor(*v)
+	}
+	return _u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_u *FederationKeyUpdateOne) SetPrivateKey(v string) *FederationKeyUpdateOne {
+	_u.mutation.SetPrivateKey(v)
+	return _u
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (_u *FederationKeyUpdateOne) SetNillablePrivateKey(v *string) *FederationKeyUpdateOne {
+	if v != nil {
+		_u.SetPrivateKey(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *FederationKeyUpdateOne) SetPublicKey(v string) *FederationKeyUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (_u *FederationKeyUpdateOne) SetNillablePublicKey(v *string) *FederationKeyUpdateOne {
+	if v != nil {
+		_u.SetPublicKey(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FederationKeyMutation object of the builder.
+func (_u *FederationKeyUpdateOne) Mutation() *FederationKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FederationKeyUpdate builder.
+func (_u *FederationKeyUpdateOne) Where(ps ...predicate.FederationKey) *FederationKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FederationKeyUpdateOne) Select(field string, fields ...string) *FederationKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FederationKey entity.
+func (_u *FederationKeyUpdateOne) Save(ctx context.Context) (*FederationKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FederationKeyUpdateOne) SaveX(ctx context.Context) *FederationKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FederationKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FederationKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FederationKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FederationKeyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FederationKeyUpdateOne) sqlSave(ctx context.Context) (_node *FederationKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(federationkey.Table, federationkey.Columns, sqlgraph.NewFieldSpec(federationkey.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FederationKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, federationkey.FieldID)
+		for _, f := range fields {
+			if !federationkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != federationkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LocalActor(); ok {
+		_spec.SetField(federationkey.FieldLocalActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrivateKey(); ok {
+		_spec.SetField(federationkey.FieldPrivateKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(federationkey.FieldPublicKey, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FederationKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{federationkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/Southclaws/storyden/internal/ent"
 )
 
+// The APIUsageFunc type is an adapter to allow the use of ordinary
+// function as APIUsage mutator.
+type APIUsageFunc func(context.Context, *ent.APIUsageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIUsageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIUsageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIUsageMutation", m)
+}
+
 // The AccountFunc type is an adapter to allow the use of ordinary
 // function as Account mutator.
 type AccountFunc func(context.Context, *ent.AccountMutation) (ent.Value, error)
@@ -21,6 +33,42 @@ func (f AccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountMutation", m)
 }
 
+// The AccountApplicationFunc type is an adapter to allow the use of ordinary
+// function as AccountApplication mutator.
+type AccountApplicationFunc func(context.Context, *ent.AccountApplicationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountApplicationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccountApplicationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountApplicationMutation", m)
+}
+
+// The AccountBadgeFunc type is an adapter to allow the use of ordinary
+// function as AccountBadge mutator.
+type AccountBadgeFunc func(context.Context, *ent.AccountBadgeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountBadgeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccountBadgeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountBadgeMutation", m)
+}
+
+// The AccountBlockFunc type is an adapter to allow the use of ordinary
+// function as AccountBlock mutator.
+type AccountBlockFunc func(context.Context, *ent.AccountBlockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountBlockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccountBlockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountBlockMutation", m)
+}
+
 // The AccountFollowFunc type is an adapter to allow the use of ordinary
 // function as AccountFollow mutator.
 type AccountFollowFunc func(context.Context, *ent.AccountFollowMutation) (ent.Value, error)
@@ -33,6 +81,18 @@ func (f AccountFollowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountFollowMutation", m)
 }
 
+// The AccountOnboardingStepFunc type is an adapter to allow the use of ordinary
+// function as AccountOnboardingStep mutator.
+type AccountOnboardingStepFunc func(context.Context, *ent.AccountOnboardingStepMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountOnboardingStepFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccountOnboardingStepMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountOnboardingStepMutation", m)
+}
+
 // The AccountRolesFunc type is an adapter to allow the use of ordinary
 // function as AccountRoles mutator.
 type AccountRolesFunc func(context.Context, *ent.AccountRolesMutation) (ent.Value, error)
@@ -45,6 +105,54 @@ func (f AccountRolesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountRolesMutation", m)
 }
 
+// The AnalyticsCohortFunc type is an adapter to allow the use of ordinary
+// function as AnalyticsCohort mutator.
+type AnalyticsCohortFunc func(context.Context, *ent.AnalyticsCohortMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnalyticsCohortFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AnalyticsCohortMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnalyticsCohortMutation", m)
+}
+
+// The AnalyticsContentFunc type is an adapter to allow the use of ordinary
+// function as AnalyticsContent mutator.
+type AnalyticsContentFunc func(context.Context, *ent.AnalyticsContentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnalyticsContentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AnalyticsContentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnalyticsContentMutation", m)
+}
+
+// The AnalyticsDayFunc type is an adapter to allow the use of ordinary
+// function as AnalyticsDay mutator.
+type AnalyticsDayFunc func(context.Context, *ent.AnalyticsDayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnalyticsDayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AnalyticsDayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnalyticsDayMutation", m)
+}
+
+// The AnalyticsExportFunc type is an adapter to allow the use of ordinary
+// function as AnalyticsExport mutator.
+type AnalyticsExportFunc func(context.Context, *ent.AnalyticsExportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnalyticsExportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AnalyticsExportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnalyticsExportMutation", m)
+}
+
 // The AssetFunc type is an adapter to allow the use of ordinary
 // function as Asset mutator.
 type AssetFunc func(context.Context, *ent.AssetMutation) (ent.Value, error)
@@ -69,6 +177,42 @@ func (f AuthenticationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthenticationMutation", m)
 }
 
+// The AutomodRuleFunc type is an adapter to allow the use of ordinary
+// function as AutomodRule mutator.
+type AutomodRuleFunc func(context.Context, *ent.AutomodRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AutomodRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AutomodRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AutomodRuleMutation", m)
+}
+
+// The BadgeFunc type is an adapter to allow the use of ordinary
+// function as Badge mutator.
+type BadgeFunc func(context.Context, *ent.BadgeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BadgeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BadgeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BadgeMutation", m)
+}
+
+// The BookmarkFunc type is an adapter to allow the use of ordinary
+// function as Bookmark mutator.
+type BookmarkFunc func(context.Context, *ent.BookmarkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BookmarkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BookmarkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BookmarkMutation", m)
+}
+
 // The CategoryFunc type is an adapter to allow the use of ordinary
 // function as Category mutator.
 type CategoryFunc func(context.Context, *ent.CategoryMutation) (ent.Value, error)
@@ -81,6 +225,30 @@ func (f CategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryMutation", m)
 }
 
+// The CategoryFollowFunc type is an adapter to allow the use of ordinary
+// function as CategoryFollow mutator.
+type CategoryFollowFunc func(context.Context, *ent.CategoryFollowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryFollowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryFollowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryFollowMutation", m)
+}
+
+// The CategoryPermissionFunc type is an adapter to allow the use of ordinary
+// function as CategoryPermission mutator.
+type CategoryPermissionFunc func(context.Context, *ent.CategoryPermissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryPermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryPermissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryPermissionMutation", m)
+}
+
 // The CollectionFunc type is an adapter to allow the use of ordinary
 // function as Collection mutator.
 type CollectionFunc func(context.Context, *ent.CollectionMutation) (ent.Value, error)
@@ -117,6 +285,114 @@ func (f CollectionPostFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionPostMutation", m)
 }
 
+// The CollectionSectionFunc type is an adapter to allow the use of ordinary
+// function as CollectionSection mutator.
+type CollectionSectionFunc func(context.Context, *ent.CollectionSectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollectionSectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollectionSectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionSectionMutation", m)
+}
+
+// The CollectionShareFunc type is an adapter to allow the use of ordinary
+// function as CollectionShare mutator.
+type CollectionShareFunc func(context.Context, *ent.CollectionShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollectionShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollectionShareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionShareMutation", m)
+}
+
+// The ContentSummaryFunc type is an adapter to allow the use of ordinary
+// function as ContentSummary mutator.
+type ContentSummaryFunc func(context.Context, *ent.ContentSummaryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentSummaryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContentSummaryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContentSummaryMutation", m)
+}
+
+// The ContentViewFunc type is an adapter to allow the use of ordinary
+// function as ContentView mutator.
+type ContentViewFunc func(context.Context, *ent.ContentViewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentViewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContentViewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContentViewMutation", m)
+}
+
+// The ConversationFunc type is an adapter to allow the use of ordinary
+// function as Conversation mutator.
+type ConversationFunc func(context.Context, *ent.ConversationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConversationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConversationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConversationMutation", m)
+}
+
+// The ConversationParticipantFunc type is an adapter to allow the use of ordinary
+// function as ConversationParticipant mutator.
+type ConversationParticipantFunc func(context.Context, *ent.ConversationParticipantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConversationParticipantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConversationParticipantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConversationParticipantMutation", m)
+}
+
+// The DigestSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as DigestSubscription mutator.
+type DigestSubscriptionFunc func(context.Context, *ent.DigestSubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DigestSubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DigestSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DigestSubscriptionMutation", m)
+}
+
+// The DirectMessageFunc type is an adapter to allow the use of ordinary
+// function as DirectMessage mutator.
+type DirectMessageFunc func(context.Context, *ent.DirectMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DirectMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DirectMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DirectMessageMutation", m)
+}
+
+// The DiscordBridgeLinkFunc type is an adapter to allow the use of ordinary
+// function as DiscordBridgeLink mutator.
+type DiscordBridgeLinkFunc func(context.Context, *ent.DiscordBridgeLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordBridgeLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordBridgeLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordBridgeLinkMutation", m)
+}
+
 // The EmailFunc type is an adapter to allow the use of ordinary
 // function as Email mutator.
 type EmailFunc func(context.Context, *ent.EmailMutation) (ent.Value, error)
@@ -153,6 +429,78 @@ func (f EventParticipantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventParticipantMutation", m)
 }
 
+// The FeatureFlagFunc type is an adapter to allow the use of ordinary
+// function as FeatureFlag mutator.
+type FeatureFlagFunc func(context.Context, *ent.FeatureFlagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeatureFlagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FeatureFlagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeatureFlagMutation", m)
+}
+
+// The FederationFollowerFunc type is an adapter to allow the use of ordinary
+// function as FederationFollower mutator.
+type FederationFollowerFunc func(context.Context, *ent.FederationFollowerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FederationFollowerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FederationFollowerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FederationFollowerMutation", m)
+}
+
+// The FederationKeyFunc type is an adapter to allow the use of ordinary
+// function as FederationKey mutator.
+type FederationKeyFunc func(context.Context, *ent.FederationKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FederationKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FederationKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FederationKeyMutation", m)
+}
+
+// The FeedTokenFunc type is an adapter to allow the use of ordinary
+// function as FeedToken mutator.
+type FeedTokenFunc func(context.Context, *ent.FeedTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeedTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FeedTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeedTokenMutation", m)
+}
+
+// The ImportRecordFunc type is an adapter to allow the use of ordinary
+// function as ImportRecord mutator.
+type ImportRecordFunc func(context.Context, *ent.ImportRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ImportRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ImportRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImportRecordMutation", m)
+}
+
+// The ImportRunFunc type is an adapter to allow the use of ordinary
+// function as ImportRun mutator.
+type ImportRunFunc func(context.Context, *ent.ImportRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ImportRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ImportRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImportRunMutation", m)
+}
+
 // The InvitationFunc type is an adapter to allow the use of ordinary
 // function as Invitation mutator.
 type InvitationFunc func(context.Context, *ent.InvitationMutation) (ent.Value, error)
@@ -165,6 +513,18 @@ func (f InvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvitationMutation", m)
 }
 
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
+}
+
 // The LikePostFunc type is an adapter to allow the use of ordinary
 // function as LikePost mutator.
 type LikePostFunc func(context.Context, *ent.LikePostMutation) (ent.Value, error)
@@ -189,6 +549,30 @@ func (f LinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkMutation", m)
 }
 
+// The LinkCheckFunc type is an adapter to allow the use of ordinary
+// function as LinkCheck mutator.
+type LinkCheckFunc func(context.Context, *ent.LinkCheckMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LinkCheckFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LinkCheckMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkCheckMutation", m)
+}
+
+// The MatrixBridgeLinkFunc type is an adapter to allow the use of ordinary
+// function as MatrixBridgeLink mutator.
+type MatrixBridgeLinkFunc func(context.Context, *ent.MatrixBridgeLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MatrixBridgeLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MatrixBridgeLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MatrixBridgeLinkMutation", m)
+}
+
 // The MentionProfileFunc type is an adapter to allow the use of ordinary
 // function as MentionProfile mutator.
 type MentionProfileFunc func(context.Context, *ent.MentionProfileMutation) (ent.Value, error)
@@ -201,6 +585,30 @@ func (f MentionProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MentionProfileMutation", m)
 }
 
+// The ModerationNoteFunc type is an adapter to allow the use of ordinary
+// function as ModerationNote mutator.
+type ModerationNoteFunc func(context.Context, *ent.ModerationNoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationNoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ModerationNoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ModerationNoteMutation", m)
+}
+
+// The NetworkBanFunc type is an adapter to allow the use of ordinary
+// function as NetworkBan mutator.
+type NetworkBanFunc func(context.Context, *ent.NetworkBanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NetworkBanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NetworkBanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NetworkBanMutation", m)
+}
+
 // The NodeFunc type is an adapter to allow the use of ordinary
 // function as Node mutator.
 type NodeFunc func(context.Context, *ent.NodeMutation) (ent.Value, error)
@@ -225,6 +633,54 @@ func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
 }
 
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
+}
+
+// The PolicyAcceptanceFunc type is an adapter to allow the use of ordinary
+// function as PolicyAcceptance mutator.
+type PolicyAcceptanceFunc func(context.Context, *ent.PolicyAcceptanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicyAcceptanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicyAcceptanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicyAcceptanceMutation", m)
+}
+
+// The PolicyDocumentFunc type is an adapter to allow the use of ordinary
+// function as PolicyDocument mutator.
+type PolicyDocumentFunc func(context.Context, *ent.PolicyDocumentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicyDocumentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicyDocumentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicyDocumentMutation", m)
+}
+
+// The PolicyVersionFunc type is an adapter to allow the use of ordinary
+// function as PolicyVersion mutator.
+type PolicyVersionFunc func(context.Context, *ent.PolicyVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicyVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicyVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicyVersionMutation", m)
+}
+
 // The PostFunc type is an adapter to allow the use of ordinary
 // function as Post mutator.
 type PostFunc func(context.Context, *ent.PostMutation) (ent.Value, error)
@@ -285,6 +741,18 @@ func (f PropertySchemaFieldFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PropertySchemaFieldMutation", m)
 }
 
+// The PushSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as PushSubscription mutator.
+type PushSubscriptionFunc func(context.Context, *ent.PushSubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PushSubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PushSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PushSubscriptionMutation", m)
+}
+
 // The QuestionFunc type is an adapter to allow the use of ordinary
 // function as Question mutator.
 type QuestionFunc func(context.Context, *ent.QuestionMutation) (ent.Value, error)
@@ -309,6 +777,18 @@ func (f ReactFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReactMutation", m)
 }
 
+// The ReadingHistoryFunc type is an adapter to allow the use of ordinary
+// function as ReadingHistory mutator.
+type ReadingHistoryFunc func(context.Context, *ent.ReadingHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReadingHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReadingHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReadingHistoryMutation", m)
+}
+
 // The ReportFunc type is an adapter to allow the use of ordinary
 // function as Report mutator.
 type ReportFunc func(context.Context, *ent.ReportMutation) (ent.Value, error)
@@ -321,6 +801,18 @@ func (f ReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReportMutation", m)
 }
 
+// The ReputationEntryFunc type is an adapter to allow the use of ordinary
+// function as ReputationEntry mutator.
+type ReputationEntryFunc func(context.Context, *ent.ReputationEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReputationEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReputationEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReputationEntryMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -333,6 +825,18 @@ func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
 }
 
+// The ScheduledTaskFunc type is an adapter to allow the use of ordinary
+// function as ScheduledTask mutator.
+type ScheduledTaskFunc func(context.Context, *ent.ScheduledTaskMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledTaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledTaskMutation", m)
+}
+
 // The SessionFunc type is an adapter to allow the use of ordinary
 // function as Session mutator.
 type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
@@ -357,6 +861,30 @@ func (f SettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingMutation", m)
 }
 
+// The SpaceFunc type is an adapter to allow the use of ordinary
+// function as Space mutator.
+type SpaceFunc func(context.Context, *ent.SpaceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SpaceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SpaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpaceMutation", m)
+}
+
+// The SpaceMemberFunc type is an adapter to allow the use of ordinary
+// function as SpaceMember mutator.
+type SpaceMemberFunc func(context.Context, *ent.SpaceMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SpaceMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SpaceMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpaceMemberMutation", m)
+}
+
 // The TagFunc type is an adapter to allow the use of ordinary
 // function as Tag mutator.
 type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
@@ -369,6 +897,138 @@ func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
 }
 
+// The TagFollowFunc type is an adapter to allow the use of ordinary
+// function as TagFollow mutator.
+type TagFollowFunc func(context.Context, *ent.TagFollowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFollowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagFollowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagFollowMutation", m)
+}
+
+// The TagSynonymFunc type is an adapter to allow the use of ordinary
+// function as TagSynonym mutator.
+type TagSynonymFunc func(context.Context, *ent.TagSynonymMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagSynonymFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagSynonymMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagSynonymMutation", m)
+}
+
+// The TelegramMessageFunc type is an adapter to allow the use of ordinary
+// function as TelegramMessage mutator.
+type TelegramMessageFunc func(context.Context, *ent.TelegramMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TelegramMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TelegramMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TelegramMessageMutation", m)
+}
+
+// The TenantFunc type is an adapter to allow the use of ordinary
+// function as Tenant mutator.
+type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantMutation", m)
+}
+
+// The TrendingScoreFunc type is an adapter to allow the use of ordinary
+// function as TrendingScore mutator.
+type TrendingScoreFunc func(context.Context, *ent.TrendingScoreMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TrendingScoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TrendingScoreMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TrendingScoreMutation", m)
+}
+
+// The UploadSessionFunc type is an adapter to allow the use of ordinary
+// function as UploadSession mutator.
+type UploadSessionFunc func(context.Context, *ent.UploadSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UploadSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UploadSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UploadSessionMutation", m)
+}
+
+// The VerificationRequestFunc type is an adapter to allow the use of ordinary
+// function as VerificationRequest mutator.
+type VerificationRequestFunc func(context.Context, *ent.VerificationRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VerificationRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VerificationRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VerificationRequestMutation", m)
+}
+
+// The WarningFunc type is an adapter to allow the use of ordinary
+// function as Warning mutator.
+type WarningFunc func(context.Context, *ent.WarningMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WarningFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WarningMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WarningMutation", m)
+}
+
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *ent.WebhookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
+// The WordFilterFunc type is an adapter to allow the use of ordinary
+// function as WordFilter mutator.
+type WordFilterFunc func(context.Context, *ent.WordFilterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WordFilterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WordFilterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WordFilterMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
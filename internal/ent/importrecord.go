@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
+	"github.com/rs/xid"
+)
+
+// ImportRecord is the model entity for the ImportRecord schema.
+type ImportRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID xid.ID `json:"run_id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID string `json:"external_id,omitempty"`
+	// InternalID holds the value of the "internal_id" field.
+	InternalID *xid.ID `json:"internal_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status importrecord.Status `json:"status,omitempty"`
+	// Message holds the value of the "message" field.
+	Message *string `json:"message,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportRecordQuery when eager-loading is set.
+	Edges        ImportRecordEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportRecordEdges holds the relations/edges for other nodes in the graph.
+type ImportRecordEdges struct {
+	// Run holds the value of the run edge.
+	Run *ImportRun `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportRecordEdges) RunOrErr() (*ImportRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: importrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importrecord.FieldInternalID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case importrecord.FieldSource, importrecord.FieldKind, importrecord.FieldExternalID, importrecord.FieldStatus, importrecord.FieldMessage:
+			values[i] = new(sql.NullString)
+		case importrecord.FieldCreatedAt, importrecord.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case importrecord.FieldID, importrecord.FieldRunID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportRecord fields.
+func (_m *ImportRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importrecord.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case importrecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case importrecord.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case importrecord.FieldRunID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value != nil {
+				_m.RunID = *value
+			}
+		case importrecord.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case importrecord.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case importrecord.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = value.String
+			}
+		case importrecord.FieldInternalID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field internal_id", values[i])
+			} else if value.Valid {
+				_m.InternalID = new(xid.ID)
+				*_m.InternalID = *value.S.(*xid.ID)
+			}
+		case importrecord.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = importrecord.Status(value.String)
+			}
+		case importrecord.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = new(string)
+				*_m.Message = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportRecord.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportRecord) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the ImportRecord entity.
+func (_m *ImportRecord) QueryRun() *ImportRunQuery {
+	return NewImportRecordClient(_m.config).QueryRun(_m)
+}
+
+// Update returns a builder for updating this ImportRecord.
+// Note that you need to call ImportRecord.Unwrap() before calling this method if this ImportRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportRecord) Update() *ImportRecordUpdateOne {
+	return NewImportRecordClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportRecord) Unwrap() *ImportRecord {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportRecord is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
+	builder.WriteString(", ")
+	if v := _m.InternalID; v != nil {
+		builder.WriteString("internal_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.Message; v != nil {
+		builder.WriteString("message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportRecords is a parsable slice of ImportRecord.
+type ImportRecords []*ImportRecord
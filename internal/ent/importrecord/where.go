@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldRunID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldSource, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldKind, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldExternalID, v))
+}
+
+// InternalID applies equality check predicate on the "internal_id" field. It's identical to InternalIDEQ.
+func InternalID(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldInternalID, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDGT applies the GT predicate on the "run_id" field.
+func RunIDGT(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldRunID, v))
+}
+
+// RunIDGTE applies the GTE predicate on the "run_id" field.
+func RunIDGTE(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldRunID, v))
+}
+
+// RunIDLT applies the LT predicate on the "run_id" field.
+func RunIDLT(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldRunID, v))
+}
+
+// RunIDLTE applies the LTE predicate on the "run_id" field.
+func RunIDLTE(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldRunID, v))
+}
+
+// RunIDContains applies the Contains predicate on the "run_id" field.
+func RunIDContains(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldContains(FieldRunID, vc))
+}
+
+// RunIDHasPrefix applies the HasPrefix predicate on the "run_id" field.
+func RunIDHasPrefix(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldHasPrefix(FieldRunID, vc))
+}
+
+// RunIDHasSuffix applies the HasSuffix predicate on the "run_id" field.
+func RunIDHasSuffix(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldHasSuffix(FieldRunID, vc))
+}
+
+// RunIDEqualFold applies the EqualFold predicate on the "run_id" field.
+func RunIDEqualFold(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldEqualFold(FieldRunID, vc))
+}
+
+// RunIDContainsFold applies the ContainsFold predicate on the "run_id" field.
+func RunIDContainsFold(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldContainsFold(FieldRunID, vc))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContainsFold(FieldSource, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// InternalIDEQ applies the EQ predicate on the "internal_id" field.
+func InternalIDEQ(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldInternalID, v))
+}
+
+// InternalIDNEQ applies the NEQ predicate on the "internal_id" field.
+func InternalIDNEQ(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldInternalID, v))
+}
+
+// InternalIDIn applies the In predicate on the "internal_id" field.
+func InternalIDIn(vs ...xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldInternalID, vs...))
+}
+
+// InternalIDNotIn applies the NotIn predicate on the "internal_id" field.
+func InternalIDNotIn(vs ...xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldInternalID, vs...))
+}
+
+// InternalIDGT applies the GT predicate on the "internal_id" field.
+func InternalIDGT(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldInternalID, v))
+}
+
+// InternalIDGTE applies the GTE predicate on the "internal_id" field.
+func InternalIDGTE(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldInternalID, v))
+}
+
+// InternalIDLT applies the LT predicate on the "internal_id" field.
+func InternalIDLT(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldInternalID, v))
+}
+
+// InternalIDLTE applies the LTE predicate on the "internal_id" field.
+func InternalIDLTE(v xid.ID) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldInternalID, v))
+}
+
+// InternalIDContains applies the Contains predicate on the "internal_id" field.
+func InternalIDContains(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldContains(FieldInternalID, vc))
+}
+
+// InternalIDHasPrefix applies the HasPrefix predicate on the "internal_id" field.
+func InternalIDHasPrefix(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldHasPrefix(FieldInternalID, vc))
+}
+
+// InternalIDHasSuffix applies the HasSuffix predicate on the "internal_id" field.
+func InternalIDHasSuffix(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldHasSuffix(FieldInternalID, vc))
+}
+
+// InternalIDIsNil applies the IsNil predicate on the "internal_id" field.
+func InternalIDIsNil() predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIsNull(FieldInternalID))
+}
+
+// InternalIDNotNil applies the NotNil predicate on the "internal_id" field.
+func InternalIDNotNil() predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotNull(FieldInternalID))
+}
+
+// InternalIDEqualFold applies the EqualFold predicate on the "internal_id" field.
+func InternalIDEqualFold(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldEqualFold(FieldInternalID, vc))
+}
+
+// InternalIDContainsFold applies the ContainsFold predicate on the "internal_id" field.
+func InternalIDContainsFold(v xid.ID) predicate.ImportRecord {
+	vc := v.String()
+	return predicate.ImportRecord(sql.FieldContainsFold(FieldInternalID, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.ImportRecord {
+	return predicate.ImportRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.ImportRun) predicate.ImportRecord {
+	return predicate.ImportRecord(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImportRecord) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImportRecord) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImportRecord) predicate.ImportRecord {
+	return predicate.ImportRecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,1005 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
+	"github.com/rs/xid"
+)
+
+// ImportRecordCreate is the builder for creating a ImportRecord entity.
+type ImportRecordCreate struct {
+	config
+	mutation *ImportRecordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImportRecordCreate) SetCreatedAt(v time.Time) *ImportRecordCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImportRecordCreate) SetNillableCreatedAt(v *time.Time) *ImportRecordCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImportRecordCreate) SetUpdatedAt(v time.Time) *ImportRecordCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImportRecordCreate) SetNillableUpdatedAt(v *time.Time) *ImportRecordCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *ImportRecordCreate) SetRunID(v xid.ID) *ImportRecordCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ImportRecordCreate) SetSource(v string) *ImportRecordCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ImportRecordCreate) SetKind(v string) *ImportRecordCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *ImportRecordCreate) SetExternalID(v string) *ImportRecordCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetInternalID sets the "internal_id" field.
+func (_c *ImportRecordCreate) SetInternalID(v xid.ID) *ImportRecordCreate {
+	_c.mutation.SetInternalID(v)
+	return _c
+}
+
+// SetNillableInternalID sets the "internal_id" field if the given value is not nil.
+func (_c *ImportRecordCreate) SetNillableInternalID(v *xid.ID) *ImportRecordCreate {
+	if v != nil {
+		_c.SetInternalID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ImportRecordCreate) SetStatus(v importrecord.Status) *ImportRecordCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ImportRecordCreate) SetMessage(v string) *ImportRecordCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ImportRecordCreate) SetNillableMessage(v *string) *ImportRecordCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImportRecordCreate) SetID(v xid.ID) *ImportRecordCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ImportRecordCreate) SetNillableID(v *xid.ID) *ImportRecordCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRun sets the "run" edge to the ImportRun entity.
+func (_c *ImportRecordCreate) SetRun(v *ImportRun) *ImportRecordCreate {
+	return _c.SetRunID(v.ID)
+}
+
+// Mutation returns the ImportRecordMutation object of the builder.
+func (_c *ImportRecordCreate) Mutation() *ImportRecordMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportRecord in the database.
+func (_c *ImportRecordCreate) Save(ctx context.Context) (*ImportRecord, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportRecordCreate) SaveX(ctx context.Context) *ImportRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportRecordCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportRecordCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportRecordCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := importrecord.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := importrecord.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := importrecord.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportRecordCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportRecord.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImportRecord.updated_at"`)}
+	}
+	if _, ok := _c.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "ImportRecord.run_id"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ImportRecord.source"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ImportRecord.kind"`)}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "ImportRecord.external_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportRecord.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := importrecord.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRecord.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := importrecord.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ImportRecord.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "ImportRecord.run"`)}
+	}
+	return nil
+}
+
+func (_c *ImportRecordCreate) sqlSave(ctx context.Context) (*ImportRecord, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportRecordCreate) createSpec() (*ImportRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportRecord{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importrecord.Table, sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(importrecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(importrecord.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(importrecord.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(importrecord.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(importrecord.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.InternalID(); ok {
+		_spec.SetField(importrecord.FieldInternalID, field.TypeString, value)
+		_node.InternalID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(importrecord.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(importrecord.FieldMessage, field.TypeString, value)
+		_node.Message = &value
+	}
+	if nodes := _c.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrecord.RunTable,
+			Columns: []string{importrecord.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportRecord.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportRecordUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportRecordCreate) OnConflict(opts ...sql.ConflictOption) *ImportRecordUpsertOne {
+	_c.conflict = opts
+	return &ImportRecordUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportRecordCreate) OnConflictColumns(columns ...string) *ImportRecordUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportRecordUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportRecordUpsertOne is the builder for "upsert"-ing
+	//  one ImportRecord node.
+	ImportRecordUpsertOne struct {
+		create *ImportRecordCreate
+	}
+
+	// ImportRecordUpsert is the "OnConflict" setter.
+	ImportRecordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportRecordUpsert) SetUpdatedAt(v time.Time) *ImportRecordUpsert {
+	u.Set(importrecord.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateUpdatedAt() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldUpdatedAt)
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *ImportRecordUpsert) SetRunID(v xid.ID) *ImportRecordUpsert {
+	u.Set(importrecord.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateRunID() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldRunID)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *ImportRecordUpsert) SetSource(v string) *ImportRecordUpsert {
+	u.Set(importrecord.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateSource() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldSource)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ImportRecordUpsert) SetKind(v string) *ImportRecordUpsert {
+	u.Set(importrecord.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateKind() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldKind)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ImportRecordUpsert) SetExternalID(v string) *ImportRecordUpsert {
+	u.Set(importrecord.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateExternalID() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldExternalID)
+	return u
+}
+
+// SetInternalID sets the "internal_id" field.
+func (u *ImportRecordUpsert) SetInternalID(v xid.ID) *ImportRecordUpsert {
+	u.Set(importrecord.FieldInternalID, v)
+	return u
+}
+
+// UpdateInternalID sets the "internal_id" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateInternalID() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldInternalID)
+	return u
+}
+
+// ClearInternalID clears the value of the "internal_id" field.
+func (u *ImportRecordUpsert) ClearInternalID() *ImportRecordUpsert {
+	u.SetNull(importrecord.FieldInternalID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRecordUpsert) SetStatus(v importrecord.Status) *ImportRecordUpsert {
+	u.Set(importrecord.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateStatus() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldStatus)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ImportRecordUpsert) SetMessage(v string) *ImportRecordUpsert {
+	u.Set(importrecord.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ImportRecordUpsert) UpdateMessage() *ImportRecordUpsert {
+	u.SetExcluded(importrecord.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ImportRecordUpsert) ClearMessage() *ImportRecordUpsert {
+	u.SetNull(importrecord.FieldMessage)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ImportRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importrecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportRecordUpsertOne) UpdateNewValues() *ImportRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(importrecord.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(importrecord.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportRecord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportRecordUpsertOne) Ignore() *ImportRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportRecordUpsertOne) DoNothing() *ImportRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportRecordCreate.OnConflict
+// documentation for more info.
+func (u *ImportRecordUpsertOne) Update(set func(*ImportRecordUpsert)) *ImportRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportRecordUpsertOne) SetUpdatedAt(v time.Time) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateUpdatedAt() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *ImportRecordUpsertOne) SetRunID(v xid.ID) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateRunID() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ImportRecordUpsertOne) SetSource(v string) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateSource() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ImportRecordUpsertOne) SetKind(v string) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateKind() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ImportRecordUpsertOne) SetExternalID(v string) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateExternalID() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// SetInternalID sets the "internal_id" field.
+func (u *ImportRecordUpsertOne) SetInternalID(v xid.ID) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetInternalID(v)
+	})
+}
+
+// UpdateInternalID sets the "internal_id" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateInternalID() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateInternalID()
+	})
+}
+
+// ClearInternalID clears the value of the "internal_id" field.
+func (u *ImportRecordUpsertOne) ClearInternalID() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.ClearInternalID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRecordUpsertOne) SetStatus(v importrecord.Status) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateStatus() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ImportRecordUpsertOne) SetMessage(v string) *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ImportRecordUpsertOne) UpdateMessage() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ImportRecordUpsertOne) ClearMessage() *ImportRecordUpsertOne {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportRecordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportRecordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportRecordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportRecordUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ImportRecordUpsertOne.ID is not supported by MySQL driver. Use ImportRecordUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportRecordUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportRecordCreateBulk is the builder for creating many ImportRecord entities in bulk.
+type ImportRecordCreateBulk struct {
+	config
+	err      error
+	builders []*ImportRecordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportRecord entities in the database.
+func (_c *ImportRecordCreateBulk) Save(ctx context.Context) ([]*ImportRecord, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportRecord, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportRecordCreateBulk) SaveX(ctx context.Context) []*ImportRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportRecord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportRecordUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportRecordCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportRecordUpsertBulk {
+	_c.conflict = opts
+	return &ImportRecordUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportRecordCreateBulk) OnConflictColumns(columns ...string) *ImportRecordUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportRecordUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportRecordUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportRecord nodes.
+type ImportRecordUpsertBulk struct {
+	create *ImportRecordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importrecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportRecordUpsertBulk) UpdateNewValues() *ImportRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(importrecord.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(importrecord.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportRecord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportRecordUpsertBulk) Ignore() *ImportRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportRecordUpsertBulk) DoNothing() *ImportRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportRecordCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportRecordUpsertBulk) Update(set func(*ImportRecordUpsert)) *ImportRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportRecordUpsertBulk) SetUpdatedAt(v time.Time) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateUpdatedAt() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *ImportRecordUpsertBulk) SetRunID(v xid.ID) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateRunID() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ImportRecordUpsertBulk) SetSource(v string) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateSource() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ImportRecordUpsertBulk) SetKind(v string) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateKind() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ImportRecordUpsertBulk) SetExternalID(v string) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateExternalID() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// SetInternalID sets the "internal_id" field.
+func (u *ImportRecordUpsertBulk) SetInternalID(v xid.ID) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetInternalID(v)
+	})
+}
+
+// UpdateInternalID sets the "internal_id" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateInternalID() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateInternalID()
+	})
+}
+
+// ClearInternalID clears the value of the "internal_id" field.
+func (u *ImportRecordUpsertBulk) ClearInternalID() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.ClearInternalID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRecordUpsertBulk) SetStatus(v importrecord.Status) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateStatus() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ImportRecordUpsertBulk) SetMessage(v string) *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ImportRecordUpsertBulk) UpdateMessage() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ImportRecordUpsertBulk) ClearMessage() *ImportRecordUpsertBulk {
+	return u.Update(func(s *ImportRecordUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportRecordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportRecordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportRecordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportRecordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ImportRecordUpdate is the builder for updating ImportRecord entities.
+type ImportRecordUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportRecordUpdate builder.
+func (_u *ImportRecordUpdate) Where(ps ...predicate.ImportRecord) *ImportRecordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportRecordUpdate) SetUpdatedAt(v time.Time) *ImportRecordUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *ImportRecordUpdate) SetRunID(v xid.ID) *ImportRecordUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *ImportRecordUpdate) SetNillableRunID(v *xid.ID) *ImportRecordUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ImportRecordUpdate) SetSource(v string) *ImportRecordUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ImportRecordUpdate) SetNillableSource(v *string) *ImportRecordUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ImportRecordUpdate) SetKind(v string) *ImportRecordUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ImportRecordUpdate) SetNillableKind(v *string) *ImportRecordUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ImportRecordUpdate) SetExternalID(v string) *ImportRecordUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ImportRecordUpdate) SetNillableExternalID(v *string) *ImportRecordUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetInternalID sets the "internal_id" field.
+func (_u *ImportRecordUpdate) SetInternalID(v xid.ID) *ImportRecordUpdate {
+	_u.mutation.SetInternalID(v)
+	return _u
+}
+
+// SetNillableInternalID sets the "internal_id" field if the given value is not nil.
+func (_u *ImportRecordUpdate) SetNillableInternalID(v *xid.ID) *ImportRecordUpdate {
+	if v != nil {
+		_u.SetInternalID(*v)
+	}
+	return _u
+}
+
+// ClearInternalID clears the value of the "internal_id" field.
+func (_u *ImportRecordUpdate) ClearInternalID() *ImportRecordUpdate {
+	_u.mutation.ClearInternalID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportRecordUpdate) SetStatus(v importrecord.Status) *ImportRecordUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportRecordUpdate) SetNillableStatus(v *importrecord.Status) *ImportRecordUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ImportRecordUpdate) SetMessage(v string) *ImportRecordUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ImportRecordUpdate) SetNillableMessage(v *string) *ImportRecordUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ImportRecordUpdate) ClearMessage() *ImportRecordUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetRun sets the "run" edge to the ImportRun entity.
+func (_u *ImportRecordUpdate) SetRun(v *ImportRun) *ImportRecordUpdate {
+	return _u.SetRunID(v.ID)
+}
+
+// Mutation returns the ImportRecordMutation object of the builder.
+func (_u *ImportRecordUpdate) Mutation() *ImportRecordMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the ImportRun entity.
+func (_u *ImportRecordUpdate) ClearRun() *ImportRecordUpdate {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportRecordUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportRecordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportRecordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportRecordUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importrecord.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportRecordUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importrecord.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRecord.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportRecord.run"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportRecordUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportRecordUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importrecord.Table, importrecord.Columns, sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importrecord.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(importrecord.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(importrecord.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(importrecord.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InternalID(); ok {
+		_spec.SetField(importrecord.FieldInternalID, field.TypeString, value)
+	}
+	if _u.mutation.InternalIDCleared() {
+		_spec.ClearField(importrecord.FieldInternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importrecord.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(importrecord.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(importrecord.FieldMessage, field.TypeString)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrecord.RunTable,
+			Columns: []string{importrecord.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrecord.RunTable,
+			Columns: []string{importrecord.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportRecordUpdateOne is the builder for updating a single ImportRecord entity.
+type ImportRecordUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportRecordUpdateOne) SetUpdatedAt(v time.Time) *ImportRecordUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *ImportRecordUpdateOne) SetRunID(v xid.ID) *ImportRecordUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *ImportRecordUpdateOne) SetNillableRunID(v *xid.ID) *ImportRecordUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ImportRecordUpdateOne) SetSource(v string) *ImportRecordUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ImportRecordUpdateOne) SetNillableSource(v *string) *ImportRecordUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ImportRecordUpdateOne) SetKind(v string) *ImportRecordUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ImportRecordUpdateOne) SetNillableKind(v *string) *ImportRecordUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ImportRecordUpdateOne) SetExternalID(v string) *ImportRecordUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ImportRecordUpdateOne) SetNillableExternalID(v *string) *ImportRecordUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetInternalID sets the "internal_id" field.
+func (_u *ImportRecordUpdateOne) SetInternalID(v xid.ID) *ImportRecordUpdateOne {
+	_u.mutation.SetInternalID(v)
+	return _u
+}
+
+// SetNillableInternalID sets the "internal_id" field if the given value is not nil.
+func (_u *ImportRecordUpdateOne) SetNillableInternalID(v *xid.ID) *ImportRecordUpdateOne {
+	if v != nil {
+		_u.SetInternalID(*v)
+	}
+	return _u
+}
+
+// ClearInternalID clears the value of the "internal_id" field.
+func (_u *ImportRecordUpdateOne) ClearInternalID() *ImportRecordUpdateOne {
+	_u.mutation.ClearInternalID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportRecordUpdateOne) SetStatus(v importrecord.Status) *ImportRecordUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportRecordUpdateOne) SetNillableStatus(v *importrecord.Status) *ImportRecordUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ImportRecordUpdateOne) SetMessage(v string) *ImportRecordUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ImportRecordUpdateOne) SetNillableMessage(v *string) *ImportRecordUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ImportRecordUpdateOne) ClearMessage() *ImportRecordUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetRun sets the "run" edge to the ImportRun entity.
+func (_u *ImportRecordUpdateOne) SetRun(v *ImportRun) *ImportRecordUpdateOne {
+	return _u.SetRunID(v.ID)
+}
+
+// Mutation returns the ImportRecordMutation object of the builder.
+func (_u *ImportRecordUpdateOne) Mutation() *ImportRecordMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the ImportRun entity.
+func (_u *ImportRecordUpdateOne) ClearRun() *ImportRecordUpdateOne {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// Where appends a list predicates to the ImportRecordUpdate builder.
+func (_u *ImportRecordUpdateOne) Where(ps ...predicate.ImportRecord) *ImportRecordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportRecordUpdateOne) Select(field string, fields ...string) *ImportRecordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportRecord entity.
+func (_u *ImportRecordUpdateOne) Save(ctx context.Context) (*ImportRecord, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportRecordUpdateOne) SaveX(ctx context.Context) *ImportRecord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportRecordUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importrecord.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importrecord.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRecord.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportRecord.run"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportRecordUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportRecordUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportRecordUpdateOne) sqlSave(ctx context.Context) (_node *ImportRecord, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importrecord.Table, importrecord.Columns, sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importrecord.FieldID)
+		for _, f := range fields {
+			if !importrecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importrecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importrecord.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(importrecord.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(importrecord.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(importrecord.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InternalID(); ok {
+		_spec.SetField(importrecord.FieldInternalID, field.TypeString, value)
+	}
+	if _u.mutation.InternalIDCleared() {
+		_spec.ClearField(importrecord.FieldInternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importrecord.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(importrecord.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(importrecord.FieldMessage, field.TypeString)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrecord.RunTable,
+			Columns: []string{importrecord.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrecord.RunTable,
+			Columns: []string{importrecord.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportRecord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
+	"github.com/rs/xid"
+)
+
+// ImportRun is the model entity for the ImportRun schema.
+type ImportRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The platform the archive was exported from.
+	Source string `json:"source,omitempty"`
+	// The member who started the import, uploads are owned by them.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// The uploaded archive, if the source is read from one.
+	AssetID *xid.ID `json:"asset_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status importrun.Status `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportRunQuery when eager-loading is set.
+	Edges        ImportRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportRunEdges holds the relations/edges for other nodes in the graph.
+type ImportRunEdges struct {
+	// Records holds the value of the records edge.
+	Records []*ImportRecord `json:"records,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RecordsOrErr returns the Records value or an error if the edge
+// was not loaded in eager-loading.
+func (e ImportRunEdges) RecordsOrErr() ([]*ImportRecord, error) {
+	if e.loadedTypes[0] {
+		return e.Records, nil
+	}
+	return nil, &NotLoadedError{edge: "records"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importrun.FieldAssetID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case importrun.FieldSource, importrun.FieldStatus, importrun.FieldError:
+			values[i] = new(sql.NullString)
+		case importrun.FieldCreatedAt, importrun.FieldUpdatedAt, importrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case importrun.FieldID, importrun.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportRun fields.
+func (_m *ImportRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importrun.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case importrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case importrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case importrun.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case importrun.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case importrun.FieldAssetID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id", values[i])
+			} else if value.Valid {
+				_m.AssetID = new(xid.ID)
+				*_m.AssetID = *value.S.(*xid.ID)
+			}
+		case importrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = importrun.Status(value.String)
+			}
+		case importrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		case importrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRecords queries the "records" edge of the ImportRun entity.
+func (_m *ImportRun) QueryRecords() *ImportRecordQuery {
+	return NewImportRunClient(_m.config).QueryRecords(_m)
+}
+
+// Update returns a builder for updating this ImportRun.
+// Note that you need to call ImportRun.Unwrap() before calling this method if this ImportRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportRun) Update() *ImportRunUpdateOne {
+	return NewImportRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportRun) Unwrap() *ImportRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.AssetID; v != nil {
+		builder.WriteString("asset_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportRuns is a parsable slice of ImportRun.
+type ImportRuns []*ImportRun
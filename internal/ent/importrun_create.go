@@ -0,0 +1,999 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
+	"github.com/rs/xid"
+)
+
+// ImportRunCreate is the builder for creating a ImportRun entity.
+type ImportRunCreate struct {
+	config
+	mutation *ImportRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImportRunCreate) SetCreatedAt(v time.Time) *ImportRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableCreatedAt(v *time.Time) *ImportRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImportRunCreate) SetUpdatedAt(v time.Time) *ImportRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableUpdatedAt(v *time.Time) *ImportRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ImportRunCreate) SetSource(v string) *ImportRunCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ImportRunCreate) SetAccountID(v xid.ID) *ImportRunCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *ImportRunCreate) SetAssetID(v xid.ID) *ImportRunCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableAssetID(v *xid.ID) *ImportRunCreate {
+	if v != nil {
+		_c.SetAssetID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ImportRunCreate) SetStatus(v importrun.Status) *ImportRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableStatus(v *importrun.Status) *ImportRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ImportRunCreate) SetError(v string) *ImportRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableError(v *string) *ImportRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ImportRunCreate) SetFinishedAt(v time.Time) *ImportRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableFinishedAt(v *time.Time) *ImportRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImportRunCreate) SetID(v xid.ID) *ImportRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableID(v *xid.ID) *ImportRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddRecordIDs adds the "records" edge to the ImportRecord entity by IDs.
+func (_c *ImportRunCreate) AddRecordIDs(ids ...xid.ID) *ImportRunCreate {
+	_c.mutation.AddRecordIDs(ids...)
+	return _c
+}
+
+// AddRecords adds the "records" edges to the ImportRecord entity.
+func (_c *ImportRunCreate) AddRecords(v ...*ImportRecord) *ImportRunCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecordIDs(ids...)
+}
+
+// Mutation returns the ImportRunMutation object of the builder.
+func (_c *ImportRunCreate) Mutation() *ImportRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportRun in the database.
+func (_c *ImportRunCreate) Save(ctx context.Context) (*ImportRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportRunCreate) SaveX(ctx context.Context) *ImportRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportRunCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := importrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := importrun.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := importrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := importrun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportRunCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportRun.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImportRun.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ImportRun.source"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ImportRun.account_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := importrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := importrun.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ImportRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ImportRunCreate) sqlSave(ctx context.Context) (*ImportRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportRunCreate) createSpec() (*ImportRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importrun.Table, sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(importrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(importrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(importrun.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(importrun.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.AssetID(); ok {
+		_spec.SetField(importrun.FieldAssetID, field.TypeString, value)
+		_node.AssetID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(importrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(importrun.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(importrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := _c.mutation.RecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.RecordsTable,
+			Columns: []string{importrun.RecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportRun.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportRunCreate) OnConflict(opts ...sql.ConflictOption) *ImportRunUpsertOne {
+	_c.conflict = opts
+	return &ImportRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportRunCreate) OnConflictColumns(columns ...string) *ImportRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportRunUpsertOne is the builder for "upsert"-ing
+	//  one ImportRun node.
+	ImportRunUpsertOne struct {
+		create *ImportRunCreate
+	}
+
+	// ImportRunUpsert is the "OnConflict" setter.
+	ImportRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportRunUpsert) SetUpdatedAt(v time.Time) *ImportRunUpsert {
+	u.Set(importrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateUpdatedAt() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldUpdatedAt)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *ImportRunUpsert) SetSource(v string) *ImportRunUpsert {
+	u.Set(importrun.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateSource() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldSource)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ImportRunUpsert) SetAccountID(v xid.ID) *ImportRunUpsert {
+	u.Set(importrun.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateAccountID() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldAccountID)
+	return u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (u *ImportRunUpsert) SetAssetID(v xid.ID) *ImportRunUpsert {
+	u.Set(importrun.FieldAssetID, v)
+	return u
+}
+
+// UpdateAssetID sets the "asset_id" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateAssetID() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldAssetID)
+	return u
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (u *ImportRunUpsert) ClearAssetID() *ImportRunUpsert {
+	u.SetNull(importrun.FieldAssetID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRunUpsert) SetStatus(v importrun.Status) *ImportRunUpsert {
+	u.Set(importrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateStatus() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ImportRunUpsert) SetError(v string) *ImportRunUpsert {
+	u.Set(importrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateError() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportRunUpsert) ClearError() *ImportRunUpsert {
+	u.SetNull(importrun.FieldError)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportRunUpsert) SetFinishedAt(v time.Time) *ImportRunUpsert {
+	u.Set(importrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateFinishedAt() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportRunUpsert) ClearFinishedAt() *ImportRunUpsert {
+	u.SetNull(importrun.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportRunUpsertOne) UpdateNewValues() *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(importrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(importrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportRunUpsertOne) Ignore() *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportRunUpsertOne) DoNothing() *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportRunCreate.OnConflict
+// documentation for more info.
+func (u *ImportRunUpsertOne) Update(set func(*ImportRunUpsert)) *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportRunUpsertOne) SetUpdatedAt(v time.Time) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateUpdatedAt() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ImportRunUpsertOne) SetSource(v string) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateSource() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ImportRunUpsertOne) SetAccountID(v xid.ID) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateAccountID() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAssetID sets the "asset_id" field.
+func (u *ImportRunUpsertOne) SetAssetID(v xid.ID) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetAssetID(v)
+	})
+}
+
+// UpdateAssetID sets the "asset_id" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateAssetID() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateAssetID()
+	})
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (u *ImportRunUpsertOne) ClearAssetID() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearAssetID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRunUpsertOne) SetStatus(v importrun.Status) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateStatus() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ImportRunUpsertOne) SetError(v string) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateError() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportRunUpsertOne) ClearError() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportRunUpsertOne) SetFinishedAt(v time.Time) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateFinishedAt() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportRunUpsertOne) ClearFinishedAt() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportRunUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ImportRunUpsertOne.ID is not supported by MySQL driver. Use ImportRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportRunUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportRunCreateBulk is the builder for creating many ImportRun entities in bulk.
+type ImportRunCreateBulk struct {
+	config
+	err      error
+	builders []*ImportRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportRun entities in the database.
+func (_c *ImportRunCreateBulk) Save(ctx context.Context) ([]*ImportRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportRunCreateBulk) SaveX(ctx context.Context) []*ImportRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportRunUpsertBulk {
+	_c.conflict = opts
+	return &ImportRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportRunCreateBulk) OnConflictColumns(columns ...string) *ImportRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportRun nodes.
+type ImportRunUpsertBulk struct {
+	create *ImportRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportRunUpsertBulk) UpdateNewValues() *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(importrun.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(importrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportRunUpsertBulk) Ignore() *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportRunUpsertBulk) DoNothing() *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportRunUpsertBulk) Update(set func(*ImportRunUpsert)) *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportRunUpsertBulk) SetUpdatedAt(v time.Time) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateUpdatedAt() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ImportRunUpsertBulk) SetSource(v string) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateSource() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ImportRunUpsertBulk) SetAccountID(v xid.ID) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateAccountID() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAssetID sets the "asset_id" field.
+func (u *ImportRunUpsertBulk) SetAssetID(v xid.ID) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetAssetID(v)
+	})
+}
+
+// UpdateAssetID sets the "asset_id" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateAssetID() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateAssetID()
+	})
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (u *ImportRunUpsertBulk) ClearAssetID() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearAssetID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRunUpsertBulk) SetStatus(v importrun.Status) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateStatus() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ImportRunUpsertBulk) SetError(v string) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateError() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportRunUpsertBulk) ClearError() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportRunUpsertBulk) SetFinishedAt(v time.Time) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateFinishedAt() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportRunUpsertBulk) ClearFinishedAt() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ImportRunUpdate is the builder for updating ImportRun entities.
+type ImportRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportRunUpdate builder.
+func (_u *ImportRunUpdate) Where(ps ...predicate.ImportRun) *ImportRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportRunUpdate) SetUpdatedAt(v time.Time) *ImportRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ImportRunUpdate) SetSource(v string) *ImportRunUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableSource(v *string) *ImportRunUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ImportRunUpdate) SetAccountID(v xid.ID) *ImportRunUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableAccountID(v *xid.ID) *ImportRunUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *ImportRunUpdate) SetAssetID(v xid.ID) *ImportRunUpdate {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableAssetID(v *xid.ID) *ImportRunUpdate {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (_u *ImportRunUpdate) ClearAssetID() *ImportRunUpdate {
+	_u.mutation.ClearAssetID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportRunUpdate) SetStatus(v importrun.Status) *ImportRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableStatus(v *importrun.Status) *ImportRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ImportRunUpdate) SetError(v string) *ImportRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableError(v *string) *ImportRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ImportRunUpdate) ClearError() *ImportRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportRunUpdate) SetFinishedAt(v time.Time) *ImportRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableFinishedAt(v *time.Time) *ImportRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportRunUpdate) ClearFinishedAt() *ImportRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// AddRecordIDs adds the "records" edge to the ImportRecord entity by IDs.
+func (_u *ImportRunUpdate) AddRecordIDs(ids ...xid.ID) *ImportRunUpdate {
+	_u.mutation.AddRecordIDs(ids...)
+	return _u
+}
+
+// AddRecords adds the "records" edges to the ImportRecord entity.
+func (_u *ImportRunUpdate) AddRecords(v ...*ImportRecord) *ImportRunUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecordIDs(ids...)
+}
+
+// Mutation returns the ImportRunMutation object of the builder.
+func (_u *ImportRunUpdate) Mutation() *ImportRunMutation {
+	return _u.mutation
+}
+
+// ClearRecords clears all "records" edges to the ImportRecord entity.
+func (_u *ImportRunUpdate) ClearRecords() *ImportRunUpdate {
+	_u.mutation.ClearRecords()
+	return _u
+}
+
+// RemoveRecordIDs removes the "records" edge to ImportRecord entities by IDs.
+func (_u *ImportRunUpdate) RemoveRecordIDs(ids ...xid.ID) *ImportRunUpdate {
+	_u.mutation.RemoveRecordIDs(ids...)
+	return _u
+}
+
+// RemoveRecords removes "records" edges to ImportRecord entities.
+func (_u *ImportRunUpdate) RemoveRecords(v ...*ImportRecord) *ImportRunUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecordIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportRunUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importrun.Table, importrun.Columns, sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(importrun.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(importrun.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetID(); ok {
+		_spec.SetField(importrun.FieldAssetID, field.TypeString, value)
+	}
+	if _u.mutation.AssetIDCleared() {
+		_spec.ClearField(importrun.FieldAssetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(importrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(importrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importrun.FieldFinishedAt, field.TypeTime)
+	}
+	if _u.mutation.RecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.RecordsTable,
+			Columns: []string{importrun.RecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecordsIDs(); len(nodes) > 0 && !_u.mutation.RecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.RecordsTable,
+			Columns: []string{importrun.RecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.RecordsTable,
+			Columns: []string{importrun.RecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportRunUpdateOne is the builder for updating a single ImportRun entity.
+type ImportRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportRunUpdateOne) SetUpdatedAt(v time.Time) *ImportRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ImportRunUpdateOne) SetSource(v string) *ImportRunUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableSource(v *string) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ImportRunUpdateOne) SetAccountID(v xid.ID) *ImportRunUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableAccountID(v *xid.ID) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *ImportRunUpdateOne) SetAssetID(v xid.ID) *ImportRunUpdateOne {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableAssetID(v *xid.ID) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (_u *ImportRunUpdateOne) ClearAssetID() *ImportRunUpdateOne {
+	_u.mutation.ClearAssetID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportRunUpdateOne) SetStatus(v importrun.Status) *ImportRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableStatus(v *importrun.Status) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ImportRunUpdateOne) SetError(v string) *ImportRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableError(v *string) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ImportRunUpdateOne) ClearError() *ImportRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportRunUpdateOne) SetFinishedAt(v time.Time) *ImportRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableFinishedAt(v *time.Time) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportRunUpdateOne) ClearFinishedAt() *ImportRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// AddRecordIDs adds the "records" edge to the ImportRecord entity by IDs.
+func (_u *ImportRunUpdateOne) AddRecordIDs(ids ...xid.ID) *ImportRunUpdateOne {
+	_u.mutation.AddRecordIDs(ids...)
+	return _u
+}
+
+// AddRecords adds the "records" edges to the ImportRecord entity.
+func (_u *ImportRunUpdateOne) AddRecords(v ...*ImportRecord) *ImportRunUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecordIDs(ids...)
+}
+
+// Mutation returns the ImportRunMutation object of the builder.
+func (_u *ImportRunUpdateOne) Mutation() *ImportRunMutation {
+	return _u.mutation
+}
+
+// ClearRecords clears all "records" edges to the ImportRecord entity.
+func (_u *ImportRunUpdateOne) ClearRecords() *ImportRunUpdateOne {
+	_u.mutation.ClearRecords()
+	return _u
+}
+
+// RemoveRecordIDs removes the "records" edge to ImportRecord entities by IDs.
+func (_u *ImportRunUpdateOne) RemoveRecordIDs(ids ...xid.ID) *ImportRunUpdateOne {
+	_u.mutation.RemoveRecordIDs(ids...)
+	return _u
+}
+
+// RemoveRecords removes "records" edges to ImportRecord entities.
+func (_u *ImportRunUpdateOne) RemoveRecords(v ...*ImportRecord) *ImportRunUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecordIDs(ids...)
+}
+
+// Where appends a list predicates to the ImportRunUpdate builder.
+func (_u *ImportRunUpdateOne) Where(ps ...predicate.ImportRun) *ImportRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportRunUpdateOne) Select(field string, fields ...string) *ImportRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportRun entity.
+func (_u *ImportRunUpdateOne) Save(ctx context.Context) (*ImportRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportRunUpdateOne) SaveX(ctx context.Context) *ImportRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportRunUpdateOne) sqlSave(ctx context.Context) (_node *ImportRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importrun.Table, importrun.Columns, sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importrun.FieldID)
+		for _, f := range fields {
+			if !importrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(importrun.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(importrun.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetID(); ok {
+		_spec.SetField(importrun.FieldAssetID, field.TypeString, value)
+	}
+	if _u.mutation.AssetIDCleared() {
+		_spec.ClearField(importrun.FieldAssetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(importrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(importrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importrun.FieldFinishedAt, field.TypeTime)
+	}
+	if _u.mutation.RecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.RecordsTable,
+			Columns: []string{importrun.RecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecordsIDs(); len(nodes) > 0 && !_u.mutation.RecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.RecordsTable,
+			Columns: []string{importrun.RecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.RecordsTable,
+			Columns: []string{importrun.RecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrecord.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/job"
+	"github.com/rs/xid"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JobCreate) SetCreatedAt(v time.Time) *JobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableCreatedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *JobCreate) SetUpdatedAt(v time.Time) *JobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableUpdatedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *JobCreate) SetKind(v string) *JobCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *JobCreate) SetPayload(v []byte) *JobCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetUniqueKey sets the "unique_key" field.
+func (_c *JobCreate) SetUniqueKey(v string) *JobCreate {
+	_c.mutation.SetUniqueKey(v)
+	return _c
+}
+
+// SetNillableUniqueKey sets the "unique_key" field if the given value is not nil.
+func (_c *JobCreate) SetNillableUniqueKey(v *string) *JobCreate {
+	if v != nil {
+		_c.SetUniqueKey(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *JobCreate) SetStatus(v job.Status) *JobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *JobCreate) SetNillableStatus(v *job.Status) *JobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *JobCreate) SetAttempts(v int) *JobCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *JobCreate) SetNillableAttempts(v *int) *JobCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *JobCreate) SetMaxAttempts(v int) *JobCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetRunAt sets the "run_at" field.
+func (_c *JobCreate) SetRunAt(v time.Time) *JobCreate {
+	_c.mutation.SetRunAt(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *JobCreate) SetStartedAt(v time.Time) *JobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableStartedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *JobCreate) SetFinishedAt(v time.Time) *JobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableFinishedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *JobCreate) SetLastError(v string) *JobCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *JobCreate) SetNillableLastError(v *string) *JobCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JobCreate) SetID(v xid.ID) *JobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *JobCreate) SetNillableID(v *xid.ID) *JobCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (_c *JobCreate) Mutation() *JobMutation {
+	return _c.mutation
+}
+
+// Save creates the Job in the database.
+func (_c *JobCreate) Save(ctx context.Context) (*Job, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JobCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := job.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := job.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := job.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := job.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := job.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JobCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Job.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Job.kind"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "Job.payload"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Job.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Job.attempts"`)}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "Job.max_attempts"`)}
+	}
+	if _, ok := _c.mutation.RunAt(); !ok {
+		return &ValidationError{Name: "run_at", err: errors.New(`ent: missing required field "Job.run_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := job.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Job.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Job{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(job.Table, sqlgraph.NewFieldSpec(job.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(job.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(job.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.UniqueKey(); ok {
+		_spec.SetField(job.FieldUniqueKey, field.TypeString, value)
+		_node.UniqueKey = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.RunAt(); ok {
+		_spec.SetField(job.FieldRunAt, field.TypeTime, value)
+		_node.RunAt = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(job.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobCreate) OnConflict(opts ...sql.ConflictOption) *JobUpsertOne {
+	_c.conflict = opts
+	return &JobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobCreate) OnConflictColumns(columns ...string) *JobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// JobUpsertOne is the builder for "upsert"-ing
+	//  one Job node.
+	JobUpsertOne struct {
+		create *JobCreate
+	}
+
+	// JobUpsert is the "OnConflict" setter.
+	JobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsert) SetUpdatedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUpdatedAt() *JobUpsert {
+	u.SetExcluded(job.FieldUpdatedAt)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *JobUpsert) SetKind(v string) *JobUpsert {
+	u.Set(job.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *JobUpsert) UpdateKind() *JobUpsert {
+	u.SetExcluded(job.FieldKind)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobUpsert) SetPayload(v []byte) *JobUpsert {
+	u.Set(job.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobUpsert) UpdatePayload() *JobUpsert {
+	u.SetExcluded(job.FieldPayload)
+	return u
+}
+
+// SetUniqueKey sets the "unique_key" field.
+func (u *JobUpsert) SetUniqueKey(v string) *JobUpsert {
+	u.Set(job.FieldUniqueKey, v)
+	return u
+}
+
+// UpdateUniqueKey sets the "unique_key" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUniqueKey() *JobUpsert {
+	u.SetExcluded(job.FieldUniqueKey)
+	return u
+}
+
+// ClearUniqueKey clears the value of the "unique_key" field.
+func (u *JobUpsert) ClearUniqueKey() *JobUpsert {
+	u.SetNull(job.FieldUniqueKey)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsert) SetStatus(v job.Status) *JobUpsert {
+	u.Set(job.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsert) UpdateStatus() *JobUpsert {
+	u.SetExcluded(job.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsert) SetAttempts(v int) *JobUpsert {
+	u.Set(job.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsert) AddAttempts(v int) *JobUpsert {
+	u.Add(job.FieldAttempts, v)
+	return u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsert) SetMaxAttempts(v int) *JobUpsert {
+	u.Set(job.FieldMaxAttempts, v)
+	return u
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateMaxAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldMaxAttempts)
+	return u
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsert) AddMaxAttempts(v int) *JobUpsert {
+	u.Add(job.FieldMaxAttempts, v)
+	return u
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsert) SetRunAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldRunAt, v)
+	return u
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateRunAt() *JobUpsert {
+	u.SetExcluded(job.FieldRunAt)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobUpsert) SetStartedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateStartedAt() *JobUpsert {
+	u.SetExcluded(job.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *JobUpsert) ClearStartedAt() *JobUpsert {
+	u.SetNull(job.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobUpsert) SetFinishedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateFinishedAt() *JobUpsert {
+	u.SetExcluded(job.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobUpsert) ClearFinishedAt() *JobUpsert {
+	u.SetNull(job.FieldFinishedAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsert) SetLastError(v string) *JobUpsert {
+	u.Set(job.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLastError() *JobUpsert {
+	u.SetExcluded(job.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsert) ClearLastError() *JobUpsert {
+	u.SetNull(job.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(job.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertOne) UpdateNewValues() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(job.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(job.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobUpsertOne) Ignore() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertOne) DoNothing() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreate.OnConflict
+// documentation for more info.
+func (u *JobUpsertOne) Update(set func(*JobUpsert)) *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertOne) SetUpdatedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUpdatedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *JobUpsertOne) SetKind(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateKind() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobUpsertOne) SetPayload(v []byte) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdatePayload() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetUniqueKey sets the "unique_key" field.
+func (u *JobUpsertOne) SetUniqueKey(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUniqueKey(v)
+	})
+}
+
+// UpdateUniqueKey sets the "unique_key" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUniqueKey() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUniqueKey()
+	})
+}
+
+// ClearUniqueKey clears the value of the "unique_key" field.
+func (u *JobUpsertOne) ClearUniqueKey() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearUniqueKey()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsertOne) SetStatus(v job.Status) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateStatus() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertOne) SetAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertOne) AddAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsertOne) SetMaxAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsertOne) AddMaxAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateMaxAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsertOne) SetRunAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateRunAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobUpsertOne) SetStartedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateStartedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *JobUpsertOne) ClearStartedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobUpsertOne) SetFinishedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateFinishedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobUpsertOne) ClearFinishedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertOne) SetLastError(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertOne) ClearLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: JobUpsertOne.ID is not supported by MySQL driver. Use JobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobCreateBulk is the builder for creating many Job entities in bulk.
+type JobCreateBulk struct {
+	config
+	err      error
+	builders []*JobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Job entities in the database.
+func (_c *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Job, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JobCreateBulk) SaveX(ctx context.Context) []*Job {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobUpsertBulk {
+	_c.conflict = opts
+	return &JobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobCreateBulk) OnConflictColumns(columns ...string) *JobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertBulk{
+		create: _c,
+	}
+}
+
+// JobUpsertBulk is the builder for "upsert"-ing
+// a bulk of Job nodes.
+type JobUpsertBulk struct {
+	create *JobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(job.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertBulk) UpdateNewValues() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(job.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(job.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobUpsertBulk) Ignore() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertBulk) DoNothing() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobUpsertBulk) Update(set func(*JobUpsert)) *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertBulk) SetUpdatedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUpdatedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *JobUpsertBulk) SetKind(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateKind() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobUpsertBulk) SetPayload(v []byte) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdatePayload() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetUniqueKey sets the "unique_key" field.
+func (u *JobUpsertBulk) SetUniqueKey(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUniqueKey(v)
+	})
+}
+
+// UpdateUniqueKey sets the "unique_key" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUniqueKey() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUniqueKey()
+	})
+}
+
+// ClearUniqueKey clears the value of the "unique_key" field.
+func (u *JobUpsertBulk) ClearUniqueKey() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearUniqueKey()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsertBulk) SetStatus(v job.Status) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateStatus() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertBulk) SetAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertBulk) AddAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsertBulk) SetMaxAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsertBulk) AddMaxAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateMaxAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsertBulk) SetRunAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateRunAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobUpsertBulk) SetStartedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateStartedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *JobUpsertBulk) ClearStartedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobUpsertBulk) SetFinishedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateFinishedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobUpsertBulk) ClearFinishedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertBulk) SetLastError(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertBulk) ClearLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -31,10 +31,26 @@ type Link struct {
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// SiteName holds the value of the "site_name" field.
+	SiteName *string `json:"site_name,omitempty"`
+	// FetchedAt holds the value of the "fetched_at" field.
+	FetchedAt *time.Time `json:"fetched_at,omitempty"`
+	// CheckedAt holds the value of the "checked_at" field.
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+	// CheckFailures holds the value of the "check_failures" field.
+	CheckFailures int `json:"check_failures,omitempty"`
+	// BrokenAt holds the value of the "broken_at" field.
+	BrokenAt *time.Time `json:"broken_at,omitempty"`
 	// PrimaryAssetID holds the value of the "primary_asset_id" field.
 	PrimaryAssetID *xid.ID `json:"primary_asset_id,omitempty"`
 	// FaviconAssetID holds the value of the "favicon_asset_id" field.
 	FaviconAssetID *xid.ID `json:"favicon_asset_id,omitempty"`
+	// SnapshotAssetID holds the value of the "snapshot_asset_id" field.
+	SnapshotAssetID *xid.ID `json:"snapshot_asset_id,omitempty"`
+	// The readable text of the snapshot, used to search links by their content.
+	SnapshotText *string `json:"snapshot_text,omitempty"`
+	// Where the page was archived by the Wayback Machine.
+	ArchiveURL *string `json:"archive_url,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LinkQuery when eager-loading is set.
 	Edges        LinkEdges `json:"edges"`
@@ -55,11 +71,15 @@ type LinkEdges struct {
 	PrimaryImage *Asset `json:"primary_image,omitempty"`
 	// FaviconImage holds the value of the favicon_image edge.
 	FaviconImage *Asset `json:"favicon_image,omitempty"`
+	// Snapshot holds the value of the snapshot edge.
+	Snapshot *Asset `json:"snapshot,omitempty"`
 	// Assets holds the value of the assets edge.
 	Assets []*Asset `json:"assets,omitempty"`
+	// Checks holds the value of the checks edge.
+	Checks []*LinkCheck `json:"checks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [9]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -120,25 +140,47 @@ func (e LinkEdges) FaviconImageOrErr() (*Asset, error) {
 	return nil, &NotLoadedError{edge: "favicon_image"}
 }
 
+// SnapshotOrErr returns the Snapshot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkEdges) SnapshotOrErr() (*Asset, error) {
+	if e.Snapshot != nil {
+		return e.Snapshot, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: asset.Label}
+	}
+	return nil, &NotLoadedError{edge: "snapshot"}
+}
+
 // AssetsOrErr returns the Assets value or an error if the edge
 // was not loaded in eager-loading.
 func (e LinkEdges) AssetsOrErr() ([]*Asset, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.Assets, nil
 	}
 	return nil, &NotLoadedError{edge: "assets"}
 }
 
+// ChecksOrErr returns the Checks value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkEdges) ChecksOrErr() ([]*LinkCheck, error) {
+	if e.loadedTypes[8] {
+		return e.Checks, nil
+	}
+	return nil, &NotLoadedError{edge: "checks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Link) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case link.FieldPrimaryAssetID, link.FieldFaviconAssetID:
+		case link.FieldPrimaryAssetID, link.FieldFaviconAssetID, link.FieldSnapshotAssetID:
 			values[i] = &sql.NullScanner{S: new(xid.ID)}
-		case link.FieldURL, link.FieldSlug, link.FieldDomain, link.FieldTitle, link.FieldDescription:
+		case link.FieldCheckFailures:
+			values[i] = new(sql.NullInt64)
+		case link.FieldURL, link.FieldSlug, link.FieldDomain, link.FieldTitle, link.FieldDescription, link.FieldSiteName, link.FieldSnapshotText, link.FieldArchiveURL:
 			values[i] = new(sql.NullString)
-		case link.FieldCreatedAt:
+		case link.FieldCreatedAt, link.FieldFetchedAt, link.FieldCheckedAt, link.FieldBrokenAt:
 			values[i] = new(sql.NullTime)
 		case link.FieldID:
 			values[i] = new(xid.ID)
@@ -199,6 +241,40 @@ func (_m *Link) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case link.FieldSiteName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_name", values[i])
+			} else if value.Valid {
+				_m.SiteName = new(string)
+				*_m.SiteName = value.String
+			}
+		case link.FieldFetchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field fetched_at", values[i])
+			} else if value.Valid {
+				_m.FetchedAt = new(time.Time)
+				*_m.FetchedAt = value.Time
+			}
+		case link.FieldCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
+			} else if value.Valid {
+				_m.CheckedAt = new(time.Time)
+				*_m.CheckedAt = value.Time
+			}
+		case link.FieldCheckFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_failures", values[i])
+			} else if value.Valid {
+				_m.CheckFailures = int(value.Int64)
+			}
+		case link.FieldBrokenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field broken_at", values[i])
+			} else if value.Valid {
+				_m.BrokenAt = new(time.Time)
+				*_m.BrokenAt = value.Time
+			}
 		case link.FieldPrimaryAssetID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field primary_asset_id", values[i])
@@ -213,6 +289,27 @@ func (_m *Link) assignValues(columns []string, values []any) error {
 				_m.FaviconAssetID = new(xid.ID)
 				*_m.FaviconAssetID = *value.S.(*xid.ID)
 			}
+		case link.FieldSnapshotAssetID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_asset_id", values[i])
+			} else if value.Valid {
+				_m.SnapshotAssetID = new(xid.ID)
+				*_m.SnapshotAssetID = *value.S.(*xid.ID)
+			}
+		case link.FieldSnapshotText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_text", values[i])
+			} else if value.Valid {
+				_m.SnapshotText = new(string)
+				*_m.SnapshotText = value.String
+			}
+		case link.FieldArchiveURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_url", values[i])
+			} else if value.Valid {
+				_m.ArchiveURL = new(string)
+				*_m.ArchiveURL = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -256,11 +353,21 @@ func (_m *Link) QueryFaviconImage() *AssetQuery {
 	return NewLinkClient(_m.config).QueryFaviconImage(_m)
 }
 
+// QuerySnapshot queries the "snapshot" edge of the Link entity.
+func (_m *Link) QuerySnapshot() *AssetQuery {
+	return NewLinkClient(_m.config).QuerySnapshot(_m)
+}
+
 // QueryAssets queries the "assets" edge of the Link entity.
 func (_m *Link) QueryAssets() *AssetQuery {
 	return NewLinkClient(_m.config).QueryAssets(_m)
 }
 
+// QueryChecks queries the "checks" edge of the Link entity.
+func (_m *Link) QueryChecks() *LinkCheckQuery {
+	return NewLinkClient(_m.config).QueryChecks(_m)
+}
+
 // Update returns a builder for updating this Link.
 // Note that you need to call Link.Unwrap() before calling this method if this Link
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -302,6 +409,29 @@ func (_m *Link) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	if v := _m.SiteName; v != nil {
+		builder.WriteString("site_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.FetchedAt; v != nil {
+		builder.WriteString("fetched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CheckedAt; v != nil {
+		builder.WriteString("checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("check_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CheckFailures))
+	builder.WriteString(", ")
+	if v := _m.BrokenAt; v != nil {
+		builder.WriteString("broken_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.PrimaryAssetID; v != nil {
 		builder.WriteString("primary_asset_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -311,6 +441,21 @@ func (_m *Link) String() string {
 		builder.WriteString("favicon_asset_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.SnapshotAssetID; v != nil {
+		builder.WriteString("snapshot_asset_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SnapshotText; v != nil {
+		builder.WriteString("snapshot_text=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ArchiveURL; v != nil {
+		builder.WriteString("archive_url=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -27,10 +27,26 @@ const (
 	FieldTitle = "title"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldSiteName holds the string denoting the site_name field in the database.
+	FieldSiteName = "site_name"
+	// FieldFetchedAt holds the string denoting the fetched_at field in the database.
+	FieldFetchedAt = "fetched_at"
+	// FieldCheckedAt holds the string denoting the checked_at field in the database.
+	FieldCheckedAt = "checked_at"
+	// FieldCheckFailures holds the string denoting the check_failures field in the database.
+	FieldCheckFailures = "check_failures"
+	// FieldBrokenAt holds the string denoting the broken_at field in the database.
+	FieldBrokenAt = "broken_at"
 	// FieldPrimaryAssetID holds the string denoting the primary_asset_id field in the database.
 	FieldPrimaryAssetID = "primary_asset_id"
 	// FieldFaviconAssetID holds the string denoting the favicon_asset_id field in the database.
 	FieldFaviconAssetID = "favicon_asset_id"
+	// FieldSnapshotAssetID holds the string denoting the snapshot_asset_id field in the database.
+	FieldSnapshotAssetID = "snapshot_asset_id"
+	// FieldSnapshotText holds the string denoting the snapshot_text field in the database.
+	FieldSnapshotText = "snapshot_text"
+	// FieldArchiveURL holds the string denoting the archive_url field in the database.
+	FieldArchiveURL = "archive_url"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgePostContentReferences holds the string denoting the post_content_references edge name in mutations.
@@ -43,8 +59,12 @@ const (
 	EdgePrimaryImage = "primary_image"
 	// EdgeFaviconImage holds the string denoting the favicon_image edge name in mutations.
 	EdgeFaviconImage = "favicon_image"
+	// EdgeSnapshot holds the string denoting the snapshot edge name in mutations.
+	EdgeSnapshot = "snapshot"
 	// EdgeAssets holds the string denoting the assets edge name in mutations.
 	EdgeAssets = "assets"
+	// EdgeChecks holds the string denoting the checks edge name in mutations.
+	EdgeChecks = "checks"
 	// Table holds the table name of the link in the database.
 	Table = "links"
 	// PostsTable is the table that holds the posts relation/edge.
@@ -85,11 +105,25 @@ const (
 	FaviconImageInverseTable = "assets"
 	// FaviconImageColumn is the table column denoting the favicon_image relation/edge.
 	FaviconImageColumn = "favicon_asset_id"
+	// SnapshotTable is the table that holds the snapshot relation/edge.
+	SnapshotTable = "links"
+	// SnapshotInverseTable is the table name for the Asset entity.
+	// It exists in this package in order to avoid circular dependency with the "asset" package.
+	SnapshotInverseTable = "assets"
+	// SnapshotColumn is the table column denoting the snapshot relation/edge.
+	SnapshotColumn = "snapshot_asset_id"
 	// AssetsTable is the table that holds the assets relation/edge. The primary key declared below.
 	AssetsTable = "link_assets"
 	// AssetsInverseTable is the table name for the Asset entity.
 	// It exists in this package in order to avoid circular dependency with the "asset" package.
 	AssetsInverseTable = "assets"
+	// ChecksTable is the table that holds the checks relation/edge.
+	ChecksTable = "link_checks"
+	// ChecksInverseTable is the table name for the LinkCheck entity.
+	// It exists in this package in order to avoid circular dependency with the "linkcheck" package.
+	ChecksInverseTable = "link_checks"
+	// ChecksColumn is the table column denoting the checks relation/edge.
+	ChecksColumn = "link_id"
 )
 
 // Columns holds all SQL columns for link fields.
@@ -101,8 +135,16 @@ var Columns = []string{
 	FieldDomain,
 	FieldTitle,
 	FieldDescription,
+	FieldSiteName,
+	FieldFetchedAt,
+	FieldCheckedAt,
+	FieldCheckFailures,
+	FieldBrokenAt,
 	FieldPrimaryAssetID,
 	FieldFaviconAssetID,
+	FieldSnapshotAssetID,
+	FieldSnapshotText,
+	FieldArchiveURL,
 }
 
 var (
@@ -130,6 +172,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultCheckFailures holds the default value on creation for the "check_failures" field.
+	DefaultCheckFailures int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() xid.ID
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -174,6 +218,31 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// BySiteName orders the results by the site_name field.
+func BySiteName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteName, opts...).ToFunc()
+}
+
+// ByFetchedAt orders the results by the fetched_at field.
+func ByFetchedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFetchedAt, opts...).ToFunc()
+}
+
+// ByCheckedAt orders the results by the checked_at field.
+func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
+}
+
+// ByCheckFailures orders the results by the check_failures field.
+func ByCheckFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckFailures, opts...).ToFunc()
+}
+
+// ByBrokenAt orders the results by the broken_at field.
+func ByBrokenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBrokenAt, opts...).ToFunc()
+}
+
 // ByPrimaryAssetID orders the results by the primary_asset_id field.
 func ByPrimaryAssetID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrimaryAssetID, opts...).ToFunc()
@@ -184,6 +253,21 @@ func ByFaviconAssetID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFaviconAssetID, opts...).ToFunc()
 }
 
+// BySnapshotAssetID orders the results by the snapshot_asset_id field.
+func BySnapshotAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnapshotAssetID, opts...).ToFunc()
+}
+
+// BySnapshotText orders the results by the snapshot_text field.
+func BySnapshotText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnapshotText, opts...).ToFunc()
+}
+
+// ByArchiveURL orders the results by the archive_url field.
+func ByArchiveURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveURL, opts...).ToFunc()
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -254,6 +338,13 @@ func ByFaviconImageField(field string, opts ...sql.OrderTermOption) OrderOption
 	}
 }
 
+// BySnapshotField orders the results by snapshot field.
+func BySnapshotField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByAssetsCount orders the results by assets count.
 func ByAssetsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -267,6 +358,20 @@ func ByAssets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAssetsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByChecksCount orders the results by checks count.
+func ByChecksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChecksStep(), opts...)
+	}
+}
+
+// ByChecks orders the results by checks terms.
+func ByChecks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChecksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -309,6 +414,13 @@ func newFaviconImageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, FaviconImageTable, FaviconImageColumn),
 	)
 }
+func newSnapshotStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SnapshotTable, SnapshotColumn),
+	)
+}
 func newAssetsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -316,3 +428,10 @@ func newAssetsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, AssetsTable, AssetsPrimaryKey...),
 	)
 }
+func newChecksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChecksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChecksTable, ChecksColumn),
+	)
+}
@@ -86,6 +86,31 @@ func Description(v string) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldDescription, v))
 }
 
+// SiteName applies equality check predicate on the "site_name" field. It's identical to SiteNameEQ.
+func SiteName(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSiteName, v))
+}
+
+// FetchedAt applies equality check predicate on the "fetched_at" field. It's identical to FetchedAtEQ.
+func FetchedAt(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldFetchedAt, v))
+}
+
+// CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
+func CheckedAt(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckFailures applies equality check predicate on the "check_failures" field. It's identical to CheckFailuresEQ.
+func CheckFailures(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCheckFailures, v))
+}
+
+// BrokenAt applies equality check predicate on the "broken_at" field. It's identical to BrokenAtEQ.
+func BrokenAt(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldBrokenAt, v))
+}
+
 // PrimaryAssetID applies equality check predicate on the "primary_asset_id" field. It's identical to PrimaryAssetIDEQ.
 func PrimaryAssetID(v xid.ID) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldPrimaryAssetID, v))
@@ -96,6 +121,21 @@ func FaviconAssetID(v xid.ID) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldFaviconAssetID, v))
 }
 
+// SnapshotAssetID applies equality check predicate on the "snapshot_asset_id" field. It's identical to SnapshotAssetIDEQ.
+func SnapshotAssetID(v xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSnapshotAssetID, v))
+}
+
+// SnapshotText applies equality check predicate on the "snapshot_text" field. It's identical to SnapshotTextEQ.
+func SnapshotText(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSnapshotText, v))
+}
+
+// ArchiveURL applies equality check predicate on the "archive_url" field. It's identical to ArchiveURLEQ.
+func ArchiveURL(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldArchiveURL, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldCreatedAt, v))
@@ -461,6 +501,271 @@ func DescriptionContainsFold(v string) predicate.Link {
 	return predicate.Link(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// SiteNameEQ applies the EQ predicate on the "site_name" field.
+func SiteNameEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSiteName, v))
+}
+
+// SiteNameNEQ applies the NEQ predicate on the "site_name" field.
+func SiteNameNEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldSiteName, v))
+}
+
+// SiteNameIn applies the In predicate on the "site_name" field.
+func SiteNameIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldSiteName, vs...))
+}
+
+// SiteNameNotIn applies the NotIn predicate on the "site_name" field.
+func SiteNameNotIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldSiteName, vs...))
+}
+
+// SiteNameGT applies the GT predicate on the "site_name" field.
+func SiteNameGT(v string) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldSiteName, v))
+}
+
+// SiteNameGTE applies the GTE predicate on the "site_name" field.
+func SiteNameGTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldSiteName, v))
+}
+
+// SiteNameLT applies the LT predicate on the "site_name" field.
+func SiteNameLT(v string) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldSiteName, v))
+}
+
+// SiteNameLTE applies the LTE predicate on the "site_name" field.
+func SiteNameLTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldSiteName, v))
+}
+
+// SiteNameContains applies the Contains predicate on the "site_name" field.
+func SiteNameContains(v string) predicate.Link {
+	return predicate.Link(sql.FieldContains(FieldSiteName, v))
+}
+
+// SiteNameHasPrefix applies the HasPrefix predicate on the "site_name" field.
+func SiteNameHasPrefix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasPrefix(FieldSiteName, v))
+}
+
+// SiteNameHasSuffix applies the HasSuffix predicate on the "site_name" field.
+func SiteNameHasSuffix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasSuffix(FieldSiteName, v))
+}
+
+// SiteNameIsNil applies the IsNil predicate on the "site_name" field.
+func SiteNameIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldSiteName))
+}
+
+// SiteNameNotNil applies the NotNil predicate on the "site_name" field.
+func SiteNameNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldSiteName))
+}
+
+// SiteNameEqualFold applies the EqualFold predicate on the "site_name" field.
+func SiteNameEqualFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldEqualFold(FieldSiteName, v))
+}
+
+// SiteNameContainsFold applies the ContainsFold predicate on the "site_name" field.
+func SiteNameContainsFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldContainsFold(FieldSiteName, v))
+}
+
+// FetchedAtEQ applies the EQ predicate on the "fetched_at" field.
+func FetchedAtEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldFetchedAt, v))
+}
+
+// FetchedAtNEQ applies the NEQ predicate on the "fetched_at" field.
+func FetchedAtNEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldFetchedAt, v))
+}
+
+// FetchedAtIn applies the In predicate on the "fetched_at" field.
+func FetchedAtIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldFetchedAt, vs...))
+}
+
+// FetchedAtNotIn applies the NotIn predicate on the "fetched_at" field.
+func FetchedAtNotIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldFetchedAt, vs...))
+}
+
+// FetchedAtGT applies the GT predicate on the "fetched_at" field.
+func FetchedAtGT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldFetchedAt, v))
+}
+
+// FetchedAtGTE applies the GTE predicate on the "fetched_at" field.
+func FetchedAtGTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldFetchedAt, v))
+}
+
+// FetchedAtLT applies the LT predicate on the "fetched_at" field.
+func FetchedAtLT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldFetchedAt, v))
+}
+
+// FetchedAtLTE applies the LTE predicate on the "fetched_at" field.
+func FetchedAtLTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldFetchedAt, v))
+}
+
+// FetchedAtIsNil applies the IsNil predicate on the "fetched_at" field.
+func FetchedAtIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldFetchedAt))
+}
+
+// FetchedAtNotNil applies the NotNil predicate on the "fetched_at" field.
+func FetchedAtNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldFetchedAt))
+}
+
+// CheckedAtEQ applies the EQ predicate on the "checked_at" field.
+func CheckedAtEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtNEQ applies the NEQ predicate on the "checked_at" field.
+func CheckedAtNEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtIn applies the In predicate on the "checked_at" field.
+func CheckedAtIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtNotIn applies the NotIn predicate on the "checked_at" field.
+func CheckedAtNotIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtGT applies the GT predicate on the "checked_at" field.
+func CheckedAtGT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldCheckedAt, v))
+}
+
+// CheckedAtGTE applies the GTE predicate on the "checked_at" field.
+func CheckedAtGTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldCheckedAt, v))
+}
+
+// CheckedAtLT applies the LT predicate on the "checked_at" field.
+func CheckedAtLT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldCheckedAt, v))
+}
+
+// CheckedAtLTE applies the LTE predicate on the "checked_at" field.
+func CheckedAtLTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldCheckedAt, v))
+}
+
+// CheckedAtIsNil applies the IsNil predicate on the "checked_at" field.
+func CheckedAtIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldCheckedAt))
+}
+
+// CheckedAtNotNil applies the NotNil predicate on the "checked_at" field.
+func CheckedAtNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldCheckedAt))
+}
+
+// CheckFailuresEQ applies the EQ predicate on the "check_failures" field.
+func CheckFailuresEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCheckFailures, v))
+}
+
+// CheckFailuresNEQ applies the NEQ predicate on the "check_failures" field.
+func CheckFailuresNEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldCheckFailures, v))
+}
+
+// CheckFailuresIn applies the In predicate on the "check_failures" field.
+func CheckFailuresIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldCheckFailures, vs...))
+}
+
+// CheckFailuresNotIn applies the NotIn predicate on the "check_failures" field.
+func CheckFailuresNotIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldCheckFailures, vs...))
+}
+
+// CheckFailuresGT applies the GT predicate on the "check_failures" field.
+func CheckFailuresGT(v int) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldCheckFailures, v))
+}
+
+// CheckFailuresGTE applies the GTE predicate on the "check_failures" field.
+func CheckFailuresGTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldCheckFailures, v))
+}
+
+// CheckFailuresLT applies the LT predicate on the "check_failures" field.
+func CheckFailuresLT(v int) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldCheckFailures, v))
+}
+
+// CheckFailuresLTE applies the LTE predicate on the "check_failures" field.
+func CheckFailuresLTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldCheckFailures, v))
+}
+
+// BrokenAtEQ applies the EQ predicate on the "broken_at" field.
+func BrokenAtEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldBrokenAt, v))
+}
+
+// BrokenAtNEQ applies the NEQ predicate on the "broken_at" field.
+func BrokenAtNEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldBrokenAt, v))
+}
+
+// BrokenAtIn applies the In predicate on the "broken_at" field.
+func BrokenAtIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldBrokenAt, vs...))
+}
+
+// BrokenAtNotIn applies the NotIn predicate on the "broken_at" field.
+func BrokenAtNotIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldBrokenAt, vs...))
+}
+
+// BrokenAtGT applies the GT predicate on the "broken_at" field.
+func BrokenAtGT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldBrokenAt, v))
+}
+
+// BrokenAtGTE applies the GTE predicate on the "broken_at" field.
+func BrokenAtGTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldBrokenAt, v))
+}
+
+// BrokenAtLT applies the LT predicate on the "broken_at" field.
+func BrokenAtLT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldBrokenAt, v))
+}
+
+// BrokenAtLTE applies the LTE predicate on the "broken_at" field.
+func BrokenAtLTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldBrokenAt, v))
+}
+
+// BrokenAtIsNil applies the IsNil predicate on the "broken_at" field.
+func BrokenAtIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldBrokenAt))
+}
+
+// BrokenAtNotNil applies the NotNil predicate on the "broken_at" field.
+func BrokenAtNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldBrokenAt))
+}
+
 // PrimaryAssetIDEQ applies the EQ predicate on the "primary_asset_id" field.
 func PrimaryAssetIDEQ(v xid.ID) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldPrimaryAssetID, v))
@@ -621,6 +926,236 @@ func FaviconAssetIDContainsFold(v xid.ID) predicate.Link {
 	return predicate.Link(sql.FieldContainsFold(FieldFaviconAssetID, vc))
 }
 
+// SnapshotAssetIDEQ applies the EQ predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDEQ(v xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSnapshotAssetID, v))
+}
+
+// SnapshotAssetIDNEQ applies the NEQ predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDNEQ(v xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldSnapshotAssetID, v))
+}
+
+// SnapshotAssetIDIn applies the In predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDIn(vs ...xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldSnapshotAssetID, vs...))
+}
+
+// SnapshotAssetIDNotIn applies the NotIn predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDNotIn(vs ...xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldSnapshotAssetID, vs...))
+}
+
+// SnapshotAssetIDGT applies the GT predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDGT(v xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldSnapshotAssetID, v))
+}
+
+// SnapshotAssetIDGTE applies the GTE predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDGTE(v xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldSnapshotAssetID, v))
+}
+
+// SnapshotAssetIDLT applies the LT predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDLT(v xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldSnapshotAssetID, v))
+}
+
+// SnapshotAssetIDLTE applies the LTE predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDLTE(v xid.ID) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldSnapshotAssetID, v))
+}
+
+// SnapshotAssetIDContains applies the Contains predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDContains(v xid.ID) predicate.Link {
+	vc := v.String()
+	return predicate.Link(sql.FieldContains(FieldSnapshotAssetID, vc))
+}
+
+// SnapshotAssetIDHasPrefix applies the HasPrefix predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDHasPrefix(v xid.ID) predicate.Link {
+	vc := v.String()
+	return predicate.Link(sql.FieldHasPrefix(FieldSnapshotAssetID, vc))
+}
+
+// SnapshotAssetIDHasSuffix applies the HasSuffix predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDHasSuffix(v xid.ID) predicate.Link {
+	vc := v.String()
+	return predicate.Link(sql.FieldHasSuffix(FieldSnapshotAssetID, vc))
+}
+
+// SnapshotAssetIDIsNil applies the IsNil predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldSnapshotAssetID))
+}
+
+// SnapshotAssetIDNotNil applies the NotNil predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldSnapshotAssetID))
+}
+
+// SnapshotAssetIDEqualFold applies the EqualFold predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDEqualFold(v xid.ID) predicate.Link {
+	vc := v.String()
+	return predicate.Link(sql.FieldEqualFold(FieldSnapshotAssetID, vc))
+}
+
+// SnapshotAssetIDContainsFold applies the ContainsFold predicate on the "snapshot_asset_id" field.
+func SnapshotAssetIDContainsFold(v xid.ID) predicate.Link {
+	vc := v.String()
+	return predicate.Link(sql.FieldContainsFold(FieldSnapshotAssetID, vc))
+}
+
+// SnapshotTextEQ applies the EQ predicate on the "snapshot_text" field.
+func SnapshotTextEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSnapshotText, v))
+}
+
+// SnapshotTextNEQ applies the NEQ predicate on the "snapshot_text" field.
+func SnapshotTextNEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldSnapshotText, v))
+}
+
+// SnapshotTextIn applies the In predicate on the "snapshot_text" field.
+func SnapshotTextIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldSnapshotText, vs...))
+}
+
+// SnapshotTextNotIn applies the NotIn predicate on the "snapshot_text" field.
+func SnapshotTextNotIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldSnapshotText, vs...))
+}
+
+// SnapshotTextGT applies the GT predicate on the "snapshot_text" field.
+func SnapshotTextGT(v string) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldSnapshotText, v))
+}
+
+// SnapshotTextGTE applies the GTE predicate on the "snapshot_text" field.
+func SnapshotTextGTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldSnapshotText, v))
+}
+
+// SnapshotTextLT applies the LT predicate on the "snapshot_text" field.
+func SnapshotTextLT(v string) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldSnapshotText, v))
+}
+
+// SnapshotTextLTE applies the LTE predicate on the "snapshot_text" field.
+func SnapshotTextLTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldSnapshotText, v))
+}
+
+// SnapshotTextContains applies the Contains predicate on the "snapshot_text" field.
+func SnapshotTextContains(v string) predicate.Link {
+	return predicate.Link(sql.FieldContains(FieldSnapshotText, v))
+}
+
+// SnapshotTextHasPrefix applies the HasPrefix predicate on the "snapshot_text" field.
+func SnapshotTextHasPrefix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasPrefix(FieldSnapshotText, v))
+}
+
+// SnapshotTextHasSuffix applies the HasSuffix predicate on the "snapshot_text" field.
+func SnapshotTextHasSuffix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasSuffix(FieldSnapshotText, v))
+}
+
+// SnapshotTextIsNil applies the IsNil predicate on the "snapshot_text" field.
+func SnapshotTextIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldSnapshotText))
+}
+
+// SnapshotTextNotNil applies the NotNil predicate on the "snapshot_text" field.
+func SnapshotTextNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldSnapshotText))
+}
+
+// SnapshotTextEqualFold applies the EqualFold predicate on the "snapshot_text" field.
+func SnapshotTextEqualFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldEqualFold(FieldSnapshotText, v))
+}
+
+// SnapshotTextContainsFold applies the ContainsFold predicate on the "snapshot_text" field.
+func SnapshotTextContainsFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldContainsFold(FieldSnapshotText, v))
+}
+
+// ArchiveURLEQ applies the EQ predicate on the "archive_url" field.
+func ArchiveURLEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldArchiveURL, v))
+}
+
+// ArchiveURLNEQ applies the NEQ predicate on the "archive_url" field.
+func ArchiveURLNEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldArchiveURL, v))
+}
+
+// ArchiveURLIn applies the In predicate on the "archive_url" field.
+func ArchiveURLIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldArchiveURL, vs...))
+}
+
+// ArchiveURLNotIn applies the NotIn predicate on the "archive_url" field.
+func ArchiveURLNotIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldArchiveURL, vs...))
+}
+
+// ArchiveURLGT applies the GT predicate on the "archive_url" field.
+func ArchiveURLGT(v string) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldArchiveURL, v))
+}
+
+// ArchiveURLGTE applies the GTE predicate on the "archive_url" field.
+func ArchiveURLGTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldArchiveURL, v))
+}
+
+// ArchiveURLLT applies the LT predicate on the "archive_url" field.
+func ArchiveURLLT(v string) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldArchiveURL, v))
+}
+
+// ArchiveURLLTE applies the LTE predicate on the "archive_url" field.
+func ArchiveURLLTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldArchiveURL, v))
+}
+
+// ArchiveURLContains applies the Contains predicate on the "archive_url" field.
+func ArchiveURLContains(v string) predicate.Link {
+	return predicate.Link(sql.FieldContains(FieldArchiveURL, v))
+}
+
+// ArchiveURLHasPrefix applies the HasPrefix predicate on the "archive_url" field.
+func ArchiveURLHasPrefix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasPrefix(FieldArchiveURL, v))
+}
+
+// ArchiveURLHasSuffix applies the HasSuffix predicate on the "archive_url" field.
+func ArchiveURLHasSuffix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasSuffix(FieldArchiveURL, v))
+}
+
+// ArchiveURLIsNil applies the IsNil predicate on the "archive_url" field.
+func ArchiveURLIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldArchiveURL))
+}
+
+// ArchiveURLNotNil applies the NotNil predicate on the "archive_url" field.
+func ArchiveURLNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldArchiveURL))
+}
+
+// ArchiveURLEqualFold applies the EqualFold predicate on the "archive_url" field.
+func ArchiveURLEqualFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldEqualFold(FieldArchiveURL, v))
+}
+
+// ArchiveURLContainsFold applies the ContainsFold predicate on the "archive_url" field.
+func ArchiveURLContainsFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldContainsFold(FieldArchiveURL, v))
+}
+
 // HasPosts applies the HasEdge predicate on the "posts" edge.
 func HasPosts() predicate.Link {
 	return predicate.Link(func(s *sql.Selector) {
@@ -759,6 +1294,29 @@ func HasFaviconImageWith(preds ...predicate.Asset) predicate.Link {
 	})
 }
 
+// HasSnapshot applies the HasEdge predicate on the "snapshot" edge.
+func HasSnapshot() predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SnapshotTable, SnapshotColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotWith applies the HasEdge predicate on the "snapshot" edge with a given conditions (other predicates).
+func HasSnapshotWith(preds ...predicate.Asset) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := newSnapshotStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAssets applies the HasEdge predicate on the "assets" edge.
 func HasAssets() predicate.Link {
 	return predicate.Link(func(s *sql.Selector) {
@@ -782,6 +1340,29 @@ func HasAssetsWith(preds ...predicate.Asset) predicate.Link {
 	})
 }
 
+// HasChecks applies the HasEdge predicate on the "checks" edge.
+func HasChecks() predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChecksTable, ChecksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChecksWith applies the HasEdge predicate on the "checks" edge with a given conditions (other predicates).
+func HasChecksWith(preds ...predicate.LinkCheck) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := newChecksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Link) predicate.Link {
 	return predicate.Link(sql.AndPredicates(predicates...))
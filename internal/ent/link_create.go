@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/rs/xid"
@@ -71,6 +72,76 @@ func (_c *LinkCreate) SetDescription(v string) *LinkCreate {
 	return _c
 }
 
+// SetSiteName sets the "site_name" field.
+func (_c *LinkCreate) SetSiteName(v string) *LinkCreate {
+	_c.mutation.SetSiteName(v)
+	return _c
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableSiteName(v *string) *LinkCreate {
+	if v != nil {
+		_c.SetSiteName(*v)
+	}
+	return _c
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_c *LinkCreate) SetFetchedAt(v time.Time) *LinkCreate {
+	_c.mutation.SetFetchedAt(v)
+	return _c
+}
+
+// SetNillableFetchedAt sets the "fetched_at" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableFetchedAt(v *time.Time) *LinkCreate {
+	if v != nil {
+		_c.SetFetchedAt(*v)
+	}
+	return _c
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (_c *LinkCreate) SetCheckedAt(v time.Time) *LinkCreate {
+	_c.mutation.SetCheckedAt(v)
+	return _c
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableCheckedAt(v *time.Time) *LinkCreate {
+	if v != nil {
+		_c.SetCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetCheckFailures sets the "check_failures" field.
+func (_c *LinkCreate) SetCheckFailures(v int) *LinkCreate {
+	_c.mutation.SetCheckFailures(v)
+	return _c
+}
+
+// SetNillableCheckFailures sets the "check_failures" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableCheckFailures(v *int) *LinkCreate {
+	if v != nil {
+		_c.SetCheckFailures(*v)
+	}
+	return _c
+}
+
+// SetBrokenAt sets the "broken_at" field.
+func (_c *LinkCreate) SetBrokenAt(v time.Time) *LinkCreate {
+	_c.mutation.SetBrokenAt(v)
+	return _c
+}
+
+// SetNillableBrokenAt sets the "broken_at" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableBrokenAt(v *time.Time) *LinkCreate {
+	if v != nil {
+		_c.SetBrokenAt(*v)
+	}
+	return _c
+}
+
 // SetPrimaryAssetID sets the "primary_asset_id" field.
 func (_c *LinkCreate) SetPrimaryAssetID(v xid.ID) *LinkCreate {
 	_c.mutation.SetPrimaryAssetID(v)
@@ -99,6 +170,48 @@ func (_c *LinkCreate) SetNillableFaviconAssetID(v *xid.ID) *LinkCreate {
 	return _c
 }
 
+// SetSnapshotAssetID sets the "snapshot_asset_id" field.
+func (_c *LinkCreate) SetSnapshotAssetID(v xid.ID) *LinkCreate {
+	_c.mutation.SetSnapshotAssetID(v)
+	return _c
+}
+
+// SetNillableSnapshotAssetID sets the "snapshot_asset_id" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableSnapshotAssetID(v *xid.ID) *LinkCreate {
+	if v != nil {
+		_c.SetSnapshotAssetID(*v)
+	}
+	return _c
+}
+
+// SetSnapshotText sets the "snapshot_text" field.
+func (_c *LinkCreate) SetSnapshotText(v string) *LinkCreate {
+	_c.mutation.SetSnapshotText(v)
+	return _c
+}
+
+// SetNillableSnapshotText sets the "snapshot_text" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableSnapshotText(v *string) *LinkCreate {
+	if v != nil {
+		_c.SetSnapshotText(*v)
+	}
+	return _c
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (_c *LinkCreate) SetArchiveURL(v string) *LinkCreate {
+	_c.mutation.SetArchiveURL(v)
+	return _c
+}
+
+// SetNillableArchiveURL sets the "archive_url" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableArchiveURL(v *string) *LinkCreate {
+	if v != nil {
+		_c.SetArchiveURL(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LinkCreate) SetID(v xid.ID) *LinkCreate {
 	_c.mutation.SetID(v)
@@ -211,6 +324,25 @@ func (_c *LinkCreate) SetFaviconImage(v *Asset) *LinkCreate {
 	return _c.SetFaviconImageID(v.ID)
 }
 
+// SetSnapshotID sets the "snapshot" edge to the Asset entity by ID.
+func (_c *LinkCreate) SetSnapshotID(id xid.ID) *LinkCreate {
+	_c.mutation.SetSnapshotID(id)
+	return _c
+}
+
+// SetNillableSnapshotID sets the "snapshot" edge to the Asset entity by ID if the given value is not nil.
+func (_c *LinkCreate) SetNillableSnapshotID(id *xid.ID) *LinkCreate {
+	if id != nil {
+		_c = _c.SetSnapshotID(*id)
+	}
+	return _c
+}
+
+// SetSnapshot sets the "snapshot" edge to the Asset entity.
+func (_c *LinkCreate) SetSnapshot(v *Asset) *LinkCreate {
+	return _c.SetSnapshotID(v.ID)
+}
+
 // AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
 func (_c *LinkCreate) AddAssetIDs(ids ...xid.ID) *LinkCreate {
 	_c.mutation.AddAssetIDs(ids...)
@@ -226,6 +358,21 @@ func (_c *LinkCreate) AddAssets(v ...*Asset) *LinkCreate {
 	return _c.AddAssetIDs(ids...)
 }
 
+// AddCheckIDs adds the "checks" edge to the LinkCheck entity by IDs.
+func (_c *LinkCreate) AddCheckIDs(ids ...xid.ID) *LinkCreate {
+	_c.mutation.AddCheckIDs(ids...)
+	return _c
+}
+
+// AddChecks adds the "checks" edges to the LinkCheck entity.
+func (_c *LinkCreate) AddChecks(v ...*LinkCheck) *LinkCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCheckIDs(ids...)
+}
+
 // Mutation returns the LinkMutation object of the builder.
 func (_c *LinkCreate) Mutation() *LinkMutation {
 	return _c.mutation
@@ -265,6 +412,10 @@ func (_c *LinkCreate) defaults() {
 		v := link.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.CheckFailures(); !ok {
+		v := link.DefaultCheckFailures
+		_c.mutation.SetCheckFailures(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := link.DefaultID()
 		_c.mutation.SetID(v)
@@ -291,6 +442,9 @@ func (_c *LinkCreate) check() error {
 	if _, ok := _c.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Link.description"`)}
 	}
+	if _, ok := _c.mutation.CheckFailures(); !ok {
+		return &ValidationError{Name: "check_failures", err: errors.New(`ent: missing required field "Link.check_failures"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := link.IDValidator(v.String()); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Link.id": %w`, err)}
@@ -356,6 +510,34 @@ func (_c *LinkCreate) createSpec() (*Link, *sqlgraph.CreateSpec) {
 		_spec.SetField(link.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.SiteName(); ok {
+		_spec.SetField(link.FieldSiteName, field.TypeString, value)
+		_node.SiteName = &value
+	}
+	if value, ok := _c.mutation.FetchedAt(); ok {
+		_spec.SetField(link.FieldFetchedAt, field.TypeTime, value)
+		_node.FetchedAt = &value
+	}
+	if value, ok := _c.mutation.CheckedAt(); ok {
+		_spec.SetField(link.FieldCheckedAt, field.TypeTime, value)
+		_node.CheckedAt = &value
+	}
+	if value, ok := _c.mutation.CheckFailures(); ok {
+		_spec.SetField(link.FieldCheckFailures, field.TypeInt, value)
+		_node.CheckFailures = value
+	}
+	if value, ok := _c.mutation.BrokenAt(); ok {
+		_spec.SetField(link.FieldBrokenAt, field.TypeTime, value)
+		_node.BrokenAt = &value
+	}
+	if value, ok := _c.mutation.SnapshotText(); ok {
+		_spec.SetField(link.FieldSnapshotText, field.TypeString, value)
+		_node.SnapshotText = &value
+	}
+	if value, ok := _c.mutation.ArchiveURL(); ok {
+		_spec.SetField(link.FieldArchiveURL, field.TypeString, value)
+		_node.ArchiveURL = &value
+	}
 	if nodes := _c.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -454,6 +636,23 @@ func (_c *LinkCreate) createSpec() (*Link, *sqlgraph.CreateSpec) {
 		_node.FaviconAssetID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   link.SnapshotTable,
+			Columns: []string{link.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SnapshotAssetID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.AssetsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -470,6 +669,22 @@ func (_c *LinkCreate) createSpec() (*Link, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -558,6 +773,96 @@ func (u *LinkUpsert) UpdateDescription() *LinkUpsert {
 	return u
 }
 
+// SetSiteName sets the "site_name" field.
+func (u *LinkUpsert) SetSiteName(v string) *LinkUpsert {
+	u.Set(link.FieldSiteName, v)
+	return u
+}
+
+// UpdateSiteName sets the "site_name" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSiteName() *LinkUpsert {
+	u.SetExcluded(link.FieldSiteName)
+	return u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (u *LinkUpsert) ClearSiteName() *LinkUpsert {
+	u.SetNull(link.FieldSiteName)
+	return u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (u *LinkUpsert) SetFetchedAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldFetchedAt, v)
+	return u
+}
+
+// UpdateFetchedAt sets the "fetched_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateFetchedAt() *LinkUpsert {
+	u.SetExcluded(link.FieldFetchedAt)
+	return u
+}
+
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (u *LinkUpsert) ClearFetchedAt() *LinkUpsert {
+	u.SetNull(link.FieldFetchedAt)
+	return u
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (u *LinkUpsert) SetCheckedAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldCheckedAt, v)
+	return u
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateCheckedAt() *LinkUpsert {
+	u.SetExcluded(link.FieldCheckedAt)
+	return u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *LinkUpsert) ClearCheckedAt() *LinkUpsert {
+	u.SetNull(link.FieldCheckedAt)
+	return u
+}
+
+// SetCheckFailures sets the "check_failures" field.
+func (u *LinkUpsert) SetCheckFailures(v int) *LinkUpsert {
+	u.Set(link.FieldCheckFailures, v)
+	return u
+}
+
+// UpdateCheckFailures sets the "check_failures" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateCheckFailures() *LinkUpsert {
+	u.SetExcluded(link.FieldCheckFailures)
+	return u
+}
+
+// AddCheckFailures adds v to the "check_failures" field.
+func (u *LinkUpsert) AddCheckFailures(v int) *LinkUpsert {
+	u.Add(link.FieldCheckFailures, v)
+	return u
+}
+
+// SetBrokenAt sets the "broken_at" field.
+func (u *LinkUpsert) SetBrokenAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldBrokenAt, v)
+	return u
+}
+
+// UpdateBrokenAt sets the "broken_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateBrokenAt() *LinkUpsert {
+	u.SetExcluded(link.FieldBrokenAt)
+	return u
+}
+
+// ClearBrokenAt clears the value of the "broken_at" field.
+func (u *LinkUpsert) ClearBrokenAt() *LinkUpsert {
+	u.SetNull(link.FieldBrokenAt)
+	return u
+}
+
 // SetPrimaryAssetID sets the "primary_asset_id" field.
 func (u *LinkUpsert) SetPrimaryAssetID(v xid.ID) *LinkUpsert {
 	u.Set(link.FieldPrimaryAssetID, v)
@@ -594,6 +899,60 @@ func (u *LinkUpsert) ClearFaviconAssetID() *LinkUpsert {
 	return u
 }
 
+// SetSnapshotAssetID sets the "snapshot_asset_id" field.
+func (u *LinkUpsert) SetSnapshotAssetID(v xid.ID) *LinkUpsert {
+	u.Set(link.FieldSnapshotAssetID, v)
+	return u
+}
+
+// UpdateSnapshotAssetID sets the "snapshot_asset_id" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSnapshotAssetID() *LinkUpsert {
+	u.SetExcluded(link.FieldSnapshotAssetID)
+	return u
+}
+
+// ClearSnapshotAssetID clears the value of the "snapshot_asset_id" field.
+func (u *LinkUpsert) ClearSnapshotAssetID() *LinkUpsert {
+	u.SetNull(link.FieldSnapshotAssetID)
+	return u
+}
+
+// SetSnapshotText sets the "snapshot_text" field.
+func (u *LinkUpsert) SetSnapshotText(v string) *LinkUpsert {
+	u.Set(link.FieldSnapshotText, v)
+	return u
+}
+
+// UpdateSnapshotText sets the "snapshot_text" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSnapshotText() *LinkUpsert {
+	u.SetExcluded(link.FieldSnapshotText)
+	return u
+}
+
+// ClearSnapshotText clears the value of the "snapshot_text" field.
+func (u *LinkUpsert) ClearSnapshotText() *LinkUpsert {
+	u.SetNull(link.FieldSnapshotText)
+	return u
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (u *LinkUpsert) SetArchiveURL(v string) *LinkUpsert {
+	u.Set(link.FieldArchiveURL, v)
+	return u
+}
+
+// UpdateArchiveURL sets the "archive_url" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateArchiveURL() *LinkUpsert {
+	u.SetExcluded(link.FieldArchiveURL)
+	return u
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (u *LinkUpsert) ClearArchiveURL() *LinkUpsert {
+	u.SetNull(link.FieldArchiveURL)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -693,6 +1052,111 @@ func (u *LinkUpsertOne) UpdateDescription() *LinkUpsertOne {
 	})
 }
 
+// SetSiteName sets the "site_name" field.
+func (u *LinkUpsertOne) SetSiteName(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSiteName(v)
+	})
+}
+
+// UpdateSiteName sets the "site_name" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSiteName() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSiteName()
+	})
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (u *LinkUpsertOne) ClearSiteName() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSiteName()
+	})
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (u *LinkUpsertOne) SetFetchedAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetFetchedAt(v)
+	})
+}
+
+// UpdateFetchedAt sets the "fetched_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateFetchedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateFetchedAt()
+	})
+}
+
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (u *LinkUpsertOne) ClearFetchedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearFetchedAt()
+	})
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (u *LinkUpsertOne) SetCheckedAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCheckedAt(v)
+	})
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateCheckedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCheckedAt()
+	})
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *LinkUpsertOne) ClearCheckedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearCheckedAt()
+	})
+}
+
+// SetCheckFailures sets the "check_failures" field.
+func (u *LinkUpsertOne) SetCheckFailures(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCheckFailures(v)
+	})
+}
+
+// AddCheckFailures adds v to the "check_failures" field.
+func (u *LinkUpsertOne) AddCheckFailures(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddCheckFailures(v)
+	})
+}
+
+// UpdateCheckFailures sets the "check_failures" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateCheckFailures() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCheckFailures()
+	})
+}
+
+// SetBrokenAt sets the "broken_at" field.
+func (u *LinkUpsertOne) SetBrokenAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetBrokenAt(v)
+	})
+}
+
+// UpdateBrokenAt sets the "broken_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateBrokenAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateBrokenAt()
+	})
+}
+
+// ClearBrokenAt clears the value of the "broken_at" field.
+func (u *LinkUpsertOne) ClearBrokenAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearBrokenAt()
+	})
+}
+
 // SetPrimaryAssetID sets the "primary_asset_id" field.
 func (u *LinkUpsertOne) SetPrimaryAssetID(v xid.ID) *LinkUpsertOne {
 	return u.Update(func(s *LinkUpsert) {
@@ -735,6 +1199,69 @@ func (u *LinkUpsertOne) ClearFaviconAssetID() *LinkUpsertOne {
 	})
 }
 
+// SetSnapshotAssetID sets the "snapshot_asset_id" field.
+func (u *LinkUpsertOne) SetSnapshotAssetID(v xid.ID) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSnapshotAssetID(v)
+	})
+}
+
+// UpdateSnapshotAssetID sets the "snapshot_asset_id" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSnapshotAssetID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSnapshotAssetID()
+	})
+}
+
+// ClearSnapshotAssetID clears the value of the "snapshot_asset_id" field.
+func (u *LinkUpsertOne) ClearSnapshotAssetID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSnapshotAssetID()
+	})
+}
+
+// SetSnapshotText sets the "snapshot_text" field.
+func (u *LinkUpsertOne) SetSnapshotText(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSnapshotText(v)
+	})
+}
+
+// UpdateSnapshotText sets the "snapshot_text" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSnapshotText() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSnapshotText()
+	})
+}
+
+// ClearSnapshotText clears the value of the "snapshot_text" field.
+func (u *LinkUpsertOne) ClearSnapshotText() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSnapshotText()
+	})
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (u *LinkUpsertOne) SetArchiveURL(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetArchiveURL(v)
+	})
+}
+
+// UpdateArchiveURL sets the "archive_url" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateArchiveURL() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateArchiveURL()
+	})
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (u *LinkUpsertOne) ClearArchiveURL() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearArchiveURL()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1001,6 +1528,111 @@ func (u *LinkUpsertBulk) UpdateDescription() *LinkUpsertBulk {
 	})
 }
 
+// SetSiteName sets the "site_name" field.
+func (u *LinkUpsertBulk) SetSiteName(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSiteName(v)
+	})
+}
+
+// UpdateSiteName sets the "site_name" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSiteName() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSiteName()
+	})
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (u *LinkUpsertBulk) ClearSiteName() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSiteName()
+	})
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (u *LinkUpsertBulk) SetFetchedAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetFetchedAt(v)
+	})
+}
+
+// UpdateFetchedAt sets the "fetched_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateFetchedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateFetchedAt()
+	})
+}
+
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (u *LinkUpsertBulk) ClearFetchedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearFetchedAt()
+	})
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (u *LinkUpsertBulk) SetCheckedAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCheckedAt(v)
+	})
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateCheckedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCheckedAt()
+	})
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *LinkUpsertBulk) ClearCheckedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearCheckedAt()
+	})
+}
+
+// SetCheckFailures sets the "check_failures" field.
+func (u *LinkUpsertBulk) SetCheckFailures(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCheckFailures(v)
+	})
+}
+
+// AddCheckFailures adds v to the "check_failures" field.
+func (u *LinkUpsertBulk) AddCheckFailures(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddCheckFailures(v)
+	})
+}
+
+// UpdateCheckFailures sets the "check_failures" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateCheckFailures() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCheckFailures()
+	})
+}
+
+// SetBrokenAt sets the "broken_at" field.
+func (u *LinkUpsertBulk) SetBrokenAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetBrokenAt(v)
+	})
+}
+
+// UpdateBrokenAt sets the "broken_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateBrokenAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateBrokenAt()
+	})
+}
+
+// ClearBrokenAt clears the value of the "broken_at" field.
+func (u *LinkUpsertBulk) ClearBrokenAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearBrokenAt()
+	})
+}
+
 // SetPrimaryAssetID sets the "primary_asset_id" field.
 func (u *LinkUpsertBulk) SetPrimaryAssetID(v xid.ID) *LinkUpsertBulk {
 	return u.Update(func(s *LinkUpsert) {
@@ -1043,6 +1675,69 @@ func (u *LinkUpsertBulk) ClearFaviconAssetID() *LinkUpsertBulk {
 	})
 }
 
+// SetSnapshotAssetID sets the "snapshot_asset_id" field.
+func (u *LinkUpsertBulk) SetSnapshotAssetID(v xid.ID) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSnapshotAssetID(v)
+	})
+}
+
+// UpdateSnapshotAssetID sets the "snapshot_asset_id" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSnapshotAssetID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSnapshotAssetID()
+	})
+}
+
+// ClearSnapshotAssetID clears the value of the "snapshot_asset_id" field.
+func (u *LinkUpsertBulk) ClearSnapshotAssetID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSnapshotAssetID()
+	})
+}
+
+// SetSnapshotText sets the "snapshot_text" field.
+func (u *LinkUpsertBulk) SetSnapshotText(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSnapshotText(v)
+	})
+}
+
+// UpdateSnapshotText sets the "snapshot_text" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSnapshotText() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSnapshotText()
+	})
+}
+
+// ClearSnapshotText clears the value of the "snapshot_text" field.
+func (u *LinkUpsertBulk) ClearSnapshotText() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSnapshotText()
+	})
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (u *LinkUpsertBulk) SetArchiveURL(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetArchiveURL(v)
+	})
+}
+
+// UpdateArchiveURL sets the "archive_url" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateArchiveURL() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateArchiveURL()
+	})
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (u *LinkUpsertBulk) ClearArchiveURL() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearArchiveURL()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
@@ -33,7 +34,9 @@ type LinkQuery struct {
 	withNodeContentReferences *NodeQuery
 	withPrimaryImage          *AssetQuery
 	withFaviconImage          *AssetQuery
+	withSnapshot              *AssetQuery
 	withAssets                *AssetQuery
+	withChecks                *LinkCheckQuery
 	modifiers                 []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -203,6 +206,28 @@ func (_q *LinkQuery) QueryFaviconImage() *AssetQuery {
 	return query
 }
 
+// QuerySnapshot chains the current query on the "snapshot" edge.
+func (_q *LinkQuery) QuerySnapshot() *AssetQuery {
+	query := (&AssetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, selector),
+			sqlgraph.To(asset.Table, asset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, link.SnapshotTable, link.SnapshotColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAssets chains the current query on the "assets" edge.
 func (_q *LinkQuery) QueryAssets() *AssetQuery {
 	query := (&AssetClient{config: _q.config}).Query()
@@ -225,6 +250,28 @@ func (_q *LinkQuery) QueryAssets() *AssetQuery {
 	return query
 }
 
+// QueryChecks chains the current query on the "checks" edge.
+func (_q *LinkQuery) QueryChecks() *LinkCheckQuery {
+	query := (&LinkCheckClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, selector),
+			sqlgraph.To(linkcheck.Table, linkcheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, link.ChecksTable, link.ChecksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Link entity from the query.
 // Returns a *NotFoundError when no Link was found.
 func (_q *LinkQuery) First(ctx context.Context) (*Link, error) {
@@ -423,7 +470,9 @@ func (_q *LinkQuery) Clone() *LinkQuery {
 		withNodeContentReferences: _q.withNodeContentReferences.Clone(),
 		withPrimaryImage:          _q.withPrimaryImage.Clone(),
 		withFaviconImage:          _q.withFaviconImage.Clone(),
+		withSnapshot:              _q.withSnapshot.Clone(),
 		withAssets:                _q.withAssets.Clone(),
+		withChecks:                _q.withChecks.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -497,6 +546,17 @@ func (_q *LinkQuery) WithFaviconImage(opts ...func(*AssetQuery)) *LinkQuery {
 	return _q
 }
 
+// WithSnapshot tells the query-builder to eager-load the nodes that are connected to
+// the "snapshot" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkQuery) WithSnapshot(opts ...func(*AssetQuery)) *LinkQuery {
+	query := (&AssetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSnapshot = query
+	return _q
+}
+
 // WithAssets tells the query-builder to eager-load the nodes that are connected to
 // the "assets" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *LinkQuery) WithAssets(opts ...func(*AssetQuery)) *LinkQuery {
@@ -508,6 +568,17 @@ func (_q *LinkQuery) WithAssets(opts ...func(*AssetQuery)) *LinkQuery {
 	return _q
 }
 
+// WithChecks tells the query-builder to eager-load the nodes that are connected to
+// the "checks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkQuery) WithChecks(opts ...func(*LinkCheckQuery)) *LinkQuery {
+	query := (&LinkCheckClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChecks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -586,14 +657,16 @@ func (_q *LinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Link, e
 	var (
 		nodes       = []*Link{}
 		_spec       = _q.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [9]bool{
 			_q.withPosts != nil,
 			_q.withPostContentReferences != nil,
 			_q.withNodes != nil,
 			_q.withNodeContentReferences != nil,
 			_q.withPrimaryImage != nil,
 			_q.withFaviconImage != nil,
+			_q.withSnapshot != nil,
 			_q.withAssets != nil,
+			_q.withChecks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -657,6 +730,12 @@ func (_q *LinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Link, e
 			return nil, err
 		}
 	}
+	if query := _q.withSnapshot; query != nil {
+		if err := _q.loadSnapshot(ctx, query, nodes, nil,
+			func(n *Link, e *Asset) { n.Edges.Snapshot = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withAssets; query != nil {
 		if err := _q.loadAssets(ctx, query, nodes,
 			func(n *Link) { n.Edges.Assets = []*Asset{} },
@@ -664,6 +743,13 @@ func (_q *LinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Link, e
 			return nil, err
 		}
 	}
+	if query := _q.withChecks; query != nil {
+		if err := _q.loadChecks(ctx, query, nodes,
+			func(n *Link) { n.Edges.Checks = []*LinkCheck{} },
+			func(n *Link, e *LinkCheck) { n.Edges.Checks = append(n.Edges.Checks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -913,6 +999,38 @@ func (_q *LinkQuery) loadFaviconImage(ctx context.Context, query *AssetQuery, no
 	}
 	return nil
 }
+func (_q *LinkQuery) loadSnapshot(ctx context.Context, query *AssetQuery, nodes []*Link, init func(*Link), assign func(*Link, *Asset)) error {
+	ids := make([]xid.ID, 0, len(nodes))
+	nodeids := make(map[xid.ID][]*Link)
+	for i := range nodes {
+		if nodes[i].SnapshotAssetID == nil {
+			continue
+		}
+		fk := *nodes[i].SnapshotAssetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(asset.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "snapshot_asset_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *LinkQuery) loadAssets(ctx context.Context, query *AssetQuery, nodes []*Link, init func(*Link), assign func(*Link, *Asset)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[xid.ID]*Link)
@@ -974,6 +1092,36 @@ func (_q *LinkQuery) loadAssets(ctx context.Context, query *AssetQuery, nodes []
 	}
 	return nil
 }
+func (_q *LinkQuery) loadChecks(ctx context.Context, query *LinkCheckQuery, nodes []*Link, init func(*Link), assign func(*Link, *LinkCheck)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Link)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(linkcheck.FieldLinkID)
+	}
+	query.Where(predicate.LinkCheck(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(link.ChecksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LinkID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "link_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *LinkQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -1009,6 +1157,9 @@ func (_q *LinkQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withFaviconImage != nil {
 			_spec.Node.AddColumnOnce(link.FieldFaviconAssetID)
 		}
+		if _q.withSnapshot != nil {
+			_spec.Node.AddColumnOnce(link.FieldSnapshotAssetID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,12 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
@@ -74,6 +76,107 @@ func (_u *LinkUpdate) SetNillableDescription(v *string) *LinkUpdate {
 	return _u
 }
 
+// SetSiteName sets the "site_name" field.
+func (_u *LinkUpdate) SetSiteName(v string) *LinkUpdate {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSiteName(v *string) *LinkUpdate {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *LinkUpdate) ClearSiteName() *LinkUpdate {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_u *LinkUpdate) SetFetchedAt(v time.Time) *LinkUpdate {
+	_u.mutation.SetFetchedAt(v)
+	return _u
+}
+
+// SetNillableFetchedAt sets the "fetched_at" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableFetchedAt(v *time.Time) *LinkUpdate {
+	if v != nil {
+		_u.SetFetchedAt(*v)
+	}
+	return _u
+}
+
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (_u *LinkUpdate) ClearFetchedAt() *LinkUpdate {
+	_u.mutation.ClearFetchedAt()
+	return _u
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (_u *LinkUpdate) SetCheckedAt(v time.Time) *LinkUpdate {
+	_u.mutation.SetCheckedAt(v)
+	return _u
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableCheckedAt(v *time.Time) *LinkUpdate {
+	if v != nil {
+		_u.SetCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (_u *LinkUpdate) ClearCheckedAt() *LinkUpdate {
+	_u.mutation.ClearCheckedAt()
+	return _u
+}
+
+// SetCheckFailures sets the "check_failures" field.
+func (_u *LinkUpdate) SetCheckFailures(v int) *LinkUpdate {
+	_u.mutation.ResetCheckFailures()
+	_u.mutation.SetCheckFailures(v)
+	return _u
+}
+
+// SetNillableCheckFailures sets the "check_failures" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableCheckFailures(v *int) *LinkUpdate {
+	if v != nil {
+		_u.SetCheckFailures(*v)
+	}
+	return _u
+}
+
+// AddCheckFailures adds value to the "check_failures" field.
+func (_u *LinkUpdate) AddCheckFailures(v int) *LinkUpdate {
+	_u.mutation.AddCheckFailures(v)
+	return _u
+}
+
+// SetBrokenAt sets the "broken_at" field.
+func (_u *LinkUpdate) SetBrokenAt(v time.Time) *LinkUpdate {
+	_u.mutation.SetBrokenAt(v)
+	return _u
+}
+
+// SetNillableBrokenAt sets the "broken_at" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableBrokenAt(v *time.Time) *LinkUpdate {
+	if v != nil {
+		_u.SetBrokenAt(*v)
+	}
+	return _u
+}
+
+// ClearBrokenAt clears the value of the "broken_at" field.
+func (_u *LinkUpdate) ClearBrokenAt() *LinkUpdate {
+	_u.mutation.ClearBrokenAt()
+	return _u
+}
+
 // SetPrimaryAssetID sets the "primary_asset_id" field.
 func (_u *LinkUpdate) SetPrimaryAssetID(v xid.ID) *LinkUpdate {
 	_u.mutation.SetPrimaryAssetID(v)
@@ -114,6 +217,66 @@ func (_u *LinkUpdate) ClearFaviconAssetID() *LinkUpdate {
 	return _u
 }
 
+// SetSnapshotAssetID sets the "snapshot_asset_id" field.
+func (_u *LinkUpdate) SetSnapshotAssetID(v xid.ID) *LinkUpdate {
+	_u.mutation.SetSnapshotAssetID(v)
+	return _u
+}
+
+// SetNillableSnapshotAssetID sets the "snapshot_asset_id" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSnapshotAssetID(v *xid.ID) *LinkUpdate {
+	if v != nil {
+		_u.SetSnapshotAssetID(*v)
+	}
+	return _u
+}
+
+// ClearSnapshotAssetID clears the value of the "snapshot_asset_id" field.
+func (_u *LinkUpdate) ClearSnapshotAssetID() *LinkUpdate {
+	_u.mutation.ClearSnapshotAssetID()
+	return _u
+}
+
+// SetSnapshotText sets the "snapshot_text" field.
+func (_u *LinkUpdate) SetSnapshotText(v string) *LinkUpdate {
+	_u.mutation.SetSnapshotText(v)
+	return _u
+}
+
+// SetNillableSnapshotText sets the "snapshot_text" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSnapshotText(v *string) *LinkUpdate {
+	if v != nil {
+		_u.SetSnapshotText(*v)
+	}
+	return _u
+}
+
+// ClearSnapshotText clears the value of the "snapshot_text" field.
+func (_u *LinkUpdate) ClearSnapshotText() *LinkUpdate {
+	_u.mutation.ClearSnapshotText()
+	return _u
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (_u *LinkUpdate) SetArchiveURL(v string) *LinkUpdate {
+	_u.mutation.SetArchiveURL(v)
+	return _u
+}
+
+// SetNillableArchiveURL sets the "archive_url" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableArchiveURL(v *string) *LinkUpdate {
+	if v != nil {
+		_u.SetArchiveURL(*v)
+	}
+	return _u
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (_u *LinkUpdate) ClearArchiveURL() *LinkUpdate {
+	_u.mutation.ClearArchiveURL()
+	return _u
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (_u *LinkUpdate) AddPostIDs(ids ...xid.ID) *LinkUpdate {
 	_u.mutation.AddPostIDs(ids...)
@@ -212,6 +375,25 @@ func (_u *LinkUpdate) SetFaviconImage(v *Asset) *LinkUpdate {
 	return _u.SetFaviconImageID(v.ID)
 }
 
+// SetSnapshotID sets the "snapshot" edge to the Asset entity by ID.
+func (_u *LinkUpdate) SetSnapshotID(id xid.ID) *LinkUpdate {
+	_u.mutation.SetSnapshotID(id)
+	return _u
+}
+
+// SetNillableSnapshotID sets the "snapshot" edge to the Asset entity by ID if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSnapshotID(id *xid.ID) *LinkUpdate {
+	if id != nil {
+		_u = _u.SetSnapshotID(*id)
+	}
+	return _u
+}
+
+// SetSnapshot sets the "snapshot" edge to the Asset entity.
+func (_u *LinkUpdate) SetSnapshot(v *Asset) *LinkUpdate {
+	return _u.SetSnapshotID(v.ID)
+}
+
 // AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
 func (_u *LinkUpdate) AddAssetIDs(ids ...xid.ID) *LinkUpdate {
 	_u.mutation.AddAssetIDs(ids...)
@@ -227,6 +409,21 @@ func (_u *LinkUpdate) AddAssets(v ...*Asset) *LinkUpdate {
 	return _u.AddAssetIDs(ids...)
 }
 
+// AddCheckIDs adds the "checks" edge to the LinkCheck entity by IDs.
+func (_u *LinkUpdate) AddCheckIDs(ids ...xid.ID) *LinkUpdate {
+	_u.mutation.AddCheckIDs(ids...)
+	return _u
+}
+
+// AddChecks adds the "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdate) AddChecks(v ...*LinkCheck) *LinkUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCheckIDs(ids...)
+}
+
 // Mutation returns the LinkMutation object of the builder.
 func (_u *LinkUpdate) Mutation() *LinkMutation {
 	return _u.mutation
@@ -328,6 +525,12 @@ func (_u *LinkUpdate) ClearFaviconImage() *LinkUpdate {
 	return _u
 }
 
+// ClearSnapshot clears the "snapshot" edge to the Asset entity.
+func (_u *LinkUpdate) ClearSnapshot() *LinkUpdate {
+	_u.mutation.ClearSnapshot()
+	return _u
+}
+
 // ClearAssets clears all "assets" edges to the Asset entity.
 func (_u *LinkUpdate) ClearAssets() *LinkUpdate {
 	_u.mutation.ClearAssets()
@@ -349,6 +552,27 @@ func (_u *LinkUpdate) RemoveAssets(v ...*Asset) *LinkUpdate {
 	return _u.RemoveAssetIDs(ids...)
 }
 
+// ClearChecks clears all "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdate) ClearChecks() *LinkUpdate {
+	_u.mutation.ClearChecks()
+	return _u
+}
+
+// RemoveCheckIDs removes the "checks" edge to LinkCheck entities by IDs.
+func (_u *LinkUpdate) RemoveCheckIDs(ids ...xid.ID) *LinkUpdate {
+	_u.mutation.RemoveCheckIDs(ids...)
+	return _u
+}
+
+// RemoveChecks removes "checks" edges to LinkCheck entities.
+func (_u *LinkUpdate) RemoveChecks(v ...*LinkCheck) *LinkUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCheckIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LinkUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -400,6 +624,48 @@ func (_u *LinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(link.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(link.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(link.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FetchedAt(); ok {
+		_spec.SetField(link.FieldFetchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FetchedAtCleared() {
+		_spec.ClearField(link.FieldFetchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckedAt(); ok {
+		_spec.SetField(link.FieldCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedAtCleared() {
+		_spec.ClearField(link.FieldCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckFailures(); ok {
+		_spec.SetField(link.FieldCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckFailures(); ok {
+		_spec.AddField(link.FieldCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BrokenAt(); ok {
+		_spec.SetField(link.FieldBrokenAt, field.TypeTime, value)
+	}
+	if _u.mutation.BrokenAtCleared() {
+		_spec.ClearField(link.FieldBrokenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SnapshotText(); ok {
+		_spec.SetField(link.FieldSnapshotText, field.TypeString, value)
+	}
+	if _u.mutation.SnapshotTextCleared() {
+		_spec.ClearField(link.FieldSnapshotText, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArchiveURL(); ok {
+		_spec.SetField(link.FieldArchiveURL, field.TypeString, value)
+	}
+	if _u.mutation.ArchiveURLCleared() {
+		_spec.ClearField(link.FieldArchiveURL, field.TypeString)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -638,6 +904,35 @@ func (_u *LinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   link.SnapshotTable,
+			Columns: []string{link.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   link.SnapshotTable,
+			Columns: []string{link.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.AssetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -683,6 +978,51 @@ func (_u *LinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChecksIDs(); len(nodes) > 0 && !_u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -747,6 +1087,107 @@ func (_u *LinkUpdateOne) SetNillableDescription(v *string) *LinkUpdateOne {
 	return _u
 }
 
+// SetSiteName sets the "site_name" field.
+func (_u *LinkUpdateOne) SetSiteName(v string) *LinkUpdateOne {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSiteName(v *string) *LinkUpdateOne {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *LinkUpdateOne) ClearSiteName() *LinkUpdateOne {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_u *LinkUpdateOne) SetFetchedAt(v time.Time) *LinkUpdateOne {
+	_u.mutation.SetFetchedAt(v)
+	return _u
+}
+
+// SetNillableFetchedAt sets the "fetched_at" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableFetchedAt(v *time.Time) *LinkUpdateOne {
+	if v != nil {
+		_u.SetFetchedAt(*v)
+	}
+	return _u
+}
+
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (_u *LinkUpdateOne) ClearFetchedAt() *LinkUpdateOne {
+	_u.mutation.ClearFetchedAt()
+	return _u
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (_u *LinkUpdateOne) SetCheckedAt(v time.Time) *LinkUpdateOne {
+	_u.mutation.SetCheckedAt(v)
+	return _u
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableCheckedAt(v *time.Time) *LinkUpdateOne {
+	if v != nil {
+		_u.SetCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (_u *LinkUpdateOne) ClearCheckedAt() *LinkUpdateOne {
+	_u.mutation.ClearCheckedAt()
+	return _u
+}
+
+// SetCheckFailures sets the "check_failures" field.
+func (_u *LinkUpdateOne) SetCheckFailures(v int) *LinkUpdateOne {
+	_u.mutation.ResetCheckFailures()
+	_u.mutation.SetCheckFailures(v)
+	return _u
+}
+
+// SetNillableCheckFailures sets the "check_failures" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableCheckFailures(v *int) *LinkUpdateOne {
+	if v != nil {
+		_u.SetCheckFailures(*v)
+	}
+	return _u
+}
+
+// AddCheckFailures adds value to the "check_failures" field.
+func (_u *LinkUpdateOne) AddCheckFailures(v int) *LinkUpdateOne {
+	_u.mutation.AddCheckFailures(v)
+	return _u
+}
+
+// SetBrokenAt sets the "broken_at" field.
+func (_u *LinkUpdateOne) SetBrokenAt(v time.Time) *LinkUpdateOne {
+	_u.mutation.SetBrokenAt(v)
+	return _u
+}
+
+// SetNillableBrokenAt sets the "broken_at" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableBrokenAt(v *time.Time) *LinkUpdateOne {
+	if v != nil {
+		_u.SetBrokenAt(*v)
+	}
+	return _u
+}
+
+// ClearBrokenAt clears the value of the "broken_at" field.
+func (_u *LinkUpdateOne) ClearBrokenAt() *LinkUpdateOne {
+	_u.mutation.ClearBrokenAt()
+	return _u
+}
+
 // SetPrimaryAssetID sets the "primary_asset_id" field.
 func (_u *LinkUpdateOne) SetPrimaryAssetID(v xid.ID) *LinkUpdateOne {
 	_u.mutation.SetPrimaryAssetID(v)
@@ -787,6 +1228,66 @@ func (_u *LinkUpdateOne) ClearFaviconAssetID() *LinkUpdateOne {
 	return _u
 }
 
+// SetSnapshotAssetID sets the "snapshot_asset_id" field.
+func (_u *LinkUpdateOne) SetSnapshotAssetID(v xid.ID) *LinkUpdateOne {
+	_u.mutation.SetSnapshotAssetID(v)
+	return _u
+}
+
+// SetNillableSnapshotAssetID sets the "snapshot_asset_id" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSnapshotAssetID(v *xid.ID) *LinkUpdateOne {
+	if v != nil {
+		_u.SetSnapshotAssetID(*v)
+	}
+	return _u
+}
+
+// ClearSnapshotAssetID clears the value of the "snapshot_asset_id" field.
+func (_u *LinkUpdateOne) ClearSnapshotAssetID() *LinkUpdateOne {
+	_u.mutation.ClearSnapshotAssetID()
+	return _u
+}
+
+// SetSnapshotText sets the "snapshot_text" field.
+func (_u *LinkUpdateOne) SetSnapshotText(v string) *LinkUpdateOne {
+	_u.mutation.SetSnapshotText(v)
+	return _u
+}
+
+// SetNillableSnapshotText sets the "snapshot_text" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSnapshotText(v *string) *LinkUpdateOne {
+	if v != nil {
+		_u.SetSnapshotText(*v)
+	}
+	return _u
+}
+
+// ClearSnapshotText clears the value of the "snapshot_text" field.
+func (_u *LinkUpdateOne) ClearSnapshotText() *LinkUpdateOne {
+	_u.mutation.ClearSnapshotText()
+	return _u
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (_u *LinkUpdateOne) SetArchiveURL(v string) *LinkUpdateOne {
+	_u.mutation.SetArchiveURL(v)
+	return _u
+}
+
+// SetNillableArchiveURL sets the "archive_url" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableArchiveURL(v *string) *LinkUpdateOne {
+	if v != nil {
+		_u.SetArchiveURL(*v)
+	}
+	return _u
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (_u *LinkUpdateOne) ClearArchiveURL() *LinkUpdateOne {
+	_u.mutation.ClearArchiveURL()
+	return _u
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (_u *LinkUpdateOne) AddPostIDs(ids ...xid.ID) *LinkUpdateOne {
 	_u.mutation.AddPostIDs(ids...)
@@ -885,6 +1386,25 @@ func (_u *LinkUpdateOne) SetFaviconImage(v *Asset) *LinkUpdateOne {
 	return _u.SetFaviconImageID(v.ID)
 }
 
+// SetSnapshotID sets the "snapshot" edge to the Asset entity by ID.
+func (_u *LinkUpdateOne) SetSnapshotID(id xid.ID) *LinkUpdateOne {
+	_u.mutation.SetSnapshotID(id)
+	return _u
+}
+
+// SetNillableSnapshotID sets the "snapshot" edge to the Asset entity by ID if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSnapshotID(id *xid.ID) *LinkUpdateOne {
+	if id != nil {
+		_u = _u.SetSnapshotID(*id)
+	}
+	return _u
+}
+
+// SetSnapshot sets the "snapshot" edge to the Asset entity.
+func (_u *LinkUpdateOne) SetSnapshot(v *Asset) *LinkUpdateOne {
+	return _u.SetSnapshotID(v.ID)
+}
+
 // AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
 func (_u *LinkUpdateOne) AddAssetIDs(ids ...xid.ID) *LinkUpdateOne {
 	_u.mutation.AddAssetIDs(ids...)
@@ -900,6 +1420,21 @@ func (_u *LinkUpdateOne) AddAssets(v ...*Asset) *LinkUpdateOne {
 	return _u.AddAssetIDs(ids...)
 }
 
+// AddCheckIDs adds the "checks" edge to the LinkCheck entity by IDs.
+func (_u *LinkUpdateOne) AddCheckIDs(ids ...xid.ID) *LinkUpdateOne {
+	_u.mutation.AddCheckIDs(ids...)
+	return _u
+}
+
+// AddChecks adds the "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdateOne) AddChecks(v ...*LinkCheck) *LinkUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCheckIDs(ids...)
+}
+
 // Mutation returns the LinkMutation object of the builder.
 func (_u *LinkUpdateOne) Mutation() *LinkMutation {
 	return _u.mutation
@@ -1001,6 +1536,12 @@ func (_u *LinkUpdateOne) ClearFaviconImage() *LinkUpdateOne {
 	return _u
 }
 
+// ClearSnapshot clears the "snapshot" edge to the Asset entity.
+func (_u *LinkUpdateOne) ClearSnapshot() *LinkUpdateOne {
+	_u.mutation.ClearSnapshot()
+	return _u
+}
+
 // ClearAssets clears all "assets" edges to the Asset entity.
 func (_u *LinkUpdateOne) ClearAssets() *LinkUpdateOne {
 	_u.mutation.ClearAssets()
@@ -1022,6 +1563,27 @@ func (_u *LinkUpdateOne) RemoveAssets(v ...*Asset) *LinkUpdateOne {
 	return _u.RemoveAssetIDs(ids...)
 }
 
+// ClearChecks clears all "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdateOne) ClearChecks() *LinkUpdateOne {
+	_u.mutation.ClearChecks()
+	return _u
+}
+
+// RemoveCheckIDs removes the "checks" edge to LinkCheck entities by IDs.
+func (_u *LinkUpdateOne) RemoveCheckIDs(ids ...xid.ID) *LinkUpdateOne {
+	_u.mutation.RemoveCheckIDs(ids...)
+	return _u
+}
+
+// RemoveChecks removes "checks" edges to LinkCheck entities.
+func (_u *LinkUpdateOne) RemoveChecks(v ...*LinkCheck) *LinkUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCheckIDs(ids...)
+}
+
 // Where appends a list predicates to the LinkUpdate builder.
 func (_u *LinkUpdateOne) Where(ps ...predicate.Link) *LinkUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1103,6 +1665,48 @@ func (_u *LinkUpdateOne) sqlSave(ctx context.Context) (_node *Link, err error) {
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(link.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(link.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(link.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FetchedAt(); ok {
+		_spec.SetField(link.FieldFetchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FetchedAtCleared() {
+		_spec.ClearField(link.FieldFetchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckedAt(); ok {
+		_spec.SetField(link.FieldCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedAtCleared() {
+		_spec.ClearField(link.FieldCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckFailures(); ok {
+		_spec.SetField(link.FieldCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckFailures(); ok {
+		_spec.AddField(link.FieldCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BrokenAt(); ok {
+		_spec.SetField(link.FieldBrokenAt, field.TypeTime, value)
+	}
+	if _u.mutation.BrokenAtCleared() {
+		_spec.ClearField(link.FieldBrokenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SnapshotText(); ok {
+		_spec.SetField(link.FieldSnapshotText, field.TypeString, value)
+	}
+	if _u.mutation.SnapshotTextCleared() {
+		_spec.ClearField(link.FieldSnapshotText, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArchiveURL(); ok {
+		_spec.SetField(link.FieldArchiveURL, field.TypeString, value)
+	}
+	if _u.mutation.ArchiveURLCleared() {
+		_spec.ClearField(link.FieldArchiveURL, field.TypeString)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1341,6 +1945,35 @@ func (_u *LinkUpdateOne) sqlSave(ctx context.Context) (_node *Link, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   link.SnapshotTable,
+			Columns: []string{link.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   link.SnapshotTable,
+			Columns: []string{link.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.AssetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1386,6 +2019,51 @@ func (_u *LinkUpdateOne) sqlSave(ctx context.Context) (_node *Link, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChecksIDs(); len(nodes) > 0 && !_u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Link{config: _u.config}
 	_spec.Assign = _node.assignValues
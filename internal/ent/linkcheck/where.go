@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkcheck
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LinkID applies equality check predicate on the "link_id" field. It's identical to LinkIDEQ.
+func LinkID(v xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldLinkID, v))
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// LinkIDEQ applies the EQ predicate on the "link_id" field.
+func LinkIDEQ(v xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldLinkID, v))
+}
+
+// LinkIDNEQ applies the NEQ predicate on the "link_id" field.
+func LinkIDNEQ(v xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldLinkID, v))
+}
+
+// LinkIDIn applies the In predicate on the "link_id" field.
+func LinkIDIn(vs ...xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldLinkID, vs...))
+}
+
+// LinkIDNotIn applies the NotIn predicate on the "link_id" field.
+func LinkIDNotIn(vs ...xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldLinkID, vs...))
+}
+
+// LinkIDGT applies the GT predicate on the "link_id" field.
+func LinkIDGT(v xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldLinkID, v))
+}
+
+// LinkIDGTE applies the GTE predicate on the "link_id" field.
+func LinkIDGTE(v xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldLinkID, v))
+}
+
+// LinkIDLT applies the LT predicate on the "link_id" field.
+func LinkIDLT(v xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldLinkID, v))
+}
+
+// LinkIDLTE applies the LTE predicate on the "link_id" field.
+func LinkIDLTE(v xid.ID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldLinkID, v))
+}
+
+// LinkIDContains applies the Contains predicate on the "link_id" field.
+func LinkIDContains(v xid.ID) predicate.LinkCheck {
+	vc := v.String()
+	return predicate.LinkCheck(sql.FieldContains(FieldLinkID, vc))
+}
+
+// LinkIDHasPrefix applies the HasPrefix predicate on the "link_id" field.
+func LinkIDHasPrefix(v xid.ID) predicate.LinkCheck {
+	vc := v.String()
+	return predicate.LinkCheck(sql.FieldHasPrefix(FieldLinkID, vc))
+}
+
+// LinkIDHasSuffix applies the HasSuffix predicate on the "link_id" field.
+func LinkIDHasSuffix(v xid.ID) predicate.LinkCheck {
+	vc := v.String()
+	return predicate.LinkCheck(sql.FieldHasSuffix(FieldLinkID, vc))
+}
+
+// LinkIDEqualFold applies the EqualFold predicate on the "link_id" field.
+func LinkIDEqualFold(v xid.ID) predicate.LinkCheck {
+	vc := v.String()
+	return predicate.LinkCheck(sql.FieldEqualFold(FieldLinkID, vc))
+}
+
+// LinkIDContainsFold applies the ContainsFold predicate on the "link_id" field.
+func LinkIDContainsFold(v xid.ID) predicate.LinkCheck {
+	vc := v.String()
+	return predicate.LinkCheck(sql.FieldContainsFold(FieldLinkID, vc))
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldStatusCode, v))
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldStatusCode, v))
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldStatusCode, v))
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldStatusCode, v))
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldStatusCode, v))
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIsNull(FieldStatusCode))
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotNull(FieldStatusCode))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldContainsFold(FieldError, v))
+}
+
+// HasLink applies the HasEdge predicate on the "link" edge.
+func HasLink() predicate.LinkCheck {
+	return predicate.LinkCheck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkTable, LinkColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkWith applies the HasEdge predicate on the "link" edge with a given conditions (other predicates).
+func HasLinkWith(preds ...predicate.Link) predicate.LinkCheck {
+	return predicate.LinkCheck(func(s *sql.Selector) {
+		step := newLinkStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LinkCheck) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LinkCheck) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LinkCheck) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.NotPredicates(p))
+}
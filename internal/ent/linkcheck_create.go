@@ -0,0 +1,743 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
+	"github.com/rs/xid"
+)
+
+// LinkCheckCreate is the builder for creating a LinkCheck entity.
+type LinkCheckCreate struct {
+	config
+	mutation *LinkCheckMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LinkCheckCreate) SetCreatedAt(v time.Time) *LinkCheckCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LinkCheckCreate) SetNillableCreatedAt(v *time.Time) *LinkCheckCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLinkID sets the "link_id" field.
+func (_c *LinkCheckCreate) SetLinkID(v xid.ID) *LinkCheckCreate {
+	_c.mutation.SetLinkID(v)
+	return _c
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_c *LinkCheckCreate) SetStatusCode(v int) *LinkCheckCreate {
+	_c.mutation.SetStatusCode(v)
+	return _c
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_c *LinkCheckCreate) SetNillableStatusCode(v *int) *LinkCheckCreate {
+	if v != nil {
+		_c.SetStatusCode(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *LinkCheckCreate) SetError(v string) *LinkCheckCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *LinkCheckCreate) SetNillableError(v *string) *LinkCheckCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkCheckCreate) SetID(v xid.ID) *LinkCheckCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LinkCheckCreate) SetNillableID(v *xid.ID) *LinkCheckCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLink sets the "link" edge to the Link entity.
+func (_c *LinkCheckCreate) SetLink(v *Link) *LinkCheckCreate {
+	return _c.SetLinkID(v.ID)
+}
+
+// Mutation returns the LinkCheckMutation object of the builder.
+func (_c *LinkCheckCreate) Mutation() *LinkCheckMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkCheck in the database.
+func (_c *LinkCheckCreate) Save(ctx context.Context) (*LinkCheck, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkCheckCreate) SaveX(ctx context.Context) *LinkCheck {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkCheckCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkCheckCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkCheckCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := linkcheck.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := linkcheck.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkCheckCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LinkCheck.created_at"`)}
+	}
+	if _, ok := _c.mutation.LinkID(); !ok {
+		return &ValidationError{Name: "link_id", err: errors.New(`ent: missing required field "LinkCheck.link_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := linkcheck.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LinkCheck.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LinkIDs()) == 0 {
+		return &ValidationError{Name: "link", err: errors.New(`ent: missing required edge "LinkCheck.link"`)}
+	}
+	return nil
+}
+
+func (_c *LinkCheckCreate) sqlSave(ctx context.Context) (*LinkCheck, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkCheckCreate) createSpec() (*LinkCheck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkCheck{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linkcheck.Table, sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(linkcheck.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.StatusCode(); ok {
+		_spec.SetField(linkcheck.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = &value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(linkcheck.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if nodes := _c.mutation.LinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkcheck.LinkTable,
+			Columns: []string{linkcheck.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LinkID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkCheck.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkCheckUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkCheckCreate) OnConflict(opts ...sql.ConflictOption) *LinkCheckUpsertOne {
+	_c.conflict = opts
+	return &LinkCheckUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkCheckCreate) OnConflictColumns(columns ...string) *LinkCheckUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkCheckUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LinkCheckUpsertOne is the builder for "upsert"-ing
+	//  one LinkCheck node.
+	LinkCheckUpsertOne struct {
+		create *LinkCheckCreate
+	}
+
+	// LinkCheckUpsert is the "OnConflict" setter.
+	LinkCheckUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLinkID sets the "link_id" field.
+func (u *LinkCheckUpsert) SetLinkID(v xid.ID) *LinkCheckUpsert {
+	u.Set(linkcheck.FieldLinkID, v)
+	return u
+}
+
+// UpdateLinkID sets the "link_id" field to the value that was provided on create.
+func (u *LinkCheckUpsert) UpdateLinkID() *LinkCheckUpsert {
+	u.SetExcluded(linkcheck.FieldLinkID)
+	return u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *LinkCheckUpsert) SetStatusCode(v int) *LinkCheckUpsert {
+	u.Set(linkcheck.FieldStatusCode, v)
+	return u
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *LinkCheckUpsert) UpdateStatusCode() *LinkCheckUpsert {
+	u.SetExcluded(linkcheck.FieldStatusCode)
+	return u
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *LinkCheckUpsert) AddStatusCode(v int) *LinkCheckUpsert {
+	u.Add(linkcheck.FieldStatusCode, v)
+	return u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *LinkCheckUpsert) ClearStatusCode() *LinkCheckUpsert {
+	u.SetNull(linkcheck.FieldStatusCode)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *LinkCheckUpsert) SetError(v string) *LinkCheckUpsert {
+	u.Set(linkcheck.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LinkCheckUpsert) UpdateError() *LinkCheckUpsert {
+	u.SetExcluded(linkcheck.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LinkCheckUpsert) ClearError() *LinkCheckUpsert {
+	u.SetNull(linkcheck.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LinkCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkcheck.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkCheckUpsertOne) UpdateNewValues() *LinkCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(linkcheck.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(linkcheck.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkCheck.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LinkCheckUpsertOne) Ignore() *LinkCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkCheckUpsertOne) DoNothing() *LinkCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkCheckCreate.OnConflict
+// documentation for more info.
+func (u *LinkCheckUpsertOne) Update(set func(*LinkCheckUpsert)) *LinkCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLinkID sets the "link_id" field.
+func (u *LinkCheckUpsertOne) SetLinkID(v xid.ID) *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.SetLinkID(v)
+	})
+}
+
+// UpdateLinkID sets the "link_id" field to the value that was provided on create.
+func (u *LinkCheckUpsertOne) UpdateLinkID() *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.UpdateLinkID()
+	})
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *LinkCheckUpsertOne) SetStatusCode(v int) *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *LinkCheckUpsertOne) AddStatusCode(v int) *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *LinkCheckUpsertOne) UpdateStatusCode() *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *LinkCheckUpsertOne) ClearStatusCode() *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.ClearStatusCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *LinkCheckUpsertOne) SetError(v string) *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LinkCheckUpsertOne) UpdateError() *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LinkCheckUpsertOne) ClearError() *LinkCheckUpsertOne {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkCheckUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkCheckCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkCheckUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LinkCheckUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LinkCheckUpsertOne.ID is not supported by MySQL driver. Use LinkCheckUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LinkCheckUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LinkCheckCreateBulk is the builder for creating many LinkCheck entities in bulk.
+type LinkCheckCreateBulk struct {
+	config
+	err      error
+	builders []*LinkCheckCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LinkCheck entities in the database.
+func (_c *LinkCheckCreateBulk) Save(ctx context.Context) ([]*LinkCheck, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkCheck, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkCheckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkCheckCreateBulk) SaveX(ctx context.Context) []*LinkCheck {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkCheckCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkCheckCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkCheck.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkCheckUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkCheckCreateBulk) OnConflict(opts ...sql.ConflictOption) *LinkCheckUpsertBulk {
+	_c.conflict = opts
+	return &LinkCheckUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkCheckCreateBulk) OnConflictColumns(columns ...string) *LinkCheckUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkCheckUpsertBulk{
+		create: _c,
+	}
+}
+
+// LinkCheckUpsertBulk is the builder for "upsert"-ing
+// a bulk of LinkCheck nodes.
+type LinkCheckUpsertBulk struct {
+	create *LinkCheckCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LinkCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkcheck.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkCheckUpsertBulk) UpdateNewValues() *LinkCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(linkcheck.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(linkcheck.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkCheck.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LinkCheckUpsertBulk) Ignore() *LinkCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkCheckUpsertBulk) DoNothing() *LinkCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkCheckCreateBulk.OnConflict
+// documentation for more info.
+func (u *LinkCheckUpsertBulk) Update(set func(*LinkCheckUpsert)) *LinkCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLinkID sets the "link_id" field.
+func (u *LinkCheckUpsertBulk) SetLinkID(v xid.ID) *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.SetLinkID(v)
+	})
+}
+
+// UpdateLinkID sets the "link_id" field to the value that was provided on create.
+func (u *LinkCheckUpsertBulk) UpdateLinkID() *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.UpdateLinkID()
+	})
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *LinkCheckUpsertBulk) SetStatusCode(v int) *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *LinkCheckUpsertBulk) AddStatusCode(v int) *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *LinkCheckUpsertBulk) UpdateStatusCode() *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *LinkCheckUpsertBulk) ClearStatusCode() *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.ClearStatusCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *LinkCheckUpsertBulk) SetError(v string) *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LinkCheckUpsertBulk) UpdateError() *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LinkCheckUpsertBulk) ClearError() *LinkCheckUpsertBulk {
+	return u.Update(func(s *LinkCheckUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkCheckUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LinkCheckCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkCheckCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkCheckUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
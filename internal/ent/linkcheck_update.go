@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// LinkCheckUpdate is the builder for updating LinkCheck entities.
+type LinkCheckUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LinkCheckMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LinkCheckUpdate builder.
+func (_u *LinkCheckUpdate) Where(ps ...predicate.LinkCheck) *LinkCheckUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLinkID sets the "link_id" field.
+func (_u *LinkCheckUpdate) SetLinkID(v xid.ID) *LinkCheckUpdate {
+	_u.mutation.SetLinkID(v)
+	return _u
+}
+
+// SetNillableLinkID sets the "link_id" field if the given value is not nil.
+func (_u *LinkCheckUpdate) SetNillableLinkID(v *xid.ID) *LinkCheckUpdate {
+	if v != nil {
+		_u.SetLinkID(*v)
+	}
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *LinkCheckUpdate) SetStatusCode(v int) *LinkCheckUpdate {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *LinkCheckUpdate) SetNillableStatusCode(v *int) *LinkCheckUpdate {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *LinkCheckUpdate) AddStatusCode(v int) *LinkCheckUpdate {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *LinkCheckUpdate) ClearStatusCode() *LinkCheckUpdate {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LinkCheckUpdate) SetError(v string) *LinkCheckUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LinkCheckUpdate) SetNillableError(v *string) *LinkCheckUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LinkCheckUpdate) ClearError() *LinkCheckUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetLink sets the "link" edge to the Link entity.
+func (_u *LinkCheckUpdate) SetLink(v *Link) *LinkCheckUpdate {
+	return _u.SetLinkID(v.ID)
+}
+
+// Mutation returns the LinkCheckMutation object of the builder.
+func (_u *LinkCheckUpdate) Mutation() *LinkCheckMutation {
+	return _u.mutation
+}
+
+// ClearLink clears the "link" edge to the Link entity.
+func (_u *LinkCheckUpdate) ClearLink() *LinkCheckUpdate {
+	_u.mutation.ClearLink()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkCheckUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkCheckUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkCheckUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkCheckUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkCheckUpdate) check() error {
+	if _u.mutation.LinkCleared() && len(_u.mutation.LinkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkCheck.link"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LinkCheckUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LinkCheckUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LinkCheckUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkcheck.Table, linkcheck.Columns, sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(linkcheck.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(linkcheck.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(linkcheck.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(linkcheck.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(linkcheck.FieldError, field.TypeString)
+	}
+	if _u.mutation.LinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkcheck.LinkTable,
+			Columns: []string{linkcheck.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkcheck.LinkTable,
+			Columns: []string{linkcheck.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkCheckUpdateOne is the builder for updating a single LinkCheck entity.
+type LinkCheckUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LinkCheckMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLinkID sets the "link_id" field.
+func (_u *LinkCheckUpdateOne) SetLinkID(v xid.ID) *LinkCheckUpdateOne {
+	_u.mutation.SetLinkID(v)
+	return _u
+}
+
+// SetNillableLinkID sets the "link_id" field if the given value is not nil.
+func (_u *LinkCheckUpdateOne) SetNillableLinkID(v *xid.ID) *LinkCheckUpdateOne {
+	if v != nil {
+		_u.SetLinkID(*v)
+	}
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *LinkCheckUpdateOne) SetStatusCode(v int) *LinkCheckUpdateOne {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *LinkCheckUpdateOne) SetNillableStatusCode(v *int) *LinkCheckUpdateOne {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *LinkCheckUpdateOne) AddStatusCode(v int) *LinkCheckUpdateOne {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *LinkCheckUpdateOne) ClearStatusCode() *LinkCheckUpdateOne {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LinkCheckUpdateOne) SetError(v string) *LinkCheckUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LinkCheckUpdateOne) SetNillableError(v *string) *LinkCheckUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LinkCheckUpdateOne) ClearError() *LinkCheckUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetLink sets the "link" edge to the Link entity.
+func (_u *LinkCheckUpdateOne) SetLink(v *Link) *LinkCheckUpdateOne {
+	return _u.SetLinkID(v.ID)
+}
+
+// Mutation returns the LinkCheckMutation object of the builder.
+func (_u *LinkCheckUpdateOne) Mutation() *LinkCheckMutation {
+	return _u.mutation
+}
+
+// ClearLink clears the "link" edge to the Link entity.
+func (_u *LinkCheckUpdateOne) ClearLink() *LinkCheckUpdateOne {
+	_u.mutation.ClearLink()
+	return _u
+}
+
+// Where appends a list predicates to the LinkCheckUpdate builder.
+func (_u *LinkCheckUpdateOne) Where(ps ...predicate.LinkCheck) *LinkCheckUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkCheckUpdateOne) Select(field string, fields ...string) *LinkCheckUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkCheck entity.
+func (_u *LinkCheckUpdateOne) Save(ctx context.Context) (*LinkCheck, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkCheckUpdateOne) SaveX(ctx context.Context) *LinkCheck {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkCheckUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkCheckUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkCheckUpdateOne) check() error {
+	if _u.mutation.LinkCleared() && len(_u.mutation.LinkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkCheck.link"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LinkCheckUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LinkCheckUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LinkCheckUpdateOne) sqlSave(ctx context.Context) (_node *LinkCheck, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkcheck.Table, linkcheck.Columns, sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkCheck.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkcheck.FieldID)
+		for _, f := range fields {
+			if !linkcheck.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(linkcheck.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(linkcheck.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(linkcheck.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(linkcheck.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(linkcheck.FieldError, field.TypeString)
+	}
+	if _u.mutation.LinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkcheck.LinkTable,
+			Columns: []string{linkcheck.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkcheck.LinkTable,
+			Columns: []string{linkcheck.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LinkCheck{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
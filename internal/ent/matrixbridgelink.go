@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/rs/xid"
+)
+
+// MatrixBridgeLink is the model entity for the MatrixBridgeLink schema.
+type MatrixBridgeLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// PostID holds the value of the "post_id" field.
+	PostID xid.ID `json:"post_id,omitempty"`
+	// ThreadID holds the value of the "thread_id" field.
+	ThreadID xid.ID `json:"thread_id,omitempty"`
+	// MatrixRoomID holds the value of the "matrix_room_id" field.
+	MatrixRoomID string `json:"matrix_room_id,omitempty"`
+	// MatrixEventID holds the value of the "matrix_event_id" field.
+	MatrixEventID string `json:"matrix_event_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MatrixBridgeLinkQuery when eager-loading is set.
+	Edges        MatrixBridgeLinkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MatrixBridgeLinkEdges holds the relations/edges for other nodes in the graph.
+type MatrixBridgeLinkEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MatrixBridgeLinkEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MatrixBridgeLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case matrixbridgelink.FieldMatrixRoomID, matrixbridgelink.FieldMatrixEventID:
+			values[i] = new(sql.NullString)
+		case matrixbridgelink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case matrixbridgelink.FieldID, matrixbridgelink.FieldPostID, matrixbridgelink.FieldThreadID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MatrixBridgeLink fields.
+func (_m *MatrixBridgeLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case matrixbridgelink.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case matrixbridgelink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case matrixbridgelink.FieldPostID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value != nil {
+				_m.PostID = *value
+			}
+		case matrixbridgelink.FieldThreadID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_id", values[i])
+			} else if value != nil {
+				_m.ThreadID = *value
+			}
+		case matrixbridgelink.FieldMatrixRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field matrix_room_id", values[i])
+			} else if value.Valid {
+				_m.MatrixRoomID = value.String
+			}
+		case matrixbridgelink.FieldMatrixEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field matrix_event_id", values[i])
+			} else if value.Valid {
+				_m.MatrixEventID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MatrixBridgeLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *MatrixBridgeLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the MatrixBridgeLink entity.
+func (_m *MatrixBridgeLink) QueryPost() *PostQuery {
+	return NewMatrixBridgeLinkClient(_m.config).QueryPost(_m)
+}
+
+// Update returns a builder for updating this MatrixBridgeLink.
+// Note that you need to call MatrixBridgeLink.Unwrap() before calling this method if this MatrixBridgeLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MatrixBridgeLink) Update() *MatrixBridgeLinkUpdateOne {
+	return NewMatrixBridgeLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MatrixBridgeLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MatrixBridgeLink) Unwrap() *MatrixBridgeLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MatrixBridgeLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MatrixBridgeLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("MatrixBridgeLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("post_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PostID))
+	builder.WriteString(", ")
+	builder.WriteString("thread_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ThreadID))
+	builder.WriteString(", ")
+	builder.WriteString("matrix_room_id=")
+	builder.WriteString(_m.MatrixRoomID)
+	builder.WriteString(", ")
+	builder.WriteString("matrix_event_id=")
+	builder.WriteString(_m.MatrixEventID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MatrixBridgeLinks is a parsable slice of MatrixBridgeLink.
+type MatrixBridgeLinks []*MatrixBridgeLink
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package matrixbridgelink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldPostID, v))
+}
+
+// ThreadID applies equality check predicate on the "thread_id" field. It's identical to ThreadIDEQ.
+func ThreadID(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldThreadID, v))
+}
+
+// MatrixRoomID applies equality check predicate on the "matrix_room_id" field. It's identical to MatrixRoomIDEQ.
+func MatrixRoomID(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldMatrixRoomID, v))
+}
+
+// MatrixEventID applies equality check predicate on the "matrix_event_id" field. It's identical to MatrixEventIDEQ.
+func MatrixEventID(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldMatrixEventID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// PostIDGT applies the GT predicate on the "post_id" field.
+func PostIDGT(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGT(FieldPostID, v))
+}
+
+// PostIDGTE applies the GTE predicate on the "post_id" field.
+func PostIDGTE(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGTE(FieldPostID, v))
+}
+
+// PostIDLT applies the LT predicate on the "post_id" field.
+func PostIDLT(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLT(FieldPostID, v))
+}
+
+// PostIDLTE applies the LTE predicate on the "post_id" field.
+func PostIDLTE(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLTE(FieldPostID, v))
+}
+
+// PostIDContains applies the Contains predicate on the "post_id" field.
+func PostIDContains(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldContains(FieldPostID, vc))
+}
+
+// PostIDHasPrefix applies the HasPrefix predicate on the "post_id" field.
+func PostIDHasPrefix(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldHasPrefix(FieldPostID, vc))
+}
+
+// PostIDHasSuffix applies the HasSuffix predicate on the "post_id" field.
+func PostIDHasSuffix(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldHasSuffix(FieldPostID, vc))
+}
+
+// PostIDEqualFold applies the EqualFold predicate on the "post_id" field.
+func PostIDEqualFold(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldEqualFold(FieldPostID, vc))
+}
+
+// PostIDContainsFold applies the ContainsFold predicate on the "post_id" field.
+func PostIDContainsFold(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldContainsFold(FieldPostID, vc))
+}
+
+// ThreadIDEQ applies the EQ predicate on the "thread_id" field.
+func ThreadIDEQ(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldThreadID, v))
+}
+
+// ThreadIDNEQ applies the NEQ predicate on the "thread_id" field.
+func ThreadIDNEQ(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNEQ(FieldThreadID, v))
+}
+
+// ThreadIDIn applies the In predicate on the "thread_id" field.
+func ThreadIDIn(vs ...xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldIn(FieldThreadID, vs...))
+}
+
+// ThreadIDNotIn applies the NotIn predicate on the "thread_id" field.
+func ThreadIDNotIn(vs ...xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNotIn(FieldThreadID, vs...))
+}
+
+// ThreadIDGT applies the GT predicate on the "thread_id" field.
+func ThreadIDGT(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGT(FieldThreadID, v))
+}
+
+// ThreadIDGTE applies the GTE predicate on the "thread_id" field.
+func ThreadIDGTE(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGTE(FieldThreadID, v))
+}
+
+// ThreadIDLT applies the LT predicate on the "thread_id" field.
+func ThreadIDLT(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLT(FieldThreadID, v))
+}
+
+// ThreadIDLTE applies the LTE predicate on the "thread_id" field.
+func ThreadIDLTE(v xid.ID) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLTE(FieldThreadID, v))
+}
+
+// ThreadIDContains applies the Contains predicate on the "thread_id" field.
+func ThreadIDContains(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldContains(FieldThreadID, vc))
+}
+
+// ThreadIDHasPrefix applies the HasPrefix predicate on the "thread_id" field.
+func ThreadIDHasPrefix(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldHasPrefix(FieldThreadID, vc))
+}
+
+// ThreadIDHasSuffix applies the HasSuffix predicate on the "thread_id" field.
+func ThreadIDHasSuffix(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldHasSuffix(FieldThreadID, vc))
+}
+
+// ThreadIDEqualFold applies the EqualFold predicate on the "thread_id" field.
+func ThreadIDEqualFold(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldEqualFold(FieldThreadID, vc))
+}
+
+// ThreadIDContainsFold applies the ContainsFold predicate on the "thread_id" field.
+func ThreadIDContainsFold(v xid.ID) predicate.MatrixBridgeLink {
+	vc := v.String()
+	return predicate.MatrixBridgeLink(sql.FieldContainsFold(FieldThreadID, vc))
+}
+
+// MatrixRoomIDEQ applies the EQ predicate on the "matrix_room_id" field.
+func MatrixRoomIDEQ(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDNEQ applies the NEQ predicate on the "matrix_room_id" field.
+func MatrixRoomIDNEQ(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNEQ(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDIn applies the In predicate on the "matrix_room_id" field.
+func MatrixRoomIDIn(vs ...string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldIn(FieldMatrixRoomID, vs...))
+}
+
+// MatrixRoomIDNotIn applies the NotIn predicate on the "matrix_room_id" field.
+func MatrixRoomIDNotIn(vs ...string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNotIn(FieldMatrixRoomID, vs...))
+}
+
+// MatrixRoomIDGT applies the GT predicate on the "matrix_room_id" field.
+func MatrixRoomIDGT(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGT(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDGTE applies the GTE predicate on the "matrix_room_id" field.
+func MatrixRoomIDGTE(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGTE(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDLT applies the LT predicate on the "matrix_room_id" field.
+func MatrixRoomIDLT(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLT(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDLTE applies the LTE predicate on the "matrix_room_id" field.
+func MatrixRoomIDLTE(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLTE(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDContains applies the Contains predicate on the "matrix_room_id" field.
+func MatrixRoomIDContains(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldContains(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDHasPrefix applies the HasPrefix predicate on the "matrix_room_id" field.
+func MatrixRoomIDHasPrefix(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldHasPrefix(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDHasSuffix applies the HasSuffix predicate on the "matrix_room_id" field.
+func MatrixRoomIDHasSuffix(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldHasSuffix(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDEqualFold applies the EqualFold predicate on the "matrix_room_id" field.
+func MatrixRoomIDEqualFold(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEqualFold(FieldMatrixRoomID, v))
+}
+
+// MatrixRoomIDContainsFold applies the ContainsFold predicate on the "matrix_room_id" field.
+func MatrixRoomIDContainsFold(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldContainsFold(FieldMatrixRoomID, v))
+}
+
+// MatrixEventIDEQ applies the EQ predicate on the "matrix_event_id" field.
+func MatrixEventIDEQ(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEQ(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDNEQ applies the NEQ predicate on the "matrix_event_id" field.
+func MatrixEventIDNEQ(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNEQ(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDIn applies the In predicate on the "matrix_event_id" field.
+func MatrixEventIDIn(vs ...string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldIn(FieldMatrixEventID, vs...))
+}
+
+// MatrixEventIDNotIn applies the NotIn predicate on the "matrix_event_id" field.
+func MatrixEventIDNotIn(vs ...string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldNotIn(FieldMatrixEventID, vs...))
+}
+
+// MatrixEventIDGT applies the GT predicate on the "matrix_event_id" field.
+func MatrixEventIDGT(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGT(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDGTE applies the GTE predicate on the "matrix_event_id" field.
+func MatrixEventIDGTE(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldGTE(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDLT applies the LT predicate on the "matrix_event_id" field.
+func MatrixEventIDLT(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLT(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDLTE applies the LTE predicate on the "matrix_event_id" field.
+func MatrixEventIDLTE(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldLTE(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDContains applies the Contains predicate on the "matrix_event_id" field.
+func MatrixEventIDContains(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldContains(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDHasPrefix applies the HasPrefix predicate on the "matrix_event_id" field.
+func MatrixEventIDHasPrefix(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldHasPrefix(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDHasSuffix applies the HasSuffix predicate on the "matrix_event_id" field.
+func MatrixEventIDHasSuffix(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldHasSuffix(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDEqualFold applies the EqualFold predicate on the "matrix_event_id" field.
+func MatrixEventIDEqualFold(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldEqualFold(FieldMatrixEventID, v))
+}
+
+// MatrixEventIDContainsFold applies the ContainsFold predicate on the "matrix_event_id" field.
+func MatrixEventIDContainsFold(v string) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.FieldContainsFold(FieldMatrixEventID, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MatrixBridgeLink) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MatrixBridgeLink) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MatrixBridgeLink) predicate.MatrixBridgeLink {
+	return predicate.MatrixBridgeLink(sql.NotPredicates(p))
+}
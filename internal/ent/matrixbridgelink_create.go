@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/rs/xid"
+)
+
+// MatrixBridgeLinkCreate is the builder for creating a MatrixBridgeLink entity.
+type MatrixBridgeLinkCreate struct {
+	config
+	mutation *MatrixBridgeLinkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MatrixBridgeLinkCreate) SetCreatedAt(v time.Time) *MatrixBridgeLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MatrixBridgeLinkCreate) SetNillableCreatedAt(v *time.Time) *MatrixBridgeLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *MatrixBridgeLinkCreate) SetPostID(v xid.ID) *MatrixBridgeLinkCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_c *MatrixBridgeLinkCreate) SetThreadID(v xid.ID) *MatrixBridgeLinkCreate {
+	_c.mutation.SetThreadID(v)
+	return _c
+}
+
+// SetMatrixRoomID sets the "matrix_room_id" field.
+func (_c *MatrixBridgeLinkCreate) SetMatrixRoomID(v string) *MatrixBridgeLinkCreate {
+	_c.mutation.SetMatrixRoomID(v)
+	return _c
+}
+
+// SetMatrixEventID sets the "matrix_event_id" field.
+func (_c *MatrixBridgeLinkCreate) SetMatrixEventID(v string) *MatrixBridgeLinkCreate {
+	_c.mutation.SetMatrixEventID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MatrixBridgeLinkCreate) SetID(v xid.ID) *MatrixBridgeLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MatrixBridgeLinkCreate) SetNillableID(v *xid.ID) *MatrixBridgeLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *MatrixBridgeLinkCreate) SetPost(v *Post) *MatrixBridgeLinkCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// Mutation returns the MatrixBridgeLinkMutation object of the builder.
+func (_c *MatrixBridgeLinkCreate) Mutation() *MatrixBridgeLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the MatrixBridgeLink in the database.
+func (_c *MatrixBridgeLinkCreate) Save(ctx context.Context) (*MatrixBridgeLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MatrixBridgeLinkCreate) SaveX(ctx context.Context) *MatrixBridgeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MatrixBridgeLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MatrixBridgeLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MatrixBridgeLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := matrixbridgelink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := matrixbridgelink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MatrixBridgeLinkCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MatrixBridgeLink.created_at"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "MatrixBridgeLink.post_id"`)}
+	}
+	if _, ok := _c.mutation.ThreadID(); !ok {
+		return &ValidationError{Name: "thread_id", err: errors.New(`ent: missing required field "MatrixBridgeLink.thread_id"`)}
+	}
+	if _, ok := _c.mutation.MatrixRoomID(); !ok {
+		return &ValidationError{Name: "matrix_room_id", err: errors.New(`ent: missing required field "MatrixBridgeLink.matrix_room_id"`)}
+	}
+	if _, ok := _c.mutation.MatrixEventID(); !ok {
+		return &ValidationError{Name: "matrix_event_id", err: errors.New(`ent: missing required field "MatrixBridgeLink.matrix_event_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := matrixbridgelink.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "MatrixBridgeLink.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "MatrixBridgeLink.post"`)}
+	}
+	return nil
+}
+
+func (_c *MatrixBridgeLinkCreate) sqlSave(ctx context.Context) (*MatrixBridgeLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MatrixBridgeLinkCreate) createSpec() (*MatrixBridgeLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MatrixBridgeLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(matrixbridgelink.Table, sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(matrixbridgelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ThreadID(); ok {
+		_spec.SetField(matrixbridgelink.FieldThreadID, field.TypeString, value)
+		_node.ThreadID = value
+	}
+	if value, ok := _c.mutation.MatrixRoomID(); ok {
+		_spec.SetField(matrixbridgelink.FieldMatrixRoomID, field.TypeString, value)
+		_node.MatrixRoomID = value
+	}
+	if value, ok := _c.mutation.MatrixEventID(); ok {
+		_spec.SetField(matrixbridgelink.FieldMatrixEventID, field.TypeString, value)
+		_node.MatrixEventID = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   matrixbridgelink.PostTable,
+			Columns: []string{matrixbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MatrixBridgeLink.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MatrixBridgeLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MatrixBridgeLinkCreate) OnConflict(opts ...sql.ConflictOption) *MatrixBridgeLinkUpsertOne {
+	_c.conflict = opts
+	return &MatrixBridgeLinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MatrixBridgeLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MatrixBridgeLinkCreate) OnConflictColumns(columns ...string) *MatrixBridgeLinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MatrixBridgeLinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MatrixBridgeLinkUpsertOne is the builder for "upsert"-ing
+	//  one MatrixBridgeLink node.
+	MatrixBridgeLinkUpsertOne struct {
+		create *MatrixBridgeLinkCreate
+	}
+
+	// MatrixBridgeLinkUpsert is the "OnConflict" setter.
+	MatrixBridgeLinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPostID sets the "post_id" field.
+func (u *MatrixBridgeLinkUpsert) SetPostID(v xid.ID) *MatrixBridgeLinkUpsert {
+	u.Set(matrixbridgelink.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsert) UpdatePostID() *MatrixBridgeLinkUpsert {
+	u.SetExcluded(matrixbridgelink.FieldPostID)
+	return u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *MatrixBridgeLinkUpsert) SetThreadID(v xid.ID) *MatrixBridgeLinkUpsert {
+	u.Set(matrixbridgelink.FieldThreadID, v)
+	return u
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsert) UpdateThreadID() *MatrixBridgeLinkUpsert {
+	u.SetExcluded(matrixbridgelink.FieldThreadID)
+	return u
+}
+
+// SetMatrixRoomID sets the "matrix_room_id" field.
+func (u *MatrixBridgeLinkUpsert) SetMatrixRoomID(v string) *MatrixBridgeLinkUpsert {
+	u.Set(matrixbridgelink.FieldMatrixRoomID, v)
+	return u
+}
+
+// UpdateMatrixRoomID sets the "matrix_room_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsert) UpdateMatrixRoomID() *MatrixBridgeLinkUpsert {
+	u.SetExcluded(matrixbridgelink.FieldMatrixRoomID)
+	return u
+}
+
+// SetMatrixEventID sets the "matrix_event_id" field.
+func (u *MatrixBridgeLinkUpsert) SetMatrixEventID(v string) *MatrixBridgeLinkUpsert {
+	u.Set(matrixbridgelink.FieldMatrixEventID, v)
+	return u
+}
+
+// UpdateMatrixEventID sets the "matrix_event_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsert) UpdateMatrixEventID() *MatrixBridgeLinkUpsert {
+	u.SetExcluded(matrixbridgelink.FieldMatrixEventID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MatrixBridgeLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(matrixbridgelink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MatrixBridgeLinkUpsertOne) UpdateNewValues() *MatrixBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(matrixbridgelink.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(matrixbridgelink.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MatrixBridgeLink.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MatrixBridgeLinkUpsertOne) Ignore() *MatrixBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MatrixBridgeLinkUpsertOne) DoNothing() *MatrixBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MatrixBridgeLinkCreate.OnConflict
+// documentation for more info.
+func (u *MatrixBridgeLinkUpsertOne) Update(set func(*MatrixBridgeLinkUpsert)) *MatrixBridgeLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MatrixBridgeLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *MatrixBridgeLinkUpsertOne) SetPostID(v xid.ID) *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertOne) UpdatePostID() *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *MatrixBridgeLinkUpsertOne) SetThreadID(v xid.ID) *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertOne) UpdateThreadID() *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// SetMatrixRoomID sets the "matrix_room_id" field.
+func (u *MatrixBridgeLinkUpsertOne) SetMatrixRoomID(v string) *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetMatrixRoomID(v)
+	})
+}
+
+// UpdateMatrixRoomID sets the "matrix_room_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertOne) UpdateMatrixRoomID() *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdateMatrixRoomID()
+	})
+}
+
+// SetMatrixEventID sets the "matrix_event_id" field.
+func (u *MatrixBridgeLinkUpsertOne) SetMatrixEventID(v string) *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetMatrixEventID(v)
+	})
+}
+
+// UpdateMatrixEventID sets the "matrix_event_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertOne) UpdateMatrixEventID() *MatrixBridgeLinkUpsertOne {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdateMatrixEventID()
+	})
+}
+
+// Exec executes the query.
+func (u *MatrixBridgeLinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MatrixBridgeLinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MatrixBridgeLinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MatrixBridgeLinkUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MatrixBridgeLinkUpsertOne.ID is not supported by MySQL driver. Use MatrixBridgeLinkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MatrixBridgeLinkUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MatrixBridgeLinkCreateBulk is the builder for creating many MatrixBridgeLink entities in bulk.
+type MatrixBridgeLinkCreateBulk struct {
+	config
+	err      error
+	builders []*MatrixBridgeLinkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MatrixBridgeLink entities in the database.
+func (_c *MatrixBridgeLinkCreateBulk) Save(ctx context.Context) ([]*MatrixBridgeLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MatrixBridgeLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MatrixBridgeLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MatrixBridgeLinkCreateBulk) SaveX(ctx context.Context) []*MatrixBridgeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MatrixBridgeLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MatrixBridgeLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MatrixBridgeLink.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MatrixBridgeLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MatrixBridgeLinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *MatrixBridgeLinkUpsertBulk {
+	_c.conflict = opts
+	return &MatrixBridgeLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MatrixBridgeLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MatrixBridgeLinkCreateBulk) OnConflictColumns(columns ...string) *MatrixBridgeLinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MatrixBridgeLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// MatrixBridgeLinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of MatrixBridgeLink nodes.
+type MatrixBridgeLinkUpsertBulk struct {
+	create *MatrixBridgeLinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MatrixBridgeLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(matrixbridgelink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MatrixBridgeLinkUpsertBulk) UpdateNewValues() *MatrixBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(matrixbridgelink.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(matrixbridgelink.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MatrixBridgeLink.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MatrixBridgeLinkUpsertBulk) Ignore() *MatrixBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MatrixBridgeLinkUpsertBulk) DoNothing() *MatrixBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MatrixBridgeLinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *MatrixBridgeLinkUpsertBulk) Update(set func(*MatrixBridgeLinkUpsert)) *MatrixBridgeLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MatrixBridgeLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *MatrixBridgeLinkUpsertBulk) SetPostID(v xid.ID) *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertBulk) UpdatePostID() *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *MatrixBridgeLinkUpsertBulk) SetThreadID(v xid.ID) *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertBulk) UpdateThreadID() *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// SetMatrixRoomID sets the "matrix_room_id" field.
+func (u *MatrixBridgeLinkUpsertBulk) SetMatrixRoomID(v string) *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetMatrixRoomID(v)
+	})
+}
+
+// UpdateMatrixRoomID sets the "matrix_room_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertBulk) UpdateMatrixRoomID() *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdateMatrixRoomID()
+	})
+}
+
+// SetMatrixEventID sets the "matrix_event_id" field.
+func (u *MatrixBridgeLinkUpsertBulk) SetMatrixEventID(v string) *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.SetMatrixEventID(v)
+	})
+}
+
+// UpdateMatrixEventID sets the "matrix_event_id" field to the value that was provided on create.
+func (u *MatrixBridgeLinkUpsertBulk) UpdateMatrixEventID() *MatrixBridgeLinkUpsertBulk {
+	return u.Update(func(s *MatrixBridgeLinkUpsert) {
+		s.UpdateMatrixEventID()
+	})
+}
+
+// Exec executes the query.
+func (u *MatrixBridgeLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MatrixBridgeLinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MatrixBridgeLinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MatrixBridgeLinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
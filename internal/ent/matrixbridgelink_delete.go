@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// MatrixBridgeLinkDelete is the builder for deleting a MatrixBridgeLink entity.
+type MatrixBridgeLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *MatrixBridgeLinkMutation
+}
+
+// Where appends a list predicates to the MatrixBridgeLinkDelete builder.
+func (_d *MatrixBridgeLinkDelete) Where(ps ...predicate.MatrixBridgeLink) *MatrixBridgeLinkDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MatrixBridgeLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MatrixBridgeLinkDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MatrixBridgeLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(matrixbridgelink.Table, sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MatrixBridgeLinkDeleteOne is the builder for deleting a single MatrixBridgeLink entity.
+type MatrixBridgeLinkDeleteOne struct {
+	_d *MatrixBridgeLinkDelete
+}
+
+// Where appends a list predicates to the MatrixBridgeLinkDelete builder.
+func (_d *MatrixBridgeLinkDeleteOne) Where(ps ...predicate.MatrixBridgeLink) *MatrixBridgeLinkDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MatrixBridgeLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{matrixbridgelink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MatrixBridgeLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// MatrixBridgeLinkUpdate is the builder for updating MatrixBridgeLink entities.
+type MatrixBridgeLinkUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MatrixBridgeLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MatrixBridgeLinkUpdate builder.
+func (_u *MatrixBridgeLinkUpdate) Where(ps ...predicate.MatrixBridgeLink) *MatrixBridgeLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *MatrixBridgeLinkUpdate) SetPostID(v xid.ID) *MatrixBridgeLinkUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdate) SetNillablePostID(v *xid.ID) *MatrixBridgeLinkUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *MatrixBridgeLinkUpdate) SetThreadID(v xid.ID) *MatrixBridgeLinkUpdate {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdate) SetNillableThreadID(v *xid.ID) *MatrixBridgeLinkUpdate {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// SetMatrixRoomID sets the "matrix_room_id" field.
+func (_u *MatrixBridgeLinkUpdate) SetMatrixRoomID(v string) *MatrixBridgeLinkUpdate {
+	_u.mutation.SetMatrixRoomID(v)
+	return _u
+}
+
+// SetNillableMatrixRoomID sets the "matrix_room_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdate) SetNillableMatrixRoomID(v *string) *MatrixBridgeLinkUpdate {
+	if v != nil {
+		_u.SetMatrixRoomID(*v)
+	}
+	return _u
+}
+
+// SetMatrixEventID sets the "matrix_event_id" field.
+func (_u *MatrixBridgeLinkUpdate) SetMatrixEventID(v string) *MatrixBridgeLinkUpdate {
+	_u.mutation.SetMatrixEventID(v)
+	return _u
+}
+
+// SetNillableMatrixEventID sets the "matrix_event_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdate) SetNillableMatrixEventID(v *string) *MatrixBridgeLinkUpdate {
+	if v != nil {
+		_u.SetMatrixEventID(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *MatrixBridgeLinkUpdate) SetPost(v *Post) *MatrixBridgeLinkUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the MatrixBridgeLinkMutation object of the builder.
+func (_u *MatrixBridgeLinkUpdate) Mutation() *MatrixBridgeLinkMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *MatrixBridgeLinkUpdate) ClearPost() *MatrixBridgeLinkUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MatrixBridgeLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MatrixBridgeLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MatrixBridgeLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MatrixBridgeLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MatrixBridgeLinkUpdate) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MatrixBridgeLink.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MatrixBridgeLinkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MatrixBridgeLinkUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MatrixBridgeLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(matrixbridgelink.Table, matrixbridgelink.Columns, sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(matrixbridgelink.FieldThreadID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MatrixRoomID(); ok {
+		_spec.SetField(matrixbridgelink.FieldMatrixRoomID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MatrixEventID(); ok {
+		_spec.SetField(matrixbridgelink.FieldMatrixEventID, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   matrixbridgelink.PostTable,
+			Columns: []string{matrixbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   matrixbridgelink.PostTable,
+			Columns: []string{matrixbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{matrixbridgelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MatrixBridgeLinkUpdateOne is the builder for updating a single MatrixBridgeLink entity.
+type MatrixBridgeLinkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MatrixBridgeLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *MatrixBridgeLinkUpdateOne) SetPostID(v xid.ID) *MatrixBridgeLinkUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdateOne) SetNillablePostID(v *xid.ID) *MatrixBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *MatrixBridgeLinkUpdateOne) SetThreadID(v xid.ID) *MatrixBridgeLinkUpdateOne {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdateOne) SetNillableThreadID(v *xid.ID) *MatrixBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// SetMatrixRoomID sets the "matrix_room_id" field.
+func (_u *MatrixBridgeLinkUpdateOne) SetMatrixRoomID(v string) *MatrixBridgeLinkUpdateOne {
+	_u.mutation.SetMatrixRoomID(v)
+	return _u
+}
+
+// SetNillableMatrixRoomID sets the "matrix_room_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdateOne) SetNillableMatrixRoomID(v *string) *MatrixBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetMatrixRoomID(*v)
+	}
+	return _u
+}
+
+// SetMatrixEventID sets the "matrix_event_id" field.
+func (_u *MatrixBridgeLinkUpdateOne) SetMatrixEventID(v string) *MatrixBridgeLinkUpdateOne {
+	_u.mutation.SetMatrixEventID(v)
+	return _u
+}
+
+// SetNillableMatrixEventID sets the "matrix_event_id" field if the given value is not nil.
+func (_u *MatrixBridgeLinkUpdateOne) SetNillableMatrixEventID(v *string) *MatrixBridgeLinkUpdateOne {
+	if v != nil {
+		_u.SetMatrixEventID(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *MatrixBridgeLinkUpdateOne) SetPost(v *Post) *MatrixBridgeLinkUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the MatrixBridgeLinkMutation object of the builder.
+func (_u *MatrixBridgeLinkUpdateOne) Mutation() *MatrixBridgeLinkMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *MatrixBridgeLinkUpdateOne) ClearPost() *MatrixBridgeLinkUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Where appends a list predicates to the MatrixBridgeLinkUpdate builder.
+func (_u *MatrixBridgeLinkUpdateOne) Where(ps ...predicate.MatrixBridgeLink) *MatrixBridgeLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MatrixBridgeLinkUpdateOne) Select(field string, fields ...string) *MatrixBridgeLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MatrixBridgeLink entity.
+func (_u *MatrixBridgeLinkUpdateOne) Save(ctx context.Context) (*MatrixBridgeLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MatrixBridgeLinkUpdateOne) SaveX(ctx context.Context) *MatrixBridgeLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MatrixBridgeLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MatrixBridgeLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MatrixBridgeLinkUpdateOne) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MatrixBridgeLink.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MatrixBridgeLinkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MatrixBridgeLinkUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MatrixBridgeLinkUpdateOne) sqlSave(ctx context.Context) (_node *MatrixBridgeLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(matrixbridgelink.Table, matrixbridgelink.Columns, sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MatrixBridgeLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, matrixbridgelink.FieldID)
+		for _, f := range fields {
+			if !matrixbridgelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != matrixbridgelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(matrixbridgelink.FieldThreadID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MatrixRoomID(); ok {
+		_spec.SetField(matrixbridgelink.FieldMatrixRoomID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MatrixEventID(); ok {
+		_spec.SetField(matrixbridgelink.FieldMatrixEventID, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   matrixbridgelink.PostTable,
+			Columns: []string{matrixbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   matrixbridgelink.PostTable,
+			Columns: []string{matrixbridgelink.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MatrixBridgeLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{matrixbridgelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,11 +3,57 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APIUsagesColumns holds the columns for the "api_usages" table.
+	APIUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "bucket", Type: field.TypeTime},
+		{Name: "requests", Type: field.TypeInt, Default: 0},
+		{Name: "client_errors", Type: field.TypeInt, Default: 0},
+		{Name: "server_errors", Type: field.TypeInt, Default: 0},
+		{Name: "rate_limited", Type: field.TypeInt, Default: 0},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "access_key_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// APIUsagesTable holds the schema information for the "api_usages" table.
+	APIUsagesTable = &schema.Table{
+		Name:       "api_usages",
+		Columns:    APIUsagesColumns,
+		PrimaryKey: []*schema.Column{APIUsagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_usages_accounts_api_usage",
+				Columns:    []*schema.Column{APIUsagesColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "api_usages_authentications_api_usage",
+				Columns:    []*schema.Column{APIUsagesColumns[9]},
+				RefColumns: []*schema.Column{AuthenticationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apiusage_account_id_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{APIUsagesColumns[8], APIUsagesColumns[3]},
+			},
+			{
+				Name:    "apiusage_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{APIUsagesColumns[3]},
+			},
+		},
+	}
 	// AccountsColumns holds the columns for the "accounts" table.
 	AccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -21,7 +67,14 @@ var (
 		{Name: "kind", Type: field.TypeEnum, Enums: []string{"human", "bot"}, Default: "human"},
 		{Name: "admin", Type: field.TypeBool, Default: false},
 		{Name: "links", Type: field.TypeJSON, Nullable: true},
+		{Name: "pinned_items", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "locale", Type: field.TypeString, Nullable: true},
+		{Name: "timezone", Type: field.TypeString, Nullable: true},
+		{Name: "reading_history_enabled", Type: field.TypeBool, Default: true},
+		{Name: "hidden_activity", Type: field.TypeJSON, Nullable: true},
+		{Name: "verification", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "invited_by_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
 	// AccountsTable holds the schema information for the "accounts" table.
@@ -30,14 +83,134 @@ var (
 		Columns:    AccountsColumns,
 		PrimaryKey: []*schema.Column{AccountsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accounts_accounts_bots",
+				Columns:    []*schema.Column{AccountsColumns[18]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "accounts_invitations_invited",
-				Columns:    []*schema.Column{AccountsColumns[12]},
+				Columns:    []*schema.Column{AccountsColumns[19]},
 				RefColumns: []*schema.Column{InvitationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// AccountApplicationsColumns holds the columns for the "account_applications" table.
+	AccountApplicationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString},
+		{Name: "answers", Type: field.TypeJSON, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "account_id", Type: field.TypeString, Unique: true, Size: 20},
+		{Name: "reviewed_by_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// AccountApplicationsTable holds the schema information for the "account_applications" table.
+	AccountApplicationsTable = &schema.Table{
+		Name:       "account_applications",
+		Columns:    AccountApplicationsColumns,
+		PrimaryKey: []*schema.Column{AccountApplicationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_applications_accounts_application",
+				Columns:    []*schema.Column{AccountApplicationsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "account_applications_accounts_reviewed_applications",
+				Columns:    []*schema.Column{AccountApplicationsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountapplication_status_submitted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountApplicationsColumns[3], AccountApplicationsColumns[5]},
+			},
+		},
+	}
+	// AccountBadgesColumns holds the columns for the "account_badges" table.
+	AccountBadgesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "badge_id", Type: field.TypeString, Size: 20},
+	}
+	// AccountBadgesTable holds the schema information for the "account_badges" table.
+	AccountBadgesTable = &schema.Table{
+		Name:       "account_badges",
+		Columns:    AccountBadgesColumns,
+		PrimaryKey: []*schema.Column{AccountBadgesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_badges_accounts_badges",
+				Columns:    []*schema.Column{AccountBadgesColumns[2]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "account_badges_badges_awards",
+				Columns:    []*schema.Column{AccountBadgesColumns[3]},
+				RefColumns: []*schema.Column{BadgesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_account_badge",
+				Unique:  true,
+				Columns: []*schema.Column{AccountBadgesColumns[2], AccountBadgesColumns[3]},
+			},
+		},
+	}
+	// AccountBlocksColumns holds the columns for the "account_blocks" table.
+	AccountBlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "target_account_id", Type: field.TypeString, Size: 20},
+	}
+	// AccountBlocksTable holds the schema information for the "account_blocks" table.
+	AccountBlocksTable = &schema.Table{
+		Name:       "account_blocks",
+		Columns:    AccountBlocksColumns,
+		PrimaryKey: []*schema.Column{AccountBlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_blocks_accounts_blocks",
+				Columns:    []*schema.Column{AccountBlocksColumns[3]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "account_blocks_accounts_blocked_by",
+				Columns:    []*schema.Column{AccountBlocksColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountblock_account_id_target_account_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccountBlocksColumns[3], AccountBlocksColumns[4]},
+			},
+			{
+				Name:    "accountblock_target_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccountBlocksColumns[4]},
+			},
+		},
+	}
 	// AccountFollowsColumns holds the columns for the "account_follows" table.
 	AccountFollowsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -72,6 +245,39 @@ var (
 			},
 		},
 	}
+	// AccountOnboardingStepsColumns holds the columns for the "account_onboarding_steps" table.
+	AccountOnboardingStepsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "step", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// AccountOnboardingStepsTable holds the schema information for the "account_onboarding_steps" table.
+	AccountOnboardingStepsTable = &schema.Table{
+		Name:       "account_onboarding_steps",
+		Columns:    AccountOnboardingStepsColumns,
+		PrimaryKey: []*schema.Column{AccountOnboardingStepsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_onboarding_steps_accounts_onboarding_steps",
+				Columns:    []*schema.Column{AccountOnboardingStepsColumns[3]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountonboardingstep_account_id_step",
+				Unique:  true,
+				Columns: []*schema.Column{AccountOnboardingStepsColumns[3], AccountOnboardingStepsColumns[2]},
+			},
+			{
+				Name:    "accountonboardingstep_step",
+				Unique:  false,
+				Columns: []*schema.Column{AccountOnboardingStepsColumns[2]},
+			},
+		},
+	}
 	// AccountRolesColumns holds the columns for the "account_roles" table.
 	AccountRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -112,6 +318,104 @@ var (
 			},
 		},
 	}
+	// AnalyticsCohortsColumns holds the columns for the "analytics_cohorts" table.
+	AnalyticsCohortsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "cohort", Type: field.TypeTime},
+		{Name: "week", Type: field.TypeInt},
+		{Name: "size", Type: field.TypeInt, Default: 0},
+		{Name: "retained", Type: field.TypeInt, Default: 0},
+	}
+	// AnalyticsCohortsTable holds the schema information for the "analytics_cohorts" table.
+	AnalyticsCohortsTable = &schema.Table{
+		Name:       "analytics_cohorts",
+		Columns:    AnalyticsCohortsColumns,
+		PrimaryKey: []*schema.Column{AnalyticsCohortsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "analyticscohort_cohort_week",
+				Unique:  true,
+				Columns: []*schema.Column{AnalyticsCohortsColumns[3], AnalyticsCohortsColumns[4]},
+			},
+		},
+	}
+	// AnalyticsContentsColumns holds the columns for the "analytics_contents" table.
+	AnalyticsContentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "item_kind", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "views", Type: field.TypeInt, Default: 0},
+		{Name: "replies", Type: field.TypeInt, Default: 0},
+		{Name: "reactions", Type: field.TypeInt, Default: 0},
+		{Name: "likes", Type: field.TypeInt, Default: 0},
+		{Name: "collections", Type: field.TypeInt, Default: 0},
+	}
+	// AnalyticsContentsTable holds the schema information for the "analytics_contents" table.
+	AnalyticsContentsTable = &schema.Table{
+		Name:       "analytics_contents",
+		Columns:    AnalyticsContentsColumns,
+		PrimaryKey: []*schema.Column{AnalyticsContentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "analyticscontent_date_item_kind_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{AnalyticsContentsColumns[2], AnalyticsContentsColumns[3], AnalyticsContentsColumns[4]},
+			},
+		},
+	}
+	// AnalyticsDaysColumns holds the columns for the "analytics_days" table.
+	AnalyticsDaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "signups", Type: field.TypeInt, Default: 0},
+		{Name: "daily_active", Type: field.TypeInt, Default: 0},
+		{Name: "weekly_active", Type: field.TypeInt, Default: 0},
+		{Name: "monthly_active", Type: field.TypeInt, Default: 0},
+		{Name: "threads", Type: field.TypeInt, Default: 0},
+		{Name: "replies", Type: field.TypeInt, Default: 0},
+		{Name: "reactions", Type: field.TypeInt, Default: 0},
+		{Name: "likes", Type: field.TypeInt, Default: 0},
+	}
+	// AnalyticsDaysTable holds the schema information for the "analytics_days" table.
+	AnalyticsDaysTable = &schema.Table{
+		Name:       "analytics_days",
+		Columns:    AnalyticsDaysColumns,
+		PrimaryKey: []*schema.Column{AnalyticsDaysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "analyticsday_date",
+				Unique:  true,
+				Columns: []*schema.Column{AnalyticsDaysColumns[3]},
+			},
+		},
+	}
+	// AnalyticsExportsColumns holds the columns for the "analytics_exports" table.
+	AnalyticsExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "sink", Type: field.TypeString},
+		{Name: "exported_through", Type: field.TypeTime},
+	}
+	// AnalyticsExportsTable holds the schema information for the "analytics_exports" table.
+	AnalyticsExportsTable = &schema.Table{
+		Name:       "analytics_exports",
+		Columns:    AnalyticsExportsColumns,
+		PrimaryKey: []*schema.Column{AnalyticsExportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "analyticsexport_sink",
+				Unique:  true,
+				Columns: []*schema.Column{AnalyticsExportsColumns[3]},
+			},
+		},
+	}
 	// AssetsColumns holds the columns for the "assets" table.
 	AssetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -121,6 +425,11 @@ var (
 		{Name: "size", Type: field.TypeInt, Default: "0"},
 		{Name: "mime_type", Type: field.TypeString, Default: "application/octet-stream"},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
+		{Name: "processing_status", Type: field.TypeString, Nullable: true},
+		{Name: "private", Type: field.TypeBool, Default: false},
+		{Name: "scan_status", Type: field.TypeString, Nullable: true},
+		{Name: "scan_signature", Type: field.TypeString, Nullable: true},
 		{Name: "account_id", Type: field.TypeString, Size: 20},
 		{Name: "parent_asset_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
@@ -132,13 +441,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "assets_accounts_assets",
-				Columns:    []*schema.Column{AssetsColumns[7]},
+				Columns:    []*schema.Column{AssetsColumns[12]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "assets_assets_assets",
-				Columns:    []*schema.Column{AssetsColumns[8]},
+				Columns:    []*schema.Column{AssetsColumns[13]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -149,6 +458,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{AssetsColumns[3]},
 			},
+			{
+				Name:    "asset_content_hash",
+				Unique:  false,
+				Columns: []*schema.Column{AssetsColumns[7]},
+			},
 		},
 	}
 	// AuthenticationsColumns holds the columns for the "authentications" table.
@@ -191,6 +505,80 @@ var (
 			},
 		},
 	}
+	// AutomodRulesColumns holds the columns for the "automod_rules" table.
+	AutomodRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "pattern", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "words", Type: field.TypeJSON, Nullable: true},
+		{Name: "threshold", Type: field.TypeInt, Nullable: true},
+		{Name: "window_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// AutomodRulesTable holds the schema information for the "automod_rules" table.
+	AutomodRulesTable = &schema.Table{
+		Name:       "automod_rules",
+		Columns:    AutomodRulesColumns,
+		PrimaryKey: []*schema.Column{AutomodRulesColumns[0]},
+	}
+	// BadgesColumns holds the columns for the "badges" table.
+	BadgesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString},
+		{Name: "icon", Type: field.TypeString, Nullable: true},
+		{Name: "trigger", Type: field.TypeString, Nullable: true},
+		{Name: "threshold", Type: field.TypeInt, Default: 0},
+	}
+	// BadgesTable holds the schema information for the "badges" table.
+	BadgesTable = &schema.Table{
+		Name:       "badges",
+		Columns:    BadgesColumns,
+		PrimaryKey: []*schema.Column{BadgesColumns[0]},
+	}
+	// BookmarksColumns holds the columns for the "bookmarks" table.
+	BookmarksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "target_kind", Type: field.TypeString},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// BookmarksTable holds the schema information for the "bookmarks" table.
+	BookmarksTable = &schema.Table{
+		Name:       "bookmarks",
+		Columns:    BookmarksColumns,
+		PrimaryKey: []*schema.Column{BookmarksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bookmarks_accounts_bookmarks",
+				Columns:    []*schema.Column{BookmarksColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bookmark_account_id_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{BookmarksColumns[6], BookmarksColumns[3]},
+			},
+			{
+				Name:    "bookmark_account_id_target_kind",
+				Unique:  false,
+				Columns: []*schema.Column{BookmarksColumns[6], BookmarksColumns[4]},
+			},
+		},
+	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -202,6 +590,9 @@ var (
 		{Name: "colour", Type: field.TypeString, Default: "#8577ce"},
 		{Name: "sort", Type: field.TypeInt, Default: -1},
 		{Name: "admin", Type: field.TypeBool, Default: false},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "opens_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "parent_category_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "cover_image_asset_id", Type: field.TypeString, Nullable: true, Size: 20},
@@ -214,18 +605,90 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "categories_categories_children",
-				Columns:    []*schema.Column{CategoriesColumns[10]},
+				Columns:    []*schema.Column{CategoriesColumns[13]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "categories_assets_cover_image",
-				Columns:    []*schema.Column{CategoriesColumns[11]},
+				Columns:    []*schema.Column{CategoriesColumns[14]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// CategoryFollowsColumns holds the columns for the "category_follows" table.
+	CategoryFollowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "category_id", Type: field.TypeString, Size: 20},
+	}
+	// CategoryFollowsTable holds the schema information for the "category_follows" table.
+	CategoryFollowsTable = &schema.Table{
+		Name:       "category_follows",
+		Columns:    CategoryFollowsColumns,
+		PrimaryKey: []*schema.Column{CategoryFollowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_follows_accounts_category_follows",
+				Columns:    []*schema.Column{CategoryFollowsColumns[2]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "category_follows_categories_followers",
+				Columns:    []*schema.Column{CategoryFollowsColumns[3]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_category_following_pair",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryFollowsColumns[2], CategoryFollowsColumns[3]},
+			},
+		},
+	}
+	// CategoryPermissionsColumns holds the columns for the "category_permissions" table.
+	CategoryPermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role_id", Type: field.TypeString},
+		{Name: "can_read", Type: field.TypeBool, Default: true},
+		{Name: "can_create_thread", Type: field.TypeBool, Default: true},
+		{Name: "can_reply", Type: field.TypeBool, Default: true},
+		{Name: "can_attach", Type: field.TypeBool, Default: true},
+		{Name: "category_id", Type: field.TypeString, Size: 20},
+	}
+	// CategoryPermissionsTable holds the schema information for the "category_permissions" table.
+	CategoryPermissionsTable = &schema.Table{
+		Name:       "category_permissions",
+		Columns:    CategoryPermissionsColumns,
+		PrimaryKey: []*schema.Column{CategoryPermissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_permissions_categories_permissions",
+				Columns:    []*schema.Column{CategoryPermissionsColumns[8]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_category_role_permission",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryPermissionsColumns[8], CategoryPermissionsColumns[3]},
+			},
+			{
+				Name:    "categorypermission_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategoryPermissionsColumns[3]},
+			},
+		},
+	}
 	// CollectionsColumns holds the columns for the "collections" table.
 	CollectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -263,33 +726,41 @@ var (
 	CollectionNodesColumns = []*schema.Column{
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "membership_type", Type: field.TypeString, Default: "normal"},
+		{Name: "sort", Type: field.TypeString, Nullable: true},
 		{Name: "collection_id", Type: field.TypeString, Size: 20},
 		{Name: "node_id", Type: field.TypeString, Size: 20},
+		{Name: "section_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
 	// CollectionNodesTable holds the schema information for the "collection_nodes" table.
 	CollectionNodesTable = &schema.Table{
 		Name:       "collection_nodes",
 		Columns:    CollectionNodesColumns,
-		PrimaryKey: []*schema.Column{CollectionNodesColumns[2], CollectionNodesColumns[3]},
+		PrimaryKey: []*schema.Column{CollectionNodesColumns[3], CollectionNodesColumns[4]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "collection_nodes_collections_collection",
-				Columns:    []*schema.Column{CollectionNodesColumns[2]},
+				Columns:    []*schema.Column{CollectionNodesColumns[3]},
 				RefColumns: []*schema.Column{CollectionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "collection_nodes_nodes_node",
-				Columns:    []*schema.Column{CollectionNodesColumns[3]},
+				Columns:    []*schema.Column{CollectionNodesColumns[4]},
 				RefColumns: []*schema.Column{NodesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "collection_nodes_collection_sections_section",
+				Columns:    []*schema.Column{CollectionNodesColumns[5]},
+				RefColumns: []*schema.Column{CollectionSectionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "unique_collection_node",
 				Unique:  true,
-				Columns: []*schema.Column{CollectionNodesColumns[2], CollectionNodesColumns[3]},
+				Columns: []*schema.Column{CollectionNodesColumns[3], CollectionNodesColumns[4]},
 			},
 		},
 	}
@@ -297,67 +768,341 @@ var (
 	CollectionPostsColumns = []*schema.Column{
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "membership_type", Type: field.TypeString, Default: "normal"},
+		{Name: "sort", Type: field.TypeString, Nullable: true},
 		{Name: "collection_id", Type: field.TypeString, Size: 20},
 		{Name: "post_id", Type: field.TypeString, Size: 20},
+		{Name: "section_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
 	// CollectionPostsTable holds the schema information for the "collection_posts" table.
 	CollectionPostsTable = &schema.Table{
 		Name:       "collection_posts",
 		Columns:    CollectionPostsColumns,
-		PrimaryKey: []*schema.Column{CollectionPostsColumns[2], CollectionPostsColumns[3]},
+		PrimaryKey: []*schema.Column{CollectionPostsColumns[3], CollectionPostsColumns[4]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "collection_posts_collections_collection",
-				Columns:    []*schema.Column{CollectionPostsColumns[2]},
+				Columns:    []*schema.Column{CollectionPostsColumns[3]},
 				RefColumns: []*schema.Column{CollectionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "collection_posts_posts_post",
-				Columns:    []*schema.Column{CollectionPostsColumns[3]},
+				Columns:    []*schema.Column{CollectionPostsColumns[4]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "collection_posts_collection_sections_section",
+				Columns:    []*schema.Column{CollectionPostsColumns[5]},
+				RefColumns: []*schema.Column{CollectionSectionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "unique_collection_post",
 				Unique:  true,
-				Columns: []*schema.Column{CollectionPostsColumns[2], CollectionPostsColumns[3]},
+				Columns: []*schema.Column{CollectionPostsColumns[3], CollectionPostsColumns[4]},
 			},
 		},
 	}
-	// EmailsColumns holds the columns for the "emails" table.
-	EmailsColumns = []*schema.Column{
+	// CollectionSectionsColumns holds the columns for the "collection_sections" table.
+	CollectionSectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
-		{Name: "email_address", Type: field.TypeString, Unique: true, Size: 254},
-		{Name: "verification_code", Type: field.TypeString, Size: 6},
-		{Name: "verified", Type: field.TypeBool, Default: "false"},
-		{Name: "account_id", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "sort", Type: field.TypeString},
+		{Name: "collection_id", Type: field.TypeString, Size: 20},
 	}
-	// EmailsTable holds the schema information for the "emails" table.
-	EmailsTable = &schema.Table{
-		Name:       "emails",
-		Columns:    EmailsColumns,
-		PrimaryKey: []*schema.Column{EmailsColumns[0]},
+	// CollectionSectionsTable holds the schema information for the "collection_sections" table.
+	CollectionSectionsTable = &schema.Table{
+		Name:       "collection_sections",
+		Columns:    CollectionSectionsColumns,
+		PrimaryKey: []*schema.Column{CollectionSectionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "emails_accounts_emails",
-				Columns:    []*schema.Column{EmailsColumns[5]},
-				RefColumns: []*schema.Column{AccountsColumns[0]},
+				Symbol:     "collection_sections_collections_sections",
+				Columns:    []*schema.Column{CollectionSectionsColumns[6]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collectionsection_collection_id_sort",
+				Unique:  false,
+				Columns: []*schema.Column{CollectionSectionsColumns[6], CollectionSectionsColumns[5]},
+			},
+		},
 	}
-	// EventsColumns holds the columns for the "events" table.
-	EventsColumns = []*schema.Column{
+	// CollectionSharesColumns holds the columns for the "collection_shares" table.
+	CollectionSharesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
-		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "indexed_at", Type: field.TypeTime, Nullable: true},
-		{Name: "name", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "passcode_hash", Type: field.TypeString, Nullable: true},
+		{Name: "collection_id", Type: field.TypeString, Size: 20},
+	}
+	// CollectionSharesTable holds the schema information for the "collection_shares" table.
+	CollectionSharesTable = &schema.Table{
+		Name:       "collection_shares",
+		Columns:    CollectionSharesColumns,
+		PrimaryKey: []*schema.Column{CollectionSharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collection_shares_collections_shares",
+				Columns:    []*schema.Column{CollectionSharesColumns[5]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ContentSummariesColumns holds the columns for the "content_summaries" table.
+	ContentSummariesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "item_kind", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString, Unique: true},
+		{Name: "summary", Type: field.TypeString, Size: 2147483647},
+		{Name: "signature", Type: field.TypeJSON},
+	}
+	// ContentSummariesTable holds the schema information for the "content_summaries" table.
+	ContentSummariesTable = &schema.Table{
+		Name:       "content_summaries",
+		Columns:    ContentSummariesColumns,
+		PrimaryKey: []*schema.Column{ContentSummariesColumns[0]},
+	}
+	// ContentViewsColumns holds the columns for the "content_views" table.
+	ContentViewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "period", Type: field.TypeString},
+		{Name: "bucket", Type: field.TypeTime},
+		{Name: "item_kind", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "views", Type: field.TypeInt, Default: 0},
+		{Name: "unique_viewers", Type: field.TypeInt, Default: 0},
+		{Name: "author_id", Type: field.TypeString, Size: 20},
+	}
+	// ContentViewsTable holds the schema information for the "content_views" table.
+	ContentViewsTable = &schema.Table{
+		Name:       "content_views",
+		Columns:    ContentViewsColumns,
+		PrimaryKey: []*schema.Column{ContentViewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "content_views_accounts_content_views",
+				Columns:    []*schema.Column{ContentViewsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentview_item_id_period_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{ContentViewsColumns[6], ContentViewsColumns[3], ContentViewsColumns[4]},
+			},
+			{
+				Name:    "contentview_author_id_period_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{ContentViewsColumns[9], ContentViewsColumns[3], ContentViewsColumns[4]},
+			},
+			{
+				Name:    "contentview_period_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{ContentViewsColumns[3], ContentViewsColumns[4]},
+			},
+		},
+	}
+	// ConversationsColumns holds the columns for the "conversations" table.
+	ConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "last_message_at", Type: field.TypeTime},
+	}
+	// ConversationsTable holds the schema information for the "conversations" table.
+	ConversationsTable = &schema.Table{
+		Name:       "conversations",
+		Columns:    ConversationsColumns,
+		PrimaryKey: []*schema.Column{ConversationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversation_last_message_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationsColumns[4]},
+			},
+		},
+	}
+	// ConversationParticipantsColumns holds the columns for the "conversation_participants" table.
+	ConversationParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "last_read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "left_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "conversation_id", Type: field.TypeString, Size: 20},
+	}
+	// ConversationParticipantsTable holds the schema information for the "conversation_participants" table.
+	ConversationParticipantsTable = &schema.Table{
+		Name:       "conversation_participants",
+		Columns:    ConversationParticipantsColumns,
+		PrimaryKey: []*schema.Column{ConversationParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversation_participants_accounts_conversations",
+				Columns:    []*schema.Column{ConversationParticipantsColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "conversation_participants_conversations_participants",
+				Columns:    []*schema.Column{ConversationParticipantsColumns[5]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationparticipant_conversation_id_account_id",
+				Unique:  true,
+				Columns: []*schema.Column{ConversationParticipantsColumns[5], ConversationParticipantsColumns[4]},
+			},
+			{
+				Name:    "conversationparticipant_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationParticipantsColumns[4]},
+			},
+		},
+	}
+	// DigestSubscriptionsColumns holds the columns for the "digest_subscriptions" table.
+	DigestSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "frequency", Type: field.TypeString},
+		{Name: "last_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Unique: true, Size: 20},
+	}
+	// DigestSubscriptionsTable holds the schema information for the "digest_subscriptions" table.
+	DigestSubscriptionsTable = &schema.Table{
+		Name:       "digest_subscriptions",
+		Columns:    DigestSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{DigestSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "digest_subscriptions_accounts_digest_subscription",
+				Columns:    []*schema.Column{DigestSubscriptionsColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DirectMessagesColumns holds the columns for the "direct_messages" table.
+	DirectMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "body", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "conversation_id", Type: field.TypeString, Size: 20},
+	}
+	// DirectMessagesTable holds the schema information for the "direct_messages" table.
+	DirectMessagesTable = &schema.Table{
+		Name:       "direct_messages",
+		Columns:    DirectMessagesColumns,
+		PrimaryKey: []*schema.Column{DirectMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "direct_messages_accounts_direct_messages",
+				Columns:    []*schema.Column{DirectMessagesColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "direct_messages_conversations_messages",
+				Columns:    []*schema.Column{DirectMessagesColumns[6]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "directmessage_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DirectMessagesColumns[6], DirectMessagesColumns[1]},
+			},
+		},
+	}
+	// DiscordBridgeLinksColumns holds the columns for the "discord_bridge_links" table.
+	DiscordBridgeLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "thread_id", Type: field.TypeString},
+		{Name: "discord_channel_id", Type: field.TypeString},
+		{Name: "discord_message_id", Type: field.TypeString, Unique: true},
+		{Name: "post_id", Type: field.TypeString, Size: 20},
+	}
+	// DiscordBridgeLinksTable holds the schema information for the "discord_bridge_links" table.
+	DiscordBridgeLinksTable = &schema.Table{
+		Name:       "discord_bridge_links",
+		Columns:    DiscordBridgeLinksColumns,
+		PrimaryKey: []*schema.Column{DiscordBridgeLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_bridge_links_posts_discord_links",
+				Columns:    []*schema.Column{DiscordBridgeLinksColumns[5]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discordbridgelink_discord_channel_id",
+				Unique:  false,
+				Columns: []*schema.Column{DiscordBridgeLinksColumns[3]},
+			},
+		},
+	}
+	// EmailsColumns holds the columns for the "emails" table.
+	EmailsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "email_address", Type: field.TypeString, Unique: true, Size: 254},
+		{Name: "verification_code", Type: field.TypeString, Size: 6},
+		{Name: "verified", Type: field.TypeBool, Default: "false"},
+		{Name: "account_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// EmailsTable holds the schema information for the "emails" table.
+	EmailsTable = &schema.Table{
+		Name:       "emails",
+		Columns:    EmailsColumns,
+		PrimaryKey: []*schema.Column{EmailsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "emails_accounts_emails",
+				Columns:    []*schema.Column{EmailsColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// EventsColumns holds the columns for the "events" table.
+	EventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "indexed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "start_time", Type: field.TypeTime},
@@ -438,6 +1183,139 @@ var (
 			},
 		},
 	}
+	// FeatureFlagsColumns holds the columns for the "feature_flags" table.
+	FeatureFlagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "percentage", Type: field.TypeInt, Default: 0},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "accounts", Type: field.TypeJSON, Nullable: true},
+	}
+	// FeatureFlagsTable holds the schema information for the "feature_flags" table.
+	FeatureFlagsTable = &schema.Table{
+		Name:       "feature_flags",
+		Columns:    FeatureFlagsColumns,
+		PrimaryKey: []*schema.Column{FeatureFlagsColumns[0]},
+	}
+	// FederationFollowersColumns holds the columns for the "federation_followers" table.
+	FederationFollowersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "local_actor", Type: field.TypeString},
+		{Name: "actor_uri", Type: field.TypeString, Size: 2147483647},
+		{Name: "inbox", Type: field.TypeString, Size: 2147483647},
+		{Name: "shared_inbox", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// FederationFollowersTable holds the schema information for the "federation_followers" table.
+	FederationFollowersTable = &schema.Table{
+		Name:       "federation_followers",
+		Columns:    FederationFollowersColumns,
+		PrimaryKey: []*schema.Column{FederationFollowersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "federationfollower_local_actor_actor_uri",
+				Unique:  true,
+				Columns: []*schema.Column{FederationFollowersColumns[3], FederationFollowersColumns[4]},
+			},
+		},
+	}
+	// FederationKeysColumns holds the columns for the "federation_keys" table.
+	FederationKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "local_actor", Type: field.TypeString, Unique: true},
+		{Name: "private_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "public_key", Type: field.TypeString, Size: 2147483647},
+	}
+	// FederationKeysTable holds the schema information for the "federation_keys" table.
+	FederationKeysTable = &schema.Table{
+		Name:       "federation_keys",
+		Columns:    FederationKeysColumns,
+		PrimaryKey: []*schema.Column{FederationKeysColumns[0]},
+	}
+	// FeedTokensColumns holds the columns for the "feed_tokens" table.
+	FeedTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "account_id", Type: field.TypeString, Unique: true, Size: 20},
+	}
+	// FeedTokensTable holds the schema information for the "feed_tokens" table.
+	FeedTokensTable = &schema.Table{
+		Name:       "feed_tokens",
+		Columns:    FeedTokensColumns,
+		PrimaryKey: []*schema.Column{FeedTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "feed_tokens_accounts_feed_token",
+				Columns:    []*schema.Column{FeedTokensColumns[3]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ImportRecordsColumns holds the columns for the "import_records" table.
+	ImportRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "source", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "external_id", Type: field.TypeString},
+		{Name: "internal_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"imported", "skipped", "failed"}},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "run_id", Type: field.TypeString, Size: 20},
+	}
+	// ImportRecordsTable holds the schema information for the "import_records" table.
+	ImportRecordsTable = &schema.Table{
+		Name:       "import_records",
+		Columns:    ImportRecordsColumns,
+		PrimaryKey: []*schema.Column{ImportRecordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_records_import_runs_records",
+				Columns:    []*schema.Column{ImportRecordsColumns[9]},
+				RefColumns: []*schema.Column{ImportRunsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importrecord_source_kind_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{ImportRecordsColumns[3], ImportRecordsColumns[4], ImportRecordsColumns[5]},
+			},
+			{
+				Name:    "importrecord_run_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ImportRecordsColumns[9], ImportRecordsColumns[7]},
+			},
+		},
+	}
+	// ImportRunsColumns holds the columns for the "import_runs" table.
+	ImportRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "source", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "asset_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "failed"}, Default: "pending"},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ImportRunsTable holds the schema information for the "import_runs" table.
+	ImportRunsTable = &schema.Table{
+		Name:       "import_runs",
+		Columns:    ImportRunsColumns,
+		PrimaryKey: []*schema.Column{ImportRunsColumns[0]},
+	}
 	// InvitationsColumns holds the columns for the "invitations" table.
 	InvitationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -445,6 +1323,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "role_id", Type: field.TypeString, Nullable: true},
 		{Name: "creator_account_id", Type: field.TypeString, Size: 20},
 	}
 	// InvitationsTable holds the schema information for the "invitations" table.
@@ -455,12 +1336,54 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "invitations_accounts_invitations",
-				Columns:    []*schema.Column{InvitationsColumns[5]},
+				Columns:    []*schema.Column{InvitationsColumns[8]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "unique_key", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[6], JobsColumns[9]},
+			},
+			{
+				Name:    "job_unique_key",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status IN ('pending', 'running')",
+				},
+			},
+			{
+				Name:    "job_kind",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[3]},
+			},
+		},
+	}
 	// LikePostsColumns holds the columns for the "like_posts" table.
 	LikePostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -504,8 +1427,16 @@ var (
 		{Name: "domain", Type: field.TypeString},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
+		{Name: "site_name", Type: field.TypeString, Nullable: true},
+		{Name: "fetched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "check_failures", Type: field.TypeInt, Default: 0},
+		{Name: "broken_at", Type: field.TypeTime, Nullable: true},
+		{Name: "snapshot_text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "archive_url", Type: field.TypeString, Nullable: true},
 		{Name: "primary_asset_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "favicon_asset_id", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "snapshot_asset_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
 	// LinksTable holds the schema information for the "links" table.
 	LinksTable = &schema.Table{
@@ -515,18 +1446,83 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "links_assets_primary_image",
-				Columns:    []*schema.Column{LinksColumns[7]},
+				Columns:    []*schema.Column{LinksColumns[14]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "links_assets_favicon_image",
-				Columns:    []*schema.Column{LinksColumns[8]},
+				Columns:    []*schema.Column{LinksColumns[15]},
+				RefColumns: []*schema.Column{AssetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "links_assets_snapshot",
+				Columns:    []*schema.Column{LinksColumns[16]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// LinkChecksColumns holds the columns for the "link_checks" table.
+	LinkChecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "link_id", Type: field.TypeString, Size: 20},
+	}
+	// LinkChecksTable holds the schema information for the "link_checks" table.
+	LinkChecksTable = &schema.Table{
+		Name:       "link_checks",
+		Columns:    LinkChecksColumns,
+		PrimaryKey: []*schema.Column{LinkChecksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_checks_links_checks",
+				Columns:    []*schema.Column{LinkChecksColumns[4]},
+				RefColumns: []*schema.Column{LinksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkcheck_link_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinkChecksColumns[4], LinkChecksColumns[1]},
+			},
+		},
+	}
+	// MatrixBridgeLinksColumns holds the columns for the "matrix_bridge_links" table.
+	MatrixBridgeLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "thread_id", Type: field.TypeString},
+		{Name: "matrix_room_id", Type: field.TypeString},
+		{Name: "matrix_event_id", Type: field.TypeString, Unique: true},
+		{Name: "post_id", Type: field.TypeString, Size: 20},
+	}
+	// MatrixBridgeLinksTable holds the schema information for the "matrix_bridge_links" table.
+	MatrixBridgeLinksTable = &schema.Table{
+		Name:       "matrix_bridge_links",
+		Columns:    MatrixBridgeLinksColumns,
+		PrimaryKey: []*schema.Column{MatrixBridgeLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "matrix_bridge_links_posts_matrix_links",
+				Columns:    []*schema.Column{MatrixBridgeLinksColumns[5]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "matrixbridgelink_thread_id",
+				Unique:  false,
+				Columns: []*schema.Column{MatrixBridgeLinksColumns[2]},
+			},
+		},
+	}
 	// MentionProfilesColumns holds the columns for the "mention_profiles" table.
 	MentionProfilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -561,22 +1557,91 @@ var (
 			},
 		},
 	}
-	// NodesColumns holds the columns for the "nodes" table.
-	NodesColumns = []*schema.Column{
+	// ModerationNotesColumns holds the columns for the "moderation_notes" table.
+	ModerationNotesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "indexed_at", Type: field.TypeTime, Nullable: true},
-		{Name: "name", Type: field.TypeString},
-		{Name: "slug", Type: field.TypeString, Unique: true},
-		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "content", Type: field.TypeString, Nullable: true},
-		{Name: "hide_child_tree", Type: field.TypeBool, Default: map[string]schema.Expr{"postgres": "false", "sqlite3": "0"}},
-		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"draft", "unlisted", "review", "published"}, Default: "draft"},
-		{Name: "sort", Type: field.TypeString},
-		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
-		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "target_kind", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "author_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// ModerationNotesTable holds the schema information for the "moderation_notes" table.
+	ModerationNotesTable = &schema.Table{
+		Name:       "moderation_notes",
+		Columns:    ModerationNotesColumns,
+		PrimaryKey: []*schema.Column{ModerationNotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "moderation_notes_accounts_moderation_notes",
+				Columns:    []*schema.Column{ModerationNotesColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "moderation_notes_accounts_authored_moderation_notes",
+				Columns:    []*schema.Column{ModerationNotesColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationnote_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationNotesColumns[7]},
+			},
+			{
+				Name:    "moderationnote_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationNotesColumns[3]},
+			},
+		},
+	}
+	// NetworkBansColumns holds the columns for the "network_bans" table.
+	NetworkBansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "target", Type: field.TypeString},
+		{Name: "list", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "hits", Type: field.TypeInt, Default: 0},
+		{Name: "last_hit_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NetworkBansTable holds the schema information for the "network_bans" table.
+	NetworkBansTable = &schema.Table{
+		Name:       "network_bans",
+		Columns:    NetworkBansColumns,
+		PrimaryKey: []*schema.Column{NetworkBansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "networkban_target_list",
+				Unique:  true,
+				Columns: []*schema.Column{NetworkBansColumns[3], NetworkBansColumns[4]},
+			},
+		},
+	}
+	// NodesColumns holds the columns for the "nodes" table.
+	NodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "indexed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true},
+		{Name: "hide_child_tree", Type: field.TypeBool, Default: map[string]schema.Expr{"postgres": "false", "sqlite3": "0"}},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"draft", "unlisted", "review", "published"}, Default: "draft"},
+		{Name: "sort", Type: field.TypeString},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
 		{Name: "link_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "parent_node_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "primary_asset_id", Type: field.TypeString, Nullable: true, Size: 20},
@@ -659,6 +1724,119 @@ var (
 			},
 		},
 	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "in_app", Type: field.TypeBool},
+		{Name: "email", Type: field.TypeBool},
+		{Name: "web_push", Type: field.TypeBool},
+		{Name: "telegram", Type: field.TypeBool, Default: false},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_accounts_notification_preferences",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_notification_preference",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[8], NotificationPreferencesColumns[3]},
+			},
+		},
+	}
+	// PolicyAcceptancesColumns holds the columns for the "policy_acceptances" table.
+	PolicyAcceptancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "version_id", Type: field.TypeString, Size: 20},
+	}
+	// PolicyAcceptancesTable holds the schema information for the "policy_acceptances" table.
+	PolicyAcceptancesTable = &schema.Table{
+		Name:       "policy_acceptances",
+		Columns:    PolicyAcceptancesColumns,
+		PrimaryKey: []*schema.Column{PolicyAcceptancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "policy_acceptances_accounts_policy_acceptances",
+				Columns:    []*schema.Column{PolicyAcceptancesColumns[2]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "policy_acceptances_policy_versions_acceptances",
+				Columns:    []*schema.Column{PolicyAcceptancesColumns[3]},
+				RefColumns: []*schema.Column{PolicyVersionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyacceptance_account_id_version_id",
+				Unique:  true,
+				Columns: []*schema.Column{PolicyAcceptancesColumns[2], PolicyAcceptancesColumns[3]},
+			},
+		},
+	}
+	// PolicyDocumentsColumns holds the columns for the "policy_documents" table.
+	PolicyDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "required", Type: field.TypeBool, Default: true},
+	}
+	// PolicyDocumentsTable holds the schema information for the "policy_documents" table.
+	PolicyDocumentsTable = &schema.Table{
+		Name:       "policy_documents",
+		Columns:    PolicyDocumentsColumns,
+		PrimaryKey: []*schema.Column{PolicyDocumentsColumns[0]},
+	}
+	// PolicyVersionsColumns holds the columns for the "policy_versions" table.
+	PolicyVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString},
+		{Name: "summary", Type: field.TypeString, Nullable: true},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "document_id", Type: field.TypeString, Size: 20},
+	}
+	// PolicyVersionsTable holds the schema information for the "policy_versions" table.
+	PolicyVersionsTable = &schema.Table{
+		Name:       "policy_versions",
+		Columns:    PolicyVersionsColumns,
+		PrimaryKey: []*schema.Column{PolicyVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "policy_versions_policy_documents_versions",
+				Columns:    []*schema.Column{PolicyVersionsColumns[6]},
+				RefColumns: []*schema.Column{PolicyDocumentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyversion_document_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{PolicyVersionsColumns[6], PolicyVersionsColumns[2]},
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -670,15 +1848,18 @@ var (
 		{Name: "slug", Type: field.TypeString, Nullable: true},
 		{Name: "pinned", Type: field.TypeBool, Default: false},
 		{Name: "last_reply_at", Type: field.TypeTime},
+		{Name: "accepted_reply_id", Type: field.TypeString, Nullable: true},
 		{Name: "body", Type: field.TypeString},
 		{Name: "short", Type: field.TypeString},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"draft", "unlisted", "review", "published"}, Default: "draft"},
+		{Name: "spam_score", Type: field.TypeFloat64, Nullable: true},
 		{Name: "account_posts", Type: field.TypeString, Size: 20},
 		{Name: "category_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "link_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "root_post_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "reply_to_post_id", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "space_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
 	// PostsTable holds the schema information for the "posts" table.
 	PostsTable = &schema.Table{
@@ -688,50 +1869,56 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_accounts_posts",
-				Columns:    []*schema.Column{PostsColumns[13]},
+				Columns:    []*schema.Column{PostsColumns[15]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "posts_categories_posts",
-				Columns:    []*schema.Column{PostsColumns[14]},
+				Columns:    []*schema.Column{PostsColumns[16]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "posts_links_posts",
-				Columns:    []*schema.Column{PostsColumns[15]},
+				Columns:    []*schema.Column{PostsColumns[17]},
 				RefColumns: []*schema.Column{LinksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "posts_posts_posts",
-				Columns:    []*schema.Column{PostsColumns[16]},
+				Columns:    []*schema.Column{PostsColumns[18]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "posts_posts_replies",
-				Columns:    []*schema.Column{PostsColumns[17]},
+				Columns:    []*schema.Column{PostsColumns[19]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "posts_spaces_posts",
+				Columns:    []*schema.Column{PostsColumns[20]},
+				RefColumns: []*schema.Column{SpacesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "post_root_post_id_deleted_at_visibility_last_reply_at",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[16], PostsColumns[3], PostsColumns[12], PostsColumns[8]},
+				Columns: []*schema.Column{PostsColumns[18], PostsColumns[3], PostsColumns[13], PostsColumns[8]},
 			},
 			{
 				Name:    "post_root_post_id_deleted_at_visibility_category_id_last_reply_at",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[16], PostsColumns[3], PostsColumns[12], PostsColumns[14], PostsColumns[8]},
+				Columns: []*schema.Column{PostsColumns[18], PostsColumns[3], PostsColumns[13], PostsColumns[16], PostsColumns[8]},
 			},
 			{
 				Name:    "post_root_post_id_deleted_at_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[16], PostsColumns[3], PostsColumns[1]},
+				Columns: []*schema.Column{PostsColumns[18], PostsColumns[3], PostsColumns[1]},
 			},
 		},
 	}
@@ -858,6 +2045,31 @@ var (
 			},
 		},
 	}
+	// PushSubscriptionsColumns holds the columns for the "push_subscriptions" table.
+	PushSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "endpoint", Type: field.TypeString, Unique: true, Size: 2147483647},
+		{Name: "p256dh", Type: field.TypeString},
+		{Name: "auth", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// PushSubscriptionsTable holds the schema information for the "push_subscriptions" table.
+	PushSubscriptionsTable = &schema.Table{
+		Name:       "push_subscriptions",
+		Columns:    PushSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{PushSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "push_subscriptions_accounts_push_subscriptions",
+				Columns:    []*schema.Column{PushSubscriptionsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// QuestionsColumns holds the columns for the "questions" table.
 	QuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -925,6 +2137,42 @@ var (
 			},
 		},
 	}
+	// ReadingHistoriesColumns holds the columns for the "reading_histories" table.
+	ReadingHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "item_kind", Type: field.TypeString},
+		{Name: "position", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// ReadingHistoriesTable holds the schema information for the "reading_histories" table.
+	ReadingHistoriesTable = &schema.Table{
+		Name:       "reading_histories",
+		Columns:    ReadingHistoriesColumns,
+		PrimaryKey: []*schema.Column{ReadingHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reading_histories_accounts_reading_history",
+				Columns:    []*schema.Column{ReadingHistoriesColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "readinghistory_account_id_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReadingHistoriesColumns[6], ReadingHistoriesColumns[3]},
+			},
+			{
+				Name:    "readinghistory_account_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReadingHistoriesColumns[6], ReadingHistoriesColumns[2]},
+			},
+		},
+	}
 	// ReportsColumns holds the columns for the "reports" table.
 	ReportsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -958,6 +2206,43 @@ var (
 			},
 		},
 	}
+	// ReputationEntriesColumns holds the columns for the "reputation_entries" table.
+	ReputationEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "points", Type: field.TypeInt},
+		{Name: "item_kind", Type: field.TypeString, Nullable: true},
+		{Name: "item_id", Type: field.TypeString, Nullable: true},
+		{Name: "source_id", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// ReputationEntriesTable holds the schema information for the "reputation_entries" table.
+	ReputationEntriesTable = &schema.Table{
+		Name:       "reputation_entries",
+		Columns:    ReputationEntriesColumns,
+		PrimaryKey: []*schema.Column{ReputationEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reputation_entries_accounts_reputation",
+				Columns:    []*schema.Column{ReputationEntriesColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reputationentry_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReputationEntriesColumns[7], ReputationEntriesColumns[1]},
+			},
+			{
+				Name:    "unique_reputation_award",
+				Unique:  true,
+				Columns: []*schema.Column{ReputationEntriesColumns[7], ReputationEntriesColumns[2], ReputationEntriesColumns[5], ReputationEntriesColumns[6]},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -967,6 +2252,7 @@ var (
 		{Name: "colour", Type: field.TypeString, Default: "hsl(157, 65%, 44%)"},
 		{Name: "permissions", Type: field.TypeJSON},
 		{Name: "sort_key", Type: field.TypeFloat64, Default: "0.0"},
+		{Name: "upload_quota", Type: field.TypeInt64, Nullable: true},
 	}
 	// RolesTable holds the schema information for the "roles" table.
 	RolesTable = &schema.Table{
@@ -974,6 +2260,26 @@ var (
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
 	}
+	// ScheduledTasksColumns holds the columns for the "scheduled_tasks" table.
+	ScheduledTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "schedule", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "last_started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"running", "succeeded", "failed"}},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+	}
+	// ScheduledTasksTable holds the schema information for the "scheduled_tasks" table.
+	ScheduledTasksTable = &schema.Table{
+		Name:       "scheduled_tasks",
+		Columns:    ScheduledTasksColumns,
+		PrimaryKey: []*schema.Column{ScheduledTasksColumns[0]},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -1008,17 +2314,411 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// SpacesColumns holds the columns for the "spaces" table.
+	SpacesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "join_policy", Type: field.TypeString},
+	}
+	// SpacesTable holds the schema information for the "spaces" table.
+	SpacesTable = &schema.Table{
+		Name:       "spaces",
+		Columns:    SpacesColumns,
+		PrimaryKey: []*schema.Column{SpacesColumns[0]},
+	}
+	// SpaceMembersColumns holds the columns for the "space_members" table.
+	SpaceMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "role", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "space_id", Type: field.TypeString, Size: 20},
+	}
+	// SpaceMembersTable holds the schema information for the "space_members" table.
+	SpaceMembersTable = &schema.Table{
+		Name:       "space_members",
+		Columns:    SpaceMembersColumns,
+		PrimaryKey: []*schema.Column{SpaceMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "space_members_accounts_spaces",
+				Columns:    []*schema.Column{SpaceMembersColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "space_members_spaces_members",
+				Columns:    []*schema.Column{SpaceMembersColumns[5]},
+				RefColumns: []*schema.Column{SpacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_space_member",
+				Unique:  true,
+				Columns: []*schema.Column{SpaceMembersColumns[4], SpaceMembersColumns[5]},
+			},
+			{
+				Name:    "spacemember_space_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SpaceMembersColumns[5], SpaceMembersColumns[3]},
+			},
+		},
+	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "parent_tag_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
 	// TagsTable holds the schema information for the "tags" table.
 	TagsTable = &schema.Table{
 		Name:       "tags",
 		Columns:    TagsColumns,
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_tags_children",
+				Columns:    []*schema.Column{TagsColumns[4]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TagFollowsColumns holds the columns for the "tag_follows" table.
+	TagFollowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "tag_id", Type: field.TypeString, Size: 20},
+	}
+	// TagFollowsTable holds the schema information for the "tag_follows" table.
+	TagFollowsTable = &schema.Table{
+		Name:       "tag_follows",
+		Columns:    TagFollowsColumns,
+		PrimaryKey: []*schema.Column{TagFollowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_follows_accounts_tag_follows",
+				Columns:    []*schema.Column{TagFollowsColumns[2]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_follows_tags_followers",
+				Columns:    []*schema.Column{TagFollowsColumns[3]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_tag_following_pair",
+				Unique:  true,
+				Columns: []*schema.Column{TagFollowsColumns[2], TagFollowsColumns[3]},
+			},
+		},
+	}
+	// TagSynonymsColumns holds the columns for the "tag_synonyms" table.
+	TagSynonymsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "tag_id", Type: field.TypeString, Size: 20},
+	}
+	// TagSynonymsTable holds the schema information for the "tag_synonyms" table.
+	TagSynonymsTable = &schema.Table{
+		Name:       "tag_synonyms",
+		Columns:    TagSynonymsColumns,
+		PrimaryKey: []*schema.Column{TagSynonymsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_synonyms_tags_synonyms",
+				Columns:    []*schema.Column{TagSynonymsColumns[3]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TelegramMessagesColumns holds the columns for the "telegram_messages" table.
+	TelegramMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "message_id", Type: field.TypeInt64},
+		{Name: "thread_id", Type: field.TypeString},
+		{Name: "post_id", Type: field.TypeString, Size: 20},
+	}
+	// TelegramMessagesTable holds the schema information for the "telegram_messages" table.
+	TelegramMessagesTable = &schema.Table{
+		Name:       "telegram_messages",
+		Columns:    TelegramMessagesColumns,
+		PrimaryKey: []*schema.Column{TelegramMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "telegram_messages_posts_telegram_messages",
+				Columns:    []*schema.Column{TelegramMessagesColumns[5]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "telegrammessage_chat_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{TelegramMessagesColumns[2], TelegramMessagesColumns[3]},
+			},
+		},
+	}
+	// TenantsColumns holds the columns for the "tenants" table.
+	TenantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "hostname", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	// TenantsTable holds the schema information for the "tenants" table.
+	TenantsTable = &schema.Table{
+		Name:       "tenants",
+		Columns:    TenantsColumns,
+		PrimaryKey: []*schema.Column{TenantsColumns[0]},
+	}
+	// TrendingScoresColumns holds the columns for the "trending_scores" table.
+	TrendingScoresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "window", Type: field.TypeString},
+		{Name: "item_kind", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "score", Type: field.TypeFloat64},
+	}
+	// TrendingScoresTable holds the schema information for the "trending_scores" table.
+	TrendingScoresTable = &schema.Table{
+		Name:       "trending_scores",
+		Columns:    TrendingScoresColumns,
+		PrimaryKey: []*schema.Column{TrendingScoresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trendingscore_window_item_kind_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{TrendingScoresColumns[2], TrendingScoresColumns[3], TrendingScoresColumns[4]},
+			},
+			{
+				Name:    "trendingscore_window_score",
+				Unique:  false,
+				Columns: []*schema.Column{TrendingScoresColumns[2], TrendingScoresColumns[5]},
+			},
+		},
+	}
+	// UploadSessionsColumns holds the columns for the "upload_sessions" table.
+	UploadSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "filename", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "received", Type: field.TypeInt64, Default: 0},
+		{Name: "parent_asset_id", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "private", Type: field.TypeBool, Default: false},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// UploadSessionsTable holds the schema information for the "upload_sessions" table.
+	UploadSessionsTable = &schema.Table{
+		Name:       "upload_sessions",
+		Columns:    UploadSessionsColumns,
+		PrimaryKey: []*schema.Column{UploadSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "upload_sessions_accounts_upload_sessions",
+				Columns:    []*schema.Column{UploadSessionsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uploadsession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UploadSessionsColumns[7]},
+			},
+		},
+	}
+	// VerificationRequestsColumns holds the columns for the "verification_requests" table.
+	VerificationRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "badge", Type: field.TypeString},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeString},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "reviewed_by_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// VerificationRequestsTable holds the schema information for the "verification_requests" table.
+	VerificationRequestsTable = &schema.Table{
+		Name:       "verification_requests",
+		Columns:    VerificationRequestsColumns,
+		PrimaryKey: []*schema.Column{VerificationRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "verification_requests_accounts_verification_requests",
+				Columns:    []*schema.Column{VerificationRequestsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "verification_requests_accounts_reviewed_verification_requests",
+				Columns:    []*schema.Column{VerificationRequestsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "verificationrequest_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationRequestsColumns[5], VerificationRequestsColumns[1]},
+			},
+			{
+				Name:    "verificationrequest_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationRequestsColumns[8], VerificationRequestsColumns[1]},
+			},
+		},
+	}
+	// WarningsColumns holds the columns for the "warnings" table.
+	WarningsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "severity", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "issued_by_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// WarningsTable holds the schema information for the "warnings" table.
+	WarningsTable = &schema.Table{
+		Name:       "warnings",
+		Columns:    WarningsColumns,
+		PrimaryKey: []*schema.Column{WarningsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "warnings_accounts_warnings",
+				Columns:    []*schema.Column{WarningsColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "warnings_accounts_issued_warnings",
+				Columns:    []*schema.Column{WarningsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warning_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{WarningsColumns[6]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "account_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_accounts_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeString, Size: 20},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[9]},
+			},
+		},
+	}
+	// WordFiltersColumns holds the columns for the "word_filters" table.
+	WordFiltersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "term", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "replacement", Type: field.TypeString, Nullable: true},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// WordFiltersTable holds the schema information for the "word_filters" table.
+	WordFiltersTable = &schema.Table{
+		Name:       "word_filters",
+		Columns:    WordFiltersColumns,
+		PrimaryKey: []*schema.Column{WordFiltersColumns[0]},
 	}
 	// AccountTagsColumns holds the columns for the "account_tags" table.
 	AccountTagsColumns = []*schema.Column{
@@ -1045,6 +2745,56 @@ var (
 			},
 		},
 	}
+	// CategoryRequiredTagsColumns holds the columns for the "category_required_tags" table.
+	CategoryRequiredTagsColumns = []*schema.Column{
+		{Name: "category_id", Type: field.TypeString, Size: 20},
+		{Name: "tag_id", Type: field.TypeString, Size: 20},
+	}
+	// CategoryRequiredTagsTable holds the schema information for the "category_required_tags" table.
+	CategoryRequiredTagsTable = &schema.Table{
+		Name:       "category_required_tags",
+		Columns:    CategoryRequiredTagsColumns,
+		PrimaryKey: []*schema.Column{CategoryRequiredTagsColumns[0], CategoryRequiredTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_required_tags_category_id",
+				Columns:    []*schema.Column{CategoryRequiredTagsColumns[0]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "category_required_tags_tag_id",
+				Columns:    []*schema.Column{CategoryRequiredTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DirectMessageAssetsColumns holds the columns for the "direct_message_assets" table.
+	DirectMessageAssetsColumns = []*schema.Column{
+		{Name: "direct_message_id", Type: field.TypeString, Size: 20},
+		{Name: "asset_id", Type: field.TypeString, Size: 20},
+	}
+	// DirectMessageAssetsTable holds the schema information for the "direct_message_assets" table.
+	DirectMessageAssetsTable = &schema.Table{
+		Name:       "direct_message_assets",
+		Columns:    DirectMessageAssetsColumns,
+		PrimaryKey: []*schema.Column{DirectMessageAssetsColumns[0], DirectMessageAssetsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "direct_message_assets_direct_message_id",
+				Columns:    []*schema.Column{DirectMessageAssetsColumns[0]},
+				RefColumns: []*schema.Column{DirectMessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "direct_message_assets_asset_id",
+				Columns:    []*schema.Column{DirectMessageAssetsColumns[1]},
+				RefColumns: []*schema.Column{AssetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LinkPostContentReferencesColumns holds the columns for the "link_post_content_references" table.
 	LinkPostContentReferencesColumns = []*schema.Column{
 		{Name: "link_id", Type: field.TypeString, Size: 20},
@@ -1222,37 +2972,94 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIUsagesTable,
 		AccountsTable,
+		AccountApplicationsTable,
+		AccountBadgesTable,
+		AccountBlocksTable,
 		AccountFollowsTable,
+		AccountOnboardingStepsTable,
 		AccountRolesTable,
+		AnalyticsCohortsTable,
+		AnalyticsContentsTable,
+		AnalyticsDaysTable,
+		AnalyticsExportsTable,
 		AssetsTable,
 		AuthenticationsTable,
+		AutomodRulesTable,
+		BadgesTable,
+		BookmarksTable,
 		CategoriesTable,
+		CategoryFollowsTable,
+		CategoryPermissionsTable,
 		CollectionsTable,
 		CollectionNodesTable,
 		CollectionPostsTable,
+		CollectionSectionsTable,
+		CollectionSharesTable,
+		ContentSummariesTable,
+		ContentViewsTable,
+		ConversationsTable,
+		ConversationParticipantsTable,
+		DigestSubscriptionsTable,
+		DirectMessagesTable,
+		DiscordBridgeLinksTable,
 		EmailsTable,
 		EventsTable,
 		EventParticipantsTable,
+		FeatureFlagsTable,
+		FederationFollowersTable,
+		FederationKeysTable,
+		FeedTokensTable,
+		ImportRecordsTable,
+		ImportRunsTable,
 		InvitationsTable,
+		JobsTable,
 		LikePostsTable,
 		LinksTable,
+		LinkChecksTable,
+		MatrixBridgeLinksTable,
 		MentionProfilesTable,
+		ModerationNotesTable,
+		NetworkBansTable,
 		NodesTable,
 		NotificationsTable,
+		NotificationPreferencesTable,
+		PolicyAcceptancesTable,
+		PolicyDocumentsTable,
+		PolicyVersionsTable,
 		PostsTable,
 		PostReadsTable,
 		PropertiesTable,
 		PropertySchemasTable,
 		PropertySchemaFieldsTable,
+		PushSubscriptionsTable,
 		QuestionsTable,
 		ReactsTable,
+		ReadingHistoriesTable,
 		ReportsTable,
+		ReputationEntriesTable,
 		RolesTable,
+		ScheduledTasksTable,
 		SessionsTable,
 		SettingsTable,
+		SpacesTable,
+		SpaceMembersTable,
 		TagsTable,
+		TagFollowsTable,
+		TagSynonymsTable,
+		TelegramMessagesTable,
+		TenantsTable,
+		TrendingScoresTable,
+		UploadSessionsTable,
+		VerificationRequestsTable,
+		WarningsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		WordFiltersTable,
 		AccountTagsTable,
+		CategoryRequiredTagsTable,
+		DirectMessageAssetsTable,
 		LinkPostContentReferencesTable,
 		LinkNodeContentReferencesTable,
 		LinkAssetsTable,
@@ -1264,34 +3071,66 @@ var (
 )
 
 func init() {
-	AccountsTable.ForeignKeys[0].RefTable = InvitationsTable
+	APIUsagesTable.ForeignKeys[0].RefTable = AccountsTable
+	APIUsagesTable.ForeignKeys[1].RefTable = AuthenticationsTable
+	AccountsTable.ForeignKeys[0].RefTable = AccountsTable
+	AccountsTable.ForeignKeys[1].RefTable = InvitationsTable
+	AccountApplicationsTable.ForeignKeys[0].RefTable = AccountsTable
+	AccountApplicationsTable.ForeignKeys[1].RefTable = AccountsTable
+	AccountBadgesTable.ForeignKeys[0].RefTable = AccountsTable
+	AccountBadgesTable.ForeignKeys[1].RefTable = BadgesTable
+	AccountBlocksTable.ForeignKeys[0].RefTable = AccountsTable
+	AccountBlocksTable.ForeignKeys[1].RefTable = AccountsTable
 	AccountFollowsTable.ForeignKeys[0].RefTable = AccountsTable
 	AccountFollowsTable.ForeignKeys[1].RefTable = AccountsTable
+	AccountOnboardingStepsTable.ForeignKeys[0].RefTable = AccountsTable
 	AccountRolesTable.ForeignKeys[0].RefTable = AccountsTable
 	AccountRolesTable.ForeignKeys[1].RefTable = RolesTable
 	AssetsTable.ForeignKeys[0].RefTable = AccountsTable
 	AssetsTable.ForeignKeys[1].RefTable = AssetsTable
 	AuthenticationsTable.ForeignKeys[0].RefTable = AccountsTable
+	BookmarksTable.ForeignKeys[0].RefTable = AccountsTable
 	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
 	CategoriesTable.ForeignKeys[1].RefTable = AssetsTable
+	CategoryFollowsTable.ForeignKeys[0].RefTable = AccountsTable
+	CategoryFollowsTable.ForeignKeys[1].RefTable = CategoriesTable
+	CategoryPermissionsTable.ForeignKeys[0].RefTable = CategoriesTable
 	CollectionsTable.ForeignKeys[0].RefTable = AccountsTable
 	CollectionsTable.ForeignKeys[1].RefTable = AssetsTable
 	CollectionNodesTable.ForeignKeys[0].RefTable = CollectionsTable
 	CollectionNodesTable.ForeignKeys[1].RefTable = NodesTable
+	CollectionNodesTable.ForeignKeys[2].RefTable = CollectionSectionsTable
 	CollectionPostsTable.ForeignKeys[0].RefTable = CollectionsTable
 	CollectionPostsTable.ForeignKeys[1].RefTable = PostsTable
+	CollectionPostsTable.ForeignKeys[2].RefTable = CollectionSectionsTable
+	CollectionSectionsTable.ForeignKeys[0].RefTable = CollectionsTable
+	CollectionSharesTable.ForeignKeys[0].RefTable = CollectionsTable
+	ContentViewsTable.ForeignKeys[0].RefTable = AccountsTable
+	ConversationParticipantsTable.ForeignKeys[0].RefTable = AccountsTable
+	ConversationParticipantsTable.ForeignKeys[1].RefTable = ConversationsTable
+	DigestSubscriptionsTable.ForeignKeys[0].RefTable = AccountsTable
+	DirectMessagesTable.ForeignKeys[0].RefTable = AccountsTable
+	DirectMessagesTable.ForeignKeys[1].RefTable = ConversationsTable
+	DiscordBridgeLinksTable.ForeignKeys[0].RefTable = PostsTable
 	EmailsTable.ForeignKeys[0].RefTable = AccountsTable
 	EventsTable.ForeignKeys[0].RefTable = AssetsTable
 	EventsTable.ForeignKeys[1].RefTable = PostsTable
 	EventParticipantsTable.ForeignKeys[0].RefTable = AccountsTable
 	EventParticipantsTable.ForeignKeys[1].RefTable = EventsTable
+	FeedTokensTable.ForeignKeys[0].RefTable = AccountsTable
+	ImportRecordsTable.ForeignKeys[0].RefTable = ImportRunsTable
 	InvitationsTable.ForeignKeys[0].RefTable = AccountsTable
 	LikePostsTable.ForeignKeys[0].RefTable = AccountsTable
 	LikePostsTable.ForeignKeys[1].RefTable = PostsTable
 	LinksTable.ForeignKeys[0].RefTable = AssetsTable
 	LinksTable.ForeignKeys[1].RefTable = AssetsTable
+	LinksTable.ForeignKeys[2].RefTable = AssetsTable
+	LinkChecksTable.ForeignKeys[0].RefTable = LinksTable
+	MatrixBridgeLinksTable.ForeignKeys[0].RefTable = PostsTable
 	MentionProfilesTable.ForeignKeys[0].RefTable = AccountsTable
 	MentionProfilesTable.ForeignKeys[1].RefTable = PostsTable
+	ModerationNotesTable.ForeignKeys[0].RefTable = AccountsTable
+	ModerationNotesTable.ForeignKeys[1].RefTable = AccountsTable
 	NodesTable.ForeignKeys[0].RefTable = AccountsTable
 	NodesTable.ForeignKeys[1].RefTable = LinksTable
 	NodesTable.ForeignKeys[2].RefTable = NodesTable
@@ -1299,25 +3138,51 @@ func init() {
 	NodesTable.ForeignKeys[4].RefTable = PropertySchemasTable
 	NotificationsTable.ForeignKeys[0].RefTable = AccountsTable
 	NotificationsTable.ForeignKeys[1].RefTable = AccountsTable
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = AccountsTable
+	PolicyAcceptancesTable.ForeignKeys[0].RefTable = AccountsTable
+	PolicyAcceptancesTable.ForeignKeys[1].RefTable = PolicyVersionsTable
+	PolicyVersionsTable.ForeignKeys[0].RefTable = PolicyDocumentsTable
 	PostsTable.ForeignKeys[0].RefTable = AccountsTable
 	PostsTable.ForeignKeys[1].RefTable = CategoriesTable
 	PostsTable.ForeignKeys[2].RefTable = LinksTable
 	PostsTable.ForeignKeys[3].RefTable = PostsTable
 	PostsTable.ForeignKeys[4].RefTable = PostsTable
+	PostsTable.ForeignKeys[5].RefTable = SpacesTable
 	PostReadsTable.ForeignKeys[0].RefTable = AccountsTable
 	PostReadsTable.ForeignKeys[1].RefTable = PostsTable
 	PropertiesTable.ForeignKeys[0].RefTable = NodesTable
 	PropertiesTable.ForeignKeys[1].RefTable = PropertySchemaFieldsTable
 	PropertySchemaFieldsTable.ForeignKeys[0].RefTable = PropertySchemasTable
+	PushSubscriptionsTable.ForeignKeys[0].RefTable = AccountsTable
 	QuestionsTable.ForeignKeys[0].RefTable = AccountsTable
 	QuestionsTable.ForeignKeys[1].RefTable = QuestionsTable
 	ReactsTable.ForeignKeys[0].RefTable = AccountsTable
 	ReactsTable.ForeignKeys[1].RefTable = PostsTable
+	ReadingHistoriesTable.ForeignKeys[0].RefTable = AccountsTable
 	ReportsTable.ForeignKeys[0].RefTable = AccountsTable
 	ReportsTable.ForeignKeys[1].RefTable = AccountsTable
+	ReputationEntriesTable.ForeignKeys[0].RefTable = AccountsTable
 	SessionsTable.ForeignKeys[0].RefTable = AccountsTable
+	SpaceMembersTable.ForeignKeys[0].RefTable = AccountsTable
+	SpaceMembersTable.ForeignKeys[1].RefTable = SpacesTable
+	TagsTable.ForeignKeys[0].RefTable = TagsTable
+	TagFollowsTable.ForeignKeys[0].RefTable = AccountsTable
+	TagFollowsTable.ForeignKeys[1].RefTable = TagsTable
+	TagSynonymsTable.ForeignKeys[0].RefTable = TagsTable
+	TelegramMessagesTable.ForeignKeys[0].RefTable = PostsTable
+	UploadSessionsTable.ForeignKeys[0].RefTable = AccountsTable
+	VerificationRequestsTable.ForeignKeys[0].RefTable = AccountsTable
+	VerificationRequestsTable.ForeignKeys[1].RefTable = AccountsTable
+	WarningsTable.ForeignKeys[0].RefTable = AccountsTable
+	WarningsTable.ForeignKeys[1].RefTable = AccountsTable
+	WebhooksTable.ForeignKeys[0].RefTable = AccountsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 	AccountTagsTable.ForeignKeys[0].RefTable = AccountsTable
 	AccountTagsTable.ForeignKeys[1].RefTable = TagsTable
+	CategoryRequiredTagsTable.ForeignKeys[0].RefTable = CategoriesTable
+	CategoryRequiredTagsTable.ForeignKeys[1].RefTable = TagsTable
+	DirectMessageAssetsTable.ForeignKeys[0].RefTable = DirectMessagesTable
+	DirectMessageAssetsTable.ForeignKeys[1].RefTable = AssetsTable
 	LinkPostContentReferencesTable.ForeignKeys[0].RefTable = LinksTable
 	LinkPostContentReferencesTable.ForeignKeys[1].RefTable = PostsTable
 	LinkNodeContentReferencesTable.ForeignKeys[0].RefTable = LinksTable
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
+	"github.com/rs/xid"
+)
+
+// ModerationNote is the model entity for the ModerationNote schema.
+type ModerationNote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The member whose case file this entry belongs to, for notes on content this is the author of the content.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID *xid.ID `json:"author_id,omitempty"`
+	// The ID of the member or content the entry is about. This is not a foreign key as entries can refer to a variety of sources, discriminated by the 'target_kind' field.
+	TargetID xid.ID `json:"target_id,omitempty"`
+	// The datagraph kind of the resource the entry is about.
+	TargetKind string `json:"target_kind,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Content holds the value of the "content" field.
+	Content *string `json:"content,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModerationNoteQuery when eager-loading is set.
+	Edges        ModerationNoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ModerationNoteEdges holds the relations/edges for other nodes in the graph.
+type ModerationNoteEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Author holds the value of the author edge.
+	Author *Account `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationNoteEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationNoteEdges) AuthorOrErr() (*Account, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationNote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationnote.FieldAuthorID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case moderationnote.FieldTargetKind, moderationnote.FieldKind, moderationnote.FieldContent:
+			values[i] = new(sql.NullString)
+		case moderationnote.FieldCreatedAt, moderationnote.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case moderationnote.FieldID, moderationnote.FieldAccountID, moderationnote.FieldTargetID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationNote fields.
+func (_m *ModerationNote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationnote.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case moderationnote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case moderationnote.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case moderationnote.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case moderationnote.FieldAuthorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value.Valid {
+				_m.AuthorID = new(xid.ID)
+				*_m.AuthorID = *value.S.(*xid.ID)
+			}
+		case moderationnote.FieldTargetID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value != nil {
+				_m.TargetID = *value
+			}
+		case moderationnote.FieldTargetKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_kind", values[i])
+			} else if value.Valid {
+				_m.TargetKind = value.String
+			}
+		case moderationnote.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case moderationnote.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = new(string)
+				*_m.Content = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationNote.
+// This includes values selected through modifiers, order, etc.
+func (_m *ModerationNote) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the ModerationNote entity.
+func (_m *ModerationNote) QueryAccount() *AccountQuery {
+	return NewModerationNoteClient(_m.config).QueryAccount(_m)
+}
+
+// QueryAuthor queries the "author" edge of the ModerationNote entity.
+func (_m *ModerationNote) QueryAuthor() *AccountQuery {
+	return NewModerationNoteClient(_m.config).QueryAuthor(_m)
+}
+
+// Update returns a builder for updating this ModerationNote.
+// Note that you need to call ModerationNote.Unwrap() before calling this method if this ModerationNote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ModerationNote) Update() *ModerationNoteUpdateOne {
+	return NewModerationNoteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ModerationNote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ModerationNote) Unwrap() *ModerationNote {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModerationNote is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ModerationNote) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationNote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.AuthorID; v != nil {
+		builder.WriteString("author_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("target_kind=")
+	builder.WriteString(_m.TargetKind)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	if v := _m.Content; v != nil {
+		builder.WriteString("content=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationNotes is a parsable slice of ModerationNote.
+type ModerationNotes []*ModerationNote
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
+	"github.com/rs/xid"
+)
+
+// ModerationNoteCreate is the builder for creating a ModerationNote entity.
+type ModerationNoteCreate struct {
+	config
+	mutation *ModerationNoteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModerationNoteCreate) SetCreatedAt(v time.Time) *ModerationNoteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModerationNoteCreate) SetNillableCreatedAt(v *time.Time) *ModerationNoteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ModerationNoteCreate) SetUpdatedAt(v time.Time) *ModerationNoteCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ModerationNoteCreate) SetNillableUpdatedAt(v *time.Time) *ModerationNoteCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ModerationNoteCreate) SetAccountID(v xid.ID) *ModerationNoteCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *ModerationNoteCreate) SetAuthorID(v xid.ID) *ModerationNoteCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_c *ModerationNoteCreate) SetNillableAuthorID(v *xid.ID) *ModerationNoteCreate {
+	if v != nil {
+		_c.SetAuthorID(*v)
+	}
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *ModerationNoteCreate) SetTargetID(v xid.ID) *ModerationNoteCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (_c *ModerationNoteCreate) SetTargetKind(v string) *ModerationNoteCreate {
+	_c.mutation.SetTargetKind(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ModerationNoteCreate) SetKind(v string) *ModerationNoteCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *ModerationNoteCreate) SetContent(v string) *ModerationNoteCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *ModerationNoteCreate) SetNillableContent(v *string) *ModerationNoteCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ModerationNoteCreate) SetID(v xid.ID) *ModerationNoteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ModerationNoteCreate) SetNillableID(v *xid.ID) *ModerationNoteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ModerationNoteCreate) SetAccount(v *Account) *ModerationNoteCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_c *ModerationNoteCreate) SetAuthor(v *Account) *ModerationNoteCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// Mutation returns the ModerationNoteMutation object of the builder.
+func (_c *ModerationNoteCreate) Mutation() *ModerationNoteMutation {
+	return _c.mutation
+}
+
+// Save creates the ModerationNote in the database.
+func (_c *ModerationNoteCreate) Save(ctx context.Context) (*ModerationNote, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModerationNoteCreate) SaveX(ctx context.Context) *ModerationNote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationNoteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationNoteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModerationNoteCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := moderationnote.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := moderationnote.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := moderationnote.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModerationNoteCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModerationNote.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ModerationNote.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ModerationNote.account_id"`)}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "ModerationNote.target_id"`)}
+	}
+	if _, ok := _c.mutation.TargetKind(); !ok {
+		return &ValidationError{Name: "target_kind", err: errors.New(`ent: missing required field "ModerationNote.target_kind"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ModerationNote.kind"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := moderationnote.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ModerationNote.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "ModerationNote.account"`)}
+	}
+	return nil
+}
+
+func (_c *ModerationNoteCreate) sqlSave(ctx context.Context) (*ModerationNote, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModerationNoteCreate) createSpec() (*ModerationNote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationNote{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderationnote.Table, sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationnote.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationnote.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(moderationnote.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.TargetKind(); ok {
+		_spec.SetField(moderationnote.FieldTargetKind, field.TypeString, value)
+		_node.TargetKind = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(moderationnote.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(moderationnote.FieldContent, field.TypeString, value)
+		_node.Content = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AccountTable,
+			Columns: []string{moderationnote.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AuthorTable,
+			Columns: []string{moderationnote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationNote.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationNoteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationNoteCreate) OnConflict(opts ...sql.ConflictOption) *ModerationNoteUpsertOne {
+	_c.conflict = opts
+	return &ModerationNoteUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationNote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationNoteCreate) OnConflictColumns(columns ...string) *ModerationNoteUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationNoteUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ModerationNoteUpsertOne is the builder for "upsert"-ing
+	//  one ModerationNote node.
+	ModerationNoteUpsertOne struct {
+		create *ModerationNoteCreate
+	}
+
+	// ModerationNoteUpsert is the "OnConflict" setter.
+	ModerationNoteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationNoteUpsert) SetUpdatedAt(v time.Time) *ModerationNoteUpsert {
+	u.Set(moderationnote.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationNoteUpsert) UpdateUpdatedAt() *ModerationNoteUpsert {
+	u.SetExcluded(moderationnote.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ModerationNoteUpsert) SetAccountID(v xid.ID) *ModerationNoteUpsert {
+	u.Set(moderationnote.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsert) UpdateAccountID() *ModerationNoteUpsert {
+	u.SetExcluded(moderationnote.FieldAccountID)
+	return u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *ModerationNoteUpsert) SetAuthorID(v xid.ID) *ModerationNoteUpsert {
+	u.Set(moderationnote.FieldAuthorID, v)
+	return u
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsert) UpdateAuthorID() *ModerationNoteUpsert {
+	u.SetExcluded(moderationnote.FieldAuthorID)
+	return u
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (u *ModerationNoteUpsert) ClearAuthorID() *ModerationNoteUpsert {
+	u.SetNull(moderationnote.FieldAuthorID)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ModerationNoteUpsert) SetTargetID(v xid.ID) *ModerationNoteUpsert {
+	u.Set(moderationnote.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsert) UpdateTargetID() *ModerationNoteUpsert {
+	u.SetExcluded(moderationnote.FieldTargetID)
+	return u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *ModerationNoteUpsert) SetTargetKind(v string) *ModerationNoteUpsert {
+	u.Set(moderationnote.FieldTargetKind, v)
+	return u
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *ModerationNoteUpsert) UpdateTargetKind() *ModerationNoteUpsert {
+	u.SetExcluded(moderationnote.FieldTargetKind)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ModerationNoteUpsert) SetKind(v string) *ModerationNoteUpsert {
+	u.Set(moderationnote.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ModerationNoteUpsert) UpdateKind() *ModerationNoteUpsert {
+	u.SetExcluded(moderationnote.FieldKind)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ModerationNoteUpsert) SetContent(v string) *ModerationNoteUpsert {
+	u.Set(moderationnote.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ModerationNoteUpsert) UpdateContent() *ModerationNoteUpsert {
+	u.SetExcluded(moderationnote.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ModerationNoteUpsert) ClearContent() *ModerationNoteUpsert {
+	u.SetNull(moderationnote.FieldContent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ModerationNote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationnote.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationNoteUpsertOne) UpdateNewValues() *ModerationNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(moderationnote.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationnote.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationNote.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationNoteUpsertOne) Ignore() *ModerationNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationNoteUpsertOne) DoNothing() *ModerationNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationNoteCreate.OnConflict
+// documentation for more info.
+func (u *ModerationNoteUpsertOne) Update(set func(*ModerationNoteUpsert)) *ModerationNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationNoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationNoteUpsertOne) SetUpdatedAt(v time.Time) *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationNoteUpsertOne) UpdateUpdatedAt() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ModerationNoteUpsertOne) SetAccountID(v xid.ID) *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsertOne) UpdateAccountID() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *ModerationNoteUpsertOne) SetAuthorID(v xid.ID) *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsertOne) UpdateAuthorID() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (u *ModerationNoteUpsertOne) ClearAuthorID() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.ClearAuthorID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ModerationNoteUpsertOne) SetTargetID(v xid.ID) *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsertOne) UpdateTargetID() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *ModerationNoteUpsertOne) SetTargetKind(v string) *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *ModerationNoteUpsertOne) UpdateTargetKind() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ModerationNoteUpsertOne) SetKind(v string) *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ModerationNoteUpsertOne) UpdateKind() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ModerationNoteUpsertOne) SetContent(v string) *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ModerationNoteUpsertOne) UpdateContent() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ModerationNoteUpsertOne) ClearContent() *ModerationNoteUpsertOne {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.ClearContent()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationNoteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationNoteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationNoteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationNoteUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ModerationNoteUpsertOne.ID is not supported by MySQL driver. Use ModerationNoteUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationNoteUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationNoteCreateBulk is the builder for creating many ModerationNote entities in bulk.
+type ModerationNoteCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationNoteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationNote entities in the database.
+func (_c *ModerationNoteCreateBulk) Save(ctx context.Context) ([]*ModerationNote, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModerationNote, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationNoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModerationNoteCreateBulk) SaveX(ctx context.Context) []*ModerationNote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationNoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationNoteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationNote.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationNoteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationNoteCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationNoteUpsertBulk {
+	_c.conflict = opts
+	return &ModerationNoteUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationNote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationNoteCreateBulk) OnConflictColumns(columns ...string) *ModerationNoteUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationNoteUpsertBulk{
+		create: _c,
+	}
+}
+
+// ModerationNoteUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationNote nodes.
+type ModerationNoteUpsertBulk struct {
+	create *ModerationNoteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationNote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationnote.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationNoteUpsertBulk) UpdateNewValues() *ModerationNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(moderationnote.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationnote.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationNote.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationNoteUpsertBulk) Ignore() *ModerationNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationNoteUpsertBulk) DoNothing() *ModerationNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationNoteCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationNoteUpsertBulk) Update(set func(*ModerationNoteUpsert)) *ModerationNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationNoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationNoteUpsertBulk) SetUpdatedAt(v time.Time) *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationNoteUpsertBulk) UpdateUpdatedAt() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ModerationNoteUpsertBulk) SetAccountID(v xid.ID) *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsertBulk) UpdateAccountID() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *ModerationNoteUpsertBulk) SetAuthorID(v xid.ID) *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsertBulk) UpdateAuthorID() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (u *ModerationNoteUpsertBulk) ClearAuthorID() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.ClearAuthorID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ModerationNoteUpsertBulk) SetTargetID(v xid.ID) *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ModerationNoteUpsertBulk) UpdateTargetID() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *ModerationNoteUpsertBulk) SetTargetKind(v string) *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *ModerationNoteUpsertBulk) UpdateTargetKind() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ModerationNoteUpsertBulk) SetKind(v string) *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ModerationNoteUpsertBulk) UpdateKind() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ModerationNoteUpsertBulk) SetContent(v string) *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ModerationNoteUpsertBulk) UpdateContent() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ModerationNoteUpsertBulk) ClearContent() *ModerationNoteUpsertBulk {
+	return u.Update(func(s *ModerationNoteUpsert) {
+		s.ClearContent()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationNoteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationNoteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationNoteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationNoteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
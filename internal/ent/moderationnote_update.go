@@ -0,0 +1,634 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ModerationNoteUpdate is the builder for updating ModerationNote entities.
+type ModerationNoteUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ModerationNoteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ModerationNoteUpdate builder.
+func (_u *ModerationNoteUpdate) Where(ps ...predicate.ModerationNote) *ModerationNoteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationNoteUpdate) SetUpdatedAt(v time.Time) *ModerationNoteUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ModerationNoteUpdate) SetAccountID(v xid.ID) *ModerationNoteUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ModerationNoteUpdate) SetNillableAccountID(v *xid.ID) *ModerationNoteUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *ModerationNoteUpdate) SetAuthorID(v xid.ID) *ModerationNoteUpdate {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *ModerationNoteUpdate) SetNillableAuthorID(v *xid.ID) *ModerationNoteUpdate {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (_u *ModerationNoteUpdate) ClearAuthorID() *ModerationNoteUpdate {
+	_u.mutation.ClearAuthorID()
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ModerationNoteUpdate) SetTargetID(v xid.ID) *ModerationNoteUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ModerationNoteUpdate) SetNillableTargetID(v *xid.ID) *ModerationNoteUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (_u *ModerationNoteUpdate) SetTargetKind(v string) *ModerationNoteUpdate {
+	_u.mutation.SetTargetKind(v)
+	return _u
+}
+
+// SetNillableTargetKind sets the "target_kind" field if the given value is not nil.
+func (_u *ModerationNoteUpdate) SetNillableTargetKind(v *string) *ModerationNoteUpdate {
+	if v != nil {
+		_u.SetTargetKind(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ModerationNoteUpdate) SetKind(v string) *ModerationNoteUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ModerationNoteUpdate) SetNillableKind(v *string) *ModerationNoteUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ModerationNoteUpdate) SetContent(v string) *ModerationNoteUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ModerationNoteUpdate) SetNillableContent(v *string) *ModerationNoteUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *ModerationNoteUpdate) ClearContent() *ModerationNoteUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ModerationNoteUpdate) SetAccount(v *Account) *ModerationNoteUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_u *ModerationNoteUpdate) SetAuthor(v *Account) *ModerationNoteUpdate {
+	return _u.SetAuthorID(v.ID)
+}
+
+// Mutation returns the ModerationNoteMutation object of the builder.
+func (_u *ModerationNoteUpdate) Mutation() *ModerationNoteMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ModerationNoteUpdate) ClearAccount() *ModerationNoteUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearAuthor clears the "author" edge to the Account entity.
+func (_u *ModerationNoteUpdate) ClearAuthor() *ModerationNoteUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModerationNoteUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationNoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModerationNoteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationNoteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModerationNoteUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := moderationnote.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationNoteUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ModerationNote.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ModerationNoteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModerationNoteUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ModerationNoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationnote.Table, moderationnote.Columns, sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationnote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(moderationnote.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetKind(); ok {
+		_spec.SetField(moderationnote.FieldTargetKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(moderationnote.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(moderationnote.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(moderationnote.FieldContent, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AccountTable,
+			Columns: []string{moderationnote.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AccountTable,
+			Columns: []string{moderationnote.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AuthorTable,
+			Columns: []string{moderationnote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AuthorTable,
+			Columns: []string{moderationnote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationnote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModerationNoteUpdateOne is the builder for updating a single ModerationNote entity.
+type ModerationNoteUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ModerationNoteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationNoteUpdateOne) SetUpdatedAt(v time.Time) *ModerationNoteUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ModerationNoteUpdateOne) SetAccountID(v xid.ID) *ModerationNoteUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ModerationNoteUpdateOne) SetNillableAccountID(v *xid.ID) *ModerationNoteUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *ModerationNoteUpdateOne) SetAuthorID(v xid.ID) *ModerationNoteUpdateOne {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *ModerationNoteUpdateOne) SetNillableAuthorID(v *xid.ID) *ModerationNoteUpdateOne {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (_u *ModerationNoteUpdateOne) ClearAuthorID() *ModerationNoteUpdateOne {
+	_u.mutation.ClearAuthorID()
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ModerationNoteUpdateOne) SetTargetID(v xid.ID) *ModerationNoteUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ModerationNoteUpdateOne) SetNillableTargetID(v *xid.ID) *ModerationNoteUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (_u *ModerationNoteUpdateOne) SetTargetKind(v string) *ModerationNoteUpdateOne {
+	_u.mutation.SetTargetKind(v)
+	return _u
+}
+
+// SetNillableTargetKind sets the "target_kind" field if the given value is not nil.
+func (_u *ModerationNoteUpdateOne) SetNillableTargetKind(v *string) *ModerationNoteUpdateOne {
+	if v != nil {
+		_u.SetTargetKind(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ModerationNoteUpdateOne) SetKind(v string) *ModerationNoteUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ModerationNoteUpdateOne) SetNillableKind(v *string) *ModerationNoteUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ModerationNoteUpdateOne) SetContent(v string) *ModerationNoteUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ModerationNoteUpdateOne) SetNillableContent(v *string) *ModerationNoteUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *ModerationNoteUpdateOne) ClearContent() *ModerationNoteUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ModerationNoteUpdateOne) SetAccount(v *Account) *ModerationNoteUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_u *ModerationNoteUpdateOne) SetAuthor(v *Account) *ModerationNoteUpdateOne {
+	return _u.SetAuthorID(v.ID)
+}
+
+// Mutation returns the ModerationNoteMutation object of the builder.
+func (_u *ModerationNoteUpdateOne) Mutation() *ModerationNoteMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ModerationNoteUpdateOne) ClearAccount() *ModerationNoteUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearAuthor clears the "author" edge to the Account entity.
+func (_u *ModerationNoteUpdateOne) ClearAuthor() *ModerationNoteUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// Where appends a list predicates to the ModerationNoteUpdate builder.
+func (_u *ModerationNoteUpdateOne) Where(ps ...predicate.ModerationNote) *ModerationNoteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModerationNoteUpdateOne) Select(field string, fields ...string) *ModerationNoteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModerationNote entity.
+func (_u *ModerationNoteUpdateOne) Save(ctx context.Context) (*ModerationNote, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationNoteUpdateOne) SaveX(ctx context.Context) *ModerationNote {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModerationNoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationNoteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModerationNoteUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := moderationnote.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationNoteUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ModerationNote.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ModerationNoteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModerationNoteUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ModerationNoteUpdateOne) sqlSave(ctx context.Context) (_node *ModerationNote, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationnote.Table, moderationnote.Columns, sqlgraph.NewFieldSpec(moderationnote.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModerationNote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationnote.FieldID)
+		for _, f := range fields {
+			if !moderationnote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderationnote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationnote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(moderationnote.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetKind(); ok {
+		_spec.SetField(moderationnote.FieldTargetKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(moderationnote.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(moderationnote.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(moderationnote.FieldContent, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AccountTable,
+			Columns: []string{moderationnote.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AccountTable,
+			Columns: []string{moderationnote.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AuthorTable,
+			Columns: []string{moderationnote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationnote.AuthorTable,
+			Columns: []string{moderationnote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ModerationNote{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationnote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
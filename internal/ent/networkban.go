@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/networkban"
+	"github.com/rs/xid"
+)
+
+// NetworkBan is the model entity for the NetworkBan schema.
+type NetworkBan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// A CIDR range, single addresses are stored as a /32 or /128, or an AS number in the form AS64496.
+	Target string `json:"target,omitempty"`
+	// Either block or allow, allow entries override any matching block entries.
+	List string `json:"list,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Hits holds the value of the "hits" field.
+	Hits int `json:"hits,omitempty"`
+	// LastHitAt holds the value of the "last_hit_at" field.
+	LastHitAt    *time.Time `json:"last_hit_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NetworkBan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case networkban.FieldHits:
+			values[i] = new(sql.NullInt64)
+		case networkban.FieldTarget, networkban.FieldList, networkban.FieldReason:
+			values[i] = new(sql.NullString)
+		case networkban.FieldCreatedAt, networkban.FieldUpdatedAt, networkban.FieldExpiresAt, networkban.FieldLastHitAt:
+			values[i] = new(sql.NullTime)
+		case networkban.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NetworkBan fields.
+func (_m *NetworkBan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case networkban.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case networkban.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case networkban.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case networkban.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				_m.Target = value.String
+			}
+		case networkban.FieldList:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field list", values[i])
+			} else if value.Valid {
+				_m.List = value.String
+			}
+		case networkban.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		case networkban.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case networkban.FieldHits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hits", values[i])
+			} else if value.Valid {
+				_m.Hits = int(value.Int64)
+			}
+		case networkban.FieldLastHitAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_hit_at", values[i])
+			} else if value.Valid {
+				_m.LastHitAt = new(time.Time)
+				*_m.LastHitAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NetworkBan.
+// This includes values selected through modifiers, order, etc.
+func (_m *NetworkBan) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NetworkBan.
+// Note that you need to call NetworkBan.Unwrap() before calling this method if this NetworkBan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NetworkBan) Update() *NetworkBanUpdateOne {
+	return NewNetworkBanClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NetworkBan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NetworkBan) Unwrap() *NetworkBan {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NetworkBan is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NetworkBan) String() string {
+	var builder strings.Builder
+	builder.WriteString("NetworkBan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(_m.Target)
+	builder.WriteString(", ")
+	builder.WriteString("list=")
+	builder.WriteString(_m.List)
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hits))
+	builder.WriteString(", ")
+	if v := _m.LastHitAt; v != nil {
+		builder.WriteString("last_hit_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NetworkBans is a parsable slice of NetworkBan.
+type NetworkBans []*NetworkBan
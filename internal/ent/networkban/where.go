@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package networkban
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldTarget, v))
+}
+
+// List applies equality check predicate on the "list" field. It's identical to ListEQ.
+func List(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldList, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldReason, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Hits applies equality check predicate on the "hits" field. It's identical to HitsEQ.
+func Hits(v int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldHits, v))
+}
+
+// LastHitAt applies equality check predicate on the "last_hit_at" field. It's identical to LastHitAtEQ.
+func LastHitAt(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldLastHitAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldTarget, v))
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldTarget, v))
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldTarget, v))
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldTarget, v))
+}
+
+// TargetContains applies the Contains predicate on the "target" field.
+func TargetContains(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldContains(FieldTarget, v))
+}
+
+// TargetHasPrefix applies the HasPrefix predicate on the "target" field.
+func TargetHasPrefix(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldHasPrefix(FieldTarget, v))
+}
+
+// TargetHasSuffix applies the HasSuffix predicate on the "target" field.
+func TargetHasSuffix(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldHasSuffix(FieldTarget, v))
+}
+
+// TargetEqualFold applies the EqualFold predicate on the "target" field.
+func TargetEqualFold(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEqualFold(FieldTarget, v))
+}
+
+// TargetContainsFold applies the ContainsFold predicate on the "target" field.
+func TargetContainsFold(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldContainsFold(FieldTarget, v))
+}
+
+// ListEQ applies the EQ predicate on the "list" field.
+func ListEQ(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldList, v))
+}
+
+// ListNEQ applies the NEQ predicate on the "list" field.
+func ListNEQ(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldList, v))
+}
+
+// ListIn applies the In predicate on the "list" field.
+func ListIn(vs ...string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldList, vs...))
+}
+
+// ListNotIn applies the NotIn predicate on the "list" field.
+func ListNotIn(vs ...string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldList, vs...))
+}
+
+// ListGT applies the GT predicate on the "list" field.
+func ListGT(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldList, v))
+}
+
+// ListGTE applies the GTE predicate on the "list" field.
+func ListGTE(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldList, v))
+}
+
+// ListLT applies the LT predicate on the "list" field.
+func ListLT(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldList, v))
+}
+
+// ListLTE applies the LTE predicate on the "list" field.
+func ListLTE(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldList, v))
+}
+
+// ListContains applies the Contains predicate on the "list" field.
+func ListContains(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldContains(FieldList, v))
+}
+
+// ListHasPrefix applies the HasPrefix predicate on the "list" field.
+func ListHasPrefix(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldHasPrefix(FieldList, v))
+}
+
+// ListHasSuffix applies the HasSuffix predicate on the "list" field.
+func ListHasSuffix(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldHasSuffix(FieldList, v))
+}
+
+// ListEqualFold applies the EqualFold predicate on the "list" field.
+func ListEqualFold(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEqualFold(FieldList, v))
+}
+
+// ListContainsFold applies the ContainsFold predicate on the "list" field.
+func ListContainsFold(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldContainsFold(FieldList, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// HitsEQ applies the EQ predicate on the "hits" field.
+func HitsEQ(v int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldHits, v))
+}
+
+// HitsNEQ applies the NEQ predicate on the "hits" field.
+func HitsNEQ(v int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldHits, v))
+}
+
+// HitsIn applies the In predicate on the "hits" field.
+func HitsIn(vs ...int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldHits, vs...))
+}
+
+// HitsNotIn applies the NotIn predicate on the "hits" field.
+func HitsNotIn(vs ...int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldHits, vs...))
+}
+
+// HitsGT applies the GT predicate on the "hits" field.
+func HitsGT(v int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldHits, v))
+}
+
+// HitsGTE applies the GTE predicate on the "hits" field.
+func HitsGTE(v int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldHits, v))
+}
+
+// HitsLT applies the LT predicate on the "hits" field.
+func HitsLT(v int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldHits, v))
+}
+
+// HitsLTE applies the LTE predicate on the "hits" field.
+func HitsLTE(v int) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldHits, v))
+}
+
+// LastHitAtEQ applies the EQ predicate on the "last_hit_at" field.
+func LastHitAtEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldEQ(FieldLastHitAt, v))
+}
+
+// LastHitAtNEQ applies the NEQ predicate on the "last_hit_at" field.
+func LastHitAtNEQ(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNEQ(FieldLastHitAt, v))
+}
+
+// LastHitAtIn applies the In predicate on the "last_hit_at" field.
+func LastHitAtIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIn(FieldLastHitAt, vs...))
+}
+
+// LastHitAtNotIn applies the NotIn predicate on the "last_hit_at" field.
+func LastHitAtNotIn(vs ...time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotIn(FieldLastHitAt, vs...))
+}
+
+// LastHitAtGT applies the GT predicate on the "last_hit_at" field.
+func LastHitAtGT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGT(FieldLastHitAt, v))
+}
+
+// LastHitAtGTE applies the GTE predicate on the "last_hit_at" field.
+func LastHitAtGTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldGTE(FieldLastHitAt, v))
+}
+
+// LastHitAtLT applies the LT predicate on the "last_hit_at" field.
+func LastHitAtLT(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLT(FieldLastHitAt, v))
+}
+
+// LastHitAtLTE applies the LTE predicate on the "last_hit_at" field.
+func LastHitAtLTE(v time.Time) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldLTE(FieldLastHitAt, v))
+}
+
+// LastHitAtIsNil applies the IsNil predicate on the "last_hit_at" field.
+func LastHitAtIsNil() predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldIsNull(FieldLastHitAt))
+}
+
+// LastHitAtNotNil applies the NotNil predicate on the "last_hit_at" field.
+func LastHitAtNotNil() predicate.NetworkBan {
+	return predicate.NetworkBan(sql.FieldNotNull(FieldLastHitAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NetworkBan) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NetworkBan) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NetworkBan) predicate.NetworkBan {
+	return predicate.NetworkBan(sql.NotPredicates(p))
+}
@@ -0,0 +1,982 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/networkban"
+	"github.com/rs/xid"
+)
+
+// NetworkBanCreate is the builder for creating a NetworkBan entity.
+type NetworkBanCreate struct {
+	config
+	mutation *NetworkBanMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NetworkBanCreate) SetCreatedAt(v time.Time) *NetworkBanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NetworkBanCreate) SetNillableCreatedAt(v *time.Time) *NetworkBanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NetworkBanCreate) SetUpdatedAt(v time.Time) *NetworkBanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NetworkBanCreate) SetNillableUpdatedAt(v *time.Time) *NetworkBanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *NetworkBanCreate) SetTarget(v string) *NetworkBanCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetList sets the "list" field.
+func (_c *NetworkBanCreate) SetList(v string) *NetworkBanCreate {
+	_c.mutation.SetList(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *NetworkBanCreate) SetReason(v string) *NetworkBanCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *NetworkBanCreate) SetNillableReason(v *string) *NetworkBanCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *NetworkBanCreate) SetExpiresAt(v time.Time) *NetworkBanCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *NetworkBanCreate) SetNillableExpiresAt(v *time.Time) *NetworkBanCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetHits sets the "hits" field.
+func (_c *NetworkBanCreate) SetHits(v int) *NetworkBanCreate {
+	_c.mutation.SetHits(v)
+	return _c
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_c *NetworkBanCreate) SetNillableHits(v *int) *NetworkBanCreate {
+	if v != nil {
+		_c.SetHits(*v)
+	}
+	return _c
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (_c *NetworkBanCreate) SetLastHitAt(v time.Time) *NetworkBanCreate {
+	_c.mutation.SetLastHitAt(v)
+	return _c
+}
+
+// SetNillableLastHitAt sets the "last_hit_at" field if the given value is not nil.
+func (_c *NetworkBanCreate) SetNillableLastHitAt(v *time.Time) *NetworkBanCreate {
+	if v != nil {
+		_c.SetLastHitAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NetworkBanCreate) SetID(v xid.ID) *NetworkBanCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NetworkBanCreate) SetNillableID(v *xid.ID) *NetworkBanCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NetworkBanMutation object of the builder.
+func (_c *NetworkBanCreate) Mutation() *NetworkBanMutation {
+	return _c.mutation
+}
+
+// Save creates the NetworkBan in the database.
+func (_c *NetworkBanCreate) Save(ctx context.Context) (*NetworkBan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NetworkBanCreate) SaveX(ctx context.Context) *NetworkBan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NetworkBanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NetworkBanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NetworkBanCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := networkban.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := networkban.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Hits(); !ok {
+		v := networkban.DefaultHits
+		_c.mutation.SetHits(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := networkban.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NetworkBanCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NetworkBan.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NetworkBan.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "NetworkBan.target"`)}
+	}
+	if _, ok := _c.mutation.List(); !ok {
+		return &ValidationError{Name: "list", err: errors.New(`ent: missing required field "NetworkBan.list"`)}
+	}
+	if _, ok := _c.mutation.Hits(); !ok {
+		return &ValidationError{Name: "hits", err: errors.New(`ent: missing required field "NetworkBan.hits"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := networkban.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "NetworkBan.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *NetworkBanCreate) sqlSave(ctx context.Context) (*NetworkBan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NetworkBanCreate) createSpec() (*NetworkBan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NetworkBan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(networkban.Table, sqlgraph.NewFieldSpec(networkban.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(networkban.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(networkban.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(networkban.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.List(); ok {
+		_spec.SetField(networkban.FieldList, field.TypeString, value)
+		_node.List = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(networkban.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(networkban.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Hits(); ok {
+		_spec.SetField(networkban.FieldHits, field.TypeInt, value)
+		_node.Hits = value
+	}
+	if value, ok := _c.mutation.LastHitAt(); ok {
+		_spec.SetField(networkban.FieldLastHitAt, field.TypeTime, value)
+		_node.LastHitAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NetworkBan.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NetworkBanUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NetworkBanCreate) OnConflict(opts ...sql.ConflictOption) *NetworkBanUpsertOne {
+	_c.conflict = opts
+	return &NetworkBanUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NetworkBan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NetworkBanCreate) OnConflictColumns(columns ...string) *NetworkBanUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NetworkBanUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NetworkBanUpsertOne is the builder for "upsert"-ing
+	//  one NetworkBan node.
+	NetworkBanUpsertOne struct {
+		create *NetworkBanCreate
+	}
+
+	// NetworkBanUpsert is the "OnConflict" setter.
+	NetworkBanUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NetworkBanUpsert) SetUpdatedAt(v time.Time) *NetworkBanUpsert {
+	u.Set(networkban.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NetworkBanUpsert) UpdateUpdatedAt() *NetworkBanUpsert {
+	u.SetExcluded(networkban.FieldUpdatedAt)
+	return u
+}
+
+// SetTarget sets the "target" field.
+func (u *NetworkBanUpsert) SetTarget(v string) *NetworkBanUpsert {
+	u.Set(networkban.FieldTarget, v)
+	return u
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *NetworkBanUpsert) UpdateTarget() *NetworkBanUpsert {
+	u.SetExcluded(networkban.FieldTarget)
+	return u
+}
+
+// SetList sets the "list" field.
+func (u *NetworkBanUpsert) SetList(v string) *NetworkBanUpsert {
+	u.Set(networkban.FieldList, v)
+	return u
+}
+
+// UpdateList sets the "list" field to the value that was provided on create.
+func (u *NetworkBanUpsert) UpdateList() *NetworkBanUpsert {
+	u.SetExcluded(networkban.FieldList)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *NetworkBanUpsert) SetReason(v string) *NetworkBanUpsert {
+	u.Set(networkban.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *NetworkBanUpsert) UpdateReason() *NetworkBanUpsert {
+	u.SetExcluded(networkban.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *NetworkBanUpsert) ClearReason() *NetworkBanUpsert {
+	u.SetNull(networkban.FieldReason)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *NetworkBanUpsert) SetExpiresAt(v time.Time) *NetworkBanUpsert {
+	u.Set(networkban.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *NetworkBanUpsert) UpdateExpiresAt() *NetworkBanUpsert {
+	u.SetExcluded(networkban.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *NetworkBanUpsert) ClearExpiresAt() *NetworkBanUpsert {
+	u.SetNull(networkban.FieldExpiresAt)
+	return u
+}
+
+// SetHits sets the "hits" field.
+func (u *NetworkBanUpsert) SetHits(v int) *NetworkBanUpsert {
+	u.Set(networkban.FieldHits, v)
+	return u
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *NetworkBanUpsert) UpdateHits() *NetworkBanUpsert {
+	u.SetExcluded(networkban.FieldHits)
+	return u
+}
+
+// AddHits adds v to the "hits" field.
+func (u *NetworkBanUpsert) AddHits(v int) *NetworkBanUpsert {
+	u.Add(networkban.FieldHits, v)
+	return u
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (u *NetworkBanUpsert) SetLastHitAt(v time.Time) *NetworkBanUpsert {
+	u.Set(networkban.FieldLastHitAt, v)
+	return u
+}
+
+// UpdateLastHitAt sets the "last_hit_at" field to the value that was provided on create.
+func (u *NetworkBanUpsert) UpdateLastHitAt() *NetworkBanUpsert {
+	u.SetExcluded(networkban.FieldLastHitAt)
+	return u
+}
+
+// ClearLastHitAt clears the value of the "last_hit_at" field.
+func (u *NetworkBanUpsert) ClearLastHitAt() *NetworkBanUpsert {
+	u.SetNull(networkban.FieldLastHitAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NetworkBan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(networkban.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NetworkBanUpsertOne) UpdateNewValues() *NetworkBanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(networkban.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(networkban.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NetworkBan.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NetworkBanUpsertOne) Ignore() *NetworkBanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NetworkBanUpsertOne) DoNothing() *NetworkBanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NetworkBanCreate.OnConflict
+// documentation for more info.
+func (u *NetworkBanUpsertOne) Update(set func(*NetworkBanUpsert)) *NetworkBanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NetworkBanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NetworkBanUpsertOne) SetUpdatedAt(v time.Time) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NetworkBanUpsertOne) UpdateUpdatedAt() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *NetworkBanUpsertOne) SetTarget(v string) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *NetworkBanUpsertOne) UpdateTarget() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetList sets the "list" field.
+func (u *NetworkBanUpsertOne) SetList(v string) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetList(v)
+	})
+}
+
+// UpdateList sets the "list" field to the value that was provided on create.
+func (u *NetworkBanUpsertOne) UpdateList() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateList()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *NetworkBanUpsertOne) SetReason(v string) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *NetworkBanUpsertOne) UpdateReason() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *NetworkBanUpsertOne) ClearReason() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *NetworkBanUpsertOne) SetExpiresAt(v time.Time) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *NetworkBanUpsertOne) UpdateExpiresAt() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *NetworkBanUpsertOne) ClearExpiresAt() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *NetworkBanUpsertOne) SetHits(v int) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *NetworkBanUpsertOne) AddHits(v int) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *NetworkBanUpsertOne) UpdateHits() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (u *NetworkBanUpsertOne) SetLastHitAt(v time.Time) *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetLastHitAt(v)
+	})
+}
+
+// UpdateLastHitAt sets the "last_hit_at" field to the value that was provided on create.
+func (u *NetworkBanUpsertOne) UpdateLastHitAt() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateLastHitAt()
+	})
+}
+
+// ClearLastHitAt clears the value of the "last_hit_at" field.
+func (u *NetworkBanUpsertOne) ClearLastHitAt() *NetworkBanUpsertOne {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.ClearLastHitAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NetworkBanUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NetworkBanCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NetworkBanUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NetworkBanUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NetworkBanUpsertOne.ID is not supported by MySQL driver. Use NetworkBanUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NetworkBanUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NetworkBanCreateBulk is the builder for creating many NetworkBan entities in bulk.
+type NetworkBanCreateBulk struct {
+	config
+	err      error
+	builders []*NetworkBanCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NetworkBan entities in the database.
+func (_c *NetworkBanCreateBulk) Save(ctx context.Context) ([]*NetworkBan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NetworkBan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NetworkBanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NetworkBanCreateBulk) SaveX(ctx context.Context) []*NetworkBan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NetworkBanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NetworkBanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NetworkBan.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NetworkBanUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NetworkBanCreateBulk) OnConflict(opts ...sql.ConflictOption) *NetworkBanUpsertBulk {
+	_c.conflict = opts
+	return &NetworkBanUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NetworkBan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NetworkBanCreateBulk) OnConflictColumns(columns ...string) *NetworkBanUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NetworkBanUpsertBulk{
+		create: _c,
+	}
+}
+
+// NetworkBanUpsertBulk is the builder for "upsert"-ing
+// a bulk of NetworkBan nodes.
+type NetworkBanUpsertBulk struct {
+	create *NetworkBanCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NetworkBan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(networkban.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NetworkBanUpsertBulk) UpdateNewValues() *NetworkBanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(networkban.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(networkban.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NetworkBan.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NetworkBanUpsertBulk) Ignore() *NetworkBanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NetworkBanUpsertBulk) DoNothing() *NetworkBanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NetworkBanCreateBulk.OnConflict
+// documentation for more info.
+func (u *NetworkBanUpsertBulk) Update(set func(*NetworkBanUpsert)) *NetworkBanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NetworkBanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NetworkBanUpsertBulk) SetUpdatedAt(v time.Time) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NetworkBanUpsertBulk) UpdateUpdatedAt() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *NetworkBanUpsertBulk) SetTarget(v string) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *NetworkBanUpsertBulk) UpdateTarget() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetList sets the "list" field.
+func (u *NetworkBanUpsertBulk) SetList(v string) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetList(v)
+	})
+}
+
+// UpdateList sets the "list" field to the value that was provided on create.
+func (u *NetworkBanUpsertBulk) UpdateList() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateList()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *NetworkBanUpsertBulk) SetReason(v string) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *NetworkBanUpsertBulk) UpdateReason() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *NetworkBanUpsertBulk) ClearReason() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *NetworkBanUpsertBulk) SetExpiresAt(v time.Time) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *NetworkBanUpsertBulk) UpdateExpiresAt() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *NetworkBanUpsertBulk) ClearExpiresAt() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *NetworkBanUpsertBulk) SetHits(v int) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *NetworkBanUpsertBulk) AddHits(v int) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *NetworkBanUpsertBulk) UpdateHits() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (u *NetworkBanUpsertBulk) SetLastHitAt(v time.Time) *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.SetLastHitAt(v)
+	})
+}
+
+// UpdateLastHitAt sets the "last_hit_at" field to the value that was provided on create.
+func (u *NetworkBanUpsertBulk) UpdateLastHitAt() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.UpdateLastHitAt()
+	})
+}
+
+// ClearLastHitAt clears the value of the "last_hit_at" field.
+func (u *NetworkBanUpsertBulk) ClearLastHitAt() *NetworkBanUpsertBulk {
+	return u.Update(func(s *NetworkBanUpsert) {
+		s.ClearLastHitAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NetworkBanUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NetworkBanCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NetworkBanCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NetworkBanUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/networkban"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// NetworkBanUpdate is the builder for updating NetworkBan entities.
+type NetworkBanUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NetworkBanMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NetworkBanUpdate builder.
+func (_u *NetworkBanUpdate) Where(ps ...predicate.NetworkBan) *NetworkBanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NetworkBanUpdate) SetUpdatedAt(v time.Time) *NetworkBanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *NetworkBanUpdate) SetTarget(v string) *NetworkBanUpdate {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *NetworkBanUpdate) SetNillableTarget(v *string) *NetworkBanUpdate {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetList sets the "list" field.
+func (_u *NetworkBanUpdate) SetList(v string) *NetworkBanUpdate {
+	_u.mutation.SetList(v)
+	return _u
+}
+
+// SetNillableList sets the "list" field if the given value is not nil.
+func (_u *NetworkBanUpdate) SetNillableList(v *string) *NetworkBanUpdate {
+	if v != nil {
+		_u.SetList(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *NetworkBanUpdate) SetReason(v string) *NetworkBanUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *NetworkBanUpdate) SetNillableReason(v *string) *NetworkBanUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *NetworkBanUpdate) ClearReason() *NetworkBanUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *NetworkBanUpdate) SetExpiresAt(v time.Time) *NetworkBanUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *NetworkBanUpdate) SetNillableExpiresAt(v *time.Time) *NetworkBanUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *NetworkBanUpdate) ClearExpiresAt() *NetworkBanUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetHits sets the "hits" field.
+func (_u *NetworkBanUpdate) SetHits(v int) *NetworkBanUpdate {
+	_u.mutation.ResetHits()
+	_u.mutation.SetHits(v)
+	return _u
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_u *NetworkBanUpdate) SetNillableHits(v *int) *NetworkBanUpdate {
+	if v != nil {
+		_u.SetHits(*v)
+	}
+	return _u
+}
+
+// AddHits adds value to the "hits" field.
+func (_u *NetworkBanUpdate) AddHits(v int) *NetworkBanUpdate {
+	_u.mutation.AddHits(v)
+	return _u
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (_u *NetworkBanUpdate) SetLastHitAt(v time.Time) *NetworkBanUpdate {
+	_u.mutation.SetLastHitAt(v)
+	return _u
+}
+
+// SetNillableLastHitAt sets the "last_hit_at" field if the given value is not nil.
+func (_u *NetworkBanUpdate) SetNillableLastHitAt(v *time.Time) *NetworkBanUpdate {
+	if v != nil {
+		_u.SetLastHitAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHitAt clears the value of the "last_hit_at" field.
+func (_u *NetworkBanUpdate) ClearLastHitAt() *NetworkBanUpdate {
+	_u.mutation.ClearLastHitAt()
+	return _u
+}
+
+// Mutation returns the NetworkBanMutation object of the builder.
+func (_u *NetworkBanUpdate) Mutation() *NetworkBanMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NetworkBanUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NetworkBanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NetworkBanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NetworkBanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NetworkBanUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := networkban.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NetworkBanUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NetworkBanUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NetworkBanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(networkban.Table, networkban.Columns, sqlgraph.NewFieldSpec(networkban.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(networkban.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(networkban.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.List(); ok {
+		_spec.SetField(networkban.FieldList, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(networkban.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(networkban.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(networkban.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(networkban.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Hits(); ok {
+		_spec.SetField(networkban.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHits(); ok {
+		_spec.AddField(networkban.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHitAt(); ok {
+		_spec.SetField(networkban.FieldLastHitAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHitAtCleared() {
+		_spec.ClearField(networkban.FieldLastHitAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{networkban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NetworkBanUpdateOne is the builder for updating a single NetworkBan entity.
+type NetworkBanUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NetworkBanMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NetworkBanUpdateOne) SetUpdatedAt(v time.Time) *NetworkBanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *NetworkBanUpdateOne) SetTarget(v string) *NetworkBanUpdateOne {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *NetworkBanUpdateOne) SetNillableTarget(v *string) *NetworkBanUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetList sets the "list" field.
+func (_u *NetworkBanUpdateOne) SetList(v string) *NetworkBanUpdateOne {
+	_u.mutation.SetList(v)
+	return _u
+}
+
+// SetNillableList sets the "list" field if the given value is not nil.
+func (_u *NetworkBanUpdateOne) SetNillableList(v *string) *NetworkBanUpdateOne {
+	if v != nil {
+		_u.SetList(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *NetworkBanUpdateOne) SetReason(v string) *NetworkBanUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *NetworkBanUpdateOne) SetNillableReason(v *string) *NetworkBanUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *NetworkBanUpdateOne) ClearReason() *NetworkBanUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *NetworkBanUpdateOne) SetExpiresAt(v time.Time) *NetworkBanUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *NetworkBanUpdateOne) SetNillableExpiresAt(v *time.Time) *NetworkBanUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *NetworkBanUpdateOne) ClearExpiresAt() *NetworkBanUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetHits sets the "hits" field.
+func (_u *NetworkBanUpdateOne) SetHits(v int) *NetworkBanUpdateOne {
+	_u.mutation.ResetHits()
+	_u.mutation.SetHits(v)
+	return _u
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_u *NetworkBanUpdateOne) SetNillableHits(v *int) *NetworkBanUpdateOne {
+	if v != nil {
+		_u.SetHits(*v)
+	}
+	return _u
+}
+
+// AddHits adds value to the "hits" field.
+func (_u *NetworkBanUpdateOne) AddHits(v int) *NetworkBanUpdateOne {
+	_u.mutation.AddHits(v)
+	return _u
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (_u *NetworkBanUpdateOne) SetLastHitAt(v time.Time) *NetworkBanUpdateOne {
+	_u.mutation.SetLastHitAt(v)
+	return _u
+}
+
+// SetNillableLastHitAt sets the "last_hit_at" field if the given value is not nil.
+func (_u *NetworkBanUpdateOne) SetNillableLastHitAt(v *time.Time) *NetworkBanUpdateOne {
+	if v != nil {
+		_u.SetLastHitAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHitAt clears the value of the "last_hit_at" field.
+func (_u *NetworkBanUpdateOne) ClearLastHitAt() *NetworkBanUpdateOne {
+	_u.mutation.ClearLastHitAt()
+	return _u
+}
+
+// Mutation returns the NetworkBanMutation object of the builder.
+func (_u *NetworkBanUpdateOne) Mutation() *NetworkBanMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NetworkBanUpdate builder.
+func (_u *NetworkBanUpdateOne) Where(ps ...predicate.NetworkBan) *NetworkBanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NetworkBanUpdateOne) Select(field string, fields ...string) *NetworkBanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NetworkBan entity.
+func (_u *NetworkBanUpdateOne) Save(ctx context.Context) (*NetworkBan, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NetworkBanUpdateOne) SaveX(ctx context.Context) *NetworkBan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NetworkBanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NetworkBanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NetworkBanUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := networkban.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NetworkBanUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NetworkBanUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NetworkBanUpdateOne) sqlSave(ctx context.Context) (_node *NetworkBan, err error) {
+	_spec := sqlgraph.NewUpdateSpec(networkban.Table, networkban.Columns, sqlgraph.NewFieldSpec(networkban.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NetworkBan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, networkban.FieldID)
+		for _, f := range fields {
+			if !networkban.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != networkban.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(networkban.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(networkban.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.List(); ok {
+		_spec.SetField(networkban.FieldList, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(networkban.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(networkban.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(networkban.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(networkban.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Hits(); ok {
+		_spec.SetField(networkban.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHits(); ok {
+		_spec.AddField(networkban.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHitAt(); ok {
+		_spec.SetField(networkban.FieldLastHitAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHitAtCleared() {
+		_spec.ClearField(networkban.FieldLastHitAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &NetworkBan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{networkban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
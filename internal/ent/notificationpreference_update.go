@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdate) SetUpdatedAt(v time.Time) *NotificationPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *NotificationPreferenceUpdate) SetAccountID(v xid.ID) *NotificationPreferenceUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableAccountID(v *xid.ID) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *NotificationPreferenceUpdate) SetEventType(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableEventType(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetInApp sets the "in_app" field.
+func (_u *NotificationPreferenceUpdate) SetInApp(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetInApp(v)
+	return _u
+}
+
+// SetNillableInApp sets the "in_app" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableInApp(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetInApp(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *NotificationPreferenceUpdate) SetEmail(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableEmail(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetWebPush sets the "web_push" field.
+func (_u *NotificationPreferenceUpdate) SetWebPush(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetWebPush(v)
+	return _u
+}
+
+// SetNillableWebPush sets the "web_push" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableWebPush(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetWebPush(*v)
+	}
+	return _u
+}
+
+// SetTelegram sets the "telegram" field.
+func (_u *NotificationPreferenceUpdate) SetTelegram(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetTelegram(v)
+	return _u
+}
+
+// SetNillableTelegram sets the "telegram" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableTelegram(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetTelegram(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *NotificationPreferenceUpdate) SetAccount(v *Account) *NotificationPreferenceUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *NotificationPreferenceUpdate) ClearAccount() *NotificationPreferenceUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationPreferenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationPreferenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(notificationpreference.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InApp(); ok {
+		_spec.SetField(notificationpreference.FieldInApp, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(notificationpreference.FieldEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebPush(); ok {
+		_spec.SetField(notificationpreference.FieldWebPush, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Telegram(); ok {
+		_spec.SetField(notificationpreference.FieldTelegram, field.TypeBool, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationpreference.AccountTable,
+			Columns: []string{notificationpreference.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationpreference.AccountTable,
+			Columns: []string{notificationpreference.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdateOne) SetUpdatedAt(v time.Time) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *NotificationPreferenceUpdateOne) SetAccountID(v xid.ID) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableAccountID(v *xid.ID) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *NotificationPreferenceUpdateOne) SetEventType(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableEventType(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetInApp sets the "in_app" field.
+func (_u *NotificationPreferenceUpdateOne) SetInApp(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetInApp(v)
+	return _u
+}
+
+// SetNillableInApp sets the "in_app" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableInApp(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetInApp(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *NotificationPreferenceUpdateOne) SetEmail(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableEmail(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetWebPush sets the "web_push" field.
+func (_u *NotificationPreferenceUpdateOne) SetWebPush(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetWebPush(v)
+	return _u
+}
+
+// SetNillableWebPush sets the "web_push" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableWebPush(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetWebPush(*v)
+	}
+	return _u
+}
+
+// SetTelegram sets the "telegram" field.
+func (_u *NotificationPreferenceUpdateOne) SetTelegram(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetTelegram(v)
+	return _u
+}
+
+// SetNillableTelegram sets the "telegram" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableTelegram(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetTelegram(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *NotificationPreferenceUpdateOne) SetAccount(v *Account) *NotificationPreferenceUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *NotificationPreferenceUpdateOne) ClearAccount() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (_u *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationPreferenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationPreferenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(notificationpreference.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InApp(); ok {
+		_spec.SetField(notificationpreference.FieldInApp, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(notificationpreference.FieldEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebPush(); ok {
+		_spec.SetField(notificationpreference.FieldWebPush, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Telegram(); ok {
+		_spec.SetField(notificationpreference.FieldTelegram, field.TypeBool, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationpreference.AccountTable,
+			Columns: []string{notificationpreference.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationpreference.AccountTable,
+			Columns: []string{notificationpreference.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &NotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
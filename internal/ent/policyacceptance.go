@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
+	"github.com/rs/xid"
+)
+
+// PolicyAcceptance is the model entity for the PolicyAcceptance schema.
+type PolicyAcceptance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// VersionID holds the value of the "version_id" field.
+	VersionID xid.ID `json:"version_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyAcceptanceQuery when eager-loading is set.
+	Edges        PolicyAcceptanceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyAcceptanceEdges holds the relations/edges for other nodes in the graph.
+type PolicyAcceptanceEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Version holds the value of the version edge.
+	Version *PolicyVersion `json:"version,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyAcceptanceEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// VersionOrErr returns the Version value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyAcceptanceEdges) VersionOrErr() (*PolicyVersion, error) {
+	if e.Version != nil {
+		return e.Version, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: policyversion.Label}
+	}
+	return nil, &NotLoadedError{edge: "version"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyAcceptance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policyacceptance.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case policyacceptance.FieldID, policyacceptance.FieldAccountID, policyacceptance.FieldVersionID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyAcceptance fields.
+func (_m *PolicyAcceptance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policyacceptance.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policyacceptance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case policyacceptance.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case policyacceptance.FieldVersionID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field version_id", values[i])
+			} else if value != nil {
+				_m.VersionID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyAcceptance.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyAcceptance) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the PolicyAcceptance entity.
+func (_m *PolicyAcceptance) QueryAccount() *AccountQuery {
+	return NewPolicyAcceptanceClient(_m.config).QueryAccount(_m)
+}
+
+// QueryVersion queries the "version" edge of the PolicyAcceptance entity.
+func (_m *PolicyAcceptance) QueryVersion() *PolicyVersionQuery {
+	return NewPolicyAcceptanceClient(_m.config).QueryVersion(_m)
+}
+
+// Update returns a builder for updating this PolicyAcceptance.
+// Note that you need to call PolicyAcceptance.Unwrap() before calling this method if this PolicyAcceptance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyAcceptance) Update() *PolicyAcceptanceUpdateOne {
+	return NewPolicyAcceptanceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyAcceptance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyAcceptance) Unwrap() *PolicyAcceptance {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyAcceptance is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyAcceptance) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyAcceptance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("version_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VersionID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyAcceptances is a parsable slice of PolicyAcceptance.
+type PolicyAcceptances []*PolicyAcceptance
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyacceptance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldAccountID, v))
+}
+
+// VersionID applies equality check predicate on the "version_id" field. It's identical to VersionIDEQ.
+func VersionID(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldVersionID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// VersionIDEQ applies the EQ predicate on the "version_id" field.
+func VersionIDEQ(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldVersionID, v))
+}
+
+// VersionIDNEQ applies the NEQ predicate on the "version_id" field.
+func VersionIDNEQ(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldVersionID, v))
+}
+
+// VersionIDIn applies the In predicate on the "version_id" field.
+func VersionIDIn(vs ...xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldVersionID, vs...))
+}
+
+// VersionIDNotIn applies the NotIn predicate on the "version_id" field.
+func VersionIDNotIn(vs ...xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldVersionID, vs...))
+}
+
+// VersionIDGT applies the GT predicate on the "version_id" field.
+func VersionIDGT(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGT(FieldVersionID, v))
+}
+
+// VersionIDGTE applies the GTE predicate on the "version_id" field.
+func VersionIDGTE(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGTE(FieldVersionID, v))
+}
+
+// VersionIDLT applies the LT predicate on the "version_id" field.
+func VersionIDLT(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLT(FieldVersionID, v))
+}
+
+// VersionIDLTE applies the LTE predicate on the "version_id" field.
+func VersionIDLTE(v xid.ID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLTE(FieldVersionID, v))
+}
+
+// VersionIDContains applies the Contains predicate on the "version_id" field.
+func VersionIDContains(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldContains(FieldVersionID, vc))
+}
+
+// VersionIDHasPrefix applies the HasPrefix predicate on the "version_id" field.
+func VersionIDHasPrefix(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldHasPrefix(FieldVersionID, vc))
+}
+
+// VersionIDHasSuffix applies the HasSuffix predicate on the "version_id" field.
+func VersionIDHasSuffix(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldHasSuffix(FieldVersionID, vc))
+}
+
+// VersionIDEqualFold applies the EqualFold predicate on the "version_id" field.
+func VersionIDEqualFold(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldEqualFold(FieldVersionID, vc))
+}
+
+// VersionIDContainsFold applies the ContainsFold predicate on the "version_id" field.
+func VersionIDContainsFold(v xid.ID) predicate.PolicyAcceptance {
+	vc := v.String()
+	return predicate.PolicyAcceptance(sql.FieldContainsFold(FieldVersionID, vc))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVersion applies the HasEdge predicate on the "version" edge.
+func HasVersion() predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VersionTable, VersionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionWith applies the HasEdge predicate on the "version" edge with a given conditions (other predicates).
+func HasVersionWith(preds ...predicate.PolicyVersion) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := newVersionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PolicyAcceptance) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PolicyAcceptance) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PolicyAcceptance) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.NotPredicates(p))
+}
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
+	"github.com/rs/xid"
+)
+
+// PolicyAcceptanceCreate is the builder for creating a PolicyAcceptance entity.
+type PolicyAcceptanceCreate struct {
+	config
+	mutation *PolicyAcceptanceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PolicyAcceptanceCreate) SetCreatedAt(v time.Time) *PolicyAcceptanceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PolicyAcceptanceCreate) SetNillableCreatedAt(v *time.Time) *PolicyAcceptanceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *PolicyAcceptanceCreate) SetAccountID(v xid.ID) *PolicyAcceptanceCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetVersionID sets the "version_id" field.
+func (_c *PolicyAcceptanceCreate) SetVersionID(v xid.ID) *PolicyAcceptanceCreate {
+	_c.mutation.SetVersionID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyAcceptanceCreate) SetID(v xid.ID) *PolicyAcceptanceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyAcceptanceCreate) SetNillableID(v *xid.ID) *PolicyAcceptanceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *PolicyAcceptanceCreate) SetAccount(v *Account) *PolicyAcceptanceCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetVersion sets the "version" edge to the PolicyVersion entity.
+func (_c *PolicyAcceptanceCreate) SetVersion(v *PolicyVersion) *PolicyAcceptanceCreate {
+	return _c.SetVersionID(v.ID)
+}
+
+// Mutation returns the PolicyAcceptanceMutation object of the builder.
+func (_c *PolicyAcceptanceCreate) Mutation() *PolicyAcceptanceMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyAcceptance in the database.
+func (_c *PolicyAcceptanceCreate) Save(ctx context.Context) (*PolicyAcceptance, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyAcceptanceCreate) SaveX(ctx context.Context) *PolicyAcceptance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyAcceptanceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyAcceptanceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyAcceptanceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := policyacceptance.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyacceptance.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyAcceptanceCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PolicyAcceptance.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "PolicyAcceptance.account_id"`)}
+	}
+	if _, ok := _c.mutation.VersionID(); !ok {
+		return &ValidationError{Name: "version_id", err: errors.New(`ent: missing required field "PolicyAcceptance.version_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := policyacceptance.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PolicyAcceptance.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "PolicyAcceptance.account"`)}
+	}
+	if len(_c.mutation.VersionIDs()) == 0 {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required edge "PolicyAcceptance.version"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyAcceptanceCreate) sqlSave(ctx context.Context) (*PolicyAcceptance, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyAcceptanceCreate) createSpec() (*PolicyAcceptance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyAcceptance{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyacceptance.Table, sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(policyacceptance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyacceptance.AccountTable,
+			Columns: []string{policyacceptance.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyacceptance.VersionTable,
+			Columns: []string{policyacceptance.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VersionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyAcceptance.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyAcceptanceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyAcceptanceCreate) OnConflict(opts ...sql.ConflictOption) *PolicyAcceptanceUpsertOne {
+	_c.conflict = opts
+	return &PolicyAcceptanceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyAcceptance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyAcceptanceCreate) OnConflictColumns(columns ...string) *PolicyAcceptanceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyAcceptanceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicyAcceptanceUpsertOne is the builder for "upsert"-ing
+	//  one PolicyAcceptance node.
+	PolicyAcceptanceUpsertOne struct {
+		create *PolicyAcceptanceCreate
+	}
+
+	// PolicyAcceptanceUpsert is the "OnConflict" setter.
+	PolicyAcceptanceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *PolicyAcceptanceUpsert) SetAccountID(v xid.ID) *PolicyAcceptanceUpsert {
+	u.Set(policyacceptance.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *PolicyAcceptanceUpsert) UpdateAccountID() *PolicyAcceptanceUpsert {
+	u.SetExcluded(policyacceptance.FieldAccountID)
+	return u
+}
+
+// SetVersionID sets the "version_id" field.
+func (u *PolicyAcceptanceUpsert) SetVersionID(v xid.ID) *PolicyAcceptanceUpsert {
+	u.Set(policyacceptance.FieldVersionID, v)
+	return u
+}
+
+// UpdateVersionID sets the "version_id" field to the value that was provided on create.
+func (u *PolicyAcceptanceUpsert) UpdateVersionID() *PolicyAcceptanceUpsert {
+	u.SetExcluded(policyacceptance.FieldVersionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicyAcceptance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyacceptance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyAcceptanceUpsertOne) UpdateNewValues() *PolicyAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policyacceptance.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(policyacceptance.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyAcceptance.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicyAcceptanceUpsertOne) Ignore() *PolicyAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyAcceptanceUpsertOne) DoNothing() *PolicyAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyAcceptanceCreate.OnConflict
+// documentation for more info.
+func (u *PolicyAcceptanceUpsertOne) Update(set func(*PolicyAcceptanceUpsert)) *PolicyAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyAcceptanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *PolicyAcceptanceUpsertOne) SetAccountID(v xid.ID) *PolicyAcceptanceUpsertOne {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *PolicyAcceptanceUpsertOne) UpdateAccountID() *PolicyAcceptanceUpsertOne {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetVersionID sets the "version_id" field.
+func (u *PolicyAcceptanceUpsertOne) SetVersionID(v xid.ID) *PolicyAcceptanceUpsertOne {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.SetVersionID(v)
+	})
+}
+
+// UpdateVersionID sets the "version_id" field to the value that was provided on create.
+func (u *PolicyAcceptanceUpsertOne) UpdateVersionID() *PolicyAcceptanceUpsertOne {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.UpdateVersionID()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyAcceptanceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyAcceptanceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyAcceptanceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicyAcceptanceUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicyAcceptanceUpsertOne.ID is not supported by MySQL driver. Use PolicyAcceptanceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicyAcceptanceUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicyAcceptanceCreateBulk is the builder for creating many PolicyAcceptance entities in bulk.
+type PolicyAcceptanceCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyAcceptanceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicyAcceptance entities in the database.
+func (_c *PolicyAcceptanceCreateBulk) Save(ctx context.Context) ([]*PolicyAcceptance, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyAcceptance, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyAcceptanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyAcceptanceCreateBulk) SaveX(ctx context.Context) []*PolicyAcceptance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyAcceptanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyAcceptanceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyAcceptance.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyAcceptanceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyAcceptanceCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicyAcceptanceUpsertBulk {
+	_c.conflict = opts
+	return &PolicyAcceptanceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyAcceptance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyAcceptanceCreateBulk) OnConflictColumns(columns ...string) *PolicyAcceptanceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyAcceptanceUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicyAcceptanceUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicyAcceptance nodes.
+type PolicyAcceptanceUpsertBulk struct {
+	create *PolicyAcceptanceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicyAcceptance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyacceptance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyAcceptanceUpsertBulk) UpdateNewValues() *PolicyAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policyacceptance.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(policyacceptance.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyAcceptance.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicyAcceptanceUpsertBulk) Ignore() *PolicyAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyAcceptanceUpsertBulk) DoNothing() *PolicyAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyAcceptanceCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicyAcceptanceUpsertBulk) Update(set func(*PolicyAcceptanceUpsert)) *PolicyAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyAcceptanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *PolicyAcceptanceUpsertBulk) SetAccountID(v xid.ID) *PolicyAcceptanceUpsertBulk {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *PolicyAcceptanceUpsertBulk) UpdateAccountID() *PolicyAcceptanceUpsertBulk {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetVersionID sets the "version_id" field.
+func (u *PolicyAcceptanceUpsertBulk) SetVersionID(v xid.ID) *PolicyAcceptanceUpsertBulk {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.SetVersionID(v)
+	})
+}
+
+// UpdateVersionID sets the "version_id" field to the value that was provided on create.
+func (u *PolicyAcceptanceUpsertBulk) UpdateVersionID() *PolicyAcceptanceUpsertBulk {
+	return u.Update(func(s *PolicyAcceptanceUpsert) {
+		s.UpdateVersionID()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyAcceptanceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicyAcceptanceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyAcceptanceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyAcceptanceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
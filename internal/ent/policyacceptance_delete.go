@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// PolicyAcceptanceDelete is the builder for deleting a PolicyAcceptance entity.
+type PolicyAcceptanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *PolicyAcceptanceMutation
+}
+
+// Where appends a list predicates to the PolicyAcceptanceDelete builder.
+func (_d *PolicyAcceptanceDelete) Where(ps ...predicate.PolicyAcceptance) *PolicyAcceptanceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PolicyAcceptanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PolicyAcceptanceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PolicyAcceptanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(policyacceptance.Table, sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PolicyAcceptanceDeleteOne is the builder for deleting a single PolicyAcceptance entity.
+type PolicyAcceptanceDeleteOne struct {
+	_d *PolicyAcceptanceDelete
+}
+
+// Where appends a list predicates to the PolicyAcceptanceDelete builder.
+func (_d *PolicyAcceptanceDeleteOne) Where(ps ...predicate.PolicyAcceptance) *PolicyAcceptanceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PolicyAcceptanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{policyacceptance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PolicyAcceptanceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
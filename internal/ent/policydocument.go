@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/rs/xid"
+)
+
+// PolicyDocument is the model entity for the PolicyDocument schema.
+type PolicyDocument struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Members must accept the latest published version before posting.
+	Required bool `json:"required,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyDocumentQuery when eager-loading is set.
+	Edges        PolicyDocumentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyDocumentEdges holds the relations/edges for other nodes in the graph.
+type PolicyDocumentEdges struct {
+	// Versions holds the value of the versions edge.
+	Versions []*PolicyVersion `json:"versions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PolicyDocumentEdges) VersionsOrErr() ([]*PolicyVersion, error) {
+	if e.loadedTypes[0] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyDocument) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policydocument.FieldRequired:
+			values[i] = new(sql.NullBool)
+		case policydocument.FieldSlug, policydocument.FieldTitle:
+			values[i] = new(sql.NullString)
+		case policydocument.FieldCreatedAt, policydocument.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case policydocument.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyDocument fields.
+func (_m *PolicyDocument) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policydocument.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policydocument.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case policydocument.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case policydocument.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		case policydocument.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case policydocument.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				_m.Required = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyDocument.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyDocument) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryVersions queries the "versions" edge of the PolicyDocument entity.
+func (_m *PolicyDocument) QueryVersions() *PolicyVersionQuery {
+	return NewPolicyDocumentClient(_m.config).QueryVersions(_m)
+}
+
+// Update returns a builder for updating this PolicyDocument.
+// Note that you need to call PolicyDocument.Unwrap() before calling this method if this PolicyDocument
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyDocument) Update() *PolicyDocumentUpdateOne {
+	return NewPolicyDocumentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyDocument entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyDocument) Unwrap() *PolicyDocument {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyDocument is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyDocument) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyDocument(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("required=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Required))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyDocuments is a parsable slice of PolicyDocument.
+type PolicyDocuments []*PolicyDocument
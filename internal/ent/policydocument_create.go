@@ -0,0 +1,760 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
+	"github.com/rs/xid"
+)
+
+// PolicyDocumentCreate is the builder for creating a PolicyDocument entity.
+type PolicyDocumentCreate struct {
+	config
+	mutation *PolicyDocumentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PolicyDocumentCreate) SetCreatedAt(v time.Time) *PolicyDocumentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PolicyDocumentCreate) SetNillableCreatedAt(v *time.Time) *PolicyDocumentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PolicyDocumentCreate) SetUpdatedAt(v time.Time) *PolicyDocumentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PolicyDocumentCreate) SetNillableUpdatedAt(v *time.Time) *PolicyDocumentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *PolicyDocumentCreate) SetSlug(v string) *PolicyDocumentCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *PolicyDocumentCreate) SetTitle(v string) *PolicyDocumentCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetRequired sets the "required" field.
+func (_c *PolicyDocumentCreate) SetRequired(v bool) *PolicyDocumentCreate {
+	_c.mutation.SetRequired(v)
+	return _c
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_c *PolicyDocumentCreate) SetNillableRequired(v *bool) *PolicyDocumentCreate {
+	if v != nil {
+		_c.SetRequired(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyDocumentCreate) SetID(v xid.ID) *PolicyDocumentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyDocumentCreate) SetNillableID(v *xid.ID) *PolicyDocumentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddVersionIDs adds the "versions" edge to the PolicyVersion entity by IDs.
+func (_c *PolicyDocumentCreate) AddVersionIDs(ids ...xid.ID) *PolicyDocumentCreate {
+	_c.mutation.AddVersionIDs(ids...)
+	return _c
+}
+
+// AddVersions adds the "versions" edges to the PolicyVersion entity.
+func (_c *PolicyDocumentCreate) AddVersions(v ...*PolicyVersion) *PolicyDocumentCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVersionIDs(ids...)
+}
+
+// Mutation returns the PolicyDocumentMutation object of the builder.
+func (_c *PolicyDocumentCreate) Mutation() *PolicyDocumentMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyDocument in the database.
+func (_c *PolicyDocumentCreate) Save(ctx context.Context) (*PolicyDocument, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyDocumentCreate) SaveX(ctx context.Context) *PolicyDocument {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyDocumentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyDocumentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyDocumentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := policydocument.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := policydocument.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Required(); !ok {
+		v := policydocument.DefaultRequired
+		_c.mutation.SetRequired(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policydocument.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyDocumentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PolicyDocument.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PolicyDocument.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "PolicyDocument.slug"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "PolicyDocument.title"`)}
+	}
+	if _, ok := _c.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "PolicyDocument.required"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := policydocument.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PolicyDocument.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PolicyDocumentCreate) sqlSave(ctx context.Context) (*PolicyDocument, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyDocumentCreate) createSpec() (*PolicyDocument, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyDocument{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policydocument.Table, sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(policydocument.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(policydocument.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(policydocument.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(policydocument.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Required(); ok {
+		_spec.SetField(policydocument.FieldRequired, field.TypeBool, value)
+		_node.Required = value
+	}
+	if nodes := _c.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policydocument.VersionsTable,
+			Columns: []string{policydocument.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyDocument.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyDocumentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyDocumentCreate) OnConflict(opts ...sql.ConflictOption) *PolicyDocumentUpsertOne {
+	_c.conflict = opts
+	return &PolicyDocumentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyDocument.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyDocumentCreate) OnConflictColumns(columns ...string) *PolicyDocumentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyDocumentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicyDocumentUpsertOne is the builder for "upsert"-ing
+	//  one PolicyDocument node.
+	PolicyDocumentUpsertOne struct {
+		create *PolicyDocumentCreate
+	}
+
+	// PolicyDocumentUpsert is the "OnConflict" setter.
+	PolicyDocumentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PolicyDocumentUpsert) SetUpdatedAt(v time.Time) *PolicyDocumentUpsert {
+	u.Set(policydocument.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PolicyDocumentUpsert) UpdateUpdatedAt() *PolicyDocumentUpsert {
+	u.SetExcluded(policydocument.FieldUpdatedAt)
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *PolicyDocumentUpsert) SetSlug(v string) *PolicyDocumentUpsert {
+	u.Set(policydocument.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PolicyDocumentUpsert) UpdateSlug() *PolicyDocumentUpsert {
+	u.SetExcluded(policydocument.FieldSlug)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *PolicyDocumentUpsert) SetTitle(v string) *PolicyDocumentUpsert {
+	u.Set(policydocument.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PolicyDocumentUpsert) UpdateTitle() *PolicyDocumentUpsert {
+	u.SetExcluded(policydocument.FieldTitle)
+	return u
+}
+
+// SetRequired sets the "required" field.
+func (u *PolicyDocumentUpsert) SetRequired(v bool) *PolicyDocumentUpsert {
+	u.Set(policydocument.FieldRequired, v)
+	return u
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *PolicyDocumentUpsert) UpdateRequired() *PolicyDocumentUpsert {
+	u.SetExcluded(policydocument.FieldRequired)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicyDocument.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policydocument.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyDocumentUpsertOne) UpdateNewValues() *PolicyDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policydocument.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(policydocument.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyDocument.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicyDocumentUpsertOne) Ignore() *PolicyDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyDocumentUpsertOne) DoNothing() *PolicyDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyDocumentCreate.OnConflict
+// documentation for more info.
+func (u *PolicyDocumentUpsertOne) Update(set func(*PolicyDocumentUpsert)) *PolicyDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyDocumentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PolicyDocumentUpsertOne) SetUpdatedAt(v time.Time) *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertOne) UpdateUpdatedAt() *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *PolicyDocumentUpsertOne) SetSlug(v string) *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertOne) UpdateSlug() *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PolicyDocumentUpsertOne) SetTitle(v string) *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertOne) UpdateTitle() *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *PolicyDocumentUpsertOne) SetRequired(v bool) *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertOne) UpdateRequired() *PolicyDocumentUpsertOne {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyDocumentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyDocumentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyDocumentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicyDocumentUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicyDocumentUpsertOne.ID is not supported by MySQL driver. Use PolicyDocumentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicyDocumentUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicyDocumentCreateBulk is the builder for creating many PolicyDocument entities in bulk.
+type PolicyDocumentCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyDocumentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicyDocument entities in the database.
+func (_c *PolicyDocumentCreateBulk) Save(ctx context.Context) ([]*PolicyDocument, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyDocument, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyDocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyDocumentCreateBulk) SaveX(ctx context.Context) []*PolicyDocument {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyDocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyDocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyDocument.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyDocumentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyDocumentCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicyDocumentUpsertBulk {
+	_c.conflict = opts
+	return &PolicyDocumentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyDocument.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyDocumentCreateBulk) OnConflictColumns(columns ...string) *PolicyDocumentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyDocumentUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicyDocumentUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicyDocument nodes.
+type PolicyDocumentUpsertBulk struct {
+	create *PolicyDocumentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicyDocument.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policydocument.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyDocumentUpsertBulk) UpdateNewValues() *PolicyDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policydocument.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(policydocument.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyDocument.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicyDocumentUpsertBulk) Ignore() *PolicyDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyDocumentUpsertBulk) DoNothing() *PolicyDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyDocumentCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicyDocumentUpsertBulk) Update(set func(*PolicyDocumentUpsert)) *PolicyDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyDocumentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PolicyDocumentUpsertBulk) SetUpdatedAt(v time.Time) *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertBulk) UpdateUpdatedAt() *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *PolicyDocumentUpsertBulk) SetSlug(v string) *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertBulk) UpdateSlug() *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PolicyDocumentUpsertBulk) SetTitle(v string) *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertBulk) UpdateTitle() *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *PolicyDocumentUpsertBulk) SetRequired(v bool) *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *PolicyDocumentUpsertBulk) UpdateRequired() *PolicyDocumentUpsertBulk {
+	return u.Update(func(s *PolicyDocumentUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyDocumentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicyDocumentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyDocumentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyDocumentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
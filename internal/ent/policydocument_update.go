@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// PolicyDocumentUpdate is the builder for updating PolicyDocument entities.
+type PolicyDocumentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PolicyDocumentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PolicyDocumentUpdate builder.
+func (_u *PolicyDocumentUpdate) Where(ps ...predicate.PolicyDocument) *PolicyDocumentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PolicyDocumentUpdate) SetUpdatedAt(v time.Time) *PolicyDocumentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PolicyDocumentUpdate) SetSlug(v string) *PolicyDocumentUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PolicyDocumentUpdate) SetNillableSlug(v *string) *PolicyDocumentUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PolicyDocumentUpdate) SetTitle(v string) *PolicyDocumentUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PolicyDocumentUpdate) SetNillableTitle(v *string) *PolicyDocumentUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *PolicyDocumentUpdate) SetRequired(v bool) *PolicyDocumentUpdate {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *PolicyDocumentUpdate) SetNillableRequired(v *bool) *PolicyDocumentUpdate {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// AddVersionIDs adds the "versions" edge to the PolicyVersion entity by IDs.
+func (_u *PolicyDocumentUpdate) AddVersionIDs(ids ...xid.ID) *PolicyDocumentUpdate {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the PolicyVersion entity.
+func (_u *PolicyDocumentUpdate) AddVersions(v ...*PolicyVersion) *PolicyDocumentUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVersionIDs(ids...)
+}
+
+// Mutation returns the PolicyDocumentMutation object of the builder.
+func (_u *PolicyDocumentUpdate) Mutation() *PolicyDocumentMutation {
+	return _u.mutation
+}
+
+// ClearVersions clears all "versions" edges to the PolicyVersion entity.
+func (_u *PolicyDocumentUpdate) ClearVersions() *PolicyDocumentUpdate {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to PolicyVersion entities by IDs.
+func (_u *PolicyDocumentUpdate) RemoveVersionIDs(ids ...xid.ID) *PolicyDocumentUpdate {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to PolicyVersion entities.
+func (_u *PolicyDocumentUpdate) RemoveVersions(v ...*PolicyVersion) *PolicyDocumentUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PolicyDocumentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyDocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PolicyDocumentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyDocumentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PolicyDocumentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := policydocument.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyDocumentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyDocumentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyDocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(policydocument.Table, policydocument.Columns, sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(policydocument.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(policydocument.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(policydocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(policydocument.FieldRequired, field.TypeBool, value)
+	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policydocument.VersionsTable,
+			Columns: []string{policydocument.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policydocument.VersionsTable,
+			Columns: []string{policydocument.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policydocument.VersionsTable,
+			Columns: []string{policydocument.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policydocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PolicyDocumentUpdateOne is the builder for updating a single PolicyDocument entity.
+type PolicyDocumentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PolicyDocumentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PolicyDocumentUpdateOne) SetUpdatedAt(v time.Time) *PolicyDocumentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PolicyDocumentUpdateOne) SetSlug(v string) *PolicyDocumentUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PolicyDocumentUpdateOne) SetNillableSlug(v *string) *PolicyDocumentUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PolicyDocumentUpdateOne) SetTitle(v string) *PolicyDocumentUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PolicyDocumentUpdateOne) SetNillableTitle(v *string) *PolicyDocumentUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *PolicyDocumentUpdateOne) SetRequired(v bool) *PolicyDocumentUpdateOne {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *PolicyDocumentUpdateOne) SetNillableRequired(v *bool) *PolicyDocumentUpdateOne {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// AddVersionIDs adds the "versions" edge to the PolicyVersion entity by IDs.
+func (_u *PolicyDocumentUpdateOne) AddVersionIDs(ids ...xid.ID) *PolicyDocumentUpdateOne {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the PolicyVersion entity.
+func (_u *PolicyDocumentUpdateOne) AddVersions(v ...*PolicyVersion) *PolicyDocumentUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVersionIDs(ids...)
+}
+
+// Mutation returns the PolicyDocumentMutation object of the builder.
+func (_u *PolicyDocumentUpdateOne) Mutation() *PolicyDocumentMutation {
+	return _u.mutation
+}
+
+// ClearVersions clears all "versions" edges to the PolicyVersion entity.
+func (_u *PolicyDocumentUpdateOne) ClearVersions() *PolicyDocumentUpdateOne {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to PolicyVersion entities by IDs.
+func (_u *PolicyDocumentUpdateOne) RemoveVersionIDs(ids ...xid.ID) *PolicyDocumentUpdateOne {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to PolicyVersion entities.
+func (_u *PolicyDocumentUpdateOne) RemoveVersions(v ...*PolicyVersion) *PolicyDocumentUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
+// Where appends a list predicates to the PolicyDocumentUpdate builder.
+func (_u *PolicyDocumentUpdateOne) Where(ps ...predicate.PolicyDocument) *PolicyDocumentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PolicyDocumentUpdateOne) Select(field string, fields ...string) *PolicyDocumentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PolicyDocument entity.
+func (_u *PolicyDocumentUpdateOne) Save(ctx context.Context) (*PolicyDocument, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyDocumentUpdateOne) SaveX(ctx context.Context) *PolicyDocument {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PolicyDocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyDocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PolicyDocumentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := policydocument.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyDocumentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyDocumentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyDocumentUpdateOne) sqlSave(ctx context.Context) (_node *PolicyDocument, err error) {
+	_spec := sqlgraph.NewUpdateSpec(policydocument.Table, policydocument.Columns, sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PolicyDocument.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policydocument.FieldID)
+		for _, f := range fields {
+			if !policydocument.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != policydocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(policydocument.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(policydocument.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(policydocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(policydocument.FieldRequired, field.TypeBool, value)
+	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policydocument.VersionsTable,
+			Columns: []string{policydocument.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policydocument.VersionsTable,
+			Columns: []string{policydocument.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policydocument.VersionsTable,
+			Columns: []string{policydocument.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PolicyDocument{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policydocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
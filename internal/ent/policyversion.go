@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
+	"github.com/rs/xid"
+)
+
+// PolicyVersion is the model entity for the PolicyVersion schema.
+type PolicyVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DocumentID holds the value of the "document_id" field.
+	DocumentID xid.ID `json:"document_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// A short description of what changed since the previous version.
+	Summary *string `json:"summary,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyVersionQuery when eager-loading is set.
+	Edges        PolicyVersionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyVersionEdges holds the relations/edges for other nodes in the graph.
+type PolicyVersionEdges struct {
+	// Document holds the value of the document edge.
+	Document *PolicyDocument `json:"document,omitempty"`
+	// Acceptances holds the value of the acceptances edge.
+	Acceptances []*PolicyAcceptance `json:"acceptances,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// DocumentOrErr returns the Document value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyVersionEdges) DocumentOrErr() (*PolicyDocument, error) {
+	if e.Document != nil {
+		return e.Document, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: policydocument.Label}
+	}
+	return nil, &NotLoadedError{edge: "document"}
+}
+
+// AcceptancesOrErr returns the Acceptances value or an error if the edge
+// was not loaded in eager-loading.
+func (e PolicyVersionEdges) AcceptancesOrErr() ([]*PolicyAcceptance, error) {
+	if e.loadedTypes[1] {
+		return e.Acceptances, nil
+	}
+	return nil, &NotLoadedError{edge: "acceptances"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policyversion.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case policyversion.FieldContent, policyversion.FieldSummary:
+			values[i] = new(sql.NullString)
+		case policyversion.FieldCreatedAt, policyversion.FieldPublishedAt:
+			values[i] = new(sql.NullTime)
+		case policyversion.FieldID, policyversion.FieldDocumentID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyVersion fields.
+func (_m *PolicyVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policyversion.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policyversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case policyversion.FieldDocumentID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value != nil {
+				_m.DocumentID = *value
+			}
+		case policyversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case policyversion.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case policyversion.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = new(string)
+				*_m.Summary = value.String
+			}
+		case policyversion.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				_m.PublishedAt = new(time.Time)
+				*_m.PublishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyVersion.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyVersion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDocument queries the "document" edge of the PolicyVersion entity.
+func (_m *PolicyVersion) QueryDocument() *PolicyDocumentQuery {
+	return NewPolicyVersionClient(_m.config).QueryDocument(_m)
+}
+
+// QueryAcceptances queries the "acceptances" edge of the PolicyVersion entity.
+func (_m *PolicyVersion) QueryAcceptances() *PolicyAcceptanceQuery {
+	return NewPolicyVersionClient(_m.config).QueryAcceptances(_m)
+}
+
+// Update returns a builder for updating this PolicyVersion.
+// Note that you need to call PolicyVersion.Unwrap() before calling this method if this PolicyVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyVersion) Update() *PolicyVersionUpdateOne {
+	return NewPolicyVersionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyVersion) Unwrap() *PolicyVersion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyVersion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	if v := _m.Summary; v != nil {
+		builder.WriteString("summary=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyVersions is a parsable slice of PolicyVersion.
+type PolicyVersions []*PolicyVersion
@@ -0,0 +1,881 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
+	"github.com/rs/xid"
+)
+
+// PolicyVersionCreate is the builder for creating a PolicyVersion entity.
+type PolicyVersionCreate struct {
+	config
+	mutation *PolicyVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PolicyVersionCreate) SetCreatedAt(v time.Time) *PolicyVersionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillableCreatedAt(v *time.Time) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *PolicyVersionCreate) SetDocumentID(v xid.ID) *PolicyVersionCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *PolicyVersionCreate) SetVersion(v int) *PolicyVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *PolicyVersionCreate) SetContent(v string) *PolicyVersionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *PolicyVersionCreate) SetSummary(v string) *PolicyVersionCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillableSummary(v *string) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_c *PolicyVersionCreate) SetPublishedAt(v time.Time) *PolicyVersionCreate {
+	_c.mutation.SetPublishedAt(v)
+	return _c
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillablePublishedAt(v *time.Time) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetPublishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyVersionCreate) SetID(v xid.ID) *PolicyVersionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillableID(v *xid.ID) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDocument sets the "document" edge to the PolicyDocument entity.
+func (_c *PolicyVersionCreate) SetDocument(v *PolicyDocument) *PolicyVersionCreate {
+	return _c.SetDocumentID(v.ID)
+}
+
+// AddAcceptanceIDs adds the "acceptances" edge to the PolicyAcceptance entity by IDs.
+func (_c *PolicyVersionCreate) AddAcceptanceIDs(ids ...xid.ID) *PolicyVersionCreate {
+	_c.mutation.AddAcceptanceIDs(ids...)
+	return _c
+}
+
+// AddAcceptances adds the "acceptances" edges to the PolicyAcceptance entity.
+func (_c *PolicyVersionCreate) AddAcceptances(v ...*PolicyAcceptance) *PolicyVersionCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAcceptanceIDs(ids...)
+}
+
+// Mutation returns the PolicyVersionMutation object of the builder.
+func (_c *PolicyVersionCreate) Mutation() *PolicyVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyVersion in the database.
+func (_c *PolicyVersionCreate) Save(ctx context.Context) (*PolicyVersion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyVersionCreate) SaveX(ctx context.Context) *PolicyVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyVersionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := policyversion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyversion.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyVersionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PolicyVersion.created_at"`)}
+	}
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "PolicyVersion.document_id"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "PolicyVersion.version"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "PolicyVersion.content"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := policyversion.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PolicyVersion.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "PolicyVersion.document"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyVersionCreate) sqlSave(ctx context.Context) (*PolicyVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyVersionCreate) createSpec() (*PolicyVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyversion.Table, sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(policyversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(policyversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(policyversion.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(policyversion.FieldSummary, field.TypeString, value)
+		_node.Summary = &value
+	}
+	if value, ok := _c.mutation.PublishedAt(); ok {
+		_spec.SetField(policyversion.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyversion.DocumentTable,
+			Columns: []string{policyversion.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AcceptancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyversion.AcceptancesTable,
+			Columns: []string{policyversion.AcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyVersion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyVersionCreate) OnConflict(opts ...sql.ConflictOption) *PolicyVersionUpsertOne {
+	_c.conflict = opts
+	return &PolicyVersionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyVersionCreate) OnConflictColumns(columns ...string) *PolicyVersionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyVersionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicyVersionUpsertOne is the builder for "upsert"-ing
+	//  one PolicyVersion node.
+	PolicyVersionUpsertOne struct {
+		create *PolicyVersionCreate
+	}
+
+	// PolicyVersionUpsert is the "OnConflict" setter.
+	PolicyVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDocumentID sets the "document_id" field.
+func (u *PolicyVersionUpsert) SetDocumentID(v xid.ID) *PolicyVersionUpsert {
+	u.Set(policyversion.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *PolicyVersionUpsert) UpdateDocumentID() *PolicyVersionUpsert {
+	u.SetExcluded(policyversion.FieldDocumentID)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *PolicyVersionUpsert) SetVersion(v int) *PolicyVersionUpsert {
+	u.Set(policyversion.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PolicyVersionUpsert) UpdateVersion() *PolicyVersionUpsert {
+	u.SetExcluded(policyversion.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PolicyVersionUpsert) AddVersion(v int) *PolicyVersionUpsert {
+	u.Add(policyversion.FieldVersion, v)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *PolicyVersionUpsert) SetContent(v string) *PolicyVersionUpsert {
+	u.Set(policyversion.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PolicyVersionUpsert) UpdateContent() *PolicyVersionUpsert {
+	u.SetExcluded(policyversion.FieldContent)
+	return u
+}
+
+// SetSummary sets the "summary" field.
+func (u *PolicyVersionUpsert) SetSummary(v string) *PolicyVersionUpsert {
+	u.Set(policyversion.FieldSummary, v)
+	return u
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *PolicyVersionUpsert) UpdateSummary() *PolicyVersionUpsert {
+	u.SetExcluded(policyversion.FieldSummary)
+	return u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *PolicyVersionUpsert) ClearSummary() *PolicyVersionUpsert {
+	u.SetNull(policyversion.FieldSummary)
+	return u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *PolicyVersionUpsert) SetPublishedAt(v time.Time) *PolicyVersionUpsert {
+	u.Set(policyversion.FieldPublishedAt, v)
+	return u
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *PolicyVersionUpsert) UpdatePublishedAt() *PolicyVersionUpsert {
+	u.SetExcluded(policyversion.FieldPublishedAt)
+	return u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *PolicyVersionUpsert) ClearPublishedAt() *PolicyVersionUpsert {
+	u.SetNull(policyversion.FieldPublishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicyVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyVersionUpsertOne) UpdateNewValues() *PolicyVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policyversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(policyversion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicyVersionUpsertOne) Ignore() *PolicyVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyVersionUpsertOne) DoNothing() *PolicyVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyVersionCreate.OnConflict
+// documentation for more info.
+func (u *PolicyVersionUpsertOne) Update(set func(*PolicyVersionUpsert)) *PolicyVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *PolicyVersionUpsertOne) SetDocumentID(v xid.ID) *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *PolicyVersionUpsertOne) UpdateDocumentID() *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *PolicyVersionUpsertOne) SetVersion(v int) *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PolicyVersionUpsertOne) AddVersion(v int) *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PolicyVersionUpsertOne) UpdateVersion() *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PolicyVersionUpsertOne) SetContent(v string) *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PolicyVersionUpsertOne) UpdateContent() *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *PolicyVersionUpsertOne) SetSummary(v string) *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *PolicyVersionUpsertOne) UpdateSummary() *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *PolicyVersionUpsertOne) ClearSummary() *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *PolicyVersionUpsertOne) SetPublishedAt(v time.Time) *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *PolicyVersionUpsertOne) UpdatePublishedAt() *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *PolicyVersionUpsertOne) ClearPublishedAt() *PolicyVersionUpsertOne {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicyVersionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicyVersionUpsertOne.ID is not supported by MySQL driver. Use PolicyVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicyVersionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicyVersionCreateBulk is the builder for creating many PolicyVersion entities in bulk.
+type PolicyVersionCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicyVersion entities in the database.
+func (_c *PolicyVersionCreateBulk) Save(ctx context.Context) ([]*PolicyVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyVersionCreateBulk) SaveX(ctx context.Context) []*PolicyVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicyVersionUpsertBulk {
+	_c.conflict = opts
+	return &PolicyVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyVersionCreateBulk) OnConflictColumns(columns ...string) *PolicyVersionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicyVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicyVersion nodes.
+type PolicyVersionUpsertBulk struct {
+	create *PolicyVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicyVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyVersionUpsertBulk) UpdateNewValues() *PolicyVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policyversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(policyversion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicyVersionUpsertBulk) Ignore() *PolicyVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyVersionUpsertBulk) DoNothing() *PolicyVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicyVersionUpsertBulk) Update(set func(*PolicyVersionUpsert)) *PolicyVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *PolicyVersionUpsertBulk) SetDocumentID(v xid.ID) *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *PolicyVersionUpsertBulk) UpdateDocumentID() *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *PolicyVersionUpsertBulk) SetVersion(v int) *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PolicyVersionUpsertBulk) AddVersion(v int) *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PolicyVersionUpsertBulk) UpdateVersion() *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PolicyVersionUpsertBulk) SetContent(v string) *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PolicyVersionUpsertBulk) UpdateContent() *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *PolicyVersionUpsertBulk) SetSummary(v string) *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *PolicyVersionUpsertBulk) UpdateSummary() *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *PolicyVersionUpsertBulk) ClearSummary() *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *PolicyVersionUpsertBulk) SetPublishedAt(v time.Time) *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *PolicyVersionUpsertBulk) UpdatePublishedAt() *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *PolicyVersionUpsertBulk) ClearPublishedAt() *PolicyVersionUpsertBulk {
+	return u.Update(func(s *PolicyVersionUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicyVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
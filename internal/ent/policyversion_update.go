@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// PolicyVersionUpdate is the builder for updating PolicyVersion entities.
+type PolicyVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PolicyVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PolicyVersionUpdate builder.
+func (_u *PolicyVersionUpdate) Where(ps ...predicate.PolicyVersion) *PolicyVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *PolicyVersionUpdate) SetDocumentID(v xid.ID) *PolicyVersionUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *PolicyVersionUpdate) SetNillableDocumentID(v *xid.ID) *PolicyVersionUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PolicyVersionUpdate) SetVersion(v int) *PolicyVersionUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PolicyVersionUpdate) SetNillableVersion(v *int) *PolicyVersionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PolicyVersionUpdate) AddVersion(v int) *PolicyVersionUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *PolicyVersionUpdate) SetContent(v string) *PolicyVersionUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PolicyVersionUpdate) SetNillableContent(v *string) *PolicyVersionUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *PolicyVersionUpdate) SetSummary(v string) *PolicyVersionUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *PolicyVersionUpdate) SetNillableSummary(v *string) *PolicyVersionUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *PolicyVersionUpdate) ClearSummary() *PolicyVersionUpdate {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_u *PolicyVersionUpdate) SetPublishedAt(v time.Time) *PolicyVersionUpdate {
+	_u.mutation.SetPublishedAt(v)
+	return _u
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_u *PolicyVersionUpdate) SetNillablePublishedAt(v *time.Time) *PolicyVersionUpdate {
+	if v != nil {
+		_u.SetPublishedAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (_u *PolicyVersionUpdate) ClearPublishedAt() *PolicyVersionUpdate {
+	_u.mutation.ClearPublishedAt()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the PolicyDocument entity.
+func (_u *PolicyVersionUpdate) SetDocument(v *PolicyDocument) *PolicyVersionUpdate {
+	return _u.SetDocumentID(v.ID)
+}
+
+// AddAcceptanceIDs adds the "acceptances" edge to the PolicyAcceptance entity by IDs.
+func (_u *PolicyVersionUpdate) AddAcceptanceIDs(ids ...xid.ID) *PolicyVersionUpdate {
+	_u.mutation.AddAcceptanceIDs(ids...)
+	return _u
+}
+
+// AddAcceptances adds the "acceptances" edges to the PolicyAcceptance entity.
+func (_u *PolicyVersionUpdate) AddAcceptances(v ...*PolicyAcceptance) *PolicyVersionUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAcceptanceIDs(ids...)
+}
+
+// Mutation returns the PolicyVersionMutation object of the builder.
+func (_u *PolicyVersionUpdate) Mutation() *PolicyVersionMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the PolicyDocument entity.
+func (_u *PolicyVersionUpdate) ClearDocument() *PolicyVersionUpdate {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// ClearAcceptances clears all "acceptances" edges to the PolicyAcceptance entity.
+func (_u *PolicyVersionUpdate) ClearAcceptances() *PolicyVersionUpdate {
+	_u.mutation.ClearAcceptances()
+	return _u
+}
+
+// RemoveAcceptanceIDs removes the "acceptances" edge to PolicyAcceptance entities by IDs.
+func (_u *PolicyVersionUpdate) RemoveAcceptanceIDs(ids ...xid.ID) *PolicyVersionUpdate {
+	_u.mutation.RemoveAcceptanceIDs(ids...)
+	return _u
+}
+
+// RemoveAcceptances removes "acceptances" edges to PolicyAcceptance entities.
+func (_u *PolicyVersionUpdate) RemoveAcceptances(v ...*PolicyAcceptance) *PolicyVersionUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAcceptanceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PolicyVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PolicyVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyVersionUpdate) check() error {
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyVersion.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyVersionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyversion.Table, policyversion.Columns, sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(policyversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(policyversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(policyversion.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(policyversion.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(policyversion.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedAt(); ok {
+		_spec.SetField(policyversion.FieldPublishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedAtCleared() {
+		_spec.ClearField(policyversion.FieldPublishedAt, field.TypeTime)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyversion.DocumentTable,
+			Columns: []string{policyversion.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyversion.DocumentTable,
+			Columns: []string{policyversion.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AcceptancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyversion.AcceptancesTable,
+			Columns: []string{policyversion.AcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAcceptancesIDs(); len(nodes) > 0 && !_u.mutation.AcceptancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyversion.AcceptancesTable,
+			Columns: []string{policyversion.AcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AcceptancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyversion.AcceptancesTable,
+			Columns: []string{policyversion.AcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PolicyVersionUpdateOne is the builder for updating a single PolicyVersion entity.
+type PolicyVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PolicyVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *PolicyVersionUpdateOne) SetDocumentID(v xid.ID) *PolicyVersionUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *PolicyVersionUpdateOne) SetNillableDocumentID(v *xid.ID) *PolicyVersionUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PolicyVersionUpdateOne) SetVersion(v int) *PolicyVersionUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PolicyVersionUpdateOne) SetNillableVersion(v *int) *PolicyVersionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PolicyVersionUpdateOne) AddVersion(v int) *PolicyVersionUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *PolicyVersionUpdateOne) SetContent(v string) *PolicyVersionUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PolicyVersionUpdateOne) SetNillableContent(v *string) *PolicyVersionUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *PolicyVersionUpdateOne) SetSummary(v string) *PolicyVersionUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *PolicyVersionUpdateOne) SetNillableSummary(v *string) *PolicyVersionUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *PolicyVersionUpdateOne) ClearSummary() *PolicyVersionUpdateOne {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_u *PolicyVersionUpdateOne) SetPublishedAt(v time.Time) *PolicyVersionUpdateOne {
+	_u.mutation.SetPublishedAt(v)
+	return _u
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_u *PolicyVersionUpdateOne) SetNillablePublishedAt(v *time.Time) *PolicyVersionUpdateOne {
+	if v != nil {
+		_u.SetPublishedAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (_u *PolicyVersionUpdateOne) ClearPublishedAt() *PolicyVersionUpdateOne {
+	_u.mutation.ClearPublishedAt()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the PolicyDocument entity.
+func (_u *PolicyVersionUpdateOne) SetDocument(v *PolicyDocument) *PolicyVersionUpdateOne {
+	return _u.SetDocumentID(v.ID)
+}
+
+// AddAcceptanceIDs adds the "acceptances" edge to the PolicyAcceptance entity by IDs.
+func (_u *PolicyVersionUpdateOne) AddAcceptanceIDs(ids ...xid.ID) *PolicyVersionUpdateOne {
+	_u.mutation.AddAcceptanceIDs(ids...)
+	return _u
+}
+
+// AddAcceptances adds the "acceptances" edges to the PolicyAcceptance entity.
+func (_u *PolicyVersionUpdateOne) AddAcceptances(v ...*PolicyAcceptance) *PolicyVersionUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAcceptanceIDs(ids...)
+}
+
+// Mutation returns the PolicyVersionMutation object of the builder.
+func (_u *PolicyVersionUpdateOne) Mutation() *PolicyVersionMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the PolicyDocument entity.
+func (_u *PolicyVersionUpdateOne) ClearDocument() *PolicyVersionUpdateOne {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// ClearAcceptances clears all "acceptances" edges to the PolicyAcceptance entity.
+func (_u *PolicyVersionUpdateOne) ClearAcceptances() *PolicyVersionUpdateOne {
+	_u.mutation.ClearAcceptances()
+	return _u
+}
+
+// RemoveAcceptanceIDs removes the "acceptances" edge to PolicyAcceptance entities by IDs.
+func (_u *PolicyVersionUpdateOne) RemoveAcceptanceIDs(ids ...xid.ID) *PolicyVersionUpdateOne {
+	_u.mutation.RemoveAcceptanceIDs(ids...)
+	return _u
+}
+
+// RemoveAcceptances removes "acceptances" edges to PolicyAcceptance entities.
+func (_u *PolicyVersionUpdateOne) RemoveAcceptances(v ...*PolicyAcceptance) *PolicyVersionUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAcceptanceIDs(ids...)
+}
+
+// Where appends a list predicates to the PolicyVersionUpdate builder.
+func (_u *PolicyVersionUpdateOne) Where(ps ...predicate.PolicyVersion) *PolicyVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PolicyVersionUpdateOne) Select(field string, fields ...string) *PolicyVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PolicyVersion entity.
+func (_u *PolicyVersionUpdateOne) Save(ctx context.Context) (*PolicyVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyVersionUpdateOne) SaveX(ctx context.Context) *PolicyVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PolicyVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyVersionUpdateOne) check() error {
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyVersion.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyVersionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyVersionUpdateOne) sqlSave(ctx context.Context) (_node *PolicyVersion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyversion.Table, policyversion.Columns, sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PolicyVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policyversion.FieldID)
+		for _, f := range fields {
+			if !policyversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != policyversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(policyversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(policyversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(policyversion.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(policyversion.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(policyversion.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedAt(); ok {
+		_spec.SetField(policyversion.FieldPublishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedAtCleared() {
+		_spec.ClearField(policyversion.FieldPublishedAt, field.TypeTime)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyversion.DocumentTable,
+			Columns: []string{policyversion.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyversion.DocumentTable,
+			Columns: []string{policyversion.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policydocument.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AcceptancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyversion.AcceptancesTable,
+			Columns: []string{policyversion.AcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAcceptancesIDs(); len(nodes) > 0 && !_u.mutation.AcceptancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyversion.AcceptancesTable,
+			Columns: []string{policyversion.AcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AcceptancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyversion.AcceptancesTable,
+			Columns: []string{policyversion.AcceptancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PolicyVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
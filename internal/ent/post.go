@@ -14,6 +14,7 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/space"
 	"github.com/rs/xid"
 )
 
@@ -38,6 +39,8 @@ type Post struct {
 	Pinned bool `json:"pinned,omitempty"`
 	// LastReplyAt holds the value of the "last_reply_at" field.
 	LastReplyAt time.Time `json:"last_reply_at,omitempty"`
+	// AcceptedReplyID holds the value of the "accepted_reply_id" field.
+	AcceptedReplyID *xid.ID `json:"accepted_reply_id,omitempty"`
 	// RootPostID holds the value of the "root_post_id" field.
 	RootPostID *xid.ID `json:"root_post_id,omitempty"`
 	// ReplyToPostID holds the value of the "reply_to_post_id" field.
@@ -50,12 +53,16 @@ type Post struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// Visibility holds the value of the "visibility" field.
 	Visibility post.Visibility `json:"visibility,omitempty"`
+	// The likelihood, from 0 to 1, that the post is spam as scored by the spam checker when it was submitted.
+	SpamScore *float64 `json:"spam_score,omitempty"`
 	// AccountPosts holds the value of the "account_posts" field.
 	AccountPosts xid.ID `json:"account_posts,omitempty"`
 	// CategoryID holds the value of the "category_id" field.
 	CategoryID xid.ID `json:"category_id,omitempty"`
 	// LinkID holds the value of the "link_id" field.
 	LinkID xid.ID `json:"link_id,omitempty"`
+	// SpaceID holds the value of the "space_id" field.
+	SpaceID *xid.ID `json:"space_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostQuery when eager-loading is set.
 	Edges        PostEdges `json:"edges"`
@@ -68,6 +75,8 @@ type PostEdges struct {
 	Author *Account `json:"author,omitempty"`
 	// Category is only required for root posts. It should never be added to a child post.
 	Category *Category `json:"category,omitempty"`
+	// Space is only set on root posts, replies belong to their thread's space.
+	Space *Space `json:"space,omitempty"`
 	// Tags are only required for root posts. It should never be added to a child post.
 	Tags []*Tag `json:"tags,omitempty"`
 	// A many-to-many recursive self reference. The root post is the first post in the thread.
@@ -96,9 +105,15 @@ type PostEdges struct {
 	Event []*Event `json:"event,omitempty"`
 	// PostReads holds the value of the post_reads edge.
 	PostReads []*PostRead `json:"post_reads,omitempty"`
+	// DiscordLinks holds the value of the discord_links edge.
+	DiscordLinks []*DiscordBridgeLink `json:"discord_links,omitempty"`
+	// MatrixLinks holds the value of the matrix_links edge.
+	MatrixLinks []*MatrixBridgeLink `json:"matrix_links,omitempty"`
+	// TelegramMessages holds the value of the telegram_messages edge.
+	TelegramMessages []*TelegramMessage `json:"telegram_messages,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [16]bool
+	loadedTypes [20]bool
 }
 
 // AuthorOrErr returns the Author value or an error if the edge
@@ -123,10 +138,21 @@ func (e PostEdges) CategoryOrErr() (*Category, error) {
 	return nil, &NotLoadedError{edge: "category"}
 }
 
+// SpaceOrErr returns the Space value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) SpaceOrErr() (*Space, error) {
+	if e.Space != nil {
+		return e.Space, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: space.Label}
+	}
+	return nil, &NotLoadedError{edge: "space"}
+}
+
 // TagsOrErr returns the Tags value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) TagsOrErr() ([]*Tag, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Tags, nil
 	}
 	return nil, &NotLoadedError{edge: "tags"}
@@ -137,7 +163,7 @@ func (e PostEdges) TagsOrErr() ([]*Tag, error) {
 func (e PostEdges) RootOrErr() (*Post, error) {
 	if e.Root != nil {
 		return e.Root, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: post.Label}
 	}
 	return nil, &NotLoadedError{edge: "root"}
@@ -146,7 +172,7 @@ func (e PostEdges) RootOrErr() (*Post, error) {
 // PostsOrErr returns the Posts value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) PostsOrErr() ([]*Post, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Posts, nil
 	}
 	return nil, &NotLoadedError{edge: "posts"}
@@ -157,7 +183,7 @@ func (e PostEdges) PostsOrErr() ([]*Post, error) {
 func (e PostEdges) ReplyToOrErr() (*Post, error) {
 	if e.ReplyTo != nil {
 		return e.ReplyTo, nil
-	} else if e.loadedTypes[5] {
+	} else if e.loadedTypes[6] {
 		return nil, &NotFoundError{label: post.Label}
 	}
 	return nil, &NotLoadedError{edge: "replyTo"}
@@ -166,7 +192,7 @@ func (e PostEdges) ReplyToOrErr() (*Post, error) {
 // RepliesOrErr returns the Replies value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) RepliesOrErr() ([]*Post, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.Replies, nil
 	}
 	return nil, &NotLoadedError{edge: "replies"}
@@ -175,7 +201,7 @@ func (e PostEdges) RepliesOrErr() ([]*Post, error) {
 // ReactsOrErr returns the Reacts value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) ReactsOrErr() ([]*React, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[8] {
 		return e.Reacts, nil
 	}
 	return nil, &NotLoadedError{edge: "reacts"}
@@ -184,7 +210,7 @@ func (e PostEdges) ReactsOrErr() ([]*React, error) {
 // LikesOrErr returns the Likes value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) LikesOrErr() ([]*LikePost, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[9] {
 		return e.Likes, nil
 	}
 	return nil, &NotLoadedError{edge: "likes"}
@@ -193,7 +219,7 @@ func (e PostEdges) LikesOrErr() ([]*LikePost, error) {
 // MentionsOrErr returns the Mentions value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) MentionsOrErr() ([]*MentionProfile, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[10] {
 		return e.Mentions, nil
 	}
 	return nil, &NotLoadedError{edge: "mentions"}
@@ -202,7 +228,7 @@ func (e PostEdges) MentionsOrErr() ([]*MentionProfile, error) {
 // AssetsOrErr returns the Assets value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) AssetsOrErr() ([]*Asset, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[11] {
 		return e.Assets, nil
 	}
 	return nil, &NotLoadedError{edge: "assets"}
@@ -211,7 +237,7 @@ func (e PostEdges) AssetsOrErr() ([]*Asset, error) {
 // CollectionsOrErr returns the Collections value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) CollectionsOrErr() ([]*Collection, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[12] {
 		return e.Collections, nil
 	}
 	return nil, &NotLoadedError{edge: "collections"}
@@ -222,7 +248,7 @@ func (e PostEdges) CollectionsOrErr() ([]*Collection, error) {
 func (e PostEdges) LinkOrErr() (*Link, error) {
 	if e.Link != nil {
 		return e.Link, nil
-	} else if e.loadedTypes[12] {
+	} else if e.loadedTypes[13] {
 		return nil, &NotFoundError{label: link.Label}
 	}
 	return nil, &NotLoadedError{edge: "link"}
@@ -231,7 +257,7 @@ func (e PostEdges) LinkOrErr() (*Link, error) {
 // ContentLinksOrErr returns the ContentLinks value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) ContentLinksOrErr() ([]*Link, error) {
-	if e.loadedTypes[13] {
+	if e.loadedTypes[14] {
 		return e.ContentLinks, nil
 	}
 	return nil, &NotLoadedError{edge: "content_links"}
@@ -240,7 +266,7 @@ func (e PostEdges) ContentLinksOrErr() ([]*Link, error) {
 // EventOrErr returns the Event value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) EventOrErr() ([]*Event, error) {
-	if e.loadedTypes[14] {
+	if e.loadedTypes[15] {
 		return e.Event, nil
 	}
 	return nil, &NotLoadedError{edge: "event"}
@@ -249,23 +275,52 @@ func (e PostEdges) EventOrErr() ([]*Event, error) {
 // PostReadsOrErr returns the PostReads value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) PostReadsOrErr() ([]*PostRead, error) {
-	if e.loadedTypes[15] {
+	if e.loadedTypes[16] {
 		return e.PostReads, nil
 	}
 	return nil, &NotLoadedError{edge: "post_reads"}
 }
 
+// DiscordLinksOrErr returns the DiscordLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) DiscordLinksOrErr() ([]*DiscordBridgeLink, error) {
+	if e.loadedTypes[17] {
+		return e.DiscordLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "discord_links"}
+}
+
+// MatrixLinksOrErr returns the MatrixLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) MatrixLinksOrErr() ([]*MatrixBridgeLink, error) {
+	if e.loadedTypes[18] {
+		return e.MatrixLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "matrix_links"}
+}
+
+// TelegramMessagesOrErr returns the TelegramMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) TelegramMessagesOrErr() ([]*TelegramMessage, error) {
+	if e.loadedTypes[19] {
+		return e.TelegramMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "telegram_messages"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldRootPostID, post.FieldReplyToPostID:
+		case post.FieldAcceptedReplyID, post.FieldRootPostID, post.FieldReplyToPostID, post.FieldSpaceID:
 			values[i] = &sql.NullScanner{S: new(xid.ID)}
 		case post.FieldMetadata:
 			values[i] = new([]byte)
 		case post.FieldPinned:
 			values[i] = new(sql.NullBool)
+		case post.FieldSpamScore:
+			values[i] = new(sql.NullFloat64)
 		case post.FieldTitle, post.FieldSlug, post.FieldBody, post.FieldShort, post.FieldVisibility:
 			values[i] = new(sql.NullString)
 		case post.FieldCreatedAt, post.FieldUpdatedAt, post.FieldDeletedAt, post.FieldIndexedAt, post.FieldLastReplyAt:
@@ -343,6 +398,13 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LastReplyAt = value.Time
 			}
+		case post.FieldAcceptedReplyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_reply_id", values[i])
+			} else if value.Valid {
+				_m.AcceptedReplyID = new(xid.ID)
+				*_m.AcceptedReplyID = *value.S.(*xid.ID)
+			}
 		case post.FieldRootPostID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field root_post_id", values[i])
@@ -383,6 +445,13 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Visibility = post.Visibility(value.String)
 			}
+		case post.FieldSpamScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_score", values[i])
+			} else if value.Valid {
+				_m.SpamScore = new(float64)
+				*_m.SpamScore = value.Float64
+			}
 		case post.FieldAccountPosts:
 			if value, ok := values[i].(*xid.ID); !ok {
 				return fmt.Errorf("unexpected type %T for field account_posts", values[i])
@@ -401,6 +470,13 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.LinkID = *value
 			}
+		case post.FieldSpaceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field space_id", values[i])
+			} else if value.Valid {
+				_m.SpaceID = new(xid.ID)
+				*_m.SpaceID = *value.S.(*xid.ID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -424,6 +500,11 @@ func (_m *Post) QueryCategory() *CategoryQuery {
 	return NewPostClient(_m.config).QueryCategory(_m)
 }
 
+// QuerySpace queries the "space" edge of the Post entity.
+func (_m *Post) QuerySpace() *SpaceQuery {
+	return NewPostClient(_m.config).QuerySpace(_m)
+}
+
 // QueryTags queries the "tags" edge of the Post entity.
 func (_m *Post) QueryTags() *TagQuery {
 	return NewPostClient(_m.config).QueryTags(_m)
@@ -494,6 +575,21 @@ func (_m *Post) QueryPostReads() *PostReadQuery {
 	return NewPostClient(_m.config).QueryPostReads(_m)
 }
 
+// QueryDiscordLinks queries the "discord_links" edge of the Post entity.
+func (_m *Post) QueryDiscordLinks() *DiscordBridgeLinkQuery {
+	return NewPostClient(_m.config).QueryDiscordLinks(_m)
+}
+
+// QueryMatrixLinks queries the "matrix_links" edge of the Post entity.
+func (_m *Post) QueryMatrixLinks() *MatrixBridgeLinkQuery {
+	return NewPostClient(_m.config).QueryMatrixLinks(_m)
+}
+
+// QueryTelegramMessages queries the "telegram_messages" edge of the Post entity.
+func (_m *Post) QueryTelegramMessages() *TelegramMessageQuery {
+	return NewPostClient(_m.config).QueryTelegramMessages(_m)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -545,6 +641,11 @@ func (_m *Post) String() string {
 	builder.WriteString("last_reply_at=")
 	builder.WriteString(_m.LastReplyAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.AcceptedReplyID; v != nil {
+		builder.WriteString("accepted_reply_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.RootPostID; v != nil {
 		builder.WriteString("root_post_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -567,6 +668,11 @@ func (_m *Post) String() string {
 	builder.WriteString("visibility=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
 	builder.WriteString(", ")
+	if v := _m.SpamScore; v != nil {
+		builder.WriteString("spam_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("account_posts=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AccountPosts))
 	builder.WriteString(", ")
@@ -575,6 +681,11 @@ func (_m *Post) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("link_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LinkID))
+	builder.WriteString(", ")
+	if v := _m.SpaceID; v != nil {
+		builder.WriteString("space_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,8 @@ const (
 	FieldPinned = "pinned"
 	// FieldLastReplyAt holds the string denoting the last_reply_at field in the database.
 	FieldLastReplyAt = "last_reply_at"
+	// FieldAcceptedReplyID holds the string denoting the accepted_reply_id field in the database.
+	FieldAcceptedReplyID = "accepted_reply_id"
 	// FieldRootPostID holds the string denoting the root_post_id field in the database.
 	FieldRootPostID = "root_post_id"
 	// FieldReplyToPostID holds the string denoting the reply_to_post_id field in the database.
@@ -44,16 +46,22 @@ const (
 	FieldMetadata = "metadata"
 	// FieldVisibility holds the string denoting the visibility field in the database.
 	FieldVisibility = "visibility"
+	// FieldSpamScore holds the string denoting the spam_score field in the database.
+	FieldSpamScore = "spam_score"
 	// FieldAccountPosts holds the string denoting the account_posts field in the database.
 	FieldAccountPosts = "account_posts"
 	// FieldCategoryID holds the string denoting the category_id field in the database.
 	FieldCategoryID = "category_id"
 	// FieldLinkID holds the string denoting the link_id field in the database.
 	FieldLinkID = "link_id"
+	// FieldSpaceID holds the string denoting the space_id field in the database.
+	FieldSpaceID = "space_id"
 	// EdgeAuthor holds the string denoting the author edge name in mutations.
 	EdgeAuthor = "author"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
+	// EdgeSpace holds the string denoting the space edge name in mutations.
+	EdgeSpace = "space"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
 	// EdgeRoot holds the string denoting the root edge name in mutations.
@@ -82,6 +90,12 @@ const (
 	EdgeEvent = "event"
 	// EdgePostReads holds the string denoting the post_reads edge name in mutations.
 	EdgePostReads = "post_reads"
+	// EdgeDiscordLinks holds the string denoting the discord_links edge name in mutations.
+	EdgeDiscordLinks = "discord_links"
+	// EdgeMatrixLinks holds the string denoting the matrix_links edge name in mutations.
+	EdgeMatrixLinks = "matrix_links"
+	// EdgeTelegramMessages holds the string denoting the telegram_messages edge name in mutations.
+	EdgeTelegramMessages = "telegram_messages"
 	// Table holds the table name of the post in the database.
 	Table = "posts"
 	// AuthorTable is the table that holds the author relation/edge.
@@ -98,6 +112,13 @@ const (
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
 	CategoryColumn = "category_id"
+	// SpaceTable is the table that holds the space relation/edge.
+	SpaceTable = "posts"
+	// SpaceInverseTable is the table name for the Space entity.
+	// It exists in this package in order to avoid circular dependency with the "space" package.
+	SpaceInverseTable = "spaces"
+	// SpaceColumn is the table column denoting the space relation/edge.
+	SpaceColumn = "space_id"
 	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
 	TagsTable = "tag_posts"
 	// TagsInverseTable is the table name for the Tag entity.
@@ -176,6 +197,27 @@ const (
 	PostReadsInverseTable = "post_reads"
 	// PostReadsColumn is the table column denoting the post_reads relation/edge.
 	PostReadsColumn = "root_post_id"
+	// DiscordLinksTable is the table that holds the discord_links relation/edge.
+	DiscordLinksTable = "discord_bridge_links"
+	// DiscordLinksInverseTable is the table name for the DiscordBridgeLink entity.
+	// It exists in this package in order to avoid circular dependency with the "discordbridgelink" package.
+	DiscordLinksInverseTable = "discord_bridge_links"
+	// DiscordLinksColumn is the table column denoting the discord_links relation/edge.
+	DiscordLinksColumn = "post_id"
+	// MatrixLinksTable is the table that holds the matrix_links relation/edge.
+	MatrixLinksTable = "matrix_bridge_links"
+	// MatrixLinksInverseTable is the table name for the MatrixBridgeLink entity.
+	// It exists in this package in order to avoid circular dependency with the "matrixbridgelink" package.
+	MatrixLinksInverseTable = "matrix_bridge_links"
+	// MatrixLinksColumn is the table column denoting the matrix_links relation/edge.
+	MatrixLinksColumn = "post_id"
+	// TelegramMessagesTable is the table that holds the telegram_messages relation/edge.
+	TelegramMessagesTable = "telegram_messages"
+	// TelegramMessagesInverseTable is the table name for the TelegramMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "telegrammessage" package.
+	TelegramMessagesInverseTable = "telegram_messages"
+	// TelegramMessagesColumn is the table column denoting the telegram_messages relation/edge.
+	TelegramMessagesColumn = "post_id"
 )
 
 // Columns holds all SQL columns for post fields.
@@ -189,15 +231,18 @@ var Columns = []string{
 	FieldSlug,
 	FieldPinned,
 	FieldLastReplyAt,
+	FieldAcceptedReplyID,
 	FieldRootPostID,
 	FieldReplyToPostID,
 	FieldBody,
 	FieldShort,
 	FieldMetadata,
 	FieldVisibility,
+	FieldSpamScore,
 	FieldAccountPosts,
 	FieldCategoryID,
 	FieldLinkID,
+	FieldSpaceID,
 }
 
 var (
@@ -312,6 +357,11 @@ func ByLastReplyAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastReplyAt, opts...).ToFunc()
 }
 
+// ByAcceptedReplyID orders the results by the accepted_reply_id field.
+func ByAcceptedReplyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedReplyID, opts...).ToFunc()
+}
+
 // ByRootPostID orders the results by the root_post_id field.
 func ByRootPostID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRootPostID, opts...).ToFunc()
@@ -337,6 +387,11 @@ func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
 }
 
+// BySpamScore orders the results by the spam_score field.
+func BySpamScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpamScore, opts...).ToFunc()
+}
+
 // ByAccountPosts orders the results by the account_posts field.
 func ByAccountPosts(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccountPosts, opts...).ToFunc()
@@ -352,6 +407,11 @@ func ByLinkID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLinkID, opts...).ToFunc()
 }
 
+// BySpaceID orders the results by the space_id field.
+func BySpaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpaceID, opts...).ToFunc()
+}
+
 // ByAuthorField orders the results by author field.
 func ByAuthorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -366,6 +426,13 @@ func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// BySpaceField orders the results by space field.
+func BySpaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSpaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByTagsCount orders the results by tags count.
 func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -540,6 +607,48 @@ func ByPostReads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPostReadsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDiscordLinksCount orders the results by discord_links count.
+func ByDiscordLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscordLinksStep(), opts...)
+	}
+}
+
+// ByDiscordLinks orders the results by discord_links terms.
+func ByDiscordLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscordLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMatrixLinksCount orders the results by matrix_links count.
+func ByMatrixLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMatrixLinksStep(), opts...)
+	}
+}
+
+// ByMatrixLinks orders the results by matrix_links terms.
+func ByMatrixLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatrixLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTelegramMessagesCount orders the results by telegram_messages count.
+func ByTelegramMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTelegramMessagesStep(), opts...)
+	}
+}
+
+// ByTelegramMessages orders the results by telegram_messages terms.
+func ByTelegramMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTelegramMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAuthorStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -554,6 +663,13 @@ func newCategoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
 	)
 }
+func newSpaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SpaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SpaceTable, SpaceColumn),
+	)
+}
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -652,3 +768,24 @@ func newPostReadsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PostReadsTable, PostReadsColumn),
 	)
 }
+func newDiscordLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscordLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DiscordLinksTable, DiscordLinksColumn),
+	)
+}
+func newMatrixLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MatrixLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MatrixLinksTable, MatrixLinksColumn),
+	)
+}
+func newTelegramMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TelegramMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TelegramMessagesTable, TelegramMessagesColumn),
+	)
+}
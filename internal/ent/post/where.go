@@ -96,6 +96,11 @@ func LastReplyAt(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldLastReplyAt, v))
 }
 
+// AcceptedReplyID applies equality check predicate on the "accepted_reply_id" field. It's identical to AcceptedReplyIDEQ.
+func AcceptedReplyID(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAcceptedReplyID, v))
+}
+
 // RootPostID applies equality check predicate on the "root_post_id" field. It's identical to RootPostIDEQ.
 func RootPostID(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldRootPostID, v))
@@ -116,6 +121,11 @@ func Short(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldShort, v))
 }
 
+// SpamScore applies equality check predicate on the "spam_score" field. It's identical to SpamScoreEQ.
+func SpamScore(v float64) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSpamScore, v))
+}
+
 // AccountPosts applies equality check predicate on the "account_posts" field. It's identical to AccountPostsEQ.
 func AccountPosts(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldAccountPosts, v))
@@ -131,6 +141,11 @@ func LinkID(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldLinkID, v))
 }
 
+// SpaceID applies equality check predicate on the "space_id" field. It's identical to SpaceIDEQ.
+func SpaceID(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSpaceID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldCreatedAt, v))
@@ -511,6 +526,86 @@ func LastReplyAtLTE(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldLTE(FieldLastReplyAt, v))
 }
 
+// AcceptedReplyIDEQ applies the EQ predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAcceptedReplyID, v))
+}
+
+// AcceptedReplyIDNEQ applies the NEQ predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDNEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldAcceptedReplyID, v))
+}
+
+// AcceptedReplyIDIn applies the In predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldAcceptedReplyID, vs...))
+}
+
+// AcceptedReplyIDNotIn applies the NotIn predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDNotIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldAcceptedReplyID, vs...))
+}
+
+// AcceptedReplyIDGT applies the GT predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDGT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldAcceptedReplyID, v))
+}
+
+// AcceptedReplyIDGTE applies the GTE predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDGTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldAcceptedReplyID, v))
+}
+
+// AcceptedReplyIDLT applies the LT predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDLT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldAcceptedReplyID, v))
+}
+
+// AcceptedReplyIDLTE applies the LTE predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDLTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldAcceptedReplyID, v))
+}
+
+// AcceptedReplyIDContains applies the Contains predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDContains(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContains(FieldAcceptedReplyID, vc))
+}
+
+// AcceptedReplyIDHasPrefix applies the HasPrefix predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDHasPrefix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasPrefix(FieldAcceptedReplyID, vc))
+}
+
+// AcceptedReplyIDHasSuffix applies the HasSuffix predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDHasSuffix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasSuffix(FieldAcceptedReplyID, vc))
+}
+
+// AcceptedReplyIDIsNil applies the IsNil predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldAcceptedReplyID))
+}
+
+// AcceptedReplyIDNotNil applies the NotNil predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldAcceptedReplyID))
+}
+
+// AcceptedReplyIDEqualFold applies the EqualFold predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDEqualFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldEqualFold(FieldAcceptedReplyID, vc))
+}
+
+// AcceptedReplyIDContainsFold applies the ContainsFold predicate on the "accepted_reply_id" field.
+func AcceptedReplyIDContainsFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContainsFold(FieldAcceptedReplyID, vc))
+}
+
 // RootPostIDEQ applies the EQ predicate on the "root_post_id" field.
 func RootPostIDEQ(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldRootPostID, v))
@@ -831,6 +926,56 @@ func VisibilityNotIn(vs ...Visibility) predicate.Post {
 	return predicate.Post(sql.FieldNotIn(FieldVisibility, vs...))
 }
 
+// SpamScoreEQ applies the EQ predicate on the "spam_score" field.
+func SpamScoreEQ(v float64) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSpamScore, v))
+}
+
+// SpamScoreNEQ applies the NEQ predicate on the "spam_score" field.
+func SpamScoreNEQ(v float64) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldSpamScore, v))
+}
+
+// SpamScoreIn applies the In predicate on the "spam_score" field.
+func SpamScoreIn(vs ...float64) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldSpamScore, vs...))
+}
+
+// SpamScoreNotIn applies the NotIn predicate on the "spam_score" field.
+func SpamScoreNotIn(vs ...float64) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldSpamScore, vs...))
+}
+
+// SpamScoreGT applies the GT predicate on the "spam_score" field.
+func SpamScoreGT(v float64) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldSpamScore, v))
+}
+
+// SpamScoreGTE applies the GTE predicate on the "spam_score" field.
+func SpamScoreGTE(v float64) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldSpamScore, v))
+}
+
+// SpamScoreLT applies the LT predicate on the "spam_score" field.
+func SpamScoreLT(v float64) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldSpamScore, v))
+}
+
+// SpamScoreLTE applies the LTE predicate on the "spam_score" field.
+func SpamScoreLTE(v float64) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldSpamScore, v))
+}
+
+// SpamScoreIsNil applies the IsNil predicate on the "spam_score" field.
+func SpamScoreIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldSpamScore))
+}
+
+// SpamScoreNotNil applies the NotNil predicate on the "spam_score" field.
+func SpamScoreNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldSpamScore))
+}
+
 // AccountPostsEQ applies the EQ predicate on the "account_posts" field.
 func AccountPostsEQ(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldAccountPosts, v))
@@ -1061,6 +1206,86 @@ func LinkIDContainsFold(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldContainsFold(FieldLinkID, vc))
 }
 
+// SpaceIDEQ applies the EQ predicate on the "space_id" field.
+func SpaceIDEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSpaceID, v))
+}
+
+// SpaceIDNEQ applies the NEQ predicate on the "space_id" field.
+func SpaceIDNEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldSpaceID, v))
+}
+
+// SpaceIDIn applies the In predicate on the "space_id" field.
+func SpaceIDIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldSpaceID, vs...))
+}
+
+// SpaceIDNotIn applies the NotIn predicate on the "space_id" field.
+func SpaceIDNotIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldSpaceID, vs...))
+}
+
+// SpaceIDGT applies the GT predicate on the "space_id" field.
+func SpaceIDGT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldSpaceID, v))
+}
+
+// SpaceIDGTE applies the GTE predicate on the "space_id" field.
+func SpaceIDGTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldSpaceID, v))
+}
+
+// SpaceIDLT applies the LT predicate on the "space_id" field.
+func SpaceIDLT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldSpaceID, v))
+}
+
+// SpaceIDLTE applies the LTE predicate on the "space_id" field.
+func SpaceIDLTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldSpaceID, v))
+}
+
+// SpaceIDContains applies the Contains predicate on the "space_id" field.
+func SpaceIDContains(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContains(FieldSpaceID, vc))
+}
+
+// SpaceIDHasPrefix applies the HasPrefix predicate on the "space_id" field.
+func SpaceIDHasPrefix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasPrefix(FieldSpaceID, vc))
+}
+
+// SpaceIDHasSuffix applies the HasSuffix predicate on the "space_id" field.
+func SpaceIDHasSuffix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasSuffix(FieldSpaceID, vc))
+}
+
+// SpaceIDIsNil applies the IsNil predicate on the "space_id" field.
+func SpaceIDIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldSpaceID))
+}
+
+// SpaceIDNotNil applies the NotNil predicate on the "space_id" field.
+func SpaceIDNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldSpaceID))
+}
+
+// SpaceIDEqualFold applies the EqualFold predicate on the "space_id" field.
+func SpaceIDEqualFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldEqualFold(FieldSpaceID, vc))
+}
+
+// SpaceIDContainsFold applies the ContainsFold predicate on the "space_id" field.
+func SpaceIDContainsFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContainsFold(FieldSpaceID, vc))
+}
+
 // HasAuthor applies the HasEdge predicate on the "author" edge.
 func HasAuthor() predicate.Post {
 	return predicate.Post(func(s *sql.Selector) {
@@ -1107,6 +1332,29 @@ func HasCategoryWith(preds ...predicate.Category) predicate.Post {
 	})
 }
 
+// HasSpace applies the HasEdge predicate on the "space" edge.
+func HasSpace() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SpaceTable, SpaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSpaceWith applies the HasEdge predicate on the "space" edge with a given conditions (other predicates).
+func HasSpaceWith(preds ...predicate.Space) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newSpaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTags applies the HasEdge predicate on the "tags" edge.
 func HasTags() predicate.Post {
 	return predicate.Post(func(s *sql.Selector) {
@@ -1429,6 +1677,75 @@ func HasPostReadsWith(preds ...predicate.PostRead) predicate.Post {
 	})
 }
 
+// HasDiscordLinks applies the HasEdge predicate on the "discord_links" edge.
+func HasDiscordLinks() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DiscordLinksTable, DiscordLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscordLinksWith applies the HasEdge predicate on the "discord_links" edge with a given conditions (other predicates).
+func HasDiscordLinksWith(preds ...predicate.DiscordBridgeLink) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newDiscordLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMatrixLinks applies the HasEdge predicate on the "matrix_links" edge.
+func HasMatrixLinks() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MatrixLinksTable, MatrixLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatrixLinksWith applies the HasEdge predicate on the "matrix_links" edge with a given conditions (other predicates).
+func HasMatrixLinksWith(preds ...predicate.MatrixBridgeLink) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newMatrixLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTelegramMessages applies the HasEdge predicate on the "telegram_messages" edge.
+func HasTelegramMessages() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TelegramMessagesTable, TelegramMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTelegramMessagesWith applies the HasEdge predicate on the "telegram_messages" edge with a given conditions (other predicates).
+func HasTelegramMessagesWith(preds ...predicate.TelegramMessage) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newTelegramMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Post) predicate.Post {
 	return predicate.Post(sql.AndPredicates(predicates...))
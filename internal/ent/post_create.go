@@ -16,14 +16,18 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/space"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
 	"github.com/rs/xid"
 )
 
@@ -131,6 +135,20 @@ func (_c *PostCreate) SetLastReplyAt(v time.Time) *PostCreate {
 	return _c
 }
 
+// SetAcceptedReplyID sets the "accepted_reply_id" field.
+func (_c *PostCreate) SetAcceptedReplyID(v xid.ID) *PostCreate {
+	_c.mutation.SetAcceptedReplyID(v)
+	return _c
+}
+
+// SetNillableAcceptedReplyID sets the "accepted_reply_id" field if the given value is not nil.
+func (_c *PostCreate) SetNillableAcceptedReplyID(v *xid.ID) *PostCreate {
+	if v != nil {
+		_c.SetAcceptedReplyID(*v)
+	}
+	return _c
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (_c *PostCreate) SetRootPostID(v xid.ID) *PostCreate {
 	_c.mutation.SetRootPostID(v)
@@ -191,6 +209,20 @@ func (_c *PostCreate) SetNillableVisibility(v *post.Visibility) *PostCreate {
 	return _c
 }
 
+// SetSpamScore sets the "spam_score" field.
+func (_c *PostCreate) SetSpamScore(v float64) *PostCreate {
+	_c.mutation.SetSpamScore(v)
+	return _c
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (_c *PostCreate) SetNillableSpamScore(v *float64) *PostCreate {
+	if v != nil {
+		_c.SetSpamScore(*v)
+	}
+	return _c
+}
+
 // SetAccountPosts sets the "account_posts" field.
 func (_c *PostCreate) SetAccountPosts(v xid.ID) *PostCreate {
 	_c.mutation.SetAccountPosts(v)
@@ -225,6 +257,20 @@ func (_c *PostCreate) SetNillableLinkID(v *xid.ID) *PostCreate {
 	return _c
 }
 
+// SetSpaceID sets the "space_id" field.
+func (_c *PostCreate) SetSpaceID(v xid.ID) *PostCreate {
+	_c.mutation.SetSpaceID(v)
+	return _c
+}
+
+// SetNillableSpaceID sets the "space_id" field if the given value is not nil.
+func (_c *PostCreate) SetNillableSpaceID(v *xid.ID) *PostCreate {
+	if v != nil {
+		_c.SetSpaceID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PostCreate) SetID(v xid.ID) *PostCreate {
 	_c.mutation.SetID(v)
@@ -255,6 +301,11 @@ func (_c *PostCreate) SetCategory(v *Category) *PostCreate {
 	return _c.SetCategoryID(v.ID)
 }
 
+// SetSpace sets the "space" edge to the Space entity.
+func (_c *PostCreate) SetSpace(v *Space) *PostCreate {
+	return _c.SetSpaceID(v.ID)
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_c *PostCreate) AddTagIDs(ids ...xid.ID) *PostCreate {
 	_c.mutation.AddTagIDs(ids...)
@@ -463,6 +514,51 @@ func (_c *PostCreate) AddPostReads(v ...*PostRead) *PostCreate {
 	return _c.AddPostReadIDs(ids...)
 }
 
+// AddDiscordLinkIDs adds the "discord_links" edge to the DiscordBridgeLink entity by IDs.
+func (_c *PostCreate) AddDiscordLinkIDs(ids ...xid.ID) *PostCreate {
+	_c.mutation.AddDiscordLinkIDs(ids...)
+	return _c
+}
+
+// AddDiscordLinks adds the "discord_links" edges to the DiscordBridgeLink entity.
+func (_c *PostCreate) AddDiscordLinks(v ...*DiscordBridgeLink) *PostCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDiscordLinkIDs(ids...)
+}
+
+// AddMatrixLinkIDs adds the "matrix_links" edge to the MatrixBridgeLink entity by IDs.
+func (_c *PostCreate) AddMatrixLinkIDs(ids ...xid.ID) *PostCreate {
+	_c.mutation.AddMatrixLinkIDs(ids...)
+	return _c
+}
+
+// AddMatrixLinks adds the "matrix_links" edges to the MatrixBridgeLink entity.
+func (_c *PostCreate) AddMatrixLinks(v ...*MatrixBridgeLink) *PostCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMatrixLinkIDs(ids...)
+}
+
+// AddTelegramMessageIDs adds the "telegram_messages" edge to the TelegramMessage entity by IDs.
+func (_c *PostCreate) AddTelegramMessageIDs(ids ...xid.ID) *PostCreate {
+	_c.mutation.AddTelegramMessageIDs(ids...)
+	return _c
+}
+
+// AddTelegramMessages adds the "telegram_messages" edges to the TelegramMessage entity.
+func (_c *PostCreate) AddTelegramMessages(v ...*TelegramMessage) *PostCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTelegramMessageIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_c *PostCreate) Mutation() *PostMutation {
 	return _c.mutation
@@ -623,6 +719,10 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldLastReplyAt, field.TypeTime, value)
 		_node.LastReplyAt = value
 	}
+	if value, ok := _c.mutation.AcceptedReplyID(); ok {
+		_spec.SetField(post.FieldAcceptedReplyID, field.TypeString, value)
+		_node.AcceptedReplyID = &value
+	}
 	if value, ok := _c.mutation.Body(); ok {
 		_spec.SetField(post.FieldBody, field.TypeString, value)
 		_node.Body = value
@@ -639,6 +739,10 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldVisibility, field.TypeEnum, value)
 		_node.Visibility = value
 	}
+	if value, ok := _c.mutation.SpamScore(); ok {
+		_spec.SetField(post.FieldSpamScore, field.TypeFloat64, value)
+		_node.SpamScore = &value
+	}
 	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -673,6 +777,23 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node.CategoryID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SpaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.SpaceTable,
+			Columns: []string{post.SpaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(space.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SpaceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -900,6 +1021,54 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.DiscordLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DiscordLinksTable,
+			Columns: []string{post.DiscordLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MatrixLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MatrixLinksTable,
+			Columns: []string{post.MatrixLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TelegramMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TelegramMessagesTable,
+			Columns: []string{post.TelegramMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -1060,6 +1229,24 @@ func (u *PostUpsert) UpdateLastReplyAt() *PostUpsert {
 	return u
 }
 
+// SetAcceptedReplyID sets the "accepted_reply_id" field.
+func (u *PostUpsert) SetAcceptedReplyID(v xid.ID) *PostUpsert {
+	u.Set(post.FieldAcceptedReplyID, v)
+	return u
+}
+
+// UpdateAcceptedReplyID sets the "accepted_reply_id" field to the value that was provided on create.
+func (u *PostUpsert) UpdateAcceptedReplyID() *PostUpsert {
+	u.SetExcluded(post.FieldAcceptedReplyID)
+	return u
+}
+
+// ClearAcceptedReplyID clears the value of the "accepted_reply_id" field.
+func (u *PostUpsert) ClearAcceptedReplyID() *PostUpsert {
+	u.SetNull(post.FieldAcceptedReplyID)
+	return u
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (u *PostUpsert) SetRootPostID(v xid.ID) *PostUpsert {
 	u.Set(post.FieldRootPostID, v)
@@ -1150,6 +1337,30 @@ func (u *PostUpsert) UpdateVisibility() *PostUpsert {
 	return u
 }
 
+// SetSpamScore sets the "spam_score" field.
+func (u *PostUpsert) SetSpamScore(v float64) *PostUpsert {
+	u.Set(post.FieldSpamScore, v)
+	return u
+}
+
+// UpdateSpamScore sets the "spam_score" field to the value that was provided on create.
+func (u *PostUpsert) UpdateSpamScore() *PostUpsert {
+	u.SetExcluded(post.FieldSpamScore)
+	return u
+}
+
+// AddSpamScore adds v to the "spam_score" field.
+func (u *PostUpsert) AddSpamScore(v float64) *PostUpsert {
+	u.Add(post.FieldSpamScore, v)
+	return u
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (u *PostUpsert) ClearSpamScore() *PostUpsert {
+	u.SetNull(post.FieldSpamScore)
+	return u
+}
+
 // SetAccountPosts sets the "account_posts" field.
 func (u *PostUpsert) SetAccountPosts(v xid.ID) *PostUpsert {
 	u.Set(post.FieldAccountPosts, v)
@@ -1198,6 +1409,24 @@ func (u *PostUpsert) ClearLinkID() *PostUpsert {
 	return u
 }
 
+// SetSpaceID sets the "space_id" field.
+func (u *PostUpsert) SetSpaceID(v xid.ID) *PostUpsert {
+	u.Set(post.FieldSpaceID, v)
+	return u
+}
+
+// UpdateSpaceID sets the "space_id" field to the value that was provided on create.
+func (u *PostUpsert) UpdateSpaceID() *PostUpsert {
+	u.SetExcluded(post.FieldSpaceID)
+	return u
+}
+
+// ClearSpaceID clears the value of the "space_id" field.
+func (u *PostUpsert) ClearSpaceID() *PostUpsert {
+	u.SetNull(post.FieldSpaceID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1375,6 +1604,27 @@ func (u *PostUpsertOne) UpdateLastReplyAt() *PostUpsertOne {
 	})
 }
 
+// SetAcceptedReplyID sets the "accepted_reply_id" field.
+func (u *PostUpsertOne) SetAcceptedReplyID(v xid.ID) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetAcceptedReplyID(v)
+	})
+}
+
+// UpdateAcceptedReplyID sets the "accepted_reply_id" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateAcceptedReplyID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateAcceptedReplyID()
+	})
+}
+
+// ClearAcceptedReplyID clears the value of the "accepted_reply_id" field.
+func (u *PostUpsertOne) ClearAcceptedReplyID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearAcceptedReplyID()
+	})
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (u *PostUpsertOne) SetRootPostID(v xid.ID) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -1480,6 +1730,34 @@ func (u *PostUpsertOne) UpdateVisibility() *PostUpsertOne {
 	})
 }
 
+// SetSpamScore sets the "spam_score" field.
+func (u *PostUpsertOne) SetSpamScore(v float64) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSpamScore(v)
+	})
+}
+
+// AddSpamScore adds v to the "spam_score" field.
+func (u *PostUpsertOne) AddSpamScore(v float64) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddSpamScore(v)
+	})
+}
+
+// UpdateSpamScore sets the "spam_score" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateSpamScore() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSpamScore()
+	})
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (u *PostUpsertOne) ClearSpamScore() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSpamScore()
+	})
+}
+
 // SetAccountPosts sets the "account_posts" field.
 func (u *PostUpsertOne) SetAccountPosts(v xid.ID) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -1536,6 +1814,27 @@ func (u *PostUpsertOne) ClearLinkID() *PostUpsertOne {
 	})
 }
 
+// SetSpaceID sets the "space_id" field.
+func (u *PostUpsertOne) SetSpaceID(v xid.ID) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSpaceID(v)
+	})
+}
+
+// UpdateSpaceID sets the "space_id" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateSpaceID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSpaceID()
+	})
+}
+
+// ClearSpaceID clears the value of the "space_id" field.
+func (u *PostUpsertOne) ClearSpaceID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSpaceID()
+	})
+}
+
 // Exec executes the query.
 func (u *PostUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1880,6 +2179,27 @@ func (u *PostUpsertBulk) UpdateLastReplyAt() *PostUpsertBulk {
 	})
 }
 
+// SetAcceptedReplyID sets the "accepted_reply_id" field.
+func (u *PostUpsertBulk) SetAcceptedReplyID(v xid.ID) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetAcceptedReplyID(v)
+	})
+}
+
+// UpdateAcceptedReplyID sets the "accepted_reply_id" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateAcceptedReplyID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateAcceptedReplyID()
+	})
+}
+
+// ClearAcceptedReplyID clears the value of the "accepted_reply_id" field.
+func (u *PostUpsertBulk) ClearAcceptedReplyID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearAcceptedReplyID()
+	})
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (u *PostUpsertBulk) SetRootPostID(v xid.ID) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
@@ -1985,6 +2305,34 @@ func (u *PostUpsertBulk) UpdateVisibility() *PostUpsertBulk {
 	})
 }
 
+// SetSpamScore sets the "spam_score" field.
+func (u *PostUpsertBulk) SetSpamScore(v float64) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSpamScore(v)
+	})
+}
+
+// AddSpamScore adds v to the "spam_score" field.
+func (u *PostUpsertBulk) AddSpamScore(v float64) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddSpamScore(v)
+	})
+}
+
+// UpdateSpamScore sets the "spam_score" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateSpamScore() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSpamScore()
+	})
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (u *PostUpsertBulk) ClearSpamScore() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSpamScore()
+	})
+}
+
 // SetAccountPosts sets the "account_posts" field.
 func (u *PostUpsertBulk) SetAccountPosts(v xid.ID) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
@@ -2041,6 +2389,27 @@ func (u *PostUpsertBulk) ClearLinkID() *PostUpsertBulk {
 	})
 }
 
+// SetSpaceID sets the "space_id" field.
+func (u *PostUpsertBulk) SetSpaceID(v xid.ID) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSpaceID(v)
+	})
+}
+
+// UpdateSpaceID sets the "space_id" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateSpaceID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSpaceID()
+	})
+}
+
+// ClearSpaceID clears the value of the "space_id" field.
+func (u *PostUpsertBulk) ClearSpaceID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSpaceID()
+	})
+}
+
 // Exec executes the query.
 func (u *PostUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
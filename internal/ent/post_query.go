@@ -16,42 +16,50 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/space"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
 	"github.com/rs/xid"
 )
 
 // PostQuery is the builder for querying Post entities.
 type PostQuery struct {
 	config
-	ctx              *QueryContext
-	order            []post.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Post
-	withAuthor       *AccountQuery
-	withCategory     *CategoryQuery
-	withTags         *TagQuery
-	withRoot         *PostQuery
-	withPosts        *PostQuery
-	withReplyTo      *PostQuery
-	withReplies      *PostQuery
-	withReacts       *ReactQuery
-	withLikes        *LikePostQuery
-	withMentions     *MentionProfileQuery
-	withAssets       *AssetQuery
-	withCollections  *CollectionQuery
-	withLink         *LinkQuery
-	withContentLinks *LinkQuery
-	withEvent        *EventQuery
-	withPostReads    *PostReadQuery
-	modifiers        []func(*sql.Selector)
+	ctx                  *QueryContext
+	order                []post.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Post
+	withAuthor           *AccountQuery
+	withCategory         *CategoryQuery
+	withSpace            *SpaceQuery
+	withTags             *TagQuery
+	withRoot             *PostQuery
+	withPosts            *PostQuery
+	withReplyTo          *PostQuery
+	withReplies          *PostQuery
+	withReacts           *ReactQuery
+	withLikes            *LikePostQuery
+	withMentions         *MentionProfileQuery
+	withAssets           *AssetQuery
+	withCollections      *CollectionQuery
+	withLink             *LinkQuery
+	withContentLinks     *LinkQuery
+	withEvent            *EventQuery
+	withPostReads        *PostReadQuery
+	withDiscordLinks     *DiscordBridgeLinkQuery
+	withMatrixLinks      *MatrixBridgeLinkQuery
+	withTelegramMessages *TelegramMessageQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +140,28 @@ func (_q *PostQuery) QueryCategory() *CategoryQuery {
 	return query
 }
 
+// QuerySpace chains the current query on the "space" edge.
+func (_q *PostQuery) QuerySpace() *SpaceQuery {
+	query := (&SpaceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(space.Table, space.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.SpaceTable, post.SpaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTags chains the current query on the "tags" edge.
 func (_q *PostQuery) QueryTags() *TagQuery {
 	query := (&TagClient{config: _q.config}).Query()
@@ -440,6 +470,72 @@ func (_q *PostQuery) QueryPostReads() *PostReadQuery {
 	return query
 }
 
+// QueryDiscordLinks chains the current query on the "discord_links" edge.
+func (_q *PostQuery) QueryDiscordLinks() *DiscordBridgeLinkQuery {
+	query := (&DiscordBridgeLinkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(discordbridgelink.Table, discordbridgelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.DiscordLinksTable, post.DiscordLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMatrixLinks chains the current query on the "matrix_links" edge.
+func (_q *PostQuery) QueryMatrixLinks() *MatrixBridgeLinkQuery {
+	query := (&MatrixBridgeLinkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(matrixbridgelink.Table, matrixbridgelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.MatrixLinksTable, post.MatrixLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTelegramMessages chains the current query on the "telegram_messages" edge.
+func (_q *PostQuery) QueryTelegramMessages() *TelegramMessageQuery {
+	query := (&TelegramMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(telegrammessage.Table, telegrammessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.TelegramMessagesTable, post.TelegramMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Post entity from the query.
 // Returns a *NotFoundError when no Post was found.
 func (_q *PostQuery) First(ctx context.Context) (*Post, error) {
@@ -627,27 +723,31 @@ func (_q *PostQuery) Clone() *PostQuery {
 		return nil
 	}
 	return &PostQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]post.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Post{}, _q.predicates...),
-		withAuthor:       _q.withAuthor.Clone(),
-		withCategory:     _q.withCategory.Clone(),
-		withTags:         _q.withTags.Clone(),
-		withRoot:         _q.withRoot.Clone(),
-		withPosts:        _q.withPosts.Clone(),
-		withReplyTo:      _q.withReplyTo.Clone(),
-		withReplies:      _q.withReplies.Clone(),
-		withReacts:       _q.withReacts.Clone(),
-		withLikes:        _q.withLikes.Clone(),
-		withMentions:     _q.withMentions.Clone(),
-		withAssets:       _q.withAssets.Clone(),
-		withCollections:  _q.withCollections.Clone(),
-		withLink:         _q.withLink.Clone(),
-		withContentLinks: _q.withContentLinks.Clone(),
-		withEvent:        _q.withEvent.Clone(),
-		withPostReads:    _q.withPostReads.Clone(),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]post.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.Post{}, _q.predicates...),
+		withAuthor:           _q.withAuthor.Clone(),
+		withCategory:         _q.withCategory.Clone(),
+		withSpace:            _q.withSpace.Clone(),
+		withTags:             _q.withTags.Clone(),
+		withRoot:             _q.withRoot.Clone(),
+		withPosts:            _q.withPosts.Clone(),
+		withReplyTo:          _q.withReplyTo.Clone(),
+		withReplies:          _q.withReplies.Clone(),
+		withReacts:           _q.withReacts.Clone(),
+		withLikes:            _q.withLikes.Clone(),
+		withMentions:         _q.withMentions.Clone(),
+		withAssets:           _q.withAssets.Clone(),
+		withCollections:      _q.withCollections.Clone(),
+		withLink:             _q.withLink.Clone(),
+		withContentLinks:     _q.withContentLinks.Clone(),
+		withEvent:            _q.withEvent.Clone(),
+		withPostReads:        _q.withPostReads.Clone(),
+		withDiscordLinks:     _q.withDiscordLinks.Clone(),
+		withMatrixLinks:      _q.withMatrixLinks.Clone(),
+		withTelegramMessages: _q.withTelegramMessages.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -677,6 +777,17 @@ func (_q *PostQuery) WithCategory(opts ...func(*CategoryQuery)) *PostQuery {
 	return _q
 }
 
+// WithSpace tells the query-builder to eager-load the nodes that are connected to
+// the "space" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PostQuery) WithSpace(opts ...func(*SpaceQuery)) *PostQuery {
+	query := (&SpaceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSpace = query
+	return _q
+}
+
 // WithTags tells the query-builder to eager-load the nodes that are connected to
 // the "tags" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PostQuery) WithTags(opts ...func(*TagQuery)) *PostQuery {
@@ -831,6 +942,39 @@ func (_q *PostQuery) WithPostReads(opts ...func(*PostReadQuery)) *PostQuery {
 	return _q
 }
 
+// WithDiscordLinks tells the query-builder to eager-load the nodes that are connected to
+// the "discord_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PostQuery) WithDiscordLinks(opts ...func(*DiscordBridgeLinkQuery)) *PostQuery {
+	query := (&DiscordBridgeLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDiscordLinks = query
+	return _q
+}
+
+// WithMatrixLinks tells the query-builder to eager-load the nodes that are connected to
+// the "matrix_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PostQuery) WithMatrixLinks(opts ...func(*MatrixBridgeLinkQuery)) *PostQuery {
+	query := (&MatrixBridgeLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMatrixLinks = query
+	return _q
+}
+
+// WithTelegramMessages tells the query-builder to eager-load the nodes that are connected to
+// the "telegram_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PostQuery) WithTelegramMessages(opts ...func(*TelegramMessageQuery)) *PostQuery {
+	query := (&TelegramMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTelegramMessages = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -909,9 +1053,10 @@ func (_q *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 	var (
 		nodes       = []*Post{}
 		_spec       = _q.querySpec()
-		loadedTypes = [16]bool{
+		loadedTypes = [20]bool{
 			_q.withAuthor != nil,
 			_q.withCategory != nil,
+			_q.withSpace != nil,
 			_q.withTags != nil,
 			_q.withRoot != nil,
 			_q.withPosts != nil,
@@ -926,6 +1071,9 @@ func (_q *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			_q.withContentLinks != nil,
 			_q.withEvent != nil,
 			_q.withPostReads != nil,
+			_q.withDiscordLinks != nil,
+			_q.withMatrixLinks != nil,
+			_q.withTelegramMessages != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -961,6 +1109,12 @@ func (_q *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			return nil, err
 		}
 	}
+	if query := _q.withSpace; query != nil {
+		if err := _q.loadSpace(ctx, query, nodes, nil,
+			func(n *Post, e *Space) { n.Edges.Space = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withTags; query != nil {
 		if err := _q.loadTags(ctx, query, nodes,
 			func(n *Post) { n.Edges.Tags = []*Tag{} },
@@ -1056,6 +1210,27 @@ func (_q *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			return nil, err
 		}
 	}
+	if query := _q.withDiscordLinks; query != nil {
+		if err := _q.loadDiscordLinks(ctx, query, nodes,
+			func(n *Post) { n.Edges.DiscordLinks = []*DiscordBridgeLink{} },
+			func(n *Post, e *DiscordBridgeLink) { n.Edges.DiscordLinks = append(n.Edges.DiscordLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMatrixLinks; query != nil {
+		if err := _q.loadMatrixLinks(ctx, query, nodes,
+			func(n *Post) { n.Edges.MatrixLinks = []*MatrixBridgeLink{} },
+			func(n *Post, e *MatrixBridgeLink) { n.Edges.MatrixLinks = append(n.Edges.MatrixLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTelegramMessages; query != nil {
+		if err := _q.loadTelegramMessages(ctx, query, nodes,
+			func(n *Post) { n.Edges.TelegramMessages = []*TelegramMessage{} },
+			func(n *Post, e *TelegramMessage) { n.Edges.TelegramMessages = append(n.Edges.TelegramMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1117,6 +1292,38 @@ func (_q *PostQuery) loadCategory(ctx context.Context, query *CategoryQuery, nod
 	}
 	return nil
 }
+func (_q *PostQuery) loadSpace(ctx context.Context, query *SpaceQuery, nodes []*Post, init func(*Post), assign func(*Post, *Space)) error {
+	ids := make([]xid.ID, 0, len(nodes))
+	nodeids := make(map[xid.ID][]*Post)
+	for i := range nodes {
+		if nodes[i].SpaceID == nil {
+			continue
+		}
+		fk := *nodes[i].SpaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(space.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "space_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *PostQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Post, init func(*Post), assign func(*Post, *Tag)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[xid.ID]*Post)
@@ -1671,6 +1878,96 @@ func (_q *PostQuery) loadPostReads(ctx context.Context, query *PostReadQuery, no
 	}
 	return nil
 }
+func (_q *PostQuery) loadDiscordLinks(ctx context.Context, query *DiscordBridgeLinkQuery, nodes []*Post, init func(*Post), assign func(*Post, *DiscordBridgeLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discordbridgelink.FieldPostID)
+	}
+	query.Where(predicate.DiscordBridgeLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.DiscordLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PostQuery) loadMatrixLinks(ctx context.Context, query *MatrixBridgeLinkQuery, nodes []*Post, init func(*Post), assign func(*Post, *MatrixBridgeLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(matrixbridgelink.FieldPostID)
+	}
+	query.Where(predicate.MatrixBridgeLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.MatrixLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PostQuery) loadTelegramMessages(ctx context.Context, query *TelegramMessageQuery, nodes []*Post, init func(*Post), assign func(*Post, *TelegramMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(telegrammessage.FieldPostID)
+	}
+	query.Where(predicate.TelegramMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.TelegramMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -1706,6 +2003,9 @@ func (_q *PostQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withCategory != nil {
 			_spec.Node.AddColumnOnce(post.FieldCategoryID)
 		}
+		if _q.withSpace != nil {
+			_spec.Node.AddColumnOnce(post.FieldSpaceID)
+		}
 		if _q.withRoot != nil {
 			_spec.Node.AddColumnOnce(post.FieldRootPostID)
 		}
@@ -15,15 +15,19 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/space"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
 	"github.com/rs/xid"
 )
 
@@ -163,6 +167,26 @@ func (_u *PostUpdate) SetNillableLastReplyAt(v *time.Time) *PostUpdate {
 	return _u
 }
 
+// SetAcceptedReplyID sets the "accepted_reply_id" field.
+func (_u *PostUpdate) SetAcceptedReplyID(v xid.ID) *PostUpdate {
+	_u.mutation.SetAcceptedReplyID(v)
+	return _u
+}
+
+// SetNillableAcceptedReplyID sets the "accepted_reply_id" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableAcceptedReplyID(v *xid.ID) *PostUpdate {
+	if v != nil {
+		_u.SetAcceptedReplyID(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedReplyID clears the value of the "accepted_reply_id" field.
+func (_u *PostUpdate) ClearAcceptedReplyID() *PostUpdate {
+	_u.mutation.ClearAcceptedReplyID()
+	return _u
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (_u *PostUpdate) SetRootPostID(v xid.ID) *PostUpdate {
 	_u.mutation.SetRootPostID(v)
@@ -257,6 +281,33 @@ func (_u *PostUpdate) SetNillableVisibility(v *post.Visibility) *PostUpdate {
 	return _u
 }
 
+// SetSpamScore sets the "spam_score" field.
+func (_u *PostUpdate) SetSpamScore(v float64) *PostUpdate {
+	_u.mutation.ResetSpamScore()
+	_u.mutation.SetSpamScore(v)
+	return _u
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableSpamScore(v *float64) *PostUpdate {
+	if v != nil {
+		_u.SetSpamScore(*v)
+	}
+	return _u
+}
+
+// AddSpamScore adds value to the "spam_score" field.
+func (_u *PostUpdate) AddSpamScore(v float64) *PostUpdate {
+	_u.mutation.AddSpamScore(v)
+	return _u
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (_u *PostUpdate) ClearSpamScore() *PostUpdate {
+	_u.mutation.ClearSpamScore()
+	return _u
+}
+
 // SetAccountPosts sets the "account_posts" field.
 func (_u *PostUpdate) SetAccountPosts(v xid.ID) *PostUpdate {
 	_u.mutation.SetAccountPosts(v)
@@ -311,6 +362,26 @@ func (_u *PostUpdate) ClearLinkID() *PostUpdate {
 	return _u
 }
 
+// SetSpaceID sets the "space_id" field.
+func (_u *PostUpdate) SetSpaceID(v xid.ID) *PostUpdate {
+	_u.mutation.SetSpaceID(v)
+	return _u
+}
+
+// SetNillableSpaceID sets the "space_id" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableSpaceID(v *xid.ID) *PostUpdate {
+	if v != nil {
+		_u.SetSpaceID(*v)
+	}
+	return _u
+}
+
+// ClearSpaceID clears the value of the "space_id" field.
+func (_u *PostUpdate) ClearSpaceID() *PostUpdate {
+	_u.mutation.ClearSpaceID()
+	return _u
+}
+
 // SetAuthorID sets the "author" edge to the Account entity by ID.
 func (_u *PostUpdate) SetAuthorID(id xid.ID) *PostUpdate {
 	_u.mutation.SetAuthorID(id)
@@ -327,6 +398,11 @@ func (_u *PostUpdate) SetCategory(v *Category) *PostUpdate {
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetSpace sets the "space" edge to the Space entity.
+func (_u *PostUpdate) SetSpace(v *Space) *PostUpdate {
+	return _u.SetSpaceID(v.ID)
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *PostUpdate) AddTagIDs(ids ...xid.ID) *PostUpdate {
 	_u.mutation.AddTagIDs(ids...)
@@ -535,6 +611,51 @@ func (_u *PostUpdate) AddPostReads(v ...*PostRead) *PostUpdate {
 	return _u.AddPostReadIDs(ids...)
 }
 
+// AddDiscordLinkIDs adds the "discord_links" edge to the DiscordBridgeLink entity by IDs.
+func (_u *PostUpdate) AddDiscordLinkIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.AddDiscordLinkIDs(ids...)
+	return _u
+}
+
+// AddDiscordLinks adds the "discord_links" edges to the DiscordBridgeLink entity.
+func (_u *PostUpdate) AddDiscordLinks(v ...*DiscordBridgeLink) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDiscordLinkIDs(ids...)
+}
+
+// AddMatrixLinkIDs adds the "matrix_links" edge to the MatrixBridgeLink entity by IDs.
+func (_u *PostUpdate) AddMatrixLinkIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.AddMatrixLinkIDs(ids...)
+	return _u
+}
+
+// AddMatrixLinks adds the "matrix_links" edges to the MatrixBridgeLink entity.
+func (_u *PostUpdate) AddMatrixLinks(v ...*MatrixBridgeLink) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMatrixLinkIDs(ids...)
+}
+
+// AddTelegramMessageIDs adds the "telegram_messages" edge to the TelegramMessage entity by IDs.
+func (_u *PostUpdate) AddTelegramMessageIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.AddTelegramMessageIDs(ids...)
+	return _u
+}
+
+// AddTelegramMessages adds the "telegram_messages" edges to the TelegramMessage entity.
+func (_u *PostUpdate) AddTelegramMessages(v ...*TelegramMessage) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTelegramMessageIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdate) Mutation() *PostMutation {
 	return _u.mutation
@@ -552,6 +673,12 @@ func (_u *PostUpdate) ClearCategory() *PostUpdate {
 	return _u
 }
 
+// ClearSpace clears the "space" edge to the Space entity.
+func (_u *PostUpdate) ClearSpace() *PostUpdate {
+	_u.mutation.ClearSpace()
+	return _u
+}
+
 // ClearTags clears all "tags" edges to the Tag entity.
 func (_u *PostUpdate) ClearTags() *PostUpdate {
 	_u.mutation.ClearTags()
@@ -801,6 +928,69 @@ func (_u *PostUpdate) RemovePostReads(v ...*PostRead) *PostUpdate {
 	return _u.RemovePostReadIDs(ids...)
 }
 
+// ClearDiscordLinks clears all "discord_links" edges to the DiscordBridgeLink entity.
+func (_u *PostUpdate) ClearDiscordLinks() *PostUpdate {
+	_u.mutation.ClearDiscordLinks()
+	return _u
+}
+
+// RemoveDiscordLinkIDs removes the "discord_links" edge to DiscordBridgeLink entities by IDs.
+func (_u *PostUpdate) RemoveDiscordLinkIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.RemoveDiscordLinkIDs(ids...)
+	return _u
+}
+
+// RemoveDiscordLinks removes "discord_links" edges to DiscordBridgeLink entities.
+func (_u *PostUpdate) RemoveDiscordLinks(v ...*DiscordBridgeLink) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDiscordLinkIDs(ids...)
+}
+
+// ClearMatrixLinks clears all "matrix_links" edges to the MatrixBridgeLink entity.
+func (_u *PostUpdate) ClearMatrixLinks() *PostUpdate {
+	_u.mutation.ClearMatrixLinks()
+	return _u
+}
+
+// RemoveMatrixLinkIDs removes the "matrix_links" edge to MatrixBridgeLink entities by IDs.
+func (_u *PostUpdate) RemoveMatrixLinkIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.RemoveMatrixLinkIDs(ids...)
+	return _u
+}
+
+// RemoveMatrixLinks removes "matrix_links" edges to MatrixBridgeLink entities.
+func (_u *PostUpdate) RemoveMatrixLinks(v ...*MatrixBridgeLink) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMatrixLinkIDs(ids...)
+}
+
+// ClearTelegramMessages clears all "telegram_messages" edges to the TelegramMessage entity.
+func (_u *PostUpdate) ClearTelegramMessages() *PostUpdate {
+	_u.mutation.ClearTelegramMessages()
+	return _u
+}
+
+// RemoveTelegramMessageIDs removes the "telegram_messages" edge to TelegramMessage entities by IDs.
+func (_u *PostUpdate) RemoveTelegramMessageIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.RemoveTelegramMessageIDs(ids...)
+	return _u
+}
+
+// RemoveTelegramMessages removes "telegram_messages" edges to TelegramMessage entities.
+func (_u *PostUpdate) RemoveTelegramMessages(v ...*TelegramMessage) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTelegramMessageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -892,6 +1082,12 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.LastReplyAt(); ok {
 		_spec.SetField(post.FieldLastReplyAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.AcceptedReplyID(); ok {
+		_spec.SetField(post.FieldAcceptedReplyID, field.TypeString, value)
+	}
+	if _u.mutation.AcceptedReplyIDCleared() {
+		_spec.ClearField(post.FieldAcceptedReplyID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(post.FieldBody, field.TypeString, value)
 	}
@@ -907,6 +1103,15 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Visibility(); ok {
 		_spec.SetField(post.FieldVisibility, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.SpamScore(); ok {
+		_spec.SetField(post.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpamScore(); ok {
+		_spec.AddField(post.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpamScoreCleared() {
+		_spec.ClearField(post.FieldSpamScore, field.TypeFloat64)
+	}
 	if _u.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -965,6 +1170,35 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SpaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.SpaceTable,
+			Columns: []string{post.SpaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(space.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SpaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.SpaceTable,
+			Columns: []string{post.SpaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(space.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1547,6 +1781,141 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DiscordLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DiscordLinksTable,
+			Columns: []string{post.DiscordLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDiscordLinksIDs(); len(nodes) > 0 && !_u.mutation.DiscordLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DiscordLinksTable,
+			Columns: []string{post.DiscordLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DiscordLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DiscordLinksTable,
+			Columns: []string{post.DiscordLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MatrixLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MatrixLinksTable,
+			Columns: []string{post.MatrixLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMatrixLinksIDs(); len(nodes) > 0 && !_u.mutation.MatrixLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MatrixLinksTable,
+			Columns: []string{post.MatrixLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatrixLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MatrixLinksTable,
+			Columns: []string{post.MatrixLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TelegramMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TelegramMessagesTable,
+			Columns: []string{post.TelegramMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTelegramMessagesIDs(); len(nodes) > 0 && !_u.mutation.TelegramMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TelegramMessagesTable,
+			Columns: []string{post.TelegramMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TelegramMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TelegramMessagesTable,
+			Columns: []string{post.TelegramMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1691,6 +2060,26 @@ func (_u *PostUpdateOne) SetNillableLastReplyAt(v *time.Time) *PostUpdateOne {
 	return _u
 }
 
+// SetAcceptedReplyID sets the "accepted_reply_id" field.
+func (_u *PostUpdateOne) SetAcceptedReplyID(v xid.ID) *PostUpdateOne {
+	_u.mutation.SetAcceptedReplyID(v)
+	return _u
+}
+
+// SetNillableAcceptedReplyID sets the "accepted_reply_id" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableAcceptedReplyID(v *xid.ID) *PostUpdateOne {
+	if v != nil {
+		_u.SetAcceptedReplyID(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedReplyID clears the value of the "accepted_reply_id" field.
+func (_u *PostUpdateOne) ClearAcceptedReplyID() *PostUpdateOne {
+	_u.mutation.ClearAcceptedReplyID()
+	return _u
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (_u *PostUpdateOne) SetRootPostID(v xid.ID) *PostUpdateOne {
 	_u.mutation.SetRootPostID(v)
@@ -1785,6 +2174,33 @@ func (_u *PostUpdateOne) SetNillableVisibility(v *post.Visibility) *PostUpdateOn
 	return _u
 }
 
+// SetSpamScore sets the "spam_score" field.
+func (_u *PostUpdateOne) SetSpamScore(v float64) *PostUpdateOne {
+	_u.mutation.ResetSpamScore()
+	_u.mutation.SetSpamScore(v)
+	return _u
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableSpamScore(v *float64) *PostUpdateOne {
+	if v != nil {
+		_u.SetSpamScore(*v)
+	}
+	return _u
+}
+
+// AddSpamScore adds value to the "spam_score" field.
+func (_u *PostUpdateOne) AddSpamScore(v float64) *PostUpdateOne {
+	_u.mutation.AddSpamScore(v)
+	return _u
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (_u *PostUpdateOne) ClearSpamScore() *PostUpdateOne {
+	_u.mutation.ClearSpamScore()
+	return _u
+}
+
 // SetAccountPosts sets the "account_posts" field.
 func (_u *PostUpdateOne) SetAccountPosts(v xid.ID) *PostUpdateOne {
 	_u.mutation.SetAccountPosts(v)
@@ -1839,6 +2255,26 @@ func (_u *PostUpdateOne) ClearLinkID() *PostUpdateOne {
 	return _u
 }
 
+// SetSpaceID sets the "space_id" field.
+func (_u *PostUpdateOne) SetSpaceID(v xid.ID) *PostUpdateOne {
+	_u.mutation.SetSpaceID(v)
+	return _u
+}
+
+// SetNillableSpaceID sets the "space_id" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableSpaceID(v *xid.ID) *PostUpdateOne {
+	if v != nil {
+		_u.SetSpaceID(*v)
+	}
+	return _u
+}
+
+// ClearSpaceID clears the value of the "space_id" field.
+func (_u *PostUpdateOne) ClearSpaceID() *PostUpdateOne {
+	_u.mutation.ClearSpaceID()
+	return _u
+}
+
 // SetAuthorID sets the "author" edge to the Account entity by ID.
 func (_u *PostUpdateOne) SetAuthorID(id xid.ID) *PostUpdateOne {
 	_u.mutation.SetAuthorID(id)
@@ -1855,6 +2291,11 @@ func (_u *PostUpdateOne) SetCategory(v *Category) *PostUpdateOne {
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetSpace sets the "space" edge to the Space entity.
+func (_u *PostUpdateOne) SetSpace(v *Space) *PostUpdateOne {
+	return _u.SetSpaceID(v.ID)
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *PostUpdateOne) AddTagIDs(ids ...xid.ID) *PostUpdateOne {
 	_u.mutation.AddTagIDs(ids...)
@@ -2063,6 +2504,51 @@ func (_u *PostUpdateOne) AddPostReads(v ...*PostRead) *PostUpdateOne {
 	return _u.AddPostReadIDs(ids...)
 }
 
+// AddDiscordLinkIDs adds the "discord_links" edge to the DiscordBridgeLink entity by IDs.
+func (_u *PostUpdateOne) AddDiscordLinkIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.AddDiscordLinkIDs(ids...)
+	return _u
+}
+
+// AddDiscordLinks adds the "discord_links" edges to the DiscordBridgeLink entity.
+func (_u *PostUpdateOne) AddDiscordLinks(v ...*DiscordBridgeLink) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDiscordLinkIDs(ids...)
+}
+
+// AddMatrixLinkIDs adds the "matrix_links" edge to the MatrixBridgeLink entity by IDs.
+func (_u *PostUpdateOne) AddMatrixLinkIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.AddMatrixLinkIDs(ids...)
+	return _u
+}
+
+// AddMatrixLinks adds the "matrix_links" edges to the MatrixBridgeLink entity.
+func (_u *PostUpdateOne) AddMatrixLinks(v ...*MatrixBridgeLink) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMatrixLinkIDs(ids...)
+}
+
+// AddTelegramMessageIDs adds the "telegram_messages" edge to the TelegramMessage entity by IDs.
+func (_u *PostUpdateOne) AddTelegramMessageIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.AddTelegramMessageIDs(ids...)
+	return _u
+}
+
+// AddTelegramMessages adds the "telegram_messages" edges to the TelegramMessage entity.
+func (_u *PostUpdateOne) AddTelegramMessages(v ...*TelegramMessage) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTelegramMessageIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdateOne) Mutation() *PostMutation {
 	return _u.mutation
@@ -2080,6 +2566,12 @@ func (_u *PostUpdateOne) ClearCategory() *PostUpdateOne {
 	return _u
 }
 
+// ClearSpace clears the "space" edge to the Space entity.
+func (_u *PostUpdateOne) ClearSpace() *PostUpdateOne {
+	_u.mutation.ClearSpace()
+	return _u
+}
+
 // ClearTags clears all "tags" edges to the Tag entity.
 func (_u *PostUpdateOne) ClearTags() *PostUpdateOne {
 	_u.mutation.ClearTags()
@@ -2329,6 +2821,69 @@ func (_u *PostUpdateOne) RemovePostReads(v ...*PostRead) *PostUpdateOne {
 	return _u.RemovePostReadIDs(ids...)
 }
 
+// ClearDiscordLinks clears all "discord_links" edges to the DiscordBridgeLink entity.
+func (_u *PostUpdateOne) ClearDiscordLinks() *PostUpdateOne {
+	_u.mutation.ClearDiscordLinks()
+	return _u
+}
+
+// RemoveDiscordLinkIDs removes the "discord_links" edge to DiscordBridgeLink entities by IDs.
+func (_u *PostUpdateOne) RemoveDiscordLinkIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.RemoveDiscordLinkIDs(ids...)
+	return _u
+}
+
+// RemoveDiscordLinks removes "discord_links" edges to DiscordBridgeLink entities.
+func (_u *PostUpdateOne) RemoveDiscordLinks(v ...*DiscordBridgeLink) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDiscordLinkIDs(ids...)
+}
+
+// ClearMatrixLinks clears all "matrix_links" edges to the MatrixBridgeLink entity.
+func (_u *PostUpdateOne) ClearMatrixLinks() *PostUpdateOne {
+	_u.mutation.ClearMatrixLinks()
+	return _u
+}
+
+// RemoveMatrixLinkIDs removes the "matrix_links" edge to MatrixBridgeLink entities by IDs.
+func (_u *PostUpdateOne) RemoveMatrixLinkIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.RemoveMatrixLinkIDs(ids...)
+	return _u
+}
+
+// RemoveMatrixLinks removes "matrix_links" edges to MatrixBridgeLink entities.
+func (_u *PostUpdateOne) RemoveMatrixLinks(v ...*MatrixBridgeLink) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMatrixLinkIDs(ids...)
+}
+
+// ClearTelegramMessages clears all "telegram_messages" edges to the TelegramMessage entity.
+func (_u *PostUpdateOne) ClearTelegramMessages() *PostUpdateOne {
+	_u.mutation.ClearTelegramMessages()
+	return _u
+}
+
+// RemoveTelegramMessageIDs removes the "telegram_messages" edge to TelegramMessage entities by IDs.
+func (_u *PostUpdateOne) RemoveTelegramMessageIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.RemoveTelegramMessageIDs(ids...)
+	return _u
+}
+
+// RemoveTelegramMessages removes "telegram_messages" edges to TelegramMessage entities.
+func (_u *PostUpdateOne) RemoveTelegramMessages(v ...*TelegramMessage) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTelegramMessageIDs(ids...)
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (_u *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2450,6 +3005,12 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if value, ok := _u.mutation.LastReplyAt(); ok {
 		_spec.SetField(post.FieldLastReplyAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.AcceptedReplyID(); ok {
+		_spec.SetField(post.FieldAcceptedReplyID, field.TypeString, value)
+	}
+	if _u.mutation.AcceptedReplyIDCleared() {
+		_spec.ClearField(post.FieldAcceptedReplyID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(post.FieldBody, field.TypeString, value)
 	}
@@ -2465,6 +3026,15 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if value, ok := _u.mutation.Visibility(); ok {
 		_spec.SetField(post.FieldVisibility, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.SpamScore(); ok {
+		_spec.SetField(post.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpamScore(); ok {
+		_spec.AddField(post.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpamScoreCleared() {
+		_spec.ClearField(post.FieldSpamScore, field.TypeFloat64)
+	}
 	if _u.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -2523,6 +3093,35 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SpaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.SpaceTable,
+			Columns: []string{post.SpaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(space.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SpaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.SpaceTable,
+			Columns: []string{post.SpaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(space.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -3105,6 +3704,141 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DiscordLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DiscordLinksTable,
+			Columns: []string{post.DiscordLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDiscordLinksIDs(); len(nodes) > 0 && !_u.mutation.DiscordLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DiscordLinksTable,
+			Columns: []string{post.DiscordLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DiscordLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.DiscordLinksTable,
+			Columns: []string{post.DiscordLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MatrixLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MatrixLinksTable,
+			Columns: []string{post.MatrixLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMatrixLinksIDs(); len(nodes) > 0 && !_u.mutation.MatrixLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MatrixLinksTable,
+			Columns: []string{post.MatrixLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatrixLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MatrixLinksTable,
+			Columns: []string{post.MatrixLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(matrixbridgelink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TelegramMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TelegramMessagesTable,
+			Columns: []string{post.TelegramMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTelegramMessagesIDs(); len(nodes) > 0 && !_u.mutation.TelegramMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TelegramMessagesTable,
+			Columns: []string{post.TelegramMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TelegramMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TelegramMessagesTable,
+			Columns: []string{post.TelegramMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Post{config: _u.config}
 	_spec.Assign = _node.assignValues
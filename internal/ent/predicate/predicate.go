@@ -6,24 +6,66 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIUsage is the predicate function for apiusage builders.
+type APIUsage func(*sql.Selector)
+
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// AccountApplication is the predicate function for accountapplication builders.
+type AccountApplication func(*sql.Selector)
+
+// AccountBadge is the predicate function for accountbadge builders.
+type AccountBadge func(*sql.Selector)
+
+// AccountBlock is the predicate function for accountblock builders.
+type AccountBlock func(*sql.Selector)
+
 // AccountFollow is the predicate function for accountfollow builders.
 type AccountFollow func(*sql.Selector)
 
+// AccountOnboardingStep is the predicate function for accountonboardingstep builders.
+type AccountOnboardingStep func(*sql.Selector)
+
 // AccountRoles is the predicate function for accountroles builders.
 type AccountRoles func(*sql.Selector)
 
+// AnalyticsCohort is the predicate function for analyticscohort builders.
+type AnalyticsCohort func(*sql.Selector)
+
+// AnalyticsContent is the predicate function for analyticscontent builders.
+type AnalyticsContent func(*sql.Selector)
+
+// AnalyticsDay is the predicate function for analyticsday builders.
+type AnalyticsDay func(*sql.Selector)
+
+// AnalyticsExport is the predicate function for analyticsexport builders.
+type AnalyticsExport func(*sql.Selector)
+
 // Asset is the predicate function for asset builders.
 type Asset func(*sql.Selector)
 
 // Authentication is the predicate function for authentication builders.
 type Authentication func(*sql.Selector)
 
+// AutomodRule is the predicate function for automodrule builders.
+type AutomodRule func(*sql.Selector)
+
+// Badge is the predicate function for badge builders.
+type Badge func(*sql.Selector)
+
+// Bookmark is the predicate function for bookmark builders.
+type Bookmark func(*sql.Selector)
+
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// CategoryFollow is the predicate function for categoryfollow builders.
+type CategoryFollow func(*sql.Selector)
+
+// CategoryPermission is the predicate function for categorypermission builders.
+type CategoryPermission func(*sql.Selector)
+
 // Collection is the predicate function for collection builders.
 type Collection func(*sql.Selector)
 
@@ -33,6 +75,33 @@ type CollectionNode func(*sql.Selector)
 // CollectionPost is the predicate function for collectionpost builders.
 type CollectionPost func(*sql.Selector)
 
+// CollectionSection is the predicate function for collectionsection builders.
+type CollectionSection func(*sql.Selector)
+
+// CollectionShare is the predicate function for collectionshare builders.
+type CollectionShare func(*sql.Selector)
+
+// ContentSummary is the predicate function for contentsummary builders.
+type ContentSummary func(*sql.Selector)
+
+// ContentView is the predicate function for contentview builders.
+type ContentView func(*sql.Selector)
+
+// Conversation is the predicate function for conversation builders.
+type Conversation func(*sql.Selector)
+
+// ConversationParticipant is the predicate function for conversationparticipant builders.
+type ConversationParticipant func(*sql.Selector)
+
+// DigestSubscription is the predicate function for digestsubscription builders.
+type DigestSubscription func(*sql.Selector)
+
+// DirectMessage is the predicate function for directmessage builders.
+type DirectMessage func(*sql.Selector)
+
+// DiscordBridgeLink is the predicate function for discordbridgelink builders.
+type DiscordBridgeLink func(*sql.Selector)
+
 // Email is the predicate function for email builders.
 type Email func(*sql.Selector)
 
@@ -42,24 +111,69 @@ type Event func(*sql.Selector)
 // EventParticipant is the predicate function for eventparticipant builders.
 type EventParticipant func(*sql.Selector)
 
+// FeatureFlag is the predicate function for featureflag builders.
+type FeatureFlag func(*sql.Selector)
+
+// FederationFollower is the predicate function for federationfollower builders.
+type FederationFollower func(*sql.Selector)
+
+// FederationKey is the predicate function for federationkey builders.
+type FederationKey func(*sql.Selector)
+
+// FeedToken is the predicate function for feedtoken builders.
+type FeedToken func(*sql.Selector)
+
+// ImportRecord is the predicate function for importrecord builders.
+type ImportRecord func(*sql.Selector)
+
+// ImportRun is the predicate function for importrun builders.
+type ImportRun func(*sql.Selector)
+
 // Invitation is the predicate function for invitation builders.
 type Invitation func(*sql.Selector)
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
 // LikePost is the predicate function for likepost builders.
 type LikePost func(*sql.Selector)
 
 // Link is the predicate function for link builders.
 type Link func(*sql.Selector)
 
+// LinkCheck is the predicate function for linkcheck builders.
+type LinkCheck func(*sql.Selector)
+
+// MatrixBridgeLink is the predicate function for matrixbridgelink builders.
+type MatrixBridgeLink func(*sql.Selector)
+
 // MentionProfile is the predicate function for mentionprofile builders.
 type MentionProfile func(*sql.Selector)
 
+// ModerationNote is the predicate function for moderationnote builders.
+type ModerationNote func(*sql.Selector)
+
+// NetworkBan is the predicate function for networkban builders.
+type NetworkBan func(*sql.Selector)
+
 // Node is the predicate function for node builders.
 type Node func(*sql.Selector)
 
 // Notification is the predicate function for notification builders.
 type Notification func(*sql.Selector)
 
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// PolicyAcceptance is the predicate function for policyacceptance builders.
+type PolicyAcceptance func(*sql.Selector)
+
+// PolicyDocument is the predicate function for policydocument builders.
+type PolicyDocument func(*sql.Selector)
+
+// PolicyVersion is the predicate function for policyversion builders.
+type PolicyVersion func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
@@ -75,23 +189,74 @@ type PropertySchema func(*sql.Selector)
 // PropertySchemaField is the predicate function for propertyschemafield builders.
 type PropertySchemaField func(*sql.Selector)
 
+// PushSubscription is the predicate function for pushsubscription builders.
+type PushSubscription func(*sql.Selector)
+
 // Question is the predicate function for question builders.
 type Question func(*sql.Selector)
 
 // React is the predicate function for react builders.
 type React func(*sql.Selector)
 
+// ReadingHistory is the predicate function for readinghistory builders.
+type ReadingHistory func(*sql.Selector)
+
 // Report is the predicate function for report builders.
 type Report func(*sql.Selector)
 
+// ReputationEntry is the predicate function for reputationentry builders.
+type ReputationEntry func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// ScheduledTask is the predicate function for scheduledtask builders.
+type ScheduledTask func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
+// Space is the predicate function for space builders.
+type Space func(*sql.Selector)
+
+// SpaceMember is the predicate function for spacemember builders.
+type SpaceMember func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
+
+// TagFollow is the predicate function for tagfollow builders.
+type TagFollow func(*sql.Selector)
+
+// TagSynonym is the predicate function for tagsynonym builders.
+type TagSynonym func(*sql.Selector)
+
+// TelegramMessage is the predicate function for telegrammessage builders.
+type TelegramMessage func(*sql.Selector)
+
+// Tenant is the predicate function for tenant builders.
+type Tenant func(*sql.Selector)
+
+// TrendingScore is the predicate function for trendingscore builders.
+type TrendingScore func(*sql.Selector)
+
+// UploadSession is the predicate function for uploadsession builders.
+type UploadSession func(*sql.Selector)
+
+// VerificationRequest is the predicate function for verificationrequest builders.
+type VerificationRequest func(*sql.Selector)
+
+// Warning is the predicate function for warning builders.
+type Warning func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WordFilter is the predicate function for wordfilter builders.
+type WordFilter func(*sql.Selector)
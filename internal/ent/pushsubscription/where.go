@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pushsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// P256dh applies equality check predicate on the "p256dh" field. It's identical to P256dhEQ.
+func P256dh(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldP256dh, v))
+}
+
+// Auth applies equality check predicate on the "auth" field. It's identical to AuthEQ.
+func Auth(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldAuth, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.PushSubscription {
+	vc := v.String()
+	return predicate.PushSubscription(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.PushSubscription {
+	vc := v.String()
+	return predicate.PushSubscription(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.PushSubscription {
+	vc := v.String()
+	return predicate.PushSubscription(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.PushSubscription {
+	vc := v.String()
+	return predicate.PushSubscription(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.PushSubscription {
+	vc := v.String()
+	return predicate.PushSubscription(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// P256dhEQ applies the EQ predicate on the "p256dh" field.
+func P256dhEQ(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldP256dh, v))
+}
+
+// P256dhNEQ applies the NEQ predicate on the "p256dh" field.
+func P256dhNEQ(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldP256dh, v))
+}
+
+// P256dhIn applies the In predicate on the "p256dh" field.
+func P256dhIn(vs ...string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldP256dh, vs...))
+}
+
+// P256dhNotIn applies the NotIn predicate on the "p256dh" field.
+func P256dhNotIn(vs ...string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldP256dh, vs...))
+}
+
+// P256dhGT applies the GT predicate on the "p256dh" field.
+func P256dhGT(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldP256dh, v))
+}
+
+// P256dhGTE applies the GTE predicate on the "p256dh" field.
+func P256dhGTE(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldP256dh, v))
+}
+
+// P256dhLT applies the LT predicate on the "p256dh" field.
+func P256dhLT(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldP256dh, v))
+}
+
+// P256dhLTE applies the LTE predicate on the "p256dh" field.
+func P256dhLTE(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldP256dh, v))
+}
+
+// P256dhContains applies the Contains predicate on the "p256dh" field.
+func P256dhContains(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldContains(FieldP256dh, v))
+}
+
+// P256dhHasPrefix applies the HasPrefix predicate on the "p256dh" field.
+func P256dhHasPrefix(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldHasPrefix(FieldP256dh, v))
+}
+
+// P256dhHasSuffix applies the HasSuffix predicate on the "p256dh" field.
+func P256dhHasSuffix(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldHasSuffix(FieldP256dh, v))
+}
+
+// P256dhEqualFold applies the EqualFold predicate on the "p256dh" field.
+func P256dhEqualFold(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEqualFold(FieldP256dh, v))
+}
+
+// P256dhContainsFold applies the ContainsFold predicate on the "p256dh" field.
+func P256dhContainsFold(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldContainsFold(FieldP256dh, v))
+}
+
+// AuthEQ applies the EQ predicate on the "auth" field.
+func AuthEQ(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldAuth, v))
+}
+
+// AuthNEQ applies the NEQ predicate on the "auth" field.
+func AuthNEQ(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldAuth, v))
+}
+
+// AuthIn applies the In predicate on the "auth" field.
+func AuthIn(vs ...string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldAuth, vs...))
+}
+
+// AuthNotIn applies the NotIn predicate on the "auth" field.
+func AuthNotIn(vs ...string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldAuth, vs...))
+}
+
+// AuthGT applies the GT predicate on the "auth" field.
+func AuthGT(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldAuth, v))
+}
+
+// AuthGTE applies the GTE predicate on the "auth" field.
+func AuthGTE(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldAuth, v))
+}
+
+// AuthLT applies the LT predicate on the "auth" field.
+func AuthLT(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldAuth, v))
+}
+
+// AuthLTE applies the LTE predicate on the "auth" field.
+func AuthLTE(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldAuth, v))
+}
+
+// AuthContains applies the Contains predicate on the "auth" field.
+func AuthContains(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldContains(FieldAuth, v))
+}
+
+// AuthHasPrefix applies the HasPrefix predicate on the "auth" field.
+func AuthHasPrefix(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldHasPrefix(FieldAuth, v))
+}
+
+// AuthHasSuffix applies the HasSuffix predicate on the "auth" field.
+func AuthHasSuffix(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldHasSuffix(FieldAuth, v))
+}
+
+// AuthEqualFold applies the EqualFold predicate on the "auth" field.
+func AuthEqualFold(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEqualFold(FieldAuth, v))
+}
+
+// AuthContainsFold applies the ContainsFold predicate on the "auth" field.
+func AuthContainsFold(v string) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldContainsFold(FieldAuth, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.PushSubscription {
+	return predicate.PushSubscription(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.PushSubscription {
+	return predicate.PushSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.PushSubscription {
+	return predicate.PushSubscription(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PushSubscription) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PushSubscription) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PushSubscription) predicate.PushSubscription {
+	return predicate.PushSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
+	"github.com/rs/xid"
+)
+
+// ReadingHistory is the model entity for the ReadingHistory schema.
+type ReadingHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// The ID of the item being read. This is not a foreign key as history can refer to a variety of sources, discriminated by the 'item_kind' field.
+	ItemID xid.ID `json:"item_id,omitempty"`
+	// The datagraph kind of the item being read.
+	ItemKind string `json:"item_kind,omitempty"`
+	// An opaque marker of how far the member has read, such as the ID of the last reply seen or a heading anchor.
+	Position *string `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReadingHistoryQuery when eager-loading is set.
+	Edges        ReadingHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReadingHistoryEdges holds the relations/edges for other nodes in the graph.
+type ReadingHistoryEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReadingHistoryEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReadingHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case readinghistory.FieldItemKind, readinghistory.FieldPosition:
+			values[i] = new(sql.NullString)
+		case readinghistory.FieldCreatedAt, readinghistory.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case readinghistory.FieldID, readinghistory.FieldAccountID, readinghistory.FieldItemID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReadingHistory fields.
+func (_m *ReadingHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case readinghistory.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case readinghistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case readinghistory.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case readinghistory.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case readinghistory.FieldItemID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				_m.ItemID = *value
+			}
+		case readinghistory.FieldItemKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_kind", values[i])
+			} else if value.Valid {
+				_m.ItemKind = value.String
+			}
+		case readinghistory.FieldPosition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = new(string)
+				*_m.Position = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReadingHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReadingHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the ReadingHistory entity.
+func (_m *ReadingHistory) QueryAccount() *AccountQuery {
+	return NewReadingHistoryClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this ReadingHistory.
+// Note that you need to call ReadingHistory.Unwrap() before calling this method if this ReadingHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReadingHistory) Update() *ReadingHistoryUpdateOne {
+	return NewReadingHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReadingHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReadingHistory) Unwrap() *ReadingHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReadingHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReadingHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReadingHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("item_kind=")
+	builder.WriteString(_m.ItemKind)
+	builder.WriteString(", ")
+	if v := _m.Position; v != nil {
+		builder.WriteString("position=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReadingHistories is a parsable slice of ReadingHistory.
+type ReadingHistories []*ReadingHistory
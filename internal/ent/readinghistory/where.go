@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readinghistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldAccountID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemKind applies equality check predicate on the "item_kind" field. It's identical to ItemKindEQ.
+func ItemKind(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldItemKind, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v xid.ID) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldContains(FieldItemID, vc))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldHasPrefix(FieldItemID, vc))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldHasSuffix(FieldItemID, vc))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldEqualFold(FieldItemID, vc))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v xid.ID) predicate.ReadingHistory {
+	vc := v.String()
+	return predicate.ReadingHistory(sql.FieldContainsFold(FieldItemID, vc))
+}
+
+// ItemKindEQ applies the EQ predicate on the "item_kind" field.
+func ItemKindEQ(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemKindNEQ applies the NEQ predicate on the "item_kind" field.
+func ItemKindNEQ(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNEQ(FieldItemKind, v))
+}
+
+// ItemKindIn applies the In predicate on the "item_kind" field.
+func ItemKindIn(vs ...string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIn(FieldItemKind, vs...))
+}
+
+// ItemKindNotIn applies the NotIn predicate on the "item_kind" field.
+func ItemKindNotIn(vs ...string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotIn(FieldItemKind, vs...))
+}
+
+// ItemKindGT applies the GT predicate on the "item_kind" field.
+func ItemKindGT(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGT(FieldItemKind, v))
+}
+
+// ItemKindGTE applies the GTE predicate on the "item_kind" field.
+func ItemKindGTE(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGTE(FieldItemKind, v))
+}
+
+// ItemKindLT applies the LT predicate on the "item_kind" field.
+func ItemKindLT(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLT(FieldItemKind, v))
+}
+
+// ItemKindLTE applies the LTE predicate on the "item_kind" field.
+func ItemKindLTE(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLTE(FieldItemKind, v))
+}
+
+// ItemKindContains applies the Contains predicate on the "item_kind" field.
+func ItemKindContains(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldContains(FieldItemKind, v))
+}
+
+// ItemKindHasPrefix applies the HasPrefix predicate on the "item_kind" field.
+func ItemKindHasPrefix(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldHasPrefix(FieldItemKind, v))
+}
+
+// ItemKindHasSuffix applies the HasSuffix predicate on the "item_kind" field.
+func ItemKindHasSuffix(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldHasSuffix(FieldItemKind, v))
+}
+
+// ItemKindEqualFold applies the EqualFold predicate on the "item_kind" field.
+func ItemKindEqualFold(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEqualFold(FieldItemKind, v))
+}
+
+// ItemKindContainsFold applies the ContainsFold predicate on the "item_kind" field.
+func ItemKindContainsFold(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldContainsFold(FieldItemKind, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldLTE(FieldPosition, v))
+}
+
+// PositionContains applies the Contains predicate on the "position" field.
+func PositionContains(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldContains(FieldPosition, v))
+}
+
+// PositionHasPrefix applies the HasPrefix predicate on the "position" field.
+func PositionHasPrefix(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldHasPrefix(FieldPosition, v))
+}
+
+// PositionHasSuffix applies the HasSuffix predicate on the "position" field.
+func PositionHasSuffix(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldHasSuffix(FieldPosition, v))
+}
+
+// PositionIsNil applies the IsNil predicate on the "position" field.
+func PositionIsNil() predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldIsNull(FieldPosition))
+}
+
+// PositionNotNil applies the NotNil predicate on the "position" field.
+func PositionNotNil() predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldNotNull(FieldPosition))
+}
+
+// PositionEqualFold applies the EqualFold predicate on the "position" field.
+func PositionEqualFold(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldEqualFold(FieldPosition, v))
+}
+
+// PositionContainsFold applies the ContainsFold predicate on the "position" field.
+func PositionContainsFold(v string) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.FieldContainsFold(FieldPosition, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.ReadingHistory {
+	return predicate.ReadingHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.ReadingHistory {
+	return predicate.ReadingHistory(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReadingHistory) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReadingHistory) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReadingHistory) predicate.ReadingHistory {
+	return predicate.ReadingHistory(sql.NotPredicates(p))
+}
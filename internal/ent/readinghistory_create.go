@@ -0,0 +1,816 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
+	"github.com/rs/xid"
+)
+
+// ReadingHistoryCreate is the builder for creating a ReadingHistory entity.
+type ReadingHistoryCreate struct {
+	config
+	mutation *ReadingHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReadingHistoryCreate) SetCreatedAt(v time.Time) *ReadingHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReadingHistoryCreate) SetNillableCreatedAt(v *time.Time) *ReadingHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReadingHistoryCreate) SetUpdatedAt(v time.Time) *ReadingHistoryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReadingHistoryCreate) SetNillableUpdatedAt(v *time.Time) *ReadingHistoryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ReadingHistoryCreate) SetAccountID(v xid.ID) *ReadingHistoryCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ReadingHistoryCreate) SetItemID(v xid.ID) *ReadingHistoryCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_c *ReadingHistoryCreate) SetItemKind(v string) *ReadingHistoryCreate {
+	_c.mutation.SetItemKind(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *ReadingHistoryCreate) SetPosition(v string) *ReadingHistoryCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *ReadingHistoryCreate) SetNillablePosition(v *string) *ReadingHistoryCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReadingHistoryCreate) SetID(v xid.ID) *ReadingHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReadingHistoryCreate) SetNillableID(v *xid.ID) *ReadingHistoryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ReadingHistoryCreate) SetAccount(v *Account) *ReadingHistoryCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the ReadingHistoryMutation object of the builder.
+func (_c *ReadingHistoryCreate) Mutation() *ReadingHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the ReadingHistory in the database.
+func (_c *ReadingHistoryCreate) Save(ctx context.Context) (*ReadingHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReadingHistoryCreate) SaveX(ctx context.Context) *ReadingHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadingHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadingHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReadingHistoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := readinghistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := readinghistory.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := readinghistory.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReadingHistoryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReadingHistory.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReadingHistory.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ReadingHistory.account_id"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ReadingHistory.item_id"`)}
+	}
+	if _, ok := _c.mutation.ItemKind(); !ok {
+		return &ValidationError{Name: "item_kind", err: errors.New(`ent: missing required field "ReadingHistory.item_kind"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := readinghistory.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ReadingHistory.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "ReadingHistory.account"`)}
+	}
+	return nil
+}
+
+func (_c *ReadingHistoryCreate) sqlSave(ctx context.Context) (*ReadingHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReadingHistoryCreate) createSpec() (*ReadingHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReadingHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(readinghistory.Table, sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(readinghistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(readinghistory.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(readinghistory.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.ItemKind(); ok {
+		_spec.SetField(readinghistory.FieldItemKind, field.TypeString, value)
+		_node.ItemKind = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(readinghistory.FieldPosition, field.TypeString, value)
+		_node.Position = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinghistory.AccountTable,
+			Columns: []string{readinghistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReadingHistory.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReadingHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReadingHistoryCreate) OnConflict(opts ...sql.ConflictOption) *ReadingHistoryUpsertOne {
+	_c.conflict = opts
+	return &ReadingHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReadingHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReadingHistoryCreate) OnConflictColumns(columns ...string) *ReadingHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReadingHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReadingHistoryUpsertOne is the builder for "upsert"-ing
+	//  one ReadingHistory node.
+	ReadingHistoryUpsertOne struct {
+		create *ReadingHistoryCreate
+	}
+
+	// ReadingHistoryUpsert is the "OnConflict" setter.
+	ReadingHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReadingHistoryUpsert) SetUpdatedAt(v time.Time) *ReadingHistoryUpsert {
+	u.Set(readinghistory.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReadingHistoryUpsert) UpdateUpdatedAt() *ReadingHistoryUpsert {
+	u.SetExcluded(readinghistory.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ReadingHistoryUpsert) SetAccountID(v xid.ID) *ReadingHistoryUpsert {
+	u.Set(readinghistory.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ReadingHistoryUpsert) UpdateAccountID() *ReadingHistoryUpsert {
+	u.SetExcluded(readinghistory.FieldAccountID)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReadingHistoryUpsert) SetItemID(v xid.ID) *ReadingHistoryUpsert {
+	u.Set(readinghistory.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReadingHistoryUpsert) UpdateItemID() *ReadingHistoryUpsert {
+	u.SetExcluded(readinghistory.FieldItemID)
+	return u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ReadingHistoryUpsert) SetItemKind(v string) *ReadingHistoryUpsert {
+	u.Set(readinghistory.FieldItemKind, v)
+	return u
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ReadingHistoryUpsert) UpdateItemKind() *ReadingHistoryUpsert {
+	u.SetExcluded(readinghistory.FieldItemKind)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *ReadingHistoryUpsert) SetPosition(v string) *ReadingHistoryUpsert {
+	u.Set(readinghistory.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ReadingHistoryUpsert) UpdatePosition() *ReadingHistoryUpsert {
+	u.SetExcluded(readinghistory.FieldPosition)
+	return u
+}
+
+// ClearPosition clears the value of the "position" field.
+func (u *ReadingHistoryUpsert) ClearPosition() *ReadingHistoryUpsert {
+	u.SetNull(readinghistory.FieldPosition)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReadingHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(readinghistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReadingHistoryUpsertOne) UpdateNewValues() *ReadingHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(readinghistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(readinghistory.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReadingHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReadingHistoryUpsertOne) Ignore() *ReadingHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReadingHistoryUpsertOne) DoNothing() *ReadingHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReadingHistoryCreate.OnConflict
+// documentation for more info.
+func (u *ReadingHistoryUpsertOne) Update(set func(*ReadingHistoryUpsert)) *ReadingHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReadingHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReadingHistoryUpsertOne) SetUpdatedAt(v time.Time) *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertOne) UpdateUpdatedAt() *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ReadingHistoryUpsertOne) SetAccountID(v xid.ID) *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertOne) UpdateAccountID() *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReadingHistoryUpsertOne) SetItemID(v xid.ID) *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertOne) UpdateItemID() *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ReadingHistoryUpsertOne) SetItemKind(v string) *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertOne) UpdateItemKind() *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *ReadingHistoryUpsertOne) SetPosition(v string) *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertOne) UpdatePosition() *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// ClearPosition clears the value of the "position" field.
+func (u *ReadingHistoryUpsertOne) ClearPosition() *ReadingHistoryUpsertOne {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.ClearPosition()
+	})
+}
+
+// Exec executes the query.
+func (u *ReadingHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReadingHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReadingHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReadingHistoryUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReadingHistoryUpsertOne.ID is not supported by MySQL driver. Use ReadingHistoryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReadingHistoryUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReadingHistoryCreateBulk is the builder for creating many ReadingHistory entities in bulk.
+type ReadingHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*ReadingHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReadingHistory entities in the database.
+func (_c *ReadingHistoryCreateBulk) Save(ctx context.Context) ([]*ReadingHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReadingHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReadingHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReadingHistoryCreateBulk) SaveX(ctx context.Context) []*ReadingHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadingHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadingHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReadingHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReadingHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReadingHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReadingHistoryUpsertBulk {
+	_c.conflict = opts
+	return &ReadingHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReadingHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReadingHistoryCreateBulk) OnConflictColumns(columns ...string) *ReadingHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReadingHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReadingHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReadingHistory nodes.
+type ReadingHistoryUpsertBulk struct {
+	create *ReadingHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReadingHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(readinghistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReadingHistoryUpsertBulk) UpdateNewValues() *ReadingHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(readinghistory.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(readinghistory.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReadingHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReadingHistoryUpsertBulk) Ignore() *ReadingHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReadingHistoryUpsertBulk) DoNothing() *ReadingHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReadingHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReadingHistoryUpsertBulk) Update(set func(*ReadingHistoryUpsert)) *ReadingHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReadingHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReadingHistoryUpsertBulk) SetUpdatedAt(v time.Time) *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertBulk) UpdateUpdatedAt() *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ReadingHistoryUpsertBulk) SetAccountID(v xid.ID) *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertBulk) UpdateAccountID() *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReadingHistoryUpsertBulk) SetItemID(v xid.ID) *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertBulk) UpdateItemID() *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ReadingHistoryUpsertBulk) SetItemKind(v string) *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertBulk) UpdateItemKind() *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *ReadingHistoryUpsertBulk) SetPosition(v string) *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ReadingHistoryUpsertBulk) UpdatePosition() *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// ClearPosition clears the value of the "position" field.
+func (u *ReadingHistoryUpsertBulk) ClearPosition() *ReadingHistoryUpsertBulk {
+	return u.Update(func(s *ReadingHistoryUpsert) {
+		s.ClearPosition()
+	})
+}
+
+// Exec executes the query.
+func (u *ReadingHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReadingHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReadingHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReadingHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
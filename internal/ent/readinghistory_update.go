@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
+	"github.com/rs/xid"
+)
+
+// ReadingHistoryUpdate is the builder for updating ReadingHistory entities.
+type ReadingHistoryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReadingHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReadingHistoryUpdate builder.
+func (_u *ReadingHistoryUpdate) Where(ps ...predicate.ReadingHistory) *ReadingHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadingHistoryUpdate) SetUpdatedAt(v time.Time) *ReadingHistoryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ReadingHistoryUpdate) SetAccountID(v xid.ID) *ReadingHistoryUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ReadingHistoryUpdate) SetNillableAccountID(v *xid.ID) *ReadingHistoryUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReadingHistoryUpdate) SetItemID(v xid.ID) *ReadingHistoryUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReadingHistoryUpdate) SetNillableItemID(v *xid.ID) *ReadingHistoryUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ReadingHistoryUpdate) SetItemKind(v string) *ReadingHistoryUpdate {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ReadingHistoryUpdate) SetNillableItemKind(v *string) *ReadingHistoryUpdate {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ReadingHistoryUpdate) SetPosition(v string) *ReadingHistoryUpdate {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ReadingHistoryUpdate) SetNillablePosition(v *string) *ReadingHistoryUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// ClearPosition clears the value of the "position" field.
+func (_u *ReadingHistoryUpdate) ClearPosition() *ReadingHistoryUpdate {
+	_u.mutation.ClearPosition()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ReadingHistoryUpdate) SetAccount(v *Account) *ReadingHistoryUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ReadingHistoryMutation object of the builder.
+func (_u *ReadingHistoryUpdate) Mutation() *ReadingHistoryMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ReadingHistoryUpdate) ClearAccount() *ReadingHistoryUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReadingHistoryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadingHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReadingHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadingHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadingHistoryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readinghistory.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadingHistoryUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingHistory.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReadingHistoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReadingHistoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReadingHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readinghistory.Table, readinghistory.Columns, sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readinghistory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(readinghistory.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(readinghistory.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(readinghistory.FieldPosition, field.TypeString, value)
+	}
+	if _u.mutation.PositionCleared() {
+		_spec.ClearField(readinghistory.FieldPosition, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinghistory.AccountTable,
+			Columns: []string{readinghistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinghistory.AccountTable,
+			Columns: []string{readinghistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readinghistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReadingHistoryUpdateOne is the builder for updating a single ReadingHistory entity.
+type ReadingHistoryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReadingHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadingHistoryUpdateOne) SetUpdatedAt(v time.Time) *ReadingHistoryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ReadingHistoryUpdateOne) SetAccountID(v xid.ID) *ReadingHistoryUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ReadingHistoryUpdateOne) SetNillableAccountID(v *xid.ID) *ReadingHistoryUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReadingHistoryUpdateOne) SetItemID(v xid.ID) *ReadingHistoryUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReadingHistoryUpdateOne) SetNillableItemID(v *xid.ID) *ReadingHistoryUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ReadingHistoryUpdateOne) SetItemKind(v string) *ReadingHistoryUpdateOne {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ReadingHistoryUpdateOne) SetNillableItemKind(v *string) *ReadingHistoryUpdateOne {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ReadingHistoryUpdateOne) SetPosition(v string) *ReadingHistoryUpdateOne {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ReadingHistoryUpdateOne) SetNillablePosition(v *string) *ReadingHistoryUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// ClearPosition clears the value of the "position" field.
+func (_u *ReadingHistoryUpdateOne) ClearPosition() *ReadingHistoryUpdateOne {
+	_u.mutation.ClearPosition()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ReadingHistoryUpdateOne) SetAccount(v *Account) *ReadingHistoryUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ReadingHistoryMutation object of the builder.
+func (_u *ReadingHistoryUpdateOne) Mutation() *ReadingHistoryMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ReadingHistoryUpdateOne) ClearAccount() *ReadingHistoryUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the ReadingHistoryUpdate builder.
+func (_u *ReadingHistoryUpdateOne) Where(ps ...predicate.ReadingHistory) *ReadingHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReadingHistoryUpdateOne) Select(field string, fields ...string) *ReadingHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReadingHistory entity.
+func (_u *ReadingHistoryUpdateOne) Save(ctx context.Context) (*ReadingHistory, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadingHistoryUpdateOne) SaveX(ctx context.Context) *ReadingHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReadingHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadingHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadingHistoryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readinghistory.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadingHistoryUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingHistory.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReadingHistoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReadingHistoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReadingHistoryUpdateOne) sqlSave(ctx context.Context) (_node *ReadingHistory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readinghistory.Table, readinghistory.Columns, sqlgraph.NewFieldSpec(readinghistory.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReadingHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, readinghistory.FieldID)
+		for _, f := range fields {
+			if !readinghistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != readinghistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readinghistory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(readinghistory.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(readinghistory.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(readinghistory.FieldPosition, field.TypeString, value)
+	}
+	if _u.mutation.PositionCleared() {
+		_spec.ClearField(readinghistory.FieldPosition, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinghistory.AccountTable,
+			Columns: []string{readinghistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinghistory.AccountTable,
+			Columns: []string{readinghistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ReadingHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readinghistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
+	"github.com/rs/xid"
+)
+
+// ReputationEntry is the model entity for the ReputationEntry schema.
+type ReputationEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Points holds the value of the "points" field.
+	Points int `json:"points,omitempty"`
+	// ItemKind holds the value of the "item_kind" field.
+	ItemKind *string `json:"item_kind,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID *xid.ID `json:"item_id,omitempty"`
+	// The thing which caused the award, such as the liking account or the reaction, used to revoke it later.
+	SourceID *xid.ID `json:"source_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReputationEntryQuery when eager-loading is set.
+	Edges        ReputationEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReputationEntryEdges holds the relations/edges for other nodes in the graph.
+type ReputationEntryEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReputationEntryEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReputationEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reputationentry.FieldItemID, reputationentry.FieldSourceID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case reputationentry.FieldPoints:
+			values[i] = new(sql.NullInt64)
+		case reputationentry.FieldReason, reputationentry.FieldItemKind:
+			values[i] = new(sql.NullString)
+		case reputationentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case reputationentry.FieldID, reputationentry.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReputationEntry fields.
+func (_m *ReputationEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reputationentry.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reputationentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case reputationentry.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case reputationentry.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case reputationentry.FieldPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points", values[i])
+			} else if value.Valid {
+				_m.Points = int(value.Int64)
+			}
+		case reputationentry.FieldItemKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_kind", values[i])
+			} else if value.Valid {
+				_m.ItemKind = new(string)
+				*_m.ItemKind = value.String
+			}
+		case reputationentry.FieldItemID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				_m.ItemID = new(xid.ID)
+				*_m.ItemID = *value.S.(*xid.ID)
+			}
+		case reputationentry.FieldSourceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				_m.SourceID = new(xid.ID)
+				*_m.SourceID = *value.S.(*xid.ID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReputationEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReputationEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the ReputationEntry entity.
+func (_m *ReputationEntry) QueryAccount() *AccountQuery {
+	return NewReputationEntryClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this ReputationEntry.
+// Note that you need to call ReputationEntry.Unwrap() before calling this method if this ReputationEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReputationEntry) Update() *ReputationEntryUpdateOne {
+	return NewReputationEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReputationEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReputationEntry) Unwrap() *ReputationEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReputationEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReputationEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReputationEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("points=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Points))
+	builder.WriteString(", ")
+	if v := _m.ItemKind; v != nil {
+		builder.WriteString("item_kind=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ItemID; v != nil {
+		builder.WriteString("item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SourceID; v != nil {
+		builder.WriteString("source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReputationEntries is a parsable slice of ReputationEntry.
+type ReputationEntries []*ReputationEntry
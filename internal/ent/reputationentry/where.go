@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reputationentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldReason, v))
+}
+
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldPoints, v))
+}
+
+// ItemKind applies equality check predicate on the "item_kind" field. It's identical to ItemKindEQ.
+func ItemKind(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldItemID, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldSourceID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldContainsFold(FieldReason, v))
+}
+
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldPoints, v))
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldPoints, v))
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldPoints, vs...))
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldPoints, vs...))
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldPoints, v))
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldPoints, v))
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldPoints, v))
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldPoints, v))
+}
+
+// ItemKindEQ applies the EQ predicate on the "item_kind" field.
+func ItemKindEQ(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemKindNEQ applies the NEQ predicate on the "item_kind" field.
+func ItemKindNEQ(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldItemKind, v))
+}
+
+// ItemKindIn applies the In predicate on the "item_kind" field.
+func ItemKindIn(vs ...string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldItemKind, vs...))
+}
+
+// ItemKindNotIn applies the NotIn predicate on the "item_kind" field.
+func ItemKindNotIn(vs ...string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldItemKind, vs...))
+}
+
+// ItemKindGT applies the GT predicate on the "item_kind" field.
+func ItemKindGT(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldItemKind, v))
+}
+
+// ItemKindGTE applies the GTE predicate on the "item_kind" field.
+func ItemKindGTE(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldItemKind, v))
+}
+
+// ItemKindLT applies the LT predicate on the "item_kind" field.
+func ItemKindLT(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldItemKind, v))
+}
+
+// ItemKindLTE applies the LTE predicate on the "item_kind" field.
+func ItemKindLTE(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldItemKind, v))
+}
+
+// ItemKindContains applies the Contains predicate on the "item_kind" field.
+func ItemKindContains(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldContains(FieldItemKind, v))
+}
+
+// ItemKindHasPrefix applies the HasPrefix predicate on the "item_kind" field.
+func ItemKindHasPrefix(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldHasPrefix(FieldItemKind, v))
+}
+
+// ItemKindHasSuffix applies the HasSuffix predicate on the "item_kind" field.
+func ItemKindHasSuffix(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldHasSuffix(FieldItemKind, v))
+}
+
+// ItemKindIsNil applies the IsNil predicate on the "item_kind" field.
+func ItemKindIsNil() predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIsNull(FieldItemKind))
+}
+
+// ItemKindNotNil applies the NotNil predicate on the "item_kind" field.
+func ItemKindNotNil() predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotNull(FieldItemKind))
+}
+
+// ItemKindEqualFold applies the EqualFold predicate on the "item_kind" field.
+func ItemKindEqualFold(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEqualFold(FieldItemKind, v))
+}
+
+// ItemKindContainsFold applies the ContainsFold predicate on the "item_kind" field.
+func ItemKindContainsFold(v string) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldContainsFold(FieldItemKind, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldContains(FieldItemID, vc))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldHasPrefix(FieldItemID, vc))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldHasSuffix(FieldItemID, vc))
+}
+
+// ItemIDIsNil applies the IsNil predicate on the "item_id" field.
+func ItemIDIsNil() predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIsNull(FieldItemID))
+}
+
+// ItemIDNotNil applies the NotNil predicate on the "item_id" field.
+func ItemIDNotNil() predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotNull(FieldItemID))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldEqualFold(FieldItemID, vc))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldContainsFold(FieldItemID, vc))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v xid.ID) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldContains(FieldSourceID, vc))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldHasPrefix(FieldSourceID, vc))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldHasSuffix(FieldSourceID, vc))
+}
+
+// SourceIDIsNil applies the IsNil predicate on the "source_id" field.
+func SourceIDIsNil() predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldIsNull(FieldSourceID))
+}
+
+// SourceIDNotNil applies the NotNil predicate on the "source_id" field.
+func SourceIDNotNil() predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.FieldNotNull(FieldSourceID))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldEqualFold(FieldSourceID, vc))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v xid.ID) predicate.ReputationEntry {
+	vc := v.String()
+	return predicate.ReputationEntry(sql.FieldContainsFold(FieldSourceID, vc))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.ReputationEntry {
+	return predicate.ReputationEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.ReputationEntry {
+	return predicate.ReputationEntry(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReputationEntry) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReputationEntry) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReputationEntry) predicate.ReputationEntry {
+	return predicate.ReputationEntry(sql.NotPredicates(p))
+}
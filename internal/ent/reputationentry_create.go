@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
+	"github.com/rs/xid"
+)
+
+// ReputationEntryCreate is the builder for creating a ReputationEntry entity.
+type ReputationEntryCreate struct {
+	config
+	mutation *ReputationEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReputationEntryCreate) SetCreatedAt(v time.Time) *ReputationEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReputationEntryCreate) SetNillableCreatedAt(v *time.Time) *ReputationEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ReputationEntryCreate) SetAccountID(v xid.ID) *ReputationEntryCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ReputationEntryCreate) SetReason(v string) *ReputationEntryCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetPoints sets the "points" field.
+func (_c *ReputationEntryCreate) SetPoints(v int) *ReputationEntryCreate {
+	_c.mutation.SetPoints(v)
+	return _c
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_c *ReputationEntryCreate) SetItemKind(v string) *ReputationEntryCreate {
+	_c.mutation.SetItemKind(v)
+	return _c
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_c *ReputationEntryCreate) SetNillableItemKind(v *string) *ReputationEntryCreate {
+	if v != nil {
+		_c.SetItemKind(*v)
+	}
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ReputationEntryCreate) SetItemID(v xid.ID) *ReputationEntryCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_c *ReputationEntryCreate) SetNillableItemID(v *xid.ID) *ReputationEntryCreate {
+	if v != nil {
+		_c.SetItemID(*v)
+	}
+	return _c
+}
+
+// SetSourceID sets the "source_id" field.
+func (_c *ReputationEntryCreate) SetSourceID(v xid.ID) *ReputationEntryCreate {
+	_c.mutation.SetSourceID(v)
+	return _c
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_c *ReputationEntryCreate) SetNillableSourceID(v *xid.ID) *ReputationEntryCreate {
+	if v != nil {
+		_c.SetSourceID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReputationEntryCreate) SetID(v xid.ID) *ReputationEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReputationEntryCreate) SetNillableID(v *xid.ID) *ReputationEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ReputationEntryCreate) SetAccount(v *Account) *ReputationEntryCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the ReputationEntryMutation object of the builder.
+func (_c *ReputationEntryCreate) Mutation() *ReputationEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the ReputationEntry in the database.
+func (_c *ReputationEntryCreate) Save(ctx context.Context) (*ReputationEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReputationEntryCreate) SaveX(ctx context.Context) *ReputationEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReputationEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReputationEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReputationEntryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reputationentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := reputationentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReputationEntryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReputationEntry.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ReputationEntry.account_id"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ReputationEntry.reason"`)}
+	}
+	if _, ok := _c.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "ReputationEntry.points"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := reputationentry.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ReputationEntry.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "ReputationEntry.account"`)}
+	}
+	return nil
+}
+
+func (_c *ReputationEntryCreate) sqlSave(ctx context.Context) (*ReputationEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReputationEntryCreate) createSpec() (*ReputationEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReputationEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reputationentry.Table, sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reputationentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(reputationentry.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Points(); ok {
+		_spec.SetField(reputationentry.FieldPoints, field.TypeInt, value)
+		_node.Points = value
+	}
+	if value, ok := _c.mutation.ItemKind(); ok {
+		_spec.SetField(reputationentry.FieldItemKind, field.TypeString, value)
+		_node.ItemKind = &value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(reputationentry.FieldItemID, field.TypeString, value)
+		_node.ItemID = &value
+	}
+	if value, ok := _c.mutation.SourceID(); ok {
+		_spec.SetField(reputationentry.FieldSourceID, field.TypeString, value)
+		_node.SourceID = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reputationentry.AccountTable,
+			Columns: []string{reputationentry.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReputationEntry.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReputationEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReputationEntryCreate) OnConflict(opts ...sql.ConflictOption) *ReputationEntryUpsertOne {
+	_c.conflict = opts
+	return &ReputationEntryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReputationEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReputationEntryCreate) OnConflictColumns(columns ...string) *ReputationEntryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReputationEntryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReputationEntryUpsertOne is the builder for "upsert"-ing
+	//  one ReputationEntry node.
+	ReputationEntryUpsertOne struct {
+		create *ReputationEntryCreate
+	}
+
+	// ReputationEntryUpsert is the "OnConflict" setter.
+	ReputationEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *ReputationEntryUpsert) SetAccountID(v xid.ID) *ReputationEntryUpsert {
+	u.Set(reputationentry.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsert) UpdateAccountID() *ReputationEntryUpsert {
+	u.SetExcluded(reputationentry.FieldAccountID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ReputationEntryUpsert) SetReason(v string) *ReputationEntryUpsert {
+	u.Set(reputationentry.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReputationEntryUpsert) UpdateReason() *ReputationEntryUpsert {
+	u.SetExcluded(reputationentry.FieldReason)
+	return u
+}
+
+// SetPoints sets the "points" field.
+func (u *ReputationEntryUpsert) SetPoints(v int) *ReputationEntryUpsert {
+	u.Set(reputationentry.FieldPoints, v)
+	return u
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *ReputationEntryUpsert) UpdatePoints() *ReputationEntryUpsert {
+	u.SetExcluded(reputationentry.FieldPoints)
+	return u
+}
+
+// AddPoints adds v to the "points" field.
+func (u *ReputationEntryUpsert) AddPoints(v int) *ReputationEntryUpsert {
+	u.Add(reputationentry.FieldPoints, v)
+	return u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ReputationEntryUpsert) SetItemKind(v string) *ReputationEntryUpsert {
+	u.Set(reputationentry.FieldItemKind, v)
+	return u
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ReputationEntryUpsert) UpdateItemKind() *ReputationEntryUpsert {
+	u.SetExcluded(reputationentry.FieldItemKind)
+	return u
+}
+
+// ClearItemKind clears the value of the "item_kind" field.
+func (u *ReputationEntryUpsert) ClearItemKind() *ReputationEntryUpsert {
+	u.SetNull(reputationentry.FieldItemKind)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReputationEntryUpsert) SetItemID(v xid.ID) *ReputationEntryUpsert {
+	u.Set(reputationentry.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsert) UpdateItemID() *ReputationEntryUpsert {
+	u.SetExcluded(reputationentry.FieldItemID)
+	return u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (u *ReputationEntryUpsert) ClearItemID() *ReputationEntryUpsert {
+	u.SetNull(reputationentry.FieldItemID)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReputationEntryUpsert) SetSourceID(v xid.ID) *ReputationEntryUpsert {
+	u.Set(reputationentry.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsert) UpdateSourceID() *ReputationEntryUpsert {
+	u.SetExcluded(reputationentry.FieldSourceID)
+	return u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ReputationEntryUpsert) ClearSourceID() *ReputationEntryUpsert {
+	u.SetNull(reputationentry.FieldSourceID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReputationEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reputationentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReputationEntryUpsertOne) UpdateNewValues() *ReputationEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reputationentry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reputationentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReputationEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReputationEntryUpsertOne) Ignore() *ReputationEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReputationEntryUpsertOne) DoNothing() *ReputationEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReputationEntryCreate.OnConflict
+// documentation for more info.
+func (u *ReputationEntryUpsertOne) Update(set func(*ReputationEntryUpsert)) *ReputationEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReputationEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ReputationEntryUpsertOne) SetAccountID(v xid.ID) *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsertOne) UpdateAccountID() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReputationEntryUpsertOne) SetReason(v string) *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReputationEntryUpsertOne) UpdateReason() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *ReputationEntryUpsertOne) SetPoints(v int) *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *ReputationEntryUpsertOne) AddPoints(v int) *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *ReputationEntryUpsertOne) UpdatePoints() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ReputationEntryUpsertOne) SetItemKind(v string) *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ReputationEntryUpsertOne) UpdateItemKind() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// ClearItemKind clears the value of the "item_kind" field.
+func (u *ReputationEntryUpsertOne) ClearItemKind() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.ClearItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReputationEntryUpsertOne) SetItemID(v xid.ID) *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsertOne) UpdateItemID() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (u *ReputationEntryUpsertOne) ClearItemID() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.ClearItemID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReputationEntryUpsertOne) SetSourceID(v xid.ID) *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsertOne) UpdateSourceID() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ReputationEntryUpsertOne) ClearSourceID() *ReputationEntryUpsertOne {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// Exec executes the query.
+func (u *ReputationEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReputationEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReputationEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReputationEntryUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReputationEntryUpsertOne.ID is not supported by MySQL driver. Use ReputationEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReputationEntryUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReputationEntryCreateBulk is the builder for creating many ReputationEntry entities in bulk.
+type ReputationEntryCreateBulk struct {
+	config
+	err      error
+	builders []*ReputationEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReputationEntry entities in the database.
+func (_c *ReputationEntryCreateBulk) Save(ctx context.Context) ([]*ReputationEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReputationEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReputationEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReputationEntryCreateBulk) SaveX(ctx context.Context) []*ReputationEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReputationEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReputationEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReputationEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReputationEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReputationEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReputationEntryUpsertBulk {
+	_c.conflict = opts
+	return &ReputationEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReputationEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReputationEntryCreateBulk) OnConflictColumns(columns ...string) *ReputationEntryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReputationEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReputationEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReputationEntry nodes.
+type ReputationEntryUpsertBulk struct {
+	create *ReputationEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReputationEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reputationentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReputationEntryUpsertBulk) UpdateNewValues() *ReputationEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reputationentry.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reputationentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReputationEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReputationEntryUpsertBulk) Ignore() *ReputationEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReputationEntryUpsertBulk) DoNothing() *ReputationEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReputationEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReputationEntryUpsertBulk) Update(set func(*ReputationEntryUpsert)) *ReputationEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReputationEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ReputationEntryUpsertBulk) SetAccountID(v xid.ID) *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsertBulk) UpdateAccountID() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReputationEntryUpsertBulk) SetReason(v string) *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReputationEntryUpsertBulk) UpdateReason() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *ReputationEntryUpsertBulk) SetPoints(v int) *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *ReputationEntryUpsertBulk) AddPoints(v int) *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *ReputationEntryUpsertBulk) UpdatePoints() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *ReputationEntryUpsertBulk) SetItemKind(v string) *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *ReputationEntryUpsertBulk) UpdateItemKind() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// ClearItemKind clears the value of the "item_kind" field.
+func (u *ReputationEntryUpsertBulk) ClearItemKind() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.ClearItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReputationEntryUpsertBulk) SetItemID(v xid.ID) *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsertBulk) UpdateItemID() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (u *ReputationEntryUpsertBulk) ClearItemID() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.ClearItemID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReputationEntryUpsertBulk) SetSourceID(v xid.ID) *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReputationEntryUpsertBulk) UpdateSourceID() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ReputationEntryUpsertBulk) ClearSourceID() *ReputationEntryUpsertBulk {
+	return u.Update(func(s *ReputationEntryUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// Exec executes the query.
+func (u *ReputationEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReputationEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReputationEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReputationEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
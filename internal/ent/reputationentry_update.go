@@ -0,0 +1,567 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
+	"github.com/rs/xid"
+)
+
+// ReputationEntryUpdate is the builder for updating ReputationEntry entities.
+type ReputationEntryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReputationEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReputationEntryUpdate builder.
+func (_u *ReputationEntryUpdate) Where(ps ...predicate.ReputationEntry) *ReputationEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ReputationEntryUpdate) SetAccountID(v xid.ID) *ReputationEntryUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ReputationEntryUpdate) SetNillableAccountID(v *xid.ID) *ReputationEntryUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ReputationEntryUpdate) SetReason(v string) *ReputationEntryUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ReputationEntryUpdate) SetNillableReason(v *string) *ReputationEntryUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetPoints sets the "points" field.
+func (_u *ReputationEntryUpdate) SetPoints(v int) *ReputationEntryUpdate {
+	_u.mutation.ResetPoints()
+	_u.mutation.SetPoints(v)
+	return _u
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (_u *ReputationEntryUpdate) SetNillablePoints(v *int) *ReputationEntryUpdate {
+	if v != nil {
+		_u.SetPoints(*v)
+	}
+	return _u
+}
+
+// AddPoints adds value to the "points" field.
+func (_u *ReputationEntryUpdate) AddPoints(v int) *ReputationEntryUpdate {
+	_u.mutation.AddPoints(v)
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ReputationEntryUpdate) SetItemKind(v string) *ReputationEntryUpdate {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ReputationEntryUpdate) SetNillableItemKind(v *string) *ReputationEntryUpdate {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// ClearItemKind clears the value of the "item_kind" field.
+func (_u *ReputationEntryUpdate) ClearItemKind() *ReputationEntryUpdate {
+	_u.mutation.ClearItemKind()
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReputationEntryUpdate) SetItemID(v xid.ID) *ReputationEntryUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReputationEntryUpdate) SetNillableItemID(v *xid.ID) *ReputationEntryUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *ReputationEntryUpdate) ClearItemID() *ReputationEntryUpdate {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *ReputationEntryUpdate) SetSourceID(v xid.ID) *ReputationEntryUpdate {
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *ReputationEntryUpdate) SetNillableSourceID(v *xid.ID) *ReputationEntryUpdate {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (_u *ReputationEntryUpdate) ClearSourceID() *ReputationEntryUpdate {
+	_u.mutation.ClearSourceID()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ReputationEntryUpdate) SetAccount(v *Account) *ReputationEntryUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ReputationEntryMutation object of the builder.
+func (_u *ReputationEntryUpdate) Mutation() *ReputationEntryMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ReputationEntryUpdate) ClearAccount() *ReputationEntryUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReputationEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReputationEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReputationEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReputationEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReputationEntryUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReputationEntry.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReputationEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReputationEntryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReputationEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reputationentry.Table, reputationentry.Columns, sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(reputationentry.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Points(); ok {
+		_spec.SetField(reputationentry.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPoints(); ok {
+		_spec.AddField(reputationentry.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(reputationentry.FieldItemKind, field.TypeString, value)
+	}
+	if _u.mutation.ItemKindCleared() {
+		_spec.ClearField(reputationentry.FieldItemKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(reputationentry.FieldItemID, field.TypeString, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(reputationentry.FieldItemID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(reputationentry.FieldSourceID, field.TypeString, value)
+	}
+	if _u.mutation.SourceIDCleared() {
+		_spec.ClearField(reputationentry.FieldSourceID, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reputationentry.AccountTable,
+			Columns: []string{reputationentry.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reputationentry.AccountTable,
+			Columns: []string{reputationentry.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reputationentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReputationEntryUpdateOne is the builder for updating a single ReputationEntry entity.
+type ReputationEntryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReputationEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ReputationEntryUpdateOne) SetAccountID(v xid.ID) *ReputationEntryUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ReputationEntryUpdateOne) SetNillableAccountID(v *xid.ID) *ReputationEntryUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ReputationEntryUpdateOne) SetReason(v string) *ReputationEntryUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ReputationEntryUpdateOne) SetNillableReason(v *string) *ReputationEntryUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetPoints sets the "points" field.
+func (_u *ReputationEntryUpdateOne) SetPoints(v int) *ReputationEntryUpdateOne {
+	_u.mutation.ResetPoints()
+	_u.mutation.SetPoints(v)
+	return _u
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (_u *ReputationEntryUpdateOne) SetNillablePoints(v *int) *ReputationEntryUpdateOne {
+	if v != nil {
+		_u.SetPoints(*v)
+	}
+	return _u
+}
+
+// AddPoints adds value to the "points" field.
+func (_u *ReputationEntryUpdateOne) AddPoints(v int) *ReputationEntryUpdateOne {
+	_u.mutation.AddPoints(v)
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *ReputationEntryUpdateOne) SetItemKind(v string) *ReputationEntryUpdateOne {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *ReputationEntryUpdateOne) SetNillableItemKind(v *string) *ReputationEntryUpdateOne {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// ClearItemKind clears the value of the "item_kind" field.
+func (_u *ReputationEntryUpdateOne) ClearItemKind() *ReputationEntryUpdateOne {
+	_u.mutation.ClearItemKind()
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReputationEntryUpdateOne) SetItemID(v xid.ID) *ReputationEntryUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReputationEntryUpdateOne) SetNillableItemID(v *xid.ID) *ReputationEntryUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *ReputationEntryUpdateOne) ClearItemID() *ReputationEntryUpdateOne {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *ReputationEntryUpdateOne) SetSourceID(v xid.ID) *ReputationEntryUpdateOne {
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *ReputationEntryUpdateOne) SetNillableSourceID(v *xid.ID) *ReputationEntryUpdateOne {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (_u *ReputationEntryUpdateOne) ClearSourceID() *ReputationEntryUpdateOne {
+	_u.mutation.ClearSourceID()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ReputationEntryUpdateOne) SetAccount(v *Account) *ReputationEntryUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ReputationEntryMutation object of the builder.
+func (_u *ReputationEntryUpdateOne) Mutation() *ReputationEntryMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ReputationEntryUpdateOne) ClearAccount() *ReputationEntryUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the ReputationEntryUpdate builder.
+func (_u *ReputationEntryUpdateOne) Where(ps ...predicate.ReputationEntry) *ReputationEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReputationEntryUpdateOne) Select(field string, fields ...string) *ReputationEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReputationEntry entity.
+func (_u *ReputationEntryUpdateOne) Save(ctx context.Context) (*ReputationEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReputationEntryUpdateOne) SaveX(ctx context.Context) *ReputationEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReputationEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReputationEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReputationEntryUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReputationEntry.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReputationEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReputationEntryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReputationEntryUpdateOne) sqlSave(ctx context.Context) (_node *ReputationEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reputationentry.Table, reputationentry.Columns, sqlgraph.NewFieldSpec(reputationentry.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReputationEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reputationentry.FieldID)
+		for _, f := range fields {
+			if !reputationentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reputationentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(reputationentry.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Points(); ok {
+		_spec.SetField(reputationentry.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPoints(); ok {
+		_spec.AddField(reputationentry.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(reputationentry.FieldItemKind, field.TypeString, value)
+	}
+	if _u.mutation.ItemKindCleared() {
+		_spec.ClearField(reputationentry.FieldItemKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(reputationentry.FieldItemID, field.TypeString, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(reputationentry.FieldItemID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(reputationentry.FieldSourceID, field.TypeString, value)
+	}
+	if _u.mutation.SourceIDCleared() {
+		_spec.ClearField(reputationentry.FieldSourceID, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reputationentry.AccountTable,
+			Columns: []string{reputationentry.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reputationentry.AccountTable,
+			Columns: []string{reputationentry.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ReputationEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reputationentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -31,6 +31,8 @@ type Role struct {
 	Permissions []string `json:"permissions,omitempty"`
 	// SortKey holds the value of the "sort_key" field.
 	SortKey float64 `json:"sort_key,omitempty"`
+	// UploadQuota holds the value of the "upload_quota" field.
+	UploadQuota *int64 `json:"upload_quota,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoleQuery when eager-loading is set.
 	Edges        RoleEdges `json:"edges"`
@@ -75,6 +77,8 @@ func (*Role) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case role.FieldSortKey:
 			values[i] = new(sql.NullFloat64)
+		case role.FieldUploadQuota:
+			values[i] = new(sql.NullInt64)
 		case role.FieldName, role.FieldColour:
 			values[i] = new(sql.NullString)
 		case role.FieldCreatedAt, role.FieldUpdatedAt:
@@ -140,6 +144,13 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SortKey = value.Float64
 			}
+		case role.FieldUploadQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_quota", values[i])
+			} else if value.Valid {
+				_m.UploadQuota = new(int64)
+				*_m.UploadQuota = value.Int64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +214,11 @@ func (_m *Role) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("sort_key=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SortKey))
+	builder.WriteString(", ")
+	if v := _m.UploadQuota; v != nil {
+		builder.WriteString("upload_quota=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
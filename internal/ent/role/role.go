@@ -27,6 +27,8 @@ const (
 	FieldPermissions = "permissions"
 	// FieldSortKey holds the string denoting the sort_key field in the database.
 	FieldSortKey = "sort_key"
+	// FieldUploadQuota holds the string denoting the upload_quota field in the database.
+	FieldUploadQuota = "upload_quota"
 	// EdgeAccounts holds the string denoting the accounts edge name in mutations.
 	EdgeAccounts = "accounts"
 	// EdgeAccountRoles holds the string denoting the account_roles edge name in mutations.
@@ -56,6 +58,7 @@ var Columns = []string{
 	FieldColour,
 	FieldPermissions,
 	FieldSortKey,
+	FieldUploadQuota,
 }
 
 var (
@@ -122,6 +125,11 @@ func BySortKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSortKey, opts...).ToFunc()
 }
 
+// ByUploadQuota orders the results by the upload_quota field.
+func ByUploadQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadQuota, opts...).ToFunc()
+}
+
 // ByAccountsCount orders the results by accounts count.
 func ByAccountsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -81,6 +81,11 @@ func SortKey(v float64) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldSortKey, v))
 }
 
+// UploadQuota applies equality check predicate on the "upload_quota" field. It's identical to UploadQuotaEQ.
+func UploadQuota(v int64) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldUploadQuota, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldCreatedAt, v))
@@ -331,6 +336,56 @@ func SortKeyLTE(v float64) predicate.Role {
 	return predicate.Role(sql.FieldLTE(FieldSortKey, v))
 }
 
+// UploadQuotaEQ applies the EQ predicate on the "upload_quota" field.
+func UploadQuotaEQ(v int64) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldUploadQuota, v))
+}
+
+// UploadQuotaNEQ applies the NEQ predicate on the "upload_quota" field.
+func UploadQuotaNEQ(v int64) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldUploadQuota, v))
+}
+
+// UploadQuotaIn applies the In predicate on the "upload_quota" field.
+func UploadQuotaIn(vs ...int64) predicate.Role {
+	return predicate.Role(sql.FieldIn(FieldUploadQuota, vs...))
+}
+
+// UploadQuotaNotIn applies the NotIn predicate on the "upload_quota" field.
+func UploadQuotaNotIn(vs ...int64) predicate.Role {
+	return predicate.Role(sql.FieldNotIn(FieldUploadQuota, vs...))
+}
+
+// UploadQuotaGT applies the GT predicate on the "upload_quota" field.
+func UploadQuotaGT(v int64) predicate.Role {
+	return predicate.Role(sql.FieldGT(FieldUploadQuota, v))
+}
+
+// UploadQuotaGTE applies the GTE predicate on the "upload_quota" field.
+func UploadQuotaGTE(v int64) predicate.Role {
+	return predicate.Role(sql.FieldGTE(FieldUploadQuota, v))
+}
+
+// UploadQuotaLT applies the LT predicate on the "upload_quota" field.
+func UploadQuotaLT(v int64) predicate.Role {
+	return predicate.Role(sql.FieldLT(FieldUploadQuota, v))
+}
+
+// UploadQuotaLTE applies the LTE predicate on the "upload_quota" field.
+func UploadQuotaLTE(v int64) predicate.Role {
+	return predicate.Role(sql.FieldLTE(FieldUploadQuota, v))
+}
+
+// UploadQuotaIsNil applies the IsNil predicate on the "upload_quota" field.
+func UploadQuotaIsNil() predicate.Role {
+	return predicate.Role(sql.FieldIsNull(FieldUploadQuota))
+}
+
+// UploadQuotaNotNil applies the NotNil predicate on the "upload_quota" field.
+func UploadQuotaNotNil() predicate.Role {
+	return predicate.Role(sql.FieldNotNull(FieldUploadQuota))
+}
+
 // HasAccounts applies the HasEdge predicate on the "accounts" edge.
 func HasAccounts() predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
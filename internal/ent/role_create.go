@@ -86,6 +86,20 @@ func (_c *RoleCreate) SetSortKey(v float64) *RoleCreate {
 	return _c
 }
 
+// SetUploadQuota sets the "upload_quota" field.
+func (_c *RoleCreate) SetUploadQuota(v int64) *RoleCreate {
+	_c.mutation.SetUploadQuota(v)
+	return _c
+}
+
+// SetNillableUploadQuota sets the "upload_quota" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableUploadQuota(v *int64) *RoleCreate {
+	if v != nil {
+		_c.SetUploadQuota(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RoleCreate) SetID(v xid.ID) *RoleCreate {
 	_c.mutation.SetID(v)
@@ -268,6 +282,10 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldSortKey, field.TypeFloat64, value)
 		_node.SortKey = value
 	}
+	if value, ok := _c.mutation.UploadQuota(); ok {
+		_spec.SetField(role.FieldUploadQuota, field.TypeInt64, value)
+		_node.UploadQuota = &value
+	}
 	if nodes := _c.mutation.AccountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -425,6 +443,30 @@ func (u *RoleUpsert) AddSortKey(v float64) *RoleUpsert {
 	return u
 }
 
+// SetUploadQuota sets the "upload_quota" field.
+func (u *RoleUpsert) SetUploadQuota(v int64) *RoleUpsert {
+	u.Set(role.FieldUploadQuota, v)
+	return u
+}
+
+// UpdateUploadQuota sets the "upload_quota" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateUploadQuota() *RoleUpsert {
+	u.SetExcluded(role.FieldUploadQuota)
+	return u
+}
+
+// AddUploadQuota adds v to the "upload_quota" field.
+func (u *RoleUpsert) AddUploadQuota(v int64) *RoleUpsert {
+	u.Add(role.FieldUploadQuota, v)
+	return u
+}
+
+// ClearUploadQuota clears the value of the "upload_quota" field.
+func (u *RoleUpsert) ClearUploadQuota() *RoleUpsert {
+	u.SetNull(role.FieldUploadQuota)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -553,6 +595,34 @@ func (u *RoleUpsertOne) UpdateSortKey() *RoleUpsertOne {
 	})
 }
 
+// SetUploadQuota sets the "upload_quota" field.
+func (u *RoleUpsertOne) SetUploadQuota(v int64) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetUploadQuota(v)
+	})
+}
+
+// AddUploadQuota adds v to the "upload_quota" field.
+func (u *RoleUpsertOne) AddUploadQuota(v int64) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddUploadQuota(v)
+	})
+}
+
+// UpdateUploadQuota sets the "upload_quota" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateUploadQuota() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateUploadQuota()
+	})
+}
+
+// ClearUploadQuota clears the value of the "upload_quota" field.
+func (u *RoleUpsertOne) ClearUploadQuota() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearUploadQuota()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -848,6 +918,34 @@ func (u *RoleUpsertBulk) UpdateSortKey() *RoleUpsertBulk {
 	})
 }
 
+// SetUploadQuota sets the "upload_quota" field.
+func (u *RoleUpsertBulk) SetUploadQuota(v int64) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetUploadQuota(v)
+	})
+}
+
+// AddUploadQuota adds v to the "upload_quota" field.
+func (u *RoleUpsertBulk) AddUploadQuota(v int64) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddUploadQuota(v)
+	})
+}
+
+// UpdateUploadQuota sets the "upload_quota" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateUploadQuota() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateUploadQuota()
+	})
+}
+
+// ClearUploadQuota clears the value of the "upload_quota" field.
+func (u *RoleUpsertBulk) ClearUploadQuota() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearUploadQuota()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -100,6 +100,33 @@ func (_u *RoleUpdate) AddSortKey(v float64) *RoleUpdate {
 	return _u
 }
 
+// SetUploadQuota sets the "upload_quota" field.
+func (_u *RoleUpdate) SetUploadQuota(v int64) *RoleUpdate {
+	_u.mutation.ResetUploadQuota()
+	_u.mutation.SetUploadQuota(v)
+	return _u
+}
+
+// SetNillableUploadQuota sets the "upload_quota" field if the given value is not nil.
+func (_u *RoleUpdate) SetNillableUploadQuota(v *int64) *RoleUpdate {
+	if v != nil {
+		_u.SetUploadQuota(*v)
+	}
+	return _u
+}
+
+// AddUploadQuota adds value to the "upload_quota" field.
+func (_u *RoleUpdate) AddUploadQuota(v int64) *RoleUpdate {
+	_u.mutation.AddUploadQuota(v)
+	return _u
+}
+
+// ClearUploadQuota clears the value of the "upload_quota" field.
+func (_u *RoleUpdate) ClearUploadQuota() *RoleUpdate {
+	_u.mutation.ClearUploadQuota()
+	return _u
+}
+
 // AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
 func (_u *RoleUpdate) AddAccountIDs(ids ...xid.ID) *RoleUpdate {
 	_u.mutation.AddAccountIDs(ids...)
@@ -251,6 +278,15 @@ func (_u *RoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSortKey(); ok {
 		_spec.AddField(role.FieldSortKey, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.UploadQuota(); ok {
+		_spec.SetField(role.FieldUploadQuota, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadQuota(); ok {
+		_spec.AddField(role.FieldUploadQuota, field.TypeInt64, value)
+	}
+	if _u.mutation.UploadQuotaCleared() {
+		_spec.ClearField(role.FieldUploadQuota, field.TypeInt64)
+	}
 	if _u.mutation.AccountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -451,6 +487,33 @@ func (_u *RoleUpdateOne) AddSortKey(v float64) *RoleUpdateOne {
 	return _u
 }
 
+// SetUploadQuota sets the "upload_quota" field.
+func (_u *RoleUpdateOne) SetUploadQuota(v int64) *RoleUpdateOne {
+	_u.mutation.ResetUploadQuota()
+	_u.mutation.SetUploadQuota(v)
+	return _u
+}
+
+// SetNillableUploadQuota sets the "upload_quota" field if the given value is not nil.
+func (_u *RoleUpdateOne) SetNillableUploadQuota(v *int64) *RoleUpdateOne {
+	if v != nil {
+		_u.SetUploadQuota(*v)
+	}
+	return _u
+}
+
+// AddUploadQuota adds value to the "upload_quota" field.
+func (_u *RoleUpdateOne) AddUploadQuota(v int64) *RoleUpdateOne {
+	_u.mutation.AddUploadQuota(v)
+	return _u
+}
+
+// ClearUploadQuota clears the value of the "upload_quota" field.
+func (_u *RoleUpdateOne) ClearUploadQuota() *RoleUpdateOne {
+	_u.mutation.ClearUploadQuota()
+	return _u
+}
+
 // AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
 func (_u *RoleUpdateOne) AddAccountIDs(ids ...xid.ID) *RoleUpdateOne {
 	_u.mutation.AddAccountIDs(ids...)
@@ -632,6 +695,15 @@ func (_u *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error) {
 	if value, ok := _u.mutation.AddedSortKey(); ok {
 		_spec.AddField(role.FieldSortKey, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.UploadQuota(); ok {
+		_spec.SetField(role.FieldUploadQuota, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadQuota(); ok {
+		_spec.AddField(role.FieldUploadQuota, field.TypeInt64, value)
+	}
+	if _u.mutation.UploadQuotaCleared() {
+		_spec.ClearField(role.FieldUploadQuota, field.TypeInt64)
+	}
 	if _u.mutation.AccountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -7,36 +7,91 @@ import (
 
 	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountapplication"
+	"github.com/Southclaws/storyden/internal/ent/accountbadge"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
+	"github.com/Southclaws/storyden/internal/ent/accountonboardingstep"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
+	"github.com/Southclaws/storyden/internal/ent/analyticscohort"
+	"github.com/Southclaws/storyden/internal/ent/analyticscontent"
+	"github.com/Southclaws/storyden/internal/ent/analyticsday"
+	"github.com/Southclaws/storyden/internal/ent/analyticsexport"
+	"github.com/Southclaws/storyden/internal/ent/apiusage"
 	"github.com/Southclaws/storyden/internal/ent/asset"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
+	"github.com/Southclaws/storyden/internal/ent/automodrule"
+	"github.com/Southclaws/storyden/internal/ent/badge"
+	"github.com/Southclaws/storyden/internal/ent/bookmark"
 	"github.com/Southclaws/storyden/internal/ent/category"
+	"github.com/Southclaws/storyden/internal/ent/categoryfollow"
+	"github.com/Southclaws/storyden/internal/ent/categorypermission"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/collectionsection"
+	"github.com/Southclaws/storyden/internal/ent/collectionshare"
+	"github.com/Southclaws/storyden/internal/ent/contentsummary"
+	"github.com/Southclaws/storyden/internal/ent/contentview"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+	"github.com/Southclaws/storyden/internal/ent/digestsubscription"
+	"github.com/Southclaws/storyden/internal/ent/directmessage"
+	"github.com/Southclaws/storyden/internal/ent/discordbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
+	"github.com/Southclaws/storyden/internal/ent/featureflag"
+	"github.com/Southclaws/storyden/internal/ent/federationfollower"
+	"github.com/Southclaws/storyden/internal/ent/federationkey"
+	"github.com/Southclaws/storyden/internal/ent/feedtoken"
+	"github.com/Southclaws/storyden/internal/ent/importrecord"
+	"github.com/Southclaws/storyden/internal/ent/importrun"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
+	"github.com/Southclaws/storyden/internal/ent/job"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/linkcheck"
+	"github.com/Southclaws/storyden/internal/ent/matrixbridgelink"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/moderationnote"
+	"github.com/Southclaws/storyden/internal/ent/networkban"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/policyacceptance"
+	"github.com/Southclaws/storyden/internal/ent/policydocument"
+	"github.com/Southclaws/storyden/internal/ent/policyversion"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
 	"github.com/Southclaws/storyden/internal/ent/property"
 	"github.com/Southclaws/storyden/internal/ent/propertyschema"
 	"github.com/Southclaws/storyden/internal/ent/propertyschemafield"
+	"github.com/Southclaws/storyden/internal/ent/pushsubscription"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
+	"github.com/Southclaws/storyden/internal/ent/readinghistory"
 	"github.com/Southclaws/storyden/internal/ent/report"
+	"github.com/Southclaws/storyden/internal/ent/reputationentry"
 	"github.com/Southclaws/storyden/internal/ent/role"
+	"github.com/Southclaws/storyden/internal/ent/scheduledtask"
 	"github.com/Southclaws/storyden/internal/ent/schema"
 	"github.com/Southclaws/storyden/internal/ent/session"
 	"github.com/Southclaws/storyden/internal/ent/setting"
+	"github.com/Southclaws/storyden/internal/ent/space"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
+	"github.com/Southclaws/storyden/internal/ent/tenant"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
+	"github.com/Southclaws/storyden/internal/ent/wordfilter"
 	"github.com/rs/xid"
 )
 
@@ -44,6 +99,61 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apiusageMixin := schema.APIUsage{}.Mixin()
+	apiusageMixinFields0 := apiusageMixin[0].Fields()
+	_ = apiusageMixinFields0
+	apiusageMixinFields1 := apiusageMixin[1].Fields()
+	_ = apiusageMixinFields1
+	apiusageMixinFields2 := apiusageMixin[2].Fields()
+	_ = apiusageMixinFields2
+	apiusageFields := schema.APIUsage{}.Fields()
+	_ = apiusageFields
+	// apiusageDescCreatedAt is the schema descriptor for created_at field.
+	apiusageDescCreatedAt := apiusageMixinFields1[0].Descriptor()
+	// apiusage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apiusage.DefaultCreatedAt = apiusageDescCreatedAt.Default.(func() time.Time)
+	// apiusageDescUpdatedAt is the schema descriptor for updated_at field.
+	apiusageDescUpdatedAt := apiusageMixinFields2[0].Descriptor()
+	// apiusage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apiusage.DefaultUpdatedAt = apiusageDescUpdatedAt.Default.(func() time.Time)
+	// apiusage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apiusage.UpdateDefaultUpdatedAt = apiusageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apiusageDescRequests is the schema descriptor for requests field.
+	apiusageDescRequests := apiusageFields[3].Descriptor()
+	// apiusage.DefaultRequests holds the default value on creation for the requests field.
+	apiusage.DefaultRequests = apiusageDescRequests.Default.(int)
+	// apiusageDescClientErrors is the schema descriptor for client_errors field.
+	apiusageDescClientErrors := apiusageFields[4].Descriptor()
+	// apiusage.DefaultClientErrors holds the default value on creation for the client_errors field.
+	apiusage.DefaultClientErrors = apiusageDescClientErrors.Default.(int)
+	// apiusageDescServerErrors is the schema descriptor for server_errors field.
+	apiusageDescServerErrors := apiusageFields[5].Descriptor()
+	// apiusage.DefaultServerErrors holds the default value on creation for the server_errors field.
+	apiusage.DefaultServerErrors = apiusageDescServerErrors.Default.(int)
+	// apiusageDescRateLimited is the schema descriptor for rate_limited field.
+	apiusageDescRateLimited := apiusageFields[6].Descriptor()
+	// apiusage.DefaultRateLimited holds the default value on creation for the rate_limited field.
+	apiusage.DefaultRateLimited = apiusageDescRateLimited.Default.(int)
+	// apiusageDescID is the schema descriptor for id field.
+	apiusageDescID := apiusageMixinFields0[0].Descriptor()
+	// apiusage.DefaultID holds the default value on creation for the id field.
+	apiusage.DefaultID = apiusageDescID.Default.(func() xid.ID)
+	// apiusage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apiusage.IDValidator = func() func(string) error {
+		validators := apiusageDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	accountMixin := schema.Account{}.Mixin()
 	accountMixinFields0 := accountMixin[0].Fields()
 	_ = accountMixinFields0
@@ -75,6 +185,10 @@ func init() {
 	accountDescAdmin := accountFields[4].Descriptor()
 	// account.DefaultAdmin holds the default value on creation for the admin field.
 	account.DefaultAdmin = accountDescAdmin.Default.(bool)
+	// accountDescReadingHistoryEnabled is the schema descriptor for reading_history_enabled field.
+	accountDescReadingHistoryEnabled := accountFields[10].Descriptor()
+	// account.DefaultReadingHistoryEnabled holds the default value on creation for the reading_history_enabled field.
+	account.DefaultReadingHistoryEnabled = accountDescReadingHistoryEnabled.Default.(bool)
 	// accountDescID is the schema descriptor for id field.
 	accountDescID := accountMixinFields0[0].Descriptor()
 	// account.DefaultID holds the default value on creation for the id field.
@@ -95,6 +209,107 @@ func init() {
 			return nil
 		}
 	}()
+	accountapplicationMixin := schema.AccountApplication{}.Mixin()
+	accountapplicationMixinFields0 := accountapplicationMixin[0].Fields()
+	_ = accountapplicationMixinFields0
+	accountapplicationMixinFields1 := accountapplicationMixin[1].Fields()
+	_ = accountapplicationMixinFields1
+	accountapplicationMixinFields2 := accountapplicationMixin[2].Fields()
+	_ = accountapplicationMixinFields2
+	accountapplicationFields := schema.AccountApplication{}.Fields()
+	_ = accountapplicationFields
+	// accountapplicationDescCreatedAt is the schema descriptor for created_at field.
+	accountapplicationDescCreatedAt := accountapplicationMixinFields1[0].Descriptor()
+	// accountapplication.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountapplication.DefaultCreatedAt = accountapplicationDescCreatedAt.Default.(func() time.Time)
+	// accountapplicationDescUpdatedAt is the schema descriptor for updated_at field.
+	accountapplicationDescUpdatedAt := accountapplicationMixinFields2[0].Descriptor()
+	// accountapplication.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accountapplication.DefaultUpdatedAt = accountapplicationDescUpdatedAt.Default.(func() time.Time)
+	// accountapplication.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accountapplication.UpdateDefaultUpdatedAt = accountapplicationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accountapplicationDescID is the schema descriptor for id field.
+	accountapplicationDescID := accountapplicationMixinFields0[0].Descriptor()
+	// accountapplication.DefaultID holds the default value on creation for the id field.
+	accountapplication.DefaultID = accountapplicationDescID.Default.(func() xid.ID)
+	// accountapplication.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accountapplication.IDValidator = func() func(string) error {
+		validators := accountapplicationDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	accountbadgeMixin := schema.AccountBadge{}.Mixin()
+	accountbadgeMixinFields0 := accountbadgeMixin[0].Fields()
+	_ = accountbadgeMixinFields0
+	accountbadgeMixinFields1 := accountbadgeMixin[1].Fields()
+	_ = accountbadgeMixinFields1
+	accountbadgeFields := schema.AccountBadge{}.Fields()
+	_ = accountbadgeFields
+	// accountbadgeDescCreatedAt is the schema descriptor for created_at field.
+	accountbadgeDescCreatedAt := accountbadgeMixinFields1[0].Descriptor()
+	// accountbadge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountbadge.DefaultCreatedAt = accountbadgeDescCreatedAt.Default.(func() time.Time)
+	// accountbadgeDescID is the schema descriptor for id field.
+	accountbadgeDescID := accountbadgeMixinFields0[0].Descriptor()
+	// accountbadge.DefaultID holds the default value on creation for the id field.
+	accountbadge.DefaultID = accountbadgeDescID.Default.(func() xid.ID)
+	// accountbadge.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accountbadge.IDValidator = func() func(string) error {
+		validators := accountbadgeDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	accountblockMixin := schema.AccountBlock{}.Mixin()
+	accountblockMixinFields0 := accountblockMixin[0].Fields()
+	_ = accountblockMixinFields0
+	accountblockMixinFields1 := accountblockMixin[1].Fields()
+	_ = accountblockMixinFields1
+	accountblockFields := schema.AccountBlock{}.Fields()
+	_ = accountblockFields
+	// accountblockDescCreatedAt is the schema descriptor for created_at field.
+	accountblockDescCreatedAt := accountblockMixinFields1[0].Descriptor()
+	// accountblock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountblock.DefaultCreatedAt = accountblockDescCreatedAt.Default.(func() time.Time)
+	// accountblockDescID is the schema descriptor for id field.
+	accountblockDescID := accountblockMixinFields0[0].Descriptor()
+	// accountblock.DefaultID holds the default value on creation for the id field.
+	accountblock.DefaultID = accountblockDescID.Default.(func() xid.ID)
+	// accountblock.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accountblock.IDValidator = func() func(string) error {
+		validators := accountblockDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	accountfollowMixin := schema.AccountFollow{}.Mixin()
 	accountfollowMixinFields0 := accountfollowMixin[0].Fields()
 	_ = accountfollowMixinFields0
@@ -126,6 +341,37 @@ func init() {
 			return nil
 		}
 	}()
+	accountonboardingstepMixin := schema.AccountOnboardingStep{}.Mixin()
+	accountonboardingstepMixinFields0 := accountonboardingstepMixin[0].Fields()
+	_ = accountonboardingstepMixinFields0
+	accountonboardingstepMixinFields1 := accountonboardingstepMixin[1].Fields()
+	_ = accountonboardingstepMixinFields1
+	accountonboardingstepFields := schema.AccountOnboardingStep{}.Fields()
+	_ = accountonboardingstepFields
+	// accountonboardingstepDescCreatedAt is the schema descriptor for created_at field.
+	accountonboardingstepDescCreatedAt := accountonboardingstepMixinFields1[0].Descriptor()
+	// accountonboardingstep.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountonboardingstep.DefaultCreatedAt = accountonboardingstepDescCreatedAt.Default.(func() time.Time)
+	// accountonboardingstepDescID is the schema descriptor for id field.
+	accountonboardingstepDescID := accountonboardingstepMixinFields0[0].Descriptor()
+	// accountonboardingstep.DefaultID holds the default value on creation for the id field.
+	accountonboardingstep.DefaultID = accountonboardingstepDescID.Default.(func() xid.ID)
+	// accountonboardingstep.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accountonboardingstep.IDValidator = func() func(string) error {
+		validators := accountonboardingstepDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	accountrolesMixin := schema.AccountRoles{}.Mixin()
 	accountrolesMixinFields0 := accountrolesMixin[0].Fields()
 	_ = accountrolesMixinFields0
@@ -157,6 +403,214 @@ func init() {
 			return nil
 		}
 	}()
+	analyticscohortMixin := schema.AnalyticsCohort{}.Mixin()
+	analyticscohortMixinFields0 := analyticscohortMixin[0].Fields()
+	_ = analyticscohortMixinFields0
+	analyticscohortMixinFields1 := analyticscohortMixin[1].Fields()
+	_ = analyticscohortMixinFields1
+	analyticscohortMixinFields2 := analyticscohortMixin[2].Fields()
+	_ = analyticscohortMixinFields2
+	analyticscohortFields := schema.AnalyticsCohort{}.Fields()
+	_ = analyticscohortFields
+	// analyticscohortDescCreatedAt is the schema descriptor for created_at field.
+	analyticscohortDescCreatedAt := analyticscohortMixinFields1[0].Descriptor()
+	// analyticscohort.DefaultCreatedAt holds the default value on creation for the created_at field.
+	analyticscohort.DefaultCreatedAt = analyticscohortDescCreatedAt.Default.(func() time.Time)
+	// analyticscohortDescUpdatedAt is the schema descriptor for updated_at field.
+	analyticscohortDescUpdatedAt := analyticscohortMixinFields2[0].Descriptor()
+	// analyticscohort.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	analyticscohort.DefaultUpdatedAt = analyticscohortDescUpdatedAt.Default.(func() time.Time)
+	// analyticscohort.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	analyticscohort.UpdateDefaultUpdatedAt = analyticscohortDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// analyticscohortDescSize is the schema descriptor for size field.
+	analyticscohortDescSize := analyticscohortFields[2].Descriptor()
+	// analyticscohort.DefaultSize holds the default value on creation for the size field.
+	analyticscohort.DefaultSize = analyticscohortDescSize.Default.(int)
+	// analyticscohortDescRetained is the schema descriptor for retained field.
+	analyticscohortDescRetained := analyticscohortFields[3].Descriptor()
+	// analyticscohort.DefaultRetained holds the default value on creation for the retained field.
+	analyticscohort.DefaultRetained = analyticscohortDescRetained.Default.(int)
+	// analyticscohortDescID is the schema descriptor for id field.
+	analyticscohortDescID := analyticscohortMixinFields0[0].Descriptor()
+	// analyticscohort.DefaultID holds the default value on creation for the id field.
+	analyticscohort.DefaultID = analyticscohortDescID.Default.(func() xid.ID)
+	// analyticscohort.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	analyticscohort.IDValidator = func() func(string) error {
+		validators := analyticscohortDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	analyticscontentMixin := schema.AnalyticsContent{}.Mixin()
+	analyticscontentMixinFields0 := analyticscontentMixin[0].Fields()
+	_ = analyticscontentMixinFields0
+	analyticscontentMixinFields1 := analyticscontentMixin[1].Fields()
+	_ = analyticscontentMixinFields1
+	analyticscontentFields := schema.AnalyticsContent{}.Fields()
+	_ = analyticscontentFields
+	// analyticscontentDescCreatedAt is the schema descriptor for created_at field.
+	analyticscontentDescCreatedAt := analyticscontentMixinFields1[0].Descriptor()
+	// analyticscontent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	analyticscontent.DefaultCreatedAt = analyticscontentDescCreatedAt.Default.(func() time.Time)
+	// analyticscontentDescViews is the schema descriptor for views field.
+	analyticscontentDescViews := analyticscontentFields[3].Descriptor()
+	// analyticscontent.DefaultViews holds the default value on creation for the views field.
+	analyticscontent.DefaultViews = analyticscontentDescViews.Default.(int)
+	// analyticscontentDescReplies is the schema descriptor for replies field.
+	analyticscontentDescReplies := analyticscontentFields[4].Descriptor()
+	// analyticscontent.DefaultReplies holds the default value on creation for the replies field.
+	analyticscontent.DefaultReplies = analyticscontentDescReplies.Default.(int)
+	// analyticscontentDescReactions is the schema descriptor for reactions field.
+	analyticscontentDescReactions := analyticscontentFields[5].Descriptor()
+	// analyticscontent.DefaultReactions holds the default value on creation for the reactions field.
+	analyticscontent.DefaultReactions = analyticscontentDescReactions.Default.(int)
+	// analyticscontentDescLikes is the schema descriptor for likes field.
+	analyticscontentDescLikes := analyticscontentFields[6].Descriptor()
+	// analyticscontent.DefaultLikes holds the default value on creation for the likes field.
+	analyticscontent.DefaultLikes = analyticscontentDescLikes.Default.(int)
+	// analyticscontentDescCollections is the schema descriptor for collections field.
+	analyticscontentDescCollections := analyticscontentFields[7].Descriptor()
+	// analyticscontent.DefaultCollections holds the default value on creation for the collections field.
+	analyticscontent.DefaultCollections = analyticscontentDescCollections.Default.(int)
+	// analyticscontentDescID is the schema descriptor for id field.
+	analyticscontentDescID := analyticscontentMixinFields0[0].Descriptor()
+	// analyticscontent.DefaultID holds the default value on creation for the id field.
+	analyticscontent.DefaultID = analyticscontentDescID.Default.(func() xid.ID)
+	// analyticscontent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	analyticscontent.IDValidator = func() func(string) error {
+		validators := analyticscontentDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	analyticsdayMixin := schema.AnalyticsDay{}.Mixin()
+	analyticsdayMixinFields0 := analyticsdayMixin[0].Fields()
+	_ = analyticsdayMixinFields0
+	analyticsdayMixinFields1 := analyticsdayMixin[1].Fields()
+	_ = analyticsdayMixinFields1
+	analyticsdayMixinFields2 := analyticsdayMixin[2].Fields()
+	_ = analyticsdayMixinFields2
+	analyticsdayFields := schema.AnalyticsDay{}.Fields()
+	_ = analyticsdayFields
+	// analyticsdayDescCreatedAt is the schema descriptor for created_at field.
+	analyticsdayDescCreatedAt := analyticsdayMixinFields1[0].Descriptor()
+	// analyticsday.DefaultCreatedAt holds the default value on creation for the created_at field.
+	analyticsday.DefaultCreatedAt = analyticsdayDescCreatedAt.Default.(func() time.Time)
+	// analyticsdayDescUpdatedAt is the schema descriptor for updated_at field.
+	analyticsdayDescUpdatedAt := analyticsdayMixinFields2[0].Descriptor()
+	// analyticsday.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	analyticsday.DefaultUpdatedAt = analyticsdayDescUpdatedAt.Default.(func() time.Time)
+	// analyticsday.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	analyticsday.UpdateDefaultUpdatedAt = analyticsdayDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// analyticsdayDescSignups is the schema descriptor for signups field.
+	analyticsdayDescSignups := analyticsdayFields[1].Descriptor()
+	// analyticsday.DefaultSignups holds the default value on creation for the signups field.
+	analyticsday.DefaultSignups = analyticsdayDescSignups.Default.(int)
+	// analyticsdayDescDailyActive is the schema descriptor for daily_active field.
+	analyticsdayDescDailyActive := analyticsdayFields[2].Descriptor()
+	// analyticsday.DefaultDailyActive holds the default value on creation for the daily_active field.
+	analyticsday.DefaultDailyActive = analyticsdayDescDailyActive.Default.(int)
+	// analyticsdayDescWeeklyActive is the schema descriptor for weekly_active field.
+	analyticsdayDescWeeklyActive := analyticsdayFields[3].Descriptor()
+	// analyticsday.DefaultWeeklyActive holds the default value on creation for the weekly_active field.
+	analyticsday.DefaultWeeklyActive = analyticsdayDescWeeklyActive.Default.(int)
+	// analyticsdayDescMonthlyActive is the schema descriptor for monthly_active field.
+	analyticsdayDescMonthlyActive := analyticsdayFields[4].Descriptor()
+	// analyticsday.DefaultMonthlyActive holds the default value on creation for the monthly_active field.
+	analyticsday.DefaultMonthlyActive = analyticsdayDescMonthlyActive.Default.(int)
+	// analyticsdayDescThreads is the schema descriptor for threads field.
+	analyticsdayDescThreads := analyticsdayFields[5].Descriptor()
+	// analyticsday.DefaultThreads holds the default value on creation for the threads field.
+	analyticsday.DefaultThreads = analyticsdayDescThreads.Default.(int)
+	// analyticsdayDescReplies is the schema descriptor for replies field.
+	analyticsdayDescReplies := analyticsdayFields[6].Descriptor()
+	// analyticsday.DefaultReplies holds the default value on creation for the replies field.
+	analyticsday.DefaultReplies = analyticsdayDescReplies.Default.(int)
+	// analyticsdayDescReactions is the schema descriptor for reactions field.
+	analyticsdayDescReactions := analyticsdayFields[7].Descriptor()
+	// analyticsday.DefaultReactions holds the default value on creation for the reactions field.
+	analyticsday.DefaultReactions = analyticsdayDescReactions.Default.(int)
+	// analyticsdayDescLikes is the schema descriptor for likes field.
+	analyticsdayDescLikes := analyticsdayFields[8].Descriptor()
+	// analyticsday.DefaultLikes holds the default value on creation for the likes field.
+	analyticsday.DefaultLikes = analyticsdayDescLikes.Default.(int)
+	// analyticsdayDescID is the schema descriptor for id field.
+	analyticsdayDescID := analyticsdayMixinFields0[0].Descriptor()
+	// analyticsday.DefaultID holds the default value on creation for the id field.
+	analyticsday.DefaultID = analyticsdayDescID.Default.(func() xid.ID)
+	// analyticsday.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	analyticsday.IDValidator = func() func(string) error {
+		validators := analyticsdayDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	analyticsexportMixin := schema.AnalyticsExport{}.Mixin()
+	analyticsexportMixinFields0 := analyticsexportMixin[0].Fields()
+	_ = analyticsexportMixinFields0
+	analyticsexportMixinFields1 := analyticsexportMixin[1].Fields()
+	_ = analyticsexportMixinFields1
+	analyticsexportMixinFields2 := analyticsexportMixin[2].Fields()
+	_ = analyticsexportMixinFields2
+	analyticsexportFields := schema.AnalyticsExport{}.Fields()
+	_ = analyticsexportFields
+	// analyticsexportDescCreatedAt is the schema descriptor for created_at field.
+	analyticsexportDescCreatedAt := analyticsexportMixinFields1[0].Descriptor()
+	// analyticsexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	analyticsexport.DefaultCreatedAt = analyticsexportDescCreatedAt.Default.(func() time.Time)
+	// analyticsexportDescUpdatedAt is the schema descriptor for updated_at field.
+	analyticsexportDescUpdatedAt := analyticsexportMixinFields2[0].Descriptor()
+	// analyticsexport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	analyticsexport.DefaultUpdatedAt = analyticsexportDescUpdatedAt.Default.(func() time.Time)
+	// analyticsexport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	analyticsexport.UpdateDefaultUpdatedAt = analyticsexportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// analyticsexportDescID is the schema descriptor for id field.
+	analyticsexportDescID := analyticsexportMixinFields0[0].Descriptor()
+	// analyticsexport.DefaultID holds the default value on creation for the id field.
+	analyticsexport.DefaultID = analyticsexportDescID.Default.(func() xid.ID)
+	// analyticsexport.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	analyticsexport.IDValidator = func() func(string) error {
+		validators := analyticsexportDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	assetMixin := schema.Asset{}.Mixin()
 	assetMixinFields0 := assetMixin[0].Fields()
 	_ = assetMixinFields0
@@ -176,6 +630,10 @@ func init() {
 	asset.DefaultUpdatedAt = assetDescUpdatedAt.Default.(func() time.Time)
 	// asset.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	asset.UpdateDefaultUpdatedAt = assetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// assetDescPrivate is the schema descriptor for private field.
+	assetDescPrivate := assetFields[6].Descriptor()
+	// asset.DefaultPrivate holds the default value on creation for the private field.
+	asset.DefaultPrivate = assetDescPrivate.Default.(bool)
 	// assetDescID is the schema descriptor for id field.
 	assetDescID := assetMixinFields0[0].Descriptor()
 	// asset.DefaultID holds the default value on creation for the id field.
@@ -243,6 +701,131 @@ func init() {
 			return nil
 		}
 	}()
+	automodruleMixin := schema.AutomodRule{}.Mixin()
+	automodruleMixinFields0 := automodruleMixin[0].Fields()
+	_ = automodruleMixinFields0
+	automodruleMixinFields1 := automodruleMixin[1].Fields()
+	_ = automodruleMixinFields1
+	automodruleMixinFields2 := automodruleMixin[2].Fields()
+	_ = automodruleMixinFields2
+	automodruleFields := schema.AutomodRule{}.Fields()
+	_ = automodruleFields
+	// automodruleDescCreatedAt is the schema descriptor for created_at field.
+	automodruleDescCreatedAt := automodruleMixinFields1[0].Descriptor()
+	// automodrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	automodrule.DefaultCreatedAt = automodruleDescCreatedAt.Default.(func() time.Time)
+	// automodruleDescUpdatedAt is the schema descriptor for updated_at field.
+	automodruleDescUpdatedAt := automodruleMixinFields2[0].Descriptor()
+	// automodrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	automodrule.DefaultUpdatedAt = automodruleDescUpdatedAt.Default.(func() time.Time)
+	// automodrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	automodrule.UpdateDefaultUpdatedAt = automodruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// automodruleDescEnabled is the schema descriptor for enabled field.
+	automodruleDescEnabled := automodruleFields[8].Descriptor()
+	// automodrule.DefaultEnabled holds the default value on creation for the enabled field.
+	automodrule.DefaultEnabled = automodruleDescEnabled.Default.(bool)
+	// automodruleDescID is the schema descriptor for id field.
+	automodruleDescID := automodruleMixinFields0[0].Descriptor()
+	// automodrule.DefaultID holds the default value on creation for the id field.
+	automodrule.DefaultID = automodruleDescID.Default.(func() xid.ID)
+	// automodrule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	automodrule.IDValidator = func() func(string) error {
+		validators := automodruleDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	badgeMixin := schema.Badge{}.Mixin()
+	badgeMixinFields0 := badgeMixin[0].Fields()
+	_ = badgeMixinFields0
+	badgeMixinFields1 := badgeMixin[1].Fields()
+	_ = badgeMixinFields1
+	badgeMixinFields2 := badgeMixin[2].Fields()
+	_ = badgeMixinFields2
+	badgeFields := schema.Badge{}.Fields()
+	_ = badgeFields
+	// badgeDescCreatedAt is the schema descriptor for created_at field.
+	badgeDescCreatedAt := badgeMixinFields1[0].Descriptor()
+	// badge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	badge.DefaultCreatedAt = badgeDescCreatedAt.Default.(func() time.Time)
+	// badgeDescUpdatedAt is the schema descriptor for updated_at field.
+	badgeDescUpdatedAt := badgeMixinFields2[0].Descriptor()
+	// badge.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	badge.DefaultUpdatedAt = badgeDescUpdatedAt.Default.(func() time.Time)
+	// badge.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	badge.UpdateDefaultUpdatedAt = badgeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// badgeDescThreshold is the schema descriptor for threshold field.
+	badgeDescThreshold := badgeFields[4].Descriptor()
+	// badge.DefaultThreshold holds the default value on creation for the threshold field.
+	badge.DefaultThreshold = badgeDescThreshold.Default.(int)
+	// badgeDescID is the schema descriptor for id field.
+	badgeDescID := badgeMixinFields0[0].Descriptor()
+	// badge.DefaultID holds the default value on creation for the id field.
+	badge.DefaultID = badgeDescID.Default.(func() xid.ID)
+	// badge.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	badge.IDValidator = func() func(string) error {
+		validators := badgeDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	bookmarkMixin := schema.Bookmark{}.Mixin()
+	bookmarkMixinFields0 := bookmarkMixin[0].Fields()
+	_ = bookmarkMixinFields0
+	bookmarkMixinFields1 := bookmarkMixin[1].Fields()
+	_ = bookmarkMixinFields1
+	bookmarkMixinFields2 := bookmarkMixin[2].Fields()
+	_ = bookmarkMixinFields2
+	bookmarkFields := schema.Bookmark{}.Fields()
+	_ = bookmarkFields
+	// bookmarkDescCreatedAt is the schema descriptor for created_at field.
+	bookmarkDescCreatedAt := bookmarkMixinFields1[0].Descriptor()
+	// bookmark.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bookmark.DefaultCreatedAt = bookmarkDescCreatedAt.Default.(func() time.Time)
+	// bookmarkDescUpdatedAt is the schema descriptor for updated_at field.
+	bookmarkDescUpdatedAt := bookmarkMixinFields2[0].Descriptor()
+	// bookmark.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bookmark.DefaultUpdatedAt = bookmarkDescUpdatedAt.Default.(func() time.Time)
+	// bookmark.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bookmark.UpdateDefaultUpdatedAt = bookmarkDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bookmarkDescID is the schema descriptor for id field.
+	bookmarkDescID := bookmarkMixinFields0[0].Descriptor()
+	// bookmark.DefaultID holds the default value on creation for the id field.
+	bookmark.DefaultID = bookmarkDescID.Default.(func() xid.ID)
+	// bookmark.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	bookmark.IDValidator = func() func(string) error {
+		validators := bookmarkDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	categoryMixin := schema.Category{}.Mixin()
 	categoryMixinFields0 := categoryMixin[0].Fields()
 	_ = categoryMixinFields0
@@ -298,6 +881,92 @@ func init() {
 			return nil
 		}
 	}()
+	categoryfollowMixin := schema.CategoryFollow{}.Mixin()
+	categoryfollowMixinFields0 := categoryfollowMixin[0].Fields()
+	_ = categoryfollowMixinFields0
+	categoryfollowMixinFields1 := categoryfollowMixin[1].Fields()
+	_ = categoryfollowMixinFields1
+	categoryfollowFields := schema.CategoryFollow{}.Fields()
+	_ = categoryfollowFields
+	// categoryfollowDescCreatedAt is the schema descriptor for created_at field.
+	categoryfollowDescCreatedAt := categoryfollowMixinFields1[0].Descriptor()
+	// categoryfollow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categoryfollow.DefaultCreatedAt = categoryfollowDescCreatedAt.Default.(func() time.Time)
+	// categoryfollowDescID is the schema descriptor for id field.
+	categoryfollowDescID := categoryfollowMixinFields0[0].Descriptor()
+	// categoryfollow.DefaultID holds the default value on creation for the id field.
+	categoryfollow.DefaultID = categoryfollowDescID.Default.(func() xid.ID)
+	// categoryfollow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	categoryfollow.IDValidator = func() func(string) error {
+		validators := categoryfollowDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	categorypermissionMixin := schema.CategoryPermission{}.Mixin()
+	categorypermissionMixinFields0 := categorypermissionMixin[0].Fields()
+	_ = categorypermissionMixinFields0
+	categorypermissionMixinFields1 := categorypermissionMixin[1].Fields()
+	_ = categorypermissionMixinFields1
+	categorypermissionMixinFields2 := categorypermissionMixin[2].Fields()
+	_ = categorypermissionMixinFields2
+	categorypermissionFields := schema.CategoryPermission{}.Fields()
+	_ = categorypermissionFields
+	// categorypermissionDescCreatedAt is the schema descriptor for created_at field.
+	categorypermissionDescCreatedAt := categorypermissionMixinFields1[0].Descriptor()
+	// categorypermission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categorypermission.DefaultCreatedAt = categorypermissionDescCreatedAt.Default.(func() time.Time)
+	// categorypermissionDescUpdatedAt is the schema descriptor for updated_at field.
+	categorypermissionDescUpdatedAt := categorypermissionMixinFields2[0].Descriptor()
+	// categorypermission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	categorypermission.DefaultUpdatedAt = categorypermissionDescUpdatedAt.Default.(func() time.Time)
+	// categorypermission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	categorypermission.UpdateDefaultUpdatedAt = categorypermissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categorypermissionDescCanRead is the schema descriptor for can_read field.
+	categorypermissionDescCanRead := categorypermissionFields[2].Descriptor()
+	// categorypermission.DefaultCanRead holds the default value on creation for the can_read field.
+	categorypermission.DefaultCanRead = categorypermissionDescCanRead.Default.(bool)
+	// categorypermissionDescCanCreateThread is the schema descriptor for can_create_thread field.
+	categorypermissionDescCanCreateThread := categorypermissionFields[3].Descriptor()
+	// categorypermission.DefaultCanCreateThread holds the default value on creation for the can_create_thread field.
+	categorypermission.DefaultCanCreateThread = categorypermissionDescCanCreateThread.Default.(bool)
+	// categorypermissionDescCanReply is the schema descriptor for can_reply field.
+	categorypermissionDescCanReply := categorypermissionFields[4].Descriptor()
+	// categorypermission.DefaultCanReply holds the default value on creation for the can_reply field.
+	categorypermission.DefaultCanReply = categorypermissionDescCanReply.Default.(bool)
+	// categorypermissionDescCanAttach is the schema descriptor for can_attach field.
+	categorypermissionDescCanAttach := categorypermissionFields[5].Descriptor()
+	// categorypermission.DefaultCanAttach holds the default value on creation for the can_attach field.
+	categorypermission.DefaultCanAttach = categorypermissionDescCanAttach.Default.(bool)
+	// categorypermissionDescID is the schema descriptor for id field.
+	categorypermissionDescID := categorypermissionMixinFields0[0].Descriptor()
+	// categorypermission.DefaultID holds the default value on creation for the id field.
+	categorypermission.DefaultID = categorypermissionDescID.Default.(func() xid.ID)
+	// categorypermission.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	categorypermission.IDValidator = func() func(string) error {
+		validators := categorypermissionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	collectionMixin := schema.Collection{}.Mixin()
 	collectionMixinFields0 := collectionMixin[0].Fields()
 	_ = collectionMixinFields0
@@ -443,52 +1112,71 @@ func init() {
 	collectionpostDescMembershipType := collectionpostFields[2].Descriptor()
 	// collectionpost.DefaultMembershipType holds the default value on creation for the membership_type field.
 	collectionpost.DefaultMembershipType = collectionpostDescMembershipType.Default.(string)
-	emailMixin := schema.Email{}.Mixin()
-	emailMixinFields0 := emailMixin[0].Fields()
-	_ = emailMixinFields0
-	emailMixinFields1 := emailMixin[1].Fields()
-	_ = emailMixinFields1
-	emailFields := schema.Email{}.Fields()
-	_ = emailFields
-	// emailDescCreatedAt is the schema descriptor for created_at field.
-	emailDescCreatedAt := emailMixinFields1[0].Descriptor()
-	// email.DefaultCreatedAt holds the default value on creation for the created_at field.
-	email.DefaultCreatedAt = emailDescCreatedAt.Default.(func() time.Time)
-	// emailDescEmailAddress is the schema descriptor for email_address field.
-	emailDescEmailAddress := emailFields[1].Descriptor()
-	// email.EmailAddressValidator is a validator for the "email_address" field. It is called by the builders before save.
-	email.EmailAddressValidator = func() func(string) error {
-		validators := emailDescEmailAddress.Validators
+	collectionsectionMixin := schema.CollectionSection{}.Mixin()
+	collectionsectionMixinFields0 := collectionsectionMixin[0].Fields()
+	_ = collectionsectionMixinFields0
+	collectionsectionMixinFields1 := collectionsectionMixin[1].Fields()
+	_ = collectionsectionMixinFields1
+	collectionsectionMixinFields2 := collectionsectionMixin[2].Fields()
+	_ = collectionsectionMixinFields2
+	collectionsectionFields := schema.CollectionSection{}.Fields()
+	_ = collectionsectionFields
+	// collectionsectionDescCreatedAt is the schema descriptor for created_at field.
+	collectionsectionDescCreatedAt := collectionsectionMixinFields1[0].Descriptor()
+	// collectionsection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collectionsection.DefaultCreatedAt = collectionsectionDescCreatedAt.Default.(func() time.Time)
+	// collectionsectionDescUpdatedAt is the schema descriptor for updated_at field.
+	collectionsectionDescUpdatedAt := collectionsectionMixinFields2[0].Descriptor()
+	// collectionsection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	collectionsection.DefaultUpdatedAt = collectionsectionDescUpdatedAt.Default.(func() time.Time)
+	// collectionsection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	collectionsection.UpdateDefaultUpdatedAt = collectionsectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// collectionsectionDescSort is the schema descriptor for sort field.
+	collectionsectionDescSort := collectionsectionFields[3].Descriptor()
+	// collectionsection.DefaultSort holds the default value on creation for the sort field.
+	collectionsection.DefaultSort = collectionsectionDescSort.Default.(func() lexorank.Key)
+	// collectionsectionDescID is the schema descriptor for id field.
+	collectionsectionDescID := collectionsectionMixinFields0[0].Descriptor()
+	// collectionsection.DefaultID holds the default value on creation for the id field.
+	collectionsection.DefaultID = collectionsectionDescID.Default.(func() xid.ID)
+	// collectionsection.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	collectionsection.IDValidator = func() func(string) error {
+		validators := collectionsectionDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
-			validators[2].(func(string) error),
-			validators[3].(func(string) error),
 		}
-		return func(email_address string) error {
+		return func(id string) error {
 			for _, fn := range fns {
-				if err := fn(email_address); err != nil {
+				if err := fn(id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// emailDescVerificationCode is the schema descriptor for verification_code field.
-	emailDescVerificationCode := emailFields[2].Descriptor()
-	// email.VerificationCodeValidator is a validator for the "verification_code" field. It is called by the builders before save.
-	email.VerificationCodeValidator = emailDescVerificationCode.Validators[0].(func(string) error)
-	// emailDescVerified is the schema descriptor for verified field.
-	emailDescVerified := emailFields[3].Descriptor()
-	// email.DefaultVerified holds the default value on creation for the verified field.
-	email.DefaultVerified = emailDescVerified.Default.(bool)
-	// emailDescID is the schema descriptor for id field.
-	emailDescID := emailMixinFields0[0].Descriptor()
-	// email.DefaultID holds the default value on creation for the id field.
-	email.DefaultID = emailDescID.Default.(func() xid.ID)
-	// email.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	email.IDValidator = func() func(string) error {
-		validators := emailDescID.Validators
+	collectionshareMixin := schema.CollectionShare{}.Mixin()
+	collectionshareMixinFields0 := collectionshareMixin[0].Fields()
+	_ = collectionshareMixinFields0
+	collectionshareMixinFields1 := collectionshareMixin[1].Fields()
+	_ = collectionshareMixinFields1
+	collectionshareFields := schema.CollectionShare{}.Fields()
+	_ = collectionshareFields
+	// collectionshareDescCreatedAt is the schema descriptor for created_at field.
+	collectionshareDescCreatedAt := collectionshareMixinFields1[0].Descriptor()
+	// collectionshare.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collectionshare.DefaultCreatedAt = collectionshareDescCreatedAt.Default.(func() time.Time)
+	// collectionshareDescToken is the schema descriptor for token field.
+	collectionshareDescToken := collectionshareFields[1].Descriptor()
+	// collectionshare.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	collectionshare.TokenValidator = collectionshareDescToken.Validators[0].(func(string) error)
+	// collectionshareDescID is the schema descriptor for id field.
+	collectionshareDescID := collectionshareMixinFields0[0].Descriptor()
+	// collectionshare.DefaultID holds the default value on creation for the id field.
+	collectionshare.DefaultID = collectionshareDescID.Default.(func() xid.ID)
+	// collectionshare.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	collectionshare.IDValidator = func() func(string) error {
+		validators := collectionshareDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -502,32 +1190,32 @@ func init() {
 			return nil
 		}
 	}()
-	eventMixin := schema.Event{}.Mixin()
-	eventMixinFields0 := eventMixin[0].Fields()
-	_ = eventMixinFields0
-	eventMixinFields1 := eventMixin[1].Fields()
-	_ = eventMixinFields1
-	eventMixinFields2 := eventMixin[2].Fields()
-	_ = eventMixinFields2
-	eventFields := schema.Event{}.Fields()
-	_ = eventFields
-	// eventDescCreatedAt is the schema descriptor for created_at field.
-	eventDescCreatedAt := eventMixinFields1[0].Descriptor()
-	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
-	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
-	// eventDescUpdatedAt is the schema descriptor for updated_at field.
-	eventDescUpdatedAt := eventMixinFields2[0].Descriptor()
-	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
-	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	event.UpdateDefaultUpdatedAt = eventDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// eventDescID is the schema descriptor for id field.
-	eventDescID := eventMixinFields0[0].Descriptor()
-	// event.DefaultID holds the default value on creation for the id field.
-	event.DefaultID = eventDescID.Default.(func() xid.ID)
-	// event.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	event.IDValidator = func() func(string) error {
-		validators := eventDescID.Validators
+	contentsummaryMixin := schema.ContentSummary{}.Mixin()
+	contentsummaryMixinFields0 := contentsummaryMixin[0].Fields()
+	_ = contentsummaryMixinFields0
+	contentsummaryMixinFields1 := contentsummaryMixin[1].Fields()
+	_ = contentsummaryMixinFields1
+	contentsummaryMixinFields2 := contentsummaryMixin[2].Fields()
+	_ = contentsummaryMixinFields2
+	contentsummaryFields := schema.ContentSummary{}.Fields()
+	_ = contentsummaryFields
+	// contentsummaryDescCreatedAt is the schema descriptor for created_at field.
+	contentsummaryDescCreatedAt := contentsummaryMixinFields1[0].Descriptor()
+	// contentsummary.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contentsummary.DefaultCreatedAt = contentsummaryDescCreatedAt.Default.(func() time.Time)
+	// contentsummaryDescUpdatedAt is the schema descriptor for updated_at field.
+	contentsummaryDescUpdatedAt := contentsummaryMixinFields2[0].Descriptor()
+	// contentsummary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contentsummary.DefaultUpdatedAt = contentsummaryDescUpdatedAt.Default.(func() time.Time)
+	// contentsummary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contentsummary.UpdateDefaultUpdatedAt = contentsummaryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// contentsummaryDescID is the schema descriptor for id field.
+	contentsummaryDescID := contentsummaryMixinFields0[0].Descriptor()
+	// contentsummary.DefaultID holds the default value on creation for the id field.
+	contentsummary.DefaultID = contentsummaryDescID.Default.(func() xid.ID)
+	// contentsummary.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	contentsummary.IDValidator = func() func(string) error {
+		validators := contentsummaryDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -541,17 +1229,341 @@ func init() {
 			return nil
 		}
 	}()
-	eventparticipantMixin := schema.EventParticipant{}.Mixin()
-	eventparticipantMixinFields0 := eventparticipantMixin[0].Fields()
-	_ = eventparticipantMixinFields0
-	eventparticipantMixinFields1 := eventparticipantMixin[1].Fields()
-	_ = eventparticipantMixinFields1
-	eventparticipantFields := schema.EventParticipant{}.Fields()
-	_ = eventparticipantFields
-	// eventparticipantDescCreatedAt is the schema descriptor for created_at field.
-	eventparticipantDescCreatedAt := eventparticipantMixinFields1[0].Descriptor()
-	// eventparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
-	eventparticipant.DefaultCreatedAt = eventparticipantDescCreatedAt.Default.(func() time.Time)
+	contentviewMixin := schema.ContentView{}.Mixin()
+	contentviewMixinFields0 := contentviewMixin[0].Fields()
+	_ = contentviewMixinFields0
+	contentviewMixinFields1 := contentviewMixin[1].Fields()
+	_ = contentviewMixinFields1
+	contentviewMixinFields2 := contentviewMixin[2].Fields()
+	_ = contentviewMixinFields2
+	contentviewFields := schema.ContentView{}.Fields()
+	_ = contentviewFields
+	// contentviewDescCreatedAt is the schema descriptor for created_at field.
+	contentviewDescCreatedAt := contentviewMixinFields1[0].Descriptor()
+	// contentview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contentview.DefaultCreatedAt = contentviewDescCreatedAt.Default.(func() time.Time)
+	// contentviewDescUpdatedAt is the schema descriptor for updated_at field.
+	contentviewDescUpdatedAt := contentviewMixinFields2[0].Descriptor()
+	// contentview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contentview.DefaultUpdatedAt = contentviewDescUpdatedAt.Default.(func() time.Time)
+	// contentview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contentview.UpdateDefaultUpdatedAt = contentviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// contentviewDescViews is the schema descriptor for views field.
+	contentviewDescViews := contentviewFields[5].Descriptor()
+	// contentview.DefaultViews holds the default value on creation for the views field.
+	contentview.DefaultViews = contentviewDescViews.Default.(int)
+	// contentviewDescUniqueViewers is the schema descriptor for unique_viewers field.
+	contentviewDescUniqueViewers := contentviewFields[6].Descriptor()
+	// contentview.DefaultUniqueViewers holds the default value on creation for the unique_viewers field.
+	contentview.DefaultUniqueViewers = contentviewDescUniqueViewers.Default.(int)
+	// contentviewDescID is the schema descriptor for id field.
+	contentviewDescID := contentviewMixinFields0[0].Descriptor()
+	// contentview.DefaultID holds the default value on creation for the id field.
+	contentview.DefaultID = contentviewDescID.Default.(func() xid.ID)
+	// contentview.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	contentview.IDValidator = func() func(string) error {
+		validators := contentviewDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	conversationMixin := schema.Conversation{}.Mixin()
+	conversationMixinFields0 := conversationMixin[0].Fields()
+	_ = conversationMixinFields0
+	conversationMixinFields1 := conversationMixin[1].Fields()
+	_ = conversationMixinFields1
+	conversationMixinFields2 := conversationMixin[2].Fields()
+	_ = conversationMixinFields2
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescCreatedAt is the schema descriptor for created_at field.
+	conversationDescCreatedAt := conversationMixinFields1[0].Descriptor()
+	// conversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversation.DefaultCreatedAt = conversationDescCreatedAt.Default.(func() time.Time)
+	// conversationDescUpdatedAt is the schema descriptor for updated_at field.
+	conversationDescUpdatedAt := conversationMixinFields2[0].Descriptor()
+	// conversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	conversation.DefaultUpdatedAt = conversationDescUpdatedAt.Default.(func() time.Time)
+	// conversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	conversation.UpdateDefaultUpdatedAt = conversationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// conversationDescID is the schema descriptor for id field.
+	conversationDescID := conversationMixinFields0[0].Descriptor()
+	// conversation.DefaultID holds the default value on creation for the id field.
+	conversation.DefaultID = conversationDescID.Default.(func() xid.ID)
+	// conversation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversation.IDValidator = func() func(string) error {
+		validators := conversationDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	conversationparticipantMixin := schema.ConversationParticipant{}.Mixin()
+	conversationparticipantMixinFields0 := conversationparticipantMixin[0].Fields()
+	_ = conversationparticipantMixinFields0
+	conversationparticipantMixinFields1 := conversationparticipantMixin[1].Fields()
+	_ = conversationparticipantMixinFields1
+	conversationparticipantFields := schema.ConversationParticipant{}.Fields()
+	_ = conversationparticipantFields
+	// conversationparticipantDescCreatedAt is the schema descriptor for created_at field.
+	conversationparticipantDescCreatedAt := conversationparticipantMixinFields1[0].Descriptor()
+	// conversationparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversationparticipant.DefaultCreatedAt = conversationparticipantDescCreatedAt.Default.(func() time.Time)
+	// conversationparticipantDescID is the schema descriptor for id field.
+	conversationparticipantDescID := conversationparticipantMixinFields0[0].Descriptor()
+	// conversationparticipant.DefaultID holds the default value on creation for the id field.
+	conversationparticipant.DefaultID = conversationparticipantDescID.Default.(func() xid.ID)
+	// conversationparticipant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversationparticipant.IDValidator = func() func(string) error {
+		validators := conversationparticipantDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	digestsubscriptionMixin := schema.DigestSubscription{}.Mixin()
+	digestsubscriptionMixinFields0 := digestsubscriptionMixin[0].Fields()
+	_ = digestsubscriptionMixinFields0
+	digestsubscriptionMixinFields1 := digestsubscriptionMixin[1].Fields()
+	_ = digestsubscriptionMixinFields1
+	digestsubscriptionMixinFields2 := digestsubscriptionMixin[2].Fields()
+	_ = digestsubscriptionMixinFields2
+	digestsubscriptionFields := schema.DigestSubscription{}.Fields()
+	_ = digestsubscriptionFields
+	// digestsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	digestsubscriptionDescCreatedAt := digestsubscriptionMixinFields1[0].Descriptor()
+	// digestsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	digestsubscription.DefaultCreatedAt = digestsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// digestsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	digestsubscriptionDescUpdatedAt := digestsubscriptionMixinFields2[0].Descriptor()
+	// digestsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	digestsubscription.DefaultUpdatedAt = digestsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// digestsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	digestsubscription.UpdateDefaultUpdatedAt = digestsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// digestsubscriptionDescID is the schema descriptor for id field.
+	digestsubscriptionDescID := digestsubscriptionMixinFields0[0].Descriptor()
+	// digestsubscription.DefaultID holds the default value on creation for the id field.
+	digestsubscription.DefaultID = digestsubscriptionDescID.Default.(func() xid.ID)
+	// digestsubscription.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	digestsubscription.IDValidator = func() func(string) error {
+		validators := digestsubscriptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	directmessageMixin := schema.DirectMessage{}.Mixin()
+	directmessageMixinFields0 := directmessageMixin[0].Fields()
+	_ = directmessageMixinFields0
+	directmessageMixinFields1 := directmessageMixin[1].Fields()
+	_ = directmessageMixinFields1
+	directmessageMixinFields2 := directmessageMixin[2].Fields()
+	_ = directmessageMixinFields2
+	directmessageFields := schema.DirectMessage{}.Fields()
+	_ = directmessageFields
+	// directmessageDescCreatedAt is the schema descriptor for created_at field.
+	directmessageDescCreatedAt := directmessageMixinFields1[0].Descriptor()
+	// directmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	directmessage.DefaultCreatedAt = directmessageDescCreatedAt.Default.(func() time.Time)
+	// directmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	directmessageDescUpdatedAt := directmessageMixinFields2[0].Descriptor()
+	// directmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	directmessage.DefaultUpdatedAt = directmessageDescUpdatedAt.Default.(func() time.Time)
+	// directmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	directmessage.UpdateDefaultUpdatedAt = directmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// directmessageDescID is the schema descriptor for id field.
+	directmessageDescID := directmessageMixinFields0[0].Descriptor()
+	// directmessage.DefaultID holds the default value on creation for the id field.
+	directmessage.DefaultID = directmessageDescID.Default.(func() xid.ID)
+	// directmessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	directmessage.IDValidator = func() func(string) error {
+		validators := directmessageDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	discordbridgelinkMixin := schema.DiscordBridgeLink{}.Mixin()
+	discordbridgelinkMixinFields0 := discordbridgelinkMixin[0].Fields()
+	_ = discordbridgelinkMixinFields0
+	discordbridgelinkMixinFields1 := discordbridgelinkMixin[1].Fields()
+	_ = discordbridgelinkMixinFields1
+	discordbridgelinkFields := schema.DiscordBridgeLink{}.Fields()
+	_ = discordbridgelinkFields
+	// discordbridgelinkDescCreatedAt is the schema descriptor for created_at field.
+	discordbridgelinkDescCreatedAt := discordbridgelinkMixinFields1[0].Descriptor()
+	// discordbridgelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	discordbridgelink.DefaultCreatedAt = discordbridgelinkDescCreatedAt.Default.(func() time.Time)
+	// discordbridgelinkDescID is the schema descriptor for id field.
+	discordbridgelinkDescID := discordbridgelinkMixinFields0[0].Descriptor()
+	// discordbridgelink.DefaultID holds the default value on creation for the id field.
+	discordbridgelink.DefaultID = discordbridgelinkDescID.Default.(func() xid.ID)
+	// discordbridgelink.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordbridgelink.IDValidator = func() func(string) error {
+		validators := discordbridgelinkDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	emailMixin := schema.Email{}.Mixin()
+	emailMixinFields0 := emailMixin[0].Fields()
+	_ = emailMixinFields0
+	emailMixinFields1 := emailMixin[1].Fields()
+	_ = emailMixinFields1
+	emailFields := schema.Email{}.Fields()
+	_ = emailFields
+	// emailDescCreatedAt is the schema descriptor for created_at field.
+	emailDescCreatedAt := emailMixinFields1[0].Descriptor()
+	// email.DefaultCreatedAt holds the default value on creation for the created_at field.
+	email.DefaultCreatedAt = emailDescCreatedAt.Default.(func() time.Time)
+	// emailDescEmailAddress is the schema descriptor for email_address field.
+	emailDescEmailAddress := emailFields[1].Descriptor()
+	// email.EmailAddressValidator is a validator for the "email_address" field. It is called by the builders before save.
+	email.EmailAddressValidator = func() func(string) error {
+		validators := emailDescEmailAddress.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+			validators[3].(func(string) error),
+		}
+		return func(email_address string) error {
+			for _, fn := range fns {
+				if err := fn(email_address); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emailDescVerificationCode is the schema descriptor for verification_code field.
+	emailDescVerificationCode := emailFields[2].Descriptor()
+	// email.VerificationCodeValidator is a validator for the "verification_code" field. It is called by the builders before save.
+	email.VerificationCodeValidator = emailDescVerificationCode.Validators[0].(func(string) error)
+	// emailDescVerified is the schema descriptor for verified field.
+	emailDescVerified := emailFields[3].Descriptor()
+	// email.DefaultVerified holds the default value on creation for the verified field.
+	email.DefaultVerified = emailDescVerified.Default.(bool)
+	// emailDescID is the schema descriptor for id field.
+	emailDescID := emailMixinFields0[0].Descriptor()
+	// email.DefaultID holds the default value on creation for the id field.
+	email.DefaultID = emailDescID.Default.(func() xid.ID)
+	// email.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	email.IDValidator = func() func(string) error {
+		validators := emailDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	eventMixin := schema.Event{}.Mixin()
+	eventMixinFields0 := eventMixin[0].Fields()
+	_ = eventMixinFields0
+	eventMixinFields1 := eventMixin[1].Fields()
+	_ = eventMixinFields1
+	eventMixinFields2 := eventMixin[2].Fields()
+	_ = eventMixinFields2
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescCreatedAt is the schema descriptor for created_at field.
+	eventDescCreatedAt := eventMixinFields1[0].Descriptor()
+	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
+	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
+	// eventDescUpdatedAt is the schema descriptor for updated_at field.
+	eventDescUpdatedAt := eventMixinFields2[0].Descriptor()
+	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
+	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	event.UpdateDefaultUpdatedAt = eventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventDescID is the schema descriptor for id field.
+	eventDescID := eventMixinFields0[0].Descriptor()
+	// event.DefaultID holds the default value on creation for the id field.
+	event.DefaultID = eventDescID.Default.(func() xid.ID)
+	// event.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	event.IDValidator = func() func(string) error {
+		validators := eventDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	eventparticipantMixin := schema.EventParticipant{}.Mixin()
+	eventparticipantMixinFields0 := eventparticipantMixin[0].Fields()
+	_ = eventparticipantMixinFields0
+	eventparticipantMixinFields1 := eventparticipantMixin[1].Fields()
+	_ = eventparticipantMixinFields1
+	eventparticipantFields := schema.EventParticipant{}.Fields()
+	_ = eventparticipantFields
+	// eventparticipantDescCreatedAt is the schema descriptor for created_at field.
+	eventparticipantDescCreatedAt := eventparticipantMixinFields1[0].Descriptor()
+	// eventparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventparticipant.DefaultCreatedAt = eventparticipantDescCreatedAt.Default.(func() time.Time)
 	// eventparticipantDescID is the schema descriptor for id field.
 	eventparticipantDescID := eventparticipantMixinFields0[0].Descriptor()
 	// eventparticipant.DefaultID holds the default value on creation for the id field.
@@ -572,6 +1584,236 @@ func init() {
 			return nil
 		}
 	}()
+	featureflagMixin := schema.FeatureFlag{}.Mixin()
+	featureflagMixinFields0 := featureflagMixin[0].Fields()
+	_ = featureflagMixinFields0
+	featureflagMixinFields1 := featureflagMixin[1].Fields()
+	_ = featureflagMixinFields1
+	featureflagMixinFields2 := featureflagMixin[2].Fields()
+	_ = featureflagMixinFields2
+	featureflagFields := schema.FeatureFlag{}.Fields()
+	_ = featureflagFields
+	// featureflagDescCreatedAt is the schema descriptor for created_at field.
+	featureflagDescCreatedAt := featureflagMixinFields1[0].Descriptor()
+	// featureflag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	featureflag.DefaultCreatedAt = featureflagDescCreatedAt.Default.(func() time.Time)
+	// featureflagDescUpdatedAt is the schema descriptor for updated_at field.
+	featureflagDescUpdatedAt := featureflagMixinFields2[0].Descriptor()
+	// featureflag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	featureflag.DefaultUpdatedAt = featureflagDescUpdatedAt.Default.(func() time.Time)
+	// featureflag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	featureflag.UpdateDefaultUpdatedAt = featureflagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// featureflagDescKey is the schema descriptor for key field.
+	featureflagDescKey := featureflagFields[0].Descriptor()
+	// featureflag.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	featureflag.KeyValidator = featureflagDescKey.Validators[0].(func(string) error)
+	// featureflagDescEnabled is the schema descriptor for enabled field.
+	featureflagDescEnabled := featureflagFields[2].Descriptor()
+	// featureflag.DefaultEnabled holds the default value on creation for the enabled field.
+	featureflag.DefaultEnabled = featureflagDescEnabled.Default.(bool)
+	// featureflagDescPercentage is the schema descriptor for percentage field.
+	featureflagDescPercentage := featureflagFields[3].Descriptor()
+	// featureflag.DefaultPercentage holds the default value on creation for the percentage field.
+	featureflag.DefaultPercentage = featureflagDescPercentage.Default.(int)
+	// featureflagDescID is the schema descriptor for id field.
+	featureflagDescID := featureflagMixinFields0[0].Descriptor()
+	// featureflag.DefaultID holds the default value on creation for the id field.
+	featureflag.DefaultID = featureflagDescID.Default.(func() xid.ID)
+	// featureflag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	featureflag.IDValidator = func() func(string) error {
+		validators := featureflagDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	federationfollowerMixin := schema.FederationFollower{}.Mixin()
+	federationfollowerMixinFields0 := federationfollowerMixin[0].Fields()
+	_ = federationfollowerMixinFields0
+	federationfollowerMixinFields1 := federationfollowerMixin[1].Fields()
+	_ = federationfollowerMixinFields1
+	federationfollowerMixinFields2 := federationfollowerMixin[2].Fields()
+	_ = federationfollowerMixinFields2
+	federationfollowerFields := schema.FederationFollower{}.Fields()
+	_ = federationfollowerFields
+	// federationfollowerDescCreatedAt is the schema descriptor for created_at field.
+	federationfollowerDescCreatedAt := federationfollowerMixinFields1[0].Descriptor()
+	// federationfollower.DefaultCreatedAt holds the default value on creation for the created_at field.
+	federationfollower.DefaultCreatedAt = federationfollowerDescCreatedAt.Default.(func() time.Time)
+	// federationfollowerDescUpdatedAt is the schema descriptor for updated_at field.
+	federationfollowerDescUpdatedAt := federationfollowerMixinFields2[0].Descriptor()
+	// federationfollower.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	federationfollower.DefaultUpdatedAt = federationfollowerDescUpdatedAt.Default.(func() time.Time)
+	// federationfollower.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	federationfollower.UpdateDefaultUpdatedAt = federationfollowerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// federationfollowerDescID is the schema descriptor for id field.
+	federationfollowerDescID := federationfollowerMixinFields0[0].Descriptor()
+	// federationfollower.DefaultID holds the default value on creation for the id field.
+	federationfollower.DefaultID = federationfollowerDescID.Default.(func() xid.ID)
+	// federationfollower.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	federationfollower.IDValidator = func() func(string) error {
+		validators := federationfollowerDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	federationkeyMixin := schema.FederationKey{}.Mixin()
+	federationkeyMixinFields0 := federationkeyMixin[0].Fields()
+	_ = federationkeyMixinFields0
+	federationkeyMixinFields1 := federationkeyMixin[1].Fields()
+	_ = federationkeyMixinFields1
+	federationkeyFields := schema.FederationKey{}.Fields()
+	_ = federationkeyFields
+	// federationkeyDescCreatedAt is the schema descriptor for created_at field.
+	federationkeyDescCreatedAt := federationkeyMixinFields1[0].Descriptor()
+	// federationkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	federationkey.DefaultCreatedAt = federationkeyDescCreatedAt.Default.(func() time.Time)
+	// federationkeyDescID is the schema descriptor for id field.
+	federationkeyDescID := federationkeyMixinFields0[0].Descriptor()
+	// federationkey.DefaultID holds the default value on creation for the id field.
+	federationkey.DefaultID = federationkeyDescID.Default.(func() xid.ID)
+	// federationkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	federationkey.IDValidator = func() func(string) error {
+		validators := federationkeyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	feedtokenMixin := schema.FeedToken{}.Mixin()
+	feedtokenMixinFields0 := feedtokenMixin[0].Fields()
+	_ = feedtokenMixinFields0
+	feedtokenMixinFields1 := feedtokenMixin[1].Fields()
+	_ = feedtokenMixinFields1
+	feedtokenFields := schema.FeedToken{}.Fields()
+	_ = feedtokenFields
+	// feedtokenDescCreatedAt is the schema descriptor for created_at field.
+	feedtokenDescCreatedAt := feedtokenMixinFields1[0].Descriptor()
+	// feedtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	feedtoken.DefaultCreatedAt = feedtokenDescCreatedAt.Default.(func() time.Time)
+	// feedtokenDescID is the schema descriptor for id field.
+	feedtokenDescID := feedtokenMixinFields0[0].Descriptor()
+	// feedtoken.DefaultID holds the default value on creation for the id field.
+	feedtoken.DefaultID = feedtokenDescID.Default.(func() xid.ID)
+	// feedtoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	feedtoken.IDValidator = func() func(string) error {
+		validators := feedtokenDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	importrecordMixin := schema.ImportRecord{}.Mixin()
+	importrecordMixinFields0 := importrecordMixin[0].Fields()
+	_ = importrecordMixinFields0
+	importrecordMixinFields1 := importrecordMixin[1].Fields()
+	_ = importrecordMixinFields1
+	importrecordMixinFields2 := importrecordMixin[2].Fields()
+	_ = importrecordMixinFields2
+	importrecordFields := schema.ImportRecord{}.Fields()
+	_ = importrecordFields
+	// importrecordDescCreatedAt is the schema descriptor for created_at field.
+	importrecordDescCreatedAt := importrecordMixinFields1[0].Descriptor()
+	// importrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importrecord.DefaultCreatedAt = importrecordDescCreatedAt.Default.(func() time.Time)
+	// importrecordDescUpdatedAt is the schema descriptor for updated_at field.
+	importrecordDescUpdatedAt := importrecordMixinFields2[0].Descriptor()
+	// importrecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	importrecord.DefaultUpdatedAt = importrecordDescUpdatedAt.Default.(func() time.Time)
+	// importrecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	importrecord.UpdateDefaultUpdatedAt = importrecordDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// importrecordDescID is the schema descriptor for id field.
+	importrecordDescID := importrecordMixinFields0[0].Descriptor()
+	// importrecord.DefaultID holds the default value on creation for the id field.
+	importrecord.DefaultID = importrecordDescID.Default.(func() xid.ID)
+	// importrecord.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	importrecord.IDValidator = func() func(string) error {
+		validators := importrecordDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	importrunMixin := schema.ImportRun{}.Mixin()
+	importrunMixinFields0 := importrunMixin[0].Fields()
+	_ = importrunMixinFields0
+	importrunMixinFields1 := importrunMixin[1].Fields()
+	_ = importrunMixinFields1
+	importrunMixinFields2 := importrunMixin[2].Fields()
+	_ = importrunMixinFields2
+	importrunFields := schema.ImportRun{}.Fields()
+	_ = importrunFields
+	// importrunDescCreatedAt is the schema descriptor for created_at field.
+	importrunDescCreatedAt := importrunMixinFields1[0].Descriptor()
+	// importrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importrun.DefaultCreatedAt = importrunDescCreatedAt.Default.(func() time.Time)
+	// importrunDescUpdatedAt is the schema descriptor for updated_at field.
+	importrunDescUpdatedAt := importrunMixinFields2[0].Descriptor()
+	// importrun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	importrun.DefaultUpdatedAt = importrunDescUpdatedAt.Default.(func() time.Time)
+	// importrun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	importrun.UpdateDefaultUpdatedAt = importrunDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// importrunDescID is the schema descriptor for id field.
+	importrunDescID := importrunMixinFields0[0].Descriptor()
+	// importrun.DefaultID holds the default value on creation for the id field.
+	importrun.DefaultID = importrunDescID.Default.(func() xid.ID)
+	// importrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	importrun.IDValidator = func() func(string) error {
+		validators := importrunDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	invitationMixin := schema.Invitation{}.Mixin()
 	invitationMixinFields0 := invitationMixin[0].Fields()
 	_ = invitationMixinFields0
@@ -611,6 +1853,49 @@ func init() {
 			return nil
 		}
 	}()
+	jobMixin := schema.Job{}.Mixin()
+	jobMixinFields0 := jobMixin[0].Fields()
+	_ = jobMixinFields0
+	jobMixinFields1 := jobMixin[1].Fields()
+	_ = jobMixinFields1
+	jobMixinFields2 := jobMixin[2].Fields()
+	_ = jobMixinFields2
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobMixinFields1[0].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobMixinFields2[0].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[4].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobMixinFields0[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() xid.ID)
+	// job.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	job.IDValidator = func() func(string) error {
+		validators := jobDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	likepostMixin := schema.LikePost{}.Mixin()
 	likepostMixinFields0 := likepostMixin[0].Fields()
 	_ = likepostMixinFields0
@@ -653,6 +1938,10 @@ func init() {
 	linkDescCreatedAt := linkMixinFields1[0].Descriptor()
 	// link.DefaultCreatedAt holds the default value on creation for the created_at field.
 	link.DefaultCreatedAt = linkDescCreatedAt.Default.(func() time.Time)
+	// linkDescCheckFailures is the schema descriptor for check_failures field.
+	linkDescCheckFailures := linkFields[8].Descriptor()
+	// link.DefaultCheckFailures holds the default value on creation for the check_failures field.
+	link.DefaultCheckFailures = linkDescCheckFailures.Default.(int)
 	// linkDescID is the schema descriptor for id field.
 	linkDescID := linkMixinFields0[0].Descriptor()
 	// link.DefaultID holds the default value on creation for the id field.
@@ -673,6 +1962,68 @@ func init() {
 			return nil
 		}
 	}()
+	linkcheckMixin := schema.LinkCheck{}.Mixin()
+	linkcheckMixinFields0 := linkcheckMixin[0].Fields()
+	_ = linkcheckMixinFields0
+	linkcheckMixinFields1 := linkcheckMixin[1].Fields()
+	_ = linkcheckMixinFields1
+	linkcheckFields := schema.LinkCheck{}.Fields()
+	_ = linkcheckFields
+	// linkcheckDescCreatedAt is the schema descriptor for created_at field.
+	linkcheckDescCreatedAt := linkcheckMixinFields1[0].Descriptor()
+	// linkcheck.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkcheck.DefaultCreatedAt = linkcheckDescCreatedAt.Default.(func() time.Time)
+	// linkcheckDescID is the schema descriptor for id field.
+	linkcheckDescID := linkcheckMixinFields0[0].Descriptor()
+	// linkcheck.DefaultID holds the default value on creation for the id field.
+	linkcheck.DefaultID = linkcheckDescID.Default.(func() xid.ID)
+	// linkcheck.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	linkcheck.IDValidator = func() func(string) error {
+		validators := linkcheckDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	matrixbridgelinkMixin := schema.MatrixBridgeLink{}.Mixin()
+	matrixbridgelinkMixinFields0 := matrixbridgelinkMixin[0].Fields()
+	_ = matrixbridgelinkMixinFields0
+	matrixbridgelinkMixinFields1 := matrixbridgelinkMixin[1].Fields()
+	_ = matrixbridgelinkMixinFields1
+	matrixbridgelinkFields := schema.MatrixBridgeLink{}.Fields()
+	_ = matrixbridgelinkFields
+	// matrixbridgelinkDescCreatedAt is the schema descriptor for created_at field.
+	matrixbridgelinkDescCreatedAt := matrixbridgelinkMixinFields1[0].Descriptor()
+	// matrixbridgelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	matrixbridgelink.DefaultCreatedAt = matrixbridgelinkDescCreatedAt.Default.(func() time.Time)
+	// matrixbridgelinkDescID is the schema descriptor for id field.
+	matrixbridgelinkDescID := matrixbridgelinkMixinFields0[0].Descriptor()
+	// matrixbridgelink.DefaultID holds the default value on creation for the id field.
+	matrixbridgelink.DefaultID = matrixbridgelinkDescID.Default.(func() xid.ID)
+	// matrixbridgelink.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	matrixbridgelink.IDValidator = func() func(string) error {
+		validators := matrixbridgelinkDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	mentionprofileMixin := schema.MentionProfile{}.Mixin()
 	mentionprofileMixinFields0 := mentionprofileMixin[0].Fields()
 	_ = mentionprofileMixinFields0
@@ -704,6 +2055,88 @@ func init() {
 			return nil
 		}
 	}()
+	moderationnoteMixin := schema.ModerationNote{}.Mixin()
+	moderationnoteMixinFields0 := moderationnoteMixin[0].Fields()
+	_ = moderationnoteMixinFields0
+	moderationnoteMixinFields1 := moderationnoteMixin[1].Fields()
+	_ = moderationnoteMixinFields1
+	moderationnoteMixinFields2 := moderationnoteMixin[2].Fields()
+	_ = moderationnoteMixinFields2
+	moderationnoteFields := schema.ModerationNote{}.Fields()
+	_ = moderationnoteFields
+	// moderationnoteDescCreatedAt is the schema descriptor for created_at field.
+	moderationnoteDescCreatedAt := moderationnoteMixinFields1[0].Descriptor()
+	// moderationnote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationnote.DefaultCreatedAt = moderationnoteDescCreatedAt.Default.(func() time.Time)
+	// moderationnoteDescUpdatedAt is the schema descriptor for updated_at field.
+	moderationnoteDescUpdatedAt := moderationnoteMixinFields2[0].Descriptor()
+	// moderationnote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	moderationnote.DefaultUpdatedAt = moderationnoteDescUpdatedAt.Default.(func() time.Time)
+	// moderationnote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	moderationnote.UpdateDefaultUpdatedAt = moderationnoteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// moderationnoteDescID is the schema descriptor for id field.
+	moderationnoteDescID := moderationnoteMixinFields0[0].Descriptor()
+	// moderationnote.DefaultID holds the default value on creation for the id field.
+	moderationnote.DefaultID = moderationnoteDescID.Default.(func() xid.ID)
+	// moderationnote.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	moderationnote.IDValidator = func() func(string) error {
+		validators := moderationnoteDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	networkbanMixin := schema.NetworkBan{}.Mixin()
+	networkbanMixinFields0 := networkbanMixin[0].Fields()
+	_ = networkbanMixinFields0
+	networkbanMixinFields1 := networkbanMixin[1].Fields()
+	_ = networkbanMixinFields1
+	networkbanMixinFields2 := networkbanMixin[2].Fields()
+	_ = networkbanMixinFields2
+	networkbanFields := schema.NetworkBan{}.Fields()
+	_ = networkbanFields
+	// networkbanDescCreatedAt is the schema descriptor for created_at field.
+	networkbanDescCreatedAt := networkbanMixinFields1[0].Descriptor()
+	// networkban.DefaultCreatedAt holds the default value on creation for the created_at field.
+	networkban.DefaultCreatedAt = networkbanDescCreatedAt.Default.(func() time.Time)
+	// networkbanDescUpdatedAt is the schema descriptor for updated_at field.
+	networkbanDescUpdatedAt := networkbanMixinFields2[0].Descriptor()
+	// networkban.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	networkban.DefaultUpdatedAt = networkbanDescUpdatedAt.Default.(func() time.Time)
+	// networkban.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	networkban.UpdateDefaultUpdatedAt = networkbanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// networkbanDescHits is the schema descriptor for hits field.
+	networkbanDescHits := networkbanFields[4].Descriptor()
+	// networkban.DefaultHits holds the default value on creation for the hits field.
+	networkban.DefaultHits = networkbanDescHits.Default.(int)
+	// networkbanDescID is the schema descriptor for id field.
+	networkbanDescID := networkbanMixinFields0[0].Descriptor()
+	// networkban.DefaultID holds the default value on creation for the id field.
+	networkban.DefaultID = networkbanDescID.Default.(func() xid.ID)
+	// networkban.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	networkban.IDValidator = func() func(string) error {
+		validators := networkbanDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	nodeMixin := schema.Node{}.Mixin()
 	nodeMixinFields0 := nodeMixin[0].Fields()
 	_ = nodeMixinFields0
@@ -751,24 +2184,172 @@ func init() {
 			return nil
 		}
 	}()
-	notificationMixin := schema.Notification{}.Mixin()
-	notificationMixinFields0 := notificationMixin[0].Fields()
-	_ = notificationMixinFields0
-	notificationMixinFields1 := notificationMixin[1].Fields()
-	_ = notificationMixinFields1
-	notificationFields := schema.Notification{}.Fields()
-	_ = notificationFields
-	// notificationDescCreatedAt is the schema descriptor for created_at field.
-	notificationDescCreatedAt := notificationMixinFields1[0].Descriptor()
-	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
-	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
-	// notificationDescID is the schema descriptor for id field.
-	notificationDescID := notificationMixinFields0[0].Descriptor()
-	// notification.DefaultID holds the default value on creation for the id field.
-	notification.DefaultID = notificationDescID.Default.(func() xid.ID)
-	// notification.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	notification.IDValidator = func() func(string) error {
-		validators := notificationDescID.Validators
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationMixinFields1 := notificationMixin[1].Fields()
+	_ = notificationMixinFields1
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationMixinFields1[0].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() xid.ID)
+	// notification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notification.IDValidator = func() func(string) error {
+		validators := notificationDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceMixinFields1 := notificationpreferenceMixin[1].Fields()
+	_ = notificationpreferenceMixinFields1
+	notificationpreferenceMixinFields2 := notificationpreferenceMixin[2].Fields()
+	_ = notificationpreferenceMixinFields2
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceMixinFields1[0].Descriptor()
+	// notificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreference.DefaultCreatedAt = notificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceMixinFields2[0].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescTelegram is the schema descriptor for telegram field.
+	notificationpreferenceDescTelegram := notificationpreferenceFields[5].Descriptor()
+	// notificationpreference.DefaultTelegram holds the default value on creation for the telegram field.
+	notificationpreference.DefaultTelegram = notificationpreferenceDescTelegram.Default.(bool)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() xid.ID)
+	// notificationpreference.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationpreference.IDValidator = func() func(string) error {
+		validators := notificationpreferenceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	policyacceptanceMixin := schema.PolicyAcceptance{}.Mixin()
+	policyacceptanceMixinFields0 := policyacceptanceMixin[0].Fields()
+	_ = policyacceptanceMixinFields0
+	policyacceptanceMixinFields1 := policyacceptanceMixin[1].Fields()
+	_ = policyacceptanceMixinFields1
+	policyacceptanceFields := schema.PolicyAcceptance{}.Fields()
+	_ = policyacceptanceFields
+	// policyacceptanceDescCreatedAt is the schema descriptor for created_at field.
+	policyacceptanceDescCreatedAt := policyacceptanceMixinFields1[0].Descriptor()
+	// policyacceptance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	policyacceptance.DefaultCreatedAt = policyacceptanceDescCreatedAt.Default.(func() time.Time)
+	// policyacceptanceDescID is the schema descriptor for id field.
+	policyacceptanceDescID := policyacceptanceMixinFields0[0].Descriptor()
+	// policyacceptance.DefaultID holds the default value on creation for the id field.
+	policyacceptance.DefaultID = policyacceptanceDescID.Default.(func() xid.ID)
+	// policyacceptance.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	policyacceptance.IDValidator = func() func(string) error {
+		validators := policyacceptanceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	policydocumentMixin := schema.PolicyDocument{}.Mixin()
+	policydocumentMixinFields0 := policydocumentMixin[0].Fields()
+	_ = policydocumentMixinFields0
+	policydocumentMixinFields1 := policydocumentMixin[1].Fields()
+	_ = policydocumentMixinFields1
+	policydocumentMixinFields2 := policydocumentMixin[2].Fields()
+	_ = policydocumentMixinFields2
+	policydocumentFields := schema.PolicyDocument{}.Fields()
+	_ = policydocumentFields
+	// policydocumentDescCreatedAt is the schema descriptor for created_at field.
+	policydocumentDescCreatedAt := policydocumentMixinFields1[0].Descriptor()
+	// policydocument.DefaultCreatedAt holds the default value on creation for the created_at field.
+	policydocument.DefaultCreatedAt = policydocumentDescCreatedAt.Default.(func() time.Time)
+	// policydocumentDescUpdatedAt is the schema descriptor for updated_at field.
+	policydocumentDescUpdatedAt := policydocumentMixinFields2[0].Descriptor()
+	// policydocument.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	policydocument.DefaultUpdatedAt = policydocumentDescUpdatedAt.Default.(func() time.Time)
+	// policydocument.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	policydocument.UpdateDefaultUpdatedAt = policydocumentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// policydocumentDescRequired is the schema descriptor for required field.
+	policydocumentDescRequired := policydocumentFields[2].Descriptor()
+	// policydocument.DefaultRequired holds the default value on creation for the required field.
+	policydocument.DefaultRequired = policydocumentDescRequired.Default.(bool)
+	// policydocumentDescID is the schema descriptor for id field.
+	policydocumentDescID := policydocumentMixinFields0[0].Descriptor()
+	// policydocument.DefaultID holds the default value on creation for the id field.
+	policydocument.DefaultID = policydocumentDescID.Default.(func() xid.ID)
+	// policydocument.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	policydocument.IDValidator = func() func(string) error {
+		validators := policydocumentDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	policyversionMixin := schema.PolicyVersion{}.Mixin()
+	policyversionMixinFields0 := policyversionMixin[0].Fields()
+	_ = policyversionMixinFields0
+	policyversionMixinFields1 := policyversionMixin[1].Fields()
+	_ = policyversionMixinFields1
+	policyversionFields := schema.PolicyVersion{}.Fields()
+	_ = policyversionFields
+	// policyversionDescCreatedAt is the schema descriptor for created_at field.
+	policyversionDescCreatedAt := policyversionMixinFields1[0].Descriptor()
+	// policyversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	policyversion.DefaultCreatedAt = policyversionDescCreatedAt.Default.(func() time.Time)
+	// policyversionDescID is the schema descriptor for id field.
+	policyversionDescID := policyversionMixinFields0[0].Descriptor()
+	// policyversion.DefaultID holds the default value on creation for the id field.
+	policyversion.DefaultID = policyversionDescID.Default.(func() xid.ID)
+	// policyversion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	policyversion.IDValidator = func() func(string) error {
+		validators := policyversionDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -923,6 +2504,45 @@ func init() {
 			return nil
 		}
 	}()
+	pushsubscriptionMixin := schema.PushSubscription{}.Mixin()
+	pushsubscriptionMixinFields0 := pushsubscriptionMixin[0].Fields()
+	_ = pushsubscriptionMixinFields0
+	pushsubscriptionMixinFields1 := pushsubscriptionMixin[1].Fields()
+	_ = pushsubscriptionMixinFields1
+	pushsubscriptionMixinFields2 := pushsubscriptionMixin[2].Fields()
+	_ = pushsubscriptionMixinFields2
+	pushsubscriptionFields := schema.PushSubscription{}.Fields()
+	_ = pushsubscriptionFields
+	// pushsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	pushsubscriptionDescCreatedAt := pushsubscriptionMixinFields1[0].Descriptor()
+	// pushsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pushsubscription.DefaultCreatedAt = pushsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// pushsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	pushsubscriptionDescUpdatedAt := pushsubscriptionMixinFields2[0].Descriptor()
+	// pushsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pushsubscription.DefaultUpdatedAt = pushsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// pushsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pushsubscription.UpdateDefaultUpdatedAt = pushsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pushsubscriptionDescID is the schema descriptor for id field.
+	pushsubscriptionDescID := pushsubscriptionMixinFields0[0].Descriptor()
+	// pushsubscription.DefaultID holds the default value on creation for the id field.
+	pushsubscription.DefaultID = pushsubscriptionDescID.Default.(func() xid.ID)
+	// pushsubscription.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	pushsubscription.IDValidator = func() func(string) error {
+		validators := pushsubscriptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	questionMixin := schema.Question{}.Mixin()
 	questionMixinFields0 := questionMixin[0].Fields()
 	_ = questionMixinFields0
@@ -985,6 +2605,45 @@ func init() {
 			return nil
 		}
 	}()
+	readinghistoryMixin := schema.ReadingHistory{}.Mixin()
+	readinghistoryMixinFields0 := readinghistoryMixin[0].Fields()
+	_ = readinghistoryMixinFields0
+	readinghistoryMixinFields1 := readinghistoryMixin[1].Fields()
+	_ = readinghistoryMixinFields1
+	readinghistoryMixinFields2 := readinghistoryMixin[2].Fields()
+	_ = readinghistoryMixinFields2
+	readinghistoryFields := schema.ReadingHistory{}.Fields()
+	_ = readinghistoryFields
+	// readinghistoryDescCreatedAt is the schema descriptor for created_at field.
+	readinghistoryDescCreatedAt := readinghistoryMixinFields1[0].Descriptor()
+	// readinghistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	readinghistory.DefaultCreatedAt = readinghistoryDescCreatedAt.Default.(func() time.Time)
+	// readinghistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	readinghistoryDescUpdatedAt := readinghistoryMixinFields2[0].Descriptor()
+	// readinghistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	readinghistory.DefaultUpdatedAt = readinghistoryDescUpdatedAt.Default.(func() time.Time)
+	// readinghistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	readinghistory.UpdateDefaultUpdatedAt = readinghistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// readinghistoryDescID is the schema descriptor for id field.
+	readinghistoryDescID := readinghistoryMixinFields0[0].Descriptor()
+	// readinghistory.DefaultID holds the default value on creation for the id field.
+	readinghistory.DefaultID = readinghistoryDescID.Default.(func() xid.ID)
+	// readinghistory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	readinghistory.IDValidator = func() func(string) error {
+		validators := readinghistoryDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	reportMixin := schema.Report{}.Mixin()
 	reportMixinFields0 := reportMixin[0].Fields()
 	_ = reportMixinFields0
@@ -1028,6 +2687,37 @@ func init() {
 			return nil
 		}
 	}()
+	reputationentryMixin := schema.ReputationEntry{}.Mixin()
+	reputationentryMixinFields0 := reputationentryMixin[0].Fields()
+	_ = reputationentryMixinFields0
+	reputationentryMixinFields1 := reputationentryMixin[1].Fields()
+	_ = reputationentryMixinFields1
+	reputationentryFields := schema.ReputationEntry{}.Fields()
+	_ = reputationentryFields
+	// reputationentryDescCreatedAt is the schema descriptor for created_at field.
+	reputationentryDescCreatedAt := reputationentryMixinFields1[0].Descriptor()
+	// reputationentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reputationentry.DefaultCreatedAt = reputationentryDescCreatedAt.Default.(func() time.Time)
+	// reputationentryDescID is the schema descriptor for id field.
+	reputationentryDescID := reputationentryMixinFields0[0].Descriptor()
+	// reputationentry.DefaultID holds the default value on creation for the id field.
+	reputationentry.DefaultID = reputationentryDescID.Default.(func() xid.ID)
+	// reputationentry.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reputationentry.IDValidator = func() func(string) error {
+		validators := reputationentryDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinFields0 := roleMixin[0].Fields()
 	_ = roleMixinFields0
@@ -1071,6 +2761,49 @@ func init() {
 			return nil
 		}
 	}()
+	scheduledtaskMixin := schema.ScheduledTask{}.Mixin()
+	scheduledtaskMixinFields0 := scheduledtaskMixin[0].Fields()
+	_ = scheduledtaskMixinFields0
+	scheduledtaskMixinFields1 := scheduledtaskMixin[1].Fields()
+	_ = scheduledtaskMixinFields1
+	scheduledtaskMixinFields2 := scheduledtaskMixin[2].Fields()
+	_ = scheduledtaskMixinFields2
+	scheduledtaskFields := schema.ScheduledTask{}.Fields()
+	_ = scheduledtaskFields
+	// scheduledtaskDescCreatedAt is the schema descriptor for created_at field.
+	scheduledtaskDescCreatedAt := scheduledtaskMixinFields1[0].Descriptor()
+	// scheduledtask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledtask.DefaultCreatedAt = scheduledtaskDescCreatedAt.Default.(func() time.Time)
+	// scheduledtaskDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledtaskDescUpdatedAt := scheduledtaskMixinFields2[0].Descriptor()
+	// scheduledtask.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledtask.DefaultUpdatedAt = scheduledtaskDescUpdatedAt.Default.(func() time.Time)
+	// scheduledtask.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledtask.UpdateDefaultUpdatedAt = scheduledtaskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduledtaskDescEnabled is the schema descriptor for enabled field.
+	scheduledtaskDescEnabled := scheduledtaskFields[2].Descriptor()
+	// scheduledtask.DefaultEnabled holds the default value on creation for the enabled field.
+	scheduledtask.DefaultEnabled = scheduledtaskDescEnabled.Default.(bool)
+	// scheduledtaskDescID is the schema descriptor for id field.
+	scheduledtaskDescID := scheduledtaskMixinFields0[0].Descriptor()
+	// scheduledtask.DefaultID holds the default value on creation for the id field.
+	scheduledtask.DefaultID = scheduledtaskDescID.Default.(func() xid.ID)
+	// scheduledtask.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scheduledtask.IDValidator = func() func(string) error {
+		validators := scheduledtaskDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	sessionMixin := schema.Session{}.Mixin()
 	sessionMixinFields0 := sessionMixin[0].Fields()
 	_ = sessionMixinFields0
@@ -1114,6 +2847,80 @@ func init() {
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	spaceMixin := schema.Space{}.Mixin()
+	spaceMixinFields0 := spaceMixin[0].Fields()
+	_ = spaceMixinFields0
+	spaceMixinFields1 := spaceMixin[1].Fields()
+	_ = spaceMixinFields1
+	spaceMixinFields2 := spaceMixin[2].Fields()
+	_ = spaceMixinFields2
+	spaceFields := schema.Space{}.Fields()
+	_ = spaceFields
+	// spaceDescCreatedAt is the schema descriptor for created_at field.
+	spaceDescCreatedAt := spaceMixinFields1[0].Descriptor()
+	// space.DefaultCreatedAt holds the default value on creation for the created_at field.
+	space.DefaultCreatedAt = spaceDescCreatedAt.Default.(func() time.Time)
+	// spaceDescUpdatedAt is the schema descriptor for updated_at field.
+	spaceDescUpdatedAt := spaceMixinFields2[0].Descriptor()
+	// space.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	space.DefaultUpdatedAt = spaceDescUpdatedAt.Default.(func() time.Time)
+	// space.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	space.UpdateDefaultUpdatedAt = spaceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// spaceDescDescription is the schema descriptor for description field.
+	spaceDescDescription := spaceFields[2].Descriptor()
+	// space.DefaultDescription holds the default value on creation for the description field.
+	space.DefaultDescription = spaceDescDescription.Default.(string)
+	// spaceDescID is the schema descriptor for id field.
+	spaceDescID := spaceMixinFields0[0].Descriptor()
+	// space.DefaultID holds the default value on creation for the id field.
+	space.DefaultID = spaceDescID.Default.(func() xid.ID)
+	// space.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	space.IDValidator = func() func(string) error {
+		validators := spaceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	spacememberMixin := schema.SpaceMember{}.Mixin()
+	spacememberMixinFields0 := spacememberMixin[0].Fields()
+	_ = spacememberMixinFields0
+	spacememberMixinFields1 := spacememberMixin[1].Fields()
+	_ = spacememberMixinFields1
+	spacememberFields := schema.SpaceMember{}.Fields()
+	_ = spacememberFields
+	// spacememberDescCreatedAt is the schema descriptor for created_at field.
+	spacememberDescCreatedAt := spacememberMixinFields1[0].Descriptor()
+	// spacemember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	spacemember.DefaultCreatedAt = spacememberDescCreatedAt.Default.(func() time.Time)
+	// spacememberDescID is the schema descriptor for id field.
+	spacememberDescID := spacememberMixinFields0[0].Descriptor()
+	// spacemember.DefaultID holds the default value on creation for the id field.
+	spacemember.DefaultID = spacememberDescID.Default.(func() xid.ID)
+	// spacemember.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	spacemember.IDValidator = func() func(string) error {
+		validators := spacememberDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	tagMixin := schema.Tag{}.Mixin()
 	tagMixinFields0 := tagMixin[0].Fields()
 	_ = tagMixinFields0
@@ -1145,4 +2952,413 @@ func init() {
 			return nil
 		}
 	}()
+	tagfollowMixin := schema.TagFollow{}.Mixin()
+	tagfollowMixinFields0 := tagfollowMixin[0].Fields()
+	_ = tagfollowMixinFields0
+	tagfollowMixinFields1 := tagfollowMixin[1].Fields()
+	_ = tagfollowMixinFields1
+	tagfollowFields := schema.TagFollow{}.Fields()
+	_ = tagfollowFields
+	// tagfollowDescCreatedAt is the schema descriptor for created_at field.
+	tagfollowDescCreatedAt := tagfollowMixinFields1[0].Descriptor()
+	// tagfollow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagfollow.DefaultCreatedAt = tagfollowDescCreatedAt.Default.(func() time.Time)
+	// tagfollowDescID is the schema descriptor for id field.
+	tagfollowDescID := tagfollowMixinFields0[0].Descriptor()
+	// tagfollow.DefaultID holds the default value on creation for the id field.
+	tagfollow.DefaultID = tagfollowDescID.Default.(func() xid.ID)
+	// tagfollow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tagfollow.IDValidator = func() func(string) error {
+		validators := tagfollowDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	tagsynonymMixin := schema.TagSynonym{}.Mixin()
+	tagsynonymMixinFields0 := tagsynonymMixin[0].Fields()
+	_ = tagsynonymMixinFields0
+	tagsynonymMixinFields1 := tagsynonymMixin[1].Fields()
+	_ = tagsynonymMixinFields1
+	tagsynonymFields := schema.TagSynonym{}.Fields()
+	_ = tagsynonymFields
+	// tagsynonymDescCreatedAt is the schema descriptor for created_at field.
+	tagsynonymDescCreatedAt := tagsynonymMixinFields1[0].Descriptor()
+	// tagsynonym.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagsynonym.DefaultCreatedAt = tagsynonymDescCreatedAt.Default.(func() time.Time)
+	// tagsynonymDescID is the schema descriptor for id field.
+	tagsynonymDescID := tagsynonymMixinFields0[0].Descriptor()
+	// tagsynonym.DefaultID holds the default value on creation for the id field.
+	tagsynonym.DefaultID = tagsynonymDescID.Default.(func() xid.ID)
+	// tagsynonym.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tagsynonym.IDValidator = func() func(string) error {
+		validators := tagsynonymDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	telegrammessageMixin := schema.TelegramMessage{}.Mixin()
+	telegrammessageMixinFields0 := telegrammessageMixin[0].Fields()
+	_ = telegrammessageMixinFields0
+	telegrammessageMixinFields1 := telegrammessageMixin[1].Fields()
+	_ = telegrammessageMixinFields1
+	telegrammessageFields := schema.TelegramMessage{}.Fields()
+	_ = telegrammessageFields
+	// telegrammessageDescCreatedAt is the schema descriptor for created_at field.
+	telegrammessageDescCreatedAt := telegrammessageMixinFields1[0].Descriptor()
+	// telegrammessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	telegrammessage.DefaultCreatedAt = telegrammessageDescCreatedAt.Default.(func() time.Time)
+	// telegrammessageDescID is the schema descriptor for id field.
+	telegrammessageDescID := telegrammessageMixinFields0[0].Descriptor()
+	// telegrammessage.DefaultID holds the default value on creation for the id field.
+	telegrammessage.DefaultID = telegrammessageDescID.Default.(func() xid.ID)
+	// telegrammessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	telegrammessage.IDValidator = func() func(string) error {
+		validators := telegrammessageDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenantMixinFields0 := tenantMixin[0].Fields()
+	_ = tenantMixinFields0
+	tenantMixinFields1 := tenantMixin[1].Fields()
+	_ = tenantMixinFields1
+	tenantMixinFields2 := tenantMixin[2].Fields()
+	_ = tenantMixinFields2
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescCreatedAt is the schema descriptor for created_at field.
+	tenantDescCreatedAt := tenantMixinFields1[0].Descriptor()
+	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
+	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantDescUpdatedAt := tenantMixinFields2[0].Descriptor()
+	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() time.Time)
+	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenant.UpdateDefaultUpdatedAt = tenantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantMixinFields0[0].Descriptor()
+	// tenant.DefaultID holds the default value on creation for the id field.
+	tenant.DefaultID = tenantDescID.Default.(func() xid.ID)
+	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenant.IDValidator = func() func(string) error {
+		validators := tenantDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	trendingscoreMixin := schema.TrendingScore{}.Mixin()
+	trendingscoreMixinFields0 := trendingscoreMixin[0].Fields()
+	_ = trendingscoreMixinFields0
+	trendingscoreMixinFields1 := trendingscoreMixin[1].Fields()
+	_ = trendingscoreMixinFields1
+	trendingscoreFields := schema.TrendingScore{}.Fields()
+	_ = trendingscoreFields
+	// trendingscoreDescCreatedAt is the schema descriptor for created_at field.
+	trendingscoreDescCreatedAt := trendingscoreMixinFields1[0].Descriptor()
+	// trendingscore.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trendingscore.DefaultCreatedAt = trendingscoreDescCreatedAt.Default.(func() time.Time)
+	// trendingscoreDescID is the schema descriptor for id field.
+	trendingscoreDescID := trendingscoreMixinFields0[0].Descriptor()
+	// trendingscore.DefaultID holds the default value on creation for the id field.
+	trendingscore.DefaultID = trendingscoreDescID.Default.(func() xid.ID)
+	// trendingscore.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	trendingscore.IDValidator = func() func(string) error {
+		validators := trendingscoreDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	uploadsessionMixin := schema.UploadSession{}.Mixin()
+	uploadsessionMixinFields0 := uploadsessionMixin[0].Fields()
+	_ = uploadsessionMixinFields0
+	uploadsessionMixinFields1 := uploadsessionMixin[1].Fields()
+	_ = uploadsessionMixinFields1
+	uploadsessionMixinFields2 := uploadsessionMixin[2].Fields()
+	_ = uploadsessionMixinFields2
+	uploadsessionFields := schema.UploadSession{}.Fields()
+	_ = uploadsessionFields
+	// uploadsessionDescCreatedAt is the schema descriptor for created_at field.
+	uploadsessionDescCreatedAt := uploadsessionMixinFields1[0].Descriptor()
+	// uploadsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	uploadsession.DefaultCreatedAt = uploadsessionDescCreatedAt.Default.(func() time.Time)
+	// uploadsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	uploadsessionDescUpdatedAt := uploadsessionMixinFields2[0].Descriptor()
+	// uploadsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	uploadsession.DefaultUpdatedAt = uploadsessionDescUpdatedAt.Default.(func() time.Time)
+	// uploadsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	uploadsession.UpdateDefaultUpdatedAt = uploadsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// uploadsessionDescReceived is the schema descriptor for received field.
+	uploadsessionDescReceived := uploadsessionFields[3].Descriptor()
+	// uploadsession.DefaultReceived holds the default value on creation for the received field.
+	uploadsession.DefaultReceived = uploadsessionDescReceived.Default.(int64)
+	// uploadsessionDescPrivate is the schema descriptor for private field.
+	uploadsessionDescPrivate := uploadsessionFields[6].Descriptor()
+	// uploadsession.DefaultPrivate holds the default value on creation for the private field.
+	uploadsession.DefaultPrivate = uploadsessionDescPrivate.Default.(bool)
+	// uploadsessionDescID is the schema descriptor for id field.
+	uploadsessionDescID := uploadsessionMixinFields0[0].Descriptor()
+	// uploadsession.DefaultID holds the default value on creation for the id field.
+	uploadsession.DefaultID = uploadsessionDescID.Default.(func() xid.ID)
+	// uploadsession.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	uploadsession.IDValidator = func() func(string) error {
+		validators := uploadsessionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	verificationrequestMixin := schema.VerificationRequest{}.Mixin()
+	verificationrequestMixinFields0 := verificationrequestMixin[0].Fields()
+	_ = verificationrequestMixinFields0
+	verificationrequestMixinFields1 := verificationrequestMixin[1].Fields()
+	_ = verificationrequestMixinFields1
+	verificationrequestMixinFields2 := verificationrequestMixin[2].Fields()
+	_ = verificationrequestMixinFields2
+	verificationrequestFields := schema.VerificationRequest{}.Fields()
+	_ = verificationrequestFields
+	// verificationrequestDescCreatedAt is the schema descriptor for created_at field.
+	verificationrequestDescCreatedAt := verificationrequestMixinFields1[0].Descriptor()
+	// verificationrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	verificationrequest.DefaultCreatedAt = verificationrequestDescCreatedAt.Default.(func() time.Time)
+	// verificationrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	verificationrequestDescUpdatedAt := verificationrequestMixinFields2[0].Descriptor()
+	// verificationrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	verificationrequest.DefaultUpdatedAt = verificationrequestDescUpdatedAt.Default.(func() time.Time)
+	// verificationrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	verificationrequest.UpdateDefaultUpdatedAt = verificationrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// verificationrequestDescID is the schema descriptor for id field.
+	verificationrequestDescID := verificationrequestMixinFields0[0].Descriptor()
+	// verificationrequest.DefaultID holds the default value on creation for the id field.
+	verificationrequest.DefaultID = verificationrequestDescID.Default.(func() xid.ID)
+	// verificationrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	verificationrequest.IDValidator = func() func(string) error {
+		validators := verificationrequestDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	warningMixin := schema.Warning{}.Mixin()
+	warningMixinFields0 := warningMixin[0].Fields()
+	_ = warningMixinFields0
+	warningMixinFields1 := warningMixin[1].Fields()
+	_ = warningMixinFields1
+	warningFields := schema.Warning{}.Fields()
+	_ = warningFields
+	// warningDescCreatedAt is the schema descriptor for created_at field.
+	warningDescCreatedAt := warningMixinFields1[0].Descriptor()
+	// warning.DefaultCreatedAt holds the default value on creation for the created_at field.
+	warning.DefaultCreatedAt = warningDescCreatedAt.Default.(func() time.Time)
+	// warningDescID is the schema descriptor for id field.
+	warningDescID := warningMixinFields0[0].Descriptor()
+	// warning.DefaultID holds the default value on creation for the id field.
+	warning.DefaultID = warningDescID.Default.(func() xid.ID)
+	// warning.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	warning.IDValidator = func() func(string) error {
+		validators := warningDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookMixinFields1 := webhookMixin[1].Fields()
+	_ = webhookMixinFields1
+	webhookMixinFields2 := webhookMixin[2].Fields()
+	_ = webhookMixinFields2
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookMixinFields1[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookMixinFields2[0].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() xid.ID)
+	// webhook.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhook.IDValidator = func() func(string) error {
+		validators := webhookDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryMixinFields2 := webhookdeliveryMixin[2].Fields()
+	_ = webhookdeliveryMixinFields2
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields2[0].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() xid.ID)
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = func() func(string) error {
+		validators := webhookdeliveryDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	wordfilterMixin := schema.WordFilter{}.Mixin()
+	wordfilterMixinFields0 := wordfilterMixin[0].Fields()
+	_ = wordfilterMixinFields0
+	wordfilterMixinFields1 := wordfilterMixin[1].Fields()
+	_ = wordfilterMixinFields1
+	wordfilterMixinFields2 := wordfilterMixin[2].Fields()
+	_ = wordfilterMixinFields2
+	wordfilterFields := schema.WordFilter{}.Fields()
+	_ = wordfilterFields
+	// wordfilterDescCreatedAt is the schema descriptor for created_at field.
+	wordfilterDescCreatedAt := wordfilterMixinFields1[0].Descriptor()
+	// wordfilter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wordfilter.DefaultCreatedAt = wordfilterDescCreatedAt.Default.(func() time.Time)
+	// wordfilterDescUpdatedAt is the schema descriptor for updated_at field.
+	wordfilterDescUpdatedAt := wordfilterMixinFields2[0].Descriptor()
+	// wordfilter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wordfilter.DefaultUpdatedAt = wordfilterDescUpdatedAt.Default.(func() time.Time)
+	// wordfilter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wordfilter.UpdateDefaultUpdatedAt = wordfilterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// wordfilterDescEnabled is the schema descriptor for enabled field.
+	wordfilterDescEnabled := wordfilterFields[4].Descriptor()
+	// wordfilter.DefaultEnabled holds the default value on creation for the enabled field.
+	wordfilter.DefaultEnabled = wordfilterDescEnabled.Default.(bool)
+	// wordfilterDescID is the schema descriptor for id field.
+	wordfilterDescID := wordfilterMixinFields0[0].Descriptor()
+	// wordfilter.DefaultID holds the default value on creation for the id field.
+	wordfilter.DefaultID = wordfilterDescID.Default.(func() xid.ID)
+	// wordfilter.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	wordfilter.IDValidator = func() func(string) error {
+		validators := wordfilterDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 }
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/scheduledtask"
+	"github.com/rs/xid"
+)
+
+// ScheduledTask is the model entity for the ScheduledTask schema.
+type ScheduledTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// An admin's override of the cron expression the task was registered with.
+	Schedule *string `json:"schedule,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// LastStartedAt holds the value of the "last_started_at" field.
+	LastStartedAt *time.Time `json:"last_started_at,omitempty"`
+	// LastFinishedAt holds the value of the "last_finished_at" field.
+	LastFinishedAt *time.Time `json:"last_finished_at,omitempty"`
+	// LastStatus holds the value of the "last_status" field.
+	LastStatus *scheduledtask.LastStatus `json:"last_status,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError    *string `json:"last_error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledtask.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case scheduledtask.FieldName, scheduledtask.FieldSchedule, scheduledtask.FieldLastStatus, scheduledtask.FieldLastError:
+			values[i] = new(sql.NullString)
+		case scheduledtask.FieldCreatedAt, scheduledtask.FieldUpdatedAt, scheduledtask.FieldNextRunAt, scheduledtask.FieldLastStartedAt, scheduledtask.FieldLastFinishedAt:
+			values[i] = new(sql.NullTime)
+		case scheduledtask.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledTask fields.
+func (_m *ScheduledTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledtask.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case scheduledtask.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scheduledtask.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case scheduledtask.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case scheduledtask.FieldSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule", values[i])
+			} else if value.Valid {
+				_m.Schedule = new(string)
+				*_m.Schedule = value.String
+			}
+		case scheduledtask.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case scheduledtask.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = value.Time
+			}
+		case scheduledtask.FieldLastStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_started_at", values[i])
+			} else if value.Valid {
+				_m.LastStartedAt = new(time.Time)
+				*_m.LastStartedAt = value.Time
+			}
+		case scheduledtask.FieldLastFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_finished_at", values[i])
+			} else if value.Valid {
+				_m.LastFinishedAt = new(time.Time)
+				*_m.LastFinishedAt = value.Time
+			}
+		case scheduledtask.FieldLastStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_status", values[i])
+			} else if value.Valid {
+				_m.LastStatus = new(scheduledtask.LastStatus)
+				*_m.LastStatus = scheduledtask.LastStatus(value.String)
+			}
+		case scheduledtask.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = new(string)
+				*_m.LastError = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledTask.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScheduledTask) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScheduledTask.
+// Note that you need to call ScheduledTask.Unwrap() before calling this method if this ScheduledTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScheduledTask) Update() *ScheduledTaskUpdateOne {
+	return NewScheduledTaskClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScheduledTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScheduledTask) Unwrap() *ScheduledTask {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledTask is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScheduledTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Schedule; v != nil {
+		builder.WriteString("schedule=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(_m.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LastStartedAt; v != nil {
+		builder.WriteString("last_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastFinishedAt; v != nil {
+		builder.WriteString("last_finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastStatus; v != nil {
+		builder.WriteString("last_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledTasks is a parsable slice of ScheduledTask.
+type ScheduledTasks []*ScheduledTask
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledtask
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the scheduledtask type in the database.
+	Label = "scheduled_task"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSchedule holds the string denoting the schedule field in the database.
+	FieldSchedule = "schedule"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldNextRunAt holds the string denoting the next_run_at field in the database.
+	FieldNextRunAt = "next_run_at"
+	// FieldLastStartedAt holds the string denoting the last_started_at field in the database.
+	FieldLastStartedAt = "last_started_at"
+	// FieldLastFinishedAt holds the string denoting the last_finished_at field in the database.
+	FieldLastFinishedAt = "last_finished_at"
+	// FieldLastStatus holds the string denoting the last_status field in the database.
+	FieldLastStatus = "last_status"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// Table holds the table name of the scheduledtask in the database.
+	Table = "scheduled_tasks"
+)
+
+// Columns holds all SQL columns for scheduledtask fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldSchedule,
+	FieldEnabled,
+	FieldNextRunAt,
+	FieldLastStartedAt,
+	FieldLastFinishedAt,
+	FieldLastStatus,
+	FieldLastError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// LastStatus defines the type for the "last_status" enum field.
+type LastStatus string
+
+// LastStatus values.
+const (
+	LastStatusRunning   LastStatus = "running"
+	LastStatusSucceeded LastStatus = "succeeded"
+	LastStatusFailed    LastStatus = "failed"
+)
+
+func (ls LastStatus) String() string {
+	return string(ls)
+}
+
+// LastStatusValidator is a validator for the "last_status" field enum values. It is called by the builders before save.
+func LastStatusValidator(ls LastStatus) error {
+	switch ls {
+	case LastStatusRunning, LastStatusSucceeded, LastStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("scheduledtask: invalid enum value for last_status field: %q", ls)
+	}
+}
+
+// OrderOption defines the ordering options for the ScheduledTask queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySchedule orders the results by the schedule field.
+func BySchedule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchedule, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByNextRunAt orders the results by the next_run_at field.
+func ByNextRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRunAt, opts...).ToFunc()
+}
+
+// ByLastStartedAt orders the results by the last_started_at field.
+func ByLastStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastStartedAt, opts...).ToFunc()
+}
+
+// ByLastFinishedAt orders the results by the last_finished_at field.
+func ByLastFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFinishedAt, opts...).ToFunc()
+}
+
+// ByLastStatus orders the results by the last_status field.
+func ByLastStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastStatus, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
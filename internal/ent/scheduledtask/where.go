@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledtask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldName, v))
+}
+
+// Schedule applies equality check predicate on the "schedule" field. It's identical to ScheduleEQ.
+func Schedule(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldSchedule, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastStartedAt applies equality check predicate on the "last_started_at" field. It's identical to LastStartedAtEQ.
+func LastStartedAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastStartedAt, v))
+}
+
+// LastFinishedAt applies equality check predicate on the "last_finished_at" field. It's identical to LastFinishedAtEQ.
+func LastFinishedAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastFinishedAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContainsFold(FieldName, v))
+}
+
+// ScheduleEQ applies the EQ predicate on the "schedule" field.
+func ScheduleEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldSchedule, v))
+}
+
+// ScheduleNEQ applies the NEQ predicate on the "schedule" field.
+func ScheduleNEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldSchedule, v))
+}
+
+// ScheduleIn applies the In predicate on the "schedule" field.
+func ScheduleIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldSchedule, vs...))
+}
+
+// ScheduleNotIn applies the NotIn predicate on the "schedule" field.
+func ScheduleNotIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldSchedule, vs...))
+}
+
+// ScheduleGT applies the GT predicate on the "schedule" field.
+func ScheduleGT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldSchedule, v))
+}
+
+// ScheduleGTE applies the GTE predicate on the "schedule" field.
+func ScheduleGTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldSchedule, v))
+}
+
+// ScheduleLT applies the LT predicate on the "schedule" field.
+func ScheduleLT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldSchedule, v))
+}
+
+// ScheduleLTE applies the LTE predicate on the "schedule" field.
+func ScheduleLTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldSchedule, v))
+}
+
+// ScheduleContains applies the Contains predicate on the "schedule" field.
+func ScheduleContains(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContains(FieldSchedule, v))
+}
+
+// ScheduleHasPrefix applies the HasPrefix predicate on the "schedule" field.
+func ScheduleHasPrefix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasPrefix(FieldSchedule, v))
+}
+
+// ScheduleHasSuffix applies the HasSuffix predicate on the "schedule" field.
+func ScheduleHasSuffix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasSuffix(FieldSchedule, v))
+}
+
+// ScheduleIsNil applies the IsNil predicate on the "schedule" field.
+func ScheduleIsNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIsNull(FieldSchedule))
+}
+
+// ScheduleNotNil applies the NotNil predicate on the "schedule" field.
+func ScheduleNotNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotNull(FieldSchedule))
+}
+
+// ScheduleEqualFold applies the EqualFold predicate on the "schedule" field.
+func ScheduleEqualFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEqualFold(FieldSchedule, v))
+}
+
+// ScheduleContainsFold applies the ContainsFold predicate on the "schedule" field.
+func ScheduleContainsFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContainsFold(FieldSchedule, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// LastStartedAtEQ applies the EQ predicate on the "last_started_at" field.
+func LastStartedAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastStartedAt, v))
+}
+
+// LastStartedAtNEQ applies the NEQ predicate on the "last_started_at" field.
+func LastStartedAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastStartedAt, v))
+}
+
+// LastStartedAtIn applies the In predicate on the "last_started_at" field.
+func LastStartedAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastStartedAt, vs...))
+}
+
+// LastStartedAtNotIn applies the NotIn predicate on the "last_started_at" field.
+func LastStartedAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastStartedAt, vs...))
+}
+
+// LastStartedAtGT applies the GT predicate on the "last_started_at" field.
+func LastStartedAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLastStartedAt, v))
+}
+
+// LastStartedAtGTE applies the GTE predicate on the "last_started_at" field.
+func LastStartedAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLastStartedAt, v))
+}
+
+// LastStartedAtLT applies the LT predicate on the "last_started_at" field.
+func LastStartedAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLastStartedAt, v))
+}
+
+// LastStartedAtLTE applies the LTE predicate on the "last_started_at" field.
+func LastStartedAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLastStartedAt, v))
+}
+
+// LastStartedAtIsNil applies the IsNil predicate on the "last_started_at" field.
+func LastStartedAtIsNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIsNull(FieldLastStartedAt))
+}
+
+// LastStartedAtNotNil applies the NotNil predicate on the "last_started_at" field.
+func LastStartedAtNotNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotNull(FieldLastStartedAt))
+}
+
+// LastFinishedAtEQ applies the EQ predicate on the "last_finished_at" field.
+func LastFinishedAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastFinishedAt, v))
+}
+
+// LastFinishedAtNEQ applies the NEQ predicate on the "last_finished_at" field.
+func LastFinishedAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastFinishedAt, v))
+}
+
+// LastFinishedAtIn applies the In predicate on the "last_finished_at" field.
+func LastFinishedAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastFinishedAt, vs...))
+}
+
+// LastFinishedAtNotIn applies the NotIn predicate on the "last_finished_at" field.
+func LastFinishedAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastFinishedAt, vs...))
+}
+
+// LastFinishedAtGT applies the GT predicate on the "last_finished_at" field.
+func LastFinishedAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLastFinishedAt, v))
+}
+
+// LastFinishedAtGTE applies the GTE predicate on the "last_finished_at" field.
+func LastFinishedAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLastFinishedAt, v))
+}
+
+// LastFinishedAtLT applies the LT predicate on the "last_finished_at" field.
+func LastFinishedAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLastFinishedAt, v))
+}
+
+// LastFinishedAtLTE applies the LTE predicate on the "last_finished_at" field.
+func LastFinishedAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLastFinishedAt, v))
+}
+
+// LastFinishedAtIsNil applies the IsNil predicate on the "last_finished_at" field.
+func LastFinishedAtIsNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIsNull(FieldLastFinishedAt))
+}
+
+// LastFinishedAtNotNil applies the NotNil predicate on the "last_finished_at" field.
+func LastFinishedAtNotNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotNull(FieldLastFinishedAt))
+}
+
+// LastStatusEQ applies the EQ predicate on the "last_status" field.
+func LastStatusEQ(v LastStatus) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastStatus, v))
+}
+
+// LastStatusNEQ applies the NEQ predicate on the "last_status" field.
+func LastStatusNEQ(v LastStatus) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastStatus, v))
+}
+
+// LastStatusIn applies the In predicate on the "last_status" field.
+func LastStatusIn(vs ...LastStatus) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastStatus, vs...))
+}
+
+// LastStatusNotIn applies the NotIn predicate on the "last_status" field.
+func LastStatusNotIn(vs ...LastStatus) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastStatus, vs...))
+}
+
+// LastStatusIsNil applies the IsNil predicate on the "last_status" field.
+func LastStatusIsNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIsNull(FieldLastStatus))
+}
+
+// LastStatusNotNil applies the NotNil predicate on the "last_status" field.
+func LastStatusNotNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotNull(FieldLastStatus))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledTask) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledTask) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledTask) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.NotPredicates(p))
+}
@@ -0,0 +1,1123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/scheduledtask"
+	"github.com/rs/xid"
+)
+
+// ScheduledTaskCreate is the builder for creating a ScheduledTask entity.
+type ScheduledTaskCreate struct {
+	config
+	mutation *ScheduledTaskMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduledTaskCreate) SetCreatedAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableCreatedAt(v *time.Time) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScheduledTaskCreate) SetUpdatedAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableUpdatedAt(v *time.Time) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScheduledTaskCreate) SetName(v string) *ScheduledTaskCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSchedule sets the "schedule" field.
+func (_c *ScheduledTaskCreate) SetSchedule(v string) *ScheduledTaskCreate {
+	_c.mutation.SetSchedule(v)
+	return _c
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableSchedule(v *string) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetSchedule(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ScheduledTaskCreate) SetEnabled(v bool) *ScheduledTaskCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableEnabled(v *bool) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *ScheduledTaskCreate) SetNextRunAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetLastStartedAt sets the "last_started_at" field.
+func (_c *ScheduledTaskCreate) SetLastStartedAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetLastStartedAt(v)
+	return _c
+}
+
+// SetNillableLastStartedAt sets the "last_started_at" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastStartedAt(v *time.Time) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastStartedAt(*v)
+	}
+	return _c
+}
+
+// SetLastFinishedAt sets the "last_finished_at" field.
+func (_c *ScheduledTaskCreate) SetLastFinishedAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetLastFinishedAt(v)
+	return _c
+}
+
+// SetNillableLastFinishedAt sets the "last_finished_at" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastFinishedAt(v *time.Time) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_c *ScheduledTaskCreate) SetLastStatus(v scheduledtask.LastStatus) *ScheduledTaskCreate {
+	_c.mutation.SetLastStatus(v)
+	return _c
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastStatus(v *scheduledtask.LastStatus) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastStatus(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ScheduledTaskCreate) SetLastError(v string) *ScheduledTaskCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastError(v *string) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScheduledTaskCreate) SetID(v xid.ID) *ScheduledTaskCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableID(v *xid.ID) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ScheduledTaskMutation object of the builder.
+func (_c *ScheduledTaskCreate) Mutation() *ScheduledTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduledTask in the database.
+func (_c *ScheduledTaskCreate) Save(ctx context.Context) (*ScheduledTask, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduledTaskCreate) SaveX(ctx context.Context) *ScheduledTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduledTaskCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scheduledtask.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scheduledtask.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := scheduledtask.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := scheduledtask.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduledTaskCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledTask.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledTask.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ScheduledTask.name"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ScheduledTask.enabled"`)}
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "ScheduledTask.next_run_at"`)}
+	}
+	if v, ok := _c.mutation.LastStatus(); ok {
+		if err := scheduledtask.LastStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_status", err: fmt.Errorf(`ent: validator failed for field "ScheduledTask.last_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := scheduledtask.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ScheduledTask.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScheduledTaskCreate) sqlSave(ctx context.Context) (*ScheduledTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduledTaskCreate) createSpec() (*ScheduledTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledtask.Table, sqlgraph.NewFieldSpec(scheduledtask.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(scheduledtask.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Schedule(); ok {
+		_spec.SetField(scheduledtask.FieldSchedule, field.TypeString, value)
+		_node.Schedule = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(scheduledtask.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := _c.mutation.LastStartedAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastStartedAt, field.TypeTime, value)
+		_node.LastStartedAt = &value
+	}
+	if value, ok := _c.mutation.LastFinishedAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastFinishedAt, field.TypeTime, value)
+		_node.LastFinishedAt = &value
+	}
+	if value, ok := _c.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledtask.FieldLastStatus, field.TypeEnum, value)
+		_node.LastStatus = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(scheduledtask.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledTask.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledTaskUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScheduledTaskCreate) OnConflict(opts ...sql.ConflictOption) *ScheduledTaskUpsertOne {
+	_c.conflict = opts
+	return &ScheduledTaskUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledTask.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScheduledTaskCreate) OnConflictColumns(columns ...string) *ScheduledTaskUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledTaskUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ScheduledTaskUpsertOne is the builder for "upsert"-ing
+	//  one ScheduledTask node.
+	ScheduledTaskUpsertOne struct {
+		create *ScheduledTaskCreate
+	}
+
+	// ScheduledTaskUpsert is the "OnConflict" setter.
+	ScheduledTaskUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledTaskUpsert) SetUpdatedAt(v time.Time) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateUpdatedAt() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ScheduledTaskUpsert) SetName(v string) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateName() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldName)
+	return u
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *ScheduledTaskUpsert) SetSchedule(v string) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldSchedule, v)
+	return u
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateSchedule() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldSchedule)
+	return u
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (u *ScheduledTaskUpsert) ClearSchedule() *ScheduledTaskUpsert {
+	u.SetNull(scheduledtask.FieldSchedule)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduledTaskUpsert) SetEnabled(v bool) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateEnabled() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldEnabled)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduledTaskUpsert) SetNextRunAt(v time.Time) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateNextRunAt() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldNextRunAt)
+	return u
+}
+
+// SetLastStartedAt sets the "last_started_at" field.
+func (u *ScheduledTaskUpsert) SetLastStartedAt(v time.Time) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldLastStartedAt, v)
+	return u
+}
+
+// UpdateLastStartedAt sets the "last_started_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateLastStartedAt() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldLastStartedAt)
+	return u
+}
+
+// ClearLastStartedAt clears the value of the "last_started_at" field.
+func (u *ScheduledTaskUpsert) ClearLastStartedAt() *ScheduledTaskUpsert {
+	u.SetNull(scheduledtask.FieldLastStartedAt)
+	return u
+}
+
+// SetLastFinishedAt sets the "last_finished_at" field.
+func (u *ScheduledTaskUpsert) SetLastFinishedAt(v time.Time) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldLastFinishedAt, v)
+	return u
+}
+
+// UpdateLastFinishedAt sets the "last_finished_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateLastFinishedAt() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldLastFinishedAt)
+	return u
+}
+
+// ClearLastFinishedAt clears the value of the "last_finished_at" field.
+func (u *ScheduledTaskUpsert) ClearLastFinishedAt() *ScheduledTaskUpsert {
+	u.SetNull(scheduledtask.FieldLastFinishedAt)
+	return u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (u *ScheduledTaskUpsert) SetLastStatus(v scheduledtask.LastStatus) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldLastStatus, v)
+	return u
+}
+
+// UpdateLastStatus sets the "last_status" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateLastStatus() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldLastStatus)
+	return u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (u *ScheduledTaskUpsert) ClearLastStatus() *ScheduledTaskUpsert {
+	u.SetNull(scheduledtask.FieldLastStatus)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduledTaskUpsert) SetLastError(v string) *ScheduledTaskUpsert {
+	u.Set(scheduledtask.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduledTaskUpsert) UpdateLastError() *ScheduledTaskUpsert {
+	u.SetExcluded(scheduledtask.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduledTaskUpsert) ClearLastError() *ScheduledTaskUpsert {
+	u.SetNull(scheduledtask.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledTask.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledtask.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledTaskUpsertOne) UpdateNewValues() *ScheduledTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scheduledtask.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scheduledtask.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledTask.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduledTaskUpsertOne) Ignore() *ScheduledTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledTaskUpsertOne) DoNothing() *ScheduledTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledTaskCreate.OnConflict
+// documentation for more info.
+func (u *ScheduledTaskUpsertOne) Update(set func(*ScheduledTaskUpsert)) *ScheduledTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledTaskUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledTaskUpsertOne) SetUpdatedAt(v time.Time) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateUpdatedAt() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScheduledTaskUpsertOne) SetName(v string) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateName() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *ScheduledTaskUpsertOne) SetSchedule(v string) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetSchedule(v)
+	})
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateSchedule() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateSchedule()
+	})
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (u *ScheduledTaskUpsertOne) ClearSchedule() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearSchedule()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduledTaskUpsertOne) SetEnabled(v bool) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateEnabled() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduledTaskUpsertOne) SetNextRunAt(v time.Time) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateNextRunAt() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// SetLastStartedAt sets the "last_started_at" field.
+func (u *ScheduledTaskUpsertOne) SetLastStartedAt(v time.Time) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastStartedAt(v)
+	})
+}
+
+// UpdateLastStartedAt sets the "last_started_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateLastStartedAt() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastStartedAt()
+	})
+}
+
+// ClearLastStartedAt clears the value of the "last_started_at" field.
+func (u *ScheduledTaskUpsertOne) ClearLastStartedAt() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastStartedAt()
+	})
+}
+
+// SetLastFinishedAt sets the "last_finished_at" field.
+func (u *ScheduledTaskUpsertOne) SetLastFinishedAt(v time.Time) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastFinishedAt(v)
+	})
+}
+
+// UpdateLastFinishedAt sets the "last_finished_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateLastFinishedAt() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastFinishedAt()
+	})
+}
+
+// ClearLastFinishedAt clears the value of the "last_finished_at" field.
+func (u *ScheduledTaskUpsertOne) ClearLastFinishedAt() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastFinishedAt()
+	})
+}
+
+// SetLastStatus sets the "last_status" field.
+func (u *ScheduledTaskUpsertOne) SetLastStatus(v scheduledtask.LastStatus) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastStatus(v)
+	})
+}
+
+// UpdateLastStatus sets the "last_status" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateLastStatus() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastStatus()
+	})
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (u *ScheduledTaskUpsertOne) ClearLastStatus() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduledTaskUpsertOne) SetLastError(v string) *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertOne) UpdateLastError() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduledTaskUpsertOne) ClearLastError() *ScheduledTaskUpsertOne {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledTaskUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledTaskCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledTaskUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduledTaskUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScheduledTaskUpsertOne.ID is not supported by MySQL driver. Use ScheduledTaskUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduledTaskUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduledTaskCreateBulk is the builder for creating many ScheduledTask entities in bulk.
+type ScheduledTaskCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledTaskCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduledTask entities in the database.
+func (_c *ScheduledTaskCreateBulk) Save(ctx context.Context) ([]*ScheduledTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduledTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduledTaskCreateBulk) SaveX(ctx context.Context) []*ScheduledTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledTask.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledTaskUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScheduledTaskCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduledTaskUpsertBulk {
+	_c.conflict = opts
+	return &ScheduledTaskUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledTask.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScheduledTaskCreateBulk) OnConflictColumns(columns ...string) *ScheduledTaskUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledTaskUpsertBulk{
+		create: _c,
+	}
+}
+
+// ScheduledTaskUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduledTask nodes.
+type ScheduledTaskUpsertBulk struct {
+	create *ScheduledTaskCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduledTask.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledtask.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledTaskUpsertBulk) UpdateNewValues() *ScheduledTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scheduledtask.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scheduledtask.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledTask.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduledTaskUpsertBulk) Ignore() *ScheduledTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledTaskUpsertBulk) DoNothing() *ScheduledTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledTaskCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduledTaskUpsertBulk) Update(set func(*ScheduledTaskUpsert)) *ScheduledTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledTaskUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledTaskUpsertBulk) SetUpdatedAt(v time.Time) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateUpdatedAt() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScheduledTaskUpsertBulk) SetName(v string) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateName() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *ScheduledTaskUpsertBulk) SetSchedule(v string) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetSchedule(v)
+	})
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateSchedule() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateSchedule()
+	})
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (u *ScheduledTaskUpsertBulk) ClearSchedule() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearSchedule()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduledTaskUpsertBulk) SetEnabled(v bool) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateEnabled() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduledTaskUpsertBulk) SetNextRunAt(v time.Time) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateNextRunAt() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// SetLastStartedAt sets the "last_started_at" field.
+func (u *ScheduledTaskUpsertBulk) SetLastStartedAt(v time.Time) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastStartedAt(v)
+	})
+}
+
+// UpdateLastStartedAt sets the "last_started_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateLastStartedAt() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastStartedAt()
+	})
+}
+
+// ClearLastStartedAt clears the value of the "last_started_at" field.
+func (u *ScheduledTaskUpsertBulk) ClearLastStartedAt() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastStartedAt()
+	})
+}
+
+// SetLastFinishedAt sets the "last_finished_at" field.
+func (u *ScheduledTaskUpsertBulk) SetLastFinishedAt(v time.Time) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastFinishedAt(v)
+	})
+}
+
+// UpdateLastFinishedAt sets the "last_finished_at" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateLastFinishedAt() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastFinishedAt()
+	})
+}
+
+// ClearLastFinishedAt clears the value of the "last_finished_at" field.
+func (u *ScheduledTaskUpsertBulk) ClearLastFinishedAt() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastFinishedAt()
+	})
+}
+
+// SetLastStatus sets the "last_status" field.
+func (u *ScheduledTaskUpsertBulk) SetLastStatus(v scheduledtask.LastStatus) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastStatus(v)
+	})
+}
+
+// UpdateLastStatus sets the "last_status" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateLastStatus() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastStatus()
+	})
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (u *ScheduledTaskUpsertBulk) ClearLastStatus() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduledTaskUpsertBulk) SetLastError(v string) *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduledTaskUpsertBulk) UpdateLastError() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduledTaskUpsertBulk) ClearLastError() *ScheduledTaskUpsertBulk {
+	return u.Update(func(s *ScheduledTaskUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledTaskUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduledTaskCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledTaskCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledTaskUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
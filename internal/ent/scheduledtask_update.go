@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/scheduledtask"
+)
+
+// ScheduledTaskUpdate is the builder for updating ScheduledTask entities.
+type ScheduledTaskUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScheduledTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScheduledTaskUpdate builder.
+func (_u *ScheduledTaskUpdate) Where(ps ...predicate.ScheduledTask) *ScheduledTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledTaskUpdate) SetUpdatedAt(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScheduledTaskUpdate) SetName(v string) *ScheduledTaskUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableName(v *string) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" field.
+func (_u *ScheduledTaskUpdate) SetSchedule(v string) *ScheduledTaskUpdate {
+	_u.mutation.SetSchedule(v)
+	return _u
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableSchedule(v *string) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetSchedule(*v)
+	}
+	return _u
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (_u *ScheduledTaskUpdate) ClearSchedule() *ScheduledTaskUpdate {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScheduledTaskUpdate) SetEnabled(v bool) *ScheduledTaskUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableEnabled(v *bool) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduledTaskUpdate) SetNextRunAt(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableNextRunAt(v *time.Time) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastStartedAt sets the "last_started_at" field.
+func (_u *ScheduledTaskUpdate) SetLastStartedAt(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetLastStartedAt(v)
+	return _u
+}
+
+// SetNillableLastStartedAt sets the "last_started_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastStartedAt(v *time.Time) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastStartedAt clears the value of the "last_started_at" field.
+func (_u *ScheduledTaskUpdate) ClearLastStartedAt() *ScheduledTaskUpdate {
+	_u.mutation.ClearLastStartedAt()
+	return _u
+}
+
+// SetLastFinishedAt sets the "last_finished_at" field.
+func (_u *ScheduledTaskUpdate) SetLastFinishedAt(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetLastFinishedAt(v)
+	return _u
+}
+
+// SetNillableLastFinishedAt sets the "last_finished_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastFinishedAt(v *time.Time) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFinishedAt clears the value of the "last_finished_at" field.
+func (_u *ScheduledTaskUpdate) ClearLastFinishedAt() *ScheduledTaskUpdate {
+	_u.mutation.ClearLastFinishedAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *ScheduledTaskUpdate) SetLastStatus(v scheduledtask.LastStatus) *ScheduledTaskUpdate {
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastStatus(v *scheduledtask.LastStatus) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (_u *ScheduledTaskUpdate) ClearLastStatus() *ScheduledTaskUpdate {
+	_u.mutation.ClearLastStatus()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledTaskUpdate) SetLastError(v string) *ScheduledTaskUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastError(v *string) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduledTaskUpdate) ClearLastError() *ScheduledTaskUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the ScheduledTaskMutation object of the builder.
+func (_u *ScheduledTaskUpdate) Mutation() *ScheduledTaskMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduledTaskUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduledTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledTaskUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledtask.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledTaskUpdate) check() error {
+	if v, ok := _u.mutation.LastStatus(); ok {
+		if err := scheduledtask.LastStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_status", err: fmt.Errorf(`ent: validator failed for field "ScheduledTask.last_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduledTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduledTaskUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduledTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledtask.Table, scheduledtask.Columns, sqlgraph.NewFieldSpec(scheduledtask.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scheduledtask.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Schedule(); ok {
+		_spec.SetField(scheduledtask.FieldSchedule, field.TypeString, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		_spec.ClearField(scheduledtask.FieldSchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(scheduledtask.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastStartedAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastStartedAtCleared() {
+		_spec.ClearField(scheduledtask.FieldLastStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastFinishedAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFinishedAtCleared() {
+		_spec.ClearField(scheduledtask.FieldLastFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledtask.FieldLastStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.LastStatusCleared() {
+		_spec.ClearField(scheduledtask.FieldLastStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledtask.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(scheduledtask.FieldLastError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduledTaskUpdateOne is the builder for updating a single ScheduledTask entity.
+type ScheduledTaskUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScheduledTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledTaskUpdateOne) SetUpdatedAt(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScheduledTaskUpdateOne) SetName(v string) *ScheduledTaskUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableName(v *string) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" field.
+func (_u *ScheduledTaskUpdateOne) SetSchedule(v string) *ScheduledTaskUpdateOne {
+	_u.mutation.SetSchedule(v)
+	return _u
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableSchedule(v *string) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetSchedule(*v)
+	}
+	return _u
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (_u *ScheduledTaskUpdateOne) ClearSchedule() *ScheduledTaskUpdateOne {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScheduledTaskUpdateOne) SetEnabled(v bool) *ScheduledTaskUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableEnabled(v *bool) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduledTaskUpdateOne) SetNextRunAt(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableNextRunAt(v *time.Time) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastStartedAt sets the "last_started_at" field.
+func (_u *ScheduledTaskUpdateOne) SetLastStartedAt(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLastStartedAt(v)
+	return _u
+}
+
+// SetNillableLastStartedAt sets the "last_started_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastStartedAt(v *time.Time) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastStartedAt clears the value of the "last_started_at" field.
+func (_u *ScheduledTaskUpdateOne) ClearLastStartedAt() *ScheduledTaskUpdateOne {
+	_u.mutation.ClearLastStartedAt()
+	return _u
+}
+
+// SetLastFinishedAt sets the "last_finished_at" field.
+func (_u *ScheduledTaskUpdateOne) SetLastFinishedAt(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLastFinishedAt(v)
+	return _u
+}
+
+// SetNillableLastFinishedAt sets the "last_finished_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastFinishedAt(v *time.Time) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFinishedAt clears the value of the "last_finished_at" field.
+func (_u *ScheduledTaskUpdateOne) ClearLastFinishedAt() *ScheduledTaskUpdateOne {
+	_u.mutation.ClearLastFinishedAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *ScheduledTaskUpdateOne) SetLastStatus(v scheduledtask.LastStatus) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastStatus(v *scheduledtask.LastStatus) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (_u *ScheduledTaskUpdateOne) ClearLastStatus() *ScheduledTaskUpdateOne {
+	_u.mutation.ClearLastStatus()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledTaskUpdateOne) SetLastError(v string) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastError(v *string) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduledTaskUpdateOne) ClearLastError() *ScheduledTaskUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the ScheduledTaskMutation object of the builder.
+func (_u *ScheduledTaskUpdateOne) Mutation() *ScheduledTaskMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScheduledTaskUpdate builder.
+func (_u *ScheduledTaskUpdateOne) Where(ps ...predicate.ScheduledTask) *ScheduledTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduledTaskUpdateOne) Select(field string, fields ...string) *ScheduledTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduledTask entity.
+func (_u *ScheduledTaskUpdateOne) Save(ctx context.Context) (*ScheduledTask, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledTaskUpdateOne) SaveX(ctx context.Context) *ScheduledTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduledTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledTaskUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledtask.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.LastStatus(); ok {
+		if err := scheduledtask.LastStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_status", err: fmt.Errorf(`ent: validator failed for field "ScheduledTask.last_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduledTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduledTaskUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduledTaskUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledtask.Table, scheduledtask.Columns, sqlgraph.NewFieldSpec(scheduledtask.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledtask.FieldID)
+		for _, f := range fields {
+			if !scheduledtask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scheduledtask.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Schedule(); ok {
+		_spec.SetField(scheduledtask.FieldSchedule, field.TypeString, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		_spec.ClearField(scheduledtask.FieldSchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(scheduledtask.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastStartedAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastStartedAtCleared() {
+		_spec.ClearField(scheduledtask.FieldLastStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastFinishedAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFinishedAtCleared() {
+		_spec.ClearField(scheduledtask.FieldLastFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledtask.FieldLastStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.LastStatusCleared() {
+		_spec.ClearField(scheduledtask.FieldLastStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledtask.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(scheduledtask.FieldLastError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ScheduledTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
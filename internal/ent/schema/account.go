@@ -21,6 +21,13 @@ type ExternalLink struct {
 	URL  string
 }
 
+// PinnedItem is a thread or library page the member has pinned to their
+// profile, the order of the list is the order they're shown in.
+type PinnedItem struct {
+	ID   xid.ID
+	Kind string
+}
+
 func (Account) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("handle").Unique().NotEmpty(),
@@ -34,12 +41,24 @@ func (Account) Fields() []ent.Field {
 			),
 		field.Bool("admin").Default(false),
 		field.JSON("links", []ExternalLink{}).Optional(),
+		field.JSON("pinned_items", []PinnedItem{}).Optional(),
 		field.JSON("metadata", map[string]any{}).Optional(),
+		field.String("locale").Optional().Nillable(),
+		field.String("timezone").Optional().Nillable(),
+		field.Bool("reading_history_enabled").Default(true),
+		field.Strings("hidden_activity").Optional(),
+		field.String("verification").Optional().Nillable().Comment("The account's verification badge, one of staff, verified, bot or organization."),
 
 		field.String("invited_by_id").
 			GoType(xid.ID{}).
 			Optional().
 			Nillable(),
+
+		field.String("owner_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable().
+			Comment("The member responsible for a bot account."),
 	}
 }
 
@@ -63,6 +82,24 @@ func (Account) Edges() []ent.Edge {
 		edge.To("followed_by", AccountFollow.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 
+		edge.To("blocks", AccountBlock.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("blocked_by", AccountBlock.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("tag_follows", TagFollow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("category_follows", CategoryFollow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("reputation", ReputationEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("badges", AccountBadge.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
 		edge.To("invitations", Invitation.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 
@@ -71,6 +108,12 @@ func (Account) Edges() []ent.Edge {
 			Field("invited_by_id").
 			Unique(),
 
+		edge.To("bots", Account.Type).
+			From("owner").
+			Field("owner_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
 		edge.To("posts", Post.Type),
 		edge.To("questions", Question.Type),
 
@@ -109,10 +152,82 @@ func (Account) Edges() []ent.Edge {
 		edge.To("post_reads", PostRead.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 
+		edge.To("notification_preferences", NotificationPreference.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("push_subscriptions", PushSubscription.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("digest_subscription", DigestSubscription.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("feed_token", FeedToken.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("api_usage", APIUsage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("content_views", ContentView.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
 		edge.To("reports", Report.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 
 		edge.To("handled_reports", Report.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("moderation_notes", ModerationNote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("authored_moderation_notes", ModerationNote.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("warnings", Warning.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("issued_warnings", Warning.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("upload_sessions", UploadSession.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("conversations", ConversationParticipant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("direct_messages", DirectMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("spaces", SpaceMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("application", AccountApplication.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("reviewed_applications", AccountApplication.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("onboarding_steps", AccountOnboardingStep.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("policy_acceptances", PolicyAcceptance.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("bookmarks", Bookmark.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("reading_history", ReadingHistory.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("verification_requests", VerificationRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("reviewed_verification_requests", VerificationRequest.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
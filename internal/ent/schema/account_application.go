@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// AccountApplication holds a new member's answers to the registration
+// questionnaire while their account waits for approval.
+type AccountApplication struct {
+	ent.Schema
+}
+
+type ApplicationAnswer struct {
+	QuestionID string `json:"question_id"`
+	Answer     string `json:"answer"`
+}
+
+func (AccountApplication) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (AccountApplication) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}).Unique(),
+		field.String("status").Comment("One of pending, approved or rejected."),
+		field.JSON("answers", []ApplicationAnswer{}).Optional(),
+		field.Time("submitted_at").Optional().Nillable(),
+		field.String("reviewed_by_id").GoType(xid.ID{}).Optional().Nillable(),
+		field.Time("reviewed_at").Optional().Nillable(),
+		field.Text("reason").Optional().Nillable(),
+	}
+}
+
+func (AccountApplication) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Field("account_id").
+			Ref("application").
+			Required().
+			Unique(),
+
+		edge.From("reviewed_by", Account.Type).
+			Field("reviewed_by_id").
+			Ref("reviewed_applications").
+			Unique(),
+	}
+}
+
+func (AccountApplication) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "submitted_at"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type AccountBadge struct {
+	ent.Schema
+}
+
+func (AccountBadge) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (AccountBadge) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("badge_id").GoType(xid.ID{}),
+	}
+}
+
+func (AccountBadge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("badges").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("badge", Badge.Type).
+			Ref("awards").
+			Field("badge_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (AccountBadge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "badge_id").
+			Unique().
+			StorageKey("unique_account_badge"),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type AccountBlock struct {
+	ent.Schema
+}
+
+func (AccountBlock) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (AccountBlock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("target_account_id").GoType(xid.ID{}),
+		field.String("kind").
+			Comment("Either block or mute, a member has at most one relationship with another."),
+	}
+}
+
+func (AccountBlock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("blocks").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("target", Account.Type).
+			Ref("blocked_by").
+			Field("target_account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (AccountBlock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "target_account_id").Unique(),
+		index.Fields("target_account_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// AccountOnboardingStep records when a member completed a step of the
+// onboarding checklist, such as verifying their email or making a post.
+type AccountOnboardingStep struct {
+	ent.Schema
+}
+
+func (AccountOnboardingStep) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (AccountOnboardingStep) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("step"),
+	}
+}
+
+func (AccountOnboardingStep) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("onboarding_steps").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (AccountOnboardingStep) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "step").Unique(),
+		index.Fields("step"),
+	}
+}
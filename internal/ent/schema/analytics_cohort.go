@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AnalyticsCohort is how many of the accounts which signed up in a week were
+// still active a number of weeks later.
+type AnalyticsCohort struct {
+	ent.Schema
+}
+
+func (AnalyticsCohort) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (AnalyticsCohort) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("cohort"),
+		field.Int("week"),
+		field.Int("size").Default(0),
+		field.Int("retained").Default(0),
+	}
+}
+
+func (AnalyticsCohort) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cohort", "week").Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// AnalyticsContent is the activity on one piece of content on one UTC day.
+// Only the most active items of each day are kept, the rows for a day are
+// replaced wholesale each time it's rolled up.
+type AnalyticsContent struct {
+	ent.Schema
+}
+
+func (AnalyticsContent) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (AnalyticsContent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("date"),
+		field.String("item_kind"),
+		field.String("item_id").GoType(xid.ID{}),
+		field.Int("views").Default(0),
+		field.Int("replies").Default(0),
+		field.Int("reactions").Default(0),
+		field.Int("likes").Default(0),
+		field.Int("collections").Default(0),
+	}
+}
+
+func (AnalyticsContent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("date", "item_kind", "item_id").Unique(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AnalyticsDay is a summary of community activity on one UTC day, rolled up
+// periodically by the analytics job so reports never query raw activity.
+type AnalyticsDay struct {
+	ent.Schema
+}
+
+func (AnalyticsDay) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (AnalyticsDay) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("date"),
+		field.Int("signups").Default(0),
+		field.Int("daily_active").Default(0),
+		field.Int("weekly_active").Default(0),
+		field.Int("monthly_active").Default(0),
+		field.Int("threads").Default(0),
+		field.Int("replies").Default(0),
+		field.Int("reactions").Default(0),
+		field.Int("likes").Default(0),
+	}
+}
+
+func (AnalyticsDay) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("date").Unique(),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AnalyticsExport tracks how far each external sink has been sent analytics,
+// so the next export only sends the days which may have changed since.
+type AnalyticsExport struct {
+	ent.Schema
+}
+
+func (AnalyticsExport) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (AnalyticsExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("sink"),
+		field.Time("exported_through"),
+	}
+}
+
+func (AnalyticsExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sink").Unique(),
+	}
+}
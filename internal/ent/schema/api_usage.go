@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// APIUsage counts the API requests an account made within an hour, split by
+// the access key used. Requests made with a browser session have no key. Rows
+// may be split across multiple instances flushing the same hour, so they're
+// always summed when read.
+type APIUsage struct {
+	ent.Schema
+}
+
+func (APIUsage) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (APIUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("access_key_id").GoType(xid.ID{}).Optional().Nillable(),
+		field.Time("bucket"),
+		field.Int("requests").Default(0),
+		field.Int("client_errors").Default(0),
+		field.Int("server_errors").Default(0),
+		field.Int("rate_limited").Default(0),
+	}
+}
+
+func (APIUsage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("api_usage").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("access_key", Authentication.Type).
+			Ref("api_usage").
+			Field("access_key_id").
+			Unique(),
+	}
+}
+
+func (APIUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "bucket"),
+		index.Fields("bucket"),
+	}
+}
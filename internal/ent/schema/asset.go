@@ -29,6 +29,23 @@ func (Asset) Fields() []ent.Field {
 
 		field.JSON("metadata", map[string]any{}).Optional(),
 
+		// SHA-256 of the file's contents, assets with the same hash share one
+		// stored blob. Nil for assets uploaded before deduplication.
+		field.String("content_hash").Optional().Nillable(),
+
+		// Set for uploads which are processed in the background, such as
+		// videos being transcoded, nil for everything else.
+		field.String("processing_status").Optional().Nillable(),
+
+		// Private assets are only served to members who can see the content
+		// they are attached to, or to anyone holding a signed URL.
+		field.Bool("private").Default(false),
+
+		// The outcome of scanning the upload for malware, nil if it was not
+		// scanned. Quarantined assets are never served.
+		field.String("scan_status").Optional().Nillable(),
+		field.String("scan_signature").Optional().Nillable(),
+
 		// Edges
 		field.String("account_id").GoType(xid.ID{}),
 		field.String("parent_asset_id").GoType(xid.ID{}).Optional().Nillable(),
@@ -38,6 +55,7 @@ func (Asset) Fields() []ent.Field {
 func (Asset) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("filename"),
+		index.Fields("content_hash"),
 	}
 }
 
@@ -52,6 +70,9 @@ func (Asset) Edges() []ent.Edge {
 		edge.From("links", Link.Type).
 			Ref("assets"),
 
+		edge.From("direct_messages", DirectMessage.Type).
+			Ref("assets"),
+
 		edge.From("owner", Account.Type).
 			Field("account_id").
 			Ref("assets").
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -58,6 +59,9 @@ func (Authentication) Edges() []ent.Edge {
 			Field("account_authentication").
 			Required().
 			Unique(),
+
+		edge.To("api_usage", APIUsage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AutomodRule is an administrator configured check which is evaluated against
+// new posts before they're published along with the action to take on a match.
+type AutomodRule struct {
+	ent.Schema
+}
+
+func (AutomodRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (AutomodRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("kind"),
+		field.String("action"),
+		field.Text("pattern").Optional().Nillable(),
+		field.Strings("words").Optional(),
+		field.Int("threshold").Optional().Nillable(),
+		field.Int("window_seconds").Optional().Nillable(),
+		field.Text("reason").Optional().Nillable(),
+		field.Bool("enabled").Default(true),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Badge struct {
+	ent.Schema
+}
+
+func (Badge) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Badge) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+		field.String("description"),
+		field.String("icon").Optional().Nillable(),
+		field.String("trigger").Optional().Nillable().
+			Comment("The kind of activity which is counted towards the badge, unset badges are only awarded manually."),
+		field.Int("threshold").Default(0),
+	}
+}
+
+func (Badge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("awards", AccountBadge.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// Bookmark is a private, read-later marker a member places on any item. Unlike
+// collections they aren't curated or shared, a member can only see their own.
+type Bookmark struct {
+	ent.Schema
+}
+
+func (Bookmark) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Bookmark) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").
+			GoType(xid.ID{}),
+
+		field.String("target_id").
+			GoType(xid.ID{}).
+			Comment("The ID of the bookmarked item. This is not a foreign key as bookmarks can refer to a variety of sources, discriminated by the 'target_kind' field."),
+
+		field.String("target_kind").
+			Comment("The datagraph kind of the bookmarked item."),
+
+		field.Text("note").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Bookmark) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Field("account_id").
+			Ref("bookmarks").
+			Required().
+			Unique(),
+	}
+}
+
+func (Bookmark) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "target_id").Unique(),
+		index.Fields("account_id", "target_kind"),
+	}
+}
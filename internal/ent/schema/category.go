@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/rs/xid"
@@ -32,6 +33,18 @@ func (Category) Fields() []ent.Field {
 			GoType(xid.ID{}).
 			Optional().
 			Nillable(),
+		field.Time("archived_at").
+			Optional().
+			Nillable().
+			Comment("When set, the category is read-only."),
+		field.Time("opens_at").
+			Optional().
+			Nillable().
+			Comment("Optional start of the window in which posting is allowed."),
+		field.Time("closes_at").
+			Optional().
+			Nillable().
+			Comment("Optional end of the window in which posting is allowed."),
 		field.JSON("metadata", map[string]any{}).
 			Optional().
 			Comment("Arbitrary metadata used by clients to store domain specific information."),
@@ -50,5 +63,11 @@ func (Category) Edges() []ent.Edge {
 		edge.To("cover_image", Asset.Type).
 			Field("cover_image_asset_id").
 			Unique(),
+		edge.To("followers", CategoryFollow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("permissions", CategoryPermission.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("required_tags", Tag.Type).
+			Comment("Threads in the category must have at least one of these tags."),
 	}
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// CategoryPermission is one row of a category's permission matrix, granting a
+// role access to the category. Role IDs are not foreign keys because the
+// default roles are not always stored in the database.
+type CategoryPermission struct {
+	ent.Schema
+}
+
+func (CategoryPermission) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (CategoryPermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("category_id").GoType(xid.ID{}),
+		field.String("role_id").GoType(xid.ID{}),
+		field.Bool("can_read").Default(true),
+		field.Bool("can_create_thread").Default(true),
+		field.Bool("can_reply").Default(true),
+		field.Bool("can_attach").Default(true),
+	}
+}
+
+func (CategoryPermission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("category", Category.Type).
+			Ref("permissions").
+			Field("category_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (CategoryPermission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category_id", "role_id").
+			Unique().
+			StorageKey("unique_category_role_permission"),
+		index.Fields("role_id"),
+	}
+}
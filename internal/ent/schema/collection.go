@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/rs/xid"
@@ -39,5 +40,11 @@ func (Collection) Edges() []ent.Edge {
 			Through("collection_posts", CollectionPost.Type),
 		edge.To("nodes", Node.Type).
 			Through("collection_nodes", CollectionNode.Type),
+
+		edge.To("sections", CollectionSection.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("shares", CollectionShare.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
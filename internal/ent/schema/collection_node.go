@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/Southclaws/lexorank"
 	"github.com/rs/xid"
 )
 
@@ -37,6 +38,16 @@ func (CollectionNode) Fields() []ent.Field {
 			Annotations(
 				entsql.Default("normal"),
 			),
+
+		field.String("sort").
+			GoType(lexorank.Key{}).
+			Optional().
+			Nillable(),
+
+		field.String("section_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -53,6 +64,11 @@ func (CollectionNode) Edges() []ent.Edge {
 			Required().
 			Field("node_id").
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("section", CollectionSection.Type).
+			Unique().
+			Field("section_id").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
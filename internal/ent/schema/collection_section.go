@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Southclaws/lexorank"
+	"github.com/rs/xid"
+)
+
+type CollectionSection struct {
+	ent.Schema
+}
+
+func (CollectionSection) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (CollectionSection) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("collection_id").GoType(xid.ID{}),
+		field.String("name"),
+		field.String("description").Optional().Nillable(),
+		field.String("sort").GoType(lexorank.Key{}).DefaultFunc(func() lexorank.Key {
+			return lexorank.Top
+		}),
+	}
+}
+
+func (CollectionSection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("collection", Collection.Type).
+			Ref("sections").
+			Field("collection_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (CollectionSection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("collection_id", "sort"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+type CollectionShare struct {
+	ent.Schema
+}
+
+func (CollectionShare) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, ExpiresAt{}}
+}
+
+func (CollectionShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("collection_id").GoType(xid.ID{}),
+		field.String("token").Unique().NotEmpty().Sensitive(),
+		field.String("passcode_hash").Optional().Nillable().Sensitive(),
+	}
+}
+
+func (CollectionShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("collection", Collection.Type).
+			Ref("shares").
+			Field("collection_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+// ContentSummary is a generated summary of a long thread or library page. The
+// signature is a MinHash of the content at the time the summary was generated
+// and is used to decide whether edits are significant enough to regenerate.
+type ContentSummary struct {
+	ent.Schema
+}
+
+func (ContentSummary) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ContentSummary) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("item_kind"),
+		field.String("item_id").GoType(xid.ID{}).Unique(),
+		field.Text("summary"),
+		field.JSON("signature", []uint64{}),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// ContentView counts the views of a thread or library page within an hour or
+// a day. Viewers are only counted once per period but who they are is never
+// stored. Rows may be split across multiple instances flushing the same
+// period, so they're always summed when read.
+type ContentView struct {
+	ent.Schema
+}
+
+func (ContentView) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ContentView) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("period"),
+		field.Time("bucket"),
+		field.String("item_kind"),
+		field.String("item_id").GoType(xid.ID{}),
+		field.String("author_id").GoType(xid.ID{}),
+		field.Int("views").Default(0),
+		field.Int("unique_viewers").Default(0),
+	}
+}
+
+func (ContentView) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("author", Account.Type).
+			Ref("content_views").
+			Field("author_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ContentView) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "period", "bucket"),
+		index.Fields("author_id", "period", "bucket"),
+		index.Fields("period", "bucket"),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Conversation struct {
+	ent.Schema
+}
+
+func (Conversation) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").Optional().Nillable().
+			Comment("Group conversations may be named, one-to-one conversations never are."),
+		field.Time("last_message_at"),
+	}
+}
+
+func (Conversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("participants", ConversationParticipant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("messages", DirectMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Conversation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_message_at"),
+	}
+}
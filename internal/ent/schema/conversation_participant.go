@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type ConversationParticipant struct {
+	ent.Schema
+}
+
+func (ConversationParticipant) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (ConversationParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("conversation_id").GoType(xid.ID{}),
+		field.String("account_id").GoType(xid.ID{}),
+		field.Time("last_read_at").Optional().Nillable().
+			Comment("Messages sent after this are unread by the participant."),
+		field.Time("left_at").Optional().Nillable().
+			Comment("Participants who leave can no longer see the conversation and are sent no more messages."),
+	}
+}
+
+func (ConversationParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("conversation", Conversation.Type).
+			Ref("participants").
+			Field("conversation_id").
+			Unique().
+			Required(),
+
+		edge.From("account", Account.Type).
+			Ref("conversations").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ConversationParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "account_id").Unique(),
+		index.Fields("account_id"),
+	}
+}
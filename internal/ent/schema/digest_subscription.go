@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+// DigestSubscription holds how often a member receives the summary email and
+// when the last one was sent. Members without a row never receive a digest.
+type DigestSubscription struct {
+	ent.Schema
+}
+
+func (DigestSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (DigestSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}).Unique(),
+		field.String("frequency"),
+		field.Time("last_sent_at").Optional().Nillable(),
+	}
+}
+
+func (DigestSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("digest_subscription").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type DirectMessage struct {
+	ent.Schema
+}
+
+func (DirectMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}, DeletedAt{}}
+}
+
+func (DirectMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("conversation_id").GoType(xid.ID{}),
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("body").
+			Comment("Rich text HTML, stored the same way as post bodies."),
+	}
+}
+
+func (DirectMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("conversation", Conversation.Type).
+			Ref("messages").
+			Field("conversation_id").
+			Unique().
+			Required(),
+
+		edge.From("author", Account.Type).
+			Ref("direct_messages").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.To("assets", Asset.Type),
+	}
+}
+
+func (DirectMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "created_at"),
+	}
+}
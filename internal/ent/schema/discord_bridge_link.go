@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// DiscordBridgeLink pairs a Storyden post with the Discord message it was
+// mirrored to or from by the Discord bridge.
+type DiscordBridgeLink struct {
+	ent.Schema
+}
+
+func (DiscordBridgeLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (DiscordBridgeLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("post_id").GoType(xid.ID{}).Unique(),
+		field.String("thread_id").GoType(xid.ID{}),
+		field.String("discord_channel_id"),
+		field.String("discord_message_id").Unique(),
+	}
+}
+
+func (DiscordBridgeLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("discord_links").
+			Field("post_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (DiscordBridgeLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("discord_channel_id"),
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// FeatureFlag gates a feature which is being rolled out gradually, either to
+// specific accounts and roles or to a percentage of members.
+type FeatureFlag struct {
+	ent.Schema
+}
+
+func (FeatureFlag) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (FeatureFlag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").Unique().NotEmpty(),
+		field.String("description").Optional().Nillable(),
+		field.Bool("enabled").Default(false),
+		field.Int("percentage").Default(0),
+		field.Strings("roles").Optional(),
+		field.Strings("accounts").Optional(),
+	}
+}
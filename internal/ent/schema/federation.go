@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FederationKey is the RSA key pair used to sign ActivityPub requests made on
+// behalf of a local actor, either the instance or a member's account.
+type FederationKey struct {
+	ent.Schema
+}
+
+func (FederationKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (FederationKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("local_actor").Unique(),
+		field.Text("private_key").Sensitive(),
+		field.Text("public_key"),
+	}
+}
+
+// FederationFollower is a remote ActivityPub actor following a local actor.
+type FederationFollower struct {
+	ent.Schema
+}
+
+func (FederationFollower) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (FederationFollower) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("local_actor"),
+		field.Text("actor_uri"),
+		field.Text("inbox"),
+		field.Text("shared_inbox").Optional().Nillable(),
+	}
+}
+
+func (FederationFollower) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("local_actor", "actor_uri").Unique(),
+	}
+}
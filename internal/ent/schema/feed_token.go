@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+// FeedToken is a secret embedded in a member's private feed URLs so that feed
+// readers, which can't hold a session, can read the feed as that member.
+type FeedToken struct {
+	ent.Schema
+}
+
+func (FeedToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (FeedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}).Unique(),
+		field.String("token").Unique().Sensitive(),
+	}
+}
+
+func (FeedToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("feed_token").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type CategoryFollow struct {
+	ent.Schema
+}
+
+func (CategoryFollow) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (CategoryFollow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("category_id").GoType(xid.ID{}),
+	}
+}
+
+func (CategoryFollow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("category_follows").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("category", Category.Type).
+			Ref("followers").
+			Field("category_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (CategoryFollow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "category_id").
+			Unique().
+			StorageKey("unique_category_following_pair"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type TagFollow struct {
+	ent.Schema
+}
+
+func (TagFollow) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (TagFollow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("tag_id").GoType(xid.ID{}),
+	}
+}
+
+func (TagFollow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("tag_follows").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("tag", Tag.Type).
+			Ref("followers").
+			Field("tag_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TagFollow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "tag_id").
+			Unique().
+			StorageKey("unique_tag_following_pair"),
+	}
+}
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// ImportRun is one attempt at importing an archive from another platform. The
+// work happens in background jobs so a run may span several of them.
+type ImportRun struct {
+	ent.Schema
+}
+
+func (ImportRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ImportRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("source").
+			Comment("The platform the archive was exported from."),
+
+		field.String("account_id").
+			GoType(xid.ID{}).
+			Comment("The member who started the import, uploads are owned by them."),
+
+		field.String("asset_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable().
+			Comment("The uploaded archive, if the source is read from one."),
+
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "failed").
+			Default("pending"),
+
+		field.Text("error").
+			Optional().
+			Nillable(),
+
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (ImportRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("records", ImportRecord.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// ImportRecord maps an item in an imported archive to what it became in
+// Storyden. Records are keyed on the source and the item's ID in it so an
+// interrupted or repeated import never creates the same thing twice.
+type ImportRecord struct {
+	ent.Schema
+}
+
+func (ImportRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ImportRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("run_id").GoType(xid.ID{}),
+		field.String("source"),
+		field.String("kind"),
+		field.String("external_id"),
+		field.String("internal_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("imported", "skipped", "failed"),
+		field.Text("message").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (ImportRecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", ImportRun.Type).
+			Ref("records").
+			Field("run_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ImportRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source", "kind", "external_id").Unique(),
+		index.Fields("run_id", "status"),
+	}
+}
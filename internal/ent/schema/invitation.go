@@ -23,6 +23,20 @@ func (Invitation) Fields() []ent.Field {
 
 		field.String("creator_account_id").
 			GoType(xid.ID{}),
+
+		field.Int("max_uses").
+			Optional().
+			Nillable(),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+
+		field.String("role_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable().
+			Comment("A role granted to members who register with this invitation."),
 	}
 }
 
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Job is a unit of background work persisted so it survives restarts and can
+// be retried. Workers claim pending jobs whose run_at has passed.
+type Job struct {
+	ent.Schema
+}
+
+func (Job) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kind").
+			Comment("The name of the handler which processes the job."),
+
+		field.Bytes("payload").
+			Comment("The JSON encoded arguments passed to the handler."),
+
+		field.String("unique_key").
+			Optional().
+			Nillable().
+			Comment("When set, only one pending or running job with this key may exist at a time."),
+
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "dead").
+			Default("pending"),
+
+		field.Int("attempts").
+			Default(0),
+
+		field.Int("max_attempts"),
+
+		field.Time("run_at"),
+
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+
+		field.String("last_error").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+		index.Fields("unique_key").
+			Unique().
+			Annotations(entsql.IndexWhere("status IN ('pending', 'running')")),
+		index.Fields("kind"),
+	}
+}
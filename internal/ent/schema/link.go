@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/rs/xid"
@@ -26,6 +27,17 @@ func (Link) Fields() []ent.Field {
 		field.String("domain"),
 		field.String("title"),
 		field.String("description"),
+		field.String("site_name").Optional().Nillable(),
+
+		// When the link's metadata was last fetched, previews older than the
+		// refresh interval are fetched again the next time the link is shared.
+		field.Time("fetched_at").Optional().Nillable(),
+
+		// Set by the link checker, a link is considered broken once enough
+		// consecutive checks have failed and until a check succeeds again.
+		field.Time("checked_at").Optional().Nillable(),
+		field.Int("check_failures").Default(0),
+		field.Time("broken_at").Optional().Nillable(),
 
 		field.String("primary_asset_id").
 			GoType(xid.ID{}).
@@ -36,6 +48,17 @@ func (Link) Fields() []ent.Field {
 			GoType(xid.ID{}).
 			Optional().
 			Nillable(),
+
+		// A plain text copy of the page captured when the link was first
+		// shared, kept so the content survives the page going away.
+		field.String("snapshot_asset_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable(),
+		field.Text("snapshot_text").Optional().Nillable().
+			Comment("The readable text of the snapshot, used to search links by their content."),
+		field.String("archive_url").Optional().Nillable().
+			Comment("Where the page was archived by the Wayback Machine."),
 	}
 }
 
@@ -59,6 +82,13 @@ func (Link) Edges() []ent.Edge {
 			Field("favicon_asset_id").
 			Unique(),
 
+		edge.To("snapshot", Asset.Type).
+			Field("snapshot_asset_id").
+			Unique(),
+
 		edge.To("assets", Asset.Type),
+
+		edge.To("checks", LinkCheck.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
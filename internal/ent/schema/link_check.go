@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type LinkCheck struct {
+	ent.Schema
+}
+
+func (LinkCheck) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (LinkCheck) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("link_id").GoType(xid.ID{}),
+		field.Int("status_code").Optional().Nillable().
+			Comment("The HTTP status of the response, empty if the request failed before a response was received."),
+		field.String("error").Optional().Nillable(),
+	}
+}
+
+func (LinkCheck) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link", Link.Type).
+			Ref("checks").
+			Field("link_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (LinkCheck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("link_id", "created_at"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// MatrixBridgeLink pairs a Storyden post with the Matrix event it was sent as
+// or received from by the Matrix bridge.
+type MatrixBridgeLink struct {
+	ent.Schema
+}
+
+func (MatrixBridgeLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (MatrixBridgeLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("post_id").GoType(xid.ID{}).Unique(),
+		field.String("thread_id").GoType(xid.ID{}),
+		field.String("matrix_room_id"),
+		field.String("matrix_event_id").Unique(),
+	}
+}
+
+func (MatrixBridgeLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("matrix_links").
+			Field("post_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (MatrixBridgeLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("thread_id"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// ModerationNote is an entry in a member's case file, either a private note
+// written by a moderator about the member or something they posted, or a
+// record of an action taken against them. Only visible to staff.
+type ModerationNote struct {
+	ent.Schema
+}
+
+func (ModerationNote) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ModerationNote) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").
+			GoType(xid.ID{}).
+			Comment("The member whose case file this entry belongs to, for notes on content this is the author of the content."),
+
+		field.String("author_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable(),
+
+		field.String("target_id").
+			GoType(xid.ID{}).
+			Comment("The ID of the member or content the entry is about. This is not a foreign key as entries can refer to a variety of sources, discriminated by the 'target_kind' field."),
+
+		field.String("target_kind").
+			Comment("The datagraph kind of the resource the entry is about."),
+
+		field.String("kind"),
+
+		field.Text("content").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (ModerationNote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Field("account_id").
+			Ref("moderation_notes").
+			Required().
+			Unique(),
+
+		edge.From("author", Account.Type).
+			Field("author_id").
+			Ref("authored_moderation_notes").
+			Unique(),
+	}
+}
+
+func (ModerationNote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id"),
+		index.Fields("target_id"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NetworkBan blocks, or explicitly allows, an IP address, CIDR range or
+// autonomous system from registering accounts and writing posts.
+type NetworkBan struct {
+	ent.Schema
+}
+
+func (NetworkBan) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (NetworkBan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target").Comment("A CIDR range, single addresses are stored as a /32 or /128, or an AS number in the form AS64496."),
+		field.String("list").Comment("Either block or allow, allow entries override any matching block entries."),
+		field.Text("reason").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Int("hits").Default(0),
+		field.Time("last_hit_at").Optional().Nillable(),
+	}
+}
+
+func (NetworkBan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target", "list").Unique(),
+	}
+}
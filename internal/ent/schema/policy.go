@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// PolicyDocument is a document such as the terms of service, its content is
+// held in versions so members' acceptance of each version can be recorded.
+type PolicyDocument struct {
+	ent.Schema
+}
+
+func (PolicyDocument) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (PolicyDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").Unique(),
+		field.String("title"),
+		field.Bool("required").
+			Default(true).
+			Comment("Members must accept the latest published version before posting."),
+	}
+}
+
+func (PolicyDocument) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("versions", PolicyVersion.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+type PolicyVersion struct {
+	ent.Schema
+}
+
+func (PolicyVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (PolicyVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("document_id").GoType(xid.ID{}),
+		field.Int("version"),
+		field.String("content"),
+		field.String("summary").Optional().Nillable().
+			Comment("A short description of what changed since the previous version."),
+		field.Time("published_at").Optional().Nillable(),
+	}
+}
+
+func (PolicyVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", PolicyDocument.Type).
+			Ref("versions").
+			Field("document_id").
+			Unique().
+			Required(),
+
+		edge.To("acceptances", PolicyAcceptance.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (PolicyVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("document_id", "version").Unique(),
+	}
+}
+
+// PolicyAcceptance is the record of a member accepting a policy version, it's
+// kept for compliance purposes.
+type PolicyAcceptance struct {
+	ent.Schema
+}
+
+func (PolicyAcceptance) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (PolicyAcceptance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("version_id").GoType(xid.ID{}),
+	}
+}
+
+func (PolicyAcceptance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("policy_acceptances").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("version", PolicyVersion.Type).
+			Ref("acceptances").
+			Field("version_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (PolicyAcceptance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "version_id").Unique(),
+	}
+}
@@ -24,6 +24,7 @@ func (Post) Fields() []ent.Field {
 		field.String("slug").Optional(),
 		field.Bool("pinned").Default(false),
 		field.Time("last_reply_at"),
+		field.String("accepted_reply_id").GoType(xid.ID{}).Optional().Nillable(),
 
 		// child posts
 		field.String("root_post_id").GoType(xid.ID{}).Optional().Nillable(),
@@ -36,11 +37,16 @@ func (Post) Fields() []ent.Field {
 			Optional().
 			Comment("Arbitrary metadata used by clients to store domain specific information."),
 		field.Enum("visibility").Values(VisibilityTypes...).Default(VisibilityTypesDraft),
+		field.Float("spam_score").
+			Optional().
+			Nillable().
+			Comment("The likelihood, from 0 to 1, that the post is spam as scored by the spam checker when it was submitted."),
 
 		// Edges
 		field.String("account_posts").GoType(xid.ID{}),
 		field.String("category_id").GoType(xid.ID{}).Optional(),
 		field.String("link_id").GoType(xid.ID{}).Optional(),
+		field.String("space_id").GoType(xid.ID{}).Optional().Nillable(),
 	}
 }
 
@@ -74,6 +80,12 @@ func (Post) Edges() []ent.Edge {
 			Unique().
 			Comment("Category is only required for root posts. It should never be added to a child post."),
 
+		edge.From("space", Space.Type).
+			Field("space_id").
+			Ref("posts").
+			Unique().
+			Comment("Space is only set on root posts, replies belong to their thread's space."),
+
 		edge.From("tags", Tag.Type).
 			Ref("posts").
 			Comment("Tags are only required for root posts. It should never be added to a child post."),
@@ -118,5 +130,14 @@ func (Post) Edges() []ent.Edge {
 
 		edge.To("post_reads", PostRead.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("discord_links", DiscordBridgeLink.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("matrix_links", MatrixBridgeLink.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("telegram_messages", TelegramMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+// PushSubscription is a browser's Web Push endpoint for a member's device.
+type PushSubscription struct {
+	ent.Schema
+}
+
+func (PushSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (PushSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.Text("endpoint").Unique(),
+		field.String("p256dh"),
+		field.String("auth"),
+		field.Time("expires_at").Optional().Nillable(),
+	}
+}
+
+func (PushSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("push_subscriptions").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
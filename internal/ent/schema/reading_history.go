@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// ReadingHistory records how far a member has got through a thread or library
+// page so they can pick up where they left off. There's one row per item which
+// is bumped each time the member reads it, members may opt out entirely.
+type ReadingHistory struct {
+	ent.Schema
+}
+
+func (ReadingHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ReadingHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").
+			GoType(xid.ID{}),
+
+		field.String("item_id").
+			GoType(xid.ID{}).
+			Comment("The ID of the item being read. This is not a foreign key as history can refer to a variety of sources, discriminated by the 'item_kind' field."),
+
+		field.String("item_kind").
+			Comment("The datagraph kind of the item being read."),
+
+		field.String("position").
+			Optional().
+			Nillable().
+			Comment("An opaque marker of how far the member has read, such as the ID of the last reply seen or a heading anchor."),
+	}
+}
+
+func (ReadingHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Field("account_id").
+			Ref("reading_history").
+			Required().
+			Unique(),
+	}
+}
+
+func (ReadingHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "item_id").Unique(),
+		index.Fields("account_id", "updated_at"),
+	}
+}
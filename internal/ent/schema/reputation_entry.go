@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type ReputationEntry struct {
+	ent.Schema
+}
+
+func (ReputationEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (ReputationEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("reason"),
+		field.Int("points"),
+		field.String("item_kind").Optional().Nillable(),
+		field.String("item_id").GoType(xid.ID{}).Optional().Nillable(),
+		field.String("source_id").GoType(xid.ID{}).Optional().Nillable().
+			Comment("The thing which caused the award, such as the liking account or the reaction, used to revoke it later."),
+	}
+}
+
+func (ReputationEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("reputation").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ReputationEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "created_at"),
+		index.Fields("account_id", "reason", "item_id", "source_id").
+			Unique().
+			StorageKey("unique_reputation_award"),
+	}
+}
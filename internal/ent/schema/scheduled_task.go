@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledTask tracks when a recurring task registered in code next runs and
+// how its last run went. Replicas share the row so only one runs each tick.
+type ScheduledTask struct {
+	ent.Schema
+}
+
+func (ScheduledTask) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ScheduledTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+
+		field.String("schedule").
+			Optional().
+			Nillable().
+			Comment("An admin's override of the cron expression the task was registered with."),
+
+		field.Bool("enabled").Default(true),
+
+		field.Time("next_run_at"),
+
+		field.Time("last_started_at").
+			Optional().
+			Nillable(),
+
+		field.Time("last_finished_at").
+			Optional().
+			Nillable(),
+
+		field.Enum("last_status").
+			Values("running", "succeeded", "failed").
+			Optional().
+			Nillable(),
+
+		field.String("last_error").
+			Optional().
+			Nillable(),
+	}
+}
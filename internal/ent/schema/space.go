@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Space is a member-created group with its own threads and membership.
+type Space struct {
+	ent.Schema
+}
+
+func (Space) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Space) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("slug").Unique(),
+		field.String("description").Default(""),
+		field.String("join_policy"),
+	}
+}
+
+func (Space) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", SpaceMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("posts", Post.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type SpaceMember struct {
+	ent.Schema
+}
+
+func (SpaceMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (SpaceMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("role"),
+		field.String("status"),
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("space_id").GoType(xid.ID{}),
+	}
+}
+
+func (SpaceMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("spaces").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("space", Space.Type).
+			Ref("members").
+			Field("space_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (SpaceMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "space_id").
+			Unique().
+			StorageKey("unique_space_member"),
+		index.Fields("space_id", "status"),
+	}
+}
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
 )
 
 type Tag struct {
@@ -19,6 +21,13 @@ func (Tag) Fields() []ent.Field {
 		field.String("name").
 			Unique().
 			Immutable(),
+		field.String("parent_tag_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable(),
+		field.Strings("roles").
+			Optional().
+			Comment("When not empty, only members holding one of these roles may apply the tag."),
 	}
 }
 
@@ -28,5 +37,16 @@ func (Tag) Edges() []ent.Edge {
 		edge.To("nodes", Node.Type),
 		edge.From("accounts", Account.Type).
 			Ref("tags"),
+		edge.To("followers", TagFollow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("children", Tag.Type).
+			From("parent").
+			Unique().
+			Field("parent_tag_id").
+			Comment("Optional recursive self reference to the parent tag."),
+		edge.To("synonyms", TagSynonym.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("required_by", Category.Type).
+			Ref("required_tags"),
 	}
 }
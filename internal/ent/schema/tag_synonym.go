@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+// TagSynonym maps an alternative name onto a canonical tag, applying the
+// synonym to an item applies the canonical tag instead.
+type TagSynonym struct {
+	ent.Schema
+}
+
+func (TagSynonym) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (TagSynonym) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+		field.String("tag_id").GoType(xid.ID{}),
+	}
+}
+
+func (TagSynonym) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tag", Tag.Type).
+			Ref("synonyms").
+			Field("tag_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// TelegramMessage records the post a notification sent by the Telegram bot
+// was about, so replying to the message in Telegram replies to the post.
+type TelegramMessage struct {
+	ent.Schema
+}
+
+func (TelegramMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (TelegramMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chat_id"),
+		field.Int64("message_id"),
+		field.String("post_id").GoType(xid.ID{}),
+		field.String("thread_id").GoType(xid.ID{}),
+	}
+}
+
+func (TelegramMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("telegram_messages").
+			Field("post_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TelegramMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("chat_id", "message_id").Unique(),
+	}
+}
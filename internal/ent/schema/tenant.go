@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Tenant is a community hosted by the same deployment on its own hostname.
+// Only the primary community's database holds tenants, each tenant's data is
+// kept in a database of its own.
+type Tenant struct {
+	ent.Schema
+}
+
+func (Tenant) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Tenant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hostname").Unique(),
+		field.String("name"),
+	}
+}
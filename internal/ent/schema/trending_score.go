@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// TrendingScore is a precomputed, periodically refreshed score for a piece of
+// content within a sliding time window. Rows are replaced wholesale per window
+// by the trending job so they never need to be updated in place.
+type TrendingScore struct {
+	ent.Schema
+}
+
+func (TrendingScore) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (TrendingScore) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("window"),
+		field.String("item_kind"),
+		field.String("item_id").GoType(xid.ID{}),
+		field.Float("score"),
+	}
+}
+
+func (TrendingScore) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("window", "item_kind", "item_id").Unique(),
+		index.Fields("window", "score"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// UploadSession tracks a resumable upload whose parts are held in the object
+// store until the client completes it, at which point they become an Asset.
+type UploadSession struct {
+	ent.Schema
+}
+
+func (UploadSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (UploadSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("filename"),
+		field.Int64("size"),
+		field.Int64("received").Default(0),
+		field.String("parent_asset_id").GoType(xid.ID{}).Optional().Nillable(),
+		field.Time("expires_at"),
+		field.Bool("private").Default(false),
+	}
+}
+
+func (UploadSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
+
+func (UploadSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("upload_sessions").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// VerificationRequest is a member asking staff to mark their account with a
+// verification badge, such as to show it belongs to a real organisation.
+type VerificationRequest struct {
+	ent.Schema
+}
+
+func (VerificationRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (VerificationRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("badge").Comment("The verification badge being requested."),
+		field.Text("note").Optional().Nillable(),
+		field.String("status").Comment("One of pending, approved or rejected."),
+		field.String("reviewed_by_id").GoType(xid.ID{}).Optional().Nillable(),
+		field.Time("reviewed_at").Optional().Nillable(),
+		field.Text("reason").Optional().Nillable(),
+	}
+}
+
+func (VerificationRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Field("account_id").
+			Ref("verification_requests").
+			Required().
+			Unique(),
+
+		edge.From("reviewed_by", Account.Type).
+			Field("reviewed_by_id").
+			Ref("reviewed_verification_requests").
+			Unique(),
+	}
+}
+
+func (VerificationRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("account_id", "created_at"),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// Warning is a formal warning issued to a member by a moderator. Active
+// warnings accumulate towards thresholds which restrict what a member can do.
+type Warning struct {
+	ent.Schema
+}
+
+func (Warning) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (Warning) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("issued_by_id").GoType(xid.ID{}).Optional().Nillable(),
+		field.String("severity").Comment("One of minor, moderate or severe, each counts for a different number of points."),
+		field.Text("reason"),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+func (Warning) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Field("account_id").
+			Ref("warnings").
+			Required().
+			Unique(),
+
+		edge.From("issued_by", Account.Type).
+			Field("issued_by_id").
+			Ref("issued_warnings").
+			Unique(),
+	}
+}
+
+func (Warning) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id"),
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// Webhook is an endpoint which receives signed HTTP requests when any of its
+// subscribed events occur. Webhooks are registered by administrators or, for
+// REST hook subscriptions made by automation services, owned by an account.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Text("url"),
+		field.String("secret").Sensitive(),
+		field.Strings("events"),
+		field.Bool("enabled").Default(true),
+		field.String("account_id").GoType(xid.ID{}).Optional().Nillable().Comment("The account which subscribed, for REST hook subscriptions."),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.From("account", Account.Type).
+			Ref("webhooks").
+			Field("account_id").
+			Unique(),
+	}
+}
+
+// WebhookDelivery is a single event sent to a webhook along with the outcome of
+// its most recent attempt. Failed deliveries are retried until they succeed or
+// run out of attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("webhook_id").GoType(xid.ID{}),
+		field.String("event"),
+		field.Text("payload"),
+		field.String("status"),
+		field.Int("attempts").Default(0),
+		field.Int("response_status").Optional().Nillable(),
+		field.Text("error").Optional().Nillable(),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// WordFilter is an administrator configured term which is blocked, held for
+// review or replaced wherever it appears in posts and display names.
+type WordFilter struct {
+	ent.Schema
+}
+
+func (WordFilter) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (WordFilter) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("term"),
+		field.String("action"),
+		field.String("replacement").Optional().Nillable(),
+		field.String("scope"),
+		field.Bool("enabled").Default(true),
+	}
+}
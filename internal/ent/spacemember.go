@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/space"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
+	"github.com/rs/xid"
+)
+
+// SpaceMember is the model entity for the SpaceMember schema.
+type SpaceMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// SpaceID holds the value of the "space_id" field.
+	SpaceID xid.ID `json:"space_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SpaceMemberQuery when eager-loading is set.
+	Edges        SpaceMemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SpaceMemberEdges holds the relations/edges for other nodes in the graph.
+type SpaceMemberEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Space holds the value of the space edge.
+	Space *Space `json:"space,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SpaceMemberEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// SpaceOrErr returns the Space value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SpaceMemberEdges) SpaceOrErr() (*Space, error) {
+	if e.Space != nil {
+		return e.Space, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: space.Label}
+	}
+	return nil, &NotLoadedError{edge: "space"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SpaceMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case spacemember.FieldRole, spacemember.FieldStatus:
+			values[i] = new(sql.NullString)
+		case spacemember.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case spacemember.FieldID, spacemember.FieldAccountID, spacemember.FieldSpaceID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SpaceMember fields.
+func (_m *SpaceMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case spacemember.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case spacemember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case spacemember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case spacemember.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case spacemember.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case spacemember.FieldSpaceID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field space_id", values[i])
+			} else if value != nil {
+				_m.SpaceID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SpaceMember.
+// This includes values selected through modifiers, order, etc.
+func (_m *SpaceMember) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the SpaceMember entity.
+func (_m *SpaceMember) QueryAccount() *AccountQuery {
+	return NewSpaceMemberClient(_m.config).QueryAccount(_m)
+}
+
+// QuerySpace queries the "space" edge of the SpaceMember entity.
+func (_m *SpaceMember) QuerySpace() *SpaceQuery {
+	return NewSpaceMemberClient(_m.config).QuerySpace(_m)
+}
+
+// Update returns a builder for updating this SpaceMember.
+// Note that you need to call SpaceMember.Unwrap() before calling this method if this SpaceMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SpaceMember) Update() *SpaceMemberUpdateOne {
+	return NewSpaceMemberClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SpaceMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SpaceMember) Unwrap() *SpaceMember {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpaceMember is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SpaceMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("SpaceMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("space_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpaceID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SpaceMembers is a parsable slice of SpaceMember.
+type SpaceMembers []*SpaceMember
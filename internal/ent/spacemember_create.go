@@ -0,0 +1,748 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/space"
+	"github.com/Southclaws/storyden/internal/ent/spacemember"
+	"github.com/rs/xid"
+)
+
+// SpaceMemberCreate is the builder for creating a SpaceMember entity.
+type SpaceMemberCreate struct {
+	config
+	mutation *SpaceMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SpaceMemberCreate) SetCreatedAt(v time.Time) *SpaceMemberCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SpaceMemberCreate) SetNillableCreatedAt(v *time.Time) *SpaceMemberCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *SpaceMemberCreate) SetRole(v string) *SpaceMemberCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SpaceMemberCreate) SetStatus(v string) *SpaceMemberCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *SpaceMemberCreate) SetAccountID(v xid.ID) *SpaceMemberCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetSpaceID sets the "space_id" field.
+func (_c *SpaceMemberCreate) SetSpaceID(v xid.ID) *SpaceMemberCreate {
+	_c.mutation.SetSpaceID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SpaceMemberCreate) SetID(v xid.ID) *SpaceMemberCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SpaceMemberCreate) SetNillableID(v *xid.ID) *SpaceMemberCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *SpaceMemberCreate) SetAccount(v *Account) *SpaceMemberCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetSpace sets the "space" edge to the Space entity.
+func (_c *SpaceMemberCreate) SetSpace(v *Space) *SpaceMemberCreate {
+	return _c.SetSpaceID(v.ID)
+}
+
+// Mutation returns the SpaceMemberMutation object of the builder.
+func (_c *SpaceMemberCreate) Mutation() *SpaceMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the SpaceMember in the database.
+func (_c *SpaceMemberCreate) Save(ctx context.Context) (*SpaceMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SpaceMemberCreate) SaveX(ctx context.Context) *SpaceMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SpaceMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SpaceMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SpaceMemberCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := spacemember.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := spacemember.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SpaceMemberCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SpaceMember.created_at"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "SpaceMember.role"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SpaceMember.status"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "SpaceMember.account_id"`)}
+	}
+	if _, ok := _c.mutation.SpaceID(); !ok {
+		return &ValidationError{Name: "space_id", err: errors.New(`ent: missing required field "SpaceMember.space_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := spacemember.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SpaceMember.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "SpaceMember.account"`)}
+	}
+	if len(_c.mutation.SpaceIDs()) == 0 {
+		return &ValidationError{Name: "space", err: errors.New(`ent: missing required edge "SpaceMember.space"`)}
+	}
+	return nil
+}
+
+func (_c *SpaceMemberCreate) sqlSave(ctx context.Context) (*SpaceMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SpaceMemberCreate) createSpec() (*SpaceMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpaceMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(spacemember.Table, sqlgraph.NewFieldSpec(spacemember.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(spacemember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(spacemember.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(spacemember.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   spacemember.AccountTable,
+			Columns: []string{spacemember.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SpaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   spacemember.SpaceTable,
+			Columns: []string{spacemember.SpaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(space.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SpaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SpaceMember.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SpaceMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SpaceMemberCreate) OnConflict(opts ...sql.ConflictOption) *SpaceMemberUpsertOne {
+	_c.conflict = opts
+	return &SpaceMemberUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SpaceMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SpaceMemberCreate) OnConflictColumns(columns ...string) *SpaceMemberUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SpaceMemberUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SpaceMemberUpsertOne is the builder for "upsert"-ing
+	//  one SpaceMember node.
+	SpaceMemberUpsertOne struct {
+		create *SpaceMemberCreate
+	}
+
+	// SpaceMemberUpsert is the "OnConflict" setter.
+	SpaceMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRole sets the "role" field.
+func (u *SpaceMemberUpsert) SetRole(v string) *SpaceMemberUpsert {
+	u.Set(spacemember.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *SpaceMemberUpsert) UpdateRole() *SpaceMemberUpsert {
+	u.SetExcluded(spacemember.FieldRole)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SpaceMemberUpsert) SetStatus(v string) *SpaceMemberUpsert {
+	u.Set(spacemember.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SpaceMemberUpsert) UpdateStatus() *SpaceMemberUpsert {
+	u.SetExcluded(spacemember.FieldStatus)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *SpaceMemberUpsert) SetAccountID(v xid.ID) *SpaceMemberUpsert {
+	u.Set(spacemember.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *SpaceMemberUpsert) UpdateAccountID() *SpaceMemberUpsert {
+	u.SetExcluded(spacemember.FieldAccountID)
+	return u
+}
+
+// SetSpaceID sets the "space_id" field.
+func (u *SpaceMemberUpsert) SetSpaceID(v xid.ID) *SpaceMemberUpsert {
+	u.Set(spacemember.FieldSpaceID, v)
+	return u
+}
+
+// UpdateSpaceID sets the "space_id" field to the value that was provided on create.
+func (u *SpaceMemberUpsert) UpdateSpaceID() *SpaceMemberUpsert {
+	u.SetExcluded(spacemember.FieldSpaceID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SpaceMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(spacemember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SpaceMemberUpsertOne) UpdateNewValues() *SpaceMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(spacemember.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(spacemember.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SpaceMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SpaceMemberUpsertOne) Ignore() *SpaceMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SpaceMemberUpsertOne) DoNothing() *SpaceMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SpaceMemberCreate.OnConflict
+// documentation for more info.
+func (u *SpaceMemberUpsertOne) Update(set func(*SpaceMemberUpsert)) *SpaceMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SpaceMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *SpaceMemberUpsertOne) SetRole(v string) *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *SpaceMemberUpsertOne) UpdateRole() *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SpaceMemberUpsertOne) SetStatus(v string) *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SpaceMemberUpsertOne) UpdateStatus() *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *SpaceMemberUpsertOne) SetAccountID(v xid.ID) *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *SpaceMemberUpsertOne) UpdateAccountID() *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetSpaceID sets the "space_id" field.
+func (u *SpaceMemberUpsertOne) SetSpaceID(v xid.ID) *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetSpaceID(v)
+	})
+}
+
+// UpdateSpaceID sets the "space_id" field to the value that was provided on create.
+func (u *SpaceMemberUpsertOne) UpdateSpaceID() *SpaceMemberUpsertOne {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateSpaceID()
+	})
+}
+
+// Exec executes the query.
+func (u *SpaceMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SpaceMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SpaceMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SpaceMemberUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SpaceMemberUpsertOne.ID is not supported by MySQL driver. Use SpaceMemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SpaceMemberUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SpaceMemberCreateBulk is the builder for creating many SpaceMember entities in bulk.
+type SpaceMemberCreateBulk struct {
+	config
+	err      error
+	builders []*SpaceMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SpaceMember entities in the database.
+func (_c *SpaceMemberCreateBulk) Save(ctx context.Context) ([]*SpaceMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SpaceMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpaceMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SpaceMemberCreateBulk) SaveX(ctx context.Context) []*SpaceMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SpaceMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SpaceMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SpaceMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SpaceMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SpaceMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *SpaceMemberUpsertBulk {
+	_c.conflict = opts
+	return &SpaceMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SpaceMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SpaceMemberCreateBulk) OnConflictColumns(columns ...string) *SpaceMemberUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SpaceMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// SpaceMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of SpaceMember nodes.
+type SpaceMemberUpsertBulk struct {
+	create *SpaceMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SpaceMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(spacemember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SpaceMemberUpsertBulk) UpdateNewValues() *SpaceMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(spacemember.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(spacemember.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SpaceMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SpaceMemberUpsertBulk) Ignore() *SpaceMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SpaceMemberUpsertBulk) DoNothing() *SpaceMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SpaceMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *SpaceMemberUpsertBulk) Update(set func(*SpaceMemberUpsert)) *SpaceMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SpaceMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *SpaceMemberUpsertBulk) SetRole(v string) *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *SpaceMemberUpsertBulk) UpdateRole() *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SpaceMemberUpsertBulk) SetStatus(v string) *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SpaceMemberUpsertBulk) UpdateStatus() *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *SpaceMemberUpsertBulk) SetAccountID(v xid.ID) *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *SpaceMemberUpsertBulk) UpdateAccountID() *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetSpaceID sets the "space_id" field.
+func (u *SpaceMemberUpsertBulk) SetSpaceID(v xid.ID) *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.SetSpaceID(v)
+	})
+}
+
+// UpdateSpaceID sets the "space_id" field to the value that was provided on create.
+func (u *SpaceMemberUpsertBulk) UpdateSpaceID() *SpaceMemberUpsertBulk {
+	return u.Update(func(s *SpaceMemberUpsert) {
+		s.UpdateSpaceID()
+	})
+}
+
+// Exec executes the query.
+func (u *SpaceMemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SpaceMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SpaceMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SpaceMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -22,6 +23,10 @@ type Tag struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// ParentTagID holds the value of the "parent_tag_id" field.
+	ParentTagID *xid.ID `json:"parent_tag_id,omitempty"`
+	// When not empty, only members holding one of these roles may apply the tag.
+	Roles []string `json:"roles,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TagQuery when eager-loading is set.
 	Edges        TagEdges `json:"edges"`
@@ -36,9 +41,19 @@ type TagEdges struct {
 	Nodes []*Node `json:"nodes,omitempty"`
 	// Accounts holds the value of the accounts edge.
 	Accounts []*Account `json:"accounts,omitempty"`
+	// Followers holds the value of the followers edge.
+	Followers []*TagFollow `json:"followers,omitempty"`
+	// Optional recursive self reference to the parent tag.
+	Parent *Tag `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Tag `json:"children,omitempty"`
+	// Synonyms holds the value of the synonyms edge.
+	Synonyms []*TagSynonym `json:"synonyms,omitempty"`
+	// RequiredBy holds the value of the required_by edge.
+	RequiredBy []*Category `json:"required_by,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [8]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -68,11 +83,62 @@ func (e TagEdges) AccountsOrErr() ([]*Account, error) {
 	return nil, &NotLoadedError{edge: "accounts"}
 }
 
+// FollowersOrErr returns the Followers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) FollowersOrErr() ([]*TagFollow, error) {
+	if e.loadedTypes[3] {
+		return e.Followers, nil
+	}
+	return nil, &NotLoadedError{edge: "followers"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagEdges) ParentOrErr() (*Tag, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) ChildrenOrErr() ([]*Tag, error) {
+	if e.loadedTypes[5] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// SynonymsOrErr returns the Synonyms value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) SynonymsOrErr() ([]*TagSynonym, error) {
+	if e.loadedTypes[6] {
+		return e.Synonyms, nil
+	}
+	return nil, &NotLoadedError{edge: "synonyms"}
+}
+
+// RequiredByOrErr returns the RequiredBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) RequiredByOrErr() ([]*Category, error) {
+	if e.loadedTypes[7] {
+		return e.RequiredBy, nil
+	}
+	return nil, &NotLoadedError{edge: "required_by"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tag) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case tag.FieldParentTagID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case tag.FieldRoles:
+			values[i] = new([]byte)
 		case tag.FieldName:
 			values[i] = new(sql.NullString)
 		case tag.FieldCreatedAt:
@@ -112,6 +178,21 @@ func (_m *Tag) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case tag.FieldParentTagID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_tag_id", values[i])
+			} else if value.Valid {
+				_m.ParentTagID = new(xid.ID)
+				*_m.ParentTagID = *value.S.(*xid.ID)
+			}
+		case tag.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -140,6 +221,31 @@ func (_m *Tag) QueryAccounts() *AccountQuery {
 	return NewTagClient(_m.config).QueryAccounts(_m)
 }
 
+// QueryFollowers queries the "followers" edge of the Tag entity.
+func (_m *Tag) QueryFollowers() *TagFollowQuery {
+	return NewTagClient(_m.config).QueryFollowers(_m)
+}
+
+// QueryParent queries the "parent" edge of the Tag entity.
+func (_m *Tag) QueryParent() *TagQuery {
+	return NewTagClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Tag entity.
+func (_m *Tag) QueryChildren() *TagQuery {
+	return NewTagClient(_m.config).QueryChildren(_m)
+}
+
+// QuerySynonyms queries the "synonyms" edge of the Tag entity.
+func (_m *Tag) QuerySynonyms() *TagSynonymQuery {
+	return NewTagClient(_m.config).QuerySynonyms(_m)
+}
+
+// QueryRequiredBy queries the "required_by" edge of the Tag entity.
+func (_m *Tag) QueryRequiredBy() *CategoryQuery {
+	return NewTagClient(_m.config).QueryRequiredBy(_m)
+}
+
 // Update returns a builder for updating this Tag.
 // Note that you need to call Tag.Unwrap() before calling this method if this Tag
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -168,6 +274,14 @@ func (_m *Tag) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.ParentTagID; v != nil {
+		builder.WriteString("parent_tag_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Roles))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -19,12 +19,26 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldParentTagID holds the string denoting the parent_tag_id field in the database.
+	FieldParentTagID = "parent_tag_id"
+	// FieldRoles holds the string denoting the roles field in the database.
+	FieldRoles = "roles"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgeNodes holds the string denoting the nodes edge name in mutations.
 	EdgeNodes = "nodes"
 	// EdgeAccounts holds the string denoting the accounts edge name in mutations.
 	EdgeAccounts = "accounts"
+	// EdgeFollowers holds the string denoting the followers edge name in mutations.
+	EdgeFollowers = "followers"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeSynonyms holds the string denoting the synonyms edge name in mutations.
+	EdgeSynonyms = "synonyms"
+	// EdgeRequiredBy holds the string denoting the required_by edge name in mutations.
+	EdgeRequiredBy = "required_by"
 	// Table holds the table name of the tag in the database.
 	Table = "tags"
 	// PostsTable is the table that holds the posts relation/edge. The primary key declared below.
@@ -42,6 +56,33 @@ const (
 	// AccountsInverseTable is the table name for the Account entity.
 	// It exists in this package in order to avoid circular dependency with the "account" package.
 	AccountsInverseTable = "accounts"
+	// FollowersTable is the table that holds the followers relation/edge.
+	FollowersTable = "tag_follows"
+	// FollowersInverseTable is the table name for the TagFollow entity.
+	// It exists in this package in order to avoid circular dependency with the "tagfollow" package.
+	FollowersInverseTable = "tag_follows"
+	// FollowersColumn is the table column denoting the followers relation/edge.
+	FollowersColumn = "tag_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "tags"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_tag_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "tags"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_tag_id"
+	// SynonymsTable is the table that holds the synonyms relation/edge.
+	SynonymsTable = "tag_synonyms"
+	// SynonymsInverseTable is the table name for the TagSynonym entity.
+	// It exists in this package in order to avoid circular dependency with the "tagsynonym" package.
+	SynonymsInverseTable = "tag_synonyms"
+	// SynonymsColumn is the table column denoting the synonyms relation/edge.
+	SynonymsColumn = "tag_id"
+	// RequiredByTable is the table that holds the required_by relation/edge. The primary key declared below.
+	RequiredByTable = "category_required_tags"
+	// RequiredByInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	RequiredByInverseTable = "categories"
 )
 
 // Columns holds all SQL columns for tag fields.
@@ -49,6 +90,8 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldName,
+	FieldParentTagID,
+	FieldRoles,
 }
 
 var (
@@ -61,6 +104,9 @@ var (
 	// AccountsPrimaryKey and AccountsColumn2 are the table columns denoting the
 	// primary key for the accounts relation (M2M).
 	AccountsPrimaryKey = []string{"account_id", "tag_id"}
+	// RequiredByPrimaryKey and RequiredByColumn2 are the table columns denoting the
+	// primary key for the required_by relation (M2M).
+	RequiredByPrimaryKey = []string{"category_id", "tag_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -100,6 +146,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByParentTagID orders the results by the parent_tag_id field.
+func ByParentTagID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentTagID, opts...).ToFunc()
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -141,6 +192,69 @@ func ByAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByFollowersCount orders the results by followers count.
+func ByFollowersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowersStep(), opts...)
+	}
+}
+
+// ByFollowers orders the results by followers terms.
+func ByFollowers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySynonymsCount orders the results by synonyms count.
+func BySynonymsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSynonymsStep(), opts...)
+	}
+}
+
+// BySynonyms orders the results by synonyms terms.
+func BySynonyms(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSynonymsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequiredByCount orders the results by required_by count.
+func ByRequiredByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequiredByStep(), opts...)
+	}
+}
+
+// ByRequiredBy orders the results by required_by terms.
+func ByRequiredBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequiredByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -162,3 +276,38 @@ func newAccountsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, AccountsTable, AccountsPrimaryKey...),
 	)
 }
+func newFollowersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowersTable, FollowersColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newSynonymsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SynonymsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SynonymsTable, SynonymsColumn),
+	)
+}
+func newRequiredByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequiredByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, RequiredByTable, RequiredByPrimaryKey...),
+	)
+}
@@ -66,6 +66,11 @@ func Name(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldName, v))
 }
 
+// ParentTagID applies equality check predicate on the "parent_tag_id" field. It's identical to ParentTagIDEQ.
+func ParentTagID(v xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldParentTagID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldCreatedAt, v))
@@ -171,6 +176,96 @@ func NameContainsFold(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldContainsFold(FieldName, v))
 }
 
+// ParentTagIDEQ applies the EQ predicate on the "parent_tag_id" field.
+func ParentTagIDEQ(v xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldParentTagID, v))
+}
+
+// ParentTagIDNEQ applies the NEQ predicate on the "parent_tag_id" field.
+func ParentTagIDNEQ(v xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldParentTagID, v))
+}
+
+// ParentTagIDIn applies the In predicate on the "parent_tag_id" field.
+func ParentTagIDIn(vs ...xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldParentTagID, vs...))
+}
+
+// ParentTagIDNotIn applies the NotIn predicate on the "parent_tag_id" field.
+func ParentTagIDNotIn(vs ...xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldParentTagID, vs...))
+}
+
+// ParentTagIDGT applies the GT predicate on the "parent_tag_id" field.
+func ParentTagIDGT(v xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldParentTagID, v))
+}
+
+// ParentTagIDGTE applies the GTE predicate on the "parent_tag_id" field.
+func ParentTagIDGTE(v xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldParentTagID, v))
+}
+
+// ParentTagIDLT applies the LT predicate on the "parent_tag_id" field.
+func ParentTagIDLT(v xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldParentTagID, v))
+}
+
+// ParentTagIDLTE applies the LTE predicate on the "parent_tag_id" field.
+func ParentTagIDLTE(v xid.ID) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldParentTagID, v))
+}
+
+// ParentTagIDContains applies the Contains predicate on the "parent_tag_id" field.
+func ParentTagIDContains(v xid.ID) predicate.Tag {
+	vc := v.String()
+	return predicate.Tag(sql.FieldContains(FieldParentTagID, vc))
+}
+
+// ParentTagIDHasPrefix applies the HasPrefix predicate on the "parent_tag_id" field.
+func ParentTagIDHasPrefix(v xid.ID) predicate.Tag {
+	vc := v.String()
+	return predicate.Tag(sql.FieldHasPrefix(FieldParentTagID, vc))
+}
+
+// ParentTagIDHasSuffix applies the HasSuffix predicate on the "parent_tag_id" field.
+func ParentTagIDHasSuffix(v xid.ID) predicate.Tag {
+	vc := v.String()
+	return predicate.Tag(sql.FieldHasSuffix(FieldParentTagID, vc))
+}
+
+// ParentTagIDIsNil applies the IsNil predicate on the "parent_tag_id" field.
+func ParentTagIDIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldParentTagID))
+}
+
+// ParentTagIDNotNil applies the NotNil predicate on the "parent_tag_id" field.
+func ParentTagIDNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldParentTagID))
+}
+
+// ParentTagIDEqualFold applies the EqualFold predicate on the "parent_tag_id" field.
+func ParentTagIDEqualFold(v xid.ID) predicate.Tag {
+	vc := v.String()
+	return predicate.Tag(sql.FieldEqualFold(FieldParentTagID, vc))
+}
+
+// ParentTagIDContainsFold applies the ContainsFold predicate on the "parent_tag_id" field.
+func ParentTagIDContainsFold(v xid.ID) predicate.Tag {
+	vc := v.String()
+	return predicate.Tag(sql.FieldContainsFold(FieldParentTagID, vc))
+}
+
+// RolesIsNil applies the IsNil predicate on the "roles" field.
+func RolesIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldRoles))
+}
+
+// RolesNotNil applies the NotNil predicate on the "roles" field.
+func RolesNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldRoles))
+}
+
 // HasPosts applies the HasEdge predicate on the "posts" edge.
 func HasPosts() predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
@@ -240,6 +335,121 @@ func HasAccountsWith(preds ...predicate.Account) predicate.Tag {
 	})
 }
 
+// HasFollowers applies the HasEdge predicate on the "followers" edge.
+func HasFollowers() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FollowersTable, FollowersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowersWith applies the HasEdge predicate on the "followers" edge with a given conditions (other predicates).
+func HasFollowersWith(preds ...predicate.TagFollow) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newFollowersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Tag) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Tag) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSynonyms applies the HasEdge predicate on the "synonyms" edge.
+func HasSynonyms() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SynonymsTable, SynonymsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSynonymsWith applies the HasEdge predicate on the "synonyms" edge with a given conditions (other predicates).
+func HasSynonymsWith(preds ...predicate.TagSynonym) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newSynonymsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequiredBy applies the HasEdge predicate on the "required_by" edge.
+func HasRequiredBy() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, RequiredByTable, RequiredByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequiredByWith applies the HasEdge predicate on the "required_by" edge with a given conditions (other predicates).
+func HasRequiredByWith(preds ...predicate.Category) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newRequiredByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tag) predicate.Tag {
 	return predicate.Tag(sql.AndPredicates(predicates...))
@@ -13,9 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
 	"github.com/rs/xid"
 )
 
@@ -47,6 +50,26 @@ func (_c *TagCreate) SetName(v string) *TagCreate {
 	return _c
 }
 
+// SetParentTagID sets the "parent_tag_id" field.
+func (_c *TagCreate) SetParentTagID(v xid.ID) *TagCreate {
+	_c.mutation.SetParentTagID(v)
+	return _c
+}
+
+// SetNillableParentTagID sets the "parent_tag_id" field if the given value is not nil.
+func (_c *TagCreate) SetNillableParentTagID(v *xid.ID) *TagCreate {
+	if v != nil {
+		_c.SetParentTagID(*v)
+	}
+	return _c
+}
+
+// SetRoles sets the "roles" field.
+func (_c *TagCreate) SetRoles(v []string) *TagCreate {
+	_c.mutation.SetRoles(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TagCreate) SetID(v xid.ID) *TagCreate {
 	_c.mutation.SetID(v)
@@ -106,6 +129,85 @@ func (_c *TagCreate) AddAccounts(v ...*Account) *TagCreate {
 	return _c.AddAccountIDs(ids...)
 }
 
+// AddFollowerIDs adds the "followers" edge to the TagFollow entity by IDs.
+func (_c *TagCreate) AddFollowerIDs(ids ...xid.ID) *TagCreate {
+	_c.mutation.AddFollowerIDs(ids...)
+	return _c
+}
+
+// AddFollowers adds the "followers" edges to the TagFollow entity.
+func (_c *TagCreate) AddFollowers(v ...*TagFollow) *TagCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFollowerIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Tag entity by ID.
+func (_c *TagCreate) SetParentID(id xid.ID) *TagCreate {
+	_c.mutation.SetParentID(id)
+	return _c
+}
+
+// SetNillableParentID sets the "parent" edge to the Tag entity by ID if the given value is not nil.
+func (_c *TagCreate) SetNillableParentID(id *xid.ID) *TagCreate {
+	if id != nil {
+		_c = _c.SetParentID(*id)
+	}
+	return _c
+}
+
+// SetParent sets the "parent" edge to the Tag entity.
+func (_c *TagCreate) SetParent(v *Tag) *TagCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Tag entity by IDs.
+func (_c *TagCreate) AddChildIDs(ids ...xid.ID) *TagCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Tag entity.
+func (_c *TagCreate) AddChildren(v ...*Tag) *TagCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
+// AddSynonymIDs adds the "synonyms" edge to the TagSynonym entity by IDs.
+func (_c *TagCreate) AddSynonymIDs(ids ...xid.ID) *TagCreate {
+	_c.mutation.AddSynonymIDs(ids...)
+	return _c
+}
+
+// AddSynonyms adds the "synonyms" edges to the TagSynonym entity.
+func (_c *TagCreate) AddSynonyms(v ...*TagSynonym) *TagCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSynonymIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the Category entity by IDs.
+func (_c *TagCreate) AddRequiredByIDs(ids ...xid.ID) *TagCreate {
+	_c.mutation.AddRequiredByIDs(ids...)
+	return _c
+}
+
+// AddRequiredBy adds the "required_by" edges to the Category entity.
+func (_c *TagCreate) AddRequiredBy(v ...*Category) *TagCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRequiredByIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (_c *TagCreate) Mutation() *TagMutation {
 	return _c.mutation
@@ -208,6 +310,10 @@ func (_c *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		_spec.SetField(tag.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Roles(); ok {
+		_spec.SetField(tag.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
 	if nodes := _c.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -256,6 +362,87 @@ func (_c *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.FollowersTable,
+			Columns: []string{tag.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.ParentTable,
+			Columns: []string{tag.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentTagID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.ChildrenTable,
+			Columns: []string{tag.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SynonymsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.SynonymsTable,
+			Columns: []string{tag.SynonymsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.RequiredByTable,
+			Columns: tag.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -308,6 +495,42 @@ type (
 	}
 )
 
+// SetParentTagID sets the "parent_tag_id" field.
+func (u *TagUpsert) SetParentTagID(v xid.ID) *TagUpsert {
+	u.Set(tag.FieldParentTagID, v)
+	return u
+}
+
+// UpdateParentTagID sets the "parent_tag_id" field to the value that was provided on create.
+func (u *TagUpsert) UpdateParentTagID() *TagUpsert {
+	u.SetExcluded(tag.FieldParentTagID)
+	return u
+}
+
+// ClearParentTagID clears the value of the "parent_tag_id" field.
+func (u *TagUpsert) ClearParentTagID() *TagUpsert {
+	u.SetNull(tag.FieldParentTagID)
+	return u
+}
+
+// SetRoles sets the "roles" field.
+func (u *TagUpsert) SetRoles(v []string) *TagUpsert {
+	u.Set(tag.FieldRoles, v)
+	return u
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *TagUpsert) UpdateRoles() *TagUpsert {
+	u.SetExcluded(tag.FieldRoles)
+	return u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *TagUpsert) ClearRoles() *TagUpsert {
+	u.SetNull(tag.FieldRoles)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -362,6 +585,48 @@ func (u *TagUpsertOne) Update(set func(*TagUpsert)) *TagUpsertOne {
 	return u
 }
 
+// SetParentTagID sets the "parent_tag_id" field.
+func (u *TagUpsertOne) SetParentTagID(v xid.ID) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetParentTagID(v)
+	})
+}
+
+// UpdateParentTagID sets the "parent_tag_id" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateParentTagID() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateParentTagID()
+	})
+}
+
+// ClearParentTagID clears the value of the "parent_tag_id" field.
+func (u *TagUpsertOne) ClearParentTagID() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearParentTagID()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *TagUpsertOne) SetRoles(v []string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateRoles() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *TagUpsertOne) ClearRoles() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearRoles()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -583,6 +848,48 @@ func (u *TagUpsertBulk) Update(set func(*TagUpsert)) *TagUpsertBulk {
 	return u
 }
 
+// SetParentTagID sets the "parent_tag_id" field.
+func (u *TagUpsertBulk) SetParentTagID(v xid.ID) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetParentTagID(v)
+	})
+}
+
+// UpdateParentTagID sets the "parent_tag_id" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateParentTagID() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateParentTagID()
+	})
+}
+
+// ClearParentTagID clears the value of the "parent_tag_id" field.
+func (u *TagUpsertBulk) ClearParentTagID() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearParentTagID()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *TagUpsertBulk) SetRoles(v []string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateRoles() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *TagUpsertBulk) ClearRoles() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearRoles()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
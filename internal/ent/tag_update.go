@@ -9,12 +9,16 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/node"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
 	"github.com/rs/xid"
 )
 
@@ -32,6 +36,44 @@ func (_u *TagUpdate) Where(ps ...predicate.Tag) *TagUpdate {
 	return _u
 }
 
+// SetParentTagID sets the "parent_tag_id" field.
+func (_u *TagUpdate) SetParentTagID(v xid.ID) *TagUpdate {
+	_u.mutation.SetParentTagID(v)
+	return _u
+}
+
+// SetNillableParentTagID sets the "parent_tag_id" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableParentTagID(v *xid.ID) *TagUpdate {
+	if v != nil {
+		_u.SetParentTagID(*v)
+	}
+	return _u
+}
+
+// ClearParentTagID clears the value of the "parent_tag_id" field.
+func (_u *TagUpdate) ClearParentTagID() *TagUpdate {
+	_u.mutation.ClearParentTagID()
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *TagUpdate) SetRoles(v []string) *TagUpdate {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *TagUpdate) AppendRoles(v []string) *TagUpdate {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (_u *TagUpdate) ClearRoles() *TagUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (_u *TagUpdate) AddPostIDs(ids ...xid.ID) *TagUpdate {
 	_u.mutation.AddPostIDs(ids...)
@@ -77,6 +119,85 @@ func (_u *TagUpdate) AddAccounts(v ...*Account) *TagUpdate {
 	return _u.AddAccountIDs(ids...)
 }
 
+// AddFollowerIDs adds the "followers" edge to the TagFollow entity by IDs.
+func (_u *TagUpdate) AddFollowerIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.AddFollowerIDs(ids...)
+	return _u
+}
+
+// AddFollowers adds the "followers" edges to the TagFollow entity.
+func (_u *TagUpdate) AddFollowers(v ...*TagFollow) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowerIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Tag entity by ID.
+func (_u *TagUpdate) SetParentID(id xid.ID) *TagUpdate {
+	_u.mutation.SetParentID(id)
+	return _u
+}
+
+// SetNillableParentID sets the "parent" edge to the Tag entity by ID if the given value is not nil.
+func (_u *TagUpdate) SetNillableParentID(id *xid.ID) *TagUpdate {
+	if id != nil {
+		_u = _u.SetParentID(*id)
+	}
+	return _u
+}
+
+// SetParent sets the "parent" edge to the Tag entity.
+func (_u *TagUpdate) SetParent(v *Tag) *TagUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Tag entity by IDs.
+func (_u *TagUpdate) AddChildIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Tag entity.
+func (_u *TagUpdate) AddChildren(v ...*Tag) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddSynonymIDs adds the "synonyms" edge to the TagSynonym entity by IDs.
+func (_u *TagUpdate) AddSynonymIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.AddSynonymIDs(ids...)
+	return _u
+}
+
+// AddSynonyms adds the "synonyms" edges to the TagSynonym entity.
+func (_u *TagUpdate) AddSynonyms(v ...*TagSynonym) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSynonymIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the Category entity by IDs.
+func (_u *TagUpdate) AddRequiredByIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.AddRequiredByIDs(ids...)
+	return _u
+}
+
+// AddRequiredBy adds the "required_by" edges to the Category entity.
+func (_u *TagUpdate) AddRequiredBy(v ...*Category) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRequiredByIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (_u *TagUpdate) Mutation() *TagMutation {
 	return _u.mutation
@@ -145,6 +266,96 @@ func (_u *TagUpdate) RemoveAccounts(v ...*Account) *TagUpdate {
 	return _u.RemoveAccountIDs(ids...)
 }
 
+// ClearFollowers clears all "followers" edges to the TagFollow entity.
+func (_u *TagUpdate) ClearFollowers() *TagUpdate {
+	_u.mutation.ClearFollowers()
+	return _u
+}
+
+// RemoveFollowerIDs removes the "followers" edge to TagFollow entities by IDs.
+func (_u *TagUpdate) RemoveFollowerIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.RemoveFollowerIDs(ids...)
+	return _u
+}
+
+// RemoveFollowers removes "followers" edges to TagFollow entities.
+func (_u *TagUpdate) RemoveFollowers(v ...*TagFollow) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowerIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Tag entity.
+func (_u *TagUpdate) ClearParent() *TagUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Tag entity.
+func (_u *TagUpdate) ClearChildren() *TagUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Tag entities by IDs.
+func (_u *TagUpdate) RemoveChildIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Tag entities.
+func (_u *TagUpdate) RemoveChildren(v ...*Tag) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearSynonyms clears all "synonyms" edges to the TagSynonym entity.
+func (_u *TagUpdate) ClearSynonyms() *TagUpdate {
+	_u.mutation.ClearSynonyms()
+	return _u
+}
+
+// RemoveSynonymIDs removes the "synonyms" edge to TagSynonym entities by IDs.
+func (_u *TagUpdate) RemoveSynonymIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.RemoveSynonymIDs(ids...)
+	return _u
+}
+
+// RemoveSynonyms removes "synonyms" edges to TagSynonym entities.
+func (_u *TagUpdate) RemoveSynonyms(v ...*TagSynonym) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSynonymIDs(ids...)
+}
+
+// ClearRequiredBy clears all "required_by" edges to the Category entity.
+func (_u *TagUpdate) ClearRequiredBy() *TagUpdate {
+	_u.mutation.ClearRequiredBy()
+	return _u
+}
+
+// RemoveRequiredByIDs removes the "required_by" edge to Category entities by IDs.
+func (_u *TagUpdate) RemoveRequiredByIDs(ids ...xid.ID) *TagUpdate {
+	_u.mutation.RemoveRequiredByIDs(ids...)
+	return _u
+}
+
+// RemoveRequiredBy removes "required_by" edges to Category entities.
+func (_u *TagUpdate) RemoveRequiredBy(v ...*Category) *TagUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRequiredByIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TagUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -187,6 +398,17 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(tag.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tag.FieldRoles, value)
+		})
+	}
+	if _u.mutation.RolesCleared() {
+		_spec.ClearField(tag.FieldRoles, field.TypeJSON)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -322,6 +544,215 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.FollowersTable,
+			Columns: []string{tag.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !_u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.FollowersTable,
+			Columns: []string{tag.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.FollowersTable,
+			Columns: []string{tag.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.ParentTable,
+			Columns: []string{tag.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.ParentTable,
+			Columns: []string{tag.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.ChildrenTable,
+			Columns: []string{tag.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.ChildrenTable,
+			Columns: []string{tag.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.ChildrenTable,
+			Columns: []string{tag.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SynonymsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.SynonymsTable,
+			Columns: []string{tag.SynonymsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSynonymsIDs(); len(nodes) > 0 && !_u.mutation.SynonymsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.SynonymsTable,
+			Columns: []string{tag.SynonymsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SynonymsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.SynonymsTable,
+			Columns: []string{tag.SynonymsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.RequiredByTable,
+			Columns: tag.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRequiredByIDs(); len(nodes) > 0 && !_u.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.RequiredByTable,
+			Columns: tag.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.RequiredByTable,
+			Columns: tag.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -335,13 +766,51 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	return _node, nil
 }
 
-// TagUpdateOne is the builder for updating a single Tag entity.
-type TagUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *TagMutation
-	modifiers []func(*sql.UpdateBuilder)
+// TagUpdateOne is the builder for updating a single Tag entity.
+type TagUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetParentTagID sets the "parent_tag_id" field.
+func (_u *TagUpdateOne) SetParentTagID(v xid.ID) *TagUpdateOne {
+	_u.mutation.SetParentTagID(v)
+	return _u
+}
+
+// SetNillableParentTagID sets the "parent_tag_id" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableParentTagID(v *xid.ID) *TagUpdateOne {
+	if v != nil {
+		_u.SetParentTagID(*v)
+	}
+	return _u
+}
+
+// ClearParentTagID clears the value of the "parent_tag_id" field.
+func (_u *TagUpdateOne) ClearParentTagID() *TagUpdateOne {
+	_u.mutation.ClearParentTagID()
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *TagUpdateOne) SetRoles(v []string) *TagUpdateOne {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *TagUpdateOne) AppendRoles(v []string) *TagUpdateOne {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (_u *TagUpdateOne) ClearRoles() *TagUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
 }
 
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
@@ -389,6 +858,85 @@ func (_u *TagUpdateOne) AddAccounts(v ...*Account) *TagUpdateOne {
 	return _u.AddAccountIDs(ids...)
 }
 
+// AddFollowerIDs adds the "followers" edge to the TagFollow entity by IDs.
+func (_u *TagUpdateOne) AddFollowerIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.AddFollowerIDs(ids...)
+	return _u
+}
+
+// AddFollowers adds the "followers" edges to the TagFollow entity.
+func (_u *TagUpdateOne) AddFollowers(v ...*TagFollow) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowerIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Tag entity by ID.
+func (_u *TagUpdateOne) SetParentID(id xid.ID) *TagUpdateOne {
+	_u.mutation.SetParentID(id)
+	return _u
+}
+
+// SetNillableParentID sets the "parent" edge to the Tag entity by ID if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableParentID(id *xid.ID) *TagUpdateOne {
+	if id != nil {
+		_u = _u.SetParentID(*id)
+	}
+	return _u
+}
+
+// SetParent sets the "parent" edge to the Tag entity.
+func (_u *TagUpdateOne) SetParent(v *Tag) *TagUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Tag entity by IDs.
+func (_u *TagUpdateOne) AddChildIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Tag entity.
+func (_u *TagUpdateOne) AddChildren(v ...*Tag) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddSynonymIDs adds the "synonyms" edge to the TagSynonym entity by IDs.
+func (_u *TagUpdateOne) AddSynonymIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.AddSynonymIDs(ids...)
+	return _u
+}
+
+// AddSynonyms adds the "synonyms" edges to the TagSynonym entity.
+func (_u *TagUpdateOne) AddSynonyms(v ...*TagSynonym) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSynonymIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the Category entity by IDs.
+func (_u *TagUpdateOne) AddRequiredByIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.AddRequiredByIDs(ids...)
+	return _u
+}
+
+// AddRequiredBy adds the "required_by" edges to the Category entity.
+func (_u *TagUpdateOne) AddRequiredBy(v ...*Category) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRequiredByIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (_u *TagUpdateOne) Mutation() *TagMutation {
 	return _u.mutation
@@ -457,6 +1005,96 @@ func (_u *TagUpdateOne) RemoveAccounts(v ...*Account) *TagUpdateOne {
 	return _u.RemoveAccountIDs(ids...)
 }
 
+// ClearFollowers clears all "followers" edges to the TagFollow entity.
+func (_u *TagUpdateOne) ClearFollowers() *TagUpdateOne {
+	_u.mutation.ClearFollowers()
+	return _u
+}
+
+// RemoveFollowerIDs removes the "followers" edge to TagFollow entities by IDs.
+func (_u *TagUpdateOne) RemoveFollowerIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.RemoveFollowerIDs(ids...)
+	return _u
+}
+
+// RemoveFollowers removes "followers" edges to TagFollow entities.
+func (_u *TagUpdateOne) RemoveFollowers(v ...*TagFollow) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowerIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Tag entity.
+func (_u *TagUpdateOne) ClearParent() *TagUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Tag entity.
+func (_u *TagUpdateOne) ClearChildren() *TagUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Tag entities by IDs.
+func (_u *TagUpdateOne) RemoveChildIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Tag entities.
+func (_u *TagUpdateOne) RemoveChildren(v ...*Tag) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearSynonyms clears all "synonyms" edges to the TagSynonym entity.
+func (_u *TagUpdateOne) ClearSynonyms() *TagUpdateOne {
+	_u.mutation.ClearSynonyms()
+	return _u
+}
+
+// RemoveSynonymIDs removes the "synonyms" edge to TagSynonym entities by IDs.
+func (_u *TagUpdateOne) RemoveSynonymIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.RemoveSynonymIDs(ids...)
+	return _u
+}
+
+// RemoveSynonyms removes "synonyms" edges to TagSynonym entities.
+func (_u *TagUpdateOne) RemoveSynonyms(v ...*TagSynonym) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSynonymIDs(ids...)
+}
+
+// ClearRequiredBy clears all "required_by" edges to the Category entity.
+func (_u *TagUpdateOne) ClearRequiredBy() *TagUpdateOne {
+	_u.mutation.ClearRequiredBy()
+	return _u
+}
+
+// RemoveRequiredByIDs removes the "required_by" edge to Category entities by IDs.
+func (_u *TagUpdateOne) RemoveRequiredByIDs(ids ...xid.ID) *TagUpdateOne {
+	_u.mutation.RemoveRequiredByIDs(ids...)
+	return _u
+}
+
+// RemoveRequiredBy removes "required_by" edges to Category entities.
+func (_u *TagUpdateOne) RemoveRequiredBy(v ...*Category) *TagUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRequiredByIDs(ids...)
+}
+
 // Where appends a list predicates to the TagUpdate builder.
 func (_u *TagUpdateOne) Where(ps ...predicate.Tag) *TagUpdateOne {
 	_u.mutation.Where(ps...)
@@ -529,6 +1167,17 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(tag.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tag.FieldRoles, value)
+		})
+	}
+	if _u.mutation.RolesCleared() {
+		_spec.ClearField(tag.FieldRoles, field.TypeJSON)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -664,6 +1313,215 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.FollowersTable,
+			Columns: []string{tag.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !_u.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.FollowersTable,
+			Columns: []string{tag.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.FollowersTable,
+			Columns: []string{tag.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.ParentTable,
+			Columns: []string{tag.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.ParentTable,
+			Columns: []string{tag.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.ChildrenTable,
+			Columns: []string{tag.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.ChildrenTable,
+			Columns: []string{tag.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.ChildrenTable,
+			Columns: []string{tag.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SynonymsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.SynonymsTable,
+			Columns: []string{tag.SynonymsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSynonymsIDs(); len(nodes) > 0 && !_u.mutation.SynonymsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.SynonymsTable,
+			Columns: []string{tag.SynonymsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SynonymsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.SynonymsTable,
+			Columns: []string{tag.SynonymsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.RequiredByTable,
+			Columns: tag.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRequiredByIDs(); len(nodes) > 0 && !_u.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.RequiredByTable,
+			Columns: tag.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.RequiredByTable,
+			Columns: tag.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Tag{config: _u.config}
 	_spec.Assign = _node.assignValues
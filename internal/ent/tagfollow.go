@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/rs/xid"
+)
+
+// TagFollow is the model entity for the TagFollow schema.
+type TagFollow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID xid.ID `json:"tag_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TagFollowQuery when eager-loading is set.
+	Edges        TagFollowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TagFollowEdges holds the relations/edges for other nodes in the graph.
+type TagFollowEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagFollowEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagFollowEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TagFollow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tagfollow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case tagfollow.FieldID, tagfollow.FieldAccountID, tagfollow.FieldTagID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TagFollow fields.
+func (_m *TagFollow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tagfollow.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case tagfollow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case tagfollow.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case tagfollow.FieldTagID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value != nil {
+				_m.TagID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TagFollow.
+// This includes values selected through modifiers, order, etc.
+func (_m *TagFollow) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the TagFollow entity.
+func (_m *TagFollow) QueryAccount() *AccountQuery {
+	return NewTagFollowClient(_m.config).QueryAccount(_m)
+}
+
+// QueryTag queries the "tag" edge of the TagFollow entity.
+func (_m *TagFollow) QueryTag() *TagQuery {
+	return NewTagFollowClient(_m.config).QueryTag(_m)
+}
+
+// Update returns a builder for updating this TagFollow.
+// Note that you need to call TagFollow.Unwrap() before calling this method if this TagFollow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TagFollow) Update() *TagFollowUpdateOne {
+	return NewTagFollowClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TagFollow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TagFollow) Unwrap() *TagFollow {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TagFollow is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TagFollow) String() string {
+	var builder strings.Builder
+	builder.WriteString("TagFollow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TagFollows is a parsable slice of TagFollow.
+type TagFollows []*TagFollow
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagfollow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldAccountID, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldTagID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// TagIDGT applies the GT predicate on the "tag_id" field.
+func TagIDGT(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGT(FieldTagID, v))
+}
+
+// TagIDGTE applies the GTE predicate on the "tag_id" field.
+func TagIDGTE(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldGTE(FieldTagID, v))
+}
+
+// TagIDLT applies the LT predicate on the "tag_id" field.
+func TagIDLT(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLT(FieldTagID, v))
+}
+
+// TagIDLTE applies the LTE predicate on the "tag_id" field.
+func TagIDLTE(v xid.ID) predicate.TagFollow {
+	return predicate.TagFollow(sql.FieldLTE(FieldTagID, v))
+}
+
+// TagIDContains applies the Contains predicate on the "tag_id" field.
+func TagIDContains(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldContains(FieldTagID, vc))
+}
+
+// TagIDHasPrefix applies the HasPrefix predicate on the "tag_id" field.
+func TagIDHasPrefix(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldHasPrefix(FieldTagID, vc))
+}
+
+// TagIDHasSuffix applies the HasSuffix predicate on the "tag_id" field.
+func TagIDHasSuffix(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldHasSuffix(FieldTagID, vc))
+}
+
+// TagIDEqualFold applies the EqualFold predicate on the "tag_id" field.
+func TagIDEqualFold(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldEqualFold(FieldTagID, vc))
+}
+
+// TagIDContainsFold applies the ContainsFold predicate on the "tag_id" field.
+func TagIDContainsFold(v xid.ID) predicate.TagFollow {
+	vc := v.String()
+	return predicate.TagFollow(sql.FieldContainsFold(FieldTagID, vc))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.TagFollow {
+	return predicate.TagFollow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.TagFollow {
+	return predicate.TagFollow(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.TagFollow {
+	return predicate.TagFollow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.TagFollow {
+	return predicate.TagFollow(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TagFollow) predicate.TagFollow {
+	return predicate.TagFollow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TagFollow) predicate.TagFollow {
+	return predicate.TagFollow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TagFollow) predicate.TagFollow {
+	return predicate.TagFollow(sql.NotPredicates(p))
+}
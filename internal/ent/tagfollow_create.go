@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/rs/xid"
+)
+
+// TagFollowCreate is the builder for creating a TagFollow entity.
+type TagFollowCreate struct {
+	config
+	mutation *TagFollowMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TagFollowCreate) SetCreatedAt(v time.Time) *TagFollowCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TagFollowCreate) SetNillableCreatedAt(v *time.Time) *TagFollowCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *TagFollowCreate) SetAccountID(v xid.ID) *TagFollowCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *TagFollowCreate) SetTagID(v xid.ID) *TagFollowCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TagFollowCreate) SetID(v xid.ID) *TagFollowCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TagFollowCreate) SetNillableID(v *xid.ID) *TagFollowCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *TagFollowCreate) SetAccount(v *Account) *TagFollowCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_c *TagFollowCreate) SetTag(v *Tag) *TagFollowCreate {
+	return _c.SetTagID(v.ID)
+}
+
+// Mutation returns the TagFollowMutation object of the builder.
+func (_c *TagFollowCreate) Mutation() *TagFollowMutation {
+	return _c.mutation
+}
+
+// Save creates the TagFollow in the database.
+func (_c *TagFollowCreate) Save(ctx context.Context) (*TagFollow, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TagFollowCreate) SaveX(ctx context.Context) *TagFollow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagFollowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagFollowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TagFollowCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tagfollow.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := tagfollow.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TagFollowCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TagFollow.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "TagFollow.account_id"`)}
+	}
+	if _, ok := _c.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TagFollow.tag_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tagfollow.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TagFollow.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "TagFollow.account"`)}
+	}
+	if len(_c.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagFollow.tag"`)}
+	}
+	return nil
+}
+
+func (_c *TagFollowCreate) sqlSave(ctx context.Context) (*TagFollow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TagFollowCreate) createSpec() (*TagFollow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagFollow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tagfollow.Table, sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tagfollow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.AccountTable,
+			Columns: []string{tagfollow.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.TagTable,
+			Columns: []string{tagfollow.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagFollow.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagFollowUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TagFollowCreate) OnConflict(opts ...sql.ConflictOption) *TagFollowUpsertOne {
+	_c.conflict = opts
+	return &TagFollowUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagFollow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TagFollowCreate) OnConflictColumns(columns ...string) *TagFollowUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TagFollowUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TagFollowUpsertOne is the builder for "upsert"-ing
+	//  one TagFollow node.
+	TagFollowUpsertOne struct {
+		create *TagFollowCreate
+	}
+
+	// TagFollowUpsert is the "OnConflict" setter.
+	TagFollowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *TagFollowUpsert) SetAccountID(v xid.ID) *TagFollowUpsert {
+	u.Set(tagfollow.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *TagFollowUpsert) UpdateAccountID() *TagFollowUpsert {
+	u.SetExcluded(tagfollow.FieldAccountID)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagFollowUpsert) SetTagID(v xid.ID) *TagFollowUpsert {
+	u.Set(tagfollow.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagFollowUpsert) UpdateTagID() *TagFollowUpsert {
+	u.SetExcluded(tagfollow.FieldTagID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TagFollow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagfollow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagFollowUpsertOne) UpdateNewValues() *TagFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tagfollow.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tagfollow.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagFollow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TagFollowUpsertOne) Ignore() *TagFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagFollowUpsertOne) DoNothing() *TagFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagFollowCreate.OnConflict
+// documentation for more info.
+func (u *TagFollowUpsertOne) Update(set func(*TagFollowUpsert)) *TagFollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagFollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *TagFollowUpsertOne) SetAccountID(v xid.ID) *TagFollowUpsertOne {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *TagFollowUpsertOne) UpdateAccountID() *TagFollowUpsertOne {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagFollowUpsertOne) SetTagID(v xid.ID) *TagFollowUpsertOne {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagFollowUpsertOne) UpdateTagID() *TagFollowUpsertOne {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *TagFollowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagFollowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagFollowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TagFollowUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TagFollowUpsertOne.ID is not supported by MySQL driver. Use TagFollowUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TagFollowUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TagFollowCreateBulk is the builder for creating many TagFollow entities in bulk.
+type TagFollowCreateBulk struct {
+	config
+	err      error
+	builders []*TagFollowCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TagFollow entities in the database.
+func (_c *TagFollowCreateBulk) Save(ctx context.Context) ([]*TagFollow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TagFollow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagFollowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TagFollowCreateBulk) SaveX(ctx context.Context) []*TagFollow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagFollowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagFollowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagFollow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagFollowUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TagFollowCreateBulk) OnConflict(opts ...sql.ConflictOption) *TagFollowUpsertBulk {
+	_c.conflict = opts
+	return &TagFollowUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagFollow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TagFollowCreateBulk) OnConflictColumns(columns ...string) *TagFollowUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TagFollowUpsertBulk{
+		create: _c,
+	}
+}
+
+// TagFollowUpsertBulk is the builder for "upsert"-ing
+// a bulk of TagFollow nodes.
+type TagFollowUpsertBulk struct {
+	create *TagFollowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TagFollow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagfollow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagFollowUpsertBulk) UpdateNewValues() *TagFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tagfollow.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tagfollow.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagFollow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TagFollowUpsertBulk) Ignore() *TagFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagFollowUpsertBulk) DoNothing() *TagFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagFollowCreateBulk.OnConflict
+// documentation for more info.
+func (u *TagFollowUpsertBulk) Update(set func(*TagFollowUpsert)) *TagFollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagFollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *TagFollowUpsertBulk) SetAccountID(v xid.ID) *TagFollowUpsertBulk {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *TagFollowUpsertBulk) UpdateAccountID() *TagFollowUpsertBulk {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagFollowUpsertBulk) SetTagID(v xid.ID) *TagFollowUpsertBulk {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagFollowUpsertBulk) UpdateTagID() *TagFollowUpsertBulk {
+	return u.Update(func(s *TagFollowUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *TagFollowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TagFollowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagFollowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagFollowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagfollow"
+	"github.com/rs/xid"
+)
+
+// TagFollowUpdate is the builder for updating TagFollow entities.
+type TagFollowUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TagFollowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TagFollowUpdate builder.
+func (_u *TagFollowUpdate) Where(ps ...predicate.TagFollow) *TagFollowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *TagFollowUpdate) SetAccountID(v xid.ID) *TagFollowUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *TagFollowUpdate) SetNillableAccountID(v *xid.ID) *TagFollowUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *TagFollowUpdate) SetTagID(v xid.ID) *TagFollowUpdate {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *TagFollowUpdate) SetNillableTagID(v *xid.ID) *TagFollowUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *TagFollowUpdate) SetAccount(v *Account) *TagFollowUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagFollowUpdate) SetTag(v *Tag) *TagFollowUpdate {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagFollowMutation object of the builder.
+func (_u *TagFollowUpdate) Mutation() *TagFollowMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *TagFollowUpdate) ClearAccount() *TagFollowUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagFollowUpdate) ClearTag() *TagFollowUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TagFollowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagFollowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TagFollowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagFollowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagFollowUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagFollow.account"`)
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagFollow.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagFollowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagFollowUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagFollowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagfollow.Table, tagfollow.Columns, sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.AccountTable,
+			Columns: []string{tagfollow.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.AccountTable,
+			Columns: []string{tagfollow.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.TagTable,
+			Columns: []string{tagfollow.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.TagTable,
+			Columns: []string{tagfollow.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagfollow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TagFollowUpdateOne is the builder for updating a single TagFollow entity.
+type TagFollowUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TagFollowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *TagFollowUpdateOne) SetAccountID(v xid.ID) *TagFollowUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *TagFollowUpdateOne) SetNillableAccountID(v *xid.ID) *TagFollowUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *TagFollowUpdateOne) SetTagID(v xid.ID) *TagFollowUpdateOne {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *TagFollowUpdateOne) SetNillableTagID(v *xid.ID) *TagFollowUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *TagFollowUpdateOne) SetAccount(v *Account) *TagFollowUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagFollowUpdateOne) SetTag(v *Tag) *TagFollowUpdateOne {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagFollowMutation object of the builder.
+func (_u *TagFollowUpdateOne) Mutation() *TagFollowMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *TagFollowUpdateOne) ClearAccount() *TagFollowUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagFollowUpdateOne) ClearTag() *TagFollowUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Where appends a list predicates to the TagFollowUpdate builder.
+func (_u *TagFollowUpdateOne) Where(ps ...predicate.TagFollow) *TagFollowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TagFollowUpdateOne) Select(field string, fields ...string) *TagFollowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TagFollow entity.
+func (_u *TagFollowUpdateOne) Save(ctx context.Context) (*TagFollow, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagFollowUpdateOne) SaveX(ctx context.Context) *TagFollow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TagFollowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagFollowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagFollowUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagFollow.account"`)
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagFollow.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagFollowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagFollowUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagFollowUpdateOne) sqlSave(ctx context.Context) (_node *TagFollow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagfollow.Table, tagfollow.Columns, sqlgraph.NewFieldSpec(tagfollow.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagFollow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagfollow.FieldID)
+		for _, f := range fields {
+			if !tagfollow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagfollow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.AccountTable,
+			Columns: []string{tagfollow.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.AccountTable,
+			Columns: []string{tagfollow.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.TagTable,
+			Columns: []string{tagfollow.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagfollow.TagTable,
+			Columns: []string{tagfollow.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TagFollow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagfollow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagsynonym
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldName, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldTagID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContainsFold(FieldName, v))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// TagIDGT applies the GT predicate on the "tag_id" field.
+func TagIDGT(v xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldTagID, v))
+}
+
+// TagIDGTE applies the GTE predicate on the "tag_id" field.
+func TagIDGTE(v xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldTagID, v))
+}
+
+// TagIDLT applies the LT predicate on the "tag_id" field.
+func TagIDLT(v xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldTagID, v))
+}
+
+// TagIDLTE applies the LTE predicate on the "tag_id" field.
+func TagIDLTE(v xid.ID) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldTagID, v))
+}
+
+// TagIDContains applies the Contains predicate on the "tag_id" field.
+func TagIDContains(v xid.ID) predicate.TagSynonym {
+	vc := v.String()
+	return predicate.TagSynonym(sql.FieldContains(FieldTagID, vc))
+}
+
+// TagIDHasPrefix applies the HasPrefix predicate on the "tag_id" field.
+func TagIDHasPrefix(v xid.ID) predicate.TagSynonym {
+	vc := v.String()
+	return predicate.TagSynonym(sql.FieldHasPrefix(FieldTagID, vc))
+}
+
+// TagIDHasSuffix applies the HasSuffix predicate on the "tag_id" field.
+func TagIDHasSuffix(v xid.ID) predicate.TagSynonym {
+	vc := v.String()
+	return predicate.TagSynonym(sql.FieldHasSuffix(FieldTagID, vc))
+}
+
+// TagIDEqualFold applies the EqualFold predicate on the "tag_id" field.
+func TagIDEqualFold(v xid.ID) predicate.TagSynonym {
+	vc := v.String()
+	return predicate.TagSynonym(sql.FieldEqualFold(FieldTagID, vc))
+}
+
+// TagIDContainsFold applies the ContainsFold predicate on the "tag_id" field.
+func TagIDContainsFold(v xid.ID) predicate.TagSynonym {
+	vc := v.String()
+	return predicate.TagSynonym(sql.FieldContainsFold(FieldTagID, vc))
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.TagSynonym {
+	return predicate.TagSynonym(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.TagSynonym {
+	return predicate.TagSynonym(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TagSynonym) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TagSynonym) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TagSynonym) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.NotPredicates(p))
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
+	"github.com/rs/xid"
+)
+
+// TagSynonymCreate is the builder for creating a TagSynonym entity.
+type TagSynonymCreate struct {
+	config
+	mutation *TagSynonymMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TagSynonymCreate) SetCreatedAt(v time.Time) *TagSynonymCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TagSynonymCreate) SetNillableCreatedAt(v *time.Time) *TagSynonymCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TagSynonymCreate) SetName(v string) *TagSynonymCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *TagSynonymCreate) SetTagID(v xid.ID) *TagSynonymCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TagSynonymCreate) SetID(v xid.ID) *TagSynonymCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TagSynonymCreate) SetNillableID(v *xid.ID) *TagSynonymCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_c *TagSynonymCreate) SetTag(v *Tag) *TagSynonymCreate {
+	return _c.SetTagID(v.ID)
+}
+
+// Mutation returns the TagSynonymMutation object of the builder.
+func (_c *TagSynonymCreate) Mutation() *TagSynonymMutation {
+	return _c.mutation
+}
+
+// Save creates the TagSynonym in the database.
+func (_c *TagSynonymCreate) Save(ctx context.Context) (*TagSynonym, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TagSynonymCreate) SaveX(ctx context.Context) *TagSynonym {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagSynonymCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagSynonymCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TagSynonymCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tagsynonym.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := tagsynonym.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TagSynonymCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TagSynonym.created_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TagSynonym.name"`)}
+	}
+	if _, ok := _c.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TagSynonym.tag_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tagsynonym.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TagSynonym.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagSynonym.tag"`)}
+	}
+	return nil
+}
+
+func (_c *TagSynonymCreate) sqlSave(ctx context.Context) (*TagSynonym, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TagSynonymCreate) createSpec() (*TagSynonym, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagSynonym{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tagsynonym.Table, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tagsynonym.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tagsynonym.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if nodes := _c.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagSynonym.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagSynonymUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TagSynonymCreate) OnConflict(opts ...sql.ConflictOption) *TagSynonymUpsertOne {
+	_c.conflict = opts
+	return &TagSynonymUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TagSynonymCreate) OnConflictColumns(columns ...string) *TagSynonymUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TagSynonymUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TagSynonymUpsertOne is the builder for "upsert"-ing
+	//  one TagSynonym node.
+	TagSynonymUpsertOne struct {
+		create *TagSynonymCreate
+	}
+
+	// TagSynonymUpsert is the "OnConflict" setter.
+	TagSynonymUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TagSynonymUpsert) SetName(v string) *TagSynonymUpsert {
+	u.Set(tagsynonym.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSynonymUpsert) UpdateName() *TagSynonymUpsert {
+	u.SetExcluded(tagsynonym.FieldName)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagSynonymUpsert) SetTagID(v xid.ID) *TagSynonymUpsert {
+	u.Set(tagsynonym.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagSynonymUpsert) UpdateTagID() *TagSynonymUpsert {
+	u.SetExcluded(tagsynonym.FieldTagID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagsynonym.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagSynonymUpsertOne) UpdateNewValues() *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tagsynonym.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tagsynonym.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TagSynonymUpsertOne) Ignore() *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagSynonymUpsertOne) DoNothing() *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagSynonymCreate.OnConflict
+// documentation for more info.
+func (u *TagSynonymUpsertOne) Update(set func(*TagSynonymUpsert)) *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagSynonymUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagSynonymUpsertOne) SetName(v string) *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSynonymUpsertOne) UpdateName() *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagSynonymUpsertOne) SetTagID(v xid.ID) *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagSynonymUpsertOne) UpdateTagID() *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *TagSynonymUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagSynonymCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagSynonymUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TagSynonymUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TagSynonymUpsertOne.ID is not supported by MySQL driver. Use TagSynonymUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TagSynonymUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TagSynonymCreateBulk is the builder for creating many TagSynonym entities in bulk.
+type TagSynonymCreateBulk struct {
+	config
+	err      error
+	builders []*TagSynonymCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TagSynonym entities in the database.
+func (_c *TagSynonymCreateBulk) Save(ctx context.Context) ([]*TagSynonym, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TagSynonym, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagSynonymMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TagSynonymCreateBulk) SaveX(ctx context.Context) []*TagSynonym {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagSynonymCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagSynonymCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagSynonym.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagSynonymUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TagSynonymCreateBulk) OnConflict(opts ...sql.ConflictOption) *TagSynonymUpsertBulk {
+	_c.conflict = opts
+	return &TagSynonymUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TagSynonymCreateBulk) OnConflictColumns(columns ...string) *TagSynonymUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TagSynonymUpsertBulk{
+		create: _c,
+	}
+}
+
+// TagSynonymUpsertBulk is the builder for "upsert"-ing
+// a bulk of TagSynonym nodes.
+type TagSynonymUpsertBulk struct {
+	create *TagSynonymCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagsynonym.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagSynonymUpsertBulk) UpdateNewValues() *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tagsynonym.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tagsynonym.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TagSynonymUpsertBulk) Ignore() *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagSynonymUpsertBulk) DoNothing() *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagSynonymCreateBulk.OnConflict
+// documentation for more info.
+func (u *TagSynonymUpsertBulk) Update(set func(*TagSynonymUpsert)) *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagSynonymUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagSynonymUpsertBulk) SetName(v string) *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSynonymUpsertBulk) UpdateName() *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagSynonymUpsertBulk) SetTagID(v xid.ID) *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagSynonymUpsertBulk) UpdateTagID() *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *TagSynonymUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TagSynonymCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagSynonymCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagSynonymUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/tagsynonym"
+	"github.com/rs/xid"
+)
+
+// TagSynonymUpdate is the builder for updating TagSynonym entities.
+type TagSynonymUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TagSynonymMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TagSynonymUpdate builder.
+func (_u *TagSynonymUpdate) Where(ps ...predicate.TagSynonym) *TagSynonymUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TagSynonymUpdate) SetName(v string) *TagSynonymUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagSynonymUpdate) SetNillableName(v *string) *TagSynonymUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *TagSynonymUpdate) SetTagID(v xid.ID) *TagSynonymUpdate {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *TagSynonymUpdate) SetNillableTagID(v *xid.ID) *TagSynonymUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagSynonymUpdate) SetTag(v *Tag) *TagSynonymUpdate {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagSynonymMutation object of the builder.
+func (_u *TagSynonymUpdate) Mutation() *TagSynonymMutation {
+	return _u.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagSynonymUpdate) ClearTag() *TagSynonymUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TagSynonymUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagSynonymUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TagSynonymUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagSynonymUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagSynonymUpdate) check() error {
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSynonym.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagSynonymUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagSynonymUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagSynonymUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagsynonym.Table, tagsynonym.Columns, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tagsynonym.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagsynonym.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TagSynonymUpdateOne is the builder for updating a single TagSynonym entity.
+type TagSynonymUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TagSynonymMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *TagSynonymUpdateOne) SetName(v string) *TagSynonymUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagSynonymUpdateOne) SetNillableName(v *string) *TagSynonymUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *TagSynonymUpdateOne) SetTagID(v xid.ID) *TagSynonymUpdateOne {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *TagSynonymUpdateOne) SetNillableTagID(v *xid.ID) *TagSynonymUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagSynonymUpdateOne) SetTag(v *Tag) *TagSynonymUpdateOne {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagSynonymMutation object of the builder.
+func (_u *TagSynonymUpdateOne) Mutation() *TagSynonymMutation {
+	return _u.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagSynonymUpdateOne) ClearTag() *TagSynonymUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Where appends a list predicates to the TagSynonymUpdate builder.
+func (_u *TagSynonymUpdateOne) Where(ps ...predicate.TagSynonym) *TagSynonymUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TagSynonymUpdateOne) Select(field string, fields ...string) *TagSynonymUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TagSynonym entity.
+func (_u *TagSynonymUpdateOne) Save(ctx context.Context) (*TagSynonym, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagSynonymUpdateOne) SaveX(ctx context.Context) *TagSynonym {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TagSynonymUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagSynonymUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagSynonymUpdateOne) check() error {
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSynonym.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagSynonymUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagSynonymUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagSynonymUpdateOne) sqlSave(ctx context.Context) (_node *TagSynonym, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagsynonym.Table, tagsynonym.Columns, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagSynonym.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagsynonym.FieldID)
+		for _, f := range fields {
+			if !tagsynonym.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagsynonym.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tagsynonym.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TagSynonym{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagsynonym.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
+	"github.com/rs/xid"
+)
+
+// TelegramMessage is the model entity for the TelegramMessage schema.
+type TelegramMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID int64 `json:"chat_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID int64 `json:"message_id,omitempty"`
+	// PostID holds the value of the "post_id" field.
+	PostID xid.ID `json:"post_id,omitempty"`
+	// ThreadID holds the value of the "thread_id" field.
+	ThreadID xid.ID `json:"thread_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TelegramMessageQuery when eager-loading is set.
+	Edges        TelegramMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TelegramMessageEdges holds the relations/edges for other nodes in the graph.
+type TelegramMessageEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TelegramMessageEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TelegramMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case telegrammessage.FieldChatID, telegrammessage.FieldMessageID:
+			values[i] = new(sql.NullInt64)
+		case telegrammessage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case telegrammessage.FieldID, telegrammessage.FieldPostID, telegrammessage.FieldThreadID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TelegramMessage fields.
+func (_m *TelegramMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case telegrammessage.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case telegrammessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case telegrammessage.FieldChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.Int64
+			}
+		case telegrammessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.Int64
+			}
+		case telegrammessage.FieldPostID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value != nil {
+				_m.PostID = *value
+			}
+		case telegrammessage.FieldThreadID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_id", values[i])
+			} else if value != nil {
+				_m.ThreadID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TelegramMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *TelegramMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the TelegramMessage entity.
+func (_m *TelegramMessage) QueryPost() *PostQuery {
+	return NewTelegramMessageClient(_m.config).QueryPost(_m)
+}
+
+// Update returns a builder for updating this TelegramMessage.
+// Note that you need to call TelegramMessage.Unwrap() before calling this method if this TelegramMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TelegramMessage) Update() *TelegramMessageUpdateOne {
+	return NewTelegramMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TelegramMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TelegramMessage) Unwrap() *TelegramMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TelegramMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TelegramMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("TelegramMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("post_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PostID))
+	builder.WriteString(", ")
+	builder.WriteString("thread_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ThreadID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TelegramMessages is a parsable slice of TelegramMessage.
+type TelegramMessages []*TelegramMessage
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package telegrammessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldPostID, v))
+}
+
+// ThreadID applies equality check predicate on the "thread_id" field. It's identical to ThreadIDEQ.
+func ThreadID(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldThreadID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLTE(FieldChatID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v int64) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLTE(FieldMessageID, v))
+}
+
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// PostIDGT applies the GT predicate on the "post_id" field.
+func PostIDGT(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGT(FieldPostID, v))
+}
+
+// PostIDGTE applies the GTE predicate on the "post_id" field.
+func PostIDGTE(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGTE(FieldPostID, v))
+}
+
+// PostIDLT applies the LT predicate on the "post_id" field.
+func PostIDLT(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLT(FieldPostID, v))
+}
+
+// PostIDLTE applies the LTE predicate on the "post_id" field.
+func PostIDLTE(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLTE(FieldPostID, v))
+}
+
+// PostIDContains applies the Contains predicate on the "post_id" field.
+func PostIDContains(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldContains(FieldPostID, vc))
+}
+
+// PostIDHasPrefix applies the HasPrefix predicate on the "post_id" field.
+func PostIDHasPrefix(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldHasPrefix(FieldPostID, vc))
+}
+
+// PostIDHasSuffix applies the HasSuffix predicate on the "post_id" field.
+func PostIDHasSuffix(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldHasSuffix(FieldPostID, vc))
+}
+
+// PostIDEqualFold applies the EqualFold predicate on the "post_id" field.
+func PostIDEqualFold(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldEqualFold(FieldPostID, vc))
+}
+
+// PostIDContainsFold applies the ContainsFold predicate on the "post_id" field.
+func PostIDContainsFold(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldContainsFold(FieldPostID, vc))
+}
+
+// ThreadIDEQ applies the EQ predicate on the "thread_id" field.
+func ThreadIDEQ(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldEQ(FieldThreadID, v))
+}
+
+// ThreadIDNEQ applies the NEQ predicate on the "thread_id" field.
+func ThreadIDNEQ(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNEQ(FieldThreadID, v))
+}
+
+// ThreadIDIn applies the In predicate on the "thread_id" field.
+func ThreadIDIn(vs ...xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldIn(FieldThreadID, vs...))
+}
+
+// ThreadIDNotIn applies the NotIn predicate on the "thread_id" field.
+func ThreadIDNotIn(vs ...xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldNotIn(FieldThreadID, vs...))
+}
+
+// ThreadIDGT applies the GT predicate on the "thread_id" field.
+func ThreadIDGT(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGT(FieldThreadID, v))
+}
+
+// ThreadIDGTE applies the GTE predicate on the "thread_id" field.
+func ThreadIDGTE(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldGTE(FieldThreadID, v))
+}
+
+// ThreadIDLT applies the LT predicate on the "thread_id" field.
+func ThreadIDLT(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLT(FieldThreadID, v))
+}
+
+// ThreadIDLTE applies the LTE predicate on the "thread_id" field.
+func ThreadIDLTE(v xid.ID) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.FieldLTE(FieldThreadID, v))
+}
+
+// ThreadIDContains applies the Contains predicate on the "thread_id" field.
+func ThreadIDContains(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldContains(FieldThreadID, vc))
+}
+
+// ThreadIDHasPrefix applies the HasPrefix predicate on the "thread_id" field.
+func ThreadIDHasPrefix(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldHasPrefix(FieldThreadID, vc))
+}
+
+// ThreadIDHasSuffix applies the HasSuffix predicate on the "thread_id" field.
+func ThreadIDHasSuffix(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldHasSuffix(FieldThreadID, vc))
+}
+
+// ThreadIDEqualFold applies the EqualFold predicate on the "thread_id" field.
+func ThreadIDEqualFold(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldEqualFold(FieldThreadID, vc))
+}
+
+// ThreadIDContainsFold applies the ContainsFold predicate on the "thread_id" field.
+func ThreadIDContainsFold(v xid.ID) predicate.TelegramMessage {
+	vc := v.String()
+	return predicate.TelegramMessage(sql.FieldContainsFold(FieldThreadID, vc))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.TelegramMessage {
+	return predicate.TelegramMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.TelegramMessage {
+	return predicate.TelegramMessage(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TelegramMessage) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TelegramMessage) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TelegramMessage) predicate.TelegramMessage {
+	return predicate.TelegramMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
+	"github.com/rs/xid"
+)
+
+// TelegramMessageCreate is the builder for creating a TelegramMessage entity.
+type TelegramMessageCreate struct {
+	config
+	mutation *TelegramMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TelegramMessageCreate) SetCreatedAt(v time.Time) *TelegramMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TelegramMessageCreate) SetNillableCreatedAt(v *time.Time) *TelegramMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *TelegramMessageCreate) SetChatID(v int64) *TelegramMessageCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *TelegramMessageCreate) SetMessageID(v int64) *TelegramMessageCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *TelegramMessageCreate) SetPostID(v xid.ID) *TelegramMessageCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_c *TelegramMessageCreate) SetThreadID(v xid.ID) *TelegramMessageCreate {
+	_c.mutation.SetThreadID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TelegramMessageCreate) SetID(v xid.ID) *TelegramMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TelegramMessageCreate) SetNillableID(v *xid.ID) *TelegramMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *TelegramMessageCreate) SetPost(v *Post) *TelegramMessageCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// Mutation returns the TelegramMessageMutation object of the builder.
+func (_c *TelegramMessageCreate) Mutation() *TelegramMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the TelegramMessage in the database.
+func (_c *TelegramMessageCreate) Save(ctx context.Context) (*TelegramMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TelegramMessageCreate) SaveX(ctx context.Context) *TelegramMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TelegramMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TelegramMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TelegramMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := telegrammessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := telegrammessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TelegramMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TelegramMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "TelegramMessage.chat_id"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "TelegramMessage.message_id"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "TelegramMessage.post_id"`)}
+	}
+	if _, ok := _c.mutation.ThreadID(); !ok {
+		return &ValidationError{Name: "thread_id", err: errors.New(`ent: missing required field "TelegramMessage.thread_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := telegrammessage.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TelegramMessage.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "TelegramMessage.post"`)}
+	}
+	return nil
+}
+
+func (_c *TelegramMessageCreate) sqlSave(ctx context.Context) (*TelegramMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TelegramMessageCreate) createSpec() (*TelegramMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TelegramMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(telegrammessage.Table, sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(telegrammessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(telegrammessage.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(telegrammessage.FieldMessageID, field.TypeInt64, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.ThreadID(); ok {
+		_spec.SetField(telegrammessage.FieldThreadID, field.TypeString, value)
+		_node.ThreadID = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegrammessage.PostTable,
+			Columns: []string{telegrammessage.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TelegramMessage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TelegramMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TelegramMessageCreate) OnConflict(opts ...sql.ConflictOption) *TelegramMessageUpsertOne {
+	_c.conflict = opts
+	return &TelegramMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TelegramMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TelegramMessageCreate) OnConflictColumns(columns ...string) *TelegramMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TelegramMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TelegramMessageUpsertOne is the builder for "upsert"-ing
+	//  one TelegramMessage node.
+	TelegramMessageUpsertOne struct {
+		create *TelegramMessageCreate
+	}
+
+	// TelegramMessageUpsert is the "OnConflict" setter.
+	TelegramMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetChatID sets the "chat_id" field.
+func (u *TelegramMessageUpsert) SetChatID(v int64) *TelegramMessageUpsert {
+	u.Set(telegrammessage.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsert) UpdateChatID() *TelegramMessageUpsert {
+	u.SetExcluded(telegrammessage.FieldChatID)
+	return u
+}
+
+// AddChatID adds v to the "chat_id" field.
+func (u *TelegramMessageUpsert) AddChatID(v int64) *TelegramMessageUpsert {
+	u.Add(telegrammessage.FieldChatID, v)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *TelegramMessageUpsert) SetMessageID(v int64) *TelegramMessageUpsert {
+	u.Set(telegrammessage.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsert) UpdateMessageID() *TelegramMessageUpsert {
+	u.SetExcluded(telegrammessage.FieldMessageID)
+	return u
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *TelegramMessageUpsert) AddMessageID(v int64) *TelegramMessageUpsert {
+	u.Add(telegrammessage.FieldMessageID, v)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *TelegramMessageUpsert) SetPostID(v xid.ID) *TelegramMessageUpsert {
+	u.Set(telegrammessage.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsert) UpdatePostID() *TelegramMessageUpsert {
+	u.SetExcluded(telegrammessage.FieldPostID)
+	return u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *TelegramMessageUpsert) SetThreadID(v xid.ID) *TelegramMessageUpsert {
+	u.Set(telegrammessage.FieldThreadID, v)
+	return u
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsert) UpdateThreadID() *TelegramMessageUpsert {
+	u.SetExcluded(telegrammessage.FieldThreadID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TelegramMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(telegrammessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TelegramMessageUpsertOne) UpdateNewValues() *TelegramMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(telegrammessage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(telegrammessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TelegramMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TelegramMessageUpsertOne) Ignore() *TelegramMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TelegramMessageUpsertOne) DoNothing() *TelegramMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TelegramMessageCreate.OnConflict
+// documentation for more info.
+func (u *TelegramMessageUpsertOne) Update(set func(*TelegramMessageUpsert)) *TelegramMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TelegramMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *TelegramMessageUpsertOne) SetChatID(v int64) *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// AddChatID adds v to the "chat_id" field.
+func (u *TelegramMessageUpsertOne) AddChatID(v int64) *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.AddChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertOne) UpdateChatID() *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *TelegramMessageUpsertOne) SetMessageID(v int64) *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *TelegramMessageUpsertOne) AddMessageID(v int64) *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertOne) UpdateMessageID() *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *TelegramMessageUpsertOne) SetPostID(v xid.ID) *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertOne) UpdatePostID() *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *TelegramMessageUpsertOne) SetThreadID(v xid.ID) *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertOne) UpdateThreadID() *TelegramMessageUpsertOne {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// Exec executes the query.
+func (u *TelegramMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TelegramMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TelegramMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TelegramMessageUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TelegramMessageUpsertOne.ID is not supported by MySQL driver. Use TelegramMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TelegramMessageUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TelegramMessageCreateBulk is the builder for creating many TelegramMessage entities in bulk.
+type TelegramMessageCreateBulk struct {
+	config
+	err      error
+	builders []*TelegramMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TelegramMessage entities in the database.
+func (_c *TelegramMessageCreateBulk) Save(ctx context.Context) ([]*TelegramMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TelegramMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TelegramMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TelegramMessageCreateBulk) SaveX(ctx context.Context) []*TelegramMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TelegramMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TelegramMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TelegramMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TelegramMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TelegramMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *TelegramMessageUpsertBulk {
+	_c.conflict = opts
+	return &TelegramMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TelegramMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TelegramMessageCreateBulk) OnConflictColumns(columns ...string) *TelegramMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TelegramMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// TelegramMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of TelegramMessage nodes.
+type TelegramMessageUpsertBulk struct {
+	create *TelegramMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TelegramMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(telegrammessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TelegramMessageUpsertBulk) UpdateNewValues() *TelegramMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(telegrammessage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(telegrammessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TelegramMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TelegramMessageUpsertBulk) Ignore() *TelegramMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TelegramMessageUpsertBulk) DoNothing() *TelegramMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TelegramMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *TelegramMessageUpsertBulk) Update(set func(*TelegramMessageUpsert)) *TelegramMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TelegramMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *TelegramMessageUpsertBulk) SetChatID(v int64) *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// AddChatID adds v to the "chat_id" field.
+func (u *TelegramMessageUpsertBulk) AddChatID(v int64) *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.AddChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertBulk) UpdateChatID() *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *TelegramMessageUpsertBulk) SetMessageID(v int64) *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *TelegramMessageUpsertBulk) AddMessageID(v int64) *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertBulk) UpdateMessageID() *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *TelegramMessageUpsertBulk) SetPostID(v xid.ID) *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertBulk) UpdatePostID() *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *TelegramMessageUpsertBulk) SetThreadID(v xid.ID) *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *TelegramMessageUpsertBulk) UpdateThreadID() *TelegramMessageUpsertBulk {
+	return u.Update(func(s *TelegramMessageUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// Exec executes the query.
+func (u *TelegramMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TelegramMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TelegramMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TelegramMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/telegrammessage"
+	"github.com/rs/xid"
+)
+
+// TelegramMessageUpdate is the builder for updating TelegramMessage entities.
+type TelegramMessageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TelegramMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TelegramMessageUpdate builder.
+func (_u *TelegramMessageUpdate) Where(ps ...predicate.TelegramMessage) *TelegramMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *TelegramMessageUpdate) SetChatID(v int64) *TelegramMessageUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdate) SetNillableChatID(v *int64) *TelegramMessageUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *TelegramMessageUpdate) AddChatID(v int64) *TelegramMessageUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *TelegramMessageUpdate) SetMessageID(v int64) *TelegramMessageUpdate {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdate) SetNillableMessageID(v *int64) *TelegramMessageUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *TelegramMessageUpdate) AddMessageID(v int64) *TelegramMessageUpdate {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *TelegramMessageUpdate) SetPostID(v xid.ID) *TelegramMessageUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdate) SetNillablePostID(v *xid.ID) *TelegramMessageUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *TelegramMessageUpdate) SetThreadID(v xid.ID) *TelegramMessageUpdate {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdate) SetNillableThreadID(v *xid.ID) *TelegramMessageUpdate {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *TelegramMessageUpdate) SetPost(v *Post) *TelegramMessageUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the TelegramMessageMutation object of the builder.
+func (_u *TelegramMessageUpdate) Mutation() *TelegramMessageMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *TelegramMessageUpdate) ClearPost() *TelegramMessageUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TelegramMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TelegramMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TelegramMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TelegramMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TelegramMessageUpdate) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TelegramMessage.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TelegramMessageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TelegramMessageUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TelegramMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(telegrammessage.Table, telegrammessage.Columns, sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(telegrammessage.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(telegrammessage.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(telegrammessage.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(telegrammessage.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(telegrammessage.FieldThreadID, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegrammessage.PostTable,
+			Columns: []string{telegrammessage.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegrammessage.PostTable,
+			Columns: []string{telegrammessage.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegrammessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TelegramMessageUpdateOne is the builder for updating a single TelegramMessage entity.
+type TelegramMessageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TelegramMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *TelegramMessageUpdateOne) SetChatID(v int64) *TelegramMessageUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdateOne) SetNillableChatID(v *int64) *TelegramMessageUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *TelegramMessageUpdateOne) AddChatID(v int64) *TelegramMessageUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *TelegramMessageUpdateOne) SetMessageID(v int64) *TelegramMessageUpdateOne {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdateOne) SetNillableMessageID(v *int64) *TelegramMessageUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *TelegramMessageUpdateOne) AddMessageID(v int64) *TelegramMessageUpdateOne {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *TelegramMessageUpdateOne) SetPostID(v xid.ID) *TelegramMessageUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdateOne) SetNillablePostID(v *xid.ID) *TelegramMessageUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *TelegramMessageUpdateOne) SetThreadID(v xid.ID) *TelegramMessageUpdateOne {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *TelegramMessageUpdateOne) SetNillableThreadID(v *xid.ID) *TelegramMessageUpdateOne {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *TelegramMessageUpdateOne) SetPost(v *Post) *TelegramMessageUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the TelegramMessageMutation object of the builder.
+func (_u *TelegramMessageUpdateOne) Mutation() *TelegramMessageMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *TelegramMessageUpdateOne) ClearPost() *TelegramMessageUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Where appends a list predicates to the TelegramMessageUpdate builder.
+func (_u *TelegramMessageUpdateOne) Where(ps ...predicate.TelegramMessage) *TelegramMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TelegramMessageUpdateOne) Select(field string, fields ...string) *TelegramMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TelegramMessage entity.
+func (_u *TelegramMessageUpdateOne) Save(ctx context.Context) (*TelegramMessage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TelegramMessageUpdateOne) SaveX(ctx context.Context) *TelegramMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TelegramMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TelegramMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TelegramMessageUpdateOne) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TelegramMessage.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TelegramMessageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TelegramMessageUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TelegramMessageUpdateOne) sqlSave(ctx context.Context) (_node *TelegramMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(telegrammessage.Table, telegrammessage.Columns, sqlgraph.NewFieldSpec(telegrammessage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TelegramMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, telegrammessage.FieldID)
+		for _, f := range fields {
+			if !telegrammessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != telegrammessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(telegrammessage.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(telegrammessage.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(telegrammessage.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(telegrammessage.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(telegrammessage.FieldThreadID, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegrammessage.PostTable,
+			Columns: []string{telegrammessage.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   telegrammessage.PostTable,
+			Columns: []string{telegrammessage.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TelegramMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegrammessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
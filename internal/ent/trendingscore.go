@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+	"github.com/rs/xid"
+)
+
+// TrendingScore is the model entity for the TrendingScore schema.
+type TrendingScore struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Window holds the value of the "window" field.
+	Window string `json:"window,omitempty"`
+	// ItemKind holds the value of the "item_kind" field.
+	ItemKind string `json:"item_kind,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID xid.ID `json:"item_id,omitempty"`
+	// Score holds the value of the "score" field.
+	Score        float64 `json:"score,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrendingScore) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trendingscore.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case trendingscore.FieldWindow, trendingscore.FieldItemKind:
+			values[i] = new(sql.NullString)
+		case trendingscore.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case trendingscore.FieldID, trendingscore.FieldItemID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrendingScore fields.
+func (_m *TrendingScore) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trendingscore.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case trendingscore.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case trendingscore.FieldWindow:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field window", values[i])
+			} else if value.Valid {
+				_m.Window = value.String
+			}
+		case trendingscore.FieldItemKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_kind", values[i])
+			} else if value.Valid {
+				_m.ItemKind = value.String
+			}
+		case trendingscore.FieldItemID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				_m.ItemID = *value
+			}
+		case trendingscore.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Float64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TrendingScore.
+// This includes values selected through modifiers, order, etc.
+func (_m *TrendingScore) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TrendingScore.
+// Note that you need to call TrendingScore.Unwrap() before calling this method if this TrendingScore
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TrendingScore) Update() *TrendingScoreUpdateOne {
+	return NewTrendingScoreClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TrendingScore entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TrendingScore) Unwrap() *TrendingScore {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TrendingScore is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TrendingScore) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrendingScore(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("window=")
+	builder.WriteString(_m.Window)
+	builder.WriteString(", ")
+	builder.WriteString("item_kind=")
+	builder.WriteString(_m.ItemKind)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrendingScores is a parsable slice of TrendingScore.
+type TrendingScores []*TrendingScore
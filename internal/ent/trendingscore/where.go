@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trendingscore
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Window applies equality check predicate on the "window" field. It's identical to WindowEQ.
+func Window(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldWindow, v))
+}
+
+// ItemKind applies equality check predicate on the "item_kind" field. It's identical to ItemKindEQ.
+func ItemKind(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldItemID, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldScore, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// WindowEQ applies the EQ predicate on the "window" field.
+func WindowEQ(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldWindow, v))
+}
+
+// WindowNEQ applies the NEQ predicate on the "window" field.
+func WindowNEQ(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNEQ(FieldWindow, v))
+}
+
+// WindowIn applies the In predicate on the "window" field.
+func WindowIn(vs ...string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldIn(FieldWindow, vs...))
+}
+
+// WindowNotIn applies the NotIn predicate on the "window" field.
+func WindowNotIn(vs ...string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNotIn(FieldWindow, vs...))
+}
+
+// WindowGT applies the GT predicate on the "window" field.
+func WindowGT(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGT(FieldWindow, v))
+}
+
+// WindowGTE applies the GTE predicate on the "window" field.
+func WindowGTE(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGTE(FieldWindow, v))
+}
+
+// WindowLT applies the LT predicate on the "window" field.
+func WindowLT(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLT(FieldWindow, v))
+}
+
+// WindowLTE applies the LTE predicate on the "window" field.
+func WindowLTE(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLTE(FieldWindow, v))
+}
+
+// WindowContains applies the Contains predicate on the "window" field.
+func WindowContains(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldContains(FieldWindow, v))
+}
+
+// WindowHasPrefix applies the HasPrefix predicate on the "window" field.
+func WindowHasPrefix(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldHasPrefix(FieldWindow, v))
+}
+
+// WindowHasSuffix applies the HasSuffix predicate on the "window" field.
+func WindowHasSuffix(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldHasSuffix(FieldWindow, v))
+}
+
+// WindowEqualFold applies the EqualFold predicate on the "window" field.
+func WindowEqualFold(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEqualFold(FieldWindow, v))
+}
+
+// WindowContainsFold applies the ContainsFold predicate on the "window" field.
+func WindowContainsFold(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldContainsFold(FieldWindow, v))
+}
+
+// ItemKindEQ applies the EQ predicate on the "item_kind" field.
+func ItemKindEQ(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemKindNEQ applies the NEQ predicate on the "item_kind" field.
+func ItemKindNEQ(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNEQ(FieldItemKind, v))
+}
+
+// ItemKindIn applies the In predicate on the "item_kind" field.
+func ItemKindIn(vs ...string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldIn(FieldItemKind, vs...))
+}
+
+// ItemKindNotIn applies the NotIn predicate on the "item_kind" field.
+func ItemKindNotIn(vs ...string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNotIn(FieldItemKind, vs...))
+}
+
+// ItemKindGT applies the GT predicate on the "item_kind" field.
+func ItemKindGT(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGT(FieldItemKind, v))
+}
+
+// ItemKindGTE applies the GTE predicate on the "item_kind" field.
+func ItemKindGTE(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGTE(FieldItemKind, v))
+}
+
+// ItemKindLT applies the LT predicate on the "item_kind" field.
+func ItemKindLT(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLT(FieldItemKind, v))
+}
+
+// ItemKindLTE applies the LTE predicate on the "item_kind" field.
+func ItemKindLTE(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLTE(FieldItemKind, v))
+}
+
+// ItemKindContains applies the Contains predicate on the "item_kind" field.
+func ItemKindContains(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldContains(FieldItemKind, v))
+}
+
+// ItemKindHasPrefix applies the HasPrefix predicate on the "item_kind" field.
+func ItemKindHasPrefix(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldHasPrefix(FieldItemKind, v))
+}
+
+// ItemKindHasSuffix applies the HasSuffix predicate on the "item_kind" field.
+func ItemKindHasSuffix(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldHasSuffix(FieldItemKind, v))
+}
+
+// ItemKindEqualFold applies the EqualFold predicate on the "item_kind" field.
+func ItemKindEqualFold(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEqualFold(FieldItemKind, v))
+}
+
+// ItemKindContainsFold applies the ContainsFold predicate on the "item_kind" field.
+func ItemKindContainsFold(v string) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldContainsFold(FieldItemKind, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v xid.ID) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v xid.ID) predicate.TrendingScore {
+	vc := v.String()
+	return predicate.TrendingScore(sql.FieldContains(FieldItemID, vc))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v xid.ID) predicate.TrendingScore {
+	vc := v.String()
+	return predicate.TrendingScore(sql.FieldHasPrefix(FieldItemID, vc))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v xid.ID) predicate.TrendingScore {
+	vc := v.String()
+	return predicate.TrendingScore(sql.FieldHasSuffix(FieldItemID, vc))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v xid.ID) predicate.TrendingScore {
+	vc := v.String()
+	return predicate.TrendingScore(sql.FieldEqualFold(FieldItemID, vc))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v xid.ID) predicate.TrendingScore {
+	vc := v.String()
+	return predicate.TrendingScore(sql.FieldContainsFold(FieldItemID, vc))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.FieldLTE(FieldScore, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TrendingScore) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TrendingScore) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TrendingScore) predicate.TrendingScore {
+	return predicate.TrendingScore(sql.NotPredicates(p))
+}
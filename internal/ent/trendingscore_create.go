@@ -0,0 +1,724 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+	"github.com/rs/xid"
+)
+
+// TrendingScoreCreate is the builder for creating a TrendingScore entity.
+type TrendingScoreCreate struct {
+	config
+	mutation *TrendingScoreMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TrendingScoreCreate) SetCreatedAt(v time.Time) *TrendingScoreCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TrendingScoreCreate) SetNillableCreatedAt(v *time.Time) *TrendingScoreCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetWindow sets the "window" field.
+func (_c *TrendingScoreCreate) SetWindow(v string) *TrendingScoreCreate {
+	_c.mutation.SetWindow(v)
+	return _c
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_c *TrendingScoreCreate) SetItemKind(v string) *TrendingScoreCreate {
+	_c.mutation.SetItemKind(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *TrendingScoreCreate) SetItemID(v xid.ID) *TrendingScoreCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *TrendingScoreCreate) SetScore(v float64) *TrendingScoreCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TrendingScoreCreate) SetID(v xid.ID) *TrendingScoreCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TrendingScoreCreate) SetNillableID(v *xid.ID) *TrendingScoreCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TrendingScoreMutation object of the builder.
+func (_c *TrendingScoreCreate) Mutation() *TrendingScoreMutation {
+	return _c.mutation
+}
+
+// Save creates the TrendingScore in the database.
+func (_c *TrendingScoreCreate) Save(ctx context.Context) (*TrendingScore, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TrendingScoreCreate) SaveX(ctx context.Context) *TrendingScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TrendingScoreCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TrendingScoreCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TrendingScoreCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := trendingscore.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := trendingscore.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TrendingScoreCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TrendingScore.created_at"`)}
+	}
+	if _, ok := _c.mutation.Window(); !ok {
+		return &ValidationError{Name: "window", err: errors.New(`ent: missing required field "TrendingScore.window"`)}
+	}
+	if _, ok := _c.mutation.ItemKind(); !ok {
+		return &ValidationError{Name: "item_kind", err: errors.New(`ent: missing required field "TrendingScore.item_kind"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "TrendingScore.item_id"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "TrendingScore.score"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := trendingscore.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TrendingScore.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TrendingScoreCreate) sqlSave(ctx context.Context) (*TrendingScore, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TrendingScoreCreate) createSpec() (*TrendingScore, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrendingScore{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(trendingscore.Table, sqlgraph.NewFieldSpec(trendingscore.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(trendingscore.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Window(); ok {
+		_spec.SetField(trendingscore.FieldWindow, field.TypeString, value)
+		_node.Window = value
+	}
+	if value, ok := _c.mutation.ItemKind(); ok {
+		_spec.SetField(trendingscore.FieldItemKind, field.TypeString, value)
+		_node.ItemKind = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(trendingscore.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(trendingscore.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrendingScore.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrendingScoreUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TrendingScoreCreate) OnConflict(opts ...sql.ConflictOption) *TrendingScoreUpsertOne {
+	_c.conflict = opts
+	return &TrendingScoreUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrendingScore.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TrendingScoreCreate) OnConflictColumns(columns ...string) *TrendingScoreUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TrendingScoreUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TrendingScoreUpsertOne is the builder for "upsert"-ing
+	//  one TrendingScore node.
+	TrendingScoreUpsertOne struct {
+		create *TrendingScoreCreate
+	}
+
+	// TrendingScoreUpsert is the "OnConflict" setter.
+	TrendingScoreUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWindow sets the "window" field.
+func (u *TrendingScoreUpsert) SetWindow(v string) *TrendingScoreUpsert {
+	u.Set(trendingscore.FieldWindow, v)
+	return u
+}
+
+// UpdateWindow sets the "window" field to the value that was provided on create.
+func (u *TrendingScoreUpsert) UpdateWindow() *TrendingScoreUpsert {
+	u.SetExcluded(trendingscore.FieldWindow)
+	return u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *TrendingScoreUpsert) SetItemKind(v string) *TrendingScoreUpsert {
+	u.Set(trendingscore.FieldItemKind, v)
+	return u
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *TrendingScoreUpsert) UpdateItemKind() *TrendingScoreUpsert {
+	u.SetExcluded(trendingscore.FieldItemKind)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *TrendingScoreUpsert) SetItemID(v xid.ID) *TrendingScoreUpsert {
+	u.Set(trendingscore.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *TrendingScoreUpsert) UpdateItemID() *TrendingScoreUpsert {
+	u.SetExcluded(trendingscore.FieldItemID)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *TrendingScoreUpsert) SetScore(v float64) *TrendingScoreUpsert {
+	u.Set(trendingscore.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *TrendingScoreUpsert) UpdateScore() *TrendingScoreUpsert {
+	u.SetExcluded(trendingscore.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *TrendingScoreUpsert) AddScore(v float64) *TrendingScoreUpsert {
+	u.Add(trendingscore.FieldScore, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TrendingScore.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(trendingscore.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TrendingScoreUpsertOne) UpdateNewValues() *TrendingScoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(trendingscore.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(trendingscore.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrendingScore.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TrendingScoreUpsertOne) Ignore() *TrendingScoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrendingScoreUpsertOne) DoNothing() *TrendingScoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrendingScoreCreate.OnConflict
+// documentation for more info.
+func (u *TrendingScoreUpsertOne) Update(set func(*TrendingScoreUpsert)) *TrendingScoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrendingScoreUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWindow sets the "window" field.
+func (u *TrendingScoreUpsertOne) SetWindow(v string) *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetWindow(v)
+	})
+}
+
+// UpdateWindow sets the "window" field to the value that was provided on create.
+func (u *TrendingScoreUpsertOne) UpdateWindow() *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateWindow()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *TrendingScoreUpsertOne) SetItemKind(v string) *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *TrendingScoreUpsertOne) UpdateItemKind() *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *TrendingScoreUpsertOne) SetItemID(v xid.ID) *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *TrendingScoreUpsertOne) UpdateItemID() *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *TrendingScoreUpsertOne) SetScore(v float64) *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *TrendingScoreUpsertOne) AddScore(v float64) *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *TrendingScoreUpsertOne) UpdateScore() *TrendingScoreUpsertOne {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *TrendingScoreUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrendingScoreCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrendingScoreUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TrendingScoreUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TrendingScoreUpsertOne.ID is not supported by MySQL driver. Use TrendingScoreUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TrendingScoreUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TrendingScoreCreateBulk is the builder for creating many TrendingScore entities in bulk.
+type TrendingScoreCreateBulk struct {
+	config
+	err      error
+	builders []*TrendingScoreCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TrendingScore entities in the database.
+func (_c *TrendingScoreCreateBulk) Save(ctx context.Context) ([]*TrendingScore, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TrendingScore, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrendingScoreMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TrendingScoreCreateBulk) SaveX(ctx context.Context) []*TrendingScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TrendingScoreCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TrendingScoreCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrendingScore.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrendingScoreUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TrendingScoreCreateBulk) OnConflict(opts ...sql.ConflictOption) *TrendingScoreUpsertBulk {
+	_c.conflict = opts
+	return &TrendingScoreUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrendingScore.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TrendingScoreCreateBulk) OnConflictColumns(columns ...string) *TrendingScoreUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TrendingScoreUpsertBulk{
+		create: _c,
+	}
+}
+
+// TrendingScoreUpsertBulk is the builder for "upsert"-ing
+// a bulk of TrendingScore nodes.
+type TrendingScoreUpsertBulk struct {
+	create *TrendingScoreCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TrendingScore.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(trendingscore.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TrendingScoreUpsertBulk) UpdateNewValues() *TrendingScoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(trendingscore.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(trendingscore.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrendingScore.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TrendingScoreUpsertBulk) Ignore() *TrendingScoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrendingScoreUpsertBulk) DoNothing() *TrendingScoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrendingScoreCreateBulk.OnConflict
+// documentation for more info.
+func (u *TrendingScoreUpsertBulk) Update(set func(*TrendingScoreUpsert)) *TrendingScoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrendingScoreUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWindow sets the "window" field.
+func (u *TrendingScoreUpsertBulk) SetWindow(v string) *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetWindow(v)
+	})
+}
+
+// UpdateWindow sets the "window" field to the value that was provided on create.
+func (u *TrendingScoreUpsertBulk) UpdateWindow() *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateWindow()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *TrendingScoreUpsertBulk) SetItemKind(v string) *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *TrendingScoreUpsertBulk) UpdateItemKind() *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *TrendingScoreUpsertBulk) SetItemID(v xid.ID) *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *TrendingScoreUpsertBulk) UpdateItemID() *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *TrendingScoreUpsertBulk) SetScore(v float64) *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *TrendingScoreUpsertBulk) AddScore(v float64) *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *TrendingScoreUpsertBulk) UpdateScore() *TrendingScoreUpsertBulk {
+	return u.Update(func(s *TrendingScoreUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *TrendingScoreUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TrendingScoreCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrendingScoreCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrendingScoreUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
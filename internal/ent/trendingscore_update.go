@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/trendingscore"
+	"github.com/rs/xid"
+)
+
+// TrendingScoreUpdate is the builder for updating TrendingScore entities.
+type TrendingScoreUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TrendingScoreMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TrendingScoreUpdate builder.
+func (_u *TrendingScoreUpdate) Where(ps ...predicate.TrendingScore) *TrendingScoreUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWindow sets the "window" field.
+func (_u *TrendingScoreUpdate) SetWindow(v string) *TrendingScoreUpdate {
+	_u.mutation.SetWindow(v)
+	return _u
+}
+
+// SetNillableWindow sets the "window" field if the given value is not nil.
+func (_u *TrendingScoreUpdate) SetNillableWindow(v *string) *TrendingScoreUpdate {
+	if v != nil {
+		_u.SetWindow(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *TrendingScoreUpdate) SetItemKind(v string) *TrendingScoreUpdate {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *TrendingScoreUpdate) SetNillableItemKind(v *string) *TrendingScoreUpdate {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *TrendingScoreUpdate) SetItemID(v xid.ID) *TrendingScoreUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *TrendingScoreUpdate) SetNillableItemID(v *xid.ID) *TrendingScoreUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TrendingScoreUpdate) SetScore(v float64) *TrendingScoreUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TrendingScoreUpdate) SetNillableScore(v *float64) *TrendingScoreUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TrendingScoreUpdate) AddScore(v float64) *TrendingScoreUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// Mutation returns the TrendingScoreMutation object of the builder.
+func (_u *TrendingScoreUpdate) Mutation() *TrendingScoreMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TrendingScoreUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TrendingScoreUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TrendingScoreUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TrendingScoreUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TrendingScoreUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TrendingScoreUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TrendingScoreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(trendingscore.Table, trendingscore.Columns, sqlgraph.NewFieldSpec(trendingscore.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Window(); ok {
+		_spec.SetField(trendingscore.FieldWindow, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(trendingscore.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(trendingscore.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(trendingscore.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(trendingscore.FieldScore, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trendingscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TrendingScoreUpdateOne is the builder for updating a single TrendingScore entity.
+type TrendingScoreUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TrendingScoreMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetWindow sets the "window" field.
+func (_u *TrendingScoreUpdateOne) SetWindow(v string) *TrendingScoreUpdateOne {
+	_u.mutation.SetWindow(v)
+	return _u
+}
+
+// SetNillableWindow sets the "window" field if the given value is not nil.
+func (_u *TrendingScoreUpdateOne) SetNillableWindow(v *string) *TrendingScoreUpdateOne {
+	if v != nil {
+		_u.SetWindow(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *TrendingScoreUpdateOne) SetItemKind(v string) *TrendingScoreUpdateOne {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *TrendingScoreUpdateOne) SetNillableItemKind(v *string) *TrendingScoreUpdateOne {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *TrendingScoreUpdateOne) SetItemID(v xid.ID) *TrendingScoreUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *TrendingScoreUpdateOne) SetNillableItemID(v *xid.ID) *TrendingScoreUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TrendingScoreUpdateOne) SetScore(v float64) *TrendingScoreUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TrendingScoreUpdateOne) SetNillableScore(v *float64) *TrendingScoreUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TrendingScoreUpdateOne) AddScore(v float64) *TrendingScoreUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// Mutation returns the TrendingScoreMutation object of the builder.
+func (_u *TrendingScoreUpdateOne) Mutation() *TrendingScoreMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TrendingScoreUpdate builder.
+func (_u *TrendingScoreUpdateOne) Where(ps ...predicate.TrendingScore) *TrendingScoreUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TrendingScoreUpdateOne) Select(field string, fields ...string) *TrendingScoreUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TrendingScore entity.
+func (_u *TrendingScoreUpdateOne) Save(ctx context.Context) (*TrendingScore, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TrendingScoreUpdateOne) SaveX(ctx context.Context) *TrendingScore {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TrendingScoreUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TrendingScoreUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TrendingScoreUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TrendingScoreUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TrendingScoreUpdateOne) sqlSave(ctx context.Context) (_node *TrendingScore, err error) {
+	_spec := sqlgraph.NewUpdateSpec(trendingscore.Table, trendingscore.Columns, sqlgraph.NewFieldSpec(trendingscore.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TrendingScore.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trendingscore.FieldID)
+		for _, f := range fields {
+			if !trendingscore.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trendingscore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Window(); ok {
+		_spec.SetField(trendingscore.FieldWindow, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(trendingscore.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(trendingscore.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(trendingscore.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(trendingscore.FieldScore, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TrendingScore{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trendingscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
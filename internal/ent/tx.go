@@ -14,42 +14,118 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIUsage is the client for interacting with the APIUsage builders.
+	APIUsage *APIUsageClient
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AccountApplication is the client for interacting with the AccountApplication builders.
+	AccountApplication *AccountApplicationClient
+	// AccountBadge is the client for interacting with the AccountBadge builders.
+	AccountBadge *AccountBadgeClient
+	// AccountBlock is the client for interacting with the AccountBlock builders.
+	AccountBlock *AccountBlockClient
 	// AccountFollow is the client for interacting with the AccountFollow builders.
 	AccountFollow *AccountFollowClient
+	// AccountOnboardingStep is the client for interacting with the AccountOnboardingStep builders.
+	AccountOnboardingStep *AccountOnboardingStepClient
 	// AccountRoles is the client for interacting with the AccountRoles builders.
 	AccountRoles *AccountRolesClient
+	// AnalyticsCohort is the client for interacting with the AnalyticsCohort builders.
+	AnalyticsCohort *AnalyticsCohortClient
+	// AnalyticsContent is the client for interacting with the AnalyticsContent builders.
+	AnalyticsContent *AnalyticsContentClient
+	// AnalyticsDay is the client for interacting with the AnalyticsDay builders.
+	AnalyticsDay *AnalyticsDayClient
+	// AnalyticsExport is the client for interacting with the AnalyticsExport builders.
+	AnalyticsExport *AnalyticsExportClient
 	// Asset is the client for interacting with the Asset builders.
 	Asset *AssetClient
 	// Authentication is the client for interacting with the Authentication builders.
 	Authentication *AuthenticationClient
+	// AutomodRule is the client for interacting with the AutomodRule builders.
+	AutomodRule *AutomodRuleClient
+	// Badge is the client for interacting with the Badge builders.
+	Badge *BadgeClient
+	// Bookmark is the client for interacting with the Bookmark builders.
+	Bookmark *BookmarkClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// CategoryFollow is the client for interacting with the CategoryFollow builders.
+	CategoryFollow *CategoryFollowClient
+	// CategoryPermission is the client for interacting with the CategoryPermission builders.
+	CategoryPermission *CategoryPermissionClient
 	// Collection is the client for interacting with the Collection builders.
 	Collection *CollectionClient
 	// CollectionNode is the client for interacting with the CollectionNode builders.
 	CollectionNode *CollectionNodeClient
 	// CollectionPost is the client for interacting with the CollectionPost builders.
 	CollectionPost *CollectionPostClient
+	// CollectionSection is the client for interacting with the CollectionSection builders.
+	CollectionSection *CollectionSectionClient
+	// CollectionShare is the client for interacting with the CollectionShare builders.
+	CollectionShare *CollectionShareClient
+	// ContentSummary is the client for interacting with the ContentSummary builders.
+	ContentSummary *ContentSummaryClient
+	// ContentView is the client for interacting with the ContentView builders.
+	ContentView *ContentViewClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// ConversationParticipant is the client for interacting with the ConversationParticipant builders.
+	ConversationParticipant *ConversationParticipantClient
+	// DigestSubscription is the client for interacting with the DigestSubscription builders.
+	DigestSubscription *DigestSubscriptionClient
+	// DirectMessage is the client for interacting with the DirectMessage builders.
+	DirectMessage *DirectMessageClient
+	// DiscordBridgeLink is the client for interacting with the DiscordBridgeLink builders.
+	DiscordBridgeLink *DiscordBridgeLinkClient
 	// Email is the client for interacting with the Email builders.
 	Email *EmailClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
 	// EventParticipant is the client for interacting with the EventParticipant builders.
 	EventParticipant *EventParticipantClient
+	// FeatureFlag is the client for interacting with the FeatureFlag builders.
+	FeatureFlag *FeatureFlagClient
+	// FederationFollower is the client for interacting with the FederationFollower builders.
+	FederationFollower *FederationFollowerClient
+	// FederationKey is the client for interacting with the FederationKey builders.
+	FederationKey *FederationKeyClient
+	// FeedToken is the client for interacting with the FeedToken builders.
+	FeedToken *FeedTokenClient
+	// ImportRecord is the client for interacting with the ImportRecord builders.
+	ImportRecord *ImportRecordClient
+	// ImportRun is the client for interacting with the ImportRun builders.
+	ImportRun *ImportRunClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// LikePost is the client for interacting with the LikePost builders.
 	LikePost *LikePostClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
+	// LinkCheck is the client for interacting with the LinkCheck builders.
+	LinkCheck *LinkCheckClient
+	// MatrixBridgeLink is the client for interacting with the MatrixBridgeLink builders.
+	MatrixBridgeLink *MatrixBridgeLinkClient
 	// MentionProfile is the client for interacting with the MentionProfile builders.
 	MentionProfile *MentionProfileClient
+	// ModerationNote is the client for interacting with the ModerationNote builders.
+	ModerationNote *ModerationNoteClient
+	// NetworkBan is the client for interacting with the NetworkBan builders.
+	NetworkBan *NetworkBanClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PolicyAcceptance is the client for interacting with the PolicyAcceptance builders.
+	PolicyAcceptance *PolicyAcceptanceClient
+	// PolicyDocument is the client for interacting with the PolicyDocument builders.
+	PolicyDocument *PolicyDocumentClient
+	// PolicyVersion is the client for interacting with the PolicyVersion builders.
+	PolicyVersion *PolicyVersionClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostRead is the client for interacting with the PostRead builders.
@@ -60,20 +136,54 @@ type Tx struct {
 	PropertySchema *PropertySchemaClient
 	// PropertySchemaField is the client for interacting with the PropertySchemaField builders.
 	PropertySchemaField *PropertySchemaFieldClient
+	// PushSubscription is the client for interacting with the PushSubscription builders.
+	PushSubscription *PushSubscriptionClient
 	// Question is the client for interacting with the Question builders.
 	Question *QuestionClient
 	// React is the client for interacting with the React builders.
 	React *ReactClient
+	// ReadingHistory is the client for interacting with the ReadingHistory builders.
+	ReadingHistory *ReadingHistoryClient
 	// Report is the client for interacting with the Report builders.
 	Report *ReportClient
+	// ReputationEntry is the client for interacting with the ReputationEntry builders.
+	ReputationEntry *ReputationEntryClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// ScheduledTask is the client for interacting with the ScheduledTask builders.
+	ScheduledTask *ScheduledTaskClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// Space is the client for interacting with the Space builders.
+	Space *SpaceClient
+	// SpaceMember is the client for interacting with the SpaceMember builders.
+	SpaceMember *SpaceMemberClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagFollow is the client for interacting with the TagFollow builders.
+	TagFollow *TagFollowClient
+	// TagSynonym is the client for interacting with the TagSynonym builders.
+	TagSynonym *TagSynonymClient
+	// TelegramMessage is the client for interacting with the TelegramMessage builders.
+	TelegramMessage *TelegramMessageClient
+	// Tenant is the client for interacting with the Tenant builders.
+	Tenant *TenantClient
+	// TrendingScore is the client for interacting with the TrendingScore builders.
+	TrendingScore *TrendingScoreClient
+	// UploadSession is the client for interacting with the UploadSession builders.
+	UploadSession *UploadSessionClient
+	// VerificationRequest is the client for interacting with the VerificationRequest builders.
+	VerificationRequest *VerificationRequestClient
+	// Warning is the client for interacting with the Warning builders.
+	Warning *WarningClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WordFilter is the client for interacting with the WordFilter builders.
+	WordFilter *WordFilterClient
 
 	// lazily loaded.
 	client     *Client
@@ -205,36 +315,91 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIUsage = NewAPIUsageClient(tx.config)
 	tx.Account = NewAccountClient(tx.config)
+	tx.AccountApplication = NewAccountApplicationClient(tx.config)
+	tx.AccountBadge = NewAccountBadgeClient(tx.config)
+	tx.AccountBlock = NewAccountBlockClient(tx.config)
 	tx.AccountFollow = NewAccountFollowClient(tx.config)
+	tx.AccountOnboardingStep = NewAccountOnboardingStepClient(tx.config)
 	tx.AccountRoles = NewAccountRolesClient(tx.config)
+	tx.AnalyticsCohort = NewAnalyticsCohortClient(tx.config)
+	tx.AnalyticsContent = NewAnalyticsContentClient(tx.config)
+	tx.AnalyticsDay = NewAnalyticsDayClient(tx.config)
+	tx.AnalyticsExport = NewAnalyticsExportClient(tx.config)
 	tx.Asset = NewAssetClient(tx.config)
 	tx.Authentication = NewAuthenticationClient(tx.config)
+	tx.AutomodRule = NewAutomodRuleClient(tx.config)
+	tx.Badge = NewBadgeClient(tx.config)
+	tx.Bookmark = NewBookmarkClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
+	tx.CategoryFollow = NewCategoryFollowClient(tx.config)
+	tx.CategoryPermission = NewCategoryPermissionClient(tx.config)
 	tx.Collection = NewCollectionClient(tx.config)
 	tx.CollectionNode = NewCollectionNodeClient(tx.config)
 	tx.CollectionPost = NewCollectionPostClient(tx.config)
+	tx.CollectionSection = NewCollectionSectionClient(tx.config)
+	tx.CollectionShare = NewCollectionShareClient(tx.config)
+	tx.ContentSummary = NewContentSummaryClient(tx.config)
+	tx.ContentView = NewContentViewClient(tx.config)
+	tx.Conversation = NewConversationClient(tx.config)
+	tx.ConversationParticipant = NewConversationParticipantClient(tx.config)
+	tx.DigestSubscription = NewDigestSubscriptionClient(tx.config)
+	tx.DirectMessage = NewDirectMessageClient(tx.config)
+	tx.DiscordBridgeLink = NewDiscordBridgeLinkClient(tx.config)
 	tx.Email = NewEmailClient(tx.config)
 	tx.Event = NewEventClient(tx.config)
 	tx.EventParticipant = NewEventParticipantClient(tx.config)
+	tx.FeatureFlag = NewFeatureFlagClient(tx.config)
+	tx.FederationFollower = NewFederationFollowerClient(tx.config)
+	tx.FederationKey = NewFederationKeyClient(tx.config)
+	tx.FeedToken = NewFeedTokenClient(tx.config)
+	tx.ImportRecord = NewImportRecordClient(tx.config)
+	tx.ImportRun = NewImportRunClient(tx.config)
 	tx.Invitation = NewInvitationClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
 	tx.LikePost = NewLikePostClient(tx.config)
 	tx.Link = NewLinkClient(tx.config)
+	tx.LinkCheck = NewLinkCheckClient(tx.config)
+	tx.MatrixBridgeLink = NewMatrixBridgeLinkClient(tx.config)
 	tx.MentionProfile = NewMentionProfileClient(tx.config)
+	tx.ModerationNote = NewModerationNoteClient(tx.config)
+	tx.NetworkBan = NewNetworkBanClient(tx.config)
 	tx.Node = NewNodeClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.PolicyAcceptance = NewPolicyAcceptanceClient(tx.config)
+	tx.PolicyDocument = NewPolicyDocumentClient(tx.config)
+	tx.PolicyVersion = NewPolicyVersionClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
 	tx.PostRead = NewPostReadClient(tx.config)
 	tx.Property = NewPropertyClient(tx.config)
 	tx.PropertySchema = NewPropertySchemaClient(tx.config)
 	tx.PropertySchemaField = NewPropertySchemaFieldClient(tx.config)
+	tx.PushSubscription = NewPushSubscriptionClient(tx.config)
 	tx.Question = NewQuestionClient(tx.config)
 	tx.React = NewReactClient(tx.config)
+	tx.ReadingHistory = NewReadingHistoryClient(tx.config)
 	tx.Report = NewReportClient(tx.config)
+	tx.ReputationEntry = NewReputationEntryClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.ScheduledTask = NewScheduledTaskClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
+	tx.Space = NewSpaceClient(tx.config)
+	tx.SpaceMember = NewSpaceMemberClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
+	tx.TagFollow = NewTagFollowClient(tx.config)
+	tx.TagSynonym = NewTagSynonymClient(tx.config)
+	tx.TelegramMessage = NewTelegramMessageClient(tx.config)
+	tx.Tenant = NewTenantClient(tx.config)
+	tx.TrendingScore = NewTrendingScoreClient(tx.config)
+	tx.UploadSession = NewUploadSessionClient(tx.config)
+	tx.VerificationRequest = NewVerificationRequestClient(tx.config)
+	tx.Warning = NewWarningClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WordFilter = NewWordFilterClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -244,7 +409,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Account.QueryXXX(), the query will be executed
+// applies a query, for example: APIUsage.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
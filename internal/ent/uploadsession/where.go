@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package uploadsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldFilename, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldSize, v))
+}
+
+// Received applies equality check predicate on the "received" field. It's identical to ReceivedEQ.
+func Received(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldReceived, v))
+}
+
+// ParentAssetID applies equality check predicate on the "parent_asset_id" field. It's identical to ParentAssetIDEQ.
+func ParentAssetID(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldParentAssetID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Private applies equality check predicate on the "private" field. It's identical to PrivateEQ.
+func Private(v bool) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldPrivate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldSize, v))
+}
+
+// ReceivedEQ applies the EQ predicate on the "received" field.
+func ReceivedEQ(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldReceived, v))
+}
+
+// ReceivedNEQ applies the NEQ predicate on the "received" field.
+func ReceivedNEQ(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldReceived, v))
+}
+
+// ReceivedIn applies the In predicate on the "received" field.
+func ReceivedIn(vs ...int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldReceived, vs...))
+}
+
+// ReceivedNotIn applies the NotIn predicate on the "received" field.
+func ReceivedNotIn(vs ...int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldReceived, vs...))
+}
+
+// ReceivedGT applies the GT predicate on the "received" field.
+func ReceivedGT(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldReceived, v))
+}
+
+// ReceivedGTE applies the GTE predicate on the "received" field.
+func ReceivedGTE(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldReceived, v))
+}
+
+// ReceivedLT applies the LT predicate on the "received" field.
+func ReceivedLT(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldReceived, v))
+}
+
+// ReceivedLTE applies the LTE predicate on the "received" field.
+func ReceivedLTE(v int64) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldReceived, v))
+}
+
+// ParentAssetIDEQ applies the EQ predicate on the "parent_asset_id" field.
+func ParentAssetIDEQ(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldParentAssetID, v))
+}
+
+// ParentAssetIDNEQ applies the NEQ predicate on the "parent_asset_id" field.
+func ParentAssetIDNEQ(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldParentAssetID, v))
+}
+
+// ParentAssetIDIn applies the In predicate on the "parent_asset_id" field.
+func ParentAssetIDIn(vs ...xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldParentAssetID, vs...))
+}
+
+// ParentAssetIDNotIn applies the NotIn predicate on the "parent_asset_id" field.
+func ParentAssetIDNotIn(vs ...xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldParentAssetID, vs...))
+}
+
+// ParentAssetIDGT applies the GT predicate on the "parent_asset_id" field.
+func ParentAssetIDGT(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldParentAssetID, v))
+}
+
+// ParentAssetIDGTE applies the GTE predicate on the "parent_asset_id" field.
+func ParentAssetIDGTE(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldParentAssetID, v))
+}
+
+// ParentAssetIDLT applies the LT predicate on the "parent_asset_id" field.
+func ParentAssetIDLT(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldParentAssetID, v))
+}
+
+// ParentAssetIDLTE applies the LTE predicate on the "parent_asset_id" field.
+func ParentAssetIDLTE(v xid.ID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldParentAssetID, v))
+}
+
+// ParentAssetIDContains applies the Contains predicate on the "parent_asset_id" field.
+func ParentAssetIDContains(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldContains(FieldParentAssetID, vc))
+}
+
+// ParentAssetIDHasPrefix applies the HasPrefix predicate on the "parent_asset_id" field.
+func ParentAssetIDHasPrefix(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldHasPrefix(FieldParentAssetID, vc))
+}
+
+// ParentAssetIDHasSuffix applies the HasSuffix predicate on the "parent_asset_id" field.
+func ParentAssetIDHasSuffix(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldHasSuffix(FieldParentAssetID, vc))
+}
+
+// ParentAssetIDIsNil applies the IsNil predicate on the "parent_asset_id" field.
+func ParentAssetIDIsNil() predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIsNull(FieldParentAssetID))
+}
+
+// ParentAssetIDNotNil applies the NotNil predicate on the "parent_asset_id" field.
+func ParentAssetIDNotNil() predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotNull(FieldParentAssetID))
+}
+
+// ParentAssetIDEqualFold applies the EqualFold predicate on the "parent_asset_id" field.
+func ParentAssetIDEqualFold(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldEqualFold(FieldParentAssetID, vc))
+}
+
+// ParentAssetIDContainsFold applies the ContainsFold predicate on the "parent_asset_id" field.
+func ParentAssetIDContainsFold(v xid.ID) predicate.UploadSession {
+	vc := v.String()
+	return predicate.UploadSession(sql.FieldContainsFold(FieldParentAssetID, vc))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// PrivateEQ applies the EQ predicate on the "private" field.
+func PrivateEQ(v bool) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldPrivate, v))
+}
+
+// PrivateNEQ applies the NEQ predicate on the "private" field.
+func PrivateNEQ(v bool) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldPrivate, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.UploadSession {
+	return predicate.UploadSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.UploadSession {
+	return predicate.UploadSession(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UploadSession) predicate.UploadSession {
+	return predicate.UploadSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UploadSession) predicate.UploadSession {
+	return predicate.UploadSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UploadSession) predicate.UploadSession {
+	return predicate.UploadSession(sql.NotPredicates(p))
+}
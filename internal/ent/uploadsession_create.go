@@ -0,0 +1,1039 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/rs/xid"
+)
+
+// UploadSessionCreate is the builder for creating a UploadSession entity.
+type UploadSessionCreate struct {
+	config
+	mutation *UploadSessionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UploadSessionCreate) SetCreatedAt(v time.Time) *UploadSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableCreatedAt(v *time.Time) *UploadSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UploadSessionCreate) SetUpdatedAt(v time.Time) *UploadSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableUpdatedAt(v *time.Time) *UploadSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *UploadSessionCreate) SetAccountID(v xid.ID) *UploadSessionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *UploadSessionCreate) SetFilename(v string) *UploadSessionCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *UploadSessionCreate) SetSize(v int64) *UploadSessionCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetReceived sets the "received" field.
+func (_c *UploadSessionCreate) SetReceived(v int64) *UploadSessionCreate {
+	_c.mutation.SetReceived(v)
+	return _c
+}
+
+// SetNillableReceived sets the "received" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableReceived(v *int64) *UploadSessionCreate {
+	if v != nil {
+		_c.SetReceived(*v)
+	}
+	return _c
+}
+
+// SetParentAssetID sets the "parent_asset_id" field.
+func (_c *UploadSessionCreate) SetParentAssetID(v xid.ID) *UploadSessionCreate {
+	_c.mutation.SetParentAssetID(v)
+	return _c
+}
+
+// SetNillableParentAssetID sets the "parent_asset_id" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableParentAssetID(v *xid.ID) *UploadSessionCreate {
+	if v != nil {
+		_c.SetParentAssetID(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *UploadSessionCreate) SetExpiresAt(v time.Time) *UploadSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetPrivate sets the "private" field.
+func (_c *UploadSessionCreate) SetPrivate(v bool) *UploadSessionCreate {
+	_c.mutation.SetPrivate(v)
+	return _c
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillablePrivate(v *bool) *UploadSessionCreate {
+	if v != nil {
+		_c.SetPrivate(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UploadSessionCreate) SetID(v xid.ID) *UploadSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableID(v *xid.ID) *UploadSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *UploadSessionCreate) SetAccount(v *Account) *UploadSessionCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (_c *UploadSessionCreate) Mutation() *UploadSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the UploadSession in the database.
+func (_c *UploadSessionCreate) Save(ctx context.Context) (*UploadSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UploadSessionCreate) SaveX(ctx context.Context) *UploadSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UploadSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := uploadsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := uploadsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Received(); !ok {
+		v := uploadsession.DefaultReceived
+		_c.mutation.SetReceived(v)
+	}
+	if _, ok := _c.mutation.Private(); !ok {
+		v := uploadsession.DefaultPrivate
+		_c.mutation.SetPrivate(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := uploadsession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UploadSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UploadSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UploadSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "UploadSession.account_id"`)}
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "UploadSession.filename"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "UploadSession.size"`)}
+	}
+	if _, ok := _c.mutation.Received(); !ok {
+		return &ValidationError{Name: "received", err: errors.New(`ent: missing required field "UploadSession.received"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "UploadSession.expires_at"`)}
+	}
+	if _, ok := _c.mutation.Private(); !ok {
+		return &ValidationError{Name: "private", err: errors.New(`ent: missing required field "UploadSession.private"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := uploadsession.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UploadSession.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "UploadSession.account"`)}
+	}
+	return nil
+}
+
+func (_c *UploadSessionCreate) sqlSave(ctx context.Context) (*UploadSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UploadSessionCreate) createSpec() (*UploadSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UploadSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(uploadsession.Table, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(uploadsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(uploadsession.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(uploadsession.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Received(); ok {
+		_spec.SetField(uploadsession.FieldReceived, field.TypeInt64, value)
+		_node.Received = value
+	}
+	if value, ok := _c.mutation.ParentAssetID(); ok {
+		_spec.SetField(uploadsession.FieldParentAssetID, field.TypeString, value)
+		_node.ParentAssetID = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.Private(); ok {
+		_spec.SetField(uploadsession.FieldPrivate, field.TypeBool, value)
+		_node.Private = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.AccountTable,
+			Columns: []string{uploadsession.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UploadSession.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UploadSessionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UploadSessionCreate) OnConflict(opts ...sql.ConflictOption) *UploadSessionUpsertOne {
+	_c.conflict = opts
+	return &UploadSessionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UploadSessionCreate) OnConflictColumns(columns ...string) *UploadSessionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UploadSessionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UploadSessionUpsertOne is the builder for "upsert"-ing
+	//  one UploadSession node.
+	UploadSessionUpsertOne struct {
+		create *UploadSessionCreate
+	}
+
+	// UploadSessionUpsert is the "OnConflict" setter.
+	UploadSessionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UploadSessionUpsert) SetUpdatedAt(v time.Time) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateUpdatedAt() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *UploadSessionUpsert) SetAccountID(v xid.ID) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateAccountID() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldAccountID)
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *UploadSessionUpsert) SetFilename(v string) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldFilename, v)
+	return u
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateFilename() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldFilename)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *UploadSessionUpsert) SetSize(v int64) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateSize() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *UploadSessionUpsert) AddSize(v int64) *UploadSessionUpsert {
+	u.Add(uploadsession.FieldSize, v)
+	return u
+}
+
+// SetReceived sets the "received" field.
+func (u *UploadSessionUpsert) SetReceived(v int64) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldReceived, v)
+	return u
+}
+
+// UpdateReceived sets the "received" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateReceived() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldReceived)
+	return u
+}
+
+// AddReceived adds v to the "received" field.
+func (u *UploadSessionUpsert) AddReceived(v int64) *UploadSessionUpsert {
+	u.Add(uploadsession.FieldReceived, v)
+	return u
+}
+
+// SetParentAssetID sets the "parent_asset_id" field.
+func (u *UploadSessionUpsert) SetParentAssetID(v xid.ID) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldParentAssetID, v)
+	return u
+}
+
+// UpdateParentAssetID sets the "parent_asset_id" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateParentAssetID() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldParentAssetID)
+	return u
+}
+
+// ClearParentAssetID clears the value of the "parent_asset_id" field.
+func (u *UploadSessionUpsert) ClearParentAssetID() *UploadSessionUpsert {
+	u.SetNull(uploadsession.FieldParentAssetID)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UploadSessionUpsert) SetExpiresAt(v time.Time) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateExpiresAt() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldExpiresAt)
+	return u
+}
+
+// SetPrivate sets the "private" field.
+func (u *UploadSessionUpsert) SetPrivate(v bool) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldPrivate, v)
+	return u
+}
+
+// UpdatePrivate sets the "private" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdatePrivate() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldPrivate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(uploadsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UploadSessionUpsertOne) UpdateNewValues() *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(uploadsession.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(uploadsession.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UploadSessionUpsertOne) Ignore() *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UploadSessionUpsertOne) DoNothing() *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UploadSessionCreate.OnConflict
+// documentation for more info.
+func (u *UploadSessionUpsertOne) Update(set func(*UploadSessionUpsert)) *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UploadSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UploadSessionUpsertOne) SetUpdatedAt(v time.Time) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateUpdatedAt() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *UploadSessionUpsertOne) SetAccountID(v xid.ID) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateAccountID() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *UploadSessionUpsertOne) SetFilename(v string) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateFilename() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *UploadSessionUpsertOne) SetSize(v int64) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *UploadSessionUpsertOne) AddSize(v int64) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateSize() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetReceived sets the "received" field.
+func (u *UploadSessionUpsertOne) SetReceived(v int64) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetReceived(v)
+	})
+}
+
+// AddReceived adds v to the "received" field.
+func (u *UploadSessionUpsertOne) AddReceived(v int64) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.AddReceived(v)
+	})
+}
+
+// UpdateReceived sets the "received" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateReceived() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateReceived()
+	})
+}
+
+// SetParentAssetID sets the "parent_asset_id" field.
+func (u *UploadSessionUpsertOne) SetParentAssetID(v xid.ID) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetParentAssetID(v)
+	})
+}
+
+// UpdateParentAssetID sets the "parent_asset_id" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateParentAssetID() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateParentAssetID()
+	})
+}
+
+// ClearParentAssetID clears the value of the "parent_asset_id" field.
+func (u *UploadSessionUpsertOne) ClearParentAssetID() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.ClearParentAssetID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UploadSessionUpsertOne) SetExpiresAt(v time.Time) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateExpiresAt() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetPrivate sets the "private" field.
+func (u *UploadSessionUpsertOne) SetPrivate(v bool) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetPrivate(v)
+	})
+}
+
+// UpdatePrivate sets the "private" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdatePrivate() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdatePrivate()
+	})
+}
+
+// Exec executes the query.
+func (u *UploadSessionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UploadSessionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UploadSessionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UploadSessionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UploadSessionUpsertOne.ID is not supported by MySQL driver. Use UploadSessionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UploadSessionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UploadSessionCreateBulk is the builder for creating many UploadSession entities in bulk.
+type UploadSessionCreateBulk struct {
+	config
+	err      error
+	builders []*UploadSessionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UploadSession entities in the database.
+func (_c *UploadSessionCreateBulk) Save(ctx context.Context) ([]*UploadSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UploadSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UploadSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UploadSessionCreateBulk) SaveX(ctx context.Context) []*UploadSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UploadSession.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UploadSessionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UploadSessionCreateBulk) OnConflict(opts ...sql.ConflictOption) *UploadSessionUpsertBulk {
+	_c.conflict = opts
+	return &UploadSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UploadSessionCreateBulk) OnConflictColumns(columns ...string) *UploadSessionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UploadSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// UploadSessionUpsertBulk is the builder for "upsert"-ing
+// a bulk of UploadSession nodes.
+type UploadSessionUpsertBulk struct {
+	create *UploadSessionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(uploadsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UploadSessionUpsertBulk) UpdateNewValues() *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(uploadsession.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(uploadsession.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UploadSessionUpsertBulk) Ignore() *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UploadSessionUpsertBulk) DoNothing() *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UploadSessionCreateBulk.OnConflict
+// documentation for more info.
+func (u *UploadSessionUpsertBulk) Update(set func(*UploadSessionUpsert)) *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UploadSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UploadSessionUpsertBulk) SetUpdatedAt(v time.Time) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateUpdatedAt() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *UploadSessionUpsertBulk) SetAccountID(v xid.ID) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateAccountID() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *UploadSessionUpsertBulk) SetFilename(v string) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateFilename() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *UploadSessionUpsertBulk) SetSize(v int64) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *UploadSessionUpsertBulk) AddSize(v int64) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateSize() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetReceived sets the "received" field.
+func (u *UploadSessionUpsertBulk) SetReceived(v int64) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetReceived(v)
+	})
+}
+
+// AddReceived adds v to the "received" field.
+func (u *UploadSessionUpsertBulk) AddReceived(v int64) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.AddReceived(v)
+	})
+}
+
+// UpdateReceived sets the "received" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateReceived() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateReceived()
+	})
+}
+
+// SetParentAssetID sets the "parent_asset_id" field.
+func (u *UploadSessionUpsertBulk) SetParentAssetID(v xid.ID) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetParentAssetID(v)
+	})
+}
+
+// UpdateParentAssetID sets the "parent_asset_id" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateParentAssetID() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateParentAssetID()
+	})
+}
+
+// ClearParentAssetID clears the value of the "parent_asset_id" field.
+func (u *UploadSessionUpsertBulk) ClearParentAssetID() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.ClearParentAssetID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UploadSessionUpsertBulk) SetExpiresAt(v time.Time) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateExpiresAt() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetPrivate sets the "private" field.
+func (u *UploadSessionUpsertBulk) SetPrivate(v bool) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetPrivate(v)
+	})
+}
+
+// UpdatePrivate sets the "private" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdatePrivate() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdatePrivate()
+	})
+}
+
+// Exec executes the query.
+func (u *UploadSessionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UploadSessionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UploadSessionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UploadSessionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
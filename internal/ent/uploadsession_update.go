@@ -0,0 +1,622 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/uploadsession"
+	"github.com/rs/xid"
+)
+
+// UploadSessionUpdate is the builder for updating UploadSession entities.
+type UploadSessionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UploadSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UploadSessionUpdate builder.
+func (_u *UploadSessionUpdate) Where(ps ...predicate.UploadSession) *UploadSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UploadSessionUpdate) SetUpdatedAt(v time.Time) *UploadSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *UploadSessionUpdate) SetAccountID(v xid.ID) *UploadSessionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableAccountID(v *xid.ID) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *UploadSessionUpdate) SetFilename(v string) *UploadSessionUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableFilename(v *string) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *UploadSessionUpdate) SetSize(v int64) *UploadSessionUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableSize(v *int64) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *UploadSessionUpdate) AddSize(v int64) *UploadSessionUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetReceived sets the "received" field.
+func (_u *UploadSessionUpdate) SetReceived(v int64) *UploadSessionUpdate {
+	_u.mutation.ResetReceived()
+	_u.mutation.SetReceived(v)
+	return _u
+}
+
+// SetNillableReceived sets the "received" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableReceived(v *int64) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetReceived(*v)
+	}
+	return _u
+}
+
+// AddReceived adds value to the "received" field.
+func (_u *UploadSessionUpdate) AddReceived(v int64) *UploadSessionUpdate {
+	_u.mutation.AddReceived(v)
+	return _u
+}
+
+// SetParentAssetID sets the "parent_asset_id" field.
+func (_u *UploadSessionUpdate) SetParentAssetID(v xid.ID) *UploadSessionUpdate {
+	_u.mutation.SetParentAssetID(v)
+	return _u
+}
+
+// SetNillableParentAssetID sets the "parent_asset_id" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableParentAssetID(v *xid.ID) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetParentAssetID(*v)
+	}
+	return _u
+}
+
+// ClearParentAssetID clears the value of the "parent_asset_id" field.
+func (_u *UploadSessionUpdate) ClearParentAssetID() *UploadSessionUpdate {
+	_u.mutation.ClearParentAssetID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UploadSessionUpdate) SetExpiresAt(v time.Time) *UploadSessionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableExpiresAt(v *time.Time) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetPrivate sets the "private" field.
+func (_u *UploadSessionUpdate) SetPrivate(v bool) *UploadSessionUpdate {
+	_u.mutation.SetPrivate(v)
+	return _u
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillablePrivate(v *bool) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetPrivate(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *UploadSessionUpdate) SetAccount(v *Account) *UploadSessionUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (_u *UploadSessionUpdate) Mutation() *UploadSessionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *UploadSessionUpdate) ClearAccount() *UploadSessionUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UploadSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UploadSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UploadSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := uploadsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadSessionUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UploadSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UploadSessionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UploadSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(uploadsession.Table, uploadsession.Columns, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(uploadsession.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(uploadsession.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(uploadsession.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Received(); ok {
+		_spec.SetField(uploadsession.FieldReceived, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReceived(); ok {
+		_spec.AddField(uploadsession.FieldReceived, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ParentAssetID(); ok {
+		_spec.SetField(uploadsession.FieldParentAssetID, field.TypeString, value)
+	}
+	if _u.mutation.ParentAssetIDCleared() {
+		_spec.ClearField(uploadsession.FieldParentAssetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Private(); ok {
+		_spec.SetField(uploadsession.FieldPrivate, field.TypeBool, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.AccountTable,
+			Columns: []string{uploadsession.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.AccountTable,
+			Columns: []string{uploadsession.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UploadSessionUpdateOne is the builder for updating a single UploadSession entity.
+type UploadSessionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UploadSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UploadSessionUpdateOne) SetUpdatedAt(v time.Time) *UploadSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *UploadSessionUpdateOne) SetAccountID(v xid.ID) *UploadSessionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableAccountID(v *xid.ID) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *UploadSessionUpdateOne) SetFilename(v string) *UploadSessionUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableFilename(v *string) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *UploadSessionUpdateOne) SetSize(v int64) *UploadSessionUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableSize(v *int64) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *UploadSessionUpdateOne) AddSize(v int64) *UploadSessionUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetReceived sets the "received" field.
+func (_u *UploadSessionUpdateOne) SetReceived(v int64) *UploadSessionUpdateOne {
+	_u.mutation.ResetReceived()
+	_u.mutation.SetReceived(v)
+	return _u
+}
+
+// SetNillableReceived sets the "received" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableReceived(v *int64) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetReceived(*v)
+	}
+	return _u
+}
+
+// AddReceived adds value to the "received" field.
+func (_u *UploadSessionUpdateOne) AddReceived(v int64) *UploadSessionUpdateOne {
+	_u.mutation.AddReceived(v)
+	return _u
+}
+
+// SetParentAssetID sets the "parent_asset_id" field.
+func (_u *UploadSessionUpdateOne) SetParentAssetID(v xid.ID) *UploadSessionUpdateOne {
+	_u.mutation.SetParentAssetID(v)
+	return _u
+}
+
+// SetNillableParentAssetID sets the "parent_asset_id" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableParentAssetID(v *xid.ID) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetParentAssetID(*v)
+	}
+	return _u
+}
+
+// ClearParentAssetID clears the value of the "parent_asset_id" field.
+func (_u *UploadSessionUpdateOne) ClearParentAssetID() *UploadSessionUpdateOne {
+	_u.mutation.ClearParentAssetID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UploadSessionUpdateOne) SetExpiresAt(v time.Time) *UploadSessionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableExpiresAt(v *time.Time) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetPrivate sets the "private" field.
+func (_u *UploadSessionUpdateOne) SetPrivate(v bool) *UploadSessionUpdateOne {
+	_u.mutation.SetPrivate(v)
+	return _u
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillablePrivate(v *bool) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetPrivate(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *UploadSessionUpdateOne) SetAccount(v *Account) *UploadSessionUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (_u *UploadSessionUpdateOne) Mutation() *UploadSessionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *UploadSessionUpdateOne) ClearAccount() *UploadSessionUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the UploadSessionUpdate builder.
+func (_u *UploadSessionUpdateOne) Where(ps ...predicate.UploadSession) *UploadSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UploadSessionUpdateOne) Select(field string, fields ...string) *UploadSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UploadSession entity.
+func (_u *UploadSessionUpdateOne) Save(ctx context.Context) (*UploadSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadSessionUpdateOne) SaveX(ctx context.Context) *UploadSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UploadSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UploadSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := uploadsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadSessionUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UploadSessionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UploadSessionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UploadSessionUpdateOne) sqlSave(ctx context.Context) (_node *UploadSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(uploadsession.Table, uploadsession.Columns, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UploadSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, uploadsession.FieldID)
+		for _, f := range fields {
+			if !uploadsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != uploadsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(uploadsession.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(uploadsession.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(uploadsession.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Received(); ok {
+		_spec.SetField(uploadsession.FieldReceived, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReceived(); ok {
+		_spec.AddField(uploadsession.FieldReceived, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ParentAssetID(); ok {
+		_spec.SetField(uploadsession.FieldParentAssetID, field.TypeString, value)
+	}
+	if _u.mutation.ParentAssetIDCleared() {
+		_spec.ClearField(uploadsession.FieldParentAssetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Private(); ok {
+		_spec.SetField(uploadsession.FieldPrivate, field.TypeBool, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.AccountTable,
+			Columns: []string{uploadsession.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.AccountTable,
+			Columns: []string{uploadsession.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &UploadSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
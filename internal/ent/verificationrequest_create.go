@@ -0,0 +1,1068 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/rs/xid"
+)
+
+// VerificationRequestCreate is the builder for creating a VerificationRequest entity.
+type VerificationRequestCreate struct {
+	config
+	mutation *VerificationRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VerificationRequestCreate) SetCreatedAt(v time.Time) *VerificationRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VerificationRequestCreate) SetNillableCreatedAt(v *time.Time) *VerificationRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VerificationRequestCreate) SetUpdatedAt(v time.Time) *VerificationRequestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VerificationRequestCreate) SetNillableUpdatedAt(v *time.Time) *VerificationRequestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *VerificationRequestCreate) SetAccountID(v xid.ID) *VerificationRequestCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetBadge sets the "badge" field.
+func (_c *VerificationRequestCreate) SetBadge(v string) *VerificationRequestCreate {
+	_c.mutation.SetBadge(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *VerificationRequestCreate) SetNote(v string) *VerificationRequestCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *VerificationRequestCreate) SetNillableNote(v *string) *VerificationRequestCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *VerificationRequestCreate) SetStatus(v string) *VerificationRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_c *VerificationRequestCreate) SetReviewedByID(v xid.ID) *VerificationRequestCreate {
+	_c.mutation.SetReviewedByID(v)
+	return _c
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_c *VerificationRequestCreate) SetNillableReviewedByID(v *xid.ID) *VerificationRequestCreate {
+	if v != nil {
+		_c.SetReviewedByID(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *VerificationRequestCreate) SetReviewedAt(v time.Time) *VerificationRequestCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *VerificationRequestCreate) SetNillableReviewedAt(v *time.Time) *VerificationRequestCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *VerificationRequestCreate) SetReason(v string) *VerificationRequestCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *VerificationRequestCreate) SetNillableReason(v *string) *VerificationRequestCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VerificationRequestCreate) SetID(v xid.ID) *VerificationRequestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VerificationRequestCreate) SetNillableID(v *xid.ID) *VerificationRequestCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *VerificationRequestCreate) SetAccount(v *Account) *VerificationRequestCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_c *VerificationRequestCreate) SetReviewedBy(v *Account) *VerificationRequestCreate {
+	return _c.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the VerificationRequestMutation object of the builder.
+func (_c *VerificationRequestCreate) Mutation() *VerificationRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the VerificationRequest in the database.
+func (_c *VerificationRequestCreate) Save(ctx context.Context) (*VerificationRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VerificationRequestCreate) SaveX(ctx context.Context) *VerificationRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VerificationRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VerificationRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VerificationRequestCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := verificationrequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := verificationrequest.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := verificationrequest.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VerificationRequestCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VerificationRequest.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VerificationRequest.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "VerificationRequest.account_id"`)}
+	}
+	if _, ok := _c.mutation.Badge(); !ok {
+		return &ValidationError{Name: "badge", err: errors.New(`ent: missing required field "VerificationRequest.badge"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "VerificationRequest.status"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := verificationrequest.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "VerificationRequest.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "VerificationRequest.account"`)}
+	}
+	return nil
+}
+
+func (_c *VerificationRequestCreate) sqlSave(ctx context.Context) (*VerificationRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VerificationRequestCreate) createSpec() (*VerificationRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VerificationRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(verificationrequest.Table, sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(verificationrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(verificationrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Badge(); ok {
+		_spec.SetField(verificationrequest.FieldBadge, field.TypeString, value)
+		_node.Badge = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(verificationrequest.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(verificationrequest.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(verificationrequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(verificationrequest.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.AccountTable,
+			Columns: []string{verificationrequest.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.ReviewedByTable,
+			Columns: []string{verificationrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerificationRequest.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerificationRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VerificationRequestCreate) OnConflict(opts ...sql.ConflictOption) *VerificationRequestUpsertOne {
+	_c.conflict = opts
+	return &VerificationRequestUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerificationRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VerificationRequestCreate) OnConflictColumns(columns ...string) *VerificationRequestUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VerificationRequestUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// VerificationRequestUpsertOne is the builder for "upsert"-ing
+	//  one VerificationRequest node.
+	VerificationRequestUpsertOne struct {
+		create *VerificationRequestCreate
+	}
+
+	// VerificationRequestUpsert is the "OnConflict" setter.
+	VerificationRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VerificationRequestUpsert) SetUpdatedAt(v time.Time) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateUpdatedAt() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *VerificationRequestUpsert) SetAccountID(v xid.ID) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateAccountID() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldAccountID)
+	return u
+}
+
+// SetBadge sets the "badge" field.
+func (u *VerificationRequestUpsert) SetBadge(v string) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldBadge, v)
+	return u
+}
+
+// UpdateBadge sets the "badge" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateBadge() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldBadge)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *VerificationRequestUpsert) SetNote(v string) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateNote() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *VerificationRequestUpsert) ClearNote() *VerificationRequestUpsert {
+	u.SetNull(verificationrequest.FieldNote)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *VerificationRequestUpsert) SetStatus(v string) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateStatus() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldStatus)
+	return u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (u *VerificationRequestUpsert) SetReviewedByID(v xid.ID) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldReviewedByID, v)
+	return u
+}
+
+// UpdateReviewedByID sets the "reviewed_by_id" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateReviewedByID() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldReviewedByID)
+	return u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (u *VerificationRequestUpsert) ClearReviewedByID() *VerificationRequestUpsert {
+	u.SetNull(verificationrequest.FieldReviewedByID)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *VerificationRequestUpsert) SetReviewedAt(v time.Time) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateReviewedAt() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *VerificationRequestUpsert) ClearReviewedAt() *VerificationRequestUpsert {
+	u.SetNull(verificationrequest.FieldReviewedAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *VerificationRequestUpsert) SetReason(v string) *VerificationRequestUpsert {
+	u.Set(verificationrequest.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *VerificationRequestUpsert) UpdateReason() *VerificationRequestUpsert {
+	u.SetExcluded(verificationrequest.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *VerificationRequestUpsert) ClearReason() *VerificationRequestUpsert {
+	u.SetNull(verificationrequest.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VerificationRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(verificationrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VerificationRequestUpsertOne) UpdateNewValues() *VerificationRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(verificationrequest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(verificationrequest.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerificationRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VerificationRequestUpsertOne) Ignore() *VerificationRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerificationRequestUpsertOne) DoNothing() *VerificationRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerificationRequestCreate.OnConflict
+// documentation for more info.
+func (u *VerificationRequestUpsertOne) Update(set func(*VerificationRequestUpsert)) *VerificationRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerificationRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VerificationRequestUpsertOne) SetUpdatedAt(v time.Time) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateUpdatedAt() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *VerificationRequestUpsertOne) SetAccountID(v xid.ID) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateAccountID() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBadge sets the "badge" field.
+func (u *VerificationRequestUpsertOne) SetBadge(v string) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetBadge(v)
+	})
+}
+
+// UpdateBadge sets the "badge" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateBadge() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateBadge()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *VerificationRequestUpsertOne) SetNote(v string) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateNote() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *VerificationRequestUpsertOne) ClearNote() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VerificationRequestUpsertOne) SetStatus(v string) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateStatus() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (u *VerificationRequestUpsertOne) SetReviewedByID(v xid.ID) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetReviewedByID(v)
+	})
+}
+
+// UpdateReviewedByID sets the "reviewed_by_id" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateReviewedByID() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateReviewedByID()
+	})
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (u *VerificationRequestUpsertOne) ClearReviewedByID() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearReviewedByID()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *VerificationRequestUpsertOne) SetReviewedAt(v time.Time) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateReviewedAt() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *VerificationRequestUpsertOne) ClearReviewedAt() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *VerificationRequestUpsertOne) SetReason(v string) *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *VerificationRequestUpsertOne) UpdateReason() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *VerificationRequestUpsertOne) ClearReason() *VerificationRequestUpsertOne {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *VerificationRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerificationRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerificationRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VerificationRequestUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VerificationRequestUpsertOne.ID is not supported by MySQL driver. Use VerificationRequestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VerificationRequestUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VerificationRequestCreateBulk is the builder for creating many VerificationRequest entities in bulk.
+type VerificationRequestCreateBulk struct {
+	config
+	err      error
+	builders []*VerificationRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VerificationRequest entities in the database.
+func (_c *VerificationRequestCreateBulk) Save(ctx context.Context) ([]*VerificationRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VerificationRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VerificationRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VerificationRequestCreateBulk) SaveX(ctx context.Context) []*VerificationRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VerificationRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VerificationRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerificationRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerificationRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VerificationRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *VerificationRequestUpsertBulk {
+	_c.conflict = opts
+	return &VerificationRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerificationRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VerificationRequestCreateBulk) OnConflictColumns(columns ...string) *VerificationRequestUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VerificationRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// VerificationRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of VerificationRequest nodes.
+type VerificationRequestUpsertBulk struct {
+	create *VerificationRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VerificationRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(verificationrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VerificationRequestUpsertBulk) UpdateNewValues() *VerificationRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(verificationrequest.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(verificationrequest.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerificationRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VerificationRequestUpsertBulk) Ignore() *VerificationRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerificationRequestUpsertBulk) DoNothing() *VerificationRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerificationRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *VerificationRequestUpsertBulk) Update(set func(*VerificationRequestUpsert)) *VerificationRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerificationRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VerificationRequestUpsertBulk) SetUpdatedAt(v time.Time) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateUpdatedAt() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *VerificationRequestUpsertBulk) SetAccountID(v xid.ID) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateAccountID() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBadge sets the "badge" field.
+func (u *VerificationRequestUpsertBulk) SetBadge(v string) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetBadge(v)
+	})
+}
+
+// UpdateBadge sets the "badge" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateBadge() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateBadge()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *VerificationRequestUpsertBulk) SetNote(v string) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateNote() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *VerificationRequestUpsertBulk) ClearNote() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VerificationRequestUpsertBulk) SetStatus(v string) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateStatus() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (u *VerificationRequestUpsertBulk) SetReviewedByID(v xid.ID) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetReviewedByID(v)
+	})
+}
+
+// UpdateReviewedByID sets the "reviewed_by_id" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateReviewedByID() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateReviewedByID()
+	})
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (u *VerificationRequestUpsertBulk) ClearReviewedByID() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearReviewedByID()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *VerificationRequestUpsertBulk) SetReviewedAt(v time.Time) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateReviewedAt() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *VerificationRequestUpsertBulk) ClearReviewedAt() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *VerificationRequestUpsertBulk) SetReason(v string) *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *VerificationRequestUpsertBulk) UpdateReason() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *VerificationRequestUpsertBulk) ClearReason() *VerificationRequestUpsertBulk {
+	return u.Update(func(s *VerificationRequestUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *VerificationRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VerificationRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerificationRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerificationRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/verificationrequest"
+	"github.com/rs/xid"
+)
+
+// VerificationRequestUpdate is the builder for updating VerificationRequest entities.
+type VerificationRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *VerificationRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the VerificationRequestUpdate builder.
+func (_u *VerificationRequestUpdate) Where(ps ...predicate.VerificationRequest) *VerificationRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VerificationRequestUpdate) SetUpdatedAt(v time.Time) *VerificationRequestUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *VerificationRequestUpdate) SetAccountID(v xid.ID) *VerificationRequestUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *VerificationRequestUpdate) SetNillableAccountID(v *xid.ID) *VerificationRequestUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetBadge sets the "badge" field.
+func (_u *VerificationRequestUpdate) SetBadge(v string) *VerificationRequestUpdate {
+	_u.mutation.SetBadge(v)
+	return _u
+}
+
+// SetNillableBadge sets the "badge" field if the given value is not nil.
+func (_u *VerificationRequestUpdate) SetNillableBadge(v *string) *VerificationRequestUpdate {
+	if v != nil {
+		_u.SetBadge(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *VerificationRequestUpdate) SetNote(v string) *VerificationRequestUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *VerificationRequestUpdate) SetNillableNote(v *string) *VerificationRequestUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *VerificationRequestUpdate) ClearNote() *VerificationRequestUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VerificationRequestUpdate) SetStatus(v string) *VerificationRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VerificationRequestUpdate) SetNillableStatus(v *string) *VerificationRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *VerificationRequestUpdate) SetReviewedByID(v xid.ID) *VerificationRequestUpdate {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *VerificationRequestUpdate) SetNillableReviewedByID(v *xid.ID) *VerificationRequestUpdate {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *VerificationRequestUpdate) ClearReviewedByID() *VerificationRequestUpdate {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *VerificationRequestUpdate) SetReviewedAt(v time.Time) *VerificationRequestUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *VerificationRequestUpdate) SetNillableReviewedAt(v *time.Time) *VerificationRequestUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *VerificationRequestUpdate) ClearReviewedAt() *VerificationRequestUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *VerificationRequestUpdate) SetReason(v string) *VerificationRequestUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *VerificationRequestUpdate) SetNillableReason(v *string) *VerificationRequestUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *VerificationRequestUpdate) ClearReason() *VerificationRequestUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *VerificationRequestUpdate) SetAccount(v *Account) *VerificationRequestUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_u *VerificationRequestUpdate) SetReviewedBy(v *Account) *VerificationRequestUpdate {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the VerificationRequestMutation object of the builder.
+func (_u *VerificationRequestUpdate) Mutation() *VerificationRequestMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *VerificationRequestUpdate) ClearAccount() *VerificationRequestUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the Account entity.
+func (_u *VerificationRequestUpdate) ClearReviewedBy() *VerificationRequestUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VerificationRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VerificationRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VerificationRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VerificationRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VerificationRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := verificationrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VerificationRequestUpdate) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VerificationRequest.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *VerificationRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VerificationRequestUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *VerificationRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationrequest.Table, verificationrequest.Columns, sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(verificationrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Badge(); ok {
+		_spec.SetField(verificationrequest.FieldBadge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(verificationrequest.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(verificationrequest.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(verificationrequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(verificationrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(verificationrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(verificationrequest.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(verificationrequest.FieldReason, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.AccountTable,
+			Columns: []string{verificationrequest.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.AccountTable,
+			Columns: []string{verificationrequest.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.ReviewedByTable,
+			Columns: []string{verificationrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.ReviewedByTable,
+			Columns: []string{verificationrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VerificationRequestUpdateOne is the builder for updating a single VerificationRequest entity.
+type VerificationRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *VerificationRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VerificationRequestUpdateOne) SetUpdatedAt(v time.Time) *VerificationRequestUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *VerificationRequestUpdateOne) SetAccountID(v xid.ID) *VerificationRequestUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *VerificationRequestUpdateOne) SetNillableAccountID(v *xid.ID) *VerificationRequestUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetBadge sets the "badge" field.
+func (_u *VerificationRequestUpdateOne) SetBadge(v string) *VerificationRequestUpdateOne {
+	_u.mutation.SetBadge(v)
+	return _u
+}
+
+// SetNillableBadge sets the "badge" field if the given value is not nil.
+func (_u *VerificationRequestUpdateOne) SetNillableBadge(v *string) *VerificationRequestUpdateOne {
+	if v != nil {
+		_u.SetBadge(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *VerificationRequestUpdateOne) SetNote(v string) *VerificationRequestUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *VerificationRequestUpdateOne) SetNillableNote(v *string) *VerificationRequestUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *VerificationRequestUpdateOne) ClearNote() *VerificationRequestUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VerificationRequestUpdateOne) SetStatus(v string) *VerificationRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VerificationRequestUpdateOne) SetNillableStatus(v *string) *VerificationRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *VerificationRequestUpdateOne) SetReviewedByID(v xid.ID) *VerificationRequestUpdateOne {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *VerificationRequestUpdateOne) SetNillableReviewedByID(v *xid.ID) *VerificationRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *VerificationRequestUpdateOne) ClearReviewedByID() *VerificationRequestUpdateOne {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *VerificationRequestUpdateOne) SetReviewedAt(v time.Time) *VerificationRequestUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *VerificationRequestUpdateOne) SetNillableReviewedAt(v *time.Time) *VerificationRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *VerificationRequestUpdateOne) ClearReviewedAt() *VerificationRequestUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *VerificationRequestUpdateOne) SetReason(v string) *VerificationRequestUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *VerificationRequestUpdateOne) SetNillableReason(v *string) *VerificationRequestUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *VerificationRequestUpdateOne) ClearReason() *VerificationRequestUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *VerificationRequestUpdateOne) SetAccount(v *Account) *VerificationRequestUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the Account entity.
+func (_u *VerificationRequestUpdateOne) SetReviewedBy(v *Account) *VerificationRequestUpdateOne {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the VerificationRequestMutation object of the builder.
+func (_u *VerificationRequestUpdateOne) Mutation() *VerificationRequestMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *VerificationRequestUpdateOne) ClearAccount() *VerificationRequestUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the Account entity.
+func (_u *VerificationRequestUpdateOne) ClearReviewedBy() *VerificationRequestUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Where appends a list predicates to the VerificationRequestUpdate builder.
+func (_u *VerificationRequestUpdateOne) Where(ps ...predicate.VerificationRequest) *VerificationRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VerificationRequestUpdateOne) Select(field string, fields ...string) *VerificationRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VerificationRequest entity.
+func (_u *VerificationRequestUpdateOne) Save(ctx context.Context) (*VerificationRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VerificationRequestUpdateOne) SaveX(ctx context.Context) *VerificationRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VerificationRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VerificationRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VerificationRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := verificationrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VerificationRequestUpdateOne) check() error {
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VerificationRequest.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *VerificationRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VerificationRequestUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *VerificationRequestUpdateOne) sqlSave(ctx context.Context) (_node *VerificationRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationrequest.Table, verificationrequest.Columns, sqlgraph.NewFieldSpec(verificationrequest.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VerificationRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, verificationrequest.FieldID)
+		for _, f := range fields {
+			if !verificationrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != verificationrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(verificationrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Badge(); ok {
+		_spec.SetField(verificationrequest.FieldBadge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(verificationrequest.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(verificationrequest.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(verificationrequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(verificationrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(verificationrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(verificationrequest.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(verificationrequest.FieldReason, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.AccountTable,
+			Columns: []string{verificationrequest.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.AccountTable,
+			Columns: []string{verificationrequest.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.ReviewedByTable,
+			Columns: []string{verificationrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verificationrequest.ReviewedByTable,
+			Columns: []string{verificationrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &VerificationRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
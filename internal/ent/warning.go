@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/rs/xid"
+)
+
+// Warning is the model entity for the Warning schema.
+type Warning struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// IssuedByID holds the value of the "issued_by_id" field.
+	IssuedByID *xid.ID `json:"issued_by_id,omitempty"`
+	// One of minor, moderate or severe, each counts for a different number of points.
+	Severity string `json:"severity,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WarningQuery when eager-loading is set.
+	Edges        WarningEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WarningEdges holds the relations/edges for other nodes in the graph.
+type WarningEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// IssuedBy holds the value of the issued_by edge.
+	IssuedBy *Account `json:"issued_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WarningEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// IssuedByOrErr returns the IssuedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WarningEdges) IssuedByOrErr() (*Account, error) {
+	if e.IssuedBy != nil {
+		return e.IssuedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "issued_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Warning) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case warning.FieldIssuedByID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case warning.FieldSeverity, warning.FieldReason:
+			values[i] = new(sql.NullString)
+		case warning.FieldCreatedAt, warning.FieldExpiresAt, warning.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		case warning.FieldID, warning.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Warning fields.
+func (_m *Warning) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case warning.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case warning.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case warning.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case warning.FieldIssuedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_by_id", values[i])
+			} else if value.Valid {
+				_m.IssuedByID = new(xid.ID)
+				*_m.IssuedByID = *value.S.(*xid.ID)
+			}
+		case warning.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = value.String
+			}
+		case warning.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case warning.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case warning.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Warning.
+// This includes values selected through modifiers, order, etc.
+func (_m *Warning) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Warning entity.
+func (_m *Warning) QueryAccount() *AccountQuery {
+	return NewWarningClient(_m.config).QueryAccount(_m)
+}
+
+// QueryIssuedBy queries the "issued_by" edge of the Warning entity.
+func (_m *Warning) QueryIssuedBy() *AccountQuery {
+	return NewWarningClient(_m.config).QueryIssuedBy(_m)
+}
+
+// Update returns a builder for updating this Warning.
+// Note that you need to call Warning.Unwrap() before calling this method if this Warning
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Warning) Update() *WarningUpdateOne {
+	return NewWarningClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Warning entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Warning) Unwrap() *Warning {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Warning is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Warning) String() string {
+	var builder strings.Builder
+	builder.WriteString("Warning(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.IssuedByID; v != nil {
+		builder.WriteString("issued_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(_m.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Warnings is a parsable slice of Warning.
+type Warnings []*Warning
@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package warning
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldAccountID, v))
+}
+
+// IssuedByID applies equality check predicate on the "issued_by_id" field. It's identical to IssuedByIDEQ.
+func IssuedByID(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldIssuedByID, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldSeverity, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldReason, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// IssuedByIDEQ applies the EQ predicate on the "issued_by_id" field.
+func IssuedByIDEQ(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldIssuedByID, v))
+}
+
+// IssuedByIDNEQ applies the NEQ predicate on the "issued_by_id" field.
+func IssuedByIDNEQ(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldIssuedByID, v))
+}
+
+// IssuedByIDIn applies the In predicate on the "issued_by_id" field.
+func IssuedByIDIn(vs ...xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldIssuedByID, vs...))
+}
+
+// IssuedByIDNotIn applies the NotIn predicate on the "issued_by_id" field.
+func IssuedByIDNotIn(vs ...xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldIssuedByID, vs...))
+}
+
+// IssuedByIDGT applies the GT predicate on the "issued_by_id" field.
+func IssuedByIDGT(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldIssuedByID, v))
+}
+
+// IssuedByIDGTE applies the GTE predicate on the "issued_by_id" field.
+func IssuedByIDGTE(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldIssuedByID, v))
+}
+
+// IssuedByIDLT applies the LT predicate on the "issued_by_id" field.
+func IssuedByIDLT(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldIssuedByID, v))
+}
+
+// IssuedByIDLTE applies the LTE predicate on the "issued_by_id" field.
+func IssuedByIDLTE(v xid.ID) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldIssuedByID, v))
+}
+
+// IssuedByIDContains applies the Contains predicate on the "issued_by_id" field.
+func IssuedByIDContains(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldContains(FieldIssuedByID, vc))
+}
+
+// IssuedByIDHasPrefix applies the HasPrefix predicate on the "issued_by_id" field.
+func IssuedByIDHasPrefix(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldHasPrefix(FieldIssuedByID, vc))
+}
+
+// IssuedByIDHasSuffix applies the HasSuffix predicate on the "issued_by_id" field.
+func IssuedByIDHasSuffix(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldHasSuffix(FieldIssuedByID, vc))
+}
+
+// IssuedByIDIsNil applies the IsNil predicate on the "issued_by_id" field.
+func IssuedByIDIsNil() predicate.Warning {
+	return predicate.Warning(sql.FieldIsNull(FieldIssuedByID))
+}
+
+// IssuedByIDNotNil applies the NotNil predicate on the "issued_by_id" field.
+func IssuedByIDNotNil() predicate.Warning {
+	return predicate.Warning(sql.FieldNotNull(FieldIssuedByID))
+}
+
+// IssuedByIDEqualFold applies the EqualFold predicate on the "issued_by_id" field.
+func IssuedByIDEqualFold(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldEqualFold(FieldIssuedByID, vc))
+}
+
+// IssuedByIDContainsFold applies the ContainsFold predicate on the "issued_by_id" field.
+func IssuedByIDContainsFold(v xid.ID) predicate.Warning {
+	vc := v.String()
+	return predicate.Warning(sql.FieldContainsFold(FieldIssuedByID, vc))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Warning {
+	return predicate.Warning(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Warning {
+	return predicate.Warning(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Warning {
+	return predicate.Warning(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.Warning {
+	return predicate.Warning(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.Warning {
+	return predicate.Warning(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.Warning {
+	return predicate.Warning(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Warning {
+	return predicate.Warning(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Warning {
+	return predicate.Warning(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIssuedBy applies the HasEdge predicate on the "issued_by" edge.
+func HasIssuedBy() predicate.Warning {
+	return predicate.Warning(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, IssuedByTable, IssuedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIssuedByWith applies the HasEdge predicate on the "issued_by" edge with a given conditions (other predicates).
+func HasIssuedByWith(preds ...predicate.Account) predicate.Warning {
+	return predicate.Warning(func(s *sql.Selector) {
+		step := newIssuedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Warning) predicate.Warning {
+	return predicate.Warning(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Warning) predicate.Warning {
+	return predicate.Warning(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Warning) predicate.Warning {
+	return predicate.Warning(sql.NotPredicates(p))
+}
@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/warning"
+	"github.com/rs/xid"
+)
+
+// WarningCreate is the builder for creating a Warning entity.
+type WarningCreate struct {
+	config
+	mutation *WarningMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WarningCreate) SetCreatedAt(v time.Time) *WarningCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WarningCreate) SetNillableCreatedAt(v *time.Time) *WarningCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *WarningCreate) SetAccountID(v xid.ID) *WarningCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetIssuedByID sets the "issued_by_id" field.
+func (_c *WarningCreate) SetIssuedByID(v xid.ID) *WarningCreate {
+	_c.mutation.SetIssuedByID(v)
+	return _c
+}
+
+// SetNillableIssuedByID sets the "issued_by_id" field if the given value is not nil.
+func (_c *WarningCreate) SetNillableIssuedByID(v *xid.ID) *WarningCreate {
+	if v != nil {
+		_c.SetIssuedByID(*v)
+	}
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *WarningCreate) SetSeverity(v string) *WarningCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *WarningCreate) SetReason(v string) *WarningCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *WarningCreate) SetExpiresAt(v time.Time) *WarningCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *WarningCreate) SetNillableExpiresAt(v *time.Time) *WarningCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *WarningCreate) SetRevokedAt(v time.Time) *WarningCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *WarningCreate) SetNillableRevokedAt(v *time.Time) *WarningCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WarningCreate) SetID(v xid.ID) *WarningCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WarningCreate) SetNillableID(v *xid.ID) *WarningCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *WarningCreate) SetAccount(v *Account) *WarningCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetIssuedBy sets the "issued_by" edge to the Account entity.
+func (_c *WarningCreate) SetIssuedBy(v *Account) *WarningCreate {
+	return _c.SetIssuedByID(v.ID)
+}
+
+// Mutation returns the WarningMutation object of the builder.
+func (_c *WarningCreate) Mutation() *WarningMutation {
+	return _c.mutation
+}
+
+// Save creates the Warning in the database.
+func (_c *WarningCreate) Save(ctx context.Context) (*Warning, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WarningCreate) SaveX(ctx context.Context) *Warning {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WarningCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WarningCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WarningCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := warning.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := warning.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WarningCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Warning.created_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Warning.account_id"`)}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "Warning.severity"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Warning.reason"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := warning.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Warning.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Warning.account"`)}
+	}
+	return nil
+}
+
+func (_c *WarningCreate) sqlSave(ctx context.Context) (*Warning, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WarningCreate) createSpec() (*Warning, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Warning{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(warning.Table, sqlgraph.NewFieldSpec(warning.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(warning.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(warning.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(warning.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(warning.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(warning.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warning.AccountTable,
+			Columns: []string{warning.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IssuedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warning.IssuedByTable,
+			Columns: []string{warning.IssuedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IssuedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Warning.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WarningUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WarningCreate) OnConflict(opts ...sql.ConflictOption) *WarningUpsertOne {
+	_c.conflict = opts
+	return &WarningUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Warning.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WarningCreate) OnConflictColumns(columns ...string) *WarningUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WarningUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WarningUpsertOne is the builder for "upsert"-ing
+	//  one Warning node.
+	WarningUpsertOne struct {
+		create *WarningCreate
+	}
+
+	// WarningUpsert is the "OnConflict" setter.
+	WarningUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *WarningUpsert) SetAccountID(v xid.ID) *WarningUpsert {
+	u.Set(warning.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *WarningUpsert) UpdateAccountID() *WarningUpsert {
+	u.SetExcluded(warning.FieldAccountID)
+	return u
+}
+
+// SetIssuedByID sets the "issued_by_id" field.
+func (u *WarningUpsert) SetIssuedByID(v xid.ID) *WarningUpsert {
+	u.Set(warning.FieldIssuedByID, v)
+	return u
+}
+
+// UpdateIssuedByID sets the "issued_by_id" field to the value that was provided on create.
+func (u *WarningUpsert) UpdateIssuedByID() *WarningUpsert {
+	u.SetExcluded(warning.FieldIssuedByID)
+	return u
+}
+
+// ClearIssuedByID clears the value of the "issued_by_id" field.
+func (u *WarningUpsert) ClearIssuedByID() *WarningUpsert {
+	u.SetNull(warning.FieldIssuedByID)
+	return u
+}
+
+// SetSeverity sets the "severity" field.
+func (u *WarningUpsert) SetSeverity(v string) *WarningUpsert {
+	u.Set(warning.FieldSeverity, v)
+	return u
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *WarningUpsert) UpdateSeverity() *WarningUpsert {
+	u.SetExcluded(warning.FieldSeverity)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *WarningUpsert) SetReason(v string) *WarningUpsert {
+	u.Set(warning.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *WarningUpsert) UpdateReason() *WarningUpsert {
+	u.SetExcluded(warning.FieldReason)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *WarningUpsert) SetExpiresAt(v time.Time) *WarningUpsert {
+	u.Set(warning.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *WarningUpsert) UpdateExpiresAt() *WarningUpsert {
+	u.SetExcluded(warning.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *WarningUpsert) ClearExpiresAt() *WarningUpsert {
+	u.SetNull(warning.FieldExpiresAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *WarningUpsert) SetRevokedAt(v time.Time) *WarningUpsert {
+	u.Set(warning.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *WarningUpsert) UpdateRevokedAt() *WarningUpsert {
+	u.SetExcluded(warning.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *WarningUpsert) ClearRevokedAt() *WarningUpsert {
+	u.SetNull(warning.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Warning.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(warning.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WarningUpsertOne) UpdateNewValues() *WarningUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(warning.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(warning.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Warning.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WarningUpsertOne) Ignore() *WarningUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WarningUpsertOne) DoNothing() *WarningUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WarningCreate.OnConflict
+// documentation for more info.
+func (u *WarningUpsertOne) Update(set func(*WarningUpsert)) *WarningUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WarningUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *WarningUpsertOne) SetAccountID(v xid.ID) *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *WarningUpsertOne) UpdateAccountID() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetIssuedByID sets the "issued_by_id" field.
+func (u *WarningUpsertOne) SetIssuedByID(v xid.ID) *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetIssuedByID(v)
+	})
+}
+
+// UpdateIssuedByID sets the "issued_by_id" field to the value that was provided on create.
+func (u *WarningUpsertOne) UpdateIssuedByID() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateIssuedByID()
+	})
+}
+
+// ClearIssuedByID clears the value of the "issued_by_id" field.
+func (u *WarningUpsertOne) ClearIssuedByID() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.ClearIssuedByID()
+	})
+}
+
+// SetSeverity sets the "severity" field.
+func (u *WarningUpsertOne) SetSeverity(v string) *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetSeverity(v)
+	})
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *WarningUpsertOne) UpdateSeverity() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateSeverity()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *WarningUpsertOne) SetReason(v string) *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *WarningUpsertOne) UpdateReason() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *WarningUpsertOne) SetExpiresAt(v time.Time) *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *WarningUpsertOne) UpdateExpiresAt() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *WarningUpsertOne) ClearExpiresAt() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *WarningUpsertOne) SetRevokedAt(v time.Time) *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *WarningUpsertOne) UpdateRevokedAt() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *WarningUpsertOne) ClearRevokedAt() *WarningUpsertOne {
+	return u.Update(func(s *WarningUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WarningUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WarningCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WarningUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WarningUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WarningUpsertOne.ID is not supported by MySQL driver. Use WarningUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WarningUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WarningCreateBulk is the builder for creating many Warning entities in bulk.
+type WarningCreateBulk struct {
+	config
+	err      error
+	builders []*WarningCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Warning entities in the database.
+func (_c *WarningCreateBulk) Save(ctx context.Context) ([]*Warning, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Warning, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WarningMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WarningCreateBulk) SaveX(ctx context.Context) []*Warning {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WarningCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WarningCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Warning.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WarningUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WarningCreateBulk) OnConflict(opts ...sql.ConflictOption) *WarningUpsertBulk {
+	_c.conflict = opts
+	return &WarningUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Warning.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WarningCreateBulk) OnConflictColumns(columns ...string) *WarningUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WarningUpsertBulk{
+		create: _c,
+	}
+}
+
+// WarningUpsertBulk is the builder for "upsert"-ing
+// a bulk of Warning nodes.
+type WarningUpsertBulk struct {
+	create *WarningCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Warning.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(warning.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WarningUpsertBulk) UpdateNewValues() *WarningUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(warning.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(warning.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Warning.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WarningUpsertBulk) Ignore() *WarningUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WarningUpsertBulk) DoNothing() *WarningUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WarningCreateBulk.OnConflict
+// documentation for more info.
+func (u *WarningUpsertBulk) Update(set func(*WarningUpsert)) *WarningUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WarningUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *WarningUpsertBulk) SetAccountID(v xid.ID) *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *WarningUpsertBulk) UpdateAccountID() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetIssuedByID sets the "issued_by_id" field.
+func (u *WarningUpsertBulk) SetIssuedByID(v xid.ID) *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetIssuedByID(v)
+	})
+}
+
+// UpdateIssuedByID sets the "issued_by_id" field to the value that was provided on create.
+func (u *WarningUpsertBulk) UpdateIssuedByID() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateIssuedByID()
+	})
+}
+
+// ClearIssuedByID clears the value of the "issued_by_id" field.
+func (u *WarningUpsertBulk) ClearIssuedByID() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.ClearIssuedByID()
+	})
+}
+
+// SetSeverity sets the "severity" field.
+func (u *WarningUpsertBulk) SetSeverity(v string) *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetSeverity(v)
+	})
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *WarningUpsertBulk) UpdateSeverity() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateSeverity()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *WarningUpsertBulk) SetReason(v string) *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *WarningUpsertBulk) UpdateReason() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *WarningUpsertBulk) SetExpiresAt(v time.Time) *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *WarningUpsertBulk) UpdateExpiresAt() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *WarningUpsertBulk) ClearExpiresAt() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *WarningUpsertBulk) SetRevokedAt(v time.Time) *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *WarningUpsertBulk) UpdateRevokedAt() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *WarningUpsertBulk) ClearRevokedAt() *WarningUpsertBulk {
+	return u.Update(func(s *WarningUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WarningUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WarningCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WarningCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WarningUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wordfilter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Term applies equality check predicate on the "term" field. It's identical to TermEQ.
+func Term(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldTerm, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldAction, v))
+}
+
+// Replacement applies equality check predicate on the "replacement" field. It's identical to ReplacementEQ.
+func Replacement(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldReplacement, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldScope, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TermEQ applies the EQ predicate on the "term" field.
+func TermEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldTerm, v))
+}
+
+// TermNEQ applies the NEQ predicate on the "term" field.
+func TermNEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldTerm, v))
+}
+
+// TermIn applies the In predicate on the "term" field.
+func TermIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIn(FieldTerm, vs...))
+}
+
+// TermNotIn applies the NotIn predicate on the "term" field.
+func TermNotIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotIn(FieldTerm, vs...))
+}
+
+// TermGT applies the GT predicate on the "term" field.
+func TermGT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGT(FieldTerm, v))
+}
+
+// TermGTE applies the GTE predicate on the "term" field.
+func TermGTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGTE(FieldTerm, v))
+}
+
+// TermLT applies the LT predicate on the "term" field.
+func TermLT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLT(FieldTerm, v))
+}
+
+// TermLTE applies the LTE predicate on the "term" field.
+func TermLTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLTE(FieldTerm, v))
+}
+
+// TermContains applies the Contains predicate on the "term" field.
+func TermContains(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContains(FieldTerm, v))
+}
+
+// TermHasPrefix applies the HasPrefix predicate on the "term" field.
+func TermHasPrefix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasPrefix(FieldTerm, v))
+}
+
+// TermHasSuffix applies the HasSuffix predicate on the "term" field.
+func TermHasSuffix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasSuffix(FieldTerm, v))
+}
+
+// TermEqualFold applies the EqualFold predicate on the "term" field.
+func TermEqualFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEqualFold(FieldTerm, v))
+}
+
+// TermContainsFold applies the ContainsFold predicate on the "term" field.
+func TermContainsFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContainsFold(FieldTerm, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ReplacementEQ applies the EQ predicate on the "replacement" field.
+func ReplacementEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldReplacement, v))
+}
+
+// ReplacementNEQ applies the NEQ predicate on the "replacement" field.
+func ReplacementNEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldReplacement, v))
+}
+
+// ReplacementIn applies the In predicate on the "replacement" field.
+func ReplacementIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIn(FieldReplacement, vs...))
+}
+
+// ReplacementNotIn applies the NotIn predicate on the "replacement" field.
+func ReplacementNotIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotIn(FieldReplacement, vs...))
+}
+
+// ReplacementGT applies the GT predicate on the "replacement" field.
+func ReplacementGT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGT(FieldReplacement, v))
+}
+
+// ReplacementGTE applies the GTE predicate on the "replacement" field.
+func ReplacementGTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGTE(FieldReplacement, v))
+}
+
+// ReplacementLT applies the LT predicate on the "replacement" field.
+func ReplacementLT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLT(FieldReplacement, v))
+}
+
+// ReplacementLTE applies the LTE predicate on the "replacement" field.
+func ReplacementLTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLTE(FieldReplacement, v))
+}
+
+// ReplacementContains applies the Contains predicate on the "replacement" field.
+func ReplacementContains(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContains(FieldReplacement, v))
+}
+
+// ReplacementHasPrefix applies the HasPrefix predicate on the "replacement" field.
+func ReplacementHasPrefix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasPrefix(FieldReplacement, v))
+}
+
+// ReplacementHasSuffix applies the HasSuffix predicate on the "replacement" field.
+func ReplacementHasSuffix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasSuffix(FieldReplacement, v))
+}
+
+// ReplacementIsNil applies the IsNil predicate on the "replacement" field.
+func ReplacementIsNil() predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIsNull(FieldReplacement))
+}
+
+// ReplacementNotNil applies the NotNil predicate on the "replacement" field.
+func ReplacementNotNil() predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotNull(FieldReplacement))
+}
+
+// ReplacementEqualFold applies the EqualFold predicate on the "replacement" field.
+func ReplacementEqualFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEqualFold(FieldReplacement, v))
+}
+
+// ReplacementContainsFold applies the ContainsFold predicate on the "replacement" field.
+func ReplacementContainsFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContainsFold(FieldReplacement, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldContainsFold(FieldScope, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.WordFilter {
+	return predicate.WordFilter(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WordFilter) predicate.WordFilter {
+	return predicate.WordFilter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WordFilter) predicate.WordFilter {
+	return predicate.WordFilter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WordFilter) predicate.WordFilter {
+	return predicate.WordFilter(sql.NotPredicates(p))
+}
@@ -0,0 +1,859 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/wordfilter"
+	"github.com/rs/xid"
+)
+
+// WordFilterCreate is the builder for creating a WordFilter entity.
+type WordFilterCreate struct {
+	config
+	mutation *WordFilterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WordFilterCreate) SetCreatedAt(v time.Time) *WordFilterCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WordFilterCreate) SetNillableCreatedAt(v *time.Time) *WordFilterCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WordFilterCreate) SetUpdatedAt(v time.Time) *WordFilterCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WordFilterCreate) SetNillableUpdatedAt(v *time.Time) *WordFilterCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTerm sets the "term" field.
+func (_c *WordFilterCreate) SetTerm(v string) *WordFilterCreate {
+	_c.mutation.SetTerm(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *WordFilterCreate) SetAction(v string) *WordFilterCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetReplacement sets the "replacement" field.
+func (_c *WordFilterCreate) SetReplacement(v string) *WordFilterCreate {
+	_c.mutation.SetReplacement(v)
+	return _c
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_c *WordFilterCreate) SetNillableReplacement(v *string) *WordFilterCreate {
+	if v != nil {
+		_c.SetReplacement(*v)
+	}
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *WordFilterCreate) SetScope(v string) *WordFilterCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *WordFilterCreate) SetEnabled(v bool) *WordFilterCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *WordFilterCreate) SetNillableEnabled(v *bool) *WordFilterCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WordFilterCreate) SetID(v xid.ID) *WordFilterCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WordFilterCreate) SetNillableID(v *xid.ID) *WordFilterCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WordFilterMutation object of the builder.
+func (_c *WordFilterCreate) Mutation() *WordFilterMutation {
+	return _c.mutation
+}
+
+// Save creates the WordFilter in the database.
+func (_c *WordFilterCreate) Save(ctx context.Context) (*WordFilter, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WordFilterCreate) SaveX(ctx context.Context) *WordFilter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WordFilterCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WordFilterCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WordFilterCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wordfilter.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := wordfilter.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := wordfilter.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := wordfilter.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WordFilterCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WordFilter.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WordFilter.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Term(); !ok {
+		return &ValidationError{Name: "term", err: errors.New(`ent: missing required field "WordFilter.term"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "WordFilter.action"`)}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "WordFilter.scope"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "WordFilter.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := wordfilter.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "WordFilter.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *WordFilterCreate) sqlSave(ctx context.Context) (*WordFilter, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WordFilterCreate) createSpec() (*WordFilter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WordFilter{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wordfilter.Table, sqlgraph.NewFieldSpec(wordfilter.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wordfilter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(wordfilter.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Term(); ok {
+		_spec.SetField(wordfilter.FieldTerm, field.TypeString, value)
+		_node.Term = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(wordfilter.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Replacement(); ok {
+		_spec.SetField(wordfilter.FieldReplacement, field.TypeString, value)
+		_node.Replacement = &value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(wordfilter.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(wordfilter.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WordFilter.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WordFilterUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WordFilterCreate) OnConflict(opts ...sql.ConflictOption) *WordFilterUpsertOne {
+	_c.conflict = opts
+	return &WordFilterUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WordFilter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WordFilterCreate) OnConflictColumns(columns ...string) *WordFilterUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WordFilterUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WordFilterUpsertOne is the builder for "upsert"-ing
+	//  one WordFilter node.
+	WordFilterUpsertOne struct {
+		create *WordFilterCreate
+	}
+
+	// WordFilterUpsert is the "OnConflict" setter.
+	WordFilterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WordFilterUpsert) SetUpdatedAt(v time.Time) *WordFilterUpsert {
+	u.Set(wordfilter.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WordFilterUpsert) UpdateUpdatedAt() *WordFilterUpsert {
+	u.SetExcluded(wordfilter.FieldUpdatedAt)
+	return u
+}
+
+// SetTerm sets the "term" field.
+func (u *WordFilterUpsert) SetTerm(v string) *WordFilterUpsert {
+	u.Set(wordfilter.FieldTerm, v)
+	return u
+}
+
+// UpdateTerm sets the "term" field to the value that was provided on create.
+func (u *WordFilterUpsert) UpdateTerm() *WordFilterUpsert {
+	u.SetExcluded(wordfilter.FieldTerm)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *WordFilterUpsert) SetAction(v string) *WordFilterUpsert {
+	u.Set(wordfilter.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *WordFilterUpsert) UpdateAction() *WordFilterUpsert {
+	u.SetExcluded(wordfilter.FieldAction)
+	return u
+}
+
+// SetReplacement sets the "replacement" field.
+func (u *WordFilterUpsert) SetReplacement(v string) *WordFilterUpsert {
+	u.Set(wordfilter.FieldReplacement, v)
+	return u
+}
+
+// UpdateReplacement sets the "replacement" field to the value that was provided on create.
+func (u *WordFilterUpsert) UpdateReplacement() *WordFilterUpsert {
+	u.SetExcluded(wordfilter.FieldReplacement)
+	return u
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (u *WordFilterUpsert) ClearReplacement() *WordFilterUpsert {
+	u.SetNull(wordfilter.FieldReplacement)
+	return u
+}
+
+// SetScope sets the "scope" field.
+func (u *WordFilterUpsert) SetScope(v string) *WordFilterUpsert {
+	u.Set(wordfilter.FieldScope, v)
+	return u
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *WordFilterUpsert) UpdateScope() *WordFilterUpsert {
+	u.SetExcluded(wordfilter.FieldScope)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WordFilterUpsert) SetEnabled(v bool) *WordFilterUpsert {
+	u.Set(wordfilter.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WordFilterUpsert) UpdateEnabled() *WordFilterUpsert {
+	u.SetExcluded(wordfilter.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WordFilter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wordfilter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WordFilterUpsertOne) UpdateNewValues() *WordFilterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(wordfilter.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wordfilter.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WordFilter.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WordFilterUpsertOne) Ignore() *WordFilterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WordFilterUpsertOne) DoNothing() *WordFilterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WordFilterCreate.OnConflict
+// documentation for more info.
+func (u *WordFilterUpsertOne) Update(set func(*WordFilterUpsert)) *WordFilterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WordFilterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WordFilterUpsertOne) SetUpdatedAt(v time.Time) *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WordFilterUpsertOne) UpdateUpdatedAt() *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTerm sets the "term" field.
+func (u *WordFilterUpsertOne) SetTerm(v string) *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetTerm(v)
+	})
+}
+
+// UpdateTerm sets the "term" field to the value that was provided on create.
+func (u *WordFilterUpsertOne) UpdateTerm() *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateTerm()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *WordFilterUpsertOne) SetAction(v string) *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *WordFilterUpsertOne) UpdateAction() *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetReplacement sets the "replacement" field.
+func (u *WordFilterUpsertOne) SetReplacement(v string) *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetReplacement(v)
+	})
+}
+
+// UpdateReplacement sets the "replacement" field to the value that was provided on create.
+func (u *WordFilterUpsertOne) UpdateReplacement() *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateReplacement()
+	})
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (u *WordFilterUpsertOne) ClearReplacement() *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.ClearReplacement()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *WordFilterUpsertOne) SetScope(v string) *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *WordFilterUpsertOne) UpdateScope() *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WordFilterUpsertOne) SetEnabled(v bool) *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WordFilterUpsertOne) UpdateEnabled() *WordFilterUpsertOne {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WordFilterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WordFilterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WordFilterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WordFilterUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WordFilterUpsertOne.ID is not supported by MySQL driver. Use WordFilterUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WordFilterUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WordFilterCreateBulk is the builder for creating many WordFilter entities in bulk.
+type WordFilterCreateBulk struct {
+	config
+	err      error
+	builders []*WordFilterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WordFilter entities in the database.
+func (_c *WordFilterCreateBulk) Save(ctx context.Context) ([]*WordFilter, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WordFilter, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WordFilterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WordFilterCreateBulk) SaveX(ctx context.Context) []*WordFilter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WordFilterCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WordFilterCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WordFilter.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WordFilterUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WordFilterCreateBulk) OnConflict(opts ...sql.ConflictOption) *WordFilterUpsertBulk {
+	_c.conflict = opts
+	return &WordFilterUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WordFilter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WordFilterCreateBulk) OnConflictColumns(columns ...string) *WordFilterUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WordFilterUpsertBulk{
+		create: _c,
+	}
+}
+
+// WordFilterUpsertBulk is the builder for "upsert"-ing
+// a bulk of WordFilter nodes.
+type WordFilterUpsertBulk struct {
+	create *WordFilterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WordFilter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wordfilter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WordFilterUpsertBulk) UpdateNewValues() *WordFilterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(wordfilter.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wordfilter.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WordFilter.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WordFilterUpsertBulk) Ignore() *WordFilterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WordFilterUpsertBulk) DoNothing() *WordFilterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WordFilterCreateBulk.OnConflict
+// documentation for more info.
+func (u *WordFilterUpsertBulk) Update(set func(*WordFilterUpsert)) *WordFilterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WordFilterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WordFilterUpsertBulk) SetUpdatedAt(v time.Time) *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WordFilterUpsertBulk) UpdateUpdatedAt() *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTerm sets the "term" field.
+func (u *WordFilterUpsertBulk) SetTerm(v string) *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetTerm(v)
+	})
+}
+
+// UpdateTerm sets the "term" field to the value that was provided on create.
+func (u *WordFilterUpsertBulk) UpdateTerm() *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateTerm()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *WordFilterUpsertBulk) SetAction(v string) *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *WordFilterUpsertBulk) UpdateAction() *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetReplacement sets the "replacement" field.
+func (u *WordFilterUpsertBulk) SetReplacement(v string) *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetReplacement(v)
+	})
+}
+
+// UpdateReplacement sets the "replacement" field to the value that was provided on create.
+func (u *WordFilterUpsertBulk) UpdateReplacement() *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateReplacement()
+	})
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (u *WordFilterUpsertBulk) ClearReplacement() *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.ClearReplacement()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *WordFilterUpsertBulk) SetScope(v string) *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *WordFilterUpsertBulk) UpdateScope() *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WordFilterUpsertBulk) SetEnabled(v bool) *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WordFilterUpsertBulk) UpdateEnabled() *WordFilterUpsertBulk {
+	return u.Update(func(s *WordFilterUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WordFilterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WordFilterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WordFilterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WordFilterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/wordfilter"
+)
+
+// WordFilterUpdate is the builder for updating WordFilter entities.
+type WordFilterUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WordFilterMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WordFilterUpdate builder.
+func (_u *WordFilterUpdate) Where(ps ...predicate.WordFilter) *WordFilterUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WordFilterUpdate) SetUpdatedAt(v time.Time) *WordFilterUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTerm sets the "term" field.
+func (_u *WordFilterUpdate) SetTerm(v string) *WordFilterUpdate {
+	_u.mutation.SetTerm(v)
+	return _u
+}
+
+// SetNillableTerm sets the "term" field if the given value is not nil.
+func (_u *WordFilterUpdate) SetNillableTerm(v *string) *WordFilterUpdate {
+	if v != nil {
+		_u.SetTerm(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *WordFilterUpdate) SetAction(v string) *WordFilterUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *WordFilterUpdate) SetNillableAction(v *string) *WordFilterUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *WordFilterUpdate) SetReplacement(v string) *WordFilterUpdate {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *WordFilterUpdate) SetNillableReplacement(v *string) *WordFilterUpdate {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (_u *WordFilterUpdate) ClearReplacement() *WordFilterUpdate {
+	_u.mutation.ClearReplacement()
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *WordFilterUpdate) SetScope(v string) *WordFilterUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *WordFilterUpdate) SetNillableScope(v *string) *WordFilterUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *WordFilterUpdate) SetEnabled(v bool) *WordFilterUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *WordFilterUpdate) SetNillableEnabled(v *bool) *WordFilterUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WordFilterMutation object of the builder.
+func (_u *WordFilterUpdate) Mutation() *WordFilterMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WordFilterUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WordFilterUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WordFilterUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WordFilterUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WordFilterUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wordfilter.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WordFilterUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WordFilterUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WordFilterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wordfilter.Table, wordfilter.Columns, sqlgraph.NewFieldSpec(wordfilter.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wordfilter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Term(); ok {
+		_spec.SetField(wordfilter.FieldTerm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(wordfilter.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(wordfilter.FieldReplacement, field.TypeString, value)
+	}
+	if _u.mutation.ReplacementCleared() {
+		_spec.ClearField(wordfilter.FieldReplacement, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(wordfilter.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(wordfilter.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordfilter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WordFilterUpdateOne is the builder for updating a single WordFilter entity.
+type WordFilterUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WordFilterMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WordFilterUpdateOne) SetUpdatedAt(v time.Time) *WordFilterUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTerm sets the "term" field.
+func (_u *WordFilterUpdateOne) SetTerm(v string) *WordFilterUpdateOne {
+	_u.mutation.SetTerm(v)
+	return _u
+}
+
+// SetNillableTerm sets the "term" field if the given value is not nil.
+func (_u *WordFilterUpdateOne) SetNillableTerm(v *string) *WordFilterUpdateOne {
+	if v != nil {
+		_u.SetTerm(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *WordFilterUpdateOne) SetAction(v string) *WordFilterUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *WordFilterUpdateOne) SetNillableAction(v *string) *WordFilterUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *WordFilterUpdateOne) SetReplacement(v string) *WordFilterUpdateOne {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *WordFilterUpdateOne) SetNillableReplacement(v *string) *WordFilterUpdateOne {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (_u *WordFilterUpdateOne) ClearReplacement() *WordFilterUpdateOne {
+	_u.mutation.ClearReplacement()
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *WordFilterUpdateOne) SetScope(v string) *WordFilterUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *WordFilterUpdateOne) SetNillableScope(v *string) *WordFilterUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *WordFilterUpdateOne) SetEnabled(v bool) *WordFilterUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *WordFilterUpdateOne) SetNillableEnabled(v *bool) *WordFilterUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WordFilterMutation object of the builder.
+func (_u *WordFilterUpdateOne) Mutation() *WordFilterMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WordFilterUpdate builder.
+func (_u *WordFilterUpdateOne) Where(ps ...predicate.WordFilter) *WordFilterUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WordFilterUpdateOne) Select(field string, fields ...string) *WordFilterUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WordFilter entity.
+func (_u *WordFilterUpdateOne) Save(ctx context.Context) (*WordFilter, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WordFilterUpdateOne) SaveX(ctx context.Context) *WordFilter {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WordFilterUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WordFilterUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WordFilterUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wordfilter.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WordFilterUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WordFilterUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WordFilterUpdateOne) sqlSave(ctx context.Context) (_node *WordFilter, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wordfilter.Table, wordfilter.Columns, sqlgraph.NewFieldSpec(wordfilter.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WordFilter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wordfilter.FieldID)
+		for _, f := range fields {
+			if !wordfilter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wordfilter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wordfilter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Term(); ok {
+		_spec.SetField(wordfilter.FieldTerm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(wordfilter.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(wordfilter.FieldReplacement, field.TypeString, value)
+	}
+	if _u.mutation.ReplacementCleared() {
+		_spec.ClearField(wordfilter.FieldReplacement, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(wordfilter.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(wordfilter.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &WordFilter{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordfilter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
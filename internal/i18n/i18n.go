@@ -0,0 +1,272 @@
+// Package i18n translates text the server writes for people to read, such as
+// emails, notifications and error messages. Messages are identified by their
+// English text, which is also what's used when there's no translation, so any
+// string can be passed through T whether or not a catalog has it yet.
+//
+// Catalogs are JSON files in the locales directory, one for each language and
+// named after its BCP 47 tag, mapping English messages to their translation.
+// Placeholders are written as {name} and must appear in the translation too:
+//
+//	{
+//	  "{name} replied to your thread": "{name} hat auf dein Thema geantwortet",
+//	  "You have {count} unread notifications.": {
+//	    "one": "Du hast {count} ungelesene Benachrichtigung.",
+//	    "other": "Du hast {count} ungelesene Benachrichtigungen."
+//	  }
+//	}
+//
+// Messages which depend on a number are objects of CLDR plural categories. An
+// empty translation is treated as missing, so a catalog may list messages which
+// haven't been translated yet.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+//go:embed locales/*.json
+var files embed.FS
+
+// Default is the language messages are written in.
+var Default = language.English
+
+var (
+	catalogs  = mustLoad()
+	supported = supportedTags()
+	matcher   = language.NewMatcher(supported)
+)
+
+type catalog map[string]message
+
+type message struct {
+	text  string
+	forms map[string]string
+}
+
+func (m *message) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &m.text); err == nil {
+		return nil
+	}
+	return json.Unmarshal(b, &m.forms)
+}
+
+func (m message) MarshalJSON() ([]byte, error) {
+	if m.forms != nil {
+		return json.Marshal(m.forms)
+	}
+	return json.Marshal(m.text)
+}
+
+func mustLoad() map[language.Tag]catalog {
+	cs, err := load()
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+func load() (map[language.Tag]catalog, error) {
+	entries, err := files.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+
+	cs := map[language.Tag]catalog{}
+	for _, e := range entries {
+		name := strings.TrimSuffix(e.Name(), ".json")
+
+		tag, err := language.Parse(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Name(), err)
+		}
+
+		b, err := files.ReadFile(path.Join("locales", e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		c := catalog{}
+		if err := json.Unmarshal(b, &c); err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Name(), err)
+		}
+
+		cs[tag] = c
+	}
+
+	return cs, nil
+}
+
+func supportedTags() []language.Tag {
+	tags := []language.Tag{Default}
+	for t := range catalogs {
+		if t != Default {
+			tags = append(tags, t)
+		}
+	}
+	slices.SortFunc(tags[1:], func(a, b language.Tag) int { return strings.Compare(a.String(), b.String()) })
+	return tags
+}
+
+// Supported lists the languages there's a catalog for, starting with Default.
+func Supported() []language.Tag {
+	return slices.Clone(supported)
+}
+
+// Normalise returns the canonical form of a locale preference such as "de-AT".
+// It's false if it's malformed or isn't close to any supported language. The
+// preference itself is kept rather than what it matched, so a more specific
+// catalog is used if one is added later.
+func Normalise(s string) (string, bool) {
+	tag, err := language.Parse(s)
+	if err != nil {
+		return "", false
+	}
+
+	if _, ok := Match(tag.String()); !ok {
+		return "", false
+	}
+
+	return tag.String(), true
+}
+
+// Match picks the supported language closest to the given preferences, which
+// may be tags or Accept-Language header values. It's false when none of them
+// are close to a supported language.
+func Match(preferences ...string) (language.Tag, bool) {
+	tags := []language.Tag{}
+	for _, p := range preferences {
+		if p == "" {
+			continue
+		}
+		parsed, _, err := language.ParseAcceptLanguage(p)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, parsed...)
+	}
+	if len(tags) == 0 {
+		return Default, false
+	}
+
+	_, i, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default, false
+	}
+
+	return supported[i], true
+}
+
+type localeKey struct{}
+
+// WithLocale sets the language text is translated into for the rest of ctx.
+func WithLocale(ctx context.Context, tag language.Tag) context.Context {
+	return context.WithValue(ctx, localeKey{}, tag)
+}
+
+// Locale is the language set on ctx, or Default if there isn't one.
+func Locale(ctx context.Context) language.Tag {
+	if t, ok := ctx.Value(localeKey{}).(language.Tag); ok {
+		return t
+	}
+	return Default
+}
+
+// T translates msg into the language set on ctx. The arguments are pairs of
+// placeholder names and their values.
+func T(ctx context.Context, msg string, args ...any) string {
+	tag := Locale(ctx)
+
+	text := msg
+	if m, ok := lookup(tag, msg); ok && m.text != "" {
+		text = m.text
+	}
+
+	return replace(text, args)
+}
+
+// N translates a message which depends on count, one is the English for when
+// it's one and other for any other number, which is also the message's ID. The
+// count is available to both as {count}.
+func N(ctx context.Context, count int, one, other string, args ...any) string {
+	tag := Locale(ctx)
+	args = append(args, "count", count)
+
+	text := other
+	if count == 1 {
+		text = one
+	}
+
+	if m, ok := lookup(tag, other); ok {
+		form := formName(plural.Cardinal.MatchPlural(tag, count, 0, 0, 0, 0))
+		if f := m.forms[form]; f != "" {
+			text = f
+		} else if f := m.forms["other"]; f != "" {
+			text = f
+		}
+	}
+
+	return replace(text, args)
+}
+
+func lookup(tag language.Tag, msg string) (message, bool) {
+	for t := tag; ; t = t.Parent() {
+		if c, ok := catalogs[t]; ok {
+			m, ok := c[msg]
+			return m, ok
+		}
+		if t.IsRoot() {
+			return message{}, false
+		}
+	}
+}
+
+func replace(text string, args []any) string {
+	if len(args) == 0 {
+		return text
+	}
+
+	pairs := make([]string, 0, len(args))
+	for i := 0; i+1 < len(args); i += 2 {
+		pairs = append(pairs, "{"+fmt.Sprint(args[i])+"}", value(args[i+1]))
+	}
+
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+func value(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formName(f plural.Form) string {
+	switch f {
+	case plural.Zero:
+		return "zero"
+	case plural.One:
+		return "one"
+	case plural.Two:
+		return "two"
+	case plural.Few:
+		return "few"
+	case plural.Many:
+		return "many"
+	default:
+		return "other"
+	}
+}
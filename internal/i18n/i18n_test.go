@@ -0,0 +1,100 @@
+package i18n
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestTranslate(t *testing.T) {
+	en := context.Background()
+	de := WithLocale(en, language.German)
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, "Odin replied to your thread", T(en, "{name} replied to your thread", "name", "Odin"))
+	})
+
+	t.Run("translated", func(t *testing.T) {
+		assert.Equal(t, "Odin hat auf dein Thema geantwortet", T(de, "{name} replied to your thread", "name", "Odin"))
+	})
+
+	t.Run("regional", func(t *testing.T) {
+		at := WithLocale(en, language.MustParse("de-AT"))
+		assert.Equal(t, "Danke", T(at, "Thanks"))
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		assert.Equal(t, "Not in any catalog", T(de, "Not in any catalog"))
+	})
+
+	t.Run("plural", func(t *testing.T) {
+		assert.Equal(t, "You have 1 unread notification.", N(en, 1, "You have {count} unread notification.", "You have {count} unread notifications."))
+		assert.Equal(t, "You have 3 unread notifications.", N(en, 3, "You have {count} unread notification.", "You have {count} unread notifications."))
+		assert.Equal(t, "Du hast 1 ungelesene Benachrichtigung.", N(de, 1, "You have {count} unread notification.", "You have {count} unread notifications."))
+		assert.Equal(t, "Du hast 3 ungelesene Benachrichtigungen.", N(de, 3, "You have {count} unread notification.", "You have {count} unread notifications."))
+	})
+}
+
+func TestMatch(t *testing.T) {
+	tag, ok := Match("de-CH,de;q=0.9,en;q=0.8")
+	assert.True(t, ok)
+	assert.Equal(t, language.German, tag)
+
+	tag, ok = Match("", "fr-FR,en;q=0.5")
+	assert.True(t, ok)
+	assert.Equal(t, language.English, tag)
+
+	_, ok = Match("fr")
+	assert.False(t, ok)
+
+	_, ok = Match("not a language")
+	assert.False(t, ok)
+}
+
+func TestNormalise(t *testing.T) {
+	s, ok := Normalise("DE-at")
+	assert.True(t, ok)
+	assert.Equal(t, "de-AT", s)
+
+	_, ok = Normalise("fr")
+	assert.False(t, ok)
+}
+
+var placeholder = regexp.MustCompile(`\{[a-zA-Z]+\}`)
+
+// TestCatalogs checks translations keep their message's placeholders, so none
+// of the values passed in go missing from translated text.
+func TestCatalogs(t *testing.T) {
+	cs, err := load()
+	require.NoError(t, err)
+
+	for tag, c := range cs {
+		for id, m := range c {
+			want := placeholders(id)
+
+			texts := []string{m.text}
+			for form, f := range m.forms {
+				texts = append(texts, f)
+				assert.Contains(t, []string{"zero", "one", "two", "few", "many", "other"}, form, "%s: %q has an unknown plural form", tag, id)
+			}
+
+			for _, text := range texts {
+				if text == "" {
+					continue
+				}
+				assert.Equal(t, want, placeholders(text), "%s: %q has different placeholders to its translation %q", tag, id, text)
+			}
+		}
+	}
+}
+
+func placeholders(s string) []string {
+	p := placeholder.FindAllString(s, -1)
+	slices.Sort(p)
+	return slices.Compact(p)
+}
@@ -0,0 +1,113 @@
+package i18n
+
+import (
+	"context"
+	"time"
+
+	// Time zones are loaded from the embedded database when the system
+	// doesn't have one, which is the case for minimal container images.
+	_ "time/tzdata"
+)
+
+type timezoneKey struct{}
+
+// WithTimezone sets the timezone dates are written in for the rest of ctx.
+func WithTimezone(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, timezoneKey{}, loc)
+}
+
+// Timezone is the timezone set on ctx, or UTC if there isn't one.
+func Timezone(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(timezoneKey{}).(*time.Location); ok {
+		return loc
+	}
+	return time.UTC
+}
+
+// LoadTimezone loads an IANA time zone such as "Europe/Berlin". The server's
+// "Local" zone isn't accepted as it means something different on each server.
+func LoadTimezone(name string) (*time.Location, bool) {
+	if name == "" || name == "Local" {
+		return nil, false
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, false
+	}
+
+	return loc, true
+}
+
+// Date writes the day t falls on in the language and timezone set on ctx.
+func Date(ctx context.Context, t time.Time) string {
+	t = t.In(Timezone(ctx))
+
+	return T(ctx, "{weekday}, {day} {month} {year}",
+		"weekday", weekday(ctx, t.Weekday()),
+		"day", t.Day(),
+		"month", month(ctx, t.Month()),
+		"year", t.Year(),
+	)
+}
+
+// DateTime writes t as a date and time of day in the language and timezone set
+// on ctx, naming the timezone so it's never mistaken for another.
+func DateTime(ctx context.Context, t time.Time) string {
+	loc := Timezone(ctx)
+	t = t.In(loc)
+
+	return T(ctx, "{date} at {time} ({timezone})",
+		"date", Date(ctx, t),
+		"time", t.Format("15:04"),
+		"timezone", loc.String(),
+	)
+}
+
+func weekday(ctx context.Context, d time.Weekday) string {
+	switch d {
+	case time.Monday:
+		return T(ctx, "Monday")
+	case time.Tuesday:
+		return T(ctx, "Tuesday")
+	case time.Wednesday:
+		return T(ctx, "Wednesday")
+	case time.Thursday:
+		return T(ctx, "Thursday")
+	case time.Friday:
+		return T(ctx, "Friday")
+	case time.Saturday:
+		return T(ctx, "Saturday")
+	default:
+		return T(ctx, "Sunday")
+	}
+}
+
+func month(ctx context.Context, m time.Month) string {
+	switch m {
+	case time.January:
+		return T(ctx, "January")
+	case time.February:
+		return T(ctx, "February")
+	case time.March:
+		return T(ctx, "March")
+	case time.April:
+		return T(ctx, "April")
+	case time.May:
+		return T(ctx, "May")
+	case time.June:
+		return T(ctx, "June")
+	case time.July:
+		return T(ctx, "July")
+	case time.August:
+		return T(ctx, "August")
+	case time.September:
+		return T(ctx, "September")
+	case time.October:
+		return T(ctx, "October")
+	case time.November:
+		return T(ctx, "November")
+	default:
+		return T(ctx, "December")
+	}
+}
@@ -0,0 +1,43 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestDateTime(t *testing.T) {
+	berlin, ok := LoadTimezone("Europe/Berlin")
+	require.True(t, ok)
+
+	at := time.Date(2025, 3, 14, 17, 30, 0, 0, time.UTC)
+
+	en := context.Background()
+	de := WithTimezone(WithLocale(en, language.German), berlin)
+
+	assert.Equal(t, "Friday, 14 March 2025 at 17:30 (UTC)", DateTime(en, at))
+	assert.Equal(t, "Freitag, 14. März 2025 um 18:30 Uhr (Europe/Berlin)", DateTime(de, at))
+
+	t.Run("crosses_midnight", func(t *testing.T) {
+		late := time.Date(2025, 12, 31, 23, 30, 0, 0, time.UTC)
+		assert.Equal(t, "Donnerstag, 1. Januar 2026", Date(de, late))
+	})
+}
+
+func TestLoadTimezone(t *testing.T) {
+	_, ok := LoadTimezone("America/New_York")
+	assert.True(t, ok)
+
+	_, ok = LoadTimezone("Local")
+	assert.False(t, ok)
+
+	_, ok = LoadTimezone("")
+	assert.False(t, ok)
+
+	_, ok = LoadTimezone("Mars/Olympus_Mons")
+	assert.False(t, ok)
+}
@@ -2,12 +2,23 @@ package ai
 
 import (
 	"context"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
 
 	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/rate"
 )
 
+type Usage struct {
+	InputTokens  int
+	OutputTokens int
+}
+
 type Result struct {
 	Answer string
+	// Usage is reported by the provider, it's zero if the provider doesn't.
+	Usage Usage
 }
 
 type Embedder func(ctx context.Context, text string) ([]float32, error)
@@ -18,16 +29,49 @@ type Prompter interface {
 	EmbeddingFunc() func(ctx context.Context, text string) ([]float32, error)
 }
 
-func New(cfg config.Config) (Prompter, error) {
+func New(cfg config.Config, logger *slog.Logger, meter *Meter, rl *rate.LimiterFactory) (Prompter, error) {
+	p, err := newProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, disabled := p.(*Disabled); disabled {
+		return p, nil
+	}
+
+	return newMetered(
+		logger,
+		cfg.LanguageModelProvider,
+		p,
+		meter,
+		rl,
+		cfg.LanguageModelRequestsPerMinute,
+		cfg.LanguageModelTokensPerMinute,
+	), nil
+}
+
+func newProvider(cfg config.Config) (Prompter, error) {
 	switch cfg.LanguageModelProvider {
 	case "openai":
 		return newOpenAI(cfg)
 
+	case "anthropic":
+		return newAnthropic(cfg)
+
+	case "ollama":
+		return newOllama(cfg)
+
+	case "openai-compatible":
+		return newOpenAICompatible(cfg)
+
 	case "mock":
 		return newMock()
 
-	default:
+	case "":
 		return &Disabled{}, nil
+
+	default:
+		return nil, fault.Newf("unknown language model provider: %s", cfg.LanguageModelProvider)
 	}
 }
 
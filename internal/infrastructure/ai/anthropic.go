@@ -0,0 +1,204 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/openai/openai-go/packages/ssestream"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+const (
+	anthropicEndpoint         = "https://api.anthropic.com/v1/messages"
+	anthropicVersion          = "2023-06-01"
+	anthropicDefaultChatModel = "claude-3-5-sonnet-latest"
+	anthropicMaxTokens        = 4096
+)
+
+// Anthropic only provides chat completions, there is no embeddings API so it
+// cannot be used to power the Semdex.
+type Anthropic struct {
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+func newAnthropic(cfg config.Config) (*Anthropic, error) {
+	if cfg.AnthropicKey == "" {
+		return nil, fault.New("anthropic language model provider requires ANTHROPIC_API_KEY")
+	}
+
+	model := cfg.LanguageModelChatModel
+	if model == "" {
+		model = anthropicDefaultChatModel
+	}
+
+	return &Anthropic{
+		apiKey:     cfg.AnthropicKey,
+		model:      model,
+		httpClient: &http.Client{Transport: outbound.Transport(nil)},
+	}, nil
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	Messages  []anthropicMessage `json:"messages"`
+	Stream    bool               `json:"stream,omitempty"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	Usage anthropicUsage `json:"usage"`
+}
+
+type anthropicStreamEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (a *Anthropic) Prompt(ctx context.Context, input string) (*Result, error) {
+	resp, err := a.send(ctx, input, false)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	var r anthropicResponse
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	answer := strings.Builder{}
+	for _, c := range r.Content {
+		if c.Type == "text" {
+			answer.WriteString(c.Text)
+		}
+	}
+
+	if answer.Len() == 0 {
+		return nil, fault.New("result is empty")
+	}
+
+	return &Result{
+		Answer: answer.String(),
+		Usage: Usage{
+			InputTokens:  r.Usage.InputTokens,
+			OutputTokens: r.Usage.OutputTokens,
+		},
+	}, nil
+}
+
+func (a *Anthropic) PromptStream(ctx context.Context, input string) (func(yield func(string, error) bool), error) {
+	resp, err := a.send(ctx, input, true)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	dec := ssestream.NewDecoder(resp)
+
+	iter := func(yield func(string, error) bool) {
+		defer resp.Body.Close()
+
+		for dec.Next() {
+			var ev anthropicStreamEvent
+			if err := json.Unmarshal(dec.Event().Data, &ev); err != nil {
+				yield("", fmt.Errorf("failed to unmarshal SSE event: %w", err))
+				return
+			}
+
+			switch ev.Type {
+			case "content_block_delta":
+				if ev.Delta.Type != "text_delta" {
+					continue
+				}
+
+				if !yield(ev.Delta.Text, nil) {
+					return
+				}
+
+			case "error":
+				yield("", fmt.Errorf("anthropic stream error: %s: %s", ev.Error.Type, ev.Error.Message))
+				return
+
+			case "message_stop":
+				return
+			}
+		}
+
+		if dec.Err() != nil {
+			yield("", fmt.Errorf("failed to read SSE stream: %w", dec.Err()))
+		}
+	}
+
+	return iter, nil
+}
+
+func (a *Anthropic) EmbeddingFunc() func(ctx context.Context, text string) ([]float32, error) {
+	return nil
+}
+
+func (a *Anthropic) send(ctx context.Context, input string, stream bool) (*http.Response, error) {
+	body, err := json.Marshal(anthropicRequest{
+		Model:     a.model,
+		MaxTokens: anthropicMaxTokens,
+		Messages:  []anthropicMessage{{Role: "user", Content: input}},
+		Stream:    stream,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, anthropicEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req.Header.Set("x-api-key", a.apiKey)
+	req.Header.Set("anthropic-version", anthropicVersion)
+	req.Header.Set("content-type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		var r anthropicStreamEvent
+		_ = json.NewDecoder(resp.Body).Decode(&r)
+
+		return nil, fault.Newf("anthropic request failed: %s: %s", resp.Status, r.Error.Message)
+	}
+
+	return resp, nil
+}
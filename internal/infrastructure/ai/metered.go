@@ -0,0 +1,237 @@
+package ai
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/rate"
+)
+
+const (
+	limitPeriod = time.Minute
+	limitBucket = time.Second * 10
+)
+
+// Totals is the accumulated usage of a single language model provider.
+type Totals struct {
+	Requests     int
+	InputTokens  int
+	OutputTokens int
+}
+
+// Meter accounts for usage per provider for the lifetime of the process.
+type Meter struct {
+	mu     sync.Mutex
+	totals map[string]Totals
+}
+
+func NewMeter() *Meter {
+	return &Meter{totals: map[string]Totals{}}
+}
+
+func (m *Meter) record(provider string, u Usage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := m.totals[provider]
+	t.Requests++
+	t.InputTokens += u.InputTokens
+	t.OutputTokens += u.OutputTokens
+	m.totals[provider] = t
+}
+
+// Totals returns a snapshot of the usage of every provider used so far.
+func (m *Meter) Totals() map[string]Totals {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string]Totals, len(m.totals))
+	for k, v := range m.totals {
+		out[k] = v
+	}
+
+	return out
+}
+
+// metered wraps a provider's prompter with usage accounting and rate limits.
+type metered struct {
+	logger   *slog.Logger
+	provider string
+	prompter Prompter
+	meter    *Meter
+	requests rate.Limiter
+	tokens   rate.Limiter
+}
+
+func newMetered(
+	logger *slog.Logger,
+	provider string,
+	prompter Prompter,
+	meter *Meter,
+	rl *rate.LimiterFactory,
+	requestsPerMinute int,
+	tokensPerMinute int,
+) *metered {
+	m := &metered{
+		logger:   logger,
+		provider: provider,
+		prompter: prompter,
+		meter:    meter,
+	}
+
+	// The limiter rejects the increment which reaches its limit rather than the
+	// one which exceeds it, so it's given one extra to allow the full amount.
+	if requestsPerMinute > 0 {
+		m.requests = rl.NewLimiter(requestsPerMinute+1, limitPeriod, limitBucket)
+	}
+
+	if tokensPerMinute > 0 {
+		m.tokens = rl.NewLimiter(tokensPerMinute+1, limitPeriod, limitBucket)
+	}
+
+	return m
+}
+
+func (m *metered) Prompt(ctx context.Context, input string) (*Result, error) {
+	if err := m.acquire(ctx, input); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := m.prompter.Prompt(ctx, input)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Not every provider reports usage, so fall back to an estimate.
+	usage := r.Usage
+	if usage.InputTokens == 0 && usage.OutputTokens == 0 {
+		usage = Usage{
+			InputTokens:  estimateTokens(input),
+			OutputTokens: estimateTokens(r.Answer),
+		}
+	}
+
+	m.release(ctx, usage)
+
+	return r, nil
+}
+
+func (m *metered) PromptStream(ctx context.Context, input string) (func(yield func(string, error) bool), error) {
+	if err := m.acquire(ctx, input); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	iter, err := m.prompter.PromptStream(ctx, input)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return func(yield func(string, error) bool) {
+		output := 0
+
+		defer func() {
+			m.release(ctx, Usage{
+				InputTokens:  estimateTokens(input),
+				OutputTokens: output,
+			})
+		}()
+
+		for chunk, err := range iter {
+			output += estimateTokens(chunk)
+
+			if !yield(chunk, err) {
+				return
+			}
+		}
+	}, nil
+}
+
+func (m *metered) EmbeddingFunc() func(ctx context.Context, text string) ([]float32, error) {
+	ef := m.prompter.EmbeddingFunc()
+	if ef == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, text string) ([]float32, error) {
+		if err := m.acquire(ctx, text); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		v, err := ef(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+
+		m.release(ctx, Usage{InputTokens: estimateTokens(text)})
+
+		return v, nil
+	}
+}
+
+func (m *metered) promptObject(ctx context.Context, description, input string, schema any) (*Result, error) {
+	op, ok := m.prompter.(objectPrompter)
+	if !ok {
+		return nil, fault.Wrap(ErrObjectPromptUnsupported, fctx.With(ctx))
+	}
+
+	if err := m.acquire(ctx, input); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := op.promptObject(ctx, description, input, schema)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.release(ctx, r.Usage)
+
+	return r, nil
+}
+
+// acquire checks the limits before a request, only the input is known at this
+// point so the output is counted against the token limit once it's complete.
+func (m *metered) acquire(ctx context.Context, input string) error {
+	if m.requests != nil {
+		if err := m.requests.Check(ctx, m.provider, 1); err != nil {
+			return fault.Wrap(err, fctx.With(ctx), fmsg.WithDesc("request limit", "The language model request limit has been reached, please try again later."))
+		}
+	}
+
+	if m.tokens != nil {
+		if err := m.tokens.Check(ctx, m.provider, estimateTokens(input)); err != nil {
+			return fault.Wrap(err, fctx.With(ctx), fmsg.WithDesc("token limit", "The language model token limit has been reached, please try again later."))
+		}
+	}
+
+	return nil
+}
+
+func (m *metered) release(ctx context.Context, u Usage) {
+	m.meter.record(m.provider, u)
+
+	if m.tokens != nil && u.OutputTokens > 0 {
+		if _, _, err := m.tokens.Increment(ctx, m.provider, u.OutputTokens); err != nil {
+			m.logger.Warn("failed to count language model tokens", slog.String("error", err.Error()))
+		}
+	}
+
+	m.logger.Debug("language model usage",
+		slog.String("provider", m.provider),
+		slog.Int("input_tokens", u.InputTokens),
+		slog.Int("output_tokens", u.OutputTokens),
+	)
+}
+
+// estimateTokens approximates the number of tokens in a piece of text for
+// providers which don't report usage, most tokenisers average four characters
+// per token for English text.
+func estimateTokens(s string) int {
+	return (utf8.RuneCountInString(s) + 3) / 4
+}
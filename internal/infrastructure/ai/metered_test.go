@@ -0,0 +1,124 @@
+package ai
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/cache/local"
+	"github.com/Southclaws/storyden/internal/infrastructure/rate"
+)
+
+type fixedPrompter struct {
+	result Result
+}
+
+func (p *fixedPrompter) Prompt(ctx context.Context, input string) (*Result, error) {
+	r := p.result
+	return &r, nil
+}
+
+func (p *fixedPrompter) PromptStream(ctx context.Context, input string) (func(yield func(string, error) bool), error) {
+	return func(yield func(string, error) bool) {
+		for _, s := range []string{"abcd", "efgh"} {
+			if !yield(s, nil) {
+				return
+			}
+		}
+	}, nil
+}
+
+func (p *fixedPrompter) EmbeddingFunc() func(ctx context.Context, text string) ([]float32, error) {
+	return nil
+}
+
+func newTestMetered(t *testing.T, p Prompter, requests, tokens int) (*metered, *Meter) {
+	store, err := local.New()
+	require.NoError(t, err)
+
+	meter := NewMeter()
+
+	return newMetered(slog.Default(), t.Name(), p, meter, rate.NewFactory(store), requests, tokens), meter
+}
+
+func TestMetered(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reported_usage", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		m, meter := newTestMetered(t, &fixedPrompter{result: Result{
+			Answer: "answer",
+			Usage:  Usage{InputTokens: 10, OutputTokens: 20},
+		}}, 0, 0)
+
+		_, err := m.Prompt(ctx, "question")
+		r.NoError(err)
+		_, err = m.Prompt(ctx, "question")
+		r.NoError(err)
+
+		a.Equal(Totals{Requests: 2, InputTokens: 20, OutputTokens: 40}, meter.Totals()[t.Name()])
+	})
+
+	t.Run("estimated_usage", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		m, meter := newTestMetered(t, &fixedPrompter{result: Result{Answer: "12345678"}}, 0, 0)
+
+		_, err := m.Prompt(ctx, "1234")
+		r.NoError(err)
+
+		a.Equal(Totals{Requests: 1, InputTokens: 1, OutputTokens: 2}, meter.Totals()[t.Name()])
+	})
+
+	t.Run("stream_usage", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		m, meter := newTestMetered(t, &fixedPrompter{}, 0, 0)
+
+		iter, err := m.PromptStream(ctx, "1234")
+		r.NoError(err)
+
+		out := ""
+		for s, err := range iter {
+			r.NoError(err)
+			out += s
+		}
+
+		a.Equal("abcdefgh", out)
+		a.Equal(Totals{Requests: 1, InputTokens: 1, OutputTokens: 2}, meter.Totals()[t.Name()])
+	})
+
+	t.Run("request_limit", func(t *testing.T) {
+		r := require.New(t)
+
+		m, _ := newTestMetered(t, &fixedPrompter{result: Result{Answer: "answer"}}, 1, 0)
+
+		_, err := m.Prompt(ctx, "question")
+		r.NoError(err)
+
+		_, err = m.Prompt(ctx, "question")
+		r.Error(err)
+	})
+
+	t.Run("token_limit", func(t *testing.T) {
+		r := require.New(t)
+
+		m, _ := newTestMetered(t, &fixedPrompter{result: Result{
+			Answer: "answer",
+			Usage:  Usage{InputTokens: 1, OutputTokens: 100},
+		}}, 0, 50)
+
+		_, err := m.Prompt(ctx, "1234")
+		r.NoError(err)
+
+		_, err = m.Prompt(ctx, "1234")
+		r.Error(err)
+	})
+}
@@ -39,7 +39,9 @@ func (o *Mock) PromptStream(ctx context.Context, input string) (func(yield func(
 			case <-ctx.Done():
 				return
 			default:
-				yield(part, nil)
+				if !yield(part, nil) {
+					return
+				}
 				time.Sleep(time.Millisecond * (10 + time.Duration(rand.Intn(100))))
 			}
 		}
@@ -0,0 +1,47 @@
+package ai
+
+import (
+	"strings"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"github.com/philippgille/chromem-go"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const (
+	ollamaDefaultURL            = "http://localhost:11434"
+	ollamaDefaultChatModel      = "llama3.1"
+	ollamaDefaultEmbeddingModel = "nomic-embed-text"
+)
+
+// newOllama uses Ollama's OpenAI-compatible API for prompts and its native API
+// for embeddings, which the compatible API does not expose.
+func newOllama(cfg config.Config) (*OpenAI, error) {
+	baseURL := strings.TrimSuffix(cfg.LanguageModelBaseURL, "/")
+	if baseURL == "" {
+		baseURL = ollamaDefaultURL
+	}
+
+	model := cfg.LanguageModelChatModel
+	if model == "" {
+		model = ollamaDefaultChatModel
+	}
+
+	embeddingModel := cfg.LanguageModelEmbeddingModel
+	if embeddingModel == "" {
+		embeddingModel = ollamaDefaultEmbeddingModel
+	}
+
+	// Ollama ignores the API key, setting one stops the client from falling
+	// back to sending OPENAI_API_KEY from the environment.
+	client := openai.NewClient(
+		option.WithBaseURL(baseURL+"/v1"),
+		option.WithAPIKey("ollama"),
+	)
+
+	ef := chromem.NewEmbeddingFuncOllama(embeddingModel, baseURL+"/api")
+
+	return &OpenAI{client: &client, model: model, structuredModel: model, ef: ef}, nil
+}
@@ -14,18 +14,61 @@ import (
 
 type OpenAI struct {
 	client *openai.Client
-	ef     func(ctx context.Context, text string) ([]float32, error)
+	model  string
+	// structuredModel is used for JSON schema constrained prompts as not every
+	// chat model supports structured outputs.
+	structuredModel string
+	ef              func(ctx context.Context, text string) ([]float32, error)
 }
 
 func newOpenAI(cfg config.Config) (*OpenAI, error) {
 	client := openai.NewClient(option.WithAPIKey(cfg.OpenAIKey))
-	ef := chromem.NewEmbeddingFuncOpenAI(cfg.OpenAIKey, chromem.EmbeddingModelOpenAI3Large)
-	return &OpenAI{client: &client, ef: ef}, nil
+
+	model := openai.ChatModelChatgpt4oLatest
+	structuredModel := openai.ChatModelGPT4_1
+	if cfg.LanguageModelChatModel != "" {
+		model = cfg.LanguageModelChatModel
+		structuredModel = cfg.LanguageModelChatModel
+	}
+
+	embeddingModel := chromem.EmbeddingModelOpenAI(cfg.LanguageModelEmbeddingModel)
+	if embeddingModel == "" {
+		embeddingModel = chromem.EmbeddingModelOpenAI3Large
+	}
+
+	ef := chromem.NewEmbeddingFuncOpenAI(cfg.OpenAIKey, embeddingModel)
+	return &OpenAI{client: &client, model: model, structuredModel: structuredModel, ef: ef}, nil
+}
+
+// newOpenAICompatible targets any server which implements the OpenAI chat
+// completions and embeddings API at a different base URL.
+func newOpenAICompatible(cfg config.Config) (*OpenAI, error) {
+	if cfg.LanguageModelBaseURL == "" {
+		return nil, fault.New("openai-compatible language model provider requires LANGUAGE_MODEL_BASE_URL")
+	}
+
+	if cfg.LanguageModelChatModel == "" {
+		return nil, fault.New("openai-compatible language model provider requires LANGUAGE_MODEL_CHAT_MODEL")
+	}
+
+	// The key is always set, even when empty, so the client never falls back
+	// to sending OPENAI_API_KEY from the environment to a third party.
+	client := openai.NewClient(
+		option.WithBaseURL(cfg.LanguageModelBaseURL),
+		option.WithAPIKey(cfg.LanguageModelAPIKey),
+	)
+
+	var ef func(ctx context.Context, text string) ([]float32, error)
+	if cfg.LanguageModelEmbeddingModel != "" {
+		ef = chromem.NewEmbeddingFuncOpenAICompat(cfg.LanguageModelBaseURL, cfg.LanguageModelAPIKey, cfg.LanguageModelEmbeddingModel, nil)
+	}
+
+	return &OpenAI{client: &client, model: cfg.LanguageModelChatModel, structuredModel: cfg.LanguageModelChatModel, ef: ef}, nil
 }
 
 func (o *OpenAI) Prompt(ctx context.Context, input string) (*Result, error) {
 	res, err := o.client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-		Model: openai.ChatModelChatgpt4oLatest,
+		Model: o.model,
 		Messages: []openai.ChatCompletionMessageParamUnion{
 			openai.UserMessage(input),
 		},
@@ -40,13 +83,17 @@ func (o *OpenAI) Prompt(ctx context.Context, input string) (*Result, error) {
 
 	return &Result{
 		Answer: res.Choices[0].Message.Content,
+		Usage: Usage{
+			InputTokens:  int(res.Usage.PromptTokens),
+			OutputTokens: int(res.Usage.CompletionTokens),
+		},
 	}, nil
 }
 
 func (o *OpenAI) PromptStream(ctx context.Context, input string) (func(yield func(string, error) bool), error) {
 	iter := func(yield func(string, error) bool) {
 		stream := o.client.Chat.Completions.NewStreaming(ctx, openai.ChatCompletionNewParams{
-			Model: openai.ChatModelChatgpt4oLatest,
+			Model: o.model,
 			Messages: []openai.ChatCompletionMessageParamUnion{
 				openai.UserMessage(input),
 			},
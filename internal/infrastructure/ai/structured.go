@@ -12,10 +12,18 @@ import (
 	"github.com/openai/openai-go/shared"
 )
 
+var ErrObjectPromptUnsupported = fault.New("structured prompt not supported by the language model provider")
+
+// objectPrompter is implemented by providers which can constrain a response to
+// a JSON schema, the result's answer is the JSON payload.
+type objectPrompter interface {
+	promptObject(ctx context.Context, description, input string, schema any) (*Result, error)
+}
+
 func PromptObject[T any](ctx context.Context, prompter Prompter, description, input string, schema T) (*T, error) {
-	s, ok := prompter.(*OpenAI)
+	op, ok := prompter.(objectPrompter)
 	if !ok {
-		return nil, fault.New("structured prompt only supported with OpenAI prompter")
+		return nil, fault.Wrap(ErrObjectPromptUnsupported, fctx.With(ctx))
 	}
 
 	serialisedSchema, err := schemaFromObjectInstance(schema)
@@ -23,8 +31,23 @@ func PromptObject[T any](ctx context.Context, prompter Prompter, description, in
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	res, err := s.client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-		Model: openai.ChatModelGPT4_1,
+	res, err := op.promptObject(ctx, description, input, serialisedSchema)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var result T
+	err = json.Unmarshal([]byte(res.Answer), &result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &result, nil
+}
+
+func (o *OpenAI) promptObject(ctx context.Context, description, input string, schema any) (*Result, error) {
+	res, err := o.client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
+		Model: o.structuredModel,
 		Messages: []openai.ChatCompletionMessageParamUnion{
 			openai.UserMessage(input),
 		},
@@ -34,7 +57,7 @@ func PromptObject[T any](ctx context.Context, prompter Prompter, description, in
 					Name:        "json_schema",
 					Strict:      param.NewOpt(true),
 					Description: param.NewOpt(description),
-					Schema:      serialisedSchema,
+					Schema:      schema,
 				},
 			},
 		},
@@ -57,15 +80,13 @@ func PromptObject[T any](ctx context.Context, prompter Prompter, description, in
 		return nil, fault.New("result is not valid JSON")
 	}
 
-	payload := choice.Message.Content
-
-	var result T
-	err = json.Unmarshal([]byte(payload), &result)
-	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
-	}
-
-	return &result, nil
+	return &Result{
+		Answer: choice.Message.Content,
+		Usage: Usage{
+			InputTokens:  int(res.Usage.PromptTokens),
+			OutputTokens: int(res.Usage.CompletionTokens),
+		},
+	}, nil
 }
 
 func schemaFromObjectInstance[T any](instance T) (any, error) {
@@ -0,0 +1,34 @@
+// Package asn looks up which autonomous systems announce an IP address.
+package asn
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/Southclaws/fault"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+type Resolver interface {
+	Lookup(ctx context.Context, addr netip.Addr) ([]uint32, error)
+}
+
+func Build() fx.Option {
+	return fx.Provide(func(cfg config.Config) (Resolver, error) {
+		switch cfg.ASNLookupProvider {
+		case "":
+			return nil, nil
+
+		case "cymru":
+			return newCymru(), nil
+
+		case "mock":
+			return &Mock{}, nil
+
+		default:
+			return nil, fault.Newf("unknown ASN lookup provider: '%s'", cfg.ASNLookupProvider)
+		}
+	})
+}
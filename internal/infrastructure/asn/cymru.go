@@ -0,0 +1,98 @@
+package asn
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+)
+
+const cacheTTL = time.Hour
+
+type cacheEntry struct {
+	asns    []uint32
+	expires time.Time
+}
+
+// Cymru resolves addresses using Team Cymru's DNS based IP to ASN service. A
+// TXT record for the reversed address under origin.asn.cymru.com is answered
+// with a record such as "13335 | 1.1.1.0/24 | AU | apnic | 2011-08-11".
+type Cymru struct {
+	resolver *net.Resolver
+
+	mu    sync.Mutex
+	cache map[netip.Addr]cacheEntry
+}
+
+func newCymru() *Cymru {
+	return &Cymru{
+		resolver: net.DefaultResolver,
+		cache:    map[netip.Addr]cacheEntry{},
+	}
+}
+
+func (c *Cymru) Lookup(ctx context.Context, addr netip.Addr) ([]uint32, error) {
+	addr = addr.Unmap()
+
+	c.mu.Lock()
+	e, ok := c.cache[addr]
+	c.mu.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.asns, nil
+	}
+
+	records, err := c.resolver.LookupTXT(ctx, cymruName(addr))
+	if err != nil {
+		if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+			records = nil
+		} else {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	asns := parseCymru(records)
+
+	c.mu.Lock()
+	c.cache[addr] = cacheEntry{asns: asns, expires: time.Now().Add(cacheTTL)}
+	c.mu.Unlock()
+
+	return asns, nil
+}
+
+func cymruName(addr netip.Addr) string {
+	b := addr.AsSlice()
+
+	if addr.Is4() {
+		return fmt.Sprintf("%d.%d.%d.%d.origin.asn.cymru.com", b[3], b[2], b[1], b[0])
+	}
+
+	nibbles := make([]string, 0, len(b)*2)
+	for i := len(b) - 1; i >= 0; i-- {
+		nibbles = append(nibbles, fmt.Sprintf("%x", b[i]&0x0f), fmt.Sprintf("%x", b[i]>>4))
+	}
+
+	return strings.Join(nibbles, ".") + ".origin6.asn.cymru.com"
+}
+
+// parseCymru reads the AS numbers from the first field of each record, which
+// may list several when a prefix is announced by more than one network.
+func parseCymru(records []string) []uint32 {
+	asns := []uint32{}
+	for _, r := range records {
+		field, _, _ := strings.Cut(r, "|")
+		for _, s := range strings.Fields(field) {
+			n, err := strconv.ParseUint(s, 10, 32)
+			if err == nil {
+				asns = append(asns, uint32(n))
+			}
+		}
+	}
+	return asns
+}
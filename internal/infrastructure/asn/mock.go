@@ -0,0 +1,15 @@
+package asn
+
+import (
+	"context"
+	"net/netip"
+)
+
+// MockASN is AS64496, reserved for use in documentation.
+const MockASN = 64496
+
+type Mock struct{}
+
+func (m *Mock) Lookup(ctx context.Context, addr netip.Addr) ([]uint32, error) {
+	return []uint32{MockASN}, nil
+}
@@ -0,0 +1,57 @@
+// Package broadcast delivers messages to every subscriber of a channel on every
+// instance of Storyden. Unlike the message queue, messages are not durable and
+// every subscriber receives every message, which suits live updates pushed to
+// connected clients. When the cache provider is Redis, messages are relayed via
+// Redis pub/sub so clients connected to different replicas all receive them.
+package broadcast
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache/redis"
+)
+
+// SubscriberBuffer is how many messages may be waiting for a subscriber before
+// newer ones are dropped, so one slow client can't hold up the others.
+const SubscriberBuffer = 64
+
+type Broadcaster interface {
+	Publish(ctx context.Context, channel string, payload []byte) error
+
+	// Subscribe returns a channel of messages published to the given channel,
+	// it's closed once the context is cancelled.
+	Subscribe(ctx context.Context, channel string) <-chan []byte
+}
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+func New(lc fx.Lifecycle, ctx context.Context, logger *slog.Logger, cfg config.Config) (Broadcaster, error) {
+	switch cfg.CacheProvider {
+	case "redis":
+		client, err := redis.NewClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		b := newRedis(logger, client, cfg.Namespace)
+
+		lc.Append(fx.StartHook(func() {
+			go b.relay(ctx)
+		}))
+
+		lc.Append(fx.StopHook(func() {
+			client.Close()
+		}))
+
+		return b, nil
+
+	default:
+		return newLocal(), nil
+	}
+}
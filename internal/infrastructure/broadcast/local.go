@@ -0,0 +1,63 @@
+package broadcast
+
+import (
+	"context"
+	"sync"
+)
+
+// local fans messages out to subscribers within this process.
+type local struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan []byte]struct{}
+}
+
+func newLocal() *local {
+	return &local{
+		subscribers: make(map[string]map[chan []byte]struct{}),
+	}
+}
+
+func (l *local) Publish(ctx context.Context, channel string, payload []byte) error {
+	l.deliver(channel, payload)
+	return nil
+}
+
+func (l *local) deliver(channel string, payload []byte) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for ch := range l.subscribers[channel] {
+		select {
+		case ch <- payload:
+		default:
+		}
+	}
+}
+
+func (l *local) Subscribe(ctx context.Context, channel string) <-chan []byte {
+	ch := make(chan []byte, SubscriberBuffer)
+
+	l.mu.Lock()
+	subs, ok := l.subscribers[channel]
+	if !ok {
+		subs = make(map[chan []byte]struct{})
+		l.subscribers[channel] = subs
+	}
+	subs[ch] = struct{}{}
+	l.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		l.mu.Lock()
+		delete(subs, ch)
+		if len(subs) == 0 {
+			delete(l.subscribers, channel)
+		}
+		l.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch
+}
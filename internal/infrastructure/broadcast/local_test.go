@@ -0,0 +1,66 @@
+package broadcast
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal(t *testing.T) {
+	t.Run("fan_out", func(t *testing.T) {
+		r := require.New(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		b := newLocal()
+
+		one := b.Subscribe(ctx, "a")
+		two := b.Subscribe(ctx, "a")
+		other := b.Subscribe(ctx, "b")
+
+		r.NoError(b.Publish(ctx, "a", []byte("hello")))
+
+		r.Equal([]byte("hello"), <-one)
+		r.Equal([]byte("hello"), <-two)
+		r.Empty(other)
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		a := assert.New(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := newLocal()
+
+		ch := b.Subscribe(ctx, "a")
+		cancel()
+
+		select {
+		case _, ok := <-ch:
+			a.False(ok)
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not closed")
+		}
+
+		b.mu.RLock()
+		a.Empty(b.subscribers)
+		b.mu.RUnlock()
+	})
+
+	t.Run("slow_subscriber", func(t *testing.T) {
+		r := require.New(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		b := newLocal()
+
+		ch := b.Subscribe(ctx, "a")
+		for range SubscriberBuffer + 10 {
+			r.NoError(b.Publish(ctx, "a", []byte("x")))
+		}
+
+		r.Len(ch, SubscriberBuffer)
+	})
+}
@@ -0,0 +1,78 @@
+package broadcast
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/redis/rueidis"
+)
+
+const relayRetryInterval = 5 * time.Second
+
+// redisBroadcaster publishes to Redis and relays everything published by any
+// instance to this instance's local subscribers over a single subscription.
+type redisBroadcaster struct {
+	logger *slog.Logger
+	client rueidis.Client
+	prefix string
+	local  *local
+}
+
+func newRedis(logger *slog.Logger, client rueidis.Client, namespace string) *redisBroadcaster {
+	prefix := "broadcast:"
+	if namespace != "" {
+		prefix = namespace + ":" + prefix
+	}
+
+	return &redisBroadcaster{
+		logger: logger,
+		client: client,
+		prefix: prefix,
+		local:  newLocal(),
+	}
+}
+
+func (r *redisBroadcaster) Publish(ctx context.Context, channel string, payload []byte) error {
+	cmd := r.client.B().
+		Publish().
+		Channel(r.prefix + channel).
+		Message(rueidis.BinaryString(payload)).
+		Build()
+
+	if err := r.client.Do(ctx, cmd).Error(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *redisBroadcaster) Subscribe(ctx context.Context, channel string) <-chan []byte {
+	return r.local.Subscribe(ctx, channel)
+}
+
+func (r *redisBroadcaster) relay(ctx context.Context) {
+	cmd := r.client.B().Psubscribe().Pattern(r.prefix + "*").Build()
+
+	for {
+		err := r.client.Receive(ctx, cmd, func(m rueidis.PubSubMessage) {
+			r.local.deliver(strings.TrimPrefix(m.Channel, r.prefix), []byte(m.Message))
+		})
+		if ctx.Err() != nil {
+			return
+		}
+
+		r.logger.Error("broadcast relay disconnected from redis, retrying",
+			slog.Any("error", err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(relayRetryInterval):
+		}
+	}
+}
@@ -4,11 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/redis/rueidis"
 	"go.uber.org/fx"
 
-	"github.com/Southclaws/fault"
-	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/cache/local"
 	"github.com/Southclaws/storyden/internal/infrastructure/cache/redis"
@@ -17,6 +14,9 @@ import (
 type Store interface {
 	Get(ctx context.Context, key string) (string, error)
 	Set(ctx context.Context, key string, object string, ttl time.Duration) error
+	// SetNX sets the key only if it doesn't already exist, reporting whether
+	// it was set. The check and the write happen atomically.
+	SetNX(ctx context.Context, key string, object string, ttl time.Duration) (bool, error)
 	Delete(ctx context.Context, key string) error
 
 	HIncrBy(ctx context.Context, key string, field string, incr int64) (int, error)
@@ -28,29 +28,34 @@ type Store interface {
 func Build() fx.Option {
 	return fx.Options(
 		fx.Provide(func(cfg config.Config) (Store, error) {
-			switch cfg.CacheProvider {
-			case "":
-				c, err := local.New()
-				return c, err
-
-			case "redis":
-				password, _ := cfg.RedisURL.User.Password()
-
-				client, err := rueidis.NewClient(rueidis.ClientOption{
-					InitAddress:      []string{cfg.RedisURL.Host},
-					Username:         cfg.RedisURL.User.Username(),
-					Password:         password,
-					DisableCache:     true,
-					ConnWriteTimeout: 5 * time.Second,
-				})
-				if err != nil {
-					return nil, fault.Wrap(err, fmsg.With("failed to connect to redis"))
-				}
-
-				return redis.New(client), nil
+			s, err := newStore(cfg)
+			if err != nil {
+				return nil, err
 			}
 
-			panic("unknown cache provider: " + cfg.CacheProvider)
+			if cfg.Namespace != "" {
+				return newNamespacedStore(cfg.Namespace, s), nil
+			}
+
+			return s, nil
 		}),
 	)
 }
+
+func newStore(cfg config.Config) (Store, error) {
+	switch cfg.CacheProvider {
+	case "":
+		c, err := local.New()
+		return c, err
+
+	case "redis":
+		client, err := redis.NewClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		return redis.New(client), nil
+	}
+
+	panic("unknown cache provider: " + cfg.CacheProvider)
+}
@@ -4,18 +4,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/gob"
-	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/dgraph-io/ristretto/v2"
 	"github.com/shirou/gopsutil/v4/mem"
 )
 
-var errNotFound = fmt.Errorf("not found")
+var errNotFound = fault.New("not found", ftag.With(ftag.NotFound))
 
 type LocalCache struct {
 	cache *ristretto.Cache[string, []byte]
+	nx    sync.Mutex
 }
 
 type HSet map[string]int
@@ -63,12 +66,31 @@ func (c *LocalCache) Set(ctx context.Context, key string, value string, ttl time
 	return nil
 }
 
+func (c *LocalCache) SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	c.nx.Lock()
+	defer c.nx.Unlock()
+
+	if _, found := c.cache.Get(key); found {
+		return false, nil
+	}
+
+	c.cache.SetWithTTL(key, []byte(value), 0, ttl)
+
+	// Writes are buffered, the next SetNX must see this one.
+	c.cache.Wait()
+
+	return true, nil
+}
+
 func (c *LocalCache) Delete(ctx context.Context, key string) error {
 	c.cache.Del(key)
 	return nil
 }
 
 func (c *LocalCache) HIncrBy(ctx context.Context, key string, field string, incr int64) (int, error) {
+	c.nx.Lock()
+	defer c.nx.Unlock()
+
 	hash, exists, err := c.getHSET(key)
 	if err != nil {
 		return 0, err
@@ -117,6 +139,10 @@ func (c *LocalCache) setHSET(key string, hset HSet) error {
 	}
 
 	c.cache.Set(key, buf.Bytes(), 0)
+
+	// Counters are read back immediately so the write must not be buffered.
+	c.cache.Wait()
+
 	return nil
 }
 
@@ -168,6 +194,7 @@ func (c *LocalCache) Expire(ctx context.Context, key string, expiration time.Dur
 	v, exists := c.cache.Get(key)
 	if exists {
 		c.cache.SetWithTTL(key, v, 0, expiration)
+		c.cache.Wait()
 	}
 
 	return nil
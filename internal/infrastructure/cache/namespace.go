@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// namespacedStore prefixes every key so tenants sharing a Redis instance
+// never read each other's entries or share rate limit counters.
+type namespacedStore struct {
+	prefix string
+	next   Store
+}
+
+func newNamespacedStore(namespace string, next Store) *namespacedStore {
+	return &namespacedStore{prefix: namespace + ":", next: next}
+}
+
+func (s *namespacedStore) Get(ctx context.Context, key string) (string, error) {
+	return s.next.Get(ctx, s.prefix+key)
+}
+
+func (s *namespacedStore) Set(ctx context.Context, key string, object string, ttl time.Duration) error {
+	return s.next.Set(ctx, s.prefix+key, object, ttl)
+}
+
+func (s *namespacedStore) SetNX(ctx context.Context, key string, object string, ttl time.Duration) (bool, error) {
+	return s.next.SetNX(ctx, s.prefix+key, object, ttl)
+}
+
+func (s *namespacedStore) Delete(ctx context.Context, key string) error {
+	return s.next.Delete(ctx, s.prefix+key)
+}
+
+func (s *namespacedStore) HIncrBy(ctx context.Context, key string, field string, incr int64) (int, error) {
+	return s.next.HIncrBy(ctx, s.prefix+key, field, incr)
+}
+
+func (s *namespacedStore) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return s.next.HGetAll(ctx, s.prefix+key)
+}
+
+func (s *namespacedStore) HDel(ctx context.Context, key string, field string) error {
+	return s.next.HDel(ctx, s.prefix+key, field)
+}
+
+func (s *namespacedStore) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return s.next.Expire(ctx, s.prefix+key, expiration)
+}
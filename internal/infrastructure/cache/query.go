@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+// versionTTL only needs to outlive every entry written under a version.
+const versionTTL = 24 * time.Hour
+
+// Namespace holds the cached results of an expensive read. Entries are stored
+// under the namespace's current version so invalidating swaps the version and
+// every entry is dropped at once, which suits listings where one write changes
+// any number of cached pages. A namespace with no TTL caches nothing.
+type Namespace struct {
+	store Store
+	name  string
+	ttl   time.Duration
+}
+
+func NewNamespace(store Store, name string, ttl time.Duration) *Namespace {
+	return &Namespace{
+		store: store,
+		name:  name,
+		ttl:   ttl,
+	}
+}
+
+func (n *Namespace) Enabled() bool {
+	return n != nil && n.ttl > 0
+}
+
+// Invalidate drops every entry in the namespace.
+func (n *Namespace) Invalidate(ctx context.Context) error {
+	if !n.Enabled() {
+		return nil
+	}
+
+	return n.store.Set(ctx, n.versionKey(), xid.New().String(), versionTTL)
+}
+
+// Delete drops a single entry.
+func (n *Namespace) Delete(ctx context.Context, key string) error {
+	if !n.Enabled() {
+		return nil
+	}
+
+	return n.store.Delete(ctx, n.entryKey(ctx, key))
+}
+
+func (n *Namespace) versionKey() string {
+	return n.name + ":version"
+}
+
+func (n *Namespace) entryKey(ctx context.Context, key string) string {
+	version, err := n.store.Get(ctx, n.versionKey())
+	if err != nil {
+		version = "0"
+	}
+
+	return n.name + ":" + version + ":" + key
+}
+
+// Fetch reads an entry from the namespace, calling fill and storing its result
+// when there isn't one. Values are stored as JSON so T must round trip through
+// encoding/json. A failing cache provider never fails the read.
+func Fetch[T any](ctx context.Context, n *Namespace, key string, fill func(ctx context.Context) (T, error)) (T, error) {
+	if !n.Enabled() {
+		return fill(ctx)
+	}
+
+	k := n.entryKey(ctx, key)
+
+	if raw, err := n.store.Get(ctx, k); err == nil {
+		var v T
+		if err := json.Unmarshal([]byte(raw), &v); err == nil {
+			return v, nil
+		}
+	}
+
+	v, err := fill(ctx)
+	if err != nil {
+		return v, err
+	}
+
+	if b, err := json.Marshal(v); err == nil {
+		_ = n.store.Set(ctx, k, string(b), n.ttl)
+	}
+
+	return v, nil
+}
+
+// Key derives an entry key from a value such as a struct of query parameters.
+func Key(v any) string {
+	b, _ := json.Marshal(v)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
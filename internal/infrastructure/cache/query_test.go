@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapStore struct {
+	Store
+	mu sync.Mutex
+	m  map[string]string
+}
+
+func (s *mapStore) Get(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.m[key]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return v, nil
+}
+
+func (s *mapStore) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[key] = value
+	return nil
+}
+
+func (s *mapStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, key)
+	return nil
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+
+	type result struct {
+		Items []string
+	}
+
+	calls := 0
+	fill := func(ctx context.Context) (result, error) {
+		calls++
+		return result{Items: []string{"a", "b"}}, nil
+	}
+
+	t.Run("caches_until_invalidated", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+		calls = 0
+
+		n := NewNamespace(&mapStore{m: map[string]string{}}, "test", time.Minute)
+
+		v, err := Fetch(ctx, n, "k", fill)
+		r.NoError(err)
+		a.Equal([]string{"a", "b"}, v.Items)
+
+		v, err = Fetch(ctx, n, "k", fill)
+		r.NoError(err)
+		a.Equal([]string{"a", "b"}, v.Items)
+		a.Equal(1, calls)
+
+		_, err = Fetch(ctx, n, "other", fill)
+		r.NoError(err)
+		a.Equal(2, calls)
+
+		r.NoError(n.Delete(ctx, "k"))
+		_, err = Fetch(ctx, n, "k", fill)
+		r.NoError(err)
+		a.Equal(3, calls)
+
+		r.NoError(n.Invalidate(ctx))
+		_, err = Fetch(ctx, n, "k", fill)
+		r.NoError(err)
+		_, err = Fetch(ctx, n, "other", fill)
+		r.NoError(err)
+		a.Equal(5, calls)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := require.New(t)
+		calls = 0
+
+		n := NewNamespace(&mapStore{m: map[string]string{}}, "test", 0)
+
+		for range 3 {
+			_, err := Fetch(ctx, n, "k", fill)
+			r.NoError(err)
+		}
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("errors_not_cached", func(t *testing.T) {
+		r := require.New(t)
+
+		n := NewNamespace(&mapStore{m: map[string]string{}}, "test", time.Minute)
+
+		_, err := Fetch(ctx, n, "k", func(ctx context.Context) (result, error) {
+			return result{}, errors.New("boom")
+		})
+		r.Error(err)
+
+		v, err := Fetch(ctx, n, "k", fill)
+		r.NoError(err)
+		assert.Len(t, v.Items, 2)
+	})
+}
+
+func TestKey(t *testing.T) {
+	type params struct {
+		Page *string
+		Tags []string
+	}
+
+	page := "2"
+	a := Key(params{Page: &page, Tags: []string{"x"}})
+	b := Key(params{Page: &page, Tags: []string{"x"}})
+	c := Key(params{Tags: []string{"x"}})
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
@@ -0,0 +1,30 @@
+package redis
+
+import (
+	"time"
+
+	"github.com/redis/rueidis"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// NewClient connects to the Redis instance at REDIS_URL.
+func NewClient(cfg config.Config) (rueidis.Client, error) {
+	password, _ := cfg.RedisURL.User.Password()
+
+	client, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:      []string{cfg.RedisURL.Host},
+		Username:         cfg.RedisURL.User.Username(),
+		Password:         password,
+		DisableCache:     true,
+		ConnWriteTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("failed to connect to redis"))
+	}
+
+	return client, nil
+}
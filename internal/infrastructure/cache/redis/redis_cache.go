@@ -2,13 +2,14 @@ package redis
 
 import (
 	"context"
-	"fmt"
 	"time"
 
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/redis/rueidis"
 )
 
-var errNotFound = fmt.Errorf("not found")
+var errNotFound = fault.New("not found", ftag.With(ftag.NotFound))
 
 type RedisCache struct {
 	client  rueidis.Client
@@ -52,6 +53,26 @@ func (c *RedisCache) Set(ctx context.Context, key string, value string, ttl time
 	return nil
 }
 
+func (c *RedisCache) SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	cmd := c.client.B().
+		Set().
+		Key(key).
+		Value(value).
+		Nx().
+		Ex(ttl).
+		Build()
+
+	err := c.client.Do(ctx, cmd).Error()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *RedisCache) Delete(ctx context.Context, key string) error {
 	cmd := c.client.B().
 		Del().
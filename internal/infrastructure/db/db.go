@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"ariga.io/atlas/sql/migrate"
 	atlas_schema "ariga.io/atlas/sql/schema"
@@ -25,6 +27,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	_ "github.com/tursodatabase/libsql-client-go/libsql"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 
@@ -67,10 +70,10 @@ func newSQL(cfg config.Config) (*sql.DB, *sqlx.DB, error) {
 // to write too much test-specific code for DB stuff. We should use enttest tbh.
 var schemaLock = sync.Mutex{}
 
-func newEntClient(lc fx.Lifecycle, tf tracing.Factory, cfg config.Config, db *sql.DB) (*ent.Client, error) {
+func newEntClient(lc fx.Lifecycle, logger *slog.Logger, tf tracing.Factory, meter metric.Meter, cfg config.Config, db *sql.DB) (*ent.Client, error) {
 	wctx, cancel := context.WithCancel(context.Background())
 
-	client, err := connect(wctx, cfg, db)
+	client, replicas, err := connect(wctx, logger, meter, cfg, db)
 	if err != nil {
 		cancel()
 		return nil, err
@@ -78,12 +81,21 @@ func newEntClient(lc fx.Lifecycle, tf tracing.Factory, cfg config.Config, db *sq
 
 	tr := tf.Build(lc, "ent")
 
+	om, err := newOperationMetrics(meter)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
 	client.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
 		return ent.QuerierFunc(func(ctx context.Context, query ent.Query) (ent.Value, error) {
 			qc := entgo.QueryFromContext(ctx)
 			spanName := fmt.Sprintf("ent/%s/%s", qc.Op, qc.Type)
+			repository := repositoryCaller()
+			start := time.Now()
 
 			ctx, span := tr.Start(ctx, spanName, trace.WithAttributes(
+				attribute.String("repository", repository),
 				attribute.String("type", qc.Type),
 				attribute.String("op", qc.Op),
 				attribute.Bool("unique", opt.NewPtr(qc.Unique).OrZero()),
@@ -93,15 +105,21 @@ func newEntClient(lc fx.Lifecycle, tf tracing.Factory, cfg config.Config, db *sq
 			))
 			defer span.End()
 
-			return next.Query(ctx, query)
+			v, err := next.Query(ctx, query)
+			om.observe(ctx, span, start, qc.Type, qc.Op, repository, err)
+
+			return v, err
 		})
 	}))
 
 	client.Use(func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			spanName := fmt.Sprintf("ent/%s/%s", m.Op(), m.Type())
+			repository := repositoryCaller()
+			start := time.Now()
 
 			ctx, span := tr.Start(ctx, spanName, trace.WithAttributes(
+				attribute.String("repository", repository),
 				attribute.String("type", m.Type()),
 				attribute.String("op", m.Op().String()),
 				attribute.StringSlice("fields", m.Fields()),
@@ -111,25 +129,60 @@ func newEntClient(lc fx.Lifecycle, tf tracing.Factory, cfg config.Config, db *sq
 			))
 			defer span.End()
 
-			return next.Mutate(ctx, m)
+			v, err := next.Mutate(ctx, m)
+			om.observe(ctx, span, start, m.Type(), m.Op().String(), repository, err)
+
+			return v, err
 		})
 	})
 
+	driver, _, err := getDriver(cfg.DatabaseURL)
+	if err != nil {
+		cancel()
+		return nil, fault.Wrap(err)
+	}
+
+	fullText, err := fullTextEnabled(driver, cfg.SearchProvider)
+	if err != nil {
+		cancel()
+		return nil, fault.Wrap(err)
+	}
+
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			schemaLock.Lock()
 			defer schemaLock.Unlock()
 
-			// Run migrations with hooks and index cleanup.
-			if err := client.Schema.Create(
-				ctx,
+			opts := []schema.MigrateOption{
 				schema.WithDropIndex(true),
 				schema.WithDropColumn(true),
 				schema.WithApplyHook(populateLastReplyAt()),
-			); err != nil {
+			}
+
+			if driver == "pgx" {
+				opts = append(opts, schema.WithDiffHook(preserveFullTextColumns()))
+			}
+
+			// Run migrations with hooks and index cleanup.
+			if err := client.Schema.Create(ctx, opts...); err != nil {
 				return fault.Wrap(err, fctx.With(ctx))
 			}
 
+			if fullText {
+				ensure := ensureFullText
+				if driver == "sqlite" {
+					ensure = ensureFullTextSQLite
+				}
+
+				if err := ensure(ctx, db, cfg.SearchLanguage); err != nil {
+					return fault.Wrap(err, fctx.With(ctx))
+				}
+			}
+
+			if replicas != nil {
+				go replicas.monitor(wctx)
+			}
+
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
@@ -147,33 +200,47 @@ func newEntClient(lc fx.Lifecycle, tf tracing.Factory, cfg config.Config, db *sq
 	return client, nil
 }
 
-func connect(ctx context.Context, cfg config.Config, driver *sql.DB) (*ent.Client, error) {
+func connect(ctx context.Context, logger *slog.Logger, meter metric.Meter, cfg config.Config, driver *sql.DB) (*ent.Client, *routingDriver, error) {
 	d, _, err := getDriver(cfg.DatabaseURL)
 	if err != nil {
-		return nil, fault.Wrap(err)
+		return nil, nil, fault.Wrap(err)
 	}
 
-	opts := []ent.Option{}
+	var drv *entsql.Driver
 
 	switch d {
 	case "pgx":
-		opts = append(opts, ent.Driver(entsql.OpenDB(dialect.Postgres, driver)))
+		drv = entsql.OpenDB(dialect.Postgres, driver)
 
 	case "sqlite":
-		opts = append(opts,
-			ent.Driver(entsql.OpenDB(dialect.SQLite, driver)),
-		)
+		drv = entsql.OpenDB(dialect.SQLite, driver)
 
 	case "libsql":
-		opts = append(opts,
-			ent.Driver(entsql.OpenDB(dialect.SQLite, driver)),
-		)
+		drv = entsql.OpenDB(dialect.SQLite, driver)
 
 	default:
 		panic(fmt.Sprintf("unsupported driver '%s' in ent connect", d))
 	}
 
-	return ent.NewClient(opts...), nil
+	if len(cfg.DatabaseReplicaURLs) == 0 {
+		return ent.NewClient(ent.Driver(drv)), nil, nil
+	}
+
+	if d != "pgx" {
+		return nil, nil, fault.New("DATABASE_REPLICA_URLS requires a PostgreSQL DATABASE_URL")
+	}
+
+	replicas, err := newReplicas(cfg)
+	if err != nil {
+		return nil, nil, fault.Wrap(err)
+	}
+
+	rd, err := newRoutingDriver(logger, meter, cfg, drv, replicas)
+	if err != nil {
+		return nil, nil, fault.Wrap(err)
+	}
+
+	return ent.NewClient(ent.Driver(rd)), rd, nil
 }
 
 func getDriver(databaseURL string) (string, string, error) {
@@ -209,7 +276,7 @@ func getDriver(databaseURL string) (string, string, error) {
 			return "", "", fault.Wrap(err, fmsg.With(fmt.Sprintf("cannot write to directory for sqlite database: %s", u)))
 		}
 
-		return "sqlite", path, nil
+		return "sqlite", withSQLitePragmas(path), nil
 
 	case "libsql":
 		// NOTE: Only remote Turso, local file-based libSQL is not supported.
@@ -220,6 +287,42 @@ func getDriver(databaseURL string) (string, string, error) {
 	}
 }
 
+// sqliteDefaultPragmas are applied unless the URL sets them explicitly. Without
+// foreign keys SQLite silently ignores cascades and references, and without a
+// busy timeout concurrent writers fail immediately instead of waiting.
+var sqliteDefaultPragmas = []string{
+	"foreign_keys(1)",
+	"busy_timeout(10000)",
+}
+
+func withSQLitePragmas(path string) string {
+	file, query, _ := strings.Cut(path, "?")
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return path
+	}
+
+	set := values["_pragma"]
+	for _, p := range sqliteDefaultPragmas {
+		name, _, _ := strings.Cut(p, "(")
+
+		explicit := false
+		for _, s := range set {
+			if strings.HasPrefix(strings.ToLower(s), name) {
+				explicit = true
+				break
+			}
+		}
+
+		if !explicit {
+			values.Add("_pragma", p)
+		}
+	}
+
+	return file + "?" + values.Encode()
+}
+
 // populateLastReplyAt is a data migration hook that fills NULL last_reply_at values
 // with created_at for threads. This only runs when the last_reply_at column is being
 // modified (e.g., changing from nullable to non-nullable).
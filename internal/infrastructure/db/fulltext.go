@@ -0,0 +1,147 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	atlas_schema "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+)
+
+// FullTextColumn is the generated tsvector column added to each searchable
+// table when Postgres full-text search is enabled. These columns are managed
+// outside of the ent schema because ent cannot express generated columns.
+const FullTextColumn = "search_tsv"
+
+var fullTextIndexSuffix = "_" + FullTextColumn + "_idx"
+
+type fullTextTable struct {
+	name string
+	// expr is the tsvector expression, %[1]s is replaced by the language.
+	expr string
+}
+
+var fullTextTables = []fullTextTable{
+	{
+		name: "posts",
+		expr: `setweight(to_tsvector('%[1]s', coalesce(title, '')), 'A') || setweight(to_tsvector('%[1]s', body), 'B')`,
+	},
+	{
+		name: "nodes",
+		expr: `setweight(to_tsvector('%[1]s', name), 'A') || setweight(to_tsvector('%[1]s', coalesce(description, '')), 'B') || setweight(to_tsvector('%[1]s', coalesce(content, '')), 'C')`,
+	},
+	{
+		name: "accounts",
+		expr: `setweight(to_tsvector('simple', handle), 'A') || setweight(to_tsvector('%[1]s', name), 'A') || setweight(to_tsvector('%[1]s', coalesce(bio, '')), 'B')`,
+	},
+}
+
+var validLanguage = regexp.MustCompile(`^[a-z_]+$`)
+
+func fullTextEnabled(driver string, searchProvider string) (bool, error) {
+	switch searchProvider {
+	case "postgres":
+		if driver != "pgx" {
+			return false, fault.New("SEARCH_PROVIDER is set to postgres but DATABASE_URL is not a Postgres database")
+		}
+		return true, nil
+
+	case "fulltext":
+		if driver != "pgx" && driver != "sqlite" {
+			return false, fault.New("SEARCH_PROVIDER is set to fulltext but DATABASE_URL is not a Postgres or SQLite database")
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// ensureFullText creates the generated search columns and their GIN indexes.
+// If the language has changed since the columns were created, they are dropped
+// and rebuilt which causes Postgres to re-compute the vector for every row.
+func ensureFullText(ctx context.Context, db *sql.DB, language string) error {
+	if !validLanguage.MatchString(language) {
+		return fault.Newf("invalid SEARCH_LANGUAGE: %q", language)
+	}
+
+	var exists bool
+	err := db.QueryRowContext(ctx, `select exists(select 1 from pg_ts_config where cfgname = $1)`, language).Scan(&exists)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if !exists {
+		return fault.Newf("SEARCH_LANGUAGE %q is not a Postgres text search configuration", language)
+	}
+
+	for _, t := range fullTextTables {
+		var current sql.NullString
+		err := db.QueryRowContext(ctx, `select generation_expression from information_schema.columns where table_name = $1 and column_name = $2`,
+			t.name, FullTextColumn,
+		).Scan(&current)
+		if err != nil && err != sql.ErrNoRows {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if current.Valid && !strings.Contains(current.String, fmt.Sprintf("'%s'::regconfig", language)) {
+			if _, err := db.ExecContext(ctx, fmt.Sprintf(`alter table %s drop column %s`, t.name, FullTextColumn)); err != nil {
+				return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to drop search column for %s", t.name)))
+			}
+		}
+
+		ddl := []string{
+			fmt.Sprintf(`alter table %s add column if not exists %s tsvector generated always as (%s) stored`,
+				t.name, FullTextColumn, fmt.Sprintf(t.expr, language)),
+			fmt.Sprintf(`create index if not exists %s%s on %s using gin (%s)`,
+				t.name, fullTextIndexSuffix, t.name, FullTextColumn),
+		}
+
+		for _, q := range ddl {
+			if _, err := db.ExecContext(ctx, q); err != nil {
+				return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to create search column for %s", t.name)))
+			}
+		}
+	}
+
+	return nil
+}
+
+// preserveFullTextColumns stops the auto-migration from dropping the search
+// columns and indexes, which it would otherwise do as they're not in the schema.
+// This applies even when full-text search is disabled so that toggling it, or
+// other processes sharing the database, don't cause a costly rebuild.
+func preserveFullTextColumns() schema.DiffHook {
+	return func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas_schema.Schema) ([]atlas_schema.Change, error) {
+			changes, err := next.Diff(current, desired)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, c := range changes {
+				m, ok := c.(*atlas_schema.ModifyTable)
+				if !ok {
+					continue
+				}
+
+				m.Changes = dt.Filter(m.Changes, func(c atlas_schema.Change) bool {
+					switch v := c.(type) {
+					case *atlas_schema.DropColumn:
+						return v.C.Name != FullTextColumn
+					case *atlas_schema.DropIndex:
+						return !strings.HasSuffix(v.I.Name, fullTextIndexSuffix)
+					}
+					return true
+				})
+			}
+
+			return changes, nil
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+)
+
+// FullTextTableSuffix names the FTS5 virtual table which mirrors each of the
+// searchable tables when full-text search is enabled on SQLite. The tables are
+// kept in sync with triggers so writes through ent need no special handling.
+const FullTextTableSuffix = "_fts"
+
+type fullTextVirtualTable struct {
+	name    string
+	columns []string
+}
+
+// The first column of each virtual table is always the unindexed row ID.
+var fullTextVirtualTables = []fullTextVirtualTable{
+	{name: "posts", columns: []string{"title", "body"}},
+	{name: "nodes", columns: []string{"name", "description", "content"}},
+	{name: "accounts", columns: []string{"handle", "name", "bio"}},
+}
+
+// sqliteTokenizer maps SEARCH_LANGUAGE to an FTS5 tokenizer. SQLite only ships
+// an English stemmer, every other language is tokenised without stemming.
+func sqliteTokenizer(language string) string {
+	if language == "english" {
+		return "porter unicode61 remove_diacritics 2"
+	}
+	return "unicode61 remove_diacritics 2"
+}
+
+// ensureFullTextSQLite creates the FTS5 tables and the triggers which maintain
+// them. A table is rebuilt from scratch when it's first created, when the
+// language has changed or when any of its triggers are missing, which happens
+// when the auto-migration recreates the underlying table.
+func ensureFullTextSQLite(ctx context.Context, db *sql.DB, language string) error {
+	if !validLanguage.MatchString(language) {
+		return fault.Newf("invalid SEARCH_LANGUAGE: %q", language)
+	}
+
+	tokenizer := sqliteTokenizer(language)
+
+	for _, t := range fullTextVirtualTables {
+		if err := ensureFullTextVirtualTable(ctx, db, t, tokenizer); err != nil {
+			return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to create search table for %s", t.name)))
+		}
+	}
+
+	return nil
+}
+
+func ensureFullTextVirtualTable(ctx context.Context, db *sql.DB, t fullTextVirtualTable, tokenizer string) error {
+	fts := t.name + FullTextTableSuffix
+	create := fmt.Sprintf(`create virtual table %s using fts5(id unindexed, %s, tokenize = '%s')`,
+		fts, strings.Join(t.columns, ", "), tokenizer)
+
+	var current sql.NullString
+	err := db.QueryRowContext(ctx, `select sql from sqlite_master where type = 'table' and name = $1`, fts).Scan(&current)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	var triggers int
+	err = db.QueryRowContext(ctx, `select count(*) from sqlite_master where type = 'trigger' and tbl_name = $1 and name like $2`,
+		t.name, fts+"_%").Scan(&triggers)
+	if err != nil {
+		return err
+	}
+
+	stale := !current.Valid || !strings.EqualFold(current.String, create) || triggers != 3
+	if !stale {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	values := make([]string, 0, len(t.columns))
+	for _, c := range t.columns {
+		values = append(values, "coalesce(new."+c+", '')")
+	}
+	insert := fmt.Sprintf(`insert into %s (id, %s) values (new.id, %s);`, fts, strings.Join(t.columns, ", "), strings.Join(values, ", "))
+	remove := fmt.Sprintf(`delete from %s where id = old.id;`, fts)
+
+	selects := make([]string, 0, len(t.columns))
+	for _, c := range t.columns {
+		selects = append(selects, "coalesce("+c+", '')")
+	}
+
+	ddl := []string{
+		fmt.Sprintf(`drop table if exists %s`, fts),
+		fmt.Sprintf(`drop trigger if exists %s_insert`, fts),
+		fmt.Sprintf(`drop trigger if exists %s_update`, fts),
+		fmt.Sprintf(`drop trigger if exists %s_delete`, fts),
+		create,
+		fmt.Sprintf(`create trigger %s_insert after insert on %s begin %s end`, fts, t.name, insert),
+		fmt.Sprintf(`create trigger %s_update after update of %s on %s begin %s %s end`, fts, strings.Join(t.columns, ", "), t.name, remove, insert),
+		fmt.Sprintf(`create trigger %s_delete after delete on %s begin %s end`, fts, t.name, remove),
+		fmt.Sprintf(`insert into %s (id, %s) select id, %s from %s`, fts, strings.Join(t.columns, ", "), strings.Join(selects, ", "), t.name),
+	}
+
+	for _, q := range ddl {
+		if _, err := tx.ExecContext(ctx, q); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
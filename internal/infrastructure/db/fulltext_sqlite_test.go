@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureFullTextSQLite(t *testing.T) {
+	ctx := context.Background()
+	r := require.New(t)
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "fts.db"))
+	r.NoError(err)
+	t.Cleanup(func() { db.Close() })
+
+	for _, q := range []string{
+		`create table posts (id text primary key, title text, body text not null)`,
+		`create table nodes (id text primary key, name text not null, description text, content text)`,
+		`create table accounts (id text primary key, handle text not null, name text not null, bio text)`,
+		`insert into posts values ('existing', 'running things', '<p>hello</p>')`,
+	} {
+		_, err := db.Exec(q)
+		r.NoError(err)
+	}
+
+	matches := func(q string) []string {
+		t.Helper()
+
+		rows, err := db.Query(`select id from posts_fts where posts_fts match $1 order by id`, q)
+		r.NoError(err)
+		defer rows.Close()
+
+		ids := []string{}
+		for rows.Next() {
+			var id string
+			r.NoError(rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	r.NoError(ensureFullTextSQLite(ctx, db, "english"))
+	assert.Equal(t, []string{"existing"}, matches("run"), "existing rows are indexed and stemmed")
+
+	_, err = db.Exec(`insert into posts values ('new', null, 'a new post')`)
+	r.NoError(err)
+	_, err = db.Exec(`update posts set title = 'walking' where id = 'existing'`)
+	r.NoError(err)
+	assert.Equal(t, []string{"new"}, matches("post"))
+	assert.Equal(t, []string{"existing"}, matches("walk"))
+	assert.Empty(t, matches("run"))
+
+	_, err = db.Exec(`delete from posts where id = 'new'`)
+	r.NoError(err)
+	assert.Empty(t, matches("post"))
+
+	r.NoError(ensureFullTextSQLite(ctx, db, "english"))
+	assert.Equal(t, []string{"existing"}, matches("walk"), "unchanged tables are left alone")
+
+	// Recreating a table during migration drops its triggers.
+	_, err = db.Exec(`drop trigger posts_fts_insert`)
+	r.NoError(err)
+	_, err = db.Exec(`insert into posts values ('missed', null, 'written without triggers')`)
+	r.NoError(err)
+	assert.Empty(t, matches("triggers"))
+
+	r.NoError(ensureFullTextSQLite(ctx, db, "english"))
+	assert.Equal(t, []string{"missed"}, matches("triggers"))
+
+	r.NoError(ensureFullTextSQLite(ctx, db, "simple"))
+	assert.Empty(t, matches("walk"), "changing the language rebuilds without stemming")
+	assert.Equal(t, []string{"existing"}, matches("walking"))
+
+	r.Error(ensureFullTextSQLite(ctx, db, "english'); drop table posts; --"))
+}
+
+func TestWithSQLitePragmas(t *testing.T) {
+	pragmas := func(path string) []string {
+		_, query, _ := strings.Cut(withSQLitePragmas(path), "?")
+		v, err := url.ParseQuery(query)
+		require.NoError(t, err)
+		return v["_pragma"]
+	}
+
+	assert.Equal(t, []string{"foreign_keys(1)", "busy_timeout(10000)"}, pragmas("data/data.db"))
+	assert.Equal(t, []string{"foreign_keys(0)", "journal_mode(WAL)", "busy_timeout(10000)"}, pragmas("data/data.db?_pragma=foreign_keys(0)&_pragma=journal_mode(WAL)"))
+}
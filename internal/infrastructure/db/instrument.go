@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/metrics"
+)
+
+const resourcesPackage = "/app/resources/"
+
+type operationMetrics struct {
+	duration metric.Float64Histogram
+}
+
+func newOperationMetrics(meter metric.Meter) (*operationMetrics, error) {
+	duration, err := meter.Float64Histogram("db.client.operation.duration",
+		metric.WithDescription("Duration of database queries and mutations."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(metrics.LatencyBuckets...),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &operationMetrics{duration: duration}, nil
+}
+
+// observe ends an operation's span and records how long it took, labelled by
+// the repository method which issued it.
+func (o *operationMetrics) observe(ctx context.Context, span trace.Span, start time.Time, typ, op, repository string, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	o.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("type", typ),
+		attribute.String("op", op),
+		attribute.String("repository", repository),
+		attribute.Bool("error", err != nil),
+	))
+}
+
+// repositoryCaller finds the resource package function which issued a query,
+// such as "thread_querier.(*Querier).Get", so queries can be attributed to a
+// repository method without instrumenting each one by hand.
+func repositoryCaller() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+
+		if _, name, ok := strings.Cut(frame.Function, resourcesPackage); ok {
+			if i := strings.LastIndex(name, "/"); i >= 0 {
+				name = name[i+1:]
+			}
+			return name
+		}
+
+		if !more {
+			return "unknown"
+		}
+	}
+}
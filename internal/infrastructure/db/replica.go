@@ -0,0 +1,271 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+var (
+	// ReplicaCheckInterval is how often each replica's lag is measured.
+	ReplicaCheckInterval = 5 * time.Second
+
+	replicaCheckTimeout = 2 * time.Second
+)
+
+// When the primary is idle the last replayed transaction gets older without
+// the replica falling behind, so a replica which has replayed everything it
+// received is treated as up to date.
+const replicaLagQuery = `select coalesce(
+  case when pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() then 0
+  else extract(epoch from now() - pg_last_xact_replay_timestamp()) end, 0)`
+
+// ListingStaleness is how out of date listings such as the thread list may be.
+// New posts showing up a few seconds late isn't noticeable, a member's own
+// posts always show up as their reads stay on the primary after writing.
+const ListingStaleness = 30 * time.Second
+
+type staleKey struct{}
+
+// AllowStale marks reads made with the returned context as tolerant of data up
+// to maxLag out of date so they may be served by a read replica. Only use this
+// for reads where a member wouldn't notice, such as listings and profiles.
+func AllowStale(ctx context.Context, maxLag time.Duration) context.Context {
+	return context.WithValue(ctx, staleKey{}, maxLag)
+}
+
+type sessionKey struct{}
+
+// Session tracks whether a request has written to the database. Once it has,
+// its reads are served by the primary so it always reads its own writes.
+type Session struct {
+	pinned bool
+	wrote  atomic.Bool
+}
+
+// WithSession starts tracking writes for a request. A pinned session never
+// reads from replicas, used when a recent request from the same client wrote.
+func WithSession(ctx context.Context, pinned bool) (context.Context, *Session) {
+	s := &Session{pinned: pinned}
+	return context.WithValue(ctx, sessionKey{}, s), s
+}
+
+func (s *Session) Wrote() bool {
+	return s.wrote.Load()
+}
+
+func markWrite(ctx context.Context) {
+	if s, ok := ctx.Value(sessionKey{}).(*Session); ok {
+		s.wrote.Store(true)
+	}
+}
+
+type replica struct {
+	name string
+	drv  *entsql.Driver
+
+	// lag is in nanoseconds, negative while the replica is unavailable.
+	lag atomic.Int64
+}
+
+// routingDriver sends reads which tolerate stale data to a replica which is
+// within tolerance, anything else is sent to the primary.
+type routingDriver struct {
+	*entsql.Driver
+
+	logger   *slog.Logger
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+	routed   metric.Int64Counter
+}
+
+func newReplicas(cfg config.Config) ([]*replica, error) {
+	replicas := make([]*replica, 0, len(cfg.DatabaseReplicaURLs))
+
+	for _, raw := range cfg.DatabaseReplicaURLs {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, fault.Wrap(err, fmsg.With("failed to parse DATABASE_REPLICA_URLS"))
+		}
+
+		if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+			return nil, fault.Newf("read replicas must be PostgreSQL, got %q", u.Scheme)
+		}
+
+		db, err := sql.Open("pgx", raw)
+		if err != nil {
+			return nil, fault.Wrap(err, fmsg.With("failed to connect to read replica"))
+		}
+
+		r := &replica{
+			// Only the host is used to identify the replica so credentials
+			// never end up in logs or metrics.
+			name: u.Host,
+			drv:  entsql.OpenDB(dialect.Postgres, db),
+		}
+		r.lag.Store(-1)
+
+		replicas = append(replicas, r)
+	}
+
+	return replicas, nil
+}
+
+func newRoutingDriver(logger *slog.Logger, meter metric.Meter, cfg config.Config, primary *entsql.Driver, replicas []*replica) (*routingDriver, error) {
+	routed, err := meter.Int64Counter("db.client.replica.queries",
+		metric.WithDescription("Reads served by a read replica."),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	d := &routingDriver{
+		Driver:   primary,
+		logger:   logger,
+		replicas: replicas,
+		maxLag:   cfg.DatabaseReplicaMaxLag,
+		routed:   routed,
+	}
+
+	_, err = meter.Float64ObservableGauge("db.replica.lag",
+		metric.WithDescription("How far each read replica is behind the primary, not reported while a replica is unavailable."),
+		metric.WithUnit("s"),
+		metric.WithFloat64Callback(func(ctx context.Context, o metric.Float64Observer) error {
+			for _, r := range d.replicas {
+				if lag := r.lag.Load(); lag >= 0 {
+					o.Observe(time.Duration(lag).Seconds(), metric.WithAttributes(attribute.String("replica", r.name)))
+				}
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return d, nil
+}
+
+func (d *routingDriver) Query(ctx context.Context, query string, args, v any) error {
+	// Postgres inserts and updates use RETURNING so aren't always an Exec.
+	if !isRead(query) {
+		markWrite(ctx)
+		return d.Driver.Query(ctx, query, args, v)
+	}
+
+	if r := d.pick(ctx); r != nil {
+		d.routed.Add(ctx, 1, metric.WithAttributes(attribute.String("replica", r.name)))
+		return r.drv.Query(ctx, query, args, v)
+	}
+
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *routingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	markWrite(ctx)
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *routingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	markWrite(ctx)
+	return d.Driver.Tx(ctx)
+}
+
+func (d *routingDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	markWrite(ctx)
+	return d.Driver.BeginTx(ctx, opts)
+}
+
+func (d *routingDriver) Close() error {
+	for _, r := range d.replicas {
+		r.drv.Close()
+	}
+	return d.Driver.Close()
+}
+
+func (d *routingDriver) pick(ctx context.Context) *replica {
+	tolerance, ok := ctx.Value(staleKey{}).(time.Duration)
+	if !ok {
+		return nil
+	}
+
+	if s, ok := ctx.Value(sessionKey{}).(*Session); ok && (s.pinned || s.Wrote()) {
+		return nil
+	}
+
+	limit := min(tolerance, d.maxLag)
+
+	n := uint64(len(d.replicas))
+	start := d.next.Add(1)
+	for i := range n {
+		r := d.replicas[(start+i)%n]
+		if lag := r.lag.Load(); lag >= 0 && time.Duration(lag) <= limit {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// monitor measures each replica's lag until ctx is cancelled.
+func (d *routingDriver) monitor(ctx context.Context) {
+	ticker := time.NewTicker(ReplicaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, r := range d.replicas {
+			d.measure(ctx, r)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *routingDriver) measure(ctx context.Context, r *replica) {
+	ctx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+	defer cancel()
+
+	var seconds float64
+	err := r.drv.DB().QueryRowContext(ctx, replicaLagQuery).Scan(&seconds)
+	if err != nil {
+		if r.lag.Swap(-1) >= 0 {
+			d.logger.Warn("read replica unavailable",
+				slog.String("replica", r.name),
+				slog.String("error", err.Error()),
+			)
+		}
+		return
+	}
+
+	lag := time.Duration(seconds * float64(time.Second))
+	if r.lag.Swap(int64(lag)) < 0 {
+		d.logger.Info("read replica available", slog.String("replica", r.name))
+	}
+}
+
+// isRead is deliberately strict, anything which isn't clearly a plain select
+// is sent to the primary.
+func isRead(query string) bool {
+	q := strings.ToUpper(strings.TrimSpace(query))
+	return strings.HasPrefix(q, "SELECT") &&
+		!strings.Contains(q, " FOR UPDATE") &&
+		!strings.Contains(q, " FOR SHARE")
+}
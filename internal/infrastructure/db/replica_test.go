@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func openNamed(t *testing.T, name string) *entsql.Driver {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), name+".db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec("create table source (name text)")
+	require.NoError(t, err)
+	_, err = db.Exec("insert into source values (?)", name)
+	require.NoError(t, err)
+
+	return entsql.OpenDB(dialect.SQLite, db)
+}
+
+func source(t *testing.T, ctx context.Context, d dialect.Driver) string {
+	t.Helper()
+
+	rows := &entsql.Rows{}
+	require.NoError(t, d.Query(ctx, "SELECT name FROM source", []any{}, rows))
+	defer rows.Close()
+
+	var name string
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&name))
+	return name
+}
+
+func TestRoutingDriver(t *testing.T) {
+	ctx := context.Background()
+
+	r := &replica{name: "replica", drv: openNamed(t, "replica")}
+	r.lag.Store(int64(time.Second))
+
+	d, err := newRoutingDriver(slog.Default(), noop.NewMeterProvider().Meter("test"),
+		config.Config{DatabaseReplicaMaxLag: 10 * time.Second},
+		openNamed(t, "primary"), []*replica{r},
+	)
+	require.NoError(t, err)
+
+	t.Run("primary_by_default", func(t *testing.T) {
+		assert.Equal(t, "primary", source(t, ctx, d))
+	})
+
+	t.Run("stale_reads_use_replica", func(t *testing.T) {
+		assert.Equal(t, "replica", source(t, AllowStale(ctx, time.Minute), d))
+	})
+
+	t.Run("lagging_replica_skipped", func(t *testing.T) {
+		assert.Equal(t, "primary", source(t, AllowStale(ctx, 500*time.Millisecond), d))
+
+		r.lag.Store(int64(time.Minute))
+		defer r.lag.Store(int64(time.Second))
+		assert.Equal(t, "primary", source(t, AllowStale(ctx, time.Hour), d))
+
+		r.lag.Store(-1)
+		assert.Equal(t, "primary", source(t, AllowStale(ctx, time.Hour), d))
+	})
+
+	t.Run("reads_own_writes", func(t *testing.T) {
+		sctx, session := WithSession(AllowStale(ctx, time.Minute), false)
+		assert.Equal(t, "replica", source(t, sctx, d))
+
+		require.NoError(t, d.Exec(sctx, "UPDATE source SET name = name", []any{}, nil))
+		assert.True(t, session.Wrote())
+		assert.Equal(t, "primary", source(t, sctx, d))
+	})
+
+	t.Run("pinned_session", func(t *testing.T) {
+		sctx, _ := WithSession(AllowStale(ctx, time.Minute), true)
+		assert.Equal(t, "primary", source(t, sctx, d))
+	})
+}
+
+func TestIsRead(t *testing.T) {
+	assert.True(t, isRead("SELECT * FROM posts"))
+	assert.True(t, isRead("  select 1"))
+	assert.False(t, isRead(`INSERT INTO "posts" ("id") VALUES ($1) RETURNING "id"`))
+	assert.False(t, isRead("SELECT * FROM posts WHERE id = $1 FOR UPDATE"))
+	assert.False(t, isRead("WITH x AS (DELETE FROM posts RETURNING id) SELECT * FROM x"))
+}
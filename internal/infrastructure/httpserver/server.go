@@ -14,9 +14,9 @@ import (
 	"github.com/Southclaws/storyden/internal/config"
 )
 
-func NewServer(lc fx.Lifecycle, logger *slog.Logger, cfg config.Config, router *http.ServeMux) *http.Server {
+func NewServer(lc fx.Lifecycle, logger *slog.Logger, cfg config.Config, handler http.Handler) *http.Server {
 	server := &http.Server{
-		Handler: router,
+		Handler: handler,
 		Addr:    cfg.ListenAddr,
 	}
 
@@ -0,0 +1,238 @@
+// Package httpsig implements the subset of the HTTP Signatures draft used by
+// ActivityPub servers such as Mastodon: rsa-sha256 signatures over the request
+// target, host, date and, for requests with a body, a SHA-256 digest header.
+package httpsig
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+)
+
+const (
+	HeaderSignature = "Signature"
+	HeaderDigest    = "Digest"
+	HeaderDate      = "Date"
+
+	algorithm = "rsa-sha256"
+)
+
+// MaxClockSkew is how far a signed request's date may be from now.
+var MaxClockSkew = 12 * time.Hour
+
+type Signature struct {
+	KeyID     string
+	Headers   []string
+	Signature []byte
+}
+
+// Sign adds Date, Digest (when body is non-nil) and Signature headers to the
+// request. The body must be the exact bytes which will be sent.
+func Sign(r *http.Request, keyID string, key *rsa.PrivateKey, body []byte) error {
+	if r.Header.Get(HeaderDate) == "" {
+		r.Header.Set(HeaderDate, time.Now().UTC().Format(http.TimeFormat))
+	}
+
+	headers := []string{"(request-target)", "host", "date"}
+	if body != nil {
+		r.Header.Set(HeaderDigest, digest(body))
+		headers = append(headers, "digest")
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(r, headers)))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	r.Header.Set(HeaderSignature, fmt.Sprintf(`keyId="%s",algorithm="%s",headers="%s",signature="%s"`,
+		keyID,
+		algorithm,
+		strings.Join(headers, " "),
+		base64.StdEncoding.EncodeToString(sig),
+	))
+
+	return nil
+}
+
+// Parse reads the Signature header of a request without verifying it, so the
+// key ID can be used to look up the signer's public key.
+func Parse(r *http.Request) (*Signature, error) {
+	raw := r.Header.Get(HeaderSignature)
+	if raw == "" {
+		return nil, fault.New("request is not signed", ftag.With(ftag.Unauthenticated))
+	}
+
+	params := map[string]string{}
+	for _, part := range strings.Split(raw, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		params[k] = strings.Trim(v, `"`)
+	}
+
+	if a, ok := params["algorithm"]; ok && a != algorithm && a != "hs2019" {
+		return nil, fault.Newf("unsupported signature algorithm: %s", a)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(params["signature"])
+	if err != nil || len(sig) == 0 || params["keyId"] == "" {
+		return nil, fault.New("malformed signature header", ftag.With(ftag.Unauthenticated))
+	}
+
+	headers := []string{"date"}
+	if h, ok := params["headers"]; ok {
+		headers = strings.Fields(strings.ToLower(h))
+	}
+
+	return &Signature{
+		KeyID:     params["keyId"],
+		Headers:   headers,
+		Signature: sig,
+	}, nil
+}
+
+// Verify checks a parsed signature against the request using the signer's
+// public key. The body must be the exact bytes received.
+func Verify(r *http.Request, s *Signature, key *rsa.PublicKey, body []byte) error {
+	covers := func(h string) bool {
+		for _, c := range s.Headers {
+			if c == h {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !covers("(request-target)") || !covers("date") && !covers("(created)") {
+		return fault.New("signature does not cover the request target and date", ftag.With(ftag.Unauthenticated))
+	}
+
+	if len(body) > 0 {
+		if !covers("digest") {
+			return fault.New("signature does not cover the body digest", ftag.With(ftag.Unauthenticated))
+		}
+		if r.Header.Get(HeaderDigest) != digest(body) {
+			return fault.New("body digest mismatch", ftag.With(ftag.Unauthenticated))
+		}
+	}
+
+	if d := r.Header.Get(HeaderDate); d != "" {
+		at, err := http.ParseTime(d)
+		if err != nil {
+			return fault.Wrap(err, ftag.With(ftag.Unauthenticated))
+		}
+		if skew := time.Since(at); skew > MaxClockSkew || skew < -MaxClockSkew {
+			return fault.New("signature date is outside the allowed window", ftag.With(ftag.Unauthenticated))
+		}
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(r, s.Headers)))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], s.Signature); err != nil {
+		return fault.Wrap(err, ftag.With(ftag.Unauthenticated))
+	}
+
+	return nil
+}
+
+func signingString(r *http.Request, headers []string) string {
+	lines := make([]string, 0, len(headers))
+	for _, h := range headers {
+		switch h {
+		case "(request-target)":
+			lines = append(lines, fmt.Sprintf("(request-target): %s %s", strings.ToLower(r.Method), r.URL.RequestURI()))
+		case "host":
+			host := r.Host
+			if host == "" {
+				host = r.URL.Host
+			}
+			lines = append(lines, "host: "+host)
+		default:
+			lines = append(lines, h+": "+r.Header.Get(h))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func GenerateKey() (*rsa.PrivateKey, error) {
+	return rsa.GenerateKey(rand.Reader, 2048)
+}
+
+func EncodePrivateKey(key *rsa.PrivateKey) (string, error) {
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fault.Wrap(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b})), nil
+}
+
+func EncodePublicKey(key *rsa.PublicKey) (string, error) {
+	b, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", fault.Wrap(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b})), nil
+}
+
+func ParsePrivateKey(in string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(in))
+	if block == nil {
+		return nil, fault.New("no PEM block found in private key")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	rk, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fault.New("private key is not an RSA key")
+	}
+
+	return rk, nil
+}
+
+func ParsePublicKey(in string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(in))
+	if block == nil {
+		return nil, fault.New("no PEM block found in public key", ftag.With(ftag.InvalidArgument))
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+		}
+		return key, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	rk, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fault.New("public key is not an RSA key", ftag.With(ftag.InvalidArgument))
+	}
+
+	return rk, nil
+}
@@ -0,0 +1,86 @@
+package httpsig
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	key, err := GenerateKey()
+	r.NoError(err)
+
+	pub, err := EncodePublicKey(&key.PublicKey)
+	r.NoError(err)
+	parsed, err := ParsePublicKey(pub)
+	r.NoError(err)
+
+	body := []byte(`{"type":"Follow"}`)
+
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "https://example.com/ap/instance/inbox", bytes.NewReader(body))
+		r.NoError(err)
+		r.NoError(Sign(req, "https://example.com/ap/instance#main-key", key, body))
+		return req
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		req := newRequest()
+
+		sig, err := Parse(req)
+		r.NoError(err)
+		a.Equal("https://example.com/ap/instance#main-key", sig.KeyID)
+		a.Equal([]string{"(request-target)", "host", "date", "digest"}, sig.Headers)
+
+		a.NoError(Verify(req, sig, parsed, body))
+	})
+
+	t.Run("tampered_body", func(t *testing.T) {
+		req := newRequest()
+
+		sig, err := Parse(req)
+		r.NoError(err)
+
+		a.Error(Verify(req, sig, parsed, []byte(`{"type":"Undo"}`)))
+	})
+
+	t.Run("wrong_key", func(t *testing.T) {
+		req := newRequest()
+
+		other, err := GenerateKey()
+		r.NoError(err)
+
+		sig, err := Parse(req)
+		r.NoError(err)
+
+		a.Error(Verify(req, sig, &other.PublicKey, body))
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "https://example.com/ap/instance/inbox", nil)
+		r.NoError(err)
+
+		_, err = Parse(req)
+		a.Error(err)
+	})
+}
+
+func TestPrivateKeyRoundTrip(t *testing.T) {
+	r := require.New(t)
+
+	key, err := GenerateKey()
+	r.NoError(err)
+
+	encoded, err := EncodePrivateKey(key)
+	r.NoError(err)
+
+	decoded, err := ParsePrivateKey(encoded)
+	r.NoError(err)
+	r.True(key.Equal(decoded))
+}
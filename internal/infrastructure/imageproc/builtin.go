@@ -0,0 +1,30 @@
+package imageproc
+
+import (
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/Southclaws/fault"
+)
+
+// Builtin encodes JPEG and PNG using the standard library, it cannot produce
+// WebP or AVIF so requests for those formats are served in the source format.
+type Builtin struct{}
+
+func (b *Builtin) Supports(f Format) bool {
+	return f == FormatJPEG || f == FormatPNG
+}
+
+func (b *Builtin) Encode(ctx context.Context, w io.Writer, img image.Image, f Format) error {
+	switch f {
+	case FormatJPEG:
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: 85})
+	case FormatPNG:
+		return png.Encode(w, img)
+	default:
+		return fault.Newf("unsupported format: %s", f)
+	}
+}
@@ -0,0 +1,69 @@
+// Package imageproc encodes resized images into the formats served to clients.
+package imageproc
+
+import (
+	"context"
+	"image"
+	"io"
+
+	"github.com/Southclaws/fault"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+	FormatAVIF Format = "avif"
+)
+
+func (f Format) MIME() string {
+	return "image/" + string(f)
+}
+
+func (f Format) Extension() string {
+	if f == FormatJPEG {
+		return "jpg"
+	}
+	return string(f)
+}
+
+// FormatFromMIME returns the format of an image MIME type, if it is one which
+// can be encoded.
+func FormatFromMIME(mime string) (Format, bool) {
+	switch mime {
+	case "image/jpeg":
+		return FormatJPEG, true
+	case "image/png":
+		return FormatPNG, true
+	case "image/webp":
+		return FormatWebP, true
+	case "image/avif":
+		return FormatAVIF, true
+	}
+	return "", false
+}
+
+type Encoder interface {
+	Supports(f Format) bool
+	Encode(ctx context.Context, w io.Writer, img image.Image, f Format) error
+}
+
+func Build() fx.Option {
+	return fx.Provide(func(cfg config.Config) (Encoder, error) {
+		switch cfg.ImageProcessor {
+		case "":
+			return &Builtin{}, nil
+
+		case "vips":
+			return newVips()
+
+		default:
+			return nil, fault.Newf("unknown image processor: '%s'", cfg.ImageProcessor)
+		}
+	})
+}
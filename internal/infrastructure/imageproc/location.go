@@ -0,0 +1,165 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+var (
+	exifHeader = []byte("Exif\x00\x00")
+	xmpHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	pngHeader  = []byte("\x89PNG\r\n\x1a\n")
+	pngXMPKey  = []byte("XML:com.adobe.xmp\x00")
+)
+
+const gpsInfoTag = 0x8825
+
+// tiffTypeSizes is the size in bytes of each TIFF field type, indexed by type.
+var tiffTypeSizes = []int{0, 1, 1, 2, 4, 8, 1, 1, 2, 4, 8, 4, 8}
+
+// StripLocation removes GPS coordinates from the metadata embedded in JPEG and
+// PNG files. Other EXIF data in JPEGs, such as orientation, is kept so photos
+// still show the right way up. Data in other formats or which cannot be parsed
+// is returned unchanged.
+func StripLocation(b []byte) []byte {
+	switch {
+	case bytes.HasPrefix(b, []byte{0xFF, 0xD8}):
+		return stripJPEG(b)
+	case bytes.HasPrefix(b, pngHeader):
+		return stripPNG(b)
+	}
+	return b
+}
+
+func stripJPEG(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	out = append(out, b[:2]...)
+
+	i := 2
+	for i+4 <= len(b) {
+		if b[i] != 0xFF {
+			return b
+		}
+
+		marker := b[i+1]
+
+		// Start of scan, everything after this is image data.
+		if marker == 0xDA {
+			break
+		}
+
+		length := int(binary.BigEndian.Uint16(b[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(b) {
+			return b
+		}
+
+		segment := b[i:end]
+		data := segment[4:]
+
+		if marker == 0xE1 {
+			switch {
+			case bytes.HasPrefix(data, exifHeader):
+				scrubGPS(data[len(exifHeader):])
+			case bytes.HasPrefix(data, xmpHeader):
+				i = end
+				continue
+			}
+		}
+
+		out = append(out, segment...)
+		i = end
+	}
+
+	return append(out, b[i:]...)
+}
+
+// scrubGPS zeroes the GPS IFD of a TIFF structure in place, including values
+// stored outside of the IFD entries themselves.
+func scrubGPS(tiff []byte) {
+	if len(tiff) < 8 {
+		return
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return
+	}
+
+	ifd0 := int(order.Uint32(tiff[4:]))
+	if ifd0+2 > len(tiff) {
+		return
+	}
+
+	count := int(order.Uint16(tiff[ifd0:]))
+	for n := 0; n < count; n++ {
+		entry := ifd0 + 2 + n*12
+		if entry+12 > len(tiff) {
+			return
+		}
+
+		if order.Uint16(tiff[entry:]) != gpsInfoTag {
+			continue
+		}
+
+		gps := int(order.Uint32(tiff[entry+8:]))
+		if gps+2 > len(tiff) {
+			return
+		}
+
+		gpsCount := int(order.Uint16(tiff[gps:]))
+		for g := 0; g < gpsCount; g++ {
+			field := gps + 2 + g*12
+			if field+12 > len(tiff) {
+				return
+			}
+
+			typ := int(order.Uint16(tiff[field+2:]))
+			if typ < len(tiffTypeSizes) {
+				size := tiffTypeSizes[typ] * int(order.Uint32(tiff[field+4:]))
+				if size > 4 {
+					offset := int(order.Uint32(tiff[field+8:]))
+					if offset >= 0 && offset+size <= len(tiff) {
+						clear(tiff[offset : offset+size])
+					}
+				}
+			}
+
+			clear(tiff[field : field+12])
+		}
+
+		order.PutUint16(tiff[gps:], 0)
+		return
+	}
+}
+
+func stripPNG(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	out = append(out, pngHeader...)
+
+	i := len(pngHeader)
+	for i+8 <= len(b) {
+		length := int(binary.BigEndian.Uint32(b[i:]))
+		end := i + 12 + length
+		if length < 0 || end > len(b) {
+			return b
+		}
+
+		kind := string(b[i+4 : i+8])
+		data := b[i+8 : i+8+length]
+
+		drop := kind == "eXIf" || (kind == "iTXt" && bytes.HasPrefix(data, pngXMPKey))
+		if !drop {
+			out = append(out, b[i:end]...)
+		}
+
+		i = end
+	}
+
+	return append(out, b[i:]...)
+}
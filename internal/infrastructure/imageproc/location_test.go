@@ -0,0 +1,115 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildExif returns a little-endian TIFF structure with an orientation tag and
+// a GPS IFD holding a latitude, along with the offset of the latitude value.
+func buildExif() ([]byte, int) {
+	le := binary.LittleEndian
+	tiff := make([]byte, 8+2+2*12+4+2+12+4+24)
+
+	copy(tiff, "II")
+	le.PutUint16(tiff[2:], 42)
+	le.PutUint32(tiff[4:], 8)
+
+	ifd0 := 8
+	le.PutUint16(tiff[ifd0:], 2)
+
+	orientation := ifd0 + 2
+	le.PutUint16(tiff[orientation:], 0x0112)
+	le.PutUint16(tiff[orientation+2:], 3)
+	le.PutUint32(tiff[orientation+4:], 1)
+	le.PutUint16(tiff[orientation+8:], 6)
+
+	gps := ifd0 + 2 + 2*12 + 4
+	pointer := ifd0 + 2 + 12
+	le.PutUint16(tiff[pointer:], gpsInfoTag)
+	le.PutUint16(tiff[pointer+2:], 4)
+	le.PutUint32(tiff[pointer+4:], 1)
+	le.PutUint32(tiff[pointer+8:], uint32(gps))
+
+	latitude := gps + 2 + 12 + 4
+	le.PutUint16(tiff[gps:], 1)
+	le.PutUint16(tiff[gps+2:], 2)
+	le.PutUint16(tiff[gps+4:], 5)
+	le.PutUint32(tiff[gps+6:], 3)
+	le.PutUint32(tiff[gps+10:], uint32(latitude))
+	for i := range 24 {
+		tiff[latitude+i] = 0xAB
+	}
+
+	return tiff, latitude
+}
+
+func TestStripLocationJPEG(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	encoded := bytes.NewBuffer(nil)
+	r.NoError(jpeg.Encode(encoded, image.NewRGBA(image.Rect(0, 0, 8, 8)), nil))
+
+	tiff, latitude := buildExif()
+	payload := append(append([]byte{}, exifHeader...), tiff...)
+
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	xmp := append(append([]byte{}, xmpHeader...), []byte(`<exif:GPSLatitude>51,30N</exif:GPSLatitude>`)...)
+	xmpSegment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(xmpSegment[2:], uint16(len(xmp)+2))
+	xmpSegment = append(xmpSegment, xmp...)
+
+	src := encoded.Bytes()
+	withExif := append(append(append(append([]byte{}, src[:2]...), segment...), xmpSegment...), src[2:]...)
+
+	stripped := StripLocation(withExif)
+
+	a.NotContains(string(stripped), "GPSLatitude")
+	a.Equal(len(withExif)-len(xmpSegment), len(stripped))
+
+	exif := stripped[2+4+len(exifHeader):]
+	a.Equal(bytes.Repeat([]byte{0}, 24), exif[latitude:latitude+24])
+	a.Equal(uint16(6), binary.LittleEndian.Uint16(exif[8+2+8:]), "orientation is kept")
+
+	_, err := jpeg.Decode(bytes.NewReader(stripped))
+	a.NoError(err)
+}
+
+func TestStripLocationPNG(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	encoded := bytes.NewBuffer(nil)
+	r.NoError(png.Encode(encoded, image.NewRGBA(image.Rect(0, 0, 8, 8))))
+
+	tiff, _ := buildExif()
+	chunk := make([]byte, 8, 8+len(tiff)+4)
+	binary.BigEndian.PutUint32(chunk, uint32(len(tiff)))
+	copy(chunk[4:], "eXIf")
+	chunk = append(chunk, tiff...)
+	chunk = append(chunk, 0, 0, 0, 0)
+
+	src := encoded.Bytes()
+	ihdrEnd := len(pngHeader) + 12 + 13
+	withExif := append(append(append([]byte{}, src[:ihdrEnd]...), chunk...), src[ihdrEnd:]...)
+
+	stripped := StripLocation(withExif)
+
+	a.Equal(src, stripped)
+}
+
+func TestStripLocationUnknown(t *testing.T) {
+	in := []byte("not an image")
+	assert.Equal(t, in, StripLocation(in))
+}
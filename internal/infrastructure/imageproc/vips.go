@@ -0,0 +1,89 @@
+package imageproc
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+)
+
+var vipsOptions = map[Format]string{
+	FormatJPEG: "[Q=85,strip]",
+	FormatPNG:  "[strip]",
+	FormatWebP: "[Q=80,strip]",
+	FormatAVIF: "[Q=60,strip]",
+}
+
+// Vips shells out to the libvips command line tool which, unlike the standard
+// library, can produce WebP and AVIF images.
+type Vips struct {
+	path string
+}
+
+func newVips() (*Vips, error) {
+	path, err := exec.LookPath("vips")
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("IMAGE_PROCESSOR is vips but the vips command was not found"))
+	}
+
+	return &Vips{path: path}, nil
+}
+
+func (v *Vips) Supports(f Format) bool {
+	_, ok := vipsOptions[f]
+	return ok
+}
+
+func (v *Vips) Encode(ctx context.Context, w io.Writer, img image.Image, f Format) error {
+	options, ok := vipsOptions[f]
+	if !ok {
+		return fault.Newf("unsupported format: %s", f)
+	}
+
+	dir, err := os.MkdirTemp("", "storyden-vips-")
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	defer os.RemoveAll(dir)
+
+	in := filepath.Join(dir, "in.png")
+	out := filepath.Join(dir, "out."+f.Extension())
+
+	src, err := os.Create(in)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	err = png.Encode(src, img)
+	src.Close()
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	stderr := bytes.NewBuffer(nil)
+	cmd := exec.CommandContext(ctx, v.path, "copy", in, out+options)
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return fault.Wrap(err, fmsg.With(stderr.String()))
+	}
+
+	result, err := os.Open(out)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	defer result.Close()
+
+	if _, err := io.Copy(w, result); err != nil {
+		return fault.Wrap(err)
+	}
+
+	return nil
+}
@@ -5,10 +5,13 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/internal/infrastructure/ai"
+	"github.com/Southclaws/storyden/internal/infrastructure/asn"
+	"github.com/Southclaws/storyden/internal/infrastructure/broadcast"
 	"github.com/Southclaws/storyden/internal/infrastructure/cache"
 	"github.com/Southclaws/storyden/internal/infrastructure/db"
 	"github.com/Southclaws/storyden/internal/infrastructure/endec/jwt"
 	"github.com/Southclaws/storyden/internal/infrastructure/frontend"
+	"github.com/Southclaws/storyden/internal/infrastructure/imageproc"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation"
 	"github.com/Southclaws/storyden/internal/infrastructure/logger"
 	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
@@ -16,10 +19,15 @@ import (
 	"github.com/Southclaws/storyden/internal/infrastructure/pdf"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 	"github.com/Southclaws/storyden/internal/infrastructure/rate"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
 	"github.com/Southclaws/storyden/internal/infrastructure/sms"
+	"github.com/Southclaws/storyden/internal/infrastructure/transcoder"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector/pgvector"
 	"github.com/Southclaws/storyden/internal/infrastructure/vector/pinecone"
-	"github.com/Southclaws/storyden/internal/infrastructure/weaviate"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector/qdrant"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector/weaviate"
 	"github.com/Southclaws/storyden/internal/infrastructure/webauthn"
+	"github.com/Southclaws/storyden/internal/infrastructure/webpush"
 )
 
 func Build() fx.Option {
@@ -28,15 +36,23 @@ func Build() fx.Option {
 		instrumentation.Build(),
 		db.Build(),
 		cache.Build(),
+		broadcast.Build(),
 		fx.Provide(rate.NewFactory),
 		mailer.Build(),
 		sms.Build(),
+		asn.Build(),
+		safehttp.Build(),
 		fx.Provide(webauthn.New),
+		fx.Provide(webpush.New),
 		object.Build(),
+		imageproc.Build(),
+		transcoder.Build(),
 		frontend.Build(),
-		weaviate.Build(),
 		pinecone.Build(),
-		fx.Provide(ai.New),
+		pgvector.Build(),
+		qdrant.Build(),
+		weaviate.Build(),
+		fx.Provide(ai.NewMeter, ai.New),
 		jwt.Build(),
 		pubsub.Build(),
 		fx.Provide(pdf.New),
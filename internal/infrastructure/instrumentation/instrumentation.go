@@ -3,6 +3,7 @@ package instrumentation
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/metrics"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/spanner"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/tracing"
 )
@@ -10,6 +11,7 @@ import (
 func Build() fx.Option {
 	return fx.Options(
 		tracing.Build(),
+		metrics.Build(),
 		fx.Provide(spanner.New),
 	)
 }
@@ -0,0 +1,139 @@
+// Package metrics provides the OpenTelemetry meter used to record request,
+// query and queue metrics, exported either for Prometheus to scrape or pushed
+// to an OTLP collector depending on METRICS_PROVIDER.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// LatencyBuckets are histogram boundaries in seconds for request and query
+// durations, the SDK's defaults are suited to milliseconds.
+var LatencyBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+func Build() fx.Option {
+	return fx.Provide(newMeterProvider, newMeter)
+}
+
+func newMeter(mp metric.MeterProvider) metric.Meter {
+	return mp.Meter("storyden")
+}
+
+func newMeterProvider(ctx context.Context, lc fx.Lifecycle, cfg config.Config, logger *slog.Logger) (metric.MeterProvider, error) {
+	var reader sdkmetric.Reader
+
+	switch cfg.MetricsProvider {
+	case "":
+		return noop.NewMeterProvider(), nil
+
+	case "prometheus":
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+
+		exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+		if err != nil {
+			return nil, fault.Wrap(err, fmsg.With("failed to create prometheus exporter"))
+		}
+		reader = exporter
+
+		serve(lc, logger, cfg.MetricsAddress, registry)
+
+	case "otlp":
+		endpoint := cfg.OTELEndpoint.String()
+		if endpoint == "" {
+			return nil, fault.New("OTEL_EXPORTER_OTLP_ENDPOINT is required when using the otlp metrics provider")
+		}
+
+		opts := []otlpmetrichttp.Option{
+			otlpmetrichttp.WithEndpointURL(endpoint),
+		}
+
+		if cfg.OTELEndpoint.Scheme != "https" {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
+
+		exporter, err := otlpmetrichttp.New(ctx, opts...)
+		if err != nil {
+			return nil, fault.Wrap(err, fmsg.With("failed to create OTLP metrics exporter"))
+		}
+		reader = sdkmetric.NewPeriodicReader(exporter)
+
+	default:
+		return nil, fault.Newf("unknown metrics provider %q", cfg.MetricsProvider)
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(reader),
+		sdkmetric.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName("storyden"),
+		)),
+	)
+
+	// Libraries such as the outbound HTTP transport use the global provider.
+	otel.SetMeterProvider(mp)
+
+	lc.Append(fx.StopHook(func(ctx context.Context) error {
+		if err := mp.Shutdown(ctx); err != nil {
+			return fault.Wrap(err)
+		}
+		return nil
+	}))
+
+	return mp, nil
+}
+
+// serve exposes the registry on its own listener so metrics aren't served on
+// the public API address.
+func serve(lc fx.Lifecycle, logger *slog.Logger, addr string, registry *prometheus.Registry) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	server := &http.Server{Handler: mux}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			ln, err := net.Listen("tcp", addr)
+			if err != nil {
+				return fault.Wrap(err, fmsg.With("failed to listen for metrics"))
+			}
+
+			logger.Info("metrics server starting", slog.String("address", ln.Addr().String()))
+
+			go func() {
+				if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					logger.Error("metrics server stopped unexpectedly", slog.String("error", err.Error()))
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return server.Shutdown(ctx)
+		},
+	})
+}
@@ -0,0 +1,23 @@
+// Package outbound instruments requests Storyden makes to other services.
+package outbound
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// Transport wraps base so outbound requests are traced, carry the current
+// trace context and are recorded in the HTTP client metrics. A nil base uses
+// the default transport.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return otelhttp.NewTransport(base,
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Host
+		}),
+	)
+}
@@ -10,6 +10,7 @@ import (
 	sentryotel "github.com/getsentry/sentry-go/otel"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
@@ -28,6 +29,7 @@ func Build() fx.Option {
 }
 
 func newTracerFactory(ctx context.Context,
+	lc fx.Lifecycle,
 	cfg config.Config,
 	logger *slog.Logger,
 	opts []trace.TracerProviderOption,
@@ -36,10 +38,26 @@ func newTracerFactory(ctx context.Context,
 		logger.Error("otel error", slog.String("error", err.Error()))
 	}))
 
-	return factory{
+	// Continue traces started by upstream services and pass them on to
+	// outbound requests made by the instrumented HTTP transport.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.OTELProvider == "" {
+		return factory{opts: opts}, nil
+	}
+
+	f := factory{
 		provider: cfg.OTELProvider,
-		opts:     opts,
-	}, nil
+		opts:     append(opts, trace.WithSampler(sampler(cfg.OTELSampleRatio))),
+	}
+
+	// Libraries such as the outbound HTTP transport use the global provider.
+	otel.SetTracerProvider(f.tracerProvider(lc, "storyden"))
+
+	return f, nil
 }
 
 func newExporter(ctx context.Context,
@@ -105,8 +123,18 @@ func newExporter(ctx context.Context,
 	}
 }
 
+// sampler follows the upstream service's decision when a request is already
+// part of a trace, otherwise records the configured share of new traces.
+func sampler(ratio float64) trace.Sampler {
+	return trace.ParentBased(trace.TraceIDRatioBased(ratio))
+}
+
 // Build constructs a new tracer for use within a system component.
 func (f factory) Build(lc fx.Lifecycle, serviceName string) Tracer {
+	return f.tracerProvider(lc, serviceName).Tracer("storyden")
+}
+
+func (f factory) tracerProvider(lc fx.Lifecycle, serviceName string) *trace.TracerProvider {
 	opts := append(f.opts, trace.WithResource(resource.NewWithAttributes(
 		semconv.SchemaURL,
 		semconv.ServiceName(serviceName),
@@ -124,7 +152,5 @@ func (f factory) Build(lc fx.Lifecycle, serviceName string) Tracer {
 		},
 	})
 
-	tracer := tp.Tracer("storyden")
-
-	return tracer
+	return tp
 }
@@ -4,6 +4,7 @@ import (
 	"net/mail"
 
 	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
 )
 
 var (
@@ -32,6 +33,7 @@ type Message struct {
 	Name    string
 	Subject string
 	Content Content
+	ReplyTo opt.Optional[mail.Address]
 }
 
 func NewMessage(
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/mail"
 	"sync"
+
+	"github.com/Southclaws/opt"
 )
 
 type MockEmail struct {
@@ -13,6 +15,7 @@ type MockEmail struct {
 	Subject string
 	Html    string
 	Plain   string
+	ReplyTo opt.Optional[mail.Address]
 }
 
 type Mock struct {
@@ -42,6 +45,7 @@ func (m *Mock) Send(
 		Subject: msg.Subject,
 		Html:    msg.Content.HTML,
 		Plain:   msg.Content.Plain,
+		ReplyTo: msg.ReplyTo,
 	})
 
 	return nil
@@ -53,3 +57,10 @@ func (m *Mock) GetLast() MockEmail {
 
 	return m.sent[len(m.sent)-1]
 }
+
+func (m *Mock) GetAll() []MockEmail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]MockEmail{}, m.sent...)
+}
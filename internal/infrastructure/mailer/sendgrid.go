@@ -42,6 +42,9 @@ func (m *SendGrid) Send(
 	from := mail.NewEmail(m.fromName, m.fromAddress)
 	to := mail.NewEmail(msg.Name, msg.Address.Address)
 	message := mail.NewSingleEmail(from, msg.Subject, to, msg.Content.Plain, msg.Content.HTML)
+	if replyTo, ok := msg.ReplyTo.Get(); ok {
+		message.SetReplyTo(mail.NewEmail(replyTo.Name, replyTo.Address))
+	}
 
 	m.logger.Info("sending live email",
 		slog.String("email", to.Address),
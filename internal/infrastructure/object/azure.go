@@ -0,0 +1,315 @@
+package object
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+// azureAPIVersion is the Blob Storage REST API version requests are made with,
+// it allows single requests to upload blobs of up to 5000 MiB.
+const azureAPIVersion = "2021-08-06"
+
+// azureStorer talks to the Blob Storage REST API directly, authenticating with
+// the account's shared key.
+type azureStorer struct {
+	client    *http.Client
+	account   string
+	key       []byte
+	endpoint  *url.URL
+	container string
+}
+
+func NewAzureStorer(ctx context.Context, cfg config.Config) (Storer, error) {
+	if cfg.AzureStorageAccount == "" || cfg.AzureStorageKey == "" || cfg.AzureStorageContainer == "" {
+		return nil, fault.New("AZURE_STORAGE_ACCOUNT, AZURE_STORAGE_KEY and AZURE_STORAGE_CONTAINER must be set when using azure storage")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cfg.AzureStorageKey)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	endpoint := cfg.AzureStorageEndpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", cfg.AzureStorageAccount)
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s := &azureStorer{
+		client:    &http.Client{Transport: outbound.Transport(nil)},
+		account:   cfg.AzureStorageAccount,
+		key:       key,
+		endpoint:  u,
+		container: cfg.AzureStorageContainer,
+	}
+
+	if err := s.createContainer(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *azureStorer) Exists(ctx context.Context, path string) (bool, error) {
+	res, err := s.do(ctx, http.MethodHead, path, nil, nil, 0, nil)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+	res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fault.Wrap(azureError(res), fctx.With(ctx))
+	}
+}
+
+func (s *azureStorer) Read(ctx context.Context, path string) (io.Reader, int64, error) {
+	res, err := s.do(ctx, http.MethodGet, path, nil, nil, 0, nil)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.Body, res.ContentLength, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, 0, fault.Wrap(azureError(res), fctx.With(ctx), ftag.With(ftag.NotFound))
+	default:
+		res.Body.Close()
+		return nil, 0, fault.Wrap(azureError(res), fctx.With(ctx))
+	}
+}
+
+func (s *azureStorer) Write(ctx context.Context, path string, r io.Reader, size int64) error {
+	// A blob must be uploaded with its length so streams of unknown size are
+	// spooled to disk first.
+	if size <= 0 {
+		f, err := os.CreateTemp("", "storyden-azure-")
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		size, err = io.Copy(f, r)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		r = f
+	}
+
+	headers := http.Header{"X-Ms-Blob-Type": []string{"BlockBlob"}}
+
+	res, err := s.do(ctx, http.MethodPut, path, nil, headers, size, io.LimitReader(r, size))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return fault.Wrap(azureError(res), fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *azureStorer) Delete(ctx context.Context, path string) error {
+	res, err := s.do(ctx, http.MethodDelete, path, nil, nil, 0, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusNotFound {
+		return fault.Wrap(azureError(res), fctx.With(ctx))
+	}
+
+	return nil
+}
+
+type azureListResult struct {
+	Blobs struct {
+		Blob []struct {
+			Name string `xml:"Name"`
+		} `xml:"Blob"`
+	} `xml:"Blobs"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (s *azureStorer) Walk(ctx context.Context, fn func(path string) error) error {
+	marker := ""
+	for {
+		query := url.Values{
+			"restype": []string{"container"},
+			"comp":    []string{"list"},
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+
+		res, err := s.do(ctx, http.MethodGet, "", query, nil, 0, nil)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return fault.Wrap(azureError(res), fctx.With(ctx))
+		}
+
+		var list azureListResult
+		err = xml.NewDecoder(res.Body).Decode(&list)
+		res.Body.Close()
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		for _, b := range list.Blobs.Blob {
+			if err := fn(b.Name); err != nil {
+				return err
+			}
+		}
+
+		if list.NextMarker == "" {
+			return nil
+		}
+		marker = list.NextMarker
+	}
+}
+
+func (s *azureStorer) createContainer(ctx context.Context) error {
+	res, err := s.do(ctx, http.MethodPut, "", url.Values{"restype": []string{"container"}}, nil, 0, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusConflict {
+		return fault.Wrap(azureError(res), fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// do sends a signed request for a blob, or the container itself if path is
+// empty. The caller must close the response body.
+func (s *azureStorer) do(ctx context.Context, method, path string, query url.Values, headers http.Header, size int64, body io.Reader) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = u.Path + "/" + s.container
+	if path != "" {
+		u.Path = u.Path + "/" + path
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+	req.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("X-Ms-Version", azureAPIVersion)
+
+	if body != nil {
+		req.ContentLength = size
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", s.account, s.sign(req)))
+
+	return s.client.Do(req)
+}
+
+// sign computes the shared key signature of a request.
+// https://learn.microsoft.com/rest/api/storageservices/authorize-with-shared-key
+func (s *azureStorer) sign(req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var msHeaders []string
+	for k := range req.Header {
+		if lk := strings.ToLower(k); strings.HasPrefix(lk, "x-ms-") {
+			msHeaders = append(msHeaders, lk)
+		}
+	}
+	sort.Strings(msHeaders)
+
+	canonicalHeaders := strings.Builder{}
+	for _, k := range msHeaders {
+		canonicalHeaders.WriteString(k + ":" + strings.TrimSpace(req.Header.Get(k)) + "\n")
+	}
+
+	canonicalResource := strings.Builder{}
+	canonicalResource.WriteString("/" + s.account + req.URL.EscapedPath())
+
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for k := range query {
+		params = append(params, k)
+	}
+	sort.Strings(params)
+	for _, k := range params {
+		values := query[k]
+		sort.Strings(values)
+		canonicalResource.WriteString("\n" + strings.ToLower(k) + ":" + strings.Join(values, ","))
+	}
+
+	toSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used instead.
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalHeaders.String() + canonicalResource.String(),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(toSign))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func azureError(res *http.Response) error {
+	return fault.Newf("azure blob storage responded with %s: %s", res.Status, res.Header.Get("X-Ms-Error-Code"))
+}
@@ -0,0 +1,109 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/api/storage/v1"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+type gcsStorer struct {
+	bucket  string
+	service *storage.Service
+}
+
+func NewGCSStorer(ctx context.Context, cfg config.Config) (Storer, error) {
+	if cfg.GCSBucket == "" {
+		return nil, fault.New("GCS_BUCKET must be set when using gcs storage")
+	}
+
+	opts := []option.ClientOption{option.WithScopes(storage.DevstorageReadWriteScope)}
+	if cfg.GCSCredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(cfg.GCSCredentialsFile))
+	}
+
+	service, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &gcsStorer{
+		bucket:  cfg.GCSBucket,
+		service: service,
+	}, nil
+}
+
+func (s *gcsStorer) Exists(ctx context.Context, path string) (bool, error) {
+	_, err := s.service.Objects.Get(s.bucket, path).Context(ctx).Do()
+	if err != nil {
+		if isGCSNotFound(err) {
+			return false, nil
+		}
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return true, nil
+}
+
+func (s *gcsStorer) Read(ctx context.Context, path string) (io.Reader, int64, error) {
+	res, err := s.service.Objects.Get(s.bucket, path).Context(ctx).Download()
+	if err != nil {
+		if isGCSNotFound(err) {
+			return nil, 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return res.Body, res.ContentLength, nil
+}
+
+func (s *gcsStorer) Write(ctx context.Context, path string, r io.Reader, size int64) error {
+	_, err := s.service.Objects.Insert(s.bucket, &storage.Object{Name: path}).
+		Media(r).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *gcsStorer) Delete(ctx context.Context, path string) error {
+	err := s.service.Objects.Delete(s.bucket, path).Context(ctx).Do()
+	if err != nil && !isGCSNotFound(err) {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *gcsStorer) Walk(ctx context.Context, fn func(path string) error) error {
+	err := s.service.Objects.List(s.bucket).Fields("nextPageToken", "items/name").Pages(ctx, func(page *storage.Objects) error {
+		for _, obj := range page.Items {
+			if err := fn(obj.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func isGCSNotFound(err error) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == http.StatusNotFound
+}
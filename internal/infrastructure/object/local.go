@@ -20,6 +20,10 @@ type localStorer struct {
 }
 
 func NewLocalStorer(cfg config.Config) Storer {
+	return newLocalStorer(cfg)
+}
+
+func newLocalStorer(cfg config.Config) *localStorer {
 	var path string
 	if cfg.AssetStorageLocalPath != "" {
 		path = cfg.AssetStorageLocalPath
@@ -94,3 +98,47 @@ func (s *localStorer) Write(ctx context.Context, path string, r io.Reader, size
 
 	return nil
 }
+
+func (s *localStorer) Delete(ctx context.Context, path string) error {
+	err := os.Remove(filepath.Join(s.path, path))
+	if err != nil && !os.IsNotExist(err) {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *localStorer) Rename(ctx context.Context, from, to string) error {
+	fullpath := filepath.Join(s.path, to)
+
+	if err := os.MkdirAll(filepath.Dir(fullpath), 0o755); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := os.Rename(filepath.Join(s.path, from), fullpath); err != nil {
+		if os.IsNotExist(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *localStorer) Walk(ctx context.Context, fn func(path string) error) error {
+	err := fs.WalkDir(s.s, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		return fn(path)
+	})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package object
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+)
+
+type MigrateResult struct {
+	Copied  int
+	Skipped int
+}
+
+// Migrate copies every object from one storer to another. Objects which are
+// already present at the destination are skipped unless overwrite is set, so
+// an interrupted migration can be run again to finish it.
+func Migrate(ctx context.Context, from, to Storer, overwrite bool) (*MigrateResult, error) {
+	result := &MigrateResult{}
+
+	err := from.Walk(ctx, func(path string) error {
+		ctx := fctx.WithMeta(ctx, "path", path)
+
+		if !overwrite {
+			exists, err := to.Exists(ctx, path)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			if exists {
+				result.Skipped++
+				return nil
+			}
+		}
+
+		r, size, err := from.Read(ctx, path)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		defer closeReader(r)
+
+		if err := to.Write(ctx, path, r, size); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		result.Copied++
+		return nil
+	})
+	if err != nil {
+		return result, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return result, nil
+}
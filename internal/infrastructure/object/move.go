@@ -0,0 +1,34 @@
+package object
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+)
+
+// Renamer is implemented by storers which can move an object without copying
+// its contents, such as local disk.
+type Renamer interface {
+	Rename(ctx context.Context, from, to string) error
+}
+
+// Move puts the object at from at to instead. Storers which can't rename an
+// object have it copied to the new path and the original deleted.
+func Move(ctx context.Context, s Storer, from, to string) error {
+	if rn, ok := s.(Renamer); ok {
+		return rn.Rename(ctx, from, to)
+	}
+
+	r, size, err := s.Read(ctx, from)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer closeReader(r)
+
+	if err := s.Write(ctx, to, r, size); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.Delete(ctx, from)
+}
@@ -0,0 +1,48 @@
+package object
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// namespacedStorer keeps objects for each namespace under their own prefix so
+// tenants can share a bucket without seeing each other's assets.
+type namespacedStorer struct {
+	prefix string
+	next   Storer
+}
+
+func newNamespacedStorer(namespace string, next Storer) *namespacedStorer {
+	return &namespacedStorer{prefix: namespace + "/", next: next}
+}
+
+func (s *namespacedStorer) Exists(ctx context.Context, path string) (bool, error) {
+	return s.next.Exists(ctx, s.prefix+path)
+}
+
+func (s *namespacedStorer) Read(ctx context.Context, path string) (io.Reader, int64, error) {
+	return s.next.Read(ctx, s.prefix+path)
+}
+
+func (s *namespacedStorer) Write(ctx context.Context, path string, r io.Reader, size int64) error {
+	return s.next.Write(ctx, s.prefix+path, r, size)
+}
+
+func (s *namespacedStorer) Delete(ctx context.Context, path string) error {
+	return s.next.Delete(ctx, s.prefix+path)
+}
+
+func (s *namespacedStorer) Rename(ctx context.Context, from, to string) error {
+	return Move(ctx, s.next, s.prefix+from, s.prefix+to)
+}
+
+func (s *namespacedStorer) Walk(ctx context.Context, fn func(path string) error) error {
+	return s.next.Walk(ctx, func(path string) error {
+		rest, ok := strings.CutPrefix(path, s.prefix)
+		if !ok {
+			return nil
+		}
+		return fn(rest)
+	})
+}
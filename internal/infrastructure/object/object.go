@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/Southclaws/fault"
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/internal/config"
@@ -13,16 +14,59 @@ type Storer interface {
 	Exists(ctx context.Context, path string) (bool, error)
 	Read(ctx context.Context, path string) (io.Reader, int64, error)
 	Write(ctx context.Context, path string, w io.Reader, size int64) error
+	Delete(ctx context.Context, path string) error
+
+	// Walk calls fn with the path of every stored object.
+	Walk(ctx context.Context, fn func(path string) error) error
 }
 
 func Build() fx.Option {
 	return fx.Provide(func(ctx context.Context, cfg config.Config) (Storer, error) {
-		switch cfg.AssetStorageType {
-		case "s3":
-			return NewS3Storer(ctx, cfg)
+		s, err := New(ctx, cfg, cfg.AssetStorageType)
+		if err != nil {
+			return nil, err
+		}
 
-		default:
-			return NewLocalStorer(cfg), nil
+		if cfg.Namespace != "" {
+			return newNamespacedStorer(cfg.Namespace, s), nil
 		}
+
+		return s, nil
 	})
 }
+
+// New creates a storer of the given kind, this is usually the configured
+// storage type but a different kind may be requested when migrating.
+func New(ctx context.Context, cfg config.Config, kind string) (Storer, error) {
+	switch kind {
+	case "s3":
+		return NewS3Storer(ctx, cfg)
+
+	case "azure":
+		return NewAzureStorer(ctx, cfg)
+
+	case "gcs":
+		return NewGCSStorer(ctx, cfg)
+
+	case "tiered":
+		if cfg.AssetStorageColdType == "tiered" || cfg.AssetStorageColdType == "local" || cfg.AssetStorageColdType == "" {
+			return nil, fault.Newf("ASSET_STORAGE_COLD_TYPE must be s3, azure or gcs, got %q", cfg.AssetStorageColdType)
+		}
+
+		cold, err := New(ctx, cfg, cfg.AssetStorageColdType)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewTieredStorer(cfg, cold), nil
+
+	default:
+		return NewLocalStorer(cfg), nil
+	}
+}
+
+func closeReader(r io.Reader) {
+	if c, ok := r.(io.Closer); ok {
+		c.Close()
+	}
+}
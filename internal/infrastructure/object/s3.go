@@ -53,9 +53,9 @@ func NewS3Storer(ctx context.Context, cfg config.Config) (Storer, error) {
 func (s *s3Storer) Exists(ctx context.Context, path string) (bool, error) {
 	_, err := s.minioClient.StatObject(ctx, s.bucket, path, minio.GetObjectOptions{})
 	if err != nil {
-		// TODO: figure out if there's a way to differentiate between an error
-		// and an item just not existing. Ideally we want to treat actual
-		// transport errors and such as actual errors and non-existence as nil.
+		if minio.ToErrorResponse(err).StatusCode == 404 {
+			return false, nil
+		}
 		return false, fault.Wrap(err, fctx.With(ctx))
 	}
 
@@ -95,3 +95,30 @@ func (s *s3Storer) Write(ctx context.Context, path string, stream io.Reader, siz
 
 	return nil
 }
+
+func (s *s3Storer) Delete(ctx context.Context, path string) error {
+	err := s.minioClient.RemoveObject(ctx, s.bucket, path, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *s3Storer) Walk(ctx context.Context, fn func(path string) error) error {
+	// Cancelling stops the listing if fn returns early.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for obj := range s.minioClient.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return fault.Wrap(obj.Err, fctx.With(ctx))
+		}
+
+		if err := fn(obj.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
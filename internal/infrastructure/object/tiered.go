@@ -0,0 +1,150 @@
+package object
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// TieredStorer writes new objects to local disk, where recent uploads which
+// are requested most are quick to serve, and moves them to cheaper object
+// storage once they are older than the hot period.
+type TieredStorer struct {
+	hot       *localStorer
+	cold      Storer
+	hotPeriod time.Duration
+}
+
+func NewTieredStorer(cfg config.Config, cold Storer) *TieredStorer {
+	return &TieredStorer{
+		hot:       newLocalStorer(cfg),
+		cold:      cold,
+		hotPeriod: cfg.AssetStorageHotPeriod,
+	}
+}
+
+func (s *TieredStorer) Exists(ctx context.Context, path string) (bool, error) {
+	exists, err := s.hot.Exists(ctx, path)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+	if exists {
+		return true, nil
+	}
+
+	return s.cold.Exists(ctx, path)
+}
+
+func (s *TieredStorer) Read(ctx context.Context, path string) (io.Reader, int64, error) {
+	r, size, err := s.hot.Read(ctx, path)
+	if err == nil {
+		return r, size, nil
+	}
+	if ftag.Get(err) != ftag.NotFound {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.cold.Read(ctx, path)
+}
+
+func (s *TieredStorer) Write(ctx context.Context, path string, r io.Reader, size int64) error {
+	return s.hot.Write(ctx, path, r, size)
+}
+
+func (s *TieredStorer) Delete(ctx context.Context, path string) error {
+	if err := s.hot.Delete(ctx, path); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.cold.Delete(ctx, path)
+}
+
+// Rename moves objects still on local disk in place, anything already
+// demoted is moved within cold storage.
+func (s *TieredStorer) Rename(ctx context.Context, from, to string) error {
+	hot, err := s.hot.Exists(ctx, from)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if hot {
+		return s.hot.Rename(ctx, from, to)
+	}
+
+	return Move(ctx, s.cold, from, to)
+}
+
+// Walk visits objects in both tiers, an object which is part way through being
+// demoted may be visited twice.
+func (s *TieredStorer) Walk(ctx context.Context, fn func(path string) error) error {
+	if err := s.hot.Walk(ctx, fn); err != nil {
+		return err
+	}
+
+	return s.cold.Walk(ctx, fn)
+}
+
+// Demote moves objects which have been on local disk for longer than the hot
+// period to cold storage and returns how many were moved.
+func (s *TieredStorer) Demote(ctx context.Context, now time.Time) (int, error) {
+	cutoff := now.Add(-s.hotPeriod)
+
+	var stale []string
+	err := fs.WalkDir(s.hot.s, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if info.ModTime().Before(cutoff) {
+			stale = append(stale, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, path := range stale {
+		if err := s.demote(ctx, path); err != nil {
+			return 0, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return len(stale), nil
+}
+
+func (s *TieredStorer) demote(ctx context.Context, path string) error {
+	f, err := os.Open(filepath.Join(s.hot.path, path))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.cold.Write(ctx, path, f, info.Size()); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.hot.Delete(ctx, path)
+}
@@ -125,7 +125,7 @@ func newBus(
 			// NOTE 2: This is durable and needs to be optionally durable based
 			// on parameters passed to Subscribe. But that's really awkward...
 			if cfg.QueueType == "amqp" {
-				apsc := amqp.NewDurablePubSubConfig(cfg.AmqpURL, func(topic string) string {
+				apsc := newAMQPConfig(cfg, func(topic string) string {
 					return topic + "." + params.HandlerName
 				})
 				subscriber, err := amqp.NewSubscriber(apsc, logger)
@@ -264,6 +264,20 @@ func (b *Bus) SendCommand(ctx context.Context, command any) error {
 	return nil
 }
 
+// Ping reports whether messages can currently be published and consumed.
+func (b *Bus) Ping(ctx context.Context) error {
+	if !b.router.IsRunning() {
+		return fault.Wrap(fault.New("message router is not running"), fctx.With(ctx))
+	}
+
+	// AMQP reconnects in the background, while it does messages can't be sent.
+	if c, ok := b.pub.(interface{ IsConnected() bool }); ok && !c.IsConnected() {
+		return fault.Wrap(fault.New("message broker is not connected"), fctx.With(ctx))
+	}
+
+	return nil
+}
+
 type Subscription struct {
 	bus            *Bus
 	subkey         subscriptionKey
@@ -17,6 +17,7 @@ const (
 	accountIDKey      = "storyden-account-id"
 	rolesKey          = "storyden-roles"
 	securitySchemeKey = "storyden-security-scheme"
+	accessKeyIDKey    = "storyden-access-key-id"
 )
 
 // propagates session context to message subscribers.
@@ -91,7 +92,12 @@ func (m *sessionContextMiddleware) extractSessionContext(ctx context.Context, ms
 	}
 
 	if securityScheme == "access_key" {
-		return session.WithAccessKey(ctx, acc, roles), nil
+		keyID, err := xid.FromString(msg.Metadata.Get(accessKeyIDKey))
+		if err != nil {
+			return nil, err
+		}
+
+		return session.WithAccessKey(ctx, acc, roles, keyID), nil
 	}
 
 	return session.WithAccount(ctx, acc, roles), nil
@@ -115,4 +121,8 @@ func injectSessionContext(ctx context.Context, msg *message.Message) {
 	if scheme, err := session.GetSecurityScheme(ctx); err == nil {
 		msg.Metadata.Set(securitySchemeKey, scheme)
 	}
+
+	if keyID, ok := session.GetOptAccessKeyID(ctx).Get(); ok {
+		msg.Metadata.Set(accessKeyIDKey, keyID.String())
+	}
 }
@@ -54,7 +54,7 @@ func newWatermillPubsub(cfg config.Config, l *slog.Logger) (message.Subscriber,
 	case "amqp":
 		l.Debug("using amqp pubsub")
 
-		apsc := amqp.NewDurablePubSubConfig(cfg.AmqpURL, amqp.GenerateExchangeNameTopicName)
+		apsc := newAMQPConfig(cfg, amqp.GenerateExchangeNameTopicName)
 
 		publisher, err := amqp.NewPublisher(apsc, logger)
 		if err != nil {
@@ -69,3 +69,22 @@ func newWatermillPubsub(cfg config.Config, l *slog.Logger) (message.Subscriber,
 		return subscriber, publisher, nil
 	}
 }
+
+// newAMQPConfig prefixes exchange and queue names with the namespace so
+// tenants sharing a broker never receive each other's messages.
+func newAMQPConfig(cfg config.Config, queueName amqp.QueueNameGenerator) amqp.Config {
+	if cfg.Namespace == "" {
+		return amqp.NewDurablePubSubConfig(cfg.AmqpURL, queueName)
+	}
+
+	prefix := cfg.Namespace + "."
+
+	c := amqp.NewDurablePubSubConfig(cfg.AmqpURL, func(topic string) string {
+		return prefix + queueName(topic)
+	})
+	c.Exchange.GenerateName = func(topic string) string {
+		return prefix + topic
+	}
+
+	return c
+}
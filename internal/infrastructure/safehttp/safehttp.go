@@ -0,0 +1,227 @@
+// Package safehttp provides an HTTP client for fetching URLs supplied by
+// members. It refuses to connect to loopback, private, link-local and other
+// non-public addresses so the server cannot be used to reach services on its
+// own network. Addresses are checked after DNS resolution, at dial time, so a
+// hostname which resolves to an internal address is rejected too. Operators
+// may allow specific private ranges with ALLOWED_PRIVATE_ADDRESSES.
+package safehttp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+)
+
+const maxRedirects = 5
+
+var (
+	ErrForbiddenAddress = fault.New("address is not publicly routable", ftag.With(ftag.InvalidArgument))
+	ErrTooManyRedirects = fault.New("too many redirects", ftag.With(ftag.InvalidArgument))
+	ErrForbiddenScheme  = fault.New("only http and https URLs may be fetched", ftag.With(ftag.InvalidArgument))
+)
+
+// Ranges which netip does not classify but which are still not reachable on
+// the public internet.
+var reserved = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// IsPublic reports whether an address is routable on the public internet.
+func IsPublic(ip netip.Addr) bool {
+	ip = ip.Unmap()
+
+	if !ip.IsValid() ||
+		ip.IsUnspecified() ||
+		ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() {
+		return false
+	}
+
+	for _, p := range reserved {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Policy decides which addresses may be connected to. The zero value only
+// permits public addresses.
+type Policy struct {
+	allowed []netip.Prefix
+	roots   *x509.CertPool
+}
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+func New(cfg config.Config) (*Policy, error) {
+	p := &Policy{}
+
+	for _, s := range cfg.AllowedPrivateAddresses {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		if !strings.Contains(s, "/") {
+			ip, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, fault.Wrap(err, fmsg.With("invalid address in ALLOWED_PRIVATE_ADDRESSES"))
+			}
+			p.allowed = append(p.allowed, netip.PrefixFrom(ip, ip.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fault.Wrap(err, fmsg.With("invalid range in ALLOWED_PRIVATE_ADDRESSES"))
+		}
+		p.allowed = append(p.allowed, prefix.Masked())
+	}
+
+	return p, nil
+}
+
+// Permits reports whether an address is public or has been allowed.
+func (p *Policy) Permits(ip netip.Addr) bool {
+	ip = ip.Unmap()
+
+	if IsPublic(ip) {
+		return true
+	}
+
+	for _, a := range p.allowed {
+		if a.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithRootCAs returns a copy of the policy whose clients only trust the given
+// certificate authorities, for receivers using a private certificate.
+func (p *Policy) WithRootCAs(roots *x509.CertPool) *Policy {
+	return &Policy{allowed: p.allowed, roots: roots}
+}
+
+// CheckURL resolves the host of a URL and rejects it when any of its addresses
+// aren't permitted. It's used when a URL is registered so members find out
+// straight away, the client still checks every connection it makes as DNS
+// records can change after registration.
+func (p *Policy) CheckURL(ctx context.Context, u *url.URL) error {
+	host := u.Hostname()
+	if host == "" {
+		return fault.Wrap(ErrForbiddenAddress, fctx.With(ctx))
+	}
+
+	var addrs []netip.Addr
+	if ip, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{ip}
+	} else {
+		addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+	}
+
+	for _, ip := range addrs {
+		if !p.Permits(ip) {
+			return fault.Wrap(ErrForbiddenAddress, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// Client returns a client which only connects to permitted addresses, follows
+// a limited number of redirects and never uses a proxy from the environment.
+func (p *Policy) Client(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: p.control,
+	}
+
+	transport := &http.Transport{
+		Proxy:                  nil,
+		DialContext:            dialer.DialContext,
+		ForceAttemptHTTP2:      true,
+		MaxIdleConns:           10,
+		IdleConnTimeout:        30 * time.Second,
+		TLSHandshakeTimeout:    10 * time.Second,
+		ResponseHeaderTimeout:  timeout,
+		MaxResponseHeaderBytes: 64 * 1024,
+	}
+
+	if p.roots != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: p.roots}
+	}
+
+	return &http.Client{
+		Timeout:       timeout,
+		Transport:     outbound.Transport(transport),
+		CheckRedirect: checkRedirect,
+	}
+}
+
+func (p *Policy) control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	if !p.Permits(ip) {
+		return ErrForbiddenAddress
+	}
+
+	return nil
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return ErrTooManyRedirects
+	}
+
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return ErrForbiddenScheme
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package safehttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func TestIsPublic(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"93.184.215.14":         true,
+		"2606:2800:21f:cb07::1": true,
+		"127.0.0.1":             false,
+		"10.1.2.3":              false,
+		"172.16.0.1":            false,
+		"192.168.1.1":           false,
+		"169.254.169.254":       false,
+		"100.64.0.1":            false,
+		"0.0.0.0":               false,
+		"::1":                   false,
+		"fd00::1":               false,
+		"fe80::1":               false,
+		"::ffff:127.0.0.1":      false,
+		"::ffff:8.8.8.8":        true,
+	} {
+		t.Run(addr, func(t *testing.T) {
+			assert.Equal(t, public, IsPublic(netip.MustParseAddr(addr)))
+		})
+	}
+}
+
+func TestClientRefusesLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	_, err = (&Policy{}).Client(5 * time.Second).Do(req)
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+}
+
+func TestPolicyAllowedAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p, err := New(config.Config{AllowedPrivateAddresses: []string{"127.0.0.1", "10.10.0.0/16"}})
+	require.NoError(t, err)
+
+	res, err := p.Client(5 * time.Second).Get(srv.URL)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	ctx := context.Background()
+	for raw, allowed := range map[string]bool{
+		"http://127.0.0.1:8080/hook":     true,
+		"http://10.10.4.2/hook":          true,
+		"http://10.11.4.2/hook":          false,
+		"http://169.254.169.254/latest/": false,
+		"http://[::1]/hook":              false,
+		"https://93.184.215.14/hook":     true,
+	} {
+		t.Run(raw, func(t *testing.T) {
+			u, err := url.Parse(raw)
+			require.NoError(t, err)
+
+			err = p.CheckURL(ctx, u)
+			if allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbiddenAddress)
+			}
+		})
+	}
+
+	_, err = New(config.Config{AllowedPrivateAddresses: []string{"not-an-address"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,120 @@
+package transcoder
+
+import (
+	"bytes"
+	"context"
+	"image"
+	_ "image/jpeg"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+)
+
+// durationPattern matches the duration FFmpeg reports for its input.
+var durationPattern = regexp.MustCompile(`Duration: (\d+):(\d+):(\d+\.\d+)`)
+
+// FFmpeg shells out to the ffmpeg command line tool. Videos are encoded as
+// H.264 and AAC with the index at the start of the file so playback can begin
+// before the whole file has downloaded.
+type FFmpeg struct {
+	path string
+}
+
+func newFFmpeg() (*FFmpeg, error) {
+	path, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("VIDEO_TRANSCODER is ffmpeg but the ffmpeg command was not found"))
+	}
+
+	return &FFmpeg{path: path}, nil
+}
+
+func (f *FFmpeg) Transcode(ctx context.Context, src string, dir string) (*Result, error) {
+	video := filepath.Join(dir, "video.mp4")
+	poster := filepath.Join(dir, "poster.jpg")
+
+	stderr, err := f.run(ctx,
+		"-i", src,
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-crf", "23",
+		"-pix_fmt", "yuv420p",
+		"-vf", "scale=trunc(iw/2)*2:trunc(ih/2)*2",
+		"-c:a", "aac",
+		"-b:a", "128k",
+		"-movflags", "+faststart",
+		video,
+	)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	duration := parseDuration(stderr)
+
+	if _, err := f.run(ctx,
+		"-i", video,
+		"-vf", "thumbnail",
+		"-frames:v", "1",
+		poster,
+	); err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	pf, err := os.Open(poster)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	defer pf.Close()
+
+	cfg, _, err := image.DecodeConfig(pf)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Result{
+		Video:    video,
+		Poster:   poster,
+		Width:    cfg.Width,
+		Height:   cfg.Height,
+		Duration: duration,
+	}, nil
+}
+
+func (f *FFmpeg) run(ctx context.Context, args ...string) (string, error) {
+	stderr := bytes.NewBuffer(nil)
+
+	cmd := exec.CommandContext(ctx, f.path, append([]string{"-y", "-hide_banner"}, args...)...)
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fault.Wrap(err, fmsg.With(lastLines(stderr.String(), 1024)))
+	}
+
+	return stderr.String(), nil
+}
+
+func parseDuration(output string) time.Duration {
+	m := durationPattern.FindStringSubmatch(output)
+	if m == nil {
+		return 0
+	}
+
+	h, _ := strconv.Atoi(m[1])
+	mins, _ := strconv.Atoi(m[2])
+	sec, _ := strconv.ParseFloat(m[3], 64)
+
+	return time.Duration(h)*time.Hour + time.Duration(mins)*time.Minute + time.Duration(sec*float64(time.Second))
+}
+
+func lastLines(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[len(s)-n:]
+}
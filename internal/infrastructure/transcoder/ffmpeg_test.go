@@ -0,0 +1,16 @@
+package transcoder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(time.Minute+30*time.Second+500*time.Millisecond, parseDuration("  Duration: 00:01:30.50, start: 0.000000, bitrate: 1205 kb/s"))
+	a.Equal(2*time.Hour, parseDuration("Duration: 02:00:00.00, start"))
+	a.Equal(time.Duration(0), parseDuration("no duration here"))
+}
@@ -0,0 +1,50 @@
+// Package transcoder converts uploaded videos into a format which plays in
+// browsers and extracts a poster image to show before playback starts.
+package transcoder
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// Result holds the paths of the files written by a transcode along with the
+// properties of the video.
+type Result struct {
+	Video    string
+	Poster   string
+	Width    int
+	Height   int
+	Duration time.Duration
+}
+
+type Transcoder interface {
+	// Transcode converts the video at src into an MP4 and a poster image, both
+	// written into dir, which is removed by the caller.
+	Transcode(ctx context.Context, src string, dir string) (*Result, error)
+}
+
+func Build() fx.Option {
+	return fx.Provide(func(cfg config.Config) (Transcoder, error) {
+		switch cfg.VideoTranscoder {
+		case "":
+			return &Disabled{}, nil
+
+		case "ffmpeg":
+			return newFFmpeg()
+
+		default:
+			return nil, fault.Newf("unknown video transcoder: '%s'", cfg.VideoTranscoder)
+		}
+	})
+}
+
+type Disabled struct{}
+
+func (d *Disabled) Transcode(ctx context.Context, src string, dir string) (*Result, error) {
+	return nil, fault.New("video transcoding is disabled")
+}
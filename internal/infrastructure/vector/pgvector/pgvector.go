@@ -0,0 +1,321 @@
+package pgvector
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+// The vector table is managed outside of the ent schema because ent has no
+// support for the vector types provided by the pgvector extension.
+const table = "semdex_vectors"
+
+// halfvec supports HNSW indexes of up to 4000 dimensions whereas the regular
+// vector type only supports 2000, which is smaller than most embedding models.
+const maxDimensions = 4000
+
+type Store struct {
+	db *sqlx.DB
+}
+
+var (
+	_ vector.Driver  = &Store{}
+	_ vector.Counter = &Store{}
+	_ vector.Pinger  = &Store{}
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+func New(ctx context.Context, cfg config.Config, db *sqlx.DB) (*Store, error) {
+	if cfg.SemdexProvider != "pgvector" {
+		return nil, nil
+	}
+
+	if db.DriverName() != "pgx" {
+		return nil, fault.New("SEMDEX_PROVIDER is set to pgvector but DATABASE_URL is not a Postgres database")
+	}
+
+	if cfg.PgvectorDimensions <= 0 || cfg.PgvectorDimensions > maxDimensions {
+		return nil, fault.Newf("PGVECTOR_DIMENSIONS must be between 1 and %d", maxDimensions)
+	}
+
+	if err := migrate(ctx, db, int(cfg.PgvectorDimensions)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Store{db: db}, nil
+}
+
+// migrate creates the vector table and its indexes. If the table exists with
+// a different dimension size, it's dropped as the stored embeddings are not
+// comparable with the new ones and every item must be indexed again anyway.
+func migrate(ctx context.Context, db *sqlx.DB, dimensions int) error {
+	if _, err := db.ExecContext(ctx, `create extension if not exists vector`); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	column := fmt.Sprintf("halfvec(%d)", dimensions)
+
+	var current sql.NullString
+	err := db.GetContext(ctx, &current, `select format_type(a.atttypid, a.atttypmod)
+from pg_attribute a
+where a.attrelid = to_regclass($1) and a.attname = 'embedding' and not a.attisdropped`, table)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if current.Valid && current.String != column {
+		if _, err := db.ExecContext(ctx, `drop table `+table); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	statements := []string{
+		fmt.Sprintf(`create table if not exists %s (
+  id             text primary key,
+  datagraph_id   text not null,
+  datagraph_type text not null,
+  name           text not null,
+  content        text not null,
+  embedding      %s not null
+)`, table, column),
+		fmt.Sprintf(`create index if not exists %[1]s_datagraph_id_idx on %[1]s (datagraph_id)`, table),
+		fmt.Sprintf(`create index if not exists %[1]s_embedding_idx on %[1]s using hnsw (embedding halfvec_cosine_ops)`, table),
+	}
+
+	for _, s := range statements {
+		if _, err := db.ExecContext(ctx, s); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+type row struct {
+	ID            string `db:"id"`
+	DatagraphID   string `db:"datagraph_id"`
+	DatagraphType string `db:"datagraph_type"`
+	Name          string `db:"name"`
+	Content       string `db:"content"`
+	Embedding     string `db:"embedding"`
+}
+
+type scoredRow struct {
+	row
+	Score float32 `db:"score"`
+}
+
+func (r row) toVector() (*vector.Vector, error) {
+	values, err := parseVector(r.Embedding)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vector.Vector{
+		ID:     r.ID,
+		Values: values,
+		Metadata: vector.Metadata{
+			DatagraphID:   r.DatagraphID,
+			DatagraphType: r.DatagraphType,
+			Name:          r.Name,
+			Content:       r.Content,
+		},
+	}, nil
+}
+
+func (s *Store) Upsert(ctx context.Context, vectors []*vector.Vector) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer tx.Rollback()
+
+	q := `insert into ` + table + ` (id, datagraph_id, datagraph_type, name, content, embedding)
+values ($1, $2, $3, $4, $5, $6::halfvec)
+on conflict (id) do update set
+  datagraph_id   = excluded.datagraph_id,
+  datagraph_type = excluded.datagraph_type,
+  name           = excluded.name,
+  content        = excluded.content,
+  embedding      = excluded.embedding`
+
+	for _, v := range vectors {
+		_, err := tx.ExecContext(ctx, q,
+			v.ID,
+			v.Metadata.DatagraphID,
+			v.Metadata.DatagraphType,
+			v.Metadata.Name,
+			v.Metadata.Content,
+			formatVector(v.Values),
+		)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *Store) List(ctx context.Context, datagraphID string) ([]string, error) {
+	ids := []string{}
+	err := s.db.SelectContext(ctx, &ids, `select id from `+table+` where datagraph_id = $1`, datagraphID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ids, nil
+}
+
+func (s *Store) Fetch(ctx context.Context, ids []string) ([]*vector.Vector, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows := []row{}
+	err := s.db.SelectContext(ctx, &rows, `select id, datagraph_id, datagraph_type, name, content, embedding::text as embedding
+from `+table+`
+where id = any($1)`, ids)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	vectors := make([]*vector.Vector, 0, len(rows))
+	for _, r := range rows {
+		v, err := r.toVector()
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		vectors = append(vectors, v)
+	}
+
+	return vectors, nil
+}
+
+func (s *Store) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := s.db.ExecContext(ctx, `delete from `+table+` where id = any($1)`, ids)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *Store) Query(ctx context.Context, q vector.Query) ([]*vector.ScoredVector, error) {
+	var kinds []string
+	if len(q.Kinds) > 0 {
+		kinds = q.Kinds
+	}
+
+	rows := []scoredRow{}
+	err := s.db.SelectContext(ctx, &rows, `select id, datagraph_id, datagraph_type, name, content, '' as embedding,
+  1 - (embedding <=> $1::halfvec) as score
+from `+table+`
+where $2::text[] is null or datagraph_type = any($2::text[])
+order by embedding <=> $1::halfvec
+limit $3`, formatVector(q.Vector), kinds, q.TopK)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	results := make([]*vector.ScoredVector, 0, len(rows))
+	for _, r := range rows {
+		results = append(results, &vector.ScoredVector{
+			Vector: vector.Vector{
+				ID: r.ID,
+				Metadata: vector.Metadata{
+					DatagraphID:   r.DatagraphID,
+					DatagraphType: r.DatagraphType,
+					Name:          r.Name,
+					Content:       r.Content,
+				},
+			},
+			Score: r.Score,
+		})
+	}
+
+	return results, nil
+}
+
+func (s *Store) Count(ctx context.Context) (map[string]int, error) {
+	rows := []struct {
+		DatagraphType string `db:"datagraph_type"`
+		Count         int    `db:"count"`
+	}{}
+	err := s.db.SelectContext(ctx, &rows, `select datagraph_type, count(distinct datagraph_id) as count from `+table+` group by datagraph_type`)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	out := make(map[string]int, len(rows))
+	for _, r := range rows {
+		out[r.DatagraphType] = r.Count
+	}
+
+	return out, nil
+}
+
+func formatVector(v []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'f', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func parseVector(s string) ([]float32, error) {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	if s == "" {
+		return []float32{}, nil
+	}
+
+	parts := strings.Split(s, ",")
+	v := make([]float32, len(parts))
+	for i, p := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 32)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		v[i] = float32(f)
+	}
+
+	return v, nil
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `select 1 from `+table+` limit 1`); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,333 @@
+package qdrant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+// Qdrant point IDs must be UUIDs or integers so chunk IDs are mapped to a
+// deterministic UUID and the original chunk ID is kept in the payload.
+var pointNamespace = uuid.MustParse("0d4c5e3a-8f0e-4d7e-9a43-3c1f2a7b9e51")
+
+const scrollPageSize = 256
+
+type Client struct {
+	http       *http.Client
+	endpoint   *url.URL
+	apiKey     string
+	collection string
+}
+
+var (
+	_ vector.Driver = &Client{}
+	_ vector.Pinger = &Client{}
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+func New(ctx context.Context, cfg config.Config) (*Client, error) {
+	if cfg.SemdexProvider != "qdrant" {
+		return nil, nil
+	}
+
+	endpoint, err := url.Parse(cfg.QdrantURL)
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("failed to parse QDRANT_URL"))
+	}
+
+	c := &Client{
+		http:       &http.Client{Timeout: 30 * time.Second, Transport: outbound.Transport(nil)},
+		endpoint:   endpoint,
+		apiKey:     cfg.QdrantAPIKey,
+		collection: cfg.QdrantCollection,
+	}
+
+	if err := c.ensureCollection(ctx, int(cfg.QdrantDimensions)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+func pointID(id string) string {
+	return uuid.NewSHA1(pointNamespace, []byte(id)).String()
+}
+
+type payload struct {
+	ChunkID       string `json:"chunk_id"`
+	DatagraphID   string `json:"datagraph_id"`
+	DatagraphType string `json:"datagraph_type"`
+	Name          string `json:"name"`
+	Content       string `json:"content"`
+}
+
+func (p payload) toVector(values []float32) vector.Vector {
+	return vector.Vector{
+		ID:     p.ChunkID,
+		Values: values,
+		Metadata: vector.Metadata{
+			DatagraphID:   p.DatagraphID,
+			DatagraphType: p.DatagraphType,
+			Name:          p.Name,
+			Content:       p.Content,
+		},
+	}
+}
+
+type point struct {
+	ID      string    `json:"id"`
+	Vector  []float32 `json:"vector,omitempty"`
+	Payload payload   `json:"payload"`
+}
+
+type scoredPoint struct {
+	point
+	Score float32 `json:"score"`
+}
+
+type condition struct {
+	Key   string `json:"key"`
+	Match match  `json:"match"`
+}
+
+type match struct {
+	Value string   `json:"value,omitempty"`
+	Any   []string `json:"any,omitempty"`
+}
+
+type filter struct {
+	Must []condition `json:"must"`
+}
+
+func (c *Client) ensureCollection(ctx context.Context, dimensions int) error {
+	var exists struct {
+		Exists bool `json:"exists"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/exists", nil, &exists); err != nil {
+		return err
+	}
+
+	if !exists.Exists {
+		body := map[string]any{
+			"vectors": map[string]any{
+				"size":     dimensions,
+				"distance": "Cosine",
+			},
+		}
+		if err := c.do(ctx, http.MethodPut, "", body, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range []string{"datagraph_id", "datagraph_type"} {
+		body := map[string]any{
+			"field_name":   field,
+			"field_schema": "keyword",
+		}
+		if err := c.do(ctx, http.MethodPut, "/index?wait=true", body, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) Upsert(ctx context.Context, vectors []*vector.Vector) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	points := make([]point, len(vectors))
+	for i, v := range vectors {
+		points[i] = point{
+			ID:     pointID(v.ID),
+			Vector: v.Values,
+			Payload: payload{
+				ChunkID:       v.ID,
+				DatagraphID:   v.Metadata.DatagraphID,
+				DatagraphType: v.Metadata.DatagraphType,
+				Name:          v.Metadata.Name,
+				Content:       v.Metadata.Content,
+			},
+		}
+	}
+
+	return c.do(ctx, http.MethodPut, "/points?wait=true", map[string]any{"points": points}, nil)
+}
+
+func (c *Client) List(ctx context.Context, datagraphID string) ([]string, error) {
+	ids := []string{}
+	var offset any
+
+	for {
+		body := map[string]any{
+			"filter": filter{Must: []condition{
+				{Key: "datagraph_id", Match: match{Value: datagraphID}},
+			}},
+			"limit":        scrollPageSize,
+			"with_payload": []string{"chunk_id"},
+			"with_vector":  false,
+		}
+		if offset != nil {
+			body["offset"] = offset
+		}
+
+		var result struct {
+			Points         []point `json:"points"`
+			NextPageOffset any     `json:"next_page_offset"`
+		}
+		if err := c.do(ctx, http.MethodPost, "/points/scroll", body, &result); err != nil {
+			return nil, err
+		}
+
+		for _, p := range result.Points {
+			ids = append(ids, p.Payload.ChunkID)
+		}
+
+		if result.NextPageOffset == nil {
+			return ids, nil
+		}
+		offset = result.NextPageOffset
+	}
+}
+
+func (c *Client) Fetch(ctx context.Context, ids []string) ([]*vector.Vector, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	points := make([]string, len(ids))
+	for i, id := range ids {
+		points[i] = pointID(id)
+	}
+
+	body := map[string]any{
+		"ids":          points,
+		"with_payload": true,
+		"with_vector":  true,
+	}
+
+	var result []point
+	if err := c.do(ctx, http.MethodPost, "/points", body, &result); err != nil {
+		return nil, err
+	}
+
+	vectors := make([]*vector.Vector, len(result))
+	for i, p := range result {
+		v := p.Payload.toVector(p.Vector)
+		vectors[i] = &v
+	}
+
+	return vectors, nil
+}
+
+func (c *Client) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	points := make([]string, len(ids))
+	for i, id := range ids {
+		points[i] = pointID(id)
+	}
+
+	return c.do(ctx, http.MethodPost, "/points/delete?wait=true", map[string]any{"points": points}, nil)
+}
+
+func (c *Client) Query(ctx context.Context, q vector.Query) ([]*vector.ScoredVector, error) {
+	body := map[string]any{
+		"vector":       q.Vector,
+		"limit":        q.TopK,
+		"with_payload": true,
+	}
+	if len(q.Kinds) > 0 {
+		body["filter"] = filter{Must: []condition{
+			{Key: "datagraph_type", Match: match{Any: q.Kinds}},
+		}}
+	}
+
+	var result []scoredPoint
+	if err := c.do(ctx, http.MethodPost, "/points/search", body, &result); err != nil {
+		return nil, err
+	}
+
+	scored := make([]*vector.ScoredVector, len(result))
+	for i, p := range result {
+		scored[i] = &vector.ScoredVector{
+			Vector: p.Payload.toVector(nil),
+			Score:  p.Score,
+		}
+	}
+
+	return scored, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, in any, out any) error {
+	var body bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&body).Encode(in); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	p, query, _ := strings.Cut(path, "?")
+	u := c.endpoint.JoinPath("collections", c.collection, p)
+	u.RawQuery = query
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), &body)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("api-key", c.apiKey)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+		Status any             `json:"status"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("qdrant: unexpected response: %s", resp.Status)))
+	}
+
+	if resp.StatusCode >= 300 {
+		return fault.Wrap(fault.Newf("qdrant: %s: %v", resp.Status, envelope.Status), fctx.With(ctx))
+	}
+
+	if out != nil && len(envelope.Result) > 0 {
+		if err := json.Unmarshal(envelope.Result, out); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	return c.do(ctx, http.MethodGet, "/exists", nil, nil)
+}
@@ -0,0 +1,59 @@
+// Package vector defines a minimal driver interface for vector stores. Drivers
+// only deal with storing and querying embeddings, chunking and embedding of
+// content is handled by the semdexer that sits on top of a driver.
+package vector
+
+import "context"
+
+type Metadata struct {
+	DatagraphID   string
+	DatagraphType string
+	Name          string
+	Content       string
+}
+
+type Vector struct {
+	ID       string
+	Values   []float32
+	Metadata Metadata
+}
+
+type ScoredVector struct {
+	Vector
+	// Score is the cosine similarity between the query and the vector.
+	Score float32
+}
+
+type Query struct {
+	Vector []float32
+	TopK   int
+	// Kinds restricts results to the given datagraph types, empty means all.
+	Kinds []string
+}
+
+type Driver interface {
+	// Upsert writes the given vectors, replacing any with the same ID.
+	Upsert(ctx context.Context, vectors []*Vector) error
+
+	// List returns the IDs of all vectors belonging to a datagraph item.
+	List(ctx context.Context, datagraphID string) ([]string, error)
+
+	// Fetch returns the vectors for the given IDs, missing IDs are ignored.
+	Fetch(ctx context.Context, ids []string) ([]*Vector, error)
+
+	Delete(ctx context.Context, ids []string) error
+
+	Query(ctx context.Context, q Query) ([]*ScoredVector, error)
+}
+
+// Counter may be implemented by drivers which can count the distinct items
+// stored in the index, keyed by datagraph type.
+type Counter interface {
+	Count(ctx context.Context) (map[string]int, error)
+}
+
+// Pinger may be implemented by drivers backed by a remote store to check the
+// store is reachable.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,362 @@
+// Package weaviate is a vector driver backed by a Weaviate class. Vectors are
+// calculated by the language model provider like every other driver, so the
+// class is created without a vectorizer module.
+package weaviate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/outbound"
+	"github.com/Southclaws/storyden/internal/infrastructure/vector"
+)
+
+// Weaviate object IDs must be UUIDs so chunk IDs are mapped to a deterministic
+// UUID and the original chunk ID is kept as a property.
+var objectNamespace = uuid.MustParse("6f2d0a8e-3b71-4c55-9e0d-5a4b8c1f7d23")
+
+var classNamePattern = regexp.MustCompile(`^[A-Z][_0-9A-Za-z]*$`)
+
+// listLimit is the most chunks of one item which are listed, it matches the
+// default QUERY_MAXIMUM_RESULTS of a Weaviate instance.
+const listLimit = 10000
+
+type Client struct {
+	wc    *weaviate.Client
+	class string
+}
+
+var (
+	_ vector.Driver = &Client{}
+	_ vector.Pinger = &Client{}
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+func New(ctx context.Context, cfg config.Config) (*Client, error) {
+	if cfg.SemdexProvider != "weaviate" {
+		return nil, nil
+	}
+
+	if !classNamePattern.MatchString(cfg.WeaviateClassName) {
+		return nil, fault.Newf("WEAVIATE_CLASS_NAME '%s' is not a valid Weaviate class name, it must start with a capital letter and contain only letters, digits and underscores", cfg.WeaviateClassName)
+	}
+
+	u, err := url.Parse(cfg.WeaviateURL)
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("failed to parse WEAVIATE_URL"))
+	}
+
+	headers := map[string]string{}
+	if cfg.WeaviateToken != "" {
+		headers["Authorization"] = "Bearer " + cfg.WeaviateToken
+	}
+
+	wcfg := weaviate.Config{
+		Host:             u.Host,
+		Scheme:           u.Scheme,
+		Headers:          headers,
+		ConnectionClient: &http.Client{Timeout: 30 * time.Second, Transport: outbound.Transport(nil)},
+	}
+
+	wc, err := weaviate.NewClient(wcfg)
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("failed to create weaviate client"))
+	}
+
+	c := &Client{
+		wc:    wc,
+		class: cfg.WeaviateClassName,
+	}
+
+	if err := c.ensureClass(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+func objectID(id string) string {
+	return uuid.NewSHA1(objectNamespace, []byte(id)).String()
+}
+
+type object struct {
+	ChunkID       string     `json:"chunk_id"`
+	DatagraphID   string     `json:"datagraph_id"`
+	DatagraphType string     `json:"datagraph_type"`
+	Name          string     `json:"name"`
+	Content       string     `json:"content"`
+	Additional    additional `json:"_additional"`
+}
+
+type additional struct {
+	Vector   []float32 `json:"vector"`
+	Distance float32   `json:"distance"`
+}
+
+func (o object) toVector() vector.Vector {
+	return vector.Vector{
+		ID:     o.ChunkID,
+		Values: o.Additional.Vector,
+		Metadata: vector.Metadata{
+			DatagraphID:   o.DatagraphID,
+			DatagraphType: o.DatagraphType,
+			Name:          o.Name,
+			Content:       o.Content,
+		},
+	}
+}
+
+var (
+	fieldsMetadata = []graphql.Field{
+		{Name: "chunk_id"},
+		{Name: "datagraph_id"},
+		{Name: "datagraph_type"},
+		{Name: "name"},
+		{Name: "content"},
+	}
+	fieldVector   = graphql.Field{Name: "_additional", Fields: []graphql.Field{{Name: "vector"}}}
+	fieldDistance = graphql.Field{Name: "_additional", Fields: []graphql.Field{{Name: "distance"}}}
+)
+
+func (c *Client) ensureClass(ctx context.Context) error {
+	exists, err := c.wc.Schema().ClassExistenceChecker().WithClassName(c.class).Do(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if exists {
+		return nil
+	}
+
+	// IDs are matched exactly so they're kept whole rather than split into
+	// words, which would break chunk IDs apart at their separators.
+	keyword := func(name string) *models.Property {
+		return &models.Property{Name: name, DataType: []string{"text"}, Tokenization: models.PropertyTokenizationField}
+	}
+
+	class := &models.Class{
+		Class:      c.class,
+		Vectorizer: "none",
+		VectorIndexConfig: map[string]any{
+			"distance": "cosine",
+		},
+		Properties: []*models.Property{
+			keyword("chunk_id"),
+			keyword("datagraph_id"),
+			keyword("datagraph_type"),
+			{Name: "name", DataType: []string{"text"}},
+			{Name: "content", DataType: []string{"text"}},
+		},
+	}
+
+	if err := c.wc.Schema().ClassCreator().WithClass(class).Do(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create weaviate class"))
+	}
+
+	return nil
+}
+
+func (c *Client) Upsert(ctx context.Context, vectors []*vector.Vector) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	objects := make([]*models.Object, len(vectors))
+	for i, v := range vectors {
+		objects[i] = &models.Object{
+			Class:  c.class,
+			ID:     strfmt.UUID(objectID(v.ID)),
+			Vector: models.C11yVector(v.Values),
+			Properties: map[string]any{
+				"chunk_id":       v.ID,
+				"datagraph_id":   v.Metadata.DatagraphID,
+				"datagraph_type": v.Metadata.DatagraphType,
+				"name":           v.Metadata.Name,
+				"content":        v.Metadata.Content,
+			},
+		}
+	}
+
+	results, err := c.wc.Batch().ObjectsBatcher().WithObjects(objects...).Do(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Batches succeed as a whole even when individual objects fail.
+	var errs []error
+	for _, r := range results {
+		if r.Result == nil || r.Result.Errors == nil {
+			continue
+		}
+		for _, e := range r.Result.Errors.Error {
+			errs = append(errs, errors.New(e.Message))
+		}
+	}
+	if len(errs) > 0 {
+		return fault.Wrap(errors.Join(errs...), fctx.With(ctx), fmsg.With("failed to upsert weaviate objects"))
+	}
+
+	return nil
+}
+
+func (c *Client) List(ctx context.Context, datagraphID string) ([]string, error) {
+	objects, err := c.get(ctx, c.wc.GraphQL().Get().
+		WithClassName(c.class).
+		WithFields(graphql.Field{Name: "chunk_id"}).
+		WithWhere(filters.Where().
+			WithPath([]string{"datagraph_id"}).
+			WithOperator(filters.Equal).
+			WithValueText(datagraphID)).
+		WithLimit(listLimit))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ids := make([]string, len(objects))
+	for i, o := range objects {
+		ids[i] = o.ChunkID
+	}
+
+	return ids, nil
+}
+
+func (c *Client) Fetch(ctx context.Context, ids []string) ([]*vector.Vector, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	objects, err := c.get(ctx, c.wc.GraphQL().Get().
+		WithClassName(c.class).
+		WithFields(append(fieldsMetadata, fieldVector)...).
+		WithWhere(filters.Where().
+			WithPath([]string{"chunk_id"}).
+			WithOperator(filters.ContainsAny).
+			WithValueText(ids...)).
+		WithLimit(len(ids)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	vectors := make([]*vector.Vector, len(objects))
+	for i, o := range objects {
+		v := o.toVector()
+		vectors[i] = &v
+	}
+
+	return vectors, nil
+}
+
+func (c *Client) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := c.wc.Batch().ObjectsBatchDeleter().
+		WithClassName(c.class).
+		WithWhere(filters.Where().
+			WithPath([]string{"chunk_id"}).
+			WithOperator(filters.ContainsAny).
+			WithValueText(ids...)).
+		Do(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (c *Client) Query(ctx context.Context, q vector.Query) ([]*vector.ScoredVector, error) {
+	query := c.wc.GraphQL().Get().
+		WithClassName(c.class).
+		WithFields(append(fieldsMetadata, fieldDistance)...).
+		WithNearVector(c.wc.GraphQL().NearVectorArgBuilder().WithVector(q.Vector)).
+		WithLimit(q.TopK)
+
+	if len(q.Kinds) > 0 {
+		query.WithWhere(filters.Where().
+			WithPath([]string{"datagraph_type"}).
+			WithOperator(filters.ContainsAny).
+			WithValueText(q.Kinds...))
+	}
+
+	objects, err := c.get(ctx, query)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	scored := make([]*vector.ScoredVector, len(objects))
+	for i, o := range objects {
+		scored[i] = &vector.ScoredVector{
+			Vector: o.toVector(),
+			// Cosine distance is one minus the cosine similarity.
+			Score: 1 - o.Additional.Distance,
+		}
+	}
+
+	return scored, nil
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	live, err := c.wc.Misc().LiveChecker().Do(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !live {
+		return fault.New("weaviate is not live", fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// get runs a Get query against the class and decodes the objects it returns.
+// The client reports GraphQL errors separately from transport errors so both
+// are checked.
+func (c *Client) get(ctx context.Context, query *graphql.GetBuilder) ([]object, error) {
+	res, err := query.Do(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(res.Errors) > 0 {
+		errs := make([]error, len(res.Errors))
+		for i, e := range res.Errors {
+			errs[i] = errors.New(e.Message)
+		}
+		return nil, fault.Wrap(errors.Join(errs...), fctx.With(ctx))
+	}
+
+	raw, err := json.Marshal(res.Data)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var data struct {
+		Get map[string][]object `json:"Get"`
+	}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return data.Get[c.class], nil
+}
@@ -0,0 +1,271 @@
+// Package webpush sends encrypted messages to browser push services using the
+// Web Push protocol (RFC 8030) with VAPID authentication (RFC 8292) and the
+// aes128gcm content encoding (RFC 8291).
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
+)
+
+var ErrSubscriptionGone = fault.New("push subscription has expired or been removed", ftag.With(ftag.NotFound))
+
+const (
+	recordSize = 4096
+	// Encryption adds a 16 byte tag and a 1 byte delimiter to the single record
+	// and the header is sent alongside it, a little headroom is left for both.
+	MaxPayload = recordSize - 16 - 1 - 86
+
+	tokenLifespan = 12 * time.Hour
+	defaultTTL    = 24 * time.Hour
+)
+
+type Subscription struct {
+	Endpoint string
+	P256DH   []byte
+	Auth     []byte
+}
+
+// ParseSubscription decodes and validates the keys of a browser subscription as
+// they appear in the Push API's PushSubscription.toJSON() output.
+func ParseSubscription(endpoint, p256dh, auth string) (Subscription, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return Subscription{}, fault.New("push subscription endpoint must be an absolute https URL", ftag.With(ftag.InvalidArgument))
+	}
+
+	pub, err := DecodeKey(p256dh)
+	if err != nil {
+		return Subscription{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+	if _, err := ecdh.P256().NewPublicKey(pub); err != nil {
+		return Subscription{}, fault.Wrap(err, fmsg.With("p256dh is not a P-256 public key"), ftag.With(ftag.InvalidArgument))
+	}
+
+	secret, err := DecodeKey(auth)
+	if err != nil {
+		return Subscription{}, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+	if len(secret) != 16 {
+		return Subscription{}, fault.Wrap(fault.Newf("auth secret must be 16 bytes, got %d", len(secret)), ftag.With(ftag.InvalidArgument))
+	}
+
+	return Subscription{Endpoint: endpoint, P256DH: pub, Auth: secret}, nil
+}
+
+type Sender struct {
+	client    *http.Client
+	key       *ecdsa.PrivateKey
+	publicKey []byte
+	subject   string
+}
+
+// New returns nil when no VAPID key is configured, Web Push is then disabled.
+// Endpoints are supplied by members so they're only sent to public addresses.
+func New(cfg config.Config, policy *safehttp.Policy) (*Sender, error) {
+	if cfg.WebPushVAPIDPrivateKey == "" {
+		return nil, nil
+	}
+
+	raw, err := DecodeKey(cfg.WebPushVAPIDPrivateKey)
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("WEB_PUSH_VAPID_PRIVATE_KEY is not valid base64url"))
+	}
+
+	key, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), raw)
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("WEB_PUSH_VAPID_PRIVATE_KEY is not a valid P-256 private key"))
+	}
+
+	ek, err := key.ECDH()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	subject := cfg.WebPushSubject
+	if subject == "" {
+		subject = cfg.PublicWebAddress.String()
+	}
+
+	return &Sender{
+		client:    policy.Client(30 * time.Second),
+		key:       key,
+		publicKey: ek.PublicKey().Bytes(),
+		subject:   subject,
+	}, nil
+}
+
+// PublicKey is the VAPID application server key browsers subscribe with.
+func (s *Sender) PublicKey() string {
+	return base64.RawURLEncoding.EncodeToString(s.publicKey)
+}
+
+// Send delivers a payload to a single subscription. ErrSubscriptionGone is
+// returned when the push service reports the subscription no longer exists.
+func (s *Sender) Send(ctx context.Context, sub Subscription, payload []byte) error {
+	if len(payload) > MaxPayload {
+		return fault.Newf("push payload of %d bytes exceeds the maximum of %d", len(payload), MaxPayload)
+	}
+
+	body, err := encrypt(sub, payload)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	token, err := s.vapid(sub.Endpoint)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", strconv.Itoa(int(defaultTTL.Seconds())))
+	req.Header.Set("Urgency", "normal")
+	req.Header.Set("Authorization", fmt.Sprintf("vapid t=%s, k=%s", token, s.PublicKey()))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return fault.Wrap(ErrSubscriptionGone, fctx.With(ctx))
+
+	case res.StatusCode >= 300:
+		return fault.Newf("push service responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *Sender) vapid(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fault.Wrap(err)
+	}
+
+	claims := jwt.MapClaims{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": time.Now().Add(tokenLifespan).Unix(),
+		"sub": s.subject,
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(s.key)
+}
+
+// encrypt produces a single aes128gcm record as described in RFC 8291.
+func encrypt(sub Subscription, payload []byte) ([]byte, error) {
+	curve := ecdh.P256()
+
+	uaPublic, err := curve.NewPublicKey(sub.P256DH)
+	if err != nil {
+		return nil, fault.Wrap(err, fmsg.With("invalid subscription public key"))
+	}
+
+	asPrivate, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	secret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	cek, nonce, err := deriveKeys(secret, sub.Auth, salt, sub.P256DH, asPublic)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	// 0x02 marks the final (and only) record, no further padding is added.
+	plaintext := append(append([]byte{}, payload...), 0x02)
+
+	header := make([]byte, 0, 16+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+func deriveKeys(secret, auth, salt, uaPublic, asPublic []byte) (cek, nonce []byte, err error) {
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+
+	ikm, err := hkdf.Key(sha256.New, secret, auth, string(keyInfo), 32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cek, err = hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce, err = hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cek, nonce, nil
+}
+
+// DecodeKey accepts base64url keys with or without padding, which is how
+// browsers and most key generators emit them.
+func DecodeKey(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
@@ -0,0 +1,110 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decrypt is the user agent side of RFC 8291, used to check encrypt round-trips.
+func decrypt(t *testing.T, uaPrivate *ecdh.PrivateKey, auth []byte, body []byte) []byte {
+	r := require.New(t)
+
+	salt := body[:16]
+	rs := binary.BigEndian.Uint32(body[16:20])
+	idlen := int(body[20])
+	asPublicBytes := body[21 : 21+idlen]
+	ciphertext := body[21+idlen:]
+
+	r.Equal(uint32(recordSize), rs)
+
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicBytes)
+	r.NoError(err)
+
+	secret, err := uaPrivate.ECDH(asPublic)
+	r.NoError(err)
+
+	cek, nonce, err := deriveKeys(secret, auth, salt, uaPrivate.PublicKey().Bytes(), asPublicBytes)
+	r.NoError(err)
+
+	block, err := aes.NewCipher(cek)
+	r.NoError(err)
+	gcm, err := cipher.NewGCM(block)
+	r.NoError(err)
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	r.NoError(err)
+	r.Equal(byte(0x02), plaintext[len(plaintext)-1])
+
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestEncrypt(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	r.NoError(err)
+
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	r.NoError(err)
+
+	sub := Subscription{
+		Endpoint: "https://push.example.com/abc",
+		P256DH:   uaPrivate.PublicKey().Bytes(),
+		Auth:     auth,
+	}
+
+	payload := []byte(`{"title":"Someone replied to your thread"}`)
+
+	body, err := encrypt(sub, payload)
+	r.NoError(err)
+	a.Equal(payload, decrypt(t, uaPrivate, auth, body))
+
+	again, err := encrypt(sub, payload)
+	r.NoError(err)
+	a.NotEqual(body, again, "every message uses a fresh key and salt")
+
+	_, err = encrypt(Subscription{P256DH: []byte("nope"), Auth: auth}, payload)
+	a.Error(err)
+}
+
+func TestParseSubscription(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	r.NoError(err)
+
+	p256dh := base64.RawURLEncoding.EncodeToString(uaPrivate.PublicKey().Bytes())
+	auth := base64.URLEncoding.EncodeToString(make([]byte, 16))
+
+	sub, err := ParseSubscription("https://push.example.com/abc", p256dh, auth)
+	r.NoError(err)
+	a.Equal(uaPrivate.PublicKey().Bytes(), sub.P256DH)
+	a.Len(sub.Auth, 16)
+
+	_, err = ParseSubscription("/relative", p256dh, auth)
+	a.Error(err)
+
+	_, err = ParseSubscription("http://push.example.com/abc", p256dh, auth)
+	a.Error(err)
+
+	_, err = ParseSubscription("https://push.example.com/abc", "AQID", auth)
+	a.Error(err)
+
+	_, err = ParseSubscription("https://push.example.com/abc", p256dh, "AQID")
+	a.Error(err)
+}
@@ -71,13 +71,15 @@ func Test(t *testing.T, cfg *config.Config, o ...fx.Option) {
 		cf()
 	})
 
-	o = append(o,
+	// The application comes first so its start hooks, such as event consumers
+	// subscribing to the message bus, have run before the test's own hooks.
+	o = append([]fx.Option{
 		// main application dependencies
 		application(),
 
 		// provide a global context
 		fx.Provide(func() context.Context { return ctx }),
-	)
+	}, o...)
 
 	// if this test has a custom config, merge+overwrite with the defaults.
 	if cfg != nil {
@@ -0,0 +1,258 @@
+// i18nextract finds every message which may be translated and adds those which
+// are missing to each locale's catalog, so translators only need to fill in the
+// blanks. Messages are passed to i18n.T or i18n.N, or are the user-facing
+// description given to fmsg.WithDesc. Only string literals can be extracted.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+func main() {
+	localesFlag := flag.String("locales", "internal/i18n/locales", "path to the locale catalogs")
+
+	flag.Parse()
+
+	dirs := flag.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"app", "internal"}
+	}
+
+	if err := run(*localesFlag, dirs); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// message is an extracted message, plural messages are keyed by their "other"
+// form and have an entry for each plural category of the locale.
+type message struct {
+	id     string
+	plural bool
+}
+
+func run(localesDir string, dirs []string) error {
+	messages := map[string]message{}
+
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return nil
+			}
+
+			found, err := extract(path)
+			if err != nil {
+				return err
+			}
+
+			for _, m := range found {
+				messages[m.id] = m
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	catalogs, err := filepath.Glob(filepath.Join(localesDir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range catalogs {
+		if err := update(path, messages); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	fmt.Printf("%d messages extracted into %d catalogs\n", len(messages), len(catalogs))
+
+	return nil
+}
+
+func extract(path string) ([]message, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	found := []message{}
+
+	// The i18n package translates some of its own messages, such as the names
+	// of months, which it calls without the package name.
+	local := f.Name.Name == "i18n"
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		pkg, name, ok := callee(call)
+		if !ok {
+			return true
+		}
+		if local && pkg == "" {
+			pkg = "i18n"
+		}
+
+		switch {
+		case pkg == "i18n" && name == "T":
+			if s, ok := literal(call.Args, 1); ok {
+				found = append(found, message{id: s})
+			}
+
+		case pkg == "i18n" && name == "N":
+			if s, ok := literal(call.Args, 3); ok {
+				found = append(found, message{id: s, plural: true})
+			}
+
+		case pkg == "fmsg" && name == "WithDesc":
+			if s, ok := literal(call.Args, 1); ok {
+				found = append(found, message{id: s})
+			}
+		}
+
+		return true
+	})
+
+	return found, nil
+}
+
+// callee is the package and name of the function being called, the package is
+// empty for calls to a function in the same package.
+func callee(call *ast.CallExpr) (string, string, bool) {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return "", fn.Name, true
+
+	case *ast.SelectorExpr:
+		pkg, ok := fn.X.(*ast.Ident)
+		if !ok {
+			return "", "", false
+		}
+		return pkg.Name, fn.Sel.Name, true
+
+	default:
+		return "", "", false
+	}
+}
+
+func literal(args []ast.Expr, i int) (string, bool) {
+	if len(args) <= i {
+		return "", false
+	}
+
+	lit, ok := args[i].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil || s == "" {
+		return "", false
+	}
+
+	return s, true
+}
+
+// update adds any missing messages to a catalog and removes those which are
+// no longer used, existing translations are left as they are.
+func update(path string, messages map[string]message) error {
+	tag, err := language.Parse(strings.TrimSuffix(filepath.Base(path), ".json"))
+	if err != nil {
+		return err
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]json.RawMessage{}
+	if len(bytes.TrimSpace(b)) > 0 {
+		if err := json.Unmarshal(b, &existing); err != nil {
+			return err
+		}
+	}
+
+	forms := pluralForms(tag)
+
+	out := map[string]any{}
+	for id, m := range messages {
+		if v, ok := existing[id]; ok {
+			out[id] = v
+			continue
+		}
+
+		if m.plural {
+			empty := map[string]string{}
+			for _, f := range forms {
+				empty[f] = ""
+			}
+			out[id] = empty
+		} else {
+			out[id] = ""
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// pluralForms lists the CLDR plural categories a language uses for whole
+// numbers, found by trying enough numbers to hit each of them.
+func pluralForms(tag language.Tag) []string {
+	seen := map[plural.Form]bool{}
+	for i := 0; i <= 1000; i++ {
+		seen[plural.Cardinal.MatchPlural(tag, i, 0, 0, 0, 0)] = true
+	}
+
+	names := map[plural.Form]string{
+		plural.Zero:  "zero",
+		plural.One:   "one",
+		plural.Two:   "two",
+		plural.Few:   "few",
+		plural.Many:  "many",
+		plural.Other: "other",
+	}
+
+	forms := []string{}
+	for f, ok := range seen {
+		if ok {
+			forms = append(forms, names[f])
+		}
+	}
+	slices.Sort(forms)
+
+	return forms
+}
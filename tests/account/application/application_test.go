@@ -0,0 +1,160 @@
+package application_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestApplications(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			set, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+				Applications: &openapi.ApplicationSettings{
+					Required: opt.New(true).Ptr(),
+					Questions: &[]openapi.ApplicationQuestion{
+						{Id: "why", Prompt: "Why do you want to join?", Required: true},
+						{Id: "where", Prompt: "Where did you hear about us?"},
+					},
+				},
+			}, adminSession)
+			tests.Ok(t, err, set)
+
+			info, err := cl.GetInfoWithResponse(root)
+			tests.Ok(t, err, info)
+			assert.True(t, info.JSON200.ApprovalRequired)
+
+			apply := func(t *testing.T) (openapi.RequestEditorFn, openapi.Application) {
+				res, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{
+					Identifier: "applicant-" + xid.New().String(),
+					Token:      "password",
+				})
+				tests.Ok(t, err, res)
+
+				id := account.AccountID(utils.Must(xid.FromString(res.JSON200.Id)))
+				session := sh.WithSession(e2e.WithAccountID(root, id))
+
+				app, err := cl.AccountApplicationGetWithResponse(root, session)
+				tests.Ok(t, err, app)
+				assert.Equal(t, openapi.ApplicationStatusPending, app.JSON200.Application.Status)
+				assert.Nil(t, app.JSON200.Application.SubmittedAt)
+				assert.Len(t, app.JSON200.Questions, 2)
+
+				missing, err := cl.AccountApplicationSubmitWithResponse(root, openapi.AccountApplicationSubmitProps{
+					Answers: []openapi.ApplicationAnswer{{QuestionId: "where", Answer: "A friend"}},
+				}, session)
+				tests.Status(t, err, missing, http.StatusBadRequest)
+
+				submitted, err := cl.AccountApplicationSubmitWithResponse(root, openapi.AccountApplicationSubmitProps{
+					Answers: []openapi.ApplicationAnswer{{QuestionId: "why", Answer: "To talk about gardening"}},
+				}, session)
+				tests.Ok(t, err, submitted)
+				require.NotNil(t, submitted.JSON200.Application.SubmittedAt)
+
+				return session, submitted.JSON200.Application
+			}
+
+			createCollection := func(session openapi.RequestEditorFn) (*openapi.CollectionCreateResponse, error) {
+				return cl.CollectionCreateWithResponse(root, openapi.CollectionCreateJSONRequestBody{
+					Name: "collection-" + xid.New().String(),
+				}, session)
+			}
+
+			t.Run("approve", func(t *testing.T) {
+				a := assert.New(t)
+
+				session, app := apply(t)
+
+				held, err := createCollection(session)
+				tests.Status(t, err, held, http.StatusForbidden)
+
+				list, err := cl.AdminApplicationListWithResponse(root, &openapi.AdminApplicationListParams{
+					Status: opt.New(openapi.ApplicationStatusPending).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, list)
+				a.Contains(dt.Map(list.JSON200.Applications, func(a openapi.Application) string { return a.Id }), app.Id)
+
+				denied, err := cl.AdminApplicationListWithResponse(root, nil, memberSession)
+				tests.Status(t, err, denied, http.StatusForbidden)
+
+				approved, err := cl.AdminApplicationApproveWithResponse(root, openapi.ApplicationReviewProps{
+					ApplicationIds: []openapi.Identifier{app.Id},
+				}, adminSession)
+				tests.Ok(t, err, approved)
+				a.Equal(1, approved.JSON200.Reviewed)
+
+				again, err := cl.AdminApplicationRejectWithResponse(root, openapi.ApplicationReviewProps{
+					ApplicationIds: []openapi.Identifier{app.Id},
+				}, adminSession)
+				tests.Ok(t, err, again)
+				a.Equal(0, again.JSON200.Reviewed)
+
+				allowed, err := createCollection(session)
+				tests.Ok(t, err, allowed)
+
+				resubmit, err := cl.AccountApplicationSubmitWithResponse(root, openapi.AccountApplicationSubmitProps{
+					Answers: []openapi.ApplicationAnswer{{QuestionId: "why", Answer: "Changed my mind"}},
+				}, session)
+				tests.Status(t, err, resubmit, http.StatusBadRequest)
+			})
+
+			t.Run("reject", func(t *testing.T) {
+				a := assert.New(t)
+
+				session, app := apply(t)
+
+				rejected, err := cl.AdminApplicationRejectWithResponse(root, openapi.ApplicationReviewProps{
+					ApplicationIds: []openapi.Identifier{app.Id},
+					Reason:         opt.New("Not a good fit").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, rejected)
+				a.Equal(1, rejected.JSON200.Reviewed)
+
+				own, err := cl.AccountApplicationGetWithResponse(root, session)
+				tests.Ok(t, err, own)
+				a.Equal(openapi.ApplicationStatusRejected, own.JSON200.Application.Status)
+				a.Equal("Not a good fit", opt.NewPtr(own.JSON200.Application.Reason).OrZero())
+
+				held, err := createCollection(session)
+				tests.Status(t, err, held, http.StatusForbidden)
+			})
+
+			t.Run("existing_members_unaffected", func(t *testing.T) {
+				own, err := cl.AccountApplicationGetWithResponse(root, memberSession)
+				tests.Status(t, err, own, http.StatusNotFound)
+
+				allowed, err := createCollection(memberSession)
+				tests.Ok(t, err, allowed)
+			})
+		}))
+	}))
+}
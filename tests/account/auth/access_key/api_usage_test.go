@@ -0,0 +1,132 @@
+package access_key_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/api_usage"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAPIUsage(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		recorder *api_usage.Recorder,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			grant(t, cl, adminSession, member.Handle, openapi.PermissionList{openapi.USEPERSONALACCESSKEYS})
+
+			ak := tests.AssertRequest(
+				cl.AccessKeyCreateWithResponse(root, openapi.AccessKeyInitialProps{
+					Name: "test-usage",
+				}, memberSession),
+			)(t, http.StatusOK)
+			keySession := createAccessKeyAuth(ak.JSON200.Secret)
+
+			for range 3 {
+				tests.AssertRequest(cl.AccountGetWithResponse(root, keySession))(t, http.StatusOK)
+			}
+			tests.AssertRequest(cl.ProfileGetWithResponse(root, "nonexistent-usage-handle", keySession))(t, http.StatusNotFound)
+
+			require.NoError(t, recorder.Flush(root))
+
+			t.Run("owner_usage", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				usage := tests.AssertRequest(
+					cl.AccountAPIUsageGetWithResponse(root, nil, memberSession),
+				)(t, http.StatusOK)
+
+				key, ok := findKeyUsage(usage.JSON200.AccessKeys, ak.JSON200.Id)
+				r.True(ok)
+				a.Equal(4, key.Requests)
+				a.Equal(1, key.ClientErrors)
+				a.Equal(0, key.ServerErrors)
+				a.Equal("test-usage", key.AccessKey.Name)
+				a.True(key.AccessKey.Enabled)
+				a.InDelta(0.25, key.ErrorRate, 0.001)
+
+				// The key was created from a browser session which is counted
+				// separately from the key.
+				session, ok := findKeyUsage(usage.JSON200.AccessKeys, "")
+				r.True(ok)
+				a.GreaterOrEqual(session.Requests, 1)
+
+				a.Equal(key.Requests+session.Requests, usage.JSON200.Total.Requests)
+				r.NotEmpty(usage.JSON200.Days)
+			})
+
+			t.Run("owner_usage_unauthenticated", func(t *testing.T) {
+				tests.AssertRequest(
+					cl.AccountAPIUsageGetWithResponse(root, nil),
+				)(t, http.StatusUnauthorized)
+			})
+
+			t.Run("admin_report", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				report := tests.AssertRequest(
+					cl.AdminAPIUsageReportWithResponse(root, &openapi.AdminAPIUsageReportParams{
+						Limit: opt.New(1000).Ptr(),
+					}, adminSession),
+				)(t, http.StatusOK)
+
+				var found *openapi.APIUsageReportEntry
+				for _, e := range report.JSON200.Entries {
+					if e.AccessKey != nil && e.AccessKey.Id == ak.JSON200.Id {
+						found = &e
+					}
+				}
+				r.NotNil(found)
+				a.Equal(member.ID.String(), found.Account.Id)
+				a.Equal(4, found.Requests)
+				a.Equal(1, found.ClientErrors)
+			})
+
+			t.Run("admin_report_forbidden", func(t *testing.T) {
+				tests.AssertRequest(
+					cl.AdminAPIUsageReportWithResponse(root, nil, memberSession),
+				)(t, http.StatusForbidden)
+			})
+		}))
+	}))
+}
+
+// findKeyUsage finds the usage of an access key, or of browser sessions when
+// the ID is empty.
+func findKeyUsage(keys []openapi.APIUsageKeyUsage, id string) (openapi.APIUsageKeyUsage, bool) {
+	for _, k := range keys {
+		if id == "" && k.AccessKey == nil {
+			return k, true
+		}
+		if k.AccessKey != nil && k.AccessKey.Id == id {
+			return k, true
+		}
+	}
+	return openapi.APIUsageKeyUsage{}, false
+}
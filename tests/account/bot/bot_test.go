@@ -0,0 +1,167 @@
+package bot_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func bearer(secret string) openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+secret)
+		return nil
+	}
+}
+
+func TestBots(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			ownerCtx, owner := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			ownerSession := sh.WithSession(ownerCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			otherSession := sh.WithSession(otherCtx)
+
+			keys := tests.AssertRequest(cl.RoleCreateWithResponse(root, openapi.RoleCreateJSONRequestBody{
+				Name:        "bot-owners-" + xid.New().String(),
+				Colour:      "red",
+				Permissions: openapi.PermissionList{openapi.USEPERSONALACCESSKEYS},
+			}, adminSession))(t, http.StatusOK)
+			tests.AssertRequest(cl.AccountAddRoleWithResponse(root, owner.Handle, keys.JSON200.Id, adminSession))(t, http.StatusOK)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        uuid.NewString(),
+				Description: "bots",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+
+			handle := "bot-" + xid.New().String()
+
+			tests.AssertRequest(cl.BotCreateWithResponse(root, openapi.BotInitialProps{Handle: "bot-" + xid.New().String()}, otherSession))(t, http.StatusForbidden)
+
+			created := tests.AssertRequest(cl.BotCreateWithResponse(root, openapi.BotInitialProps{
+				Handle: handle,
+				Name:   opt.New("Announcer").Ptr(),
+			}, ownerSession))(t, http.StatusOK)
+			bot := created.JSON200
+
+			tests.AssertRequest(cl.BotCreateWithResponse(root, openapi.BotInitialProps{Handle: handle}, ownerSession))(t, http.StatusConflict)
+
+			t.Run("owned", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				a.Equal("Announcer", bot.Name)
+				r.NotNil(bot.Owner)
+				a.Equal(owner.Handle, bot.Owner.Handle)
+
+				list := tests.AssertRequest(cl.BotListWithResponse(root, ownerSession))(t, http.StatusOK)
+				a.Contains(dt.Map(list.JSON200.Bots, func(b openapi.Bot) string { return b.Id }), bot.Id)
+
+				others := tests.AssertRequest(cl.BotListWithResponse(root, otherSession))(t, http.StatusOK)
+				a.Empty(others.JSON200.Bots)
+
+				tests.AssertRequest(cl.BotGetWithResponse(root, handle, otherSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.BotGetWithResponse(root, handle, adminSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.BotGetWithResponse(root, owner.Handle, ownerSession))(t, http.StatusNotFound)
+
+				updated := tests.AssertRequest(cl.BotUpdateWithResponse(root, handle, openapi.BotMutableProps{
+					Bio: opt.New("<p>Posts announcements.</p>").Ptr(),
+				}, ownerSession))(t, http.StatusOK)
+				a.Contains(updated.JSON200.Bio, "Posts announcements.")
+
+				prof := tests.AssertRequest(cl.ProfileGetWithResponse(root, handle))(t, http.StatusOK)
+				a.Equal(openapi.AccountKindBot, prof.JSON200.Kind)
+				r.NotNil(prof.JSON200.Owner)
+				a.Equal(owner.Handle, prof.JSON200.Owner.Handle)
+
+				roles := tests.AssertRequest(cl.RoleListWithResponse(root, adminSession))(t, http.StatusOK)
+				a.Contains(dt.Map(roles.JSON200.Roles, func(r openapi.Role) string { return r.Id }), role.DefaultRoleBotID.String())
+				a.Contains(dt.Map(prof.JSON200.Roles, func(r openapi.AccountRole) string { return r.Id }), role.DefaultRoleBotID.String())
+			})
+
+			t.Run("access_keys", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.BotAccessKeyCreateWithResponse(root, handle, openapi.AccessKeyInitialProps{Name: "stolen"}, otherSession))(t, http.StatusForbidden)
+
+				issued := tests.AssertRequest(cl.BotAccessKeyCreateWithResponse(root, handle, openapi.AccessKeyInitialProps{Name: "announcer"}, ownerSession))(t, http.StatusOK)
+				a.Contains(issued.JSON200.Secret, "sdbak_")
+				botSession := bearer(issued.JSON200.Secret)
+
+				acc := tests.AssertRequest(cl.AccountGetWithResponse(root, botSession))(t, http.StatusOK)
+				a.Equal(openapi.AccountKindBot, acc.JSON200.Kind)
+				a.Equal(handle, acc.JSON200.Handle)
+
+				thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>beep boop</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "bot thread " + uuid.NewString(),
+				}, botSession))(t, http.StatusOK)
+				a.Equal(openapi.AccountKindBot, thread.JSON200.Author.Kind)
+
+				got := tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil))(t, http.StatusOK)
+				a.Equal(openapi.AccountKindBot, got.JSON200.Author.Kind)
+
+				// Bots can't manage keys or other bots themselves.
+				tests.AssertRequest(cl.AccessKeyCreateWithResponse(root, openapi.AccessKeyInitialProps{Name: "escalate"}, botSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.BotCreateWithResponse(root, openapi.BotInitialProps{Handle: "bot-" + xid.New().String()}, botSession))(t, http.StatusForbidden)
+
+				// Members keep using personal keys as before.
+				personal := tests.AssertRequest(cl.AccessKeyCreateWithResponse(root, openapi.AccessKeyInitialProps{Name: "personal"}, ownerSession))(t, http.StatusOK)
+				me := tests.AssertRequest(cl.AccountGetWithResponse(root, bearer(personal.JSON200.Secret)))(t, http.StatusOK)
+				a.Equal(openapi.AccountKindHuman, me.JSON200.Kind)
+
+				list := tests.AssertRequest(cl.BotAccessKeyListWithResponse(root, handle, ownerSession))(t, http.StatusOK)
+				r.Len(list.JSON200.Keys, 1)
+				a.Equal(issued.JSON200.Id, list.JSON200.Keys[0].Id)
+
+				tests.AssertRequest(cl.BotAccessKeyDeleteWithResponse(root, handle, issued.JSON200.Id, otherSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.BotAccessKeyDeleteWithResponse(root, handle, issued.JSON200.Id, ownerSession))(t, http.StatusNoContent)
+
+				tests.AssertRequest(cl.AccountGetWithResponse(root, botSession))(t, http.StatusUnauthorized)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				issued := tests.AssertRequest(cl.BotAccessKeyCreateWithResponse(root, handle, openapi.AccessKeyInitialProps{Name: "doomed"}, ownerSession))(t, http.StatusOK)
+				botSession := bearer(issued.JSON200.Secret)
+				tests.AssertRequest(cl.AccountGetWithResponse(root, botSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.BotDeleteWithResponse(root, handle, otherSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.BotDeleteWithResponse(root, handle, ownerSession))(t, http.StatusNoContent)
+
+				tests.AssertRequest(cl.AccountGetWithResponse(root, botSession))(t, http.StatusUnauthorized)
+
+				list := tests.AssertRequest(cl.BotListWithResponse(root, ownerSession))(t, http.StatusOK)
+				assert.NotContains(t, dt.Map(list.JSON200.Bots, func(b openapi.Bot) string { return b.Id }), bot.Id)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,142 @@
+package email_domain_test
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestEmailDomains(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		mail mailer.Sender,
+	) {
+		inbox := mail.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			staff, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+				Name:        "Staff " + xid.New().String(),
+				Permissions: []openapi.Permission{openapi.MANAGEREPORTS},
+			}, adminSession)
+			tests.Ok(t, err, staff)
+
+			set, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+				Applications: &openapi.ApplicationSettings{Required: opt.New(true).Ptr()},
+				EmailDomains: &openapi.EmailDomainSettings{
+					Restricted: opt.New(true).Ptr(),
+					Rules: &[]openapi.EmailDomainRule{
+						{Domain: "Staff.Example.edu", AutoApprove: opt.New(true).Ptr(), Roles: &[]openapi.Identifier{staff.JSON200.Id}},
+						{Domain: "example.edu"},
+					},
+				},
+			}, adminSession)
+			tests.Ok(t, err, set)
+			assert.Equal(t, "staff.example.edu", (*set.JSON200.EmailDomains.Rules)[0].Domain)
+
+			info, err := cl.GetInfoWithResponse(root)
+			tests.Ok(t, err, info)
+			require.NotNil(t, info.JSON200.RegistrationDomains)
+			assert.ElementsMatch(t, []string{"staff.example.edu", "example.edu"}, *info.JSON200.RegistrationDomains)
+
+			signup := func(t *testing.T, address string) (openapi.RequestEditorFn, string) {
+				handle := xid.New().String()
+				res, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: address, Handle: &handle, Password: "password"})
+				tests.Ok(t, err, res)
+
+				id := account.AccountID(utils.Must(xid.FromString(res.JSON200.Id)))
+				code := regexp.MustCompile(`verify your account: ([0-9]{6})`).FindStringSubmatch(inbox.GetLast().Plain)[1]
+
+				return sh.WithSession(e2e.WithAccountID(root, id)), code
+			}
+
+			verify := func(t *testing.T, session openapi.RequestEditorFn, address, code string) {
+				res, err := cl.AuthEmailVerifyWithResponse(root, openapi.AuthEmailVerifyJSONRequestBody{Email: address, Code: code}, session)
+				tests.Ok(t, err, res)
+			}
+
+			t.Run("other_domains_rejected", func(t *testing.T) {
+				handle := xid.New().String()
+				res, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{
+					Email:    xid.New().String() + "@elsewhere.com",
+					Handle:   &handle,
+					Password: "password",
+				})
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				lookalike, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{
+					Email:    xid.New().String() + "@notexample.edu",
+					Handle:   &handle,
+					Password: "password",
+				})
+				tests.Status(t, err, lookalike, http.StatusForbidden)
+
+				profile, err := cl.ProfileGetWithResponse(root, handle)
+				tests.Status(t, err, profile, http.StatusNotFound)
+			})
+
+			t.Run("auto_approve_and_roles", func(t *testing.T) {
+				address := xid.New().String() + "@Staff.example.edu"
+				session, code := signup(t, address)
+
+				app, err := cl.AccountApplicationGetWithResponse(root, session)
+				tests.Ok(t, err, app)
+				assert.Equal(t, openapi.ApplicationStatusPending, app.JSON200.Application.Status)
+
+				verify(t, session, address, code)
+
+				app, err = cl.AccountApplicationGetWithResponse(root, session)
+				tests.Ok(t, err, app)
+				assert.Equal(t, openapi.ApplicationStatusApproved, app.JSON200.Application.Status)
+
+				acc, err := cl.AccountGetWithResponse(root, session)
+				tests.Ok(t, err, acc)
+				assert.True(t, lo.ContainsBy(acc.JSON200.Roles, func(r openapi.AccountRole) bool { return r.Id == staff.JSON200.Id }))
+			})
+
+			t.Run("allowed_without_grants", func(t *testing.T) {
+				address := xid.New().String() + "@example.edu"
+				session, code := signup(t, address)
+
+				verify(t, session, address, code)
+
+				app, err := cl.AccountApplicationGetWithResponse(root, session)
+				tests.Ok(t, err, app)
+				assert.Equal(t, openapi.ApplicationStatusPending, app.JSON200.Application.Status)
+
+				acc, err := cl.AccountGetWithResponse(root, session)
+				tests.Ok(t, err, acc)
+				assert.False(t, lo.ContainsBy(acc.JSON200.Roles, func(r openapi.AccountRole) bool { return r.Id == staff.JSON200.Id }))
+
+				add, err := cl.AccountEmailAddWithResponse(root, openapi.AccountEmailInitialProps{EmailAddress: xid.New().String() + "@elsewhere.com"}, session)
+				tests.Status(t, err, add, http.StatusForbidden)
+			})
+		}))
+	}))
+}
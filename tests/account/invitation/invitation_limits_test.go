@@ -0,0 +1,156 @@
+package invitation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestInvitationLimits(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			inviterRole, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+				Name:        "Inviter " + xid.New().String(),
+				Permissions: []openapi.Permission{openapi.CREATEINVITATION},
+			}, adminSession)
+			tests.Ok(t, err, inviterRole)
+
+			addRole, err := cl.AccountAddRoleWithResponse(root, member.Handle, inviterRole.JSON200.Id, adminSession)
+			tests.Ok(t, err, addRole)
+
+			signup := func(invitationID *string) (*openapi.AuthPasswordSignupResponse, error) {
+				return cl.AuthPasswordSignupWithResponse(root, &openapi.AuthPasswordSignupParams{
+					InvitationId: invitationID,
+				}, openapi.AuthPair{Identifier: "invitee-" + xid.New().String(), Token: "password"})
+			}
+
+			t.Run("max_uses", func(t *testing.T) {
+				a := assert.New(t)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					MaxUses: opt.New(1).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, inv)
+
+				first, err := signup(&inv.JSON200.Id)
+				tests.Ok(t, err, first)
+
+				second, err := signup(&inv.JSON200.Id)
+				tests.Status(t, err, second, http.StatusBadRequest)
+
+				get, err := cl.InvitationGetWithResponse(root, inv.JSON200.Id)
+				tests.Ok(t, err, get)
+				a.Equal(1, get.JSON200.Uses)
+
+				members, err := cl.InvitationMemberListWithResponse(root, inv.JSON200.Id, adminSession)
+				tests.Ok(t, err, members)
+				a.Equal([]string{first.JSON200.Id}, dt.Map(members.JSON200.Members, func(p openapi.ProfileReference) string { return p.Id }))
+
+				hidden, err := cl.InvitationMemberListWithResponse(root, inv.JSON200.Id, memberSession)
+				tests.Status(t, err, hidden, http.StatusForbidden)
+			})
+
+			t.Run("expired", func(t *testing.T) {
+				past, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					ExpiresAt: opt.New(time.Now().Add(-time.Hour)).Ptr(),
+				}, adminSession)
+				tests.Status(t, err, past, http.StatusBadRequest)
+			})
+
+			t.Run("grants_role", func(t *testing.T) {
+				a := assert.New(t)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					RoleId: &inviterRole.JSON200.Id,
+				}, adminSession)
+				tests.Ok(t, err, inv)
+
+				denied, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					RoleId: &inviterRole.JSON200.Id,
+				}, memberSession)
+				tests.Status(t, err, denied, http.StatusForbidden)
+
+				res, err := signup(&inv.JSON200.Id)
+				tests.Ok(t, err, res)
+
+				id := account.AccountID(utils.Must(xid.FromString(res.JSON200.Id)))
+				acc, err := cl.AccountGetWithResponse(root, sh.WithSession(e2e.WithAccountID(root, id)))
+				tests.Ok(t, err, acc)
+				a.Contains(dt.Map(acc.JSON200.Roles, func(r openapi.AccountRole) string { return r.Id }), inviterRole.JSON200.Id)
+			})
+
+			t.Run("quota", func(t *testing.T) {
+				set, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Invitations: &openapi.InvitationSettings{Quota: opt.New(1).Ptr()},
+				}, adminSession)
+				tests.Ok(t, err, set)
+
+				first, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{}, memberSession)
+				tests.Ok(t, err, first)
+
+				second, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{}, memberSession)
+				tests.Status(t, err, second, http.StatusForbidden)
+
+				admin, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{}, adminSession)
+				tests.Ok(t, err, admin)
+			})
+
+			t.Run("required", func(t *testing.T) {
+				a := assert.New(t)
+
+				set, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Invitations: &openapi.InvitationSettings{Required: opt.New(true).Ptr()},
+				}, adminSession)
+				tests.Ok(t, err, set)
+				defer func() {
+					reset, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+						Invitations: &openapi.InvitationSettings{Required: opt.New(false).Ptr()},
+					}, adminSession)
+					tests.Ok(t, err, reset)
+				}()
+
+				info, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, info)
+				a.True(info.JSON200.InvitationRequired)
+
+				uninvited, err := signup(nil)
+				tests.Status(t, err, uninvited, http.StatusForbidden)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{}, adminSession)
+				tests.Ok(t, err, inv)
+
+				invited, err := signup(&inv.JSON200.Id)
+				tests.Ok(t, err, invited)
+			})
+		}))
+	}))
+}
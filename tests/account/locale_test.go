@@ -0,0 +1,128 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAccountLocale(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			acceptLanguage := func(lang string) openapi.RequestEditorFn {
+				return func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("Accept-Language", lang)
+					return nil
+				}
+			}
+
+			invalidHandle := "Not A Handle!"
+			english := "Handle must be lowercase letters, numbers, hyphens, and underscores only"
+			german := "Der Benutzername darf nur Kleinbuchstaben, Zahlen, Bindestriche und Unterstriche enthalten"
+
+			errorMessage := func(t *testing.T, editors ...openapi.RequestEditorFn) string {
+				resp, err := cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{Handle: &invalidHandle}, editors...)
+				tests.Status(t, err, resp, http.StatusBadRequest)
+				require.NotNil(t, resp.JSONDefault)
+				require.NotNil(t, resp.JSONDefault.Message)
+				return *resp.JSONDefault.Message
+			}
+
+			setLocale := func(t *testing.T, locale string) *openapi.AccountUpdateResponse {
+				resp, err := cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{Locale: &locale}, memberSession)
+				require.NoError(t, err)
+				return resp
+			}
+
+			t.Run("info", func(t *testing.T) {
+				info, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, info)
+				a.Equal("en", info.JSON200.DefaultLocale)
+				a.Contains(info.JSON200.Locales, "en")
+				a.Contains(info.JSON200.Locales, "de")
+			})
+
+			t.Run("default_english", func(t *testing.T) {
+				a.Equal(english, errorMessage(t, memberSession))
+			})
+
+			t.Run("accept_language", func(t *testing.T) {
+				a.Equal(german, errorMessage(t, memberSession, acceptLanguage("de-DE,de;q=0.9,en;q=0.8")))
+				a.Equal(english, errorMessage(t, memberSession, acceptLanguage("fr-FR")))
+			})
+
+			t.Run("account_locale", func(t *testing.T) {
+				resp := setLocale(t, "de-AT")
+				tests.Ok(t, nil, resp)
+				require.NotNil(t, resp.JSON200.Locale)
+				a.Equal("de-AT", *resp.JSON200.Locale)
+
+				a.Equal(german, errorMessage(t, memberSession))
+				a.Equal(german, errorMessage(t, memberSession, acceptLanguage("en")), "the account's choice comes before the browser's")
+
+				resp = setLocale(t, "")
+				tests.Ok(t, nil, resp)
+				a.Nil(resp.JSON200.Locale)
+
+				a.Equal(english, errorMessage(t, memberSession))
+			})
+
+			t.Run("unsupported_locale", func(t *testing.T) {
+				resp := setLocale(t, "fr")
+				tests.Status(t, nil, resp, http.StatusBadRequest)
+
+				resp = setLocale(t, "not a language")
+				tests.Status(t, nil, resp, http.StatusBadRequest)
+			})
+
+			t.Run("instance_default", func(t *testing.T) {
+				unsupported := "xx"
+				bad, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{DefaultLocale: &unsupported}, adminSession)
+				tests.Status(t, err, bad, http.StatusBadRequest)
+
+				de := "de"
+				upd, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{DefaultLocale: &de}, adminSession)
+				tests.Ok(t, err, upd)
+				require.NotNil(t, upd.JSON200.DefaultLocale)
+				a.Equal("de", *upd.JSON200.DefaultLocale)
+
+				info, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, info)
+				a.Equal("de", info.JSON200.DefaultLocale)
+
+				a.Equal(german, errorMessage(t, memberSession))
+				a.Equal(english, errorMessage(t, memberSession, acceptLanguage("en-GB")), "the browser's choice comes before the default")
+
+				en := "en"
+				upd, err = cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{DefaultLocale: &en}, adminSession)
+				tests.Ok(t, err, upd)
+			})
+		}))
+	}))
+}
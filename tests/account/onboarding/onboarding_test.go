@@ -0,0 +1,151 @@
+package onboarding_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestOnboardingChecklist(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		mail mailer.Sender,
+	) {
+		inbox := mail.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			// Subscribers start after this hook so the checklist is driven from
+			// a parallel test which runs once the application has started.
+			t.Run("checklist", func(t *testing.T) {
+				t.Parallel()
+
+				adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+				adminSession := sh.WithSession(adminCtx)
+
+				cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+				tests.Ok(t, err, cat)
+
+				address := xid.New().String() + "@storyden.org"
+				handle := xid.New().String()
+				signup, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: address, Handle: &handle, Password: "password"})
+				tests.Ok(t, err, signup)
+				code := regexp.MustCompile(`verify your account: ([0-9]{6})`).FindStringSubmatch(inbox.GetLast().Plain)[1]
+
+				session := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(signup.JSON200.Id)))))
+
+				completed := func(t *testing.T) map[openapi.OnboardingStep]bool {
+					res, err := cl.AccountOnboardingGetWithResponse(root, session)
+					tests.Ok(t, err, res)
+
+					out := map[openapi.OnboardingStep]bool{}
+					for _, i := range res.JSON200.Items {
+						out[i.Step] = i.CompletedAt != nil
+					}
+					return out
+				}
+
+				waitFor := func(t *testing.T, step openapi.OnboardingStep) {
+					require.Eventually(t, func() bool { return completed(t)[step] }, 5*time.Second, 100*time.Millisecond)
+				}
+
+				t.Run("fresh", func(t *testing.T) {
+					res, err := cl.AccountOnboardingGetWithResponse(root, session)
+					tests.Ok(t, err, res)
+					assert.False(t, res.JSON200.Complete)
+					assert.Equal(t, map[openapi.OnboardingStep]bool{
+						openapi.VerifyEmail:  false,
+						openapi.SetAvatar:    false,
+						openapi.FirstPost:    false,
+						openapi.JoinCategory: false,
+					}, completed(t))
+				})
+
+				t.Run("steps_complete", func(t *testing.T) {
+					verify, err := cl.AuthEmailVerifyWithResponse(root, openapi.AuthEmailVerifyJSONRequestBody{Email: address, Code: code}, session)
+					tests.Ok(t, err, verify)
+					waitFor(t, openapi.VerifyEmail)
+
+					avatar := []byte("\x89PNG\r\n\x1a\n")
+					set, err := cl.AccountSetAvatarWithBodyWithResponse(root, &openapi.AccountSetAvatarParams{ContentLength: int64(len(avatar))}, "application/octet-stream", bytes.NewReader(avatar), session)
+					tests.Ok(t, err, set)
+					waitFor(t, openapi.SetAvatar)
+
+					follow, err := cl.CategoryFollowersAddWithResponse(root, cat.JSON200.Slug, session)
+					tests.Ok(t, err, follow)
+					waitFor(t, openapi.JoinCategory)
+
+					thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+						Body:       opt.New("<p>hello</p>").Ptr(),
+						Category:   opt.New(cat.JSON200.Id).Ptr(),
+						Visibility: opt.New(openapi.Published).Ptr(),
+						Title:      "hello",
+					}, session)
+					tests.Ok(t, err, thread)
+					waitFor(t, openapi.FirstPost)
+
+					res, err := cl.AccountOnboardingGetWithResponse(root, session)
+					tests.Ok(t, err, res)
+					assert.True(t, res.JSON200.Complete)
+				})
+
+				t.Run("configured_steps", func(t *testing.T) {
+					set, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+						OnboardingChecklist: &openapi.OnboardingChecklistSettings{Steps: &[]openapi.OnboardingStep{openapi.FirstPost, openapi.VerifyEmail}},
+					}, adminSession)
+					tests.Ok(t, err, set)
+
+					res, err := cl.AccountOnboardingGetWithResponse(root, session)
+					tests.Ok(t, err, res)
+					require.Len(t, res.JSON200.Items, 2)
+					assert.Equal(t, openapi.FirstPost, res.JSON200.Items[0].Step)
+
+					invalid, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+						OnboardingChecklist: &openapi.OnboardingChecklistSettings{Steps: &[]openapi.OnboardingStep{"nonsense"}},
+					}, adminSession)
+					tests.Status(t, err, invalid, http.StatusBadRequest)
+				})
+
+				t.Run("funnel", func(t *testing.T) {
+					memberSession := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(signup.JSON200.Id)))))
+					denied, err := cl.AdminOnboardingFunnelWithResponse(root, memberSession)
+					tests.Status(t, err, denied, http.StatusForbidden)
+
+					res, err := cl.AdminOnboardingFunnelWithResponse(root, adminSession)
+					tests.Ok(t, err, res)
+					assert.GreaterOrEqual(t, res.JSON200.Accounts, 2)
+					require.Len(t, res.JSON200.Steps, 2)
+					for _, s := range res.JSON200.Steps {
+						assert.GreaterOrEqual(t, s.Completed, 1)
+					}
+					assert.GreaterOrEqual(t, res.JSON200.Completed, 1)
+				})
+			})
+		}))
+	}))
+}
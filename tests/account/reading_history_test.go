@@ -0,0 +1,167 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestReadingHistory(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			authorCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			authorSession := sh.WithSession(authorCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			beacon := func(kind openapi.DatagraphItemKind, id openapi.Identifier, position string) {
+				body := fmt.Sprintf(`{"k":%q,"id":%q,"p":%q}`, kind, id, position)
+				tests.AssertRequest(
+					cl.SendBeaconWithBodyWithResponse(root, "text/plain", strings.NewReader(body), memberSession),
+				)(t, http.StatusAccepted)
+			}
+
+			history := func(kind *openapi.DatagraphItemKind) openapi.ReadingHistoryList {
+				return tests.AssertRequest(cl.AccountReadingHistoryListWithResponse(root, &openapi.AccountReadingHistoryListParams{Kind: kind}, memberSession))(t, http.StatusOK).JSON200.History
+			}
+
+			itemIDs := func(in openapi.ReadingHistoryList) []string {
+				return dt.Map(in, func(e openapi.ReadingHistoryEntry) string { return e.ItemId })
+			}
+
+			positionOf := func(id string) string {
+				for _, e := range history(nil) {
+					if e.ItemId == id && e.Position != nil {
+						return *e.Position
+					}
+				}
+				return ""
+			}
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        uuid.NewString(),
+				Description: "reading",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+
+			create := func(vis openapi.Visibility) *openapi.ThreadCreateOK {
+				return tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>a long read</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(vis).Ptr(),
+					Title:      "reading history " + uuid.NewString(),
+				}, authorSession))(t, http.StatusOK).JSON200
+			}
+
+			thread := create(openapi.Published)
+			draft := create(openapi.Draft)
+
+			node := tests.AssertRequest(cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+				Name:       "reading history " + uuid.NewString(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, adminSession))(t, http.StatusOK).JSON200
+
+			t.Run("record", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				beacon(openapi.DatagraphItemKindThread, draft.Id, "")
+				beacon(openapi.DatagraphItemKindThread, thread.Id, "reply-1")
+				r.Eventually(func() bool { return positionOf(thread.Id) == "reply-1" }, 10*time.Second, 100*time.Millisecond)
+
+				// Opening the thread again without a position keeps the place.
+				beacon(openapi.DatagraphItemKindThread, thread.Id, "")
+				beacon(openapi.DatagraphItemKindNode, node.Id, "heading-2")
+				r.Eventually(func() bool { return len(history(nil)) == 2 }, 10*time.Second, 100*time.Millisecond)
+
+				list := history(nil)
+				a.Equal([]string{node.Id, thread.Id}, itemIDs(list))
+				a.Equal("reply-1", *list[1].Position)
+				r.NotNil(list[1].Item)
+
+				nodes := history(opt.New(openapi.DatagraphItemKindNode).Ptr())
+				a.Equal([]string{node.Id}, itemIDs(nodes))
+
+				entry := tests.AssertRequest(cl.AccountReadingHistoryGetWithResponse(root, thread.Id, memberSession))(t, http.StatusOK)
+				a.Equal("reply-1", *entry.JSON200.Position)
+
+				tests.AssertRequest(cl.AccountReadingHistoryGetWithResponse(root, draft.Id, memberSession))(t, http.StatusNotFound)
+				tests.AssertRequest(cl.AccountReadingHistoryGetWithResponse(root, thread.Id, authorSession))(t, http.StatusNotFound)
+				tests.AssertRequest(cl.AccountReadingHistoryListWithResponse(root, nil))(t, http.StatusUnauthorized)
+			})
+
+			t.Run("remove_and_clear", func(t *testing.T) {
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.AccountReadingHistoryRemoveWithResponse(root, thread.Id, memberSession))(t, http.StatusNoContent)
+				tests.AssertRequest(cl.AccountReadingHistoryRemoveWithResponse(root, thread.Id, memberSession))(t, http.StatusNoContent)
+				a.Equal([]string{node.Id}, itemIDs(history(nil)))
+
+				tests.AssertRequest(cl.AccountReadingHistoryClearWithResponse(root, memberSession))(t, http.StatusNoContent)
+				a.Empty(history(nil))
+			})
+
+			t.Run("opt_out", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				beacon(openapi.DatagraphItemKindThread, thread.Id, "reply-2")
+				r.Eventually(func() bool { return positionOf(thread.Id) == "reply-2" }, 10*time.Second, 100*time.Millisecond)
+
+				acc := tests.AssertRequest(cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Privacy: &openapi.AccountPrivacy{ReadingHistory: opt.New(false).Ptr()},
+				}, memberSession))(t, http.StatusOK)
+				r.NotNil(acc.JSON200.Privacy)
+				a.False(*acc.JSON200.Privacy.ReadingHistory)
+
+				// Opting out forgets what was already recorded...
+				a.Empty(history(nil))
+
+				// ...and stops anything new being recorded.
+				beacon(openapi.DatagraphItemKindThread, thread.Id, "reply-3")
+				a.Never(func() bool { return len(history(nil)) > 0 }, time.Second, 100*time.Millisecond)
+
+				// Other account changes leave the preference alone.
+				acc = tests.AssertRequest(cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Name: opt.New("Baldur").Ptr(),
+				}, memberSession))(t, http.StatusOK)
+				a.False(*acc.JSON200.Privacy.ReadingHistory)
+
+				acc = tests.AssertRequest(cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Privacy: &openapi.AccountPrivacy{ReadingHistory: opt.New(true).Ptr()},
+				}, memberSession))(t, http.StatusOK)
+				a.True(*acc.JSON200.Privacy.ReadingHistory)
+
+				beacon(openapi.DatagraphItemKindThread, thread.Id, "reply-4")
+				r.Eventually(func() bool { return positionOf(thread.Id) == "reply-4" }, 10*time.Second, 100*time.Millisecond)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,129 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAccountTimezone(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			hints := func(lang, tz string) openapi.RequestEditorFn {
+				return func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("Accept-Language", lang)
+					req.Header.Set("X-Storyden-Timezone", tz)
+					return nil
+				}
+			}
+
+			setTimezone := func(t *testing.T, tz string) *openapi.AccountUpdateResponse {
+				resp, err := cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{Timezone: &tz}, memberSession)
+				require.NoError(t, err)
+				return resp
+			}
+
+			t.Run("info", func(t *testing.T) {
+				info, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, info)
+				a.Equal("UTC", info.JSON200.DefaultTimezone)
+			})
+
+			t.Run("account_timezone", func(t *testing.T) {
+				resp := setTimezone(t, "America/New_York")
+				tests.Ok(t, nil, resp)
+				require.NotNil(t, resp.JSON200.Timezone)
+				a.Equal("America/New_York", *resp.JSON200.Timezone)
+
+				resp = setTimezone(t, "")
+				tests.Ok(t, nil, resp)
+				a.Nil(resp.JSON200.Timezone)
+			})
+
+			t.Run("invalid_timezone", func(t *testing.T) {
+				tests.Status(t, nil, setTimezone(t, "Mars/Olympus_Mons"), http.StatusBadRequest)
+				tests.Status(t, nil, setTimezone(t, "Local"), http.StatusBadRequest)
+			})
+
+			t.Run("instance_default", func(t *testing.T) {
+				invalid := "Nowhere"
+				bad, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{DefaultTimezone: &invalid}, adminSession)
+				tests.Status(t, err, bad, http.StatusBadRequest)
+
+				tz := "Europe/London"
+				upd, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{DefaultTimezone: &tz}, adminSession)
+				tests.Ok(t, err, upd)
+				require.NotNil(t, upd.JSON200.DefaultTimezone)
+				a.Equal("Europe/London", *upd.JSON200.DefaultTimezone)
+
+				info, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, info)
+				a.Equal("Europe/London", info.JSON200.DefaultTimezone)
+			})
+
+			t.Run("hints_on_sign_in", func(t *testing.T) {
+				handle := xid.New().String()
+
+				signup, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: handle, Token: "password"}, hints("de-DE,de;q=0.9", "Europe/Berlin"))
+				tests.Ok(t, err, signup)
+				session := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(signup.JSON200.Id)))))
+
+				acc, err := cl.AccountGetWithResponse(root, session)
+				tests.Ok(t, err, acc)
+				require.NotNil(t, acc.JSON200.Timezone)
+				require.NotNil(t, acc.JSON200.Locale)
+				a.Equal("Europe/Berlin", *acc.JSON200.Timezone)
+				a.Equal("de", *acc.JSON200.Locale)
+
+				// Hints never replace a choice already made.
+				signin, err := cl.AuthPasswordSigninWithResponse(root, openapi.AuthPair{Identifier: handle, Token: "password"}, hints("en", "Asia/Tokyo"))
+				tests.Ok(t, err, signin)
+
+				acc, err = cl.AccountGetWithResponse(root, session)
+				tests.Ok(t, err, acc)
+				a.Equal("Europe/Berlin", *acc.JSON200.Timezone)
+				a.Equal("de", *acc.JSON200.Locale)
+			})
+
+			t.Run("invalid_hints_ignored", func(t *testing.T) {
+				signup, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: xid.New().String(), Token: "password"}, hints("fr", "Local"))
+				tests.Ok(t, err, signup)
+				session := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(signup.JSON200.Id)))))
+
+				acc, err := cl.AccountGetWithResponse(root, session)
+				tests.Ok(t, err, acc)
+				a.Nil(acc.JSON200.Timezone)
+				a.Nil(acc.JSON200.Locale)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,176 @@
+package verification_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestVerification(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+			otherCtx, other := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			otherSession := sh.WithSession(otherCtx)
+
+			pending := func(t *testing.T) []string {
+				list := tests.AssertRequest(cl.AdminVerificationRequestListWithResponse(root, &openapi.AdminVerificationRequestListParams{
+					Status: opt.New(openapi.VerificationRequestStatusPending).Ptr(),
+				}, adminSession))(t, http.StatusOK)
+				return dt.Map(list.JSON200.Requests, func(r openapi.VerificationRequest) string { return r.Id })
+			}
+
+			t.Run("request_and_approve", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.AccountVerificationRequestGetWithResponse(root, memberSession))(t, http.StatusNotFound)
+
+				tests.AssertRequest(cl.AccountVerificationRequestCreateWithResponse(root, openapi.VerificationRequestInitialProps{
+					Badge: openapi.VerificationBadgeStaff,
+				}, memberSession))(t, http.StatusBadRequest)
+
+				req := tests.AssertRequest(cl.AccountVerificationRequestCreateWithResponse(root, openapi.VerificationRequestInitialProps{
+					Badge: openapi.VerificationBadgeVerified,
+					Note:  opt.New("it's really me").Ptr(),
+				}, memberSession))(t, http.StatusOK)
+				a.Equal(openapi.VerificationRequestStatusPending, req.JSON200.Status)
+				a.Equal(member.Handle, req.JSON200.Account.Handle)
+				r.NotNil(req.JSON200.Note)
+				a.Equal("it's really me", *req.JSON200.Note)
+
+				tests.AssertRequest(cl.AccountVerificationRequestCreateWithResponse(root, openapi.VerificationRequestInitialProps{
+					Badge: openapi.VerificationBadgeOrganization,
+				}, memberSession))(t, http.StatusConflict)
+
+				tests.AssertRequest(cl.AdminVerificationRequestListWithResponse(root, nil, memberSession))(t, http.StatusForbidden)
+				a.Contains(pending(t), req.JSON200.Id)
+
+				approved := tests.AssertRequest(cl.AdminVerificationRequestApproveWithResponse(root, req.JSON200.Id, adminSession))(t, http.StatusOK)
+				a.Equal(openapi.VerificationRequestStatusApproved, approved.JSON200.Status)
+				a.NotNil(approved.JSON200.ReviewedAt)
+				a.NotContains(pending(t), req.JSON200.Id)
+
+				tests.AssertRequest(cl.AdminVerificationRequestApproveWithResponse(root, req.JSON200.Id, adminSession))(t, http.StatusBadRequest)
+
+				own := tests.AssertRequest(cl.AccountVerificationRequestGetWithResponse(root, memberSession))(t, http.StatusOK)
+				a.Equal(openapi.VerificationRequestStatusApproved, own.JSON200.Status)
+
+				acc := tests.AssertRequest(cl.AccountGetWithResponse(root, memberSession))(t, http.StatusOK)
+				r.NotNil(acc.JSON200.Verification)
+				a.Equal(openapi.VerificationBadgeVerified, *acc.JSON200.Verification)
+
+				prof := tests.AssertRequest(cl.ProfileGetWithResponse(root, member.Handle))(t, http.StatusOK)
+				r.NotNil(prof.JSON200.Verification)
+				a.Equal(openapi.VerificationBadgeVerified, *prof.JSON200.Verification)
+
+				tests.AssertRequest(cl.AccountVerificationRequestCreateWithResponse(root, openapi.VerificationRequestInitialProps{
+					Badge: openapi.VerificationBadgeVerified,
+				}, memberSession))(t, http.StatusBadRequest)
+			})
+
+			t.Run("author_references", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+					Name:        uuid.NewString(),
+					Description: "verification",
+					Colour:      "#000",
+				}, adminSession))(t, http.StatusOK)
+
+				thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>verified post</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "verification test " + uuid.NewString(),
+				}, memberSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>unverified reply</p>"}, otherSession))(t, http.StatusOK)
+
+				got := tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil))(t, http.StatusOK)
+				r.NotNil(got.JSON200.Author.Verification)
+				a.Equal(openapi.VerificationBadgeVerified, *got.JSON200.Author.Verification)
+				r.Len(got.JSON200.Replies.Replies, 1)
+				a.Nil(got.JSON200.Replies.Replies[0].Author.Verification)
+			})
+
+			t.Run("reject", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				req := tests.AssertRequest(cl.AccountVerificationRequestCreateWithResponse(root, openapi.VerificationRequestInitialProps{
+					Badge: openapi.VerificationBadgeOrganization,
+				}, otherSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.AdminVerificationRequestRejectWithResponse(root, req.JSON200.Id, openapi.VerificationRequestRejectProps{}, otherSession))(t, http.StatusForbidden)
+
+				rejected := tests.AssertRequest(cl.AdminVerificationRequestRejectWithResponse(root, req.JSON200.Id, openapi.VerificationRequestRejectProps{
+					Reason: opt.New("not an organization").Ptr(),
+				}, adminSession))(t, http.StatusOK)
+				a.Equal(openapi.VerificationRequestStatusRejected, rejected.JSON200.Status)
+				r.NotNil(rejected.JSON200.Reason)
+				a.Equal("not an organization", *rejected.JSON200.Reason)
+
+				own := tests.AssertRequest(cl.AccountVerificationRequestGetWithResponse(root, otherSession))(t, http.StatusOK)
+				a.Equal(openapi.VerificationRequestStatusRejected, own.JSON200.Status)
+
+				prof := tests.AssertRequest(cl.ProfileGetWithResponse(root, other.Handle))(t, http.StatusOK)
+				a.Nil(prof.JSON200.Verification)
+
+				// Members may ask again once their request has been reviewed.
+				tests.AssertRequest(cl.AccountVerificationRequestCreateWithResponse(root, openapi.VerificationRequestInitialProps{
+					Badge: openapi.VerificationBadgeVerified,
+				}, otherSession))(t, http.StatusOK)
+			})
+
+			t.Run("set_and_remove", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.AccountVerificationSetWithResponse(root, other.Handle, openapi.AccountVerificationSetProps{Badge: openapi.VerificationBadgeBot}, memberSession))(t, http.StatusForbidden)
+
+				acc := tests.AssertRequest(cl.AccountVerificationSetWithResponse(root, other.Handle, openapi.AccountVerificationSetProps{Badge: openapi.VerificationBadgeBot}, adminSession))(t, http.StatusOK)
+				r.NotNil(acc.JSON200.Verification)
+				a.Equal(openapi.VerificationBadgeBot, *acc.JSON200.Verification)
+
+				prof := tests.AssertRequest(cl.ProfileGetWithResponse(root, other.Handle))(t, http.StatusOK)
+				r.NotNil(prof.JSON200.Verification)
+				a.Equal(openapi.VerificationBadgeBot, *prof.JSON200.Verification)
+
+				removed := tests.AssertRequest(cl.AccountVerificationRemoveWithResponse(root, other.Handle, adminSession))(t, http.StatusOK)
+				a.Nil(removed.JSON200.Verification)
+
+				prof = tests.AssertRequest(cl.ProfileGetWithResponse(root, other.Handle))(t, http.StatusOK)
+				a.Nil(prof.JSON200.Verification)
+
+				tests.AssertRequest(cl.AccountVerificationRemoveWithResponse(root, "nobody-"+uuid.NewString(), adminSession))(t, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,138 @@
+package admin_events_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type message struct {
+	event string
+	data  struct {
+		Event     openapi.AdminEventType `json:"event"`
+		Timestamp time.Time              `json:"timestamp"`
+		Data      map[string]any         `json:"data"`
+	}
+}
+
+// readEvents parses the stream's messages, skipping keep-alive comments.
+func readEvents(t *testing.T, res *http.Response) <-chan message {
+	out := make(chan message, 16)
+
+	go func() {
+		defer close(out)
+
+		sc := bufio.NewScanner(res.Body)
+		var m message
+		for sc.Scan() {
+			line := sc.Text()
+			switch {
+			case strings.HasPrefix(line, "event: "):
+				m.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &m.data); err != nil {
+					t.Error(err)
+					return
+				}
+			case line == "" && m.event != "":
+				out <- m
+				m = message{}
+			}
+		}
+	}()
+
+	return out
+}
+
+func TestAdminEventStream(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				res, err := cl.AdminEventStreamWithResponse(root, nil)
+				tests.Status(t, err, res, http.StatusUnauthorized)
+			})
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminEventStreamWithResponse(root, nil, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("report_filed", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				ctx, cancel := context.WithTimeout(root, 30*time.Second)
+				defer cancel()
+
+				res, err := cl.AdminEventStream(ctx, &openapi.AdminEventStreamParams{
+					Event: &openapi.AdminEventTypeQuery{openapi.AdminEventTypeReportFiled},
+				}, adminSession)
+				r.NoError(err)
+				defer res.Body.Close()
+				r.Equal(http.StatusOK, res.StatusCode)
+				a.Equal("text/event-stream", res.Header.Get("Content-Type"))
+
+				events := readEvents(t, res)
+
+				// Filtered out, the stream only asked for reports.
+				signup, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: "ev" + uuid.NewString()[:8], Token: "password"})
+				tests.Ok(t, err, signup)
+
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>reported</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "reported " + uuid.NewString(),
+				}, adminSession)
+				tests.Ok(t, err, thread)
+
+				report, err := cl.ReportCreateWithResponse(root, openapi.ReportInitialProps{
+					TargetId:   thread.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindPost,
+				}, memberSession)
+				tests.Ok(t, err, report)
+
+				select {
+				case m, ok := <-events:
+					r.True(ok, "stream closed before the event arrived")
+					a.Equal("report.filed", m.event)
+					a.Equal(openapi.AdminEventTypeReportFiled, m.data.Event)
+					a.Equal(report.JSON200.Id, m.data.Data["report_id"])
+					a.Equal(member.ID.String(), m.data.Data["reported_by"])
+					a.Equal(thread.JSON200.Id, m.data.Data["target_id"])
+				case <-ctx.Done():
+					t.Fatal("timed out waiting for the report event")
+				}
+			})
+		}))
+	}))
+}
@@ -0,0 +1,154 @@
+package analytics_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/analytics/analytics_rollup"
+	"github.com/Southclaws/storyden/app/services/api_usage"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAnalytics(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		recorder *api_usage.Recorder,
+		rollup *analytics_rollup.Rollup,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat := tests.AssertRequest(
+				cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession),
+			)(t, http.StatusOK)
+
+			thread := tests.AssertRequest(
+				cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>analytics</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Analytics test thread",
+				}, memberSession),
+			)(t, http.StatusOK)
+
+			for range 2 {
+				tests.AssertRequest(
+					cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>reply</p>"}, adminSession),
+				)(t, http.StatusOK)
+			}
+
+			// Requests are only counted towards active members once flushed.
+			require.NoError(t, recorder.Flush(root))
+			require.NoError(t, rollup.Run(root, time.Now()))
+
+			today := analytics.StartOfDay(time.Now())
+
+			t.Run("days", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res := tests.AssertRequest(
+					cl.AdminAnalyticsGetWithResponse(root, &openapi.AdminAnalyticsGetParams{Days: opt.New(7).Ptr()}, adminSession),
+				)(t, http.StatusOK)
+
+				r.NotEmpty(res.JSON200.Days)
+				last := res.JSON200.Days[len(res.JSON200.Days)-1]
+				a.True(today.Equal(last.Date))
+				a.GreaterOrEqual(last.Signups, 2)
+				a.GreaterOrEqual(last.DailyActive, 2)
+				a.GreaterOrEqual(last.WeeklyActive, last.DailyActive)
+				a.GreaterOrEqual(last.MonthlyActive, last.WeeklyActive)
+				a.GreaterOrEqual(last.Threads, 1)
+				a.GreaterOrEqual(last.Replies, 2)
+			})
+
+			t.Run("rerun_replaces_days", func(t *testing.T) {
+				r := require.New(t)
+
+				before := tests.AssertRequest(
+					cl.AdminAnalyticsGetWithResponse(root, nil, adminSession),
+				)(t, http.StatusOK)
+
+				r.NoError(rollup.Run(root, time.Now()))
+
+				after := tests.AssertRequest(
+					cl.AdminAnalyticsGetWithResponse(root, nil, adminSession),
+				)(t, http.StatusOK)
+
+				r.Len(after.JSON200.Days, len(before.JSON200.Days))
+			})
+
+			t.Run("top_content", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res := tests.AssertRequest(
+					cl.AdminAnalyticsTopContentWithResponse(root, &openapi.AdminAnalyticsTopContentParams{Limit: opt.New(100).Ptr()}, adminSession),
+				)(t, http.StatusOK)
+
+				var found *openapi.AnalyticsContentItem
+				for _, item := range res.JSON200.Items {
+					th, err := item.Item.AsDatagraphItemThread()
+					if err == nil && th.Ref.Id == thread.JSON200.Id {
+						found = &item
+					}
+				}
+				r.NotNil(found)
+				a.Equal(2, found.Replies)
+			})
+
+			t.Run("cohorts", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res := tests.AssertRequest(
+					cl.AdminAnalyticsCohortsWithResponse(root, nil, adminSession),
+				)(t, http.StatusOK)
+
+				r.NotEmpty(res.JSON200.Cohorts)
+				latest := res.JSON200.Cohorts[len(res.JSON200.Cohorts)-1]
+				a.True(analytics.StartOfWeek(time.Now()).Equal(latest.Cohort))
+				a.GreaterOrEqual(latest.Size, 2)
+				r.NotEmpty(latest.Weeks)
+				a.Equal(0, latest.Weeks[0].Week)
+				a.GreaterOrEqual(latest.Weeks[0].Retained, 2)
+			})
+
+			t.Run("forbidden", func(t *testing.T) {
+				tests.AssertRequest(
+					cl.AdminAnalyticsGetWithResponse(root, nil, memberSession),
+				)(t, http.StatusForbidden)
+				tests.AssertRequest(
+					cl.AdminAnalyticsTopContentWithResponse(root, nil, memberSession),
+				)(t, http.StatusForbidden)
+				tests.AssertRequest(
+					cl.AdminAnalyticsCohortsWithResponse(root, nil, memberSession),
+				)(t, http.StatusForbidden)
+			})
+		}))
+	}))
+}
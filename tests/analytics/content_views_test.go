@@ -0,0 +1,185 @@
+package analytics_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/view_counter"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestContentViews(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		counter *view_counter.Counter,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			authorCtx, author := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			authorSession := sh.WithSession(authorCtx)
+
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			otherSession := sh.WithSession(otherCtx)
+
+			userAgent := func(ua string) openapi.RequestEditorFn {
+				return func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("User-Agent", ua)
+					return nil
+				}
+			}
+
+			beacon := func(kind openapi.DatagraphItemKind, id openapi.Identifier, editors ...openapi.RequestEditorFn) {
+				body := fmt.Sprintf(`{"k":%q,"id":%q}`, kind, id)
+				tests.AssertRequest(
+					cl.SendBeaconWithBodyWithResponse(root, "text/plain", strings.NewReader(body), editors...),
+				)(t, http.StatusAccepted)
+			}
+
+			cat := tests.AssertRequest(
+				cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession),
+			)(t, http.StatusOK)
+
+			thread := tests.AssertRequest(
+				cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>views</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Content views test thread",
+				}, authorSession),
+			)(t, http.StatusOK)
+
+			published := openapi.Published
+			node := tests.AssertRequest(
+				cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{Name: uuid.NewString(), Visibility: &published}, adminSession),
+			)(t, http.StatusOK)
+
+			draft := tests.AssertRequest(
+				cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>draft</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+					Title:      "Content views test draft",
+				}, authorSession),
+			)(t, http.StatusOK)
+
+			// Two members, one of them twice, and two guests on different
+			// browsers. The crawler and the draft aren't counted.
+			beacon(openapi.DatagraphItemKindThread, thread.JSON200.Id, authorSession)
+			beacon(openapi.DatagraphItemKindThread, thread.JSON200.Id, authorSession)
+			beacon(openapi.DatagraphItemKindThread, thread.JSON200.Id, otherSession)
+			beacon(openapi.DatagraphItemKindThread, thread.JSON200.Id, userAgent("Firefox/140.0"))
+			beacon(openapi.DatagraphItemKindThread, thread.JSON200.Id, userAgent("Safari/605.1.15"))
+			beacon(openapi.DatagraphItemKindThread, thread.JSON200.Id, userAgent("Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"))
+			beacon(openapi.DatagraphItemKindThread, draft.JSON200.Id, authorSession)
+			beacon(openapi.DatagraphItemKindNode, node.JSON200.Id, otherSession)
+
+			require.NoError(t, counter.Flush(root))
+
+			t.Run("thread", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res := tests.AssertRequest(
+					cl.ThreadViewsGetWithResponse(root, thread.JSON200.Slug, nil, authorSession),
+				)(t, http.StatusOK)
+
+				a.Equal(openapi.ContentViewCounts{Views: 5, UniqueViewers: 4}, res.JSON200.Total)
+				r.Len(res.JSON200.Days, 1)
+				r.Len(res.JSON200.Hours, 1)
+				a.Equal(5, res.JSON200.Hours[0].Views)
+				a.Equal(4, res.JSON200.Hours[0].UniqueViewers)
+			})
+
+			t.Run("adds_to_existing_counts", func(t *testing.T) {
+				a := assert.New(t)
+
+				beacon(openapi.DatagraphItemKindThread, thread.JSON200.Id, adminSession)
+				require.NoError(t, counter.Flush(root))
+
+				res := tests.AssertRequest(
+					cl.ThreadViewsGetWithResponse(root, thread.JSON200.Slug, nil, authorSession),
+				)(t, http.StatusOK)
+
+				a.Equal(openapi.ContentViewCounts{Views: 6, UniqueViewers: 5}, res.JSON200.Total)
+			})
+
+			t.Run("node", func(t *testing.T) {
+				a := assert.New(t)
+
+				res := tests.AssertRequest(
+					cl.NodeViewsGetWithResponse(root, node.JSON200.Slug, nil, adminSession),
+				)(t, http.StatusOK)
+
+				a.Equal(openapi.ContentViewCounts{Views: 1, UniqueViewers: 1}, res.JSON200.Total)
+			})
+
+			t.Run("draft", func(t *testing.T) {
+				tests.AssertRequest(
+					cl.ThreadViewsGetWithResponse(root, draft.JSON200.Slug, nil, authorSession),
+				)(t, http.StatusNotFound)
+			})
+
+			t.Run("profile", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res := tests.AssertRequest(
+					cl.ProfileViewsGetWithResponse(root, author.Handle, nil, authorSession),
+				)(t, http.StatusOK)
+
+				a.Equal(openapi.ContentViewCounts{Views: 6, UniqueViewers: 5}, res.JSON200.Total)
+				r.Len(res.JSON200.Items, 1)
+				th, err := res.JSON200.Items[0].Item.AsDatagraphItemThread()
+				r.NoError(err)
+				a.Equal(thread.JSON200.Id, th.Ref.Id)
+				a.Equal(6, res.JSON200.Items[0].Views)
+			})
+
+			t.Run("admin", func(t *testing.T) {
+				tests.AssertRequest(
+					cl.ThreadViewsGetWithResponse(root, thread.JSON200.Slug, nil, adminSession),
+				)(t, http.StatusOK)
+				tests.AssertRequest(
+					cl.ProfileViewsGetWithResponse(root, author.Handle, nil, adminSession),
+				)(t, http.StatusOK)
+			})
+
+			t.Run("forbidden", func(t *testing.T) {
+				tests.AssertRequest(
+					cl.ThreadViewsGetWithResponse(root, thread.JSON200.Slug, nil, otherSession),
+				)(t, http.StatusForbidden)
+				tests.AssertRequest(
+					cl.NodeViewsGetWithResponse(root, node.JSON200.Slug, nil, otherSession),
+				)(t, http.StatusForbidden)
+				tests.AssertRequest(
+					cl.ProfileViewsGetWithResponse(root, author.Handle, nil, otherSession),
+				)(t, http.StatusForbidden)
+				tests.AssertRequest(
+					cl.ThreadViewsGetWithResponse(root, thread.JSON200.Slug, nil),
+				)(t, http.StatusUnauthorized)
+			})
+		}))
+	}))
+}
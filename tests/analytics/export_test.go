@@ -0,0 +1,175 @@
+package analytics_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/analytics"
+	"github.com/Southclaws/storyden/app/resources/analytics/analytics_querier"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/analytics/analytics_export"
+	"github.com/Southclaws/storyden/app/services/analytics/analytics_rollup"
+	"github.com/Southclaws/storyden/app/services/api_usage"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// fakeClickHouse records the queries sent to it and fails them while broken.
+type fakeClickHouse struct {
+	mu      sync.Mutex
+	broken  bool
+	queries []string
+	inserts map[string]string
+}
+
+func (f *fakeClickHouse) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.broken {
+		http.Error(w, "Code: 210. Connection refused", http.StatusServiceUnavailable)
+		return
+	}
+
+	q := r.URL.Query().Get("query")
+	f.queries = append(f.queries, q)
+
+	if table, ok := strings.CutPrefix(q, "INSERT INTO "); ok {
+		body, _ := io.ReadAll(r.Body)
+		f.inserts[table] += string(body)
+	}
+}
+
+func (f *fakeClickHouse) reset(broken bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.broken = broken
+	f.queries = nil
+	f.inserts = map[string]string{}
+}
+
+func (f *fakeClickHouse) deletes(table string) int {
+	n := 0
+	for _, q := range f.queries {
+		if strings.HasPrefix(q, "DELETE FROM `"+table+"`") {
+			n++
+		}
+	}
+	return n
+}
+
+func TestAnalyticsExport(t *testing.T) {
+	t.Parallel()
+
+	ch := &fakeClickHouse{inserts: map[string]string{}}
+	srv := httptest.NewServer(ch)
+	defer srv.Close()
+
+	integration.Test(t, &config.Config{
+		AnalyticsExportSinks:       []string{"clickhouse"},
+		AnalyticsExportTablePrefix: "storyden_",
+		ClickHouseURL:              srv.URL,
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		recorder *api_usage.Recorder,
+		rollup *analytics_rollup.Rollup,
+		exporter *analytics_export.Exporter,
+		querier *analytics_querier.Querier,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat := tests.AssertRequest(
+				cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession),
+			)(t, http.StatusOK)
+
+			thread := tests.AssertRequest(
+				cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>export</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Analytics export test thread",
+				}, memberSession),
+			)(t, http.StatusOK)
+
+			tests.AssertRequest(
+				cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>reply</p>"}, adminSession),
+			)(t, http.StatusOK)
+
+			require.NoError(t, recorder.Flush(root))
+			require.NoError(t, rollup.Run(root, time.Now()))
+
+			today := analytics.StartOfDay(time.Now()).Format(time.DateOnly)
+
+			t.Run("failing_sink_is_retried", func(t *testing.T) {
+				r := require.New(t)
+
+				ch.reset(true)
+				r.Error(exporter.Run(root, time.Now()))
+
+				through, err := querier.ExportedThrough(root, "clickhouse")
+				r.NoError(err)
+				r.False(through.Ok())
+			})
+
+			t.Run("backfill", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ch.reset(false)
+				r.NoError(exporter.Run(root, time.Now()))
+
+				days := ch.inserts["`storyden_days` FORMAT JSONEachRow"]
+				a.Contains(days, `"date":"`+today+`"`)
+
+				content := ch.inserts["`storyden_content` FORMAT JSONEachRow"]
+				a.Contains(content, thread.JSON200.Id)
+
+				cohorts := ch.inserts["`storyden_cohorts` FORMAT JSONEachRow"]
+				a.Contains(cohorts, `"week":0`)
+
+				a.Equal(analytics_export.DefaultBackfillDays+1, ch.deletes("storyden_days"))
+
+				through, err := querier.ExportedThrough(root, "clickhouse")
+				r.NoError(err)
+				a.Equal(today, through.OrZero().Format(time.DateOnly))
+			})
+
+			t.Run("incremental", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ch.reset(false)
+				r.NoError(exporter.Run(root, time.Now()))
+
+				// Only yesterday and today are sent again.
+				a.Equal(2, ch.deletes("storyden_days"))
+				a.Contains(ch.inserts["`storyden_days` FORMAT JSONEachRow"], `"date":"`+today+`"`)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,91 @@
+package asset_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAssetDeduplication(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		objects object.Storer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			content := []byte("deduplicated " + uuid.NewString())
+			sum := sha256.Sum256(content)
+			blob := asset.BuildBlobPath(hex.EncodeToString(sum[:]))
+
+			upload := func() openapi.Asset {
+				res, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+					ContentLength: int64(len(content)),
+				}, "application/octet-stream", bytes.NewReader(content), adminSession)
+				tests.Ok(t, err, res)
+				return *res.JSON200
+			}
+
+			first := upload()
+			second := upload()
+			a.NotEqual(first.Filename, second.Filename)
+
+			exists, err := objects.Exists(root, blob)
+			r.NoError(err)
+			a.True(exists)
+
+			notOwner, err := cl.AssetDeleteWithResponse(root, first.Filename, memberSession)
+			tests.Status(t, err, notOwner, http.StatusForbidden)
+
+			del1, err := cl.AssetDeleteWithResponse(root, first.Filename, adminSession)
+			tests.Status(t, err, del1, http.StatusNoContent)
+
+			gone, err := cl.AssetGetWithResponse(root, first.Filename, &openapi.AssetGetParams{})
+			tests.Status(t, err, gone, http.StatusNotFound)
+
+			stillShared, err := cl.AssetGetWithResponse(root, second.Filename, &openapi.AssetGetParams{})
+			tests.Ok(t, err, stillShared)
+			a.Equal(content, stillShared.Body)
+
+			exists, err = objects.Exists(root, blob)
+			r.NoError(err)
+			a.True(exists, "blob is kept while another asset refers to it")
+
+			del2, err := cl.AssetDeleteWithResponse(root, second.Filename, adminSession)
+			tests.Status(t, err, del2, http.StatusNoContent)
+
+			exists, err = objects.Exists(root, blob)
+			r.NoError(err)
+			a.False(exists, "blob is removed with the last reference")
+		}))
+	}))
+}
@@ -0,0 +1,138 @@
+package asset_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestPrivateAssets(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		db *ent.Client,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			content := []byte("private " + xid.New().String())
+
+			res, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+				ContentLength: int64(len(content)),
+				Private:       opt.New(true).Ptr(),
+			}, "application/octet-stream", bytes.NewReader(content), adminSession)
+			tests.Ok(t, err, res)
+			r.NotNil(res.JSON200.Private)
+			a.True(*res.JSON200.Private)
+			name := res.JSON200.Filename
+
+			owner, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{}, adminSession)
+			tests.Ok(t, err, owner)
+			a.Equal(content, owner.Body)
+			a.True(strings.HasPrefix(owner.HTTPResponse.Header.Get("Cache-Control"), "private"))
+
+			guest, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{})
+			tests.Status(t, err, guest, http.StatusNotFound)
+
+			member, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{}, memberSession)
+			tests.Status(t, err, member, http.StatusNotFound)
+
+			memberSign, err := cl.AssetSignedURLCreateWithResponse(root, name, memberSession)
+			tests.Status(t, err, memberSign, http.StatusNotFound)
+
+			t.Run("signed_url", func(t *testing.T) {
+				signed, err := cl.AssetSignedURLCreateWithResponse(root, name, adminSession)
+				tests.Ok(t, err, signed)
+				assert.Contains(t, signed.JSON200.Url, name)
+
+				withToken, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{
+					Token: &signed.JSON200.Token,
+				})
+				tests.Ok(t, err, withToken)
+				assert.Equal(t, content, withToken.Body)
+
+				forged, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{
+					Token: opt.New(signed.JSON200.Token + "x").Ptr(),
+				})
+				tests.Status(t, err, forged, http.StatusNotFound)
+			})
+
+			t.Run("attached_to_published_content", func(t *testing.T) {
+				published := openapi.Published
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{
+					Name:       xid.New().String(),
+					AssetIds:   &openapi.AssetIDs{res.JSON200.Id},
+					Visibility: &published,
+				}, adminSession)
+				tests.Ok(t, err, node)
+
+				member, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{}, memberSession)
+				tests.Ok(t, err, member)
+				assert.Equal(t, content, member.Body)
+			})
+
+			t.Run("attached_to_unreadable_category", func(t *testing.T) {
+				hidden := tests.AssertRequest(cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+					ContentLength: int64(len(content)),
+					Private:       opt.New(true).Ptr(),
+				}, "application/octet-stream", bytes.NewReader(content), adminSession))(t, http.StatusOK)
+
+				cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+					Name:   "staff " + xid.New().String(),
+					Colour: "#000",
+				}, adminSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, cat.JSON200.Slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{
+						{RoleId: role.DefaultRoleAdminID.String(), Read: true},
+					},
+				}, adminSession))(t, http.StatusOK)
+
+				thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>staff only</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "staff " + xid.New().String(),
+				}, adminSession))(t, http.StatusOK)
+
+				// Threads can't attach assets through the API, only imports do.
+				r.NoError(db.Post.UpdateOneID(openapi.ParseID(thread.JSON200.Id)).
+					AddAssetIDs(openapi.ParseID(hidden.JSON200.Id)).
+					Exec(root))
+
+				member, err := cl.AssetGetWithResponse(root, hidden.JSON200.Filename, &openapi.AssetGetParams{}, memberSession)
+				tests.Status(t, err, member, http.StatusNotFound)
+
+				guest, err := cl.AssetGetWithResponse(root, hidden.JSON200.Filename, &openapi.AssetGetParams{})
+				tests.Status(t, err, guest, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,112 @@
+package asset_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAssetUploadQuota(t *testing.T) {
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			upload := func(size int, session openapi.RequestEditorFn) (*openapi.AssetUploadResponse, error) {
+				return cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+					ContentLength: int64(size),
+				}, "application/octet-stream", bytes.NewReader(bytes.Repeat([]byte("q"), size)), session)
+			}
+
+			setQuota := func(quota int64) {
+				res, err := cl.RoleUpdateWithResponse(root, role.DefaultRoleMemberID.String(), openapi.RoleUpdateJSONRequestBody{
+					UploadQuota: &quota,
+				}, adminSession)
+				tests.Ok(t, err, res)
+			}
+
+			setQuota(1024)
+
+			usage, err := cl.AssetUsageGetWithResponse(root, memberSession)
+			tests.Ok(t, err, usage)
+			a.Equal(int64(0), usage.JSON200.Used)
+			a.Equal(opt.New(int64(1024)).Ptr(), usage.JSON200.Quota)
+
+			first, err := upload(600, memberSession)
+			tests.Ok(t, err, first)
+
+			usage, err = cl.AssetUsageGetWithResponse(root, memberSession)
+			tests.Ok(t, err, usage)
+			a.Equal(int64(600), usage.JSON200.Used)
+			a.Equal(1, usage.JSON200.Assets)
+
+			over, err := upload(600, memberSession)
+			tests.Status(t, err, over, http.StatusForbidden)
+
+			resumable, err := cl.AssetUploadSessionCreateWithResponse(root, openapi.AssetUploadSessionInitialProps{
+				Size: 600,
+			}, memberSession)
+			tests.Status(t, err, resumable, http.StatusForbidden)
+
+			t.Run("admins_are_unlimited", func(t *testing.T) {
+				res, err := upload(2048, adminSession)
+				tests.Ok(t, err, res)
+
+				usage, err := cl.AssetUsageGetWithResponse(root, adminSession)
+				tests.Ok(t, err, usage)
+				assert.Nil(t, usage.JSON200.Quota)
+			})
+
+			t.Run("admin_storage_report", func(t *testing.T) {
+				denied, err := cl.AdminStorageUsageReportWithResponse(root, memberSession)
+				tests.Status(t, err, denied, http.StatusForbidden)
+
+				report, err := cl.AdminStorageUsageReportWithResponse(root, adminSession)
+				tests.Ok(t, err, report)
+				assert.GreaterOrEqual(t, report.JSON200.Used, int64(2048+600))
+
+				var found *openapi.StorageConsumer
+				for _, c := range report.JSON200.Consumers {
+					if c.Profile.Id == member.ID.String() {
+						found = &c
+					}
+				}
+				r.NotNil(found)
+				assert.Equal(t, int64(600), found.Used)
+				assert.Equal(t, 1, found.Assets)
+			})
+
+			t.Run("removing_quota", func(t *testing.T) {
+				setQuota(0)
+
+				res, err := upload(600, memberSession)
+				tests.Ok(t, err, res)
+			})
+		}))
+	}))
+}
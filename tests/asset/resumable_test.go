@@ -0,0 +1,166 @@
+package asset_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestResumableUpload(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_002_Frigg)
+			otherSession := sh.WithSession(otherCtx)
+
+			content := bytes.Repeat([]byte("storyden"), 1024)
+			first, second := content[:5000], content[5000:]
+
+			appendPart := func(id string, offset int, part []byte) (*openapi.AssetUploadSessionAppendResponse, error) {
+				return cl.AssetUploadSessionAppendWithBodyWithResponse(root, id, &openapi.AssetUploadSessionAppendParams{
+					ContentLength: int64(len(part)),
+					Offset:        int64(offset),
+				}, "application/octet-stream", bytes.NewReader(part), adminSession)
+			}
+
+			t.Run("upload_in_parts", func(t *testing.T) {
+				session, err := cl.AssetUploadSessionCreateWithResponse(root, openapi.AssetUploadSessionInitialProps{
+					Filename: opt.New("parts.txt").Ptr(),
+					Size:     int64(len(content)),
+				}, adminSession)
+				tests.Ok(t, err, session)
+				a.Equal(int64(0), session.JSON200.Received)
+
+				id := session.JSON200.Id
+
+				part1, err := appendPart(id, 0, first)
+				tests.Ok(t, err, part1)
+				a.Equal(int64(len(first)), part1.JSON200.Received)
+
+				incomplete, err := cl.AssetUploadSessionCompleteWithResponse(root, id, nil, adminSession)
+				tests.Status(t, err, incomplete, http.StatusBadRequest)
+
+				stale, err := appendPart(id, 0, second)
+				tests.Status(t, err, stale, http.StatusBadRequest)
+
+				progress, err := cl.AssetUploadSessionGetWithResponse(root, id, adminSession)
+				tests.Ok(t, err, progress)
+				a.Equal(int64(len(first)), progress.JSON200.Received)
+
+				notOwner, err := cl.AssetUploadSessionGetWithResponse(root, id, otherSession)
+				tests.Status(t, err, notOwner, http.StatusNotFound)
+
+				part2, err := appendPart(id, len(first), second)
+				tests.Ok(t, err, part2)
+				a.Equal(int64(len(content)), part2.JSON200.Received)
+
+				done, err := cl.AssetUploadSessionCompleteWithResponse(root, id, nil, adminSession)
+				tests.Ok(t, err, done)
+
+				get, err := cl.AssetGetWithResponse(root, done.JSON200.Filename, &openapi.AssetGetParams{})
+				r.NoError(err)
+				r.Equal(http.StatusOK, get.StatusCode())
+				a.Equal(content, get.Body)
+
+				gone, err := cl.AssetUploadSessionGetWithResponse(root, id, adminSession)
+				tests.Status(t, err, gone, http.StatusNotFound)
+			})
+
+			t.Run("concurrent_retries", func(t *testing.T) {
+				session, err := cl.AssetUploadSessionCreateWithResponse(root, openapi.AssetUploadSessionInitialProps{
+					Filename: opt.New("retried.txt").Ptr(),
+					Size:     int64(len(content)),
+				}, adminSession)
+				tests.Ok(t, err, session)
+
+				// Each attempt sends different bytes for the same offset so a part
+				// overwritten by a losing attempt would show in the asset.
+				attempts := make([][]byte, 8)
+				statuses := make([]int, len(attempts))
+				for i := range attempts {
+					attempts[i] = bytes.Repeat([]byte{byte('a' + i)}, len(content))
+				}
+
+				var wg sync.WaitGroup
+				start := make(chan struct{})
+				for i, part := range attempts {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						<-start
+						res, err := appendPart(session.JSON200.Id, 0, part)
+						if err == nil {
+							statuses[i] = res.StatusCode()
+						}
+					}()
+				}
+				close(start)
+				wg.Wait()
+
+				winner := slices.Index(statuses, http.StatusOK)
+				r.NotEqual(-1, winner)
+
+				done, err := cl.AssetUploadSessionCompleteWithResponse(root, session.JSON200.Id, nil, adminSession)
+				tests.Ok(t, err, done)
+
+				get, err := cl.AssetGetWithResponse(root, done.JSON200.Filename, &openapi.AssetGetParams{})
+				r.NoError(err)
+				r.Equal(http.StatusOK, get.StatusCode())
+				a.Equal(attempts[winner], get.Body)
+			})
+
+			t.Run("part_beyond_size", func(t *testing.T) {
+				session, err := cl.AssetUploadSessionCreateWithResponse(root, openapi.AssetUploadSessionInitialProps{
+					Size: int64(len(first)),
+				}, adminSession)
+				tests.Ok(t, err, session)
+
+				tooBig, err := appendPart(session.JSON200.Id, 0, content)
+				tests.Status(t, err, tooBig, http.StatusBadRequest)
+			})
+
+			t.Run("cancel", func(t *testing.T) {
+				session, err := cl.AssetUploadSessionCreateWithResponse(root, openapi.AssetUploadSessionInitialProps{
+					Size: int64(len(content)),
+				}, adminSession)
+				tests.Ok(t, err, session)
+
+				part1, err := appendPart(session.JSON200.Id, 0, first)
+				tests.Ok(t, err, part1)
+
+				cancel, err := cl.AssetUploadSessionCancelWithResponse(root, session.JSON200.Id, adminSession)
+				tests.Status(t, err, cancel, http.StatusNoContent)
+
+				gone, err := cl.AssetUploadSessionGetWithResponse(root, session.JSON200.Id, adminSession)
+				tests.Status(t, err, gone, http.StatusNotFound)
+			})
+		}))
+	}))
+}
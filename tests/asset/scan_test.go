@@ -0,0 +1,128 @@
+package asset_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+var malwareMarker = []byte("STORYDEN-TEST-MALWARE")
+
+func fakeMalwareScanner(t *testing.T) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		infected := bytes.Contains(b, malwareMarker)
+		json.NewEncoder(w).Encode(map[string]any{
+			"infected":  infected,
+			"signature": "Test.Marker",
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+func TestAssetMalwareScanBlock(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		MalwareScanner:         "http",
+		MalwareScanURL:         fakeMalwareScanner(t),
+		MalwareScanEnforcement: "block",
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			clean := []byte("a perfectly normal file")
+			res, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+				ContentLength: int64(len(clean)),
+			}, "application/octet-stream", bytes.NewReader(clean), memberSession)
+			tests.Ok(t, err, res)
+			r.NotNil(res.JSON200.ScanStatus)
+			a.Equal(openapi.AssetScanStatusClean, *res.JSON200.ScanStatus)
+
+			infected := append([]byte("not so normal "), malwareMarker...)
+			blocked, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+				ContentLength: int64(len(infected)),
+			}, "application/octet-stream", bytes.NewReader(infected), memberSession)
+			tests.Status(t, err, blocked, http.StatusBadRequest)
+
+			flagged, err := cl.AdminFlaggedAssetListWithResponse(root, adminSession)
+			tests.Ok(t, err, flagged)
+			r.Len(flagged.JSON200.Assets, 1)
+			quarantined := flagged.JSON200.Assets[0]
+			a.Equal(openapi.AssetScanStatusQuarantined, *quarantined.ScanStatus)
+			a.Equal("Test.Marker", *quarantined.ScanSignature)
+
+			get, err := cl.AssetGetWithResponse(root, quarantined.Filename, &openapi.AssetGetParams{}, adminSession)
+			tests.Status(t, err, get, http.StatusNotFound)
+
+			memberList, err := cl.AdminFlaggedAssetListWithResponse(root, memberSession)
+			tests.Status(t, err, memberList, http.StatusForbidden)
+		}))
+	}))
+}
+
+func TestAssetMalwareScanWarn(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		MalwareScanner:         "http",
+		MalwareScanURL:         fakeMalwareScanner(t),
+		MalwareScanEnforcement: "warn",
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			infected := append([]byte("not so normal "), malwareMarker...)
+			res, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+				ContentLength: int64(len(infected)),
+			}, "application/octet-stream", bytes.NewReader(infected), memberSession)
+			tests.Ok(t, err, res)
+			r.NotNil(res.JSON200.ScanStatus)
+			a.Equal(openapi.AssetScanStatusFlagged, *res.JSON200.ScanStatus)
+
+			get, err := cl.AssetGetWithResponse(root, res.JSON200.Filename, &openapi.AssetGetParams{}, memberSession)
+			tests.Ok(t, err, get)
+			a.Equal(infected, get.Body)
+		}))
+	}))
+}
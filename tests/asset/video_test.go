@@ -0,0 +1,62 @@
+package asset_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// mp4Header is enough of an MP4 file for its type to be detected.
+var mp4Header = []byte{
+	0x00, 0x00, 0x00, 0x18, 'f', 't', 'y', 'p',
+	'i', 's', 'o', 'm', 0x00, 0x00, 0x02, 0x00,
+	'i', 's', 'o', 'm', 'i', 's', 'o', '2',
+}
+
+func TestVideoWithoutTranscoder(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			upload, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+				ContentLength: int64(len(mp4Header)),
+				Filename:      opt.New("clip.mp4").Ptr(),
+			}, "application/octet-stream", bytes.NewReader(mp4Header), adminSession)
+			tests.Ok(t, err, upload)
+			a.Equal("video/mp4", upload.JSON200.MimeType)
+			a.Nil(upload.JSON200.ProcessingStatus, "videos are stored as-is when transcoding is disabled")
+
+			poster, err := cl.AssetGetWithResponse(root, upload.JSON200.Filename, &openapi.AssetGetParams{
+				Variant: opt.New(openapi.AssetProcessedVariantPoster).Ptr(),
+			})
+			tests.Status(t, err, poster, http.StatusNotFound)
+
+			original, err := cl.AssetGetWithResponse(root, upload.JSON200.Filename, &openapi.AssetGetParams{})
+			tests.Ok(t, err, original)
+			a.Equal(mp4Header, original.Body)
+		}))
+	}))
+}
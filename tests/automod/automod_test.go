@@ -0,0 +1,214 @@
+package automod_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAutomod(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			otherSession := sh.WithSession(otherCtx)
+
+			// Unique words so rules don't affect other tests sharing the instance.
+			rejected := "rejectword" + uuid.NewString()[:8]
+			held := "holdword" + uuid.NewString()[:8]
+			hidden := "hideword" + uuid.NewString()[:8]
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func(body string, session openapi.RequestEditorFn) (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>" + body + "</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "automod " + uuid.NewString(),
+				}, session)
+			}
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminAutomodRuleListWithResponse(root, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				created, err := cl.AdminAutomodRuleCreateWithResponse(root, openapi.AutomodRuleInitialProps{
+					Name:   "nope",
+					Kind:   openapi.Words,
+					Action: openapi.Reject,
+					Words:  &[]string{rejected},
+				}, memberSession)
+				tests.Status(t, err, created, http.StatusForbidden)
+			})
+
+			t.Run("invalid_rules", func(t *testing.T) {
+				badPattern, err := cl.AdminAutomodRuleCreateWithResponse(root, openapi.AutomodRuleInitialProps{
+					Name:    "bad pattern",
+					Kind:    openapi.Pattern,
+					Action:  openapi.Flag,
+					Pattern: opt.New("(unclosed").Ptr(),
+				}, adminSession)
+				tests.Status(t, err, badPattern, http.StatusBadRequest)
+
+				noWords, err := cl.AdminAutomodRuleCreateWithResponse(root, openapi.AutomodRuleInitialProps{
+					Name:   "no words",
+					Kind:   openapi.Words,
+					Action: openapi.Flag,
+				}, adminSession)
+				tests.Status(t, err, noWords, http.StatusBadRequest)
+
+				noWindow, err := cl.AdminAutomodRuleCreateWithResponse(root, openapi.AutomodRuleInitialProps{
+					Name:      "no window",
+					Kind:      openapi.Velocity,
+					Action:    openapi.Hold,
+					Threshold: opt.New(3).Ptr(),
+				}, adminSession)
+				tests.Status(t, err, noWindow, http.StatusBadRequest)
+			})
+
+			rejectRule, err := cl.AdminAutomodRuleCreateWithResponse(root, openapi.AutomodRuleInitialProps{
+				Name:   "reject",
+				Kind:   openapi.Words,
+				Action: openapi.Reject,
+				Words:  &[]string{rejected},
+				Reason: opt.New("No thanks.").Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, rejectRule)
+			a.True(rejectRule.JSON200.Enabled)
+
+			holdRule, err := cl.AdminAutomodRuleCreateWithResponse(root, openapi.AutomodRuleInitialProps{
+				Name:    "hold",
+				Kind:    openapi.Pattern,
+				Action:  openapi.Hold,
+				Pattern: opt.New(`(?i)` + held).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, holdRule)
+
+			hideRule, err := cl.AdminAutomodRuleCreateWithResponse(root, openapi.AutomodRuleInitialProps{
+				Name:   "hide",
+				Kind:   openapi.Words,
+				Action: openapi.Hide,
+				Words:  &[]string{hidden},
+			}, adminSession)
+			tests.Ok(t, err, hideRule)
+
+			defer func() {
+				for _, id := range []string{rejectRule.JSON200.Id, holdRule.JSON200.Id, hideRule.JSON200.Id} {
+					cl.AdminAutomodRuleDeleteWithResponse(root, id, adminSession)
+				}
+			}()
+
+			t.Run("list_and_get", func(t *testing.T) {
+				list, err := cl.AdminAutomodRuleListWithResponse(root, adminSession)
+				tests.Ok(t, err, list)
+
+				ids := dt.Map(list.JSON200.Rules, func(r openapi.AutomodRule) string { return r.Id })
+				a.Contains(ids, rejectRule.JSON200.Id)
+				a.Contains(ids, holdRule.JSON200.Id)
+
+				get, err := cl.AdminAutomodRuleGetWithResponse(root, holdRule.JSON200.Id, adminSession)
+				tests.Ok(t, err, get)
+				a.Equal(openapi.Hold, get.JSON200.Action)
+				a.Equal(`(?i)`+held, *get.JSON200.Pattern)
+			})
+
+			t.Run("reject", func(t *testing.T) {
+				res, err := createThread("hello "+rejected+" world", memberSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+
+				ok, err := createThread("hello "+rejected+"suffix world", memberSession)
+				tests.Ok(t, err, ok)
+			})
+
+			t.Run("admins_exempt", func(t *testing.T) {
+				res, err := createThread("hello "+rejected, adminSession)
+				tests.Ok(t, err, res)
+				a.Equal(openapi.Published, res.JSON200.Visibility)
+			})
+
+			t.Run("hold_thread", func(t *testing.T) {
+				res, err := createThread("please "+held, memberSession)
+				tests.Ok(t, err, res)
+				a.Equal(openapi.Review, res.JSON200.Visibility)
+
+				get, err := cl.ThreadGetWithResponse(root, res.JSON200.Slug, nil, otherSession)
+				r.NoError(err)
+				a.Equal(http.StatusForbidden, get.StatusCode())
+			})
+
+			t.Run("hide_reply", func(t *testing.T) {
+				thread, err := createThread("a thread", otherSession)
+				tests.Ok(t, err, thread)
+
+				hiddenReply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>" + hidden + "</p>"}, memberSession)
+				tests.Ok(t, err, hiddenReply)
+
+				normalReply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>normal</p>"}, memberSession)
+				tests.Ok(t, err, normalReply)
+
+				replyIDs := func(session openapi.RequestEditorFn) []string {
+					get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, session)
+					tests.Ok(t, err, get)
+					return dt.Map(get.JSON200.Replies.Replies, func(r openapi.Reply) string { return r.Id })
+				}
+
+				asAuthor := replyIDs(memberSession)
+				a.Contains(asAuthor, hiddenReply.JSON200.Id)
+				a.Contains(asAuthor, normalReply.JSON200.Id)
+
+				asOther := replyIDs(otherSession)
+				a.NotContains(asOther, hiddenReply.JSON200.Id)
+				a.Contains(asOther, normalReply.JSON200.Id)
+			})
+
+			t.Run("disable", func(t *testing.T) {
+				upd, err := cl.AdminAutomodRuleUpdateWithResponse(root, rejectRule.JSON200.Id, openapi.AutomodRuleMutableProps{
+					Enabled: opt.New(false).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, upd)
+				a.False(upd.JSON200.Enabled)
+
+				res, err := createThread("hello "+rejected, memberSession)
+				tests.Ok(t, err, res)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				del, err := cl.AdminAutomodRuleDeleteWithResponse(root, hideRule.JSON200.Id, adminSession)
+				tests.Status(t, err, del, http.StatusNoContent)
+
+				get, err := cl.AdminAutomodRuleGetWithResponse(root, hideRule.JSON200.Id, adminSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,158 @@
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/backup"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// newInstance creates an empty SQLite database with the schema applied and
+// local storage, as a freshly deployed instance would have.
+func newInstance(t *testing.T, ctx context.Context) (*sqlx.DB, object.Storer) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	raw, err := sqlx.Connect("sqlite", filepath.Join(dir, "restored.db")+"?_pragma=foreign_keys(1)")
+	require.NoError(t, err)
+	t.Cleanup(func() { raw.Close() })
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, raw.DB)))
+	require.NoError(t, client.Schema.Create(ctx))
+
+	store, err := object.New(ctx, config.Config{AssetStorageLocalPath: filepath.Join(dir, "assets")}, "local")
+	require.NoError(t, err)
+
+	return raw, store
+}
+
+func TestBackup(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{AssetStorageLocalPath: t.TempDir()}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		raw *sqlx.DB,
+		store object.Storer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			session := sh.WithSession(memberCtx)
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>backed up</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "backup " + uuid.NewString(),
+			}, session)
+			tests.Ok(t, err, thread)
+
+			reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+				Body: "<p>a reply</p>",
+			}, session)
+			tests.Ok(t, err, reply)
+
+			asset := []byte("asset contents")
+			require.NoError(t, store.Write(root, "assets/backup-test", bytes.NewReader(asset), int64(len(asset))))
+
+			bundle := func(t *testing.T, assets bool) []byte {
+				buf := &bytes.Buffer{}
+				_, err := backup.Create(root, buf, raw, store, backup.CreateOptions{IncludeAssets: assets}, io.Discard)
+				require.NoError(t, err)
+				return buf.Bytes()
+			}
+
+			t.Run("round_trip", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				b := bundle(t, true)
+
+				m, err := backup.Verify(root, bytes.NewReader(b), io.Discard)
+				r.NoError(err)
+				r.NotEmpty(m.Tables)
+
+				restored, restoredStore := newInstance(t, root)
+
+				result, err := backup.Restore(root, bytes.NewReader(b), restored, restoredStore, io.Discard)
+				r.NoError(err)
+				a.Empty(result.Missing)
+				a.Equal(len(m.Assets), result.Assets)
+
+				for _, table := range m.Tables {
+					var n int
+					r.NoError(restored.Get(&n, fmt.Sprintf(`select count(*) from "%s"`, table.Name)))
+					a.Equal(table.Rows, n, table.Name)
+				}
+
+				var rootPostID sql.NullString
+				r.NoError(restored.Get(&rootPostID, `select root_post_id from posts where id = $1`, reply.JSON200.Id))
+				a.Equal(thread.JSON200.Id, rootPostID.String, "self references are restored")
+
+				var title string
+				r.NoError(restored.Get(&title, `select title from posts where id = $1`, thread.JSON200.Id))
+				a.Equal(thread.JSON200.Title, title)
+
+				rd, _, err := restoredStore.Read(root, "assets/backup-test")
+				r.NoError(err)
+				got, err := io.ReadAll(rd)
+				r.NoError(err)
+				a.Equal(asset, got)
+
+				_, err = backup.Restore(root, bytes.NewReader(b), restored, restoredStore, io.Discard)
+				r.ErrorContains(err, "not empty")
+			})
+
+			t.Run("assets_listed_only", func(t *testing.T) {
+				r := require.New(t)
+
+				restored, restoredStore := newInstance(t, root)
+
+				result, err := backup.Restore(root, bytes.NewReader(bundle(t, false)), restored, restoredStore, io.Discard)
+				r.NoError(err)
+				r.Equal(0, result.Assets)
+				r.Contains(result.Missing, "assets/backup-test")
+			})
+
+			t.Run("corrupted", func(t *testing.T) {
+				r := require.New(t)
+
+				b := bundle(t, false)
+				b[len(b)/2] ^= 0xff
+
+				_, err := backup.Verify(root, bytes.NewReader(b), io.Discard)
+				r.Error(err)
+
+				_, err = backup.Verify(root, strings.NewReader("not a bundle"), io.Discard)
+				r.Error(err)
+			})
+		}))
+	}))
+}
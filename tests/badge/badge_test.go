@@ -0,0 +1,218 @@
+package badge_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/nullable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestBadges(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			badgeNames := func(t *testing.T, handle string) []string {
+				res, err := cl.ProfileBadgeListWithResponse(root, handle)
+				tests.Ok(t, err, res)
+
+				names := []string{}
+				for _, b := range res.JSON200.Badges {
+					names = append(names, b.Name)
+				}
+				return names
+			}
+
+			newThread := func(t *testing.T, session openapi.RequestEditorFn) *openapi.Thread {
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>badges</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "badges",
+				}, session)
+				tests.Ok(t, err, thread)
+				return thread.JSON200
+			}
+
+			t.Run("builtin", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				list, err := cl.BadgeListWithResponse(root)
+				tests.Ok(t, err, list)
+
+				names := []string{}
+				for _, b := range list.JSON200.Badges {
+					names = append(names, b.Name)
+				}
+				a.Contains(names, "First Post")
+
+				memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				newThread(t, sh.WithSession(memberCtx))
+
+				r.Eventually(func() bool {
+					for _, n := range badgeNames(t, member.Handle) {
+						if n == "First Post" {
+							return true
+						}
+					}
+					return false
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("custom_rule", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+
+				name := "Replier " + uuid.NewString()
+				created, err := cl.BadgeCreateWithResponse(root, openapi.BadgeInitialProps{
+					Name:        name,
+					Description: "Replied once.",
+					Rule:        &openapi.BadgeRule{Trigger: openapi.BadgeTrigger("reply_created"), Threshold: 1},
+				}, adminSession)
+				tests.Ok(t, err, created)
+				r.NotNil(created.JSON200.Rule)
+
+				authorCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				thread := newThread(t, sh.WithSession(authorCtx))
+
+				replierCtx, replier := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+				reply, err := cl.ReplyCreateWithResponse(root, thread.Slug, nil, openapi.ReplyInitialProps{Body: "<p>reply</p>"}, sh.WithSession(replierCtx))
+				tests.Ok(t, err, reply)
+
+				r.Eventually(func() bool {
+					for _, n := range badgeNames(t, replier.Handle) {
+						if n == name {
+							return true
+						}
+					}
+					return false
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("manual_award", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				name := "Staff Pick " + uuid.NewString()
+				created, err := cl.BadgeCreateWithResponse(root, openapi.BadgeInitialProps{
+					Name:        name,
+					Description: "Hand picked.",
+				}, adminSession)
+				tests.Ok(t, err, created)
+				a.Nil(created.JSON200.Rule)
+
+				_, member := e2e.WithAccount(root, aw, seed.Account_005_Þórr)
+
+				award, err := cl.ProfileBadgeAwardWithResponse(root, member.Handle, created.JSON200.Id, adminSession)
+				tests.Ok(t, err, award)
+				r.Len(award.JSON200.Badges, 1)
+				a.Equal(name, award.JSON200.Badges[0].Name)
+
+				// Awarding twice is a no-op.
+				award, err = cl.ProfileBadgeAwardWithResponse(root, member.Handle, created.JSON200.Id, adminSession)
+				tests.Ok(t, err, award)
+				r.Len(award.JSON200.Badges, 1)
+
+				revoke, err := cl.ProfileBadgeRevokeWithResponse(root, member.Handle, created.JSON200.Id, adminSession)
+				tests.Ok(t, err, revoke)
+				a.Empty(revoke.JSON200.Badges)
+			})
+
+			t.Run("update_and_delete", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				created, err := cl.BadgeCreateWithResponse(root, openapi.BadgeInitialProps{
+					Name:        "Updatable " + uuid.NewString(),
+					Description: "Before.",
+					Icon:        opt.New("star").Ptr(),
+					Rule:        &openapi.BadgeRule{Trigger: openapi.BadgeTrigger("like_received"), Threshold: 10},
+				}, adminSession)
+				tests.Ok(t, err, created)
+
+				updated, err := cl.BadgeUpdateWithResponse(root, created.JSON200.Id, openapi.BadgeMutableProps{
+					Description: opt.New("After.").Ptr(),
+					Icon:        nullable.NewNullNullable[string](),
+					Rule:        nullable.NewNullNullable[openapi.BadgeRule](),
+				}, adminSession)
+				tests.Ok(t, err, updated)
+				a.Equal("After.", updated.JSON200.Description)
+				a.Nil(updated.JSON200.Icon)
+				a.Nil(updated.JSON200.Rule)
+
+				del, err := cl.BadgeDeleteWithResponse(root, created.JSON200.Id, adminSession)
+				tests.Ok(t, err, del)
+
+				list, err := cl.BadgeListWithResponse(root)
+				tests.Ok(t, err, list)
+				for _, b := range list.JSON200.Badges {
+					r.NotEqual(created.JSON200.Id, b.Id)
+				}
+			})
+
+			t.Run("invalid_trigger", func(t *testing.T) {
+				t.Parallel()
+
+				res, err := cl.BadgeCreateWithResponse(root, openapi.BadgeInitialProps{
+					Name:        "Invalid " + uuid.NewString(),
+					Description: "Nope.",
+					Rule:        &openapi.BadgeRule{Trigger: openapi.BadgeTrigger("nonsense"), Threshold: 1},
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("member_cannot_manage", func(t *testing.T) {
+				t.Parallel()
+
+				memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				memberSession := sh.WithSession(memberCtx)
+
+				res, err := cl.BadgeCreateWithResponse(root, openapi.BadgeInitialProps{
+					Name:        "Self Awarded " + uuid.NewString(),
+					Description: "Nope.",
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				list, err := cl.BadgeListWithResponse(root)
+				tests.Ok(t, err, list)
+
+				award, err := cl.ProfileBadgeAwardWithResponse(root, member.Handle, list.JSON200.Badges[0].Id, memberSession)
+				tests.Status(t, err, award, http.StatusForbidden)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,197 @@
+package batch_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+			otherSession := sh.WithSession(otherCtx)
+
+			published := openapi.Published
+
+			createThread := func(session openapi.RequestEditorFn) *openapi.ThreadCreateOK {
+				res, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>batch</p>").Ptr(),
+					Visibility: &published,
+					Title:      "batch " + xid.New().String(),
+				}, session)
+				tests.Ok(t, err, res)
+				return res.JSON200
+			}
+
+			createNode := func(tags ...string) *openapi.NodeCreateOK {
+				res, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+					Name:       "batch " + xid.New().String(),
+					Content:    opt.New("<p>batch</p>").Ptr(),
+					Visibility: &published,
+					Tags:       opt.NewIf(tags, func(t []string) bool { return len(t) > 0 }).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, res)
+				return res.JSON200
+			}
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				res, err := cl.BatchPostDeleteWithResponse(root, openapi.BatchPostDeleteProps{
+					PostIds: []string{xid.New().String()},
+				})
+				tests.Status(t, err, res, http.StatusUnauthorized)
+			})
+
+			t.Run("delete_posts", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				own1 := createThread(memberSession)
+				own2 := createThread(memberSession)
+				others := createThread(otherSession)
+
+				failed, err := cl.BatchPostDeleteWithResponse(root, openapi.BatchPostDeleteProps{
+					PostIds: []string{own1.Id, own2.Id, others.Id},
+				}, memberSession)
+				tests.Status(t, err, failed, http.StatusUnprocessableEntity)
+				r.NotNil(failed.JSON422)
+				a.False(failed.JSON422.Applied)
+				r.Len(failed.JSON422.Items, 3)
+				a.True(failed.JSON422.Items[0].Ok)
+				a.True(failed.JSON422.Items[1].Ok)
+				a.False(failed.JSON422.Items[2].Ok)
+				a.Equal(others.Id, failed.JSON422.Items[2].Id)
+				a.Equal(http.StatusForbidden, *failed.JSON422.Items[2].Status)
+				r.NotNil(failed.JSON422.Items[2].Error)
+
+				// Nothing is deleted when any post fails.
+				get, err := cl.ThreadGetWithResponse(root, own1.Slug, nil)
+				tests.Ok(t, err, get)
+
+				missing, err := cl.BatchPostDeleteWithResponse(root, openapi.BatchPostDeleteProps{
+					PostIds: []string{own1.Id, xid.New().String()},
+				}, memberSession)
+				tests.Status(t, err, missing, http.StatusUnprocessableEntity)
+				a.Equal(http.StatusNotFound, *missing.JSON422.Items[1].Status)
+
+				deleted, err := cl.BatchPostDeleteWithResponse(root, openapi.BatchPostDeleteProps{
+					PostIds: []string{own1.Id, own2.Id},
+				}, memberSession)
+				tests.Ok(t, err, deleted)
+				a.True(deleted.JSON200.Applied)
+
+				for _, id := range []string{own1.Slug, own2.Slug} {
+					get, err := cl.ThreadGetWithResponse(root, id, nil)
+					tests.Status(t, err, get, http.StatusNotFound)
+				}
+
+				// Admins may delete anyone's posts.
+				moderated, err := cl.BatchPostDeleteWithResponse(root, openapi.BatchPostDeleteProps{
+					PostIds: []string{others.Id},
+				}, adminSession)
+				tests.Ok(t, err, moderated)
+			})
+
+			t.Run("add_to_collection", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				col, err := cl.CollectionCreateWithResponse(root, openapi.CollectionInitialProps{Name: "batch"}, memberSession)
+				tests.Ok(t, err, col)
+
+				thread1 := createThread(memberSession)
+				thread2 := createThread(otherSession)
+				node1 := createNode()
+
+				failed, err := cl.BatchCollectionItemAddWithResponse(root, col.JSON200.Id, openapi.BatchCollectionItemAddProps{
+					PostIds: &[]string{thread1.Id, xid.New().String()},
+					NodeIds: &[]string{node1.Id},
+				}, memberSession)
+				tests.Status(t, err, failed, http.StatusUnprocessableEntity)
+				a.Equal(http.StatusNotFound, *failed.JSON422.Items[1].Status)
+
+				unchanged, err := cl.CollectionGetWithResponse(root, col.JSON200.Id)
+				tests.Ok(t, err, unchanged)
+				a.Empty(unchanged.JSON200.Items)
+
+				added, err := cl.BatchCollectionItemAddWithResponse(root, col.JSON200.Id, openapi.BatchCollectionItemAddProps{
+					PostIds: &[]string{thread1.Id, thread2.Id},
+					NodeIds: &[]string{node1.Id},
+				}, memberSession)
+				tests.Ok(t, err, added)
+				a.True(added.JSON200.Applied)
+
+				get, err := cl.CollectionGetWithResponse(root, col.JSON200.Id)
+				tests.Ok(t, err, get)
+				r.Len(get.JSON200.Items, 3)
+
+				guest, err := cl.BatchCollectionItemAddWithResponse(root, col.JSON200.Id, openapi.BatchCollectionItemAddProps{
+					PostIds: &[]string{thread1.Id},
+				})
+				tests.Status(t, err, guest, http.StatusUnauthorized)
+
+				noCollection, err := cl.BatchCollectionItemAddWithResponse(root, xid.New().String(), openapi.BatchCollectionItemAddProps{
+					PostIds: &[]string{thread1.Id},
+				}, memberSession)
+				tests.Status(t, err, noCollection, http.StatusNotFound)
+			})
+
+			t.Run("tag_nodes", func(t *testing.T) {
+				a := assert.New(t)
+
+				existing := "existing-" + xid.New().String()
+				added := "added-" + xid.New().String()
+
+				node1 := createNode(existing)
+				node2 := createNode()
+
+				forbidden, err := cl.BatchNodeTagAddWithResponse(root, openapi.BatchNodeTagAddProps{
+					NodeIds: []string{node1.Id, node2.Id},
+					Tags:    []string{added},
+				}, memberSession)
+				tests.Status(t, err, forbidden, http.StatusUnprocessableEntity)
+				a.Equal(http.StatusForbidden, *forbidden.JSON422.Items[0].Status)
+
+				tagged, err := cl.BatchNodeTagAddWithResponse(root, openapi.BatchNodeTagAddProps{
+					NodeIds: []string{node1.Id, node2.Id},
+					Tags:    []string{added, existing},
+				}, adminSession)
+				tests.Ok(t, err, tagged)
+
+				get1, err := cl.NodeGetWithResponse(root, node1.Slug, nil, adminSession)
+				tests.Ok(t, err, get1)
+				a.ElementsMatch([]string{existing, added}, dt.Map(get1.JSON200.Tags, func(t openapi.TagReference) string { return t.Name }))
+
+				get2, err := cl.NodeGetWithResponse(root, node2.Slug, nil, adminSession)
+				tests.Ok(t, err, get2)
+				a.ElementsMatch([]string{existing, added}, dt.Map(get2.JSON200.Tags, func(t openapi.TagReference) string { return t.Name }))
+			})
+		}))
+	}))
+}
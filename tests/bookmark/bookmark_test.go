@@ -0,0 +1,157 @@
+package bookmark_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestBookmarks(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			authorCtx, author := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			authorSession := sh.WithSession(authorCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        uuid.NewString(),
+				Description: "bookmarks",
+				Colour:      "#000",
+			}, sh.WithSession(adminCtx)))(t, http.StatusOK)
+
+			create := func(t *testing.T, vis openapi.Visibility) *openapi.ThreadCreateOK {
+				return tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>worth reading later</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(vis).Ptr(),
+					Title:      "bookmark test " + uuid.NewString(),
+				}, authorSession))(t, http.StatusOK).JSON200
+			}
+
+			thread := create(t, openapi.Published)
+			draft := create(t, openapi.Draft)
+
+			list := func(t *testing.T, session openapi.RequestEditorFn, kind *openapi.DatagraphItemKind) openapi.BookmarkList {
+				return tests.AssertRequest(cl.BookmarkListWithResponse(root, &openapi.BookmarkListParams{Kind: kind}, session))(t, http.StatusOK).JSON200.Bookmarks
+			}
+
+			t.Run("add", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.BookmarkAddWithResponse(root, thread.Id, openapi.BookmarkInitialProps{Kind: openapi.DatagraphItemKindThread}))(t, http.StatusUnauthorized)
+
+				bm := tests.AssertRequest(cl.BookmarkAddWithResponse(root, thread.Id, openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindThread,
+					Note: opt.New("read this weekend").Ptr(),
+				}, memberSession))(t, http.StatusOK)
+				a.Equal(thread.Id, bm.JSON200.ItemId)
+				a.Equal(openapi.DatagraphItemKindThread, bm.JSON200.ItemKind)
+				r.NotNil(bm.JSON200.Item)
+				r.NotNil(bm.JSON200.Note)
+				a.Equal("read this weekend", *bm.JSON200.Note)
+
+				// Adding again without a note keeps the bookmark and its note.
+				again := tests.AssertRequest(cl.BookmarkAddWithResponse(root, thread.Id, openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindThread,
+				}, memberSession))(t, http.StatusOK)
+				a.Equal(bm.JSON200.Id, again.JSON200.Id)
+				r.NotNil(again.JSON200.Note)
+				a.Equal("read this weekend", *again.JSON200.Note)
+
+				renote := tests.AssertRequest(cl.BookmarkAddWithResponse(root, thread.Id, openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindThread,
+					Note: opt.New("actually, tonight").Ptr(),
+				}, memberSession))(t, http.StatusOK)
+				a.Equal(bm.JSON200.Id, renote.JSON200.Id)
+				a.Equal("actually, tonight", *renote.JSON200.Note)
+
+				tests.AssertRequest(cl.BookmarkAddWithResponse(root, xid.ID(author.ID).String(), openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindProfile,
+				}, memberSession))(t, http.StatusOK)
+			})
+
+			t.Run("rejected", func(t *testing.T) {
+				tests.AssertRequest(cl.BookmarkAddWithResponse(root, draft.Id, openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindThread,
+				}, memberSession))(t, http.StatusNotFound)
+
+				tests.AssertRequest(cl.BookmarkAddWithResponse(root, xid.New().String(), openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindThread,
+				}, memberSession))(t, http.StatusNotFound)
+
+				tests.AssertRequest(cl.BookmarkAddWithResponse(root, xid.New().String(), openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindCollection,
+				}, memberSession))(t, http.StatusBadRequest)
+
+				// Authors may bookmark their own drafts.
+				tests.AssertRequest(cl.BookmarkAddWithResponse(root, draft.Id, openapi.BookmarkInitialProps{
+					Kind: openapi.DatagraphItemKindThread,
+				}, authorSession))(t, http.StatusOK)
+			})
+
+			t.Run("list", func(t *testing.T) {
+				a := assert.New(t)
+
+				all := list(t, memberSession, nil)
+				a.Equal([]string{xid.ID(author.ID).String(), thread.Id}, dt.Map(all, func(b openapi.Bookmark) string { return b.ItemId }))
+
+				threads := list(t, memberSession, opt.New(openapi.DatagraphItemKindThread).Ptr())
+				a.Equal([]string{thread.Id}, dt.Map(threads, func(b openapi.Bookmark) string { return b.ItemId }))
+
+				// Bookmarks are private to each member.
+				own := list(t, authorSession, nil)
+				a.Equal([]string{draft.Id}, dt.Map(own, func(b openapi.Bookmark) string { return b.ItemId }))
+
+				tests.AssertRequest(cl.BookmarkListWithResponse(root, nil))(t, http.StatusUnauthorized)
+			})
+
+			t.Run("deleted_item", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.ThreadDeleteWithResponse(root, thread.Slug, authorSession))(t, http.StatusOK)
+
+				threads := list(t, memberSession, opt.New(openapi.DatagraphItemKindThread).Ptr())
+				r.Len(threads, 1)
+				a.Equal(thread.Id, threads[0].ItemId)
+				a.Nil(threads[0].Item)
+			})
+
+			t.Run("remove", func(t *testing.T) {
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.BookmarkRemoveWithResponse(root, thread.Id, memberSession))(t, http.StatusNoContent)
+				tests.AssertRequest(cl.BookmarkRemoveWithResponse(root, thread.Id, memberSession))(t, http.StatusNoContent)
+
+				remaining := list(t, memberSession, nil)
+				a.Equal([]string{xid.ID(author.ID).String()}, dt.Map(remaining, func(b openapi.Bookmark) string { return b.ItemId }))
+			})
+		}))
+	}))
+}
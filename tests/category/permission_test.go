@@ -0,0 +1,143 @@
+package category_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestCategoryPermissions(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			staffCtx, staff := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			staffSession := sh.WithSession(staffCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+
+			staffRole := tests.AssertRequest(cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+				Name:        "Staff " + uuid.NewString(),
+				Colour:      "blue",
+				Permissions: []openapi.Permission{},
+			}, adminSession))(t, http.StatusOK)
+			tests.AssertRequest(cl.AccountAddRoleWithResponse(root, staff.Handle, staffRole.JSON200.Id, adminSession))(t, http.StatusOK)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        "staff room " + uuid.NewString(),
+				Description: "restricted",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+			slug := cat.JSON200.Slug
+
+			thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>staff only</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "staff thread " + uuid.NewString(),
+			}, adminSession))(t, http.StatusOK)
+
+			t.Run("managers_only", func(t *testing.T) {
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{},
+				}, memberSession))(t, http.StatusForbidden)
+			})
+
+			perms := tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, slug, openapi.CategoryPermissions{
+				Roles: openapi.CategoryRolePermissionList{
+					{RoleId: staffRole.JSON200.Id, Read: true, CreateThread: true, Reply: true, Attach: false},
+					{RoleId: role.DefaultRoleMemberID.String(), Read: true},
+				},
+			}, adminSession))(t, http.StatusOK)
+			r.Len(perms.JSON200.Roles, 2)
+
+			get := tests.AssertRequest(cl.CategoryPermissionsGetWithResponse(root, slug, adminSession))(t, http.StatusOK)
+			a.Len(get.JSON200.Roles, 2)
+
+			t.Run("hidden_from_guests", func(t *testing.T) {
+				list := tests.AssertRequest(cl.CategoryListWithResponse(root))(t, http.StatusOK)
+				a.NotContains(dt.Map(list.JSON200.Categories, func(c openapi.Category) string { return c.Slug }), slug)
+
+				tests.AssertRequest(cl.CategoryGetWithResponse(root, slug))(t, http.StatusNotFound)
+				tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil))(t, http.StatusNotFound)
+				tests.AssertRequest(cl.FeedCategoryGetWithResponse(root, openapi.Rss, slug, nil))(t, http.StatusNotFound)
+
+				threads := tests.AssertRequest(cl.ThreadListWithResponse(root, &openapi.ThreadListParams{}))(t, http.StatusOK)
+				a.NotContains(dt.Map(threads.JSON200.Threads, func(t openapi.ThreadReference) string { return t.Id }), thread.JSON200.Id)
+			})
+
+			t.Run("members_read_only", func(t *testing.T) {
+				list := tests.AssertRequest(cl.CategoryListWithResponse(root, memberSession))(t, http.StatusOK)
+				a.Contains(dt.Map(list.JSON200.Categories, func(c openapi.Category) string { return c.Slug }), slug)
+
+				tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, memberSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>let me in</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "member thread " + uuid.NewString(),
+				}, memberSession))(t, http.StatusForbidden)
+
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+					Body: "<p>hello</p>",
+				}, memberSession))(t, http.StatusForbidden)
+			})
+
+			t.Run("staff_post_without_attachments", func(t *testing.T) {
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>staff meeting</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "staff meeting " + uuid.NewString(),
+				}, staffSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+					Body: "<p>agreed</p>",
+				}, staffSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+					Body: `<p>see</p><img src="https://example.com/diagram.png">`,
+				}, staffSession))(t, http.StatusForbidden)
+			})
+
+			t.Run("cleared", func(t *testing.T) {
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{},
+				}, adminSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.CategoryGetWithResponse(root, slug))(t, http.StatusOK)
+				tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil))(t, http.StatusOK)
+
+				threads := tests.AssertRequest(cl.ThreadListWithResponse(root, &openapi.ThreadListParams{Categories: &openapi.CategorySlugListQuery{slug}}))(t, http.StatusOK)
+				a.Contains(dt.Map(threads.JSON200.Threads, func(t openapi.ThreadReference) string { return t.Id }), thread.JSON200.Id)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,115 @@
+package category_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/nullable"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestCategoryState(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        "season " + uuid.NewString(),
+				Description: "seasonal",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+			slug := cat.JSON200.Slug
+			a.Equal(openapi.CategoryStateOpen, cat.JSON200.State)
+
+			post := func(session openapi.RequestEditorFn) *openapi.ThreadCreateResponse {
+				res, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "thread " + uuid.NewString(),
+				}, session)
+				a.NoError(err)
+				return res
+			}
+
+			thread := post(memberSession)
+			a.Equal(http.StatusOK, thread.StatusCode())
+
+			update := func(props openapi.CategoryMutableProps) *openapi.CategoryUpdateResponse {
+				return tests.AssertRequest(cl.CategoryUpdateWithResponse(root, slug, props, adminSession))(t, http.StatusOK)
+			}
+
+			t.Run("archived", func(t *testing.T) {
+				archived := update(openapi.CategoryMutableProps{Archived: opt.New(true).Ptr()})
+				a.Equal(openapi.CategoryStateArchived, archived.JSON200.State)
+				a.NotNil(archived.JSON200.ArchivedAt)
+
+				a.Equal(http.StatusForbidden, post(memberSession).StatusCode())
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>late</p>"}, memberSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{Title: opt.New("renamed").Ptr()}, memberSession))(t, http.StatusForbidden)
+
+				// Still visible, and category managers may still post.
+				tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, memberSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>closing remarks</p>"}, adminSession))(t, http.StatusOK)
+
+				restored := update(openapi.CategoryMutableProps{Archived: opt.New(false).Ptr()})
+				a.Equal(openapi.CategoryStateOpen, restored.JSON200.State)
+				a.Nil(restored.JSON200.ArchivedAt)
+
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>back again</p>"}, memberSession))(t, http.StatusOK)
+			})
+
+			t.Run("scheduled", func(t *testing.T) {
+				scheduled := update(openapi.CategoryMutableProps{OpensAt: nullable.NewNullableWithValue(time.Now().Add(time.Hour))})
+				a.Equal(openapi.CategoryStateScheduled, scheduled.JSON200.State)
+				a.Equal(http.StatusForbidden, post(memberSession).StatusCode())
+
+				opened := update(openapi.CategoryMutableProps{OpensAt: nullable.NewNullNullable[time.Time]()})
+				a.Equal(openapi.CategoryStateOpen, opened.JSON200.State)
+				a.Nil(opened.JSON200.OpensAt)
+			})
+
+			t.Run("closed", func(t *testing.T) {
+				closed := update(openapi.CategoryMutableProps{ClosesAt: nullable.NewNullableWithValue(time.Now().Add(-time.Minute))})
+				a.Equal(openapi.CategoryStateClosed, closed.JSON200.State)
+				a.Equal(http.StatusForbidden, post(memberSession).StatusCode())
+
+				update(openapi.CategoryMutableProps{ClosesAt: nullable.NewNullNullable[time.Time]()})
+				a.Equal(http.StatusOK, post(memberSession).StatusCode())
+			})
+
+			t.Run("invalid_window", func(t *testing.T) {
+				now := time.Now()
+				tests.AssertRequest(cl.CategoryUpdateWithResponse(root, slug, openapi.CategoryMutableProps{
+					OpensAt:  nullable.NewNullableWithValue(now.Add(time.Hour)),
+					ClosesAt: nullable.NewNullableWithValue(now),
+				}, adminSession))(t, http.StatusBadRequest)
+			})
+		}))
+	}))
+}
@@ -65,10 +65,10 @@ func TestCollectionItems(t *testing.T) {
 
 			published := openapi.Published
 
-			thread1create, err := cl.ThreadCreateWithResponse(root, threadCreateProps, session1)
+			thread1create, err := cl.ThreadCreateWithResponse(root, nil, threadCreateProps, session1)
 			tests.Ok(t, err, thread1create)
 
-			thread2create, err := cl.ThreadCreateWithResponse(root, threadCreateProps, session2)
+			thread2create, err := cl.ThreadCreateWithResponse(root, nil, threadCreateProps, session2)
 			tests.Ok(t, err, thread2create)
 
 			node1create, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{Name: xid.New().String(), Content: opt.New("<p>hi</p>").Ptr(), Visibility: &published}, adminSession)
@@ -148,7 +148,7 @@ func TestCollectionItems(t *testing.T) {
 				}, session1)
 				tests.Ok(t, err, col)
 
-				thr, err := cl.ThreadCreateWithResponse(root, threadCreateProps, session1)
+				thr, err := cl.ThreadCreateWithResponse(root, nil, threadCreateProps, session1)
 				tests.Ok(t, err, thr)
 
 				addThr, err := cl.CollectionAddPostWithResponse(root, col.JSON200.Id, thr.JSON200.Id, session1)
@@ -0,0 +1,206 @@
+package collection_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/oapi-codegen/nullable"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestCollectionOrdering(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			acc1, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{xid.New().String(), "password"})
+			tests.Ok(t, err, acc1)
+			session1 := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(acc1.JSON200.Id)))))
+
+			acc2, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{xid.New().String(), "password"})
+			tests.Ok(t, err, acc2)
+			session2 := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(acc2.JSON200.Id)))))
+
+			cat1, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Colour:      "",
+				Description: "cat",
+				Name:        xid.New().String(),
+			}, adminSession)
+			tests.Ok(t, err, cat1)
+
+			threadCreateProps := openapi.ThreadInitialProps{
+				Body:       opt.New("<p>this is a thread</p>").Ptr(),
+				Category:   opt.New(cat1.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "thread",
+			}
+
+			newCollectionWithThreads := func(t *testing.T, n int) (*openapi.Collection, []string) {
+				col, err := cl.CollectionCreateWithResponse(root, openapi.CollectionCreateJSONRequestBody{Name: "ordered"}, session1)
+				tests.Ok(t, err, col)
+
+				ids := []string{}
+				for range n {
+					thr, err := cl.ThreadCreateWithResponse(root, nil, threadCreateProps, session1)
+					tests.Ok(t, err, thr)
+
+					add, err := cl.CollectionAddPostWithResponse(root, col.JSON200.Id, thr.JSON200.Id, session1)
+					tests.Ok(t, err, add)
+
+					ids = append(ids, thr.JSON200.Id)
+				}
+
+				return col.JSON200, ids
+			}
+
+			itemIDs := func(items openapi.CollectionItemList) []string {
+				return lo.Map(items, func(i openapi.CollectionItem, _ int) string { return i.Id })
+			}
+
+			t.Run("move_items", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+
+				col, ids := newCollectionWithThreads(t, 3)
+
+				// Unordered collections list the newest item first.
+				get, err := cl.CollectionGetWithResponse(root, col.Id)
+				tests.Ok(t, err, get)
+				r.Equal([]string{ids[2], ids[1], ids[0]}, itemIDs(get.JSON200.Items))
+
+				move1, err := cl.CollectionItemUpdateWithResponse(root, col.Id, ids[0], openapi.CollectionItemMutableProps{
+					Before: &ids[2],
+				}, session1)
+				tests.Ok(t, err, move1)
+				r.Equal([]string{ids[0], ids[2], ids[1]}, itemIDs(move1.JSON200.Items))
+
+				move2, err := cl.CollectionItemUpdateWithResponse(root, col.Id, ids[2], openapi.CollectionItemMutableProps{
+					After: &ids[1],
+				}, session1)
+				tests.Ok(t, err, move2)
+				r.Equal([]string{ids[0], ids[1], ids[2]}, itemIDs(move2.JSON200.Items))
+
+				// Newly added items go to the top of an ordered collection.
+				thr, err := cl.ThreadCreateWithResponse(root, nil, threadCreateProps, session1)
+				tests.Ok(t, err, thr)
+				add, err := cl.CollectionAddPostWithResponse(root, col.Id, thr.JSON200.Id, session1)
+				tests.Ok(t, err, add)
+				r.Equal([]string{thr.JSON200.Id, ids[0], ids[1], ids[2]}, itemIDs(add.JSON200.Items))
+			})
+
+			t.Run("move_items_unauthorised", func(t *testing.T) {
+				t.Parallel()
+
+				col, ids := newCollectionWithThreads(t, 2)
+
+				move, err := cl.CollectionItemUpdateWithResponse(root, col.Id, ids[0], openapi.CollectionItemMutableProps{
+					Before: &ids[1],
+				}, session2)
+				tests.Status(t, err, move, http.StatusForbidden)
+			})
+
+			t.Run("move_items_invalid", func(t *testing.T) {
+				t.Parallel()
+
+				col, ids := newCollectionWithThreads(t, 2)
+
+				both, err := cl.CollectionItemUpdateWithResponse(root, col.Id, ids[0], openapi.CollectionItemMutableProps{
+					Before: &ids[1],
+					After:  &ids[1],
+				}, session1)
+				tests.Status(t, err, both, http.StatusBadRequest)
+
+				missing := xid.New().String()
+				notfound, err := cl.CollectionItemUpdateWithResponse(root, col.Id, ids[0], openapi.CollectionItemMutableProps{
+					Before: &missing,
+				}, session1)
+				tests.Status(t, err, notfound, http.StatusNotFound)
+			})
+
+			t.Run("sections", func(t *testing.T) {
+				t.Parallel()
+				a := assert.New(t)
+				r := require.New(t)
+
+				col, ids := newCollectionWithThreads(t, 2)
+
+				s1, err := cl.CollectionSectionCreateWithResponse(root, col.Id, openapi.CollectionSectionInitialProps{Name: "first"}, session1)
+				tests.Ok(t, err, s1)
+				s2, err := cl.CollectionSectionCreateWithResponse(root, col.Id, openapi.CollectionSectionInitialProps{Name: "second"}, session1)
+				tests.Ok(t, err, s2)
+
+				get, err := cl.CollectionGetWithResponse(root, col.Id)
+				tests.Ok(t, err, get)
+				r.Len(get.JSON200.Sections, 2)
+				a.Equal(s1.JSON200.Id, get.JSON200.Sections[0].Id)
+				a.Equal(s2.JSON200.Id, get.JSON200.Sections[1].Id)
+
+				moveSection, err := cl.CollectionSectionUpdateWithResponse(root, col.Id, s2.JSON200.Id, openapi.CollectionSectionMutableProps{
+					Name:   opt.New("renamed").Ptr(),
+					Before: &s1.JSON200.Id,
+				}, session1)
+				tests.Ok(t, err, moveSection)
+				a.Equal("renamed", moveSection.JSON200.Name)
+
+				get, err = cl.CollectionGetWithResponse(root, col.Id)
+				tests.Ok(t, err, get)
+				a.Equal(s2.JSON200.Id, get.JSON200.Sections[0].Id)
+				a.Equal(s1.JSON200.Id, get.JSON200.Sections[1].Id)
+
+				place, err := cl.CollectionItemUpdateWithResponse(root, col.Id, ids[0], openapi.CollectionItemMutableProps{
+					SectionId: nullable.NewNullableWithValue(s1.JSON200.Id),
+				}, session1)
+				tests.Ok(t, err, place)
+				placed, found := lo.Find(place.JSON200.Items, func(i openapi.CollectionItem) bool { return i.Id == ids[0] })
+				r.True(found)
+				r.NotNil(placed.SectionId)
+				a.Equal(s1.JSON200.Id, *placed.SectionId)
+
+				other, err := cl.CollectionCreateWithResponse(root, openapi.CollectionCreateJSONRequestBody{Name: "other"}, session1)
+				tests.Ok(t, err, other)
+				foreign, err := cl.CollectionSectionCreateWithResponse(root, other.JSON200.Id, openapi.CollectionSectionInitialProps{Name: "foreign"}, session1)
+				tests.Ok(t, err, foreign)
+
+				wrongSection, err := cl.CollectionItemUpdateWithResponse(root, col.Id, ids[1], openapi.CollectionItemMutableProps{
+					SectionId: nullable.NewNullableWithValue(foreign.JSON200.Id),
+				}, session1)
+				tests.Status(t, err, wrongSection, http.StatusNotFound)
+
+				del, err := cl.CollectionSectionDeleteWithResponse(root, col.Id, s1.JSON200.Id, session1)
+				tests.Ok(t, err, del)
+
+				get, err = cl.CollectionGetWithResponse(root, col.Id)
+				tests.Ok(t, err, get)
+				r.Len(get.JSON200.Sections, 1)
+				r.Len(get.JSON200.Items, 2)
+				for _, i := range get.JSON200.Items {
+					a.Nil(i.SectionId)
+				}
+			})
+		}))
+	}))
+}
@@ -0,0 +1,178 @@
+package collection_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/collection/collection_share_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestCollectionSharing(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			acc1, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{xid.New().String(), "password"})
+			tests.Ok(t, err, acc1)
+			session1 := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(acc1.JSON200.Id)))))
+
+			acc2, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{xid.New().String(), "password"})
+			tests.Ok(t, err, acc2)
+			session2 := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(acc2.JSON200.Id)))))
+
+			published := openapi.Published
+			draft := openapi.Draft
+
+			col, err := cl.CollectionCreateWithResponse(root, openapi.CollectionCreateJSONRequestBody{Name: "shared"}, session1)
+			tests.Ok(t, err, col)
+
+			node1, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{Name: xid.New().String(), Visibility: &published}, adminSession)
+			tests.Ok(t, err, node1)
+			node2, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{Name: xid.New().String(), Visibility: &draft}, session1)
+			tests.Ok(t, err, node2)
+
+			add1, err := cl.CollectionAddNodeWithResponse(root, col.JSON200.Id, node1.JSON200.Id, session1)
+			tests.Ok(t, err, add1)
+			add2, err := cl.CollectionAddNodeWithResponse(root, col.JSON200.Id, node2.JSON200.Id, session1)
+			tests.Ok(t, err, add2)
+
+			t.Run("open_without_passcode", func(t *testing.T) {
+				t.Parallel()
+				a := assert.New(t)
+				r := require.New(t)
+
+				share, err := cl.CollectionShareCreateWithResponse(root, col.JSON200.Id, openapi.CollectionShareInitialProps{}, session1)
+				tests.Ok(t, err, share)
+				a.False(share.JSON200.HasPasscode)
+				a.NotEmpty(share.JSON200.Token)
+
+				open, err := cl.CollectionShareOpenWithResponse(root, share.JSON200.Token, openapi.CollectionShareOpenProps{})
+				tests.Ok(t, err, open)
+				a.Equal(col.JSON200.Id, open.JSON200.Id)
+
+				// Only the published node is visible via the share link.
+				r.Len(open.JSON200.Items, 1)
+				a.Equal(node1.JSON200.Id, open.JSON200.Items[0].Id)
+			})
+
+			t.Run("open_with_passcode", func(t *testing.T) {
+				t.Parallel()
+				a := assert.New(t)
+
+				share, err := cl.CollectionShareCreateWithResponse(root, col.JSON200.Id, openapi.CollectionShareInitialProps{
+					Passcode: opt.New("hunter2").Ptr(),
+				}, session1)
+				tests.Ok(t, err, share)
+				a.True(share.JSON200.HasPasscode)
+
+				missing, err := cl.CollectionShareOpenWithResponse(root, share.JSON200.Token, openapi.CollectionShareOpenProps{})
+				tests.Status(t, err, missing, http.StatusUnauthorized)
+
+				wrong, err := cl.CollectionShareOpenWithResponse(root, share.JSON200.Token, openapi.CollectionShareOpenProps{
+					Passcode: opt.New("hunter3").Ptr(),
+				})
+				tests.Status(t, err, wrong, http.StatusForbidden)
+
+				right, err := cl.CollectionShareOpenWithResponse(root, share.JSON200.Token, openapi.CollectionShareOpenProps{
+					Passcode: opt.New("hunter2").Ptr(),
+				})
+				tests.Ok(t, err, right)
+			})
+
+			t.Run("passcode_attempts", func(t *testing.T) {
+				t.Parallel()
+
+				share, err := cl.CollectionShareCreateWithResponse(root, col.JSON200.Id, openapi.CollectionShareInitialProps{
+					Passcode: opt.New("hunter2").Ptr(),
+				}, session1)
+				tests.Ok(t, err, share)
+
+				other, err := cl.CollectionShareCreateWithResponse(root, col.JSON200.Id, openapi.CollectionShareInitialProps{
+					Passcode: opt.New("hunter2").Ptr(),
+				}, session1)
+				tests.Ok(t, err, other)
+
+				for range collection_share_manager.PasscodeAttemptLimit {
+					wrong, err := cl.CollectionShareOpenWithResponse(root, share.JSON200.Token, openapi.CollectionShareOpenProps{
+						Passcode: opt.New(xid.New().String()).Ptr(),
+					})
+					tests.Status(t, err, wrong, http.StatusForbidden)
+				}
+
+				// Once locked, even the right passcode is turned away.
+				locked, err := cl.CollectionShareOpenWithResponse(root, share.JSON200.Token, openapi.CollectionShareOpenProps{
+					Passcode: opt.New("hunter2").Ptr(),
+				})
+				tests.Status(t, err, locked, http.StatusForbidden)
+
+				// Other shares of the same collection are counted separately.
+				right, err := cl.CollectionShareOpenWithResponse(root, other.JSON200.Token, openapi.CollectionShareOpenProps{
+					Passcode: opt.New("hunter2").Ptr(),
+				})
+				tests.Ok(t, err, right)
+			})
+
+			t.Run("revoke", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+
+				share, err := cl.CollectionShareCreateWithResponse(root, col.JSON200.Id, openapi.CollectionShareInitialProps{}, session1)
+				tests.Ok(t, err, share)
+
+				list, err := cl.CollectionShareListWithResponse(root, col.JSON200.Id, session1)
+				tests.Ok(t, err, list)
+				r.NotEmpty(list.JSON200.Shares)
+
+				notOwner, err := cl.CollectionShareRevokeWithResponse(root, col.JSON200.Id, share.JSON200.Id, session2)
+				tests.Status(t, err, notOwner, http.StatusForbidden)
+
+				revoke, err := cl.CollectionShareRevokeWithResponse(root, col.JSON200.Id, share.JSON200.Id, session1)
+				tests.Ok(t, err, revoke)
+
+				open, err := cl.CollectionShareOpenWithResponse(root, share.JSON200.Token, openapi.CollectionShareOpenProps{})
+				tests.Status(t, err, open, http.StatusNotFound)
+			})
+
+			t.Run("expired", func(t *testing.T) {
+				t.Parallel()
+
+				past, err := cl.CollectionShareCreateWithResponse(root, col.JSON200.Id, openapi.CollectionShareInitialProps{
+					ExpiresAt: opt.New(time.Now().Add(-time.Hour)).Ptr(),
+				}, session1)
+				tests.Status(t, err, past, http.StatusBadRequest)
+			})
+
+			t.Run("unknown_token", func(t *testing.T) {
+				t.Parallel()
+
+				open, err := cl.CollectionShareOpenWithResponse(root, "nonexistent", openapi.CollectionShareOpenProps{})
+				tests.Status(t, err, open, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -62,10 +62,10 @@ func TestCollectionSubmissions(t *testing.T) {
 
 			published := openapi.Published
 
-			thread1create, err := cl.ThreadCreateWithResponse(root, threadCreateProps, session1)
+			thread1create, err := cl.ThreadCreateWithResponse(root, nil, threadCreateProps, session1)
 			tests.Ok(t, err, thread1create)
 
-			thread2create, err := cl.ThreadCreateWithResponse(root, threadCreateProps, session2)
+			thread2create, err := cl.ThreadCreateWithResponse(root, nil, threadCreateProps, session2)
 			tests.Ok(t, err, thread2create)
 
 			node1create, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{Name: xid.New().String(), Content: opt.New("<p>hi</p>").Ptr(), Visibility: &published}, adminSession)
@@ -0,0 +1,179 @@
+package conversation_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestConversations(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			baldurCtx, baldur := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			baldurSession := sh.WithSession(baldurCtx)
+			lokiCtx, loki := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			lokiSession := sh.WithSession(lokiCtx)
+			freyjaCtx, freyja := e2e.WithAccount(root, aw, seed.Account_006_Freyja)
+			freyjaSession := sh.WithSession(freyjaCtx)
+
+			created, err := cl.ConversationCreateWithResponse(root, openapi.ConversationCreateJSONRequestBody{
+				Recipients: []openapi.Identifier{loki.ID.String()},
+				Body:       "<p>hello loki</p>",
+			}, baldurSession)
+			tests.Ok(t, err, created)
+			id := created.JSON200.Id
+			a.Len(created.JSON200.Participants, 2)
+			a.Equal(0, created.JSON200.Unread)
+
+			t.Run("recipient_has_unread", func(t *testing.T) {
+				list, err := cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, lokiSession)
+				tests.Ok(t, err, list)
+				assert.Equal(t, 1, list.JSON200.UnreadTotal)
+				r.Len(list.JSON200.Conversations, 1)
+				assert.Equal(t, id, list.JSON200.Conversations[0].Id)
+				r.NotNil(list.JSON200.Conversations[0].LastMessage)
+				assert.Contains(t, list.JSON200.Conversations[0].LastMessage.Body, "hello loki")
+			})
+
+			t.Run("direct_conversation_is_reused", func(t *testing.T) {
+				again, err := cl.ConversationCreateWithResponse(root, openapi.ConversationCreateJSONRequestBody{
+					Recipients: []openapi.Identifier{loki.ID.String()},
+					Body:       "<p>are you there?</p>",
+				}, baldurSession)
+				tests.Ok(t, err, again)
+				assert.Equal(t, id, again.JSON200.Id)
+
+				get, err := cl.ConversationGetWithResponse(root, id, &openapi.ConversationGetParams{}, lokiSession)
+				tests.Ok(t, err, get)
+				assert.Equal(t, 2, get.JSON200.Unread)
+				r.Len(get.JSON200.Messages.Messages, 2)
+				assert.Contains(t, get.JSON200.Messages.Messages[0].Body, "are you there?")
+			})
+
+			t.Run("reply_and_mark_read", func(t *testing.T) {
+				reply, err := cl.ConversationMessageCreateWithResponse(root, id, openapi.ConversationMessageCreateJSONRequestBody{
+					Body: "<p>hi baldur</p>",
+				}, lokiSession)
+				tests.Ok(t, err, reply)
+				assert.Equal(t, loki.ID.String(), reply.JSON200.Author.Id)
+
+				read, err := cl.ConversationMarkReadWithResponse(root, id, lokiSession)
+				tests.Status(t, err, read, http.StatusNoContent)
+
+				list, err := cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, lokiSession)
+				tests.Ok(t, err, list)
+				assert.Equal(t, 0, list.JSON200.UnreadTotal)
+
+				list, err = cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, baldurSession)
+				tests.Ok(t, err, list)
+				assert.Equal(t, 1, list.JSON200.UnreadTotal)
+			})
+
+			t.Run("hidden_from_non_members", func(t *testing.T) {
+				get, err := cl.ConversationGetWithResponse(root, id, &openapi.ConversationGetParams{}, freyjaSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+
+				send, err := cl.ConversationMessageCreateWithResponse(root, id, openapi.ConversationMessageCreateJSONRequestBody{
+					Body: "<p>let me in</p>",
+				}, freyjaSession)
+				tests.Status(t, err, send, http.StatusNotFound)
+
+				guest, err := cl.ConversationGetWithResponse(root, id, &openapi.ConversationGetParams{})
+				tests.Status(t, err, guest, http.StatusUnauthorized)
+			})
+
+			t.Run("validation", func(t *testing.T) {
+				self, err := cl.ConversationCreateWithResponse(root, openapi.ConversationCreateJSONRequestBody{
+					Recipients: []openapi.Identifier{baldur.ID.String()},
+					Body:       "<p>note to self</p>",
+				}, baldurSession)
+				tests.Status(t, err, self, http.StatusBadRequest)
+
+				empty, err := cl.ConversationMessageCreateWithResponse(root, id, openapi.ConversationMessageCreateJSONRequestBody{
+					Body: "",
+				}, baldurSession)
+				tests.Status(t, err, empty, http.StatusBadRequest)
+			})
+
+			t.Run("group_conversation", func(t *testing.T) {
+				title := "Planning"
+				group, err := cl.ConversationCreateWithResponse(root, openapi.ConversationCreateJSONRequestBody{
+					Recipients: []openapi.Identifier{loki.ID.String(), freyja.ID.String()},
+					Title:      &title,
+					Body:       "<p>welcome</p>",
+				}, baldurSession)
+				tests.Ok(t, err, group)
+				assert.NotEqual(t, id, group.JSON200.Id)
+				assert.Len(t, group.JSON200.Participants, 3)
+
+				leave, err := cl.ConversationLeaveWithResponse(root, group.JSON200.Id, freyjaSession)
+				tests.Status(t, err, leave, http.StatusNoContent)
+
+				get, err := cl.ConversationGetWithResponse(root, group.JSON200.Id, &openapi.ConversationGetParams{}, freyjaSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+
+				get, err = cl.ConversationGetWithResponse(root, group.JSON200.Id, &openapi.ConversationGetParams{}, lokiSession)
+				tests.Ok(t, err, get)
+			})
+
+			t.Run("private_attachments", func(t *testing.T) {
+				content := []byte("attachment " + xid.New().String())
+
+				upload, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+					ContentLength: int64(len(content)),
+					Private:       opt.New(true).Ptr(),
+				}, "application/octet-stream", bytes.NewReader(content), baldurSession)
+				tests.Ok(t, err, upload)
+				name := upload.JSON200.Filename
+
+				before, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{}, lokiSession)
+				tests.Status(t, err, before, http.StatusNotFound)
+
+				stolen, err := cl.ConversationMessageCreateWithResponse(root, id, openapi.ConversationMessageCreateJSONRequestBody{
+					Body:     "<p>not mine</p>",
+					AssetIds: &openapi.AssetIDs{upload.JSON200.Id},
+				}, lokiSession)
+				tests.Status(t, err, stolen, http.StatusForbidden)
+
+				send, err := cl.ConversationMessageCreateWithResponse(root, id, openapi.ConversationMessageCreateJSONRequestBody{
+					Body:     "<p>see attached</p>",
+					AssetIds: &openapi.AssetIDs{upload.JSON200.Id},
+				}, baldurSession)
+				tests.Ok(t, err, send)
+				assert.Len(t, send.JSON200.Assets, 1)
+
+				after, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{}, lokiSession)
+				tests.Ok(t, err, after)
+				assert.Equal(t, content, after.Body)
+
+				outsider, err := cl.AssetGetWithResponse(root, name, &openapi.AssetGetParams{}, freyjaSession)
+				tests.Status(t, err, outsider, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,296 @@
+package search_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestSearchFullText(t *testing.T) {
+	t.Parallel()
+
+	// The fulltext provider uses Postgres or SQLite depending on DATABASE_URL.
+	integration.Test(t, &config.Config{SearchProvider: "fulltext", SearchLanguage: "english"}, e2e.Setup(), fx.Invoke(func(
+		root context.Context,
+		lc fx.Lifecycle,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			// A unique made-up word so results from other tests don't interfere.
+			word := "zorbl" + strings.ReplaceAll(uuid.NewString()[:8], "-", "")
+
+			newThread := func(t *testing.T, title, body string, vis openapi.Visibility) *openapi.Thread {
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New(body).Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(vis).Ptr(),
+					Title:      title,
+				}, memberSession)
+				tests.Ok(t, err, thread)
+				return thread.JSON200
+			}
+
+			inTitle := newThread(t, "all about "+word, "<p>nothing else to say</p>", openapi.Published)
+			inBody := newThread(t, "unrelated", "<p>a passing mention of "+word+" here</p>", openapi.Published)
+			draft := newThread(t, word, "<p>"+word+"</p>", openapi.Draft)
+			other := newThread(t, "unrelated", "<p>nothing to see</p>", openapi.Published)
+
+			published := openapi.Published
+			node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+				Name:       "node " + uuid.NewString(),
+				Content:    opt.New("<p>the library has a page on " + word + "</p>").Ptr(),
+				Visibility: &published,
+			}, adminSession)
+			tests.Ok(t, err, node)
+
+			t.Run("ranked", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: word}, memberSession)
+				tests.Ok(t, err, res)
+
+				ids := []string{}
+				for _, i := range res.JSON200.Items {
+					ids = append(ids, coerceDatagraphItem(i))
+				}
+
+				r.Len(ids, 3)
+				a.Equal(inTitle.Id, ids[0], "title matches are weighted above body matches")
+				a.Contains(ids, inBody.Id)
+				a.Contains(ids, node.JSON200.Id)
+				a.NotContains(ids, draft.Id)
+				a.NotContains(ids, other.Id)
+			})
+
+			t.Run("stemmed", func(t *testing.T) {
+				r := require.New(t)
+
+				thread := newThread(t, "stemming", "<p>the "+word+" was running quickly</p>", openapi.Published)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: word + " runs"}, memberSession)
+				tests.Ok(t, err, res)
+
+				r.NotNil(findItem(res.JSON200.Items, thread.Id))
+			})
+
+			t.Run("web_syntax", func(t *testing.T) {
+				a := assert.New(t)
+
+				search := func(q string) []string {
+					res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: q}, memberSession)
+					tests.Ok(t, err, res)
+
+					ids := []string{}
+					for _, i := range res.JSON200.Items {
+						ids = append(ids, coerceDatagraphItem(i))
+					}
+					return ids
+				}
+
+				phrase := search(`"passing mention of ` + word + `"`)
+				a.Equal([]string{inBody.Id}, phrase)
+
+				negated := search(word + " -passing")
+				a.Contains(negated, inTitle.Id)
+				a.NotContains(negated, inBody.Id)
+
+				either := search(`"nothing else to say" or "passing mention"`)
+				a.Contains(either, inTitle.Id)
+				a.Contains(either, inBody.Id)
+
+				a.Empty(search(`" -" or ( ) * NEAR AND`))
+			})
+
+			t.Run("updated_and_deleted", func(t *testing.T) {
+				r := require.New(t)
+
+				renamed := word + "renamed"
+				thread := newThread(t, "before the rename", "<p>nothing</p>", openapi.Published)
+
+				upd, err := cl.ThreadUpdateWithResponse(root, thread.Slug, openapi.ThreadMutableProps{Title: &renamed}, memberSession)
+				tests.Ok(t, err, upd)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: renamed}, memberSession)
+				tests.Ok(t, err, res)
+				r.Len(res.JSON200.Items, 1)
+				r.NotNil(findItem(res.JSON200.Items, thread.Id))
+
+				del, err := cl.ThreadDeleteWithResponse(root, thread.Slug, memberSession)
+				tests.Ok(t, err, del)
+
+				res, err = cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: renamed}, memberSession)
+				tests.Ok(t, err, res)
+				r.Empty(res.JSON200.Items)
+			})
+
+			t.Run("kind_filter", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:    word,
+					Kind: &[]openapi.DatagraphItemKind{openapi.DatagraphItemKindNode},
+				}, memberSession)
+				tests.Ok(t, err, res)
+
+				r.Len(res.JSON200.Items, 1)
+				r.NotNil(findItem(res.JSON200.Items, node.JSON200.Id))
+			})
+
+			t.Run("highlights", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: word}, memberSession)
+				tests.Ok(t, err, res)
+
+				r.NotNil(res.JSON200.Highlights)
+				highlights := *res.JSON200.Highlights
+				a.Contains(highlights[inBody.Id], "<mark>"+word+"</mark>")
+				a.NotContains(highlights[inBody.Id], "<p>")
+			})
+
+			t.Run("author_filter", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:      word,
+					Author: &[]string{admin.Handle},
+				}, memberSession)
+				tests.Ok(t, err, res)
+
+				r.Len(res.JSON200.Items, 1)
+				r.NotNil(findItem(res.JSON200.Items, node.JSON200.Id))
+			})
+
+			t.Run("category_and_solved_filter", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:        word,
+					Category: &[]string{cat.JSON200.Slug},
+				}, memberSession)
+				tests.Ok(t, err, res)
+
+				r.Len(res.JSON200.Items, 3)
+				a.Nil(findItem(res.JSON200.Items, node.JSON200.Id))
+
+				solved := true
+				res, err = cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:      word,
+					Solved: &solved,
+				}, memberSession)
+				tests.Ok(t, err, res)
+
+				a.Empty(res.JSON200.Items)
+			})
+
+			t.Run("facets", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: word}, memberSession)
+				tests.Ok(t, err, res)
+
+				r.NotNil(res.JSON200.Facets)
+				facets := res.JSON200.Facets
+
+				count := func(list openapi.SearchFacetCountList, value string) int {
+					for _, c := range list {
+						if c.Value == value {
+							return c.Count
+						}
+					}
+					return 0
+				}
+
+				a.Equal(3, count(facets.Kinds, "thread"))
+				a.Equal(1, count(facets.Kinds, "node"))
+				a.Equal(3, count(facets.Authors, member.Handle))
+				a.Equal(1, count(facets.Authors, admin.Handle))
+				a.Equal(3, count(facets.Categories, cat.JSON200.Slug))
+				a.Equal(0, facets.Solved)
+				a.Equal(3, facets.Unsolved)
+			})
+
+			t.Run("category_permissions", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				staff := tests.AssertRequest(cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "staff " + uuid.NewString(),
+					Colour:      "red",
+					Permissions: []openapi.Permission{},
+				}, adminSession))(t, http.StatusOK)
+
+				restricted := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, restricted.JSON200.Slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{
+						{RoleId: staff.JSON200.Id, Read: true, CreateThread: true, Reply: true},
+					},
+				}, adminSession))(t, http.StatusOK)
+
+				hidden := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>staff only " + word + "</p>").Ptr(),
+					Category:   opt.New(restricted.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "staff thread",
+				}, adminSession))(t, http.StatusOK)
+
+				search := func(t *testing.T, opts ...openapi.RequestEditorFn) *openapi.DatagraphSearchOK {
+					res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{Q: word}, opts...)
+					tests.Ok(t, err, res)
+					return res.JSON200
+				}
+
+				for name, opts := range map[string][]openapi.RequestEditorFn{
+					"guest":   nil,
+					"no_role": {memberSession},
+				} {
+					t.Run(name, func(t *testing.T) {
+						res := search(t, opts...)
+						a.Nil(findItem(res.Items, hidden.JSON200.Id))
+						a.NotNil(findItem(res.Items, inTitle.Id))
+
+						r.NotNil(res.Facets)
+						for _, c := range res.Facets.Categories {
+							a.NotEqual(restricted.JSON200.Slug, c.Value)
+						}
+						a.Equal(3, res.Facets.Unsolved)
+					})
+				}
+
+				t.Run("admin", func(t *testing.T) {
+					res := search(t, adminSession)
+					a.NotNil(findItem(res.Items, hidden.JSON200.Id))
+				})
+			})
+		}))
+	}))
+}
@@ -0,0 +1,85 @@
+package search_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestSearchIndexAdmin(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{}, e2e.Setup(), fx.Invoke(func(
+		root context.Context,
+		lc fx.Lifecycle,
+		cfg config.Config,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			t.Run("status", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.AdminSearchIndexStatusWithResponse(root, adminSession)
+				tests.Ok(t, err, res)
+
+				a.Equal(cfg.SemdexProvider, res.JSON200.Provider)
+				r.Len(res.JSON200.Health, 4)
+				for _, h := range res.JSON200.Health {
+					a.Equal(h.Published, h.Indexed+h.Stale+h.Missing)
+				}
+			})
+
+			t.Run("status_forbidden", func(t *testing.T) {
+				res, err := cl.AdminSearchIndexStatusWithResponse(root, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("rebuild_forbidden", func(t *testing.T) {
+				res, err := cl.AdminSearchIndexRebuildWithResponse(root, openapi.SearchIndexRebuildProps{}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("rebuild", func(t *testing.T) {
+				a := assert.New(t)
+
+				from := time.Now().Add(-time.Hour)
+				kinds := []openapi.DatagraphItemKind{openapi.DatagraphItemKindThread}
+
+				res, err := cl.AdminSearchIndexRebuildWithResponse(root, openapi.SearchIndexRebuildProps{
+					From:  &from,
+					Kinds: &kinds,
+				}, adminSession)
+
+				if cfg.SemdexProvider == "" {
+					tests.Status(t, err, res, http.StatusBadRequest)
+					return
+				}
+
+				tests.Ok(t, err, res)
+				a.False(res.JSON200.Full)
+				a.Len(res.JSON200.Progress, 1)
+			})
+		}))
+	}))
+}
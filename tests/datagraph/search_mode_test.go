@@ -0,0 +1,121 @@
+package search_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/opt"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestSearchModes(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{}, e2e.Setup(), fx.Invoke(func(
+		root context.Context,
+		lc fx.Lifecycle,
+		cfg config.Config,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			word := "zebra" + uuid.NewString()[:8]
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>a thread about the " + word + "</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "mode test",
+			}, memberSession)
+			tests.Ok(t, err, thread)
+
+			keyword := openapi.SearchModeKeyword
+			semantic := openapi.SearchModeSemantic
+			hybrid := openapi.SearchModeHybrid
+
+			t.Run("keyword", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:    word,
+					Mode: &keyword,
+				}, memberSession)
+				tests.Ok(t, err, res)
+				r.NotNil(findItem(res.JSON200.Items, thread.JSON200.Id))
+			})
+
+			t.Run("semantic_and_hybrid", func(t *testing.T) {
+				r := require.New(t)
+
+				sres, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:    word,
+					Mode: &semantic,
+				}, memberSession)
+				hres, herr := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:    word,
+					Mode: &hybrid,
+				}, memberSession)
+
+				if cfg.SemdexProvider == "" {
+					tests.Status(t, err, sres, http.StatusBadRequest)
+					tests.Status(t, herr, hres, http.StatusBadRequest)
+					return
+				}
+
+				tests.Ok(t, err, sres)
+				tests.Ok(t, herr, hres)
+				r.NotNil(findItem(hres.JSON200.Items, thread.JSON200.Id))
+			})
+
+			t.Run("keyword_author_filter", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:      word,
+					Mode:   &keyword,
+					Author: &[]string{member.Handle},
+				}, memberSession)
+				tests.Ok(t, err, res)
+				r.NotNil(findItem(res.JSON200.Items, thread.JSON200.Id))
+
+				res, err = cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:      word,
+					Mode:   &keyword,
+					Author: &[]string{"nobody-" + uuid.NewString()},
+				}, memberSession)
+				tests.Ok(t, err, res)
+				r.Nil(findItem(res.JSON200.Items, thread.JSON200.Id))
+			})
+
+			t.Run("invalid_mode", func(t *testing.T) {
+				invalid := openapi.SearchMode("vibes")
+
+				res, err := cl.DatagraphSearchWithResponse(root, &openapi.DatagraphSearchParams{
+					Q:    word,
+					Mode: &invalid,
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+		}))
+	}))
+}
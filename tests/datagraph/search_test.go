@@ -55,14 +55,14 @@ func TestSearchMultipleKinds(t *testing.T) {
 			hot := "<p>this contains the keyword we want</p>"
 			cold := "<p>this contains none of the words we want</p>"
 
-			t1, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+			t1, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 				Body:       opt.New(hot).Ptr(),
 				Category:   opt.New(cat1.JSON200.Id).Ptr(),
 				Visibility: opt.New(openapi.Published).Ptr(),
 				Title:      "thread",
 			}, session1)
 			tests.Ok(t, err, t1)
-			t2, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+			t2, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 				Body:       opt.New(cold).Ptr(),
 				Category:   opt.New(cat1.JSON200.Id).Ptr(),
 				Visibility: opt.New(openapi.Published).Ptr(),
@@ -223,14 +223,14 @@ func TestSearchVisibilityRules(t *testing.T) {
 			hot := "<p>this contains the keyword we want</p>"
 			cold := "<p>this contains the keyword we want but it's not published</p>"
 
-			t1, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+			t1, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 				Body:       opt.New(hot).Ptr(),
 				Category:   opt.New(cat1.JSON200.Id).Ptr(),
 				Visibility: opt.New(openapi.Published).Ptr(),
 				Title:      "thread",
 			}, session1)
 			tests.Ok(t, err, t1)
-			t2, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+			t2, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 				Body:       opt.New(cold).Ptr(),
 				Category:   opt.New(cat1.JSON200.Id).Ptr(),
 				Visibility: opt.New(openapi.Draft).Ptr(),
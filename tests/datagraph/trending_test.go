@@ -0,0 +1,145 @@
+package search_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/resources/trending"
+	"github.com/Southclaws/storyden/app/resources/trending/trending_writer"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestTrending(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		root context.Context,
+		lc fx.Lifecycle,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		tw *trending_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			newThread := func() string {
+				thr, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>trending</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "trending",
+				}, adminSession)
+				tests.Ok(t, err, thr)
+				return thr.JSON200.Id
+			}
+
+			t1 := newThread()
+			t2 := newThread()
+
+			visibility := openapi.Published
+			n1, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+				Name:       "trending " + xid.New().String(),
+				Visibility: &visibility,
+			}, adminSession)
+			tests.Ok(t, err, n1)
+
+			score := func(kind datagraph.Kind, id string, s float64) *trending.Score {
+				return &trending.Score{Kind: kind, ItemID: utils.Must(xid.FromString(id)), Score: s, ComputedAt: time.Now()}
+			}
+
+			err = tw.Replace(root, trending.WindowWeek, trending.Scores{
+				score(datagraph.KindThread, t1, 1),
+				score(datagraph.KindThread, t2, 5),
+				score(datagraph.KindNode, n1.JSON200.Id, 3),
+			})
+			r.NoError(err)
+
+			week := openapi.TrendingWindow("week")
+
+			t.Run("ranked_by_score", func(t *testing.T) {
+				res, err := cl.DatagraphTrendingWithResponse(root, &openapi.DatagraphTrendingParams{Window: &week})
+				tests.Ok(t, err, res)
+
+				a.Equal(week, res.JSON200.Window)
+				a.Equal([]string{t2, n1.JSON200.Id, t1}, dt.Map(res.JSON200.Items, coerceDatagraphItem))
+			})
+
+			t.Run("filter_by_kind", func(t *testing.T) {
+				kinds := []openapi.DatagraphItemKind{openapi.DatagraphItemKindThread}
+				res, err := cl.DatagraphTrendingWithResponse(root, &openapi.DatagraphTrendingParams{Window: &week, Kind: &kinds})
+				tests.Ok(t, err, res)
+
+				a.Equal([]string{t2, t1}, dt.Map(res.JSON200.Items, coerceDatagraphItem))
+			})
+
+			t.Run("default_window", func(t *testing.T) {
+				res, err := cl.DatagraphTrendingWithResponse(root, &openapi.DatagraphTrendingParams{})
+				tests.Ok(t, err, res)
+
+				a.Equal(openapi.TrendingWindow("day"), res.JSON200.Window)
+				a.NotContains(dt.Map(res.JSON200.Items, coerceDatagraphItem), t2)
+			})
+
+			t.Run("category_permissions", func(t *testing.T) {
+				restricted := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, restricted.JSON200.Slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{
+						{RoleId: role.DefaultRoleAdminID.String(), Read: true},
+					},
+				}, adminSession))(t, http.StatusOK)
+
+				hidden := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>trending</p>").Ptr(),
+					Category:   opt.New(restricted.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "trending",
+				}, adminSession))(t, http.StatusOK)
+
+				r.NoError(tw.Replace(root, trending.WindowMonth, trending.Scores{
+					score(datagraph.KindThread, t1, 1),
+					score(datagraph.KindThread, hidden.JSON200.Id, 5),
+				}))
+
+				month := openapi.TrendingWindow("month")
+
+				guest := tests.AssertRequest(cl.DatagraphTrendingWithResponse(root, &openapi.DatagraphTrendingParams{Window: &month}))(t, http.StatusOK)
+				a.Equal([]string{t1}, dt.Map(guest.JSON200.Items, coerceDatagraphItem))
+
+				admin := tests.AssertRequest(cl.DatagraphTrendingWithResponse(root, &openapi.DatagraphTrendingParams{Window: &month}, adminSession))(t, http.StatusOK)
+				a.Equal([]string{hidden.JSON200.Id, t1}, dt.Map(admin.JSON200.Items, coerceDatagraphItem))
+			})
+
+			t.Run("invalid_window", func(t *testing.T) {
+				invalid := openapi.TrendingWindow("year")
+				res, err := cl.DatagraphTrendingWithResponse(root, &openapi.DatagraphTrendingParams{Window: &invalid})
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+		}))
+	}))
+}
@@ -62,7 +62,7 @@ func TestGuestRolePermissions(t *testing.T) {
 			t.Run("guest_cannot_create_post", func(t *testing.T) {
 				// PermissionCreatePost
 				AssertRequest(
-					cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{}),
+					cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{}),
 				)(t, http.StatusUnauthorized)
 			})
 
@@ -74,7 +74,7 @@ func TestGuestRolePermissions(t *testing.T) {
 			})
 
 			t.Run("guest_cannot_create_reaction", func(t *testing.T) {
-				thread := AssertRequest(cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread := AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Title:      "guest_cannot_create_reaction" + uuid.NewString(),
 					Body:       opt.New("<body>This is a test thread.</body>").Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -174,7 +174,7 @@ func TestGuestRolePermissions(t *testing.T) {
 					}, adminSession),
 				)(t, http.StatusOK)
 
-				thread := AssertRequest(cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread := AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Title:      "guest_cannot_create_collection_item",
 					Body:       opt.New("<body>This is a test thread.</body>").Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -240,7 +240,7 @@ func TestGuestRoleWithPermissions(t *testing.T) {
 			Ok(t, err, edit)
 
 			t.Run("guest_can_read_published_threads", func(t *testing.T) {
-				thread := AssertRequest(cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread := AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Title:      "guest_can_read_published_threads" + uuid.NewString(),
 					Body:       opt.New("<body>This is a test thread.</body>").Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -350,7 +350,7 @@ func TestMemberRolePermissions(t *testing.T) {
 			t.Run("member_cannot_create_post", func(t *testing.T) {
 				// PermissionCreatePost
 				AssertRequest(
-					cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{}, member1Session),
+					cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{}, member1Session),
 				)(t, http.StatusForbidden)
 			})
 
@@ -362,7 +362,7 @@ func TestMemberRolePermissions(t *testing.T) {
 			})
 
 			t.Run("member_cannot_create_reaction", func(t *testing.T) {
-				thread := AssertRequest(cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread := AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Title:      "member_cannot_create_reaction" + uuid.NewString(),
 					Body:       opt.New("<body>This is a test thread.</body>").Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -466,7 +466,7 @@ func TestMemberRolePermissions(t *testing.T) {
 					}, adminSession),
 				)(t, http.StatusOK)
 
-				thread := AssertRequest(cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread := AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Title:      "member_cannot_create_collection_item",
 					Body:       opt.New("<body>This is a test thread.</body>").Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -512,7 +512,7 @@ func TestGuestVsMemberAccess(t *testing.T) {
 				Name: "TestGuestVsMemberAccess" + uuid.NewString(),
 			}, adminSession))(t, http.StatusOK)
 
-			AssertRequest(cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+			AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 				Title:      "guest_cannot_create_reaction" + uuid.NewString(),
 				Body:       opt.New("<body>This is a test thread.</body>").Ptr(),
 				Visibility: opt.New(openapi.Published).Ptr(),
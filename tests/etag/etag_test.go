@@ -0,0 +1,139 @@
+package etag_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func header(k, v string) openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(k, v)
+		return nil
+	}
+}
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>etag</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "etag " + uuid.NewString(),
+			}, memberSession)
+			tests.Ok(t, err, thread)
+
+			t.Run("thread", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				first, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil)
+				tests.Ok(t, err, first)
+				tag := first.HTTPResponse.Header.Get("ETag")
+				r.NotEmpty(tag)
+
+				same, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, header("If-None-Match", tag))
+				tests.Status(t, err, same, http.StatusNotModified)
+				a.Empty(same.Body)
+				a.Equal(tag, same.HTTPResponse.Header.Get("ETag"))
+
+				weak, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, header("If-None-Match", `"other", W/`+tag))
+				tests.Status(t, err, weak, http.StatusNotModified)
+
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+					Body: "<p>changed</p>",
+				}, adminSession)
+				tests.Ok(t, err, reply)
+
+				// If-None-Match wins over an If-Modified-Since which is still
+				// in the future and would otherwise have produced a 304.
+				future := time.Now().Add(time.Hour).UTC().Format(time.RFC1123)
+				changed, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil,
+					header("If-None-Match", tag),
+					header("If-Modified-Since", future),
+				)
+				tests.Ok(t, err, changed)
+				a.NotEqual(tag, changed.HTTPResponse.Header.Get("ETag"))
+				a.Len(changed.JSON200.Replies.Replies, 1)
+			})
+
+			t.Run("profile", func(t *testing.T) {
+				first, err := cl.ProfileGetWithResponse(root, admin.Handle)
+				tests.Ok(t, err, first)
+				tag := first.HTTPResponse.Header.Get("ETag")
+				require.NotEmpty(t, tag)
+
+				same, err := cl.ProfileGetWithResponse(root, admin.Handle, header("If-None-Match", tag))
+				tests.Status(t, err, same, http.StatusNotModified)
+			})
+
+			t.Run("node", func(t *testing.T) {
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+					Name:       "etag " + uuid.NewString(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, node)
+
+				first, err := cl.NodeGetWithResponse(root, node.JSON200.Slug, nil)
+				tests.Ok(t, err, first)
+				tag := first.HTTPResponse.Header.Get("ETag")
+				require.NotEmpty(t, tag)
+
+				same, err := cl.NodeGetWithResponse(root, node.JSON200.Slug, nil, header("If-None-Match", tag))
+				tests.Status(t, err, same, http.StatusNotModified)
+			})
+
+			t.Run("asset", func(t *testing.T) {
+				a := assert.New(t)
+
+				content := []byte("etag " + xid.New().String())
+				upload, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+					ContentLength: int64(len(content)),
+				}, "application/octet-stream", bytes.NewReader(content), adminSession)
+				tests.Ok(t, err, upload)
+
+				first, err := cl.AssetGetWithResponse(root, upload.JSON200.Filename, &openapi.AssetGetParams{})
+				tests.Ok(t, err, first)
+				a.Equal(content, first.Body)
+				tag := first.HTTPResponse.Header.Get("ETag")
+				require.NotEmpty(t, tag)
+
+				same, err := cl.AssetGetWithResponse(root, upload.JSON200.Filename, &openapi.AssetGetParams{}, header("If-None-Match", tag))
+				tests.Status(t, err, same, http.StatusNotModified)
+				a.Empty(same.Body)
+
+				wildcard, err := cl.AssetGetWithResponse(root, upload.JSON200.Filename, &openapi.AssetGetParams{}, header("If-None-Match", "*"))
+				tests.Status(t, err, wildcard, http.StatusNotModified)
+			})
+		}))
+	}))
+}
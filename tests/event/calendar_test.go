@@ -0,0 +1,153 @@
+package event_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/opt"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestEventsCalendar(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Colour: "#fe4efd", Description: "calendar testing", Name: "Category " + uuid.NewString()}, adminSession)
+			tests.Ok(t, err, cat)
+
+			newEvent := func(vis openapi.Visibility) *openapi.EventCreateResponse {
+				evt, err := cl.EventCreateWithResponse(root, openapi.EventInitialProps{
+					Name:                "Meetup " + uuid.NewString(),
+					Content:             "<body><p>meetup</p></body>",
+					TimeRange:           openapi.EventTimeRange{Start: time.Now().Add(time.Hour * 24), End: time.Now().Add(time.Hour * 26)},
+					ParticipationPolicy: openapi.EventParticipationPolicyOpen,
+					Visibility:          vis,
+					ThreadCategoryId:    cat.JSON200.Id,
+				}, adminSession)
+				tests.Ok(t, err, evt)
+				return evt
+			}
+
+			published := newEvent(openapi.Published)
+			unlisted := newEvent(openapi.Unlisted)
+			draft := newEvent(openapi.Draft)
+
+			tok, err := cl.AccountFeedTokenGetWithResponse(root, memberSession)
+			tests.Ok(t, err, tok)
+
+			t.Run("calendar", func(t *testing.T) {
+				resp, err := cl.CalendarGetWithResponse(root, nil)
+				tests.Ok(t, err, resp)
+
+				a.Contains(resp.HTTPResponse.Header.Get("Content-Type"), "text/calendar")
+				a.Equal("public, max-age=300", resp.HTTPResponse.Header.Get("Cache-Control"))
+				a.Contains(string(resp.Body), "BEGIN:VCALENDAR")
+				a.Contains(string(resp.Body), published.JSON200.Name)
+				a.NotContains(string(resp.Body), unlisted.JSON200.Name)
+				a.NotContains(string(resp.Body), draft.JSON200.Name)
+			})
+
+			t.Run("single_event", func(t *testing.T) {
+				resp, err := cl.EventCalendarGetWithResponse(root, unlisted.JSON200.Slug, nil)
+				tests.Ok(t, err, resp)
+				a.Contains(string(resp.Body), unlisted.JSON200.Name)
+
+				etag := resp.HTTPResponse.Header.Get("ETag")
+				again, err := cl.EventCalendarGetWithResponse(root, unlisted.JSON200.Slug, nil, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", etag)
+					return nil
+				})
+				tests.Status(t, err, again, http.StatusNotModified)
+
+				hidden, err := cl.EventCalendarGetWithResponse(root, draft.JSON200.Slug, nil)
+				tests.Status(t, err, hidden, http.StatusNotFound)
+			})
+
+			t.Run("rsvp_and_participating", func(t *testing.T) {
+				params := &openapi.CalendarParticipatingGetParams{Token: &tok.JSON200.Token}
+
+				anon, err := cl.CalendarParticipatingGetWithResponse(root, nil)
+				tests.Status(t, err, anon, http.StatusUnauthorized)
+
+				before, err := cl.CalendarParticipatingGetWithResponse(root, params)
+				tests.Ok(t, err, before)
+				a.Equal("private, max-age=300", before.HTTPResponse.Header.Get("Cache-Control"))
+				a.NotContains(string(before.Body), unlisted.JSON200.Name)
+
+				attend, err := cl.EventParticipantUpdateWithResponse(root, unlisted.JSON200.Slug, member.ID.String(), openapi.EventParticipantMutableProps{
+					Status: opt.New(openapi.EventParticipationStatusAttending).Ptr(),
+				}, memberSession)
+				tests.Ok(t, err, attend)
+
+				evt, err := cl.EventGetWithResponse(root, unlisted.JSON200.Slug, memberSession)
+				tests.Ok(t, err, evt)
+				r.Len(evt.JSON200.Participants, 2)
+
+				after, err := cl.CalendarParticipatingGetWithResponse(root, params)
+				tests.Ok(t, err, after)
+				a.Contains(string(after.Body), unlisted.JSON200.Name)
+				a.NotContains(string(after.Body), published.JSON200.Name)
+
+				remove, err := cl.EventParticipantRemoveWithResponse(root, unlisted.JSON200.Slug, member.ID.String(), memberSession)
+				tests.Ok(t, err, remove)
+
+				removed, err := cl.CalendarParticipatingGetWithResponse(root, params)
+				tests.Ok(t, err, removed)
+				a.NotContains(string(removed.Body), unlisted.JSON200.Name)
+			})
+
+			t.Run("update", func(t *testing.T) {
+				name := "Renamed meetup " + uuid.NewString()
+				update, err := cl.EventUpdateWithResponse(root, published.JSON200.Slug, openapi.EventMutableProps{
+					Name: &name,
+				}, adminSession)
+				tests.Ok(t, err, update)
+				a.Equal(name, update.JSON200.Name)
+
+				forbidden, err := cl.EventUpdateWithResponse(root, published.JSON200.Slug, openapi.EventMutableProps{
+					Name: &name,
+				}, memberSession)
+				tests.Status(t, err, forbidden, http.StatusForbidden)
+
+				resp, err := cl.CalendarGetWithResponse(root, nil)
+				tests.Ok(t, err, resp)
+				a.Contains(string(resp.Body), name)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				del, err := cl.EventDeleteWithResponse(root, published.JSON200.Slug, adminSession)
+				tests.Ok(t, err, del)
+
+				resp, err := cl.EventCalendarGetWithResponse(root, published.JSON200.Slug, nil)
+				tests.Status(t, err, resp, http.StatusNotFound)
+			})
+		}))
+	}))
+}
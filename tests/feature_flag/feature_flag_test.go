@@ -0,0 +1,150 @@
+package feature_flag_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestFeatureFlags(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			// Flag changes are picked up via the event bus, which starts after
+			// this hook, so the assertions run in a parallel test.
+			t.Run("rollout", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+				a := assert.New(t)
+
+				adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+				adminSession := sh.WithSession(adminCtx)
+
+				signup := func() (string, openapi.RequestEditorFn) {
+					handle := xid.New().String()
+					res, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: handle, Token: "password"})
+					tests.Ok(t, err, res)
+					return handle, sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(res.JSON200.Id)))))
+				}
+
+				_, member1 := signup()
+				handle2, member2 := signup()
+
+				key := "new-editor-" + xid.New().String()
+
+				features := func(session ...openapi.RequestEditorFn) []string {
+					res, err := cl.FeatureListWithResponse(root, session...)
+					tests.Ok(t, err, res)
+					return res.JSON200.Features
+				}
+				has := func(session ...openapi.RequestEditorFn) bool {
+					return slices.Contains(features(session...), key)
+				}
+				eventually := func(cond func() bool) {
+					r.Eventually(cond, 5*time.Second, 50*time.Millisecond)
+				}
+
+				t.Run("member_cannot_manage", func(t *testing.T) {
+					res, err := cl.AdminFeatureFlagListWithResponse(root, member1)
+					tests.Status(t, err, res, http.StatusForbidden)
+				})
+
+				t.Run("invalid", func(t *testing.T) {
+					res, err := cl.AdminFeatureFlagCreateWithResponse(root, openapi.FeatureFlagInitialProps{Key: "Not Valid"}, adminSession)
+					tests.Status(t, err, res, http.StatusBadRequest)
+
+					res, err = cl.AdminFeatureFlagCreateWithResponse(root, openapi.FeatureFlagInitialProps{Key: "valid", Percentage: opt.New(101).Ptr()}, adminSession)
+					tests.Status(t, err, res, http.StatusBadRequest)
+				})
+
+				beta, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "Beta " + xid.New().String(),
+					Permissions: []openapi.Permission{},
+				}, adminSession)
+				tests.Ok(t, err, beta)
+
+				created, err := cl.AdminFeatureFlagCreateWithResponse(root, openapi.FeatureFlagInitialProps{
+					Key:   key,
+					Roles: &[]openapi.Identifier{beta.JSON200.Id},
+				}, adminSession)
+				tests.Ok(t, err, created)
+				a.False(created.JSON200.Enabled)
+				a.Equal(0, created.JSON200.Percentage)
+
+				dup, err := cl.AdminFeatureFlagCreateWithResponse(root, openapi.FeatureFlagInitialProps{Key: key}, adminSession)
+				tests.Status(t, err, dup, http.StatusConflict)
+
+				addRole, err := cl.AccountAddRoleWithResponse(root, handle2, beta.JSON200.Id, adminSession)
+				tests.Ok(t, err, addRole)
+
+				t.Run("disabled_for_everyone", func(t *testing.T) {
+					a.False(has(member1))
+					a.False(has(member2))
+				})
+
+				update, err := cl.AdminFeatureFlagUpdateWithResponse(root, key, openapi.FeatureFlagMutableProps{Enabled: opt.New(true).Ptr()}, adminSession)
+				tests.Ok(t, err, update)
+				a.True(update.JSON200.Enabled)
+
+				t.Run("role_targeted", func(t *testing.T) {
+					eventually(func() bool { return has(member2) })
+					a.False(has(member1))
+					a.False(has())
+				})
+
+				update, err = cl.AdminFeatureFlagUpdateWithResponse(root, key, openapi.FeatureFlagMutableProps{Percentage: opt.New(100).Ptr()}, adminSession)
+				tests.Ok(t, err, update)
+
+				t.Run("full_rollout", func(t *testing.T) {
+					eventually(func() bool { return has(member1) })
+					a.True(has(member2))
+					a.True(has(), "guests see flags rolled out to everyone")
+				})
+
+				update, err = cl.AdminFeatureFlagUpdateWithResponse(root, key, openapi.FeatureFlagMutableProps{Enabled: opt.New(false).Ptr()}, adminSession)
+				tests.Ok(t, err, update)
+				a.Equal(100, update.JSON200.Percentage)
+
+				t.Run("kill_switch", func(t *testing.T) {
+					eventually(func() bool { return !has(member1) && !has(member2) && !has() })
+				})
+
+				t.Run("list_and_delete", func(t *testing.T) {
+					list, err := cl.AdminFeatureFlagListWithResponse(root, adminSession)
+					tests.Ok(t, err, list)
+					r.True(slices.ContainsFunc(list.JSON200.Flags, func(f openapi.FeatureFlag) bool { return f.Key == key }))
+
+					del, err := cl.AdminFeatureFlagDeleteWithResponse(root, key, adminSession)
+					tests.Status(t, err, del, http.StatusNoContent)
+
+					del, err = cl.AdminFeatureFlagDeleteWithResponse(root, key, adminSession)
+					tests.Status(t, err, del, http.StatusNotFound)
+				})
+			})
+		}))
+	}))
+}
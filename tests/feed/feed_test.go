@@ -0,0 +1,144 @@
+package feed_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestFeed(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Colour:      "#fe4efd",
+				Description: "feed",
+				Name:        "Category " + xid.New().String(),
+			}, adminSession)
+			tests.Ok(t, err, cat)
+
+			newMember := func(t *testing.T) (openapi.RequestEditorFn, string) {
+				handle := xid.New().String()
+				acc, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: handle, Token: "password"})
+				tests.Ok(t, err, acc)
+				id := account.AccountID(utils.Must(xid.FromString(acc.JSON200.Id)))
+				return sh.WithSession(e2e.WithAccountID(root, id)), handle
+			}
+
+			newThread := func(t *testing.T, session openapi.RequestEditorFn) *openapi.Thread {
+				thr, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>feed</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "thread",
+				}, session)
+				tests.Ok(t, err, thr)
+				return thr.JSON200
+			}
+
+			feedIDs := func(t *testing.T, session openapi.RequestEditorFn, mode *openapi.FeedMode) []string {
+				feed, err := cl.FeedListWithResponse(root, &openapi.FeedListParams{Mode: mode}, session)
+				tests.Ok(t, err, feed)
+				return dt.Map(feed.JSON200.Threads, func(th openapi.ThreadReference) string { return th.Id })
+			}
+
+			t.Run("ranking_modes", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				authorSession, authorHandle := newMember(t)
+				followerSession, _ := newMember(t)
+				otherSession, _ := newMember(t)
+
+				popular := newThread(t, authorSession)
+
+				for range 3 {
+					reply, err := cl.ReplyCreateWithResponse(root, popular.Slug, nil, openapi.ReplyInitialProps{Body: "reply"}, otherSession)
+					tests.Ok(t, err, reply)
+				}
+
+				like, err := cl.LikePostAddWithResponse(root, popular.Id, otherSession)
+				tests.Ok(t, err, like)
+
+				quiet := newThread(t, authorSession)
+
+				follow, err := cl.ProfileFollowersAddWithResponse(root, authorHandle, followerSession)
+				tests.Ok(t, err, follow)
+
+				latest := feedIDs(t, followerSession, opt.New(openapi.FeedMode("latest")).Ptr())
+				r.Len(latest, 2)
+				a.Equal(quiet.Id, latest[0])
+				a.Equal(popular.Id, latest[1])
+
+				a.Equal(latest, feedIDs(t, followerSession, nil), "latest is the default mode")
+
+				topIDs := feedIDs(t, followerSession, opt.New(openapi.FeedMode("top")).Ptr())
+				r.Len(topIDs, 2)
+				a.Equal(popular.Id, topIDs[0])
+				a.Equal(quiet.Id, topIDs[1])
+
+				recommended := feedIDs(t, followerSession, opt.New(openapi.FeedMode("recommended")).Ptr())
+				r.Len(recommended, 2)
+				a.Equal(popular.Id, recommended[0])
+			})
+
+			t.Run("participation", func(t *testing.T) {
+				t.Parallel()
+
+				a := assert.New(t)
+
+				authorSession, _ := newMember(t)
+				memberSession, _ := newMember(t)
+
+				thr := newThread(t, authorSession)
+				own := newThread(t, memberSession)
+
+				a.NotContains(feedIDs(t, memberSession, nil), thr.Id)
+
+				reply, err := cl.ReplyCreateWithResponse(root, thr.Slug, nil, openapi.ReplyInitialProps{Body: "reply"}, memberSession)
+				tests.Ok(t, err, reply)
+
+				ids := feedIDs(t, memberSession, nil)
+				a.Contains(ids, thr.Id, "threads the member replied to are included")
+				a.NotContains(ids, own.Id, "the member's own threads are excluded")
+			})
+
+			t.Run("invalid_mode", func(t *testing.T) {
+				t.Parallel()
+
+				memberSession, _ := newMember(t)
+
+				feed, err := cl.FeedListWithResponse(root, &openapi.FeedListParams{Mode: opt.New(openapi.FeedMode("chronological")).Ptr()}, memberSession)
+				tests.Status(t, err, feed, http.StatusBadRequest)
+			})
+		}))
+	}))
+}
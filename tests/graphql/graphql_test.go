@@ -0,0 +1,237 @@
+package graphql_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/graphql"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func TestGraphQL(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{GraphQLEnabled: true, GraphQLMaxDepth: 8}, e2e.Setup(), graphql.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		ts *httptest.Server,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			query := func(t *testing.T, ctx context.Context, q string, vars map[string]any, out any) response {
+				t.Helper()
+
+				body, err := json.Marshal(map[string]any{"query": q, "variables": vars})
+				require.NoError(t, err)
+
+				req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+"/graphql", bytes.NewReader(body))
+				require.NoError(t, err)
+				req.Header.Set("Content-Type", "application/json")
+				if ctx != root {
+					require.NoError(t, sh.WithSession(ctx)(ctx, req))
+				}
+
+				resp, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				defer resp.Body.Close()
+				require.Equal(t, http.StatusOK, resp.StatusCode)
+
+				var r response
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&r))
+				if out != nil && len(r.Data) > 0 {
+					require.NoError(t, json.Unmarshal(r.Data, out))
+				}
+				return r
+			}
+
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			published, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>graphql</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "graphql " + uuid.NewString(),
+			}, memberSession)
+			tests.Ok(t, err, published)
+
+			reply, err := cl.ReplyCreateWithResponse(root, published.JSON200.Slug, nil, openapi.ReplyInitialProps{
+				Body: "<p>a reply</p>",
+			}, adminSession)
+			tests.Ok(t, err, reply)
+
+			draft, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>draft</p>").Ptr(),
+				Visibility: opt.New(openapi.Draft).Ptr(),
+				Title:      "graphql draft " + uuid.NewString(),
+			}, memberSession)
+			tests.Ok(t, err, draft)
+
+			t.Run("thread", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				var out struct {
+					Thread struct {
+						ID     string `json:"id"`
+						Title  string `json:"title"`
+						Author struct {
+							Handle string `json:"handle"`
+						} `json:"author"`
+						Replies struct {
+							PageInfo struct {
+								Results int `json:"results"`
+							} `json:"pageInfo"`
+							Replies []struct {
+								Body   string `json:"body"`
+								Author struct {
+									Handle string `json:"handle"`
+								} `json:"author"`
+							} `json:"replies"`
+						} `json:"replies"`
+					} `json:"thread"`
+				}
+
+				res := query(t, root, `query($mark: String!) {
+					thread(mark: $mark) {
+						id title
+						author { handle }
+						replies { pageInfo { results } replies { body author { handle } } }
+					}
+				}`, map[string]any{"mark": published.JSON200.Slug}, &out)
+				r.Empty(res.Errors)
+
+				a.Equal(published.JSON200.Id, out.Thread.ID)
+				a.Equal(published.JSON200.Title, out.Thread.Title)
+				a.Equal(member.Handle, out.Thread.Author.Handle)
+				r.Len(out.Thread.Replies.Replies, 1)
+				a.Equal(1, out.Thread.Replies.PageInfo.Results)
+				a.Equal(admin.Handle, out.Thread.Replies.Replies[0].Author.Handle)
+				a.Contains(out.Thread.Replies.Replies[0].Body, "a reply")
+			})
+
+			t.Run("threads_with_authors", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				var out struct {
+					Threads struct {
+						Threads []struct {
+							ID     string `json:"id"`
+							Author struct {
+								ID     string `json:"id"`
+								Handle string `json:"handle"`
+							} `json:"author"`
+						} `json:"threads"`
+					} `json:"threads"`
+				}
+
+				res := query(t, root, `query($author: String) {
+					threads(author: $author) { threads { id author { id handle } } }
+				}`, map[string]any{"author": member.Handle}, &out)
+				r.Empty(res.Errors)
+
+				ids := []string{}
+				for _, th := range out.Threads.Threads {
+					ids = append(ids, th.ID)
+					a.Equal(member.ID.String(), th.Author.ID)
+					a.Equal(member.Handle, th.Author.Handle)
+				}
+				a.Contains(ids, published.JSON200.Id)
+				a.NotContains(ids, draft.JSON200.Id)
+			})
+
+			t.Run("profile", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				var out struct {
+					Profile *struct {
+						Handle  string `json:"handle"`
+						Threads struct {
+							Threads []struct {
+								ID string `json:"id"`
+							} `json:"threads"`
+						} `json:"threads"`
+					} `json:"profile"`
+				}
+
+				res := query(t, root, `query($handle: String!) {
+					profile(handle: $handle) { handle threads { threads { id } } }
+				}`, map[string]any{"handle": member.Handle}, &out)
+				r.Empty(res.Errors)
+				r.NotNil(out.Profile)
+				a.Equal(member.Handle, out.Profile.Handle)
+				a.NotEmpty(out.Profile.Threads.Threads)
+			})
+
+			t.Run("not_found_is_null", func(t *testing.T) {
+				a := assert.New(t)
+
+				var out struct {
+					Thread  *struct{ ID string } `json:"thread"`
+					Profile *struct{ ID string } `json:"profile"`
+				}
+
+				res := query(t, root, `{ thread(mark: "nonexistent") { id } profile(handle: "nonexistent") { id } }`, nil, &out)
+				a.Empty(res.Errors)
+				a.Nil(out.Thread)
+				a.Nil(out.Profile)
+			})
+
+			t.Run("draft_hidden", func(t *testing.T) {
+				a := assert.New(t)
+
+				var out struct {
+					Thread *struct{ ID string } `json:"thread"`
+				}
+
+				q := `query($mark: String!) { thread(mark: $mark) { id } }`
+				vars := map[string]any{"mark": draft.JSON200.Id}
+
+				res := query(t, root, q, vars, &out)
+				a.NotEmpty(res.Errors)
+				a.Nil(out.Thread)
+
+				res = query(t, memberCtx, q, vars, &out)
+				a.Empty(res.Errors)
+				a.NotNil(out.Thread)
+			})
+
+			t.Run("max_depth", func(t *testing.T) {
+				res := query(t, root, `{
+					threads { threads { author { threads { threads { author { threads { threads { author { handle } } } } } } } } }
+				}`, nil, nil)
+				require.NotEmpty(t, res.Errors)
+				assert.Empty(t, res.Data)
+			})
+		}))
+	}))
+}
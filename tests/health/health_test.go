@@ -0,0 +1,91 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/system/health"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+)
+
+type dependency struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Critical bool   `json:"critical"`
+}
+
+type report struct {
+	Status       string       `json:"status"`
+	Ready        bool         `json:"ready"`
+	Draining     bool         `json:"draining"`
+	Dependencies []dependency `json:"dependencies"`
+}
+
+func get(t *testing.T, url string) (int, report) {
+	t.Helper()
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var r report
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&r))
+
+	return resp.StatusCode, r
+}
+
+func TestHealth(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		ts *httptest.Server,
+		hc *health.Checker,
+	) {
+		lc.Append(fx.StartHook(func() {
+			t.Run("liveness", func(t *testing.T) {
+				code, r := get(t, ts.URL+"/healthz")
+				assert.Equal(t, http.StatusOK, code)
+				assert.Equal(t, "ok", r.Status)
+
+				names := lo.Map(r.Dependencies, func(d dependency, _ int) string { return d.Name })
+				assert.ElementsMatch(t, []string{"database", "object_storage", "queue", "cache"}, names)
+
+				for _, d := range r.Dependencies {
+					assert.Equal(t, "ok", d.Status, d.Name)
+					assert.Equal(t, d.Name == "database", d.Critical, d.Name)
+				}
+			})
+
+			t.Run("readiness", func(t *testing.T) {
+				code, r := get(t, ts.URL+"/readyz")
+				assert.Equal(t, http.StatusOK, code)
+				assert.True(t, r.Ready)
+				assert.False(t, r.Draining)
+			})
+
+			t.Run("draining", func(t *testing.T) {
+				hc.Drain()
+
+				code, r := get(t, ts.URL+"/readyz")
+				assert.Equal(t, http.StatusServiceUnavailable, code)
+				assert.False(t, r.Ready)
+				assert.True(t, r.Draining)
+
+				// Draining only affects readiness, the process is still alive.
+				code, _ = get(t, ts.URL+"/healthz")
+				assert.Equal(t, http.StatusOK, code)
+			})
+		}))
+	}))
+}
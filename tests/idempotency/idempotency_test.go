@@ -0,0 +1,114 @@
+package idempotency_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+			otherSession := sh.WithSession(otherCtx)
+
+			title := "idempotent " + uuid.NewString()
+			props := openapi.ThreadInitialProps{
+				Body:       opt.New("<p>idempotent</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      title,
+			}
+
+			t.Run("thread_create", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				key := &openapi.ThreadCreateParams{IdempotencyKey: opt.New(uuid.NewString()).Ptr()}
+
+				first, err := cl.ThreadCreateWithResponse(root, key, props, memberSession)
+				tests.Ok(t, err, first)
+				a.Empty(first.HTTPResponse.Header.Get("Idempotency-Replayed"))
+
+				retry, err := cl.ThreadCreateWithResponse(root, key, props, memberSession)
+				tests.Ok(t, err, retry)
+				a.Equal("true", retry.HTTPResponse.Header.Get("Idempotency-Replayed"))
+				a.Equal(first.JSON200.Id, retry.JSON200.Id)
+
+				list, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{Author: &member.Handle})
+				tests.Ok(t, err, list)
+				matching := 0
+				for _, th := range list.JSON200.Threads {
+					if th.Title == title {
+						matching++
+					}
+				}
+				a.Equal(1, matching, "the retry must not create a second thread")
+
+				changed := props
+				changed.Title = "something else"
+				mismatch, err := cl.ThreadCreateWithResponse(root, key, changed, memberSession)
+				tests.Status(t, err, mismatch, http.StatusUnprocessableEntity)
+
+				// Keys are scoped to the account which sent them.
+				other, err := cl.ThreadCreateWithResponse(root, key, props, otherSession)
+				tests.Ok(t, err, other)
+				r.NotEqual(first.JSON200.Id, other.JSON200.Id)
+
+				fresh, err := cl.ThreadCreateWithResponse(root, nil, props, memberSession)
+				tests.Ok(t, err, fresh)
+				a.NotEqual(first.JSON200.Id, fresh.JSON200.Id)
+			})
+
+			t.Run("reply_create", func(t *testing.T) {
+				a := assert.New(t)
+
+				thread, err := cl.ThreadCreateWithResponse(root, nil, props, memberSession)
+				tests.Ok(t, err, thread)
+
+				key := &openapi.ReplyCreateParams{IdempotencyKey: opt.New(uuid.NewString()).Ptr()}
+				reply := openapi.ReplyInitialProps{Body: "<p>only once</p>"}
+
+				first, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, key, reply, otherSession)
+				tests.Ok(t, err, first)
+
+				retry, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, key, reply, otherSession)
+				tests.Ok(t, err, retry)
+				a.Equal(first.JSON200.Id, retry.JSON200.Id)
+
+				got, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil)
+				tests.Ok(t, err, got)
+				a.Len(got.JSON200.Replies.Replies, 1)
+
+				// The same key on a different thread is a different request.
+				elsewhere, err := cl.ThreadCreateWithResponse(root, nil, props, memberSession)
+				tests.Ok(t, err, elsewhere)
+				moved, err := cl.ReplyCreateWithResponse(root, elsewhere.JSON200.Slug, key, reply, otherSession)
+				tests.Ok(t, err, moved)
+				a.NotEqual(first.JSON200.Id, moved.JSON200.Id)
+			})
+		}))
+	}))
+}
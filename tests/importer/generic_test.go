@@ -0,0 +1,166 @@
+package importer_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestGenericImport(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			suffix := xid.New().String()
+			id := func(s string) string { return s + "-" + suffix }
+
+			doc := openapi.ImportDocument{
+				Members: &[]openapi.ImportDocumentMember{
+					{Id: id("m1"), Handle: "ada-" + suffix, Name: opt.New("Ada").Ptr(), CreatedAt: opt.New(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)).Ptr()},
+					{Id: id("m2"), Handle: "charles-" + suffix},
+				},
+				Categories: &[]openapi.ImportDocumentCategory{
+					{Id: id("c1"), Name: "Engines " + suffix},
+				},
+				Threads: &[]openapi.ImportDocumentThread{
+					{Id: id("t1"), CategoryId: opt.New(id("c1")).Ptr(), AuthorId: opt.New(id("m1")).Ptr(), Title: "Note G", Body: "<p>Bernoulli</p>"},
+				},
+				Posts: &[]openapi.ImportDocumentPost{
+					{Id: id("p1"), ThreadId: id("t1"), AuthorId: opt.New(id("m2")).Ptr(), Body: "<p>Splendid</p>"},
+					{Id: id("p2"), ThreadId: id("t1"), ReplyToId: opt.New(id("p1")).Ptr(), AuthorId: opt.New(id("m1")).Ptr(), Body: "<p>Thank you</p>"},
+				},
+				Likes: &[]openapi.ImportDocumentLike{
+					{ThreadId: opt.New(id("t1")).Ptr(), AuthorId: id("m2")},
+				},
+			}
+
+			send := func(t *testing.T, doc openapi.ImportDocument) *openapi.AdminImportGetResponse {
+				start, err := cl.AdminImportDocumentWithResponse(root, doc, adminSession)
+				tests.Ok(t, err, start)
+				assert.Equal(t, openapi.Generic, start.JSON200.Source)
+				return waitForImport(t, root, cl, adminSession, start.JSON200.Id)
+			}
+
+			threadID := func(t *testing.T, run *openapi.AdminImportGetResponse) string {
+				records, err := cl.AdminImportRecordListWithResponse(root, run.JSON200.Id, &openapi.AdminImportRecordListParams{
+					Kind: opt.New(openapi.ImportRecordKindThread).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, records)
+				require.Len(t, records.JSON200.Records, 1)
+				return *records.JSON200.Records[0].InternalId
+			}
+
+			first := send(t, doc)
+			counts := stats(first)
+			assert.Equal(t, 2, counts[openapi.ImportRecordKindMember].Imported)
+			assert.Equal(t, 1, counts[openapi.ImportRecordKindCategory].Imported)
+			assert.Equal(t, 1, counts[openapi.ImportRecordKindThread].Imported)
+			assert.Equal(t, 2, counts[openapi.ImportRecordKindPost].Imported)
+			assert.Equal(t, 1, counts[openapi.ImportRecordKindLike].Imported)
+
+			imported := threadID(t, first)
+
+			t.Run("content", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				thread, err := cl.ThreadGetWithResponse(root, imported, nil)
+				tests.Ok(t, err, thread)
+				a.Equal("Note G", thread.JSON200.Title)
+				a.Equal("Ada", thread.JSON200.Author.Name)
+				r.NotNil(thread.JSON200.Category)
+				a.Equal("Engines "+suffix, thread.JSON200.Category.Name)
+				a.Equal(1, thread.JSON200.Likes.Likes)
+				r.Len(thread.JSON200.Replies.Replies, 2)
+				a.Equal("charles-"+suffix, thread.JSON200.Replies.Replies[0].Author.Handle)
+				a.Contains(thread.JSON200.Replies.Replies[1].Body, "Thank you")
+			})
+
+			t.Run("idempotent", func(t *testing.T) {
+				again := send(t, doc)
+				assert.Empty(t, again.JSON200.Stats, "nothing is imported twice")
+
+				thread, err := cl.ThreadGetWithResponse(root, imported, nil)
+				tests.Ok(t, err, thread)
+				assert.Len(t, thread.JSON200.Replies.Replies, 2)
+			})
+
+			t.Run("refers_to_earlier_import", func(t *testing.T) {
+				more := send(t, openapi.ImportDocument{
+					Posts: &[]openapi.ImportDocumentPost{
+						{Id: id("p3"), ThreadId: id("t1"), AuthorId: opt.New(id("m2")).Ptr(), Body: "<p>Later</p>"},
+					},
+				})
+				assert.Equal(t, 1, stats(more)[openapi.ImportRecordKindPost].Imported)
+
+				thread, err := cl.ThreadGetWithResponse(root, imported, nil)
+				tests.Ok(t, err, thread)
+				require.Len(t, thread.JSON200.Replies.Replies, 3)
+				assert.Equal(t, "charles-"+suffix, thread.JSON200.Replies.Replies[2].Author.Handle)
+			})
+
+			t.Run("csv", func(t *testing.T) {
+				archive := tarball(t, map[string][]byte{
+					"export/members.csv": []byte("handle,id\ngrace-" + suffix + "," + id("m3") + "\n"),
+					"export/threads.csv": []byte("id,author_id,title,body,created_at\n" +
+						fmt.Sprintf("%s,%s,\"Compilers, briefly\",\"<p>A, B</p>\",2020-01-02T03:04:05Z\n", id("t2"), id("m3"))),
+					"export/posts.csv": []byte("id,thread_id,author_id,body\n" + id("p4") + "," + id("t2") + "," + id("m1") + ",<p>Yes</p>\n"),
+				})
+
+				run := importArchive(t, root, cl, adminSession, openapi.Generic, archive)
+				counts := stats(run)
+				assert.Equal(t, 1, counts[openapi.ImportRecordKindMember].Imported)
+				assert.Equal(t, 1, counts[openapi.ImportRecordKindThread].Imported)
+				assert.Equal(t, 1, counts[openapi.ImportRecordKindPost].Imported)
+
+				thread, err := cl.ThreadGetWithResponse(root, threadID(t, run), nil)
+				tests.Ok(t, err, thread)
+				assert.Equal(t, "Compilers, briefly", thread.JSON200.Title)
+				assert.Equal(t, "grace-"+suffix, thread.JSON200.Author.Handle)
+				require.Len(t, thread.JSON200.Replies.Replies, 1)
+				assert.Equal(t, "Ada", thread.JSON200.Replies.Replies[0].Author.Name, "members from earlier imports are found by ID")
+			})
+
+			t.Run("invalid", func(t *testing.T) {
+				res, err := cl.AdminImportDocumentWithResponse(root, openapi.ImportDocument{
+					Posts: &[]openapi.ImportDocumentPost{
+						{Id: id("p5"), Body: "<p>Nowhere</p>"},
+						{Id: id("p5"), ThreadId: id("t1"), Body: "<p>Twice</p>"},
+					},
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminImportDocumentWithResponse(root, doc, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,238 @@
+package importer_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// backup builds a minimal Discourse backup with two members, a category, a
+// thread with an image, a reply and a like.
+func backup(t *testing.T, suffix string, base int) []byte {
+	t.Helper()
+
+	id := func(n int) int { return base + n }
+
+	dump := fmt.Sprintf(`COPY public.users (id, username, name, created_at) FROM stdin;
+-1	system	system	2020-01-01 00:00:00
+%[2]d	author%[1]s	The Author	2020-01-02 00:00:00
+%[3]d	replier%[1]s	\N	2020-01-03 00:00:00
+\.
+
+COPY public.user_emails (id, user_id, email, "primary") FROM stdin;
+1	%[2]d	author%[1]s@example.com	t
+\.
+
+COPY public.categories (id, name, slug, color, description, parent_category_id, "position") FROM stdin;
+%[4]d	Imported %[1]s	imported-%[1]s	0088CC	Things from Discourse	\N	1
+\.
+
+COPY public.topics (id, title, created_at, user_id, category_id, archetype, deleted_at) FROM stdin;
+%[5]d	Hello from Discourse	2020-02-01 00:00:00	%[2]d	%[4]d	regular	\N
+\.
+
+COPY public.posts (id, user_id, topic_id, post_number, cooked, created_at, reply_to_post_number, post_type, deleted_at, hidden) FROM stdin;
+%[6]d	%[2]d	%[5]d	1	<p>Look <img src="/uploads/default/original/1X/pic.png"></p>	2020-02-01 00:00:00	\N	1	\N	f
+%[7]d	%[3]d	%[5]d	2	<p>Nice picture</p>	2020-02-02 00:00:00	\N	1	\N	f
+%[8]d	-1	%[5]d	3	<p>This topic was automatically closed</p>	2020-02-03 00:00:00	\N	3	\N	f
+\.
+
+COPY public.uploads (id, user_id, original_filename, url) FROM stdin;
+%[9]d	%[2]d	pic.png	/uploads/default/original/1X/pic.png
+\.
+
+COPY public.post_actions (id, post_id, user_id, post_action_type_id, deleted_at) FROM stdin;
+1	%[6]d	%[3]d	2	\N
+\.
+`, suffix, id(1), id(2), id(3), id(4), id(5), id(6), id(7), id(8))
+
+	var sql bytes.Buffer
+	gz := gzip.NewWriter(&sql)
+	_, err := gz.Write([]byte(dump))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	return tarball(t, map[string][]byte{
+		"dump.sql.gz":                         sql.Bytes(),
+		"uploads/default/original/1X/pic.png": []byte("not really a png"),
+	})
+}
+
+func TestDiscourseImport(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			suffix := xid.New().String()[12:]
+			archive := backup(t, suffix, rand.IntN(1_000_000)*10)
+
+			run := func(t *testing.T) *openapi.AdminImportGetResponse {
+				return importArchive(t, root, cl, adminSession, openapi.Discourse, archive)
+			}
+
+			first := run(t)
+
+			counts := stats(first)
+			a.Equal(2, counts[openapi.ImportRecordKindMember].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindMember].Skipped, "the system account is skipped")
+			a.Equal(1, counts[openapi.ImportRecordKindCategory].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindUpload].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindThread].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindPost].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindPost].Skipped, "automated notices are skipped")
+			a.Equal(1, counts[openapi.ImportRecordKindLike].Imported)
+
+			threads, err := cl.AdminImportRecordListWithResponse(root, first.JSON200.Id, &openapi.AdminImportRecordListParams{
+				Kind: opt.New(openapi.ImportRecordKindThread).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, threads)
+			r.Len(threads.JSON200.Records, 1)
+			r.NotNil(threads.JSON200.Records[0].InternalId)
+
+			thread, err := cl.ThreadGetWithResponse(root, *threads.JSON200.Records[0].InternalId, nil)
+			tests.Ok(t, err, thread)
+			a.Equal("Hello from Discourse", thread.JSON200.Title)
+			a.Equal("author"+suffix, thread.JSON200.Author.Handle)
+			a.Equal(2020, thread.JSON200.CreatedAt.Year())
+			r.NotNil(thread.JSON200.Category)
+			a.Equal("imported-"+suffix, thread.JSON200.Category.Slug)
+			a.Equal(1, thread.JSON200.Likes.Likes)
+			r.Len(thread.JSON200.Assets, 1)
+			a.Contains(thread.JSON200.Body, "/api/assets/"+thread.JSON200.Assets[0].Filename)
+			r.Len(thread.JSON200.Replies.Replies, 1)
+			a.Equal("replier"+suffix, thread.JSON200.Replies.Replies[0].Author.Handle)
+
+			skipped, err := cl.AdminImportRecordListWithResponse(root, first.JSON200.Id, &openapi.AdminImportRecordListParams{
+				Status: opt.New(openapi.ImportRecordStatusSkipped).Ptr(),
+				Kind:   opt.New(openapi.ImportRecordKindPost).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, skipped)
+			r.Len(skipped.JSON200.Records, 1)
+			a.Equal("whisper or automated notice", *skipped.JSON200.Records[0].Message)
+
+			t.Run("reimport", func(t *testing.T) {
+				second := run(t)
+				assert.Empty(t, second.JSON200.Stats, "everything was already imported")
+
+				again, err := cl.ThreadGetWithResponse(root, thread.JSON200.Id, nil)
+				tests.Ok(t, err, again)
+				assert.Len(t, again.JSON200.Replies.Replies, 1)
+
+				list, err := cl.AdminImportListWithResponse(root, nil, adminSession)
+				tests.Ok(t, err, list)
+				assert.Len(t, list.JSON200.Imports, 2)
+			})
+
+			t.Run("resume_requires_failure", func(t *testing.T) {
+				res, err := cl.AdminImportResumeWithResponse(root, first.JSON200.Id, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminImportStartWithResponse(root, openapi.ImportRunInitialProps{
+					Source:  openapi.Discourse,
+					AssetId: *first.JSON200.AssetId,
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				list, err := cl.AdminImportListWithResponse(root, nil, memberSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+			})
+		}))
+	}))
+}
+
+// importArchive uploads an archive, imports it and waits for it to finish.
+func importArchive(t *testing.T, root context.Context, cl *openapi.ClientWithResponses, session openapi.RequestEditorFn, source openapi.ImportSource, archive []byte) *openapi.AdminImportGetResponse {
+	t.Helper()
+
+	upload, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+		ContentLength: int64(len(archive)),
+		Private:       opt.New(true).Ptr(),
+	}, "application/octet-stream", bytes.NewReader(archive), session)
+	tests.Ok(t, err, upload)
+
+	start, err := cl.AdminImportStartWithResponse(root, openapi.ImportRunInitialProps{
+		Source:  source,
+		AssetId: upload.JSON200.Id,
+	}, session)
+	tests.Ok(t, err, start)
+	assert.Equal(t, openapi.ImportRunStatusPending, start.JSON200.Status)
+
+	return waitForImport(t, root, cl, session, start.JSON200.Id)
+}
+
+// waitForImport waits for an import to finish and requires it to succeed.
+func waitForImport(t *testing.T, root context.Context, cl *openapi.ClientWithResponses, session openapi.RequestEditorFn, id openapi.Identifier) *openapi.AdminImportGetResponse {
+	t.Helper()
+
+	var get *openapi.AdminImportGetResponse
+	require.Eventually(t, func() bool {
+		var err error
+		get, err = cl.AdminImportGetWithResponse(root, id, session)
+		tests.Ok(t, err, get)
+		return get.JSON200.Status == openapi.ImportRunStatusSucceeded ||
+			get.JSON200.Status == openapi.ImportRunStatusFailed
+	}, 30*time.Second, 100*time.Millisecond)
+	require.Equal(t, openapi.ImportRunStatusSucceeded, get.JSON200.Status, get.JSON200.Error)
+
+	return get
+}
+
+func stats(res *openapi.AdminImportGetResponse) map[openapi.ImportRecordKind]openapi.ImportKindStats {
+	s := map[openapi.ImportRecordKind]openapi.ImportKindStats{}
+	for _, k := range res.JSON200.Stats {
+		s[k.Kind] = k
+	}
+	return s
+}
+
+func tarball(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+
+	var archive bytes.Buffer
+	zw := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(zw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, zw.Close())
+
+	return archive.Bytes()
+}
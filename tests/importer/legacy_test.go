@@ -0,0 +1,280 @@
+package importer_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// phpass hash of "correct horse".
+const phpassHash = "$H$9abcdefghPDonBA7cb6Wv7y6IfLfEF."
+
+func phpbbDump(suffix string, base int) string {
+	return fmt.Sprintf("-- MySQL dump 10.13\n"+
+		"/*!40101 SET NAMES utf8mb4 */;\n"+
+		"CREATE TABLE `phpbb_users` (\n"+
+		"  `user_id` int unsigned NOT NULL,\n"+
+		"  `user_type` tinyint NOT NULL,\n"+
+		"  `username` varchar(255) NOT NULL,\n"+
+		"  `user_password` varchar(255) NOT NULL,\n"+
+		"  `user_email` varchar(100) NOT NULL,\n"+
+		"  `user_regdate` int unsigned NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `phpbb_users` VALUES (1,2,'Anonymous','','',0),(%[2]d,3,'admin%[1]s','%[3]s','admin%[1]s@example.com',1262304000),(%[4]d,0,'guy &amp; co%[1]s','','',1262390400);\n"+
+		"CREATE TABLE `phpbb_forums` (\n"+
+		"  `forum_id` int unsigned NOT NULL,\n"+
+		"  `parent_id` int unsigned NOT NULL,\n"+
+		"  `left_id` int unsigned NOT NULL,\n"+
+		"  `forum_name` varchar(255) NOT NULL,\n"+
+		"  `forum_desc` text NOT NULL,\n"+
+		"  `forum_type` tinyint NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `phpbb_forums` VALUES (%[5]d,0,1,'Lounge %[1]s','General chat',0),(%[6]d,%[5]d,2,'Off topic %[1]s','',1),(%[7]d,0,3,'Our website','',2);\n"+
+		"CREATE TABLE `phpbb_topics` (\n"+
+		"  `topic_id` int unsigned NOT NULL,\n"+
+		"  `forum_id` int unsigned NOT NULL,\n"+
+		"  `topic_title` varchar(255) NOT NULL,\n"+
+		"  `topic_poster` int unsigned NOT NULL,\n"+
+		"  `topic_time` int unsigned NOT NULL,\n"+
+		"  `topic_first_post_id` int unsigned NOT NULL,\n"+
+		"  `topic_visibility` tinyint NOT NULL,\n"+
+		"  `topic_moved_id` int unsigned NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `phpbb_topics` VALUES (%[8]d,%[6]d,'Tea &amp; biscuits',%[2]d,1262476800,%[9]d,1,0),(%[10]d,%[6]d,'Moved',%[2]d,1262476800,%[9]d,1,%[8]d);\n"+
+		"CREATE TABLE `phpbb_posts` (\n"+
+		"  `post_id` int unsigned NOT NULL,\n"+
+		"  `topic_id` int unsigned NOT NULL,\n"+
+		"  `poster_id` int unsigned NOT NULL,\n"+
+		"  `post_time` int unsigned NOT NULL,\n"+
+		"  `post_text` mediumtext NOT NULL,\n"+
+		"  `bbcode_uid` varchar(8) NOT NULL,\n"+
+		"  `post_visibility` tinyint NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `phpbb_posts` VALUES "+
+		"(%[9]d,%[8]d,%[2]d,1262476800,'[b:2x8f1]Builders[/b:2x8f1] only\\n[attachment=0:2x8f1]<!-- ia0 -->mug.png<!-- ia0 -->[/attachment:2x8f1]','2x8f1',1),"+
+		"(%[11]d,%[8]d,%[4]d,1262563200,'<r><QUOTE author=\"admin%[1]s\"><s>[quote=\"admin%[1]s\"]</s>Builders only<e>[/quote]</e></QUOTE>\\nNo &amp; never</r>','',1),"+
+		"(%[12]d,%[8]d,1,1262649600,'<t>A guest says hi</t>','',1),"+
+		"(%[13]d,%[8]d,%[4]d,1262736000,'spam','',2);\n"+
+		"CREATE TABLE `phpbb_attachments` (\n"+
+		"  `attach_id` int unsigned NOT NULL,\n"+
+		"  `post_msg_id` int unsigned NOT NULL,\n"+
+		"  `in_message` tinyint NOT NULL,\n"+
+		"  `poster_id` int unsigned NOT NULL,\n"+
+		"  `is_orphan` tinyint NOT NULL,\n"+
+		"  `physical_filename` varchar(255) NOT NULL,\n"+
+		"  `real_filename` varchar(255) NOT NULL,\n"+
+		"  `mimetype` varchar(100) NOT NULL,\n"+
+		"  `filetime` int unsigned NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `phpbb_attachments` VALUES (%[14]d,%[9]d,0,%[2]d,0,'2_abcdef','mug.png','image/png',1262476800);\n",
+		suffix, base+1, phpassHash, base+2, base+3, base+4, base+5, base+6, base+7, base+8, base+9, base+10, base+11, base+12)
+}
+
+func TestPHPBBImport(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			suffix := xid.New().String()[12:]
+			archive := tarball(t, map[string][]byte{
+				"backup/forum.sql": []byte(phpbbDump(suffix, rand.IntN(1_000_000)*100)),
+				"files/2_abcdef":   []byte("mug"),
+			})
+
+			run := importArchive(t, root, cl, adminSession, openapi.Phpbb, archive)
+
+			counts := stats(run)
+			a.Equal(2, counts[openapi.ImportRecordKindMember].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindMember].Skipped, "the guest account is skipped")
+			a.Equal(2, counts[openapi.ImportRecordKindCategory].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindCategory].Skipped, "link forums are skipped")
+			a.Equal(1, counts[openapi.ImportRecordKindUpload].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindThread].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindThread].Skipped, "shadow topics are skipped")
+			a.Equal(2, counts[openapi.ImportRecordKindPost].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindPost].Skipped, "deleted posts are skipped")
+
+			threads, err := cl.AdminImportRecordListWithResponse(root, run.JSON200.Id, &openapi.AdminImportRecordListParams{
+				Kind:   opt.New(openapi.ImportRecordKindThread).Ptr(),
+				Status: opt.New(openapi.ImportRecordStatusImported).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, threads)
+			r.Len(threads.JSON200.Records, 1)
+
+			thread, err := cl.ThreadGetWithResponse(root, *threads.JSON200.Records[0].InternalId, nil)
+			tests.Ok(t, err, thread)
+			a.Equal("Tea & biscuits", thread.JSON200.Title)
+			a.Equal("admin"+suffix, thread.JSON200.Author.Handle)
+			a.Contains(thread.JSON200.Body, "<strong>Builders</strong>")
+			r.NotNil(thread.JSON200.Category)
+			a.Equal("Off topic "+suffix, thread.JSON200.Category.Name)
+			r.Len(thread.JSON200.Assets, 1)
+			a.Contains(thread.JSON200.Body, "/api/assets/"+thread.JSON200.Assets[0].Filename)
+
+			replies := thread.JSON200.Replies.Replies
+			r.Len(replies, 2)
+			a.Contains(replies[0].Body, "<blockquote>")
+			a.Contains(replies[0].Body, "No &amp; never")
+			a.Equal("Odin", replies[1].Author.Name, "guest posts are attributed to the importer")
+
+			t.Run("legacy_password", func(t *testing.T) {
+				signin := func(password string) (*openapi.AuthPasswordSigninResponse, error) {
+					return cl.AuthPasswordSigninWithResponse(root, openapi.AuthPair{Identifier: "admin" + suffix, Token: password})
+				}
+
+				wrong, err := signin("battery staple")
+				tests.Status(t, err, wrong, http.StatusUnauthorized)
+
+				first, err := signin("correct horse")
+				tests.Ok(t, err, first)
+
+				// The legacy hash is replaced on the first sign in.
+				again, err := signin("correct horse")
+				tests.Ok(t, err, again)
+			})
+		}))
+	}))
+}
+
+func vbulletinDump(suffix string, base int, hash string, salt string) string {
+	return fmt.Sprintf("CREATE TABLE `vb_user` (\n"+
+		"  `userid` int unsigned NOT NULL,\n"+
+		"  `username` varchar(100) NOT NULL,\n"+
+		"  `email` char(100) NOT NULL,\n"+
+		"  `password` char(32) NOT NULL,\n"+
+		"  `salt` char(30) NOT NULL,\n"+
+		"  `joindate` int unsigned NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `vb_user` VALUES (%[2]d,'mod%[1]s','mod%[1]s@example.com','%[3]s','%[4]s',1104537600);\n"+
+		"CREATE TABLE `vb_forum` (\n"+
+		"  `forumid` smallint unsigned NOT NULL,\n"+
+		"  `parentid` smallint NOT NULL,\n"+
+		"  `title` varchar(100) NOT NULL,\n"+
+		"  `description` varchar(250) DEFAULT NULL,\n"+
+		"  `displayorder` smallint NOT NULL,\n"+
+		"  `link` varchar(200) NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `vb_forum` VALUES (%[5]d,-1,'Garage %[1]s',NULL,1,'');\n"+
+		"CREATE TABLE `vb_thread` (\n"+
+		"  `threadid` int unsigned NOT NULL,\n"+
+		"  `title` varchar(250) NOT NULL,\n"+
+		"  `firstpostid` int unsigned NOT NULL,\n"+
+		"  `forumid` smallint unsigned NOT NULL,\n"+
+		"  `open` smallint NOT NULL,\n"+
+		"  `postuserid` int unsigned NOT NULL,\n"+
+		"  `dateline` int unsigned NOT NULL,\n"+
+		"  `visible` smallint NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `vb_thread` VALUES (%[6]d,'Engine rebuild',%[7]d,%[5]d,1,%[2]d,1104624000,1);\n"+
+		"CREATE TABLE `vb_post` (\n"+
+		"  `postid` int unsigned NOT NULL,\n"+
+		"  `threadid` int unsigned NOT NULL,\n"+
+		"  `parentid` int unsigned NOT NULL,\n"+
+		"  `userid` int unsigned NOT NULL,\n"+
+		"  `dateline` int unsigned NOT NULL,\n"+
+		"  `pagetext` mediumtext,\n"+
+		"  `visible` smallint NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `vb_post` VALUES (%[7]d,%[6]d,0,%[2]d,1104624000,'Pics: [ATTACH]%[8]d[/ATTACH]',1),"+
+		"(%[9]d,%[6]d,%[7]d,0,1104710400,'[QUOTE=mod%[1]s;%[7]d]Pics[/QUOTE]\\r\\nNice',1),"+
+		"(%[10]d,%[6]d,%[9]d,%[2]d,1104796800,'Thanks',1),"+
+		"(%[11]d,%[6]d,%[7]d,%[2]d,1104883200,'waiting',0);\n"+
+		"CREATE TABLE `vb_attachment` (\n"+
+		"  `attachmentid` int unsigned NOT NULL,\n"+
+		"  `userid` int unsigned NOT NULL,\n"+
+		"  `filename` varchar(100) NOT NULL,\n"+
+		"  `filedata` mediumblob,\n"+
+		"  `visible` smallint NOT NULL,\n"+
+		"  `postid` int unsigned NOT NULL\n"+
+		");\n"+
+		"INSERT INTO `vb_attachment` VALUES (%[8]d,%[2]d,'engine.jpg',0x656E67696E65,1,%[7]d);\n",
+		suffix, base+1, hash, salt, base+2, base+3, base+4, base+5, base+6, base+7, base+8)
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestVBulletinImport(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			suffix := xid.New().String()[12:]
+			salt := "a'b"
+			dump := vbulletinDump(suffix, rand.IntN(1_000_000)*100, md5Hex(md5Hex("hunter22")+salt), `a\'b`)
+
+			run := importArchive(t, root, cl, adminSession, openapi.Vbulletin, []byte(dump))
+
+			counts := stats(run)
+			a.Equal(1, counts[openapi.ImportRecordKindMember].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindCategory].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindUpload].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindThread].Imported)
+			a.Equal(2, counts[openapi.ImportRecordKindPost].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindPost].Skipped, "moderated posts are skipped")
+
+			threads, err := cl.AdminImportRecordListWithResponse(root, run.JSON200.Id, &openapi.AdminImportRecordListParams{
+				Kind: opt.New(openapi.ImportRecordKindThread).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, threads)
+			r.Len(threads.JSON200.Records, 1)
+
+			thread, err := cl.ThreadGetWithResponse(root, *threads.JSON200.Records[0].InternalId, nil)
+			tests.Ok(t, err, thread)
+			a.Equal("Engine rebuild", thread.JSON200.Title)
+			r.Len(thread.JSON200.Assets, 1)
+			a.Contains(thread.JSON200.Body, `alt="engine.jpg"`)
+
+			replies := thread.JSON200.Replies.Replies
+			r.Len(replies, 2)
+			a.Contains(replies[0].Body, "mod"+suffix+" wrote:")
+			a.Contains(replies[1].Body, "Thanks")
+
+			signin, err := cl.AuthPasswordSigninWithResponse(root, openapi.AuthPair{Identifier: "mod" + suffix, Token: "hunter22"})
+			tests.Ok(t, err, signin)
+		}))
+	}))
+}
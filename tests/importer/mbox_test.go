@@ -0,0 +1,120 @@
+package importer_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func mailingList(suffix string) string {
+	return fmt.Sprintf(`From ada-%[1]s@example.com Mon Jan  6 10:00:00 2020
+From: "Ada" <ada-%[1]s@example.com>
+Subject: [engines] Bernoulli numbers %[1]s
+Date: Mon, 6 Jan 2020 10:00:00 +0000
+Message-ID: <root-%[1]s@example.com>
+List-Id: Engines %[1]s <engines-%[1]s.example.com>
+
+Note G is attached.
+
+From charles-%[1]s@example.com Mon Jan  6 12:00:00 2020
+From: Charles <charles-%[1]s@example.com>
+Subject: Re: [engines] Bernoulli numbers %[1]s
+Date: Mon, 6 Jan 2020 12:00:00 +0000
+Message-ID: <reply-%[1]s@example.com>
+In-Reply-To: <root-%[1]s@example.com>
+List-Id: Engines %[1]s <engines-%[1]s.example.com>
+
+> Note G is attached.
+
+Splendid.
+`, suffix)
+}
+
+func TestMboxImport(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		mail mailer.Sender,
+	) {
+		inbox := mail.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			suffix := xid.New().String()
+			run := importArchive(t, root, cl, adminSession, openapi.Mbox, []byte(mailingList(suffix)))
+
+			counts := stats(run)
+			a.Equal(2, counts[openapi.ImportRecordKindMember].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindCategory].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindThread].Imported)
+			a.Equal(1, counts[openapi.ImportRecordKindPost].Imported)
+
+			threads, err := cl.AdminImportRecordListWithResponse(root, run.JSON200.Id, &openapi.AdminImportRecordListParams{
+				Kind: opt.New(openapi.ImportRecordKindThread).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, threads)
+			r.Len(threads.JSON200.Records, 1)
+			a.Equal("root-"+suffix+"@example.com", threads.JSON200.Records[0].ExternalId)
+
+			thread, err := cl.ThreadGetWithResponse(root, *threads.JSON200.Records[0].InternalId, nil)
+			tests.Ok(t, err, thread)
+			a.Equal("Bernoulli numbers "+suffix, thread.JSON200.Title)
+			a.Equal("Ada", thread.JSON200.Author.Name)
+			r.NotNil(thread.JSON200.Category)
+			a.Equal("Engines "+suffix, thread.JSON200.Category.Name)
+
+			r.Len(thread.JSON200.Replies.Replies, 1)
+			reply := thread.JSON200.Replies.Replies[0]
+			a.Equal("Charles", reply.Author.Name)
+			a.Contains(reply.Body, "<blockquote>")
+			a.Contains(reply.Body, "Splendid.")
+
+			t.Run("claim", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				address := "ada-" + suffix + "@example.com"
+
+				signin, err := cl.AuthEmailSigninWithResponse(root, openapi.AuthEmailSigninJSONRequestBody{Email: address})
+				tests.Ok(t, err, signin)
+
+				code := regexp.MustCompile(`verify your account: ([0-9]{6})`).FindStringSubmatch(inbox.GetLast().Plain)
+				r.Len(code, 2)
+
+				verify, err := cl.AuthEmailVerifyWithResponse(root, openapi.AuthEmailVerifyJSONRequestBody{Email: address, Code: code[1]})
+				tests.Ok(t, err, verify)
+				a.Equal(thread.JSON200.Author.Id, verify.JSON200.Id, "the imported author is claimed")
+
+				claimed, err := cl.AccountGetWithResponse(root, e2e.WithSessionFromHeader(t, root, verify.HTTPResponse.Header))
+				tests.Ok(t, err, claimed)
+				r.Len(claimed.JSON200.EmailAddresses, 1)
+				a.True(claimed.JSON200.EmailAddresses[0].Verified)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,224 @@
+package job_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/job"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/job_queue"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type testArgs struct {
+	Value string
+}
+
+func TestJobQueue(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		q *job_queue.Queue,
+		repo *job.Repository,
+	) {
+		okKind := "test.ok." + xid.New().String()
+		flakyKind := "test.flaky." + xid.New().String()
+
+		var received atomic.Value
+		job_queue.Register(q, okKind, func(ctx context.Context, args testArgs) error {
+			received.Store(args.Value)
+			return nil
+		})
+
+		var healthy atomic.Bool
+		job_queue.Register(q, flakyKind, func(ctx context.Context, args testArgs) error {
+			if !healthy.Load() {
+				return errors.New("downstream unavailable")
+			}
+			return nil
+		}, job_queue.WithMaxAttempts(1))
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			status := func(t *testing.T, id string) openapi.JobStatus {
+				res, err := cl.AdminJobGetWithResponse(root, id, adminSession)
+				tests.Ok(t, err, res)
+				return res.JSON200.Status
+			}
+
+			t.Run("release_stale", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				// No handler is registered so the queue never claims these.
+				kind := "test.unhandled." + xid.New().String()
+
+				exhausted, err := repo.Enqueue(root, kind, []byte(`{}`), 1)
+				r.NoError(err)
+				remaining, err := repo.Enqueue(root, kind, []byte(`{}`), 2)
+				r.NoError(err)
+
+				claimed, err := repo.Claim(root, []string{kind}, time.Now(), 10)
+				r.NoError(err)
+				r.Len(claimed, 2)
+
+				n, err := repo.ReleaseStale(root, time.Now().Add(time.Second))
+				r.NoError(err)
+				a.Equal(2, n)
+
+				a.Equal(openapi.JobStatusDead, status(t, exhausted.ID.String()))
+				a.Equal(openapi.JobStatusPending, status(t, remaining.ID.String()))
+			})
+
+			t.Run("runs", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+				a := assert.New(t)
+
+				j, err := job_queue.Enqueue(root, q, okKind, testArgs{Value: "hello"})
+				r.NoError(err)
+
+				r.Eventually(func() bool {
+					return status(t, j.ID.String()) == openapi.JobStatusSucceeded
+				}, 10*time.Second, 100*time.Millisecond)
+
+				a.Equal("hello", received.Load())
+
+				get, err := cl.AdminJobGetWithResponse(root, j.ID.String(), adminSession)
+				tests.Ok(t, err, get)
+				a.Equal(okKind, get.JSON200.Kind)
+				a.Equal(1, get.JSON200.Attempts)
+				a.JSONEq(`{"Value":"hello"}`, get.JSON200.Payload)
+				a.NotNil(get.JSON200.FinishedAt)
+			})
+
+			t.Run("unique_key", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+
+				key := xid.New().String()
+				later := time.Now().Add(time.Hour)
+
+				first, err := job_queue.Enqueue(root, q, okKind, testArgs{Value: "first"}, job.WithUniqueKey(key), job.WithRunAt(later))
+				r.NoError(err)
+
+				second, err := job_queue.Enqueue(root, q, okKind, testArgs{Value: "second"}, job.WithUniqueKey(key), job.WithRunAt(later))
+				r.NoError(err)
+				r.Equal(first.ID, second.ID)
+
+				del, err := cl.AdminJobDeleteWithResponse(root, first.ID.String(), adminSession)
+				tests.Status(t, err, del, http.StatusNoContent)
+
+				third, err := job_queue.Enqueue(root, q, okKind, testArgs{Value: "third"}, job.WithUniqueKey(key), job.WithRunAt(later))
+				r.NoError(err)
+				r.NotEqual(first.ID, third.ID)
+			})
+
+			t.Run("unique_key_concurrent", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+
+				key := xid.New().String()
+				later := time.Now().Add(time.Hour)
+
+				ids := make([]job.JobID, 10)
+				var wg sync.WaitGroup
+				for i := range ids {
+					wg.Go(func() {
+						j, err := job_queue.Enqueue(root, q, okKind, testArgs{Value: "concurrent"}, job.WithUniqueKey(key), job.WithRunAt(later))
+						if err == nil {
+							ids[i] = j.ID
+						}
+					})
+				}
+				wg.Wait()
+
+				for _, id := range ids {
+					r.Equal(ids[0], id)
+				}
+				r.NotZero(ids[0])
+			})
+
+			t.Run("dead_and_retry", func(t *testing.T) {
+				t.Parallel()
+				r := require.New(t)
+				a := assert.New(t)
+
+				j, err := job_queue.Enqueue(root, q, flakyKind, testArgs{Value: "flaky"})
+				r.NoError(err)
+				id := j.ID.String()
+
+				r.Eventually(func() bool {
+					return status(t, id) == openapi.JobStatusDead
+				}, 10*time.Second, 100*time.Millisecond)
+
+				get, err := cl.AdminJobGetWithResponse(root, id, adminSession)
+				tests.Ok(t, err, get)
+				r.NotNil(get.JSON200.LastError)
+				a.Contains(*get.JSON200.LastError, "downstream unavailable")
+
+				dead := openapi.JobStatusDead
+				list, err := cl.AdminJobListWithResponse(root, &openapi.AdminJobListParams{Status: &dead, Kind: &flakyKind}, adminSession)
+				tests.Ok(t, err, list)
+				r.Len(list.JSON200.Jobs, 1)
+				a.Equal(id, list.JSON200.Jobs[0].Id)
+
+				stats, err := cl.AdminJobStatsWithResponse(root, adminSession)
+				tests.Ok(t, err, stats)
+				found := false
+				for _, k := range stats.JSON200.Kinds {
+					if k.Kind == flakyKind {
+						found = true
+						a.Equal(1, k.Dead)
+					}
+				}
+				a.True(found)
+
+				forbidden, err := cl.AdminJobRetryWithResponse(root, id, memberSession)
+				tests.Status(t, err, forbidden, http.StatusForbidden)
+
+				healthy.Store(true)
+
+				retry, err := cl.AdminJobRetryWithResponse(root, id, adminSession)
+				tests.Ok(t, err, retry)
+
+				r.Eventually(func() bool {
+					return status(t, id) == openapi.JobStatusSucceeded
+				}, 10*time.Second, 100*time.Millisecond)
+
+				again, err := cl.AdminJobRetryWithResponse(root, id, adminSession)
+				tests.Status(t, err, again, http.StatusBadRequest)
+
+				del, err := cl.AdminJobDeleteWithResponse(root, id, adminSession)
+				tests.Status(t, err, del, http.StatusNoContent)
+
+				gone, err := cl.AdminJobGetWithResponse(root, id, adminSession)
+				tests.Status(t, err, gone, http.StatusNotFound)
+			})
+		}))
+	}))
+}
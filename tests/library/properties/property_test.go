@@ -2,6 +2,7 @@ package properties_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/Southclaws/dt"
@@ -475,6 +476,15 @@ func TestNodesPropertySchemaOnParentAndChildNodes(t *testing.T) {
 
 				c, err := db.PropertySchema.Query().Where(propertyschema.ID(*schemaID)).Count(ctx)
 				r.NoError(err)
+				r.Equal(1, c, "property schema should be kept while the nodes are restorable")
+
+				for _, id := range []string{node1.JSON200.Id, node2.JSON200.Id, node3.JSON200.Id} {
+					purge, err := cl.ModerationTrashDeleteWithResponse(ctx, id, session)
+					tests.Status(t, err, purge, http.StatusNoContent)
+				}
+
+				c, err = db.PropertySchema.Query().Where(propertyschema.ID(*schemaID)).Count(ctx)
+				r.NoError(err)
 				r.Equal(0, c, "property schema should be deleted as it is no longer in use by any nodes")
 			})
 		}))
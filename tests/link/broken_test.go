@@ -0,0 +1,91 @@
+package link_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/link/link_check"
+	"github.com/Southclaws/storyden/app/resources/link/link_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestBrokenLinks(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		lw *link_writer.LinkWriter,
+		checks *link_check.Repository,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			ln, err := lw.Store(root, "https://"+xid.New().String()+".example.com/gone", "Gone", "")
+			r.NoError(err)
+
+			findBroken := func() *openapi.BrokenLink {
+				res, err := cl.LinkBrokenListWithResponse(root, adminSession)
+				tests.Ok(t, err, res)
+				for _, l := range res.JSON200.Links {
+					if l.Link.Id == ln.ID.String() {
+						return &l
+					}
+				}
+				return nil
+			}
+
+			failed := link_check.Result{StatusCode: opt.New(http.StatusNotFound), Failed: true}
+			now := time.Now()
+
+			broken, err := checks.Record(root, ln.ID, failed, now, 3)
+			r.NoError(err)
+			a.False(broken)
+			broken, err = checks.Record(root, ln.ID, failed, now.Add(time.Hour), 3)
+			r.NoError(err)
+			a.False(broken)
+			a.Nil(findBroken(), "a link is not broken until enough checks fail in a row")
+
+			broken, err = checks.Record(root, ln.ID, failed, now.Add(2*time.Hour), 3)
+			r.NoError(err)
+			a.True(broken)
+
+			bl := findBroken()
+			r.NotNil(bl)
+			a.Equal(3, bl.Failures)
+			a.Len(bl.History, 3)
+			a.Equal(http.StatusNotFound, *bl.History[0].StatusCode)
+			r.NotNil(bl.Link.BrokenAt)
+
+			member, err := cl.LinkBrokenListWithResponse(root, memberSession)
+			tests.Status(t, err, member, http.StatusForbidden)
+
+			broken, err = checks.Record(root, ln.ID, link_check.Result{StatusCode: opt.New(http.StatusOK)}, now.Add(3*time.Hour), 3)
+			r.NoError(err)
+			a.False(broken)
+			a.Nil(findBroken(), "a successful check clears the broken flag")
+		}))
+	}))
+}
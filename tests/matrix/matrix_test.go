@@ -0,0 +1,307 @@
+package matrix_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/appservice"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+const (
+	roomID    = "!general:storyden.test"
+	botUserID = "@storyden:storyden.test"
+	hsToken   = "hs_token"
+)
+
+func TestMatrixBridge(t *testing.T) {
+	t.Parallel()
+
+	hs := &homeserver{}
+	hsServer := httptest.NewServer(hs)
+	defer hsServer.Close()
+
+	hsURL, err := url.Parse(hsServer.URL)
+	require.NoError(t, err)
+
+	integration.Test(t, &config.Config{
+		JWTSecret:           []byte("07d422e512b23a056ccc953994d1593f"),
+		MatrixHomeserverURL: *hsURL,
+		MatrixASToken:       "as_token",
+		MatrixHSToken:       hsToken,
+		MatrixBotUserID:     botUserID,
+	}, e2e.Setup(), appservice.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		ts *httptest.Server,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+
+			memberUserID := "@loki:storyden.test"
+
+			push := func(t *testing.T, token string, events ...map[string]any) int {
+				b, err := json.Marshal(map[string]any{"events": events})
+				require.NoError(t, err)
+
+				req, err := http.NewRequest(http.MethodPut, ts.URL+"/_matrix/app/v1/transactions/"+uuid.NewString(), bytes.NewReader(b))
+				require.NoError(t, err)
+				if token != "" {
+					req.Header.Set("Authorization", "Bearer "+token)
+				}
+
+				res, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				defer res.Body.Close()
+
+				return res.StatusCode
+			}
+
+			message := func(sender string, content map[string]any) map[string]any {
+				return map[string]any{
+					"type":     "m.room.message",
+					"event_id": "$" + uuid.NewString(),
+					"room_id":  roomID,
+					"sender":   sender,
+					"content":  content,
+				}
+			}
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession))(t, http.StatusOK)
+
+			tests.AssertRequest(cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+				MatrixBridge: &openapi.MatrixBridgeSettings{
+					Rooms: []openapi.MatrixBridgeRoom{{Category: cat.JSON200.Id, RoomId: roomID}},
+				},
+			}, adminSession))(t, http.StatusOK)
+
+			t.Run("authentication", func(t *testing.T) {
+				a := assert.New(t)
+
+				a.Equal(http.StatusUnauthorized, push(t, ""))
+				a.Equal(http.StatusForbidden, push(t, "wrong"))
+				a.Equal(http.StatusOK, push(t, hsToken))
+			})
+
+			t.Run("invite", func(t *testing.T) {
+				push(t, hsToken, map[string]any{
+					"type":      "m.room.member",
+					"event_id":  "$" + uuid.NewString(),
+					"room_id":   "!invited:storyden.test",
+					"sender":    memberUserID,
+					"state_key": botUserID,
+					"content":   map[string]any{"membership": "invite"},
+				})
+
+				assert.Len(t, hs.requests("/join"), 1)
+			})
+
+			t.Run("link", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.AccountMatrixLinkGetWithResponse(root))(t, http.StatusUnauthorized)
+
+				status := tests.AssertRequest(cl.AccountMatrixLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				a.Equal(botUserID, status.JSON200.BotUserId)
+				a.Nil(status.JSON200.UserId)
+
+				push(t, hsToken, message(memberUserID, map[string]any{"msgtype": "m.text", "body": "!link nonsense"}))
+				a.Len(hs.requests("/send/"), 1)
+
+				code := tests.AssertRequest(cl.AccountMatrixLinkCreateWithResponse(root, memberSession))(t, http.StatusOK)
+				a.True(code.JSON200.ExpiresAt.After(time.Now()))
+
+				push(t, hsToken, message(memberUserID, map[string]any{"msgtype": "m.text", "body": "!link " + code.JSON200.Code}))
+				a.Len(hs.requests("/send/"), 2)
+
+				status = tests.AssertRequest(cl.AccountMatrixLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				r.NotNil(status.JSON200.UserId)
+				a.Equal(memberUserID, *status.JSON200.UserId)
+
+				// A code can't be used again by someone else who saw it.
+				push(t, hsToken, message("@mallory:storyden.test", map[string]any{"msgtype": "m.text", "body": "!link " + code.JSON200.Code}))
+				status = tests.AssertRequest(cl.AccountMatrixLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				r.NotNil(status.JSON200.UserId)
+				a.Equal(memberUserID, *status.JSON200.UserId)
+			})
+
+			t.Run("announce_and_reply", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				title := "Matrix bridge " + uuid.NewString()
+				thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello matrix</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      title,
+				}, adminSession))(t, http.StatusOK)
+
+				var announcement request
+				r.Eventually(func() bool {
+					for _, req := range hs.requests("/rooms/" + roomID + "/send/") {
+						if strings.Contains(req.body["body"].(string), title) {
+							announcement = req
+							return true
+						}
+					}
+					return false
+				}, 10*time.Second, 100*time.Millisecond)
+				a.Equal("m.notice", announcement.body["msgtype"])
+				a.Contains(announcement.body["body"], "hello matrix")
+
+				reply := message(memberUserID, map[string]any{
+					"msgtype": "m.text",
+					"body":    "replying from **matrix**",
+					"m.relates_to": map[string]any{
+						"rel_type":        "m.thread",
+						"event_id":        announcement.eventID,
+						"is_falling_back": true,
+						"m.in_reply_to":   map[string]any{"event_id": announcement.eventID},
+					},
+				})
+				unlinked := message("@stranger:storyden.test", map[string]any{
+					"msgtype":      "m.text",
+					"body":         "who am I",
+					"m.relates_to": map[string]any{"rel_type": "m.thread", "event_id": announcement.eventID},
+				})
+				unrelated := message(memberUserID, map[string]any{"msgtype": "m.text", "body": "not in a thread"})
+
+				a.Equal(http.StatusOK, push(t, hsToken, reply, unlinked, unrelated))
+
+				// Homeservers retry transactions, replies are only synced once.
+				a.Equal(http.StatusOK, push(t, hsToken, reply))
+
+				got := tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, adminSession))(t, http.StatusOK)
+				r.Len(got.JSON200.Replies.Replies, 1)
+				a.Equal(member.Handle, got.JSON200.Replies.Replies[0].Author.Handle)
+				a.Contains(got.JSON200.Replies.Replies[0].Body, "<strong>matrix</strong>")
+
+				// Members who can't reply on the site can't reply from Matrix either.
+				tests.AssertRequest(cl.ModerationWarningIssueWithResponse(root, member.ID.String(), openapi.WarningInitialProps{
+					Severity: openapi.Severe,
+					Reason:   "Harassing other members.",
+				}, adminSession))(t, http.StatusOK)
+
+				blocked := message(memberUserID, map[string]any{
+					"msgtype":      "m.text",
+					"body":         "replying while warned",
+					"m.relates_to": map[string]any{"rel_type": "m.thread", "event_id": announcement.eventID},
+				})
+				a.Equal(http.StatusOK, push(t, hsToken, blocked))
+
+				got = tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, adminSession))(t, http.StatusOK)
+				a.Len(got.JSON200.Replies.Replies, 1)
+			})
+
+			t.Run("unlink", func(t *testing.T) {
+				tests.AssertRequest(cl.AccountMatrixLinkRemoveWithResponse(root, memberSession))(t, http.StatusNoContent)
+
+				status := tests.AssertRequest(cl.AccountMatrixLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				assert.Nil(t, status.JSON200.UserId)
+			})
+		}))
+	}))
+}
+
+func TestMatrixBridgeDisabled(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), appservice.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		ts *httptest.Server,
+	) {
+		lc.Append(fx.StartHook(func() {
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+
+			tests.AssertRequest(cl.AccountMatrixLinkGetWithResponse(root, memberSession))(t, http.StatusNotFound)
+			tests.AssertRequest(cl.AccountMatrixLinkCreateWithResponse(root, memberSession))(t, http.StatusNotFound)
+
+			res, err := http.Post(ts.URL+"/_matrix/app/v1/ping", "application/json", strings.NewReader("{}"))
+			require.NoError(t, err)
+			res.Body.Close()
+			assert.NotEqual(t, http.StatusOK, res.StatusCode)
+		}))
+	}))
+}
+
+type request struct {
+	path    string
+	eventID string
+	body    map[string]any
+}
+
+// homeserver records the client-server API calls made by the bridge.
+type homeserver struct {
+	mu   sync.Mutex
+	reqs []request
+}
+
+func (h *homeserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer as_token" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var body map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	req := request{
+		path:    r.URL.Path,
+		eventID: fmt.Sprintf("$event_%d", len(h.reqs)),
+		body:    body,
+	}
+	h.reqs = append(h.reqs, req)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"event_id": req.eventID})
+}
+
+func (h *homeserver) requests(path string) []request {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var out []request
+	for _, r := range h.reqs {
+		if strings.Contains(r.path, path) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package metrics_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	t.Parallel()
+
+	addr := freeAddress(t)
+
+	integration.Test(t, &config.Config{
+		MetricsProvider: "prometheus",
+		MetricsAddress:  addr,
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+	) {
+		lc.Append(fx.StartHook(func() {
+			res, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{})
+			tests.Ok(t, err, res)
+
+			resp, err := http.Get("http://" + addr + "/metrics")
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			a := assert.New(t)
+			a.Contains(string(body), `http_server_request_duration_seconds_count{`)
+			a.Contains(string(body), `http_route="/api/threads"`)
+			a.Contains(string(body), `db_client_operation_duration_seconds_count{`)
+			a.Contains(string(body), `repository="thread_querier.`)
+			a.Contains(string(body), `go_goroutines`)
+		}))
+	}))
+}
+
+func freeAddress(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	return ln.Addr().String()
+}
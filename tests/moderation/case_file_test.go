@@ -0,0 +1,143 @@
+package moderation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestCaseFile(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			otherAdminCtx, _ := e2e.WithAccount(root, aw, seed.Account_002_Frigg)
+			otherAdminSession := sh.WithSession(otherAdminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>borderline</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "case file " + uuid.NewString(),
+			}, memberSession)
+			tests.Ok(t, err, thread)
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.ModerationNoteCreateWithResponse(root, openapi.ModerationNoteInitialProps{
+					TargetId:   member.ID.String(),
+					TargetKind: openapi.DatagraphItemKindProfile,
+					Content:    "nope",
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				cf, err := cl.ModerationCaseFileGetWithResponse(root, member.ID.String(), memberSession)
+				tests.Status(t, err, cf, http.StatusForbidden)
+			})
+
+			t.Run("empty_note", func(t *testing.T) {
+				res, err := cl.ModerationNoteCreateWithResponse(root, openapi.ModerationNoteInitialProps{
+					TargetId:   member.ID.String(),
+					TargetKind: openapi.DatagraphItemKindProfile,
+					Content:    "   ",
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			profileNote, err := cl.ModerationNoteCreateWithResponse(root, openapi.ModerationNoteInitialProps{
+				TargetId:   member.ID.String(),
+				TargetKind: openapi.DatagraphItemKindProfile,
+				Content:    "Argumentative in the general chat.",
+			}, adminSession)
+			tests.Ok(t, err, profileNote)
+			a.Equal(openapi.ModerationNoteKindNote, profileNote.JSON200.Kind)
+			r.NotNil(profileNote.JSON200.Author)
+			a.Equal(admin.ID.String(), profileNote.JSON200.Author.Id)
+
+			threadNote, err := cl.ModerationNoteCreateWithResponse(root, openapi.ModerationNoteInitialProps{
+				TargetId:   thread.JSON200.Id,
+				TargetKind: openapi.DatagraphItemKindThread,
+				Content:    "Left up, but keep an eye on it.",
+			}, otherAdminSession)
+			tests.Ok(t, err, threadNote)
+
+			t.Run("list_for_content", func(t *testing.T) {
+				res, err := cl.ModerationNoteListWithResponse(root, &openapi.ModerationNoteListParams{TargetId: thread.JSON200.Id}, adminSession)
+				tests.Ok(t, err, res)
+				r.Len(res.JSON200.Notes, 1)
+				a.Equal(threadNote.JSON200.Id, res.JSON200.Notes[0].Id)
+			})
+
+			t.Run("only_author_edits", func(t *testing.T) {
+				res, err := cl.ModerationNoteUpdateWithResponse(root, profileNote.JSON200.Id, openapi.ModerationNoteMutableProps{
+					Content: "Changed by someone else.",
+				}, otherAdminSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				res, err = cl.ModerationNoteUpdateWithResponse(root, profileNote.JSON200.Id, openapi.ModerationNoteMutableProps{
+					Content: "Argumentative in the general chat, twice.",
+				}, adminSession)
+				tests.Ok(t, err, res)
+				a.Equal("Argumentative in the general chat, twice.", *res.JSON200.Content)
+			})
+
+			suspend, err := cl.AdminAccountBanCreateWithResponse(root, member.ID.String(), adminSession)
+			tests.Ok(t, err, suspend)
+			reinstate, err := cl.AdminAccountBanRemoveWithResponse(root, member.ID.String(), adminSession)
+			tests.Ok(t, err, reinstate)
+
+			cf, err := cl.ModerationCaseFileGetWithResponse(root, member.ID.String(), adminSession)
+			tests.Ok(t, err, cf)
+			a.Equal(member.ID.String(), cf.JSON200.Account.Id)
+
+			kinds := dt.Map(cf.JSON200.Entries, func(n openapi.ModerationNote) openapi.ModerationNoteKind { return n.Kind })
+			a.Equal([]openapi.ModerationNoteKind{openapi.ModerationNoteKindNote, openapi.ModerationNoteKindNote, openapi.ModerationNoteKindSuspension, openapi.ModerationNoteKindReinstatement}, kinds)
+			a.Equal(openapi.DatagraphItemKindThread, cf.JSON200.Entries[1].TargetKind)
+
+			suspension := cf.JSON200.Entries[2]
+
+			t.Run("actions_are_permanent", func(t *testing.T) {
+				res, err := cl.ModerationNoteDeleteWithResponse(root, suspension.Id, adminSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("admins_delete_any_note", func(t *testing.T) {
+				res, err := cl.ModerationNoteDeleteWithResponse(root, profileNote.JSON200.Id, otherAdminSession)
+				tests.Status(t, err, res, http.StatusNoContent)
+
+				cf, err := cl.ModerationCaseFileGetWithResponse(root, member.ID.String(), adminSession)
+				tests.Ok(t, err, cf)
+				a.Len(cf.JSON200.Entries, 3)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,166 @@
+package moderation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestTrash(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			findInTrash := func(id string) (openapi.TrashItem, bool) {
+				list, err := cl.ModerationTrashListWithResponse(root, adminSession)
+				tests.Ok(t, err, list)
+				return lo.Find(list.JSON200.Items, func(i openapi.TrashItem) bool { return i.Id == id })
+			}
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>hello</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "trash " + uuid.NewString(),
+			}, memberSession)
+			tests.Ok(t, err, thread)
+
+			reply1, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>one</p>"}, memberSession)
+			tests.Ok(t, err, reply1)
+			reply2, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>two</p>"}, memberSession)
+			tests.Ok(t, err, reply2)
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.ModerationTrashListWithResponse(root, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("restore_reply", func(t *testing.T) {
+				del, err := cl.PostDeleteWithResponse(root, reply1.JSON200.Id, memberSession)
+				tests.Ok(t, err, del)
+
+				item, found := findInTrash(reply1.JSON200.Id)
+				r.True(found)
+				a.Equal(openapi.DatagraphItemKindReply, item.Kind)
+				a.Equal(thread.JSON200.Title, item.Name)
+				a.Equal(thread.JSON200.Id, *item.ThreadId)
+				a.WithinDuration(item.DeletedAt.Add(30*24*time.Hour), item.RestorableUntil, time.Second)
+
+				res, err := cl.ModerationTrashRestoreWithResponse(root, reply1.JSON200.Id, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				res, err = cl.ModerationTrashRestoreWithResponse(root, reply1.JSON200.Id, adminSession)
+				tests.Ok(t, err, res)
+
+				_, found = findInTrash(reply1.JSON200.Id)
+				a.False(found)
+
+				get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil)
+				tests.Ok(t, err, get)
+				a.Equal(2, get.JSON200.ReplyStatus.Replies)
+			})
+
+			t.Run("restore_thread", func(t *testing.T) {
+				del, err := cl.PostDeleteWithResponse(root, reply2.JSON200.Id, memberSession)
+				tests.Ok(t, err, del)
+
+				tdel, err := cl.ThreadDeleteWithResponse(root, thread.JSON200.Slug, memberSession)
+				tests.Ok(t, err, tdel)
+
+				get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil)
+				tests.Status(t, err, get, http.StatusNotFound)
+
+				item, found := findInTrash(thread.JSON200.Id)
+				r.True(found)
+				a.Equal(openapi.DatagraphItemKindThread, item.Kind)
+
+				_, found = findInTrash(reply1.JSON200.Id)
+				a.False(found, "replies deleted with the thread are restored with it")
+
+				_, found = findInTrash(reply2.JSON200.Id)
+				a.True(found, "replies deleted before the thread are listed on their own")
+
+				res, err := cl.ModerationTrashRestoreWithResponse(root, reply2.JSON200.Id, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+
+				res, err = cl.ModerationTrashRestoreWithResponse(root, thread.JSON200.Id, adminSession)
+				tests.Ok(t, err, res)
+
+				get, err = cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil)
+				tests.Ok(t, err, get)
+				a.Equal(1, get.JSON200.ReplyStatus.Replies)
+
+				res, err = cl.ModerationTrashRestoreWithResponse(root, reply2.JSON200.Id, adminSession)
+				tests.Ok(t, err, res)
+			})
+
+			t.Run("node", func(t *testing.T) {
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{Name: uuid.NewString()}, adminSession)
+				tests.Ok(t, err, node)
+
+				del, err := cl.NodeDeleteWithResponse(root, node.JSON200.Slug, nil, adminSession)
+				tests.Ok(t, err, del)
+
+				get, err := cl.NodeGetWithResponse(root, node.JSON200.Slug, &openapi.NodeGetParams{}, adminSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+
+				item, found := findInTrash(node.JSON200.Id)
+				r.True(found)
+				a.Equal(openapi.DatagraphItemKindNode, item.Kind)
+				a.Equal(node.JSON200.Slug, *item.Slug)
+
+				res, err := cl.ModerationTrashRestoreWithResponse(root, node.JSON200.Id, adminSession)
+				tests.Ok(t, err, res)
+
+				get, err = cl.NodeGetWithResponse(root, node.JSON200.Slug, &openapi.NodeGetParams{}, adminSession)
+				tests.Ok(t, err, get)
+
+				del, err = cl.NodeDeleteWithResponse(root, node.JSON200.Slug, nil, adminSession)
+				tests.Ok(t, err, del)
+
+				purge, err := cl.ModerationTrashDeleteWithResponse(root, node.JSON200.Id, adminSession)
+				tests.Status(t, err, purge, http.StatusNoContent)
+
+				res, err = cl.ModerationTrashRestoreWithResponse(root, node.JSON200.Id, adminSession)
+				tests.Status(t, err, res, http.StatusNotFound)
+			})
+
+			t.Run("not_deleted", func(t *testing.T) {
+				res, err := cl.ModerationTrashRestoreWithResponse(root, thread.JSON200.Id, adminSession)
+				tests.Status(t, err, res, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,148 @@
+package moderation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestWarnings(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+			handle := member.ID.String()
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func() (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "warnings " + uuid.NewString(),
+				}, memberSession)
+			}
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.ModerationWarningIssueWithResponse(root, handle, openapi.WarningInitialProps{
+					Severity: openapi.Minor,
+					Reason:   "nope",
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				list, err := cl.ModerationWarningListWithResponse(root, handle, memberSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+			})
+
+			t.Run("invalid", func(t *testing.T) {
+				res, err := cl.ModerationWarningIssueWithResponse(root, handle, openapi.WarningInitialProps{
+					Severity: openapi.Minor,
+					Reason:   "  ",
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+
+				res, err = cl.ModerationWarningIssueWithResponse(root, handle, openapi.WarningInitialProps{
+					Severity:  openapi.Minor,
+					Reason:    "Off topic.",
+					ExpiresAt: opt.New(time.Now().Add(-time.Hour)).Ptr(),
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			minor, err := cl.ModerationWarningIssueWithResponse(root, handle, openapi.WarningInitialProps{
+				Severity:  openapi.Minor,
+				Reason:    "Off topic.",
+				ExpiresAt: opt.New(time.Now().Add(24 * time.Hour)).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, minor)
+			a.Equal(1, minor.JSON200.Points)
+			a.True(minor.JSON200.Active)
+			r.NotNil(minor.JSON200.IssuedBy)
+			a.Equal(admin.ID.String(), minor.JSON200.IssuedBy.Id)
+
+			thread, err := createThread()
+			tests.Ok(t, err, thread)
+
+			severe, err := cl.ModerationWarningIssueWithResponse(root, handle, openapi.WarningInitialProps{
+				Severity: openapi.Severe,
+				Reason:   "Harassing other members.",
+			}, adminSession)
+			tests.Ok(t, err, severe)
+			a.Equal(3, severe.JSON200.Points)
+
+			t.Run("restricted_at_threshold", func(t *testing.T) {
+				thread, err := createThread()
+				tests.Status(t, err, thread, http.StatusForbidden)
+			})
+
+			t.Run("member_sees_own_warnings", func(t *testing.T) {
+				own, err := cl.AccountWarningsGetWithResponse(root, memberSession)
+				tests.Ok(t, err, own)
+				a.Len(own.JSON200.Warnings, 2)
+				a.Equal(4, own.JSON200.Points)
+				a.Contains(own.JSON200.Restrictions, openapi.CREATEPOST)
+				for _, w := range own.JSON200.Warnings {
+					a.Nil(w.IssuedBy)
+				}
+			})
+
+			t.Run("recorded_in_case_file", func(t *testing.T) {
+				cf, err := cl.ModerationCaseFileGetWithResponse(root, handle, adminSession)
+				tests.Ok(t, err, cf)
+				warnings := lo.Filter(cf.JSON200.Entries, func(e openapi.ModerationNote, _ int) bool { return string(e.Kind) == "warning" })
+				a.Len(warnings, 2)
+			})
+
+			revoked, err := cl.ModerationWarningRevokeWithResponse(root, severe.JSON200.Id, adminSession)
+			tests.Ok(t, err, revoked)
+			a.False(revoked.JSON200.Active)
+			a.NotNil(revoked.JSON200.RevokedAt)
+
+			t.Run("revoke_twice", func(t *testing.T) {
+				res, err := cl.ModerationWarningRevokeWithResponse(root, severe.JSON200.Id, adminSession)
+				tests.Status(t, err, res, http.StatusConflict)
+			})
+
+			t.Run("restriction_lifted", func(t *testing.T) {
+				thread, err := createThread()
+				tests.Ok(t, err, thread)
+
+				list, err := cl.ModerationWarningListWithResponse(root, handle, adminSession)
+				tests.Ok(t, err, list)
+				a.Len(list.JSON200.Warnings, 2)
+				a.Equal(1, list.JSON200.Points)
+				a.Empty(list.JSON200.Restrictions)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,237 @@
+package netban_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/nullable"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/asn"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// randomNetwork returns a /64 from the IPv6 documentation range and an address
+// within it so bans don't affect other tests sharing the database.
+func randomNetwork() (string, string) {
+	prefix := fmt.Sprintf("2001:db8:%x:%x::", rand.N(0xffff), rand.N(0xffff))
+	return prefix + "/64", prefix + "1"
+}
+
+func fromAddress(addr string) openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Real-IP", addr)
+		return nil
+	}
+}
+
+func TestNetworkBans(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func(editors ...openapi.RequestEditorFn) (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "netban " + uuid.NewString(),
+				}, editors...)
+			}
+
+			signup := func(addr string) (*openapi.AuthPasswordSignupResponse, error) {
+				return cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{xid.New().String(), "password"}, fromAddress(addr))
+			}
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminNetworkBanListWithResponse(root, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				cidr, _ := randomNetwork()
+				created, err := cl.AdminNetworkBanCreateWithResponse(root, openapi.NetworkBanInitialProps{
+					Target: openapi.NetworkBanTarget(cidr),
+					List:   openapi.NetworkBanListKindBlock,
+				}, memberSession)
+				tests.Status(t, err, created, http.StatusForbidden)
+			})
+
+			t.Run("invalid_target", func(t *testing.T) {
+				for _, target := range []string{"not an address", "10.0.0.0/99", "AS-1"} {
+					res, err := cl.AdminNetworkBanCreateWithResponse(root, openapi.NetworkBanInitialProps{
+						Target: openapi.NetworkBanTarget(target),
+						List:   openapi.NetworkBanListKindBlock,
+					}, adminSession)
+					tests.Status(t, err, res, http.StatusBadRequest)
+				}
+			})
+
+			t.Run("block_range", func(t *testing.T) {
+				cidr, blockedAddr := randomNetwork()
+
+				ban, err := cl.AdminNetworkBanCreateWithResponse(root, openapi.NetworkBanInitialProps{
+					Target: openapi.NetworkBanTarget(cidr),
+					List:   openapi.NetworkBanListKindBlock,
+					Reason: opt.New("Too much spam.").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, ban)
+				a.True(ban.JSON200.Active)
+				a.Equal(0, ban.JSON200.Hits)
+
+				res, err := signup(blockedAddr)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				thread, err := createThread(memberSession, fromAddress(blockedAddr))
+				tests.Status(t, err, thread, http.StatusForbidden)
+
+				t.Run("admins_exempt_from_posting", func(t *testing.T) {
+					thread, err := createThread(adminSession, fromAddress(blockedAddr))
+					tests.Ok(t, err, thread)
+				})
+
+				t.Run("other_networks_unaffected", func(t *testing.T) {
+					res, err := signup("2001:db8:ffff:ffff::1")
+					tests.Ok(t, err, res)
+				})
+
+				list, err := cl.AdminNetworkBanListWithResponse(root, adminSession)
+				tests.Ok(t, err, list)
+				listed, found := lo.Find(list.JSON200.Bans, func(b openapi.NetworkBan) bool { return b.Id == ban.JSON200.Id })
+				r.True(found)
+				a.Equal(2, listed.Hits)
+				a.NotNil(listed.LastHitAt)
+
+				t.Run("allowlist_overrides", func(t *testing.T) {
+					allow, err := cl.AdminNetworkBanCreateWithResponse(root, openapi.NetworkBanInitialProps{
+						Target: openapi.NetworkBanTarget(blockedAddr),
+						List:   openapi.NetworkBanListKindAllow,
+					}, adminSession)
+					tests.Ok(t, err, allow)
+					a.Equal(blockedAddr+"/128", string(allow.JSON200.Target))
+
+					res, err := signup(blockedAddr)
+					tests.Ok(t, err, res)
+
+					del, err := cl.AdminNetworkBanDeleteWithResponse(root, allow.JSON200.Id, adminSession)
+					tests.Status(t, err, del, http.StatusNoContent)
+				})
+
+				t.Run("expired", func(t *testing.T) {
+					updated, err := cl.AdminNetworkBanUpdateWithResponse(root, ban.JSON200.Id, openapi.NetworkBanMutableProps{
+						ExpiresAt: nullable.NewNullableWithValue(time.Now().Add(-time.Minute)),
+					}, adminSession)
+					tests.Ok(t, err, updated)
+					a.False(updated.JSON200.Active)
+
+					res, err := signup(blockedAddr)
+					tests.Ok(t, err, res)
+
+					updated, err = cl.AdminNetworkBanUpdateWithResponse(root, ban.JSON200.Id, openapi.NetworkBanMutableProps{
+						ExpiresAt: nullable.NewNullNullable[time.Time](),
+					}, adminSession)
+					tests.Ok(t, err, updated)
+					a.True(updated.JSON200.Active)
+					a.Nil(updated.JSON200.ExpiresAt)
+				})
+
+				del, err := cl.AdminNetworkBanDeleteWithResponse(root, ban.JSON200.Id, adminSession)
+				tests.Status(t, err, del, http.StatusNoContent)
+
+				res, err = signup(blockedAddr)
+				tests.Ok(t, err, res)
+			})
+
+			t.Run("duplicate", func(t *testing.T) {
+				cidr, _ := randomNetwork()
+
+				first, err := cl.AdminNetworkBanCreateWithResponse(root, openapi.NetworkBanInitialProps{
+					Target: openapi.NetworkBanTarget(cidr),
+					List:   openapi.NetworkBanListKindBlock,
+				}, adminSession)
+				tests.Ok(t, err, first)
+
+				second, err := cl.AdminNetworkBanCreateWithResponse(root, openapi.NetworkBanInitialProps{
+					Target: openapi.NetworkBanTarget(cidr),
+					List:   openapi.NetworkBanListKindBlock,
+				}, adminSession)
+				tests.Status(t, err, second, http.StatusConflict)
+
+				del, err := cl.AdminNetworkBanDeleteWithResponse(root, first.JSON200.Id, adminSession)
+				tests.Status(t, err, del, http.StatusNoContent)
+			})
+		}))
+	}))
+}
+
+func TestNetworkBansByASN(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{ASNLookupProvider: "mock"}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			_, addr := randomNetwork()
+
+			signup := func() (*openapi.AuthPasswordSignupResponse, error) {
+				return cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{xid.New().String(), "password"}, fromAddress(addr))
+			}
+
+			ban, err := cl.AdminNetworkBanCreateWithResponse(root, openapi.NetworkBanInitialProps{
+				Target: openapi.NetworkBanTarget(fmt.Sprintf("as%d", asn.MockASN)),
+				List:   openapi.NetworkBanListKindBlock,
+			}, adminSession)
+			tests.Ok(t, err, ban)
+			a.Equal(fmt.Sprintf("AS%d", asn.MockASN), string(ban.JSON200.Target))
+
+			res, err := signup()
+			tests.Status(t, err, res, http.StatusForbidden)
+
+			del, err := cl.AdminNetworkBanDeleteWithResponse(root, ban.JSON200.Id, adminSession)
+			tests.Status(t, err, del, http.StatusNoContent)
+
+			res, err = signup()
+			tests.Ok(t, err, res)
+		}))
+	}))
+}
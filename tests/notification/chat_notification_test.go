@@ -0,0 +1,141 @@
+package notification_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type chatReceiver struct {
+	mu     sync.Mutex
+	bodies []map[string]any
+}
+
+func (c *chatReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := io.ReadAll(r.Body)
+
+	var body map[string]any
+	_ = json.Unmarshal(b, &body)
+
+	c.mu.Lock()
+	c.bodies = append(c.bodies, body)
+	c.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *chatReceiver) all() []map[string]any {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]map[string]any{}, c.bodies...)
+}
+
+func TestChatNotifications(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			slack := &chatReceiver{}
+			slackServer := httptest.NewServer(slack)
+			defer slackServer.Close()
+
+			discord := &chatReceiver{}
+			discordServer := httptest.NewServer(discord)
+			defer discordServer.Close()
+
+			included, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, included)
+			excluded, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, excluded)
+
+			t.Run("invalid_url", func(t *testing.T) {
+				res, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					ChatNotifications: &openapi.ChatNotificationSettings{
+						Channels: []openapi.ChatNotificationChannel{{
+							Name:       "bad",
+							Provider:   "slack",
+							WebhookUrl: "not a url",
+							Triggers:   []openapi.ChatNotificationTrigger{"thread_created"},
+						}},
+					},
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			categories := []openapi.Identifier{included.JSON200.Id}
+			settings, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+				ChatNotifications: &openapi.ChatNotificationSettings{
+					Channels: []openapi.ChatNotificationChannel{
+						{
+							Name:       "slack-threads",
+							Provider:   "slack",
+							WebhookUrl: slackServer.URL,
+							Triggers:   []openapi.ChatNotificationTrigger{"thread_created"},
+							Categories: &categories,
+						},
+						{
+							Name:       "discord-reports",
+							Provider:   "discord",
+							WebhookUrl: discordServer.URL,
+							Triggers:   []openapi.ChatNotificationTrigger{"report_filed"},
+						},
+					},
+				},
+			}, adminSession)
+			tests.Ok(t, err, settings)
+			r.NotNil(settings.JSON200.ChatNotifications)
+			a.Len(settings.JSON200.ChatNotifications.Channels, 2)
+
+			newThread := func(categoryID string, title string) {
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>chat notifications</p>").Ptr(),
+					Category:   opt.New(categoryID).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      title,
+				}, adminSession)
+				tests.Ok(t, err, thread)
+			}
+
+			newThread(excluded.JSON200.Id, "not announced")
+			newThread(included.JSON200.Id, "announced")
+
+			r.Eventually(func() bool { return len(slack.all()) > 0 }, 10*time.Second, 100*time.Millisecond)
+
+			time.Sleep(500 * time.Millisecond)
+			received := slack.all()
+			r.Len(received, 1)
+			a.Equal("announced", received[0]["text"])
+			a.Empty(discord.all())
+		}))
+	}))
+}
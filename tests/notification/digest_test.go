@@ -0,0 +1,260 @@
+package notification_test
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/account/notification/digest"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/notification/digest_email"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+var unsubscribeLink = regexp.MustCompile(`/unsubscribe\?token=([^\s\)]+)`)
+
+func TestDigestEmail(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		JWTSecret: []byte("07d422e512b23a056ccc953994d1593f"),
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		er *email.Repository,
+		digests *digest.Repository,
+		digester *digest_email.Digester,
+		sender mailer.Sender,
+	) {
+		inbox := sender.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			subscriber := func(t *testing.T) (context.Context, *account.Account, string) {
+				ctx, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+				address := mail.Address{Address: xid.New().String() + "@storyden.org"}
+				_, err := er.Add(root, acc.ID, address, "")
+				require.NoError(t, err)
+				require.NoError(t, er.Verify(root, acc.ID, address))
+
+				return ctx, acc, address.Address
+			}
+
+			inboxFor := func(address string) []mailer.MockEmail {
+				return lo.Filter(inbox.GetAll(), func(m mailer.MockEmail, _ int) bool {
+					return m.Address.Address == address
+				})
+			}
+
+			t.Run("frequency_preference", func(t *testing.T) {
+				a := assert.New(t)
+
+				ctx, _, _ := subscriber(t)
+				session := sh.WithSession(ctx)
+
+				get, err := cl.AccountNotificationPreferencesGetWithResponse(root, session)
+				tests.Ok(t, err, get)
+				a.Equal(openapi.Never, *get.JSON200.DigestFrequency)
+
+				update, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences:     openapi.NotificationPreferenceList{},
+					DigestFrequency: opt.New(openapi.Weekly).Ptr(),
+				}, session)
+				tests.Ok(t, err, update)
+				a.Equal(openapi.Weekly, *update.JSON200.DigestFrequency)
+
+				invalid, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences:     openapi.NotificationPreferenceList{},
+					DigestFrequency: opt.New(openapi.DigestFrequency("hourly")).Ptr(),
+				}, session)
+				tests.Status(t, err, invalid, http.StatusBadRequest)
+			})
+
+			t.Run("send_and_unsubscribe", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ctx, _, address := subscriber(t)
+				session := sh.WithSession(ctx)
+
+				follow, err := cl.CategoryFollowersAddWithResponse(root, cat.JSON200.Slug, session)
+				tests.Ok(t, err, follow)
+
+				update, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences:     openapi.NotificationPreferenceList{},
+					DigestFrequency: opt.New(openapi.Daily).Ptr(),
+				}, session)
+				tests.Ok(t, err, update)
+
+				title := "Digest " + uuid.NewString()
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>digest</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      title,
+				}, adminSession)
+				tests.Ok(t, err, thread)
+
+				digester.Run(root, time.Now())
+
+				var sent mailer.MockEmail
+				r.Eventually(func() bool {
+					got := inboxFor(address)
+					if len(got) == 0 {
+						return false
+					}
+					sent = got[0]
+					return true
+				}, 5*time.Second, 100*time.Millisecond)
+
+				a.Equal("Your daily summary", sent.Subject)
+				a.Contains(sent.Plain, title)
+
+				// Already sent within the interval, running again sends nothing.
+				digester.Run(root, time.Now())
+				time.Sleep(200 * time.Millisecond)
+				a.Len(inboxFor(address), 1)
+
+				match := unsubscribeLink.FindStringSubmatch(sent.Plain)
+				r.Len(match, 2)
+				token, err := url.QueryUnescape(strings.TrimSpace(match[1]))
+				r.NoError(err)
+
+				unsub, err := cl.NotificationDigestUnsubscribeWithResponse(root, openapi.DigestUnsubscribeProps{Token: token})
+				tests.Status(t, err, unsub, http.StatusNoContent)
+
+				get, err := cl.AccountNotificationPreferencesGetWithResponse(root, session)
+				tests.Ok(t, err, get)
+				a.Equal(openapi.Never, *get.JSON200.DigestFrequency)
+			})
+
+			t.Run("nothing_to_report", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				_, acc, address := subscriber(t)
+				r.NoError(digests.Set(root, acc.ID, digest.FrequencyWeekly))
+
+				now := time.Now()
+				r.NoError(digester.Send(root, acc.ID, digest.FrequencyWeekly, now.Add(-time.Minute), now))
+
+				time.Sleep(200 * time.Millisecond)
+				a.Empty(inboxFor(address))
+			})
+
+			t.Run("translated", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ctx, acc, address := subscriber(t)
+				session := sh.WithSession(ctx)
+
+				locale := "de"
+				timezone := "Europe/Berlin"
+				upd, err := cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{Locale: &locale, Timezone: &timezone}, session)
+				tests.Ok(t, err, upd)
+
+				follow, err := cl.CategoryFollowersAddWithResponse(root, cat.JSON200.Slug, session)
+				tests.Ok(t, err, follow)
+
+				since := time.Now().Add(-time.Minute)
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>digest</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Digest " + uuid.NewString(),
+				}, adminSession)
+				tests.Ok(t, err, thread)
+
+				r.NoError(digests.Set(root, acc.ID, digest.FrequencyWeekly))
+				r.NoError(digester.Send(root, acc.ID, digest.FrequencyWeekly, since, time.Now()))
+
+				var sent mailer.MockEmail
+				r.Eventually(func() bool {
+					got := inboxFor(address)
+					if len(got) == 0 {
+						return false
+					}
+					sent = got[0]
+					return true
+				}, 5*time.Second, 100*time.Millisecond)
+
+				a.Equal("Deine wöchentliche Zusammenfassung", sent.Subject)
+				a.Contains(sent.Plain, "Beliebt in Kategorien, denen du folgst:")
+				a.Contains(sent.Plain, "Hallo")
+				a.Contains(sent.Plain, "Danke")
+				a.Contains(sent.Plain, "Das ist neu seit")
+				a.Contains(sent.Plain, "(Europe/Berlin)")
+			})
+
+			t.Run("scheduled_in_timezone", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ctx, tokyoAcc, _ := subscriber(t)
+				timezone := "Asia/Tokyo"
+				upd, err := cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{Timezone: &timezone}, sh.WithSession(ctx))
+				tests.Ok(t, err, upd)
+
+				_, utcAcc, _ := subscriber(t)
+
+				// 07:00 on the 8th in Tokyo, 22:00 on the 7th in UTC.
+				lastSent := time.Date(2030, 1, 7, 22, 0, 0, 0, time.UTC)
+				for _, acc := range []*account.Account{tokyoAcc, utcAcc} {
+					r.NoError(digests.Set(root, acc.ID, digest.FrequencyDaily))
+					r.NoError(digests.MarkSent(root, acc.ID, lastSent))
+				}
+
+				isDue := func(now time.Time, id account.AccountID) bool {
+					due, err := digests.ListDue(root, digest.FrequencyDaily, now, time.UTC)
+					r.NoError(err)
+					return lo.ContainsBy(due, func(d digest.Due) bool { return d.AccountID == id })
+				}
+
+				// Half past eight in Tokyo, the UTC member's next digest is
+				// still ten hours away.
+				now := lastSent.Add(90 * time.Minute)
+				a.True(isDue(now, tokyoAcc.ID))
+				a.False(isDue(now, utcAcc.ID))
+
+				now = time.Date(2030, 1, 8, 8, 30, 0, 0, time.UTC)
+				a.True(isDue(now, utcAcc.ID))
+			})
+
+			t.Run("invalid_token", func(t *testing.T) {
+				res, err := cl.NotificationDigestUnsubscribeWithResponse(root, openapi.DigestUnsubscribeProps{Token: "nope"})
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+		}))
+	}))
+}
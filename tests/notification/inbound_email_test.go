@@ -0,0 +1,67 @@
+package notification_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/transports/inbound_email"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+)
+
+func postInbound(t *testing.T, ts *httptest.Server, secret string) int {
+	form := url.Values{"to": {"abc@reply.storyden.test"}, "from": {"someone@example.com"}, "text": {"hello"}}
+
+	res, err := http.Post(ts.URL+"/email/inbound?secret="+url.QueryEscape(secret), "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	res.Body.Close()
+
+	return res.StatusCode
+}
+
+func TestInboundEmailSecret(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		JWTSecret:          []byte("07d422e512b23a056ccc953994d1593f"),
+		EmailReplyDomain:   "reply.storyden.test",
+		EmailInboundSecret: "inbound_secret",
+	}, e2e.Setup(), inbound_email.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		ts *httptest.Server,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			a.Equal(http.StatusUnauthorized, postInbound(t, ts, ""))
+			a.Equal(http.StatusUnauthorized, postInbound(t, ts, "wrong"))
+
+			// Mail which can't be posted is still acknowledged.
+			a.Equal(http.StatusOK, postInbound(t, ts, "inbound_secret"))
+		}))
+	}))
+}
+
+func TestInboundEmailWithoutSecret(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		JWTSecret:        []byte("07d422e512b23a056ccc953994d1593f"),
+		EmailReplyDomain: "reply.storyden.test",
+	}, e2e.Setup(), inbound_email.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		ts *httptest.Server,
+	) {
+		lc.Append(fx.StartHook(func() {
+			assert.Equal(t, http.StatusUnauthorized, postInbound(t, ts, ""))
+		}))
+	}))
+}
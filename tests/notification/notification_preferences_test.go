@@ -0,0 +1,140 @@
+package notification_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		notifier *notify.Notifier,
+	) {
+		lc.Append(fx.StartHook(func() {
+			find := func(list openapi.NotificationPreferenceList, event openapi.NotificationEvent) openapi.NotificationPreference {
+				p, ok := lo.Find(list, func(p openapi.NotificationPreference) bool { return p.Event == event })
+				require.True(t, ok)
+				return p
+			}
+
+			t.Run("defaults", func(t *testing.T) {
+				a := assert.New(t)
+
+				ctx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+				res, err := cl.AccountNotificationPreferencesGetWithResponse(root, sh.WithSession(ctx))
+				tests.Ok(t, err, res)
+
+				a.Len(res.JSON200.Preferences, 15)
+
+				mention := find(res.JSON200.Preferences, openapi.NotificationEventProfileMention)
+				a.True(mention.InApp)
+				a.True(mention.Email)
+
+				like := find(res.JSON200.Preferences, openapi.NotificationEventPostLike)
+				a.True(like.InApp)
+				a.False(like.Email)
+				a.False(like.WebPush)
+			})
+
+			t.Run("update", func(t *testing.T) {
+				a := assert.New(t)
+
+				ctx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				session := sh.WithSession(ctx)
+
+				res, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences: openapi.NotificationPreferenceList{
+						{Event: openapi.NotificationEventPostLike, InApp: false, Email: true, WebPush: true},
+					},
+				}, session)
+				tests.Ok(t, err, res)
+
+				like := find(res.JSON200.Preferences, openapi.NotificationEventPostLike)
+				a.False(like.InApp)
+				a.True(like.Email)
+				a.True(like.WebPush)
+
+				follow := find(res.JSON200.Preferences, openapi.NotificationEventFollow)
+				a.True(follow.InApp, "events not in the request are unchanged")
+
+				get, err := cl.AccountNotificationPreferencesGetWithResponse(root, session)
+				tests.Ok(t, err, get)
+				a.Equal(like, find(get.JSON200.Preferences, openapi.NotificationEventPostLike))
+			})
+
+			t.Run("in_app_disabled", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ctx, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				session := sh.WithSession(ctx)
+
+				res, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences: openapi.NotificationPreferenceList{
+						{Event: openapi.NotificationEventPostLike, InApp: false},
+					},
+				}, session)
+				tests.Ok(t, err, res)
+
+				r.NoError(notifier.Send(root, acc.ID, opt.NewEmpty[account.AccountID](), notification.EventPostLike, nil))
+				r.NoError(notifier.Send(root, acc.ID, opt.NewEmpty[account.AccountID](), notification.EventFollow, nil))
+
+				var list *openapi.NotificationListResponse
+				r.Eventually(func() bool {
+					list, err = cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, session)
+					tests.Ok(t, err, list)
+					return len(list.JSON200.Notifications) > 0
+				}, 5*time.Second, 100*time.Millisecond)
+
+				// Give the like a chance to be handled before asserting it was dropped.
+				time.Sleep(200 * time.Millisecond)
+				list, err = cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, session)
+				tests.Ok(t, err, list)
+
+				a.Len(list.JSON200.Notifications, 1)
+				a.Equal(openapi.NotificationEventFollow, list.JSON200.Notifications[0].Event)
+			})
+
+			t.Run("invalid_event", func(t *testing.T) {
+				ctx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+				res, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences: openapi.NotificationPreferenceList{
+						{Event: "not_an_event", InApp: true},
+					},
+				}, sh.WithSession(ctx))
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				res, err := cl.AccountNotificationPreferencesGetWithResponse(root)
+				tests.Status(t, err, res, http.StatusUnauthorized)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,270 @@
+package notification_test
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/safehttp"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type pushService struct {
+	*httptest.Server
+	mu       sync.Mutex
+	received []*http.Request
+}
+
+func newPushService(t *testing.T) *pushService {
+	ps := &pushService{}
+	ps.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ps.mu.Lock()
+		ps.received = append(ps.received, r)
+		ps.mu.Unlock()
+
+		if strings.HasPrefix(r.URL.Path, "/gone") {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(ps.Close)
+	return ps
+}
+
+func (ps *pushService) requests(path string) []*http.Request {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	var out []*http.Request
+	for _, r := range ps.received {
+		if r.URL.Path == path {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func browserKeys(t *testing.T) openapi.PushSubscriptionKeys {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+
+	return openapi.PushSubscriptionKeys{
+		P256dh: base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		Auth:   base64.RawURLEncoding.EncodeToString(auth),
+	}
+}
+
+func TestPushSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	vapid, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	raw, err := vapid.Bytes()
+	require.NoError(t, err)
+
+	ps := newPushService(t)
+
+	integration.Test(t, &config.Config{
+		WebPushVAPIDPrivateKey: base64.RawURLEncoding.EncodeToString(raw),
+		WebPushSubject:         "mailto:admin@example.com",
+		// The push service in this test listens on loopback.
+		AllowedPrivateAddresses: []string{"127.0.0.1"},
+	}, e2e.Setup(), fx.Decorate(func(p *safehttp.Policy) *safehttp.Policy {
+		roots := x509.NewCertPool()
+		roots.AddCert(ps.Certificate())
+		return p.WithRootCAs(roots)
+	}), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		notifier *notify.Notifier,
+	) {
+		lc.Append(fx.StartHook(func() {
+			t.Run("subscribe_and_deliver", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ctx, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				session := sh.WithSession(ctx)
+
+				list, err := cl.NotificationPushSubscriptionListWithResponse(root, session)
+				tests.Ok(t, err, list)
+				r.NotNil(list.JSON200.VapidPublicKey)
+				a.Empty(list.JSON200.Subscriptions)
+
+				endpoint := ps.URL + "/device/" + acc.ID.String()
+				sub, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+					Endpoint: endpoint,
+					Keys:     browserKeys(t),
+				}, session)
+				tests.Ok(t, err, sub)
+				a.Equal(endpoint, sub.JSON200.Endpoint)
+
+				// Re-subscribing the same browser replaces rather than duplicates.
+				again, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+					Endpoint: endpoint,
+					Keys:     browserKeys(t),
+				}, session)
+				tests.Ok(t, err, again)
+				a.Equal(sub.JSON200.Id, again.JSON200.Id)
+
+				r.NoError(notifier.Send(root, acc.ID, opt.NewEmpty[account.AccountID](), notification.EventProfileMention, nil))
+
+				r.Eventually(func() bool {
+					return len(ps.requests("/device/"+acc.ID.String())) == 1
+				}, 5*time.Second, 100*time.Millisecond)
+
+				req := ps.requests("/device/" + acc.ID.String())[0]
+				a.Equal("aes128gcm", req.Header.Get("Content-Encoding"))
+				a.True(strings.HasPrefix(req.Header.Get("Authorization"), "vapid t="))
+				a.Contains(req.Header.Get("Authorization"), "k="+*list.JSON200.VapidPublicKey)
+
+				del, err := cl.NotificationPushSubscriptionDeleteWithResponse(root, sub.JSON200.Id, session)
+				tests.Status(t, err, del, http.StatusNoContent)
+
+				list, err = cl.NotificationPushSubscriptionListWithResponse(root, session)
+				tests.Ok(t, err, list)
+				a.Empty(list.JSON200.Subscriptions)
+			})
+
+			t.Run("gone_subscription_pruned", func(t *testing.T) {
+				r := require.New(t)
+
+				ctx, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				session := sh.WithSession(ctx)
+
+				sub, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+					Endpoint: ps.URL + "/gone/" + acc.ID.String(),
+					Keys:     browserKeys(t),
+				}, session)
+				tests.Ok(t, err, sub)
+
+				r.NoError(notifier.Send(root, acc.ID, opt.NewEmpty[account.AccountID](), notification.EventProfileMention, nil))
+
+				r.Eventually(func() bool {
+					list, err := cl.NotificationPushSubscriptionListWithResponse(root, session)
+					tests.Ok(t, err, list)
+					return len(list.JSON200.Subscriptions) == 0
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("expired_subscription_pruned", func(t *testing.T) {
+				r := require.New(t)
+
+				ctx, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				session := sh.WithSession(ctx)
+
+				sub, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+					Endpoint:       ps.URL + "/expired/" + acc.ID.String(),
+					Keys:           browserKeys(t),
+					ExpirationTime: opt.New(time.Now().Add(-time.Minute)).Ptr(),
+				}, session)
+				tests.Ok(t, err, sub)
+
+				r.NoError(notifier.Send(root, acc.ID, opt.NewEmpty[account.AccountID](), notification.EventProfileMention, nil))
+
+				r.Eventually(func() bool {
+					list, err := cl.NotificationPushSubscriptionListWithResponse(root, session)
+					tests.Ok(t, err, list)
+					return len(list.JSON200.Subscriptions) == 0
+				}, 5*time.Second, 100*time.Millisecond)
+
+				r.Empty(ps.requests("/expired/" + acc.ID.String()))
+			})
+
+			t.Run("insecure_endpoint", func(t *testing.T) {
+				ctx, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+				res, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+					Endpoint: strings.Replace(ps.URL, "https://", "http://", 1) + "/device/" + acc.ID.String(),
+					Keys:     browserKeys(t),
+				}, sh.WithSession(ctx))
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("invalid_keys", func(t *testing.T) {
+				ctx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+				res, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+					Endpoint: ps.URL + "/invalid",
+					Keys:     openapi.PushSubscriptionKeys{P256dh: "AQID", Auth: "AQID"},
+				}, sh.WithSession(ctx))
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("delete_other_members", func(t *testing.T) {
+				ctx1, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				ctx2, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+
+				sub, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+					Endpoint: ps.URL + "/device/" + acc.ID.String() + "/other",
+					Keys:     browserKeys(t),
+				}, sh.WithSession(ctx1))
+				tests.Ok(t, err, sub)
+
+				del, err := cl.NotificationPushSubscriptionDeleteWithResponse(root, sub.JSON200.Id, sh.WithSession(ctx2))
+				tests.Status(t, err, del, http.StatusNotFound)
+			})
+		}))
+	}))
+}
+
+func TestPushSubscriptionsDisabled(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			ctx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			session := sh.WithSession(ctx)
+
+			list, err := cl.NotificationPushSubscriptionListWithResponse(root, session)
+			tests.Ok(t, err, list)
+			a.Nil(list.JSON200.VapidPublicKey)
+
+			res, err := cl.NotificationPushSubscriptionCreateWithResponse(root, openapi.PushSubscriptionInitialProps{
+				Endpoint: "https://push.example.com/abc",
+				Keys:     browserKeys(t),
+			}, session)
+			tests.Status(t, err, res, http.StatusBadRequest)
+		}))
+	}))
+}
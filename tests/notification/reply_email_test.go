@@ -0,0 +1,194 @@
+package notification_test
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/reply/reply_email"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestReplyEmail(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		JWTSecret:        []byte("07d422e512b23a056ccc953994d1593f"),
+		EmailReplyDomain: "reply.storyden.test",
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		er *email.Repository,
+		mailbox *reply_email.Mailbox,
+		sender mailer.Sender,
+	) {
+		inbox := sender.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			authorCtx, author := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			authorSession := sh.WithSession(authorCtx)
+
+			replierCtx, replier := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			replierSession := sh.WithSession(replierCtx)
+
+			address := xid.New().String() + "@storyden.org"
+			_, err := er.Add(root, author.ID, mail.Address{Address: address}, "")
+			require.NoError(t, err)
+			require.NoError(t, er.Verify(root, author.ID, mail.Address{Address: address}))
+
+			cat := tests.AssertRequest(
+				cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession),
+			)(t, http.StatusOK)
+
+			title := "Reply email " + uuid.NewString()
+			thread := tests.AssertRequest(
+				cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>thread</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      title,
+				}, authorSession),
+			)(t, http.StatusOK)
+
+			inboxFor := func() []mailer.MockEmail {
+				return lo.Filter(inbox.GetAll(), func(m mailer.MockEmail, _ int) bool {
+					return m.Address.Address == address
+				})
+			}
+
+			reply := func(body string) {
+				tests.AssertRequest(
+					cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: body}, replierSession),
+				)(t, http.StatusOK)
+			}
+
+			t.Run("not_emailed_by_default", func(t *testing.T) {
+				reply("<p>quiet reply</p>")
+
+				time.Sleep(500 * time.Millisecond)
+				assert.Empty(t, inboxFor())
+			})
+
+			tests.AssertRequest(
+				cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences: openapi.NotificationPreferenceList{
+						{Event: openapi.NotificationEventThreadReply, InApp: true, Email: true},
+					},
+				}, authorSession),
+			)(t, http.StatusOK)
+
+			var replyTo mail.Address
+
+			t.Run("emailed_in_full", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				reply("<p>First paragraph of the reply.</p><p>Second paragraph.</p>")
+
+				var sent mailer.MockEmail
+				r.Eventually(func() bool {
+					got := inboxFor()
+					if len(got) == 0 {
+						return false
+					}
+					sent = got[0]
+					return true
+				}, 5*time.Second, 100*time.Millisecond)
+
+				a.Equal(replier.Name+" replied to "+title, sent.Subject)
+				a.Contains(sent.Plain, "First paragraph of the reply.")
+				a.Contains(sent.Plain, "Second paragraph.")
+				a.Contains(sent.Plain, "/t/"+thread.JSON200.Slug+"#")
+
+				var ok bool
+				replyTo, ok = sent.ReplyTo.Get()
+				r.True(ok)
+				a.True(strings.HasSuffix(replyTo.Address, "@reply.storyden.test"))
+			})
+
+			t.Run("reply_by_email", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				posted, err := mailbox.Receive(root, reply_email.Inbound{
+					To:   []string{"Storyden <" + replyTo.Address + ">"},
+					From: "Baldur <" + strings.ToUpper(address) + ">",
+					Text: "Thanks for this!\n\nOn Mon, 10 Mar 2025 at 09:00, Storyden <" + replyTo.Address + "> wrote:\n> First paragraph of the reply.",
+				})
+				r.NoError(err)
+				a.Equal(author.ID.String(), posted.Author.ID.String())
+
+				get := tests.AssertRequest(
+					cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, authorSession),
+				)(t, http.StatusOK)
+
+				found, ok := lo.Find(get.JSON200.Replies.Replies, func(p openapi.Reply) bool { return p.Id == posted.ID.String() })
+				r.True(ok)
+				a.Contains(found.Body, "Thanks for this!")
+				a.NotContains(found.Body, "First paragraph")
+			})
+
+			t.Run("wrong_sender", func(t *testing.T) {
+				_, err := mailbox.Receive(root, reply_email.Inbound{
+					To:   []string{replyTo.Address},
+					From: "someone@example.com",
+					Text: "Not me",
+				})
+				assert.Error(t, err)
+			})
+
+			t.Run("tampered_token", func(t *testing.T) {
+				local, domain, _ := strings.Cut(replyTo.Address, "@")
+				tampered := local[:len(local)-1] + lo.Ternary(strings.HasSuffix(local, "a"), "b", "a") + "@" + domain
+
+				_, err := mailbox.Receive(root, reply_email.Inbound{
+					To:   []string{tampered},
+					From: address,
+					Text: "Hello",
+				})
+				assert.Error(t, err)
+			})
+
+			t.Run("warned", func(t *testing.T) {
+				tests.AssertRequest(cl.ModerationWarningIssueWithResponse(root, author.ID.String(), openapi.WarningInitialProps{
+					Severity: openapi.Severe,
+					Reason:   "Harassing other members.",
+				}, adminSession))(t, http.StatusOK)
+
+				_, err := mailbox.Receive(root, reply_email.Inbound{
+					To:   []string{replyTo.Address},
+					From: address,
+					Text: "Hello",
+				})
+				require.Error(t, err)
+				assert.Equal(t, ftag.PermissionDenied, ftag.Get(err))
+			})
+		}))
+	}))
+}
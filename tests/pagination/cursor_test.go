@@ -0,0 +1,157 @@
+package pagination_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// Listings are served in pages of 50, so 55 items spans two pages.
+const total = 55
+
+func TestCursorPagination(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			authorCtx, author := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			replierCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			authorSession := sh.WithSession(authorCtx)
+			replierSession := sh.WithSession(replierCtx)
+
+			createThread := func(t *testing.T) *openapi.ThreadCreateResponse {
+				t.Helper()
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>paging</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "paging " + uuid.NewString(),
+				}, authorSession)
+				tests.Ok(t, err, thread)
+				return thread
+			}
+
+			t.Run("threads", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				for range total {
+					createThread(t)
+				}
+
+				params := &openapi.ThreadListParams{Author: &author.Handle}
+
+				first, err := cl.ThreadListWithResponse(root, params)
+				tests.Ok(t, err, first)
+				r.Len(first.JSON200.Threads, 50)
+				r.NotNil(first.JSON200.NextCursor)
+
+				// A new thread at the top of the list would push the last item
+				// of the first page on to the second page with page numbers.
+				createThread(t)
+
+				params.Cursor = first.JSON200.NextCursor
+				second, err := cl.ThreadListWithResponse(root, params)
+				tests.Ok(t, err, second)
+				a.Len(second.JSON200.Threads, total-50)
+				a.Nil(second.JSON200.NextCursor)
+
+				ids := func(ts []openapi.ThreadReference) []string {
+					return dt.Map(ts, func(t openapi.ThreadReference) string { return t.Id })
+				}
+				all := append(ids(first.JSON200.Threads), ids(second.JSON200.Threads)...)
+				a.Len(all, total)
+				a.ElementsMatch(all, lo.Uniq(all), "no thread is listed twice")
+			})
+
+			t.Run("replies_and_notifications", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				thread := createThread(t)
+
+				for i := range total {
+					reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+						Body: fmt.Sprintf("<p>reply %d</p>", i),
+					}, replierSession)
+					tests.Ok(t, err, reply)
+				}
+
+				first, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, &openapi.ThreadGetParams{})
+				tests.Ok(t, err, first)
+				r.Len(first.JSON200.Replies.Replies, 50)
+				r.NotNil(first.JSON200.Replies.NextCursor)
+
+				second, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, &openapi.ThreadGetParams{Cursor: first.JSON200.Replies.NextCursor})
+				tests.Ok(t, err, second)
+				a.Len(second.JSON200.Replies.Replies, total-50)
+				a.Nil(second.JSON200.Replies.NextCursor)
+				a.Contains(second.JSON200.Replies.Replies[0].Body, "reply 50")
+
+				// Each reply notifies the thread's author, which happens async.
+				var page *openapi.NotificationListResponse
+				r.Eventually(func() bool {
+					page, err = cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, authorSession)
+					return err == nil && page.JSON200 != nil && page.JSON200.TotalPages == 2
+				}, 10*time.Second, 100*time.Millisecond)
+				r.Len(page.JSON200.Notifications, 50)
+				r.NotNil(page.JSON200.NextCursor)
+
+				rest, err := cl.NotificationListWithResponse(root, &openapi.NotificationListParams{Cursor: page.JSON200.NextCursor}, authorSession)
+				tests.Ok(t, err, rest)
+				a.Len(rest.JSON200.Notifications, total-50)
+				a.Nil(rest.JSON200.NextCursor)
+			})
+
+			t.Run("profiles", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				name := "pager" + uuid.NewString()[:8]
+				for range total {
+					e2e.WithAccount(root, aw, account.Account{Handle: "pager", Name: name})
+				}
+
+				first, err := cl.ProfileListWithResponse(root, &openapi.ProfileListParams{Q: &name})
+				tests.Ok(t, err, first)
+				r.Len(first.JSON200.Profiles, 50)
+				r.NotNil(first.JSON200.NextCursor)
+
+				second, err := cl.ProfileListWithResponse(root, &openapi.ProfileListParams{Q: &name, Cursor: first.JSON200.NextCursor})
+				tests.Ok(t, err, second)
+				a.Len(second.JSON200.Profiles, total-50)
+				a.Nil(second.JSON200.NextCursor)
+			})
+
+			t.Run("invalid_cursor", func(t *testing.T) {
+				bad := "not-a-cursor"
+				resp, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{Cursor: &bad})
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+			})
+		}))
+	}))
+}
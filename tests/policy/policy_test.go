@@ -0,0 +1,213 @@
+package policy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestPolicyAcceptance(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			signup, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: xid.New().String(), Token: "password"})
+			tests.Ok(t, err, signup)
+			memberID := signup.JSON200.Id
+			member := sh.WithSession(e2e.WithAccountID(root, account.AccountID(utils.Must(xid.FromString(memberID)))))
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			post := func() (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "policy test",
+				}, member)
+			}
+
+			slug := "terms-" + xid.New().String()
+
+			t.Run("member_cannot_manage", func(t *testing.T) {
+				res, err := cl.PolicyCreateWithResponse(root, openapi.PolicyInitialProps{Slug: slug, Title: "Terms"}, member)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			doc, err := cl.PolicyCreateWithResponse(root, openapi.PolicyInitialProps{Slug: slug, Title: "Terms of Service"}, adminSession)
+			tests.Ok(t, err, doc)
+			a.True(doc.JSON200.Required)
+			a.Nil(doc.JSON200.Current)
+
+			t.Run("unpublished_hidden_and_not_enforced", func(t *testing.T) {
+				list, err := cl.PolicyListWithResponse(root, member)
+				tests.Ok(t, err, list)
+				for _, p := range list.JSON200.Policies {
+					a.NotEqual(slug, p.Slug)
+				}
+
+				res, err := post()
+				tests.Ok(t, err, res)
+			})
+
+			v1, err := cl.PolicyVersionCreateWithResponse(root, slug, openapi.PolicyVersionInitialProps{Content: "<p>Be nice.</p>"}, adminSession)
+			tests.Ok(t, err, v1)
+			a.Equal(1, v1.JSON200.Version)
+			a.Nil(v1.JSON200.PublishedAt)
+
+			pub1, err := cl.PolicyVersionPublishWithResponse(root, slug, v1.JSON200.Id, adminSession)
+			tests.Ok(t, err, pub1)
+			r.NotNil(pub1.JSON200.Current)
+			a.Equal(v1.JSON200.Id, pub1.JSON200.Current.Id)
+
+			t.Run("publish_twice", func(t *testing.T) {
+				res, err := cl.PolicyVersionPublishWithResponse(root, slug, v1.JSON200.Id, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("blocked_until_accepted", func(t *testing.T) {
+				status, err := cl.AccountPolicyListWithResponse(root, member)
+				tests.Ok(t, err, status)
+				r.Len(status.JSON200.Policies, 1)
+				a.True(status.JSON200.Policies[0].Pending)
+				a.Nil(status.JSON200.Policies[0].PreviousVersion)
+
+				res, err := post()
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				// Admins are never asked to accept before posting.
+				adminPost, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "admin post",
+				}, adminSession)
+				tests.Ok(t, err, adminPost)
+			})
+
+			t.Run("accept", func(t *testing.T) {
+				accept, err := cl.AccountPolicyAcceptWithResponse(root, slug, openapi.PolicyAcceptProps{VersionId: v1.JSON200.Id}, member)
+				tests.Ok(t, err, accept)
+				r.Len(accept.JSON200.Policies, 1)
+				a.False(accept.JSON200.Policies[0].Pending)
+				a.NotNil(accept.JSON200.Policies[0].AcceptedAt)
+
+				again, err := cl.AccountPolicyAcceptWithResponse(root, slug, openapi.PolicyAcceptProps{VersionId: v1.JSON200.Id}, member)
+				tests.Ok(t, err, again)
+
+				res, err := post()
+				tests.Ok(t, err, res)
+			})
+
+			v2, err := cl.PolicyVersionCreateWithResponse(root, slug, openapi.PolicyVersionInitialProps{
+				Content: "<p>Be very nice.</p>",
+				Summary: opt.New("Stricter").Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, v2)
+			a.Equal(2, v2.JSON200.Version)
+
+			t.Run("draft_not_enforced", func(t *testing.T) {
+				res, err := post()
+				tests.Ok(t, err, res)
+
+				accept, err := cl.AccountPolicyAcceptWithResponse(root, slug, openapi.PolicyAcceptProps{VersionId: v2.JSON200.Id}, member)
+				tests.Status(t, err, accept, http.StatusBadRequest)
+			})
+
+			pub2, err := cl.PolicyVersionPublishWithResponse(root, slug, v2.JSON200.Id, adminSession)
+			tests.Ok(t, err, pub2)
+
+			t.Run("reacceptance_required", func(t *testing.T) {
+				status, err := cl.AccountPolicyListWithResponse(root, member)
+				tests.Ok(t, err, status)
+				r.Len(status.JSON200.Policies, 1)
+				a.True(status.JSON200.Policies[0].Pending)
+				r.NotNil(status.JSON200.Policies[0].PreviousVersion)
+				a.Equal(1, *status.JSON200.Policies[0].PreviousVersion)
+
+				res, err := post()
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				outdated, err := cl.AccountPolicyAcceptWithResponse(root, slug, openapi.PolicyAcceptProps{VersionId: v1.JSON200.Id}, member)
+				tests.Status(t, err, outdated, http.StatusBadRequest)
+
+				accept, err := cl.AccountPolicyAcceptWithResponse(root, slug, openapi.PolicyAcceptProps{VersionId: v2.JSON200.Id}, member)
+				tests.Ok(t, err, accept)
+
+				res, err = post()
+				tests.Ok(t, err, res)
+			})
+
+			t.Run("acceptance_records", func(t *testing.T) {
+				versions, err := cl.PolicyVersionListWithResponse(root, slug, adminSession)
+				tests.Ok(t, err, versions)
+				r.Len(versions.JSON200.Versions, 2)
+				a.Equal(2, versions.JSON200.Versions[0].Version)
+				a.Equal(1, versions.JSON200.Versions[0].Acceptances)
+				a.Equal(1, versions.JSON200.Versions[1].Acceptances)
+
+				records, err := cl.PolicyAcceptanceListWithResponse(root, slug, v1.JSON200.Id, adminSession)
+				tests.Ok(t, err, records)
+				r.Len(records.JSON200.Acceptances, 1)
+				a.Equal(memberID, records.JSON200.Acceptances[0].Profile.Id)
+
+				memberRecords, err := cl.PolicyAcceptanceListWithResponse(root, slug, v1.JSON200.Id, member)
+				tests.Status(t, err, memberRecords, http.StatusForbidden)
+			})
+
+			t.Run("not_required", func(t *testing.T) {
+				v3, err := cl.PolicyVersionCreateWithResponse(root, slug, openapi.PolicyVersionInitialProps{Content: "<p>Be kind.</p>"}, adminSession)
+				tests.Ok(t, err, v3)
+				pub3, err := cl.PolicyVersionPublishWithResponse(root, slug, v3.JSON200.Id, adminSession)
+				tests.Ok(t, err, pub3)
+
+				res, err := post()
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				update, err := cl.PolicyUpdateWithResponse(root, slug, openapi.PolicyMutableProps{Required: opt.New(false).Ptr()}, adminSession)
+				tests.Ok(t, err, update)
+				a.False(update.JSON200.Required)
+
+				res, err = post()
+				tests.Ok(t, err, res)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				del, err := cl.PolicyDeleteWithResponse(root, slug, adminSession)
+				tests.Ok(t, err, del)
+
+				get, err := cl.PolicyGetWithResponse(root, slug)
+				tests.Status(t, err, get, http.StatusNotFound)
+			})
+		}))
+	}))
+}
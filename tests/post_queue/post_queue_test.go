@@ -0,0 +1,161 @@
+package post_queue_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestNewMemberApprovalQueue(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			newCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			newSession := sh.WithSession(newCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			otherSession := sh.WithSession(otherCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func(session openapi.RequestEditorFn) *openapi.ThreadCreateResponse {
+				res, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "queue " + uuid.NewString(),
+				}, session)
+				tests.Ok(t, err, res)
+				return res
+			}
+
+			queued := func() []string {
+				res, err := cl.PostQueueListWithResponse(root, nil, adminSession)
+				tests.Ok(t, err, res)
+				return dt.Map(res.JSON200.Posts, func(p openapi.QueuedPost) string { return p.Post.Id })
+			}
+
+			before := createThread(newSession)
+			a.Equal(openapi.Published, before.JSON200.Visibility)
+
+			set, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+				NewMemberApprovals: opt.New(2).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, set)
+			a.Equal(2, *set.JSON200.NewMemberApprovals)
+
+			freshCtx, _ := e2e.WithAccount(root, aw, seed.Account_005_Þórr)
+			freshSession := sh.WithSession(freshCtx)
+
+			adminThread := createThread(adminSession)
+			a.Equal(openapi.Published, adminThread.JSON200.Visibility)
+
+			heldThread := createThread(freshSession)
+			a.Equal(openapi.Review, heldThread.JSON200.Visibility)
+
+			heldReply, err := cl.ReplyCreateWithResponse(root, adminThread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>first reply</p>"}, freshSession)
+			tests.Ok(t, err, heldReply)
+
+			replyIDs := func(session openapi.RequestEditorFn) []string {
+				get, err := cl.ThreadGetWithResponse(root, adminThread.JSON200.Slug, nil, session)
+				tests.Ok(t, err, get)
+				return dt.Map(get.JSON200.Replies.Replies, func(r openapi.Reply) string { return r.Id })
+			}
+
+			a.NotContains(replyIDs(otherSession), heldReply.JSON200.Id)
+			a.Contains(replyIDs(freshSession), heldReply.JSON200.Id)
+
+			t.Run("members_forbidden", func(t *testing.T) {
+				list, err := cl.PostQueueListWithResponse(root, nil, otherSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+
+				upd, err := cl.PostQueueUpdateWithResponse(root, heldThread.JSON200.Id, openapi.PostQueueMutableProps{Action: openapi.Approve}, otherSession)
+				tests.Status(t, err, upd, http.StatusForbidden)
+			})
+
+			t.Run("list", func(t *testing.T) {
+				res, err := cl.PostQueueListWithResponse(root, nil, adminSession)
+				tests.Ok(t, err, res)
+
+				kinds := map[string]openapi.DatagraphItemKind{}
+				for _, p := range res.JSON200.Posts {
+					kinds[p.Post.Id] = p.Kind
+				}
+
+				a.Equal(openapi.DatagraphItemKindThread, kinds[heldThread.JSON200.Id])
+				a.Equal(openapi.DatagraphItemKindReply, kinds[heldReply.JSON200.Id])
+				a.NotContains(kinds, before.JSON200.Id)
+			})
+
+			t.Run("approve_and_graduate", func(t *testing.T) {
+				approveThread, err := cl.PostQueueUpdateWithResponse(root, heldThread.JSON200.Id, openapi.PostQueueMutableProps{Action: openapi.Approve}, adminSession)
+				tests.Ok(t, err, approveThread)
+
+				get, err := cl.ThreadGetWithResponse(root, heldThread.JSON200.Slug, nil, otherSession)
+				tests.Ok(t, err, get)
+				a.Equal(openapi.Published, get.JSON200.Visibility)
+
+				stillHeld := createThread(freshSession)
+				a.Equal(openapi.Review, stillHeld.JSON200.Visibility)
+
+				approveReply, err := cl.PostQueueUpdateWithResponse(root, heldReply.JSON200.Id, openapi.PostQueueMutableProps{Action: openapi.Approve}, adminSession)
+				tests.Ok(t, err, approveReply)
+				a.Equal(openapi.DatagraphItemKindReply, approveReply.JSON200.Kind)
+
+				a.Contains(replyIDs(otherSession), heldReply.JSON200.Id)
+
+				graduated := createThread(freshSession)
+				a.Equal(openapi.Published, graduated.JSON200.Visibility)
+
+				ids := queued()
+				a.NotContains(ids, heldThread.JSON200.Id)
+				a.NotContains(ids, heldReply.JSON200.Id)
+				a.Contains(ids, stillHeld.JSON200.Id)
+
+				again, err := cl.PostQueueUpdateWithResponse(root, heldThread.JSON200.Id, openapi.PostQueueMutableProps{Action: openapi.Approve}, adminSession)
+				tests.Status(t, err, again, http.StatusNotFound)
+			})
+
+			t.Run("remove", func(t *testing.T) {
+				removedCtx, _ := e2e.WithAccount(root, aw, seed.Account_005_Þórr)
+				held := createThread(sh.WithSession(removedCtx))
+				r.Equal(openapi.Review, held.JSON200.Visibility)
+
+				res, err := cl.PostQueueUpdateWithResponse(root, held.JSON200.Id, openapi.PostQueueMutableProps{Action: openapi.Remove}, adminSession)
+				tests.Ok(t, err, res)
+				a.NotNil(res.JSON200.Post.DeletedAt)
+
+				a.NotContains(queued(), held.JSON200.Id)
+
+				get, err := cl.ThreadGetWithResponse(root, held.JSON200.Slug, nil, adminSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,154 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestProfileActivity(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+			viewerCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			viewerSession := sh.WithSession(viewerCtx)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        uuid.NewString(),
+				Description: "activity",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+
+			create := func(vis openapi.Visibility) *openapi.ThreadCreateOK {
+				return tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>what I've been up to</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(vis).Ptr(),
+					Title:      "activity test " + uuid.NewString(),
+				}, memberSession))(t, http.StatusOK).JSON200
+			}
+
+			thread := create(openapi.Published)
+			create(openapi.Draft)
+
+			reply := tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.Slug, nil, openapi.ReplyInitialProps{Body: "<p>and another thing</p>"}, memberSession))(t, http.StatusOK)
+
+			node := tests.AssertRequest(cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{Name: "activity page", Slug: opt.New(uuid.NewString()).Ptr()}, memberSession))(t, http.StatusOK)
+			tests.AssertRequest(cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{Name: "draft page", Slug: opt.New(uuid.NewString()).Ptr()}, memberSession))(t, http.StatusOK)
+			tests.AssertRequest(cl.NodeUpdateVisibilityWithResponse(root, node.JSON200.Slug, openapi.VisibilityMutationProps{
+				Visibility: openapi.Published,
+			}, adminSession))(t, http.StatusOK)
+
+			col := tests.AssertRequest(cl.CollectionCreateWithResponse(root, openapi.CollectionInitialProps{Name: "activity collection"}, memberSession))(t, http.StatusOK)
+
+			list := func(t *testing.T, params *openapi.ProfileActivityListParams, session ...openapi.RequestEditorFn) *openapi.ProfileActivityListOK {
+				return tests.AssertRequest(cl.ProfileActivityListWithResponse(root, member.Handle, params, session...))(t, http.StatusOK).JSON200
+			}
+
+			ids := func(activity openapi.ProfileActivityList) []string {
+				return dt.Map(activity, func(a openapi.ProfileActivity) string { return a.Id })
+			}
+
+			t.Run("list", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res := list(t, nil)
+				a.Equal([]string{col.JSON200.Id, node.JSON200.Id, reply.JSON200.Id, thread.Id}, ids(res.Activity))
+				a.Equal([]openapi.ProfileActivityKind{
+					openapi.ProfileActivityKindCollectionPublished,
+					openapi.ProfileActivityKindNodeUpdated,
+					openapi.ProfileActivityKindReplyCreated,
+					openapi.ProfileActivityKindThreadCreated,
+				}, dt.Map(res.Activity, func(a openapi.ProfileActivity) openapi.ProfileActivityKind { return a.Kind }))
+
+				r.NotNil(res.Activity[0].Collection)
+				a.Nil(res.Activity[0].Item)
+				r.NotNil(res.Activity[3].Item)
+				a.Nil(res.Activity[3].Collection)
+				a.Nil(res.NextCursor)
+
+				threads := list(t, &openapi.ProfileActivityListParams{Kind: opt.New(openapi.ProfileActivityKindThreadCreated).Ptr()})
+				a.Equal([]string{thread.Id}, ids(threads.Activity))
+
+				tests.AssertRequest(cl.ProfileActivityListWithResponse(root, "nobody-"+uuid.NewString(), nil))(t, http.StatusNotFound)
+			})
+
+			t.Run("privacy", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				acc := tests.AssertRequest(cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Privacy: &openapi.AccountPrivacy{HiddenActivity: &openapi.ProfileActivityKindList{openapi.ProfileActivityKindReplyCreated, openapi.ProfileActivityKindNodeUpdated}},
+				}, memberSession))(t, http.StatusOK)
+				r.NotNil(acc.JSON200.Privacy.HiddenActivity)
+				a.ElementsMatch([]openapi.ProfileActivityKind{openapi.ProfileActivityKindReplyCreated, openapi.ProfileActivityKindNodeUpdated}, *acc.JSON200.Privacy.HiddenActivity)
+
+				a.Equal([]string{col.JSON200.Id, thread.Id}, ids(list(t, nil).Activity))
+				a.Equal([]string{col.JSON200.Id, thread.Id}, ids(list(t, nil, viewerSession).Activity))
+				a.Empty(list(t, &openapi.ProfileActivityListParams{Kind: opt.New(openapi.ProfileActivityKindReplyCreated).Ptr()}, viewerSession).Activity)
+
+				// Members always see everything on their own timeline.
+				a.Equal([]string{col.JSON200.Id, node.JSON200.Id, reply.JSON200.Id, thread.Id}, ids(list(t, nil, memberSession).Activity))
+
+				// Other account changes leave the preference alone.
+				acc = tests.AssertRequest(cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Privacy: &openapi.AccountPrivacy{ReadingHistory: opt.New(true).Ptr()},
+				}, memberSession))(t, http.StatusOK)
+				a.Len(*acc.JSON200.Privacy.HiddenActivity, 2)
+
+				tests.AssertRequest(cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Privacy: &openapi.AccountPrivacy{HiddenActivity: &openapi.ProfileActivityKindList{}},
+				}, memberSession))(t, http.StatusOK)
+
+				a.Len(list(t, nil).Activity, 4)
+			})
+
+			t.Run("pagination", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				replies := []string{}
+				for i := range 50 {
+					reply := tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.Slug, nil, openapi.ReplyInitialProps{Body: fmt.Sprintf("<p>reply %d</p>", i)}, memberSession))(t, http.StatusOK)
+					replies = append([]string{reply.JSON200.Id}, replies...)
+				}
+
+				first := list(t, nil)
+				a.Equal(replies, ids(first.Activity))
+				a.Equal(50, first.Results)
+				r.NotNil(first.NextCursor)
+
+				second := list(t, &openapi.ProfileActivityListParams{Cursor: first.NextCursor})
+				a.Equal([]string{col.JSON200.Id, node.JSON200.Id, reply.JSON200.Id, thread.Id}, ids(second.Activity))
+				a.Nil(second.NextCursor)
+			})
+		}))
+	}))
+}
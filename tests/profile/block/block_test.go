@@ -0,0 +1,125 @@
+package block_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestBlocking(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			baldurCtx, baldur := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			baldurSession := sh.WithSession(baldurCtx)
+			lokiCtx, loki := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			lokiSession := sh.WithSession(lokiCtx)
+			freyjaCtx, freyja := e2e.WithAccount(root, aw, seed.Account_006_Freyja)
+			freyjaSession := sh.WithSession(freyjaCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			threadIDs := func(t *testing.T, author string, session openapi.RequestEditorFn) []string {
+				list, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{Author: &author}, session)
+				tests.Ok(t, err, list)
+				return dt.Map(list.JSON200.Threads, func(th openapi.ThreadReference) string { return th.Id })
+			}
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>mischief</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "block test " + uuid.NewString(),
+			}, lokiSession)
+			tests.Ok(t, err, thread)
+
+			t.Run("cannot_block_self", func(t *testing.T) {
+				res, err := cl.ProfileBlockSetWithResponse(root, baldur.Handle, openapi.ProfileBlockSetJSONRequestBody{Kind: openapi.BlockKindBlock}, baldurSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("guest_cannot_block", func(t *testing.T) {
+				res, err := cl.ProfileBlockSetWithResponse(root, loki.Handle, openapi.ProfileBlockSetJSONRequestBody{Kind: openapi.BlockKindBlock})
+				tests.Status(t, err, res, http.StatusUnauthorized)
+			})
+
+			t.Run("block_hides_content_and_prevents_messages", func(t *testing.T) {
+				a := assert.New(t)
+
+				a.Contains(threadIDs(t, loki.Handle, baldurSession), thread.JSON200.Id)
+
+				follow, err := cl.ProfileFollowersAddWithResponse(root, baldur.Handle, lokiSession)
+				tests.Ok(t, err, follow)
+
+				set, err := cl.ProfileBlockSetWithResponse(root, loki.Handle, openapi.ProfileBlockSetJSONRequestBody{Kind: openapi.BlockKindBlock}, baldurSession)
+				tests.Status(t, err, set, http.StatusNoContent)
+
+				list, err := cl.AccountBlockListWithResponse(root, baldurSession)
+				tests.Ok(t, err, list)
+				require.Len(t, list.JSON200.Blocks, 1)
+				a.Equal(loki.ID.String(), list.JSON200.Blocks[0].Profile.Id)
+				a.Equal(openapi.BlockKindBlock, list.JSON200.Blocks[0].Kind)
+
+				a.NotContains(threadIDs(t, loki.Handle, baldurSession), thread.JSON200.Id)
+				a.Contains(threadIDs(t, loki.Handle, freyjaSession), thread.JSON200.Id)
+
+				followers, err := cl.ProfileFollowersGetWithResponse(root, baldur.Handle, &openapi.ProfileFollowersGetParams{})
+				tests.Ok(t, err, followers)
+				a.Empty(followers.JSON200.Followers)
+
+				refollow, err := cl.ProfileFollowersAddWithResponse(root, baldur.Handle, lokiSession)
+				tests.Status(t, err, refollow, http.StatusForbidden)
+
+				dm, err := cl.ConversationCreateWithResponse(root, openapi.ConversationCreateJSONRequestBody{
+					Recipients: []openapi.Identifier{baldur.ID.String()},
+					Body:       "<p>let me in</p>",
+				}, lokiSession)
+				tests.Status(t, err, dm, http.StatusForbidden)
+
+				remove, err := cl.ProfileBlockRemoveWithResponse(root, loki.Handle, baldurSession)
+				tests.Status(t, err, remove, http.StatusNoContent)
+
+				a.Contains(threadIDs(t, loki.Handle, baldurSession), thread.JSON200.Id)
+			})
+
+			t.Run("mute_hides_content_but_allows_messages", func(t *testing.T) {
+				a := assert.New(t)
+
+				set, err := cl.ProfileBlockSetWithResponse(root, loki.Handle, openapi.ProfileBlockSetJSONRequestBody{Kind: openapi.BlockKindMute}, freyjaSession)
+				tests.Status(t, err, set, http.StatusNoContent)
+
+				a.NotContains(threadIDs(t, loki.Handle, freyjaSession), thread.JSON200.Id)
+
+				dm, err := cl.ConversationCreateWithResponse(root, openapi.ConversationCreateJSONRequestBody{
+					Recipients: []openapi.Identifier{freyja.ID.String()},
+					Body:       "<p>still here</p>",
+				}, lokiSession)
+				tests.Ok(t, err, dm)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,295 @@
+package follow_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		ar *account_querier.Querier,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			newCategory := func(t *testing.T) *openapi.Category {
+				cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+					Colour:      "#fe4efd",
+					Description: "subscriptions",
+					Name:        "Category " + xid.New().String(),
+				}, adminSession)
+				tests.Ok(t, err, cat)
+				return cat.JSON200
+			}
+
+			newThread := func(t *testing.T, session openapi.RequestEditorFn, cat *openapi.Category, tags ...string) string {
+				props := openapi.ThreadInitialProps{
+					Body:       opt.New("<p>subscriptions</p>").Ptr(),
+					Category:   opt.New(cat.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "thread",
+				}
+				if len(tags) > 0 {
+					props.Tags = &tags
+				}
+
+				thr, err := cl.ThreadCreateWithResponse(root, nil, props, session)
+				tests.Ok(t, err, thr)
+				return thr.JSON200.Id
+			}
+
+			feedIDs := func(t *testing.T, session openapi.RequestEditorFn) []string {
+				feed, err := cl.FeedListWithResponse(root, &openapi.FeedListParams{}, session)
+				tests.Ok(t, err, feed)
+				return dt.Map(feed.JSON200.Threads, func(th openapi.ThreadReference) string { return th.Id })
+			}
+
+			notifiedIDs := func(session openapi.RequestEditorFn) []string {
+				nl, err := cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, session)
+				if err != nil || nl.JSON200 == nil {
+					return nil
+				}
+
+				ids := []string{}
+				for _, n := range nl.JSON200.Notifications {
+					if n.Event != openapi.NotificationEventFollowedThread || n.Item == nil {
+						continue
+					}
+					thr, err := n.Item.AsDatagraphItemThread()
+					if err == nil {
+						ids = append(ids, thr.Ref.Id)
+					}
+				}
+				return ids
+			}
+
+			t.Run("follow_category", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				author := newAccount(t, root, cl, ar, "author")
+				follower := newAccount(t, root, cl, ar, "follower")
+				authorSession := sh.WithSession(e2e.WithAccountID(root, author.ID))
+				followerSession := sh.WithSession(e2e.WithAccountID(root, follower.ID))
+
+				followed := newCategory(t)
+				other := newCategory(t)
+
+				add, err := cl.CategoryFollowersAddWithResponse(root, followed.Slug, followerSession)
+				tests.Ok(t, err, add)
+
+				// Following twice is a no-op.
+				add, err = cl.CategoryFollowersAddWithResponse(root, followed.Slug, followerSession)
+				tests.Ok(t, err, add)
+
+				subs, err := cl.AccountSubscriptionsGetWithResponse(root, followerSession)
+				tests.Ok(t, err, subs)
+				r.Len(subs.JSON200.Categories, 1)
+				a.Equal(followed.Id, subs.JSON200.Categories[0].Id)
+				a.Empty(subs.JSON200.Tags)
+
+				in := newThread(t, authorSession, followed)
+				out := newThread(t, authorSession, other)
+
+				ids := feedIDs(t, followerSession)
+				a.Contains(ids, in)
+				a.NotContains(ids, out)
+
+				r.Eventually(func() bool {
+					return slices.Contains(notifiedIDs(followerSession), in)
+				}, 5*time.Second, 100*time.Millisecond)
+
+				remove, err := cl.CategoryFollowersRemoveWithResponse(root, followed.Slug, followerSession)
+				tests.Ok(t, err, remove)
+
+				a.NotContains(feedIDs(t, followerSession), in)
+			})
+
+			t.Run("follow_tag", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				author := newAccount(t, root, cl, ar, "author")
+				follower := newAccount(t, root, cl, ar, "follower")
+				authorSession := sh.WithSession(e2e.WithAccountID(root, author.ID))
+				followerSession := sh.WithSession(e2e.WithAccountID(root, follower.ID))
+
+				cat := newCategory(t)
+				tagName := "tag-" + xid.New().String()
+
+				tagged := newThread(t, authorSession, cat, tagName)
+				untagged := newThread(t, authorSession, cat)
+
+				add, err := cl.TagFollowersAddWithResponse(root, tagName, followerSession)
+				tests.Ok(t, err, add)
+
+				subs, err := cl.AccountSubscriptionsGetWithResponse(root, followerSession)
+				tests.Ok(t, err, subs)
+				r.Len(subs.JSON200.Tags, 1)
+				a.Equal(tagName, subs.JSON200.Tags[0].Name)
+
+				ids := feedIDs(t, followerSession)
+				a.Contains(ids, tagged)
+				a.NotContains(ids, untagged)
+
+				remove, err := cl.TagFollowersRemoveWithResponse(root, tagName, followerSession)
+				tests.Ok(t, err, remove)
+
+				a.NotContains(feedIDs(t, followerSession), tagged)
+			})
+
+			t.Run("follow_unreadable_category", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				follower := newAccount(t, root, cl, ar, "follower")
+				followerSession := sh.WithSession(e2e.WithAccountID(root, follower.ID))
+
+				staffRole := tests.AssertRequest(cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "Staff " + xid.New().String(),
+					Colour:      "blue",
+					Permissions: []openapi.Permission{},
+				}, adminSession))(t, http.StatusOK)
+
+				restricted := newCategory(t)
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, restricted.Slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{
+						{RoleId: staffRole.JSON200.Id, Read: true},
+					},
+				}, adminSession))(t, http.StatusOK)
+
+				tagName := "tag-" + xid.New().String()
+				// The tag only exists once a thread uses it.
+				newThread(t, adminSession, newCategory(t), tagName)
+
+				add, err := cl.TagFollowersAddWithResponse(root, tagName, followerSession)
+				tests.Ok(t, err, add)
+
+				hidden := newThread(t, adminSession, restricted, tagName)
+				visible := newThread(t, adminSession, newCategory(t), tagName)
+
+				r.Eventually(func() bool {
+					return slices.Contains(notifiedIDs(followerSession), visible)
+				}, 5*time.Second, 100*time.Millisecond)
+
+				a.NotContains(notifiedIDs(followerSession), hidden)
+			})
+
+			t.Run("follow_private_space", func(t *testing.T) {
+				t.Parallel()
+
+				r := require.New(t)
+				a := assert.New(t)
+
+				follower := newAccount(t, root, cl, ar, "follower")
+				followerSession := sh.WithSession(e2e.WithAccountID(root, follower.ID))
+
+				policy := openapi.SpaceJoinPolicy("invite")
+				sp, err := cl.SpaceCreateWithResponse(root, openapi.SpaceInitialProps{
+					Name:       "space " + xid.New().String(),
+					JoinPolicy: &policy,
+				}, adminSession)
+				tests.Ok(t, err, sp)
+
+				cat := newCategory(t)
+				tagName := "tag-" + xid.New().String()
+
+				newThread(t, adminSession, cat, tagName)
+
+				add, err := cl.TagFollowersAddWithResponse(root, tagName, followerSession)
+				tests.Ok(t, err, add)
+
+				tags := []string{tagName}
+				hidden, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>members only</p>").Ptr(),
+					Category:   opt.New(cat.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "space thread",
+					Space:      &sp.JSON200.Id,
+					Tags:       &tags,
+				}, adminSession)
+				tests.Ok(t, err, hidden)
+
+				visible := newThread(t, adminSession, cat, tagName)
+
+				r.Eventually(func() bool {
+					return slices.Contains(notifiedIDs(followerSession), visible)
+				}, 5*time.Second, 100*time.Millisecond)
+
+				a.NotContains(notifiedIDs(followerSession), hidden.JSON200.Id)
+			})
+
+			t.Run("follow_profile", func(t *testing.T) {
+				t.Parallel()
+
+				a := assert.New(t)
+
+				author := newAccount(t, root, cl, ar, "author")
+				follower := newAccount(t, root, cl, ar, "follower")
+				authorSession := sh.WithSession(e2e.WithAccountID(root, author.ID))
+				followerSession := sh.WithSession(e2e.WithAccountID(root, follower.ID))
+
+				thr := newThread(t, authorSession, newCategory(t))
+
+				a.NotContains(feedIDs(t, followerSession), thr)
+
+				add, err := cl.ProfileFollowersAddWithResponse(root, author.Handle, followerSession)
+				tests.Ok(t, err, add)
+
+				a.Contains(feedIDs(t, followerSession), thr)
+			})
+
+			t.Run("follow_unknown", func(t *testing.T) {
+				t.Parallel()
+
+				follower := newAccount(t, root, cl, ar, "follower")
+				followerSession := sh.WithSession(e2e.WithAccountID(root, follower.ID))
+
+				tag, err := cl.TagFollowersAddWithResponse(root, "tag-"+xid.New().String(), followerSession)
+				tests.Status(t, err, tag, http.StatusNotFound)
+
+				cat, err := cl.CategoryFollowersAddWithResponse(root, "category-"+xid.New().String(), followerSession)
+				tests.Status(t, err, cat, http.StatusNotFound)
+			})
+
+			t.Run("feed_requires_session", func(t *testing.T) {
+				t.Parallel()
+
+				feed, err := cl.FeedListWithResponse(root, &openapi.FeedListParams{})
+				tests.Status(t, err, feed, http.StatusUnauthorized)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,174 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestProfilePins(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			otherSession := sh.WithSession(otherCtx)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        uuid.NewString(),
+				Description: "pins",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+
+			create := func(session openapi.RequestEditorFn, vis openapi.Visibility) string {
+				return tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>proud of this one</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(vis).Ptr(),
+					Title:      "pinned thread " + uuid.NewString(),
+				}, session))(t, http.StatusOK).JSON200.Id
+			}
+
+			first := create(memberSession, openapi.Published)
+			second := create(memberSession, openapi.Published)
+			third := create(memberSession, openapi.Published)
+			draft := create(memberSession, openapi.Draft)
+			others := create(otherSession, openapi.Published)
+
+			thread := func(id string) openapi.ProfilePinRef {
+				return openapi.ProfilePinRef{Id: id, Kind: openapi.DatagraphItemKindThread}
+			}
+
+			pin := func(session openapi.RequestEditorFn, status int, items ...openapi.ProfilePinRef) *openapi.AccountPinsUpdateResponse {
+				if items == nil {
+					items = []openapi.ProfilePinRef{}
+				}
+				return tests.AssertRequest(cl.AccountPinsUpdateWithResponse(root, openapi.ProfilePinsMutableProps{Items: items}, session))(t, status)
+			}
+
+			itemIDs := func(items openapi.DatagraphItemList) []string {
+				return dt.Map(items, func(i openapi.DatagraphItem) string {
+					v, err := i.ValueByDiscriminator()
+					require.NoError(t, err)
+					switch v := v.(type) {
+					case openapi.DatagraphItemThread:
+						return v.Ref.Id
+					case openapi.DatagraphItemNode:
+						return v.Ref.Id
+					}
+					return ""
+				})
+			}
+
+			pinned := func(handle string) []string {
+				p := tests.AssertRequest(cl.ProfileGetWithResponse(root, handle))(t, http.StatusOK)
+				require.NotNil(t, p.JSON200.Pinned)
+				return itemIDs(*p.JSON200.Pinned)
+			}
+
+			t.Run("order", func(t *testing.T) {
+				a := assert.New(t)
+
+				a.Empty(pinned(member.Handle))
+
+				res := pin(memberSession, http.StatusOK, thread(second), thread(first))
+				a.Equal([]string{second, first}, itemIDs(res.JSON200.Items))
+				a.Equal([]string{second, first}, pinned(member.Handle))
+
+				res = pin(memberSession, http.StatusOK, thread(first), thread(third), thread(second))
+				a.Equal([]string{first, third, second}, itemIDs(res.JSON200.Items))
+				a.Equal([]string{first, third, second}, pinned(member.Handle))
+			})
+
+			t.Run("rejected", func(t *testing.T) {
+				a := assert.New(t)
+
+				pin(memberSession, http.StatusBadRequest, thread(draft))
+				pin(memberSession, http.StatusBadRequest, thread(others))
+				pin(memberSession, http.StatusBadRequest, thread(xid.New().String()))
+				pin(memberSession, http.StatusBadRequest, openapi.ProfilePinRef{Id: first, Kind: openapi.DatagraphItemKindReply})
+				tests.AssertRequest(cl.AccountPinsUpdateWithResponse(root, openapi.ProfilePinsMutableProps{Items: []openapi.ProfilePinRef{thread(first)}}))(t, http.StatusUnauthorized)
+
+				// Failed updates leave the existing pins alone.
+				a.Equal([]string{first, third, second}, pinned(member.Handle))
+			})
+
+			t.Run("limit", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				set := tests.AssertRequest(cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					ProfilePinLimit: opt.New(2).Ptr(),
+				}, adminSession))(t, http.StatusOK)
+				r.NotNil(set.JSON200.ProfilePinLimit)
+				a.Equal(2, *set.JSON200.ProfilePinLimit)
+
+				// Pins beyond a lowered limit are hidden rather than removed.
+				a.Equal([]string{first, third}, pinned(member.Handle))
+
+				pin(memberSession, http.StatusBadRequest, thread(first), thread(second), thread(third))
+				pin(memberSession, http.StatusOK, thread(third), thread(first))
+				a.Equal([]string{third, first}, pinned(member.Handle))
+
+				tests.AssertRequest(cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					ProfilePinLimit: opt.New(0).Ptr(),
+				}, adminSession))(t, http.StatusOK)
+				pin(memberSession, http.StatusBadRequest, thread(first))
+				a.Empty(pinned(member.Handle))
+
+				tests.AssertRequest(cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					ProfilePinLimit: opt.New(6).Ptr(),
+				}, adminSession))(t, http.StatusOK)
+			})
+
+			t.Run("library_page", func(t *testing.T) {
+				a := assert.New(t)
+
+				node := tests.AssertRequest(cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+					Name:       "pinned page " + uuid.NewString(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, adminSession))(t, http.StatusOK)
+
+				pin(memberSession, http.StatusBadRequest, openapi.ProfilePinRef{Id: node.JSON200.Id, Kind: openapi.DatagraphItemKindNode})
+				pin(adminSession, http.StatusOK, openapi.ProfilePinRef{Id: node.JSON200.Id, Kind: openapi.DatagraphItemKindNode})
+				a.Equal([]string{node.JSON200.Id}, pinned(admin.Handle))
+			})
+
+			t.Run("deleted", func(t *testing.T) {
+				a := assert.New(t)
+
+				threadSlug := tests.AssertRequest(cl.ThreadGetWithResponse(root, third, nil))(t, http.StatusOK).JSON200.Slug
+				tests.AssertRequest(cl.ThreadDeleteWithResponse(root, threadSlug, memberSession))(t, http.StatusOK)
+				a.Equal([]string{first}, pinned(member.Handle))
+
+				pin(memberSession, http.StatusOK)
+				a.Empty(pinned(member.Handle))
+			})
+		}))
+	}))
+}
@@ -0,0 +1,55 @@
+package account_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestProfileCache(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{CacheQueryTTL: time.Hour}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			session := sh.WithSession(memberCtx)
+
+			get, err := cl.ProfileGetWithResponse(root, member.Handle)
+			tests.Ok(t, err, get)
+			a.Equal(member.Name, get.JSON200.Name)
+
+			upd, err := cl.AccountUpdateWithResponse(root, openapi.AccountUpdateJSONRequestBody{
+				Name: opt.New("Baldur the Bright").Ptr(),
+			}, session)
+			tests.Ok(t, err, upd)
+
+			r.Eventually(func() bool {
+				get, err := cl.ProfileGetWithResponse(root, member.Handle)
+				tests.Ok(t, err, get)
+				return get.JSON200.Name == "Baldur the Bright"
+			}, 5*time.Second, 50*time.Millisecond)
+		}))
+	}))
+}
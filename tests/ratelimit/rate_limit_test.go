@@ -0,0 +1,128 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func bearer(secret string) openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+secret)
+		return nil
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			createThread := func(opts ...openapi.RequestEditorFn) (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>rate limit</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "rate limit " + uuid.NewString(),
+				}, opts...)
+			}
+
+			t.Run("headers", func(t *testing.T) {
+				a := assert.New(t)
+
+				res, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, res)
+
+				h := res.HTTPResponse.Header
+				a.Equal("1000", h.Get("RateLimit-Limit"))
+				a.NotEmpty(h.Get("RateLimit-Remaining"))
+				a.NotEmpty(h.Get("RateLimit-Reset"))
+				a.Equal(`1000;w=3600;comment="read"`, h.Get("RateLimit-Policy"))
+				a.Equal("1000", h.Get("X-RateLimit-Limit"))
+
+				write, err := createThread(memberSession)
+				tests.Ok(t, err, write)
+				a.Contains(write.HTTPResponse.Header.Get("RateLimit-Policy"), `comment="write"`)
+			})
+
+			key, err := cl.AccessKeyCreateWithResponse(root, openapi.AccessKeyInitialProps{Name: "rate limited"}, adminSession)
+			tests.Ok(t, err, key)
+			keySession := bearer(key.JSON200.Secret)
+
+			update, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+				RateLimits: &openapi.RateLimitSettings{
+					Default: &openapi.RateLimits{Write: opt.New(50).Ptr()},
+					Roles: &[]openapi.RoleRateLimits{
+						{RoleId: role.DefaultRoleAdminID.String(), Limits: openapi.RateLimits{Write: opt.New(0).Ptr()}},
+					},
+					AccessKeys: &[]openapi.AccessKeyRateLimits{
+						{AccessKeyId: key.JSON200.Id, Limits: openapi.RateLimits{Write: opt.New(3).Ptr()}},
+					},
+				},
+			}, adminSession)
+			tests.Ok(t, err, update)
+			require.NotNil(t, update.JSON200.RateLimits)
+			assert.Equal(t, 50, *update.JSON200.RateLimits.Default.Write)
+
+			t.Run("default", func(t *testing.T) {
+				res, err := createThread(memberSession)
+				tests.Ok(t, err, res)
+				assert.Equal(t, "50", res.HTTPResponse.Header.Get("RateLimit-Limit"))
+			})
+
+			t.Run("role_unlimited", func(t *testing.T) {
+				res, err := createThread(adminSession)
+				tests.Ok(t, err, res)
+				assert.Empty(t, res.HTTPResponse.Header.Get("RateLimit-Limit"))
+			})
+
+			t.Run("access_key", func(t *testing.T) {
+				a := assert.New(t)
+
+				var limited *openapi.ThreadCreateResponse
+				for range 3 {
+					res, err := createThread(keySession)
+					require.NoError(t, err)
+					a.Equal("3", res.HTTPResponse.Header.Get("RateLimit-Limit"))
+					if res.StatusCode() == http.StatusTooManyRequests {
+						limited = res
+						break
+					}
+					tests.Ok(t, err, res)
+				}
+
+				require.NotNil(t, limited, "the access key's limit applies over the admin role")
+				a.Equal("0", limited.HTTPResponse.Header.Get("RateLimit-Remaining"))
+				a.NotEmpty(limited.HTTPResponse.Header.Get("Retry-After"))
+
+				// The key's limit doesn't affect the same account's session.
+				res, err := createThread(adminSession)
+				tests.Ok(t, err, res)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,192 @@
+package realtime_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	realtime_service "github.com/Southclaws/storyden/app/services/realtime"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/app/transports/realtime"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestRealtime(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), realtime.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		ts *httptest.Server,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			url := "ws" + strings.TrimPrefix(ts.URL, "http") + "/realtime"
+
+			dial := func(t *testing.T, ctx context.Context) *websocket.Conn {
+				t.Helper()
+
+				header := http.Header{}
+				if ctx != root {
+					req, _ := http.NewRequest(http.MethodGet, url, nil)
+					require.NoError(t, sh.WithSession(ctx)(ctx, req))
+					header = req.Header
+				}
+
+				conn, _, err := websocket.Dial(root, url, &websocket.DialOptions{HTTPHeader: header})
+				require.NoError(t, err)
+				t.Cleanup(func() { conn.CloseNow() })
+
+				return conn
+			}
+
+			send := func(t *testing.T, conn *websocket.Conn, typ, thread string) {
+				t.Helper()
+				require.NoError(t, wsjson.Write(root, conn, map[string]string{"type": typ, "thread": thread}))
+			}
+
+			// next reads events until one matches, presence updates and other
+			// events may arrive in between depending on timing.
+			next := func(t *testing.T, conn *websocket.Conn, match func(realtime_service.Event) bool) realtime_service.Event {
+				t.Helper()
+
+				ctx, cancel := context.WithTimeout(root, 5*time.Second)
+				defer cancel()
+
+				for {
+					var e realtime_service.Event
+					require.NoError(t, wsjson.Read(ctx, conn, &e))
+					if match(e) {
+						return e
+					}
+				}
+			}
+
+			ofType := func(typ realtime_service.EventType) func(realtime_service.Event) bool {
+				return func(e realtime_service.Event) bool { return e.Type == typ }
+			}
+
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>live</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "realtime " + uuid.NewString(),
+			}, sh.WithSession(memberCtx))
+			tests.Ok(t, err, thread)
+
+			t.Run("replies_and_notifications", func(t *testing.T) {
+				a := assert.New(t)
+
+				conn := dial(t, memberCtx)
+
+				send(t, conn, "watch", thread.JSON200.Slug)
+				watching := next(t, conn, ofType(realtime_service.EventTypeWatching))
+				a.Equal(thread.JSON200.Id, watching.ThreadID)
+
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+					Body: "<p>a live reply</p>",
+				}, sh.WithSession(adminCtx))
+				tests.Ok(t, err, reply)
+
+				created := next(t, conn, ofType(realtime_service.EventTypeReplyCreated))
+				a.Equal(thread.JSON200.Id, created.ThreadID)
+				a.Equal(reply.JSON200.Id, created.ReplyID)
+
+				// The thread's author is notified of the reply on the same connection.
+				received := next(t, conn, ofType(realtime_service.EventTypeNotification))
+				a.NotEmpty(received.NotificationID)
+				a.Equal(notification.EventThreadReply.String(), received.Event)
+			})
+
+			t.Run("presence", func(t *testing.T) {
+				a := assert.New(t)
+
+				handles := func(e realtime_service.Event) []string {
+					h := []string{}
+					for _, v := range e.Presence.Viewers {
+						h = append(h, v.Handle)
+					}
+					return h
+				}
+
+				viewers := func(want ...string) func(realtime_service.Event) bool {
+					return func(e realtime_service.Event) bool {
+						return e.Type == realtime_service.EventTypePresence && assert.ObjectsAreEqual(want, handles(e))
+					}
+				}
+
+				memberConn := dial(t, memberCtx)
+				send(t, memberConn, "watch", thread.JSON200.Id)
+				next(t, memberConn, viewers(member.Handle))
+
+				adminConn := dial(t, adminCtx)
+				send(t, adminConn, "watch", thread.JSON200.Id)
+
+				both := []string{admin.Handle, member.Handle}
+				if both[0] > both[1] {
+					both[0], both[1] = both[1], both[0]
+				}
+				e := next(t, memberConn, viewers(both...))
+				a.Len(e.Presence.Viewers, 2)
+
+				adminConn.Close(websocket.StatusNormalClosure, "")
+				next(t, memberConn, viewers(member.Handle))
+
+				send(t, memberConn, "unwatch", thread.JSON200.Id)
+
+				guestConn := dial(t, root)
+				send(t, guestConn, "watch", thread.JSON200.Id)
+				next(t, guestConn, ofType(realtime_service.EventTypeWatching))
+			})
+
+			t.Run("draft_not_watchable", func(t *testing.T) {
+				a := assert.New(t)
+
+				draft, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>draft</p>").Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+					Title:      "realtime draft " + uuid.NewString(),
+				}, sh.WithSession(memberCtx))
+				tests.Ok(t, err, draft)
+
+				guest := dial(t, root)
+				send(t, guest, "watch", draft.JSON200.Id)
+				e := next(t, guest, func(realtime_service.Event) bool { return true })
+				a.Equal(realtime_service.EventTypeError, e.Type)
+
+				author := dial(t, memberCtx)
+				send(t, author, "watch", draft.JSON200.Id)
+				next(t, author, ofType(realtime_service.EventTypeWatching))
+			})
+
+			t.Run("cross_origin_rejected", func(t *testing.T) {
+				_, resp, err := websocket.Dial(root, url, &websocket.DialOptions{
+					HTTPHeader: http.Header{"Origin": []string{"https://evil.example.com"}},
+				})
+				require.Error(t, err)
+				require.NotNil(t, resp)
+				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,152 @@
+package report_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestReportQueue(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			member1Ctx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			member1Session := sh.WithSession(member1Ctx)
+			member2Ctx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			member2Session := sh.WithSession(member2Ctx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Colour:      "#fe4efd",
+				Description: "queue",
+				Name:        "Category " + xid.New().String(),
+			}, adminSession)
+			tests.Ok(t, err, cat)
+
+			newThread := func() *openapi.ThreadCreateResponse {
+				thr, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>buy my stuff</p>").Ptr(),
+					Category:   &cat.JSON200.Id,
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Queue thread " + xid.New().String(),
+				}, adminSession)
+				tests.Ok(t, err, thr)
+				return thr
+			}
+
+			report := func(targetID string, reason openapi.ReportReason, session openapi.RequestEditorFn) *openapi.ReportCreateResponse {
+				rep, err := cl.ReportCreateWithResponse(root, openapi.ReportInitialProps{
+					TargetId:   targetID,
+					TargetKind: openapi.DatagraphItemKindThread,
+					Reason:     &reason,
+				}, session)
+				tests.Ok(t, err, rep)
+				return rep
+			}
+
+			findTarget := func(targetID string) (openapi.ReportTarget, bool) {
+				queue, err := cl.ReportQueueListWithResponse(root, &openapi.ReportQueueListParams{}, adminSession)
+				tests.Ok(t, err, queue)
+				return lo.Find(queue.JSON200.Targets, func(rt openapi.ReportTarget) bool { return rt.TargetId == targetID })
+			}
+
+			t.Run("aggregates_and_resolves", func(t *testing.T) {
+				thr := newThread()
+
+				rep1 := report(thr.JSON200.Id, openapi.Spam, member1Session)
+				a.Equal(openapi.Spam, *rep1.JSON200.Reason)
+				report(thr.JSON200.Id, openapi.Spam, member2Session)
+
+				target, ok := findTarget(thr.JSON200.Id)
+				r.True(ok)
+				a.Equal(2, target.ReportCount)
+				a.Equal(openapi.Submitted, target.Status)
+				r.Len(target.Reasons, 1)
+				a.Equal(openapi.Spam, target.Reasons[0].Reason)
+				a.Equal(2, target.Reasons[0].Count)
+				a.Len(target.Reports, 2)
+
+				resolved, err := cl.ReportQueueUpdateWithResponse(root, thr.JSON200.Id, openapi.ReportQueueMutableProps{
+					Action: openapi.Resolve,
+				}, adminSession)
+				tests.Ok(t, err, resolved)
+				a.Equal(openapi.Resolved, resolved.JSON200.Status)
+				for _, rep := range resolved.JSON200.Reports {
+					a.Equal(openapi.Resolved, rep.Status)
+					r.NotNil(rep.HandledBy)
+				}
+
+				_, ok = findTarget(thr.JSON200.Id)
+				a.False(ok)
+
+				mine, err := cl.ReportListWithResponse(root, &openapi.ReportListParams{}, member1Session)
+				tests.Ok(t, err, mine)
+				own, ok := lo.Find(mine.JSON200.Reports, func(rp openapi.Report) bool { return rp.Id == rep1.JSON200.Id })
+				r.True(ok)
+				a.Equal(openapi.Resolved, own.Status)
+
+				again, err := cl.ReportQueueUpdateWithResponse(root, thr.JSON200.Id, openapi.ReportQueueMutableProps{
+					Action: openapi.Dismiss,
+				}, adminSession)
+				tests.Status(t, err, again, http.StatusNotFound)
+			})
+
+			t.Run("escalate_then_dismiss", func(t *testing.T) {
+				thr := newThread()
+
+				report(thr.JSON200.Id, openapi.Harassment, member1Session)
+
+				escalated, err := cl.ReportQueueUpdateWithResponse(root, thr.JSON200.Id, openapi.ReportQueueMutableProps{
+					Action: openapi.Escalate,
+				}, adminSession)
+				tests.Ok(t, err, escalated)
+				a.Equal(openapi.Escalated, escalated.JSON200.Status)
+
+				target, ok := findTarget(thr.JSON200.Id)
+				r.True(ok)
+				a.Equal(openapi.Escalated, target.Status)
+
+				dismissed, err := cl.ReportQueueUpdateWithResponse(root, thr.JSON200.Id, openapi.ReportQueueMutableProps{
+					Action: openapi.Dismiss,
+				}, adminSession)
+				tests.Ok(t, err, dismissed)
+				a.Equal(openapi.Dismissed, dismissed.JSON200.Status)
+			})
+
+			t.Run("requires_permission", func(t *testing.T) {
+				queue, err := cl.ReportQueueListWithResponse(root, &openapi.ReportQueueListParams{}, member1Session)
+				tests.Status(t, err, queue, http.StatusForbidden)
+
+				update, err := cl.ReportQueueUpdateWithResponse(root, xid.New().String(), openapi.ReportQueueMutableProps{
+					Action: openapi.Resolve,
+				}, member1Session)
+				tests.Status(t, err, update, http.StatusForbidden)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,179 @@
+package reputation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestReputation(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			askerCtx, asker := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			askerSession := sh.WithSession(askerCtx)
+			answererCtx, answerer := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			answererSession := sh.WithSession(answererCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>how do I do the thing?</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "question",
+			}, askerSession)
+			tests.Ok(t, err, thread)
+
+			reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>like this</p>"}, answererSession)
+			tests.Ok(t, err, reply)
+
+			reasons := func(t *testing.T, handle string) map[openapi.ReputationReason]int {
+				res, err := cl.ProfileReputationGetWithResponse(root, handle, &openapi.ProfileReputationGetParams{})
+				tests.Ok(t, err, res)
+
+				out := map[openapi.ReputationReason]int{}
+				for _, e := range res.JSON200.Ledger {
+					out[e.Reason] += e.Points
+				}
+				return out
+			}
+
+			total := func(t *testing.T, handle string) int {
+				res, err := cl.ProfileReputationGetWithResponse(root, handle, &openapi.ProfileReputationGetParams{})
+				tests.Ok(t, err, res)
+				return res.JSON200.Total
+			}
+
+			t.Run("milestone", func(t *testing.T) {
+				r := require.New(t)
+
+				r.Eventually(func() bool {
+					return reasons(t, asker.Handle)["milestone"] > 0 && reasons(t, answerer.Handle)["milestone"] > 0
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("accepted_answer", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				accept, err := cl.ThreadAnswerSetWithResponse(root, thread.JSON200.Slug, openapi.ThreadAnswerSetProps{ReplyId: reply.JSON200.Id}, askerSession)
+				tests.Ok(t, err, accept)
+				r.NotNil(accept.JSON200.AcceptedReplyId)
+				a.Equal(reply.JSON200.Id, *accept.JSON200.AcceptedReplyId)
+
+				r.Eventually(func() bool {
+					return reasons(t, answerer.Handle)["answer_accepted"] == 15
+				}, 5*time.Second, 100*time.Millisecond)
+
+				remove, err := cl.ThreadAnswerRemoveWithResponse(root, thread.JSON200.Slug, askerSession)
+				tests.Ok(t, err, remove)
+				a.Nil(remove.JSON200.AcceptedReplyId)
+
+				r.Eventually(func() bool {
+					return reasons(t, answerer.Handle)["answer_accepted"] == 0
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("accept_requires_author", func(t *testing.T) {
+				accept, err := cl.ThreadAnswerSetWithResponse(root, thread.JSON200.Slug, openapi.ThreadAnswerSetProps{ReplyId: reply.JSON200.Id}, answererSession)
+				tests.Status(t, err, accept, http.StatusForbidden)
+			})
+
+			t.Run("likes", func(t *testing.T) {
+				r := require.New(t)
+
+				before := total(t, asker.Handle)
+
+				like, err := cl.LikePostAddWithResponse(root, thread.JSON200.Id, answererSession)
+				tests.Ok(t, err, like)
+
+				r.Eventually(func() bool {
+					return total(t, asker.Handle) == before+2
+				}, 5*time.Second, 100*time.Millisecond)
+
+				unlike, err := cl.LikePostRemoveWithResponse(root, thread.JSON200.Id, answererSession)
+				tests.Ok(t, err, unlike)
+
+				r.Eventually(func() bool {
+					return total(t, asker.Handle) == before
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("self_like", func(t *testing.T) {
+				a := assert.New(t)
+
+				before := total(t, asker.Handle)
+
+				like, err := cl.LikePostAddWithResponse(root, thread.JSON200.Id, askerSession)
+				tests.Ok(t, err, like)
+
+				time.Sleep(time.Second)
+				a.Equal(before, total(t, asker.Handle))
+			})
+
+			t.Run("leaderboard", func(t *testing.T) {
+				a := assert.New(t)
+
+				res, err := cl.ReputationLeaderboardWithResponse(root, &openapi.ReputationLeaderboardParams{})
+				tests.Ok(t, err, res)
+
+				handles := []string{}
+				for _, s := range res.JSON200.Standings {
+					handles = append(handles, s.Profile.Handle)
+				}
+				a.Contains(handles, asker.Handle)
+				a.Contains(handles, answerer.Handle)
+
+				invalid := openapi.LeaderboardWindow("year")
+				bad, err := cl.ReputationLeaderboardWithResponse(root, &openapi.ReputationLeaderboardParams{Window: &invalid})
+				tests.Status(t, err, bad, http.StatusBadRequest)
+			})
+
+			t.Run("threshold", func(t *testing.T) {
+				r := require.New(t)
+
+				settings, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Reputation: &openapi.ReputationSettings{
+						Weights: openapi.ReputationWeights{AnswerAccepted: 15, LikeReceived: 2, ReactionReceived: 1, Milestone: 5},
+						Thresholds: []openapi.ReputationThreshold{
+							{Permission: openapi.Permission("CREATE_REACTION"), Points: 1000},
+						},
+					},
+				}, adminSession)
+				tests.Ok(t, err, settings)
+				r.Len(settings.JSON200.Reputation.Thresholds, 1)
+
+				react, err := cl.PostReactAddWithResponse(root, thread.JSON200.Id, openapi.PostReactAddJSONRequestBody{Emoji: "👍"}, answererSession)
+				tests.Status(t, err, react, http.StatusForbidden)
+
+				react, err = cl.PostReactAddWithResponse(root, thread.JSON200.Id, openapi.PostReactAddJSONRequestBody{Emoji: "👍"}, adminSession)
+				tests.Ok(t, err, react)
+			})
+		}))
+	}))
+}
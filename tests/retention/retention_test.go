@@ -0,0 +1,138 @@
+package retention_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/system/retention_enforcer"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestRetention(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		db *ent.Client,
+		enforcer *retention_enforcer.Enforcer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			memberID := xid.ID(member.ID)
+			old := time.Now().Add(-72 * time.Hour)
+
+			expired := db.Session.Create().
+				SetAccountID(memberID).
+				SetCreatedAt(old).
+				SetExpiresAt(old).
+				SaveX(root)
+			current := db.Session.Create().
+				SetAccountID(memberID).
+				SetExpiresAt(time.Now().Add(time.Hour)).
+				SaveX(root)
+
+			unclaimed := db.Email.Create().
+				SetEmailAddress(uuid.NewString() + "@example.com").
+				SetVerificationCode("123456").
+				SetCreatedAt(old).
+				SaveX(root)
+			recent := db.Email.Create().
+				SetEmailAddress(uuid.NewString() + "@example.com").
+				SetVerificationCode("123456").
+				SaveX(root)
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminRetentionReportWithResponse(root, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("only_trash_by_default", func(t *testing.T) {
+				res, err := cl.AdminRetentionReportWithResponse(root, adminSession)
+				tests.Ok(t, err, res)
+
+				r.Len(res.JSON200.Rules, 1)
+				a.Equal(openapi.Trash, res.JSON200.Rules[0].Rule)
+				a.Equal(30, res.JSON200.Rules[0].Days)
+			})
+
+			settings, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+				Retention: &openapi.RetentionSettings{
+					SessionDays:         opt.New(1).Ptr(),
+					UnverifiedEmailDays: opt.New(1).Ptr(),
+				},
+			}, adminSession)
+			tests.Ok(t, err, settings)
+			a.Equal(1, *settings.JSON200.Retention.SessionDays)
+			a.Nil(settings.JSON200.Retention.WebhookDeliveryDays)
+
+			t.Run("dry_run", func(t *testing.T) {
+				res, err := cl.AdminRetentionReportWithResponse(root, adminSession)
+				tests.Ok(t, err, res)
+
+				rules := lo.KeyBy(res.JSON200.Rules, func(r openapi.RetentionRuleResult) openapi.RetentionRule { return r.Rule })
+				r.Len(rules, 3)
+				a.NotContains(rules, openapi.WebhookDeliveries)
+				a.GreaterOrEqual(rules[openapi.Sessions].Count, 1)
+				a.GreaterOrEqual(rules[openapi.UnverifiedEmails].Count, 1)
+				a.WithinDuration(time.Now().Add(-24*time.Hour), rules[openapi.Sessions].Before, time.Minute)
+
+				_, err = db.Session.Get(root, expired.ID)
+				a.NoError(err, "a dry run removes nothing")
+				_, err = db.Email.Get(root, unclaimed.ID)
+				a.NoError(err, "a dry run removes nothing")
+			})
+
+			t.Run("enforce", func(t *testing.T) {
+				report, err := enforcer.Enforce(root, time.Now())
+				r.NoError(err)
+				a.False(report.DryRun)
+				a.GreaterOrEqual(report.Total(), 2)
+
+				_, err = db.Session.Get(root, expired.ID)
+				a.True(ent.IsNotFound(err))
+				_, err = db.Session.Get(root, current.ID)
+				a.NoError(err)
+
+				_, err = db.Email.Get(root, unclaimed.ID)
+				a.True(ent.IsNotFound(err))
+				_, err = db.Email.Get(root, recent.ID)
+				a.NoError(err)
+
+				res, err := cl.AdminRetentionReportWithResponse(root, adminSession)
+				tests.Ok(t, err, res)
+				for _, rule := range res.JSON200.Rules {
+					if rule.Rule != openapi.Trash {
+						a.Zero(rule.Count, rule.Rule)
+					}
+				}
+			})
+		}))
+	}))
+}
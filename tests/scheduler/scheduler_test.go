@@ -0,0 +1,167 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/scheduler"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		sched *scheduler.Scheduler,
+	) {
+		var ticks atomic.Int64
+		sched.Register("test_tick", "@every 1s", func(ctx context.Context) error {
+			ticks.Add(1)
+			return nil
+		})
+
+		var manual atomic.Int64
+		sched.Register("test_manual", "@yearly", func(ctx context.Context) error {
+			if manual.Add(1) == 1 {
+				return errors.New("first run fails")
+			}
+			return nil
+		})
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			find := func(t *testing.T, name string) openapi.ScheduledTask {
+				res, err := cl.AdminScheduledTaskListWithResponse(root, adminSession)
+				tests.Ok(t, err, res)
+
+				task, ok := lo.Find(res.JSON200.Tasks, func(t openapi.ScheduledTask) bool { return t.Name == name })
+				require.True(t, ok, name)
+				return task
+			}
+
+			t.Run("runs_on_schedule", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				r.Eventually(func() bool {
+					task := find(t, "test_tick")
+					return task.LastStatus != nil && *task.LastStatus == openapi.ScheduledTaskStatusSucceeded
+				}, 15*time.Second, 200*time.Millisecond)
+
+				task := find(t, "test_tick")
+				a.Equal("@every 1s", task.Schedule)
+				a.Equal("@every 1s", task.DefaultSchedule)
+				a.True(task.Enabled)
+				a.NotNil(task.LastFinishedAt)
+				a.Nil(task.LastError)
+
+				cleanup := find(t, "job_cleanup")
+				a.Equal("@daily", cleanup.Schedule)
+
+				upd, err := cl.AdminScheduledTaskUpdateWithResponse(root, "test_tick", openapi.ScheduledTaskMutableProps{
+					Enabled: opt.New(false).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, upd)
+				a.False(upd.JSON200.Enabled)
+
+				// Allow a run which was already queued to finish.
+				time.Sleep(1500 * time.Millisecond)
+				before := ticks.Load()
+				time.Sleep(2500 * time.Millisecond)
+				a.Equal(before, ticks.Load())
+			})
+
+			t.Run("update_schedule", func(t *testing.T) {
+				a := assert.New(t)
+
+				upd, err := cl.AdminScheduledTaskUpdateWithResponse(root, "test_manual", openapi.ScheduledTaskMutableProps{
+					Schedule: opt.New("0 9 * * mon").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, upd)
+				a.Equal("0 9 * * mon", upd.JSON200.Schedule)
+				a.Equal("@yearly", upd.JSON200.DefaultSchedule)
+				a.Equal(time.Monday, upd.JSON200.NextRunAt.UTC().Weekday())
+				a.Equal(9, upd.JSON200.NextRunAt.UTC().Hour())
+
+				bad, err := cl.AdminScheduledTaskUpdateWithResponse(root, "test_manual", openapi.ScheduledTaskMutableProps{
+					Schedule: opt.New("every tuesday").Ptr(),
+				}, adminSession)
+				tests.Status(t, err, bad, http.StatusBadRequest)
+
+				reset, err := cl.AdminScheduledTaskUpdateWithResponse(root, "test_manual", openapi.ScheduledTaskMutableProps{
+					Schedule: opt.New("").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, reset)
+				a.Equal("@yearly", reset.JSON200.Schedule)
+
+				missing, err := cl.AdminScheduledTaskUpdateWithResponse(root, "nonexistent", openapi.ScheduledTaskMutableProps{
+					Enabled: opt.New(false).Ptr(),
+				}, adminSession)
+				tests.Status(t, err, missing, http.StatusNotFound)
+			})
+
+			t.Run("manual_run", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				run, err := cl.AdminScheduledTaskRunWithResponse(root, "test_manual", adminSession)
+				tests.Ok(t, err, run)
+				a.Equal("scheduled.test_manual", run.JSON200.Kind)
+
+				r.Eventually(func() bool {
+					task := find(t, "test_manual")
+					return task.LastStatus != nil && *task.LastStatus == openapi.ScheduledTaskStatusFailed
+				}, 10*time.Second, 100*time.Millisecond)
+
+				task := find(t, "test_manual")
+				r.NotNil(task.LastError)
+				a.Contains(*task.LastError, "first run fails")
+
+				run, err = cl.AdminScheduledTaskRunWithResponse(root, "test_manual", adminSession)
+				tests.Ok(t, err, run)
+
+				r.Eventually(func() bool {
+					task := find(t, "test_manual")
+					return task.LastStatus != nil && *task.LastStatus == openapi.ScheduledTaskStatusSucceeded
+				}, 10*time.Second, 100*time.Millisecond)
+				a.Nil(find(t, "test_manual").LastError)
+			})
+
+			t.Run("admin_only", func(t *testing.T) {
+				list, err := cl.AdminScheduledTaskListWithResponse(root, memberSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+
+				run, err := cl.AdminScheduledTaskRunWithResponse(root, "test_manual", memberSession)
+				tests.Status(t, err, run, http.StatusForbidden)
+
+				missing, err := cl.AdminScheduledTaskRunWithResponse(root, "nonexistent", adminSession)
+				tests.Status(t, err, missing, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,78 @@
+package semdex_weaviate_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/semdex/index_job"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestSemdexIndexJobs(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		SemdexProvider:        "chromem",
+		SemdexLocalPath:       t.TempDir(),
+		LanguageModelProvider: "mock",
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			// succeeded reports whether a job of the given kind has finished
+			// for the item.
+			succeeded := func(kind string, id string) bool {
+				status := openapi.JobStatusSucceeded
+				list, err := cl.AdminJobListWithResponse(root, &openapi.AdminJobListParams{Status: &status, Kind: &kind}, adminSession)
+				tests.Ok(t, err, list)
+
+				for _, j := range list.JSON200.Jobs {
+					if strings.Contains(j.Payload, id) {
+						return true
+					}
+				}
+				return false
+			}
+
+			thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>indexed by a job</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "Index jobs " + uuid.NewString(),
+			}, adminSession))(t, http.StatusOK)
+
+			r.Eventually(func() bool {
+				return succeeded(index_job.JobIndex(datagraph.KindThread), thread.JSON200.Id)
+			}, 10*time.Second, 100*time.Millisecond)
+
+			tests.AssertRequest(cl.ThreadDeleteWithResponse(root, thread.JSON200.Slug, adminSession))(t, http.StatusOK)
+
+			r.Eventually(func() bool {
+				return succeeded(index_job.JobDeindex(datagraph.KindThread), thread.JSON200.Id)
+			}, 10*time.Second, 100*time.Millisecond)
+		}))
+	}))
+}
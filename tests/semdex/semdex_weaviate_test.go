@@ -70,7 +70,7 @@ func TestSemdexWeaviate(t *testing.T) {
 				b, err := os.ReadFile(filename)
 				r.NoError(err)
 
-				response, err := cl.ThreadCreateWithResponse(ctx, openapi.ThreadInitialProps{
+				response, err := cl.ThreadCreateWithResponse(ctx, nil, openapi.ThreadInitialProps{
 					Title:      title,
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Body:       opt.New(string(b)).Ptr(),
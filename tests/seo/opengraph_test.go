@@ -0,0 +1,109 @@
+package seo_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestOpenGraphImage(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			decode := func(t *testing.T, resp *http.Response, body []byte) {
+				a.Equal("image/png", resp.Header.Get("Content-Type"))
+				a.Contains(resp.Header.Get("Cache-Control"), "public")
+
+				img, err := png.Decode(bytes.NewReader(body))
+				require.NoError(t, err)
+				a.Equal(image.Rect(0, 0, 1200, 630), img.Bounds())
+			}
+
+			t.Run("thread", func(t *testing.T) {
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>shared</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Shared thread " + xid.New().String(),
+				}, memberSession)
+				tests.Ok(t, err, thread)
+
+				first, err := cl.OpenGraphImageThreadGetWithResponse(root, thread.JSON200.Slug)
+				tests.Ok(t, err, first)
+				decode(t, first.HTTPResponse, first.Body)
+
+				again, err := cl.OpenGraphImageThreadGetWithResponse(root, thread.JSON200.Slug)
+				tests.Ok(t, err, again)
+				a.Equal(first.Body, again.Body, "the stored image is served again")
+
+				renamed := "Renamed thread " + xid.New().String()
+				upd, err := cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{Title: &renamed}, memberSession)
+				tests.Ok(t, err, upd)
+
+				changed, err := cl.OpenGraphImageThreadGetWithResponse(root, thread.JSON200.Slug)
+				tests.Ok(t, err, changed)
+				decode(t, changed.HTTPResponse, changed.Body)
+				a.NotEqual(first.Body, changed.Body, "a new title is a new image")
+			})
+
+			t.Run("draft_not_found", func(t *testing.T) {
+				draft, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>private</p>").Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+					Title:      "Draft thread " + xid.New().String(),
+				}, memberSession)
+				tests.Ok(t, err, draft)
+
+				resp, err := cl.OpenGraphImageThreadGetWithResponse(root, draft.JSON200.Slug)
+				tests.Status(t, err, resp, http.StatusNotFound)
+			})
+
+			t.Run("node", func(t *testing.T) {
+				slug := "shared-page-" + xid.New().String()
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+					Name:       "Shared page",
+					Slug:       &slug,
+					Content:    opt.New("<p>library</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, node)
+
+				resp, err := cl.OpenGraphImageNodeGetWithResponse(root, slug)
+				tests.Ok(t, err, resp)
+				decode(t, resp.HTTPResponse, resp.Body)
+
+				missing, err := cl.OpenGraphImageNodeGetWithResponse(root, "no-such-page-"+xid.New().String())
+				tests.Status(t, err, missing, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,92 @@
+package seo_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestRestrictedCategory(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        "members room " + xid.New().String(),
+				Description: "restricted",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+
+			thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>members only</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "Members thread " + xid.New().String(),
+			}, adminSession))(t, http.StatusOK)
+
+			open := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>everyone</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "Open thread " + xid.New().String(),
+			}, adminSession))(t, http.StatusOK)
+
+			sitemap := func(t *testing.T) string {
+				resp, err := cl.SitemapGetWithResponse(root, openapi.Threads, 1)
+				tests.Ok(t, err, resp)
+				return string(resp.Body)
+			}
+
+			// Render and cache everything while the category is still open.
+			require.Contains(t, sitemap(t), thread.JSON200.Slug)
+			tests.AssertRequest(cl.StructuredDataThreadGetWithResponse(root, thread.JSON200.Slug))(t, http.StatusOK)
+
+			tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, cat.JSON200.Slug, openapi.CategoryPermissions{
+				Roles: openapi.CategoryRolePermissionList{
+					{RoleId: role.DefaultRoleMemberID.String(), Read: true},
+				},
+			}, adminSession))(t, http.StatusOK)
+
+			t.Run("sitemap", func(t *testing.T) {
+				require.Eventually(t, func() bool {
+					return !strings.Contains(sitemap(t), thread.JSON200.Slug)
+				}, 5*time.Second, 50*time.Millisecond)
+				require.Contains(t, sitemap(t), open.JSON200.Slug)
+			})
+
+			t.Run("structured_data", func(t *testing.T) {
+				require.Eventually(t, func() bool {
+					resp, err := cl.StructuredDataThreadGetWithResponse(root, thread.JSON200.Slug)
+					return err == nil && resp.StatusCode() == http.StatusNotFound
+				}, 5*time.Second, 50*time.Millisecond)
+			})
+
+			t.Run("open_graph_image", func(t *testing.T) {
+				tests.AssertRequest(cl.OpenGraphImageThreadGetWithResponse(root, thread.JSON200.Slug))(t, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,272 @@
+package seo_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestSitemap(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			published, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>indexed</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "Sitemap thread " + xid.New().String(),
+			}, memberSession)
+			tests.Ok(t, err, published)
+
+			draft, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>not indexed</p>").Ptr(),
+				Visibility: opt.New(openapi.Draft).Ptr(),
+				Title:      "Draft thread " + xid.New().String(),
+			}, memberSession)
+			tests.Ok(t, err, draft)
+
+			slug := "sitemap-page-" + xid.New().String()
+			node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+				Name:       "Sitemap page",
+				Slug:       &slug,
+				Content:    opt.New("<p>library</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, node)
+
+			sitemap := func(t *testing.T, section openapi.SitemapSection) string {
+				resp, err := cl.SitemapGetWithResponse(root, section, 1)
+				tests.Ok(t, err, resp)
+				return string(resp.Body)
+			}
+
+			t.Run("index", func(t *testing.T) {
+				resp, err := cl.SitemapIndexGetWithResponse(root)
+				tests.Ok(t, err, resp)
+
+				a.Contains(resp.HTTPResponse.Header.Get("Content-Type"), "application/xml")
+				a.Contains(string(resp.Body), "<sitemapindex")
+				a.Contains(string(resp.Body), "/api/sitemaps/threads/1</loc>")
+				a.Contains(string(resp.Body), "/api/sitemaps/nodes/1</loc>")
+				a.Contains(string(resp.Body), "/api/sitemaps/profiles/1</loc>")
+				a.Contains(string(resp.Body), "<lastmod>")
+			})
+
+			t.Run("sections", func(t *testing.T) {
+				threads := sitemap(t, openapi.Threads)
+				a.Contains(threads, "/t/"+published.JSON200.Slug+"</loc>")
+				a.NotContains(threads, draft.JSON200.Id)
+
+				a.Contains(sitemap(t, openapi.Nodes), "/l/"+slug+"</loc>")
+				a.Contains(sitemap(t, openapi.Profiles), "/m/"+member.Handle+"</loc>")
+			})
+
+			t.Run("past_the_end", func(t *testing.T) {
+				resp, err := cl.SitemapGetWithResponse(root, openapi.Threads, 1000)
+				tests.Status(t, err, resp, http.StatusNotFound)
+			})
+
+			t.Run("regenerated_on_change", func(t *testing.T) {
+				// Render and cache the section before it changes.
+				sitemap(t, openapi.Threads)
+
+				later, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>later</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Later thread " + xid.New().String(),
+				}, memberSession)
+				tests.Ok(t, err, later)
+
+				require.Eventually(t, func() bool {
+					return strings.Contains(sitemap(t, openapi.Threads), later.JSON200.Slug)
+				}, 5*time.Second, 50*time.Millisecond)
+
+				_, err = cl.ThreadDeleteWithResponse(root, later.JSON200.Slug, memberSession)
+				require.NoError(t, err)
+
+				require.Eventually(t, func() bool {
+					return !strings.Contains(sitemap(t, openapi.Threads), later.JSON200.Slug)
+				}, 5*time.Second, 50*time.Millisecond)
+			})
+
+			t.Run("not_modified", func(t *testing.T) {
+				first, err := cl.SitemapGetWithResponse(root, openapi.Nodes, 1)
+				tests.Ok(t, err, first)
+
+				second, err := cl.SitemapGetWithResponse(root, openapi.Nodes, 1, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", first.HTTPResponse.Header.Get("ETag"))
+					return nil
+				})
+				tests.Status(t, err, second, http.StatusNotModified)
+			})
+		}))
+	}))
+}
+
+type structuredData struct {
+	Type                 string `json:"@type"`
+	URL                  string `json:"url"`
+	Headline             string `json:"headline"`
+	Image                string `json:"image"`
+	CommentCount         int    `json:"commentCount"`
+	InteractionStatistic []struct {
+		InteractionType      string `json:"interactionType"`
+		UserInteractionCount int    `json:"userInteractionCount"`
+	} `json:"interactionStatistic"`
+	Author struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"author"`
+	MainEntity struct {
+		Type          string `json:"@type"`
+		Name          string `json:"name"`
+		AlternateName string `json:"alternateName"`
+	} `json:"mainEntity"`
+}
+
+func TestStructuredData(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			title := "Structured thread " + xid.New().String()
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>described</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      title,
+			}, memberSession)
+			tests.Ok(t, err, thread)
+
+			threadData := func(t *testing.T) structuredData {
+				resp, err := cl.StructuredDataThreadGetWithResponse(root, thread.JSON200.Slug)
+				tests.Ok(t, err, resp)
+
+				var d structuredData
+				require.NoError(t, json.Unmarshal(resp.Body, &d))
+				return d
+			}
+
+			t.Run("thread", func(t *testing.T) {
+				a := assert.New(t)
+
+				resp, err := cl.StructuredDataThreadGetWithResponse(root, thread.JSON200.Slug)
+				tests.Ok(t, err, resp)
+				a.Equal("application/ld+json", resp.HTTPResponse.Header.Get("Content-Type"))
+				a.NotEmpty(resp.HTTPResponse.Header.Get("ETag"))
+
+				d := threadData(t)
+				a.Equal("DiscussionForumPosting", d.Type)
+				a.Equal(title, d.Headline)
+				a.Contains(d.URL, "/t/"+thread.JSON200.Slug)
+				a.Contains(d.Image, "/api/og/threads/"+thread.JSON200.Slug)
+				a.Equal(member.Name, d.Author.Name)
+				a.Contains(d.Author.URL, "/m/"+member.Handle)
+				a.Equal(0, d.CommentCount)
+			})
+
+			t.Run("thread_regenerated_on_reply", func(t *testing.T) {
+				threadData(t)
+
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+					Body: "<p>a reply</p>",
+				}, adminSession)
+				tests.Ok(t, err, reply)
+
+				require.Eventually(t, func() bool {
+					return threadData(t).CommentCount == 1
+				}, 5*time.Second, 50*time.Millisecond)
+			})
+
+			t.Run("draft_not_found", func(t *testing.T) {
+				draft, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hidden</p>").Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+					Title:      "Draft " + xid.New().String(),
+				}, memberSession)
+				tests.Ok(t, err, draft)
+
+				resp, err := cl.StructuredDataThreadGetWithResponse(root, draft.JSON200.Slug)
+				tests.Status(t, err, resp, http.StatusNotFound)
+			})
+
+			t.Run("node", func(t *testing.T) {
+				a := assert.New(t)
+
+				slug := "structured-page-" + xid.New().String()
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+					Name:       "Structured page",
+					Slug:       &slug,
+					Content:    opt.New("<p>library</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, node)
+
+				resp, err := cl.StructuredDataNodeGetWithResponse(root, slug)
+				tests.Ok(t, err, resp)
+
+				var d structuredData
+				require.NoError(t, json.Unmarshal(resp.Body, &d))
+				a.Equal("Article", d.Type)
+				a.Equal("Structured page", d.Headline)
+				a.Contains(d.URL, "/l/"+slug)
+			})
+
+			t.Run("profile", func(t *testing.T) {
+				a := assert.New(t)
+
+				resp, err := cl.StructuredDataProfileGetWithResponse(root, member.Handle)
+				tests.Ok(t, err, resp)
+
+				var d structuredData
+				require.NoError(t, json.Unmarshal(resp.Body, &d))
+				a.Equal("ProfilePage", d.Type)
+				a.Equal("Person", d.MainEntity.Type)
+				a.Equal(member.Name, d.MainEntity.Name)
+				a.Equal(member.Handle, d.MainEntity.AlternateName)
+
+				missing, err := cl.StructuredDataProfileGetWithResponse(root, "nobody-"+xid.New().String())
+				tests.Status(t, err, missing, http.StatusNotFound)
+			})
+		}))
+	}))
+}
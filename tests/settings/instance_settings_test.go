@@ -0,0 +1,105 @@
+package settings_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestInstanceSettings(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			t.Run("defaults", func(t *testing.T) {
+				res, err := cl.AdminSettingsGetWithResponse(root, adminSession)
+				tests.Ok(t, err, res)
+				r.NotNil(res.JSON200.Limits)
+				a.Equal(int64(0), *res.JSON200.Limits.MaxRequestSize)
+				r.NotNil(res.JSON200.Features)
+				a.Nil(res.JSON200.Features.LinkSnapshots)
+			})
+
+			t.Run("member_cannot_update", func(t *testing.T) {
+				res, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Limits: &openapi.InstanceLimits{MaxRequestSize: opt.New(int64(1)).Ptr()},
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("invalid_limits", func(t *testing.T) {
+				res, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Limits: &openapi.InstanceLimits{SpamThreshold: opt.New(2.0).Ptr()},
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			post := func() (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>" + strings.Repeat("long ", 1000) + "</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "request size",
+				}, memberSession)
+			}
+
+			t.Run("request_size_applies_without_restart", func(t *testing.T) {
+				res, err := post()
+				tests.Ok(t, err, res)
+
+				update, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Limits:   &openapi.InstanceLimits{MaxRequestSize: opt.New(int64(1024)).Ptr()},
+					Features: &openapi.InstanceFeatures{LinkSnapshots: opt.New(true).Ptr()},
+				}, adminSession)
+				tests.Ok(t, err, update)
+				a.Equal(int64(1024), *update.JSON200.Limits.MaxRequestSize)
+				a.Equal(true, *update.JSON200.Features.LinkSnapshots)
+
+				res, err = post()
+				r.NoError(err)
+				a.NotEqual(http.StatusOK, res.StatusCode())
+
+				update, err = cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Limits: &openapi.InstanceLimits{},
+				}, adminSession)
+				tests.Ok(t, err, update)
+				a.Equal(int64(0), *update.JSON200.Limits.MaxRequestSize)
+				a.Equal(true, *update.JSON200.Features.LinkSnapshots, "unrelated settings are kept")
+
+				res, err = post()
+				tests.Ok(t, err, res)
+			})
+		}))
+	}))
+}
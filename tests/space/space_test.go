@@ -0,0 +1,138 @@
+package space_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestSpaces(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			baldurCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			baldurSession := sh.WithSession(baldurCtx)
+			lokiCtx, loki := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			lokiSession := sh.WithSession(lokiCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			create := func(t *testing.T, policy string) *openapi.SpaceCreateResponse {
+				p := openapi.SpaceJoinPolicy(policy)
+				res, err := cl.SpaceCreateWithResponse(root, openapi.SpaceInitialProps{
+					Name:       "space " + uuid.NewString(),
+					JoinPolicy: &p,
+				}, baldurSession)
+				tests.Ok(t, err, res)
+				return res
+			}
+
+			t.Run("open_space", func(t *testing.T) {
+				a := assert.New(t)
+
+				sp := create(t, "open")
+				a.Equal(1, sp.JSON200.MemberCount)
+				a.NotNil(sp.JSON200.Membership)
+				a.Equal("owner", string(sp.JSON200.Membership.Role))
+
+				join, err := cl.SpaceJoinWithResponse(root, sp.JSON200.Slug, lokiSession)
+				tests.Ok(t, err, join)
+				a.Equal("active", string(join.JSON200.Membership.Status))
+				a.Equal(2, join.JSON200.MemberCount)
+
+				leave, err := cl.SpaceLeaveWithResponse(root, sp.JSON200.Slug, baldurSession)
+				tests.Status(t, err, leave, http.StatusBadRequest)
+			})
+
+			t.Run("request_space", func(t *testing.T) {
+				a := assert.New(t)
+
+				sp := create(t, "request")
+				slug := sp.JSON200.Slug
+
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>members only</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "space thread " + uuid.NewString(),
+					Space:      &sp.JSON200.Id,
+				}, baldurSession)
+				tests.Ok(t, err, thread)
+
+				threadIDs := func(t *testing.T, session openapi.RequestEditorFn) []string {
+					list, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{Space: &slug}, session)
+					tests.Ok(t, err, list)
+					return dt.Map(list.JSON200.Threads, func(th openapi.ThreadReference) string { return th.Id })
+				}
+
+				a.NotContains(threadIDs(t, lokiSession), thread.JSON200.Id)
+
+				get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, lokiSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+
+				post, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>let me in</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "outsider " + uuid.NewString(),
+					Space:      &sp.JSON200.Id,
+				}, lokiSession)
+				tests.Status(t, err, post, http.StatusForbidden)
+
+				join, err := cl.SpaceJoinWithResponse(root, slug, lokiSession)
+				tests.Ok(t, err, join)
+				a.Equal("requested", string(join.JSON200.Membership.Status))
+
+				approve, err := cl.SpaceMemberUpdateWithResponse(root, slug, loki.Handle, openapi.SpaceMemberMutableProps{}, baldurSession)
+				tests.Ok(t, err, approve)
+				a.Equal("active", string(approve.JSON200.Status))
+
+				a.Contains(threadIDs(t, lokiSession), thread.JSON200.Id)
+			})
+
+			t.Run("invite_space", func(t *testing.T) {
+				a := assert.New(t)
+
+				sp := create(t, "invite")
+				slug := sp.JSON200.Slug
+
+				join, err := cl.SpaceJoinWithResponse(root, slug, lokiSession)
+				tests.Status(t, err, join, http.StatusForbidden)
+
+				members, err := cl.SpaceMemberListWithResponse(root, slug, lokiSession)
+				tests.Status(t, err, members, http.StatusForbidden)
+
+				invite, err := cl.SpaceMemberUpdateWithResponse(root, slug, loki.Handle, openapi.SpaceMemberMutableProps{}, baldurSession)
+				tests.Ok(t, err, invite)
+				a.Equal("invited", string(invite.JSON200.Status))
+
+				join, err = cl.SpaceJoinWithResponse(root, slug, lokiSession)
+				tests.Ok(t, err, join)
+				a.Equal("active", string(join.JSON200.Membership.Status))
+			})
+		}))
+	}))
+}
@@ -0,0 +1,99 @@
+package spam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+const linkDump = `<p><a href="https://spam.example/1">a</a> <a href="https://spam.example/2">b</a> <a href="https://spam.example/3">c</a> <a href="https://spam.example/4">d</a></p>`
+
+func TestSpamScreening(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func(body string, session openapi.RequestEditorFn) *openapi.ThreadCreateResponse {
+				res, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New(body).Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "spam " + uuid.NewString(),
+				}, session)
+				tests.Ok(t, err, res)
+				return res
+			}
+
+			queued := func() map[string]openapi.QueuedPost {
+				res, err := cl.PostQueueListWithResponse(root, nil, adminSession)
+				tests.Ok(t, err, res)
+				return dt.Reduce(res.JSON200.Posts, func(m map[string]openapi.QueuedPost, p openapi.QueuedPost) map[string]openapi.QueuedPost {
+					m[p.Post.Id] = p
+					return m
+				}, map[string]openapi.QueuedPost{})
+			}
+
+			ham := createThread("<p>Does anyone know a good way to keep slugs off lettuce without pellets?</p>", memberSession)
+			a.Equal(openapi.Published, ham.JSON200.Visibility)
+
+			spam := createThread(linkDump, memberSession)
+			a.Equal(openapi.Review, spam.JSON200.Visibility)
+
+			admin := createThread(linkDump, adminSession)
+			a.Equal(openapi.Published, admin.JSON200.Visibility)
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>Post your seed swaps here.</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "swaps " + uuid.NewString(),
+			}, adminSession)
+			tests.Ok(t, err, thread)
+
+			reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: linkDump}, memberSession)
+			tests.Ok(t, err, reply)
+
+			q := queued()
+
+			r.Contains(q, spam.JSON200.Id)
+			r.NotNil(q[spam.JSON200.Id].SpamScore)
+			a.GreaterOrEqual(*q[spam.JSON200.Id].SpamScore, float32(0.8))
+
+			r.Contains(q, reply.JSON200.Id)
+			a.Equal(openapi.DatagraphItemKindReply, q[reply.JSON200.Id].Kind)
+
+			a.NotContains(q, ham.JSON200.Id)
+			a.NotContains(q, admin.JSON200.Id)
+		}))
+	}))
+}
@@ -0,0 +1,163 @@
+package syndication_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestFeeds(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			newCategory := func() *openapi.CategoryCreateResponse {
+				cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+					Colour:      "#fe4efd",
+					Description: "feeds",
+					Name:        "Category " + xid.New().String(),
+				}, adminSession)
+				tests.Ok(t, err, cat)
+				return cat
+			}
+
+			cat1 := newCategory()
+			cat2 := newCategory()
+
+			title1 := "Feed thread " + xid.New().String()
+			title2 := "Feed thread " + xid.New().String()
+			tag := "feedtag" + xid.New().String()
+
+			thr1, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>first</p>").Ptr(),
+				Category:   opt.New(cat1.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Tags:       &openapi.TagNameList{tag},
+				Title:      title1,
+			}, memberSession)
+			tests.Ok(t, err, thr1)
+
+			thr2, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>second</p>").Ptr(),
+				Category:   opt.New(cat2.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      title2,
+			}, adminSession)
+			tests.Ok(t, err, thr2)
+
+			t.Run("timeline_rss", func(t *testing.T) {
+				resp, err := cl.FeedTimelineGetWithResponse(root, openapi.Rss, nil)
+				tests.Ok(t, err, resp)
+
+				a.Contains(resp.HTTPResponse.Header.Get("Content-Type"), "application/rss+xml")
+				a.Equal("public, max-age=300", resp.HTTPResponse.Header.Get("Cache-Control"))
+				a.NotEmpty(resp.HTTPResponse.Header.Get("ETag"))
+				a.Contains(string(resp.Body), title1)
+				a.Contains(string(resp.Body), title2)
+			})
+
+			t.Run("category_atom", func(t *testing.T) {
+				resp, err := cl.FeedCategoryGetWithResponse(root, openapi.Atom, cat1.JSON200.Slug, nil)
+				tests.Ok(t, err, resp)
+
+				a.Contains(resp.HTTPResponse.Header.Get("Content-Type"), "application/atom+xml")
+				a.Contains(string(resp.Body), title1)
+				a.NotContains(string(resp.Body), title2)
+			})
+
+			t.Run("tag", func(t *testing.T) {
+				resp, err := cl.FeedTagGetWithResponse(root, openapi.Rss, tag, nil)
+				tests.Ok(t, err, resp)
+
+				a.Contains(string(resp.Body), title1)
+				a.NotContains(string(resp.Body), title2)
+			})
+
+			t.Run("profile", func(t *testing.T) {
+				resp, err := cl.FeedProfileGetWithResponse(root, openapi.Atom, member.Handle, nil)
+				tests.Ok(t, err, resp)
+
+				a.Contains(string(resp.Body), title1)
+				a.NotContains(string(resp.Body), title2)
+			})
+
+			t.Run("not_modified", func(t *testing.T) {
+				first, err := cl.FeedCategoryGetWithResponse(root, openapi.Rss, cat1.JSON200.Slug, nil)
+				tests.Ok(t, err, first)
+
+				etag := first.HTTPResponse.Header.Get("ETag")
+
+				second, err := cl.FeedCategoryGetWithResponse(root, openapi.Rss, cat1.JSON200.Slug, nil, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", etag)
+					return nil
+				})
+				tests.Status(t, err, second, http.StatusNotModified)
+			})
+
+			t.Run("home_requires_token", func(t *testing.T) {
+				resp, err := cl.FeedHomeGetWithResponse(root, openapi.Rss, nil)
+				tests.Status(t, err, resp, http.StatusUnauthorized)
+
+				bad := "nope"
+				resp, err = cl.FeedHomeGetWithResponse(root, openapi.Rss, &openapi.FeedHomeGetParams{Token: &bad})
+				tests.Status(t, err, resp, http.StatusUnauthorized)
+			})
+
+			t.Run("token_lifecycle", func(t *testing.T) {
+				tok, err := cl.AccountFeedTokenGetWithResponse(root, memberSession)
+				tests.Ok(t, err, tok)
+				r.NotEmpty(tok.JSON200.Token)
+
+				again, err := cl.AccountFeedTokenGetWithResponse(root, memberSession)
+				tests.Ok(t, err, again)
+				a.Equal(tok.JSON200.Token, again.JSON200.Token)
+
+				home, err := cl.FeedHomeGetWithResponse(root, openapi.Atom, &openapi.FeedHomeGetParams{Token: &tok.JSON200.Token})
+				tests.Ok(t, err, home)
+				a.Equal("private, max-age=300", home.HTTPResponse.Header.Get("Cache-Control"))
+
+				revoke, err := cl.AccountFeedTokenRevokeWithResponse(root, memberSession)
+				tests.Status(t, err, revoke, http.StatusNoContent)
+
+				home, err = cl.FeedHomeGetWithResponse(root, openapi.Atom, &openapi.FeedHomeGetParams{Token: &tok.JSON200.Token})
+				tests.Status(t, err, home, http.StatusUnauthorized)
+
+				rotated, err := cl.AccountFeedTokenGetWithResponse(root, memberSession)
+				tests.Ok(t, err, rotated)
+				a.NotEqual(tok.JSON200.Token, rotated.JSON200.Token)
+			})
+
+			t.Run("token_requires_session", func(t *testing.T) {
+				resp, err := cl.AccountFeedTokenGetWithResponse(root)
+				tests.Status(t, err, resp, http.StatusUnauthorized)
+			})
+		}))
+	}))
+}
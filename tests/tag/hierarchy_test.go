@@ -0,0 +1,160 @@
+package tag_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/nullable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestTagHierarchyAndSynonyms(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession))(t, http.StatusOK)
+
+			suffix := strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+			javascript := "javascript" + suffix
+			js := "js" + suffix
+			ecmascript := "ecmascript" + suffix
+			languages := "languages" + suffix
+
+			create := func(t *testing.T, tags ...string) *openapi.ThreadCreateOK {
+				return tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>tagged</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "tag hierarchy test " + uuid.NewString(),
+					Tags:       &tags,
+				}, memberSession))(t, http.StatusOK).JSON200
+			}
+
+			tagNames := func(tags openapi.TagReferenceList) []string {
+				return dt.Map(tags, func(t openapi.TagReference) string { return t.Name })
+			}
+
+			original := create(t, javascript)
+
+			t.Run("synonyms", func(t *testing.T) {
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.TagSynonymAddWithResponse(root, javascript, js, memberSession))(t, http.StatusForbidden)
+
+				tag := tests.AssertRequest(cl.TagSynonymAddWithResponse(root, javascript, js, adminSession))(t, http.StatusOK)
+				a.Equal([]string{js}, tag.JSON200.Synonyms)
+
+				thread := create(t, js)
+				a.Equal([]string{javascript}, tagNames(thread.Tags))
+
+				both := create(t, js, javascript)
+				a.Equal([]string{javascript}, tagNames(both.Tags))
+
+				// Updating a thread with a synonym of a tag it already has
+				// must not remove the tag.
+				updated := tests.AssertRequest(cl.ThreadUpdateWithResponse(root, original.Slug, openapi.ThreadMutableProps{
+					Tags: &openapi.TagNameList{js},
+				}, memberSession))(t, http.StatusOK)
+				a.Equal([]string{javascript}, tagNames(updated.JSON200.Tags))
+
+				// Looking up the synonym resolves to the canonical tag.
+				get := tests.AssertRequest(cl.TagGetWithResponse(root, js))(t, http.StatusOK)
+				a.Equal(javascript, get.JSON200.Name)
+
+				// An existing tag can't become a synonym, it must be merged.
+				create(t, ecmascript)
+				tests.AssertRequest(cl.TagSynonymAddWithResponse(root, javascript, ecmascript, adminSession))(t, http.StatusConflict)
+			})
+
+			t.Run("hierarchy", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				create(t, languages)
+
+				tests.AssertRequest(cl.TagUpdateWithResponse(root, javascript, openapi.TagMutableProps{
+					Parent: nullable.NewNullableWithValue(languages),
+				}, memberSession))(t, http.StatusForbidden)
+
+				child := tests.AssertRequest(cl.TagUpdateWithResponse(root, javascript, openapi.TagMutableProps{
+					Parent: nullable.NewNullableWithValue(languages),
+				}, adminSession))(t, http.StatusOK)
+				r.NotNil(child.JSON200.Parent)
+				a.Equal(languages, *child.JSON200.Parent)
+
+				parent := tests.AssertRequest(cl.TagGetWithResponse(root, languages))(t, http.StatusOK)
+				a.Equal([]string{javascript}, parent.JSON200.Children)
+
+				// Filtering by the parent tag includes threads tagged with a child.
+				list := tests.AssertRequest(cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+					Tags: &openapi.TagListIDs{parent.JSON200.Id},
+				}))(t, http.StatusOK)
+				a.Contains(dt.Map(list.JSON200.Threads, func(t openapi.ThreadReference) string { return t.Id }), original.Id)
+
+				tests.AssertRequest(cl.TagUpdateWithResponse(root, languages, openapi.TagMutableProps{
+					Parent: nullable.NewNullableWithValue(javascript),
+				}, adminSession))(t, http.StatusBadRequest)
+			})
+
+			t.Run("merge", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				duplicate := create(t, ecmascript)
+				overlap := create(t, ecmascript, javascript)
+
+				tests.AssertRequest(cl.TagFollowersAddWithResponse(root, ecmascript, memberSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.TagMergeWithResponse(root, ecmascript, openapi.TagMergeRequest{Target: ecmascript}, adminSession))(t, http.StatusBadRequest)
+				tests.AssertRequest(cl.TagMergeWithResponse(root, ecmascript, openapi.TagMergeRequest{Target: javascript}, memberSession))(t, http.StatusForbidden)
+
+				merged := tests.AssertRequest(cl.TagMergeWithResponse(root, ecmascript, openapi.TagMergeRequest{Target: javascript}, adminSession))(t, http.StatusOK)
+				a.Equal(javascript, merged.JSON200.Name)
+				a.ElementsMatch([]string{js, ecmascript}, merged.JSON200.Synonyms)
+				r.NotNil(merged.JSON200.Parent)
+				a.Equal(languages, *merged.JSON200.Parent)
+
+				for _, moved := range []*openapi.ThreadCreateOK{duplicate, overlap} {
+					thread := tests.AssertRequest(cl.ThreadGetWithResponse(root, moved.Slug, nil))(t, http.StatusOK)
+					a.Equal([]string{javascript}, tagNames(thread.JSON200.Tags))
+				}
+
+				// The old name now resolves to the merged tag when posting.
+				again := create(t, ecmascript)
+				a.Equal([]string{javascript}, tagNames(again.Tags))
+
+				// The follow moved over to the merged tag.
+				subs := tests.AssertRequest(cl.AccountSubscriptionsGetWithResponse(root, memberSession))(t, http.StatusOK)
+				followed := tagNames(subs.JSON200.Tags)
+				a.Contains(followed, javascript)
+				a.NotContains(followed, ecmascript)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,171 @@
+package tag_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestTagPolicies(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			staffCtx, staff := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			staffSession := sh.WithSession(staffCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+
+			staffRole := tests.AssertRequest(cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+				Name:        "Staff " + uuid.NewString(),
+				Colour:      "blue",
+				Permissions: []openapi.Permission{},
+			}, adminSession))(t, http.StatusOK)
+			tests.AssertRequest(cl.AccountAddRoleWithResponse(root, staff.Handle, staffRole.JSON200.Id, adminSession))(t, http.StatusOK)
+
+			general := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:        "general " + uuid.NewString(),
+				Description: "anything goes",
+				Colour:      "#000",
+			}, adminSession))(t, http.StatusOK)
+
+			suffix := strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+			question := "question" + suffix
+			bug := "bug" + suffix
+			announcement := "announcement" + suffix
+
+			thread := func(category string, tags ...string) openapi.ThreadInitialProps {
+				props := openapi.ThreadInitialProps{
+					Body:       opt.New("<p>tagged</p>").Ptr(),
+					Category:   opt.New(category).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "tag policy test " + uuid.NewString(),
+				}
+				if len(tags) > 0 {
+					props.Tags = &tags
+				}
+				return props
+			}
+
+			tagNames := func(tags openapi.TagReferenceList) []string {
+				return dt.Map(tags, func(t openapi.TagReference) string { return t.Name })
+			}
+
+			t.Run("required", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, bug), memberSession))(t, http.StatusOK)
+
+				support := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+					Name:         "support " + uuid.NewString(),
+					Description:  "ask for help",
+					Colour:       "#000",
+					RequiredTags: &openapi.TagNameList{question, bug},
+				}, adminSession))(t, http.StatusOK)
+				r.NotNil(support.JSON200.RequiredTags)
+				a.ElementsMatch([]string{question, bug}, *support.JSON200.RequiredTags)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(support.JSON200.Id), memberSession))(t, http.StatusBadRequest)
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(support.JSON200.Id, "other"+suffix), memberSession))(t, http.StatusBadRequest)
+
+				ok := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(support.JSON200.Id, bug), memberSession))(t, http.StatusOK)
+				a.Equal([]string{bug}, tagNames(ok.JSON200.Tags))
+
+				// Removing the last required tag is rejected.
+				tests.AssertRequest(cl.ThreadUpdateWithResponse(root, ok.JSON200.Slug, openapi.ThreadMutableProps{
+					Tags: &openapi.TagNameList{},
+				}, memberSession))(t, http.StatusBadRequest)
+
+				// So is moving an untagged thread into the category.
+				untagged := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id), memberSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.ThreadUpdateWithResponse(root, untagged.JSON200.Slug, openapi.ThreadMutableProps{
+					Category: &support.JSON200.Id,
+				}, memberSession))(t, http.StatusBadRequest)
+
+				lifted := tests.AssertRequest(cl.CategoryUpdateWithResponse(root, support.JSON200.Slug, openapi.CategoryMutableProps{
+					RequiredTags: &openapi.TagNameList{},
+				}, adminSession))(t, http.StatusOK)
+				a.Nil(lifted.JSON200.RequiredTags)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(support.JSON200.Id), memberSession))(t, http.StatusOK)
+			})
+
+			t.Run("restricted", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, announcement), adminSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.TagUpdateWithResponse(root, announcement, openapi.TagMutableProps{
+					Roles: &[]openapi.Identifier{staffRole.JSON200.Id},
+				}, memberSession))(t, http.StatusForbidden)
+
+				tag := tests.AssertRequest(cl.TagUpdateWithResponse(root, announcement, openapi.TagMutableProps{
+					Roles: &[]openapi.Identifier{staffRole.JSON200.Id},
+				}, adminSession))(t, http.StatusOK)
+				r.NotNil(tag.JSON200.Roles)
+				a.Equal([]string{staffRole.JSON200.Id}, *tag.JSON200.Roles)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, announcement), memberSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, announcement), staffSession))(t, http.StatusOK)
+
+				// A member can't strip a restricted tag a moderator applied.
+				own := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, bug), memberSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.ThreadUpdateWithResponse(root, own.JSON200.Slug, openapi.ThreadMutableProps{
+					Tags: &openapi.TagNameList{bug, announcement},
+				}, adminSession))(t, http.StatusOK)
+
+				updated := tests.AssertRequest(cl.ThreadUpdateWithResponse(root, own.JSON200.Slug, openapi.ThreadMutableProps{
+					Tags: &openapi.TagNameList{},
+				}, memberSession))(t, http.StatusOK)
+				a.Equal([]string{announcement}, tagNames(updated.JSON200.Tags))
+			})
+
+			t.Run("creation", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				set := tests.AssertRequest(cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					TagCreation: &openapi.TagCreationSettings{Roles: &[]openapi.Identifier{staffRole.JSON200.Id}},
+				}, adminSession))(t, http.StatusOK)
+				r.NotNil(set.JSON200.TagCreation)
+				a.Equal([]string{staffRole.JSON200.Id}, *set.JSON200.TagCreation.Roles)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, "new"+suffix), memberSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, bug), memberSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, "new"+suffix), staffSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, "admin"+suffix), adminSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					TagCreation: &openapi.TagCreationSettings{},
+				}, adminSession))(t, http.StatusOK)
+
+				tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, thread(general.JSON200.Id, "other"+suffix), memberSession))(t, http.StatusOK)
+			})
+		}))
+	}))
+}
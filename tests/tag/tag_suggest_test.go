@@ -0,0 +1,120 @@
+package tag_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestTagSuggest(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		TagAutoApplyEnabled:   true,
+		TagAutoApplyThreshold: 0.5,
+		TagAutoApplyLimit:     3,
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			session := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, sh.WithSession(adminCtx))
+			tests.Ok(t, err, cat)
+
+			tagName := "suggest" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+			create := func(t *testing.T, body string, tags []string) *openapi.ThreadCreateOK {
+				var tagList *openapi.TagNameList
+				if tags != nil {
+					tagList = &tags
+				}
+
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>" + body + "</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "tag suggestion test " + uuid.NewString(),
+					Tags:       tagList,
+				}, session)
+				tests.Ok(t, err, thread)
+				return thread.JSON200
+			}
+
+			create(t, "the original thread", []string{tagName})
+
+			t.Run("suggest_existing", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.TagSuggestWithResponse(root, openapi.TagSuggestRequest{
+					Content: "<p>a question about " + tagName + " and more " + tagName + " plus gardening and gardening</p>",
+				}, session)
+				tests.Ok(t, err, res)
+
+				s, ok := lo.Find(res.JSON200.Suggestions, func(s openapi.TagSuggestion) bool { return s.Name == tagName })
+				r.True(ok)
+				a.True(s.Existing)
+				a.Greater(s.Score, float32(0.5))
+
+				g, ok := lo.Find(res.JSON200.Suggestions, func(s openapi.TagSuggestion) bool { return s.Name == "gardening" })
+				r.True(ok)
+				a.False(g.Existing)
+				a.Less(g.Score, s.Score)
+			})
+
+			t.Run("suggest_unauthenticated", func(t *testing.T) {
+				res, err := cl.TagSuggestWithResponse(root, openapi.TagSuggestRequest{Content: "<p>hello</p>"})
+				tests.Status(t, err, res, http.StatusUnauthorized)
+			})
+
+			t.Run("auto_apply", func(t *testing.T) {
+				r := require.New(t)
+
+				thread := create(t, "more discussion of "+tagName+" and "+tagName, nil)
+
+				r.Eventually(func() bool {
+					get, err := cl.ThreadGetWithResponse(root, thread.Slug, nil, session)
+					tests.Ok(t, err, get)
+					_, found := lo.Find(get.JSON200.Tags, func(tg openapi.TagReference) bool { return tg.Name == tagName })
+					return found
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("auto_apply_skips_tagged", func(t *testing.T) {
+				a := assert.New(t)
+
+				thread := create(t, "a thread about "+tagName+" and "+tagName, []string{"manual"})
+
+				time.Sleep(time.Second)
+
+				get, err := cl.ThreadGetWithResponse(root, thread.Slug, nil, session)
+				tests.Ok(t, err, get)
+				a.Len(get.JSON200.Tags, 1)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,318 @@
+package telegram_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/app/transports/telegram"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+const (
+	botToken    = "123456:bot_token"
+	botUsername = "storyden_bot"
+	memberChat  = int64(1001)
+)
+
+func TestTelegramBot(t *testing.T) {
+	t.Parallel()
+
+	api := &botAPI{}
+	apiServer := httptest.NewServer(api)
+	defer apiServer.Close()
+
+	apiURL, err := url.Parse(apiServer.URL)
+	require.NoError(t, err)
+
+	integration.Test(t, &config.Config{
+		JWTSecret:        []byte("07d422e512b23a056ccc953994d1593f"),
+		TelegramBotToken: botToken,
+		TelegramAPIURL:   *apiURL,
+	}, e2e.Setup(), telegram.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		ts *httptest.Server,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+
+			webhooks := api.requests("setWebhook")
+			require.Len(t, webhooks, 1)
+			assert.True(t, strings.HasSuffix(webhooks[0].body["url"].(string), "/telegram/webhook"))
+			secret := webhooks[0].body["secret_token"].(string)
+
+			updateID := 0
+			push := func(t *testing.T, secret string, message map[string]any) int {
+				updateID++
+				b, err := json.Marshal(map[string]any{"update_id": updateID, "message": message})
+				require.NoError(t, err)
+
+				req, err := http.NewRequest(http.MethodPost, ts.URL+"/telegram/webhook", bytes.NewReader(b))
+				require.NoError(t, err)
+				if secret != "" {
+					req.Header.Set("X-Telegram-Bot-Api-Secret-Token", secret)
+				}
+
+				res, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				defer res.Body.Close()
+
+				return res.StatusCode
+			}
+
+			messageID := int64(0)
+			message := func(from int64, username string, text string) map[string]any {
+				messageID++
+				return map[string]any{
+					"message_id": messageID,
+					"from":       map[string]any{"id": from, "is_bot": false, "first_name": username, "username": username},
+					"chat":       map[string]any{"id": from, "type": "private"},
+					"text":       text,
+				}
+			}
+
+			t.Run("authentication", func(t *testing.T) {
+				a := assert.New(t)
+
+				a.Equal(http.StatusUnauthorized, push(t, "", message(memberChat, "loki", "hello")))
+				a.Equal(http.StatusUnauthorized, push(t, "wrong", message(memberChat, "loki", "hello")))
+				a.Equal(http.StatusOK, push(t, secret, message(memberChat, "loki", "hello")))
+			})
+
+			t.Run("link", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.AccountTelegramLinkGetWithResponse(root))(t, http.StatusUnauthorized)
+
+				status := tests.AssertRequest(cl.AccountTelegramLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				a.Equal(botUsername, status.JSON200.BotUsername)
+				a.Nil(status.JSON200.UserId)
+
+				sent := len(api.requests("sendMessage"))
+				push(t, secret, message(memberChat, "loki", "/start nonsense"))
+				a.Len(api.requests("sendMessage"), sent+1)
+
+				code := tests.AssertRequest(cl.AccountTelegramLinkCreateWithResponse(root, memberSession))(t, http.StatusOK)
+				a.True(code.JSON200.ExpiresAt.After(time.Now()))
+				a.Equal("https://t.me/"+botUsername+"?start="+code.JSON200.Code, code.JSON200.Url)
+
+				push(t, secret, message(memberChat, "loki", "/start "+code.JSON200.Code))
+
+				status = tests.AssertRequest(cl.AccountTelegramLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				r.NotNil(status.JSON200.UserId)
+				a.Equal("1001", *status.JSON200.UserId)
+				r.NotNil(status.JSON200.Username)
+				a.Equal("loki", *status.JSON200.Username)
+
+				// A forwarded link can't be used again by someone else.
+				push(t, secret, message(2002, "mallory", "/start "+code.JSON200.Code))
+				status = tests.AssertRequest(cl.AccountTelegramLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				r.NotNil(status.JSON200.UserId)
+				a.Equal("1001", *status.JSON200.UserId)
+			})
+
+			t.Run("preferences", func(t *testing.T) {
+				prefs := tests.AssertRequest(cl.AccountNotificationPreferencesGetWithResponse(root, memberSession))(t, http.StatusOK)
+
+				for _, p := range prefs.JSON200.Preferences {
+					switch p.Event {
+					case openapi.NotificationEventThreadReply:
+						assert.True(t, p.Telegram)
+					case openapi.NotificationEventPostLike:
+						assert.False(t, p.Telegram)
+					}
+				}
+			})
+
+			t.Run("notify_and_reply", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>hello telegram</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "Telegram bot " + uuid.NewString(),
+				}, memberSession))(t, http.StatusOK)
+
+				body := "a reply for " + uuid.NewString()
+				tests.AssertRequest(cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{
+					Body: "<p>" + body + "</p>",
+				}, adminSession))(t, http.StatusOK)
+
+				var notification request
+				r.Eventually(func() bool {
+					for _, req := range api.requests("sendMessage") {
+						if strings.Contains(req.body["text"].(string), body) {
+							notification = req
+							return true
+						}
+					}
+					return false
+				}, 10*time.Second, 100*time.Millisecond)
+				a.Equal(float64(memberChat), notification.body["chat_id"])
+				a.Equal("HTML", notification.body["parse_mode"])
+
+				reply := message(memberChat, "loki", "replying from **telegram**")
+				reply["reply_to_message"] = map[string]any{
+					"message_id": notification.messageID,
+					"chat":       map[string]any{"id": memberChat, "type": "private"},
+				}
+
+				a.Equal(http.StatusOK, push(t, secret, reply))
+
+				// Telegram redelivers updates, replies are only posted once.
+				a.Equal(http.StatusOK, push(t, secret, reply))
+
+				got := tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, adminSession))(t, http.StatusOK)
+				r.Len(got.JSON200.Replies.Replies, 2)
+				posted := got.JSON200.Replies.Replies[1]
+				a.Equal(member.Handle, posted.Author.Handle)
+				a.Contains(posted.Body, "<strong>telegram</strong>")
+
+				// Members who can't reply on the site can't reply from Telegram either.
+				tests.AssertRequest(cl.ModerationWarningIssueWithResponse(root, member.ID.String(), openapi.WarningInitialProps{
+					Severity: openapi.Severe,
+					Reason:   "Harassing other members.",
+				}, adminSession))(t, http.StatusOK)
+
+				blocked := message(memberChat, "loki", "replying while warned")
+				blocked["reply_to_message"] = map[string]any{
+					"message_id": notification.messageID,
+					"chat":       map[string]any{"id": memberChat, "type": "private"},
+				}
+
+				sent := len(api.requests("sendMessage"))
+				a.Equal(http.StatusOK, push(t, secret, blocked))
+
+				responses := api.requests("sendMessage")
+				r.Len(responses, sent+1)
+				a.Contains(responses[sent].body["text"], "active warnings")
+
+				got = tests.AssertRequest(cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, adminSession))(t, http.StatusOK)
+				a.Len(got.JSON200.Replies.Replies, 2)
+			})
+
+			t.Run("unlink", func(t *testing.T) {
+				tests.AssertRequest(cl.AccountTelegramLinkRemoveWithResponse(root, memberSession))(t, http.StatusNoContent)
+
+				status := tests.AssertRequest(cl.AccountTelegramLinkGetWithResponse(root, memberSession))(t, http.StatusOK)
+				assert.Nil(t, status.JSON200.UserId)
+			})
+		}))
+	}))
+}
+
+func TestTelegramBotDisabled(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), telegram.Build(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		ts *httptest.Server,
+	) {
+		lc.Append(fx.StartHook(func() {
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			memberSession := sh.WithSession(memberCtx)
+
+			tests.AssertRequest(cl.AccountTelegramLinkGetWithResponse(root, memberSession))(t, http.StatusNotFound)
+			tests.AssertRequest(cl.AccountTelegramLinkCreateWithResponse(root, memberSession))(t, http.StatusNotFound)
+
+			res, err := http.Post(ts.URL+"/telegram/webhook", "application/json", strings.NewReader("{}"))
+			require.NoError(t, err)
+			res.Body.Close()
+			assert.NotEqual(t, http.StatusOK, res.StatusCode)
+		}))
+	}))
+}
+
+type request struct {
+	method    string
+	messageID int64
+	body      map[string]any
+}
+
+// botAPI records the Bot API calls made by the bot.
+type botAPI struct {
+	mu   sync.Mutex
+	reqs []request
+}
+
+func (b *botAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	method, ok := strings.CutPrefix(r.URL.Path, "/bot"+botToken+"/")
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": false, "error_code": 401, "description": "Unauthorized"})
+		return
+	}
+
+	var body map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	req := request{
+		method:    method,
+		messageID: int64(len(b.reqs) + 1000),
+		body:      body,
+	}
+	b.reqs = append(b.reqs, req)
+
+	var result any = true
+	switch method {
+	case "getMe":
+		result = map[string]any{"id": 1, "is_bot": true, "first_name": "Storyden", "username": botUsername}
+	case "sendMessage":
+		result = map[string]any{
+			"message_id": req.messageID,
+			"chat":       map[string]any{"id": body["chat_id"], "type": "private"},
+			"text":       body["text"],
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "result": result})
+}
+
+func (b *botAPI) requests(method string) []request {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var out []request
+	for _, r := range b.reqs {
+		if r.method == method {
+			out = append(out, r)
+		}
+	}
+	return out
+}
@@ -0,0 +1,261 @@
+package tenancy_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services"
+	"github.com/Southclaws/storyden/app/services/tenancy"
+	"github.com/Southclaws/storyden/app/transports"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func composeTenant() fx.Option {
+	return fx.Options(
+		infrastructure.Build(),
+		resources.Build(),
+		services.Build(),
+		transports.BuildTenant(),
+	)
+}
+
+func withHost(host string) openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Host = host
+		return nil
+	}
+}
+
+func TestTenancy(t *testing.T) {
+	t.Parallel()
+
+	tenantDB := fmt.Sprintf("sqlite://%s/tenant_{tenant}.db?_pragma=foreign_keys(1)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)", t.TempDir())
+
+	integration.Test(t, &config.Config{
+		TenantDatabaseURL:     tenantDB,
+		AssetStorageLocalPath: t.TempDir(),
+	}, e2e.Setup(), fx.Supply(tenancy.Composition(composeTenant)), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		mux *http.ServeMux,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		tm *tenancy.Manager,
+	) {
+		lc.Append(fx.StartHook(func() {
+			ts := httptest.NewServer(tm.Route(mux))
+			t.Cleanup(ts.Close)
+
+			cl, err := openapi.NewClientWithResponses(ts.URL + "/api")
+			require.NoError(t, err)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			hostname := "community-" + xid.New().String() + ".example.com"
+			tenantHost := withHost(hostname)
+
+			var tenantID string
+
+			t.Run("requires_admin", func(t *testing.T) {
+				res, err := cl.AdminTenantCreateWithResponse(root, openapi.TenantInitialProps{Hostname: hostname, Name: "Community"}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("rejects_invalid_hostname", func(t *testing.T) {
+				res, err := cl.AdminTenantCreateWithResponse(root, openapi.TenantInitialProps{Hostname: "https://" + hostname, Name: "Community"}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("create", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.AdminTenantCreateWithResponse(root, openapi.TenantInitialProps{Hostname: hostname, Name: "Community"}, adminSession)
+				tests.Ok(t, err, res)
+				a.Equal(hostname, res.JSON200.Hostname)
+				tenantID = res.JSON200.Id
+
+				dupe, err := cl.AdminTenantCreateWithResponse(root, openapi.TenantInitialProps{Hostname: hostname, Name: "Other"}, adminSession)
+				tests.Status(t, err, dupe, http.StatusConflict)
+
+				list, err := cl.AdminTenantListWithResponse(root, adminSession)
+				tests.Ok(t, err, list)
+				r.Len(list.JSON200.Tenants, 1)
+				a.Equal(tenantID, list.JSON200.Tenants[0].Id)
+			})
+
+			t.Run("served_on_hostname", func(t *testing.T) {
+				a := assert.New(t)
+
+				info, err := cl.GetInfoWithResponse(root, tenantHost)
+				tests.Ok(t, err, info)
+				a.Equal("Community", info.JSON200.Title)
+
+				primary, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, primary)
+				a.NotEqual("Community", primary.JSON200.Title)
+			})
+
+			t.Run("isolated", func(t *testing.T) {
+				a := assert.New(t)
+
+				handle := "tenant-" + xid.New().String()
+
+				signup, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: handle, Token: "password"}, tenantHost)
+				tests.Ok(t, err, signup)
+				tenantSession := e2e.WithSessionFromHeader(t, root, signup.HTTPResponse.Header)
+
+				// The first member of a new community is its admin.
+				self, err := cl.AccountGetWithResponse(root, tenantHost, tenantSession)
+				tests.Ok(t, err, self)
+				a.True(self.JSON200.Admin)
+
+				onTenant, err := cl.ProfileGetWithResponse(root, handle, tenantHost)
+				tests.Ok(t, err, onTenant)
+
+				onPrimary, err := cl.ProfileGetWithResponse(root, handle)
+				tests.Status(t, err, onPrimary, http.StatusNotFound)
+
+				// Sessions from one community aren't valid on another.
+				primarySelf, err := cl.AccountGetWithResponse(root, tenantSession)
+				tests.Status(t, err, primarySelf, http.StatusUnauthorized)
+
+				// Tenants can't provision tenants of their own.
+				nested, err := cl.AdminTenantListWithResponse(root, tenantHost, tenantSession)
+				tests.Status(t, err, nested, http.StatusBadRequest)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				a := assert.New(t)
+
+				res, err := cl.AdminTenantDeleteWithResponse(root, tenantID, adminSession)
+				tests.Status(t, err, res, http.StatusNoContent)
+
+				// The hostname is no longer routed to the tenant.
+				info, err := cl.GetInfoWithResponse(root, tenantHost)
+				tests.Ok(t, err, info)
+				a.NotEqual("Community", info.JSON200.Title)
+			})
+		}))
+	}))
+}
+
+func TestTenancySlowStart(t *testing.T) {
+	t.Parallel()
+
+	tenantDB := fmt.Sprintf("sqlite://%s/tenant_{tenant}.db?_pragma=foreign_keys(1)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)", t.TempDir())
+
+	// Tenants whose hostname starts with "slow-" don't finish booting until
+	// the test lets them.
+	release := make(chan struct{})
+	compose := func() fx.Option {
+		return fx.Options(
+			composeTenant(),
+			fx.Invoke(func(lc fx.Lifecycle, cfg config.Config) {
+				if strings.HasPrefix(cfg.PublicWebAddress.Host, "slow-") {
+					lc.Append(fx.StartHook(func() { <-release }))
+				}
+			}),
+		)
+	}
+
+	integration.Test(t, &config.Config{
+		TenantDatabaseURL:     tenantDB,
+		AssetStorageLocalPath: t.TempDir(),
+	}, e2e.Setup(), fx.Supply(tenancy.Composition(compose)), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		mux *http.ServeMux,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		tm *tenancy.Manager,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			ts := httptest.NewServer(tm.Route(mux))
+			t.Cleanup(ts.Close)
+
+			cl, err := openapi.NewClientWithResponses(ts.URL + "/api")
+			r.NoError(err)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			slow := "slow-" + xid.New().String() + ".example.com"
+			fast := "fast-" + xid.New().String() + ".example.com"
+
+			created := make(chan int, 1)
+			go func() {
+				res, err := cl.AdminTenantCreateWithResponse(root, openapi.TenantInitialProps{Hostname: slow, Name: "Slow"}, adminSession)
+				if err != nil {
+					created <- 0
+					return
+				}
+				created <- res.StatusCode()
+			}()
+
+			r.Eventually(func() bool {
+				res, err := cl.GetInfoWithResponse(root, withHost(slow))
+				return err == nil && res.StatusCode() == http.StatusServiceUnavailable
+			}, 10*time.Second, 50*time.Millisecond)
+
+			// Another tenant boots while the first is still starting.
+			res, err := cl.AdminTenantCreateWithResponse(root, openapi.TenantInitialProps{Hostname: fast, Name: "Fast"}, adminSession)
+			tests.Ok(t, err, res)
+
+			info, err := cl.GetInfoWithResponse(root, withHost(fast))
+			tests.Ok(t, err, info)
+			a.Equal("Fast", info.JSON200.Title)
+
+			close(release)
+			a.Equal(http.StatusOK, <-created)
+
+			info, err = cl.GetInfoWithResponse(root, withHost(slow))
+			tests.Ok(t, err, info)
+			a.Equal("Slow", info.JSON200.Title)
+		}))
+	}))
+}
+
+func TestTenancyDisabled(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+
+			res, err := cl.AdminTenantListWithResponse(root, sh.WithSession(adminCtx))
+			tests.Status(t, err, res, http.StatusBadRequest)
+		}))
+	}))
+}
@@ -0,0 +1,88 @@
+package thread_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/reply"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+// Replies from chat bridges and email go straight to the reply service, so it
+// must apply the same gates as the HTTP API.
+func TestReplyServiceGates(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		replyService reply.Service,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>gated</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "gated " + uuid.NewString(),
+			}, adminSession))(t, http.StatusOK)
+			threadID := post.ID(openapi.ParseID(thread.JSON200.Id))
+
+			create := func(id account.AccountID) error {
+				content, err := datagraph.NewRichText("<p>from elsewhere</p>")
+				require.NoError(t, err)
+
+				_, err = replyService.Create(root, id, threadID, reply.Partial{
+					Content: opt.New(content),
+				})
+				return err
+			}
+
+			t.Run("allowed", func(t *testing.T) {
+				_, acc := e2e.WithAccount(root, aw, seed.Account_005_Þórr)
+				require.NoError(t, create(acc.ID))
+			})
+
+			t.Run("suspended", func(t *testing.T) {
+				_, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				tests.AssertRequest(cl.AdminAccountBanCreateWithResponse(root, acc.ID.String(), adminSession))(t, http.StatusOK)
+
+				err := create(acc.ID)
+				require.Error(t, err)
+				assert.Equal(t, ftag.PermissionDenied, ftag.Get(err))
+			})
+
+			t.Run("warned", func(t *testing.T) {
+				_, acc := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+				tests.AssertRequest(cl.ModerationWarningIssueWithResponse(root, acc.ID.String(), openapi.WarningInitialProps{
+					Severity: openapi.Severe,
+					Reason:   "Harassing other members.",
+				}, adminSession))(t, http.StatusOK)
+
+				err := create(acc.ID)
+				require.Error(t, err)
+				assert.Equal(t, ftag.PermissionDenied, ftag.Get(err))
+			})
+		}))
+	}))
+}
@@ -54,7 +54,7 @@ func TestThreadCacheWithReactions(t *testing.T) {
 			tests.Ok(t, err, catCreate)
 
 			// Create a thread
-			threadCreate, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+			threadCreate, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 				Body:       opt.New("<p>test thread for cache</p>").Ptr(),
 				Category:   opt.New(catCreate.JSON200.Id).Ptr(),
 				Visibility: opt.New(openapi.Published).Ptr(),
@@ -0,0 +1,153 @@
+package thread_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestThreadListCache(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{CacheQueryTTL: time.Hour}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		db *ent.Client,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			session := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Colour:      "#fe4efd",
+				Description: "list cache testing",
+				Name:        "Category " + uuid.NewString(),
+			}, session)
+			tests.Ok(t, err, cat)
+
+			create := func(title string) *openapi.ThreadCreateResponse {
+				res, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>list cache</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      title,
+				}, session)
+				tests.Ok(t, err, res)
+				return res
+			}
+
+			titles := func(editors ...openapi.RequestEditorFn) []string {
+				res, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+					Categories: &[]string{cat.JSON200.Slug},
+				}, editors...)
+				tests.Ok(t, err, res)
+				return dt.Map(res.JSON200.Threads, func(t openapi.ThreadReference) string { return t.Title })
+			}
+
+			first := create("first")
+
+			a.Equal([]string{"first"}, titles())
+
+			// A change which bypasses the application isn't seen by guests until
+			// the listing is invalidated, members always read fresh listings.
+			err = db.Post.UpdateOneID(openapi.ParseID(first.JSON200.Id)).SetTitle("renamed").Exec(root)
+			r.NoError(err)
+
+			a.Equal([]string{"first"}, titles())
+			a.Equal([]string{"renamed"}, titles(session))
+
+			create("second")
+
+			r.Eventually(func() bool {
+				return len(titles()) == 2
+			}, 5*time.Second, 50*time.Millisecond)
+
+			a.ElementsMatch([]string{"renamed", "second"}, titles())
+
+			t.Run("round_trip", func(t *testing.T) {
+				list := func() *openapi.ThreadListResponse {
+					res, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+						Categories: &[]string{cat.JSON200.Slug},
+					})
+					tests.Ok(t, err, res)
+					return res
+				}
+
+				create("third")
+
+				// The first listing with the new thread is read from the database,
+				// the next is the same listing read back from the cache.
+				var filled *openapi.ThreadListResponse
+				r.Eventually(func() bool {
+					filled = list()
+					return len(filled.JSON200.Threads) == 3
+				}, 5*time.Second, 50*time.Millisecond)
+
+				a.JSONEq(string(filled.Body), string(list().Body))
+			})
+
+			t.Run("approved", func(t *testing.T) {
+				tests.AssertRequest(cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					NewMemberApprovals: opt.New(1).Ptr(),
+				}, session))(t, http.StatusOK)
+
+				freshCtx, _ := e2e.WithAccount(root, aw, seed.Account_005_Þórr)
+
+				held := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>held</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "held",
+				}, sh.WithSession(freshCtx)))(t, http.StatusOK)
+				r.Equal(openapi.Review, held.JSON200.Visibility)
+
+				a.NotContains(titles(), "held")
+
+				tests.AssertRequest(cl.PostQueueUpdateWithResponse(root, held.JSON200.Id, openapi.PostQueueMutableProps{Action: openapi.Approve}, session))(t, http.StatusOK)
+
+				r.Eventually(func() bool {
+					return slices.Contains(titles(), "held")
+				}, 5*time.Second, 50*time.Millisecond)
+			})
+
+			t.Run("category_permissions", func(t *testing.T) {
+				r.NotEmpty(titles())
+
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, cat.JSON200.Slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{
+						{RoleId: role.DefaultRoleMemberID.String(), Read: true},
+					},
+				}, session))(t, http.StatusOK)
+
+				r.Eventually(func() bool {
+					return len(titles()) == 0
+				}, 5*time.Second, 50*time.Millisecond)
+			})
+		}))
+	}))
+}
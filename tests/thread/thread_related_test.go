@@ -0,0 +1,136 @@
+package thread_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_read_state"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestThreadRelated(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		rw *post_read_state.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			session := sh.WithSession(adminCtx)
+
+			// Each test uses its own readers so read states from other tests
+			// don't count as co-visits.
+			reader := func() account.AccountID {
+				_, acc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				return acc.ID
+			}
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, session)
+			tests.Ok(t, err, cat)
+
+			create := func(t *testing.T) *openapi.ThreadCreateOK {
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>related content</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "related test " + uuid.NewString(),
+				}, session)
+				tests.Ok(t, err, thread)
+				return thread.JSON200
+			}
+
+			read := func(t *testing.T, reader account.AccountID, threads ...*openapi.ThreadCreateOK) {
+				for _, th := range threads {
+					err := rw.UpsertReadState(root, reader, post.ID(utils.Must(xid.FromString(th.Id))))
+					require.NoError(t, err)
+				}
+			}
+
+			relatedIDs := func(t *testing.T, slug string) []string {
+				res, err := cl.ThreadRelatedWithResponse(root, slug, nil, session)
+				tests.Ok(t, err, res)
+				return dt.Map(res.JSON200.Items, func(i openapi.DatagraphItem) string {
+					th, err := i.AsDatagraphItemThread()
+					require.NoError(t, err)
+					return th.Ref.Id
+				})
+			}
+
+			t.Run("co_visited", func(t *testing.T) {
+				a := assert.New(t)
+
+				t1, t2, t3, t4 := create(t), create(t), create(t), create(t)
+
+				read(t, reader(), t1, t2, t3)
+				read(t, reader(), t1, t2)
+
+				ids := relatedIDs(t, t1.Slug)
+				a.Equal([]string{t2.Id, t3.Id}, ids, "ranked by shared readers, excluding the thread itself")
+				a.NotContains(ids, t4.Id)
+			})
+
+			t.Run("invalidated_on_update", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				t1, t2, t3 := create(t), create(t), create(t)
+
+				acc := reader()
+
+				read(t, acc, t1, t2)
+				a.Equal([]string{t2.Id}, relatedIDs(t, t1.Slug))
+
+				read(t, acc, t3)
+				a.Equal([]string{t2.Id}, relatedIDs(t, t1.Slug), "served from cache")
+
+				update, err := cl.ThreadUpdateWithResponse(root, t1.Slug, openapi.ThreadMutableProps{
+					Title: opt.New("related test updated " + uuid.NewString()).Ptr(),
+				}, session)
+				tests.Ok(t, err, update)
+
+				r.Eventually(func() bool {
+					return len(relatedIDs(t, t1.Slug)) == 2
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("limit", func(t *testing.T) {
+				t1, t2, t3 := create(t), create(t), create(t)
+
+				read(t, reader(), t1, t2, t3)
+
+				limit := 1
+				res, err := cl.ThreadRelatedWithResponse(root, t1.Slug, &openapi.ThreadRelatedParams{Limit: &limit}, session)
+				tests.Ok(t, err, res)
+				assert.Len(t, res.JSON200.Items, 1)
+			})
+
+			t.Run("not_found", func(t *testing.T) {
+				res, err := cl.ThreadRelatedWithResponse(root, "nonexistent", nil, session)
+				tests.Status(t, err, res, http.StatusNotFound)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,92 @@
+package thread_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestThreadSummary(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		LanguageModelProvider:         "mock",
+		ContentSummariesEnabled:       true,
+		ContentSummaryMinLength:       500,
+		ContentSummaryChangeThreshold: 0.25,
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			session := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, sh.WithSession(adminCtx))
+			tests.Ok(t, err, cat)
+
+			create := func(t *testing.T, body string) *openapi.ThreadCreateOK {
+				thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>" + body + "</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "summary test " + uuid.NewString(),
+				}, session)
+				tests.Ok(t, err, thread)
+				return thread.JSON200
+			}
+
+			t.Run("long_thread", func(t *testing.T) {
+				r := require.New(t)
+
+				thread := create(t, strings.Repeat("a long discussion about many interesting things. ", 20))
+
+				r.Eventually(func() bool {
+					get, err := cl.ThreadGetWithResponse(root, thread.Slug, nil, session)
+					tests.Ok(t, err, get)
+					return get.JSON200.Summary != nil && *get.JSON200.Summary != ""
+				}, 5*time.Second, 100*time.Millisecond)
+
+				list, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{}, session)
+				tests.Ok(t, err, list)
+
+				for _, th := range list.JSON200.Threads {
+					if th.Id == thread.Id {
+						r.NotNil(th.Summary)
+					}
+				}
+			})
+
+			t.Run("short_thread", func(t *testing.T) {
+				a := assert.New(t)
+
+				thread := create(t, "too short to summarise")
+
+				time.Sleep(500 * time.Millisecond)
+
+				get, err := cl.ThreadGetWithResponse(root, thread.Slug, nil, session)
+				tests.Ok(t, err, get)
+				a.Nil(get.JSON200.Summary)
+			})
+		}))
+	}))
+}
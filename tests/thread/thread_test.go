@@ -57,7 +57,7 @@ func TestThreads(t *testing.T) {
 				r := require.New(t)
 				a := assert.New(t)
 
-				thread1create, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread1create, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>this is a thread</p>").Ptr(),
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -86,7 +86,7 @@ func TestThreads(t *testing.T) {
 
 				// Reply to the thread
 
-				reply1create, err := cl.ReplyCreateWithResponse(root, thread1create.JSON200.Slug, openapi.ReplyInitialProps{
+				reply1create, err := cl.ReplyCreateWithResponse(root, thread1create.JSON200.Slug, nil, openapi.ReplyInitialProps{
 					Body: "this is a reply",
 				}, session2)
 				tests.Ok(t, err, reply1create)
@@ -113,7 +113,7 @@ func TestThreads(t *testing.T) {
 				r := require.New(t)
 				a := assert.New(t)
 
-				thread1create, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread1create, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>thread one</p>").Ptr(),
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -121,7 +121,7 @@ func TestThreads(t *testing.T) {
 				}, session1)
 				tests.Ok(t, err, thread1create)
 
-				thread2create, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread2create, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>thread two</p>").Ptr(),
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -146,7 +146,7 @@ func TestThreads(t *testing.T) {
 				}
 
 				// Reply to thread 1, bumping it to the top
-				reply1create, err := cl.ReplyCreateWithResponse(root, thread1create.JSON200.Slug, openapi.ReplyInitialProps{
+				reply1create, err := cl.ReplyCreateWithResponse(root, thread1create.JSON200.Slug, nil, openapi.ReplyInitialProps{
 					Body: "this is a reply",
 				}, session2)
 				tests.Ok(t, err, reply1create)
@@ -166,7 +166,7 @@ func TestThreads(t *testing.T) {
 				}
 
 				// Reply to thread 2, bumping it to the top
-				reply2create, err := cl.ReplyCreateWithResponse(root, thread2create.JSON200.Slug, openapi.ReplyInitialProps{
+				reply2create, err := cl.ReplyCreateWithResponse(root, thread2create.JSON200.Slug, nil, openapi.ReplyInitialProps{
 					Body: "this is a reply",
 				}, session2)
 				tests.Ok(t, err, reply2create)
@@ -192,7 +192,7 @@ func TestThreads(t *testing.T) {
 				a := assert.New(t)
 
 				// create thread a and reply to it (so it gets last_reply_at set)
-				threadA, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				threadA, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>thread A</p>").Ptr(),
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -200,13 +200,13 @@ func TestThreads(t *testing.T) {
 				}, session1)
 				tests.Ok(t, err, threadA)
 
-				replyA, err := cl.ReplyCreateWithResponse(root, threadA.JSON200.Slug, openapi.ReplyInitialProps{
+				replyA, err := cl.ReplyCreateWithResponse(root, threadA.JSON200.Slug, nil, openapi.ReplyInitialProps{
 					Body: "reply to thread A",
 				}, session2)
 				tests.Ok(t, err, replyA)
 
 				// create thread b (no replies, so last_reply_at will be null)
-				threadB, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				threadB, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>thread B</p>").Ptr(),
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -242,7 +242,7 @@ func TestThreads(t *testing.T) {
 				r := require.New(t)
 				a := assert.New(t)
 
-				t1, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				t1, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>t1</p>").Ptr(),
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -250,11 +250,11 @@ func TestThreads(t *testing.T) {
 				}, session1)
 				tests.Ok(t, err, t1)
 
-				r1, err := cl.ReplyCreateWithResponse(root, t1.JSON200.Slug, openapi.ReplyInitialProps{Body: "r1"}, session2)
+				r1, err := cl.ReplyCreateWithResponse(root, t1.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "r1"}, session2)
 				tests.Ok(t, err, r1)
-				r2, err := cl.ReplyCreateWithResponse(root, t1.JSON200.Slug, openapi.ReplyInitialProps{Body: "r2"}, session2)
+				r2, err := cl.ReplyCreateWithResponse(root, t1.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "r2"}, session2)
 				tests.Ok(t, err, r2)
-				r3, err := cl.ReplyCreateWithResponse(root, t1.JSON200.Slug, openapi.ReplyInitialProps{Body: "r3"}, session2)
+				r3, err := cl.ReplyCreateWithResponse(root, t1.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "r3"}, session2)
 				tests.Ok(t, err, r3)
 
 				r1del, err := cl.PostDeleteWithResponse(root, r1.JSON200.Id, session2)
@@ -289,7 +289,7 @@ func TestThreads(t *testing.T) {
 				tests.Ok(t, err, cat)
 
 				url := "https://ogp.me"
-				thread1create, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				thread1create, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>this is a thread</p>").Ptr(),
 					Category:   opt.New(cat.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
@@ -350,7 +350,7 @@ func TestThreads(t *testing.T) {
 				a := assert.New(t)
 
 				// Create a thread without a category as a draft
-				threadCreate, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				threadCreate, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>this is a thread without category</p>").Ptr(),
 					Visibility: opt.New(openapi.Draft).Ptr(),
 					Title:      "Thread without category",
@@ -431,14 +431,14 @@ func TestThreads(t *testing.T) {
 				}, session1)
 				tests.Ok(t, err, cat1create)
 
-				categorisedThread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				categorisedThread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>this is a thread without category</p>").Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
 					Title:      "Thread without category",
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 				}, session1)
 				tests.Ok(t, err, categorisedThread)
-				uncategorisedThread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				uncategorisedThread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>this is a thread without category</p>").Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
 					Title:      "Thread without category",
@@ -479,7 +479,7 @@ func TestThreads(t *testing.T) {
 
 				a := assert.New(t)
 
-				threadCreate, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				threadCreate, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
 					Body:       opt.New("<p>test content</p>").Ptr(),
 					Category:   opt.New(cat1create.JSON200.Id).Ptr(),
 					Visibility: opt.New(openapi.Published).Ptr(),
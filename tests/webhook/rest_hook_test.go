@@ -0,0 +1,198 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_dispatch"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestRESTHooks(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		// Receivers in these tests listen on loopback.
+		AllowedPrivateAddresses: []string{"127.0.0.1"},
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			otherSession := sh.WithSession(otherCtx)
+
+			ok := &receiver{status: http.StatusOK}
+			okServer := httptest.NewServer(ok)
+			defer okServer.Close()
+
+			gone := &receiver{status: http.StatusGone}
+			goneServer := httptest.NewServer(gone)
+			defer goneServer.Close()
+
+			cat := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession))(t, http.StatusOK)
+
+			subscriptionIDs := func(t *testing.T, session openapi.RequestEditorFn) []string {
+				list := tests.AssertRequest(cl.HookSubscriptionListWithResponse(root, session))(t, http.StatusOK)
+				return dt.Map(list.JSON200.Subscriptions, func(s openapi.HookSubscription) string { return s.Id })
+			}
+
+			tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.ThreadCreated, TargetUrl: okServer.URL}))(t, http.StatusUnauthorized)
+			tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.ReportFiled, TargetUrl: okServer.URL}, memberSession))(t, http.StatusForbidden)
+			tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.PostCreated, TargetUrl: okServer.URL}, memberSession))(t, http.StatusBadRequest)
+			tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.ThreadCreated, TargetUrl: "ftp://example.com"}, memberSession))(t, http.StatusBadRequest)
+			tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.ThreadCreated, TargetUrl: "http://169.254.169.254/latest/meta-data"}, memberSession))(t, http.StatusBadRequest)
+			tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.ThreadCreated, TargetUrl: "http://10.0.0.1/hook"}, memberSession))(t, http.StatusBadRequest)
+
+			sub := tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.ThreadCreated, TargetUrl: okServer.URL}, memberSession))(t, http.StatusOK)
+			r.Equal(openapi.ThreadCreated, sub.JSON200.Event)
+
+			goneSub := tests.AssertRequest(cl.HookSubscribeWithResponse(root, openapi.HookSubscribeProps{Event: openapi.ThreadCreated, TargetUrl: goneServer.URL}, memberSession))(t, http.StatusOK)
+
+			r.ElementsMatch([]string{sub.JSON200.Id, goneSub.JSON200.Id}, subscriptionIDs(t, memberSession))
+			r.Empty(subscriptionIDs(t, otherSession))
+
+			thread := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>rest hooks</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "rest hooks",
+			}, adminSession))(t, http.StatusOK)
+
+			t.Run("delivery_matches_poll", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				r.Eventually(func() bool { return len(ok.all()) > 0 }, 10*time.Second, 100*time.Millisecond)
+
+				var delivered webhook_dispatch.Payload
+				r.NoError(json.Unmarshal(ok.all()[0].body, &delivered))
+				a.Equal(thread.JSON200.Id, delivered.ID)
+				a.Equal("thread.created", delivered.Event)
+
+				poll := tests.AssertRequest(cl.HookEventPollWithResponse(root, openapi.ThreadCreated, memberSession))(t, http.StatusOK)
+				r.NotEmpty(poll.JSON200.Items)
+				latest := poll.JSON200.Items[0]
+				a.Equal(delivered.ID, latest.Id)
+				a.Equal(delivered.Data, latest.Data)
+
+				tests.AssertRequest(cl.HookEventPollWithResponse(root, openapi.ReportFiled, memberSession))(t, http.StatusForbidden)
+				tests.AssertRequest(cl.HookEventPollWithResponse(root, openapi.ReportFiled, adminSession))(t, http.StatusOK)
+
+				members := tests.AssertRequest(cl.HookEventPollWithResponse(root, openapi.AccountCreated, memberSession))(t, http.StatusOK)
+				r.NotEmpty(members.JSON200.Items)
+				a.Equal(members.JSON200.Items[0].Id, members.JSON200.Items[0].Data["account_id"])
+			})
+
+			t.Run("unreadable_threads", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				staffRole := tests.AssertRequest(cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "Staff " + uuid.NewString(),
+					Colour:      "blue",
+					Permissions: []openapi.Permission{},
+				}, adminSession))(t, http.StatusOK)
+
+				staffRoom := tests.AssertRequest(cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.CategoryPermissionsUpdateWithResponse(root, staffRoom.JSON200.Slug, openapi.CategoryPermissions{
+					Roles: openapi.CategoryRolePermissionList{{RoleId: staffRole.JSON200.Id, Read: true}},
+				}, adminSession))(t, http.StatusOK)
+
+				hidden := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>staff only</p>").Ptr(),
+					Category:   opt.New(staffRoom.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "staff only",
+				}, adminSession))(t, http.StatusOK)
+
+				visible := tests.AssertRequest(cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>everyone</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      "everyone",
+				}, adminSession))(t, http.StatusOK)
+
+				deliveredIDs := func() []string {
+					return dt.Map(ok.all(), func(rc received) string {
+						var p webhook_dispatch.Payload
+						_ = json.Unmarshal(rc.body, &p)
+						return p.ID
+					})
+				}
+
+				r.Eventually(func() bool { return slices.Contains(deliveredIDs(), visible.JSON200.Id) }, 10*time.Second, 100*time.Millisecond)
+				a.NotContains(deliveredIDs(), hidden.JSON200.Id)
+
+				poll := tests.AssertRequest(cl.HookEventPollWithResponse(root, openapi.ThreadCreated, memberSession))(t, http.StatusOK)
+				polled := dt.Map(poll.JSON200.Items, func(i openapi.HookPayload) string { return i.Id })
+				a.Contains(polled, visible.JSON200.Id)
+				a.NotContains(polled, hidden.JSON200.Id)
+
+				poll = tests.AssertRequest(cl.HookEventPollWithResponse(root, openapi.ThreadCreated, adminSession))(t, http.StatusOK)
+				a.Contains(dt.Map(poll.JSON200.Items, func(i openapi.HookPayload) string { return i.Id }), hidden.JSON200.Id)
+			})
+
+			t.Run("sample", func(t *testing.T) {
+				a := assert.New(t)
+
+				sample := tests.AssertRequest(cl.HookEventSampleWithResponse(root, openapi.ReportFiled, adminSession))(t, http.StatusOK)
+				a.Equal(openapi.ReportFiled, sample.JSON200.Event)
+				a.Equal(sample.JSON200.Id, sample.JSON200.Data["report_id"])
+				a.Contains(sample.JSON200.Data, "target_kind")
+
+				tests.AssertRequest(cl.HookEventSampleWithResponse(root, openapi.PostCreated, adminSession))(t, http.StatusBadRequest)
+			})
+
+			t.Run("gone_removes_subscription", func(t *testing.T) {
+				require.Eventually(t, func() bool {
+					return len(gone.all()) > 0 && !slices.Contains(subscriptionIDs(t, memberSession), goneSub.JSON200.Id)
+				}, 10*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("unsubscribe", func(t *testing.T) {
+				a := assert.New(t)
+
+				tests.AssertRequest(cl.HookUnsubscribeWithResponse(root, sub.JSON200.Id, otherSession))(t, http.StatusNotFound)
+
+				// Webhooks registered by administrators can't be removed here.
+				admin := tests.AssertRequest(cl.AdminWebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+					Name:   "admin",
+					Url:    okServer.URL,
+					Events: openapi.WebhookEventList{openapi.ThreadCreated},
+				}, adminSession))(t, http.StatusOK)
+				tests.AssertRequest(cl.HookUnsubscribeWithResponse(root, admin.JSON200.Id, adminSession))(t, http.StatusNotFound)
+
+				tests.AssertRequest(cl.HookUnsubscribeWithResponse(root, sub.JSON200.Id, memberSession))(t, http.StatusNoContent)
+				a.Empty(subscriptionIDs(t, memberSession))
+			})
+		}))
+	}))
+}
@@ -0,0 +1,235 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_dispatch"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+type receiver struct {
+	mu       sync.Mutex
+	requests []received
+	status   int
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	rc.requests = append(rc.requests, received{header: r.Header.Clone(), body: body})
+	rc.mu.Unlock()
+
+	w.WriteHeader(rc.status)
+}
+
+func (rc *receiver) all() []received {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]received{}, rc.requests...)
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		// Receivers in these tests listen on loopback.
+		AllowedPrivateAddresses: []string{"127.0.0.1"},
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			ok := &receiver{status: http.StatusOK}
+			okServer := httptest.NewServer(ok)
+			defer okServer.Close()
+
+			broken := &receiver{status: http.StatusInternalServerError}
+			brokenServer := httptest.NewServer(broken)
+			defer brokenServer.Close()
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminWebhookListWithResponse(root, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				created, err := cl.AdminWebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+					Name:   "nope",
+					Url:    okServer.URL,
+					Events: openapi.WebhookEventList{"thread.created"},
+				}, memberSession)
+				tests.Status(t, err, created, http.StatusForbidden)
+			})
+
+			t.Run("invalid_url", func(t *testing.T) {
+				res, err := cl.AdminWebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+					Name:   "bad",
+					Url:    "ftp://example.com",
+					Events: openapi.WebhookEventList{"thread.created"},
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+
+				res, err = cl.AdminWebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+					Name:   "metadata",
+					Url:    "http://169.254.169.254/latest/meta-data",
+					Events: openapi.WebhookEventList{"thread.created"},
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			okHook, err := cl.AdminWebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+				Name:   "receiver",
+				Url:    okServer.URL,
+				Events: openapi.WebhookEventList{"thread.created"},
+			}, adminSession)
+			tests.Ok(t, err, okHook)
+			r.NotNil(okHook.JSON200.Secret)
+			secret := *okHook.JSON200.Secret
+
+			brokenHook, err := cl.AdminWebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+				Name:   "broken",
+				Url:    brokenServer.URL,
+				Events: openapi.WebhookEventList{"thread.created"},
+			}, adminSession)
+			tests.Ok(t, err, brokenHook)
+
+			get, err := cl.AdminWebhookGetWithResponse(root, okHook.JSON200.Id, adminSession)
+			tests.Ok(t, err, get)
+			a.Equal("receiver", get.JSON200.Name)
+
+			thread, err := cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>webhooks</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "webhooks",
+			}, adminSession)
+			tests.Ok(t, err, thread)
+
+			t.Run("signed_delivery", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				r.Eventually(func() bool { return len(ok.all()) > 0 }, 10*time.Second, 100*time.Millisecond)
+
+				req := ok.all()[0]
+				a.Equal("thread.created", req.header.Get(webhook_dispatch.HeaderEvent))
+				a.NotEmpty(req.header.Get(webhook_dispatch.HeaderDelivery))
+				a.Contains(string(req.body), thread.JSON200.Id)
+
+				sig := req.header.Get(webhook_dispatch.HeaderSignature)
+				ts, _, found := strings.Cut(strings.TrimPrefix(sig, "t="), ",")
+				r.True(found)
+				unix, err := strconv.ParseInt(ts, 10, 64)
+				r.NoError(err)
+				a.Equal(webhook_dispatch.Sign(secret, time.Unix(unix, 0), req.body), sig)
+
+				r.Eventually(func() bool {
+					res, err := cl.AdminWebhookDeliveryListWithResponse(root, okHook.JSON200.Id, adminSession)
+					tests.Ok(t, err, res)
+					return len(res.JSON200.Deliveries) == 1 &&
+						res.JSON200.Deliveries[0].Status == "succeeded"
+				}, 10*time.Second, 100*time.Millisecond)
+			})
+
+			t.Run("failed_delivery_scheduled_for_retry", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				var delivery openapi.WebhookDelivery
+				r.Eventually(func() bool {
+					res, err := cl.AdminWebhookDeliveryListWithResponse(root, brokenHook.JSON200.Id, adminSession)
+					tests.Ok(t, err, res)
+					if len(res.JSON200.Deliveries) == 0 || res.JSON200.Deliveries[0].Attempts == 0 {
+						return false
+					}
+					delivery = res.JSON200.Deliveries[0]
+					return true
+				}, 10*time.Second, 100*time.Millisecond)
+
+				a.Equal(openapi.WebhookDeliveryStatus("pending"), delivery.Status)
+				a.Equal(1, delivery.Attempts)
+				a.Equal(opt.New(http.StatusInternalServerError).Ptr(), delivery.ResponseStatus)
+				a.NotNil(delivery.NextAttemptAt)
+				a.NotNil(delivery.Error)
+
+				redelivered, err := cl.AdminWebhookDeliveryRedeliverWithResponse(root, brokenHook.JSON200.Id, delivery.Id, adminSession)
+				tests.Ok(t, err, redelivered)
+				a.NotEqual(delivery.Id, redelivered.JSON200.Id)
+				a.Equal(delivery.Payload, redelivered.JSON200.Payload)
+
+				res, err := cl.AdminWebhookDeliveryListWithResponse(root, brokenHook.JSON200.Id, adminSession)
+				tests.Ok(t, err, res)
+				a.Len(res.JSON200.Deliveries, 2)
+
+				wrong, err := cl.AdminWebhookDeliveryRedeliverWithResponse(root, okHook.JSON200.Id, delivery.Id, adminSession)
+				tests.Status(t, err, wrong, http.StatusNotFound)
+			})
+
+			t.Run("update_and_rotate", func(t *testing.T) {
+				a := assert.New(t)
+
+				updated, err := cl.AdminWebhookUpdateWithResponse(root, okHook.JSON200.Id, openapi.WebhookMutableProps{
+					Enabled:      opt.New(false).Ptr(),
+					RotateSecret: opt.New(true).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, updated)
+				a.False(updated.JSON200.Enabled)
+				a.NotNil(updated.JSON200.Secret)
+				a.NotEqual(secret, *updated.JSON200.Secret)
+
+				renamed, err := cl.AdminWebhookUpdateWithResponse(root, okHook.JSON200.Id, openapi.WebhookMutableProps{
+					Name: opt.New("renamed").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, renamed)
+				a.Equal("renamed", renamed.JSON200.Name)
+				a.Nil(renamed.JSON200.Secret)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				del, err := cl.AdminWebhookDeleteWithResponse(root, brokenHook.JSON200.Id, adminSession)
+				tests.Status(t, err, del, http.StatusNoContent)
+
+				get, err := cl.AdminWebhookGetWithResponse(root, brokenHook.JSON200.Id, adminSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+			})
+		}))
+	}))
+}
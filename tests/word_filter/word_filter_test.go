@@ -0,0 +1,175 @@
+package word_filter_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestWordFilters(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			// Unique terms so filters don't affect other tests sharing the instance.
+			blocked := "blockterm" + uuid.NewString()[:8]
+			reviewed := "reviewterm" + uuid.NewString()[:8]
+			masked := "maskterm" + uuid.NewString()[:8]
+			replaced := "replaceterm" + uuid.NewString()[:8]
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Name: uuid.NewString(), Colour: "#000"}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func(title, body string) (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, nil, openapi.ThreadInitialProps{
+					Body:       opt.New("<p>" + body + "</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Title:      title + " " + uuid.NewString(),
+				}, memberSession)
+			}
+
+			createFilter := func(props openapi.WordFilterInitialProps) string {
+				res, err := cl.AdminWordFilterCreateWithResponse(root, props, adminSession)
+				tests.Ok(t, err, res)
+				return res.JSON200.Id
+			}
+
+			t.Run("member_forbidden", func(t *testing.T) {
+				res, err := cl.AdminWordFilterListWithResponse(root, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+
+				created, err := cl.AdminWordFilterCreateWithResponse(root, openapi.WordFilterInitialProps{
+					Term:   blocked,
+					Action: openapi.WordFilterActionBlock,
+					Scope:  openapi.WordFilterScopeAll,
+				}, memberSession)
+				tests.Status(t, err, created, http.StatusForbidden)
+			})
+
+			t.Run("empty_term", func(t *testing.T) {
+				res, err := cl.AdminWordFilterCreateWithResponse(root, openapi.WordFilterInitialProps{
+					Term:   "  ",
+					Action: openapi.WordFilterActionBlock,
+					Scope:  openapi.WordFilterScopeAll,
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			blockID := createFilter(openapi.WordFilterInitialProps{
+				Term:   blocked,
+				Action: openapi.WordFilterActionBlock,
+				Scope:  openapi.WordFilterScopeTitles,
+			})
+			createFilter(openapi.WordFilterInitialProps{
+				Term:   reviewed,
+				Action: openapi.WordFilterActionReview,
+				Scope:  openapi.WordFilterScopeAll,
+			})
+			createFilter(openapi.WordFilterInitialProps{
+				Term:   masked,
+				Action: openapi.WordFilterActionReplace,
+				Scope:  openapi.WordFilterScopeAll,
+			})
+			createFilter(openapi.WordFilterInitialProps{
+				Term:        replaced,
+				Action:      openapi.WordFilterActionReplace,
+				Replacement: opt.New("kindness").Ptr(),
+				Scope:       openapi.WordFilterScopeAll,
+			})
+
+			t.Run("block_in_title", func(t *testing.T) {
+				thread, err := createThread("about "+blocked, "hello")
+				tests.Status(t, err, thread, http.StatusBadRequest)
+			})
+
+			t.Run("title_scope_ignores_body", func(t *testing.T) {
+				thread, err := createThread("hello", "about "+blocked)
+				tests.Ok(t, err, thread)
+				a.Equal(openapi.Published, thread.JSON200.Visibility)
+			})
+
+			t.Run("review_holds_post", func(t *testing.T) {
+				thread, err := createThread("hello", "visit "+reviewed)
+				tests.Ok(t, err, thread)
+				a.Equal(openapi.Review, thread.JSON200.Visibility)
+			})
+
+			t.Run("replace", func(t *testing.T) {
+				thread, err := createThread("spreading "+replaced, "such "+masked+" here")
+				tests.Ok(t, err, thread)
+				a.Contains(thread.JSON200.Title, "spreading kindness")
+				a.NotContains(thread.JSON200.Body, masked)
+				a.Contains(thread.JSON200.Body, "such "+strings.Repeat("*", len(masked))+" here")
+
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, nil, openapi.ReplyInitialProps{Body: "<p>" + replaced + "</p>"}, memberSession)
+				tests.Ok(t, err, reply)
+				a.Contains(reply.JSON200.Body, "kindness")
+			})
+
+			t.Run("display_names", func(t *testing.T) {
+				res, err := cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Name: opt.New("The " + blocked).Ptr(),
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+
+				res, err = cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Name: opt.New("The " + reviewed).Ptr(),
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+
+				res, err = cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+					Name: opt.New("The " + replaced).Ptr(),
+				}, memberSession)
+				tests.Ok(t, err, res)
+				a.Equal("The kindness", res.JSON200.Name)
+			})
+
+			t.Run("disabled", func(t *testing.T) {
+				updated, err := cl.AdminWordFilterUpdateWithResponse(root, blockID, openapi.WordFilterMutableProps{
+					Enabled: opt.New(false).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, updated)
+				a.False(updated.JSON200.Enabled)
+
+				thread, err := createThread("about "+blocked, "hello")
+				tests.Ok(t, err, thread)
+			})
+
+			list, err := cl.AdminWordFilterListWithResponse(root, adminSession)
+			tests.Ok(t, err, list)
+			r.GreaterOrEqual(len(list.JSON200.Filters), 4)
+
+			del, err := cl.AdminWordFilterDeleteWithResponse(root, blockID, adminSession)
+			tests.Status(t, err, del, http.StatusNoContent)
+		}))
+	}))
+}
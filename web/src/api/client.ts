@@ -7,6 +7,12 @@ import { Options, buildRequest, buildResult } from "./common";
 export const fetcher = async <T>(opts: Options): Promise<T> => {
   const request = buildRequest({
     ...opts,
+    headers: {
+      ...opts.headers,
+      // Lets the API fill in the member's timezone on sign in, so emails and
+      // calendars are scheduled in their local time until they choose one.
+      "X-Storyden-Timezone": Intl.DateTimeFormat().resolvedOptions().timeZone,
+    },
     // We use the browser default cache behaviour for the client side requests.
     // There's no revalidation set on the client as we're already using SWR for
     // that. The default cache behaviour will however make use of browser HTTP
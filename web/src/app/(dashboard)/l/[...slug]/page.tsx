@@ -3,7 +3,7 @@ import { Metadata } from "next";
 import { NodeListResult, NodeWithChildren } from "@/api/openapi-schema";
 import { nodeGet, nodeListChildren } from "@/api/openapi-server/nodes";
 import { getTargetSlug } from "@/components/library/utils";
-import { WEB_ADDRESS } from "@/config";
+import { API_ADDRESS } from "@/config";
 import {
   LibraryPageBlockTypeDirectory,
   parseNodeMetadata,
@@ -61,9 +61,7 @@ export async function generateMetadata(props: Props) {
       title: `${data.name} | ${settings.title}`,
       description: data.description,
       openGraph: {
-        // NOTE: Massive hack because Next.js still hasn't fixed a bug with
-        // catch-all routes and opengraph-image route handlers.
-        images: [`${WEB_ADDRESS}/l/og?slug=${targetSlug}&t=${data.updatedAt}`],
+        images: [`${API_ADDRESS}/api/og/nodes/${targetSlug}?t=${data.updatedAt}`],
       },
     } satisfies Metadata;
   } catch (e) {
@@ -2,6 +2,7 @@ import { z } from "zod";
 
 import { threadGet } from "@/api/openapi-server/threads";
 import { UnreadyBanner } from "@/components/site/Unready";
+import { API_ADDRESS } from "@/config";
 import { getSettings } from "@/lib/settings/settings-server";
 import { ThreadScreen } from "@/screens/thread/ThreadScreen/ThreadScreen";
 
@@ -41,6 +42,9 @@ export async function generateMetadata(props: Props) {
     return {
       title: `${data.title} | ${settings.title}`,
       description: data.description,
+      openGraph: {
+        images: [`${API_ADDRESS}/api/og/threads/${params.slug}?t=${data.updatedAt}`],
+      },
     };
   } catch (e) {
     return {
@@ -6,7 +6,9 @@ import { parseAsBoolean, useQueryState } from "nuqs";
 import { memo } from "react";
 
 import { useNodeGet } from "@/api/openapi-client/nodes";
+import { DatagraphItemKind } from "@/api/openapi-schema";
 import { UnreadyBanner } from "@/components/site/Unready";
+import { useBeacon } from "@/lib/beacon/useBeacon";
 import { LStack } from "@/styled-system/jsx";
 
 import { Params } from "../library-path";
@@ -36,6 +38,9 @@ export function LibraryPageScreen(props: Props) {
       enabled: !editing,
     },
   });
+
+  useBeacon(DatagraphItemKind.node, data?.id);
+
   if (!data) {
     return <UnreadyBanner error={error} />;
   }